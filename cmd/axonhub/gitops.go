@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/looplj/axonhub/conf"
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/server/db"
+	"github.com/looplj/axonhub/internal/server/gitops"
+)
+
+func newGitOpsService(reason string) (*gitops.GitOpsService, context.Context, func()) {
+	config, err := conf.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+
+	client := db.NewEntClient(config.DB)
+
+	ctx := authz.WithSystemBypass(context.Background(), reason)
+	ctx = ent.NewContext(ctx, client)
+
+	return gitops.NewGitOpsService(gitops.GitOpsServiceParams{Ent: client}), ctx, func() { _ = client.Close() }
+}
+
+func configExport() {
+	var dir string
+
+	for i := 3; i < len(os.Args); i++ {
+		if (os.Args[i] == "--output" || os.Args[i] == "-o") && i+1 < len(os.Args) {
+			dir = os.Args[i+1]
+			i++
+		}
+	}
+
+	svc, ctx, closeClient := newGitOpsService("config-export")
+	defer closeClient()
+
+	doc, err := svc.ExportWithoutAuth(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Export failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	if dir != "" {
+		if err := gitops.WriteDir(doc, dir); err != nil {
+			fmt.Fprintf(os.Stderr, "Export failed: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("Configuration exported to %s\n", dir)
+
+		return
+	}
+
+	data, err := gitops.Marshal(doc)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Export failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Print(string(data))
+}
+
+func configApply() {
+	var (
+		path string
+		opts gitops.ApplyOptions
+	)
+
+	for i := 3; i < len(os.Args); i++ {
+		switch os.Args[i] {
+		case "--file", "-f":
+			if i+1 < len(os.Args) {
+				path = os.Args[i+1]
+				i++
+			}
+		case "--prune":
+			opts.Prune = true
+		case "--dry-run":
+			opts.DryRun = true
+		}
+	}
+
+	if path == "" {
+		fmt.Println("Usage: axonhub config apply -f <file|dir> [--prune] [--dry-run]")
+		os.Exit(1)
+	}
+
+	doc, err := gitops.Load(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load %s: %v\n", path, err)
+		os.Exit(1)
+	}
+
+	svc, ctx, closeClient := newGitOpsService("config-apply")
+	defer closeClient()
+
+	plan, err := svc.ApplyWithoutAuth(ctx, doc, opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Apply failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	for _, change := range plan.Changes {
+		fmt.Println(formatChange(change))
+	}
+
+	switch {
+	case plan.Empty():
+		fmt.Println("No changes, the configuration is up to date")
+	case opts.DryRun:
+		fmt.Printf("%d change(s) planned, run without --dry-run to apply them\n", len(plan.Changes))
+	default:
+		fmt.Printf("%d change(s) applied\n", len(plan.Changes))
+	}
+}
+
+func formatChange(change *gitops.Change) string {
+	symbol := map[gitops.Action]string{
+		gitops.ActionCreate: "+",
+		gitops.ActionUpdate: "~",
+		gitops.ActionDelete: "-",
+	}[change.Action]
+
+	line := fmt.Sprintf("%s %s %s", symbol, change.Kind, change.Name)
+	if len(change.Fields) > 0 {
+		line += " (" + strings.Join(change.Fields, ", ") + ")"
+	}
+
+	return line
+}
@@ -19,6 +19,7 @@ import (
 	_ "time/tzdata"
 
 	sdk "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
 	"github.com/looplj/axonhub/conf"
 	"github.com/looplj/axonhub/internal/build"
@@ -28,6 +29,7 @@ import (
 	"github.com/looplj/axonhub/internal/server"
 	"github.com/looplj/axonhub/internal/server/biz"
 	"github.com/looplj/axonhub/internal/server/middleware"
+	"github.com/looplj/axonhub/internal/tracing"
 	"github.com/looplj/axonhub/llm/transformer/antigravity"
 )
 
@@ -40,6 +42,9 @@ func main() {
 		case "config":
 			handleConfigCommand()
 			return
+		case "rollup":
+			handleRollupCommand()
+			return
 		case "version", "--version", "-v":
 			showVersion()
 			return
@@ -74,6 +79,19 @@ func startServer() {
 		}),
 		conf.Module,
 		fx.Provide(metrics.NewProvider),
+		fx.Provide(tracing.NewTracerProvider),
+		// Registered before the server hook so that Fx flushes the spans after the server stops.
+		fx.Invoke(func(lc fx.Lifecycle, provider *sdktrace.TracerProvider) {
+			lc.Append(fx.Hook{
+				OnStop: func(ctx context.Context) error {
+					if provider != nil {
+						return provider.Shutdown(ctx)
+					}
+
+					return nil
+				},
+			})
+		}),
 		fx.Invoke(func(lc fx.Lifecycle, cfg server.Config) {
 			lc.Append(fx.Hook{
 				OnStart: func(ctx context.Context) error {
@@ -195,7 +213,7 @@ func expandHome(path string) string {
 
 func handleConfigCommand() {
 	if len(os.Args) < 3 {
-		fmt.Println("Usage: axonhub config <preview|validate|get>")
+		fmt.Println("Usage: axonhub config <preview|validate|get|export|apply>")
 		os.Exit(1)
 	}
 
@@ -206,8 +224,12 @@ func handleConfigCommand() {
 		configValidate()
 	case "get":
 		configGet()
+	case "export":
+		configExport()
+	case "apply":
+		configApply()
 	default:
-		fmt.Println("Usage: axonhub config <preview|validate|get>")
+		fmt.Println("Usage: axonhub config <preview|validate|get|export|apply>")
 		os.Exit(1)
 	}
 }
@@ -357,11 +379,19 @@ func showHelp() {
 	fmt.Println("  axonhub config preview     Preview configuration")
 	fmt.Println("  axonhub config validate    Validate configuration")
 	fmt.Println("  axonhub config get <key>   Get a specific config value")
+	fmt.Println("  axonhub config export      Export channels, models, prices and API keys as YAML")
+	fmt.Println("  axonhub config apply       Apply YAML declared channels, models, prices and API keys")
+	fmt.Println("  axonhub rollup backfill    Rebuild usage rollups from usage logs")
 	fmt.Println("  axonhub version            Show version")
 	fmt.Println("  axonhub help               Show this help message")
 	fmt.Println("")
 	fmt.Println("Options:")
 	fmt.Println("  -f, --format FORMAT       Output format for config preview (yml, json)")
+	fmt.Println("  --from, --to YYYY-MM-DD   Inclusive date range for rollup backfill, in the system timezone")
+	fmt.Println("  -o, --output DIR          Directory for config export, one file per kind (default: stdout)")
+	fmt.Println("  -f, --file PATH           File or directory of YAML documents for config apply")
+	fmt.Println("  --prune                   Delete objects not declared in the applied documents")
+	fmt.Println("  --dry-run                 Print the planned changes without applying them")
 }
 
 func showVersion() {
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/looplj/axonhub/conf"
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/internal/server/db"
+)
+
+func handleRollupCommand() {
+	if len(os.Args) < 3 || os.Args[2] != "backfill" {
+		fmt.Println("Usage: axonhub rollup backfill --from YYYY-MM-DD [--to YYYY-MM-DD]")
+		os.Exit(1)
+	}
+
+	var fromStr, toStr string
+
+	for i := 3; i < len(os.Args); i++ {
+		switch os.Args[i] {
+		case "--from":
+			if i+1 < len(os.Args) {
+				fromStr = os.Args[i+1]
+				i++
+			}
+		case "--to":
+			if i+1 < len(os.Args) {
+				toStr = os.Args[i+1]
+				i++
+			}
+		}
+	}
+
+	if fromStr == "" {
+		fmt.Println("Usage: axonhub rollup backfill --from YYYY-MM-DD [--to YYYY-MM-DD]")
+		os.Exit(1)
+	}
+
+	config, err := conf.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+
+	client := db.NewEntClient(config.DB)
+	defer client.Close()
+
+	ctx := authz.WithSystemBypass(context.Background(), "usage-rollup-backfill")
+
+	systemService := biz.NewSystemService(biz.SystemServiceParams{
+		CacheConfig: config.Cache,
+		Ent:         client,
+	})
+	rollupService := biz.NewUsageRollupService(biz.UsageRollupServiceParams{
+		Ent:           client,
+		SystemService: systemService,
+	})
+
+	// The dates are days in the system timezone, the same as the analytics filters.
+	loc := systemService.TimeLocation(ctx)
+
+	from, err := time.ParseInLocation(time.DateOnly, fromStr, loc)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid --from date %q: %v\n", fromStr, err)
+		os.Exit(1)
+	}
+
+	to := time.Now().In(loc)
+	if toStr != "" {
+		to, err = time.ParseInLocation(time.DateOnly, toStr, loc)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid --to date %q: %v\n", toStr, err)
+			os.Exit(1)
+		}
+	}
+
+	// --to is inclusive.
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1)
+
+	if !from.Before(to) {
+		fmt.Fprintln(os.Stderr, "--from must not be after --to")
+		os.Exit(1)
+	}
+
+	if err := rollupService.Backfill(ctx, from, to); err != nil {
+		fmt.Fprintf(os.Stderr, "Backfill failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Usage rollups backfilled from %s to %s (%s)\n", from.Format(time.DateOnly), to.AddDate(0, 0, -1).Format(time.DateOnly), loc)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/looplj/axonhub/internal/server/biz"
 	"github.com/looplj/axonhub/internal/server/db"
 	"github.com/looplj/axonhub/internal/server/gc"
+	"github.com/looplj/axonhub/internal/tracing"
 )
 
 type Config struct {
@@ -32,10 +33,13 @@ type Config struct {
 	Log              log.Config          `conf:"log" yaml:"log" json:"log"`
 	APIServer        server.Config       `conf:"server" yaml:"server" json:"server"`
 	Metrics          metrics.Config      `conf:"metrics" yaml:"metrics" json:"metrics"`
+	Tracing          tracing.OTelConfig  `conf:"tracing" yaml:"tracing" json:"tracing"`
 	GC               gc.Config           `conf:"gc" yaml:"gc" json:"gc"`
 	Cache            xcache.Config       `conf:"cache" yaml:"cache" json:"cache"`
 	ProviderQuota    providerQuotaConfig `conf:"provider_quota" yaml:"provider_quota" json:"provider_quota"`
 	OIDC             biz.OIDCConfig      `conf:"oidc" yaml:"oidc" json:"oidc"`
+	SCIM             biz.SCIMConfig      `conf:"scim" yaml:"scim" json:"scim"`
+	SAML             biz.SAMLConfig      `conf:"saml" yaml:"saml" json:"saml"`
 	DisableSSLVerify bool                `name:"disable_ssl_verify" yaml:"-" json:"-"`
 	AllowNoAuth      bool                `name:"allow_no_auth" yaml:"-" json:"-"`
 	APIKeyPrefix     string              `name:"api_key_prefix" yaml:"-" json:"-"`
@@ -284,6 +288,15 @@ func setDefaults(v *viper.Viper) {
 
 	// Metrics defaults
 	v.SetDefault("metrics.enabled", false)
+	v.SetDefault("metrics.exporter.bearer_token", "")
+
+	// Tracing defaults
+	v.SetDefault("tracing.enabled", false)
+	v.SetDefault("tracing.service_name", "axonhub")
+	v.SetDefault("tracing.sample_ratio", 1.0)
+	v.SetDefault("tracing.exporter.type", "otlpgrpc")
+	v.SetDefault("tracing.exporter.endpoint", "localhost:4317")
+	v.SetDefault("tracing.exporter.insecure", false)
 
 	// GC defaults
 	v.SetDefault("gc.cron", "0 2 * * *") // Daily at 2:00 AM
@@ -312,6 +325,14 @@ func setDefaults(v *viper.Viper) {
 
 	// OIDC defaults
 	v.SetDefault("oidc.providers", []biz.OIDCProvider{})
+
+	// SCIM defaults
+	v.SetDefault("scim.enabled", false)
+	v.SetDefault("scim.token", "")
+	v.SetDefault("scim.group_mappings", []biz.SCIMGroupMapping{})
+
+	// SAML defaults
+	v.SetDefault("saml.providers", []biz.SAMLProvider{})
 }
 
 // parseLogLevel converts a string log level to zapcore.Level.
@@ -0,0 +1,41 @@
+package contexts
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// AuditInfo describes the admin operation in progress.
+// Mutations of audited entities made while it is present in the context are written to the audit log.
+type AuditInfo struct {
+	// Source is graphql or rest.
+	Source string
+	// Operation is the GraphQL mutation field or the REST route.
+	Operation string
+
+	recorded atomic.Int32
+}
+
+// MarkRecorded marks that at least one audit log has been written for the operation.
+func (i *AuditInfo) MarkRecorded() {
+	i.recorded.Add(1)
+}
+
+// Recorded reports whether any audit log has been written for the operation.
+func (i *AuditInfo) Recorded() bool {
+	return i.recorded.Load() > 0
+}
+
+// WithAuditInfo stores the audit info in the context.
+func WithAuditInfo(ctx context.Context, info *AuditInfo) context.Context {
+	container := getContainer(ctx)
+	container.Audit = info
+
+	return withContainer(ctx, container)
+}
+
+// GetAuditInfo retrieves the audit info from the context.
+func GetAuditInfo(ctx context.Context) (*AuditInfo, bool) {
+	container := getContainer(ctx)
+	return container.Audit, container.Audit != nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/objects"
 )
 
 // contextContainer contains all values in the context.
@@ -14,11 +15,15 @@ type contextContainer struct {
 	TraceID       *string
 	RequestID     *string
 	OperationName *string
+	ClientIP      *string
 	APIKey        *ent.APIKey
+	APIKeyToken   *objects.APIKeyToken
+	EndUserID     *string
 	User          *ent.User
 	Source        *request.Source
 	Thread        *ent.Thread
 	Trace         *ent.Trace
+	Audit         *AuditInfo
 	Errors        []error
 	mu            sync.RWMutex
 
@@ -5,6 +5,7 @@ import (
 	"slices"
 
 	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/objects"
 )
 
 // ContextKey defines the context key type.
@@ -39,6 +40,38 @@ func GetAPIKeyString(ctx context.Context) (string, bool) {
 	return apiKey.Key, true
 }
 
+// WithAPIKeyToken stores the short-lived API key token in the context.
+func WithAPIKeyToken(ctx context.Context, token *objects.APIKeyToken) context.Context {
+	container := getContainer(ctx)
+	container.APIKeyToken = token
+
+	return withContainer(ctx, container)
+}
+
+// GetAPIKeyToken retrieves the short-lived API key token from the context.
+func GetAPIKeyToken(ctx context.Context) (*objects.APIKeyToken, bool) {
+	container := getContainer(ctx)
+	return container.APIKeyToken, container.APIKeyToken != nil
+}
+
+// WithEndUserID stores the end user identifier of the request in the context.
+func WithEndUserID(ctx context.Context, endUserID string) context.Context {
+	container := getContainer(ctx)
+	container.EndUserID = &endUserID
+
+	return withContainer(ctx, container)
+}
+
+// GetEndUserID retrieves the end user identifier of the request from the context.
+func GetEndUserID(ctx context.Context) (string, bool) {
+	container := getContainer(ctx)
+	if container.EndUserID != nil {
+		return *container.EndUserID, true
+	}
+
+	return "", false
+}
+
 // WithUser stores the user entity in the context.
 func WithUser(ctx context.Context, user *ent.User) context.Context {
 	container := getContainer(ctx)
@@ -89,6 +122,24 @@ func GetOperationName(ctx context.Context) (string, bool) {
 	return "", false
 }
 
+// WithClientIP stores the client ip of the incoming http request in the context.
+func WithClientIP(ctx context.Context, clientIP string) context.Context {
+	container := getContainer(ctx)
+	container.ClientIP = &clientIP
+
+	return withContainer(ctx, container)
+}
+
+// GetClientIP retrieves the client ip from the context.
+func GetClientIP(ctx context.Context) (string, bool) {
+	container := getContainer(ctx)
+	if container.ClientIP != nil {
+		return *container.ClientIP, true
+	}
+
+	return "", false
+}
+
 // WithRequestID stores the request id in the context.
 func WithRequestID(ctx context.Context, requestID string) context.Context {
 	container := getContainer(ctx)
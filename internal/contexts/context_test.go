@@ -534,3 +534,22 @@ func TestContextContainerWithOtherValues(t *testing.T) {
 		t.Error("Our context values should also be accessible")
 	}
 }
+
+func TestWithClientIP(t *testing.T) {
+	ctx := t.Context()
+
+	if _, ok := GetClientIP(ctx); ok {
+		t.Error("GetClientIP should return false for context without client ip")
+	}
+
+	newCtx := WithClientIP(ctx, "192.168.1.10")
+
+	clientIP, ok := GetClientIP(newCtx)
+	if !ok {
+		t.Error("GetClientIP should return true for existing client ip")
+	}
+
+	if clientIP != "192.168.1.10" {
+		t.Errorf("expected client ip 192.168.1.10, got %s", clientIP)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/project"
 	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/wallet"
 	"github.com/looplj/axonhub/internal/objects"
 )
 
@@ -45,6 +46,8 @@ type APIKey struct {
 	Profiles *objects.APIKeyProfiles `json:"profiles,omitempty"`
 	// IP CIDR allowlist for this API key. If non-empty, only requests from matching source IPs are accepted.
 	AllowedIps []string `json:"allowed_ips,omitempty"`
+	// Revocations of the short-lived tokens minted by this API key
+	TokenRevocations *objects.APIKeyTokenRevocations `json:"token_revocations,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the APIKeyQuery when eager-loading is set.
 	Edges        APIKeyEdges `json:"edges"`
@@ -59,11 +62,13 @@ type APIKeyEdges struct {
 	Project *Project `json:"project,omitempty"`
 	// Requests holds the value of the requests edge.
 	Requests []*Request `json:"requests,omitempty"`
+	// Wallet holds the value of the wallet edge.
+	Wallet *Wallet `json:"wallet,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 	// totalCount holds the count of the edges above.
-	totalCount [3]map[string]int
+	totalCount [4]map[string]int
 
 	namedRequests map[string][]*Request
 }
@@ -99,12 +104,23 @@ func (e APIKeyEdges) RequestsOrErr() ([]*Request, error) {
 	return nil, &NotLoadedError{edge: "requests"}
 }
 
+// WalletOrErr returns the Wallet value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e APIKeyEdges) WalletOrErr() (*Wallet, error) {
+	if e.Wallet != nil {
+		return e.Wallet, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: wallet.Label}
+	}
+	return nil, &NotLoadedError{edge: "wallet"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*APIKey) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case apikey.FieldScopes, apikey.FieldProfiles, apikey.FieldAllowedIps:
+		case apikey.FieldScopes, apikey.FieldProfiles, apikey.FieldAllowedIps, apikey.FieldTokenRevocations:
 			values[i] = new([]byte)
 		case apikey.FieldID, apikey.FieldDeletedAt, apikey.FieldUserID, apikey.FieldProjectID:
 			values[i] = new(sql.NullInt64)
@@ -211,6 +227,14 @@ func (_m *APIKey) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field allowed_ips: %w", err)
 				}
 			}
+		case apikey.FieldTokenRevocations:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field token_revocations", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TokenRevocations); err != nil {
+					return fmt.Errorf("unmarshal field token_revocations: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -239,6 +263,11 @@ func (_m *APIKey) QueryRequests() *RequestQuery {
 	return NewAPIKeyClient(_m.config).QueryRequests(_m)
 }
 
+// QueryWallet queries the "wallet" edge of the APIKey entity.
+func (_m *APIKey) QueryWallet() *WalletQuery {
+	return NewAPIKeyClient(_m.config).QueryWallet(_m)
+}
+
 // Update returns a builder for updating this APIKey.
 // Note that you need to call APIKey.Unwrap() before calling this method if this APIKey
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -297,6 +326,9 @@ func (_m *APIKey) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("allowed_ips=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AllowedIps))
+	builder.WriteString(", ")
+	builder.WriteString("token_revocations=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TokenRevocations))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -460,6 +460,16 @@ func AllowedIpsNotNil() predicate.APIKey {
 	return predicate.APIKey(sql.FieldNotNull(FieldAllowedIps))
 }
 
+// TokenRevocationsIsNil applies the IsNil predicate on the "token_revocations" field.
+func TokenRevocationsIsNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldIsNull(FieldTokenRevocations))
+}
+
+// TokenRevocationsNotNil applies the NotNil predicate on the "token_revocations" field.
+func TokenRevocationsNotNil() predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotNull(FieldTokenRevocations))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.APIKey {
 	return predicate.APIKey(func(s *sql.Selector) {
@@ -529,6 +539,29 @@ func HasRequestsWith(preds ...predicate.Request) predicate.APIKey {
 	})
 }
 
+// HasWallet applies the HasEdge predicate on the "wallet" edge.
+func HasWallet() predicate.APIKey {
+	return predicate.APIKey(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, WalletTable, WalletColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWalletWith applies the HasEdge predicate on the "wallet" edge with a given conditions (other predicates).
+func HasWalletWith(preds ...predicate.Wallet) predicate.APIKey {
+	return predicate.APIKey(func(s *sql.Selector) {
+		step := newWalletStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.APIKey) predicate.APIKey {
 	return predicate.APIKey(sql.AndPredicates(predicates...))
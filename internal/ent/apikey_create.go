@@ -15,6 +15,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent/project"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/wallet"
 	"github.com/looplj/axonhub/internal/objects"
 )
 
@@ -154,6 +155,12 @@ func (_c *APIKeyCreate) SetAllowedIps(v []string) *APIKeyCreate {
 	return _c
 }
 
+// SetTokenRevocations sets the "token_revocations" field.
+func (_c *APIKeyCreate) SetTokenRevocations(v *objects.APIKeyTokenRevocations) *APIKeyCreate {
+	_c.mutation.SetTokenRevocations(v)
+	return _c
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_c *APIKeyCreate) SetUser(v *User) *APIKeyCreate {
 	return _c.SetUserID(v.ID)
@@ -179,6 +186,25 @@ func (_c *APIKeyCreate) AddRequests(v ...*Request) *APIKeyCreate {
 	return _c.AddRequestIDs(ids...)
 }
 
+// SetWalletID sets the "wallet" edge to the Wallet entity by ID.
+func (_c *APIKeyCreate) SetWalletID(id int) *APIKeyCreate {
+	_c.mutation.SetWalletID(id)
+	return _c
+}
+
+// SetNillableWalletID sets the "wallet" edge to the Wallet entity by ID if the given value is not nil.
+func (_c *APIKeyCreate) SetNillableWalletID(id *int) *APIKeyCreate {
+	if id != nil {
+		_c = _c.SetWalletID(*id)
+	}
+	return _c
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (_c *APIKeyCreate) SetWallet(v *Wallet) *APIKeyCreate {
+	return _c.SetWalletID(v.ID)
+}
+
 // Mutation returns the APIKeyMutation object of the builder.
 func (_c *APIKeyCreate) Mutation() *APIKeyMutation {
 	return _c.mutation
@@ -361,6 +387,10 @@ func (_c *APIKeyCreate) createSpec() (*APIKey, *sqlgraph.CreateSpec) {
 		_spec.SetField(apikey.FieldAllowedIps, field.TypeJSON, value)
 		_node.AllowedIps = value
 	}
+	if value, ok := _c.mutation.TokenRevocations(); ok {
+		_spec.SetField(apikey.FieldTokenRevocations, field.TypeJSON, value)
+		_node.TokenRevocations = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -411,6 +441,22 @@ func (_c *APIKeyCreate) createSpec() (*APIKey, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   apikey.WalletTable,
+			Columns: []string{apikey.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -595,6 +641,24 @@ func (u *APIKeyUpsert) ClearAllowedIps() *APIKeyUpsert {
 	return u
 }
 
+// SetTokenRevocations sets the "token_revocations" field.
+func (u *APIKeyUpsert) SetTokenRevocations(v *objects.APIKeyTokenRevocations) *APIKeyUpsert {
+	u.Set(apikey.FieldTokenRevocations, v)
+	return u
+}
+
+// UpdateTokenRevocations sets the "token_revocations" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdateTokenRevocations() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldTokenRevocations)
+	return u
+}
+
+// ClearTokenRevocations clears the value of the "token_revocations" field.
+func (u *APIKeyUpsert) ClearTokenRevocations() *APIKeyUpsert {
+	u.SetNull(apikey.FieldTokenRevocations)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -800,6 +864,27 @@ func (u *APIKeyUpsertOne) ClearAllowedIps() *APIKeyUpsertOne {
 	})
 }
 
+// SetTokenRevocations sets the "token_revocations" field.
+func (u *APIKeyUpsertOne) SetTokenRevocations(v *objects.APIKeyTokenRevocations) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetTokenRevocations(v)
+	})
+}
+
+// UpdateTokenRevocations sets the "token_revocations" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdateTokenRevocations() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateTokenRevocations()
+	})
+}
+
+// ClearTokenRevocations clears the value of the "token_revocations" field.
+func (u *APIKeyUpsertOne) ClearTokenRevocations() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearTokenRevocations()
+	})
+}
+
 // Exec executes the query.
 func (u *APIKeyUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1171,6 +1256,27 @@ func (u *APIKeyUpsertBulk) ClearAllowedIps() *APIKeyUpsertBulk {
 	})
 }
 
+// SetTokenRevocations sets the "token_revocations" field.
+func (u *APIKeyUpsertBulk) SetTokenRevocations(v *objects.APIKeyTokenRevocations) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetTokenRevocations(v)
+	})
+}
+
+// UpdateTokenRevocations sets the "token_revocations" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdateTokenRevocations() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdateTokenRevocations()
+	})
+}
+
+// ClearTokenRevocations clears the value of the "token_revocations" field.
+func (u *APIKeyUpsertBulk) ClearTokenRevocations() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.ClearTokenRevocations()
+	})
+}
+
 // Exec executes the query.
 func (u *APIKeyUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/predicate"
 	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/ent/wallet"
 	"github.com/looplj/axonhub/internal/objects"
 )
 
@@ -163,6 +164,18 @@ func (_u *APIKeyUpdate) ClearAllowedIps() *APIKeyUpdate {
 	return _u
 }
 
+// SetTokenRevocations sets the "token_revocations" field.
+func (_u *APIKeyUpdate) SetTokenRevocations(v *objects.APIKeyTokenRevocations) *APIKeyUpdate {
+	_u.mutation.SetTokenRevocations(v)
+	return _u
+}
+
+// ClearTokenRevocations clears the value of the "token_revocations" field.
+func (_u *APIKeyUpdate) ClearTokenRevocations() *APIKeyUpdate {
+	_u.mutation.ClearTokenRevocations()
+	return _u
+}
+
 // AddRequestIDs adds the "requests" edge to the Request entity by IDs.
 func (_u *APIKeyUpdate) AddRequestIDs(ids ...int) *APIKeyUpdate {
 	_u.mutation.AddRequestIDs(ids...)
@@ -178,6 +191,25 @@ func (_u *APIKeyUpdate) AddRequests(v ...*Request) *APIKeyUpdate {
 	return _u.AddRequestIDs(ids...)
 }
 
+// SetWalletID sets the "wallet" edge to the Wallet entity by ID.
+func (_u *APIKeyUpdate) SetWalletID(id int) *APIKeyUpdate {
+	_u.mutation.SetWalletID(id)
+	return _u
+}
+
+// SetNillableWalletID sets the "wallet" edge to the Wallet entity by ID if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillableWalletID(id *int) *APIKeyUpdate {
+	if id != nil {
+		_u = _u.SetWalletID(*id)
+	}
+	return _u
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (_u *APIKeyUpdate) SetWallet(v *Wallet) *APIKeyUpdate {
+	return _u.SetWalletID(v.ID)
+}
+
 // Mutation returns the APIKeyMutation object of the builder.
 func (_u *APIKeyUpdate) Mutation() *APIKeyMutation {
 	return _u.mutation
@@ -204,6 +236,12 @@ func (_u *APIKeyUpdate) RemoveRequests(v ...*Request) *APIKeyUpdate {
 	return _u.RemoveRequestIDs(ids...)
 }
 
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (_u *APIKeyUpdate) ClearWallet() *APIKeyUpdate {
+	_u.mutation.ClearWallet()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *APIKeyUpdate) Save(ctx context.Context) (int, error) {
 	if err := _u.defaults(); err != nil {
@@ -331,6 +369,12 @@ func (_u *APIKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.AllowedIpsCleared() {
 		_spec.ClearField(apikey.FieldAllowedIps, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.TokenRevocations(); ok {
+		_spec.SetField(apikey.FieldTokenRevocations, field.TypeJSON, value)
+	}
+	if _u.mutation.TokenRevocationsCleared() {
+		_spec.ClearField(apikey.FieldTokenRevocations, field.TypeJSON)
+	}
 	if _u.mutation.RequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -376,6 +420,35 @@ func (_u *APIKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   apikey.WalletTable,
+			Columns: []string{apikey.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   apikey.WalletTable,
+			Columns: []string{apikey.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -529,6 +602,18 @@ func (_u *APIKeyUpdateOne) ClearAllowedIps() *APIKeyUpdateOne {
 	return _u
 }
 
+// SetTokenRevocations sets the "token_revocations" field.
+func (_u *APIKeyUpdateOne) SetTokenRevocations(v *objects.APIKeyTokenRevocations) *APIKeyUpdateOne {
+	_u.mutation.SetTokenRevocations(v)
+	return _u
+}
+
+// ClearTokenRevocations clears the value of the "token_revocations" field.
+func (_u *APIKeyUpdateOne) ClearTokenRevocations() *APIKeyUpdateOne {
+	_u.mutation.ClearTokenRevocations()
+	return _u
+}
+
 // AddRequestIDs adds the "requests" edge to the Request entity by IDs.
 func (_u *APIKeyUpdateOne) AddRequestIDs(ids ...int) *APIKeyUpdateOne {
 	_u.mutation.AddRequestIDs(ids...)
@@ -544,6 +629,25 @@ func (_u *APIKeyUpdateOne) AddRequests(v ...*Request) *APIKeyUpdateOne {
 	return _u.AddRequestIDs(ids...)
 }
 
+// SetWalletID sets the "wallet" edge to the Wallet entity by ID.
+func (_u *APIKeyUpdateOne) SetWalletID(id int) *APIKeyUpdateOne {
+	_u.mutation.SetWalletID(id)
+	return _u
+}
+
+// SetNillableWalletID sets the "wallet" edge to the Wallet entity by ID if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillableWalletID(id *int) *APIKeyUpdateOne {
+	if id != nil {
+		_u = _u.SetWalletID(*id)
+	}
+	return _u
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (_u *APIKeyUpdateOne) SetWallet(v *Wallet) *APIKeyUpdateOne {
+	return _u.SetWalletID(v.ID)
+}
+
 // Mutation returns the APIKeyMutation object of the builder.
 func (_u *APIKeyUpdateOne) Mutation() *APIKeyMutation {
 	return _u.mutation
@@ -570,6 +674,12 @@ func (_u *APIKeyUpdateOne) RemoveRequests(v ...*Request) *APIKeyUpdateOne {
 	return _u.RemoveRequestIDs(ids...)
 }
 
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (_u *APIKeyUpdateOne) ClearWallet() *APIKeyUpdateOne {
+	_u.mutation.ClearWallet()
+	return _u
+}
+
 // Where appends a list predicates to the APIKeyUpdate builder.
 func (_u *APIKeyUpdateOne) Where(ps ...predicate.APIKey) *APIKeyUpdateOne {
 	_u.mutation.Where(ps...)
@@ -727,6 +837,12 @@ func (_u *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err erro
 	if _u.mutation.AllowedIpsCleared() {
 		_spec.ClearField(apikey.FieldAllowedIps, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.TokenRevocations(); ok {
+		_spec.SetField(apikey.FieldTokenRevocations, field.TypeJSON, value)
+	}
+	if _u.mutation.TokenRevocationsCleared() {
+		_spec.ClearField(apikey.FieldTokenRevocations, field.TypeJSON)
+	}
 	if _u.mutation.RequestsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -772,6 +888,35 @@ func (_u *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   apikey.WalletTable,
+			Columns: []string{apikey.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   apikey.WalletTable,
+			Columns: []string{apikey.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &APIKey{config: _u.config}
 	_spec.Assign = _node.assignValues
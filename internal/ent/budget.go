@@ -0,0 +1,308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/looplj/axonhub/internal/ent/budget"
+	"github.com/looplj/axonhub/internal/ent/project"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// Budget is the model entity for the Budget schema.
+type Budget struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt int `json:"deleted_at,omitempty"`
+	// Project ID that this budget belongs to
+	ProjectID int `json:"project_id,omitempty"`
+	// Budget name
+	Name string `json:"name,omitempty"`
+	// Budget description
+	Description string `json:"description,omitempty"`
+	// What the spend is counted for: the whole project, one API key, or the API keys of one user in the project
+	Scope budget.Scope `json:"scope,omitempty"`
+	// API key ID of an api_key budget
+	APIKeyID *int `json:"api_key_id,omitempty"`
+	// User ID of a user budget
+	UserID *int `json:"user_id,omitempty"`
+	// Budget amount in the system currency
+	Amount float64 `json:"amount,omitempty"`
+	// Budget period, in the system timezone
+	Period budget.Period `json:"period,omitempty"`
+	// Status holds the value of the "status" field.
+	Status budget.Status `json:"status,omitempty"`
+	// Alert thresholds and enforcement action
+	Settings objects.BudgetSettings `json:"settings,omitempty"`
+	// The highest threshold alerted in the alerted period
+	AlertedThreshold int `json:"alerted_threshold,omitempty"`
+	// Start of the period the alerted threshold belongs to
+	AlertedPeriodStart *time.Time `json:"alerted_period_start,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BudgetQuery when eager-loading is set.
+	Edges        BudgetEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BudgetEdges holds the relations/edges for other nodes in the graph.
+type BudgetEdges struct {
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BudgetEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Budget) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case budget.FieldSettings:
+			values[i] = new([]byte)
+		case budget.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case budget.FieldID, budget.FieldDeletedAt, budget.FieldProjectID, budget.FieldAPIKeyID, budget.FieldUserID, budget.FieldAlertedThreshold:
+			values[i] = new(sql.NullInt64)
+		case budget.FieldName, budget.FieldDescription, budget.FieldScope, budget.FieldPeriod, budget.FieldStatus:
+			values[i] = new(sql.NullString)
+		case budget.FieldCreatedAt, budget.FieldUpdatedAt, budget.FieldAlertedPeriodStart:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Budget fields.
+func (_m *Budget) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case budget.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case budget.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case budget.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case budget.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = int(value.Int64)
+			}
+		case budget.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				_m.ProjectID = int(value.Int64)
+			}
+		case budget.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case budget.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case budget.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				_m.Scope = budget.Scope(value.String)
+			}
+		case budget.FieldAPIKeyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field api_key_id", values[i])
+			} else if value.Valid {
+				_m.APIKeyID = new(int)
+				*_m.APIKeyID = int(value.Int64)
+			}
+		case budget.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(int)
+				*_m.UserID = int(value.Int64)
+			}
+		case budget.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = value.Float64
+			}
+		case budget.FieldPeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field period", values[i])
+			} else if value.Valid {
+				_m.Period = budget.Period(value.String)
+			}
+		case budget.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = budget.Status(value.String)
+			}
+		case budget.FieldSettings:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field settings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Settings); err != nil {
+					return fmt.Errorf("unmarshal field settings: %w", err)
+				}
+			}
+		case budget.FieldAlertedThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field alerted_threshold", values[i])
+			} else if value.Valid {
+				_m.AlertedThreshold = int(value.Int64)
+			}
+		case budget.FieldAlertedPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field alerted_period_start", values[i])
+			} else if value.Valid {
+				_m.AlertedPeriodStart = new(time.Time)
+				*_m.AlertedPeriodStart = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Budget.
+// This includes values selected through modifiers, order, etc.
+func (_m *Budget) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the Budget entity.
+func (_m *Budget) QueryProject() *ProjectQuery {
+	return NewBudgetClient(_m.config).QueryProject(_m)
+}
+
+// Update returns a builder for updating this Budget.
+// Note that you need to call Budget.Unwrap() before calling this method if this Budget
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Budget) Update() *BudgetUpdateOne {
+	return NewBudgetClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Budget entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Budget) Unwrap() *Budget {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Budget is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Budget) String() string {
+	var builder strings.Builder
+	builder.WriteString("Budget(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedAt))
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Scope))
+	builder.WriteString(", ")
+	if v := _m.APIKeyID; v != nil {
+		builder.WriteString("api_key_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("period=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Period))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("settings=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Settings))
+	builder.WriteString(", ")
+	builder.WriteString("alerted_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AlertedThreshold))
+	builder.WriteString(", ")
+	if v := _m.AlertedPeriodStart; v != nil {
+		builder.WriteString("alerted_period_start=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Budgets is a parsable slice of Budget.
+type Budgets []*Budget
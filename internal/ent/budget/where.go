@@ -0,0 +1,709 @@
+// Code generated by ent, DO NOT EDIT.
+
+package budget
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldProjectID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldDescription, v))
+}
+
+// APIKeyID applies equality check predicate on the "api_key_id" field. It's identical to APIKeyIDEQ.
+func APIKeyID(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldAPIKeyID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldUserID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldAmount, v))
+}
+
+// AlertedThreshold applies equality check predicate on the "alerted_threshold" field. It's identical to AlertedThresholdEQ.
+func AlertedThreshold(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldAlertedThreshold, v))
+}
+
+// AlertedPeriodStart applies equality check predicate on the "alerted_period_start" field. It's identical to AlertedPeriodStartEQ.
+func AlertedPeriodStart(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldAlertedPeriodStart, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...int) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...int) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...int) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...int) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Budget {
+	return predicate.Budget(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v Scope) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v Scope) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...Scope) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...Scope) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// APIKeyIDEQ applies the EQ predicate on the "api_key_id" field.
+func APIKeyIDEQ(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldAPIKeyID, v))
+}
+
+// APIKeyIDNEQ applies the NEQ predicate on the "api_key_id" field.
+func APIKeyIDNEQ(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldAPIKeyID, v))
+}
+
+// APIKeyIDIn applies the In predicate on the "api_key_id" field.
+func APIKeyIDIn(vs ...int) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldAPIKeyID, vs...))
+}
+
+// APIKeyIDNotIn applies the NotIn predicate on the "api_key_id" field.
+func APIKeyIDNotIn(vs ...int) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldAPIKeyID, vs...))
+}
+
+// APIKeyIDGT applies the GT predicate on the "api_key_id" field.
+func APIKeyIDGT(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldAPIKeyID, v))
+}
+
+// APIKeyIDGTE applies the GTE predicate on the "api_key_id" field.
+func APIKeyIDGTE(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldAPIKeyID, v))
+}
+
+// APIKeyIDLT applies the LT predicate on the "api_key_id" field.
+func APIKeyIDLT(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldAPIKeyID, v))
+}
+
+// APIKeyIDLTE applies the LTE predicate on the "api_key_id" field.
+func APIKeyIDLTE(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldAPIKeyID, v))
+}
+
+// APIKeyIDIsNil applies the IsNil predicate on the "api_key_id" field.
+func APIKeyIDIsNil() predicate.Budget {
+	return predicate.Budget(sql.FieldIsNull(FieldAPIKeyID))
+}
+
+// APIKeyIDNotNil applies the NotNil predicate on the "api_key_id" field.
+func APIKeyIDNotNil() predicate.Budget {
+	return predicate.Budget(sql.FieldNotNull(FieldAPIKeyID))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.Budget {
+	return predicate.Budget(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.Budget {
+	return predicate.Budget(sql.FieldNotNull(FieldUserID))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldAmount, v))
+}
+
+// PeriodEQ applies the EQ predicate on the "period" field.
+func PeriodEQ(v Period) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldPeriod, v))
+}
+
+// PeriodNEQ applies the NEQ predicate on the "period" field.
+func PeriodNEQ(v Period) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldPeriod, v))
+}
+
+// PeriodIn applies the In predicate on the "period" field.
+func PeriodIn(vs ...Period) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldPeriod, vs...))
+}
+
+// PeriodNotIn applies the NotIn predicate on the "period" field.
+func PeriodNotIn(vs ...Period) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldPeriod, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AlertedThresholdEQ applies the EQ predicate on the "alerted_threshold" field.
+func AlertedThresholdEQ(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldAlertedThreshold, v))
+}
+
+// AlertedThresholdNEQ applies the NEQ predicate on the "alerted_threshold" field.
+func AlertedThresholdNEQ(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldAlertedThreshold, v))
+}
+
+// AlertedThresholdIn applies the In predicate on the "alerted_threshold" field.
+func AlertedThresholdIn(vs ...int) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldAlertedThreshold, vs...))
+}
+
+// AlertedThresholdNotIn applies the NotIn predicate on the "alerted_threshold" field.
+func AlertedThresholdNotIn(vs ...int) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldAlertedThreshold, vs...))
+}
+
+// AlertedThresholdGT applies the GT predicate on the "alerted_threshold" field.
+func AlertedThresholdGT(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldAlertedThreshold, v))
+}
+
+// AlertedThresholdGTE applies the GTE predicate on the "alerted_threshold" field.
+func AlertedThresholdGTE(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldAlertedThreshold, v))
+}
+
+// AlertedThresholdLT applies the LT predicate on the "alerted_threshold" field.
+func AlertedThresholdLT(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldAlertedThreshold, v))
+}
+
+// AlertedThresholdLTE applies the LTE predicate on the "alerted_threshold" field.
+func AlertedThresholdLTE(v int) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldAlertedThreshold, v))
+}
+
+// AlertedPeriodStartEQ applies the EQ predicate on the "alerted_period_start" field.
+func AlertedPeriodStartEQ(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldEQ(FieldAlertedPeriodStart, v))
+}
+
+// AlertedPeriodStartNEQ applies the NEQ predicate on the "alerted_period_start" field.
+func AlertedPeriodStartNEQ(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldNEQ(FieldAlertedPeriodStart, v))
+}
+
+// AlertedPeriodStartIn applies the In predicate on the "alerted_period_start" field.
+func AlertedPeriodStartIn(vs ...time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldIn(FieldAlertedPeriodStart, vs...))
+}
+
+// AlertedPeriodStartNotIn applies the NotIn predicate on the "alerted_period_start" field.
+func AlertedPeriodStartNotIn(vs ...time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldNotIn(FieldAlertedPeriodStart, vs...))
+}
+
+// AlertedPeriodStartGT applies the GT predicate on the "alerted_period_start" field.
+func AlertedPeriodStartGT(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldGT(FieldAlertedPeriodStart, v))
+}
+
+// AlertedPeriodStartGTE applies the GTE predicate on the "alerted_period_start" field.
+func AlertedPeriodStartGTE(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldGTE(FieldAlertedPeriodStart, v))
+}
+
+// AlertedPeriodStartLT applies the LT predicate on the "alerted_period_start" field.
+func AlertedPeriodStartLT(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldLT(FieldAlertedPeriodStart, v))
+}
+
+// AlertedPeriodStartLTE applies the LTE predicate on the "alerted_period_start" field.
+func AlertedPeriodStartLTE(v time.Time) predicate.Budget {
+	return predicate.Budget(sql.FieldLTE(FieldAlertedPeriodStart, v))
+}
+
+// AlertedPeriodStartIsNil applies the IsNil predicate on the "alerted_period_start" field.
+func AlertedPeriodStartIsNil() predicate.Budget {
+	return predicate.Budget(sql.FieldIsNull(FieldAlertedPeriodStart))
+}
+
+// AlertedPeriodStartNotNil applies the NotNil predicate on the "alerted_period_start" field.
+func AlertedPeriodStartNotNil() predicate.Budget {
+	return predicate.Budget(sql.FieldNotNull(FieldAlertedPeriodStart))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.Budget {
+	return predicate.Budget(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.Budget {
+	return predicate.Budget(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Budget) predicate.Budget {
+	return predicate.Budget(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Budget) predicate.Budget {
+	return predicate.Budget(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Budget) predicate.Budget {
+	return predicate.Budget(sql.NotPredicates(p))
+}
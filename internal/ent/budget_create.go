@@ -0,0 +1,1465 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/budget"
+	"github.com/looplj/axonhub/internal/ent/project"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// BudgetCreate is the builder for creating a Budget entity.
+type BudgetCreate struct {
+	config
+	mutation *BudgetMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BudgetCreate) SetCreatedAt(v time.Time) *BudgetCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableCreatedAt(v *time.Time) *BudgetCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BudgetCreate) SetUpdatedAt(v time.Time) *BudgetCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableUpdatedAt(v *time.Time) *BudgetCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *BudgetCreate) SetDeletedAt(v int) *BudgetCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableDeletedAt(v *int) *BudgetCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetProjectID sets the "project_id" field.
+func (_c *BudgetCreate) SetProjectID(v int) *BudgetCreate {
+	_c.mutation.SetProjectID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BudgetCreate) SetName(v string) *BudgetCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BudgetCreate) SetDescription(v string) *BudgetCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableDescription(v *string) *BudgetCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetScope sets the "scope" field.
+func (_c *BudgetCreate) SetScope(v budget.Scope) *BudgetCreate {
+	_c.mutation.SetScope(v)
+	return _c
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableScope(v *budget.Scope) *BudgetCreate {
+	if v != nil {
+		_c.SetScope(*v)
+	}
+	return _c
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_c *BudgetCreate) SetAPIKeyID(v int) *BudgetCreate {
+	_c.mutation.SetAPIKeyID(v)
+	return _c
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableAPIKeyID(v *int) *BudgetCreate {
+	if v != nil {
+		_c.SetAPIKeyID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BudgetCreate) SetUserID(v int) *BudgetCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableUserID(v *int) *BudgetCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *BudgetCreate) SetAmount(v float64) *BudgetCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetPeriod sets the "period" field.
+func (_c *BudgetCreate) SetPeriod(v budget.Period) *BudgetCreate {
+	_c.mutation.SetPeriod(v)
+	return _c
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillablePeriod(v *budget.Period) *BudgetCreate {
+	if v != nil {
+		_c.SetPeriod(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *BudgetCreate) SetStatus(v budget.Status) *BudgetCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableStatus(v *budget.Status) *BudgetCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetSettings sets the "settings" field.
+func (_c *BudgetCreate) SetSettings(v objects.BudgetSettings) *BudgetCreate {
+	_c.mutation.SetSettings(v)
+	return _c
+}
+
+// SetNillableSettings sets the "settings" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableSettings(v *objects.BudgetSettings) *BudgetCreate {
+	if v != nil {
+		_c.SetSettings(*v)
+	}
+	return _c
+}
+
+// SetAlertedThreshold sets the "alerted_threshold" field.
+func (_c *BudgetCreate) SetAlertedThreshold(v int) *BudgetCreate {
+	_c.mutation.SetAlertedThreshold(v)
+	return _c
+}
+
+// SetNillableAlertedThreshold sets the "alerted_threshold" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableAlertedThreshold(v *int) *BudgetCreate {
+	if v != nil {
+		_c.SetAlertedThreshold(*v)
+	}
+	return _c
+}
+
+// SetAlertedPeriodStart sets the "alerted_period_start" field.
+func (_c *BudgetCreate) SetAlertedPeriodStart(v time.Time) *BudgetCreate {
+	_c.mutation.SetAlertedPeriodStart(v)
+	return _c
+}
+
+// SetNillableAlertedPeriodStart sets the "alerted_period_start" field if the given value is not nil.
+func (_c *BudgetCreate) SetNillableAlertedPeriodStart(v *time.Time) *BudgetCreate {
+	if v != nil {
+		_c.SetAlertedPeriodStart(*v)
+	}
+	return _c
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_c *BudgetCreate) SetProject(v *Project) *BudgetCreate {
+	return _c.SetProjectID(v.ID)
+}
+
+// Mutation returns the BudgetMutation object of the builder.
+func (_c *BudgetCreate) Mutation() *BudgetMutation {
+	return _c.mutation
+}
+
+// Save creates the Budget in the database.
+func (_c *BudgetCreate) Save(ctx context.Context) (*Budget, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BudgetCreate) SaveX(ctx context.Context) *Budget {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BudgetCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BudgetCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BudgetCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if budget.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized budget.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := budget.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if budget.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized budget.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := budget.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		v := budget.DefaultDeletedAt
+		_c.mutation.SetDeletedAt(v)
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		v := budget.DefaultDescription
+		_c.mutation.SetDescription(v)
+	}
+	if _, ok := _c.mutation.Scope(); !ok {
+		v := budget.DefaultScope
+		_c.mutation.SetScope(v)
+	}
+	if _, ok := _c.mutation.Period(); !ok {
+		v := budget.DefaultPeriod
+		_c.mutation.SetPeriod(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := budget.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Settings(); !ok {
+		v := budget.DefaultSettings
+		_c.mutation.SetSettings(v)
+	}
+	if _, ok := _c.mutation.AlertedThreshold(); !ok {
+		v := budget.DefaultAlertedThreshold
+		_c.mutation.SetAlertedThreshold(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BudgetCreate) check() error {
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "Budget.deleted_at"`)}
+	}
+	if _, ok := _c.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "Budget.project_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Budget.name"`)}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Budget.description"`)}
+	}
+	if _, ok := _c.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "Budget.scope"`)}
+	}
+	if v, ok := _c.mutation.Scope(); ok {
+		if err := budget.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "Budget.scope": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Budget.amount"`)}
+	}
+	if _, ok := _c.mutation.Period(); !ok {
+		return &ValidationError{Name: "period", err: errors.New(`ent: missing required field "Budget.period"`)}
+	}
+	if v, ok := _c.mutation.Period(); ok {
+		if err := budget.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`ent: validator failed for field "Budget.period": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Budget.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := budget.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Budget.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Settings(); !ok {
+		return &ValidationError{Name: "settings", err: errors.New(`ent: missing required field "Budget.settings"`)}
+	}
+	if _, ok := _c.mutation.AlertedThreshold(); !ok {
+		return &ValidationError{Name: "alerted_threshold", err: errors.New(`ent: missing required field "Budget.alerted_threshold"`)}
+	}
+	if len(_c.mutation.ProjectIDs()) == 0 {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "Budget.project"`)}
+	}
+	return nil
+}
+
+func (_c *BudgetCreate) sqlSave(ctx context.Context) (*Budget, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BudgetCreate) createSpec() (*Budget, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Budget{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(budget.Table, sqlgraph.NewFieldSpec(budget.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(budget.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(budget.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(budget.FieldDeletedAt, field.TypeInt, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(budget.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(budget.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Scope(); ok {
+		_spec.SetField(budget.FieldScope, field.TypeEnum, value)
+		_node.Scope = value
+	}
+	if value, ok := _c.mutation.APIKeyID(); ok {
+		_spec.SetField(budget.FieldAPIKeyID, field.TypeInt, value)
+		_node.APIKeyID = &value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(budget.FieldUserID, field.TypeInt, value)
+		_node.UserID = &value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(budget.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Period(); ok {
+		_spec.SetField(budget.FieldPeriod, field.TypeEnum, value)
+		_node.Period = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(budget.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Settings(); ok {
+		_spec.SetField(budget.FieldSettings, field.TypeJSON, value)
+		_node.Settings = value
+	}
+	if value, ok := _c.mutation.AlertedThreshold(); ok {
+		_spec.SetField(budget.FieldAlertedThreshold, field.TypeInt, value)
+		_node.AlertedThreshold = value
+	}
+	if value, ok := _c.mutation.AlertedPeriodStart(); ok {
+		_spec.SetField(budget.FieldAlertedPeriodStart, field.TypeTime, value)
+		_node.AlertedPeriodStart = &value
+	}
+	if nodes := _c.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   budget.ProjectTable,
+			Columns: []string{budget.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Budget.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BudgetCreate) OnConflict(opts ...sql.ConflictOption) *BudgetUpsertOne {
+	_c.conflict = opts
+	return &BudgetUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BudgetCreate) OnConflictColumns(columns ...string) *BudgetUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BudgetUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BudgetUpsertOne is the builder for "upsert"-ing
+	//  one Budget node.
+	BudgetUpsertOne struct {
+		create *BudgetCreate
+	}
+
+	// BudgetUpsert is the "OnConflict" setter.
+	BudgetUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BudgetUpsert) SetUpdatedAt(v time.Time) *BudgetUpsert {
+	u.Set(budget.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateUpdatedAt() *BudgetUpsert {
+	u.SetExcluded(budget.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *BudgetUpsert) SetDeletedAt(v int) *BudgetUpsert {
+	u.Set(budget.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateDeletedAt() *BudgetUpsert {
+	u.SetExcluded(budget.FieldDeletedAt)
+	return u
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *BudgetUpsert) AddDeletedAt(v int) *BudgetUpsert {
+	u.Add(budget.FieldDeletedAt, v)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *BudgetUpsert) SetName(v string) *BudgetUpsert {
+	u.Set(budget.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateName() *BudgetUpsert {
+	u.SetExcluded(budget.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *BudgetUpsert) SetDescription(v string) *BudgetUpsert {
+	u.Set(budget.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateDescription() *BudgetUpsert {
+	u.SetExcluded(budget.FieldDescription)
+	return u
+}
+
+// SetScope sets the "scope" field.
+func (u *BudgetUpsert) SetScope(v budget.Scope) *BudgetUpsert {
+	u.Set(budget.FieldScope, v)
+	return u
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateScope() *BudgetUpsert {
+	u.SetExcluded(budget.FieldScope)
+	return u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (u *BudgetUpsert) SetAPIKeyID(v int) *BudgetUpsert {
+	u.Set(budget.FieldAPIKeyID, v)
+	return u
+}
+
+// UpdateAPIKeyID sets the "api_key_id" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateAPIKeyID() *BudgetUpsert {
+	u.SetExcluded(budget.FieldAPIKeyID)
+	return u
+}
+
+// AddAPIKeyID adds v to the "api_key_id" field.
+func (u *BudgetUpsert) AddAPIKeyID(v int) *BudgetUpsert {
+	u.Add(budget.FieldAPIKeyID, v)
+	return u
+}
+
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (u *BudgetUpsert) ClearAPIKeyID() *BudgetUpsert {
+	u.SetNull(budget.FieldAPIKeyID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *BudgetUpsert) SetUserID(v int) *BudgetUpsert {
+	u.Set(budget.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateUserID() *BudgetUpsert {
+	u.SetExcluded(budget.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *BudgetUpsert) AddUserID(v int) *BudgetUpsert {
+	u.Add(budget.FieldUserID, v)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *BudgetUpsert) ClearUserID() *BudgetUpsert {
+	u.SetNull(budget.FieldUserID)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *BudgetUpsert) SetAmount(v float64) *BudgetUpsert {
+	u.Set(budget.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateAmount() *BudgetUpsert {
+	u.SetExcluded(budget.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BudgetUpsert) AddAmount(v float64) *BudgetUpsert {
+	u.Add(budget.FieldAmount, v)
+	return u
+}
+
+// SetPeriod sets the "period" field.
+func (u *BudgetUpsert) SetPeriod(v budget.Period) *BudgetUpsert {
+	u.Set(budget.FieldPeriod, v)
+	return u
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdatePeriod() *BudgetUpsert {
+	u.SetExcluded(budget.FieldPeriod)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *BudgetUpsert) SetStatus(v budget.Status) *BudgetUpsert {
+	u.Set(budget.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateStatus() *BudgetUpsert {
+	u.SetExcluded(budget.FieldStatus)
+	return u
+}
+
+// SetSettings sets the "settings" field.
+func (u *BudgetUpsert) SetSettings(v objects.BudgetSettings) *BudgetUpsert {
+	u.Set(budget.FieldSettings, v)
+	return u
+}
+
+// UpdateSettings sets the "settings" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateSettings() *BudgetUpsert {
+	u.SetExcluded(budget.FieldSettings)
+	return u
+}
+
+// SetAlertedThreshold sets the "alerted_threshold" field.
+func (u *BudgetUpsert) SetAlertedThreshold(v int) *BudgetUpsert {
+	u.Set(budget.FieldAlertedThreshold, v)
+	return u
+}
+
+// UpdateAlertedThreshold sets the "alerted_threshold" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateAlertedThreshold() *BudgetUpsert {
+	u.SetExcluded(budget.FieldAlertedThreshold)
+	return u
+}
+
+// AddAlertedThreshold adds v to the "alerted_threshold" field.
+func (u *BudgetUpsert) AddAlertedThreshold(v int) *BudgetUpsert {
+	u.Add(budget.FieldAlertedThreshold, v)
+	return u
+}
+
+// SetAlertedPeriodStart sets the "alerted_period_start" field.
+func (u *BudgetUpsert) SetAlertedPeriodStart(v time.Time) *BudgetUpsert {
+	u.Set(budget.FieldAlertedPeriodStart, v)
+	return u
+}
+
+// UpdateAlertedPeriodStart sets the "alerted_period_start" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateAlertedPeriodStart() *BudgetUpsert {
+	u.SetExcluded(budget.FieldAlertedPeriodStart)
+	return u
+}
+
+// ClearAlertedPeriodStart clears the value of the "alerted_period_start" field.
+func (u *BudgetUpsert) ClearAlertedPeriodStart() *BudgetUpsert {
+	u.SetNull(budget.FieldAlertedPeriodStart)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BudgetUpsertOne) UpdateNewValues() *BudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(budget.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(budget.FieldProjectID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BudgetUpsertOne) Ignore() *BudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetUpsertOne) DoNothing() *BudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetCreate.OnConflict
+// documentation for more info.
+func (u *BudgetUpsertOne) Update(set func(*BudgetUpsert)) *BudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BudgetUpsertOne) SetUpdatedAt(v time.Time) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateUpdatedAt() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *BudgetUpsertOne) SetDeletedAt(v int) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *BudgetUpsertOne) AddDeletedAt(v int) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateDeletedAt() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *BudgetUpsertOne) SetName(v string) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateName() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *BudgetUpsertOne) SetDescription(v string) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateDescription() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetScope sets the "scope" field.
+func (u *BudgetUpsertOne) SetScope(v budget.Scope) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetScope(v)
+	})
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateScope() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateScope()
+	})
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (u *BudgetUpsertOne) SetAPIKeyID(v int) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAPIKeyID(v)
+	})
+}
+
+// AddAPIKeyID adds v to the "api_key_id" field.
+func (u *BudgetUpsertOne) AddAPIKeyID(v int) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddAPIKeyID(v)
+	})
+}
+
+// UpdateAPIKeyID sets the "api_key_id" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateAPIKeyID() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAPIKeyID()
+	})
+}
+
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (u *BudgetUpsertOne) ClearAPIKeyID() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearAPIKeyID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *BudgetUpsertOne) SetUserID(v int) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *BudgetUpsertOne) AddUserID(v int) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateUserID() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *BudgetUpsertOne) ClearUserID() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BudgetUpsertOne) SetAmount(v float64) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BudgetUpsertOne) AddAmount(v float64) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateAmount() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetPeriod sets the "period" field.
+func (u *BudgetUpsertOne) SetPeriod(v budget.Period) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetPeriod(v)
+	})
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdatePeriod() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdatePeriod()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BudgetUpsertOne) SetStatus(v budget.Status) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateStatus() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetSettings sets the "settings" field.
+func (u *BudgetUpsertOne) SetSettings(v objects.BudgetSettings) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetSettings(v)
+	})
+}
+
+// UpdateSettings sets the "settings" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateSettings() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateSettings()
+	})
+}
+
+// SetAlertedThreshold sets the "alerted_threshold" field.
+func (u *BudgetUpsertOne) SetAlertedThreshold(v int) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAlertedThreshold(v)
+	})
+}
+
+// AddAlertedThreshold adds v to the "alerted_threshold" field.
+func (u *BudgetUpsertOne) AddAlertedThreshold(v int) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddAlertedThreshold(v)
+	})
+}
+
+// UpdateAlertedThreshold sets the "alerted_threshold" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateAlertedThreshold() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAlertedThreshold()
+	})
+}
+
+// SetAlertedPeriodStart sets the "alerted_period_start" field.
+func (u *BudgetUpsertOne) SetAlertedPeriodStart(v time.Time) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAlertedPeriodStart(v)
+	})
+}
+
+// UpdateAlertedPeriodStart sets the "alerted_period_start" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateAlertedPeriodStart() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAlertedPeriodStart()
+	})
+}
+
+// ClearAlertedPeriodStart clears the value of the "alerted_period_start" field.
+func (u *BudgetUpsertOne) ClearAlertedPeriodStart() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearAlertedPeriodStart()
+	})
+}
+
+// Exec executes the query.
+func (u *BudgetUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BudgetCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BudgetUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BudgetUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BudgetCreateBulk is the builder for creating many Budget entities in bulk.
+type BudgetCreateBulk struct {
+	config
+	err      error
+	builders []*BudgetCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Budget entities in the database.
+func (_c *BudgetCreateBulk) Save(ctx context.Context) ([]*Budget, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Budget, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BudgetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BudgetCreateBulk) SaveX(ctx context.Context) []*Budget {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BudgetCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BudgetCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Budget.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BudgetCreateBulk) OnConflict(opts ...sql.ConflictOption) *BudgetUpsertBulk {
+	_c.conflict = opts
+	return &BudgetUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BudgetCreateBulk) OnConflictColumns(columns ...string) *BudgetUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BudgetUpsertBulk{
+		create: _c,
+	}
+}
+
+// BudgetUpsertBulk is the builder for "upsert"-ing
+// a bulk of Budget nodes.
+type BudgetUpsertBulk struct {
+	create *BudgetCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BudgetUpsertBulk) UpdateNewValues() *BudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(budget.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(budget.FieldProjectID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BudgetUpsertBulk) Ignore() *BudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetUpsertBulk) DoNothing() *BudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetCreateBulk.OnConflict
+// documentation for more info.
+func (u *BudgetUpsertBulk) Update(set func(*BudgetUpsert)) *BudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BudgetUpsertBulk) SetUpdatedAt(v time.Time) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateUpdatedAt() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *BudgetUpsertBulk) SetDeletedAt(v int) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// AddDeletedAt adds v to the "deleted_at" field.
+func (u *BudgetUpsertBulk) AddDeletedAt(v int) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateDeletedAt() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *BudgetUpsertBulk) SetName(v string) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateName() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *BudgetUpsertBulk) SetDescription(v string) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateDescription() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetScope sets the "scope" field.
+func (u *BudgetUpsertBulk) SetScope(v budget.Scope) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetScope(v)
+	})
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateScope() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateScope()
+	})
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (u *BudgetUpsertBulk) SetAPIKeyID(v int) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAPIKeyID(v)
+	})
+}
+
+// AddAPIKeyID adds v to the "api_key_id" field.
+func (u *BudgetUpsertBulk) AddAPIKeyID(v int) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddAPIKeyID(v)
+	})
+}
+
+// UpdateAPIKeyID sets the "api_key_id" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateAPIKeyID() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAPIKeyID()
+	})
+}
+
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (u *BudgetUpsertBulk) ClearAPIKeyID() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearAPIKeyID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *BudgetUpsertBulk) SetUserID(v int) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *BudgetUpsertBulk) AddUserID(v int) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateUserID() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *BudgetUpsertBulk) ClearUserID() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BudgetUpsertBulk) SetAmount(v float64) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BudgetUpsertBulk) AddAmount(v float64) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateAmount() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetPeriod sets the "period" field.
+func (u *BudgetUpsertBulk) SetPeriod(v budget.Period) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetPeriod(v)
+	})
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdatePeriod() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdatePeriod()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BudgetUpsertBulk) SetStatus(v budget.Status) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateStatus() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetSettings sets the "settings" field.
+func (u *BudgetUpsertBulk) SetSettings(v objects.BudgetSettings) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetSettings(v)
+	})
+}
+
+// UpdateSettings sets the "settings" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateSettings() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateSettings()
+	})
+}
+
+// SetAlertedThreshold sets the "alerted_threshold" field.
+func (u *BudgetUpsertBulk) SetAlertedThreshold(v int) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAlertedThreshold(v)
+	})
+}
+
+// AddAlertedThreshold adds v to the "alerted_threshold" field.
+func (u *BudgetUpsertBulk) AddAlertedThreshold(v int) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddAlertedThreshold(v)
+	})
+}
+
+// UpdateAlertedThreshold sets the "alerted_threshold" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateAlertedThreshold() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAlertedThreshold()
+	})
+}
+
+// SetAlertedPeriodStart sets the "alerted_period_start" field.
+func (u *BudgetUpsertBulk) SetAlertedPeriodStart(v time.Time) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAlertedPeriodStart(v)
+	})
+}
+
+// UpdateAlertedPeriodStart sets the "alerted_period_start" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateAlertedPeriodStart() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAlertedPeriodStart()
+	})
+}
+
+// ClearAlertedPeriodStart clears the value of the "alerted_period_start" field.
+func (u *BudgetUpsertBulk) ClearAlertedPeriodStart() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearAlertedPeriodStart()
+	})
+}
+
+// Exec executes the query.
+func (u *BudgetUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BudgetCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BudgetCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,855 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/budget"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// BudgetUpdate is the builder for updating Budget entities.
+type BudgetUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BudgetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BudgetUpdate builder.
+func (_u *BudgetUpdate) Where(ps ...predicate.Budget) *BudgetUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BudgetUpdate) SetUpdatedAt(v time.Time) *BudgetUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *BudgetUpdate) SetDeletedAt(v int) *BudgetUpdate {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillableDeletedAt(v *int) *BudgetUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *BudgetUpdate) AddDeletedAt(v int) *BudgetUpdate {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BudgetUpdate) SetName(v string) *BudgetUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillableName(v *string) *BudgetUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BudgetUpdate) SetDescription(v string) *BudgetUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillableDescription(v *string) *BudgetUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *BudgetUpdate) SetScope(v budget.Scope) *BudgetUpdate {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillableScope(v *budget.Scope) *BudgetUpdate {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_u *BudgetUpdate) SetAPIKeyID(v int) *BudgetUpdate {
+	_u.mutation.ResetAPIKeyID()
+	_u.mutation.SetAPIKeyID(v)
+	return _u
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillableAPIKeyID(v *int) *BudgetUpdate {
+	if v != nil {
+		_u.SetAPIKeyID(*v)
+	}
+	return _u
+}
+
+// AddAPIKeyID adds value to the "api_key_id" field.
+func (_u *BudgetUpdate) AddAPIKeyID(v int) *BudgetUpdate {
+	_u.mutation.AddAPIKeyID(v)
+	return _u
+}
+
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (_u *BudgetUpdate) ClearAPIKeyID() *BudgetUpdate {
+	_u.mutation.ClearAPIKeyID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BudgetUpdate) SetUserID(v int) *BudgetUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillableUserID(v *int) *BudgetUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *BudgetUpdate) AddUserID(v int) *BudgetUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *BudgetUpdate) ClearUserID() *BudgetUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *BudgetUpdate) SetAmount(v float64) *BudgetUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillableAmount(v *float64) *BudgetUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *BudgetUpdate) AddAmount(v float64) *BudgetUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetPeriod sets the "period" field.
+func (_u *BudgetUpdate) SetPeriod(v budget.Period) *BudgetUpdate {
+	_u.mutation.SetPeriod(v)
+	return _u
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillablePeriod(v *budget.Period) *BudgetUpdate {
+	if v != nil {
+		_u.SetPeriod(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BudgetUpdate) SetStatus(v budget.Status) *BudgetUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillableStatus(v *budget.Status) *BudgetUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetSettings sets the "settings" field.
+func (_u *BudgetUpdate) SetSettings(v objects.BudgetSettings) *BudgetUpdate {
+	_u.mutation.SetSettings(v)
+	return _u
+}
+
+// SetNillableSettings sets the "settings" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillableSettings(v *objects.BudgetSettings) *BudgetUpdate {
+	if v != nil {
+		_u.SetSettings(*v)
+	}
+	return _u
+}
+
+// SetAlertedThreshold sets the "alerted_threshold" field.
+func (_u *BudgetUpdate) SetAlertedThreshold(v int) *BudgetUpdate {
+	_u.mutation.ResetAlertedThreshold()
+	_u.mutation.SetAlertedThreshold(v)
+	return _u
+}
+
+// SetNillableAlertedThreshold sets the "alerted_threshold" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillableAlertedThreshold(v *int) *BudgetUpdate {
+	if v != nil {
+		_u.SetAlertedThreshold(*v)
+	}
+	return _u
+}
+
+// AddAlertedThreshold adds value to the "alerted_threshold" field.
+func (_u *BudgetUpdate) AddAlertedThreshold(v int) *BudgetUpdate {
+	_u.mutation.AddAlertedThreshold(v)
+	return _u
+}
+
+// SetAlertedPeriodStart sets the "alerted_period_start" field.
+func (_u *BudgetUpdate) SetAlertedPeriodStart(v time.Time) *BudgetUpdate {
+	_u.mutation.SetAlertedPeriodStart(v)
+	return _u
+}
+
+// SetNillableAlertedPeriodStart sets the "alerted_period_start" field if the given value is not nil.
+func (_u *BudgetUpdate) SetNillableAlertedPeriodStart(v *time.Time) *BudgetUpdate {
+	if v != nil {
+		_u.SetAlertedPeriodStart(*v)
+	}
+	return _u
+}
+
+// ClearAlertedPeriodStart clears the value of the "alerted_period_start" field.
+func (_u *BudgetUpdate) ClearAlertedPeriodStart() *BudgetUpdate {
+	_u.mutation.ClearAlertedPeriodStart()
+	return _u
+}
+
+// Mutation returns the BudgetMutation object of the builder.
+func (_u *BudgetUpdate) Mutation() *BudgetMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BudgetUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BudgetUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BudgetUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BudgetUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BudgetUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if budget.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized budget.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := budget.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BudgetUpdate) check() error {
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := budget.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "Budget.scope": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Period(); ok {
+		if err := budget.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`ent: validator failed for field "Budget.period": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := budget.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Budget.status": %w`, err)}
+		}
+	}
+	if _u.mutation.ProjectCleared() && len(_u.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Budget.project"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BudgetUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BudgetUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BudgetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(budget.Table, budget.Columns, sqlgraph.NewFieldSpec(budget.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(budget.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(budget.FieldDeletedAt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(budget.FieldDeletedAt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(budget.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(budget.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(budget.FieldScope, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.APIKeyID(); ok {
+		_spec.SetField(budget.FieldAPIKeyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAPIKeyID(); ok {
+		_spec.AddField(budget.FieldAPIKeyID, field.TypeInt, value)
+	}
+	if _u.mutation.APIKeyIDCleared() {
+		_spec.ClearField(budget.FieldAPIKeyID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(budget.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(budget.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(budget.FieldUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(budget.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(budget.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Period(); ok {
+		_spec.SetField(budget.FieldPeriod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(budget.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Settings(); ok {
+		_spec.SetField(budget.FieldSettings, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AlertedThreshold(); ok {
+		_spec.SetField(budget.FieldAlertedThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAlertedThreshold(); ok {
+		_spec.AddField(budget.FieldAlertedThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AlertedPeriodStart(); ok {
+		_spec.SetField(budget.FieldAlertedPeriodStart, field.TypeTime, value)
+	}
+	if _u.mutation.AlertedPeriodStartCleared() {
+		_spec.ClearField(budget.FieldAlertedPeriodStart, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{budget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BudgetUpdateOne is the builder for updating a single Budget entity.
+type BudgetUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BudgetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BudgetUpdateOne) SetUpdatedAt(v time.Time) *BudgetUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *BudgetUpdateOne) SetDeletedAt(v int) *BudgetUpdateOne {
+	_u.mutation.ResetDeletedAt()
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillableDeletedAt(v *int) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// AddDeletedAt adds value to the "deleted_at" field.
+func (_u *BudgetUpdateOne) AddDeletedAt(v int) *BudgetUpdateOne {
+	_u.mutation.AddDeletedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BudgetUpdateOne) SetName(v string) *BudgetUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillableName(v *string) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BudgetUpdateOne) SetDescription(v string) *BudgetUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillableDescription(v *string) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *BudgetUpdateOne) SetScope(v budget.Scope) *BudgetUpdateOne {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillableScope(v *budget.Scope) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_u *BudgetUpdateOne) SetAPIKeyID(v int) *BudgetUpdateOne {
+	_u.mutation.ResetAPIKeyID()
+	_u.mutation.SetAPIKeyID(v)
+	return _u
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillableAPIKeyID(v *int) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetAPIKeyID(*v)
+	}
+	return _u
+}
+
+// AddAPIKeyID adds value to the "api_key_id" field.
+func (_u *BudgetUpdateOne) AddAPIKeyID(v int) *BudgetUpdateOne {
+	_u.mutation.AddAPIKeyID(v)
+	return _u
+}
+
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (_u *BudgetUpdateOne) ClearAPIKeyID() *BudgetUpdateOne {
+	_u.mutation.ClearAPIKeyID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BudgetUpdateOne) SetUserID(v int) *BudgetUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillableUserID(v *int) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *BudgetUpdateOne) AddUserID(v int) *BudgetUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *BudgetUpdateOne) ClearUserID() *BudgetUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *BudgetUpdateOne) SetAmount(v float64) *BudgetUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillableAmount(v *float64) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *BudgetUpdateOne) AddAmount(v float64) *BudgetUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetPeriod sets the "period" field.
+func (_u *BudgetUpdateOne) SetPeriod(v budget.Period) *BudgetUpdateOne {
+	_u.mutation.SetPeriod(v)
+	return _u
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillablePeriod(v *budget.Period) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetPeriod(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BudgetUpdateOne) SetStatus(v budget.Status) *BudgetUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillableStatus(v *budget.Status) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetSettings sets the "settings" field.
+func (_u *BudgetUpdateOne) SetSettings(v objects.BudgetSettings) *BudgetUpdateOne {
+	_u.mutation.SetSettings(v)
+	return _u
+}
+
+// SetNillableSettings sets the "settings" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillableSettings(v *objects.BudgetSettings) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetSettings(*v)
+	}
+	return _u
+}
+
+// SetAlertedThreshold sets the "alerted_threshold" field.
+func (_u *BudgetUpdateOne) SetAlertedThreshold(v int) *BudgetUpdateOne {
+	_u.mutation.ResetAlertedThreshold()
+	_u.mutation.SetAlertedThreshold(v)
+	return _u
+}
+
+// SetNillableAlertedThreshold sets the "alerted_threshold" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillableAlertedThreshold(v *int) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetAlertedThreshold(*v)
+	}
+	return _u
+}
+
+// AddAlertedThreshold adds value to the "alerted_threshold" field.
+func (_u *BudgetUpdateOne) AddAlertedThreshold(v int) *BudgetUpdateOne {
+	_u.mutation.AddAlertedThreshold(v)
+	return _u
+}
+
+// SetAlertedPeriodStart sets the "alerted_period_start" field.
+func (_u *BudgetUpdateOne) SetAlertedPeriodStart(v time.Time) *BudgetUpdateOne {
+	_u.mutation.SetAlertedPeriodStart(v)
+	return _u
+}
+
+// SetNillableAlertedPeriodStart sets the "alerted_period_start" field if the given value is not nil.
+func (_u *BudgetUpdateOne) SetNillableAlertedPeriodStart(v *time.Time) *BudgetUpdateOne {
+	if v != nil {
+		_u.SetAlertedPeriodStart(*v)
+	}
+	return _u
+}
+
+// ClearAlertedPeriodStart clears the value of the "alerted_period_start" field.
+func (_u *BudgetUpdateOne) ClearAlertedPeriodStart() *BudgetUpdateOne {
+	_u.mutation.ClearAlertedPeriodStart()
+	return _u
+}
+
+// Mutation returns the BudgetMutation object of the builder.
+func (_u *BudgetUpdateOne) Mutation() *BudgetMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BudgetUpdate builder.
+func (_u *BudgetUpdateOne) Where(ps ...predicate.Budget) *BudgetUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BudgetUpdateOne) Select(field string, fields ...string) *BudgetUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Budget entity.
+func (_u *BudgetUpdateOne) Save(ctx context.Context) (*Budget, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BudgetUpdateOne) SaveX(ctx context.Context) *Budget {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BudgetUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BudgetUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BudgetUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if budget.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized budget.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := budget.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BudgetUpdateOne) check() error {
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := budget.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "Budget.scope": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Period(); ok {
+		if err := budget.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`ent: validator failed for field "Budget.period": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := budget.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Budget.status": %w`, err)}
+		}
+	}
+	if _u.mutation.ProjectCleared() && len(_u.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Budget.project"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BudgetUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BudgetUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BudgetUpdateOne) sqlSave(ctx context.Context) (_node *Budget, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(budget.Table, budget.Columns, sqlgraph.NewFieldSpec(budget.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Budget.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, budget.FieldID)
+		for _, f := range fields {
+			if !budget.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != budget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(budget.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(budget.FieldDeletedAt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedAt(); ok {
+		_spec.AddField(budget.FieldDeletedAt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(budget.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(budget.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(budget.FieldScope, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.APIKeyID(); ok {
+		_spec.SetField(budget.FieldAPIKeyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAPIKeyID(); ok {
+		_spec.AddField(budget.FieldAPIKeyID, field.TypeInt, value)
+	}
+	if _u.mutation.APIKeyIDCleared() {
+		_spec.ClearField(budget.FieldAPIKeyID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(budget.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(budget.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(budget.FieldUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(budget.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(budget.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Period(); ok {
+		_spec.SetField(budget.FieldPeriod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(budget.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Settings(); ok {
+		_spec.SetField(budget.FieldSettings, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AlertedThreshold(); ok {
+		_spec.SetField(budget.FieldAlertedThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAlertedThreshold(); ok {
+		_spec.AddField(budget.FieldAlertedThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AlertedPeriodStart(); ok {
+		_spec.SetField(budget.FieldAlertedPeriodStart, field.TypeTime, value)
+	}
+	if _u.mutation.AlertedPeriodStartCleared() {
+		_spec.ClearField(budget.FieldAlertedPeriodStart, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Budget{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{budget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -17,13 +17,19 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/apikeyprofiletemplate"
+	"github.com/looplj/axonhub/internal/ent/auditlog"
+	"github.com/looplj/axonhub/internal/ent/budget"
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/channelmodelprice"
 	"github.com/looplj/axonhub/internal/ent/channelmodelpriceversion"
 	"github.com/looplj/axonhub/internal/ent/channeloverridetemplate"
 	"github.com/looplj/axonhub/internal/ent/channelprobe"
+	"github.com/looplj/axonhub/internal/ent/contentblob"
+	"github.com/looplj/axonhub/internal/ent/dataexport"
 	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/exchangerate"
 	"github.com/looplj/axonhub/internal/ent/invitation"
+	"github.com/looplj/axonhub/internal/ent/invoice"
 	"github.com/looplj/axonhub/internal/ent/model"
 	"github.com/looplj/axonhub/internal/ent/oidcidentity"
 	"github.com/looplj/axonhub/internal/ent/project"
@@ -32,14 +38,22 @@ import (
 	"github.com/looplj/axonhub/internal/ent/providerquotastatus"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/ent/requestsearchterm"
 	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/scimgroup"
 	"github.com/looplj/axonhub/internal/ent/system"
 	"github.com/looplj/axonhub/internal/ent/thread"
 	"github.com/looplj/axonhub/internal/ent/trace"
+	"github.com/looplj/axonhub/internal/ent/usagedailyrollup"
+	"github.com/looplj/axonhub/internal/ent/usagehourlyrollup"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/ent/user"
 	"github.com/looplj/axonhub/internal/ent/userproject"
 	"github.com/looplj/axonhub/internal/ent/userrole"
+	"github.com/looplj/axonhub/internal/ent/wallet"
+	"github.com/looplj/axonhub/internal/ent/walletreservation"
+	"github.com/looplj/axonhub/internal/ent/wallettransaction"
+	"github.com/looplj/axonhub/internal/ent/webhookdelivery"
 )
 
 // Client is the client that holds all ent builders.
@@ -51,6 +65,10 @@ type Client struct {
 	APIKey *APIKeyClient
 	// APIKeyProfileTemplate is the client for interacting with the APIKeyProfileTemplate builders.
 	APIKeyProfileTemplate *APIKeyProfileTemplateClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// Budget is the client for interacting with the Budget builders.
+	Budget *BudgetClient
 	// Channel is the client for interacting with the Channel builders.
 	Channel *ChannelClient
 	// ChannelModelPrice is the client for interacting with the ChannelModelPrice builders.
@@ -61,10 +79,18 @@ type Client struct {
 	ChannelOverrideTemplate *ChannelOverrideTemplateClient
 	// ChannelProbe is the client for interacting with the ChannelProbe builders.
 	ChannelProbe *ChannelProbeClient
+	// ContentBlob is the client for interacting with the ContentBlob builders.
+	ContentBlob *ContentBlobClient
+	// DataExport is the client for interacting with the DataExport builders.
+	DataExport *DataExportClient
 	// DataStorage is the client for interacting with the DataStorage builders.
 	DataStorage *DataStorageClient
+	// ExchangeRate is the client for interacting with the ExchangeRate builders.
+	ExchangeRate *ExchangeRateClient
 	// Invitation is the client for interacting with the Invitation builders.
 	Invitation *InvitationClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
 	// Model is the client for interacting with the Model builders.
 	Model *ModelClient
 	// OIDCIdentity is the client for interacting with the OIDCIdentity builders.
@@ -81,14 +107,22 @@ type Client struct {
 	Request *RequestClient
 	// RequestExecution is the client for interacting with the RequestExecution builders.
 	RequestExecution *RequestExecutionClient
+	// RequestSearchTerm is the client for interacting with the RequestSearchTerm builders.
+	RequestSearchTerm *RequestSearchTermClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// SCIMGroup is the client for interacting with the SCIMGroup builders.
+	SCIMGroup *SCIMGroupClient
 	// System is the client for interacting with the System builders.
 	System *SystemClient
 	// Thread is the client for interacting with the Thread builders.
 	Thread *ThreadClient
 	// Trace is the client for interacting with the Trace builders.
 	Trace *TraceClient
+	// UsageDailyRollup is the client for interacting with the UsageDailyRollup builders.
+	UsageDailyRollup *UsageDailyRollupClient
+	// UsageHourlyRollup is the client for interacting with the UsageHourlyRollup builders.
+	UsageHourlyRollup *UsageHourlyRollupClient
 	// UsageLog is the client for interacting with the UsageLog builders.
 	UsageLog *UsageLogClient
 	// User is the client for interacting with the User builders.
@@ -97,6 +131,14 @@ type Client struct {
 	UserProject *UserProjectClient
 	// UserRole is the client for interacting with the UserRole builders.
 	UserRole *UserRoleClient
+	// Wallet is the client for interacting with the Wallet builders.
+	Wallet *WalletClient
+	// WalletReservation is the client for interacting with the WalletReservation builders.
+	WalletReservation *WalletReservationClient
+	// WalletTransaction is the client for interacting with the WalletTransaction builders.
+	WalletTransaction *WalletTransactionClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 	// additional fields for node api
 	tables tables
 }
@@ -112,13 +154,19 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.APIKey = NewAPIKeyClient(c.config)
 	c.APIKeyProfileTemplate = NewAPIKeyProfileTemplateClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
+	c.Budget = NewBudgetClient(c.config)
 	c.Channel = NewChannelClient(c.config)
 	c.ChannelModelPrice = NewChannelModelPriceClient(c.config)
 	c.ChannelModelPriceVersion = NewChannelModelPriceVersionClient(c.config)
 	c.ChannelOverrideTemplate = NewChannelOverrideTemplateClient(c.config)
 	c.ChannelProbe = NewChannelProbeClient(c.config)
+	c.ContentBlob = NewContentBlobClient(c.config)
+	c.DataExport = NewDataExportClient(c.config)
 	c.DataStorage = NewDataStorageClient(c.config)
+	c.ExchangeRate = NewExchangeRateClient(c.config)
 	c.Invitation = NewInvitationClient(c.config)
+	c.Invoice = NewInvoiceClient(c.config)
 	c.Model = NewModelClient(c.config)
 	c.OIDCIdentity = NewOIDCIdentityClient(c.config)
 	c.Project = NewProjectClient(c.config)
@@ -127,14 +175,22 @@ func (c *Client) init() {
 	c.ProviderQuotaStatus = NewProviderQuotaStatusClient(c.config)
 	c.Request = NewRequestClient(c.config)
 	c.RequestExecution = NewRequestExecutionClient(c.config)
+	c.RequestSearchTerm = NewRequestSearchTermClient(c.config)
 	c.Role = NewRoleClient(c.config)
+	c.SCIMGroup = NewSCIMGroupClient(c.config)
 	c.System = NewSystemClient(c.config)
 	c.Thread = NewThreadClient(c.config)
 	c.Trace = NewTraceClient(c.config)
+	c.UsageDailyRollup = NewUsageDailyRollupClient(c.config)
+	c.UsageHourlyRollup = NewUsageHourlyRollupClient(c.config)
 	c.UsageLog = NewUsageLogClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserProject = NewUserProjectClient(c.config)
 	c.UserRole = NewUserRoleClient(c.config)
+	c.Wallet = NewWalletClient(c.config)
+	c.WalletReservation = NewWalletReservationClient(c.config)
+	c.WalletTransaction = NewWalletTransactionClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
 }
 
 type (
@@ -229,13 +285,19 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		config:                   cfg,
 		APIKey:                   NewAPIKeyClient(cfg),
 		APIKeyProfileTemplate:    NewAPIKeyProfileTemplateClient(cfg),
+		AuditLog:                 NewAuditLogClient(cfg),
+		Budget:                   NewBudgetClient(cfg),
 		Channel:                  NewChannelClient(cfg),
 		ChannelModelPrice:        NewChannelModelPriceClient(cfg),
 		ChannelModelPriceVersion: NewChannelModelPriceVersionClient(cfg),
 		ChannelOverrideTemplate:  NewChannelOverrideTemplateClient(cfg),
 		ChannelProbe:             NewChannelProbeClient(cfg),
+		ContentBlob:              NewContentBlobClient(cfg),
+		DataExport:               NewDataExportClient(cfg),
 		DataStorage:              NewDataStorageClient(cfg),
+		ExchangeRate:             NewExchangeRateClient(cfg),
 		Invitation:               NewInvitationClient(cfg),
+		Invoice:                  NewInvoiceClient(cfg),
 		Model:                    NewModelClient(cfg),
 		OIDCIdentity:             NewOIDCIdentityClient(cfg),
 		Project:                  NewProjectClient(cfg),
@@ -244,14 +306,22 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ProviderQuotaStatus:      NewProviderQuotaStatusClient(cfg),
 		Request:                  NewRequestClient(cfg),
 		RequestExecution:         NewRequestExecutionClient(cfg),
+		RequestSearchTerm:        NewRequestSearchTermClient(cfg),
 		Role:                     NewRoleClient(cfg),
+		SCIMGroup:                NewSCIMGroupClient(cfg),
 		System:                   NewSystemClient(cfg),
 		Thread:                   NewThreadClient(cfg),
 		Trace:                    NewTraceClient(cfg),
+		UsageDailyRollup:         NewUsageDailyRollupClient(cfg),
+		UsageHourlyRollup:        NewUsageHourlyRollupClient(cfg),
 		UsageLog:                 NewUsageLogClient(cfg),
 		User:                     NewUserClient(cfg),
 		UserProject:              NewUserProjectClient(cfg),
 		UserRole:                 NewUserRoleClient(cfg),
+		Wallet:                   NewWalletClient(cfg),
+		WalletReservation:        NewWalletReservationClient(cfg),
+		WalletTransaction:        NewWalletTransactionClient(cfg),
+		WebhookDelivery:          NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -273,13 +343,19 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		config:                   cfg,
 		APIKey:                   NewAPIKeyClient(cfg),
 		APIKeyProfileTemplate:    NewAPIKeyProfileTemplateClient(cfg),
+		AuditLog:                 NewAuditLogClient(cfg),
+		Budget:                   NewBudgetClient(cfg),
 		Channel:                  NewChannelClient(cfg),
 		ChannelModelPrice:        NewChannelModelPriceClient(cfg),
 		ChannelModelPriceVersion: NewChannelModelPriceVersionClient(cfg),
 		ChannelOverrideTemplate:  NewChannelOverrideTemplateClient(cfg),
 		ChannelProbe:             NewChannelProbeClient(cfg),
+		ContentBlob:              NewContentBlobClient(cfg),
+		DataExport:               NewDataExportClient(cfg),
 		DataStorage:              NewDataStorageClient(cfg),
+		ExchangeRate:             NewExchangeRateClient(cfg),
 		Invitation:               NewInvitationClient(cfg),
+		Invoice:                  NewInvoiceClient(cfg),
 		Model:                    NewModelClient(cfg),
 		OIDCIdentity:             NewOIDCIdentityClient(cfg),
 		Project:                  NewProjectClient(cfg),
@@ -288,14 +364,22 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ProviderQuotaStatus:      NewProviderQuotaStatusClient(cfg),
 		Request:                  NewRequestClient(cfg),
 		RequestExecution:         NewRequestExecutionClient(cfg),
+		RequestSearchTerm:        NewRequestSearchTermClient(cfg),
 		Role:                     NewRoleClient(cfg),
+		SCIMGroup:                NewSCIMGroupClient(cfg),
 		System:                   NewSystemClient(cfg),
 		Thread:                   NewThreadClient(cfg),
 		Trace:                    NewTraceClient(cfg),
+		UsageDailyRollup:         NewUsageDailyRollupClient(cfg),
+		UsageHourlyRollup:        NewUsageHourlyRollupClient(cfg),
 		UsageLog:                 NewUsageLogClient(cfg),
 		User:                     NewUserClient(cfg),
 		UserProject:              NewUserProjectClient(cfg),
 		UserRole:                 NewUserRoleClient(cfg),
+		Wallet:                   NewWalletClient(cfg),
+		WalletReservation:        NewWalletReservationClient(cfg),
+		WalletTransaction:        NewWalletTransactionClient(cfg),
+		WebhookDelivery:          NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -325,12 +409,15 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.APIKey, c.APIKeyProfileTemplate, c.Channel, c.ChannelModelPrice,
-		c.ChannelModelPriceVersion, c.ChannelOverrideTemplate, c.ChannelProbe,
-		c.DataStorage, c.Invitation, c.Model, c.OIDCIdentity, c.Project, c.Prompt,
+		c.APIKey, c.APIKeyProfileTemplate, c.AuditLog, c.Budget, c.Channel,
+		c.ChannelModelPrice, c.ChannelModelPriceVersion, c.ChannelOverrideTemplate,
+		c.ChannelProbe, c.ContentBlob, c.DataExport, c.DataStorage, c.ExchangeRate,
+		c.Invitation, c.Invoice, c.Model, c.OIDCIdentity, c.Project, c.Prompt,
 		c.PromptProtectionRule, c.ProviderQuotaStatus, c.Request, c.RequestExecution,
-		c.Role, c.System, c.Thread, c.Trace, c.UsageLog, c.User, c.UserProject,
-		c.UserRole,
+		c.RequestSearchTerm, c.Role, c.SCIMGroup, c.System, c.Thread, c.Trace,
+		c.UsageDailyRollup, c.UsageHourlyRollup, c.UsageLog, c.User, c.UserProject,
+		c.UserRole, c.Wallet, c.WalletReservation, c.WalletTransaction,
+		c.WebhookDelivery,
 	} {
 		n.Use(hooks...)
 	}
@@ -340,12 +427,15 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.APIKey, c.APIKeyProfileTemplate, c.Channel, c.ChannelModelPrice,
-		c.ChannelModelPriceVersion, c.ChannelOverrideTemplate, c.ChannelProbe,
-		c.DataStorage, c.Invitation, c.Model, c.OIDCIdentity, c.Project, c.Prompt,
+		c.APIKey, c.APIKeyProfileTemplate, c.AuditLog, c.Budget, c.Channel,
+		c.ChannelModelPrice, c.ChannelModelPriceVersion, c.ChannelOverrideTemplate,
+		c.ChannelProbe, c.ContentBlob, c.DataExport, c.DataStorage, c.ExchangeRate,
+		c.Invitation, c.Invoice, c.Model, c.OIDCIdentity, c.Project, c.Prompt,
 		c.PromptProtectionRule, c.ProviderQuotaStatus, c.Request, c.RequestExecution,
-		c.Role, c.System, c.Thread, c.Trace, c.UsageLog, c.User, c.UserProject,
-		c.UserRole,
+		c.RequestSearchTerm, c.Role, c.SCIMGroup, c.System, c.Thread, c.Trace,
+		c.UsageDailyRollup, c.UsageHourlyRollup, c.UsageLog, c.User, c.UserProject,
+		c.UserRole, c.Wallet, c.WalletReservation, c.WalletTransaction,
+		c.WebhookDelivery,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -358,6 +448,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.APIKey.mutate(ctx, m)
 	case *APIKeyProfileTemplateMutation:
 		return c.APIKeyProfileTemplate.mutate(ctx, m)
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
+	case *BudgetMutation:
+		return c.Budget.mutate(ctx, m)
 	case *ChannelMutation:
 		return c.Channel.mutate(ctx, m)
 	case *ChannelModelPriceMutation:
@@ -368,10 +462,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ChannelOverrideTemplate.mutate(ctx, m)
 	case *ChannelProbeMutation:
 		return c.ChannelProbe.mutate(ctx, m)
+	case *ContentBlobMutation:
+		return c.ContentBlob.mutate(ctx, m)
+	case *DataExportMutation:
+		return c.DataExport.mutate(ctx, m)
 	case *DataStorageMutation:
 		return c.DataStorage.mutate(ctx, m)
+	case *ExchangeRateMutation:
+		return c.ExchangeRate.mutate(ctx, m)
 	case *InvitationMutation:
 		return c.Invitation.mutate(ctx, m)
+	case *InvoiceMutation:
+		return c.Invoice.mutate(ctx, m)
 	case *ModelMutation:
 		return c.Model.mutate(ctx, m)
 	case *OIDCIdentityMutation:
@@ -388,14 +490,22 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Request.mutate(ctx, m)
 	case *RequestExecutionMutation:
 		return c.RequestExecution.mutate(ctx, m)
+	case *RequestSearchTermMutation:
+		return c.RequestSearchTerm.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
+	case *SCIMGroupMutation:
+		return c.SCIMGroup.mutate(ctx, m)
 	case *SystemMutation:
 		return c.System.mutate(ctx, m)
 	case *ThreadMutation:
 		return c.Thread.mutate(ctx, m)
 	case *TraceMutation:
 		return c.Trace.mutate(ctx, m)
+	case *UsageDailyRollupMutation:
+		return c.UsageDailyRollup.mutate(ctx, m)
+	case *UsageHourlyRollupMutation:
+		return c.UsageHourlyRollup.mutate(ctx, m)
 	case *UsageLogMutation:
 		return c.UsageLog.mutate(ctx, m)
 	case *UserMutation:
@@ -404,6 +514,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.UserProject.mutate(ctx, m)
 	case *UserRoleMutation:
 		return c.UserRole.mutate(ctx, m)
+	case *WalletMutation:
+		return c.Wallet.mutate(ctx, m)
+	case *WalletReservationMutation:
+		return c.WalletReservation.mutate(ctx, m)
+	case *WalletTransactionMutation:
+		return c.WalletTransaction.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -565,6 +683,22 @@ func (c *APIKeyClient) QueryRequests(_m *APIKey) *RequestQuery {
 	return query
 }
 
+// QueryWallet queries the wallet edge of a APIKey.
+func (c *APIKeyClient) QueryWallet(_m *APIKey) *WalletQuery {
+	query := (&WalletClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apikey.Table, apikey.FieldID, id),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, apikey.WalletTable, apikey.WalletColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *APIKeyClient) Hooks() []Hook {
 	hooks := c.hooks.APIKey
@@ -743,6 +877,291 @@ func (c *APIKeyProfileTemplateClient) mutate(ctx context.Context, m *APIKeyProfi
 	}
 }
 
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(_m *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(_m))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id int) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogClient) DeleteOne(_m *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogClient) DeleteOneID(id int) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id int) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id int) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	hooks := c.hooks.AuditLog
+	return append(hooks[:len(hooks):len(hooks)], auditlog.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
+}
+
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
+	}
+}
+
+// BudgetClient is a client for the Budget schema.
+type BudgetClient struct {
+	config
+}
+
+// NewBudgetClient returns a client for the Budget from the given config.
+func NewBudgetClient(c config) *BudgetClient {
+	return &BudgetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `budget.Hooks(f(g(h())))`.
+func (c *BudgetClient) Use(hooks ...Hook) {
+	c.hooks.Budget = append(c.hooks.Budget, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `budget.Intercept(f(g(h())))`.
+func (c *BudgetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Budget = append(c.inters.Budget, interceptors...)
+}
+
+// Create returns a builder for creating a Budget entity.
+func (c *BudgetClient) Create() *BudgetCreate {
+	mutation := newBudgetMutation(c.config, OpCreate)
+	return &BudgetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Budget entities.
+func (c *BudgetClient) CreateBulk(builders ...*BudgetCreate) *BudgetCreateBulk {
+	return &BudgetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BudgetClient) MapCreateBulk(slice any, setFunc func(*BudgetCreate, int)) *BudgetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BudgetCreateBulk{err: fmt.Errorf("calling to BudgetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BudgetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BudgetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Budget.
+func (c *BudgetClient) Update() *BudgetUpdate {
+	mutation := newBudgetMutation(c.config, OpUpdate)
+	return &BudgetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BudgetClient) UpdateOne(_m *Budget) *BudgetUpdateOne {
+	mutation := newBudgetMutation(c.config, OpUpdateOne, withBudget(_m))
+	return &BudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BudgetClient) UpdateOneID(id int) *BudgetUpdateOne {
+	mutation := newBudgetMutation(c.config, OpUpdateOne, withBudgetID(id))
+	return &BudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Budget.
+func (c *BudgetClient) Delete() *BudgetDelete {
+	mutation := newBudgetMutation(c.config, OpDelete)
+	return &BudgetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BudgetClient) DeleteOne(_m *Budget) *BudgetDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BudgetClient) DeleteOneID(id int) *BudgetDeleteOne {
+	builder := c.Delete().Where(budget.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BudgetDeleteOne{builder}
+}
+
+// Query returns a query builder for Budget.
+func (c *BudgetClient) Query() *BudgetQuery {
+	return &BudgetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBudget},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Budget entity by its id.
+func (c *BudgetClient) Get(ctx context.Context, id int) (*Budget, error) {
+	return c.Query().Where(budget.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BudgetClient) GetX(ctx context.Context, id int) *Budget {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a Budget.
+func (c *BudgetClient) QueryProject(_m *Budget) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(budget.Table, budget.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, budget.ProjectTable, budget.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BudgetClient) Hooks() []Hook {
+	hooks := c.hooks.Budget
+	return append(hooks[:len(hooks):len(hooks)], budget.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *BudgetClient) Interceptors() []Interceptor {
+	inters := c.inters.Budget
+	return append(inters[:len(inters):len(inters)], budget.Interceptors[:]...)
+}
+
+func (c *BudgetClient) mutate(ctx context.Context, m *BudgetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BudgetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BudgetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BudgetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Budget mutation op: %q", m.Op())
+	}
+}
+
 // ChannelClient is a client for the Channel schema.
 type ChannelClient struct {
 	config
@@ -1591,107 +2010,107 @@ func (c *ChannelProbeClient) mutate(ctx context.Context, m *ChannelProbeMutation
 	}
 }
 
-// DataStorageClient is a client for the DataStorage schema.
-type DataStorageClient struct {
+// ContentBlobClient is a client for the ContentBlob schema.
+type ContentBlobClient struct {
 	config
 }
 
-// NewDataStorageClient returns a client for the DataStorage from the given config.
-func NewDataStorageClient(c config) *DataStorageClient {
-	return &DataStorageClient{config: c}
+// NewContentBlobClient returns a client for the ContentBlob from the given config.
+func NewContentBlobClient(c config) *ContentBlobClient {
+	return &ContentBlobClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `datastorage.Hooks(f(g(h())))`.
-func (c *DataStorageClient) Use(hooks ...Hook) {
-	c.hooks.DataStorage = append(c.hooks.DataStorage, hooks...)
+// A call to `Use(f, g, h)` equals to `contentblob.Hooks(f(g(h())))`.
+func (c *ContentBlobClient) Use(hooks ...Hook) {
+	c.hooks.ContentBlob = append(c.hooks.ContentBlob, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `datastorage.Intercept(f(g(h())))`.
-func (c *DataStorageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DataStorage = append(c.inters.DataStorage, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `contentblob.Intercept(f(g(h())))`.
+func (c *ContentBlobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ContentBlob = append(c.inters.ContentBlob, interceptors...)
 }
 
-// Create returns a builder for creating a DataStorage entity.
-func (c *DataStorageClient) Create() *DataStorageCreate {
-	mutation := newDataStorageMutation(c.config, OpCreate)
-	return &DataStorageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ContentBlob entity.
+func (c *ContentBlobClient) Create() *ContentBlobCreate {
+	mutation := newContentBlobMutation(c.config, OpCreate)
+	return &ContentBlobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DataStorage entities.
-func (c *DataStorageClient) CreateBulk(builders ...*DataStorageCreate) *DataStorageCreateBulk {
-	return &DataStorageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ContentBlob entities.
+func (c *ContentBlobClient) CreateBulk(builders ...*ContentBlobCreate) *ContentBlobCreateBulk {
+	return &ContentBlobCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DataStorageClient) MapCreateBulk(slice any, setFunc func(*DataStorageCreate, int)) *DataStorageCreateBulk {
+func (c *ContentBlobClient) MapCreateBulk(slice any, setFunc func(*ContentBlobCreate, int)) *ContentBlobCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DataStorageCreateBulk{err: fmt.Errorf("calling to DataStorageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ContentBlobCreateBulk{err: fmt.Errorf("calling to ContentBlobClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DataStorageCreate, rv.Len())
+	builders := make([]*ContentBlobCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DataStorageCreateBulk{config: c.config, builders: builders}
+	return &ContentBlobCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DataStorage.
-func (c *DataStorageClient) Update() *DataStorageUpdate {
-	mutation := newDataStorageMutation(c.config, OpUpdate)
-	return &DataStorageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ContentBlob.
+func (c *ContentBlobClient) Update() *ContentBlobUpdate {
+	mutation := newContentBlobMutation(c.config, OpUpdate)
+	return &ContentBlobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DataStorageClient) UpdateOne(_m *DataStorage) *DataStorageUpdateOne {
-	mutation := newDataStorageMutation(c.config, OpUpdateOne, withDataStorage(_m))
-	return &DataStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ContentBlobClient) UpdateOne(_m *ContentBlob) *ContentBlobUpdateOne {
+	mutation := newContentBlobMutation(c.config, OpUpdateOne, withContentBlob(_m))
+	return &ContentBlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DataStorageClient) UpdateOneID(id int) *DataStorageUpdateOne {
-	mutation := newDataStorageMutation(c.config, OpUpdateOne, withDataStorageID(id))
-	return &DataStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ContentBlobClient) UpdateOneID(id int) *ContentBlobUpdateOne {
+	mutation := newContentBlobMutation(c.config, OpUpdateOne, withContentBlobID(id))
+	return &ContentBlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DataStorage.
-func (c *DataStorageClient) Delete() *DataStorageDelete {
-	mutation := newDataStorageMutation(c.config, OpDelete)
-	return &DataStorageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ContentBlob.
+func (c *ContentBlobClient) Delete() *ContentBlobDelete {
+	mutation := newContentBlobMutation(c.config, OpDelete)
+	return &ContentBlobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DataStorageClient) DeleteOne(_m *DataStorage) *DataStorageDeleteOne {
+func (c *ContentBlobClient) DeleteOne(_m *ContentBlob) *ContentBlobDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DataStorageClient) DeleteOneID(id int) *DataStorageDeleteOne {
-	builder := c.Delete().Where(datastorage.ID(id))
+func (c *ContentBlobClient) DeleteOneID(id int) *ContentBlobDeleteOne {
+	builder := c.Delete().Where(contentblob.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DataStorageDeleteOne{builder}
+	return &ContentBlobDeleteOne{builder}
 }
 
-// Query returns a query builder for DataStorage.
-func (c *DataStorageClient) Query() *DataStorageQuery {
-	return &DataStorageQuery{
+// Query returns a query builder for ContentBlob.
+func (c *ContentBlobClient) Query() *ContentBlobQuery {
+	return &ContentBlobQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDataStorage},
+		ctx:    &QueryContext{Type: TypeContentBlob},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DataStorage entity by its id.
-func (c *DataStorageClient) Get(ctx context.Context, id int) (*DataStorage, error) {
-	return c.Query().Where(datastorage.ID(id)).Only(ctx)
+// Get returns a ContentBlob entity by its id.
+func (c *ContentBlobClient) Get(ctx context.Context, id int) (*ContentBlob, error) {
+	return c.Query().Where(contentblob.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DataStorageClient) GetX(ctx context.Context, id int) *DataStorage {
+func (c *ContentBlobClient) GetX(ctx context.Context, id int) *ContentBlob {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1699,166 +2118,133 @@ func (c *DataStorageClient) GetX(ctx context.Context, id int) *DataStorage {
 	return obj
 }
 
-// QueryRequests queries the requests edge of a DataStorage.
-func (c *DataStorageClient) QueryRequests(_m *DataStorage) *RequestQuery {
-	query := (&RequestClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(datastorage.Table, datastorage.FieldID, id),
-			sqlgraph.To(request.Table, request.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, datastorage.RequestsTable, datastorage.RequestsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryExecutions queries the executions edge of a DataStorage.
-func (c *DataStorageClient) QueryExecutions(_m *DataStorage) *RequestExecutionQuery {
-	query := (&RequestExecutionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(datastorage.Table, datastorage.FieldID, id),
-			sqlgraph.To(requestexecution.Table, requestexecution.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, datastorage.ExecutionsTable, datastorage.ExecutionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DataStorageClient) Hooks() []Hook {
-	hooks := c.hooks.DataStorage
-	return append(hooks[:len(hooks):len(hooks)], datastorage.Hooks[:]...)
+func (c *ContentBlobClient) Hooks() []Hook {
+	hooks := c.hooks.ContentBlob
+	return append(hooks[:len(hooks):len(hooks)], contentblob.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *DataStorageClient) Interceptors() []Interceptor {
-	inters := c.inters.DataStorage
-	return append(inters[:len(inters):len(inters)], datastorage.Interceptors[:]...)
+func (c *ContentBlobClient) Interceptors() []Interceptor {
+	return c.inters.ContentBlob
 }
 
-func (c *DataStorageClient) mutate(ctx context.Context, m *DataStorageMutation) (Value, error) {
+func (c *ContentBlobClient) mutate(ctx context.Context, m *ContentBlobMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DataStorageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ContentBlobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DataStorageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ContentBlobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DataStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ContentBlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DataStorageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ContentBlobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DataStorage mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ContentBlob mutation op: %q", m.Op())
 	}
 }
 
-// InvitationClient is a client for the Invitation schema.
-type InvitationClient struct {
+// DataExportClient is a client for the DataExport schema.
+type DataExportClient struct {
 	config
 }
 
-// NewInvitationClient returns a client for the Invitation from the given config.
-func NewInvitationClient(c config) *InvitationClient {
-	return &InvitationClient{config: c}
+// NewDataExportClient returns a client for the DataExport from the given config.
+func NewDataExportClient(c config) *DataExportClient {
+	return &DataExportClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `invitation.Hooks(f(g(h())))`.
-func (c *InvitationClient) Use(hooks ...Hook) {
-	c.hooks.Invitation = append(c.hooks.Invitation, hooks...)
+// A call to `Use(f, g, h)` equals to `dataexport.Hooks(f(g(h())))`.
+func (c *DataExportClient) Use(hooks ...Hook) {
+	c.hooks.DataExport = append(c.hooks.DataExport, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `invitation.Intercept(f(g(h())))`.
-func (c *InvitationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Invitation = append(c.inters.Invitation, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `dataexport.Intercept(f(g(h())))`.
+func (c *DataExportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DataExport = append(c.inters.DataExport, interceptors...)
 }
 
-// Create returns a builder for creating a Invitation entity.
-func (c *InvitationClient) Create() *InvitationCreate {
-	mutation := newInvitationMutation(c.config, OpCreate)
-	return &InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DataExport entity.
+func (c *DataExportClient) Create() *DataExportCreate {
+	mutation := newDataExportMutation(c.config, OpCreate)
+	return &DataExportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Invitation entities.
-func (c *InvitationClient) CreateBulk(builders ...*InvitationCreate) *InvitationCreateBulk {
-	return &InvitationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DataExport entities.
+func (c *DataExportClient) CreateBulk(builders ...*DataExportCreate) *DataExportCreateBulk {
+	return &DataExportCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InvitationClient) MapCreateBulk(slice any, setFunc func(*InvitationCreate, int)) *InvitationCreateBulk {
+func (c *DataExportClient) MapCreateBulk(slice any, setFunc func(*DataExportCreate, int)) *DataExportCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InvitationCreateBulk{err: fmt.Errorf("calling to InvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DataExportCreateBulk{err: fmt.Errorf("calling to DataExportClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InvitationCreate, rv.Len())
+	builders := make([]*DataExportCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InvitationCreateBulk{config: c.config, builders: builders}
+	return &DataExportCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Invitation.
-func (c *InvitationClient) Update() *InvitationUpdate {
-	mutation := newInvitationMutation(c.config, OpUpdate)
-	return &InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DataExport.
+func (c *DataExportClient) Update() *DataExportUpdate {
+	mutation := newDataExportMutation(c.config, OpUpdate)
+	return &DataExportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InvitationClient) UpdateOne(_m *Invitation) *InvitationUpdateOne {
-	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitation(_m))
-	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DataExportClient) UpdateOne(_m *DataExport) *DataExportUpdateOne {
+	mutation := newDataExportMutation(c.config, OpUpdateOne, withDataExport(_m))
+	return &DataExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InvitationClient) UpdateOneID(id int) *InvitationUpdateOne {
-	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitationID(id))
-	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DataExportClient) UpdateOneID(id int) *DataExportUpdateOne {
+	mutation := newDataExportMutation(c.config, OpUpdateOne, withDataExportID(id))
+	return &DataExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Invitation.
-func (c *InvitationClient) Delete() *InvitationDelete {
-	mutation := newInvitationMutation(c.config, OpDelete)
-	return &InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DataExport.
+func (c *DataExportClient) Delete() *DataExportDelete {
+	mutation := newDataExportMutation(c.config, OpDelete)
+	return &DataExportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InvitationClient) DeleteOne(_m *Invitation) *InvitationDeleteOne {
+func (c *DataExportClient) DeleteOne(_m *DataExport) *DataExportDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InvitationClient) DeleteOneID(id int) *InvitationDeleteOne {
-	builder := c.Delete().Where(invitation.ID(id))
+func (c *DataExportClient) DeleteOneID(id int) *DataExportDeleteOne {
+	builder := c.Delete().Where(dataexport.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InvitationDeleteOne{builder}
+	return &DataExportDeleteOne{builder}
 }
 
-// Query returns a query builder for Invitation.
-func (c *InvitationClient) Query() *InvitationQuery {
-	return &InvitationQuery{
+// Query returns a query builder for DataExport.
+func (c *DataExportClient) Query() *DataExportQuery {
+	return &DataExportQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInvitation},
+		ctx:    &QueryContext{Type: TypeDataExport},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Invitation entity by its id.
-func (c *InvitationClient) Get(ctx context.Context, id int) (*Invitation, error) {
-	return c.Query().Where(invitation.ID(id)).Only(ctx)
+// Get returns a DataExport entity by its id.
+func (c *DataExportClient) Get(ctx context.Context, id int) (*DataExport, error) {
+	return c.Query().Where(dataexport.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InvitationClient) GetX(ctx context.Context, id int) *Invitation {
+func (c *DataExportClient) GetX(ctx context.Context, id int) *DataExport {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1866,15 +2252,15 @@ func (c *InvitationClient) GetX(ctx context.Context, id int) *Invitation {
 	return obj
 }
 
-// QueryProject queries the project edge of a Invitation.
-func (c *InvitationClient) QueryProject(_m *Invitation) *ProjectQuery {
+// QueryProject queries the project edge of a DataExport.
+func (c *DataExportClient) QueryProject(_m *DataExport) *ProjectQuery {
 	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(invitation.Table, invitation.FieldID, id),
+			sqlgraph.From(dataexport.Table, dataexport.FieldID, id),
 			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, invitation.ProjectTable, invitation.ProjectColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, dataexport.ProjectTable, dataexport.ProjectColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1883,133 +2269,132 @@ func (c *InvitationClient) QueryProject(_m *Invitation) *ProjectQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *InvitationClient) Hooks() []Hook {
-	hooks := c.hooks.Invitation
-	return append(hooks[:len(hooks):len(hooks)], invitation.Hooks[:]...)
+func (c *DataExportClient) Hooks() []Hook {
+	hooks := c.hooks.DataExport
+	return append(hooks[:len(hooks):len(hooks)], dataexport.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *InvitationClient) Interceptors() []Interceptor {
-	inters := c.inters.Invitation
-	return append(inters[:len(inters):len(inters)], invitation.Interceptors[:]...)
+func (c *DataExportClient) Interceptors() []Interceptor {
+	return c.inters.DataExport
 }
 
-func (c *InvitationClient) mutate(ctx context.Context, m *InvitationMutation) (Value, error) {
+func (c *DataExportClient) mutate(ctx context.Context, m *DataExportMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataExportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataExportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DataExportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Invitation mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DataExport mutation op: %q", m.Op())
 	}
 }
 
-// ModelClient is a client for the Model schema.
-type ModelClient struct {
+// DataStorageClient is a client for the DataStorage schema.
+type DataStorageClient struct {
 	config
 }
 
-// NewModelClient returns a client for the Model from the given config.
-func NewModelClient(c config) *ModelClient {
-	return &ModelClient{config: c}
+// NewDataStorageClient returns a client for the DataStorage from the given config.
+func NewDataStorageClient(c config) *DataStorageClient {
+	return &DataStorageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `model.Hooks(f(g(h())))`.
-func (c *ModelClient) Use(hooks ...Hook) {
-	c.hooks.Model = append(c.hooks.Model, hooks...)
+// A call to `Use(f, g, h)` equals to `datastorage.Hooks(f(g(h())))`.
+func (c *DataStorageClient) Use(hooks ...Hook) {
+	c.hooks.DataStorage = append(c.hooks.DataStorage, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `model.Intercept(f(g(h())))`.
-func (c *ModelClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Model = append(c.inters.Model, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `datastorage.Intercept(f(g(h())))`.
+func (c *DataStorageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DataStorage = append(c.inters.DataStorage, interceptors...)
 }
 
-// Create returns a builder for creating a Model entity.
-func (c *ModelClient) Create() *ModelCreate {
-	mutation := newModelMutation(c.config, OpCreate)
-	return &ModelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DataStorage entity.
+func (c *DataStorageClient) Create() *DataStorageCreate {
+	mutation := newDataStorageMutation(c.config, OpCreate)
+	return &DataStorageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Model entities.
-func (c *ModelClient) CreateBulk(builders ...*ModelCreate) *ModelCreateBulk {
-	return &ModelCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DataStorage entities.
+func (c *DataStorageClient) CreateBulk(builders ...*DataStorageCreate) *DataStorageCreateBulk {
+	return &DataStorageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ModelClient) MapCreateBulk(slice any, setFunc func(*ModelCreate, int)) *ModelCreateBulk {
+func (c *DataStorageClient) MapCreateBulk(slice any, setFunc func(*DataStorageCreate, int)) *DataStorageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ModelCreateBulk{err: fmt.Errorf("calling to ModelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DataStorageCreateBulk{err: fmt.Errorf("calling to DataStorageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ModelCreate, rv.Len())
+	builders := make([]*DataStorageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ModelCreateBulk{config: c.config, builders: builders}
+	return &DataStorageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Model.
-func (c *ModelClient) Update() *ModelUpdate {
-	mutation := newModelMutation(c.config, OpUpdate)
-	return &ModelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DataStorage.
+func (c *DataStorageClient) Update() *DataStorageUpdate {
+	mutation := newDataStorageMutation(c.config, OpUpdate)
+	return &DataStorageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ModelClient) UpdateOne(_m *Model) *ModelUpdateOne {
-	mutation := newModelMutation(c.config, OpUpdateOne, withModel(_m))
-	return &ModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DataStorageClient) UpdateOne(_m *DataStorage) *DataStorageUpdateOne {
+	mutation := newDataStorageMutation(c.config, OpUpdateOne, withDataStorage(_m))
+	return &DataStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ModelClient) UpdateOneID(id int) *ModelUpdateOne {
-	mutation := newModelMutation(c.config, OpUpdateOne, withModelID(id))
-	return &ModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DataStorageClient) UpdateOneID(id int) *DataStorageUpdateOne {
+	mutation := newDataStorageMutation(c.config, OpUpdateOne, withDataStorageID(id))
+	return &DataStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Model.
-func (c *ModelClient) Delete() *ModelDelete {
-	mutation := newModelMutation(c.config, OpDelete)
-	return &ModelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DataStorage.
+func (c *DataStorageClient) Delete() *DataStorageDelete {
+	mutation := newDataStorageMutation(c.config, OpDelete)
+	return &DataStorageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ModelClient) DeleteOne(_m *Model) *ModelDeleteOne {
+func (c *DataStorageClient) DeleteOne(_m *DataStorage) *DataStorageDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ModelClient) DeleteOneID(id int) *ModelDeleteOne {
-	builder := c.Delete().Where(model.ID(id))
+func (c *DataStorageClient) DeleteOneID(id int) *DataStorageDeleteOne {
+	builder := c.Delete().Where(datastorage.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ModelDeleteOne{builder}
+	return &DataStorageDeleteOne{builder}
 }
 
-// Query returns a query builder for Model.
-func (c *ModelClient) Query() *ModelQuery {
-	return &ModelQuery{
+// Query returns a query builder for DataStorage.
+func (c *DataStorageClient) Query() *DataStorageQuery {
+	return &DataStorageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeModel},
+		ctx:    &QueryContext{Type: TypeDataStorage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Model entity by its id.
-func (c *ModelClient) Get(ctx context.Context, id int) (*Model, error) {
-	return c.Query().Where(model.ID(id)).Only(ctx)
+// Get returns a DataStorage entity by its id.
+func (c *DataStorageClient) Get(ctx context.Context, id int) (*DataStorage, error) {
+	return c.Query().Where(datastorage.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ModelClient) GetX(ctx context.Context, id int) *Model {
+func (c *DataStorageClient) GetX(ctx context.Context, id int) *DataStorage {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2017,134 +2402,166 @@ func (c *ModelClient) GetX(ctx context.Context, id int) *Model {
 	return obj
 }
 
+// QueryRequests queries the requests edge of a DataStorage.
+func (c *DataStorageClient) QueryRequests(_m *DataStorage) *RequestQuery {
+	query := (&RequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(datastorage.Table, datastorage.FieldID, id),
+			sqlgraph.To(request.Table, request.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, datastorage.RequestsTable, datastorage.RequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryExecutions queries the executions edge of a DataStorage.
+func (c *DataStorageClient) QueryExecutions(_m *DataStorage) *RequestExecutionQuery {
+	query := (&RequestExecutionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(datastorage.Table, datastorage.FieldID, id),
+			sqlgraph.To(requestexecution.Table, requestexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, datastorage.ExecutionsTable, datastorage.ExecutionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *ModelClient) Hooks() []Hook {
-	hooks := c.hooks.Model
-	return append(hooks[:len(hooks):len(hooks)], model.Hooks[:]...)
+func (c *DataStorageClient) Hooks() []Hook {
+	hooks := c.hooks.DataStorage
+	return append(hooks[:len(hooks):len(hooks)], datastorage.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ModelClient) Interceptors() []Interceptor {
-	inters := c.inters.Model
-	return append(inters[:len(inters):len(inters)], model.Interceptors[:]...)
+func (c *DataStorageClient) Interceptors() []Interceptor {
+	inters := c.inters.DataStorage
+	return append(inters[:len(inters):len(inters)], datastorage.Interceptors[:]...)
 }
 
-func (c *ModelClient) mutate(ctx context.Context, m *ModelMutation) (Value, error) {
+func (c *DataStorageClient) mutate(ctx context.Context, m *DataStorageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ModelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataStorageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ModelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataStorageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DataStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ModelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DataStorageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Model mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DataStorage mutation op: %q", m.Op())
 	}
 }
 
-// OIDCIdentityClient is a client for the OIDCIdentity schema.
-type OIDCIdentityClient struct {
+// ExchangeRateClient is a client for the ExchangeRate schema.
+type ExchangeRateClient struct {
 	config
 }
 
-// NewOIDCIdentityClient returns a client for the OIDCIdentity from the given config.
-func NewOIDCIdentityClient(c config) *OIDCIdentityClient {
-	return &OIDCIdentityClient{config: c}
+// NewExchangeRateClient returns a client for the ExchangeRate from the given config.
+func NewExchangeRateClient(c config) *ExchangeRateClient {
+	return &ExchangeRateClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `oidcidentity.Hooks(f(g(h())))`.
-func (c *OIDCIdentityClient) Use(hooks ...Hook) {
-	c.hooks.OIDCIdentity = append(c.hooks.OIDCIdentity, hooks...)
+// A call to `Use(f, g, h)` equals to `exchangerate.Hooks(f(g(h())))`.
+func (c *ExchangeRateClient) Use(hooks ...Hook) {
+	c.hooks.ExchangeRate = append(c.hooks.ExchangeRate, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `oidcidentity.Intercept(f(g(h())))`.
-func (c *OIDCIdentityClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OIDCIdentity = append(c.inters.OIDCIdentity, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `exchangerate.Intercept(f(g(h())))`.
+func (c *ExchangeRateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExchangeRate = append(c.inters.ExchangeRate, interceptors...)
 }
 
-// Create returns a builder for creating a OIDCIdentity entity.
-func (c *OIDCIdentityClient) Create() *OIDCIdentityCreate {
-	mutation := newOIDCIdentityMutation(c.config, OpCreate)
-	return &OIDCIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ExchangeRate entity.
+func (c *ExchangeRateClient) Create() *ExchangeRateCreate {
+	mutation := newExchangeRateMutation(c.config, OpCreate)
+	return &ExchangeRateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OIDCIdentity entities.
-func (c *OIDCIdentityClient) CreateBulk(builders ...*OIDCIdentityCreate) *OIDCIdentityCreateBulk {
-	return &OIDCIdentityCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ExchangeRate entities.
+func (c *ExchangeRateClient) CreateBulk(builders ...*ExchangeRateCreate) *ExchangeRateCreateBulk {
+	return &ExchangeRateCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OIDCIdentityClient) MapCreateBulk(slice any, setFunc func(*OIDCIdentityCreate, int)) *OIDCIdentityCreateBulk {
+func (c *ExchangeRateClient) MapCreateBulk(slice any, setFunc func(*ExchangeRateCreate, int)) *ExchangeRateCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OIDCIdentityCreateBulk{err: fmt.Errorf("calling to OIDCIdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ExchangeRateCreateBulk{err: fmt.Errorf("calling to ExchangeRateClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OIDCIdentityCreate, rv.Len())
+	builders := make([]*ExchangeRateCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OIDCIdentityCreateBulk{config: c.config, builders: builders}
+	return &ExchangeRateCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OIDCIdentity.
-func (c *OIDCIdentityClient) Update() *OIDCIdentityUpdate {
-	mutation := newOIDCIdentityMutation(c.config, OpUpdate)
-	return &OIDCIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ExchangeRate.
+func (c *ExchangeRateClient) Update() *ExchangeRateUpdate {
+	mutation := newExchangeRateMutation(c.config, OpUpdate)
+	return &ExchangeRateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OIDCIdentityClient) UpdateOne(_m *OIDCIdentity) *OIDCIdentityUpdateOne {
-	mutation := newOIDCIdentityMutation(c.config, OpUpdateOne, withOIDCIdentity(_m))
-	return &OIDCIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ExchangeRateClient) UpdateOne(_m *ExchangeRate) *ExchangeRateUpdateOne {
+	mutation := newExchangeRateMutation(c.config, OpUpdateOne, withExchangeRate(_m))
+	return &ExchangeRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OIDCIdentityClient) UpdateOneID(id int) *OIDCIdentityUpdateOne {
-	mutation := newOIDCIdentityMutation(c.config, OpUpdateOne, withOIDCIdentityID(id))
-	return &OIDCIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ExchangeRateClient) UpdateOneID(id int) *ExchangeRateUpdateOne {
+	mutation := newExchangeRateMutation(c.config, OpUpdateOne, withExchangeRateID(id))
+	return &ExchangeRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OIDCIdentity.
-func (c *OIDCIdentityClient) Delete() *OIDCIdentityDelete {
-	mutation := newOIDCIdentityMutation(c.config, OpDelete)
-	return &OIDCIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ExchangeRate.
+func (c *ExchangeRateClient) Delete() *ExchangeRateDelete {
+	mutation := newExchangeRateMutation(c.config, OpDelete)
+	return &ExchangeRateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OIDCIdentityClient) DeleteOne(_m *OIDCIdentity) *OIDCIdentityDeleteOne {
+func (c *ExchangeRateClient) DeleteOne(_m *ExchangeRate) *ExchangeRateDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OIDCIdentityClient) DeleteOneID(id int) *OIDCIdentityDeleteOne {
-	builder := c.Delete().Where(oidcidentity.ID(id))
+func (c *ExchangeRateClient) DeleteOneID(id int) *ExchangeRateDeleteOne {
+	builder := c.Delete().Where(exchangerate.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OIDCIdentityDeleteOne{builder}
+	return &ExchangeRateDeleteOne{builder}
 }
 
-// Query returns a query builder for OIDCIdentity.
-func (c *OIDCIdentityClient) Query() *OIDCIdentityQuery {
-	return &OIDCIdentityQuery{
+// Query returns a query builder for ExchangeRate.
+func (c *ExchangeRateClient) Query() *ExchangeRateQuery {
+	return &ExchangeRateQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOIDCIdentity},
+		ctx:    &QueryContext{Type: TypeExchangeRate},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OIDCIdentity entity by its id.
-func (c *OIDCIdentityClient) Get(ctx context.Context, id int) (*OIDCIdentity, error) {
-	return c.Query().Where(oidcidentity.ID(id)).Only(ctx)
+// Get returns a ExchangeRate entity by its id.
+func (c *ExchangeRateClient) Get(ctx context.Context, id int) (*ExchangeRate, error) {
+	return c.Query().Where(exchangerate.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OIDCIdentityClient) GetX(ctx context.Context, id int) *OIDCIdentity {
+func (c *ExchangeRateClient) GetX(ctx context.Context, id int) *ExchangeRate {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2152,15 +2569,149 @@ func (c *OIDCIdentityClient) GetX(ctx context.Context, id int) *OIDCIdentity {
 	return obj
 }
 
-// QueryUser queries the user edge of a OIDCIdentity.
-func (c *OIDCIdentityClient) QueryUser(_m *OIDCIdentity) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// Hooks returns the client hooks.
+func (c *ExchangeRateClient) Hooks() []Hook {
+	hooks := c.hooks.ExchangeRate
+	return append(hooks[:len(hooks):len(hooks)], exchangerate.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExchangeRateClient) Interceptors() []Interceptor {
+	return c.inters.ExchangeRate
+}
+
+func (c *ExchangeRateClient) mutate(ctx context.Context, m *ExchangeRateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExchangeRateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExchangeRateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExchangeRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExchangeRateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExchangeRate mutation op: %q", m.Op())
+	}
+}
+
+// InvitationClient is a client for the Invitation schema.
+type InvitationClient struct {
+	config
+}
+
+// NewInvitationClient returns a client for the Invitation from the given config.
+func NewInvitationClient(c config) *InvitationClient {
+	return &InvitationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invitation.Hooks(f(g(h())))`.
+func (c *InvitationClient) Use(hooks ...Hook) {
+	c.hooks.Invitation = append(c.hooks.Invitation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invitation.Intercept(f(g(h())))`.
+func (c *InvitationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invitation = append(c.inters.Invitation, interceptors...)
+}
+
+// Create returns a builder for creating a Invitation entity.
+func (c *InvitationClient) Create() *InvitationCreate {
+	mutation := newInvitationMutation(c.config, OpCreate)
+	return &InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invitation entities.
+func (c *InvitationClient) CreateBulk(builders ...*InvitationCreate) *InvitationCreateBulk {
+	return &InvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvitationClient) MapCreateBulk(slice any, setFunc func(*InvitationCreate, int)) *InvitationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvitationCreateBulk{err: fmt.Errorf("calling to InvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvitationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invitation.
+func (c *InvitationClient) Update() *InvitationUpdate {
+	mutation := newInvitationMutation(c.config, OpUpdate)
+	return &InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvitationClient) UpdateOne(_m *Invitation) *InvitationUpdateOne {
+	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitation(_m))
+	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvitationClient) UpdateOneID(id int) *InvitationUpdateOne {
+	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitationID(id))
+	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invitation.
+func (c *InvitationClient) Delete() *InvitationDelete {
+	mutation := newInvitationMutation(c.config, OpDelete)
+	return &InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvitationClient) DeleteOne(_m *Invitation) *InvitationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvitationClient) DeleteOneID(id int) *InvitationDeleteOne {
+	builder := c.Delete().Where(invitation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvitationDeleteOne{builder}
+}
+
+// Query returns a query builder for Invitation.
+func (c *InvitationClient) Query() *InvitationQuery {
+	return &InvitationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvitation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Invitation entity by its id.
+func (c *InvitationClient) Get(ctx context.Context, id int) (*Invitation, error) {
+	return c.Query().Where(invitation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvitationClient) GetX(ctx context.Context, id int) *Invitation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a Invitation.
+func (c *InvitationClient) QueryProject(_m *Invitation) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(oidcidentity.Table, oidcidentity.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, oidcidentity.UserTable, oidcidentity.UserColumn),
+			sqlgraph.From(invitation.Table, invitation.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invitation.ProjectTable, invitation.ProjectColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2169,133 +2720,133 @@ func (c *OIDCIdentityClient) QueryUser(_m *OIDCIdentity) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *OIDCIdentityClient) Hooks() []Hook {
-	hooks := c.hooks.OIDCIdentity
-	return append(hooks[:len(hooks):len(hooks)], oidcidentity.Hooks[:]...)
+func (c *InvitationClient) Hooks() []Hook {
+	hooks := c.hooks.Invitation
+	return append(hooks[:len(hooks):len(hooks)], invitation.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *OIDCIdentityClient) Interceptors() []Interceptor {
-	inters := c.inters.OIDCIdentity
-	return append(inters[:len(inters):len(inters)], oidcidentity.Interceptors[:]...)
+func (c *InvitationClient) Interceptors() []Interceptor {
+	inters := c.inters.Invitation
+	return append(inters[:len(inters):len(inters)], invitation.Interceptors[:]...)
 }
 
-func (c *OIDCIdentityClient) mutate(ctx context.Context, m *OIDCIdentityMutation) (Value, error) {
+func (c *InvitationClient) mutate(ctx context.Context, m *InvitationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OIDCIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OIDCIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OIDCIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OIDCIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OIDCIdentity mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Invitation mutation op: %q", m.Op())
 	}
 }
 
-// ProjectClient is a client for the Project schema.
-type ProjectClient struct {
+// InvoiceClient is a client for the Invoice schema.
+type InvoiceClient struct {
 	config
 }
 
-// NewProjectClient returns a client for the Project from the given config.
-func NewProjectClient(c config) *ProjectClient {
-	return &ProjectClient{config: c}
+// NewInvoiceClient returns a client for the Invoice from the given config.
+func NewInvoiceClient(c config) *InvoiceClient {
+	return &InvoiceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `project.Hooks(f(g(h())))`.
-func (c *ProjectClient) Use(hooks ...Hook) {
-	c.hooks.Project = append(c.hooks.Project, hooks...)
+// A call to `Use(f, g, h)` equals to `invoice.Hooks(f(g(h())))`.
+func (c *InvoiceClient) Use(hooks ...Hook) {
+	c.hooks.Invoice = append(c.hooks.Invoice, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `project.Intercept(f(g(h())))`.
-func (c *ProjectClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Project = append(c.inters.Project, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `invoice.Intercept(f(g(h())))`.
+func (c *InvoiceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invoice = append(c.inters.Invoice, interceptors...)
 }
 
-// Create returns a builder for creating a Project entity.
-func (c *ProjectClient) Create() *ProjectCreate {
-	mutation := newProjectMutation(c.config, OpCreate)
-	return &ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Invoice entity.
+func (c *InvoiceClient) Create() *InvoiceCreate {
+	mutation := newInvoiceMutation(c.config, OpCreate)
+	return &InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Project entities.
-func (c *ProjectClient) CreateBulk(builders ...*ProjectCreate) *ProjectCreateBulk {
-	return &ProjectCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Invoice entities.
+func (c *InvoiceClient) CreateBulk(builders ...*InvoiceCreate) *InvoiceCreateBulk {
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProjectClient) MapCreateBulk(slice any, setFunc func(*ProjectCreate, int)) *ProjectCreateBulk {
+func (c *InvoiceClient) MapCreateBulk(slice any, setFunc func(*InvoiceCreate, int)) *InvoiceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProjectCreateBulk{err: fmt.Errorf("calling to ProjectClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &InvoiceCreateBulk{err: fmt.Errorf("calling to InvoiceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProjectCreate, rv.Len())
+	builders := make([]*InvoiceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProjectCreateBulk{config: c.config, builders: builders}
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Project.
-func (c *ProjectClient) Update() *ProjectUpdate {
-	mutation := newProjectMutation(c.config, OpUpdate)
-	return &ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Invoice.
+func (c *InvoiceClient) Update() *InvoiceUpdate {
+	mutation := newInvoiceMutation(c.config, OpUpdate)
+	return &InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProjectClient) UpdateOne(_m *Project) *ProjectUpdateOne {
-	mutation := newProjectMutation(c.config, OpUpdateOne, withProject(_m))
-	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InvoiceClient) UpdateOne(_m *Invoice) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoice(_m))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProjectClient) UpdateOneID(id int) *ProjectUpdateOne {
-	mutation := newProjectMutation(c.config, OpUpdateOne, withProjectID(id))
-	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InvoiceClient) UpdateOneID(id int) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoiceID(id))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Project.
-func (c *ProjectClient) Delete() *ProjectDelete {
-	mutation := newProjectMutation(c.config, OpDelete)
-	return &ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Invoice.
+func (c *InvoiceClient) Delete() *InvoiceDelete {
+	mutation := newInvoiceMutation(c.config, OpDelete)
+	return &InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProjectClient) DeleteOne(_m *Project) *ProjectDeleteOne {
+func (c *InvoiceClient) DeleteOne(_m *Invoice) *InvoiceDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProjectClient) DeleteOneID(id int) *ProjectDeleteOne {
-	builder := c.Delete().Where(project.ID(id))
+func (c *InvoiceClient) DeleteOneID(id int) *InvoiceDeleteOne {
+	builder := c.Delete().Where(invoice.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProjectDeleteOne{builder}
+	return &InvoiceDeleteOne{builder}
 }
 
-// Query returns a query builder for Project.
-func (c *ProjectClient) Query() *ProjectQuery {
-	return &ProjectQuery{
+// Query returns a query builder for Invoice.
+func (c *InvoiceClient) Query() *InvoiceQuery {
+	return &InvoiceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProject},
+		ctx:    &QueryContext{Type: TypeInvoice},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Project entity by its id.
-func (c *ProjectClient) Get(ctx context.Context, id int) (*Project, error) {
-	return c.Query().Where(project.ID(id)).Only(ctx)
+// Get returns a Invoice entity by its id.
+func (c *InvoiceClient) Get(ctx context.Context, id int) (*Invoice, error) {
+	return c.Query().Where(invoice.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProjectClient) GetX(ctx context.Context, id int) *Project {
+func (c *InvoiceClient) GetX(ctx context.Context, id int) *Invoice {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2303,15 +2854,15 @@ func (c *ProjectClient) GetX(ctx context.Context, id int) *Project {
 	return obj
 }
 
-// QueryUsers queries the users edge of a Project.
-func (c *ProjectClient) QueryUsers(_m *Project) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryProject queries the project edge of a Invoice.
+func (c *InvoiceClient) QueryProject(_m *Invoice) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, project.UsersTable, project.UsersPrimaryKey...),
+			sqlgraph.From(invoice.Table, invoice.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invoice.ProjectTable, invoice.ProjectColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2319,31 +2870,1477 @@ func (c *ProjectClient) QueryUsers(_m *Project) *UserQuery {
 	return query
 }
 
-// QueryInvitations queries the invitations edge of a Project.
-func (c *ProjectClient) QueryInvitations(_m *Project) *InvitationQuery {
-	query := (&InvitationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(invitation.Table, invitation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.InvitationsTable, project.InvitationsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *InvoiceClient) Hooks() []Hook {
+	hooks := c.hooks.Invoice
+	return append(hooks[:len(hooks):len(hooks)], invoice.Hooks[:]...)
 }
 
-// QueryRoles queries the roles edge of a Project.
-func (c *ProjectClient) QueryRoles(_m *Project) *RoleQuery {
-	query := (&RoleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(role.Table, role.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.RolesTable, project.RolesColumn),
+// Interceptors returns the client interceptors.
+func (c *InvoiceClient) Interceptors() []Interceptor {
+	return c.inters.Invoice
+}
+
+func (c *InvoiceClient) mutate(ctx context.Context, m *InvoiceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Invoice mutation op: %q", m.Op())
+	}
+}
+
+// ModelClient is a client for the Model schema.
+type ModelClient struct {
+	config
+}
+
+// NewModelClient returns a client for the Model from the given config.
+func NewModelClient(c config) *ModelClient {
+	return &ModelClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `model.Hooks(f(g(h())))`.
+func (c *ModelClient) Use(hooks ...Hook) {
+	c.hooks.Model = append(c.hooks.Model, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `model.Intercept(f(g(h())))`.
+func (c *ModelClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Model = append(c.inters.Model, interceptors...)
+}
+
+// Create returns a builder for creating a Model entity.
+func (c *ModelClient) Create() *ModelCreate {
+	mutation := newModelMutation(c.config, OpCreate)
+	return &ModelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Model entities.
+func (c *ModelClient) CreateBulk(builders ...*ModelCreate) *ModelCreateBulk {
+	return &ModelCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ModelClient) MapCreateBulk(slice any, setFunc func(*ModelCreate, int)) *ModelCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ModelCreateBulk{err: fmt.Errorf("calling to ModelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ModelCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ModelCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Model.
+func (c *ModelClient) Update() *ModelUpdate {
+	mutation := newModelMutation(c.config, OpUpdate)
+	return &ModelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ModelClient) UpdateOne(_m *Model) *ModelUpdateOne {
+	mutation := newModelMutation(c.config, OpUpdateOne, withModel(_m))
+	return &ModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModelClient) UpdateOneID(id int) *ModelUpdateOne {
+	mutation := newModelMutation(c.config, OpUpdateOne, withModelID(id))
+	return &ModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Model.
+func (c *ModelClient) Delete() *ModelDelete {
+	mutation := newModelMutation(c.config, OpDelete)
+	return &ModelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModelClient) DeleteOne(_m *Model) *ModelDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ModelClient) DeleteOneID(id int) *ModelDeleteOne {
+	builder := c.Delete().Where(model.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ModelDeleteOne{builder}
+}
+
+// Query returns a query builder for Model.
+func (c *ModelClient) Query() *ModelQuery {
+	return &ModelQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeModel},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Model entity by its id.
+func (c *ModelClient) Get(ctx context.Context, id int) (*Model, error) {
+	return c.Query().Where(model.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ModelClient) GetX(ctx context.Context, id int) *Model {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ModelClient) Hooks() []Hook {
+	hooks := c.hooks.Model
+	return append(hooks[:len(hooks):len(hooks)], model.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModelClient) Interceptors() []Interceptor {
+	inters := c.inters.Model
+	return append(inters[:len(inters):len(inters)], model.Interceptors[:]...)
+}
+
+func (c *ModelClient) mutate(ctx context.Context, m *ModelMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Model mutation op: %q", m.Op())
+	}
+}
+
+// OIDCIdentityClient is a client for the OIDCIdentity schema.
+type OIDCIdentityClient struct {
+	config
+}
+
+// NewOIDCIdentityClient returns a client for the OIDCIdentity from the given config.
+func NewOIDCIdentityClient(c config) *OIDCIdentityClient {
+	return &OIDCIdentityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `oidcidentity.Hooks(f(g(h())))`.
+func (c *OIDCIdentityClient) Use(hooks ...Hook) {
+	c.hooks.OIDCIdentity = append(c.hooks.OIDCIdentity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `oidcidentity.Intercept(f(g(h())))`.
+func (c *OIDCIdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OIDCIdentity = append(c.inters.OIDCIdentity, interceptors...)
+}
+
+// Create returns a builder for creating a OIDCIdentity entity.
+func (c *OIDCIdentityClient) Create() *OIDCIdentityCreate {
+	mutation := newOIDCIdentityMutation(c.config, OpCreate)
+	return &OIDCIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OIDCIdentity entities.
+func (c *OIDCIdentityClient) CreateBulk(builders ...*OIDCIdentityCreate) *OIDCIdentityCreateBulk {
+	return &OIDCIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OIDCIdentityClient) MapCreateBulk(slice any, setFunc func(*OIDCIdentityCreate, int)) *OIDCIdentityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OIDCIdentityCreateBulk{err: fmt.Errorf("calling to OIDCIdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OIDCIdentityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OIDCIdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OIDCIdentity.
+func (c *OIDCIdentityClient) Update() *OIDCIdentityUpdate {
+	mutation := newOIDCIdentityMutation(c.config, OpUpdate)
+	return &OIDCIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OIDCIdentityClient) UpdateOne(_m *OIDCIdentity) *OIDCIdentityUpdateOne {
+	mutation := newOIDCIdentityMutation(c.config, OpUpdateOne, withOIDCIdentity(_m))
+	return &OIDCIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OIDCIdentityClient) UpdateOneID(id int) *OIDCIdentityUpdateOne {
+	mutation := newOIDCIdentityMutation(c.config, OpUpdateOne, withOIDCIdentityID(id))
+	return &OIDCIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OIDCIdentity.
+func (c *OIDCIdentityClient) Delete() *OIDCIdentityDelete {
+	mutation := newOIDCIdentityMutation(c.config, OpDelete)
+	return &OIDCIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OIDCIdentityClient) DeleteOne(_m *OIDCIdentity) *OIDCIdentityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OIDCIdentityClient) DeleteOneID(id int) *OIDCIdentityDeleteOne {
+	builder := c.Delete().Where(oidcidentity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OIDCIdentityDeleteOne{builder}
+}
+
+// Query returns a query builder for OIDCIdentity.
+func (c *OIDCIdentityClient) Query() *OIDCIdentityQuery {
+	return &OIDCIdentityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOIDCIdentity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OIDCIdentity entity by its id.
+func (c *OIDCIdentityClient) Get(ctx context.Context, id int) (*OIDCIdentity, error) {
+	return c.Query().Where(oidcidentity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OIDCIdentityClient) GetX(ctx context.Context, id int) *OIDCIdentity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a OIDCIdentity.
+func (c *OIDCIdentityClient) QueryUser(_m *OIDCIdentity) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oidcidentity.Table, oidcidentity.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, oidcidentity.UserTable, oidcidentity.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OIDCIdentityClient) Hooks() []Hook {
+	hooks := c.hooks.OIDCIdentity
+	return append(hooks[:len(hooks):len(hooks)], oidcidentity.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OIDCIdentityClient) Interceptors() []Interceptor {
+	inters := c.inters.OIDCIdentity
+	return append(inters[:len(inters):len(inters)], oidcidentity.Interceptors[:]...)
+}
+
+func (c *OIDCIdentityClient) mutate(ctx context.Context, m *OIDCIdentityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OIDCIdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OIDCIdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OIDCIdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OIDCIdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OIDCIdentity mutation op: %q", m.Op())
+	}
+}
+
+// ProjectClient is a client for the Project schema.
+type ProjectClient struct {
+	config
+}
+
+// NewProjectClient returns a client for the Project from the given config.
+func NewProjectClient(c config) *ProjectClient {
+	return &ProjectClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `project.Hooks(f(g(h())))`.
+func (c *ProjectClient) Use(hooks ...Hook) {
+	c.hooks.Project = append(c.hooks.Project, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `project.Intercept(f(g(h())))`.
+func (c *ProjectClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Project = append(c.inters.Project, interceptors...)
+}
+
+// Create returns a builder for creating a Project entity.
+func (c *ProjectClient) Create() *ProjectCreate {
+	mutation := newProjectMutation(c.config, OpCreate)
+	return &ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Project entities.
+func (c *ProjectClient) CreateBulk(builders ...*ProjectCreate) *ProjectCreateBulk {
+	return &ProjectCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProjectClient) MapCreateBulk(slice any, setFunc func(*ProjectCreate, int)) *ProjectCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProjectCreateBulk{err: fmt.Errorf("calling to ProjectClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProjectCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProjectCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Project.
+func (c *ProjectClient) Update() *ProjectUpdate {
+	mutation := newProjectMutation(c.config, OpUpdate)
+	return &ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProjectClient) UpdateOne(_m *Project) *ProjectUpdateOne {
+	mutation := newProjectMutation(c.config, OpUpdateOne, withProject(_m))
+	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProjectClient) UpdateOneID(id int) *ProjectUpdateOne {
+	mutation := newProjectMutation(c.config, OpUpdateOne, withProjectID(id))
+	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Project.
+func (c *ProjectClient) Delete() *ProjectDelete {
+	mutation := newProjectMutation(c.config, OpDelete)
+	return &ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProjectClient) DeleteOne(_m *Project) *ProjectDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProjectClient) DeleteOneID(id int) *ProjectDeleteOne {
+	builder := c.Delete().Where(project.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProjectDeleteOne{builder}
+}
+
+// Query returns a query builder for Project.
+func (c *ProjectClient) Query() *ProjectQuery {
+	return &ProjectQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProject},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Project entity by its id.
+func (c *ProjectClient) Get(ctx context.Context, id int) (*Project, error) {
+	return c.Query().Where(project.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProjectClient) GetX(ctx context.Context, id int) *Project {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUsers queries the users edge of a Project.
+func (c *ProjectClient) QueryUsers(_m *Project) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, project.UsersTable, project.UsersPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvitations queries the invitations edge of a Project.
+func (c *ProjectClient) QueryInvitations(_m *Project) *InvitationQuery {
+	query := (&InvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(invitation.Table, invitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.InvitationsTable, project.InvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoles queries the roles edge of a Project.
+func (c *ProjectClient) QueryRoles(_m *Project) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.RolesTable, project.RolesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPIKeys queries the api_keys edge of a Project.
+func (c *ProjectClient) QueryAPIKeys(_m *Project) *APIKeyQuery {
+	query := (&APIKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.APIKeysTable, project.APIKeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRequests queries the requests edge of a Project.
+func (c *ProjectClient) QueryRequests(_m *Project) *RequestQuery {
+	query := (&RequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(request.Table, request.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.RequestsTable, project.RequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUsageLogs queries the usage_logs edge of a Project.
+func (c *ProjectClient) QueryUsageLogs(_m *Project) *UsageLogQuery {
+	query := (&UsageLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(usagelog.Table, usagelog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.UsageLogsTable, project.UsageLogsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryThreads queries the threads edge of a Project.
+func (c *ProjectClient) QueryThreads(_m *Project) *ThreadQuery {
+	query := (&ThreadClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(thread.Table, thread.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.ThreadsTable, project.ThreadsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTraces queries the traces edge of a Project.
+func (c *ProjectClient) QueryTraces(_m *Project) *TraceQuery {
+	query := (&TraceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(trace.Table, trace.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.TracesTable, project.TracesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPrompts queries the prompts edge of a Project.
+func (c *ProjectClient) QueryPrompts(_m *Project) *PromptQuery {
+	query := (&PromptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(prompt.Table, prompt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.PromptsTable, project.PromptsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPIKeyProfileTemplates queries the api_key_profile_templates edge of a Project.
+func (c *ProjectClient) QueryAPIKeyProfileTemplates(_m *Project) *APIKeyProfileTemplateQuery {
+	query := (&APIKeyProfileTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(apikeyprofiletemplate.Table, apikeyprofiletemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.APIKeyProfileTemplatesTable, project.APIKeyProfileTemplatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBudgets queries the budgets edge of a Project.
+func (c *ProjectClient) QueryBudgets(_m *Project) *BudgetQuery {
+	query := (&BudgetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.BudgetsTable, project.BudgetsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvoices queries the invoices edge of a Project.
+func (c *ProjectClient) QueryInvoices(_m *Project) *InvoiceQuery {
+	query := (&InvoiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.InvoicesTable, project.InvoicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDataExports queries the data_exports edge of a Project.
+func (c *ProjectClient) QueryDataExports(_m *Project) *DataExportQuery {
+	query := (&DataExportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(dataexport.Table, dataexport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.DataExportsTable, project.DataExportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProjectUsers queries the project_users edge of a Project.
+func (c *ProjectClient) QueryProjectUsers(_m *Project) *UserProjectQuery {
+	query := (&UserProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(userproject.Table, userproject.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, project.ProjectUsersTable, project.ProjectUsersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProjectClient) Hooks() []Hook {
+	hooks := c.hooks.Project
+	return append(hooks[:len(hooks):len(hooks)], project.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProjectClient) Interceptors() []Interceptor {
+	inters := c.inters.Project
+	return append(inters[:len(inters):len(inters)], project.Interceptors[:]...)
+}
+
+func (c *ProjectClient) mutate(ctx context.Context, m *ProjectMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Project mutation op: %q", m.Op())
+	}
+}
+
+// PromptClient is a client for the Prompt schema.
+type PromptClient struct {
+	config
+}
+
+// NewPromptClient returns a client for the Prompt from the given config.
+func NewPromptClient(c config) *PromptClient {
+	return &PromptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `prompt.Hooks(f(g(h())))`.
+func (c *PromptClient) Use(hooks ...Hook) {
+	c.hooks.Prompt = append(c.hooks.Prompt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `prompt.Intercept(f(g(h())))`.
+func (c *PromptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Prompt = append(c.inters.Prompt, interceptors...)
+}
+
+// Create returns a builder for creating a Prompt entity.
+func (c *PromptClient) Create() *PromptCreate {
+	mutation := newPromptMutation(c.config, OpCreate)
+	return &PromptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Prompt entities.
+func (c *PromptClient) CreateBulk(builders ...*PromptCreate) *PromptCreateBulk {
+	return &PromptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PromptClient) MapCreateBulk(slice any, setFunc func(*PromptCreate, int)) *PromptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PromptCreateBulk{err: fmt.Errorf("calling to PromptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PromptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PromptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Prompt.
+func (c *PromptClient) Update() *PromptUpdate {
+	mutation := newPromptMutation(c.config, OpUpdate)
+	return &PromptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PromptClient) UpdateOne(_m *Prompt) *PromptUpdateOne {
+	mutation := newPromptMutation(c.config, OpUpdateOne, withPrompt(_m))
+	return &PromptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PromptClient) UpdateOneID(id int) *PromptUpdateOne {
+	mutation := newPromptMutation(c.config, OpUpdateOne, withPromptID(id))
+	return &PromptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Prompt.
+func (c *PromptClient) Delete() *PromptDelete {
+	mutation := newPromptMutation(c.config, OpDelete)
+	return &PromptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PromptClient) DeleteOne(_m *Prompt) *PromptDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PromptClient) DeleteOneID(id int) *PromptDeleteOne {
+	builder := c.Delete().Where(prompt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PromptDeleteOne{builder}
+}
+
+// Query returns a query builder for Prompt.
+func (c *PromptClient) Query() *PromptQuery {
+	return &PromptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePrompt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Prompt entity by its id.
+func (c *PromptClient) Get(ctx context.Context, id int) (*Prompt, error) {
+	return c.Query().Where(prompt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PromptClient) GetX(ctx context.Context, id int) *Prompt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a Prompt.
+func (c *PromptClient) QueryProject(_m *Prompt) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(prompt.Table, prompt.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, prompt.ProjectTable, prompt.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PromptClient) Hooks() []Hook {
+	hooks := c.hooks.Prompt
+	return append(hooks[:len(hooks):len(hooks)], prompt.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PromptClient) Interceptors() []Interceptor {
+	inters := c.inters.Prompt
+	return append(inters[:len(inters):len(inters)], prompt.Interceptors[:]...)
+}
+
+func (c *PromptClient) mutate(ctx context.Context, m *PromptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PromptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PromptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PromptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PromptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Prompt mutation op: %q", m.Op())
+	}
+}
+
+// PromptProtectionRuleClient is a client for the PromptProtectionRule schema.
+type PromptProtectionRuleClient struct {
+	config
+}
+
+// NewPromptProtectionRuleClient returns a client for the PromptProtectionRule from the given config.
+func NewPromptProtectionRuleClient(c config) *PromptProtectionRuleClient {
+	return &PromptProtectionRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `promptprotectionrule.Hooks(f(g(h())))`.
+func (c *PromptProtectionRuleClient) Use(hooks ...Hook) {
+	c.hooks.PromptProtectionRule = append(c.hooks.PromptProtectionRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `promptprotectionrule.Intercept(f(g(h())))`.
+func (c *PromptProtectionRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PromptProtectionRule = append(c.inters.PromptProtectionRule, interceptors...)
+}
+
+// Create returns a builder for creating a PromptProtectionRule entity.
+func (c *PromptProtectionRuleClient) Create() *PromptProtectionRuleCreate {
+	mutation := newPromptProtectionRuleMutation(c.config, OpCreate)
+	return &PromptProtectionRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PromptProtectionRule entities.
+func (c *PromptProtectionRuleClient) CreateBulk(builders ...*PromptProtectionRuleCreate) *PromptProtectionRuleCreateBulk {
+	return &PromptProtectionRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PromptProtectionRuleClient) MapCreateBulk(slice any, setFunc func(*PromptProtectionRuleCreate, int)) *PromptProtectionRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PromptProtectionRuleCreateBulk{err: fmt.Errorf("calling to PromptProtectionRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PromptProtectionRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PromptProtectionRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PromptProtectionRule.
+func (c *PromptProtectionRuleClient) Update() *PromptProtectionRuleUpdate {
+	mutation := newPromptProtectionRuleMutation(c.config, OpUpdate)
+	return &PromptProtectionRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PromptProtectionRuleClient) UpdateOne(_m *PromptProtectionRule) *PromptProtectionRuleUpdateOne {
+	mutation := newPromptProtectionRuleMutation(c.config, OpUpdateOne, withPromptProtectionRule(_m))
+	return &PromptProtectionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PromptProtectionRuleClient) UpdateOneID(id int) *PromptProtectionRuleUpdateOne {
+	mutation := newPromptProtectionRuleMutation(c.config, OpUpdateOne, withPromptProtectionRuleID(id))
+	return &PromptProtectionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PromptProtectionRule.
+func (c *PromptProtectionRuleClient) Delete() *PromptProtectionRuleDelete {
+	mutation := newPromptProtectionRuleMutation(c.config, OpDelete)
+	return &PromptProtectionRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PromptProtectionRuleClient) DeleteOne(_m *PromptProtectionRule) *PromptProtectionRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PromptProtectionRuleClient) DeleteOneID(id int) *PromptProtectionRuleDeleteOne {
+	builder := c.Delete().Where(promptprotectionrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PromptProtectionRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for PromptProtectionRule.
+func (c *PromptProtectionRuleClient) Query() *PromptProtectionRuleQuery {
+	return &PromptProtectionRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePromptProtectionRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PromptProtectionRule entity by its id.
+func (c *PromptProtectionRuleClient) Get(ctx context.Context, id int) (*PromptProtectionRule, error) {
+	return c.Query().Where(promptprotectionrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PromptProtectionRuleClient) GetX(ctx context.Context, id int) *PromptProtectionRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PromptProtectionRuleClient) Hooks() []Hook {
+	hooks := c.hooks.PromptProtectionRule
+	return append(hooks[:len(hooks):len(hooks)], promptprotectionrule.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PromptProtectionRuleClient) Interceptors() []Interceptor {
+	inters := c.inters.PromptProtectionRule
+	return append(inters[:len(inters):len(inters)], promptprotectionrule.Interceptors[:]...)
+}
+
+func (c *PromptProtectionRuleClient) mutate(ctx context.Context, m *PromptProtectionRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PromptProtectionRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PromptProtectionRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PromptProtectionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PromptProtectionRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PromptProtectionRule mutation op: %q", m.Op())
+	}
+}
+
+// ProviderQuotaStatusClient is a client for the ProviderQuotaStatus schema.
+type ProviderQuotaStatusClient struct {
+	config
+}
+
+// NewProviderQuotaStatusClient returns a client for the ProviderQuotaStatus from the given config.
+func NewProviderQuotaStatusClient(c config) *ProviderQuotaStatusClient {
+	return &ProviderQuotaStatusClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `providerquotastatus.Hooks(f(g(h())))`.
+func (c *ProviderQuotaStatusClient) Use(hooks ...Hook) {
+	c.hooks.ProviderQuotaStatus = append(c.hooks.ProviderQuotaStatus, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `providerquotastatus.Intercept(f(g(h())))`.
+func (c *ProviderQuotaStatusClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProviderQuotaStatus = append(c.inters.ProviderQuotaStatus, interceptors...)
+}
+
+// Create returns a builder for creating a ProviderQuotaStatus entity.
+func (c *ProviderQuotaStatusClient) Create() *ProviderQuotaStatusCreate {
+	mutation := newProviderQuotaStatusMutation(c.config, OpCreate)
+	return &ProviderQuotaStatusCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProviderQuotaStatus entities.
+func (c *ProviderQuotaStatusClient) CreateBulk(builders ...*ProviderQuotaStatusCreate) *ProviderQuotaStatusCreateBulk {
+	return &ProviderQuotaStatusCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProviderQuotaStatusClient) MapCreateBulk(slice any, setFunc func(*ProviderQuotaStatusCreate, int)) *ProviderQuotaStatusCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProviderQuotaStatusCreateBulk{err: fmt.Errorf("calling to ProviderQuotaStatusClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProviderQuotaStatusCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProviderQuotaStatusCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProviderQuotaStatus.
+func (c *ProviderQuotaStatusClient) Update() *ProviderQuotaStatusUpdate {
+	mutation := newProviderQuotaStatusMutation(c.config, OpUpdate)
+	return &ProviderQuotaStatusUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProviderQuotaStatusClient) UpdateOne(_m *ProviderQuotaStatus) *ProviderQuotaStatusUpdateOne {
+	mutation := newProviderQuotaStatusMutation(c.config, OpUpdateOne, withProviderQuotaStatus(_m))
+	return &ProviderQuotaStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProviderQuotaStatusClient) UpdateOneID(id int) *ProviderQuotaStatusUpdateOne {
+	mutation := newProviderQuotaStatusMutation(c.config, OpUpdateOne, withProviderQuotaStatusID(id))
+	return &ProviderQuotaStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProviderQuotaStatus.
+func (c *ProviderQuotaStatusClient) Delete() *ProviderQuotaStatusDelete {
+	mutation := newProviderQuotaStatusMutation(c.config, OpDelete)
+	return &ProviderQuotaStatusDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProviderQuotaStatusClient) DeleteOne(_m *ProviderQuotaStatus) *ProviderQuotaStatusDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProviderQuotaStatusClient) DeleteOneID(id int) *ProviderQuotaStatusDeleteOne {
+	builder := c.Delete().Where(providerquotastatus.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProviderQuotaStatusDeleteOne{builder}
+}
+
+// Query returns a query builder for ProviderQuotaStatus.
+func (c *ProviderQuotaStatusClient) Query() *ProviderQuotaStatusQuery {
+	return &ProviderQuotaStatusQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProviderQuotaStatus},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProviderQuotaStatus entity by its id.
+func (c *ProviderQuotaStatusClient) Get(ctx context.Context, id int) (*ProviderQuotaStatus, error) {
+	return c.Query().Where(providerquotastatus.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProviderQuotaStatusClient) GetX(ctx context.Context, id int) *ProviderQuotaStatus {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryChannel queries the channel edge of a ProviderQuotaStatus.
+func (c *ProviderQuotaStatusClient) QueryChannel(_m *ProviderQuotaStatus) *ChannelQuery {
+	query := (&ChannelClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(providerquotastatus.Table, providerquotastatus.FieldID, id),
+			sqlgraph.To(channel.Table, channel.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, providerquotastatus.ChannelTable, providerquotastatus.ChannelColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProviderQuotaStatusClient) Hooks() []Hook {
+	hooks := c.hooks.ProviderQuotaStatus
+	return append(hooks[:len(hooks):len(hooks)], providerquotastatus.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProviderQuotaStatusClient) Interceptors() []Interceptor {
+	inters := c.inters.ProviderQuotaStatus
+	return append(inters[:len(inters):len(inters)], providerquotastatus.Interceptors[:]...)
+}
+
+func (c *ProviderQuotaStatusClient) mutate(ctx context.Context, m *ProviderQuotaStatusMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProviderQuotaStatusCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProviderQuotaStatusUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProviderQuotaStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProviderQuotaStatusDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProviderQuotaStatus mutation op: %q", m.Op())
+	}
+}
+
+// RequestClient is a client for the Request schema.
+type RequestClient struct {
+	config
+}
+
+// NewRequestClient returns a client for the Request from the given config.
+func NewRequestClient(c config) *RequestClient {
+	return &RequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `request.Hooks(f(g(h())))`.
+func (c *RequestClient) Use(hooks ...Hook) {
+	c.hooks.Request = append(c.hooks.Request, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `request.Intercept(f(g(h())))`.
+func (c *RequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Request = append(c.inters.Request, interceptors...)
+}
+
+// Create returns a builder for creating a Request entity.
+func (c *RequestClient) Create() *RequestCreate {
+	mutation := newRequestMutation(c.config, OpCreate)
+	return &RequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Request entities.
+func (c *RequestClient) CreateBulk(builders ...*RequestCreate) *RequestCreateBulk {
+	return &RequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RequestClient) MapCreateBulk(slice any, setFunc func(*RequestCreate, int)) *RequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RequestCreateBulk{err: fmt.Errorf("calling to RequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Request.
+func (c *RequestClient) Update() *RequestUpdate {
+	mutation := newRequestMutation(c.config, OpUpdate)
+	return &RequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RequestClient) UpdateOne(_m *Request) *RequestUpdateOne {
+	mutation := newRequestMutation(c.config, OpUpdateOne, withRequest(_m))
+	return &RequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RequestClient) UpdateOneID(id int) *RequestUpdateOne {
+	mutation := newRequestMutation(c.config, OpUpdateOne, withRequestID(id))
+	return &RequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Request.
+func (c *RequestClient) Delete() *RequestDelete {
+	mutation := newRequestMutation(c.config, OpDelete)
+	return &RequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RequestClient) DeleteOne(_m *Request) *RequestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RequestClient) DeleteOneID(id int) *RequestDeleteOne {
+	builder := c.Delete().Where(request.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RequestDeleteOne{builder}
+}
+
+// Query returns a query builder for Request.
+func (c *RequestClient) Query() *RequestQuery {
+	return &RequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Request entity by its id.
+func (c *RequestClient) Get(ctx context.Context, id int) (*Request, error) {
+	return c.Query().Where(request.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RequestClient) GetX(ctx context.Context, id int) *Request {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAPIKey queries the api_key edge of a Request.
+func (c *RequestClient) QueryAPIKey(_m *Request) *APIKeyQuery {
+	query := (&APIKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(request.Table, request.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, request.APIKeyTable, request.APIKeyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProject queries the project edge of a Request.
+func (c *RequestClient) QueryProject(_m *Request) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(request.Table, request.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, request.ProjectTable, request.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTrace queries the trace edge of a Request.
+func (c *RequestClient) QueryTrace(_m *Request) *TraceQuery {
+	query := (&TraceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(request.Table, request.FieldID, id),
+			sqlgraph.To(trace.Table, trace.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, request.TraceTable, request.TraceColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDataStorage queries the data_storage edge of a Request.
+func (c *RequestClient) QueryDataStorage(_m *Request) *DataStorageQuery {
+	query := (&DataStorageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(request.Table, request.FieldID, id),
+			sqlgraph.To(datastorage.Table, datastorage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, request.DataStorageTable, request.DataStorageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryExecutions queries the executions edge of a Request.
+func (c *RequestClient) QueryExecutions(_m *Request) *RequestExecutionQuery {
+	query := (&RequestExecutionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(request.Table, request.FieldID, id),
+			sqlgraph.To(requestexecution.Table, requestexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, request.ExecutionsTable, request.ExecutionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChannel queries the channel edge of a Request.
+func (c *RequestClient) QueryChannel(_m *Request) *ChannelQuery {
+	query := (&ChannelClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(request.Table, request.FieldID, id),
+			sqlgraph.To(channel.Table, channel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, request.ChannelTable, request.ChannelColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUsageLogs queries the usage_logs edge of a Request.
+func (c *RequestClient) QueryUsageLogs(_m *Request) *UsageLogQuery {
+	query := (&UsageLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(request.Table, request.FieldID, id),
+			sqlgraph.To(usagelog.Table, usagelog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, request.UsageLogsTable, request.UsageLogsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RequestClient) Hooks() []Hook {
+	hooks := c.hooks.Request
+	return append(hooks[:len(hooks):len(hooks)], request.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RequestClient) Interceptors() []Interceptor {
+	return c.inters.Request
+}
+
+func (c *RequestClient) mutate(ctx context.Context, m *RequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Request mutation op: %q", m.Op())
+	}
+}
+
+// RequestExecutionClient is a client for the RequestExecution schema.
+type RequestExecutionClient struct {
+	config
+}
+
+// NewRequestExecutionClient returns a client for the RequestExecution from the given config.
+func NewRequestExecutionClient(c config) *RequestExecutionClient {
+	return &RequestExecutionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `requestexecution.Hooks(f(g(h())))`.
+func (c *RequestExecutionClient) Use(hooks ...Hook) {
+	c.hooks.RequestExecution = append(c.hooks.RequestExecution, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `requestexecution.Intercept(f(g(h())))`.
+func (c *RequestExecutionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RequestExecution = append(c.inters.RequestExecution, interceptors...)
+}
+
+// Create returns a builder for creating a RequestExecution entity.
+func (c *RequestExecutionClient) Create() *RequestExecutionCreate {
+	mutation := newRequestExecutionMutation(c.config, OpCreate)
+	return &RequestExecutionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RequestExecution entities.
+func (c *RequestExecutionClient) CreateBulk(builders ...*RequestExecutionCreate) *RequestExecutionCreateBulk {
+	return &RequestExecutionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RequestExecutionClient) MapCreateBulk(slice any, setFunc func(*RequestExecutionCreate, int)) *RequestExecutionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RequestExecutionCreateBulk{err: fmt.Errorf("calling to RequestExecutionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RequestExecutionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RequestExecutionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RequestExecution.
+func (c *RequestExecutionClient) Update() *RequestExecutionUpdate {
+	mutation := newRequestExecutionMutation(c.config, OpUpdate)
+	return &RequestExecutionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RequestExecutionClient) UpdateOne(_m *RequestExecution) *RequestExecutionUpdateOne {
+	mutation := newRequestExecutionMutation(c.config, OpUpdateOne, withRequestExecution(_m))
+	return &RequestExecutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RequestExecutionClient) UpdateOneID(id int) *RequestExecutionUpdateOne {
+	mutation := newRequestExecutionMutation(c.config, OpUpdateOne, withRequestExecutionID(id))
+	return &RequestExecutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RequestExecution.
+func (c *RequestExecutionClient) Delete() *RequestExecutionDelete {
+	mutation := newRequestExecutionMutation(c.config, OpDelete)
+	return &RequestExecutionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RequestExecutionClient) DeleteOne(_m *RequestExecution) *RequestExecutionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RequestExecutionClient) DeleteOneID(id int) *RequestExecutionDeleteOne {
+	builder := c.Delete().Where(requestexecution.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RequestExecutionDeleteOne{builder}
+}
+
+// Query returns a query builder for RequestExecution.
+func (c *RequestExecutionClient) Query() *RequestExecutionQuery {
+	return &RequestExecutionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRequestExecution},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RequestExecution entity by its id.
+func (c *RequestExecutionClient) Get(ctx context.Context, id int) (*RequestExecution, error) {
+	return c.Query().Where(requestexecution.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RequestExecutionClient) GetX(ctx context.Context, id int) *RequestExecution {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRequest queries the request edge of a RequestExecution.
+func (c *RequestExecutionClient) QueryRequest(_m *RequestExecution) *RequestQuery {
+	query := (&RequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(requestexecution.Table, requestexecution.FieldID, id),
+			sqlgraph.To(request.Table, request.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, requestexecution.RequestTable, requestexecution.RequestColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2351,15 +4348,15 @@ func (c *ProjectClient) QueryRoles(_m *Project) *RoleQuery {
 	return query
 }
 
-// QueryAPIKeys queries the api_keys edge of a Project.
-func (c *ProjectClient) QueryAPIKeys(_m *Project) *APIKeyQuery {
-	query := (&APIKeyClient{config: c.config}).Query()
+// QueryChannel queries the channel edge of a RequestExecution.
+func (c *RequestExecutionClient) QueryChannel(_m *RequestExecution) *ChannelQuery {
+	query := (&ChannelClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(apikey.Table, apikey.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.APIKeysTable, project.APIKeysColumn),
+			sqlgraph.From(requestexecution.Table, requestexecution.FieldID, id),
+			sqlgraph.To(channel.Table, channel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, requestexecution.ChannelTable, requestexecution.ChannelColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2367,15 +4364,15 @@ func (c *ProjectClient) QueryAPIKeys(_m *Project) *APIKeyQuery {
 	return query
 }
 
-// QueryRequests queries the requests edge of a Project.
-func (c *ProjectClient) QueryRequests(_m *Project) *RequestQuery {
-	query := (&RequestClient{config: c.config}).Query()
+// QueryDataStorage queries the data_storage edge of a RequestExecution.
+func (c *RequestExecutionClient) QueryDataStorage(_m *RequestExecution) *DataStorageQuery {
+	query := (&DataStorageClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(request.Table, request.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.RequestsTable, project.RequestsColumn),
+			sqlgraph.From(requestexecution.Table, requestexecution.FieldID, id),
+			sqlgraph.To(datastorage.Table, datastorage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, requestexecution.DataStorageTable, requestexecution.DataStorageColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2383,63 +4380,282 @@ func (c *ProjectClient) QueryRequests(_m *Project) *RequestQuery {
 	return query
 }
 
-// QueryUsageLogs queries the usage_logs edge of a Project.
-func (c *ProjectClient) QueryUsageLogs(_m *Project) *UsageLogQuery {
-	query := (&UsageLogClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(usagelog.Table, usagelog.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.UsageLogsTable, project.UsageLogsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *RequestExecutionClient) Hooks() []Hook {
+	return c.hooks.RequestExecution
+}
+
+// Interceptors returns the client interceptors.
+func (c *RequestExecutionClient) Interceptors() []Interceptor {
+	return c.inters.RequestExecution
+}
+
+func (c *RequestExecutionClient) mutate(ctx context.Context, m *RequestExecutionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RequestExecutionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RequestExecutionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RequestExecutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RequestExecutionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RequestExecution mutation op: %q", m.Op())
+	}
+}
+
+// RequestSearchTermClient is a client for the RequestSearchTerm schema.
+type RequestSearchTermClient struct {
+	config
+}
+
+// NewRequestSearchTermClient returns a client for the RequestSearchTerm from the given config.
+func NewRequestSearchTermClient(c config) *RequestSearchTermClient {
+	return &RequestSearchTermClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `requestsearchterm.Hooks(f(g(h())))`.
+func (c *RequestSearchTermClient) Use(hooks ...Hook) {
+	c.hooks.RequestSearchTerm = append(c.hooks.RequestSearchTerm, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `requestsearchterm.Intercept(f(g(h())))`.
+func (c *RequestSearchTermClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RequestSearchTerm = append(c.inters.RequestSearchTerm, interceptors...)
+}
+
+// Create returns a builder for creating a RequestSearchTerm entity.
+func (c *RequestSearchTermClient) Create() *RequestSearchTermCreate {
+	mutation := newRequestSearchTermMutation(c.config, OpCreate)
+	return &RequestSearchTermCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RequestSearchTerm entities.
+func (c *RequestSearchTermClient) CreateBulk(builders ...*RequestSearchTermCreate) *RequestSearchTermCreateBulk {
+	return &RequestSearchTermCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RequestSearchTermClient) MapCreateBulk(slice any, setFunc func(*RequestSearchTermCreate, int)) *RequestSearchTermCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RequestSearchTermCreateBulk{err: fmt.Errorf("calling to RequestSearchTermClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RequestSearchTermCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RequestSearchTermCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RequestSearchTerm.
+func (c *RequestSearchTermClient) Update() *RequestSearchTermUpdate {
+	mutation := newRequestSearchTermMutation(c.config, OpUpdate)
+	return &RequestSearchTermUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RequestSearchTermClient) UpdateOne(_m *RequestSearchTerm) *RequestSearchTermUpdateOne {
+	mutation := newRequestSearchTermMutation(c.config, OpUpdateOne, withRequestSearchTerm(_m))
+	return &RequestSearchTermUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RequestSearchTermClient) UpdateOneID(id int) *RequestSearchTermUpdateOne {
+	mutation := newRequestSearchTermMutation(c.config, OpUpdateOne, withRequestSearchTermID(id))
+	return &RequestSearchTermUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RequestSearchTerm.
+func (c *RequestSearchTermClient) Delete() *RequestSearchTermDelete {
+	mutation := newRequestSearchTermMutation(c.config, OpDelete)
+	return &RequestSearchTermDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RequestSearchTermClient) DeleteOne(_m *RequestSearchTerm) *RequestSearchTermDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RequestSearchTermClient) DeleteOneID(id int) *RequestSearchTermDeleteOne {
+	builder := c.Delete().Where(requestsearchterm.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RequestSearchTermDeleteOne{builder}
+}
+
+// Query returns a query builder for RequestSearchTerm.
+func (c *RequestSearchTermClient) Query() *RequestSearchTermQuery {
+	return &RequestSearchTermQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRequestSearchTerm},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RequestSearchTerm entity by its id.
+func (c *RequestSearchTermClient) Get(ctx context.Context, id int) (*RequestSearchTerm, error) {
+	return c.Query().Where(requestsearchterm.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RequestSearchTermClient) GetX(ctx context.Context, id int) *RequestSearchTerm {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RequestSearchTermClient) Hooks() []Hook {
+	hooks := c.hooks.RequestSearchTerm
+	return append(hooks[:len(hooks):len(hooks)], requestsearchterm.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RequestSearchTermClient) Interceptors() []Interceptor {
+	return c.inters.RequestSearchTerm
+}
+
+func (c *RequestSearchTermClient) mutate(ctx context.Context, m *RequestSearchTermMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RequestSearchTermCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RequestSearchTermUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RequestSearchTermUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RequestSearchTermDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RequestSearchTerm mutation op: %q", m.Op())
+	}
+}
+
+// RoleClient is a client for the Role schema.
+type RoleClient struct {
+	config
+}
+
+// NewRoleClient returns a client for the Role from the given config.
+func NewRoleClient(c config) *RoleClient {
+	return &RoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
+func (c *RoleClient) Use(hooks ...Hook) {
+	c.hooks.Role = append(c.hooks.Role, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
+func (c *RoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Role = append(c.inters.Role, interceptors...)
+}
+
+// Create returns a builder for creating a Role entity.
+func (c *RoleClient) Create() *RoleCreate {
+	mutation := newRoleMutation(c.config, OpCreate)
+	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Role entities.
+func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Role.
+func (c *RoleClient) Update() *RoleUpdate {
+	mutation := newRoleMutation(c.config, OpUpdate)
+	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleClient) UpdateOne(_m *Role) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(_m))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleClient) UpdateOneID(id int) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Role.
+func (c *RoleClient) Delete() *RoleDelete {
+	mutation := newRoleMutation(c.config, OpDelete)
+	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleClient) DeleteOne(_m *Role) *RoleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleClient) DeleteOneID(id int) *RoleDeleteOne {
+	builder := c.Delete().Where(role.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleDeleteOne{builder}
 }
 
-// QueryThreads queries the threads edge of a Project.
-func (c *ProjectClient) QueryThreads(_m *Project) *ThreadQuery {
-	query := (&ThreadClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(thread.Table, thread.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.ThreadsTable, project.ThreadsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Query returns a query builder for Role.
+func (c *RoleClient) Query() *RoleQuery {
+	return &RoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRole},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryTraces queries the traces edge of a Project.
-func (c *ProjectClient) QueryTraces(_m *Project) *TraceQuery {
-	query := (&TraceClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(trace.Table, trace.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.TracesTable, project.TracesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a Role entity by its id.
+func (c *RoleClient) Get(ctx context.Context, id int) (*Role, error) {
+	return c.Query().Where(role.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleClient) GetX(ctx context.Context, id int) *Role {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryPrompts queries the prompts edge of a Project.
-func (c *ProjectClient) QueryPrompts(_m *Project) *PromptQuery {
-	query := (&PromptClient{config: c.config}).Query()
+// QueryUsers queries the users edge of a Role.
+func (c *RoleClient) QueryUsers(_m *Role) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(prompt.Table, prompt.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.PromptsTable, project.PromptsColumn),
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, role.UsersTable, role.UsersPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2447,15 +4663,15 @@ func (c *ProjectClient) QueryPrompts(_m *Project) *PromptQuery {
 	return query
 }
 
-// QueryAPIKeyProfileTemplates queries the api_key_profile_templates edge of a Project.
-func (c *ProjectClient) QueryAPIKeyProfileTemplates(_m *Project) *APIKeyProfileTemplateQuery {
-	query := (&APIKeyProfileTemplateClient{config: c.config}).Query()
+// QueryProject queries the project edge of a Role.
+func (c *RoleClient) QueryProject(_m *Role) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(apikeyprofiletemplate.Table, apikeyprofiletemplate.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.APIKeyProfileTemplatesTable, project.APIKeyProfileTemplatesColumn),
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, role.ProjectTable, role.ProjectColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2463,15 +4679,15 @@ func (c *ProjectClient) QueryAPIKeyProfileTemplates(_m *Project) *APIKeyProfileT
 	return query
 }
 
-// QueryProjectUsers queries the project_users edge of a Project.
-func (c *ProjectClient) QueryProjectUsers(_m *Project) *UserProjectQuery {
-	query := (&UserProjectClient{config: c.config}).Query()
+// QueryUserRoles queries the user_roles edge of a Role.
+func (c *RoleClient) QueryUserRoles(_m *Role) *UserRoleQuery {
+	query := (&UserRoleClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(userproject.Table, userproject.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, project.ProjectUsersTable, project.ProjectUsersColumn),
+			sqlgraph.From(role.Table, role.FieldID, id),
+			sqlgraph.To(userrole.Table, userrole.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, role.UserRolesTable, role.UserRolesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2480,133 +4696,133 @@ func (c *ProjectClient) QueryProjectUsers(_m *Project) *UserProjectQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ProjectClient) Hooks() []Hook {
-	hooks := c.hooks.Project
-	return append(hooks[:len(hooks):len(hooks)], project.Hooks[:]...)
+func (c *RoleClient) Hooks() []Hook {
+	hooks := c.hooks.Role
+	return append(hooks[:len(hooks):len(hooks)], role.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProjectClient) Interceptors() []Interceptor {
-	inters := c.inters.Project
-	return append(inters[:len(inters):len(inters)], project.Interceptors[:]...)
+func (c *RoleClient) Interceptors() []Interceptor {
+	inters := c.inters.Role
+	return append(inters[:len(inters):len(inters)], role.Interceptors[:]...)
 }
 
-func (c *ProjectClient) mutate(ctx context.Context, m *ProjectMutation) (Value, error) {
+func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Project mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
 	}
 }
 
-// PromptClient is a client for the Prompt schema.
-type PromptClient struct {
+// SCIMGroupClient is a client for the SCIMGroup schema.
+type SCIMGroupClient struct {
 	config
 }
 
-// NewPromptClient returns a client for the Prompt from the given config.
-func NewPromptClient(c config) *PromptClient {
-	return &PromptClient{config: c}
+// NewSCIMGroupClient returns a client for the SCIMGroup from the given config.
+func NewSCIMGroupClient(c config) *SCIMGroupClient {
+	return &SCIMGroupClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `prompt.Hooks(f(g(h())))`.
-func (c *PromptClient) Use(hooks ...Hook) {
-	c.hooks.Prompt = append(c.hooks.Prompt, hooks...)
+// A call to `Use(f, g, h)` equals to `scimgroup.Hooks(f(g(h())))`.
+func (c *SCIMGroupClient) Use(hooks ...Hook) {
+	c.hooks.SCIMGroup = append(c.hooks.SCIMGroup, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `prompt.Intercept(f(g(h())))`.
-func (c *PromptClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Prompt = append(c.inters.Prompt, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `scimgroup.Intercept(f(g(h())))`.
+func (c *SCIMGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SCIMGroup = append(c.inters.SCIMGroup, interceptors...)
 }
 
-// Create returns a builder for creating a Prompt entity.
-func (c *PromptClient) Create() *PromptCreate {
-	mutation := newPromptMutation(c.config, OpCreate)
-	return &PromptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SCIMGroup entity.
+func (c *SCIMGroupClient) Create() *SCIMGroupCreate {
+	mutation := newSCIMGroupMutation(c.config, OpCreate)
+	return &SCIMGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Prompt entities.
-func (c *PromptClient) CreateBulk(builders ...*PromptCreate) *PromptCreateBulk {
-	return &PromptCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SCIMGroup entities.
+func (c *SCIMGroupClient) CreateBulk(builders ...*SCIMGroupCreate) *SCIMGroupCreateBulk {
+	return &SCIMGroupCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PromptClient) MapCreateBulk(slice any, setFunc func(*PromptCreate, int)) *PromptCreateBulk {
+func (c *SCIMGroupClient) MapCreateBulk(slice any, setFunc func(*SCIMGroupCreate, int)) *SCIMGroupCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PromptCreateBulk{err: fmt.Errorf("calling to PromptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SCIMGroupCreateBulk{err: fmt.Errorf("calling to SCIMGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PromptCreate, rv.Len())
+	builders := make([]*SCIMGroupCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PromptCreateBulk{config: c.config, builders: builders}
+	return &SCIMGroupCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Prompt.
-func (c *PromptClient) Update() *PromptUpdate {
-	mutation := newPromptMutation(c.config, OpUpdate)
-	return &PromptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SCIMGroup.
+func (c *SCIMGroupClient) Update() *SCIMGroupUpdate {
+	mutation := newSCIMGroupMutation(c.config, OpUpdate)
+	return &SCIMGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PromptClient) UpdateOne(_m *Prompt) *PromptUpdateOne {
-	mutation := newPromptMutation(c.config, OpUpdateOne, withPrompt(_m))
-	return &PromptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SCIMGroupClient) UpdateOne(_m *SCIMGroup) *SCIMGroupUpdateOne {
+	mutation := newSCIMGroupMutation(c.config, OpUpdateOne, withSCIMGroup(_m))
+	return &SCIMGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PromptClient) UpdateOneID(id int) *PromptUpdateOne {
-	mutation := newPromptMutation(c.config, OpUpdateOne, withPromptID(id))
-	return &PromptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SCIMGroupClient) UpdateOneID(id int) *SCIMGroupUpdateOne {
+	mutation := newSCIMGroupMutation(c.config, OpUpdateOne, withSCIMGroupID(id))
+	return &SCIMGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Prompt.
-func (c *PromptClient) Delete() *PromptDelete {
-	mutation := newPromptMutation(c.config, OpDelete)
-	return &PromptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SCIMGroup.
+func (c *SCIMGroupClient) Delete() *SCIMGroupDelete {
+	mutation := newSCIMGroupMutation(c.config, OpDelete)
+	return &SCIMGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PromptClient) DeleteOne(_m *Prompt) *PromptDeleteOne {
+func (c *SCIMGroupClient) DeleteOne(_m *SCIMGroup) *SCIMGroupDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PromptClient) DeleteOneID(id int) *PromptDeleteOne {
-	builder := c.Delete().Where(prompt.ID(id))
+func (c *SCIMGroupClient) DeleteOneID(id int) *SCIMGroupDeleteOne {
+	builder := c.Delete().Where(scimgroup.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PromptDeleteOne{builder}
+	return &SCIMGroupDeleteOne{builder}
 }
 
-// Query returns a query builder for Prompt.
-func (c *PromptClient) Query() *PromptQuery {
-	return &PromptQuery{
+// Query returns a query builder for SCIMGroup.
+func (c *SCIMGroupClient) Query() *SCIMGroupQuery {
+	return &SCIMGroupQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePrompt},
+		ctx:    &QueryContext{Type: TypeSCIMGroup},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Prompt entity by its id.
-func (c *PromptClient) Get(ctx context.Context, id int) (*Prompt, error) {
-	return c.Query().Where(prompt.ID(id)).Only(ctx)
+// Get returns a SCIMGroup entity by its id.
+func (c *SCIMGroupClient) Get(ctx context.Context, id int) (*SCIMGroup, error) {
+	return c.Query().Where(scimgroup.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PromptClient) GetX(ctx context.Context, id int) *Prompt {
+func (c *SCIMGroupClient) GetX(ctx context.Context, id int) *SCIMGroup {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2614,15 +4830,15 @@ func (c *PromptClient) GetX(ctx context.Context, id int) *Prompt {
 	return obj
 }
 
-// QueryProject queries the project edge of a Prompt.
-func (c *PromptClient) QueryProject(_m *Prompt) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
+// QueryUsers queries the users edge of a SCIMGroup.
+func (c *SCIMGroupClient) QueryUsers(_m *SCIMGroup) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(prompt.Table, prompt.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, prompt.ProjectTable, prompt.ProjectColumn),
+			sqlgraph.From(scimgroup.Table, scimgroup.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, scimgroup.UsersTable, scimgroup.UsersPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2631,133 +4847,132 @@ func (c *PromptClient) QueryProject(_m *Prompt) *ProjectQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *PromptClient) Hooks() []Hook {
-	hooks := c.hooks.Prompt
-	return append(hooks[:len(hooks):len(hooks)], prompt.Hooks[:]...)
+func (c *SCIMGroupClient) Hooks() []Hook {
+	hooks := c.hooks.SCIMGroup
+	return append(hooks[:len(hooks):len(hooks)], scimgroup.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *PromptClient) Interceptors() []Interceptor {
-	inters := c.inters.Prompt
-	return append(inters[:len(inters):len(inters)], prompt.Interceptors[:]...)
+func (c *SCIMGroupClient) Interceptors() []Interceptor {
+	return c.inters.SCIMGroup
 }
 
-func (c *PromptClient) mutate(ctx context.Context, m *PromptMutation) (Value, error) {
+func (c *SCIMGroupClient) mutate(ctx context.Context, m *SCIMGroupMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PromptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SCIMGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PromptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SCIMGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PromptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SCIMGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PromptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SCIMGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Prompt mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SCIMGroup mutation op: %q", m.Op())
 	}
 }
 
-// PromptProtectionRuleClient is a client for the PromptProtectionRule schema.
-type PromptProtectionRuleClient struct {
+// SystemClient is a client for the System schema.
+type SystemClient struct {
 	config
 }
 
-// NewPromptProtectionRuleClient returns a client for the PromptProtectionRule from the given config.
-func NewPromptProtectionRuleClient(c config) *PromptProtectionRuleClient {
-	return &PromptProtectionRuleClient{config: c}
+// NewSystemClient returns a client for the System from the given config.
+func NewSystemClient(c config) *SystemClient {
+	return &SystemClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `promptprotectionrule.Hooks(f(g(h())))`.
-func (c *PromptProtectionRuleClient) Use(hooks ...Hook) {
-	c.hooks.PromptProtectionRule = append(c.hooks.PromptProtectionRule, hooks...)
+// A call to `Use(f, g, h)` equals to `system.Hooks(f(g(h())))`.
+func (c *SystemClient) Use(hooks ...Hook) {
+	c.hooks.System = append(c.hooks.System, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `promptprotectionrule.Intercept(f(g(h())))`.
-func (c *PromptProtectionRuleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PromptProtectionRule = append(c.inters.PromptProtectionRule, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `system.Intercept(f(g(h())))`.
+func (c *SystemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.System = append(c.inters.System, interceptors...)
 }
 
-// Create returns a builder for creating a PromptProtectionRule entity.
-func (c *PromptProtectionRuleClient) Create() *PromptProtectionRuleCreate {
-	mutation := newPromptProtectionRuleMutation(c.config, OpCreate)
-	return &PromptProtectionRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a System entity.
+func (c *SystemClient) Create() *SystemCreate {
+	mutation := newSystemMutation(c.config, OpCreate)
+	return &SystemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PromptProtectionRule entities.
-func (c *PromptProtectionRuleClient) CreateBulk(builders ...*PromptProtectionRuleCreate) *PromptProtectionRuleCreateBulk {
-	return &PromptProtectionRuleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of System entities.
+func (c *SystemClient) CreateBulk(builders ...*SystemCreate) *SystemCreateBulk {
+	return &SystemCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PromptProtectionRuleClient) MapCreateBulk(slice any, setFunc func(*PromptProtectionRuleCreate, int)) *PromptProtectionRuleCreateBulk {
+func (c *SystemClient) MapCreateBulk(slice any, setFunc func(*SystemCreate, int)) *SystemCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PromptProtectionRuleCreateBulk{err: fmt.Errorf("calling to PromptProtectionRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SystemCreateBulk{err: fmt.Errorf("calling to SystemClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PromptProtectionRuleCreate, rv.Len())
+	builders := make([]*SystemCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PromptProtectionRuleCreateBulk{config: c.config, builders: builders}
+	return &SystemCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PromptProtectionRule.
-func (c *PromptProtectionRuleClient) Update() *PromptProtectionRuleUpdate {
-	mutation := newPromptProtectionRuleMutation(c.config, OpUpdate)
-	return &PromptProtectionRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for System.
+func (c *SystemClient) Update() *SystemUpdate {
+	mutation := newSystemMutation(c.config, OpUpdate)
+	return &SystemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PromptProtectionRuleClient) UpdateOne(_m *PromptProtectionRule) *PromptProtectionRuleUpdateOne {
-	mutation := newPromptProtectionRuleMutation(c.config, OpUpdateOne, withPromptProtectionRule(_m))
-	return &PromptProtectionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SystemClient) UpdateOne(_m *System) *SystemUpdateOne {
+	mutation := newSystemMutation(c.config, OpUpdateOne, withSystem(_m))
+	return &SystemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PromptProtectionRuleClient) UpdateOneID(id int) *PromptProtectionRuleUpdateOne {
-	mutation := newPromptProtectionRuleMutation(c.config, OpUpdateOne, withPromptProtectionRuleID(id))
-	return &PromptProtectionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SystemClient) UpdateOneID(id int) *SystemUpdateOne {
+	mutation := newSystemMutation(c.config, OpUpdateOne, withSystemID(id))
+	return &SystemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PromptProtectionRule.
-func (c *PromptProtectionRuleClient) Delete() *PromptProtectionRuleDelete {
-	mutation := newPromptProtectionRuleMutation(c.config, OpDelete)
-	return &PromptProtectionRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for System.
+func (c *SystemClient) Delete() *SystemDelete {
+	mutation := newSystemMutation(c.config, OpDelete)
+	return &SystemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PromptProtectionRuleClient) DeleteOne(_m *PromptProtectionRule) *PromptProtectionRuleDeleteOne {
+func (c *SystemClient) DeleteOne(_m *System) *SystemDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PromptProtectionRuleClient) DeleteOneID(id int) *PromptProtectionRuleDeleteOne {
-	builder := c.Delete().Where(promptprotectionrule.ID(id))
+func (c *SystemClient) DeleteOneID(id int) *SystemDeleteOne {
+	builder := c.Delete().Where(system.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PromptProtectionRuleDeleteOne{builder}
+	return &SystemDeleteOne{builder}
 }
 
-// Query returns a query builder for PromptProtectionRule.
-func (c *PromptProtectionRuleClient) Query() *PromptProtectionRuleQuery {
-	return &PromptProtectionRuleQuery{
+// Query returns a query builder for System.
+func (c *SystemClient) Query() *SystemQuery {
+	return &SystemQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePromptProtectionRule},
+		ctx:    &QueryContext{Type: TypeSystem},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PromptProtectionRule entity by its id.
-func (c *PromptProtectionRuleClient) Get(ctx context.Context, id int) (*PromptProtectionRule, error) {
-	return c.Query().Where(promptprotectionrule.ID(id)).Only(ctx)
+// Get returns a System entity by its id.
+func (c *SystemClient) Get(ctx context.Context, id int) (*System, error) {
+	return c.Query().Where(system.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PromptProtectionRuleClient) GetX(ctx context.Context, id int) *PromptProtectionRule {
+func (c *SystemClient) GetX(ctx context.Context, id int) *System {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2766,133 +4981,133 @@ func (c *PromptProtectionRuleClient) GetX(ctx context.Context, id int) *PromptPr
 }
 
 // Hooks returns the client hooks.
-func (c *PromptProtectionRuleClient) Hooks() []Hook {
-	hooks := c.hooks.PromptProtectionRule
-	return append(hooks[:len(hooks):len(hooks)], promptprotectionrule.Hooks[:]...)
+func (c *SystemClient) Hooks() []Hook {
+	hooks := c.hooks.System
+	return append(hooks[:len(hooks):len(hooks)], system.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *PromptProtectionRuleClient) Interceptors() []Interceptor {
-	inters := c.inters.PromptProtectionRule
-	return append(inters[:len(inters):len(inters)], promptprotectionrule.Interceptors[:]...)
+func (c *SystemClient) Interceptors() []Interceptor {
+	inters := c.inters.System
+	return append(inters[:len(inters):len(inters)], system.Interceptors[:]...)
 }
 
-func (c *PromptProtectionRuleClient) mutate(ctx context.Context, m *PromptProtectionRuleMutation) (Value, error) {
+func (c *SystemClient) mutate(ctx context.Context, m *SystemMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PromptProtectionRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SystemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PromptProtectionRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SystemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PromptProtectionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SystemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PromptProtectionRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SystemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PromptProtectionRule mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown System mutation op: %q", m.Op())
 	}
 }
 
-// ProviderQuotaStatusClient is a client for the ProviderQuotaStatus schema.
-type ProviderQuotaStatusClient struct {
+// ThreadClient is a client for the Thread schema.
+type ThreadClient struct {
 	config
 }
 
-// NewProviderQuotaStatusClient returns a client for the ProviderQuotaStatus from the given config.
-func NewProviderQuotaStatusClient(c config) *ProviderQuotaStatusClient {
-	return &ProviderQuotaStatusClient{config: c}
+// NewThreadClient returns a client for the Thread from the given config.
+func NewThreadClient(c config) *ThreadClient {
+	return &ThreadClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `providerquotastatus.Hooks(f(g(h())))`.
-func (c *ProviderQuotaStatusClient) Use(hooks ...Hook) {
-	c.hooks.ProviderQuotaStatus = append(c.hooks.ProviderQuotaStatus, hooks...)
+// A call to `Use(f, g, h)` equals to `thread.Hooks(f(g(h())))`.
+func (c *ThreadClient) Use(hooks ...Hook) {
+	c.hooks.Thread = append(c.hooks.Thread, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `providerquotastatus.Intercept(f(g(h())))`.
-func (c *ProviderQuotaStatusClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ProviderQuotaStatus = append(c.inters.ProviderQuotaStatus, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `thread.Intercept(f(g(h())))`.
+func (c *ThreadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Thread = append(c.inters.Thread, interceptors...)
 }
 
-// Create returns a builder for creating a ProviderQuotaStatus entity.
-func (c *ProviderQuotaStatusClient) Create() *ProviderQuotaStatusCreate {
-	mutation := newProviderQuotaStatusMutation(c.config, OpCreate)
-	return &ProviderQuotaStatusCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Thread entity.
+func (c *ThreadClient) Create() *ThreadCreate {
+	mutation := newThreadMutation(c.config, OpCreate)
+	return &ThreadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ProviderQuotaStatus entities.
-func (c *ProviderQuotaStatusClient) CreateBulk(builders ...*ProviderQuotaStatusCreate) *ProviderQuotaStatusCreateBulk {
-	return &ProviderQuotaStatusCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Thread entities.
+func (c *ThreadClient) CreateBulk(builders ...*ThreadCreate) *ThreadCreateBulk {
+	return &ThreadCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProviderQuotaStatusClient) MapCreateBulk(slice any, setFunc func(*ProviderQuotaStatusCreate, int)) *ProviderQuotaStatusCreateBulk {
+func (c *ThreadClient) MapCreateBulk(slice any, setFunc func(*ThreadCreate, int)) *ThreadCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProviderQuotaStatusCreateBulk{err: fmt.Errorf("calling to ProviderQuotaStatusClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ThreadCreateBulk{err: fmt.Errorf("calling to ThreadClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProviderQuotaStatusCreate, rv.Len())
+	builders := make([]*ThreadCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProviderQuotaStatusCreateBulk{config: c.config, builders: builders}
+	return &ThreadCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ProviderQuotaStatus.
-func (c *ProviderQuotaStatusClient) Update() *ProviderQuotaStatusUpdate {
-	mutation := newProviderQuotaStatusMutation(c.config, OpUpdate)
-	return &ProviderQuotaStatusUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Thread.
+func (c *ThreadClient) Update() *ThreadUpdate {
+	mutation := newThreadMutation(c.config, OpUpdate)
+	return &ThreadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProviderQuotaStatusClient) UpdateOne(_m *ProviderQuotaStatus) *ProviderQuotaStatusUpdateOne {
-	mutation := newProviderQuotaStatusMutation(c.config, OpUpdateOne, withProviderQuotaStatus(_m))
-	return &ProviderQuotaStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ThreadClient) UpdateOne(_m *Thread) *ThreadUpdateOne {
+	mutation := newThreadMutation(c.config, OpUpdateOne, withThread(_m))
+	return &ThreadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProviderQuotaStatusClient) UpdateOneID(id int) *ProviderQuotaStatusUpdateOne {
-	mutation := newProviderQuotaStatusMutation(c.config, OpUpdateOne, withProviderQuotaStatusID(id))
-	return &ProviderQuotaStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ThreadClient) UpdateOneID(id int) *ThreadUpdateOne {
+	mutation := newThreadMutation(c.config, OpUpdateOne, withThreadID(id))
+	return &ThreadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ProviderQuotaStatus.
-func (c *ProviderQuotaStatusClient) Delete() *ProviderQuotaStatusDelete {
-	mutation := newProviderQuotaStatusMutation(c.config, OpDelete)
-	return &ProviderQuotaStatusDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Thread.
+func (c *ThreadClient) Delete() *ThreadDelete {
+	mutation := newThreadMutation(c.config, OpDelete)
+	return &ThreadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProviderQuotaStatusClient) DeleteOne(_m *ProviderQuotaStatus) *ProviderQuotaStatusDeleteOne {
+func (c *ThreadClient) DeleteOne(_m *Thread) *ThreadDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProviderQuotaStatusClient) DeleteOneID(id int) *ProviderQuotaStatusDeleteOne {
-	builder := c.Delete().Where(providerquotastatus.ID(id))
+func (c *ThreadClient) DeleteOneID(id int) *ThreadDeleteOne {
+	builder := c.Delete().Where(thread.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProviderQuotaStatusDeleteOne{builder}
+	return &ThreadDeleteOne{builder}
 }
 
-// Query returns a query builder for ProviderQuotaStatus.
-func (c *ProviderQuotaStatusClient) Query() *ProviderQuotaStatusQuery {
-	return &ProviderQuotaStatusQuery{
+// Query returns a query builder for Thread.
+func (c *ThreadClient) Query() *ThreadQuery {
+	return &ThreadQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProviderQuotaStatus},
+		ctx:    &QueryContext{Type: TypeThread},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ProviderQuotaStatus entity by its id.
-func (c *ProviderQuotaStatusClient) Get(ctx context.Context, id int) (*ProviderQuotaStatus, error) {
-	return c.Query().Where(providerquotastatus.ID(id)).Only(ctx)
+// Get returns a Thread entity by its id.
+func (c *ThreadClient) Get(ctx context.Context, id int) (*Thread, error) {
+	return c.Query().Where(thread.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProviderQuotaStatusClient) GetX(ctx context.Context, id int) *ProviderQuotaStatus {
+func (c *ThreadClient) GetX(ctx context.Context, id int) *Thread {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2900,15 +5115,31 @@ func (c *ProviderQuotaStatusClient) GetX(ctx context.Context, id int) *ProviderQ
 	return obj
 }
 
-// QueryChannel queries the channel edge of a ProviderQuotaStatus.
-func (c *ProviderQuotaStatusClient) QueryChannel(_m *ProviderQuotaStatus) *ChannelQuery {
-	query := (&ChannelClient{config: c.config}).Query()
+// QueryProject queries the project edge of a Thread.
+func (c *ThreadClient) QueryProject(_m *Thread) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(providerquotastatus.Table, providerquotastatus.FieldID, id),
-			sqlgraph.To(channel.Table, channel.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, providerquotastatus.ChannelTable, providerquotastatus.ChannelColumn),
+			sqlgraph.From(thread.Table, thread.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, thread.ProjectTable, thread.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTraces queries the traces edge of a Thread.
+func (c *ThreadClient) QueryTraces(_m *Thread) *TraceQuery {
+	query := (&TraceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(thread.Table, thread.FieldID, id),
+			sqlgraph.To(trace.Table, trace.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, thread.TracesTable, thread.TracesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2917,133 +5148,132 @@ func (c *ProviderQuotaStatusClient) QueryChannel(_m *ProviderQuotaStatus) *Chann
 }
 
 // Hooks returns the client hooks.
-func (c *ProviderQuotaStatusClient) Hooks() []Hook {
-	hooks := c.hooks.ProviderQuotaStatus
-	return append(hooks[:len(hooks):len(hooks)], providerquotastatus.Hooks[:]...)
+func (c *ThreadClient) Hooks() []Hook {
+	hooks := c.hooks.Thread
+	return append(hooks[:len(hooks):len(hooks)], thread.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProviderQuotaStatusClient) Interceptors() []Interceptor {
-	inters := c.inters.ProviderQuotaStatus
-	return append(inters[:len(inters):len(inters)], providerquotastatus.Interceptors[:]...)
+func (c *ThreadClient) Interceptors() []Interceptor {
+	return c.inters.Thread
 }
 
-func (c *ProviderQuotaStatusClient) mutate(ctx context.Context, m *ProviderQuotaStatusMutation) (Value, error) {
+func (c *ThreadClient) mutate(ctx context.Context, m *ThreadMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProviderQuotaStatusCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ThreadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProviderQuotaStatusUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ThreadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProviderQuotaStatusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ThreadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProviderQuotaStatusDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ThreadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ProviderQuotaStatus mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Thread mutation op: %q", m.Op())
 	}
 }
 
-// RequestClient is a client for the Request schema.
-type RequestClient struct {
+// TraceClient is a client for the Trace schema.
+type TraceClient struct {
 	config
 }
 
-// NewRequestClient returns a client for the Request from the given config.
-func NewRequestClient(c config) *RequestClient {
-	return &RequestClient{config: c}
+// NewTraceClient returns a client for the Trace from the given config.
+func NewTraceClient(c config) *TraceClient {
+	return &TraceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `request.Hooks(f(g(h())))`.
-func (c *RequestClient) Use(hooks ...Hook) {
-	c.hooks.Request = append(c.hooks.Request, hooks...)
+// A call to `Use(f, g, h)` equals to `trace.Hooks(f(g(h())))`.
+func (c *TraceClient) Use(hooks ...Hook) {
+	c.hooks.Trace = append(c.hooks.Trace, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `request.Intercept(f(g(h())))`.
-func (c *RequestClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Request = append(c.inters.Request, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `trace.Intercept(f(g(h())))`.
+func (c *TraceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Trace = append(c.inters.Trace, interceptors...)
 }
 
-// Create returns a builder for creating a Request entity.
-func (c *RequestClient) Create() *RequestCreate {
-	mutation := newRequestMutation(c.config, OpCreate)
-	return &RequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Trace entity.
+func (c *TraceClient) Create() *TraceCreate {
+	mutation := newTraceMutation(c.config, OpCreate)
+	return &TraceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Request entities.
-func (c *RequestClient) CreateBulk(builders ...*RequestCreate) *RequestCreateBulk {
-	return &RequestCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Trace entities.
+func (c *TraceClient) CreateBulk(builders ...*TraceCreate) *TraceCreateBulk {
+	return &TraceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RequestClient) MapCreateBulk(slice any, setFunc func(*RequestCreate, int)) *RequestCreateBulk {
+func (c *TraceClient) MapCreateBulk(slice any, setFunc func(*TraceCreate, int)) *TraceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RequestCreateBulk{err: fmt.Errorf("calling to RequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &TraceCreateBulk{err: fmt.Errorf("calling to TraceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RequestCreate, rv.Len())
+	builders := make([]*TraceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RequestCreateBulk{config: c.config, builders: builders}
-}
-
-// Update returns an update builder for Request.
-func (c *RequestClient) Update() *RequestUpdate {
-	mutation := newRequestMutation(c.config, OpUpdate)
-	return &RequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+	return &TraceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Trace.
+func (c *TraceClient) Update() *TraceUpdate {
+	mutation := newTraceMutation(c.config, OpUpdate)
+	return &TraceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RequestClient) UpdateOne(_m *Request) *RequestUpdateOne {
-	mutation := newRequestMutation(c.config, OpUpdateOne, withRequest(_m))
-	return &RequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TraceClient) UpdateOne(_m *Trace) *TraceUpdateOne {
+	mutation := newTraceMutation(c.config, OpUpdateOne, withTrace(_m))
+	return &TraceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RequestClient) UpdateOneID(id int) *RequestUpdateOne {
-	mutation := newRequestMutation(c.config, OpUpdateOne, withRequestID(id))
-	return &RequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TraceClient) UpdateOneID(id int) *TraceUpdateOne {
+	mutation := newTraceMutation(c.config, OpUpdateOne, withTraceID(id))
+	return &TraceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Request.
-func (c *RequestClient) Delete() *RequestDelete {
-	mutation := newRequestMutation(c.config, OpDelete)
-	return &RequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Trace.
+func (c *TraceClient) Delete() *TraceDelete {
+	mutation := newTraceMutation(c.config, OpDelete)
+	return &TraceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RequestClient) DeleteOne(_m *Request) *RequestDeleteOne {
+func (c *TraceClient) DeleteOne(_m *Trace) *TraceDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RequestClient) DeleteOneID(id int) *RequestDeleteOne {
-	builder := c.Delete().Where(request.ID(id))
+func (c *TraceClient) DeleteOneID(id int) *TraceDeleteOne {
+	builder := c.Delete().Where(trace.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RequestDeleteOne{builder}
+	return &TraceDeleteOne{builder}
 }
 
-// Query returns a query builder for Request.
-func (c *RequestClient) Query() *RequestQuery {
-	return &RequestQuery{
+// Query returns a query builder for Trace.
+func (c *TraceClient) Query() *TraceQuery {
+	return &TraceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRequest},
+		ctx:    &QueryContext{Type: TypeTrace},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Request entity by its id.
-func (c *RequestClient) Get(ctx context.Context, id int) (*Request, error) {
-	return c.Query().Where(request.ID(id)).Only(ctx)
+// Get returns a Trace entity by its id.
+func (c *TraceClient) Get(ctx context.Context, id int) (*Trace, error) {
+	return c.Query().Where(trace.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RequestClient) GetX(ctx context.Context, id int) *Request {
+func (c *TraceClient) GetX(ctx context.Context, id int) *Trace {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3051,79 +5281,15 @@ func (c *RequestClient) GetX(ctx context.Context, id int) *Request {
 	return obj
 }
 
-// QueryAPIKey queries the api_key edge of a Request.
-func (c *RequestClient) QueryAPIKey(_m *Request) *APIKeyQuery {
-	query := (&APIKeyClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(request.Table, request.FieldID, id),
-			sqlgraph.To(apikey.Table, apikey.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, request.APIKeyTable, request.APIKeyColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProject queries the project edge of a Request.
-func (c *RequestClient) QueryProject(_m *Request) *ProjectQuery {
+// QueryProject queries the project edge of a Trace.
+func (c *TraceClient) QueryProject(_m *Trace) *ProjectQuery {
 	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(request.Table, request.FieldID, id),
+			sqlgraph.From(trace.Table, trace.FieldID, id),
 			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, request.ProjectTable, request.ProjectColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTrace queries the trace edge of a Request.
-func (c *RequestClient) QueryTrace(_m *Request) *TraceQuery {
-	query := (&TraceClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(request.Table, request.FieldID, id),
-			sqlgraph.To(trace.Table, trace.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, request.TraceTable, request.TraceColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryDataStorage queries the data_storage edge of a Request.
-func (c *RequestClient) QueryDataStorage(_m *Request) *DataStorageQuery {
-	query := (&DataStorageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(request.Table, request.FieldID, id),
-			sqlgraph.To(datastorage.Table, datastorage.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, request.DataStorageTable, request.DataStorageColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryExecutions queries the executions edge of a Request.
-func (c *RequestClient) QueryExecutions(_m *Request) *RequestExecutionQuery {
-	query := (&RequestExecutionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(request.Table, request.FieldID, id),
-			sqlgraph.To(requestexecution.Table, requestexecution.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, request.ExecutionsTable, request.ExecutionsColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, trace.ProjectTable, trace.ProjectColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3131,15 +5297,15 @@ func (c *RequestClient) QueryExecutions(_m *Request) *RequestExecutionQuery {
 	return query
 }
 
-// QueryChannel queries the channel edge of a Request.
-func (c *RequestClient) QueryChannel(_m *Request) *ChannelQuery {
-	query := (&ChannelClient{config: c.config}).Query()
+// QueryThread queries the thread edge of a Trace.
+func (c *TraceClient) QueryThread(_m *Trace) *ThreadQuery {
+	query := (&ThreadClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(request.Table, request.FieldID, id),
-			sqlgraph.To(channel.Table, channel.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, request.ChannelTable, request.ChannelColumn),
+			sqlgraph.From(trace.Table, trace.FieldID, id),
+			sqlgraph.To(thread.Table, thread.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, trace.ThreadTable, trace.ThreadColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3147,15 +5313,15 @@ func (c *RequestClient) QueryChannel(_m *Request) *ChannelQuery {
 	return query
 }
 
-// QueryUsageLogs queries the usage_logs edge of a Request.
-func (c *RequestClient) QueryUsageLogs(_m *Request) *UsageLogQuery {
-	query := (&UsageLogClient{config: c.config}).Query()
+// QueryRequests queries the requests edge of a Trace.
+func (c *TraceClient) QueryRequests(_m *Trace) *RequestQuery {
+	query := (&RequestClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(request.Table, request.FieldID, id),
-			sqlgraph.To(usagelog.Table, usagelog.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, request.UsageLogsTable, request.UsageLogsColumn),
+			sqlgraph.From(trace.Table, trace.FieldID, id),
+			sqlgraph.To(request.Table, request.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, trace.RequestsTable, trace.RequestsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3164,313 +5330,266 @@ func (c *RequestClient) QueryUsageLogs(_m *Request) *UsageLogQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *RequestClient) Hooks() []Hook {
-	hooks := c.hooks.Request
-	return append(hooks[:len(hooks):len(hooks)], request.Hooks[:]...)
+func (c *TraceClient) Hooks() []Hook {
+	hooks := c.hooks.Trace
+	return append(hooks[:len(hooks):len(hooks)], trace.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *RequestClient) Interceptors() []Interceptor {
-	return c.inters.Request
+func (c *TraceClient) Interceptors() []Interceptor {
+	return c.inters.Trace
 }
 
-func (c *RequestClient) mutate(ctx context.Context, m *RequestMutation) (Value, error) {
+func (c *TraceClient) mutate(ctx context.Context, m *TraceMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TraceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TraceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TraceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TraceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Request mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Trace mutation op: %q", m.Op())
 	}
 }
 
-// RequestExecutionClient is a client for the RequestExecution schema.
-type RequestExecutionClient struct {
+// UsageDailyRollupClient is a client for the UsageDailyRollup schema.
+type UsageDailyRollupClient struct {
 	config
 }
 
-// NewRequestExecutionClient returns a client for the RequestExecution from the given config.
-func NewRequestExecutionClient(c config) *RequestExecutionClient {
-	return &RequestExecutionClient{config: c}
+// NewUsageDailyRollupClient returns a client for the UsageDailyRollup from the given config.
+func NewUsageDailyRollupClient(c config) *UsageDailyRollupClient {
+	return &UsageDailyRollupClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `requestexecution.Hooks(f(g(h())))`.
-func (c *RequestExecutionClient) Use(hooks ...Hook) {
-	c.hooks.RequestExecution = append(c.hooks.RequestExecution, hooks...)
+// A call to `Use(f, g, h)` equals to `usagedailyrollup.Hooks(f(g(h())))`.
+func (c *UsageDailyRollupClient) Use(hooks ...Hook) {
+	c.hooks.UsageDailyRollup = append(c.hooks.UsageDailyRollup, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `requestexecution.Intercept(f(g(h())))`.
-func (c *RequestExecutionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RequestExecution = append(c.inters.RequestExecution, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `usagedailyrollup.Intercept(f(g(h())))`.
+func (c *UsageDailyRollupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsageDailyRollup = append(c.inters.UsageDailyRollup, interceptors...)
 }
 
-// Create returns a builder for creating a RequestExecution entity.
-func (c *RequestExecutionClient) Create() *RequestExecutionCreate {
-	mutation := newRequestExecutionMutation(c.config, OpCreate)
-	return &RequestExecutionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UsageDailyRollup entity.
+func (c *UsageDailyRollupClient) Create() *UsageDailyRollupCreate {
+	mutation := newUsageDailyRollupMutation(c.config, OpCreate)
+	return &UsageDailyRollupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RequestExecution entities.
-func (c *RequestExecutionClient) CreateBulk(builders ...*RequestExecutionCreate) *RequestExecutionCreateBulk {
-	return &RequestExecutionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UsageDailyRollup entities.
+func (c *UsageDailyRollupClient) CreateBulk(builders ...*UsageDailyRollupCreate) *UsageDailyRollupCreateBulk {
+	return &UsageDailyRollupCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RequestExecutionClient) MapCreateBulk(slice any, setFunc func(*RequestExecutionCreate, int)) *RequestExecutionCreateBulk {
+func (c *UsageDailyRollupClient) MapCreateBulk(slice any, setFunc func(*UsageDailyRollupCreate, int)) *UsageDailyRollupCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RequestExecutionCreateBulk{err: fmt.Errorf("calling to RequestExecutionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UsageDailyRollupCreateBulk{err: fmt.Errorf("calling to UsageDailyRollupClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RequestExecutionCreate, rv.Len())
+	builders := make([]*UsageDailyRollupCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RequestExecutionCreateBulk{config: c.config, builders: builders}
+	return &UsageDailyRollupCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RequestExecution.
-func (c *RequestExecutionClient) Update() *RequestExecutionUpdate {
-	mutation := newRequestExecutionMutation(c.config, OpUpdate)
-	return &RequestExecutionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UsageDailyRollup.
+func (c *UsageDailyRollupClient) Update() *UsageDailyRollupUpdate {
+	mutation := newUsageDailyRollupMutation(c.config, OpUpdate)
+	return &UsageDailyRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RequestExecutionClient) UpdateOne(_m *RequestExecution) *RequestExecutionUpdateOne {
-	mutation := newRequestExecutionMutation(c.config, OpUpdateOne, withRequestExecution(_m))
-	return &RequestExecutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UsageDailyRollupClient) UpdateOne(_m *UsageDailyRollup) *UsageDailyRollupUpdateOne {
+	mutation := newUsageDailyRollupMutation(c.config, OpUpdateOne, withUsageDailyRollup(_m))
+	return &UsageDailyRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RequestExecutionClient) UpdateOneID(id int) *RequestExecutionUpdateOne {
-	mutation := newRequestExecutionMutation(c.config, OpUpdateOne, withRequestExecutionID(id))
-	return &RequestExecutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// Delete returns a delete builder for RequestExecution.
-func (c *RequestExecutionClient) Delete() *RequestExecutionDelete {
-	mutation := newRequestExecutionMutation(c.config, OpDelete)
-	return &RequestExecutionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
-
-// DeleteOne returns a builder for deleting the given entity.
-func (c *RequestExecutionClient) DeleteOne(_m *RequestExecution) *RequestExecutionDeleteOne {
-	return c.DeleteOneID(_m.ID)
-}
-
-// DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RequestExecutionClient) DeleteOneID(id int) *RequestExecutionDeleteOne {
-	builder := c.Delete().Where(requestexecution.ID(id))
-	builder.mutation.id = &id
-	builder.mutation.op = OpDeleteOne
-	return &RequestExecutionDeleteOne{builder}
-}
-
-// Query returns a query builder for RequestExecution.
-func (c *RequestExecutionClient) Query() *RequestExecutionQuery {
-	return &RequestExecutionQuery{
-		config: c.config,
-		ctx:    &QueryContext{Type: TypeRequestExecution},
-		inters: c.Interceptors(),
-	}
-}
-
-// Get returns a RequestExecution entity by its id.
-func (c *RequestExecutionClient) Get(ctx context.Context, id int) (*RequestExecution, error) {
-	return c.Query().Where(requestexecution.ID(id)).Only(ctx)
-}
-
-// GetX is like Get, but panics if an error occurs.
-func (c *RequestExecutionClient) GetX(ctx context.Context, id int) *RequestExecution {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
-	}
-	return obj
+func (c *UsageDailyRollupClient) UpdateOneID(id int) *UsageDailyRollupUpdateOne {
+	mutation := newUsageDailyRollupMutation(c.config, OpUpdateOne, withUsageDailyRollupID(id))
+	return &UsageDailyRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// QueryRequest queries the request edge of a RequestExecution.
-func (c *RequestExecutionClient) QueryRequest(_m *RequestExecution) *RequestQuery {
-	query := (&RequestClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(requestexecution.Table, requestexecution.FieldID, id),
-			sqlgraph.To(request.Table, request.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, requestexecution.RequestTable, requestexecution.RequestColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Delete returns a delete builder for UsageDailyRollup.
+func (c *UsageDailyRollupClient) Delete() *UsageDailyRollupDelete {
+	mutation := newUsageDailyRollupMutation(c.config, OpDelete)
+	return &UsageDailyRollupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// QueryChannel queries the channel edge of a RequestExecution.
-func (c *RequestExecutionClient) QueryChannel(_m *RequestExecution) *ChannelQuery {
-	query := (&ChannelClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(requestexecution.Table, requestexecution.FieldID, id),
-			sqlgraph.To(channel.Table, channel.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, requestexecution.ChannelTable, requestexecution.ChannelColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UsageDailyRollupClient) DeleteOne(_m *UsageDailyRollup) *UsageDailyRollupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UsageDailyRollupClient) DeleteOneID(id int) *UsageDailyRollupDeleteOne {
+	builder := c.Delete().Where(usagedailyrollup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UsageDailyRollupDeleteOne{builder}
+}
+
+// Query returns a query builder for UsageDailyRollup.
+func (c *UsageDailyRollupClient) Query() *UsageDailyRollupQuery {
+	return &UsageDailyRollupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUsageDailyRollup},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryDataStorage queries the data_storage edge of a RequestExecution.
-func (c *RequestExecutionClient) QueryDataStorage(_m *RequestExecution) *DataStorageQuery {
-	query := (&DataStorageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(requestexecution.Table, requestexecution.FieldID, id),
-			sqlgraph.To(datastorage.Table, datastorage.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, requestexecution.DataStorageTable, requestexecution.DataStorageColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a UsageDailyRollup entity by its id.
+func (c *UsageDailyRollupClient) Get(ctx context.Context, id int) (*UsageDailyRollup, error) {
+	return c.Query().Where(usagedailyrollup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UsageDailyRollupClient) GetX(ctx context.Context, id int) *UsageDailyRollup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *RequestExecutionClient) Hooks() []Hook {
-	return c.hooks.RequestExecution
+func (c *UsageDailyRollupClient) Hooks() []Hook {
+	hooks := c.hooks.UsageDailyRollup
+	return append(hooks[:len(hooks):len(hooks)], usagedailyrollup.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *RequestExecutionClient) Interceptors() []Interceptor {
-	return c.inters.RequestExecution
+func (c *UsageDailyRollupClient) Interceptors() []Interceptor {
+	return c.inters.UsageDailyRollup
 }
 
-func (c *RequestExecutionClient) mutate(ctx context.Context, m *RequestExecutionMutation) (Value, error) {
+func (c *UsageDailyRollupClient) mutate(ctx context.Context, m *UsageDailyRollupMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RequestExecutionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageDailyRollupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RequestExecutionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageDailyRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RequestExecutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageDailyRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RequestExecutionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UsageDailyRollupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown RequestExecution mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown UsageDailyRollup mutation op: %q", m.Op())
 	}
 }
 
-// RoleClient is a client for the Role schema.
-type RoleClient struct {
+// UsageHourlyRollupClient is a client for the UsageHourlyRollup schema.
+type UsageHourlyRollupClient struct {
 	config
 }
 
-// NewRoleClient returns a client for the Role from the given config.
-func NewRoleClient(c config) *RoleClient {
-	return &RoleClient{config: c}
+// NewUsageHourlyRollupClient returns a client for the UsageHourlyRollup from the given config.
+func NewUsageHourlyRollupClient(c config) *UsageHourlyRollupClient {
+	return &UsageHourlyRollupClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
-func (c *RoleClient) Use(hooks ...Hook) {
-	c.hooks.Role = append(c.hooks.Role, hooks...)
+// A call to `Use(f, g, h)` equals to `usagehourlyrollup.Hooks(f(g(h())))`.
+func (c *UsageHourlyRollupClient) Use(hooks ...Hook) {
+	c.hooks.UsageHourlyRollup = append(c.hooks.UsageHourlyRollup, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
-func (c *RoleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Role = append(c.inters.Role, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `usagehourlyrollup.Intercept(f(g(h())))`.
+func (c *UsageHourlyRollupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsageHourlyRollup = append(c.inters.UsageHourlyRollup, interceptors...)
 }
 
-// Create returns a builder for creating a Role entity.
-func (c *RoleClient) Create() *RoleCreate {
-	mutation := newRoleMutation(c.config, OpCreate)
-	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UsageHourlyRollup entity.
+func (c *UsageHourlyRollupClient) Create() *UsageHourlyRollupCreate {
+	mutation := newUsageHourlyRollupMutation(c.config, OpCreate)
+	return &UsageHourlyRollupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Role entities.
-func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
-	return &RoleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UsageHourlyRollup entities.
+func (c *UsageHourlyRollupClient) CreateBulk(builders ...*UsageHourlyRollupCreate) *UsageHourlyRollupCreateBulk {
+	return &UsageHourlyRollupCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
+func (c *UsageHourlyRollupClient) MapCreateBulk(slice any, setFunc func(*UsageHourlyRollupCreate, int)) *UsageHourlyRollupCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UsageHourlyRollupCreateBulk{err: fmt.Errorf("calling to UsageHourlyRollupClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RoleCreate, rv.Len())
+	builders := make([]*UsageHourlyRollupCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RoleCreateBulk{config: c.config, builders: builders}
+	return &UsageHourlyRollupCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Role.
-func (c *RoleClient) Update() *RoleUpdate {
-	mutation := newRoleMutation(c.config, OpUpdate)
-	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UsageHourlyRollup.
+func (c *UsageHourlyRollupClient) Update() *UsageHourlyRollupUpdate {
+	mutation := newUsageHourlyRollupMutation(c.config, OpUpdate)
+	return &UsageHourlyRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RoleClient) UpdateOne(_m *Role) *RoleUpdateOne {
-	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(_m))
-	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UsageHourlyRollupClient) UpdateOne(_m *UsageHourlyRollup) *UsageHourlyRollupUpdateOne {
+	mutation := newUsageHourlyRollupMutation(c.config, OpUpdateOne, withUsageHourlyRollup(_m))
+	return &UsageHourlyRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RoleClient) UpdateOneID(id int) *RoleUpdateOne {
-	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
-	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UsageHourlyRollupClient) UpdateOneID(id int) *UsageHourlyRollupUpdateOne {
+	mutation := newUsageHourlyRollupMutation(c.config, OpUpdateOne, withUsageHourlyRollupID(id))
+	return &UsageHourlyRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Role.
-func (c *RoleClient) Delete() *RoleDelete {
-	mutation := newRoleMutation(c.config, OpDelete)
-	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UsageHourlyRollup.
+func (c *UsageHourlyRollupClient) Delete() *UsageHourlyRollupDelete {
+	mutation := newUsageHourlyRollupMutation(c.config, OpDelete)
+	return &UsageHourlyRollupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RoleClient) DeleteOne(_m *Role) *RoleDeleteOne {
+func (c *UsageHourlyRollupClient) DeleteOne(_m *UsageHourlyRollup) *UsageHourlyRollupDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RoleClient) DeleteOneID(id int) *RoleDeleteOne {
-	builder := c.Delete().Where(role.ID(id))
+func (c *UsageHourlyRollupClient) DeleteOneID(id int) *UsageHourlyRollupDeleteOne {
+	builder := c.Delete().Where(usagehourlyrollup.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RoleDeleteOne{builder}
+	return &UsageHourlyRollupDeleteOne{builder}
 }
 
-// Query returns a query builder for Role.
-func (c *RoleClient) Query() *RoleQuery {
-	return &RoleQuery{
+// Query returns a query builder for UsageHourlyRollup.
+func (c *UsageHourlyRollupClient) Query() *UsageHourlyRollupQuery {
+	return &UsageHourlyRollupQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRole},
+		ctx:    &QueryContext{Type: TypeUsageHourlyRollup},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Role entity by its id.
-func (c *RoleClient) Get(ctx context.Context, id int) (*Role, error) {
-	return c.Query().Where(role.ID(id)).Only(ctx)
+// Get returns a UsageHourlyRollup entity by its id.
+func (c *UsageHourlyRollupClient) Get(ctx context.Context, id int) (*UsageHourlyRollup, error) {
+	return c.Query().Where(usagehourlyrollup.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RoleClient) GetX(ctx context.Context, id int) *Role {
+func (c *UsageHourlyRollupClient) GetX(ctx context.Context, id int) *UsageHourlyRollup {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3478,182 +5597,133 @@ func (c *RoleClient) GetX(ctx context.Context, id int) *Role {
 	return obj
 }
 
-// QueryUsers queries the users edge of a Role.
-func (c *RoleClient) QueryUsers(_m *Role) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(role.Table, role.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, role.UsersTable, role.UsersPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProject queries the project edge of a Role.
-func (c *RoleClient) QueryProject(_m *Role) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(role.Table, role.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, role.ProjectTable, role.ProjectColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryUserRoles queries the user_roles edge of a Role.
-func (c *RoleClient) QueryUserRoles(_m *Role) *UserRoleQuery {
-	query := (&UserRoleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(role.Table, role.FieldID, id),
-			sqlgraph.To(userrole.Table, userrole.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, role.UserRolesTable, role.UserRolesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *RoleClient) Hooks() []Hook {
-	hooks := c.hooks.Role
-	return append(hooks[:len(hooks):len(hooks)], role.Hooks[:]...)
+func (c *UsageHourlyRollupClient) Hooks() []Hook {
+	hooks := c.hooks.UsageHourlyRollup
+	return append(hooks[:len(hooks):len(hooks)], usagehourlyrollup.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *RoleClient) Interceptors() []Interceptor {
-	inters := c.inters.Role
-	return append(inters[:len(inters):len(inters)], role.Interceptors[:]...)
+func (c *UsageHourlyRollupClient) Interceptors() []Interceptor {
+	return c.inters.UsageHourlyRollup
 }
 
-func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+func (c *UsageHourlyRollupClient) mutate(ctx context.Context, m *UsageHourlyRollupMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageHourlyRollupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageHourlyRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageHourlyRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UsageHourlyRollupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown UsageHourlyRollup mutation op: %q", m.Op())
 	}
 }
 
-// SystemClient is a client for the System schema.
-type SystemClient struct {
+// UsageLogClient is a client for the UsageLog schema.
+type UsageLogClient struct {
 	config
 }
 
-// NewSystemClient returns a client for the System from the given config.
-func NewSystemClient(c config) *SystemClient {
-	return &SystemClient{config: c}
+// NewUsageLogClient returns a client for the UsageLog from the given config.
+func NewUsageLogClient(c config) *UsageLogClient {
+	return &UsageLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `system.Hooks(f(g(h())))`.
-func (c *SystemClient) Use(hooks ...Hook) {
-	c.hooks.System = append(c.hooks.System, hooks...)
+// A call to `Use(f, g, h)` equals to `usagelog.Hooks(f(g(h())))`.
+func (c *UsageLogClient) Use(hooks ...Hook) {
+	c.hooks.UsageLog = append(c.hooks.UsageLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `system.Intercept(f(g(h())))`.
-func (c *SystemClient) Intercept(interceptors ...Interceptor) {
-	c.inters.System = append(c.inters.System, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `usagelog.Intercept(f(g(h())))`.
+func (c *UsageLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsageLog = append(c.inters.UsageLog, interceptors...)
 }
 
-// Create returns a builder for creating a System entity.
-func (c *SystemClient) Create() *SystemCreate {
-	mutation := newSystemMutation(c.config, OpCreate)
-	return &SystemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UsageLog entity.
+func (c *UsageLogClient) Create() *UsageLogCreate {
+	mutation := newUsageLogMutation(c.config, OpCreate)
+	return &UsageLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of System entities.
-func (c *SystemClient) CreateBulk(builders ...*SystemCreate) *SystemCreateBulk {
-	return &SystemCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UsageLog entities.
+func (c *UsageLogClient) CreateBulk(builders ...*UsageLogCreate) *UsageLogCreateBulk {
+	return &UsageLogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SystemClient) MapCreateBulk(slice any, setFunc func(*SystemCreate, int)) *SystemCreateBulk {
+func (c *UsageLogClient) MapCreateBulk(slice any, setFunc func(*UsageLogCreate, int)) *UsageLogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SystemCreateBulk{err: fmt.Errorf("calling to SystemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UsageLogCreateBulk{err: fmt.Errorf("calling to UsageLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SystemCreate, rv.Len())
+	builders := make([]*UsageLogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SystemCreateBulk{config: c.config, builders: builders}
+	return &UsageLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for System.
-func (c *SystemClient) Update() *SystemUpdate {
-	mutation := newSystemMutation(c.config, OpUpdate)
-	return &SystemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UsageLog.
+func (c *UsageLogClient) Update() *UsageLogUpdate {
+	mutation := newUsageLogMutation(c.config, OpUpdate)
+	return &UsageLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SystemClient) UpdateOne(_m *System) *SystemUpdateOne {
-	mutation := newSystemMutation(c.config, OpUpdateOne, withSystem(_m))
-	return &SystemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UsageLogClient) UpdateOne(_m *UsageLog) *UsageLogUpdateOne {
+	mutation := newUsageLogMutation(c.config, OpUpdateOne, withUsageLog(_m))
+	return &UsageLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SystemClient) UpdateOneID(id int) *SystemUpdateOne {
-	mutation := newSystemMutation(c.config, OpUpdateOne, withSystemID(id))
-	return &SystemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UsageLogClient) UpdateOneID(id int) *UsageLogUpdateOne {
+	mutation := newUsageLogMutation(c.config, OpUpdateOne, withUsageLogID(id))
+	return &UsageLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for System.
-func (c *SystemClient) Delete() *SystemDelete {
-	mutation := newSystemMutation(c.config, OpDelete)
-	return &SystemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UsageLog.
+func (c *UsageLogClient) Delete() *UsageLogDelete {
+	mutation := newUsageLogMutation(c.config, OpDelete)
+	return &UsageLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SystemClient) DeleteOne(_m *System) *SystemDeleteOne {
+func (c *UsageLogClient) DeleteOne(_m *UsageLog) *UsageLogDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SystemClient) DeleteOneID(id int) *SystemDeleteOne {
-	builder := c.Delete().Where(system.ID(id))
+func (c *UsageLogClient) DeleteOneID(id int) *UsageLogDeleteOne {
+	builder := c.Delete().Where(usagelog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SystemDeleteOne{builder}
+	return &UsageLogDeleteOne{builder}
 }
 
-// Query returns a query builder for System.
-func (c *SystemClient) Query() *SystemQuery {
-	return &SystemQuery{
+// Query returns a query builder for UsageLog.
+func (c *UsageLogClient) Query() *UsageLogQuery {
+	return &UsageLogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSystem},
+		ctx:    &QueryContext{Type: TypeUsageLog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a System entity by its id.
-func (c *SystemClient) Get(ctx context.Context, id int) (*System, error) {
-	return c.Query().Where(system.ID(id)).Only(ctx)
+// Get returns a UsageLog entity by its id.
+func (c *UsageLogClient) Get(ctx context.Context, id int) (*UsageLog, error) {
+	return c.Query().Where(usagelog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SystemClient) GetX(ctx context.Context, id int) *System {
+func (c *UsageLogClient) GetX(ctx context.Context, id int) *UsageLog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3661,150 +5731,293 @@ func (c *SystemClient) GetX(ctx context.Context, id int) *System {
 	return obj
 }
 
+// QueryRequest queries the request edge of a UsageLog.
+func (c *UsageLogClient) QueryRequest(_m *UsageLog) *RequestQuery {
+	query := (&RequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(usagelog.Table, usagelog.FieldID, id),
+			sqlgraph.To(request.Table, request.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, usagelog.RequestTable, usagelog.RequestColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProject queries the project edge of a UsageLog.
+func (c *UsageLogClient) QueryProject(_m *UsageLog) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(usagelog.Table, usagelog.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, usagelog.ProjectTable, usagelog.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChannel queries the channel edge of a UsageLog.
+func (c *UsageLogClient) QueryChannel(_m *UsageLog) *ChannelQuery {
+	query := (&ChannelClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(usagelog.Table, usagelog.FieldID, id),
+			sqlgraph.To(channel.Table, channel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, usagelog.ChannelTable, usagelog.ChannelColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *SystemClient) Hooks() []Hook {
-	hooks := c.hooks.System
-	return append(hooks[:len(hooks):len(hooks)], system.Hooks[:]...)
+func (c *UsageLogClient) Hooks() []Hook {
+	hooks := c.hooks.UsageLog
+	return append(hooks[:len(hooks):len(hooks)], usagelog.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *SystemClient) Interceptors() []Interceptor {
-	inters := c.inters.System
-	return append(inters[:len(inters):len(inters)], system.Interceptors[:]...)
+func (c *UsageLogClient) Interceptors() []Interceptor {
+	return c.inters.UsageLog
 }
 
-func (c *SystemClient) mutate(ctx context.Context, m *SystemMutation) (Value, error) {
+func (c *UsageLogClient) mutate(ctx context.Context, m *UsageLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SystemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SystemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SystemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SystemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UsageLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown System mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown UsageLog mutation op: %q", m.Op())
 	}
 }
 
-// ThreadClient is a client for the Thread schema.
-type ThreadClient struct {
+// UserClient is a client for the User schema.
+type UserClient struct {
 	config
 }
 
-// NewThreadClient returns a client for the Thread from the given config.
-func NewThreadClient(c config) *ThreadClient {
-	return &ThreadClient{config: c}
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `thread.Hooks(f(g(h())))`.
-func (c *ThreadClient) Use(hooks ...Hook) {
-	c.hooks.Thread = append(c.hooks.Thread, hooks...)
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `thread.Intercept(f(g(h())))`.
-func (c *ThreadClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Thread = append(c.inters.Thread, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
 }
 
-// Create returns a builder for creating a Thread entity.
-func (c *ThreadClient) Create() *ThreadCreate {
-	mutation := newThreadMutation(c.config, OpCreate)
-	return &ThreadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Thread entities.
-func (c *ThreadClient) CreateBulk(builders ...*ThreadCreate) *ThreadCreateBulk {
-	return &ThreadCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ThreadClient) MapCreateBulk(slice any, setFunc func(*ThreadCreate, int)) *ThreadCreateBulk {
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ThreadCreateBulk{err: fmt.Errorf("calling to ThreadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ThreadCreate, rv.Len())
+	builders := make([]*UserCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ThreadCreateBulk{config: c.config, builders: builders}
+	return &UserCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Thread.
-func (c *ThreadClient) Update() *ThreadUpdate {
-	mutation := newThreadMutation(c.config, OpUpdate)
-	return &ThreadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ThreadClient) UpdateOne(_m *Thread) *ThreadUpdateOne {
-	mutation := newThreadMutation(c.config, OpUpdateOne, withThread(_m))
-	return &ThreadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ThreadClient) UpdateOneID(id int) *ThreadUpdateOne {
-	mutation := newThreadMutation(c.config, OpUpdateOne, withThreadID(id))
-	return &ThreadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Thread.
-func (c *ThreadClient) Delete() *ThreadDelete {
-	mutation := newThreadMutation(c.config, OpDelete)
-	return &ThreadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ThreadClient) DeleteOne(_m *Thread) *ThreadDeleteOne {
+func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ThreadClient) DeleteOneID(id int) *ThreadDeleteOne {
-	builder := c.Delete().Where(thread.ID(id))
+func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ThreadDeleteOne{builder}
+	return &UserDeleteOne{builder}
 }
 
-// Query returns a query builder for Thread.
-func (c *ThreadClient) Query() *ThreadQuery {
-	return &ThreadQuery{
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeThread},
+		ctx:    &QueryContext{Type: TypeUser},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Thread entity by its id.
-func (c *ThreadClient) Get(ctx context.Context, id int) (*Thread, error) {
-	return c.Query().Where(thread.ID(id)).Only(ctx)
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProjects queries the projects edge of a User.
+func (c *UserClient) QueryProjects(_m *User) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.ProjectsTable, user.ProjectsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPIKeys queries the api_keys edge of a User.
+func (c *UserClient) QueryAPIKeys(_m *User) *APIKeyQuery {
+	query := (&APIKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.APIKeysTable, user.APIKeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRoles queries the roles edge of a User.
+func (c *UserClient) QueryRoles(_m *User) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, user.RolesTable, user.RolesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChannelOverrideTemplates queries the channel_override_templates edge of a User.
+func (c *UserClient) QueryChannelOverrideTemplates(_m *User) *ChannelOverrideTemplateQuery {
+	query := (&ChannelOverrideTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(channeloverridetemplate.Table, channeloverridetemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ChannelOverrideTemplatesTable, user.ChannelOverrideTemplatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOidcIdentities queries the oidc_identities edge of a User.
+func (c *UserClient) QueryOidcIdentities(_m *User) *OIDCIdentityQuery {
+	query := (&OIDCIdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(oidcidentity.Table, oidcidentity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.OidcIdentitiesTable, user.OidcIdentitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// GetX is like Get, but panics if an error occurs.
-func (c *ThreadClient) GetX(ctx context.Context, id int) *Thread {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
+// QueryScimGroups queries the scim_groups edge of a User.
+func (c *UserClient) QueryScimGroups(_m *User) *SCIMGroupQuery {
+	query := (&SCIMGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(scimgroup.Table, scimgroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.ScimGroupsTable, user.ScimGroupsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
 	}
-	return obj
+	return query
 }
 
-// QueryProject queries the project edge of a Thread.
-func (c *ThreadClient) QueryProject(_m *Thread) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
+// QueryProjectUsers queries the project_users edge of a User.
+func (c *UserClient) QueryProjectUsers(_m *User) *UserProjectQuery {
+	query := (&UserProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(thread.Table, thread.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, thread.ProjectTable, thread.ProjectColumn),
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(userproject.Table, userproject.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.ProjectUsersTable, user.ProjectUsersColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3812,15 +6025,15 @@ func (c *ThreadClient) QueryProject(_m *Thread) *ProjectQuery {
 	return query
 }
 
-// QueryTraces queries the traces edge of a Thread.
-func (c *ThreadClient) QueryTraces(_m *Thread) *TraceQuery {
-	query := (&TraceClient{config: c.config}).Query()
+// QueryUserRoles queries the user_roles edge of a User.
+func (c *UserClient) QueryUserRoles(_m *User) *UserRoleQuery {
+	query := (&UserRoleClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(thread.Table, thread.FieldID, id),
-			sqlgraph.To(trace.Table, trace.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, thread.TracesTable, thread.TracesColumn),
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(userrole.Table, userrole.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.UserRolesTable, user.UserRolesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3829,132 +6042,133 @@ func (c *ThreadClient) QueryTraces(_m *Thread) *TraceQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ThreadClient) Hooks() []Hook {
-	hooks := c.hooks.Thread
-	return append(hooks[:len(hooks):len(hooks)], thread.Hooks[:]...)
+func (c *UserClient) Hooks() []Hook {
+	hooks := c.hooks.User
+	return append(hooks[:len(hooks):len(hooks)], user.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ThreadClient) Interceptors() []Interceptor {
-	return c.inters.Thread
+func (c *UserClient) Interceptors() []Interceptor {
+	inters := c.inters.User
+	return append(inters[:len(inters):len(inters)], user.Interceptors[:]...)
 }
 
-func (c *ThreadClient) mutate(ctx context.Context, m *ThreadMutation) (Value, error) {
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ThreadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ThreadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ThreadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ThreadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Thread mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
 	}
 }
 
-// TraceClient is a client for the Trace schema.
-type TraceClient struct {
+// UserProjectClient is a client for the UserProject schema.
+type UserProjectClient struct {
 	config
 }
 
-// NewTraceClient returns a client for the Trace from the given config.
-func NewTraceClient(c config) *TraceClient {
-	return &TraceClient{config: c}
+// NewUserProjectClient returns a client for the UserProject from the given config.
+func NewUserProjectClient(c config) *UserProjectClient {
+	return &UserProjectClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `trace.Hooks(f(g(h())))`.
-func (c *TraceClient) Use(hooks ...Hook) {
-	c.hooks.Trace = append(c.hooks.Trace, hooks...)
+// A call to `Use(f, g, h)` equals to `userproject.Hooks(f(g(h())))`.
+func (c *UserProjectClient) Use(hooks ...Hook) {
+	c.hooks.UserProject = append(c.hooks.UserProject, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `trace.Intercept(f(g(h())))`.
-func (c *TraceClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Trace = append(c.inters.Trace, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `userproject.Intercept(f(g(h())))`.
+func (c *UserProjectClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserProject = append(c.inters.UserProject, interceptors...)
 }
 
-// Create returns a builder for creating a Trace entity.
-func (c *TraceClient) Create() *TraceCreate {
-	mutation := newTraceMutation(c.config, OpCreate)
-	return &TraceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UserProject entity.
+func (c *UserProjectClient) Create() *UserProjectCreate {
+	mutation := newUserProjectMutation(c.config, OpCreate)
+	return &UserProjectCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Trace entities.
-func (c *TraceClient) CreateBulk(builders ...*TraceCreate) *TraceCreateBulk {
-	return &TraceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UserProject entities.
+func (c *UserProjectClient) CreateBulk(builders ...*UserProjectCreate) *UserProjectCreateBulk {
+	return &UserProjectCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TraceClient) MapCreateBulk(slice any, setFunc func(*TraceCreate, int)) *TraceCreateBulk {
+func (c *UserProjectClient) MapCreateBulk(slice any, setFunc func(*UserProjectCreate, int)) *UserProjectCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TraceCreateBulk{err: fmt.Errorf("calling to TraceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UserProjectCreateBulk{err: fmt.Errorf("calling to UserProjectClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TraceCreate, rv.Len())
+	builders := make([]*UserProjectCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TraceCreateBulk{config: c.config, builders: builders}
+	return &UserProjectCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Trace.
-func (c *TraceClient) Update() *TraceUpdate {
-	mutation := newTraceMutation(c.config, OpUpdate)
-	return &TraceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UserProject.
+func (c *UserProjectClient) Update() *UserProjectUpdate {
+	mutation := newUserProjectMutation(c.config, OpUpdate)
+	return &UserProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TraceClient) UpdateOne(_m *Trace) *TraceUpdateOne {
-	mutation := newTraceMutation(c.config, OpUpdateOne, withTrace(_m))
-	return &TraceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserProjectClient) UpdateOne(_m *UserProject) *UserProjectUpdateOne {
+	mutation := newUserProjectMutation(c.config, OpUpdateOne, withUserProject(_m))
+	return &UserProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TraceClient) UpdateOneID(id int) *TraceUpdateOne {
-	mutation := newTraceMutation(c.config, OpUpdateOne, withTraceID(id))
-	return &TraceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserProjectClient) UpdateOneID(id int) *UserProjectUpdateOne {
+	mutation := newUserProjectMutation(c.config, OpUpdateOne, withUserProjectID(id))
+	return &UserProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Trace.
-func (c *TraceClient) Delete() *TraceDelete {
-	mutation := newTraceMutation(c.config, OpDelete)
-	return &TraceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UserProject.
+func (c *UserProjectClient) Delete() *UserProjectDelete {
+	mutation := newUserProjectMutation(c.config, OpDelete)
+	return &UserProjectDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TraceClient) DeleteOne(_m *Trace) *TraceDeleteOne {
+func (c *UserProjectClient) DeleteOne(_m *UserProject) *UserProjectDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TraceClient) DeleteOneID(id int) *TraceDeleteOne {
-	builder := c.Delete().Where(trace.ID(id))
+func (c *UserProjectClient) DeleteOneID(id int) *UserProjectDeleteOne {
+	builder := c.Delete().Where(userproject.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TraceDeleteOne{builder}
+	return &UserProjectDeleteOne{builder}
 }
 
-// Query returns a query builder for Trace.
-func (c *TraceClient) Query() *TraceQuery {
-	return &TraceQuery{
+// Query returns a query builder for UserProject.
+func (c *UserProjectClient) Query() *UserProjectQuery {
+	return &UserProjectQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTrace},
+		ctx:    &QueryContext{Type: TypeUserProject},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Trace entity by its id.
-func (c *TraceClient) Get(ctx context.Context, id int) (*Trace, error) {
-	return c.Query().Where(trace.ID(id)).Only(ctx)
+// Get returns a UserProject entity by its id.
+func (c *UserProjectClient) Get(ctx context.Context, id int) (*UserProject, error) {
+	return c.Query().Where(userproject.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TraceClient) GetX(ctx context.Context, id int) *Trace {
+func (c *UserProjectClient) GetX(ctx context.Context, id int) *UserProject {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3962,31 +6176,15 @@ func (c *TraceClient) GetX(ctx context.Context, id int) *Trace {
 	return obj
 }
 
-// QueryProject queries the project edge of a Trace.
-func (c *TraceClient) QueryProject(_m *Trace) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(trace.Table, trace.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, trace.ProjectTable, trace.ProjectColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryThread queries the thread edge of a Trace.
-func (c *TraceClient) QueryThread(_m *Trace) *ThreadQuery {
-	query := (&ThreadClient{config: c.config}).Query()
+// QueryUser queries the user edge of a UserProject.
+func (c *UserProjectClient) QueryUser(_m *UserProject) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(trace.Table, trace.FieldID, id),
-			sqlgraph.To(thread.Table, thread.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, trace.ThreadTable, trace.ThreadColumn),
+			sqlgraph.From(userproject.Table, userproject.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, userproject.UserTable, userproject.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3994,15 +6192,15 @@ func (c *TraceClient) QueryThread(_m *Trace) *ThreadQuery {
 	return query
 }
 
-// QueryRequests queries the requests edge of a Trace.
-func (c *TraceClient) QueryRequests(_m *Trace) *RequestQuery {
-	query := (&RequestClient{config: c.config}).Query()
+// QueryProject queries the project edge of a UserProject.
+func (c *UserProjectClient) QueryProject(_m *UserProject) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(trace.Table, trace.FieldID, id),
-			sqlgraph.To(request.Table, request.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, trace.RequestsTable, trace.RequestsColumn),
+			sqlgraph.From(userproject.Table, userproject.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, userproject.ProjectTable, userproject.ProjectColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4011,132 +6209,132 @@ func (c *TraceClient) QueryRequests(_m *Trace) *RequestQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *TraceClient) Hooks() []Hook {
-	hooks := c.hooks.Trace
-	return append(hooks[:len(hooks):len(hooks)], trace.Hooks[:]...)
+func (c *UserProjectClient) Hooks() []Hook {
+	hooks := c.hooks.UserProject
+	return append(hooks[:len(hooks):len(hooks)], userproject.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *TraceClient) Interceptors() []Interceptor {
-	return c.inters.Trace
+func (c *UserProjectClient) Interceptors() []Interceptor {
+	return c.inters.UserProject
 }
 
-func (c *TraceClient) mutate(ctx context.Context, m *TraceMutation) (Value, error) {
+func (c *UserProjectClient) mutate(ctx context.Context, m *UserProjectMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TraceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserProjectCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TraceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TraceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TraceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserProjectDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Trace mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown UserProject mutation op: %q", m.Op())
 	}
 }
 
-// UsageLogClient is a client for the UsageLog schema.
-type UsageLogClient struct {
+// UserRoleClient is a client for the UserRole schema.
+type UserRoleClient struct {
 	config
 }
 
-// NewUsageLogClient returns a client for the UsageLog from the given config.
-func NewUsageLogClient(c config) *UsageLogClient {
-	return &UsageLogClient{config: c}
+// NewUserRoleClient returns a client for the UserRole from the given config.
+func NewUserRoleClient(c config) *UserRoleClient {
+	return &UserRoleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `usagelog.Hooks(f(g(h())))`.
-func (c *UsageLogClient) Use(hooks ...Hook) {
-	c.hooks.UsageLog = append(c.hooks.UsageLog, hooks...)
+// A call to `Use(f, g, h)` equals to `userrole.Hooks(f(g(h())))`.
+func (c *UserRoleClient) Use(hooks ...Hook) {
+	c.hooks.UserRole = append(c.hooks.UserRole, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `usagelog.Intercept(f(g(h())))`.
-func (c *UsageLogClient) Intercept(interceptors ...Interceptor) {
-	c.inters.UsageLog = append(c.inters.UsageLog, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `userrole.Intercept(f(g(h())))`.
+func (c *UserRoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserRole = append(c.inters.UserRole, interceptors...)
 }
 
-// Create returns a builder for creating a UsageLog entity.
-func (c *UsageLogClient) Create() *UsageLogCreate {
-	mutation := newUsageLogMutation(c.config, OpCreate)
-	return &UsageLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UserRole entity.
+func (c *UserRoleClient) Create() *UserRoleCreate {
+	mutation := newUserRoleMutation(c.config, OpCreate)
+	return &UserRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of UsageLog entities.
-func (c *UsageLogClient) CreateBulk(builders ...*UsageLogCreate) *UsageLogCreateBulk {
-	return &UsageLogCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UserRole entities.
+func (c *UserRoleClient) CreateBulk(builders ...*UserRoleCreate) *UserRoleCreateBulk {
+	return &UserRoleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UsageLogClient) MapCreateBulk(slice any, setFunc func(*UsageLogCreate, int)) *UsageLogCreateBulk {
+func (c *UserRoleClient) MapCreateBulk(slice any, setFunc func(*UserRoleCreate, int)) *UserRoleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UsageLogCreateBulk{err: fmt.Errorf("calling to UsageLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UserRoleCreateBulk{err: fmt.Errorf("calling to UserRoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UsageLogCreate, rv.Len())
+	builders := make([]*UserRoleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UsageLogCreateBulk{config: c.config, builders: builders}
+	return &UserRoleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for UsageLog.
-func (c *UsageLogClient) Update() *UsageLogUpdate {
-	mutation := newUsageLogMutation(c.config, OpUpdate)
-	return &UsageLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UserRole.
+func (c *UserRoleClient) Update() *UserRoleUpdate {
+	mutation := newUserRoleMutation(c.config, OpUpdate)
+	return &UserRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UsageLogClient) UpdateOne(_m *UsageLog) *UsageLogUpdateOne {
-	mutation := newUsageLogMutation(c.config, OpUpdateOne, withUsageLog(_m))
-	return &UsageLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserRoleClient) UpdateOne(_m *UserRole) *UserRoleUpdateOne {
+	mutation := newUserRoleMutation(c.config, OpUpdateOne, withUserRole(_m))
+	return &UserRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UsageLogClient) UpdateOneID(id int) *UsageLogUpdateOne {
-	mutation := newUsageLogMutation(c.config, OpUpdateOne, withUsageLogID(id))
-	return &UsageLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UserRoleClient) UpdateOneID(id int) *UserRoleUpdateOne {
+	mutation := newUserRoleMutation(c.config, OpUpdateOne, withUserRoleID(id))
+	return &UserRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for UsageLog.
-func (c *UsageLogClient) Delete() *UsageLogDelete {
-	mutation := newUsageLogMutation(c.config, OpDelete)
-	return &UsageLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UserRole.
+func (c *UserRoleClient) Delete() *UserRoleDelete {
+	mutation := newUserRoleMutation(c.config, OpDelete)
+	return &UserRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UsageLogClient) DeleteOne(_m *UsageLog) *UsageLogDeleteOne {
+func (c *UserRoleClient) DeleteOne(_m *UserRole) *UserRoleDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UsageLogClient) DeleteOneID(id int) *UsageLogDeleteOne {
-	builder := c.Delete().Where(usagelog.ID(id))
+func (c *UserRoleClient) DeleteOneID(id int) *UserRoleDeleteOne {
+	builder := c.Delete().Where(userrole.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UsageLogDeleteOne{builder}
+	return &UserRoleDeleteOne{builder}
 }
 
-// Query returns a query builder for UsageLog.
-func (c *UsageLogClient) Query() *UsageLogQuery {
-	return &UsageLogQuery{
+// Query returns a query builder for UserRole.
+func (c *UserRoleClient) Query() *UserRoleQuery {
+	return &UserRoleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUsageLog},
+		ctx:    &QueryContext{Type: TypeUserRole},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a UsageLog entity by its id.
-func (c *UsageLogClient) Get(ctx context.Context, id int) (*UsageLog, error) {
-	return c.Query().Where(usagelog.ID(id)).Only(ctx)
+// Get returns a UserRole entity by its id.
+func (c *UserRoleClient) Get(ctx context.Context, id int) (*UserRole, error) {
+	return c.Query().Where(userrole.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UsageLogClient) GetX(ctx context.Context, id int) *UsageLog {
+func (c *UserRoleClient) GetX(ctx context.Context, id int) *UserRole {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4144,31 +6342,15 @@ func (c *UsageLogClient) GetX(ctx context.Context, id int) *UsageLog {
 	return obj
 }
 
-// QueryRequest queries the request edge of a UsageLog.
-func (c *UsageLogClient) QueryRequest(_m *UsageLog) *RequestQuery {
-	query := (&RequestClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(usagelog.Table, usagelog.FieldID, id),
-			sqlgraph.To(request.Table, request.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, usagelog.RequestTable, usagelog.RequestColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProject queries the project edge of a UsageLog.
-func (c *UsageLogClient) QueryProject(_m *UsageLog) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
+// QueryUser queries the user edge of a UserRole.
+func (c *UserRoleClient) QueryUser(_m *UserRole) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(usagelog.Table, usagelog.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, usagelog.ProjectTable, usagelog.ProjectColumn),
+			sqlgraph.From(userrole.Table, userrole.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, userrole.UserTable, userrole.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4176,15 +6358,15 @@ func (c *UsageLogClient) QueryProject(_m *UsageLog) *ProjectQuery {
 	return query
 }
 
-// QueryChannel queries the channel edge of a UsageLog.
-func (c *UsageLogClient) QueryChannel(_m *UsageLog) *ChannelQuery {
-	query := (&ChannelClient{config: c.config}).Query()
+// QueryRole queries the role edge of a UserRole.
+func (c *UserRoleClient) QueryRole(_m *UserRole) *RoleQuery {
+	query := (&RoleClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(usagelog.Table, usagelog.FieldID, id),
-			sqlgraph.To(channel.Table, channel.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, usagelog.ChannelTable, usagelog.ChannelColumn),
+			sqlgraph.From(userrole.Table, userrole.FieldID, id),
+			sqlgraph.To(role.Table, role.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, userrole.RoleTable, userrole.RoleColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4193,132 +6375,131 @@ func (c *UsageLogClient) QueryChannel(_m *UsageLog) *ChannelQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *UsageLogClient) Hooks() []Hook {
-	hooks := c.hooks.UsageLog
-	return append(hooks[:len(hooks):len(hooks)], usagelog.Hooks[:]...)
+func (c *UserRoleClient) Hooks() []Hook {
+	return c.hooks.UserRole
 }
 
 // Interceptors returns the client interceptors.
-func (c *UsageLogClient) Interceptors() []Interceptor {
-	return c.inters.UsageLog
+func (c *UserRoleClient) Interceptors() []Interceptor {
+	return c.inters.UserRole
 }
 
-func (c *UsageLogClient) mutate(ctx context.Context, m *UsageLogMutation) (Value, error) {
+func (c *UserRoleClient) mutate(ctx context.Context, m *UserRoleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UsageLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserRoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UsageLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UsageLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UsageLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserRoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown UsageLog mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown UserRole mutation op: %q", m.Op())
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// WalletClient is a client for the Wallet schema.
+type WalletClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewWalletClient returns a client for the Wallet from the given config.
+func NewWalletClient(c config) *WalletClient {
+	return &WalletClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `wallet.Hooks(f(g(h())))`.
+func (c *WalletClient) Use(hooks ...Hook) {
+	c.hooks.Wallet = append(c.hooks.Wallet, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `wallet.Intercept(f(g(h())))`.
+func (c *WalletClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Wallet = append(c.inters.Wallet, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Wallet entity.
+func (c *WalletClient) Create() *WalletCreate {
+	mutation := newWalletMutation(c.config, OpCreate)
+	return &WalletCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
-	return &UserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Wallet entities.
+func (c *WalletClient) CreateBulk(builders ...*WalletCreate) *WalletCreateBulk {
+	return &WalletCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+func (c *WalletClient) MapCreateBulk(slice any, setFunc func(*WalletCreate, int)) *WalletCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WalletCreateBulk{err: fmt.Errorf("calling to WalletClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserCreate, rv.Len())
+	builders := make([]*WalletCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserCreateBulk{config: c.config, builders: builders}
+	return &WalletCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for User.
-func (c *UserClient) Update() *UserUpdate {
-	mutation := newUserMutation(c.config, OpUpdate)
-	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Wallet.
+func (c *WalletClient) Update() *WalletUpdate {
+	mutation := newWalletMutation(c.config, OpUpdate)
+	return &WalletUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WalletClient) UpdateOne(_m *Wallet) *WalletUpdateOne {
+	mutation := newWalletMutation(c.config, OpUpdateOne, withWallet(_m))
+	return &WalletUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WalletClient) UpdateOneID(id int) *WalletUpdateOne {
+	mutation := newWalletMutation(c.config, OpUpdateOne, withWalletID(id))
+	return &WalletUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for User.
-func (c *UserClient) Delete() *UserDelete {
-	mutation := newUserMutation(c.config, OpDelete)
-	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Wallet.
+func (c *WalletClient) Delete() *WalletDelete {
+	mutation := newWalletMutation(c.config, OpDelete)
+	return &WalletDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+func (c *WalletClient) DeleteOne(_m *Wallet) *WalletDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
-	builder := c.Delete().Where(user.ID(id))
+func (c *WalletClient) DeleteOneID(id int) *WalletDeleteOne {
+	builder := c.Delete().Where(wallet.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserDeleteOne{builder}
+	return &WalletDeleteOne{builder}
 }
 
-// Query returns a query builder for User.
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{
+// Query returns a query builder for Wallet.
+func (c *WalletClient) Query() *WalletQuery {
+	return &WalletQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUser},
+		ctx:    &QueryContext{Type: TypeWallet},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+// Get returns a Wallet entity by its id.
+func (c *WalletClient) Get(ctx context.Context, id int) (*Wallet, error) {
+	return c.Query().Where(wallet.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id int) *User {
+func (c *WalletClient) GetX(ctx context.Context, id int) *Wallet {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4326,111 +6507,31 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return obj
 }
 
-// QueryProjects queries the projects edge of a User.
-func (c *UserClient) QueryProjects(_m *User) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, user.ProjectsTable, user.ProjectsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAPIKeys queries the api_keys edge of a User.
-func (c *UserClient) QueryAPIKeys(_m *User) *APIKeyQuery {
+// QueryAPIKey queries the api_key edge of a Wallet.
+func (c *WalletClient) QueryAPIKey(_m *Wallet) *APIKeyQuery {
 	query := (&APIKeyClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.From(wallet.Table, wallet.FieldID, id),
 			sqlgraph.To(apikey.Table, apikey.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.APIKeysTable, user.APIKeysColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRoles queries the roles edge of a User.
-func (c *UserClient) QueryRoles(_m *User) *RoleQuery {
-	query := (&RoleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(role.Table, role.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, user.RolesTable, user.RolesPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryChannelOverrideTemplates queries the channel_override_templates edge of a User.
-func (c *UserClient) QueryChannelOverrideTemplates(_m *User) *ChannelOverrideTemplateQuery {
-	query := (&ChannelOverrideTemplateClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(channeloverridetemplate.Table, channeloverridetemplate.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.ChannelOverrideTemplatesTable, user.ChannelOverrideTemplatesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryOidcIdentities queries the oidc_identities edge of a User.
-func (c *UserClient) QueryOidcIdentities(_m *User) *OIDCIdentityQuery {
-	query := (&OIDCIdentityClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(oidcidentity.Table, oidcidentity.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, user.OidcIdentitiesTable, user.OidcIdentitiesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProjectUsers queries the project_users edge of a User.
-func (c *UserClient) QueryProjectUsers(_m *User) *UserProjectQuery {
-	query := (&UserProjectClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(userproject.Table, userproject.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, user.ProjectUsersTable, user.ProjectUsersColumn),
+			sqlgraph.Edge(sqlgraph.O2O, true, wallet.APIKeyTable, wallet.APIKeyColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
-
-// QueryUserRoles queries the user_roles edge of a User.
-func (c *UserClient) QueryUserRoles(_m *User) *UserRoleQuery {
-	query := (&UserRoleClient{config: c.config}).Query()
+
+// QueryTransactions queries the transactions edge of a Wallet.
+func (c *WalletClient) QueryTransactions(_m *Wallet) *WalletTransactionQuery {
+	query := (&WalletTransactionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(userrole.Table, userrole.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, user.UserRolesTable, user.UserRolesColumn),
+			sqlgraph.From(wallet.Table, wallet.FieldID, id),
+			sqlgraph.To(wallettransaction.Table, wallettransaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.TransactionsTable, wallet.TransactionsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4439,133 +6540,132 @@ func (c *UserClient) QueryUserRoles(_m *User) *UserRoleQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *UserClient) Hooks() []Hook {
-	hooks := c.hooks.User
-	return append(hooks[:len(hooks):len(hooks)], user.Hooks[:]...)
+func (c *WalletClient) Hooks() []Hook {
+	hooks := c.hooks.Wallet
+	return append(hooks[:len(hooks):len(hooks)], wallet.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserClient) Interceptors() []Interceptor {
-	inters := c.inters.User
-	return append(inters[:len(inters):len(inters)], user.Interceptors[:]...)
+func (c *WalletClient) Interceptors() []Interceptor {
+	return c.inters.Wallet
 }
 
-func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+func (c *WalletClient) mutate(ctx context.Context, m *WalletMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WalletCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WalletUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WalletUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WalletDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Wallet mutation op: %q", m.Op())
 	}
 }
 
-// UserProjectClient is a client for the UserProject schema.
-type UserProjectClient struct {
+// WalletReservationClient is a client for the WalletReservation schema.
+type WalletReservationClient struct {
 	config
 }
 
-// NewUserProjectClient returns a client for the UserProject from the given config.
-func NewUserProjectClient(c config) *UserProjectClient {
-	return &UserProjectClient{config: c}
+// NewWalletReservationClient returns a client for the WalletReservation from the given config.
+func NewWalletReservationClient(c config) *WalletReservationClient {
+	return &WalletReservationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `userproject.Hooks(f(g(h())))`.
-func (c *UserProjectClient) Use(hooks ...Hook) {
-	c.hooks.UserProject = append(c.hooks.UserProject, hooks...)
+// A call to `Use(f, g, h)` equals to `walletreservation.Hooks(f(g(h())))`.
+func (c *WalletReservationClient) Use(hooks ...Hook) {
+	c.hooks.WalletReservation = append(c.hooks.WalletReservation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `userproject.Intercept(f(g(h())))`.
-func (c *UserProjectClient) Intercept(interceptors ...Interceptor) {
-	c.inters.UserProject = append(c.inters.UserProject, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `walletreservation.Intercept(f(g(h())))`.
+func (c *WalletReservationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WalletReservation = append(c.inters.WalletReservation, interceptors...)
 }
 
-// Create returns a builder for creating a UserProject entity.
-func (c *UserProjectClient) Create() *UserProjectCreate {
-	mutation := newUserProjectMutation(c.config, OpCreate)
-	return &UserProjectCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WalletReservation entity.
+func (c *WalletReservationClient) Create() *WalletReservationCreate {
+	mutation := newWalletReservationMutation(c.config, OpCreate)
+	return &WalletReservationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of UserProject entities.
-func (c *UserProjectClient) CreateBulk(builders ...*UserProjectCreate) *UserProjectCreateBulk {
-	return &UserProjectCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WalletReservation entities.
+func (c *WalletReservationClient) CreateBulk(builders ...*WalletReservationCreate) *WalletReservationCreateBulk {
+	return &WalletReservationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserProjectClient) MapCreateBulk(slice any, setFunc func(*UserProjectCreate, int)) *UserProjectCreateBulk {
+func (c *WalletReservationClient) MapCreateBulk(slice any, setFunc func(*WalletReservationCreate, int)) *WalletReservationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserProjectCreateBulk{err: fmt.Errorf("calling to UserProjectClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WalletReservationCreateBulk{err: fmt.Errorf("calling to WalletReservationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserProjectCreate, rv.Len())
+	builders := make([]*WalletReservationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserProjectCreateBulk{config: c.config, builders: builders}
+	return &WalletReservationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for UserProject.
-func (c *UserProjectClient) Update() *UserProjectUpdate {
-	mutation := newUserProjectMutation(c.config, OpUpdate)
-	return &UserProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WalletReservation.
+func (c *WalletReservationClient) Update() *WalletReservationUpdate {
+	mutation := newWalletReservationMutation(c.config, OpUpdate)
+	return &WalletReservationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserProjectClient) UpdateOne(_m *UserProject) *UserProjectUpdateOne {
-	mutation := newUserProjectMutation(c.config, OpUpdateOne, withUserProject(_m))
-	return &UserProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WalletReservationClient) UpdateOne(_m *WalletReservation) *WalletReservationUpdateOne {
+	mutation := newWalletReservationMutation(c.config, OpUpdateOne, withWalletReservation(_m))
+	return &WalletReservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserProjectClient) UpdateOneID(id int) *UserProjectUpdateOne {
-	mutation := newUserProjectMutation(c.config, OpUpdateOne, withUserProjectID(id))
-	return &UserProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WalletReservationClient) UpdateOneID(id int) *WalletReservationUpdateOne {
+	mutation := newWalletReservationMutation(c.config, OpUpdateOne, withWalletReservationID(id))
+	return &WalletReservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for UserProject.
-func (c *UserProjectClient) Delete() *UserProjectDelete {
-	mutation := newUserProjectMutation(c.config, OpDelete)
-	return &UserProjectDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WalletReservation.
+func (c *WalletReservationClient) Delete() *WalletReservationDelete {
+	mutation := newWalletReservationMutation(c.config, OpDelete)
+	return &WalletReservationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserProjectClient) DeleteOne(_m *UserProject) *UserProjectDeleteOne {
+func (c *WalletReservationClient) DeleteOne(_m *WalletReservation) *WalletReservationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserProjectClient) DeleteOneID(id int) *UserProjectDeleteOne {
-	builder := c.Delete().Where(userproject.ID(id))
+func (c *WalletReservationClient) DeleteOneID(id int) *WalletReservationDeleteOne {
+	builder := c.Delete().Where(walletreservation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserProjectDeleteOne{builder}
+	return &WalletReservationDeleteOne{builder}
 }
 
-// Query returns a query builder for UserProject.
-func (c *UserProjectClient) Query() *UserProjectQuery {
-	return &UserProjectQuery{
+// Query returns a query builder for WalletReservation.
+func (c *WalletReservationClient) Query() *WalletReservationQuery {
+	return &WalletReservationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUserProject},
+		ctx:    &QueryContext{Type: TypeWalletReservation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a UserProject entity by its id.
-func (c *UserProjectClient) Get(ctx context.Context, id int) (*UserProject, error) {
-	return c.Query().Where(userproject.ID(id)).Only(ctx)
+// Get returns a WalletReservation entity by its id.
+func (c *WalletReservationClient) Get(ctx context.Context, id int) (*WalletReservation, error) {
+	return c.Query().Where(walletreservation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserProjectClient) GetX(ctx context.Context, id int) *UserProject {
+func (c *WalletReservationClient) GetX(ctx context.Context, id int) *WalletReservation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4573,165 +6673,133 @@ func (c *UserProjectClient) GetX(ctx context.Context, id int) *UserProject {
 	return obj
 }
 
-// QueryUser queries the user edge of a UserProject.
-func (c *UserProjectClient) QueryUser(_m *UserProject) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(userproject.Table, userproject.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, userproject.UserTable, userproject.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProject queries the project edge of a UserProject.
-func (c *UserProjectClient) QueryProject(_m *UserProject) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(userproject.Table, userproject.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, userproject.ProjectTable, userproject.ProjectColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *UserProjectClient) Hooks() []Hook {
-	hooks := c.hooks.UserProject
-	return append(hooks[:len(hooks):len(hooks)], userproject.Hooks[:]...)
+func (c *WalletReservationClient) Hooks() []Hook {
+	hooks := c.hooks.WalletReservation
+	return append(hooks[:len(hooks):len(hooks)], walletreservation.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserProjectClient) Interceptors() []Interceptor {
-	return c.inters.UserProject
+func (c *WalletReservationClient) Interceptors() []Interceptor {
+	return c.inters.WalletReservation
 }
 
-func (c *UserProjectClient) mutate(ctx context.Context, m *UserProjectMutation) (Value, error) {
+func (c *WalletReservationClient) mutate(ctx context.Context, m *WalletReservationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserProjectCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WalletReservationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WalletReservationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WalletReservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserProjectDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WalletReservationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown UserProject mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WalletReservation mutation op: %q", m.Op())
 	}
 }
 
-// UserRoleClient is a client for the UserRole schema.
-type UserRoleClient struct {
+// WalletTransactionClient is a client for the WalletTransaction schema.
+type WalletTransactionClient struct {
 	config
 }
 
-// NewUserRoleClient returns a client for the UserRole from the given config.
-func NewUserRoleClient(c config) *UserRoleClient {
-	return &UserRoleClient{config: c}
+// NewWalletTransactionClient returns a client for the WalletTransaction from the given config.
+func NewWalletTransactionClient(c config) *WalletTransactionClient {
+	return &WalletTransactionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `userrole.Hooks(f(g(h())))`.
-func (c *UserRoleClient) Use(hooks ...Hook) {
-	c.hooks.UserRole = append(c.hooks.UserRole, hooks...)
+// A call to `Use(f, g, h)` equals to `wallettransaction.Hooks(f(g(h())))`.
+func (c *WalletTransactionClient) Use(hooks ...Hook) {
+	c.hooks.WalletTransaction = append(c.hooks.WalletTransaction, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `userrole.Intercept(f(g(h())))`.
-func (c *UserRoleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.UserRole = append(c.inters.UserRole, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `wallettransaction.Intercept(f(g(h())))`.
+func (c *WalletTransactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WalletTransaction = append(c.inters.WalletTransaction, interceptors...)
 }
 
-// Create returns a builder for creating a UserRole entity.
-func (c *UserRoleClient) Create() *UserRoleCreate {
-	mutation := newUserRoleMutation(c.config, OpCreate)
-	return &UserRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WalletTransaction entity.
+func (c *WalletTransactionClient) Create() *WalletTransactionCreate {
+	mutation := newWalletTransactionMutation(c.config, OpCreate)
+	return &WalletTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of UserRole entities.
-func (c *UserRoleClient) CreateBulk(builders ...*UserRoleCreate) *UserRoleCreateBulk {
-	return &UserRoleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WalletTransaction entities.
+func (c *WalletTransactionClient) CreateBulk(builders ...*WalletTransactionCreate) *WalletTransactionCreateBulk {
+	return &WalletTransactionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserRoleClient) MapCreateBulk(slice any, setFunc func(*UserRoleCreate, int)) *UserRoleCreateBulk {
+func (c *WalletTransactionClient) MapCreateBulk(slice any, setFunc func(*WalletTransactionCreate, int)) *WalletTransactionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserRoleCreateBulk{err: fmt.Errorf("calling to UserRoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WalletTransactionCreateBulk{err: fmt.Errorf("calling to WalletTransactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserRoleCreate, rv.Len())
+	builders := make([]*WalletTransactionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserRoleCreateBulk{config: c.config, builders: builders}
+	return &WalletTransactionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for UserRole.
-func (c *UserRoleClient) Update() *UserRoleUpdate {
-	mutation := newUserRoleMutation(c.config, OpUpdate)
-	return &UserRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WalletTransaction.
+func (c *WalletTransactionClient) Update() *WalletTransactionUpdate {
+	mutation := newWalletTransactionMutation(c.config, OpUpdate)
+	return &WalletTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserRoleClient) UpdateOne(_m *UserRole) *UserRoleUpdateOne {
-	mutation := newUserRoleMutation(c.config, OpUpdateOne, withUserRole(_m))
-	return &UserRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WalletTransactionClient) UpdateOne(_m *WalletTransaction) *WalletTransactionUpdateOne {
+	mutation := newWalletTransactionMutation(c.config, OpUpdateOne, withWalletTransaction(_m))
+	return &WalletTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserRoleClient) UpdateOneID(id int) *UserRoleUpdateOne {
-	mutation := newUserRoleMutation(c.config, OpUpdateOne, withUserRoleID(id))
-	return &UserRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WalletTransactionClient) UpdateOneID(id int) *WalletTransactionUpdateOne {
+	mutation := newWalletTransactionMutation(c.config, OpUpdateOne, withWalletTransactionID(id))
+	return &WalletTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for UserRole.
-func (c *UserRoleClient) Delete() *UserRoleDelete {
-	mutation := newUserRoleMutation(c.config, OpDelete)
-	return &UserRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WalletTransaction.
+func (c *WalletTransactionClient) Delete() *WalletTransactionDelete {
+	mutation := newWalletTransactionMutation(c.config, OpDelete)
+	return &WalletTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserRoleClient) DeleteOne(_m *UserRole) *UserRoleDeleteOne {
+func (c *WalletTransactionClient) DeleteOne(_m *WalletTransaction) *WalletTransactionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserRoleClient) DeleteOneID(id int) *UserRoleDeleteOne {
-	builder := c.Delete().Where(userrole.ID(id))
+func (c *WalletTransactionClient) DeleteOneID(id int) *WalletTransactionDeleteOne {
+	builder := c.Delete().Where(wallettransaction.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserRoleDeleteOne{builder}
+	return &WalletTransactionDeleteOne{builder}
 }
 
-// Query returns a query builder for UserRole.
-func (c *UserRoleClient) Query() *UserRoleQuery {
-	return &UserRoleQuery{
+// Query returns a query builder for WalletTransaction.
+func (c *WalletTransactionClient) Query() *WalletTransactionQuery {
+	return &WalletTransactionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUserRole},
+		ctx:    &QueryContext{Type: TypeWalletTransaction},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a UserRole entity by its id.
-func (c *UserRoleClient) Get(ctx context.Context, id int) (*UserRole, error) {
-	return c.Query().Where(userrole.ID(id)).Only(ctx)
+// Get returns a WalletTransaction entity by its id.
+func (c *WalletTransactionClient) Get(ctx context.Context, id int) (*WalletTransaction, error) {
+	return c.Query().Where(wallettransaction.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserRoleClient) GetX(ctx context.Context, id int) *UserRole {
+func (c *WalletTransactionClient) GetX(ctx context.Context, id int) *WalletTransaction {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4739,15 +6807,15 @@ func (c *UserRoleClient) GetX(ctx context.Context, id int) *UserRole {
 	return obj
 }
 
-// QueryUser queries the user edge of a UserRole.
-func (c *UserRoleClient) QueryUser(_m *UserRole) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryWallet queries the wallet edge of a WalletTransaction.
+func (c *WalletTransactionClient) QueryWallet(_m *WalletTransaction) *WalletQuery {
+	query := (&WalletClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(userrole.Table, userrole.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, userrole.UserTable, userrole.UserColumn),
+			sqlgraph.From(wallettransaction.Table, wallettransaction.FieldID, id),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, wallettransaction.WalletTable, wallettransaction.WalletColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4755,61 +6823,186 @@ func (c *UserRoleClient) QueryUser(_m *UserRole) *UserQuery {
 	return query
 }
 
-// QueryRole queries the role edge of a UserRole.
-func (c *UserRoleClient) QueryRole(_m *UserRole) *RoleQuery {
-	query := (&RoleClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(userrole.Table, userrole.FieldID, id),
-			sqlgraph.To(role.Table, role.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, userrole.RoleTable, userrole.RoleColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *WalletTransactionClient) Hooks() []Hook {
+	hooks := c.hooks.WalletTransaction
+	return append(hooks[:len(hooks):len(hooks)], wallettransaction.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WalletTransactionClient) Interceptors() []Interceptor {
+	return c.inters.WalletTransaction
+}
+
+func (c *WalletTransactionClient) mutate(ctx context.Context, m *WalletTransactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WalletTransactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WalletTransactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WalletTransactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WalletTransactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WalletTransaction mutation op: %q", m.Op())
 	}
-	return query
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id int) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id int) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id int) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id int) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *UserRoleClient) Hooks() []Hook {
-	return c.hooks.UserRole
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	hooks := c.hooks.WebhookDelivery
+	return append(hooks[:len(hooks):len(hooks)], webhookdelivery.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserRoleClient) Interceptors() []Interceptor {
-	return c.inters.UserRole
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
 }
 
-func (c *UserRoleClient) mutate(ctx context.Context, m *UserRoleMutation) (Value, error) {
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserRoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserRoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown UserRole mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		APIKey, APIKeyProfileTemplate, Channel, ChannelModelPrice,
-		ChannelModelPriceVersion, ChannelOverrideTemplate, ChannelProbe, DataStorage,
-		Invitation, Model, OIDCIdentity, Project, Prompt, PromptProtectionRule,
-		ProviderQuotaStatus, Request, RequestExecution, Role, System, Thread, Trace,
-		UsageLog, User, UserProject, UserRole []ent.Hook
+		APIKey, APIKeyProfileTemplate, AuditLog, Budget, Channel, ChannelModelPrice,
+		ChannelModelPriceVersion, ChannelOverrideTemplate, ChannelProbe, ContentBlob,
+		DataExport, DataStorage, ExchangeRate, Invitation, Invoice, Model,
+		OIDCIdentity, Project, Prompt, PromptProtectionRule, ProviderQuotaStatus,
+		Request, RequestExecution, RequestSearchTerm, Role, SCIMGroup, System, Thread,
+		Trace, UsageDailyRollup, UsageHourlyRollup, UsageLog, User, UserProject,
+		UserRole, Wallet, WalletReservation, WalletTransaction,
+		WebhookDelivery []ent.Hook
 	}
 	inters struct {
-		APIKey, APIKeyProfileTemplate, Channel, ChannelModelPrice,
-		ChannelModelPriceVersion, ChannelOverrideTemplate, ChannelProbe, DataStorage,
-		Invitation, Model, OIDCIdentity, Project, Prompt, PromptProtectionRule,
-		ProviderQuotaStatus, Request, RequestExecution, Role, System, Thread, Trace,
-		UsageLog, User, UserProject, UserRole []ent.Interceptor
+		APIKey, APIKeyProfileTemplate, AuditLog, Budget, Channel, ChannelModelPrice,
+		ChannelModelPriceVersion, ChannelOverrideTemplate, ChannelProbe, ContentBlob,
+		DataExport, DataStorage, ExchangeRate, Invitation, Invoice, Model,
+		OIDCIdentity, Project, Prompt, PromptProtectionRule, ProviderQuotaStatus,
+		Request, RequestExecution, RequestSearchTerm, Role, SCIMGroup, System, Thread,
+		Trace, UsageDailyRollup, UsageHourlyRollup, UsageLog, User, UserProject,
+		UserRole, Wallet, WalletReservation, WalletTransaction,
+		WebhookDelivery []ent.Interceptor
 	}
 )
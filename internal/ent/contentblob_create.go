@@ -0,0 +1,660 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/contentblob"
+)
+
+// ContentBlobCreate is the builder for creating a ContentBlob entity.
+type ContentBlobCreate struct {
+	config
+	mutation *ContentBlobMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ContentBlobCreate) SetCreatedAt(v time.Time) *ContentBlobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ContentBlobCreate) SetNillableCreatedAt(v *time.Time) *ContentBlobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ContentBlobCreate) SetUpdatedAt(v time.Time) *ContentBlobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ContentBlobCreate) SetNillableUpdatedAt(v *time.Time) *ContentBlobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetHash sets the "hash" field.
+func (_c *ContentBlobCreate) SetHash(v string) *ContentBlobCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetData sets the "data" field.
+func (_c *ContentBlobCreate) SetData(v []byte) *ContentBlobCreate {
+	_c.mutation.SetData(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *ContentBlobCreate) SetSize(v int64) *ContentBlobCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetRefCount sets the "ref_count" field.
+func (_c *ContentBlobCreate) SetRefCount(v int64) *ContentBlobCreate {
+	_c.mutation.SetRefCount(v)
+	return _c
+}
+
+// SetNillableRefCount sets the "ref_count" field if the given value is not nil.
+func (_c *ContentBlobCreate) SetNillableRefCount(v *int64) *ContentBlobCreate {
+	if v != nil {
+		_c.SetRefCount(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ContentBlobMutation object of the builder.
+func (_c *ContentBlobCreate) Mutation() *ContentBlobMutation {
+	return _c.mutation
+}
+
+// Save creates the ContentBlob in the database.
+func (_c *ContentBlobCreate) Save(ctx context.Context) (*ContentBlob, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ContentBlobCreate) SaveX(ctx context.Context) *ContentBlob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContentBlobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContentBlobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ContentBlobCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if contentblob.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized contentblob.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := contentblob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if contentblob.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized contentblob.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := contentblob.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.RefCount(); !ok {
+		v := contentblob.DefaultRefCount
+		_c.mutation.SetRefCount(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ContentBlobCreate) check() error {
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "ContentBlob.hash"`)}
+	}
+	if v, ok := _c.mutation.Hash(); ok {
+		if err := contentblob.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "ContentBlob.hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`ent: missing required field "ContentBlob.data"`)}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "ContentBlob.size"`)}
+	}
+	if _, ok := _c.mutation.RefCount(); !ok {
+		return &ValidationError{Name: "ref_count", err: errors.New(`ent: missing required field "ContentBlob.ref_count"`)}
+	}
+	return nil
+}
+
+func (_c *ContentBlobCreate) sqlSave(ctx context.Context) (*ContentBlob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ContentBlobCreate) createSpec() (*ContentBlob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContentBlob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(contentblob.Table, sqlgraph.NewFieldSpec(contentblob.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(contentblob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(contentblob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(contentblob.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.Data(); ok {
+		_spec.SetField(contentblob.FieldData, field.TypeBytes, value)
+		_node.Data = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(contentblob.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.RefCount(); ok {
+		_spec.SetField(contentblob.FieldRefCount, field.TypeInt64, value)
+		_node.RefCount = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ContentBlob.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ContentBlobUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ContentBlobCreate) OnConflict(opts ...sql.ConflictOption) *ContentBlobUpsertOne {
+	_c.conflict = opts
+	return &ContentBlobUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ContentBlob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ContentBlobCreate) OnConflictColumns(columns ...string) *ContentBlobUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ContentBlobUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ContentBlobUpsertOne is the builder for "upsert"-ing
+	//  one ContentBlob node.
+	ContentBlobUpsertOne struct {
+		create *ContentBlobCreate
+	}
+
+	// ContentBlobUpsert is the "OnConflict" setter.
+	ContentBlobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ContentBlobUpsert) SetUpdatedAt(v time.Time) *ContentBlobUpsert {
+	u.Set(contentblob.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ContentBlobUpsert) UpdateUpdatedAt() *ContentBlobUpsert {
+	u.SetExcluded(contentblob.FieldUpdatedAt)
+	return u
+}
+
+// SetRefCount sets the "ref_count" field.
+func (u *ContentBlobUpsert) SetRefCount(v int64) *ContentBlobUpsert {
+	u.Set(contentblob.FieldRefCount, v)
+	return u
+}
+
+// UpdateRefCount sets the "ref_count" field to the value that was provided on create.
+func (u *ContentBlobUpsert) UpdateRefCount() *ContentBlobUpsert {
+	u.SetExcluded(contentblob.FieldRefCount)
+	return u
+}
+
+// AddRefCount adds v to the "ref_count" field.
+func (u *ContentBlobUpsert) AddRefCount(v int64) *ContentBlobUpsert {
+	u.Add(contentblob.FieldRefCount, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ContentBlob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ContentBlobUpsertOne) UpdateNewValues() *ContentBlobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(contentblob.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Hash(); exists {
+			s.SetIgnore(contentblob.FieldHash)
+		}
+		if _, exists := u.create.mutation.Data(); exists {
+			s.SetIgnore(contentblob.FieldData)
+		}
+		if _, exists := u.create.mutation.Size(); exists {
+			s.SetIgnore(contentblob.FieldSize)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ContentBlob.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ContentBlobUpsertOne) Ignore() *ContentBlobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ContentBlobUpsertOne) DoNothing() *ContentBlobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ContentBlobCreate.OnConflict
+// documentation for more info.
+func (u *ContentBlobUpsertOne) Update(set func(*ContentBlobUpsert)) *ContentBlobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ContentBlobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ContentBlobUpsertOne) SetUpdatedAt(v time.Time) *ContentBlobUpsertOne {
+	return u.Update(func(s *ContentBlobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ContentBlobUpsertOne) UpdateUpdatedAt() *ContentBlobUpsertOne {
+	return u.Update(func(s *ContentBlobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetRefCount sets the "ref_count" field.
+func (u *ContentBlobUpsertOne) SetRefCount(v int64) *ContentBlobUpsertOne {
+	return u.Update(func(s *ContentBlobUpsert) {
+		s.SetRefCount(v)
+	})
+}
+
+// AddRefCount adds v to the "ref_count" field.
+func (u *ContentBlobUpsertOne) AddRefCount(v int64) *ContentBlobUpsertOne {
+	return u.Update(func(s *ContentBlobUpsert) {
+		s.AddRefCount(v)
+	})
+}
+
+// UpdateRefCount sets the "ref_count" field to the value that was provided on create.
+func (u *ContentBlobUpsertOne) UpdateRefCount() *ContentBlobUpsertOne {
+	return u.Update(func(s *ContentBlobUpsert) {
+		s.UpdateRefCount()
+	})
+}
+
+// Exec executes the query.
+func (u *ContentBlobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ContentBlobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ContentBlobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ContentBlobUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ContentBlobUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ContentBlobCreateBulk is the builder for creating many ContentBlob entities in bulk.
+type ContentBlobCreateBulk struct {
+	config
+	err      error
+	builders []*ContentBlobCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ContentBlob entities in the database.
+func (_c *ContentBlobCreateBulk) Save(ctx context.Context) ([]*ContentBlob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ContentBlob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContentBlobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ContentBlobCreateBulk) SaveX(ctx context.Context) []*ContentBlob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContentBlobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContentBlobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ContentBlob.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ContentBlobUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ContentBlobCreateBulk) OnConflict(opts ...sql.ConflictOption) *ContentBlobUpsertBulk {
+	_c.conflict = opts
+	return &ContentBlobUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ContentBlob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ContentBlobCreateBulk) OnConflictColumns(columns ...string) *ContentBlobUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ContentBlobUpsertBulk{
+		create: _c,
+	}
+}
+
+// ContentBlobUpsertBulk is the builder for "upsert"-ing
+// a bulk of ContentBlob nodes.
+type ContentBlobUpsertBulk struct {
+	create *ContentBlobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ContentBlob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ContentBlobUpsertBulk) UpdateNewValues() *ContentBlobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(contentblob.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Hash(); exists {
+				s.SetIgnore(contentblob.FieldHash)
+			}
+			if _, exists := b.mutation.Data(); exists {
+				s.SetIgnore(contentblob.FieldData)
+			}
+			if _, exists := b.mutation.Size(); exists {
+				s.SetIgnore(contentblob.FieldSize)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ContentBlob.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ContentBlobUpsertBulk) Ignore() *ContentBlobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ContentBlobUpsertBulk) DoNothing() *ContentBlobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ContentBlobCreateBulk.OnConflict
+// documentation for more info.
+func (u *ContentBlobUpsertBulk) Update(set func(*ContentBlobUpsert)) *ContentBlobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ContentBlobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ContentBlobUpsertBulk) SetUpdatedAt(v time.Time) *ContentBlobUpsertBulk {
+	return u.Update(func(s *ContentBlobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ContentBlobUpsertBulk) UpdateUpdatedAt() *ContentBlobUpsertBulk {
+	return u.Update(func(s *ContentBlobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetRefCount sets the "ref_count" field.
+func (u *ContentBlobUpsertBulk) SetRefCount(v int64) *ContentBlobUpsertBulk {
+	return u.Update(func(s *ContentBlobUpsert) {
+		s.SetRefCount(v)
+	})
+}
+
+// AddRefCount adds v to the "ref_count" field.
+func (u *ContentBlobUpsertBulk) AddRefCount(v int64) *ContentBlobUpsertBulk {
+	return u.Update(func(s *ContentBlobUpsert) {
+		s.AddRefCount(v)
+	})
+}
+
+// UpdateRefCount sets the "ref_count" field to the value that was provided on create.
+func (u *ContentBlobUpsertBulk) UpdateRefCount() *ContentBlobUpsertBulk {
+	return u.Update(func(s *ContentBlobUpsert) {
+		s.UpdateRefCount()
+	})
+}
+
+// Exec executes the query.
+func (u *ContentBlobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ContentBlobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ContentBlobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ContentBlobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
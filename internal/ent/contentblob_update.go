@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/contentblob"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+)
+
+// ContentBlobUpdate is the builder for updating ContentBlob entities.
+type ContentBlobUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ContentBlobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ContentBlobUpdate builder.
+func (_u *ContentBlobUpdate) Where(ps ...predicate.ContentBlob) *ContentBlobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ContentBlobUpdate) SetUpdatedAt(v time.Time) *ContentBlobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRefCount sets the "ref_count" field.
+func (_u *ContentBlobUpdate) SetRefCount(v int64) *ContentBlobUpdate {
+	_u.mutation.ResetRefCount()
+	_u.mutation.SetRefCount(v)
+	return _u
+}
+
+// SetNillableRefCount sets the "ref_count" field if the given value is not nil.
+func (_u *ContentBlobUpdate) SetNillableRefCount(v *int64) *ContentBlobUpdate {
+	if v != nil {
+		_u.SetRefCount(*v)
+	}
+	return _u
+}
+
+// AddRefCount adds value to the "ref_count" field.
+func (_u *ContentBlobUpdate) AddRefCount(v int64) *ContentBlobUpdate {
+	_u.mutation.AddRefCount(v)
+	return _u
+}
+
+// Mutation returns the ContentBlobMutation object of the builder.
+func (_u *ContentBlobUpdate) Mutation() *ContentBlobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ContentBlobUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContentBlobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ContentBlobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContentBlobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ContentBlobUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if contentblob.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized contentblob.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := contentblob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ContentBlobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ContentBlobUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ContentBlobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(contentblob.Table, contentblob.Columns, sqlgraph.NewFieldSpec(contentblob.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(contentblob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RefCount(); ok {
+		_spec.SetField(contentblob.FieldRefCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRefCount(); ok {
+		_spec.AddField(contentblob.FieldRefCount, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentblob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ContentBlobUpdateOne is the builder for updating a single ContentBlob entity.
+type ContentBlobUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ContentBlobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ContentBlobUpdateOne) SetUpdatedAt(v time.Time) *ContentBlobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRefCount sets the "ref_count" field.
+func (_u *ContentBlobUpdateOne) SetRefCount(v int64) *ContentBlobUpdateOne {
+	_u.mutation.ResetRefCount()
+	_u.mutation.SetRefCount(v)
+	return _u
+}
+
+// SetNillableRefCount sets the "ref_count" field if the given value is not nil.
+func (_u *ContentBlobUpdateOne) SetNillableRefCount(v *int64) *ContentBlobUpdateOne {
+	if v != nil {
+		_u.SetRefCount(*v)
+	}
+	return _u
+}
+
+// AddRefCount adds value to the "ref_count" field.
+func (_u *ContentBlobUpdateOne) AddRefCount(v int64) *ContentBlobUpdateOne {
+	_u.mutation.AddRefCount(v)
+	return _u
+}
+
+// Mutation returns the ContentBlobMutation object of the builder.
+func (_u *ContentBlobUpdateOne) Mutation() *ContentBlobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ContentBlobUpdate builder.
+func (_u *ContentBlobUpdateOne) Where(ps ...predicate.ContentBlob) *ContentBlobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ContentBlobUpdateOne) Select(field string, fields ...string) *ContentBlobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ContentBlob entity.
+func (_u *ContentBlobUpdateOne) Save(ctx context.Context) (*ContentBlob, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContentBlobUpdateOne) SaveX(ctx context.Context) *ContentBlob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ContentBlobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContentBlobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ContentBlobUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if contentblob.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized contentblob.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := contentblob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ContentBlobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ContentBlobUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ContentBlobUpdateOne) sqlSave(ctx context.Context) (_node *ContentBlob, err error) {
+	_spec := sqlgraph.NewUpdateSpec(contentblob.Table, contentblob.Columns, sqlgraph.NewFieldSpec(contentblob.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ContentBlob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contentblob.FieldID)
+		for _, f := range fields {
+			if !contentblob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != contentblob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(contentblob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RefCount(); ok {
+		_spec.SetField(contentblob.FieldRefCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRefCount(); ok {
+		_spec.AddField(contentblob.FieldRefCount, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ContentBlob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contentblob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,1265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/dataexport"
+	"github.com/looplj/axonhub/internal/ent/project"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// DataExportCreate is the builder for creating a DataExport entity.
+type DataExportCreate struct {
+	config
+	mutation *DataExportMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DataExportCreate) SetCreatedAt(v time.Time) *DataExportCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableCreatedAt(v *time.Time) *DataExportCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DataExportCreate) SetUpdatedAt(v time.Time) *DataExportCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableUpdatedAt(v *time.Time) *DataExportCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetProjectID sets the "project_id" field.
+func (_c *DataExportCreate) SetProjectID(v int) *DataExportCreate {
+	_c.mutation.SetProjectID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *DataExportCreate) SetUserID(v int) *DataExportCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableUserID(v *int) *DataExportCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetResource sets the "resource" field.
+func (_c *DataExportCreate) SetResource(v dataexport.Resource) *DataExportCreate {
+	_c.mutation.SetResource(v)
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *DataExportCreate) SetFormat(v dataexport.Format) *DataExportCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetIncludeBodies sets the "include_bodies" field.
+func (_c *DataExportCreate) SetIncludeBodies(v bool) *DataExportCreate {
+	_c.mutation.SetIncludeBodies(v)
+	return _c
+}
+
+// SetNillableIncludeBodies sets the "include_bodies" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableIncludeBodies(v *bool) *DataExportCreate {
+	if v != nil {
+		_c.SetIncludeBodies(*v)
+	}
+	return _c
+}
+
+// SetCriteria sets the "criteria" field.
+func (_c *DataExportCreate) SetCriteria(v objects.DataExportCriteria) *DataExportCreate {
+	_c.mutation.SetCriteria(v)
+	return _c
+}
+
+// SetNillableCriteria sets the "criteria" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableCriteria(v *objects.DataExportCriteria) *DataExportCreate {
+	if v != nil {
+		_c.SetCriteria(*v)
+	}
+	return _c
+}
+
+// SetDataStorageID sets the "data_storage_id" field.
+func (_c *DataExportCreate) SetDataStorageID(v int) *DataExportCreate {
+	_c.mutation.SetDataStorageID(v)
+	return _c
+}
+
+// SetNillableDataStorageID sets the "data_storage_id" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableDataStorageID(v *int) *DataExportCreate {
+	if v != nil {
+		_c.SetDataStorageID(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *DataExportCreate) SetStatus(v dataexport.Status) *DataExportCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableStatus(v *dataexport.Status) *DataExportCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_c *DataExportCreate) SetStorageKey(v string) *DataExportCreate {
+	_c.mutation.SetStorageKey(v)
+	return _c
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableStorageKey(v *string) *DataExportCreate {
+	if v != nil {
+		_c.SetStorageKey(*v)
+	}
+	return _c
+}
+
+// SetRowCount sets the "row_count" field.
+func (_c *DataExportCreate) SetRowCount(v int64) *DataExportCreate {
+	_c.mutation.SetRowCount(v)
+	return _c
+}
+
+// SetNillableRowCount sets the "row_count" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableRowCount(v *int64) *DataExportCreate {
+	if v != nil {
+		_c.SetRowCount(*v)
+	}
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *DataExportCreate) SetSize(v int64) *DataExportCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableSize(v *int64) *DataExportCreate {
+	if v != nil {
+		_c.SetSize(*v)
+	}
+	return _c
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_c *DataExportCreate) SetErrorMessage(v string) *DataExportCreate {
+	_c.mutation.SetErrorMessage(v)
+	return _c
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableErrorMessage(v *string) *DataExportCreate {
+	if v != nil {
+		_c.SetErrorMessage(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *DataExportCreate) SetStartedAt(v time.Time) *DataExportCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableStartedAt(v *time.Time) *DataExportCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *DataExportCreate) SetCompletedAt(v time.Time) *DataExportCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *DataExportCreate) SetNillableCompletedAt(v *time.Time) *DataExportCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_c *DataExportCreate) SetProject(v *Project) *DataExportCreate {
+	return _c.SetProjectID(v.ID)
+}
+
+// Mutation returns the DataExportMutation object of the builder.
+func (_c *DataExportCreate) Mutation() *DataExportMutation {
+	return _c.mutation
+}
+
+// Save creates the DataExport in the database.
+func (_c *DataExportCreate) Save(ctx context.Context) (*DataExport, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DataExportCreate) SaveX(ctx context.Context) *DataExport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DataExportCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DataExportCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DataExportCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if dataexport.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dataexport.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := dataexport.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if dataexport.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dataexport.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := dataexport.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.IncludeBodies(); !ok {
+		v := dataexport.DefaultIncludeBodies
+		_c.mutation.SetIncludeBodies(v)
+	}
+	if _, ok := _c.mutation.Criteria(); !ok {
+		v := dataexport.DefaultCriteria
+		_c.mutation.SetCriteria(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := dataexport.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.RowCount(); !ok {
+		v := dataexport.DefaultRowCount
+		_c.mutation.SetRowCount(v)
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		v := dataexport.DefaultSize
+		_c.mutation.SetSize(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DataExportCreate) check() error {
+	if _, ok := _c.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "DataExport.project_id"`)}
+	}
+	if _, ok := _c.mutation.Resource(); !ok {
+		return &ValidationError{Name: "resource", err: errors.New(`ent: missing required field "DataExport.resource"`)}
+	}
+	if v, ok := _c.mutation.Resource(); ok {
+		if err := dataexport.ResourceValidator(v); err != nil {
+			return &ValidationError{Name: "resource", err: fmt.Errorf(`ent: validator failed for field "DataExport.resource": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "DataExport.format"`)}
+	}
+	if v, ok := _c.mutation.Format(); ok {
+		if err := dataexport.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "DataExport.format": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IncludeBodies(); !ok {
+		return &ValidationError{Name: "include_bodies", err: errors.New(`ent: missing required field "DataExport.include_bodies"`)}
+	}
+	if _, ok := _c.mutation.Criteria(); !ok {
+		return &ValidationError{Name: "criteria", err: errors.New(`ent: missing required field "DataExport.criteria"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DataExport.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := dataexport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DataExport.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RowCount(); !ok {
+		return &ValidationError{Name: "row_count", err: errors.New(`ent: missing required field "DataExport.row_count"`)}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "DataExport.size"`)}
+	}
+	if len(_c.mutation.ProjectIDs()) == 0 {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required edge "DataExport.project"`)}
+	}
+	return nil
+}
+
+func (_c *DataExportCreate) sqlSave(ctx context.Context) (*DataExport, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DataExportCreate) createSpec() (*DataExport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DataExport{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(dataexport.Table, sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(dataexport.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(dataexport.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(dataexport.FieldUserID, field.TypeInt, value)
+		_node.UserID = &value
+	}
+	if value, ok := _c.mutation.Resource(); ok {
+		_spec.SetField(dataexport.FieldResource, field.TypeEnum, value)
+		_node.Resource = value
+	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(dataexport.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.IncludeBodies(); ok {
+		_spec.SetField(dataexport.FieldIncludeBodies, field.TypeBool, value)
+		_node.IncludeBodies = value
+	}
+	if value, ok := _c.mutation.Criteria(); ok {
+		_spec.SetField(dataexport.FieldCriteria, field.TypeJSON, value)
+		_node.Criteria = value
+	}
+	if value, ok := _c.mutation.DataStorageID(); ok {
+		_spec.SetField(dataexport.FieldDataStorageID, field.TypeInt, value)
+		_node.DataStorageID = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(dataexport.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.StorageKey(); ok {
+		_spec.SetField(dataexport.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = &value
+	}
+	if value, ok := _c.mutation.RowCount(); ok {
+		_spec.SetField(dataexport.FieldRowCount, field.TypeInt64, value)
+		_node.RowCount = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(dataexport.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.ErrorMessage(); ok {
+		_spec.SetField(dataexport.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = &value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(dataexport.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(dataexport.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if nodes := _c.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dataexport.ProjectTable,
+			Columns: []string{dataexport.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DataExport.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DataExportUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DataExportCreate) OnConflict(opts ...sql.ConflictOption) *DataExportUpsertOne {
+	_c.conflict = opts
+	return &DataExportUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DataExportCreate) OnConflictColumns(columns ...string) *DataExportUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DataExportUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DataExportUpsertOne is the builder for "upsert"-ing
+	//  one DataExport node.
+	DataExportUpsertOne struct {
+		create *DataExportCreate
+	}
+
+	// DataExportUpsert is the "OnConflict" setter.
+	DataExportUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DataExportUpsert) SetUpdatedAt(v time.Time) *DataExportUpsert {
+	u.Set(dataexport.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateUpdatedAt() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldUpdatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *DataExportUpsert) SetStatus(v dataexport.Status) *DataExportUpsert {
+	u.Set(dataexport.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateStatus() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldStatus)
+	return u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *DataExportUpsert) SetStorageKey(v string) *DataExportUpsert {
+	u.Set(dataexport.FieldStorageKey, v)
+	return u
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateStorageKey() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldStorageKey)
+	return u
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (u *DataExportUpsert) ClearStorageKey() *DataExportUpsert {
+	u.SetNull(dataexport.FieldStorageKey)
+	return u
+}
+
+// SetRowCount sets the "row_count" field.
+func (u *DataExportUpsert) SetRowCount(v int64) *DataExportUpsert {
+	u.Set(dataexport.FieldRowCount, v)
+	return u
+}
+
+// UpdateRowCount sets the "row_count" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateRowCount() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldRowCount)
+	return u
+}
+
+// AddRowCount adds v to the "row_count" field.
+func (u *DataExportUpsert) AddRowCount(v int64) *DataExportUpsert {
+	u.Add(dataexport.FieldRowCount, v)
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *DataExportUpsert) SetSize(v int64) *DataExportUpsert {
+	u.Set(dataexport.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateSize() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *DataExportUpsert) AddSize(v int64) *DataExportUpsert {
+	u.Add(dataexport.FieldSize, v)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *DataExportUpsert) SetErrorMessage(v string) *DataExportUpsert {
+	u.Set(dataexport.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateErrorMessage() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *DataExportUpsert) ClearErrorMessage() *DataExportUpsert {
+	u.SetNull(dataexport.FieldErrorMessage)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *DataExportUpsert) SetStartedAt(v time.Time) *DataExportUpsert {
+	u.Set(dataexport.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateStartedAt() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *DataExportUpsert) ClearStartedAt() *DataExportUpsert {
+	u.SetNull(dataexport.FieldStartedAt)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *DataExportUpsert) SetCompletedAt(v time.Time) *DataExportUpsert {
+	u.Set(dataexport.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *DataExportUpsert) UpdateCompletedAt() *DataExportUpsert {
+	u.SetExcluded(dataexport.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *DataExportUpsert) ClearCompletedAt() *DataExportUpsert {
+	u.SetNull(dataexport.FieldCompletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DataExportUpsertOne) UpdateNewValues() *DataExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(dataexport.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(dataexport.FieldProjectID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(dataexport.FieldUserID)
+		}
+		if _, exists := u.create.mutation.Resource(); exists {
+			s.SetIgnore(dataexport.FieldResource)
+		}
+		if _, exists := u.create.mutation.Format(); exists {
+			s.SetIgnore(dataexport.FieldFormat)
+		}
+		if _, exists := u.create.mutation.IncludeBodies(); exists {
+			s.SetIgnore(dataexport.FieldIncludeBodies)
+		}
+		if _, exists := u.create.mutation.Criteria(); exists {
+			s.SetIgnore(dataexport.FieldCriteria)
+		}
+		if _, exists := u.create.mutation.DataStorageID(); exists {
+			s.SetIgnore(dataexport.FieldDataStorageID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DataExportUpsertOne) Ignore() *DataExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DataExportUpsertOne) DoNothing() *DataExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DataExportCreate.OnConflict
+// documentation for more info.
+func (u *DataExportUpsertOne) Update(set func(*DataExportUpsert)) *DataExportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DataExportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DataExportUpsertOne) SetUpdatedAt(v time.Time) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateUpdatedAt() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DataExportUpsertOne) SetStatus(v dataexport.Status) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateStatus() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *DataExportUpsertOne) SetStorageKey(v string) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetStorageKey(v)
+	})
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateStorageKey() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateStorageKey()
+	})
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (u *DataExportUpsertOne) ClearStorageKey() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearStorageKey()
+	})
+}
+
+// SetRowCount sets the "row_count" field.
+func (u *DataExportUpsertOne) SetRowCount(v int64) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetRowCount(v)
+	})
+}
+
+// AddRowCount adds v to the "row_count" field.
+func (u *DataExportUpsertOne) AddRowCount(v int64) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.AddRowCount(v)
+	})
+}
+
+// UpdateRowCount sets the "row_count" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateRowCount() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateRowCount()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *DataExportUpsertOne) SetSize(v int64) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *DataExportUpsertOne) AddSize(v int64) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateSize() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *DataExportUpsertOne) SetErrorMessage(v string) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateErrorMessage() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *DataExportUpsertOne) ClearErrorMessage() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *DataExportUpsertOne) SetStartedAt(v time.Time) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateStartedAt() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *DataExportUpsertOne) ClearStartedAt() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *DataExportUpsertOne) SetCompletedAt(v time.Time) *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *DataExportUpsertOne) UpdateCompletedAt() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *DataExportUpsertOne) ClearCompletedAt() *DataExportUpsertOne {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DataExportUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DataExportCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DataExportUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DataExportUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DataExportUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DataExportCreateBulk is the builder for creating many DataExport entities in bulk.
+type DataExportCreateBulk struct {
+	config
+	err      error
+	builders []*DataExportCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DataExport entities in the database.
+func (_c *DataExportCreateBulk) Save(ctx context.Context) ([]*DataExport, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DataExport, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DataExportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DataExportCreateBulk) SaveX(ctx context.Context) []*DataExport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DataExportCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DataExportCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DataExport.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DataExportUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DataExportCreateBulk) OnConflict(opts ...sql.ConflictOption) *DataExportUpsertBulk {
+	_c.conflict = opts
+	return &DataExportUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DataExportCreateBulk) OnConflictColumns(columns ...string) *DataExportUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DataExportUpsertBulk{
+		create: _c,
+	}
+}
+
+// DataExportUpsertBulk is the builder for "upsert"-ing
+// a bulk of DataExport nodes.
+type DataExportUpsertBulk struct {
+	create *DataExportCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DataExportUpsertBulk) UpdateNewValues() *DataExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(dataexport.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(dataexport.FieldProjectID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(dataexport.FieldUserID)
+			}
+			if _, exists := b.mutation.Resource(); exists {
+				s.SetIgnore(dataexport.FieldResource)
+			}
+			if _, exists := b.mutation.Format(); exists {
+				s.SetIgnore(dataexport.FieldFormat)
+			}
+			if _, exists := b.mutation.IncludeBodies(); exists {
+				s.SetIgnore(dataexport.FieldIncludeBodies)
+			}
+			if _, exists := b.mutation.Criteria(); exists {
+				s.SetIgnore(dataexport.FieldCriteria)
+			}
+			if _, exists := b.mutation.DataStorageID(); exists {
+				s.SetIgnore(dataexport.FieldDataStorageID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DataExport.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DataExportUpsertBulk) Ignore() *DataExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DataExportUpsertBulk) DoNothing() *DataExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DataExportCreateBulk.OnConflict
+// documentation for more info.
+func (u *DataExportUpsertBulk) Update(set func(*DataExportUpsert)) *DataExportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DataExportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DataExportUpsertBulk) SetUpdatedAt(v time.Time) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateUpdatedAt() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DataExportUpsertBulk) SetStatus(v dataexport.Status) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateStatus() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (u *DataExportUpsertBulk) SetStorageKey(v string) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetStorageKey(v)
+	})
+}
+
+// UpdateStorageKey sets the "storage_key" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateStorageKey() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateStorageKey()
+	})
+}
+
+// ClearStorageKey clears the value of the "storage_key" field.
+func (u *DataExportUpsertBulk) ClearStorageKey() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearStorageKey()
+	})
+}
+
+// SetRowCount sets the "row_count" field.
+func (u *DataExportUpsertBulk) SetRowCount(v int64) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetRowCount(v)
+	})
+}
+
+// AddRowCount adds v to the "row_count" field.
+func (u *DataExportUpsertBulk) AddRowCount(v int64) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.AddRowCount(v)
+	})
+}
+
+// UpdateRowCount sets the "row_count" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateRowCount() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateRowCount()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *DataExportUpsertBulk) SetSize(v int64) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *DataExportUpsertBulk) AddSize(v int64) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateSize() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *DataExportUpsertBulk) SetErrorMessage(v string) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateErrorMessage() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *DataExportUpsertBulk) ClearErrorMessage() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *DataExportUpsertBulk) SetStartedAt(v time.Time) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateStartedAt() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *DataExportUpsertBulk) ClearStartedAt() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *DataExportUpsertBulk) SetCompletedAt(v time.Time) *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *DataExportUpsertBulk) UpdateCompletedAt() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *DataExportUpsertBulk) ClearCompletedAt() *DataExportUpsertBulk {
+	return u.Update(func(s *DataExportUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DataExportUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DataExportCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DataExportCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DataExportUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
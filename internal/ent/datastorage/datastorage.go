@@ -113,6 +113,8 @@ const (
 	TypeS3       Type = "s3"
 	TypeGcs      Type = "gcs"
 	TypeWebdav   Type = "webdav"
+	TypeAzblob   Type = "azblob"
+	TypeSftp     Type = "sftp"
 )
 
 func (_type Type) String() string {
@@ -122,7 +124,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeDatabase, TypeFs, TypeS3, TypeGcs, TypeWebdav:
+	case TypeDatabase, TypeFs, TypeS3, TypeGcs, TypeWebdav, TypeAzblob, TypeSftp:
 		return nil
 	default:
 		return fmt.Errorf("datastorage: invalid enum value for type field: %q", _type)
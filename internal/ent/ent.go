@@ -14,13 +14,19 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/apikeyprofiletemplate"
+	"github.com/looplj/axonhub/internal/ent/auditlog"
+	"github.com/looplj/axonhub/internal/ent/budget"
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/channelmodelprice"
 	"github.com/looplj/axonhub/internal/ent/channelmodelpriceversion"
 	"github.com/looplj/axonhub/internal/ent/channeloverridetemplate"
 	"github.com/looplj/axonhub/internal/ent/channelprobe"
+	"github.com/looplj/axonhub/internal/ent/contentblob"
+	"github.com/looplj/axonhub/internal/ent/dataexport"
 	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/exchangerate"
 	"github.com/looplj/axonhub/internal/ent/invitation"
+	"github.com/looplj/axonhub/internal/ent/invoice"
 	"github.com/looplj/axonhub/internal/ent/model"
 	"github.com/looplj/axonhub/internal/ent/oidcidentity"
 	"github.com/looplj/axonhub/internal/ent/project"
@@ -29,14 +35,22 @@ import (
 	"github.com/looplj/axonhub/internal/ent/providerquotastatus"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/ent/requestsearchterm"
 	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/scimgroup"
 	"github.com/looplj/axonhub/internal/ent/system"
 	"github.com/looplj/axonhub/internal/ent/thread"
 	"github.com/looplj/axonhub/internal/ent/trace"
+	"github.com/looplj/axonhub/internal/ent/usagedailyrollup"
+	"github.com/looplj/axonhub/internal/ent/usagehourlyrollup"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/ent/user"
 	"github.com/looplj/axonhub/internal/ent/userproject"
 	"github.com/looplj/axonhub/internal/ent/userrole"
+	"github.com/looplj/axonhub/internal/ent/wallet"
+	"github.com/looplj/axonhub/internal/ent/walletreservation"
+	"github.com/looplj/axonhub/internal/ent/wallettransaction"
+	"github.com/looplj/axonhub/internal/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -99,13 +113,19 @@ func checkColumn(t, c string) error {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			apikey.Table:                   apikey.ValidColumn,
 			apikeyprofiletemplate.Table:    apikeyprofiletemplate.ValidColumn,
+			auditlog.Table:                 auditlog.ValidColumn,
+			budget.Table:                   budget.ValidColumn,
 			channel.Table:                  channel.ValidColumn,
 			channelmodelprice.Table:        channelmodelprice.ValidColumn,
 			channelmodelpriceversion.Table: channelmodelpriceversion.ValidColumn,
 			channeloverridetemplate.Table:  channeloverridetemplate.ValidColumn,
 			channelprobe.Table:             channelprobe.ValidColumn,
+			contentblob.Table:              contentblob.ValidColumn,
+			dataexport.Table:               dataexport.ValidColumn,
 			datastorage.Table:              datastorage.ValidColumn,
+			exchangerate.Table:             exchangerate.ValidColumn,
 			invitation.Table:               invitation.ValidColumn,
+			invoice.Table:                  invoice.ValidColumn,
 			model.Table:                    model.ValidColumn,
 			oidcidentity.Table:             oidcidentity.ValidColumn,
 			project.Table:                  project.ValidColumn,
@@ -114,14 +134,22 @@ func checkColumn(t, c string) error {
 			providerquotastatus.Table:      providerquotastatus.ValidColumn,
 			request.Table:                  request.ValidColumn,
 			requestexecution.Table:         requestexecution.ValidColumn,
+			requestsearchterm.Table:        requestsearchterm.ValidColumn,
 			role.Table:                     role.ValidColumn,
+			scimgroup.Table:                scimgroup.ValidColumn,
 			system.Table:                   system.ValidColumn,
 			thread.Table:                   thread.ValidColumn,
 			trace.Table:                    trace.ValidColumn,
+			usagedailyrollup.Table:         usagedailyrollup.ValidColumn,
+			usagehourlyrollup.Table:        usagehourlyrollup.ValidColumn,
 			usagelog.Table:                 usagelog.ValidColumn,
 			user.Table:                     user.ValidColumn,
 			userproject.Table:              userproject.ValidColumn,
 			userrole.Table:                 userrole.ValidColumn,
+			wallet.Table:                   wallet.ValidColumn,
+			walletreservation.Table:        walletreservation.ValidColumn,
+			wallettransaction.Table:        wallettransaction.ValidColumn,
+			webhookdelivery.Table:          webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -5,13 +5,19 @@ package ent
 import (
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/apikeyprofiletemplate"
+	"github.com/looplj/axonhub/internal/ent/auditlog"
+	"github.com/looplj/axonhub/internal/ent/budget"
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/channelmodelprice"
 	"github.com/looplj/axonhub/internal/ent/channelmodelpriceversion"
 	"github.com/looplj/axonhub/internal/ent/channeloverridetemplate"
 	"github.com/looplj/axonhub/internal/ent/channelprobe"
+	"github.com/looplj/axonhub/internal/ent/contentblob"
+	"github.com/looplj/axonhub/internal/ent/dataexport"
 	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/exchangerate"
 	"github.com/looplj/axonhub/internal/ent/invitation"
+	"github.com/looplj/axonhub/internal/ent/invoice"
 	"github.com/looplj/axonhub/internal/ent/model"
 	"github.com/looplj/axonhub/internal/ent/oidcidentity"
 	"github.com/looplj/axonhub/internal/ent/predicate"
@@ -21,14 +27,22 @@ import (
 	"github.com/looplj/axonhub/internal/ent/providerquotastatus"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/ent/requestsearchterm"
 	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/scimgroup"
 	"github.com/looplj/axonhub/internal/ent/system"
 	"github.com/looplj/axonhub/internal/ent/thread"
 	"github.com/looplj/axonhub/internal/ent/trace"
+	"github.com/looplj/axonhub/internal/ent/usagedailyrollup"
+	"github.com/looplj/axonhub/internal/ent/usagehourlyrollup"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/ent/user"
 	"github.com/looplj/axonhub/internal/ent/userproject"
 	"github.com/looplj/axonhub/internal/ent/userrole"
+	"github.com/looplj/axonhub/internal/ent/wallet"
+	"github.com/looplj/axonhub/internal/ent/walletreservation"
+	"github.com/looplj/axonhub/internal/ent/wallettransaction"
+	"github.com/looplj/axonhub/internal/ent/webhookdelivery"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -38,7 +52,7 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 25)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 39)}
 	graph.Nodes[0] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   apikey.Table,
@@ -50,18 +64,19 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "APIKey",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			apikey.FieldCreatedAt:  {Type: field.TypeTime, Column: apikey.FieldCreatedAt},
-			apikey.FieldUpdatedAt:  {Type: field.TypeTime, Column: apikey.FieldUpdatedAt},
-			apikey.FieldDeletedAt:  {Type: field.TypeInt, Column: apikey.FieldDeletedAt},
-			apikey.FieldUserID:     {Type: field.TypeInt, Column: apikey.FieldUserID},
-			apikey.FieldProjectID:  {Type: field.TypeInt, Column: apikey.FieldProjectID},
-			apikey.FieldKey:        {Type: field.TypeString, Column: apikey.FieldKey},
-			apikey.FieldName:       {Type: field.TypeString, Column: apikey.FieldName},
-			apikey.FieldType:       {Type: field.TypeEnum, Column: apikey.FieldType},
-			apikey.FieldStatus:     {Type: field.TypeEnum, Column: apikey.FieldStatus},
-			apikey.FieldScopes:     {Type: field.TypeJSON, Column: apikey.FieldScopes},
-			apikey.FieldProfiles:   {Type: field.TypeJSON, Column: apikey.FieldProfiles},
-			apikey.FieldAllowedIps: {Type: field.TypeJSON, Column: apikey.FieldAllowedIps},
+			apikey.FieldCreatedAt:        {Type: field.TypeTime, Column: apikey.FieldCreatedAt},
+			apikey.FieldUpdatedAt:        {Type: field.TypeTime, Column: apikey.FieldUpdatedAt},
+			apikey.FieldDeletedAt:        {Type: field.TypeInt, Column: apikey.FieldDeletedAt},
+			apikey.FieldUserID:           {Type: field.TypeInt, Column: apikey.FieldUserID},
+			apikey.FieldProjectID:        {Type: field.TypeInt, Column: apikey.FieldProjectID},
+			apikey.FieldKey:              {Type: field.TypeString, Column: apikey.FieldKey},
+			apikey.FieldName:             {Type: field.TypeString, Column: apikey.FieldName},
+			apikey.FieldType:             {Type: field.TypeEnum, Column: apikey.FieldType},
+			apikey.FieldStatus:           {Type: field.TypeEnum, Column: apikey.FieldStatus},
+			apikey.FieldScopes:           {Type: field.TypeJSON, Column: apikey.FieldScopes},
+			apikey.FieldProfiles:         {Type: field.TypeJSON, Column: apikey.FieldProfiles},
+			apikey.FieldAllowedIps:       {Type: field.TypeJSON, Column: apikey.FieldAllowedIps},
+			apikey.FieldTokenRevocations: {Type: field.TypeJSON, Column: apikey.FieldTokenRevocations},
 		},
 	}
 	graph.Nodes[1] = &sqlgraph.Node{
@@ -85,6 +100,60 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 	}
 	graph.Nodes[2] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   auditlog.Table,
+			Columns: auditlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditlog.FieldID,
+			},
+		},
+		Type: "AuditLog",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			auditlog.FieldCreatedAt:  {Type: field.TypeTime, Column: auditlog.FieldCreatedAt},
+			auditlog.FieldActorType:  {Type: field.TypeEnum, Column: auditlog.FieldActorType},
+			auditlog.FieldUserID:     {Type: field.TypeInt, Column: auditlog.FieldUserID},
+			auditlog.FieldAPIKeyID:   {Type: field.TypeInt, Column: auditlog.FieldAPIKeyID},
+			auditlog.FieldActor:      {Type: field.TypeString, Column: auditlog.FieldActor},
+			auditlog.FieldProjectID:  {Type: field.TypeInt, Column: auditlog.FieldProjectID},
+			auditlog.FieldSource:     {Type: field.TypeEnum, Column: auditlog.FieldSource},
+			auditlog.FieldOperation:  {Type: field.TypeString, Column: auditlog.FieldOperation},
+			auditlog.FieldAction:     {Type: field.TypeEnum, Column: auditlog.FieldAction},
+			auditlog.FieldEntityType: {Type: field.TypeString, Column: auditlog.FieldEntityType},
+			auditlog.FieldEntityID:   {Type: field.TypeInt, Column: auditlog.FieldEntityID},
+			auditlog.FieldChanges:    {Type: field.TypeJSON, Column: auditlog.FieldChanges},
+			auditlog.FieldSourceIP:   {Type: field.TypeString, Column: auditlog.FieldSourceIP},
+		},
+	}
+	graph.Nodes[3] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   budget.Table,
+			Columns: budget.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: budget.FieldID,
+			},
+		},
+		Type: "Budget",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			budget.FieldCreatedAt:          {Type: field.TypeTime, Column: budget.FieldCreatedAt},
+			budget.FieldUpdatedAt:          {Type: field.TypeTime, Column: budget.FieldUpdatedAt},
+			budget.FieldDeletedAt:          {Type: field.TypeInt, Column: budget.FieldDeletedAt},
+			budget.FieldProjectID:          {Type: field.TypeInt, Column: budget.FieldProjectID},
+			budget.FieldName:               {Type: field.TypeString, Column: budget.FieldName},
+			budget.FieldDescription:        {Type: field.TypeString, Column: budget.FieldDescription},
+			budget.FieldScope:              {Type: field.TypeEnum, Column: budget.FieldScope},
+			budget.FieldAPIKeyID:           {Type: field.TypeInt, Column: budget.FieldAPIKeyID},
+			budget.FieldUserID:             {Type: field.TypeInt, Column: budget.FieldUserID},
+			budget.FieldAmount:             {Type: field.TypeFloat64, Column: budget.FieldAmount},
+			budget.FieldPeriod:             {Type: field.TypeEnum, Column: budget.FieldPeriod},
+			budget.FieldStatus:             {Type: field.TypeEnum, Column: budget.FieldStatus},
+			budget.FieldSettings:           {Type: field.TypeJSON, Column: budget.FieldSettings},
+			budget.FieldAlertedThreshold:   {Type: field.TypeInt, Column: budget.FieldAlertedThreshold},
+			budget.FieldAlertedPeriodStart: {Type: field.TypeTime, Column: budget.FieldAlertedPeriodStart},
+		},
+	}
+	graph.Nodes[4] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   channel.Table,
 			Columns: channel.Columns,
@@ -118,7 +187,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			channel.FieldEndpoints:               {Type: field.TypeJSON, Column: channel.FieldEndpoints},
 		},
 	}
-	graph.Nodes[3] = &sqlgraph.Node{
+	graph.Nodes[5] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   channelmodelprice.Table,
 			Columns: channelmodelprice.Columns,
@@ -138,7 +207,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			channelmodelprice.FieldReferenceID: {Type: field.TypeString, Column: channelmodelprice.FieldReferenceID},
 		},
 	}
-	graph.Nodes[4] = &sqlgraph.Node{
+	graph.Nodes[6] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   channelmodelpriceversion.Table,
 			Columns: channelmodelpriceversion.Columns,
@@ -161,7 +230,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			channelmodelpriceversion.FieldReferenceID:         {Type: field.TypeString, Column: channelmodelpriceversion.FieldReferenceID},
 		},
 	}
-	graph.Nodes[5] = &sqlgraph.Node{
+	graph.Nodes[7] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   channeloverridetemplate.Table,
 			Columns: channeloverridetemplate.Columns,
@@ -184,7 +253,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			channeloverridetemplate.FieldBodyOverrideOperations:   {Type: field.TypeJSON, Column: channeloverridetemplate.FieldBodyOverrideOperations},
 		},
 	}
-	graph.Nodes[6] = &sqlgraph.Node{
+	graph.Nodes[8] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   channelprobe.Table,
 			Columns: channelprobe.Columns,
@@ -203,7 +272,55 @@ var schemaGraph = func() *sqlgraph.Schema {
 			channelprobe.FieldTimestamp:             {Type: field.TypeInt64, Column: channelprobe.FieldTimestamp},
 		},
 	}
-	graph.Nodes[7] = &sqlgraph.Node{
+	graph.Nodes[9] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   contentblob.Table,
+			Columns: contentblob.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: contentblob.FieldID,
+			},
+		},
+		Type: "ContentBlob",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			contentblob.FieldCreatedAt: {Type: field.TypeTime, Column: contentblob.FieldCreatedAt},
+			contentblob.FieldUpdatedAt: {Type: field.TypeTime, Column: contentblob.FieldUpdatedAt},
+			contentblob.FieldHash:      {Type: field.TypeString, Column: contentblob.FieldHash},
+			contentblob.FieldData:      {Type: field.TypeBytes, Column: contentblob.FieldData},
+			contentblob.FieldSize:      {Type: field.TypeInt64, Column: contentblob.FieldSize},
+			contentblob.FieldRefCount:  {Type: field.TypeInt64, Column: contentblob.FieldRefCount},
+		},
+	}
+	graph.Nodes[10] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   dataexport.Table,
+			Columns: dataexport.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: dataexport.FieldID,
+			},
+		},
+		Type: "DataExport",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			dataexport.FieldCreatedAt:     {Type: field.TypeTime, Column: dataexport.FieldCreatedAt},
+			dataexport.FieldUpdatedAt:     {Type: field.TypeTime, Column: dataexport.FieldUpdatedAt},
+			dataexport.FieldProjectID:     {Type: field.TypeInt, Column: dataexport.FieldProjectID},
+			dataexport.FieldUserID:        {Type: field.TypeInt, Column: dataexport.FieldUserID},
+			dataexport.FieldResource:      {Type: field.TypeEnum, Column: dataexport.FieldResource},
+			dataexport.FieldFormat:        {Type: field.TypeEnum, Column: dataexport.FieldFormat},
+			dataexport.FieldIncludeBodies: {Type: field.TypeBool, Column: dataexport.FieldIncludeBodies},
+			dataexport.FieldCriteria:      {Type: field.TypeJSON, Column: dataexport.FieldCriteria},
+			dataexport.FieldDataStorageID: {Type: field.TypeInt, Column: dataexport.FieldDataStorageID},
+			dataexport.FieldStatus:        {Type: field.TypeEnum, Column: dataexport.FieldStatus},
+			dataexport.FieldStorageKey:    {Type: field.TypeString, Column: dataexport.FieldStorageKey},
+			dataexport.FieldRowCount:      {Type: field.TypeInt64, Column: dataexport.FieldRowCount},
+			dataexport.FieldSize:          {Type: field.TypeInt64, Column: dataexport.FieldSize},
+			dataexport.FieldErrorMessage:  {Type: field.TypeString, Column: dataexport.FieldErrorMessage},
+			dataexport.FieldStartedAt:     {Type: field.TypeTime, Column: dataexport.FieldStartedAt},
+			dataexport.FieldCompletedAt:   {Type: field.TypeTime, Column: dataexport.FieldCompletedAt},
+		},
+	}
+	graph.Nodes[11] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   datastorage.Table,
 			Columns: datastorage.Columns,
@@ -225,7 +342,28 @@ var schemaGraph = func() *sqlgraph.Schema {
 			datastorage.FieldStatus:      {Type: field.TypeEnum, Column: datastorage.FieldStatus},
 		},
 	}
-	graph.Nodes[8] = &sqlgraph.Node{
+	graph.Nodes[12] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   exchangerate.Table,
+			Columns: exchangerate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: exchangerate.FieldID,
+			},
+		},
+		Type: "ExchangeRate",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			exchangerate.FieldCreatedAt:    {Type: field.TypeTime, Column: exchangerate.FieldCreatedAt},
+			exchangerate.FieldUpdatedAt:    {Type: field.TypeTime, Column: exchangerate.FieldUpdatedAt},
+			exchangerate.FieldFromCurrency: {Type: field.TypeString, Column: exchangerate.FieldFromCurrency},
+			exchangerate.FieldToCurrency:   {Type: field.TypeString, Column: exchangerate.FieldToCurrency},
+			exchangerate.FieldRate:         {Type: field.TypeFloat64, Column: exchangerate.FieldRate},
+			exchangerate.FieldEffectiveAt:  {Type: field.TypeTime, Column: exchangerate.FieldEffectiveAt},
+			exchangerate.FieldSource:       {Type: field.TypeEnum, Column: exchangerate.FieldSource},
+			exchangerate.FieldImportSource: {Type: field.TypeString, Column: exchangerate.FieldImportSource},
+		},
+	}
+	graph.Nodes[13] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   invitation.Table,
 			Columns: invitation.Columns,
@@ -246,7 +384,33 @@ var schemaGraph = func() *sqlgraph.Schema {
 			invitation.FieldUsedCount: {Type: field.TypeInt, Column: invitation.FieldUsedCount},
 		},
 	}
-	graph.Nodes[9] = &sqlgraph.Node{
+	graph.Nodes[14] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   invoice.Table,
+			Columns: invoice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoice.FieldID,
+			},
+		},
+		Type: "Invoice",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			invoice.FieldCreatedAt:       {Type: field.TypeTime, Column: invoice.FieldCreatedAt},
+			invoice.FieldUpdatedAt:       {Type: field.TypeTime, Column: invoice.FieldUpdatedAt},
+			invoice.FieldProjectID:       {Type: field.TypeInt, Column: invoice.FieldProjectID},
+			invoice.FieldNumber:          {Type: field.TypeString, Column: invoice.FieldNumber},
+			invoice.FieldPeriodStart:     {Type: field.TypeTime, Column: invoice.FieldPeriodStart},
+			invoice.FieldPeriodEnd:       {Type: field.TypeTime, Column: invoice.FieldPeriodEnd},
+			invoice.FieldCurrency:        {Type: field.TypeString, Column: invoice.FieldCurrency},
+			invoice.FieldSubtotal:        {Type: field.TypeFloat64, Column: invoice.FieldSubtotal},
+			invoice.FieldMarkupAmount:    {Type: field.TypeFloat64, Column: invoice.FieldMarkupAmount},
+			invoice.FieldFee:             {Type: field.TypeFloat64, Column: invoice.FieldFee},
+			invoice.FieldTotal:           {Type: field.TypeFloat64, Column: invoice.FieldTotal},
+			invoice.FieldLineItems:       {Type: field.TypeJSON, Column: invoice.FieldLineItems},
+			invoice.FieldBillingSettings: {Type: field.TypeJSON, Column: invoice.FieldBillingSettings},
+		},
+	}
+	graph.Nodes[15] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   model.Table,
 			Columns: model.Columns,
@@ -272,7 +436,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			model.FieldRemark:    {Type: field.TypeString, Column: model.FieldRemark},
 		},
 	}
-	graph.Nodes[10] = &sqlgraph.Node{
+	graph.Nodes[16] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   oidcidentity.Table,
 			Columns: oidcidentity.Columns,
@@ -294,7 +458,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			oidcidentity.FieldUserID:      {Type: field.TypeInt, Column: oidcidentity.FieldUserID},
 		},
 	}
-	graph.Nodes[11] = &sqlgraph.Node{
+	graph.Nodes[17] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   project.Table,
 			Columns: project.Columns,
@@ -305,16 +469,19 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "Project",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			project.FieldCreatedAt:   {Type: field.TypeTime, Column: project.FieldCreatedAt},
-			project.FieldUpdatedAt:   {Type: field.TypeTime, Column: project.FieldUpdatedAt},
-			project.FieldDeletedAt:   {Type: field.TypeInt, Column: project.FieldDeletedAt},
-			project.FieldName:        {Type: field.TypeString, Column: project.FieldName},
-			project.FieldDescription: {Type: field.TypeString, Column: project.FieldDescription},
-			project.FieldStatus:      {Type: field.TypeEnum, Column: project.FieldStatus},
-			project.FieldProfiles:    {Type: field.TypeJSON, Column: project.FieldProfiles},
+			project.FieldCreatedAt:          {Type: field.TypeTime, Column: project.FieldCreatedAt},
+			project.FieldUpdatedAt:          {Type: field.TypeTime, Column: project.FieldUpdatedAt},
+			project.FieldDeletedAt:          {Type: field.TypeInt, Column: project.FieldDeletedAt},
+			project.FieldName:               {Type: field.TypeString, Column: project.FieldName},
+			project.FieldDescription:        {Type: field.TypeString, Column: project.FieldDescription},
+			project.FieldStatus:             {Type: field.TypeEnum, Column: project.FieldStatus},
+			project.FieldProfiles:           {Type: field.TypeJSON, Column: project.FieldProfiles},
+			project.FieldModerationSettings: {Type: field.TypeJSON, Column: project.FieldModerationSettings},
+			project.FieldBillingSettings:    {Type: field.TypeJSON, Column: project.FieldBillingSettings},
+			project.FieldDataPolicy:         {Type: field.TypeJSON, Column: project.FieldDataPolicy},
 		},
 	}
-	graph.Nodes[12] = &sqlgraph.Node{
+	graph.Nodes[18] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   prompt.Table,
 			Columns: prompt.Columns,
@@ -338,7 +505,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			prompt.FieldSettings:    {Type: field.TypeJSON, Column: prompt.FieldSettings},
 		},
 	}
-	graph.Nodes[13] = &sqlgraph.Node{
+	graph.Nodes[19] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   promptprotectionrule.Table,
 			Columns: promptprotectionrule.Columns,
@@ -359,7 +526,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			promptprotectionrule.FieldSettings:    {Type: field.TypeJSON, Column: promptprotectionrule.FieldSettings},
 		},
 	}
-	graph.Nodes[14] = &sqlgraph.Node{
+	graph.Nodes[20] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   providerquotastatus.Table,
 			Columns: providerquotastatus.Columns,
@@ -382,7 +549,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			providerquotastatus.FieldNextCheckAt:  {Type: field.TypeTime, Column: providerquotastatus.FieldNextCheckAt},
 		},
 	}
-	graph.Nodes[15] = &sqlgraph.Node{
+	graph.Nodes[21] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   request.Table,
 			Columns: request.Columns,
@@ -405,9 +572,11 @@ var schemaGraph = func() *sqlgraph.Schema {
 			request.FieldFormat:                     {Type: field.TypeString, Column: request.FieldFormat},
 			request.FieldRequestHeaders:             {Type: field.TypeJSON, Column: request.FieldRequestHeaders},
 			request.FieldRequestBody:                {Type: field.TypeJSON, Column: request.FieldRequestBody},
+			request.FieldRequestBodyBlobs:           {Type: field.TypeBool, Column: request.FieldRequestBodyBlobs},
 			request.FieldResponseBody:               {Type: field.TypeJSON, Column: request.FieldResponseBody},
 			request.FieldResponseChunks:             {Type: field.TypeJSON, Column: request.FieldResponseChunks},
 			request.FieldChannelID:                  {Type: field.TypeInt, Column: request.FieldChannelID},
+			request.FieldEndUserID:                  {Type: field.TypeString, Column: request.FieldEndUserID},
 			request.FieldExternalID:                 {Type: field.TypeString, Column: request.FieldExternalID},
 			request.FieldStatus:                     {Type: field.TypeEnum, Column: request.FieldStatus},
 			request.FieldStream:                     {Type: field.TypeBool, Column: request.FieldStream},
@@ -419,9 +588,13 @@ var schemaGraph = func() *sqlgraph.Schema {
 			request.FieldContentStorageID:           {Type: field.TypeInt, Column: request.FieldContentStorageID},
 			request.FieldContentStorageKey:          {Type: field.TypeString, Column: request.FieldContentStorageKey},
 			request.FieldContentSavedAt:             {Type: field.TypeTime, Column: request.FieldContentSavedAt},
+			request.FieldModerationVerdicts:         {Type: field.TypeJSON, Column: request.FieldModerationVerdicts},
+			request.FieldArchivedAt:                 {Type: field.TypeTime, Column: request.FieldArchivedAt},
+			request.FieldArchiveStorageID:           {Type: field.TypeInt, Column: request.FieldArchiveStorageID},
+			request.FieldArchiveKey:                 {Type: field.TypeString, Column: request.FieldArchiveKey},
 		},
 	}
-	graph.Nodes[16] = &sqlgraph.Node{
+	graph.Nodes[22] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   requestexecution.Table,
 			Columns: requestexecution.Columns,
@@ -442,6 +615,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			requestexecution.FieldModelID:                    {Type: field.TypeString, Column: requestexecution.FieldModelID},
 			requestexecution.FieldFormat:                     {Type: field.TypeString, Column: requestexecution.FieldFormat},
 			requestexecution.FieldRequestBody:                {Type: field.TypeJSON, Column: requestexecution.FieldRequestBody},
+			requestexecution.FieldRequestBodyBlobs:           {Type: field.TypeBool, Column: requestexecution.FieldRequestBodyBlobs},
 			requestexecution.FieldResponseBody:               {Type: field.TypeJSON, Column: requestexecution.FieldResponseBody},
 			requestexecution.FieldResponseChunks:             {Type: field.TypeJSON, Column: requestexecution.FieldResponseChunks},
 			requestexecution.FieldErrorMessage:               {Type: field.TypeString, Column: requestexecution.FieldErrorMessage},
@@ -456,7 +630,25 @@ var schemaGraph = func() *sqlgraph.Schema {
 			requestexecution.FieldPassThroughApplied:         {Type: field.TypeBool, Column: requestexecution.FieldPassThroughApplied},
 		},
 	}
-	graph.Nodes[17] = &sqlgraph.Node{
+	graph.Nodes[23] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   requestsearchterm.Table,
+			Columns: requestsearchterm.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: requestsearchterm.FieldID,
+			},
+		},
+		Type: "RequestSearchTerm",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			requestsearchterm.FieldProjectID: {Type: field.TypeInt, Column: requestsearchterm.FieldProjectID},
+			requestsearchterm.FieldRequestID: {Type: field.TypeInt, Column: requestsearchterm.FieldRequestID},
+			requestsearchterm.FieldField:     {Type: field.TypeEnum, Column: requestsearchterm.FieldField},
+			requestsearchterm.FieldTerm:      {Type: field.TypeString, Column: requestsearchterm.FieldTerm},
+			requestsearchterm.FieldFrequency: {Type: field.TypeInt, Column: requestsearchterm.FieldFrequency},
+		},
+	}
+	graph.Nodes[24] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   role.Table,
 			Columns: role.Columns,
@@ -476,7 +668,24 @@ var schemaGraph = func() *sqlgraph.Schema {
 			role.FieldScopes:    {Type: field.TypeJSON, Column: role.FieldScopes},
 		},
 	}
-	graph.Nodes[18] = &sqlgraph.Node{
+	graph.Nodes[25] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   scimgroup.Table,
+			Columns: scimgroup.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: scimgroup.FieldID,
+			},
+		},
+		Type: "SCIMGroup",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			scimgroup.FieldCreatedAt:   {Type: field.TypeTime, Column: scimgroup.FieldCreatedAt},
+			scimgroup.FieldUpdatedAt:   {Type: field.TypeTime, Column: scimgroup.FieldUpdatedAt},
+			scimgroup.FieldDisplayName: {Type: field.TypeString, Column: scimgroup.FieldDisplayName},
+			scimgroup.FieldExternalID:  {Type: field.TypeString, Column: scimgroup.FieldExternalID},
+		},
+	}
+	graph.Nodes[26] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   system.Table,
 			Columns: system.Columns,
@@ -494,7 +703,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			system.FieldValue:     {Type: field.TypeString, Column: system.FieldValue},
 		},
 	}
-	graph.Nodes[19] = &sqlgraph.Node{
+	graph.Nodes[27] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   thread.Table,
 			Columns: thread.Columns,
@@ -512,7 +721,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			thread.FieldStatus:    {Type: field.TypeEnum, Column: thread.FieldStatus},
 		},
 	}
-	graph.Nodes[20] = &sqlgraph.Node{
+	graph.Nodes[28] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   trace.Table,
 			Columns: trace.Columns,
@@ -531,7 +740,61 @@ var schemaGraph = func() *sqlgraph.Schema {
 			trace.FieldStatus:    {Type: field.TypeEnum, Column: trace.FieldStatus},
 		},
 	}
-	graph.Nodes[21] = &sqlgraph.Node{
+	graph.Nodes[29] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   usagedailyrollup.Table,
+			Columns: usagedailyrollup.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: usagedailyrollup.FieldID,
+			},
+		},
+		Type: "UsageDailyRollup",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			usagedailyrollup.FieldBucket:                    {Type: field.TypeTime, Column: usagedailyrollup.FieldBucket},
+			usagedailyrollup.FieldProjectID:                 {Type: field.TypeInt, Column: usagedailyrollup.FieldProjectID},
+			usagedailyrollup.FieldAPIKeyID:                  {Type: field.TypeInt, Column: usagedailyrollup.FieldAPIKeyID},
+			usagedailyrollup.FieldChannelID:                 {Type: field.TypeInt, Column: usagedailyrollup.FieldChannelID},
+			usagedailyrollup.FieldModelID:                   {Type: field.TypeString, Column: usagedailyrollup.FieldModelID},
+			usagedailyrollup.FieldEndUserID:                 {Type: field.TypeString, Column: usagedailyrollup.FieldEndUserID},
+			usagedailyrollup.FieldRequestCount:              {Type: field.TypeInt64, Column: usagedailyrollup.FieldRequestCount},
+			usagedailyrollup.FieldPromptTokens:              {Type: field.TypeInt64, Column: usagedailyrollup.FieldPromptTokens},
+			usagedailyrollup.FieldPromptCachedTokens:        {Type: field.TypeInt64, Column: usagedailyrollup.FieldPromptCachedTokens},
+			usagedailyrollup.FieldPromptWriteCachedTokens:   {Type: field.TypeInt64, Column: usagedailyrollup.FieldPromptWriteCachedTokens},
+			usagedailyrollup.FieldCompletionTokens:          {Type: field.TypeInt64, Column: usagedailyrollup.FieldCompletionTokens},
+			usagedailyrollup.FieldCompletionReasoningTokens: {Type: field.TypeInt64, Column: usagedailyrollup.FieldCompletionReasoningTokens},
+			usagedailyrollup.FieldTotalTokens:               {Type: field.TypeInt64, Column: usagedailyrollup.FieldTotalTokens},
+			usagedailyrollup.FieldTotalCost:                 {Type: field.TypeFloat64, Column: usagedailyrollup.FieldTotalCost},
+		},
+	}
+	graph.Nodes[30] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   usagehourlyrollup.Table,
+			Columns: usagehourlyrollup.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: usagehourlyrollup.FieldID,
+			},
+		},
+		Type: "UsageHourlyRollup",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			usagehourlyrollup.FieldBucket:                    {Type: field.TypeTime, Column: usagehourlyrollup.FieldBucket},
+			usagehourlyrollup.FieldProjectID:                 {Type: field.TypeInt, Column: usagehourlyrollup.FieldProjectID},
+			usagehourlyrollup.FieldAPIKeyID:                  {Type: field.TypeInt, Column: usagehourlyrollup.FieldAPIKeyID},
+			usagehourlyrollup.FieldChannelID:                 {Type: field.TypeInt, Column: usagehourlyrollup.FieldChannelID},
+			usagehourlyrollup.FieldModelID:                   {Type: field.TypeString, Column: usagehourlyrollup.FieldModelID},
+			usagehourlyrollup.FieldEndUserID:                 {Type: field.TypeString, Column: usagehourlyrollup.FieldEndUserID},
+			usagehourlyrollup.FieldRequestCount:              {Type: field.TypeInt64, Column: usagehourlyrollup.FieldRequestCount},
+			usagehourlyrollup.FieldPromptTokens:              {Type: field.TypeInt64, Column: usagehourlyrollup.FieldPromptTokens},
+			usagehourlyrollup.FieldPromptCachedTokens:        {Type: field.TypeInt64, Column: usagehourlyrollup.FieldPromptCachedTokens},
+			usagehourlyrollup.FieldPromptWriteCachedTokens:   {Type: field.TypeInt64, Column: usagehourlyrollup.FieldPromptWriteCachedTokens},
+			usagehourlyrollup.FieldCompletionTokens:          {Type: field.TypeInt64, Column: usagehourlyrollup.FieldCompletionTokens},
+			usagehourlyrollup.FieldCompletionReasoningTokens: {Type: field.TypeInt64, Column: usagehourlyrollup.FieldCompletionReasoningTokens},
+			usagehourlyrollup.FieldTotalTokens:               {Type: field.TypeInt64, Column: usagehourlyrollup.FieldTotalTokens},
+			usagehourlyrollup.FieldTotalCost:                 {Type: field.TypeFloat64, Column: usagehourlyrollup.FieldTotalCost},
+		},
+	}
+	graph.Nodes[31] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   usagelog.Table,
 			Columns: usagelog.Columns,
@@ -546,6 +809,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			usagelog.FieldUpdatedAt:                          {Type: field.TypeTime, Column: usagelog.FieldUpdatedAt},
 			usagelog.FieldRequestID:                          {Type: field.TypeInt, Column: usagelog.FieldRequestID},
 			usagelog.FieldAPIKeyID:                           {Type: field.TypeInt, Column: usagelog.FieldAPIKeyID},
+			usagelog.FieldEndUserID:                          {Type: field.TypeString, Column: usagelog.FieldEndUserID},
 			usagelog.FieldProjectID:                          {Type: field.TypeInt, Column: usagelog.FieldProjectID},
 			usagelog.FieldChannelID:                          {Type: field.TypeInt, Column: usagelog.FieldChannelID},
 			usagelog.FieldModelID:                            {Type: field.TypeString, Column: usagelog.FieldModelID},
@@ -566,9 +830,13 @@ var schemaGraph = func() *sqlgraph.Schema {
 			usagelog.FieldTotalCost:                          {Type: field.TypeFloat64, Column: usagelog.FieldTotalCost},
 			usagelog.FieldCostItems:                          {Type: field.TypeJSON, Column: usagelog.FieldCostItems},
 			usagelog.FieldCostPriceReferenceID:               {Type: field.TypeString, Column: usagelog.FieldCostPriceReferenceID},
+			usagelog.FieldCurrency:                           {Type: field.TypeString, Column: usagelog.FieldCurrency},
+			usagelog.FieldOriginalTotalCost:                  {Type: field.TypeFloat64, Column: usagelog.FieldOriginalTotalCost},
+			usagelog.FieldOriginalCurrency:                   {Type: field.TypeString, Column: usagelog.FieldOriginalCurrency},
+			usagelog.FieldExchangeRate:                       {Type: field.TypeFloat64, Column: usagelog.FieldExchangeRate},
 		},
 	}
-	graph.Nodes[22] = &sqlgraph.Node{
+	graph.Nodes[32] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   user.Table,
 			Columns: user.Columns,
@@ -593,7 +861,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			user.FieldScopes:         {Type: field.TypeJSON, Column: user.FieldScopes},
 		},
 	}
-	graph.Nodes[23] = &sqlgraph.Node{
+	graph.Nodes[33] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   userproject.Table,
 			Columns: userproject.Columns,
@@ -612,7 +880,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			userproject.FieldScopes:    {Type: field.TypeJSON, Column: userproject.FieldScopes},
 		},
 	}
-	graph.Nodes[24] = &sqlgraph.Node{
+	graph.Nodes[34] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   userrole.Table,
 			Columns: userrole.Columns,
@@ -629,6 +897,99 @@ var schemaGraph = func() *sqlgraph.Schema {
 			userrole.FieldUpdatedAt: {Type: field.TypeTime, Column: userrole.FieldUpdatedAt},
 		},
 	}
+	graph.Nodes[35] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   wallet.Table,
+			Columns: wallet.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: wallet.FieldID,
+			},
+		},
+		Type: "Wallet",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			wallet.FieldCreatedAt:           {Type: field.TypeTime, Column: wallet.FieldCreatedAt},
+			wallet.FieldUpdatedAt:           {Type: field.TypeTime, Column: wallet.FieldUpdatedAt},
+			wallet.FieldAPIKeyID:            {Type: field.TypeInt, Column: wallet.FieldAPIKeyID},
+			wallet.FieldProjectID:           {Type: field.TypeInt, Column: wallet.FieldProjectID},
+			wallet.FieldBalance:             {Type: field.TypeFloat64, Column: wallet.FieldBalance},
+			wallet.FieldReserved:            {Type: field.TypeFloat64, Column: wallet.FieldReserved},
+			wallet.FieldStatus:              {Type: field.TypeEnum, Column: wallet.FieldStatus},
+			wallet.FieldLowBalanceThreshold: {Type: field.TypeFloat64, Column: wallet.FieldLowBalanceThreshold},
+			wallet.FieldLowBalanceAlerted:   {Type: field.TypeBool, Column: wallet.FieldLowBalanceAlerted},
+		},
+	}
+	graph.Nodes[36] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   walletreservation.Table,
+			Columns: walletreservation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: walletreservation.FieldID,
+			},
+		},
+		Type: "WalletReservation",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			walletreservation.FieldCreatedAt: {Type: field.TypeTime, Column: walletreservation.FieldCreatedAt},
+			walletreservation.FieldUpdatedAt: {Type: field.TypeTime, Column: walletreservation.FieldUpdatedAt},
+			walletreservation.FieldWalletID:  {Type: field.TypeInt, Column: walletreservation.FieldWalletID},
+			walletreservation.FieldRequestID: {Type: field.TypeInt, Column: walletreservation.FieldRequestID},
+			walletreservation.FieldAmount:    {Type: field.TypeFloat64, Column: walletreservation.FieldAmount},
+			walletreservation.FieldExpiresAt: {Type: field.TypeTime, Column: walletreservation.FieldExpiresAt},
+		},
+	}
+	graph.Nodes[37] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   wallettransaction.Table,
+			Columns: wallettransaction.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: wallettransaction.FieldID,
+			},
+		},
+		Type: "WalletTransaction",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			wallettransaction.FieldCreatedAt:    {Type: field.TypeTime, Column: wallettransaction.FieldCreatedAt},
+			wallettransaction.FieldUpdatedAt:    {Type: field.TypeTime, Column: wallettransaction.FieldUpdatedAt},
+			wallettransaction.FieldWalletID:     {Type: field.TypeInt, Column: wallettransaction.FieldWalletID},
+			wallettransaction.FieldAPIKeyID:     {Type: field.TypeInt, Column: wallettransaction.FieldAPIKeyID},
+			wallettransaction.FieldProjectID:    {Type: field.TypeInt, Column: wallettransaction.FieldProjectID},
+			wallettransaction.FieldType:         {Type: field.TypeEnum, Column: wallettransaction.FieldType},
+			wallettransaction.FieldAmount:       {Type: field.TypeFloat64, Column: wallettransaction.FieldAmount},
+			wallettransaction.FieldBalanceAfter: {Type: field.TypeFloat64, Column: wallettransaction.FieldBalanceAfter},
+			wallettransaction.FieldRequestID:    {Type: field.TypeInt, Column: wallettransaction.FieldRequestID},
+			wallettransaction.FieldUsageLogID:   {Type: field.TypeInt, Column: wallettransaction.FieldUsageLogID},
+			wallettransaction.FieldUserID:       {Type: field.TypeInt, Column: wallettransaction.FieldUserID},
+			wallettransaction.FieldDescription:  {Type: field.TypeString, Column: wallettransaction.FieldDescription},
+		},
+	}
+	graph.Nodes[38] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   webhookdelivery.Table,
+			Columns: webhookdelivery.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookdelivery.FieldID,
+			},
+		},
+		Type: "WebhookDelivery",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			webhookdelivery.FieldCreatedAt:      {Type: field.TypeTime, Column: webhookdelivery.FieldCreatedAt},
+			webhookdelivery.FieldUpdatedAt:      {Type: field.TypeTime, Column: webhookdelivery.FieldUpdatedAt},
+			webhookdelivery.FieldEventID:        {Type: field.TypeString, Column: webhookdelivery.FieldEventID},
+			webhookdelivery.FieldEvent:          {Type: field.TypeString, Column: webhookdelivery.FieldEvent},
+			webhookdelivery.FieldTargetName:     {Type: field.TypeString, Column: webhookdelivery.FieldTargetName},
+			webhookdelivery.FieldURL:            {Type: field.TypeString, Column: webhookdelivery.FieldURL},
+			webhookdelivery.FieldPayload:        {Type: field.TypeString, Column: webhookdelivery.FieldPayload},
+			webhookdelivery.FieldHeaders:        {Type: field.TypeJSON, Column: webhookdelivery.FieldHeaders},
+			webhookdelivery.FieldStatus:         {Type: field.TypeEnum, Column: webhookdelivery.FieldStatus},
+			webhookdelivery.FieldAttempts:       {Type: field.TypeInt, Column: webhookdelivery.FieldAttempts},
+			webhookdelivery.FieldNextAttemptAt:  {Type: field.TypeTime, Column: webhookdelivery.FieldNextAttemptAt},
+			webhookdelivery.FieldLastError:      {Type: field.TypeString, Column: webhookdelivery.FieldLastError},
+			webhookdelivery.FieldResponseStatus: {Type: field.TypeInt, Column: webhookdelivery.FieldResponseStatus},
+			webhookdelivery.FieldDeliveredAt:    {Type: field.TypeTime, Column: webhookdelivery.FieldDeliveredAt},
+		},
+	}
 	graph.MustAddE(
 		"user",
 		&sqlgraph.EdgeSpec{
@@ -665,6 +1026,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"APIKey",
 		"Request",
 	)
+	graph.MustAddE(
+		"wallet",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   apikey.WalletTable,
+			Columns: []string{apikey.WalletColumn},
+			Bidi:    false,
+		},
+		"APIKey",
+		"Wallet",
+	)
 	graph.MustAddE(
 		"project",
 		&sqlgraph.EdgeSpec{
@@ -677,6 +1050,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"APIKeyProfileTemplate",
 		"Project",
 	)
+	graph.MustAddE(
+		"project",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   budget.ProjectTable,
+			Columns: []string{budget.ProjectColumn},
+			Bidi:    false,
+		},
+		"Budget",
+		"Project",
+	)
 	graph.MustAddE(
 		"requests",
 		&sqlgraph.EdgeSpec{
@@ -809,6 +1194,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"ChannelProbe",
 		"Channel",
 	)
+	graph.MustAddE(
+		"project",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dataexport.ProjectTable,
+			Columns: []string{dataexport.ProjectColumn},
+			Bidi:    false,
+		},
+		"DataExport",
+		"Project",
+	)
 	graph.MustAddE(
 		"requests",
 		&sqlgraph.EdgeSpec{
@@ -845,6 +1242,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Invitation",
 		"Project",
 	)
+	graph.MustAddE(
+		"project",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invoice.ProjectTable,
+			Columns: []string{invoice.ProjectColumn},
+			Bidi:    false,
+		},
+		"Invoice",
+		"Project",
+	)
 	graph.MustAddE(
 		"user",
 		&sqlgraph.EdgeSpec{
@@ -977,6 +1386,42 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Project",
 		"APIKeyProfileTemplate",
 	)
+	graph.MustAddE(
+		"budgets",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BudgetsTable,
+			Columns: []string{project.BudgetsColumn},
+			Bidi:    false,
+		},
+		"Project",
+		"Budget",
+	)
+	graph.MustAddE(
+		"invoices",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.InvoicesTable,
+			Columns: []string{project.InvoicesColumn},
+			Bidi:    false,
+		},
+		"Project",
+		"Invoice",
+	)
+	graph.MustAddE(
+		"data_exports",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DataExportsTable,
+			Columns: []string{project.DataExportsColumn},
+			Bidi:    false,
+		},
+		"Project",
+		"DataExport",
+	)
 	graph.MustAddE(
 		"project_users",
 		&sqlgraph.EdgeSpec{
@@ -1169,6 +1614,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Role",
 		"UserRole",
 	)
+	graph.MustAddE(
+		"users",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   scimgroup.UsersTable,
+			Columns: scimgroup.UsersPrimaryKey,
+			Bidi:    false,
+		},
+		"SCIMGroup",
+		"User",
+	)
 	graph.MustAddE(
 		"project",
 		&sqlgraph.EdgeSpec{
@@ -1325,6 +1782,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"User",
 		"OIDCIdentity",
 	)
+	graph.MustAddE(
+		"scim_groups",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.ScimGroupsTable,
+			Columns: user.ScimGroupsPrimaryKey,
+			Bidi:    false,
+		},
+		"User",
+		"SCIMGroup",
+	)
 	graph.MustAddE(
 		"project_users",
 		&sqlgraph.EdgeSpec{
@@ -1397,6 +1866,42 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"UserRole",
 		"Role",
 	)
+	graph.MustAddE(
+		"api_key",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   wallet.APIKeyTable,
+			Columns: []string{wallet.APIKeyColumn},
+			Bidi:    false,
+		},
+		"Wallet",
+		"APIKey",
+	)
+	graph.MustAddE(
+		"transactions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.TransactionsTable,
+			Columns: []string{wallet.TransactionsColumn},
+			Bidi:    false,
+		},
+		"Wallet",
+		"WalletTransaction",
+	)
+	graph.MustAddE(
+		"wallet",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wallettransaction.WalletTable,
+			Columns: []string{wallettransaction.WalletColumn},
+			Bidi:    false,
+		},
+		"WalletTransaction",
+		"Wallet",
+	)
 	return graph
 }()
 
@@ -1506,7 +2011,12 @@ func (f *APIKeyFilter) WhereAllowedIps(p entql.BytesP) {
 	f.Where(p.Field(apikey.FieldAllowedIps))
 }
 
-// WhereHasUser applies a predicate to check if query has an edge user.
+// WhereTokenRevocations applies the entql json.RawMessage predicate on the token_revocations field.
+func (f *APIKeyFilter) WhereTokenRevocations(p entql.BytesP) {
+	f.Where(p.Field(apikey.FieldTokenRevocations))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
 func (f *APIKeyFilter) WhereHasUser() {
 	f.Where(entql.HasEdge("user"))
 }
@@ -1548,6 +2058,20 @@ func (f *APIKeyFilter) WhereHasRequestsWith(preds ...predicate.Request) {
 	})))
 }
 
+// WhereHasWallet applies a predicate to check if query has an edge wallet.
+func (f *APIKeyFilter) WhereHasWallet() {
+	f.Where(entql.HasEdge("wallet"))
+}
+
+// WhereHasWalletWith applies a predicate to check if query has an edge wallet with a given conditions (other predicates).
+func (f *APIKeyFilter) WhereHasWalletWith(preds ...predicate.Wallet) {
+	f.Where(entql.HasEdgeWith("wallet", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *APIKeyProfileTemplateQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -1637,6 +2161,240 @@ func (f *APIKeyProfileTemplateFilter) WhereHasProjectWith(preds ...predicate.Pro
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *AuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AuditLogQuery builder.
+func (_q *AuditLogQuery) Filter() *AuditLogFilter {
+	return &AuditLogFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AuditLogMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AuditLogMutation builder.
+func (m *AuditLogMutation) Filter() *AuditLogFilter {
+	return &AuditLogFilter{config: m.config, predicateAdder: m}
+}
+
+// AuditLogFilter provides a generic filtering capability at runtime for AuditLogQuery.
+type AuditLogFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AuditLogFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *AuditLogFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(auditlog.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *AuditLogFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(auditlog.FieldCreatedAt))
+}
+
+// WhereActorType applies the entql string predicate on the actor_type field.
+func (f *AuditLogFilter) WhereActorType(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldActorType))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *AuditLogFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(auditlog.FieldUserID))
+}
+
+// WhereAPIKeyID applies the entql int predicate on the api_key_id field.
+func (f *AuditLogFilter) WhereAPIKeyID(p entql.IntP) {
+	f.Where(p.Field(auditlog.FieldAPIKeyID))
+}
+
+// WhereActor applies the entql string predicate on the actor field.
+func (f *AuditLogFilter) WhereActor(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldActor))
+}
+
+// WhereProjectID applies the entql int predicate on the project_id field.
+func (f *AuditLogFilter) WhereProjectID(p entql.IntP) {
+	f.Where(p.Field(auditlog.FieldProjectID))
+}
+
+// WhereSource applies the entql string predicate on the source field.
+func (f *AuditLogFilter) WhereSource(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldSource))
+}
+
+// WhereOperation applies the entql string predicate on the operation field.
+func (f *AuditLogFilter) WhereOperation(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldOperation))
+}
+
+// WhereAction applies the entql string predicate on the action field.
+func (f *AuditLogFilter) WhereAction(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldAction))
+}
+
+// WhereEntityType applies the entql string predicate on the entity_type field.
+func (f *AuditLogFilter) WhereEntityType(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldEntityType))
+}
+
+// WhereEntityID applies the entql int predicate on the entity_id field.
+func (f *AuditLogFilter) WhereEntityID(p entql.IntP) {
+	f.Where(p.Field(auditlog.FieldEntityID))
+}
+
+// WhereChanges applies the entql json.RawMessage predicate on the changes field.
+func (f *AuditLogFilter) WhereChanges(p entql.BytesP) {
+	f.Where(p.Field(auditlog.FieldChanges))
+}
+
+// WhereSourceIP applies the entql string predicate on the source_ip field.
+func (f *AuditLogFilter) WhereSourceIP(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldSourceIP))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *BudgetQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the BudgetQuery builder.
+func (_q *BudgetQuery) Filter() *BudgetFilter {
+	return &BudgetFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *BudgetMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the BudgetMutation builder.
+func (m *BudgetMutation) Filter() *BudgetFilter {
+	return &BudgetFilter{config: m.config, predicateAdder: m}
+}
+
+// BudgetFilter provides a generic filtering capability at runtime for BudgetQuery.
+type BudgetFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *BudgetFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *BudgetFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(budget.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *BudgetFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(budget.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *BudgetFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(budget.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql int predicate on the deleted_at field.
+func (f *BudgetFilter) WhereDeletedAt(p entql.IntP) {
+	f.Where(p.Field(budget.FieldDeletedAt))
+}
+
+// WhereProjectID applies the entql int predicate on the project_id field.
+func (f *BudgetFilter) WhereProjectID(p entql.IntP) {
+	f.Where(p.Field(budget.FieldProjectID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *BudgetFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(budget.FieldName))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *BudgetFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(budget.FieldDescription))
+}
+
+// WhereScope applies the entql string predicate on the scope field.
+func (f *BudgetFilter) WhereScope(p entql.StringP) {
+	f.Where(p.Field(budget.FieldScope))
+}
+
+// WhereAPIKeyID applies the entql int predicate on the api_key_id field.
+func (f *BudgetFilter) WhereAPIKeyID(p entql.IntP) {
+	f.Where(p.Field(budget.FieldAPIKeyID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *BudgetFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(budget.FieldUserID))
+}
+
+// WhereAmount applies the entql float64 predicate on the amount field.
+func (f *BudgetFilter) WhereAmount(p entql.Float64P) {
+	f.Where(p.Field(budget.FieldAmount))
+}
+
+// WherePeriod applies the entql string predicate on the period field.
+func (f *BudgetFilter) WherePeriod(p entql.StringP) {
+	f.Where(p.Field(budget.FieldPeriod))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *BudgetFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(budget.FieldStatus))
+}
+
+// WhereSettings applies the entql json.RawMessage predicate on the settings field.
+func (f *BudgetFilter) WhereSettings(p entql.BytesP) {
+	f.Where(p.Field(budget.FieldSettings))
+}
+
+// WhereAlertedThreshold applies the entql int predicate on the alerted_threshold field.
+func (f *BudgetFilter) WhereAlertedThreshold(p entql.IntP) {
+	f.Where(p.Field(budget.FieldAlertedThreshold))
+}
+
+// WhereAlertedPeriodStart applies the entql time.Time predicate on the alerted_period_start field.
+func (f *BudgetFilter) WhereAlertedPeriodStart(p entql.TimeP) {
+	f.Where(p.Field(budget.FieldAlertedPeriodStart))
+}
+
+// WhereHasProject applies a predicate to check if query has an edge project.
+func (f *BudgetFilter) WhereHasProject() {
+	f.Where(entql.HasEdge("project"))
+}
+
+// WhereHasProjectWith applies a predicate to check if query has an edge project with a given conditions (other predicates).
+func (f *BudgetFilter) WhereHasProjectWith(preds ...predicate.Project) {
+	f.Where(entql.HasEdgeWith("project", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *ChannelQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -1666,7 +2424,7 @@ type ChannelFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ChannelFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1895,7 +2653,7 @@ type ChannelModelPriceFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ChannelModelPriceFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1998,7 +2756,7 @@ type ChannelModelPriceVersionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ChannelModelPriceVersionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2102,7 +2860,7 @@ type ChannelOverrideTemplateFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ChannelOverrideTemplateFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2206,7 +2964,7 @@ type ChannelProbeFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ChannelProbeFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2262,174 +3020,458 @@ func (f *ChannelProbeFilter) WhereHasChannelWith(preds ...predicate.Channel) {
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *DataStorageQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *ContentBlobQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the DataStorageQuery builder.
-func (_q *DataStorageQuery) Filter() *DataStorageFilter {
-	return &DataStorageFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the ContentBlobQuery builder.
+func (_q *ContentBlobQuery) Filter() *ContentBlobFilter {
+	return &ContentBlobFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *DataStorageMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *ContentBlobMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the DataStorageMutation builder.
-func (m *DataStorageMutation) Filter() *DataStorageFilter {
-	return &DataStorageFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the ContentBlobMutation builder.
+func (m *ContentBlobMutation) Filter() *ContentBlobFilter {
+	return &ContentBlobFilter{config: m.config, predicateAdder: m}
 }
 
-// DataStorageFilter provides a generic filtering capability at runtime for DataStorageQuery.
-type DataStorageFilter struct {
+// ContentBlobFilter provides a generic filtering capability at runtime for ContentBlobQuery.
+type ContentBlobFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *DataStorageFilter) Where(p entql.P) {
+func (f *ContentBlobFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql int predicate on the id field.
-func (f *DataStorageFilter) WhereID(p entql.IntP) {
-	f.Where(p.Field(datastorage.FieldID))
+func (f *ContentBlobFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(contentblob.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *DataStorageFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(datastorage.FieldCreatedAt))
+func (f *ContentBlobFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(contentblob.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *DataStorageFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(datastorage.FieldUpdatedAt))
-}
-
-// WhereDeletedAt applies the entql int predicate on the deleted_at field.
-func (f *DataStorageFilter) WhereDeletedAt(p entql.IntP) {
-	f.Where(p.Field(datastorage.FieldDeletedAt))
-}
-
-// WhereName applies the entql string predicate on the name field.
-func (f *DataStorageFilter) WhereName(p entql.StringP) {
-	f.Where(p.Field(datastorage.FieldName))
-}
-
-// WhereDescription applies the entql string predicate on the description field.
-func (f *DataStorageFilter) WhereDescription(p entql.StringP) {
-	f.Where(p.Field(datastorage.FieldDescription))
+func (f *ContentBlobFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(contentblob.FieldUpdatedAt))
 }
 
-// WherePrimary applies the entql bool predicate on the primary field.
-func (f *DataStorageFilter) WherePrimary(p entql.BoolP) {
-	f.Where(p.Field(datastorage.FieldPrimary))
-}
-
-// WhereType applies the entql string predicate on the type field.
-func (f *DataStorageFilter) WhereType(p entql.StringP) {
-	f.Where(p.Field(datastorage.FieldType))
-}
-
-// WhereSettings applies the entql json.RawMessage predicate on the settings field.
-func (f *DataStorageFilter) WhereSettings(p entql.BytesP) {
-	f.Where(p.Field(datastorage.FieldSettings))
-}
-
-// WhereStatus applies the entql string predicate on the status field.
-func (f *DataStorageFilter) WhereStatus(p entql.StringP) {
-	f.Where(p.Field(datastorage.FieldStatus))
-}
-
-// WhereHasRequests applies a predicate to check if query has an edge requests.
-func (f *DataStorageFilter) WhereHasRequests() {
-	f.Where(entql.HasEdge("requests"))
+// WhereHash applies the entql string predicate on the hash field.
+func (f *ContentBlobFilter) WhereHash(p entql.StringP) {
+	f.Where(p.Field(contentblob.FieldHash))
 }
 
-// WhereHasRequestsWith applies a predicate to check if query has an edge requests with a given conditions (other predicates).
-func (f *DataStorageFilter) WhereHasRequestsWith(preds ...predicate.Request) {
-	f.Where(entql.HasEdgeWith("requests", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereData applies the entql []byte predicate on the data field.
+func (f *ContentBlobFilter) WhereData(p entql.BytesP) {
+	f.Where(p.Field(contentblob.FieldData))
 }
 
-// WhereHasExecutions applies a predicate to check if query has an edge executions.
-func (f *DataStorageFilter) WhereHasExecutions() {
-	f.Where(entql.HasEdge("executions"))
+// WhereSize applies the entql int64 predicate on the size field.
+func (f *ContentBlobFilter) WhereSize(p entql.Int64P) {
+	f.Where(p.Field(contentblob.FieldSize))
 }
 
-// WhereHasExecutionsWith applies a predicate to check if query has an edge executions with a given conditions (other predicates).
-func (f *DataStorageFilter) WhereHasExecutionsWith(preds ...predicate.RequestExecution) {
-	f.Where(entql.HasEdgeWith("executions", sqlgraph.WrapFunc(func(s *sql.Selector) {
-		for _, p := range preds {
-			p(s)
-		}
-	})))
+// WhereRefCount applies the entql int64 predicate on the ref_count field.
+func (f *ContentBlobFilter) WhereRefCount(p entql.Int64P) {
+	f.Where(p.Field(contentblob.FieldRefCount))
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *InvitationQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *DataExportQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the InvitationQuery builder.
-func (_q *InvitationQuery) Filter() *InvitationFilter {
-	return &InvitationFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the DataExportQuery builder.
+func (_q *DataExportQuery) Filter() *DataExportFilter {
+	return &DataExportFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *InvitationMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *DataExportMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the InvitationMutation builder.
-func (m *InvitationMutation) Filter() *InvitationFilter {
-	return &InvitationFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the DataExportMutation builder.
+func (m *DataExportMutation) Filter() *DataExportFilter {
+	return &DataExportFilter{config: m.config, predicateAdder: m}
 }
 
-// InvitationFilter provides a generic filtering capability at runtime for InvitationQuery.
-type InvitationFilter struct {
+// DataExportFilter provides a generic filtering capability at runtime for DataExportQuery.
+type DataExportFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *InvitationFilter) Where(p entql.P) {
+func (f *DataExportFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql int predicate on the id field.
-func (f *InvitationFilter) WhereID(p entql.IntP) {
-	f.Where(p.Field(invitation.FieldID))
+func (f *DataExportFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(dataexport.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *InvitationFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(invitation.FieldCreatedAt))
+func (f *DataExportFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(dataexport.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *InvitationFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(invitation.FieldUpdatedAt))
+func (f *DataExportFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(dataexport.FieldUpdatedAt))
 }
 
-// WhereDeletedAt applies the entql int predicate on the deleted_at field.
-func (f *InvitationFilter) WhereDeletedAt(p entql.IntP) {
-	f.Where(p.Field(invitation.FieldDeletedAt))
+// WhereProjectID applies the entql int predicate on the project_id field.
+func (f *DataExportFilter) WhereProjectID(p entql.IntP) {
+	f.Where(p.Field(dataexport.FieldProjectID))
 }
 
-// WhereTokenHash applies the entql string predicate on the token_hash field.
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *DataExportFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(dataexport.FieldUserID))
+}
+
+// WhereResource applies the entql string predicate on the resource field.
+func (f *DataExportFilter) WhereResource(p entql.StringP) {
+	f.Where(p.Field(dataexport.FieldResource))
+}
+
+// WhereFormat applies the entql string predicate on the format field.
+func (f *DataExportFilter) WhereFormat(p entql.StringP) {
+	f.Where(p.Field(dataexport.FieldFormat))
+}
+
+// WhereIncludeBodies applies the entql bool predicate on the include_bodies field.
+func (f *DataExportFilter) WhereIncludeBodies(p entql.BoolP) {
+	f.Where(p.Field(dataexport.FieldIncludeBodies))
+}
+
+// WhereCriteria applies the entql json.RawMessage predicate on the criteria field.
+func (f *DataExportFilter) WhereCriteria(p entql.BytesP) {
+	f.Where(p.Field(dataexport.FieldCriteria))
+}
+
+// WhereDataStorageID applies the entql int predicate on the data_storage_id field.
+func (f *DataExportFilter) WhereDataStorageID(p entql.IntP) {
+	f.Where(p.Field(dataexport.FieldDataStorageID))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *DataExportFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(dataexport.FieldStatus))
+}
+
+// WhereStorageKey applies the entql string predicate on the storage_key field.
+func (f *DataExportFilter) WhereStorageKey(p entql.StringP) {
+	f.Where(p.Field(dataexport.FieldStorageKey))
+}
+
+// WhereRowCount applies the entql int64 predicate on the row_count field.
+func (f *DataExportFilter) WhereRowCount(p entql.Int64P) {
+	f.Where(p.Field(dataexport.FieldRowCount))
+}
+
+// WhereSize applies the entql int64 predicate on the size field.
+func (f *DataExportFilter) WhereSize(p entql.Int64P) {
+	f.Where(p.Field(dataexport.FieldSize))
+}
+
+// WhereErrorMessage applies the entql string predicate on the error_message field.
+func (f *DataExportFilter) WhereErrorMessage(p entql.StringP) {
+	f.Where(p.Field(dataexport.FieldErrorMessage))
+}
+
+// WhereStartedAt applies the entql time.Time predicate on the started_at field.
+func (f *DataExportFilter) WhereStartedAt(p entql.TimeP) {
+	f.Where(p.Field(dataexport.FieldStartedAt))
+}
+
+// WhereCompletedAt applies the entql time.Time predicate on the completed_at field.
+func (f *DataExportFilter) WhereCompletedAt(p entql.TimeP) {
+	f.Where(p.Field(dataexport.FieldCompletedAt))
+}
+
+// WhereHasProject applies a predicate to check if query has an edge project.
+func (f *DataExportFilter) WhereHasProject() {
+	f.Where(entql.HasEdge("project"))
+}
+
+// WhereHasProjectWith applies a predicate to check if query has an edge project with a given conditions (other predicates).
+func (f *DataExportFilter) WhereHasProjectWith(preds ...predicate.Project) {
+	f.Where(entql.HasEdgeWith("project", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *DataStorageQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the DataStorageQuery builder.
+func (_q *DataStorageQuery) Filter() *DataStorageFilter {
+	return &DataStorageFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *DataStorageMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the DataStorageMutation builder.
+func (m *DataStorageMutation) Filter() *DataStorageFilter {
+	return &DataStorageFilter{config: m.config, predicateAdder: m}
+}
+
+// DataStorageFilter provides a generic filtering capability at runtime for DataStorageQuery.
+type DataStorageFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *DataStorageFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *DataStorageFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(datastorage.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *DataStorageFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(datastorage.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *DataStorageFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(datastorage.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql int predicate on the deleted_at field.
+func (f *DataStorageFilter) WhereDeletedAt(p entql.IntP) {
+	f.Where(p.Field(datastorage.FieldDeletedAt))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *DataStorageFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(datastorage.FieldName))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *DataStorageFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(datastorage.FieldDescription))
+}
+
+// WherePrimary applies the entql bool predicate on the primary field.
+func (f *DataStorageFilter) WherePrimary(p entql.BoolP) {
+	f.Where(p.Field(datastorage.FieldPrimary))
+}
+
+// WhereType applies the entql string predicate on the type field.
+func (f *DataStorageFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(datastorage.FieldType))
+}
+
+// WhereSettings applies the entql json.RawMessage predicate on the settings field.
+func (f *DataStorageFilter) WhereSettings(p entql.BytesP) {
+	f.Where(p.Field(datastorage.FieldSettings))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *DataStorageFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(datastorage.FieldStatus))
+}
+
+// WhereHasRequests applies a predicate to check if query has an edge requests.
+func (f *DataStorageFilter) WhereHasRequests() {
+	f.Where(entql.HasEdge("requests"))
+}
+
+// WhereHasRequestsWith applies a predicate to check if query has an edge requests with a given conditions (other predicates).
+func (f *DataStorageFilter) WhereHasRequestsWith(preds ...predicate.Request) {
+	f.Where(entql.HasEdgeWith("requests", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasExecutions applies a predicate to check if query has an edge executions.
+func (f *DataStorageFilter) WhereHasExecutions() {
+	f.Where(entql.HasEdge("executions"))
+}
+
+// WhereHasExecutionsWith applies a predicate to check if query has an edge executions with a given conditions (other predicates).
+func (f *DataStorageFilter) WhereHasExecutionsWith(preds ...predicate.RequestExecution) {
+	f.Where(entql.HasEdgeWith("executions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *ExchangeRateQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ExchangeRateQuery builder.
+func (_q *ExchangeRateQuery) Filter() *ExchangeRateFilter {
+	return &ExchangeRateFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ExchangeRateMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ExchangeRateMutation builder.
+func (m *ExchangeRateMutation) Filter() *ExchangeRateFilter {
+	return &ExchangeRateFilter{config: m.config, predicateAdder: m}
+}
+
+// ExchangeRateFilter provides a generic filtering capability at runtime for ExchangeRateQuery.
+type ExchangeRateFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ExchangeRateFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *ExchangeRateFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(exchangerate.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ExchangeRateFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(exchangerate.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *ExchangeRateFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(exchangerate.FieldUpdatedAt))
+}
+
+// WhereFromCurrency applies the entql string predicate on the from_currency field.
+func (f *ExchangeRateFilter) WhereFromCurrency(p entql.StringP) {
+	f.Where(p.Field(exchangerate.FieldFromCurrency))
+}
+
+// WhereToCurrency applies the entql string predicate on the to_currency field.
+func (f *ExchangeRateFilter) WhereToCurrency(p entql.StringP) {
+	f.Where(p.Field(exchangerate.FieldToCurrency))
+}
+
+// WhereRate applies the entql float64 predicate on the rate field.
+func (f *ExchangeRateFilter) WhereRate(p entql.Float64P) {
+	f.Where(p.Field(exchangerate.FieldRate))
+}
+
+// WhereEffectiveAt applies the entql time.Time predicate on the effective_at field.
+func (f *ExchangeRateFilter) WhereEffectiveAt(p entql.TimeP) {
+	f.Where(p.Field(exchangerate.FieldEffectiveAt))
+}
+
+// WhereSource applies the entql string predicate on the source field.
+func (f *ExchangeRateFilter) WhereSource(p entql.StringP) {
+	f.Where(p.Field(exchangerate.FieldSource))
+}
+
+// WhereImportSource applies the entql string predicate on the import_source field.
+func (f *ExchangeRateFilter) WhereImportSource(p entql.StringP) {
+	f.Where(p.Field(exchangerate.FieldImportSource))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *InvitationQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the InvitationQuery builder.
+func (_q *InvitationQuery) Filter() *InvitationFilter {
+	return &InvitationFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *InvitationMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the InvitationMutation builder.
+func (m *InvitationMutation) Filter() *InvitationFilter {
+	return &InvitationFilter{config: m.config, predicateAdder: m}
+}
+
+// InvitationFilter provides a generic filtering capability at runtime for InvitationQuery.
+type InvitationFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *InvitationFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *InvitationFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(invitation.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *InvitationFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(invitation.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *InvitationFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(invitation.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql int predicate on the deleted_at field.
+func (f *InvitationFilter) WhereDeletedAt(p entql.IntP) {
+	f.Where(p.Field(invitation.FieldDeletedAt))
+}
+
+// WhereTokenHash applies the entql string predicate on the token_hash field.
 func (f *InvitationFilter) WhereTokenHash(p entql.StringP) {
 	f.Where(p.Field(invitation.FieldTokenHash))
 }
@@ -2468,6 +3510,125 @@ func (f *InvitationFilter) WhereHasProjectWith(preds ...predicate.Project) {
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *InvoiceQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the InvoiceQuery builder.
+func (_q *InvoiceQuery) Filter() *InvoiceFilter {
+	return &InvoiceFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *InvoiceMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the InvoiceMutation builder.
+func (m *InvoiceMutation) Filter() *InvoiceFilter {
+	return &InvoiceFilter{config: m.config, predicateAdder: m}
+}
+
+// InvoiceFilter provides a generic filtering capability at runtime for InvoiceQuery.
+type InvoiceFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *InvoiceFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *InvoiceFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(invoice.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *InvoiceFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(invoice.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *InvoiceFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(invoice.FieldUpdatedAt))
+}
+
+// WhereProjectID applies the entql int predicate on the project_id field.
+func (f *InvoiceFilter) WhereProjectID(p entql.IntP) {
+	f.Where(p.Field(invoice.FieldProjectID))
+}
+
+// WhereNumber applies the entql string predicate on the number field.
+func (f *InvoiceFilter) WhereNumber(p entql.StringP) {
+	f.Where(p.Field(invoice.FieldNumber))
+}
+
+// WherePeriodStart applies the entql time.Time predicate on the period_start field.
+func (f *InvoiceFilter) WherePeriodStart(p entql.TimeP) {
+	f.Where(p.Field(invoice.FieldPeriodStart))
+}
+
+// WherePeriodEnd applies the entql time.Time predicate on the period_end field.
+func (f *InvoiceFilter) WherePeriodEnd(p entql.TimeP) {
+	f.Where(p.Field(invoice.FieldPeriodEnd))
+}
+
+// WhereCurrency applies the entql string predicate on the currency field.
+func (f *InvoiceFilter) WhereCurrency(p entql.StringP) {
+	f.Where(p.Field(invoice.FieldCurrency))
+}
+
+// WhereSubtotal applies the entql float64 predicate on the subtotal field.
+func (f *InvoiceFilter) WhereSubtotal(p entql.Float64P) {
+	f.Where(p.Field(invoice.FieldSubtotal))
+}
+
+// WhereMarkupAmount applies the entql float64 predicate on the markup_amount field.
+func (f *InvoiceFilter) WhereMarkupAmount(p entql.Float64P) {
+	f.Where(p.Field(invoice.FieldMarkupAmount))
+}
+
+// WhereFee applies the entql float64 predicate on the fee field.
+func (f *InvoiceFilter) WhereFee(p entql.Float64P) {
+	f.Where(p.Field(invoice.FieldFee))
+}
+
+// WhereTotal applies the entql float64 predicate on the total field.
+func (f *InvoiceFilter) WhereTotal(p entql.Float64P) {
+	f.Where(p.Field(invoice.FieldTotal))
+}
+
+// WhereLineItems applies the entql json.RawMessage predicate on the line_items field.
+func (f *InvoiceFilter) WhereLineItems(p entql.BytesP) {
+	f.Where(p.Field(invoice.FieldLineItems))
+}
+
+// WhereBillingSettings applies the entql json.RawMessage predicate on the billing_settings field.
+func (f *InvoiceFilter) WhereBillingSettings(p entql.BytesP) {
+	f.Where(p.Field(invoice.FieldBillingSettings))
+}
+
+// WhereHasProject applies a predicate to check if query has an edge project.
+func (f *InvoiceFilter) WhereHasProject() {
+	f.Where(entql.HasEdge("project"))
+}
+
+// WhereHasProjectWith applies a predicate to check if query has an edge project with a given conditions (other predicates).
+func (f *InvoiceFilter) WhereHasProjectWith(preds ...predicate.Project) {
+	f.Where(entql.HasEdgeWith("project", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *ModelQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -2497,7 +3658,7 @@ type ModelFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ModelFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2602,7 +3763,7 @@ type OIDCIdentityFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *OIDCIdentityFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2701,7 +3862,7 @@ type ProjectFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ProjectFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2747,6 +3908,21 @@ func (f *ProjectFilter) WhereProfiles(p entql.BytesP) {
 	f.Where(p.Field(project.FieldProfiles))
 }
 
+// WhereModerationSettings applies the entql json.RawMessage predicate on the moderation_settings field.
+func (f *ProjectFilter) WhereModerationSettings(p entql.BytesP) {
+	f.Where(p.Field(project.FieldModerationSettings))
+}
+
+// WhereBillingSettings applies the entql json.RawMessage predicate on the billing_settings field.
+func (f *ProjectFilter) WhereBillingSettings(p entql.BytesP) {
+	f.Where(p.Field(project.FieldBillingSettings))
+}
+
+// WhereDataPolicy applies the entql json.RawMessage predicate on the data_policy field.
+func (f *ProjectFilter) WhereDataPolicy(p entql.BytesP) {
+	f.Where(p.Field(project.FieldDataPolicy))
+}
+
 // WhereHasUsers applies a predicate to check if query has an edge users.
 func (f *ProjectFilter) WhereHasUsers() {
 	f.Where(entql.HasEdge("users"))
@@ -2864,23 +4040,65 @@ func (f *ProjectFilter) WhereHasPrompts() {
 	f.Where(entql.HasEdge("prompts"))
 }
 
-// WhereHasPromptsWith applies a predicate to check if query has an edge prompts with a given conditions (other predicates).
-func (f *ProjectFilter) WhereHasPromptsWith(preds ...predicate.Prompt) {
-	f.Where(entql.HasEdgeWith("prompts", sqlgraph.WrapFunc(func(s *sql.Selector) {
+// WhereHasPromptsWith applies a predicate to check if query has an edge prompts with a given conditions (other predicates).
+func (f *ProjectFilter) WhereHasPromptsWith(preds ...predicate.Prompt) {
+	f.Where(entql.HasEdgeWith("prompts", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasAPIKeyProfileTemplates applies a predicate to check if query has an edge api_key_profile_templates.
+func (f *ProjectFilter) WhereHasAPIKeyProfileTemplates() {
+	f.Where(entql.HasEdge("api_key_profile_templates"))
+}
+
+// WhereHasAPIKeyProfileTemplatesWith applies a predicate to check if query has an edge api_key_profile_templates with a given conditions (other predicates).
+func (f *ProjectFilter) WhereHasAPIKeyProfileTemplatesWith(preds ...predicate.APIKeyProfileTemplate) {
+	f.Where(entql.HasEdgeWith("api_key_profile_templates", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasBudgets applies a predicate to check if query has an edge budgets.
+func (f *ProjectFilter) WhereHasBudgets() {
+	f.Where(entql.HasEdge("budgets"))
+}
+
+// WhereHasBudgetsWith applies a predicate to check if query has an edge budgets with a given conditions (other predicates).
+func (f *ProjectFilter) WhereHasBudgetsWith(preds ...predicate.Budget) {
+	f.Where(entql.HasEdgeWith("budgets", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasInvoices applies a predicate to check if query has an edge invoices.
+func (f *ProjectFilter) WhereHasInvoices() {
+	f.Where(entql.HasEdge("invoices"))
+}
+
+// WhereHasInvoicesWith applies a predicate to check if query has an edge invoices with a given conditions (other predicates).
+func (f *ProjectFilter) WhereHasInvoicesWith(preds ...predicate.Invoice) {
+	f.Where(entql.HasEdgeWith("invoices", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
 		}
 	})))
 }
 
-// WhereHasAPIKeyProfileTemplates applies a predicate to check if query has an edge api_key_profile_templates.
-func (f *ProjectFilter) WhereHasAPIKeyProfileTemplates() {
-	f.Where(entql.HasEdge("api_key_profile_templates"))
+// WhereHasDataExports applies a predicate to check if query has an edge data_exports.
+func (f *ProjectFilter) WhereHasDataExports() {
+	f.Where(entql.HasEdge("data_exports"))
 }
 
-// WhereHasAPIKeyProfileTemplatesWith applies a predicate to check if query has an edge api_key_profile_templates with a given conditions (other predicates).
-func (f *ProjectFilter) WhereHasAPIKeyProfileTemplatesWith(preds ...predicate.APIKeyProfileTemplate) {
-	f.Where(entql.HasEdgeWith("api_key_profile_templates", sqlgraph.WrapFunc(func(s *sql.Selector) {
+// WhereHasDataExportsWith applies a predicate to check if query has an edge data_exports with a given conditions (other predicates).
+func (f *ProjectFilter) WhereHasDataExportsWith(preds ...predicate.DataExport) {
+	f.Where(entql.HasEdgeWith("data_exports", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
 		}
@@ -2930,7 +4148,7 @@ type PromptFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PromptFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3039,7 +4257,7 @@ type PromptProtectionRuleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PromptProtectionRuleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3119,7 +4337,7 @@ type ProviderQuotaStatusFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ProviderQuotaStatusFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[20].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3223,7 +4441,7 @@ type RequestFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RequestFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[21].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3294,6 +4512,11 @@ func (f *RequestFilter) WhereRequestBody(p entql.BytesP) {
 	f.Where(p.Field(request.FieldRequestBody))
 }
 
+// WhereRequestBodyBlobs applies the entql bool predicate on the request_body_blobs field.
+func (f *RequestFilter) WhereRequestBodyBlobs(p entql.BoolP) {
+	f.Where(p.Field(request.FieldRequestBodyBlobs))
+}
+
 // WhereResponseBody applies the entql json.RawMessage predicate on the response_body field.
 func (f *RequestFilter) WhereResponseBody(p entql.BytesP) {
 	f.Where(p.Field(request.FieldResponseBody))
@@ -3309,6 +4532,11 @@ func (f *RequestFilter) WhereChannelID(p entql.IntP) {
 	f.Where(p.Field(request.FieldChannelID))
 }
 
+// WhereEndUserID applies the entql string predicate on the end_user_id field.
+func (f *RequestFilter) WhereEndUserID(p entql.StringP) {
+	f.Where(p.Field(request.FieldEndUserID))
+}
+
 // WhereExternalID applies the entql string predicate on the external_id field.
 func (f *RequestFilter) WhereExternalID(p entql.StringP) {
 	f.Where(p.Field(request.FieldExternalID))
@@ -3364,6 +4592,26 @@ func (f *RequestFilter) WhereContentSavedAt(p entql.TimeP) {
 	f.Where(p.Field(request.FieldContentSavedAt))
 }
 
+// WhereModerationVerdicts applies the entql json.RawMessage predicate on the moderation_verdicts field.
+func (f *RequestFilter) WhereModerationVerdicts(p entql.BytesP) {
+	f.Where(p.Field(request.FieldModerationVerdicts))
+}
+
+// WhereArchivedAt applies the entql time.Time predicate on the archived_at field.
+func (f *RequestFilter) WhereArchivedAt(p entql.TimeP) {
+	f.Where(p.Field(request.FieldArchivedAt))
+}
+
+// WhereArchiveStorageID applies the entql int predicate on the archive_storage_id field.
+func (f *RequestFilter) WhereArchiveStorageID(p entql.IntP) {
+	f.Where(p.Field(request.FieldArchiveStorageID))
+}
+
+// WhereArchiveKey applies the entql string predicate on the archive_key field.
+func (f *RequestFilter) WhereArchiveKey(p entql.StringP) {
+	f.Where(p.Field(request.FieldArchiveKey))
+}
+
 // WhereHasAPIKey applies a predicate to check if query has an edge api_key.
 func (f *RequestFilter) WhereHasAPIKey() {
 	f.Where(entql.HasEdge("api_key"))
@@ -3491,7 +4739,7 @@ type RequestExecutionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RequestExecutionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[22].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3552,6 +4800,11 @@ func (f *RequestExecutionFilter) WhereRequestBody(p entql.BytesP) {
 	f.Where(p.Field(requestexecution.FieldRequestBody))
 }
 
+// WhereRequestBodyBlobs applies the entql bool predicate on the request_body_blobs field.
+func (f *RequestExecutionFilter) WhereRequestBodyBlobs(p entql.BoolP) {
+	f.Where(p.Field(requestexecution.FieldRequestBodyBlobs))
+}
+
 // WhereResponseBody applies the entql json.RawMessage predicate on the response_body field.
 func (f *RequestExecutionFilter) WhereResponseBody(p entql.BytesP) {
 	f.Where(p.Field(requestexecution.FieldResponseBody))
@@ -3654,6 +4907,71 @@ func (f *RequestExecutionFilter) WhereHasDataStorageWith(preds ...predicate.Data
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *RequestSearchTermQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RequestSearchTermQuery builder.
+func (_q *RequestSearchTermQuery) Filter() *RequestSearchTermFilter {
+	return &RequestSearchTermFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RequestSearchTermMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RequestSearchTermMutation builder.
+func (m *RequestSearchTermMutation) Filter() *RequestSearchTermFilter {
+	return &RequestSearchTermFilter{config: m.config, predicateAdder: m}
+}
+
+// RequestSearchTermFilter provides a generic filtering capability at runtime for RequestSearchTermQuery.
+type RequestSearchTermFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RequestSearchTermFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[23].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *RequestSearchTermFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(requestsearchterm.FieldID))
+}
+
+// WhereProjectID applies the entql int predicate on the project_id field.
+func (f *RequestSearchTermFilter) WhereProjectID(p entql.IntP) {
+	f.Where(p.Field(requestsearchterm.FieldProjectID))
+}
+
+// WhereRequestID applies the entql int predicate on the request_id field.
+func (f *RequestSearchTermFilter) WhereRequestID(p entql.IntP) {
+	f.Where(p.Field(requestsearchterm.FieldRequestID))
+}
+
+// WhereField applies the entql string predicate on the field field.
+func (f *RequestSearchTermFilter) WhereField(p entql.StringP) {
+	f.Where(p.Field(requestsearchterm.FieldField))
+}
+
+// WhereTerm applies the entql string predicate on the term field.
+func (f *RequestSearchTermFilter) WhereTerm(p entql.StringP) {
+	f.Where(p.Field(requestsearchterm.FieldTerm))
+}
+
+// WhereFrequency applies the entql int predicate on the frequency field.
+func (f *RequestSearchTermFilter) WhereFrequency(p entql.IntP) {
+	f.Where(p.Field(requestsearchterm.FieldFrequency))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *RoleQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -3683,7 +5001,7 @@ type RoleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RoleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[24].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3771,6 +5089,80 @@ func (f *RoleFilter) WhereHasUserRolesWith(preds ...predicate.UserRole) {
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *SCIMGroupQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SCIMGroupQuery builder.
+func (_q *SCIMGroupQuery) Filter() *SCIMGroupFilter {
+	return &SCIMGroupFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SCIMGroupMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SCIMGroupMutation builder.
+func (m *SCIMGroupMutation) Filter() *SCIMGroupFilter {
+	return &SCIMGroupFilter{config: m.config, predicateAdder: m}
+}
+
+// SCIMGroupFilter provides a generic filtering capability at runtime for SCIMGroupQuery.
+type SCIMGroupFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SCIMGroupFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[25].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *SCIMGroupFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(scimgroup.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *SCIMGroupFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(scimgroup.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *SCIMGroupFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(scimgroup.FieldUpdatedAt))
+}
+
+// WhereDisplayName applies the entql string predicate on the display_name field.
+func (f *SCIMGroupFilter) WhereDisplayName(p entql.StringP) {
+	f.Where(p.Field(scimgroup.FieldDisplayName))
+}
+
+// WhereExternalID applies the entql string predicate on the external_id field.
+func (f *SCIMGroupFilter) WhereExternalID(p entql.StringP) {
+	f.Where(p.Field(scimgroup.FieldExternalID))
+}
+
+// WhereHasUsers applies a predicate to check if query has an edge users.
+func (f *SCIMGroupFilter) WhereHasUsers() {
+	f.Where(entql.HasEdge("users"))
+}
+
+// WhereHasUsersWith applies a predicate to check if query has an edge users with a given conditions (other predicates).
+func (f *SCIMGroupFilter) WhereHasUsersWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("users", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *SystemQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -3800,7 +5192,7 @@ type SystemFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *SystemFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[26].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3865,7 +5257,7 @@ type ThreadFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *ThreadFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[27].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3958,7 +5350,7 @@ type TraceFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TraceFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[20].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[28].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4041,6 +5433,226 @@ func (f *TraceFilter) WhereHasRequestsWith(preds ...predicate.Request) {
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *UsageDailyRollupQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the UsageDailyRollupQuery builder.
+func (_q *UsageDailyRollupQuery) Filter() *UsageDailyRollupFilter {
+	return &UsageDailyRollupFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *UsageDailyRollupMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the UsageDailyRollupMutation builder.
+func (m *UsageDailyRollupMutation) Filter() *UsageDailyRollupFilter {
+	return &UsageDailyRollupFilter{config: m.config, predicateAdder: m}
+}
+
+// UsageDailyRollupFilter provides a generic filtering capability at runtime for UsageDailyRollupQuery.
+type UsageDailyRollupFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *UsageDailyRollupFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[29].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *UsageDailyRollupFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(usagedailyrollup.FieldID))
+}
+
+// WhereBucket applies the entql time.Time predicate on the bucket field.
+func (f *UsageDailyRollupFilter) WhereBucket(p entql.TimeP) {
+	f.Where(p.Field(usagedailyrollup.FieldBucket))
+}
+
+// WhereProjectID applies the entql int predicate on the project_id field.
+func (f *UsageDailyRollupFilter) WhereProjectID(p entql.IntP) {
+	f.Where(p.Field(usagedailyrollup.FieldProjectID))
+}
+
+// WhereAPIKeyID applies the entql int predicate on the api_key_id field.
+func (f *UsageDailyRollupFilter) WhereAPIKeyID(p entql.IntP) {
+	f.Where(p.Field(usagedailyrollup.FieldAPIKeyID))
+}
+
+// WhereChannelID applies the entql int predicate on the channel_id field.
+func (f *UsageDailyRollupFilter) WhereChannelID(p entql.IntP) {
+	f.Where(p.Field(usagedailyrollup.FieldChannelID))
+}
+
+// WhereModelID applies the entql string predicate on the model_id field.
+func (f *UsageDailyRollupFilter) WhereModelID(p entql.StringP) {
+	f.Where(p.Field(usagedailyrollup.FieldModelID))
+}
+
+// WhereEndUserID applies the entql string predicate on the end_user_id field.
+func (f *UsageDailyRollupFilter) WhereEndUserID(p entql.StringP) {
+	f.Where(p.Field(usagedailyrollup.FieldEndUserID))
+}
+
+// WhereRequestCount applies the entql int64 predicate on the request_count field.
+func (f *UsageDailyRollupFilter) WhereRequestCount(p entql.Int64P) {
+	f.Where(p.Field(usagedailyrollup.FieldRequestCount))
+}
+
+// WherePromptTokens applies the entql int64 predicate on the prompt_tokens field.
+func (f *UsageDailyRollupFilter) WherePromptTokens(p entql.Int64P) {
+	f.Where(p.Field(usagedailyrollup.FieldPromptTokens))
+}
+
+// WherePromptCachedTokens applies the entql int64 predicate on the prompt_cached_tokens field.
+func (f *UsageDailyRollupFilter) WherePromptCachedTokens(p entql.Int64P) {
+	f.Where(p.Field(usagedailyrollup.FieldPromptCachedTokens))
+}
+
+// WherePromptWriteCachedTokens applies the entql int64 predicate on the prompt_write_cached_tokens field.
+func (f *UsageDailyRollupFilter) WherePromptWriteCachedTokens(p entql.Int64P) {
+	f.Where(p.Field(usagedailyrollup.FieldPromptWriteCachedTokens))
+}
+
+// WhereCompletionTokens applies the entql int64 predicate on the completion_tokens field.
+func (f *UsageDailyRollupFilter) WhereCompletionTokens(p entql.Int64P) {
+	f.Where(p.Field(usagedailyrollup.FieldCompletionTokens))
+}
+
+// WhereCompletionReasoningTokens applies the entql int64 predicate on the completion_reasoning_tokens field.
+func (f *UsageDailyRollupFilter) WhereCompletionReasoningTokens(p entql.Int64P) {
+	f.Where(p.Field(usagedailyrollup.FieldCompletionReasoningTokens))
+}
+
+// WhereTotalTokens applies the entql int64 predicate on the total_tokens field.
+func (f *UsageDailyRollupFilter) WhereTotalTokens(p entql.Int64P) {
+	f.Where(p.Field(usagedailyrollup.FieldTotalTokens))
+}
+
+// WhereTotalCost applies the entql float64 predicate on the total_cost field.
+func (f *UsageDailyRollupFilter) WhereTotalCost(p entql.Float64P) {
+	f.Where(p.Field(usagedailyrollup.FieldTotalCost))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *UsageHourlyRollupQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the UsageHourlyRollupQuery builder.
+func (_q *UsageHourlyRollupQuery) Filter() *UsageHourlyRollupFilter {
+	return &UsageHourlyRollupFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *UsageHourlyRollupMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the UsageHourlyRollupMutation builder.
+func (m *UsageHourlyRollupMutation) Filter() *UsageHourlyRollupFilter {
+	return &UsageHourlyRollupFilter{config: m.config, predicateAdder: m}
+}
+
+// UsageHourlyRollupFilter provides a generic filtering capability at runtime for UsageHourlyRollupQuery.
+type UsageHourlyRollupFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *UsageHourlyRollupFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[30].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *UsageHourlyRollupFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(usagehourlyrollup.FieldID))
+}
+
+// WhereBucket applies the entql time.Time predicate on the bucket field.
+func (f *UsageHourlyRollupFilter) WhereBucket(p entql.TimeP) {
+	f.Where(p.Field(usagehourlyrollup.FieldBucket))
+}
+
+// WhereProjectID applies the entql int predicate on the project_id field.
+func (f *UsageHourlyRollupFilter) WhereProjectID(p entql.IntP) {
+	f.Where(p.Field(usagehourlyrollup.FieldProjectID))
+}
+
+// WhereAPIKeyID applies the entql int predicate on the api_key_id field.
+func (f *UsageHourlyRollupFilter) WhereAPIKeyID(p entql.IntP) {
+	f.Where(p.Field(usagehourlyrollup.FieldAPIKeyID))
+}
+
+// WhereChannelID applies the entql int predicate on the channel_id field.
+func (f *UsageHourlyRollupFilter) WhereChannelID(p entql.IntP) {
+	f.Where(p.Field(usagehourlyrollup.FieldChannelID))
+}
+
+// WhereModelID applies the entql string predicate on the model_id field.
+func (f *UsageHourlyRollupFilter) WhereModelID(p entql.StringP) {
+	f.Where(p.Field(usagehourlyrollup.FieldModelID))
+}
+
+// WhereEndUserID applies the entql string predicate on the end_user_id field.
+func (f *UsageHourlyRollupFilter) WhereEndUserID(p entql.StringP) {
+	f.Where(p.Field(usagehourlyrollup.FieldEndUserID))
+}
+
+// WhereRequestCount applies the entql int64 predicate on the request_count field.
+func (f *UsageHourlyRollupFilter) WhereRequestCount(p entql.Int64P) {
+	f.Where(p.Field(usagehourlyrollup.FieldRequestCount))
+}
+
+// WherePromptTokens applies the entql int64 predicate on the prompt_tokens field.
+func (f *UsageHourlyRollupFilter) WherePromptTokens(p entql.Int64P) {
+	f.Where(p.Field(usagehourlyrollup.FieldPromptTokens))
+}
+
+// WherePromptCachedTokens applies the entql int64 predicate on the prompt_cached_tokens field.
+func (f *UsageHourlyRollupFilter) WherePromptCachedTokens(p entql.Int64P) {
+	f.Where(p.Field(usagehourlyrollup.FieldPromptCachedTokens))
+}
+
+// WherePromptWriteCachedTokens applies the entql int64 predicate on the prompt_write_cached_tokens field.
+func (f *UsageHourlyRollupFilter) WherePromptWriteCachedTokens(p entql.Int64P) {
+	f.Where(p.Field(usagehourlyrollup.FieldPromptWriteCachedTokens))
+}
+
+// WhereCompletionTokens applies the entql int64 predicate on the completion_tokens field.
+func (f *UsageHourlyRollupFilter) WhereCompletionTokens(p entql.Int64P) {
+	f.Where(p.Field(usagehourlyrollup.FieldCompletionTokens))
+}
+
+// WhereCompletionReasoningTokens applies the entql int64 predicate on the completion_reasoning_tokens field.
+func (f *UsageHourlyRollupFilter) WhereCompletionReasoningTokens(p entql.Int64P) {
+	f.Where(p.Field(usagehourlyrollup.FieldCompletionReasoningTokens))
+}
+
+// WhereTotalTokens applies the entql int64 predicate on the total_tokens field.
+func (f *UsageHourlyRollupFilter) WhereTotalTokens(p entql.Int64P) {
+	f.Where(p.Field(usagehourlyrollup.FieldTotalTokens))
+}
+
+// WhereTotalCost applies the entql float64 predicate on the total_cost field.
+func (f *UsageHourlyRollupFilter) WhereTotalCost(p entql.Float64P) {
+	f.Where(p.Field(usagehourlyrollup.FieldTotalCost))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *UsageLogQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -4070,7 +5682,7 @@ type UsageLogFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UsageLogFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[21].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[31].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4101,6 +5713,11 @@ func (f *UsageLogFilter) WhereAPIKeyID(p entql.IntP) {
 	f.Where(p.Field(usagelog.FieldAPIKeyID))
 }
 
+// WhereEndUserID applies the entql string predicate on the end_user_id field.
+func (f *UsageLogFilter) WhereEndUserID(p entql.StringP) {
+	f.Where(p.Field(usagelog.FieldEndUserID))
+}
+
 // WhereProjectID applies the entql int predicate on the project_id field.
 func (f *UsageLogFilter) WhereProjectID(p entql.IntP) {
 	f.Where(p.Field(usagelog.FieldProjectID))
@@ -4201,6 +5818,26 @@ func (f *UsageLogFilter) WhereCostPriceReferenceID(p entql.StringP) {
 	f.Where(p.Field(usagelog.FieldCostPriceReferenceID))
 }
 
+// WhereCurrency applies the entql string predicate on the currency field.
+func (f *UsageLogFilter) WhereCurrency(p entql.StringP) {
+	f.Where(p.Field(usagelog.FieldCurrency))
+}
+
+// WhereOriginalTotalCost applies the entql float64 predicate on the original_total_cost field.
+func (f *UsageLogFilter) WhereOriginalTotalCost(p entql.Float64P) {
+	f.Where(p.Field(usagelog.FieldOriginalTotalCost))
+}
+
+// WhereOriginalCurrency applies the entql string predicate on the original_currency field.
+func (f *UsageLogFilter) WhereOriginalCurrency(p entql.StringP) {
+	f.Where(p.Field(usagelog.FieldOriginalCurrency))
+}
+
+// WhereExchangeRate applies the entql float64 predicate on the exchange_rate field.
+func (f *UsageLogFilter) WhereExchangeRate(p entql.Float64P) {
+	f.Where(p.Field(usagelog.FieldExchangeRate))
+}
+
 // WhereHasRequest applies a predicate to check if query has an edge request.
 func (f *UsageLogFilter) WhereHasRequest() {
 	f.Where(entql.HasEdge("request"))
@@ -4272,7 +5909,7 @@ type UserFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[22].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[32].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4413,6 +6050,20 @@ func (f *UserFilter) WhereHasOidcIdentitiesWith(preds ...predicate.OIDCIdentity)
 	})))
 }
 
+// WhereHasScimGroups applies a predicate to check if query has an edge scim_groups.
+func (f *UserFilter) WhereHasScimGroups() {
+	f.Where(entql.HasEdge("scim_groups"))
+}
+
+// WhereHasScimGroupsWith applies a predicate to check if query has an edge scim_groups with a given conditions (other predicates).
+func (f *UserFilter) WhereHasScimGroupsWith(preds ...predicate.SCIMGroup) {
+	f.Where(entql.HasEdgeWith("scim_groups", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // WhereHasProjectUsers applies a predicate to check if query has an edge project_users.
 func (f *UserFilter) WhereHasProjectUsers() {
 	f.Where(entql.HasEdge("project_users"))
@@ -4470,7 +6121,7 @@ type UserProjectFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserProjectFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[23].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[33].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4568,7 +6219,7 @@ type UserRoleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserRoleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[24].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[34].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4626,3 +6277,410 @@ func (f *UserRoleFilter) WhereHasRoleWith(preds ...predicate.Role) {
 		}
 	})))
 }
+
+// addPredicate implements the predicateAdder interface.
+func (_q *WalletQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WalletQuery builder.
+func (_q *WalletQuery) Filter() *WalletFilter {
+	return &WalletFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WalletMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WalletMutation builder.
+func (m *WalletMutation) Filter() *WalletFilter {
+	return &WalletFilter{config: m.config, predicateAdder: m}
+}
+
+// WalletFilter provides a generic filtering capability at runtime for WalletQuery.
+type WalletFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WalletFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[35].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *WalletFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(wallet.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *WalletFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(wallet.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *WalletFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(wallet.FieldUpdatedAt))
+}
+
+// WhereAPIKeyID applies the entql int predicate on the api_key_id field.
+func (f *WalletFilter) WhereAPIKeyID(p entql.IntP) {
+	f.Where(p.Field(wallet.FieldAPIKeyID))
+}
+
+// WhereProjectID applies the entql int predicate on the project_id field.
+func (f *WalletFilter) WhereProjectID(p entql.IntP) {
+	f.Where(p.Field(wallet.FieldProjectID))
+}
+
+// WhereBalance applies the entql float64 predicate on the balance field.
+func (f *WalletFilter) WhereBalance(p entql.Float64P) {
+	f.Where(p.Field(wallet.FieldBalance))
+}
+
+// WhereReserved applies the entql float64 predicate on the reserved field.
+func (f *WalletFilter) WhereReserved(p entql.Float64P) {
+	f.Where(p.Field(wallet.FieldReserved))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *WalletFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(wallet.FieldStatus))
+}
+
+// WhereLowBalanceThreshold applies the entql float64 predicate on the low_balance_threshold field.
+func (f *WalletFilter) WhereLowBalanceThreshold(p entql.Float64P) {
+	f.Where(p.Field(wallet.FieldLowBalanceThreshold))
+}
+
+// WhereLowBalanceAlerted applies the entql bool predicate on the low_balance_alerted field.
+func (f *WalletFilter) WhereLowBalanceAlerted(p entql.BoolP) {
+	f.Where(p.Field(wallet.FieldLowBalanceAlerted))
+}
+
+// WhereHasAPIKey applies a predicate to check if query has an edge api_key.
+func (f *WalletFilter) WhereHasAPIKey() {
+	f.Where(entql.HasEdge("api_key"))
+}
+
+// WhereHasAPIKeyWith applies a predicate to check if query has an edge api_key with a given conditions (other predicates).
+func (f *WalletFilter) WhereHasAPIKeyWith(preds ...predicate.APIKey) {
+	f.Where(entql.HasEdgeWith("api_key", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTransactions applies a predicate to check if query has an edge transactions.
+func (f *WalletFilter) WhereHasTransactions() {
+	f.Where(entql.HasEdge("transactions"))
+}
+
+// WhereHasTransactionsWith applies a predicate to check if query has an edge transactions with a given conditions (other predicates).
+func (f *WalletFilter) WhereHasTransactionsWith(preds ...predicate.WalletTransaction) {
+	f.Where(entql.HasEdgeWith("transactions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *WalletReservationQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WalletReservationQuery builder.
+func (_q *WalletReservationQuery) Filter() *WalletReservationFilter {
+	return &WalletReservationFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WalletReservationMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WalletReservationMutation builder.
+func (m *WalletReservationMutation) Filter() *WalletReservationFilter {
+	return &WalletReservationFilter{config: m.config, predicateAdder: m}
+}
+
+// WalletReservationFilter provides a generic filtering capability at runtime for WalletReservationQuery.
+type WalletReservationFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WalletReservationFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[36].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *WalletReservationFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(walletreservation.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *WalletReservationFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(walletreservation.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *WalletReservationFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(walletreservation.FieldUpdatedAt))
+}
+
+// WhereWalletID applies the entql int predicate on the wallet_id field.
+func (f *WalletReservationFilter) WhereWalletID(p entql.IntP) {
+	f.Where(p.Field(walletreservation.FieldWalletID))
+}
+
+// WhereRequestID applies the entql int predicate on the request_id field.
+func (f *WalletReservationFilter) WhereRequestID(p entql.IntP) {
+	f.Where(p.Field(walletreservation.FieldRequestID))
+}
+
+// WhereAmount applies the entql float64 predicate on the amount field.
+func (f *WalletReservationFilter) WhereAmount(p entql.Float64P) {
+	f.Where(p.Field(walletreservation.FieldAmount))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *WalletReservationFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(walletreservation.FieldExpiresAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *WalletTransactionQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WalletTransactionQuery builder.
+func (_q *WalletTransactionQuery) Filter() *WalletTransactionFilter {
+	return &WalletTransactionFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WalletTransactionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WalletTransactionMutation builder.
+func (m *WalletTransactionMutation) Filter() *WalletTransactionFilter {
+	return &WalletTransactionFilter{config: m.config, predicateAdder: m}
+}
+
+// WalletTransactionFilter provides a generic filtering capability at runtime for WalletTransactionQuery.
+type WalletTransactionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WalletTransactionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[37].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *WalletTransactionFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(wallettransaction.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *WalletTransactionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(wallettransaction.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *WalletTransactionFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(wallettransaction.FieldUpdatedAt))
+}
+
+// WhereWalletID applies the entql int predicate on the wallet_id field.
+func (f *WalletTransactionFilter) WhereWalletID(p entql.IntP) {
+	f.Where(p.Field(wallettransaction.FieldWalletID))
+}
+
+// WhereAPIKeyID applies the entql int predicate on the api_key_id field.
+func (f *WalletTransactionFilter) WhereAPIKeyID(p entql.IntP) {
+	f.Where(p.Field(wallettransaction.FieldAPIKeyID))
+}
+
+// WhereProjectID applies the entql int predicate on the project_id field.
+func (f *WalletTransactionFilter) WhereProjectID(p entql.IntP) {
+	f.Where(p.Field(wallettransaction.FieldProjectID))
+}
+
+// WhereType applies the entql string predicate on the type field.
+func (f *WalletTransactionFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(wallettransaction.FieldType))
+}
+
+// WhereAmount applies the entql float64 predicate on the amount field.
+func (f *WalletTransactionFilter) WhereAmount(p entql.Float64P) {
+	f.Where(p.Field(wallettransaction.FieldAmount))
+}
+
+// WhereBalanceAfter applies the entql float64 predicate on the balance_after field.
+func (f *WalletTransactionFilter) WhereBalanceAfter(p entql.Float64P) {
+	f.Where(p.Field(wallettransaction.FieldBalanceAfter))
+}
+
+// WhereRequestID applies the entql int predicate on the request_id field.
+func (f *WalletTransactionFilter) WhereRequestID(p entql.IntP) {
+	f.Where(p.Field(wallettransaction.FieldRequestID))
+}
+
+// WhereUsageLogID applies the entql int predicate on the usage_log_id field.
+func (f *WalletTransactionFilter) WhereUsageLogID(p entql.IntP) {
+	f.Where(p.Field(wallettransaction.FieldUsageLogID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *WalletTransactionFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(wallettransaction.FieldUserID))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *WalletTransactionFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(wallettransaction.FieldDescription))
+}
+
+// WhereHasWallet applies a predicate to check if query has an edge wallet.
+func (f *WalletTransactionFilter) WhereHasWallet() {
+	f.Where(entql.HasEdge("wallet"))
+}
+
+// WhereHasWalletWith applies a predicate to check if query has an edge wallet with a given conditions (other predicates).
+func (f *WalletTransactionFilter) WhereHasWalletWith(preds ...predicate.Wallet) {
+	f.Where(entql.HasEdgeWith("wallet", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *WebhookDeliveryQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WebhookDeliveryQuery builder.
+func (_q *WebhookDeliveryQuery) Filter() *WebhookDeliveryFilter {
+	return &WebhookDeliveryFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WebhookDeliveryMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Filter() *WebhookDeliveryFilter {
+	return &WebhookDeliveryFilter{config: m.config, predicateAdder: m}
+}
+
+// WebhookDeliveryFilter provides a generic filtering capability at runtime for WebhookDeliveryQuery.
+type WebhookDeliveryFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WebhookDeliveryFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[38].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *WebhookDeliveryFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(webhookdelivery.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *WebhookDeliveryFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(webhookdelivery.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *WebhookDeliveryFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(webhookdelivery.FieldUpdatedAt))
+}
+
+// WhereEventID applies the entql string predicate on the event_id field.
+func (f *WebhookDeliveryFilter) WhereEventID(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldEventID))
+}
+
+// WhereEvent applies the entql string predicate on the event field.
+func (f *WebhookDeliveryFilter) WhereEvent(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldEvent))
+}
+
+// WhereTargetName applies the entql string predicate on the target_name field.
+func (f *WebhookDeliveryFilter) WhereTargetName(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldTargetName))
+}
+
+// WhereURL applies the entql string predicate on the url field.
+func (f *WebhookDeliveryFilter) WhereURL(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldURL))
+}
+
+// WherePayload applies the entql string predicate on the payload field.
+func (f *WebhookDeliveryFilter) WherePayload(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldPayload))
+}
+
+// WhereHeaders applies the entql json.RawMessage predicate on the headers field.
+func (f *WebhookDeliveryFilter) WhereHeaders(p entql.BytesP) {
+	f.Where(p.Field(webhookdelivery.FieldHeaders))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *WebhookDeliveryFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldStatus))
+}
+
+// WhereAttempts applies the entql int predicate on the attempts field.
+func (f *WebhookDeliveryFilter) WhereAttempts(p entql.IntP) {
+	f.Where(p.Field(webhookdelivery.FieldAttempts))
+}
+
+// WhereNextAttemptAt applies the entql time.Time predicate on the next_attempt_at field.
+func (f *WebhookDeliveryFilter) WhereNextAttemptAt(p entql.TimeP) {
+	f.Where(p.Field(webhookdelivery.FieldNextAttemptAt))
+}
+
+// WhereLastError applies the entql string predicate on the last_error field.
+func (f *WebhookDeliveryFilter) WhereLastError(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldLastError))
+}
+
+// WhereResponseStatus applies the entql int predicate on the response_status field.
+func (f *WebhookDeliveryFilter) WhereResponseStatus(p entql.IntP) {
+	f.Where(p.Field(webhookdelivery.FieldResponseStatus))
+}
+
+// WhereDeliveredAt applies the entql time.Time predicate on the delivered_at field.
+func (f *WebhookDeliveryFilter) WhereDeliveredAt(p entql.TimeP) {
+	f.Where(p.Field(webhookdelivery.FieldDeliveredAt))
+}
@@ -12,12 +12,17 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/apikeyprofiletemplate"
+	"github.com/looplj/axonhub/internal/ent/auditlog"
+	"github.com/looplj/axonhub/internal/ent/budget"
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/channelmodelprice"
 	"github.com/looplj/axonhub/internal/ent/channelmodelpriceversion"
 	"github.com/looplj/axonhub/internal/ent/channeloverridetemplate"
 	"github.com/looplj/axonhub/internal/ent/channelprobe"
+	"github.com/looplj/axonhub/internal/ent/dataexport"
 	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/exchangerate"
+	"github.com/looplj/axonhub/internal/ent/invoice"
 	"github.com/looplj/axonhub/internal/ent/model"
 	"github.com/looplj/axonhub/internal/ent/oidcidentity"
 	"github.com/looplj/axonhub/internal/ent/project"
@@ -34,6 +39,9 @@ import (
 	"github.com/looplj/axonhub/internal/ent/user"
 	"github.com/looplj/axonhub/internal/ent/userproject"
 	"github.com/looplj/axonhub/internal/ent/userrole"
+	"github.com/looplj/axonhub/internal/ent/wallet"
+	"github.com/looplj/axonhub/internal/ent/wallettransaction"
+	"github.com/looplj/axonhub/internal/ent/webhookdelivery"
 )
 
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
@@ -176,6 +184,17 @@ func (_q *APIKeyQuery) collectField(ctx context.Context, oneNode bool, opCtx *gr
 			_q.WithNamedRequests(alias, func(wq *RequestQuery) {
 				*wq = *query
 			})
+
+		case "wallet":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&WalletClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, walletImplementors)...); err != nil {
+				return err
+			}
+			_q.withWallet = query
 		case "createdAt":
 			if _, ok := fieldSeen[apikey.FieldCreatedAt]; !ok {
 				selectedFields = append(selectedFields, apikey.FieldCreatedAt)
@@ -423,6 +442,324 @@ func newAPIKeyProfileTemplatePaginateArgs(rv map[string]any) *apikeyprofiletempl
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *AuditLogQuery) CollectFields(ctx context.Context, satisfies ...string) (*AuditLogQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *AuditLogQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(auditlog.Columns))
+		selectedFields = []string{auditlog.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "createdAt":
+			if _, ok := fieldSeen[auditlog.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldCreatedAt)
+				fieldSeen[auditlog.FieldCreatedAt] = struct{}{}
+			}
+		case "actorType":
+			if _, ok := fieldSeen[auditlog.FieldActorType]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldActorType)
+				fieldSeen[auditlog.FieldActorType] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[auditlog.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldUserID)
+				fieldSeen[auditlog.FieldUserID] = struct{}{}
+			}
+		case "apiKeyID":
+			if _, ok := fieldSeen[auditlog.FieldAPIKeyID]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldAPIKeyID)
+				fieldSeen[auditlog.FieldAPIKeyID] = struct{}{}
+			}
+		case "actor":
+			if _, ok := fieldSeen[auditlog.FieldActor]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldActor)
+				fieldSeen[auditlog.FieldActor] = struct{}{}
+			}
+		case "projectID":
+			if _, ok := fieldSeen[auditlog.FieldProjectID]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldProjectID)
+				fieldSeen[auditlog.FieldProjectID] = struct{}{}
+			}
+		case "source":
+			if _, ok := fieldSeen[auditlog.FieldSource]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldSource)
+				fieldSeen[auditlog.FieldSource] = struct{}{}
+			}
+		case "operation":
+			if _, ok := fieldSeen[auditlog.FieldOperation]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldOperation)
+				fieldSeen[auditlog.FieldOperation] = struct{}{}
+			}
+		case "action":
+			if _, ok := fieldSeen[auditlog.FieldAction]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldAction)
+				fieldSeen[auditlog.FieldAction] = struct{}{}
+			}
+		case "entityType":
+			if _, ok := fieldSeen[auditlog.FieldEntityType]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldEntityType)
+				fieldSeen[auditlog.FieldEntityType] = struct{}{}
+			}
+		case "entityID":
+			if _, ok := fieldSeen[auditlog.FieldEntityID]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldEntityID)
+				fieldSeen[auditlog.FieldEntityID] = struct{}{}
+			}
+		case "changes":
+			if _, ok := fieldSeen[auditlog.FieldChanges]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldChanges)
+				fieldSeen[auditlog.FieldChanges] = struct{}{}
+			}
+		case "sourceIP":
+			if _, ok := fieldSeen[auditlog.FieldSourceIP]; !ok {
+				selectedFields = append(selectedFields, auditlog.FieldSourceIP)
+				fieldSeen[auditlog.FieldSourceIP] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type auditlogPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []AuditLogPaginateOption
+}
+
+func newAuditLogPaginateArgs(rv map[string]any) *auditlogPaginateArgs {
+	args := &auditlogPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &AuditLogOrder{Field: &AuditLogOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithAuditLogOrder(order))
+			}
+		case *AuditLogOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithAuditLogOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*AuditLogWhereInput); ok {
+		args.opts = append(args.opts, WithAuditLogFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *BudgetQuery) CollectFields(ctx context.Context, satisfies ...string) (*BudgetQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *BudgetQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(budget.Columns))
+		selectedFields = []string{budget.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "project":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ProjectClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, projectImplementors)...); err != nil {
+				return err
+			}
+			_q.withProject = query
+			if _, ok := fieldSeen[budget.FieldProjectID]; !ok {
+				selectedFields = append(selectedFields, budget.FieldProjectID)
+				fieldSeen[budget.FieldProjectID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[budget.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, budget.FieldCreatedAt)
+				fieldSeen[budget.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[budget.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, budget.FieldUpdatedAt)
+				fieldSeen[budget.FieldUpdatedAt] = struct{}{}
+			}
+		case "projectID":
+			if _, ok := fieldSeen[budget.FieldProjectID]; !ok {
+				selectedFields = append(selectedFields, budget.FieldProjectID)
+				fieldSeen[budget.FieldProjectID] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[budget.FieldName]; !ok {
+				selectedFields = append(selectedFields, budget.FieldName)
+				fieldSeen[budget.FieldName] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[budget.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, budget.FieldDescription)
+				fieldSeen[budget.FieldDescription] = struct{}{}
+			}
+		case "scope":
+			if _, ok := fieldSeen[budget.FieldScope]; !ok {
+				selectedFields = append(selectedFields, budget.FieldScope)
+				fieldSeen[budget.FieldScope] = struct{}{}
+			}
+		case "apiKeyID":
+			if _, ok := fieldSeen[budget.FieldAPIKeyID]; !ok {
+				selectedFields = append(selectedFields, budget.FieldAPIKeyID)
+				fieldSeen[budget.FieldAPIKeyID] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[budget.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, budget.FieldUserID)
+				fieldSeen[budget.FieldUserID] = struct{}{}
+			}
+		case "amount":
+			if _, ok := fieldSeen[budget.FieldAmount]; !ok {
+				selectedFields = append(selectedFields, budget.FieldAmount)
+				fieldSeen[budget.FieldAmount] = struct{}{}
+			}
+		case "period":
+			if _, ok := fieldSeen[budget.FieldPeriod]; !ok {
+				selectedFields = append(selectedFields, budget.FieldPeriod)
+				fieldSeen[budget.FieldPeriod] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[budget.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, budget.FieldStatus)
+				fieldSeen[budget.FieldStatus] = struct{}{}
+			}
+		case "settings":
+			if _, ok := fieldSeen[budget.FieldSettings]; !ok {
+				selectedFields = append(selectedFields, budget.FieldSettings)
+				fieldSeen[budget.FieldSettings] = struct{}{}
+			}
+		case "alertedThreshold":
+			if _, ok := fieldSeen[budget.FieldAlertedThreshold]; !ok {
+				selectedFields = append(selectedFields, budget.FieldAlertedThreshold)
+				fieldSeen[budget.FieldAlertedThreshold] = struct{}{}
+			}
+		case "alertedPeriodStart":
+			if _, ok := fieldSeen[budget.FieldAlertedPeriodStart]; !ok {
+				selectedFields = append(selectedFields, budget.FieldAlertedPeriodStart)
+				fieldSeen[budget.FieldAlertedPeriodStart] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type budgetPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []BudgetPaginateOption
+}
+
+func newBudgetPaginateArgs(rv map[string]any) *budgetPaginateArgs {
+	args := &budgetPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &BudgetOrder{Field: &BudgetOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithBudgetOrder(order))
+			}
+		case *BudgetOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithBudgetOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*BudgetWhereInput); ok {
+		args.opts = append(args.opts, WithBudgetFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (_q *ChannelQuery) CollectFields(ctx context.Context, satisfies ...string) (*ChannelQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -1444,7 +1781,7 @@ func newChannelProbePaginateArgs(rv map[string]any) *channelprobePaginateArgs {
 }
 
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
-func (_q *DataStorageQuery) CollectFields(ctx context.Context, satisfies ...string) (*DataStorageQuery, error) {
+func (_q *DataExportQuery) CollectFields(ctx context.Context, satisfies ...string) (*DataExportQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
 	if fc == nil {
 		return _q, nil
@@ -1455,75 +1792,254 @@ func (_q *DataStorageQuery) CollectFields(ctx context.Context, satisfies ...stri
 	return _q, nil
 }
 
-func (_q *DataStorageQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+func (_q *DataExportQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
 	path = append([]string(nil), path...)
 	var (
 		unknownSeen    bool
-		fieldSeen      = make(map[string]struct{}, len(datastorage.Columns))
-		selectedFields = []string{datastorage.FieldID}
+		fieldSeen      = make(map[string]struct{}, len(dataexport.Columns))
+		selectedFields = []string{dataexport.FieldID}
 	)
 	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
 		switch field.Name {
 
-		case "requests":
+		case "project":
 			var (
 				alias = field.Alias
 				path  = append(path, alias)
-				query = (&RequestClient{config: _q.config}).Query()
+				query = (&ProjectClient{config: _q.config}).Query()
 			)
-			args := newRequestPaginateArgs(fieldArgs(ctx, new(RequestWhereInput), path...))
-			if err := validateFirstLast(args.first, args.last); err != nil {
-				return fmt.Errorf("validate first and last in path %q: %w", path, err)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, projectImplementors)...); err != nil {
+				return err
 			}
-			pager, err := newRequestPager(args.opts, args.last != nil)
-			if err != nil {
-				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			_q.withProject = query
+			if _, ok := fieldSeen[dataexport.FieldProjectID]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldProjectID)
+				fieldSeen[dataexport.FieldProjectID] = struct{}{}
 			}
-			if query, err = pager.applyFilter(query); err != nil {
-				return err
+		case "createdAt":
+			if _, ok := fieldSeen[dataexport.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldCreatedAt)
+				fieldSeen[dataexport.FieldCreatedAt] = struct{}{}
 			}
-			ignoredEdges := !hasCollectedField(ctx, append(path, edgesField)...)
-			if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
-				hasPagination := args.after != nil || args.first != nil || args.before != nil || args.last != nil
-				if hasPagination || ignoredEdges {
-					query := query.Clone()
-					_q.loadTotal = append(_q.loadTotal, func(ctx context.Context, nodes []*DataStorage) error {
-						ids := make([]driver.Value, len(nodes))
-						for i := range nodes {
-							ids[i] = nodes[i].ID
-						}
-						var v []struct {
-							NodeID int `sql:"data_storage_id"`
-							Count  int `sql:"count"`
-						}
-						query.Where(func(s *sql.Selector) {
-							s.Where(sql.InValues(s.C(datastorage.RequestsColumn), ids...))
-						})
-						if err := query.GroupBy(datastorage.RequestsColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
-							return err
-						}
-						m := make(map[int]int, len(v))
-						for i := range v {
-							m[v[i].NodeID] = v[i].Count
-						}
-						for i := range nodes {
-							n := m[nodes[i].ID]
-							if nodes[i].Edges.totalCount[0] == nil {
-								nodes[i].Edges.totalCount[0] = make(map[string]int)
-							}
-							nodes[i].Edges.totalCount[0][alias] = n
-						}
-						return nil
-					})
-				} else {
-					_q.loadTotal = append(_q.loadTotal, func(_ context.Context, nodes []*DataStorage) error {
-						for i := range nodes {
-							n := len(nodes[i].Edges.Requests)
-							if nodes[i].Edges.totalCount[0] == nil {
-								nodes[i].Edges.totalCount[0] = make(map[string]int)
-							}
-							nodes[i].Edges.totalCount[0][alias] = n
-						}
+		case "updatedAt":
+			if _, ok := fieldSeen[dataexport.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldUpdatedAt)
+				fieldSeen[dataexport.FieldUpdatedAt] = struct{}{}
+			}
+		case "projectID":
+			if _, ok := fieldSeen[dataexport.FieldProjectID]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldProjectID)
+				fieldSeen[dataexport.FieldProjectID] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[dataexport.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldUserID)
+				fieldSeen[dataexport.FieldUserID] = struct{}{}
+			}
+		case "resource":
+			if _, ok := fieldSeen[dataexport.FieldResource]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldResource)
+				fieldSeen[dataexport.FieldResource] = struct{}{}
+			}
+		case "format":
+			if _, ok := fieldSeen[dataexport.FieldFormat]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldFormat)
+				fieldSeen[dataexport.FieldFormat] = struct{}{}
+			}
+		case "includeBodies":
+			if _, ok := fieldSeen[dataexport.FieldIncludeBodies]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldIncludeBodies)
+				fieldSeen[dataexport.FieldIncludeBodies] = struct{}{}
+			}
+		case "criteria":
+			if _, ok := fieldSeen[dataexport.FieldCriteria]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldCriteria)
+				fieldSeen[dataexport.FieldCriteria] = struct{}{}
+			}
+		case "dataStorageID":
+			if _, ok := fieldSeen[dataexport.FieldDataStorageID]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldDataStorageID)
+				fieldSeen[dataexport.FieldDataStorageID] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[dataexport.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldStatus)
+				fieldSeen[dataexport.FieldStatus] = struct{}{}
+			}
+		case "storageKey":
+			if _, ok := fieldSeen[dataexport.FieldStorageKey]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldStorageKey)
+				fieldSeen[dataexport.FieldStorageKey] = struct{}{}
+			}
+		case "rowCount":
+			if _, ok := fieldSeen[dataexport.FieldRowCount]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldRowCount)
+				fieldSeen[dataexport.FieldRowCount] = struct{}{}
+			}
+		case "size":
+			if _, ok := fieldSeen[dataexport.FieldSize]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldSize)
+				fieldSeen[dataexport.FieldSize] = struct{}{}
+			}
+		case "errorMessage":
+			if _, ok := fieldSeen[dataexport.FieldErrorMessage]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldErrorMessage)
+				fieldSeen[dataexport.FieldErrorMessage] = struct{}{}
+			}
+		case "startedAt":
+			if _, ok := fieldSeen[dataexport.FieldStartedAt]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldStartedAt)
+				fieldSeen[dataexport.FieldStartedAt] = struct{}{}
+			}
+		case "completedAt":
+			if _, ok := fieldSeen[dataexport.FieldCompletedAt]; !ok {
+				selectedFields = append(selectedFields, dataexport.FieldCompletedAt)
+				fieldSeen[dataexport.FieldCompletedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type dataexportPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []DataExportPaginateOption
+}
+
+func newDataExportPaginateArgs(rv map[string]any) *dataexportPaginateArgs {
+	args := &dataexportPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &DataExportOrder{Field: &DataExportOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithDataExportOrder(order))
+			}
+		case *DataExportOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithDataExportOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*DataExportWhereInput); ok {
+		args.opts = append(args.opts, WithDataExportFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *DataStorageQuery) CollectFields(ctx context.Context, satisfies ...string) (*DataStorageQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *DataStorageQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(datastorage.Columns))
+		selectedFields = []string{datastorage.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "requests":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&RequestClient{config: _q.config}).Query()
+			)
+			args := newRequestPaginateArgs(fieldArgs(ctx, new(RequestWhereInput), path...))
+			if err := validateFirstLast(args.first, args.last); err != nil {
+				return fmt.Errorf("validate first and last in path %q: %w", path, err)
+			}
+			pager, err := newRequestPager(args.opts, args.last != nil)
+			if err != nil {
+				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			}
+			if query, err = pager.applyFilter(query); err != nil {
+				return err
+			}
+			ignoredEdges := !hasCollectedField(ctx, append(path, edgesField)...)
+			if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
+				hasPagination := args.after != nil || args.first != nil || args.before != nil || args.last != nil
+				if hasPagination || ignoredEdges {
+					query := query.Clone()
+					_q.loadTotal = append(_q.loadTotal, func(ctx context.Context, nodes []*DataStorage) error {
+						ids := make([]driver.Value, len(nodes))
+						for i := range nodes {
+							ids[i] = nodes[i].ID
+						}
+						var v []struct {
+							NodeID int `sql:"data_storage_id"`
+							Count  int `sql:"count"`
+						}
+						query.Where(func(s *sql.Selector) {
+							s.Where(sql.InValues(s.C(datastorage.RequestsColumn), ids...))
+						})
+						if err := query.GroupBy(datastorage.RequestsColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
+							return err
+						}
+						m := make(map[int]int, len(v))
+						for i := range v {
+							m[v[i].NodeID] = v[i].Count
+						}
+						for i := range nodes {
+							n := m[nodes[i].ID]
+							if nodes[i].Edges.totalCount[0] == nil {
+								nodes[i].Edges.totalCount[0] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[0][alias] = n
+						}
+						return nil
+					})
+				} else {
+					_q.loadTotal = append(_q.loadTotal, func(_ context.Context, nodes []*DataStorage) error {
+						for i := range nodes {
+							n := len(nodes[i].Edges.Requests)
+							if nodes[i].Edges.totalCount[0] == nil {
+								nodes[i].Edges.totalCount[0] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[0][alias] = n
+						}
 						return nil
 					})
 				}
@@ -1746,7 +2262,7 @@ func newDataStoragePaginateArgs(rv map[string]any) *datastoragePaginateArgs {
 }
 
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
-func (_q *ModelQuery) CollectFields(ctx context.Context, satisfies ...string) (*ModelQuery, error) {
+func (_q *ExchangeRateQuery) CollectFields(ctx context.Context, satisfies ...string) (*ExchangeRateQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
 	if fc == nil {
 		return _q, nil
@@ -1757,74 +2273,54 @@ func (_q *ModelQuery) CollectFields(ctx context.Context, satisfies ...string) (*
 	return _q, nil
 }
 
-func (_q *ModelQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+func (_q *ExchangeRateQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
 	path = append([]string(nil), path...)
 	var (
 		unknownSeen    bool
-		fieldSeen      = make(map[string]struct{}, len(model.Columns))
-		selectedFields = []string{model.FieldID}
+		fieldSeen      = make(map[string]struct{}, len(exchangerate.Columns))
+		selectedFields = []string{exchangerate.FieldID}
 	)
 	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
 		switch field.Name {
 		case "createdAt":
-			if _, ok := fieldSeen[model.FieldCreatedAt]; !ok {
-				selectedFields = append(selectedFields, model.FieldCreatedAt)
-				fieldSeen[model.FieldCreatedAt] = struct{}{}
+			if _, ok := fieldSeen[exchangerate.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, exchangerate.FieldCreatedAt)
+				fieldSeen[exchangerate.FieldCreatedAt] = struct{}{}
 			}
 		case "updatedAt":
-			if _, ok := fieldSeen[model.FieldUpdatedAt]; !ok {
-				selectedFields = append(selectedFields, model.FieldUpdatedAt)
-				fieldSeen[model.FieldUpdatedAt] = struct{}{}
-			}
-		case "developer":
-			if _, ok := fieldSeen[model.FieldDeveloper]; !ok {
-				selectedFields = append(selectedFields, model.FieldDeveloper)
-				fieldSeen[model.FieldDeveloper] = struct{}{}
-			}
-		case "modelID":
-			if _, ok := fieldSeen[model.FieldModelID]; !ok {
-				selectedFields = append(selectedFields, model.FieldModelID)
-				fieldSeen[model.FieldModelID] = struct{}{}
-			}
-		case "type":
-			if _, ok := fieldSeen[model.FieldType]; !ok {
-				selectedFields = append(selectedFields, model.FieldType)
-				fieldSeen[model.FieldType] = struct{}{}
-			}
-		case "name":
-			if _, ok := fieldSeen[model.FieldName]; !ok {
-				selectedFields = append(selectedFields, model.FieldName)
-				fieldSeen[model.FieldName] = struct{}{}
-			}
-		case "icon":
-			if _, ok := fieldSeen[model.FieldIcon]; !ok {
-				selectedFields = append(selectedFields, model.FieldIcon)
-				fieldSeen[model.FieldIcon] = struct{}{}
-			}
-		case "group":
-			if _, ok := fieldSeen[model.FieldGroup]; !ok {
-				selectedFields = append(selectedFields, model.FieldGroup)
-				fieldSeen[model.FieldGroup] = struct{}{}
-			}
-		case "modelCard":
-			if _, ok := fieldSeen[model.FieldModelCard]; !ok {
-				selectedFields = append(selectedFields, model.FieldModelCard)
-				fieldSeen[model.FieldModelCard] = struct{}{}
-			}
-		case "settings":
-			if _, ok := fieldSeen[model.FieldSettings]; !ok {
-				selectedFields = append(selectedFields, model.FieldSettings)
-				fieldSeen[model.FieldSettings] = struct{}{}
+			if _, ok := fieldSeen[exchangerate.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, exchangerate.FieldUpdatedAt)
+				fieldSeen[exchangerate.FieldUpdatedAt] = struct{}{}
+			}
+		case "fromCurrency":
+			if _, ok := fieldSeen[exchangerate.FieldFromCurrency]; !ok {
+				selectedFields = append(selectedFields, exchangerate.FieldFromCurrency)
+				fieldSeen[exchangerate.FieldFromCurrency] = struct{}{}
+			}
+		case "toCurrency":
+			if _, ok := fieldSeen[exchangerate.FieldToCurrency]; !ok {
+				selectedFields = append(selectedFields, exchangerate.FieldToCurrency)
+				fieldSeen[exchangerate.FieldToCurrency] = struct{}{}
+			}
+		case "rate":
+			if _, ok := fieldSeen[exchangerate.FieldRate]; !ok {
+				selectedFields = append(selectedFields, exchangerate.FieldRate)
+				fieldSeen[exchangerate.FieldRate] = struct{}{}
+			}
+		case "effectiveAt":
+			if _, ok := fieldSeen[exchangerate.FieldEffectiveAt]; !ok {
+				selectedFields = append(selectedFields, exchangerate.FieldEffectiveAt)
+				fieldSeen[exchangerate.FieldEffectiveAt] = struct{}{}
 			}
-		case "status":
-			if _, ok := fieldSeen[model.FieldStatus]; !ok {
-				selectedFields = append(selectedFields, model.FieldStatus)
-				fieldSeen[model.FieldStatus] = struct{}{}
+		case "source":
+			if _, ok := fieldSeen[exchangerate.FieldSource]; !ok {
+				selectedFields = append(selectedFields, exchangerate.FieldSource)
+				fieldSeen[exchangerate.FieldSource] = struct{}{}
 			}
-		case "remark":
-			if _, ok := fieldSeen[model.FieldRemark]; !ok {
-				selectedFields = append(selectedFields, model.FieldRemark)
-				fieldSeen[model.FieldRemark] = struct{}{}
+		case "importSource":
+			if _, ok := fieldSeen[exchangerate.FieldImportSource]; !ok {
+				selectedFields = append(selectedFields, exchangerate.FieldImportSource)
+				fieldSeen[exchangerate.FieldImportSource] = struct{}{}
 			}
 		case "id":
 		case "__typename":
@@ -1838,14 +2334,14 @@ func (_q *ModelQuery) collectField(ctx context.Context, oneNode bool, opCtx *gra
 	return nil
 }
 
-type modelPaginateArgs struct {
+type exchangeratePaginateArgs struct {
 	first, last   *int
 	after, before *Cursor
-	opts          []ModelPaginateOption
+	opts          []ExchangeRatePaginateOption
 }
 
-func newModelPaginateArgs(rv map[string]any) *modelPaginateArgs {
-	args := &modelPaginateArgs{}
+func newExchangeRatePaginateArgs(rv map[string]any) *exchangeratePaginateArgs {
+	args := &exchangeratePaginateArgs{}
 	if rv == nil {
 		return args
 	}
@@ -1866,7 +2362,7 @@ func newModelPaginateArgs(rv map[string]any) *modelPaginateArgs {
 		case map[string]any:
 			var (
 				err1, err2 error
-				order      = &ModelOrder{Field: &ModelOrderField{}, Direction: entgql.OrderDirectionAsc}
+				order      = &ExchangeRateOrder{Field: &ExchangeRateOrderField{}, Direction: entgql.OrderDirectionAsc}
 			)
 			if d, ok := v[directionField]; ok {
 				err1 = order.Direction.UnmarshalGQL(d)
@@ -1875,22 +2371,22 @@ func newModelPaginateArgs(rv map[string]any) *modelPaginateArgs {
 				err2 = order.Field.UnmarshalGQL(f)
 			}
 			if err1 == nil && err2 == nil {
-				args.opts = append(args.opts, WithModelOrder(order))
+				args.opts = append(args.opts, WithExchangeRateOrder(order))
 			}
-		case *ModelOrder:
+		case *ExchangeRateOrder:
 			if v != nil {
-				args.opts = append(args.opts, WithModelOrder(v))
+				args.opts = append(args.opts, WithExchangeRateOrder(v))
 			}
 		}
 	}
-	if v, ok := rv[whereField].(*ModelWhereInput); ok {
-		args.opts = append(args.opts, WithModelFilter(v.Filter))
+	if v, ok := rv[whereField].(*ExchangeRateWhereInput); ok {
+		args.opts = append(args.opts, WithExchangeRateFilter(v.Filter))
 	}
 	return args
 }
 
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
-func (_q *OIDCIdentityQuery) CollectFields(ctx context.Context, satisfies ...string) (*OIDCIdentityQuery, error) {
+func (_q *InvoiceQuery) CollectFields(ctx context.Context, satisfies ...string) (*InvoiceQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
 	if fc == nil {
 		return _q, nil
@@ -1901,49 +2397,357 @@ func (_q *OIDCIdentityQuery) CollectFields(ctx context.Context, satisfies ...str
 	return _q, nil
 }
 
-func (_q *OIDCIdentityQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+func (_q *InvoiceQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
 	path = append([]string(nil), path...)
 	var (
 		unknownSeen    bool
-		fieldSeen      = make(map[string]struct{}, len(oidcidentity.Columns))
-		selectedFields = []string{oidcidentity.FieldID}
+		fieldSeen      = make(map[string]struct{}, len(invoice.Columns))
+		selectedFields = []string{invoice.FieldID}
 	)
 	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
 		switch field.Name {
 
-		case "user":
+		case "project":
 			var (
 				alias = field.Alias
 				path  = append(path, alias)
-				query = (&UserClient{config: _q.config}).Query()
+				query = (&ProjectClient{config: _q.config}).Query()
 			)
-			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, projectImplementors)...); err != nil {
 				return err
 			}
-			_q.withUser = query
-			if _, ok := fieldSeen[oidcidentity.FieldUserID]; !ok {
-				selectedFields = append(selectedFields, oidcidentity.FieldUserID)
-				fieldSeen[oidcidentity.FieldUserID] = struct{}{}
+			_q.withProject = query
+			if _, ok := fieldSeen[invoice.FieldProjectID]; !ok {
+				selectedFields = append(selectedFields, invoice.FieldProjectID)
+				fieldSeen[invoice.FieldProjectID] = struct{}{}
 			}
 		case "createdAt":
-			if _, ok := fieldSeen[oidcidentity.FieldCreatedAt]; !ok {
-				selectedFields = append(selectedFields, oidcidentity.FieldCreatedAt)
-				fieldSeen[oidcidentity.FieldCreatedAt] = struct{}{}
+			if _, ok := fieldSeen[invoice.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, invoice.FieldCreatedAt)
+				fieldSeen[invoice.FieldCreatedAt] = struct{}{}
 			}
 		case "updatedAt":
-			if _, ok := fieldSeen[oidcidentity.FieldUpdatedAt]; !ok {
-				selectedFields = append(selectedFields, oidcidentity.FieldUpdatedAt)
-				fieldSeen[oidcidentity.FieldUpdatedAt] = struct{}{}
-			}
-		case "issuer":
-			if _, ok := fieldSeen[oidcidentity.FieldIssuer]; !ok {
-				selectedFields = append(selectedFields, oidcidentity.FieldIssuer)
-				fieldSeen[oidcidentity.FieldIssuer] = struct{}{}
+			if _, ok := fieldSeen[invoice.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, invoice.FieldUpdatedAt)
+				fieldSeen[invoice.FieldUpdatedAt] = struct{}{}
 			}
-		case "subject":
-			if _, ok := fieldSeen[oidcidentity.FieldSubject]; !ok {
-				selectedFields = append(selectedFields, oidcidentity.FieldSubject)
-				fieldSeen[oidcidentity.FieldSubject] = struct{}{}
+		case "projectID":
+			if _, ok := fieldSeen[invoice.FieldProjectID]; !ok {
+				selectedFields = append(selectedFields, invoice.FieldProjectID)
+				fieldSeen[invoice.FieldProjectID] = struct{}{}
+			}
+		case "number":
+			if _, ok := fieldSeen[invoice.FieldNumber]; !ok {
+				selectedFields = append(selectedFields, invoice.FieldNumber)
+				fieldSeen[invoice.FieldNumber] = struct{}{}
+			}
+		case "periodStart":
+			if _, ok := fieldSeen[invoice.FieldPeriodStart]; !ok {
+				selectedFields = append(selectedFields, invoice.FieldPeriodStart)
+				fieldSeen[invoice.FieldPeriodStart] = struct{}{}
+			}
+		case "periodEnd":
+			if _, ok := fieldSeen[invoice.FieldPeriodEnd]; !ok {
+				selectedFields = append(selectedFields, invoice.FieldPeriodEnd)
+				fieldSeen[invoice.FieldPeriodEnd] = struct{}{}
+			}
+		case "currency":
+			if _, ok := fieldSeen[invoice.FieldCurrency]; !ok {
+				selectedFields = append(selectedFields, invoice.FieldCurrency)
+				fieldSeen[invoice.FieldCurrency] = struct{}{}
+			}
+		case "subtotal":
+			if _, ok := fieldSeen[invoice.FieldSubtotal]; !ok {
+				selectedFields = append(selectedFields, invoice.FieldSubtotal)
+				fieldSeen[invoice.FieldSubtotal] = struct{}{}
+			}
+		case "markupAmount":
+			if _, ok := fieldSeen[invoice.FieldMarkupAmount]; !ok {
+				selectedFields = append(selectedFields, invoice.FieldMarkupAmount)
+				fieldSeen[invoice.FieldMarkupAmount] = struct{}{}
+			}
+		case "fee":
+			if _, ok := fieldSeen[invoice.FieldFee]; !ok {
+				selectedFields = append(selectedFields, invoice.FieldFee)
+				fieldSeen[invoice.FieldFee] = struct{}{}
+			}
+		case "total":
+			if _, ok := fieldSeen[invoice.FieldTotal]; !ok {
+				selectedFields = append(selectedFields, invoice.FieldTotal)
+				fieldSeen[invoice.FieldTotal] = struct{}{}
+			}
+		case "lineItems":
+			if _, ok := fieldSeen[invoice.FieldLineItems]; !ok {
+				selectedFields = append(selectedFields, invoice.FieldLineItems)
+				fieldSeen[invoice.FieldLineItems] = struct{}{}
+			}
+		case "billingSettings":
+			if _, ok := fieldSeen[invoice.FieldBillingSettings]; !ok {
+				selectedFields = append(selectedFields, invoice.FieldBillingSettings)
+				fieldSeen[invoice.FieldBillingSettings] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type invoicePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []InvoicePaginateOption
+}
+
+func newInvoicePaginateArgs(rv map[string]any) *invoicePaginateArgs {
+	args := &invoicePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &InvoiceOrder{Field: &InvoiceOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithInvoiceOrder(order))
+			}
+		case *InvoiceOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithInvoiceOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*InvoiceWhereInput); ok {
+		args.opts = append(args.opts, WithInvoiceFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *ModelQuery) CollectFields(ctx context.Context, satisfies ...string) (*ModelQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *ModelQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(model.Columns))
+		selectedFields = []string{model.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "createdAt":
+			if _, ok := fieldSeen[model.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, model.FieldCreatedAt)
+				fieldSeen[model.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[model.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, model.FieldUpdatedAt)
+				fieldSeen[model.FieldUpdatedAt] = struct{}{}
+			}
+		case "developer":
+			if _, ok := fieldSeen[model.FieldDeveloper]; !ok {
+				selectedFields = append(selectedFields, model.FieldDeveloper)
+				fieldSeen[model.FieldDeveloper] = struct{}{}
+			}
+		case "modelID":
+			if _, ok := fieldSeen[model.FieldModelID]; !ok {
+				selectedFields = append(selectedFields, model.FieldModelID)
+				fieldSeen[model.FieldModelID] = struct{}{}
+			}
+		case "type":
+			if _, ok := fieldSeen[model.FieldType]; !ok {
+				selectedFields = append(selectedFields, model.FieldType)
+				fieldSeen[model.FieldType] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[model.FieldName]; !ok {
+				selectedFields = append(selectedFields, model.FieldName)
+				fieldSeen[model.FieldName] = struct{}{}
+			}
+		case "icon":
+			if _, ok := fieldSeen[model.FieldIcon]; !ok {
+				selectedFields = append(selectedFields, model.FieldIcon)
+				fieldSeen[model.FieldIcon] = struct{}{}
+			}
+		case "group":
+			if _, ok := fieldSeen[model.FieldGroup]; !ok {
+				selectedFields = append(selectedFields, model.FieldGroup)
+				fieldSeen[model.FieldGroup] = struct{}{}
+			}
+		case "modelCard":
+			if _, ok := fieldSeen[model.FieldModelCard]; !ok {
+				selectedFields = append(selectedFields, model.FieldModelCard)
+				fieldSeen[model.FieldModelCard] = struct{}{}
+			}
+		case "settings":
+			if _, ok := fieldSeen[model.FieldSettings]; !ok {
+				selectedFields = append(selectedFields, model.FieldSettings)
+				fieldSeen[model.FieldSettings] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[model.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, model.FieldStatus)
+				fieldSeen[model.FieldStatus] = struct{}{}
+			}
+		case "remark":
+			if _, ok := fieldSeen[model.FieldRemark]; !ok {
+				selectedFields = append(selectedFields, model.FieldRemark)
+				fieldSeen[model.FieldRemark] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type modelPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []ModelPaginateOption
+}
+
+func newModelPaginateArgs(rv map[string]any) *modelPaginateArgs {
+	args := &modelPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &ModelOrder{Field: &ModelOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithModelOrder(order))
+			}
+		case *ModelOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithModelOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*ModelWhereInput); ok {
+		args.opts = append(args.opts, WithModelFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *OIDCIdentityQuery) CollectFields(ctx context.Context, satisfies ...string) (*OIDCIdentityQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *OIDCIdentityQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(oidcidentity.Columns))
+		selectedFields = []string{oidcidentity.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "user":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, userImplementors)...); err != nil {
+				return err
+			}
+			_q.withUser = query
+			if _, ok := fieldSeen[oidcidentity.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, oidcidentity.FieldUserID)
+				fieldSeen[oidcidentity.FieldUserID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[oidcidentity.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, oidcidentity.FieldCreatedAt)
+				fieldSeen[oidcidentity.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[oidcidentity.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, oidcidentity.FieldUpdatedAt)
+				fieldSeen[oidcidentity.FieldUpdatedAt] = struct{}{}
+			}
+		case "issuer":
+			if _, ok := fieldSeen[oidcidentity.FieldIssuer]; !ok {
+				selectedFields = append(selectedFields, oidcidentity.FieldIssuer)
+				fieldSeen[oidcidentity.FieldIssuer] = struct{}{}
+			}
+		case "subject":
+			if _, ok := fieldSeen[oidcidentity.FieldSubject]; !ok {
+				selectedFields = append(selectedFields, oidcidentity.FieldSubject)
+				fieldSeen[oidcidentity.FieldSubject] = struct{}{}
 			}
 		case "email":
 			if _, ok := fieldSeen[oidcidentity.FieldEmail]; !ok {
@@ -2855,17 +3659,17 @@ func (_q *ProjectQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				*wq = *query
 			})
 
-		case "projectUsers":
+		case "budgets":
 			var (
 				alias = field.Alias
 				path  = append(path, alias)
-				query = (&UserProjectClient{config: _q.config}).Query()
+				query = (&BudgetClient{config: _q.config}).Query()
 			)
-			args := newUserProjectPaginateArgs(fieldArgs(ctx, new(UserProjectWhereInput), path...))
+			args := newBudgetPaginateArgs(fieldArgs(ctx, new(BudgetWhereInput), path...))
 			if err := validateFirstLast(args.first, args.last); err != nil {
 				return fmt.Errorf("validate first and last in path %q: %w", path, err)
 			}
-			pager, err := newUserProjectPager(args.opts, args.last != nil)
+			pager, err := newBudgetPager(args.opts, args.last != nil)
 			if err != nil {
 				return fmt.Errorf("create new pager in path %q: %w", path, err)
 			}
@@ -2887,9 +3691,9 @@ func (_q *ProjectQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 							Count  int `sql:"count"`
 						}
 						query.Where(func(s *sql.Selector) {
-							s.Where(sql.InValues(s.C(project.ProjectUsersColumn), ids...))
+							s.Where(sql.InValues(s.C(project.BudgetsColumn), ids...))
 						})
-						if err := query.GroupBy(project.ProjectUsersColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
+						if err := query.GroupBy(project.BudgetsColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
 							return err
 						}
 						m := make(map[int]int, len(v))
@@ -2908,7 +3712,7 @@ func (_q *ProjectQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				} else {
 					_q.loadTotal = append(_q.loadTotal, func(_ context.Context, nodes []*Project) error {
 						for i := range nodes {
-							n := len(nodes[i].Edges.ProjectUsers)
+							n := len(nodes[i].Edges.Budgets)
 							if nodes[i].Edges.totalCount[9] == nil {
 								nodes[i].Edges.totalCount[9] = make(map[string]int)
 							}
@@ -2925,6 +3729,273 @@ func (_q *ProjectQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				return err
 			}
 			path = append(path, edgesField, nodeField)
+			if field := collectedField(ctx, path...); field != nil {
+				if err := query.collectField(ctx, false, opCtx, *field, path, mayAddCondition(satisfies, budgetImplementors)...); err != nil {
+					return err
+				}
+			}
+			if limit := paginateLimit(args.first, args.last); limit > 0 {
+				if oneNode {
+					pager.applyOrder(query.Limit(limit))
+				} else {
+					modify := entgql.LimitPerRow(project.BudgetsColumn, limit, pager.orderExpr(query))
+					query.modifiers = append(query.modifiers, modify)
+				}
+			} else {
+				query = pager.applyOrder(query)
+			}
+			_q.WithNamedBudgets(alias, func(wq *BudgetQuery) {
+				*wq = *query
+			})
+
+		case "invoices":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&InvoiceClient{config: _q.config}).Query()
+			)
+			args := newInvoicePaginateArgs(fieldArgs(ctx, new(InvoiceWhereInput), path...))
+			if err := validateFirstLast(args.first, args.last); err != nil {
+				return fmt.Errorf("validate first and last in path %q: %w", path, err)
+			}
+			pager, err := newInvoicePager(args.opts, args.last != nil)
+			if err != nil {
+				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			}
+			if query, err = pager.applyFilter(query); err != nil {
+				return err
+			}
+			ignoredEdges := !hasCollectedField(ctx, append(path, edgesField)...)
+			if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
+				hasPagination := args.after != nil || args.first != nil || args.before != nil || args.last != nil
+				if hasPagination || ignoredEdges {
+					query := query.Clone()
+					_q.loadTotal = append(_q.loadTotal, func(ctx context.Context, nodes []*Project) error {
+						ids := make([]driver.Value, len(nodes))
+						for i := range nodes {
+							ids[i] = nodes[i].ID
+						}
+						var v []struct {
+							NodeID int `sql:"project_id"`
+							Count  int `sql:"count"`
+						}
+						query.Where(func(s *sql.Selector) {
+							s.Where(sql.InValues(s.C(project.InvoicesColumn), ids...))
+						})
+						if err := query.GroupBy(project.InvoicesColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
+							return err
+						}
+						m := make(map[int]int, len(v))
+						for i := range v {
+							m[v[i].NodeID] = v[i].Count
+						}
+						for i := range nodes {
+							n := m[nodes[i].ID]
+							if nodes[i].Edges.totalCount[10] == nil {
+								nodes[i].Edges.totalCount[10] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[10][alias] = n
+						}
+						return nil
+					})
+				} else {
+					_q.loadTotal = append(_q.loadTotal, func(_ context.Context, nodes []*Project) error {
+						for i := range nodes {
+							n := len(nodes[i].Edges.Invoices)
+							if nodes[i].Edges.totalCount[10] == nil {
+								nodes[i].Edges.totalCount[10] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[10][alias] = n
+						}
+						return nil
+					})
+				}
+			}
+			if ignoredEdges || (args.first != nil && *args.first == 0) || (args.last != nil && *args.last == 0) {
+				continue
+			}
+			if query, err = pager.applyCursors(query, args.after, args.before); err != nil {
+				return err
+			}
+			path = append(path, edgesField, nodeField)
+			if field := collectedField(ctx, path...); field != nil {
+				if err := query.collectField(ctx, false, opCtx, *field, path, mayAddCondition(satisfies, invoiceImplementors)...); err != nil {
+					return err
+				}
+			}
+			if limit := paginateLimit(args.first, args.last); limit > 0 {
+				if oneNode {
+					pager.applyOrder(query.Limit(limit))
+				} else {
+					modify := entgql.LimitPerRow(project.InvoicesColumn, limit, pager.orderExpr(query))
+					query.modifiers = append(query.modifiers, modify)
+				}
+			} else {
+				query = pager.applyOrder(query)
+			}
+			_q.WithNamedInvoices(alias, func(wq *InvoiceQuery) {
+				*wq = *query
+			})
+
+		case "dataExports":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&DataExportClient{config: _q.config}).Query()
+			)
+			args := newDataExportPaginateArgs(fieldArgs(ctx, new(DataExportWhereInput), path...))
+			if err := validateFirstLast(args.first, args.last); err != nil {
+				return fmt.Errorf("validate first and last in path %q: %w", path, err)
+			}
+			pager, err := newDataExportPager(args.opts, args.last != nil)
+			if err != nil {
+				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			}
+			if query, err = pager.applyFilter(query); err != nil {
+				return err
+			}
+			ignoredEdges := !hasCollectedField(ctx, append(path, edgesField)...)
+			if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
+				hasPagination := args.after != nil || args.first != nil || args.before != nil || args.last != nil
+				if hasPagination || ignoredEdges {
+					query := query.Clone()
+					_q.loadTotal = append(_q.loadTotal, func(ctx context.Context, nodes []*Project) error {
+						ids := make([]driver.Value, len(nodes))
+						for i := range nodes {
+							ids[i] = nodes[i].ID
+						}
+						var v []struct {
+							NodeID int `sql:"project_id"`
+							Count  int `sql:"count"`
+						}
+						query.Where(func(s *sql.Selector) {
+							s.Where(sql.InValues(s.C(project.DataExportsColumn), ids...))
+						})
+						if err := query.GroupBy(project.DataExportsColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
+							return err
+						}
+						m := make(map[int]int, len(v))
+						for i := range v {
+							m[v[i].NodeID] = v[i].Count
+						}
+						for i := range nodes {
+							n := m[nodes[i].ID]
+							if nodes[i].Edges.totalCount[11] == nil {
+								nodes[i].Edges.totalCount[11] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[11][alias] = n
+						}
+						return nil
+					})
+				} else {
+					_q.loadTotal = append(_q.loadTotal, func(_ context.Context, nodes []*Project) error {
+						for i := range nodes {
+							n := len(nodes[i].Edges.DataExports)
+							if nodes[i].Edges.totalCount[11] == nil {
+								nodes[i].Edges.totalCount[11] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[11][alias] = n
+						}
+						return nil
+					})
+				}
+			}
+			if ignoredEdges || (args.first != nil && *args.first == 0) || (args.last != nil && *args.last == 0) {
+				continue
+			}
+			if query, err = pager.applyCursors(query, args.after, args.before); err != nil {
+				return err
+			}
+			path = append(path, edgesField, nodeField)
+			if field := collectedField(ctx, path...); field != nil {
+				if err := query.collectField(ctx, false, opCtx, *field, path, mayAddCondition(satisfies, dataexportImplementors)...); err != nil {
+					return err
+				}
+			}
+			if limit := paginateLimit(args.first, args.last); limit > 0 {
+				if oneNode {
+					pager.applyOrder(query.Limit(limit))
+				} else {
+					modify := entgql.LimitPerRow(project.DataExportsColumn, limit, pager.orderExpr(query))
+					query.modifiers = append(query.modifiers, modify)
+				}
+			} else {
+				query = pager.applyOrder(query)
+			}
+			_q.WithNamedDataExports(alias, func(wq *DataExportQuery) {
+				*wq = *query
+			})
+
+		case "projectUsers":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&UserProjectClient{config: _q.config}).Query()
+			)
+			args := newUserProjectPaginateArgs(fieldArgs(ctx, new(UserProjectWhereInput), path...))
+			if err := validateFirstLast(args.first, args.last); err != nil {
+				return fmt.Errorf("validate first and last in path %q: %w", path, err)
+			}
+			pager, err := newUserProjectPager(args.opts, args.last != nil)
+			if err != nil {
+				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			}
+			if query, err = pager.applyFilter(query); err != nil {
+				return err
+			}
+			ignoredEdges := !hasCollectedField(ctx, append(path, edgesField)...)
+			if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
+				hasPagination := args.after != nil || args.first != nil || args.before != nil || args.last != nil
+				if hasPagination || ignoredEdges {
+					query := query.Clone()
+					_q.loadTotal = append(_q.loadTotal, func(ctx context.Context, nodes []*Project) error {
+						ids := make([]driver.Value, len(nodes))
+						for i := range nodes {
+							ids[i] = nodes[i].ID
+						}
+						var v []struct {
+							NodeID int `sql:"project_id"`
+							Count  int `sql:"count"`
+						}
+						query.Where(func(s *sql.Selector) {
+							s.Where(sql.InValues(s.C(project.ProjectUsersColumn), ids...))
+						})
+						if err := query.GroupBy(project.ProjectUsersColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
+							return err
+						}
+						m := make(map[int]int, len(v))
+						for i := range v {
+							m[v[i].NodeID] = v[i].Count
+						}
+						for i := range nodes {
+							n := m[nodes[i].ID]
+							if nodes[i].Edges.totalCount[12] == nil {
+								nodes[i].Edges.totalCount[12] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[12][alias] = n
+						}
+						return nil
+					})
+				} else {
+					_q.loadTotal = append(_q.loadTotal, func(_ context.Context, nodes []*Project) error {
+						for i := range nodes {
+							n := len(nodes[i].Edges.ProjectUsers)
+							if nodes[i].Edges.totalCount[12] == nil {
+								nodes[i].Edges.totalCount[12] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[12][alias] = n
+						}
+						return nil
+					})
+				}
+			}
+			if ignoredEdges || (args.first != nil && *args.first == 0) || (args.last != nil && *args.last == 0) {
+				continue
+			}
+			if query, err = pager.applyCursors(query, args.after, args.before); err != nil {
+				return err
+			}
+			path = append(path, edgesField, nodeField)
 			if field := collectedField(ctx, path...); field != nil {
 				if err := query.collectField(ctx, false, opCtx, *field, path, mayAddCondition(satisfies, userprojectImplementors)...); err != nil {
 					return err
@@ -2973,6 +4044,21 @@ func (_q *ProjectQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				selectedFields = append(selectedFields, project.FieldProfiles)
 				fieldSeen[project.FieldProfiles] = struct{}{}
 			}
+		case "moderationSettings":
+			if _, ok := fieldSeen[project.FieldModerationSettings]; !ok {
+				selectedFields = append(selectedFields, project.FieldModerationSettings)
+				fieldSeen[project.FieldModerationSettings] = struct{}{}
+			}
+		case "billingSettings":
+			if _, ok := fieldSeen[project.FieldBillingSettings]; !ok {
+				selectedFields = append(selectedFields, project.FieldBillingSettings)
+				fieldSeen[project.FieldBillingSettings] = struct{}{}
+			}
+		case "dataPolicy":
+			if _, ok := fieldSeen[project.FieldDataPolicy]; !ok {
+				selectedFields = append(selectedFields, project.FieldDataPolicy)
+				fieldSeen[project.FieldDataPolicy] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -3797,6 +4883,11 @@ func (_q *RequestQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				selectedFields = append(selectedFields, request.FieldChannelID)
 				fieldSeen[request.FieldChannelID] = struct{}{}
 			}
+		case "endUserID":
+			if _, ok := fieldSeen[request.FieldEndUserID]; !ok {
+				selectedFields = append(selectedFields, request.FieldEndUserID)
+				fieldSeen[request.FieldEndUserID] = struct{}{}
+			}
 		case "externalID":
 			if _, ok := fieldSeen[request.FieldExternalID]; !ok {
 				selectedFields = append(selectedFields, request.FieldExternalID)
@@ -3852,6 +4943,16 @@ func (_q *RequestQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				selectedFields = append(selectedFields, request.FieldContentSavedAt)
 				fieldSeen[request.FieldContentSavedAt] = struct{}{}
 			}
+		case "moderationVerdicts":
+			if _, ok := fieldSeen[request.FieldModerationVerdicts]; !ok {
+				selectedFields = append(selectedFields, request.FieldModerationVerdicts)
+				fieldSeen[request.FieldModerationVerdicts] = struct{}{}
+			}
+		case "archivedAt":
+			if _, ok := fieldSeen[request.FieldArchivedAt]; !ok {
+				selectedFields = append(selectedFields, request.FieldArchivedAt)
+				fieldSeen[request.FieldArchivedAt] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -5101,6 +6202,11 @@ func (_q *UsageLogQuery) collectField(ctx context.Context, oneNode bool, opCtx *
 				selectedFields = append(selectedFields, usagelog.FieldAPIKeyID)
 				fieldSeen[usagelog.FieldAPIKeyID] = struct{}{}
 			}
+		case "endUserID":
+			if _, ok := fieldSeen[usagelog.FieldEndUserID]; !ok {
+				selectedFields = append(selectedFields, usagelog.FieldEndUserID)
+				fieldSeen[usagelog.FieldEndUserID] = struct{}{}
+			}
 		case "projectID":
 			if _, ok := fieldSeen[usagelog.FieldProjectID]; !ok {
 				selectedFields = append(selectedFields, usagelog.FieldProjectID)
@@ -5201,6 +6307,26 @@ func (_q *UsageLogQuery) collectField(ctx context.Context, oneNode bool, opCtx *
 				selectedFields = append(selectedFields, usagelog.FieldCostPriceReferenceID)
 				fieldSeen[usagelog.FieldCostPriceReferenceID] = struct{}{}
 			}
+		case "currency":
+			if _, ok := fieldSeen[usagelog.FieldCurrency]; !ok {
+				selectedFields = append(selectedFields, usagelog.FieldCurrency)
+				fieldSeen[usagelog.FieldCurrency] = struct{}{}
+			}
+		case "originalTotalCost":
+			if _, ok := fieldSeen[usagelog.FieldOriginalTotalCost]; !ok {
+				selectedFields = append(selectedFields, usagelog.FieldOriginalTotalCost)
+				fieldSeen[usagelog.FieldOriginalTotalCost] = struct{}{}
+			}
+		case "originalCurrency":
+			if _, ok := fieldSeen[usagelog.FieldOriginalCurrency]; !ok {
+				selectedFields = append(selectedFields, usagelog.FieldOriginalCurrency)
+				fieldSeen[usagelog.FieldOriginalCurrency] = struct{}{}
+			}
+		case "exchangeRate":
+			if _, ok := fieldSeen[usagelog.FieldExchangeRate]; !ok {
+				selectedFields = append(selectedFields, usagelog.FieldExchangeRate)
+				fieldSeen[usagelog.FieldExchangeRate] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -6307,6 +7433,542 @@ func newUserRolePaginateArgs(rv map[string]any) *userrolePaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *WalletQuery) CollectFields(ctx context.Context, satisfies ...string) (*WalletQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *WalletQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(wallet.Columns))
+		selectedFields = []string{wallet.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "apiKey":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&APIKeyClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, apikeyImplementors)...); err != nil {
+				return err
+			}
+			_q.withAPIKey = query
+			if _, ok := fieldSeen[wallet.FieldAPIKeyID]; !ok {
+				selectedFields = append(selectedFields, wallet.FieldAPIKeyID)
+				fieldSeen[wallet.FieldAPIKeyID] = struct{}{}
+			}
+
+		case "transactions":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&WalletTransactionClient{config: _q.config}).Query()
+			)
+			args := newWalletTransactionPaginateArgs(fieldArgs(ctx, new(WalletTransactionWhereInput), path...))
+			if err := validateFirstLast(args.first, args.last); err != nil {
+				return fmt.Errorf("validate first and last in path %q: %w", path, err)
+			}
+			pager, err := newWalletTransactionPager(args.opts, args.last != nil)
+			if err != nil {
+				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			}
+			if query, err = pager.applyFilter(query); err != nil {
+				return err
+			}
+			ignoredEdges := !hasCollectedField(ctx, append(path, edgesField)...)
+			if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
+				hasPagination := args.after != nil || args.first != nil || args.before != nil || args.last != nil
+				if hasPagination || ignoredEdges {
+					query := query.Clone()
+					_q.loadTotal = append(_q.loadTotal, func(ctx context.Context, nodes []*Wallet) error {
+						ids := make([]driver.Value, len(nodes))
+						for i := range nodes {
+							ids[i] = nodes[i].ID
+						}
+						var v []struct {
+							NodeID int `sql:"wallet_id"`
+							Count  int `sql:"count"`
+						}
+						query.Where(func(s *sql.Selector) {
+							s.Where(sql.InValues(s.C(wallet.TransactionsColumn), ids...))
+						})
+						if err := query.GroupBy(wallet.TransactionsColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
+							return err
+						}
+						m := make(map[int]int, len(v))
+						for i := range v {
+							m[v[i].NodeID] = v[i].Count
+						}
+						for i := range nodes {
+							n := m[nodes[i].ID]
+							if nodes[i].Edges.totalCount[1] == nil {
+								nodes[i].Edges.totalCount[1] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[1][alias] = n
+						}
+						return nil
+					})
+				} else {
+					_q.loadTotal = append(_q.loadTotal, func(_ context.Context, nodes []*Wallet) error {
+						for i := range nodes {
+							n := len(nodes[i].Edges.Transactions)
+							if nodes[i].Edges.totalCount[1] == nil {
+								nodes[i].Edges.totalCount[1] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[1][alias] = n
+						}
+						return nil
+					})
+				}
+			}
+			if ignoredEdges || (args.first != nil && *args.first == 0) || (args.last != nil && *args.last == 0) {
+				continue
+			}
+			if query, err = pager.applyCursors(query, args.after, args.before); err != nil {
+				return err
+			}
+			path = append(path, edgesField, nodeField)
+			if field := collectedField(ctx, path...); field != nil {
+				if err := query.collectField(ctx, false, opCtx, *field, path, mayAddCondition(satisfies, wallettransactionImplementors)...); err != nil {
+					return err
+				}
+			}
+			if limit := paginateLimit(args.first, args.last); limit > 0 {
+				if oneNode {
+					pager.applyOrder(query.Limit(limit))
+				} else {
+					modify := entgql.LimitPerRow(wallet.TransactionsColumn, limit, pager.orderExpr(query))
+					query.modifiers = append(query.modifiers, modify)
+				}
+			} else {
+				query = pager.applyOrder(query)
+			}
+			_q.WithNamedTransactions(alias, func(wq *WalletTransactionQuery) {
+				*wq = *query
+			})
+		case "createdAt":
+			if _, ok := fieldSeen[wallet.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, wallet.FieldCreatedAt)
+				fieldSeen[wallet.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[wallet.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, wallet.FieldUpdatedAt)
+				fieldSeen[wallet.FieldUpdatedAt] = struct{}{}
+			}
+		case "apiKeyID":
+			if _, ok := fieldSeen[wallet.FieldAPIKeyID]; !ok {
+				selectedFields = append(selectedFields, wallet.FieldAPIKeyID)
+				fieldSeen[wallet.FieldAPIKeyID] = struct{}{}
+			}
+		case "projectID":
+			if _, ok := fieldSeen[wallet.FieldProjectID]; !ok {
+				selectedFields = append(selectedFields, wallet.FieldProjectID)
+				fieldSeen[wallet.FieldProjectID] = struct{}{}
+			}
+		case "balance":
+			if _, ok := fieldSeen[wallet.FieldBalance]; !ok {
+				selectedFields = append(selectedFields, wallet.FieldBalance)
+				fieldSeen[wallet.FieldBalance] = struct{}{}
+			}
+		case "reserved":
+			if _, ok := fieldSeen[wallet.FieldReserved]; !ok {
+				selectedFields = append(selectedFields, wallet.FieldReserved)
+				fieldSeen[wallet.FieldReserved] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[wallet.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, wallet.FieldStatus)
+				fieldSeen[wallet.FieldStatus] = struct{}{}
+			}
+		case "lowBalanceThreshold":
+			if _, ok := fieldSeen[wallet.FieldLowBalanceThreshold]; !ok {
+				selectedFields = append(selectedFields, wallet.FieldLowBalanceThreshold)
+				fieldSeen[wallet.FieldLowBalanceThreshold] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type walletPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []WalletPaginateOption
+}
+
+func newWalletPaginateArgs(rv map[string]any) *walletPaginateArgs {
+	args := &walletPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &WalletOrder{Field: &WalletOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithWalletOrder(order))
+			}
+		case *WalletOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithWalletOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*WalletWhereInput); ok {
+		args.opts = append(args.opts, WithWalletFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *WalletTransactionQuery) CollectFields(ctx context.Context, satisfies ...string) (*WalletTransactionQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *WalletTransactionQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(wallettransaction.Columns))
+		selectedFields = []string{wallettransaction.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "wallet":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&WalletClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, walletImplementors)...); err != nil {
+				return err
+			}
+			_q.withWallet = query
+			if _, ok := fieldSeen[wallettransaction.FieldWalletID]; !ok {
+				selectedFields = append(selectedFields, wallettransaction.FieldWalletID)
+				fieldSeen[wallettransaction.FieldWalletID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[wallettransaction.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, wallettransaction.FieldCreatedAt)
+				fieldSeen[wallettransaction.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[wallettransaction.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, wallettransaction.FieldUpdatedAt)
+				fieldSeen[wallettransaction.FieldUpdatedAt] = struct{}{}
+			}
+		case "walletID":
+			if _, ok := fieldSeen[wallettransaction.FieldWalletID]; !ok {
+				selectedFields = append(selectedFields, wallettransaction.FieldWalletID)
+				fieldSeen[wallettransaction.FieldWalletID] = struct{}{}
+			}
+		case "apiKeyID":
+			if _, ok := fieldSeen[wallettransaction.FieldAPIKeyID]; !ok {
+				selectedFields = append(selectedFields, wallettransaction.FieldAPIKeyID)
+				fieldSeen[wallettransaction.FieldAPIKeyID] = struct{}{}
+			}
+		case "projectID":
+			if _, ok := fieldSeen[wallettransaction.FieldProjectID]; !ok {
+				selectedFields = append(selectedFields, wallettransaction.FieldProjectID)
+				fieldSeen[wallettransaction.FieldProjectID] = struct{}{}
+			}
+		case "type":
+			if _, ok := fieldSeen[wallettransaction.FieldType]; !ok {
+				selectedFields = append(selectedFields, wallettransaction.FieldType)
+				fieldSeen[wallettransaction.FieldType] = struct{}{}
+			}
+		case "amount":
+			if _, ok := fieldSeen[wallettransaction.FieldAmount]; !ok {
+				selectedFields = append(selectedFields, wallettransaction.FieldAmount)
+				fieldSeen[wallettransaction.FieldAmount] = struct{}{}
+			}
+		case "balanceAfter":
+			if _, ok := fieldSeen[wallettransaction.FieldBalanceAfter]; !ok {
+				selectedFields = append(selectedFields, wallettransaction.FieldBalanceAfter)
+				fieldSeen[wallettransaction.FieldBalanceAfter] = struct{}{}
+			}
+		case "requestID":
+			if _, ok := fieldSeen[wallettransaction.FieldRequestID]; !ok {
+				selectedFields = append(selectedFields, wallettransaction.FieldRequestID)
+				fieldSeen[wallettransaction.FieldRequestID] = struct{}{}
+			}
+		case "usageLogID":
+			if _, ok := fieldSeen[wallettransaction.FieldUsageLogID]; !ok {
+				selectedFields = append(selectedFields, wallettransaction.FieldUsageLogID)
+				fieldSeen[wallettransaction.FieldUsageLogID] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[wallettransaction.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, wallettransaction.FieldUserID)
+				fieldSeen[wallettransaction.FieldUserID] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[wallettransaction.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, wallettransaction.FieldDescription)
+				fieldSeen[wallettransaction.FieldDescription] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type wallettransactionPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []WalletTransactionPaginateOption
+}
+
+func newWalletTransactionPaginateArgs(rv map[string]any) *wallettransactionPaginateArgs {
+	args := &wallettransactionPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &WalletTransactionOrder{Field: &WalletTransactionOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithWalletTransactionOrder(order))
+			}
+		case *WalletTransactionOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithWalletTransactionOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*WalletTransactionWhereInput); ok {
+		args.opts = append(args.opts, WithWalletTransactionFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *WebhookDeliveryQuery) CollectFields(ctx context.Context, satisfies ...string) (*WebhookDeliveryQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *WebhookDeliveryQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(webhookdelivery.Columns))
+		selectedFields = []string{webhookdelivery.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "createdAt":
+			if _, ok := fieldSeen[webhookdelivery.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldCreatedAt)
+				fieldSeen[webhookdelivery.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[webhookdelivery.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldUpdatedAt)
+				fieldSeen[webhookdelivery.FieldUpdatedAt] = struct{}{}
+			}
+		case "eventID":
+			if _, ok := fieldSeen[webhookdelivery.FieldEventID]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldEventID)
+				fieldSeen[webhookdelivery.FieldEventID] = struct{}{}
+			}
+		case "event":
+			if _, ok := fieldSeen[webhookdelivery.FieldEvent]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldEvent)
+				fieldSeen[webhookdelivery.FieldEvent] = struct{}{}
+			}
+		case "targetName":
+			if _, ok := fieldSeen[webhookdelivery.FieldTargetName]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldTargetName)
+				fieldSeen[webhookdelivery.FieldTargetName] = struct{}{}
+			}
+		case "url":
+			if _, ok := fieldSeen[webhookdelivery.FieldURL]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldURL)
+				fieldSeen[webhookdelivery.FieldURL] = struct{}{}
+			}
+		case "payload":
+			if _, ok := fieldSeen[webhookdelivery.FieldPayload]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldPayload)
+				fieldSeen[webhookdelivery.FieldPayload] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[webhookdelivery.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldStatus)
+				fieldSeen[webhookdelivery.FieldStatus] = struct{}{}
+			}
+		case "attempts":
+			if _, ok := fieldSeen[webhookdelivery.FieldAttempts]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldAttempts)
+				fieldSeen[webhookdelivery.FieldAttempts] = struct{}{}
+			}
+		case "nextAttemptAt":
+			if _, ok := fieldSeen[webhookdelivery.FieldNextAttemptAt]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldNextAttemptAt)
+				fieldSeen[webhookdelivery.FieldNextAttemptAt] = struct{}{}
+			}
+		case "lastError":
+			if _, ok := fieldSeen[webhookdelivery.FieldLastError]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldLastError)
+				fieldSeen[webhookdelivery.FieldLastError] = struct{}{}
+			}
+		case "responseStatus":
+			if _, ok := fieldSeen[webhookdelivery.FieldResponseStatus]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldResponseStatus)
+				fieldSeen[webhookdelivery.FieldResponseStatus] = struct{}{}
+			}
+		case "deliveredAt":
+			if _, ok := fieldSeen[webhookdelivery.FieldDeliveredAt]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldDeliveredAt)
+				fieldSeen[webhookdelivery.FieldDeliveredAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type webhookdeliveryPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []WebhookDeliveryPaginateOption
+}
+
+func newWebhookDeliveryPaginateArgs(rv map[string]any) *webhookdeliveryPaginateArgs {
+	args := &webhookdeliveryPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &WebhookDeliveryOrder{Field: &WebhookDeliveryOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithWebhookDeliveryOrder(order))
+			}
+		case *WebhookDeliveryOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithWebhookDeliveryOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*WebhookDeliveryWhereInput); ok {
+		args.opts = append(args.opts, WithWebhookDeliveryFilter(v.Filter))
+	}
+	return args
+}
+
 const (
 	afterField     = "after"
 	firstField     = "first"
@@ -45,6 +45,14 @@ func (_m *APIKey) Requests(
 	return _m.QueryRequests().Paginate(ctx, after, first, before, last, opts...)
 }
 
+func (_m *APIKey) Wallet(ctx context.Context) (*Wallet, error) {
+	result, err := _m.Edges.WalletOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryWallet().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
 func (_m *APIKeyProfileTemplate) Project(ctx context.Context) (*Project, error) {
 	result, err := _m.Edges.ProjectOrErr()
 	if IsNotLoaded(err) {
@@ -53,6 +61,14 @@ func (_m *APIKeyProfileTemplate) Project(ctx context.Context) (*Project, error)
 	return result, err
 }
 
+func (_m *Budget) Project(ctx context.Context) (*Project, error) {
+	result, err := _m.Edges.ProjectOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryProject().Only(ctx)
+	}
+	return result, err
+}
+
 func (_m *Channel) Requests(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *RequestOrder, where *RequestWhereInput,
 ) (*RequestConnection, error) {
@@ -192,6 +208,14 @@ func (_m *ChannelProbe) Channel(ctx context.Context) (*Channel, error) {
 	return result, err
 }
 
+func (_m *DataExport) Project(ctx context.Context) (*Project, error) {
+	result, err := _m.Edges.ProjectOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryProject().Only(ctx)
+	}
+	return result, err
+}
+
 func (_m *DataStorage) Requests(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *RequestOrder, where *RequestWhereInput,
 ) (*RequestConnection, error) {
@@ -234,6 +258,14 @@ func (_m *DataStorage) Executions(
 	return _m.QueryExecutions().Paginate(ctx, after, first, before, last, opts...)
 }
 
+func (_m *Invoice) Project(ctx context.Context) (*Project, error) {
+	result, err := _m.Edges.ProjectOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryProject().Only(ctx)
+	}
+	return result, err
+}
+
 func (_m *OIDCIdentity) User(ctx context.Context) (*User, error) {
 	result, err := _m.Edges.UserOrErr()
 	if IsNotLoaded(err) {
@@ -431,6 +463,69 @@ func (_m *Project) APIKeyProfileTemplates(
 	return _m.QueryAPIKeyProfileTemplates().Paginate(ctx, after, first, before, last, opts...)
 }
 
+func (_m *Project) Budgets(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *BudgetOrder, where *BudgetWhereInput,
+) (*BudgetConnection, error) {
+	opts := []BudgetPaginateOption{
+		WithBudgetOrder(orderBy),
+		WithBudgetFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[9][alias]
+	if nodes, err := _m.NamedBudgets(alias); err == nil || hasTotalCount {
+		pager, err := newBudgetPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &BudgetConnection{Edges: []*BudgetEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryBudgets().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (_m *Project) Invoices(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *InvoiceOrder, where *InvoiceWhereInput,
+) (*InvoiceConnection, error) {
+	opts := []InvoicePaginateOption{
+		WithInvoiceOrder(orderBy),
+		WithInvoiceFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[10][alias]
+	if nodes, err := _m.NamedInvoices(alias); err == nil || hasTotalCount {
+		pager, err := newInvoicePager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &InvoiceConnection{Edges: []*InvoiceEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryInvoices().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (_m *Project) DataExports(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *DataExportOrder, where *DataExportWhereInput,
+) (*DataExportConnection, error) {
+	opts := []DataExportPaginateOption{
+		WithDataExportOrder(orderBy),
+		WithDataExportFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[11][alias]
+	if nodes, err := _m.NamedDataExports(alias); err == nil || hasTotalCount {
+		pager, err := newDataExportPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &DataExportConnection{Edges: []*DataExportEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryDataExports().Paginate(ctx, after, first, before, last, opts...)
+}
+
 func (_m *Project) ProjectUsers(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *UserProjectOrder, where *UserProjectWhereInput,
 ) (*UserProjectConnection, error) {
@@ -439,7 +534,7 @@ func (_m *Project) ProjectUsers(
 		WithUserProjectFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := _m.Edges.totalCount[9][alias]
+	totalCount, hasTotalCount := _m.Edges.totalCount[12][alias]
 	if nodes, err := _m.NamedProjectUsers(alias); err == nil || hasTotalCount {
 		pager, err := newUserProjectPager(opts, last != nil)
 		if err != nil {
@@ -892,3 +987,40 @@ func (_m *UserRole) Role(ctx context.Context) (*Role, error) {
 	}
 	return result, err
 }
+
+func (_m *Wallet) APIKey(ctx context.Context) (*APIKey, error) {
+	result, err := _m.Edges.APIKeyOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryAPIKey().Only(ctx)
+	}
+	return result, err
+}
+
+func (_m *Wallet) Transactions(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *WalletTransactionOrder, where *WalletTransactionWhereInput,
+) (*WalletTransactionConnection, error) {
+	opts := []WalletTransactionPaginateOption{
+		WithWalletTransactionOrder(orderBy),
+		WithWalletTransactionFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[1][alias]
+	if nodes, err := _m.NamedTransactions(alias); err == nil || hasTotalCount {
+		pager, err := newWalletTransactionPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &WalletTransactionConnection{Edges: []*WalletTransactionEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryTransactions().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (_m *WalletTransaction) Wallet(ctx context.Context) (*Wallet, error) {
+	result, err := _m.Edges.WalletOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryWallet().Only(ctx)
+	}
+	return result, err
+}
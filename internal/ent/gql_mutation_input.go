@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/budget"
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/datastorage"
 	"github.com/looplj/axonhub/internal/ent/model"
@@ -18,6 +19,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent/trace"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/wallet"
 	"github.com/looplj/axonhub/internal/objects"
 )
 
@@ -149,6 +151,116 @@ func (c *APIKeyProfileTemplateUpdateOne) SetInput(i UpdateAPIKeyProfileTemplateI
 	return c
 }
 
+// CreateBudgetInput represents a mutation input for creating budgets.
+type CreateBudgetInput struct {
+	Name        string
+	Description *string
+	Scope       *budget.Scope
+	APIKeyID    *int
+	UserID      *int
+	Amount      float64
+	Period      *budget.Period
+	Status      *budget.Status
+	Settings    *objects.BudgetSettings
+}
+
+// Mutate applies the CreateBudgetInput on the BudgetMutation builder.
+func (i *CreateBudgetInput) Mutate(m *BudgetMutation) {
+	m.SetName(i.Name)
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.Scope; v != nil {
+		m.SetScope(*v)
+	}
+	if v := i.APIKeyID; v != nil {
+		m.SetAPIKeyID(*v)
+	}
+	if v := i.UserID; v != nil {
+		m.SetUserID(*v)
+	}
+	m.SetAmount(i.Amount)
+	if v := i.Period; v != nil {
+		m.SetPeriod(*v)
+	}
+	if v := i.Status; v != nil {
+		m.SetStatus(*v)
+	}
+	if v := i.Settings; v != nil {
+		m.SetSettings(*v)
+	}
+}
+
+// SetInput applies the change-set in the CreateBudgetInput on the BudgetCreate builder.
+func (c *BudgetCreate) SetInput(i CreateBudgetInput) *BudgetCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// UpdateBudgetInput represents a mutation input for updating budgets.
+type UpdateBudgetInput struct {
+	Name          *string
+	Description   *string
+	Scope         *budget.Scope
+	ClearAPIKeyID bool
+	APIKeyID      *int
+	ClearUserID   bool
+	UserID        *int
+	Amount        *float64
+	Period        *budget.Period
+	Status        *budget.Status
+	Settings      *objects.BudgetSettings
+}
+
+// Mutate applies the UpdateBudgetInput on the BudgetMutation builder.
+func (i *UpdateBudgetInput) Mutate(m *BudgetMutation) {
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.Scope; v != nil {
+		m.SetScope(*v)
+	}
+	if i.ClearAPIKeyID {
+		m.ClearAPIKeyID()
+	}
+	if v := i.APIKeyID; v != nil {
+		m.SetAPIKeyID(*v)
+	}
+	if i.ClearUserID {
+		m.ClearUserID()
+	}
+	if v := i.UserID; v != nil {
+		m.SetUserID(*v)
+	}
+	if v := i.Amount; v != nil {
+		m.SetAmount(*v)
+	}
+	if v := i.Period; v != nil {
+		m.SetPeriod(*v)
+	}
+	if v := i.Status; v != nil {
+		m.SetStatus(*v)
+	}
+	if v := i.Settings; v != nil {
+		m.SetSettings(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateBudgetInput on the BudgetUpdate builder.
+func (c *BudgetUpdate) SetInput(i UpdateBudgetInput) *BudgetUpdate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// SetInput applies the change-set in the UpdateBudgetInput on the BudgetUpdateOne builder.
+func (c *BudgetUpdateOne) SetInput(i UpdateBudgetInput) *BudgetUpdateOne {
+	i.Mutate(c.Mutation())
+	return c
+}
+
 // CreateChannelInput represents a mutation input for creating channels.
 type CreateChannelInput struct {
 	Type                    channel.Type
@@ -503,6 +615,30 @@ func (c *DataStorageUpdateOne) SetInput(i UpdateDataStorageInput) *DataStorageUp
 	return c
 }
 
+// CreateExchangeRateInput represents a mutation input for creating exchangerates.
+type CreateExchangeRateInput struct {
+	FromCurrency string
+	ToCurrency   string
+	Rate         float64
+	EffectiveAt  *time.Time
+}
+
+// Mutate applies the CreateExchangeRateInput on the ExchangeRateMutation builder.
+func (i *CreateExchangeRateInput) Mutate(m *ExchangeRateMutation) {
+	m.SetFromCurrency(i.FromCurrency)
+	m.SetToCurrency(i.ToCurrency)
+	m.SetRate(i.Rate)
+	if v := i.EffectiveAt; v != nil {
+		m.SetEffectiveAt(*v)
+	}
+}
+
+// SetInput applies the change-set in the CreateExchangeRateInput on the ExchangeRateCreate builder.
+func (c *ExchangeRateCreate) SetInput(i CreateExchangeRateInput) *ExchangeRateCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
 // CreateModelInput represents a mutation input for creating models.
 type CreateModelInput struct {
 	Developer string
@@ -919,6 +1055,7 @@ type CreateRequestInput struct {
 	RequestBody                objects.JSONRawMessage
 	ResponseBody               objects.JSONRawMessage
 	ResponseChunks             []objects.JSONRawMessage
+	EndUserID                  *string
 	ExternalID                 *string
 	Status                     request.Status
 	Stream                     *bool
@@ -961,6 +1098,9 @@ func (i *CreateRequestInput) Mutate(m *RequestMutation) {
 	if v := i.ResponseChunks; v != nil {
 		m.SetResponseChunks(v)
 	}
+	if v := i.EndUserID; v != nil {
+		m.SetEndUserID(*v)
+	}
 	if v := i.ExternalID; v != nil {
 		m.SetExternalID(*v)
 	}
@@ -1382,6 +1522,7 @@ func (c *TraceUpdateOne) SetInput(i UpdateTraceInput) *TraceUpdateOne {
 // CreateUsageLogInput represents a mutation input for creating usagelogs.
 type CreateUsageLogInput struct {
 	APIKeyID                           *int
+	EndUserID                          *string
 	ModelID                            string
 	PromptTokens                       *int64
 	CompletionTokens                   *int64
@@ -1400,6 +1541,10 @@ type CreateUsageLogInput struct {
 	TotalCost                          *float64
 	CostItems                          []objects.CostItem
 	CostPriceReferenceID               *string
+	Currency                           *string
+	OriginalTotalCost                  *float64
+	OriginalCurrency                   *string
+	ExchangeRate                       *float64
 	RequestID                          int
 	ProjectID                          int
 	ChannelID                          *int
@@ -1410,6 +1555,9 @@ func (i *CreateUsageLogInput) Mutate(m *UsageLogMutation) {
 	if v := i.APIKeyID; v != nil {
 		m.SetAPIKeyID(*v)
 	}
+	if v := i.EndUserID; v != nil {
+		m.SetEndUserID(*v)
+	}
 	m.SetModelID(i.ModelID)
 	if v := i.PromptTokens; v != nil {
 		m.SetPromptTokens(*v)
@@ -1462,6 +1610,18 @@ func (i *CreateUsageLogInput) Mutate(m *UsageLogMutation) {
 	if v := i.CostPriceReferenceID; v != nil {
 		m.SetCostPriceReferenceID(*v)
 	}
+	if v := i.Currency; v != nil {
+		m.SetCurrency(*v)
+	}
+	if v := i.OriginalTotalCost; v != nil {
+		m.SetOriginalTotalCost(*v)
+	}
+	if v := i.OriginalCurrency; v != nil {
+		m.SetOriginalCurrency(*v)
+	}
+	if v := i.ExchangeRate; v != nil {
+		m.SetExchangeRate(*v)
+	}
 	m.SetRequestID(i.RequestID)
 	m.SetProjectID(i.ProjectID)
 	if v := i.ChannelID; v != nil {
@@ -1505,6 +1665,14 @@ type UpdateUsageLogInput struct {
 	AppendCostItems                         []objects.CostItem
 	ClearCostPriceReferenceID               bool
 	CostPriceReferenceID                    *string
+	ClearCurrency                           bool
+	Currency                                *string
+	ClearOriginalTotalCost                  bool
+	OriginalTotalCost                       *float64
+	ClearOriginalCurrency                   bool
+	OriginalCurrency                        *string
+	ClearExchangeRate                       bool
+	ExchangeRate                            *float64
 }
 
 // Mutate applies the UpdateUsageLogInput on the UsageLogMutation builder.
@@ -1593,6 +1761,30 @@ func (i *UpdateUsageLogInput) Mutate(m *UsageLogMutation) {
 	if v := i.CostPriceReferenceID; v != nil {
 		m.SetCostPriceReferenceID(*v)
 	}
+	if i.ClearCurrency {
+		m.ClearCurrency()
+	}
+	if v := i.Currency; v != nil {
+		m.SetCurrency(*v)
+	}
+	if i.ClearOriginalTotalCost {
+		m.ClearOriginalTotalCost()
+	}
+	if v := i.OriginalTotalCost; v != nil {
+		m.SetOriginalTotalCost(*v)
+	}
+	if i.ClearOriginalCurrency {
+		m.ClearOriginalCurrency()
+	}
+	if v := i.OriginalCurrency; v != nil {
+		m.SetOriginalCurrency(*v)
+	}
+	if i.ClearExchangeRate {
+		m.ClearExchangeRate()
+	}
+	if v := i.ExchangeRate; v != nil {
+		m.SetExchangeRate(*v)
+	}
 }
 
 // SetInput applies the change-set in the UpdateUsageLogInput on the UsageLogUpdate builder.
@@ -1752,3 +1944,35 @@ func (c *UserUpdateOne) SetInput(i UpdateUserInput) *UserUpdateOne {
 	i.Mutate(c.Mutation())
 	return c
 }
+
+// UpdateWalletInput represents a mutation input for updating wallets.
+type UpdateWalletInput struct {
+	Status                   *wallet.Status
+	ClearLowBalanceThreshold bool
+	LowBalanceThreshold      *float64
+}
+
+// Mutate applies the UpdateWalletInput on the WalletMutation builder.
+func (i *UpdateWalletInput) Mutate(m *WalletMutation) {
+	if v := i.Status; v != nil {
+		m.SetStatus(*v)
+	}
+	if i.ClearLowBalanceThreshold {
+		m.ClearLowBalanceThreshold()
+	}
+	if v := i.LowBalanceThreshold; v != nil {
+		m.SetLowBalanceThreshold(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateWalletInput on the WalletUpdate builder.
+func (c *WalletUpdate) SetInput(i UpdateWalletInput) *WalletUpdate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// SetInput applies the change-set in the UpdateWalletInput on the WalletUpdateOne builder.
+func (c *WalletUpdateOne) SetInput(i UpdateWalletInput) *WalletUpdateOne {
+	i.Mutate(c.Mutation())
+	return c
+}
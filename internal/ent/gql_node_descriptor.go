@@ -8,12 +8,15 @@ import (
 
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/apikeyprofiletemplate"
+	"github.com/looplj/axonhub/internal/ent/budget"
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/channelmodelprice"
 	"github.com/looplj/axonhub/internal/ent/channelmodelpriceversion"
 	"github.com/looplj/axonhub/internal/ent/channeloverridetemplate"
 	"github.com/looplj/axonhub/internal/ent/channelprobe"
+	"github.com/looplj/axonhub/internal/ent/dataexport"
 	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/invoice"
 	"github.com/looplj/axonhub/internal/ent/oidcidentity"
 	"github.com/looplj/axonhub/internal/ent/project"
 	"github.com/looplj/axonhub/internal/ent/prompt"
@@ -27,6 +30,8 @@ import (
 	"github.com/looplj/axonhub/internal/ent/user"
 	"github.com/looplj/axonhub/internal/ent/userproject"
 	"github.com/looplj/axonhub/internal/ent/userrole"
+	"github.com/looplj/axonhub/internal/ent/wallet"
+	"github.com/looplj/axonhub/internal/ent/wallettransaction"
 )
 
 // Node in the graph.
@@ -57,7 +62,7 @@ func (_m *APIKey) Node(ctx context.Context) (node *Node, err error) {
 		ID:     _m.ID,
 		Type:   "APIKey",
 		Fields: make([]*Field, 11),
-		Edges:  make([]*Edge, 3),
+		Edges:  make([]*Edge, 4),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
@@ -178,6 +183,16 @@ func (_m *APIKey) Node(ctx context.Context) (node *Node, err error) {
 	if err != nil {
 		return nil, err
 	}
+	node.Edges[3] = &Edge{
+		Type: "Wallet",
+		Name: "wallet",
+	}
+	err = _m.QueryWallet().
+		Select(wallet.FieldID).
+		Scan(ctx, &node.Edges[3].IDs)
+	if err != nil {
+		return nil, err
+	}
 	return node, nil
 }
 
@@ -251,6 +266,256 @@ func (_m *APIKeyProfileTemplate) Node(ctx context.Context) (node *Node, err erro
 	return node, nil
 }
 
+// Node implements Noder interface
+func (_m *AuditLog) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     _m.ID,
+		Type:   "AuditLog",
+		Fields: make([]*Field, 13),
+		Edges:  make([]*Edge, 0),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ActorType); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "auditlog.ActorType",
+		Name:  "actor_type",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.UserID); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "int",
+		Name:  "user_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.APIKeyID); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "int",
+		Name:  "api_key_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Actor); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "string",
+		Name:  "actor",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ProjectID); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "int",
+		Name:  "project_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Source); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "auditlog.Source",
+		Name:  "source",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Operation); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "string",
+		Name:  "operation",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Action); err != nil {
+		return nil, err
+	}
+	node.Fields[8] = &Field{
+		Type:  "auditlog.Action",
+		Name:  "action",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.EntityType); err != nil {
+		return nil, err
+	}
+	node.Fields[9] = &Field{
+		Type:  "string",
+		Name:  "entity_type",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.EntityID); err != nil {
+		return nil, err
+	}
+	node.Fields[10] = &Field{
+		Type:  "int",
+		Name:  "entity_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Changes); err != nil {
+		return nil, err
+	}
+	node.Fields[11] = &Field{
+		Type:  "[]objects.AuditLogChange",
+		Name:  "changes",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.SourceIP); err != nil {
+		return nil, err
+	}
+	node.Fields[12] = &Field{
+		Type:  "string",
+		Name:  "source_ip",
+		Value: string(buf),
+	}
+	return node, nil
+}
+
+// Node implements Noder interface
+func (_m *Budget) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     _m.ID,
+		Type:   "Budget",
+		Fields: make([]*Field, 14),
+		Edges:  make([]*Edge, 1),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ProjectID); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "int",
+		Name:  "project_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Name); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "name",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Description); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "string",
+		Name:  "description",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Scope); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "budget.Scope",
+		Name:  "scope",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.APIKeyID); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "int",
+		Name:  "api_key_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.UserID); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "int",
+		Name:  "user_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Amount); err != nil {
+		return nil, err
+	}
+	node.Fields[8] = &Field{
+		Type:  "float64",
+		Name:  "amount",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Period); err != nil {
+		return nil, err
+	}
+	node.Fields[9] = &Field{
+		Type:  "budget.Period",
+		Name:  "period",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Status); err != nil {
+		return nil, err
+	}
+	node.Fields[10] = &Field{
+		Type:  "budget.Status",
+		Name:  "status",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Settings); err != nil {
+		return nil, err
+	}
+	node.Fields[11] = &Field{
+		Type:  "objects.BudgetSettings",
+		Name:  "settings",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.AlertedThreshold); err != nil {
+		return nil, err
+	}
+	node.Fields[12] = &Field{
+		Type:  "int",
+		Name:  "alerted_threshold",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.AlertedPeriodStart); err != nil {
+		return nil, err
+	}
+	node.Fields[13] = &Field{
+		Type:  "time.Time",
+		Name:  "alerted_period_start",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "Project",
+		Name: "project",
+	}
+	err = _m.QueryProject().
+		Select(project.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
 // Node implements Noder interface
 func (_m *Channel) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
@@ -830,12 +1095,12 @@ func (_m *ChannelProbe) Node(ctx context.Context) (node *Node, err error) {
 }
 
 // Node implements Noder interface
-func (_m *DataStorage) Node(ctx context.Context) (node *Node, err error) {
+func (_m *DataExport) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     _m.ID,
-		Type:   "DataStorage",
-		Fields: make([]*Field, 8),
-		Edges:  make([]*Edge, 2),
+		Type:   "DataExport",
+		Fields: make([]*Field, 16),
+		Edges:  make([]*Edge, 1),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
@@ -854,71 +1119,125 @@ func (_m *DataStorage) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "updated_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Name); err != nil {
+	if buf, err = json.Marshal(_m.ProjectID); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
-		Type:  "string",
-		Name:  "name",
+		Type:  "int",
+		Name:  "project_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Description); err != nil {
+	if buf, err = json.Marshal(_m.UserID); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
-		Type:  "string",
-		Name:  "description",
+		Type:  "int",
+		Name:  "user_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Primary); err != nil {
+	if buf, err = json.Marshal(_m.Resource); err != nil {
 		return nil, err
 	}
 	node.Fields[4] = &Field{
-		Type:  "bool",
-		Name:  "primary",
+		Type:  "dataexport.Resource",
+		Name:  "resource",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Type); err != nil {
+	if buf, err = json.Marshal(_m.Format); err != nil {
 		return nil, err
 	}
 	node.Fields[5] = &Field{
-		Type:  "datastorage.Type",
-		Name:  "type",
+		Type:  "dataexport.Format",
+		Name:  "format",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Settings); err != nil {
+	if buf, err = json.Marshal(_m.IncludeBodies); err != nil {
 		return nil, err
 	}
 	node.Fields[6] = &Field{
-		Type:  "*objects.DataStorageSettings",
-		Name:  "settings",
+		Type:  "bool",
+		Name:  "include_bodies",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Status); err != nil {
+	if buf, err = json.Marshal(_m.Criteria); err != nil {
 		return nil, err
 	}
 	node.Fields[7] = &Field{
-		Type:  "datastorage.Status",
-		Name:  "status",
+		Type:  "objects.DataExportCriteria",
+		Name:  "criteria",
 		Value: string(buf),
 	}
-	node.Edges[0] = &Edge{
-		Type: "Request",
-		Name: "requests",
+	if buf, err = json.Marshal(_m.DataStorageID); err != nil {
+		return nil, err
 	}
-	err = _m.QueryRequests().
-		Select(request.FieldID).
-		Scan(ctx, &node.Edges[0].IDs)
-	if err != nil {
+	node.Fields[8] = &Field{
+		Type:  "int",
+		Name:  "data_storage_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Status); err != nil {
 		return nil, err
 	}
-	node.Edges[1] = &Edge{
-		Type: "RequestExecution",
-		Name: "executions",
+	node.Fields[9] = &Field{
+		Type:  "dataexport.Status",
+		Name:  "status",
+		Value: string(buf),
 	}
-	err = _m.QueryExecutions().
-		Select(requestexecution.FieldID).
-		Scan(ctx, &node.Edges[1].IDs)
+	if buf, err = json.Marshal(_m.StorageKey); err != nil {
+		return nil, err
+	}
+	node.Fields[10] = &Field{
+		Type:  "string",
+		Name:  "storage_key",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.RowCount); err != nil {
+		return nil, err
+	}
+	node.Fields[11] = &Field{
+		Type:  "int64",
+		Name:  "row_count",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Size); err != nil {
+		return nil, err
+	}
+	node.Fields[12] = &Field{
+		Type:  "int64",
+		Name:  "size",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ErrorMessage); err != nil {
+		return nil, err
+	}
+	node.Fields[13] = &Field{
+		Type:  "string",
+		Name:  "error_message",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.StartedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[14] = &Field{
+		Type:  "time.Time",
+		Name:  "started_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.CompletedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[15] = &Field{
+		Type:  "time.Time",
+		Name:  "completed_at",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "Project",
+		Name: "project",
+	}
+	err = _m.QueryProject().
+		Select(project.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
 		return nil, err
 	}
@@ -926,12 +1245,12 @@ func (_m *DataStorage) Node(ctx context.Context) (node *Node, err error) {
 }
 
 // Node implements Noder interface
-func (_m *Model) Node(ctx context.Context) (node *Node, err error) {
+func (_m *DataStorage) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     _m.ID,
-		Type:   "Model",
-		Fields: make([]*Field, 12),
-		Edges:  make([]*Edge, 0),
+		Type:   "DataStorage",
+		Fields: make([]*Field, 8),
+		Edges:  make([]*Edge, 2),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
@@ -950,96 +1269,84 @@ func (_m *Model) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "updated_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Developer); err != nil {
+	if buf, err = json.Marshal(_m.Name); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
 		Type:  "string",
-		Name:  "developer",
+		Name:  "name",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ModelID); err != nil {
+	if buf, err = json.Marshal(_m.Description); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
 		Type:  "string",
-		Name:  "model_id",
+		Name:  "description",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Type); err != nil {
+	if buf, err = json.Marshal(_m.Primary); err != nil {
 		return nil, err
 	}
 	node.Fields[4] = &Field{
-		Type:  "model.Type",
-		Name:  "type",
+		Type:  "bool",
+		Name:  "primary",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Name); err != nil {
+	if buf, err = json.Marshal(_m.Type); err != nil {
 		return nil, err
 	}
 	node.Fields[5] = &Field{
-		Type:  "string",
-		Name:  "name",
+		Type:  "datastorage.Type",
+		Name:  "type",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Icon); err != nil {
+	if buf, err = json.Marshal(_m.Settings); err != nil {
 		return nil, err
 	}
 	node.Fields[6] = &Field{
-		Type:  "string",
-		Name:  "icon",
+		Type:  "*objects.DataStorageSettings",
+		Name:  "settings",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Group); err != nil {
+	if buf, err = json.Marshal(_m.Status); err != nil {
 		return nil, err
 	}
 	node.Fields[7] = &Field{
-		Type:  "string",
-		Name:  "group",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(_m.ModelCard); err != nil {
-		return nil, err
-	}
-	node.Fields[8] = &Field{
-		Type:  "*objects.ModelCard",
-		Name:  "model_card",
+		Type:  "datastorage.Status",
+		Name:  "status",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Settings); err != nil {
-		return nil, err
-	}
-	node.Fields[9] = &Field{
-		Type:  "*objects.ModelSettings",
-		Name:  "settings",
-		Value: string(buf),
+	node.Edges[0] = &Edge{
+		Type: "Request",
+		Name: "requests",
 	}
-	if buf, err = json.Marshal(_m.Status); err != nil {
+	err = _m.QueryRequests().
+		Select(request.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
 		return nil, err
 	}
-	node.Fields[10] = &Field{
-		Type:  "model.Status",
-		Name:  "status",
-		Value: string(buf),
+	node.Edges[1] = &Edge{
+		Type: "RequestExecution",
+		Name: "executions",
 	}
-	if buf, err = json.Marshal(_m.Remark); err != nil {
+	err = _m.QueryExecutions().
+		Select(requestexecution.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
 		return nil, err
 	}
-	node.Fields[11] = &Field{
-		Type:  "string",
-		Name:  "remark",
-		Value: string(buf),
-	}
 	return node, nil
 }
 
 // Node implements Noder interface
-func (_m *OIDCIdentity) Node(ctx context.Context) (node *Node, err error) {
+func (_m *ExchangeRate) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     _m.ID,
-		Type:   "OIDCIdentity",
+		Type:   "ExchangeRate",
 		Fields: make([]*Field, 8),
-		Edges:  make([]*Edge, 1),
+		Edges:  make([]*Edge, 0),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
@@ -1058,74 +1365,64 @@ func (_m *OIDCIdentity) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "updated_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Issuer); err != nil {
+	if buf, err = json.Marshal(_m.FromCurrency); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
 		Type:  "string",
-		Name:  "issuer",
+		Name:  "from_currency",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Subject); err != nil {
+	if buf, err = json.Marshal(_m.ToCurrency); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
 		Type:  "string",
-		Name:  "subject",
+		Name:  "to_currency",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Email); err != nil {
+	if buf, err = json.Marshal(_m.Rate); err != nil {
 		return nil, err
 	}
 	node.Fields[4] = &Field{
-		Type:  "string",
-		Name:  "email",
+		Type:  "float64",
+		Name:  "rate",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.IdpName); err != nil {
+	if buf, err = json.Marshal(_m.EffectiveAt); err != nil {
 		return nil, err
 	}
 	node.Fields[5] = &Field{
-		Type:  "string",
-		Name:  "idp_name",
+		Type:  "time.Time",
+		Name:  "effective_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.LastLoginAt); err != nil {
+	if buf, err = json.Marshal(_m.Source); err != nil {
 		return nil, err
 	}
 	node.Fields[6] = &Field{
-		Type:  "time.Time",
-		Name:  "last_login_at",
+		Type:  "exchangerate.Source",
+		Name:  "source",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.UserID); err != nil {
+	if buf, err = json.Marshal(_m.ImportSource); err != nil {
 		return nil, err
 	}
 	node.Fields[7] = &Field{
-		Type:  "int",
-		Name:  "user_id",
+		Type:  "string",
+		Name:  "import_source",
 		Value: string(buf),
 	}
-	node.Edges[0] = &Edge{
-		Type: "User",
-		Name: "user",
-	}
-	err = _m.QueryUser().
-		Select(user.FieldID).
-		Scan(ctx, &node.Edges[0].IDs)
-	if err != nil {
-		return nil, err
-	}
 	return node, nil
 }
 
 // Node implements Noder interface
-func (_m *Project) Node(ctx context.Context) (node *Node, err error) {
+func (_m *Invoice) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     _m.ID,
-		Type:   "Project",
-		Fields: make([]*Field, 6),
-		Edges:  make([]*Edge, 10),
+		Type:   "Invoice",
+		Fields: make([]*Field, 13),
+		Edges:  make([]*Edge, 1),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
@@ -1144,135 +1441,101 @@ func (_m *Project) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "updated_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Name); err != nil {
+	if buf, err = json.Marshal(_m.ProjectID); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
-		Type:  "string",
-		Name:  "name",
+		Type:  "int",
+		Name:  "project_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Description); err != nil {
+	if buf, err = json.Marshal(_m.Number); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
 		Type:  "string",
-		Name:  "description",
+		Name:  "number",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Status); err != nil {
+	if buf, err = json.Marshal(_m.PeriodStart); err != nil {
 		return nil, err
 	}
 	node.Fields[4] = &Field{
-		Type:  "project.Status",
-		Name:  "status",
+		Type:  "time.Time",
+		Name:  "period_start",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Profiles); err != nil {
+	if buf, err = json.Marshal(_m.PeriodEnd); err != nil {
 		return nil, err
 	}
 	node.Fields[5] = &Field{
-		Type:  "*objects.ProjectProfiles",
-		Name:  "profiles",
+		Type:  "time.Time",
+		Name:  "period_end",
 		Value: string(buf),
 	}
-	node.Edges[0] = &Edge{
-		Type: "User",
-		Name: "users",
-	}
-	err = _m.QueryUsers().
-		Select(user.FieldID).
-		Scan(ctx, &node.Edges[0].IDs)
-	if err != nil {
+	if buf, err = json.Marshal(_m.Currency); err != nil {
 		return nil, err
 	}
-	node.Edges[1] = &Edge{
-		Type: "Role",
-		Name: "roles",
+	node.Fields[6] = &Field{
+		Type:  "string",
+		Name:  "currency",
+		Value: string(buf),
 	}
-	err = _m.QueryRoles().
-		Select(role.FieldID).
-		Scan(ctx, &node.Edges[1].IDs)
-	if err != nil {
+	if buf, err = json.Marshal(_m.Subtotal); err != nil {
 		return nil, err
 	}
-	node.Edges[2] = &Edge{
-		Type: "APIKey",
-		Name: "api_keys",
+	node.Fields[7] = &Field{
+		Type:  "float64",
+		Name:  "subtotal",
+		Value: string(buf),
 	}
-	err = _m.QueryAPIKeys().
-		Select(apikey.FieldID).
-		Scan(ctx, &node.Edges[2].IDs)
-	if err != nil {
+	if buf, err = json.Marshal(_m.MarkupAmount); err != nil {
 		return nil, err
 	}
-	node.Edges[3] = &Edge{
-		Type: "Request",
-		Name: "requests",
+	node.Fields[8] = &Field{
+		Type:  "float64",
+		Name:  "markup_amount",
+		Value: string(buf),
 	}
-	err = _m.QueryRequests().
-		Select(request.FieldID).
-		Scan(ctx, &node.Edges[3].IDs)
-	if err != nil {
+	if buf, err = json.Marshal(_m.Fee); err != nil {
 		return nil, err
 	}
-	node.Edges[4] = &Edge{
-		Type: "UsageLog",
-		Name: "usage_logs",
+	node.Fields[9] = &Field{
+		Type:  "float64",
+		Name:  "fee",
+		Value: string(buf),
 	}
-	err = _m.QueryUsageLogs().
-		Select(usagelog.FieldID).
-		Scan(ctx, &node.Edges[4].IDs)
-	if err != nil {
+	if buf, err = json.Marshal(_m.Total); err != nil {
 		return nil, err
 	}
-	node.Edges[5] = &Edge{
-		Type: "Thread",
-		Name: "threads",
+	node.Fields[10] = &Field{
+		Type:  "float64",
+		Name:  "total",
+		Value: string(buf),
 	}
-	err = _m.QueryThreads().
-		Select(thread.FieldID).
-		Scan(ctx, &node.Edges[5].IDs)
-	if err != nil {
+	if buf, err = json.Marshal(_m.LineItems); err != nil {
 		return nil, err
 	}
-	node.Edges[6] = &Edge{
-		Type: "Trace",
-		Name: "traces",
-	}
-	err = _m.QueryTraces().
-		Select(trace.FieldID).
-		Scan(ctx, &node.Edges[6].IDs)
-	if err != nil {
-		return nil, err
-	}
-	node.Edges[7] = &Edge{
-		Type: "Prompt",
-		Name: "prompts",
+	node.Fields[11] = &Field{
+		Type:  "[]objects.InvoiceLineItem",
+		Name:  "line_items",
+		Value: string(buf),
 	}
-	err = _m.QueryPrompts().
-		Select(prompt.FieldID).
-		Scan(ctx, &node.Edges[7].IDs)
-	if err != nil {
+	if buf, err = json.Marshal(_m.BillingSettings); err != nil {
 		return nil, err
 	}
-	node.Edges[8] = &Edge{
-		Type: "APIKeyProfileTemplate",
-		Name: "api_key_profile_templates",
-	}
-	err = _m.QueryAPIKeyProfileTemplates().
-		Select(apikeyprofiletemplate.FieldID).
-		Scan(ctx, &node.Edges[8].IDs)
-	if err != nil {
-		return nil, err
+	node.Fields[12] = &Field{
+		Type:  "objects.ProjectBillingSettings",
+		Name:  "billing_settings",
+		Value: string(buf),
 	}
-	node.Edges[9] = &Edge{
-		Type: "UserProject",
-		Name: "project_users",
+	node.Edges[0] = &Edge{
+		Type: "Project",
+		Name: "project",
 	}
-	err = _m.QueryProjectUsers().
-		Select(userproject.FieldID).
-		Scan(ctx, &node.Edges[9].IDs)
+	err = _m.QueryProject().
+		Select(project.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
 		return nil, err
 	}
@@ -1280,12 +1543,12 @@ func (_m *Project) Node(ctx context.Context) (node *Node, err error) {
 }
 
 // Node implements Noder interface
-func (_m *Prompt) Node(ctx context.Context) (node *Node, err error) {
+func (_m *Model) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     _m.ID,
-		Type:   "Prompt",
-		Fields: make([]*Field, 10),
-		Edges:  make([]*Edge, 1),
+		Type:   "Model",
+		Fields: make([]*Field, 12),
+		Edges:  make([]*Edge, 0),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
@@ -1304,90 +1567,96 @@ func (_m *Prompt) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "updated_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ProjectID); err != nil {
+	if buf, err = json.Marshal(_m.Developer); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
-		Type:  "int",
-		Name:  "project_id",
+		Type:  "string",
+		Name:  "developer",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Name); err != nil {
+	if buf, err = json.Marshal(_m.ModelID); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
 		Type:  "string",
-		Name:  "name",
+		Name:  "model_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Description); err != nil {
+	if buf, err = json.Marshal(_m.Type); err != nil {
 		return nil, err
 	}
 	node.Fields[4] = &Field{
-		Type:  "string",
-		Name:  "description",
+		Type:  "model.Type",
+		Name:  "type",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Role); err != nil {
+	if buf, err = json.Marshal(_m.Name); err != nil {
 		return nil, err
 	}
 	node.Fields[5] = &Field{
 		Type:  "string",
-		Name:  "role",
+		Name:  "name",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Content); err != nil {
+	if buf, err = json.Marshal(_m.Icon); err != nil {
 		return nil, err
 	}
 	node.Fields[6] = &Field{
 		Type:  "string",
-		Name:  "content",
+		Name:  "icon",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Status); err != nil {
+	if buf, err = json.Marshal(_m.Group); err != nil {
 		return nil, err
 	}
 	node.Fields[7] = &Field{
-		Type:  "prompt.Status",
-		Name:  "status",
+		Type:  "string",
+		Name:  "group",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Order); err != nil {
+	if buf, err = json.Marshal(_m.ModelCard); err != nil {
 		return nil, err
 	}
 	node.Fields[8] = &Field{
-		Type:  "int",
-		Name:  "order",
+		Type:  "*objects.ModelCard",
+		Name:  "model_card",
 		Value: string(buf),
 	}
 	if buf, err = json.Marshal(_m.Settings); err != nil {
 		return nil, err
 	}
 	node.Fields[9] = &Field{
-		Type:  "objects.PromptSettings",
+		Type:  "*objects.ModelSettings",
 		Name:  "settings",
 		Value: string(buf),
 	}
-	node.Edges[0] = &Edge{
-		Type: "Project",
-		Name: "project",
+	if buf, err = json.Marshal(_m.Status); err != nil {
+		return nil, err
 	}
-	err = _m.QueryProject().
-		Select(project.FieldID).
-		Scan(ctx, &node.Edges[0].IDs)
-	if err != nil {
+	node.Fields[10] = &Field{
+		Type:  "model.Status",
+		Name:  "status",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Remark); err != nil {
 		return nil, err
 	}
+	node.Fields[11] = &Field{
+		Type:  "string",
+		Name:  "remark",
+		Value: string(buf),
+	}
 	return node, nil
 }
 
 // Node implements Noder interface
-func (_m *PromptProtectionRule) Node(ctx context.Context) (node *Node, err error) {
+func (_m *OIDCIdentity) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     _m.ID,
-		Type:   "PromptProtectionRule",
-		Fields: make([]*Field, 7),
-		Edges:  make([]*Edge, 0),
+		Type:   "OIDCIdentity",
+		Fields: make([]*Field, 8),
+		Edges:  make([]*Edge, 1),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
@@ -1406,56 +1675,74 @@ func (_m *PromptProtectionRule) Node(ctx context.Context) (node *Node, err error
 		Name:  "updated_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Name); err != nil {
+	if buf, err = json.Marshal(_m.Issuer); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
 		Type:  "string",
-		Name:  "name",
+		Name:  "issuer",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Description); err != nil {
+	if buf, err = json.Marshal(_m.Subject); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
 		Type:  "string",
-		Name:  "description",
+		Name:  "subject",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Pattern); err != nil {
+	if buf, err = json.Marshal(_m.Email); err != nil {
 		return nil, err
 	}
 	node.Fields[4] = &Field{
 		Type:  "string",
-		Name:  "pattern",
+		Name:  "email",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Status); err != nil {
+	if buf, err = json.Marshal(_m.IdpName); err != nil {
 		return nil, err
 	}
 	node.Fields[5] = &Field{
-		Type:  "promptprotectionrule.Status",
-		Name:  "status",
+		Type:  "string",
+		Name:  "idp_name",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Settings); err != nil {
+	if buf, err = json.Marshal(_m.LastLoginAt); err != nil {
 		return nil, err
 	}
 	node.Fields[6] = &Field{
-		Type:  "*objects.PromptProtectionSettings",
-		Name:  "settings",
+		Type:  "time.Time",
+		Name:  "last_login_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.UserID); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "int",
+		Name:  "user_id",
 		Value: string(buf),
 	}
+	node.Edges[0] = &Edge{
+		Type: "User",
+		Name: "user",
+	}
+	err = _m.QueryUser().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
 	return node, nil
 }
 
 // Node implements Noder interface
-func (_m *ProviderQuotaStatus) Node(ctx context.Context) (node *Node, err error) {
+func (_m *Project) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     _m.ID,
-		Type:   "ProviderQuotaStatus",
+		Type:   "Project",
 		Fields: make([]*Field, 9),
-		Edges:  make([]*Edge, 1),
+		Edges:  make([]*Edge, 13),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
@@ -1474,371 +1761,1293 @@ func (_m *ProviderQuotaStatus) Node(ctx context.Context) (node *Node, err error)
 		Name:  "updated_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ChannelID); err != nil {
+	if buf, err = json.Marshal(_m.Name); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
-		Type:  "int",
-		Name:  "channel_id",
+		Type:  "string",
+		Name:  "name",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ProviderType); err != nil {
+	if buf, err = json.Marshal(_m.Description); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
-		Type:  "providerquotastatus.ProviderType",
-		Name:  "provider_type",
+		Type:  "string",
+		Name:  "description",
 		Value: string(buf),
 	}
 	if buf, err = json.Marshal(_m.Status); err != nil {
 		return nil, err
 	}
 	node.Fields[4] = &Field{
-		Type:  "providerquotastatus.Status",
+		Type:  "project.Status",
 		Name:  "status",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.QuotaData); err != nil {
+	if buf, err = json.Marshal(_m.Profiles); err != nil {
 		return nil, err
 	}
 	node.Fields[5] = &Field{
-		Type:  "map[string]interface {}",
-		Name:  "quota_data",
+		Type:  "*objects.ProjectProfiles",
+		Name:  "profiles",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.NextResetAt); err != nil {
+	if buf, err = json.Marshal(_m.ModerationSettings); err != nil {
 		return nil, err
 	}
 	node.Fields[6] = &Field{
-		Type:  "time.Time",
-		Name:  "next_reset_at",
+		Type:  "*objects.ProjectModerationSettings",
+		Name:  "moderation_settings",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Ready); err != nil {
+	if buf, err = json.Marshal(_m.BillingSettings); err != nil {
 		return nil, err
 	}
 	node.Fields[7] = &Field{
-		Type:  "bool",
-		Name:  "ready",
+		Type:  "*objects.ProjectBillingSettings",
+		Name:  "billing_settings",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.NextCheckAt); err != nil {
+	if buf, err = json.Marshal(_m.DataPolicy); err != nil {
 		return nil, err
 	}
 	node.Fields[8] = &Field{
-		Type:  "time.Time",
-		Name:  "next_check_at",
+		Type:  "*objects.ProjectDataPolicy",
+		Name:  "data_policy",
 		Value: string(buf),
 	}
 	node.Edges[0] = &Edge{
-		Type: "Channel",
-		Name: "channel",
+		Type: "User",
+		Name: "users",
 	}
-	err = _m.QueryChannel().
-		Select(channel.FieldID).
+	err = _m.QueryUsers().
+		Select(user.FieldID).
 		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
 		return nil, err
 	}
-	return node, nil
-}
-
-// Node implements Noder interface
-func (_m *Request) Node(ctx context.Context) (node *Node, err error) {
-	node = &Node{
-		ID:     _m.ID,
-		Type:   "Request",
-		Fields: make([]*Field, 26),
-		Edges:  make([]*Edge, 7),
+	node.Edges[1] = &Edge{
+		Type: "Role",
+		Name: "roles",
 	}
-	var buf []byte
-	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
+	err = _m.QueryRoles().
+		Select(role.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
 		return nil, err
 	}
-	node.Fields[0] = &Field{
-		Type:  "time.Time",
-		Name:  "created_at",
-		Value: string(buf),
+	node.Edges[2] = &Edge{
+		Type: "APIKey",
+		Name: "api_keys",
 	}
-	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
+	err = _m.QueryAPIKeys().
+		Select(apikey.FieldID).
+		Scan(ctx, &node.Edges[2].IDs)
+	if err != nil {
 		return nil, err
 	}
-	node.Fields[1] = &Field{
-		Type:  "time.Time",
-		Name:  "updated_at",
-		Value: string(buf),
+	node.Edges[3] = &Edge{
+		Type: "Request",
+		Name: "requests",
 	}
-	if buf, err = json.Marshal(_m.APIKeyID); err != nil {
+	err = _m.QueryRequests().
+		Select(request.FieldID).
+		Scan(ctx, &node.Edges[3].IDs)
+	if err != nil {
 		return nil, err
 	}
-	node.Fields[2] = &Field{
-		Type:  "int",
-		Name:  "api_key_id",
-		Value: string(buf),
+	node.Edges[4] = &Edge{
+		Type: "UsageLog",
+		Name: "usage_logs",
 	}
-	if buf, err = json.Marshal(_m.ProjectID); err != nil {
+	err = _m.QueryUsageLogs().
+		Select(usagelog.FieldID).
+		Scan(ctx, &node.Edges[4].IDs)
+	if err != nil {
 		return nil, err
 	}
-	node.Fields[3] = &Field{
-		Type:  "int",
-		Name:  "project_id",
+	node.Edges[5] = &Edge{
+		Type: "Thread",
+		Name: "threads",
+	}
+	err = _m.QueryThreads().
+		Select(thread.FieldID).
+		Scan(ctx, &node.Edges[5].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[6] = &Edge{
+		Type: "Trace",
+		Name: "traces",
+	}
+	err = _m.QueryTraces().
+		Select(trace.FieldID).
+		Scan(ctx, &node.Edges[6].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[7] = &Edge{
+		Type: "Prompt",
+		Name: "prompts",
+	}
+	err = _m.QueryPrompts().
+		Select(prompt.FieldID).
+		Scan(ctx, &node.Edges[7].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[8] = &Edge{
+		Type: "APIKeyProfileTemplate",
+		Name: "api_key_profile_templates",
+	}
+	err = _m.QueryAPIKeyProfileTemplates().
+		Select(apikeyprofiletemplate.FieldID).
+		Scan(ctx, &node.Edges[8].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[9] = &Edge{
+		Type: "Budget",
+		Name: "budgets",
+	}
+	err = _m.QueryBudgets().
+		Select(budget.FieldID).
+		Scan(ctx, &node.Edges[9].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[10] = &Edge{
+		Type: "Invoice",
+		Name: "invoices",
+	}
+	err = _m.QueryInvoices().
+		Select(invoice.FieldID).
+		Scan(ctx, &node.Edges[10].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[11] = &Edge{
+		Type: "DataExport",
+		Name: "data_exports",
+	}
+	err = _m.QueryDataExports().
+		Select(dataexport.FieldID).
+		Scan(ctx, &node.Edges[11].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[12] = &Edge{
+		Type: "UserProject",
+		Name: "project_users",
+	}
+	err = _m.QueryProjectUsers().
+		Select(userproject.FieldID).
+		Scan(ctx, &node.Edges[12].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// Node implements Noder interface
+func (_m *Prompt) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     _m.ID,
+		Type:   "Prompt",
+		Fields: make([]*Field, 10),
+		Edges:  make([]*Edge, 1),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.TraceID); err != nil {
+	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
 		return nil, err
 	}
-	node.Fields[4] = &Field{
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ProjectID); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
 		Type:  "int",
-		Name:  "trace_id",
+		Name:  "project_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.DataStorageID); err != nil {
+	if buf, err = json.Marshal(_m.Name); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "name",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Description); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "string",
+		Name:  "description",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Role); err != nil {
 		return nil, err
 	}
 	node.Fields[5] = &Field{
-		Type:  "int",
-		Name:  "data_storage_id",
+		Type:  "string",
+		Name:  "role",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Source); err != nil {
+	if buf, err = json.Marshal(_m.Content); err != nil {
 		return nil, err
 	}
 	node.Fields[6] = &Field{
-		Type:  "request.Source",
-		Name:  "source",
+		Type:  "string",
+		Name:  "content",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ModelID); err != nil {
+	if buf, err = json.Marshal(_m.Status); err != nil {
 		return nil, err
 	}
 	node.Fields[7] = &Field{
-		Type:  "string",
-		Name:  "model_id",
+		Type:  "prompt.Status",
+		Name:  "status",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ReasoningEffort); err != nil {
+	if buf, err = json.Marshal(_m.Order); err != nil {
 		return nil, err
 	}
 	node.Fields[8] = &Field{
-		Type:  "string",
-		Name:  "reasoning_effort",
+		Type:  "int",
+		Name:  "order",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Format); err != nil {
+	if buf, err = json.Marshal(_m.Settings); err != nil {
 		return nil, err
 	}
 	node.Fields[9] = &Field{
+		Type:  "objects.PromptSettings",
+		Name:  "settings",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "Project",
+		Name: "project",
+	}
+	err = _m.QueryProject().
+		Select(project.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// Node implements Noder interface
+func (_m *PromptProtectionRule) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     _m.ID,
+		Type:   "PromptProtectionRule",
+		Fields: make([]*Field, 7),
+		Edges:  make([]*Edge, 0),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Name); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
 		Type:  "string",
-		Name:  "format",
+		Name:  "name",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.RequestHeaders); err != nil {
+	if buf, err = json.Marshal(_m.Description); err != nil {
 		return nil, err
 	}
-	node.Fields[10] = &Field{
-		Type:  "objects.JSONRawMessage",
-		Name:  "request_headers",
+	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "description",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.RequestBody); err != nil {
+	if buf, err = json.Marshal(_m.Pattern); err != nil {
 		return nil, err
 	}
-	node.Fields[11] = &Field{
-		Type:  "objects.JSONRawMessage",
-		Name:  "request_body",
+	node.Fields[4] = &Field{
+		Type:  "string",
+		Name:  "pattern",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ResponseBody); err != nil {
+	if buf, err = json.Marshal(_m.Status); err != nil {
 		return nil, err
 	}
-	node.Fields[12] = &Field{
-		Type:  "objects.JSONRawMessage",
-		Name:  "response_body",
+	node.Fields[5] = &Field{
+		Type:  "promptprotectionrule.Status",
+		Name:  "status",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Settings); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "*objects.PromptProtectionSettings",
+		Name:  "settings",
+		Value: string(buf),
+	}
+	return node, nil
+}
+
+// Node implements Noder interface
+func (_m *ProviderQuotaStatus) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     _m.ID,
+		Type:   "ProviderQuotaStatus",
+		Fields: make([]*Field, 9),
+		Edges:  make([]*Edge, 1),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ChannelID); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "int",
+		Name:  "channel_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ProviderType); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "providerquotastatus.ProviderType",
+		Name:  "provider_type",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Status); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "providerquotastatus.Status",
+		Name:  "status",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.QuotaData); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "map[string]interface {}",
+		Name:  "quota_data",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.NextResetAt); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "time.Time",
+		Name:  "next_reset_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Ready); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "bool",
+		Name:  "ready",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.NextCheckAt); err != nil {
+		return nil, err
+	}
+	node.Fields[8] = &Field{
+		Type:  "time.Time",
+		Name:  "next_check_at",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "Channel",
+		Name: "channel",
+	}
+	err = _m.QueryChannel().
+		Select(channel.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// Node implements Noder interface
+func (_m *Request) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     _m.ID,
+		Type:   "Request",
+		Fields: make([]*Field, 29),
+		Edges:  make([]*Edge, 7),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.APIKeyID); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "int",
+		Name:  "api_key_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ProjectID); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "int",
+		Name:  "project_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.TraceID); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "int",
+		Name:  "trace_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.DataStorageID); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "int",
+		Name:  "data_storage_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Source); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "request.Source",
+		Name:  "source",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ModelID); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "string",
+		Name:  "model_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ReasoningEffort); err != nil {
+		return nil, err
+	}
+	node.Fields[8] = &Field{
+		Type:  "string",
+		Name:  "reasoning_effort",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Format); err != nil {
+		return nil, err
+	}
+	node.Fields[9] = &Field{
+		Type:  "string",
+		Name:  "format",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.RequestHeaders); err != nil {
+		return nil, err
+	}
+	node.Fields[10] = &Field{
+		Type:  "objects.JSONRawMessage",
+		Name:  "request_headers",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.RequestBody); err != nil {
+		return nil, err
+	}
+	node.Fields[11] = &Field{
+		Type:  "objects.JSONRawMessage",
+		Name:  "request_body",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ResponseBody); err != nil {
+		return nil, err
+	}
+	node.Fields[12] = &Field{
+		Type:  "objects.JSONRawMessage",
+		Name:  "response_body",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ResponseChunks); err != nil {
+		return nil, err
+	}
+	node.Fields[13] = &Field{
+		Type:  "[]objects.JSONRawMessage",
+		Name:  "response_chunks",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ChannelID); err != nil {
+		return nil, err
+	}
+	node.Fields[14] = &Field{
+		Type:  "int",
+		Name:  "channel_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.EndUserID); err != nil {
+		return nil, err
+	}
+	node.Fields[15] = &Field{
+		Type:  "string",
+		Name:  "end_user_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ExternalID); err != nil {
+		return nil, err
+	}
+	node.Fields[16] = &Field{
+		Type:  "string",
+		Name:  "external_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Status); err != nil {
+		return nil, err
+	}
+	node.Fields[17] = &Field{
+		Type:  "request.Status",
+		Name:  "status",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Stream); err != nil {
+		return nil, err
+	}
+	node.Fields[18] = &Field{
+		Type:  "bool",
+		Name:  "stream",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ClientIP); err != nil {
+		return nil, err
+	}
+	node.Fields[19] = &Field{
+		Type:  "string",
+		Name:  "client_ip",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.MetricsLatencyMs); err != nil {
+		return nil, err
+	}
+	node.Fields[20] = &Field{
+		Type:  "int64",
+		Name:  "metrics_latency_ms",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.MetricsFirstTokenLatencyMs); err != nil {
+		return nil, err
+	}
+	node.Fields[21] = &Field{
+		Type:  "int64",
+		Name:  "metrics_first_token_latency_ms",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.MetricsReasoningDurationMs); err != nil {
+		return nil, err
+	}
+	node.Fields[22] = &Field{
+		Type:  "int64",
+		Name:  "metrics_reasoning_duration_ms",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ContentSaved); err != nil {
+		return nil, err
+	}
+	node.Fields[23] = &Field{
+		Type:  "bool",
+		Name:  "content_saved",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ContentStorageID); err != nil {
+		return nil, err
+	}
+	node.Fields[24] = &Field{
+		Type:  "int",
+		Name:  "content_storage_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ContentStorageKey); err != nil {
+		return nil, err
+	}
+	node.Fields[25] = &Field{
+		Type:  "string",
+		Name:  "content_storage_key",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ContentSavedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[26] = &Field{
+		Type:  "time.Time",
+		Name:  "content_saved_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ModerationVerdicts); err != nil {
+		return nil, err
+	}
+	node.Fields[27] = &Field{
+		Type:  "[]objects.ModerationVerdict",
+		Name:  "moderation_verdicts",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ArchivedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[28] = &Field{
+		Type:  "time.Time",
+		Name:  "archived_at",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "APIKey",
+		Name: "api_key",
+	}
+	err = _m.QueryAPIKey().
+		Select(apikey.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[1] = &Edge{
+		Type: "Project",
+		Name: "project",
+	}
+	err = _m.QueryProject().
+		Select(project.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[2] = &Edge{
+		Type: "Trace",
+		Name: "trace",
+	}
+	err = _m.QueryTrace().
+		Select(trace.FieldID).
+		Scan(ctx, &node.Edges[2].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[3] = &Edge{
+		Type: "DataStorage",
+		Name: "data_storage",
+	}
+	err = _m.QueryDataStorage().
+		Select(datastorage.FieldID).
+		Scan(ctx, &node.Edges[3].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[4] = &Edge{
+		Type: "RequestExecution",
+		Name: "executions",
+	}
+	err = _m.QueryExecutions().
+		Select(requestexecution.FieldID).
+		Scan(ctx, &node.Edges[4].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[5] = &Edge{
+		Type: "Channel",
+		Name: "channel",
+	}
+	err = _m.QueryChannel().
+		Select(channel.FieldID).
+		Scan(ctx, &node.Edges[5].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[6] = &Edge{
+		Type: "UsageLog",
+		Name: "usage_logs",
+	}
+	err = _m.QueryUsageLogs().
+		Select(usagelog.FieldID).
+		Scan(ctx, &node.Edges[6].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// Node implements Noder interface
+func (_m *RequestExecution) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     _m.ID,
+		Type:   "RequestExecution",
+		Fields: make([]*Field, 22),
+		Edges:  make([]*Edge, 3),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ProjectID); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "int",
+		Name:  "project_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.RequestID); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "int",
+		Name:  "request_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ChannelID); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "int",
+		Name:  "channel_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.DataStorageID); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "int",
+		Name:  "data_storage_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ExternalID); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "string",
+		Name:  "external_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ModelID); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "string",
+		Name:  "model_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Format); err != nil {
+		return nil, err
+	}
+	node.Fields[8] = &Field{
+		Type:  "string",
+		Name:  "format",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.RequestBody); err != nil {
+		return nil, err
+	}
+	node.Fields[9] = &Field{
+		Type:  "objects.JSONRawMessage",
+		Name:  "request_body",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ResponseBody); err != nil {
+		return nil, err
+	}
+	node.Fields[10] = &Field{
+		Type:  "objects.JSONRawMessage",
+		Name:  "response_body",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ResponseChunks); err != nil {
+		return nil, err
+	}
+	node.Fields[11] = &Field{
+		Type:  "[]objects.JSONRawMessage",
+		Name:  "response_chunks",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ErrorMessage); err != nil {
+		return nil, err
+	}
+	node.Fields[12] = &Field{
+		Type:  "string",
+		Name:  "error_message",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ResponseStatusCode); err != nil {
+		return nil, err
+	}
+	node.Fields[13] = &Field{
+		Type:  "int",
+		Name:  "response_status_code",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Status); err != nil {
+		return nil, err
+	}
+	node.Fields[14] = &Field{
+		Type:  "requestexecution.Status",
+		Name:  "status",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Stream); err != nil {
+		return nil, err
+	}
+	node.Fields[15] = &Field{
+		Type:  "bool",
+		Name:  "stream",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.MetricsLatencyMs); err != nil {
+		return nil, err
+	}
+	node.Fields[16] = &Field{
+		Type:  "int64",
+		Name:  "metrics_latency_ms",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.MetricsFirstTokenLatencyMs); err != nil {
+		return nil, err
+	}
+	node.Fields[17] = &Field{
+		Type:  "int64",
+		Name:  "metrics_first_token_latency_ms",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.MetricsReasoningDurationMs); err != nil {
+		return nil, err
+	}
+	node.Fields[18] = &Field{
+		Type:  "int64",
+		Name:  "metrics_reasoning_duration_ms",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.RequestHeaders); err != nil {
+		return nil, err
+	}
+	node.Fields[19] = &Field{
+		Type:  "objects.JSONRawMessage",
+		Name:  "request_headers",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.RequestURL); err != nil {
+		return nil, err
+	}
+	node.Fields[20] = &Field{
+		Type:  "string",
+		Name:  "request_url",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.PassThroughApplied); err != nil {
+		return nil, err
+	}
+	node.Fields[21] = &Field{
+		Type:  "bool",
+		Name:  "pass_through_applied",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "Request",
+		Name: "request",
+	}
+	err = _m.QueryRequest().
+		Select(request.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[1] = &Edge{
+		Type: "Channel",
+		Name: "channel",
+	}
+	err = _m.QueryChannel().
+		Select(channel.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[2] = &Edge{
+		Type: "DataStorage",
+		Name: "data_storage",
+	}
+	err = _m.QueryDataStorage().
+		Select(datastorage.FieldID).
+		Scan(ctx, &node.Edges[2].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// Node implements Noder interface
+func (_m *Role) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     _m.ID,
+		Type:   "Role",
+		Fields: make([]*Field, 6),
+		Edges:  make([]*Edge, 3),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Name); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "string",
+		Name:  "name",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Level); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "role.Level",
+		Name:  "level",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ProjectID); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "int",
+		Name:  "project_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Scopes); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "[]string",
+		Name:  "scopes",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "User",
+		Name: "users",
+	}
+	err = _m.QueryUsers().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[1] = &Edge{
+		Type: "Project",
+		Name: "project",
+	}
+	err = _m.QueryProject().
+		Select(project.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[2] = &Edge{
+		Type: "UserRole",
+		Name: "user_roles",
+	}
+	err = _m.QueryUserRoles().
+		Select(userrole.FieldID).
+		Scan(ctx, &node.Edges[2].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// Node implements Noder interface
+func (_m *System) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     _m.ID,
+		Type:   "System",
+		Fields: make([]*Field, 4),
+		Edges:  make([]*Edge, 0),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Key); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "string",
+		Name:  "key",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Value); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "value",
+		Value: string(buf),
+	}
+	return node, nil
+}
+
+// Node implements Noder interface
+func (_m *Thread) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     _m.ID,
+		Type:   "Thread",
+		Fields: make([]*Field, 5),
+		Edges:  make([]*Edge, 2),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ResponseChunks); err != nil {
+	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
 		return nil, err
 	}
-	node.Fields[13] = &Field{
-		Type:  "[]objects.JSONRawMessage",
-		Name:  "response_chunks",
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ChannelID); err != nil {
+	if buf, err = json.Marshal(_m.ProjectID); err != nil {
 		return nil, err
 	}
-	node.Fields[14] = &Field{
+	node.Fields[2] = &Field{
 		Type:  "int",
-		Name:  "channel_id",
+		Name:  "project_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ExternalID); err != nil {
+	if buf, err = json.Marshal(_m.ThreadID); err != nil {
 		return nil, err
 	}
-	node.Fields[15] = &Field{
+	node.Fields[3] = &Field{
 		Type:  "string",
-		Name:  "external_id",
+		Name:  "thread_id",
 		Value: string(buf),
 	}
 	if buf, err = json.Marshal(_m.Status); err != nil {
 		return nil, err
 	}
-	node.Fields[16] = &Field{
-		Type:  "request.Status",
+	node.Fields[4] = &Field{
+		Type:  "thread.Status",
 		Name:  "status",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Stream); err != nil {
-		return nil, err
-	}
-	node.Fields[17] = &Field{
-		Type:  "bool",
-		Name:  "stream",
-		Value: string(buf),
+	node.Edges[0] = &Edge{
+		Type: "Project",
+		Name: "project",
 	}
-	if buf, err = json.Marshal(_m.ClientIP); err != nil {
+	err = _m.QueryProject().
+		Select(project.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
 		return nil, err
 	}
-	node.Fields[18] = &Field{
-		Type:  "string",
-		Name:  "client_ip",
-		Value: string(buf),
+	node.Edges[1] = &Edge{
+		Type: "Trace",
+		Name: "traces",
 	}
-	if buf, err = json.Marshal(_m.MetricsLatencyMs); err != nil {
+	err = _m.QueryTraces().
+		Select(trace.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
 		return nil, err
 	}
-	node.Fields[19] = &Field{
-		Type:  "int64",
-		Name:  "metrics_latency_ms",
-		Value: string(buf),
+	return node, nil
+}
+
+// Node implements Noder interface
+func (_m *Trace) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     _m.ID,
+		Type:   "Trace",
+		Fields: make([]*Field, 6),
+		Edges:  make([]*Edge, 3),
 	}
-	if buf, err = json.Marshal(_m.MetricsFirstTokenLatencyMs); err != nil {
+	var buf []byte
+	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
 		return nil, err
 	}
-	node.Fields[20] = &Field{
-		Type:  "int64",
-		Name:  "metrics_first_token_latency_ms",
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.MetricsReasoningDurationMs); err != nil {
+	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
 		return nil, err
 	}
-	node.Fields[21] = &Field{
-		Type:  "int64",
-		Name:  "metrics_reasoning_duration_ms",
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ContentSaved); err != nil {
+	if buf, err = json.Marshal(_m.ProjectID); err != nil {
 		return nil, err
 	}
-	node.Fields[22] = &Field{
-		Type:  "bool",
-		Name:  "content_saved",
+	node.Fields[2] = &Field{
+		Type:  "int",
+		Name:  "project_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ContentStorageID); err != nil {
+	if buf, err = json.Marshal(_m.TraceID); err != nil {
 		return nil, err
 	}
-	node.Fields[23] = &Field{
-		Type:  "int",
-		Name:  "content_storage_id",
+	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "trace_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ContentStorageKey); err != nil {
+	if buf, err = json.Marshal(_m.ThreadID); err != nil {
 		return nil, err
 	}
-	node.Fields[24] = &Field{
-		Type:  "string",
-		Name:  "content_storage_key",
+	node.Fields[4] = &Field{
+		Type:  "int",
+		Name:  "thread_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ContentSavedAt); err != nil {
+	if buf, err = json.Marshal(_m.Status); err != nil {
 		return nil, err
 	}
-	node.Fields[25] = &Field{
-		Type:  "time.Time",
-		Name:  "content_saved_at",
+	node.Fields[5] = &Field{
+		Type:  "trace.Status",
+		Name:  "status",
 		Value: string(buf),
 	}
 	node.Edges[0] = &Edge{
-		Type: "APIKey",
-		Name: "api_key",
+		Type: "Project",
+		Name: "project",
 	}
-	err = _m.QueryAPIKey().
-		Select(apikey.FieldID).
+	err = _m.QueryProject().
+		Select(project.FieldID).
 		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
 		return nil, err
 	}
 	node.Edges[1] = &Edge{
-		Type: "Project",
-		Name: "project",
+		Type: "Thread",
+		Name: "thread",
 	}
-	err = _m.QueryProject().
-		Select(project.FieldID).
+	err = _m.QueryThread().
+		Select(thread.FieldID).
 		Scan(ctx, &node.Edges[1].IDs)
 	if err != nil {
 		return nil, err
 	}
 	node.Edges[2] = &Edge{
-		Type: "Trace",
-		Name: "trace",
+		Type: "Request",
+		Name: "requests",
 	}
-	err = _m.QueryTrace().
-		Select(trace.FieldID).
+	err = _m.QueryRequests().
+		Select(request.FieldID).
 		Scan(ctx, &node.Edges[2].IDs)
 	if err != nil {
 		return nil, err
 	}
-	node.Edges[3] = &Edge{
-		Type: "DataStorage",
-		Name: "data_storage",
-	}
-	err = _m.QueryDataStorage().
-		Select(datastorage.FieldID).
-		Scan(ctx, &node.Edges[3].IDs)
-	if err != nil {
-		return nil, err
-	}
-	node.Edges[4] = &Edge{
-		Type: "RequestExecution",
-		Name: "executions",
-	}
-	err = _m.QueryExecutions().
-		Select(requestexecution.FieldID).
-		Scan(ctx, &node.Edges[4].IDs)
-	if err != nil {
-		return nil, err
-	}
-	node.Edges[5] = &Edge{
-		Type: "Channel",
-		Name: "channel",
-	}
-	err = _m.QueryChannel().
-		Select(channel.FieldID).
-		Scan(ctx, &node.Edges[5].IDs)
-	if err != nil {
-		return nil, err
-	}
-	node.Edges[6] = &Edge{
-		Type: "UsageLog",
-		Name: "usage_logs",
-	}
-	err = _m.QueryUsageLogs().
-		Select(usagelog.FieldID).
-		Scan(ctx, &node.Edges[6].IDs)
-	if err != nil {
-		return nil, err
-	}
 	return node, nil
 }
 
 // Node implements Noder interface
-func (_m *RequestExecution) Node(ctx context.Context) (node *Node, err error) {
+func (_m *UsageLog) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     _m.ID,
-		Type:   "RequestExecution",
-		Fields: make([]*Field, 22),
+		Type:   "UsageLog",
+		Fields: make([]*Field, 29),
 		Edges:  make([]*Edge, 3),
 	}
 	var buf []byte
@@ -1858,44 +3067,44 @@ func (_m *RequestExecution) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "updated_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ProjectID); err != nil {
+	if buf, err = json.Marshal(_m.RequestID); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
 		Type:  "int",
-		Name:  "project_id",
+		Name:  "request_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.RequestID); err != nil {
+	if buf, err = json.Marshal(_m.APIKeyID); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
 		Type:  "int",
-		Name:  "request_id",
+		Name:  "api_key_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ChannelID); err != nil {
+	if buf, err = json.Marshal(_m.EndUserID); err != nil {
 		return nil, err
 	}
 	node.Fields[4] = &Field{
-		Type:  "int",
-		Name:  "channel_id",
+		Type:  "string",
+		Name:  "end_user_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.DataStorageID); err != nil {
+	if buf, err = json.Marshal(_m.ProjectID); err != nil {
 		return nil, err
 	}
 	node.Fields[5] = &Field{
 		Type:  "int",
-		Name:  "data_storage_id",
+		Name:  "project_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ExternalID); err != nil {
+	if buf, err = json.Marshal(_m.ChannelID); err != nil {
 		return nil, err
 	}
 	node.Fields[6] = &Field{
-		Type:  "string",
-		Name:  "external_id",
+		Type:  "int",
+		Name:  "channel_id",
 		Value: string(buf),
 	}
 	if buf, err = json.Marshal(_m.ModelID); err != nil {
@@ -1906,214 +3115,180 @@ func (_m *RequestExecution) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "model_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Format); err != nil {
+	if buf, err = json.Marshal(_m.PromptTokens); err != nil {
 		return nil, err
 	}
 	node.Fields[8] = &Field{
-		Type:  "string",
-		Name:  "format",
+		Type:  "int64",
+		Name:  "prompt_tokens",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.RequestBody); err != nil {
+	if buf, err = json.Marshal(_m.CompletionTokens); err != nil {
 		return nil, err
 	}
 	node.Fields[9] = &Field{
-		Type:  "objects.JSONRawMessage",
-		Name:  "request_body",
+		Type:  "int64",
+		Name:  "completion_tokens",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ResponseBody); err != nil {
+	if buf, err = json.Marshal(_m.TotalTokens); err != nil {
 		return nil, err
 	}
 	node.Fields[10] = &Field{
-		Type:  "objects.JSONRawMessage",
-		Name:  "response_body",
+		Type:  "int64",
+		Name:  "total_tokens",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ResponseChunks); err != nil {
+	if buf, err = json.Marshal(_m.PromptAudioTokens); err != nil {
 		return nil, err
 	}
 	node.Fields[11] = &Field{
-		Type:  "[]objects.JSONRawMessage",
-		Name:  "response_chunks",
+		Type:  "int64",
+		Name:  "prompt_audio_tokens",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ErrorMessage); err != nil {
+	if buf, err = json.Marshal(_m.PromptCachedTokens); err != nil {
 		return nil, err
 	}
 	node.Fields[12] = &Field{
-		Type:  "string",
-		Name:  "error_message",
+		Type:  "int64",
+		Name:  "prompt_cached_tokens",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ResponseStatusCode); err != nil {
+	if buf, err = json.Marshal(_m.PromptWriteCachedTokens); err != nil {
 		return nil, err
 	}
 	node.Fields[13] = &Field{
-		Type:  "int",
-		Name:  "response_status_code",
+		Type:  "int64",
+		Name:  "prompt_write_cached_tokens",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Status); err != nil {
+	if buf, err = json.Marshal(_m.PromptWriteCachedTokens5m); err != nil {
 		return nil, err
 	}
 	node.Fields[14] = &Field{
-		Type:  "requestexecution.Status",
-		Name:  "status",
+		Type:  "int64",
+		Name:  "prompt_write_cached_tokens_5m",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Stream); err != nil {
+	if buf, err = json.Marshal(_m.PromptWriteCachedTokens1h); err != nil {
 		return nil, err
 	}
 	node.Fields[15] = &Field{
-		Type:  "bool",
-		Name:  "stream",
+		Type:  "int64",
+		Name:  "prompt_write_cached_tokens_1h",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.MetricsLatencyMs); err != nil {
+	if buf, err = json.Marshal(_m.CompletionAudioTokens); err != nil {
 		return nil, err
 	}
 	node.Fields[16] = &Field{
 		Type:  "int64",
-		Name:  "metrics_latency_ms",
+		Name:  "completion_audio_tokens",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.MetricsFirstTokenLatencyMs); err != nil {
+	if buf, err = json.Marshal(_m.CompletionReasoningTokens); err != nil {
 		return nil, err
 	}
 	node.Fields[17] = &Field{
 		Type:  "int64",
-		Name:  "metrics_first_token_latency_ms",
+		Name:  "completion_reasoning_tokens",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.MetricsReasoningDurationMs); err != nil {
+	if buf, err = json.Marshal(_m.CompletionAcceptedPredictionTokens); err != nil {
 		return nil, err
 	}
 	node.Fields[18] = &Field{
 		Type:  "int64",
-		Name:  "metrics_reasoning_duration_ms",
+		Name:  "completion_accepted_prediction_tokens",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.RequestHeaders); err != nil {
+	if buf, err = json.Marshal(_m.CompletionRejectedPredictionTokens); err != nil {
 		return nil, err
 	}
 	node.Fields[19] = &Field{
-		Type:  "objects.JSONRawMessage",
-		Name:  "request_headers",
+		Type:  "int64",
+		Name:  "completion_rejected_prediction_tokens",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.RequestURL); err != nil {
+	if buf, err = json.Marshal(_m.Source); err != nil {
 		return nil, err
 	}
 	node.Fields[20] = &Field{
-		Type:  "string",
-		Name:  "request_url",
+		Type:  "usagelog.Source",
+		Name:  "source",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.PassThroughApplied); err != nil {
+	if buf, err = json.Marshal(_m.Format); err != nil {
 		return nil, err
 	}
 	node.Fields[21] = &Field{
-		Type:  "bool",
-		Name:  "pass_through_applied",
+		Type:  "string",
+		Name:  "format",
 		Value: string(buf),
 	}
-	node.Edges[0] = &Edge{
-		Type: "Request",
-		Name: "request",
-	}
-	err = _m.QueryRequest().
-		Select(request.FieldID).
-		Scan(ctx, &node.Edges[0].IDs)
-	if err != nil {
-		return nil, err
-	}
-	node.Edges[1] = &Edge{
-		Type: "Channel",
-		Name: "channel",
-	}
-	err = _m.QueryChannel().
-		Select(channel.FieldID).
-		Scan(ctx, &node.Edges[1].IDs)
-	if err != nil {
-		return nil, err
-	}
-	node.Edges[2] = &Edge{
-		Type: "DataStorage",
-		Name: "data_storage",
-	}
-	err = _m.QueryDataStorage().
-		Select(datastorage.FieldID).
-		Scan(ctx, &node.Edges[2].IDs)
-	if err != nil {
+	if buf, err = json.Marshal(_m.TotalCost); err != nil {
 		return nil, err
 	}
-	return node, nil
-}
-
-// Node implements Noder interface
-func (_m *Role) Node(ctx context.Context) (node *Node, err error) {
-	node = &Node{
-		ID:     _m.ID,
-		Type:   "Role",
-		Fields: make([]*Field, 6),
-		Edges:  make([]*Edge, 3),
+	node.Fields[22] = &Field{
+		Type:  "float64",
+		Name:  "total_cost",
+		Value: string(buf),
 	}
-	var buf []byte
-	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
+	if buf, err = json.Marshal(_m.CostItems); err != nil {
 		return nil, err
 	}
-	node.Fields[0] = &Field{
-		Type:  "time.Time",
-		Name:  "created_at",
+	node.Fields[23] = &Field{
+		Type:  "[]objects.CostItem",
+		Name:  "cost_items",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
+	if buf, err = json.Marshal(_m.CostPriceReferenceID); err != nil {
 		return nil, err
 	}
-	node.Fields[1] = &Field{
-		Type:  "time.Time",
-		Name:  "updated_at",
+	node.Fields[24] = &Field{
+		Type:  "string",
+		Name:  "cost_price_reference_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Name); err != nil {
+	if buf, err = json.Marshal(_m.Currency); err != nil {
 		return nil, err
 	}
-	node.Fields[2] = &Field{
+	node.Fields[25] = &Field{
 		Type:  "string",
-		Name:  "name",
+		Name:  "currency",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Level); err != nil {
+	if buf, err = json.Marshal(_m.OriginalTotalCost); err != nil {
 		return nil, err
 	}
-	node.Fields[3] = &Field{
-		Type:  "role.Level",
-		Name:  "level",
+	node.Fields[26] = &Field{
+		Type:  "float64",
+		Name:  "original_total_cost",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ProjectID); err != nil {
+	if buf, err = json.Marshal(_m.OriginalCurrency); err != nil {
 		return nil, err
 	}
-	node.Fields[4] = &Field{
-		Type:  "int",
-		Name:  "project_id",
+	node.Fields[27] = &Field{
+		Type:  "string",
+		Name:  "original_currency",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Scopes); err != nil {
+	if buf, err = json.Marshal(_m.ExchangeRate); err != nil {
 		return nil, err
 	}
-	node.Fields[5] = &Field{
-		Type:  "[]string",
-		Name:  "scopes",
+	node.Fields[28] = &Field{
+		Type:  "float64",
+		Name:  "exchange_rate",
 		Value: string(buf),
 	}
 	node.Edges[0] = &Edge{
-		Type: "User",
-		Name: "users",
+		Type: "Request",
+		Name: "request",
 	}
-	err = _m.QueryUsers().
-		Select(user.FieldID).
+	err = _m.QueryRequest().
+		Select(request.FieldID).
 		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
 		return nil, err
@@ -2129,11 +3304,11 @@ func (_m *Role) Node(ctx context.Context) (node *Node, err error) {
 		return nil, err
 	}
 	node.Edges[2] = &Edge{
-		Type: "UserRole",
-		Name: "user_roles",
+		Type: "Channel",
+		Name: "channel",
 	}
-	err = _m.QueryUserRoles().
-		Select(userrole.FieldID).
+	err = _m.QueryChannel().
+		Select(channel.FieldID).
 		Scan(ctx, &node.Edges[2].IDs)
 	if err != nil {
 		return nil, err
@@ -2142,12 +3317,12 @@ func (_m *Role) Node(ctx context.Context) (node *Node, err error) {
 }
 
 // Node implements Noder interface
-func (_m *System) Node(ctx context.Context) (node *Node, err error) {
+func (_m *User) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     _m.ID,
-		Type:   "System",
-		Fields: make([]*Field, 4),
-		Edges:  make([]*Edge, 0),
+		Type:   "User",
+		Fields: make([]*Field, 11),
+		Edges:  make([]*Edge, 7),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
@@ -2166,194 +3341,158 @@ func (_m *System) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "updated_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Key); err != nil {
+	if buf, err = json.Marshal(_m.Email); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
 		Type:  "string",
-		Name:  "key",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(_m.Value); err != nil {
-		return nil, err
-	}
-	node.Fields[3] = &Field{
-		Type:  "string",
-		Name:  "value",
-		Value: string(buf),
-	}
-	return node, nil
-}
-
-// Node implements Noder interface
-func (_m *Thread) Node(ctx context.Context) (node *Node, err error) {
-	node = &Node{
-		ID:     _m.ID,
-		Type:   "Thread",
-		Fields: make([]*Field, 5),
-		Edges:  make([]*Edge, 2),
-	}
-	var buf []byte
-	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
-		return nil, err
-	}
-	node.Fields[0] = &Field{
-		Type:  "time.Time",
-		Name:  "created_at",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
-		return nil, err
-	}
-	node.Fields[1] = &Field{
-		Type:  "time.Time",
-		Name:  "updated_at",
-		Value: string(buf),
-	}
-	if buf, err = json.Marshal(_m.ProjectID); err != nil {
-		return nil, err
-	}
-	node.Fields[2] = &Field{
-		Type:  "int",
-		Name:  "project_id",
+		Name:  "email",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ThreadID); err != nil {
+	if buf, err = json.Marshal(_m.Status); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
-		Type:  "string",
-		Name:  "thread_id",
+		Type:  "user.Status",
+		Name:  "status",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Status); err != nil {
+	if buf, err = json.Marshal(_m.PreferLanguage); err != nil {
 		return nil, err
 	}
 	node.Fields[4] = &Field{
-		Type:  "thread.Status",
-		Name:  "status",
+		Type:  "string",
+		Name:  "prefer_language",
 		Value: string(buf),
 	}
-	node.Edges[0] = &Edge{
-		Type: "Project",
-		Name: "project",
-	}
-	err = _m.QueryProject().
-		Select(project.FieldID).
-		Scan(ctx, &node.Edges[0].IDs)
-	if err != nil {
-		return nil, err
-	}
-	node.Edges[1] = &Edge{
-		Type: "Trace",
-		Name: "traces",
-	}
-	err = _m.QueryTraces().
-		Select(trace.FieldID).
-		Scan(ctx, &node.Edges[1].IDs)
-	if err != nil {
-		return nil, err
-	}
-	return node, nil
-}
-
-// Node implements Noder interface
-func (_m *Trace) Node(ctx context.Context) (node *Node, err error) {
-	node = &Node{
-		ID:     _m.ID,
-		Type:   "Trace",
-		Fields: make([]*Field, 6),
-		Edges:  make([]*Edge, 3),
-	}
-	var buf []byte
-	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
+	if buf, err = json.Marshal(_m.Password); err != nil {
 		return nil, err
 	}
-	node.Fields[0] = &Field{
-		Type:  "time.Time",
-		Name:  "created_at",
+	node.Fields[5] = &Field{
+		Type:  "string",
+		Name:  "password",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
+	if buf, err = json.Marshal(_m.FirstName); err != nil {
 		return nil, err
 	}
-	node.Fields[1] = &Field{
-		Type:  "time.Time",
-		Name:  "updated_at",
+	node.Fields[6] = &Field{
+		Type:  "string",
+		Name:  "first_name",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ProjectID); err != nil {
+	if buf, err = json.Marshal(_m.LastName); err != nil {
 		return nil, err
 	}
-	node.Fields[2] = &Field{
-		Type:  "int",
-		Name:  "project_id",
+	node.Fields[7] = &Field{
+		Type:  "string",
+		Name:  "last_name",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.TraceID); err != nil {
+	if buf, err = json.Marshal(_m.Avatar); err != nil {
 		return nil, err
 	}
-	node.Fields[3] = &Field{
+	node.Fields[8] = &Field{
 		Type:  "string",
-		Name:  "trace_id",
+		Name:  "avatar",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ThreadID); err != nil {
+	if buf, err = json.Marshal(_m.IsOwner); err != nil {
 		return nil, err
 	}
-	node.Fields[4] = &Field{
-		Type:  "int",
-		Name:  "thread_id",
+	node.Fields[9] = &Field{
+		Type:  "bool",
+		Name:  "is_owner",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Status); err != nil {
+	if buf, err = json.Marshal(_m.Scopes); err != nil {
 		return nil, err
 	}
-	node.Fields[5] = &Field{
-		Type:  "trace.Status",
-		Name:  "status",
+	node.Fields[10] = &Field{
+		Type:  "[]string",
+		Name:  "scopes",
 		Value: string(buf),
 	}
 	node.Edges[0] = &Edge{
 		Type: "Project",
-		Name: "project",
+		Name: "projects",
 	}
-	err = _m.QueryProject().
+	err = _m.QueryProjects().
 		Select(project.FieldID).
 		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
 		return nil, err
 	}
 	node.Edges[1] = &Edge{
-		Type: "Thread",
-		Name: "thread",
+		Type: "APIKey",
+		Name: "api_keys",
 	}
-	err = _m.QueryThread().
-		Select(thread.FieldID).
+	err = _m.QueryAPIKeys().
+		Select(apikey.FieldID).
 		Scan(ctx, &node.Edges[1].IDs)
 	if err != nil {
 		return nil, err
 	}
 	node.Edges[2] = &Edge{
-		Type: "Request",
-		Name: "requests",
+		Type: "Role",
+		Name: "roles",
 	}
-	err = _m.QueryRequests().
-		Select(request.FieldID).
+	err = _m.QueryRoles().
+		Select(role.FieldID).
 		Scan(ctx, &node.Edges[2].IDs)
 	if err != nil {
 		return nil, err
 	}
+	node.Edges[3] = &Edge{
+		Type: "ChannelOverrideTemplate",
+		Name: "channel_override_templates",
+	}
+	err = _m.QueryChannelOverrideTemplates().
+		Select(channeloverridetemplate.FieldID).
+		Scan(ctx, &node.Edges[3].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[4] = &Edge{
+		Type: "OIDCIdentity",
+		Name: "oidc_identities",
+	}
+	err = _m.QueryOidcIdentities().
+		Select(oidcidentity.FieldID).
+		Scan(ctx, &node.Edges[4].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[5] = &Edge{
+		Type: "UserProject",
+		Name: "project_users",
+	}
+	err = _m.QueryProjectUsers().
+		Select(userproject.FieldID).
+		Scan(ctx, &node.Edges[5].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[6] = &Edge{
+		Type: "UserRole",
+		Name: "user_roles",
+	}
+	err = _m.QueryUserRoles().
+		Select(userrole.FieldID).
+		Scan(ctx, &node.Edges[6].IDs)
+	if err != nil {
+		return nil, err
+	}
 	return node, nil
 }
 
 // Node implements Noder interface
-func (_m *UsageLog) Node(ctx context.Context) (node *Node, err error) {
+func (_m *UserProject) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     _m.ID,
-		Type:   "UsageLog",
-		Fields: make([]*Field, 24),
-		Edges:  make([]*Edge, 3),
+		Type:   "UserProject",
+		Fields: make([]*Field, 6),
+		Edges:  make([]*Edge, 2),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
@@ -2372,222 +3511,228 @@ func (_m *UsageLog) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "updated_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.RequestID); err != nil {
+	if buf, err = json.Marshal(_m.UserID); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
 		Type:  "int",
-		Name:  "request_id",
+		Name:  "user_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.APIKeyID); err != nil {
+	if buf, err = json.Marshal(_m.ProjectID); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
 		Type:  "int",
-		Name:  "api_key_id",
+		Name:  "project_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ProjectID); err != nil {
+	if buf, err = json.Marshal(_m.IsOwner); err != nil {
 		return nil, err
 	}
 	node.Fields[4] = &Field{
-		Type:  "int",
-		Name:  "project_id",
+		Type:  "bool",
+		Name:  "is_owner",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ChannelID); err != nil {
+	if buf, err = json.Marshal(_m.Scopes); err != nil {
 		return nil, err
 	}
 	node.Fields[5] = &Field{
-		Type:  "int",
-		Name:  "channel_id",
+		Type:  "[]string",
+		Name:  "scopes",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ModelID); err != nil {
-		return nil, err
-	}
-	node.Fields[6] = &Field{
-		Type:  "string",
-		Name:  "model_id",
-		Value: string(buf),
+	node.Edges[0] = &Edge{
+		Type: "User",
+		Name: "user",
 	}
-	if buf, err = json.Marshal(_m.PromptTokens); err != nil {
+	err = _m.QueryUser().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
 		return nil, err
 	}
-	node.Fields[7] = &Field{
-		Type:  "int64",
-		Name:  "prompt_tokens",
-		Value: string(buf),
+	node.Edges[1] = &Edge{
+		Type: "Project",
+		Name: "project",
 	}
-	if buf, err = json.Marshal(_m.CompletionTokens); err != nil {
+	err = _m.QueryProject().
+		Select(project.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
 		return nil, err
 	}
-	node.Fields[8] = &Field{
-		Type:  "int64",
-		Name:  "completion_tokens",
-		Value: string(buf),
+	return node, nil
+}
+
+// Node implements Noder interface
+func (_m *UserRole) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     _m.ID,
+		Type:   "UserRole",
+		Fields: make([]*Field, 4),
+		Edges:  make([]*Edge, 2),
 	}
-	if buf, err = json.Marshal(_m.TotalTokens); err != nil {
+	var buf []byte
+	if buf, err = json.Marshal(_m.UserID); err != nil {
 		return nil, err
 	}
-	node.Fields[9] = &Field{
-		Type:  "int64",
-		Name:  "total_tokens",
+	node.Fields[0] = &Field{
+		Type:  "int",
+		Name:  "user_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.PromptAudioTokens); err != nil {
+	if buf, err = json.Marshal(_m.RoleID); err != nil {
 		return nil, err
 	}
-	node.Fields[10] = &Field{
-		Type:  "int64",
-		Name:  "prompt_audio_tokens",
+	node.Fields[1] = &Field{
+		Type:  "int",
+		Name:  "role_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.PromptCachedTokens); err != nil {
+	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
 		return nil, err
 	}
-	node.Fields[11] = &Field{
-		Type:  "int64",
-		Name:  "prompt_cached_tokens",
+	node.Fields[2] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.PromptWriteCachedTokens); err != nil {
+	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
 		return nil, err
 	}
-	node.Fields[12] = &Field{
-		Type:  "int64",
-		Name:  "prompt_write_cached_tokens",
+	node.Fields[3] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.PromptWriteCachedTokens5m); err != nil {
-		return nil, err
-	}
-	node.Fields[13] = &Field{
-		Type:  "int64",
-		Name:  "prompt_write_cached_tokens_5m",
-		Value: string(buf),
+	node.Edges[0] = &Edge{
+		Type: "User",
+		Name: "user",
 	}
-	if buf, err = json.Marshal(_m.PromptWriteCachedTokens1h); err != nil {
+	err = _m.QueryUser().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
 		return nil, err
 	}
-	node.Fields[14] = &Field{
-		Type:  "int64",
-		Name:  "prompt_write_cached_tokens_1h",
-		Value: string(buf),
+	node.Edges[1] = &Edge{
+		Type: "Role",
+		Name: "role",
 	}
-	if buf, err = json.Marshal(_m.CompletionAudioTokens); err != nil {
+	err = _m.QueryRole().
+		Select(role.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
 		return nil, err
 	}
-	node.Fields[15] = &Field{
-		Type:  "int64",
-		Name:  "completion_audio_tokens",
-		Value: string(buf),
+	return node, nil
+}
+
+// Node implements Noder interface
+func (_m *Wallet) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     _m.ID,
+		Type:   "Wallet",
+		Fields: make([]*Field, 8),
+		Edges:  make([]*Edge, 2),
 	}
-	if buf, err = json.Marshal(_m.CompletionReasoningTokens); err != nil {
+	var buf []byte
+	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
 		return nil, err
 	}
-	node.Fields[16] = &Field{
-		Type:  "int64",
-		Name:  "completion_reasoning_tokens",
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.CompletionAcceptedPredictionTokens); err != nil {
+	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
 		return nil, err
 	}
-	node.Fields[17] = &Field{
-		Type:  "int64",
-		Name:  "completion_accepted_prediction_tokens",
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "updated_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.CompletionRejectedPredictionTokens); err != nil {
+	if buf, err = json.Marshal(_m.APIKeyID); err != nil {
 		return nil, err
 	}
-	node.Fields[18] = &Field{
-		Type:  "int64",
-		Name:  "completion_rejected_prediction_tokens",
+	node.Fields[2] = &Field{
+		Type:  "int",
+		Name:  "api_key_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Source); err != nil {
+	if buf, err = json.Marshal(_m.ProjectID); err != nil {
 		return nil, err
 	}
-	node.Fields[19] = &Field{
-		Type:  "usagelog.Source",
-		Name:  "source",
+	node.Fields[3] = &Field{
+		Type:  "int",
+		Name:  "project_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Format); err != nil {
+	if buf, err = json.Marshal(_m.Balance); err != nil {
 		return nil, err
 	}
-	node.Fields[20] = &Field{
-		Type:  "string",
-		Name:  "format",
+	node.Fields[4] = &Field{
+		Type:  "float64",
+		Name:  "balance",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.TotalCost); err != nil {
+	if buf, err = json.Marshal(_m.Reserved); err != nil {
 		return nil, err
 	}
-	node.Fields[21] = &Field{
+	node.Fields[5] = &Field{
 		Type:  "float64",
-		Name:  "total_cost",
+		Name:  "reserved",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.CostItems); err != nil {
+	if buf, err = json.Marshal(_m.Status); err != nil {
 		return nil, err
 	}
-	node.Fields[22] = &Field{
-		Type:  "[]objects.CostItem",
-		Name:  "cost_items",
+	node.Fields[6] = &Field{
+		Type:  "wallet.Status",
+		Name:  "status",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.CostPriceReferenceID); err != nil {
+	if buf, err = json.Marshal(_m.LowBalanceThreshold); err != nil {
 		return nil, err
 	}
-	node.Fields[23] = &Field{
-		Type:  "string",
-		Name:  "cost_price_reference_id",
+	node.Fields[7] = &Field{
+		Type:  "float64",
+		Name:  "low_balance_threshold",
 		Value: string(buf),
 	}
 	node.Edges[0] = &Edge{
-		Type: "Request",
-		Name: "request",
+		Type: "APIKey",
+		Name: "api_key",
 	}
-	err = _m.QueryRequest().
-		Select(request.FieldID).
+	err = _m.QueryAPIKey().
+		Select(apikey.FieldID).
 		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
 		return nil, err
 	}
 	node.Edges[1] = &Edge{
-		Type: "Project",
-		Name: "project",
+		Type: "WalletTransaction",
+		Name: "transactions",
 	}
-	err = _m.QueryProject().
-		Select(project.FieldID).
+	err = _m.QueryTransactions().
+		Select(wallettransaction.FieldID).
 		Scan(ctx, &node.Edges[1].IDs)
 	if err != nil {
 		return nil, err
 	}
-	node.Edges[2] = &Edge{
-		Type: "Channel",
-		Name: "channel",
-	}
-	err = _m.QueryChannel().
-		Select(channel.FieldID).
-		Scan(ctx, &node.Edges[2].IDs)
-	if err != nil {
-		return nil, err
-	}
 	return node, nil
 }
 
 // Node implements Noder interface
-func (_m *User) Node(ctx context.Context) (node *Node, err error) {
+func (_m *WalletTransaction) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     _m.ID,
-		Type:   "User",
-		Fields: make([]*Field, 11),
-		Edges:  make([]*Edge, 7),
+		Type:   "WalletTransaction",
+		Fields: make([]*Field, 12),
+		Edges:  make([]*Edge, 1),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
@@ -2606,145 +3751,93 @@ func (_m *User) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "updated_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Email); err != nil {
+	if buf, err = json.Marshal(_m.WalletID); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
-		Type:  "string",
-		Name:  "email",
+		Type:  "int",
+		Name:  "wallet_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Status); err != nil {
+	if buf, err = json.Marshal(_m.APIKeyID); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
-		Type:  "user.Status",
-		Name:  "status",
+		Type:  "int",
+		Name:  "api_key_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.PreferLanguage); err != nil {
+	if buf, err = json.Marshal(_m.ProjectID); err != nil {
 		return nil, err
 	}
 	node.Fields[4] = &Field{
-		Type:  "string",
-		Name:  "prefer_language",
+		Type:  "int",
+		Name:  "project_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Password); err != nil {
+	if buf, err = json.Marshal(_m.Type); err != nil {
 		return nil, err
 	}
 	node.Fields[5] = &Field{
-		Type:  "string",
-		Name:  "password",
+		Type:  "wallettransaction.Type",
+		Name:  "type",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.FirstName); err != nil {
+	if buf, err = json.Marshal(_m.Amount); err != nil {
 		return nil, err
 	}
 	node.Fields[6] = &Field{
-		Type:  "string",
-		Name:  "first_name",
+		Type:  "float64",
+		Name:  "amount",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.LastName); err != nil {
+	if buf, err = json.Marshal(_m.BalanceAfter); err != nil {
 		return nil, err
 	}
 	node.Fields[7] = &Field{
-		Type:  "string",
-		Name:  "last_name",
+		Type:  "float64",
+		Name:  "balance_after",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Avatar); err != nil {
+	if buf, err = json.Marshal(_m.RequestID); err != nil {
 		return nil, err
 	}
 	node.Fields[8] = &Field{
-		Type:  "string",
-		Name:  "avatar",
+		Type:  "int",
+		Name:  "request_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.IsOwner); err != nil {
+	if buf, err = json.Marshal(_m.UsageLogID); err != nil {
 		return nil, err
 	}
 	node.Fields[9] = &Field{
-		Type:  "bool",
-		Name:  "is_owner",
+		Type:  "int",
+		Name:  "usage_log_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Scopes); err != nil {
+	if buf, err = json.Marshal(_m.UserID); err != nil {
 		return nil, err
 	}
 	node.Fields[10] = &Field{
-		Type:  "[]string",
-		Name:  "scopes",
+		Type:  "int",
+		Name:  "user_id",
 		Value: string(buf),
 	}
-	node.Edges[0] = &Edge{
-		Type: "Project",
-		Name: "projects",
-	}
-	err = _m.QueryProjects().
-		Select(project.FieldID).
-		Scan(ctx, &node.Edges[0].IDs)
-	if err != nil {
-		return nil, err
-	}
-	node.Edges[1] = &Edge{
-		Type: "APIKey",
-		Name: "api_keys",
-	}
-	err = _m.QueryAPIKeys().
-		Select(apikey.FieldID).
-		Scan(ctx, &node.Edges[1].IDs)
-	if err != nil {
-		return nil, err
-	}
-	node.Edges[2] = &Edge{
-		Type: "Role",
-		Name: "roles",
-	}
-	err = _m.QueryRoles().
-		Select(role.FieldID).
-		Scan(ctx, &node.Edges[2].IDs)
-	if err != nil {
-		return nil, err
-	}
-	node.Edges[3] = &Edge{
-		Type: "ChannelOverrideTemplate",
-		Name: "channel_override_templates",
-	}
-	err = _m.QueryChannelOverrideTemplates().
-		Select(channeloverridetemplate.FieldID).
-		Scan(ctx, &node.Edges[3].IDs)
-	if err != nil {
-		return nil, err
-	}
-	node.Edges[4] = &Edge{
-		Type: "OIDCIdentity",
-		Name: "oidc_identities",
-	}
-	err = _m.QueryOidcIdentities().
-		Select(oidcidentity.FieldID).
-		Scan(ctx, &node.Edges[4].IDs)
-	if err != nil {
+	if buf, err = json.Marshal(_m.Description); err != nil {
 		return nil, err
 	}
-	node.Edges[5] = &Edge{
-		Type: "UserProject",
-		Name: "project_users",
-	}
-	err = _m.QueryProjectUsers().
-		Select(userproject.FieldID).
-		Scan(ctx, &node.Edges[5].IDs)
-	if err != nil {
-		return nil, err
+	node.Fields[11] = &Field{
+		Type:  "string",
+		Name:  "description",
+		Value: string(buf),
 	}
-	node.Edges[6] = &Edge{
-		Type: "UserRole",
-		Name: "user_roles",
+	node.Edges[0] = &Edge{
+		Type: "Wallet",
+		Name: "wallet",
 	}
-	err = _m.QueryUserRoles().
-		Select(userrole.FieldID).
-		Scan(ctx, &node.Edges[6].IDs)
+	err = _m.QueryWallet().
+		Select(wallet.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
 		return nil, err
 	}
@@ -2752,12 +3845,12 @@ func (_m *User) Node(ctx context.Context) (node *Node, err error) {
 }
 
 // Node implements Noder interface
-func (_m *UserProject) Node(ctx context.Context) (node *Node, err error) {
+func (_m *WebhookDelivery) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     _m.ID,
-		Type:   "UserProject",
-		Fields: make([]*Field, 6),
-		Edges:  make([]*Edge, 2),
+		Type:   "WebhookDelivery",
+		Fields: make([]*Field, 13),
+		Edges:  make([]*Edge, 0),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
@@ -2776,122 +3869,94 @@ func (_m *UserProject) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "updated_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.UserID); err != nil {
+	if buf, err = json.Marshal(_m.EventID); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
-		Type:  "int",
-		Name:  "user_id",
+		Type:  "string",
+		Name:  "event_id",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.ProjectID); err != nil {
+	if buf, err = json.Marshal(_m.Event); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
-		Type:  "int",
-		Name:  "project_id",
+		Type:  "string",
+		Name:  "event",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.IsOwner); err != nil {
+	if buf, err = json.Marshal(_m.TargetName); err != nil {
 		return nil, err
 	}
 	node.Fields[4] = &Field{
-		Type:  "bool",
-		Name:  "is_owner",
+		Type:  "string",
+		Name:  "target_name",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Scopes); err != nil {
+	if buf, err = json.Marshal(_m.URL); err != nil {
 		return nil, err
 	}
 	node.Fields[5] = &Field{
-		Type:  "[]string",
-		Name:  "scopes",
+		Type:  "string",
+		Name:  "url",
 		Value: string(buf),
 	}
-	node.Edges[0] = &Edge{
-		Type: "User",
-		Name: "user",
-	}
-	err = _m.QueryUser().
-		Select(user.FieldID).
-		Scan(ctx, &node.Edges[0].IDs)
-	if err != nil {
-		return nil, err
-	}
-	node.Edges[1] = &Edge{
-		Type: "Project",
-		Name: "project",
-	}
-	err = _m.QueryProject().
-		Select(project.FieldID).
-		Scan(ctx, &node.Edges[1].IDs)
-	if err != nil {
+	if buf, err = json.Marshal(_m.Payload); err != nil {
 		return nil, err
 	}
-	return node, nil
-}
-
-// Node implements Noder interface
-func (_m *UserRole) Node(ctx context.Context) (node *Node, err error) {
-	node = &Node{
-		ID:     _m.ID,
-		Type:   "UserRole",
-		Fields: make([]*Field, 4),
-		Edges:  make([]*Edge, 2),
+	node.Fields[6] = &Field{
+		Type:  "string",
+		Name:  "payload",
+		Value: string(buf),
 	}
-	var buf []byte
-	if buf, err = json.Marshal(_m.UserID); err != nil {
+	if buf, err = json.Marshal(_m.Status); err != nil {
 		return nil, err
 	}
-	node.Fields[0] = &Field{
-		Type:  "int",
-		Name:  "user_id",
+	node.Fields[7] = &Field{
+		Type:  "webhookdelivery.Status",
+		Name:  "status",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.RoleID); err != nil {
+	if buf, err = json.Marshal(_m.Attempts); err != nil {
 		return nil, err
 	}
-	node.Fields[1] = &Field{
+	node.Fields[8] = &Field{
 		Type:  "int",
-		Name:  "role_id",
+		Name:  "attempts",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.CreatedAt); err != nil {
+	if buf, err = json.Marshal(_m.NextAttemptAt); err != nil {
 		return nil, err
 	}
-	node.Fields[2] = &Field{
+	node.Fields[9] = &Field{
 		Type:  "time.Time",
-		Name:  "created_at",
+		Name:  "next_attempt_at",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.UpdatedAt); err != nil {
+	if buf, err = json.Marshal(_m.LastError); err != nil {
 		return nil, err
 	}
-	node.Fields[3] = &Field{
-		Type:  "time.Time",
-		Name:  "updated_at",
+	node.Fields[10] = &Field{
+		Type:  "string",
+		Name:  "last_error",
 		Value: string(buf),
 	}
-	node.Edges[0] = &Edge{
-		Type: "User",
-		Name: "user",
-	}
-	err = _m.QueryUser().
-		Select(user.FieldID).
-		Scan(ctx, &node.Edges[0].IDs)
-	if err != nil {
+	if buf, err = json.Marshal(_m.ResponseStatus); err != nil {
 		return nil, err
 	}
-	node.Edges[1] = &Edge{
-		Type: "Role",
-		Name: "role",
+	node.Fields[11] = &Field{
+		Type:  "int",
+		Name:  "response_status",
+		Value: string(buf),
 	}
-	err = _m.QueryRole().
-		Select(role.FieldID).
-		Scan(ctx, &node.Edges[1].IDs)
-	if err != nil {
+	if buf, err = json.Marshal(_m.DeliveredAt); err != nil {
 		return nil, err
 	}
+	node.Fields[12] = &Field{
+		Type:  "time.Time",
+		Name:  "delivered_at",
+		Value: string(buf),
+	}
 	return node, nil
 }
 
@@ -16,12 +16,17 @@ import (
 	"github.com/99designs/gqlgen/graphql/errcode"
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/apikeyprofiletemplate"
+	"github.com/looplj/axonhub/internal/ent/auditlog"
+	"github.com/looplj/axonhub/internal/ent/budget"
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/channelmodelprice"
 	"github.com/looplj/axonhub/internal/ent/channelmodelpriceversion"
 	"github.com/looplj/axonhub/internal/ent/channeloverridetemplate"
 	"github.com/looplj/axonhub/internal/ent/channelprobe"
+	"github.com/looplj/axonhub/internal/ent/dataexport"
 	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/exchangerate"
+	"github.com/looplj/axonhub/internal/ent/invoice"
 	"github.com/looplj/axonhub/internal/ent/model"
 	"github.com/looplj/axonhub/internal/ent/oidcidentity"
 	"github.com/looplj/axonhub/internal/ent/project"
@@ -38,6 +43,9 @@ import (
 	"github.com/looplj/axonhub/internal/ent/user"
 	"github.com/looplj/axonhub/internal/ent/userproject"
 	"github.com/looplj/axonhub/internal/ent/userrole"
+	"github.com/looplj/axonhub/internal/ent/wallet"
+	"github.com/looplj/axonhub/internal/ent/wallettransaction"
+	"github.com/looplj/axonhub/internal/ent/webhookdelivery"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -767,20 +775,20 @@ func (_m *APIKeyProfileTemplate) ToEdge(order *APIKeyProfileTemplateOrder) *APIK
 	}
 }
 
-// ChannelEdge is the edge representation of Channel.
-type ChannelEdge struct {
-	Node   *Channel `json:"node"`
-	Cursor Cursor   `json:"cursor"`
+// AuditLogEdge is the edge representation of AuditLog.
+type AuditLogEdge struct {
+	Node   *AuditLog `json:"node"`
+	Cursor Cursor    `json:"cursor"`
 }
 
-// ChannelConnection is the connection containing edges to Channel.
-type ChannelConnection struct {
-	Edges      []*ChannelEdge `json:"edges"`
-	PageInfo   PageInfo       `json:"pageInfo"`
-	TotalCount int            `json:"totalCount"`
+// AuditLogConnection is the connection containing edges to AuditLog.
+type AuditLogConnection struct {
+	Edges      []*AuditLogEdge `json:"edges"`
+	PageInfo   PageInfo        `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
 }
 
-func (c *ChannelConnection) build(nodes []*Channel, pager *channelPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *AuditLogConnection) build(nodes []*AuditLog, pager *auditlogPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -790,21 +798,21 @@ func (c *ChannelConnection) build(nodes []*Channel, pager *channelPager, after *
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Channel
+	var nodeAt func(int) *AuditLog
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Channel {
+		nodeAt = func(i int) *AuditLog {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Channel {
+		nodeAt = func(i int) *AuditLog {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*ChannelEdge, len(nodes))
+	c.Edges = make([]*AuditLogEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &ChannelEdge{
+		c.Edges[i] = &AuditLogEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -818,87 +826,87 @@ func (c *ChannelConnection) build(nodes []*Channel, pager *channelPager, after *
 	}
 }
 
-// ChannelPaginateOption enables pagination customization.
-type ChannelPaginateOption func(*channelPager) error
+// AuditLogPaginateOption enables pagination customization.
+type AuditLogPaginateOption func(*auditlogPager) error
 
-// WithChannelOrder configures pagination ordering.
-func WithChannelOrder(order *ChannelOrder) ChannelPaginateOption {
+// WithAuditLogOrder configures pagination ordering.
+func WithAuditLogOrder(order *AuditLogOrder) AuditLogPaginateOption {
 	if order == nil {
-		order = DefaultChannelOrder
+		order = DefaultAuditLogOrder
 	}
 	o := *order
-	return func(pager *channelPager) error {
+	return func(pager *auditlogPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultChannelOrder.Field
+			o.Field = DefaultAuditLogOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithChannelFilter configures pagination filter.
-func WithChannelFilter(filter func(*ChannelQuery) (*ChannelQuery, error)) ChannelPaginateOption {
-	return func(pager *channelPager) error {
+// WithAuditLogFilter configures pagination filter.
+func WithAuditLogFilter(filter func(*AuditLogQuery) (*AuditLogQuery, error)) AuditLogPaginateOption {
+	return func(pager *auditlogPager) error {
 		if filter == nil {
-			return errors.New("ChannelQuery filter cannot be nil")
+			return errors.New("AuditLogQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type channelPager struct {
+type auditlogPager struct {
 	reverse bool
-	order   *ChannelOrder
-	filter  func(*ChannelQuery) (*ChannelQuery, error)
+	order   *AuditLogOrder
+	filter  func(*AuditLogQuery) (*AuditLogQuery, error)
 }
 
-func newChannelPager(opts []ChannelPaginateOption, reverse bool) (*channelPager, error) {
-	pager := &channelPager{reverse: reverse}
+func newAuditLogPager(opts []AuditLogPaginateOption, reverse bool) (*auditlogPager, error) {
+	pager := &auditlogPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultChannelOrder
+		pager.order = DefaultAuditLogOrder
 	}
 	return pager, nil
 }
 
-func (p *channelPager) applyFilter(query *ChannelQuery) (*ChannelQuery, error) {
+func (p *auditlogPager) applyFilter(query *AuditLogQuery) (*AuditLogQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *channelPager) toCursor(_m *Channel) Cursor {
+func (p *auditlogPager) toCursor(_m *AuditLog) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *channelPager) applyCursors(query *ChannelQuery, after, before *Cursor) (*ChannelQuery, error) {
+func (p *auditlogPager) applyCursors(query *AuditLogQuery, after, before *Cursor) (*AuditLogQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultChannelOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultAuditLogOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *channelPager) applyOrder(query *ChannelQuery) *ChannelQuery {
+func (p *auditlogPager) applyOrder(query *AuditLogQuery) *AuditLogQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultChannelOrder.Field {
-		query = query.Order(DefaultChannelOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultAuditLogOrder.Field {
+		query = query.Order(DefaultAuditLogOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -906,7 +914,7 @@ func (p *channelPager) applyOrder(query *ChannelQuery) *ChannelQuery {
 	return query
 }
 
-func (p *channelPager) orderExpr(query *ChannelQuery) sql.Querier {
+func (p *auditlogPager) orderExpr(query *AuditLogQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -916,28 +924,28 @@ func (p *channelPager) orderExpr(query *ChannelQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultChannelOrder.Field {
-			b.Comma().Ident(DefaultChannelOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultAuditLogOrder.Field {
+			b.Comma().Ident(DefaultAuditLogOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Channel.
-func (_m *ChannelQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to AuditLog.
+func (_m *AuditLogQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ChannelPaginateOption,
-) (*ChannelConnection, error) {
+	before *Cursor, last *int, opts ...AuditLogPaginateOption,
+) (*AuditLogConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newChannelPager(opts, last != nil)
+	pager, err := newAuditLogPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &ChannelConnection{Edges: []*ChannelEdge{}}
+	conn := &AuditLogConnection{Edges: []*AuditLogEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -976,197 +984,107 @@ func (_m *ChannelQuery) Paginate(
 }
 
 var (
-	// ChannelOrderFieldCreatedAt orders Channel by created_at.
-	ChannelOrderFieldCreatedAt = &ChannelOrderField{
-		Value: func(_m *Channel) (ent.Value, error) {
+	// AuditLogOrderFieldCreatedAt orders AuditLog by created_at.
+	AuditLogOrderFieldCreatedAt = &AuditLogOrderField{
+		Value: func(_m *AuditLog) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: channel.FieldCreatedAt,
-		toTerm: channel.ByCreatedAt,
-		toCursor: func(_m *Channel) Cursor {
+		column: auditlog.FieldCreatedAt,
+		toTerm: auditlog.ByCreatedAt,
+		toCursor: func(_m *AuditLog) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// ChannelOrderFieldUpdatedAt orders Channel by updated_at.
-	ChannelOrderFieldUpdatedAt = &ChannelOrderField{
-		Value: func(_m *Channel) (ent.Value, error) {
-			return _m.UpdatedAt, nil
-		},
-		column: channel.FieldUpdatedAt,
-		toTerm: channel.ByUpdatedAt,
-		toCursor: func(_m *Channel) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.UpdatedAt,
-			}
-		},
-	}
-	// ChannelOrderFieldType orders Channel by type.
-	ChannelOrderFieldType = &ChannelOrderField{
-		Value: func(_m *Channel) (ent.Value, error) {
-			return _m.Type, nil
-		},
-		column: channel.FieldType,
-		toTerm: channel.ByType,
-		toCursor: func(_m *Channel) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Type,
-			}
-		},
-	}
-	// ChannelOrderFieldName orders Channel by name.
-	ChannelOrderFieldName = &ChannelOrderField{
-		Value: func(_m *Channel) (ent.Value, error) {
-			return _m.Name, nil
-		},
-		column: channel.FieldName,
-		toTerm: channel.ByName,
-		toCursor: func(_m *Channel) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Name,
-			}
-		},
-	}
-	// ChannelOrderFieldStatus orders Channel by status.
-	ChannelOrderFieldStatus = &ChannelOrderField{
-		Value: func(_m *Channel) (ent.Value, error) {
-			return _m.Status, nil
-		},
-		column: channel.FieldStatus,
-		toTerm: channel.ByStatus,
-		toCursor: func(_m *Channel) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Status,
-			}
-		},
-	}
-	// ChannelOrderFieldOrderingWeight orders Channel by ordering_weight.
-	ChannelOrderFieldOrderingWeight = &ChannelOrderField{
-		Value: func(_m *Channel) (ent.Value, error) {
-			return _m.OrderingWeight, nil
-		},
-		column: channel.FieldOrderingWeight,
-		toTerm: channel.ByOrderingWeight,
-		toCursor: func(_m *Channel) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.OrderingWeight,
-			}
-		},
-	}
 )
 
 // String implement fmt.Stringer interface.
-func (f ChannelOrderField) String() string {
+func (f AuditLogOrderField) String() string {
 	var str string
 	switch f.column {
-	case ChannelOrderFieldCreatedAt.column:
+	case AuditLogOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case ChannelOrderFieldUpdatedAt.column:
-		str = "UPDATED_AT"
-	case ChannelOrderFieldType.column:
-		str = "TYPE"
-	case ChannelOrderFieldName.column:
-		str = "NAME"
-	case ChannelOrderFieldStatus.column:
-		str = "STATUS"
-	case ChannelOrderFieldOrderingWeight.column:
-		str = "ORDERING_WEIGHT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f ChannelOrderField) MarshalGQL(w io.Writer) {
+func (f AuditLogOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *ChannelOrderField) UnmarshalGQL(v interface{}) error {
+func (f *AuditLogOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("ChannelOrderField %T must be a string", v)
+		return fmt.Errorf("AuditLogOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *ChannelOrderFieldCreatedAt
-	case "UPDATED_AT":
-		*f = *ChannelOrderFieldUpdatedAt
-	case "TYPE":
-		*f = *ChannelOrderFieldType
-	case "NAME":
-		*f = *ChannelOrderFieldName
-	case "STATUS":
-		*f = *ChannelOrderFieldStatus
-	case "ORDERING_WEIGHT":
-		*f = *ChannelOrderFieldOrderingWeight
+		*f = *AuditLogOrderFieldCreatedAt
 	default:
-		return fmt.Errorf("%s is not a valid ChannelOrderField", str)
+		return fmt.Errorf("%s is not a valid AuditLogOrderField", str)
 	}
 	return nil
 }
 
-// ChannelOrderField defines the ordering field of Channel.
-type ChannelOrderField struct {
-	// Value extracts the ordering value from the given Channel.
-	Value    func(*Channel) (ent.Value, error)
+// AuditLogOrderField defines the ordering field of AuditLog.
+type AuditLogOrderField struct {
+	// Value extracts the ordering value from the given AuditLog.
+	Value    func(*AuditLog) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) channel.OrderOption
-	toCursor func(*Channel) Cursor
+	toTerm   func(...sql.OrderTermOption) auditlog.OrderOption
+	toCursor func(*AuditLog) Cursor
 }
 
-// ChannelOrder defines the ordering of Channel.
-type ChannelOrder struct {
-	Direction OrderDirection     `json:"direction"`
-	Field     *ChannelOrderField `json:"field"`
+// AuditLogOrder defines the ordering of AuditLog.
+type AuditLogOrder struct {
+	Direction OrderDirection      `json:"direction"`
+	Field     *AuditLogOrderField `json:"field"`
 }
 
-// DefaultChannelOrder is the default ordering of Channel.
-var DefaultChannelOrder = &ChannelOrder{
+// DefaultAuditLogOrder is the default ordering of AuditLog.
+var DefaultAuditLogOrder = &AuditLogOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &ChannelOrderField{
-		Value: func(_m *Channel) (ent.Value, error) {
+	Field: &AuditLogOrderField{
+		Value: func(_m *AuditLog) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: channel.FieldID,
-		toTerm: channel.ByID,
-		toCursor: func(_m *Channel) Cursor {
+		column: auditlog.FieldID,
+		toTerm: auditlog.ByID,
+		toCursor: func(_m *AuditLog) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Channel into ChannelEdge.
-func (_m *Channel) ToEdge(order *ChannelOrder) *ChannelEdge {
+// ToEdge converts AuditLog into AuditLogEdge.
+func (_m *AuditLog) ToEdge(order *AuditLogOrder) *AuditLogEdge {
 	if order == nil {
-		order = DefaultChannelOrder
+		order = DefaultAuditLogOrder
 	}
-	return &ChannelEdge{
+	return &AuditLogEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// ChannelModelPriceEdge is the edge representation of ChannelModelPrice.
-type ChannelModelPriceEdge struct {
-	Node   *ChannelModelPrice `json:"node"`
-	Cursor Cursor             `json:"cursor"`
+// BudgetEdge is the edge representation of Budget.
+type BudgetEdge struct {
+	Node   *Budget `json:"node"`
+	Cursor Cursor  `json:"cursor"`
 }
 
-// ChannelModelPriceConnection is the connection containing edges to ChannelModelPrice.
-type ChannelModelPriceConnection struct {
-	Edges      []*ChannelModelPriceEdge `json:"edges"`
-	PageInfo   PageInfo                 `json:"pageInfo"`
-	TotalCount int                      `json:"totalCount"`
+// BudgetConnection is the connection containing edges to Budget.
+type BudgetConnection struct {
+	Edges      []*BudgetEdge `json:"edges"`
+	PageInfo   PageInfo      `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
 }
 
-func (c *ChannelModelPriceConnection) build(nodes []*ChannelModelPrice, pager *channelmodelpricePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *BudgetConnection) build(nodes []*Budget, pager *budgetPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -1176,21 +1094,21 @@ func (c *ChannelModelPriceConnection) build(nodes []*ChannelModelPrice, pager *c
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *ChannelModelPrice
+	var nodeAt func(int) *Budget
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *ChannelModelPrice {
+		nodeAt = func(i int) *Budget {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *ChannelModelPrice {
+		nodeAt = func(i int) *Budget {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*ChannelModelPriceEdge, len(nodes))
+	c.Edges = make([]*BudgetEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &ChannelModelPriceEdge{
+		c.Edges[i] = &BudgetEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1204,87 +1122,87 @@ func (c *ChannelModelPriceConnection) build(nodes []*ChannelModelPrice, pager *c
 	}
 }
 
-// ChannelModelPricePaginateOption enables pagination customization.
-type ChannelModelPricePaginateOption func(*channelmodelpricePager) error
+// BudgetPaginateOption enables pagination customization.
+type BudgetPaginateOption func(*budgetPager) error
 
-// WithChannelModelPriceOrder configures pagination ordering.
-func WithChannelModelPriceOrder(order *ChannelModelPriceOrder) ChannelModelPricePaginateOption {
+// WithBudgetOrder configures pagination ordering.
+func WithBudgetOrder(order *BudgetOrder) BudgetPaginateOption {
 	if order == nil {
-		order = DefaultChannelModelPriceOrder
+		order = DefaultBudgetOrder
 	}
 	o := *order
-	return func(pager *channelmodelpricePager) error {
+	return func(pager *budgetPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultChannelModelPriceOrder.Field
+			o.Field = DefaultBudgetOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithChannelModelPriceFilter configures pagination filter.
-func WithChannelModelPriceFilter(filter func(*ChannelModelPriceQuery) (*ChannelModelPriceQuery, error)) ChannelModelPricePaginateOption {
-	return func(pager *channelmodelpricePager) error {
+// WithBudgetFilter configures pagination filter.
+func WithBudgetFilter(filter func(*BudgetQuery) (*BudgetQuery, error)) BudgetPaginateOption {
+	return func(pager *budgetPager) error {
 		if filter == nil {
-			return errors.New("ChannelModelPriceQuery filter cannot be nil")
+			return errors.New("BudgetQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type channelmodelpricePager struct {
+type budgetPager struct {
 	reverse bool
-	order   *ChannelModelPriceOrder
-	filter  func(*ChannelModelPriceQuery) (*ChannelModelPriceQuery, error)
+	order   *BudgetOrder
+	filter  func(*BudgetQuery) (*BudgetQuery, error)
 }
 
-func newChannelModelPricePager(opts []ChannelModelPricePaginateOption, reverse bool) (*channelmodelpricePager, error) {
-	pager := &channelmodelpricePager{reverse: reverse}
+func newBudgetPager(opts []BudgetPaginateOption, reverse bool) (*budgetPager, error) {
+	pager := &budgetPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultChannelModelPriceOrder
+		pager.order = DefaultBudgetOrder
 	}
 	return pager, nil
 }
 
-func (p *channelmodelpricePager) applyFilter(query *ChannelModelPriceQuery) (*ChannelModelPriceQuery, error) {
+func (p *budgetPager) applyFilter(query *BudgetQuery) (*BudgetQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *channelmodelpricePager) toCursor(_m *ChannelModelPrice) Cursor {
+func (p *budgetPager) toCursor(_m *Budget) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *channelmodelpricePager) applyCursors(query *ChannelModelPriceQuery, after, before *Cursor) (*ChannelModelPriceQuery, error) {
+func (p *budgetPager) applyCursors(query *BudgetQuery, after, before *Cursor) (*BudgetQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultChannelModelPriceOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultBudgetOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *channelmodelpricePager) applyOrder(query *ChannelModelPriceQuery) *ChannelModelPriceQuery {
+func (p *budgetPager) applyOrder(query *BudgetQuery) *BudgetQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultChannelModelPriceOrder.Field {
-		query = query.Order(DefaultChannelModelPriceOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultBudgetOrder.Field {
+		query = query.Order(DefaultBudgetOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -1292,7 +1210,7 @@ func (p *channelmodelpricePager) applyOrder(query *ChannelModelPriceQuery) *Chan
 	return query
 }
 
-func (p *channelmodelpricePager) orderExpr(query *ChannelModelPriceQuery) sql.Querier {
+func (p *budgetPager) orderExpr(query *BudgetQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -1302,28 +1220,28 @@ func (p *channelmodelpricePager) orderExpr(query *ChannelModelPriceQuery) sql.Qu
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultChannelModelPriceOrder.Field {
-			b.Comma().Ident(DefaultChannelModelPriceOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultBudgetOrder.Field {
+			b.Comma().Ident(DefaultBudgetOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to ChannelModelPrice.
-func (_m *ChannelModelPriceQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Budget.
+func (_m *BudgetQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ChannelModelPricePaginateOption,
-) (*ChannelModelPriceConnection, error) {
+	before *Cursor, last *int, opts ...BudgetPaginateOption,
+) (*BudgetConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newChannelModelPricePager(opts, last != nil)
+	pager, err := newBudgetPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &ChannelModelPriceConnection{Edges: []*ChannelModelPriceEdge{}}
+	conn := &BudgetConnection{Edges: []*BudgetEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -1362,125 +1280,2662 @@ func (_m *ChannelModelPriceQuery) Paginate(
 }
 
 var (
-	// ChannelModelPriceOrderFieldCreatedAt orders ChannelModelPrice by created_at.
-	ChannelModelPriceOrderFieldCreatedAt = &ChannelModelPriceOrderField{
-		Value: func(_m *ChannelModelPrice) (ent.Value, error) {
+	// BudgetOrderFieldCreatedAt orders Budget by created_at.
+	BudgetOrderFieldCreatedAt = &BudgetOrderField{
+		Value: func(_m *Budget) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: channelmodelprice.FieldCreatedAt,
-		toTerm: channelmodelprice.ByCreatedAt,
-		toCursor: func(_m *ChannelModelPrice) Cursor {
+		column: budget.FieldCreatedAt,
+		toTerm: budget.ByCreatedAt,
+		toCursor: func(_m *Budget) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// ChannelModelPriceOrderFieldUpdatedAt orders ChannelModelPrice by updated_at.
-	ChannelModelPriceOrderFieldUpdatedAt = &ChannelModelPriceOrderField{
-		Value: func(_m *ChannelModelPrice) (ent.Value, error) {
+	// BudgetOrderFieldUpdatedAt orders Budget by updated_at.
+	BudgetOrderFieldUpdatedAt = &BudgetOrderField{
+		Value: func(_m *Budget) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: channelmodelprice.FieldUpdatedAt,
-		toTerm: channelmodelprice.ByUpdatedAt,
-		toCursor: func(_m *ChannelModelPrice) Cursor {
+		column: budget.FieldUpdatedAt,
+		toTerm: budget.ByUpdatedAt,
+		toCursor: func(_m *Budget) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
+	// BudgetOrderFieldName orders Budget by name.
+	BudgetOrderFieldName = &BudgetOrderField{
+		Value: func(_m *Budget) (ent.Value, error) {
+			return _m.Name, nil
+		},
+		column: budget.FieldName,
+		toTerm: budget.ByName,
+		toCursor: func(_m *Budget) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Name,
+			}
+		},
+	}
 )
 
 // String implement fmt.Stringer interface.
-func (f ChannelModelPriceOrderField) String() string {
+func (f BudgetOrderField) String() string {
 	var str string
 	switch f.column {
-	case ChannelModelPriceOrderFieldCreatedAt.column:
+	case BudgetOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case ChannelModelPriceOrderFieldUpdatedAt.column:
+	case BudgetOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
+	case BudgetOrderFieldName.column:
+		str = "NAME"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f ChannelModelPriceOrderField) MarshalGQL(w io.Writer) {
+func (f BudgetOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *ChannelModelPriceOrderField) UnmarshalGQL(v interface{}) error {
+func (f *BudgetOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("ChannelModelPriceOrderField %T must be a string", v)
+		return fmt.Errorf("BudgetOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *ChannelModelPriceOrderFieldCreatedAt
+		*f = *BudgetOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *ChannelModelPriceOrderFieldUpdatedAt
+		*f = *BudgetOrderFieldUpdatedAt
+	case "NAME":
+		*f = *BudgetOrderFieldName
+	default:
+		return fmt.Errorf("%s is not a valid BudgetOrderField", str)
+	}
+	return nil
+}
+
+// BudgetOrderField defines the ordering field of Budget.
+type BudgetOrderField struct {
+	// Value extracts the ordering value from the given Budget.
+	Value    func(*Budget) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) budget.OrderOption
+	toCursor func(*Budget) Cursor
+}
+
+// BudgetOrder defines the ordering of Budget.
+type BudgetOrder struct {
+	Direction OrderDirection    `json:"direction"`
+	Field     *BudgetOrderField `json:"field"`
+}
+
+// DefaultBudgetOrder is the default ordering of Budget.
+var DefaultBudgetOrder = &BudgetOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &BudgetOrderField{
+		Value: func(_m *Budget) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: budget.FieldID,
+		toTerm: budget.ByID,
+		toCursor: func(_m *Budget) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts Budget into BudgetEdge.
+func (_m *Budget) ToEdge(order *BudgetOrder) *BudgetEdge {
+	if order == nil {
+		order = DefaultBudgetOrder
+	}
+	return &BudgetEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// ChannelEdge is the edge representation of Channel.
+type ChannelEdge struct {
+	Node   *Channel `json:"node"`
+	Cursor Cursor   `json:"cursor"`
+}
+
+// ChannelConnection is the connection containing edges to Channel.
+type ChannelConnection struct {
+	Edges      []*ChannelEdge `json:"edges"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+func (c *ChannelConnection) build(nodes []*Channel, pager *channelPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Channel
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Channel {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Channel {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*ChannelEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &ChannelEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// ChannelPaginateOption enables pagination customization.
+type ChannelPaginateOption func(*channelPager) error
+
+// WithChannelOrder configures pagination ordering.
+func WithChannelOrder(order *ChannelOrder) ChannelPaginateOption {
+	if order == nil {
+		order = DefaultChannelOrder
+	}
+	o := *order
+	return func(pager *channelPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultChannelOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithChannelFilter configures pagination filter.
+func WithChannelFilter(filter func(*ChannelQuery) (*ChannelQuery, error)) ChannelPaginateOption {
+	return func(pager *channelPager) error {
+		if filter == nil {
+			return errors.New("ChannelQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type channelPager struct {
+	reverse bool
+	order   *ChannelOrder
+	filter  func(*ChannelQuery) (*ChannelQuery, error)
+}
+
+func newChannelPager(opts []ChannelPaginateOption, reverse bool) (*channelPager, error) {
+	pager := &channelPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultChannelOrder
+	}
+	return pager, nil
+}
+
+func (p *channelPager) applyFilter(query *ChannelQuery) (*ChannelQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *channelPager) toCursor(_m *Channel) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *channelPager) applyCursors(query *ChannelQuery, after, before *Cursor) (*ChannelQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultChannelOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *channelPager) applyOrder(query *ChannelQuery) *ChannelQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultChannelOrder.Field {
+		query = query.Order(DefaultChannelOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *channelPager) orderExpr(query *ChannelQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultChannelOrder.Field {
+			b.Comma().Ident(DefaultChannelOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Channel.
+func (_m *ChannelQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ChannelPaginateOption,
+) (*ChannelConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newChannelPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &ChannelConnection{Edges: []*ChannelEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// ChannelOrderFieldCreatedAt orders Channel by created_at.
+	ChannelOrderFieldCreatedAt = &ChannelOrderField{
+		Value: func(_m *Channel) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: channel.FieldCreatedAt,
+		toTerm: channel.ByCreatedAt,
+		toCursor: func(_m *Channel) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+	// ChannelOrderFieldUpdatedAt orders Channel by updated_at.
+	ChannelOrderFieldUpdatedAt = &ChannelOrderField{
+		Value: func(_m *Channel) (ent.Value, error) {
+			return _m.UpdatedAt, nil
+		},
+		column: channel.FieldUpdatedAt,
+		toTerm: channel.ByUpdatedAt,
+		toCursor: func(_m *Channel) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.UpdatedAt,
+			}
+		},
+	}
+	// ChannelOrderFieldType orders Channel by type.
+	ChannelOrderFieldType = &ChannelOrderField{
+		Value: func(_m *Channel) (ent.Value, error) {
+			return _m.Type, nil
+		},
+		column: channel.FieldType,
+		toTerm: channel.ByType,
+		toCursor: func(_m *Channel) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Type,
+			}
+		},
+	}
+	// ChannelOrderFieldName orders Channel by name.
+	ChannelOrderFieldName = &ChannelOrderField{
+		Value: func(_m *Channel) (ent.Value, error) {
+			return _m.Name, nil
+		},
+		column: channel.FieldName,
+		toTerm: channel.ByName,
+		toCursor: func(_m *Channel) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Name,
+			}
+		},
+	}
+	// ChannelOrderFieldStatus orders Channel by status.
+	ChannelOrderFieldStatus = &ChannelOrderField{
+		Value: func(_m *Channel) (ent.Value, error) {
+			return _m.Status, nil
+		},
+		column: channel.FieldStatus,
+		toTerm: channel.ByStatus,
+		toCursor: func(_m *Channel) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Status,
+			}
+		},
+	}
+	// ChannelOrderFieldOrderingWeight orders Channel by ordering_weight.
+	ChannelOrderFieldOrderingWeight = &ChannelOrderField{
+		Value: func(_m *Channel) (ent.Value, error) {
+			return _m.OrderingWeight, nil
+		},
+		column: channel.FieldOrderingWeight,
+		toTerm: channel.ByOrderingWeight,
+		toCursor: func(_m *Channel) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.OrderingWeight,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f ChannelOrderField) String() string {
+	var str string
+	switch f.column {
+	case ChannelOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case ChannelOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	case ChannelOrderFieldType.column:
+		str = "TYPE"
+	case ChannelOrderFieldName.column:
+		str = "NAME"
+	case ChannelOrderFieldStatus.column:
+		str = "STATUS"
+	case ChannelOrderFieldOrderingWeight.column:
+		str = "ORDERING_WEIGHT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f ChannelOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *ChannelOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("ChannelOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *ChannelOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *ChannelOrderFieldUpdatedAt
+	case "TYPE":
+		*f = *ChannelOrderFieldType
+	case "NAME":
+		*f = *ChannelOrderFieldName
+	case "STATUS":
+		*f = *ChannelOrderFieldStatus
+	case "ORDERING_WEIGHT":
+		*f = *ChannelOrderFieldOrderingWeight
+	default:
+		return fmt.Errorf("%s is not a valid ChannelOrderField", str)
+	}
+	return nil
+}
+
+// ChannelOrderField defines the ordering field of Channel.
+type ChannelOrderField struct {
+	// Value extracts the ordering value from the given Channel.
+	Value    func(*Channel) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) channel.OrderOption
+	toCursor func(*Channel) Cursor
+}
+
+// ChannelOrder defines the ordering of Channel.
+type ChannelOrder struct {
+	Direction OrderDirection     `json:"direction"`
+	Field     *ChannelOrderField `json:"field"`
+}
+
+// DefaultChannelOrder is the default ordering of Channel.
+var DefaultChannelOrder = &ChannelOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &ChannelOrderField{
+		Value: func(_m *Channel) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: channel.FieldID,
+		toTerm: channel.ByID,
+		toCursor: func(_m *Channel) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts Channel into ChannelEdge.
+func (_m *Channel) ToEdge(order *ChannelOrder) *ChannelEdge {
+	if order == nil {
+		order = DefaultChannelOrder
+	}
+	return &ChannelEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// ChannelModelPriceEdge is the edge representation of ChannelModelPrice.
+type ChannelModelPriceEdge struct {
+	Node   *ChannelModelPrice `json:"node"`
+	Cursor Cursor             `json:"cursor"`
+}
+
+// ChannelModelPriceConnection is the connection containing edges to ChannelModelPrice.
+type ChannelModelPriceConnection struct {
+	Edges      []*ChannelModelPriceEdge `json:"edges"`
+	PageInfo   PageInfo                 `json:"pageInfo"`
+	TotalCount int                      `json:"totalCount"`
+}
+
+func (c *ChannelModelPriceConnection) build(nodes []*ChannelModelPrice, pager *channelmodelpricePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *ChannelModelPrice
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *ChannelModelPrice {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *ChannelModelPrice {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*ChannelModelPriceEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &ChannelModelPriceEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// ChannelModelPricePaginateOption enables pagination customization.
+type ChannelModelPricePaginateOption func(*channelmodelpricePager) error
+
+// WithChannelModelPriceOrder configures pagination ordering.
+func WithChannelModelPriceOrder(order *ChannelModelPriceOrder) ChannelModelPricePaginateOption {
+	if order == nil {
+		order = DefaultChannelModelPriceOrder
+	}
+	o := *order
+	return func(pager *channelmodelpricePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultChannelModelPriceOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithChannelModelPriceFilter configures pagination filter.
+func WithChannelModelPriceFilter(filter func(*ChannelModelPriceQuery) (*ChannelModelPriceQuery, error)) ChannelModelPricePaginateOption {
+	return func(pager *channelmodelpricePager) error {
+		if filter == nil {
+			return errors.New("ChannelModelPriceQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type channelmodelpricePager struct {
+	reverse bool
+	order   *ChannelModelPriceOrder
+	filter  func(*ChannelModelPriceQuery) (*ChannelModelPriceQuery, error)
+}
+
+func newChannelModelPricePager(opts []ChannelModelPricePaginateOption, reverse bool) (*channelmodelpricePager, error) {
+	pager := &channelmodelpricePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultChannelModelPriceOrder
+	}
+	return pager, nil
+}
+
+func (p *channelmodelpricePager) applyFilter(query *ChannelModelPriceQuery) (*ChannelModelPriceQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *channelmodelpricePager) toCursor(_m *ChannelModelPrice) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *channelmodelpricePager) applyCursors(query *ChannelModelPriceQuery, after, before *Cursor) (*ChannelModelPriceQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultChannelModelPriceOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *channelmodelpricePager) applyOrder(query *ChannelModelPriceQuery) *ChannelModelPriceQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultChannelModelPriceOrder.Field {
+		query = query.Order(DefaultChannelModelPriceOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *channelmodelpricePager) orderExpr(query *ChannelModelPriceQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultChannelModelPriceOrder.Field {
+			b.Comma().Ident(DefaultChannelModelPriceOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to ChannelModelPrice.
+func (_m *ChannelModelPriceQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ChannelModelPricePaginateOption,
+) (*ChannelModelPriceConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newChannelModelPricePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &ChannelModelPriceConnection{Edges: []*ChannelModelPriceEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// ChannelModelPriceOrderFieldCreatedAt orders ChannelModelPrice by created_at.
+	ChannelModelPriceOrderFieldCreatedAt = &ChannelModelPriceOrderField{
+		Value: func(_m *ChannelModelPrice) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: channelmodelprice.FieldCreatedAt,
+		toTerm: channelmodelprice.ByCreatedAt,
+		toCursor: func(_m *ChannelModelPrice) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+	// ChannelModelPriceOrderFieldUpdatedAt orders ChannelModelPrice by updated_at.
+	ChannelModelPriceOrderFieldUpdatedAt = &ChannelModelPriceOrderField{
+		Value: func(_m *ChannelModelPrice) (ent.Value, error) {
+			return _m.UpdatedAt, nil
+		},
+		column: channelmodelprice.FieldUpdatedAt,
+		toTerm: channelmodelprice.ByUpdatedAt,
+		toCursor: func(_m *ChannelModelPrice) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.UpdatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f ChannelModelPriceOrderField) String() string {
+	var str string
+	switch f.column {
+	case ChannelModelPriceOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case ChannelModelPriceOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f ChannelModelPriceOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *ChannelModelPriceOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("ChannelModelPriceOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *ChannelModelPriceOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *ChannelModelPriceOrderFieldUpdatedAt
+	default:
+		return fmt.Errorf("%s is not a valid ChannelModelPriceOrderField", str)
+	}
+	return nil
+}
+
+// ChannelModelPriceOrderField defines the ordering field of ChannelModelPrice.
+type ChannelModelPriceOrderField struct {
+	// Value extracts the ordering value from the given ChannelModelPrice.
+	Value    func(*ChannelModelPrice) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) channelmodelprice.OrderOption
+	toCursor func(*ChannelModelPrice) Cursor
+}
+
+// ChannelModelPriceOrder defines the ordering of ChannelModelPrice.
+type ChannelModelPriceOrder struct {
+	Direction OrderDirection               `json:"direction"`
+	Field     *ChannelModelPriceOrderField `json:"field"`
+}
+
+// DefaultChannelModelPriceOrder is the default ordering of ChannelModelPrice.
+var DefaultChannelModelPriceOrder = &ChannelModelPriceOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &ChannelModelPriceOrderField{
+		Value: func(_m *ChannelModelPrice) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: channelmodelprice.FieldID,
+		toTerm: channelmodelprice.ByID,
+		toCursor: func(_m *ChannelModelPrice) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts ChannelModelPrice into ChannelModelPriceEdge.
+func (_m *ChannelModelPrice) ToEdge(order *ChannelModelPriceOrder) *ChannelModelPriceEdge {
+	if order == nil {
+		order = DefaultChannelModelPriceOrder
+	}
+	return &ChannelModelPriceEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// ChannelModelPriceVersionEdge is the edge representation of ChannelModelPriceVersion.
+type ChannelModelPriceVersionEdge struct {
+	Node   *ChannelModelPriceVersion `json:"node"`
+	Cursor Cursor                    `json:"cursor"`
+}
+
+// ChannelModelPriceVersionConnection is the connection containing edges to ChannelModelPriceVersion.
+type ChannelModelPriceVersionConnection struct {
+	Edges      []*ChannelModelPriceVersionEdge `json:"edges"`
+	PageInfo   PageInfo                        `json:"pageInfo"`
+	TotalCount int                             `json:"totalCount"`
+}
+
+func (c *ChannelModelPriceVersionConnection) build(nodes []*ChannelModelPriceVersion, pager *channelmodelpriceversionPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *ChannelModelPriceVersion
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *ChannelModelPriceVersion {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *ChannelModelPriceVersion {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*ChannelModelPriceVersionEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &ChannelModelPriceVersionEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// ChannelModelPriceVersionPaginateOption enables pagination customization.
+type ChannelModelPriceVersionPaginateOption func(*channelmodelpriceversionPager) error
+
+// WithChannelModelPriceVersionOrder configures pagination ordering.
+func WithChannelModelPriceVersionOrder(order *ChannelModelPriceVersionOrder) ChannelModelPriceVersionPaginateOption {
+	if order == nil {
+		order = DefaultChannelModelPriceVersionOrder
+	}
+	o := *order
+	return func(pager *channelmodelpriceversionPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultChannelModelPriceVersionOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithChannelModelPriceVersionFilter configures pagination filter.
+func WithChannelModelPriceVersionFilter(filter func(*ChannelModelPriceVersionQuery) (*ChannelModelPriceVersionQuery, error)) ChannelModelPriceVersionPaginateOption {
+	return func(pager *channelmodelpriceversionPager) error {
+		if filter == nil {
+			return errors.New("ChannelModelPriceVersionQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type channelmodelpriceversionPager struct {
+	reverse bool
+	order   *ChannelModelPriceVersionOrder
+	filter  func(*ChannelModelPriceVersionQuery) (*ChannelModelPriceVersionQuery, error)
+}
+
+func newChannelModelPriceVersionPager(opts []ChannelModelPriceVersionPaginateOption, reverse bool) (*channelmodelpriceversionPager, error) {
+	pager := &channelmodelpriceversionPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultChannelModelPriceVersionOrder
+	}
+	return pager, nil
+}
+
+func (p *channelmodelpriceversionPager) applyFilter(query *ChannelModelPriceVersionQuery) (*ChannelModelPriceVersionQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *channelmodelpriceversionPager) toCursor(_m *ChannelModelPriceVersion) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *channelmodelpriceversionPager) applyCursors(query *ChannelModelPriceVersionQuery, after, before *Cursor) (*ChannelModelPriceVersionQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultChannelModelPriceVersionOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *channelmodelpriceversionPager) applyOrder(query *ChannelModelPriceVersionQuery) *ChannelModelPriceVersionQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultChannelModelPriceVersionOrder.Field {
+		query = query.Order(DefaultChannelModelPriceVersionOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *channelmodelpriceversionPager) orderExpr(query *ChannelModelPriceVersionQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultChannelModelPriceVersionOrder.Field {
+			b.Comma().Ident(DefaultChannelModelPriceVersionOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to ChannelModelPriceVersion.
+func (_m *ChannelModelPriceVersionQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ChannelModelPriceVersionPaginateOption,
+) (*ChannelModelPriceVersionConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newChannelModelPriceVersionPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &ChannelModelPriceVersionConnection{Edges: []*ChannelModelPriceVersionEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// ChannelModelPriceVersionOrderFieldCreatedAt orders ChannelModelPriceVersion by created_at.
+	ChannelModelPriceVersionOrderFieldCreatedAt = &ChannelModelPriceVersionOrderField{
+		Value: func(_m *ChannelModelPriceVersion) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: channelmodelpriceversion.FieldCreatedAt,
+		toTerm: channelmodelpriceversion.ByCreatedAt,
+		toCursor: func(_m *ChannelModelPriceVersion) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+	// ChannelModelPriceVersionOrderFieldUpdatedAt orders ChannelModelPriceVersion by updated_at.
+	ChannelModelPriceVersionOrderFieldUpdatedAt = &ChannelModelPriceVersionOrderField{
+		Value: func(_m *ChannelModelPriceVersion) (ent.Value, error) {
+			return _m.UpdatedAt, nil
+		},
+		column: channelmodelpriceversion.FieldUpdatedAt,
+		toTerm: channelmodelpriceversion.ByUpdatedAt,
+		toCursor: func(_m *ChannelModelPriceVersion) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.UpdatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f ChannelModelPriceVersionOrderField) String() string {
+	var str string
+	switch f.column {
+	case ChannelModelPriceVersionOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case ChannelModelPriceVersionOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f ChannelModelPriceVersionOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *ChannelModelPriceVersionOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("ChannelModelPriceVersionOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *ChannelModelPriceVersionOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *ChannelModelPriceVersionOrderFieldUpdatedAt
+	default:
+		return fmt.Errorf("%s is not a valid ChannelModelPriceVersionOrderField", str)
+	}
+	return nil
+}
+
+// ChannelModelPriceVersionOrderField defines the ordering field of ChannelModelPriceVersion.
+type ChannelModelPriceVersionOrderField struct {
+	// Value extracts the ordering value from the given ChannelModelPriceVersion.
+	Value    func(*ChannelModelPriceVersion) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) channelmodelpriceversion.OrderOption
+	toCursor func(*ChannelModelPriceVersion) Cursor
+}
+
+// ChannelModelPriceVersionOrder defines the ordering of ChannelModelPriceVersion.
+type ChannelModelPriceVersionOrder struct {
+	Direction OrderDirection                      `json:"direction"`
+	Field     *ChannelModelPriceVersionOrderField `json:"field"`
+}
+
+// DefaultChannelModelPriceVersionOrder is the default ordering of ChannelModelPriceVersion.
+var DefaultChannelModelPriceVersionOrder = &ChannelModelPriceVersionOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &ChannelModelPriceVersionOrderField{
+		Value: func(_m *ChannelModelPriceVersion) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: channelmodelpriceversion.FieldID,
+		toTerm: channelmodelpriceversion.ByID,
+		toCursor: func(_m *ChannelModelPriceVersion) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts ChannelModelPriceVersion into ChannelModelPriceVersionEdge.
+func (_m *ChannelModelPriceVersion) ToEdge(order *ChannelModelPriceVersionOrder) *ChannelModelPriceVersionEdge {
+	if order == nil {
+		order = DefaultChannelModelPriceVersionOrder
+	}
+	return &ChannelModelPriceVersionEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// ChannelOverrideTemplateEdge is the edge representation of ChannelOverrideTemplate.
+type ChannelOverrideTemplateEdge struct {
+	Node   *ChannelOverrideTemplate `json:"node"`
+	Cursor Cursor                   `json:"cursor"`
+}
+
+// ChannelOverrideTemplateConnection is the connection containing edges to ChannelOverrideTemplate.
+type ChannelOverrideTemplateConnection struct {
+	Edges      []*ChannelOverrideTemplateEdge `json:"edges"`
+	PageInfo   PageInfo                       `json:"pageInfo"`
+	TotalCount int                            `json:"totalCount"`
+}
+
+func (c *ChannelOverrideTemplateConnection) build(nodes []*ChannelOverrideTemplate, pager *channeloverridetemplatePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *ChannelOverrideTemplate
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *ChannelOverrideTemplate {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *ChannelOverrideTemplate {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*ChannelOverrideTemplateEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &ChannelOverrideTemplateEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// ChannelOverrideTemplatePaginateOption enables pagination customization.
+type ChannelOverrideTemplatePaginateOption func(*channeloverridetemplatePager) error
+
+// WithChannelOverrideTemplateOrder configures pagination ordering.
+func WithChannelOverrideTemplateOrder(order *ChannelOverrideTemplateOrder) ChannelOverrideTemplatePaginateOption {
+	if order == nil {
+		order = DefaultChannelOverrideTemplateOrder
+	}
+	o := *order
+	return func(pager *channeloverridetemplatePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultChannelOverrideTemplateOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithChannelOverrideTemplateFilter configures pagination filter.
+func WithChannelOverrideTemplateFilter(filter func(*ChannelOverrideTemplateQuery) (*ChannelOverrideTemplateQuery, error)) ChannelOverrideTemplatePaginateOption {
+	return func(pager *channeloverridetemplatePager) error {
+		if filter == nil {
+			return errors.New("ChannelOverrideTemplateQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type channeloverridetemplatePager struct {
+	reverse bool
+	order   *ChannelOverrideTemplateOrder
+	filter  func(*ChannelOverrideTemplateQuery) (*ChannelOverrideTemplateQuery, error)
+}
+
+func newChannelOverrideTemplatePager(opts []ChannelOverrideTemplatePaginateOption, reverse bool) (*channeloverridetemplatePager, error) {
+	pager := &channeloverridetemplatePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultChannelOverrideTemplateOrder
+	}
+	return pager, nil
+}
+
+func (p *channeloverridetemplatePager) applyFilter(query *ChannelOverrideTemplateQuery) (*ChannelOverrideTemplateQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *channeloverridetemplatePager) toCursor(_m *ChannelOverrideTemplate) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *channeloverridetemplatePager) applyCursors(query *ChannelOverrideTemplateQuery, after, before *Cursor) (*ChannelOverrideTemplateQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultChannelOverrideTemplateOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *channeloverridetemplatePager) applyOrder(query *ChannelOverrideTemplateQuery) *ChannelOverrideTemplateQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultChannelOverrideTemplateOrder.Field {
+		query = query.Order(DefaultChannelOverrideTemplateOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *channeloverridetemplatePager) orderExpr(query *ChannelOverrideTemplateQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultChannelOverrideTemplateOrder.Field {
+			b.Comma().Ident(DefaultChannelOverrideTemplateOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to ChannelOverrideTemplate.
+func (_m *ChannelOverrideTemplateQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ChannelOverrideTemplatePaginateOption,
+) (*ChannelOverrideTemplateConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newChannelOverrideTemplatePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &ChannelOverrideTemplateConnection{Edges: []*ChannelOverrideTemplateEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// ChannelOverrideTemplateOrderFieldCreatedAt orders ChannelOverrideTemplate by created_at.
+	ChannelOverrideTemplateOrderFieldCreatedAt = &ChannelOverrideTemplateOrderField{
+		Value: func(_m *ChannelOverrideTemplate) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: channeloverridetemplate.FieldCreatedAt,
+		toTerm: channeloverridetemplate.ByCreatedAt,
+		toCursor: func(_m *ChannelOverrideTemplate) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+	// ChannelOverrideTemplateOrderFieldUpdatedAt orders ChannelOverrideTemplate by updated_at.
+	ChannelOverrideTemplateOrderFieldUpdatedAt = &ChannelOverrideTemplateOrderField{
+		Value: func(_m *ChannelOverrideTemplate) (ent.Value, error) {
+			return _m.UpdatedAt, nil
+		},
+		column: channeloverridetemplate.FieldUpdatedAt,
+		toTerm: channeloverridetemplate.ByUpdatedAt,
+		toCursor: func(_m *ChannelOverrideTemplate) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.UpdatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f ChannelOverrideTemplateOrderField) String() string {
+	var str string
+	switch f.column {
+	case ChannelOverrideTemplateOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case ChannelOverrideTemplateOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f ChannelOverrideTemplateOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *ChannelOverrideTemplateOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("ChannelOverrideTemplateOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *ChannelOverrideTemplateOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *ChannelOverrideTemplateOrderFieldUpdatedAt
+	default:
+		return fmt.Errorf("%s is not a valid ChannelOverrideTemplateOrderField", str)
+	}
+	return nil
+}
+
+// ChannelOverrideTemplateOrderField defines the ordering field of ChannelOverrideTemplate.
+type ChannelOverrideTemplateOrderField struct {
+	// Value extracts the ordering value from the given ChannelOverrideTemplate.
+	Value    func(*ChannelOverrideTemplate) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) channeloverridetemplate.OrderOption
+	toCursor func(*ChannelOverrideTemplate) Cursor
+}
+
+// ChannelOverrideTemplateOrder defines the ordering of ChannelOverrideTemplate.
+type ChannelOverrideTemplateOrder struct {
+	Direction OrderDirection                     `json:"direction"`
+	Field     *ChannelOverrideTemplateOrderField `json:"field"`
+}
+
+// DefaultChannelOverrideTemplateOrder is the default ordering of ChannelOverrideTemplate.
+var DefaultChannelOverrideTemplateOrder = &ChannelOverrideTemplateOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &ChannelOverrideTemplateOrderField{
+		Value: func(_m *ChannelOverrideTemplate) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: channeloverridetemplate.FieldID,
+		toTerm: channeloverridetemplate.ByID,
+		toCursor: func(_m *ChannelOverrideTemplate) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts ChannelOverrideTemplate into ChannelOverrideTemplateEdge.
+func (_m *ChannelOverrideTemplate) ToEdge(order *ChannelOverrideTemplateOrder) *ChannelOverrideTemplateEdge {
+	if order == nil {
+		order = DefaultChannelOverrideTemplateOrder
+	}
+	return &ChannelOverrideTemplateEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// ChannelProbeEdge is the edge representation of ChannelProbe.
+type ChannelProbeEdge struct {
+	Node   *ChannelProbe `json:"node"`
+	Cursor Cursor        `json:"cursor"`
+}
+
+// ChannelProbeConnection is the connection containing edges to ChannelProbe.
+type ChannelProbeConnection struct {
+	Edges      []*ChannelProbeEdge `json:"edges"`
+	PageInfo   PageInfo            `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
+}
+
+func (c *ChannelProbeConnection) build(nodes []*ChannelProbe, pager *channelprobePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *ChannelProbe
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *ChannelProbe {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *ChannelProbe {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*ChannelProbeEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &ChannelProbeEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// ChannelProbePaginateOption enables pagination customization.
+type ChannelProbePaginateOption func(*channelprobePager) error
+
+// WithChannelProbeOrder configures pagination ordering.
+func WithChannelProbeOrder(order *ChannelProbeOrder) ChannelProbePaginateOption {
+	if order == nil {
+		order = DefaultChannelProbeOrder
+	}
+	o := *order
+	return func(pager *channelprobePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultChannelProbeOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithChannelProbeFilter configures pagination filter.
+func WithChannelProbeFilter(filter func(*ChannelProbeQuery) (*ChannelProbeQuery, error)) ChannelProbePaginateOption {
+	return func(pager *channelprobePager) error {
+		if filter == nil {
+			return errors.New("ChannelProbeQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type channelprobePager struct {
+	reverse bool
+	order   *ChannelProbeOrder
+	filter  func(*ChannelProbeQuery) (*ChannelProbeQuery, error)
+}
+
+func newChannelProbePager(opts []ChannelProbePaginateOption, reverse bool) (*channelprobePager, error) {
+	pager := &channelprobePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultChannelProbeOrder
+	}
+	return pager, nil
+}
+
+func (p *channelprobePager) applyFilter(query *ChannelProbeQuery) (*ChannelProbeQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *channelprobePager) toCursor(_m *ChannelProbe) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *channelprobePager) applyCursors(query *ChannelProbeQuery, after, before *Cursor) (*ChannelProbeQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultChannelProbeOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *channelprobePager) applyOrder(query *ChannelProbeQuery) *ChannelProbeQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultChannelProbeOrder.Field {
+		query = query.Order(DefaultChannelProbeOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *channelprobePager) orderExpr(query *ChannelProbeQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultChannelProbeOrder.Field {
+			b.Comma().Ident(DefaultChannelProbeOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to ChannelProbe.
+func (_m *ChannelProbeQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ChannelProbePaginateOption,
+) (*ChannelProbeConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newChannelProbePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &ChannelProbeConnection{Edges: []*ChannelProbeEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// ChannelProbeOrderField defines the ordering field of ChannelProbe.
+type ChannelProbeOrderField struct {
+	// Value extracts the ordering value from the given ChannelProbe.
+	Value    func(*ChannelProbe) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) channelprobe.OrderOption
+	toCursor func(*ChannelProbe) Cursor
+}
+
+// ChannelProbeOrder defines the ordering of ChannelProbe.
+type ChannelProbeOrder struct {
+	Direction OrderDirection          `json:"direction"`
+	Field     *ChannelProbeOrderField `json:"field"`
+}
+
+// DefaultChannelProbeOrder is the default ordering of ChannelProbe.
+var DefaultChannelProbeOrder = &ChannelProbeOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &ChannelProbeOrderField{
+		Value: func(_m *ChannelProbe) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: channelprobe.FieldID,
+		toTerm: channelprobe.ByID,
+		toCursor: func(_m *ChannelProbe) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts ChannelProbe into ChannelProbeEdge.
+func (_m *ChannelProbe) ToEdge(order *ChannelProbeOrder) *ChannelProbeEdge {
+	if order == nil {
+		order = DefaultChannelProbeOrder
+	}
+	return &ChannelProbeEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// DataExportEdge is the edge representation of DataExport.
+type DataExportEdge struct {
+	Node   *DataExport `json:"node"`
+	Cursor Cursor      `json:"cursor"`
+}
+
+// DataExportConnection is the connection containing edges to DataExport.
+type DataExportConnection struct {
+	Edges      []*DataExportEdge `json:"edges"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
+func (c *DataExportConnection) build(nodes []*DataExport, pager *dataexportPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *DataExport
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *DataExport {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *DataExport {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*DataExportEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &DataExportEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// DataExportPaginateOption enables pagination customization.
+type DataExportPaginateOption func(*dataexportPager) error
+
+// WithDataExportOrder configures pagination ordering.
+func WithDataExportOrder(order *DataExportOrder) DataExportPaginateOption {
+	if order == nil {
+		order = DefaultDataExportOrder
+	}
+	o := *order
+	return func(pager *dataexportPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultDataExportOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithDataExportFilter configures pagination filter.
+func WithDataExportFilter(filter func(*DataExportQuery) (*DataExportQuery, error)) DataExportPaginateOption {
+	return func(pager *dataexportPager) error {
+		if filter == nil {
+			return errors.New("DataExportQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type dataexportPager struct {
+	reverse bool
+	order   *DataExportOrder
+	filter  func(*DataExportQuery) (*DataExportQuery, error)
+}
+
+func newDataExportPager(opts []DataExportPaginateOption, reverse bool) (*dataexportPager, error) {
+	pager := &dataexportPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultDataExportOrder
+	}
+	return pager, nil
+}
+
+func (p *dataexportPager) applyFilter(query *DataExportQuery) (*DataExportQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *dataexportPager) toCursor(_m *DataExport) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *dataexportPager) applyCursors(query *DataExportQuery, after, before *Cursor) (*DataExportQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultDataExportOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *dataexportPager) applyOrder(query *DataExportQuery) *DataExportQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultDataExportOrder.Field {
+		query = query.Order(DefaultDataExportOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *dataexportPager) orderExpr(query *DataExportQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultDataExportOrder.Field {
+			b.Comma().Ident(DefaultDataExportOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to DataExport.
+func (_m *DataExportQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...DataExportPaginateOption,
+) (*DataExportConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newDataExportPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &DataExportConnection{Edges: []*DataExportEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// DataExportOrderFieldCreatedAt orders DataExport by created_at.
+	DataExportOrderFieldCreatedAt = &DataExportOrderField{
+		Value: func(_m *DataExport) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: dataexport.FieldCreatedAt,
+		toTerm: dataexport.ByCreatedAt,
+		toCursor: func(_m *DataExport) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+	// DataExportOrderFieldUpdatedAt orders DataExport by updated_at.
+	DataExportOrderFieldUpdatedAt = &DataExportOrderField{
+		Value: func(_m *DataExport) (ent.Value, error) {
+			return _m.UpdatedAt, nil
+		},
+		column: dataexport.FieldUpdatedAt,
+		toTerm: dataexport.ByUpdatedAt,
+		toCursor: func(_m *DataExport) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.UpdatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f DataExportOrderField) String() string {
+	var str string
+	switch f.column {
+	case DataExportOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case DataExportOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f DataExportOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *DataExportOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("DataExportOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *DataExportOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *DataExportOrderFieldUpdatedAt
+	default:
+		return fmt.Errorf("%s is not a valid DataExportOrderField", str)
+	}
+	return nil
+}
+
+// DataExportOrderField defines the ordering field of DataExport.
+type DataExportOrderField struct {
+	// Value extracts the ordering value from the given DataExport.
+	Value    func(*DataExport) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) dataexport.OrderOption
+	toCursor func(*DataExport) Cursor
+}
+
+// DataExportOrder defines the ordering of DataExport.
+type DataExportOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *DataExportOrderField `json:"field"`
+}
+
+// DefaultDataExportOrder is the default ordering of DataExport.
+var DefaultDataExportOrder = &DataExportOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &DataExportOrderField{
+		Value: func(_m *DataExport) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: dataexport.FieldID,
+		toTerm: dataexport.ByID,
+		toCursor: func(_m *DataExport) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts DataExport into DataExportEdge.
+func (_m *DataExport) ToEdge(order *DataExportOrder) *DataExportEdge {
+	if order == nil {
+		order = DefaultDataExportOrder
+	}
+	return &DataExportEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// DataStorageEdge is the edge representation of DataStorage.
+type DataStorageEdge struct {
+	Node   *DataStorage `json:"node"`
+	Cursor Cursor       `json:"cursor"`
+}
+
+// DataStorageConnection is the connection containing edges to DataStorage.
+type DataStorageConnection struct {
+	Edges      []*DataStorageEdge `json:"edges"`
+	PageInfo   PageInfo           `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+func (c *DataStorageConnection) build(nodes []*DataStorage, pager *datastoragePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *DataStorage
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *DataStorage {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *DataStorage {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*DataStorageEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &DataStorageEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// DataStoragePaginateOption enables pagination customization.
+type DataStoragePaginateOption func(*datastoragePager) error
+
+// WithDataStorageOrder configures pagination ordering.
+func WithDataStorageOrder(order *DataStorageOrder) DataStoragePaginateOption {
+	if order == nil {
+		order = DefaultDataStorageOrder
+	}
+	o := *order
+	return func(pager *datastoragePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultDataStorageOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithDataStorageFilter configures pagination filter.
+func WithDataStorageFilter(filter func(*DataStorageQuery) (*DataStorageQuery, error)) DataStoragePaginateOption {
+	return func(pager *datastoragePager) error {
+		if filter == nil {
+			return errors.New("DataStorageQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type datastoragePager struct {
+	reverse bool
+	order   *DataStorageOrder
+	filter  func(*DataStorageQuery) (*DataStorageQuery, error)
+}
+
+func newDataStoragePager(opts []DataStoragePaginateOption, reverse bool) (*datastoragePager, error) {
+	pager := &datastoragePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultDataStorageOrder
+	}
+	return pager, nil
+}
+
+func (p *datastoragePager) applyFilter(query *DataStorageQuery) (*DataStorageQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *datastoragePager) toCursor(_m *DataStorage) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *datastoragePager) applyCursors(query *DataStorageQuery, after, before *Cursor) (*DataStorageQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultDataStorageOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *datastoragePager) applyOrder(query *DataStorageQuery) *DataStorageQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultDataStorageOrder.Field {
+		query = query.Order(DefaultDataStorageOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *datastoragePager) orderExpr(query *DataStorageQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultDataStorageOrder.Field {
+			b.Comma().Ident(DefaultDataStorageOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to DataStorage.
+func (_m *DataStorageQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...DataStoragePaginateOption,
+) (*DataStorageConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newDataStoragePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &DataStorageConnection{Edges: []*DataStorageEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// DataStorageOrderFieldCreatedAt orders DataStorage by created_at.
+	DataStorageOrderFieldCreatedAt = &DataStorageOrderField{
+		Value: func(_m *DataStorage) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: datastorage.FieldCreatedAt,
+		toTerm: datastorage.ByCreatedAt,
+		toCursor: func(_m *DataStorage) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+	// DataStorageOrderFieldUpdatedAt orders DataStorage by updated_at.
+	DataStorageOrderFieldUpdatedAt = &DataStorageOrderField{
+		Value: func(_m *DataStorage) (ent.Value, error) {
+			return _m.UpdatedAt, nil
+		},
+		column: datastorage.FieldUpdatedAt,
+		toTerm: datastorage.ByUpdatedAt,
+		toCursor: func(_m *DataStorage) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.UpdatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f DataStorageOrderField) String() string {
+	var str string
+	switch f.column {
+	case DataStorageOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case DataStorageOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f DataStorageOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *DataStorageOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("DataStorageOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *DataStorageOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *DataStorageOrderFieldUpdatedAt
+	default:
+		return fmt.Errorf("%s is not a valid DataStorageOrderField", str)
+	}
+	return nil
+}
+
+// DataStorageOrderField defines the ordering field of DataStorage.
+type DataStorageOrderField struct {
+	// Value extracts the ordering value from the given DataStorage.
+	Value    func(*DataStorage) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) datastorage.OrderOption
+	toCursor func(*DataStorage) Cursor
+}
+
+// DataStorageOrder defines the ordering of DataStorage.
+type DataStorageOrder struct {
+	Direction OrderDirection         `json:"direction"`
+	Field     *DataStorageOrderField `json:"field"`
+}
+
+// DefaultDataStorageOrder is the default ordering of DataStorage.
+var DefaultDataStorageOrder = &DataStorageOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &DataStorageOrderField{
+		Value: func(_m *DataStorage) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: datastorage.FieldID,
+		toTerm: datastorage.ByID,
+		toCursor: func(_m *DataStorage) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts DataStorage into DataStorageEdge.
+func (_m *DataStorage) ToEdge(order *DataStorageOrder) *DataStorageEdge {
+	if order == nil {
+		order = DefaultDataStorageOrder
+	}
+	return &DataStorageEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// ExchangeRateEdge is the edge representation of ExchangeRate.
+type ExchangeRateEdge struct {
+	Node   *ExchangeRate `json:"node"`
+	Cursor Cursor        `json:"cursor"`
+}
+
+// ExchangeRateConnection is the connection containing edges to ExchangeRate.
+type ExchangeRateConnection struct {
+	Edges      []*ExchangeRateEdge `json:"edges"`
+	PageInfo   PageInfo            `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
+}
+
+func (c *ExchangeRateConnection) build(nodes []*ExchangeRate, pager *exchangeratePager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *ExchangeRate
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *ExchangeRate {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *ExchangeRate {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*ExchangeRateEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &ExchangeRateEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// ExchangeRatePaginateOption enables pagination customization.
+type ExchangeRatePaginateOption func(*exchangeratePager) error
+
+// WithExchangeRateOrder configures pagination ordering.
+func WithExchangeRateOrder(order *ExchangeRateOrder) ExchangeRatePaginateOption {
+	if order == nil {
+		order = DefaultExchangeRateOrder
+	}
+	o := *order
+	return func(pager *exchangeratePager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultExchangeRateOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithExchangeRateFilter configures pagination filter.
+func WithExchangeRateFilter(filter func(*ExchangeRateQuery) (*ExchangeRateQuery, error)) ExchangeRatePaginateOption {
+	return func(pager *exchangeratePager) error {
+		if filter == nil {
+			return errors.New("ExchangeRateQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type exchangeratePager struct {
+	reverse bool
+	order   *ExchangeRateOrder
+	filter  func(*ExchangeRateQuery) (*ExchangeRateQuery, error)
+}
+
+func newExchangeRatePager(opts []ExchangeRatePaginateOption, reverse bool) (*exchangeratePager, error) {
+	pager := &exchangeratePager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultExchangeRateOrder
+	}
+	return pager, nil
+}
+
+func (p *exchangeratePager) applyFilter(query *ExchangeRateQuery) (*ExchangeRateQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *exchangeratePager) toCursor(_m *ExchangeRate) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *exchangeratePager) applyCursors(query *ExchangeRateQuery, after, before *Cursor) (*ExchangeRateQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultExchangeRateOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *exchangeratePager) applyOrder(query *ExchangeRateQuery) *ExchangeRateQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultExchangeRateOrder.Field {
+		query = query.Order(DefaultExchangeRateOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *exchangeratePager) orderExpr(query *ExchangeRateQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultExchangeRateOrder.Field {
+			b.Comma().Ident(DefaultExchangeRateOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to ExchangeRate.
+func (_m *ExchangeRateQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ExchangeRatePaginateOption,
+) (*ExchangeRateConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newExchangeRatePager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &ExchangeRateConnection{Edges: []*ExchangeRateEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// ExchangeRateOrderFieldCreatedAt orders ExchangeRate by created_at.
+	ExchangeRateOrderFieldCreatedAt = &ExchangeRateOrderField{
+		Value: func(_m *ExchangeRate) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: exchangerate.FieldCreatedAt,
+		toTerm: exchangerate.ByCreatedAt,
+		toCursor: func(_m *ExchangeRate) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+	// ExchangeRateOrderFieldUpdatedAt orders ExchangeRate by updated_at.
+	ExchangeRateOrderFieldUpdatedAt = &ExchangeRateOrderField{
+		Value: func(_m *ExchangeRate) (ent.Value, error) {
+			return _m.UpdatedAt, nil
+		},
+		column: exchangerate.FieldUpdatedAt,
+		toTerm: exchangerate.ByUpdatedAt,
+		toCursor: func(_m *ExchangeRate) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.UpdatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f ExchangeRateOrderField) String() string {
+	var str string
+	switch f.column {
+	case ExchangeRateOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case ExchangeRateOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f ExchangeRateOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *ExchangeRateOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("ExchangeRateOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *ExchangeRateOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *ExchangeRateOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid ChannelModelPriceOrderField", str)
+		return fmt.Errorf("%s is not a valid ExchangeRateOrderField", str)
 	}
 	return nil
 }
 
-// ChannelModelPriceOrderField defines the ordering field of ChannelModelPrice.
-type ChannelModelPriceOrderField struct {
-	// Value extracts the ordering value from the given ChannelModelPrice.
-	Value    func(*ChannelModelPrice) (ent.Value, error)
+// ExchangeRateOrderField defines the ordering field of ExchangeRate.
+type ExchangeRateOrderField struct {
+	// Value extracts the ordering value from the given ExchangeRate.
+	Value    func(*ExchangeRate) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) channelmodelprice.OrderOption
-	toCursor func(*ChannelModelPrice) Cursor
+	toTerm   func(...sql.OrderTermOption) exchangerate.OrderOption
+	toCursor func(*ExchangeRate) Cursor
 }
 
-// ChannelModelPriceOrder defines the ordering of ChannelModelPrice.
-type ChannelModelPriceOrder struct {
-	Direction OrderDirection               `json:"direction"`
-	Field     *ChannelModelPriceOrderField `json:"field"`
+// ExchangeRateOrder defines the ordering of ExchangeRate.
+type ExchangeRateOrder struct {
+	Direction OrderDirection          `json:"direction"`
+	Field     *ExchangeRateOrderField `json:"field"`
 }
 
-// DefaultChannelModelPriceOrder is the default ordering of ChannelModelPrice.
-var DefaultChannelModelPriceOrder = &ChannelModelPriceOrder{
+// DefaultExchangeRateOrder is the default ordering of ExchangeRate.
+var DefaultExchangeRateOrder = &ExchangeRateOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &ChannelModelPriceOrderField{
-		Value: func(_m *ChannelModelPrice) (ent.Value, error) {
+	Field: &ExchangeRateOrderField{
+		Value: func(_m *ExchangeRate) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: channelmodelprice.FieldID,
-		toTerm: channelmodelprice.ByID,
-		toCursor: func(_m *ChannelModelPrice) Cursor {
+		column: exchangerate.FieldID,
+		toTerm: exchangerate.ByID,
+		toCursor: func(_m *ExchangeRate) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts ChannelModelPrice into ChannelModelPriceEdge.
-func (_m *ChannelModelPrice) ToEdge(order *ChannelModelPriceOrder) *ChannelModelPriceEdge {
+// ToEdge converts ExchangeRate into ExchangeRateEdge.
+func (_m *ExchangeRate) ToEdge(order *ExchangeRateOrder) *ExchangeRateEdge {
 	if order == nil {
-		order = DefaultChannelModelPriceOrder
+		order = DefaultExchangeRateOrder
 	}
-	return &ChannelModelPriceEdge{
+	return &ExchangeRateEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// ChannelModelPriceVersionEdge is the edge representation of ChannelModelPriceVersion.
-type ChannelModelPriceVersionEdge struct {
-	Node   *ChannelModelPriceVersion `json:"node"`
-	Cursor Cursor                    `json:"cursor"`
+// InvoiceEdge is the edge representation of Invoice.
+type InvoiceEdge struct {
+	Node   *Invoice `json:"node"`
+	Cursor Cursor   `json:"cursor"`
 }
 
-// ChannelModelPriceVersionConnection is the connection containing edges to ChannelModelPriceVersion.
-type ChannelModelPriceVersionConnection struct {
-	Edges      []*ChannelModelPriceVersionEdge `json:"edges"`
-	PageInfo   PageInfo                        `json:"pageInfo"`
-	TotalCount int                             `json:"totalCount"`
+// InvoiceConnection is the connection containing edges to Invoice.
+type InvoiceConnection struct {
+	Edges      []*InvoiceEdge `json:"edges"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
 }
 
-func (c *ChannelModelPriceVersionConnection) build(nodes []*ChannelModelPriceVersion, pager *channelmodelpriceversionPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *InvoiceConnection) build(nodes []*Invoice, pager *invoicePager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -1490,21 +3945,21 @@ func (c *ChannelModelPriceVersionConnection) build(nodes []*ChannelModelPriceVer
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *ChannelModelPriceVersion
+	var nodeAt func(int) *Invoice
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *ChannelModelPriceVersion {
+		nodeAt = func(i int) *Invoice {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *ChannelModelPriceVersion {
+		nodeAt = func(i int) *Invoice {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*ChannelModelPriceVersionEdge, len(nodes))
+	c.Edges = make([]*InvoiceEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &ChannelModelPriceVersionEdge{
+		c.Edges[i] = &InvoiceEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1518,87 +3973,87 @@ func (c *ChannelModelPriceVersionConnection) build(nodes []*ChannelModelPriceVer
 	}
 }
 
-// ChannelModelPriceVersionPaginateOption enables pagination customization.
-type ChannelModelPriceVersionPaginateOption func(*channelmodelpriceversionPager) error
+// InvoicePaginateOption enables pagination customization.
+type InvoicePaginateOption func(*invoicePager) error
 
-// WithChannelModelPriceVersionOrder configures pagination ordering.
-func WithChannelModelPriceVersionOrder(order *ChannelModelPriceVersionOrder) ChannelModelPriceVersionPaginateOption {
+// WithInvoiceOrder configures pagination ordering.
+func WithInvoiceOrder(order *InvoiceOrder) InvoicePaginateOption {
 	if order == nil {
-		order = DefaultChannelModelPriceVersionOrder
+		order = DefaultInvoiceOrder
 	}
 	o := *order
-	return func(pager *channelmodelpriceversionPager) error {
+	return func(pager *invoicePager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultChannelModelPriceVersionOrder.Field
+			o.Field = DefaultInvoiceOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithChannelModelPriceVersionFilter configures pagination filter.
-func WithChannelModelPriceVersionFilter(filter func(*ChannelModelPriceVersionQuery) (*ChannelModelPriceVersionQuery, error)) ChannelModelPriceVersionPaginateOption {
-	return func(pager *channelmodelpriceversionPager) error {
+// WithInvoiceFilter configures pagination filter.
+func WithInvoiceFilter(filter func(*InvoiceQuery) (*InvoiceQuery, error)) InvoicePaginateOption {
+	return func(pager *invoicePager) error {
 		if filter == nil {
-			return errors.New("ChannelModelPriceVersionQuery filter cannot be nil")
+			return errors.New("InvoiceQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type channelmodelpriceversionPager struct {
+type invoicePager struct {
 	reverse bool
-	order   *ChannelModelPriceVersionOrder
-	filter  func(*ChannelModelPriceVersionQuery) (*ChannelModelPriceVersionQuery, error)
+	order   *InvoiceOrder
+	filter  func(*InvoiceQuery) (*InvoiceQuery, error)
 }
 
-func newChannelModelPriceVersionPager(opts []ChannelModelPriceVersionPaginateOption, reverse bool) (*channelmodelpriceversionPager, error) {
-	pager := &channelmodelpriceversionPager{reverse: reverse}
+func newInvoicePager(opts []InvoicePaginateOption, reverse bool) (*invoicePager, error) {
+	pager := &invoicePager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultChannelModelPriceVersionOrder
+		pager.order = DefaultInvoiceOrder
 	}
 	return pager, nil
 }
 
-func (p *channelmodelpriceversionPager) applyFilter(query *ChannelModelPriceVersionQuery) (*ChannelModelPriceVersionQuery, error) {
+func (p *invoicePager) applyFilter(query *InvoiceQuery) (*InvoiceQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *channelmodelpriceversionPager) toCursor(_m *ChannelModelPriceVersion) Cursor {
+func (p *invoicePager) toCursor(_m *Invoice) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *channelmodelpriceversionPager) applyCursors(query *ChannelModelPriceVersionQuery, after, before *Cursor) (*ChannelModelPriceVersionQuery, error) {
+func (p *invoicePager) applyCursors(query *InvoiceQuery, after, before *Cursor) (*InvoiceQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultChannelModelPriceVersionOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultInvoiceOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *channelmodelpriceversionPager) applyOrder(query *ChannelModelPriceVersionQuery) *ChannelModelPriceVersionQuery {
+func (p *invoicePager) applyOrder(query *InvoiceQuery) *InvoiceQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultChannelModelPriceVersionOrder.Field {
-		query = query.Order(DefaultChannelModelPriceVersionOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultInvoiceOrder.Field {
+		query = query.Order(DefaultInvoiceOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -1606,7 +4061,7 @@ func (p *channelmodelpriceversionPager) applyOrder(query *ChannelModelPriceVersi
 	return query
 }
 
-func (p *channelmodelpriceversionPager) orderExpr(query *ChannelModelPriceVersionQuery) sql.Querier {
+func (p *invoicePager) orderExpr(query *InvoiceQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -1616,28 +4071,28 @@ func (p *channelmodelpriceversionPager) orderExpr(query *ChannelModelPriceVersio
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultChannelModelPriceVersionOrder.Field {
-			b.Comma().Ident(DefaultChannelModelPriceVersionOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultInvoiceOrder.Field {
+			b.Comma().Ident(DefaultInvoiceOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to ChannelModelPriceVersion.
-func (_m *ChannelModelPriceVersionQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Invoice.
+func (_m *InvoiceQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ChannelModelPriceVersionPaginateOption,
-) (*ChannelModelPriceVersionConnection, error) {
+	before *Cursor, last *int, opts ...InvoicePaginateOption,
+) (*InvoiceConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newChannelModelPriceVersionPager(opts, last != nil)
+	pager, err := newInvoicePager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &ChannelModelPriceVersionConnection{Edges: []*ChannelModelPriceVersionEdge{}}
+	conn := &InvoiceConnection{Edges: []*InvoiceEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -1676,125 +4131,143 @@ func (_m *ChannelModelPriceVersionQuery) Paginate(
 }
 
 var (
-	// ChannelModelPriceVersionOrderFieldCreatedAt orders ChannelModelPriceVersion by created_at.
-	ChannelModelPriceVersionOrderFieldCreatedAt = &ChannelModelPriceVersionOrderField{
-		Value: func(_m *ChannelModelPriceVersion) (ent.Value, error) {
+	// InvoiceOrderFieldCreatedAt orders Invoice by created_at.
+	InvoiceOrderFieldCreatedAt = &InvoiceOrderField{
+		Value: func(_m *Invoice) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: channelmodelpriceversion.FieldCreatedAt,
-		toTerm: channelmodelpriceversion.ByCreatedAt,
-		toCursor: func(_m *ChannelModelPriceVersion) Cursor {
+		column: invoice.FieldCreatedAt,
+		toTerm: invoice.ByCreatedAt,
+		toCursor: func(_m *Invoice) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// ChannelModelPriceVersionOrderFieldUpdatedAt orders ChannelModelPriceVersion by updated_at.
-	ChannelModelPriceVersionOrderFieldUpdatedAt = &ChannelModelPriceVersionOrderField{
-		Value: func(_m *ChannelModelPriceVersion) (ent.Value, error) {
+	// InvoiceOrderFieldUpdatedAt orders Invoice by updated_at.
+	InvoiceOrderFieldUpdatedAt = &InvoiceOrderField{
+		Value: func(_m *Invoice) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: channelmodelpriceversion.FieldUpdatedAt,
-		toTerm: channelmodelpriceversion.ByUpdatedAt,
-		toCursor: func(_m *ChannelModelPriceVersion) Cursor {
+		column: invoice.FieldUpdatedAt,
+		toTerm: invoice.ByUpdatedAt,
+		toCursor: func(_m *Invoice) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
+	// InvoiceOrderFieldPeriodStart orders Invoice by period_start.
+	InvoiceOrderFieldPeriodStart = &InvoiceOrderField{
+		Value: func(_m *Invoice) (ent.Value, error) {
+			return _m.PeriodStart, nil
+		},
+		column: invoice.FieldPeriodStart,
+		toTerm: invoice.ByPeriodStart,
+		toCursor: func(_m *Invoice) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.PeriodStart,
+			}
+		},
+	}
 )
 
 // String implement fmt.Stringer interface.
-func (f ChannelModelPriceVersionOrderField) String() string {
+func (f InvoiceOrderField) String() string {
 	var str string
 	switch f.column {
-	case ChannelModelPriceVersionOrderFieldCreatedAt.column:
+	case InvoiceOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case ChannelModelPriceVersionOrderFieldUpdatedAt.column:
+	case InvoiceOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
+	case InvoiceOrderFieldPeriodStart.column:
+		str = "PERIOD_START"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f ChannelModelPriceVersionOrderField) MarshalGQL(w io.Writer) {
+func (f InvoiceOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *ChannelModelPriceVersionOrderField) UnmarshalGQL(v interface{}) error {
+func (f *InvoiceOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("ChannelModelPriceVersionOrderField %T must be a string", v)
+		return fmt.Errorf("InvoiceOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *ChannelModelPriceVersionOrderFieldCreatedAt
+		*f = *InvoiceOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *ChannelModelPriceVersionOrderFieldUpdatedAt
+		*f = *InvoiceOrderFieldUpdatedAt
+	case "PERIOD_START":
+		*f = *InvoiceOrderFieldPeriodStart
 	default:
-		return fmt.Errorf("%s is not a valid ChannelModelPriceVersionOrderField", str)
+		return fmt.Errorf("%s is not a valid InvoiceOrderField", str)
 	}
 	return nil
 }
 
-// ChannelModelPriceVersionOrderField defines the ordering field of ChannelModelPriceVersion.
-type ChannelModelPriceVersionOrderField struct {
-	// Value extracts the ordering value from the given ChannelModelPriceVersion.
-	Value    func(*ChannelModelPriceVersion) (ent.Value, error)
+// InvoiceOrderField defines the ordering field of Invoice.
+type InvoiceOrderField struct {
+	// Value extracts the ordering value from the given Invoice.
+	Value    func(*Invoice) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) channelmodelpriceversion.OrderOption
-	toCursor func(*ChannelModelPriceVersion) Cursor
+	toTerm   func(...sql.OrderTermOption) invoice.OrderOption
+	toCursor func(*Invoice) Cursor
 }
 
-// ChannelModelPriceVersionOrder defines the ordering of ChannelModelPriceVersion.
-type ChannelModelPriceVersionOrder struct {
-	Direction OrderDirection                      `json:"direction"`
-	Field     *ChannelModelPriceVersionOrderField `json:"field"`
+// InvoiceOrder defines the ordering of Invoice.
+type InvoiceOrder struct {
+	Direction OrderDirection     `json:"direction"`
+	Field     *InvoiceOrderField `json:"field"`
 }
 
-// DefaultChannelModelPriceVersionOrder is the default ordering of ChannelModelPriceVersion.
-var DefaultChannelModelPriceVersionOrder = &ChannelModelPriceVersionOrder{
+// DefaultInvoiceOrder is the default ordering of Invoice.
+var DefaultInvoiceOrder = &InvoiceOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &ChannelModelPriceVersionOrderField{
-		Value: func(_m *ChannelModelPriceVersion) (ent.Value, error) {
+	Field: &InvoiceOrderField{
+		Value: func(_m *Invoice) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: channelmodelpriceversion.FieldID,
-		toTerm: channelmodelpriceversion.ByID,
-		toCursor: func(_m *ChannelModelPriceVersion) Cursor {
+		column: invoice.FieldID,
+		toTerm: invoice.ByID,
+		toCursor: func(_m *Invoice) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts ChannelModelPriceVersion into ChannelModelPriceVersionEdge.
-func (_m *ChannelModelPriceVersion) ToEdge(order *ChannelModelPriceVersionOrder) *ChannelModelPriceVersionEdge {
+// ToEdge converts Invoice into InvoiceEdge.
+func (_m *Invoice) ToEdge(order *InvoiceOrder) *InvoiceEdge {
 	if order == nil {
-		order = DefaultChannelModelPriceVersionOrder
+		order = DefaultInvoiceOrder
 	}
-	return &ChannelModelPriceVersionEdge{
+	return &InvoiceEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// ChannelOverrideTemplateEdge is the edge representation of ChannelOverrideTemplate.
-type ChannelOverrideTemplateEdge struct {
-	Node   *ChannelOverrideTemplate `json:"node"`
-	Cursor Cursor                   `json:"cursor"`
+// ModelEdge is the edge representation of Model.
+type ModelEdge struct {
+	Node   *Model `json:"node"`
+	Cursor Cursor `json:"cursor"`
 }
 
-// ChannelOverrideTemplateConnection is the connection containing edges to ChannelOverrideTemplate.
-type ChannelOverrideTemplateConnection struct {
-	Edges      []*ChannelOverrideTemplateEdge `json:"edges"`
-	PageInfo   PageInfo                       `json:"pageInfo"`
-	TotalCount int                            `json:"totalCount"`
+// ModelConnection is the connection containing edges to Model.
+type ModelConnection struct {
+	Edges      []*ModelEdge `json:"edges"`
+	PageInfo   PageInfo     `json:"pageInfo"`
+	TotalCount int          `json:"totalCount"`
 }
 
-func (c *ChannelOverrideTemplateConnection) build(nodes []*ChannelOverrideTemplate, pager *channeloverridetemplatePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *ModelConnection) build(nodes []*Model, pager *modelPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -1804,21 +4277,21 @@ func (c *ChannelOverrideTemplateConnection) build(nodes []*ChannelOverrideTempla
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *ChannelOverrideTemplate
+	var nodeAt func(int) *Model
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *ChannelOverrideTemplate {
+		nodeAt = func(i int) *Model {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *ChannelOverrideTemplate {
+		nodeAt = func(i int) *Model {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*ChannelOverrideTemplateEdge, len(nodes))
+	c.Edges = make([]*ModelEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &ChannelOverrideTemplateEdge{
+		c.Edges[i] = &ModelEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1832,87 +4305,87 @@ func (c *ChannelOverrideTemplateConnection) build(nodes []*ChannelOverrideTempla
 	}
 }
 
-// ChannelOverrideTemplatePaginateOption enables pagination customization.
-type ChannelOverrideTemplatePaginateOption func(*channeloverridetemplatePager) error
+// ModelPaginateOption enables pagination customization.
+type ModelPaginateOption func(*modelPager) error
 
-// WithChannelOverrideTemplateOrder configures pagination ordering.
-func WithChannelOverrideTemplateOrder(order *ChannelOverrideTemplateOrder) ChannelOverrideTemplatePaginateOption {
+// WithModelOrder configures pagination ordering.
+func WithModelOrder(order *ModelOrder) ModelPaginateOption {
 	if order == nil {
-		order = DefaultChannelOverrideTemplateOrder
+		order = DefaultModelOrder
 	}
 	o := *order
-	return func(pager *channeloverridetemplatePager) error {
+	return func(pager *modelPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultChannelOverrideTemplateOrder.Field
+			o.Field = DefaultModelOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithChannelOverrideTemplateFilter configures pagination filter.
-func WithChannelOverrideTemplateFilter(filter func(*ChannelOverrideTemplateQuery) (*ChannelOverrideTemplateQuery, error)) ChannelOverrideTemplatePaginateOption {
-	return func(pager *channeloverridetemplatePager) error {
+// WithModelFilter configures pagination filter.
+func WithModelFilter(filter func(*ModelQuery) (*ModelQuery, error)) ModelPaginateOption {
+	return func(pager *modelPager) error {
 		if filter == nil {
-			return errors.New("ChannelOverrideTemplateQuery filter cannot be nil")
+			return errors.New("ModelQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type channeloverridetemplatePager struct {
+type modelPager struct {
 	reverse bool
-	order   *ChannelOverrideTemplateOrder
-	filter  func(*ChannelOverrideTemplateQuery) (*ChannelOverrideTemplateQuery, error)
+	order   *ModelOrder
+	filter  func(*ModelQuery) (*ModelQuery, error)
 }
 
-func newChannelOverrideTemplatePager(opts []ChannelOverrideTemplatePaginateOption, reverse bool) (*channeloverridetemplatePager, error) {
-	pager := &channeloverridetemplatePager{reverse: reverse}
+func newModelPager(opts []ModelPaginateOption, reverse bool) (*modelPager, error) {
+	pager := &modelPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultChannelOverrideTemplateOrder
+		pager.order = DefaultModelOrder
 	}
 	return pager, nil
 }
 
-func (p *channeloverridetemplatePager) applyFilter(query *ChannelOverrideTemplateQuery) (*ChannelOverrideTemplateQuery, error) {
+func (p *modelPager) applyFilter(query *ModelQuery) (*ModelQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *channeloverridetemplatePager) toCursor(_m *ChannelOverrideTemplate) Cursor {
+func (p *modelPager) toCursor(_m *Model) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *channeloverridetemplatePager) applyCursors(query *ChannelOverrideTemplateQuery, after, before *Cursor) (*ChannelOverrideTemplateQuery, error) {
+func (p *modelPager) applyCursors(query *ModelQuery, after, before *Cursor) (*ModelQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultChannelOverrideTemplateOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultModelOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *channeloverridetemplatePager) applyOrder(query *ChannelOverrideTemplateQuery) *ChannelOverrideTemplateQuery {
+func (p *modelPager) applyOrder(query *ModelQuery) *ModelQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultChannelOverrideTemplateOrder.Field {
-		query = query.Order(DefaultChannelOverrideTemplateOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultModelOrder.Field {
+		query = query.Order(DefaultModelOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -1920,7 +4393,7 @@ func (p *channeloverridetemplatePager) applyOrder(query *ChannelOverrideTemplate
 	return query
 }
 
-func (p *channeloverridetemplatePager) orderExpr(query *ChannelOverrideTemplateQuery) sql.Querier {
+func (p *modelPager) orderExpr(query *ModelQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -1930,28 +4403,28 @@ func (p *channeloverridetemplatePager) orderExpr(query *ChannelOverrideTemplateQ
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultChannelOverrideTemplateOrder.Field {
-			b.Comma().Ident(DefaultChannelOverrideTemplateOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultModelOrder.Field {
+			b.Comma().Ident(DefaultModelOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to ChannelOverrideTemplate.
-func (_m *ChannelOverrideTemplateQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Model.
+func (_m *ModelQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ChannelOverrideTemplatePaginateOption,
-) (*ChannelOverrideTemplateConnection, error) {
+	before *Cursor, last *int, opts ...ModelPaginateOption,
+) (*ModelConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newChannelOverrideTemplatePager(opts, last != nil)
+	pager, err := newModelPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &ChannelOverrideTemplateConnection{Edges: []*ChannelOverrideTemplateEdge{}}
+	conn := &ModelConnection{Edges: []*ModelEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -1990,125 +4463,143 @@ func (_m *ChannelOverrideTemplateQuery) Paginate(
 }
 
 var (
-	// ChannelOverrideTemplateOrderFieldCreatedAt orders ChannelOverrideTemplate by created_at.
-	ChannelOverrideTemplateOrderFieldCreatedAt = &ChannelOverrideTemplateOrderField{
-		Value: func(_m *ChannelOverrideTemplate) (ent.Value, error) {
+	// ModelOrderFieldCreatedAt orders Model by created_at.
+	ModelOrderFieldCreatedAt = &ModelOrderField{
+		Value: func(_m *Model) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: channeloverridetemplate.FieldCreatedAt,
-		toTerm: channeloverridetemplate.ByCreatedAt,
-		toCursor: func(_m *ChannelOverrideTemplate) Cursor {
+		column: model.FieldCreatedAt,
+		toTerm: model.ByCreatedAt,
+		toCursor: func(_m *Model) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// ChannelOverrideTemplateOrderFieldUpdatedAt orders ChannelOverrideTemplate by updated_at.
-	ChannelOverrideTemplateOrderFieldUpdatedAt = &ChannelOverrideTemplateOrderField{
-		Value: func(_m *ChannelOverrideTemplate) (ent.Value, error) {
+	// ModelOrderFieldUpdatedAt orders Model by updated_at.
+	ModelOrderFieldUpdatedAt = &ModelOrderField{
+		Value: func(_m *Model) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: channeloverridetemplate.FieldUpdatedAt,
-		toTerm: channeloverridetemplate.ByUpdatedAt,
-		toCursor: func(_m *ChannelOverrideTemplate) Cursor {
+		column: model.FieldUpdatedAt,
+		toTerm: model.ByUpdatedAt,
+		toCursor: func(_m *Model) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
+	// ModelOrderFieldName orders Model by name.
+	ModelOrderFieldName = &ModelOrderField{
+		Value: func(_m *Model) (ent.Value, error) {
+			return _m.Name, nil
+		},
+		column: model.FieldName,
+		toTerm: model.ByName,
+		toCursor: func(_m *Model) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Name,
+			}
+		},
+	}
 )
 
 // String implement fmt.Stringer interface.
-func (f ChannelOverrideTemplateOrderField) String() string {
+func (f ModelOrderField) String() string {
 	var str string
 	switch f.column {
-	case ChannelOverrideTemplateOrderFieldCreatedAt.column:
+	case ModelOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case ChannelOverrideTemplateOrderFieldUpdatedAt.column:
+	case ModelOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
+	case ModelOrderFieldName.column:
+		str = "NAME"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f ChannelOverrideTemplateOrderField) MarshalGQL(w io.Writer) {
+func (f ModelOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *ChannelOverrideTemplateOrderField) UnmarshalGQL(v interface{}) error {
+func (f *ModelOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("ChannelOverrideTemplateOrderField %T must be a string", v)
+		return fmt.Errorf("ModelOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *ChannelOverrideTemplateOrderFieldCreatedAt
+		*f = *ModelOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *ChannelOverrideTemplateOrderFieldUpdatedAt
+		*f = *ModelOrderFieldUpdatedAt
+	case "NAME":
+		*f = *ModelOrderFieldName
 	default:
-		return fmt.Errorf("%s is not a valid ChannelOverrideTemplateOrderField", str)
+		return fmt.Errorf("%s is not a valid ModelOrderField", str)
 	}
 	return nil
 }
 
-// ChannelOverrideTemplateOrderField defines the ordering field of ChannelOverrideTemplate.
-type ChannelOverrideTemplateOrderField struct {
-	// Value extracts the ordering value from the given ChannelOverrideTemplate.
-	Value    func(*ChannelOverrideTemplate) (ent.Value, error)
+// ModelOrderField defines the ordering field of Model.
+type ModelOrderField struct {
+	// Value extracts the ordering value from the given Model.
+	Value    func(*Model) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) channeloverridetemplate.OrderOption
-	toCursor func(*ChannelOverrideTemplate) Cursor
+	toTerm   func(...sql.OrderTermOption) model.OrderOption
+	toCursor func(*Model) Cursor
 }
 
-// ChannelOverrideTemplateOrder defines the ordering of ChannelOverrideTemplate.
-type ChannelOverrideTemplateOrder struct {
-	Direction OrderDirection                     `json:"direction"`
-	Field     *ChannelOverrideTemplateOrderField `json:"field"`
+// ModelOrder defines the ordering of Model.
+type ModelOrder struct {
+	Direction OrderDirection   `json:"direction"`
+	Field     *ModelOrderField `json:"field"`
 }
 
-// DefaultChannelOverrideTemplateOrder is the default ordering of ChannelOverrideTemplate.
-var DefaultChannelOverrideTemplateOrder = &ChannelOverrideTemplateOrder{
+// DefaultModelOrder is the default ordering of Model.
+var DefaultModelOrder = &ModelOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &ChannelOverrideTemplateOrderField{
-		Value: func(_m *ChannelOverrideTemplate) (ent.Value, error) {
+	Field: &ModelOrderField{
+		Value: func(_m *Model) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: channeloverridetemplate.FieldID,
-		toTerm: channeloverridetemplate.ByID,
-		toCursor: func(_m *ChannelOverrideTemplate) Cursor {
+		column: model.FieldID,
+		toTerm: model.ByID,
+		toCursor: func(_m *Model) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts ChannelOverrideTemplate into ChannelOverrideTemplateEdge.
-func (_m *ChannelOverrideTemplate) ToEdge(order *ChannelOverrideTemplateOrder) *ChannelOverrideTemplateEdge {
+// ToEdge converts Model into ModelEdge.
+func (_m *Model) ToEdge(order *ModelOrder) *ModelEdge {
 	if order == nil {
-		order = DefaultChannelOverrideTemplateOrder
+		order = DefaultModelOrder
 	}
-	return &ChannelOverrideTemplateEdge{
+	return &ModelEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// ChannelProbeEdge is the edge representation of ChannelProbe.
-type ChannelProbeEdge struct {
-	Node   *ChannelProbe `json:"node"`
+// OIDCIdentityEdge is the edge representation of OIDCIdentity.
+type OIDCIdentityEdge struct {
+	Node   *OIDCIdentity `json:"node"`
 	Cursor Cursor        `json:"cursor"`
 }
 
-// ChannelProbeConnection is the connection containing edges to ChannelProbe.
-type ChannelProbeConnection struct {
-	Edges      []*ChannelProbeEdge `json:"edges"`
+// OIDCIdentityConnection is the connection containing edges to OIDCIdentity.
+type OIDCIdentityConnection struct {
+	Edges      []*OIDCIdentityEdge `json:"edges"`
 	PageInfo   PageInfo            `json:"pageInfo"`
 	TotalCount int                 `json:"totalCount"`
 }
 
-func (c *ChannelProbeConnection) build(nodes []*ChannelProbe, pager *channelprobePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *OIDCIdentityConnection) build(nodes []*OIDCIdentity, pager *oidcidentityPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -2118,21 +4609,21 @@ func (c *ChannelProbeConnection) build(nodes []*ChannelProbe, pager *channelprob
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *ChannelProbe
+	var nodeAt func(int) *OIDCIdentity
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *ChannelProbe {
+		nodeAt = func(i int) *OIDCIdentity {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *ChannelProbe {
+		nodeAt = func(i int) *OIDCIdentity {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*ChannelProbeEdge, len(nodes))
+	c.Edges = make([]*OIDCIdentityEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &ChannelProbeEdge{
+		c.Edges[i] = &OIDCIdentityEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -2146,87 +4637,87 @@ func (c *ChannelProbeConnection) build(nodes []*ChannelProbe, pager *channelprob
 	}
 }
 
-// ChannelProbePaginateOption enables pagination customization.
-type ChannelProbePaginateOption func(*channelprobePager) error
+// OIDCIdentityPaginateOption enables pagination customization.
+type OIDCIdentityPaginateOption func(*oidcidentityPager) error
 
-// WithChannelProbeOrder configures pagination ordering.
-func WithChannelProbeOrder(order *ChannelProbeOrder) ChannelProbePaginateOption {
+// WithOIDCIdentityOrder configures pagination ordering.
+func WithOIDCIdentityOrder(order *OIDCIdentityOrder) OIDCIdentityPaginateOption {
 	if order == nil {
-		order = DefaultChannelProbeOrder
+		order = DefaultOIDCIdentityOrder
 	}
 	o := *order
-	return func(pager *channelprobePager) error {
+	return func(pager *oidcidentityPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultChannelProbeOrder.Field
+			o.Field = DefaultOIDCIdentityOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithChannelProbeFilter configures pagination filter.
-func WithChannelProbeFilter(filter func(*ChannelProbeQuery) (*ChannelProbeQuery, error)) ChannelProbePaginateOption {
-	return func(pager *channelprobePager) error {
+// WithOIDCIdentityFilter configures pagination filter.
+func WithOIDCIdentityFilter(filter func(*OIDCIdentityQuery) (*OIDCIdentityQuery, error)) OIDCIdentityPaginateOption {
+	return func(pager *oidcidentityPager) error {
 		if filter == nil {
-			return errors.New("ChannelProbeQuery filter cannot be nil")
+			return errors.New("OIDCIdentityQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type channelprobePager struct {
+type oidcidentityPager struct {
 	reverse bool
-	order   *ChannelProbeOrder
-	filter  func(*ChannelProbeQuery) (*ChannelProbeQuery, error)
+	order   *OIDCIdentityOrder
+	filter  func(*OIDCIdentityQuery) (*OIDCIdentityQuery, error)
 }
 
-func newChannelProbePager(opts []ChannelProbePaginateOption, reverse bool) (*channelprobePager, error) {
-	pager := &channelprobePager{reverse: reverse}
+func newOIDCIdentityPager(opts []OIDCIdentityPaginateOption, reverse bool) (*oidcidentityPager, error) {
+	pager := &oidcidentityPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultChannelProbeOrder
+		pager.order = DefaultOIDCIdentityOrder
 	}
 	return pager, nil
 }
 
-func (p *channelprobePager) applyFilter(query *ChannelProbeQuery) (*ChannelProbeQuery, error) {
+func (p *oidcidentityPager) applyFilter(query *OIDCIdentityQuery) (*OIDCIdentityQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *channelprobePager) toCursor(_m *ChannelProbe) Cursor {
+func (p *oidcidentityPager) toCursor(_m *OIDCIdentity) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *channelprobePager) applyCursors(query *ChannelProbeQuery, after, before *Cursor) (*ChannelProbeQuery, error) {
+func (p *oidcidentityPager) applyCursors(query *OIDCIdentityQuery, after, before *Cursor) (*OIDCIdentityQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultChannelProbeOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultOIDCIdentityOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *channelprobePager) applyOrder(query *ChannelProbeQuery) *ChannelProbeQuery {
+func (p *oidcidentityPager) applyOrder(query *OIDCIdentityQuery) *OIDCIdentityQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultChannelProbeOrder.Field {
-		query = query.Order(DefaultChannelProbeOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultOIDCIdentityOrder.Field {
+		query = query.Order(DefaultOIDCIdentityOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -2234,7 +4725,7 @@ func (p *channelprobePager) applyOrder(query *ChannelProbeQuery) *ChannelProbeQu
 	return query
 }
 
-func (p *channelprobePager) orderExpr(query *ChannelProbeQuery) sql.Querier {
+func (p *oidcidentityPager) orderExpr(query *OIDCIdentityQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -2244,28 +4735,28 @@ func (p *channelprobePager) orderExpr(query *ChannelProbeQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultChannelProbeOrder.Field {
-			b.Comma().Ident(DefaultChannelProbeOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultOIDCIdentityOrder.Field {
+			b.Comma().Ident(DefaultOIDCIdentityOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to ChannelProbe.
-func (_m *ChannelProbeQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to OIDCIdentity.
+func (_m *OIDCIdentityQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ChannelProbePaginateOption,
-) (*ChannelProbeConnection, error) {
+	before *Cursor, last *int, opts ...OIDCIdentityPaginateOption,
+) (*OIDCIdentityConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newChannelProbePager(opts, last != nil)
+	pager, err := newOIDCIdentityPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &ChannelProbeConnection{Edges: []*ChannelProbeEdge{}}
+	conn := &OIDCIdentityConnection{Edges: []*OIDCIdentityEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -2303,61 +4794,126 @@ func (_m *ChannelProbeQuery) Paginate(
 	return conn, nil
 }
 
-// ChannelProbeOrderField defines the ordering field of ChannelProbe.
-type ChannelProbeOrderField struct {
-	// Value extracts the ordering value from the given ChannelProbe.
-	Value    func(*ChannelProbe) (ent.Value, error)
+var (
+	// OIDCIdentityOrderFieldCreatedAt orders OIDCIdentity by created_at.
+	OIDCIdentityOrderFieldCreatedAt = &OIDCIdentityOrderField{
+		Value: func(_m *OIDCIdentity) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: oidcidentity.FieldCreatedAt,
+		toTerm: oidcidentity.ByCreatedAt,
+		toCursor: func(_m *OIDCIdentity) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+	// OIDCIdentityOrderFieldUpdatedAt orders OIDCIdentity by updated_at.
+	OIDCIdentityOrderFieldUpdatedAt = &OIDCIdentityOrderField{
+		Value: func(_m *OIDCIdentity) (ent.Value, error) {
+			return _m.UpdatedAt, nil
+		},
+		column: oidcidentity.FieldUpdatedAt,
+		toTerm: oidcidentity.ByUpdatedAt,
+		toCursor: func(_m *OIDCIdentity) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.UpdatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f OIDCIdentityOrderField) String() string {
+	var str string
+	switch f.column {
+	case OIDCIdentityOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case OIDCIdentityOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f OIDCIdentityOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *OIDCIdentityOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("OIDCIdentityOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *OIDCIdentityOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *OIDCIdentityOrderFieldUpdatedAt
+	default:
+		return fmt.Errorf("%s is not a valid OIDCIdentityOrderField", str)
+	}
+	return nil
+}
+
+// OIDCIdentityOrderField defines the ordering field of OIDCIdentity.
+type OIDCIdentityOrderField struct {
+	// Value extracts the ordering value from the given OIDCIdentity.
+	Value    func(*OIDCIdentity) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) channelprobe.OrderOption
-	toCursor func(*ChannelProbe) Cursor
+	toTerm   func(...sql.OrderTermOption) oidcidentity.OrderOption
+	toCursor func(*OIDCIdentity) Cursor
 }
 
-// ChannelProbeOrder defines the ordering of ChannelProbe.
-type ChannelProbeOrder struct {
+// OIDCIdentityOrder defines the ordering of OIDCIdentity.
+type OIDCIdentityOrder struct {
 	Direction OrderDirection          `json:"direction"`
-	Field     *ChannelProbeOrderField `json:"field"`
+	Field     *OIDCIdentityOrderField `json:"field"`
 }
 
-// DefaultChannelProbeOrder is the default ordering of ChannelProbe.
-var DefaultChannelProbeOrder = &ChannelProbeOrder{
+// DefaultOIDCIdentityOrder is the default ordering of OIDCIdentity.
+var DefaultOIDCIdentityOrder = &OIDCIdentityOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &ChannelProbeOrderField{
-		Value: func(_m *ChannelProbe) (ent.Value, error) {
+	Field: &OIDCIdentityOrderField{
+		Value: func(_m *OIDCIdentity) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: channelprobe.FieldID,
-		toTerm: channelprobe.ByID,
-		toCursor: func(_m *ChannelProbe) Cursor {
+		column: oidcidentity.FieldID,
+		toTerm: oidcidentity.ByID,
+		toCursor: func(_m *OIDCIdentity) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts ChannelProbe into ChannelProbeEdge.
-func (_m *ChannelProbe) ToEdge(order *ChannelProbeOrder) *ChannelProbeEdge {
+// ToEdge converts OIDCIdentity into OIDCIdentityEdge.
+func (_m *OIDCIdentity) ToEdge(order *OIDCIdentityOrder) *OIDCIdentityEdge {
 	if order == nil {
-		order = DefaultChannelProbeOrder
+		order = DefaultOIDCIdentityOrder
 	}
-	return &ChannelProbeEdge{
+	return &OIDCIdentityEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// DataStorageEdge is the edge representation of DataStorage.
-type DataStorageEdge struct {
-	Node   *DataStorage `json:"node"`
-	Cursor Cursor       `json:"cursor"`
+// ProjectEdge is the edge representation of Project.
+type ProjectEdge struct {
+	Node   *Project `json:"node"`
+	Cursor Cursor   `json:"cursor"`
 }
 
-// DataStorageConnection is the connection containing edges to DataStorage.
-type DataStorageConnection struct {
-	Edges      []*DataStorageEdge `json:"edges"`
-	PageInfo   PageInfo           `json:"pageInfo"`
-	TotalCount int                `json:"totalCount"`
+// ProjectConnection is the connection containing edges to Project.
+type ProjectConnection struct {
+	Edges      []*ProjectEdge `json:"edges"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
 }
 
-func (c *DataStorageConnection) build(nodes []*DataStorage, pager *datastoragePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *ProjectConnection) build(nodes []*Project, pager *projectPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -2367,21 +4923,21 @@ func (c *DataStorageConnection) build(nodes []*DataStorage, pager *datastoragePa
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *DataStorage
+	var nodeAt func(int) *Project
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *DataStorage {
+		nodeAt = func(i int) *Project {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *DataStorage {
+		nodeAt = func(i int) *Project {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*DataStorageEdge, len(nodes))
+	c.Edges = make([]*ProjectEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &DataStorageEdge{
+		c.Edges[i] = &ProjectEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -2395,87 +4951,87 @@ func (c *DataStorageConnection) build(nodes []*DataStorage, pager *datastoragePa
 	}
 }
 
-// DataStoragePaginateOption enables pagination customization.
-type DataStoragePaginateOption func(*datastoragePager) error
+// ProjectPaginateOption enables pagination customization.
+type ProjectPaginateOption func(*projectPager) error
 
-// WithDataStorageOrder configures pagination ordering.
-func WithDataStorageOrder(order *DataStorageOrder) DataStoragePaginateOption {
+// WithProjectOrder configures pagination ordering.
+func WithProjectOrder(order *ProjectOrder) ProjectPaginateOption {
 	if order == nil {
-		order = DefaultDataStorageOrder
+		order = DefaultProjectOrder
 	}
 	o := *order
-	return func(pager *datastoragePager) error {
+	return func(pager *projectPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultDataStorageOrder.Field
+			o.Field = DefaultProjectOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithDataStorageFilter configures pagination filter.
-func WithDataStorageFilter(filter func(*DataStorageQuery) (*DataStorageQuery, error)) DataStoragePaginateOption {
-	return func(pager *datastoragePager) error {
+// WithProjectFilter configures pagination filter.
+func WithProjectFilter(filter func(*ProjectQuery) (*ProjectQuery, error)) ProjectPaginateOption {
+	return func(pager *projectPager) error {
 		if filter == nil {
-			return errors.New("DataStorageQuery filter cannot be nil")
+			return errors.New("ProjectQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type datastoragePager struct {
+type projectPager struct {
 	reverse bool
-	order   *DataStorageOrder
-	filter  func(*DataStorageQuery) (*DataStorageQuery, error)
+	order   *ProjectOrder
+	filter  func(*ProjectQuery) (*ProjectQuery, error)
 }
 
-func newDataStoragePager(opts []DataStoragePaginateOption, reverse bool) (*datastoragePager, error) {
-	pager := &datastoragePager{reverse: reverse}
+func newProjectPager(opts []ProjectPaginateOption, reverse bool) (*projectPager, error) {
+	pager := &projectPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultDataStorageOrder
+		pager.order = DefaultProjectOrder
 	}
 	return pager, nil
 }
 
-func (p *datastoragePager) applyFilter(query *DataStorageQuery) (*DataStorageQuery, error) {
+func (p *projectPager) applyFilter(query *ProjectQuery) (*ProjectQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *datastoragePager) toCursor(_m *DataStorage) Cursor {
+func (p *projectPager) toCursor(_m *Project) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *datastoragePager) applyCursors(query *DataStorageQuery, after, before *Cursor) (*DataStorageQuery, error) {
+func (p *projectPager) applyCursors(query *ProjectQuery, after, before *Cursor) (*ProjectQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultDataStorageOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProjectOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *datastoragePager) applyOrder(query *DataStorageQuery) *DataStorageQuery {
+func (p *projectPager) applyOrder(query *ProjectQuery) *ProjectQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultDataStorageOrder.Field {
-		query = query.Order(DefaultDataStorageOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultProjectOrder.Field {
+		query = query.Order(DefaultProjectOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -2483,7 +5039,7 @@ func (p *datastoragePager) applyOrder(query *DataStorageQuery) *DataStorageQuery
 	return query
 }
 
-func (p *datastoragePager) orderExpr(query *DataStorageQuery) sql.Querier {
+func (p *projectPager) orderExpr(query *ProjectQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -2493,28 +5049,28 @@ func (p *datastoragePager) orderExpr(query *DataStorageQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultDataStorageOrder.Field {
-			b.Comma().Ident(DefaultDataStorageOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultProjectOrder.Field {
+			b.Comma().Ident(DefaultProjectOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to DataStorage.
-func (_m *DataStorageQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Project.
+func (_m *ProjectQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...DataStoragePaginateOption,
-) (*DataStorageConnection, error) {
+	before *Cursor, last *int, opts ...ProjectPaginateOption,
+) (*ProjectConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newDataStoragePager(opts, last != nil)
+	pager, err := newProjectPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &DataStorageConnection{Edges: []*DataStorageEdge{}}
+	conn := &ProjectConnection{Edges: []*ProjectEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -2553,28 +5109,28 @@ func (_m *DataStorageQuery) Paginate(
 }
 
 var (
-	// DataStorageOrderFieldCreatedAt orders DataStorage by created_at.
-	DataStorageOrderFieldCreatedAt = &DataStorageOrderField{
-		Value: func(_m *DataStorage) (ent.Value, error) {
+	// ProjectOrderFieldCreatedAt orders Project by created_at.
+	ProjectOrderFieldCreatedAt = &ProjectOrderField{
+		Value: func(_m *Project) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: datastorage.FieldCreatedAt,
-		toTerm: datastorage.ByCreatedAt,
-		toCursor: func(_m *DataStorage) Cursor {
+		column: project.FieldCreatedAt,
+		toTerm: project.ByCreatedAt,
+		toCursor: func(_m *Project) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// DataStorageOrderFieldUpdatedAt orders DataStorage by updated_at.
-	DataStorageOrderFieldUpdatedAt = &DataStorageOrderField{
-		Value: func(_m *DataStorage) (ent.Value, error) {
+	// ProjectOrderFieldUpdatedAt orders Project by updated_at.
+	ProjectOrderFieldUpdatedAt = &ProjectOrderField{
+		Value: func(_m *Project) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: datastorage.FieldUpdatedAt,
-		toTerm: datastorage.ByUpdatedAt,
-		toCursor: func(_m *DataStorage) Cursor {
+		column: project.FieldUpdatedAt,
+		toTerm: project.ByUpdatedAt,
+		toCursor: func(_m *Project) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
@@ -2584,94 +5140,94 @@ var (
 )
 
 // String implement fmt.Stringer interface.
-func (f DataStorageOrderField) String() string {
+func (f ProjectOrderField) String() string {
 	var str string
 	switch f.column {
-	case DataStorageOrderFieldCreatedAt.column:
+	case ProjectOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case DataStorageOrderFieldUpdatedAt.column:
+	case ProjectOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f DataStorageOrderField) MarshalGQL(w io.Writer) {
+func (f ProjectOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *DataStorageOrderField) UnmarshalGQL(v interface{}) error {
+func (f *ProjectOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("DataStorageOrderField %T must be a string", v)
+		return fmt.Errorf("ProjectOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *DataStorageOrderFieldCreatedAt
+		*f = *ProjectOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *DataStorageOrderFieldUpdatedAt
+		*f = *ProjectOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid DataStorageOrderField", str)
+		return fmt.Errorf("%s is not a valid ProjectOrderField", str)
 	}
 	return nil
 }
 
-// DataStorageOrderField defines the ordering field of DataStorage.
-type DataStorageOrderField struct {
-	// Value extracts the ordering value from the given DataStorage.
-	Value    func(*DataStorage) (ent.Value, error)
+// ProjectOrderField defines the ordering field of Project.
+type ProjectOrderField struct {
+	// Value extracts the ordering value from the given Project.
+	Value    func(*Project) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) datastorage.OrderOption
-	toCursor func(*DataStorage) Cursor
+	toTerm   func(...sql.OrderTermOption) project.OrderOption
+	toCursor func(*Project) Cursor
 }
 
-// DataStorageOrder defines the ordering of DataStorage.
-type DataStorageOrder struct {
-	Direction OrderDirection         `json:"direction"`
-	Field     *DataStorageOrderField `json:"field"`
+// ProjectOrder defines the ordering of Project.
+type ProjectOrder struct {
+	Direction OrderDirection     `json:"direction"`
+	Field     *ProjectOrderField `json:"field"`
 }
 
-// DefaultDataStorageOrder is the default ordering of DataStorage.
-var DefaultDataStorageOrder = &DataStorageOrder{
+// DefaultProjectOrder is the default ordering of Project.
+var DefaultProjectOrder = &ProjectOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &DataStorageOrderField{
-		Value: func(_m *DataStorage) (ent.Value, error) {
+	Field: &ProjectOrderField{
+		Value: func(_m *Project) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: datastorage.FieldID,
-		toTerm: datastorage.ByID,
-		toCursor: func(_m *DataStorage) Cursor {
+		column: project.FieldID,
+		toTerm: project.ByID,
+		toCursor: func(_m *Project) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts DataStorage into DataStorageEdge.
-func (_m *DataStorage) ToEdge(order *DataStorageOrder) *DataStorageEdge {
+// ToEdge converts Project into ProjectEdge.
+func (_m *Project) ToEdge(order *ProjectOrder) *ProjectEdge {
 	if order == nil {
-		order = DefaultDataStorageOrder
+		order = DefaultProjectOrder
 	}
-	return &DataStorageEdge{
+	return &ProjectEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// ModelEdge is the edge representation of Model.
-type ModelEdge struct {
-	Node   *Model `json:"node"`
-	Cursor Cursor `json:"cursor"`
+// PromptEdge is the edge representation of Prompt.
+type PromptEdge struct {
+	Node   *Prompt `json:"node"`
+	Cursor Cursor  `json:"cursor"`
 }
 
-// ModelConnection is the connection containing edges to Model.
-type ModelConnection struct {
-	Edges      []*ModelEdge `json:"edges"`
-	PageInfo   PageInfo     `json:"pageInfo"`
-	TotalCount int          `json:"totalCount"`
+// PromptConnection is the connection containing edges to Prompt.
+type PromptConnection struct {
+	Edges      []*PromptEdge `json:"edges"`
+	PageInfo   PageInfo      `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
 }
 
-func (c *ModelConnection) build(nodes []*Model, pager *modelPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *PromptConnection) build(nodes []*Prompt, pager *promptPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -2681,21 +5237,21 @@ func (c *ModelConnection) build(nodes []*Model, pager *modelPager, after *Cursor
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Model
+	var nodeAt func(int) *Prompt
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Model {
+		nodeAt = func(i int) *Prompt {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Model {
+		nodeAt = func(i int) *Prompt {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*ModelEdge, len(nodes))
+	c.Edges = make([]*PromptEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &ModelEdge{
+		c.Edges[i] = &PromptEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -2709,87 +5265,87 @@ func (c *ModelConnection) build(nodes []*Model, pager *modelPager, after *Cursor
 	}
 }
 
-// ModelPaginateOption enables pagination customization.
-type ModelPaginateOption func(*modelPager) error
+// PromptPaginateOption enables pagination customization.
+type PromptPaginateOption func(*promptPager) error
 
-// WithModelOrder configures pagination ordering.
-func WithModelOrder(order *ModelOrder) ModelPaginateOption {
+// WithPromptOrder configures pagination ordering.
+func WithPromptOrder(order *PromptOrder) PromptPaginateOption {
 	if order == nil {
-		order = DefaultModelOrder
+		order = DefaultPromptOrder
 	}
 	o := *order
-	return func(pager *modelPager) error {
+	return func(pager *promptPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultModelOrder.Field
+			o.Field = DefaultPromptOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithModelFilter configures pagination filter.
-func WithModelFilter(filter func(*ModelQuery) (*ModelQuery, error)) ModelPaginateOption {
-	return func(pager *modelPager) error {
+// WithPromptFilter configures pagination filter.
+func WithPromptFilter(filter func(*PromptQuery) (*PromptQuery, error)) PromptPaginateOption {
+	return func(pager *promptPager) error {
 		if filter == nil {
-			return errors.New("ModelQuery filter cannot be nil")
+			return errors.New("PromptQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type modelPager struct {
+type promptPager struct {
 	reverse bool
-	order   *ModelOrder
-	filter  func(*ModelQuery) (*ModelQuery, error)
+	order   *PromptOrder
+	filter  func(*PromptQuery) (*PromptQuery, error)
 }
 
-func newModelPager(opts []ModelPaginateOption, reverse bool) (*modelPager, error) {
-	pager := &modelPager{reverse: reverse}
+func newPromptPager(opts []PromptPaginateOption, reverse bool) (*promptPager, error) {
+	pager := &promptPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultModelOrder
+		pager.order = DefaultPromptOrder
 	}
 	return pager, nil
 }
 
-func (p *modelPager) applyFilter(query *ModelQuery) (*ModelQuery, error) {
+func (p *promptPager) applyFilter(query *PromptQuery) (*PromptQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *modelPager) toCursor(_m *Model) Cursor {
+func (p *promptPager) toCursor(_m *Prompt) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *modelPager) applyCursors(query *ModelQuery, after, before *Cursor) (*ModelQuery, error) {
+func (p *promptPager) applyCursors(query *PromptQuery, after, before *Cursor) (*PromptQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultModelOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultPromptOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *modelPager) applyOrder(query *ModelQuery) *ModelQuery {
+func (p *promptPager) applyOrder(query *PromptQuery) *PromptQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultModelOrder.Field {
-		query = query.Order(DefaultModelOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultPromptOrder.Field {
+		query = query.Order(DefaultPromptOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -2797,7 +5353,7 @@ func (p *modelPager) applyOrder(query *ModelQuery) *ModelQuery {
 	return query
 }
 
-func (p *modelPager) orderExpr(query *ModelQuery) sql.Querier {
+func (p *promptPager) orderExpr(query *PromptQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -2807,28 +5363,28 @@ func (p *modelPager) orderExpr(query *ModelQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultModelOrder.Field {
-			b.Comma().Ident(DefaultModelOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultPromptOrder.Field {
+			b.Comma().Ident(DefaultPromptOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Model.
-func (_m *ModelQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Prompt.
+func (_m *PromptQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ModelPaginateOption,
-) (*ModelConnection, error) {
+	before *Cursor, last *int, opts ...PromptPaginateOption,
+) (*PromptConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newModelPager(opts, last != nil)
+	pager, err := newPromptPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &ModelConnection{Edges: []*ModelEdge{}}
+	conn := &PromptConnection{Edges: []*PromptEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -2867,143 +5423,143 @@ func (_m *ModelQuery) Paginate(
 }
 
 var (
-	// ModelOrderFieldCreatedAt orders Model by created_at.
-	ModelOrderFieldCreatedAt = &ModelOrderField{
-		Value: func(_m *Model) (ent.Value, error) {
+	// PromptOrderFieldCreatedAt orders Prompt by created_at.
+	PromptOrderFieldCreatedAt = &PromptOrderField{
+		Value: func(_m *Prompt) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: model.FieldCreatedAt,
-		toTerm: model.ByCreatedAt,
-		toCursor: func(_m *Model) Cursor {
+		column: prompt.FieldCreatedAt,
+		toTerm: prompt.ByCreatedAt,
+		toCursor: func(_m *Prompt) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// ModelOrderFieldUpdatedAt orders Model by updated_at.
-	ModelOrderFieldUpdatedAt = &ModelOrderField{
-		Value: func(_m *Model) (ent.Value, error) {
+	// PromptOrderFieldUpdatedAt orders Prompt by updated_at.
+	PromptOrderFieldUpdatedAt = &PromptOrderField{
+		Value: func(_m *Prompt) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: model.FieldUpdatedAt,
-		toTerm: model.ByUpdatedAt,
-		toCursor: func(_m *Model) Cursor {
+		column: prompt.FieldUpdatedAt,
+		toTerm: prompt.ByUpdatedAt,
+		toCursor: func(_m *Prompt) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
-	// ModelOrderFieldName orders Model by name.
-	ModelOrderFieldName = &ModelOrderField{
-		Value: func(_m *Model) (ent.Value, error) {
-			return _m.Name, nil
+	// PromptOrderFieldOrder orders Prompt by order.
+	PromptOrderFieldOrder = &PromptOrderField{
+		Value: func(_m *Prompt) (ent.Value, error) {
+			return _m.Order, nil
 		},
-		column: model.FieldName,
-		toTerm: model.ByName,
-		toCursor: func(_m *Model) Cursor {
+		column: prompt.FieldOrder,
+		toTerm: prompt.ByOrder,
+		toCursor: func(_m *Prompt) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.Name,
+				Value: _m.Order,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f ModelOrderField) String() string {
+func (f PromptOrderField) String() string {
 	var str string
 	switch f.column {
-	case ModelOrderFieldCreatedAt.column:
+	case PromptOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case ModelOrderFieldUpdatedAt.column:
+	case PromptOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
-	case ModelOrderFieldName.column:
-		str = "NAME"
+	case PromptOrderFieldOrder.column:
+		str = "ORDER"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f ModelOrderField) MarshalGQL(w io.Writer) {
+func (f PromptOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *ModelOrderField) UnmarshalGQL(v interface{}) error {
+func (f *PromptOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("ModelOrderField %T must be a string", v)
+		return fmt.Errorf("PromptOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *ModelOrderFieldCreatedAt
+		*f = *PromptOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *ModelOrderFieldUpdatedAt
-	case "NAME":
-		*f = *ModelOrderFieldName
+		*f = *PromptOrderFieldUpdatedAt
+	case "ORDER":
+		*f = *PromptOrderFieldOrder
 	default:
-		return fmt.Errorf("%s is not a valid ModelOrderField", str)
+		return fmt.Errorf("%s is not a valid PromptOrderField", str)
 	}
 	return nil
 }
 
-// ModelOrderField defines the ordering field of Model.
-type ModelOrderField struct {
-	// Value extracts the ordering value from the given Model.
-	Value    func(*Model) (ent.Value, error)
+// PromptOrderField defines the ordering field of Prompt.
+type PromptOrderField struct {
+	// Value extracts the ordering value from the given Prompt.
+	Value    func(*Prompt) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) model.OrderOption
-	toCursor func(*Model) Cursor
+	toTerm   func(...sql.OrderTermOption) prompt.OrderOption
+	toCursor func(*Prompt) Cursor
 }
 
-// ModelOrder defines the ordering of Model.
-type ModelOrder struct {
-	Direction OrderDirection   `json:"direction"`
-	Field     *ModelOrderField `json:"field"`
+// PromptOrder defines the ordering of Prompt.
+type PromptOrder struct {
+	Direction OrderDirection    `json:"direction"`
+	Field     *PromptOrderField `json:"field"`
 }
 
-// DefaultModelOrder is the default ordering of Model.
-var DefaultModelOrder = &ModelOrder{
+// DefaultPromptOrder is the default ordering of Prompt.
+var DefaultPromptOrder = &PromptOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &ModelOrderField{
-		Value: func(_m *Model) (ent.Value, error) {
+	Field: &PromptOrderField{
+		Value: func(_m *Prompt) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: model.FieldID,
-		toTerm: model.ByID,
-		toCursor: func(_m *Model) Cursor {
+		column: prompt.FieldID,
+		toTerm: prompt.ByID,
+		toCursor: func(_m *Prompt) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Model into ModelEdge.
-func (_m *Model) ToEdge(order *ModelOrder) *ModelEdge {
+// ToEdge converts Prompt into PromptEdge.
+func (_m *Prompt) ToEdge(order *PromptOrder) *PromptEdge {
 	if order == nil {
-		order = DefaultModelOrder
+		order = DefaultPromptOrder
 	}
-	return &ModelEdge{
+	return &PromptEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// OIDCIdentityEdge is the edge representation of OIDCIdentity.
-type OIDCIdentityEdge struct {
-	Node   *OIDCIdentity `json:"node"`
-	Cursor Cursor        `json:"cursor"`
+// PromptProtectionRuleEdge is the edge representation of PromptProtectionRule.
+type PromptProtectionRuleEdge struct {
+	Node   *PromptProtectionRule `json:"node"`
+	Cursor Cursor                `json:"cursor"`
 }
 
-// OIDCIdentityConnection is the connection containing edges to OIDCIdentity.
-type OIDCIdentityConnection struct {
-	Edges      []*OIDCIdentityEdge `json:"edges"`
-	PageInfo   PageInfo            `json:"pageInfo"`
-	TotalCount int                 `json:"totalCount"`
+// PromptProtectionRuleConnection is the connection containing edges to PromptProtectionRule.
+type PromptProtectionRuleConnection struct {
+	Edges      []*PromptProtectionRuleEdge `json:"edges"`
+	PageInfo   PageInfo                    `json:"pageInfo"`
+	TotalCount int                         `json:"totalCount"`
 }
 
-func (c *OIDCIdentityConnection) build(nodes []*OIDCIdentity, pager *oidcidentityPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *PromptProtectionRuleConnection) build(nodes []*PromptProtectionRule, pager *promptprotectionrulePager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -3013,21 +5569,21 @@ func (c *OIDCIdentityConnection) build(nodes []*OIDCIdentity, pager *oidcidentit
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *OIDCIdentity
+	var nodeAt func(int) *PromptProtectionRule
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *OIDCIdentity {
+		nodeAt = func(i int) *PromptProtectionRule {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *OIDCIdentity {
+		nodeAt = func(i int) *PromptProtectionRule {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*OIDCIdentityEdge, len(nodes))
+	c.Edges = make([]*PromptProtectionRuleEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &OIDCIdentityEdge{
+		c.Edges[i] = &PromptProtectionRuleEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -3041,87 +5597,87 @@ func (c *OIDCIdentityConnection) build(nodes []*OIDCIdentity, pager *oidcidentit
 	}
 }
 
-// OIDCIdentityPaginateOption enables pagination customization.
-type OIDCIdentityPaginateOption func(*oidcidentityPager) error
+// PromptProtectionRulePaginateOption enables pagination customization.
+type PromptProtectionRulePaginateOption func(*promptprotectionrulePager) error
 
-// WithOIDCIdentityOrder configures pagination ordering.
-func WithOIDCIdentityOrder(order *OIDCIdentityOrder) OIDCIdentityPaginateOption {
+// WithPromptProtectionRuleOrder configures pagination ordering.
+func WithPromptProtectionRuleOrder(order *PromptProtectionRuleOrder) PromptProtectionRulePaginateOption {
 	if order == nil {
-		order = DefaultOIDCIdentityOrder
+		order = DefaultPromptProtectionRuleOrder
 	}
 	o := *order
-	return func(pager *oidcidentityPager) error {
+	return func(pager *promptprotectionrulePager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultOIDCIdentityOrder.Field
+			o.Field = DefaultPromptProtectionRuleOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithOIDCIdentityFilter configures pagination filter.
-func WithOIDCIdentityFilter(filter func(*OIDCIdentityQuery) (*OIDCIdentityQuery, error)) OIDCIdentityPaginateOption {
-	return func(pager *oidcidentityPager) error {
+// WithPromptProtectionRuleFilter configures pagination filter.
+func WithPromptProtectionRuleFilter(filter func(*PromptProtectionRuleQuery) (*PromptProtectionRuleQuery, error)) PromptProtectionRulePaginateOption {
+	return func(pager *promptprotectionrulePager) error {
 		if filter == nil {
-			return errors.New("OIDCIdentityQuery filter cannot be nil")
+			return errors.New("PromptProtectionRuleQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type oidcidentityPager struct {
+type promptprotectionrulePager struct {
 	reverse bool
-	order   *OIDCIdentityOrder
-	filter  func(*OIDCIdentityQuery) (*OIDCIdentityQuery, error)
+	order   *PromptProtectionRuleOrder
+	filter  func(*PromptProtectionRuleQuery) (*PromptProtectionRuleQuery, error)
 }
 
-func newOIDCIdentityPager(opts []OIDCIdentityPaginateOption, reverse bool) (*oidcidentityPager, error) {
-	pager := &oidcidentityPager{reverse: reverse}
+func newPromptProtectionRulePager(opts []PromptProtectionRulePaginateOption, reverse bool) (*promptprotectionrulePager, error) {
+	pager := &promptprotectionrulePager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultOIDCIdentityOrder
+		pager.order = DefaultPromptProtectionRuleOrder
 	}
 	return pager, nil
 }
 
-func (p *oidcidentityPager) applyFilter(query *OIDCIdentityQuery) (*OIDCIdentityQuery, error) {
+func (p *promptprotectionrulePager) applyFilter(query *PromptProtectionRuleQuery) (*PromptProtectionRuleQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *oidcidentityPager) toCursor(_m *OIDCIdentity) Cursor {
+func (p *promptprotectionrulePager) toCursor(_m *PromptProtectionRule) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *oidcidentityPager) applyCursors(query *OIDCIdentityQuery, after, before *Cursor) (*OIDCIdentityQuery, error) {
+func (p *promptprotectionrulePager) applyCursors(query *PromptProtectionRuleQuery, after, before *Cursor) (*PromptProtectionRuleQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultOIDCIdentityOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultPromptProtectionRuleOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *oidcidentityPager) applyOrder(query *OIDCIdentityQuery) *OIDCIdentityQuery {
+func (p *promptprotectionrulePager) applyOrder(query *PromptProtectionRuleQuery) *PromptProtectionRuleQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultOIDCIdentityOrder.Field {
-		query = query.Order(DefaultOIDCIdentityOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultPromptProtectionRuleOrder.Field {
+		query = query.Order(DefaultPromptProtectionRuleOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -3129,7 +5685,7 @@ func (p *oidcidentityPager) applyOrder(query *OIDCIdentityQuery) *OIDCIdentityQu
 	return query
 }
 
-func (p *oidcidentityPager) orderExpr(query *OIDCIdentityQuery) sql.Querier {
+func (p *promptprotectionrulePager) orderExpr(query *PromptProtectionRuleQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -3139,28 +5695,28 @@ func (p *oidcidentityPager) orderExpr(query *OIDCIdentityQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultOIDCIdentityOrder.Field {
-			b.Comma().Ident(DefaultOIDCIdentityOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultPromptProtectionRuleOrder.Field {
+			b.Comma().Ident(DefaultPromptProtectionRuleOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to OIDCIdentity.
-func (_m *OIDCIdentityQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to PromptProtectionRule.
+func (_m *PromptProtectionRuleQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...OIDCIdentityPaginateOption,
-) (*OIDCIdentityConnection, error) {
+	before *Cursor, last *int, opts ...PromptProtectionRulePaginateOption,
+) (*PromptProtectionRuleConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newOIDCIdentityPager(opts, last != nil)
+	pager, err := newPromptProtectionRulePager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &OIDCIdentityConnection{Edges: []*OIDCIdentityEdge{}}
+	conn := &PromptProtectionRuleConnection{Edges: []*PromptProtectionRuleEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -3199,125 +5755,143 @@ func (_m *OIDCIdentityQuery) Paginate(
 }
 
 var (
-	// OIDCIdentityOrderFieldCreatedAt orders OIDCIdentity by created_at.
-	OIDCIdentityOrderFieldCreatedAt = &OIDCIdentityOrderField{
-		Value: func(_m *OIDCIdentity) (ent.Value, error) {
+	// PromptProtectionRuleOrderFieldCreatedAt orders PromptProtectionRule by created_at.
+	PromptProtectionRuleOrderFieldCreatedAt = &PromptProtectionRuleOrderField{
+		Value: func(_m *PromptProtectionRule) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: oidcidentity.FieldCreatedAt,
-		toTerm: oidcidentity.ByCreatedAt,
-		toCursor: func(_m *OIDCIdentity) Cursor {
+		column: promptprotectionrule.FieldCreatedAt,
+		toTerm: promptprotectionrule.ByCreatedAt,
+		toCursor: func(_m *PromptProtectionRule) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// OIDCIdentityOrderFieldUpdatedAt orders OIDCIdentity by updated_at.
-	OIDCIdentityOrderFieldUpdatedAt = &OIDCIdentityOrderField{
-		Value: func(_m *OIDCIdentity) (ent.Value, error) {
+	// PromptProtectionRuleOrderFieldUpdatedAt orders PromptProtectionRule by updated_at.
+	PromptProtectionRuleOrderFieldUpdatedAt = &PromptProtectionRuleOrderField{
+		Value: func(_m *PromptProtectionRule) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: oidcidentity.FieldUpdatedAt,
-		toTerm: oidcidentity.ByUpdatedAt,
-		toCursor: func(_m *OIDCIdentity) Cursor {
+		column: promptprotectionrule.FieldUpdatedAt,
+		toTerm: promptprotectionrule.ByUpdatedAt,
+		toCursor: func(_m *PromptProtectionRule) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
+	// PromptProtectionRuleOrderFieldName orders PromptProtectionRule by name.
+	PromptProtectionRuleOrderFieldName = &PromptProtectionRuleOrderField{
+		Value: func(_m *PromptProtectionRule) (ent.Value, error) {
+			return _m.Name, nil
+		},
+		column: promptprotectionrule.FieldName,
+		toTerm: promptprotectionrule.ByName,
+		toCursor: func(_m *PromptProtectionRule) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Name,
+			}
+		},
+	}
 )
 
 // String implement fmt.Stringer interface.
-func (f OIDCIdentityOrderField) String() string {
+func (f PromptProtectionRuleOrderField) String() string {
 	var str string
 	switch f.column {
-	case OIDCIdentityOrderFieldCreatedAt.column:
+	case PromptProtectionRuleOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case OIDCIdentityOrderFieldUpdatedAt.column:
+	case PromptProtectionRuleOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
+	case PromptProtectionRuleOrderFieldName.column:
+		str = "NAME"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f OIDCIdentityOrderField) MarshalGQL(w io.Writer) {
+func (f PromptProtectionRuleOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *OIDCIdentityOrderField) UnmarshalGQL(v interface{}) error {
+func (f *PromptProtectionRuleOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("OIDCIdentityOrderField %T must be a string", v)
+		return fmt.Errorf("PromptProtectionRuleOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *OIDCIdentityOrderFieldCreatedAt
+		*f = *PromptProtectionRuleOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *OIDCIdentityOrderFieldUpdatedAt
+		*f = *PromptProtectionRuleOrderFieldUpdatedAt
+	case "NAME":
+		*f = *PromptProtectionRuleOrderFieldName
 	default:
-		return fmt.Errorf("%s is not a valid OIDCIdentityOrderField", str)
+		return fmt.Errorf("%s is not a valid PromptProtectionRuleOrderField", str)
 	}
 	return nil
 }
 
-// OIDCIdentityOrderField defines the ordering field of OIDCIdentity.
-type OIDCIdentityOrderField struct {
-	// Value extracts the ordering value from the given OIDCIdentity.
-	Value    func(*OIDCIdentity) (ent.Value, error)
+// PromptProtectionRuleOrderField defines the ordering field of PromptProtectionRule.
+type PromptProtectionRuleOrderField struct {
+	// Value extracts the ordering value from the given PromptProtectionRule.
+	Value    func(*PromptProtectionRule) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) oidcidentity.OrderOption
-	toCursor func(*OIDCIdentity) Cursor
+	toTerm   func(...sql.OrderTermOption) promptprotectionrule.OrderOption
+	toCursor func(*PromptProtectionRule) Cursor
 }
 
-// OIDCIdentityOrder defines the ordering of OIDCIdentity.
-type OIDCIdentityOrder struct {
-	Direction OrderDirection          `json:"direction"`
-	Field     *OIDCIdentityOrderField `json:"field"`
+// PromptProtectionRuleOrder defines the ordering of PromptProtectionRule.
+type PromptProtectionRuleOrder struct {
+	Direction OrderDirection                  `json:"direction"`
+	Field     *PromptProtectionRuleOrderField `json:"field"`
 }
 
-// DefaultOIDCIdentityOrder is the default ordering of OIDCIdentity.
-var DefaultOIDCIdentityOrder = &OIDCIdentityOrder{
+// DefaultPromptProtectionRuleOrder is the default ordering of PromptProtectionRule.
+var DefaultPromptProtectionRuleOrder = &PromptProtectionRuleOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &OIDCIdentityOrderField{
-		Value: func(_m *OIDCIdentity) (ent.Value, error) {
+	Field: &PromptProtectionRuleOrderField{
+		Value: func(_m *PromptProtectionRule) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: oidcidentity.FieldID,
-		toTerm: oidcidentity.ByID,
-		toCursor: func(_m *OIDCIdentity) Cursor {
+		column: promptprotectionrule.FieldID,
+		toTerm: promptprotectionrule.ByID,
+		toCursor: func(_m *PromptProtectionRule) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts OIDCIdentity into OIDCIdentityEdge.
-func (_m *OIDCIdentity) ToEdge(order *OIDCIdentityOrder) *OIDCIdentityEdge {
+// ToEdge converts PromptProtectionRule into PromptProtectionRuleEdge.
+func (_m *PromptProtectionRule) ToEdge(order *PromptProtectionRuleOrder) *PromptProtectionRuleEdge {
 	if order == nil {
-		order = DefaultOIDCIdentityOrder
+		order = DefaultPromptProtectionRuleOrder
 	}
-	return &OIDCIdentityEdge{
+	return &PromptProtectionRuleEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// ProjectEdge is the edge representation of Project.
-type ProjectEdge struct {
-	Node   *Project `json:"node"`
-	Cursor Cursor   `json:"cursor"`
+// ProviderQuotaStatusEdge is the edge representation of ProviderQuotaStatus.
+type ProviderQuotaStatusEdge struct {
+	Node   *ProviderQuotaStatus `json:"node"`
+	Cursor Cursor               `json:"cursor"`
 }
 
-// ProjectConnection is the connection containing edges to Project.
-type ProjectConnection struct {
-	Edges      []*ProjectEdge `json:"edges"`
-	PageInfo   PageInfo       `json:"pageInfo"`
-	TotalCount int            `json:"totalCount"`
+// ProviderQuotaStatusConnection is the connection containing edges to ProviderQuotaStatus.
+type ProviderQuotaStatusConnection struct {
+	Edges      []*ProviderQuotaStatusEdge `json:"edges"`
+	PageInfo   PageInfo                   `json:"pageInfo"`
+	TotalCount int                        `json:"totalCount"`
 }
 
-func (c *ProjectConnection) build(nodes []*Project, pager *projectPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *ProviderQuotaStatusConnection) build(nodes []*ProviderQuotaStatus, pager *providerquotastatusPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -3327,21 +5901,21 @@ func (c *ProjectConnection) build(nodes []*Project, pager *projectPager, after *
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Project
+	var nodeAt func(int) *ProviderQuotaStatus
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Project {
+		nodeAt = func(i int) *ProviderQuotaStatus {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Project {
+		nodeAt = func(i int) *ProviderQuotaStatus {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*ProjectEdge, len(nodes))
+	c.Edges = make([]*ProviderQuotaStatusEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &ProjectEdge{
+		c.Edges[i] = &ProviderQuotaStatusEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -3355,87 +5929,87 @@ func (c *ProjectConnection) build(nodes []*Project, pager *projectPager, after *
 	}
 }
 
-// ProjectPaginateOption enables pagination customization.
-type ProjectPaginateOption func(*projectPager) error
+// ProviderQuotaStatusPaginateOption enables pagination customization.
+type ProviderQuotaStatusPaginateOption func(*providerquotastatusPager) error
 
-// WithProjectOrder configures pagination ordering.
-func WithProjectOrder(order *ProjectOrder) ProjectPaginateOption {
+// WithProviderQuotaStatusOrder configures pagination ordering.
+func WithProviderQuotaStatusOrder(order *ProviderQuotaStatusOrder) ProviderQuotaStatusPaginateOption {
 	if order == nil {
-		order = DefaultProjectOrder
+		order = DefaultProviderQuotaStatusOrder
 	}
 	o := *order
-	return func(pager *projectPager) error {
+	return func(pager *providerquotastatusPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultProjectOrder.Field
+			o.Field = DefaultProviderQuotaStatusOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithProjectFilter configures pagination filter.
-func WithProjectFilter(filter func(*ProjectQuery) (*ProjectQuery, error)) ProjectPaginateOption {
-	return func(pager *projectPager) error {
+// WithProviderQuotaStatusFilter configures pagination filter.
+func WithProviderQuotaStatusFilter(filter func(*ProviderQuotaStatusQuery) (*ProviderQuotaStatusQuery, error)) ProviderQuotaStatusPaginateOption {
+	return func(pager *providerquotastatusPager) error {
 		if filter == nil {
-			return errors.New("ProjectQuery filter cannot be nil")
+			return errors.New("ProviderQuotaStatusQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type projectPager struct {
+type providerquotastatusPager struct {
 	reverse bool
-	order   *ProjectOrder
-	filter  func(*ProjectQuery) (*ProjectQuery, error)
+	order   *ProviderQuotaStatusOrder
+	filter  func(*ProviderQuotaStatusQuery) (*ProviderQuotaStatusQuery, error)
 }
 
-func newProjectPager(opts []ProjectPaginateOption, reverse bool) (*projectPager, error) {
-	pager := &projectPager{reverse: reverse}
+func newProviderQuotaStatusPager(opts []ProviderQuotaStatusPaginateOption, reverse bool) (*providerquotastatusPager, error) {
+	pager := &providerquotastatusPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultProjectOrder
+		pager.order = DefaultProviderQuotaStatusOrder
 	}
 	return pager, nil
 }
 
-func (p *projectPager) applyFilter(query *ProjectQuery) (*ProjectQuery, error) {
+func (p *providerquotastatusPager) applyFilter(query *ProviderQuotaStatusQuery) (*ProviderQuotaStatusQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *projectPager) toCursor(_m *Project) Cursor {
+func (p *providerquotastatusPager) toCursor(_m *ProviderQuotaStatus) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *projectPager) applyCursors(query *ProjectQuery, after, before *Cursor) (*ProjectQuery, error) {
+func (p *providerquotastatusPager) applyCursors(query *ProviderQuotaStatusQuery, after, before *Cursor) (*ProviderQuotaStatusQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProjectOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProviderQuotaStatusOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *projectPager) applyOrder(query *ProjectQuery) *ProjectQuery {
+func (p *providerquotastatusPager) applyOrder(query *ProviderQuotaStatusQuery) *ProviderQuotaStatusQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultProjectOrder.Field {
-		query = query.Order(DefaultProjectOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultProviderQuotaStatusOrder.Field {
+		query = query.Order(DefaultProviderQuotaStatusOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -3443,7 +6017,7 @@ func (p *projectPager) applyOrder(query *ProjectQuery) *ProjectQuery {
 	return query
 }
 
-func (p *projectPager) orderExpr(query *ProjectQuery) sql.Querier {
+func (p *providerquotastatusPager) orderExpr(query *ProviderQuotaStatusQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -3453,28 +6027,28 @@ func (p *projectPager) orderExpr(query *ProjectQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultProjectOrder.Field {
-			b.Comma().Ident(DefaultProjectOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultProviderQuotaStatusOrder.Field {
+			b.Comma().Ident(DefaultProviderQuotaStatusOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Project.
-func (_m *ProjectQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to ProviderQuotaStatus.
+func (_m *ProviderQuotaStatusQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ProjectPaginateOption,
-) (*ProjectConnection, error) {
+	before *Cursor, last *int, opts ...ProviderQuotaStatusPaginateOption,
+) (*ProviderQuotaStatusConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newProjectPager(opts, last != nil)
+	pager, err := newProviderQuotaStatusPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &ProjectConnection{Edges: []*ProjectEdge{}}
+	conn := &ProviderQuotaStatusConnection{Edges: []*ProviderQuotaStatusEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -3513,28 +6087,28 @@ func (_m *ProjectQuery) Paginate(
 }
 
 var (
-	// ProjectOrderFieldCreatedAt orders Project by created_at.
-	ProjectOrderFieldCreatedAt = &ProjectOrderField{
-		Value: func(_m *Project) (ent.Value, error) {
+	// ProviderQuotaStatusOrderFieldCreatedAt orders ProviderQuotaStatus by created_at.
+	ProviderQuotaStatusOrderFieldCreatedAt = &ProviderQuotaStatusOrderField{
+		Value: func(_m *ProviderQuotaStatus) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: project.FieldCreatedAt,
-		toTerm: project.ByCreatedAt,
-		toCursor: func(_m *Project) Cursor {
+		column: providerquotastatus.FieldCreatedAt,
+		toTerm: providerquotastatus.ByCreatedAt,
+		toCursor: func(_m *ProviderQuotaStatus) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// ProjectOrderFieldUpdatedAt orders Project by updated_at.
-	ProjectOrderFieldUpdatedAt = &ProjectOrderField{
-		Value: func(_m *Project) (ent.Value, error) {
+	// ProviderQuotaStatusOrderFieldUpdatedAt orders ProviderQuotaStatus by updated_at.
+	ProviderQuotaStatusOrderFieldUpdatedAt = &ProviderQuotaStatusOrderField{
+		Value: func(_m *ProviderQuotaStatus) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: project.FieldUpdatedAt,
-		toTerm: project.ByUpdatedAt,
-		toCursor: func(_m *Project) Cursor {
+		column: providerquotastatus.FieldUpdatedAt,
+		toTerm: providerquotastatus.ByUpdatedAt,
+		toCursor: func(_m *ProviderQuotaStatus) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
@@ -3544,94 +6118,94 @@ var (
 )
 
 // String implement fmt.Stringer interface.
-func (f ProjectOrderField) String() string {
+func (f ProviderQuotaStatusOrderField) String() string {
 	var str string
 	switch f.column {
-	case ProjectOrderFieldCreatedAt.column:
+	case ProviderQuotaStatusOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case ProjectOrderFieldUpdatedAt.column:
+	case ProviderQuotaStatusOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f ProjectOrderField) MarshalGQL(w io.Writer) {
+func (f ProviderQuotaStatusOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *ProjectOrderField) UnmarshalGQL(v interface{}) error {
+func (f *ProviderQuotaStatusOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("ProjectOrderField %T must be a string", v)
+		return fmt.Errorf("ProviderQuotaStatusOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *ProjectOrderFieldCreatedAt
+		*f = *ProviderQuotaStatusOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *ProjectOrderFieldUpdatedAt
+		*f = *ProviderQuotaStatusOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid ProjectOrderField", str)
+		return fmt.Errorf("%s is not a valid ProviderQuotaStatusOrderField", str)
 	}
 	return nil
 }
 
-// ProjectOrderField defines the ordering field of Project.
-type ProjectOrderField struct {
-	// Value extracts the ordering value from the given Project.
-	Value    func(*Project) (ent.Value, error)
+// ProviderQuotaStatusOrderField defines the ordering field of ProviderQuotaStatus.
+type ProviderQuotaStatusOrderField struct {
+	// Value extracts the ordering value from the given ProviderQuotaStatus.
+	Value    func(*ProviderQuotaStatus) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) project.OrderOption
-	toCursor func(*Project) Cursor
+	toTerm   func(...sql.OrderTermOption) providerquotastatus.OrderOption
+	toCursor func(*ProviderQuotaStatus) Cursor
 }
 
-// ProjectOrder defines the ordering of Project.
-type ProjectOrder struct {
-	Direction OrderDirection     `json:"direction"`
-	Field     *ProjectOrderField `json:"field"`
+// ProviderQuotaStatusOrder defines the ordering of ProviderQuotaStatus.
+type ProviderQuotaStatusOrder struct {
+	Direction OrderDirection                 `json:"direction"`
+	Field     *ProviderQuotaStatusOrderField `json:"field"`
 }
 
-// DefaultProjectOrder is the default ordering of Project.
-var DefaultProjectOrder = &ProjectOrder{
+// DefaultProviderQuotaStatusOrder is the default ordering of ProviderQuotaStatus.
+var DefaultProviderQuotaStatusOrder = &ProviderQuotaStatusOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &ProjectOrderField{
-		Value: func(_m *Project) (ent.Value, error) {
+	Field: &ProviderQuotaStatusOrderField{
+		Value: func(_m *ProviderQuotaStatus) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: project.FieldID,
-		toTerm: project.ByID,
-		toCursor: func(_m *Project) Cursor {
+		column: providerquotastatus.FieldID,
+		toTerm: providerquotastatus.ByID,
+		toCursor: func(_m *ProviderQuotaStatus) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Project into ProjectEdge.
-func (_m *Project) ToEdge(order *ProjectOrder) *ProjectEdge {
+// ToEdge converts ProviderQuotaStatus into ProviderQuotaStatusEdge.
+func (_m *ProviderQuotaStatus) ToEdge(order *ProviderQuotaStatusOrder) *ProviderQuotaStatusEdge {
 	if order == nil {
-		order = DefaultProjectOrder
+		order = DefaultProviderQuotaStatusOrder
 	}
-	return &ProjectEdge{
+	return &ProviderQuotaStatusEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// PromptEdge is the edge representation of Prompt.
-type PromptEdge struct {
-	Node   *Prompt `json:"node"`
-	Cursor Cursor  `json:"cursor"`
+// RequestEdge is the edge representation of Request.
+type RequestEdge struct {
+	Node   *Request `json:"node"`
+	Cursor Cursor   `json:"cursor"`
 }
 
-// PromptConnection is the connection containing edges to Prompt.
-type PromptConnection struct {
-	Edges      []*PromptEdge `json:"edges"`
-	PageInfo   PageInfo      `json:"pageInfo"`
-	TotalCount int           `json:"totalCount"`
+// RequestConnection is the connection containing edges to Request.
+type RequestConnection struct {
+	Edges      []*RequestEdge `json:"edges"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
 }
 
-func (c *PromptConnection) build(nodes []*Prompt, pager *promptPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *RequestConnection) build(nodes []*Request, pager *requestPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -3641,21 +6215,21 @@ func (c *PromptConnection) build(nodes []*Prompt, pager *promptPager, after *Cur
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Prompt
+	var nodeAt func(int) *Request
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Prompt {
+		nodeAt = func(i int) *Request {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Prompt {
+		nodeAt = func(i int) *Request {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*PromptEdge, len(nodes))
+	c.Edges = make([]*RequestEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &PromptEdge{
+		c.Edges[i] = &RequestEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -3669,87 +6243,87 @@ func (c *PromptConnection) build(nodes []*Prompt, pager *promptPager, after *Cur
 	}
 }
 
-// PromptPaginateOption enables pagination customization.
-type PromptPaginateOption func(*promptPager) error
+// RequestPaginateOption enables pagination customization.
+type RequestPaginateOption func(*requestPager) error
 
-// WithPromptOrder configures pagination ordering.
-func WithPromptOrder(order *PromptOrder) PromptPaginateOption {
+// WithRequestOrder configures pagination ordering.
+func WithRequestOrder(order *RequestOrder) RequestPaginateOption {
 	if order == nil {
-		order = DefaultPromptOrder
+		order = DefaultRequestOrder
 	}
 	o := *order
-	return func(pager *promptPager) error {
+	return func(pager *requestPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultPromptOrder.Field
+			o.Field = DefaultRequestOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithPromptFilter configures pagination filter.
-func WithPromptFilter(filter func(*PromptQuery) (*PromptQuery, error)) PromptPaginateOption {
-	return func(pager *promptPager) error {
+// WithRequestFilter configures pagination filter.
+func WithRequestFilter(filter func(*RequestQuery) (*RequestQuery, error)) RequestPaginateOption {
+	return func(pager *requestPager) error {
 		if filter == nil {
-			return errors.New("PromptQuery filter cannot be nil")
+			return errors.New("RequestQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type promptPager struct {
+type requestPager struct {
 	reverse bool
-	order   *PromptOrder
-	filter  func(*PromptQuery) (*PromptQuery, error)
+	order   *RequestOrder
+	filter  func(*RequestQuery) (*RequestQuery, error)
 }
 
-func newPromptPager(opts []PromptPaginateOption, reverse bool) (*promptPager, error) {
-	pager := &promptPager{reverse: reverse}
+func newRequestPager(opts []RequestPaginateOption, reverse bool) (*requestPager, error) {
+	pager := &requestPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultPromptOrder
+		pager.order = DefaultRequestOrder
 	}
 	return pager, nil
 }
 
-func (p *promptPager) applyFilter(query *PromptQuery) (*PromptQuery, error) {
+func (p *requestPager) applyFilter(query *RequestQuery) (*RequestQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *promptPager) toCursor(_m *Prompt) Cursor {
+func (p *requestPager) toCursor(_m *Request) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *promptPager) applyCursors(query *PromptQuery, after, before *Cursor) (*PromptQuery, error) {
+func (p *requestPager) applyCursors(query *RequestQuery, after, before *Cursor) (*RequestQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultPromptOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultRequestOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *promptPager) applyOrder(query *PromptQuery) *PromptQuery {
+func (p *requestPager) applyOrder(query *RequestQuery) *RequestQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultPromptOrder.Field {
-		query = query.Order(DefaultPromptOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultRequestOrder.Field {
+		query = query.Order(DefaultRequestOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -3757,7 +6331,7 @@ func (p *promptPager) applyOrder(query *PromptQuery) *PromptQuery {
 	return query
 }
 
-func (p *promptPager) orderExpr(query *PromptQuery) sql.Querier {
+func (p *requestPager) orderExpr(query *RequestQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -3767,28 +6341,28 @@ func (p *promptPager) orderExpr(query *PromptQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultPromptOrder.Field {
-			b.Comma().Ident(DefaultPromptOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultRequestOrder.Field {
+			b.Comma().Ident(DefaultRequestOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Prompt.
-func (_m *PromptQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Request.
+func (_m *RequestQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...PromptPaginateOption,
-) (*PromptConnection, error) {
+	before *Cursor, last *int, opts ...RequestPaginateOption,
+) (*RequestConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newPromptPager(opts, last != nil)
+	pager, err := newRequestPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &PromptConnection{Edges: []*PromptEdge{}}
+	conn := &RequestConnection{Edges: []*RequestEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -3827,143 +6401,125 @@ func (_m *PromptQuery) Paginate(
 }
 
 var (
-	// PromptOrderFieldCreatedAt orders Prompt by created_at.
-	PromptOrderFieldCreatedAt = &PromptOrderField{
-		Value: func(_m *Prompt) (ent.Value, error) {
+	// RequestOrderFieldCreatedAt orders Request by created_at.
+	RequestOrderFieldCreatedAt = &RequestOrderField{
+		Value: func(_m *Request) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: prompt.FieldCreatedAt,
-		toTerm: prompt.ByCreatedAt,
-		toCursor: func(_m *Prompt) Cursor {
+		column: request.FieldCreatedAt,
+		toTerm: request.ByCreatedAt,
+		toCursor: func(_m *Request) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// PromptOrderFieldUpdatedAt orders Prompt by updated_at.
-	PromptOrderFieldUpdatedAt = &PromptOrderField{
-		Value: func(_m *Prompt) (ent.Value, error) {
+	// RequestOrderFieldUpdatedAt orders Request by updated_at.
+	RequestOrderFieldUpdatedAt = &RequestOrderField{
+		Value: func(_m *Request) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: prompt.FieldUpdatedAt,
-		toTerm: prompt.ByUpdatedAt,
-		toCursor: func(_m *Prompt) Cursor {
+		column: request.FieldUpdatedAt,
+		toTerm: request.ByUpdatedAt,
+		toCursor: func(_m *Request) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
-	// PromptOrderFieldOrder orders Prompt by order.
-	PromptOrderFieldOrder = &PromptOrderField{
-		Value: func(_m *Prompt) (ent.Value, error) {
-			return _m.Order, nil
-		},
-		column: prompt.FieldOrder,
-		toTerm: prompt.ByOrder,
-		toCursor: func(_m *Prompt) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Order,
-			}
-		},
-	}
 )
 
 // String implement fmt.Stringer interface.
-func (f PromptOrderField) String() string {
+func (f RequestOrderField) String() string {
 	var str string
 	switch f.column {
-	case PromptOrderFieldCreatedAt.column:
+	case RequestOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case PromptOrderFieldUpdatedAt.column:
+	case RequestOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
-	case PromptOrderFieldOrder.column:
-		str = "ORDER"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f PromptOrderField) MarshalGQL(w io.Writer) {
+func (f RequestOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *PromptOrderField) UnmarshalGQL(v interface{}) error {
+func (f *RequestOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("PromptOrderField %T must be a string", v)
+		return fmt.Errorf("RequestOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *PromptOrderFieldCreatedAt
+		*f = *RequestOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *PromptOrderFieldUpdatedAt
-	case "ORDER":
-		*f = *PromptOrderFieldOrder
+		*f = *RequestOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid PromptOrderField", str)
+		return fmt.Errorf("%s is not a valid RequestOrderField", str)
 	}
 	return nil
 }
 
-// PromptOrderField defines the ordering field of Prompt.
-type PromptOrderField struct {
-	// Value extracts the ordering value from the given Prompt.
-	Value    func(*Prompt) (ent.Value, error)
+// RequestOrderField defines the ordering field of Request.
+type RequestOrderField struct {
+	// Value extracts the ordering value from the given Request.
+	Value    func(*Request) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) prompt.OrderOption
-	toCursor func(*Prompt) Cursor
+	toTerm   func(...sql.OrderTermOption) request.OrderOption
+	toCursor func(*Request) Cursor
 }
 
-// PromptOrder defines the ordering of Prompt.
-type PromptOrder struct {
-	Direction OrderDirection    `json:"direction"`
-	Field     *PromptOrderField `json:"field"`
+// RequestOrder defines the ordering of Request.
+type RequestOrder struct {
+	Direction OrderDirection     `json:"direction"`
+	Field     *RequestOrderField `json:"field"`
 }
 
-// DefaultPromptOrder is the default ordering of Prompt.
-var DefaultPromptOrder = &PromptOrder{
+// DefaultRequestOrder is the default ordering of Request.
+var DefaultRequestOrder = &RequestOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &PromptOrderField{
-		Value: func(_m *Prompt) (ent.Value, error) {
+	Field: &RequestOrderField{
+		Value: func(_m *Request) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: prompt.FieldID,
-		toTerm: prompt.ByID,
-		toCursor: func(_m *Prompt) Cursor {
+		column: request.FieldID,
+		toTerm: request.ByID,
+		toCursor: func(_m *Request) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Prompt into PromptEdge.
-func (_m *Prompt) ToEdge(order *PromptOrder) *PromptEdge {
+// ToEdge converts Request into RequestEdge.
+func (_m *Request) ToEdge(order *RequestOrder) *RequestEdge {
 	if order == nil {
-		order = DefaultPromptOrder
+		order = DefaultRequestOrder
 	}
-	return &PromptEdge{
+	return &RequestEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// PromptProtectionRuleEdge is the edge representation of PromptProtectionRule.
-type PromptProtectionRuleEdge struct {
-	Node   *PromptProtectionRule `json:"node"`
-	Cursor Cursor                `json:"cursor"`
+// RequestExecutionEdge is the edge representation of RequestExecution.
+type RequestExecutionEdge struct {
+	Node   *RequestExecution `json:"node"`
+	Cursor Cursor            `json:"cursor"`
 }
 
-// PromptProtectionRuleConnection is the connection containing edges to PromptProtectionRule.
-type PromptProtectionRuleConnection struct {
-	Edges      []*PromptProtectionRuleEdge `json:"edges"`
-	PageInfo   PageInfo                    `json:"pageInfo"`
-	TotalCount int                         `json:"totalCount"`
+// RequestExecutionConnection is the connection containing edges to RequestExecution.
+type RequestExecutionConnection struct {
+	Edges      []*RequestExecutionEdge `json:"edges"`
+	PageInfo   PageInfo                `json:"pageInfo"`
+	TotalCount int                     `json:"totalCount"`
 }
 
-func (c *PromptProtectionRuleConnection) build(nodes []*PromptProtectionRule, pager *promptprotectionrulePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *RequestExecutionConnection) build(nodes []*RequestExecution, pager *requestexecutionPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -3973,21 +6529,21 @@ func (c *PromptProtectionRuleConnection) build(nodes []*PromptProtectionRule, pa
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *PromptProtectionRule
+	var nodeAt func(int) *RequestExecution
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *PromptProtectionRule {
+		nodeAt = func(i int) *RequestExecution {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *PromptProtectionRule {
+		nodeAt = func(i int) *RequestExecution {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*PromptProtectionRuleEdge, len(nodes))
+	c.Edges = make([]*RequestExecutionEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &PromptProtectionRuleEdge{
+		c.Edges[i] = &RequestExecutionEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -4001,87 +6557,87 @@ func (c *PromptProtectionRuleConnection) build(nodes []*PromptProtectionRule, pa
 	}
 }
 
-// PromptProtectionRulePaginateOption enables pagination customization.
-type PromptProtectionRulePaginateOption func(*promptprotectionrulePager) error
+// RequestExecutionPaginateOption enables pagination customization.
+type RequestExecutionPaginateOption func(*requestexecutionPager) error
 
-// WithPromptProtectionRuleOrder configures pagination ordering.
-func WithPromptProtectionRuleOrder(order *PromptProtectionRuleOrder) PromptProtectionRulePaginateOption {
+// WithRequestExecutionOrder configures pagination ordering.
+func WithRequestExecutionOrder(order *RequestExecutionOrder) RequestExecutionPaginateOption {
 	if order == nil {
-		order = DefaultPromptProtectionRuleOrder
+		order = DefaultRequestExecutionOrder
 	}
 	o := *order
-	return func(pager *promptprotectionrulePager) error {
+	return func(pager *requestexecutionPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultPromptProtectionRuleOrder.Field
+			o.Field = DefaultRequestExecutionOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithPromptProtectionRuleFilter configures pagination filter.
-func WithPromptProtectionRuleFilter(filter func(*PromptProtectionRuleQuery) (*PromptProtectionRuleQuery, error)) PromptProtectionRulePaginateOption {
-	return func(pager *promptprotectionrulePager) error {
+// WithRequestExecutionFilter configures pagination filter.
+func WithRequestExecutionFilter(filter func(*RequestExecutionQuery) (*RequestExecutionQuery, error)) RequestExecutionPaginateOption {
+	return func(pager *requestexecutionPager) error {
 		if filter == nil {
-			return errors.New("PromptProtectionRuleQuery filter cannot be nil")
+			return errors.New("RequestExecutionQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type promptprotectionrulePager struct {
+type requestexecutionPager struct {
 	reverse bool
-	order   *PromptProtectionRuleOrder
-	filter  func(*PromptProtectionRuleQuery) (*PromptProtectionRuleQuery, error)
+	order   *RequestExecutionOrder
+	filter  func(*RequestExecutionQuery) (*RequestExecutionQuery, error)
 }
 
-func newPromptProtectionRulePager(opts []PromptProtectionRulePaginateOption, reverse bool) (*promptprotectionrulePager, error) {
-	pager := &promptprotectionrulePager{reverse: reverse}
+func newRequestExecutionPager(opts []RequestExecutionPaginateOption, reverse bool) (*requestexecutionPager, error) {
+	pager := &requestexecutionPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultPromptProtectionRuleOrder
+		pager.order = DefaultRequestExecutionOrder
 	}
 	return pager, nil
 }
 
-func (p *promptprotectionrulePager) applyFilter(query *PromptProtectionRuleQuery) (*PromptProtectionRuleQuery, error) {
+func (p *requestexecutionPager) applyFilter(query *RequestExecutionQuery) (*RequestExecutionQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *promptprotectionrulePager) toCursor(_m *PromptProtectionRule) Cursor {
+func (p *requestexecutionPager) toCursor(_m *RequestExecution) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *promptprotectionrulePager) applyCursors(query *PromptProtectionRuleQuery, after, before *Cursor) (*PromptProtectionRuleQuery, error) {
+func (p *requestexecutionPager) applyCursors(query *RequestExecutionQuery, after, before *Cursor) (*RequestExecutionQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultPromptProtectionRuleOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultRequestExecutionOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *promptprotectionrulePager) applyOrder(query *PromptProtectionRuleQuery) *PromptProtectionRuleQuery {
+func (p *requestexecutionPager) applyOrder(query *RequestExecutionQuery) *RequestExecutionQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultPromptProtectionRuleOrder.Field {
-		query = query.Order(DefaultPromptProtectionRuleOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultRequestExecutionOrder.Field {
+		query = query.Order(DefaultRequestExecutionOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -4089,7 +6645,7 @@ func (p *promptprotectionrulePager) applyOrder(query *PromptProtectionRuleQuery)
 	return query
 }
 
-func (p *promptprotectionrulePager) orderExpr(query *PromptProtectionRuleQuery) sql.Querier {
+func (p *requestexecutionPager) orderExpr(query *RequestExecutionQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -4099,28 +6655,28 @@ func (p *promptprotectionrulePager) orderExpr(query *PromptProtectionRuleQuery)
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultPromptProtectionRuleOrder.Field {
-			b.Comma().Ident(DefaultPromptProtectionRuleOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultRequestExecutionOrder.Field {
+			b.Comma().Ident(DefaultRequestExecutionOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to PromptProtectionRule.
-func (_m *PromptProtectionRuleQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to RequestExecution.
+func (_m *RequestExecutionQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...PromptProtectionRulePaginateOption,
-) (*PromptProtectionRuleConnection, error) {
+	before *Cursor, last *int, opts ...RequestExecutionPaginateOption,
+) (*RequestExecutionConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newPromptProtectionRulePager(opts, last != nil)
+	pager, err := newRequestExecutionPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &PromptProtectionRuleConnection{Edges: []*PromptProtectionRuleEdge{}}
+	conn := &RequestExecutionConnection{Edges: []*RequestExecutionEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -4159,143 +6715,125 @@ func (_m *PromptProtectionRuleQuery) Paginate(
 }
 
 var (
-	// PromptProtectionRuleOrderFieldCreatedAt orders PromptProtectionRule by created_at.
-	PromptProtectionRuleOrderFieldCreatedAt = &PromptProtectionRuleOrderField{
-		Value: func(_m *PromptProtectionRule) (ent.Value, error) {
+	// RequestExecutionOrderFieldCreatedAt orders RequestExecution by created_at.
+	RequestExecutionOrderFieldCreatedAt = &RequestExecutionOrderField{
+		Value: func(_m *RequestExecution) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: promptprotectionrule.FieldCreatedAt,
-		toTerm: promptprotectionrule.ByCreatedAt,
-		toCursor: func(_m *PromptProtectionRule) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.CreatedAt,
-			}
-		},
-	}
-	// PromptProtectionRuleOrderFieldUpdatedAt orders PromptProtectionRule by updated_at.
-	PromptProtectionRuleOrderFieldUpdatedAt = &PromptProtectionRuleOrderField{
-		Value: func(_m *PromptProtectionRule) (ent.Value, error) {
-			return _m.UpdatedAt, nil
-		},
-		column: promptprotectionrule.FieldUpdatedAt,
-		toTerm: promptprotectionrule.ByUpdatedAt,
-		toCursor: func(_m *PromptProtectionRule) Cursor {
+		column: requestexecution.FieldCreatedAt,
+		toTerm: requestexecution.ByCreatedAt,
+		toCursor: func(_m *RequestExecution) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.UpdatedAt,
+				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// PromptProtectionRuleOrderFieldName orders PromptProtectionRule by name.
-	PromptProtectionRuleOrderFieldName = &PromptProtectionRuleOrderField{
-		Value: func(_m *PromptProtectionRule) (ent.Value, error) {
-			return _m.Name, nil
+	// RequestExecutionOrderFieldUpdatedAt orders RequestExecution by updated_at.
+	RequestExecutionOrderFieldUpdatedAt = &RequestExecutionOrderField{
+		Value: func(_m *RequestExecution) (ent.Value, error) {
+			return _m.UpdatedAt, nil
 		},
-		column: promptprotectionrule.FieldName,
-		toTerm: promptprotectionrule.ByName,
-		toCursor: func(_m *PromptProtectionRule) Cursor {
+		column: requestexecution.FieldUpdatedAt,
+		toTerm: requestexecution.ByUpdatedAt,
+		toCursor: func(_m *RequestExecution) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.Name,
+				Value: _m.UpdatedAt,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f PromptProtectionRuleOrderField) String() string {
+func (f RequestExecutionOrderField) String() string {
 	var str string
 	switch f.column {
-	case PromptProtectionRuleOrderFieldCreatedAt.column:
+	case RequestExecutionOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case PromptProtectionRuleOrderFieldUpdatedAt.column:
+	case RequestExecutionOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
-	case PromptProtectionRuleOrderFieldName.column:
-		str = "NAME"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f PromptProtectionRuleOrderField) MarshalGQL(w io.Writer) {
+func (f RequestExecutionOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *PromptProtectionRuleOrderField) UnmarshalGQL(v interface{}) error {
+func (f *RequestExecutionOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("PromptProtectionRuleOrderField %T must be a string", v)
+		return fmt.Errorf("RequestExecutionOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *PromptProtectionRuleOrderFieldCreatedAt
+		*f = *RequestExecutionOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *PromptProtectionRuleOrderFieldUpdatedAt
-	case "NAME":
-		*f = *PromptProtectionRuleOrderFieldName
+		*f = *RequestExecutionOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid PromptProtectionRuleOrderField", str)
+		return fmt.Errorf("%s is not a valid RequestExecutionOrderField", str)
 	}
 	return nil
 }
 
-// PromptProtectionRuleOrderField defines the ordering field of PromptProtectionRule.
-type PromptProtectionRuleOrderField struct {
-	// Value extracts the ordering value from the given PromptProtectionRule.
-	Value    func(*PromptProtectionRule) (ent.Value, error)
+// RequestExecutionOrderField defines the ordering field of RequestExecution.
+type RequestExecutionOrderField struct {
+	// Value extracts the ordering value from the given RequestExecution.
+	Value    func(*RequestExecution) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) promptprotectionrule.OrderOption
-	toCursor func(*PromptProtectionRule) Cursor
+	toTerm   func(...sql.OrderTermOption) requestexecution.OrderOption
+	toCursor func(*RequestExecution) Cursor
 }
 
-// PromptProtectionRuleOrder defines the ordering of PromptProtectionRule.
-type PromptProtectionRuleOrder struct {
-	Direction OrderDirection                  `json:"direction"`
-	Field     *PromptProtectionRuleOrderField `json:"field"`
+// RequestExecutionOrder defines the ordering of RequestExecution.
+type RequestExecutionOrder struct {
+	Direction OrderDirection              `json:"direction"`
+	Field     *RequestExecutionOrderField `json:"field"`
 }
 
-// DefaultPromptProtectionRuleOrder is the default ordering of PromptProtectionRule.
-var DefaultPromptProtectionRuleOrder = &PromptProtectionRuleOrder{
+// DefaultRequestExecutionOrder is the default ordering of RequestExecution.
+var DefaultRequestExecutionOrder = &RequestExecutionOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &PromptProtectionRuleOrderField{
-		Value: func(_m *PromptProtectionRule) (ent.Value, error) {
+	Field: &RequestExecutionOrderField{
+		Value: func(_m *RequestExecution) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: promptprotectionrule.FieldID,
-		toTerm: promptprotectionrule.ByID,
-		toCursor: func(_m *PromptProtectionRule) Cursor {
+		column: requestexecution.FieldID,
+		toTerm: requestexecution.ByID,
+		toCursor: func(_m *RequestExecution) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts PromptProtectionRule into PromptProtectionRuleEdge.
-func (_m *PromptProtectionRule) ToEdge(order *PromptProtectionRuleOrder) *PromptProtectionRuleEdge {
+// ToEdge converts RequestExecution into RequestExecutionEdge.
+func (_m *RequestExecution) ToEdge(order *RequestExecutionOrder) *RequestExecutionEdge {
 	if order == nil {
-		order = DefaultPromptProtectionRuleOrder
+		order = DefaultRequestExecutionOrder
 	}
-	return &PromptProtectionRuleEdge{
+	return &RequestExecutionEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// ProviderQuotaStatusEdge is the edge representation of ProviderQuotaStatus.
-type ProviderQuotaStatusEdge struct {
-	Node   *ProviderQuotaStatus `json:"node"`
-	Cursor Cursor               `json:"cursor"`
+// RoleEdge is the edge representation of Role.
+type RoleEdge struct {
+	Node   *Role  `json:"node"`
+	Cursor Cursor `json:"cursor"`
 }
 
-// ProviderQuotaStatusConnection is the connection containing edges to ProviderQuotaStatus.
-type ProviderQuotaStatusConnection struct {
-	Edges      []*ProviderQuotaStatusEdge `json:"edges"`
-	PageInfo   PageInfo                   `json:"pageInfo"`
-	TotalCount int                        `json:"totalCount"`
+// RoleConnection is the connection containing edges to Role.
+type RoleConnection struct {
+	Edges      []*RoleEdge `json:"edges"`
+	PageInfo   PageInfo    `json:"pageInfo"`
+	TotalCount int         `json:"totalCount"`
 }
 
-func (c *ProviderQuotaStatusConnection) build(nodes []*ProviderQuotaStatus, pager *providerquotastatusPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *RoleConnection) build(nodes []*Role, pager *rolePager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -4305,21 +6843,21 @@ func (c *ProviderQuotaStatusConnection) build(nodes []*ProviderQuotaStatus, page
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *ProviderQuotaStatus
+	var nodeAt func(int) *Role
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *ProviderQuotaStatus {
+		nodeAt = func(i int) *Role {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *ProviderQuotaStatus {
+		nodeAt = func(i int) *Role {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*ProviderQuotaStatusEdge, len(nodes))
+	c.Edges = make([]*RoleEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &ProviderQuotaStatusEdge{
+		c.Edges[i] = &RoleEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -4333,87 +6871,87 @@ func (c *ProviderQuotaStatusConnection) build(nodes []*ProviderQuotaStatus, page
 	}
 }
 
-// ProviderQuotaStatusPaginateOption enables pagination customization.
-type ProviderQuotaStatusPaginateOption func(*providerquotastatusPager) error
+// RolePaginateOption enables pagination customization.
+type RolePaginateOption func(*rolePager) error
 
-// WithProviderQuotaStatusOrder configures pagination ordering.
-func WithProviderQuotaStatusOrder(order *ProviderQuotaStatusOrder) ProviderQuotaStatusPaginateOption {
+// WithRoleOrder configures pagination ordering.
+func WithRoleOrder(order *RoleOrder) RolePaginateOption {
 	if order == nil {
-		order = DefaultProviderQuotaStatusOrder
+		order = DefaultRoleOrder
 	}
 	o := *order
-	return func(pager *providerquotastatusPager) error {
+	return func(pager *rolePager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultProviderQuotaStatusOrder.Field
+			o.Field = DefaultRoleOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithProviderQuotaStatusFilter configures pagination filter.
-func WithProviderQuotaStatusFilter(filter func(*ProviderQuotaStatusQuery) (*ProviderQuotaStatusQuery, error)) ProviderQuotaStatusPaginateOption {
-	return func(pager *providerquotastatusPager) error {
+// WithRoleFilter configures pagination filter.
+func WithRoleFilter(filter func(*RoleQuery) (*RoleQuery, error)) RolePaginateOption {
+	return func(pager *rolePager) error {
 		if filter == nil {
-			return errors.New("ProviderQuotaStatusQuery filter cannot be nil")
+			return errors.New("RoleQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type providerquotastatusPager struct {
+type rolePager struct {
 	reverse bool
-	order   *ProviderQuotaStatusOrder
-	filter  func(*ProviderQuotaStatusQuery) (*ProviderQuotaStatusQuery, error)
+	order   *RoleOrder
+	filter  func(*RoleQuery) (*RoleQuery, error)
 }
 
-func newProviderQuotaStatusPager(opts []ProviderQuotaStatusPaginateOption, reverse bool) (*providerquotastatusPager, error) {
-	pager := &providerquotastatusPager{reverse: reverse}
+func newRolePager(opts []RolePaginateOption, reverse bool) (*rolePager, error) {
+	pager := &rolePager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultProviderQuotaStatusOrder
+		pager.order = DefaultRoleOrder
 	}
 	return pager, nil
 }
 
-func (p *providerquotastatusPager) applyFilter(query *ProviderQuotaStatusQuery) (*ProviderQuotaStatusQuery, error) {
+func (p *rolePager) applyFilter(query *RoleQuery) (*RoleQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *providerquotastatusPager) toCursor(_m *ProviderQuotaStatus) Cursor {
+func (p *rolePager) toCursor(_m *Role) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *providerquotastatusPager) applyCursors(query *ProviderQuotaStatusQuery, after, before *Cursor) (*ProviderQuotaStatusQuery, error) {
+func (p *rolePager) applyCursors(query *RoleQuery, after, before *Cursor) (*RoleQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProviderQuotaStatusOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultRoleOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *providerquotastatusPager) applyOrder(query *ProviderQuotaStatusQuery) *ProviderQuotaStatusQuery {
+func (p *rolePager) applyOrder(query *RoleQuery) *RoleQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultProviderQuotaStatusOrder.Field {
-		query = query.Order(DefaultProviderQuotaStatusOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultRoleOrder.Field {
+		query = query.Order(DefaultRoleOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -4421,7 +6959,7 @@ func (p *providerquotastatusPager) applyOrder(query *ProviderQuotaStatusQuery) *
 	return query
 }
 
-func (p *providerquotastatusPager) orderExpr(query *ProviderQuotaStatusQuery) sql.Querier {
+func (p *rolePager) orderExpr(query *RoleQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -4431,28 +6969,28 @@ func (p *providerquotastatusPager) orderExpr(query *ProviderQuotaStatusQuery) sq
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultProviderQuotaStatusOrder.Field {
-			b.Comma().Ident(DefaultProviderQuotaStatusOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultRoleOrder.Field {
+			b.Comma().Ident(DefaultRoleOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to ProviderQuotaStatus.
-func (_m *ProviderQuotaStatusQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Role.
+func (_m *RoleQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ProviderQuotaStatusPaginateOption,
-) (*ProviderQuotaStatusConnection, error) {
+	before *Cursor, last *int, opts ...RolePaginateOption,
+) (*RoleConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newProviderQuotaStatusPager(opts, last != nil)
+	pager, err := newRolePager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &ProviderQuotaStatusConnection{Edges: []*ProviderQuotaStatusEdge{}}
+	conn := &RoleConnection{Edges: []*RoleEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -4491,28 +7029,28 @@ func (_m *ProviderQuotaStatusQuery) Paginate(
 }
 
 var (
-	// ProviderQuotaStatusOrderFieldCreatedAt orders ProviderQuotaStatus by created_at.
-	ProviderQuotaStatusOrderFieldCreatedAt = &ProviderQuotaStatusOrderField{
-		Value: func(_m *ProviderQuotaStatus) (ent.Value, error) {
+	// RoleOrderFieldCreatedAt orders Role by created_at.
+	RoleOrderFieldCreatedAt = &RoleOrderField{
+		Value: func(_m *Role) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: providerquotastatus.FieldCreatedAt,
-		toTerm: providerquotastatus.ByCreatedAt,
-		toCursor: func(_m *ProviderQuotaStatus) Cursor {
+		column: role.FieldCreatedAt,
+		toTerm: role.ByCreatedAt,
+		toCursor: func(_m *Role) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// ProviderQuotaStatusOrderFieldUpdatedAt orders ProviderQuotaStatus by updated_at.
-	ProviderQuotaStatusOrderFieldUpdatedAt = &ProviderQuotaStatusOrderField{
-		Value: func(_m *ProviderQuotaStatus) (ent.Value, error) {
+	// RoleOrderFieldUpdatedAt orders Role by updated_at.
+	RoleOrderFieldUpdatedAt = &RoleOrderField{
+		Value: func(_m *Role) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: providerquotastatus.FieldUpdatedAt,
-		toTerm: providerquotastatus.ByUpdatedAt,
-		toCursor: func(_m *ProviderQuotaStatus) Cursor {
+		column: role.FieldUpdatedAt,
+		toTerm: role.ByUpdatedAt,
+		toCursor: func(_m *Role) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
@@ -4522,94 +7060,94 @@ var (
 )
 
 // String implement fmt.Stringer interface.
-func (f ProviderQuotaStatusOrderField) String() string {
+func (f RoleOrderField) String() string {
 	var str string
 	switch f.column {
-	case ProviderQuotaStatusOrderFieldCreatedAt.column:
+	case RoleOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case ProviderQuotaStatusOrderFieldUpdatedAt.column:
+	case RoleOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f ProviderQuotaStatusOrderField) MarshalGQL(w io.Writer) {
+func (f RoleOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *ProviderQuotaStatusOrderField) UnmarshalGQL(v interface{}) error {
+func (f *RoleOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("ProviderQuotaStatusOrderField %T must be a string", v)
+		return fmt.Errorf("RoleOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *ProviderQuotaStatusOrderFieldCreatedAt
+		*f = *RoleOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *ProviderQuotaStatusOrderFieldUpdatedAt
+		*f = *RoleOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid ProviderQuotaStatusOrderField", str)
+		return fmt.Errorf("%s is not a valid RoleOrderField", str)
 	}
 	return nil
 }
 
-// ProviderQuotaStatusOrderField defines the ordering field of ProviderQuotaStatus.
-type ProviderQuotaStatusOrderField struct {
-	// Value extracts the ordering value from the given ProviderQuotaStatus.
-	Value    func(*ProviderQuotaStatus) (ent.Value, error)
+// RoleOrderField defines the ordering field of Role.
+type RoleOrderField struct {
+	// Value extracts the ordering value from the given Role.
+	Value    func(*Role) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) providerquotastatus.OrderOption
-	toCursor func(*ProviderQuotaStatus) Cursor
+	toTerm   func(...sql.OrderTermOption) role.OrderOption
+	toCursor func(*Role) Cursor
 }
 
-// ProviderQuotaStatusOrder defines the ordering of ProviderQuotaStatus.
-type ProviderQuotaStatusOrder struct {
-	Direction OrderDirection                 `json:"direction"`
-	Field     *ProviderQuotaStatusOrderField `json:"field"`
+// RoleOrder defines the ordering of Role.
+type RoleOrder struct {
+	Direction OrderDirection  `json:"direction"`
+	Field     *RoleOrderField `json:"field"`
 }
 
-// DefaultProviderQuotaStatusOrder is the default ordering of ProviderQuotaStatus.
-var DefaultProviderQuotaStatusOrder = &ProviderQuotaStatusOrder{
+// DefaultRoleOrder is the default ordering of Role.
+var DefaultRoleOrder = &RoleOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &ProviderQuotaStatusOrderField{
-		Value: func(_m *ProviderQuotaStatus) (ent.Value, error) {
+	Field: &RoleOrderField{
+		Value: func(_m *Role) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: providerquotastatus.FieldID,
-		toTerm: providerquotastatus.ByID,
-		toCursor: func(_m *ProviderQuotaStatus) Cursor {
+		column: role.FieldID,
+		toTerm: role.ByID,
+		toCursor: func(_m *Role) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts ProviderQuotaStatus into ProviderQuotaStatusEdge.
-func (_m *ProviderQuotaStatus) ToEdge(order *ProviderQuotaStatusOrder) *ProviderQuotaStatusEdge {
+// ToEdge converts Role into RoleEdge.
+func (_m *Role) ToEdge(order *RoleOrder) *RoleEdge {
 	if order == nil {
-		order = DefaultProviderQuotaStatusOrder
+		order = DefaultRoleOrder
 	}
-	return &ProviderQuotaStatusEdge{
+	return &RoleEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// RequestEdge is the edge representation of Request.
-type RequestEdge struct {
-	Node   *Request `json:"node"`
-	Cursor Cursor   `json:"cursor"`
+// SystemEdge is the edge representation of System.
+type SystemEdge struct {
+	Node   *System `json:"node"`
+	Cursor Cursor  `json:"cursor"`
 }
 
-// RequestConnection is the connection containing edges to Request.
-type RequestConnection struct {
-	Edges      []*RequestEdge `json:"edges"`
-	PageInfo   PageInfo       `json:"pageInfo"`
-	TotalCount int            `json:"totalCount"`
+// SystemConnection is the connection containing edges to System.
+type SystemConnection struct {
+	Edges      []*SystemEdge `json:"edges"`
+	PageInfo   PageInfo      `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
 }
 
-func (c *RequestConnection) build(nodes []*Request, pager *requestPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *SystemConnection) build(nodes []*System, pager *systemPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -4619,21 +7157,21 @@ func (c *RequestConnection) build(nodes []*Request, pager *requestPager, after *
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Request
+	var nodeAt func(int) *System
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Request {
+		nodeAt = func(i int) *System {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Request {
+		nodeAt = func(i int) *System {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*RequestEdge, len(nodes))
+	c.Edges = make([]*SystemEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &RequestEdge{
+		c.Edges[i] = &SystemEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -4647,87 +7185,87 @@ func (c *RequestConnection) build(nodes []*Request, pager *requestPager, after *
 	}
 }
 
-// RequestPaginateOption enables pagination customization.
-type RequestPaginateOption func(*requestPager) error
+// SystemPaginateOption enables pagination customization.
+type SystemPaginateOption func(*systemPager) error
 
-// WithRequestOrder configures pagination ordering.
-func WithRequestOrder(order *RequestOrder) RequestPaginateOption {
+// WithSystemOrder configures pagination ordering.
+func WithSystemOrder(order *SystemOrder) SystemPaginateOption {
 	if order == nil {
-		order = DefaultRequestOrder
+		order = DefaultSystemOrder
 	}
 	o := *order
-	return func(pager *requestPager) error {
+	return func(pager *systemPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultRequestOrder.Field
+			o.Field = DefaultSystemOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithRequestFilter configures pagination filter.
-func WithRequestFilter(filter func(*RequestQuery) (*RequestQuery, error)) RequestPaginateOption {
-	return func(pager *requestPager) error {
+// WithSystemFilter configures pagination filter.
+func WithSystemFilter(filter func(*SystemQuery) (*SystemQuery, error)) SystemPaginateOption {
+	return func(pager *systemPager) error {
 		if filter == nil {
-			return errors.New("RequestQuery filter cannot be nil")
+			return errors.New("SystemQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type requestPager struct {
+type systemPager struct {
 	reverse bool
-	order   *RequestOrder
-	filter  func(*RequestQuery) (*RequestQuery, error)
+	order   *SystemOrder
+	filter  func(*SystemQuery) (*SystemQuery, error)
 }
 
-func newRequestPager(opts []RequestPaginateOption, reverse bool) (*requestPager, error) {
-	pager := &requestPager{reverse: reverse}
+func newSystemPager(opts []SystemPaginateOption, reverse bool) (*systemPager, error) {
+	pager := &systemPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultRequestOrder
+		pager.order = DefaultSystemOrder
 	}
 	return pager, nil
 }
 
-func (p *requestPager) applyFilter(query *RequestQuery) (*RequestQuery, error) {
+func (p *systemPager) applyFilter(query *SystemQuery) (*SystemQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *requestPager) toCursor(_m *Request) Cursor {
+func (p *systemPager) toCursor(_m *System) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *requestPager) applyCursors(query *RequestQuery, after, before *Cursor) (*RequestQuery, error) {
+func (p *systemPager) applyCursors(query *SystemQuery, after, before *Cursor) (*SystemQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultRequestOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultSystemOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *requestPager) applyOrder(query *RequestQuery) *RequestQuery {
+func (p *systemPager) applyOrder(query *SystemQuery) *SystemQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultRequestOrder.Field {
-		query = query.Order(DefaultRequestOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultSystemOrder.Field {
+		query = query.Order(DefaultSystemOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -4735,7 +7273,7 @@ func (p *requestPager) applyOrder(query *RequestQuery) *RequestQuery {
 	return query
 }
 
-func (p *requestPager) orderExpr(query *RequestQuery) sql.Querier {
+func (p *systemPager) orderExpr(query *SystemQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -4745,28 +7283,28 @@ func (p *requestPager) orderExpr(query *RequestQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultRequestOrder.Field {
-			b.Comma().Ident(DefaultRequestOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultSystemOrder.Field {
+			b.Comma().Ident(DefaultSystemOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Request.
-func (_m *RequestQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to System.
+func (_m *SystemQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...RequestPaginateOption,
-) (*RequestConnection, error) {
+	before *Cursor, last *int, opts ...SystemPaginateOption,
+) (*SystemConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newRequestPager(opts, last != nil)
+	pager, err := newSystemPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &RequestConnection{Edges: []*RequestEdge{}}
+	conn := &SystemConnection{Edges: []*SystemEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -4805,28 +7343,28 @@ func (_m *RequestQuery) Paginate(
 }
 
 var (
-	// RequestOrderFieldCreatedAt orders Request by created_at.
-	RequestOrderFieldCreatedAt = &RequestOrderField{
-		Value: func(_m *Request) (ent.Value, error) {
+	// SystemOrderFieldCreatedAt orders System by created_at.
+	SystemOrderFieldCreatedAt = &SystemOrderField{
+		Value: func(_m *System) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: request.FieldCreatedAt,
-		toTerm: request.ByCreatedAt,
-		toCursor: func(_m *Request) Cursor {
+		column: system.FieldCreatedAt,
+		toTerm: system.ByCreatedAt,
+		toCursor: func(_m *System) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// RequestOrderFieldUpdatedAt orders Request by updated_at.
-	RequestOrderFieldUpdatedAt = &RequestOrderField{
-		Value: func(_m *Request) (ent.Value, error) {
+	// SystemOrderFieldUpdatedAt orders System by updated_at.
+	SystemOrderFieldUpdatedAt = &SystemOrderField{
+		Value: func(_m *System) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: request.FieldUpdatedAt,
-		toTerm: request.ByUpdatedAt,
-		toCursor: func(_m *Request) Cursor {
+		column: system.FieldUpdatedAt,
+		toTerm: system.ByUpdatedAt,
+		toCursor: func(_m *System) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
@@ -4836,94 +7374,94 @@ var (
 )
 
 // String implement fmt.Stringer interface.
-func (f RequestOrderField) String() string {
+func (f SystemOrderField) String() string {
 	var str string
 	switch f.column {
-	case RequestOrderFieldCreatedAt.column:
+	case SystemOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case RequestOrderFieldUpdatedAt.column:
+	case SystemOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f RequestOrderField) MarshalGQL(w io.Writer) {
+func (f SystemOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *RequestOrderField) UnmarshalGQL(v interface{}) error {
+func (f *SystemOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("RequestOrderField %T must be a string", v)
+		return fmt.Errorf("SystemOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *RequestOrderFieldCreatedAt
+		*f = *SystemOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *RequestOrderFieldUpdatedAt
+		*f = *SystemOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid RequestOrderField", str)
+		return fmt.Errorf("%s is not a valid SystemOrderField", str)
 	}
 	return nil
 }
 
-// RequestOrderField defines the ordering field of Request.
-type RequestOrderField struct {
-	// Value extracts the ordering value from the given Request.
-	Value    func(*Request) (ent.Value, error)
+// SystemOrderField defines the ordering field of System.
+type SystemOrderField struct {
+	// Value extracts the ordering value from the given System.
+	Value    func(*System) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) request.OrderOption
-	toCursor func(*Request) Cursor
+	toTerm   func(...sql.OrderTermOption) system.OrderOption
+	toCursor func(*System) Cursor
 }
 
-// RequestOrder defines the ordering of Request.
-type RequestOrder struct {
-	Direction OrderDirection     `json:"direction"`
-	Field     *RequestOrderField `json:"field"`
+// SystemOrder defines the ordering of System.
+type SystemOrder struct {
+	Direction OrderDirection    `json:"direction"`
+	Field     *SystemOrderField `json:"field"`
 }
 
-// DefaultRequestOrder is the default ordering of Request.
-var DefaultRequestOrder = &RequestOrder{
+// DefaultSystemOrder is the default ordering of System.
+var DefaultSystemOrder = &SystemOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &RequestOrderField{
-		Value: func(_m *Request) (ent.Value, error) {
+	Field: &SystemOrderField{
+		Value: func(_m *System) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: request.FieldID,
-		toTerm: request.ByID,
-		toCursor: func(_m *Request) Cursor {
+		column: system.FieldID,
+		toTerm: system.ByID,
+		toCursor: func(_m *System) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Request into RequestEdge.
-func (_m *Request) ToEdge(order *RequestOrder) *RequestEdge {
+// ToEdge converts System into SystemEdge.
+func (_m *System) ToEdge(order *SystemOrder) *SystemEdge {
 	if order == nil {
-		order = DefaultRequestOrder
+		order = DefaultSystemOrder
 	}
-	return &RequestEdge{
+	return &SystemEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// RequestExecutionEdge is the edge representation of RequestExecution.
-type RequestExecutionEdge struct {
-	Node   *RequestExecution `json:"node"`
-	Cursor Cursor            `json:"cursor"`
+// ThreadEdge is the edge representation of Thread.
+type ThreadEdge struct {
+	Node   *Thread `json:"node"`
+	Cursor Cursor  `json:"cursor"`
 }
 
-// RequestExecutionConnection is the connection containing edges to RequestExecution.
-type RequestExecutionConnection struct {
-	Edges      []*RequestExecutionEdge `json:"edges"`
-	PageInfo   PageInfo                `json:"pageInfo"`
-	TotalCount int                     `json:"totalCount"`
+// ThreadConnection is the connection containing edges to Thread.
+type ThreadConnection struct {
+	Edges      []*ThreadEdge `json:"edges"`
+	PageInfo   PageInfo      `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
 }
 
-func (c *RequestExecutionConnection) build(nodes []*RequestExecution, pager *requestexecutionPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *ThreadConnection) build(nodes []*Thread, pager *threadPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -4933,21 +7471,21 @@ func (c *RequestExecutionConnection) build(nodes []*RequestExecution, pager *req
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *RequestExecution
+	var nodeAt func(int) *Thread
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *RequestExecution {
+		nodeAt = func(i int) *Thread {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *RequestExecution {
+		nodeAt = func(i int) *Thread {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*RequestExecutionEdge, len(nodes))
+	c.Edges = make([]*ThreadEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &RequestExecutionEdge{
+		c.Edges[i] = &ThreadEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -4961,87 +7499,87 @@ func (c *RequestExecutionConnection) build(nodes []*RequestExecution, pager *req
 	}
 }
 
-// RequestExecutionPaginateOption enables pagination customization.
-type RequestExecutionPaginateOption func(*requestexecutionPager) error
+// ThreadPaginateOption enables pagination customization.
+type ThreadPaginateOption func(*threadPager) error
 
-// WithRequestExecutionOrder configures pagination ordering.
-func WithRequestExecutionOrder(order *RequestExecutionOrder) RequestExecutionPaginateOption {
+// WithThreadOrder configures pagination ordering.
+func WithThreadOrder(order *ThreadOrder) ThreadPaginateOption {
 	if order == nil {
-		order = DefaultRequestExecutionOrder
+		order = DefaultThreadOrder
 	}
 	o := *order
-	return func(pager *requestexecutionPager) error {
+	return func(pager *threadPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultRequestExecutionOrder.Field
+			o.Field = DefaultThreadOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithRequestExecutionFilter configures pagination filter.
-func WithRequestExecutionFilter(filter func(*RequestExecutionQuery) (*RequestExecutionQuery, error)) RequestExecutionPaginateOption {
-	return func(pager *requestexecutionPager) error {
+// WithThreadFilter configures pagination filter.
+func WithThreadFilter(filter func(*ThreadQuery) (*ThreadQuery, error)) ThreadPaginateOption {
+	return func(pager *threadPager) error {
 		if filter == nil {
-			return errors.New("RequestExecutionQuery filter cannot be nil")
+			return errors.New("ThreadQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type requestexecutionPager struct {
+type threadPager struct {
 	reverse bool
-	order   *RequestExecutionOrder
-	filter  func(*RequestExecutionQuery) (*RequestExecutionQuery, error)
+	order   *ThreadOrder
+	filter  func(*ThreadQuery) (*ThreadQuery, error)
 }
 
-func newRequestExecutionPager(opts []RequestExecutionPaginateOption, reverse bool) (*requestexecutionPager, error) {
-	pager := &requestexecutionPager{reverse: reverse}
+func newThreadPager(opts []ThreadPaginateOption, reverse bool) (*threadPager, error) {
+	pager := &threadPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultRequestExecutionOrder
+		pager.order = DefaultThreadOrder
 	}
 	return pager, nil
 }
 
-func (p *requestexecutionPager) applyFilter(query *RequestExecutionQuery) (*RequestExecutionQuery, error) {
+func (p *threadPager) applyFilter(query *ThreadQuery) (*ThreadQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *requestexecutionPager) toCursor(_m *RequestExecution) Cursor {
+func (p *threadPager) toCursor(_m *Thread) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *requestexecutionPager) applyCursors(query *RequestExecutionQuery, after, before *Cursor) (*RequestExecutionQuery, error) {
+func (p *threadPager) applyCursors(query *ThreadQuery, after, before *Cursor) (*ThreadQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultRequestExecutionOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultThreadOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *requestexecutionPager) applyOrder(query *RequestExecutionQuery) *RequestExecutionQuery {
+func (p *threadPager) applyOrder(query *ThreadQuery) *ThreadQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultRequestExecutionOrder.Field {
-		query = query.Order(DefaultRequestExecutionOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultThreadOrder.Field {
+		query = query.Order(DefaultThreadOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -5049,7 +7587,7 @@ func (p *requestexecutionPager) applyOrder(query *RequestExecutionQuery) *Reques
 	return query
 }
 
-func (p *requestexecutionPager) orderExpr(query *RequestExecutionQuery) sql.Querier {
+func (p *threadPager) orderExpr(query *ThreadQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -5059,28 +7597,28 @@ func (p *requestexecutionPager) orderExpr(query *RequestExecutionQuery) sql.Quer
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultRequestExecutionOrder.Field {
-			b.Comma().Ident(DefaultRequestExecutionOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultThreadOrder.Field {
+			b.Comma().Ident(DefaultThreadOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to RequestExecution.
-func (_m *RequestExecutionQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Thread.
+func (_m *ThreadQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...RequestExecutionPaginateOption,
-) (*RequestExecutionConnection, error) {
+	before *Cursor, last *int, opts ...ThreadPaginateOption,
+) (*ThreadConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newRequestExecutionPager(opts, last != nil)
+	pager, err := newThreadPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &RequestExecutionConnection{Edges: []*RequestExecutionEdge{}}
+	conn := &ThreadConnection{Edges: []*ThreadEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -5119,28 +7657,28 @@ func (_m *RequestExecutionQuery) Paginate(
 }
 
 var (
-	// RequestExecutionOrderFieldCreatedAt orders RequestExecution by created_at.
-	RequestExecutionOrderFieldCreatedAt = &RequestExecutionOrderField{
-		Value: func(_m *RequestExecution) (ent.Value, error) {
+	// ThreadOrderFieldCreatedAt orders Thread by created_at.
+	ThreadOrderFieldCreatedAt = &ThreadOrderField{
+		Value: func(_m *Thread) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: requestexecution.FieldCreatedAt,
-		toTerm: requestexecution.ByCreatedAt,
-		toCursor: func(_m *RequestExecution) Cursor {
+		column: thread.FieldCreatedAt,
+		toTerm: thread.ByCreatedAt,
+		toCursor: func(_m *Thread) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// RequestExecutionOrderFieldUpdatedAt orders RequestExecution by updated_at.
-	RequestExecutionOrderFieldUpdatedAt = &RequestExecutionOrderField{
-		Value: func(_m *RequestExecution) (ent.Value, error) {
+	// ThreadOrderFieldUpdatedAt orders Thread by updated_at.
+	ThreadOrderFieldUpdatedAt = &ThreadOrderField{
+		Value: func(_m *Thread) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: requestexecution.FieldUpdatedAt,
-		toTerm: requestexecution.ByUpdatedAt,
-		toCursor: func(_m *RequestExecution) Cursor {
+		column: thread.FieldUpdatedAt,
+		toTerm: thread.ByUpdatedAt,
+		toCursor: func(_m *Thread) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
@@ -5150,94 +7688,94 @@ var (
 )
 
 // String implement fmt.Stringer interface.
-func (f RequestExecutionOrderField) String() string {
+func (f ThreadOrderField) String() string {
 	var str string
 	switch f.column {
-	case RequestExecutionOrderFieldCreatedAt.column:
+	case ThreadOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case RequestExecutionOrderFieldUpdatedAt.column:
+	case ThreadOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f RequestExecutionOrderField) MarshalGQL(w io.Writer) {
+func (f ThreadOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *RequestExecutionOrderField) UnmarshalGQL(v interface{}) error {
+func (f *ThreadOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("RequestExecutionOrderField %T must be a string", v)
+		return fmt.Errorf("ThreadOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *RequestExecutionOrderFieldCreatedAt
+		*f = *ThreadOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *RequestExecutionOrderFieldUpdatedAt
+		*f = *ThreadOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid RequestExecutionOrderField", str)
+		return fmt.Errorf("%s is not a valid ThreadOrderField", str)
 	}
 	return nil
 }
 
-// RequestExecutionOrderField defines the ordering field of RequestExecution.
-type RequestExecutionOrderField struct {
-	// Value extracts the ordering value from the given RequestExecution.
-	Value    func(*RequestExecution) (ent.Value, error)
+// ThreadOrderField defines the ordering field of Thread.
+type ThreadOrderField struct {
+	// Value extracts the ordering value from the given Thread.
+	Value    func(*Thread) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) requestexecution.OrderOption
-	toCursor func(*RequestExecution) Cursor
+	toTerm   func(...sql.OrderTermOption) thread.OrderOption
+	toCursor func(*Thread) Cursor
 }
 
-// RequestExecutionOrder defines the ordering of RequestExecution.
-type RequestExecutionOrder struct {
-	Direction OrderDirection              `json:"direction"`
-	Field     *RequestExecutionOrderField `json:"field"`
+// ThreadOrder defines the ordering of Thread.
+type ThreadOrder struct {
+	Direction OrderDirection    `json:"direction"`
+	Field     *ThreadOrderField `json:"field"`
 }
 
-// DefaultRequestExecutionOrder is the default ordering of RequestExecution.
-var DefaultRequestExecutionOrder = &RequestExecutionOrder{
+// DefaultThreadOrder is the default ordering of Thread.
+var DefaultThreadOrder = &ThreadOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &RequestExecutionOrderField{
-		Value: func(_m *RequestExecution) (ent.Value, error) {
+	Field: &ThreadOrderField{
+		Value: func(_m *Thread) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: requestexecution.FieldID,
-		toTerm: requestexecution.ByID,
-		toCursor: func(_m *RequestExecution) Cursor {
+		column: thread.FieldID,
+		toTerm: thread.ByID,
+		toCursor: func(_m *Thread) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts RequestExecution into RequestExecutionEdge.
-func (_m *RequestExecution) ToEdge(order *RequestExecutionOrder) *RequestExecutionEdge {
+// ToEdge converts Thread into ThreadEdge.
+func (_m *Thread) ToEdge(order *ThreadOrder) *ThreadEdge {
 	if order == nil {
-		order = DefaultRequestExecutionOrder
+		order = DefaultThreadOrder
 	}
-	return &RequestExecutionEdge{
+	return &ThreadEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// RoleEdge is the edge representation of Role.
-type RoleEdge struct {
-	Node   *Role  `json:"node"`
+// TraceEdge is the edge representation of Trace.
+type TraceEdge struct {
+	Node   *Trace `json:"node"`
 	Cursor Cursor `json:"cursor"`
 }
 
-// RoleConnection is the connection containing edges to Role.
-type RoleConnection struct {
-	Edges      []*RoleEdge `json:"edges"`
-	PageInfo   PageInfo    `json:"pageInfo"`
-	TotalCount int         `json:"totalCount"`
+// TraceConnection is the connection containing edges to Trace.
+type TraceConnection struct {
+	Edges      []*TraceEdge `json:"edges"`
+	PageInfo   PageInfo     `json:"pageInfo"`
+	TotalCount int          `json:"totalCount"`
 }
 
-func (c *RoleConnection) build(nodes []*Role, pager *rolePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *TraceConnection) build(nodes []*Trace, pager *tracePager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -5247,21 +7785,21 @@ func (c *RoleConnection) build(nodes []*Role, pager *rolePager, after *Cursor, f
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Role
+	var nodeAt func(int) *Trace
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Role {
+		nodeAt = func(i int) *Trace {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Role {
+		nodeAt = func(i int) *Trace {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*RoleEdge, len(nodes))
+	c.Edges = make([]*TraceEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &RoleEdge{
+		c.Edges[i] = &TraceEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -5275,87 +7813,87 @@ func (c *RoleConnection) build(nodes []*Role, pager *rolePager, after *Cursor, f
 	}
 }
 
-// RolePaginateOption enables pagination customization.
-type RolePaginateOption func(*rolePager) error
+// TracePaginateOption enables pagination customization.
+type TracePaginateOption func(*tracePager) error
 
-// WithRoleOrder configures pagination ordering.
-func WithRoleOrder(order *RoleOrder) RolePaginateOption {
+// WithTraceOrder configures pagination ordering.
+func WithTraceOrder(order *TraceOrder) TracePaginateOption {
 	if order == nil {
-		order = DefaultRoleOrder
+		order = DefaultTraceOrder
 	}
 	o := *order
-	return func(pager *rolePager) error {
+	return func(pager *tracePager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultRoleOrder.Field
+			o.Field = DefaultTraceOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithRoleFilter configures pagination filter.
-func WithRoleFilter(filter func(*RoleQuery) (*RoleQuery, error)) RolePaginateOption {
-	return func(pager *rolePager) error {
+// WithTraceFilter configures pagination filter.
+func WithTraceFilter(filter func(*TraceQuery) (*TraceQuery, error)) TracePaginateOption {
+	return func(pager *tracePager) error {
 		if filter == nil {
-			return errors.New("RoleQuery filter cannot be nil")
+			return errors.New("TraceQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type rolePager struct {
+type tracePager struct {
 	reverse bool
-	order   *RoleOrder
-	filter  func(*RoleQuery) (*RoleQuery, error)
+	order   *TraceOrder
+	filter  func(*TraceQuery) (*TraceQuery, error)
 }
 
-func newRolePager(opts []RolePaginateOption, reverse bool) (*rolePager, error) {
-	pager := &rolePager{reverse: reverse}
+func newTracePager(opts []TracePaginateOption, reverse bool) (*tracePager, error) {
+	pager := &tracePager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultRoleOrder
+		pager.order = DefaultTraceOrder
 	}
 	return pager, nil
 }
 
-func (p *rolePager) applyFilter(query *RoleQuery) (*RoleQuery, error) {
+func (p *tracePager) applyFilter(query *TraceQuery) (*TraceQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *rolePager) toCursor(_m *Role) Cursor {
+func (p *tracePager) toCursor(_m *Trace) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *rolePager) applyCursors(query *RoleQuery, after, before *Cursor) (*RoleQuery, error) {
+func (p *tracePager) applyCursors(query *TraceQuery, after, before *Cursor) (*TraceQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultRoleOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultTraceOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *rolePager) applyOrder(query *RoleQuery) *RoleQuery {
+func (p *tracePager) applyOrder(query *TraceQuery) *TraceQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultRoleOrder.Field {
-		query = query.Order(DefaultRoleOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultTraceOrder.Field {
+		query = query.Order(DefaultTraceOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -5363,7 +7901,7 @@ func (p *rolePager) applyOrder(query *RoleQuery) *RoleQuery {
 	return query
 }
 
-func (p *rolePager) orderExpr(query *RoleQuery) sql.Querier {
+func (p *tracePager) orderExpr(query *TraceQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -5373,28 +7911,28 @@ func (p *rolePager) orderExpr(query *RoleQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultRoleOrder.Field {
-			b.Comma().Ident(DefaultRoleOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultTraceOrder.Field {
+			b.Comma().Ident(DefaultTraceOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Role.
-func (_m *RoleQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Trace.
+func (_m *TraceQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...RolePaginateOption,
-) (*RoleConnection, error) {
+	before *Cursor, last *int, opts ...TracePaginateOption,
+) (*TraceConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newRolePager(opts, last != nil)
+	pager, err := newTracePager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &RoleConnection{Edges: []*RoleEdge{}}
+	conn := &TraceConnection{Edges: []*TraceEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -5433,28 +7971,28 @@ func (_m *RoleQuery) Paginate(
 }
 
 var (
-	// RoleOrderFieldCreatedAt orders Role by created_at.
-	RoleOrderFieldCreatedAt = &RoleOrderField{
-		Value: func(_m *Role) (ent.Value, error) {
+	// TraceOrderFieldCreatedAt orders Trace by created_at.
+	TraceOrderFieldCreatedAt = &TraceOrderField{
+		Value: func(_m *Trace) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: role.FieldCreatedAt,
-		toTerm: role.ByCreatedAt,
-		toCursor: func(_m *Role) Cursor {
+		column: trace.FieldCreatedAt,
+		toTerm: trace.ByCreatedAt,
+		toCursor: func(_m *Trace) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// RoleOrderFieldUpdatedAt orders Role by updated_at.
-	RoleOrderFieldUpdatedAt = &RoleOrderField{
-		Value: func(_m *Role) (ent.Value, error) {
+	// TraceOrderFieldUpdatedAt orders Trace by updated_at.
+	TraceOrderFieldUpdatedAt = &TraceOrderField{
+		Value: func(_m *Trace) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: role.FieldUpdatedAt,
-		toTerm: role.ByUpdatedAt,
-		toCursor: func(_m *Role) Cursor {
+		column: trace.FieldUpdatedAt,
+		toTerm: trace.ByUpdatedAt,
+		toCursor: func(_m *Trace) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
@@ -5464,94 +8002,94 @@ var (
 )
 
 // String implement fmt.Stringer interface.
-func (f RoleOrderField) String() string {
+func (f TraceOrderField) String() string {
 	var str string
 	switch f.column {
-	case RoleOrderFieldCreatedAt.column:
+	case TraceOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case RoleOrderFieldUpdatedAt.column:
+	case TraceOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f RoleOrderField) MarshalGQL(w io.Writer) {
+func (f TraceOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *RoleOrderField) UnmarshalGQL(v interface{}) error {
+func (f *TraceOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("RoleOrderField %T must be a string", v)
+		return fmt.Errorf("TraceOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *RoleOrderFieldCreatedAt
+		*f = *TraceOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *RoleOrderFieldUpdatedAt
+		*f = *TraceOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid RoleOrderField", str)
+		return fmt.Errorf("%s is not a valid TraceOrderField", str)
 	}
 	return nil
 }
 
-// RoleOrderField defines the ordering field of Role.
-type RoleOrderField struct {
-	// Value extracts the ordering value from the given Role.
-	Value    func(*Role) (ent.Value, error)
+// TraceOrderField defines the ordering field of Trace.
+type TraceOrderField struct {
+	// Value extracts the ordering value from the given Trace.
+	Value    func(*Trace) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) role.OrderOption
-	toCursor func(*Role) Cursor
+	toTerm   func(...sql.OrderTermOption) trace.OrderOption
+	toCursor func(*Trace) Cursor
 }
 
-// RoleOrder defines the ordering of Role.
-type RoleOrder struct {
-	Direction OrderDirection  `json:"direction"`
-	Field     *RoleOrderField `json:"field"`
+// TraceOrder defines the ordering of Trace.
+type TraceOrder struct {
+	Direction OrderDirection   `json:"direction"`
+	Field     *TraceOrderField `json:"field"`
 }
 
-// DefaultRoleOrder is the default ordering of Role.
-var DefaultRoleOrder = &RoleOrder{
+// DefaultTraceOrder is the default ordering of Trace.
+var DefaultTraceOrder = &TraceOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &RoleOrderField{
-		Value: func(_m *Role) (ent.Value, error) {
+	Field: &TraceOrderField{
+		Value: func(_m *Trace) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: role.FieldID,
-		toTerm: role.ByID,
-		toCursor: func(_m *Role) Cursor {
+		column: trace.FieldID,
+		toTerm: trace.ByID,
+		toCursor: func(_m *Trace) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Role into RoleEdge.
-func (_m *Role) ToEdge(order *RoleOrder) *RoleEdge {
+// ToEdge converts Trace into TraceEdge.
+func (_m *Trace) ToEdge(order *TraceOrder) *TraceEdge {
 	if order == nil {
-		order = DefaultRoleOrder
+		order = DefaultTraceOrder
 	}
-	return &RoleEdge{
+	return &TraceEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// SystemEdge is the edge representation of System.
-type SystemEdge struct {
-	Node   *System `json:"node"`
-	Cursor Cursor  `json:"cursor"`
+// UsageLogEdge is the edge representation of UsageLog.
+type UsageLogEdge struct {
+	Node   *UsageLog `json:"node"`
+	Cursor Cursor    `json:"cursor"`
 }
 
-// SystemConnection is the connection containing edges to System.
-type SystemConnection struct {
-	Edges      []*SystemEdge `json:"edges"`
-	PageInfo   PageInfo      `json:"pageInfo"`
-	TotalCount int           `json:"totalCount"`
+// UsageLogConnection is the connection containing edges to UsageLog.
+type UsageLogConnection struct {
+	Edges      []*UsageLogEdge `json:"edges"`
+	PageInfo   PageInfo        `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
 }
 
-func (c *SystemConnection) build(nodes []*System, pager *systemPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *UsageLogConnection) build(nodes []*UsageLog, pager *usagelogPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -5561,21 +8099,21 @@ func (c *SystemConnection) build(nodes []*System, pager *systemPager, after *Cur
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *System
+	var nodeAt func(int) *UsageLog
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *System {
+		nodeAt = func(i int) *UsageLog {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *System {
+		nodeAt = func(i int) *UsageLog {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*SystemEdge, len(nodes))
+	c.Edges = make([]*UsageLogEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &SystemEdge{
+		c.Edges[i] = &UsageLogEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -5589,87 +8127,87 @@ func (c *SystemConnection) build(nodes []*System, pager *systemPager, after *Cur
 	}
 }
 
-// SystemPaginateOption enables pagination customization.
-type SystemPaginateOption func(*systemPager) error
+// UsageLogPaginateOption enables pagination customization.
+type UsageLogPaginateOption func(*usagelogPager) error
 
-// WithSystemOrder configures pagination ordering.
-func WithSystemOrder(order *SystemOrder) SystemPaginateOption {
+// WithUsageLogOrder configures pagination ordering.
+func WithUsageLogOrder(order *UsageLogOrder) UsageLogPaginateOption {
 	if order == nil {
-		order = DefaultSystemOrder
+		order = DefaultUsageLogOrder
 	}
 	o := *order
-	return func(pager *systemPager) error {
+	return func(pager *usagelogPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultSystemOrder.Field
+			o.Field = DefaultUsageLogOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithSystemFilter configures pagination filter.
-func WithSystemFilter(filter func(*SystemQuery) (*SystemQuery, error)) SystemPaginateOption {
-	return func(pager *systemPager) error {
+// WithUsageLogFilter configures pagination filter.
+func WithUsageLogFilter(filter func(*UsageLogQuery) (*UsageLogQuery, error)) UsageLogPaginateOption {
+	return func(pager *usagelogPager) error {
 		if filter == nil {
-			return errors.New("SystemQuery filter cannot be nil")
+			return errors.New("UsageLogQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type systemPager struct {
+type usagelogPager struct {
 	reverse bool
-	order   *SystemOrder
-	filter  func(*SystemQuery) (*SystemQuery, error)
+	order   *UsageLogOrder
+	filter  func(*UsageLogQuery) (*UsageLogQuery, error)
 }
 
-func newSystemPager(opts []SystemPaginateOption, reverse bool) (*systemPager, error) {
-	pager := &systemPager{reverse: reverse}
+func newUsageLogPager(opts []UsageLogPaginateOption, reverse bool) (*usagelogPager, error) {
+	pager := &usagelogPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultSystemOrder
+		pager.order = DefaultUsageLogOrder
 	}
 	return pager, nil
 }
 
-func (p *systemPager) applyFilter(query *SystemQuery) (*SystemQuery, error) {
+func (p *usagelogPager) applyFilter(query *UsageLogQuery) (*UsageLogQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *systemPager) toCursor(_m *System) Cursor {
+func (p *usagelogPager) toCursor(_m *UsageLog) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *systemPager) applyCursors(query *SystemQuery, after, before *Cursor) (*SystemQuery, error) {
+func (p *usagelogPager) applyCursors(query *UsageLogQuery, after, before *Cursor) (*UsageLogQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultSystemOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultUsageLogOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *systemPager) applyOrder(query *SystemQuery) *SystemQuery {
+func (p *usagelogPager) applyOrder(query *UsageLogQuery) *UsageLogQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultSystemOrder.Field {
-		query = query.Order(DefaultSystemOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultUsageLogOrder.Field {
+		query = query.Order(DefaultUsageLogOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -5677,7 +8215,7 @@ func (p *systemPager) applyOrder(query *SystemQuery) *SystemQuery {
 	return query
 }
 
-func (p *systemPager) orderExpr(query *SystemQuery) sql.Querier {
+func (p *usagelogPager) orderExpr(query *UsageLogQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -5687,28 +8225,28 @@ func (p *systemPager) orderExpr(query *SystemQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultSystemOrder.Field {
-			b.Comma().Ident(DefaultSystemOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultUsageLogOrder.Field {
+			b.Comma().Ident(DefaultUsageLogOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to System.
-func (_m *SystemQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to UsageLog.
+func (_m *UsageLogQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...SystemPaginateOption,
-) (*SystemConnection, error) {
+	before *Cursor, last *int, opts ...UsageLogPaginateOption,
+) (*UsageLogConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newSystemPager(opts, last != nil)
+	pager, err := newUsageLogPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &SystemConnection{Edges: []*SystemEdge{}}
+	conn := &UsageLogConnection{Edges: []*UsageLogEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -5747,28 +8285,28 @@ func (_m *SystemQuery) Paginate(
 }
 
 var (
-	// SystemOrderFieldCreatedAt orders System by created_at.
-	SystemOrderFieldCreatedAt = &SystemOrderField{
-		Value: func(_m *System) (ent.Value, error) {
+	// UsageLogOrderFieldCreatedAt orders UsageLog by created_at.
+	UsageLogOrderFieldCreatedAt = &UsageLogOrderField{
+		Value: func(_m *UsageLog) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: system.FieldCreatedAt,
-		toTerm: system.ByCreatedAt,
-		toCursor: func(_m *System) Cursor {
+		column: usagelog.FieldCreatedAt,
+		toTerm: usagelog.ByCreatedAt,
+		toCursor: func(_m *UsageLog) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// SystemOrderFieldUpdatedAt orders System by updated_at.
-	SystemOrderFieldUpdatedAt = &SystemOrderField{
-		Value: func(_m *System) (ent.Value, error) {
+	// UsageLogOrderFieldUpdatedAt orders UsageLog by updated_at.
+	UsageLogOrderFieldUpdatedAt = &UsageLogOrderField{
+		Value: func(_m *UsageLog) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: system.FieldUpdatedAt,
-		toTerm: system.ByUpdatedAt,
-		toCursor: func(_m *System) Cursor {
+		column: usagelog.FieldUpdatedAt,
+		toTerm: usagelog.ByUpdatedAt,
+		toCursor: func(_m *UsageLog) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
@@ -5778,94 +8316,94 @@ var (
 )
 
 // String implement fmt.Stringer interface.
-func (f SystemOrderField) String() string {
+func (f UsageLogOrderField) String() string {
 	var str string
 	switch f.column {
-	case SystemOrderFieldCreatedAt.column:
+	case UsageLogOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case SystemOrderFieldUpdatedAt.column:
+	case UsageLogOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f SystemOrderField) MarshalGQL(w io.Writer) {
+func (f UsageLogOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *SystemOrderField) UnmarshalGQL(v interface{}) error {
+func (f *UsageLogOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("SystemOrderField %T must be a string", v)
+		return fmt.Errorf("UsageLogOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *SystemOrderFieldCreatedAt
+		*f = *UsageLogOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *SystemOrderFieldUpdatedAt
+		*f = *UsageLogOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid SystemOrderField", str)
+		return fmt.Errorf("%s is not a valid UsageLogOrderField", str)
 	}
 	return nil
 }
 
-// SystemOrderField defines the ordering field of System.
-type SystemOrderField struct {
-	// Value extracts the ordering value from the given System.
-	Value    func(*System) (ent.Value, error)
+// UsageLogOrderField defines the ordering field of UsageLog.
+type UsageLogOrderField struct {
+	// Value extracts the ordering value from the given UsageLog.
+	Value    func(*UsageLog) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) system.OrderOption
-	toCursor func(*System) Cursor
+	toTerm   func(...sql.OrderTermOption) usagelog.OrderOption
+	toCursor func(*UsageLog) Cursor
 }
 
-// SystemOrder defines the ordering of System.
-type SystemOrder struct {
-	Direction OrderDirection    `json:"direction"`
-	Field     *SystemOrderField `json:"field"`
+// UsageLogOrder defines the ordering of UsageLog.
+type UsageLogOrder struct {
+	Direction OrderDirection      `json:"direction"`
+	Field     *UsageLogOrderField `json:"field"`
 }
 
-// DefaultSystemOrder is the default ordering of System.
-var DefaultSystemOrder = &SystemOrder{
+// DefaultUsageLogOrder is the default ordering of UsageLog.
+var DefaultUsageLogOrder = &UsageLogOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &SystemOrderField{
-		Value: func(_m *System) (ent.Value, error) {
+	Field: &UsageLogOrderField{
+		Value: func(_m *UsageLog) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: system.FieldID,
-		toTerm: system.ByID,
-		toCursor: func(_m *System) Cursor {
+		column: usagelog.FieldID,
+		toTerm: usagelog.ByID,
+		toCursor: func(_m *UsageLog) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts System into SystemEdge.
-func (_m *System) ToEdge(order *SystemOrder) *SystemEdge {
+// ToEdge converts UsageLog into UsageLogEdge.
+func (_m *UsageLog) ToEdge(order *UsageLogOrder) *UsageLogEdge {
 	if order == nil {
-		order = DefaultSystemOrder
+		order = DefaultUsageLogOrder
 	}
-	return &SystemEdge{
+	return &UsageLogEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// ThreadEdge is the edge representation of Thread.
-type ThreadEdge struct {
-	Node   *Thread `json:"node"`
-	Cursor Cursor  `json:"cursor"`
+// UserEdge is the edge representation of User.
+type UserEdge struct {
+	Node   *User  `json:"node"`
+	Cursor Cursor `json:"cursor"`
 }
 
-// ThreadConnection is the connection containing edges to Thread.
-type ThreadConnection struct {
-	Edges      []*ThreadEdge `json:"edges"`
-	PageInfo   PageInfo      `json:"pageInfo"`
-	TotalCount int           `json:"totalCount"`
+// UserConnection is the connection containing edges to User.
+type UserConnection struct {
+	Edges      []*UserEdge `json:"edges"`
+	PageInfo   PageInfo    `json:"pageInfo"`
+	TotalCount int         `json:"totalCount"`
 }
 
-func (c *ThreadConnection) build(nodes []*Thread, pager *threadPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *UserConnection) build(nodes []*User, pager *userPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -5875,21 +8413,21 @@ func (c *ThreadConnection) build(nodes []*Thread, pager *threadPager, after *Cur
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Thread
+	var nodeAt func(int) *User
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Thread {
+		nodeAt = func(i int) *User {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Thread {
+		nodeAt = func(i int) *User {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*ThreadEdge, len(nodes))
+	c.Edges = make([]*UserEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &ThreadEdge{
+		c.Edges[i] = &UserEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -5903,87 +8441,87 @@ func (c *ThreadConnection) build(nodes []*Thread, pager *threadPager, after *Cur
 	}
 }
 
-// ThreadPaginateOption enables pagination customization.
-type ThreadPaginateOption func(*threadPager) error
+// UserPaginateOption enables pagination customization.
+type UserPaginateOption func(*userPager) error
 
-// WithThreadOrder configures pagination ordering.
-func WithThreadOrder(order *ThreadOrder) ThreadPaginateOption {
+// WithUserOrder configures pagination ordering.
+func WithUserOrder(order *UserOrder) UserPaginateOption {
 	if order == nil {
-		order = DefaultThreadOrder
+		order = DefaultUserOrder
 	}
 	o := *order
-	return func(pager *threadPager) error {
+	return func(pager *userPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultThreadOrder.Field
+			o.Field = DefaultUserOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithThreadFilter configures pagination filter.
-func WithThreadFilter(filter func(*ThreadQuery) (*ThreadQuery, error)) ThreadPaginateOption {
-	return func(pager *threadPager) error {
+// WithUserFilter configures pagination filter.
+func WithUserFilter(filter func(*UserQuery) (*UserQuery, error)) UserPaginateOption {
+	return func(pager *userPager) error {
 		if filter == nil {
-			return errors.New("ThreadQuery filter cannot be nil")
+			return errors.New("UserQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type threadPager struct {
+type userPager struct {
 	reverse bool
-	order   *ThreadOrder
-	filter  func(*ThreadQuery) (*ThreadQuery, error)
+	order   *UserOrder
+	filter  func(*UserQuery) (*UserQuery, error)
 }
 
-func newThreadPager(opts []ThreadPaginateOption, reverse bool) (*threadPager, error) {
-	pager := &threadPager{reverse: reverse}
+func newUserPager(opts []UserPaginateOption, reverse bool) (*userPager, error) {
+	pager := &userPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultThreadOrder
+		pager.order = DefaultUserOrder
 	}
 	return pager, nil
 }
 
-func (p *threadPager) applyFilter(query *ThreadQuery) (*ThreadQuery, error) {
+func (p *userPager) applyFilter(query *UserQuery) (*UserQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *threadPager) toCursor(_m *Thread) Cursor {
+func (p *userPager) toCursor(_m *User) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *threadPager) applyCursors(query *ThreadQuery, after, before *Cursor) (*ThreadQuery, error) {
+func (p *userPager) applyCursors(query *UserQuery, after, before *Cursor) (*UserQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultThreadOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultUserOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *threadPager) applyOrder(query *ThreadQuery) *ThreadQuery {
+func (p *userPager) applyOrder(query *UserQuery) *UserQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultThreadOrder.Field {
-		query = query.Order(DefaultThreadOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultUserOrder.Field {
+		query = query.Order(DefaultUserOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -5991,7 +8529,7 @@ func (p *threadPager) applyOrder(query *ThreadQuery) *ThreadQuery {
 	return query
 }
 
-func (p *threadPager) orderExpr(query *ThreadQuery) sql.Querier {
+func (p *userPager) orderExpr(query *UserQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -6001,28 +8539,28 @@ func (p *threadPager) orderExpr(query *ThreadQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultThreadOrder.Field {
-			b.Comma().Ident(DefaultThreadOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultUserOrder.Field {
+			b.Comma().Ident(DefaultUserOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Thread.
-func (_m *ThreadQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to User.
+func (_m *UserQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ThreadPaginateOption,
-) (*ThreadConnection, error) {
+	before *Cursor, last *int, opts ...UserPaginateOption,
+) (*UserConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newThreadPager(opts, last != nil)
+	pager, err := newUserPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &ThreadConnection{Edges: []*ThreadEdge{}}
+	conn := &UserConnection{Edges: []*UserEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -6061,28 +8599,28 @@ func (_m *ThreadQuery) Paginate(
 }
 
 var (
-	// ThreadOrderFieldCreatedAt orders Thread by created_at.
-	ThreadOrderFieldCreatedAt = &ThreadOrderField{
-		Value: func(_m *Thread) (ent.Value, error) {
+	// UserOrderFieldCreatedAt orders User by created_at.
+	UserOrderFieldCreatedAt = &UserOrderField{
+		Value: func(_m *User) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: thread.FieldCreatedAt,
-		toTerm: thread.ByCreatedAt,
-		toCursor: func(_m *Thread) Cursor {
+		column: user.FieldCreatedAt,
+		toTerm: user.ByCreatedAt,
+		toCursor: func(_m *User) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// ThreadOrderFieldUpdatedAt orders Thread by updated_at.
-	ThreadOrderFieldUpdatedAt = &ThreadOrderField{
-		Value: func(_m *Thread) (ent.Value, error) {
+	// UserOrderFieldUpdatedAt orders User by updated_at.
+	UserOrderFieldUpdatedAt = &UserOrderField{
+		Value: func(_m *User) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: thread.FieldUpdatedAt,
-		toTerm: thread.ByUpdatedAt,
-		toCursor: func(_m *Thread) Cursor {
+		column: user.FieldUpdatedAt,
+		toTerm: user.ByUpdatedAt,
+		toCursor: func(_m *User) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
@@ -6092,94 +8630,94 @@ var (
 )
 
 // String implement fmt.Stringer interface.
-func (f ThreadOrderField) String() string {
+func (f UserOrderField) String() string {
 	var str string
 	switch f.column {
-	case ThreadOrderFieldCreatedAt.column:
+	case UserOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case ThreadOrderFieldUpdatedAt.column:
+	case UserOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f ThreadOrderField) MarshalGQL(w io.Writer) {
+func (f UserOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *ThreadOrderField) UnmarshalGQL(v interface{}) error {
+func (f *UserOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("ThreadOrderField %T must be a string", v)
+		return fmt.Errorf("UserOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *ThreadOrderFieldCreatedAt
+		*f = *UserOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *ThreadOrderFieldUpdatedAt
+		*f = *UserOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid ThreadOrderField", str)
+		return fmt.Errorf("%s is not a valid UserOrderField", str)
 	}
 	return nil
 }
 
-// ThreadOrderField defines the ordering field of Thread.
-type ThreadOrderField struct {
-	// Value extracts the ordering value from the given Thread.
-	Value    func(*Thread) (ent.Value, error)
+// UserOrderField defines the ordering field of User.
+type UserOrderField struct {
+	// Value extracts the ordering value from the given User.
+	Value    func(*User) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) thread.OrderOption
-	toCursor func(*Thread) Cursor
+	toTerm   func(...sql.OrderTermOption) user.OrderOption
+	toCursor func(*User) Cursor
 }
 
-// ThreadOrder defines the ordering of Thread.
-type ThreadOrder struct {
-	Direction OrderDirection    `json:"direction"`
-	Field     *ThreadOrderField `json:"field"`
+// UserOrder defines the ordering of User.
+type UserOrder struct {
+	Direction OrderDirection  `json:"direction"`
+	Field     *UserOrderField `json:"field"`
 }
 
-// DefaultThreadOrder is the default ordering of Thread.
-var DefaultThreadOrder = &ThreadOrder{
+// DefaultUserOrder is the default ordering of User.
+var DefaultUserOrder = &UserOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &ThreadOrderField{
-		Value: func(_m *Thread) (ent.Value, error) {
+	Field: &UserOrderField{
+		Value: func(_m *User) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: thread.FieldID,
-		toTerm: thread.ByID,
-		toCursor: func(_m *Thread) Cursor {
+		column: user.FieldID,
+		toTerm: user.ByID,
+		toCursor: func(_m *User) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Thread into ThreadEdge.
-func (_m *Thread) ToEdge(order *ThreadOrder) *ThreadEdge {
+// ToEdge converts User into UserEdge.
+func (_m *User) ToEdge(order *UserOrder) *UserEdge {
 	if order == nil {
-		order = DefaultThreadOrder
+		order = DefaultUserOrder
 	}
-	return &ThreadEdge{
+	return &UserEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// TraceEdge is the edge representation of Trace.
-type TraceEdge struct {
-	Node   *Trace `json:"node"`
-	Cursor Cursor `json:"cursor"`
+// UserProjectEdge is the edge representation of UserProject.
+type UserProjectEdge struct {
+	Node   *UserProject `json:"node"`
+	Cursor Cursor       `json:"cursor"`
 }
 
-// TraceConnection is the connection containing edges to Trace.
-type TraceConnection struct {
-	Edges      []*TraceEdge `json:"edges"`
-	PageInfo   PageInfo     `json:"pageInfo"`
-	TotalCount int          `json:"totalCount"`
+// UserProjectConnection is the connection containing edges to UserProject.
+type UserProjectConnection struct {
+	Edges      []*UserProjectEdge `json:"edges"`
+	PageInfo   PageInfo           `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
 }
 
-func (c *TraceConnection) build(nodes []*Trace, pager *tracePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *UserProjectConnection) build(nodes []*UserProject, pager *userprojectPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -6189,21 +8727,21 @@ func (c *TraceConnection) build(nodes []*Trace, pager *tracePager, after *Cursor
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Trace
+	var nodeAt func(int) *UserProject
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Trace {
+		nodeAt = func(i int) *UserProject {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Trace {
+		nodeAt = func(i int) *UserProject {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*TraceEdge, len(nodes))
+	c.Edges = make([]*UserProjectEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &TraceEdge{
+		c.Edges[i] = &UserProjectEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -6217,87 +8755,87 @@ func (c *TraceConnection) build(nodes []*Trace, pager *tracePager, after *Cursor
 	}
 }
 
-// TracePaginateOption enables pagination customization.
-type TracePaginateOption func(*tracePager) error
+// UserProjectPaginateOption enables pagination customization.
+type UserProjectPaginateOption func(*userprojectPager) error
 
-// WithTraceOrder configures pagination ordering.
-func WithTraceOrder(order *TraceOrder) TracePaginateOption {
+// WithUserProjectOrder configures pagination ordering.
+func WithUserProjectOrder(order *UserProjectOrder) UserProjectPaginateOption {
 	if order == nil {
-		order = DefaultTraceOrder
+		order = DefaultUserProjectOrder
 	}
 	o := *order
-	return func(pager *tracePager) error {
+	return func(pager *userprojectPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultTraceOrder.Field
+			o.Field = DefaultUserProjectOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithTraceFilter configures pagination filter.
-func WithTraceFilter(filter func(*TraceQuery) (*TraceQuery, error)) TracePaginateOption {
-	return func(pager *tracePager) error {
+// WithUserProjectFilter configures pagination filter.
+func WithUserProjectFilter(filter func(*UserProjectQuery) (*UserProjectQuery, error)) UserProjectPaginateOption {
+	return func(pager *userprojectPager) error {
 		if filter == nil {
-			return errors.New("TraceQuery filter cannot be nil")
+			return errors.New("UserProjectQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type tracePager struct {
+type userprojectPager struct {
 	reverse bool
-	order   *TraceOrder
-	filter  func(*TraceQuery) (*TraceQuery, error)
+	order   *UserProjectOrder
+	filter  func(*UserProjectQuery) (*UserProjectQuery, error)
 }
 
-func newTracePager(opts []TracePaginateOption, reverse bool) (*tracePager, error) {
-	pager := &tracePager{reverse: reverse}
+func newUserProjectPager(opts []UserProjectPaginateOption, reverse bool) (*userprojectPager, error) {
+	pager := &userprojectPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultTraceOrder
+		pager.order = DefaultUserProjectOrder
 	}
 	return pager, nil
 }
 
-func (p *tracePager) applyFilter(query *TraceQuery) (*TraceQuery, error) {
+func (p *userprojectPager) applyFilter(query *UserProjectQuery) (*UserProjectQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *tracePager) toCursor(_m *Trace) Cursor {
+func (p *userprojectPager) toCursor(_m *UserProject) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *tracePager) applyCursors(query *TraceQuery, after, before *Cursor) (*TraceQuery, error) {
+func (p *userprojectPager) applyCursors(query *UserProjectQuery, after, before *Cursor) (*UserProjectQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultTraceOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultUserProjectOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *tracePager) applyOrder(query *TraceQuery) *TraceQuery {
+func (p *userprojectPager) applyOrder(query *UserProjectQuery) *UserProjectQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultTraceOrder.Field {
-		query = query.Order(DefaultTraceOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultUserProjectOrder.Field {
+		query = query.Order(DefaultUserProjectOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -6305,7 +8843,7 @@ func (p *tracePager) applyOrder(query *TraceQuery) *TraceQuery {
 	return query
 }
 
-func (p *tracePager) orderExpr(query *TraceQuery) sql.Querier {
+func (p *userprojectPager) orderExpr(query *UserProjectQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -6315,28 +8853,28 @@ func (p *tracePager) orderExpr(query *TraceQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultTraceOrder.Field {
-			b.Comma().Ident(DefaultTraceOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultUserProjectOrder.Field {
+			b.Comma().Ident(DefaultUserProjectOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Trace.
-func (_m *TraceQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to UserProject.
+func (_m *UserProjectQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...TracePaginateOption,
-) (*TraceConnection, error) {
+	before *Cursor, last *int, opts ...UserProjectPaginateOption,
+) (*UserProjectConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newTracePager(opts, last != nil)
+	pager, err := newUserProjectPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &TraceConnection{Edges: []*TraceEdge{}}
+	conn := &UserProjectConnection{Edges: []*UserProjectEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -6375,28 +8913,28 @@ func (_m *TraceQuery) Paginate(
 }
 
 var (
-	// TraceOrderFieldCreatedAt orders Trace by created_at.
-	TraceOrderFieldCreatedAt = &TraceOrderField{
-		Value: func(_m *Trace) (ent.Value, error) {
+	// UserProjectOrderFieldCreatedAt orders UserProject by created_at.
+	UserProjectOrderFieldCreatedAt = &UserProjectOrderField{
+		Value: func(_m *UserProject) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: trace.FieldCreatedAt,
-		toTerm: trace.ByCreatedAt,
-		toCursor: func(_m *Trace) Cursor {
+		column: userproject.FieldCreatedAt,
+		toTerm: userproject.ByCreatedAt,
+		toCursor: func(_m *UserProject) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// TraceOrderFieldUpdatedAt orders Trace by updated_at.
-	TraceOrderFieldUpdatedAt = &TraceOrderField{
-		Value: func(_m *Trace) (ent.Value, error) {
+	// UserProjectOrderFieldUpdatedAt orders UserProject by updated_at.
+	UserProjectOrderFieldUpdatedAt = &UserProjectOrderField{
+		Value: func(_m *UserProject) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: trace.FieldUpdatedAt,
-		toTerm: trace.ByUpdatedAt,
-		toCursor: func(_m *Trace) Cursor {
+		column: userproject.FieldUpdatedAt,
+		toTerm: userproject.ByUpdatedAt,
+		toCursor: func(_m *UserProject) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
@@ -6406,94 +8944,94 @@ var (
 )
 
 // String implement fmt.Stringer interface.
-func (f TraceOrderField) String() string {
+func (f UserProjectOrderField) String() string {
 	var str string
 	switch f.column {
-	case TraceOrderFieldCreatedAt.column:
+	case UserProjectOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case TraceOrderFieldUpdatedAt.column:
+	case UserProjectOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f TraceOrderField) MarshalGQL(w io.Writer) {
+func (f UserProjectOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *TraceOrderField) UnmarshalGQL(v interface{}) error {
+func (f *UserProjectOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("TraceOrderField %T must be a string", v)
+		return fmt.Errorf("UserProjectOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *TraceOrderFieldCreatedAt
+		*f = *UserProjectOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *TraceOrderFieldUpdatedAt
+		*f = *UserProjectOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid TraceOrderField", str)
+		return fmt.Errorf("%s is not a valid UserProjectOrderField", str)
 	}
 	return nil
 }
 
-// TraceOrderField defines the ordering field of Trace.
-type TraceOrderField struct {
-	// Value extracts the ordering value from the given Trace.
-	Value    func(*Trace) (ent.Value, error)
+// UserProjectOrderField defines the ordering field of UserProject.
+type UserProjectOrderField struct {
+	// Value extracts the ordering value from the given UserProject.
+	Value    func(*UserProject) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) trace.OrderOption
-	toCursor func(*Trace) Cursor
+	toTerm   func(...sql.OrderTermOption) userproject.OrderOption
+	toCursor func(*UserProject) Cursor
 }
 
-// TraceOrder defines the ordering of Trace.
-type TraceOrder struct {
-	Direction OrderDirection   `json:"direction"`
-	Field     *TraceOrderField `json:"field"`
+// UserProjectOrder defines the ordering of UserProject.
+type UserProjectOrder struct {
+	Direction OrderDirection         `json:"direction"`
+	Field     *UserProjectOrderField `json:"field"`
 }
 
-// DefaultTraceOrder is the default ordering of Trace.
-var DefaultTraceOrder = &TraceOrder{
+// DefaultUserProjectOrder is the default ordering of UserProject.
+var DefaultUserProjectOrder = &UserProjectOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &TraceOrderField{
-		Value: func(_m *Trace) (ent.Value, error) {
+	Field: &UserProjectOrderField{
+		Value: func(_m *UserProject) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: trace.FieldID,
-		toTerm: trace.ByID,
-		toCursor: func(_m *Trace) Cursor {
+		column: userproject.FieldID,
+		toTerm: userproject.ByID,
+		toCursor: func(_m *UserProject) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Trace into TraceEdge.
-func (_m *Trace) ToEdge(order *TraceOrder) *TraceEdge {
+// ToEdge converts UserProject into UserProjectEdge.
+func (_m *UserProject) ToEdge(order *UserProjectOrder) *UserProjectEdge {
 	if order == nil {
-		order = DefaultTraceOrder
+		order = DefaultUserProjectOrder
 	}
-	return &TraceEdge{
+	return &UserProjectEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// UsageLogEdge is the edge representation of UsageLog.
-type UsageLogEdge struct {
-	Node   *UsageLog `json:"node"`
+// UserRoleEdge is the edge representation of UserRole.
+type UserRoleEdge struct {
+	Node   *UserRole `json:"node"`
 	Cursor Cursor    `json:"cursor"`
 }
 
-// UsageLogConnection is the connection containing edges to UsageLog.
-type UsageLogConnection struct {
-	Edges      []*UsageLogEdge `json:"edges"`
+// UserRoleConnection is the connection containing edges to UserRole.
+type UserRoleConnection struct {
+	Edges      []*UserRoleEdge `json:"edges"`
 	PageInfo   PageInfo        `json:"pageInfo"`
 	TotalCount int             `json:"totalCount"`
 }
 
-func (c *UsageLogConnection) build(nodes []*UsageLog, pager *usagelogPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *UserRoleConnection) build(nodes []*UserRole, pager *userrolePager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -6503,21 +9041,21 @@ func (c *UsageLogConnection) build(nodes []*UsageLog, pager *usagelogPager, afte
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *UsageLog
+	var nodeAt func(int) *UserRole
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *UsageLog {
+		nodeAt = func(i int) *UserRole {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *UsageLog {
+		nodeAt = func(i int) *UserRole {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*UsageLogEdge, len(nodes))
+	c.Edges = make([]*UserRoleEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &UsageLogEdge{
+		c.Edges[i] = &UserRoleEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -6531,87 +9069,87 @@ func (c *UsageLogConnection) build(nodes []*UsageLog, pager *usagelogPager, afte
 	}
 }
 
-// UsageLogPaginateOption enables pagination customization.
-type UsageLogPaginateOption func(*usagelogPager) error
+// UserRolePaginateOption enables pagination customization.
+type UserRolePaginateOption func(*userrolePager) error
 
-// WithUsageLogOrder configures pagination ordering.
-func WithUsageLogOrder(order *UsageLogOrder) UsageLogPaginateOption {
+// WithUserRoleOrder configures pagination ordering.
+func WithUserRoleOrder(order *UserRoleOrder) UserRolePaginateOption {
 	if order == nil {
-		order = DefaultUsageLogOrder
+		order = DefaultUserRoleOrder
 	}
 	o := *order
-	return func(pager *usagelogPager) error {
+	return func(pager *userrolePager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultUsageLogOrder.Field
+			o.Field = DefaultUserRoleOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithUsageLogFilter configures pagination filter.
-func WithUsageLogFilter(filter func(*UsageLogQuery) (*UsageLogQuery, error)) UsageLogPaginateOption {
-	return func(pager *usagelogPager) error {
+// WithUserRoleFilter configures pagination filter.
+func WithUserRoleFilter(filter func(*UserRoleQuery) (*UserRoleQuery, error)) UserRolePaginateOption {
+	return func(pager *userrolePager) error {
 		if filter == nil {
-			return errors.New("UsageLogQuery filter cannot be nil")
+			return errors.New("UserRoleQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type usagelogPager struct {
+type userrolePager struct {
 	reverse bool
-	order   *UsageLogOrder
-	filter  func(*UsageLogQuery) (*UsageLogQuery, error)
+	order   *UserRoleOrder
+	filter  func(*UserRoleQuery) (*UserRoleQuery, error)
 }
 
-func newUsageLogPager(opts []UsageLogPaginateOption, reverse bool) (*usagelogPager, error) {
-	pager := &usagelogPager{reverse: reverse}
+func newUserRolePager(opts []UserRolePaginateOption, reverse bool) (*userrolePager, error) {
+	pager := &userrolePager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultUsageLogOrder
+		pager.order = DefaultUserRoleOrder
 	}
 	return pager, nil
 }
 
-func (p *usagelogPager) applyFilter(query *UsageLogQuery) (*UsageLogQuery, error) {
+func (p *userrolePager) applyFilter(query *UserRoleQuery) (*UserRoleQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *usagelogPager) toCursor(_m *UsageLog) Cursor {
+func (p *userrolePager) toCursor(_m *UserRole) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *usagelogPager) applyCursors(query *UsageLogQuery, after, before *Cursor) (*UsageLogQuery, error) {
+func (p *userrolePager) applyCursors(query *UserRoleQuery, after, before *Cursor) (*UserRoleQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultUsageLogOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultUserRoleOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *usagelogPager) applyOrder(query *UsageLogQuery) *UsageLogQuery {
+func (p *userrolePager) applyOrder(query *UserRoleQuery) *UserRoleQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultUsageLogOrder.Field {
-		query = query.Order(DefaultUsageLogOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultUserRoleOrder.Field {
+		query = query.Order(DefaultUserRoleOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -6619,7 +9157,7 @@ func (p *usagelogPager) applyOrder(query *UsageLogQuery) *UsageLogQuery {
 	return query
 }
 
-func (p *usagelogPager) orderExpr(query *UsageLogQuery) sql.Querier {
+func (p *userrolePager) orderExpr(query *UserRoleQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -6629,28 +9167,28 @@ func (p *usagelogPager) orderExpr(query *UsageLogQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultUsageLogOrder.Field {
-			b.Comma().Ident(DefaultUsageLogOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultUserRoleOrder.Field {
+			b.Comma().Ident(DefaultUserRoleOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to UsageLog.
-func (_m *UsageLogQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to UserRole.
+func (_m *UserRoleQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...UsageLogPaginateOption,
-) (*UsageLogConnection, error) {
+	before *Cursor, last *int, opts ...UserRolePaginateOption,
+) (*UserRoleConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newUsageLogPager(opts, last != nil)
+	pager, err := newUserRolePager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &UsageLogConnection{Edges: []*UsageLogEdge{}}
+	conn := &UserRoleConnection{Edges: []*UserRoleEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -6689,28 +9227,28 @@ func (_m *UsageLogQuery) Paginate(
 }
 
 var (
-	// UsageLogOrderFieldCreatedAt orders UsageLog by created_at.
-	UsageLogOrderFieldCreatedAt = &UsageLogOrderField{
-		Value: func(_m *UsageLog) (ent.Value, error) {
+	// UserRoleOrderFieldCreatedAt orders UserRole by created_at.
+	UserRoleOrderFieldCreatedAt = &UserRoleOrderField{
+		Value: func(_m *UserRole) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: usagelog.FieldCreatedAt,
-		toTerm: usagelog.ByCreatedAt,
-		toCursor: func(_m *UsageLog) Cursor {
+		column: userrole.FieldCreatedAt,
+		toTerm: userrole.ByCreatedAt,
+		toCursor: func(_m *UserRole) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// UsageLogOrderFieldUpdatedAt orders UsageLog by updated_at.
-	UsageLogOrderFieldUpdatedAt = &UsageLogOrderField{
-		Value: func(_m *UsageLog) (ent.Value, error) {
+	// UserRoleOrderFieldUpdatedAt orders UserRole by updated_at.
+	UserRoleOrderFieldUpdatedAt = &UserRoleOrderField{
+		Value: func(_m *UserRole) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: usagelog.FieldUpdatedAt,
-		toTerm: usagelog.ByUpdatedAt,
-		toCursor: func(_m *UsageLog) Cursor {
+		column: userrole.FieldUpdatedAt,
+		toTerm: userrole.ByUpdatedAt,
+		toCursor: func(_m *UserRole) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
@@ -6720,94 +9258,94 @@ var (
 )
 
 // String implement fmt.Stringer interface.
-func (f UsageLogOrderField) String() string {
+func (f UserRoleOrderField) String() string {
 	var str string
 	switch f.column {
-	case UsageLogOrderFieldCreatedAt.column:
+	case UserRoleOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case UsageLogOrderFieldUpdatedAt.column:
+	case UserRoleOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f UsageLogOrderField) MarshalGQL(w io.Writer) {
+func (f UserRoleOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *UsageLogOrderField) UnmarshalGQL(v interface{}) error {
+func (f *UserRoleOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("UsageLogOrderField %T must be a string", v)
+		return fmt.Errorf("UserRoleOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *UsageLogOrderFieldCreatedAt
+		*f = *UserRoleOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *UsageLogOrderFieldUpdatedAt
+		*f = *UserRoleOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid UsageLogOrderField", str)
+		return fmt.Errorf("%s is not a valid UserRoleOrderField", str)
 	}
 	return nil
 }
 
-// UsageLogOrderField defines the ordering field of UsageLog.
-type UsageLogOrderField struct {
-	// Value extracts the ordering value from the given UsageLog.
-	Value    func(*UsageLog) (ent.Value, error)
+// UserRoleOrderField defines the ordering field of UserRole.
+type UserRoleOrderField struct {
+	// Value extracts the ordering value from the given UserRole.
+	Value    func(*UserRole) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) usagelog.OrderOption
-	toCursor func(*UsageLog) Cursor
+	toTerm   func(...sql.OrderTermOption) userrole.OrderOption
+	toCursor func(*UserRole) Cursor
 }
 
-// UsageLogOrder defines the ordering of UsageLog.
-type UsageLogOrder struct {
+// UserRoleOrder defines the ordering of UserRole.
+type UserRoleOrder struct {
 	Direction OrderDirection      `json:"direction"`
-	Field     *UsageLogOrderField `json:"field"`
+	Field     *UserRoleOrderField `json:"field"`
 }
 
-// DefaultUsageLogOrder is the default ordering of UsageLog.
-var DefaultUsageLogOrder = &UsageLogOrder{
+// DefaultUserRoleOrder is the default ordering of UserRole.
+var DefaultUserRoleOrder = &UserRoleOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &UsageLogOrderField{
-		Value: func(_m *UsageLog) (ent.Value, error) {
+	Field: &UserRoleOrderField{
+		Value: func(_m *UserRole) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: usagelog.FieldID,
-		toTerm: usagelog.ByID,
-		toCursor: func(_m *UsageLog) Cursor {
+		column: userrole.FieldID,
+		toTerm: userrole.ByID,
+		toCursor: func(_m *UserRole) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts UsageLog into UsageLogEdge.
-func (_m *UsageLog) ToEdge(order *UsageLogOrder) *UsageLogEdge {
+// ToEdge converts UserRole into UserRoleEdge.
+func (_m *UserRole) ToEdge(order *UserRoleOrder) *UserRoleEdge {
 	if order == nil {
-		order = DefaultUsageLogOrder
+		order = DefaultUserRoleOrder
 	}
-	return &UsageLogEdge{
+	return &UserRoleEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// UserEdge is the edge representation of User.
-type UserEdge struct {
-	Node   *User  `json:"node"`
-	Cursor Cursor `json:"cursor"`
+// WalletEdge is the edge representation of Wallet.
+type WalletEdge struct {
+	Node   *Wallet `json:"node"`
+	Cursor Cursor  `json:"cursor"`
 }
 
-// UserConnection is the connection containing edges to User.
-type UserConnection struct {
-	Edges      []*UserEdge `json:"edges"`
-	PageInfo   PageInfo    `json:"pageInfo"`
-	TotalCount int         `json:"totalCount"`
+// WalletConnection is the connection containing edges to Wallet.
+type WalletConnection struct {
+	Edges      []*WalletEdge `json:"edges"`
+	PageInfo   PageInfo      `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
 }
 
-func (c *UserConnection) build(nodes []*User, pager *userPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *WalletConnection) build(nodes []*Wallet, pager *walletPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -6817,21 +9355,21 @@ func (c *UserConnection) build(nodes []*User, pager *userPager, after *Cursor, f
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *User
+	var nodeAt func(int) *Wallet
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *User {
+		nodeAt = func(i int) *Wallet {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *User {
+		nodeAt = func(i int) *Wallet {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*UserEdge, len(nodes))
+	c.Edges = make([]*WalletEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &UserEdge{
+		c.Edges[i] = &WalletEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -6845,87 +9383,87 @@ func (c *UserConnection) build(nodes []*User, pager *userPager, after *Cursor, f
 	}
 }
 
-// UserPaginateOption enables pagination customization.
-type UserPaginateOption func(*userPager) error
+// WalletPaginateOption enables pagination customization.
+type WalletPaginateOption func(*walletPager) error
 
-// WithUserOrder configures pagination ordering.
-func WithUserOrder(order *UserOrder) UserPaginateOption {
+// WithWalletOrder configures pagination ordering.
+func WithWalletOrder(order *WalletOrder) WalletPaginateOption {
 	if order == nil {
-		order = DefaultUserOrder
+		order = DefaultWalletOrder
 	}
 	o := *order
-	return func(pager *userPager) error {
+	return func(pager *walletPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultUserOrder.Field
+			o.Field = DefaultWalletOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithUserFilter configures pagination filter.
-func WithUserFilter(filter func(*UserQuery) (*UserQuery, error)) UserPaginateOption {
-	return func(pager *userPager) error {
+// WithWalletFilter configures pagination filter.
+func WithWalletFilter(filter func(*WalletQuery) (*WalletQuery, error)) WalletPaginateOption {
+	return func(pager *walletPager) error {
 		if filter == nil {
-			return errors.New("UserQuery filter cannot be nil")
+			return errors.New("WalletQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type userPager struct {
+type walletPager struct {
 	reverse bool
-	order   *UserOrder
-	filter  func(*UserQuery) (*UserQuery, error)
+	order   *WalletOrder
+	filter  func(*WalletQuery) (*WalletQuery, error)
 }
 
-func newUserPager(opts []UserPaginateOption, reverse bool) (*userPager, error) {
-	pager := &userPager{reverse: reverse}
+func newWalletPager(opts []WalletPaginateOption, reverse bool) (*walletPager, error) {
+	pager := &walletPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultUserOrder
+		pager.order = DefaultWalletOrder
 	}
 	return pager, nil
 }
 
-func (p *userPager) applyFilter(query *UserQuery) (*UserQuery, error) {
+func (p *walletPager) applyFilter(query *WalletQuery) (*WalletQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *userPager) toCursor(_m *User) Cursor {
+func (p *walletPager) toCursor(_m *Wallet) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *userPager) applyCursors(query *UserQuery, after, before *Cursor) (*UserQuery, error) {
+func (p *walletPager) applyCursors(query *WalletQuery, after, before *Cursor) (*WalletQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultUserOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultWalletOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *userPager) applyOrder(query *UserQuery) *UserQuery {
+func (p *walletPager) applyOrder(query *WalletQuery) *WalletQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultUserOrder.Field {
-		query = query.Order(DefaultUserOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultWalletOrder.Field {
+		query = query.Order(DefaultWalletOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -6933,7 +9471,7 @@ func (p *userPager) applyOrder(query *UserQuery) *UserQuery {
 	return query
 }
 
-func (p *userPager) orderExpr(query *UserQuery) sql.Querier {
+func (p *walletPager) orderExpr(query *WalletQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -6943,28 +9481,28 @@ func (p *userPager) orderExpr(query *UserQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultUserOrder.Field {
-			b.Comma().Ident(DefaultUserOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultWalletOrder.Field {
+			b.Comma().Ident(DefaultWalletOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to User.
-func (_m *UserQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Wallet.
+func (_m *WalletQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...UserPaginateOption,
-) (*UserConnection, error) {
+	before *Cursor, last *int, opts ...WalletPaginateOption,
+) (*WalletConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newUserPager(opts, last != nil)
+	pager, err := newWalletPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &UserConnection{Edges: []*UserEdge{}}
+	conn := &WalletConnection{Edges: []*WalletEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -7003,28 +9541,28 @@ func (_m *UserQuery) Paginate(
 }
 
 var (
-	// UserOrderFieldCreatedAt orders User by created_at.
-	UserOrderFieldCreatedAt = &UserOrderField{
-		Value: func(_m *User) (ent.Value, error) {
+	// WalletOrderFieldCreatedAt orders Wallet by created_at.
+	WalletOrderFieldCreatedAt = &WalletOrderField{
+		Value: func(_m *Wallet) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: user.FieldCreatedAt,
-		toTerm: user.ByCreatedAt,
-		toCursor: func(_m *User) Cursor {
+		column: wallet.FieldCreatedAt,
+		toTerm: wallet.ByCreatedAt,
+		toCursor: func(_m *Wallet) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// UserOrderFieldUpdatedAt orders User by updated_at.
-	UserOrderFieldUpdatedAt = &UserOrderField{
-		Value: func(_m *User) (ent.Value, error) {
+	// WalletOrderFieldUpdatedAt orders Wallet by updated_at.
+	WalletOrderFieldUpdatedAt = &WalletOrderField{
+		Value: func(_m *Wallet) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: user.FieldUpdatedAt,
-		toTerm: user.ByUpdatedAt,
-		toCursor: func(_m *User) Cursor {
+		column: wallet.FieldUpdatedAt,
+		toTerm: wallet.ByUpdatedAt,
+		toCursor: func(_m *Wallet) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
@@ -7034,94 +9572,94 @@ var (
 )
 
 // String implement fmt.Stringer interface.
-func (f UserOrderField) String() string {
+func (f WalletOrderField) String() string {
 	var str string
 	switch f.column {
-	case UserOrderFieldCreatedAt.column:
+	case WalletOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case UserOrderFieldUpdatedAt.column:
+	case WalletOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f UserOrderField) MarshalGQL(w io.Writer) {
+func (f WalletOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *UserOrderField) UnmarshalGQL(v interface{}) error {
+func (f *WalletOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("UserOrderField %T must be a string", v)
+		return fmt.Errorf("WalletOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *UserOrderFieldCreatedAt
+		*f = *WalletOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *UserOrderFieldUpdatedAt
+		*f = *WalletOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid UserOrderField", str)
+		return fmt.Errorf("%s is not a valid WalletOrderField", str)
 	}
 	return nil
 }
 
-// UserOrderField defines the ordering field of User.
-type UserOrderField struct {
-	// Value extracts the ordering value from the given User.
-	Value    func(*User) (ent.Value, error)
+// WalletOrderField defines the ordering field of Wallet.
+type WalletOrderField struct {
+	// Value extracts the ordering value from the given Wallet.
+	Value    func(*Wallet) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) user.OrderOption
-	toCursor func(*User) Cursor
+	toTerm   func(...sql.OrderTermOption) wallet.OrderOption
+	toCursor func(*Wallet) Cursor
 }
 
-// UserOrder defines the ordering of User.
-type UserOrder struct {
-	Direction OrderDirection  `json:"direction"`
-	Field     *UserOrderField `json:"field"`
+// WalletOrder defines the ordering of Wallet.
+type WalletOrder struct {
+	Direction OrderDirection    `json:"direction"`
+	Field     *WalletOrderField `json:"field"`
 }
 
-// DefaultUserOrder is the default ordering of User.
-var DefaultUserOrder = &UserOrder{
+// DefaultWalletOrder is the default ordering of Wallet.
+var DefaultWalletOrder = &WalletOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &UserOrderField{
-		Value: func(_m *User) (ent.Value, error) {
+	Field: &WalletOrderField{
+		Value: func(_m *Wallet) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: user.FieldID,
-		toTerm: user.ByID,
-		toCursor: func(_m *User) Cursor {
+		column: wallet.FieldID,
+		toTerm: wallet.ByID,
+		toCursor: func(_m *Wallet) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts User into UserEdge.
-func (_m *User) ToEdge(order *UserOrder) *UserEdge {
+// ToEdge converts Wallet into WalletEdge.
+func (_m *Wallet) ToEdge(order *WalletOrder) *WalletEdge {
 	if order == nil {
-		order = DefaultUserOrder
+		order = DefaultWalletOrder
 	}
-	return &UserEdge{
+	return &WalletEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// UserProjectEdge is the edge representation of UserProject.
-type UserProjectEdge struct {
-	Node   *UserProject `json:"node"`
-	Cursor Cursor       `json:"cursor"`
+// WalletTransactionEdge is the edge representation of WalletTransaction.
+type WalletTransactionEdge struct {
+	Node   *WalletTransaction `json:"node"`
+	Cursor Cursor             `json:"cursor"`
 }
 
-// UserProjectConnection is the connection containing edges to UserProject.
-type UserProjectConnection struct {
-	Edges      []*UserProjectEdge `json:"edges"`
-	PageInfo   PageInfo           `json:"pageInfo"`
-	TotalCount int                `json:"totalCount"`
+// WalletTransactionConnection is the connection containing edges to WalletTransaction.
+type WalletTransactionConnection struct {
+	Edges      []*WalletTransactionEdge `json:"edges"`
+	PageInfo   PageInfo                 `json:"pageInfo"`
+	TotalCount int                      `json:"totalCount"`
 }
 
-func (c *UserProjectConnection) build(nodes []*UserProject, pager *userprojectPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *WalletTransactionConnection) build(nodes []*WalletTransaction, pager *wallettransactionPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -7131,21 +9669,21 @@ func (c *UserProjectConnection) build(nodes []*UserProject, pager *userprojectPa
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *UserProject
+	var nodeAt func(int) *WalletTransaction
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *UserProject {
+		nodeAt = func(i int) *WalletTransaction {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *UserProject {
+		nodeAt = func(i int) *WalletTransaction {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*UserProjectEdge, len(nodes))
+	c.Edges = make([]*WalletTransactionEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &UserProjectEdge{
+		c.Edges[i] = &WalletTransactionEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -7159,87 +9697,87 @@ func (c *UserProjectConnection) build(nodes []*UserProject, pager *userprojectPa
 	}
 }
 
-// UserProjectPaginateOption enables pagination customization.
-type UserProjectPaginateOption func(*userprojectPager) error
+// WalletTransactionPaginateOption enables pagination customization.
+type WalletTransactionPaginateOption func(*wallettransactionPager) error
 
-// WithUserProjectOrder configures pagination ordering.
-func WithUserProjectOrder(order *UserProjectOrder) UserProjectPaginateOption {
+// WithWalletTransactionOrder configures pagination ordering.
+func WithWalletTransactionOrder(order *WalletTransactionOrder) WalletTransactionPaginateOption {
 	if order == nil {
-		order = DefaultUserProjectOrder
+		order = DefaultWalletTransactionOrder
 	}
 	o := *order
-	return func(pager *userprojectPager) error {
+	return func(pager *wallettransactionPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultUserProjectOrder.Field
+			o.Field = DefaultWalletTransactionOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithUserProjectFilter configures pagination filter.
-func WithUserProjectFilter(filter func(*UserProjectQuery) (*UserProjectQuery, error)) UserProjectPaginateOption {
-	return func(pager *userprojectPager) error {
+// WithWalletTransactionFilter configures pagination filter.
+func WithWalletTransactionFilter(filter func(*WalletTransactionQuery) (*WalletTransactionQuery, error)) WalletTransactionPaginateOption {
+	return func(pager *wallettransactionPager) error {
 		if filter == nil {
-			return errors.New("UserProjectQuery filter cannot be nil")
+			return errors.New("WalletTransactionQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type userprojectPager struct {
+type wallettransactionPager struct {
 	reverse bool
-	order   *UserProjectOrder
-	filter  func(*UserProjectQuery) (*UserProjectQuery, error)
+	order   *WalletTransactionOrder
+	filter  func(*WalletTransactionQuery) (*WalletTransactionQuery, error)
 }
 
-func newUserProjectPager(opts []UserProjectPaginateOption, reverse bool) (*userprojectPager, error) {
-	pager := &userprojectPager{reverse: reverse}
+func newWalletTransactionPager(opts []WalletTransactionPaginateOption, reverse bool) (*wallettransactionPager, error) {
+	pager := &wallettransactionPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultUserProjectOrder
+		pager.order = DefaultWalletTransactionOrder
 	}
 	return pager, nil
 }
 
-func (p *userprojectPager) applyFilter(query *UserProjectQuery) (*UserProjectQuery, error) {
+func (p *wallettransactionPager) applyFilter(query *WalletTransactionQuery) (*WalletTransactionQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *userprojectPager) toCursor(_m *UserProject) Cursor {
+func (p *wallettransactionPager) toCursor(_m *WalletTransaction) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *userprojectPager) applyCursors(query *UserProjectQuery, after, before *Cursor) (*UserProjectQuery, error) {
+func (p *wallettransactionPager) applyCursors(query *WalletTransactionQuery, after, before *Cursor) (*WalletTransactionQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultUserProjectOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultWalletTransactionOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *userprojectPager) applyOrder(query *UserProjectQuery) *UserProjectQuery {
+func (p *wallettransactionPager) applyOrder(query *WalletTransactionQuery) *WalletTransactionQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultUserProjectOrder.Field {
-		query = query.Order(DefaultUserProjectOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultWalletTransactionOrder.Field {
+		query = query.Order(DefaultWalletTransactionOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -7247,7 +9785,7 @@ func (p *userprojectPager) applyOrder(query *UserProjectQuery) *UserProjectQuery
 	return query
 }
 
-func (p *userprojectPager) orderExpr(query *UserProjectQuery) sql.Querier {
+func (p *wallettransactionPager) orderExpr(query *WalletTransactionQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -7257,28 +9795,28 @@ func (p *userprojectPager) orderExpr(query *UserProjectQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultUserProjectOrder.Field {
-			b.Comma().Ident(DefaultUserProjectOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultWalletTransactionOrder.Field {
+			b.Comma().Ident(DefaultWalletTransactionOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to UserProject.
-func (_m *UserProjectQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to WalletTransaction.
+func (_m *WalletTransactionQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...UserProjectPaginateOption,
-) (*UserProjectConnection, error) {
+	before *Cursor, last *int, opts ...WalletTransactionPaginateOption,
+) (*WalletTransactionConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newUserProjectPager(opts, last != nil)
+	pager, err := newWalletTransactionPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &UserProjectConnection{Edges: []*UserProjectEdge{}}
+	conn := &WalletTransactionConnection{Edges: []*WalletTransactionEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -7317,28 +9855,28 @@ func (_m *UserProjectQuery) Paginate(
 }
 
 var (
-	// UserProjectOrderFieldCreatedAt orders UserProject by created_at.
-	UserProjectOrderFieldCreatedAt = &UserProjectOrderField{
-		Value: func(_m *UserProject) (ent.Value, error) {
+	// WalletTransactionOrderFieldCreatedAt orders WalletTransaction by created_at.
+	WalletTransactionOrderFieldCreatedAt = &WalletTransactionOrderField{
+		Value: func(_m *WalletTransaction) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: userproject.FieldCreatedAt,
-		toTerm: userproject.ByCreatedAt,
-		toCursor: func(_m *UserProject) Cursor {
+		column: wallettransaction.FieldCreatedAt,
+		toTerm: wallettransaction.ByCreatedAt,
+		toCursor: func(_m *WalletTransaction) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// UserProjectOrderFieldUpdatedAt orders UserProject by updated_at.
-	UserProjectOrderFieldUpdatedAt = &UserProjectOrderField{
-		Value: func(_m *UserProject) (ent.Value, error) {
+	// WalletTransactionOrderFieldUpdatedAt orders WalletTransaction by updated_at.
+	WalletTransactionOrderFieldUpdatedAt = &WalletTransactionOrderField{
+		Value: func(_m *WalletTransaction) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: userproject.FieldUpdatedAt,
-		toTerm: userproject.ByUpdatedAt,
-		toCursor: func(_m *UserProject) Cursor {
+		column: wallettransaction.FieldUpdatedAt,
+		toTerm: wallettransaction.ByUpdatedAt,
+		toCursor: func(_m *WalletTransaction) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
@@ -7348,94 +9886,94 @@ var (
 )
 
 // String implement fmt.Stringer interface.
-func (f UserProjectOrderField) String() string {
+func (f WalletTransactionOrderField) String() string {
 	var str string
 	switch f.column {
-	case UserProjectOrderFieldCreatedAt.column:
+	case WalletTransactionOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case UserProjectOrderFieldUpdatedAt.column:
+	case WalletTransactionOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f UserProjectOrderField) MarshalGQL(w io.Writer) {
+func (f WalletTransactionOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *UserProjectOrderField) UnmarshalGQL(v interface{}) error {
+func (f *WalletTransactionOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("UserProjectOrderField %T must be a string", v)
+		return fmt.Errorf("WalletTransactionOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *UserProjectOrderFieldCreatedAt
+		*f = *WalletTransactionOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *UserProjectOrderFieldUpdatedAt
+		*f = *WalletTransactionOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid UserProjectOrderField", str)
+		return fmt.Errorf("%s is not a valid WalletTransactionOrderField", str)
 	}
 	return nil
 }
 
-// UserProjectOrderField defines the ordering field of UserProject.
-type UserProjectOrderField struct {
-	// Value extracts the ordering value from the given UserProject.
-	Value    func(*UserProject) (ent.Value, error)
+// WalletTransactionOrderField defines the ordering field of WalletTransaction.
+type WalletTransactionOrderField struct {
+	// Value extracts the ordering value from the given WalletTransaction.
+	Value    func(*WalletTransaction) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) userproject.OrderOption
-	toCursor func(*UserProject) Cursor
+	toTerm   func(...sql.OrderTermOption) wallettransaction.OrderOption
+	toCursor func(*WalletTransaction) Cursor
 }
 
-// UserProjectOrder defines the ordering of UserProject.
-type UserProjectOrder struct {
-	Direction OrderDirection         `json:"direction"`
-	Field     *UserProjectOrderField `json:"field"`
+// WalletTransactionOrder defines the ordering of WalletTransaction.
+type WalletTransactionOrder struct {
+	Direction OrderDirection               `json:"direction"`
+	Field     *WalletTransactionOrderField `json:"field"`
 }
 
-// DefaultUserProjectOrder is the default ordering of UserProject.
-var DefaultUserProjectOrder = &UserProjectOrder{
+// DefaultWalletTransactionOrder is the default ordering of WalletTransaction.
+var DefaultWalletTransactionOrder = &WalletTransactionOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &UserProjectOrderField{
-		Value: func(_m *UserProject) (ent.Value, error) {
+	Field: &WalletTransactionOrderField{
+		Value: func(_m *WalletTransaction) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: userproject.FieldID,
-		toTerm: userproject.ByID,
-		toCursor: func(_m *UserProject) Cursor {
+		column: wallettransaction.FieldID,
+		toTerm: wallettransaction.ByID,
+		toCursor: func(_m *WalletTransaction) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts UserProject into UserProjectEdge.
-func (_m *UserProject) ToEdge(order *UserProjectOrder) *UserProjectEdge {
+// ToEdge converts WalletTransaction into WalletTransactionEdge.
+func (_m *WalletTransaction) ToEdge(order *WalletTransactionOrder) *WalletTransactionEdge {
 	if order == nil {
-		order = DefaultUserProjectOrder
+		order = DefaultWalletTransactionOrder
 	}
-	return &UserProjectEdge{
+	return &WalletTransactionEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// UserRoleEdge is the edge representation of UserRole.
-type UserRoleEdge struct {
-	Node   *UserRole `json:"node"`
-	Cursor Cursor    `json:"cursor"`
+// WebhookDeliveryEdge is the edge representation of WebhookDelivery.
+type WebhookDeliveryEdge struct {
+	Node   *WebhookDelivery `json:"node"`
+	Cursor Cursor           `json:"cursor"`
 }
 
-// UserRoleConnection is the connection containing edges to UserRole.
-type UserRoleConnection struct {
-	Edges      []*UserRoleEdge `json:"edges"`
-	PageInfo   PageInfo        `json:"pageInfo"`
-	TotalCount int             `json:"totalCount"`
+// WebhookDeliveryConnection is the connection containing edges to WebhookDelivery.
+type WebhookDeliveryConnection struct {
+	Edges      []*WebhookDeliveryEdge `json:"edges"`
+	PageInfo   PageInfo               `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
 }
 
-func (c *UserRoleConnection) build(nodes []*UserRole, pager *userrolePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *WebhookDeliveryConnection) build(nodes []*WebhookDelivery, pager *webhookdeliveryPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -7445,21 +9983,21 @@ func (c *UserRoleConnection) build(nodes []*UserRole, pager *userrolePager, afte
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *UserRole
+	var nodeAt func(int) *WebhookDelivery
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *UserRole {
+		nodeAt = func(i int) *WebhookDelivery {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *UserRole {
+		nodeAt = func(i int) *WebhookDelivery {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*UserRoleEdge, len(nodes))
+	c.Edges = make([]*WebhookDeliveryEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &UserRoleEdge{
+		c.Edges[i] = &WebhookDeliveryEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -7473,87 +10011,87 @@ func (c *UserRoleConnection) build(nodes []*UserRole, pager *userrolePager, afte
 	}
 }
 
-// UserRolePaginateOption enables pagination customization.
-type UserRolePaginateOption func(*userrolePager) error
+// WebhookDeliveryPaginateOption enables pagination customization.
+type WebhookDeliveryPaginateOption func(*webhookdeliveryPager) error
 
-// WithUserRoleOrder configures pagination ordering.
-func WithUserRoleOrder(order *UserRoleOrder) UserRolePaginateOption {
+// WithWebhookDeliveryOrder configures pagination ordering.
+func WithWebhookDeliveryOrder(order *WebhookDeliveryOrder) WebhookDeliveryPaginateOption {
 	if order == nil {
-		order = DefaultUserRoleOrder
+		order = DefaultWebhookDeliveryOrder
 	}
 	o := *order
-	return func(pager *userrolePager) error {
+	return func(pager *webhookdeliveryPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultUserRoleOrder.Field
+			o.Field = DefaultWebhookDeliveryOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithUserRoleFilter configures pagination filter.
-func WithUserRoleFilter(filter func(*UserRoleQuery) (*UserRoleQuery, error)) UserRolePaginateOption {
-	return func(pager *userrolePager) error {
+// WithWebhookDeliveryFilter configures pagination filter.
+func WithWebhookDeliveryFilter(filter func(*WebhookDeliveryQuery) (*WebhookDeliveryQuery, error)) WebhookDeliveryPaginateOption {
+	return func(pager *webhookdeliveryPager) error {
 		if filter == nil {
-			return errors.New("UserRoleQuery filter cannot be nil")
+			return errors.New("WebhookDeliveryQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type userrolePager struct {
+type webhookdeliveryPager struct {
 	reverse bool
-	order   *UserRoleOrder
-	filter  func(*UserRoleQuery) (*UserRoleQuery, error)
+	order   *WebhookDeliveryOrder
+	filter  func(*WebhookDeliveryQuery) (*WebhookDeliveryQuery, error)
 }
 
-func newUserRolePager(opts []UserRolePaginateOption, reverse bool) (*userrolePager, error) {
-	pager := &userrolePager{reverse: reverse}
+func newWebhookDeliveryPager(opts []WebhookDeliveryPaginateOption, reverse bool) (*webhookdeliveryPager, error) {
+	pager := &webhookdeliveryPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultUserRoleOrder
+		pager.order = DefaultWebhookDeliveryOrder
 	}
 	return pager, nil
 }
 
-func (p *userrolePager) applyFilter(query *UserRoleQuery) (*UserRoleQuery, error) {
+func (p *webhookdeliveryPager) applyFilter(query *WebhookDeliveryQuery) (*WebhookDeliveryQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *userrolePager) toCursor(_m *UserRole) Cursor {
+func (p *webhookdeliveryPager) toCursor(_m *WebhookDelivery) Cursor {
 	return p.order.Field.toCursor(_m)
 }
 
-func (p *userrolePager) applyCursors(query *UserRoleQuery, after, before *Cursor) (*UserRoleQuery, error) {
+func (p *webhookdeliveryPager) applyCursors(query *WebhookDeliveryQuery, after, before *Cursor) (*WebhookDeliveryQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultUserRoleOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultWebhookDeliveryOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *userrolePager) applyOrder(query *UserRoleQuery) *UserRoleQuery {
+func (p *webhookdeliveryPager) applyOrder(query *WebhookDeliveryQuery) *WebhookDeliveryQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultUserRoleOrder.Field {
-		query = query.Order(DefaultUserRoleOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultWebhookDeliveryOrder.Field {
+		query = query.Order(DefaultWebhookDeliveryOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -7561,7 +10099,7 @@ func (p *userrolePager) applyOrder(query *UserRoleQuery) *UserRoleQuery {
 	return query
 }
 
-func (p *userrolePager) orderExpr(query *UserRoleQuery) sql.Querier {
+func (p *webhookdeliveryPager) orderExpr(query *WebhookDeliveryQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -7571,28 +10109,28 @@ func (p *userrolePager) orderExpr(query *UserRoleQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultUserRoleOrder.Field {
-			b.Comma().Ident(DefaultUserRoleOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultWebhookDeliveryOrder.Field {
+			b.Comma().Ident(DefaultWebhookDeliveryOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to UserRole.
-func (_m *UserRoleQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to WebhookDelivery.
+func (_m *WebhookDeliveryQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...UserRolePaginateOption,
-) (*UserRoleConnection, error) {
+	before *Cursor, last *int, opts ...WebhookDeliveryPaginateOption,
+) (*WebhookDeliveryConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newUserRolePager(opts, last != nil)
+	pager, err := newWebhookDeliveryPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &UserRoleConnection{Edges: []*UserRoleEdge{}}
+	conn := &WebhookDeliveryConnection{Edges: []*WebhookDeliveryEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -7631,28 +10169,28 @@ func (_m *UserRoleQuery) Paginate(
 }
 
 var (
-	// UserRoleOrderFieldCreatedAt orders UserRole by created_at.
-	UserRoleOrderFieldCreatedAt = &UserRoleOrderField{
-		Value: func(_m *UserRole) (ent.Value, error) {
+	// WebhookDeliveryOrderFieldCreatedAt orders WebhookDelivery by created_at.
+	WebhookDeliveryOrderFieldCreatedAt = &WebhookDeliveryOrderField{
+		Value: func(_m *WebhookDelivery) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: userrole.FieldCreatedAt,
-		toTerm: userrole.ByCreatedAt,
-		toCursor: func(_m *UserRole) Cursor {
+		column: webhookdelivery.FieldCreatedAt,
+		toTerm: webhookdelivery.ByCreatedAt,
+		toCursor: func(_m *WebhookDelivery) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// UserRoleOrderFieldUpdatedAt orders UserRole by updated_at.
-	UserRoleOrderFieldUpdatedAt = &UserRoleOrderField{
-		Value: func(_m *UserRole) (ent.Value, error) {
+	// WebhookDeliveryOrderFieldUpdatedAt orders WebhookDelivery by updated_at.
+	WebhookDeliveryOrderFieldUpdatedAt = &WebhookDeliveryOrderField{
+		Value: func(_m *WebhookDelivery) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: userrole.FieldUpdatedAt,
-		toTerm: userrole.ByUpdatedAt,
-		toCursor: func(_m *UserRole) Cursor {
+		column: webhookdelivery.FieldUpdatedAt,
+		toTerm: webhookdelivery.ByUpdatedAt,
+		toCursor: func(_m *WebhookDelivery) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
@@ -7662,75 +10200,75 @@ var (
 )
 
 // String implement fmt.Stringer interface.
-func (f UserRoleOrderField) String() string {
+func (f WebhookDeliveryOrderField) String() string {
 	var str string
 	switch f.column {
-	case UserRoleOrderFieldCreatedAt.column:
+	case WebhookDeliveryOrderFieldCreatedAt.column:
 		str = "CREATED_AT"
-	case UserRoleOrderFieldUpdatedAt.column:
+	case WebhookDeliveryOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f UserRoleOrderField) MarshalGQL(w io.Writer) {
+func (f WebhookDeliveryOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *UserRoleOrderField) UnmarshalGQL(v interface{}) error {
+func (f *WebhookDeliveryOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("UserRoleOrderField %T must be a string", v)
+		return fmt.Errorf("WebhookDeliveryOrderField %T must be a string", v)
 	}
 	switch str {
 	case "CREATED_AT":
-		*f = *UserRoleOrderFieldCreatedAt
+		*f = *WebhookDeliveryOrderFieldCreatedAt
 	case "UPDATED_AT":
-		*f = *UserRoleOrderFieldUpdatedAt
+		*f = *WebhookDeliveryOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid UserRoleOrderField", str)
+		return fmt.Errorf("%s is not a valid WebhookDeliveryOrderField", str)
 	}
 	return nil
 }
 
-// UserRoleOrderField defines the ordering field of UserRole.
-type UserRoleOrderField struct {
-	// Value extracts the ordering value from the given UserRole.
-	Value    func(*UserRole) (ent.Value, error)
+// WebhookDeliveryOrderField defines the ordering field of WebhookDelivery.
+type WebhookDeliveryOrderField struct {
+	// Value extracts the ordering value from the given WebhookDelivery.
+	Value    func(*WebhookDelivery) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) userrole.OrderOption
-	toCursor func(*UserRole) Cursor
+	toTerm   func(...sql.OrderTermOption) webhookdelivery.OrderOption
+	toCursor func(*WebhookDelivery) Cursor
 }
 
-// UserRoleOrder defines the ordering of UserRole.
-type UserRoleOrder struct {
-	Direction OrderDirection      `json:"direction"`
-	Field     *UserRoleOrderField `json:"field"`
+// WebhookDeliveryOrder defines the ordering of WebhookDelivery.
+type WebhookDeliveryOrder struct {
+	Direction OrderDirection             `json:"direction"`
+	Field     *WebhookDeliveryOrderField `json:"field"`
 }
 
-// DefaultUserRoleOrder is the default ordering of UserRole.
-var DefaultUserRoleOrder = &UserRoleOrder{
+// DefaultWebhookDeliveryOrder is the default ordering of WebhookDelivery.
+var DefaultWebhookDeliveryOrder = &WebhookDeliveryOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &UserRoleOrderField{
-		Value: func(_m *UserRole) (ent.Value, error) {
+	Field: &WebhookDeliveryOrderField{
+		Value: func(_m *WebhookDelivery) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: userrole.FieldID,
-		toTerm: userrole.ByID,
-		toCursor: func(_m *UserRole) Cursor {
+		column: webhookdelivery.FieldID,
+		toTerm: webhookdelivery.ByID,
+		toCursor: func(_m *WebhookDelivery) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts UserRole into UserRoleEdge.
-func (_m *UserRole) ToEdge(order *UserRoleOrder) *UserRoleEdge {
+// ToEdge converts WebhookDelivery into WebhookDeliveryEdge.
+func (_m *WebhookDelivery) ToEdge(order *WebhookDeliveryOrder) *WebhookDeliveryEdge {
 	if order == nil {
-		order = DefaultUserRoleOrder
+		order = DefaultWebhookDeliveryOrder
 	}
-	return &UserRoleEdge{
+	return &WebhookDeliveryEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
@@ -9,12 +9,17 @@ import (
 
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/apikeyprofiletemplate"
+	"github.com/looplj/axonhub/internal/ent/auditlog"
+	"github.com/looplj/axonhub/internal/ent/budget"
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/channelmodelprice"
 	"github.com/looplj/axonhub/internal/ent/channelmodelpriceversion"
 	"github.com/looplj/axonhub/internal/ent/channeloverridetemplate"
 	"github.com/looplj/axonhub/internal/ent/channelprobe"
+	"github.com/looplj/axonhub/internal/ent/dataexport"
 	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/exchangerate"
+	"github.com/looplj/axonhub/internal/ent/invoice"
 	"github.com/looplj/axonhub/internal/ent/model"
 	"github.com/looplj/axonhub/internal/ent/oidcidentity"
 	"github.com/looplj/axonhub/internal/ent/predicate"
@@ -32,6 +37,9 @@ import (
 	"github.com/looplj/axonhub/internal/ent/user"
 	"github.com/looplj/axonhub/internal/ent/userproject"
 	"github.com/looplj/axonhub/internal/ent/userrole"
+	"github.com/looplj/axonhub/internal/ent/wallet"
+	"github.com/looplj/axonhub/internal/ent/wallettransaction"
+	"github.com/looplj/axonhub/internal/ent/webhookdelivery"
 )
 
 // APIKeyWhereInput represents a where input for filtering APIKey queries.
@@ -138,6 +146,10 @@ type APIKeyWhereInput struct {
 	// "requests" edge predicates.
 	HasRequests     *bool                `json:"hasRequests,omitempty"`
 	HasRequestsWith []*RequestWhereInput `json:"hasRequestsWith,omitempty"`
+
+	// "wallet" edge predicates.
+	HasWallet     *bool               `json:"hasWallet,omitempty"`
+	HasWalletWith []*WalletWhereInput `json:"hasWalletWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
@@ -470,6 +482,24 @@ func (i *APIKeyWhereInput) P() (predicate.APIKey, error) {
 		}
 		predicates = append(predicates, apikey.HasRequestsWith(with...))
 	}
+	if i.HasWallet != nil {
+		p := apikey.HasWallet()
+		if !*i.HasWallet {
+			p = apikey.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasWalletWith) > 0 {
+		with := make([]predicate.Wallet, 0, len(i.HasWalletWith))
+		for _, w := range i.HasWalletWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasWalletWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, apikey.HasWalletWith(with...))
+	}
 	switch len(predicates) {
 	case 0:
 		return nil, ErrEmptyAPIKeyWhereInput
@@ -820,12 +850,12 @@ func (i *APIKeyProfileTemplateWhereInput) P() (predicate.APIKeyProfileTemplate,
 	}
 }
 
-// ChannelWhereInput represents a where input for filtering Channel queries.
-type ChannelWhereInput struct {
-	Predicates []predicate.Channel  `json:"-"`
-	Not        *ChannelWhereInput   `json:"not,omitempty"`
-	Or         []*ChannelWhereInput `json:"or,omitempty"`
-	And        []*ChannelWhereInput `json:"and,omitempty"`
+// AuditLogWhereInput represents a where input for filtering AuditLog queries.
+type AuditLogWhereInput struct {
+	Predicates []predicate.AuditLog  `json:"-"`
+	Not        *AuditLogWhereInput   `json:"not,omitempty"`
+	Or         []*AuditLogWhereInput `json:"or,omitempty"`
+	And        []*AuditLogWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -847,178 +877,146 @@ type ChannelWhereInput struct {
 	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
 	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
-	// "updated_at" field predicates.
-	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
-	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
-	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
-	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
-	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
-	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
-	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
-	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
-
-	// "type" field predicates.
-	Type      *channel.Type  `json:"type,omitempty"`
-	TypeNEQ   *channel.Type  `json:"typeNEQ,omitempty"`
-	TypeIn    []channel.Type `json:"typeIn,omitempty"`
-	TypeNotIn []channel.Type `json:"typeNotIn,omitempty"`
-
-	// "base_url" field predicates.
-	BaseURL             *string  `json:"baseURL,omitempty"`
-	BaseURLNEQ          *string  `json:"baseURLNEQ,omitempty"`
-	BaseURLIn           []string `json:"baseURLIn,omitempty"`
-	BaseURLNotIn        []string `json:"baseURLNotIn,omitempty"`
-	BaseURLGT           *string  `json:"baseURLGT,omitempty"`
-	BaseURLGTE          *string  `json:"baseURLGTE,omitempty"`
-	BaseURLLT           *string  `json:"baseURLLT,omitempty"`
-	BaseURLLTE          *string  `json:"baseURLLTE,omitempty"`
-	BaseURLContains     *string  `json:"baseURLContains,omitempty"`
-	BaseURLHasPrefix    *string  `json:"baseURLHasPrefix,omitempty"`
-	BaseURLHasSuffix    *string  `json:"baseURLHasSuffix,omitempty"`
-	BaseURLIsNil        bool     `json:"baseURLIsNil,omitempty"`
-	BaseURLNotNil       bool     `json:"baseURLNotNil,omitempty"`
-	BaseURLEqualFold    *string  `json:"baseURLEqualFold,omitempty"`
-	BaseURLContainsFold *string  `json:"baseURLContainsFold,omitempty"`
-
-	// "name" field predicates.
-	Name             *string  `json:"name,omitempty"`
-	NameNEQ          *string  `json:"nameNEQ,omitempty"`
-	NameIn           []string `json:"nameIn,omitempty"`
-	NameNotIn        []string `json:"nameNotIn,omitempty"`
-	NameGT           *string  `json:"nameGT,omitempty"`
-	NameGTE          *string  `json:"nameGTE,omitempty"`
-	NameLT           *string  `json:"nameLT,omitempty"`
-	NameLTE          *string  `json:"nameLTE,omitempty"`
-	NameContains     *string  `json:"nameContains,omitempty"`
-	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
-	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
-	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
-	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
-
-	// "status" field predicates.
-	Status      *channel.Status  `json:"status,omitempty"`
-	StatusNEQ   *channel.Status  `json:"statusNEQ,omitempty"`
-	StatusIn    []channel.Status `json:"statusIn,omitempty"`
-	StatusNotIn []channel.Status `json:"statusNotIn,omitempty"`
-
-	// "auto_sync_supported_models" field predicates.
-	AutoSyncSupportedModels    *bool `json:"autoSyncSupportedModels,omitempty"`
-	AutoSyncSupportedModelsNEQ *bool `json:"autoSyncSupportedModelsNEQ,omitempty"`
-
-	// "auto_sync_model_pattern" field predicates.
-	AutoSyncModelPattern             *string  `json:"autoSyncModelPattern,omitempty"`
-	AutoSyncModelPatternNEQ          *string  `json:"autoSyncModelPatternNEQ,omitempty"`
-	AutoSyncModelPatternIn           []string `json:"autoSyncModelPatternIn,omitempty"`
-	AutoSyncModelPatternNotIn        []string `json:"autoSyncModelPatternNotIn,omitempty"`
-	AutoSyncModelPatternGT           *string  `json:"autoSyncModelPatternGT,omitempty"`
-	AutoSyncModelPatternGTE          *string  `json:"autoSyncModelPatternGTE,omitempty"`
-	AutoSyncModelPatternLT           *string  `json:"autoSyncModelPatternLT,omitempty"`
-	AutoSyncModelPatternLTE          *string  `json:"autoSyncModelPatternLTE,omitempty"`
-	AutoSyncModelPatternContains     *string  `json:"autoSyncModelPatternContains,omitempty"`
-	AutoSyncModelPatternHasPrefix    *string  `json:"autoSyncModelPatternHasPrefix,omitempty"`
-	AutoSyncModelPatternHasSuffix    *string  `json:"autoSyncModelPatternHasSuffix,omitempty"`
-	AutoSyncModelPatternIsNil        bool     `json:"autoSyncModelPatternIsNil,omitempty"`
-	AutoSyncModelPatternNotNil       bool     `json:"autoSyncModelPatternNotNil,omitempty"`
-	AutoSyncModelPatternEqualFold    *string  `json:"autoSyncModelPatternEqualFold,omitempty"`
-	AutoSyncModelPatternContainsFold *string  `json:"autoSyncModelPatternContainsFold,omitempty"`
-
-	// "default_test_model" field predicates.
-	DefaultTestModel             *string  `json:"defaultTestModel,omitempty"`
-	DefaultTestModelNEQ          *string  `json:"defaultTestModelNEQ,omitempty"`
-	DefaultTestModelIn           []string `json:"defaultTestModelIn,omitempty"`
-	DefaultTestModelNotIn        []string `json:"defaultTestModelNotIn,omitempty"`
-	DefaultTestModelGT           *string  `json:"defaultTestModelGT,omitempty"`
-	DefaultTestModelGTE          *string  `json:"defaultTestModelGTE,omitempty"`
-	DefaultTestModelLT           *string  `json:"defaultTestModelLT,omitempty"`
-	DefaultTestModelLTE          *string  `json:"defaultTestModelLTE,omitempty"`
-	DefaultTestModelContains     *string  `json:"defaultTestModelContains,omitempty"`
-	DefaultTestModelHasPrefix    *string  `json:"defaultTestModelHasPrefix,omitempty"`
-	DefaultTestModelHasSuffix    *string  `json:"defaultTestModelHasSuffix,omitempty"`
-	DefaultTestModelEqualFold    *string  `json:"defaultTestModelEqualFold,omitempty"`
-	DefaultTestModelContainsFold *string  `json:"defaultTestModelContainsFold,omitempty"`
-
-	// "ordering_weight" field predicates.
-	OrderingWeight      *int  `json:"orderingWeight,omitempty"`
-	OrderingWeightNEQ   *int  `json:"orderingWeightNEQ,omitempty"`
-	OrderingWeightIn    []int `json:"orderingWeightIn,omitempty"`
-	OrderingWeightNotIn []int `json:"orderingWeightNotIn,omitempty"`
-	OrderingWeightGT    *int  `json:"orderingWeightGT,omitempty"`
-	OrderingWeightGTE   *int  `json:"orderingWeightGTE,omitempty"`
-	OrderingWeightLT    *int  `json:"orderingWeightLT,omitempty"`
-	OrderingWeightLTE   *int  `json:"orderingWeightLTE,omitempty"`
-
-	// "error_message" field predicates.
-	ErrorMessage             *string  `json:"errorMessage,omitempty"`
-	ErrorMessageNEQ          *string  `json:"errorMessageNEQ,omitempty"`
-	ErrorMessageIn           []string `json:"errorMessageIn,omitempty"`
-	ErrorMessageNotIn        []string `json:"errorMessageNotIn,omitempty"`
-	ErrorMessageGT           *string  `json:"errorMessageGT,omitempty"`
-	ErrorMessageGTE          *string  `json:"errorMessageGTE,omitempty"`
-	ErrorMessageLT           *string  `json:"errorMessageLT,omitempty"`
-	ErrorMessageLTE          *string  `json:"errorMessageLTE,omitempty"`
-	ErrorMessageContains     *string  `json:"errorMessageContains,omitempty"`
-	ErrorMessageHasPrefix    *string  `json:"errorMessageHasPrefix,omitempty"`
-	ErrorMessageHasSuffix    *string  `json:"errorMessageHasSuffix,omitempty"`
-	ErrorMessageIsNil        bool     `json:"errorMessageIsNil,omitempty"`
-	ErrorMessageNotNil       bool     `json:"errorMessageNotNil,omitempty"`
-	ErrorMessageEqualFold    *string  `json:"errorMessageEqualFold,omitempty"`
-	ErrorMessageContainsFold *string  `json:"errorMessageContainsFold,omitempty"`
-
-	// "remark" field predicates.
-	Remark             *string  `json:"remark,omitempty"`
-	RemarkNEQ          *string  `json:"remarkNEQ,omitempty"`
-	RemarkIn           []string `json:"remarkIn,omitempty"`
-	RemarkNotIn        []string `json:"remarkNotIn,omitempty"`
-	RemarkGT           *string  `json:"remarkGT,omitempty"`
-	RemarkGTE          *string  `json:"remarkGTE,omitempty"`
-	RemarkLT           *string  `json:"remarkLT,omitempty"`
-	RemarkLTE          *string  `json:"remarkLTE,omitempty"`
-	RemarkContains     *string  `json:"remarkContains,omitempty"`
-	RemarkHasPrefix    *string  `json:"remarkHasPrefix,omitempty"`
-	RemarkHasSuffix    *string  `json:"remarkHasSuffix,omitempty"`
-	RemarkIsNil        bool     `json:"remarkIsNil,omitempty"`
-	RemarkNotNil       bool     `json:"remarkNotNil,omitempty"`
-	RemarkEqualFold    *string  `json:"remarkEqualFold,omitempty"`
-	RemarkContainsFold *string  `json:"remarkContainsFold,omitempty"`
-
-	// "requests" edge predicates.
-	HasRequests     *bool                `json:"hasRequests,omitempty"`
-	HasRequestsWith []*RequestWhereInput `json:"hasRequestsWith,omitempty"`
+	// "actor_type" field predicates.
+	ActorType      *auditlog.ActorType  `json:"actorType,omitempty"`
+	ActorTypeNEQ   *auditlog.ActorType  `json:"actorTypeNEQ,omitempty"`
+	ActorTypeIn    []auditlog.ActorType `json:"actorTypeIn,omitempty"`
+	ActorTypeNotIn []auditlog.ActorType `json:"actorTypeNotIn,omitempty"`
 
-	// "executions" edge predicates.
-	HasExecutions     *bool                         `json:"hasExecutions,omitempty"`
-	HasExecutionsWith []*RequestExecutionWhereInput `json:"hasExecutionsWith,omitempty"`
+	// "user_id" field predicates.
+	UserID       *int  `json:"userID,omitempty"`
+	UserIDNEQ    *int  `json:"userIDNEQ,omitempty"`
+	UserIDIn     []int `json:"userIDIn,omitempty"`
+	UserIDNotIn  []int `json:"userIDNotIn,omitempty"`
+	UserIDGT     *int  `json:"userIDGT,omitempty"`
+	UserIDGTE    *int  `json:"userIDGTE,omitempty"`
+	UserIDLT     *int  `json:"userIDLT,omitempty"`
+	UserIDLTE    *int  `json:"userIDLTE,omitempty"`
+	UserIDIsNil  bool  `json:"userIDIsNil,omitempty"`
+	UserIDNotNil bool  `json:"userIDNotNil,omitempty"`
 
-	// "usage_logs" edge predicates.
-	HasUsageLogs     *bool                 `json:"hasUsageLogs,omitempty"`
-	HasUsageLogsWith []*UsageLogWhereInput `json:"hasUsageLogsWith,omitempty"`
+	// "api_key_id" field predicates.
+	APIKeyID       *int  `json:"apiKeyID,omitempty"`
+	APIKeyIDNEQ    *int  `json:"apiKeyIDNEQ,omitempty"`
+	APIKeyIDIn     []int `json:"apiKeyIDIn,omitempty"`
+	APIKeyIDNotIn  []int `json:"apiKeyIDNotIn,omitempty"`
+	APIKeyIDGT     *int  `json:"apiKeyIDGT,omitempty"`
+	APIKeyIDGTE    *int  `json:"apiKeyIDGTE,omitempty"`
+	APIKeyIDLT     *int  `json:"apiKeyIDLT,omitempty"`
+	APIKeyIDLTE    *int  `json:"apiKeyIDLTE,omitempty"`
+	APIKeyIDIsNil  bool  `json:"apiKeyIDIsNil,omitempty"`
+	APIKeyIDNotNil bool  `json:"apiKeyIDNotNil,omitempty"`
 
-	// "channel_probes" edge predicates.
-	HasChannelProbes     *bool                     `json:"hasChannelProbes,omitempty"`
-	HasChannelProbesWith []*ChannelProbeWhereInput `json:"hasChannelProbesWith,omitempty"`
+	// "actor" field predicates.
+	Actor             *string  `json:"actor,omitempty"`
+	ActorNEQ          *string  `json:"actorNEQ,omitempty"`
+	ActorIn           []string `json:"actorIn,omitempty"`
+	ActorNotIn        []string `json:"actorNotIn,omitempty"`
+	ActorGT           *string  `json:"actorGT,omitempty"`
+	ActorGTE          *string  `json:"actorGTE,omitempty"`
+	ActorLT           *string  `json:"actorLT,omitempty"`
+	ActorLTE          *string  `json:"actorLTE,omitempty"`
+	ActorContains     *string  `json:"actorContains,omitempty"`
+	ActorHasPrefix    *string  `json:"actorHasPrefix,omitempty"`
+	ActorHasSuffix    *string  `json:"actorHasSuffix,omitempty"`
+	ActorEqualFold    *string  `json:"actorEqualFold,omitempty"`
+	ActorContainsFold *string  `json:"actorContainsFold,omitempty"`
 
-	// "channel_model_prices" edge predicates.
-	HasChannelModelPrices     *bool                          `json:"hasChannelModelPrices,omitempty"`
-	HasChannelModelPricesWith []*ChannelModelPriceWhereInput `json:"hasChannelModelPricesWith,omitempty"`
+	// "project_id" field predicates.
+	ProjectID       *int  `json:"projectID,omitempty"`
+	ProjectIDNEQ    *int  `json:"projectIDNEQ,omitempty"`
+	ProjectIDIn     []int `json:"projectIDIn,omitempty"`
+	ProjectIDNotIn  []int `json:"projectIDNotIn,omitempty"`
+	ProjectIDGT     *int  `json:"projectIDGT,omitempty"`
+	ProjectIDGTE    *int  `json:"projectIDGTE,omitempty"`
+	ProjectIDLT     *int  `json:"projectIDLT,omitempty"`
+	ProjectIDLTE    *int  `json:"projectIDLTE,omitempty"`
+	ProjectIDIsNil  bool  `json:"projectIDIsNil,omitempty"`
+	ProjectIDNotNil bool  `json:"projectIDNotNil,omitempty"`
 
-	// "provider_quota_status" edge predicates.
-	HasProviderQuotaStatus     *bool                            `json:"hasProviderQuotaStatus,omitempty"`
-	HasProviderQuotaStatusWith []*ProviderQuotaStatusWhereInput `json:"hasProviderQuotaStatusWith,omitempty"`
+	// "source" field predicates.
+	Source      *auditlog.Source  `json:"source,omitempty"`
+	SourceNEQ   *auditlog.Source  `json:"sourceNEQ,omitempty"`
+	SourceIn    []auditlog.Source `json:"sourceIn,omitempty"`
+	SourceNotIn []auditlog.Source `json:"sourceNotIn,omitempty"`
+
+	// "operation" field predicates.
+	Operation             *string  `json:"operation,omitempty"`
+	OperationNEQ          *string  `json:"operationNEQ,omitempty"`
+	OperationIn           []string `json:"operationIn,omitempty"`
+	OperationNotIn        []string `json:"operationNotIn,omitempty"`
+	OperationGT           *string  `json:"operationGT,omitempty"`
+	OperationGTE          *string  `json:"operationGTE,omitempty"`
+	OperationLT           *string  `json:"operationLT,omitempty"`
+	OperationLTE          *string  `json:"operationLTE,omitempty"`
+	OperationContains     *string  `json:"operationContains,omitempty"`
+	OperationHasPrefix    *string  `json:"operationHasPrefix,omitempty"`
+	OperationHasSuffix    *string  `json:"operationHasSuffix,omitempty"`
+	OperationEqualFold    *string  `json:"operationEqualFold,omitempty"`
+	OperationContainsFold *string  `json:"operationContainsFold,omitempty"`
+
+	// "action" field predicates.
+	Action      *auditlog.Action  `json:"action,omitempty"`
+	ActionNEQ   *auditlog.Action  `json:"actionNEQ,omitempty"`
+	ActionIn    []auditlog.Action `json:"actionIn,omitempty"`
+	ActionNotIn []auditlog.Action `json:"actionNotIn,omitempty"`
+
+	// "entity_type" field predicates.
+	EntityType             *string  `json:"entityType,omitempty"`
+	EntityTypeNEQ          *string  `json:"entityTypeNEQ,omitempty"`
+	EntityTypeIn           []string `json:"entityTypeIn,omitempty"`
+	EntityTypeNotIn        []string `json:"entityTypeNotIn,omitempty"`
+	EntityTypeGT           *string  `json:"entityTypeGT,omitempty"`
+	EntityTypeGTE          *string  `json:"entityTypeGTE,omitempty"`
+	EntityTypeLT           *string  `json:"entityTypeLT,omitempty"`
+	EntityTypeLTE          *string  `json:"entityTypeLTE,omitempty"`
+	EntityTypeContains     *string  `json:"entityTypeContains,omitempty"`
+	EntityTypeHasPrefix    *string  `json:"entityTypeHasPrefix,omitempty"`
+	EntityTypeHasSuffix    *string  `json:"entityTypeHasSuffix,omitempty"`
+	EntityTypeEqualFold    *string  `json:"entityTypeEqualFold,omitempty"`
+	EntityTypeContainsFold *string  `json:"entityTypeContainsFold,omitempty"`
+
+	// "entity_id" field predicates.
+	EntityID       *int  `json:"entityID,omitempty"`
+	EntityIDNEQ    *int  `json:"entityIDNEQ,omitempty"`
+	EntityIDIn     []int `json:"entityIDIn,omitempty"`
+	EntityIDNotIn  []int `json:"entityIDNotIn,omitempty"`
+	EntityIDGT     *int  `json:"entityIDGT,omitempty"`
+	EntityIDGTE    *int  `json:"entityIDGTE,omitempty"`
+	EntityIDLT     *int  `json:"entityIDLT,omitempty"`
+	EntityIDLTE    *int  `json:"entityIDLTE,omitempty"`
+	EntityIDIsNil  bool  `json:"entityIDIsNil,omitempty"`
+	EntityIDNotNil bool  `json:"entityIDNotNil,omitempty"`
+
+	// "source_ip" field predicates.
+	SourceIP             *string  `json:"sourceIP,omitempty"`
+	SourceIPNEQ          *string  `json:"sourceIPNEQ,omitempty"`
+	SourceIPIn           []string `json:"sourceIPIn,omitempty"`
+	SourceIPNotIn        []string `json:"sourceIPNotIn,omitempty"`
+	SourceIPGT           *string  `json:"sourceIPGT,omitempty"`
+	SourceIPGTE          *string  `json:"sourceIPGTE,omitempty"`
+	SourceIPLT           *string  `json:"sourceIPLT,omitempty"`
+	SourceIPLTE          *string  `json:"sourceIPLTE,omitempty"`
+	SourceIPContains     *string  `json:"sourceIPContains,omitempty"`
+	SourceIPHasPrefix    *string  `json:"sourceIPHasPrefix,omitempty"`
+	SourceIPHasSuffix    *string  `json:"sourceIPHasSuffix,omitempty"`
+	SourceIPEqualFold    *string  `json:"sourceIPEqualFold,omitempty"`
+	SourceIPContainsFold *string  `json:"sourceIPContainsFold,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *ChannelWhereInput) AddPredicates(predicates ...predicate.Channel) {
+func (i *AuditLogWhereInput) AddPredicates(predicates ...predicate.AuditLog) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the ChannelWhereInput filter on the ChannelQuery builder.
-func (i *ChannelWhereInput) Filter(q *ChannelQuery) (*ChannelQuery, error) {
+// Filter applies the AuditLogWhereInput filter on the AuditLogQuery builder.
+func (i *AuditLogWhereInput) Filter(q *AuditLogQuery) (*AuditLogQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyChannelWhereInput {
+		if err == ErrEmptyAuditLogWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -1026,19 +1024,19 @@ func (i *ChannelWhereInput) Filter(q *ChannelQuery) (*ChannelQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyChannelWhereInput is returned in case the ChannelWhereInput is empty.
-var ErrEmptyChannelWhereInput = errors.New("ent: empty predicate ChannelWhereInput")
+// ErrEmptyAuditLogWhereInput is returned in case the AuditLogWhereInput is empty.
+var ErrEmptyAuditLogWhereInput = errors.New("ent: empty predicate AuditLogWhereInput")
 
-// P returns a predicate for filtering channels.
+// P returns a predicate for filtering auditlogs.
 // An error is returned if the input is empty or invalid.
-func (i *ChannelWhereInput) P() (predicate.Channel, error) {
-	var predicates []predicate.Channel
+func (i *AuditLogWhereInput) P() (predicate.AuditLog, error) {
+	var predicates []predicate.AuditLog
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, channel.Not(p))
+		predicates = append(predicates, auditlog.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -1048,7 +1046,7 @@ func (i *ChannelWhereInput) P() (predicate.Channel, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Channel, 0, n)
+		or := make([]predicate.AuditLog, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -1056,7 +1054,7 @@ func (i *ChannelWhereInput) P() (predicate.Channel, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, channel.Or(or...))
+		predicates = append(predicates, auditlog.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -1066,7 +1064,7 @@ func (i *ChannelWhereInput) P() (predicate.Channel, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Channel, 0, n)
+		and := make([]predicate.AuditLog, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -1074,518 +1072,386 @@ func (i *ChannelWhereInput) P() (predicate.Channel, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, channel.And(and...))
+		predicates = append(predicates, auditlog.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, channel.IDEQ(*i.ID))
+		predicates = append(predicates, auditlog.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, channel.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, auditlog.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, channel.IDIn(i.IDIn...))
+		predicates = append(predicates, auditlog.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, channel.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, auditlog.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, channel.IDGT(*i.IDGT))
+		predicates = append(predicates, auditlog.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, channel.IDGTE(*i.IDGTE))
+		predicates = append(predicates, auditlog.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, channel.IDLT(*i.IDLT))
+		predicates = append(predicates, auditlog.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, channel.IDLTE(*i.IDLTE))
+		predicates = append(predicates, auditlog.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, channel.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, auditlog.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, channel.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, auditlog.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, channel.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, auditlog.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, channel.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, auditlog.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, channel.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, auditlog.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, channel.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, auditlog.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, channel.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, auditlog.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, channel.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, auditlog.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.UpdatedAt != nil {
-		predicates = append(predicates, channel.UpdatedAtEQ(*i.UpdatedAt))
+	if i.ActorType != nil {
+		predicates = append(predicates, auditlog.ActorTypeEQ(*i.ActorType))
 	}
-	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, channel.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	if i.ActorTypeNEQ != nil {
+		predicates = append(predicates, auditlog.ActorTypeNEQ(*i.ActorTypeNEQ))
 	}
-	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, channel.UpdatedAtIn(i.UpdatedAtIn...))
+	if len(i.ActorTypeIn) > 0 {
+		predicates = append(predicates, auditlog.ActorTypeIn(i.ActorTypeIn...))
 	}
-	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, channel.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	if len(i.ActorTypeNotIn) > 0 {
+		predicates = append(predicates, auditlog.ActorTypeNotIn(i.ActorTypeNotIn...))
 	}
-	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, channel.UpdatedAtGT(*i.UpdatedAtGT))
+	if i.UserID != nil {
+		predicates = append(predicates, auditlog.UserIDEQ(*i.UserID))
 	}
-	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, channel.UpdatedAtGTE(*i.UpdatedAtGTE))
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, auditlog.UserIDNEQ(*i.UserIDNEQ))
 	}
-	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, channel.UpdatedAtLT(*i.UpdatedAtLT))
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, auditlog.UserIDIn(i.UserIDIn...))
 	}
-	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, channel.UpdatedAtLTE(*i.UpdatedAtLTE))
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, auditlog.UserIDNotIn(i.UserIDNotIn...))
 	}
-	if i.Type != nil {
-		predicates = append(predicates, channel.TypeEQ(*i.Type))
+	if i.UserIDGT != nil {
+		predicates = append(predicates, auditlog.UserIDGT(*i.UserIDGT))
 	}
-	if i.TypeNEQ != nil {
-		predicates = append(predicates, channel.TypeNEQ(*i.TypeNEQ))
+	if i.UserIDGTE != nil {
+		predicates = append(predicates, auditlog.UserIDGTE(*i.UserIDGTE))
 	}
-	if len(i.TypeIn) > 0 {
-		predicates = append(predicates, channel.TypeIn(i.TypeIn...))
+	if i.UserIDLT != nil {
+		predicates = append(predicates, auditlog.UserIDLT(*i.UserIDLT))
 	}
-	if len(i.TypeNotIn) > 0 {
-		predicates = append(predicates, channel.TypeNotIn(i.TypeNotIn...))
+	if i.UserIDLTE != nil {
+		predicates = append(predicates, auditlog.UserIDLTE(*i.UserIDLTE))
 	}
-	if i.BaseURL != nil {
-		predicates = append(predicates, channel.BaseURLEQ(*i.BaseURL))
+	if i.UserIDIsNil {
+		predicates = append(predicates, auditlog.UserIDIsNil())
 	}
-	if i.BaseURLNEQ != nil {
-		predicates = append(predicates, channel.BaseURLNEQ(*i.BaseURLNEQ))
-	}
-	if len(i.BaseURLIn) > 0 {
-		predicates = append(predicates, channel.BaseURLIn(i.BaseURLIn...))
-	}
-	if len(i.BaseURLNotIn) > 0 {
-		predicates = append(predicates, channel.BaseURLNotIn(i.BaseURLNotIn...))
-	}
-	if i.BaseURLGT != nil {
-		predicates = append(predicates, channel.BaseURLGT(*i.BaseURLGT))
-	}
-	if i.BaseURLGTE != nil {
-		predicates = append(predicates, channel.BaseURLGTE(*i.BaseURLGTE))
-	}
-	if i.BaseURLLT != nil {
-		predicates = append(predicates, channel.BaseURLLT(*i.BaseURLLT))
-	}
-	if i.BaseURLLTE != nil {
-		predicates = append(predicates, channel.BaseURLLTE(*i.BaseURLLTE))
-	}
-	if i.BaseURLContains != nil {
-		predicates = append(predicates, channel.BaseURLContains(*i.BaseURLContains))
-	}
-	if i.BaseURLHasPrefix != nil {
-		predicates = append(predicates, channel.BaseURLHasPrefix(*i.BaseURLHasPrefix))
+	if i.UserIDNotNil {
+		predicates = append(predicates, auditlog.UserIDNotNil())
 	}
-	if i.BaseURLHasSuffix != nil {
-		predicates = append(predicates, channel.BaseURLHasSuffix(*i.BaseURLHasSuffix))
+	if i.APIKeyID != nil {
+		predicates = append(predicates, auditlog.APIKeyIDEQ(*i.APIKeyID))
 	}
-	if i.BaseURLIsNil {
-		predicates = append(predicates, channel.BaseURLIsNil())
+	if i.APIKeyIDNEQ != nil {
+		predicates = append(predicates, auditlog.APIKeyIDNEQ(*i.APIKeyIDNEQ))
 	}
-	if i.BaseURLNotNil {
-		predicates = append(predicates, channel.BaseURLNotNil())
+	if len(i.APIKeyIDIn) > 0 {
+		predicates = append(predicates, auditlog.APIKeyIDIn(i.APIKeyIDIn...))
 	}
-	if i.BaseURLEqualFold != nil {
-		predicates = append(predicates, channel.BaseURLEqualFold(*i.BaseURLEqualFold))
+	if len(i.APIKeyIDNotIn) > 0 {
+		predicates = append(predicates, auditlog.APIKeyIDNotIn(i.APIKeyIDNotIn...))
 	}
-	if i.BaseURLContainsFold != nil {
-		predicates = append(predicates, channel.BaseURLContainsFold(*i.BaseURLContainsFold))
+	if i.APIKeyIDGT != nil {
+		predicates = append(predicates, auditlog.APIKeyIDGT(*i.APIKeyIDGT))
 	}
-	if i.Name != nil {
-		predicates = append(predicates, channel.NameEQ(*i.Name))
+	if i.APIKeyIDGTE != nil {
+		predicates = append(predicates, auditlog.APIKeyIDGTE(*i.APIKeyIDGTE))
 	}
-	if i.NameNEQ != nil {
-		predicates = append(predicates, channel.NameNEQ(*i.NameNEQ))
+	if i.APIKeyIDLT != nil {
+		predicates = append(predicates, auditlog.APIKeyIDLT(*i.APIKeyIDLT))
 	}
-	if len(i.NameIn) > 0 {
-		predicates = append(predicates, channel.NameIn(i.NameIn...))
+	if i.APIKeyIDLTE != nil {
+		predicates = append(predicates, auditlog.APIKeyIDLTE(*i.APIKeyIDLTE))
 	}
-	if len(i.NameNotIn) > 0 {
-		predicates = append(predicates, channel.NameNotIn(i.NameNotIn...))
+	if i.APIKeyIDIsNil {
+		predicates = append(predicates, auditlog.APIKeyIDIsNil())
 	}
-	if i.NameGT != nil {
-		predicates = append(predicates, channel.NameGT(*i.NameGT))
+	if i.APIKeyIDNotNil {
+		predicates = append(predicates, auditlog.APIKeyIDNotNil())
 	}
-	if i.NameGTE != nil {
-		predicates = append(predicates, channel.NameGTE(*i.NameGTE))
+	if i.Actor != nil {
+		predicates = append(predicates, auditlog.ActorEQ(*i.Actor))
 	}
-	if i.NameLT != nil {
-		predicates = append(predicates, channel.NameLT(*i.NameLT))
+	if i.ActorNEQ != nil {
+		predicates = append(predicates, auditlog.ActorNEQ(*i.ActorNEQ))
 	}
-	if i.NameLTE != nil {
-		predicates = append(predicates, channel.NameLTE(*i.NameLTE))
+	if len(i.ActorIn) > 0 {
+		predicates = append(predicates, auditlog.ActorIn(i.ActorIn...))
 	}
-	if i.NameContains != nil {
-		predicates = append(predicates, channel.NameContains(*i.NameContains))
+	if len(i.ActorNotIn) > 0 {
+		predicates = append(predicates, auditlog.ActorNotIn(i.ActorNotIn...))
 	}
-	if i.NameHasPrefix != nil {
-		predicates = append(predicates, channel.NameHasPrefix(*i.NameHasPrefix))
+	if i.ActorGT != nil {
+		predicates = append(predicates, auditlog.ActorGT(*i.ActorGT))
 	}
-	if i.NameHasSuffix != nil {
-		predicates = append(predicates, channel.NameHasSuffix(*i.NameHasSuffix))
+	if i.ActorGTE != nil {
+		predicates = append(predicates, auditlog.ActorGTE(*i.ActorGTE))
 	}
-	if i.NameEqualFold != nil {
-		predicates = append(predicates, channel.NameEqualFold(*i.NameEqualFold))
+	if i.ActorLT != nil {
+		predicates = append(predicates, auditlog.ActorLT(*i.ActorLT))
 	}
-	if i.NameContainsFold != nil {
-		predicates = append(predicates, channel.NameContainsFold(*i.NameContainsFold))
+	if i.ActorLTE != nil {
+		predicates = append(predicates, auditlog.ActorLTE(*i.ActorLTE))
 	}
-	if i.Status != nil {
-		predicates = append(predicates, channel.StatusEQ(*i.Status))
+	if i.ActorContains != nil {
+		predicates = append(predicates, auditlog.ActorContains(*i.ActorContains))
 	}
-	if i.StatusNEQ != nil {
-		predicates = append(predicates, channel.StatusNEQ(*i.StatusNEQ))
+	if i.ActorHasPrefix != nil {
+		predicates = append(predicates, auditlog.ActorHasPrefix(*i.ActorHasPrefix))
 	}
-	if len(i.StatusIn) > 0 {
-		predicates = append(predicates, channel.StatusIn(i.StatusIn...))
+	if i.ActorHasSuffix != nil {
+		predicates = append(predicates, auditlog.ActorHasSuffix(*i.ActorHasSuffix))
 	}
-	if len(i.StatusNotIn) > 0 {
-		predicates = append(predicates, channel.StatusNotIn(i.StatusNotIn...))
+	if i.ActorEqualFold != nil {
+		predicates = append(predicates, auditlog.ActorEqualFold(*i.ActorEqualFold))
 	}
-	if i.AutoSyncSupportedModels != nil {
-		predicates = append(predicates, channel.AutoSyncSupportedModelsEQ(*i.AutoSyncSupportedModels))
+	if i.ActorContainsFold != nil {
+		predicates = append(predicates, auditlog.ActorContainsFold(*i.ActorContainsFold))
 	}
-	if i.AutoSyncSupportedModelsNEQ != nil {
-		predicates = append(predicates, channel.AutoSyncSupportedModelsNEQ(*i.AutoSyncSupportedModelsNEQ))
+	if i.ProjectID != nil {
+		predicates = append(predicates, auditlog.ProjectIDEQ(*i.ProjectID))
 	}
-	if i.AutoSyncModelPattern != nil {
-		predicates = append(predicates, channel.AutoSyncModelPatternEQ(*i.AutoSyncModelPattern))
+	if i.ProjectIDNEQ != nil {
+		predicates = append(predicates, auditlog.ProjectIDNEQ(*i.ProjectIDNEQ))
 	}
-	if i.AutoSyncModelPatternNEQ != nil {
-		predicates = append(predicates, channel.AutoSyncModelPatternNEQ(*i.AutoSyncModelPatternNEQ))
+	if len(i.ProjectIDIn) > 0 {
+		predicates = append(predicates, auditlog.ProjectIDIn(i.ProjectIDIn...))
 	}
-	if len(i.AutoSyncModelPatternIn) > 0 {
-		predicates = append(predicates, channel.AutoSyncModelPatternIn(i.AutoSyncModelPatternIn...))
+	if len(i.ProjectIDNotIn) > 0 {
+		predicates = append(predicates, auditlog.ProjectIDNotIn(i.ProjectIDNotIn...))
 	}
-	if len(i.AutoSyncModelPatternNotIn) > 0 {
-		predicates = append(predicates, channel.AutoSyncModelPatternNotIn(i.AutoSyncModelPatternNotIn...))
+	if i.ProjectIDGT != nil {
+		predicates = append(predicates, auditlog.ProjectIDGT(*i.ProjectIDGT))
 	}
-	if i.AutoSyncModelPatternGT != nil {
-		predicates = append(predicates, channel.AutoSyncModelPatternGT(*i.AutoSyncModelPatternGT))
+	if i.ProjectIDGTE != nil {
+		predicates = append(predicates, auditlog.ProjectIDGTE(*i.ProjectIDGTE))
 	}
-	if i.AutoSyncModelPatternGTE != nil {
-		predicates = append(predicates, channel.AutoSyncModelPatternGTE(*i.AutoSyncModelPatternGTE))
+	if i.ProjectIDLT != nil {
+		predicates = append(predicates, auditlog.ProjectIDLT(*i.ProjectIDLT))
 	}
-	if i.AutoSyncModelPatternLT != nil {
-		predicates = append(predicates, channel.AutoSyncModelPatternLT(*i.AutoSyncModelPatternLT))
+	if i.ProjectIDLTE != nil {
+		predicates = append(predicates, auditlog.ProjectIDLTE(*i.ProjectIDLTE))
 	}
-	if i.AutoSyncModelPatternLTE != nil {
-		predicates = append(predicates, channel.AutoSyncModelPatternLTE(*i.AutoSyncModelPatternLTE))
+	if i.ProjectIDIsNil {
+		predicates = append(predicates, auditlog.ProjectIDIsNil())
 	}
-	if i.AutoSyncModelPatternContains != nil {
-		predicates = append(predicates, channel.AutoSyncModelPatternContains(*i.AutoSyncModelPatternContains))
+	if i.ProjectIDNotNil {
+		predicates = append(predicates, auditlog.ProjectIDNotNil())
 	}
-	if i.AutoSyncModelPatternHasPrefix != nil {
-		predicates = append(predicates, channel.AutoSyncModelPatternHasPrefix(*i.AutoSyncModelPatternHasPrefix))
+	if i.Source != nil {
+		predicates = append(predicates, auditlog.SourceEQ(*i.Source))
 	}
-	if i.AutoSyncModelPatternHasSuffix != nil {
-		predicates = append(predicates, channel.AutoSyncModelPatternHasSuffix(*i.AutoSyncModelPatternHasSuffix))
+	if i.SourceNEQ != nil {
+		predicates = append(predicates, auditlog.SourceNEQ(*i.SourceNEQ))
 	}
-	if i.AutoSyncModelPatternIsNil {
-		predicates = append(predicates, channel.AutoSyncModelPatternIsNil())
+	if len(i.SourceIn) > 0 {
+		predicates = append(predicates, auditlog.SourceIn(i.SourceIn...))
 	}
-	if i.AutoSyncModelPatternNotNil {
-		predicates = append(predicates, channel.AutoSyncModelPatternNotNil())
+	if len(i.SourceNotIn) > 0 {
+		predicates = append(predicates, auditlog.SourceNotIn(i.SourceNotIn...))
 	}
-	if i.AutoSyncModelPatternEqualFold != nil {
-		predicates = append(predicates, channel.AutoSyncModelPatternEqualFold(*i.AutoSyncModelPatternEqualFold))
+	if i.Operation != nil {
+		predicates = append(predicates, auditlog.OperationEQ(*i.Operation))
 	}
-	if i.AutoSyncModelPatternContainsFold != nil {
-		predicates = append(predicates, channel.AutoSyncModelPatternContainsFold(*i.AutoSyncModelPatternContainsFold))
+	if i.OperationNEQ != nil {
+		predicates = append(predicates, auditlog.OperationNEQ(*i.OperationNEQ))
 	}
-	if i.DefaultTestModel != nil {
-		predicates = append(predicates, channel.DefaultTestModelEQ(*i.DefaultTestModel))
+	if len(i.OperationIn) > 0 {
+		predicates = append(predicates, auditlog.OperationIn(i.OperationIn...))
 	}
-	if i.DefaultTestModelNEQ != nil {
-		predicates = append(predicates, channel.DefaultTestModelNEQ(*i.DefaultTestModelNEQ))
+	if len(i.OperationNotIn) > 0 {
+		predicates = append(predicates, auditlog.OperationNotIn(i.OperationNotIn...))
 	}
-	if len(i.DefaultTestModelIn) > 0 {
-		predicates = append(predicates, channel.DefaultTestModelIn(i.DefaultTestModelIn...))
+	if i.OperationGT != nil {
+		predicates = append(predicates, auditlog.OperationGT(*i.OperationGT))
 	}
-	if len(i.DefaultTestModelNotIn) > 0 {
-		predicates = append(predicates, channel.DefaultTestModelNotIn(i.DefaultTestModelNotIn...))
+	if i.OperationGTE != nil {
+		predicates = append(predicates, auditlog.OperationGTE(*i.OperationGTE))
 	}
-	if i.DefaultTestModelGT != nil {
-		predicates = append(predicates, channel.DefaultTestModelGT(*i.DefaultTestModelGT))
+	if i.OperationLT != nil {
+		predicates = append(predicates, auditlog.OperationLT(*i.OperationLT))
 	}
-	if i.DefaultTestModelGTE != nil {
-		predicates = append(predicates, channel.DefaultTestModelGTE(*i.DefaultTestModelGTE))
+	if i.OperationLTE != nil {
+		predicates = append(predicates, auditlog.OperationLTE(*i.OperationLTE))
 	}
-	if i.DefaultTestModelLT != nil {
-		predicates = append(predicates, channel.DefaultTestModelLT(*i.DefaultTestModelLT))
+	if i.OperationContains != nil {
+		predicates = append(predicates, auditlog.OperationContains(*i.OperationContains))
 	}
-	if i.DefaultTestModelLTE != nil {
-		predicates = append(predicates, channel.DefaultTestModelLTE(*i.DefaultTestModelLTE))
+	if i.OperationHasPrefix != nil {
+		predicates = append(predicates, auditlog.OperationHasPrefix(*i.OperationHasPrefix))
 	}
-	if i.DefaultTestModelContains != nil {
-		predicates = append(predicates, channel.DefaultTestModelContains(*i.DefaultTestModelContains))
+	if i.OperationHasSuffix != nil {
+		predicates = append(predicates, auditlog.OperationHasSuffix(*i.OperationHasSuffix))
 	}
-	if i.DefaultTestModelHasPrefix != nil {
-		predicates = append(predicates, channel.DefaultTestModelHasPrefix(*i.DefaultTestModelHasPrefix))
+	if i.OperationEqualFold != nil {
+		predicates = append(predicates, auditlog.OperationEqualFold(*i.OperationEqualFold))
 	}
-	if i.DefaultTestModelHasSuffix != nil {
-		predicates = append(predicates, channel.DefaultTestModelHasSuffix(*i.DefaultTestModelHasSuffix))
+	if i.OperationContainsFold != nil {
+		predicates = append(predicates, auditlog.OperationContainsFold(*i.OperationContainsFold))
 	}
-	if i.DefaultTestModelEqualFold != nil {
-		predicates = append(predicates, channel.DefaultTestModelEqualFold(*i.DefaultTestModelEqualFold))
+	if i.Action != nil {
+		predicates = append(predicates, auditlog.ActionEQ(*i.Action))
 	}
-	if i.DefaultTestModelContainsFold != nil {
-		predicates = append(predicates, channel.DefaultTestModelContainsFold(*i.DefaultTestModelContainsFold))
+	if i.ActionNEQ != nil {
+		predicates = append(predicates, auditlog.ActionNEQ(*i.ActionNEQ))
 	}
-	if i.OrderingWeight != nil {
-		predicates = append(predicates, channel.OrderingWeightEQ(*i.OrderingWeight))
+	if len(i.ActionIn) > 0 {
+		predicates = append(predicates, auditlog.ActionIn(i.ActionIn...))
 	}
-	if i.OrderingWeightNEQ != nil {
-		predicates = append(predicates, channel.OrderingWeightNEQ(*i.OrderingWeightNEQ))
+	if len(i.ActionNotIn) > 0 {
+		predicates = append(predicates, auditlog.ActionNotIn(i.ActionNotIn...))
 	}
-	if len(i.OrderingWeightIn) > 0 {
-		predicates = append(predicates, channel.OrderingWeightIn(i.OrderingWeightIn...))
+	if i.EntityType != nil {
+		predicates = append(predicates, auditlog.EntityTypeEQ(*i.EntityType))
 	}
-	if len(i.OrderingWeightNotIn) > 0 {
-		predicates = append(predicates, channel.OrderingWeightNotIn(i.OrderingWeightNotIn...))
+	if i.EntityTypeNEQ != nil {
+		predicates = append(predicates, auditlog.EntityTypeNEQ(*i.EntityTypeNEQ))
 	}
-	if i.OrderingWeightGT != nil {
-		predicates = append(predicates, channel.OrderingWeightGT(*i.OrderingWeightGT))
+	if len(i.EntityTypeIn) > 0 {
+		predicates = append(predicates, auditlog.EntityTypeIn(i.EntityTypeIn...))
 	}
-	if i.OrderingWeightGTE != nil {
-		predicates = append(predicates, channel.OrderingWeightGTE(*i.OrderingWeightGTE))
+	if len(i.EntityTypeNotIn) > 0 {
+		predicates = append(predicates, auditlog.EntityTypeNotIn(i.EntityTypeNotIn...))
 	}
-	if i.OrderingWeightLT != nil {
-		predicates = append(predicates, channel.OrderingWeightLT(*i.OrderingWeightLT))
+	if i.EntityTypeGT != nil {
+		predicates = append(predicates, auditlog.EntityTypeGT(*i.EntityTypeGT))
 	}
-	if i.OrderingWeightLTE != nil {
-		predicates = append(predicates, channel.OrderingWeightLTE(*i.OrderingWeightLTE))
+	if i.EntityTypeGTE != nil {
+		predicates = append(predicates, auditlog.EntityTypeGTE(*i.EntityTypeGTE))
 	}
-	if i.ErrorMessage != nil {
-		predicates = append(predicates, channel.ErrorMessageEQ(*i.ErrorMessage))
+	if i.EntityTypeLT != nil {
+		predicates = append(predicates, auditlog.EntityTypeLT(*i.EntityTypeLT))
 	}
-	if i.ErrorMessageNEQ != nil {
-		predicates = append(predicates, channel.ErrorMessageNEQ(*i.ErrorMessageNEQ))
+	if i.EntityTypeLTE != nil {
+		predicates = append(predicates, auditlog.EntityTypeLTE(*i.EntityTypeLTE))
 	}
-	if len(i.ErrorMessageIn) > 0 {
-		predicates = append(predicates, channel.ErrorMessageIn(i.ErrorMessageIn...))
+	if i.EntityTypeContains != nil {
+		predicates = append(predicates, auditlog.EntityTypeContains(*i.EntityTypeContains))
 	}
-	if len(i.ErrorMessageNotIn) > 0 {
-		predicates = append(predicates, channel.ErrorMessageNotIn(i.ErrorMessageNotIn...))
+	if i.EntityTypeHasPrefix != nil {
+		predicates = append(predicates, auditlog.EntityTypeHasPrefix(*i.EntityTypeHasPrefix))
 	}
-	if i.ErrorMessageGT != nil {
-		predicates = append(predicates, channel.ErrorMessageGT(*i.ErrorMessageGT))
+	if i.EntityTypeHasSuffix != nil {
+		predicates = append(predicates, auditlog.EntityTypeHasSuffix(*i.EntityTypeHasSuffix))
 	}
-	if i.ErrorMessageGTE != nil {
-		predicates = append(predicates, channel.ErrorMessageGTE(*i.ErrorMessageGTE))
+	if i.EntityTypeEqualFold != nil {
+		predicates = append(predicates, auditlog.EntityTypeEqualFold(*i.EntityTypeEqualFold))
 	}
-	if i.ErrorMessageLT != nil {
-		predicates = append(predicates, channel.ErrorMessageLT(*i.ErrorMessageLT))
+	if i.EntityTypeContainsFold != nil {
+		predicates = append(predicates, auditlog.EntityTypeContainsFold(*i.EntityTypeContainsFold))
 	}
-	if i.ErrorMessageLTE != nil {
-		predicates = append(predicates, channel.ErrorMessageLTE(*i.ErrorMessageLTE))
+	if i.EntityID != nil {
+		predicates = append(predicates, auditlog.EntityIDEQ(*i.EntityID))
 	}
-	if i.ErrorMessageContains != nil {
-		predicates = append(predicates, channel.ErrorMessageContains(*i.ErrorMessageContains))
+	if i.EntityIDNEQ != nil {
+		predicates = append(predicates, auditlog.EntityIDNEQ(*i.EntityIDNEQ))
 	}
-	if i.ErrorMessageHasPrefix != nil {
-		predicates = append(predicates, channel.ErrorMessageHasPrefix(*i.ErrorMessageHasPrefix))
+	if len(i.EntityIDIn) > 0 {
+		predicates = append(predicates, auditlog.EntityIDIn(i.EntityIDIn...))
 	}
-	if i.ErrorMessageHasSuffix != nil {
-		predicates = append(predicates, channel.ErrorMessageHasSuffix(*i.ErrorMessageHasSuffix))
+	if len(i.EntityIDNotIn) > 0 {
+		predicates = append(predicates, auditlog.EntityIDNotIn(i.EntityIDNotIn...))
 	}
-	if i.ErrorMessageIsNil {
-		predicates = append(predicates, channel.ErrorMessageIsNil())
+	if i.EntityIDGT != nil {
+		predicates = append(predicates, auditlog.EntityIDGT(*i.EntityIDGT))
 	}
-	if i.ErrorMessageNotNil {
-		predicates = append(predicates, channel.ErrorMessageNotNil())
+	if i.EntityIDGTE != nil {
+		predicates = append(predicates, auditlog.EntityIDGTE(*i.EntityIDGTE))
 	}
-	if i.ErrorMessageEqualFold != nil {
-		predicates = append(predicates, channel.ErrorMessageEqualFold(*i.ErrorMessageEqualFold))
+	if i.EntityIDLT != nil {
+		predicates = append(predicates, auditlog.EntityIDLT(*i.EntityIDLT))
 	}
-	if i.ErrorMessageContainsFold != nil {
-		predicates = append(predicates, channel.ErrorMessageContainsFold(*i.ErrorMessageContainsFold))
+	if i.EntityIDLTE != nil {
+		predicates = append(predicates, auditlog.EntityIDLTE(*i.EntityIDLTE))
 	}
-	if i.Remark != nil {
-		predicates = append(predicates, channel.RemarkEQ(*i.Remark))
+	if i.EntityIDIsNil {
+		predicates = append(predicates, auditlog.EntityIDIsNil())
 	}
-	if i.RemarkNEQ != nil {
-		predicates = append(predicates, channel.RemarkNEQ(*i.RemarkNEQ))
+	if i.EntityIDNotNil {
+		predicates = append(predicates, auditlog.EntityIDNotNil())
 	}
-	if len(i.RemarkIn) > 0 {
-		predicates = append(predicates, channel.RemarkIn(i.RemarkIn...))
+	if i.SourceIP != nil {
+		predicates = append(predicates, auditlog.SourceIPEQ(*i.SourceIP))
 	}
-	if len(i.RemarkNotIn) > 0 {
-		predicates = append(predicates, channel.RemarkNotIn(i.RemarkNotIn...))
+	if i.SourceIPNEQ != nil {
+		predicates = append(predicates, auditlog.SourceIPNEQ(*i.SourceIPNEQ))
 	}
-	if i.RemarkGT != nil {
-		predicates = append(predicates, channel.RemarkGT(*i.RemarkGT))
+	if len(i.SourceIPIn) > 0 {
+		predicates = append(predicates, auditlog.SourceIPIn(i.SourceIPIn...))
 	}
-	if i.RemarkGTE != nil {
-		predicates = append(predicates, channel.RemarkGTE(*i.RemarkGTE))
+	if len(i.SourceIPNotIn) > 0 {
+		predicates = append(predicates, auditlog.SourceIPNotIn(i.SourceIPNotIn...))
 	}
-	if i.RemarkLT != nil {
-		predicates = append(predicates, channel.RemarkLT(*i.RemarkLT))
+	if i.SourceIPGT != nil {
+		predicates = append(predicates, auditlog.SourceIPGT(*i.SourceIPGT))
 	}
-	if i.RemarkLTE != nil {
-		predicates = append(predicates, channel.RemarkLTE(*i.RemarkLTE))
+	if i.SourceIPGTE != nil {
+		predicates = append(predicates, auditlog.SourceIPGTE(*i.SourceIPGTE))
 	}
-	if i.RemarkContains != nil {
-		predicates = append(predicates, channel.RemarkContains(*i.RemarkContains))
+	if i.SourceIPLT != nil {
+		predicates = append(predicates, auditlog.SourceIPLT(*i.SourceIPLT))
 	}
-	if i.RemarkHasPrefix != nil {
-		predicates = append(predicates, channel.RemarkHasPrefix(*i.RemarkHasPrefix))
+	if i.SourceIPLTE != nil {
+		predicates = append(predicates, auditlog.SourceIPLTE(*i.SourceIPLTE))
 	}
-	if i.RemarkHasSuffix != nil {
-		predicates = append(predicates, channel.RemarkHasSuffix(*i.RemarkHasSuffix))
+	if i.SourceIPContains != nil {
+		predicates = append(predicates, auditlog.SourceIPContains(*i.SourceIPContains))
 	}
-	if i.RemarkIsNil {
-		predicates = append(predicates, channel.RemarkIsNil())
+	if i.SourceIPHasPrefix != nil {
+		predicates = append(predicates, auditlog.SourceIPHasPrefix(*i.SourceIPHasPrefix))
 	}
-	if i.RemarkNotNil {
-		predicates = append(predicates, channel.RemarkNotNil())
+	if i.SourceIPHasSuffix != nil {
+		predicates = append(predicates, auditlog.SourceIPHasSuffix(*i.SourceIPHasSuffix))
 	}
-	if i.RemarkEqualFold != nil {
-		predicates = append(predicates, channel.RemarkEqualFold(*i.RemarkEqualFold))
+	if i.SourceIPEqualFold != nil {
+		predicates = append(predicates, auditlog.SourceIPEqualFold(*i.SourceIPEqualFold))
 	}
-	if i.RemarkContainsFold != nil {
-		predicates = append(predicates, channel.RemarkContainsFold(*i.RemarkContainsFold))
+	if i.SourceIPContainsFold != nil {
+		predicates = append(predicates, auditlog.SourceIPContainsFold(*i.SourceIPContainsFold))
 	}
 
-	if i.HasRequests != nil {
-		p := channel.HasRequests()
-		if !*i.HasRequests {
-			p = channel.Not(p)
-		}
-		predicates = append(predicates, p)
-	}
-	if len(i.HasRequestsWith) > 0 {
-		with := make([]predicate.Request, 0, len(i.HasRequestsWith))
-		for _, w := range i.HasRequestsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasRequestsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, channel.HasRequestsWith(with...))
-	}
-	if i.HasExecutions != nil {
-		p := channel.HasExecutions()
-		if !*i.HasExecutions {
-			p = channel.Not(p)
-		}
-		predicates = append(predicates, p)
-	}
-	if len(i.HasExecutionsWith) > 0 {
-		with := make([]predicate.RequestExecution, 0, len(i.HasExecutionsWith))
-		for _, w := range i.HasExecutionsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasExecutionsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, channel.HasExecutionsWith(with...))
-	}
-	if i.HasUsageLogs != nil {
-		p := channel.HasUsageLogs()
-		if !*i.HasUsageLogs {
-			p = channel.Not(p)
-		}
-		predicates = append(predicates, p)
-	}
-	if len(i.HasUsageLogsWith) > 0 {
-		with := make([]predicate.UsageLog, 0, len(i.HasUsageLogsWith))
-		for _, w := range i.HasUsageLogsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUsageLogsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, channel.HasUsageLogsWith(with...))
-	}
-	if i.HasChannelProbes != nil {
-		p := channel.HasChannelProbes()
-		if !*i.HasChannelProbes {
-			p = channel.Not(p)
-		}
-		predicates = append(predicates, p)
-	}
-	if len(i.HasChannelProbesWith) > 0 {
-		with := make([]predicate.ChannelProbe, 0, len(i.HasChannelProbesWith))
-		for _, w := range i.HasChannelProbesWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasChannelProbesWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, channel.HasChannelProbesWith(with...))
-	}
-	if i.HasChannelModelPrices != nil {
-		p := channel.HasChannelModelPrices()
-		if !*i.HasChannelModelPrices {
-			p = channel.Not(p)
-		}
-		predicates = append(predicates, p)
-	}
-	if len(i.HasChannelModelPricesWith) > 0 {
-		with := make([]predicate.ChannelModelPrice, 0, len(i.HasChannelModelPricesWith))
-		for _, w := range i.HasChannelModelPricesWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasChannelModelPricesWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, channel.HasChannelModelPricesWith(with...))
-	}
-	if i.HasProviderQuotaStatus != nil {
-		p := channel.HasProviderQuotaStatus()
-		if !*i.HasProviderQuotaStatus {
-			p = channel.Not(p)
-		}
-		predicates = append(predicates, p)
-	}
-	if len(i.HasProviderQuotaStatusWith) > 0 {
-		with := make([]predicate.ProviderQuotaStatus, 0, len(i.HasProviderQuotaStatusWith))
-		for _, w := range i.HasProviderQuotaStatusWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasProviderQuotaStatusWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, channel.HasProviderQuotaStatusWith(with...))
-	}
-	switch len(predicates) {
-	case 0:
-		return nil, ErrEmptyChannelWhereInput
-	case 1:
-		return predicates[0], nil
-	default:
-		return channel.And(predicates...), nil
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyAuditLogWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return auditlog.And(predicates...), nil
 	}
 }
 
-// ChannelModelPriceWhereInput represents a where input for filtering ChannelModelPrice queries.
-type ChannelModelPriceWhereInput struct {
-	Predicates []predicate.ChannelModelPrice  `json:"-"`
-	Not        *ChannelModelPriceWhereInput   `json:"not,omitempty"`
-	Or         []*ChannelModelPriceWhereInput `json:"or,omitempty"`
-	And        []*ChannelModelPriceWhereInput `json:"and,omitempty"`
+// BudgetWhereInput represents a where input for filtering Budget queries.
+type BudgetWhereInput struct {
+	Predicates []predicate.Budget  `json:"-"`
+	Not        *BudgetWhereInput   `json:"not,omitempty"`
+	Or         []*BudgetWhereInput `json:"or,omitempty"`
+	And        []*BudgetWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -1617,64 +1483,134 @@ type ChannelModelPriceWhereInput struct {
 	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
 	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
-	// "channel_id" field predicates.
-	ChannelID      *int  `json:"channelID,omitempty"`
-	ChannelIDNEQ   *int  `json:"channelIDNEQ,omitempty"`
-	ChannelIDIn    []int `json:"channelIDIn,omitempty"`
-	ChannelIDNotIn []int `json:"channelIDNotIn,omitempty"`
+	// "project_id" field predicates.
+	ProjectID      *int  `json:"projectID,omitempty"`
+	ProjectIDNEQ   *int  `json:"projectIDNEQ,omitempty"`
+	ProjectIDIn    []int `json:"projectIDIn,omitempty"`
+	ProjectIDNotIn []int `json:"projectIDNotIn,omitempty"`
 
-	// "model_id" field predicates.
-	ModelID             *string  `json:"modelID,omitempty"`
-	ModelIDNEQ          *string  `json:"modelIDNEQ,omitempty"`
-	ModelIDIn           []string `json:"modelIDIn,omitempty"`
-	ModelIDNotIn        []string `json:"modelIDNotIn,omitempty"`
-	ModelIDGT           *string  `json:"modelIDGT,omitempty"`
-	ModelIDGTE          *string  `json:"modelIDGTE,omitempty"`
-	ModelIDLT           *string  `json:"modelIDLT,omitempty"`
-	ModelIDLTE          *string  `json:"modelIDLTE,omitempty"`
-	ModelIDContains     *string  `json:"modelIDContains,omitempty"`
-	ModelIDHasPrefix    *string  `json:"modelIDHasPrefix,omitempty"`
-	ModelIDHasSuffix    *string  `json:"modelIDHasSuffix,omitempty"`
-	ModelIDEqualFold    *string  `json:"modelIDEqualFold,omitempty"`
-	ModelIDContainsFold *string  `json:"modelIDContainsFold,omitempty"`
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "reference_id" field predicates.
-	ReferenceID             *string  `json:"referenceID,omitempty"`
-	ReferenceIDNEQ          *string  `json:"referenceIDNEQ,omitempty"`
-	ReferenceIDIn           []string `json:"referenceIDIn,omitempty"`
-	ReferenceIDNotIn        []string `json:"referenceIDNotIn,omitempty"`
-	ReferenceIDGT           *string  `json:"referenceIDGT,omitempty"`
-	ReferenceIDGTE          *string  `json:"referenceIDGTE,omitempty"`
-	ReferenceIDLT           *string  `json:"referenceIDLT,omitempty"`
-	ReferenceIDLTE          *string  `json:"referenceIDLTE,omitempty"`
-	ReferenceIDContains     *string  `json:"referenceIDContains,omitempty"`
-	ReferenceIDHasPrefix    *string  `json:"referenceIDHasPrefix,omitempty"`
-	ReferenceIDHasSuffix    *string  `json:"referenceIDHasSuffix,omitempty"`
-	ReferenceIDEqualFold    *string  `json:"referenceIDEqualFold,omitempty"`
-	ReferenceIDContainsFold *string  `json:"referenceIDContainsFold,omitempty"`
+	// "description" field predicates.
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
 
-	// "channel" edge predicates.
-	HasChannel     *bool                `json:"hasChannel,omitempty"`
-	HasChannelWith []*ChannelWhereInput `json:"hasChannelWith,omitempty"`
+	// "scope" field predicates.
+	Scope      *budget.Scope  `json:"scope,omitempty"`
+	ScopeNEQ   *budget.Scope  `json:"scopeNEQ,omitempty"`
+	ScopeIn    []budget.Scope `json:"scopeIn,omitempty"`
+	ScopeNotIn []budget.Scope `json:"scopeNotIn,omitempty"`
 
-	// "versions" edge predicates.
-	HasVersions     *bool                                 `json:"hasVersions,omitempty"`
-	HasVersionsWith []*ChannelModelPriceVersionWhereInput `json:"hasVersionsWith,omitempty"`
+	// "api_key_id" field predicates.
+	APIKeyID       *int  `json:"apiKeyID,omitempty"`
+	APIKeyIDNEQ    *int  `json:"apiKeyIDNEQ,omitempty"`
+	APIKeyIDIn     []int `json:"apiKeyIDIn,omitempty"`
+	APIKeyIDNotIn  []int `json:"apiKeyIDNotIn,omitempty"`
+	APIKeyIDGT     *int  `json:"apiKeyIDGT,omitempty"`
+	APIKeyIDGTE    *int  `json:"apiKeyIDGTE,omitempty"`
+	APIKeyIDLT     *int  `json:"apiKeyIDLT,omitempty"`
+	APIKeyIDLTE    *int  `json:"apiKeyIDLTE,omitempty"`
+	APIKeyIDIsNil  bool  `json:"apiKeyIDIsNil,omitempty"`
+	APIKeyIDNotNil bool  `json:"apiKeyIDNotNil,omitempty"`
+
+	// "user_id" field predicates.
+	UserID       *int  `json:"userID,omitempty"`
+	UserIDNEQ    *int  `json:"userIDNEQ,omitempty"`
+	UserIDIn     []int `json:"userIDIn,omitempty"`
+	UserIDNotIn  []int `json:"userIDNotIn,omitempty"`
+	UserIDGT     *int  `json:"userIDGT,omitempty"`
+	UserIDGTE    *int  `json:"userIDGTE,omitempty"`
+	UserIDLT     *int  `json:"userIDLT,omitempty"`
+	UserIDLTE    *int  `json:"userIDLTE,omitempty"`
+	UserIDIsNil  bool  `json:"userIDIsNil,omitempty"`
+	UserIDNotNil bool  `json:"userIDNotNil,omitempty"`
+
+	// "amount" field predicates.
+	Amount      *float64  `json:"amount,omitempty"`
+	AmountNEQ   *float64  `json:"amountNEQ,omitempty"`
+	AmountIn    []float64 `json:"amountIn,omitempty"`
+	AmountNotIn []float64 `json:"amountNotIn,omitempty"`
+	AmountGT    *float64  `json:"amountGT,omitempty"`
+	AmountGTE   *float64  `json:"amountGTE,omitempty"`
+	AmountLT    *float64  `json:"amountLT,omitempty"`
+	AmountLTE   *float64  `json:"amountLTE,omitempty"`
+
+	// "period" field predicates.
+	Period      *budget.Period  `json:"period,omitempty"`
+	PeriodNEQ   *budget.Period  `json:"periodNEQ,omitempty"`
+	PeriodIn    []budget.Period `json:"periodIn,omitempty"`
+	PeriodNotIn []budget.Period `json:"periodNotIn,omitempty"`
+
+	// "status" field predicates.
+	Status      *budget.Status  `json:"status,omitempty"`
+	StatusNEQ   *budget.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []budget.Status `json:"statusIn,omitempty"`
+	StatusNotIn []budget.Status `json:"statusNotIn,omitempty"`
+
+	// "alerted_threshold" field predicates.
+	AlertedThreshold      *int  `json:"alertedThreshold,omitempty"`
+	AlertedThresholdNEQ   *int  `json:"alertedThresholdNEQ,omitempty"`
+	AlertedThresholdIn    []int `json:"alertedThresholdIn,omitempty"`
+	AlertedThresholdNotIn []int `json:"alertedThresholdNotIn,omitempty"`
+	AlertedThresholdGT    *int  `json:"alertedThresholdGT,omitempty"`
+	AlertedThresholdGTE   *int  `json:"alertedThresholdGTE,omitempty"`
+	AlertedThresholdLT    *int  `json:"alertedThresholdLT,omitempty"`
+	AlertedThresholdLTE   *int  `json:"alertedThresholdLTE,omitempty"`
+
+	// "alerted_period_start" field predicates.
+	AlertedPeriodStart       *time.Time  `json:"alertedPeriodStart,omitempty"`
+	AlertedPeriodStartNEQ    *time.Time  `json:"alertedPeriodStartNEQ,omitempty"`
+	AlertedPeriodStartIn     []time.Time `json:"alertedPeriodStartIn,omitempty"`
+	AlertedPeriodStartNotIn  []time.Time `json:"alertedPeriodStartNotIn,omitempty"`
+	AlertedPeriodStartGT     *time.Time  `json:"alertedPeriodStartGT,omitempty"`
+	AlertedPeriodStartGTE    *time.Time  `json:"alertedPeriodStartGTE,omitempty"`
+	AlertedPeriodStartLT     *time.Time  `json:"alertedPeriodStartLT,omitempty"`
+	AlertedPeriodStartLTE    *time.Time  `json:"alertedPeriodStartLTE,omitempty"`
+	AlertedPeriodStartIsNil  bool        `json:"alertedPeriodStartIsNil,omitempty"`
+	AlertedPeriodStartNotNil bool        `json:"alertedPeriodStartNotNil,omitempty"`
+
+	// "project" edge predicates.
+	HasProject     *bool                `json:"hasProject,omitempty"`
+	HasProjectWith []*ProjectWhereInput `json:"hasProjectWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *ChannelModelPriceWhereInput) AddPredicates(predicates ...predicate.ChannelModelPrice) {
+func (i *BudgetWhereInput) AddPredicates(predicates ...predicate.Budget) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the ChannelModelPriceWhereInput filter on the ChannelModelPriceQuery builder.
-func (i *ChannelModelPriceWhereInput) Filter(q *ChannelModelPriceQuery) (*ChannelModelPriceQuery, error) {
+// Filter applies the BudgetWhereInput filter on the BudgetQuery builder.
+func (i *BudgetWhereInput) Filter(q *BudgetQuery) (*BudgetQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyChannelModelPriceWhereInput {
+		if err == ErrEmptyBudgetWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -1682,19 +1618,19 @@ func (i *ChannelModelPriceWhereInput) Filter(q *ChannelModelPriceQuery) (*Channe
 	return q.Where(p), nil
 }
 
-// ErrEmptyChannelModelPriceWhereInput is returned in case the ChannelModelPriceWhereInput is empty.
-var ErrEmptyChannelModelPriceWhereInput = errors.New("ent: empty predicate ChannelModelPriceWhereInput")
+// ErrEmptyBudgetWhereInput is returned in case the BudgetWhereInput is empty.
+var ErrEmptyBudgetWhereInput = errors.New("ent: empty predicate BudgetWhereInput")
 
-// P returns a predicate for filtering channelmodelprices.
+// P returns a predicate for filtering budgets.
 // An error is returned if the input is empty or invalid.
-func (i *ChannelModelPriceWhereInput) P() (predicate.ChannelModelPrice, error) {
-	var predicates []predicate.ChannelModelPrice
+func (i *BudgetWhereInput) P() (predicate.Budget, error) {
+	var predicates []predicate.Budget
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, channelmodelprice.Not(p))
+		predicates = append(predicates, budget.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -1704,7 +1640,7 @@ func (i *ChannelModelPriceWhereInput) P() (predicate.ChannelModelPrice, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.ChannelModelPrice, 0, n)
+		or := make([]predicate.Budget, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -1712,7 +1648,7 @@ func (i *ChannelModelPriceWhereInput) P() (predicate.ChannelModelPrice, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, channelmodelprice.Or(or...))
+		predicates = append(predicates, budget.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -1722,7 +1658,7 @@ func (i *ChannelModelPriceWhereInput) P() (predicate.ChannelModelPrice, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.ChannelModelPrice, 0, n)
+		and := make([]predicate.Budget, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -1730,224 +1666,380 @@ func (i *ChannelModelPriceWhereInput) P() (predicate.ChannelModelPrice, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, channelmodelprice.And(and...))
+		predicates = append(predicates, budget.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, channelmodelprice.IDEQ(*i.ID))
+		predicates = append(predicates, budget.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, channelmodelprice.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, budget.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, channelmodelprice.IDIn(i.IDIn...))
+		predicates = append(predicates, budget.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, channelmodelprice.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, budget.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, channelmodelprice.IDGT(*i.IDGT))
+		predicates = append(predicates, budget.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, channelmodelprice.IDGTE(*i.IDGTE))
+		predicates = append(predicates, budget.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, channelmodelprice.IDLT(*i.IDLT))
+		predicates = append(predicates, budget.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, channelmodelprice.IDLTE(*i.IDLTE))
+		predicates = append(predicates, budget.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, channelmodelprice.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, budget.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, channelmodelprice.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, budget.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, channelmodelprice.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, budget.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, channelmodelprice.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, budget.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, channelmodelprice.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, budget.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, channelmodelprice.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, budget.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, channelmodelprice.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, budget.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, channelmodelprice.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, budget.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, channelmodelprice.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, budget.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, channelmodelprice.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, budget.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, channelmodelprice.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, budget.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, channelmodelprice.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, budget.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, channelmodelprice.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, budget.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, channelmodelprice.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, budget.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, channelmodelprice.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, budget.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, channelmodelprice.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, budget.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.ChannelID != nil {
-		predicates = append(predicates, channelmodelprice.ChannelIDEQ(*i.ChannelID))
+	if i.ProjectID != nil {
+		predicates = append(predicates, budget.ProjectIDEQ(*i.ProjectID))
 	}
-	if i.ChannelIDNEQ != nil {
-		predicates = append(predicates, channelmodelprice.ChannelIDNEQ(*i.ChannelIDNEQ))
+	if i.ProjectIDNEQ != nil {
+		predicates = append(predicates, budget.ProjectIDNEQ(*i.ProjectIDNEQ))
 	}
-	if len(i.ChannelIDIn) > 0 {
-		predicates = append(predicates, channelmodelprice.ChannelIDIn(i.ChannelIDIn...))
+	if len(i.ProjectIDIn) > 0 {
+		predicates = append(predicates, budget.ProjectIDIn(i.ProjectIDIn...))
 	}
-	if len(i.ChannelIDNotIn) > 0 {
-		predicates = append(predicates, channelmodelprice.ChannelIDNotIn(i.ChannelIDNotIn...))
+	if len(i.ProjectIDNotIn) > 0 {
+		predicates = append(predicates, budget.ProjectIDNotIn(i.ProjectIDNotIn...))
 	}
-	if i.ModelID != nil {
-		predicates = append(predicates, channelmodelprice.ModelIDEQ(*i.ModelID))
+	if i.Name != nil {
+		predicates = append(predicates, budget.NameEQ(*i.Name))
 	}
-	if i.ModelIDNEQ != nil {
-		predicates = append(predicates, channelmodelprice.ModelIDNEQ(*i.ModelIDNEQ))
+	if i.NameNEQ != nil {
+		predicates = append(predicates, budget.NameNEQ(*i.NameNEQ))
 	}
-	if len(i.ModelIDIn) > 0 {
-		predicates = append(predicates, channelmodelprice.ModelIDIn(i.ModelIDIn...))
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, budget.NameIn(i.NameIn...))
 	}
-	if len(i.ModelIDNotIn) > 0 {
-		predicates = append(predicates, channelmodelprice.ModelIDNotIn(i.ModelIDNotIn...))
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, budget.NameNotIn(i.NameNotIn...))
 	}
-	if i.ModelIDGT != nil {
-		predicates = append(predicates, channelmodelprice.ModelIDGT(*i.ModelIDGT))
+	if i.NameGT != nil {
+		predicates = append(predicates, budget.NameGT(*i.NameGT))
 	}
-	if i.ModelIDGTE != nil {
-		predicates = append(predicates, channelmodelprice.ModelIDGTE(*i.ModelIDGTE))
+	if i.NameGTE != nil {
+		predicates = append(predicates, budget.NameGTE(*i.NameGTE))
 	}
-	if i.ModelIDLT != nil {
-		predicates = append(predicates, channelmodelprice.ModelIDLT(*i.ModelIDLT))
+	if i.NameLT != nil {
+		predicates = append(predicates, budget.NameLT(*i.NameLT))
 	}
-	if i.ModelIDLTE != nil {
-		predicates = append(predicates, channelmodelprice.ModelIDLTE(*i.ModelIDLTE))
+	if i.NameLTE != nil {
+		predicates = append(predicates, budget.NameLTE(*i.NameLTE))
 	}
-	if i.ModelIDContains != nil {
-		predicates = append(predicates, channelmodelprice.ModelIDContains(*i.ModelIDContains))
+	if i.NameContains != nil {
+		predicates = append(predicates, budget.NameContains(*i.NameContains))
 	}
-	if i.ModelIDHasPrefix != nil {
-		predicates = append(predicates, channelmodelprice.ModelIDHasPrefix(*i.ModelIDHasPrefix))
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, budget.NameHasPrefix(*i.NameHasPrefix))
 	}
-	if i.ModelIDHasSuffix != nil {
-		predicates = append(predicates, channelmodelprice.ModelIDHasSuffix(*i.ModelIDHasSuffix))
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, budget.NameHasSuffix(*i.NameHasSuffix))
 	}
-	if i.ModelIDEqualFold != nil {
-		predicates = append(predicates, channelmodelprice.ModelIDEqualFold(*i.ModelIDEqualFold))
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, budget.NameEqualFold(*i.NameEqualFold))
 	}
-	if i.ModelIDContainsFold != nil {
-		predicates = append(predicates, channelmodelprice.ModelIDContainsFold(*i.ModelIDContainsFold))
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, budget.NameContainsFold(*i.NameContainsFold))
 	}
-	if i.ReferenceID != nil {
-		predicates = append(predicates, channelmodelprice.ReferenceIDEQ(*i.ReferenceID))
+	if i.Description != nil {
+		predicates = append(predicates, budget.DescriptionEQ(*i.Description))
 	}
-	if i.ReferenceIDNEQ != nil {
-		predicates = append(predicates, channelmodelprice.ReferenceIDNEQ(*i.ReferenceIDNEQ))
+	if i.DescriptionNEQ != nil {
+		predicates = append(predicates, budget.DescriptionNEQ(*i.DescriptionNEQ))
 	}
-	if len(i.ReferenceIDIn) > 0 {
-		predicates = append(predicates, channelmodelprice.ReferenceIDIn(i.ReferenceIDIn...))
+	if len(i.DescriptionIn) > 0 {
+		predicates = append(predicates, budget.DescriptionIn(i.DescriptionIn...))
 	}
-	if len(i.ReferenceIDNotIn) > 0 {
-		predicates = append(predicates, channelmodelprice.ReferenceIDNotIn(i.ReferenceIDNotIn...))
+	if len(i.DescriptionNotIn) > 0 {
+		predicates = append(predicates, budget.DescriptionNotIn(i.DescriptionNotIn...))
 	}
-	if i.ReferenceIDGT != nil {
-		predicates = append(predicates, channelmodelprice.ReferenceIDGT(*i.ReferenceIDGT))
+	if i.DescriptionGT != nil {
+		predicates = append(predicates, budget.DescriptionGT(*i.DescriptionGT))
 	}
-	if i.ReferenceIDGTE != nil {
-		predicates = append(predicates, channelmodelprice.ReferenceIDGTE(*i.ReferenceIDGTE))
+	if i.DescriptionGTE != nil {
+		predicates = append(predicates, budget.DescriptionGTE(*i.DescriptionGTE))
 	}
-	if i.ReferenceIDLT != nil {
-		predicates = append(predicates, channelmodelprice.ReferenceIDLT(*i.ReferenceIDLT))
+	if i.DescriptionLT != nil {
+		predicates = append(predicates, budget.DescriptionLT(*i.DescriptionLT))
 	}
-	if i.ReferenceIDLTE != nil {
-		predicates = append(predicates, channelmodelprice.ReferenceIDLTE(*i.ReferenceIDLTE))
+	if i.DescriptionLTE != nil {
+		predicates = append(predicates, budget.DescriptionLTE(*i.DescriptionLTE))
 	}
-	if i.ReferenceIDContains != nil {
-		predicates = append(predicates, channelmodelprice.ReferenceIDContains(*i.ReferenceIDContains))
+	if i.DescriptionContains != nil {
+		predicates = append(predicates, budget.DescriptionContains(*i.DescriptionContains))
 	}
-	if i.ReferenceIDHasPrefix != nil {
-		predicates = append(predicates, channelmodelprice.ReferenceIDHasPrefix(*i.ReferenceIDHasPrefix))
+	if i.DescriptionHasPrefix != nil {
+		predicates = append(predicates, budget.DescriptionHasPrefix(*i.DescriptionHasPrefix))
 	}
-	if i.ReferenceIDHasSuffix != nil {
-		predicates = append(predicates, channelmodelprice.ReferenceIDHasSuffix(*i.ReferenceIDHasSuffix))
+	if i.DescriptionHasSuffix != nil {
+		predicates = append(predicates, budget.DescriptionHasSuffix(*i.DescriptionHasSuffix))
 	}
-	if i.ReferenceIDEqualFold != nil {
-		predicates = append(predicates, channelmodelprice.ReferenceIDEqualFold(*i.ReferenceIDEqualFold))
+	if i.DescriptionEqualFold != nil {
+		predicates = append(predicates, budget.DescriptionEqualFold(*i.DescriptionEqualFold))
 	}
-	if i.ReferenceIDContainsFold != nil {
-		predicates = append(predicates, channelmodelprice.ReferenceIDContainsFold(*i.ReferenceIDContainsFold))
+	if i.DescriptionContainsFold != nil {
+		predicates = append(predicates, budget.DescriptionContainsFold(*i.DescriptionContainsFold))
 	}
-
-	if i.HasChannel != nil {
-		p := channelmodelprice.HasChannel()
-		if !*i.HasChannel {
-			p = channelmodelprice.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.Scope != nil {
+		predicates = append(predicates, budget.ScopeEQ(*i.Scope))
 	}
-	if len(i.HasChannelWith) > 0 {
-		with := make([]predicate.Channel, 0, len(i.HasChannelWith))
-		for _, w := range i.HasChannelWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasChannelWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, channelmodelprice.HasChannelWith(with...))
+	if i.ScopeNEQ != nil {
+		predicates = append(predicates, budget.ScopeNEQ(*i.ScopeNEQ))
 	}
-	if i.HasVersions != nil {
-		p := channelmodelprice.HasVersions()
-		if !*i.HasVersions {
-			p = channelmodelprice.Not(p)
+	if len(i.ScopeIn) > 0 {
+		predicates = append(predicates, budget.ScopeIn(i.ScopeIn...))
+	}
+	if len(i.ScopeNotIn) > 0 {
+		predicates = append(predicates, budget.ScopeNotIn(i.ScopeNotIn...))
+	}
+	if i.APIKeyID != nil {
+		predicates = append(predicates, budget.APIKeyIDEQ(*i.APIKeyID))
+	}
+	if i.APIKeyIDNEQ != nil {
+		predicates = append(predicates, budget.APIKeyIDNEQ(*i.APIKeyIDNEQ))
+	}
+	if len(i.APIKeyIDIn) > 0 {
+		predicates = append(predicates, budget.APIKeyIDIn(i.APIKeyIDIn...))
+	}
+	if len(i.APIKeyIDNotIn) > 0 {
+		predicates = append(predicates, budget.APIKeyIDNotIn(i.APIKeyIDNotIn...))
+	}
+	if i.APIKeyIDGT != nil {
+		predicates = append(predicates, budget.APIKeyIDGT(*i.APIKeyIDGT))
+	}
+	if i.APIKeyIDGTE != nil {
+		predicates = append(predicates, budget.APIKeyIDGTE(*i.APIKeyIDGTE))
+	}
+	if i.APIKeyIDLT != nil {
+		predicates = append(predicates, budget.APIKeyIDLT(*i.APIKeyIDLT))
+	}
+	if i.APIKeyIDLTE != nil {
+		predicates = append(predicates, budget.APIKeyIDLTE(*i.APIKeyIDLTE))
+	}
+	if i.APIKeyIDIsNil {
+		predicates = append(predicates, budget.APIKeyIDIsNil())
+	}
+	if i.APIKeyIDNotNil {
+		predicates = append(predicates, budget.APIKeyIDNotNil())
+	}
+	if i.UserID != nil {
+		predicates = append(predicates, budget.UserIDEQ(*i.UserID))
+	}
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, budget.UserIDNEQ(*i.UserIDNEQ))
+	}
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, budget.UserIDIn(i.UserIDIn...))
+	}
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, budget.UserIDNotIn(i.UserIDNotIn...))
+	}
+	if i.UserIDGT != nil {
+		predicates = append(predicates, budget.UserIDGT(*i.UserIDGT))
+	}
+	if i.UserIDGTE != nil {
+		predicates = append(predicates, budget.UserIDGTE(*i.UserIDGTE))
+	}
+	if i.UserIDLT != nil {
+		predicates = append(predicates, budget.UserIDLT(*i.UserIDLT))
+	}
+	if i.UserIDLTE != nil {
+		predicates = append(predicates, budget.UserIDLTE(*i.UserIDLTE))
+	}
+	if i.UserIDIsNil {
+		predicates = append(predicates, budget.UserIDIsNil())
+	}
+	if i.UserIDNotNil {
+		predicates = append(predicates, budget.UserIDNotNil())
+	}
+	if i.Amount != nil {
+		predicates = append(predicates, budget.AmountEQ(*i.Amount))
+	}
+	if i.AmountNEQ != nil {
+		predicates = append(predicates, budget.AmountNEQ(*i.AmountNEQ))
+	}
+	if len(i.AmountIn) > 0 {
+		predicates = append(predicates, budget.AmountIn(i.AmountIn...))
+	}
+	if len(i.AmountNotIn) > 0 {
+		predicates = append(predicates, budget.AmountNotIn(i.AmountNotIn...))
+	}
+	if i.AmountGT != nil {
+		predicates = append(predicates, budget.AmountGT(*i.AmountGT))
+	}
+	if i.AmountGTE != nil {
+		predicates = append(predicates, budget.AmountGTE(*i.AmountGTE))
+	}
+	if i.AmountLT != nil {
+		predicates = append(predicates, budget.AmountLT(*i.AmountLT))
+	}
+	if i.AmountLTE != nil {
+		predicates = append(predicates, budget.AmountLTE(*i.AmountLTE))
+	}
+	if i.Period != nil {
+		predicates = append(predicates, budget.PeriodEQ(*i.Period))
+	}
+	if i.PeriodNEQ != nil {
+		predicates = append(predicates, budget.PeriodNEQ(*i.PeriodNEQ))
+	}
+	if len(i.PeriodIn) > 0 {
+		predicates = append(predicates, budget.PeriodIn(i.PeriodIn...))
+	}
+	if len(i.PeriodNotIn) > 0 {
+		predicates = append(predicates, budget.PeriodNotIn(i.PeriodNotIn...))
+	}
+	if i.Status != nil {
+		predicates = append(predicates, budget.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, budget.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, budget.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, budget.StatusNotIn(i.StatusNotIn...))
+	}
+	if i.AlertedThreshold != nil {
+		predicates = append(predicates, budget.AlertedThresholdEQ(*i.AlertedThreshold))
+	}
+	if i.AlertedThresholdNEQ != nil {
+		predicates = append(predicates, budget.AlertedThresholdNEQ(*i.AlertedThresholdNEQ))
+	}
+	if len(i.AlertedThresholdIn) > 0 {
+		predicates = append(predicates, budget.AlertedThresholdIn(i.AlertedThresholdIn...))
+	}
+	if len(i.AlertedThresholdNotIn) > 0 {
+		predicates = append(predicates, budget.AlertedThresholdNotIn(i.AlertedThresholdNotIn...))
+	}
+	if i.AlertedThresholdGT != nil {
+		predicates = append(predicates, budget.AlertedThresholdGT(*i.AlertedThresholdGT))
+	}
+	if i.AlertedThresholdGTE != nil {
+		predicates = append(predicates, budget.AlertedThresholdGTE(*i.AlertedThresholdGTE))
+	}
+	if i.AlertedThresholdLT != nil {
+		predicates = append(predicates, budget.AlertedThresholdLT(*i.AlertedThresholdLT))
+	}
+	if i.AlertedThresholdLTE != nil {
+		predicates = append(predicates, budget.AlertedThresholdLTE(*i.AlertedThresholdLTE))
+	}
+	if i.AlertedPeriodStart != nil {
+		predicates = append(predicates, budget.AlertedPeriodStartEQ(*i.AlertedPeriodStart))
+	}
+	if i.AlertedPeriodStartNEQ != nil {
+		predicates = append(predicates, budget.AlertedPeriodStartNEQ(*i.AlertedPeriodStartNEQ))
+	}
+	if len(i.AlertedPeriodStartIn) > 0 {
+		predicates = append(predicates, budget.AlertedPeriodStartIn(i.AlertedPeriodStartIn...))
+	}
+	if len(i.AlertedPeriodStartNotIn) > 0 {
+		predicates = append(predicates, budget.AlertedPeriodStartNotIn(i.AlertedPeriodStartNotIn...))
+	}
+	if i.AlertedPeriodStartGT != nil {
+		predicates = append(predicates, budget.AlertedPeriodStartGT(*i.AlertedPeriodStartGT))
+	}
+	if i.AlertedPeriodStartGTE != nil {
+		predicates = append(predicates, budget.AlertedPeriodStartGTE(*i.AlertedPeriodStartGTE))
+	}
+	if i.AlertedPeriodStartLT != nil {
+		predicates = append(predicates, budget.AlertedPeriodStartLT(*i.AlertedPeriodStartLT))
+	}
+	if i.AlertedPeriodStartLTE != nil {
+		predicates = append(predicates, budget.AlertedPeriodStartLTE(*i.AlertedPeriodStartLTE))
+	}
+	if i.AlertedPeriodStartIsNil {
+		predicates = append(predicates, budget.AlertedPeriodStartIsNil())
+	}
+	if i.AlertedPeriodStartNotNil {
+		predicates = append(predicates, budget.AlertedPeriodStartNotNil())
+	}
+
+	if i.HasProject != nil {
+		p := budget.HasProject()
+		if !*i.HasProject {
+			p = budget.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasVersionsWith) > 0 {
-		with := make([]predicate.ChannelModelPriceVersion, 0, len(i.HasVersionsWith))
-		for _, w := range i.HasVersionsWith {
+	if len(i.HasProjectWith) > 0 {
+		with := make([]predicate.Project, 0, len(i.HasProjectWith))
+		for _, w := range i.HasProjectWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasVersionsWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasProjectWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, channelmodelprice.HasVersionsWith(with...))
+		predicates = append(predicates, budget.HasProjectWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyChannelModelPriceWhereInput
+		return nil, ErrEmptyBudgetWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return channelmodelprice.And(predicates...), nil
+		return budget.And(predicates...), nil
 	}
 }
 
-// ChannelModelPriceVersionWhereInput represents a where input for filtering ChannelModelPriceVersion queries.
-type ChannelModelPriceVersionWhereInput struct {
-	Predicates []predicate.ChannelModelPriceVersion  `json:"-"`
-	Not        *ChannelModelPriceVersionWhereInput   `json:"not,omitempty"`
-	Or         []*ChannelModelPriceVersionWhereInput `json:"or,omitempty"`
-	And        []*ChannelModelPriceVersionWhereInput `json:"and,omitempty"`
+// ChannelWhereInput represents a where input for filtering Channel queries.
+type ChannelWhereInput struct {
+	Predicates []predicate.Channel  `json:"-"`
+	Not        *ChannelWhereInput   `json:"not,omitempty"`
+	Or         []*ChannelWhereInput `json:"or,omitempty"`
+	And        []*ChannelWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -1979,98 +2071,168 @@ type ChannelModelPriceVersionWhereInput struct {
 	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
 	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
-	// "channel_id" field predicates.
-	ChannelID      *int  `json:"channelID,omitempty"`
-	ChannelIDNEQ   *int  `json:"channelIDNEQ,omitempty"`
-	ChannelIDIn    []int `json:"channelIDIn,omitempty"`
-	ChannelIDNotIn []int `json:"channelIDNotIn,omitempty"`
-	ChannelIDGT    *int  `json:"channelIDGT,omitempty"`
-	ChannelIDGTE   *int  `json:"channelIDGTE,omitempty"`
-	ChannelIDLT    *int  `json:"channelIDLT,omitempty"`
-	ChannelIDLTE   *int  `json:"channelIDLTE,omitempty"`
-
-	// "model_id" field predicates.
-	ModelID             *string  `json:"modelID,omitempty"`
-	ModelIDNEQ          *string  `json:"modelIDNEQ,omitempty"`
-	ModelIDIn           []string `json:"modelIDIn,omitempty"`
-	ModelIDNotIn        []string `json:"modelIDNotIn,omitempty"`
-	ModelIDGT           *string  `json:"modelIDGT,omitempty"`
-	ModelIDGTE          *string  `json:"modelIDGTE,omitempty"`
-	ModelIDLT           *string  `json:"modelIDLT,omitempty"`
-	ModelIDLTE          *string  `json:"modelIDLTE,omitempty"`
-	ModelIDContains     *string  `json:"modelIDContains,omitempty"`
-	ModelIDHasPrefix    *string  `json:"modelIDHasPrefix,omitempty"`
-	ModelIDHasSuffix    *string  `json:"modelIDHasSuffix,omitempty"`
-	ModelIDEqualFold    *string  `json:"modelIDEqualFold,omitempty"`
-	ModelIDContainsFold *string  `json:"modelIDContainsFold,omitempty"`
-
-	// "channel_model_price_id" field predicates.
-	ChannelModelPriceID      *int  `json:"channelModelPriceID,omitempty"`
-	ChannelModelPriceIDNEQ   *int  `json:"channelModelPriceIDNEQ,omitempty"`
-	ChannelModelPriceIDIn    []int `json:"channelModelPriceIDIn,omitempty"`
-	ChannelModelPriceIDNotIn []int `json:"channelModelPriceIDNotIn,omitempty"`
+	// "type" field predicates.
+	Type      *channel.Type  `json:"type,omitempty"`
+	TypeNEQ   *channel.Type  `json:"typeNEQ,omitempty"`
+	TypeIn    []channel.Type `json:"typeIn,omitempty"`
+	TypeNotIn []channel.Type `json:"typeNotIn,omitempty"`
 
-	// "status" field predicates.
-	Status      *channelmodelpriceversion.Status  `json:"status,omitempty"`
-	StatusNEQ   *channelmodelpriceversion.Status  `json:"statusNEQ,omitempty"`
-	StatusIn    []channelmodelpriceversion.Status `json:"statusIn,omitempty"`
-	StatusNotIn []channelmodelpriceversion.Status `json:"statusNotIn,omitempty"`
+	// "base_url" field predicates.
+	BaseURL             *string  `json:"baseURL,omitempty"`
+	BaseURLNEQ          *string  `json:"baseURLNEQ,omitempty"`
+	BaseURLIn           []string `json:"baseURLIn,omitempty"`
+	BaseURLNotIn        []string `json:"baseURLNotIn,omitempty"`
+	BaseURLGT           *string  `json:"baseURLGT,omitempty"`
+	BaseURLGTE          *string  `json:"baseURLGTE,omitempty"`
+	BaseURLLT           *string  `json:"baseURLLT,omitempty"`
+	BaseURLLTE          *string  `json:"baseURLLTE,omitempty"`
+	BaseURLContains     *string  `json:"baseURLContains,omitempty"`
+	BaseURLHasPrefix    *string  `json:"baseURLHasPrefix,omitempty"`
+	BaseURLHasSuffix    *string  `json:"baseURLHasSuffix,omitempty"`
+	BaseURLIsNil        bool     `json:"baseURLIsNil,omitempty"`
+	BaseURLNotNil       bool     `json:"baseURLNotNil,omitempty"`
+	BaseURLEqualFold    *string  `json:"baseURLEqualFold,omitempty"`
+	BaseURLContainsFold *string  `json:"baseURLContainsFold,omitempty"`
 
-	// "effective_start_at" field predicates.
-	EffectiveStartAt      *time.Time  `json:"effectiveStartAt,omitempty"`
-	EffectiveStartAtNEQ   *time.Time  `json:"effectiveStartAtNEQ,omitempty"`
-	EffectiveStartAtIn    []time.Time `json:"effectiveStartAtIn,omitempty"`
-	EffectiveStartAtNotIn []time.Time `json:"effectiveStartAtNotIn,omitempty"`
-	EffectiveStartAtGT    *time.Time  `json:"effectiveStartAtGT,omitempty"`
-	EffectiveStartAtGTE   *time.Time  `json:"effectiveStartAtGTE,omitempty"`
-	EffectiveStartAtLT    *time.Time  `json:"effectiveStartAtLT,omitempty"`
-	EffectiveStartAtLTE   *time.Time  `json:"effectiveStartAtLTE,omitempty"`
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "effective_end_at" field predicates.
-	EffectiveEndAt       *time.Time  `json:"effectiveEndAt,omitempty"`
-	EffectiveEndAtNEQ    *time.Time  `json:"effectiveEndAtNEQ,omitempty"`
-	EffectiveEndAtIn     []time.Time `json:"effectiveEndAtIn,omitempty"`
-	EffectiveEndAtNotIn  []time.Time `json:"effectiveEndAtNotIn,omitempty"`
-	EffectiveEndAtGT     *time.Time  `json:"effectiveEndAtGT,omitempty"`
-	EffectiveEndAtGTE    *time.Time  `json:"effectiveEndAtGTE,omitempty"`
-	EffectiveEndAtLT     *time.Time  `json:"effectiveEndAtLT,omitempty"`
-	EffectiveEndAtLTE    *time.Time  `json:"effectiveEndAtLTE,omitempty"`
-	EffectiveEndAtIsNil  bool        `json:"effectiveEndAtIsNil,omitempty"`
-	EffectiveEndAtNotNil bool        `json:"effectiveEndAtNotNil,omitempty"`
+	// "status" field predicates.
+	Status      *channel.Status  `json:"status,omitempty"`
+	StatusNEQ   *channel.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []channel.Status `json:"statusIn,omitempty"`
+	StatusNotIn []channel.Status `json:"statusNotIn,omitempty"`
 
-	// "reference_id" field predicates.
-	ReferenceID             *string  `json:"referenceID,omitempty"`
-	ReferenceIDNEQ          *string  `json:"referenceIDNEQ,omitempty"`
-	ReferenceIDIn           []string `json:"referenceIDIn,omitempty"`
-	ReferenceIDNotIn        []string `json:"referenceIDNotIn,omitempty"`
-	ReferenceIDGT           *string  `json:"referenceIDGT,omitempty"`
-	ReferenceIDGTE          *string  `json:"referenceIDGTE,omitempty"`
-	ReferenceIDLT           *string  `json:"referenceIDLT,omitempty"`
-	ReferenceIDLTE          *string  `json:"referenceIDLTE,omitempty"`
-	ReferenceIDContains     *string  `json:"referenceIDContains,omitempty"`
-	ReferenceIDHasPrefix    *string  `json:"referenceIDHasPrefix,omitempty"`
-	ReferenceIDHasSuffix    *string  `json:"referenceIDHasSuffix,omitempty"`
-	ReferenceIDEqualFold    *string  `json:"referenceIDEqualFold,omitempty"`
-	ReferenceIDContainsFold *string  `json:"referenceIDContainsFold,omitempty"`
+	// "auto_sync_supported_models" field predicates.
+	AutoSyncSupportedModels    *bool `json:"autoSyncSupportedModels,omitempty"`
+	AutoSyncSupportedModelsNEQ *bool `json:"autoSyncSupportedModelsNEQ,omitempty"`
 
-	// "channel_model_price" edge predicates.
-	HasChannelModelPrice     *bool                          `json:"hasChannelModelPrice,omitempty"`
-	HasChannelModelPriceWith []*ChannelModelPriceWhereInput `json:"hasChannelModelPriceWith,omitempty"`
+	// "auto_sync_model_pattern" field predicates.
+	AutoSyncModelPattern             *string  `json:"autoSyncModelPattern,omitempty"`
+	AutoSyncModelPatternNEQ          *string  `json:"autoSyncModelPatternNEQ,omitempty"`
+	AutoSyncModelPatternIn           []string `json:"autoSyncModelPatternIn,omitempty"`
+	AutoSyncModelPatternNotIn        []string `json:"autoSyncModelPatternNotIn,omitempty"`
+	AutoSyncModelPatternGT           *string  `json:"autoSyncModelPatternGT,omitempty"`
+	AutoSyncModelPatternGTE          *string  `json:"autoSyncModelPatternGTE,omitempty"`
+	AutoSyncModelPatternLT           *string  `json:"autoSyncModelPatternLT,omitempty"`
+	AutoSyncModelPatternLTE          *string  `json:"autoSyncModelPatternLTE,omitempty"`
+	AutoSyncModelPatternContains     *string  `json:"autoSyncModelPatternContains,omitempty"`
+	AutoSyncModelPatternHasPrefix    *string  `json:"autoSyncModelPatternHasPrefix,omitempty"`
+	AutoSyncModelPatternHasSuffix    *string  `json:"autoSyncModelPatternHasSuffix,omitempty"`
+	AutoSyncModelPatternIsNil        bool     `json:"autoSyncModelPatternIsNil,omitempty"`
+	AutoSyncModelPatternNotNil       bool     `json:"autoSyncModelPatternNotNil,omitempty"`
+	AutoSyncModelPatternEqualFold    *string  `json:"autoSyncModelPatternEqualFold,omitempty"`
+	AutoSyncModelPatternContainsFold *string  `json:"autoSyncModelPatternContainsFold,omitempty"`
+
+	// "default_test_model" field predicates.
+	DefaultTestModel             *string  `json:"defaultTestModel,omitempty"`
+	DefaultTestModelNEQ          *string  `json:"defaultTestModelNEQ,omitempty"`
+	DefaultTestModelIn           []string `json:"defaultTestModelIn,omitempty"`
+	DefaultTestModelNotIn        []string `json:"defaultTestModelNotIn,omitempty"`
+	DefaultTestModelGT           *string  `json:"defaultTestModelGT,omitempty"`
+	DefaultTestModelGTE          *string  `json:"defaultTestModelGTE,omitempty"`
+	DefaultTestModelLT           *string  `json:"defaultTestModelLT,omitempty"`
+	DefaultTestModelLTE          *string  `json:"defaultTestModelLTE,omitempty"`
+	DefaultTestModelContains     *string  `json:"defaultTestModelContains,omitempty"`
+	DefaultTestModelHasPrefix    *string  `json:"defaultTestModelHasPrefix,omitempty"`
+	DefaultTestModelHasSuffix    *string  `json:"defaultTestModelHasSuffix,omitempty"`
+	DefaultTestModelEqualFold    *string  `json:"defaultTestModelEqualFold,omitempty"`
+	DefaultTestModelContainsFold *string  `json:"defaultTestModelContainsFold,omitempty"`
+
+	// "ordering_weight" field predicates.
+	OrderingWeight      *int  `json:"orderingWeight,omitempty"`
+	OrderingWeightNEQ   *int  `json:"orderingWeightNEQ,omitempty"`
+	OrderingWeightIn    []int `json:"orderingWeightIn,omitempty"`
+	OrderingWeightNotIn []int `json:"orderingWeightNotIn,omitempty"`
+	OrderingWeightGT    *int  `json:"orderingWeightGT,omitempty"`
+	OrderingWeightGTE   *int  `json:"orderingWeightGTE,omitempty"`
+	OrderingWeightLT    *int  `json:"orderingWeightLT,omitempty"`
+	OrderingWeightLTE   *int  `json:"orderingWeightLTE,omitempty"`
+
+	// "error_message" field predicates.
+	ErrorMessage             *string  `json:"errorMessage,omitempty"`
+	ErrorMessageNEQ          *string  `json:"errorMessageNEQ,omitempty"`
+	ErrorMessageIn           []string `json:"errorMessageIn,omitempty"`
+	ErrorMessageNotIn        []string `json:"errorMessageNotIn,omitempty"`
+	ErrorMessageGT           *string  `json:"errorMessageGT,omitempty"`
+	ErrorMessageGTE          *string  `json:"errorMessageGTE,omitempty"`
+	ErrorMessageLT           *string  `json:"errorMessageLT,omitempty"`
+	ErrorMessageLTE          *string  `json:"errorMessageLTE,omitempty"`
+	ErrorMessageContains     *string  `json:"errorMessageContains,omitempty"`
+	ErrorMessageHasPrefix    *string  `json:"errorMessageHasPrefix,omitempty"`
+	ErrorMessageHasSuffix    *string  `json:"errorMessageHasSuffix,omitempty"`
+	ErrorMessageIsNil        bool     `json:"errorMessageIsNil,omitempty"`
+	ErrorMessageNotNil       bool     `json:"errorMessageNotNil,omitempty"`
+	ErrorMessageEqualFold    *string  `json:"errorMessageEqualFold,omitempty"`
+	ErrorMessageContainsFold *string  `json:"errorMessageContainsFold,omitempty"`
+
+	// "remark" field predicates.
+	Remark             *string  `json:"remark,omitempty"`
+	RemarkNEQ          *string  `json:"remarkNEQ,omitempty"`
+	RemarkIn           []string `json:"remarkIn,omitempty"`
+	RemarkNotIn        []string `json:"remarkNotIn,omitempty"`
+	RemarkGT           *string  `json:"remarkGT,omitempty"`
+	RemarkGTE          *string  `json:"remarkGTE,omitempty"`
+	RemarkLT           *string  `json:"remarkLT,omitempty"`
+	RemarkLTE          *string  `json:"remarkLTE,omitempty"`
+	RemarkContains     *string  `json:"remarkContains,omitempty"`
+	RemarkHasPrefix    *string  `json:"remarkHasPrefix,omitempty"`
+	RemarkHasSuffix    *string  `json:"remarkHasSuffix,omitempty"`
+	RemarkIsNil        bool     `json:"remarkIsNil,omitempty"`
+	RemarkNotNil       bool     `json:"remarkNotNil,omitempty"`
+	RemarkEqualFold    *string  `json:"remarkEqualFold,omitempty"`
+	RemarkContainsFold *string  `json:"remarkContainsFold,omitempty"`
+
+	// "requests" edge predicates.
+	HasRequests     *bool                `json:"hasRequests,omitempty"`
+	HasRequestsWith []*RequestWhereInput `json:"hasRequestsWith,omitempty"`
+
+	// "executions" edge predicates.
+	HasExecutions     *bool                         `json:"hasExecutions,omitempty"`
+	HasExecutionsWith []*RequestExecutionWhereInput `json:"hasExecutionsWith,omitempty"`
+
+	// "usage_logs" edge predicates.
+	HasUsageLogs     *bool                 `json:"hasUsageLogs,omitempty"`
+	HasUsageLogsWith []*UsageLogWhereInput `json:"hasUsageLogsWith,omitempty"`
+
+	// "channel_probes" edge predicates.
+	HasChannelProbes     *bool                     `json:"hasChannelProbes,omitempty"`
+	HasChannelProbesWith []*ChannelProbeWhereInput `json:"hasChannelProbesWith,omitempty"`
+
+	// "channel_model_prices" edge predicates.
+	HasChannelModelPrices     *bool                          `json:"hasChannelModelPrices,omitempty"`
+	HasChannelModelPricesWith []*ChannelModelPriceWhereInput `json:"hasChannelModelPricesWith,omitempty"`
+
+	// "provider_quota_status" edge predicates.
+	HasProviderQuotaStatus     *bool                            `json:"hasProviderQuotaStatus,omitempty"`
+	HasProviderQuotaStatusWith []*ProviderQuotaStatusWhereInput `json:"hasProviderQuotaStatusWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *ChannelModelPriceVersionWhereInput) AddPredicates(predicates ...predicate.ChannelModelPriceVersion) {
+func (i *ChannelWhereInput) AddPredicates(predicates ...predicate.Channel) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the ChannelModelPriceVersionWhereInput filter on the ChannelModelPriceVersionQuery builder.
-func (i *ChannelModelPriceVersionWhereInput) Filter(q *ChannelModelPriceVersionQuery) (*ChannelModelPriceVersionQuery, error) {
+// Filter applies the ChannelWhereInput filter on the ChannelQuery builder.
+func (i *ChannelWhereInput) Filter(q *ChannelQuery) (*ChannelQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyChannelModelPriceVersionWhereInput {
+		if err == ErrEmptyChannelWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -2078,19 +2240,19 @@ func (i *ChannelModelPriceVersionWhereInput) Filter(q *ChannelModelPriceVersionQ
 	return q.Where(p), nil
 }
 
-// ErrEmptyChannelModelPriceVersionWhereInput is returned in case the ChannelModelPriceVersionWhereInput is empty.
-var ErrEmptyChannelModelPriceVersionWhereInput = errors.New("ent: empty predicate ChannelModelPriceVersionWhereInput")
+// ErrEmptyChannelWhereInput is returned in case the ChannelWhereInput is empty.
+var ErrEmptyChannelWhereInput = errors.New("ent: empty predicate ChannelWhereInput")
 
-// P returns a predicate for filtering channelmodelpriceversions.
+// P returns a predicate for filtering channels.
 // An error is returned if the input is empty or invalid.
-func (i *ChannelModelPriceVersionWhereInput) P() (predicate.ChannelModelPriceVersion, error) {
-	var predicates []predicate.ChannelModelPriceVersion
+func (i *ChannelWhereInput) P() (predicate.Channel, error) {
+	var predicates []predicate.Channel
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, channelmodelpriceversion.Not(p))
+		predicates = append(predicates, channel.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -2100,7 +2262,7 @@ func (i *ChannelModelPriceVersionWhereInput) P() (predicate.ChannelModelPriceVer
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.ChannelModelPriceVersion, 0, n)
+		or := make([]predicate.Channel, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -2108,7 +2270,7 @@ func (i *ChannelModelPriceVersionWhereInput) P() (predicate.ChannelModelPriceVer
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, channelmodelpriceversion.Or(or...))
+		predicates = append(predicates, channel.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -2118,7 +2280,7 @@ func (i *ChannelModelPriceVersionWhereInput) P() (predicate.ChannelModelPriceVer
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.ChannelModelPriceVersion, 0, n)
+		and := make([]predicate.Channel, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -2126,296 +2288,518 @@ func (i *ChannelModelPriceVersionWhereInput) P() (predicate.ChannelModelPriceVer
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, channelmodelpriceversion.And(and...))
+		predicates = append(predicates, channel.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, channelmodelpriceversion.IDEQ(*i.ID))
+		predicates = append(predicates, channel.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, channelmodelpriceversion.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, channel.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.IDIn(i.IDIn...))
+		predicates = append(predicates, channel.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, channel.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, channelmodelpriceversion.IDGT(*i.IDGT))
+		predicates = append(predicates, channel.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, channelmodelpriceversion.IDGTE(*i.IDGTE))
+		predicates = append(predicates, channel.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, channelmodelpriceversion.IDLT(*i.IDLT))
+		predicates = append(predicates, channel.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, channelmodelpriceversion.IDLTE(*i.IDLTE))
+		predicates = append(predicates, channel.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, channelmodelpriceversion.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, channel.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, channelmodelpriceversion.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, channel.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, channel.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, channel.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, channelmodelpriceversion.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, channel.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, channelmodelpriceversion.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, channel.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, channelmodelpriceversion.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, channel.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, channelmodelpriceversion.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, channel.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, channelmodelpriceversion.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, channel.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, channelmodelpriceversion.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, channel.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, channel.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, channel.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, channelmodelpriceversion.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, channel.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, channelmodelpriceversion.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, channel.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, channelmodelpriceversion.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, channel.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, channelmodelpriceversion.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, channel.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.ChannelID != nil {
-		predicates = append(predicates, channelmodelpriceversion.ChannelIDEQ(*i.ChannelID))
+	if i.Type != nil {
+		predicates = append(predicates, channel.TypeEQ(*i.Type))
 	}
-	if i.ChannelIDNEQ != nil {
-		predicates = append(predicates, channelmodelpriceversion.ChannelIDNEQ(*i.ChannelIDNEQ))
+	if i.TypeNEQ != nil {
+		predicates = append(predicates, channel.TypeNEQ(*i.TypeNEQ))
 	}
-	if len(i.ChannelIDIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.ChannelIDIn(i.ChannelIDIn...))
+	if len(i.TypeIn) > 0 {
+		predicates = append(predicates, channel.TypeIn(i.TypeIn...))
 	}
-	if len(i.ChannelIDNotIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.ChannelIDNotIn(i.ChannelIDNotIn...))
+	if len(i.TypeNotIn) > 0 {
+		predicates = append(predicates, channel.TypeNotIn(i.TypeNotIn...))
 	}
-	if i.ChannelIDGT != nil {
-		predicates = append(predicates, channelmodelpriceversion.ChannelIDGT(*i.ChannelIDGT))
+	if i.BaseURL != nil {
+		predicates = append(predicates, channel.BaseURLEQ(*i.BaseURL))
 	}
-	if i.ChannelIDGTE != nil {
-		predicates = append(predicates, channelmodelpriceversion.ChannelIDGTE(*i.ChannelIDGTE))
+	if i.BaseURLNEQ != nil {
+		predicates = append(predicates, channel.BaseURLNEQ(*i.BaseURLNEQ))
 	}
-	if i.ChannelIDLT != nil {
-		predicates = append(predicates, channelmodelpriceversion.ChannelIDLT(*i.ChannelIDLT))
+	if len(i.BaseURLIn) > 0 {
+		predicates = append(predicates, channel.BaseURLIn(i.BaseURLIn...))
 	}
-	if i.ChannelIDLTE != nil {
-		predicates = append(predicates, channelmodelpriceversion.ChannelIDLTE(*i.ChannelIDLTE))
+	if len(i.BaseURLNotIn) > 0 {
+		predicates = append(predicates, channel.BaseURLNotIn(i.BaseURLNotIn...))
 	}
-	if i.ModelID != nil {
-		predicates = append(predicates, channelmodelpriceversion.ModelIDEQ(*i.ModelID))
+	if i.BaseURLGT != nil {
+		predicates = append(predicates, channel.BaseURLGT(*i.BaseURLGT))
 	}
-	if i.ModelIDNEQ != nil {
-		predicates = append(predicates, channelmodelpriceversion.ModelIDNEQ(*i.ModelIDNEQ))
+	if i.BaseURLGTE != nil {
+		predicates = append(predicates, channel.BaseURLGTE(*i.BaseURLGTE))
 	}
-	if len(i.ModelIDIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.ModelIDIn(i.ModelIDIn...))
+	if i.BaseURLLT != nil {
+		predicates = append(predicates, channel.BaseURLLT(*i.BaseURLLT))
 	}
-	if len(i.ModelIDNotIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.ModelIDNotIn(i.ModelIDNotIn...))
+	if i.BaseURLLTE != nil {
+		predicates = append(predicates, channel.BaseURLLTE(*i.BaseURLLTE))
 	}
-	if i.ModelIDGT != nil {
-		predicates = append(predicates, channelmodelpriceversion.ModelIDGT(*i.ModelIDGT))
+	if i.BaseURLContains != nil {
+		predicates = append(predicates, channel.BaseURLContains(*i.BaseURLContains))
 	}
-	if i.ModelIDGTE != nil {
-		predicates = append(predicates, channelmodelpriceversion.ModelIDGTE(*i.ModelIDGTE))
+	if i.BaseURLHasPrefix != nil {
+		predicates = append(predicates, channel.BaseURLHasPrefix(*i.BaseURLHasPrefix))
 	}
-	if i.ModelIDLT != nil {
-		predicates = append(predicates, channelmodelpriceversion.ModelIDLT(*i.ModelIDLT))
+	if i.BaseURLHasSuffix != nil {
+		predicates = append(predicates, channel.BaseURLHasSuffix(*i.BaseURLHasSuffix))
 	}
-	if i.ModelIDLTE != nil {
-		predicates = append(predicates, channelmodelpriceversion.ModelIDLTE(*i.ModelIDLTE))
+	if i.BaseURLIsNil {
+		predicates = append(predicates, channel.BaseURLIsNil())
 	}
-	if i.ModelIDContains != nil {
-		predicates = append(predicates, channelmodelpriceversion.ModelIDContains(*i.ModelIDContains))
+	if i.BaseURLNotNil {
+		predicates = append(predicates, channel.BaseURLNotNil())
 	}
-	if i.ModelIDHasPrefix != nil {
-		predicates = append(predicates, channelmodelpriceversion.ModelIDHasPrefix(*i.ModelIDHasPrefix))
+	if i.BaseURLEqualFold != nil {
+		predicates = append(predicates, channel.BaseURLEqualFold(*i.BaseURLEqualFold))
 	}
-	if i.ModelIDHasSuffix != nil {
-		predicates = append(predicates, channelmodelpriceversion.ModelIDHasSuffix(*i.ModelIDHasSuffix))
+	if i.BaseURLContainsFold != nil {
+		predicates = append(predicates, channel.BaseURLContainsFold(*i.BaseURLContainsFold))
 	}
-	if i.ModelIDEqualFold != nil {
-		predicates = append(predicates, channelmodelpriceversion.ModelIDEqualFold(*i.ModelIDEqualFold))
+	if i.Name != nil {
+		predicates = append(predicates, channel.NameEQ(*i.Name))
 	}
-	if i.ModelIDContainsFold != nil {
-		predicates = append(predicates, channelmodelpriceversion.ModelIDContainsFold(*i.ModelIDContainsFold))
+	if i.NameNEQ != nil {
+		predicates = append(predicates, channel.NameNEQ(*i.NameNEQ))
 	}
-	if i.ChannelModelPriceID != nil {
-		predicates = append(predicates, channelmodelpriceversion.ChannelModelPriceIDEQ(*i.ChannelModelPriceID))
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, channel.NameIn(i.NameIn...))
 	}
-	if i.ChannelModelPriceIDNEQ != nil {
-		predicates = append(predicates, channelmodelpriceversion.ChannelModelPriceIDNEQ(*i.ChannelModelPriceIDNEQ))
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, channel.NameNotIn(i.NameNotIn...))
 	}
-	if len(i.ChannelModelPriceIDIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.ChannelModelPriceIDIn(i.ChannelModelPriceIDIn...))
+	if i.NameGT != nil {
+		predicates = append(predicates, channel.NameGT(*i.NameGT))
 	}
-	if len(i.ChannelModelPriceIDNotIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.ChannelModelPriceIDNotIn(i.ChannelModelPriceIDNotIn...))
+	if i.NameGTE != nil {
+		predicates = append(predicates, channel.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, channel.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, channel.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, channel.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, channel.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, channel.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, channel.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, channel.NameContainsFold(*i.NameContainsFold))
 	}
 	if i.Status != nil {
-		predicates = append(predicates, channelmodelpriceversion.StatusEQ(*i.Status))
+		predicates = append(predicates, channel.StatusEQ(*i.Status))
 	}
 	if i.StatusNEQ != nil {
-		predicates = append(predicates, channelmodelpriceversion.StatusNEQ(*i.StatusNEQ))
+		predicates = append(predicates, channel.StatusNEQ(*i.StatusNEQ))
 	}
 	if len(i.StatusIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.StatusIn(i.StatusIn...))
+		predicates = append(predicates, channel.StatusIn(i.StatusIn...))
 	}
 	if len(i.StatusNotIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.StatusNotIn(i.StatusNotIn...))
+		predicates = append(predicates, channel.StatusNotIn(i.StatusNotIn...))
 	}
-	if i.EffectiveStartAt != nil {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveStartAtEQ(*i.EffectiveStartAt))
+	if i.AutoSyncSupportedModels != nil {
+		predicates = append(predicates, channel.AutoSyncSupportedModelsEQ(*i.AutoSyncSupportedModels))
 	}
-	if i.EffectiveStartAtNEQ != nil {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveStartAtNEQ(*i.EffectiveStartAtNEQ))
+	if i.AutoSyncSupportedModelsNEQ != nil {
+		predicates = append(predicates, channel.AutoSyncSupportedModelsNEQ(*i.AutoSyncSupportedModelsNEQ))
 	}
-	if len(i.EffectiveStartAtIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveStartAtIn(i.EffectiveStartAtIn...))
+	if i.AutoSyncModelPattern != nil {
+		predicates = append(predicates, channel.AutoSyncModelPatternEQ(*i.AutoSyncModelPattern))
 	}
-	if len(i.EffectiveStartAtNotIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveStartAtNotIn(i.EffectiveStartAtNotIn...))
+	if i.AutoSyncModelPatternNEQ != nil {
+		predicates = append(predicates, channel.AutoSyncModelPatternNEQ(*i.AutoSyncModelPatternNEQ))
 	}
-	if i.EffectiveStartAtGT != nil {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveStartAtGT(*i.EffectiveStartAtGT))
+	if len(i.AutoSyncModelPatternIn) > 0 {
+		predicates = append(predicates, channel.AutoSyncModelPatternIn(i.AutoSyncModelPatternIn...))
 	}
-	if i.EffectiveStartAtGTE != nil {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveStartAtGTE(*i.EffectiveStartAtGTE))
+	if len(i.AutoSyncModelPatternNotIn) > 0 {
+		predicates = append(predicates, channel.AutoSyncModelPatternNotIn(i.AutoSyncModelPatternNotIn...))
 	}
-	if i.EffectiveStartAtLT != nil {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveStartAtLT(*i.EffectiveStartAtLT))
+	if i.AutoSyncModelPatternGT != nil {
+		predicates = append(predicates, channel.AutoSyncModelPatternGT(*i.AutoSyncModelPatternGT))
 	}
-	if i.EffectiveStartAtLTE != nil {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveStartAtLTE(*i.EffectiveStartAtLTE))
+	if i.AutoSyncModelPatternGTE != nil {
+		predicates = append(predicates, channel.AutoSyncModelPatternGTE(*i.AutoSyncModelPatternGTE))
 	}
-	if i.EffectiveEndAt != nil {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtEQ(*i.EffectiveEndAt))
+	if i.AutoSyncModelPatternLT != nil {
+		predicates = append(predicates, channel.AutoSyncModelPatternLT(*i.AutoSyncModelPatternLT))
 	}
-	if i.EffectiveEndAtNEQ != nil {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtNEQ(*i.EffectiveEndAtNEQ))
+	if i.AutoSyncModelPatternLTE != nil {
+		predicates = append(predicates, channel.AutoSyncModelPatternLTE(*i.AutoSyncModelPatternLTE))
 	}
-	if len(i.EffectiveEndAtIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtIn(i.EffectiveEndAtIn...))
+	if i.AutoSyncModelPatternContains != nil {
+		predicates = append(predicates, channel.AutoSyncModelPatternContains(*i.AutoSyncModelPatternContains))
 	}
-	if len(i.EffectiveEndAtNotIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtNotIn(i.EffectiveEndAtNotIn...))
+	if i.AutoSyncModelPatternHasPrefix != nil {
+		predicates = append(predicates, channel.AutoSyncModelPatternHasPrefix(*i.AutoSyncModelPatternHasPrefix))
 	}
-	if i.EffectiveEndAtGT != nil {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtGT(*i.EffectiveEndAtGT))
+	if i.AutoSyncModelPatternHasSuffix != nil {
+		predicates = append(predicates, channel.AutoSyncModelPatternHasSuffix(*i.AutoSyncModelPatternHasSuffix))
 	}
-	if i.EffectiveEndAtGTE != nil {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtGTE(*i.EffectiveEndAtGTE))
+	if i.AutoSyncModelPatternIsNil {
+		predicates = append(predicates, channel.AutoSyncModelPatternIsNil())
 	}
-	if i.EffectiveEndAtLT != nil {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtLT(*i.EffectiveEndAtLT))
+	if i.AutoSyncModelPatternNotNil {
+		predicates = append(predicates, channel.AutoSyncModelPatternNotNil())
 	}
-	if i.EffectiveEndAtLTE != nil {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtLTE(*i.EffectiveEndAtLTE))
+	if i.AutoSyncModelPatternEqualFold != nil {
+		predicates = append(predicates, channel.AutoSyncModelPatternEqualFold(*i.AutoSyncModelPatternEqualFold))
 	}
-	if i.EffectiveEndAtIsNil {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtIsNil())
+	if i.AutoSyncModelPatternContainsFold != nil {
+		predicates = append(predicates, channel.AutoSyncModelPatternContainsFold(*i.AutoSyncModelPatternContainsFold))
 	}
-	if i.EffectiveEndAtNotNil {
-		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtNotNil())
+	if i.DefaultTestModel != nil {
+		predicates = append(predicates, channel.DefaultTestModelEQ(*i.DefaultTestModel))
 	}
-	if i.ReferenceID != nil {
-		predicates = append(predicates, channelmodelpriceversion.ReferenceIDEQ(*i.ReferenceID))
+	if i.DefaultTestModelNEQ != nil {
+		predicates = append(predicates, channel.DefaultTestModelNEQ(*i.DefaultTestModelNEQ))
 	}
-	if i.ReferenceIDNEQ != nil {
-		predicates = append(predicates, channelmodelpriceversion.ReferenceIDNEQ(*i.ReferenceIDNEQ))
+	if len(i.DefaultTestModelIn) > 0 {
+		predicates = append(predicates, channel.DefaultTestModelIn(i.DefaultTestModelIn...))
 	}
-	if len(i.ReferenceIDIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.ReferenceIDIn(i.ReferenceIDIn...))
+	if len(i.DefaultTestModelNotIn) > 0 {
+		predicates = append(predicates, channel.DefaultTestModelNotIn(i.DefaultTestModelNotIn...))
 	}
-	if len(i.ReferenceIDNotIn) > 0 {
-		predicates = append(predicates, channelmodelpriceversion.ReferenceIDNotIn(i.ReferenceIDNotIn...))
+	if i.DefaultTestModelGT != nil {
+		predicates = append(predicates, channel.DefaultTestModelGT(*i.DefaultTestModelGT))
 	}
-	if i.ReferenceIDGT != nil {
-		predicates = append(predicates, channelmodelpriceversion.ReferenceIDGT(*i.ReferenceIDGT))
+	if i.DefaultTestModelGTE != nil {
+		predicates = append(predicates, channel.DefaultTestModelGTE(*i.DefaultTestModelGTE))
 	}
-	if i.ReferenceIDGTE != nil {
-		predicates = append(predicates, channelmodelpriceversion.ReferenceIDGTE(*i.ReferenceIDGTE))
+	if i.DefaultTestModelLT != nil {
+		predicates = append(predicates, channel.DefaultTestModelLT(*i.DefaultTestModelLT))
 	}
-	if i.ReferenceIDLT != nil {
-		predicates = append(predicates, channelmodelpriceversion.ReferenceIDLT(*i.ReferenceIDLT))
+	if i.DefaultTestModelLTE != nil {
+		predicates = append(predicates, channel.DefaultTestModelLTE(*i.DefaultTestModelLTE))
 	}
-	if i.ReferenceIDLTE != nil {
-		predicates = append(predicates, channelmodelpriceversion.ReferenceIDLTE(*i.ReferenceIDLTE))
+	if i.DefaultTestModelContains != nil {
+		predicates = append(predicates, channel.DefaultTestModelContains(*i.DefaultTestModelContains))
 	}
-	if i.ReferenceIDContains != nil {
-		predicates = append(predicates, channelmodelpriceversion.ReferenceIDContains(*i.ReferenceIDContains))
+	if i.DefaultTestModelHasPrefix != nil {
+		predicates = append(predicates, channel.DefaultTestModelHasPrefix(*i.DefaultTestModelHasPrefix))
 	}
-	if i.ReferenceIDHasPrefix != nil {
-		predicates = append(predicates, channelmodelpriceversion.ReferenceIDHasPrefix(*i.ReferenceIDHasPrefix))
+	if i.DefaultTestModelHasSuffix != nil {
+		predicates = append(predicates, channel.DefaultTestModelHasSuffix(*i.DefaultTestModelHasSuffix))
 	}
-	if i.ReferenceIDHasSuffix != nil {
-		predicates = append(predicates, channelmodelpriceversion.ReferenceIDHasSuffix(*i.ReferenceIDHasSuffix))
+	if i.DefaultTestModelEqualFold != nil {
+		predicates = append(predicates, channel.DefaultTestModelEqualFold(*i.DefaultTestModelEqualFold))
 	}
-	if i.ReferenceIDEqualFold != nil {
-		predicates = append(predicates, channelmodelpriceversion.ReferenceIDEqualFold(*i.ReferenceIDEqualFold))
+	if i.DefaultTestModelContainsFold != nil {
+		predicates = append(predicates, channel.DefaultTestModelContainsFold(*i.DefaultTestModelContainsFold))
 	}
-	if i.ReferenceIDContainsFold != nil {
-		predicates = append(predicates, channelmodelpriceversion.ReferenceIDContainsFold(*i.ReferenceIDContainsFold))
+	if i.OrderingWeight != nil {
+		predicates = append(predicates, channel.OrderingWeightEQ(*i.OrderingWeight))
 	}
-
-	if i.HasChannelModelPrice != nil {
-		p := channelmodelpriceversion.HasChannelModelPrice()
-		if !*i.HasChannelModelPrice {
-			p = channelmodelpriceversion.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.OrderingWeightNEQ != nil {
+		predicates = append(predicates, channel.OrderingWeightNEQ(*i.OrderingWeightNEQ))
 	}
-	if len(i.HasChannelModelPriceWith) > 0 {
-		with := make([]predicate.ChannelModelPrice, 0, len(i.HasChannelModelPriceWith))
-		for _, w := range i.HasChannelModelPriceWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasChannelModelPriceWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, channelmodelpriceversion.HasChannelModelPriceWith(with...))
+	if len(i.OrderingWeightIn) > 0 {
+		predicates = append(predicates, channel.OrderingWeightIn(i.OrderingWeightIn...))
 	}
-	switch len(predicates) {
-	case 0:
-		return nil, ErrEmptyChannelModelPriceVersionWhereInput
-	case 1:
-		return predicates[0], nil
-	default:
-		return channelmodelpriceversion.And(predicates...), nil
+	if len(i.OrderingWeightNotIn) > 0 {
+		predicates = append(predicates, channel.OrderingWeightNotIn(i.OrderingWeightNotIn...))
+	}
+	if i.OrderingWeightGT != nil {
+		predicates = append(predicates, channel.OrderingWeightGT(*i.OrderingWeightGT))
+	}
+	if i.OrderingWeightGTE != nil {
+		predicates = append(predicates, channel.OrderingWeightGTE(*i.OrderingWeightGTE))
+	}
+	if i.OrderingWeightLT != nil {
+		predicates = append(predicates, channel.OrderingWeightLT(*i.OrderingWeightLT))
+	}
+	if i.OrderingWeightLTE != nil {
+		predicates = append(predicates, channel.OrderingWeightLTE(*i.OrderingWeightLTE))
+	}
+	if i.ErrorMessage != nil {
+		predicates = append(predicates, channel.ErrorMessageEQ(*i.ErrorMessage))
+	}
+	if i.ErrorMessageNEQ != nil {
+		predicates = append(predicates, channel.ErrorMessageNEQ(*i.ErrorMessageNEQ))
+	}
+	if len(i.ErrorMessageIn) > 0 {
+		predicates = append(predicates, channel.ErrorMessageIn(i.ErrorMessageIn...))
+	}
+	if len(i.ErrorMessageNotIn) > 0 {
+		predicates = append(predicates, channel.ErrorMessageNotIn(i.ErrorMessageNotIn...))
+	}
+	if i.ErrorMessageGT != nil {
+		predicates = append(predicates, channel.ErrorMessageGT(*i.ErrorMessageGT))
+	}
+	if i.ErrorMessageGTE != nil {
+		predicates = append(predicates, channel.ErrorMessageGTE(*i.ErrorMessageGTE))
+	}
+	if i.ErrorMessageLT != nil {
+		predicates = append(predicates, channel.ErrorMessageLT(*i.ErrorMessageLT))
+	}
+	if i.ErrorMessageLTE != nil {
+		predicates = append(predicates, channel.ErrorMessageLTE(*i.ErrorMessageLTE))
+	}
+	if i.ErrorMessageContains != nil {
+		predicates = append(predicates, channel.ErrorMessageContains(*i.ErrorMessageContains))
+	}
+	if i.ErrorMessageHasPrefix != nil {
+		predicates = append(predicates, channel.ErrorMessageHasPrefix(*i.ErrorMessageHasPrefix))
+	}
+	if i.ErrorMessageHasSuffix != nil {
+		predicates = append(predicates, channel.ErrorMessageHasSuffix(*i.ErrorMessageHasSuffix))
+	}
+	if i.ErrorMessageIsNil {
+		predicates = append(predicates, channel.ErrorMessageIsNil())
+	}
+	if i.ErrorMessageNotNil {
+		predicates = append(predicates, channel.ErrorMessageNotNil())
+	}
+	if i.ErrorMessageEqualFold != nil {
+		predicates = append(predicates, channel.ErrorMessageEqualFold(*i.ErrorMessageEqualFold))
+	}
+	if i.ErrorMessageContainsFold != nil {
+		predicates = append(predicates, channel.ErrorMessageContainsFold(*i.ErrorMessageContainsFold))
+	}
+	if i.Remark != nil {
+		predicates = append(predicates, channel.RemarkEQ(*i.Remark))
+	}
+	if i.RemarkNEQ != nil {
+		predicates = append(predicates, channel.RemarkNEQ(*i.RemarkNEQ))
+	}
+	if len(i.RemarkIn) > 0 {
+		predicates = append(predicates, channel.RemarkIn(i.RemarkIn...))
+	}
+	if len(i.RemarkNotIn) > 0 {
+		predicates = append(predicates, channel.RemarkNotIn(i.RemarkNotIn...))
+	}
+	if i.RemarkGT != nil {
+		predicates = append(predicates, channel.RemarkGT(*i.RemarkGT))
+	}
+	if i.RemarkGTE != nil {
+		predicates = append(predicates, channel.RemarkGTE(*i.RemarkGTE))
+	}
+	if i.RemarkLT != nil {
+		predicates = append(predicates, channel.RemarkLT(*i.RemarkLT))
+	}
+	if i.RemarkLTE != nil {
+		predicates = append(predicates, channel.RemarkLTE(*i.RemarkLTE))
+	}
+	if i.RemarkContains != nil {
+		predicates = append(predicates, channel.RemarkContains(*i.RemarkContains))
+	}
+	if i.RemarkHasPrefix != nil {
+		predicates = append(predicates, channel.RemarkHasPrefix(*i.RemarkHasPrefix))
+	}
+	if i.RemarkHasSuffix != nil {
+		predicates = append(predicates, channel.RemarkHasSuffix(*i.RemarkHasSuffix))
+	}
+	if i.RemarkIsNil {
+		predicates = append(predicates, channel.RemarkIsNil())
+	}
+	if i.RemarkNotNil {
+		predicates = append(predicates, channel.RemarkNotNil())
+	}
+	if i.RemarkEqualFold != nil {
+		predicates = append(predicates, channel.RemarkEqualFold(*i.RemarkEqualFold))
+	}
+	if i.RemarkContainsFold != nil {
+		predicates = append(predicates, channel.RemarkContainsFold(*i.RemarkContainsFold))
 	}
-}
 
-// ChannelOverrideTemplateWhereInput represents a where input for filtering ChannelOverrideTemplate queries.
-type ChannelOverrideTemplateWhereInput struct {
-	Predicates []predicate.ChannelOverrideTemplate  `json:"-"`
-	Not        *ChannelOverrideTemplateWhereInput   `json:"not,omitempty"`
-	Or         []*ChannelOverrideTemplateWhereInput `json:"or,omitempty"`
-	And        []*ChannelOverrideTemplateWhereInput `json:"and,omitempty"`
+	if i.HasRequests != nil {
+		p := channel.HasRequests()
+		if !*i.HasRequests {
+			p = channel.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasRequestsWith) > 0 {
+		with := make([]predicate.Request, 0, len(i.HasRequestsWith))
+		for _, w := range i.HasRequestsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRequestsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, channel.HasRequestsWith(with...))
+	}
+	if i.HasExecutions != nil {
+		p := channel.HasExecutions()
+		if !*i.HasExecutions {
+			p = channel.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasExecutionsWith) > 0 {
+		with := make([]predicate.RequestExecution, 0, len(i.HasExecutionsWith))
+		for _, w := range i.HasExecutionsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasExecutionsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, channel.HasExecutionsWith(with...))
+	}
+	if i.HasUsageLogs != nil {
+		p := channel.HasUsageLogs()
+		if !*i.HasUsageLogs {
+			p = channel.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasUsageLogsWith) > 0 {
+		with := make([]predicate.UsageLog, 0, len(i.HasUsageLogsWith))
+		for _, w := range i.HasUsageLogsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUsageLogsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, channel.HasUsageLogsWith(with...))
+	}
+	if i.HasChannelProbes != nil {
+		p := channel.HasChannelProbes()
+		if !*i.HasChannelProbes {
+			p = channel.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasChannelProbesWith) > 0 {
+		with := make([]predicate.ChannelProbe, 0, len(i.HasChannelProbesWith))
+		for _, w := range i.HasChannelProbesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasChannelProbesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, channel.HasChannelProbesWith(with...))
+	}
+	if i.HasChannelModelPrices != nil {
+		p := channel.HasChannelModelPrices()
+		if !*i.HasChannelModelPrices {
+			p = channel.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasChannelModelPricesWith) > 0 {
+		with := make([]predicate.ChannelModelPrice, 0, len(i.HasChannelModelPricesWith))
+		for _, w := range i.HasChannelModelPricesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasChannelModelPricesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, channel.HasChannelModelPricesWith(with...))
+	}
+	if i.HasProviderQuotaStatus != nil {
+		p := channel.HasProviderQuotaStatus()
+		if !*i.HasProviderQuotaStatus {
+			p = channel.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasProviderQuotaStatusWith) > 0 {
+		with := make([]predicate.ProviderQuotaStatus, 0, len(i.HasProviderQuotaStatusWith))
+		for _, w := range i.HasProviderQuotaStatusWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProviderQuotaStatusWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, channel.HasProviderQuotaStatusWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyChannelWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return channel.And(predicates...), nil
+	}
+}
+
+// ChannelModelPriceWhereInput represents a where input for filtering ChannelModelPrice queries.
+type ChannelModelPriceWhereInput struct {
+	Predicates []predicate.ChannelModelPrice  `json:"-"`
+	Not        *ChannelModelPriceWhereInput   `json:"not,omitempty"`
+	Or         []*ChannelModelPriceWhereInput `json:"or,omitempty"`
+	And        []*ChannelModelPriceWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -2447,79 +2831,64 @@ type ChannelOverrideTemplateWhereInput struct {
 	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
 	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
-	// "user_id" field predicates.
-	UserID       *int  `json:"userID,omitempty"`
-	UserIDNEQ    *int  `json:"userIDNEQ,omitempty"`
-	UserIDIn     []int `json:"userIDIn,omitempty"`
-	UserIDNotIn  []int `json:"userIDNotIn,omitempty"`
-	UserIDIsNil  bool  `json:"userIDIsNil,omitempty"`
-	UserIDNotNil bool  `json:"userIDNotNil,omitempty"`
+	// "channel_id" field predicates.
+	ChannelID      *int  `json:"channelID,omitempty"`
+	ChannelIDNEQ   *int  `json:"channelIDNEQ,omitempty"`
+	ChannelIDIn    []int `json:"channelIDIn,omitempty"`
+	ChannelIDNotIn []int `json:"channelIDNotIn,omitempty"`
 
-	// "name" field predicates.
-	Name             *string  `json:"name,omitempty"`
-	NameNEQ          *string  `json:"nameNEQ,omitempty"`
-	NameIn           []string `json:"nameIn,omitempty"`
-	NameNotIn        []string `json:"nameNotIn,omitempty"`
-	NameGT           *string  `json:"nameGT,omitempty"`
-	NameGTE          *string  `json:"nameGTE,omitempty"`
-	NameLT           *string  `json:"nameLT,omitempty"`
-	NameLTE          *string  `json:"nameLTE,omitempty"`
-	NameContains     *string  `json:"nameContains,omitempty"`
-	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
-	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
-	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
-	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// "model_id" field predicates.
+	ModelID             *string  `json:"modelID,omitempty"`
+	ModelIDNEQ          *string  `json:"modelIDNEQ,omitempty"`
+	ModelIDIn           []string `json:"modelIDIn,omitempty"`
+	ModelIDNotIn        []string `json:"modelIDNotIn,omitempty"`
+	ModelIDGT           *string  `json:"modelIDGT,omitempty"`
+	ModelIDGTE          *string  `json:"modelIDGTE,omitempty"`
+	ModelIDLT           *string  `json:"modelIDLT,omitempty"`
+	ModelIDLTE          *string  `json:"modelIDLTE,omitempty"`
+	ModelIDContains     *string  `json:"modelIDContains,omitempty"`
+	ModelIDHasPrefix    *string  `json:"modelIDHasPrefix,omitempty"`
+	ModelIDHasSuffix    *string  `json:"modelIDHasSuffix,omitempty"`
+	ModelIDEqualFold    *string  `json:"modelIDEqualFold,omitempty"`
+	ModelIDContainsFold *string  `json:"modelIDContainsFold,omitempty"`
 
-	// "description" field predicates.
-	Description             *string  `json:"description,omitempty"`
-	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
-	DescriptionIn           []string `json:"descriptionIn,omitempty"`
-	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
-	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
-	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
-	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
-	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
-	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
-	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
-	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
-	DescriptionIsNil        bool     `json:"descriptionIsNil,omitempty"`
-	DescriptionNotNil       bool     `json:"descriptionNotNil,omitempty"`
-	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
-	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+	// "reference_id" field predicates.
+	ReferenceID             *string  `json:"referenceID,omitempty"`
+	ReferenceIDNEQ          *string  `json:"referenceIDNEQ,omitempty"`
+	ReferenceIDIn           []string `json:"referenceIDIn,omitempty"`
+	ReferenceIDNotIn        []string `json:"referenceIDNotIn,omitempty"`
+	ReferenceIDGT           *string  `json:"referenceIDGT,omitempty"`
+	ReferenceIDGTE          *string  `json:"referenceIDGTE,omitempty"`
+	ReferenceIDLT           *string  `json:"referenceIDLT,omitempty"`
+	ReferenceIDLTE          *string  `json:"referenceIDLTE,omitempty"`
+	ReferenceIDContains     *string  `json:"referenceIDContains,omitempty"`
+	ReferenceIDHasPrefix    *string  `json:"referenceIDHasPrefix,omitempty"`
+	ReferenceIDHasSuffix    *string  `json:"referenceIDHasSuffix,omitempty"`
+	ReferenceIDEqualFold    *string  `json:"referenceIDEqualFold,omitempty"`
+	ReferenceIDContainsFold *string  `json:"referenceIDContainsFold,omitempty"`
 
-	// "override_parameters" field predicates.
-	OverrideParameters             *string  `json:"overrideParameters,omitempty"`
-	OverrideParametersNEQ          *string  `json:"overrideParametersNEQ,omitempty"`
-	OverrideParametersIn           []string `json:"overrideParametersIn,omitempty"`
-	OverrideParametersNotIn        []string `json:"overrideParametersNotIn,omitempty"`
-	OverrideParametersGT           *string  `json:"overrideParametersGT,omitempty"`
-	OverrideParametersGTE          *string  `json:"overrideParametersGTE,omitempty"`
-	OverrideParametersLT           *string  `json:"overrideParametersLT,omitempty"`
-	OverrideParametersLTE          *string  `json:"overrideParametersLTE,omitempty"`
-	OverrideParametersContains     *string  `json:"overrideParametersContains,omitempty"`
-	OverrideParametersHasPrefix    *string  `json:"overrideParametersHasPrefix,omitempty"`
-	OverrideParametersHasSuffix    *string  `json:"overrideParametersHasSuffix,omitempty"`
-	OverrideParametersEqualFold    *string  `json:"overrideParametersEqualFold,omitempty"`
-	OverrideParametersContainsFold *string  `json:"overrideParametersContainsFold,omitempty"`
+	// "channel" edge predicates.
+	HasChannel     *bool                `json:"hasChannel,omitempty"`
+	HasChannelWith []*ChannelWhereInput `json:"hasChannelWith,omitempty"`
 
-	// "user" edge predicates.
-	HasUser     *bool             `json:"hasUser,omitempty"`
-	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+	// "versions" edge predicates.
+	HasVersions     *bool                                 `json:"hasVersions,omitempty"`
+	HasVersionsWith []*ChannelModelPriceVersionWhereInput `json:"hasVersionsWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *ChannelOverrideTemplateWhereInput) AddPredicates(predicates ...predicate.ChannelOverrideTemplate) {
+func (i *ChannelModelPriceWhereInput) AddPredicates(predicates ...predicate.ChannelModelPrice) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the ChannelOverrideTemplateWhereInput filter on the ChannelOverrideTemplateQuery builder.
-func (i *ChannelOverrideTemplateWhereInput) Filter(q *ChannelOverrideTemplateQuery) (*ChannelOverrideTemplateQuery, error) {
+// Filter applies the ChannelModelPriceWhereInput filter on the ChannelModelPriceQuery builder.
+func (i *ChannelModelPriceWhereInput) Filter(q *ChannelModelPriceQuery) (*ChannelModelPriceQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyChannelOverrideTemplateWhereInput {
+		if err == ErrEmptyChannelModelPriceWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -2527,19 +2896,19 @@ func (i *ChannelOverrideTemplateWhereInput) Filter(q *ChannelOverrideTemplateQue
 	return q.Where(p), nil
 }
 
-// ErrEmptyChannelOverrideTemplateWhereInput is returned in case the ChannelOverrideTemplateWhereInput is empty.
-var ErrEmptyChannelOverrideTemplateWhereInput = errors.New("ent: empty predicate ChannelOverrideTemplateWhereInput")
+// ErrEmptyChannelModelPriceWhereInput is returned in case the ChannelModelPriceWhereInput is empty.
+var ErrEmptyChannelModelPriceWhereInput = errors.New("ent: empty predicate ChannelModelPriceWhereInput")
 
-// P returns a predicate for filtering channeloverridetemplates.
+// P returns a predicate for filtering channelmodelprices.
 // An error is returned if the input is empty or invalid.
-func (i *ChannelOverrideTemplateWhereInput) P() (predicate.ChannelOverrideTemplate, error) {
-	var predicates []predicate.ChannelOverrideTemplate
+func (i *ChannelModelPriceWhereInput) P() (predicate.ChannelModelPrice, error) {
+	var predicates []predicate.ChannelModelPrice
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, channeloverridetemplate.Not(p))
+		predicates = append(predicates, channelmodelprice.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -2549,7 +2918,7 @@ func (i *ChannelOverrideTemplateWhereInput) P() (predicate.ChannelOverrideTempla
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.ChannelOverrideTemplate, 0, n)
+		or := make([]predicate.ChannelModelPrice, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -2557,7 +2926,7 @@ func (i *ChannelOverrideTemplateWhereInput) P() (predicate.ChannelOverrideTempla
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, channeloverridetemplate.Or(or...))
+		predicates = append(predicates, channelmodelprice.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -2567,7 +2936,7 @@ func (i *ChannelOverrideTemplateWhereInput) P() (predicate.ChannelOverrideTempla
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.ChannelOverrideTemplate, 0, n)
+		and := make([]predicate.ChannelModelPrice, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -2575,257 +2944,224 @@ func (i *ChannelOverrideTemplateWhereInput) P() (predicate.ChannelOverrideTempla
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, channeloverridetemplate.And(and...))
+		predicates = append(predicates, channelmodelprice.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, channeloverridetemplate.IDEQ(*i.ID))
+		predicates = append(predicates, channelmodelprice.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, channeloverridetemplate.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, channelmodelprice.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, channeloverridetemplate.IDIn(i.IDIn...))
+		predicates = append(predicates, channelmodelprice.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, channeloverridetemplate.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, channelmodelprice.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, channeloverridetemplate.IDGT(*i.IDGT))
+		predicates = append(predicates, channelmodelprice.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, channeloverridetemplate.IDGTE(*i.IDGTE))
+		predicates = append(predicates, channelmodelprice.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, channeloverridetemplate.IDLT(*i.IDLT))
+		predicates = append(predicates, channelmodelprice.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, channeloverridetemplate.IDLTE(*i.IDLTE))
+		predicates = append(predicates, channelmodelprice.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, channeloverridetemplate.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, channelmodelprice.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, channeloverridetemplate.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, channelmodelprice.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, channeloverridetemplate.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, channelmodelprice.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, channeloverridetemplate.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, channelmodelprice.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, channeloverridetemplate.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, channelmodelprice.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, channeloverridetemplate.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, channelmodelprice.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, channeloverridetemplate.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, channelmodelprice.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, channeloverridetemplate.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, channelmodelprice.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, channeloverridetemplate.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, channelmodelprice.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, channeloverridetemplate.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, channelmodelprice.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, channeloverridetemplate.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, channelmodelprice.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, channeloverridetemplate.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, channelmodelprice.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, channeloverridetemplate.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, channelmodelprice.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, channeloverridetemplate.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, channelmodelprice.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, channeloverridetemplate.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, channelmodelprice.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, channeloverridetemplate.UpdatedAtLTE(*i.UpdatedAtLTE))
-	}
-	if i.UserID != nil {
-		predicates = append(predicates, channeloverridetemplate.UserIDEQ(*i.UserID))
+		predicates = append(predicates, channelmodelprice.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.UserIDNEQ != nil {
-		predicates = append(predicates, channeloverridetemplate.UserIDNEQ(*i.UserIDNEQ))
+	if i.ChannelID != nil {
+		predicates = append(predicates, channelmodelprice.ChannelIDEQ(*i.ChannelID))
 	}
-	if len(i.UserIDIn) > 0 {
-		predicates = append(predicates, channeloverridetemplate.UserIDIn(i.UserIDIn...))
+	if i.ChannelIDNEQ != nil {
+		predicates = append(predicates, channelmodelprice.ChannelIDNEQ(*i.ChannelIDNEQ))
 	}
-	if len(i.UserIDNotIn) > 0 {
-		predicates = append(predicates, channeloverridetemplate.UserIDNotIn(i.UserIDNotIn...))
+	if len(i.ChannelIDIn) > 0 {
+		predicates = append(predicates, channelmodelprice.ChannelIDIn(i.ChannelIDIn...))
 	}
-	if i.UserIDIsNil {
-		predicates = append(predicates, channeloverridetemplate.UserIDIsNil())
+	if len(i.ChannelIDNotIn) > 0 {
+		predicates = append(predicates, channelmodelprice.ChannelIDNotIn(i.ChannelIDNotIn...))
 	}
-	if i.UserIDNotNil {
-		predicates = append(predicates, channeloverridetemplate.UserIDNotNil())
+	if i.ModelID != nil {
+		predicates = append(predicates, channelmodelprice.ModelIDEQ(*i.ModelID))
 	}
-	if i.Name != nil {
-		predicates = append(predicates, channeloverridetemplate.NameEQ(*i.Name))
+	if i.ModelIDNEQ != nil {
+		predicates = append(predicates, channelmodelprice.ModelIDNEQ(*i.ModelIDNEQ))
 	}
-	if i.NameNEQ != nil {
-		predicates = append(predicates, channeloverridetemplate.NameNEQ(*i.NameNEQ))
+	if len(i.ModelIDIn) > 0 {
+		predicates = append(predicates, channelmodelprice.ModelIDIn(i.ModelIDIn...))
 	}
-	if len(i.NameIn) > 0 {
-		predicates = append(predicates, channeloverridetemplate.NameIn(i.NameIn...))
+	if len(i.ModelIDNotIn) > 0 {
+		predicates = append(predicates, channelmodelprice.ModelIDNotIn(i.ModelIDNotIn...))
 	}
-	if len(i.NameNotIn) > 0 {
-		predicates = append(predicates, channeloverridetemplate.NameNotIn(i.NameNotIn...))
+	if i.ModelIDGT != nil {
+		predicates = append(predicates, channelmodelprice.ModelIDGT(*i.ModelIDGT))
 	}
-	if i.NameGT != nil {
-		predicates = append(predicates, channeloverridetemplate.NameGT(*i.NameGT))
+	if i.ModelIDGTE != nil {
+		predicates = append(predicates, channelmodelprice.ModelIDGTE(*i.ModelIDGTE))
 	}
-	if i.NameGTE != nil {
-		predicates = append(predicates, channeloverridetemplate.NameGTE(*i.NameGTE))
+	if i.ModelIDLT != nil {
+		predicates = append(predicates, channelmodelprice.ModelIDLT(*i.ModelIDLT))
 	}
-	if i.NameLT != nil {
-		predicates = append(predicates, channeloverridetemplate.NameLT(*i.NameLT))
+	if i.ModelIDLTE != nil {
+		predicates = append(predicates, channelmodelprice.ModelIDLTE(*i.ModelIDLTE))
 	}
-	if i.NameLTE != nil {
-		predicates = append(predicates, channeloverridetemplate.NameLTE(*i.NameLTE))
+	if i.ModelIDContains != nil {
+		predicates = append(predicates, channelmodelprice.ModelIDContains(*i.ModelIDContains))
 	}
-	if i.NameContains != nil {
-		predicates = append(predicates, channeloverridetemplate.NameContains(*i.NameContains))
+	if i.ModelIDHasPrefix != nil {
+		predicates = append(predicates, channelmodelprice.ModelIDHasPrefix(*i.ModelIDHasPrefix))
 	}
-	if i.NameHasPrefix != nil {
-		predicates = append(predicates, channeloverridetemplate.NameHasPrefix(*i.NameHasPrefix))
+	if i.ModelIDHasSuffix != nil {
+		predicates = append(predicates, channelmodelprice.ModelIDHasSuffix(*i.ModelIDHasSuffix))
 	}
-	if i.NameHasSuffix != nil {
-		predicates = append(predicates, channeloverridetemplate.NameHasSuffix(*i.NameHasSuffix))
+	if i.ModelIDEqualFold != nil {
+		predicates = append(predicates, channelmodelprice.ModelIDEqualFold(*i.ModelIDEqualFold))
 	}
-	if i.NameEqualFold != nil {
-		predicates = append(predicates, channeloverridetemplate.NameEqualFold(*i.NameEqualFold))
+	if i.ModelIDContainsFold != nil {
+		predicates = append(predicates, channelmodelprice.ModelIDContainsFold(*i.ModelIDContainsFold))
 	}
-	if i.NameContainsFold != nil {
-		predicates = append(predicates, channeloverridetemplate.NameContainsFold(*i.NameContainsFold))
+	if i.ReferenceID != nil {
+		predicates = append(predicates, channelmodelprice.ReferenceIDEQ(*i.ReferenceID))
 	}
-	if i.Description != nil {
-		predicates = append(predicates, channeloverridetemplate.DescriptionEQ(*i.Description))
+	if i.ReferenceIDNEQ != nil {
+		predicates = append(predicates, channelmodelprice.ReferenceIDNEQ(*i.ReferenceIDNEQ))
 	}
-	if i.DescriptionNEQ != nil {
-		predicates = append(predicates, channeloverridetemplate.DescriptionNEQ(*i.DescriptionNEQ))
+	if len(i.ReferenceIDIn) > 0 {
+		predicates = append(predicates, channelmodelprice.ReferenceIDIn(i.ReferenceIDIn...))
 	}
-	if len(i.DescriptionIn) > 0 {
-		predicates = append(predicates, channeloverridetemplate.DescriptionIn(i.DescriptionIn...))
+	if len(i.ReferenceIDNotIn) > 0 {
+		predicates = append(predicates, channelmodelprice.ReferenceIDNotIn(i.ReferenceIDNotIn...))
 	}
-	if len(i.DescriptionNotIn) > 0 {
-		predicates = append(predicates, channeloverridetemplate.DescriptionNotIn(i.DescriptionNotIn...))
+	if i.ReferenceIDGT != nil {
+		predicates = append(predicates, channelmodelprice.ReferenceIDGT(*i.ReferenceIDGT))
 	}
-	if i.DescriptionGT != nil {
-		predicates = append(predicates, channeloverridetemplate.DescriptionGT(*i.DescriptionGT))
+	if i.ReferenceIDGTE != nil {
+		predicates = append(predicates, channelmodelprice.ReferenceIDGTE(*i.ReferenceIDGTE))
 	}
-	if i.DescriptionGTE != nil {
-		predicates = append(predicates, channeloverridetemplate.DescriptionGTE(*i.DescriptionGTE))
+	if i.ReferenceIDLT != nil {
+		predicates = append(predicates, channelmodelprice.ReferenceIDLT(*i.ReferenceIDLT))
 	}
-	if i.DescriptionLT != nil {
-		predicates = append(predicates, channeloverridetemplate.DescriptionLT(*i.DescriptionLT))
+	if i.ReferenceIDLTE != nil {
+		predicates = append(predicates, channelmodelprice.ReferenceIDLTE(*i.ReferenceIDLTE))
 	}
-	if i.DescriptionLTE != nil {
-		predicates = append(predicates, channeloverridetemplate.DescriptionLTE(*i.DescriptionLTE))
+	if i.ReferenceIDContains != nil {
+		predicates = append(predicates, channelmodelprice.ReferenceIDContains(*i.ReferenceIDContains))
 	}
-	if i.DescriptionContains != nil {
-		predicates = append(predicates, channeloverridetemplate.DescriptionContains(*i.DescriptionContains))
+	if i.ReferenceIDHasPrefix != nil {
+		predicates = append(predicates, channelmodelprice.ReferenceIDHasPrefix(*i.ReferenceIDHasPrefix))
 	}
-	if i.DescriptionHasPrefix != nil {
-		predicates = append(predicates, channeloverridetemplate.DescriptionHasPrefix(*i.DescriptionHasPrefix))
-	}
-	if i.DescriptionHasSuffix != nil {
-		predicates = append(predicates, channeloverridetemplate.DescriptionHasSuffix(*i.DescriptionHasSuffix))
-	}
-	if i.DescriptionIsNil {
-		predicates = append(predicates, channeloverridetemplate.DescriptionIsNil())
-	}
-	if i.DescriptionNotNil {
-		predicates = append(predicates, channeloverridetemplate.DescriptionNotNil())
-	}
-	if i.DescriptionEqualFold != nil {
-		predicates = append(predicates, channeloverridetemplate.DescriptionEqualFold(*i.DescriptionEqualFold))
-	}
-	if i.DescriptionContainsFold != nil {
-		predicates = append(predicates, channeloverridetemplate.DescriptionContainsFold(*i.DescriptionContainsFold))
-	}
-	if i.OverrideParameters != nil {
-		predicates = append(predicates, channeloverridetemplate.OverrideParametersEQ(*i.OverrideParameters))
-	}
-	if i.OverrideParametersNEQ != nil {
-		predicates = append(predicates, channeloverridetemplate.OverrideParametersNEQ(*i.OverrideParametersNEQ))
-	}
-	if len(i.OverrideParametersIn) > 0 {
-		predicates = append(predicates, channeloverridetemplate.OverrideParametersIn(i.OverrideParametersIn...))
-	}
-	if len(i.OverrideParametersNotIn) > 0 {
-		predicates = append(predicates, channeloverridetemplate.OverrideParametersNotIn(i.OverrideParametersNotIn...))
-	}
-	if i.OverrideParametersGT != nil {
-		predicates = append(predicates, channeloverridetemplate.OverrideParametersGT(*i.OverrideParametersGT))
-	}
-	if i.OverrideParametersGTE != nil {
-		predicates = append(predicates, channeloverridetemplate.OverrideParametersGTE(*i.OverrideParametersGTE))
-	}
-	if i.OverrideParametersLT != nil {
-		predicates = append(predicates, channeloverridetemplate.OverrideParametersLT(*i.OverrideParametersLT))
-	}
-	if i.OverrideParametersLTE != nil {
-		predicates = append(predicates, channeloverridetemplate.OverrideParametersLTE(*i.OverrideParametersLTE))
-	}
-	if i.OverrideParametersContains != nil {
-		predicates = append(predicates, channeloverridetemplate.OverrideParametersContains(*i.OverrideParametersContains))
+	if i.ReferenceIDHasSuffix != nil {
+		predicates = append(predicates, channelmodelprice.ReferenceIDHasSuffix(*i.ReferenceIDHasSuffix))
 	}
-	if i.OverrideParametersHasPrefix != nil {
-		predicates = append(predicates, channeloverridetemplate.OverrideParametersHasPrefix(*i.OverrideParametersHasPrefix))
+	if i.ReferenceIDEqualFold != nil {
+		predicates = append(predicates, channelmodelprice.ReferenceIDEqualFold(*i.ReferenceIDEqualFold))
 	}
-	if i.OverrideParametersHasSuffix != nil {
-		predicates = append(predicates, channeloverridetemplate.OverrideParametersHasSuffix(*i.OverrideParametersHasSuffix))
+	if i.ReferenceIDContainsFold != nil {
+		predicates = append(predicates, channelmodelprice.ReferenceIDContainsFold(*i.ReferenceIDContainsFold))
 	}
-	if i.OverrideParametersEqualFold != nil {
-		predicates = append(predicates, channeloverridetemplate.OverrideParametersEqualFold(*i.OverrideParametersEqualFold))
+
+	if i.HasChannel != nil {
+		p := channelmodelprice.HasChannel()
+		if !*i.HasChannel {
+			p = channelmodelprice.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.OverrideParametersContainsFold != nil {
-		predicates = append(predicates, channeloverridetemplate.OverrideParametersContainsFold(*i.OverrideParametersContainsFold))
+	if len(i.HasChannelWith) > 0 {
+		with := make([]predicate.Channel, 0, len(i.HasChannelWith))
+		for _, w := range i.HasChannelWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasChannelWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, channelmodelprice.HasChannelWith(with...))
 	}
-
-	if i.HasUser != nil {
-		p := channeloverridetemplate.HasUser()
-		if !*i.HasUser {
-			p = channeloverridetemplate.Not(p)
+	if i.HasVersions != nil {
+		p := channelmodelprice.HasVersions()
+		if !*i.HasVersions {
+			p = channelmodelprice.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasUserWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasUserWith))
-		for _, w := range i.HasUserWith {
+	if len(i.HasVersionsWith) > 0 {
+		with := make([]predicate.ChannelModelPriceVersion, 0, len(i.HasVersionsWith))
+		for _, w := range i.HasVersionsWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasVersionsWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, channeloverridetemplate.HasUserWith(with...))
+		predicates = append(predicates, channelmodelprice.HasVersionsWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyChannelOverrideTemplateWhereInput
+		return nil, ErrEmptyChannelModelPriceWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return channeloverridetemplate.And(predicates...), nil
+		return channelmodelprice.And(predicates...), nil
 	}
 }
 
-// ChannelProbeWhereInput represents a where input for filtering ChannelProbe queries.
-type ChannelProbeWhereInput struct {
-	Predicates []predicate.ChannelProbe  `json:"-"`
-	Not        *ChannelProbeWhereInput   `json:"not,omitempty"`
-	Or         []*ChannelProbeWhereInput `json:"or,omitempty"`
-	And        []*ChannelProbeWhereInput `json:"and,omitempty"`
+// ChannelModelPriceVersionWhereInput represents a where input for filtering ChannelModelPriceVersion queries.
+type ChannelModelPriceVersionWhereInput struct {
+	Predicates []predicate.ChannelModelPriceVersion  `json:"-"`
+	Not        *ChannelModelPriceVersionWhereInput   `json:"not,omitempty"`
+	Or         []*ChannelModelPriceVersionWhereInput `json:"or,omitempty"`
+	And        []*ChannelModelPriceVersionWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -2837,84 +3173,118 @@ type ChannelProbeWhereInput struct {
 	IDLT    *int  `json:"idLT,omitempty"`
 	IDLTE   *int  `json:"idLTE,omitempty"`
 
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
 	// "channel_id" field predicates.
 	ChannelID      *int  `json:"channelID,omitempty"`
 	ChannelIDNEQ   *int  `json:"channelIDNEQ,omitempty"`
 	ChannelIDIn    []int `json:"channelIDIn,omitempty"`
 	ChannelIDNotIn []int `json:"channelIDNotIn,omitempty"`
+	ChannelIDGT    *int  `json:"channelIDGT,omitempty"`
+	ChannelIDGTE   *int  `json:"channelIDGTE,omitempty"`
+	ChannelIDLT    *int  `json:"channelIDLT,omitempty"`
+	ChannelIDLTE   *int  `json:"channelIDLTE,omitempty"`
 
-	// "total_request_count" field predicates.
-	TotalRequestCount      *int  `json:"totalRequestCount,omitempty"`
-	TotalRequestCountNEQ   *int  `json:"totalRequestCountNEQ,omitempty"`
-	TotalRequestCountIn    []int `json:"totalRequestCountIn,omitempty"`
-	TotalRequestCountNotIn []int `json:"totalRequestCountNotIn,omitempty"`
-	TotalRequestCountGT    *int  `json:"totalRequestCountGT,omitempty"`
-	TotalRequestCountGTE   *int  `json:"totalRequestCountGTE,omitempty"`
-	TotalRequestCountLT    *int  `json:"totalRequestCountLT,omitempty"`
-	TotalRequestCountLTE   *int  `json:"totalRequestCountLTE,omitempty"`
+	// "model_id" field predicates.
+	ModelID             *string  `json:"modelID,omitempty"`
+	ModelIDNEQ          *string  `json:"modelIDNEQ,omitempty"`
+	ModelIDIn           []string `json:"modelIDIn,omitempty"`
+	ModelIDNotIn        []string `json:"modelIDNotIn,omitempty"`
+	ModelIDGT           *string  `json:"modelIDGT,omitempty"`
+	ModelIDGTE          *string  `json:"modelIDGTE,omitempty"`
+	ModelIDLT           *string  `json:"modelIDLT,omitempty"`
+	ModelIDLTE          *string  `json:"modelIDLTE,omitempty"`
+	ModelIDContains     *string  `json:"modelIDContains,omitempty"`
+	ModelIDHasPrefix    *string  `json:"modelIDHasPrefix,omitempty"`
+	ModelIDHasSuffix    *string  `json:"modelIDHasSuffix,omitempty"`
+	ModelIDEqualFold    *string  `json:"modelIDEqualFold,omitempty"`
+	ModelIDContainsFold *string  `json:"modelIDContainsFold,omitempty"`
 
-	// "success_request_count" field predicates.
-	SuccessRequestCount      *int  `json:"successRequestCount,omitempty"`
-	SuccessRequestCountNEQ   *int  `json:"successRequestCountNEQ,omitempty"`
-	SuccessRequestCountIn    []int `json:"successRequestCountIn,omitempty"`
-	SuccessRequestCountNotIn []int `json:"successRequestCountNotIn,omitempty"`
-	SuccessRequestCountGT    *int  `json:"successRequestCountGT,omitempty"`
-	SuccessRequestCountGTE   *int  `json:"successRequestCountGTE,omitempty"`
-	SuccessRequestCountLT    *int  `json:"successRequestCountLT,omitempty"`
-	SuccessRequestCountLTE   *int  `json:"successRequestCountLTE,omitempty"`
+	// "channel_model_price_id" field predicates.
+	ChannelModelPriceID      *int  `json:"channelModelPriceID,omitempty"`
+	ChannelModelPriceIDNEQ   *int  `json:"channelModelPriceIDNEQ,omitempty"`
+	ChannelModelPriceIDIn    []int `json:"channelModelPriceIDIn,omitempty"`
+	ChannelModelPriceIDNotIn []int `json:"channelModelPriceIDNotIn,omitempty"`
 
-	// "avg_tokens_per_second" field predicates.
-	AvgTokensPerSecond       *float64  `json:"avgTokensPerSecond,omitempty"`
-	AvgTokensPerSecondNEQ    *float64  `json:"avgTokensPerSecondNEQ,omitempty"`
-	AvgTokensPerSecondIn     []float64 `json:"avgTokensPerSecondIn,omitempty"`
-	AvgTokensPerSecondNotIn  []float64 `json:"avgTokensPerSecondNotIn,omitempty"`
-	AvgTokensPerSecondGT     *float64  `json:"avgTokensPerSecondGT,omitempty"`
-	AvgTokensPerSecondGTE    *float64  `json:"avgTokensPerSecondGTE,omitempty"`
-	AvgTokensPerSecondLT     *float64  `json:"avgTokensPerSecondLT,omitempty"`
-	AvgTokensPerSecondLTE    *float64  `json:"avgTokensPerSecondLTE,omitempty"`
-	AvgTokensPerSecondIsNil  bool      `json:"avgTokensPerSecondIsNil,omitempty"`
-	AvgTokensPerSecondNotNil bool      `json:"avgTokensPerSecondNotNil,omitempty"`
+	// "status" field predicates.
+	Status      *channelmodelpriceversion.Status  `json:"status,omitempty"`
+	StatusNEQ   *channelmodelpriceversion.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []channelmodelpriceversion.Status `json:"statusIn,omitempty"`
+	StatusNotIn []channelmodelpriceversion.Status `json:"statusNotIn,omitempty"`
 
-	// "avg_time_to_first_token_ms" field predicates.
-	AvgTimeToFirstTokenMs       *float64  `json:"avgTimeToFirstTokenMs,omitempty"`
-	AvgTimeToFirstTokenMsNEQ    *float64  `json:"avgTimeToFirstTokenMsNEQ,omitempty"`
-	AvgTimeToFirstTokenMsIn     []float64 `json:"avgTimeToFirstTokenMsIn,omitempty"`
-	AvgTimeToFirstTokenMsNotIn  []float64 `json:"avgTimeToFirstTokenMsNotIn,omitempty"`
-	AvgTimeToFirstTokenMsGT     *float64  `json:"avgTimeToFirstTokenMsGT,omitempty"`
-	AvgTimeToFirstTokenMsGTE    *float64  `json:"avgTimeToFirstTokenMsGTE,omitempty"`
-	AvgTimeToFirstTokenMsLT     *float64  `json:"avgTimeToFirstTokenMsLT,omitempty"`
-	AvgTimeToFirstTokenMsLTE    *float64  `json:"avgTimeToFirstTokenMsLTE,omitempty"`
-	AvgTimeToFirstTokenMsIsNil  bool      `json:"avgTimeToFirstTokenMsIsNil,omitempty"`
-	AvgTimeToFirstTokenMsNotNil bool      `json:"avgTimeToFirstTokenMsNotNil,omitempty"`
+	// "effective_start_at" field predicates.
+	EffectiveStartAt      *time.Time  `json:"effectiveStartAt,omitempty"`
+	EffectiveStartAtNEQ   *time.Time  `json:"effectiveStartAtNEQ,omitempty"`
+	EffectiveStartAtIn    []time.Time `json:"effectiveStartAtIn,omitempty"`
+	EffectiveStartAtNotIn []time.Time `json:"effectiveStartAtNotIn,omitempty"`
+	EffectiveStartAtGT    *time.Time  `json:"effectiveStartAtGT,omitempty"`
+	EffectiveStartAtGTE   *time.Time  `json:"effectiveStartAtGTE,omitempty"`
+	EffectiveStartAtLT    *time.Time  `json:"effectiveStartAtLT,omitempty"`
+	EffectiveStartAtLTE   *time.Time  `json:"effectiveStartAtLTE,omitempty"`
 
-	// "timestamp" field predicates.
-	Timestamp      *int64  `json:"timestamp,omitempty"`
-	TimestampNEQ   *int64  `json:"timestampNEQ,omitempty"`
-	TimestampIn    []int64 `json:"timestampIn,omitempty"`
-	TimestampNotIn []int64 `json:"timestampNotIn,omitempty"`
-	TimestampGT    *int64  `json:"timestampGT,omitempty"`
-	TimestampGTE   *int64  `json:"timestampGTE,omitempty"`
-	TimestampLT    *int64  `json:"timestampLT,omitempty"`
-	TimestampLTE   *int64  `json:"timestampLTE,omitempty"`
+	// "effective_end_at" field predicates.
+	EffectiveEndAt       *time.Time  `json:"effectiveEndAt,omitempty"`
+	EffectiveEndAtNEQ    *time.Time  `json:"effectiveEndAtNEQ,omitempty"`
+	EffectiveEndAtIn     []time.Time `json:"effectiveEndAtIn,omitempty"`
+	EffectiveEndAtNotIn  []time.Time `json:"effectiveEndAtNotIn,omitempty"`
+	EffectiveEndAtGT     *time.Time  `json:"effectiveEndAtGT,omitempty"`
+	EffectiveEndAtGTE    *time.Time  `json:"effectiveEndAtGTE,omitempty"`
+	EffectiveEndAtLT     *time.Time  `json:"effectiveEndAtLT,omitempty"`
+	EffectiveEndAtLTE    *time.Time  `json:"effectiveEndAtLTE,omitempty"`
+	EffectiveEndAtIsNil  bool        `json:"effectiveEndAtIsNil,omitempty"`
+	EffectiveEndAtNotNil bool        `json:"effectiveEndAtNotNil,omitempty"`
 
-	// "channel" edge predicates.
-	HasChannel     *bool                `json:"hasChannel,omitempty"`
-	HasChannelWith []*ChannelWhereInput `json:"hasChannelWith,omitempty"`
+	// "reference_id" field predicates.
+	ReferenceID             *string  `json:"referenceID,omitempty"`
+	ReferenceIDNEQ          *string  `json:"referenceIDNEQ,omitempty"`
+	ReferenceIDIn           []string `json:"referenceIDIn,omitempty"`
+	ReferenceIDNotIn        []string `json:"referenceIDNotIn,omitempty"`
+	ReferenceIDGT           *string  `json:"referenceIDGT,omitempty"`
+	ReferenceIDGTE          *string  `json:"referenceIDGTE,omitempty"`
+	ReferenceIDLT           *string  `json:"referenceIDLT,omitempty"`
+	ReferenceIDLTE          *string  `json:"referenceIDLTE,omitempty"`
+	ReferenceIDContains     *string  `json:"referenceIDContains,omitempty"`
+	ReferenceIDHasPrefix    *string  `json:"referenceIDHasPrefix,omitempty"`
+	ReferenceIDHasSuffix    *string  `json:"referenceIDHasSuffix,omitempty"`
+	ReferenceIDEqualFold    *string  `json:"referenceIDEqualFold,omitempty"`
+	ReferenceIDContainsFold *string  `json:"referenceIDContainsFold,omitempty"`
+
+	// "channel_model_price" edge predicates.
+	HasChannelModelPrice     *bool                          `json:"hasChannelModelPrice,omitempty"`
+	HasChannelModelPriceWith []*ChannelModelPriceWhereInput `json:"hasChannelModelPriceWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *ChannelProbeWhereInput) AddPredicates(predicates ...predicate.ChannelProbe) {
+func (i *ChannelModelPriceVersionWhereInput) AddPredicates(predicates ...predicate.ChannelModelPriceVersion) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the ChannelProbeWhereInput filter on the ChannelProbeQuery builder.
-func (i *ChannelProbeWhereInput) Filter(q *ChannelProbeQuery) (*ChannelProbeQuery, error) {
+// Filter applies the ChannelModelPriceVersionWhereInput filter on the ChannelModelPriceVersionQuery builder.
+func (i *ChannelModelPriceVersionWhereInput) Filter(q *ChannelModelPriceVersionQuery) (*ChannelModelPriceVersionQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyChannelProbeWhereInput {
+		if err == ErrEmptyChannelModelPriceVersionWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -2922,19 +3292,19 @@ func (i *ChannelProbeWhereInput) Filter(q *ChannelProbeQuery) (*ChannelProbeQuer
 	return q.Where(p), nil
 }
 
-// ErrEmptyChannelProbeWhereInput is returned in case the ChannelProbeWhereInput is empty.
-var ErrEmptyChannelProbeWhereInput = errors.New("ent: empty predicate ChannelProbeWhereInput")
+// ErrEmptyChannelModelPriceVersionWhereInput is returned in case the ChannelModelPriceVersionWhereInput is empty.
+var ErrEmptyChannelModelPriceVersionWhereInput = errors.New("ent: empty predicate ChannelModelPriceVersionWhereInput")
 
-// P returns a predicate for filtering channelprobes.
+// P returns a predicate for filtering channelmodelpriceversions.
 // An error is returned if the input is empty or invalid.
-func (i *ChannelProbeWhereInput) P() (predicate.ChannelProbe, error) {
-	var predicates []predicate.ChannelProbe
+func (i *ChannelModelPriceVersionWhereInput) P() (predicate.ChannelModelPriceVersion, error) {
+	var predicates []predicate.ChannelModelPriceVersion
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, channelprobe.Not(p))
+		predicates = append(predicates, channelmodelpriceversion.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -2944,7 +3314,7 @@ func (i *ChannelProbeWhereInput) P() (predicate.ChannelProbe, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.ChannelProbe, 0, n)
+		or := make([]predicate.ChannelModelPriceVersion, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -2952,7 +3322,7 @@ func (i *ChannelProbeWhereInput) P() (predicate.ChannelProbe, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, channelprobe.Or(or...))
+		predicates = append(predicates, channelmodelpriceversion.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -2962,7 +3332,7 @@ func (i *ChannelProbeWhereInput) P() (predicate.ChannelProbe, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.ChannelProbe, 0, n)
+		and := make([]predicate.ChannelModelPriceVersion, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -2970,212 +3340,296 @@ func (i *ChannelProbeWhereInput) P() (predicate.ChannelProbe, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, channelprobe.And(and...))
+		predicates = append(predicates, channelmodelpriceversion.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, channelprobe.IDEQ(*i.ID))
+		predicates = append(predicates, channelmodelpriceversion.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, channelprobe.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, channelmodelpriceversion.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, channelprobe.IDIn(i.IDIn...))
+		predicates = append(predicates, channelmodelpriceversion.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, channelprobe.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, channelmodelpriceversion.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, channelprobe.IDGT(*i.IDGT))
+		predicates = append(predicates, channelmodelpriceversion.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, channelprobe.IDGTE(*i.IDGTE))
+		predicates = append(predicates, channelmodelpriceversion.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, channelprobe.IDLT(*i.IDLT))
+		predicates = append(predicates, channelmodelpriceversion.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, channelprobe.IDLTE(*i.IDLTE))
+		predicates = append(predicates, channelmodelpriceversion.IDLTE(*i.IDLTE))
 	}
-	if i.ChannelID != nil {
-		predicates = append(predicates, channelprobe.ChannelIDEQ(*i.ChannelID))
+	if i.CreatedAt != nil {
+		predicates = append(predicates, channelmodelpriceversion.CreatedAtEQ(*i.CreatedAt))
 	}
-	if i.ChannelIDNEQ != nil {
-		predicates = append(predicates, channelprobe.ChannelIDNEQ(*i.ChannelIDNEQ))
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, channelmodelpriceversion.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if len(i.ChannelIDIn) > 0 {
-		predicates = append(predicates, channelprobe.ChannelIDIn(i.ChannelIDIn...))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if len(i.ChannelIDNotIn) > 0 {
-		predicates = append(predicates, channelprobe.ChannelIDNotIn(i.ChannelIDNotIn...))
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if i.TotalRequestCount != nil {
-		predicates = append(predicates, channelprobe.TotalRequestCountEQ(*i.TotalRequestCount))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, channelmodelpriceversion.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.TotalRequestCountNEQ != nil {
-		predicates = append(predicates, channelprobe.TotalRequestCountNEQ(*i.TotalRequestCountNEQ))
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, channelmodelpriceversion.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if len(i.TotalRequestCountIn) > 0 {
-		predicates = append(predicates, channelprobe.TotalRequestCountIn(i.TotalRequestCountIn...))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, channelmodelpriceversion.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if len(i.TotalRequestCountNotIn) > 0 {
-		predicates = append(predicates, channelprobe.TotalRequestCountNotIn(i.TotalRequestCountNotIn...))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, channelmodelpriceversion.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.TotalRequestCountGT != nil {
-		predicates = append(predicates, channelprobe.TotalRequestCountGT(*i.TotalRequestCountGT))
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, channelmodelpriceversion.UpdatedAtEQ(*i.UpdatedAt))
 	}
-	if i.TotalRequestCountGTE != nil {
-		predicates = append(predicates, channelprobe.TotalRequestCountGTE(*i.TotalRequestCountGTE))
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, channelmodelpriceversion.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
-	if i.TotalRequestCountLT != nil {
-		predicates = append(predicates, channelprobe.TotalRequestCountLT(*i.TotalRequestCountLT))
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.UpdatedAtIn(i.UpdatedAtIn...))
 	}
-	if i.TotalRequestCountLTE != nil {
-		predicates = append(predicates, channelprobe.TotalRequestCountLTE(*i.TotalRequestCountLTE))
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
-	if i.SuccessRequestCount != nil {
-		predicates = append(predicates, channelprobe.SuccessRequestCountEQ(*i.SuccessRequestCount))
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, channelmodelpriceversion.UpdatedAtGT(*i.UpdatedAtGT))
 	}
-	if i.SuccessRequestCountNEQ != nil {
-		predicates = append(predicates, channelprobe.SuccessRequestCountNEQ(*i.SuccessRequestCountNEQ))
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, channelmodelpriceversion.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
-	if len(i.SuccessRequestCountIn) > 0 {
-		predicates = append(predicates, channelprobe.SuccessRequestCountIn(i.SuccessRequestCountIn...))
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, channelmodelpriceversion.UpdatedAtLT(*i.UpdatedAtLT))
 	}
-	if len(i.SuccessRequestCountNotIn) > 0 {
-		predicates = append(predicates, channelprobe.SuccessRequestCountNotIn(i.SuccessRequestCountNotIn...))
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, channelmodelpriceversion.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.SuccessRequestCountGT != nil {
-		predicates = append(predicates, channelprobe.SuccessRequestCountGT(*i.SuccessRequestCountGT))
+	if i.ChannelID != nil {
+		predicates = append(predicates, channelmodelpriceversion.ChannelIDEQ(*i.ChannelID))
 	}
-	if i.SuccessRequestCountGTE != nil {
-		predicates = append(predicates, channelprobe.SuccessRequestCountGTE(*i.SuccessRequestCountGTE))
+	if i.ChannelIDNEQ != nil {
+		predicates = append(predicates, channelmodelpriceversion.ChannelIDNEQ(*i.ChannelIDNEQ))
 	}
-	if i.SuccessRequestCountLT != nil {
-		predicates = append(predicates, channelprobe.SuccessRequestCountLT(*i.SuccessRequestCountLT))
+	if len(i.ChannelIDIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.ChannelIDIn(i.ChannelIDIn...))
 	}
-	if i.SuccessRequestCountLTE != nil {
-		predicates = append(predicates, channelprobe.SuccessRequestCountLTE(*i.SuccessRequestCountLTE))
+	if len(i.ChannelIDNotIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.ChannelIDNotIn(i.ChannelIDNotIn...))
 	}
-	if i.AvgTokensPerSecond != nil {
-		predicates = append(predicates, channelprobe.AvgTokensPerSecondEQ(*i.AvgTokensPerSecond))
+	if i.ChannelIDGT != nil {
+		predicates = append(predicates, channelmodelpriceversion.ChannelIDGT(*i.ChannelIDGT))
 	}
-	if i.AvgTokensPerSecondNEQ != nil {
-		predicates = append(predicates, channelprobe.AvgTokensPerSecondNEQ(*i.AvgTokensPerSecondNEQ))
+	if i.ChannelIDGTE != nil {
+		predicates = append(predicates, channelmodelpriceversion.ChannelIDGTE(*i.ChannelIDGTE))
 	}
-	if len(i.AvgTokensPerSecondIn) > 0 {
-		predicates = append(predicates, channelprobe.AvgTokensPerSecondIn(i.AvgTokensPerSecondIn...))
+	if i.ChannelIDLT != nil {
+		predicates = append(predicates, channelmodelpriceversion.ChannelIDLT(*i.ChannelIDLT))
 	}
-	if len(i.AvgTokensPerSecondNotIn) > 0 {
-		predicates = append(predicates, channelprobe.AvgTokensPerSecondNotIn(i.AvgTokensPerSecondNotIn...))
+	if i.ChannelIDLTE != nil {
+		predicates = append(predicates, channelmodelpriceversion.ChannelIDLTE(*i.ChannelIDLTE))
 	}
-	if i.AvgTokensPerSecondGT != nil {
-		predicates = append(predicates, channelprobe.AvgTokensPerSecondGT(*i.AvgTokensPerSecondGT))
+	if i.ModelID != nil {
+		predicates = append(predicates, channelmodelpriceversion.ModelIDEQ(*i.ModelID))
 	}
-	if i.AvgTokensPerSecondGTE != nil {
-		predicates = append(predicates, channelprobe.AvgTokensPerSecondGTE(*i.AvgTokensPerSecondGTE))
+	if i.ModelIDNEQ != nil {
+		predicates = append(predicates, channelmodelpriceversion.ModelIDNEQ(*i.ModelIDNEQ))
 	}
-	if i.AvgTokensPerSecondLT != nil {
-		predicates = append(predicates, channelprobe.AvgTokensPerSecondLT(*i.AvgTokensPerSecondLT))
+	if len(i.ModelIDIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.ModelIDIn(i.ModelIDIn...))
 	}
-	if i.AvgTokensPerSecondLTE != nil {
-		predicates = append(predicates, channelprobe.AvgTokensPerSecondLTE(*i.AvgTokensPerSecondLTE))
+	if len(i.ModelIDNotIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.ModelIDNotIn(i.ModelIDNotIn...))
 	}
-	if i.AvgTokensPerSecondIsNil {
-		predicates = append(predicates, channelprobe.AvgTokensPerSecondIsNil())
+	if i.ModelIDGT != nil {
+		predicates = append(predicates, channelmodelpriceversion.ModelIDGT(*i.ModelIDGT))
 	}
-	if i.AvgTokensPerSecondNotNil {
-		predicates = append(predicates, channelprobe.AvgTokensPerSecondNotNil())
+	if i.ModelIDGTE != nil {
+		predicates = append(predicates, channelmodelpriceversion.ModelIDGTE(*i.ModelIDGTE))
 	}
-	if i.AvgTimeToFirstTokenMs != nil {
-		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsEQ(*i.AvgTimeToFirstTokenMs))
+	if i.ModelIDLT != nil {
+		predicates = append(predicates, channelmodelpriceversion.ModelIDLT(*i.ModelIDLT))
 	}
-	if i.AvgTimeToFirstTokenMsNEQ != nil {
-		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsNEQ(*i.AvgTimeToFirstTokenMsNEQ))
+	if i.ModelIDLTE != nil {
+		predicates = append(predicates, channelmodelpriceversion.ModelIDLTE(*i.ModelIDLTE))
 	}
-	if len(i.AvgTimeToFirstTokenMsIn) > 0 {
-		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsIn(i.AvgTimeToFirstTokenMsIn...))
+	if i.ModelIDContains != nil {
+		predicates = append(predicates, channelmodelpriceversion.ModelIDContains(*i.ModelIDContains))
 	}
-	if len(i.AvgTimeToFirstTokenMsNotIn) > 0 {
-		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsNotIn(i.AvgTimeToFirstTokenMsNotIn...))
+	if i.ModelIDHasPrefix != nil {
+		predicates = append(predicates, channelmodelpriceversion.ModelIDHasPrefix(*i.ModelIDHasPrefix))
 	}
-	if i.AvgTimeToFirstTokenMsGT != nil {
-		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsGT(*i.AvgTimeToFirstTokenMsGT))
+	if i.ModelIDHasSuffix != nil {
+		predicates = append(predicates, channelmodelpriceversion.ModelIDHasSuffix(*i.ModelIDHasSuffix))
 	}
-	if i.AvgTimeToFirstTokenMsGTE != nil {
-		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsGTE(*i.AvgTimeToFirstTokenMsGTE))
+	if i.ModelIDEqualFold != nil {
+		predicates = append(predicates, channelmodelpriceversion.ModelIDEqualFold(*i.ModelIDEqualFold))
 	}
-	if i.AvgTimeToFirstTokenMsLT != nil {
-		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsLT(*i.AvgTimeToFirstTokenMsLT))
+	if i.ModelIDContainsFold != nil {
+		predicates = append(predicates, channelmodelpriceversion.ModelIDContainsFold(*i.ModelIDContainsFold))
 	}
-	if i.AvgTimeToFirstTokenMsLTE != nil {
-		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsLTE(*i.AvgTimeToFirstTokenMsLTE))
+	if i.ChannelModelPriceID != nil {
+		predicates = append(predicates, channelmodelpriceversion.ChannelModelPriceIDEQ(*i.ChannelModelPriceID))
 	}
-	if i.AvgTimeToFirstTokenMsIsNil {
-		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsIsNil())
+	if i.ChannelModelPriceIDNEQ != nil {
+		predicates = append(predicates, channelmodelpriceversion.ChannelModelPriceIDNEQ(*i.ChannelModelPriceIDNEQ))
 	}
-	if i.AvgTimeToFirstTokenMsNotNil {
-		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsNotNil())
+	if len(i.ChannelModelPriceIDIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.ChannelModelPriceIDIn(i.ChannelModelPriceIDIn...))
 	}
-	if i.Timestamp != nil {
-		predicates = append(predicates, channelprobe.TimestampEQ(*i.Timestamp))
+	if len(i.ChannelModelPriceIDNotIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.ChannelModelPriceIDNotIn(i.ChannelModelPriceIDNotIn...))
 	}
-	if i.TimestampNEQ != nil {
-		predicates = append(predicates, channelprobe.TimestampNEQ(*i.TimestampNEQ))
+	if i.Status != nil {
+		predicates = append(predicates, channelmodelpriceversion.StatusEQ(*i.Status))
 	}
-	if len(i.TimestampIn) > 0 {
-		predicates = append(predicates, channelprobe.TimestampIn(i.TimestampIn...))
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, channelmodelpriceversion.StatusNEQ(*i.StatusNEQ))
 	}
-	if len(i.TimestampNotIn) > 0 {
-		predicates = append(predicates, channelprobe.TimestampNotIn(i.TimestampNotIn...))
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.StatusIn(i.StatusIn...))
 	}
-	if i.TimestampGT != nil {
-		predicates = append(predicates, channelprobe.TimestampGT(*i.TimestampGT))
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.StatusNotIn(i.StatusNotIn...))
 	}
-	if i.TimestampGTE != nil {
-		predicates = append(predicates, channelprobe.TimestampGTE(*i.TimestampGTE))
+	if i.EffectiveStartAt != nil {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveStartAtEQ(*i.EffectiveStartAt))
 	}
-	if i.TimestampLT != nil {
-		predicates = append(predicates, channelprobe.TimestampLT(*i.TimestampLT))
+	if i.EffectiveStartAtNEQ != nil {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveStartAtNEQ(*i.EffectiveStartAtNEQ))
 	}
-	if i.TimestampLTE != nil {
-		predicates = append(predicates, channelprobe.TimestampLTE(*i.TimestampLTE))
+	if len(i.EffectiveStartAtIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveStartAtIn(i.EffectiveStartAtIn...))
 	}
-
-	if i.HasChannel != nil {
-		p := channelprobe.HasChannel()
-		if !*i.HasChannel {
-			p = channelprobe.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.EffectiveStartAtNotIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveStartAtNotIn(i.EffectiveStartAtNotIn...))
 	}
-	if len(i.HasChannelWith) > 0 {
-		with := make([]predicate.Channel, 0, len(i.HasChannelWith))
-		for _, w := range i.HasChannelWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasChannelWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, channelprobe.HasChannelWith(with...))
+	if i.EffectiveStartAtGT != nil {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveStartAtGT(*i.EffectiveStartAtGT))
 	}
-	switch len(predicates) {
-	case 0:
-		return nil, ErrEmptyChannelProbeWhereInput
-	case 1:
-		return predicates[0], nil
-	default:
-		return channelprobe.And(predicates...), nil
+	if i.EffectiveStartAtGTE != nil {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveStartAtGTE(*i.EffectiveStartAtGTE))
+	}
+	if i.EffectiveStartAtLT != nil {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveStartAtLT(*i.EffectiveStartAtLT))
+	}
+	if i.EffectiveStartAtLTE != nil {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveStartAtLTE(*i.EffectiveStartAtLTE))
+	}
+	if i.EffectiveEndAt != nil {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtEQ(*i.EffectiveEndAt))
+	}
+	if i.EffectiveEndAtNEQ != nil {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtNEQ(*i.EffectiveEndAtNEQ))
+	}
+	if len(i.EffectiveEndAtIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtIn(i.EffectiveEndAtIn...))
+	}
+	if len(i.EffectiveEndAtNotIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtNotIn(i.EffectiveEndAtNotIn...))
+	}
+	if i.EffectiveEndAtGT != nil {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtGT(*i.EffectiveEndAtGT))
+	}
+	if i.EffectiveEndAtGTE != nil {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtGTE(*i.EffectiveEndAtGTE))
+	}
+	if i.EffectiveEndAtLT != nil {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtLT(*i.EffectiveEndAtLT))
+	}
+	if i.EffectiveEndAtLTE != nil {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtLTE(*i.EffectiveEndAtLTE))
+	}
+	if i.EffectiveEndAtIsNil {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtIsNil())
+	}
+	if i.EffectiveEndAtNotNil {
+		predicates = append(predicates, channelmodelpriceversion.EffectiveEndAtNotNil())
+	}
+	if i.ReferenceID != nil {
+		predicates = append(predicates, channelmodelpriceversion.ReferenceIDEQ(*i.ReferenceID))
+	}
+	if i.ReferenceIDNEQ != nil {
+		predicates = append(predicates, channelmodelpriceversion.ReferenceIDNEQ(*i.ReferenceIDNEQ))
+	}
+	if len(i.ReferenceIDIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.ReferenceIDIn(i.ReferenceIDIn...))
+	}
+	if len(i.ReferenceIDNotIn) > 0 {
+		predicates = append(predicates, channelmodelpriceversion.ReferenceIDNotIn(i.ReferenceIDNotIn...))
+	}
+	if i.ReferenceIDGT != nil {
+		predicates = append(predicates, channelmodelpriceversion.ReferenceIDGT(*i.ReferenceIDGT))
+	}
+	if i.ReferenceIDGTE != nil {
+		predicates = append(predicates, channelmodelpriceversion.ReferenceIDGTE(*i.ReferenceIDGTE))
+	}
+	if i.ReferenceIDLT != nil {
+		predicates = append(predicates, channelmodelpriceversion.ReferenceIDLT(*i.ReferenceIDLT))
+	}
+	if i.ReferenceIDLTE != nil {
+		predicates = append(predicates, channelmodelpriceversion.ReferenceIDLTE(*i.ReferenceIDLTE))
+	}
+	if i.ReferenceIDContains != nil {
+		predicates = append(predicates, channelmodelpriceversion.ReferenceIDContains(*i.ReferenceIDContains))
+	}
+	if i.ReferenceIDHasPrefix != nil {
+		predicates = append(predicates, channelmodelpriceversion.ReferenceIDHasPrefix(*i.ReferenceIDHasPrefix))
+	}
+	if i.ReferenceIDHasSuffix != nil {
+		predicates = append(predicates, channelmodelpriceversion.ReferenceIDHasSuffix(*i.ReferenceIDHasSuffix))
+	}
+	if i.ReferenceIDEqualFold != nil {
+		predicates = append(predicates, channelmodelpriceversion.ReferenceIDEqualFold(*i.ReferenceIDEqualFold))
+	}
+	if i.ReferenceIDContainsFold != nil {
+		predicates = append(predicates, channelmodelpriceversion.ReferenceIDContainsFold(*i.ReferenceIDContainsFold))
+	}
+
+	if i.HasChannelModelPrice != nil {
+		p := channelmodelpriceversion.HasChannelModelPrice()
+		if !*i.HasChannelModelPrice {
+			p = channelmodelpriceversion.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasChannelModelPriceWith) > 0 {
+		with := make([]predicate.ChannelModelPrice, 0, len(i.HasChannelModelPriceWith))
+		for _, w := range i.HasChannelModelPriceWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasChannelModelPriceWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, channelmodelpriceversion.HasChannelModelPriceWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyChannelModelPriceVersionWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return channelmodelpriceversion.And(predicates...), nil
 	}
 }
 
-// DataStorageWhereInput represents a where input for filtering DataStorage queries.
-type DataStorageWhereInput struct {
-	Predicates []predicate.DataStorage  `json:"-"`
-	Not        *DataStorageWhereInput   `json:"not,omitempty"`
-	Or         []*DataStorageWhereInput `json:"or,omitempty"`
-	And        []*DataStorageWhereInput `json:"and,omitempty"`
+// ChannelOverrideTemplateWhereInput represents a where input for filtering ChannelOverrideTemplate queries.
+type ChannelOverrideTemplateWhereInput struct {
+	Predicates []predicate.ChannelOverrideTemplate  `json:"-"`
+	Not        *ChannelOverrideTemplateWhereInput   `json:"not,omitempty"`
+	Or         []*ChannelOverrideTemplateWhereInput `json:"or,omitempty"`
+	And        []*ChannelOverrideTemplateWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -3207,6 +3661,14 @@ type DataStorageWhereInput struct {
 	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
 	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
+	// "user_id" field predicates.
+	UserID       *int  `json:"userID,omitempty"`
+	UserIDNEQ    *int  `json:"userIDNEQ,omitempty"`
+	UserIDIn     []int `json:"userIDIn,omitempty"`
+	UserIDNotIn  []int `json:"userIDNotIn,omitempty"`
+	UserIDIsNil  bool  `json:"userIDIsNil,omitempty"`
+	UserIDNotNil bool  `json:"userIDNotNil,omitempty"`
+
 	// "name" field predicates.
 	Name             *string  `json:"name,omitempty"`
 	NameNEQ          *string  `json:"nameNEQ,omitempty"`
@@ -3234,47 +3696,44 @@ type DataStorageWhereInput struct {
 	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
 	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
 	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionIsNil        bool     `json:"descriptionIsNil,omitempty"`
+	DescriptionNotNil       bool     `json:"descriptionNotNil,omitempty"`
 	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
 	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
 
-	// "primary" field predicates.
-	Primary    *bool `json:"primary,omitempty"`
-	PrimaryNEQ *bool `json:"primaryNEQ,omitempty"`
-
-	// "type" field predicates.
-	Type      *datastorage.Type  `json:"type,omitempty"`
-	TypeNEQ   *datastorage.Type  `json:"typeNEQ,omitempty"`
-	TypeIn    []datastorage.Type `json:"typeIn,omitempty"`
-	TypeNotIn []datastorage.Type `json:"typeNotIn,omitempty"`
-
-	// "status" field predicates.
-	Status      *datastorage.Status  `json:"status,omitempty"`
-	StatusNEQ   *datastorage.Status  `json:"statusNEQ,omitempty"`
-	StatusIn    []datastorage.Status `json:"statusIn,omitempty"`
-	StatusNotIn []datastorage.Status `json:"statusNotIn,omitempty"`
-
-	// "requests" edge predicates.
-	HasRequests     *bool                `json:"hasRequests,omitempty"`
-	HasRequestsWith []*RequestWhereInput `json:"hasRequestsWith,omitempty"`
+	// "override_parameters" field predicates.
+	OverrideParameters             *string  `json:"overrideParameters,omitempty"`
+	OverrideParametersNEQ          *string  `json:"overrideParametersNEQ,omitempty"`
+	OverrideParametersIn           []string `json:"overrideParametersIn,omitempty"`
+	OverrideParametersNotIn        []string `json:"overrideParametersNotIn,omitempty"`
+	OverrideParametersGT           *string  `json:"overrideParametersGT,omitempty"`
+	OverrideParametersGTE          *string  `json:"overrideParametersGTE,omitempty"`
+	OverrideParametersLT           *string  `json:"overrideParametersLT,omitempty"`
+	OverrideParametersLTE          *string  `json:"overrideParametersLTE,omitempty"`
+	OverrideParametersContains     *string  `json:"overrideParametersContains,omitempty"`
+	OverrideParametersHasPrefix    *string  `json:"overrideParametersHasPrefix,omitempty"`
+	OverrideParametersHasSuffix    *string  `json:"overrideParametersHasSuffix,omitempty"`
+	OverrideParametersEqualFold    *string  `json:"overrideParametersEqualFold,omitempty"`
+	OverrideParametersContainsFold *string  `json:"overrideParametersContainsFold,omitempty"`
 
-	// "executions" edge predicates.
-	HasExecutions     *bool                         `json:"hasExecutions,omitempty"`
-	HasExecutionsWith []*RequestExecutionWhereInput `json:"hasExecutionsWith,omitempty"`
+	// "user" edge predicates.
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *DataStorageWhereInput) AddPredicates(predicates ...predicate.DataStorage) {
+func (i *ChannelOverrideTemplateWhereInput) AddPredicates(predicates ...predicate.ChannelOverrideTemplate) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the DataStorageWhereInput filter on the DataStorageQuery builder.
-func (i *DataStorageWhereInput) Filter(q *DataStorageQuery) (*DataStorageQuery, error) {
+// Filter applies the ChannelOverrideTemplateWhereInput filter on the ChannelOverrideTemplateQuery builder.
+func (i *ChannelOverrideTemplateWhereInput) Filter(q *ChannelOverrideTemplateQuery) (*ChannelOverrideTemplateQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyDataStorageWhereInput {
+		if err == ErrEmptyChannelOverrideTemplateWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -3282,19 +3741,19 @@ func (i *DataStorageWhereInput) Filter(q *DataStorageQuery) (*DataStorageQuery,
 	return q.Where(p), nil
 }
 
-// ErrEmptyDataStorageWhereInput is returned in case the DataStorageWhereInput is empty.
-var ErrEmptyDataStorageWhereInput = errors.New("ent: empty predicate DataStorageWhereInput")
+// ErrEmptyChannelOverrideTemplateWhereInput is returned in case the ChannelOverrideTemplateWhereInput is empty.
+var ErrEmptyChannelOverrideTemplateWhereInput = errors.New("ent: empty predicate ChannelOverrideTemplateWhereInput")
 
-// P returns a predicate for filtering datastorages.
+// P returns a predicate for filtering channeloverridetemplates.
 // An error is returned if the input is empty or invalid.
-func (i *DataStorageWhereInput) P() (predicate.DataStorage, error) {
-	var predicates []predicate.DataStorage
+func (i *ChannelOverrideTemplateWhereInput) P() (predicate.ChannelOverrideTemplate, error) {
+	var predicates []predicate.ChannelOverrideTemplate
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, datastorage.Not(p))
+		predicates = append(predicates, channeloverridetemplate.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -3304,7 +3763,7 @@ func (i *DataStorageWhereInput) P() (predicate.DataStorage, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.DataStorage, 0, n)
+		or := make([]predicate.ChannelOverrideTemplate, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -3312,7 +3771,7 @@ func (i *DataStorageWhereInput) P() (predicate.DataStorage, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, datastorage.Or(or...))
+		predicates = append(predicates, channeloverridetemplate.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -3322,7 +3781,7 @@ func (i *DataStorageWhereInput) P() (predicate.DataStorage, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.DataStorage, 0, n)
+		and := make([]predicate.ChannelOverrideTemplate, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -3330,242 +3789,257 @@ func (i *DataStorageWhereInput) P() (predicate.DataStorage, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, datastorage.And(and...))
+		predicates = append(predicates, channeloverridetemplate.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, datastorage.IDEQ(*i.ID))
+		predicates = append(predicates, channeloverridetemplate.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, datastorage.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, channeloverridetemplate.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, datastorage.IDIn(i.IDIn...))
+		predicates = append(predicates, channeloverridetemplate.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, datastorage.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, channeloverridetemplate.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, datastorage.IDGT(*i.IDGT))
+		predicates = append(predicates, channeloverridetemplate.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, datastorage.IDGTE(*i.IDGTE))
+		predicates = append(predicates, channeloverridetemplate.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, datastorage.IDLT(*i.IDLT))
+		predicates = append(predicates, channeloverridetemplate.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, datastorage.IDLTE(*i.IDLTE))
+		predicates = append(predicates, channeloverridetemplate.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, datastorage.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, channeloverridetemplate.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, datastorage.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, channeloverridetemplate.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, datastorage.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, channeloverridetemplate.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, datastorage.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, channeloverridetemplate.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, datastorage.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, channeloverridetemplate.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, datastorage.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, channeloverridetemplate.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, datastorage.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, channeloverridetemplate.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, datastorage.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, channeloverridetemplate.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, datastorage.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, channeloverridetemplate.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, datastorage.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, channeloverridetemplate.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, datastorage.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, channeloverridetemplate.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, datastorage.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, channeloverridetemplate.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, datastorage.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, channeloverridetemplate.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, datastorage.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, channeloverridetemplate.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, datastorage.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, channeloverridetemplate.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, datastorage.UpdatedAtLTE(*i.UpdatedAtLTE))
-	}
-	if i.Name != nil {
-		predicates = append(predicates, datastorage.NameEQ(*i.Name))
+		predicates = append(predicates, channeloverridetemplate.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.NameNEQ != nil {
-		predicates = append(predicates, datastorage.NameNEQ(*i.NameNEQ))
+	if i.UserID != nil {
+		predicates = append(predicates, channeloverridetemplate.UserIDEQ(*i.UserID))
 	}
-	if len(i.NameIn) > 0 {
-		predicates = append(predicates, datastorage.NameIn(i.NameIn...))
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, channeloverridetemplate.UserIDNEQ(*i.UserIDNEQ))
 	}
-	if len(i.NameNotIn) > 0 {
-		predicates = append(predicates, datastorage.NameNotIn(i.NameNotIn...))
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, channeloverridetemplate.UserIDIn(i.UserIDIn...))
+	}
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, channeloverridetemplate.UserIDNotIn(i.UserIDNotIn...))
+	}
+	if i.UserIDIsNil {
+		predicates = append(predicates, channeloverridetemplate.UserIDIsNil())
+	}
+	if i.UserIDNotNil {
+		predicates = append(predicates, channeloverridetemplate.UserIDNotNil())
+	}
+	if i.Name != nil {
+		predicates = append(predicates, channeloverridetemplate.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, channeloverridetemplate.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, channeloverridetemplate.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, channeloverridetemplate.NameNotIn(i.NameNotIn...))
 	}
 	if i.NameGT != nil {
-		predicates = append(predicates, datastorage.NameGT(*i.NameGT))
+		predicates = append(predicates, channeloverridetemplate.NameGT(*i.NameGT))
 	}
 	if i.NameGTE != nil {
-		predicates = append(predicates, datastorage.NameGTE(*i.NameGTE))
+		predicates = append(predicates, channeloverridetemplate.NameGTE(*i.NameGTE))
 	}
 	if i.NameLT != nil {
-		predicates = append(predicates, datastorage.NameLT(*i.NameLT))
+		predicates = append(predicates, channeloverridetemplate.NameLT(*i.NameLT))
 	}
 	if i.NameLTE != nil {
-		predicates = append(predicates, datastorage.NameLTE(*i.NameLTE))
+		predicates = append(predicates, channeloverridetemplate.NameLTE(*i.NameLTE))
 	}
 	if i.NameContains != nil {
-		predicates = append(predicates, datastorage.NameContains(*i.NameContains))
+		predicates = append(predicates, channeloverridetemplate.NameContains(*i.NameContains))
 	}
 	if i.NameHasPrefix != nil {
-		predicates = append(predicates, datastorage.NameHasPrefix(*i.NameHasPrefix))
+		predicates = append(predicates, channeloverridetemplate.NameHasPrefix(*i.NameHasPrefix))
 	}
 	if i.NameHasSuffix != nil {
-		predicates = append(predicates, datastorage.NameHasSuffix(*i.NameHasSuffix))
+		predicates = append(predicates, channeloverridetemplate.NameHasSuffix(*i.NameHasSuffix))
 	}
 	if i.NameEqualFold != nil {
-		predicates = append(predicates, datastorage.NameEqualFold(*i.NameEqualFold))
+		predicates = append(predicates, channeloverridetemplate.NameEqualFold(*i.NameEqualFold))
 	}
 	if i.NameContainsFold != nil {
-		predicates = append(predicates, datastorage.NameContainsFold(*i.NameContainsFold))
+		predicates = append(predicates, channeloverridetemplate.NameContainsFold(*i.NameContainsFold))
 	}
 	if i.Description != nil {
-		predicates = append(predicates, datastorage.DescriptionEQ(*i.Description))
+		predicates = append(predicates, channeloverridetemplate.DescriptionEQ(*i.Description))
 	}
 	if i.DescriptionNEQ != nil {
-		predicates = append(predicates, datastorage.DescriptionNEQ(*i.DescriptionNEQ))
+		predicates = append(predicates, channeloverridetemplate.DescriptionNEQ(*i.DescriptionNEQ))
 	}
 	if len(i.DescriptionIn) > 0 {
-		predicates = append(predicates, datastorage.DescriptionIn(i.DescriptionIn...))
+		predicates = append(predicates, channeloverridetemplate.DescriptionIn(i.DescriptionIn...))
 	}
 	if len(i.DescriptionNotIn) > 0 {
-		predicates = append(predicates, datastorage.DescriptionNotIn(i.DescriptionNotIn...))
+		predicates = append(predicates, channeloverridetemplate.DescriptionNotIn(i.DescriptionNotIn...))
 	}
 	if i.DescriptionGT != nil {
-		predicates = append(predicates, datastorage.DescriptionGT(*i.DescriptionGT))
+		predicates = append(predicates, channeloverridetemplate.DescriptionGT(*i.DescriptionGT))
 	}
 	if i.DescriptionGTE != nil {
-		predicates = append(predicates, datastorage.DescriptionGTE(*i.DescriptionGTE))
+		predicates = append(predicates, channeloverridetemplate.DescriptionGTE(*i.DescriptionGTE))
 	}
 	if i.DescriptionLT != nil {
-		predicates = append(predicates, datastorage.DescriptionLT(*i.DescriptionLT))
+		predicates = append(predicates, channeloverridetemplate.DescriptionLT(*i.DescriptionLT))
 	}
 	if i.DescriptionLTE != nil {
-		predicates = append(predicates, datastorage.DescriptionLTE(*i.DescriptionLTE))
+		predicates = append(predicates, channeloverridetemplate.DescriptionLTE(*i.DescriptionLTE))
 	}
 	if i.DescriptionContains != nil {
-		predicates = append(predicates, datastorage.DescriptionContains(*i.DescriptionContains))
+		predicates = append(predicates, channeloverridetemplate.DescriptionContains(*i.DescriptionContains))
 	}
 	if i.DescriptionHasPrefix != nil {
-		predicates = append(predicates, datastorage.DescriptionHasPrefix(*i.DescriptionHasPrefix))
+		predicates = append(predicates, channeloverridetemplate.DescriptionHasPrefix(*i.DescriptionHasPrefix))
 	}
 	if i.DescriptionHasSuffix != nil {
-		predicates = append(predicates, datastorage.DescriptionHasSuffix(*i.DescriptionHasSuffix))
+		predicates = append(predicates, channeloverridetemplate.DescriptionHasSuffix(*i.DescriptionHasSuffix))
+	}
+	if i.DescriptionIsNil {
+		predicates = append(predicates, channeloverridetemplate.DescriptionIsNil())
+	}
+	if i.DescriptionNotNil {
+		predicates = append(predicates, channeloverridetemplate.DescriptionNotNil())
 	}
 	if i.DescriptionEqualFold != nil {
-		predicates = append(predicates, datastorage.DescriptionEqualFold(*i.DescriptionEqualFold))
+		predicates = append(predicates, channeloverridetemplate.DescriptionEqualFold(*i.DescriptionEqualFold))
 	}
 	if i.DescriptionContainsFold != nil {
-		predicates = append(predicates, datastorage.DescriptionContainsFold(*i.DescriptionContainsFold))
+		predicates = append(predicates, channeloverridetemplate.DescriptionContainsFold(*i.DescriptionContainsFold))
 	}
-	if i.Primary != nil {
-		predicates = append(predicates, datastorage.PrimaryEQ(*i.Primary))
+	if i.OverrideParameters != nil {
+		predicates = append(predicates, channeloverridetemplate.OverrideParametersEQ(*i.OverrideParameters))
 	}
-	if i.PrimaryNEQ != nil {
-		predicates = append(predicates, datastorage.PrimaryNEQ(*i.PrimaryNEQ))
+	if i.OverrideParametersNEQ != nil {
+		predicates = append(predicates, channeloverridetemplate.OverrideParametersNEQ(*i.OverrideParametersNEQ))
 	}
-	if i.Type != nil {
-		predicates = append(predicates, datastorage.TypeEQ(*i.Type))
+	if len(i.OverrideParametersIn) > 0 {
+		predicates = append(predicates, channeloverridetemplate.OverrideParametersIn(i.OverrideParametersIn...))
 	}
-	if i.TypeNEQ != nil {
-		predicates = append(predicates, datastorage.TypeNEQ(*i.TypeNEQ))
+	if len(i.OverrideParametersNotIn) > 0 {
+		predicates = append(predicates, channeloverridetemplate.OverrideParametersNotIn(i.OverrideParametersNotIn...))
 	}
-	if len(i.TypeIn) > 0 {
-		predicates = append(predicates, datastorage.TypeIn(i.TypeIn...))
+	if i.OverrideParametersGT != nil {
+		predicates = append(predicates, channeloverridetemplate.OverrideParametersGT(*i.OverrideParametersGT))
 	}
-	if len(i.TypeNotIn) > 0 {
-		predicates = append(predicates, datastorage.TypeNotIn(i.TypeNotIn...))
+	if i.OverrideParametersGTE != nil {
+		predicates = append(predicates, channeloverridetemplate.OverrideParametersGTE(*i.OverrideParametersGTE))
 	}
-	if i.Status != nil {
-		predicates = append(predicates, datastorage.StatusEQ(*i.Status))
+	if i.OverrideParametersLT != nil {
+		predicates = append(predicates, channeloverridetemplate.OverrideParametersLT(*i.OverrideParametersLT))
 	}
-	if i.StatusNEQ != nil {
-		predicates = append(predicates, datastorage.StatusNEQ(*i.StatusNEQ))
+	if i.OverrideParametersLTE != nil {
+		predicates = append(predicates, channeloverridetemplate.OverrideParametersLTE(*i.OverrideParametersLTE))
 	}
-	if len(i.StatusIn) > 0 {
-		predicates = append(predicates, datastorage.StatusIn(i.StatusIn...))
+	if i.OverrideParametersContains != nil {
+		predicates = append(predicates, channeloverridetemplate.OverrideParametersContains(*i.OverrideParametersContains))
 	}
-	if len(i.StatusNotIn) > 0 {
-		predicates = append(predicates, datastorage.StatusNotIn(i.StatusNotIn...))
+	if i.OverrideParametersHasPrefix != nil {
+		predicates = append(predicates, channeloverridetemplate.OverrideParametersHasPrefix(*i.OverrideParametersHasPrefix))
 	}
-
-	if i.HasRequests != nil {
-		p := datastorage.HasRequests()
-		if !*i.HasRequests {
-			p = datastorage.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.OverrideParametersHasSuffix != nil {
+		predicates = append(predicates, channeloverridetemplate.OverrideParametersHasSuffix(*i.OverrideParametersHasSuffix))
 	}
-	if len(i.HasRequestsWith) > 0 {
-		with := make([]predicate.Request, 0, len(i.HasRequestsWith))
-		for _, w := range i.HasRequestsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasRequestsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, datastorage.HasRequestsWith(with...))
+	if i.OverrideParametersEqualFold != nil {
+		predicates = append(predicates, channeloverridetemplate.OverrideParametersEqualFold(*i.OverrideParametersEqualFold))
 	}
-	if i.HasExecutions != nil {
-		p := datastorage.HasExecutions()
-		if !*i.HasExecutions {
-			p = datastorage.Not(p)
+	if i.OverrideParametersContainsFold != nil {
+		predicates = append(predicates, channeloverridetemplate.OverrideParametersContainsFold(*i.OverrideParametersContainsFold))
+	}
+
+	if i.HasUser != nil {
+		p := channeloverridetemplate.HasUser()
+		if !*i.HasUser {
+			p = channeloverridetemplate.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasExecutionsWith) > 0 {
-		with := make([]predicate.RequestExecution, 0, len(i.HasExecutionsWith))
-		for _, w := range i.HasExecutionsWith {
+	if len(i.HasUserWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUserWith))
+		for _, w := range i.HasUserWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasExecutionsWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, datastorage.HasExecutionsWith(with...))
+		predicates = append(predicates, channeloverridetemplate.HasUserWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyDataStorageWhereInput
+		return nil, ErrEmptyChannelOverrideTemplateWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return datastorage.And(predicates...), nil
+		return channeloverridetemplate.And(predicates...), nil
 	}
 }
 
-// ModelWhereInput represents a where input for filtering Model queries.
-type ModelWhereInput struct {
-	Predicates []predicate.Model  `json:"-"`
-	Not        *ModelWhereInput   `json:"not,omitempty"`
-	Or         []*ModelWhereInput `json:"or,omitempty"`
-	And        []*ModelWhereInput `json:"and,omitempty"`
+// ChannelProbeWhereInput represents a where input for filtering ChannelProbe queries.
+type ChannelProbeWhereInput struct {
+	Predicates []predicate.ChannelProbe  `json:"-"`
+	Not        *ChannelProbeWhereInput   `json:"not,omitempty"`
+	Or         []*ChannelProbeWhereInput `json:"or,omitempty"`
+	And        []*ChannelProbeWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -3577,144 +4051,84 @@ type ModelWhereInput struct {
 	IDLT    *int  `json:"idLT,omitempty"`
 	IDLTE   *int  `json:"idLTE,omitempty"`
 
-	// "created_at" field predicates.
-	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
-	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
-	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
-	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
-	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
-	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
-	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
-	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
-
-	// "updated_at" field predicates.
-	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
-	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
-	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
-	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
-	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
-	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
-	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
-	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+	// "channel_id" field predicates.
+	ChannelID      *int  `json:"channelID,omitempty"`
+	ChannelIDNEQ   *int  `json:"channelIDNEQ,omitempty"`
+	ChannelIDIn    []int `json:"channelIDIn,omitempty"`
+	ChannelIDNotIn []int `json:"channelIDNotIn,omitempty"`
 
-	// "developer" field predicates.
-	Developer             *string  `json:"developer,omitempty"`
-	DeveloperNEQ          *string  `json:"developerNEQ,omitempty"`
-	DeveloperIn           []string `json:"developerIn,omitempty"`
-	DeveloperNotIn        []string `json:"developerNotIn,omitempty"`
-	DeveloperGT           *string  `json:"developerGT,omitempty"`
-	DeveloperGTE          *string  `json:"developerGTE,omitempty"`
-	DeveloperLT           *string  `json:"developerLT,omitempty"`
-	DeveloperLTE          *string  `json:"developerLTE,omitempty"`
-	DeveloperContains     *string  `json:"developerContains,omitempty"`
-	DeveloperHasPrefix    *string  `json:"developerHasPrefix,omitempty"`
-	DeveloperHasSuffix    *string  `json:"developerHasSuffix,omitempty"`
-	DeveloperEqualFold    *string  `json:"developerEqualFold,omitempty"`
-	DeveloperContainsFold *string  `json:"developerContainsFold,omitempty"`
+	// "total_request_count" field predicates.
+	TotalRequestCount      *int  `json:"totalRequestCount,omitempty"`
+	TotalRequestCountNEQ   *int  `json:"totalRequestCountNEQ,omitempty"`
+	TotalRequestCountIn    []int `json:"totalRequestCountIn,omitempty"`
+	TotalRequestCountNotIn []int `json:"totalRequestCountNotIn,omitempty"`
+	TotalRequestCountGT    *int  `json:"totalRequestCountGT,omitempty"`
+	TotalRequestCountGTE   *int  `json:"totalRequestCountGTE,omitempty"`
+	TotalRequestCountLT    *int  `json:"totalRequestCountLT,omitempty"`
+	TotalRequestCountLTE   *int  `json:"totalRequestCountLTE,omitempty"`
 
-	// "model_id" field predicates.
-	ModelID             *string  `json:"modelID,omitempty"`
-	ModelIDNEQ          *string  `json:"modelIDNEQ,omitempty"`
-	ModelIDIn           []string `json:"modelIDIn,omitempty"`
-	ModelIDNotIn        []string `json:"modelIDNotIn,omitempty"`
-	ModelIDGT           *string  `json:"modelIDGT,omitempty"`
-	ModelIDGTE          *string  `json:"modelIDGTE,omitempty"`
-	ModelIDLT           *string  `json:"modelIDLT,omitempty"`
-	ModelIDLTE          *string  `json:"modelIDLTE,omitempty"`
-	ModelIDContains     *string  `json:"modelIDContains,omitempty"`
-	ModelIDHasPrefix    *string  `json:"modelIDHasPrefix,omitempty"`
-	ModelIDHasSuffix    *string  `json:"modelIDHasSuffix,omitempty"`
-	ModelIDEqualFold    *string  `json:"modelIDEqualFold,omitempty"`
-	ModelIDContainsFold *string  `json:"modelIDContainsFold,omitempty"`
+	// "success_request_count" field predicates.
+	SuccessRequestCount      *int  `json:"successRequestCount,omitempty"`
+	SuccessRequestCountNEQ   *int  `json:"successRequestCountNEQ,omitempty"`
+	SuccessRequestCountIn    []int `json:"successRequestCountIn,omitempty"`
+	SuccessRequestCountNotIn []int `json:"successRequestCountNotIn,omitempty"`
+	SuccessRequestCountGT    *int  `json:"successRequestCountGT,omitempty"`
+	SuccessRequestCountGTE   *int  `json:"successRequestCountGTE,omitempty"`
+	SuccessRequestCountLT    *int  `json:"successRequestCountLT,omitempty"`
+	SuccessRequestCountLTE   *int  `json:"successRequestCountLTE,omitempty"`
 
-	// "type" field predicates.
-	Type      *model.Type  `json:"type,omitempty"`
-	TypeNEQ   *model.Type  `json:"typeNEQ,omitempty"`
-	TypeIn    []model.Type `json:"typeIn,omitempty"`
-	TypeNotIn []model.Type `json:"typeNotIn,omitempty"`
+	// "avg_tokens_per_second" field predicates.
+	AvgTokensPerSecond       *float64  `json:"avgTokensPerSecond,omitempty"`
+	AvgTokensPerSecondNEQ    *float64  `json:"avgTokensPerSecondNEQ,omitempty"`
+	AvgTokensPerSecondIn     []float64 `json:"avgTokensPerSecondIn,omitempty"`
+	AvgTokensPerSecondNotIn  []float64 `json:"avgTokensPerSecondNotIn,omitempty"`
+	AvgTokensPerSecondGT     *float64  `json:"avgTokensPerSecondGT,omitempty"`
+	AvgTokensPerSecondGTE    *float64  `json:"avgTokensPerSecondGTE,omitempty"`
+	AvgTokensPerSecondLT     *float64  `json:"avgTokensPerSecondLT,omitempty"`
+	AvgTokensPerSecondLTE    *float64  `json:"avgTokensPerSecondLTE,omitempty"`
+	AvgTokensPerSecondIsNil  bool      `json:"avgTokensPerSecondIsNil,omitempty"`
+	AvgTokensPerSecondNotNil bool      `json:"avgTokensPerSecondNotNil,omitempty"`
 
-	// "name" field predicates.
-	Name             *string  `json:"name,omitempty"`
-	NameNEQ          *string  `json:"nameNEQ,omitempty"`
-	NameIn           []string `json:"nameIn,omitempty"`
-	NameNotIn        []string `json:"nameNotIn,omitempty"`
-	NameGT           *string  `json:"nameGT,omitempty"`
-	NameGTE          *string  `json:"nameGTE,omitempty"`
-	NameLT           *string  `json:"nameLT,omitempty"`
-	NameLTE          *string  `json:"nameLTE,omitempty"`
-	NameContains     *string  `json:"nameContains,omitempty"`
-	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
-	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
-	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
-	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
-
-	// "icon" field predicates.
-	Icon             *string  `json:"icon,omitempty"`
-	IconNEQ          *string  `json:"iconNEQ,omitempty"`
-	IconIn           []string `json:"iconIn,omitempty"`
-	IconNotIn        []string `json:"iconNotIn,omitempty"`
-	IconGT           *string  `json:"iconGT,omitempty"`
-	IconGTE          *string  `json:"iconGTE,omitempty"`
-	IconLT           *string  `json:"iconLT,omitempty"`
-	IconLTE          *string  `json:"iconLTE,omitempty"`
-	IconContains     *string  `json:"iconContains,omitempty"`
-	IconHasPrefix    *string  `json:"iconHasPrefix,omitempty"`
-	IconHasSuffix    *string  `json:"iconHasSuffix,omitempty"`
-	IconEqualFold    *string  `json:"iconEqualFold,omitempty"`
-	IconContainsFold *string  `json:"iconContainsFold,omitempty"`
-
-	// "group" field predicates.
-	Group             *string  `json:"group,omitempty"`
-	GroupNEQ          *string  `json:"groupNEQ,omitempty"`
-	GroupIn           []string `json:"groupIn,omitempty"`
-	GroupNotIn        []string `json:"groupNotIn,omitempty"`
-	GroupGT           *string  `json:"groupGT,omitempty"`
-	GroupGTE          *string  `json:"groupGTE,omitempty"`
-	GroupLT           *string  `json:"groupLT,omitempty"`
-	GroupLTE          *string  `json:"groupLTE,omitempty"`
-	GroupContains     *string  `json:"groupContains,omitempty"`
-	GroupHasPrefix    *string  `json:"groupHasPrefix,omitempty"`
-	GroupHasSuffix    *string  `json:"groupHasSuffix,omitempty"`
-	GroupEqualFold    *string  `json:"groupEqualFold,omitempty"`
-	GroupContainsFold *string  `json:"groupContainsFold,omitempty"`
+	// "avg_time_to_first_token_ms" field predicates.
+	AvgTimeToFirstTokenMs       *float64  `json:"avgTimeToFirstTokenMs,omitempty"`
+	AvgTimeToFirstTokenMsNEQ    *float64  `json:"avgTimeToFirstTokenMsNEQ,omitempty"`
+	AvgTimeToFirstTokenMsIn     []float64 `json:"avgTimeToFirstTokenMsIn,omitempty"`
+	AvgTimeToFirstTokenMsNotIn  []float64 `json:"avgTimeToFirstTokenMsNotIn,omitempty"`
+	AvgTimeToFirstTokenMsGT     *float64  `json:"avgTimeToFirstTokenMsGT,omitempty"`
+	AvgTimeToFirstTokenMsGTE    *float64  `json:"avgTimeToFirstTokenMsGTE,omitempty"`
+	AvgTimeToFirstTokenMsLT     *float64  `json:"avgTimeToFirstTokenMsLT,omitempty"`
+	AvgTimeToFirstTokenMsLTE    *float64  `json:"avgTimeToFirstTokenMsLTE,omitempty"`
+	AvgTimeToFirstTokenMsIsNil  bool      `json:"avgTimeToFirstTokenMsIsNil,omitempty"`
+	AvgTimeToFirstTokenMsNotNil bool      `json:"avgTimeToFirstTokenMsNotNil,omitempty"`
 
-	// "status" field predicates.
-	Status      *model.Status  `json:"status,omitempty"`
-	StatusNEQ   *model.Status  `json:"statusNEQ,omitempty"`
-	StatusIn    []model.Status `json:"statusIn,omitempty"`
-	StatusNotIn []model.Status `json:"statusNotIn,omitempty"`
+	// "timestamp" field predicates.
+	Timestamp      *int64  `json:"timestamp,omitempty"`
+	TimestampNEQ   *int64  `json:"timestampNEQ,omitempty"`
+	TimestampIn    []int64 `json:"timestampIn,omitempty"`
+	TimestampNotIn []int64 `json:"timestampNotIn,omitempty"`
+	TimestampGT    *int64  `json:"timestampGT,omitempty"`
+	TimestampGTE   *int64  `json:"timestampGTE,omitempty"`
+	TimestampLT    *int64  `json:"timestampLT,omitempty"`
+	TimestampLTE   *int64  `json:"timestampLTE,omitempty"`
 
-	// "remark" field predicates.
-	Remark             *string  `json:"remark,omitempty"`
-	RemarkNEQ          *string  `json:"remarkNEQ,omitempty"`
-	RemarkIn           []string `json:"remarkIn,omitempty"`
-	RemarkNotIn        []string `json:"remarkNotIn,omitempty"`
-	RemarkGT           *string  `json:"remarkGT,omitempty"`
-	RemarkGTE          *string  `json:"remarkGTE,omitempty"`
-	RemarkLT           *string  `json:"remarkLT,omitempty"`
-	RemarkLTE          *string  `json:"remarkLTE,omitempty"`
-	RemarkContains     *string  `json:"remarkContains,omitempty"`
-	RemarkHasPrefix    *string  `json:"remarkHasPrefix,omitempty"`
-	RemarkHasSuffix    *string  `json:"remarkHasSuffix,omitempty"`
-	RemarkIsNil        bool     `json:"remarkIsNil,omitempty"`
-	RemarkNotNil       bool     `json:"remarkNotNil,omitempty"`
-	RemarkEqualFold    *string  `json:"remarkEqualFold,omitempty"`
-	RemarkContainsFold *string  `json:"remarkContainsFold,omitempty"`
+	// "channel" edge predicates.
+	HasChannel     *bool                `json:"hasChannel,omitempty"`
+	HasChannelWith []*ChannelWhereInput `json:"hasChannelWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *ModelWhereInput) AddPredicates(predicates ...predicate.Model) {
+func (i *ChannelProbeWhereInput) AddPredicates(predicates ...predicate.ChannelProbe) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the ModelWhereInput filter on the ModelQuery builder.
-func (i *ModelWhereInput) Filter(q *ModelQuery) (*ModelQuery, error) {
+// Filter applies the ChannelProbeWhereInput filter on the ChannelProbeQuery builder.
+func (i *ChannelProbeWhereInput) Filter(q *ChannelProbeQuery) (*ChannelProbeQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyModelWhereInput {
+		if err == ErrEmptyChannelProbeWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -3722,19 +4136,19 @@ func (i *ModelWhereInput) Filter(q *ModelQuery) (*ModelQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyModelWhereInput is returned in case the ModelWhereInput is empty.
-var ErrEmptyModelWhereInput = errors.New("ent: empty predicate ModelWhereInput")
+// ErrEmptyChannelProbeWhereInput is returned in case the ChannelProbeWhereInput is empty.
+var ErrEmptyChannelProbeWhereInput = errors.New("ent: empty predicate ChannelProbeWhereInput")
 
-// P returns a predicate for filtering models.
+// P returns a predicate for filtering channelprobes.
 // An error is returned if the input is empty or invalid.
-func (i *ModelWhereInput) P() (predicate.Model, error) {
-	var predicates []predicate.Model
+func (i *ChannelProbeWhereInput) P() (predicate.ChannelProbe, error) {
+	var predicates []predicate.ChannelProbe
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, model.Not(p))
+		predicates = append(predicates, channelprobe.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -3744,7 +4158,7 @@ func (i *ModelWhereInput) P() (predicate.Model, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Model, 0, n)
+		or := make([]predicate.ChannelProbe, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -3752,7 +4166,7 @@ func (i *ModelWhereInput) P() (predicate.Model, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, model.Or(or...))
+		predicates = append(predicates, channelprobe.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -3762,7 +4176,7 @@ func (i *ModelWhereInput) P() (predicate.Model, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Model, 0, n)
+		and := make([]predicate.ChannelProbe, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -3770,362 +4184,868 @@ func (i *ModelWhereInput) P() (predicate.Model, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, model.And(and...))
+		predicates = append(predicates, channelprobe.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, model.IDEQ(*i.ID))
+		predicates = append(predicates, channelprobe.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, model.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, channelprobe.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, model.IDIn(i.IDIn...))
+		predicates = append(predicates, channelprobe.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, model.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, channelprobe.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, model.IDGT(*i.IDGT))
+		predicates = append(predicates, channelprobe.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, model.IDGTE(*i.IDGTE))
+		predicates = append(predicates, channelprobe.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, model.IDLT(*i.IDLT))
+		predicates = append(predicates, channelprobe.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, model.IDLTE(*i.IDLTE))
+		predicates = append(predicates, channelprobe.IDLTE(*i.IDLTE))
 	}
-	if i.CreatedAt != nil {
-		predicates = append(predicates, model.CreatedAtEQ(*i.CreatedAt))
+	if i.ChannelID != nil {
+		predicates = append(predicates, channelprobe.ChannelIDEQ(*i.ChannelID))
 	}
-	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, model.CreatedAtNEQ(*i.CreatedAtNEQ))
+	if i.ChannelIDNEQ != nil {
+		predicates = append(predicates, channelprobe.ChannelIDNEQ(*i.ChannelIDNEQ))
 	}
-	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, model.CreatedAtIn(i.CreatedAtIn...))
+	if len(i.ChannelIDIn) > 0 {
+		predicates = append(predicates, channelprobe.ChannelIDIn(i.ChannelIDIn...))
 	}
-	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, model.CreatedAtNotIn(i.CreatedAtNotIn...))
+	if len(i.ChannelIDNotIn) > 0 {
+		predicates = append(predicates, channelprobe.ChannelIDNotIn(i.ChannelIDNotIn...))
 	}
-	if i.CreatedAtGT != nil {
-		predicates = append(predicates, model.CreatedAtGT(*i.CreatedAtGT))
+	if i.TotalRequestCount != nil {
+		predicates = append(predicates, channelprobe.TotalRequestCountEQ(*i.TotalRequestCount))
 	}
-	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, model.CreatedAtGTE(*i.CreatedAtGTE))
+	if i.TotalRequestCountNEQ != nil {
+		predicates = append(predicates, channelprobe.TotalRequestCountNEQ(*i.TotalRequestCountNEQ))
 	}
-	if i.CreatedAtLT != nil {
-		predicates = append(predicates, model.CreatedAtLT(*i.CreatedAtLT))
+	if len(i.TotalRequestCountIn) > 0 {
+		predicates = append(predicates, channelprobe.TotalRequestCountIn(i.TotalRequestCountIn...))
 	}
-	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, model.CreatedAtLTE(*i.CreatedAtLTE))
+	if len(i.TotalRequestCountNotIn) > 0 {
+		predicates = append(predicates, channelprobe.TotalRequestCountNotIn(i.TotalRequestCountNotIn...))
 	}
-	if i.UpdatedAt != nil {
-		predicates = append(predicates, model.UpdatedAtEQ(*i.UpdatedAt))
+	if i.TotalRequestCountGT != nil {
+		predicates = append(predicates, channelprobe.TotalRequestCountGT(*i.TotalRequestCountGT))
 	}
-	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, model.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	if i.TotalRequestCountGTE != nil {
+		predicates = append(predicates, channelprobe.TotalRequestCountGTE(*i.TotalRequestCountGTE))
 	}
-	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, model.UpdatedAtIn(i.UpdatedAtIn...))
+	if i.TotalRequestCountLT != nil {
+		predicates = append(predicates, channelprobe.TotalRequestCountLT(*i.TotalRequestCountLT))
 	}
-	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, model.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	if i.TotalRequestCountLTE != nil {
+		predicates = append(predicates, channelprobe.TotalRequestCountLTE(*i.TotalRequestCountLTE))
 	}
-	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, model.UpdatedAtGT(*i.UpdatedAtGT))
+	if i.SuccessRequestCount != nil {
+		predicates = append(predicates, channelprobe.SuccessRequestCountEQ(*i.SuccessRequestCount))
 	}
-	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, model.UpdatedAtGTE(*i.UpdatedAtGTE))
+	if i.SuccessRequestCountNEQ != nil {
+		predicates = append(predicates, channelprobe.SuccessRequestCountNEQ(*i.SuccessRequestCountNEQ))
 	}
-	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, model.UpdatedAtLT(*i.UpdatedAtLT))
+	if len(i.SuccessRequestCountIn) > 0 {
+		predicates = append(predicates, channelprobe.SuccessRequestCountIn(i.SuccessRequestCountIn...))
 	}
-	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, model.UpdatedAtLTE(*i.UpdatedAtLTE))
+	if len(i.SuccessRequestCountNotIn) > 0 {
+		predicates = append(predicates, channelprobe.SuccessRequestCountNotIn(i.SuccessRequestCountNotIn...))
 	}
-	if i.Developer != nil {
-		predicates = append(predicates, model.DeveloperEQ(*i.Developer))
+	if i.SuccessRequestCountGT != nil {
+		predicates = append(predicates, channelprobe.SuccessRequestCountGT(*i.SuccessRequestCountGT))
 	}
-	if i.DeveloperNEQ != nil {
-		predicates = append(predicates, model.DeveloperNEQ(*i.DeveloperNEQ))
+	if i.SuccessRequestCountGTE != nil {
+		predicates = append(predicates, channelprobe.SuccessRequestCountGTE(*i.SuccessRequestCountGTE))
 	}
-	if len(i.DeveloperIn) > 0 {
-		predicates = append(predicates, model.DeveloperIn(i.DeveloperIn...))
+	if i.SuccessRequestCountLT != nil {
+		predicates = append(predicates, channelprobe.SuccessRequestCountLT(*i.SuccessRequestCountLT))
 	}
-	if len(i.DeveloperNotIn) > 0 {
-		predicates = append(predicates, model.DeveloperNotIn(i.DeveloperNotIn...))
+	if i.SuccessRequestCountLTE != nil {
+		predicates = append(predicates, channelprobe.SuccessRequestCountLTE(*i.SuccessRequestCountLTE))
 	}
-	if i.DeveloperGT != nil {
-		predicates = append(predicates, model.DeveloperGT(*i.DeveloperGT))
+	if i.AvgTokensPerSecond != nil {
+		predicates = append(predicates, channelprobe.AvgTokensPerSecondEQ(*i.AvgTokensPerSecond))
 	}
-	if i.DeveloperGTE != nil {
-		predicates = append(predicates, model.DeveloperGTE(*i.DeveloperGTE))
+	if i.AvgTokensPerSecondNEQ != nil {
+		predicates = append(predicates, channelprobe.AvgTokensPerSecondNEQ(*i.AvgTokensPerSecondNEQ))
 	}
-	if i.DeveloperLT != nil {
-		predicates = append(predicates, model.DeveloperLT(*i.DeveloperLT))
+	if len(i.AvgTokensPerSecondIn) > 0 {
+		predicates = append(predicates, channelprobe.AvgTokensPerSecondIn(i.AvgTokensPerSecondIn...))
 	}
-	if i.DeveloperLTE != nil {
-		predicates = append(predicates, model.DeveloperLTE(*i.DeveloperLTE))
+	if len(i.AvgTokensPerSecondNotIn) > 0 {
+		predicates = append(predicates, channelprobe.AvgTokensPerSecondNotIn(i.AvgTokensPerSecondNotIn...))
 	}
-	if i.DeveloperContains != nil {
-		predicates = append(predicates, model.DeveloperContains(*i.DeveloperContains))
+	if i.AvgTokensPerSecondGT != nil {
+		predicates = append(predicates, channelprobe.AvgTokensPerSecondGT(*i.AvgTokensPerSecondGT))
 	}
-	if i.DeveloperHasPrefix != nil {
-		predicates = append(predicates, model.DeveloperHasPrefix(*i.DeveloperHasPrefix))
+	if i.AvgTokensPerSecondGTE != nil {
+		predicates = append(predicates, channelprobe.AvgTokensPerSecondGTE(*i.AvgTokensPerSecondGTE))
 	}
-	if i.DeveloperHasSuffix != nil {
-		predicates = append(predicates, model.DeveloperHasSuffix(*i.DeveloperHasSuffix))
+	if i.AvgTokensPerSecondLT != nil {
+		predicates = append(predicates, channelprobe.AvgTokensPerSecondLT(*i.AvgTokensPerSecondLT))
 	}
-	if i.DeveloperEqualFold != nil {
-		predicates = append(predicates, model.DeveloperEqualFold(*i.DeveloperEqualFold))
+	if i.AvgTokensPerSecondLTE != nil {
+		predicates = append(predicates, channelprobe.AvgTokensPerSecondLTE(*i.AvgTokensPerSecondLTE))
 	}
-	if i.DeveloperContainsFold != nil {
-		predicates = append(predicates, model.DeveloperContainsFold(*i.DeveloperContainsFold))
+	if i.AvgTokensPerSecondIsNil {
+		predicates = append(predicates, channelprobe.AvgTokensPerSecondIsNil())
 	}
-	if i.ModelID != nil {
-		predicates = append(predicates, model.ModelIDEQ(*i.ModelID))
+	if i.AvgTokensPerSecondNotNil {
+		predicates = append(predicates, channelprobe.AvgTokensPerSecondNotNil())
 	}
-	if i.ModelIDNEQ != nil {
-		predicates = append(predicates, model.ModelIDNEQ(*i.ModelIDNEQ))
+	if i.AvgTimeToFirstTokenMs != nil {
+		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsEQ(*i.AvgTimeToFirstTokenMs))
 	}
-	if len(i.ModelIDIn) > 0 {
-		predicates = append(predicates, model.ModelIDIn(i.ModelIDIn...))
+	if i.AvgTimeToFirstTokenMsNEQ != nil {
+		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsNEQ(*i.AvgTimeToFirstTokenMsNEQ))
 	}
-	if len(i.ModelIDNotIn) > 0 {
-		predicates = append(predicates, model.ModelIDNotIn(i.ModelIDNotIn...))
+	if len(i.AvgTimeToFirstTokenMsIn) > 0 {
+		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsIn(i.AvgTimeToFirstTokenMsIn...))
 	}
-	if i.ModelIDGT != nil {
-		predicates = append(predicates, model.ModelIDGT(*i.ModelIDGT))
+	if len(i.AvgTimeToFirstTokenMsNotIn) > 0 {
+		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsNotIn(i.AvgTimeToFirstTokenMsNotIn...))
 	}
-	if i.ModelIDGTE != nil {
-		predicates = append(predicates, model.ModelIDGTE(*i.ModelIDGTE))
+	if i.AvgTimeToFirstTokenMsGT != nil {
+		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsGT(*i.AvgTimeToFirstTokenMsGT))
 	}
-	if i.ModelIDLT != nil {
-		predicates = append(predicates, model.ModelIDLT(*i.ModelIDLT))
+	if i.AvgTimeToFirstTokenMsGTE != nil {
+		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsGTE(*i.AvgTimeToFirstTokenMsGTE))
 	}
-	if i.ModelIDLTE != nil {
-		predicates = append(predicates, model.ModelIDLTE(*i.ModelIDLTE))
+	if i.AvgTimeToFirstTokenMsLT != nil {
+		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsLT(*i.AvgTimeToFirstTokenMsLT))
 	}
-	if i.ModelIDContains != nil {
-		predicates = append(predicates, model.ModelIDContains(*i.ModelIDContains))
+	if i.AvgTimeToFirstTokenMsLTE != nil {
+		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsLTE(*i.AvgTimeToFirstTokenMsLTE))
 	}
-	if i.ModelIDHasPrefix != nil {
-		predicates = append(predicates, model.ModelIDHasPrefix(*i.ModelIDHasPrefix))
+	if i.AvgTimeToFirstTokenMsIsNil {
+		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsIsNil())
 	}
-	if i.ModelIDHasSuffix != nil {
-		predicates = append(predicates, model.ModelIDHasSuffix(*i.ModelIDHasSuffix))
+	if i.AvgTimeToFirstTokenMsNotNil {
+		predicates = append(predicates, channelprobe.AvgTimeToFirstTokenMsNotNil())
 	}
-	if i.ModelIDEqualFold != nil {
-		predicates = append(predicates, model.ModelIDEqualFold(*i.ModelIDEqualFold))
+	if i.Timestamp != nil {
+		predicates = append(predicates, channelprobe.TimestampEQ(*i.Timestamp))
 	}
-	if i.ModelIDContainsFold != nil {
-		predicates = append(predicates, model.ModelIDContainsFold(*i.ModelIDContainsFold))
+	if i.TimestampNEQ != nil {
+		predicates = append(predicates, channelprobe.TimestampNEQ(*i.TimestampNEQ))
 	}
-	if i.Type != nil {
-		predicates = append(predicates, model.TypeEQ(*i.Type))
+	if len(i.TimestampIn) > 0 {
+		predicates = append(predicates, channelprobe.TimestampIn(i.TimestampIn...))
 	}
-	if i.TypeNEQ != nil {
-		predicates = append(predicates, model.TypeNEQ(*i.TypeNEQ))
+	if len(i.TimestampNotIn) > 0 {
+		predicates = append(predicates, channelprobe.TimestampNotIn(i.TimestampNotIn...))
 	}
-	if len(i.TypeIn) > 0 {
-		predicates = append(predicates, model.TypeIn(i.TypeIn...))
+	if i.TimestampGT != nil {
+		predicates = append(predicates, channelprobe.TimestampGT(*i.TimestampGT))
 	}
-	if len(i.TypeNotIn) > 0 {
-		predicates = append(predicates, model.TypeNotIn(i.TypeNotIn...))
+	if i.TimestampGTE != nil {
+		predicates = append(predicates, channelprobe.TimestampGTE(*i.TimestampGTE))
 	}
-	if i.Name != nil {
-		predicates = append(predicates, model.NameEQ(*i.Name))
+	if i.TimestampLT != nil {
+		predicates = append(predicates, channelprobe.TimestampLT(*i.TimestampLT))
 	}
-	if i.NameNEQ != nil {
-		predicates = append(predicates, model.NameNEQ(*i.NameNEQ))
+	if i.TimestampLTE != nil {
+		predicates = append(predicates, channelprobe.TimestampLTE(*i.TimestampLTE))
 	}
-	if len(i.NameIn) > 0 {
-		predicates = append(predicates, model.NameIn(i.NameIn...))
+
+	if i.HasChannel != nil {
+		p := channelprobe.HasChannel()
+		if !*i.HasChannel {
+			p = channelprobe.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if len(i.NameNotIn) > 0 {
-		predicates = append(predicates, model.NameNotIn(i.NameNotIn...))
+	if len(i.HasChannelWith) > 0 {
+		with := make([]predicate.Channel, 0, len(i.HasChannelWith))
+		for _, w := range i.HasChannelWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasChannelWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, channelprobe.HasChannelWith(with...))
 	}
-	if i.NameGT != nil {
-		predicates = append(predicates, model.NameGT(*i.NameGT))
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyChannelProbeWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return channelprobe.And(predicates...), nil
 	}
-	if i.NameGTE != nil {
-		predicates = append(predicates, model.NameGTE(*i.NameGTE))
+}
+
+// DataExportWhereInput represents a where input for filtering DataExport queries.
+type DataExportWhereInput struct {
+	Predicates []predicate.DataExport  `json:"-"`
+	Not        *DataExportWhereInput   `json:"not,omitempty"`
+	Or         []*DataExportWhereInput `json:"or,omitempty"`
+	And        []*DataExportWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "project_id" field predicates.
+	ProjectID      *int  `json:"projectID,omitempty"`
+	ProjectIDNEQ   *int  `json:"projectIDNEQ,omitempty"`
+	ProjectIDIn    []int `json:"projectIDIn,omitempty"`
+	ProjectIDNotIn []int `json:"projectIDNotIn,omitempty"`
+
+	// "user_id" field predicates.
+	UserID       *int  `json:"userID,omitempty"`
+	UserIDNEQ    *int  `json:"userIDNEQ,omitempty"`
+	UserIDIn     []int `json:"userIDIn,omitempty"`
+	UserIDNotIn  []int `json:"userIDNotIn,omitempty"`
+	UserIDGT     *int  `json:"userIDGT,omitempty"`
+	UserIDGTE    *int  `json:"userIDGTE,omitempty"`
+	UserIDLT     *int  `json:"userIDLT,omitempty"`
+	UserIDLTE    *int  `json:"userIDLTE,omitempty"`
+	UserIDIsNil  bool  `json:"userIDIsNil,omitempty"`
+	UserIDNotNil bool  `json:"userIDNotNil,omitempty"`
+
+	// "resource" field predicates.
+	Resource      *dataexport.Resource  `json:"resource,omitempty"`
+	ResourceNEQ   *dataexport.Resource  `json:"resourceNEQ,omitempty"`
+	ResourceIn    []dataexport.Resource `json:"resourceIn,omitempty"`
+	ResourceNotIn []dataexport.Resource `json:"resourceNotIn,omitempty"`
+
+	// "format" field predicates.
+	Format      *dataexport.Format  `json:"format,omitempty"`
+	FormatNEQ   *dataexport.Format  `json:"formatNEQ,omitempty"`
+	FormatIn    []dataexport.Format `json:"formatIn,omitempty"`
+	FormatNotIn []dataexport.Format `json:"formatNotIn,omitempty"`
+
+	// "include_bodies" field predicates.
+	IncludeBodies    *bool `json:"includeBodies,omitempty"`
+	IncludeBodiesNEQ *bool `json:"includeBodiesNEQ,omitempty"`
+
+	// "data_storage_id" field predicates.
+	DataStorageID       *int  `json:"dataStorageID,omitempty"`
+	DataStorageIDNEQ    *int  `json:"dataStorageIDNEQ,omitempty"`
+	DataStorageIDIn     []int `json:"dataStorageIDIn,omitempty"`
+	DataStorageIDNotIn  []int `json:"dataStorageIDNotIn,omitempty"`
+	DataStorageIDGT     *int  `json:"dataStorageIDGT,omitempty"`
+	DataStorageIDGTE    *int  `json:"dataStorageIDGTE,omitempty"`
+	DataStorageIDLT     *int  `json:"dataStorageIDLT,omitempty"`
+	DataStorageIDLTE    *int  `json:"dataStorageIDLTE,omitempty"`
+	DataStorageIDIsNil  bool  `json:"dataStorageIDIsNil,omitempty"`
+	DataStorageIDNotNil bool  `json:"dataStorageIDNotNil,omitempty"`
+
+	// "status" field predicates.
+	Status      *dataexport.Status  `json:"status,omitempty"`
+	StatusNEQ   *dataexport.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []dataexport.Status `json:"statusIn,omitempty"`
+	StatusNotIn []dataexport.Status `json:"statusNotIn,omitempty"`
+
+	// "storage_key" field predicates.
+	StorageKey             *string  `json:"storageKey,omitempty"`
+	StorageKeyNEQ          *string  `json:"storageKeyNEQ,omitempty"`
+	StorageKeyIn           []string `json:"storageKeyIn,omitempty"`
+	StorageKeyNotIn        []string `json:"storageKeyNotIn,omitempty"`
+	StorageKeyGT           *string  `json:"storageKeyGT,omitempty"`
+	StorageKeyGTE          *string  `json:"storageKeyGTE,omitempty"`
+	StorageKeyLT           *string  `json:"storageKeyLT,omitempty"`
+	StorageKeyLTE          *string  `json:"storageKeyLTE,omitempty"`
+	StorageKeyContains     *string  `json:"storageKeyContains,omitempty"`
+	StorageKeyHasPrefix    *string  `json:"storageKeyHasPrefix,omitempty"`
+	StorageKeyHasSuffix    *string  `json:"storageKeyHasSuffix,omitempty"`
+	StorageKeyIsNil        bool     `json:"storageKeyIsNil,omitempty"`
+	StorageKeyNotNil       bool     `json:"storageKeyNotNil,omitempty"`
+	StorageKeyEqualFold    *string  `json:"storageKeyEqualFold,omitempty"`
+	StorageKeyContainsFold *string  `json:"storageKeyContainsFold,omitempty"`
+
+	// "row_count" field predicates.
+	RowCount      *int64  `json:"rowCount,omitempty"`
+	RowCountNEQ   *int64  `json:"rowCountNEQ,omitempty"`
+	RowCountIn    []int64 `json:"rowCountIn,omitempty"`
+	RowCountNotIn []int64 `json:"rowCountNotIn,omitempty"`
+	RowCountGT    *int64  `json:"rowCountGT,omitempty"`
+	RowCountGTE   *int64  `json:"rowCountGTE,omitempty"`
+	RowCountLT    *int64  `json:"rowCountLT,omitempty"`
+	RowCountLTE   *int64  `json:"rowCountLTE,omitempty"`
+
+	// "size" field predicates.
+	Size      *int64  `json:"size,omitempty"`
+	SizeNEQ   *int64  `json:"sizeNEQ,omitempty"`
+	SizeIn    []int64 `json:"sizeIn,omitempty"`
+	SizeNotIn []int64 `json:"sizeNotIn,omitempty"`
+	SizeGT    *int64  `json:"sizeGT,omitempty"`
+	SizeGTE   *int64  `json:"sizeGTE,omitempty"`
+	SizeLT    *int64  `json:"sizeLT,omitempty"`
+	SizeLTE   *int64  `json:"sizeLTE,omitempty"`
+
+	// "error_message" field predicates.
+	ErrorMessage             *string  `json:"errorMessage,omitempty"`
+	ErrorMessageNEQ          *string  `json:"errorMessageNEQ,omitempty"`
+	ErrorMessageIn           []string `json:"errorMessageIn,omitempty"`
+	ErrorMessageNotIn        []string `json:"errorMessageNotIn,omitempty"`
+	ErrorMessageGT           *string  `json:"errorMessageGT,omitempty"`
+	ErrorMessageGTE          *string  `json:"errorMessageGTE,omitempty"`
+	ErrorMessageLT           *string  `json:"errorMessageLT,omitempty"`
+	ErrorMessageLTE          *string  `json:"errorMessageLTE,omitempty"`
+	ErrorMessageContains     *string  `json:"errorMessageContains,omitempty"`
+	ErrorMessageHasPrefix    *string  `json:"errorMessageHasPrefix,omitempty"`
+	ErrorMessageHasSuffix    *string  `json:"errorMessageHasSuffix,omitempty"`
+	ErrorMessageIsNil        bool     `json:"errorMessageIsNil,omitempty"`
+	ErrorMessageNotNil       bool     `json:"errorMessageNotNil,omitempty"`
+	ErrorMessageEqualFold    *string  `json:"errorMessageEqualFold,omitempty"`
+	ErrorMessageContainsFold *string  `json:"errorMessageContainsFold,omitempty"`
+
+	// "started_at" field predicates.
+	StartedAt       *time.Time  `json:"startedAt,omitempty"`
+	StartedAtNEQ    *time.Time  `json:"startedAtNEQ,omitempty"`
+	StartedAtIn     []time.Time `json:"startedAtIn,omitempty"`
+	StartedAtNotIn  []time.Time `json:"startedAtNotIn,omitempty"`
+	StartedAtGT     *time.Time  `json:"startedAtGT,omitempty"`
+	StartedAtGTE    *time.Time  `json:"startedAtGTE,omitempty"`
+	StartedAtLT     *time.Time  `json:"startedAtLT,omitempty"`
+	StartedAtLTE    *time.Time  `json:"startedAtLTE,omitempty"`
+	StartedAtIsNil  bool        `json:"startedAtIsNil,omitempty"`
+	StartedAtNotNil bool        `json:"startedAtNotNil,omitempty"`
+
+	// "completed_at" field predicates.
+	CompletedAt       *time.Time  `json:"completedAt,omitempty"`
+	CompletedAtNEQ    *time.Time  `json:"completedAtNEQ,omitempty"`
+	CompletedAtIn     []time.Time `json:"completedAtIn,omitempty"`
+	CompletedAtNotIn  []time.Time `json:"completedAtNotIn,omitempty"`
+	CompletedAtGT     *time.Time  `json:"completedAtGT,omitempty"`
+	CompletedAtGTE    *time.Time  `json:"completedAtGTE,omitempty"`
+	CompletedAtLT     *time.Time  `json:"completedAtLT,omitempty"`
+	CompletedAtLTE    *time.Time  `json:"completedAtLTE,omitempty"`
+	CompletedAtIsNil  bool        `json:"completedAtIsNil,omitempty"`
+	CompletedAtNotNil bool        `json:"completedAtNotNil,omitempty"`
+
+	// "project" edge predicates.
+	HasProject     *bool                `json:"hasProject,omitempty"`
+	HasProjectWith []*ProjectWhereInput `json:"hasProjectWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *DataExportWhereInput) AddPredicates(predicates ...predicate.DataExport) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the DataExportWhereInput filter on the DataExportQuery builder.
+func (i *DataExportWhereInput) Filter(q *DataExportQuery) (*DataExportQuery, error) {
+	if i == nil {
+		return q, nil
 	}
-	if i.NameLT != nil {
-		predicates = append(predicates, model.NameLT(*i.NameLT))
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyDataExportWhereInput {
+			return q, nil
+		}
+		return nil, err
 	}
-	if i.NameLTE != nil {
-		predicates = append(predicates, model.NameLTE(*i.NameLTE))
+	return q.Where(p), nil
+}
+
+// ErrEmptyDataExportWhereInput is returned in case the DataExportWhereInput is empty.
+var ErrEmptyDataExportWhereInput = errors.New("ent: empty predicate DataExportWhereInput")
+
+// P returns a predicate for filtering dataexports.
+// An error is returned if the input is empty or invalid.
+func (i *DataExportWhereInput) P() (predicate.DataExport, error) {
+	var predicates []predicate.DataExport
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, dataexport.Not(p))
 	}
-	if i.NameContains != nil {
-		predicates = append(predicates, model.NameContains(*i.NameContains))
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.DataExport, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, dataexport.Or(or...))
 	}
-	if i.NameHasPrefix != nil {
-		predicates = append(predicates, model.NameHasPrefix(*i.NameHasPrefix))
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.DataExport, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, dataexport.And(and...))
 	}
-	if i.NameHasSuffix != nil {
-		predicates = append(predicates, model.NameHasSuffix(*i.NameHasSuffix))
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, dataexport.IDEQ(*i.ID))
 	}
-	if i.NameEqualFold != nil {
-		predicates = append(predicates, model.NameEqualFold(*i.NameEqualFold))
+	if i.IDNEQ != nil {
+		predicates = append(predicates, dataexport.IDNEQ(*i.IDNEQ))
 	}
-	if i.NameContainsFold != nil {
-		predicates = append(predicates, model.NameContainsFold(*i.NameContainsFold))
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, dataexport.IDIn(i.IDIn...))
 	}
-	if i.Icon != nil {
-		predicates = append(predicates, model.IconEQ(*i.Icon))
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, dataexport.IDNotIn(i.IDNotIn...))
 	}
-	if i.IconNEQ != nil {
-		predicates = append(predicates, model.IconNEQ(*i.IconNEQ))
+	if i.IDGT != nil {
+		predicates = append(predicates, dataexport.IDGT(*i.IDGT))
 	}
-	if len(i.IconIn) > 0 {
-		predicates = append(predicates, model.IconIn(i.IconIn...))
+	if i.IDGTE != nil {
+		predicates = append(predicates, dataexport.IDGTE(*i.IDGTE))
 	}
-	if len(i.IconNotIn) > 0 {
-		predicates = append(predicates, model.IconNotIn(i.IconNotIn...))
+	if i.IDLT != nil {
+		predicates = append(predicates, dataexport.IDLT(*i.IDLT))
 	}
-	if i.IconGT != nil {
-		predicates = append(predicates, model.IconGT(*i.IconGT))
+	if i.IDLTE != nil {
+		predicates = append(predicates, dataexport.IDLTE(*i.IDLTE))
 	}
-	if i.IconGTE != nil {
-		predicates = append(predicates, model.IconGTE(*i.IconGTE))
+	if i.CreatedAt != nil {
+		predicates = append(predicates, dataexport.CreatedAtEQ(*i.CreatedAt))
 	}
-	if i.IconLT != nil {
-		predicates = append(predicates, model.IconLT(*i.IconLT))
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, dataexport.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if i.IconLTE != nil {
-		predicates = append(predicates, model.IconLTE(*i.IconLTE))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, dataexport.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if i.IconContains != nil {
-		predicates = append(predicates, model.IconContains(*i.IconContains))
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, dataexport.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if i.IconHasPrefix != nil {
-		predicates = append(predicates, model.IconHasPrefix(*i.IconHasPrefix))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, dataexport.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.IconHasSuffix != nil {
-		predicates = append(predicates, model.IconHasSuffix(*i.IconHasSuffix))
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, dataexport.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if i.IconEqualFold != nil {
-		predicates = append(predicates, model.IconEqualFold(*i.IconEqualFold))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, dataexport.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if i.IconContainsFold != nil {
-		predicates = append(predicates, model.IconContainsFold(*i.IconContainsFold))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, dataexport.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.Group != nil {
-		predicates = append(predicates, model.GroupEQ(*i.Group))
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, dataexport.UpdatedAtEQ(*i.UpdatedAt))
 	}
-	if i.GroupNEQ != nil {
-		predicates = append(predicates, model.GroupNEQ(*i.GroupNEQ))
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, dataexport.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
-	if len(i.GroupIn) > 0 {
-		predicates = append(predicates, model.GroupIn(i.GroupIn...))
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, dataexport.UpdatedAtIn(i.UpdatedAtIn...))
 	}
-	if len(i.GroupNotIn) > 0 {
-		predicates = append(predicates, model.GroupNotIn(i.GroupNotIn...))
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, dataexport.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
-	if i.GroupGT != nil {
-		predicates = append(predicates, model.GroupGT(*i.GroupGT))
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, dataexport.UpdatedAtGT(*i.UpdatedAtGT))
 	}
-	if i.GroupGTE != nil {
-		predicates = append(predicates, model.GroupGTE(*i.GroupGTE))
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, dataexport.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
-	if i.GroupLT != nil {
-		predicates = append(predicates, model.GroupLT(*i.GroupLT))
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, dataexport.UpdatedAtLT(*i.UpdatedAtLT))
 	}
-	if i.GroupLTE != nil {
-		predicates = append(predicates, model.GroupLTE(*i.GroupLTE))
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, dataexport.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.GroupContains != nil {
-		predicates = append(predicates, model.GroupContains(*i.GroupContains))
+	if i.ProjectID != nil {
+		predicates = append(predicates, dataexport.ProjectIDEQ(*i.ProjectID))
 	}
-	if i.GroupHasPrefix != nil {
-		predicates = append(predicates, model.GroupHasPrefix(*i.GroupHasPrefix))
+	if i.ProjectIDNEQ != nil {
+		predicates = append(predicates, dataexport.ProjectIDNEQ(*i.ProjectIDNEQ))
 	}
-	if i.GroupHasSuffix != nil {
-		predicates = append(predicates, model.GroupHasSuffix(*i.GroupHasSuffix))
+	if len(i.ProjectIDIn) > 0 {
+		predicates = append(predicates, dataexport.ProjectIDIn(i.ProjectIDIn...))
 	}
-	if i.GroupEqualFold != nil {
-		predicates = append(predicates, model.GroupEqualFold(*i.GroupEqualFold))
+	if len(i.ProjectIDNotIn) > 0 {
+		predicates = append(predicates, dataexport.ProjectIDNotIn(i.ProjectIDNotIn...))
 	}
-	if i.GroupContainsFold != nil {
-		predicates = append(predicates, model.GroupContainsFold(*i.GroupContainsFold))
+	if i.UserID != nil {
+		predicates = append(predicates, dataexport.UserIDEQ(*i.UserID))
+	}
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, dataexport.UserIDNEQ(*i.UserIDNEQ))
+	}
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, dataexport.UserIDIn(i.UserIDIn...))
+	}
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, dataexport.UserIDNotIn(i.UserIDNotIn...))
+	}
+	if i.UserIDGT != nil {
+		predicates = append(predicates, dataexport.UserIDGT(*i.UserIDGT))
+	}
+	if i.UserIDGTE != nil {
+		predicates = append(predicates, dataexport.UserIDGTE(*i.UserIDGTE))
+	}
+	if i.UserIDLT != nil {
+		predicates = append(predicates, dataexport.UserIDLT(*i.UserIDLT))
+	}
+	if i.UserIDLTE != nil {
+		predicates = append(predicates, dataexport.UserIDLTE(*i.UserIDLTE))
+	}
+	if i.UserIDIsNil {
+		predicates = append(predicates, dataexport.UserIDIsNil())
+	}
+	if i.UserIDNotNil {
+		predicates = append(predicates, dataexport.UserIDNotNil())
+	}
+	if i.Resource != nil {
+		predicates = append(predicates, dataexport.ResourceEQ(*i.Resource))
+	}
+	if i.ResourceNEQ != nil {
+		predicates = append(predicates, dataexport.ResourceNEQ(*i.ResourceNEQ))
+	}
+	if len(i.ResourceIn) > 0 {
+		predicates = append(predicates, dataexport.ResourceIn(i.ResourceIn...))
+	}
+	if len(i.ResourceNotIn) > 0 {
+		predicates = append(predicates, dataexport.ResourceNotIn(i.ResourceNotIn...))
+	}
+	if i.Format != nil {
+		predicates = append(predicates, dataexport.FormatEQ(*i.Format))
+	}
+	if i.FormatNEQ != nil {
+		predicates = append(predicates, dataexport.FormatNEQ(*i.FormatNEQ))
+	}
+	if len(i.FormatIn) > 0 {
+		predicates = append(predicates, dataexport.FormatIn(i.FormatIn...))
+	}
+	if len(i.FormatNotIn) > 0 {
+		predicates = append(predicates, dataexport.FormatNotIn(i.FormatNotIn...))
+	}
+	if i.IncludeBodies != nil {
+		predicates = append(predicates, dataexport.IncludeBodiesEQ(*i.IncludeBodies))
+	}
+	if i.IncludeBodiesNEQ != nil {
+		predicates = append(predicates, dataexport.IncludeBodiesNEQ(*i.IncludeBodiesNEQ))
+	}
+	if i.DataStorageID != nil {
+		predicates = append(predicates, dataexport.DataStorageIDEQ(*i.DataStorageID))
+	}
+	if i.DataStorageIDNEQ != nil {
+		predicates = append(predicates, dataexport.DataStorageIDNEQ(*i.DataStorageIDNEQ))
+	}
+	if len(i.DataStorageIDIn) > 0 {
+		predicates = append(predicates, dataexport.DataStorageIDIn(i.DataStorageIDIn...))
+	}
+	if len(i.DataStorageIDNotIn) > 0 {
+		predicates = append(predicates, dataexport.DataStorageIDNotIn(i.DataStorageIDNotIn...))
+	}
+	if i.DataStorageIDGT != nil {
+		predicates = append(predicates, dataexport.DataStorageIDGT(*i.DataStorageIDGT))
+	}
+	if i.DataStorageIDGTE != nil {
+		predicates = append(predicates, dataexport.DataStorageIDGTE(*i.DataStorageIDGTE))
+	}
+	if i.DataStorageIDLT != nil {
+		predicates = append(predicates, dataexport.DataStorageIDLT(*i.DataStorageIDLT))
+	}
+	if i.DataStorageIDLTE != nil {
+		predicates = append(predicates, dataexport.DataStorageIDLTE(*i.DataStorageIDLTE))
+	}
+	if i.DataStorageIDIsNil {
+		predicates = append(predicates, dataexport.DataStorageIDIsNil())
+	}
+	if i.DataStorageIDNotNil {
+		predicates = append(predicates, dataexport.DataStorageIDNotNil())
 	}
 	if i.Status != nil {
-		predicates = append(predicates, model.StatusEQ(*i.Status))
+		predicates = append(predicates, dataexport.StatusEQ(*i.Status))
 	}
 	if i.StatusNEQ != nil {
-		predicates = append(predicates, model.StatusNEQ(*i.StatusNEQ))
+		predicates = append(predicates, dataexport.StatusNEQ(*i.StatusNEQ))
 	}
 	if len(i.StatusIn) > 0 {
-		predicates = append(predicates, model.StatusIn(i.StatusIn...))
+		predicates = append(predicates, dataexport.StatusIn(i.StatusIn...))
 	}
 	if len(i.StatusNotIn) > 0 {
-		predicates = append(predicates, model.StatusNotIn(i.StatusNotIn...))
+		predicates = append(predicates, dataexport.StatusNotIn(i.StatusNotIn...))
 	}
-	if i.Remark != nil {
-		predicates = append(predicates, model.RemarkEQ(*i.Remark))
+	if i.StorageKey != nil {
+		predicates = append(predicates, dataexport.StorageKeyEQ(*i.StorageKey))
 	}
-	if i.RemarkNEQ != nil {
-		predicates = append(predicates, model.RemarkNEQ(*i.RemarkNEQ))
+	if i.StorageKeyNEQ != nil {
+		predicates = append(predicates, dataexport.StorageKeyNEQ(*i.StorageKeyNEQ))
 	}
-	if len(i.RemarkIn) > 0 {
-		predicates = append(predicates, model.RemarkIn(i.RemarkIn...))
+	if len(i.StorageKeyIn) > 0 {
+		predicates = append(predicates, dataexport.StorageKeyIn(i.StorageKeyIn...))
 	}
-	if len(i.RemarkNotIn) > 0 {
-		predicates = append(predicates, model.RemarkNotIn(i.RemarkNotIn...))
+	if len(i.StorageKeyNotIn) > 0 {
+		predicates = append(predicates, dataexport.StorageKeyNotIn(i.StorageKeyNotIn...))
 	}
-	if i.RemarkGT != nil {
-		predicates = append(predicates, model.RemarkGT(*i.RemarkGT))
+	if i.StorageKeyGT != nil {
+		predicates = append(predicates, dataexport.StorageKeyGT(*i.StorageKeyGT))
 	}
-	if i.RemarkGTE != nil {
-		predicates = append(predicates, model.RemarkGTE(*i.RemarkGTE))
+	if i.StorageKeyGTE != nil {
+		predicates = append(predicates, dataexport.StorageKeyGTE(*i.StorageKeyGTE))
 	}
-	if i.RemarkLT != nil {
-		predicates = append(predicates, model.RemarkLT(*i.RemarkLT))
+	if i.StorageKeyLT != nil {
+		predicates = append(predicates, dataexport.StorageKeyLT(*i.StorageKeyLT))
 	}
-	if i.RemarkLTE != nil {
-		predicates = append(predicates, model.RemarkLTE(*i.RemarkLTE))
+	if i.StorageKeyLTE != nil {
+		predicates = append(predicates, dataexport.StorageKeyLTE(*i.StorageKeyLTE))
 	}
-	if i.RemarkContains != nil {
-		predicates = append(predicates, model.RemarkContains(*i.RemarkContains))
+	if i.StorageKeyContains != nil {
+		predicates = append(predicates, dataexport.StorageKeyContains(*i.StorageKeyContains))
 	}
-	if i.RemarkHasPrefix != nil {
-		predicates = append(predicates, model.RemarkHasPrefix(*i.RemarkHasPrefix))
+	if i.StorageKeyHasPrefix != nil {
+		predicates = append(predicates, dataexport.StorageKeyHasPrefix(*i.StorageKeyHasPrefix))
 	}
-	if i.RemarkHasSuffix != nil {
-		predicates = append(predicates, model.RemarkHasSuffix(*i.RemarkHasSuffix))
+	if i.StorageKeyHasSuffix != nil {
+		predicates = append(predicates, dataexport.StorageKeyHasSuffix(*i.StorageKeyHasSuffix))
 	}
-	if i.RemarkIsNil {
-		predicates = append(predicates, model.RemarkIsNil())
+	if i.StorageKeyIsNil {
+		predicates = append(predicates, dataexport.StorageKeyIsNil())
 	}
-	if i.RemarkNotNil {
-		predicates = append(predicates, model.RemarkNotNil())
+	if i.StorageKeyNotNil {
+		predicates = append(predicates, dataexport.StorageKeyNotNil())
 	}
-	if i.RemarkEqualFold != nil {
-		predicates = append(predicates, model.RemarkEqualFold(*i.RemarkEqualFold))
+	if i.StorageKeyEqualFold != nil {
+		predicates = append(predicates, dataexport.StorageKeyEqualFold(*i.StorageKeyEqualFold))
 	}
-	if i.RemarkContainsFold != nil {
-		predicates = append(predicates, model.RemarkContainsFold(*i.RemarkContainsFold))
+	if i.StorageKeyContainsFold != nil {
+		predicates = append(predicates, dataexport.StorageKeyContainsFold(*i.StorageKeyContainsFold))
+	}
+	if i.RowCount != nil {
+		predicates = append(predicates, dataexport.RowCountEQ(*i.RowCount))
+	}
+	if i.RowCountNEQ != nil {
+		predicates = append(predicates, dataexport.RowCountNEQ(*i.RowCountNEQ))
+	}
+	if len(i.RowCountIn) > 0 {
+		predicates = append(predicates, dataexport.RowCountIn(i.RowCountIn...))
+	}
+	if len(i.RowCountNotIn) > 0 {
+		predicates = append(predicates, dataexport.RowCountNotIn(i.RowCountNotIn...))
+	}
+	if i.RowCountGT != nil {
+		predicates = append(predicates, dataexport.RowCountGT(*i.RowCountGT))
+	}
+	if i.RowCountGTE != nil {
+		predicates = append(predicates, dataexport.RowCountGTE(*i.RowCountGTE))
+	}
+	if i.RowCountLT != nil {
+		predicates = append(predicates, dataexport.RowCountLT(*i.RowCountLT))
+	}
+	if i.RowCountLTE != nil {
+		predicates = append(predicates, dataexport.RowCountLTE(*i.RowCountLTE))
+	}
+	if i.Size != nil {
+		predicates = append(predicates, dataexport.SizeEQ(*i.Size))
+	}
+	if i.SizeNEQ != nil {
+		predicates = append(predicates, dataexport.SizeNEQ(*i.SizeNEQ))
+	}
+	if len(i.SizeIn) > 0 {
+		predicates = append(predicates, dataexport.SizeIn(i.SizeIn...))
+	}
+	if len(i.SizeNotIn) > 0 {
+		predicates = append(predicates, dataexport.SizeNotIn(i.SizeNotIn...))
+	}
+	if i.SizeGT != nil {
+		predicates = append(predicates, dataexport.SizeGT(*i.SizeGT))
+	}
+	if i.SizeGTE != nil {
+		predicates = append(predicates, dataexport.SizeGTE(*i.SizeGTE))
+	}
+	if i.SizeLT != nil {
+		predicates = append(predicates, dataexport.SizeLT(*i.SizeLT))
+	}
+	if i.SizeLTE != nil {
+		predicates = append(predicates, dataexport.SizeLTE(*i.SizeLTE))
+	}
+	if i.ErrorMessage != nil {
+		predicates = append(predicates, dataexport.ErrorMessageEQ(*i.ErrorMessage))
+	}
+	if i.ErrorMessageNEQ != nil {
+		predicates = append(predicates, dataexport.ErrorMessageNEQ(*i.ErrorMessageNEQ))
+	}
+	if len(i.ErrorMessageIn) > 0 {
+		predicates = append(predicates, dataexport.ErrorMessageIn(i.ErrorMessageIn...))
+	}
+	if len(i.ErrorMessageNotIn) > 0 {
+		predicates = append(predicates, dataexport.ErrorMessageNotIn(i.ErrorMessageNotIn...))
+	}
+	if i.ErrorMessageGT != nil {
+		predicates = append(predicates, dataexport.ErrorMessageGT(*i.ErrorMessageGT))
+	}
+	if i.ErrorMessageGTE != nil {
+		predicates = append(predicates, dataexport.ErrorMessageGTE(*i.ErrorMessageGTE))
+	}
+	if i.ErrorMessageLT != nil {
+		predicates = append(predicates, dataexport.ErrorMessageLT(*i.ErrorMessageLT))
+	}
+	if i.ErrorMessageLTE != nil {
+		predicates = append(predicates, dataexport.ErrorMessageLTE(*i.ErrorMessageLTE))
+	}
+	if i.ErrorMessageContains != nil {
+		predicates = append(predicates, dataexport.ErrorMessageContains(*i.ErrorMessageContains))
+	}
+	if i.ErrorMessageHasPrefix != nil {
+		predicates = append(predicates, dataexport.ErrorMessageHasPrefix(*i.ErrorMessageHasPrefix))
+	}
+	if i.ErrorMessageHasSuffix != nil {
+		predicates = append(predicates, dataexport.ErrorMessageHasSuffix(*i.ErrorMessageHasSuffix))
+	}
+	if i.ErrorMessageIsNil {
+		predicates = append(predicates, dataexport.ErrorMessageIsNil())
+	}
+	if i.ErrorMessageNotNil {
+		predicates = append(predicates, dataexport.ErrorMessageNotNil())
+	}
+	if i.ErrorMessageEqualFold != nil {
+		predicates = append(predicates, dataexport.ErrorMessageEqualFold(*i.ErrorMessageEqualFold))
+	}
+	if i.ErrorMessageContainsFold != nil {
+		predicates = append(predicates, dataexport.ErrorMessageContainsFold(*i.ErrorMessageContainsFold))
+	}
+	if i.StartedAt != nil {
+		predicates = append(predicates, dataexport.StartedAtEQ(*i.StartedAt))
+	}
+	if i.StartedAtNEQ != nil {
+		predicates = append(predicates, dataexport.StartedAtNEQ(*i.StartedAtNEQ))
+	}
+	if len(i.StartedAtIn) > 0 {
+		predicates = append(predicates, dataexport.StartedAtIn(i.StartedAtIn...))
+	}
+	if len(i.StartedAtNotIn) > 0 {
+		predicates = append(predicates, dataexport.StartedAtNotIn(i.StartedAtNotIn...))
+	}
+	if i.StartedAtGT != nil {
+		predicates = append(predicates, dataexport.StartedAtGT(*i.StartedAtGT))
+	}
+	if i.StartedAtGTE != nil {
+		predicates = append(predicates, dataexport.StartedAtGTE(*i.StartedAtGTE))
+	}
+	if i.StartedAtLT != nil {
+		predicates = append(predicates, dataexport.StartedAtLT(*i.StartedAtLT))
+	}
+	if i.StartedAtLTE != nil {
+		predicates = append(predicates, dataexport.StartedAtLTE(*i.StartedAtLTE))
+	}
+	if i.StartedAtIsNil {
+		predicates = append(predicates, dataexport.StartedAtIsNil())
+	}
+	if i.StartedAtNotNil {
+		predicates = append(predicates, dataexport.StartedAtNotNil())
+	}
+	if i.CompletedAt != nil {
+		predicates = append(predicates, dataexport.CompletedAtEQ(*i.CompletedAt))
+	}
+	if i.CompletedAtNEQ != nil {
+		predicates = append(predicates, dataexport.CompletedAtNEQ(*i.CompletedAtNEQ))
+	}
+	if len(i.CompletedAtIn) > 0 {
+		predicates = append(predicates, dataexport.CompletedAtIn(i.CompletedAtIn...))
+	}
+	if len(i.CompletedAtNotIn) > 0 {
+		predicates = append(predicates, dataexport.CompletedAtNotIn(i.CompletedAtNotIn...))
+	}
+	if i.CompletedAtGT != nil {
+		predicates = append(predicates, dataexport.CompletedAtGT(*i.CompletedAtGT))
+	}
+	if i.CompletedAtGTE != nil {
+		predicates = append(predicates, dataexport.CompletedAtGTE(*i.CompletedAtGTE))
+	}
+	if i.CompletedAtLT != nil {
+		predicates = append(predicates, dataexport.CompletedAtLT(*i.CompletedAtLT))
+	}
+	if i.CompletedAtLTE != nil {
+		predicates = append(predicates, dataexport.CompletedAtLTE(*i.CompletedAtLTE))
+	}
+	if i.CompletedAtIsNil {
+		predicates = append(predicates, dataexport.CompletedAtIsNil())
+	}
+	if i.CompletedAtNotNil {
+		predicates = append(predicates, dataexport.CompletedAtNotNil())
 	}
 
+	if i.HasProject != nil {
+		p := dataexport.HasProject()
+		if !*i.HasProject {
+			p = dataexport.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasProjectWith) > 0 {
+		with := make([]predicate.Project, 0, len(i.HasProjectWith))
+		for _, w := range i.HasProjectWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProjectWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, dataexport.HasProjectWith(with...))
+	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyModelWhereInput
+		return nil, ErrEmptyDataExportWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return model.And(predicates...), nil
+		return dataexport.And(predicates...), nil
 	}
 }
 
-// OIDCIdentityWhereInput represents a where input for filtering OIDCIdentity queries.
-type OIDCIdentityWhereInput struct {
-	Predicates []predicate.OIDCIdentity  `json:"-"`
-	Not        *OIDCIdentityWhereInput   `json:"not,omitempty"`
-	Or         []*OIDCIdentityWhereInput `json:"or,omitempty"`
-	And        []*OIDCIdentityWhereInput `json:"and,omitempty"`
+// DataStorageWhereInput represents a where input for filtering DataStorage queries.
+type DataStorageWhereInput struct {
+	Predicates []predicate.DataStorage  `json:"-"`
+	Not        *DataStorageWhereInput   `json:"not,omitempty"`
+	Or         []*DataStorageWhereInput `json:"or,omitempty"`
+	And        []*DataStorageWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -4157,106 +5077,74 @@ type OIDCIdentityWhereInput struct {
 	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
 	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
-	// "issuer" field predicates.
-	Issuer             *string  `json:"issuer,omitempty"`
-	IssuerNEQ          *string  `json:"issuerNEQ,omitempty"`
-	IssuerIn           []string `json:"issuerIn,omitempty"`
-	IssuerNotIn        []string `json:"issuerNotIn,omitempty"`
-	IssuerGT           *string  `json:"issuerGT,omitempty"`
-	IssuerGTE          *string  `json:"issuerGTE,omitempty"`
-	IssuerLT           *string  `json:"issuerLT,omitempty"`
-	IssuerLTE          *string  `json:"issuerLTE,omitempty"`
-	IssuerContains     *string  `json:"issuerContains,omitempty"`
-	IssuerHasPrefix    *string  `json:"issuerHasPrefix,omitempty"`
-	IssuerHasSuffix    *string  `json:"issuerHasSuffix,omitempty"`
-	IssuerEqualFold    *string  `json:"issuerEqualFold,omitempty"`
-	IssuerContainsFold *string  `json:"issuerContainsFold,omitempty"`
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "subject" field predicates.
-	Subject             *string  `json:"subject,omitempty"`
-	SubjectNEQ          *string  `json:"subjectNEQ,omitempty"`
-	SubjectIn           []string `json:"subjectIn,omitempty"`
-	SubjectNotIn        []string `json:"subjectNotIn,omitempty"`
-	SubjectGT           *string  `json:"subjectGT,omitempty"`
-	SubjectGTE          *string  `json:"subjectGTE,omitempty"`
-	SubjectLT           *string  `json:"subjectLT,omitempty"`
-	SubjectLTE          *string  `json:"subjectLTE,omitempty"`
-	SubjectContains     *string  `json:"subjectContains,omitempty"`
-	SubjectHasPrefix    *string  `json:"subjectHasPrefix,omitempty"`
-	SubjectHasSuffix    *string  `json:"subjectHasSuffix,omitempty"`
-	SubjectEqualFold    *string  `json:"subjectEqualFold,omitempty"`
-	SubjectContainsFold *string  `json:"subjectContainsFold,omitempty"`
+	// "description" field predicates.
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
 
-	// "email" field predicates.
-	Email             *string  `json:"email,omitempty"`
-	EmailNEQ          *string  `json:"emailNEQ,omitempty"`
-	EmailIn           []string `json:"emailIn,omitempty"`
-	EmailNotIn        []string `json:"emailNotIn,omitempty"`
-	EmailGT           *string  `json:"emailGT,omitempty"`
-	EmailGTE          *string  `json:"emailGTE,omitempty"`
-	EmailLT           *string  `json:"emailLT,omitempty"`
-	EmailLTE          *string  `json:"emailLTE,omitempty"`
-	EmailContains     *string  `json:"emailContains,omitempty"`
-	EmailHasPrefix    *string  `json:"emailHasPrefix,omitempty"`
-	EmailHasSuffix    *string  `json:"emailHasSuffix,omitempty"`
-	EmailIsNil        bool     `json:"emailIsNil,omitempty"`
-	EmailNotNil       bool     `json:"emailNotNil,omitempty"`
-	EmailEqualFold    *string  `json:"emailEqualFold,omitempty"`
-	EmailContainsFold *string  `json:"emailContainsFold,omitempty"`
+	// "primary" field predicates.
+	Primary    *bool `json:"primary,omitempty"`
+	PrimaryNEQ *bool `json:"primaryNEQ,omitempty"`
 
-	// "idp_name" field predicates.
-	IdpName             *string  `json:"idpName,omitempty"`
-	IdpNameNEQ          *string  `json:"idpNameNEQ,omitempty"`
-	IdpNameIn           []string `json:"idpNameIn,omitempty"`
-	IdpNameNotIn        []string `json:"idpNameNotIn,omitempty"`
-	IdpNameGT           *string  `json:"idpNameGT,omitempty"`
-	IdpNameGTE          *string  `json:"idpNameGTE,omitempty"`
-	IdpNameLT           *string  `json:"idpNameLT,omitempty"`
-	IdpNameLTE          *string  `json:"idpNameLTE,omitempty"`
-	IdpNameContains     *string  `json:"idpNameContains,omitempty"`
-	IdpNameHasPrefix    *string  `json:"idpNameHasPrefix,omitempty"`
-	IdpNameHasSuffix    *string  `json:"idpNameHasSuffix,omitempty"`
-	IdpNameIsNil        bool     `json:"idpNameIsNil,omitempty"`
-	IdpNameNotNil       bool     `json:"idpNameNotNil,omitempty"`
-	IdpNameEqualFold    *string  `json:"idpNameEqualFold,omitempty"`
-	IdpNameContainsFold *string  `json:"idpNameContainsFold,omitempty"`
+	// "type" field predicates.
+	Type      *datastorage.Type  `json:"type,omitempty"`
+	TypeNEQ   *datastorage.Type  `json:"typeNEQ,omitempty"`
+	TypeIn    []datastorage.Type `json:"typeIn,omitempty"`
+	TypeNotIn []datastorage.Type `json:"typeNotIn,omitempty"`
 
-	// "last_login_at" field predicates.
-	LastLoginAt       *time.Time  `json:"lastLoginAt,omitempty"`
-	LastLoginAtNEQ    *time.Time  `json:"lastLoginAtNEQ,omitempty"`
-	LastLoginAtIn     []time.Time `json:"lastLoginAtIn,omitempty"`
-	LastLoginAtNotIn  []time.Time `json:"lastLoginAtNotIn,omitempty"`
-	LastLoginAtGT     *time.Time  `json:"lastLoginAtGT,omitempty"`
-	LastLoginAtGTE    *time.Time  `json:"lastLoginAtGTE,omitempty"`
-	LastLoginAtLT     *time.Time  `json:"lastLoginAtLT,omitempty"`
-	LastLoginAtLTE    *time.Time  `json:"lastLoginAtLTE,omitempty"`
-	LastLoginAtIsNil  bool        `json:"lastLoginAtIsNil,omitempty"`
-	LastLoginAtNotNil bool        `json:"lastLoginAtNotNil,omitempty"`
+	// "status" field predicates.
+	Status      *datastorage.Status  `json:"status,omitempty"`
+	StatusNEQ   *datastorage.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []datastorage.Status `json:"statusIn,omitempty"`
+	StatusNotIn []datastorage.Status `json:"statusNotIn,omitempty"`
 
-	// "user_id" field predicates.
-	UserID      *int  `json:"userID,omitempty"`
-	UserIDNEQ   *int  `json:"userIDNEQ,omitempty"`
-	UserIDIn    []int `json:"userIDIn,omitempty"`
-	UserIDNotIn []int `json:"userIDNotIn,omitempty"`
+	// "requests" edge predicates.
+	HasRequests     *bool                `json:"hasRequests,omitempty"`
+	HasRequestsWith []*RequestWhereInput `json:"hasRequestsWith,omitempty"`
 
-	// "user" edge predicates.
-	HasUser     *bool             `json:"hasUser,omitempty"`
-	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+	// "executions" edge predicates.
+	HasExecutions     *bool                         `json:"hasExecutions,omitempty"`
+	HasExecutionsWith []*RequestExecutionWhereInput `json:"hasExecutionsWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *OIDCIdentityWhereInput) AddPredicates(predicates ...predicate.OIDCIdentity) {
+func (i *DataStorageWhereInput) AddPredicates(predicates ...predicate.DataStorage) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the OIDCIdentityWhereInput filter on the OIDCIdentityQuery builder.
-func (i *OIDCIdentityWhereInput) Filter(q *OIDCIdentityQuery) (*OIDCIdentityQuery, error) {
+// Filter applies the DataStorageWhereInput filter on the DataStorageQuery builder.
+func (i *DataStorageWhereInput) Filter(q *DataStorageQuery) (*DataStorageQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyOIDCIdentityWhereInput {
+		if err == ErrEmptyDataStorageWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -4264,19 +5152,19 @@ func (i *OIDCIdentityWhereInput) Filter(q *OIDCIdentityQuery) (*OIDCIdentityQuer
 	return q.Where(p), nil
 }
 
-// ErrEmptyOIDCIdentityWhereInput is returned in case the OIDCIdentityWhereInput is empty.
-var ErrEmptyOIDCIdentityWhereInput = errors.New("ent: empty predicate OIDCIdentityWhereInput")
+// ErrEmptyDataStorageWhereInput is returned in case the DataStorageWhereInput is empty.
+var ErrEmptyDataStorageWhereInput = errors.New("ent: empty predicate DataStorageWhereInput")
 
-// P returns a predicate for filtering oidcidentities.
+// P returns a predicate for filtering datastorages.
 // An error is returned if the input is empty or invalid.
-func (i *OIDCIdentityWhereInput) P() (predicate.OIDCIdentity, error) {
-	var predicates []predicate.OIDCIdentity
+func (i *DataStorageWhereInput) P() (predicate.DataStorage, error) {
+	var predicates []predicate.DataStorage
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, oidcidentity.Not(p))
+		predicates = append(predicates, datastorage.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -4286,7 +5174,7 @@ func (i *OIDCIdentityWhereInput) P() (predicate.OIDCIdentity, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.OIDCIdentity, 0, n)
+		or := make([]predicate.DataStorage, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -4294,7 +5182,7 @@ func (i *OIDCIdentityWhereInput) P() (predicate.OIDCIdentity, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, oidcidentity.Or(or...))
+		predicates = append(predicates, datastorage.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -4304,7 +5192,7 @@ func (i *OIDCIdentityWhereInput) P() (predicate.OIDCIdentity, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.OIDCIdentity, 0, n)
+		and := make([]predicate.DataStorage, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -4312,326 +5200,242 @@ func (i *OIDCIdentityWhereInput) P() (predicate.OIDCIdentity, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, oidcidentity.And(and...))
+		predicates = append(predicates, datastorage.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, oidcidentity.IDEQ(*i.ID))
+		predicates = append(predicates, datastorage.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, oidcidentity.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, datastorage.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, oidcidentity.IDIn(i.IDIn...))
+		predicates = append(predicates, datastorage.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, oidcidentity.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, datastorage.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, oidcidentity.IDGT(*i.IDGT))
+		predicates = append(predicates, datastorage.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, oidcidentity.IDGTE(*i.IDGTE))
+		predicates = append(predicates, datastorage.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, oidcidentity.IDLT(*i.IDLT))
+		predicates = append(predicates, datastorage.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, oidcidentity.IDLTE(*i.IDLTE))
+		predicates = append(predicates, datastorage.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, oidcidentity.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, datastorage.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, oidcidentity.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, datastorage.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, oidcidentity.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, datastorage.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, oidcidentity.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, datastorage.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, oidcidentity.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, datastorage.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, oidcidentity.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, datastorage.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, oidcidentity.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, datastorage.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, oidcidentity.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, datastorage.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, oidcidentity.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, datastorage.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, oidcidentity.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, datastorage.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, oidcidentity.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, datastorage.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, oidcidentity.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, datastorage.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, oidcidentity.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, datastorage.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, oidcidentity.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, datastorage.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, oidcidentity.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, datastorage.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, oidcidentity.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, datastorage.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.Issuer != nil {
-		predicates = append(predicates, oidcidentity.IssuerEQ(*i.Issuer))
+	if i.Name != nil {
+		predicates = append(predicates, datastorage.NameEQ(*i.Name))
 	}
-	if i.IssuerNEQ != nil {
-		predicates = append(predicates, oidcidentity.IssuerNEQ(*i.IssuerNEQ))
+	if i.NameNEQ != nil {
+		predicates = append(predicates, datastorage.NameNEQ(*i.NameNEQ))
 	}
-	if len(i.IssuerIn) > 0 {
-		predicates = append(predicates, oidcidentity.IssuerIn(i.IssuerIn...))
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, datastorage.NameIn(i.NameIn...))
 	}
-	if len(i.IssuerNotIn) > 0 {
-		predicates = append(predicates, oidcidentity.IssuerNotIn(i.IssuerNotIn...))
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, datastorage.NameNotIn(i.NameNotIn...))
 	}
-	if i.IssuerGT != nil {
-		predicates = append(predicates, oidcidentity.IssuerGT(*i.IssuerGT))
+	if i.NameGT != nil {
+		predicates = append(predicates, datastorage.NameGT(*i.NameGT))
 	}
-	if i.IssuerGTE != nil {
-		predicates = append(predicates, oidcidentity.IssuerGTE(*i.IssuerGTE))
+	if i.NameGTE != nil {
+		predicates = append(predicates, datastorage.NameGTE(*i.NameGTE))
 	}
-	if i.IssuerLT != nil {
-		predicates = append(predicates, oidcidentity.IssuerLT(*i.IssuerLT))
+	if i.NameLT != nil {
+		predicates = append(predicates, datastorage.NameLT(*i.NameLT))
 	}
-	if i.IssuerLTE != nil {
-		predicates = append(predicates, oidcidentity.IssuerLTE(*i.IssuerLTE))
+	if i.NameLTE != nil {
+		predicates = append(predicates, datastorage.NameLTE(*i.NameLTE))
 	}
-	if i.IssuerContains != nil {
-		predicates = append(predicates, oidcidentity.IssuerContains(*i.IssuerContains))
+	if i.NameContains != nil {
+		predicates = append(predicates, datastorage.NameContains(*i.NameContains))
 	}
-	if i.IssuerHasPrefix != nil {
-		predicates = append(predicates, oidcidentity.IssuerHasPrefix(*i.IssuerHasPrefix))
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, datastorage.NameHasPrefix(*i.NameHasPrefix))
 	}
-	if i.IssuerHasSuffix != nil {
-		predicates = append(predicates, oidcidentity.IssuerHasSuffix(*i.IssuerHasSuffix))
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, datastorage.NameHasSuffix(*i.NameHasSuffix))
 	}
-	if i.IssuerEqualFold != nil {
-		predicates = append(predicates, oidcidentity.IssuerEqualFold(*i.IssuerEqualFold))
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, datastorage.NameEqualFold(*i.NameEqualFold))
 	}
-	if i.IssuerContainsFold != nil {
-		predicates = append(predicates, oidcidentity.IssuerContainsFold(*i.IssuerContainsFold))
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, datastorage.NameContainsFold(*i.NameContainsFold))
 	}
-	if i.Subject != nil {
-		predicates = append(predicates, oidcidentity.SubjectEQ(*i.Subject))
+	if i.Description != nil {
+		predicates = append(predicates, datastorage.DescriptionEQ(*i.Description))
 	}
-	if i.SubjectNEQ != nil {
-		predicates = append(predicates, oidcidentity.SubjectNEQ(*i.SubjectNEQ))
+	if i.DescriptionNEQ != nil {
+		predicates = append(predicates, datastorage.DescriptionNEQ(*i.DescriptionNEQ))
 	}
-	if len(i.SubjectIn) > 0 {
-		predicates = append(predicates, oidcidentity.SubjectIn(i.SubjectIn...))
-	}
-	if len(i.SubjectNotIn) > 0 {
-		predicates = append(predicates, oidcidentity.SubjectNotIn(i.SubjectNotIn...))
-	}
-	if i.SubjectGT != nil {
-		predicates = append(predicates, oidcidentity.SubjectGT(*i.SubjectGT))
-	}
-	if i.SubjectGTE != nil {
-		predicates = append(predicates, oidcidentity.SubjectGTE(*i.SubjectGTE))
-	}
-	if i.SubjectLT != nil {
-		predicates = append(predicates, oidcidentity.SubjectLT(*i.SubjectLT))
-	}
-	if i.SubjectLTE != nil {
-		predicates = append(predicates, oidcidentity.SubjectLTE(*i.SubjectLTE))
-	}
-	if i.SubjectContains != nil {
-		predicates = append(predicates, oidcidentity.SubjectContains(*i.SubjectContains))
-	}
-	if i.SubjectHasPrefix != nil {
-		predicates = append(predicates, oidcidentity.SubjectHasPrefix(*i.SubjectHasPrefix))
-	}
-	if i.SubjectHasSuffix != nil {
-		predicates = append(predicates, oidcidentity.SubjectHasSuffix(*i.SubjectHasSuffix))
-	}
-	if i.SubjectEqualFold != nil {
-		predicates = append(predicates, oidcidentity.SubjectEqualFold(*i.SubjectEqualFold))
-	}
-	if i.SubjectContainsFold != nil {
-		predicates = append(predicates, oidcidentity.SubjectContainsFold(*i.SubjectContainsFold))
-	}
-	if i.Email != nil {
-		predicates = append(predicates, oidcidentity.EmailEQ(*i.Email))
-	}
-	if i.EmailNEQ != nil {
-		predicates = append(predicates, oidcidentity.EmailNEQ(*i.EmailNEQ))
-	}
-	if len(i.EmailIn) > 0 {
-		predicates = append(predicates, oidcidentity.EmailIn(i.EmailIn...))
-	}
-	if len(i.EmailNotIn) > 0 {
-		predicates = append(predicates, oidcidentity.EmailNotIn(i.EmailNotIn...))
-	}
-	if i.EmailGT != nil {
-		predicates = append(predicates, oidcidentity.EmailGT(*i.EmailGT))
-	}
-	if i.EmailGTE != nil {
-		predicates = append(predicates, oidcidentity.EmailGTE(*i.EmailGTE))
-	}
-	if i.EmailLT != nil {
-		predicates = append(predicates, oidcidentity.EmailLT(*i.EmailLT))
-	}
-	if i.EmailLTE != nil {
-		predicates = append(predicates, oidcidentity.EmailLTE(*i.EmailLTE))
-	}
-	if i.EmailContains != nil {
-		predicates = append(predicates, oidcidentity.EmailContains(*i.EmailContains))
-	}
-	if i.EmailHasPrefix != nil {
-		predicates = append(predicates, oidcidentity.EmailHasPrefix(*i.EmailHasPrefix))
-	}
-	if i.EmailHasSuffix != nil {
-		predicates = append(predicates, oidcidentity.EmailHasSuffix(*i.EmailHasSuffix))
-	}
-	if i.EmailIsNil {
-		predicates = append(predicates, oidcidentity.EmailIsNil())
-	}
-	if i.EmailNotNil {
-		predicates = append(predicates, oidcidentity.EmailNotNil())
-	}
-	if i.EmailEqualFold != nil {
-		predicates = append(predicates, oidcidentity.EmailEqualFold(*i.EmailEqualFold))
-	}
-	if i.EmailContainsFold != nil {
-		predicates = append(predicates, oidcidentity.EmailContainsFold(*i.EmailContainsFold))
-	}
-	if i.IdpName != nil {
-		predicates = append(predicates, oidcidentity.IdpNameEQ(*i.IdpName))
-	}
-	if i.IdpNameNEQ != nil {
-		predicates = append(predicates, oidcidentity.IdpNameNEQ(*i.IdpNameNEQ))
-	}
-	if len(i.IdpNameIn) > 0 {
-		predicates = append(predicates, oidcidentity.IdpNameIn(i.IdpNameIn...))
-	}
-	if len(i.IdpNameNotIn) > 0 {
-		predicates = append(predicates, oidcidentity.IdpNameNotIn(i.IdpNameNotIn...))
-	}
-	if i.IdpNameGT != nil {
-		predicates = append(predicates, oidcidentity.IdpNameGT(*i.IdpNameGT))
-	}
-	if i.IdpNameGTE != nil {
-		predicates = append(predicates, oidcidentity.IdpNameGTE(*i.IdpNameGTE))
-	}
-	if i.IdpNameLT != nil {
-		predicates = append(predicates, oidcidentity.IdpNameLT(*i.IdpNameLT))
+	if len(i.DescriptionIn) > 0 {
+		predicates = append(predicates, datastorage.DescriptionIn(i.DescriptionIn...))
 	}
-	if i.IdpNameLTE != nil {
-		predicates = append(predicates, oidcidentity.IdpNameLTE(*i.IdpNameLTE))
+	if len(i.DescriptionNotIn) > 0 {
+		predicates = append(predicates, datastorage.DescriptionNotIn(i.DescriptionNotIn...))
 	}
-	if i.IdpNameContains != nil {
-		predicates = append(predicates, oidcidentity.IdpNameContains(*i.IdpNameContains))
+	if i.DescriptionGT != nil {
+		predicates = append(predicates, datastorage.DescriptionGT(*i.DescriptionGT))
 	}
-	if i.IdpNameHasPrefix != nil {
-		predicates = append(predicates, oidcidentity.IdpNameHasPrefix(*i.IdpNameHasPrefix))
+	if i.DescriptionGTE != nil {
+		predicates = append(predicates, datastorage.DescriptionGTE(*i.DescriptionGTE))
 	}
-	if i.IdpNameHasSuffix != nil {
-		predicates = append(predicates, oidcidentity.IdpNameHasSuffix(*i.IdpNameHasSuffix))
+	if i.DescriptionLT != nil {
+		predicates = append(predicates, datastorage.DescriptionLT(*i.DescriptionLT))
 	}
-	if i.IdpNameIsNil {
-		predicates = append(predicates, oidcidentity.IdpNameIsNil())
+	if i.DescriptionLTE != nil {
+		predicates = append(predicates, datastorage.DescriptionLTE(*i.DescriptionLTE))
 	}
-	if i.IdpNameNotNil {
-		predicates = append(predicates, oidcidentity.IdpNameNotNil())
+	if i.DescriptionContains != nil {
+		predicates = append(predicates, datastorage.DescriptionContains(*i.DescriptionContains))
 	}
-	if i.IdpNameEqualFold != nil {
-		predicates = append(predicates, oidcidentity.IdpNameEqualFold(*i.IdpNameEqualFold))
+	if i.DescriptionHasPrefix != nil {
+		predicates = append(predicates, datastorage.DescriptionHasPrefix(*i.DescriptionHasPrefix))
 	}
-	if i.IdpNameContainsFold != nil {
-		predicates = append(predicates, oidcidentity.IdpNameContainsFold(*i.IdpNameContainsFold))
+	if i.DescriptionHasSuffix != nil {
+		predicates = append(predicates, datastorage.DescriptionHasSuffix(*i.DescriptionHasSuffix))
 	}
-	if i.LastLoginAt != nil {
-		predicates = append(predicates, oidcidentity.LastLoginAtEQ(*i.LastLoginAt))
+	if i.DescriptionEqualFold != nil {
+		predicates = append(predicates, datastorage.DescriptionEqualFold(*i.DescriptionEqualFold))
 	}
-	if i.LastLoginAtNEQ != nil {
-		predicates = append(predicates, oidcidentity.LastLoginAtNEQ(*i.LastLoginAtNEQ))
+	if i.DescriptionContainsFold != nil {
+		predicates = append(predicates, datastorage.DescriptionContainsFold(*i.DescriptionContainsFold))
 	}
-	if len(i.LastLoginAtIn) > 0 {
-		predicates = append(predicates, oidcidentity.LastLoginAtIn(i.LastLoginAtIn...))
+	if i.Primary != nil {
+		predicates = append(predicates, datastorage.PrimaryEQ(*i.Primary))
 	}
-	if len(i.LastLoginAtNotIn) > 0 {
-		predicates = append(predicates, oidcidentity.LastLoginAtNotIn(i.LastLoginAtNotIn...))
+	if i.PrimaryNEQ != nil {
+		predicates = append(predicates, datastorage.PrimaryNEQ(*i.PrimaryNEQ))
 	}
-	if i.LastLoginAtGT != nil {
-		predicates = append(predicates, oidcidentity.LastLoginAtGT(*i.LastLoginAtGT))
+	if i.Type != nil {
+		predicates = append(predicates, datastorage.TypeEQ(*i.Type))
 	}
-	if i.LastLoginAtGTE != nil {
-		predicates = append(predicates, oidcidentity.LastLoginAtGTE(*i.LastLoginAtGTE))
+	if i.TypeNEQ != nil {
+		predicates = append(predicates, datastorage.TypeNEQ(*i.TypeNEQ))
 	}
-	if i.LastLoginAtLT != nil {
-		predicates = append(predicates, oidcidentity.LastLoginAtLT(*i.LastLoginAtLT))
+	if len(i.TypeIn) > 0 {
+		predicates = append(predicates, datastorage.TypeIn(i.TypeIn...))
 	}
-	if i.LastLoginAtLTE != nil {
-		predicates = append(predicates, oidcidentity.LastLoginAtLTE(*i.LastLoginAtLTE))
+	if len(i.TypeNotIn) > 0 {
+		predicates = append(predicates, datastorage.TypeNotIn(i.TypeNotIn...))
 	}
-	if i.LastLoginAtIsNil {
-		predicates = append(predicates, oidcidentity.LastLoginAtIsNil())
+	if i.Status != nil {
+		predicates = append(predicates, datastorage.StatusEQ(*i.Status))
 	}
-	if i.LastLoginAtNotNil {
-		predicates = append(predicates, oidcidentity.LastLoginAtNotNil())
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, datastorage.StatusNEQ(*i.StatusNEQ))
 	}
-	if i.UserID != nil {
-		predicates = append(predicates, oidcidentity.UserIDEQ(*i.UserID))
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, datastorage.StatusIn(i.StatusIn...))
 	}
-	if i.UserIDNEQ != nil {
-		predicates = append(predicates, oidcidentity.UserIDNEQ(*i.UserIDNEQ))
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, datastorage.StatusNotIn(i.StatusNotIn...))
 	}
-	if len(i.UserIDIn) > 0 {
-		predicates = append(predicates, oidcidentity.UserIDIn(i.UserIDIn...))
+
+	if i.HasRequests != nil {
+		p := datastorage.HasRequests()
+		if !*i.HasRequests {
+			p = datastorage.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if len(i.UserIDNotIn) > 0 {
-		predicates = append(predicates, oidcidentity.UserIDNotIn(i.UserIDNotIn...))
+	if len(i.HasRequestsWith) > 0 {
+		with := make([]predicate.Request, 0, len(i.HasRequestsWith))
+		for _, w := range i.HasRequestsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRequestsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, datastorage.HasRequestsWith(with...))
 	}
-
-	if i.HasUser != nil {
-		p := oidcidentity.HasUser()
-		if !*i.HasUser {
-			p = oidcidentity.Not(p)
+	if i.HasExecutions != nil {
+		p := datastorage.HasExecutions()
+		if !*i.HasExecutions {
+			p = datastorage.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasUserWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasUserWith))
-		for _, w := range i.HasUserWith {
+	if len(i.HasExecutionsWith) > 0 {
+		with := make([]predicate.RequestExecution, 0, len(i.HasExecutionsWith))
+		for _, w := range i.HasExecutionsWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasExecutionsWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, oidcidentity.HasUserWith(with...))
+		predicates = append(predicates, datastorage.HasExecutionsWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyOIDCIdentityWhereInput
+		return nil, ErrEmptyDataStorageWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return oidcidentity.And(predicates...), nil
+		return datastorage.And(predicates...), nil
 	}
 }
 
-// ProjectWhereInput represents a where input for filtering Project queries.
-type ProjectWhereInput struct {
-	Predicates []predicate.Project  `json:"-"`
-	Not        *ProjectWhereInput   `json:"not,omitempty"`
-	Or         []*ProjectWhereInput `json:"or,omitempty"`
-	And        []*ProjectWhereInput `json:"and,omitempty"`
+// ExchangeRateWhereInput represents a where input for filtering ExchangeRate queries.
+type ExchangeRateWhereInput struct {
+	Predicates []predicate.ExchangeRate  `json:"-"`
+	Not        *ExchangeRateWhereInput   `json:"not,omitempty"`
+	Or         []*ExchangeRateWhereInput `json:"or,omitempty"`
+	And        []*ExchangeRateWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -4663,96 +5467,93 @@ type ProjectWhereInput struct {
 	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
 	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
-	// "name" field predicates.
-	Name             *string  `json:"name,omitempty"`
-	NameNEQ          *string  `json:"nameNEQ,omitempty"`
-	NameIn           []string `json:"nameIn,omitempty"`
-	NameNotIn        []string `json:"nameNotIn,omitempty"`
-	NameGT           *string  `json:"nameGT,omitempty"`
-	NameGTE          *string  `json:"nameGTE,omitempty"`
-	NameLT           *string  `json:"nameLT,omitempty"`
-	NameLTE          *string  `json:"nameLTE,omitempty"`
-	NameContains     *string  `json:"nameContains,omitempty"`
-	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
-	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
-	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
-	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// "from_currency" field predicates.
+	FromCurrency             *string  `json:"fromCurrency,omitempty"`
+	FromCurrencyNEQ          *string  `json:"fromCurrencyNEQ,omitempty"`
+	FromCurrencyIn           []string `json:"fromCurrencyIn,omitempty"`
+	FromCurrencyNotIn        []string `json:"fromCurrencyNotIn,omitempty"`
+	FromCurrencyGT           *string  `json:"fromCurrencyGT,omitempty"`
+	FromCurrencyGTE          *string  `json:"fromCurrencyGTE,omitempty"`
+	FromCurrencyLT           *string  `json:"fromCurrencyLT,omitempty"`
+	FromCurrencyLTE          *string  `json:"fromCurrencyLTE,omitempty"`
+	FromCurrencyContains     *string  `json:"fromCurrencyContains,omitempty"`
+	FromCurrencyHasPrefix    *string  `json:"fromCurrencyHasPrefix,omitempty"`
+	FromCurrencyHasSuffix    *string  `json:"fromCurrencyHasSuffix,omitempty"`
+	FromCurrencyEqualFold    *string  `json:"fromCurrencyEqualFold,omitempty"`
+	FromCurrencyContainsFold *string  `json:"fromCurrencyContainsFold,omitempty"`
+
+	// "to_currency" field predicates.
+	ToCurrency             *string  `json:"toCurrency,omitempty"`
+	ToCurrencyNEQ          *string  `json:"toCurrencyNEQ,omitempty"`
+	ToCurrencyIn           []string `json:"toCurrencyIn,omitempty"`
+	ToCurrencyNotIn        []string `json:"toCurrencyNotIn,omitempty"`
+	ToCurrencyGT           *string  `json:"toCurrencyGT,omitempty"`
+	ToCurrencyGTE          *string  `json:"toCurrencyGTE,omitempty"`
+	ToCurrencyLT           *string  `json:"toCurrencyLT,omitempty"`
+	ToCurrencyLTE          *string  `json:"toCurrencyLTE,omitempty"`
+	ToCurrencyContains     *string  `json:"toCurrencyContains,omitempty"`
+	ToCurrencyHasPrefix    *string  `json:"toCurrencyHasPrefix,omitempty"`
+	ToCurrencyHasSuffix    *string  `json:"toCurrencyHasSuffix,omitempty"`
+	ToCurrencyEqualFold    *string  `json:"toCurrencyEqualFold,omitempty"`
+	ToCurrencyContainsFold *string  `json:"toCurrencyContainsFold,omitempty"`
+
+	// "rate" field predicates.
+	Rate      *float64  `json:"rate,omitempty"`
+	RateNEQ   *float64  `json:"rateNEQ,omitempty"`
+	RateIn    []float64 `json:"rateIn,omitempty"`
+	RateNotIn []float64 `json:"rateNotIn,omitempty"`
+	RateGT    *float64  `json:"rateGT,omitempty"`
+	RateGTE   *float64  `json:"rateGTE,omitempty"`
+	RateLT    *float64  `json:"rateLT,omitempty"`
+	RateLTE   *float64  `json:"rateLTE,omitempty"`
+
+	// "effective_at" field predicates.
+	EffectiveAt      *time.Time  `json:"effectiveAt,omitempty"`
+	EffectiveAtNEQ   *time.Time  `json:"effectiveAtNEQ,omitempty"`
+	EffectiveAtIn    []time.Time `json:"effectiveAtIn,omitempty"`
+	EffectiveAtNotIn []time.Time `json:"effectiveAtNotIn,omitempty"`
+	EffectiveAtGT    *time.Time  `json:"effectiveAtGT,omitempty"`
+	EffectiveAtGTE   *time.Time  `json:"effectiveAtGTE,omitempty"`
+	EffectiveAtLT    *time.Time  `json:"effectiveAtLT,omitempty"`
+	EffectiveAtLTE   *time.Time  `json:"effectiveAtLTE,omitempty"`
 
-	// "description" field predicates.
-	Description             *string  `json:"description,omitempty"`
-	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
-	DescriptionIn           []string `json:"descriptionIn,omitempty"`
-	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
-	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
-	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
-	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
-	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
-	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
-	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
-	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
-	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
-	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
-
-	// "status" field predicates.
-	Status      *project.Status  `json:"status,omitempty"`
-	StatusNEQ   *project.Status  `json:"statusNEQ,omitempty"`
-	StatusIn    []project.Status `json:"statusIn,omitempty"`
-	StatusNotIn []project.Status `json:"statusNotIn,omitempty"`
-
-	// "users" edge predicates.
-	HasUsers     *bool             `json:"hasUsers,omitempty"`
-	HasUsersWith []*UserWhereInput `json:"hasUsersWith,omitempty"`
-
-	// "roles" edge predicates.
-	HasRoles     *bool             `json:"hasRoles,omitempty"`
-	HasRolesWith []*RoleWhereInput `json:"hasRolesWith,omitempty"`
-
-	// "api_keys" edge predicates.
-	HasAPIKeys     *bool               `json:"hasAPIKeys,omitempty"`
-	HasAPIKeysWith []*APIKeyWhereInput `json:"hasAPIKeysWith,omitempty"`
-
-	// "requests" edge predicates.
-	HasRequests     *bool                `json:"hasRequests,omitempty"`
-	HasRequestsWith []*RequestWhereInput `json:"hasRequestsWith,omitempty"`
-
-	// "usage_logs" edge predicates.
-	HasUsageLogs     *bool                 `json:"hasUsageLogs,omitempty"`
-	HasUsageLogsWith []*UsageLogWhereInput `json:"hasUsageLogsWith,omitempty"`
-
-	// "threads" edge predicates.
-	HasThreads     *bool               `json:"hasThreads,omitempty"`
-	HasThreadsWith []*ThreadWhereInput `json:"hasThreadsWith,omitempty"`
-
-	// "traces" edge predicates.
-	HasTraces     *bool              `json:"hasTraces,omitempty"`
-	HasTracesWith []*TraceWhereInput `json:"hasTracesWith,omitempty"`
-
-	// "prompts" edge predicates.
-	HasPrompts     *bool               `json:"hasPrompts,omitempty"`
-	HasPromptsWith []*PromptWhereInput `json:"hasPromptsWith,omitempty"`
-
-	// "api_key_profile_templates" edge predicates.
-	HasAPIKeyProfileTemplates     *bool                              `json:"hasAPIKeyProfileTemplates,omitempty"`
-	HasAPIKeyProfileTemplatesWith []*APIKeyProfileTemplateWhereInput `json:"hasAPIKeyProfileTemplatesWith,omitempty"`
-
-	// "project_users" edge predicates.
-	HasProjectUsers     *bool                    `json:"hasProjectUsers,omitempty"`
-	HasProjectUsersWith []*UserProjectWhereInput `json:"hasProjectUsersWith,omitempty"`
+	// "source" field predicates.
+	Source      *exchangerate.Source  `json:"source,omitempty"`
+	SourceNEQ   *exchangerate.Source  `json:"sourceNEQ,omitempty"`
+	SourceIn    []exchangerate.Source `json:"sourceIn,omitempty"`
+	SourceNotIn []exchangerate.Source `json:"sourceNotIn,omitempty"`
+
+	// "import_source" field predicates.
+	ImportSource             *string  `json:"importSource,omitempty"`
+	ImportSourceNEQ          *string  `json:"importSourceNEQ,omitempty"`
+	ImportSourceIn           []string `json:"importSourceIn,omitempty"`
+	ImportSourceNotIn        []string `json:"importSourceNotIn,omitempty"`
+	ImportSourceGT           *string  `json:"importSourceGT,omitempty"`
+	ImportSourceGTE          *string  `json:"importSourceGTE,omitempty"`
+	ImportSourceLT           *string  `json:"importSourceLT,omitempty"`
+	ImportSourceLTE          *string  `json:"importSourceLTE,omitempty"`
+	ImportSourceContains     *string  `json:"importSourceContains,omitempty"`
+	ImportSourceHasPrefix    *string  `json:"importSourceHasPrefix,omitempty"`
+	ImportSourceHasSuffix    *string  `json:"importSourceHasSuffix,omitempty"`
+	ImportSourceIsNil        bool     `json:"importSourceIsNil,omitempty"`
+	ImportSourceNotNil       bool     `json:"importSourceNotNil,omitempty"`
+	ImportSourceEqualFold    *string  `json:"importSourceEqualFold,omitempty"`
+	ImportSourceContainsFold *string  `json:"importSourceContainsFold,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *ProjectWhereInput) AddPredicates(predicates ...predicate.Project) {
+func (i *ExchangeRateWhereInput) AddPredicates(predicates ...predicate.ExchangeRate) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the ProjectWhereInput filter on the ProjectQuery builder.
-func (i *ProjectWhereInput) Filter(q *ProjectQuery) (*ProjectQuery, error) {
+// Filter applies the ExchangeRateWhereInput filter on the ExchangeRateQuery builder.
+func (i *ExchangeRateWhereInput) Filter(q *ExchangeRateQuery) (*ExchangeRateQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyProjectWhereInput {
+		if err == ErrEmptyExchangeRateWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -4760,19 +5561,19 @@ func (i *ProjectWhereInput) Filter(q *ProjectQuery) (*ProjectQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyProjectWhereInput is returned in case the ProjectWhereInput is empty.
-var ErrEmptyProjectWhereInput = errors.New("ent: empty predicate ProjectWhereInput")
+// ErrEmptyExchangeRateWhereInput is returned in case the ExchangeRateWhereInput is empty.
+var ErrEmptyExchangeRateWhereInput = errors.New("ent: empty predicate ExchangeRateWhereInput")
 
-// P returns a predicate for filtering projects.
+// P returns a predicate for filtering exchangerates.
 // An error is returned if the input is empty or invalid.
-func (i *ProjectWhereInput) P() (predicate.Project, error) {
-	var predicates []predicate.Project
+func (i *ExchangeRateWhereInput) P() (predicate.ExchangeRate, error) {
+	var predicates []predicate.ExchangeRate
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, project.Not(p))
+		predicates = append(predicates, exchangerate.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -4782,7 +5583,7 @@ func (i *ProjectWhereInput) P() (predicate.Project, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Project, 0, n)
+		or := make([]predicate.ExchangeRate, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -4790,7 +5591,7 @@ func (i *ProjectWhereInput) P() (predicate.Project, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, project.Or(or...))
+		predicates = append(predicates, exchangerate.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -4800,7 +5601,7 @@ func (i *ProjectWhereInput) P() (predicate.Project, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Project, 0, n)
+		and := make([]predicate.ExchangeRate, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -4808,368 +5609,281 @@ func (i *ProjectWhereInput) P() (predicate.Project, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, project.And(and...))
+		predicates = append(predicates, exchangerate.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, project.IDEQ(*i.ID))
+		predicates = append(predicates, exchangerate.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, project.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, exchangerate.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, project.IDIn(i.IDIn...))
+		predicates = append(predicates, exchangerate.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, project.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, exchangerate.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, project.IDGT(*i.IDGT))
+		predicates = append(predicates, exchangerate.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, project.IDGTE(*i.IDGTE))
+		predicates = append(predicates, exchangerate.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, project.IDLT(*i.IDLT))
+		predicates = append(predicates, exchangerate.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, project.IDLTE(*i.IDLTE))
+		predicates = append(predicates, exchangerate.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, project.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, exchangerate.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, project.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, exchangerate.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, project.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, exchangerate.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, project.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, exchangerate.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, project.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, exchangerate.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, project.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, exchangerate.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, project.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, exchangerate.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, project.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, exchangerate.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, project.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, exchangerate.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, project.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, exchangerate.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, project.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, exchangerate.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, project.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, exchangerate.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, project.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, exchangerate.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, project.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, exchangerate.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, project.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, exchangerate.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, project.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, exchangerate.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.Name != nil {
-		predicates = append(predicates, project.NameEQ(*i.Name))
+	if i.FromCurrency != nil {
+		predicates = append(predicates, exchangerate.FromCurrencyEQ(*i.FromCurrency))
 	}
-	if i.NameNEQ != nil {
-		predicates = append(predicates, project.NameNEQ(*i.NameNEQ))
+	if i.FromCurrencyNEQ != nil {
+		predicates = append(predicates, exchangerate.FromCurrencyNEQ(*i.FromCurrencyNEQ))
 	}
-	if len(i.NameIn) > 0 {
-		predicates = append(predicates, project.NameIn(i.NameIn...))
+	if len(i.FromCurrencyIn) > 0 {
+		predicates = append(predicates, exchangerate.FromCurrencyIn(i.FromCurrencyIn...))
 	}
-	if len(i.NameNotIn) > 0 {
-		predicates = append(predicates, project.NameNotIn(i.NameNotIn...))
+	if len(i.FromCurrencyNotIn) > 0 {
+		predicates = append(predicates, exchangerate.FromCurrencyNotIn(i.FromCurrencyNotIn...))
 	}
-	if i.NameGT != nil {
-		predicates = append(predicates, project.NameGT(*i.NameGT))
+	if i.FromCurrencyGT != nil {
+		predicates = append(predicates, exchangerate.FromCurrencyGT(*i.FromCurrencyGT))
 	}
-	if i.NameGTE != nil {
-		predicates = append(predicates, project.NameGTE(*i.NameGTE))
+	if i.FromCurrencyGTE != nil {
+		predicates = append(predicates, exchangerate.FromCurrencyGTE(*i.FromCurrencyGTE))
 	}
-	if i.NameLT != nil {
-		predicates = append(predicates, project.NameLT(*i.NameLT))
+	if i.FromCurrencyLT != nil {
+		predicates = append(predicates, exchangerate.FromCurrencyLT(*i.FromCurrencyLT))
 	}
-	if i.NameLTE != nil {
-		predicates = append(predicates, project.NameLTE(*i.NameLTE))
+	if i.FromCurrencyLTE != nil {
+		predicates = append(predicates, exchangerate.FromCurrencyLTE(*i.FromCurrencyLTE))
 	}
-	if i.NameContains != nil {
-		predicates = append(predicates, project.NameContains(*i.NameContains))
+	if i.FromCurrencyContains != nil {
+		predicates = append(predicates, exchangerate.FromCurrencyContains(*i.FromCurrencyContains))
 	}
-	if i.NameHasPrefix != nil {
-		predicates = append(predicates, project.NameHasPrefix(*i.NameHasPrefix))
+	if i.FromCurrencyHasPrefix != nil {
+		predicates = append(predicates, exchangerate.FromCurrencyHasPrefix(*i.FromCurrencyHasPrefix))
 	}
-	if i.NameHasSuffix != nil {
-		predicates = append(predicates, project.NameHasSuffix(*i.NameHasSuffix))
+	if i.FromCurrencyHasSuffix != nil {
+		predicates = append(predicates, exchangerate.FromCurrencyHasSuffix(*i.FromCurrencyHasSuffix))
 	}
-	if i.NameEqualFold != nil {
-		predicates = append(predicates, project.NameEqualFold(*i.NameEqualFold))
+	if i.FromCurrencyEqualFold != nil {
+		predicates = append(predicates, exchangerate.FromCurrencyEqualFold(*i.FromCurrencyEqualFold))
 	}
-	if i.NameContainsFold != nil {
-		predicates = append(predicates, project.NameContainsFold(*i.NameContainsFold))
+	if i.FromCurrencyContainsFold != nil {
+		predicates = append(predicates, exchangerate.FromCurrencyContainsFold(*i.FromCurrencyContainsFold))
 	}
-	if i.Description != nil {
-		predicates = append(predicates, project.DescriptionEQ(*i.Description))
+	if i.ToCurrency != nil {
+		predicates = append(predicates, exchangerate.ToCurrencyEQ(*i.ToCurrency))
 	}
-	if i.DescriptionNEQ != nil {
-		predicates = append(predicates, project.DescriptionNEQ(*i.DescriptionNEQ))
+	if i.ToCurrencyNEQ != nil {
+		predicates = append(predicates, exchangerate.ToCurrencyNEQ(*i.ToCurrencyNEQ))
 	}
-	if len(i.DescriptionIn) > 0 {
-		predicates = append(predicates, project.DescriptionIn(i.DescriptionIn...))
+	if len(i.ToCurrencyIn) > 0 {
+		predicates = append(predicates, exchangerate.ToCurrencyIn(i.ToCurrencyIn...))
 	}
-	if len(i.DescriptionNotIn) > 0 {
-		predicates = append(predicates, project.DescriptionNotIn(i.DescriptionNotIn...))
+	if len(i.ToCurrencyNotIn) > 0 {
+		predicates = append(predicates, exchangerate.ToCurrencyNotIn(i.ToCurrencyNotIn...))
 	}
-	if i.DescriptionGT != nil {
-		predicates = append(predicates, project.DescriptionGT(*i.DescriptionGT))
+	if i.ToCurrencyGT != nil {
+		predicates = append(predicates, exchangerate.ToCurrencyGT(*i.ToCurrencyGT))
 	}
-	if i.DescriptionGTE != nil {
-		predicates = append(predicates, project.DescriptionGTE(*i.DescriptionGTE))
+	if i.ToCurrencyGTE != nil {
+		predicates = append(predicates, exchangerate.ToCurrencyGTE(*i.ToCurrencyGTE))
 	}
-	if i.DescriptionLT != nil {
-		predicates = append(predicates, project.DescriptionLT(*i.DescriptionLT))
+	if i.ToCurrencyLT != nil {
+		predicates = append(predicates, exchangerate.ToCurrencyLT(*i.ToCurrencyLT))
 	}
-	if i.DescriptionLTE != nil {
-		predicates = append(predicates, project.DescriptionLTE(*i.DescriptionLTE))
+	if i.ToCurrencyLTE != nil {
+		predicates = append(predicates, exchangerate.ToCurrencyLTE(*i.ToCurrencyLTE))
 	}
-	if i.DescriptionContains != nil {
-		predicates = append(predicates, project.DescriptionContains(*i.DescriptionContains))
+	if i.ToCurrencyContains != nil {
+		predicates = append(predicates, exchangerate.ToCurrencyContains(*i.ToCurrencyContains))
 	}
-	if i.DescriptionHasPrefix != nil {
-		predicates = append(predicates, project.DescriptionHasPrefix(*i.DescriptionHasPrefix))
+	if i.ToCurrencyHasPrefix != nil {
+		predicates = append(predicates, exchangerate.ToCurrencyHasPrefix(*i.ToCurrencyHasPrefix))
 	}
-	if i.DescriptionHasSuffix != nil {
-		predicates = append(predicates, project.DescriptionHasSuffix(*i.DescriptionHasSuffix))
+	if i.ToCurrencyHasSuffix != nil {
+		predicates = append(predicates, exchangerate.ToCurrencyHasSuffix(*i.ToCurrencyHasSuffix))
 	}
-	if i.DescriptionEqualFold != nil {
-		predicates = append(predicates, project.DescriptionEqualFold(*i.DescriptionEqualFold))
+	if i.ToCurrencyEqualFold != nil {
+		predicates = append(predicates, exchangerate.ToCurrencyEqualFold(*i.ToCurrencyEqualFold))
 	}
-	if i.DescriptionContainsFold != nil {
-		predicates = append(predicates, project.DescriptionContainsFold(*i.DescriptionContainsFold))
+	if i.ToCurrencyContainsFold != nil {
+		predicates = append(predicates, exchangerate.ToCurrencyContainsFold(*i.ToCurrencyContainsFold))
 	}
-	if i.Status != nil {
-		predicates = append(predicates, project.StatusEQ(*i.Status))
+	if i.Rate != nil {
+		predicates = append(predicates, exchangerate.RateEQ(*i.Rate))
 	}
-	if i.StatusNEQ != nil {
-		predicates = append(predicates, project.StatusNEQ(*i.StatusNEQ))
+	if i.RateNEQ != nil {
+		predicates = append(predicates, exchangerate.RateNEQ(*i.RateNEQ))
 	}
-	if len(i.StatusIn) > 0 {
-		predicates = append(predicates, project.StatusIn(i.StatusIn...))
+	if len(i.RateIn) > 0 {
+		predicates = append(predicates, exchangerate.RateIn(i.RateIn...))
 	}
-	if len(i.StatusNotIn) > 0 {
-		predicates = append(predicates, project.StatusNotIn(i.StatusNotIn...))
+	if len(i.RateNotIn) > 0 {
+		predicates = append(predicates, exchangerate.RateNotIn(i.RateNotIn...))
 	}
-
-	if i.HasUsers != nil {
-		p := project.HasUsers()
-		if !*i.HasUsers {
-			p = project.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.RateGT != nil {
+		predicates = append(predicates, exchangerate.RateGT(*i.RateGT))
 	}
-	if len(i.HasUsersWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasUsersWith))
-		for _, w := range i.HasUsersWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUsersWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, project.HasUsersWith(with...))
+	if i.RateGTE != nil {
+		predicates = append(predicates, exchangerate.RateGTE(*i.RateGTE))
 	}
-	if i.HasRoles != nil {
-		p := project.HasRoles()
-		if !*i.HasRoles {
-			p = project.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.RateLT != nil {
+		predicates = append(predicates, exchangerate.RateLT(*i.RateLT))
 	}
-	if len(i.HasRolesWith) > 0 {
-		with := make([]predicate.Role, 0, len(i.HasRolesWith))
-		for _, w := range i.HasRolesWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasRolesWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, project.HasRolesWith(with...))
+	if i.RateLTE != nil {
+		predicates = append(predicates, exchangerate.RateLTE(*i.RateLTE))
 	}
-	if i.HasAPIKeys != nil {
-		p := project.HasAPIKeys()
-		if !*i.HasAPIKeys {
-			p = project.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.EffectiveAt != nil {
+		predicates = append(predicates, exchangerate.EffectiveAtEQ(*i.EffectiveAt))
 	}
-	if len(i.HasAPIKeysWith) > 0 {
-		with := make([]predicate.APIKey, 0, len(i.HasAPIKeysWith))
-		for _, w := range i.HasAPIKeysWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasAPIKeysWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, project.HasAPIKeysWith(with...))
+	if i.EffectiveAtNEQ != nil {
+		predicates = append(predicates, exchangerate.EffectiveAtNEQ(*i.EffectiveAtNEQ))
 	}
-	if i.HasRequests != nil {
-		p := project.HasRequests()
-		if !*i.HasRequests {
-			p = project.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.EffectiveAtIn) > 0 {
+		predicates = append(predicates, exchangerate.EffectiveAtIn(i.EffectiveAtIn...))
 	}
-	if len(i.HasRequestsWith) > 0 {
-		with := make([]predicate.Request, 0, len(i.HasRequestsWith))
-		for _, w := range i.HasRequestsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasRequestsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, project.HasRequestsWith(with...))
+	if len(i.EffectiveAtNotIn) > 0 {
+		predicates = append(predicates, exchangerate.EffectiveAtNotIn(i.EffectiveAtNotIn...))
 	}
-	if i.HasUsageLogs != nil {
-		p := project.HasUsageLogs()
-		if !*i.HasUsageLogs {
-			p = project.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.EffectiveAtGT != nil {
+		predicates = append(predicates, exchangerate.EffectiveAtGT(*i.EffectiveAtGT))
 	}
-	if len(i.HasUsageLogsWith) > 0 {
-		with := make([]predicate.UsageLog, 0, len(i.HasUsageLogsWith))
-		for _, w := range i.HasUsageLogsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUsageLogsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, project.HasUsageLogsWith(with...))
+	if i.EffectiveAtGTE != nil {
+		predicates = append(predicates, exchangerate.EffectiveAtGTE(*i.EffectiveAtGTE))
 	}
-	if i.HasThreads != nil {
-		p := project.HasThreads()
-		if !*i.HasThreads {
-			p = project.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.EffectiveAtLT != nil {
+		predicates = append(predicates, exchangerate.EffectiveAtLT(*i.EffectiveAtLT))
 	}
-	if len(i.HasThreadsWith) > 0 {
-		with := make([]predicate.Thread, 0, len(i.HasThreadsWith))
-		for _, w := range i.HasThreadsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasThreadsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, project.HasThreadsWith(with...))
+	if i.EffectiveAtLTE != nil {
+		predicates = append(predicates, exchangerate.EffectiveAtLTE(*i.EffectiveAtLTE))
 	}
-	if i.HasTraces != nil {
-		p := project.HasTraces()
-		if !*i.HasTraces {
-			p = project.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.Source != nil {
+		predicates = append(predicates, exchangerate.SourceEQ(*i.Source))
 	}
-	if len(i.HasTracesWith) > 0 {
-		with := make([]predicate.Trace, 0, len(i.HasTracesWith))
-		for _, w := range i.HasTracesWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasTracesWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, project.HasTracesWith(with...))
+	if i.SourceNEQ != nil {
+		predicates = append(predicates, exchangerate.SourceNEQ(*i.SourceNEQ))
 	}
-	if i.HasPrompts != nil {
-		p := project.HasPrompts()
-		if !*i.HasPrompts {
-			p = project.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.SourceIn) > 0 {
+		predicates = append(predicates, exchangerate.SourceIn(i.SourceIn...))
 	}
-	if len(i.HasPromptsWith) > 0 {
-		with := make([]predicate.Prompt, 0, len(i.HasPromptsWith))
-		for _, w := range i.HasPromptsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasPromptsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, project.HasPromptsWith(with...))
+	if len(i.SourceNotIn) > 0 {
+		predicates = append(predicates, exchangerate.SourceNotIn(i.SourceNotIn...))
 	}
-	if i.HasAPIKeyProfileTemplates != nil {
-		p := project.HasAPIKeyProfileTemplates()
-		if !*i.HasAPIKeyProfileTemplates {
-			p = project.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.ImportSource != nil {
+		predicates = append(predicates, exchangerate.ImportSourceEQ(*i.ImportSource))
 	}
-	if len(i.HasAPIKeyProfileTemplatesWith) > 0 {
-		with := make([]predicate.APIKeyProfileTemplate, 0, len(i.HasAPIKeyProfileTemplatesWith))
-		for _, w := range i.HasAPIKeyProfileTemplatesWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasAPIKeyProfileTemplatesWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, project.HasAPIKeyProfileTemplatesWith(with...))
+	if i.ImportSourceNEQ != nil {
+		predicates = append(predicates, exchangerate.ImportSourceNEQ(*i.ImportSourceNEQ))
 	}
-	if i.HasProjectUsers != nil {
-		p := project.HasProjectUsers()
-		if !*i.HasProjectUsers {
-			p = project.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.ImportSourceIn) > 0 {
+		predicates = append(predicates, exchangerate.ImportSourceIn(i.ImportSourceIn...))
 	}
-	if len(i.HasProjectUsersWith) > 0 {
-		with := make([]predicate.UserProject, 0, len(i.HasProjectUsersWith))
-		for _, w := range i.HasProjectUsersWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasProjectUsersWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, project.HasProjectUsersWith(with...))
+	if len(i.ImportSourceNotIn) > 0 {
+		predicates = append(predicates, exchangerate.ImportSourceNotIn(i.ImportSourceNotIn...))
+	}
+	if i.ImportSourceGT != nil {
+		predicates = append(predicates, exchangerate.ImportSourceGT(*i.ImportSourceGT))
+	}
+	if i.ImportSourceGTE != nil {
+		predicates = append(predicates, exchangerate.ImportSourceGTE(*i.ImportSourceGTE))
+	}
+	if i.ImportSourceLT != nil {
+		predicates = append(predicates, exchangerate.ImportSourceLT(*i.ImportSourceLT))
+	}
+	if i.ImportSourceLTE != nil {
+		predicates = append(predicates, exchangerate.ImportSourceLTE(*i.ImportSourceLTE))
+	}
+	if i.ImportSourceContains != nil {
+		predicates = append(predicates, exchangerate.ImportSourceContains(*i.ImportSourceContains))
+	}
+	if i.ImportSourceHasPrefix != nil {
+		predicates = append(predicates, exchangerate.ImportSourceHasPrefix(*i.ImportSourceHasPrefix))
+	}
+	if i.ImportSourceHasSuffix != nil {
+		predicates = append(predicates, exchangerate.ImportSourceHasSuffix(*i.ImportSourceHasSuffix))
+	}
+	if i.ImportSourceIsNil {
+		predicates = append(predicates, exchangerate.ImportSourceIsNil())
+	}
+	if i.ImportSourceNotNil {
+		predicates = append(predicates, exchangerate.ImportSourceNotNil())
+	}
+	if i.ImportSourceEqualFold != nil {
+		predicates = append(predicates, exchangerate.ImportSourceEqualFold(*i.ImportSourceEqualFold))
 	}
+	if i.ImportSourceContainsFold != nil {
+		predicates = append(predicates, exchangerate.ImportSourceContainsFold(*i.ImportSourceContainsFold))
+	}
+
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyProjectWhereInput
+		return nil, ErrEmptyExchangeRateWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return project.And(predicates...), nil
+		return exchangerate.And(predicates...), nil
 	}
 }
 
-// PromptWhereInput represents a where input for filtering Prompt queries.
-type PromptWhereInput struct {
-	Predicates []predicate.Prompt  `json:"-"`
-	Not        *PromptWhereInput   `json:"not,omitempty"`
-	Or         []*PromptWhereInput `json:"or,omitempty"`
-	And        []*PromptWhereInput `json:"and,omitempty"`
+// InvoiceWhereInput represents a where input for filtering Invoice queries.
+type InvoiceWhereInput struct {
+	Predicates []predicate.Invoice  `json:"-"`
+	Not        *InvoiceWhereInput   `json:"not,omitempty"`
+	Or         []*InvoiceWhereInput `json:"or,omitempty"`
+	And        []*InvoiceWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -5207,81 +5921,95 @@ type PromptWhereInput struct {
 	ProjectIDIn    []int `json:"projectIDIn,omitempty"`
 	ProjectIDNotIn []int `json:"projectIDNotIn,omitempty"`
 
-	// "name" field predicates.
-	Name             *string  `json:"name,omitempty"`
-	NameNEQ          *string  `json:"nameNEQ,omitempty"`
-	NameIn           []string `json:"nameIn,omitempty"`
-	NameNotIn        []string `json:"nameNotIn,omitempty"`
-	NameGT           *string  `json:"nameGT,omitempty"`
-	NameGTE          *string  `json:"nameGTE,omitempty"`
-	NameLT           *string  `json:"nameLT,omitempty"`
-	NameLTE          *string  `json:"nameLTE,omitempty"`
-	NameContains     *string  `json:"nameContains,omitempty"`
-	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
-	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
-	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
-	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
-
-	// "description" field predicates.
-	Description             *string  `json:"description,omitempty"`
-	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
-	DescriptionIn           []string `json:"descriptionIn,omitempty"`
-	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
-	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
-	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
-	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
-	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
-	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
-	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
-	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
-	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
-	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
-
-	// "role" field predicates.
-	Role             *string  `json:"role,omitempty"`
-	RoleNEQ          *string  `json:"roleNEQ,omitempty"`
-	RoleIn           []string `json:"roleIn,omitempty"`
-	RoleNotIn        []string `json:"roleNotIn,omitempty"`
-	RoleGT           *string  `json:"roleGT,omitempty"`
-	RoleGTE          *string  `json:"roleGTE,omitempty"`
-	RoleLT           *string  `json:"roleLT,omitempty"`
-	RoleLTE          *string  `json:"roleLTE,omitempty"`
-	RoleContains     *string  `json:"roleContains,omitempty"`
-	RoleHasPrefix    *string  `json:"roleHasPrefix,omitempty"`
-	RoleHasSuffix    *string  `json:"roleHasSuffix,omitempty"`
-	RoleEqualFold    *string  `json:"roleEqualFold,omitempty"`
-	RoleContainsFold *string  `json:"roleContainsFold,omitempty"`
-
-	// "content" field predicates.
-	Content             *string  `json:"content,omitempty"`
-	ContentNEQ          *string  `json:"contentNEQ,omitempty"`
-	ContentIn           []string `json:"contentIn,omitempty"`
-	ContentNotIn        []string `json:"contentNotIn,omitempty"`
-	ContentGT           *string  `json:"contentGT,omitempty"`
-	ContentGTE          *string  `json:"contentGTE,omitempty"`
-	ContentLT           *string  `json:"contentLT,omitempty"`
-	ContentLTE          *string  `json:"contentLTE,omitempty"`
-	ContentContains     *string  `json:"contentContains,omitempty"`
-	ContentHasPrefix    *string  `json:"contentHasPrefix,omitempty"`
-	ContentHasSuffix    *string  `json:"contentHasSuffix,omitempty"`
-	ContentEqualFold    *string  `json:"contentEqualFold,omitempty"`
-	ContentContainsFold *string  `json:"contentContainsFold,omitempty"`
-
-	// "status" field predicates.
-	Status      *prompt.Status  `json:"status,omitempty"`
-	StatusNEQ   *prompt.Status  `json:"statusNEQ,omitempty"`
-	StatusIn    []prompt.Status `json:"statusIn,omitempty"`
-	StatusNotIn []prompt.Status `json:"statusNotIn,omitempty"`
-
-	// "order" field predicates.
-	Order      *int  `json:"order,omitempty"`
-	OrderNEQ   *int  `json:"orderNEQ,omitempty"`
-	OrderIn    []int `json:"orderIn,omitempty"`
-	OrderNotIn []int `json:"orderNotIn,omitempty"`
-	OrderGT    *int  `json:"orderGT,omitempty"`
-	OrderGTE   *int  `json:"orderGTE,omitempty"`
-	OrderLT    *int  `json:"orderLT,omitempty"`
-	OrderLTE   *int  `json:"orderLTE,omitempty"`
+	// "number" field predicates.
+	Number             *string  `json:"number,omitempty"`
+	NumberNEQ          *string  `json:"numberNEQ,omitempty"`
+	NumberIn           []string `json:"numberIn,omitempty"`
+	NumberNotIn        []string `json:"numberNotIn,omitempty"`
+	NumberGT           *string  `json:"numberGT,omitempty"`
+	NumberGTE          *string  `json:"numberGTE,omitempty"`
+	NumberLT           *string  `json:"numberLT,omitempty"`
+	NumberLTE          *string  `json:"numberLTE,omitempty"`
+	NumberContains     *string  `json:"numberContains,omitempty"`
+	NumberHasPrefix    *string  `json:"numberHasPrefix,omitempty"`
+	NumberHasSuffix    *string  `json:"numberHasSuffix,omitempty"`
+	NumberEqualFold    *string  `json:"numberEqualFold,omitempty"`
+	NumberContainsFold *string  `json:"numberContainsFold,omitempty"`
+
+	// "period_start" field predicates.
+	PeriodStart      *time.Time  `json:"periodStart,omitempty"`
+	PeriodStartNEQ   *time.Time  `json:"periodStartNEQ,omitempty"`
+	PeriodStartIn    []time.Time `json:"periodStartIn,omitempty"`
+	PeriodStartNotIn []time.Time `json:"periodStartNotIn,omitempty"`
+	PeriodStartGT    *time.Time  `json:"periodStartGT,omitempty"`
+	PeriodStartGTE   *time.Time  `json:"periodStartGTE,omitempty"`
+	PeriodStartLT    *time.Time  `json:"periodStartLT,omitempty"`
+	PeriodStartLTE   *time.Time  `json:"periodStartLTE,omitempty"`
+
+	// "period_end" field predicates.
+	PeriodEnd      *time.Time  `json:"periodEnd,omitempty"`
+	PeriodEndNEQ   *time.Time  `json:"periodEndNEQ,omitempty"`
+	PeriodEndIn    []time.Time `json:"periodEndIn,omitempty"`
+	PeriodEndNotIn []time.Time `json:"periodEndNotIn,omitempty"`
+	PeriodEndGT    *time.Time  `json:"periodEndGT,omitempty"`
+	PeriodEndGTE   *time.Time  `json:"periodEndGTE,omitempty"`
+	PeriodEndLT    *time.Time  `json:"periodEndLT,omitempty"`
+	PeriodEndLTE   *time.Time  `json:"periodEndLTE,omitempty"`
+
+	// "currency" field predicates.
+	Currency             *string  `json:"currency,omitempty"`
+	CurrencyNEQ          *string  `json:"currencyNEQ,omitempty"`
+	CurrencyIn           []string `json:"currencyIn,omitempty"`
+	CurrencyNotIn        []string `json:"currencyNotIn,omitempty"`
+	CurrencyGT           *string  `json:"currencyGT,omitempty"`
+	CurrencyGTE          *string  `json:"currencyGTE,omitempty"`
+	CurrencyLT           *string  `json:"currencyLT,omitempty"`
+	CurrencyLTE          *string  `json:"currencyLTE,omitempty"`
+	CurrencyContains     *string  `json:"currencyContains,omitempty"`
+	CurrencyHasPrefix    *string  `json:"currencyHasPrefix,omitempty"`
+	CurrencyHasSuffix    *string  `json:"currencyHasSuffix,omitempty"`
+	CurrencyEqualFold    *string  `json:"currencyEqualFold,omitempty"`
+	CurrencyContainsFold *string  `json:"currencyContainsFold,omitempty"`
+
+	// "subtotal" field predicates.
+	Subtotal      *float64  `json:"subtotal,omitempty"`
+	SubtotalNEQ   *float64  `json:"subtotalNEQ,omitempty"`
+	SubtotalIn    []float64 `json:"subtotalIn,omitempty"`
+	SubtotalNotIn []float64 `json:"subtotalNotIn,omitempty"`
+	SubtotalGT    *float64  `json:"subtotalGT,omitempty"`
+	SubtotalGTE   *float64  `json:"subtotalGTE,omitempty"`
+	SubtotalLT    *float64  `json:"subtotalLT,omitempty"`
+	SubtotalLTE   *float64  `json:"subtotalLTE,omitempty"`
+
+	// "markup_amount" field predicates.
+	MarkupAmount      *float64  `json:"markupAmount,omitempty"`
+	MarkupAmountNEQ   *float64  `json:"markupAmountNEQ,omitempty"`
+	MarkupAmountIn    []float64 `json:"markupAmountIn,omitempty"`
+	MarkupAmountNotIn []float64 `json:"markupAmountNotIn,omitempty"`
+	MarkupAmountGT    *float64  `json:"markupAmountGT,omitempty"`
+	MarkupAmountGTE   *float64  `json:"markupAmountGTE,omitempty"`
+	MarkupAmountLT    *float64  `json:"markupAmountLT,omitempty"`
+	MarkupAmountLTE   *float64  `json:"markupAmountLTE,omitempty"`
+
+	// "fee" field predicates.
+	Fee      *float64  `json:"fee,omitempty"`
+	FeeNEQ   *float64  `json:"feeNEQ,omitempty"`
+	FeeIn    []float64 `json:"feeIn,omitempty"`
+	FeeNotIn []float64 `json:"feeNotIn,omitempty"`
+	FeeGT    *float64  `json:"feeGT,omitempty"`
+	FeeGTE   *float64  `json:"feeGTE,omitempty"`
+	FeeLT    *float64  `json:"feeLT,omitempty"`
+	FeeLTE   *float64  `json:"feeLTE,omitempty"`
+
+	// "total" field predicates.
+	Total      *float64  `json:"total,omitempty"`
+	TotalNEQ   *float64  `json:"totalNEQ,omitempty"`
+	TotalIn    []float64 `json:"totalIn,omitempty"`
+	TotalNotIn []float64 `json:"totalNotIn,omitempty"`
+	TotalGT    *float64  `json:"totalGT,omitempty"`
+	TotalGTE   *float64  `json:"totalGTE,omitempty"`
+	TotalLT    *float64  `json:"totalLT,omitempty"`
+	TotalLTE   *float64  `json:"totalLTE,omitempty"`
 
 	// "project" edge predicates.
 	HasProject     *bool                `json:"hasProject,omitempty"`
@@ -5289,18 +6017,18 @@ type PromptWhereInput struct {
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *PromptWhereInput) AddPredicates(predicates ...predicate.Prompt) {
+func (i *InvoiceWhereInput) AddPredicates(predicates ...predicate.Invoice) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the PromptWhereInput filter on the PromptQuery builder.
-func (i *PromptWhereInput) Filter(q *PromptQuery) (*PromptQuery, error) {
+// Filter applies the InvoiceWhereInput filter on the InvoiceQuery builder.
+func (i *InvoiceWhereInput) Filter(q *InvoiceQuery) (*InvoiceQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyPromptWhereInput {
+		if err == ErrEmptyInvoiceWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -5308,19 +6036,19 @@ func (i *PromptWhereInput) Filter(q *PromptQuery) (*PromptQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyPromptWhereInput is returned in case the PromptWhereInput is empty.
-var ErrEmptyPromptWhereInput = errors.New("ent: empty predicate PromptWhereInput")
+// ErrEmptyInvoiceWhereInput is returned in case the InvoiceWhereInput is empty.
+var ErrEmptyInvoiceWhereInput = errors.New("ent: empty predicate InvoiceWhereInput")
 
-// P returns a predicate for filtering prompts.
+// P returns a predicate for filtering invoices.
 // An error is returned if the input is empty or invalid.
-func (i *PromptWhereInput) P() (predicate.Prompt, error) {
-	var predicates []predicate.Prompt
+func (i *InvoiceWhereInput) P() (predicate.Invoice, error) {
+	var predicates []predicate.Invoice
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, prompt.Not(p))
+		predicates = append(predicates, invoice.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -5330,7 +6058,7 @@ func (i *PromptWhereInput) P() (predicate.Prompt, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Prompt, 0, n)
+		or := make([]predicate.Invoice, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -5338,7 +6066,7 @@ func (i *PromptWhereInput) P() (predicate.Prompt, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, prompt.Or(or...))
+		predicates = append(predicates, invoice.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -5348,7 +6076,7 @@ func (i *PromptWhereInput) P() (predicate.Prompt, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Prompt, 0, n)
+		and := make([]predicate.Invoice, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -5356,290 +6084,320 @@ func (i *PromptWhereInput) P() (predicate.Prompt, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, prompt.And(and...))
+		predicates = append(predicates, invoice.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, prompt.IDEQ(*i.ID))
+		predicates = append(predicates, invoice.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, prompt.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, invoice.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, prompt.IDIn(i.IDIn...))
+		predicates = append(predicates, invoice.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, prompt.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, invoice.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, prompt.IDGT(*i.IDGT))
+		predicates = append(predicates, invoice.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, prompt.IDGTE(*i.IDGTE))
+		predicates = append(predicates, invoice.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, prompt.IDLT(*i.IDLT))
+		predicates = append(predicates, invoice.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, prompt.IDLTE(*i.IDLTE))
+		predicates = append(predicates, invoice.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, prompt.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, invoice.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, prompt.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, invoice.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, prompt.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, invoice.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, prompt.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, invoice.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, prompt.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, invoice.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, prompt.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, invoice.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, prompt.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, invoice.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, prompt.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, invoice.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, prompt.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, invoice.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, prompt.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, invoice.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, prompt.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, invoice.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, prompt.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, invoice.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, prompt.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, invoice.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, prompt.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, invoice.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, prompt.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, invoice.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, prompt.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, invoice.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.ProjectID != nil {
-		predicates = append(predicates, prompt.ProjectIDEQ(*i.ProjectID))
+		predicates = append(predicates, invoice.ProjectIDEQ(*i.ProjectID))
 	}
 	if i.ProjectIDNEQ != nil {
-		predicates = append(predicates, prompt.ProjectIDNEQ(*i.ProjectIDNEQ))
+		predicates = append(predicates, invoice.ProjectIDNEQ(*i.ProjectIDNEQ))
 	}
 	if len(i.ProjectIDIn) > 0 {
-		predicates = append(predicates, prompt.ProjectIDIn(i.ProjectIDIn...))
+		predicates = append(predicates, invoice.ProjectIDIn(i.ProjectIDIn...))
 	}
 	if len(i.ProjectIDNotIn) > 0 {
-		predicates = append(predicates, prompt.ProjectIDNotIn(i.ProjectIDNotIn...))
+		predicates = append(predicates, invoice.ProjectIDNotIn(i.ProjectIDNotIn...))
 	}
-	if i.Name != nil {
-		predicates = append(predicates, prompt.NameEQ(*i.Name))
+	if i.Number != nil {
+		predicates = append(predicates, invoice.NumberEQ(*i.Number))
 	}
-	if i.NameNEQ != nil {
-		predicates = append(predicates, prompt.NameNEQ(*i.NameNEQ))
+	if i.NumberNEQ != nil {
+		predicates = append(predicates, invoice.NumberNEQ(*i.NumberNEQ))
 	}
-	if len(i.NameIn) > 0 {
-		predicates = append(predicates, prompt.NameIn(i.NameIn...))
+	if len(i.NumberIn) > 0 {
+		predicates = append(predicates, invoice.NumberIn(i.NumberIn...))
 	}
-	if len(i.NameNotIn) > 0 {
-		predicates = append(predicates, prompt.NameNotIn(i.NameNotIn...))
+	if len(i.NumberNotIn) > 0 {
+		predicates = append(predicates, invoice.NumberNotIn(i.NumberNotIn...))
 	}
-	if i.NameGT != nil {
-		predicates = append(predicates, prompt.NameGT(*i.NameGT))
+	if i.NumberGT != nil {
+		predicates = append(predicates, invoice.NumberGT(*i.NumberGT))
 	}
-	if i.NameGTE != nil {
-		predicates = append(predicates, prompt.NameGTE(*i.NameGTE))
+	if i.NumberGTE != nil {
+		predicates = append(predicates, invoice.NumberGTE(*i.NumberGTE))
 	}
-	if i.NameLT != nil {
-		predicates = append(predicates, prompt.NameLT(*i.NameLT))
+	if i.NumberLT != nil {
+		predicates = append(predicates, invoice.NumberLT(*i.NumberLT))
 	}
-	if i.NameLTE != nil {
-		predicates = append(predicates, prompt.NameLTE(*i.NameLTE))
+	if i.NumberLTE != nil {
+		predicates = append(predicates, invoice.NumberLTE(*i.NumberLTE))
 	}
-	if i.NameContains != nil {
-		predicates = append(predicates, prompt.NameContains(*i.NameContains))
+	if i.NumberContains != nil {
+		predicates = append(predicates, invoice.NumberContains(*i.NumberContains))
 	}
-	if i.NameHasPrefix != nil {
-		predicates = append(predicates, prompt.NameHasPrefix(*i.NameHasPrefix))
+	if i.NumberHasPrefix != nil {
+		predicates = append(predicates, invoice.NumberHasPrefix(*i.NumberHasPrefix))
 	}
-	if i.NameHasSuffix != nil {
-		predicates = append(predicates, prompt.NameHasSuffix(*i.NameHasSuffix))
+	if i.NumberHasSuffix != nil {
+		predicates = append(predicates, invoice.NumberHasSuffix(*i.NumberHasSuffix))
 	}
-	if i.NameEqualFold != nil {
-		predicates = append(predicates, prompt.NameEqualFold(*i.NameEqualFold))
+	if i.NumberEqualFold != nil {
+		predicates = append(predicates, invoice.NumberEqualFold(*i.NumberEqualFold))
 	}
-	if i.NameContainsFold != nil {
-		predicates = append(predicates, prompt.NameContainsFold(*i.NameContainsFold))
+	if i.NumberContainsFold != nil {
+		predicates = append(predicates, invoice.NumberContainsFold(*i.NumberContainsFold))
 	}
-	if i.Description != nil {
-		predicates = append(predicates, prompt.DescriptionEQ(*i.Description))
+	if i.PeriodStart != nil {
+		predicates = append(predicates, invoice.PeriodStartEQ(*i.PeriodStart))
 	}
-	if i.DescriptionNEQ != nil {
-		predicates = append(predicates, prompt.DescriptionNEQ(*i.DescriptionNEQ))
+	if i.PeriodStartNEQ != nil {
+		predicates = append(predicates, invoice.PeriodStartNEQ(*i.PeriodStartNEQ))
 	}
-	if len(i.DescriptionIn) > 0 {
-		predicates = append(predicates, prompt.DescriptionIn(i.DescriptionIn...))
+	if len(i.PeriodStartIn) > 0 {
+		predicates = append(predicates, invoice.PeriodStartIn(i.PeriodStartIn...))
 	}
-	if len(i.DescriptionNotIn) > 0 {
-		predicates = append(predicates, prompt.DescriptionNotIn(i.DescriptionNotIn...))
+	if len(i.PeriodStartNotIn) > 0 {
+		predicates = append(predicates, invoice.PeriodStartNotIn(i.PeriodStartNotIn...))
 	}
-	if i.DescriptionGT != nil {
-		predicates = append(predicates, prompt.DescriptionGT(*i.DescriptionGT))
+	if i.PeriodStartGT != nil {
+		predicates = append(predicates, invoice.PeriodStartGT(*i.PeriodStartGT))
 	}
-	if i.DescriptionGTE != nil {
-		predicates = append(predicates, prompt.DescriptionGTE(*i.DescriptionGTE))
+	if i.PeriodStartGTE != nil {
+		predicates = append(predicates, invoice.PeriodStartGTE(*i.PeriodStartGTE))
 	}
-	if i.DescriptionLT != nil {
-		predicates = append(predicates, prompt.DescriptionLT(*i.DescriptionLT))
+	if i.PeriodStartLT != nil {
+		predicates = append(predicates, invoice.PeriodStartLT(*i.PeriodStartLT))
 	}
-	if i.DescriptionLTE != nil {
-		predicates = append(predicates, prompt.DescriptionLTE(*i.DescriptionLTE))
+	if i.PeriodStartLTE != nil {
+		predicates = append(predicates, invoice.PeriodStartLTE(*i.PeriodStartLTE))
 	}
-	if i.DescriptionContains != nil {
-		predicates = append(predicates, prompt.DescriptionContains(*i.DescriptionContains))
+	if i.PeriodEnd != nil {
+		predicates = append(predicates, invoice.PeriodEndEQ(*i.PeriodEnd))
 	}
-	if i.DescriptionHasPrefix != nil {
-		predicates = append(predicates, prompt.DescriptionHasPrefix(*i.DescriptionHasPrefix))
+	if i.PeriodEndNEQ != nil {
+		predicates = append(predicates, invoice.PeriodEndNEQ(*i.PeriodEndNEQ))
 	}
-	if i.DescriptionHasSuffix != nil {
-		predicates = append(predicates, prompt.DescriptionHasSuffix(*i.DescriptionHasSuffix))
+	if len(i.PeriodEndIn) > 0 {
+		predicates = append(predicates, invoice.PeriodEndIn(i.PeriodEndIn...))
 	}
-	if i.DescriptionEqualFold != nil {
-		predicates = append(predicates, prompt.DescriptionEqualFold(*i.DescriptionEqualFold))
+	if len(i.PeriodEndNotIn) > 0 {
+		predicates = append(predicates, invoice.PeriodEndNotIn(i.PeriodEndNotIn...))
 	}
-	if i.DescriptionContainsFold != nil {
-		predicates = append(predicates, prompt.DescriptionContainsFold(*i.DescriptionContainsFold))
+	if i.PeriodEndGT != nil {
+		predicates = append(predicates, invoice.PeriodEndGT(*i.PeriodEndGT))
 	}
-	if i.Role != nil {
-		predicates = append(predicates, prompt.RoleEQ(*i.Role))
+	if i.PeriodEndGTE != nil {
+		predicates = append(predicates, invoice.PeriodEndGTE(*i.PeriodEndGTE))
 	}
-	if i.RoleNEQ != nil {
-		predicates = append(predicates, prompt.RoleNEQ(*i.RoleNEQ))
+	if i.PeriodEndLT != nil {
+		predicates = append(predicates, invoice.PeriodEndLT(*i.PeriodEndLT))
 	}
-	if len(i.RoleIn) > 0 {
-		predicates = append(predicates, prompt.RoleIn(i.RoleIn...))
+	if i.PeriodEndLTE != nil {
+		predicates = append(predicates, invoice.PeriodEndLTE(*i.PeriodEndLTE))
 	}
-	if len(i.RoleNotIn) > 0 {
-		predicates = append(predicates, prompt.RoleNotIn(i.RoleNotIn...))
+	if i.Currency != nil {
+		predicates = append(predicates, invoice.CurrencyEQ(*i.Currency))
 	}
-	if i.RoleGT != nil {
-		predicates = append(predicates, prompt.RoleGT(*i.RoleGT))
+	if i.CurrencyNEQ != nil {
+		predicates = append(predicates, invoice.CurrencyNEQ(*i.CurrencyNEQ))
 	}
-	if i.RoleGTE != nil {
-		predicates = append(predicates, prompt.RoleGTE(*i.RoleGTE))
+	if len(i.CurrencyIn) > 0 {
+		predicates = append(predicates, invoice.CurrencyIn(i.CurrencyIn...))
 	}
-	if i.RoleLT != nil {
-		predicates = append(predicates, prompt.RoleLT(*i.RoleLT))
+	if len(i.CurrencyNotIn) > 0 {
+		predicates = append(predicates, invoice.CurrencyNotIn(i.CurrencyNotIn...))
 	}
-	if i.RoleLTE != nil {
-		predicates = append(predicates, prompt.RoleLTE(*i.RoleLTE))
+	if i.CurrencyGT != nil {
+		predicates = append(predicates, invoice.CurrencyGT(*i.CurrencyGT))
 	}
-	if i.RoleContains != nil {
-		predicates = append(predicates, prompt.RoleContains(*i.RoleContains))
+	if i.CurrencyGTE != nil {
+		predicates = append(predicates, invoice.CurrencyGTE(*i.CurrencyGTE))
 	}
-	if i.RoleHasPrefix != nil {
-		predicates = append(predicates, prompt.RoleHasPrefix(*i.RoleHasPrefix))
+	if i.CurrencyLT != nil {
+		predicates = append(predicates, invoice.CurrencyLT(*i.CurrencyLT))
 	}
-	if i.RoleHasSuffix != nil {
-		predicates = append(predicates, prompt.RoleHasSuffix(*i.RoleHasSuffix))
+	if i.CurrencyLTE != nil {
+		predicates = append(predicates, invoice.CurrencyLTE(*i.CurrencyLTE))
 	}
-	if i.RoleEqualFold != nil {
-		predicates = append(predicates, prompt.RoleEqualFold(*i.RoleEqualFold))
+	if i.CurrencyContains != nil {
+		predicates = append(predicates, invoice.CurrencyContains(*i.CurrencyContains))
 	}
-	if i.RoleContainsFold != nil {
-		predicates = append(predicates, prompt.RoleContainsFold(*i.RoleContainsFold))
+	if i.CurrencyHasPrefix != nil {
+		predicates = append(predicates, invoice.CurrencyHasPrefix(*i.CurrencyHasPrefix))
 	}
-	if i.Content != nil {
-		predicates = append(predicates, prompt.ContentEQ(*i.Content))
+	if i.CurrencyHasSuffix != nil {
+		predicates = append(predicates, invoice.CurrencyHasSuffix(*i.CurrencyHasSuffix))
 	}
-	if i.ContentNEQ != nil {
-		predicates = append(predicates, prompt.ContentNEQ(*i.ContentNEQ))
+	if i.CurrencyEqualFold != nil {
+		predicates = append(predicates, invoice.CurrencyEqualFold(*i.CurrencyEqualFold))
 	}
-	if len(i.ContentIn) > 0 {
-		predicates = append(predicates, prompt.ContentIn(i.ContentIn...))
+	if i.CurrencyContainsFold != nil {
+		predicates = append(predicates, invoice.CurrencyContainsFold(*i.CurrencyContainsFold))
 	}
-	if len(i.ContentNotIn) > 0 {
-		predicates = append(predicates, prompt.ContentNotIn(i.ContentNotIn...))
+	if i.Subtotal != nil {
+		predicates = append(predicates, invoice.SubtotalEQ(*i.Subtotal))
 	}
-	if i.ContentGT != nil {
-		predicates = append(predicates, prompt.ContentGT(*i.ContentGT))
+	if i.SubtotalNEQ != nil {
+		predicates = append(predicates, invoice.SubtotalNEQ(*i.SubtotalNEQ))
 	}
-	if i.ContentGTE != nil {
-		predicates = append(predicates, prompt.ContentGTE(*i.ContentGTE))
+	if len(i.SubtotalIn) > 0 {
+		predicates = append(predicates, invoice.SubtotalIn(i.SubtotalIn...))
 	}
-	if i.ContentLT != nil {
-		predicates = append(predicates, prompt.ContentLT(*i.ContentLT))
+	if len(i.SubtotalNotIn) > 0 {
+		predicates = append(predicates, invoice.SubtotalNotIn(i.SubtotalNotIn...))
 	}
-	if i.ContentLTE != nil {
-		predicates = append(predicates, prompt.ContentLTE(*i.ContentLTE))
+	if i.SubtotalGT != nil {
+		predicates = append(predicates, invoice.SubtotalGT(*i.SubtotalGT))
 	}
-	if i.ContentContains != nil {
-		predicates = append(predicates, prompt.ContentContains(*i.ContentContains))
+	if i.SubtotalGTE != nil {
+		predicates = append(predicates, invoice.SubtotalGTE(*i.SubtotalGTE))
 	}
-	if i.ContentHasPrefix != nil {
-		predicates = append(predicates, prompt.ContentHasPrefix(*i.ContentHasPrefix))
+	if i.SubtotalLT != nil {
+		predicates = append(predicates, invoice.SubtotalLT(*i.SubtotalLT))
 	}
-	if i.ContentHasSuffix != nil {
-		predicates = append(predicates, prompt.ContentHasSuffix(*i.ContentHasSuffix))
+	if i.SubtotalLTE != nil {
+		predicates = append(predicates, invoice.SubtotalLTE(*i.SubtotalLTE))
 	}
-	if i.ContentEqualFold != nil {
-		predicates = append(predicates, prompt.ContentEqualFold(*i.ContentEqualFold))
+	if i.MarkupAmount != nil {
+		predicates = append(predicates, invoice.MarkupAmountEQ(*i.MarkupAmount))
 	}
-	if i.ContentContainsFold != nil {
-		predicates = append(predicates, prompt.ContentContainsFold(*i.ContentContainsFold))
+	if i.MarkupAmountNEQ != nil {
+		predicates = append(predicates, invoice.MarkupAmountNEQ(*i.MarkupAmountNEQ))
 	}
-	if i.Status != nil {
-		predicates = append(predicates, prompt.StatusEQ(*i.Status))
+	if len(i.MarkupAmountIn) > 0 {
+		predicates = append(predicates, invoice.MarkupAmountIn(i.MarkupAmountIn...))
 	}
-	if i.StatusNEQ != nil {
-		predicates = append(predicates, prompt.StatusNEQ(*i.StatusNEQ))
+	if len(i.MarkupAmountNotIn) > 0 {
+		predicates = append(predicates, invoice.MarkupAmountNotIn(i.MarkupAmountNotIn...))
 	}
-	if len(i.StatusIn) > 0 {
-		predicates = append(predicates, prompt.StatusIn(i.StatusIn...))
+	if i.MarkupAmountGT != nil {
+		predicates = append(predicates, invoice.MarkupAmountGT(*i.MarkupAmountGT))
 	}
-	if len(i.StatusNotIn) > 0 {
-		predicates = append(predicates, prompt.StatusNotIn(i.StatusNotIn...))
+	if i.MarkupAmountGTE != nil {
+		predicates = append(predicates, invoice.MarkupAmountGTE(*i.MarkupAmountGTE))
 	}
-	if i.Order != nil {
-		predicates = append(predicates, prompt.OrderEQ(*i.Order))
+	if i.MarkupAmountLT != nil {
+		predicates = append(predicates, invoice.MarkupAmountLT(*i.MarkupAmountLT))
 	}
-	if i.OrderNEQ != nil {
-		predicates = append(predicates, prompt.OrderNEQ(*i.OrderNEQ))
+	if i.MarkupAmountLTE != nil {
+		predicates = append(predicates, invoice.MarkupAmountLTE(*i.MarkupAmountLTE))
 	}
-	if len(i.OrderIn) > 0 {
-		predicates = append(predicates, prompt.OrderIn(i.OrderIn...))
+	if i.Fee != nil {
+		predicates = append(predicates, invoice.FeeEQ(*i.Fee))
 	}
-	if len(i.OrderNotIn) > 0 {
-		predicates = append(predicates, prompt.OrderNotIn(i.OrderNotIn...))
+	if i.FeeNEQ != nil {
+		predicates = append(predicates, invoice.FeeNEQ(*i.FeeNEQ))
 	}
-	if i.OrderGT != nil {
-		predicates = append(predicates, prompt.OrderGT(*i.OrderGT))
+	if len(i.FeeIn) > 0 {
+		predicates = append(predicates, invoice.FeeIn(i.FeeIn...))
 	}
-	if i.OrderGTE != nil {
-		predicates = append(predicates, prompt.OrderGTE(*i.OrderGTE))
+	if len(i.FeeNotIn) > 0 {
+		predicates = append(predicates, invoice.FeeNotIn(i.FeeNotIn...))
 	}
-	if i.OrderLT != nil {
-		predicates = append(predicates, prompt.OrderLT(*i.OrderLT))
+	if i.FeeGT != nil {
+		predicates = append(predicates, invoice.FeeGT(*i.FeeGT))
 	}
-	if i.OrderLTE != nil {
-		predicates = append(predicates, prompt.OrderLTE(*i.OrderLTE))
+	if i.FeeGTE != nil {
+		predicates = append(predicates, invoice.FeeGTE(*i.FeeGTE))
+	}
+	if i.FeeLT != nil {
+		predicates = append(predicates, invoice.FeeLT(*i.FeeLT))
+	}
+	if i.FeeLTE != nil {
+		predicates = append(predicates, invoice.FeeLTE(*i.FeeLTE))
+	}
+	if i.Total != nil {
+		predicates = append(predicates, invoice.TotalEQ(*i.Total))
+	}
+	if i.TotalNEQ != nil {
+		predicates = append(predicates, invoice.TotalNEQ(*i.TotalNEQ))
+	}
+	if len(i.TotalIn) > 0 {
+		predicates = append(predicates, invoice.TotalIn(i.TotalIn...))
+	}
+	if len(i.TotalNotIn) > 0 {
+		predicates = append(predicates, invoice.TotalNotIn(i.TotalNotIn...))
+	}
+	if i.TotalGT != nil {
+		predicates = append(predicates, invoice.TotalGT(*i.TotalGT))
+	}
+	if i.TotalGTE != nil {
+		predicates = append(predicates, invoice.TotalGTE(*i.TotalGTE))
+	}
+	if i.TotalLT != nil {
+		predicates = append(predicates, invoice.TotalLT(*i.TotalLT))
+	}
+	if i.TotalLTE != nil {
+		predicates = append(predicates, invoice.TotalLTE(*i.TotalLTE))
 	}
 
 	if i.HasProject != nil {
-		p := prompt.HasProject()
+		p := invoice.HasProject()
 		if !*i.HasProject {
-			p = prompt.Not(p)
+			p = invoice.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
@@ -5652,24 +6410,24 @@ func (i *PromptWhereInput) P() (predicate.Prompt, error) {
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, prompt.HasProjectWith(with...))
+		predicates = append(predicates, invoice.HasProjectWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyPromptWhereInput
+		return nil, ErrEmptyInvoiceWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return prompt.And(predicates...), nil
+		return invoice.And(predicates...), nil
 	}
 }
 
-// PromptProtectionRuleWhereInput represents a where input for filtering PromptProtectionRule queries.
-type PromptProtectionRuleWhereInput struct {
-	Predicates []predicate.PromptProtectionRule  `json:"-"`
-	Not        *PromptProtectionRuleWhereInput   `json:"not,omitempty"`
-	Or         []*PromptProtectionRuleWhereInput `json:"or,omitempty"`
-	And        []*PromptProtectionRuleWhereInput `json:"and,omitempty"`
+// ModelWhereInput represents a where input for filtering Model queries.
+type ModelWhereInput struct {
+	Predicates []predicate.Model  `json:"-"`
+	Not        *ModelWhereInput   `json:"not,omitempty"`
+	Or         []*ModelWhereInput `json:"or,omitempty"`
+	And        []*ModelWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -5701,6 +6459,42 @@ type PromptProtectionRuleWhereInput struct {
 	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
 	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
+	// "developer" field predicates.
+	Developer             *string  `json:"developer,omitempty"`
+	DeveloperNEQ          *string  `json:"developerNEQ,omitempty"`
+	DeveloperIn           []string `json:"developerIn,omitempty"`
+	DeveloperNotIn        []string `json:"developerNotIn,omitempty"`
+	DeveloperGT           *string  `json:"developerGT,omitempty"`
+	DeveloperGTE          *string  `json:"developerGTE,omitempty"`
+	DeveloperLT           *string  `json:"developerLT,omitempty"`
+	DeveloperLTE          *string  `json:"developerLTE,omitempty"`
+	DeveloperContains     *string  `json:"developerContains,omitempty"`
+	DeveloperHasPrefix    *string  `json:"developerHasPrefix,omitempty"`
+	DeveloperHasSuffix    *string  `json:"developerHasSuffix,omitempty"`
+	DeveloperEqualFold    *string  `json:"developerEqualFold,omitempty"`
+	DeveloperContainsFold *string  `json:"developerContainsFold,omitempty"`
+
+	// "model_id" field predicates.
+	ModelID             *string  `json:"modelID,omitempty"`
+	ModelIDNEQ          *string  `json:"modelIDNEQ,omitempty"`
+	ModelIDIn           []string `json:"modelIDIn,omitempty"`
+	ModelIDNotIn        []string `json:"modelIDNotIn,omitempty"`
+	ModelIDGT           *string  `json:"modelIDGT,omitempty"`
+	ModelIDGTE          *string  `json:"modelIDGTE,omitempty"`
+	ModelIDLT           *string  `json:"modelIDLT,omitempty"`
+	ModelIDLTE          *string  `json:"modelIDLTE,omitempty"`
+	ModelIDContains     *string  `json:"modelIDContains,omitempty"`
+	ModelIDHasPrefix    *string  `json:"modelIDHasPrefix,omitempty"`
+	ModelIDHasSuffix    *string  `json:"modelIDHasSuffix,omitempty"`
+	ModelIDEqualFold    *string  `json:"modelIDEqualFold,omitempty"`
+	ModelIDContainsFold *string  `json:"modelIDContainsFold,omitempty"`
+
+	// "type" field predicates.
+	Type      *model.Type  `json:"type,omitempty"`
+	TypeNEQ   *model.Type  `json:"typeNEQ,omitempty"`
+	TypeIn    []model.Type `json:"typeIn,omitempty"`
+	TypeNotIn []model.Type `json:"typeNotIn,omitempty"`
+
 	// "name" field predicates.
 	Name             *string  `json:"name,omitempty"`
 	NameNEQ          *string  `json:"nameNEQ,omitempty"`
@@ -5716,56 +6510,73 @@ type PromptProtectionRuleWhereInput struct {
 	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
 	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "description" field predicates.
-	Description             *string  `json:"description,omitempty"`
-	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
-	DescriptionIn           []string `json:"descriptionIn,omitempty"`
-	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
-	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
-	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
-	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
-	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
-	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
-	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
-	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
-	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
-	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+	// "icon" field predicates.
+	Icon             *string  `json:"icon,omitempty"`
+	IconNEQ          *string  `json:"iconNEQ,omitempty"`
+	IconIn           []string `json:"iconIn,omitempty"`
+	IconNotIn        []string `json:"iconNotIn,omitempty"`
+	IconGT           *string  `json:"iconGT,omitempty"`
+	IconGTE          *string  `json:"iconGTE,omitempty"`
+	IconLT           *string  `json:"iconLT,omitempty"`
+	IconLTE          *string  `json:"iconLTE,omitempty"`
+	IconContains     *string  `json:"iconContains,omitempty"`
+	IconHasPrefix    *string  `json:"iconHasPrefix,omitempty"`
+	IconHasSuffix    *string  `json:"iconHasSuffix,omitempty"`
+	IconEqualFold    *string  `json:"iconEqualFold,omitempty"`
+	IconContainsFold *string  `json:"iconContainsFold,omitempty"`
 
-	// "pattern" field predicates.
-	Pattern             *string  `json:"pattern,omitempty"`
-	PatternNEQ          *string  `json:"patternNEQ,omitempty"`
-	PatternIn           []string `json:"patternIn,omitempty"`
-	PatternNotIn        []string `json:"patternNotIn,omitempty"`
-	PatternGT           *string  `json:"patternGT,omitempty"`
-	PatternGTE          *string  `json:"patternGTE,omitempty"`
-	PatternLT           *string  `json:"patternLT,omitempty"`
-	PatternLTE          *string  `json:"patternLTE,omitempty"`
-	PatternContains     *string  `json:"patternContains,omitempty"`
-	PatternHasPrefix    *string  `json:"patternHasPrefix,omitempty"`
-	PatternHasSuffix    *string  `json:"patternHasSuffix,omitempty"`
-	PatternEqualFold    *string  `json:"patternEqualFold,omitempty"`
-	PatternContainsFold *string  `json:"patternContainsFold,omitempty"`
+	// "group" field predicates.
+	Group             *string  `json:"group,omitempty"`
+	GroupNEQ          *string  `json:"groupNEQ,omitempty"`
+	GroupIn           []string `json:"groupIn,omitempty"`
+	GroupNotIn        []string `json:"groupNotIn,omitempty"`
+	GroupGT           *string  `json:"groupGT,omitempty"`
+	GroupGTE          *string  `json:"groupGTE,omitempty"`
+	GroupLT           *string  `json:"groupLT,omitempty"`
+	GroupLTE          *string  `json:"groupLTE,omitempty"`
+	GroupContains     *string  `json:"groupContains,omitempty"`
+	GroupHasPrefix    *string  `json:"groupHasPrefix,omitempty"`
+	GroupHasSuffix    *string  `json:"groupHasSuffix,omitempty"`
+	GroupEqualFold    *string  `json:"groupEqualFold,omitempty"`
+	GroupContainsFold *string  `json:"groupContainsFold,omitempty"`
 
 	// "status" field predicates.
-	Status      *promptprotectionrule.Status  `json:"status,omitempty"`
-	StatusNEQ   *promptprotectionrule.Status  `json:"statusNEQ,omitempty"`
-	StatusIn    []promptprotectionrule.Status `json:"statusIn,omitempty"`
-	StatusNotIn []promptprotectionrule.Status `json:"statusNotIn,omitempty"`
+	Status      *model.Status  `json:"status,omitempty"`
+	StatusNEQ   *model.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []model.Status `json:"statusIn,omitempty"`
+	StatusNotIn []model.Status `json:"statusNotIn,omitempty"`
+
+	// "remark" field predicates.
+	Remark             *string  `json:"remark,omitempty"`
+	RemarkNEQ          *string  `json:"remarkNEQ,omitempty"`
+	RemarkIn           []string `json:"remarkIn,omitempty"`
+	RemarkNotIn        []string `json:"remarkNotIn,omitempty"`
+	RemarkGT           *string  `json:"remarkGT,omitempty"`
+	RemarkGTE          *string  `json:"remarkGTE,omitempty"`
+	RemarkLT           *string  `json:"remarkLT,omitempty"`
+	RemarkLTE          *string  `json:"remarkLTE,omitempty"`
+	RemarkContains     *string  `json:"remarkContains,omitempty"`
+	RemarkHasPrefix    *string  `json:"remarkHasPrefix,omitempty"`
+	RemarkHasSuffix    *string  `json:"remarkHasSuffix,omitempty"`
+	RemarkIsNil        bool     `json:"remarkIsNil,omitempty"`
+	RemarkNotNil       bool     `json:"remarkNotNil,omitempty"`
+	RemarkEqualFold    *string  `json:"remarkEqualFold,omitempty"`
+	RemarkContainsFold *string  `json:"remarkContainsFold,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *PromptProtectionRuleWhereInput) AddPredicates(predicates ...predicate.PromptProtectionRule) {
+func (i *ModelWhereInput) AddPredicates(predicates ...predicate.Model) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the PromptProtectionRuleWhereInput filter on the PromptProtectionRuleQuery builder.
-func (i *PromptProtectionRuleWhereInput) Filter(q *PromptProtectionRuleQuery) (*PromptProtectionRuleQuery, error) {
+// Filter applies the ModelWhereInput filter on the ModelQuery builder.
+func (i *ModelWhereInput) Filter(q *ModelQuery) (*ModelQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyPromptProtectionRuleWhereInput {
+		if err == ErrEmptyModelWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -5773,19 +6584,19 @@ func (i *PromptProtectionRuleWhereInput) Filter(q *PromptProtectionRuleQuery) (*
 	return q.Where(p), nil
 }
 
-// ErrEmptyPromptProtectionRuleWhereInput is returned in case the PromptProtectionRuleWhereInput is empty.
-var ErrEmptyPromptProtectionRuleWhereInput = errors.New("ent: empty predicate PromptProtectionRuleWhereInput")
+// ErrEmptyModelWhereInput is returned in case the ModelWhereInput is empty.
+var ErrEmptyModelWhereInput = errors.New("ent: empty predicate ModelWhereInput")
 
-// P returns a predicate for filtering promptprotectionrules.
+// P returns a predicate for filtering models.
 // An error is returned if the input is empty or invalid.
-func (i *PromptProtectionRuleWhereInput) P() (predicate.PromptProtectionRule, error) {
-	var predicates []predicate.PromptProtectionRule
+func (i *ModelWhereInput) P() (predicate.Model, error) {
+	var predicates []predicate.Model
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, promptprotectionrule.Not(p))
+		predicates = append(predicates, model.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -5795,7 +6606,7 @@ func (i *PromptProtectionRuleWhereInput) P() (predicate.PromptProtectionRule, er
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.PromptProtectionRule, 0, n)
+		or := make([]predicate.Model, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -5803,7 +6614,7 @@ func (i *PromptProtectionRuleWhereInput) P() (predicate.PromptProtectionRule, er
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, promptprotectionrule.Or(or...))
+		predicates = append(predicates, model.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -5813,7 +6624,7 @@ func (i *PromptProtectionRuleWhereInput) P() (predicate.PromptProtectionRule, er
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.PromptProtectionRule, 0, n)
+		and := make([]predicate.Model, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -5821,227 +6632,362 @@ func (i *PromptProtectionRuleWhereInput) P() (predicate.PromptProtectionRule, er
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, promptprotectionrule.And(and...))
+		predicates = append(predicates, model.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, promptprotectionrule.IDEQ(*i.ID))
+		predicates = append(predicates, model.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, promptprotectionrule.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, model.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, promptprotectionrule.IDIn(i.IDIn...))
+		predicates = append(predicates, model.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, promptprotectionrule.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, model.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, promptprotectionrule.IDGT(*i.IDGT))
+		predicates = append(predicates, model.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, promptprotectionrule.IDGTE(*i.IDGTE))
+		predicates = append(predicates, model.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, promptprotectionrule.IDLT(*i.IDLT))
+		predicates = append(predicates, model.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, promptprotectionrule.IDLTE(*i.IDLTE))
+		predicates = append(predicates, model.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, promptprotectionrule.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, model.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, promptprotectionrule.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, model.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, promptprotectionrule.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, model.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, promptprotectionrule.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, model.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, promptprotectionrule.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, model.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, promptprotectionrule.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, model.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, promptprotectionrule.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, model.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, promptprotectionrule.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, model.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, promptprotectionrule.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, model.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, promptprotectionrule.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, model.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, promptprotectionrule.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, model.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, promptprotectionrule.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, model.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, promptprotectionrule.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, model.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, promptprotectionrule.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, model.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, promptprotectionrule.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, model.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, promptprotectionrule.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, model.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.Developer != nil {
+		predicates = append(predicates, model.DeveloperEQ(*i.Developer))
+	}
+	if i.DeveloperNEQ != nil {
+		predicates = append(predicates, model.DeveloperNEQ(*i.DeveloperNEQ))
+	}
+	if len(i.DeveloperIn) > 0 {
+		predicates = append(predicates, model.DeveloperIn(i.DeveloperIn...))
+	}
+	if len(i.DeveloperNotIn) > 0 {
+		predicates = append(predicates, model.DeveloperNotIn(i.DeveloperNotIn...))
+	}
+	if i.DeveloperGT != nil {
+		predicates = append(predicates, model.DeveloperGT(*i.DeveloperGT))
+	}
+	if i.DeveloperGTE != nil {
+		predicates = append(predicates, model.DeveloperGTE(*i.DeveloperGTE))
+	}
+	if i.DeveloperLT != nil {
+		predicates = append(predicates, model.DeveloperLT(*i.DeveloperLT))
+	}
+	if i.DeveloperLTE != nil {
+		predicates = append(predicates, model.DeveloperLTE(*i.DeveloperLTE))
+	}
+	if i.DeveloperContains != nil {
+		predicates = append(predicates, model.DeveloperContains(*i.DeveloperContains))
+	}
+	if i.DeveloperHasPrefix != nil {
+		predicates = append(predicates, model.DeveloperHasPrefix(*i.DeveloperHasPrefix))
+	}
+	if i.DeveloperHasSuffix != nil {
+		predicates = append(predicates, model.DeveloperHasSuffix(*i.DeveloperHasSuffix))
+	}
+	if i.DeveloperEqualFold != nil {
+		predicates = append(predicates, model.DeveloperEqualFold(*i.DeveloperEqualFold))
+	}
+	if i.DeveloperContainsFold != nil {
+		predicates = append(predicates, model.DeveloperContainsFold(*i.DeveloperContainsFold))
+	}
+	if i.ModelID != nil {
+		predicates = append(predicates, model.ModelIDEQ(*i.ModelID))
+	}
+	if i.ModelIDNEQ != nil {
+		predicates = append(predicates, model.ModelIDNEQ(*i.ModelIDNEQ))
+	}
+	if len(i.ModelIDIn) > 0 {
+		predicates = append(predicates, model.ModelIDIn(i.ModelIDIn...))
+	}
+	if len(i.ModelIDNotIn) > 0 {
+		predicates = append(predicates, model.ModelIDNotIn(i.ModelIDNotIn...))
+	}
+	if i.ModelIDGT != nil {
+		predicates = append(predicates, model.ModelIDGT(*i.ModelIDGT))
+	}
+	if i.ModelIDGTE != nil {
+		predicates = append(predicates, model.ModelIDGTE(*i.ModelIDGTE))
+	}
+	if i.ModelIDLT != nil {
+		predicates = append(predicates, model.ModelIDLT(*i.ModelIDLT))
+	}
+	if i.ModelIDLTE != nil {
+		predicates = append(predicates, model.ModelIDLTE(*i.ModelIDLTE))
+	}
+	if i.ModelIDContains != nil {
+		predicates = append(predicates, model.ModelIDContains(*i.ModelIDContains))
+	}
+	if i.ModelIDHasPrefix != nil {
+		predicates = append(predicates, model.ModelIDHasPrefix(*i.ModelIDHasPrefix))
+	}
+	if i.ModelIDHasSuffix != nil {
+		predicates = append(predicates, model.ModelIDHasSuffix(*i.ModelIDHasSuffix))
+	}
+	if i.ModelIDEqualFold != nil {
+		predicates = append(predicates, model.ModelIDEqualFold(*i.ModelIDEqualFold))
+	}
+	if i.ModelIDContainsFold != nil {
+		predicates = append(predicates, model.ModelIDContainsFold(*i.ModelIDContainsFold))
+	}
+	if i.Type != nil {
+		predicates = append(predicates, model.TypeEQ(*i.Type))
+	}
+	if i.TypeNEQ != nil {
+		predicates = append(predicates, model.TypeNEQ(*i.TypeNEQ))
+	}
+	if len(i.TypeIn) > 0 {
+		predicates = append(predicates, model.TypeIn(i.TypeIn...))
+	}
+	if len(i.TypeNotIn) > 0 {
+		predicates = append(predicates, model.TypeNotIn(i.TypeNotIn...))
 	}
 	if i.Name != nil {
-		predicates = append(predicates, promptprotectionrule.NameEQ(*i.Name))
+		predicates = append(predicates, model.NameEQ(*i.Name))
 	}
 	if i.NameNEQ != nil {
-		predicates = append(predicates, promptprotectionrule.NameNEQ(*i.NameNEQ))
+		predicates = append(predicates, model.NameNEQ(*i.NameNEQ))
 	}
 	if len(i.NameIn) > 0 {
-		predicates = append(predicates, promptprotectionrule.NameIn(i.NameIn...))
+		predicates = append(predicates, model.NameIn(i.NameIn...))
 	}
 	if len(i.NameNotIn) > 0 {
-		predicates = append(predicates, promptprotectionrule.NameNotIn(i.NameNotIn...))
+		predicates = append(predicates, model.NameNotIn(i.NameNotIn...))
 	}
 	if i.NameGT != nil {
-		predicates = append(predicates, promptprotectionrule.NameGT(*i.NameGT))
+		predicates = append(predicates, model.NameGT(*i.NameGT))
 	}
 	if i.NameGTE != nil {
-		predicates = append(predicates, promptprotectionrule.NameGTE(*i.NameGTE))
+		predicates = append(predicates, model.NameGTE(*i.NameGTE))
 	}
 	if i.NameLT != nil {
-		predicates = append(predicates, promptprotectionrule.NameLT(*i.NameLT))
+		predicates = append(predicates, model.NameLT(*i.NameLT))
 	}
 	if i.NameLTE != nil {
-		predicates = append(predicates, promptprotectionrule.NameLTE(*i.NameLTE))
+		predicates = append(predicates, model.NameLTE(*i.NameLTE))
 	}
 	if i.NameContains != nil {
-		predicates = append(predicates, promptprotectionrule.NameContains(*i.NameContains))
+		predicates = append(predicates, model.NameContains(*i.NameContains))
 	}
 	if i.NameHasPrefix != nil {
-		predicates = append(predicates, promptprotectionrule.NameHasPrefix(*i.NameHasPrefix))
+		predicates = append(predicates, model.NameHasPrefix(*i.NameHasPrefix))
 	}
 	if i.NameHasSuffix != nil {
-		predicates = append(predicates, promptprotectionrule.NameHasSuffix(*i.NameHasSuffix))
+		predicates = append(predicates, model.NameHasSuffix(*i.NameHasSuffix))
 	}
 	if i.NameEqualFold != nil {
-		predicates = append(predicates, promptprotectionrule.NameEqualFold(*i.NameEqualFold))
+		predicates = append(predicates, model.NameEqualFold(*i.NameEqualFold))
 	}
 	if i.NameContainsFold != nil {
-		predicates = append(predicates, promptprotectionrule.NameContainsFold(*i.NameContainsFold))
+		predicates = append(predicates, model.NameContainsFold(*i.NameContainsFold))
 	}
-	if i.Description != nil {
-		predicates = append(predicates, promptprotectionrule.DescriptionEQ(*i.Description))
+	if i.Icon != nil {
+		predicates = append(predicates, model.IconEQ(*i.Icon))
 	}
-	if i.DescriptionNEQ != nil {
-		predicates = append(predicates, promptprotectionrule.DescriptionNEQ(*i.DescriptionNEQ))
+	if i.IconNEQ != nil {
+		predicates = append(predicates, model.IconNEQ(*i.IconNEQ))
 	}
-	if len(i.DescriptionIn) > 0 {
-		predicates = append(predicates, promptprotectionrule.DescriptionIn(i.DescriptionIn...))
+	if len(i.IconIn) > 0 {
+		predicates = append(predicates, model.IconIn(i.IconIn...))
 	}
-	if len(i.DescriptionNotIn) > 0 {
-		predicates = append(predicates, promptprotectionrule.DescriptionNotIn(i.DescriptionNotIn...))
+	if len(i.IconNotIn) > 0 {
+		predicates = append(predicates, model.IconNotIn(i.IconNotIn...))
 	}
-	if i.DescriptionGT != nil {
-		predicates = append(predicates, promptprotectionrule.DescriptionGT(*i.DescriptionGT))
+	if i.IconGT != nil {
+		predicates = append(predicates, model.IconGT(*i.IconGT))
 	}
-	if i.DescriptionGTE != nil {
-		predicates = append(predicates, promptprotectionrule.DescriptionGTE(*i.DescriptionGTE))
+	if i.IconGTE != nil {
+		predicates = append(predicates, model.IconGTE(*i.IconGTE))
 	}
-	if i.DescriptionLT != nil {
-		predicates = append(predicates, promptprotectionrule.DescriptionLT(*i.DescriptionLT))
+	if i.IconLT != nil {
+		predicates = append(predicates, model.IconLT(*i.IconLT))
 	}
-	if i.DescriptionLTE != nil {
-		predicates = append(predicates, promptprotectionrule.DescriptionLTE(*i.DescriptionLTE))
+	if i.IconLTE != nil {
+		predicates = append(predicates, model.IconLTE(*i.IconLTE))
 	}
-	if i.DescriptionContains != nil {
-		predicates = append(predicates, promptprotectionrule.DescriptionContains(*i.DescriptionContains))
+	if i.IconContains != nil {
+		predicates = append(predicates, model.IconContains(*i.IconContains))
 	}
-	if i.DescriptionHasPrefix != nil {
-		predicates = append(predicates, promptprotectionrule.DescriptionHasPrefix(*i.DescriptionHasPrefix))
+	if i.IconHasPrefix != nil {
+		predicates = append(predicates, model.IconHasPrefix(*i.IconHasPrefix))
 	}
-	if i.DescriptionHasSuffix != nil {
-		predicates = append(predicates, promptprotectionrule.DescriptionHasSuffix(*i.DescriptionHasSuffix))
+	if i.IconHasSuffix != nil {
+		predicates = append(predicates, model.IconHasSuffix(*i.IconHasSuffix))
 	}
-	if i.DescriptionEqualFold != nil {
-		predicates = append(predicates, promptprotectionrule.DescriptionEqualFold(*i.DescriptionEqualFold))
+	if i.IconEqualFold != nil {
+		predicates = append(predicates, model.IconEqualFold(*i.IconEqualFold))
 	}
-	if i.DescriptionContainsFold != nil {
-		predicates = append(predicates, promptprotectionrule.DescriptionContainsFold(*i.DescriptionContainsFold))
+	if i.IconContainsFold != nil {
+		predicates = append(predicates, model.IconContainsFold(*i.IconContainsFold))
 	}
-	if i.Pattern != nil {
-		predicates = append(predicates, promptprotectionrule.PatternEQ(*i.Pattern))
+	if i.Group != nil {
+		predicates = append(predicates, model.GroupEQ(*i.Group))
 	}
-	if i.PatternNEQ != nil {
-		predicates = append(predicates, promptprotectionrule.PatternNEQ(*i.PatternNEQ))
+	if i.GroupNEQ != nil {
+		predicates = append(predicates, model.GroupNEQ(*i.GroupNEQ))
 	}
-	if len(i.PatternIn) > 0 {
-		predicates = append(predicates, promptprotectionrule.PatternIn(i.PatternIn...))
+	if len(i.GroupIn) > 0 {
+		predicates = append(predicates, model.GroupIn(i.GroupIn...))
 	}
-	if len(i.PatternNotIn) > 0 {
-		predicates = append(predicates, promptprotectionrule.PatternNotIn(i.PatternNotIn...))
+	if len(i.GroupNotIn) > 0 {
+		predicates = append(predicates, model.GroupNotIn(i.GroupNotIn...))
 	}
-	if i.PatternGT != nil {
-		predicates = append(predicates, promptprotectionrule.PatternGT(*i.PatternGT))
+	if i.GroupGT != nil {
+		predicates = append(predicates, model.GroupGT(*i.GroupGT))
 	}
-	if i.PatternGTE != nil {
-		predicates = append(predicates, promptprotectionrule.PatternGTE(*i.PatternGTE))
+	if i.GroupGTE != nil {
+		predicates = append(predicates, model.GroupGTE(*i.GroupGTE))
 	}
-	if i.PatternLT != nil {
-		predicates = append(predicates, promptprotectionrule.PatternLT(*i.PatternLT))
+	if i.GroupLT != nil {
+		predicates = append(predicates, model.GroupLT(*i.GroupLT))
 	}
-	if i.PatternLTE != nil {
-		predicates = append(predicates, promptprotectionrule.PatternLTE(*i.PatternLTE))
+	if i.GroupLTE != nil {
+		predicates = append(predicates, model.GroupLTE(*i.GroupLTE))
 	}
-	if i.PatternContains != nil {
-		predicates = append(predicates, promptprotectionrule.PatternContains(*i.PatternContains))
+	if i.GroupContains != nil {
+		predicates = append(predicates, model.GroupContains(*i.GroupContains))
 	}
-	if i.PatternHasPrefix != nil {
-		predicates = append(predicates, promptprotectionrule.PatternHasPrefix(*i.PatternHasPrefix))
+	if i.GroupHasPrefix != nil {
+		predicates = append(predicates, model.GroupHasPrefix(*i.GroupHasPrefix))
 	}
-	if i.PatternHasSuffix != nil {
-		predicates = append(predicates, promptprotectionrule.PatternHasSuffix(*i.PatternHasSuffix))
+	if i.GroupHasSuffix != nil {
+		predicates = append(predicates, model.GroupHasSuffix(*i.GroupHasSuffix))
 	}
-	if i.PatternEqualFold != nil {
-		predicates = append(predicates, promptprotectionrule.PatternEqualFold(*i.PatternEqualFold))
+	if i.GroupEqualFold != nil {
+		predicates = append(predicates, model.GroupEqualFold(*i.GroupEqualFold))
 	}
-	if i.PatternContainsFold != nil {
-		predicates = append(predicates, promptprotectionrule.PatternContainsFold(*i.PatternContainsFold))
+	if i.GroupContainsFold != nil {
+		predicates = append(predicates, model.GroupContainsFold(*i.GroupContainsFold))
 	}
 	if i.Status != nil {
-		predicates = append(predicates, promptprotectionrule.StatusEQ(*i.Status))
+		predicates = append(predicates, model.StatusEQ(*i.Status))
 	}
 	if i.StatusNEQ != nil {
-		predicates = append(predicates, promptprotectionrule.StatusNEQ(*i.StatusNEQ))
+		predicates = append(predicates, model.StatusNEQ(*i.StatusNEQ))
 	}
 	if len(i.StatusIn) > 0 {
-		predicates = append(predicates, promptprotectionrule.StatusIn(i.StatusIn...))
+		predicates = append(predicates, model.StatusIn(i.StatusIn...))
 	}
 	if len(i.StatusNotIn) > 0 {
-		predicates = append(predicates, promptprotectionrule.StatusNotIn(i.StatusNotIn...))
+		predicates = append(predicates, model.StatusNotIn(i.StatusNotIn...))
 	}
-
+	if i.Remark != nil {
+		predicates = append(predicates, model.RemarkEQ(*i.Remark))
+	}
+	if i.RemarkNEQ != nil {
+		predicates = append(predicates, model.RemarkNEQ(*i.RemarkNEQ))
+	}
+	if len(i.RemarkIn) > 0 {
+		predicates = append(predicates, model.RemarkIn(i.RemarkIn...))
+	}
+	if len(i.RemarkNotIn) > 0 {
+		predicates = append(predicates, model.RemarkNotIn(i.RemarkNotIn...))
+	}
+	if i.RemarkGT != nil {
+		predicates = append(predicates, model.RemarkGT(*i.RemarkGT))
+	}
+	if i.RemarkGTE != nil {
+		predicates = append(predicates, model.RemarkGTE(*i.RemarkGTE))
+	}
+	if i.RemarkLT != nil {
+		predicates = append(predicates, model.RemarkLT(*i.RemarkLT))
+	}
+	if i.RemarkLTE != nil {
+		predicates = append(predicates, model.RemarkLTE(*i.RemarkLTE))
+	}
+	if i.RemarkContains != nil {
+		predicates = append(predicates, model.RemarkContains(*i.RemarkContains))
+	}
+	if i.RemarkHasPrefix != nil {
+		predicates = append(predicates, model.RemarkHasPrefix(*i.RemarkHasPrefix))
+	}
+	if i.RemarkHasSuffix != nil {
+		predicates = append(predicates, model.RemarkHasSuffix(*i.RemarkHasSuffix))
+	}
+	if i.RemarkIsNil {
+		predicates = append(predicates, model.RemarkIsNil())
+	}
+	if i.RemarkNotNil {
+		predicates = append(predicates, model.RemarkNotNil())
+	}
+	if i.RemarkEqualFold != nil {
+		predicates = append(predicates, model.RemarkEqualFold(*i.RemarkEqualFold))
+	}
+	if i.RemarkContainsFold != nil {
+		predicates = append(predicates, model.RemarkContainsFold(*i.RemarkContainsFold))
+	}
+
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyPromptProtectionRuleWhereInput
+		return nil, ErrEmptyModelWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return promptprotectionrule.And(predicates...), nil
+		return model.And(predicates...), nil
 	}
 }
 
-// ProviderQuotaStatusWhereInput represents a where input for filtering ProviderQuotaStatus queries.
-type ProviderQuotaStatusWhereInput struct {
-	Predicates []predicate.ProviderQuotaStatus  `json:"-"`
-	Not        *ProviderQuotaStatusWhereInput   `json:"not,omitempty"`
-	Or         []*ProviderQuotaStatusWhereInput `json:"or,omitempty"`
-	And        []*ProviderQuotaStatusWhereInput `json:"and,omitempty"`
+// OIDCIdentityWhereInput represents a where input for filtering OIDCIdentity queries.
+type OIDCIdentityWhereInput struct {
+	Predicates []predicate.OIDCIdentity  `json:"-"`
+	Not        *OIDCIdentityWhereInput   `json:"not,omitempty"`
+	Or         []*OIDCIdentityWhereInput `json:"or,omitempty"`
+	And        []*OIDCIdentityWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -6073,68 +7019,106 @@ type ProviderQuotaStatusWhereInput struct {
 	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
 	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
-	// "channel_id" field predicates.
-	ChannelID      *int  `json:"channelID,omitempty"`
-	ChannelIDNEQ   *int  `json:"channelIDNEQ,omitempty"`
-	ChannelIDIn    []int `json:"channelIDIn,omitempty"`
-	ChannelIDNotIn []int `json:"channelIDNotIn,omitempty"`
+	// "issuer" field predicates.
+	Issuer             *string  `json:"issuer,omitempty"`
+	IssuerNEQ          *string  `json:"issuerNEQ,omitempty"`
+	IssuerIn           []string `json:"issuerIn,omitempty"`
+	IssuerNotIn        []string `json:"issuerNotIn,omitempty"`
+	IssuerGT           *string  `json:"issuerGT,omitempty"`
+	IssuerGTE          *string  `json:"issuerGTE,omitempty"`
+	IssuerLT           *string  `json:"issuerLT,omitempty"`
+	IssuerLTE          *string  `json:"issuerLTE,omitempty"`
+	IssuerContains     *string  `json:"issuerContains,omitempty"`
+	IssuerHasPrefix    *string  `json:"issuerHasPrefix,omitempty"`
+	IssuerHasSuffix    *string  `json:"issuerHasSuffix,omitempty"`
+	IssuerEqualFold    *string  `json:"issuerEqualFold,omitempty"`
+	IssuerContainsFold *string  `json:"issuerContainsFold,omitempty"`
 
-	// "provider_type" field predicates.
-	ProviderType      *providerquotastatus.ProviderType  `json:"providerType,omitempty"`
-	ProviderTypeNEQ   *providerquotastatus.ProviderType  `json:"providerTypeNEQ,omitempty"`
-	ProviderTypeIn    []providerquotastatus.ProviderType `json:"providerTypeIn,omitempty"`
-	ProviderTypeNotIn []providerquotastatus.ProviderType `json:"providerTypeNotIn,omitempty"`
+	// "subject" field predicates.
+	Subject             *string  `json:"subject,omitempty"`
+	SubjectNEQ          *string  `json:"subjectNEQ,omitempty"`
+	SubjectIn           []string `json:"subjectIn,omitempty"`
+	SubjectNotIn        []string `json:"subjectNotIn,omitempty"`
+	SubjectGT           *string  `json:"subjectGT,omitempty"`
+	SubjectGTE          *string  `json:"subjectGTE,omitempty"`
+	SubjectLT           *string  `json:"subjectLT,omitempty"`
+	SubjectLTE          *string  `json:"subjectLTE,omitempty"`
+	SubjectContains     *string  `json:"subjectContains,omitempty"`
+	SubjectHasPrefix    *string  `json:"subjectHasPrefix,omitempty"`
+	SubjectHasSuffix    *string  `json:"subjectHasSuffix,omitempty"`
+	SubjectEqualFold    *string  `json:"subjectEqualFold,omitempty"`
+	SubjectContainsFold *string  `json:"subjectContainsFold,omitempty"`
 
-	// "status" field predicates.
-	Status      *providerquotastatus.Status  `json:"status,omitempty"`
-	StatusNEQ   *providerquotastatus.Status  `json:"statusNEQ,omitempty"`
-	StatusIn    []providerquotastatus.Status `json:"statusIn,omitempty"`
-	StatusNotIn []providerquotastatus.Status `json:"statusNotIn,omitempty"`
+	// "email" field predicates.
+	Email             *string  `json:"email,omitempty"`
+	EmailNEQ          *string  `json:"emailNEQ,omitempty"`
+	EmailIn           []string `json:"emailIn,omitempty"`
+	EmailNotIn        []string `json:"emailNotIn,omitempty"`
+	EmailGT           *string  `json:"emailGT,omitempty"`
+	EmailGTE          *string  `json:"emailGTE,omitempty"`
+	EmailLT           *string  `json:"emailLT,omitempty"`
+	EmailLTE          *string  `json:"emailLTE,omitempty"`
+	EmailContains     *string  `json:"emailContains,omitempty"`
+	EmailHasPrefix    *string  `json:"emailHasPrefix,omitempty"`
+	EmailHasSuffix    *string  `json:"emailHasSuffix,omitempty"`
+	EmailIsNil        bool     `json:"emailIsNil,omitempty"`
+	EmailNotNil       bool     `json:"emailNotNil,omitempty"`
+	EmailEqualFold    *string  `json:"emailEqualFold,omitempty"`
+	EmailContainsFold *string  `json:"emailContainsFold,omitempty"`
 
-	// "next_reset_at" field predicates.
-	NextResetAt       *time.Time  `json:"nextResetAt,omitempty"`
-	NextResetAtNEQ    *time.Time  `json:"nextResetAtNEQ,omitempty"`
-	NextResetAtIn     []time.Time `json:"nextResetAtIn,omitempty"`
-	NextResetAtNotIn  []time.Time `json:"nextResetAtNotIn,omitempty"`
-	NextResetAtGT     *time.Time  `json:"nextResetAtGT,omitempty"`
-	NextResetAtGTE    *time.Time  `json:"nextResetAtGTE,omitempty"`
-	NextResetAtLT     *time.Time  `json:"nextResetAtLT,omitempty"`
-	NextResetAtLTE    *time.Time  `json:"nextResetAtLTE,omitempty"`
-	NextResetAtIsNil  bool        `json:"nextResetAtIsNil,omitempty"`
-	NextResetAtNotNil bool        `json:"nextResetAtNotNil,omitempty"`
+	// "idp_name" field predicates.
+	IdpName             *string  `json:"idpName,omitempty"`
+	IdpNameNEQ          *string  `json:"idpNameNEQ,omitempty"`
+	IdpNameIn           []string `json:"idpNameIn,omitempty"`
+	IdpNameNotIn        []string `json:"idpNameNotIn,omitempty"`
+	IdpNameGT           *string  `json:"idpNameGT,omitempty"`
+	IdpNameGTE          *string  `json:"idpNameGTE,omitempty"`
+	IdpNameLT           *string  `json:"idpNameLT,omitempty"`
+	IdpNameLTE          *string  `json:"idpNameLTE,omitempty"`
+	IdpNameContains     *string  `json:"idpNameContains,omitempty"`
+	IdpNameHasPrefix    *string  `json:"idpNameHasPrefix,omitempty"`
+	IdpNameHasSuffix    *string  `json:"idpNameHasSuffix,omitempty"`
+	IdpNameIsNil        bool     `json:"idpNameIsNil,omitempty"`
+	IdpNameNotNil       bool     `json:"idpNameNotNil,omitempty"`
+	IdpNameEqualFold    *string  `json:"idpNameEqualFold,omitempty"`
+	IdpNameContainsFold *string  `json:"idpNameContainsFold,omitempty"`
 
-	// "ready" field predicates.
-	Ready    *bool `json:"ready,omitempty"`
-	ReadyNEQ *bool `json:"readyNEQ,omitempty"`
+	// "last_login_at" field predicates.
+	LastLoginAt       *time.Time  `json:"lastLoginAt,omitempty"`
+	LastLoginAtNEQ    *time.Time  `json:"lastLoginAtNEQ,omitempty"`
+	LastLoginAtIn     []time.Time `json:"lastLoginAtIn,omitempty"`
+	LastLoginAtNotIn  []time.Time `json:"lastLoginAtNotIn,omitempty"`
+	LastLoginAtGT     *time.Time  `json:"lastLoginAtGT,omitempty"`
+	LastLoginAtGTE    *time.Time  `json:"lastLoginAtGTE,omitempty"`
+	LastLoginAtLT     *time.Time  `json:"lastLoginAtLT,omitempty"`
+	LastLoginAtLTE    *time.Time  `json:"lastLoginAtLTE,omitempty"`
+	LastLoginAtIsNil  bool        `json:"lastLoginAtIsNil,omitempty"`
+	LastLoginAtNotNil bool        `json:"lastLoginAtNotNil,omitempty"`
 
-	// "next_check_at" field predicates.
-	NextCheckAt      *time.Time  `json:"nextCheckAt,omitempty"`
-	NextCheckAtNEQ   *time.Time  `json:"nextCheckAtNEQ,omitempty"`
-	NextCheckAtIn    []time.Time `json:"nextCheckAtIn,omitempty"`
-	NextCheckAtNotIn []time.Time `json:"nextCheckAtNotIn,omitempty"`
-	NextCheckAtGT    *time.Time  `json:"nextCheckAtGT,omitempty"`
-	NextCheckAtGTE   *time.Time  `json:"nextCheckAtGTE,omitempty"`
-	NextCheckAtLT    *time.Time  `json:"nextCheckAtLT,omitempty"`
-	NextCheckAtLTE   *time.Time  `json:"nextCheckAtLTE,omitempty"`
+	// "user_id" field predicates.
+	UserID      *int  `json:"userID,omitempty"`
+	UserIDNEQ   *int  `json:"userIDNEQ,omitempty"`
+	UserIDIn    []int `json:"userIDIn,omitempty"`
+	UserIDNotIn []int `json:"userIDNotIn,omitempty"`
 
-	// "channel" edge predicates.
-	HasChannel     *bool                `json:"hasChannel,omitempty"`
-	HasChannelWith []*ChannelWhereInput `json:"hasChannelWith,omitempty"`
+	// "user" edge predicates.
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *ProviderQuotaStatusWhereInput) AddPredicates(predicates ...predicate.ProviderQuotaStatus) {
+func (i *OIDCIdentityWhereInput) AddPredicates(predicates ...predicate.OIDCIdentity) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the ProviderQuotaStatusWhereInput filter on the ProviderQuotaStatusQuery builder.
-func (i *ProviderQuotaStatusWhereInput) Filter(q *ProviderQuotaStatusQuery) (*ProviderQuotaStatusQuery, error) {
+// Filter applies the OIDCIdentityWhereInput filter on the OIDCIdentityQuery builder.
+func (i *OIDCIdentityWhereInput) Filter(q *OIDCIdentityQuery) (*OIDCIdentityQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyProviderQuotaStatusWhereInput {
+		if err == ErrEmptyOIDCIdentityWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -6142,19 +7126,19 @@ func (i *ProviderQuotaStatusWhereInput) Filter(q *ProviderQuotaStatusQuery) (*Pr
 	return q.Where(p), nil
 }
 
-// ErrEmptyProviderQuotaStatusWhereInput is returned in case the ProviderQuotaStatusWhereInput is empty.
-var ErrEmptyProviderQuotaStatusWhereInput = errors.New("ent: empty predicate ProviderQuotaStatusWhereInput")
+// ErrEmptyOIDCIdentityWhereInput is returned in case the OIDCIdentityWhereInput is empty.
+var ErrEmptyOIDCIdentityWhereInput = errors.New("ent: empty predicate OIDCIdentityWhereInput")
 
-// P returns a predicate for filtering providerquotastatusslice.
+// P returns a predicate for filtering oidcidentities.
 // An error is returned if the input is empty or invalid.
-func (i *ProviderQuotaStatusWhereInput) P() (predicate.ProviderQuotaStatus, error) {
-	var predicates []predicate.ProviderQuotaStatus
+func (i *OIDCIdentityWhereInput) P() (predicate.OIDCIdentity, error) {
+	var predicates []predicate.OIDCIdentity
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, providerquotastatus.Not(p))
+		predicates = append(predicates, oidcidentity.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -6164,7 +7148,7 @@ func (i *ProviderQuotaStatusWhereInput) P() (predicate.ProviderQuotaStatus, erro
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.ProviderQuotaStatus, 0, n)
+		or := make([]predicate.OIDCIdentity, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -6172,7 +7156,7 @@ func (i *ProviderQuotaStatusWhereInput) P() (predicate.ProviderQuotaStatus, erro
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, providerquotastatus.Or(or...))
+		predicates = append(predicates, oidcidentity.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -6182,7 +7166,7 @@ func (i *ProviderQuotaStatusWhereInput) P() (predicate.ProviderQuotaStatus, erro
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.ProviderQuotaStatus, 0, n)
+		and := make([]predicate.OIDCIdentity, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -6190,212 +7174,326 @@ func (i *ProviderQuotaStatusWhereInput) P() (predicate.ProviderQuotaStatus, erro
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, providerquotastatus.And(and...))
+		predicates = append(predicates, oidcidentity.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, providerquotastatus.IDEQ(*i.ID))
+		predicates = append(predicates, oidcidentity.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, providerquotastatus.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, oidcidentity.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, providerquotastatus.IDIn(i.IDIn...))
+		predicates = append(predicates, oidcidentity.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, providerquotastatus.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, oidcidentity.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, providerquotastatus.IDGT(*i.IDGT))
+		predicates = append(predicates, oidcidentity.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, providerquotastatus.IDGTE(*i.IDGTE))
+		predicates = append(predicates, oidcidentity.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, providerquotastatus.IDLT(*i.IDLT))
+		predicates = append(predicates, oidcidentity.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, providerquotastatus.IDLTE(*i.IDLTE))
+		predicates = append(predicates, oidcidentity.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, providerquotastatus.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, oidcidentity.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, providerquotastatus.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, oidcidentity.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, providerquotastatus.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, oidcidentity.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, providerquotastatus.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, oidcidentity.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, providerquotastatus.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, oidcidentity.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, providerquotastatus.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, oidcidentity.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, providerquotastatus.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, oidcidentity.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, providerquotastatus.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, oidcidentity.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, providerquotastatus.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, oidcidentity.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, providerquotastatus.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, oidcidentity.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, providerquotastatus.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, oidcidentity.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, providerquotastatus.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, oidcidentity.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, providerquotastatus.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, oidcidentity.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, providerquotastatus.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, oidcidentity.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, providerquotastatus.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, oidcidentity.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, providerquotastatus.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, oidcidentity.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.ChannelID != nil {
-		predicates = append(predicates, providerquotastatus.ChannelIDEQ(*i.ChannelID))
+	if i.Issuer != nil {
+		predicates = append(predicates, oidcidentity.IssuerEQ(*i.Issuer))
 	}
-	if i.ChannelIDNEQ != nil {
-		predicates = append(predicates, providerquotastatus.ChannelIDNEQ(*i.ChannelIDNEQ))
+	if i.IssuerNEQ != nil {
+		predicates = append(predicates, oidcidentity.IssuerNEQ(*i.IssuerNEQ))
 	}
-	if len(i.ChannelIDIn) > 0 {
-		predicates = append(predicates, providerquotastatus.ChannelIDIn(i.ChannelIDIn...))
+	if len(i.IssuerIn) > 0 {
+		predicates = append(predicates, oidcidentity.IssuerIn(i.IssuerIn...))
 	}
-	if len(i.ChannelIDNotIn) > 0 {
-		predicates = append(predicates, providerquotastatus.ChannelIDNotIn(i.ChannelIDNotIn...))
+	if len(i.IssuerNotIn) > 0 {
+		predicates = append(predicates, oidcidentity.IssuerNotIn(i.IssuerNotIn...))
 	}
-	if i.ProviderType != nil {
-		predicates = append(predicates, providerquotastatus.ProviderTypeEQ(*i.ProviderType))
+	if i.IssuerGT != nil {
+		predicates = append(predicates, oidcidentity.IssuerGT(*i.IssuerGT))
 	}
-	if i.ProviderTypeNEQ != nil {
-		predicates = append(predicates, providerquotastatus.ProviderTypeNEQ(*i.ProviderTypeNEQ))
+	if i.IssuerGTE != nil {
+		predicates = append(predicates, oidcidentity.IssuerGTE(*i.IssuerGTE))
 	}
-	if len(i.ProviderTypeIn) > 0 {
-		predicates = append(predicates, providerquotastatus.ProviderTypeIn(i.ProviderTypeIn...))
+	if i.IssuerLT != nil {
+		predicates = append(predicates, oidcidentity.IssuerLT(*i.IssuerLT))
 	}
-	if len(i.ProviderTypeNotIn) > 0 {
-		predicates = append(predicates, providerquotastatus.ProviderTypeNotIn(i.ProviderTypeNotIn...))
+	if i.IssuerLTE != nil {
+		predicates = append(predicates, oidcidentity.IssuerLTE(*i.IssuerLTE))
 	}
-	if i.Status != nil {
-		predicates = append(predicates, providerquotastatus.StatusEQ(*i.Status))
+	if i.IssuerContains != nil {
+		predicates = append(predicates, oidcidentity.IssuerContains(*i.IssuerContains))
 	}
-	if i.StatusNEQ != nil {
-		predicates = append(predicates, providerquotastatus.StatusNEQ(*i.StatusNEQ))
+	if i.IssuerHasPrefix != nil {
+		predicates = append(predicates, oidcidentity.IssuerHasPrefix(*i.IssuerHasPrefix))
 	}
-	if len(i.StatusIn) > 0 {
-		predicates = append(predicates, providerquotastatus.StatusIn(i.StatusIn...))
+	if i.IssuerHasSuffix != nil {
+		predicates = append(predicates, oidcidentity.IssuerHasSuffix(*i.IssuerHasSuffix))
 	}
-	if len(i.StatusNotIn) > 0 {
-		predicates = append(predicates, providerquotastatus.StatusNotIn(i.StatusNotIn...))
+	if i.IssuerEqualFold != nil {
+		predicates = append(predicates, oidcidentity.IssuerEqualFold(*i.IssuerEqualFold))
 	}
-	if i.NextResetAt != nil {
-		predicates = append(predicates, providerquotastatus.NextResetAtEQ(*i.NextResetAt))
+	if i.IssuerContainsFold != nil {
+		predicates = append(predicates, oidcidentity.IssuerContainsFold(*i.IssuerContainsFold))
 	}
-	if i.NextResetAtNEQ != nil {
-		predicates = append(predicates, providerquotastatus.NextResetAtNEQ(*i.NextResetAtNEQ))
+	if i.Subject != nil {
+		predicates = append(predicates, oidcidentity.SubjectEQ(*i.Subject))
 	}
-	if len(i.NextResetAtIn) > 0 {
-		predicates = append(predicates, providerquotastatus.NextResetAtIn(i.NextResetAtIn...))
+	if i.SubjectNEQ != nil {
+		predicates = append(predicates, oidcidentity.SubjectNEQ(*i.SubjectNEQ))
 	}
-	if len(i.NextResetAtNotIn) > 0 {
-		predicates = append(predicates, providerquotastatus.NextResetAtNotIn(i.NextResetAtNotIn...))
+	if len(i.SubjectIn) > 0 {
+		predicates = append(predicates, oidcidentity.SubjectIn(i.SubjectIn...))
 	}
-	if i.NextResetAtGT != nil {
-		predicates = append(predicates, providerquotastatus.NextResetAtGT(*i.NextResetAtGT))
+	if len(i.SubjectNotIn) > 0 {
+		predicates = append(predicates, oidcidentity.SubjectNotIn(i.SubjectNotIn...))
 	}
-	if i.NextResetAtGTE != nil {
-		predicates = append(predicates, providerquotastatus.NextResetAtGTE(*i.NextResetAtGTE))
+	if i.SubjectGT != nil {
+		predicates = append(predicates, oidcidentity.SubjectGT(*i.SubjectGT))
 	}
-	if i.NextResetAtLT != nil {
-		predicates = append(predicates, providerquotastatus.NextResetAtLT(*i.NextResetAtLT))
+	if i.SubjectGTE != nil {
+		predicates = append(predicates, oidcidentity.SubjectGTE(*i.SubjectGTE))
 	}
-	if i.NextResetAtLTE != nil {
-		predicates = append(predicates, providerquotastatus.NextResetAtLTE(*i.NextResetAtLTE))
+	if i.SubjectLT != nil {
+		predicates = append(predicates, oidcidentity.SubjectLT(*i.SubjectLT))
 	}
-	if i.NextResetAtIsNil {
-		predicates = append(predicates, providerquotastatus.NextResetAtIsNil())
+	if i.SubjectLTE != nil {
+		predicates = append(predicates, oidcidentity.SubjectLTE(*i.SubjectLTE))
 	}
-	if i.NextResetAtNotNil {
-		predicates = append(predicates, providerquotastatus.NextResetAtNotNil())
+	if i.SubjectContains != nil {
+		predicates = append(predicates, oidcidentity.SubjectContains(*i.SubjectContains))
 	}
-	if i.Ready != nil {
-		predicates = append(predicates, providerquotastatus.ReadyEQ(*i.Ready))
+	if i.SubjectHasPrefix != nil {
+		predicates = append(predicates, oidcidentity.SubjectHasPrefix(*i.SubjectHasPrefix))
 	}
-	if i.ReadyNEQ != nil {
-		predicates = append(predicates, providerquotastatus.ReadyNEQ(*i.ReadyNEQ))
+	if i.SubjectHasSuffix != nil {
+		predicates = append(predicates, oidcidentity.SubjectHasSuffix(*i.SubjectHasSuffix))
 	}
-	if i.NextCheckAt != nil {
-		predicates = append(predicates, providerquotastatus.NextCheckAtEQ(*i.NextCheckAt))
+	if i.SubjectEqualFold != nil {
+		predicates = append(predicates, oidcidentity.SubjectEqualFold(*i.SubjectEqualFold))
 	}
-	if i.NextCheckAtNEQ != nil {
-		predicates = append(predicates, providerquotastatus.NextCheckAtNEQ(*i.NextCheckAtNEQ))
+	if i.SubjectContainsFold != nil {
+		predicates = append(predicates, oidcidentity.SubjectContainsFold(*i.SubjectContainsFold))
 	}
-	if len(i.NextCheckAtIn) > 0 {
-		predicates = append(predicates, providerquotastatus.NextCheckAtIn(i.NextCheckAtIn...))
+	if i.Email != nil {
+		predicates = append(predicates, oidcidentity.EmailEQ(*i.Email))
 	}
-	if len(i.NextCheckAtNotIn) > 0 {
-		predicates = append(predicates, providerquotastatus.NextCheckAtNotIn(i.NextCheckAtNotIn...))
+	if i.EmailNEQ != nil {
+		predicates = append(predicates, oidcidentity.EmailNEQ(*i.EmailNEQ))
 	}
-	if i.NextCheckAtGT != nil {
-		predicates = append(predicates, providerquotastatus.NextCheckAtGT(*i.NextCheckAtGT))
+	if len(i.EmailIn) > 0 {
+		predicates = append(predicates, oidcidentity.EmailIn(i.EmailIn...))
 	}
-	if i.NextCheckAtGTE != nil {
-		predicates = append(predicates, providerquotastatus.NextCheckAtGTE(*i.NextCheckAtGTE))
+	if len(i.EmailNotIn) > 0 {
+		predicates = append(predicates, oidcidentity.EmailNotIn(i.EmailNotIn...))
 	}
-	if i.NextCheckAtLT != nil {
-		predicates = append(predicates, providerquotastatus.NextCheckAtLT(*i.NextCheckAtLT))
+	if i.EmailGT != nil {
+		predicates = append(predicates, oidcidentity.EmailGT(*i.EmailGT))
 	}
-	if i.NextCheckAtLTE != nil {
-		predicates = append(predicates, providerquotastatus.NextCheckAtLTE(*i.NextCheckAtLTE))
+	if i.EmailGTE != nil {
+		predicates = append(predicates, oidcidentity.EmailGTE(*i.EmailGTE))
+	}
+	if i.EmailLT != nil {
+		predicates = append(predicates, oidcidentity.EmailLT(*i.EmailLT))
+	}
+	if i.EmailLTE != nil {
+		predicates = append(predicates, oidcidentity.EmailLTE(*i.EmailLTE))
+	}
+	if i.EmailContains != nil {
+		predicates = append(predicates, oidcidentity.EmailContains(*i.EmailContains))
+	}
+	if i.EmailHasPrefix != nil {
+		predicates = append(predicates, oidcidentity.EmailHasPrefix(*i.EmailHasPrefix))
+	}
+	if i.EmailHasSuffix != nil {
+		predicates = append(predicates, oidcidentity.EmailHasSuffix(*i.EmailHasSuffix))
+	}
+	if i.EmailIsNil {
+		predicates = append(predicates, oidcidentity.EmailIsNil())
+	}
+	if i.EmailNotNil {
+		predicates = append(predicates, oidcidentity.EmailNotNil())
+	}
+	if i.EmailEqualFold != nil {
+		predicates = append(predicates, oidcidentity.EmailEqualFold(*i.EmailEqualFold))
+	}
+	if i.EmailContainsFold != nil {
+		predicates = append(predicates, oidcidentity.EmailContainsFold(*i.EmailContainsFold))
+	}
+	if i.IdpName != nil {
+		predicates = append(predicates, oidcidentity.IdpNameEQ(*i.IdpName))
+	}
+	if i.IdpNameNEQ != nil {
+		predicates = append(predicates, oidcidentity.IdpNameNEQ(*i.IdpNameNEQ))
+	}
+	if len(i.IdpNameIn) > 0 {
+		predicates = append(predicates, oidcidentity.IdpNameIn(i.IdpNameIn...))
+	}
+	if len(i.IdpNameNotIn) > 0 {
+		predicates = append(predicates, oidcidentity.IdpNameNotIn(i.IdpNameNotIn...))
+	}
+	if i.IdpNameGT != nil {
+		predicates = append(predicates, oidcidentity.IdpNameGT(*i.IdpNameGT))
+	}
+	if i.IdpNameGTE != nil {
+		predicates = append(predicates, oidcidentity.IdpNameGTE(*i.IdpNameGTE))
+	}
+	if i.IdpNameLT != nil {
+		predicates = append(predicates, oidcidentity.IdpNameLT(*i.IdpNameLT))
+	}
+	if i.IdpNameLTE != nil {
+		predicates = append(predicates, oidcidentity.IdpNameLTE(*i.IdpNameLTE))
+	}
+	if i.IdpNameContains != nil {
+		predicates = append(predicates, oidcidentity.IdpNameContains(*i.IdpNameContains))
+	}
+	if i.IdpNameHasPrefix != nil {
+		predicates = append(predicates, oidcidentity.IdpNameHasPrefix(*i.IdpNameHasPrefix))
+	}
+	if i.IdpNameHasSuffix != nil {
+		predicates = append(predicates, oidcidentity.IdpNameHasSuffix(*i.IdpNameHasSuffix))
+	}
+	if i.IdpNameIsNil {
+		predicates = append(predicates, oidcidentity.IdpNameIsNil())
+	}
+	if i.IdpNameNotNil {
+		predicates = append(predicates, oidcidentity.IdpNameNotNil())
+	}
+	if i.IdpNameEqualFold != nil {
+		predicates = append(predicates, oidcidentity.IdpNameEqualFold(*i.IdpNameEqualFold))
+	}
+	if i.IdpNameContainsFold != nil {
+		predicates = append(predicates, oidcidentity.IdpNameContainsFold(*i.IdpNameContainsFold))
+	}
+	if i.LastLoginAt != nil {
+		predicates = append(predicates, oidcidentity.LastLoginAtEQ(*i.LastLoginAt))
+	}
+	if i.LastLoginAtNEQ != nil {
+		predicates = append(predicates, oidcidentity.LastLoginAtNEQ(*i.LastLoginAtNEQ))
+	}
+	if len(i.LastLoginAtIn) > 0 {
+		predicates = append(predicates, oidcidentity.LastLoginAtIn(i.LastLoginAtIn...))
+	}
+	if len(i.LastLoginAtNotIn) > 0 {
+		predicates = append(predicates, oidcidentity.LastLoginAtNotIn(i.LastLoginAtNotIn...))
+	}
+	if i.LastLoginAtGT != nil {
+		predicates = append(predicates, oidcidentity.LastLoginAtGT(*i.LastLoginAtGT))
+	}
+	if i.LastLoginAtGTE != nil {
+		predicates = append(predicates, oidcidentity.LastLoginAtGTE(*i.LastLoginAtGTE))
+	}
+	if i.LastLoginAtLT != nil {
+		predicates = append(predicates, oidcidentity.LastLoginAtLT(*i.LastLoginAtLT))
+	}
+	if i.LastLoginAtLTE != nil {
+		predicates = append(predicates, oidcidentity.LastLoginAtLTE(*i.LastLoginAtLTE))
+	}
+	if i.LastLoginAtIsNil {
+		predicates = append(predicates, oidcidentity.LastLoginAtIsNil())
+	}
+	if i.LastLoginAtNotNil {
+		predicates = append(predicates, oidcidentity.LastLoginAtNotNil())
+	}
+	if i.UserID != nil {
+		predicates = append(predicates, oidcidentity.UserIDEQ(*i.UserID))
+	}
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, oidcidentity.UserIDNEQ(*i.UserIDNEQ))
+	}
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, oidcidentity.UserIDIn(i.UserIDIn...))
+	}
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, oidcidentity.UserIDNotIn(i.UserIDNotIn...))
 	}
 
-	if i.HasChannel != nil {
-		p := providerquotastatus.HasChannel()
-		if !*i.HasChannel {
-			p = providerquotastatus.Not(p)
+	if i.HasUser != nil {
+		p := oidcidentity.HasUser()
+		if !*i.HasUser {
+			p = oidcidentity.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasChannelWith) > 0 {
-		with := make([]predicate.Channel, 0, len(i.HasChannelWith))
-		for _, w := range i.HasChannelWith {
-			p, err := w.P()
+	if len(i.HasUserWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUserWith))
+		for _, w := range i.HasUserWith {
+			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasChannelWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, providerquotastatus.HasChannelWith(with...))
+		predicates = append(predicates, oidcidentity.HasUserWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyProviderQuotaStatusWhereInput
+		return nil, ErrEmptyOIDCIdentityWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return providerquotastatus.And(predicates...), nil
+		return oidcidentity.And(predicates...), nil
 	}
 }
 
-// RequestWhereInput represents a where input for filtering Request queries.
-type RequestWhereInput struct {
-	Predicates []predicate.Request  `json:"-"`
-	Not        *RequestWhereInput   `json:"not,omitempty"`
-	Or         []*RequestWhereInput `json:"or,omitempty"`
-	And        []*RequestWhereInput `json:"and,omitempty"`
+// ProjectWhereInput represents a where input for filtering Project queries.
+type ProjectWhereInput struct {
+	Predicates []predicate.Project  `json:"-"`
+	Not        *ProjectWhereInput   `json:"not,omitempty"`
+	Or         []*ProjectWhereInput `json:"or,omitempty"`
+	And        []*ProjectWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -6427,262 +7525,108 @@ type RequestWhereInput struct {
 	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
 	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
-	// "api_key_id" field predicates.
-	APIKeyID       *int  `json:"apiKeyID,omitempty"`
-	APIKeyIDNEQ    *int  `json:"apiKeyIDNEQ,omitempty"`
-	APIKeyIDIn     []int `json:"apiKeyIDIn,omitempty"`
-	APIKeyIDNotIn  []int `json:"apiKeyIDNotIn,omitempty"`
-	APIKeyIDIsNil  bool  `json:"apiKeyIDIsNil,omitempty"`
-	APIKeyIDNotNil bool  `json:"apiKeyIDNotNil,omitempty"`
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
-	// "project_id" field predicates.
-	ProjectID      *int  `json:"projectID,omitempty"`
-	ProjectIDNEQ   *int  `json:"projectIDNEQ,omitempty"`
-	ProjectIDIn    []int `json:"projectIDIn,omitempty"`
-	ProjectIDNotIn []int `json:"projectIDNotIn,omitempty"`
+	// "description" field predicates.
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
 
-	// "trace_id" field predicates.
-	TraceID       *int  `json:"traceID,omitempty"`
-	TraceIDNEQ    *int  `json:"traceIDNEQ,omitempty"`
-	TraceIDIn     []int `json:"traceIDIn,omitempty"`
-	TraceIDNotIn  []int `json:"traceIDNotIn,omitempty"`
-	TraceIDIsNil  bool  `json:"traceIDIsNil,omitempty"`
-	TraceIDNotNil bool  `json:"traceIDNotNil,omitempty"`
+	// "status" field predicates.
+	Status      *project.Status  `json:"status,omitempty"`
+	StatusNEQ   *project.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []project.Status `json:"statusIn,omitempty"`
+	StatusNotIn []project.Status `json:"statusNotIn,omitempty"`
 
-	// "data_storage_id" field predicates.
-	DataStorageID       *int  `json:"dataStorageID,omitempty"`
-	DataStorageIDNEQ    *int  `json:"dataStorageIDNEQ,omitempty"`
-	DataStorageIDIn     []int `json:"dataStorageIDIn,omitempty"`
-	DataStorageIDNotIn  []int `json:"dataStorageIDNotIn,omitempty"`
-	DataStorageIDIsNil  bool  `json:"dataStorageIDIsNil,omitempty"`
-	DataStorageIDNotNil bool  `json:"dataStorageIDNotNil,omitempty"`
+	// "users" edge predicates.
+	HasUsers     *bool             `json:"hasUsers,omitempty"`
+	HasUsersWith []*UserWhereInput `json:"hasUsersWith,omitempty"`
 
-	// "source" field predicates.
-	Source      *request.Source  `json:"source,omitempty"`
-	SourceNEQ   *request.Source  `json:"sourceNEQ,omitempty"`
-	SourceIn    []request.Source `json:"sourceIn,omitempty"`
-	SourceNotIn []request.Source `json:"sourceNotIn,omitempty"`
+	// "roles" edge predicates.
+	HasRoles     *bool             `json:"hasRoles,omitempty"`
+	HasRolesWith []*RoleWhereInput `json:"hasRolesWith,omitempty"`
 
-	// "model_id" field predicates.
-	ModelID             *string  `json:"modelID,omitempty"`
-	ModelIDNEQ          *string  `json:"modelIDNEQ,omitempty"`
-	ModelIDIn           []string `json:"modelIDIn,omitempty"`
-	ModelIDNotIn        []string `json:"modelIDNotIn,omitempty"`
-	ModelIDGT           *string  `json:"modelIDGT,omitempty"`
-	ModelIDGTE          *string  `json:"modelIDGTE,omitempty"`
-	ModelIDLT           *string  `json:"modelIDLT,omitempty"`
-	ModelIDLTE          *string  `json:"modelIDLTE,omitempty"`
-	ModelIDContains     *string  `json:"modelIDContains,omitempty"`
-	ModelIDHasPrefix    *string  `json:"modelIDHasPrefix,omitempty"`
-	ModelIDHasSuffix    *string  `json:"modelIDHasSuffix,omitempty"`
-	ModelIDEqualFold    *string  `json:"modelIDEqualFold,omitempty"`
-	ModelIDContainsFold *string  `json:"modelIDContainsFold,omitempty"`
+	// "api_keys" edge predicates.
+	HasAPIKeys     *bool               `json:"hasAPIKeys,omitempty"`
+	HasAPIKeysWith []*APIKeyWhereInput `json:"hasAPIKeysWith,omitempty"`
 
-	// "reasoning_effort" field predicates.
-	ReasoningEffort             *string  `json:"reasoningEffort,omitempty"`
-	ReasoningEffortNEQ          *string  `json:"reasoningEffortNEQ,omitempty"`
-	ReasoningEffortIn           []string `json:"reasoningEffortIn,omitempty"`
-	ReasoningEffortNotIn        []string `json:"reasoningEffortNotIn,omitempty"`
-	ReasoningEffortGT           *string  `json:"reasoningEffortGT,omitempty"`
-	ReasoningEffortGTE          *string  `json:"reasoningEffortGTE,omitempty"`
-	ReasoningEffortLT           *string  `json:"reasoningEffortLT,omitempty"`
-	ReasoningEffortLTE          *string  `json:"reasoningEffortLTE,omitempty"`
-	ReasoningEffortContains     *string  `json:"reasoningEffortContains,omitempty"`
-	ReasoningEffortHasPrefix    *string  `json:"reasoningEffortHasPrefix,omitempty"`
-	ReasoningEffortHasSuffix    *string  `json:"reasoningEffortHasSuffix,omitempty"`
-	ReasoningEffortIsNil        bool     `json:"reasoningEffortIsNil,omitempty"`
-	ReasoningEffortNotNil       bool     `json:"reasoningEffortNotNil,omitempty"`
-	ReasoningEffortEqualFold    *string  `json:"reasoningEffortEqualFold,omitempty"`
-	ReasoningEffortContainsFold *string  `json:"reasoningEffortContainsFold,omitempty"`
+	// "requests" edge predicates.
+	HasRequests     *bool                `json:"hasRequests,omitempty"`
+	HasRequestsWith []*RequestWhereInput `json:"hasRequestsWith,omitempty"`
 
-	// "format" field predicates.
-	Format             *string  `json:"format,omitempty"`
-	FormatNEQ          *string  `json:"formatNEQ,omitempty"`
-	FormatIn           []string `json:"formatIn,omitempty"`
-	FormatNotIn        []string `json:"formatNotIn,omitempty"`
-	FormatGT           *string  `json:"formatGT,omitempty"`
-	FormatGTE          *string  `json:"formatGTE,omitempty"`
-	FormatLT           *string  `json:"formatLT,omitempty"`
-	FormatLTE          *string  `json:"formatLTE,omitempty"`
-	FormatContains     *string  `json:"formatContains,omitempty"`
-	FormatHasPrefix    *string  `json:"formatHasPrefix,omitempty"`
-	FormatHasSuffix    *string  `json:"formatHasSuffix,omitempty"`
-	FormatEqualFold    *string  `json:"formatEqualFold,omitempty"`
-	FormatContainsFold *string  `json:"formatContainsFold,omitempty"`
+	// "usage_logs" edge predicates.
+	HasUsageLogs     *bool                 `json:"hasUsageLogs,omitempty"`
+	HasUsageLogsWith []*UsageLogWhereInput `json:"hasUsageLogsWith,omitempty"`
 
-	// "channel_id" field predicates.
-	ChannelID       *int  `json:"channelID,omitempty"`
-	ChannelIDNEQ    *int  `json:"channelIDNEQ,omitempty"`
-	ChannelIDIn     []int `json:"channelIDIn,omitempty"`
-	ChannelIDNotIn  []int `json:"channelIDNotIn,omitempty"`
-	ChannelIDIsNil  bool  `json:"channelIDIsNil,omitempty"`
-	ChannelIDNotNil bool  `json:"channelIDNotNil,omitempty"`
+	// "threads" edge predicates.
+	HasThreads     *bool               `json:"hasThreads,omitempty"`
+	HasThreadsWith []*ThreadWhereInput `json:"hasThreadsWith,omitempty"`
 
-	// "external_id" field predicates.
-	ExternalID             *string  `json:"externalID,omitempty"`
-	ExternalIDNEQ          *string  `json:"externalIDNEQ,omitempty"`
-	ExternalIDIn           []string `json:"externalIDIn,omitempty"`
-	ExternalIDNotIn        []string `json:"externalIDNotIn,omitempty"`
-	ExternalIDGT           *string  `json:"externalIDGT,omitempty"`
-	ExternalIDGTE          *string  `json:"externalIDGTE,omitempty"`
-	ExternalIDLT           *string  `json:"externalIDLT,omitempty"`
-	ExternalIDLTE          *string  `json:"externalIDLTE,omitempty"`
-	ExternalIDContains     *string  `json:"externalIDContains,omitempty"`
-	ExternalIDHasPrefix    *string  `json:"externalIDHasPrefix,omitempty"`
-	ExternalIDHasSuffix    *string  `json:"externalIDHasSuffix,omitempty"`
-	ExternalIDIsNil        bool     `json:"externalIDIsNil,omitempty"`
-	ExternalIDNotNil       bool     `json:"externalIDNotNil,omitempty"`
-	ExternalIDEqualFold    *string  `json:"externalIDEqualFold,omitempty"`
-	ExternalIDContainsFold *string  `json:"externalIDContainsFold,omitempty"`
+	// "traces" edge predicates.
+	HasTraces     *bool              `json:"hasTraces,omitempty"`
+	HasTracesWith []*TraceWhereInput `json:"hasTracesWith,omitempty"`
 
-	// "status" field predicates.
-	Status      *request.Status  `json:"status,omitempty"`
-	StatusNEQ   *request.Status  `json:"statusNEQ,omitempty"`
-	StatusIn    []request.Status `json:"statusIn,omitempty"`
-	StatusNotIn []request.Status `json:"statusNotIn,omitempty"`
+	// "prompts" edge predicates.
+	HasPrompts     *bool               `json:"hasPrompts,omitempty"`
+	HasPromptsWith []*PromptWhereInput `json:"hasPromptsWith,omitempty"`
 
-	// "stream" field predicates.
-	Stream    *bool `json:"stream,omitempty"`
-	StreamNEQ *bool `json:"streamNEQ,omitempty"`
+	// "api_key_profile_templates" edge predicates.
+	HasAPIKeyProfileTemplates     *bool                              `json:"hasAPIKeyProfileTemplates,omitempty"`
+	HasAPIKeyProfileTemplatesWith []*APIKeyProfileTemplateWhereInput `json:"hasAPIKeyProfileTemplatesWith,omitempty"`
 
-	// "client_ip" field predicates.
-	ClientIP             *string  `json:"clientIP,omitempty"`
-	ClientIPNEQ          *string  `json:"clientIPNEQ,omitempty"`
-	ClientIPIn           []string `json:"clientIPIn,omitempty"`
-	ClientIPNotIn        []string `json:"clientIPNotIn,omitempty"`
-	ClientIPGT           *string  `json:"clientIPGT,omitempty"`
-	ClientIPGTE          *string  `json:"clientIPGTE,omitempty"`
-	ClientIPLT           *string  `json:"clientIPLT,omitempty"`
-	ClientIPLTE          *string  `json:"clientIPLTE,omitempty"`
-	ClientIPContains     *string  `json:"clientIPContains,omitempty"`
-	ClientIPHasPrefix    *string  `json:"clientIPHasPrefix,omitempty"`
-	ClientIPHasSuffix    *string  `json:"clientIPHasSuffix,omitempty"`
-	ClientIPEqualFold    *string  `json:"clientIPEqualFold,omitempty"`
-	ClientIPContainsFold *string  `json:"clientIPContainsFold,omitempty"`
+	// "budgets" edge predicates.
+	HasBudgets     *bool               `json:"hasBudgets,omitempty"`
+	HasBudgetsWith []*BudgetWhereInput `json:"hasBudgetsWith,omitempty"`
 
-	// "metrics_latency_ms" field predicates.
-	MetricsLatencyMs       *int64  `json:"metricsLatencyMs,omitempty"`
-	MetricsLatencyMsNEQ    *int64  `json:"metricsLatencyMsNEQ,omitempty"`
-	MetricsLatencyMsIn     []int64 `json:"metricsLatencyMsIn,omitempty"`
-	MetricsLatencyMsNotIn  []int64 `json:"metricsLatencyMsNotIn,omitempty"`
-	MetricsLatencyMsGT     *int64  `json:"metricsLatencyMsGT,omitempty"`
-	MetricsLatencyMsGTE    *int64  `json:"metricsLatencyMsGTE,omitempty"`
-	MetricsLatencyMsLT     *int64  `json:"metricsLatencyMsLT,omitempty"`
-	MetricsLatencyMsLTE    *int64  `json:"metricsLatencyMsLTE,omitempty"`
-	MetricsLatencyMsIsNil  bool    `json:"metricsLatencyMsIsNil,omitempty"`
-	MetricsLatencyMsNotNil bool    `json:"metricsLatencyMsNotNil,omitempty"`
+	// "invoices" edge predicates.
+	HasInvoices     *bool                `json:"hasInvoices,omitempty"`
+	HasInvoicesWith []*InvoiceWhereInput `json:"hasInvoicesWith,omitempty"`
 
-	// "metrics_first_token_latency_ms" field predicates.
-	MetricsFirstTokenLatencyMs       *int64  `json:"metricsFirstTokenLatencyMs,omitempty"`
-	MetricsFirstTokenLatencyMsNEQ    *int64  `json:"metricsFirstTokenLatencyMsNEQ,omitempty"`
-	MetricsFirstTokenLatencyMsIn     []int64 `json:"metricsFirstTokenLatencyMsIn,omitempty"`
-	MetricsFirstTokenLatencyMsNotIn  []int64 `json:"metricsFirstTokenLatencyMsNotIn,omitempty"`
-	MetricsFirstTokenLatencyMsGT     *int64  `json:"metricsFirstTokenLatencyMsGT,omitempty"`
-	MetricsFirstTokenLatencyMsGTE    *int64  `json:"metricsFirstTokenLatencyMsGTE,omitempty"`
-	MetricsFirstTokenLatencyMsLT     *int64  `json:"metricsFirstTokenLatencyMsLT,omitempty"`
-	MetricsFirstTokenLatencyMsLTE    *int64  `json:"metricsFirstTokenLatencyMsLTE,omitempty"`
-	MetricsFirstTokenLatencyMsIsNil  bool    `json:"metricsFirstTokenLatencyMsIsNil,omitempty"`
-	MetricsFirstTokenLatencyMsNotNil bool    `json:"metricsFirstTokenLatencyMsNotNil,omitempty"`
+	// "data_exports" edge predicates.
+	HasDataExports     *bool                   `json:"hasDataExports,omitempty"`
+	HasDataExportsWith []*DataExportWhereInput `json:"hasDataExportsWith,omitempty"`
 
-	// "metrics_reasoning_duration_ms" field predicates.
-	MetricsReasoningDurationMs       *int64  `json:"metricsReasoningDurationMs,omitempty"`
-	MetricsReasoningDurationMsNEQ    *int64  `json:"metricsReasoningDurationMsNEQ,omitempty"`
-	MetricsReasoningDurationMsIn     []int64 `json:"metricsReasoningDurationMsIn,omitempty"`
-	MetricsReasoningDurationMsNotIn  []int64 `json:"metricsReasoningDurationMsNotIn,omitempty"`
-	MetricsReasoningDurationMsGT     *int64  `json:"metricsReasoningDurationMsGT,omitempty"`
-	MetricsReasoningDurationMsGTE    *int64  `json:"metricsReasoningDurationMsGTE,omitempty"`
-	MetricsReasoningDurationMsLT     *int64  `json:"metricsReasoningDurationMsLT,omitempty"`
-	MetricsReasoningDurationMsLTE    *int64  `json:"metricsReasoningDurationMsLTE,omitempty"`
-	MetricsReasoningDurationMsIsNil  bool    `json:"metricsReasoningDurationMsIsNil,omitempty"`
-	MetricsReasoningDurationMsNotNil bool    `json:"metricsReasoningDurationMsNotNil,omitempty"`
+	// "project_users" edge predicates.
+	HasProjectUsers     *bool                    `json:"hasProjectUsers,omitempty"`
+	HasProjectUsersWith []*UserProjectWhereInput `json:"hasProjectUsersWith,omitempty"`
+}
 
-	// "content_saved" field predicates.
-	ContentSaved    *bool `json:"contentSaved,omitempty"`
-	ContentSavedNEQ *bool `json:"contentSavedNEQ,omitempty"`
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *ProjectWhereInput) AddPredicates(predicates ...predicate.Project) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
 
-	// "content_storage_id" field predicates.
-	ContentStorageID       *int  `json:"contentStorageID,omitempty"`
-	ContentStorageIDNEQ    *int  `json:"contentStorageIDNEQ,omitempty"`
-	ContentStorageIDIn     []int `json:"contentStorageIDIn,omitempty"`
-	ContentStorageIDNotIn  []int `json:"contentStorageIDNotIn,omitempty"`
-	ContentStorageIDGT     *int  `json:"contentStorageIDGT,omitempty"`
-	ContentStorageIDGTE    *int  `json:"contentStorageIDGTE,omitempty"`
-	ContentStorageIDLT     *int  `json:"contentStorageIDLT,omitempty"`
-	ContentStorageIDLTE    *int  `json:"contentStorageIDLTE,omitempty"`
-	ContentStorageIDIsNil  bool  `json:"contentStorageIDIsNil,omitempty"`
-	ContentStorageIDNotNil bool  `json:"contentStorageIDNotNil,omitempty"`
-
-	// "content_storage_key" field predicates.
-	ContentStorageKey             *string  `json:"contentStorageKey,omitempty"`
-	ContentStorageKeyNEQ          *string  `json:"contentStorageKeyNEQ,omitempty"`
-	ContentStorageKeyIn           []string `json:"contentStorageKeyIn,omitempty"`
-	ContentStorageKeyNotIn        []string `json:"contentStorageKeyNotIn,omitempty"`
-	ContentStorageKeyGT           *string  `json:"contentStorageKeyGT,omitempty"`
-	ContentStorageKeyGTE          *string  `json:"contentStorageKeyGTE,omitempty"`
-	ContentStorageKeyLT           *string  `json:"contentStorageKeyLT,omitempty"`
-	ContentStorageKeyLTE          *string  `json:"contentStorageKeyLTE,omitempty"`
-	ContentStorageKeyContains     *string  `json:"contentStorageKeyContains,omitempty"`
-	ContentStorageKeyHasPrefix    *string  `json:"contentStorageKeyHasPrefix,omitempty"`
-	ContentStorageKeyHasSuffix    *string  `json:"contentStorageKeyHasSuffix,omitempty"`
-	ContentStorageKeyIsNil        bool     `json:"contentStorageKeyIsNil,omitempty"`
-	ContentStorageKeyNotNil       bool     `json:"contentStorageKeyNotNil,omitempty"`
-	ContentStorageKeyEqualFold    *string  `json:"contentStorageKeyEqualFold,omitempty"`
-	ContentStorageKeyContainsFold *string  `json:"contentStorageKeyContainsFold,omitempty"`
-
-	// "content_saved_at" field predicates.
-	ContentSavedAt       *time.Time  `json:"contentSavedAt,omitempty"`
-	ContentSavedAtNEQ    *time.Time  `json:"contentSavedAtNEQ,omitempty"`
-	ContentSavedAtIn     []time.Time `json:"contentSavedAtIn,omitempty"`
-	ContentSavedAtNotIn  []time.Time `json:"contentSavedAtNotIn,omitempty"`
-	ContentSavedAtGT     *time.Time  `json:"contentSavedAtGT,omitempty"`
-	ContentSavedAtGTE    *time.Time  `json:"contentSavedAtGTE,omitempty"`
-	ContentSavedAtLT     *time.Time  `json:"contentSavedAtLT,omitempty"`
-	ContentSavedAtLTE    *time.Time  `json:"contentSavedAtLTE,omitempty"`
-	ContentSavedAtIsNil  bool        `json:"contentSavedAtIsNil,omitempty"`
-	ContentSavedAtNotNil bool        `json:"contentSavedAtNotNil,omitempty"`
-
-	// "api_key" edge predicates.
-	HasAPIKey     *bool               `json:"hasAPIKey,omitempty"`
-	HasAPIKeyWith []*APIKeyWhereInput `json:"hasAPIKeyWith,omitempty"`
-
-	// "project" edge predicates.
-	HasProject     *bool                `json:"hasProject,omitempty"`
-	HasProjectWith []*ProjectWhereInput `json:"hasProjectWith,omitempty"`
-
-	// "trace" edge predicates.
-	HasTrace     *bool              `json:"hasTrace,omitempty"`
-	HasTraceWith []*TraceWhereInput `json:"hasTraceWith,omitempty"`
-
-	// "data_storage" edge predicates.
-	HasDataStorage     *bool                    `json:"hasDataStorage,omitempty"`
-	HasDataStorageWith []*DataStorageWhereInput `json:"hasDataStorageWith,omitempty"`
-
-	// "executions" edge predicates.
-	HasExecutions     *bool                         `json:"hasExecutions,omitempty"`
-	HasExecutionsWith []*RequestExecutionWhereInput `json:"hasExecutionsWith,omitempty"`
-
-	// "channel" edge predicates.
-	HasChannel     *bool                `json:"hasChannel,omitempty"`
-	HasChannelWith []*ChannelWhereInput `json:"hasChannelWith,omitempty"`
-
-	// "usage_logs" edge predicates.
-	HasUsageLogs     *bool                 `json:"hasUsageLogs,omitempty"`
-	HasUsageLogsWith []*UsageLogWhereInput `json:"hasUsageLogsWith,omitempty"`
-}
-
-// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *RequestWhereInput) AddPredicates(predicates ...predicate.Request) {
-	i.Predicates = append(i.Predicates, predicates...)
-}
-
-// Filter applies the RequestWhereInput filter on the RequestQuery builder.
-func (i *RequestWhereInput) Filter(q *RequestQuery) (*RequestQuery, error) {
+// Filter applies the ProjectWhereInput filter on the ProjectQuery builder.
+func (i *ProjectWhereInput) Filter(q *ProjectQuery) (*ProjectQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyRequestWhereInput {
+		if err == ErrEmptyProjectWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -6690,19 +7634,19 @@ func (i *RequestWhereInput) Filter(q *RequestQuery) (*RequestQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyRequestWhereInput is returned in case the RequestWhereInput is empty.
-var ErrEmptyRequestWhereInput = errors.New("ent: empty predicate RequestWhereInput")
+// ErrEmptyProjectWhereInput is returned in case the ProjectWhereInput is empty.
+var ErrEmptyProjectWhereInput = errors.New("ent: empty predicate ProjectWhereInput")
 
-// P returns a predicate for filtering requests.
+// P returns a predicate for filtering projects.
 // An error is returned if the input is empty or invalid.
-func (i *RequestWhereInput) P() (predicate.Request, error) {
-	var predicates []predicate.Request
+func (i *ProjectWhereInput) P() (predicate.Project, error) {
+	var predicates []predicate.Project
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, request.Not(p))
+		predicates = append(predicates, project.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -6712,7 +7656,7 @@ func (i *RequestWhereInput) P() (predicate.Request, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Request, 0, n)
+		or := make([]predicate.Project, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -6720,7 +7664,7 @@ func (i *RequestWhereInput) P() (predicate.Request, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, request.Or(or...))
+		predicates = append(predicates, project.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -6730,7 +7674,7 @@ func (i *RequestWhereInput) P() (predicate.Request, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Request, 0, n)
+		and := make([]predicate.Project, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -6738,746 +7682,4352 @@ func (i *RequestWhereInput) P() (predicate.Request, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, request.And(and...))
+		predicates = append(predicates, project.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, request.IDEQ(*i.ID))
+		predicates = append(predicates, project.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, request.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, project.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, request.IDIn(i.IDIn...))
+		predicates = append(predicates, project.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, request.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, project.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, request.IDGT(*i.IDGT))
+		predicates = append(predicates, project.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, request.IDGTE(*i.IDGTE))
+		predicates = append(predicates, project.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, request.IDLT(*i.IDLT))
+		predicates = append(predicates, project.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, request.IDLTE(*i.IDLTE))
+		predicates = append(predicates, project.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, request.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, project.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, request.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, project.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, request.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, project.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, request.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, project.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, request.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, project.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, request.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, project.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, request.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, project.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, request.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, project.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, request.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, project.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, request.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, project.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, request.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, project.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, request.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, project.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, request.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, project.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, request.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, project.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, request.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, project.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, request.UpdatedAtLTE(*i.UpdatedAtLTE))
-	}
-	if i.APIKeyID != nil {
-		predicates = append(predicates, request.APIKeyIDEQ(*i.APIKeyID))
+		predicates = append(predicates, project.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.APIKeyIDNEQ != nil {
-		predicates = append(predicates, request.APIKeyIDNEQ(*i.APIKeyIDNEQ))
+	if i.Name != nil {
+		predicates = append(predicates, project.NameEQ(*i.Name))
 	}
-	if len(i.APIKeyIDIn) > 0 {
-		predicates = append(predicates, request.APIKeyIDIn(i.APIKeyIDIn...))
+	if i.NameNEQ != nil {
+		predicates = append(predicates, project.NameNEQ(*i.NameNEQ))
 	}
-	if len(i.APIKeyIDNotIn) > 0 {
-		predicates = append(predicates, request.APIKeyIDNotIn(i.APIKeyIDNotIn...))
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, project.NameIn(i.NameIn...))
 	}
-	if i.APIKeyIDIsNil {
-		predicates = append(predicates, request.APIKeyIDIsNil())
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, project.NameNotIn(i.NameNotIn...))
 	}
-	if i.APIKeyIDNotNil {
-		predicates = append(predicates, request.APIKeyIDNotNil())
+	if i.NameGT != nil {
+		predicates = append(predicates, project.NameGT(*i.NameGT))
 	}
-	if i.ProjectID != nil {
-		predicates = append(predicates, request.ProjectIDEQ(*i.ProjectID))
+	if i.NameGTE != nil {
+		predicates = append(predicates, project.NameGTE(*i.NameGTE))
 	}
-	if i.ProjectIDNEQ != nil {
-		predicates = append(predicates, request.ProjectIDNEQ(*i.ProjectIDNEQ))
+	if i.NameLT != nil {
+		predicates = append(predicates, project.NameLT(*i.NameLT))
 	}
-	if len(i.ProjectIDIn) > 0 {
-		predicates = append(predicates, request.ProjectIDIn(i.ProjectIDIn...))
+	if i.NameLTE != nil {
+		predicates = append(predicates, project.NameLTE(*i.NameLTE))
 	}
-	if len(i.ProjectIDNotIn) > 0 {
-		predicates = append(predicates, request.ProjectIDNotIn(i.ProjectIDNotIn...))
+	if i.NameContains != nil {
+		predicates = append(predicates, project.NameContains(*i.NameContains))
 	}
-	if i.TraceID != nil {
-		predicates = append(predicates, request.TraceIDEQ(*i.TraceID))
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, project.NameHasPrefix(*i.NameHasPrefix))
 	}
-	if i.TraceIDNEQ != nil {
-		predicates = append(predicates, request.TraceIDNEQ(*i.TraceIDNEQ))
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, project.NameHasSuffix(*i.NameHasSuffix))
 	}
-	if len(i.TraceIDIn) > 0 {
-		predicates = append(predicates, request.TraceIDIn(i.TraceIDIn...))
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, project.NameEqualFold(*i.NameEqualFold))
 	}
-	if len(i.TraceIDNotIn) > 0 {
-		predicates = append(predicates, request.TraceIDNotIn(i.TraceIDNotIn...))
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, project.NameContainsFold(*i.NameContainsFold))
 	}
-	if i.TraceIDIsNil {
-		predicates = append(predicates, request.TraceIDIsNil())
+	if i.Description != nil {
+		predicates = append(predicates, project.DescriptionEQ(*i.Description))
 	}
-	if i.TraceIDNotNil {
-		predicates = append(predicates, request.TraceIDNotNil())
+	if i.DescriptionNEQ != nil {
+		predicates = append(predicates, project.DescriptionNEQ(*i.DescriptionNEQ))
 	}
-	if i.DataStorageID != nil {
-		predicates = append(predicates, request.DataStorageIDEQ(*i.DataStorageID))
+	if len(i.DescriptionIn) > 0 {
+		predicates = append(predicates, project.DescriptionIn(i.DescriptionIn...))
 	}
-	if i.DataStorageIDNEQ != nil {
-		predicates = append(predicates, request.DataStorageIDNEQ(*i.DataStorageIDNEQ))
+	if len(i.DescriptionNotIn) > 0 {
+		predicates = append(predicates, project.DescriptionNotIn(i.DescriptionNotIn...))
 	}
-	if len(i.DataStorageIDIn) > 0 {
-		predicates = append(predicates, request.DataStorageIDIn(i.DataStorageIDIn...))
+	if i.DescriptionGT != nil {
+		predicates = append(predicates, project.DescriptionGT(*i.DescriptionGT))
 	}
-	if len(i.DataStorageIDNotIn) > 0 {
-		predicates = append(predicates, request.DataStorageIDNotIn(i.DataStorageIDNotIn...))
+	if i.DescriptionGTE != nil {
+		predicates = append(predicates, project.DescriptionGTE(*i.DescriptionGTE))
 	}
-	if i.DataStorageIDIsNil {
-		predicates = append(predicates, request.DataStorageIDIsNil())
+	if i.DescriptionLT != nil {
+		predicates = append(predicates, project.DescriptionLT(*i.DescriptionLT))
 	}
-	if i.DataStorageIDNotNil {
-		predicates = append(predicates, request.DataStorageIDNotNil())
+	if i.DescriptionLTE != nil {
+		predicates = append(predicates, project.DescriptionLTE(*i.DescriptionLTE))
 	}
-	if i.Source != nil {
-		predicates = append(predicates, request.SourceEQ(*i.Source))
+	if i.DescriptionContains != nil {
+		predicates = append(predicates, project.DescriptionContains(*i.DescriptionContains))
 	}
-	if i.SourceNEQ != nil {
-		predicates = append(predicates, request.SourceNEQ(*i.SourceNEQ))
+	if i.DescriptionHasPrefix != nil {
+		predicates = append(predicates, project.DescriptionHasPrefix(*i.DescriptionHasPrefix))
 	}
-	if len(i.SourceIn) > 0 {
-		predicates = append(predicates, request.SourceIn(i.SourceIn...))
+	if i.DescriptionHasSuffix != nil {
+		predicates = append(predicates, project.DescriptionHasSuffix(*i.DescriptionHasSuffix))
 	}
-	if len(i.SourceNotIn) > 0 {
-		predicates = append(predicates, request.SourceNotIn(i.SourceNotIn...))
+	if i.DescriptionEqualFold != nil {
+		predicates = append(predicates, project.DescriptionEqualFold(*i.DescriptionEqualFold))
 	}
-	if i.ModelID != nil {
-		predicates = append(predicates, request.ModelIDEQ(*i.ModelID))
+	if i.DescriptionContainsFold != nil {
+		predicates = append(predicates, project.DescriptionContainsFold(*i.DescriptionContainsFold))
 	}
-	if i.ModelIDNEQ != nil {
-		predicates = append(predicates, request.ModelIDNEQ(*i.ModelIDNEQ))
+	if i.Status != nil {
+		predicates = append(predicates, project.StatusEQ(*i.Status))
 	}
-	if len(i.ModelIDIn) > 0 {
-		predicates = append(predicates, request.ModelIDIn(i.ModelIDIn...))
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, project.StatusNEQ(*i.StatusNEQ))
 	}
-	if len(i.ModelIDNotIn) > 0 {
-		predicates = append(predicates, request.ModelIDNotIn(i.ModelIDNotIn...))
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, project.StatusIn(i.StatusIn...))
 	}
-	if i.ModelIDGT != nil {
-		predicates = append(predicates, request.ModelIDGT(*i.ModelIDGT))
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, project.StatusNotIn(i.StatusNotIn...))
 	}
-	if i.ModelIDGTE != nil {
-		predicates = append(predicates, request.ModelIDGTE(*i.ModelIDGTE))
+
+	if i.HasUsers != nil {
+		p := project.HasUsers()
+		if !*i.HasUsers {
+			p = project.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.ModelIDLT != nil {
-		predicates = append(predicates, request.ModelIDLT(*i.ModelIDLT))
+	if len(i.HasUsersWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUsersWith))
+		for _, w := range i.HasUsersWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUsersWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, project.HasUsersWith(with...))
 	}
-	if i.ModelIDLTE != nil {
-		predicates = append(predicates, request.ModelIDLTE(*i.ModelIDLTE))
+	if i.HasRoles != nil {
+		p := project.HasRoles()
+		if !*i.HasRoles {
+			p = project.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.ModelIDContains != nil {
-		predicates = append(predicates, request.ModelIDContains(*i.ModelIDContains))
+	if len(i.HasRolesWith) > 0 {
+		with := make([]predicate.Role, 0, len(i.HasRolesWith))
+		for _, w := range i.HasRolesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRolesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, project.HasRolesWith(with...))
 	}
-	if i.ModelIDHasPrefix != nil {
-		predicates = append(predicates, request.ModelIDHasPrefix(*i.ModelIDHasPrefix))
+	if i.HasAPIKeys != nil {
+		p := project.HasAPIKeys()
+		if !*i.HasAPIKeys {
+			p = project.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.ModelIDHasSuffix != nil {
-		predicates = append(predicates, request.ModelIDHasSuffix(*i.ModelIDHasSuffix))
+	if len(i.HasAPIKeysWith) > 0 {
+		with := make([]predicate.APIKey, 0, len(i.HasAPIKeysWith))
+		for _, w := range i.HasAPIKeysWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAPIKeysWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, project.HasAPIKeysWith(with...))
 	}
-	if i.ModelIDEqualFold != nil {
-		predicates = append(predicates, request.ModelIDEqualFold(*i.ModelIDEqualFold))
+	if i.HasRequests != nil {
+		p := project.HasRequests()
+		if !*i.HasRequests {
+			p = project.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.ModelIDContainsFold != nil {
-		predicates = append(predicates, request.ModelIDContainsFold(*i.ModelIDContainsFold))
+	if len(i.HasRequestsWith) > 0 {
+		with := make([]predicate.Request, 0, len(i.HasRequestsWith))
+		for _, w := range i.HasRequestsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRequestsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, project.HasRequestsWith(with...))
 	}
-	if i.ReasoningEffort != nil {
-		predicates = append(predicates, request.ReasoningEffortEQ(*i.ReasoningEffort))
+	if i.HasUsageLogs != nil {
+		p := project.HasUsageLogs()
+		if !*i.HasUsageLogs {
+			p = project.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.ReasoningEffortNEQ != nil {
-		predicates = append(predicates, request.ReasoningEffortNEQ(*i.ReasoningEffortNEQ))
+	if len(i.HasUsageLogsWith) > 0 {
+		with := make([]predicate.UsageLog, 0, len(i.HasUsageLogsWith))
+		for _, w := range i.HasUsageLogsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUsageLogsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, project.HasUsageLogsWith(with...))
 	}
-	if len(i.ReasoningEffortIn) > 0 {
-		predicates = append(predicates, request.ReasoningEffortIn(i.ReasoningEffortIn...))
+	if i.HasThreads != nil {
+		p := project.HasThreads()
+		if !*i.HasThreads {
+			p = project.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if len(i.ReasoningEffortNotIn) > 0 {
-		predicates = append(predicates, request.ReasoningEffortNotIn(i.ReasoningEffortNotIn...))
+	if len(i.HasThreadsWith) > 0 {
+		with := make([]predicate.Thread, 0, len(i.HasThreadsWith))
+		for _, w := range i.HasThreadsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasThreadsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, project.HasThreadsWith(with...))
 	}
-	if i.ReasoningEffortGT != nil {
-		predicates = append(predicates, request.ReasoningEffortGT(*i.ReasoningEffortGT))
+	if i.HasTraces != nil {
+		p := project.HasTraces()
+		if !*i.HasTraces {
+			p = project.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.ReasoningEffortGTE != nil {
-		predicates = append(predicates, request.ReasoningEffortGTE(*i.ReasoningEffortGTE))
+	if len(i.HasTracesWith) > 0 {
+		with := make([]predicate.Trace, 0, len(i.HasTracesWith))
+		for _, w := range i.HasTracesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasTracesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, project.HasTracesWith(with...))
 	}
-	if i.ReasoningEffortLT != nil {
-		predicates = append(predicates, request.ReasoningEffortLT(*i.ReasoningEffortLT))
+	if i.HasPrompts != nil {
+		p := project.HasPrompts()
+		if !*i.HasPrompts {
+			p = project.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.ReasoningEffortLTE != nil {
-		predicates = append(predicates, request.ReasoningEffortLTE(*i.ReasoningEffortLTE))
+	if len(i.HasPromptsWith) > 0 {
+		with := make([]predicate.Prompt, 0, len(i.HasPromptsWith))
+		for _, w := range i.HasPromptsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasPromptsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, project.HasPromptsWith(with...))
 	}
-	if i.ReasoningEffortContains != nil {
-		predicates = append(predicates, request.ReasoningEffortContains(*i.ReasoningEffortContains))
+	if i.HasAPIKeyProfileTemplates != nil {
+		p := project.HasAPIKeyProfileTemplates()
+		if !*i.HasAPIKeyProfileTemplates {
+			p = project.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.ReasoningEffortHasPrefix != nil {
-		predicates = append(predicates, request.ReasoningEffortHasPrefix(*i.ReasoningEffortHasPrefix))
+	if len(i.HasAPIKeyProfileTemplatesWith) > 0 {
+		with := make([]predicate.APIKeyProfileTemplate, 0, len(i.HasAPIKeyProfileTemplatesWith))
+		for _, w := range i.HasAPIKeyProfileTemplatesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAPIKeyProfileTemplatesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, project.HasAPIKeyProfileTemplatesWith(with...))
 	}
-	if i.ReasoningEffortHasSuffix != nil {
-		predicates = append(predicates, request.ReasoningEffortHasSuffix(*i.ReasoningEffortHasSuffix))
+	if i.HasBudgets != nil {
+		p := project.HasBudgets()
+		if !*i.HasBudgets {
+			p = project.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.ReasoningEffortIsNil {
-		predicates = append(predicates, request.ReasoningEffortIsNil())
+	if len(i.HasBudgetsWith) > 0 {
+		with := make([]predicate.Budget, 0, len(i.HasBudgetsWith))
+		for _, w := range i.HasBudgetsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasBudgetsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, project.HasBudgetsWith(with...))
 	}
-	if i.ReasoningEffortNotNil {
-		predicates = append(predicates, request.ReasoningEffortNotNil())
+	if i.HasInvoices != nil {
+		p := project.HasInvoices()
+		if !*i.HasInvoices {
+			p = project.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.ReasoningEffortEqualFold != nil {
-		predicates = append(predicates, request.ReasoningEffortEqualFold(*i.ReasoningEffortEqualFold))
+	if len(i.HasInvoicesWith) > 0 {
+		with := make([]predicate.Invoice, 0, len(i.HasInvoicesWith))
+		for _, w := range i.HasInvoicesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasInvoicesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, project.HasInvoicesWith(with...))
 	}
-	if i.ReasoningEffortContainsFold != nil {
-		predicates = append(predicates, request.ReasoningEffortContainsFold(*i.ReasoningEffortContainsFold))
+	if i.HasDataExports != nil {
+		p := project.HasDataExports()
+		if !*i.HasDataExports {
+			p = project.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.Format != nil {
-		predicates = append(predicates, request.FormatEQ(*i.Format))
+	if len(i.HasDataExportsWith) > 0 {
+		with := make([]predicate.DataExport, 0, len(i.HasDataExportsWith))
+		for _, w := range i.HasDataExportsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasDataExportsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, project.HasDataExportsWith(with...))
 	}
-	if i.FormatNEQ != nil {
-		predicates = append(predicates, request.FormatNEQ(*i.FormatNEQ))
+	if i.HasProjectUsers != nil {
+		p := project.HasProjectUsers()
+		if !*i.HasProjectUsers {
+			p = project.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if len(i.FormatIn) > 0 {
-		predicates = append(predicates, request.FormatIn(i.FormatIn...))
+	if len(i.HasProjectUsersWith) > 0 {
+		with := make([]predicate.UserProject, 0, len(i.HasProjectUsersWith))
+		for _, w := range i.HasProjectUsersWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProjectUsersWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, project.HasProjectUsersWith(with...))
 	}
-	if len(i.FormatNotIn) > 0 {
-		predicates = append(predicates, request.FormatNotIn(i.FormatNotIn...))
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyProjectWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return project.And(predicates...), nil
 	}
-	if i.FormatGT != nil {
-		predicates = append(predicates, request.FormatGT(*i.FormatGT))
+}
+
+// PromptWhereInput represents a where input for filtering Prompt queries.
+type PromptWhereInput struct {
+	Predicates []predicate.Prompt  `json:"-"`
+	Not        *PromptWhereInput   `json:"not,omitempty"`
+	Or         []*PromptWhereInput `json:"or,omitempty"`
+	And        []*PromptWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "project_id" field predicates.
+	ProjectID      *int  `json:"projectID,omitempty"`
+	ProjectIDNEQ   *int  `json:"projectIDNEQ,omitempty"`
+	ProjectIDIn    []int `json:"projectIDIn,omitempty"`
+	ProjectIDNotIn []int `json:"projectIDNotIn,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "description" field predicates.
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+
+	// "role" field predicates.
+	Role             *string  `json:"role,omitempty"`
+	RoleNEQ          *string  `json:"roleNEQ,omitempty"`
+	RoleIn           []string `json:"roleIn,omitempty"`
+	RoleNotIn        []string `json:"roleNotIn,omitempty"`
+	RoleGT           *string  `json:"roleGT,omitempty"`
+	RoleGTE          *string  `json:"roleGTE,omitempty"`
+	RoleLT           *string  `json:"roleLT,omitempty"`
+	RoleLTE          *string  `json:"roleLTE,omitempty"`
+	RoleContains     *string  `json:"roleContains,omitempty"`
+	RoleHasPrefix    *string  `json:"roleHasPrefix,omitempty"`
+	RoleHasSuffix    *string  `json:"roleHasSuffix,omitempty"`
+	RoleEqualFold    *string  `json:"roleEqualFold,omitempty"`
+	RoleContainsFold *string  `json:"roleContainsFold,omitempty"`
+
+	// "content" field predicates.
+	Content             *string  `json:"content,omitempty"`
+	ContentNEQ          *string  `json:"contentNEQ,omitempty"`
+	ContentIn           []string `json:"contentIn,omitempty"`
+	ContentNotIn        []string `json:"contentNotIn,omitempty"`
+	ContentGT           *string  `json:"contentGT,omitempty"`
+	ContentGTE          *string  `json:"contentGTE,omitempty"`
+	ContentLT           *string  `json:"contentLT,omitempty"`
+	ContentLTE          *string  `json:"contentLTE,omitempty"`
+	ContentContains     *string  `json:"contentContains,omitempty"`
+	ContentHasPrefix    *string  `json:"contentHasPrefix,omitempty"`
+	ContentHasSuffix    *string  `json:"contentHasSuffix,omitempty"`
+	ContentEqualFold    *string  `json:"contentEqualFold,omitempty"`
+	ContentContainsFold *string  `json:"contentContainsFold,omitempty"`
+
+	// "status" field predicates.
+	Status      *prompt.Status  `json:"status,omitempty"`
+	StatusNEQ   *prompt.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []prompt.Status `json:"statusIn,omitempty"`
+	StatusNotIn []prompt.Status `json:"statusNotIn,omitempty"`
+
+	// "order" field predicates.
+	Order      *int  `json:"order,omitempty"`
+	OrderNEQ   *int  `json:"orderNEQ,omitempty"`
+	OrderIn    []int `json:"orderIn,omitempty"`
+	OrderNotIn []int `json:"orderNotIn,omitempty"`
+	OrderGT    *int  `json:"orderGT,omitempty"`
+	OrderGTE   *int  `json:"orderGTE,omitempty"`
+	OrderLT    *int  `json:"orderLT,omitempty"`
+	OrderLTE   *int  `json:"orderLTE,omitempty"`
+
+	// "project" edge predicates.
+	HasProject     *bool                `json:"hasProject,omitempty"`
+	HasProjectWith []*ProjectWhereInput `json:"hasProjectWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *PromptWhereInput) AddPredicates(predicates ...predicate.Prompt) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the PromptWhereInput filter on the PromptQuery builder.
+func (i *PromptWhereInput) Filter(q *PromptQuery) (*PromptQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyPromptWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyPromptWhereInput is returned in case the PromptWhereInput is empty.
+var ErrEmptyPromptWhereInput = errors.New("ent: empty predicate PromptWhereInput")
+
+// P returns a predicate for filtering prompts.
+// An error is returned if the input is empty or invalid.
+func (i *PromptWhereInput) P() (predicate.Prompt, error) {
+	var predicates []predicate.Prompt
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, prompt.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Prompt, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, prompt.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Prompt, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, prompt.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, prompt.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, prompt.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, prompt.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, prompt.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, prompt.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, prompt.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, prompt.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, prompt.IDLTE(*i.IDLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, prompt.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, prompt.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, prompt.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, prompt.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, prompt.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, prompt.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, prompt.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, prompt.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, prompt.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, prompt.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, prompt.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, prompt.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, prompt.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, prompt.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, prompt.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, prompt.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.ProjectID != nil {
+		predicates = append(predicates, prompt.ProjectIDEQ(*i.ProjectID))
+	}
+	if i.ProjectIDNEQ != nil {
+		predicates = append(predicates, prompt.ProjectIDNEQ(*i.ProjectIDNEQ))
+	}
+	if len(i.ProjectIDIn) > 0 {
+		predicates = append(predicates, prompt.ProjectIDIn(i.ProjectIDIn...))
+	}
+	if len(i.ProjectIDNotIn) > 0 {
+		predicates = append(predicates, prompt.ProjectIDNotIn(i.ProjectIDNotIn...))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, prompt.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, prompt.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, prompt.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, prompt.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, prompt.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, prompt.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, prompt.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, prompt.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, prompt.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, prompt.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, prompt.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, prompt.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, prompt.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.Description != nil {
+		predicates = append(predicates, prompt.DescriptionEQ(*i.Description))
+	}
+	if i.DescriptionNEQ != nil {
+		predicates = append(predicates, prompt.DescriptionNEQ(*i.DescriptionNEQ))
+	}
+	if len(i.DescriptionIn) > 0 {
+		predicates = append(predicates, prompt.DescriptionIn(i.DescriptionIn...))
+	}
+	if len(i.DescriptionNotIn) > 0 {
+		predicates = append(predicates, prompt.DescriptionNotIn(i.DescriptionNotIn...))
+	}
+	if i.DescriptionGT != nil {
+		predicates = append(predicates, prompt.DescriptionGT(*i.DescriptionGT))
+	}
+	if i.DescriptionGTE != nil {
+		predicates = append(predicates, prompt.DescriptionGTE(*i.DescriptionGTE))
+	}
+	if i.DescriptionLT != nil {
+		predicates = append(predicates, prompt.DescriptionLT(*i.DescriptionLT))
+	}
+	if i.DescriptionLTE != nil {
+		predicates = append(predicates, prompt.DescriptionLTE(*i.DescriptionLTE))
+	}
+	if i.DescriptionContains != nil {
+		predicates = append(predicates, prompt.DescriptionContains(*i.DescriptionContains))
+	}
+	if i.DescriptionHasPrefix != nil {
+		predicates = append(predicates, prompt.DescriptionHasPrefix(*i.DescriptionHasPrefix))
+	}
+	if i.DescriptionHasSuffix != nil {
+		predicates = append(predicates, prompt.DescriptionHasSuffix(*i.DescriptionHasSuffix))
+	}
+	if i.DescriptionEqualFold != nil {
+		predicates = append(predicates, prompt.DescriptionEqualFold(*i.DescriptionEqualFold))
+	}
+	if i.DescriptionContainsFold != nil {
+		predicates = append(predicates, prompt.DescriptionContainsFold(*i.DescriptionContainsFold))
+	}
+	if i.Role != nil {
+		predicates = append(predicates, prompt.RoleEQ(*i.Role))
+	}
+	if i.RoleNEQ != nil {
+		predicates = append(predicates, prompt.RoleNEQ(*i.RoleNEQ))
+	}
+	if len(i.RoleIn) > 0 {
+		predicates = append(predicates, prompt.RoleIn(i.RoleIn...))
+	}
+	if len(i.RoleNotIn) > 0 {
+		predicates = append(predicates, prompt.RoleNotIn(i.RoleNotIn...))
+	}
+	if i.RoleGT != nil {
+		predicates = append(predicates, prompt.RoleGT(*i.RoleGT))
+	}
+	if i.RoleGTE != nil {
+		predicates = append(predicates, prompt.RoleGTE(*i.RoleGTE))
+	}
+	if i.RoleLT != nil {
+		predicates = append(predicates, prompt.RoleLT(*i.RoleLT))
+	}
+	if i.RoleLTE != nil {
+		predicates = append(predicates, prompt.RoleLTE(*i.RoleLTE))
+	}
+	if i.RoleContains != nil {
+		predicates = append(predicates, prompt.RoleContains(*i.RoleContains))
+	}
+	if i.RoleHasPrefix != nil {
+		predicates = append(predicates, prompt.RoleHasPrefix(*i.RoleHasPrefix))
+	}
+	if i.RoleHasSuffix != nil {
+		predicates = append(predicates, prompt.RoleHasSuffix(*i.RoleHasSuffix))
+	}
+	if i.RoleEqualFold != nil {
+		predicates = append(predicates, prompt.RoleEqualFold(*i.RoleEqualFold))
+	}
+	if i.RoleContainsFold != nil {
+		predicates = append(predicates, prompt.RoleContainsFold(*i.RoleContainsFold))
+	}
+	if i.Content != nil {
+		predicates = append(predicates, prompt.ContentEQ(*i.Content))
+	}
+	if i.ContentNEQ != nil {
+		predicates = append(predicates, prompt.ContentNEQ(*i.ContentNEQ))
+	}
+	if len(i.ContentIn) > 0 {
+		predicates = append(predicates, prompt.ContentIn(i.ContentIn...))
+	}
+	if len(i.ContentNotIn) > 0 {
+		predicates = append(predicates, prompt.ContentNotIn(i.ContentNotIn...))
+	}
+	if i.ContentGT != nil {
+		predicates = append(predicates, prompt.ContentGT(*i.ContentGT))
+	}
+	if i.ContentGTE != nil {
+		predicates = append(predicates, prompt.ContentGTE(*i.ContentGTE))
+	}
+	if i.ContentLT != nil {
+		predicates = append(predicates, prompt.ContentLT(*i.ContentLT))
+	}
+	if i.ContentLTE != nil {
+		predicates = append(predicates, prompt.ContentLTE(*i.ContentLTE))
+	}
+	if i.ContentContains != nil {
+		predicates = append(predicates, prompt.ContentContains(*i.ContentContains))
+	}
+	if i.ContentHasPrefix != nil {
+		predicates = append(predicates, prompt.ContentHasPrefix(*i.ContentHasPrefix))
+	}
+	if i.ContentHasSuffix != nil {
+		predicates = append(predicates, prompt.ContentHasSuffix(*i.ContentHasSuffix))
+	}
+	if i.ContentEqualFold != nil {
+		predicates = append(predicates, prompt.ContentEqualFold(*i.ContentEqualFold))
+	}
+	if i.ContentContainsFold != nil {
+		predicates = append(predicates, prompt.ContentContainsFold(*i.ContentContainsFold))
+	}
+	if i.Status != nil {
+		predicates = append(predicates, prompt.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, prompt.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, prompt.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, prompt.StatusNotIn(i.StatusNotIn...))
+	}
+	if i.Order != nil {
+		predicates = append(predicates, prompt.OrderEQ(*i.Order))
+	}
+	if i.OrderNEQ != nil {
+		predicates = append(predicates, prompt.OrderNEQ(*i.OrderNEQ))
+	}
+	if len(i.OrderIn) > 0 {
+		predicates = append(predicates, prompt.OrderIn(i.OrderIn...))
+	}
+	if len(i.OrderNotIn) > 0 {
+		predicates = append(predicates, prompt.OrderNotIn(i.OrderNotIn...))
+	}
+	if i.OrderGT != nil {
+		predicates = append(predicates, prompt.OrderGT(*i.OrderGT))
+	}
+	if i.OrderGTE != nil {
+		predicates = append(predicates, prompt.OrderGTE(*i.OrderGTE))
+	}
+	if i.OrderLT != nil {
+		predicates = append(predicates, prompt.OrderLT(*i.OrderLT))
+	}
+	if i.OrderLTE != nil {
+		predicates = append(predicates, prompt.OrderLTE(*i.OrderLTE))
+	}
+
+	if i.HasProject != nil {
+		p := prompt.HasProject()
+		if !*i.HasProject {
+			p = prompt.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasProjectWith) > 0 {
+		with := make([]predicate.Project, 0, len(i.HasProjectWith))
+		for _, w := range i.HasProjectWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProjectWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, prompt.HasProjectWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyPromptWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return prompt.And(predicates...), nil
+	}
+}
+
+// PromptProtectionRuleWhereInput represents a where input for filtering PromptProtectionRule queries.
+type PromptProtectionRuleWhereInput struct {
+	Predicates []predicate.PromptProtectionRule  `json:"-"`
+	Not        *PromptProtectionRuleWhereInput   `json:"not,omitempty"`
+	Or         []*PromptProtectionRuleWhereInput `json:"or,omitempty"`
+	And        []*PromptProtectionRuleWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "description" field predicates.
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+
+	// "pattern" field predicates.
+	Pattern             *string  `json:"pattern,omitempty"`
+	PatternNEQ          *string  `json:"patternNEQ,omitempty"`
+	PatternIn           []string `json:"patternIn,omitempty"`
+	PatternNotIn        []string `json:"patternNotIn,omitempty"`
+	PatternGT           *string  `json:"patternGT,omitempty"`
+	PatternGTE          *string  `json:"patternGTE,omitempty"`
+	PatternLT           *string  `json:"patternLT,omitempty"`
+	PatternLTE          *string  `json:"patternLTE,omitempty"`
+	PatternContains     *string  `json:"patternContains,omitempty"`
+	PatternHasPrefix    *string  `json:"patternHasPrefix,omitempty"`
+	PatternHasSuffix    *string  `json:"patternHasSuffix,omitempty"`
+	PatternEqualFold    *string  `json:"patternEqualFold,omitempty"`
+	PatternContainsFold *string  `json:"patternContainsFold,omitempty"`
+
+	// "status" field predicates.
+	Status      *promptprotectionrule.Status  `json:"status,omitempty"`
+	StatusNEQ   *promptprotectionrule.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []promptprotectionrule.Status `json:"statusIn,omitempty"`
+	StatusNotIn []promptprotectionrule.Status `json:"statusNotIn,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *PromptProtectionRuleWhereInput) AddPredicates(predicates ...predicate.PromptProtectionRule) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the PromptProtectionRuleWhereInput filter on the PromptProtectionRuleQuery builder.
+func (i *PromptProtectionRuleWhereInput) Filter(q *PromptProtectionRuleQuery) (*PromptProtectionRuleQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyPromptProtectionRuleWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyPromptProtectionRuleWhereInput is returned in case the PromptProtectionRuleWhereInput is empty.
+var ErrEmptyPromptProtectionRuleWhereInput = errors.New("ent: empty predicate PromptProtectionRuleWhereInput")
+
+// P returns a predicate for filtering promptprotectionrules.
+// An error is returned if the input is empty or invalid.
+func (i *PromptProtectionRuleWhereInput) P() (predicate.PromptProtectionRule, error) {
+	var predicates []predicate.PromptProtectionRule
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, promptprotectionrule.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.PromptProtectionRule, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, promptprotectionrule.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.PromptProtectionRule, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, promptprotectionrule.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, promptprotectionrule.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, promptprotectionrule.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, promptprotectionrule.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, promptprotectionrule.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, promptprotectionrule.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, promptprotectionrule.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, promptprotectionrule.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, promptprotectionrule.IDLTE(*i.IDLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, promptprotectionrule.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, promptprotectionrule.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, promptprotectionrule.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, promptprotectionrule.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, promptprotectionrule.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, promptprotectionrule.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, promptprotectionrule.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, promptprotectionrule.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, promptprotectionrule.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, promptprotectionrule.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, promptprotectionrule.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, promptprotectionrule.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, promptprotectionrule.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, promptprotectionrule.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, promptprotectionrule.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, promptprotectionrule.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, promptprotectionrule.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, promptprotectionrule.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, promptprotectionrule.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, promptprotectionrule.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, promptprotectionrule.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, promptprotectionrule.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, promptprotectionrule.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, promptprotectionrule.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, promptprotectionrule.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, promptprotectionrule.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, promptprotectionrule.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, promptprotectionrule.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, promptprotectionrule.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.Description != nil {
+		predicates = append(predicates, promptprotectionrule.DescriptionEQ(*i.Description))
+	}
+	if i.DescriptionNEQ != nil {
+		predicates = append(predicates, promptprotectionrule.DescriptionNEQ(*i.DescriptionNEQ))
+	}
+	if len(i.DescriptionIn) > 0 {
+		predicates = append(predicates, promptprotectionrule.DescriptionIn(i.DescriptionIn...))
+	}
+	if len(i.DescriptionNotIn) > 0 {
+		predicates = append(predicates, promptprotectionrule.DescriptionNotIn(i.DescriptionNotIn...))
+	}
+	if i.DescriptionGT != nil {
+		predicates = append(predicates, promptprotectionrule.DescriptionGT(*i.DescriptionGT))
+	}
+	if i.DescriptionGTE != nil {
+		predicates = append(predicates, promptprotectionrule.DescriptionGTE(*i.DescriptionGTE))
+	}
+	if i.DescriptionLT != nil {
+		predicates = append(predicates, promptprotectionrule.DescriptionLT(*i.DescriptionLT))
+	}
+	if i.DescriptionLTE != nil {
+		predicates = append(predicates, promptprotectionrule.DescriptionLTE(*i.DescriptionLTE))
+	}
+	if i.DescriptionContains != nil {
+		predicates = append(predicates, promptprotectionrule.DescriptionContains(*i.DescriptionContains))
+	}
+	if i.DescriptionHasPrefix != nil {
+		predicates = append(predicates, promptprotectionrule.DescriptionHasPrefix(*i.DescriptionHasPrefix))
+	}
+	if i.DescriptionHasSuffix != nil {
+		predicates = append(predicates, promptprotectionrule.DescriptionHasSuffix(*i.DescriptionHasSuffix))
+	}
+	if i.DescriptionEqualFold != nil {
+		predicates = append(predicates, promptprotectionrule.DescriptionEqualFold(*i.DescriptionEqualFold))
+	}
+	if i.DescriptionContainsFold != nil {
+		predicates = append(predicates, promptprotectionrule.DescriptionContainsFold(*i.DescriptionContainsFold))
+	}
+	if i.Pattern != nil {
+		predicates = append(predicates, promptprotectionrule.PatternEQ(*i.Pattern))
+	}
+	if i.PatternNEQ != nil {
+		predicates = append(predicates, promptprotectionrule.PatternNEQ(*i.PatternNEQ))
+	}
+	if len(i.PatternIn) > 0 {
+		predicates = append(predicates, promptprotectionrule.PatternIn(i.PatternIn...))
+	}
+	if len(i.PatternNotIn) > 0 {
+		predicates = append(predicates, promptprotectionrule.PatternNotIn(i.PatternNotIn...))
+	}
+	if i.PatternGT != nil {
+		predicates = append(predicates, promptprotectionrule.PatternGT(*i.PatternGT))
+	}
+	if i.PatternGTE != nil {
+		predicates = append(predicates, promptprotectionrule.PatternGTE(*i.PatternGTE))
+	}
+	if i.PatternLT != nil {
+		predicates = append(predicates, promptprotectionrule.PatternLT(*i.PatternLT))
+	}
+	if i.PatternLTE != nil {
+		predicates = append(predicates, promptprotectionrule.PatternLTE(*i.PatternLTE))
+	}
+	if i.PatternContains != nil {
+		predicates = append(predicates, promptprotectionrule.PatternContains(*i.PatternContains))
+	}
+	if i.PatternHasPrefix != nil {
+		predicates = append(predicates, promptprotectionrule.PatternHasPrefix(*i.PatternHasPrefix))
+	}
+	if i.PatternHasSuffix != nil {
+		predicates = append(predicates, promptprotectionrule.PatternHasSuffix(*i.PatternHasSuffix))
+	}
+	if i.PatternEqualFold != nil {
+		predicates = append(predicates, promptprotectionrule.PatternEqualFold(*i.PatternEqualFold))
+	}
+	if i.PatternContainsFold != nil {
+		predicates = append(predicates, promptprotectionrule.PatternContainsFold(*i.PatternContainsFold))
+	}
+	if i.Status != nil {
+		predicates = append(predicates, promptprotectionrule.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, promptprotectionrule.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, promptprotectionrule.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, promptprotectionrule.StatusNotIn(i.StatusNotIn...))
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyPromptProtectionRuleWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return promptprotectionrule.And(predicates...), nil
+	}
+}
+
+// ProviderQuotaStatusWhereInput represents a where input for filtering ProviderQuotaStatus queries.
+type ProviderQuotaStatusWhereInput struct {
+	Predicates []predicate.ProviderQuotaStatus  `json:"-"`
+	Not        *ProviderQuotaStatusWhereInput   `json:"not,omitempty"`
+	Or         []*ProviderQuotaStatusWhereInput `json:"or,omitempty"`
+	And        []*ProviderQuotaStatusWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "channel_id" field predicates.
+	ChannelID      *int  `json:"channelID,omitempty"`
+	ChannelIDNEQ   *int  `json:"channelIDNEQ,omitempty"`
+	ChannelIDIn    []int `json:"channelIDIn,omitempty"`
+	ChannelIDNotIn []int `json:"channelIDNotIn,omitempty"`
+
+	// "provider_type" field predicates.
+	ProviderType      *providerquotastatus.ProviderType  `json:"providerType,omitempty"`
+	ProviderTypeNEQ   *providerquotastatus.ProviderType  `json:"providerTypeNEQ,omitempty"`
+	ProviderTypeIn    []providerquotastatus.ProviderType `json:"providerTypeIn,omitempty"`
+	ProviderTypeNotIn []providerquotastatus.ProviderType `json:"providerTypeNotIn,omitempty"`
+
+	// "status" field predicates.
+	Status      *providerquotastatus.Status  `json:"status,omitempty"`
+	StatusNEQ   *providerquotastatus.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []providerquotastatus.Status `json:"statusIn,omitempty"`
+	StatusNotIn []providerquotastatus.Status `json:"statusNotIn,omitempty"`
+
+	// "next_reset_at" field predicates.
+	NextResetAt       *time.Time  `json:"nextResetAt,omitempty"`
+	NextResetAtNEQ    *time.Time  `json:"nextResetAtNEQ,omitempty"`
+	NextResetAtIn     []time.Time `json:"nextResetAtIn,omitempty"`
+	NextResetAtNotIn  []time.Time `json:"nextResetAtNotIn,omitempty"`
+	NextResetAtGT     *time.Time  `json:"nextResetAtGT,omitempty"`
+	NextResetAtGTE    *time.Time  `json:"nextResetAtGTE,omitempty"`
+	NextResetAtLT     *time.Time  `json:"nextResetAtLT,omitempty"`
+	NextResetAtLTE    *time.Time  `json:"nextResetAtLTE,omitempty"`
+	NextResetAtIsNil  bool        `json:"nextResetAtIsNil,omitempty"`
+	NextResetAtNotNil bool        `json:"nextResetAtNotNil,omitempty"`
+
+	// "ready" field predicates.
+	Ready    *bool `json:"ready,omitempty"`
+	ReadyNEQ *bool `json:"readyNEQ,omitempty"`
+
+	// "next_check_at" field predicates.
+	NextCheckAt      *time.Time  `json:"nextCheckAt,omitempty"`
+	NextCheckAtNEQ   *time.Time  `json:"nextCheckAtNEQ,omitempty"`
+	NextCheckAtIn    []time.Time `json:"nextCheckAtIn,omitempty"`
+	NextCheckAtNotIn []time.Time `json:"nextCheckAtNotIn,omitempty"`
+	NextCheckAtGT    *time.Time  `json:"nextCheckAtGT,omitempty"`
+	NextCheckAtGTE   *time.Time  `json:"nextCheckAtGTE,omitempty"`
+	NextCheckAtLT    *time.Time  `json:"nextCheckAtLT,omitempty"`
+	NextCheckAtLTE   *time.Time  `json:"nextCheckAtLTE,omitempty"`
+
+	// "channel" edge predicates.
+	HasChannel     *bool                `json:"hasChannel,omitempty"`
+	HasChannelWith []*ChannelWhereInput `json:"hasChannelWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *ProviderQuotaStatusWhereInput) AddPredicates(predicates ...predicate.ProviderQuotaStatus) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the ProviderQuotaStatusWhereInput filter on the ProviderQuotaStatusQuery builder.
+func (i *ProviderQuotaStatusWhereInput) Filter(q *ProviderQuotaStatusQuery) (*ProviderQuotaStatusQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyProviderQuotaStatusWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyProviderQuotaStatusWhereInput is returned in case the ProviderQuotaStatusWhereInput is empty.
+var ErrEmptyProviderQuotaStatusWhereInput = errors.New("ent: empty predicate ProviderQuotaStatusWhereInput")
+
+// P returns a predicate for filtering providerquotastatusslice.
+// An error is returned if the input is empty or invalid.
+func (i *ProviderQuotaStatusWhereInput) P() (predicate.ProviderQuotaStatus, error) {
+	var predicates []predicate.ProviderQuotaStatus
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, providerquotastatus.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.ProviderQuotaStatus, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, providerquotastatus.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.ProviderQuotaStatus, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, providerquotastatus.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, providerquotastatus.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, providerquotastatus.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, providerquotastatus.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, providerquotastatus.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, providerquotastatus.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, providerquotastatus.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, providerquotastatus.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, providerquotastatus.IDLTE(*i.IDLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, providerquotastatus.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, providerquotastatus.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, providerquotastatus.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, providerquotastatus.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, providerquotastatus.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, providerquotastatus.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, providerquotastatus.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, providerquotastatus.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, providerquotastatus.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, providerquotastatus.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, providerquotastatus.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, providerquotastatus.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, providerquotastatus.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, providerquotastatus.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, providerquotastatus.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, providerquotastatus.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.ChannelID != nil {
+		predicates = append(predicates, providerquotastatus.ChannelIDEQ(*i.ChannelID))
+	}
+	if i.ChannelIDNEQ != nil {
+		predicates = append(predicates, providerquotastatus.ChannelIDNEQ(*i.ChannelIDNEQ))
+	}
+	if len(i.ChannelIDIn) > 0 {
+		predicates = append(predicates, providerquotastatus.ChannelIDIn(i.ChannelIDIn...))
+	}
+	if len(i.ChannelIDNotIn) > 0 {
+		predicates = append(predicates, providerquotastatus.ChannelIDNotIn(i.ChannelIDNotIn...))
+	}
+	if i.ProviderType != nil {
+		predicates = append(predicates, providerquotastatus.ProviderTypeEQ(*i.ProviderType))
+	}
+	if i.ProviderTypeNEQ != nil {
+		predicates = append(predicates, providerquotastatus.ProviderTypeNEQ(*i.ProviderTypeNEQ))
+	}
+	if len(i.ProviderTypeIn) > 0 {
+		predicates = append(predicates, providerquotastatus.ProviderTypeIn(i.ProviderTypeIn...))
+	}
+	if len(i.ProviderTypeNotIn) > 0 {
+		predicates = append(predicates, providerquotastatus.ProviderTypeNotIn(i.ProviderTypeNotIn...))
+	}
+	if i.Status != nil {
+		predicates = append(predicates, providerquotastatus.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, providerquotastatus.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, providerquotastatus.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, providerquotastatus.StatusNotIn(i.StatusNotIn...))
+	}
+	if i.NextResetAt != nil {
+		predicates = append(predicates, providerquotastatus.NextResetAtEQ(*i.NextResetAt))
+	}
+	if i.NextResetAtNEQ != nil {
+		predicates = append(predicates, providerquotastatus.NextResetAtNEQ(*i.NextResetAtNEQ))
+	}
+	if len(i.NextResetAtIn) > 0 {
+		predicates = append(predicates, providerquotastatus.NextResetAtIn(i.NextResetAtIn...))
+	}
+	if len(i.NextResetAtNotIn) > 0 {
+		predicates = append(predicates, providerquotastatus.NextResetAtNotIn(i.NextResetAtNotIn...))
+	}
+	if i.NextResetAtGT != nil {
+		predicates = append(predicates, providerquotastatus.NextResetAtGT(*i.NextResetAtGT))
+	}
+	if i.NextResetAtGTE != nil {
+		predicates = append(predicates, providerquotastatus.NextResetAtGTE(*i.NextResetAtGTE))
+	}
+	if i.NextResetAtLT != nil {
+		predicates = append(predicates, providerquotastatus.NextResetAtLT(*i.NextResetAtLT))
+	}
+	if i.NextResetAtLTE != nil {
+		predicates = append(predicates, providerquotastatus.NextResetAtLTE(*i.NextResetAtLTE))
+	}
+	if i.NextResetAtIsNil {
+		predicates = append(predicates, providerquotastatus.NextResetAtIsNil())
+	}
+	if i.NextResetAtNotNil {
+		predicates = append(predicates, providerquotastatus.NextResetAtNotNil())
+	}
+	if i.Ready != nil {
+		predicates = append(predicates, providerquotastatus.ReadyEQ(*i.Ready))
+	}
+	if i.ReadyNEQ != nil {
+		predicates = append(predicates, providerquotastatus.ReadyNEQ(*i.ReadyNEQ))
+	}
+	if i.NextCheckAt != nil {
+		predicates = append(predicates, providerquotastatus.NextCheckAtEQ(*i.NextCheckAt))
+	}
+	if i.NextCheckAtNEQ != nil {
+		predicates = append(predicates, providerquotastatus.NextCheckAtNEQ(*i.NextCheckAtNEQ))
+	}
+	if len(i.NextCheckAtIn) > 0 {
+		predicates = append(predicates, providerquotastatus.NextCheckAtIn(i.NextCheckAtIn...))
+	}
+	if len(i.NextCheckAtNotIn) > 0 {
+		predicates = append(predicates, providerquotastatus.NextCheckAtNotIn(i.NextCheckAtNotIn...))
+	}
+	if i.NextCheckAtGT != nil {
+		predicates = append(predicates, providerquotastatus.NextCheckAtGT(*i.NextCheckAtGT))
+	}
+	if i.NextCheckAtGTE != nil {
+		predicates = append(predicates, providerquotastatus.NextCheckAtGTE(*i.NextCheckAtGTE))
+	}
+	if i.NextCheckAtLT != nil {
+		predicates = append(predicates, providerquotastatus.NextCheckAtLT(*i.NextCheckAtLT))
+	}
+	if i.NextCheckAtLTE != nil {
+		predicates = append(predicates, providerquotastatus.NextCheckAtLTE(*i.NextCheckAtLTE))
+	}
+
+	if i.HasChannel != nil {
+		p := providerquotastatus.HasChannel()
+		if !*i.HasChannel {
+			p = providerquotastatus.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasChannelWith) > 0 {
+		with := make([]predicate.Channel, 0, len(i.HasChannelWith))
+		for _, w := range i.HasChannelWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasChannelWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, providerquotastatus.HasChannelWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyProviderQuotaStatusWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return providerquotastatus.And(predicates...), nil
+	}
+}
+
+// RequestWhereInput represents a where input for filtering Request queries.
+type RequestWhereInput struct {
+	Predicates []predicate.Request  `json:"-"`
+	Not        *RequestWhereInput   `json:"not,omitempty"`
+	Or         []*RequestWhereInput `json:"or,omitempty"`
+	And        []*RequestWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "api_key_id" field predicates.
+	APIKeyID       *int  `json:"apiKeyID,omitempty"`
+	APIKeyIDNEQ    *int  `json:"apiKeyIDNEQ,omitempty"`
+	APIKeyIDIn     []int `json:"apiKeyIDIn,omitempty"`
+	APIKeyIDNotIn  []int `json:"apiKeyIDNotIn,omitempty"`
+	APIKeyIDIsNil  bool  `json:"apiKeyIDIsNil,omitempty"`
+	APIKeyIDNotNil bool  `json:"apiKeyIDNotNil,omitempty"`
+
+	// "project_id" field predicates.
+	ProjectID      *int  `json:"projectID,omitempty"`
+	ProjectIDNEQ   *int  `json:"projectIDNEQ,omitempty"`
+	ProjectIDIn    []int `json:"projectIDIn,omitempty"`
+	ProjectIDNotIn []int `json:"projectIDNotIn,omitempty"`
+
+	// "trace_id" field predicates.
+	TraceID       *int  `json:"traceID,omitempty"`
+	TraceIDNEQ    *int  `json:"traceIDNEQ,omitempty"`
+	TraceIDIn     []int `json:"traceIDIn,omitempty"`
+	TraceIDNotIn  []int `json:"traceIDNotIn,omitempty"`
+	TraceIDIsNil  bool  `json:"traceIDIsNil,omitempty"`
+	TraceIDNotNil bool  `json:"traceIDNotNil,omitempty"`
+
+	// "data_storage_id" field predicates.
+	DataStorageID       *int  `json:"dataStorageID,omitempty"`
+	DataStorageIDNEQ    *int  `json:"dataStorageIDNEQ,omitempty"`
+	DataStorageIDIn     []int `json:"dataStorageIDIn,omitempty"`
+	DataStorageIDNotIn  []int `json:"dataStorageIDNotIn,omitempty"`
+	DataStorageIDIsNil  bool  `json:"dataStorageIDIsNil,omitempty"`
+	DataStorageIDNotNil bool  `json:"dataStorageIDNotNil,omitempty"`
+
+	// "source" field predicates.
+	Source      *request.Source  `json:"source,omitempty"`
+	SourceNEQ   *request.Source  `json:"sourceNEQ,omitempty"`
+	SourceIn    []request.Source `json:"sourceIn,omitempty"`
+	SourceNotIn []request.Source `json:"sourceNotIn,omitempty"`
+
+	// "model_id" field predicates.
+	ModelID             *string  `json:"modelID,omitempty"`
+	ModelIDNEQ          *string  `json:"modelIDNEQ,omitempty"`
+	ModelIDIn           []string `json:"modelIDIn,omitempty"`
+	ModelIDNotIn        []string `json:"modelIDNotIn,omitempty"`
+	ModelIDGT           *string  `json:"modelIDGT,omitempty"`
+	ModelIDGTE          *string  `json:"modelIDGTE,omitempty"`
+	ModelIDLT           *string  `json:"modelIDLT,omitempty"`
+	ModelIDLTE          *string  `json:"modelIDLTE,omitempty"`
+	ModelIDContains     *string  `json:"modelIDContains,omitempty"`
+	ModelIDHasPrefix    *string  `json:"modelIDHasPrefix,omitempty"`
+	ModelIDHasSuffix    *string  `json:"modelIDHasSuffix,omitempty"`
+	ModelIDEqualFold    *string  `json:"modelIDEqualFold,omitempty"`
+	ModelIDContainsFold *string  `json:"modelIDContainsFold,omitempty"`
+
+	// "reasoning_effort" field predicates.
+	ReasoningEffort             *string  `json:"reasoningEffort,omitempty"`
+	ReasoningEffortNEQ          *string  `json:"reasoningEffortNEQ,omitempty"`
+	ReasoningEffortIn           []string `json:"reasoningEffortIn,omitempty"`
+	ReasoningEffortNotIn        []string `json:"reasoningEffortNotIn,omitempty"`
+	ReasoningEffortGT           *string  `json:"reasoningEffortGT,omitempty"`
+	ReasoningEffortGTE          *string  `json:"reasoningEffortGTE,omitempty"`
+	ReasoningEffortLT           *string  `json:"reasoningEffortLT,omitempty"`
+	ReasoningEffortLTE          *string  `json:"reasoningEffortLTE,omitempty"`
+	ReasoningEffortContains     *string  `json:"reasoningEffortContains,omitempty"`
+	ReasoningEffortHasPrefix    *string  `json:"reasoningEffortHasPrefix,omitempty"`
+	ReasoningEffortHasSuffix    *string  `json:"reasoningEffortHasSuffix,omitempty"`
+	ReasoningEffortIsNil        bool     `json:"reasoningEffortIsNil,omitempty"`
+	ReasoningEffortNotNil       bool     `json:"reasoningEffortNotNil,omitempty"`
+	ReasoningEffortEqualFold    *string  `json:"reasoningEffortEqualFold,omitempty"`
+	ReasoningEffortContainsFold *string  `json:"reasoningEffortContainsFold,omitempty"`
+
+	// "format" field predicates.
+	Format             *string  `json:"format,omitempty"`
+	FormatNEQ          *string  `json:"formatNEQ,omitempty"`
+	FormatIn           []string `json:"formatIn,omitempty"`
+	FormatNotIn        []string `json:"formatNotIn,omitempty"`
+	FormatGT           *string  `json:"formatGT,omitempty"`
+	FormatGTE          *string  `json:"formatGTE,omitempty"`
+	FormatLT           *string  `json:"formatLT,omitempty"`
+	FormatLTE          *string  `json:"formatLTE,omitempty"`
+	FormatContains     *string  `json:"formatContains,omitempty"`
+	FormatHasPrefix    *string  `json:"formatHasPrefix,omitempty"`
+	FormatHasSuffix    *string  `json:"formatHasSuffix,omitempty"`
+	FormatEqualFold    *string  `json:"formatEqualFold,omitempty"`
+	FormatContainsFold *string  `json:"formatContainsFold,omitempty"`
+
+	// "channel_id" field predicates.
+	ChannelID       *int  `json:"channelID,omitempty"`
+	ChannelIDNEQ    *int  `json:"channelIDNEQ,omitempty"`
+	ChannelIDIn     []int `json:"channelIDIn,omitempty"`
+	ChannelIDNotIn  []int `json:"channelIDNotIn,omitempty"`
+	ChannelIDIsNil  bool  `json:"channelIDIsNil,omitempty"`
+	ChannelIDNotNil bool  `json:"channelIDNotNil,omitempty"`
+
+	// "end_user_id" field predicates.
+	EndUserID             *string  `json:"endUserID,omitempty"`
+	EndUserIDNEQ          *string  `json:"endUserIDNEQ,omitempty"`
+	EndUserIDIn           []string `json:"endUserIDIn,omitempty"`
+	EndUserIDNotIn        []string `json:"endUserIDNotIn,omitempty"`
+	EndUserIDGT           *string  `json:"endUserIDGT,omitempty"`
+	EndUserIDGTE          *string  `json:"endUserIDGTE,omitempty"`
+	EndUserIDLT           *string  `json:"endUserIDLT,omitempty"`
+	EndUserIDLTE          *string  `json:"endUserIDLTE,omitempty"`
+	EndUserIDContains     *string  `json:"endUserIDContains,omitempty"`
+	EndUserIDHasPrefix    *string  `json:"endUserIDHasPrefix,omitempty"`
+	EndUserIDHasSuffix    *string  `json:"endUserIDHasSuffix,omitempty"`
+	EndUserIDIsNil        bool     `json:"endUserIDIsNil,omitempty"`
+	EndUserIDNotNil       bool     `json:"endUserIDNotNil,omitempty"`
+	EndUserIDEqualFold    *string  `json:"endUserIDEqualFold,omitempty"`
+	EndUserIDContainsFold *string  `json:"endUserIDContainsFold,omitempty"`
+
+	// "external_id" field predicates.
+	ExternalID             *string  `json:"externalID,omitempty"`
+	ExternalIDNEQ          *string  `json:"externalIDNEQ,omitempty"`
+	ExternalIDIn           []string `json:"externalIDIn,omitempty"`
+	ExternalIDNotIn        []string `json:"externalIDNotIn,omitempty"`
+	ExternalIDGT           *string  `json:"externalIDGT,omitempty"`
+	ExternalIDGTE          *string  `json:"externalIDGTE,omitempty"`
+	ExternalIDLT           *string  `json:"externalIDLT,omitempty"`
+	ExternalIDLTE          *string  `json:"externalIDLTE,omitempty"`
+	ExternalIDContains     *string  `json:"externalIDContains,omitempty"`
+	ExternalIDHasPrefix    *string  `json:"externalIDHasPrefix,omitempty"`
+	ExternalIDHasSuffix    *string  `json:"externalIDHasSuffix,omitempty"`
+	ExternalIDIsNil        bool     `json:"externalIDIsNil,omitempty"`
+	ExternalIDNotNil       bool     `json:"externalIDNotNil,omitempty"`
+	ExternalIDEqualFold    *string  `json:"externalIDEqualFold,omitempty"`
+	ExternalIDContainsFold *string  `json:"externalIDContainsFold,omitempty"`
+
+	// "status" field predicates.
+	Status      *request.Status  `json:"status,omitempty"`
+	StatusNEQ   *request.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []request.Status `json:"statusIn,omitempty"`
+	StatusNotIn []request.Status `json:"statusNotIn,omitempty"`
+
+	// "stream" field predicates.
+	Stream    *bool `json:"stream,omitempty"`
+	StreamNEQ *bool `json:"streamNEQ,omitempty"`
+
+	// "client_ip" field predicates.
+	ClientIP             *string  `json:"clientIP,omitempty"`
+	ClientIPNEQ          *string  `json:"clientIPNEQ,omitempty"`
+	ClientIPIn           []string `json:"clientIPIn,omitempty"`
+	ClientIPNotIn        []string `json:"clientIPNotIn,omitempty"`
+	ClientIPGT           *string  `json:"clientIPGT,omitempty"`
+	ClientIPGTE          *string  `json:"clientIPGTE,omitempty"`
+	ClientIPLT           *string  `json:"clientIPLT,omitempty"`
+	ClientIPLTE          *string  `json:"clientIPLTE,omitempty"`
+	ClientIPContains     *string  `json:"clientIPContains,omitempty"`
+	ClientIPHasPrefix    *string  `json:"clientIPHasPrefix,omitempty"`
+	ClientIPHasSuffix    *string  `json:"clientIPHasSuffix,omitempty"`
+	ClientIPEqualFold    *string  `json:"clientIPEqualFold,omitempty"`
+	ClientIPContainsFold *string  `json:"clientIPContainsFold,omitempty"`
+
+	// "metrics_latency_ms" field predicates.
+	MetricsLatencyMs       *int64  `json:"metricsLatencyMs,omitempty"`
+	MetricsLatencyMsNEQ    *int64  `json:"metricsLatencyMsNEQ,omitempty"`
+	MetricsLatencyMsIn     []int64 `json:"metricsLatencyMsIn,omitempty"`
+	MetricsLatencyMsNotIn  []int64 `json:"metricsLatencyMsNotIn,omitempty"`
+	MetricsLatencyMsGT     *int64  `json:"metricsLatencyMsGT,omitempty"`
+	MetricsLatencyMsGTE    *int64  `json:"metricsLatencyMsGTE,omitempty"`
+	MetricsLatencyMsLT     *int64  `json:"metricsLatencyMsLT,omitempty"`
+	MetricsLatencyMsLTE    *int64  `json:"metricsLatencyMsLTE,omitempty"`
+	MetricsLatencyMsIsNil  bool    `json:"metricsLatencyMsIsNil,omitempty"`
+	MetricsLatencyMsNotNil bool    `json:"metricsLatencyMsNotNil,omitempty"`
+
+	// "metrics_first_token_latency_ms" field predicates.
+	MetricsFirstTokenLatencyMs       *int64  `json:"metricsFirstTokenLatencyMs,omitempty"`
+	MetricsFirstTokenLatencyMsNEQ    *int64  `json:"metricsFirstTokenLatencyMsNEQ,omitempty"`
+	MetricsFirstTokenLatencyMsIn     []int64 `json:"metricsFirstTokenLatencyMsIn,omitempty"`
+	MetricsFirstTokenLatencyMsNotIn  []int64 `json:"metricsFirstTokenLatencyMsNotIn,omitempty"`
+	MetricsFirstTokenLatencyMsGT     *int64  `json:"metricsFirstTokenLatencyMsGT,omitempty"`
+	MetricsFirstTokenLatencyMsGTE    *int64  `json:"metricsFirstTokenLatencyMsGTE,omitempty"`
+	MetricsFirstTokenLatencyMsLT     *int64  `json:"metricsFirstTokenLatencyMsLT,omitempty"`
+	MetricsFirstTokenLatencyMsLTE    *int64  `json:"metricsFirstTokenLatencyMsLTE,omitempty"`
+	MetricsFirstTokenLatencyMsIsNil  bool    `json:"metricsFirstTokenLatencyMsIsNil,omitempty"`
+	MetricsFirstTokenLatencyMsNotNil bool    `json:"metricsFirstTokenLatencyMsNotNil,omitempty"`
+
+	// "metrics_reasoning_duration_ms" field predicates.
+	MetricsReasoningDurationMs       *int64  `json:"metricsReasoningDurationMs,omitempty"`
+	MetricsReasoningDurationMsNEQ    *int64  `json:"metricsReasoningDurationMsNEQ,omitempty"`
+	MetricsReasoningDurationMsIn     []int64 `json:"metricsReasoningDurationMsIn,omitempty"`
+	MetricsReasoningDurationMsNotIn  []int64 `json:"metricsReasoningDurationMsNotIn,omitempty"`
+	MetricsReasoningDurationMsGT     *int64  `json:"metricsReasoningDurationMsGT,omitempty"`
+	MetricsReasoningDurationMsGTE    *int64  `json:"metricsReasoningDurationMsGTE,omitempty"`
+	MetricsReasoningDurationMsLT     *int64  `json:"metricsReasoningDurationMsLT,omitempty"`
+	MetricsReasoningDurationMsLTE    *int64  `json:"metricsReasoningDurationMsLTE,omitempty"`
+	MetricsReasoningDurationMsIsNil  bool    `json:"metricsReasoningDurationMsIsNil,omitempty"`
+	MetricsReasoningDurationMsNotNil bool    `json:"metricsReasoningDurationMsNotNil,omitempty"`
+
+	// "content_saved" field predicates.
+	ContentSaved    *bool `json:"contentSaved,omitempty"`
+	ContentSavedNEQ *bool `json:"contentSavedNEQ,omitempty"`
+
+	// "content_storage_id" field predicates.
+	ContentStorageID       *int  `json:"contentStorageID,omitempty"`
+	ContentStorageIDNEQ    *int  `json:"contentStorageIDNEQ,omitempty"`
+	ContentStorageIDIn     []int `json:"contentStorageIDIn,omitempty"`
+	ContentStorageIDNotIn  []int `json:"contentStorageIDNotIn,omitempty"`
+	ContentStorageIDGT     *int  `json:"contentStorageIDGT,omitempty"`
+	ContentStorageIDGTE    *int  `json:"contentStorageIDGTE,omitempty"`
+	ContentStorageIDLT     *int  `json:"contentStorageIDLT,omitempty"`
+	ContentStorageIDLTE    *int  `json:"contentStorageIDLTE,omitempty"`
+	ContentStorageIDIsNil  bool  `json:"contentStorageIDIsNil,omitempty"`
+	ContentStorageIDNotNil bool  `json:"contentStorageIDNotNil,omitempty"`
+
+	// "content_storage_key" field predicates.
+	ContentStorageKey             *string  `json:"contentStorageKey,omitempty"`
+	ContentStorageKeyNEQ          *string  `json:"contentStorageKeyNEQ,omitempty"`
+	ContentStorageKeyIn           []string `json:"contentStorageKeyIn,omitempty"`
+	ContentStorageKeyNotIn        []string `json:"contentStorageKeyNotIn,omitempty"`
+	ContentStorageKeyGT           *string  `json:"contentStorageKeyGT,omitempty"`
+	ContentStorageKeyGTE          *string  `json:"contentStorageKeyGTE,omitempty"`
+	ContentStorageKeyLT           *string  `json:"contentStorageKeyLT,omitempty"`
+	ContentStorageKeyLTE          *string  `json:"contentStorageKeyLTE,omitempty"`
+	ContentStorageKeyContains     *string  `json:"contentStorageKeyContains,omitempty"`
+	ContentStorageKeyHasPrefix    *string  `json:"contentStorageKeyHasPrefix,omitempty"`
+	ContentStorageKeyHasSuffix    *string  `json:"contentStorageKeyHasSuffix,omitempty"`
+	ContentStorageKeyIsNil        bool     `json:"contentStorageKeyIsNil,omitempty"`
+	ContentStorageKeyNotNil       bool     `json:"contentStorageKeyNotNil,omitempty"`
+	ContentStorageKeyEqualFold    *string  `json:"contentStorageKeyEqualFold,omitempty"`
+	ContentStorageKeyContainsFold *string  `json:"contentStorageKeyContainsFold,omitempty"`
+
+	// "content_saved_at" field predicates.
+	ContentSavedAt       *time.Time  `json:"contentSavedAt,omitempty"`
+	ContentSavedAtNEQ    *time.Time  `json:"contentSavedAtNEQ,omitempty"`
+	ContentSavedAtIn     []time.Time `json:"contentSavedAtIn,omitempty"`
+	ContentSavedAtNotIn  []time.Time `json:"contentSavedAtNotIn,omitempty"`
+	ContentSavedAtGT     *time.Time  `json:"contentSavedAtGT,omitempty"`
+	ContentSavedAtGTE    *time.Time  `json:"contentSavedAtGTE,omitempty"`
+	ContentSavedAtLT     *time.Time  `json:"contentSavedAtLT,omitempty"`
+	ContentSavedAtLTE    *time.Time  `json:"contentSavedAtLTE,omitempty"`
+	ContentSavedAtIsNil  bool        `json:"contentSavedAtIsNil,omitempty"`
+	ContentSavedAtNotNil bool        `json:"contentSavedAtNotNil,omitempty"`
+
+	// "archived_at" field predicates.
+	ArchivedAt       *time.Time  `json:"archivedAt,omitempty"`
+	ArchivedAtNEQ    *time.Time  `json:"archivedAtNEQ,omitempty"`
+	ArchivedAtIn     []time.Time `json:"archivedAtIn,omitempty"`
+	ArchivedAtNotIn  []time.Time `json:"archivedAtNotIn,omitempty"`
+	ArchivedAtGT     *time.Time  `json:"archivedAtGT,omitempty"`
+	ArchivedAtGTE    *time.Time  `json:"archivedAtGTE,omitempty"`
+	ArchivedAtLT     *time.Time  `json:"archivedAtLT,omitempty"`
+	ArchivedAtLTE    *time.Time  `json:"archivedAtLTE,omitempty"`
+	ArchivedAtIsNil  bool        `json:"archivedAtIsNil,omitempty"`
+	ArchivedAtNotNil bool        `json:"archivedAtNotNil,omitempty"`
+
+	// "api_key" edge predicates.
+	HasAPIKey     *bool               `json:"hasAPIKey,omitempty"`
+	HasAPIKeyWith []*APIKeyWhereInput `json:"hasAPIKeyWith,omitempty"`
+
+	// "project" edge predicates.
+	HasProject     *bool                `json:"hasProject,omitempty"`
+	HasProjectWith []*ProjectWhereInput `json:"hasProjectWith,omitempty"`
+
+	// "trace" edge predicates.
+	HasTrace     *bool              `json:"hasTrace,omitempty"`
+	HasTraceWith []*TraceWhereInput `json:"hasTraceWith,omitempty"`
+
+	// "data_storage" edge predicates.
+	HasDataStorage     *bool                    `json:"hasDataStorage,omitempty"`
+	HasDataStorageWith []*DataStorageWhereInput `json:"hasDataStorageWith,omitempty"`
+
+	// "executions" edge predicates.
+	HasExecutions     *bool                         `json:"hasExecutions,omitempty"`
+	HasExecutionsWith []*RequestExecutionWhereInput `json:"hasExecutionsWith,omitempty"`
+
+	// "channel" edge predicates.
+	HasChannel     *bool                `json:"hasChannel,omitempty"`
+	HasChannelWith []*ChannelWhereInput `json:"hasChannelWith,omitempty"`
+
+	// "usage_logs" edge predicates.
+	HasUsageLogs     *bool                 `json:"hasUsageLogs,omitempty"`
+	HasUsageLogsWith []*UsageLogWhereInput `json:"hasUsageLogsWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *RequestWhereInput) AddPredicates(predicates ...predicate.Request) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the RequestWhereInput filter on the RequestQuery builder.
+func (i *RequestWhereInput) Filter(q *RequestQuery) (*RequestQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyRequestWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyRequestWhereInput is returned in case the RequestWhereInput is empty.
+var ErrEmptyRequestWhereInput = errors.New("ent: empty predicate RequestWhereInput")
+
+// P returns a predicate for filtering requests.
+// An error is returned if the input is empty or invalid.
+func (i *RequestWhereInput) P() (predicate.Request, error) {
+	var predicates []predicate.Request
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, request.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Request, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, request.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Request, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, request.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, request.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, request.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, request.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, request.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, request.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, request.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, request.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, request.IDLTE(*i.IDLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, request.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, request.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, request.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, request.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, request.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, request.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, request.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, request.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, request.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, request.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, request.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, request.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, request.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, request.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, request.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, request.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.APIKeyID != nil {
+		predicates = append(predicates, request.APIKeyIDEQ(*i.APIKeyID))
+	}
+	if i.APIKeyIDNEQ != nil {
+		predicates = append(predicates, request.APIKeyIDNEQ(*i.APIKeyIDNEQ))
+	}
+	if len(i.APIKeyIDIn) > 0 {
+		predicates = append(predicates, request.APIKeyIDIn(i.APIKeyIDIn...))
+	}
+	if len(i.APIKeyIDNotIn) > 0 {
+		predicates = append(predicates, request.APIKeyIDNotIn(i.APIKeyIDNotIn...))
+	}
+	if i.APIKeyIDIsNil {
+		predicates = append(predicates, request.APIKeyIDIsNil())
+	}
+	if i.APIKeyIDNotNil {
+		predicates = append(predicates, request.APIKeyIDNotNil())
+	}
+	if i.ProjectID != nil {
+		predicates = append(predicates, request.ProjectIDEQ(*i.ProjectID))
+	}
+	if i.ProjectIDNEQ != nil {
+		predicates = append(predicates, request.ProjectIDNEQ(*i.ProjectIDNEQ))
+	}
+	if len(i.ProjectIDIn) > 0 {
+		predicates = append(predicates, request.ProjectIDIn(i.ProjectIDIn...))
+	}
+	if len(i.ProjectIDNotIn) > 0 {
+		predicates = append(predicates, request.ProjectIDNotIn(i.ProjectIDNotIn...))
+	}
+	if i.TraceID != nil {
+		predicates = append(predicates, request.TraceIDEQ(*i.TraceID))
+	}
+	if i.TraceIDNEQ != nil {
+		predicates = append(predicates, request.TraceIDNEQ(*i.TraceIDNEQ))
+	}
+	if len(i.TraceIDIn) > 0 {
+		predicates = append(predicates, request.TraceIDIn(i.TraceIDIn...))
+	}
+	if len(i.TraceIDNotIn) > 0 {
+		predicates = append(predicates, request.TraceIDNotIn(i.TraceIDNotIn...))
+	}
+	if i.TraceIDIsNil {
+		predicates = append(predicates, request.TraceIDIsNil())
+	}
+	if i.TraceIDNotNil {
+		predicates = append(predicates, request.TraceIDNotNil())
+	}
+	if i.DataStorageID != nil {
+		predicates = append(predicates, request.DataStorageIDEQ(*i.DataStorageID))
+	}
+	if i.DataStorageIDNEQ != nil {
+		predicates = append(predicates, request.DataStorageIDNEQ(*i.DataStorageIDNEQ))
+	}
+	if len(i.DataStorageIDIn) > 0 {
+		predicates = append(predicates, request.DataStorageIDIn(i.DataStorageIDIn...))
+	}
+	if len(i.DataStorageIDNotIn) > 0 {
+		predicates = append(predicates, request.DataStorageIDNotIn(i.DataStorageIDNotIn...))
+	}
+	if i.DataStorageIDIsNil {
+		predicates = append(predicates, request.DataStorageIDIsNil())
+	}
+	if i.DataStorageIDNotNil {
+		predicates = append(predicates, request.DataStorageIDNotNil())
+	}
+	if i.Source != nil {
+		predicates = append(predicates, request.SourceEQ(*i.Source))
+	}
+	if i.SourceNEQ != nil {
+		predicates = append(predicates, request.SourceNEQ(*i.SourceNEQ))
+	}
+	if len(i.SourceIn) > 0 {
+		predicates = append(predicates, request.SourceIn(i.SourceIn...))
+	}
+	if len(i.SourceNotIn) > 0 {
+		predicates = append(predicates, request.SourceNotIn(i.SourceNotIn...))
+	}
+	if i.ModelID != nil {
+		predicates = append(predicates, request.ModelIDEQ(*i.ModelID))
+	}
+	if i.ModelIDNEQ != nil {
+		predicates = append(predicates, request.ModelIDNEQ(*i.ModelIDNEQ))
+	}
+	if len(i.ModelIDIn) > 0 {
+		predicates = append(predicates, request.ModelIDIn(i.ModelIDIn...))
+	}
+	if len(i.ModelIDNotIn) > 0 {
+		predicates = append(predicates, request.ModelIDNotIn(i.ModelIDNotIn...))
+	}
+	if i.ModelIDGT != nil {
+		predicates = append(predicates, request.ModelIDGT(*i.ModelIDGT))
+	}
+	if i.ModelIDGTE != nil {
+		predicates = append(predicates, request.ModelIDGTE(*i.ModelIDGTE))
+	}
+	if i.ModelIDLT != nil {
+		predicates = append(predicates, request.ModelIDLT(*i.ModelIDLT))
+	}
+	if i.ModelIDLTE != nil {
+		predicates = append(predicates, request.ModelIDLTE(*i.ModelIDLTE))
+	}
+	if i.ModelIDContains != nil {
+		predicates = append(predicates, request.ModelIDContains(*i.ModelIDContains))
+	}
+	if i.ModelIDHasPrefix != nil {
+		predicates = append(predicates, request.ModelIDHasPrefix(*i.ModelIDHasPrefix))
+	}
+	if i.ModelIDHasSuffix != nil {
+		predicates = append(predicates, request.ModelIDHasSuffix(*i.ModelIDHasSuffix))
+	}
+	if i.ModelIDEqualFold != nil {
+		predicates = append(predicates, request.ModelIDEqualFold(*i.ModelIDEqualFold))
+	}
+	if i.ModelIDContainsFold != nil {
+		predicates = append(predicates, request.ModelIDContainsFold(*i.ModelIDContainsFold))
+	}
+	if i.ReasoningEffort != nil {
+		predicates = append(predicates, request.ReasoningEffortEQ(*i.ReasoningEffort))
+	}
+	if i.ReasoningEffortNEQ != nil {
+		predicates = append(predicates, request.ReasoningEffortNEQ(*i.ReasoningEffortNEQ))
+	}
+	if len(i.ReasoningEffortIn) > 0 {
+		predicates = append(predicates, request.ReasoningEffortIn(i.ReasoningEffortIn...))
+	}
+	if len(i.ReasoningEffortNotIn) > 0 {
+		predicates = append(predicates, request.ReasoningEffortNotIn(i.ReasoningEffortNotIn...))
+	}
+	if i.ReasoningEffortGT != nil {
+		predicates = append(predicates, request.ReasoningEffortGT(*i.ReasoningEffortGT))
+	}
+	if i.ReasoningEffortGTE != nil {
+		predicates = append(predicates, request.ReasoningEffortGTE(*i.ReasoningEffortGTE))
+	}
+	if i.ReasoningEffortLT != nil {
+		predicates = append(predicates, request.ReasoningEffortLT(*i.ReasoningEffortLT))
+	}
+	if i.ReasoningEffortLTE != nil {
+		predicates = append(predicates, request.ReasoningEffortLTE(*i.ReasoningEffortLTE))
+	}
+	if i.ReasoningEffortContains != nil {
+		predicates = append(predicates, request.ReasoningEffortContains(*i.ReasoningEffortContains))
+	}
+	if i.ReasoningEffortHasPrefix != nil {
+		predicates = append(predicates, request.ReasoningEffortHasPrefix(*i.ReasoningEffortHasPrefix))
+	}
+	if i.ReasoningEffortHasSuffix != nil {
+		predicates = append(predicates, request.ReasoningEffortHasSuffix(*i.ReasoningEffortHasSuffix))
+	}
+	if i.ReasoningEffortIsNil {
+		predicates = append(predicates, request.ReasoningEffortIsNil())
+	}
+	if i.ReasoningEffortNotNil {
+		predicates = append(predicates, request.ReasoningEffortNotNil())
+	}
+	if i.ReasoningEffortEqualFold != nil {
+		predicates = append(predicates, request.ReasoningEffortEqualFold(*i.ReasoningEffortEqualFold))
+	}
+	if i.ReasoningEffortContainsFold != nil {
+		predicates = append(predicates, request.ReasoningEffortContainsFold(*i.ReasoningEffortContainsFold))
+	}
+	if i.Format != nil {
+		predicates = append(predicates, request.FormatEQ(*i.Format))
+	}
+	if i.FormatNEQ != nil {
+		predicates = append(predicates, request.FormatNEQ(*i.FormatNEQ))
+	}
+	if len(i.FormatIn) > 0 {
+		predicates = append(predicates, request.FormatIn(i.FormatIn...))
+	}
+	if len(i.FormatNotIn) > 0 {
+		predicates = append(predicates, request.FormatNotIn(i.FormatNotIn...))
+	}
+	if i.FormatGT != nil {
+		predicates = append(predicates, request.FormatGT(*i.FormatGT))
+	}
+	if i.FormatGTE != nil {
+		predicates = append(predicates, request.FormatGTE(*i.FormatGTE))
+	}
+	if i.FormatLT != nil {
+		predicates = append(predicates, request.FormatLT(*i.FormatLT))
+	}
+	if i.FormatLTE != nil {
+		predicates = append(predicates, request.FormatLTE(*i.FormatLTE))
+	}
+	if i.FormatContains != nil {
+		predicates = append(predicates, request.FormatContains(*i.FormatContains))
+	}
+	if i.FormatHasPrefix != nil {
+		predicates = append(predicates, request.FormatHasPrefix(*i.FormatHasPrefix))
+	}
+	if i.FormatHasSuffix != nil {
+		predicates = append(predicates, request.FormatHasSuffix(*i.FormatHasSuffix))
+	}
+	if i.FormatEqualFold != nil {
+		predicates = append(predicates, request.FormatEqualFold(*i.FormatEqualFold))
+	}
+	if i.FormatContainsFold != nil {
+		predicates = append(predicates, request.FormatContainsFold(*i.FormatContainsFold))
+	}
+	if i.ChannelID != nil {
+		predicates = append(predicates, request.ChannelIDEQ(*i.ChannelID))
+	}
+	if i.ChannelIDNEQ != nil {
+		predicates = append(predicates, request.ChannelIDNEQ(*i.ChannelIDNEQ))
+	}
+	if len(i.ChannelIDIn) > 0 {
+		predicates = append(predicates, request.ChannelIDIn(i.ChannelIDIn...))
+	}
+	if len(i.ChannelIDNotIn) > 0 {
+		predicates = append(predicates, request.ChannelIDNotIn(i.ChannelIDNotIn...))
+	}
+	if i.ChannelIDIsNil {
+		predicates = append(predicates, request.ChannelIDIsNil())
+	}
+	if i.ChannelIDNotNil {
+		predicates = append(predicates, request.ChannelIDNotNil())
+	}
+	if i.EndUserID != nil {
+		predicates = append(predicates, request.EndUserIDEQ(*i.EndUserID))
+	}
+	if i.EndUserIDNEQ != nil {
+		predicates = append(predicates, request.EndUserIDNEQ(*i.EndUserIDNEQ))
+	}
+	if len(i.EndUserIDIn) > 0 {
+		predicates = append(predicates, request.EndUserIDIn(i.EndUserIDIn...))
+	}
+	if len(i.EndUserIDNotIn) > 0 {
+		predicates = append(predicates, request.EndUserIDNotIn(i.EndUserIDNotIn...))
+	}
+	if i.EndUserIDGT != nil {
+		predicates = append(predicates, request.EndUserIDGT(*i.EndUserIDGT))
+	}
+	if i.EndUserIDGTE != nil {
+		predicates = append(predicates, request.EndUserIDGTE(*i.EndUserIDGTE))
+	}
+	if i.EndUserIDLT != nil {
+		predicates = append(predicates, request.EndUserIDLT(*i.EndUserIDLT))
+	}
+	if i.EndUserIDLTE != nil {
+		predicates = append(predicates, request.EndUserIDLTE(*i.EndUserIDLTE))
+	}
+	if i.EndUserIDContains != nil {
+		predicates = append(predicates, request.EndUserIDContains(*i.EndUserIDContains))
+	}
+	if i.EndUserIDHasPrefix != nil {
+		predicates = append(predicates, request.EndUserIDHasPrefix(*i.EndUserIDHasPrefix))
+	}
+	if i.EndUserIDHasSuffix != nil {
+		predicates = append(predicates, request.EndUserIDHasSuffix(*i.EndUserIDHasSuffix))
+	}
+	if i.EndUserIDIsNil {
+		predicates = append(predicates, request.EndUserIDIsNil())
+	}
+	if i.EndUserIDNotNil {
+		predicates = append(predicates, request.EndUserIDNotNil())
+	}
+	if i.EndUserIDEqualFold != nil {
+		predicates = append(predicates, request.EndUserIDEqualFold(*i.EndUserIDEqualFold))
+	}
+	if i.EndUserIDContainsFold != nil {
+		predicates = append(predicates, request.EndUserIDContainsFold(*i.EndUserIDContainsFold))
+	}
+	if i.ExternalID != nil {
+		predicates = append(predicates, request.ExternalIDEQ(*i.ExternalID))
+	}
+	if i.ExternalIDNEQ != nil {
+		predicates = append(predicates, request.ExternalIDNEQ(*i.ExternalIDNEQ))
+	}
+	if len(i.ExternalIDIn) > 0 {
+		predicates = append(predicates, request.ExternalIDIn(i.ExternalIDIn...))
+	}
+	if len(i.ExternalIDNotIn) > 0 {
+		predicates = append(predicates, request.ExternalIDNotIn(i.ExternalIDNotIn...))
+	}
+	if i.ExternalIDGT != nil {
+		predicates = append(predicates, request.ExternalIDGT(*i.ExternalIDGT))
+	}
+	if i.ExternalIDGTE != nil {
+		predicates = append(predicates, request.ExternalIDGTE(*i.ExternalIDGTE))
+	}
+	if i.ExternalIDLT != nil {
+		predicates = append(predicates, request.ExternalIDLT(*i.ExternalIDLT))
+	}
+	if i.ExternalIDLTE != nil {
+		predicates = append(predicates, request.ExternalIDLTE(*i.ExternalIDLTE))
+	}
+	if i.ExternalIDContains != nil {
+		predicates = append(predicates, request.ExternalIDContains(*i.ExternalIDContains))
+	}
+	if i.ExternalIDHasPrefix != nil {
+		predicates = append(predicates, request.ExternalIDHasPrefix(*i.ExternalIDHasPrefix))
+	}
+	if i.ExternalIDHasSuffix != nil {
+		predicates = append(predicates, request.ExternalIDHasSuffix(*i.ExternalIDHasSuffix))
+	}
+	if i.ExternalIDIsNil {
+		predicates = append(predicates, request.ExternalIDIsNil())
+	}
+	if i.ExternalIDNotNil {
+		predicates = append(predicates, request.ExternalIDNotNil())
+	}
+	if i.ExternalIDEqualFold != nil {
+		predicates = append(predicates, request.ExternalIDEqualFold(*i.ExternalIDEqualFold))
+	}
+	if i.ExternalIDContainsFold != nil {
+		predicates = append(predicates, request.ExternalIDContainsFold(*i.ExternalIDContainsFold))
+	}
+	if i.Status != nil {
+		predicates = append(predicates, request.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, request.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, request.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, request.StatusNotIn(i.StatusNotIn...))
+	}
+	if i.Stream != nil {
+		predicates = append(predicates, request.StreamEQ(*i.Stream))
+	}
+	if i.StreamNEQ != nil {
+		predicates = append(predicates, request.StreamNEQ(*i.StreamNEQ))
+	}
+	if i.ClientIP != nil {
+		predicates = append(predicates, request.ClientIPEQ(*i.ClientIP))
+	}
+	if i.ClientIPNEQ != nil {
+		predicates = append(predicates, request.ClientIPNEQ(*i.ClientIPNEQ))
+	}
+	if len(i.ClientIPIn) > 0 {
+		predicates = append(predicates, request.ClientIPIn(i.ClientIPIn...))
+	}
+	if len(i.ClientIPNotIn) > 0 {
+		predicates = append(predicates, request.ClientIPNotIn(i.ClientIPNotIn...))
+	}
+	if i.ClientIPGT != nil {
+		predicates = append(predicates, request.ClientIPGT(*i.ClientIPGT))
+	}
+	if i.ClientIPGTE != nil {
+		predicates = append(predicates, request.ClientIPGTE(*i.ClientIPGTE))
+	}
+	if i.ClientIPLT != nil {
+		predicates = append(predicates, request.ClientIPLT(*i.ClientIPLT))
+	}
+	if i.ClientIPLTE != nil {
+		predicates = append(predicates, request.ClientIPLTE(*i.ClientIPLTE))
+	}
+	if i.ClientIPContains != nil {
+		predicates = append(predicates, request.ClientIPContains(*i.ClientIPContains))
+	}
+	if i.ClientIPHasPrefix != nil {
+		predicates = append(predicates, request.ClientIPHasPrefix(*i.ClientIPHasPrefix))
+	}
+	if i.ClientIPHasSuffix != nil {
+		predicates = append(predicates, request.ClientIPHasSuffix(*i.ClientIPHasSuffix))
+	}
+	if i.ClientIPEqualFold != nil {
+		predicates = append(predicates, request.ClientIPEqualFold(*i.ClientIPEqualFold))
+	}
+	if i.ClientIPContainsFold != nil {
+		predicates = append(predicates, request.ClientIPContainsFold(*i.ClientIPContainsFold))
+	}
+	if i.MetricsLatencyMs != nil {
+		predicates = append(predicates, request.MetricsLatencyMsEQ(*i.MetricsLatencyMs))
+	}
+	if i.MetricsLatencyMsNEQ != nil {
+		predicates = append(predicates, request.MetricsLatencyMsNEQ(*i.MetricsLatencyMsNEQ))
+	}
+	if len(i.MetricsLatencyMsIn) > 0 {
+		predicates = append(predicates, request.MetricsLatencyMsIn(i.MetricsLatencyMsIn...))
+	}
+	if len(i.MetricsLatencyMsNotIn) > 0 {
+		predicates = append(predicates, request.MetricsLatencyMsNotIn(i.MetricsLatencyMsNotIn...))
+	}
+	if i.MetricsLatencyMsGT != nil {
+		predicates = append(predicates, request.MetricsLatencyMsGT(*i.MetricsLatencyMsGT))
+	}
+	if i.MetricsLatencyMsGTE != nil {
+		predicates = append(predicates, request.MetricsLatencyMsGTE(*i.MetricsLatencyMsGTE))
+	}
+	if i.MetricsLatencyMsLT != nil {
+		predicates = append(predicates, request.MetricsLatencyMsLT(*i.MetricsLatencyMsLT))
+	}
+	if i.MetricsLatencyMsLTE != nil {
+		predicates = append(predicates, request.MetricsLatencyMsLTE(*i.MetricsLatencyMsLTE))
+	}
+	if i.MetricsLatencyMsIsNil {
+		predicates = append(predicates, request.MetricsLatencyMsIsNil())
+	}
+	if i.MetricsLatencyMsNotNil {
+		predicates = append(predicates, request.MetricsLatencyMsNotNil())
+	}
+	if i.MetricsFirstTokenLatencyMs != nil {
+		predicates = append(predicates, request.MetricsFirstTokenLatencyMsEQ(*i.MetricsFirstTokenLatencyMs))
+	}
+	if i.MetricsFirstTokenLatencyMsNEQ != nil {
+		predicates = append(predicates, request.MetricsFirstTokenLatencyMsNEQ(*i.MetricsFirstTokenLatencyMsNEQ))
+	}
+	if len(i.MetricsFirstTokenLatencyMsIn) > 0 {
+		predicates = append(predicates, request.MetricsFirstTokenLatencyMsIn(i.MetricsFirstTokenLatencyMsIn...))
+	}
+	if len(i.MetricsFirstTokenLatencyMsNotIn) > 0 {
+		predicates = append(predicates, request.MetricsFirstTokenLatencyMsNotIn(i.MetricsFirstTokenLatencyMsNotIn...))
+	}
+	if i.MetricsFirstTokenLatencyMsGT != nil {
+		predicates = append(predicates, request.MetricsFirstTokenLatencyMsGT(*i.MetricsFirstTokenLatencyMsGT))
+	}
+	if i.MetricsFirstTokenLatencyMsGTE != nil {
+		predicates = append(predicates, request.MetricsFirstTokenLatencyMsGTE(*i.MetricsFirstTokenLatencyMsGTE))
+	}
+	if i.MetricsFirstTokenLatencyMsLT != nil {
+		predicates = append(predicates, request.MetricsFirstTokenLatencyMsLT(*i.MetricsFirstTokenLatencyMsLT))
+	}
+	if i.MetricsFirstTokenLatencyMsLTE != nil {
+		predicates = append(predicates, request.MetricsFirstTokenLatencyMsLTE(*i.MetricsFirstTokenLatencyMsLTE))
+	}
+	if i.MetricsFirstTokenLatencyMsIsNil {
+		predicates = append(predicates, request.MetricsFirstTokenLatencyMsIsNil())
+	}
+	if i.MetricsFirstTokenLatencyMsNotNil {
+		predicates = append(predicates, request.MetricsFirstTokenLatencyMsNotNil())
+	}
+	if i.MetricsReasoningDurationMs != nil {
+		predicates = append(predicates, request.MetricsReasoningDurationMsEQ(*i.MetricsReasoningDurationMs))
+	}
+	if i.MetricsReasoningDurationMsNEQ != nil {
+		predicates = append(predicates, request.MetricsReasoningDurationMsNEQ(*i.MetricsReasoningDurationMsNEQ))
+	}
+	if len(i.MetricsReasoningDurationMsIn) > 0 {
+		predicates = append(predicates, request.MetricsReasoningDurationMsIn(i.MetricsReasoningDurationMsIn...))
+	}
+	if len(i.MetricsReasoningDurationMsNotIn) > 0 {
+		predicates = append(predicates, request.MetricsReasoningDurationMsNotIn(i.MetricsReasoningDurationMsNotIn...))
+	}
+	if i.MetricsReasoningDurationMsGT != nil {
+		predicates = append(predicates, request.MetricsReasoningDurationMsGT(*i.MetricsReasoningDurationMsGT))
+	}
+	if i.MetricsReasoningDurationMsGTE != nil {
+		predicates = append(predicates, request.MetricsReasoningDurationMsGTE(*i.MetricsReasoningDurationMsGTE))
+	}
+	if i.MetricsReasoningDurationMsLT != nil {
+		predicates = append(predicates, request.MetricsReasoningDurationMsLT(*i.MetricsReasoningDurationMsLT))
+	}
+	if i.MetricsReasoningDurationMsLTE != nil {
+		predicates = append(predicates, request.MetricsReasoningDurationMsLTE(*i.MetricsReasoningDurationMsLTE))
+	}
+	if i.MetricsReasoningDurationMsIsNil {
+		predicates = append(predicates, request.MetricsReasoningDurationMsIsNil())
+	}
+	if i.MetricsReasoningDurationMsNotNil {
+		predicates = append(predicates, request.MetricsReasoningDurationMsNotNil())
+	}
+	if i.ContentSaved != nil {
+		predicates = append(predicates, request.ContentSavedEQ(*i.ContentSaved))
+	}
+	if i.ContentSavedNEQ != nil {
+		predicates = append(predicates, request.ContentSavedNEQ(*i.ContentSavedNEQ))
+	}
+	if i.ContentStorageID != nil {
+		predicates = append(predicates, request.ContentStorageIDEQ(*i.ContentStorageID))
+	}
+	if i.ContentStorageIDNEQ != nil {
+		predicates = append(predicates, request.ContentStorageIDNEQ(*i.ContentStorageIDNEQ))
+	}
+	if len(i.ContentStorageIDIn) > 0 {
+		predicates = append(predicates, request.ContentStorageIDIn(i.ContentStorageIDIn...))
+	}
+	if len(i.ContentStorageIDNotIn) > 0 {
+		predicates = append(predicates, request.ContentStorageIDNotIn(i.ContentStorageIDNotIn...))
+	}
+	if i.ContentStorageIDGT != nil {
+		predicates = append(predicates, request.ContentStorageIDGT(*i.ContentStorageIDGT))
+	}
+	if i.ContentStorageIDGTE != nil {
+		predicates = append(predicates, request.ContentStorageIDGTE(*i.ContentStorageIDGTE))
+	}
+	if i.ContentStorageIDLT != nil {
+		predicates = append(predicates, request.ContentStorageIDLT(*i.ContentStorageIDLT))
+	}
+	if i.ContentStorageIDLTE != nil {
+		predicates = append(predicates, request.ContentStorageIDLTE(*i.ContentStorageIDLTE))
+	}
+	if i.ContentStorageIDIsNil {
+		predicates = append(predicates, request.ContentStorageIDIsNil())
+	}
+	if i.ContentStorageIDNotNil {
+		predicates = append(predicates, request.ContentStorageIDNotNil())
+	}
+	if i.ContentStorageKey != nil {
+		predicates = append(predicates, request.ContentStorageKeyEQ(*i.ContentStorageKey))
+	}
+	if i.ContentStorageKeyNEQ != nil {
+		predicates = append(predicates, request.ContentStorageKeyNEQ(*i.ContentStorageKeyNEQ))
+	}
+	if len(i.ContentStorageKeyIn) > 0 {
+		predicates = append(predicates, request.ContentStorageKeyIn(i.ContentStorageKeyIn...))
+	}
+	if len(i.ContentStorageKeyNotIn) > 0 {
+		predicates = append(predicates, request.ContentStorageKeyNotIn(i.ContentStorageKeyNotIn...))
+	}
+	if i.ContentStorageKeyGT != nil {
+		predicates = append(predicates, request.ContentStorageKeyGT(*i.ContentStorageKeyGT))
+	}
+	if i.ContentStorageKeyGTE != nil {
+		predicates = append(predicates, request.ContentStorageKeyGTE(*i.ContentStorageKeyGTE))
+	}
+	if i.ContentStorageKeyLT != nil {
+		predicates = append(predicates, request.ContentStorageKeyLT(*i.ContentStorageKeyLT))
+	}
+	if i.ContentStorageKeyLTE != nil {
+		predicates = append(predicates, request.ContentStorageKeyLTE(*i.ContentStorageKeyLTE))
+	}
+	if i.ContentStorageKeyContains != nil {
+		predicates = append(predicates, request.ContentStorageKeyContains(*i.ContentStorageKeyContains))
+	}
+	if i.ContentStorageKeyHasPrefix != nil {
+		predicates = append(predicates, request.ContentStorageKeyHasPrefix(*i.ContentStorageKeyHasPrefix))
+	}
+	if i.ContentStorageKeyHasSuffix != nil {
+		predicates = append(predicates, request.ContentStorageKeyHasSuffix(*i.ContentStorageKeyHasSuffix))
+	}
+	if i.ContentStorageKeyIsNil {
+		predicates = append(predicates, request.ContentStorageKeyIsNil())
+	}
+	if i.ContentStorageKeyNotNil {
+		predicates = append(predicates, request.ContentStorageKeyNotNil())
+	}
+	if i.ContentStorageKeyEqualFold != nil {
+		predicates = append(predicates, request.ContentStorageKeyEqualFold(*i.ContentStorageKeyEqualFold))
+	}
+	if i.ContentStorageKeyContainsFold != nil {
+		predicates = append(predicates, request.ContentStorageKeyContainsFold(*i.ContentStorageKeyContainsFold))
+	}
+	if i.ContentSavedAt != nil {
+		predicates = append(predicates, request.ContentSavedAtEQ(*i.ContentSavedAt))
+	}
+	if i.ContentSavedAtNEQ != nil {
+		predicates = append(predicates, request.ContentSavedAtNEQ(*i.ContentSavedAtNEQ))
+	}
+	if len(i.ContentSavedAtIn) > 0 {
+		predicates = append(predicates, request.ContentSavedAtIn(i.ContentSavedAtIn...))
+	}
+	if len(i.ContentSavedAtNotIn) > 0 {
+		predicates = append(predicates, request.ContentSavedAtNotIn(i.ContentSavedAtNotIn...))
+	}
+	if i.ContentSavedAtGT != nil {
+		predicates = append(predicates, request.ContentSavedAtGT(*i.ContentSavedAtGT))
+	}
+	if i.ContentSavedAtGTE != nil {
+		predicates = append(predicates, request.ContentSavedAtGTE(*i.ContentSavedAtGTE))
+	}
+	if i.ContentSavedAtLT != nil {
+		predicates = append(predicates, request.ContentSavedAtLT(*i.ContentSavedAtLT))
+	}
+	if i.ContentSavedAtLTE != nil {
+		predicates = append(predicates, request.ContentSavedAtLTE(*i.ContentSavedAtLTE))
+	}
+	if i.ContentSavedAtIsNil {
+		predicates = append(predicates, request.ContentSavedAtIsNil())
+	}
+	if i.ContentSavedAtNotNil {
+		predicates = append(predicates, request.ContentSavedAtNotNil())
+	}
+	if i.ArchivedAt != nil {
+		predicates = append(predicates, request.ArchivedAtEQ(*i.ArchivedAt))
+	}
+	if i.ArchivedAtNEQ != nil {
+		predicates = append(predicates, request.ArchivedAtNEQ(*i.ArchivedAtNEQ))
+	}
+	if len(i.ArchivedAtIn) > 0 {
+		predicates = append(predicates, request.ArchivedAtIn(i.ArchivedAtIn...))
+	}
+	if len(i.ArchivedAtNotIn) > 0 {
+		predicates = append(predicates, request.ArchivedAtNotIn(i.ArchivedAtNotIn...))
+	}
+	if i.ArchivedAtGT != nil {
+		predicates = append(predicates, request.ArchivedAtGT(*i.ArchivedAtGT))
+	}
+	if i.ArchivedAtGTE != nil {
+		predicates = append(predicates, request.ArchivedAtGTE(*i.ArchivedAtGTE))
+	}
+	if i.ArchivedAtLT != nil {
+		predicates = append(predicates, request.ArchivedAtLT(*i.ArchivedAtLT))
+	}
+	if i.ArchivedAtLTE != nil {
+		predicates = append(predicates, request.ArchivedAtLTE(*i.ArchivedAtLTE))
+	}
+	if i.ArchivedAtIsNil {
+		predicates = append(predicates, request.ArchivedAtIsNil())
+	}
+	if i.ArchivedAtNotNil {
+		predicates = append(predicates, request.ArchivedAtNotNil())
+	}
+
+	if i.HasAPIKey != nil {
+		p := request.HasAPIKey()
+		if !*i.HasAPIKey {
+			p = request.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasAPIKeyWith) > 0 {
+		with := make([]predicate.APIKey, 0, len(i.HasAPIKeyWith))
+		for _, w := range i.HasAPIKeyWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAPIKeyWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, request.HasAPIKeyWith(with...))
+	}
+	if i.HasProject != nil {
+		p := request.HasProject()
+		if !*i.HasProject {
+			p = request.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasProjectWith) > 0 {
+		with := make([]predicate.Project, 0, len(i.HasProjectWith))
+		for _, w := range i.HasProjectWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProjectWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, request.HasProjectWith(with...))
+	}
+	if i.HasTrace != nil {
+		p := request.HasTrace()
+		if !*i.HasTrace {
+			p = request.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasTraceWith) > 0 {
+		with := make([]predicate.Trace, 0, len(i.HasTraceWith))
+		for _, w := range i.HasTraceWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasTraceWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, request.HasTraceWith(with...))
+	}
+	if i.HasDataStorage != nil {
+		p := request.HasDataStorage()
+		if !*i.HasDataStorage {
+			p = request.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasDataStorageWith) > 0 {
+		with := make([]predicate.DataStorage, 0, len(i.HasDataStorageWith))
+		for _, w := range i.HasDataStorageWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasDataStorageWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, request.HasDataStorageWith(with...))
+	}
+	if i.HasExecutions != nil {
+		p := request.HasExecutions()
+		if !*i.HasExecutions {
+			p = request.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasExecutionsWith) > 0 {
+		with := make([]predicate.RequestExecution, 0, len(i.HasExecutionsWith))
+		for _, w := range i.HasExecutionsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasExecutionsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, request.HasExecutionsWith(with...))
+	}
+	if i.HasChannel != nil {
+		p := request.HasChannel()
+		if !*i.HasChannel {
+			p = request.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasChannelWith) > 0 {
+		with := make([]predicate.Channel, 0, len(i.HasChannelWith))
+		for _, w := range i.HasChannelWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasChannelWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, request.HasChannelWith(with...))
+	}
+	if i.HasUsageLogs != nil {
+		p := request.HasUsageLogs()
+		if !*i.HasUsageLogs {
+			p = request.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasUsageLogsWith) > 0 {
+		with := make([]predicate.UsageLog, 0, len(i.HasUsageLogsWith))
+		for _, w := range i.HasUsageLogsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUsageLogsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, request.HasUsageLogsWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyRequestWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return request.And(predicates...), nil
+	}
+}
+
+// RequestExecutionWhereInput represents a where input for filtering RequestExecution queries.
+type RequestExecutionWhereInput struct {
+	Predicates []predicate.RequestExecution  `json:"-"`
+	Not        *RequestExecutionWhereInput   `json:"not,omitempty"`
+	Or         []*RequestExecutionWhereInput `json:"or,omitempty"`
+	And        []*RequestExecutionWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "project_id" field predicates.
+	ProjectID      *int  `json:"projectID,omitempty"`
+	ProjectIDNEQ   *int  `json:"projectIDNEQ,omitempty"`
+	ProjectIDIn    []int `json:"projectIDIn,omitempty"`
+	ProjectIDNotIn []int `json:"projectIDNotIn,omitempty"`
+	ProjectIDGT    *int  `json:"projectIDGT,omitempty"`
+	ProjectIDGTE   *int  `json:"projectIDGTE,omitempty"`
+	ProjectIDLT    *int  `json:"projectIDLT,omitempty"`
+	ProjectIDLTE   *int  `json:"projectIDLTE,omitempty"`
+
+	// "request_id" field predicates.
+	RequestID      *int  `json:"requestID,omitempty"`
+	RequestIDNEQ   *int  `json:"requestIDNEQ,omitempty"`
+	RequestIDIn    []int `json:"requestIDIn,omitempty"`
+	RequestIDNotIn []int `json:"requestIDNotIn,omitempty"`
+
+	// "channel_id" field predicates.
+	ChannelID       *int  `json:"channelID,omitempty"`
+	ChannelIDNEQ    *int  `json:"channelIDNEQ,omitempty"`
+	ChannelIDIn     []int `json:"channelIDIn,omitempty"`
+	ChannelIDNotIn  []int `json:"channelIDNotIn,omitempty"`
+	ChannelIDIsNil  bool  `json:"channelIDIsNil,omitempty"`
+	ChannelIDNotNil bool  `json:"channelIDNotNil,omitempty"`
+
+	// "data_storage_id" field predicates.
+	DataStorageID       *int  `json:"dataStorageID,omitempty"`
+	DataStorageIDNEQ    *int  `json:"dataStorageIDNEQ,omitempty"`
+	DataStorageIDIn     []int `json:"dataStorageIDIn,omitempty"`
+	DataStorageIDNotIn  []int `json:"dataStorageIDNotIn,omitempty"`
+	DataStorageIDIsNil  bool  `json:"dataStorageIDIsNil,omitempty"`
+	DataStorageIDNotNil bool  `json:"dataStorageIDNotNil,omitempty"`
+
+	// "external_id" field predicates.
+	ExternalID             *string  `json:"externalID,omitempty"`
+	ExternalIDNEQ          *string  `json:"externalIDNEQ,omitempty"`
+	ExternalIDIn           []string `json:"externalIDIn,omitempty"`
+	ExternalIDNotIn        []string `json:"externalIDNotIn,omitempty"`
+	ExternalIDGT           *string  `json:"externalIDGT,omitempty"`
+	ExternalIDGTE          *string  `json:"externalIDGTE,omitempty"`
+	ExternalIDLT           *string  `json:"externalIDLT,omitempty"`
+	ExternalIDLTE          *string  `json:"externalIDLTE,omitempty"`
+	ExternalIDContains     *string  `json:"externalIDContains,omitempty"`
+	ExternalIDHasPrefix    *string  `json:"externalIDHasPrefix,omitempty"`
+	ExternalIDHasSuffix    *string  `json:"externalIDHasSuffix,omitempty"`
+	ExternalIDIsNil        bool     `json:"externalIDIsNil,omitempty"`
+	ExternalIDNotNil       bool     `json:"externalIDNotNil,omitempty"`
+	ExternalIDEqualFold    *string  `json:"externalIDEqualFold,omitempty"`
+	ExternalIDContainsFold *string  `json:"externalIDContainsFold,omitempty"`
+
+	// "model_id" field predicates.
+	ModelID             *string  `json:"modelID,omitempty"`
+	ModelIDNEQ          *string  `json:"modelIDNEQ,omitempty"`
+	ModelIDIn           []string `json:"modelIDIn,omitempty"`
+	ModelIDNotIn        []string `json:"modelIDNotIn,omitempty"`
+	ModelIDGT           *string  `json:"modelIDGT,omitempty"`
+	ModelIDGTE          *string  `json:"modelIDGTE,omitempty"`
+	ModelIDLT           *string  `json:"modelIDLT,omitempty"`
+	ModelIDLTE          *string  `json:"modelIDLTE,omitempty"`
+	ModelIDContains     *string  `json:"modelIDContains,omitempty"`
+	ModelIDHasPrefix    *string  `json:"modelIDHasPrefix,omitempty"`
+	ModelIDHasSuffix    *string  `json:"modelIDHasSuffix,omitempty"`
+	ModelIDEqualFold    *string  `json:"modelIDEqualFold,omitempty"`
+	ModelIDContainsFold *string  `json:"modelIDContainsFold,omitempty"`
+
+	// "format" field predicates.
+	Format             *string  `json:"format,omitempty"`
+	FormatNEQ          *string  `json:"formatNEQ,omitempty"`
+	FormatIn           []string `json:"formatIn,omitempty"`
+	FormatNotIn        []string `json:"formatNotIn,omitempty"`
+	FormatGT           *string  `json:"formatGT,omitempty"`
+	FormatGTE          *string  `json:"formatGTE,omitempty"`
+	FormatLT           *string  `json:"formatLT,omitempty"`
+	FormatLTE          *string  `json:"formatLTE,omitempty"`
+	FormatContains     *string  `json:"formatContains,omitempty"`
+	FormatHasPrefix    *string  `json:"formatHasPrefix,omitempty"`
+	FormatHasSuffix    *string  `json:"formatHasSuffix,omitempty"`
+	FormatEqualFold    *string  `json:"formatEqualFold,omitempty"`
+	FormatContainsFold *string  `json:"formatContainsFold,omitempty"`
+
+	// "error_message" field predicates.
+	ErrorMessage             *string  `json:"errorMessage,omitempty"`
+	ErrorMessageNEQ          *string  `json:"errorMessageNEQ,omitempty"`
+	ErrorMessageIn           []string `json:"errorMessageIn,omitempty"`
+	ErrorMessageNotIn        []string `json:"errorMessageNotIn,omitempty"`
+	ErrorMessageGT           *string  `json:"errorMessageGT,omitempty"`
+	ErrorMessageGTE          *string  `json:"errorMessageGTE,omitempty"`
+	ErrorMessageLT           *string  `json:"errorMessageLT,omitempty"`
+	ErrorMessageLTE          *string  `json:"errorMessageLTE,omitempty"`
+	ErrorMessageContains     *string  `json:"errorMessageContains,omitempty"`
+	ErrorMessageHasPrefix    *string  `json:"errorMessageHasPrefix,omitempty"`
+	ErrorMessageHasSuffix    *string  `json:"errorMessageHasSuffix,omitempty"`
+	ErrorMessageIsNil        bool     `json:"errorMessageIsNil,omitempty"`
+	ErrorMessageNotNil       bool     `json:"errorMessageNotNil,omitempty"`
+	ErrorMessageEqualFold    *string  `json:"errorMessageEqualFold,omitempty"`
+	ErrorMessageContainsFold *string  `json:"errorMessageContainsFold,omitempty"`
+
+	// "response_status_code" field predicates.
+	ResponseStatusCode       *int  `json:"responseStatusCode,omitempty"`
+	ResponseStatusCodeNEQ    *int  `json:"responseStatusCodeNEQ,omitempty"`
+	ResponseStatusCodeIn     []int `json:"responseStatusCodeIn,omitempty"`
+	ResponseStatusCodeNotIn  []int `json:"responseStatusCodeNotIn,omitempty"`
+	ResponseStatusCodeGT     *int  `json:"responseStatusCodeGT,omitempty"`
+	ResponseStatusCodeGTE    *int  `json:"responseStatusCodeGTE,omitempty"`
+	ResponseStatusCodeLT     *int  `json:"responseStatusCodeLT,omitempty"`
+	ResponseStatusCodeLTE    *int  `json:"responseStatusCodeLTE,omitempty"`
+	ResponseStatusCodeIsNil  bool  `json:"responseStatusCodeIsNil,omitempty"`
+	ResponseStatusCodeNotNil bool  `json:"responseStatusCodeNotNil,omitempty"`
+
+	// "status" field predicates.
+	Status      *requestexecution.Status  `json:"status,omitempty"`
+	StatusNEQ   *requestexecution.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []requestexecution.Status `json:"statusIn,omitempty"`
+	StatusNotIn []requestexecution.Status `json:"statusNotIn,omitempty"`
+
+	// "stream" field predicates.
+	Stream    *bool `json:"stream,omitempty"`
+	StreamNEQ *bool `json:"streamNEQ,omitempty"`
+
+	// "metrics_latency_ms" field predicates.
+	MetricsLatencyMs       *int64  `json:"metricsLatencyMs,omitempty"`
+	MetricsLatencyMsNEQ    *int64  `json:"metricsLatencyMsNEQ,omitempty"`
+	MetricsLatencyMsIn     []int64 `json:"metricsLatencyMsIn,omitempty"`
+	MetricsLatencyMsNotIn  []int64 `json:"metricsLatencyMsNotIn,omitempty"`
+	MetricsLatencyMsGT     *int64  `json:"metricsLatencyMsGT,omitempty"`
+	MetricsLatencyMsGTE    *int64  `json:"metricsLatencyMsGTE,omitempty"`
+	MetricsLatencyMsLT     *int64  `json:"metricsLatencyMsLT,omitempty"`
+	MetricsLatencyMsLTE    *int64  `json:"metricsLatencyMsLTE,omitempty"`
+	MetricsLatencyMsIsNil  bool    `json:"metricsLatencyMsIsNil,omitempty"`
+	MetricsLatencyMsNotNil bool    `json:"metricsLatencyMsNotNil,omitempty"`
+
+	// "metrics_first_token_latency_ms" field predicates.
+	MetricsFirstTokenLatencyMs       *int64  `json:"metricsFirstTokenLatencyMs,omitempty"`
+	MetricsFirstTokenLatencyMsNEQ    *int64  `json:"metricsFirstTokenLatencyMsNEQ,omitempty"`
+	MetricsFirstTokenLatencyMsIn     []int64 `json:"metricsFirstTokenLatencyMsIn,omitempty"`
+	MetricsFirstTokenLatencyMsNotIn  []int64 `json:"metricsFirstTokenLatencyMsNotIn,omitempty"`
+	MetricsFirstTokenLatencyMsGT     *int64  `json:"metricsFirstTokenLatencyMsGT,omitempty"`
+	MetricsFirstTokenLatencyMsGTE    *int64  `json:"metricsFirstTokenLatencyMsGTE,omitempty"`
+	MetricsFirstTokenLatencyMsLT     *int64  `json:"metricsFirstTokenLatencyMsLT,omitempty"`
+	MetricsFirstTokenLatencyMsLTE    *int64  `json:"metricsFirstTokenLatencyMsLTE,omitempty"`
+	MetricsFirstTokenLatencyMsIsNil  bool    `json:"metricsFirstTokenLatencyMsIsNil,omitempty"`
+	MetricsFirstTokenLatencyMsNotNil bool    `json:"metricsFirstTokenLatencyMsNotNil,omitempty"`
+
+	// "metrics_reasoning_duration_ms" field predicates.
+	MetricsReasoningDurationMs       *int64  `json:"metricsReasoningDurationMs,omitempty"`
+	MetricsReasoningDurationMsNEQ    *int64  `json:"metricsReasoningDurationMsNEQ,omitempty"`
+	MetricsReasoningDurationMsIn     []int64 `json:"metricsReasoningDurationMsIn,omitempty"`
+	MetricsReasoningDurationMsNotIn  []int64 `json:"metricsReasoningDurationMsNotIn,omitempty"`
+	MetricsReasoningDurationMsGT     *int64  `json:"metricsReasoningDurationMsGT,omitempty"`
+	MetricsReasoningDurationMsGTE    *int64  `json:"metricsReasoningDurationMsGTE,omitempty"`
+	MetricsReasoningDurationMsLT     *int64  `json:"metricsReasoningDurationMsLT,omitempty"`
+	MetricsReasoningDurationMsLTE    *int64  `json:"metricsReasoningDurationMsLTE,omitempty"`
+	MetricsReasoningDurationMsIsNil  bool    `json:"metricsReasoningDurationMsIsNil,omitempty"`
+	MetricsReasoningDurationMsNotNil bool    `json:"metricsReasoningDurationMsNotNil,omitempty"`
+
+	// "request_url" field predicates.
+	RequestURL             *string  `json:"requestURL,omitempty"`
+	RequestURLNEQ          *string  `json:"requestURLNEQ,omitempty"`
+	RequestURLIn           []string `json:"requestURLIn,omitempty"`
+	RequestURLNotIn        []string `json:"requestURLNotIn,omitempty"`
+	RequestURLGT           *string  `json:"requestURLGT,omitempty"`
+	RequestURLGTE          *string  `json:"requestURLGTE,omitempty"`
+	RequestURLLT           *string  `json:"requestURLLT,omitempty"`
+	RequestURLLTE          *string  `json:"requestURLLTE,omitempty"`
+	RequestURLContains     *string  `json:"requestURLContains,omitempty"`
+	RequestURLHasPrefix    *string  `json:"requestURLHasPrefix,omitempty"`
+	RequestURLHasSuffix    *string  `json:"requestURLHasSuffix,omitempty"`
+	RequestURLIsNil        bool     `json:"requestURLIsNil,omitempty"`
+	RequestURLNotNil       bool     `json:"requestURLNotNil,omitempty"`
+	RequestURLEqualFold    *string  `json:"requestURLEqualFold,omitempty"`
+	RequestURLContainsFold *string  `json:"requestURLContainsFold,omitempty"`
+
+	// "pass_through_applied" field predicates.
+	PassThroughApplied    *bool `json:"passThroughApplied,omitempty"`
+	PassThroughAppliedNEQ *bool `json:"passThroughAppliedNEQ,omitempty"`
+
+	// "request" edge predicates.
+	HasRequest     *bool                `json:"hasRequest,omitempty"`
+	HasRequestWith []*RequestWhereInput `json:"hasRequestWith,omitempty"`
+
+	// "channel" edge predicates.
+	HasChannel     *bool                `json:"hasChannel,omitempty"`
+	HasChannelWith []*ChannelWhereInput `json:"hasChannelWith,omitempty"`
+
+	// "data_storage" edge predicates.
+	HasDataStorage     *bool                    `json:"hasDataStorage,omitempty"`
+	HasDataStorageWith []*DataStorageWhereInput `json:"hasDataStorageWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *RequestExecutionWhereInput) AddPredicates(predicates ...predicate.RequestExecution) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the RequestExecutionWhereInput filter on the RequestExecutionQuery builder.
+func (i *RequestExecutionWhereInput) Filter(q *RequestExecutionQuery) (*RequestExecutionQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyRequestExecutionWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyRequestExecutionWhereInput is returned in case the RequestExecutionWhereInput is empty.
+var ErrEmptyRequestExecutionWhereInput = errors.New("ent: empty predicate RequestExecutionWhereInput")
+
+// P returns a predicate for filtering requestexecutions.
+// An error is returned if the input is empty or invalid.
+func (i *RequestExecutionWhereInput) P() (predicate.RequestExecution, error) {
+	var predicates []predicate.RequestExecution
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, requestexecution.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.RequestExecution, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, requestexecution.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.RequestExecution, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, requestexecution.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, requestexecution.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, requestexecution.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, requestexecution.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, requestexecution.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, requestexecution.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, requestexecution.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, requestexecution.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, requestexecution.IDLTE(*i.IDLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, requestexecution.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, requestexecution.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, requestexecution.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, requestexecution.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, requestexecution.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, requestexecution.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, requestexecution.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, requestexecution.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, requestexecution.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, requestexecution.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, requestexecution.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, requestexecution.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, requestexecution.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, requestexecution.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, requestexecution.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, requestexecution.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.ProjectID != nil {
+		predicates = append(predicates, requestexecution.ProjectIDEQ(*i.ProjectID))
+	}
+	if i.ProjectIDNEQ != nil {
+		predicates = append(predicates, requestexecution.ProjectIDNEQ(*i.ProjectIDNEQ))
+	}
+	if len(i.ProjectIDIn) > 0 {
+		predicates = append(predicates, requestexecution.ProjectIDIn(i.ProjectIDIn...))
+	}
+	if len(i.ProjectIDNotIn) > 0 {
+		predicates = append(predicates, requestexecution.ProjectIDNotIn(i.ProjectIDNotIn...))
+	}
+	if i.ProjectIDGT != nil {
+		predicates = append(predicates, requestexecution.ProjectIDGT(*i.ProjectIDGT))
+	}
+	if i.ProjectIDGTE != nil {
+		predicates = append(predicates, requestexecution.ProjectIDGTE(*i.ProjectIDGTE))
+	}
+	if i.ProjectIDLT != nil {
+		predicates = append(predicates, requestexecution.ProjectIDLT(*i.ProjectIDLT))
+	}
+	if i.ProjectIDLTE != nil {
+		predicates = append(predicates, requestexecution.ProjectIDLTE(*i.ProjectIDLTE))
+	}
+	if i.RequestID != nil {
+		predicates = append(predicates, requestexecution.RequestIDEQ(*i.RequestID))
+	}
+	if i.RequestIDNEQ != nil {
+		predicates = append(predicates, requestexecution.RequestIDNEQ(*i.RequestIDNEQ))
+	}
+	if len(i.RequestIDIn) > 0 {
+		predicates = append(predicates, requestexecution.RequestIDIn(i.RequestIDIn...))
+	}
+	if len(i.RequestIDNotIn) > 0 {
+		predicates = append(predicates, requestexecution.RequestIDNotIn(i.RequestIDNotIn...))
+	}
+	if i.ChannelID != nil {
+		predicates = append(predicates, requestexecution.ChannelIDEQ(*i.ChannelID))
+	}
+	if i.ChannelIDNEQ != nil {
+		predicates = append(predicates, requestexecution.ChannelIDNEQ(*i.ChannelIDNEQ))
+	}
+	if len(i.ChannelIDIn) > 0 {
+		predicates = append(predicates, requestexecution.ChannelIDIn(i.ChannelIDIn...))
+	}
+	if len(i.ChannelIDNotIn) > 0 {
+		predicates = append(predicates, requestexecution.ChannelIDNotIn(i.ChannelIDNotIn...))
+	}
+	if i.ChannelIDIsNil {
+		predicates = append(predicates, requestexecution.ChannelIDIsNil())
+	}
+	if i.ChannelIDNotNil {
+		predicates = append(predicates, requestexecution.ChannelIDNotNil())
+	}
+	if i.DataStorageID != nil {
+		predicates = append(predicates, requestexecution.DataStorageIDEQ(*i.DataStorageID))
+	}
+	if i.DataStorageIDNEQ != nil {
+		predicates = append(predicates, requestexecution.DataStorageIDNEQ(*i.DataStorageIDNEQ))
+	}
+	if len(i.DataStorageIDIn) > 0 {
+		predicates = append(predicates, requestexecution.DataStorageIDIn(i.DataStorageIDIn...))
+	}
+	if len(i.DataStorageIDNotIn) > 0 {
+		predicates = append(predicates, requestexecution.DataStorageIDNotIn(i.DataStorageIDNotIn...))
+	}
+	if i.DataStorageIDIsNil {
+		predicates = append(predicates, requestexecution.DataStorageIDIsNil())
+	}
+	if i.DataStorageIDNotNil {
+		predicates = append(predicates, requestexecution.DataStorageIDNotNil())
+	}
+	if i.ExternalID != nil {
+		predicates = append(predicates, requestexecution.ExternalIDEQ(*i.ExternalID))
+	}
+	if i.ExternalIDNEQ != nil {
+		predicates = append(predicates, requestexecution.ExternalIDNEQ(*i.ExternalIDNEQ))
+	}
+	if len(i.ExternalIDIn) > 0 {
+		predicates = append(predicates, requestexecution.ExternalIDIn(i.ExternalIDIn...))
+	}
+	if len(i.ExternalIDNotIn) > 0 {
+		predicates = append(predicates, requestexecution.ExternalIDNotIn(i.ExternalIDNotIn...))
+	}
+	if i.ExternalIDGT != nil {
+		predicates = append(predicates, requestexecution.ExternalIDGT(*i.ExternalIDGT))
+	}
+	if i.ExternalIDGTE != nil {
+		predicates = append(predicates, requestexecution.ExternalIDGTE(*i.ExternalIDGTE))
+	}
+	if i.ExternalIDLT != nil {
+		predicates = append(predicates, requestexecution.ExternalIDLT(*i.ExternalIDLT))
+	}
+	if i.ExternalIDLTE != nil {
+		predicates = append(predicates, requestexecution.ExternalIDLTE(*i.ExternalIDLTE))
+	}
+	if i.ExternalIDContains != nil {
+		predicates = append(predicates, requestexecution.ExternalIDContains(*i.ExternalIDContains))
+	}
+	if i.ExternalIDHasPrefix != nil {
+		predicates = append(predicates, requestexecution.ExternalIDHasPrefix(*i.ExternalIDHasPrefix))
+	}
+	if i.ExternalIDHasSuffix != nil {
+		predicates = append(predicates, requestexecution.ExternalIDHasSuffix(*i.ExternalIDHasSuffix))
+	}
+	if i.ExternalIDIsNil {
+		predicates = append(predicates, requestexecution.ExternalIDIsNil())
+	}
+	if i.ExternalIDNotNil {
+		predicates = append(predicates, requestexecution.ExternalIDNotNil())
+	}
+	if i.ExternalIDEqualFold != nil {
+		predicates = append(predicates, requestexecution.ExternalIDEqualFold(*i.ExternalIDEqualFold))
+	}
+	if i.ExternalIDContainsFold != nil {
+		predicates = append(predicates, requestexecution.ExternalIDContainsFold(*i.ExternalIDContainsFold))
+	}
+	if i.ModelID != nil {
+		predicates = append(predicates, requestexecution.ModelIDEQ(*i.ModelID))
+	}
+	if i.ModelIDNEQ != nil {
+		predicates = append(predicates, requestexecution.ModelIDNEQ(*i.ModelIDNEQ))
+	}
+	if len(i.ModelIDIn) > 0 {
+		predicates = append(predicates, requestexecution.ModelIDIn(i.ModelIDIn...))
+	}
+	if len(i.ModelIDNotIn) > 0 {
+		predicates = append(predicates, requestexecution.ModelIDNotIn(i.ModelIDNotIn...))
+	}
+	if i.ModelIDGT != nil {
+		predicates = append(predicates, requestexecution.ModelIDGT(*i.ModelIDGT))
+	}
+	if i.ModelIDGTE != nil {
+		predicates = append(predicates, requestexecution.ModelIDGTE(*i.ModelIDGTE))
+	}
+	if i.ModelIDLT != nil {
+		predicates = append(predicates, requestexecution.ModelIDLT(*i.ModelIDLT))
+	}
+	if i.ModelIDLTE != nil {
+		predicates = append(predicates, requestexecution.ModelIDLTE(*i.ModelIDLTE))
+	}
+	if i.ModelIDContains != nil {
+		predicates = append(predicates, requestexecution.ModelIDContains(*i.ModelIDContains))
+	}
+	if i.ModelIDHasPrefix != nil {
+		predicates = append(predicates, requestexecution.ModelIDHasPrefix(*i.ModelIDHasPrefix))
+	}
+	if i.ModelIDHasSuffix != nil {
+		predicates = append(predicates, requestexecution.ModelIDHasSuffix(*i.ModelIDHasSuffix))
+	}
+	if i.ModelIDEqualFold != nil {
+		predicates = append(predicates, requestexecution.ModelIDEqualFold(*i.ModelIDEqualFold))
+	}
+	if i.ModelIDContainsFold != nil {
+		predicates = append(predicates, requestexecution.ModelIDContainsFold(*i.ModelIDContainsFold))
+	}
+	if i.Format != nil {
+		predicates = append(predicates, requestexecution.FormatEQ(*i.Format))
+	}
+	if i.FormatNEQ != nil {
+		predicates = append(predicates, requestexecution.FormatNEQ(*i.FormatNEQ))
+	}
+	if len(i.FormatIn) > 0 {
+		predicates = append(predicates, requestexecution.FormatIn(i.FormatIn...))
+	}
+	if len(i.FormatNotIn) > 0 {
+		predicates = append(predicates, requestexecution.FormatNotIn(i.FormatNotIn...))
+	}
+	if i.FormatGT != nil {
+		predicates = append(predicates, requestexecution.FormatGT(*i.FormatGT))
+	}
+	if i.FormatGTE != nil {
+		predicates = append(predicates, requestexecution.FormatGTE(*i.FormatGTE))
+	}
+	if i.FormatLT != nil {
+		predicates = append(predicates, requestexecution.FormatLT(*i.FormatLT))
+	}
+	if i.FormatLTE != nil {
+		predicates = append(predicates, requestexecution.FormatLTE(*i.FormatLTE))
+	}
+	if i.FormatContains != nil {
+		predicates = append(predicates, requestexecution.FormatContains(*i.FormatContains))
+	}
+	if i.FormatHasPrefix != nil {
+		predicates = append(predicates, requestexecution.FormatHasPrefix(*i.FormatHasPrefix))
+	}
+	if i.FormatHasSuffix != nil {
+		predicates = append(predicates, requestexecution.FormatHasSuffix(*i.FormatHasSuffix))
+	}
+	if i.FormatEqualFold != nil {
+		predicates = append(predicates, requestexecution.FormatEqualFold(*i.FormatEqualFold))
+	}
+	if i.FormatContainsFold != nil {
+		predicates = append(predicates, requestexecution.FormatContainsFold(*i.FormatContainsFold))
+	}
+	if i.ErrorMessage != nil {
+		predicates = append(predicates, requestexecution.ErrorMessageEQ(*i.ErrorMessage))
+	}
+	if i.ErrorMessageNEQ != nil {
+		predicates = append(predicates, requestexecution.ErrorMessageNEQ(*i.ErrorMessageNEQ))
+	}
+	if len(i.ErrorMessageIn) > 0 {
+		predicates = append(predicates, requestexecution.ErrorMessageIn(i.ErrorMessageIn...))
+	}
+	if len(i.ErrorMessageNotIn) > 0 {
+		predicates = append(predicates, requestexecution.ErrorMessageNotIn(i.ErrorMessageNotIn...))
+	}
+	if i.ErrorMessageGT != nil {
+		predicates = append(predicates, requestexecution.ErrorMessageGT(*i.ErrorMessageGT))
+	}
+	if i.ErrorMessageGTE != nil {
+		predicates = append(predicates, requestexecution.ErrorMessageGTE(*i.ErrorMessageGTE))
+	}
+	if i.ErrorMessageLT != nil {
+		predicates = append(predicates, requestexecution.ErrorMessageLT(*i.ErrorMessageLT))
+	}
+	if i.ErrorMessageLTE != nil {
+		predicates = append(predicates, requestexecution.ErrorMessageLTE(*i.ErrorMessageLTE))
+	}
+	if i.ErrorMessageContains != nil {
+		predicates = append(predicates, requestexecution.ErrorMessageContains(*i.ErrorMessageContains))
+	}
+	if i.ErrorMessageHasPrefix != nil {
+		predicates = append(predicates, requestexecution.ErrorMessageHasPrefix(*i.ErrorMessageHasPrefix))
+	}
+	if i.ErrorMessageHasSuffix != nil {
+		predicates = append(predicates, requestexecution.ErrorMessageHasSuffix(*i.ErrorMessageHasSuffix))
+	}
+	if i.ErrorMessageIsNil {
+		predicates = append(predicates, requestexecution.ErrorMessageIsNil())
+	}
+	if i.ErrorMessageNotNil {
+		predicates = append(predicates, requestexecution.ErrorMessageNotNil())
+	}
+	if i.ErrorMessageEqualFold != nil {
+		predicates = append(predicates, requestexecution.ErrorMessageEqualFold(*i.ErrorMessageEqualFold))
+	}
+	if i.ErrorMessageContainsFold != nil {
+		predicates = append(predicates, requestexecution.ErrorMessageContainsFold(*i.ErrorMessageContainsFold))
+	}
+	if i.ResponseStatusCode != nil {
+		predicates = append(predicates, requestexecution.ResponseStatusCodeEQ(*i.ResponseStatusCode))
+	}
+	if i.ResponseStatusCodeNEQ != nil {
+		predicates = append(predicates, requestexecution.ResponseStatusCodeNEQ(*i.ResponseStatusCodeNEQ))
+	}
+	if len(i.ResponseStatusCodeIn) > 0 {
+		predicates = append(predicates, requestexecution.ResponseStatusCodeIn(i.ResponseStatusCodeIn...))
+	}
+	if len(i.ResponseStatusCodeNotIn) > 0 {
+		predicates = append(predicates, requestexecution.ResponseStatusCodeNotIn(i.ResponseStatusCodeNotIn...))
+	}
+	if i.ResponseStatusCodeGT != nil {
+		predicates = append(predicates, requestexecution.ResponseStatusCodeGT(*i.ResponseStatusCodeGT))
+	}
+	if i.ResponseStatusCodeGTE != nil {
+		predicates = append(predicates, requestexecution.ResponseStatusCodeGTE(*i.ResponseStatusCodeGTE))
+	}
+	if i.ResponseStatusCodeLT != nil {
+		predicates = append(predicates, requestexecution.ResponseStatusCodeLT(*i.ResponseStatusCodeLT))
+	}
+	if i.ResponseStatusCodeLTE != nil {
+		predicates = append(predicates, requestexecution.ResponseStatusCodeLTE(*i.ResponseStatusCodeLTE))
+	}
+	if i.ResponseStatusCodeIsNil {
+		predicates = append(predicates, requestexecution.ResponseStatusCodeIsNil())
+	}
+	if i.ResponseStatusCodeNotNil {
+		predicates = append(predicates, requestexecution.ResponseStatusCodeNotNil())
+	}
+	if i.Status != nil {
+		predicates = append(predicates, requestexecution.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, requestexecution.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, requestexecution.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, requestexecution.StatusNotIn(i.StatusNotIn...))
+	}
+	if i.Stream != nil {
+		predicates = append(predicates, requestexecution.StreamEQ(*i.Stream))
+	}
+	if i.StreamNEQ != nil {
+		predicates = append(predicates, requestexecution.StreamNEQ(*i.StreamNEQ))
+	}
+	if i.MetricsLatencyMs != nil {
+		predicates = append(predicates, requestexecution.MetricsLatencyMsEQ(*i.MetricsLatencyMs))
+	}
+	if i.MetricsLatencyMsNEQ != nil {
+		predicates = append(predicates, requestexecution.MetricsLatencyMsNEQ(*i.MetricsLatencyMsNEQ))
+	}
+	if len(i.MetricsLatencyMsIn) > 0 {
+		predicates = append(predicates, requestexecution.MetricsLatencyMsIn(i.MetricsLatencyMsIn...))
+	}
+	if len(i.MetricsLatencyMsNotIn) > 0 {
+		predicates = append(predicates, requestexecution.MetricsLatencyMsNotIn(i.MetricsLatencyMsNotIn...))
 	}
-	if i.FormatGTE != nil {
-		predicates = append(predicates, request.FormatGTE(*i.FormatGTE))
+	if i.MetricsLatencyMsGT != nil {
+		predicates = append(predicates, requestexecution.MetricsLatencyMsGT(*i.MetricsLatencyMsGT))
 	}
-	if i.FormatLT != nil {
-		predicates = append(predicates, request.FormatLT(*i.FormatLT))
+	if i.MetricsLatencyMsGTE != nil {
+		predicates = append(predicates, requestexecution.MetricsLatencyMsGTE(*i.MetricsLatencyMsGTE))
 	}
-	if i.FormatLTE != nil {
-		predicates = append(predicates, request.FormatLTE(*i.FormatLTE))
+	if i.MetricsLatencyMsLT != nil {
+		predicates = append(predicates, requestexecution.MetricsLatencyMsLT(*i.MetricsLatencyMsLT))
 	}
-	if i.FormatContains != nil {
-		predicates = append(predicates, request.FormatContains(*i.FormatContains))
+	if i.MetricsLatencyMsLTE != nil {
+		predicates = append(predicates, requestexecution.MetricsLatencyMsLTE(*i.MetricsLatencyMsLTE))
 	}
-	if i.FormatHasPrefix != nil {
-		predicates = append(predicates, request.FormatHasPrefix(*i.FormatHasPrefix))
+	if i.MetricsLatencyMsIsNil {
+		predicates = append(predicates, requestexecution.MetricsLatencyMsIsNil())
 	}
-	if i.FormatHasSuffix != nil {
-		predicates = append(predicates, request.FormatHasSuffix(*i.FormatHasSuffix))
+	if i.MetricsLatencyMsNotNil {
+		predicates = append(predicates, requestexecution.MetricsLatencyMsNotNil())
 	}
-	if i.FormatEqualFold != nil {
-		predicates = append(predicates, request.FormatEqualFold(*i.FormatEqualFold))
+	if i.MetricsFirstTokenLatencyMs != nil {
+		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsEQ(*i.MetricsFirstTokenLatencyMs))
 	}
-	if i.FormatContainsFold != nil {
-		predicates = append(predicates, request.FormatContainsFold(*i.FormatContainsFold))
+	if i.MetricsFirstTokenLatencyMsNEQ != nil {
+		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsNEQ(*i.MetricsFirstTokenLatencyMsNEQ))
 	}
-	if i.ChannelID != nil {
-		predicates = append(predicates, request.ChannelIDEQ(*i.ChannelID))
+	if len(i.MetricsFirstTokenLatencyMsIn) > 0 {
+		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsIn(i.MetricsFirstTokenLatencyMsIn...))
 	}
-	if i.ChannelIDNEQ != nil {
-		predicates = append(predicates, request.ChannelIDNEQ(*i.ChannelIDNEQ))
+	if len(i.MetricsFirstTokenLatencyMsNotIn) > 0 {
+		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsNotIn(i.MetricsFirstTokenLatencyMsNotIn...))
 	}
-	if len(i.ChannelIDIn) > 0 {
-		predicates = append(predicates, request.ChannelIDIn(i.ChannelIDIn...))
+	if i.MetricsFirstTokenLatencyMsGT != nil {
+		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsGT(*i.MetricsFirstTokenLatencyMsGT))
 	}
-	if len(i.ChannelIDNotIn) > 0 {
-		predicates = append(predicates, request.ChannelIDNotIn(i.ChannelIDNotIn...))
+	if i.MetricsFirstTokenLatencyMsGTE != nil {
+		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsGTE(*i.MetricsFirstTokenLatencyMsGTE))
 	}
-	if i.ChannelIDIsNil {
-		predicates = append(predicates, request.ChannelIDIsNil())
+	if i.MetricsFirstTokenLatencyMsLT != nil {
+		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsLT(*i.MetricsFirstTokenLatencyMsLT))
 	}
-	if i.ChannelIDNotNil {
-		predicates = append(predicates, request.ChannelIDNotNil())
+	if i.MetricsFirstTokenLatencyMsLTE != nil {
+		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsLTE(*i.MetricsFirstTokenLatencyMsLTE))
 	}
-	if i.ExternalID != nil {
-		predicates = append(predicates, request.ExternalIDEQ(*i.ExternalID))
+	if i.MetricsFirstTokenLatencyMsIsNil {
+		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsIsNil())
 	}
-	if i.ExternalIDNEQ != nil {
-		predicates = append(predicates, request.ExternalIDNEQ(*i.ExternalIDNEQ))
+	if i.MetricsFirstTokenLatencyMsNotNil {
+		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsNotNil())
 	}
-	if len(i.ExternalIDIn) > 0 {
-		predicates = append(predicates, request.ExternalIDIn(i.ExternalIDIn...))
+	if i.MetricsReasoningDurationMs != nil {
+		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsEQ(*i.MetricsReasoningDurationMs))
 	}
-	if len(i.ExternalIDNotIn) > 0 {
-		predicates = append(predicates, request.ExternalIDNotIn(i.ExternalIDNotIn...))
+	if i.MetricsReasoningDurationMsNEQ != nil {
+		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsNEQ(*i.MetricsReasoningDurationMsNEQ))
 	}
-	if i.ExternalIDGT != nil {
-		predicates = append(predicates, request.ExternalIDGT(*i.ExternalIDGT))
+	if len(i.MetricsReasoningDurationMsIn) > 0 {
+		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsIn(i.MetricsReasoningDurationMsIn...))
+	}
+	if len(i.MetricsReasoningDurationMsNotIn) > 0 {
+		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsNotIn(i.MetricsReasoningDurationMsNotIn...))
+	}
+	if i.MetricsReasoningDurationMsGT != nil {
+		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsGT(*i.MetricsReasoningDurationMsGT))
+	}
+	if i.MetricsReasoningDurationMsGTE != nil {
+		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsGTE(*i.MetricsReasoningDurationMsGTE))
+	}
+	if i.MetricsReasoningDurationMsLT != nil {
+		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsLT(*i.MetricsReasoningDurationMsLT))
+	}
+	if i.MetricsReasoningDurationMsLTE != nil {
+		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsLTE(*i.MetricsReasoningDurationMsLTE))
+	}
+	if i.MetricsReasoningDurationMsIsNil {
+		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsIsNil())
+	}
+	if i.MetricsReasoningDurationMsNotNil {
+		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsNotNil())
+	}
+	if i.RequestURL != nil {
+		predicates = append(predicates, requestexecution.RequestURLEQ(*i.RequestURL))
+	}
+	if i.RequestURLNEQ != nil {
+		predicates = append(predicates, requestexecution.RequestURLNEQ(*i.RequestURLNEQ))
+	}
+	if len(i.RequestURLIn) > 0 {
+		predicates = append(predicates, requestexecution.RequestURLIn(i.RequestURLIn...))
+	}
+	if len(i.RequestURLNotIn) > 0 {
+		predicates = append(predicates, requestexecution.RequestURLNotIn(i.RequestURLNotIn...))
+	}
+	if i.RequestURLGT != nil {
+		predicates = append(predicates, requestexecution.RequestURLGT(*i.RequestURLGT))
+	}
+	if i.RequestURLGTE != nil {
+		predicates = append(predicates, requestexecution.RequestURLGTE(*i.RequestURLGTE))
+	}
+	if i.RequestURLLT != nil {
+		predicates = append(predicates, requestexecution.RequestURLLT(*i.RequestURLLT))
+	}
+	if i.RequestURLLTE != nil {
+		predicates = append(predicates, requestexecution.RequestURLLTE(*i.RequestURLLTE))
+	}
+	if i.RequestURLContains != nil {
+		predicates = append(predicates, requestexecution.RequestURLContains(*i.RequestURLContains))
+	}
+	if i.RequestURLHasPrefix != nil {
+		predicates = append(predicates, requestexecution.RequestURLHasPrefix(*i.RequestURLHasPrefix))
+	}
+	if i.RequestURLHasSuffix != nil {
+		predicates = append(predicates, requestexecution.RequestURLHasSuffix(*i.RequestURLHasSuffix))
+	}
+	if i.RequestURLIsNil {
+		predicates = append(predicates, requestexecution.RequestURLIsNil())
+	}
+	if i.RequestURLNotNil {
+		predicates = append(predicates, requestexecution.RequestURLNotNil())
+	}
+	if i.RequestURLEqualFold != nil {
+		predicates = append(predicates, requestexecution.RequestURLEqualFold(*i.RequestURLEqualFold))
+	}
+	if i.RequestURLContainsFold != nil {
+		predicates = append(predicates, requestexecution.RequestURLContainsFold(*i.RequestURLContainsFold))
+	}
+	if i.PassThroughApplied != nil {
+		predicates = append(predicates, requestexecution.PassThroughAppliedEQ(*i.PassThroughApplied))
+	}
+	if i.PassThroughAppliedNEQ != nil {
+		predicates = append(predicates, requestexecution.PassThroughAppliedNEQ(*i.PassThroughAppliedNEQ))
+	}
+
+	if i.HasRequest != nil {
+		p := requestexecution.HasRequest()
+		if !*i.HasRequest {
+			p = requestexecution.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasRequestWith) > 0 {
+		with := make([]predicate.Request, 0, len(i.HasRequestWith))
+		for _, w := range i.HasRequestWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRequestWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, requestexecution.HasRequestWith(with...))
+	}
+	if i.HasChannel != nil {
+		p := requestexecution.HasChannel()
+		if !*i.HasChannel {
+			p = requestexecution.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasChannelWith) > 0 {
+		with := make([]predicate.Channel, 0, len(i.HasChannelWith))
+		for _, w := range i.HasChannelWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasChannelWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, requestexecution.HasChannelWith(with...))
+	}
+	if i.HasDataStorage != nil {
+		p := requestexecution.HasDataStorage()
+		if !*i.HasDataStorage {
+			p = requestexecution.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasDataStorageWith) > 0 {
+		with := make([]predicate.DataStorage, 0, len(i.HasDataStorageWith))
+		for _, w := range i.HasDataStorageWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasDataStorageWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, requestexecution.HasDataStorageWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyRequestExecutionWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return requestexecution.And(predicates...), nil
+	}
+}
+
+// RoleWhereInput represents a where input for filtering Role queries.
+type RoleWhereInput struct {
+	Predicates []predicate.Role  `json:"-"`
+	Not        *RoleWhereInput   `json:"not,omitempty"`
+	Or         []*RoleWhereInput `json:"or,omitempty"`
+	And        []*RoleWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "level" field predicates.
+	Level      *role.Level  `json:"level,omitempty"`
+	LevelNEQ   *role.Level  `json:"levelNEQ,omitempty"`
+	LevelIn    []role.Level `json:"levelIn,omitempty"`
+	LevelNotIn []role.Level `json:"levelNotIn,omitempty"`
+
+	// "project_id" field predicates.
+	ProjectID       *int  `json:"projectID,omitempty"`
+	ProjectIDNEQ    *int  `json:"projectIDNEQ,omitempty"`
+	ProjectIDIn     []int `json:"projectIDIn,omitempty"`
+	ProjectIDNotIn  []int `json:"projectIDNotIn,omitempty"`
+	ProjectIDIsNil  bool  `json:"projectIDIsNil,omitempty"`
+	ProjectIDNotNil bool  `json:"projectIDNotNil,omitempty"`
+
+	// "users" edge predicates.
+	HasUsers     *bool             `json:"hasUsers,omitempty"`
+	HasUsersWith []*UserWhereInput `json:"hasUsersWith,omitempty"`
+
+	// "project" edge predicates.
+	HasProject     *bool                `json:"hasProject,omitempty"`
+	HasProjectWith []*ProjectWhereInput `json:"hasProjectWith,omitempty"`
+
+	// "user_roles" edge predicates.
+	HasUserRoles     *bool                 `json:"hasUserRoles,omitempty"`
+	HasUserRolesWith []*UserRoleWhereInput `json:"hasUserRolesWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *RoleWhereInput) AddPredicates(predicates ...predicate.Role) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the RoleWhereInput filter on the RoleQuery builder.
+func (i *RoleWhereInput) Filter(q *RoleQuery) (*RoleQuery, error) {
+	if i == nil {
+		return q, nil
 	}
-	if i.ExternalIDGTE != nil {
-		predicates = append(predicates, request.ExternalIDGTE(*i.ExternalIDGTE))
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyRoleWhereInput {
+			return q, nil
+		}
+		return nil, err
 	}
-	if i.ExternalIDLT != nil {
-		predicates = append(predicates, request.ExternalIDLT(*i.ExternalIDLT))
+	return q.Where(p), nil
+}
+
+// ErrEmptyRoleWhereInput is returned in case the RoleWhereInput is empty.
+var ErrEmptyRoleWhereInput = errors.New("ent: empty predicate RoleWhereInput")
+
+// P returns a predicate for filtering roles.
+// An error is returned if the input is empty or invalid.
+func (i *RoleWhereInput) P() (predicate.Role, error) {
+	var predicates []predicate.Role
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, role.Not(p))
 	}
-	if i.ExternalIDLTE != nil {
-		predicates = append(predicates, request.ExternalIDLTE(*i.ExternalIDLTE))
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Role, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, role.Or(or...))
 	}
-	if i.ExternalIDContains != nil {
-		predicates = append(predicates, request.ExternalIDContains(*i.ExternalIDContains))
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Role, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, role.And(and...))
 	}
-	if i.ExternalIDHasPrefix != nil {
-		predicates = append(predicates, request.ExternalIDHasPrefix(*i.ExternalIDHasPrefix))
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, role.IDEQ(*i.ID))
 	}
-	if i.ExternalIDHasSuffix != nil {
-		predicates = append(predicates, request.ExternalIDHasSuffix(*i.ExternalIDHasSuffix))
+	if i.IDNEQ != nil {
+		predicates = append(predicates, role.IDNEQ(*i.IDNEQ))
 	}
-	if i.ExternalIDIsNil {
-		predicates = append(predicates, request.ExternalIDIsNil())
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, role.IDIn(i.IDIn...))
 	}
-	if i.ExternalIDNotNil {
-		predicates = append(predicates, request.ExternalIDNotNil())
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, role.IDNotIn(i.IDNotIn...))
 	}
-	if i.ExternalIDEqualFold != nil {
-		predicates = append(predicates, request.ExternalIDEqualFold(*i.ExternalIDEqualFold))
+	if i.IDGT != nil {
+		predicates = append(predicates, role.IDGT(*i.IDGT))
 	}
-	if i.ExternalIDContainsFold != nil {
-		predicates = append(predicates, request.ExternalIDContainsFold(*i.ExternalIDContainsFold))
+	if i.IDGTE != nil {
+		predicates = append(predicates, role.IDGTE(*i.IDGTE))
 	}
-	if i.Status != nil {
-		predicates = append(predicates, request.StatusEQ(*i.Status))
+	if i.IDLT != nil {
+		predicates = append(predicates, role.IDLT(*i.IDLT))
 	}
-	if i.StatusNEQ != nil {
-		predicates = append(predicates, request.StatusNEQ(*i.StatusNEQ))
+	if i.IDLTE != nil {
+		predicates = append(predicates, role.IDLTE(*i.IDLTE))
 	}
-	if len(i.StatusIn) > 0 {
-		predicates = append(predicates, request.StatusIn(i.StatusIn...))
+	if i.CreatedAt != nil {
+		predicates = append(predicates, role.CreatedAtEQ(*i.CreatedAt))
 	}
-	if len(i.StatusNotIn) > 0 {
-		predicates = append(predicates, request.StatusNotIn(i.StatusNotIn...))
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, role.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if i.Stream != nil {
-		predicates = append(predicates, request.StreamEQ(*i.Stream))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, role.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if i.StreamNEQ != nil {
-		predicates = append(predicates, request.StreamNEQ(*i.StreamNEQ))
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, role.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if i.ClientIP != nil {
-		predicates = append(predicates, request.ClientIPEQ(*i.ClientIP))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, role.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.ClientIPNEQ != nil {
-		predicates = append(predicates, request.ClientIPNEQ(*i.ClientIPNEQ))
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, role.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if len(i.ClientIPIn) > 0 {
-		predicates = append(predicates, request.ClientIPIn(i.ClientIPIn...))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, role.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if len(i.ClientIPNotIn) > 0 {
-		predicates = append(predicates, request.ClientIPNotIn(i.ClientIPNotIn...))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, role.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.ClientIPGT != nil {
-		predicates = append(predicates, request.ClientIPGT(*i.ClientIPGT))
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, role.UpdatedAtEQ(*i.UpdatedAt))
 	}
-	if i.ClientIPGTE != nil {
-		predicates = append(predicates, request.ClientIPGTE(*i.ClientIPGTE))
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, role.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
-	if i.ClientIPLT != nil {
-		predicates = append(predicates, request.ClientIPLT(*i.ClientIPLT))
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, role.UpdatedAtIn(i.UpdatedAtIn...))
 	}
-	if i.ClientIPLTE != nil {
-		predicates = append(predicates, request.ClientIPLTE(*i.ClientIPLTE))
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, role.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
-	if i.ClientIPContains != nil {
-		predicates = append(predicates, request.ClientIPContains(*i.ClientIPContains))
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, role.UpdatedAtGT(*i.UpdatedAtGT))
 	}
-	if i.ClientIPHasPrefix != nil {
-		predicates = append(predicates, request.ClientIPHasPrefix(*i.ClientIPHasPrefix))
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, role.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
-	if i.ClientIPHasSuffix != nil {
-		predicates = append(predicates, request.ClientIPHasSuffix(*i.ClientIPHasSuffix))
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, role.UpdatedAtLT(*i.UpdatedAtLT))
 	}
-	if i.ClientIPEqualFold != nil {
-		predicates = append(predicates, request.ClientIPEqualFold(*i.ClientIPEqualFold))
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, role.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.ClientIPContainsFold != nil {
-		predicates = append(predicates, request.ClientIPContainsFold(*i.ClientIPContainsFold))
+	if i.Name != nil {
+		predicates = append(predicates, role.NameEQ(*i.Name))
 	}
-	if i.MetricsLatencyMs != nil {
-		predicates = append(predicates, request.MetricsLatencyMsEQ(*i.MetricsLatencyMs))
+	if i.NameNEQ != nil {
+		predicates = append(predicates, role.NameNEQ(*i.NameNEQ))
 	}
-	if i.MetricsLatencyMsNEQ != nil {
-		predicates = append(predicates, request.MetricsLatencyMsNEQ(*i.MetricsLatencyMsNEQ))
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, role.NameIn(i.NameIn...))
 	}
-	if len(i.MetricsLatencyMsIn) > 0 {
-		predicates = append(predicates, request.MetricsLatencyMsIn(i.MetricsLatencyMsIn...))
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, role.NameNotIn(i.NameNotIn...))
 	}
-	if len(i.MetricsLatencyMsNotIn) > 0 {
-		predicates = append(predicates, request.MetricsLatencyMsNotIn(i.MetricsLatencyMsNotIn...))
+	if i.NameGT != nil {
+		predicates = append(predicates, role.NameGT(*i.NameGT))
 	}
-	if i.MetricsLatencyMsGT != nil {
-		predicates = append(predicates, request.MetricsLatencyMsGT(*i.MetricsLatencyMsGT))
+	if i.NameGTE != nil {
+		predicates = append(predicates, role.NameGTE(*i.NameGTE))
 	}
-	if i.MetricsLatencyMsGTE != nil {
-		predicates = append(predicates, request.MetricsLatencyMsGTE(*i.MetricsLatencyMsGTE))
+	if i.NameLT != nil {
+		predicates = append(predicates, role.NameLT(*i.NameLT))
 	}
-	if i.MetricsLatencyMsLT != nil {
-		predicates = append(predicates, request.MetricsLatencyMsLT(*i.MetricsLatencyMsLT))
+	if i.NameLTE != nil {
+		predicates = append(predicates, role.NameLTE(*i.NameLTE))
 	}
-	if i.MetricsLatencyMsLTE != nil {
-		predicates = append(predicates, request.MetricsLatencyMsLTE(*i.MetricsLatencyMsLTE))
+	if i.NameContains != nil {
+		predicates = append(predicates, role.NameContains(*i.NameContains))
 	}
-	if i.MetricsLatencyMsIsNil {
-		predicates = append(predicates, request.MetricsLatencyMsIsNil())
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, role.NameHasPrefix(*i.NameHasPrefix))
 	}
-	if i.MetricsLatencyMsNotNil {
-		predicates = append(predicates, request.MetricsLatencyMsNotNil())
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, role.NameHasSuffix(*i.NameHasSuffix))
 	}
-	if i.MetricsFirstTokenLatencyMs != nil {
-		predicates = append(predicates, request.MetricsFirstTokenLatencyMsEQ(*i.MetricsFirstTokenLatencyMs))
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, role.NameEqualFold(*i.NameEqualFold))
 	}
-	if i.MetricsFirstTokenLatencyMsNEQ != nil {
-		predicates = append(predicates, request.MetricsFirstTokenLatencyMsNEQ(*i.MetricsFirstTokenLatencyMsNEQ))
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, role.NameContainsFold(*i.NameContainsFold))
 	}
-	if len(i.MetricsFirstTokenLatencyMsIn) > 0 {
-		predicates = append(predicates, request.MetricsFirstTokenLatencyMsIn(i.MetricsFirstTokenLatencyMsIn...))
+	if i.Level != nil {
+		predicates = append(predicates, role.LevelEQ(*i.Level))
 	}
-	if len(i.MetricsFirstTokenLatencyMsNotIn) > 0 {
-		predicates = append(predicates, request.MetricsFirstTokenLatencyMsNotIn(i.MetricsFirstTokenLatencyMsNotIn...))
+	if i.LevelNEQ != nil {
+		predicates = append(predicates, role.LevelNEQ(*i.LevelNEQ))
 	}
-	if i.MetricsFirstTokenLatencyMsGT != nil {
-		predicates = append(predicates, request.MetricsFirstTokenLatencyMsGT(*i.MetricsFirstTokenLatencyMsGT))
+	if len(i.LevelIn) > 0 {
+		predicates = append(predicates, role.LevelIn(i.LevelIn...))
 	}
-	if i.MetricsFirstTokenLatencyMsGTE != nil {
-		predicates = append(predicates, request.MetricsFirstTokenLatencyMsGTE(*i.MetricsFirstTokenLatencyMsGTE))
+	if len(i.LevelNotIn) > 0 {
+		predicates = append(predicates, role.LevelNotIn(i.LevelNotIn...))
 	}
-	if i.MetricsFirstTokenLatencyMsLT != nil {
-		predicates = append(predicates, request.MetricsFirstTokenLatencyMsLT(*i.MetricsFirstTokenLatencyMsLT))
+	if i.ProjectID != nil {
+		predicates = append(predicates, role.ProjectIDEQ(*i.ProjectID))
 	}
-	if i.MetricsFirstTokenLatencyMsLTE != nil {
-		predicates = append(predicates, request.MetricsFirstTokenLatencyMsLTE(*i.MetricsFirstTokenLatencyMsLTE))
+	if i.ProjectIDNEQ != nil {
+		predicates = append(predicates, role.ProjectIDNEQ(*i.ProjectIDNEQ))
 	}
-	if i.MetricsFirstTokenLatencyMsIsNil {
-		predicates = append(predicates, request.MetricsFirstTokenLatencyMsIsNil())
+	if len(i.ProjectIDIn) > 0 {
+		predicates = append(predicates, role.ProjectIDIn(i.ProjectIDIn...))
 	}
-	if i.MetricsFirstTokenLatencyMsNotNil {
-		predicates = append(predicates, request.MetricsFirstTokenLatencyMsNotNil())
+	if len(i.ProjectIDNotIn) > 0 {
+		predicates = append(predicates, role.ProjectIDNotIn(i.ProjectIDNotIn...))
 	}
-	if i.MetricsReasoningDurationMs != nil {
-		predicates = append(predicates, request.MetricsReasoningDurationMsEQ(*i.MetricsReasoningDurationMs))
+	if i.ProjectIDIsNil {
+		predicates = append(predicates, role.ProjectIDIsNil())
 	}
-	if i.MetricsReasoningDurationMsNEQ != nil {
-		predicates = append(predicates, request.MetricsReasoningDurationMsNEQ(*i.MetricsReasoningDurationMsNEQ))
+	if i.ProjectIDNotNil {
+		predicates = append(predicates, role.ProjectIDNotNil())
 	}
-	if len(i.MetricsReasoningDurationMsIn) > 0 {
-		predicates = append(predicates, request.MetricsReasoningDurationMsIn(i.MetricsReasoningDurationMsIn...))
+
+	if i.HasUsers != nil {
+		p := role.HasUsers()
+		if !*i.HasUsers {
+			p = role.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if len(i.MetricsReasoningDurationMsNotIn) > 0 {
-		predicates = append(predicates, request.MetricsReasoningDurationMsNotIn(i.MetricsReasoningDurationMsNotIn...))
+	if len(i.HasUsersWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUsersWith))
+		for _, w := range i.HasUsersWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUsersWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, role.HasUsersWith(with...))
 	}
-	if i.MetricsReasoningDurationMsGT != nil {
-		predicates = append(predicates, request.MetricsReasoningDurationMsGT(*i.MetricsReasoningDurationMsGT))
+	if i.HasProject != nil {
+		p := role.HasProject()
+		if !*i.HasProject {
+			p = role.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.MetricsReasoningDurationMsGTE != nil {
-		predicates = append(predicates, request.MetricsReasoningDurationMsGTE(*i.MetricsReasoningDurationMsGTE))
+	if len(i.HasProjectWith) > 0 {
+		with := make([]predicate.Project, 0, len(i.HasProjectWith))
+		for _, w := range i.HasProjectWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProjectWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, role.HasProjectWith(with...))
 	}
-	if i.MetricsReasoningDurationMsLT != nil {
-		predicates = append(predicates, request.MetricsReasoningDurationMsLT(*i.MetricsReasoningDurationMsLT))
+	if i.HasUserRoles != nil {
+		p := role.HasUserRoles()
+		if !*i.HasUserRoles {
+			p = role.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.MetricsReasoningDurationMsLTE != nil {
-		predicates = append(predicates, request.MetricsReasoningDurationMsLTE(*i.MetricsReasoningDurationMsLTE))
+	if len(i.HasUserRolesWith) > 0 {
+		with := make([]predicate.UserRole, 0, len(i.HasUserRolesWith))
+		for _, w := range i.HasUserRolesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUserRolesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, role.HasUserRolesWith(with...))
 	}
-	if i.MetricsReasoningDurationMsIsNil {
-		predicates = append(predicates, request.MetricsReasoningDurationMsIsNil())
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyRoleWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return role.And(predicates...), nil
 	}
-	if i.MetricsReasoningDurationMsNotNil {
-		predicates = append(predicates, request.MetricsReasoningDurationMsNotNil())
+}
+
+// SystemWhereInput represents a where input for filtering System queries.
+type SystemWhereInput struct {
+	Predicates []predicate.System  `json:"-"`
+	Not        *SystemWhereInput   `json:"not,omitempty"`
+	Or         []*SystemWhereInput `json:"or,omitempty"`
+	And        []*SystemWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "key" field predicates.
+	Key             *string  `json:"key,omitempty"`
+	KeyNEQ          *string  `json:"keyNEQ,omitempty"`
+	KeyIn           []string `json:"keyIn,omitempty"`
+	KeyNotIn        []string `json:"keyNotIn,omitempty"`
+	KeyGT           *string  `json:"keyGT,omitempty"`
+	KeyGTE          *string  `json:"keyGTE,omitempty"`
+	KeyLT           *string  `json:"keyLT,omitempty"`
+	KeyLTE          *string  `json:"keyLTE,omitempty"`
+	KeyContains     *string  `json:"keyContains,omitempty"`
+	KeyHasPrefix    *string  `json:"keyHasPrefix,omitempty"`
+	KeyHasSuffix    *string  `json:"keyHasSuffix,omitempty"`
+	KeyEqualFold    *string  `json:"keyEqualFold,omitempty"`
+	KeyContainsFold *string  `json:"keyContainsFold,omitempty"`
+
+	// "value" field predicates.
+	Value             *string  `json:"value,omitempty"`
+	ValueNEQ          *string  `json:"valueNEQ,omitempty"`
+	ValueIn           []string `json:"valueIn,omitempty"`
+	ValueNotIn        []string `json:"valueNotIn,omitempty"`
+	ValueGT           *string  `json:"valueGT,omitempty"`
+	ValueGTE          *string  `json:"valueGTE,omitempty"`
+	ValueLT           *string  `json:"valueLT,omitempty"`
+	ValueLTE          *string  `json:"valueLTE,omitempty"`
+	ValueContains     *string  `json:"valueContains,omitempty"`
+	ValueHasPrefix    *string  `json:"valueHasPrefix,omitempty"`
+	ValueHasSuffix    *string  `json:"valueHasSuffix,omitempty"`
+	ValueEqualFold    *string  `json:"valueEqualFold,omitempty"`
+	ValueContainsFold *string  `json:"valueContainsFold,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *SystemWhereInput) AddPredicates(predicates ...predicate.System) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the SystemWhereInput filter on the SystemQuery builder.
+func (i *SystemWhereInput) Filter(q *SystemQuery) (*SystemQuery, error) {
+	if i == nil {
+		return q, nil
 	}
-	if i.ContentSaved != nil {
-		predicates = append(predicates, request.ContentSavedEQ(*i.ContentSaved))
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptySystemWhereInput {
+			return q, nil
+		}
+		return nil, err
 	}
-	if i.ContentSavedNEQ != nil {
-		predicates = append(predicates, request.ContentSavedNEQ(*i.ContentSavedNEQ))
+	return q.Where(p), nil
+}
+
+// ErrEmptySystemWhereInput is returned in case the SystemWhereInput is empty.
+var ErrEmptySystemWhereInput = errors.New("ent: empty predicate SystemWhereInput")
+
+// P returns a predicate for filtering systems.
+// An error is returned if the input is empty or invalid.
+func (i *SystemWhereInput) P() (predicate.System, error) {
+	var predicates []predicate.System
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, system.Not(p))
 	}
-	if i.ContentStorageID != nil {
-		predicates = append(predicates, request.ContentStorageIDEQ(*i.ContentStorageID))
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.System, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, system.Or(or...))
 	}
-	if i.ContentStorageIDNEQ != nil {
-		predicates = append(predicates, request.ContentStorageIDNEQ(*i.ContentStorageIDNEQ))
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.System, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, system.And(and...))
 	}
-	if len(i.ContentStorageIDIn) > 0 {
-		predicates = append(predicates, request.ContentStorageIDIn(i.ContentStorageIDIn...))
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, system.IDEQ(*i.ID))
 	}
-	if len(i.ContentStorageIDNotIn) > 0 {
-		predicates = append(predicates, request.ContentStorageIDNotIn(i.ContentStorageIDNotIn...))
+	if i.IDNEQ != nil {
+		predicates = append(predicates, system.IDNEQ(*i.IDNEQ))
 	}
-	if i.ContentStorageIDGT != nil {
-		predicates = append(predicates, request.ContentStorageIDGT(*i.ContentStorageIDGT))
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, system.IDIn(i.IDIn...))
 	}
-	if i.ContentStorageIDGTE != nil {
-		predicates = append(predicates, request.ContentStorageIDGTE(*i.ContentStorageIDGTE))
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, system.IDNotIn(i.IDNotIn...))
 	}
-	if i.ContentStorageIDLT != nil {
-		predicates = append(predicates, request.ContentStorageIDLT(*i.ContentStorageIDLT))
+	if i.IDGT != nil {
+		predicates = append(predicates, system.IDGT(*i.IDGT))
 	}
-	if i.ContentStorageIDLTE != nil {
-		predicates = append(predicates, request.ContentStorageIDLTE(*i.ContentStorageIDLTE))
+	if i.IDGTE != nil {
+		predicates = append(predicates, system.IDGTE(*i.IDGTE))
 	}
-	if i.ContentStorageIDIsNil {
-		predicates = append(predicates, request.ContentStorageIDIsNil())
+	if i.IDLT != nil {
+		predicates = append(predicates, system.IDLT(*i.IDLT))
 	}
-	if i.ContentStorageIDNotNil {
-		predicates = append(predicates, request.ContentStorageIDNotNil())
+	if i.IDLTE != nil {
+		predicates = append(predicates, system.IDLTE(*i.IDLTE))
 	}
-	if i.ContentStorageKey != nil {
-		predicates = append(predicates, request.ContentStorageKeyEQ(*i.ContentStorageKey))
+	if i.CreatedAt != nil {
+		predicates = append(predicates, system.CreatedAtEQ(*i.CreatedAt))
 	}
-	if i.ContentStorageKeyNEQ != nil {
-		predicates = append(predicates, request.ContentStorageKeyNEQ(*i.ContentStorageKeyNEQ))
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, system.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if len(i.ContentStorageKeyIn) > 0 {
-		predicates = append(predicates, request.ContentStorageKeyIn(i.ContentStorageKeyIn...))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, system.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if len(i.ContentStorageKeyNotIn) > 0 {
-		predicates = append(predicates, request.ContentStorageKeyNotIn(i.ContentStorageKeyNotIn...))
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, system.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if i.ContentStorageKeyGT != nil {
-		predicates = append(predicates, request.ContentStorageKeyGT(*i.ContentStorageKeyGT))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, system.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.ContentStorageKeyGTE != nil {
-		predicates = append(predicates, request.ContentStorageKeyGTE(*i.ContentStorageKeyGTE))
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, system.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if i.ContentStorageKeyLT != nil {
-		predicates = append(predicates, request.ContentStorageKeyLT(*i.ContentStorageKeyLT))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, system.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if i.ContentStorageKeyLTE != nil {
-		predicates = append(predicates, request.ContentStorageKeyLTE(*i.ContentStorageKeyLTE))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, system.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.ContentStorageKeyContains != nil {
-		predicates = append(predicates, request.ContentStorageKeyContains(*i.ContentStorageKeyContains))
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, system.UpdatedAtEQ(*i.UpdatedAt))
 	}
-	if i.ContentStorageKeyHasPrefix != nil {
-		predicates = append(predicates, request.ContentStorageKeyHasPrefix(*i.ContentStorageKeyHasPrefix))
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, system.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
-	if i.ContentStorageKeyHasSuffix != nil {
-		predicates = append(predicates, request.ContentStorageKeyHasSuffix(*i.ContentStorageKeyHasSuffix))
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, system.UpdatedAtIn(i.UpdatedAtIn...))
 	}
-	if i.ContentStorageKeyIsNil {
-		predicates = append(predicates, request.ContentStorageKeyIsNil())
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, system.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
-	if i.ContentStorageKeyNotNil {
-		predicates = append(predicates, request.ContentStorageKeyNotNil())
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, system.UpdatedAtGT(*i.UpdatedAtGT))
 	}
-	if i.ContentStorageKeyEqualFold != nil {
-		predicates = append(predicates, request.ContentStorageKeyEqualFold(*i.ContentStorageKeyEqualFold))
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, system.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
-	if i.ContentStorageKeyContainsFold != nil {
-		predicates = append(predicates, request.ContentStorageKeyContainsFold(*i.ContentStorageKeyContainsFold))
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, system.UpdatedAtLT(*i.UpdatedAtLT))
 	}
-	if i.ContentSavedAt != nil {
-		predicates = append(predicates, request.ContentSavedAtEQ(*i.ContentSavedAt))
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, system.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.ContentSavedAtNEQ != nil {
-		predicates = append(predicates, request.ContentSavedAtNEQ(*i.ContentSavedAtNEQ))
+	if i.Key != nil {
+		predicates = append(predicates, system.KeyEQ(*i.Key))
 	}
-	if len(i.ContentSavedAtIn) > 0 {
-		predicates = append(predicates, request.ContentSavedAtIn(i.ContentSavedAtIn...))
+	if i.KeyNEQ != nil {
+		predicates = append(predicates, system.KeyNEQ(*i.KeyNEQ))
 	}
-	if len(i.ContentSavedAtNotIn) > 0 {
-		predicates = append(predicates, request.ContentSavedAtNotIn(i.ContentSavedAtNotIn...))
+	if len(i.KeyIn) > 0 {
+		predicates = append(predicates, system.KeyIn(i.KeyIn...))
 	}
-	if i.ContentSavedAtGT != nil {
-		predicates = append(predicates, request.ContentSavedAtGT(*i.ContentSavedAtGT))
+	if len(i.KeyNotIn) > 0 {
+		predicates = append(predicates, system.KeyNotIn(i.KeyNotIn...))
 	}
-	if i.ContentSavedAtGTE != nil {
-		predicates = append(predicates, request.ContentSavedAtGTE(*i.ContentSavedAtGTE))
+	if i.KeyGT != nil {
+		predicates = append(predicates, system.KeyGT(*i.KeyGT))
 	}
-	if i.ContentSavedAtLT != nil {
-		predicates = append(predicates, request.ContentSavedAtLT(*i.ContentSavedAtLT))
+	if i.KeyGTE != nil {
+		predicates = append(predicates, system.KeyGTE(*i.KeyGTE))
 	}
-	if i.ContentSavedAtLTE != nil {
-		predicates = append(predicates, request.ContentSavedAtLTE(*i.ContentSavedAtLTE))
+	if i.KeyLT != nil {
+		predicates = append(predicates, system.KeyLT(*i.KeyLT))
 	}
-	if i.ContentSavedAtIsNil {
-		predicates = append(predicates, request.ContentSavedAtIsNil())
+	if i.KeyLTE != nil {
+		predicates = append(predicates, system.KeyLTE(*i.KeyLTE))
 	}
-	if i.ContentSavedAtNotNil {
-		predicates = append(predicates, request.ContentSavedAtNotNil())
+	if i.KeyContains != nil {
+		predicates = append(predicates, system.KeyContains(*i.KeyContains))
 	}
-
-	if i.HasAPIKey != nil {
-		p := request.HasAPIKey()
-		if !*i.HasAPIKey {
-			p = request.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.KeyHasPrefix != nil {
+		predicates = append(predicates, system.KeyHasPrefix(*i.KeyHasPrefix))
 	}
-	if len(i.HasAPIKeyWith) > 0 {
-		with := make([]predicate.APIKey, 0, len(i.HasAPIKeyWith))
-		for _, w := range i.HasAPIKeyWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasAPIKeyWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, request.HasAPIKeyWith(with...))
+	if i.KeyHasSuffix != nil {
+		predicates = append(predicates, system.KeyHasSuffix(*i.KeyHasSuffix))
 	}
-	if i.HasProject != nil {
-		p := request.HasProject()
-		if !*i.HasProject {
-			p = request.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.KeyEqualFold != nil {
+		predicates = append(predicates, system.KeyEqualFold(*i.KeyEqualFold))
 	}
-	if len(i.HasProjectWith) > 0 {
-		with := make([]predicate.Project, 0, len(i.HasProjectWith))
-		for _, w := range i.HasProjectWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasProjectWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, request.HasProjectWith(with...))
+	if i.KeyContainsFold != nil {
+		predicates = append(predicates, system.KeyContainsFold(*i.KeyContainsFold))
 	}
-	if i.HasTrace != nil {
-		p := request.HasTrace()
-		if !*i.HasTrace {
-			p = request.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.Value != nil {
+		predicates = append(predicates, system.ValueEQ(*i.Value))
 	}
-	if len(i.HasTraceWith) > 0 {
-		with := make([]predicate.Trace, 0, len(i.HasTraceWith))
-		for _, w := range i.HasTraceWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasTraceWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, request.HasTraceWith(with...))
+	if i.ValueNEQ != nil {
+		predicates = append(predicates, system.ValueNEQ(*i.ValueNEQ))
 	}
-	if i.HasDataStorage != nil {
-		p := request.HasDataStorage()
-		if !*i.HasDataStorage {
-			p = request.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.ValueIn) > 0 {
+		predicates = append(predicates, system.ValueIn(i.ValueIn...))
 	}
-	if len(i.HasDataStorageWith) > 0 {
-		with := make([]predicate.DataStorage, 0, len(i.HasDataStorageWith))
-		for _, w := range i.HasDataStorageWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasDataStorageWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, request.HasDataStorageWith(with...))
+	if len(i.ValueNotIn) > 0 {
+		predicates = append(predicates, system.ValueNotIn(i.ValueNotIn...))
 	}
-	if i.HasExecutions != nil {
-		p := request.HasExecutions()
-		if !*i.HasExecutions {
-			p = request.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.ValueGT != nil {
+		predicates = append(predicates, system.ValueGT(*i.ValueGT))
 	}
-	if len(i.HasExecutionsWith) > 0 {
-		with := make([]predicate.RequestExecution, 0, len(i.HasExecutionsWith))
-		for _, w := range i.HasExecutionsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasExecutionsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, request.HasExecutionsWith(with...))
+	if i.ValueGTE != nil {
+		predicates = append(predicates, system.ValueGTE(*i.ValueGTE))
 	}
-	if i.HasChannel != nil {
-		p := request.HasChannel()
-		if !*i.HasChannel {
-			p = request.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.ValueLT != nil {
+		predicates = append(predicates, system.ValueLT(*i.ValueLT))
 	}
-	if len(i.HasChannelWith) > 0 {
-		with := make([]predicate.Channel, 0, len(i.HasChannelWith))
-		for _, w := range i.HasChannelWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasChannelWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, request.HasChannelWith(with...))
+	if i.ValueLTE != nil {
+		predicates = append(predicates, system.ValueLTE(*i.ValueLTE))
 	}
-	if i.HasUsageLogs != nil {
-		p := request.HasUsageLogs()
-		if !*i.HasUsageLogs {
-			p = request.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.ValueContains != nil {
+		predicates = append(predicates, system.ValueContains(*i.ValueContains))
 	}
-	if len(i.HasUsageLogsWith) > 0 {
-		with := make([]predicate.UsageLog, 0, len(i.HasUsageLogsWith))
-		for _, w := range i.HasUsageLogsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUsageLogsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, request.HasUsageLogsWith(with...))
+	if i.ValueHasPrefix != nil {
+		predicates = append(predicates, system.ValueHasPrefix(*i.ValueHasPrefix))
+	}
+	if i.ValueHasSuffix != nil {
+		predicates = append(predicates, system.ValueHasSuffix(*i.ValueHasSuffix))
+	}
+	if i.ValueEqualFold != nil {
+		predicates = append(predicates, system.ValueEqualFold(*i.ValueEqualFold))
 	}
+	if i.ValueContainsFold != nil {
+		predicates = append(predicates, system.ValueContainsFold(*i.ValueContainsFold))
+	}
+
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyRequestWhereInput
+		return nil, ErrEmptySystemWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return request.And(predicates...), nil
+		return system.And(predicates...), nil
 	}
 }
 
-// RequestExecutionWhereInput represents a where input for filtering RequestExecution queries.
-type RequestExecutionWhereInput struct {
-	Predicates []predicate.RequestExecution  `json:"-"`
-	Not        *RequestExecutionWhereInput   `json:"not,omitempty"`
-	Or         []*RequestExecutionWhereInput `json:"or,omitempty"`
-	And        []*RequestExecutionWhereInput `json:"and,omitempty"`
+// ThreadWhereInput represents a where input for filtering Thread queries.
+type ThreadWhereInput struct {
+	Predicates []predicate.Thread  `json:"-"`
+	Not        *ThreadWhereInput   `json:"not,omitempty"`
+	Or         []*ThreadWhereInput `json:"or,omitempty"`
+	And        []*ThreadWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -7514,202 +12064,50 @@ type RequestExecutionWhereInput struct {
 	ProjectIDNEQ   *int  `json:"projectIDNEQ,omitempty"`
 	ProjectIDIn    []int `json:"projectIDIn,omitempty"`
 	ProjectIDNotIn []int `json:"projectIDNotIn,omitempty"`
-	ProjectIDGT    *int  `json:"projectIDGT,omitempty"`
-	ProjectIDGTE   *int  `json:"projectIDGTE,omitempty"`
-	ProjectIDLT    *int  `json:"projectIDLT,omitempty"`
-	ProjectIDLTE   *int  `json:"projectIDLTE,omitempty"`
-
-	// "request_id" field predicates.
-	RequestID      *int  `json:"requestID,omitempty"`
-	RequestIDNEQ   *int  `json:"requestIDNEQ,omitempty"`
-	RequestIDIn    []int `json:"requestIDIn,omitempty"`
-	RequestIDNotIn []int `json:"requestIDNotIn,omitempty"`
-
-	// "channel_id" field predicates.
-	ChannelID       *int  `json:"channelID,omitempty"`
-	ChannelIDNEQ    *int  `json:"channelIDNEQ,omitempty"`
-	ChannelIDIn     []int `json:"channelIDIn,omitempty"`
-	ChannelIDNotIn  []int `json:"channelIDNotIn,omitempty"`
-	ChannelIDIsNil  bool  `json:"channelIDIsNil,omitempty"`
-	ChannelIDNotNil bool  `json:"channelIDNotNil,omitempty"`
-
-	// "data_storage_id" field predicates.
-	DataStorageID       *int  `json:"dataStorageID,omitempty"`
-	DataStorageIDNEQ    *int  `json:"dataStorageIDNEQ,omitempty"`
-	DataStorageIDIn     []int `json:"dataStorageIDIn,omitempty"`
-	DataStorageIDNotIn  []int `json:"dataStorageIDNotIn,omitempty"`
-	DataStorageIDIsNil  bool  `json:"dataStorageIDIsNil,omitempty"`
-	DataStorageIDNotNil bool  `json:"dataStorageIDNotNil,omitempty"`
-
-	// "external_id" field predicates.
-	ExternalID             *string  `json:"externalID,omitempty"`
-	ExternalIDNEQ          *string  `json:"externalIDNEQ,omitempty"`
-	ExternalIDIn           []string `json:"externalIDIn,omitempty"`
-	ExternalIDNotIn        []string `json:"externalIDNotIn,omitempty"`
-	ExternalIDGT           *string  `json:"externalIDGT,omitempty"`
-	ExternalIDGTE          *string  `json:"externalIDGTE,omitempty"`
-	ExternalIDLT           *string  `json:"externalIDLT,omitempty"`
-	ExternalIDLTE          *string  `json:"externalIDLTE,omitempty"`
-	ExternalIDContains     *string  `json:"externalIDContains,omitempty"`
-	ExternalIDHasPrefix    *string  `json:"externalIDHasPrefix,omitempty"`
-	ExternalIDHasSuffix    *string  `json:"externalIDHasSuffix,omitempty"`
-	ExternalIDIsNil        bool     `json:"externalIDIsNil,omitempty"`
-	ExternalIDNotNil       bool     `json:"externalIDNotNil,omitempty"`
-	ExternalIDEqualFold    *string  `json:"externalIDEqualFold,omitempty"`
-	ExternalIDContainsFold *string  `json:"externalIDContainsFold,omitempty"`
-
-	// "model_id" field predicates.
-	ModelID             *string  `json:"modelID,omitempty"`
-	ModelIDNEQ          *string  `json:"modelIDNEQ,omitempty"`
-	ModelIDIn           []string `json:"modelIDIn,omitempty"`
-	ModelIDNotIn        []string `json:"modelIDNotIn,omitempty"`
-	ModelIDGT           *string  `json:"modelIDGT,omitempty"`
-	ModelIDGTE          *string  `json:"modelIDGTE,omitempty"`
-	ModelIDLT           *string  `json:"modelIDLT,omitempty"`
-	ModelIDLTE          *string  `json:"modelIDLTE,omitempty"`
-	ModelIDContains     *string  `json:"modelIDContains,omitempty"`
-	ModelIDHasPrefix    *string  `json:"modelIDHasPrefix,omitempty"`
-	ModelIDHasSuffix    *string  `json:"modelIDHasSuffix,omitempty"`
-	ModelIDEqualFold    *string  `json:"modelIDEqualFold,omitempty"`
-	ModelIDContainsFold *string  `json:"modelIDContainsFold,omitempty"`
-
-	// "format" field predicates.
-	Format             *string  `json:"format,omitempty"`
-	FormatNEQ          *string  `json:"formatNEQ,omitempty"`
-	FormatIn           []string `json:"formatIn,omitempty"`
-	FormatNotIn        []string `json:"formatNotIn,omitempty"`
-	FormatGT           *string  `json:"formatGT,omitempty"`
-	FormatGTE          *string  `json:"formatGTE,omitempty"`
-	FormatLT           *string  `json:"formatLT,omitempty"`
-	FormatLTE          *string  `json:"formatLTE,omitempty"`
-	FormatContains     *string  `json:"formatContains,omitempty"`
-	FormatHasPrefix    *string  `json:"formatHasPrefix,omitempty"`
-	FormatHasSuffix    *string  `json:"formatHasSuffix,omitempty"`
-	FormatEqualFold    *string  `json:"formatEqualFold,omitempty"`
-	FormatContainsFold *string  `json:"formatContainsFold,omitempty"`
-
-	// "error_message" field predicates.
-	ErrorMessage             *string  `json:"errorMessage,omitempty"`
-	ErrorMessageNEQ          *string  `json:"errorMessageNEQ,omitempty"`
-	ErrorMessageIn           []string `json:"errorMessageIn,omitempty"`
-	ErrorMessageNotIn        []string `json:"errorMessageNotIn,omitempty"`
-	ErrorMessageGT           *string  `json:"errorMessageGT,omitempty"`
-	ErrorMessageGTE          *string  `json:"errorMessageGTE,omitempty"`
-	ErrorMessageLT           *string  `json:"errorMessageLT,omitempty"`
-	ErrorMessageLTE          *string  `json:"errorMessageLTE,omitempty"`
-	ErrorMessageContains     *string  `json:"errorMessageContains,omitempty"`
-	ErrorMessageHasPrefix    *string  `json:"errorMessageHasPrefix,omitempty"`
-	ErrorMessageHasSuffix    *string  `json:"errorMessageHasSuffix,omitempty"`
-	ErrorMessageIsNil        bool     `json:"errorMessageIsNil,omitempty"`
-	ErrorMessageNotNil       bool     `json:"errorMessageNotNil,omitempty"`
-	ErrorMessageEqualFold    *string  `json:"errorMessageEqualFold,omitempty"`
-	ErrorMessageContainsFold *string  `json:"errorMessageContainsFold,omitempty"`
 
-	// "response_status_code" field predicates.
-	ResponseStatusCode       *int  `json:"responseStatusCode,omitempty"`
-	ResponseStatusCodeNEQ    *int  `json:"responseStatusCodeNEQ,omitempty"`
-	ResponseStatusCodeIn     []int `json:"responseStatusCodeIn,omitempty"`
-	ResponseStatusCodeNotIn  []int `json:"responseStatusCodeNotIn,omitempty"`
-	ResponseStatusCodeGT     *int  `json:"responseStatusCodeGT,omitempty"`
-	ResponseStatusCodeGTE    *int  `json:"responseStatusCodeGTE,omitempty"`
-	ResponseStatusCodeLT     *int  `json:"responseStatusCodeLT,omitempty"`
-	ResponseStatusCodeLTE    *int  `json:"responseStatusCodeLTE,omitempty"`
-	ResponseStatusCodeIsNil  bool  `json:"responseStatusCodeIsNil,omitempty"`
-	ResponseStatusCodeNotNil bool  `json:"responseStatusCodeNotNil,omitempty"`
+	// "thread_id" field predicates.
+	ThreadID             *string  `json:"threadID,omitempty"`
+	ThreadIDNEQ          *string  `json:"threadIDNEQ,omitempty"`
+	ThreadIDIn           []string `json:"threadIDIn,omitempty"`
+	ThreadIDNotIn        []string `json:"threadIDNotIn,omitempty"`
+	ThreadIDGT           *string  `json:"threadIDGT,omitempty"`
+	ThreadIDGTE          *string  `json:"threadIDGTE,omitempty"`
+	ThreadIDLT           *string  `json:"threadIDLT,omitempty"`
+	ThreadIDLTE          *string  `json:"threadIDLTE,omitempty"`
+	ThreadIDContains     *string  `json:"threadIDContains,omitempty"`
+	ThreadIDHasPrefix    *string  `json:"threadIDHasPrefix,omitempty"`
+	ThreadIDHasSuffix    *string  `json:"threadIDHasSuffix,omitempty"`
+	ThreadIDEqualFold    *string  `json:"threadIDEqualFold,omitempty"`
+	ThreadIDContainsFold *string  `json:"threadIDContainsFold,omitempty"`
 
 	// "status" field predicates.
-	Status      *requestexecution.Status  `json:"status,omitempty"`
-	StatusNEQ   *requestexecution.Status  `json:"statusNEQ,omitempty"`
-	StatusIn    []requestexecution.Status `json:"statusIn,omitempty"`
-	StatusNotIn []requestexecution.Status `json:"statusNotIn,omitempty"`
-
-	// "stream" field predicates.
-	Stream    *bool `json:"stream,omitempty"`
-	StreamNEQ *bool `json:"streamNEQ,omitempty"`
-
-	// "metrics_latency_ms" field predicates.
-	MetricsLatencyMs       *int64  `json:"metricsLatencyMs,omitempty"`
-	MetricsLatencyMsNEQ    *int64  `json:"metricsLatencyMsNEQ,omitempty"`
-	MetricsLatencyMsIn     []int64 `json:"metricsLatencyMsIn,omitempty"`
-	MetricsLatencyMsNotIn  []int64 `json:"metricsLatencyMsNotIn,omitempty"`
-	MetricsLatencyMsGT     *int64  `json:"metricsLatencyMsGT,omitempty"`
-	MetricsLatencyMsGTE    *int64  `json:"metricsLatencyMsGTE,omitempty"`
-	MetricsLatencyMsLT     *int64  `json:"metricsLatencyMsLT,omitempty"`
-	MetricsLatencyMsLTE    *int64  `json:"metricsLatencyMsLTE,omitempty"`
-	MetricsLatencyMsIsNil  bool    `json:"metricsLatencyMsIsNil,omitempty"`
-	MetricsLatencyMsNotNil bool    `json:"metricsLatencyMsNotNil,omitempty"`
-
-	// "metrics_first_token_latency_ms" field predicates.
-	MetricsFirstTokenLatencyMs       *int64  `json:"metricsFirstTokenLatencyMs,omitempty"`
-	MetricsFirstTokenLatencyMsNEQ    *int64  `json:"metricsFirstTokenLatencyMsNEQ,omitempty"`
-	MetricsFirstTokenLatencyMsIn     []int64 `json:"metricsFirstTokenLatencyMsIn,omitempty"`
-	MetricsFirstTokenLatencyMsNotIn  []int64 `json:"metricsFirstTokenLatencyMsNotIn,omitempty"`
-	MetricsFirstTokenLatencyMsGT     *int64  `json:"metricsFirstTokenLatencyMsGT,omitempty"`
-	MetricsFirstTokenLatencyMsGTE    *int64  `json:"metricsFirstTokenLatencyMsGTE,omitempty"`
-	MetricsFirstTokenLatencyMsLT     *int64  `json:"metricsFirstTokenLatencyMsLT,omitempty"`
-	MetricsFirstTokenLatencyMsLTE    *int64  `json:"metricsFirstTokenLatencyMsLTE,omitempty"`
-	MetricsFirstTokenLatencyMsIsNil  bool    `json:"metricsFirstTokenLatencyMsIsNil,omitempty"`
-	MetricsFirstTokenLatencyMsNotNil bool    `json:"metricsFirstTokenLatencyMsNotNil,omitempty"`
-
-	// "metrics_reasoning_duration_ms" field predicates.
-	MetricsReasoningDurationMs       *int64  `json:"metricsReasoningDurationMs,omitempty"`
-	MetricsReasoningDurationMsNEQ    *int64  `json:"metricsReasoningDurationMsNEQ,omitempty"`
-	MetricsReasoningDurationMsIn     []int64 `json:"metricsReasoningDurationMsIn,omitempty"`
-	MetricsReasoningDurationMsNotIn  []int64 `json:"metricsReasoningDurationMsNotIn,omitempty"`
-	MetricsReasoningDurationMsGT     *int64  `json:"metricsReasoningDurationMsGT,omitempty"`
-	MetricsReasoningDurationMsGTE    *int64  `json:"metricsReasoningDurationMsGTE,omitempty"`
-	MetricsReasoningDurationMsLT     *int64  `json:"metricsReasoningDurationMsLT,omitempty"`
-	MetricsReasoningDurationMsLTE    *int64  `json:"metricsReasoningDurationMsLTE,omitempty"`
-	MetricsReasoningDurationMsIsNil  bool    `json:"metricsReasoningDurationMsIsNil,omitempty"`
-	MetricsReasoningDurationMsNotNil bool    `json:"metricsReasoningDurationMsNotNil,omitempty"`
-
-	// "request_url" field predicates.
-	RequestURL             *string  `json:"requestURL,omitempty"`
-	RequestURLNEQ          *string  `json:"requestURLNEQ,omitempty"`
-	RequestURLIn           []string `json:"requestURLIn,omitempty"`
-	RequestURLNotIn        []string `json:"requestURLNotIn,omitempty"`
-	RequestURLGT           *string  `json:"requestURLGT,omitempty"`
-	RequestURLGTE          *string  `json:"requestURLGTE,omitempty"`
-	RequestURLLT           *string  `json:"requestURLLT,omitempty"`
-	RequestURLLTE          *string  `json:"requestURLLTE,omitempty"`
-	RequestURLContains     *string  `json:"requestURLContains,omitempty"`
-	RequestURLHasPrefix    *string  `json:"requestURLHasPrefix,omitempty"`
-	RequestURLHasSuffix    *string  `json:"requestURLHasSuffix,omitempty"`
-	RequestURLIsNil        bool     `json:"requestURLIsNil,omitempty"`
-	RequestURLNotNil       bool     `json:"requestURLNotNil,omitempty"`
-	RequestURLEqualFold    *string  `json:"requestURLEqualFold,omitempty"`
-	RequestURLContainsFold *string  `json:"requestURLContainsFold,omitempty"`
-
-	// "pass_through_applied" field predicates.
-	PassThroughApplied    *bool `json:"passThroughApplied,omitempty"`
-	PassThroughAppliedNEQ *bool `json:"passThroughAppliedNEQ,omitempty"`
-
-	// "request" edge predicates.
-	HasRequest     *bool                `json:"hasRequest,omitempty"`
-	HasRequestWith []*RequestWhereInput `json:"hasRequestWith,omitempty"`
+	Status      *thread.Status  `json:"status,omitempty"`
+	StatusNEQ   *thread.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []thread.Status `json:"statusIn,omitempty"`
+	StatusNotIn []thread.Status `json:"statusNotIn,omitempty"`
 
-	// "channel" edge predicates.
-	HasChannel     *bool                `json:"hasChannel,omitempty"`
-	HasChannelWith []*ChannelWhereInput `json:"hasChannelWith,omitempty"`
+	// "project" edge predicates.
+	HasProject     *bool                `json:"hasProject,omitempty"`
+	HasProjectWith []*ProjectWhereInput `json:"hasProjectWith,omitempty"`
 
-	// "data_storage" edge predicates.
-	HasDataStorage     *bool                    `json:"hasDataStorage,omitempty"`
-	HasDataStorageWith []*DataStorageWhereInput `json:"hasDataStorageWith,omitempty"`
+	// "traces" edge predicates.
+	HasTraces     *bool              `json:"hasTraces,omitempty"`
+	HasTracesWith []*TraceWhereInput `json:"hasTracesWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *RequestExecutionWhereInput) AddPredicates(predicates ...predicate.RequestExecution) {
+func (i *ThreadWhereInput) AddPredicates(predicates ...predicate.Thread) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the RequestExecutionWhereInput filter on the RequestExecutionQuery builder.
-func (i *RequestExecutionWhereInput) Filter(q *RequestExecutionQuery) (*RequestExecutionQuery, error) {
+// Filter applies the ThreadWhereInput filter on the ThreadQuery builder.
+func (i *ThreadWhereInput) Filter(q *ThreadQuery) (*ThreadQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyRequestExecutionWhereInput {
+		if err == ErrEmptyThreadWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -7717,19 +12115,19 @@ func (i *RequestExecutionWhereInput) Filter(q *RequestExecutionQuery) (*RequestE
 	return q.Where(p), nil
 }
 
-// ErrEmptyRequestExecutionWhereInput is returned in case the RequestExecutionWhereInput is empty.
-var ErrEmptyRequestExecutionWhereInput = errors.New("ent: empty predicate RequestExecutionWhereInput")
+// ErrEmptyThreadWhereInput is returned in case the ThreadWhereInput is empty.
+var ErrEmptyThreadWhereInput = errors.New("ent: empty predicate ThreadWhereInput")
 
-// P returns a predicate for filtering requestexecutions.
+// P returns a predicate for filtering threads.
 // An error is returned if the input is empty or invalid.
-func (i *RequestExecutionWhereInput) P() (predicate.RequestExecution, error) {
-	var predicates []predicate.RequestExecution
+func (i *ThreadWhereInput) P() (predicate.Thread, error) {
+	var predicates []predicate.Thread
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, requestexecution.Not(p))
+		predicates = append(predicates, thread.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -7739,7 +12137,7 @@ func (i *RequestExecutionWhereInput) P() (predicate.RequestExecution, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.RequestExecution, 0, n)
+		or := make([]predicate.Thread, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -7747,7 +12145,7 @@ func (i *RequestExecutionWhereInput) P() (predicate.RequestExecution, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, requestexecution.Or(or...))
+		predicates = append(predicates, thread.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -7757,7 +12155,7 @@ func (i *RequestExecutionWhereInput) P() (predicate.RequestExecution, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.RequestExecution, 0, n)
+		and := make([]predicate.Thread, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -7765,581 +12163,571 @@ func (i *RequestExecutionWhereInput) P() (predicate.RequestExecution, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, requestexecution.And(and...))
+		predicates = append(predicates, thread.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, requestexecution.IDEQ(*i.ID))
+		predicates = append(predicates, thread.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, requestexecution.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, thread.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, requestexecution.IDIn(i.IDIn...))
+		predicates = append(predicates, thread.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, requestexecution.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, thread.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, requestexecution.IDGT(*i.IDGT))
+		predicates = append(predicates, thread.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, requestexecution.IDGTE(*i.IDGTE))
+		predicates = append(predicates, thread.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, requestexecution.IDLT(*i.IDLT))
+		predicates = append(predicates, thread.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, requestexecution.IDLTE(*i.IDLTE))
+		predicates = append(predicates, thread.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, requestexecution.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, thread.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, requestexecution.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, thread.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, requestexecution.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, thread.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, requestexecution.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, thread.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, requestexecution.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, thread.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, requestexecution.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, thread.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, requestexecution.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, thread.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, requestexecution.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, thread.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, requestexecution.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, thread.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, requestexecution.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, thread.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, requestexecution.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, thread.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, requestexecution.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, thread.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, requestexecution.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, thread.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, requestexecution.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, thread.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, requestexecution.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, thread.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, requestexecution.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, thread.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
 	if i.ProjectID != nil {
-		predicates = append(predicates, requestexecution.ProjectIDEQ(*i.ProjectID))
-	}
-	if i.ProjectIDNEQ != nil {
-		predicates = append(predicates, requestexecution.ProjectIDNEQ(*i.ProjectIDNEQ))
-	}
-	if len(i.ProjectIDIn) > 0 {
-		predicates = append(predicates, requestexecution.ProjectIDIn(i.ProjectIDIn...))
-	}
-	if len(i.ProjectIDNotIn) > 0 {
-		predicates = append(predicates, requestexecution.ProjectIDNotIn(i.ProjectIDNotIn...))
-	}
-	if i.ProjectIDGT != nil {
-		predicates = append(predicates, requestexecution.ProjectIDGT(*i.ProjectIDGT))
-	}
-	if i.ProjectIDGTE != nil {
-		predicates = append(predicates, requestexecution.ProjectIDGTE(*i.ProjectIDGTE))
-	}
-	if i.ProjectIDLT != nil {
-		predicates = append(predicates, requestexecution.ProjectIDLT(*i.ProjectIDLT))
-	}
-	if i.ProjectIDLTE != nil {
-		predicates = append(predicates, requestexecution.ProjectIDLTE(*i.ProjectIDLTE))
-	}
-	if i.RequestID != nil {
-		predicates = append(predicates, requestexecution.RequestIDEQ(*i.RequestID))
-	}
-	if i.RequestIDNEQ != nil {
-		predicates = append(predicates, requestexecution.RequestIDNEQ(*i.RequestIDNEQ))
-	}
-	if len(i.RequestIDIn) > 0 {
-		predicates = append(predicates, requestexecution.RequestIDIn(i.RequestIDIn...))
-	}
-	if len(i.RequestIDNotIn) > 0 {
-		predicates = append(predicates, requestexecution.RequestIDNotIn(i.RequestIDNotIn...))
-	}
-	if i.ChannelID != nil {
-		predicates = append(predicates, requestexecution.ChannelIDEQ(*i.ChannelID))
-	}
-	if i.ChannelIDNEQ != nil {
-		predicates = append(predicates, requestexecution.ChannelIDNEQ(*i.ChannelIDNEQ))
-	}
-	if len(i.ChannelIDIn) > 0 {
-		predicates = append(predicates, requestexecution.ChannelIDIn(i.ChannelIDIn...))
-	}
-	if len(i.ChannelIDNotIn) > 0 {
-		predicates = append(predicates, requestexecution.ChannelIDNotIn(i.ChannelIDNotIn...))
-	}
-	if i.ChannelIDIsNil {
-		predicates = append(predicates, requestexecution.ChannelIDIsNil())
-	}
-	if i.ChannelIDNotNil {
-		predicates = append(predicates, requestexecution.ChannelIDNotNil())
-	}
-	if i.DataStorageID != nil {
-		predicates = append(predicates, requestexecution.DataStorageIDEQ(*i.DataStorageID))
-	}
-	if i.DataStorageIDNEQ != nil {
-		predicates = append(predicates, requestexecution.DataStorageIDNEQ(*i.DataStorageIDNEQ))
-	}
-	if len(i.DataStorageIDIn) > 0 {
-		predicates = append(predicates, requestexecution.DataStorageIDIn(i.DataStorageIDIn...))
-	}
-	if len(i.DataStorageIDNotIn) > 0 {
-		predicates = append(predicates, requestexecution.DataStorageIDNotIn(i.DataStorageIDNotIn...))
-	}
-	if i.DataStorageIDIsNil {
-		predicates = append(predicates, requestexecution.DataStorageIDIsNil())
-	}
-	if i.DataStorageIDNotNil {
-		predicates = append(predicates, requestexecution.DataStorageIDNotNil())
-	}
-	if i.ExternalID != nil {
-		predicates = append(predicates, requestexecution.ExternalIDEQ(*i.ExternalID))
-	}
-	if i.ExternalIDNEQ != nil {
-		predicates = append(predicates, requestexecution.ExternalIDNEQ(*i.ExternalIDNEQ))
-	}
-	if len(i.ExternalIDIn) > 0 {
-		predicates = append(predicates, requestexecution.ExternalIDIn(i.ExternalIDIn...))
-	}
-	if len(i.ExternalIDNotIn) > 0 {
-		predicates = append(predicates, requestexecution.ExternalIDNotIn(i.ExternalIDNotIn...))
-	}
-	if i.ExternalIDGT != nil {
-		predicates = append(predicates, requestexecution.ExternalIDGT(*i.ExternalIDGT))
-	}
-	if i.ExternalIDGTE != nil {
-		predicates = append(predicates, requestexecution.ExternalIDGTE(*i.ExternalIDGTE))
-	}
-	if i.ExternalIDLT != nil {
-		predicates = append(predicates, requestexecution.ExternalIDLT(*i.ExternalIDLT))
-	}
-	if i.ExternalIDLTE != nil {
-		predicates = append(predicates, requestexecution.ExternalIDLTE(*i.ExternalIDLTE))
-	}
-	if i.ExternalIDContains != nil {
-		predicates = append(predicates, requestexecution.ExternalIDContains(*i.ExternalIDContains))
-	}
-	if i.ExternalIDHasPrefix != nil {
-		predicates = append(predicates, requestexecution.ExternalIDHasPrefix(*i.ExternalIDHasPrefix))
-	}
-	if i.ExternalIDHasSuffix != nil {
-		predicates = append(predicates, requestexecution.ExternalIDHasSuffix(*i.ExternalIDHasSuffix))
-	}
-	if i.ExternalIDIsNil {
-		predicates = append(predicates, requestexecution.ExternalIDIsNil())
-	}
-	if i.ExternalIDNotNil {
-		predicates = append(predicates, requestexecution.ExternalIDNotNil())
-	}
-	if i.ExternalIDEqualFold != nil {
-		predicates = append(predicates, requestexecution.ExternalIDEqualFold(*i.ExternalIDEqualFold))
-	}
-	if i.ExternalIDContainsFold != nil {
-		predicates = append(predicates, requestexecution.ExternalIDContainsFold(*i.ExternalIDContainsFold))
-	}
-	if i.ModelID != nil {
-		predicates = append(predicates, requestexecution.ModelIDEQ(*i.ModelID))
-	}
-	if i.ModelIDNEQ != nil {
-		predicates = append(predicates, requestexecution.ModelIDNEQ(*i.ModelIDNEQ))
-	}
-	if len(i.ModelIDIn) > 0 {
-		predicates = append(predicates, requestexecution.ModelIDIn(i.ModelIDIn...))
-	}
-	if len(i.ModelIDNotIn) > 0 {
-		predicates = append(predicates, requestexecution.ModelIDNotIn(i.ModelIDNotIn...))
-	}
-	if i.ModelIDGT != nil {
-		predicates = append(predicates, requestexecution.ModelIDGT(*i.ModelIDGT))
-	}
-	if i.ModelIDGTE != nil {
-		predicates = append(predicates, requestexecution.ModelIDGTE(*i.ModelIDGTE))
-	}
-	if i.ModelIDLT != nil {
-		predicates = append(predicates, requestexecution.ModelIDLT(*i.ModelIDLT))
-	}
-	if i.ModelIDLTE != nil {
-		predicates = append(predicates, requestexecution.ModelIDLTE(*i.ModelIDLTE))
-	}
-	if i.ModelIDContains != nil {
-		predicates = append(predicates, requestexecution.ModelIDContains(*i.ModelIDContains))
-	}
-	if i.ModelIDHasPrefix != nil {
-		predicates = append(predicates, requestexecution.ModelIDHasPrefix(*i.ModelIDHasPrefix))
-	}
-	if i.ModelIDHasSuffix != nil {
-		predicates = append(predicates, requestexecution.ModelIDHasSuffix(*i.ModelIDHasSuffix))
-	}
-	if i.ModelIDEqualFold != nil {
-		predicates = append(predicates, requestexecution.ModelIDEqualFold(*i.ModelIDEqualFold))
-	}
-	if i.ModelIDContainsFold != nil {
-		predicates = append(predicates, requestexecution.ModelIDContainsFold(*i.ModelIDContainsFold))
-	}
-	if i.Format != nil {
-		predicates = append(predicates, requestexecution.FormatEQ(*i.Format))
-	}
-	if i.FormatNEQ != nil {
-		predicates = append(predicates, requestexecution.FormatNEQ(*i.FormatNEQ))
-	}
-	if len(i.FormatIn) > 0 {
-		predicates = append(predicates, requestexecution.FormatIn(i.FormatIn...))
-	}
-	if len(i.FormatNotIn) > 0 {
-		predicates = append(predicates, requestexecution.FormatNotIn(i.FormatNotIn...))
-	}
-	if i.FormatGT != nil {
-		predicates = append(predicates, requestexecution.FormatGT(*i.FormatGT))
-	}
-	if i.FormatGTE != nil {
-		predicates = append(predicates, requestexecution.FormatGTE(*i.FormatGTE))
-	}
-	if i.FormatLT != nil {
-		predicates = append(predicates, requestexecution.FormatLT(*i.FormatLT))
-	}
-	if i.FormatLTE != nil {
-		predicates = append(predicates, requestexecution.FormatLTE(*i.FormatLTE))
-	}
-	if i.FormatContains != nil {
-		predicates = append(predicates, requestexecution.FormatContains(*i.FormatContains))
-	}
-	if i.FormatHasPrefix != nil {
-		predicates = append(predicates, requestexecution.FormatHasPrefix(*i.FormatHasPrefix))
-	}
-	if i.FormatHasSuffix != nil {
-		predicates = append(predicates, requestexecution.FormatHasSuffix(*i.FormatHasSuffix))
+		predicates = append(predicates, thread.ProjectIDEQ(*i.ProjectID))
 	}
-	if i.FormatEqualFold != nil {
-		predicates = append(predicates, requestexecution.FormatEqualFold(*i.FormatEqualFold))
+	if i.ProjectIDNEQ != nil {
+		predicates = append(predicates, thread.ProjectIDNEQ(*i.ProjectIDNEQ))
 	}
-	if i.FormatContainsFold != nil {
-		predicates = append(predicates, requestexecution.FormatContainsFold(*i.FormatContainsFold))
+	if len(i.ProjectIDIn) > 0 {
+		predicates = append(predicates, thread.ProjectIDIn(i.ProjectIDIn...))
 	}
-	if i.ErrorMessage != nil {
-		predicates = append(predicates, requestexecution.ErrorMessageEQ(*i.ErrorMessage))
+	if len(i.ProjectIDNotIn) > 0 {
+		predicates = append(predicates, thread.ProjectIDNotIn(i.ProjectIDNotIn...))
 	}
-	if i.ErrorMessageNEQ != nil {
-		predicates = append(predicates, requestexecution.ErrorMessageNEQ(*i.ErrorMessageNEQ))
+	if i.ThreadID != nil {
+		predicates = append(predicates, thread.ThreadIDEQ(*i.ThreadID))
 	}
-	if len(i.ErrorMessageIn) > 0 {
-		predicates = append(predicates, requestexecution.ErrorMessageIn(i.ErrorMessageIn...))
+	if i.ThreadIDNEQ != nil {
+		predicates = append(predicates, thread.ThreadIDNEQ(*i.ThreadIDNEQ))
 	}
-	if len(i.ErrorMessageNotIn) > 0 {
-		predicates = append(predicates, requestexecution.ErrorMessageNotIn(i.ErrorMessageNotIn...))
+	if len(i.ThreadIDIn) > 0 {
+		predicates = append(predicates, thread.ThreadIDIn(i.ThreadIDIn...))
 	}
-	if i.ErrorMessageGT != nil {
-		predicates = append(predicates, requestexecution.ErrorMessageGT(*i.ErrorMessageGT))
+	if len(i.ThreadIDNotIn) > 0 {
+		predicates = append(predicates, thread.ThreadIDNotIn(i.ThreadIDNotIn...))
 	}
-	if i.ErrorMessageGTE != nil {
-		predicates = append(predicates, requestexecution.ErrorMessageGTE(*i.ErrorMessageGTE))
+	if i.ThreadIDGT != nil {
+		predicates = append(predicates, thread.ThreadIDGT(*i.ThreadIDGT))
 	}
-	if i.ErrorMessageLT != nil {
-		predicates = append(predicates, requestexecution.ErrorMessageLT(*i.ErrorMessageLT))
+	if i.ThreadIDGTE != nil {
+		predicates = append(predicates, thread.ThreadIDGTE(*i.ThreadIDGTE))
 	}
-	if i.ErrorMessageLTE != nil {
-		predicates = append(predicates, requestexecution.ErrorMessageLTE(*i.ErrorMessageLTE))
+	if i.ThreadIDLT != nil {
+		predicates = append(predicates, thread.ThreadIDLT(*i.ThreadIDLT))
 	}
-	if i.ErrorMessageContains != nil {
-		predicates = append(predicates, requestexecution.ErrorMessageContains(*i.ErrorMessageContains))
+	if i.ThreadIDLTE != nil {
+		predicates = append(predicates, thread.ThreadIDLTE(*i.ThreadIDLTE))
 	}
-	if i.ErrorMessageHasPrefix != nil {
-		predicates = append(predicates, requestexecution.ErrorMessageHasPrefix(*i.ErrorMessageHasPrefix))
+	if i.ThreadIDContains != nil {
+		predicates = append(predicates, thread.ThreadIDContains(*i.ThreadIDContains))
 	}
-	if i.ErrorMessageHasSuffix != nil {
-		predicates = append(predicates, requestexecution.ErrorMessageHasSuffix(*i.ErrorMessageHasSuffix))
+	if i.ThreadIDHasPrefix != nil {
+		predicates = append(predicates, thread.ThreadIDHasPrefix(*i.ThreadIDHasPrefix))
 	}
-	if i.ErrorMessageIsNil {
-		predicates = append(predicates, requestexecution.ErrorMessageIsNil())
+	if i.ThreadIDHasSuffix != nil {
+		predicates = append(predicates, thread.ThreadIDHasSuffix(*i.ThreadIDHasSuffix))
 	}
-	if i.ErrorMessageNotNil {
-		predicates = append(predicates, requestexecution.ErrorMessageNotNil())
+	if i.ThreadIDEqualFold != nil {
+		predicates = append(predicates, thread.ThreadIDEqualFold(*i.ThreadIDEqualFold))
 	}
-	if i.ErrorMessageEqualFold != nil {
-		predicates = append(predicates, requestexecution.ErrorMessageEqualFold(*i.ErrorMessageEqualFold))
+	if i.ThreadIDContainsFold != nil {
+		predicates = append(predicates, thread.ThreadIDContainsFold(*i.ThreadIDContainsFold))
 	}
-	if i.ErrorMessageContainsFold != nil {
-		predicates = append(predicates, requestexecution.ErrorMessageContainsFold(*i.ErrorMessageContainsFold))
+	if i.Status != nil {
+		predicates = append(predicates, thread.StatusEQ(*i.Status))
 	}
-	if i.ResponseStatusCode != nil {
-		predicates = append(predicates, requestexecution.ResponseStatusCodeEQ(*i.ResponseStatusCode))
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, thread.StatusNEQ(*i.StatusNEQ))
 	}
-	if i.ResponseStatusCodeNEQ != nil {
-		predicates = append(predicates, requestexecution.ResponseStatusCodeNEQ(*i.ResponseStatusCodeNEQ))
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, thread.StatusIn(i.StatusIn...))
 	}
-	if len(i.ResponseStatusCodeIn) > 0 {
-		predicates = append(predicates, requestexecution.ResponseStatusCodeIn(i.ResponseStatusCodeIn...))
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, thread.StatusNotIn(i.StatusNotIn...))
 	}
-	if len(i.ResponseStatusCodeNotIn) > 0 {
-		predicates = append(predicates, requestexecution.ResponseStatusCodeNotIn(i.ResponseStatusCodeNotIn...))
+
+	if i.HasProject != nil {
+		p := thread.HasProject()
+		if !*i.HasProject {
+			p = thread.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.ResponseStatusCodeGT != nil {
-		predicates = append(predicates, requestexecution.ResponseStatusCodeGT(*i.ResponseStatusCodeGT))
+	if len(i.HasProjectWith) > 0 {
+		with := make([]predicate.Project, 0, len(i.HasProjectWith))
+		for _, w := range i.HasProjectWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProjectWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, thread.HasProjectWith(with...))
 	}
-	if i.ResponseStatusCodeGTE != nil {
-		predicates = append(predicates, requestexecution.ResponseStatusCodeGTE(*i.ResponseStatusCodeGTE))
+	if i.HasTraces != nil {
+		p := thread.HasTraces()
+		if !*i.HasTraces {
+			p = thread.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.ResponseStatusCodeLT != nil {
-		predicates = append(predicates, requestexecution.ResponseStatusCodeLT(*i.ResponseStatusCodeLT))
+	if len(i.HasTracesWith) > 0 {
+		with := make([]predicate.Trace, 0, len(i.HasTracesWith))
+		for _, w := range i.HasTracesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasTracesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, thread.HasTracesWith(with...))
 	}
-	if i.ResponseStatusCodeLTE != nil {
-		predicates = append(predicates, requestexecution.ResponseStatusCodeLTE(*i.ResponseStatusCodeLTE))
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyThreadWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return thread.And(predicates...), nil
 	}
-	if i.ResponseStatusCodeIsNil {
-		predicates = append(predicates, requestexecution.ResponseStatusCodeIsNil())
+}
+
+// TraceWhereInput represents a where input for filtering Trace queries.
+type TraceWhereInput struct {
+	Predicates []predicate.Trace  `json:"-"`
+	Not        *TraceWhereInput   `json:"not,omitempty"`
+	Or         []*TraceWhereInput `json:"or,omitempty"`
+	And        []*TraceWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "project_id" field predicates.
+	ProjectID      *int  `json:"projectID,omitempty"`
+	ProjectIDNEQ   *int  `json:"projectIDNEQ,omitempty"`
+	ProjectIDIn    []int `json:"projectIDIn,omitempty"`
+	ProjectIDNotIn []int `json:"projectIDNotIn,omitempty"`
+
+	// "trace_id" field predicates.
+	TraceID             *string  `json:"traceID,omitempty"`
+	TraceIDNEQ          *string  `json:"traceIDNEQ,omitempty"`
+	TraceIDIn           []string `json:"traceIDIn,omitempty"`
+	TraceIDNotIn        []string `json:"traceIDNotIn,omitempty"`
+	TraceIDGT           *string  `json:"traceIDGT,omitempty"`
+	TraceIDGTE          *string  `json:"traceIDGTE,omitempty"`
+	TraceIDLT           *string  `json:"traceIDLT,omitempty"`
+	TraceIDLTE          *string  `json:"traceIDLTE,omitempty"`
+	TraceIDContains     *string  `json:"traceIDContains,omitempty"`
+	TraceIDHasPrefix    *string  `json:"traceIDHasPrefix,omitempty"`
+	TraceIDHasSuffix    *string  `json:"traceIDHasSuffix,omitempty"`
+	TraceIDEqualFold    *string  `json:"traceIDEqualFold,omitempty"`
+	TraceIDContainsFold *string  `json:"traceIDContainsFold,omitempty"`
+
+	// "thread_id" field predicates.
+	ThreadID       *int  `json:"threadID,omitempty"`
+	ThreadIDNEQ    *int  `json:"threadIDNEQ,omitempty"`
+	ThreadIDIn     []int `json:"threadIDIn,omitempty"`
+	ThreadIDNotIn  []int `json:"threadIDNotIn,omitempty"`
+	ThreadIDIsNil  bool  `json:"threadIDIsNil,omitempty"`
+	ThreadIDNotNil bool  `json:"threadIDNotNil,omitempty"`
+
+	// "status" field predicates.
+	Status      *trace.Status  `json:"status,omitempty"`
+	StatusNEQ   *trace.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []trace.Status `json:"statusIn,omitempty"`
+	StatusNotIn []trace.Status `json:"statusNotIn,omitempty"`
+
+	// "project" edge predicates.
+	HasProject     *bool                `json:"hasProject,omitempty"`
+	HasProjectWith []*ProjectWhereInput `json:"hasProjectWith,omitempty"`
+
+	// "thread" edge predicates.
+	HasThread     *bool               `json:"hasThread,omitempty"`
+	HasThreadWith []*ThreadWhereInput `json:"hasThreadWith,omitempty"`
+
+	// "requests" edge predicates.
+	HasRequests     *bool                `json:"hasRequests,omitempty"`
+	HasRequestsWith []*RequestWhereInput `json:"hasRequestsWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *TraceWhereInput) AddPredicates(predicates ...predicate.Trace) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the TraceWhereInput filter on the TraceQuery builder.
+func (i *TraceWhereInput) Filter(q *TraceQuery) (*TraceQuery, error) {
+	if i == nil {
+		return q, nil
 	}
-	if i.ResponseStatusCodeNotNil {
-		predicates = append(predicates, requestexecution.ResponseStatusCodeNotNil())
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyTraceWhereInput {
+			return q, nil
+		}
+		return nil, err
 	}
-	if i.Status != nil {
-		predicates = append(predicates, requestexecution.StatusEQ(*i.Status))
+	return q.Where(p), nil
+}
+
+// ErrEmptyTraceWhereInput is returned in case the TraceWhereInput is empty.
+var ErrEmptyTraceWhereInput = errors.New("ent: empty predicate TraceWhereInput")
+
+// P returns a predicate for filtering traces.
+// An error is returned if the input is empty or invalid.
+func (i *TraceWhereInput) P() (predicate.Trace, error) {
+	var predicates []predicate.Trace
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, trace.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Trace, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, trace.Or(or...))
 	}
-	if i.StatusNEQ != nil {
-		predicates = append(predicates, requestexecution.StatusNEQ(*i.StatusNEQ))
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Trace, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, trace.And(and...))
 	}
-	if len(i.StatusIn) > 0 {
-		predicates = append(predicates, requestexecution.StatusIn(i.StatusIn...))
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, trace.IDEQ(*i.ID))
 	}
-	if len(i.StatusNotIn) > 0 {
-		predicates = append(predicates, requestexecution.StatusNotIn(i.StatusNotIn...))
+	if i.IDNEQ != nil {
+		predicates = append(predicates, trace.IDNEQ(*i.IDNEQ))
 	}
-	if i.Stream != nil {
-		predicates = append(predicates, requestexecution.StreamEQ(*i.Stream))
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, trace.IDIn(i.IDIn...))
 	}
-	if i.StreamNEQ != nil {
-		predicates = append(predicates, requestexecution.StreamNEQ(*i.StreamNEQ))
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, trace.IDNotIn(i.IDNotIn...))
 	}
-	if i.MetricsLatencyMs != nil {
-		predicates = append(predicates, requestexecution.MetricsLatencyMsEQ(*i.MetricsLatencyMs))
+	if i.IDGT != nil {
+		predicates = append(predicates, trace.IDGT(*i.IDGT))
 	}
-	if i.MetricsLatencyMsNEQ != nil {
-		predicates = append(predicates, requestexecution.MetricsLatencyMsNEQ(*i.MetricsLatencyMsNEQ))
+	if i.IDGTE != nil {
+		predicates = append(predicates, trace.IDGTE(*i.IDGTE))
 	}
-	if len(i.MetricsLatencyMsIn) > 0 {
-		predicates = append(predicates, requestexecution.MetricsLatencyMsIn(i.MetricsLatencyMsIn...))
+	if i.IDLT != nil {
+		predicates = append(predicates, trace.IDLT(*i.IDLT))
 	}
-	if len(i.MetricsLatencyMsNotIn) > 0 {
-		predicates = append(predicates, requestexecution.MetricsLatencyMsNotIn(i.MetricsLatencyMsNotIn...))
+	if i.IDLTE != nil {
+		predicates = append(predicates, trace.IDLTE(*i.IDLTE))
 	}
-	if i.MetricsLatencyMsGT != nil {
-		predicates = append(predicates, requestexecution.MetricsLatencyMsGT(*i.MetricsLatencyMsGT))
+	if i.CreatedAt != nil {
+		predicates = append(predicates, trace.CreatedAtEQ(*i.CreatedAt))
 	}
-	if i.MetricsLatencyMsGTE != nil {
-		predicates = append(predicates, requestexecution.MetricsLatencyMsGTE(*i.MetricsLatencyMsGTE))
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, trace.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if i.MetricsLatencyMsLT != nil {
-		predicates = append(predicates, requestexecution.MetricsLatencyMsLT(*i.MetricsLatencyMsLT))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, trace.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if i.MetricsLatencyMsLTE != nil {
-		predicates = append(predicates, requestexecution.MetricsLatencyMsLTE(*i.MetricsLatencyMsLTE))
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, trace.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if i.MetricsLatencyMsIsNil {
-		predicates = append(predicates, requestexecution.MetricsLatencyMsIsNil())
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, trace.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.MetricsLatencyMsNotNil {
-		predicates = append(predicates, requestexecution.MetricsLatencyMsNotNil())
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, trace.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if i.MetricsFirstTokenLatencyMs != nil {
-		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsEQ(*i.MetricsFirstTokenLatencyMs))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, trace.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if i.MetricsFirstTokenLatencyMsNEQ != nil {
-		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsNEQ(*i.MetricsFirstTokenLatencyMsNEQ))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, trace.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if len(i.MetricsFirstTokenLatencyMsIn) > 0 {
-		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsIn(i.MetricsFirstTokenLatencyMsIn...))
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, trace.UpdatedAtEQ(*i.UpdatedAt))
 	}
-	if len(i.MetricsFirstTokenLatencyMsNotIn) > 0 {
-		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsNotIn(i.MetricsFirstTokenLatencyMsNotIn...))
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, trace.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
-	if i.MetricsFirstTokenLatencyMsGT != nil {
-		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsGT(*i.MetricsFirstTokenLatencyMsGT))
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, trace.UpdatedAtIn(i.UpdatedAtIn...))
 	}
-	if i.MetricsFirstTokenLatencyMsGTE != nil {
-		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsGTE(*i.MetricsFirstTokenLatencyMsGTE))
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, trace.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
-	if i.MetricsFirstTokenLatencyMsLT != nil {
-		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsLT(*i.MetricsFirstTokenLatencyMsLT))
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, trace.UpdatedAtGT(*i.UpdatedAtGT))
 	}
-	if i.MetricsFirstTokenLatencyMsLTE != nil {
-		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsLTE(*i.MetricsFirstTokenLatencyMsLTE))
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, trace.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
-	if i.MetricsFirstTokenLatencyMsIsNil {
-		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsIsNil())
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, trace.UpdatedAtLT(*i.UpdatedAtLT))
 	}
-	if i.MetricsFirstTokenLatencyMsNotNil {
-		predicates = append(predicates, requestexecution.MetricsFirstTokenLatencyMsNotNil())
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, trace.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.MetricsReasoningDurationMs != nil {
-		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsEQ(*i.MetricsReasoningDurationMs))
+	if i.ProjectID != nil {
+		predicates = append(predicates, trace.ProjectIDEQ(*i.ProjectID))
 	}
-	if i.MetricsReasoningDurationMsNEQ != nil {
-		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsNEQ(*i.MetricsReasoningDurationMsNEQ))
+	if i.ProjectIDNEQ != nil {
+		predicates = append(predicates, trace.ProjectIDNEQ(*i.ProjectIDNEQ))
 	}
-	if len(i.MetricsReasoningDurationMsIn) > 0 {
-		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsIn(i.MetricsReasoningDurationMsIn...))
+	if len(i.ProjectIDIn) > 0 {
+		predicates = append(predicates, trace.ProjectIDIn(i.ProjectIDIn...))
 	}
-	if len(i.MetricsReasoningDurationMsNotIn) > 0 {
-		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsNotIn(i.MetricsReasoningDurationMsNotIn...))
+	if len(i.ProjectIDNotIn) > 0 {
+		predicates = append(predicates, trace.ProjectIDNotIn(i.ProjectIDNotIn...))
 	}
-	if i.MetricsReasoningDurationMsGT != nil {
-		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsGT(*i.MetricsReasoningDurationMsGT))
+	if i.TraceID != nil {
+		predicates = append(predicates, trace.TraceIDEQ(*i.TraceID))
 	}
-	if i.MetricsReasoningDurationMsGTE != nil {
-		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsGTE(*i.MetricsReasoningDurationMsGTE))
+	if i.TraceIDNEQ != nil {
+		predicates = append(predicates, trace.TraceIDNEQ(*i.TraceIDNEQ))
 	}
-	if i.MetricsReasoningDurationMsLT != nil {
-		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsLT(*i.MetricsReasoningDurationMsLT))
+	if len(i.TraceIDIn) > 0 {
+		predicates = append(predicates, trace.TraceIDIn(i.TraceIDIn...))
 	}
-	if i.MetricsReasoningDurationMsLTE != nil {
-		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsLTE(*i.MetricsReasoningDurationMsLTE))
+	if len(i.TraceIDNotIn) > 0 {
+		predicates = append(predicates, trace.TraceIDNotIn(i.TraceIDNotIn...))
 	}
-	if i.MetricsReasoningDurationMsIsNil {
-		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsIsNil())
+	if i.TraceIDGT != nil {
+		predicates = append(predicates, trace.TraceIDGT(*i.TraceIDGT))
 	}
-	if i.MetricsReasoningDurationMsNotNil {
-		predicates = append(predicates, requestexecution.MetricsReasoningDurationMsNotNil())
+	if i.TraceIDGTE != nil {
+		predicates = append(predicates, trace.TraceIDGTE(*i.TraceIDGTE))
 	}
-	if i.RequestURL != nil {
-		predicates = append(predicates, requestexecution.RequestURLEQ(*i.RequestURL))
+	if i.TraceIDLT != nil {
+		predicates = append(predicates, trace.TraceIDLT(*i.TraceIDLT))
 	}
-	if i.RequestURLNEQ != nil {
-		predicates = append(predicates, requestexecution.RequestURLNEQ(*i.RequestURLNEQ))
+	if i.TraceIDLTE != nil {
+		predicates = append(predicates, trace.TraceIDLTE(*i.TraceIDLTE))
 	}
-	if len(i.RequestURLIn) > 0 {
-		predicates = append(predicates, requestexecution.RequestURLIn(i.RequestURLIn...))
+	if i.TraceIDContains != nil {
+		predicates = append(predicates, trace.TraceIDContains(*i.TraceIDContains))
 	}
-	if len(i.RequestURLNotIn) > 0 {
-		predicates = append(predicates, requestexecution.RequestURLNotIn(i.RequestURLNotIn...))
+	if i.TraceIDHasPrefix != nil {
+		predicates = append(predicates, trace.TraceIDHasPrefix(*i.TraceIDHasPrefix))
 	}
-	if i.RequestURLGT != nil {
-		predicates = append(predicates, requestexecution.RequestURLGT(*i.RequestURLGT))
+	if i.TraceIDHasSuffix != nil {
+		predicates = append(predicates, trace.TraceIDHasSuffix(*i.TraceIDHasSuffix))
 	}
-	if i.RequestURLGTE != nil {
-		predicates = append(predicates, requestexecution.RequestURLGTE(*i.RequestURLGTE))
+	if i.TraceIDEqualFold != nil {
+		predicates = append(predicates, trace.TraceIDEqualFold(*i.TraceIDEqualFold))
 	}
-	if i.RequestURLLT != nil {
-		predicates = append(predicates, requestexecution.RequestURLLT(*i.RequestURLLT))
+	if i.TraceIDContainsFold != nil {
+		predicates = append(predicates, trace.TraceIDContainsFold(*i.TraceIDContainsFold))
 	}
-	if i.RequestURLLTE != nil {
-		predicates = append(predicates, requestexecution.RequestURLLTE(*i.RequestURLLTE))
+	if i.ThreadID != nil {
+		predicates = append(predicates, trace.ThreadIDEQ(*i.ThreadID))
 	}
-	if i.RequestURLContains != nil {
-		predicates = append(predicates, requestexecution.RequestURLContains(*i.RequestURLContains))
+	if i.ThreadIDNEQ != nil {
+		predicates = append(predicates, trace.ThreadIDNEQ(*i.ThreadIDNEQ))
 	}
-	if i.RequestURLHasPrefix != nil {
-		predicates = append(predicates, requestexecution.RequestURLHasPrefix(*i.RequestURLHasPrefix))
+	if len(i.ThreadIDIn) > 0 {
+		predicates = append(predicates, trace.ThreadIDIn(i.ThreadIDIn...))
 	}
-	if i.RequestURLHasSuffix != nil {
-		predicates = append(predicates, requestexecution.RequestURLHasSuffix(*i.RequestURLHasSuffix))
+	if len(i.ThreadIDNotIn) > 0 {
+		predicates = append(predicates, trace.ThreadIDNotIn(i.ThreadIDNotIn...))
 	}
-	if i.RequestURLIsNil {
-		predicates = append(predicates, requestexecution.RequestURLIsNil())
+	if i.ThreadIDIsNil {
+		predicates = append(predicates, trace.ThreadIDIsNil())
 	}
-	if i.RequestURLNotNil {
-		predicates = append(predicates, requestexecution.RequestURLNotNil())
+	if i.ThreadIDNotNil {
+		predicates = append(predicates, trace.ThreadIDNotNil())
 	}
-	if i.RequestURLEqualFold != nil {
-		predicates = append(predicates, requestexecution.RequestURLEqualFold(*i.RequestURLEqualFold))
+	if i.Status != nil {
+		predicates = append(predicates, trace.StatusEQ(*i.Status))
 	}
-	if i.RequestURLContainsFold != nil {
-		predicates = append(predicates, requestexecution.RequestURLContainsFold(*i.RequestURLContainsFold))
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, trace.StatusNEQ(*i.StatusNEQ))
 	}
-	if i.PassThroughApplied != nil {
-		predicates = append(predicates, requestexecution.PassThroughAppliedEQ(*i.PassThroughApplied))
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, trace.StatusIn(i.StatusIn...))
 	}
-	if i.PassThroughAppliedNEQ != nil {
-		predicates = append(predicates, requestexecution.PassThroughAppliedNEQ(*i.PassThroughAppliedNEQ))
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, trace.StatusNotIn(i.StatusNotIn...))
 	}
 
-	if i.HasRequest != nil {
-		p := requestexecution.HasRequest()
-		if !*i.HasRequest {
-			p = requestexecution.Not(p)
+	if i.HasProject != nil {
+		p := trace.HasProject()
+		if !*i.HasProject {
+			p = trace.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasRequestWith) > 0 {
-		with := make([]predicate.Request, 0, len(i.HasRequestWith))
-		for _, w := range i.HasRequestWith {
+	if len(i.HasProjectWith) > 0 {
+		with := make([]predicate.Project, 0, len(i.HasProjectWith))
+		for _, w := range i.HasProjectWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasRequestWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasProjectWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, requestexecution.HasRequestWith(with...))
+		predicates = append(predicates, trace.HasProjectWith(with...))
 	}
-	if i.HasChannel != nil {
-		p := requestexecution.HasChannel()
-		if !*i.HasChannel {
-			p = requestexecution.Not(p)
+	if i.HasThread != nil {
+		p := trace.HasThread()
+		if !*i.HasThread {
+			p = trace.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasChannelWith) > 0 {
-		with := make([]predicate.Channel, 0, len(i.HasChannelWith))
-		for _, w := range i.HasChannelWith {
+	if len(i.HasThreadWith) > 0 {
+		with := make([]predicate.Thread, 0, len(i.HasThreadWith))
+		for _, w := range i.HasThreadWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasChannelWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasThreadWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, requestexecution.HasChannelWith(with...))
+		predicates = append(predicates, trace.HasThreadWith(with...))
 	}
-	if i.HasDataStorage != nil {
-		p := requestexecution.HasDataStorage()
-		if !*i.HasDataStorage {
-			p = requestexecution.Not(p)
+	if i.HasRequests != nil {
+		p := trace.HasRequests()
+		if !*i.HasRequests {
+			p = trace.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasDataStorageWith) > 0 {
-		with := make([]predicate.DataStorage, 0, len(i.HasDataStorageWith))
-		for _, w := range i.HasDataStorageWith {
+	if len(i.HasRequestsWith) > 0 {
+		with := make([]predicate.Request, 0, len(i.HasRequestsWith))
+		for _, w := range i.HasRequestsWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasDataStorageWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasRequestsWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, requestexecution.HasDataStorageWith(with...))
+		predicates = append(predicates, trace.HasRequestsWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyRequestExecutionWhereInput
+		return nil, ErrEmptyTraceWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return requestexecution.And(predicates...), nil
+		return trace.And(predicates...), nil
 	}
 }
 
-// RoleWhereInput represents a where input for filtering Role queries.
-type RoleWhereInput struct {
-	Predicates []predicate.Role  `json:"-"`
-	Not        *RoleWhereInput   `json:"not,omitempty"`
-	Or         []*RoleWhereInput `json:"or,omitempty"`
-	And        []*RoleWhereInput `json:"and,omitempty"`
+// UsageLogWhereInput represents a where input for filtering UsageLog queries.
+type UsageLogWhereInput struct {
+	Predicates []predicate.UsageLog  `json:"-"`
+	Not        *UsageLogWhereInput   `json:"not,omitempty"`
+	Or         []*UsageLogWhereInput `json:"or,omitempty"`
+	And        []*UsageLogWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -8351,81 +12739,362 @@ type RoleWhereInput struct {
 	IDLT    *int  `json:"idLT,omitempty"`
 	IDLTE   *int  `json:"idLTE,omitempty"`
 
-	// "created_at" field predicates.
-	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
-	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
-	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
-	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
-	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
-	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
-	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
-	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "request_id" field predicates.
+	RequestID      *int  `json:"requestID,omitempty"`
+	RequestIDNEQ   *int  `json:"requestIDNEQ,omitempty"`
+	RequestIDIn    []int `json:"requestIDIn,omitempty"`
+	RequestIDNotIn []int `json:"requestIDNotIn,omitempty"`
+
+	// "api_key_id" field predicates.
+	APIKeyID       *int  `json:"apiKeyID,omitempty"`
+	APIKeyIDNEQ    *int  `json:"apiKeyIDNEQ,omitempty"`
+	APIKeyIDIn     []int `json:"apiKeyIDIn,omitempty"`
+	APIKeyIDNotIn  []int `json:"apiKeyIDNotIn,omitempty"`
+	APIKeyIDGT     *int  `json:"apiKeyIDGT,omitempty"`
+	APIKeyIDGTE    *int  `json:"apiKeyIDGTE,omitempty"`
+	APIKeyIDLT     *int  `json:"apiKeyIDLT,omitempty"`
+	APIKeyIDLTE    *int  `json:"apiKeyIDLTE,omitempty"`
+	APIKeyIDIsNil  bool  `json:"apiKeyIDIsNil,omitempty"`
+	APIKeyIDNotNil bool  `json:"apiKeyIDNotNil,omitempty"`
+
+	// "end_user_id" field predicates.
+	EndUserID             *string  `json:"endUserID,omitempty"`
+	EndUserIDNEQ          *string  `json:"endUserIDNEQ,omitempty"`
+	EndUserIDIn           []string `json:"endUserIDIn,omitempty"`
+	EndUserIDNotIn        []string `json:"endUserIDNotIn,omitempty"`
+	EndUserIDGT           *string  `json:"endUserIDGT,omitempty"`
+	EndUserIDGTE          *string  `json:"endUserIDGTE,omitempty"`
+	EndUserIDLT           *string  `json:"endUserIDLT,omitempty"`
+	EndUserIDLTE          *string  `json:"endUserIDLTE,omitempty"`
+	EndUserIDContains     *string  `json:"endUserIDContains,omitempty"`
+	EndUserIDHasPrefix    *string  `json:"endUserIDHasPrefix,omitempty"`
+	EndUserIDHasSuffix    *string  `json:"endUserIDHasSuffix,omitempty"`
+	EndUserIDIsNil        bool     `json:"endUserIDIsNil,omitempty"`
+	EndUserIDNotNil       bool     `json:"endUserIDNotNil,omitempty"`
+	EndUserIDEqualFold    *string  `json:"endUserIDEqualFold,omitempty"`
+	EndUserIDContainsFold *string  `json:"endUserIDContainsFold,omitempty"`
+
+	// "project_id" field predicates.
+	ProjectID      *int  `json:"projectID,omitempty"`
+	ProjectIDNEQ   *int  `json:"projectIDNEQ,omitempty"`
+	ProjectIDIn    []int `json:"projectIDIn,omitempty"`
+	ProjectIDNotIn []int `json:"projectIDNotIn,omitempty"`
+
+	// "channel_id" field predicates.
+	ChannelID       *int  `json:"channelID,omitempty"`
+	ChannelIDNEQ    *int  `json:"channelIDNEQ,omitempty"`
+	ChannelIDIn     []int `json:"channelIDIn,omitempty"`
+	ChannelIDNotIn  []int `json:"channelIDNotIn,omitempty"`
+	ChannelIDIsNil  bool  `json:"channelIDIsNil,omitempty"`
+	ChannelIDNotNil bool  `json:"channelIDNotNil,omitempty"`
+
+	// "model_id" field predicates.
+	ModelID             *string  `json:"modelID,omitempty"`
+	ModelIDNEQ          *string  `json:"modelIDNEQ,omitempty"`
+	ModelIDIn           []string `json:"modelIDIn,omitempty"`
+	ModelIDNotIn        []string `json:"modelIDNotIn,omitempty"`
+	ModelIDGT           *string  `json:"modelIDGT,omitempty"`
+	ModelIDGTE          *string  `json:"modelIDGTE,omitempty"`
+	ModelIDLT           *string  `json:"modelIDLT,omitempty"`
+	ModelIDLTE          *string  `json:"modelIDLTE,omitempty"`
+	ModelIDContains     *string  `json:"modelIDContains,omitempty"`
+	ModelIDHasPrefix    *string  `json:"modelIDHasPrefix,omitempty"`
+	ModelIDHasSuffix    *string  `json:"modelIDHasSuffix,omitempty"`
+	ModelIDEqualFold    *string  `json:"modelIDEqualFold,omitempty"`
+	ModelIDContainsFold *string  `json:"modelIDContainsFold,omitempty"`
+
+	// "prompt_tokens" field predicates.
+	PromptTokens      *int64  `json:"promptTokens,omitempty"`
+	PromptTokensNEQ   *int64  `json:"promptTokensNEQ,omitempty"`
+	PromptTokensIn    []int64 `json:"promptTokensIn,omitempty"`
+	PromptTokensNotIn []int64 `json:"promptTokensNotIn,omitempty"`
+	PromptTokensGT    *int64  `json:"promptTokensGT,omitempty"`
+	PromptTokensGTE   *int64  `json:"promptTokensGTE,omitempty"`
+	PromptTokensLT    *int64  `json:"promptTokensLT,omitempty"`
+	PromptTokensLTE   *int64  `json:"promptTokensLTE,omitempty"`
+
+	// "completion_tokens" field predicates.
+	CompletionTokens      *int64  `json:"completionTokens,omitempty"`
+	CompletionTokensNEQ   *int64  `json:"completionTokensNEQ,omitempty"`
+	CompletionTokensIn    []int64 `json:"completionTokensIn,omitempty"`
+	CompletionTokensNotIn []int64 `json:"completionTokensNotIn,omitempty"`
+	CompletionTokensGT    *int64  `json:"completionTokensGT,omitempty"`
+	CompletionTokensGTE   *int64  `json:"completionTokensGTE,omitempty"`
+	CompletionTokensLT    *int64  `json:"completionTokensLT,omitempty"`
+	CompletionTokensLTE   *int64  `json:"completionTokensLTE,omitempty"`
+
+	// "total_tokens" field predicates.
+	TotalTokens      *int64  `json:"totalTokens,omitempty"`
+	TotalTokensNEQ   *int64  `json:"totalTokensNEQ,omitempty"`
+	TotalTokensIn    []int64 `json:"totalTokensIn,omitempty"`
+	TotalTokensNotIn []int64 `json:"totalTokensNotIn,omitempty"`
+	TotalTokensGT    *int64  `json:"totalTokensGT,omitempty"`
+	TotalTokensGTE   *int64  `json:"totalTokensGTE,omitempty"`
+	TotalTokensLT    *int64  `json:"totalTokensLT,omitempty"`
+	TotalTokensLTE   *int64  `json:"totalTokensLTE,omitempty"`
+
+	// "prompt_audio_tokens" field predicates.
+	PromptAudioTokens       *int64  `json:"promptAudioTokens,omitempty"`
+	PromptAudioTokensNEQ    *int64  `json:"promptAudioTokensNEQ,omitempty"`
+	PromptAudioTokensIn     []int64 `json:"promptAudioTokensIn,omitempty"`
+	PromptAudioTokensNotIn  []int64 `json:"promptAudioTokensNotIn,omitempty"`
+	PromptAudioTokensGT     *int64  `json:"promptAudioTokensGT,omitempty"`
+	PromptAudioTokensGTE    *int64  `json:"promptAudioTokensGTE,omitempty"`
+	PromptAudioTokensLT     *int64  `json:"promptAudioTokensLT,omitempty"`
+	PromptAudioTokensLTE    *int64  `json:"promptAudioTokensLTE,omitempty"`
+	PromptAudioTokensIsNil  bool    `json:"promptAudioTokensIsNil,omitempty"`
+	PromptAudioTokensNotNil bool    `json:"promptAudioTokensNotNil,omitempty"`
+
+	// "prompt_cached_tokens" field predicates.
+	PromptCachedTokens       *int64  `json:"promptCachedTokens,omitempty"`
+	PromptCachedTokensNEQ    *int64  `json:"promptCachedTokensNEQ,omitempty"`
+	PromptCachedTokensIn     []int64 `json:"promptCachedTokensIn,omitempty"`
+	PromptCachedTokensNotIn  []int64 `json:"promptCachedTokensNotIn,omitempty"`
+	PromptCachedTokensGT     *int64  `json:"promptCachedTokensGT,omitempty"`
+	PromptCachedTokensGTE    *int64  `json:"promptCachedTokensGTE,omitempty"`
+	PromptCachedTokensLT     *int64  `json:"promptCachedTokensLT,omitempty"`
+	PromptCachedTokensLTE    *int64  `json:"promptCachedTokensLTE,omitempty"`
+	PromptCachedTokensIsNil  bool    `json:"promptCachedTokensIsNil,omitempty"`
+	PromptCachedTokensNotNil bool    `json:"promptCachedTokensNotNil,omitempty"`
+
+	// "prompt_write_cached_tokens" field predicates.
+	PromptWriteCachedTokens       *int64  `json:"promptWriteCachedTokens,omitempty"`
+	PromptWriteCachedTokensNEQ    *int64  `json:"promptWriteCachedTokensNEQ,omitempty"`
+	PromptWriteCachedTokensIn     []int64 `json:"promptWriteCachedTokensIn,omitempty"`
+	PromptWriteCachedTokensNotIn  []int64 `json:"promptWriteCachedTokensNotIn,omitempty"`
+	PromptWriteCachedTokensGT     *int64  `json:"promptWriteCachedTokensGT,omitempty"`
+	PromptWriteCachedTokensGTE    *int64  `json:"promptWriteCachedTokensGTE,omitempty"`
+	PromptWriteCachedTokensLT     *int64  `json:"promptWriteCachedTokensLT,omitempty"`
+	PromptWriteCachedTokensLTE    *int64  `json:"promptWriteCachedTokensLTE,omitempty"`
+	PromptWriteCachedTokensIsNil  bool    `json:"promptWriteCachedTokensIsNil,omitempty"`
+	PromptWriteCachedTokensNotNil bool    `json:"promptWriteCachedTokensNotNil,omitempty"`
+
+	// "prompt_write_cached_tokens_5m" field predicates.
+	PromptWriteCachedTokens5m       *int64  `json:"promptWriteCachedTokens5m,omitempty"`
+	PromptWriteCachedTokens5mNEQ    *int64  `json:"promptWriteCachedTokens5mNEQ,omitempty"`
+	PromptWriteCachedTokens5mIn     []int64 `json:"promptWriteCachedTokens5mIn,omitempty"`
+	PromptWriteCachedTokens5mNotIn  []int64 `json:"promptWriteCachedTokens5mNotIn,omitempty"`
+	PromptWriteCachedTokens5mGT     *int64  `json:"promptWriteCachedTokens5mGT,omitempty"`
+	PromptWriteCachedTokens5mGTE    *int64  `json:"promptWriteCachedTokens5mGTE,omitempty"`
+	PromptWriteCachedTokens5mLT     *int64  `json:"promptWriteCachedTokens5mLT,omitempty"`
+	PromptWriteCachedTokens5mLTE    *int64  `json:"promptWriteCachedTokens5mLTE,omitempty"`
+	PromptWriteCachedTokens5mIsNil  bool    `json:"promptWriteCachedTokens5mIsNil,omitempty"`
+	PromptWriteCachedTokens5mNotNil bool    `json:"promptWriteCachedTokens5mNotNil,omitempty"`
+
+	// "prompt_write_cached_tokens_1h" field predicates.
+	PromptWriteCachedTokens1h       *int64  `json:"promptWriteCachedTokens1h,omitempty"`
+	PromptWriteCachedTokens1hNEQ    *int64  `json:"promptWriteCachedTokens1hNEQ,omitempty"`
+	PromptWriteCachedTokens1hIn     []int64 `json:"promptWriteCachedTokens1hIn,omitempty"`
+	PromptWriteCachedTokens1hNotIn  []int64 `json:"promptWriteCachedTokens1hNotIn,omitempty"`
+	PromptWriteCachedTokens1hGT     *int64  `json:"promptWriteCachedTokens1hGT,omitempty"`
+	PromptWriteCachedTokens1hGTE    *int64  `json:"promptWriteCachedTokens1hGTE,omitempty"`
+	PromptWriteCachedTokens1hLT     *int64  `json:"promptWriteCachedTokens1hLT,omitempty"`
+	PromptWriteCachedTokens1hLTE    *int64  `json:"promptWriteCachedTokens1hLTE,omitempty"`
+	PromptWriteCachedTokens1hIsNil  bool    `json:"promptWriteCachedTokens1hIsNil,omitempty"`
+	PromptWriteCachedTokens1hNotNil bool    `json:"promptWriteCachedTokens1hNotNil,omitempty"`
+
+	// "completion_audio_tokens" field predicates.
+	CompletionAudioTokens       *int64  `json:"completionAudioTokens,omitempty"`
+	CompletionAudioTokensNEQ    *int64  `json:"completionAudioTokensNEQ,omitempty"`
+	CompletionAudioTokensIn     []int64 `json:"completionAudioTokensIn,omitempty"`
+	CompletionAudioTokensNotIn  []int64 `json:"completionAudioTokensNotIn,omitempty"`
+	CompletionAudioTokensGT     *int64  `json:"completionAudioTokensGT,omitempty"`
+	CompletionAudioTokensGTE    *int64  `json:"completionAudioTokensGTE,omitempty"`
+	CompletionAudioTokensLT     *int64  `json:"completionAudioTokensLT,omitempty"`
+	CompletionAudioTokensLTE    *int64  `json:"completionAudioTokensLTE,omitempty"`
+	CompletionAudioTokensIsNil  bool    `json:"completionAudioTokensIsNil,omitempty"`
+	CompletionAudioTokensNotNil bool    `json:"completionAudioTokensNotNil,omitempty"`
+
+	// "completion_reasoning_tokens" field predicates.
+	CompletionReasoningTokens       *int64  `json:"completionReasoningTokens,omitempty"`
+	CompletionReasoningTokensNEQ    *int64  `json:"completionReasoningTokensNEQ,omitempty"`
+	CompletionReasoningTokensIn     []int64 `json:"completionReasoningTokensIn,omitempty"`
+	CompletionReasoningTokensNotIn  []int64 `json:"completionReasoningTokensNotIn,omitempty"`
+	CompletionReasoningTokensGT     *int64  `json:"completionReasoningTokensGT,omitempty"`
+	CompletionReasoningTokensGTE    *int64  `json:"completionReasoningTokensGTE,omitempty"`
+	CompletionReasoningTokensLT     *int64  `json:"completionReasoningTokensLT,omitempty"`
+	CompletionReasoningTokensLTE    *int64  `json:"completionReasoningTokensLTE,omitempty"`
+	CompletionReasoningTokensIsNil  bool    `json:"completionReasoningTokensIsNil,omitempty"`
+	CompletionReasoningTokensNotNil bool    `json:"completionReasoningTokensNotNil,omitempty"`
+
+	// "completion_accepted_prediction_tokens" field predicates.
+	CompletionAcceptedPredictionTokens       *int64  `json:"completionAcceptedPredictionTokens,omitempty"`
+	CompletionAcceptedPredictionTokensNEQ    *int64  `json:"completionAcceptedPredictionTokensNEQ,omitempty"`
+	CompletionAcceptedPredictionTokensIn     []int64 `json:"completionAcceptedPredictionTokensIn,omitempty"`
+	CompletionAcceptedPredictionTokensNotIn  []int64 `json:"completionAcceptedPredictionTokensNotIn,omitempty"`
+	CompletionAcceptedPredictionTokensGT     *int64  `json:"completionAcceptedPredictionTokensGT,omitempty"`
+	CompletionAcceptedPredictionTokensGTE    *int64  `json:"completionAcceptedPredictionTokensGTE,omitempty"`
+	CompletionAcceptedPredictionTokensLT     *int64  `json:"completionAcceptedPredictionTokensLT,omitempty"`
+	CompletionAcceptedPredictionTokensLTE    *int64  `json:"completionAcceptedPredictionTokensLTE,omitempty"`
+	CompletionAcceptedPredictionTokensIsNil  bool    `json:"completionAcceptedPredictionTokensIsNil,omitempty"`
+	CompletionAcceptedPredictionTokensNotNil bool    `json:"completionAcceptedPredictionTokensNotNil,omitempty"`
+
+	// "completion_rejected_prediction_tokens" field predicates.
+	CompletionRejectedPredictionTokens       *int64  `json:"completionRejectedPredictionTokens,omitempty"`
+	CompletionRejectedPredictionTokensNEQ    *int64  `json:"completionRejectedPredictionTokensNEQ,omitempty"`
+	CompletionRejectedPredictionTokensIn     []int64 `json:"completionRejectedPredictionTokensIn,omitempty"`
+	CompletionRejectedPredictionTokensNotIn  []int64 `json:"completionRejectedPredictionTokensNotIn,omitempty"`
+	CompletionRejectedPredictionTokensGT     *int64  `json:"completionRejectedPredictionTokensGT,omitempty"`
+	CompletionRejectedPredictionTokensGTE    *int64  `json:"completionRejectedPredictionTokensGTE,omitempty"`
+	CompletionRejectedPredictionTokensLT     *int64  `json:"completionRejectedPredictionTokensLT,omitempty"`
+	CompletionRejectedPredictionTokensLTE    *int64  `json:"completionRejectedPredictionTokensLTE,omitempty"`
+	CompletionRejectedPredictionTokensIsNil  bool    `json:"completionRejectedPredictionTokensIsNil,omitempty"`
+	CompletionRejectedPredictionTokensNotNil bool    `json:"completionRejectedPredictionTokensNotNil,omitempty"`
+
+	// "source" field predicates.
+	Source      *usagelog.Source  `json:"source,omitempty"`
+	SourceNEQ   *usagelog.Source  `json:"sourceNEQ,omitempty"`
+	SourceIn    []usagelog.Source `json:"sourceIn,omitempty"`
+	SourceNotIn []usagelog.Source `json:"sourceNotIn,omitempty"`
 
-	// "updated_at" field predicates.
-	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
-	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
-	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
-	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
-	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
-	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
-	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
-	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+	// "format" field predicates.
+	Format             *string  `json:"format,omitempty"`
+	FormatNEQ          *string  `json:"formatNEQ,omitempty"`
+	FormatIn           []string `json:"formatIn,omitempty"`
+	FormatNotIn        []string `json:"formatNotIn,omitempty"`
+	FormatGT           *string  `json:"formatGT,omitempty"`
+	FormatGTE          *string  `json:"formatGTE,omitempty"`
+	FormatLT           *string  `json:"formatLT,omitempty"`
+	FormatLTE          *string  `json:"formatLTE,omitempty"`
+	FormatContains     *string  `json:"formatContains,omitempty"`
+	FormatHasPrefix    *string  `json:"formatHasPrefix,omitempty"`
+	FormatHasSuffix    *string  `json:"formatHasSuffix,omitempty"`
+	FormatEqualFold    *string  `json:"formatEqualFold,omitempty"`
+	FormatContainsFold *string  `json:"formatContainsFold,omitempty"`
 
-	// "name" field predicates.
-	Name             *string  `json:"name,omitempty"`
-	NameNEQ          *string  `json:"nameNEQ,omitempty"`
-	NameIn           []string `json:"nameIn,omitempty"`
-	NameNotIn        []string `json:"nameNotIn,omitempty"`
-	NameGT           *string  `json:"nameGT,omitempty"`
-	NameGTE          *string  `json:"nameGTE,omitempty"`
-	NameLT           *string  `json:"nameLT,omitempty"`
-	NameLTE          *string  `json:"nameLTE,omitempty"`
-	NameContains     *string  `json:"nameContains,omitempty"`
-	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
-	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
-	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
-	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// "total_cost" field predicates.
+	TotalCost       *float64  `json:"totalCost,omitempty"`
+	TotalCostNEQ    *float64  `json:"totalCostNEQ,omitempty"`
+	TotalCostIn     []float64 `json:"totalCostIn,omitempty"`
+	TotalCostNotIn  []float64 `json:"totalCostNotIn,omitempty"`
+	TotalCostGT     *float64  `json:"totalCostGT,omitempty"`
+	TotalCostGTE    *float64  `json:"totalCostGTE,omitempty"`
+	TotalCostLT     *float64  `json:"totalCostLT,omitempty"`
+	TotalCostLTE    *float64  `json:"totalCostLTE,omitempty"`
+	TotalCostIsNil  bool      `json:"totalCostIsNil,omitempty"`
+	TotalCostNotNil bool      `json:"totalCostNotNil,omitempty"`
 
-	// "level" field predicates.
-	Level      *role.Level  `json:"level,omitempty"`
-	LevelNEQ   *role.Level  `json:"levelNEQ,omitempty"`
-	LevelIn    []role.Level `json:"levelIn,omitempty"`
-	LevelNotIn []role.Level `json:"levelNotIn,omitempty"`
+	// "cost_price_reference_id" field predicates.
+	CostPriceReferenceID             *string  `json:"costPriceReferenceID,omitempty"`
+	CostPriceReferenceIDNEQ          *string  `json:"costPriceReferenceIDNEQ,omitempty"`
+	CostPriceReferenceIDIn           []string `json:"costPriceReferenceIDIn,omitempty"`
+	CostPriceReferenceIDNotIn        []string `json:"costPriceReferenceIDNotIn,omitempty"`
+	CostPriceReferenceIDGT           *string  `json:"costPriceReferenceIDGT,omitempty"`
+	CostPriceReferenceIDGTE          *string  `json:"costPriceReferenceIDGTE,omitempty"`
+	CostPriceReferenceIDLT           *string  `json:"costPriceReferenceIDLT,omitempty"`
+	CostPriceReferenceIDLTE          *string  `json:"costPriceReferenceIDLTE,omitempty"`
+	CostPriceReferenceIDContains     *string  `json:"costPriceReferenceIDContains,omitempty"`
+	CostPriceReferenceIDHasPrefix    *string  `json:"costPriceReferenceIDHasPrefix,omitempty"`
+	CostPriceReferenceIDHasSuffix    *string  `json:"costPriceReferenceIDHasSuffix,omitempty"`
+	CostPriceReferenceIDIsNil        bool     `json:"costPriceReferenceIDIsNil,omitempty"`
+	CostPriceReferenceIDNotNil       bool     `json:"costPriceReferenceIDNotNil,omitempty"`
+	CostPriceReferenceIDEqualFold    *string  `json:"costPriceReferenceIDEqualFold,omitempty"`
+	CostPriceReferenceIDContainsFold *string  `json:"costPriceReferenceIDContainsFold,omitempty"`
 
-	// "project_id" field predicates.
-	ProjectID       *int  `json:"projectID,omitempty"`
-	ProjectIDNEQ    *int  `json:"projectIDNEQ,omitempty"`
-	ProjectIDIn     []int `json:"projectIDIn,omitempty"`
-	ProjectIDNotIn  []int `json:"projectIDNotIn,omitempty"`
-	ProjectIDIsNil  bool  `json:"projectIDIsNil,omitempty"`
-	ProjectIDNotNil bool  `json:"projectIDNotNil,omitempty"`
+	// "currency" field predicates.
+	Currency             *string  `json:"currency,omitempty"`
+	CurrencyNEQ          *string  `json:"currencyNEQ,omitempty"`
+	CurrencyIn           []string `json:"currencyIn,omitempty"`
+	CurrencyNotIn        []string `json:"currencyNotIn,omitempty"`
+	CurrencyGT           *string  `json:"currencyGT,omitempty"`
+	CurrencyGTE          *string  `json:"currencyGTE,omitempty"`
+	CurrencyLT           *string  `json:"currencyLT,omitempty"`
+	CurrencyLTE          *string  `json:"currencyLTE,omitempty"`
+	CurrencyContains     *string  `json:"currencyContains,omitempty"`
+	CurrencyHasPrefix    *string  `json:"currencyHasPrefix,omitempty"`
+	CurrencyHasSuffix    *string  `json:"currencyHasSuffix,omitempty"`
+	CurrencyIsNil        bool     `json:"currencyIsNil,omitempty"`
+	CurrencyNotNil       bool     `json:"currencyNotNil,omitempty"`
+	CurrencyEqualFold    *string  `json:"currencyEqualFold,omitempty"`
+	CurrencyContainsFold *string  `json:"currencyContainsFold,omitempty"`
+
+	// "original_total_cost" field predicates.
+	OriginalTotalCost       *float64  `json:"originalTotalCost,omitempty"`
+	OriginalTotalCostNEQ    *float64  `json:"originalTotalCostNEQ,omitempty"`
+	OriginalTotalCostIn     []float64 `json:"originalTotalCostIn,omitempty"`
+	OriginalTotalCostNotIn  []float64 `json:"originalTotalCostNotIn,omitempty"`
+	OriginalTotalCostGT     *float64  `json:"originalTotalCostGT,omitempty"`
+	OriginalTotalCostGTE    *float64  `json:"originalTotalCostGTE,omitempty"`
+	OriginalTotalCostLT     *float64  `json:"originalTotalCostLT,omitempty"`
+	OriginalTotalCostLTE    *float64  `json:"originalTotalCostLTE,omitempty"`
+	OriginalTotalCostIsNil  bool      `json:"originalTotalCostIsNil,omitempty"`
+	OriginalTotalCostNotNil bool      `json:"originalTotalCostNotNil,omitempty"`
+
+	// "original_currency" field predicates.
+	OriginalCurrency             *string  `json:"originalCurrency,omitempty"`
+	OriginalCurrencyNEQ          *string  `json:"originalCurrencyNEQ,omitempty"`
+	OriginalCurrencyIn           []string `json:"originalCurrencyIn,omitempty"`
+	OriginalCurrencyNotIn        []string `json:"originalCurrencyNotIn,omitempty"`
+	OriginalCurrencyGT           *string  `json:"originalCurrencyGT,omitempty"`
+	OriginalCurrencyGTE          *string  `json:"originalCurrencyGTE,omitempty"`
+	OriginalCurrencyLT           *string  `json:"originalCurrencyLT,omitempty"`
+	OriginalCurrencyLTE          *string  `json:"originalCurrencyLTE,omitempty"`
+	OriginalCurrencyContains     *string  `json:"originalCurrencyContains,omitempty"`
+	OriginalCurrencyHasPrefix    *string  `json:"originalCurrencyHasPrefix,omitempty"`
+	OriginalCurrencyHasSuffix    *string  `json:"originalCurrencyHasSuffix,omitempty"`
+	OriginalCurrencyIsNil        bool     `json:"originalCurrencyIsNil,omitempty"`
+	OriginalCurrencyNotNil       bool     `json:"originalCurrencyNotNil,omitempty"`
+	OriginalCurrencyEqualFold    *string  `json:"originalCurrencyEqualFold,omitempty"`
+	OriginalCurrencyContainsFold *string  `json:"originalCurrencyContainsFold,omitempty"`
+
+	// "exchange_rate" field predicates.
+	ExchangeRate       *float64  `json:"exchangeRate,omitempty"`
+	ExchangeRateNEQ    *float64  `json:"exchangeRateNEQ,omitempty"`
+	ExchangeRateIn     []float64 `json:"exchangeRateIn,omitempty"`
+	ExchangeRateNotIn  []float64 `json:"exchangeRateNotIn,omitempty"`
+	ExchangeRateGT     *float64  `json:"exchangeRateGT,omitempty"`
+	ExchangeRateGTE    *float64  `json:"exchangeRateGTE,omitempty"`
+	ExchangeRateLT     *float64  `json:"exchangeRateLT,omitempty"`
+	ExchangeRateLTE    *float64  `json:"exchangeRateLTE,omitempty"`
+	ExchangeRateIsNil  bool      `json:"exchangeRateIsNil,omitempty"`
+	ExchangeRateNotNil bool      `json:"exchangeRateNotNil,omitempty"`
 
-	// "users" edge predicates.
-	HasUsers     *bool             `json:"hasUsers,omitempty"`
-	HasUsersWith []*UserWhereInput `json:"hasUsersWith,omitempty"`
+	// "request" edge predicates.
+	HasRequest     *bool                `json:"hasRequest,omitempty"`
+	HasRequestWith []*RequestWhereInput `json:"hasRequestWith,omitempty"`
 
 	// "project" edge predicates.
 	HasProject     *bool                `json:"hasProject,omitempty"`
 	HasProjectWith []*ProjectWhereInput `json:"hasProjectWith,omitempty"`
 
-	// "user_roles" edge predicates.
-	HasUserRoles     *bool                 `json:"hasUserRoles,omitempty"`
-	HasUserRolesWith []*UserRoleWhereInput `json:"hasUserRolesWith,omitempty"`
+	// "channel" edge predicates.
+	HasChannel     *bool                `json:"hasChannel,omitempty"`
+	HasChannelWith []*ChannelWhereInput `json:"hasChannelWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *RoleWhereInput) AddPredicates(predicates ...predicate.Role) {
+func (i *UsageLogWhereInput) AddPredicates(predicates ...predicate.UsageLog) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the RoleWhereInput filter on the RoleQuery builder.
-func (i *RoleWhereInput) Filter(q *RoleQuery) (*RoleQuery, error) {
+// Filter applies the UsageLogWhereInput filter on the UsageLogQuery builder.
+func (i *UsageLogWhereInput) Filter(q *UsageLogQuery) (*UsageLogQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyRoleWhereInput {
+		if err == ErrEmptyUsageLogWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -8433,19 +13102,19 @@ func (i *RoleWhereInput) Filter(q *RoleQuery) (*RoleQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyRoleWhereInput is returned in case the RoleWhereInput is empty.
-var ErrEmptyRoleWhereInput = errors.New("ent: empty predicate RoleWhereInput")
+// ErrEmptyUsageLogWhereInput is returned in case the UsageLogWhereInput is empty.
+var ErrEmptyUsageLogWhereInput = errors.New("ent: empty predicate UsageLogWhereInput")
 
-// P returns a predicate for filtering roles.
+// P returns a predicate for filtering usagelogs.
 // An error is returned if the input is empty or invalid.
-func (i *RoleWhereInput) P() (predicate.Role, error) {
-	var predicates []predicate.Role
+func (i *UsageLogWhereInput) P() (predicate.UsageLog, error) {
+	var predicates []predicate.UsageLog
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, role.Not(p))
+		predicates = append(predicates, usagelog.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -8455,7 +13124,7 @@ func (i *RoleWhereInput) P() (predicate.Role, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Role, 0, n)
+		or := make([]predicate.UsageLog, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -8463,7 +13132,7 @@ func (i *RoleWhereInput) P() (predicate.Role, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, role.Or(or...))
+		predicates = append(predicates, usagelog.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -8473,7 +13142,7 @@ func (i *RoleWhereInput) P() (predicate.Role, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Role, 0, n)
+		and := make([]predicate.UsageLog, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -8481,1221 +13150,926 @@ func (i *RoleWhereInput) P() (predicate.Role, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, role.And(and...))
+		predicates = append(predicates, usagelog.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, role.IDEQ(*i.ID))
+		predicates = append(predicates, usagelog.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, role.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, usagelog.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, role.IDIn(i.IDIn...))
+		predicates = append(predicates, usagelog.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, role.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, usagelog.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, role.IDGT(*i.IDGT))
+		predicates = append(predicates, usagelog.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, role.IDGTE(*i.IDGTE))
+		predicates = append(predicates, usagelog.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, role.IDLT(*i.IDLT))
+		predicates = append(predicates, usagelog.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, role.IDLTE(*i.IDLTE))
+		predicates = append(predicates, usagelog.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, role.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, usagelog.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, role.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, usagelog.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, role.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, usagelog.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, role.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, usagelog.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, role.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, usagelog.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, role.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, usagelog.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, role.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, usagelog.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, role.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, usagelog.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, role.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, usagelog.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, role.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, usagelog.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, role.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, usagelog.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, role.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, usagelog.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, role.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, usagelog.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, usagelog.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, usagelog.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, usagelog.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.RequestID != nil {
+		predicates = append(predicates, usagelog.RequestIDEQ(*i.RequestID))
+	}
+	if i.RequestIDNEQ != nil {
+		predicates = append(predicates, usagelog.RequestIDNEQ(*i.RequestIDNEQ))
+	}
+	if len(i.RequestIDIn) > 0 {
+		predicates = append(predicates, usagelog.RequestIDIn(i.RequestIDIn...))
+	}
+	if len(i.RequestIDNotIn) > 0 {
+		predicates = append(predicates, usagelog.RequestIDNotIn(i.RequestIDNotIn...))
+	}
+	if i.APIKeyID != nil {
+		predicates = append(predicates, usagelog.APIKeyIDEQ(*i.APIKeyID))
+	}
+	if i.APIKeyIDNEQ != nil {
+		predicates = append(predicates, usagelog.APIKeyIDNEQ(*i.APIKeyIDNEQ))
+	}
+	if len(i.APIKeyIDIn) > 0 {
+		predicates = append(predicates, usagelog.APIKeyIDIn(i.APIKeyIDIn...))
+	}
+	if len(i.APIKeyIDNotIn) > 0 {
+		predicates = append(predicates, usagelog.APIKeyIDNotIn(i.APIKeyIDNotIn...))
+	}
+	if i.APIKeyIDGT != nil {
+		predicates = append(predicates, usagelog.APIKeyIDGT(*i.APIKeyIDGT))
+	}
+	if i.APIKeyIDGTE != nil {
+		predicates = append(predicates, usagelog.APIKeyIDGTE(*i.APIKeyIDGTE))
+	}
+	if i.APIKeyIDLT != nil {
+		predicates = append(predicates, usagelog.APIKeyIDLT(*i.APIKeyIDLT))
+	}
+	if i.APIKeyIDLTE != nil {
+		predicates = append(predicates, usagelog.APIKeyIDLTE(*i.APIKeyIDLTE))
+	}
+	if i.APIKeyIDIsNil {
+		predicates = append(predicates, usagelog.APIKeyIDIsNil())
+	}
+	if i.APIKeyIDNotNil {
+		predicates = append(predicates, usagelog.APIKeyIDNotNil())
+	}
+	if i.EndUserID != nil {
+		predicates = append(predicates, usagelog.EndUserIDEQ(*i.EndUserID))
+	}
+	if i.EndUserIDNEQ != nil {
+		predicates = append(predicates, usagelog.EndUserIDNEQ(*i.EndUserIDNEQ))
+	}
+	if len(i.EndUserIDIn) > 0 {
+		predicates = append(predicates, usagelog.EndUserIDIn(i.EndUserIDIn...))
+	}
+	if len(i.EndUserIDNotIn) > 0 {
+		predicates = append(predicates, usagelog.EndUserIDNotIn(i.EndUserIDNotIn...))
+	}
+	if i.EndUserIDGT != nil {
+		predicates = append(predicates, usagelog.EndUserIDGT(*i.EndUserIDGT))
+	}
+	if i.EndUserIDGTE != nil {
+		predicates = append(predicates, usagelog.EndUserIDGTE(*i.EndUserIDGTE))
+	}
+	if i.EndUserIDLT != nil {
+		predicates = append(predicates, usagelog.EndUserIDLT(*i.EndUserIDLT))
+	}
+	if i.EndUserIDLTE != nil {
+		predicates = append(predicates, usagelog.EndUserIDLTE(*i.EndUserIDLTE))
+	}
+	if i.EndUserIDContains != nil {
+		predicates = append(predicates, usagelog.EndUserIDContains(*i.EndUserIDContains))
+	}
+	if i.EndUserIDHasPrefix != nil {
+		predicates = append(predicates, usagelog.EndUserIDHasPrefix(*i.EndUserIDHasPrefix))
+	}
+	if i.EndUserIDHasSuffix != nil {
+		predicates = append(predicates, usagelog.EndUserIDHasSuffix(*i.EndUserIDHasSuffix))
+	}
+	if i.EndUserIDIsNil {
+		predicates = append(predicates, usagelog.EndUserIDIsNil())
+	}
+	if i.EndUserIDNotNil {
+		predicates = append(predicates, usagelog.EndUserIDNotNil())
+	}
+	if i.EndUserIDEqualFold != nil {
+		predicates = append(predicates, usagelog.EndUserIDEqualFold(*i.EndUserIDEqualFold))
+	}
+	if i.EndUserIDContainsFold != nil {
+		predicates = append(predicates, usagelog.EndUserIDContainsFold(*i.EndUserIDContainsFold))
+	}
+	if i.ProjectID != nil {
+		predicates = append(predicates, usagelog.ProjectIDEQ(*i.ProjectID))
+	}
+	if i.ProjectIDNEQ != nil {
+		predicates = append(predicates, usagelog.ProjectIDNEQ(*i.ProjectIDNEQ))
+	}
+	if len(i.ProjectIDIn) > 0 {
+		predicates = append(predicates, usagelog.ProjectIDIn(i.ProjectIDIn...))
+	}
+	if len(i.ProjectIDNotIn) > 0 {
+		predicates = append(predicates, usagelog.ProjectIDNotIn(i.ProjectIDNotIn...))
+	}
+	if i.ChannelID != nil {
+		predicates = append(predicates, usagelog.ChannelIDEQ(*i.ChannelID))
 	}
-	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, role.UpdatedAtGTE(*i.UpdatedAtGTE))
+	if i.ChannelIDNEQ != nil {
+		predicates = append(predicates, usagelog.ChannelIDNEQ(*i.ChannelIDNEQ))
 	}
-	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, role.UpdatedAtLT(*i.UpdatedAtLT))
+	if len(i.ChannelIDIn) > 0 {
+		predicates = append(predicates, usagelog.ChannelIDIn(i.ChannelIDIn...))
 	}
-	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, role.UpdatedAtLTE(*i.UpdatedAtLTE))
+	if len(i.ChannelIDNotIn) > 0 {
+		predicates = append(predicates, usagelog.ChannelIDNotIn(i.ChannelIDNotIn...))
 	}
-	if i.Name != nil {
-		predicates = append(predicates, role.NameEQ(*i.Name))
+	if i.ChannelIDIsNil {
+		predicates = append(predicates, usagelog.ChannelIDIsNil())
 	}
-	if i.NameNEQ != nil {
-		predicates = append(predicates, role.NameNEQ(*i.NameNEQ))
+	if i.ChannelIDNotNil {
+		predicates = append(predicates, usagelog.ChannelIDNotNil())
 	}
-	if len(i.NameIn) > 0 {
-		predicates = append(predicates, role.NameIn(i.NameIn...))
+	if i.ModelID != nil {
+		predicates = append(predicates, usagelog.ModelIDEQ(*i.ModelID))
 	}
-	if len(i.NameNotIn) > 0 {
-		predicates = append(predicates, role.NameNotIn(i.NameNotIn...))
+	if i.ModelIDNEQ != nil {
+		predicates = append(predicates, usagelog.ModelIDNEQ(*i.ModelIDNEQ))
 	}
-	if i.NameGT != nil {
-		predicates = append(predicates, role.NameGT(*i.NameGT))
+	if len(i.ModelIDIn) > 0 {
+		predicates = append(predicates, usagelog.ModelIDIn(i.ModelIDIn...))
 	}
-	if i.NameGTE != nil {
-		predicates = append(predicates, role.NameGTE(*i.NameGTE))
+	if len(i.ModelIDNotIn) > 0 {
+		predicates = append(predicates, usagelog.ModelIDNotIn(i.ModelIDNotIn...))
 	}
-	if i.NameLT != nil {
-		predicates = append(predicates, role.NameLT(*i.NameLT))
+	if i.ModelIDGT != nil {
+		predicates = append(predicates, usagelog.ModelIDGT(*i.ModelIDGT))
 	}
-	if i.NameLTE != nil {
-		predicates = append(predicates, role.NameLTE(*i.NameLTE))
+	if i.ModelIDGTE != nil {
+		predicates = append(predicates, usagelog.ModelIDGTE(*i.ModelIDGTE))
 	}
-	if i.NameContains != nil {
-		predicates = append(predicates, role.NameContains(*i.NameContains))
+	if i.ModelIDLT != nil {
+		predicates = append(predicates, usagelog.ModelIDLT(*i.ModelIDLT))
 	}
-	if i.NameHasPrefix != nil {
-		predicates = append(predicates, role.NameHasPrefix(*i.NameHasPrefix))
+	if i.ModelIDLTE != nil {
+		predicates = append(predicates, usagelog.ModelIDLTE(*i.ModelIDLTE))
 	}
-	if i.NameHasSuffix != nil {
-		predicates = append(predicates, role.NameHasSuffix(*i.NameHasSuffix))
+	if i.ModelIDContains != nil {
+		predicates = append(predicates, usagelog.ModelIDContains(*i.ModelIDContains))
 	}
-	if i.NameEqualFold != nil {
-		predicates = append(predicates, role.NameEqualFold(*i.NameEqualFold))
+	if i.ModelIDHasPrefix != nil {
+		predicates = append(predicates, usagelog.ModelIDHasPrefix(*i.ModelIDHasPrefix))
 	}
-	if i.NameContainsFold != nil {
-		predicates = append(predicates, role.NameContainsFold(*i.NameContainsFold))
+	if i.ModelIDHasSuffix != nil {
+		predicates = append(predicates, usagelog.ModelIDHasSuffix(*i.ModelIDHasSuffix))
 	}
-	if i.Level != nil {
-		predicates = append(predicates, role.LevelEQ(*i.Level))
+	if i.ModelIDEqualFold != nil {
+		predicates = append(predicates, usagelog.ModelIDEqualFold(*i.ModelIDEqualFold))
 	}
-	if i.LevelNEQ != nil {
-		predicates = append(predicates, role.LevelNEQ(*i.LevelNEQ))
+	if i.ModelIDContainsFold != nil {
+		predicates = append(predicates, usagelog.ModelIDContainsFold(*i.ModelIDContainsFold))
 	}
-	if len(i.LevelIn) > 0 {
-		predicates = append(predicates, role.LevelIn(i.LevelIn...))
+	if i.PromptTokens != nil {
+		predicates = append(predicates, usagelog.PromptTokensEQ(*i.PromptTokens))
 	}
-	if len(i.LevelNotIn) > 0 {
-		predicates = append(predicates, role.LevelNotIn(i.LevelNotIn...))
+	if i.PromptTokensNEQ != nil {
+		predicates = append(predicates, usagelog.PromptTokensNEQ(*i.PromptTokensNEQ))
 	}
-	if i.ProjectID != nil {
-		predicates = append(predicates, role.ProjectIDEQ(*i.ProjectID))
+	if len(i.PromptTokensIn) > 0 {
+		predicates = append(predicates, usagelog.PromptTokensIn(i.PromptTokensIn...))
 	}
-	if i.ProjectIDNEQ != nil {
-		predicates = append(predicates, role.ProjectIDNEQ(*i.ProjectIDNEQ))
+	if len(i.PromptTokensNotIn) > 0 {
+		predicates = append(predicates, usagelog.PromptTokensNotIn(i.PromptTokensNotIn...))
 	}
-	if len(i.ProjectIDIn) > 0 {
-		predicates = append(predicates, role.ProjectIDIn(i.ProjectIDIn...))
+	if i.PromptTokensGT != nil {
+		predicates = append(predicates, usagelog.PromptTokensGT(*i.PromptTokensGT))
 	}
-	if len(i.ProjectIDNotIn) > 0 {
-		predicates = append(predicates, role.ProjectIDNotIn(i.ProjectIDNotIn...))
+	if i.PromptTokensGTE != nil {
+		predicates = append(predicates, usagelog.PromptTokensGTE(*i.PromptTokensGTE))
 	}
-	if i.ProjectIDIsNil {
-		predicates = append(predicates, role.ProjectIDIsNil())
+	if i.PromptTokensLT != nil {
+		predicates = append(predicates, usagelog.PromptTokensLT(*i.PromptTokensLT))
 	}
-	if i.ProjectIDNotNil {
-		predicates = append(predicates, role.ProjectIDNotNil())
+	if i.PromptTokensLTE != nil {
+		predicates = append(predicates, usagelog.PromptTokensLTE(*i.PromptTokensLTE))
 	}
-
-	if i.HasUsers != nil {
-		p := role.HasUsers()
-		if !*i.HasUsers {
-			p = role.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.CompletionTokens != nil {
+		predicates = append(predicates, usagelog.CompletionTokensEQ(*i.CompletionTokens))
 	}
-	if len(i.HasUsersWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasUsersWith))
-		for _, w := range i.HasUsersWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUsersWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, role.HasUsersWith(with...))
+	if i.CompletionTokensNEQ != nil {
+		predicates = append(predicates, usagelog.CompletionTokensNEQ(*i.CompletionTokensNEQ))
 	}
-	if i.HasProject != nil {
-		p := role.HasProject()
-		if !*i.HasProject {
-			p = role.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.CompletionTokensIn) > 0 {
+		predicates = append(predicates, usagelog.CompletionTokensIn(i.CompletionTokensIn...))
 	}
-	if len(i.HasProjectWith) > 0 {
-		with := make([]predicate.Project, 0, len(i.HasProjectWith))
-		for _, w := range i.HasProjectWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasProjectWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, role.HasProjectWith(with...))
+	if len(i.CompletionTokensNotIn) > 0 {
+		predicates = append(predicates, usagelog.CompletionTokensNotIn(i.CompletionTokensNotIn...))
 	}
-	if i.HasUserRoles != nil {
-		p := role.HasUserRoles()
-		if !*i.HasUserRoles {
-			p = role.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.CompletionTokensGT != nil {
+		predicates = append(predicates, usagelog.CompletionTokensGT(*i.CompletionTokensGT))
 	}
-	if len(i.HasUserRolesWith) > 0 {
-		with := make([]predicate.UserRole, 0, len(i.HasUserRolesWith))
-		for _, w := range i.HasUserRolesWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUserRolesWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, role.HasUserRolesWith(with...))
+	if i.CompletionTokensGTE != nil {
+		predicates = append(predicates, usagelog.CompletionTokensGTE(*i.CompletionTokensGTE))
 	}
-	switch len(predicates) {
-	case 0:
-		return nil, ErrEmptyRoleWhereInput
-	case 1:
-		return predicates[0], nil
-	default:
-		return role.And(predicates...), nil
+	if i.CompletionTokensLT != nil {
+		predicates = append(predicates, usagelog.CompletionTokensLT(*i.CompletionTokensLT))
 	}
-}
-
-// SystemWhereInput represents a where input for filtering System queries.
-type SystemWhereInput struct {
-	Predicates []predicate.System  `json:"-"`
-	Not        *SystemWhereInput   `json:"not,omitempty"`
-	Or         []*SystemWhereInput `json:"or,omitempty"`
-	And        []*SystemWhereInput `json:"and,omitempty"`
-
-	// "id" field predicates.
-	ID      *int  `json:"id,omitempty"`
-	IDNEQ   *int  `json:"idNEQ,omitempty"`
-	IDIn    []int `json:"idIn,omitempty"`
-	IDNotIn []int `json:"idNotIn,omitempty"`
-	IDGT    *int  `json:"idGT,omitempty"`
-	IDGTE   *int  `json:"idGTE,omitempty"`
-	IDLT    *int  `json:"idLT,omitempty"`
-	IDLTE   *int  `json:"idLTE,omitempty"`
-
-	// "created_at" field predicates.
-	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
-	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
-	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
-	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
-	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
-	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
-	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
-	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
-
-	// "updated_at" field predicates.
-	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
-	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
-	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
-	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
-	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
-	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
-	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
-	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
-
-	// "key" field predicates.
-	Key             *string  `json:"key,omitempty"`
-	KeyNEQ          *string  `json:"keyNEQ,omitempty"`
-	KeyIn           []string `json:"keyIn,omitempty"`
-	KeyNotIn        []string `json:"keyNotIn,omitempty"`
-	KeyGT           *string  `json:"keyGT,omitempty"`
-	KeyGTE          *string  `json:"keyGTE,omitempty"`
-	KeyLT           *string  `json:"keyLT,omitempty"`
-	KeyLTE          *string  `json:"keyLTE,omitempty"`
-	KeyContains     *string  `json:"keyContains,omitempty"`
-	KeyHasPrefix    *string  `json:"keyHasPrefix,omitempty"`
-	KeyHasSuffix    *string  `json:"keyHasSuffix,omitempty"`
-	KeyEqualFold    *string  `json:"keyEqualFold,omitempty"`
-	KeyContainsFold *string  `json:"keyContainsFold,omitempty"`
-
-	// "value" field predicates.
-	Value             *string  `json:"value,omitempty"`
-	ValueNEQ          *string  `json:"valueNEQ,omitempty"`
-	ValueIn           []string `json:"valueIn,omitempty"`
-	ValueNotIn        []string `json:"valueNotIn,omitempty"`
-	ValueGT           *string  `json:"valueGT,omitempty"`
-	ValueGTE          *string  `json:"valueGTE,omitempty"`
-	ValueLT           *string  `json:"valueLT,omitempty"`
-	ValueLTE          *string  `json:"valueLTE,omitempty"`
-	ValueContains     *string  `json:"valueContains,omitempty"`
-	ValueHasPrefix    *string  `json:"valueHasPrefix,omitempty"`
-	ValueHasSuffix    *string  `json:"valueHasSuffix,omitempty"`
-	ValueEqualFold    *string  `json:"valueEqualFold,omitempty"`
-	ValueContainsFold *string  `json:"valueContainsFold,omitempty"`
-}
-
-// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *SystemWhereInput) AddPredicates(predicates ...predicate.System) {
-	i.Predicates = append(i.Predicates, predicates...)
-}
-
-// Filter applies the SystemWhereInput filter on the SystemQuery builder.
-func (i *SystemWhereInput) Filter(q *SystemQuery) (*SystemQuery, error) {
-	if i == nil {
-		return q, nil
+	if i.CompletionTokensLTE != nil {
+		predicates = append(predicates, usagelog.CompletionTokensLTE(*i.CompletionTokensLTE))
 	}
-	p, err := i.P()
-	if err != nil {
-		if err == ErrEmptySystemWhereInput {
-			return q, nil
-		}
-		return nil, err
+	if i.TotalTokens != nil {
+		predicates = append(predicates, usagelog.TotalTokensEQ(*i.TotalTokens))
 	}
-	return q.Where(p), nil
-}
-
-// ErrEmptySystemWhereInput is returned in case the SystemWhereInput is empty.
-var ErrEmptySystemWhereInput = errors.New("ent: empty predicate SystemWhereInput")
-
-// P returns a predicate for filtering systems.
-// An error is returned if the input is empty or invalid.
-func (i *SystemWhereInput) P() (predicate.System, error) {
-	var predicates []predicate.System
-	if i.Not != nil {
-		p, err := i.Not.P()
-		if err != nil {
-			return nil, fmt.Errorf("%w: field 'not'", err)
-		}
-		predicates = append(predicates, system.Not(p))
+	if i.TotalTokensNEQ != nil {
+		predicates = append(predicates, usagelog.TotalTokensNEQ(*i.TotalTokensNEQ))
 	}
-	switch n := len(i.Or); {
-	case n == 1:
-		p, err := i.Or[0].P()
-		if err != nil {
-			return nil, fmt.Errorf("%w: field 'or'", err)
-		}
-		predicates = append(predicates, p)
-	case n > 1:
-		or := make([]predicate.System, 0, n)
-		for _, w := range i.Or {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'or'", err)
-			}
-			or = append(or, p)
-		}
-		predicates = append(predicates, system.Or(or...))
+	if len(i.TotalTokensIn) > 0 {
+		predicates = append(predicates, usagelog.TotalTokensIn(i.TotalTokensIn...))
 	}
-	switch n := len(i.And); {
-	case n == 1:
-		p, err := i.And[0].P()
-		if err != nil {
-			return nil, fmt.Errorf("%w: field 'and'", err)
-		}
-		predicates = append(predicates, p)
-	case n > 1:
-		and := make([]predicate.System, 0, n)
-		for _, w := range i.And {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'and'", err)
-			}
-			and = append(and, p)
-		}
-		predicates = append(predicates, system.And(and...))
+	if len(i.TotalTokensNotIn) > 0 {
+		predicates = append(predicates, usagelog.TotalTokensNotIn(i.TotalTokensNotIn...))
 	}
-	predicates = append(predicates, i.Predicates...)
-	if i.ID != nil {
-		predicates = append(predicates, system.IDEQ(*i.ID))
+	if i.TotalTokensGT != nil {
+		predicates = append(predicates, usagelog.TotalTokensGT(*i.TotalTokensGT))
 	}
-	if i.IDNEQ != nil {
-		predicates = append(predicates, system.IDNEQ(*i.IDNEQ))
+	if i.TotalTokensGTE != nil {
+		predicates = append(predicates, usagelog.TotalTokensGTE(*i.TotalTokensGTE))
 	}
-	if len(i.IDIn) > 0 {
-		predicates = append(predicates, system.IDIn(i.IDIn...))
+	if i.TotalTokensLT != nil {
+		predicates = append(predicates, usagelog.TotalTokensLT(*i.TotalTokensLT))
 	}
-	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, system.IDNotIn(i.IDNotIn...))
+	if i.TotalTokensLTE != nil {
+		predicates = append(predicates, usagelog.TotalTokensLTE(*i.TotalTokensLTE))
 	}
-	if i.IDGT != nil {
-		predicates = append(predicates, system.IDGT(*i.IDGT))
+	if i.PromptAudioTokens != nil {
+		predicates = append(predicates, usagelog.PromptAudioTokensEQ(*i.PromptAudioTokens))
 	}
-	if i.IDGTE != nil {
-		predicates = append(predicates, system.IDGTE(*i.IDGTE))
+	if i.PromptAudioTokensNEQ != nil {
+		predicates = append(predicates, usagelog.PromptAudioTokensNEQ(*i.PromptAudioTokensNEQ))
 	}
-	if i.IDLT != nil {
-		predicates = append(predicates, system.IDLT(*i.IDLT))
+	if len(i.PromptAudioTokensIn) > 0 {
+		predicates = append(predicates, usagelog.PromptAudioTokensIn(i.PromptAudioTokensIn...))
 	}
-	if i.IDLTE != nil {
-		predicates = append(predicates, system.IDLTE(*i.IDLTE))
+	if len(i.PromptAudioTokensNotIn) > 0 {
+		predicates = append(predicates, usagelog.PromptAudioTokensNotIn(i.PromptAudioTokensNotIn...))
 	}
-	if i.CreatedAt != nil {
-		predicates = append(predicates, system.CreatedAtEQ(*i.CreatedAt))
+	if i.PromptAudioTokensGT != nil {
+		predicates = append(predicates, usagelog.PromptAudioTokensGT(*i.PromptAudioTokensGT))
 	}
-	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, system.CreatedAtNEQ(*i.CreatedAtNEQ))
+	if i.PromptAudioTokensGTE != nil {
+		predicates = append(predicates, usagelog.PromptAudioTokensGTE(*i.PromptAudioTokensGTE))
 	}
-	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, system.CreatedAtIn(i.CreatedAtIn...))
+	if i.PromptAudioTokensLT != nil {
+		predicates = append(predicates, usagelog.PromptAudioTokensLT(*i.PromptAudioTokensLT))
 	}
-	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, system.CreatedAtNotIn(i.CreatedAtNotIn...))
+	if i.PromptAudioTokensLTE != nil {
+		predicates = append(predicates, usagelog.PromptAudioTokensLTE(*i.PromptAudioTokensLTE))
 	}
-	if i.CreatedAtGT != nil {
-		predicates = append(predicates, system.CreatedAtGT(*i.CreatedAtGT))
+	if i.PromptAudioTokensIsNil {
+		predicates = append(predicates, usagelog.PromptAudioTokensIsNil())
 	}
-	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, system.CreatedAtGTE(*i.CreatedAtGTE))
+	if i.PromptAudioTokensNotNil {
+		predicates = append(predicates, usagelog.PromptAudioTokensNotNil())
 	}
-	if i.CreatedAtLT != nil {
-		predicates = append(predicates, system.CreatedAtLT(*i.CreatedAtLT))
+	if i.PromptCachedTokens != nil {
+		predicates = append(predicates, usagelog.PromptCachedTokensEQ(*i.PromptCachedTokens))
 	}
-	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, system.CreatedAtLTE(*i.CreatedAtLTE))
+	if i.PromptCachedTokensNEQ != nil {
+		predicates = append(predicates, usagelog.PromptCachedTokensNEQ(*i.PromptCachedTokensNEQ))
 	}
-	if i.UpdatedAt != nil {
-		predicates = append(predicates, system.UpdatedAtEQ(*i.UpdatedAt))
+	if len(i.PromptCachedTokensIn) > 0 {
+		predicates = append(predicates, usagelog.PromptCachedTokensIn(i.PromptCachedTokensIn...))
 	}
-	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, system.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	if len(i.PromptCachedTokensNotIn) > 0 {
+		predicates = append(predicates, usagelog.PromptCachedTokensNotIn(i.PromptCachedTokensNotIn...))
 	}
-	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, system.UpdatedAtIn(i.UpdatedAtIn...))
+	if i.PromptCachedTokensGT != nil {
+		predicates = append(predicates, usagelog.PromptCachedTokensGT(*i.PromptCachedTokensGT))
 	}
-	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, system.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	if i.PromptCachedTokensGTE != nil {
+		predicates = append(predicates, usagelog.PromptCachedTokensGTE(*i.PromptCachedTokensGTE))
 	}
-	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, system.UpdatedAtGT(*i.UpdatedAtGT))
+	if i.PromptCachedTokensLT != nil {
+		predicates = append(predicates, usagelog.PromptCachedTokensLT(*i.PromptCachedTokensLT))
 	}
-	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, system.UpdatedAtGTE(*i.UpdatedAtGTE))
+	if i.PromptCachedTokensLTE != nil {
+		predicates = append(predicates, usagelog.PromptCachedTokensLTE(*i.PromptCachedTokensLTE))
 	}
-	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, system.UpdatedAtLT(*i.UpdatedAtLT))
+	if i.PromptCachedTokensIsNil {
+		predicates = append(predicates, usagelog.PromptCachedTokensIsNil())
 	}
-	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, system.UpdatedAtLTE(*i.UpdatedAtLTE))
+	if i.PromptCachedTokensNotNil {
+		predicates = append(predicates, usagelog.PromptCachedTokensNotNil())
 	}
-	if i.Key != nil {
-		predicates = append(predicates, system.KeyEQ(*i.Key))
+	if i.PromptWriteCachedTokens != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokensEQ(*i.PromptWriteCachedTokens))
 	}
-	if i.KeyNEQ != nil {
-		predicates = append(predicates, system.KeyNEQ(*i.KeyNEQ))
+	if i.PromptWriteCachedTokensNEQ != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokensNEQ(*i.PromptWriteCachedTokensNEQ))
 	}
-	if len(i.KeyIn) > 0 {
-		predicates = append(predicates, system.KeyIn(i.KeyIn...))
+	if len(i.PromptWriteCachedTokensIn) > 0 {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokensIn(i.PromptWriteCachedTokensIn...))
 	}
-	if len(i.KeyNotIn) > 0 {
-		predicates = append(predicates, system.KeyNotIn(i.KeyNotIn...))
+	if len(i.PromptWriteCachedTokensNotIn) > 0 {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokensNotIn(i.PromptWriteCachedTokensNotIn...))
 	}
-	if i.KeyGT != nil {
-		predicates = append(predicates, system.KeyGT(*i.KeyGT))
+	if i.PromptWriteCachedTokensGT != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokensGT(*i.PromptWriteCachedTokensGT))
 	}
-	if i.KeyGTE != nil {
-		predicates = append(predicates, system.KeyGTE(*i.KeyGTE))
+	if i.PromptWriteCachedTokensGTE != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokensGTE(*i.PromptWriteCachedTokensGTE))
 	}
-	if i.KeyLT != nil {
-		predicates = append(predicates, system.KeyLT(*i.KeyLT))
+	if i.PromptWriteCachedTokensLT != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokensLT(*i.PromptWriteCachedTokensLT))
 	}
-	if i.KeyLTE != nil {
-		predicates = append(predicates, system.KeyLTE(*i.KeyLTE))
+	if i.PromptWriteCachedTokensLTE != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokensLTE(*i.PromptWriteCachedTokensLTE))
 	}
-	if i.KeyContains != nil {
-		predicates = append(predicates, system.KeyContains(*i.KeyContains))
+	if i.PromptWriteCachedTokensIsNil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokensIsNil())
 	}
-	if i.KeyHasPrefix != nil {
-		predicates = append(predicates, system.KeyHasPrefix(*i.KeyHasPrefix))
+	if i.PromptWriteCachedTokensNotNil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokensNotNil())
 	}
-	if i.KeyHasSuffix != nil {
-		predicates = append(predicates, system.KeyHasSuffix(*i.KeyHasSuffix))
+	if i.PromptWriteCachedTokens5m != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mEQ(*i.PromptWriteCachedTokens5m))
 	}
-	if i.KeyEqualFold != nil {
-		predicates = append(predicates, system.KeyEqualFold(*i.KeyEqualFold))
+	if i.PromptWriteCachedTokens5mNEQ != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mNEQ(*i.PromptWriteCachedTokens5mNEQ))
 	}
-	if i.KeyContainsFold != nil {
-		predicates = append(predicates, system.KeyContainsFold(*i.KeyContainsFold))
+	if len(i.PromptWriteCachedTokens5mIn) > 0 {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mIn(i.PromptWriteCachedTokens5mIn...))
 	}
-	if i.Value != nil {
-		predicates = append(predicates, system.ValueEQ(*i.Value))
+	if len(i.PromptWriteCachedTokens5mNotIn) > 0 {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mNotIn(i.PromptWriteCachedTokens5mNotIn...))
 	}
-	if i.ValueNEQ != nil {
-		predicates = append(predicates, system.ValueNEQ(*i.ValueNEQ))
+	if i.PromptWriteCachedTokens5mGT != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mGT(*i.PromptWriteCachedTokens5mGT))
 	}
-	if len(i.ValueIn) > 0 {
-		predicates = append(predicates, system.ValueIn(i.ValueIn...))
+	if i.PromptWriteCachedTokens5mGTE != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mGTE(*i.PromptWriteCachedTokens5mGTE))
 	}
-	if len(i.ValueNotIn) > 0 {
-		predicates = append(predicates, system.ValueNotIn(i.ValueNotIn...))
+	if i.PromptWriteCachedTokens5mLT != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mLT(*i.PromptWriteCachedTokens5mLT))
 	}
-	if i.ValueGT != nil {
-		predicates = append(predicates, system.ValueGT(*i.ValueGT))
+	if i.PromptWriteCachedTokens5mLTE != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mLTE(*i.PromptWriteCachedTokens5mLTE))
 	}
-	if i.ValueGTE != nil {
-		predicates = append(predicates, system.ValueGTE(*i.ValueGTE))
+	if i.PromptWriteCachedTokens5mIsNil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mIsNil())
 	}
-	if i.ValueLT != nil {
-		predicates = append(predicates, system.ValueLT(*i.ValueLT))
+	if i.PromptWriteCachedTokens5mNotNil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mNotNil())
+	}
+	if i.PromptWriteCachedTokens1h != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hEQ(*i.PromptWriteCachedTokens1h))
+	}
+	if i.PromptWriteCachedTokens1hNEQ != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hNEQ(*i.PromptWriteCachedTokens1hNEQ))
+	}
+	if len(i.PromptWriteCachedTokens1hIn) > 0 {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hIn(i.PromptWriteCachedTokens1hIn...))
+	}
+	if len(i.PromptWriteCachedTokens1hNotIn) > 0 {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hNotIn(i.PromptWriteCachedTokens1hNotIn...))
+	}
+	if i.PromptWriteCachedTokens1hGT != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hGT(*i.PromptWriteCachedTokens1hGT))
 	}
-	if i.ValueLTE != nil {
-		predicates = append(predicates, system.ValueLTE(*i.ValueLTE))
+	if i.PromptWriteCachedTokens1hGTE != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hGTE(*i.PromptWriteCachedTokens1hGTE))
 	}
-	if i.ValueContains != nil {
-		predicates = append(predicates, system.ValueContains(*i.ValueContains))
+	if i.PromptWriteCachedTokens1hLT != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hLT(*i.PromptWriteCachedTokens1hLT))
 	}
-	if i.ValueHasPrefix != nil {
-		predicates = append(predicates, system.ValueHasPrefix(*i.ValueHasPrefix))
+	if i.PromptWriteCachedTokens1hLTE != nil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hLTE(*i.PromptWriteCachedTokens1hLTE))
 	}
-	if i.ValueHasSuffix != nil {
-		predicates = append(predicates, system.ValueHasSuffix(*i.ValueHasSuffix))
+	if i.PromptWriteCachedTokens1hIsNil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hIsNil())
 	}
-	if i.ValueEqualFold != nil {
-		predicates = append(predicates, system.ValueEqualFold(*i.ValueEqualFold))
+	if i.PromptWriteCachedTokens1hNotNil {
+		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hNotNil())
 	}
-	if i.ValueContainsFold != nil {
-		predicates = append(predicates, system.ValueContainsFold(*i.ValueContainsFold))
+	if i.CompletionAudioTokens != nil {
+		predicates = append(predicates, usagelog.CompletionAudioTokensEQ(*i.CompletionAudioTokens))
 	}
-
-	switch len(predicates) {
-	case 0:
-		return nil, ErrEmptySystemWhereInput
-	case 1:
-		return predicates[0], nil
-	default:
-		return system.And(predicates...), nil
+	if i.CompletionAudioTokensNEQ != nil {
+		predicates = append(predicates, usagelog.CompletionAudioTokensNEQ(*i.CompletionAudioTokensNEQ))
 	}
-}
-
-// ThreadWhereInput represents a where input for filtering Thread queries.
-type ThreadWhereInput struct {
-	Predicates []predicate.Thread  `json:"-"`
-	Not        *ThreadWhereInput   `json:"not,omitempty"`
-	Or         []*ThreadWhereInput `json:"or,omitempty"`
-	And        []*ThreadWhereInput `json:"and,omitempty"`
-
-	// "id" field predicates.
-	ID      *int  `json:"id,omitempty"`
-	IDNEQ   *int  `json:"idNEQ,omitempty"`
-	IDIn    []int `json:"idIn,omitempty"`
-	IDNotIn []int `json:"idNotIn,omitempty"`
-	IDGT    *int  `json:"idGT,omitempty"`
-	IDGTE   *int  `json:"idGTE,omitempty"`
-	IDLT    *int  `json:"idLT,omitempty"`
-	IDLTE   *int  `json:"idLTE,omitempty"`
-
-	// "created_at" field predicates.
-	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
-	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
-	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
-	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
-	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
-	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
-	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
-	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
-
-	// "updated_at" field predicates.
-	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
-	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
-	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
-	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
-	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
-	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
-	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
-	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
-
-	// "project_id" field predicates.
-	ProjectID      *int  `json:"projectID,omitempty"`
-	ProjectIDNEQ   *int  `json:"projectIDNEQ,omitempty"`
-	ProjectIDIn    []int `json:"projectIDIn,omitempty"`
-	ProjectIDNotIn []int `json:"projectIDNotIn,omitempty"`
-
-	// "thread_id" field predicates.
-	ThreadID             *string  `json:"threadID,omitempty"`
-	ThreadIDNEQ          *string  `json:"threadIDNEQ,omitempty"`
-	ThreadIDIn           []string `json:"threadIDIn,omitempty"`
-	ThreadIDNotIn        []string `json:"threadIDNotIn,omitempty"`
-	ThreadIDGT           *string  `json:"threadIDGT,omitempty"`
-	ThreadIDGTE          *string  `json:"threadIDGTE,omitempty"`
-	ThreadIDLT           *string  `json:"threadIDLT,omitempty"`
-	ThreadIDLTE          *string  `json:"threadIDLTE,omitempty"`
-	ThreadIDContains     *string  `json:"threadIDContains,omitempty"`
-	ThreadIDHasPrefix    *string  `json:"threadIDHasPrefix,omitempty"`
-	ThreadIDHasSuffix    *string  `json:"threadIDHasSuffix,omitempty"`
-	ThreadIDEqualFold    *string  `json:"threadIDEqualFold,omitempty"`
-	ThreadIDContainsFold *string  `json:"threadIDContainsFold,omitempty"`
-
-	// "status" field predicates.
-	Status      *thread.Status  `json:"status,omitempty"`
-	StatusNEQ   *thread.Status  `json:"statusNEQ,omitempty"`
-	StatusIn    []thread.Status `json:"statusIn,omitempty"`
-	StatusNotIn []thread.Status `json:"statusNotIn,omitempty"`
-
-	// "project" edge predicates.
-	HasProject     *bool                `json:"hasProject,omitempty"`
-	HasProjectWith []*ProjectWhereInput `json:"hasProjectWith,omitempty"`
-
-	// "traces" edge predicates.
-	HasTraces     *bool              `json:"hasTraces,omitempty"`
-	HasTracesWith []*TraceWhereInput `json:"hasTracesWith,omitempty"`
-}
-
-// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *ThreadWhereInput) AddPredicates(predicates ...predicate.Thread) {
-	i.Predicates = append(i.Predicates, predicates...)
-}
-
-// Filter applies the ThreadWhereInput filter on the ThreadQuery builder.
-func (i *ThreadWhereInput) Filter(q *ThreadQuery) (*ThreadQuery, error) {
-	if i == nil {
-		return q, nil
+	if len(i.CompletionAudioTokensIn) > 0 {
+		predicates = append(predicates, usagelog.CompletionAudioTokensIn(i.CompletionAudioTokensIn...))
 	}
-	p, err := i.P()
-	if err != nil {
-		if err == ErrEmptyThreadWhereInput {
-			return q, nil
-		}
-		return nil, err
+	if len(i.CompletionAudioTokensNotIn) > 0 {
+		predicates = append(predicates, usagelog.CompletionAudioTokensNotIn(i.CompletionAudioTokensNotIn...))
 	}
-	return q.Where(p), nil
-}
-
-// ErrEmptyThreadWhereInput is returned in case the ThreadWhereInput is empty.
-var ErrEmptyThreadWhereInput = errors.New("ent: empty predicate ThreadWhereInput")
-
-// P returns a predicate for filtering threads.
-// An error is returned if the input is empty or invalid.
-func (i *ThreadWhereInput) P() (predicate.Thread, error) {
-	var predicates []predicate.Thread
-	if i.Not != nil {
-		p, err := i.Not.P()
-		if err != nil {
-			return nil, fmt.Errorf("%w: field 'not'", err)
-		}
-		predicates = append(predicates, thread.Not(p))
+	if i.CompletionAudioTokensGT != nil {
+		predicates = append(predicates, usagelog.CompletionAudioTokensGT(*i.CompletionAudioTokensGT))
 	}
-	switch n := len(i.Or); {
-	case n == 1:
-		p, err := i.Or[0].P()
-		if err != nil {
-			return nil, fmt.Errorf("%w: field 'or'", err)
-		}
-		predicates = append(predicates, p)
-	case n > 1:
-		or := make([]predicate.Thread, 0, n)
-		for _, w := range i.Or {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'or'", err)
-			}
-			or = append(or, p)
-		}
-		predicates = append(predicates, thread.Or(or...))
+	if i.CompletionAudioTokensGTE != nil {
+		predicates = append(predicates, usagelog.CompletionAudioTokensGTE(*i.CompletionAudioTokensGTE))
 	}
-	switch n := len(i.And); {
-	case n == 1:
-		p, err := i.And[0].P()
-		if err != nil {
-			return nil, fmt.Errorf("%w: field 'and'", err)
-		}
-		predicates = append(predicates, p)
-	case n > 1:
-		and := make([]predicate.Thread, 0, n)
-		for _, w := range i.And {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'and'", err)
-			}
-			and = append(and, p)
-		}
-		predicates = append(predicates, thread.And(and...))
+	if i.CompletionAudioTokensLT != nil {
+		predicates = append(predicates, usagelog.CompletionAudioTokensLT(*i.CompletionAudioTokensLT))
 	}
-	predicates = append(predicates, i.Predicates...)
-	if i.ID != nil {
-		predicates = append(predicates, thread.IDEQ(*i.ID))
+	if i.CompletionAudioTokensLTE != nil {
+		predicates = append(predicates, usagelog.CompletionAudioTokensLTE(*i.CompletionAudioTokensLTE))
 	}
-	if i.IDNEQ != nil {
-		predicates = append(predicates, thread.IDNEQ(*i.IDNEQ))
+	if i.CompletionAudioTokensIsNil {
+		predicates = append(predicates, usagelog.CompletionAudioTokensIsNil())
 	}
-	if len(i.IDIn) > 0 {
-		predicates = append(predicates, thread.IDIn(i.IDIn...))
+	if i.CompletionAudioTokensNotNil {
+		predicates = append(predicates, usagelog.CompletionAudioTokensNotNil())
 	}
-	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, thread.IDNotIn(i.IDNotIn...))
+	if i.CompletionReasoningTokens != nil {
+		predicates = append(predicates, usagelog.CompletionReasoningTokensEQ(*i.CompletionReasoningTokens))
 	}
-	if i.IDGT != nil {
-		predicates = append(predicates, thread.IDGT(*i.IDGT))
+	if i.CompletionReasoningTokensNEQ != nil {
+		predicates = append(predicates, usagelog.CompletionReasoningTokensNEQ(*i.CompletionReasoningTokensNEQ))
 	}
-	if i.IDGTE != nil {
-		predicates = append(predicates, thread.IDGTE(*i.IDGTE))
+	if len(i.CompletionReasoningTokensIn) > 0 {
+		predicates = append(predicates, usagelog.CompletionReasoningTokensIn(i.CompletionReasoningTokensIn...))
 	}
-	if i.IDLT != nil {
-		predicates = append(predicates, thread.IDLT(*i.IDLT))
+	if len(i.CompletionReasoningTokensNotIn) > 0 {
+		predicates = append(predicates, usagelog.CompletionReasoningTokensNotIn(i.CompletionReasoningTokensNotIn...))
 	}
-	if i.IDLTE != nil {
-		predicates = append(predicates, thread.IDLTE(*i.IDLTE))
+	if i.CompletionReasoningTokensGT != nil {
+		predicates = append(predicates, usagelog.CompletionReasoningTokensGT(*i.CompletionReasoningTokensGT))
 	}
-	if i.CreatedAt != nil {
-		predicates = append(predicates, thread.CreatedAtEQ(*i.CreatedAt))
+	if i.CompletionReasoningTokensGTE != nil {
+		predicates = append(predicates, usagelog.CompletionReasoningTokensGTE(*i.CompletionReasoningTokensGTE))
 	}
-	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, thread.CreatedAtNEQ(*i.CreatedAtNEQ))
+	if i.CompletionReasoningTokensLT != nil {
+		predicates = append(predicates, usagelog.CompletionReasoningTokensLT(*i.CompletionReasoningTokensLT))
 	}
-	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, thread.CreatedAtIn(i.CreatedAtIn...))
+	if i.CompletionReasoningTokensLTE != nil {
+		predicates = append(predicates, usagelog.CompletionReasoningTokensLTE(*i.CompletionReasoningTokensLTE))
 	}
-	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, thread.CreatedAtNotIn(i.CreatedAtNotIn...))
+	if i.CompletionReasoningTokensIsNil {
+		predicates = append(predicates, usagelog.CompletionReasoningTokensIsNil())
 	}
-	if i.CreatedAtGT != nil {
-		predicates = append(predicates, thread.CreatedAtGT(*i.CreatedAtGT))
+	if i.CompletionReasoningTokensNotNil {
+		predicates = append(predicates, usagelog.CompletionReasoningTokensNotNil())
 	}
-	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, thread.CreatedAtGTE(*i.CreatedAtGTE))
+	if i.CompletionAcceptedPredictionTokens != nil {
+		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensEQ(*i.CompletionAcceptedPredictionTokens))
 	}
-	if i.CreatedAtLT != nil {
-		predicates = append(predicates, thread.CreatedAtLT(*i.CreatedAtLT))
+	if i.CompletionAcceptedPredictionTokensNEQ != nil {
+		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensNEQ(*i.CompletionAcceptedPredictionTokensNEQ))
 	}
-	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, thread.CreatedAtLTE(*i.CreatedAtLTE))
+	if len(i.CompletionAcceptedPredictionTokensIn) > 0 {
+		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensIn(i.CompletionAcceptedPredictionTokensIn...))
 	}
-	if i.UpdatedAt != nil {
-		predicates = append(predicates, thread.UpdatedAtEQ(*i.UpdatedAt))
+	if len(i.CompletionAcceptedPredictionTokensNotIn) > 0 {
+		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensNotIn(i.CompletionAcceptedPredictionTokensNotIn...))
 	}
-	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, thread.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	if i.CompletionAcceptedPredictionTokensGT != nil {
+		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensGT(*i.CompletionAcceptedPredictionTokensGT))
 	}
-	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, thread.UpdatedAtIn(i.UpdatedAtIn...))
+	if i.CompletionAcceptedPredictionTokensGTE != nil {
+		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensGTE(*i.CompletionAcceptedPredictionTokensGTE))
 	}
-	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, thread.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	if i.CompletionAcceptedPredictionTokensLT != nil {
+		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensLT(*i.CompletionAcceptedPredictionTokensLT))
 	}
-	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, thread.UpdatedAtGT(*i.UpdatedAtGT))
+	if i.CompletionAcceptedPredictionTokensLTE != nil {
+		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensLTE(*i.CompletionAcceptedPredictionTokensLTE))
 	}
-	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, thread.UpdatedAtGTE(*i.UpdatedAtGTE))
+	if i.CompletionAcceptedPredictionTokensIsNil {
+		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensIsNil())
 	}
-	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, thread.UpdatedAtLT(*i.UpdatedAtLT))
+	if i.CompletionAcceptedPredictionTokensNotNil {
+		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensNotNil())
 	}
-	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, thread.UpdatedAtLTE(*i.UpdatedAtLTE))
+	if i.CompletionRejectedPredictionTokens != nil {
+		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensEQ(*i.CompletionRejectedPredictionTokens))
 	}
-	if i.ProjectID != nil {
-		predicates = append(predicates, thread.ProjectIDEQ(*i.ProjectID))
+	if i.CompletionRejectedPredictionTokensNEQ != nil {
+		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensNEQ(*i.CompletionRejectedPredictionTokensNEQ))
 	}
-	if i.ProjectIDNEQ != nil {
-		predicates = append(predicates, thread.ProjectIDNEQ(*i.ProjectIDNEQ))
+	if len(i.CompletionRejectedPredictionTokensIn) > 0 {
+		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensIn(i.CompletionRejectedPredictionTokensIn...))
 	}
-	if len(i.ProjectIDIn) > 0 {
-		predicates = append(predicates, thread.ProjectIDIn(i.ProjectIDIn...))
+	if len(i.CompletionRejectedPredictionTokensNotIn) > 0 {
+		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensNotIn(i.CompletionRejectedPredictionTokensNotIn...))
 	}
-	if len(i.ProjectIDNotIn) > 0 {
-		predicates = append(predicates, thread.ProjectIDNotIn(i.ProjectIDNotIn...))
+	if i.CompletionRejectedPredictionTokensGT != nil {
+		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensGT(*i.CompletionRejectedPredictionTokensGT))
 	}
-	if i.ThreadID != nil {
-		predicates = append(predicates, thread.ThreadIDEQ(*i.ThreadID))
+	if i.CompletionRejectedPredictionTokensGTE != nil {
+		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensGTE(*i.CompletionRejectedPredictionTokensGTE))
 	}
-	if i.ThreadIDNEQ != nil {
-		predicates = append(predicates, thread.ThreadIDNEQ(*i.ThreadIDNEQ))
+	if i.CompletionRejectedPredictionTokensLT != nil {
+		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensLT(*i.CompletionRejectedPredictionTokensLT))
 	}
-	if len(i.ThreadIDIn) > 0 {
-		predicates = append(predicates, thread.ThreadIDIn(i.ThreadIDIn...))
+	if i.CompletionRejectedPredictionTokensLTE != nil {
+		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensLTE(*i.CompletionRejectedPredictionTokensLTE))
 	}
-	if len(i.ThreadIDNotIn) > 0 {
-		predicates = append(predicates, thread.ThreadIDNotIn(i.ThreadIDNotIn...))
+	if i.CompletionRejectedPredictionTokensIsNil {
+		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensIsNil())
 	}
-	if i.ThreadIDGT != nil {
-		predicates = append(predicates, thread.ThreadIDGT(*i.ThreadIDGT))
+	if i.CompletionRejectedPredictionTokensNotNil {
+		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensNotNil())
 	}
-	if i.ThreadIDGTE != nil {
-		predicates = append(predicates, thread.ThreadIDGTE(*i.ThreadIDGTE))
+	if i.Source != nil {
+		predicates = append(predicates, usagelog.SourceEQ(*i.Source))
 	}
-	if i.ThreadIDLT != nil {
-		predicates = append(predicates, thread.ThreadIDLT(*i.ThreadIDLT))
+	if i.SourceNEQ != nil {
+		predicates = append(predicates, usagelog.SourceNEQ(*i.SourceNEQ))
 	}
-	if i.ThreadIDLTE != nil {
-		predicates = append(predicates, thread.ThreadIDLTE(*i.ThreadIDLTE))
+	if len(i.SourceIn) > 0 {
+		predicates = append(predicates, usagelog.SourceIn(i.SourceIn...))
 	}
-	if i.ThreadIDContains != nil {
-		predicates = append(predicates, thread.ThreadIDContains(*i.ThreadIDContains))
+	if len(i.SourceNotIn) > 0 {
+		predicates = append(predicates, usagelog.SourceNotIn(i.SourceNotIn...))
 	}
-	if i.ThreadIDHasPrefix != nil {
-		predicates = append(predicates, thread.ThreadIDHasPrefix(*i.ThreadIDHasPrefix))
+	if i.Format != nil {
+		predicates = append(predicates, usagelog.FormatEQ(*i.Format))
 	}
-	if i.ThreadIDHasSuffix != nil {
-		predicates = append(predicates, thread.ThreadIDHasSuffix(*i.ThreadIDHasSuffix))
+	if i.FormatNEQ != nil {
+		predicates = append(predicates, usagelog.FormatNEQ(*i.FormatNEQ))
 	}
-	if i.ThreadIDEqualFold != nil {
-		predicates = append(predicates, thread.ThreadIDEqualFold(*i.ThreadIDEqualFold))
+	if len(i.FormatIn) > 0 {
+		predicates = append(predicates, usagelog.FormatIn(i.FormatIn...))
 	}
-	if i.ThreadIDContainsFold != nil {
-		predicates = append(predicates, thread.ThreadIDContainsFold(*i.ThreadIDContainsFold))
+	if len(i.FormatNotIn) > 0 {
+		predicates = append(predicates, usagelog.FormatNotIn(i.FormatNotIn...))
 	}
-	if i.Status != nil {
-		predicates = append(predicates, thread.StatusEQ(*i.Status))
+	if i.FormatGT != nil {
+		predicates = append(predicates, usagelog.FormatGT(*i.FormatGT))
 	}
-	if i.StatusNEQ != nil {
-		predicates = append(predicates, thread.StatusNEQ(*i.StatusNEQ))
+	if i.FormatGTE != nil {
+		predicates = append(predicates, usagelog.FormatGTE(*i.FormatGTE))
 	}
-	if len(i.StatusIn) > 0 {
-		predicates = append(predicates, thread.StatusIn(i.StatusIn...))
+	if i.FormatLT != nil {
+		predicates = append(predicates, usagelog.FormatLT(*i.FormatLT))
 	}
-	if len(i.StatusNotIn) > 0 {
-		predicates = append(predicates, thread.StatusNotIn(i.StatusNotIn...))
+	if i.FormatLTE != nil {
+		predicates = append(predicates, usagelog.FormatLTE(*i.FormatLTE))
 	}
-
-	if i.HasProject != nil {
-		p := thread.HasProject()
-		if !*i.HasProject {
-			p = thread.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.FormatContains != nil {
+		predicates = append(predicates, usagelog.FormatContains(*i.FormatContains))
 	}
-	if len(i.HasProjectWith) > 0 {
-		with := make([]predicate.Project, 0, len(i.HasProjectWith))
-		for _, w := range i.HasProjectWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasProjectWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, thread.HasProjectWith(with...))
+	if i.FormatHasPrefix != nil {
+		predicates = append(predicates, usagelog.FormatHasPrefix(*i.FormatHasPrefix))
 	}
-	if i.HasTraces != nil {
-		p := thread.HasTraces()
-		if !*i.HasTraces {
-			p = thread.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.FormatHasSuffix != nil {
+		predicates = append(predicates, usagelog.FormatHasSuffix(*i.FormatHasSuffix))
 	}
-	if len(i.HasTracesWith) > 0 {
-		with := make([]predicate.Trace, 0, len(i.HasTracesWith))
-		for _, w := range i.HasTracesWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasTracesWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, thread.HasTracesWith(with...))
+	if i.FormatEqualFold != nil {
+		predicates = append(predicates, usagelog.FormatEqualFold(*i.FormatEqualFold))
 	}
-	switch len(predicates) {
-	case 0:
-		return nil, ErrEmptyThreadWhereInput
-	case 1:
-		return predicates[0], nil
-	default:
-		return thread.And(predicates...), nil
+	if i.FormatContainsFold != nil {
+		predicates = append(predicates, usagelog.FormatContainsFold(*i.FormatContainsFold))
 	}
-}
-
-// TraceWhereInput represents a where input for filtering Trace queries.
-type TraceWhereInput struct {
-	Predicates []predicate.Trace  `json:"-"`
-	Not        *TraceWhereInput   `json:"not,omitempty"`
-	Or         []*TraceWhereInput `json:"or,omitempty"`
-	And        []*TraceWhereInput `json:"and,omitempty"`
-
-	// "id" field predicates.
-	ID      *int  `json:"id,omitempty"`
-	IDNEQ   *int  `json:"idNEQ,omitempty"`
-	IDIn    []int `json:"idIn,omitempty"`
-	IDNotIn []int `json:"idNotIn,omitempty"`
-	IDGT    *int  `json:"idGT,omitempty"`
-	IDGTE   *int  `json:"idGTE,omitempty"`
-	IDLT    *int  `json:"idLT,omitempty"`
-	IDLTE   *int  `json:"idLTE,omitempty"`
-
-	// "created_at" field predicates.
-	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
-	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
-	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
-	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
-	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
-	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
-	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
-	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
-
-	// "updated_at" field predicates.
-	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
-	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
-	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
-	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
-	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
-	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
-	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
-	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
-
-	// "project_id" field predicates.
-	ProjectID      *int  `json:"projectID,omitempty"`
-	ProjectIDNEQ   *int  `json:"projectIDNEQ,omitempty"`
-	ProjectIDIn    []int `json:"projectIDIn,omitempty"`
-	ProjectIDNotIn []int `json:"projectIDNotIn,omitempty"`
-
-	// "trace_id" field predicates.
-	TraceID             *string  `json:"traceID,omitempty"`
-	TraceIDNEQ          *string  `json:"traceIDNEQ,omitempty"`
-	TraceIDIn           []string `json:"traceIDIn,omitempty"`
-	TraceIDNotIn        []string `json:"traceIDNotIn,omitempty"`
-	TraceIDGT           *string  `json:"traceIDGT,omitempty"`
-	TraceIDGTE          *string  `json:"traceIDGTE,omitempty"`
-	TraceIDLT           *string  `json:"traceIDLT,omitempty"`
-	TraceIDLTE          *string  `json:"traceIDLTE,omitempty"`
-	TraceIDContains     *string  `json:"traceIDContains,omitempty"`
-	TraceIDHasPrefix    *string  `json:"traceIDHasPrefix,omitempty"`
-	TraceIDHasSuffix    *string  `json:"traceIDHasSuffix,omitempty"`
-	TraceIDEqualFold    *string  `json:"traceIDEqualFold,omitempty"`
-	TraceIDContainsFold *string  `json:"traceIDContainsFold,omitempty"`
-
-	// "thread_id" field predicates.
-	ThreadID       *int  `json:"threadID,omitempty"`
-	ThreadIDNEQ    *int  `json:"threadIDNEQ,omitempty"`
-	ThreadIDIn     []int `json:"threadIDIn,omitempty"`
-	ThreadIDNotIn  []int `json:"threadIDNotIn,omitempty"`
-	ThreadIDIsNil  bool  `json:"threadIDIsNil,omitempty"`
-	ThreadIDNotNil bool  `json:"threadIDNotNil,omitempty"`
-
-	// "status" field predicates.
-	Status      *trace.Status  `json:"status,omitempty"`
-	StatusNEQ   *trace.Status  `json:"statusNEQ,omitempty"`
-	StatusIn    []trace.Status `json:"statusIn,omitempty"`
-	StatusNotIn []trace.Status `json:"statusNotIn,omitempty"`
-
-	// "project" edge predicates.
-	HasProject     *bool                `json:"hasProject,omitempty"`
-	HasProjectWith []*ProjectWhereInput `json:"hasProjectWith,omitempty"`
-
-	// "thread" edge predicates.
-	HasThread     *bool               `json:"hasThread,omitempty"`
-	HasThreadWith []*ThreadWhereInput `json:"hasThreadWith,omitempty"`
-
-	// "requests" edge predicates.
-	HasRequests     *bool                `json:"hasRequests,omitempty"`
-	HasRequestsWith []*RequestWhereInput `json:"hasRequestsWith,omitempty"`
-}
-
-// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *TraceWhereInput) AddPredicates(predicates ...predicate.Trace) {
-	i.Predicates = append(i.Predicates, predicates...)
-}
-
-// Filter applies the TraceWhereInput filter on the TraceQuery builder.
-func (i *TraceWhereInput) Filter(q *TraceQuery) (*TraceQuery, error) {
-	if i == nil {
-		return q, nil
+	if i.TotalCost != nil {
+		predicates = append(predicates, usagelog.TotalCostEQ(*i.TotalCost))
 	}
-	p, err := i.P()
-	if err != nil {
-		if err == ErrEmptyTraceWhereInput {
-			return q, nil
-		}
-		return nil, err
+	if i.TotalCostNEQ != nil {
+		predicates = append(predicates, usagelog.TotalCostNEQ(*i.TotalCostNEQ))
 	}
-	return q.Where(p), nil
-}
-
-// ErrEmptyTraceWhereInput is returned in case the TraceWhereInput is empty.
-var ErrEmptyTraceWhereInput = errors.New("ent: empty predicate TraceWhereInput")
-
-// P returns a predicate for filtering traces.
-// An error is returned if the input is empty or invalid.
-func (i *TraceWhereInput) P() (predicate.Trace, error) {
-	var predicates []predicate.Trace
-	if i.Not != nil {
-		p, err := i.Not.P()
-		if err != nil {
-			return nil, fmt.Errorf("%w: field 'not'", err)
-		}
-		predicates = append(predicates, trace.Not(p))
+	if len(i.TotalCostIn) > 0 {
+		predicates = append(predicates, usagelog.TotalCostIn(i.TotalCostIn...))
 	}
-	switch n := len(i.Or); {
-	case n == 1:
-		p, err := i.Or[0].P()
-		if err != nil {
-			return nil, fmt.Errorf("%w: field 'or'", err)
-		}
-		predicates = append(predicates, p)
-	case n > 1:
-		or := make([]predicate.Trace, 0, n)
-		for _, w := range i.Or {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'or'", err)
-			}
-			or = append(or, p)
-		}
-		predicates = append(predicates, trace.Or(or...))
+	if len(i.TotalCostNotIn) > 0 {
+		predicates = append(predicates, usagelog.TotalCostNotIn(i.TotalCostNotIn...))
 	}
-	switch n := len(i.And); {
-	case n == 1:
-		p, err := i.And[0].P()
-		if err != nil {
-			return nil, fmt.Errorf("%w: field 'and'", err)
-		}
-		predicates = append(predicates, p)
-	case n > 1:
-		and := make([]predicate.Trace, 0, n)
-		for _, w := range i.And {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'and'", err)
-			}
-			and = append(and, p)
-		}
-		predicates = append(predicates, trace.And(and...))
+	if i.TotalCostGT != nil {
+		predicates = append(predicates, usagelog.TotalCostGT(*i.TotalCostGT))
 	}
-	predicates = append(predicates, i.Predicates...)
-	if i.ID != nil {
-		predicates = append(predicates, trace.IDEQ(*i.ID))
+	if i.TotalCostGTE != nil {
+		predicates = append(predicates, usagelog.TotalCostGTE(*i.TotalCostGTE))
 	}
-	if i.IDNEQ != nil {
-		predicates = append(predicates, trace.IDNEQ(*i.IDNEQ))
+	if i.TotalCostLT != nil {
+		predicates = append(predicates, usagelog.TotalCostLT(*i.TotalCostLT))
 	}
-	if len(i.IDIn) > 0 {
-		predicates = append(predicates, trace.IDIn(i.IDIn...))
+	if i.TotalCostLTE != nil {
+		predicates = append(predicates, usagelog.TotalCostLTE(*i.TotalCostLTE))
 	}
-	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, trace.IDNotIn(i.IDNotIn...))
+	if i.TotalCostIsNil {
+		predicates = append(predicates, usagelog.TotalCostIsNil())
 	}
-	if i.IDGT != nil {
-		predicates = append(predicates, trace.IDGT(*i.IDGT))
+	if i.TotalCostNotNil {
+		predicates = append(predicates, usagelog.TotalCostNotNil())
 	}
-	if i.IDGTE != nil {
-		predicates = append(predicates, trace.IDGTE(*i.IDGTE))
+	if i.CostPriceReferenceID != nil {
+		predicates = append(predicates, usagelog.CostPriceReferenceIDEQ(*i.CostPriceReferenceID))
 	}
-	if i.IDLT != nil {
-		predicates = append(predicates, trace.IDLT(*i.IDLT))
+	if i.CostPriceReferenceIDNEQ != nil {
+		predicates = append(predicates, usagelog.CostPriceReferenceIDNEQ(*i.CostPriceReferenceIDNEQ))
 	}
-	if i.IDLTE != nil {
-		predicates = append(predicates, trace.IDLTE(*i.IDLTE))
+	if len(i.CostPriceReferenceIDIn) > 0 {
+		predicates = append(predicates, usagelog.CostPriceReferenceIDIn(i.CostPriceReferenceIDIn...))
 	}
-	if i.CreatedAt != nil {
-		predicates = append(predicates, trace.CreatedAtEQ(*i.CreatedAt))
+	if len(i.CostPriceReferenceIDNotIn) > 0 {
+		predicates = append(predicates, usagelog.CostPriceReferenceIDNotIn(i.CostPriceReferenceIDNotIn...))
 	}
-	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, trace.CreatedAtNEQ(*i.CreatedAtNEQ))
+	if i.CostPriceReferenceIDGT != nil {
+		predicates = append(predicates, usagelog.CostPriceReferenceIDGT(*i.CostPriceReferenceIDGT))
 	}
-	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, trace.CreatedAtIn(i.CreatedAtIn...))
+	if i.CostPriceReferenceIDGTE != nil {
+		predicates = append(predicates, usagelog.CostPriceReferenceIDGTE(*i.CostPriceReferenceIDGTE))
 	}
-	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, trace.CreatedAtNotIn(i.CreatedAtNotIn...))
+	if i.CostPriceReferenceIDLT != nil {
+		predicates = append(predicates, usagelog.CostPriceReferenceIDLT(*i.CostPriceReferenceIDLT))
 	}
-	if i.CreatedAtGT != nil {
-		predicates = append(predicates, trace.CreatedAtGT(*i.CreatedAtGT))
+	if i.CostPriceReferenceIDLTE != nil {
+		predicates = append(predicates, usagelog.CostPriceReferenceIDLTE(*i.CostPriceReferenceIDLTE))
 	}
-	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, trace.CreatedAtGTE(*i.CreatedAtGTE))
+	if i.CostPriceReferenceIDContains != nil {
+		predicates = append(predicates, usagelog.CostPriceReferenceIDContains(*i.CostPriceReferenceIDContains))
 	}
-	if i.CreatedAtLT != nil {
-		predicates = append(predicates, trace.CreatedAtLT(*i.CreatedAtLT))
+	if i.CostPriceReferenceIDHasPrefix != nil {
+		predicates = append(predicates, usagelog.CostPriceReferenceIDHasPrefix(*i.CostPriceReferenceIDHasPrefix))
 	}
-	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, trace.CreatedAtLTE(*i.CreatedAtLTE))
+	if i.CostPriceReferenceIDHasSuffix != nil {
+		predicates = append(predicates, usagelog.CostPriceReferenceIDHasSuffix(*i.CostPriceReferenceIDHasSuffix))
 	}
-	if i.UpdatedAt != nil {
-		predicates = append(predicates, trace.UpdatedAtEQ(*i.UpdatedAt))
+	if i.CostPriceReferenceIDIsNil {
+		predicates = append(predicates, usagelog.CostPriceReferenceIDIsNil())
 	}
-	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, trace.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	if i.CostPriceReferenceIDNotNil {
+		predicates = append(predicates, usagelog.CostPriceReferenceIDNotNil())
 	}
-	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, trace.UpdatedAtIn(i.UpdatedAtIn...))
+	if i.CostPriceReferenceIDEqualFold != nil {
+		predicates = append(predicates, usagelog.CostPriceReferenceIDEqualFold(*i.CostPriceReferenceIDEqualFold))
 	}
-	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, trace.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	if i.CostPriceReferenceIDContainsFold != nil {
+		predicates = append(predicates, usagelog.CostPriceReferenceIDContainsFold(*i.CostPriceReferenceIDContainsFold))
 	}
-	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, trace.UpdatedAtGT(*i.UpdatedAtGT))
+	if i.Currency != nil {
+		predicates = append(predicates, usagelog.CurrencyEQ(*i.Currency))
 	}
-	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, trace.UpdatedAtGTE(*i.UpdatedAtGTE))
+	if i.CurrencyNEQ != nil {
+		predicates = append(predicates, usagelog.CurrencyNEQ(*i.CurrencyNEQ))
 	}
-	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, trace.UpdatedAtLT(*i.UpdatedAtLT))
+	if len(i.CurrencyIn) > 0 {
+		predicates = append(predicates, usagelog.CurrencyIn(i.CurrencyIn...))
 	}
-	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, trace.UpdatedAtLTE(*i.UpdatedAtLTE))
+	if len(i.CurrencyNotIn) > 0 {
+		predicates = append(predicates, usagelog.CurrencyNotIn(i.CurrencyNotIn...))
 	}
-	if i.ProjectID != nil {
-		predicates = append(predicates, trace.ProjectIDEQ(*i.ProjectID))
+	if i.CurrencyGT != nil {
+		predicates = append(predicates, usagelog.CurrencyGT(*i.CurrencyGT))
 	}
-	if i.ProjectIDNEQ != nil {
-		predicates = append(predicates, trace.ProjectIDNEQ(*i.ProjectIDNEQ))
+	if i.CurrencyGTE != nil {
+		predicates = append(predicates, usagelog.CurrencyGTE(*i.CurrencyGTE))
 	}
-	if len(i.ProjectIDIn) > 0 {
-		predicates = append(predicates, trace.ProjectIDIn(i.ProjectIDIn...))
+	if i.CurrencyLT != nil {
+		predicates = append(predicates, usagelog.CurrencyLT(*i.CurrencyLT))
 	}
-	if len(i.ProjectIDNotIn) > 0 {
-		predicates = append(predicates, trace.ProjectIDNotIn(i.ProjectIDNotIn...))
+	if i.CurrencyLTE != nil {
+		predicates = append(predicates, usagelog.CurrencyLTE(*i.CurrencyLTE))
 	}
-	if i.TraceID != nil {
-		predicates = append(predicates, trace.TraceIDEQ(*i.TraceID))
+	if i.CurrencyContains != nil {
+		predicates = append(predicates, usagelog.CurrencyContains(*i.CurrencyContains))
 	}
-	if i.TraceIDNEQ != nil {
-		predicates = append(predicates, trace.TraceIDNEQ(*i.TraceIDNEQ))
+	if i.CurrencyHasPrefix != nil {
+		predicates = append(predicates, usagelog.CurrencyHasPrefix(*i.CurrencyHasPrefix))
 	}
-	if len(i.TraceIDIn) > 0 {
-		predicates = append(predicates, trace.TraceIDIn(i.TraceIDIn...))
+	if i.CurrencyHasSuffix != nil {
+		predicates = append(predicates, usagelog.CurrencyHasSuffix(*i.CurrencyHasSuffix))
 	}
-	if len(i.TraceIDNotIn) > 0 {
-		predicates = append(predicates, trace.TraceIDNotIn(i.TraceIDNotIn...))
+	if i.CurrencyIsNil {
+		predicates = append(predicates, usagelog.CurrencyIsNil())
 	}
-	if i.TraceIDGT != nil {
-		predicates = append(predicates, trace.TraceIDGT(*i.TraceIDGT))
+	if i.CurrencyNotNil {
+		predicates = append(predicates, usagelog.CurrencyNotNil())
 	}
-	if i.TraceIDGTE != nil {
-		predicates = append(predicates, trace.TraceIDGTE(*i.TraceIDGTE))
+	if i.CurrencyEqualFold != nil {
+		predicates = append(predicates, usagelog.CurrencyEqualFold(*i.CurrencyEqualFold))
 	}
-	if i.TraceIDLT != nil {
-		predicates = append(predicates, trace.TraceIDLT(*i.TraceIDLT))
+	if i.CurrencyContainsFold != nil {
+		predicates = append(predicates, usagelog.CurrencyContainsFold(*i.CurrencyContainsFold))
 	}
-	if i.TraceIDLTE != nil {
-		predicates = append(predicates, trace.TraceIDLTE(*i.TraceIDLTE))
+	if i.OriginalTotalCost != nil {
+		predicates = append(predicates, usagelog.OriginalTotalCostEQ(*i.OriginalTotalCost))
 	}
-	if i.TraceIDContains != nil {
-		predicates = append(predicates, trace.TraceIDContains(*i.TraceIDContains))
+	if i.OriginalTotalCostNEQ != nil {
+		predicates = append(predicates, usagelog.OriginalTotalCostNEQ(*i.OriginalTotalCostNEQ))
 	}
-	if i.TraceIDHasPrefix != nil {
-		predicates = append(predicates, trace.TraceIDHasPrefix(*i.TraceIDHasPrefix))
+	if len(i.OriginalTotalCostIn) > 0 {
+		predicates = append(predicates, usagelog.OriginalTotalCostIn(i.OriginalTotalCostIn...))
 	}
-	if i.TraceIDHasSuffix != nil {
-		predicates = append(predicates, trace.TraceIDHasSuffix(*i.TraceIDHasSuffix))
+	if len(i.OriginalTotalCostNotIn) > 0 {
+		predicates = append(predicates, usagelog.OriginalTotalCostNotIn(i.OriginalTotalCostNotIn...))
 	}
-	if i.TraceIDEqualFold != nil {
-		predicates = append(predicates, trace.TraceIDEqualFold(*i.TraceIDEqualFold))
+	if i.OriginalTotalCostGT != nil {
+		predicates = append(predicates, usagelog.OriginalTotalCostGT(*i.OriginalTotalCostGT))
 	}
-	if i.TraceIDContainsFold != nil {
-		predicates = append(predicates, trace.TraceIDContainsFold(*i.TraceIDContainsFold))
+	if i.OriginalTotalCostGTE != nil {
+		predicates = append(predicates, usagelog.OriginalTotalCostGTE(*i.OriginalTotalCostGTE))
 	}
-	if i.ThreadID != nil {
-		predicates = append(predicates, trace.ThreadIDEQ(*i.ThreadID))
+	if i.OriginalTotalCostLT != nil {
+		predicates = append(predicates, usagelog.OriginalTotalCostLT(*i.OriginalTotalCostLT))
 	}
-	if i.ThreadIDNEQ != nil {
-		predicates = append(predicates, trace.ThreadIDNEQ(*i.ThreadIDNEQ))
+	if i.OriginalTotalCostLTE != nil {
+		predicates = append(predicates, usagelog.OriginalTotalCostLTE(*i.OriginalTotalCostLTE))
 	}
-	if len(i.ThreadIDIn) > 0 {
-		predicates = append(predicates, trace.ThreadIDIn(i.ThreadIDIn...))
+	if i.OriginalTotalCostIsNil {
+		predicates = append(predicates, usagelog.OriginalTotalCostIsNil())
 	}
-	if len(i.ThreadIDNotIn) > 0 {
-		predicates = append(predicates, trace.ThreadIDNotIn(i.ThreadIDNotIn...))
+	if i.OriginalTotalCostNotNil {
+		predicates = append(predicates, usagelog.OriginalTotalCostNotNil())
 	}
-	if i.ThreadIDIsNil {
-		predicates = append(predicates, trace.ThreadIDIsNil())
+	if i.OriginalCurrency != nil {
+		predicates = append(predicates, usagelog.OriginalCurrencyEQ(*i.OriginalCurrency))
 	}
-	if i.ThreadIDNotNil {
-		predicates = append(predicates, trace.ThreadIDNotNil())
+	if i.OriginalCurrencyNEQ != nil {
+		predicates = append(predicates, usagelog.OriginalCurrencyNEQ(*i.OriginalCurrencyNEQ))
 	}
-	if i.Status != nil {
-		predicates = append(predicates, trace.StatusEQ(*i.Status))
+	if len(i.OriginalCurrencyIn) > 0 {
+		predicates = append(predicates, usagelog.OriginalCurrencyIn(i.OriginalCurrencyIn...))
 	}
-	if i.StatusNEQ != nil {
-		predicates = append(predicates, trace.StatusNEQ(*i.StatusNEQ))
+	if len(i.OriginalCurrencyNotIn) > 0 {
+		predicates = append(predicates, usagelog.OriginalCurrencyNotIn(i.OriginalCurrencyNotIn...))
 	}
-	if len(i.StatusIn) > 0 {
-		predicates = append(predicates, trace.StatusIn(i.StatusIn...))
+	if i.OriginalCurrencyGT != nil {
+		predicates = append(predicates, usagelog.OriginalCurrencyGT(*i.OriginalCurrencyGT))
 	}
-	if len(i.StatusNotIn) > 0 {
-		predicates = append(predicates, trace.StatusNotIn(i.StatusNotIn...))
+	if i.OriginalCurrencyGTE != nil {
+		predicates = append(predicates, usagelog.OriginalCurrencyGTE(*i.OriginalCurrencyGTE))
+	}
+	if i.OriginalCurrencyLT != nil {
+		predicates = append(predicates, usagelog.OriginalCurrencyLT(*i.OriginalCurrencyLT))
+	}
+	if i.OriginalCurrencyLTE != nil {
+		predicates = append(predicates, usagelog.OriginalCurrencyLTE(*i.OriginalCurrencyLTE))
+	}
+	if i.OriginalCurrencyContains != nil {
+		predicates = append(predicates, usagelog.OriginalCurrencyContains(*i.OriginalCurrencyContains))
+	}
+	if i.OriginalCurrencyHasPrefix != nil {
+		predicates = append(predicates, usagelog.OriginalCurrencyHasPrefix(*i.OriginalCurrencyHasPrefix))
+	}
+	if i.OriginalCurrencyHasSuffix != nil {
+		predicates = append(predicates, usagelog.OriginalCurrencyHasSuffix(*i.OriginalCurrencyHasSuffix))
+	}
+	if i.OriginalCurrencyIsNil {
+		predicates = append(predicates, usagelog.OriginalCurrencyIsNil())
+	}
+	if i.OriginalCurrencyNotNil {
+		predicates = append(predicates, usagelog.OriginalCurrencyNotNil())
+	}
+	if i.OriginalCurrencyEqualFold != nil {
+		predicates = append(predicates, usagelog.OriginalCurrencyEqualFold(*i.OriginalCurrencyEqualFold))
+	}
+	if i.OriginalCurrencyContainsFold != nil {
+		predicates = append(predicates, usagelog.OriginalCurrencyContainsFold(*i.OriginalCurrencyContainsFold))
+	}
+	if i.ExchangeRate != nil {
+		predicates = append(predicates, usagelog.ExchangeRateEQ(*i.ExchangeRate))
+	}
+	if i.ExchangeRateNEQ != nil {
+		predicates = append(predicates, usagelog.ExchangeRateNEQ(*i.ExchangeRateNEQ))
+	}
+	if len(i.ExchangeRateIn) > 0 {
+		predicates = append(predicates, usagelog.ExchangeRateIn(i.ExchangeRateIn...))
+	}
+	if len(i.ExchangeRateNotIn) > 0 {
+		predicates = append(predicates, usagelog.ExchangeRateNotIn(i.ExchangeRateNotIn...))
+	}
+	if i.ExchangeRateGT != nil {
+		predicates = append(predicates, usagelog.ExchangeRateGT(*i.ExchangeRateGT))
+	}
+	if i.ExchangeRateGTE != nil {
+		predicates = append(predicates, usagelog.ExchangeRateGTE(*i.ExchangeRateGTE))
+	}
+	if i.ExchangeRateLT != nil {
+		predicates = append(predicates, usagelog.ExchangeRateLT(*i.ExchangeRateLT))
+	}
+	if i.ExchangeRateLTE != nil {
+		predicates = append(predicates, usagelog.ExchangeRateLTE(*i.ExchangeRateLTE))
+	}
+	if i.ExchangeRateIsNil {
+		predicates = append(predicates, usagelog.ExchangeRateIsNil())
+	}
+	if i.ExchangeRateNotNil {
+		predicates = append(predicates, usagelog.ExchangeRateNotNil())
 	}
 
-	if i.HasProject != nil {
-		p := trace.HasProject()
-		if !*i.HasProject {
-			p = trace.Not(p)
+	if i.HasRequest != nil {
+		p := usagelog.HasRequest()
+		if !*i.HasRequest {
+			p = usagelog.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasProjectWith) > 0 {
-		with := make([]predicate.Project, 0, len(i.HasProjectWith))
-		for _, w := range i.HasProjectWith {
+	if len(i.HasRequestWith) > 0 {
+		with := make([]predicate.Request, 0, len(i.HasRequestWith))
+		for _, w := range i.HasRequestWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasProjectWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasRequestWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, trace.HasProjectWith(with...))
+		predicates = append(predicates, usagelog.HasRequestWith(with...))
 	}
-	if i.HasThread != nil {
-		p := trace.HasThread()
-		if !*i.HasThread {
-			p = trace.Not(p)
+	if i.HasProject != nil {
+		p := usagelog.HasProject()
+		if !*i.HasProject {
+			p = usagelog.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasThreadWith) > 0 {
-		with := make([]predicate.Thread, 0, len(i.HasThreadWith))
-		for _, w := range i.HasThreadWith {
+	if len(i.HasProjectWith) > 0 {
+		with := make([]predicate.Project, 0, len(i.HasProjectWith))
+		for _, w := range i.HasProjectWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasThreadWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasProjectWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, trace.HasThreadWith(with...))
+		predicates = append(predicates, usagelog.HasProjectWith(with...))
 	}
-	if i.HasRequests != nil {
-		p := trace.HasRequests()
-		if !*i.HasRequests {
-			p = trace.Not(p)
+	if i.HasChannel != nil {
+		p := usagelog.HasChannel()
+		if !*i.HasChannel {
+			p = usagelog.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasRequestsWith) > 0 {
-		with := make([]predicate.Request, 0, len(i.HasRequestsWith))
-		for _, w := range i.HasRequestsWith {
+	if len(i.HasChannelWith) > 0 {
+		with := make([]predicate.Channel, 0, len(i.HasChannelWith))
+		for _, w := range i.HasChannelWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasRequestsWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasChannelWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, trace.HasRequestsWith(with...))
+		predicates = append(predicates, usagelog.HasChannelWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyTraceWhereInput
+		return nil, ErrEmptyUsageLogWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return trace.And(predicates...), nil
+		return usagelog.And(predicates...), nil
 	}
 }
 
-// UsageLogWhereInput represents a where input for filtering UsageLog queries.
-type UsageLogWhereInput struct {
-	Predicates []predicate.UsageLog  `json:"-"`
-	Not        *UsageLogWhereInput   `json:"not,omitempty"`
-	Or         []*UsageLogWhereInput `json:"or,omitempty"`
-	And        []*UsageLogWhereInput `json:"and,omitempty"`
+// UserWhereInput represents a where input for filtering User queries.
+type UserWhereInput struct {
+	Predicates []predicate.User  `json:"-"`
+	Not        *UserWhereInput   `json:"not,omitempty"`
+	Or         []*UserWhereInput `json:"or,omitempty"`
+	And        []*UserWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -9727,267 +14101,150 @@ type UsageLogWhereInput struct {
 	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
 	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
-	// "request_id" field predicates.
-	RequestID      *int  `json:"requestID,omitempty"`
-	RequestIDNEQ   *int  `json:"requestIDNEQ,omitempty"`
-	RequestIDIn    []int `json:"requestIDIn,omitempty"`
-	RequestIDNotIn []int `json:"requestIDNotIn,omitempty"`
-
-	// "api_key_id" field predicates.
-	APIKeyID       *int  `json:"apiKeyID,omitempty"`
-	APIKeyIDNEQ    *int  `json:"apiKeyIDNEQ,omitempty"`
-	APIKeyIDIn     []int `json:"apiKeyIDIn,omitempty"`
-	APIKeyIDNotIn  []int `json:"apiKeyIDNotIn,omitempty"`
-	APIKeyIDGT     *int  `json:"apiKeyIDGT,omitempty"`
-	APIKeyIDGTE    *int  `json:"apiKeyIDGTE,omitempty"`
-	APIKeyIDLT     *int  `json:"apiKeyIDLT,omitempty"`
-	APIKeyIDLTE    *int  `json:"apiKeyIDLTE,omitempty"`
-	APIKeyIDIsNil  bool  `json:"apiKeyIDIsNil,omitempty"`
-	APIKeyIDNotNil bool  `json:"apiKeyIDNotNil,omitempty"`
-
-	// "project_id" field predicates.
-	ProjectID      *int  `json:"projectID,omitempty"`
-	ProjectIDNEQ   *int  `json:"projectIDNEQ,omitempty"`
-	ProjectIDIn    []int `json:"projectIDIn,omitempty"`
-	ProjectIDNotIn []int `json:"projectIDNotIn,omitempty"`
-
-	// "channel_id" field predicates.
-	ChannelID       *int  `json:"channelID,omitempty"`
-	ChannelIDNEQ    *int  `json:"channelIDNEQ,omitempty"`
-	ChannelIDIn     []int `json:"channelIDIn,omitempty"`
-	ChannelIDNotIn  []int `json:"channelIDNotIn,omitempty"`
-	ChannelIDIsNil  bool  `json:"channelIDIsNil,omitempty"`
-	ChannelIDNotNil bool  `json:"channelIDNotNil,omitempty"`
-
-	// "model_id" field predicates.
-	ModelID             *string  `json:"modelID,omitempty"`
-	ModelIDNEQ          *string  `json:"modelIDNEQ,omitempty"`
-	ModelIDIn           []string `json:"modelIDIn,omitempty"`
-	ModelIDNotIn        []string `json:"modelIDNotIn,omitempty"`
-	ModelIDGT           *string  `json:"modelIDGT,omitempty"`
-	ModelIDGTE          *string  `json:"modelIDGTE,omitempty"`
-	ModelIDLT           *string  `json:"modelIDLT,omitempty"`
-	ModelIDLTE          *string  `json:"modelIDLTE,omitempty"`
-	ModelIDContains     *string  `json:"modelIDContains,omitempty"`
-	ModelIDHasPrefix    *string  `json:"modelIDHasPrefix,omitempty"`
-	ModelIDHasSuffix    *string  `json:"modelIDHasSuffix,omitempty"`
-	ModelIDEqualFold    *string  `json:"modelIDEqualFold,omitempty"`
-	ModelIDContainsFold *string  `json:"modelIDContainsFold,omitempty"`
-
-	// "prompt_tokens" field predicates.
-	PromptTokens      *int64  `json:"promptTokens,omitempty"`
-	PromptTokensNEQ   *int64  `json:"promptTokensNEQ,omitempty"`
-	PromptTokensIn    []int64 `json:"promptTokensIn,omitempty"`
-	PromptTokensNotIn []int64 `json:"promptTokensNotIn,omitempty"`
-	PromptTokensGT    *int64  `json:"promptTokensGT,omitempty"`
-	PromptTokensGTE   *int64  `json:"promptTokensGTE,omitempty"`
-	PromptTokensLT    *int64  `json:"promptTokensLT,omitempty"`
-	PromptTokensLTE   *int64  `json:"promptTokensLTE,omitempty"`
-
-	// "completion_tokens" field predicates.
-	CompletionTokens      *int64  `json:"completionTokens,omitempty"`
-	CompletionTokensNEQ   *int64  `json:"completionTokensNEQ,omitempty"`
-	CompletionTokensIn    []int64 `json:"completionTokensIn,omitempty"`
-	CompletionTokensNotIn []int64 `json:"completionTokensNotIn,omitempty"`
-	CompletionTokensGT    *int64  `json:"completionTokensGT,omitempty"`
-	CompletionTokensGTE   *int64  `json:"completionTokensGTE,omitempty"`
-	CompletionTokensLT    *int64  `json:"completionTokensLT,omitempty"`
-	CompletionTokensLTE   *int64  `json:"completionTokensLTE,omitempty"`
-
-	// "total_tokens" field predicates.
-	TotalTokens      *int64  `json:"totalTokens,omitempty"`
-	TotalTokensNEQ   *int64  `json:"totalTokensNEQ,omitempty"`
-	TotalTokensIn    []int64 `json:"totalTokensIn,omitempty"`
-	TotalTokensNotIn []int64 `json:"totalTokensNotIn,omitempty"`
-	TotalTokensGT    *int64  `json:"totalTokensGT,omitempty"`
-	TotalTokensGTE   *int64  `json:"totalTokensGTE,omitempty"`
-	TotalTokensLT    *int64  `json:"totalTokensLT,omitempty"`
-	TotalTokensLTE   *int64  `json:"totalTokensLTE,omitempty"`
-
-	// "prompt_audio_tokens" field predicates.
-	PromptAudioTokens       *int64  `json:"promptAudioTokens,omitempty"`
-	PromptAudioTokensNEQ    *int64  `json:"promptAudioTokensNEQ,omitempty"`
-	PromptAudioTokensIn     []int64 `json:"promptAudioTokensIn,omitempty"`
-	PromptAudioTokensNotIn  []int64 `json:"promptAudioTokensNotIn,omitempty"`
-	PromptAudioTokensGT     *int64  `json:"promptAudioTokensGT,omitempty"`
-	PromptAudioTokensGTE    *int64  `json:"promptAudioTokensGTE,omitempty"`
-	PromptAudioTokensLT     *int64  `json:"promptAudioTokensLT,omitempty"`
-	PromptAudioTokensLTE    *int64  `json:"promptAudioTokensLTE,omitempty"`
-	PromptAudioTokensIsNil  bool    `json:"promptAudioTokensIsNil,omitempty"`
-	PromptAudioTokensNotNil bool    `json:"promptAudioTokensNotNil,omitempty"`
-
-	// "prompt_cached_tokens" field predicates.
-	PromptCachedTokens       *int64  `json:"promptCachedTokens,omitempty"`
-	PromptCachedTokensNEQ    *int64  `json:"promptCachedTokensNEQ,omitempty"`
-	PromptCachedTokensIn     []int64 `json:"promptCachedTokensIn,omitempty"`
-	PromptCachedTokensNotIn  []int64 `json:"promptCachedTokensNotIn,omitempty"`
-	PromptCachedTokensGT     *int64  `json:"promptCachedTokensGT,omitempty"`
-	PromptCachedTokensGTE    *int64  `json:"promptCachedTokensGTE,omitempty"`
-	PromptCachedTokensLT     *int64  `json:"promptCachedTokensLT,omitempty"`
-	PromptCachedTokensLTE    *int64  `json:"promptCachedTokensLTE,omitempty"`
-	PromptCachedTokensIsNil  bool    `json:"promptCachedTokensIsNil,omitempty"`
-	PromptCachedTokensNotNil bool    `json:"promptCachedTokensNotNil,omitempty"`
-
-	// "prompt_write_cached_tokens" field predicates.
-	PromptWriteCachedTokens       *int64  `json:"promptWriteCachedTokens,omitempty"`
-	PromptWriteCachedTokensNEQ    *int64  `json:"promptWriteCachedTokensNEQ,omitempty"`
-	PromptWriteCachedTokensIn     []int64 `json:"promptWriteCachedTokensIn,omitempty"`
-	PromptWriteCachedTokensNotIn  []int64 `json:"promptWriteCachedTokensNotIn,omitempty"`
-	PromptWriteCachedTokensGT     *int64  `json:"promptWriteCachedTokensGT,omitempty"`
-	PromptWriteCachedTokensGTE    *int64  `json:"promptWriteCachedTokensGTE,omitempty"`
-	PromptWriteCachedTokensLT     *int64  `json:"promptWriteCachedTokensLT,omitempty"`
-	PromptWriteCachedTokensLTE    *int64  `json:"promptWriteCachedTokensLTE,omitempty"`
-	PromptWriteCachedTokensIsNil  bool    `json:"promptWriteCachedTokensIsNil,omitempty"`
-	PromptWriteCachedTokensNotNil bool    `json:"promptWriteCachedTokensNotNil,omitempty"`
+	// "email" field predicates.
+	Email             *string  `json:"email,omitempty"`
+	EmailNEQ          *string  `json:"emailNEQ,omitempty"`
+	EmailIn           []string `json:"emailIn,omitempty"`
+	EmailNotIn        []string `json:"emailNotIn,omitempty"`
+	EmailGT           *string  `json:"emailGT,omitempty"`
+	EmailGTE          *string  `json:"emailGTE,omitempty"`
+	EmailLT           *string  `json:"emailLT,omitempty"`
+	EmailLTE          *string  `json:"emailLTE,omitempty"`
+	EmailContains     *string  `json:"emailContains,omitempty"`
+	EmailHasPrefix    *string  `json:"emailHasPrefix,omitempty"`
+	EmailHasSuffix    *string  `json:"emailHasSuffix,omitempty"`
+	EmailEqualFold    *string  `json:"emailEqualFold,omitempty"`
+	EmailContainsFold *string  `json:"emailContainsFold,omitempty"`
 
-	// "prompt_write_cached_tokens_5m" field predicates.
-	PromptWriteCachedTokens5m       *int64  `json:"promptWriteCachedTokens5m,omitempty"`
-	PromptWriteCachedTokens5mNEQ    *int64  `json:"promptWriteCachedTokens5mNEQ,omitempty"`
-	PromptWriteCachedTokens5mIn     []int64 `json:"promptWriteCachedTokens5mIn,omitempty"`
-	PromptWriteCachedTokens5mNotIn  []int64 `json:"promptWriteCachedTokens5mNotIn,omitempty"`
-	PromptWriteCachedTokens5mGT     *int64  `json:"promptWriteCachedTokens5mGT,omitempty"`
-	PromptWriteCachedTokens5mGTE    *int64  `json:"promptWriteCachedTokens5mGTE,omitempty"`
-	PromptWriteCachedTokens5mLT     *int64  `json:"promptWriteCachedTokens5mLT,omitempty"`
-	PromptWriteCachedTokens5mLTE    *int64  `json:"promptWriteCachedTokens5mLTE,omitempty"`
-	PromptWriteCachedTokens5mIsNil  bool    `json:"promptWriteCachedTokens5mIsNil,omitempty"`
-	PromptWriteCachedTokens5mNotNil bool    `json:"promptWriteCachedTokens5mNotNil,omitempty"`
+	// "status" field predicates.
+	Status      *user.Status  `json:"status,omitempty"`
+	StatusNEQ   *user.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []user.Status `json:"statusIn,omitempty"`
+	StatusNotIn []user.Status `json:"statusNotIn,omitempty"`
 
-	// "prompt_write_cached_tokens_1h" field predicates.
-	PromptWriteCachedTokens1h       *int64  `json:"promptWriteCachedTokens1h,omitempty"`
-	PromptWriteCachedTokens1hNEQ    *int64  `json:"promptWriteCachedTokens1hNEQ,omitempty"`
-	PromptWriteCachedTokens1hIn     []int64 `json:"promptWriteCachedTokens1hIn,omitempty"`
-	PromptWriteCachedTokens1hNotIn  []int64 `json:"promptWriteCachedTokens1hNotIn,omitempty"`
-	PromptWriteCachedTokens1hGT     *int64  `json:"promptWriteCachedTokens1hGT,omitempty"`
-	PromptWriteCachedTokens1hGTE    *int64  `json:"promptWriteCachedTokens1hGTE,omitempty"`
-	PromptWriteCachedTokens1hLT     *int64  `json:"promptWriteCachedTokens1hLT,omitempty"`
-	PromptWriteCachedTokens1hLTE    *int64  `json:"promptWriteCachedTokens1hLTE,omitempty"`
-	PromptWriteCachedTokens1hIsNil  bool    `json:"promptWriteCachedTokens1hIsNil,omitempty"`
-	PromptWriteCachedTokens1hNotNil bool    `json:"promptWriteCachedTokens1hNotNil,omitempty"`
+	// "prefer_language" field predicates.
+	PreferLanguage             *string  `json:"preferLanguage,omitempty"`
+	PreferLanguageNEQ          *string  `json:"preferLanguageNEQ,omitempty"`
+	PreferLanguageIn           []string `json:"preferLanguageIn,omitempty"`
+	PreferLanguageNotIn        []string `json:"preferLanguageNotIn,omitempty"`
+	PreferLanguageGT           *string  `json:"preferLanguageGT,omitempty"`
+	PreferLanguageGTE          *string  `json:"preferLanguageGTE,omitempty"`
+	PreferLanguageLT           *string  `json:"preferLanguageLT,omitempty"`
+	PreferLanguageLTE          *string  `json:"preferLanguageLTE,omitempty"`
+	PreferLanguageContains     *string  `json:"preferLanguageContains,omitempty"`
+	PreferLanguageHasPrefix    *string  `json:"preferLanguageHasPrefix,omitempty"`
+	PreferLanguageHasSuffix    *string  `json:"preferLanguageHasSuffix,omitempty"`
+	PreferLanguageEqualFold    *string  `json:"preferLanguageEqualFold,omitempty"`
+	PreferLanguageContainsFold *string  `json:"preferLanguageContainsFold,omitempty"`
 
-	// "completion_audio_tokens" field predicates.
-	CompletionAudioTokens       *int64  `json:"completionAudioTokens,omitempty"`
-	CompletionAudioTokensNEQ    *int64  `json:"completionAudioTokensNEQ,omitempty"`
-	CompletionAudioTokensIn     []int64 `json:"completionAudioTokensIn,omitempty"`
-	CompletionAudioTokensNotIn  []int64 `json:"completionAudioTokensNotIn,omitempty"`
-	CompletionAudioTokensGT     *int64  `json:"completionAudioTokensGT,omitempty"`
-	CompletionAudioTokensGTE    *int64  `json:"completionAudioTokensGTE,omitempty"`
-	CompletionAudioTokensLT     *int64  `json:"completionAudioTokensLT,omitempty"`
-	CompletionAudioTokensLTE    *int64  `json:"completionAudioTokensLTE,omitempty"`
-	CompletionAudioTokensIsNil  bool    `json:"completionAudioTokensIsNil,omitempty"`
-	CompletionAudioTokensNotNil bool    `json:"completionAudioTokensNotNil,omitempty"`
+	// "password" field predicates.
+	Password             *string  `json:"password,omitempty"`
+	PasswordNEQ          *string  `json:"passwordNEQ,omitempty"`
+	PasswordIn           []string `json:"passwordIn,omitempty"`
+	PasswordNotIn        []string `json:"passwordNotIn,omitempty"`
+	PasswordGT           *string  `json:"passwordGT,omitempty"`
+	PasswordGTE          *string  `json:"passwordGTE,omitempty"`
+	PasswordLT           *string  `json:"passwordLT,omitempty"`
+	PasswordLTE          *string  `json:"passwordLTE,omitempty"`
+	PasswordContains     *string  `json:"passwordContains,omitempty"`
+	PasswordHasPrefix    *string  `json:"passwordHasPrefix,omitempty"`
+	PasswordHasSuffix    *string  `json:"passwordHasSuffix,omitempty"`
+	PasswordEqualFold    *string  `json:"passwordEqualFold,omitempty"`
+	PasswordContainsFold *string  `json:"passwordContainsFold,omitempty"`
 
-	// "completion_reasoning_tokens" field predicates.
-	CompletionReasoningTokens       *int64  `json:"completionReasoningTokens,omitempty"`
-	CompletionReasoningTokensNEQ    *int64  `json:"completionReasoningTokensNEQ,omitempty"`
-	CompletionReasoningTokensIn     []int64 `json:"completionReasoningTokensIn,omitempty"`
-	CompletionReasoningTokensNotIn  []int64 `json:"completionReasoningTokensNotIn,omitempty"`
-	CompletionReasoningTokensGT     *int64  `json:"completionReasoningTokensGT,omitempty"`
-	CompletionReasoningTokensGTE    *int64  `json:"completionReasoningTokensGTE,omitempty"`
-	CompletionReasoningTokensLT     *int64  `json:"completionReasoningTokensLT,omitempty"`
-	CompletionReasoningTokensLTE    *int64  `json:"completionReasoningTokensLTE,omitempty"`
-	CompletionReasoningTokensIsNil  bool    `json:"completionReasoningTokensIsNil,omitempty"`
-	CompletionReasoningTokensNotNil bool    `json:"completionReasoningTokensNotNil,omitempty"`
+	// "first_name" field predicates.
+	FirstName             *string  `json:"firstName,omitempty"`
+	FirstNameNEQ          *string  `json:"firstNameNEQ,omitempty"`
+	FirstNameIn           []string `json:"firstNameIn,omitempty"`
+	FirstNameNotIn        []string `json:"firstNameNotIn,omitempty"`
+	FirstNameGT           *string  `json:"firstNameGT,omitempty"`
+	FirstNameGTE          *string  `json:"firstNameGTE,omitempty"`
+	FirstNameLT           *string  `json:"firstNameLT,omitempty"`
+	FirstNameLTE          *string  `json:"firstNameLTE,omitempty"`
+	FirstNameContains     *string  `json:"firstNameContains,omitempty"`
+	FirstNameHasPrefix    *string  `json:"firstNameHasPrefix,omitempty"`
+	FirstNameHasSuffix    *string  `json:"firstNameHasSuffix,omitempty"`
+	FirstNameEqualFold    *string  `json:"firstNameEqualFold,omitempty"`
+	FirstNameContainsFold *string  `json:"firstNameContainsFold,omitempty"`
 
-	// "completion_accepted_prediction_tokens" field predicates.
-	CompletionAcceptedPredictionTokens       *int64  `json:"completionAcceptedPredictionTokens,omitempty"`
-	CompletionAcceptedPredictionTokensNEQ    *int64  `json:"completionAcceptedPredictionTokensNEQ,omitempty"`
-	CompletionAcceptedPredictionTokensIn     []int64 `json:"completionAcceptedPredictionTokensIn,omitempty"`
-	CompletionAcceptedPredictionTokensNotIn  []int64 `json:"completionAcceptedPredictionTokensNotIn,omitempty"`
-	CompletionAcceptedPredictionTokensGT     *int64  `json:"completionAcceptedPredictionTokensGT,omitempty"`
-	CompletionAcceptedPredictionTokensGTE    *int64  `json:"completionAcceptedPredictionTokensGTE,omitempty"`
-	CompletionAcceptedPredictionTokensLT     *int64  `json:"completionAcceptedPredictionTokensLT,omitempty"`
-	CompletionAcceptedPredictionTokensLTE    *int64  `json:"completionAcceptedPredictionTokensLTE,omitempty"`
-	CompletionAcceptedPredictionTokensIsNil  bool    `json:"completionAcceptedPredictionTokensIsNil,omitempty"`
-	CompletionAcceptedPredictionTokensNotNil bool    `json:"completionAcceptedPredictionTokensNotNil,omitempty"`
+	// "last_name" field predicates.
+	LastName             *string  `json:"lastName,omitempty"`
+	LastNameNEQ          *string  `json:"lastNameNEQ,omitempty"`
+	LastNameIn           []string `json:"lastNameIn,omitempty"`
+	LastNameNotIn        []string `json:"lastNameNotIn,omitempty"`
+	LastNameGT           *string  `json:"lastNameGT,omitempty"`
+	LastNameGTE          *string  `json:"lastNameGTE,omitempty"`
+	LastNameLT           *string  `json:"lastNameLT,omitempty"`
+	LastNameLTE          *string  `json:"lastNameLTE,omitempty"`
+	LastNameContains     *string  `json:"lastNameContains,omitempty"`
+	LastNameHasPrefix    *string  `json:"lastNameHasPrefix,omitempty"`
+	LastNameHasSuffix    *string  `json:"lastNameHasSuffix,omitempty"`
+	LastNameEqualFold    *string  `json:"lastNameEqualFold,omitempty"`
+	LastNameContainsFold *string  `json:"lastNameContainsFold,omitempty"`
 
-	// "completion_rejected_prediction_tokens" field predicates.
-	CompletionRejectedPredictionTokens       *int64  `json:"completionRejectedPredictionTokens,omitempty"`
-	CompletionRejectedPredictionTokensNEQ    *int64  `json:"completionRejectedPredictionTokensNEQ,omitempty"`
-	CompletionRejectedPredictionTokensIn     []int64 `json:"completionRejectedPredictionTokensIn,omitempty"`
-	CompletionRejectedPredictionTokensNotIn  []int64 `json:"completionRejectedPredictionTokensNotIn,omitempty"`
-	CompletionRejectedPredictionTokensGT     *int64  `json:"completionRejectedPredictionTokensGT,omitempty"`
-	CompletionRejectedPredictionTokensGTE    *int64  `json:"completionRejectedPredictionTokensGTE,omitempty"`
-	CompletionRejectedPredictionTokensLT     *int64  `json:"completionRejectedPredictionTokensLT,omitempty"`
-	CompletionRejectedPredictionTokensLTE    *int64  `json:"completionRejectedPredictionTokensLTE,omitempty"`
-	CompletionRejectedPredictionTokensIsNil  bool    `json:"completionRejectedPredictionTokensIsNil,omitempty"`
-	CompletionRejectedPredictionTokensNotNil bool    `json:"completionRejectedPredictionTokensNotNil,omitempty"`
+	// "avatar" field predicates.
+	Avatar             *string  `json:"avatar,omitempty"`
+	AvatarNEQ          *string  `json:"avatarNEQ,omitempty"`
+	AvatarIn           []string `json:"avatarIn,omitempty"`
+	AvatarNotIn        []string `json:"avatarNotIn,omitempty"`
+	AvatarGT           *string  `json:"avatarGT,omitempty"`
+	AvatarGTE          *string  `json:"avatarGTE,omitempty"`
+	AvatarLT           *string  `json:"avatarLT,omitempty"`
+	AvatarLTE          *string  `json:"avatarLTE,omitempty"`
+	AvatarContains     *string  `json:"avatarContains,omitempty"`
+	AvatarHasPrefix    *string  `json:"avatarHasPrefix,omitempty"`
+	AvatarHasSuffix    *string  `json:"avatarHasSuffix,omitempty"`
+	AvatarIsNil        bool     `json:"avatarIsNil,omitempty"`
+	AvatarNotNil       bool     `json:"avatarNotNil,omitempty"`
+	AvatarEqualFold    *string  `json:"avatarEqualFold,omitempty"`
+	AvatarContainsFold *string  `json:"avatarContainsFold,omitempty"`
 
-	// "source" field predicates.
-	Source      *usagelog.Source  `json:"source,omitempty"`
-	SourceNEQ   *usagelog.Source  `json:"sourceNEQ,omitempty"`
-	SourceIn    []usagelog.Source `json:"sourceIn,omitempty"`
-	SourceNotIn []usagelog.Source `json:"sourceNotIn,omitempty"`
+	// "is_owner" field predicates.
+	IsOwner    *bool `json:"isOwner,omitempty"`
+	IsOwnerNEQ *bool `json:"isOwnerNEQ,omitempty"`
 
-	// "format" field predicates.
-	Format             *string  `json:"format,omitempty"`
-	FormatNEQ          *string  `json:"formatNEQ,omitempty"`
-	FormatIn           []string `json:"formatIn,omitempty"`
-	FormatNotIn        []string `json:"formatNotIn,omitempty"`
-	FormatGT           *string  `json:"formatGT,omitempty"`
-	FormatGTE          *string  `json:"formatGTE,omitempty"`
-	FormatLT           *string  `json:"formatLT,omitempty"`
-	FormatLTE          *string  `json:"formatLTE,omitempty"`
-	FormatContains     *string  `json:"formatContains,omitempty"`
-	FormatHasPrefix    *string  `json:"formatHasPrefix,omitempty"`
-	FormatHasSuffix    *string  `json:"formatHasSuffix,omitempty"`
-	FormatEqualFold    *string  `json:"formatEqualFold,omitempty"`
-	FormatContainsFold *string  `json:"formatContainsFold,omitempty"`
+	// "projects" edge predicates.
+	HasProjects     *bool                `json:"hasProjects,omitempty"`
+	HasProjectsWith []*ProjectWhereInput `json:"hasProjectsWith,omitempty"`
 
-	// "total_cost" field predicates.
-	TotalCost       *float64  `json:"totalCost,omitempty"`
-	TotalCostNEQ    *float64  `json:"totalCostNEQ,omitempty"`
-	TotalCostIn     []float64 `json:"totalCostIn,omitempty"`
-	TotalCostNotIn  []float64 `json:"totalCostNotIn,omitempty"`
-	TotalCostGT     *float64  `json:"totalCostGT,omitempty"`
-	TotalCostGTE    *float64  `json:"totalCostGTE,omitempty"`
-	TotalCostLT     *float64  `json:"totalCostLT,omitempty"`
-	TotalCostLTE    *float64  `json:"totalCostLTE,omitempty"`
-	TotalCostIsNil  bool      `json:"totalCostIsNil,omitempty"`
-	TotalCostNotNil bool      `json:"totalCostNotNil,omitempty"`
+	// "api_keys" edge predicates.
+	HasAPIKeys     *bool               `json:"hasAPIKeys,omitempty"`
+	HasAPIKeysWith []*APIKeyWhereInput `json:"hasAPIKeysWith,omitempty"`
 
-	// "cost_price_reference_id" field predicates.
-	CostPriceReferenceID             *string  `json:"costPriceReferenceID,omitempty"`
-	CostPriceReferenceIDNEQ          *string  `json:"costPriceReferenceIDNEQ,omitempty"`
-	CostPriceReferenceIDIn           []string `json:"costPriceReferenceIDIn,omitempty"`
-	CostPriceReferenceIDNotIn        []string `json:"costPriceReferenceIDNotIn,omitempty"`
-	CostPriceReferenceIDGT           *string  `json:"costPriceReferenceIDGT,omitempty"`
-	CostPriceReferenceIDGTE          *string  `json:"costPriceReferenceIDGTE,omitempty"`
-	CostPriceReferenceIDLT           *string  `json:"costPriceReferenceIDLT,omitempty"`
-	CostPriceReferenceIDLTE          *string  `json:"costPriceReferenceIDLTE,omitempty"`
-	CostPriceReferenceIDContains     *string  `json:"costPriceReferenceIDContains,omitempty"`
-	CostPriceReferenceIDHasPrefix    *string  `json:"costPriceReferenceIDHasPrefix,omitempty"`
-	CostPriceReferenceIDHasSuffix    *string  `json:"costPriceReferenceIDHasSuffix,omitempty"`
-	CostPriceReferenceIDIsNil        bool     `json:"costPriceReferenceIDIsNil,omitempty"`
-	CostPriceReferenceIDNotNil       bool     `json:"costPriceReferenceIDNotNil,omitempty"`
-	CostPriceReferenceIDEqualFold    *string  `json:"costPriceReferenceIDEqualFold,omitempty"`
-	CostPriceReferenceIDContainsFold *string  `json:"costPriceReferenceIDContainsFold,omitempty"`
+	// "roles" edge predicates.
+	HasRoles     *bool             `json:"hasRoles,omitempty"`
+	HasRolesWith []*RoleWhereInput `json:"hasRolesWith,omitempty"`
 
-	// "request" edge predicates.
-	HasRequest     *bool                `json:"hasRequest,omitempty"`
-	HasRequestWith []*RequestWhereInput `json:"hasRequestWith,omitempty"`
+	// "channel_override_templates" edge predicates.
+	HasChannelOverrideTemplates     *bool                                `json:"hasChannelOverrideTemplates,omitempty"`
+	HasChannelOverrideTemplatesWith []*ChannelOverrideTemplateWhereInput `json:"hasChannelOverrideTemplatesWith,omitempty"`
 
-	// "project" edge predicates.
-	HasProject     *bool                `json:"hasProject,omitempty"`
-	HasProjectWith []*ProjectWhereInput `json:"hasProjectWith,omitempty"`
+	// "oidc_identities" edge predicates.
+	HasOidcIdentities     *bool                     `json:"hasOidcIdentities,omitempty"`
+	HasOidcIdentitiesWith []*OIDCIdentityWhereInput `json:"hasOidcIdentitiesWith,omitempty"`
 
-	// "channel" edge predicates.
-	HasChannel     *bool                `json:"hasChannel,omitempty"`
-	HasChannelWith []*ChannelWhereInput `json:"hasChannelWith,omitempty"`
+	// "project_users" edge predicates.
+	HasProjectUsers     *bool                    `json:"hasProjectUsers,omitempty"`
+	HasProjectUsersWith []*UserProjectWhereInput `json:"hasProjectUsersWith,omitempty"`
+
+	// "user_roles" edge predicates.
+	HasUserRoles     *bool                 `json:"hasUserRoles,omitempty"`
+	HasUserRolesWith []*UserRoleWhereInput `json:"hasUserRolesWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *UsageLogWhereInput) AddPredicates(predicates ...predicate.UsageLog) {
+func (i *UserWhereInput) AddPredicates(predicates ...predicate.User) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the UsageLogWhereInput filter on the UsageLogQuery builder.
-func (i *UsageLogWhereInput) Filter(q *UsageLogQuery) (*UsageLogQuery, error) {
+// Filter applies the UserWhereInput filter on the UserQuery builder.
+func (i *UserWhereInput) Filter(q *UserQuery) (*UserQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyUsageLogWhereInput {
+		if err == ErrEmptyUserWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -9995,19 +14252,19 @@ func (i *UsageLogWhereInput) Filter(q *UsageLogQuery) (*UsageLogQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyUsageLogWhereInput is returned in case the UsageLogWhereInput is empty.
-var ErrEmptyUsageLogWhereInput = errors.New("ent: empty predicate UsageLogWhereInput")
+// ErrEmptyUserWhereInput is returned in case the UserWhereInput is empty.
+var ErrEmptyUserWhereInput = errors.New("ent: empty predicate UserWhereInput")
 
-// P returns a predicate for filtering usagelogs.
+// P returns a predicate for filtering users.
 // An error is returned if the input is empty or invalid.
-func (i *UsageLogWhereInput) P() (predicate.UsageLog, error) {
-	var predicates []predicate.UsageLog
+func (i *UserWhereInput) P() (predicate.User, error) {
+	var predicates []predicate.User
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, usagelog.Not(p))
+		predicates = append(predicates, user.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -10017,7 +14274,7 @@ func (i *UsageLogWhereInput) P() (predicate.UsageLog, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.UsageLog, 0, n)
+		or := make([]predicate.User, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -10025,7 +14282,7 @@ func (i *UsageLogWhereInput) P() (predicate.UsageLog, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, usagelog.Or(or...))
+		predicates = append(predicates, user.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -10035,7 +14292,7 @@ func (i *UsageLogWhereInput) P() (predicate.UsageLog, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.UsageLog, 0, n)
+		and := make([]predicate.User, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -10043,906 +14300,1159 @@ func (i *UsageLogWhereInput) P() (predicate.UsageLog, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, usagelog.And(and...))
+		predicates = append(predicates, user.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, usagelog.IDEQ(*i.ID))
+		predicates = append(predicates, user.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, usagelog.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, user.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, usagelog.IDIn(i.IDIn...))
+		predicates = append(predicates, user.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, usagelog.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, user.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, usagelog.IDGT(*i.IDGT))
+		predicates = append(predicates, user.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, usagelog.IDGTE(*i.IDGTE))
+		predicates = append(predicates, user.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, usagelog.IDLT(*i.IDLT))
+		predicates = append(predicates, user.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, usagelog.IDLTE(*i.IDLTE))
+		predicates = append(predicates, user.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, usagelog.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, user.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, usagelog.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, user.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, usagelog.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, user.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, usagelog.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, user.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, usagelog.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, user.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, usagelog.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, user.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, usagelog.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, user.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, usagelog.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, user.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, usagelog.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, user.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, usagelog.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, user.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, usagelog.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, user.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, usagelog.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, user.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, usagelog.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, user.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, usagelog.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, user.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, usagelog.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, user.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, usagelog.UpdatedAtLTE(*i.UpdatedAtLTE))
-	}
-	if i.RequestID != nil {
-		predicates = append(predicates, usagelog.RequestIDEQ(*i.RequestID))
-	}
-	if i.RequestIDNEQ != nil {
-		predicates = append(predicates, usagelog.RequestIDNEQ(*i.RequestIDNEQ))
-	}
-	if len(i.RequestIDIn) > 0 {
-		predicates = append(predicates, usagelog.RequestIDIn(i.RequestIDIn...))
-	}
-	if len(i.RequestIDNotIn) > 0 {
-		predicates = append(predicates, usagelog.RequestIDNotIn(i.RequestIDNotIn...))
-	}
-	if i.APIKeyID != nil {
-		predicates = append(predicates, usagelog.APIKeyIDEQ(*i.APIKeyID))
-	}
-	if i.APIKeyIDNEQ != nil {
-		predicates = append(predicates, usagelog.APIKeyIDNEQ(*i.APIKeyIDNEQ))
-	}
-	if len(i.APIKeyIDIn) > 0 {
-		predicates = append(predicates, usagelog.APIKeyIDIn(i.APIKeyIDIn...))
-	}
-	if len(i.APIKeyIDNotIn) > 0 {
-		predicates = append(predicates, usagelog.APIKeyIDNotIn(i.APIKeyIDNotIn...))
-	}
-	if i.APIKeyIDGT != nil {
-		predicates = append(predicates, usagelog.APIKeyIDGT(*i.APIKeyIDGT))
-	}
-	if i.APIKeyIDGTE != nil {
-		predicates = append(predicates, usagelog.APIKeyIDGTE(*i.APIKeyIDGTE))
+		predicates = append(predicates, user.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.APIKeyIDLT != nil {
-		predicates = append(predicates, usagelog.APIKeyIDLT(*i.APIKeyIDLT))
+	if i.Email != nil {
+		predicates = append(predicates, user.EmailEQ(*i.Email))
 	}
-	if i.APIKeyIDLTE != nil {
-		predicates = append(predicates, usagelog.APIKeyIDLTE(*i.APIKeyIDLTE))
+	if i.EmailNEQ != nil {
+		predicates = append(predicates, user.EmailNEQ(*i.EmailNEQ))
 	}
-	if i.APIKeyIDIsNil {
-		predicates = append(predicates, usagelog.APIKeyIDIsNil())
+	if len(i.EmailIn) > 0 {
+		predicates = append(predicates, user.EmailIn(i.EmailIn...))
 	}
-	if i.APIKeyIDNotNil {
-		predicates = append(predicates, usagelog.APIKeyIDNotNil())
+	if len(i.EmailNotIn) > 0 {
+		predicates = append(predicates, user.EmailNotIn(i.EmailNotIn...))
 	}
-	if i.ProjectID != nil {
-		predicates = append(predicates, usagelog.ProjectIDEQ(*i.ProjectID))
+	if i.EmailGT != nil {
+		predicates = append(predicates, user.EmailGT(*i.EmailGT))
 	}
-	if i.ProjectIDNEQ != nil {
-		predicates = append(predicates, usagelog.ProjectIDNEQ(*i.ProjectIDNEQ))
+	if i.EmailGTE != nil {
+		predicates = append(predicates, user.EmailGTE(*i.EmailGTE))
 	}
-	if len(i.ProjectIDIn) > 0 {
-		predicates = append(predicates, usagelog.ProjectIDIn(i.ProjectIDIn...))
+	if i.EmailLT != nil {
+		predicates = append(predicates, user.EmailLT(*i.EmailLT))
 	}
-	if len(i.ProjectIDNotIn) > 0 {
-		predicates = append(predicates, usagelog.ProjectIDNotIn(i.ProjectIDNotIn...))
+	if i.EmailLTE != nil {
+		predicates = append(predicates, user.EmailLTE(*i.EmailLTE))
 	}
-	if i.ChannelID != nil {
-		predicates = append(predicates, usagelog.ChannelIDEQ(*i.ChannelID))
+	if i.EmailContains != nil {
+		predicates = append(predicates, user.EmailContains(*i.EmailContains))
 	}
-	if i.ChannelIDNEQ != nil {
-		predicates = append(predicates, usagelog.ChannelIDNEQ(*i.ChannelIDNEQ))
+	if i.EmailHasPrefix != nil {
+		predicates = append(predicates, user.EmailHasPrefix(*i.EmailHasPrefix))
 	}
-	if len(i.ChannelIDIn) > 0 {
-		predicates = append(predicates, usagelog.ChannelIDIn(i.ChannelIDIn...))
+	if i.EmailHasSuffix != nil {
+		predicates = append(predicates, user.EmailHasSuffix(*i.EmailHasSuffix))
 	}
-	if len(i.ChannelIDNotIn) > 0 {
-		predicates = append(predicates, usagelog.ChannelIDNotIn(i.ChannelIDNotIn...))
+	if i.EmailEqualFold != nil {
+		predicates = append(predicates, user.EmailEqualFold(*i.EmailEqualFold))
 	}
-	if i.ChannelIDIsNil {
-		predicates = append(predicates, usagelog.ChannelIDIsNil())
+	if i.EmailContainsFold != nil {
+		predicates = append(predicates, user.EmailContainsFold(*i.EmailContainsFold))
 	}
-	if i.ChannelIDNotNil {
-		predicates = append(predicates, usagelog.ChannelIDNotNil())
+	if i.Status != nil {
+		predicates = append(predicates, user.StatusEQ(*i.Status))
 	}
-	if i.ModelID != nil {
-		predicates = append(predicates, usagelog.ModelIDEQ(*i.ModelID))
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, user.StatusNEQ(*i.StatusNEQ))
 	}
-	if i.ModelIDNEQ != nil {
-		predicates = append(predicates, usagelog.ModelIDNEQ(*i.ModelIDNEQ))
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, user.StatusIn(i.StatusIn...))
 	}
-	if len(i.ModelIDIn) > 0 {
-		predicates = append(predicates, usagelog.ModelIDIn(i.ModelIDIn...))
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, user.StatusNotIn(i.StatusNotIn...))
 	}
-	if len(i.ModelIDNotIn) > 0 {
-		predicates = append(predicates, usagelog.ModelIDNotIn(i.ModelIDNotIn...))
+	if i.PreferLanguage != nil {
+		predicates = append(predicates, user.PreferLanguageEQ(*i.PreferLanguage))
 	}
-	if i.ModelIDGT != nil {
-		predicates = append(predicates, usagelog.ModelIDGT(*i.ModelIDGT))
+	if i.PreferLanguageNEQ != nil {
+		predicates = append(predicates, user.PreferLanguageNEQ(*i.PreferLanguageNEQ))
 	}
-	if i.ModelIDGTE != nil {
-		predicates = append(predicates, usagelog.ModelIDGTE(*i.ModelIDGTE))
+	if len(i.PreferLanguageIn) > 0 {
+		predicates = append(predicates, user.PreferLanguageIn(i.PreferLanguageIn...))
 	}
-	if i.ModelIDLT != nil {
-		predicates = append(predicates, usagelog.ModelIDLT(*i.ModelIDLT))
+	if len(i.PreferLanguageNotIn) > 0 {
+		predicates = append(predicates, user.PreferLanguageNotIn(i.PreferLanguageNotIn...))
 	}
-	if i.ModelIDLTE != nil {
-		predicates = append(predicates, usagelog.ModelIDLTE(*i.ModelIDLTE))
+	if i.PreferLanguageGT != nil {
+		predicates = append(predicates, user.PreferLanguageGT(*i.PreferLanguageGT))
 	}
-	if i.ModelIDContains != nil {
-		predicates = append(predicates, usagelog.ModelIDContains(*i.ModelIDContains))
+	if i.PreferLanguageGTE != nil {
+		predicates = append(predicates, user.PreferLanguageGTE(*i.PreferLanguageGTE))
 	}
-	if i.ModelIDHasPrefix != nil {
-		predicates = append(predicates, usagelog.ModelIDHasPrefix(*i.ModelIDHasPrefix))
+	if i.PreferLanguageLT != nil {
+		predicates = append(predicates, user.PreferLanguageLT(*i.PreferLanguageLT))
 	}
-	if i.ModelIDHasSuffix != nil {
-		predicates = append(predicates, usagelog.ModelIDHasSuffix(*i.ModelIDHasSuffix))
+	if i.PreferLanguageLTE != nil {
+		predicates = append(predicates, user.PreferLanguageLTE(*i.PreferLanguageLTE))
 	}
-	if i.ModelIDEqualFold != nil {
-		predicates = append(predicates, usagelog.ModelIDEqualFold(*i.ModelIDEqualFold))
+	if i.PreferLanguageContains != nil {
+		predicates = append(predicates, user.PreferLanguageContains(*i.PreferLanguageContains))
 	}
-	if i.ModelIDContainsFold != nil {
-		predicates = append(predicates, usagelog.ModelIDContainsFold(*i.ModelIDContainsFold))
+	if i.PreferLanguageHasPrefix != nil {
+		predicates = append(predicates, user.PreferLanguageHasPrefix(*i.PreferLanguageHasPrefix))
 	}
-	if i.PromptTokens != nil {
-		predicates = append(predicates, usagelog.PromptTokensEQ(*i.PromptTokens))
+	if i.PreferLanguageHasSuffix != nil {
+		predicates = append(predicates, user.PreferLanguageHasSuffix(*i.PreferLanguageHasSuffix))
 	}
-	if i.PromptTokensNEQ != nil {
-		predicates = append(predicates, usagelog.PromptTokensNEQ(*i.PromptTokensNEQ))
+	if i.PreferLanguageEqualFold != nil {
+		predicates = append(predicates, user.PreferLanguageEqualFold(*i.PreferLanguageEqualFold))
 	}
-	if len(i.PromptTokensIn) > 0 {
-		predicates = append(predicates, usagelog.PromptTokensIn(i.PromptTokensIn...))
+	if i.PreferLanguageContainsFold != nil {
+		predicates = append(predicates, user.PreferLanguageContainsFold(*i.PreferLanguageContainsFold))
 	}
-	if len(i.PromptTokensNotIn) > 0 {
-		predicates = append(predicates, usagelog.PromptTokensNotIn(i.PromptTokensNotIn...))
+	if i.Password != nil {
+		predicates = append(predicates, user.PasswordEQ(*i.Password))
 	}
-	if i.PromptTokensGT != nil {
-		predicates = append(predicates, usagelog.PromptTokensGT(*i.PromptTokensGT))
+	if i.PasswordNEQ != nil {
+		predicates = append(predicates, user.PasswordNEQ(*i.PasswordNEQ))
 	}
-	if i.PromptTokensGTE != nil {
-		predicates = append(predicates, usagelog.PromptTokensGTE(*i.PromptTokensGTE))
+	if len(i.PasswordIn) > 0 {
+		predicates = append(predicates, user.PasswordIn(i.PasswordIn...))
 	}
-	if i.PromptTokensLT != nil {
-		predicates = append(predicates, usagelog.PromptTokensLT(*i.PromptTokensLT))
+	if len(i.PasswordNotIn) > 0 {
+		predicates = append(predicates, user.PasswordNotIn(i.PasswordNotIn...))
 	}
-	if i.PromptTokensLTE != nil {
-		predicates = append(predicates, usagelog.PromptTokensLTE(*i.PromptTokensLTE))
+	if i.PasswordGT != nil {
+		predicates = append(predicates, user.PasswordGT(*i.PasswordGT))
 	}
-	if i.CompletionTokens != nil {
-		predicates = append(predicates, usagelog.CompletionTokensEQ(*i.CompletionTokens))
+	if i.PasswordGTE != nil {
+		predicates = append(predicates, user.PasswordGTE(*i.PasswordGTE))
 	}
-	if i.CompletionTokensNEQ != nil {
-		predicates = append(predicates, usagelog.CompletionTokensNEQ(*i.CompletionTokensNEQ))
+	if i.PasswordLT != nil {
+		predicates = append(predicates, user.PasswordLT(*i.PasswordLT))
 	}
-	if len(i.CompletionTokensIn) > 0 {
-		predicates = append(predicates, usagelog.CompletionTokensIn(i.CompletionTokensIn...))
+	if i.PasswordLTE != nil {
+		predicates = append(predicates, user.PasswordLTE(*i.PasswordLTE))
 	}
-	if len(i.CompletionTokensNotIn) > 0 {
-		predicates = append(predicates, usagelog.CompletionTokensNotIn(i.CompletionTokensNotIn...))
+	if i.PasswordContains != nil {
+		predicates = append(predicates, user.PasswordContains(*i.PasswordContains))
 	}
-	if i.CompletionTokensGT != nil {
-		predicates = append(predicates, usagelog.CompletionTokensGT(*i.CompletionTokensGT))
+	if i.PasswordHasPrefix != nil {
+		predicates = append(predicates, user.PasswordHasPrefix(*i.PasswordHasPrefix))
 	}
-	if i.CompletionTokensGTE != nil {
-		predicates = append(predicates, usagelog.CompletionTokensGTE(*i.CompletionTokensGTE))
+	if i.PasswordHasSuffix != nil {
+		predicates = append(predicates, user.PasswordHasSuffix(*i.PasswordHasSuffix))
 	}
-	if i.CompletionTokensLT != nil {
-		predicates = append(predicates, usagelog.CompletionTokensLT(*i.CompletionTokensLT))
+	if i.PasswordEqualFold != nil {
+		predicates = append(predicates, user.PasswordEqualFold(*i.PasswordEqualFold))
 	}
-	if i.CompletionTokensLTE != nil {
-		predicates = append(predicates, usagelog.CompletionTokensLTE(*i.CompletionTokensLTE))
+	if i.PasswordContainsFold != nil {
+		predicates = append(predicates, user.PasswordContainsFold(*i.PasswordContainsFold))
 	}
-	if i.TotalTokens != nil {
-		predicates = append(predicates, usagelog.TotalTokensEQ(*i.TotalTokens))
+	if i.FirstName != nil {
+		predicates = append(predicates, user.FirstNameEQ(*i.FirstName))
 	}
-	if i.TotalTokensNEQ != nil {
-		predicates = append(predicates, usagelog.TotalTokensNEQ(*i.TotalTokensNEQ))
+	if i.FirstNameNEQ != nil {
+		predicates = append(predicates, user.FirstNameNEQ(*i.FirstNameNEQ))
 	}
-	if len(i.TotalTokensIn) > 0 {
-		predicates = append(predicates, usagelog.TotalTokensIn(i.TotalTokensIn...))
+	if len(i.FirstNameIn) > 0 {
+		predicates = append(predicates, user.FirstNameIn(i.FirstNameIn...))
 	}
-	if len(i.TotalTokensNotIn) > 0 {
-		predicates = append(predicates, usagelog.TotalTokensNotIn(i.TotalTokensNotIn...))
+	if len(i.FirstNameNotIn) > 0 {
+		predicates = append(predicates, user.FirstNameNotIn(i.FirstNameNotIn...))
 	}
-	if i.TotalTokensGT != nil {
-		predicates = append(predicates, usagelog.TotalTokensGT(*i.TotalTokensGT))
+	if i.FirstNameGT != nil {
+		predicates = append(predicates, user.FirstNameGT(*i.FirstNameGT))
 	}
-	if i.TotalTokensGTE != nil {
-		predicates = append(predicates, usagelog.TotalTokensGTE(*i.TotalTokensGTE))
+	if i.FirstNameGTE != nil {
+		predicates = append(predicates, user.FirstNameGTE(*i.FirstNameGTE))
 	}
-	if i.TotalTokensLT != nil {
-		predicates = append(predicates, usagelog.TotalTokensLT(*i.TotalTokensLT))
+	if i.FirstNameLT != nil {
+		predicates = append(predicates, user.FirstNameLT(*i.FirstNameLT))
 	}
-	if i.TotalTokensLTE != nil {
-		predicates = append(predicates, usagelog.TotalTokensLTE(*i.TotalTokensLTE))
+	if i.FirstNameLTE != nil {
+		predicates = append(predicates, user.FirstNameLTE(*i.FirstNameLTE))
 	}
-	if i.PromptAudioTokens != nil {
-		predicates = append(predicates, usagelog.PromptAudioTokensEQ(*i.PromptAudioTokens))
+	if i.FirstNameContains != nil {
+		predicates = append(predicates, user.FirstNameContains(*i.FirstNameContains))
 	}
-	if i.PromptAudioTokensNEQ != nil {
-		predicates = append(predicates, usagelog.PromptAudioTokensNEQ(*i.PromptAudioTokensNEQ))
+	if i.FirstNameHasPrefix != nil {
+		predicates = append(predicates, user.FirstNameHasPrefix(*i.FirstNameHasPrefix))
 	}
-	if len(i.PromptAudioTokensIn) > 0 {
-		predicates = append(predicates, usagelog.PromptAudioTokensIn(i.PromptAudioTokensIn...))
+	if i.FirstNameHasSuffix != nil {
+		predicates = append(predicates, user.FirstNameHasSuffix(*i.FirstNameHasSuffix))
 	}
-	if len(i.PromptAudioTokensNotIn) > 0 {
-		predicates = append(predicates, usagelog.PromptAudioTokensNotIn(i.PromptAudioTokensNotIn...))
+	if i.FirstNameEqualFold != nil {
+		predicates = append(predicates, user.FirstNameEqualFold(*i.FirstNameEqualFold))
 	}
-	if i.PromptAudioTokensGT != nil {
-		predicates = append(predicates, usagelog.PromptAudioTokensGT(*i.PromptAudioTokensGT))
+	if i.FirstNameContainsFold != nil {
+		predicates = append(predicates, user.FirstNameContainsFold(*i.FirstNameContainsFold))
 	}
-	if i.PromptAudioTokensGTE != nil {
-		predicates = append(predicates, usagelog.PromptAudioTokensGTE(*i.PromptAudioTokensGTE))
+	if i.LastName != nil {
+		predicates = append(predicates, user.LastNameEQ(*i.LastName))
 	}
-	if i.PromptAudioTokensLT != nil {
-		predicates = append(predicates, usagelog.PromptAudioTokensLT(*i.PromptAudioTokensLT))
+	if i.LastNameNEQ != nil {
+		predicates = append(predicates, user.LastNameNEQ(*i.LastNameNEQ))
 	}
-	if i.PromptAudioTokensLTE != nil {
-		predicates = append(predicates, usagelog.PromptAudioTokensLTE(*i.PromptAudioTokensLTE))
+	if len(i.LastNameIn) > 0 {
+		predicates = append(predicates, user.LastNameIn(i.LastNameIn...))
 	}
-	if i.PromptAudioTokensIsNil {
-		predicates = append(predicates, usagelog.PromptAudioTokensIsNil())
+	if len(i.LastNameNotIn) > 0 {
+		predicates = append(predicates, user.LastNameNotIn(i.LastNameNotIn...))
 	}
-	if i.PromptAudioTokensNotNil {
-		predicates = append(predicates, usagelog.PromptAudioTokensNotNil())
+	if i.LastNameGT != nil {
+		predicates = append(predicates, user.LastNameGT(*i.LastNameGT))
 	}
-	if i.PromptCachedTokens != nil {
-		predicates = append(predicates, usagelog.PromptCachedTokensEQ(*i.PromptCachedTokens))
+	if i.LastNameGTE != nil {
+		predicates = append(predicates, user.LastNameGTE(*i.LastNameGTE))
 	}
-	if i.PromptCachedTokensNEQ != nil {
-		predicates = append(predicates, usagelog.PromptCachedTokensNEQ(*i.PromptCachedTokensNEQ))
+	if i.LastNameLT != nil {
+		predicates = append(predicates, user.LastNameLT(*i.LastNameLT))
 	}
-	if len(i.PromptCachedTokensIn) > 0 {
-		predicates = append(predicates, usagelog.PromptCachedTokensIn(i.PromptCachedTokensIn...))
+	if i.LastNameLTE != nil {
+		predicates = append(predicates, user.LastNameLTE(*i.LastNameLTE))
 	}
-	if len(i.PromptCachedTokensNotIn) > 0 {
-		predicates = append(predicates, usagelog.PromptCachedTokensNotIn(i.PromptCachedTokensNotIn...))
+	if i.LastNameContains != nil {
+		predicates = append(predicates, user.LastNameContains(*i.LastNameContains))
 	}
-	if i.PromptCachedTokensGT != nil {
-		predicates = append(predicates, usagelog.PromptCachedTokensGT(*i.PromptCachedTokensGT))
+	if i.LastNameHasPrefix != nil {
+		predicates = append(predicates, user.LastNameHasPrefix(*i.LastNameHasPrefix))
 	}
-	if i.PromptCachedTokensGTE != nil {
-		predicates = append(predicates, usagelog.PromptCachedTokensGTE(*i.PromptCachedTokensGTE))
+	if i.LastNameHasSuffix != nil {
+		predicates = append(predicates, user.LastNameHasSuffix(*i.LastNameHasSuffix))
 	}
-	if i.PromptCachedTokensLT != nil {
-		predicates = append(predicates, usagelog.PromptCachedTokensLT(*i.PromptCachedTokensLT))
+	if i.LastNameEqualFold != nil {
+		predicates = append(predicates, user.LastNameEqualFold(*i.LastNameEqualFold))
 	}
-	if i.PromptCachedTokensLTE != nil {
-		predicates = append(predicates, usagelog.PromptCachedTokensLTE(*i.PromptCachedTokensLTE))
+	if i.LastNameContainsFold != nil {
+		predicates = append(predicates, user.LastNameContainsFold(*i.LastNameContainsFold))
 	}
-	if i.PromptCachedTokensIsNil {
-		predicates = append(predicates, usagelog.PromptCachedTokensIsNil())
+	if i.Avatar != nil {
+		predicates = append(predicates, user.AvatarEQ(*i.Avatar))
 	}
-	if i.PromptCachedTokensNotNil {
-		predicates = append(predicates, usagelog.PromptCachedTokensNotNil())
+	if i.AvatarNEQ != nil {
+		predicates = append(predicates, user.AvatarNEQ(*i.AvatarNEQ))
 	}
-	if i.PromptWriteCachedTokens != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokensEQ(*i.PromptWriteCachedTokens))
+	if len(i.AvatarIn) > 0 {
+		predicates = append(predicates, user.AvatarIn(i.AvatarIn...))
 	}
-	if i.PromptWriteCachedTokensNEQ != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokensNEQ(*i.PromptWriteCachedTokensNEQ))
+	if len(i.AvatarNotIn) > 0 {
+		predicates = append(predicates, user.AvatarNotIn(i.AvatarNotIn...))
 	}
-	if len(i.PromptWriteCachedTokensIn) > 0 {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokensIn(i.PromptWriteCachedTokensIn...))
+	if i.AvatarGT != nil {
+		predicates = append(predicates, user.AvatarGT(*i.AvatarGT))
 	}
-	if len(i.PromptWriteCachedTokensNotIn) > 0 {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokensNotIn(i.PromptWriteCachedTokensNotIn...))
+	if i.AvatarGTE != nil {
+		predicates = append(predicates, user.AvatarGTE(*i.AvatarGTE))
 	}
-	if i.PromptWriteCachedTokensGT != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokensGT(*i.PromptWriteCachedTokensGT))
+	if i.AvatarLT != nil {
+		predicates = append(predicates, user.AvatarLT(*i.AvatarLT))
 	}
-	if i.PromptWriteCachedTokensGTE != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokensGTE(*i.PromptWriteCachedTokensGTE))
+	if i.AvatarLTE != nil {
+		predicates = append(predicates, user.AvatarLTE(*i.AvatarLTE))
 	}
-	if i.PromptWriteCachedTokensLT != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokensLT(*i.PromptWriteCachedTokensLT))
+	if i.AvatarContains != nil {
+		predicates = append(predicates, user.AvatarContains(*i.AvatarContains))
 	}
-	if i.PromptWriteCachedTokensLTE != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokensLTE(*i.PromptWriteCachedTokensLTE))
+	if i.AvatarHasPrefix != nil {
+		predicates = append(predicates, user.AvatarHasPrefix(*i.AvatarHasPrefix))
 	}
-	if i.PromptWriteCachedTokensIsNil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokensIsNil())
+	if i.AvatarHasSuffix != nil {
+		predicates = append(predicates, user.AvatarHasSuffix(*i.AvatarHasSuffix))
 	}
-	if i.PromptWriteCachedTokensNotNil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokensNotNil())
+	if i.AvatarIsNil {
+		predicates = append(predicates, user.AvatarIsNil())
 	}
-	if i.PromptWriteCachedTokens5m != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mEQ(*i.PromptWriteCachedTokens5m))
+	if i.AvatarNotNil {
+		predicates = append(predicates, user.AvatarNotNil())
 	}
-	if i.PromptWriteCachedTokens5mNEQ != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mNEQ(*i.PromptWriteCachedTokens5mNEQ))
+	if i.AvatarEqualFold != nil {
+		predicates = append(predicates, user.AvatarEqualFold(*i.AvatarEqualFold))
 	}
-	if len(i.PromptWriteCachedTokens5mIn) > 0 {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mIn(i.PromptWriteCachedTokens5mIn...))
+	if i.AvatarContainsFold != nil {
+		predicates = append(predicates, user.AvatarContainsFold(*i.AvatarContainsFold))
 	}
-	if len(i.PromptWriteCachedTokens5mNotIn) > 0 {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mNotIn(i.PromptWriteCachedTokens5mNotIn...))
+	if i.IsOwner != nil {
+		predicates = append(predicates, user.IsOwnerEQ(*i.IsOwner))
 	}
-	if i.PromptWriteCachedTokens5mGT != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mGT(*i.PromptWriteCachedTokens5mGT))
+	if i.IsOwnerNEQ != nil {
+		predicates = append(predicates, user.IsOwnerNEQ(*i.IsOwnerNEQ))
 	}
-	if i.PromptWriteCachedTokens5mGTE != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mGTE(*i.PromptWriteCachedTokens5mGTE))
+
+	if i.HasProjects != nil {
+		p := user.HasProjects()
+		if !*i.HasProjects {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.PromptWriteCachedTokens5mLT != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mLT(*i.PromptWriteCachedTokens5mLT))
+	if len(i.HasProjectsWith) > 0 {
+		with := make([]predicate.Project, 0, len(i.HasProjectsWith))
+		for _, w := range i.HasProjectsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProjectsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasProjectsWith(with...))
 	}
-	if i.PromptWriteCachedTokens5mLTE != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mLTE(*i.PromptWriteCachedTokens5mLTE))
+	if i.HasAPIKeys != nil {
+		p := user.HasAPIKeys()
+		if !*i.HasAPIKeys {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.PromptWriteCachedTokens5mIsNil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mIsNil())
+	if len(i.HasAPIKeysWith) > 0 {
+		with := make([]predicate.APIKey, 0, len(i.HasAPIKeysWith))
+		for _, w := range i.HasAPIKeysWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasAPIKeysWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasAPIKeysWith(with...))
 	}
-	if i.PromptWriteCachedTokens5mNotNil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens5mNotNil())
+	if i.HasRoles != nil {
+		p := user.HasRoles()
+		if !*i.HasRoles {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.PromptWriteCachedTokens1h != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hEQ(*i.PromptWriteCachedTokens1h))
+	if len(i.HasRolesWith) > 0 {
+		with := make([]predicate.Role, 0, len(i.HasRolesWith))
+		for _, w := range i.HasRolesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasRolesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasRolesWith(with...))
 	}
-	if i.PromptWriteCachedTokens1hNEQ != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hNEQ(*i.PromptWriteCachedTokens1hNEQ))
+	if i.HasChannelOverrideTemplates != nil {
+		p := user.HasChannelOverrideTemplates()
+		if !*i.HasChannelOverrideTemplates {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if len(i.PromptWriteCachedTokens1hIn) > 0 {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hIn(i.PromptWriteCachedTokens1hIn...))
+	if len(i.HasChannelOverrideTemplatesWith) > 0 {
+		with := make([]predicate.ChannelOverrideTemplate, 0, len(i.HasChannelOverrideTemplatesWith))
+		for _, w := range i.HasChannelOverrideTemplatesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasChannelOverrideTemplatesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasChannelOverrideTemplatesWith(with...))
 	}
-	if len(i.PromptWriteCachedTokens1hNotIn) > 0 {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hNotIn(i.PromptWriteCachedTokens1hNotIn...))
+	if i.HasOidcIdentities != nil {
+		p := user.HasOidcIdentities()
+		if !*i.HasOidcIdentities {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.PromptWriteCachedTokens1hGT != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hGT(*i.PromptWriteCachedTokens1hGT))
+	if len(i.HasOidcIdentitiesWith) > 0 {
+		with := make([]predicate.OIDCIdentity, 0, len(i.HasOidcIdentitiesWith))
+		for _, w := range i.HasOidcIdentitiesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOidcIdentitiesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasOidcIdentitiesWith(with...))
 	}
-	if i.PromptWriteCachedTokens1hGTE != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hGTE(*i.PromptWriteCachedTokens1hGTE))
+	if i.HasProjectUsers != nil {
+		p := user.HasProjectUsers()
+		if !*i.HasProjectUsers {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.PromptWriteCachedTokens1hLT != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hLT(*i.PromptWriteCachedTokens1hLT))
+	if len(i.HasProjectUsersWith) > 0 {
+		with := make([]predicate.UserProject, 0, len(i.HasProjectUsersWith))
+		for _, w := range i.HasProjectUsersWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProjectUsersWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasProjectUsersWith(with...))
 	}
-	if i.PromptWriteCachedTokens1hLTE != nil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hLTE(*i.PromptWriteCachedTokens1hLTE))
+	if i.HasUserRoles != nil {
+		p := user.HasUserRoles()
+		if !*i.HasUserRoles {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.PromptWriteCachedTokens1hIsNil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hIsNil())
+	if len(i.HasUserRolesWith) > 0 {
+		with := make([]predicate.UserRole, 0, len(i.HasUserRolesWith))
+		for _, w := range i.HasUserRolesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUserRolesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasUserRolesWith(with...))
 	}
-	if i.PromptWriteCachedTokens1hNotNil {
-		predicates = append(predicates, usagelog.PromptWriteCachedTokens1hNotNil())
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyUserWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return user.And(predicates...), nil
 	}
-	if i.CompletionAudioTokens != nil {
-		predicates = append(predicates, usagelog.CompletionAudioTokensEQ(*i.CompletionAudioTokens))
+}
+
+// UserProjectWhereInput represents a where input for filtering UserProject queries.
+type UserProjectWhereInput struct {
+	Predicates []predicate.UserProject  `json:"-"`
+	Not        *UserProjectWhereInput   `json:"not,omitempty"`
+	Or         []*UserProjectWhereInput `json:"or,omitempty"`
+	And        []*UserProjectWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "user_id" field predicates.
+	UserID      *int  `json:"userID,omitempty"`
+	UserIDNEQ   *int  `json:"userIDNEQ,omitempty"`
+	UserIDIn    []int `json:"userIDIn,omitempty"`
+	UserIDNotIn []int `json:"userIDNotIn,omitempty"`
+
+	// "project_id" field predicates.
+	ProjectID      *int  `json:"projectID,omitempty"`
+	ProjectIDNEQ   *int  `json:"projectIDNEQ,omitempty"`
+	ProjectIDIn    []int `json:"projectIDIn,omitempty"`
+	ProjectIDNotIn []int `json:"projectIDNotIn,omitempty"`
+
+	// "is_owner" field predicates.
+	IsOwner    *bool `json:"isOwner,omitempty"`
+	IsOwnerNEQ *bool `json:"isOwnerNEQ,omitempty"`
+
+	// "user" edge predicates.
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+
+	// "project" edge predicates.
+	HasProject     *bool                `json:"hasProject,omitempty"`
+	HasProjectWith []*ProjectWhereInput `json:"hasProjectWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *UserProjectWhereInput) AddPredicates(predicates ...predicate.UserProject) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the UserProjectWhereInput filter on the UserProjectQuery builder.
+func (i *UserProjectWhereInput) Filter(q *UserProjectQuery) (*UserProjectQuery, error) {
+	if i == nil {
+		return q, nil
 	}
-	if i.CompletionAudioTokensNEQ != nil {
-		predicates = append(predicates, usagelog.CompletionAudioTokensNEQ(*i.CompletionAudioTokensNEQ))
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyUserProjectWhereInput {
+			return q, nil
+		}
+		return nil, err
 	}
-	if len(i.CompletionAudioTokensIn) > 0 {
-		predicates = append(predicates, usagelog.CompletionAudioTokensIn(i.CompletionAudioTokensIn...))
+	return q.Where(p), nil
+}
+
+// ErrEmptyUserProjectWhereInput is returned in case the UserProjectWhereInput is empty.
+var ErrEmptyUserProjectWhereInput = errors.New("ent: empty predicate UserProjectWhereInput")
+
+// P returns a predicate for filtering userprojects.
+// An error is returned if the input is empty or invalid.
+func (i *UserProjectWhereInput) P() (predicate.UserProject, error) {
+	var predicates []predicate.UserProject
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, userproject.Not(p))
 	}
-	if len(i.CompletionAudioTokensNotIn) > 0 {
-		predicates = append(predicates, usagelog.CompletionAudioTokensNotIn(i.CompletionAudioTokensNotIn...))
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.UserProject, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, userproject.Or(or...))
 	}
-	if i.CompletionAudioTokensGT != nil {
-		predicates = append(predicates, usagelog.CompletionAudioTokensGT(*i.CompletionAudioTokensGT))
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.UserProject, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, userproject.And(and...))
 	}
-	if i.CompletionAudioTokensGTE != nil {
-		predicates = append(predicates, usagelog.CompletionAudioTokensGTE(*i.CompletionAudioTokensGTE))
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, userproject.IDEQ(*i.ID))
 	}
-	if i.CompletionAudioTokensLT != nil {
-		predicates = append(predicates, usagelog.CompletionAudioTokensLT(*i.CompletionAudioTokensLT))
+	if i.IDNEQ != nil {
+		predicates = append(predicates, userproject.IDNEQ(*i.IDNEQ))
 	}
-	if i.CompletionAudioTokensLTE != nil {
-		predicates = append(predicates, usagelog.CompletionAudioTokensLTE(*i.CompletionAudioTokensLTE))
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, userproject.IDIn(i.IDIn...))
 	}
-	if i.CompletionAudioTokensIsNil {
-		predicates = append(predicates, usagelog.CompletionAudioTokensIsNil())
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, userproject.IDNotIn(i.IDNotIn...))
 	}
-	if i.CompletionAudioTokensNotNil {
-		predicates = append(predicates, usagelog.CompletionAudioTokensNotNil())
+	if i.IDGT != nil {
+		predicates = append(predicates, userproject.IDGT(*i.IDGT))
 	}
-	if i.CompletionReasoningTokens != nil {
-		predicates = append(predicates, usagelog.CompletionReasoningTokensEQ(*i.CompletionReasoningTokens))
+	if i.IDGTE != nil {
+		predicates = append(predicates, userproject.IDGTE(*i.IDGTE))
 	}
-	if i.CompletionReasoningTokensNEQ != nil {
-		predicates = append(predicates, usagelog.CompletionReasoningTokensNEQ(*i.CompletionReasoningTokensNEQ))
+	if i.IDLT != nil {
+		predicates = append(predicates, userproject.IDLT(*i.IDLT))
 	}
-	if len(i.CompletionReasoningTokensIn) > 0 {
-		predicates = append(predicates, usagelog.CompletionReasoningTokensIn(i.CompletionReasoningTokensIn...))
+	if i.IDLTE != nil {
+		predicates = append(predicates, userproject.IDLTE(*i.IDLTE))
 	}
-	if len(i.CompletionReasoningTokensNotIn) > 0 {
-		predicates = append(predicates, usagelog.CompletionReasoningTokensNotIn(i.CompletionReasoningTokensNotIn...))
+	if i.CreatedAt != nil {
+		predicates = append(predicates, userproject.CreatedAtEQ(*i.CreatedAt))
 	}
-	if i.CompletionReasoningTokensGT != nil {
-		predicates = append(predicates, usagelog.CompletionReasoningTokensGT(*i.CompletionReasoningTokensGT))
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, userproject.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if i.CompletionReasoningTokensGTE != nil {
-		predicates = append(predicates, usagelog.CompletionReasoningTokensGTE(*i.CompletionReasoningTokensGTE))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, userproject.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if i.CompletionReasoningTokensLT != nil {
-		predicates = append(predicates, usagelog.CompletionReasoningTokensLT(*i.CompletionReasoningTokensLT))
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, userproject.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if i.CompletionReasoningTokensLTE != nil {
-		predicates = append(predicates, usagelog.CompletionReasoningTokensLTE(*i.CompletionReasoningTokensLTE))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, userproject.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.CompletionReasoningTokensIsNil {
-		predicates = append(predicates, usagelog.CompletionReasoningTokensIsNil())
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, userproject.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if i.CompletionReasoningTokensNotNil {
-		predicates = append(predicates, usagelog.CompletionReasoningTokensNotNil())
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, userproject.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if i.CompletionAcceptedPredictionTokens != nil {
-		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensEQ(*i.CompletionAcceptedPredictionTokens))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, userproject.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.CompletionAcceptedPredictionTokensNEQ != nil {
-		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensNEQ(*i.CompletionAcceptedPredictionTokensNEQ))
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, userproject.UpdatedAtEQ(*i.UpdatedAt))
 	}
-	if len(i.CompletionAcceptedPredictionTokensIn) > 0 {
-		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensIn(i.CompletionAcceptedPredictionTokensIn...))
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, userproject.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
-	if len(i.CompletionAcceptedPredictionTokensNotIn) > 0 {
-		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensNotIn(i.CompletionAcceptedPredictionTokensNotIn...))
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, userproject.UpdatedAtIn(i.UpdatedAtIn...))
 	}
-	if i.CompletionAcceptedPredictionTokensGT != nil {
-		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensGT(*i.CompletionAcceptedPredictionTokensGT))
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, userproject.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
-	if i.CompletionAcceptedPredictionTokensGTE != nil {
-		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensGTE(*i.CompletionAcceptedPredictionTokensGTE))
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, userproject.UpdatedAtGT(*i.UpdatedAtGT))
 	}
-	if i.CompletionAcceptedPredictionTokensLT != nil {
-		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensLT(*i.CompletionAcceptedPredictionTokensLT))
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, userproject.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
-	if i.CompletionAcceptedPredictionTokensLTE != nil {
-		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensLTE(*i.CompletionAcceptedPredictionTokensLTE))
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, userproject.UpdatedAtLT(*i.UpdatedAtLT))
 	}
-	if i.CompletionAcceptedPredictionTokensIsNil {
-		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensIsNil())
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, userproject.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.CompletionAcceptedPredictionTokensNotNil {
-		predicates = append(predicates, usagelog.CompletionAcceptedPredictionTokensNotNil())
+	if i.UserID != nil {
+		predicates = append(predicates, userproject.UserIDEQ(*i.UserID))
 	}
-	if i.CompletionRejectedPredictionTokens != nil {
-		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensEQ(*i.CompletionRejectedPredictionTokens))
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, userproject.UserIDNEQ(*i.UserIDNEQ))
 	}
-	if i.CompletionRejectedPredictionTokensNEQ != nil {
-		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensNEQ(*i.CompletionRejectedPredictionTokensNEQ))
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, userproject.UserIDIn(i.UserIDIn...))
 	}
-	if len(i.CompletionRejectedPredictionTokensIn) > 0 {
-		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensIn(i.CompletionRejectedPredictionTokensIn...))
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, userproject.UserIDNotIn(i.UserIDNotIn...))
 	}
-	if len(i.CompletionRejectedPredictionTokensNotIn) > 0 {
-		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensNotIn(i.CompletionRejectedPredictionTokensNotIn...))
+	if i.ProjectID != nil {
+		predicates = append(predicates, userproject.ProjectIDEQ(*i.ProjectID))
 	}
-	if i.CompletionRejectedPredictionTokensGT != nil {
-		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensGT(*i.CompletionRejectedPredictionTokensGT))
+	if i.ProjectIDNEQ != nil {
+		predicates = append(predicates, userproject.ProjectIDNEQ(*i.ProjectIDNEQ))
 	}
-	if i.CompletionRejectedPredictionTokensGTE != nil {
-		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensGTE(*i.CompletionRejectedPredictionTokensGTE))
+	if len(i.ProjectIDIn) > 0 {
+		predicates = append(predicates, userproject.ProjectIDIn(i.ProjectIDIn...))
 	}
-	if i.CompletionRejectedPredictionTokensLT != nil {
-		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensLT(*i.CompletionRejectedPredictionTokensLT))
+	if len(i.ProjectIDNotIn) > 0 {
+		predicates = append(predicates, userproject.ProjectIDNotIn(i.ProjectIDNotIn...))
 	}
-	if i.CompletionRejectedPredictionTokensLTE != nil {
-		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensLTE(*i.CompletionRejectedPredictionTokensLTE))
+	if i.IsOwner != nil {
+		predicates = append(predicates, userproject.IsOwnerEQ(*i.IsOwner))
 	}
-	if i.CompletionRejectedPredictionTokensIsNil {
-		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensIsNil())
+	if i.IsOwnerNEQ != nil {
+		predicates = append(predicates, userproject.IsOwnerNEQ(*i.IsOwnerNEQ))
 	}
-	if i.CompletionRejectedPredictionTokensNotNil {
-		predicates = append(predicates, usagelog.CompletionRejectedPredictionTokensNotNil())
+
+	if i.HasUser != nil {
+		p := userproject.HasUser()
+		if !*i.HasUser {
+			p = userproject.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if i.Source != nil {
-		predicates = append(predicates, usagelog.SourceEQ(*i.Source))
+	if len(i.HasUserWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUserWith))
+		for _, w := range i.HasUserWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, userproject.HasUserWith(with...))
 	}
-	if i.SourceNEQ != nil {
-		predicates = append(predicates, usagelog.SourceNEQ(*i.SourceNEQ))
+	if i.HasProject != nil {
+		p := userproject.HasProject()
+		if !*i.HasProject {
+			p = userproject.Not(p)
+		}
+		predicates = append(predicates, p)
 	}
-	if len(i.SourceIn) > 0 {
-		predicates = append(predicates, usagelog.SourceIn(i.SourceIn...))
+	if len(i.HasProjectWith) > 0 {
+		with := make([]predicate.Project, 0, len(i.HasProjectWith))
+		for _, w := range i.HasProjectWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasProjectWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, userproject.HasProjectWith(with...))
 	}
-	if len(i.SourceNotIn) > 0 {
-		predicates = append(predicates, usagelog.SourceNotIn(i.SourceNotIn...))
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyUserProjectWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return userproject.And(predicates...), nil
 	}
-	if i.Format != nil {
-		predicates = append(predicates, usagelog.FormatEQ(*i.Format))
+}
+
+// UserRoleWhereInput represents a where input for filtering UserRole queries.
+type UserRoleWhereInput struct {
+	Predicates []predicate.UserRole  `json:"-"`
+	Not        *UserRoleWhereInput   `json:"not,omitempty"`
+	Or         []*UserRoleWhereInput `json:"or,omitempty"`
+	And        []*UserRoleWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "user_id" field predicates.
+	UserID      *int  `json:"userID,omitempty"`
+	UserIDNEQ   *int  `json:"userIDNEQ,omitempty"`
+	UserIDIn    []int `json:"userIDIn,omitempty"`
+	UserIDNotIn []int `json:"userIDNotIn,omitempty"`
+
+	// "role_id" field predicates.
+	RoleID      *int  `json:"roleID,omitempty"`
+	RoleIDNEQ   *int  `json:"roleIDNEQ,omitempty"`
+	RoleIDIn    []int `json:"roleIDIn,omitempty"`
+	RoleIDNotIn []int `json:"roleIDNotIn,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt       *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ    *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn     []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn  []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT     *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE    *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT     *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE    *time.Time  `json:"createdAtLTE,omitempty"`
+	CreatedAtIsNil  bool        `json:"createdAtIsNil,omitempty"`
+	CreatedAtNotNil bool        `json:"createdAtNotNil,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt       *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ    *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn     []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn  []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT     *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE    *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT     *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE    *time.Time  `json:"updatedAtLTE,omitempty"`
+	UpdatedAtIsNil  bool        `json:"updatedAtIsNil,omitempty"`
+	UpdatedAtNotNil bool        `json:"updatedAtNotNil,omitempty"`
+
+	// "user" edge predicates.
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+
+	// "role" edge predicates.
+	HasRole     *bool             `json:"hasRole,omitempty"`
+	HasRoleWith []*RoleWhereInput `json:"hasRoleWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *UserRoleWhereInput) AddPredicates(predicates ...predicate.UserRole) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the UserRoleWhereInput filter on the UserRoleQuery builder.
+func (i *UserRoleWhereInput) Filter(q *UserRoleQuery) (*UserRoleQuery, error) {
+	if i == nil {
+		return q, nil
 	}
-	if i.FormatNEQ != nil {
-		predicates = append(predicates, usagelog.FormatNEQ(*i.FormatNEQ))
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyUserRoleWhereInput {
+			return q, nil
+		}
+		return nil, err
 	}
-	if len(i.FormatIn) > 0 {
-		predicates = append(predicates, usagelog.FormatIn(i.FormatIn...))
+	return q.Where(p), nil
+}
+
+// ErrEmptyUserRoleWhereInput is returned in case the UserRoleWhereInput is empty.
+var ErrEmptyUserRoleWhereInput = errors.New("ent: empty predicate UserRoleWhereInput")
+
+// P returns a predicate for filtering userroles.
+// An error is returned if the input is empty or invalid.
+func (i *UserRoleWhereInput) P() (predicate.UserRole, error) {
+	var predicates []predicate.UserRole
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, userrole.Not(p))
 	}
-	if len(i.FormatNotIn) > 0 {
-		predicates = append(predicates, usagelog.FormatNotIn(i.FormatNotIn...))
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.UserRole, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, userrole.Or(or...))
 	}
-	if i.FormatGT != nil {
-		predicates = append(predicates, usagelog.FormatGT(*i.FormatGT))
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.UserRole, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, userrole.And(and...))
 	}
-	if i.FormatGTE != nil {
-		predicates = append(predicates, usagelog.FormatGTE(*i.FormatGTE))
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, userrole.IDEQ(*i.ID))
 	}
-	if i.FormatLT != nil {
-		predicates = append(predicates, usagelog.FormatLT(*i.FormatLT))
+	if i.IDNEQ != nil {
+		predicates = append(predicates, userrole.IDNEQ(*i.IDNEQ))
 	}
-	if i.FormatLTE != nil {
-		predicates = append(predicates, usagelog.FormatLTE(*i.FormatLTE))
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, userrole.IDIn(i.IDIn...))
 	}
-	if i.FormatContains != nil {
-		predicates = append(predicates, usagelog.FormatContains(*i.FormatContains))
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, userrole.IDNotIn(i.IDNotIn...))
 	}
-	if i.FormatHasPrefix != nil {
-		predicates = append(predicates, usagelog.FormatHasPrefix(*i.FormatHasPrefix))
+	if i.IDGT != nil {
+		predicates = append(predicates, userrole.IDGT(*i.IDGT))
 	}
-	if i.FormatHasSuffix != nil {
-		predicates = append(predicates, usagelog.FormatHasSuffix(*i.FormatHasSuffix))
+	if i.IDGTE != nil {
+		predicates = append(predicates, userrole.IDGTE(*i.IDGTE))
 	}
-	if i.FormatEqualFold != nil {
-		predicates = append(predicates, usagelog.FormatEqualFold(*i.FormatEqualFold))
+	if i.IDLT != nil {
+		predicates = append(predicates, userrole.IDLT(*i.IDLT))
 	}
-	if i.FormatContainsFold != nil {
-		predicates = append(predicates, usagelog.FormatContainsFold(*i.FormatContainsFold))
+	if i.IDLTE != nil {
+		predicates = append(predicates, userrole.IDLTE(*i.IDLTE))
 	}
-	if i.TotalCost != nil {
-		predicates = append(predicates, usagelog.TotalCostEQ(*i.TotalCost))
+	if i.UserID != nil {
+		predicates = append(predicates, userrole.UserIDEQ(*i.UserID))
 	}
-	if i.TotalCostNEQ != nil {
-		predicates = append(predicates, usagelog.TotalCostNEQ(*i.TotalCostNEQ))
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, userrole.UserIDNEQ(*i.UserIDNEQ))
 	}
-	if len(i.TotalCostIn) > 0 {
-		predicates = append(predicates, usagelog.TotalCostIn(i.TotalCostIn...))
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, userrole.UserIDIn(i.UserIDIn...))
 	}
-	if len(i.TotalCostNotIn) > 0 {
-		predicates = append(predicates, usagelog.TotalCostNotIn(i.TotalCostNotIn...))
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, userrole.UserIDNotIn(i.UserIDNotIn...))
 	}
-	if i.TotalCostGT != nil {
-		predicates = append(predicates, usagelog.TotalCostGT(*i.TotalCostGT))
+	if i.RoleID != nil {
+		predicates = append(predicates, userrole.RoleIDEQ(*i.RoleID))
 	}
-	if i.TotalCostGTE != nil {
-		predicates = append(predicates, usagelog.TotalCostGTE(*i.TotalCostGTE))
+	if i.RoleIDNEQ != nil {
+		predicates = append(predicates, userrole.RoleIDNEQ(*i.RoleIDNEQ))
 	}
-	if i.TotalCostLT != nil {
-		predicates = append(predicates, usagelog.TotalCostLT(*i.TotalCostLT))
+	if len(i.RoleIDIn) > 0 {
+		predicates = append(predicates, userrole.RoleIDIn(i.RoleIDIn...))
 	}
-	if i.TotalCostLTE != nil {
-		predicates = append(predicates, usagelog.TotalCostLTE(*i.TotalCostLTE))
+	if len(i.RoleIDNotIn) > 0 {
+		predicates = append(predicates, userrole.RoleIDNotIn(i.RoleIDNotIn...))
 	}
-	if i.TotalCostIsNil {
-		predicates = append(predicates, usagelog.TotalCostIsNil())
+	if i.CreatedAt != nil {
+		predicates = append(predicates, userrole.CreatedAtEQ(*i.CreatedAt))
 	}
-	if i.TotalCostNotNil {
-		predicates = append(predicates, usagelog.TotalCostNotNil())
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, userrole.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if i.CostPriceReferenceID != nil {
-		predicates = append(predicates, usagelog.CostPriceReferenceIDEQ(*i.CostPriceReferenceID))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, userrole.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if i.CostPriceReferenceIDNEQ != nil {
-		predicates = append(predicates, usagelog.CostPriceReferenceIDNEQ(*i.CostPriceReferenceIDNEQ))
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, userrole.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if len(i.CostPriceReferenceIDIn) > 0 {
-		predicates = append(predicates, usagelog.CostPriceReferenceIDIn(i.CostPriceReferenceIDIn...))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, userrole.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if len(i.CostPriceReferenceIDNotIn) > 0 {
-		predicates = append(predicates, usagelog.CostPriceReferenceIDNotIn(i.CostPriceReferenceIDNotIn...))
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, userrole.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if i.CostPriceReferenceIDGT != nil {
-		predicates = append(predicates, usagelog.CostPriceReferenceIDGT(*i.CostPriceReferenceIDGT))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, userrole.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if i.CostPriceReferenceIDGTE != nil {
-		predicates = append(predicates, usagelog.CostPriceReferenceIDGTE(*i.CostPriceReferenceIDGTE))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, userrole.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if i.CostPriceReferenceIDLT != nil {
-		predicates = append(predicates, usagelog.CostPriceReferenceIDLT(*i.CostPriceReferenceIDLT))
+	if i.CreatedAtIsNil {
+		predicates = append(predicates, userrole.CreatedAtIsNil())
 	}
-	if i.CostPriceReferenceIDLTE != nil {
-		predicates = append(predicates, usagelog.CostPriceReferenceIDLTE(*i.CostPriceReferenceIDLTE))
+	if i.CreatedAtNotNil {
+		predicates = append(predicates, userrole.CreatedAtNotNil())
 	}
-	if i.CostPriceReferenceIDContains != nil {
-		predicates = append(predicates, usagelog.CostPriceReferenceIDContains(*i.CostPriceReferenceIDContains))
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, userrole.UpdatedAtEQ(*i.UpdatedAt))
 	}
-	if i.CostPriceReferenceIDHasPrefix != nil {
-		predicates = append(predicates, usagelog.CostPriceReferenceIDHasPrefix(*i.CostPriceReferenceIDHasPrefix))
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, userrole.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
-	if i.CostPriceReferenceIDHasSuffix != nil {
-		predicates = append(predicates, usagelog.CostPriceReferenceIDHasSuffix(*i.CostPriceReferenceIDHasSuffix))
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, userrole.UpdatedAtIn(i.UpdatedAtIn...))
 	}
-	if i.CostPriceReferenceIDIsNil {
-		predicates = append(predicates, usagelog.CostPriceReferenceIDIsNil())
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, userrole.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
-	if i.CostPriceReferenceIDNotNil {
-		predicates = append(predicates, usagelog.CostPriceReferenceIDNotNil())
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, userrole.UpdatedAtGT(*i.UpdatedAtGT))
 	}
-	if i.CostPriceReferenceIDEqualFold != nil {
-		predicates = append(predicates, usagelog.CostPriceReferenceIDEqualFold(*i.CostPriceReferenceIDEqualFold))
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, userrole.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
-	if i.CostPriceReferenceIDContainsFold != nil {
-		predicates = append(predicates, usagelog.CostPriceReferenceIDContainsFold(*i.CostPriceReferenceIDContainsFold))
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, userrole.UpdatedAtLT(*i.UpdatedAtLT))
 	}
-
-	if i.HasRequest != nil {
-		p := usagelog.HasRequest()
-		if !*i.HasRequest {
-			p = usagelog.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, userrole.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if len(i.HasRequestWith) > 0 {
-		with := make([]predicate.Request, 0, len(i.HasRequestWith))
-		for _, w := range i.HasRequestWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasRequestWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, usagelog.HasRequestWith(with...))
+	if i.UpdatedAtIsNil {
+		predicates = append(predicates, userrole.UpdatedAtIsNil())
 	}
-	if i.HasProject != nil {
-		p := usagelog.HasProject()
-		if !*i.HasProject {
-			p = usagelog.Not(p)
+	if i.UpdatedAtNotNil {
+		predicates = append(predicates, userrole.UpdatedAtNotNil())
+	}
+
+	if i.HasUser != nil {
+		p := userrole.HasUser()
+		if !*i.HasUser {
+			p = userrole.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasProjectWith) > 0 {
-		with := make([]predicate.Project, 0, len(i.HasProjectWith))
-		for _, w := range i.HasProjectWith {
+	if len(i.HasUserWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUserWith))
+		for _, w := range i.HasUserWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasProjectWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, usagelog.HasProjectWith(with...))
+		predicates = append(predicates, userrole.HasUserWith(with...))
 	}
-	if i.HasChannel != nil {
-		p := usagelog.HasChannel()
-		if !*i.HasChannel {
-			p = usagelog.Not(p)
+	if i.HasRole != nil {
+		p := userrole.HasRole()
+		if !*i.HasRole {
+			p = userrole.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasChannelWith) > 0 {
-		with := make([]predicate.Channel, 0, len(i.HasChannelWith))
-		for _, w := range i.HasChannelWith {
+	if len(i.HasRoleWith) > 0 {
+		with := make([]predicate.Role, 0, len(i.HasRoleWith))
+		for _, w := range i.HasRoleWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasChannelWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasRoleWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, usagelog.HasChannelWith(with...))
+		predicates = append(predicates, userrole.HasRoleWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyUsageLogWhereInput
-	case 1:
-		return predicates[0], nil
-	default:
-		return usagelog.And(predicates...), nil
-	}
-}
-
-// UserWhereInput represents a where input for filtering User queries.
-type UserWhereInput struct {
-	Predicates []predicate.User  `json:"-"`
-	Not        *UserWhereInput   `json:"not,omitempty"`
-	Or         []*UserWhereInput `json:"or,omitempty"`
-	And        []*UserWhereInput `json:"and,omitempty"`
-
-	// "id" field predicates.
-	ID      *int  `json:"id,omitempty"`
-	IDNEQ   *int  `json:"idNEQ,omitempty"`
-	IDIn    []int `json:"idIn,omitempty"`
-	IDNotIn []int `json:"idNotIn,omitempty"`
-	IDGT    *int  `json:"idGT,omitempty"`
-	IDGTE   *int  `json:"idGTE,omitempty"`
-	IDLT    *int  `json:"idLT,omitempty"`
-	IDLTE   *int  `json:"idLTE,omitempty"`
-
-	// "created_at" field predicates.
-	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
-	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
-	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
-	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
-	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
-	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
-	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
-	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
-
-	// "updated_at" field predicates.
-	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
-	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
-	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
-	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
-	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
-	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
-	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
-	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
-
-	// "email" field predicates.
-	Email             *string  `json:"email,omitempty"`
-	EmailNEQ          *string  `json:"emailNEQ,omitempty"`
-	EmailIn           []string `json:"emailIn,omitempty"`
-	EmailNotIn        []string `json:"emailNotIn,omitempty"`
-	EmailGT           *string  `json:"emailGT,omitempty"`
-	EmailGTE          *string  `json:"emailGTE,omitempty"`
-	EmailLT           *string  `json:"emailLT,omitempty"`
-	EmailLTE          *string  `json:"emailLTE,omitempty"`
-	EmailContains     *string  `json:"emailContains,omitempty"`
-	EmailHasPrefix    *string  `json:"emailHasPrefix,omitempty"`
-	EmailHasSuffix    *string  `json:"emailHasSuffix,omitempty"`
-	EmailEqualFold    *string  `json:"emailEqualFold,omitempty"`
-	EmailContainsFold *string  `json:"emailContainsFold,omitempty"`
-
-	// "status" field predicates.
-	Status      *user.Status  `json:"status,omitempty"`
-	StatusNEQ   *user.Status  `json:"statusNEQ,omitempty"`
-	StatusIn    []user.Status `json:"statusIn,omitempty"`
-	StatusNotIn []user.Status `json:"statusNotIn,omitempty"`
-
-	// "prefer_language" field predicates.
-	PreferLanguage             *string  `json:"preferLanguage,omitempty"`
-	PreferLanguageNEQ          *string  `json:"preferLanguageNEQ,omitempty"`
-	PreferLanguageIn           []string `json:"preferLanguageIn,omitempty"`
-	PreferLanguageNotIn        []string `json:"preferLanguageNotIn,omitempty"`
-	PreferLanguageGT           *string  `json:"preferLanguageGT,omitempty"`
-	PreferLanguageGTE          *string  `json:"preferLanguageGTE,omitempty"`
-	PreferLanguageLT           *string  `json:"preferLanguageLT,omitempty"`
-	PreferLanguageLTE          *string  `json:"preferLanguageLTE,omitempty"`
-	PreferLanguageContains     *string  `json:"preferLanguageContains,omitempty"`
-	PreferLanguageHasPrefix    *string  `json:"preferLanguageHasPrefix,omitempty"`
-	PreferLanguageHasSuffix    *string  `json:"preferLanguageHasSuffix,omitempty"`
-	PreferLanguageEqualFold    *string  `json:"preferLanguageEqualFold,omitempty"`
-	PreferLanguageContainsFold *string  `json:"preferLanguageContainsFold,omitempty"`
-
-	// "password" field predicates.
-	Password             *string  `json:"password,omitempty"`
-	PasswordNEQ          *string  `json:"passwordNEQ,omitempty"`
-	PasswordIn           []string `json:"passwordIn,omitempty"`
-	PasswordNotIn        []string `json:"passwordNotIn,omitempty"`
-	PasswordGT           *string  `json:"passwordGT,omitempty"`
-	PasswordGTE          *string  `json:"passwordGTE,omitempty"`
-	PasswordLT           *string  `json:"passwordLT,omitempty"`
-	PasswordLTE          *string  `json:"passwordLTE,omitempty"`
-	PasswordContains     *string  `json:"passwordContains,omitempty"`
-	PasswordHasPrefix    *string  `json:"passwordHasPrefix,omitempty"`
-	PasswordHasSuffix    *string  `json:"passwordHasSuffix,omitempty"`
-	PasswordEqualFold    *string  `json:"passwordEqualFold,omitempty"`
-	PasswordContainsFold *string  `json:"passwordContainsFold,omitempty"`
-
-	// "first_name" field predicates.
-	FirstName             *string  `json:"firstName,omitempty"`
-	FirstNameNEQ          *string  `json:"firstNameNEQ,omitempty"`
-	FirstNameIn           []string `json:"firstNameIn,omitempty"`
-	FirstNameNotIn        []string `json:"firstNameNotIn,omitempty"`
-	FirstNameGT           *string  `json:"firstNameGT,omitempty"`
-	FirstNameGTE          *string  `json:"firstNameGTE,omitempty"`
-	FirstNameLT           *string  `json:"firstNameLT,omitempty"`
-	FirstNameLTE          *string  `json:"firstNameLTE,omitempty"`
-	FirstNameContains     *string  `json:"firstNameContains,omitempty"`
-	FirstNameHasPrefix    *string  `json:"firstNameHasPrefix,omitempty"`
-	FirstNameHasSuffix    *string  `json:"firstNameHasSuffix,omitempty"`
-	FirstNameEqualFold    *string  `json:"firstNameEqualFold,omitempty"`
-	FirstNameContainsFold *string  `json:"firstNameContainsFold,omitempty"`
-
-	// "last_name" field predicates.
-	LastName             *string  `json:"lastName,omitempty"`
-	LastNameNEQ          *string  `json:"lastNameNEQ,omitempty"`
-	LastNameIn           []string `json:"lastNameIn,omitempty"`
-	LastNameNotIn        []string `json:"lastNameNotIn,omitempty"`
-	LastNameGT           *string  `json:"lastNameGT,omitempty"`
-	LastNameGTE          *string  `json:"lastNameGTE,omitempty"`
-	LastNameLT           *string  `json:"lastNameLT,omitempty"`
-	LastNameLTE          *string  `json:"lastNameLTE,omitempty"`
-	LastNameContains     *string  `json:"lastNameContains,omitempty"`
-	LastNameHasPrefix    *string  `json:"lastNameHasPrefix,omitempty"`
-	LastNameHasSuffix    *string  `json:"lastNameHasSuffix,omitempty"`
-	LastNameEqualFold    *string  `json:"lastNameEqualFold,omitempty"`
-	LastNameContainsFold *string  `json:"lastNameContainsFold,omitempty"`
-
-	// "avatar" field predicates.
-	Avatar             *string  `json:"avatar,omitempty"`
-	AvatarNEQ          *string  `json:"avatarNEQ,omitempty"`
-	AvatarIn           []string `json:"avatarIn,omitempty"`
-	AvatarNotIn        []string `json:"avatarNotIn,omitempty"`
-	AvatarGT           *string  `json:"avatarGT,omitempty"`
-	AvatarGTE          *string  `json:"avatarGTE,omitempty"`
-	AvatarLT           *string  `json:"avatarLT,omitempty"`
-	AvatarLTE          *string  `json:"avatarLTE,omitempty"`
-	AvatarContains     *string  `json:"avatarContains,omitempty"`
-	AvatarHasPrefix    *string  `json:"avatarHasPrefix,omitempty"`
-	AvatarHasSuffix    *string  `json:"avatarHasSuffix,omitempty"`
-	AvatarIsNil        bool     `json:"avatarIsNil,omitempty"`
-	AvatarNotNil       bool     `json:"avatarNotNil,omitempty"`
-	AvatarEqualFold    *string  `json:"avatarEqualFold,omitempty"`
-	AvatarContainsFold *string  `json:"avatarContainsFold,omitempty"`
+		return nil, ErrEmptyUserRoleWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return userrole.And(predicates...), nil
+	}
+}
 
-	// "is_owner" field predicates.
-	IsOwner    *bool `json:"isOwner,omitempty"`
-	IsOwnerNEQ *bool `json:"isOwnerNEQ,omitempty"`
+// WalletWhereInput represents a where input for filtering Wallet queries.
+type WalletWhereInput struct {
+	Predicates []predicate.Wallet  `json:"-"`
+	Not        *WalletWhereInput   `json:"not,omitempty"`
+	Or         []*WalletWhereInput `json:"or,omitempty"`
+	And        []*WalletWhereInput `json:"and,omitempty"`
 
-	// "projects" edge predicates.
-	HasProjects     *bool                `json:"hasProjects,omitempty"`
-	HasProjectsWith []*ProjectWhereInput `json:"hasProjectsWith,omitempty"`
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
 
-	// "api_keys" edge predicates.
-	HasAPIKeys     *bool               `json:"hasAPIKeys,omitempty"`
-	HasAPIKeysWith []*APIKeyWhereInput `json:"hasAPIKeysWith,omitempty"`
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
-	// "roles" edge predicates.
-	HasRoles     *bool             `json:"hasRoles,omitempty"`
-	HasRolesWith []*RoleWhereInput `json:"hasRolesWith,omitempty"`
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
-	// "channel_override_templates" edge predicates.
-	HasChannelOverrideTemplates     *bool                                `json:"hasChannelOverrideTemplates,omitempty"`
-	HasChannelOverrideTemplatesWith []*ChannelOverrideTemplateWhereInput `json:"hasChannelOverrideTemplatesWith,omitempty"`
+	// "api_key_id" field predicates.
+	APIKeyID      *int  `json:"apiKeyID,omitempty"`
+	APIKeyIDNEQ   *int  `json:"apiKeyIDNEQ,omitempty"`
+	APIKeyIDIn    []int `json:"apiKeyIDIn,omitempty"`
+	APIKeyIDNotIn []int `json:"apiKeyIDNotIn,omitempty"`
 
-	// "oidc_identities" edge predicates.
-	HasOidcIdentities     *bool                     `json:"hasOidcIdentities,omitempty"`
-	HasOidcIdentitiesWith []*OIDCIdentityWhereInput `json:"hasOidcIdentitiesWith,omitempty"`
+	// "project_id" field predicates.
+	ProjectID      *int  `json:"projectID,omitempty"`
+	ProjectIDNEQ   *int  `json:"projectIDNEQ,omitempty"`
+	ProjectIDIn    []int `json:"projectIDIn,omitempty"`
+	ProjectIDNotIn []int `json:"projectIDNotIn,omitempty"`
+	ProjectIDGT    *int  `json:"projectIDGT,omitempty"`
+	ProjectIDGTE   *int  `json:"projectIDGTE,omitempty"`
+	ProjectIDLT    *int  `json:"projectIDLT,omitempty"`
+	ProjectIDLTE   *int  `json:"projectIDLTE,omitempty"`
 
-	// "project_users" edge predicates.
-	HasProjectUsers     *bool                    `json:"hasProjectUsers,omitempty"`
-	HasProjectUsersWith []*UserProjectWhereInput `json:"hasProjectUsersWith,omitempty"`
+	// "balance" field predicates.
+	Balance      *float64  `json:"balance,omitempty"`
+	BalanceNEQ   *float64  `json:"balanceNEQ,omitempty"`
+	BalanceIn    []float64 `json:"balanceIn,omitempty"`
+	BalanceNotIn []float64 `json:"balanceNotIn,omitempty"`
+	BalanceGT    *float64  `json:"balanceGT,omitempty"`
+	BalanceGTE   *float64  `json:"balanceGTE,omitempty"`
+	BalanceLT    *float64  `json:"balanceLT,omitempty"`
+	BalanceLTE   *float64  `json:"balanceLTE,omitempty"`
+
+	// "reserved" field predicates.
+	Reserved      *float64  `json:"reserved,omitempty"`
+	ReservedNEQ   *float64  `json:"reservedNEQ,omitempty"`
+	ReservedIn    []float64 `json:"reservedIn,omitempty"`
+	ReservedNotIn []float64 `json:"reservedNotIn,omitempty"`
+	ReservedGT    *float64  `json:"reservedGT,omitempty"`
+	ReservedGTE   *float64  `json:"reservedGTE,omitempty"`
+	ReservedLT    *float64  `json:"reservedLT,omitempty"`
+	ReservedLTE   *float64  `json:"reservedLTE,omitempty"`
 
-	// "user_roles" edge predicates.
-	HasUserRoles     *bool                 `json:"hasUserRoles,omitempty"`
-	HasUserRolesWith []*UserRoleWhereInput `json:"hasUserRolesWith,omitempty"`
+	// "status" field predicates.
+	Status      *wallet.Status  `json:"status,omitempty"`
+	StatusNEQ   *wallet.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []wallet.Status `json:"statusIn,omitempty"`
+	StatusNotIn []wallet.Status `json:"statusNotIn,omitempty"`
+
+	// "low_balance_threshold" field predicates.
+	LowBalanceThreshold       *float64  `json:"lowBalanceThreshold,omitempty"`
+	LowBalanceThresholdNEQ    *float64  `json:"lowBalanceThresholdNEQ,omitempty"`
+	LowBalanceThresholdIn     []float64 `json:"lowBalanceThresholdIn,omitempty"`
+	LowBalanceThresholdNotIn  []float64 `json:"lowBalanceThresholdNotIn,omitempty"`
+	LowBalanceThresholdGT     *float64  `json:"lowBalanceThresholdGT,omitempty"`
+	LowBalanceThresholdGTE    *float64  `json:"lowBalanceThresholdGTE,omitempty"`
+	LowBalanceThresholdLT     *float64  `json:"lowBalanceThresholdLT,omitempty"`
+	LowBalanceThresholdLTE    *float64  `json:"lowBalanceThresholdLTE,omitempty"`
+	LowBalanceThresholdIsNil  bool      `json:"lowBalanceThresholdIsNil,omitempty"`
+	LowBalanceThresholdNotNil bool      `json:"lowBalanceThresholdNotNil,omitempty"`
+
+	// "api_key" edge predicates.
+	HasAPIKey     *bool               `json:"hasAPIKey,omitempty"`
+	HasAPIKeyWith []*APIKeyWhereInput `json:"hasAPIKeyWith,omitempty"`
+
+	// "transactions" edge predicates.
+	HasTransactions     *bool                          `json:"hasTransactions,omitempty"`
+	HasTransactionsWith []*WalletTransactionWhereInput `json:"hasTransactionsWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *UserWhereInput) AddPredicates(predicates ...predicate.User) {
+func (i *WalletWhereInput) AddPredicates(predicates ...predicate.Wallet) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the UserWhereInput filter on the UserQuery builder.
-func (i *UserWhereInput) Filter(q *UserQuery) (*UserQuery, error) {
+// Filter applies the WalletWhereInput filter on the WalletQuery builder.
+func (i *WalletWhereInput) Filter(q *WalletQuery) (*WalletQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyUserWhereInput {
+		if err == ErrEmptyWalletWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -10950,19 +15460,19 @@ func (i *UserWhereInput) Filter(q *UserQuery) (*UserQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyUserWhereInput is returned in case the UserWhereInput is empty.
-var ErrEmptyUserWhereInput = errors.New("ent: empty predicate UserWhereInput")
+// ErrEmptyWalletWhereInput is returned in case the WalletWhereInput is empty.
+var ErrEmptyWalletWhereInput = errors.New("ent: empty predicate WalletWhereInput")
 
-// P returns a predicate for filtering users.
+// P returns a predicate for filtering wallets.
 // An error is returned if the input is empty or invalid.
-func (i *UserWhereInput) P() (predicate.User, error) {
-	var predicates []predicate.User
+func (i *WalletWhereInput) P() (predicate.Wallet, error) {
+	var predicates []predicate.Wallet
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, user.Not(p))
+		predicates = append(predicates, wallet.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -10972,7 +15482,7 @@ func (i *UserWhereInput) P() (predicate.User, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.User, 0, n)
+		or := make([]predicate.Wallet, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -10980,7 +15490,7 @@ func (i *UserWhereInput) P() (predicate.User, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, user.Or(or...))
+		predicates = append(predicates, wallet.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -10990,7 +15500,7 @@ func (i *UserWhereInput) P() (predicate.User, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.User, 0, n)
+		and := make([]predicate.Wallet, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -10998,482 +15508,260 @@ func (i *UserWhereInput) P() (predicate.User, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, user.And(and...))
+		predicates = append(predicates, wallet.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, user.IDEQ(*i.ID))
+		predicates = append(predicates, wallet.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, user.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, wallet.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, user.IDIn(i.IDIn...))
+		predicates = append(predicates, wallet.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, user.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, wallet.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, user.IDGT(*i.IDGT))
+		predicates = append(predicates, wallet.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, user.IDGTE(*i.IDGTE))
+		predicates = append(predicates, wallet.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, user.IDLT(*i.IDLT))
-	}
-	if i.IDLTE != nil {
-		predicates = append(predicates, user.IDLTE(*i.IDLTE))
-	}
-	if i.CreatedAt != nil {
-		predicates = append(predicates, user.CreatedAtEQ(*i.CreatedAt))
-	}
-	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, user.CreatedAtNEQ(*i.CreatedAtNEQ))
-	}
-	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, user.CreatedAtIn(i.CreatedAtIn...))
-	}
-	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, user.CreatedAtNotIn(i.CreatedAtNotIn...))
-	}
-	if i.CreatedAtGT != nil {
-		predicates = append(predicates, user.CreatedAtGT(*i.CreatedAtGT))
-	}
-	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, user.CreatedAtGTE(*i.CreatedAtGTE))
-	}
-	if i.CreatedAtLT != nil {
-		predicates = append(predicates, user.CreatedAtLT(*i.CreatedAtLT))
-	}
-	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, user.CreatedAtLTE(*i.CreatedAtLTE))
-	}
-	if i.UpdatedAt != nil {
-		predicates = append(predicates, user.UpdatedAtEQ(*i.UpdatedAt))
-	}
-	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, user.UpdatedAtNEQ(*i.UpdatedAtNEQ))
-	}
-	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, user.UpdatedAtIn(i.UpdatedAtIn...))
-	}
-	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, user.UpdatedAtNotIn(i.UpdatedAtNotIn...))
-	}
-	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, user.UpdatedAtGT(*i.UpdatedAtGT))
-	}
-	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, user.UpdatedAtGTE(*i.UpdatedAtGTE))
-	}
-	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, user.UpdatedAtLT(*i.UpdatedAtLT))
-	}
-	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, user.UpdatedAtLTE(*i.UpdatedAtLTE))
-	}
-	if i.Email != nil {
-		predicates = append(predicates, user.EmailEQ(*i.Email))
-	}
-	if i.EmailNEQ != nil {
-		predicates = append(predicates, user.EmailNEQ(*i.EmailNEQ))
-	}
-	if len(i.EmailIn) > 0 {
-		predicates = append(predicates, user.EmailIn(i.EmailIn...))
-	}
-	if len(i.EmailNotIn) > 0 {
-		predicates = append(predicates, user.EmailNotIn(i.EmailNotIn...))
-	}
-	if i.EmailGT != nil {
-		predicates = append(predicates, user.EmailGT(*i.EmailGT))
-	}
-	if i.EmailGTE != nil {
-		predicates = append(predicates, user.EmailGTE(*i.EmailGTE))
-	}
-	if i.EmailLT != nil {
-		predicates = append(predicates, user.EmailLT(*i.EmailLT))
-	}
-	if i.EmailLTE != nil {
-		predicates = append(predicates, user.EmailLTE(*i.EmailLTE))
-	}
-	if i.EmailContains != nil {
-		predicates = append(predicates, user.EmailContains(*i.EmailContains))
-	}
-	if i.EmailHasPrefix != nil {
-		predicates = append(predicates, user.EmailHasPrefix(*i.EmailHasPrefix))
-	}
-	if i.EmailHasSuffix != nil {
-		predicates = append(predicates, user.EmailHasSuffix(*i.EmailHasSuffix))
-	}
-	if i.EmailEqualFold != nil {
-		predicates = append(predicates, user.EmailEqualFold(*i.EmailEqualFold))
-	}
-	if i.EmailContainsFold != nil {
-		predicates = append(predicates, user.EmailContainsFold(*i.EmailContainsFold))
-	}
-	if i.Status != nil {
-		predicates = append(predicates, user.StatusEQ(*i.Status))
-	}
-	if i.StatusNEQ != nil {
-		predicates = append(predicates, user.StatusNEQ(*i.StatusNEQ))
-	}
-	if len(i.StatusIn) > 0 {
-		predicates = append(predicates, user.StatusIn(i.StatusIn...))
-	}
-	if len(i.StatusNotIn) > 0 {
-		predicates = append(predicates, user.StatusNotIn(i.StatusNotIn...))
-	}
-	if i.PreferLanguage != nil {
-		predicates = append(predicates, user.PreferLanguageEQ(*i.PreferLanguage))
-	}
-	if i.PreferLanguageNEQ != nil {
-		predicates = append(predicates, user.PreferLanguageNEQ(*i.PreferLanguageNEQ))
-	}
-	if len(i.PreferLanguageIn) > 0 {
-		predicates = append(predicates, user.PreferLanguageIn(i.PreferLanguageIn...))
-	}
-	if len(i.PreferLanguageNotIn) > 0 {
-		predicates = append(predicates, user.PreferLanguageNotIn(i.PreferLanguageNotIn...))
-	}
-	if i.PreferLanguageGT != nil {
-		predicates = append(predicates, user.PreferLanguageGT(*i.PreferLanguageGT))
-	}
-	if i.PreferLanguageGTE != nil {
-		predicates = append(predicates, user.PreferLanguageGTE(*i.PreferLanguageGTE))
-	}
-	if i.PreferLanguageLT != nil {
-		predicates = append(predicates, user.PreferLanguageLT(*i.PreferLanguageLT))
-	}
-	if i.PreferLanguageLTE != nil {
-		predicates = append(predicates, user.PreferLanguageLTE(*i.PreferLanguageLTE))
-	}
-	if i.PreferLanguageContains != nil {
-		predicates = append(predicates, user.PreferLanguageContains(*i.PreferLanguageContains))
-	}
-	if i.PreferLanguageHasPrefix != nil {
-		predicates = append(predicates, user.PreferLanguageHasPrefix(*i.PreferLanguageHasPrefix))
-	}
-	if i.PreferLanguageHasSuffix != nil {
-		predicates = append(predicates, user.PreferLanguageHasSuffix(*i.PreferLanguageHasSuffix))
-	}
-	if i.PreferLanguageEqualFold != nil {
-		predicates = append(predicates, user.PreferLanguageEqualFold(*i.PreferLanguageEqualFold))
-	}
-	if i.PreferLanguageContainsFold != nil {
-		predicates = append(predicates, user.PreferLanguageContainsFold(*i.PreferLanguageContainsFold))
-	}
-	if i.Password != nil {
-		predicates = append(predicates, user.PasswordEQ(*i.Password))
-	}
-	if i.PasswordNEQ != nil {
-		predicates = append(predicates, user.PasswordNEQ(*i.PasswordNEQ))
-	}
-	if len(i.PasswordIn) > 0 {
-		predicates = append(predicates, user.PasswordIn(i.PasswordIn...))
-	}
-	if len(i.PasswordNotIn) > 0 {
-		predicates = append(predicates, user.PasswordNotIn(i.PasswordNotIn...))
-	}
-	if i.PasswordGT != nil {
-		predicates = append(predicates, user.PasswordGT(*i.PasswordGT))
-	}
-	if i.PasswordGTE != nil {
-		predicates = append(predicates, user.PasswordGTE(*i.PasswordGTE))
-	}
-	if i.PasswordLT != nil {
-		predicates = append(predicates, user.PasswordLT(*i.PasswordLT))
+		predicates = append(predicates, wallet.IDLT(*i.IDLT))
 	}
-	if i.PasswordLTE != nil {
-		predicates = append(predicates, user.PasswordLTE(*i.PasswordLTE))
+	if i.IDLTE != nil {
+		predicates = append(predicates, wallet.IDLTE(*i.IDLTE))
 	}
-	if i.PasswordContains != nil {
-		predicates = append(predicates, user.PasswordContains(*i.PasswordContains))
+	if i.CreatedAt != nil {
+		predicates = append(predicates, wallet.CreatedAtEQ(*i.CreatedAt))
 	}
-	if i.PasswordHasPrefix != nil {
-		predicates = append(predicates, user.PasswordHasPrefix(*i.PasswordHasPrefix))
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, wallet.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
-	if i.PasswordHasSuffix != nil {
-		predicates = append(predicates, user.PasswordHasSuffix(*i.PasswordHasSuffix))
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, wallet.CreatedAtIn(i.CreatedAtIn...))
 	}
-	if i.PasswordEqualFold != nil {
-		predicates = append(predicates, user.PasswordEqualFold(*i.PasswordEqualFold))
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, wallet.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
-	if i.PasswordContainsFold != nil {
-		predicates = append(predicates, user.PasswordContainsFold(*i.PasswordContainsFold))
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, wallet.CreatedAtGT(*i.CreatedAtGT))
 	}
-	if i.FirstName != nil {
-		predicates = append(predicates, user.FirstNameEQ(*i.FirstName))
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, wallet.CreatedAtGTE(*i.CreatedAtGTE))
 	}
-	if i.FirstNameNEQ != nil {
-		predicates = append(predicates, user.FirstNameNEQ(*i.FirstNameNEQ))
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, wallet.CreatedAtLT(*i.CreatedAtLT))
 	}
-	if len(i.FirstNameIn) > 0 {
-		predicates = append(predicates, user.FirstNameIn(i.FirstNameIn...))
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, wallet.CreatedAtLTE(*i.CreatedAtLTE))
 	}
-	if len(i.FirstNameNotIn) > 0 {
-		predicates = append(predicates, user.FirstNameNotIn(i.FirstNameNotIn...))
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, wallet.UpdatedAtEQ(*i.UpdatedAt))
 	}
-	if i.FirstNameGT != nil {
-		predicates = append(predicates, user.FirstNameGT(*i.FirstNameGT))
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, wallet.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
-	if i.FirstNameGTE != nil {
-		predicates = append(predicates, user.FirstNameGTE(*i.FirstNameGTE))
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, wallet.UpdatedAtIn(i.UpdatedAtIn...))
 	}
-	if i.FirstNameLT != nil {
-		predicates = append(predicates, user.FirstNameLT(*i.FirstNameLT))
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, wallet.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
-	if i.FirstNameLTE != nil {
-		predicates = append(predicates, user.FirstNameLTE(*i.FirstNameLTE))
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, wallet.UpdatedAtGT(*i.UpdatedAtGT))
 	}
-	if i.FirstNameContains != nil {
-		predicates = append(predicates, user.FirstNameContains(*i.FirstNameContains))
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, wallet.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
-	if i.FirstNameHasPrefix != nil {
-		predicates = append(predicates, user.FirstNameHasPrefix(*i.FirstNameHasPrefix))
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, wallet.UpdatedAtLT(*i.UpdatedAtLT))
 	}
-	if i.FirstNameHasSuffix != nil {
-		predicates = append(predicates, user.FirstNameHasSuffix(*i.FirstNameHasSuffix))
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, wallet.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.FirstNameEqualFold != nil {
-		predicates = append(predicates, user.FirstNameEqualFold(*i.FirstNameEqualFold))
+	if i.APIKeyID != nil {
+		predicates = append(predicates, wallet.APIKeyIDEQ(*i.APIKeyID))
 	}
-	if i.FirstNameContainsFold != nil {
-		predicates = append(predicates, user.FirstNameContainsFold(*i.FirstNameContainsFold))
+	if i.APIKeyIDNEQ != nil {
+		predicates = append(predicates, wallet.APIKeyIDNEQ(*i.APIKeyIDNEQ))
 	}
-	if i.LastName != nil {
-		predicates = append(predicates, user.LastNameEQ(*i.LastName))
+	if len(i.APIKeyIDIn) > 0 {
+		predicates = append(predicates, wallet.APIKeyIDIn(i.APIKeyIDIn...))
 	}
-	if i.LastNameNEQ != nil {
-		predicates = append(predicates, user.LastNameNEQ(*i.LastNameNEQ))
+	if len(i.APIKeyIDNotIn) > 0 {
+		predicates = append(predicates, wallet.APIKeyIDNotIn(i.APIKeyIDNotIn...))
 	}
-	if len(i.LastNameIn) > 0 {
-		predicates = append(predicates, user.LastNameIn(i.LastNameIn...))
+	if i.ProjectID != nil {
+		predicates = append(predicates, wallet.ProjectIDEQ(*i.ProjectID))
 	}
-	if len(i.LastNameNotIn) > 0 {
-		predicates = append(predicates, user.LastNameNotIn(i.LastNameNotIn...))
+	if i.ProjectIDNEQ != nil {
+		predicates = append(predicates, wallet.ProjectIDNEQ(*i.ProjectIDNEQ))
 	}
-	if i.LastNameGT != nil {
-		predicates = append(predicates, user.LastNameGT(*i.LastNameGT))
+	if len(i.ProjectIDIn) > 0 {
+		predicates = append(predicates, wallet.ProjectIDIn(i.ProjectIDIn...))
 	}
-	if i.LastNameGTE != nil {
-		predicates = append(predicates, user.LastNameGTE(*i.LastNameGTE))
+	if len(i.ProjectIDNotIn) > 0 {
+		predicates = append(predicates, wallet.ProjectIDNotIn(i.ProjectIDNotIn...))
 	}
-	if i.LastNameLT != nil {
-		predicates = append(predicates, user.LastNameLT(*i.LastNameLT))
+	if i.ProjectIDGT != nil {
+		predicates = append(predicates, wallet.ProjectIDGT(*i.ProjectIDGT))
 	}
-	if i.LastNameLTE != nil {
-		predicates = append(predicates, user.LastNameLTE(*i.LastNameLTE))
+	if i.ProjectIDGTE != nil {
+		predicates = append(predicates, wallet.ProjectIDGTE(*i.ProjectIDGTE))
 	}
-	if i.LastNameContains != nil {
-		predicates = append(predicates, user.LastNameContains(*i.LastNameContains))
+	if i.ProjectIDLT != nil {
+		predicates = append(predicates, wallet.ProjectIDLT(*i.ProjectIDLT))
 	}
-	if i.LastNameHasPrefix != nil {
-		predicates = append(predicates, user.LastNameHasPrefix(*i.LastNameHasPrefix))
+	if i.ProjectIDLTE != nil {
+		predicates = append(predicates, wallet.ProjectIDLTE(*i.ProjectIDLTE))
 	}
-	if i.LastNameHasSuffix != nil {
-		predicates = append(predicates, user.LastNameHasSuffix(*i.LastNameHasSuffix))
+	if i.Balance != nil {
+		predicates = append(predicates, wallet.BalanceEQ(*i.Balance))
 	}
-	if i.LastNameEqualFold != nil {
-		predicates = append(predicates, user.LastNameEqualFold(*i.LastNameEqualFold))
+	if i.BalanceNEQ != nil {
+		predicates = append(predicates, wallet.BalanceNEQ(*i.BalanceNEQ))
 	}
-	if i.LastNameContainsFold != nil {
-		predicates = append(predicates, user.LastNameContainsFold(*i.LastNameContainsFold))
+	if len(i.BalanceIn) > 0 {
+		predicates = append(predicates, wallet.BalanceIn(i.BalanceIn...))
 	}
-	if i.Avatar != nil {
-		predicates = append(predicates, user.AvatarEQ(*i.Avatar))
+	if len(i.BalanceNotIn) > 0 {
+		predicates = append(predicates, wallet.BalanceNotIn(i.BalanceNotIn...))
 	}
-	if i.AvatarNEQ != nil {
-		predicates = append(predicates, user.AvatarNEQ(*i.AvatarNEQ))
+	if i.BalanceGT != nil {
+		predicates = append(predicates, wallet.BalanceGT(*i.BalanceGT))
 	}
-	if len(i.AvatarIn) > 0 {
-		predicates = append(predicates, user.AvatarIn(i.AvatarIn...))
+	if i.BalanceGTE != nil {
+		predicates = append(predicates, wallet.BalanceGTE(*i.BalanceGTE))
 	}
-	if len(i.AvatarNotIn) > 0 {
-		predicates = append(predicates, user.AvatarNotIn(i.AvatarNotIn...))
+	if i.BalanceLT != nil {
+		predicates = append(predicates, wallet.BalanceLT(*i.BalanceLT))
 	}
-	if i.AvatarGT != nil {
-		predicates = append(predicates, user.AvatarGT(*i.AvatarGT))
+	if i.BalanceLTE != nil {
+		predicates = append(predicates, wallet.BalanceLTE(*i.BalanceLTE))
 	}
-	if i.AvatarGTE != nil {
-		predicates = append(predicates, user.AvatarGTE(*i.AvatarGTE))
+	if i.Reserved != nil {
+		predicates = append(predicates, wallet.ReservedEQ(*i.Reserved))
 	}
-	if i.AvatarLT != nil {
-		predicates = append(predicates, user.AvatarLT(*i.AvatarLT))
+	if i.ReservedNEQ != nil {
+		predicates = append(predicates, wallet.ReservedNEQ(*i.ReservedNEQ))
 	}
-	if i.AvatarLTE != nil {
-		predicates = append(predicates, user.AvatarLTE(*i.AvatarLTE))
+	if len(i.ReservedIn) > 0 {
+		predicates = append(predicates, wallet.ReservedIn(i.ReservedIn...))
 	}
-	if i.AvatarContains != nil {
-		predicates = append(predicates, user.AvatarContains(*i.AvatarContains))
+	if len(i.ReservedNotIn) > 0 {
+		predicates = append(predicates, wallet.ReservedNotIn(i.ReservedNotIn...))
 	}
-	if i.AvatarHasPrefix != nil {
-		predicates = append(predicates, user.AvatarHasPrefix(*i.AvatarHasPrefix))
+	if i.ReservedGT != nil {
+		predicates = append(predicates, wallet.ReservedGT(*i.ReservedGT))
 	}
-	if i.AvatarHasSuffix != nil {
-		predicates = append(predicates, user.AvatarHasSuffix(*i.AvatarHasSuffix))
+	if i.ReservedGTE != nil {
+		predicates = append(predicates, wallet.ReservedGTE(*i.ReservedGTE))
 	}
-	if i.AvatarIsNil {
-		predicates = append(predicates, user.AvatarIsNil())
+	if i.ReservedLT != nil {
+		predicates = append(predicates, wallet.ReservedLT(*i.ReservedLT))
 	}
-	if i.AvatarNotNil {
-		predicates = append(predicates, user.AvatarNotNil())
+	if i.ReservedLTE != nil {
+		predicates = append(predicates, wallet.ReservedLTE(*i.ReservedLTE))
 	}
-	if i.AvatarEqualFold != nil {
-		predicates = append(predicates, user.AvatarEqualFold(*i.AvatarEqualFold))
+	if i.Status != nil {
+		predicates = append(predicates, wallet.StatusEQ(*i.Status))
 	}
-	if i.AvatarContainsFold != nil {
-		predicates = append(predicates, user.AvatarContainsFold(*i.AvatarContainsFold))
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, wallet.StatusNEQ(*i.StatusNEQ))
 	}
-	if i.IsOwner != nil {
-		predicates = append(predicates, user.IsOwnerEQ(*i.IsOwner))
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, wallet.StatusIn(i.StatusIn...))
 	}
-	if i.IsOwnerNEQ != nil {
-		predicates = append(predicates, user.IsOwnerNEQ(*i.IsOwnerNEQ))
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, wallet.StatusNotIn(i.StatusNotIn...))
 	}
-
-	if i.HasProjects != nil {
-		p := user.HasProjects()
-		if !*i.HasProjects {
-			p = user.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.LowBalanceThreshold != nil {
+		predicates = append(predicates, wallet.LowBalanceThresholdEQ(*i.LowBalanceThreshold))
 	}
-	if len(i.HasProjectsWith) > 0 {
-		with := make([]predicate.Project, 0, len(i.HasProjectsWith))
-		for _, w := range i.HasProjectsWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasProjectsWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, user.HasProjectsWith(with...))
+	if i.LowBalanceThresholdNEQ != nil {
+		predicates = append(predicates, wallet.LowBalanceThresholdNEQ(*i.LowBalanceThresholdNEQ))
 	}
-	if i.HasAPIKeys != nil {
-		p := user.HasAPIKeys()
-		if !*i.HasAPIKeys {
-			p = user.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.LowBalanceThresholdIn) > 0 {
+		predicates = append(predicates, wallet.LowBalanceThresholdIn(i.LowBalanceThresholdIn...))
 	}
-	if len(i.HasAPIKeysWith) > 0 {
-		with := make([]predicate.APIKey, 0, len(i.HasAPIKeysWith))
-		for _, w := range i.HasAPIKeysWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasAPIKeysWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, user.HasAPIKeysWith(with...))
+	if len(i.LowBalanceThresholdNotIn) > 0 {
+		predicates = append(predicates, wallet.LowBalanceThresholdNotIn(i.LowBalanceThresholdNotIn...))
 	}
-	if i.HasRoles != nil {
-		p := user.HasRoles()
-		if !*i.HasRoles {
-			p = user.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.LowBalanceThresholdGT != nil {
+		predicates = append(predicates, wallet.LowBalanceThresholdGT(*i.LowBalanceThresholdGT))
 	}
-	if len(i.HasRolesWith) > 0 {
-		with := make([]predicate.Role, 0, len(i.HasRolesWith))
-		for _, w := range i.HasRolesWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasRolesWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, user.HasRolesWith(with...))
+	if i.LowBalanceThresholdGTE != nil {
+		predicates = append(predicates, wallet.LowBalanceThresholdGTE(*i.LowBalanceThresholdGTE))
 	}
-	if i.HasChannelOverrideTemplates != nil {
-		p := user.HasChannelOverrideTemplates()
-		if !*i.HasChannelOverrideTemplates {
-			p = user.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.LowBalanceThresholdLT != nil {
+		predicates = append(predicates, wallet.LowBalanceThresholdLT(*i.LowBalanceThresholdLT))
 	}
-	if len(i.HasChannelOverrideTemplatesWith) > 0 {
-		with := make([]predicate.ChannelOverrideTemplate, 0, len(i.HasChannelOverrideTemplatesWith))
-		for _, w := range i.HasChannelOverrideTemplatesWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasChannelOverrideTemplatesWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, user.HasChannelOverrideTemplatesWith(with...))
+	if i.LowBalanceThresholdLTE != nil {
+		predicates = append(predicates, wallet.LowBalanceThresholdLTE(*i.LowBalanceThresholdLTE))
 	}
-	if i.HasOidcIdentities != nil {
-		p := user.HasOidcIdentities()
-		if !*i.HasOidcIdentities {
-			p = user.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.LowBalanceThresholdIsNil {
+		predicates = append(predicates, wallet.LowBalanceThresholdIsNil())
 	}
-	if len(i.HasOidcIdentitiesWith) > 0 {
-		with := make([]predicate.OIDCIdentity, 0, len(i.HasOidcIdentitiesWith))
-		for _, w := range i.HasOidcIdentitiesWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasOidcIdentitiesWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, user.HasOidcIdentitiesWith(with...))
+	if i.LowBalanceThresholdNotNil {
+		predicates = append(predicates, wallet.LowBalanceThresholdNotNil())
 	}
-	if i.HasProjectUsers != nil {
-		p := user.HasProjectUsers()
-		if !*i.HasProjectUsers {
-			p = user.Not(p)
+
+	if i.HasAPIKey != nil {
+		p := wallet.HasAPIKey()
+		if !*i.HasAPIKey {
+			p = wallet.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasProjectUsersWith) > 0 {
-		with := make([]predicate.UserProject, 0, len(i.HasProjectUsersWith))
-		for _, w := range i.HasProjectUsersWith {
+	if len(i.HasAPIKeyWith) > 0 {
+		with := make([]predicate.APIKey, 0, len(i.HasAPIKeyWith))
+		for _, w := range i.HasAPIKeyWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasProjectUsersWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasAPIKeyWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, user.HasProjectUsersWith(with...))
+		predicates = append(predicates, wallet.HasAPIKeyWith(with...))
 	}
-	if i.HasUserRoles != nil {
-		p := user.HasUserRoles()
-		if !*i.HasUserRoles {
-			p = user.Not(p)
+	if i.HasTransactions != nil {
+		p := wallet.HasTransactions()
+		if !*i.HasTransactions {
+			p = wallet.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasUserRolesWith) > 0 {
-		with := make([]predicate.UserRole, 0, len(i.HasUserRolesWith))
-		for _, w := range i.HasUserRolesWith {
+	if len(i.HasTransactionsWith) > 0 {
+		with := make([]predicate.WalletTransaction, 0, len(i.HasTransactionsWith))
+		for _, w := range i.HasTransactionsWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUserRolesWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasTransactionsWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, user.HasUserRolesWith(with...))
+		predicates = append(predicates, wallet.HasTransactionsWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyUserWhereInput
+		return nil, ErrEmptyWalletWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return user.And(predicates...), nil
+		return wallet.And(predicates...), nil
 	}
 }
 
-// UserProjectWhereInput represents a where input for filtering UserProject queries.
-type UserProjectWhereInput struct {
-	Predicates []predicate.UserProject  `json:"-"`
-	Not        *UserProjectWhereInput   `json:"not,omitempty"`
-	Or         []*UserProjectWhereInput `json:"or,omitempty"`
-	And        []*UserProjectWhereInput `json:"and,omitempty"`
+// WalletTransactionWhereInput represents a where input for filtering WalletTransaction queries.
+type WalletTransactionWhereInput struct {
+	Predicates []predicate.WalletTransaction  `json:"-"`
+	Not        *WalletTransactionWhereInput   `json:"not,omitempty"`
+	Or         []*WalletTransactionWhereInput `json:"or,omitempty"`
+	And        []*WalletTransactionWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -11505,44 +15793,127 @@ type UserProjectWhereInput struct {
 	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
 	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
-	// "user_id" field predicates.
-	UserID      *int  `json:"userID,omitempty"`
-	UserIDNEQ   *int  `json:"userIDNEQ,omitempty"`
-	UserIDIn    []int `json:"userIDIn,omitempty"`
-	UserIDNotIn []int `json:"userIDNotIn,omitempty"`
+	// "wallet_id" field predicates.
+	WalletID      *int  `json:"walletID,omitempty"`
+	WalletIDNEQ   *int  `json:"walletIDNEQ,omitempty"`
+	WalletIDIn    []int `json:"walletIDIn,omitempty"`
+	WalletIDNotIn []int `json:"walletIDNotIn,omitempty"`
+
+	// "api_key_id" field predicates.
+	APIKeyID      *int  `json:"apiKeyID,omitempty"`
+	APIKeyIDNEQ   *int  `json:"apiKeyIDNEQ,omitempty"`
+	APIKeyIDIn    []int `json:"apiKeyIDIn,omitempty"`
+	APIKeyIDNotIn []int `json:"apiKeyIDNotIn,omitempty"`
+	APIKeyIDGT    *int  `json:"apiKeyIDGT,omitempty"`
+	APIKeyIDGTE   *int  `json:"apiKeyIDGTE,omitempty"`
+	APIKeyIDLT    *int  `json:"apiKeyIDLT,omitempty"`
+	APIKeyIDLTE   *int  `json:"apiKeyIDLTE,omitempty"`
 
 	// "project_id" field predicates.
 	ProjectID      *int  `json:"projectID,omitempty"`
 	ProjectIDNEQ   *int  `json:"projectIDNEQ,omitempty"`
 	ProjectIDIn    []int `json:"projectIDIn,omitempty"`
 	ProjectIDNotIn []int `json:"projectIDNotIn,omitempty"`
+	ProjectIDGT    *int  `json:"projectIDGT,omitempty"`
+	ProjectIDGTE   *int  `json:"projectIDGTE,omitempty"`
+	ProjectIDLT    *int  `json:"projectIDLT,omitempty"`
+	ProjectIDLTE   *int  `json:"projectIDLTE,omitempty"`
 
-	// "is_owner" field predicates.
-	IsOwner    *bool `json:"isOwner,omitempty"`
-	IsOwnerNEQ *bool `json:"isOwnerNEQ,omitempty"`
+	// "type" field predicates.
+	Type      *wallettransaction.Type  `json:"type,omitempty"`
+	TypeNEQ   *wallettransaction.Type  `json:"typeNEQ,omitempty"`
+	TypeIn    []wallettransaction.Type `json:"typeIn,omitempty"`
+	TypeNotIn []wallettransaction.Type `json:"typeNotIn,omitempty"`
+
+	// "amount" field predicates.
+	Amount      *float64  `json:"amount,omitempty"`
+	AmountNEQ   *float64  `json:"amountNEQ,omitempty"`
+	AmountIn    []float64 `json:"amountIn,omitempty"`
+	AmountNotIn []float64 `json:"amountNotIn,omitempty"`
+	AmountGT    *float64  `json:"amountGT,omitempty"`
+	AmountGTE   *float64  `json:"amountGTE,omitempty"`
+	AmountLT    *float64  `json:"amountLT,omitempty"`
+	AmountLTE   *float64  `json:"amountLTE,omitempty"`
+
+	// "balance_after" field predicates.
+	BalanceAfter      *float64  `json:"balanceAfter,omitempty"`
+	BalanceAfterNEQ   *float64  `json:"balanceAfterNEQ,omitempty"`
+	BalanceAfterIn    []float64 `json:"balanceAfterIn,omitempty"`
+	BalanceAfterNotIn []float64 `json:"balanceAfterNotIn,omitempty"`
+	BalanceAfterGT    *float64  `json:"balanceAfterGT,omitempty"`
+	BalanceAfterGTE   *float64  `json:"balanceAfterGTE,omitempty"`
+	BalanceAfterLT    *float64  `json:"balanceAfterLT,omitempty"`
+	BalanceAfterLTE   *float64  `json:"balanceAfterLTE,omitempty"`
 
-	// "user" edge predicates.
-	HasUser     *bool             `json:"hasUser,omitempty"`
-	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+	// "request_id" field predicates.
+	RequestID       *int  `json:"requestID,omitempty"`
+	RequestIDNEQ    *int  `json:"requestIDNEQ,omitempty"`
+	RequestIDIn     []int `json:"requestIDIn,omitempty"`
+	RequestIDNotIn  []int `json:"requestIDNotIn,omitempty"`
+	RequestIDGT     *int  `json:"requestIDGT,omitempty"`
+	RequestIDGTE    *int  `json:"requestIDGTE,omitempty"`
+	RequestIDLT     *int  `json:"requestIDLT,omitempty"`
+	RequestIDLTE    *int  `json:"requestIDLTE,omitempty"`
+	RequestIDIsNil  bool  `json:"requestIDIsNil,omitempty"`
+	RequestIDNotNil bool  `json:"requestIDNotNil,omitempty"`
+
+	// "usage_log_id" field predicates.
+	UsageLogID       *int  `json:"usageLogID,omitempty"`
+	UsageLogIDNEQ    *int  `json:"usageLogIDNEQ,omitempty"`
+	UsageLogIDIn     []int `json:"usageLogIDIn,omitempty"`
+	UsageLogIDNotIn  []int `json:"usageLogIDNotIn,omitempty"`
+	UsageLogIDGT     *int  `json:"usageLogIDGT,omitempty"`
+	UsageLogIDGTE    *int  `json:"usageLogIDGTE,omitempty"`
+	UsageLogIDLT     *int  `json:"usageLogIDLT,omitempty"`
+	UsageLogIDLTE    *int  `json:"usageLogIDLTE,omitempty"`
+	UsageLogIDIsNil  bool  `json:"usageLogIDIsNil,omitempty"`
+	UsageLogIDNotNil bool  `json:"usageLogIDNotNil,omitempty"`
 
-	// "project" edge predicates.
-	HasProject     *bool                `json:"hasProject,omitempty"`
-	HasProjectWith []*ProjectWhereInput `json:"hasProjectWith,omitempty"`
+	// "user_id" field predicates.
+	UserID       *int  `json:"userID,omitempty"`
+	UserIDNEQ    *int  `json:"userIDNEQ,omitempty"`
+	UserIDIn     []int `json:"userIDIn,omitempty"`
+	UserIDNotIn  []int `json:"userIDNotIn,omitempty"`
+	UserIDGT     *int  `json:"userIDGT,omitempty"`
+	UserIDGTE    *int  `json:"userIDGTE,omitempty"`
+	UserIDLT     *int  `json:"userIDLT,omitempty"`
+	UserIDLTE    *int  `json:"userIDLTE,omitempty"`
+	UserIDIsNil  bool  `json:"userIDIsNil,omitempty"`
+	UserIDNotNil bool  `json:"userIDNotNil,omitempty"`
+
+	// "description" field predicates.
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGT           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGTE          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLT           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLTE          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+
+	// "wallet" edge predicates.
+	HasWallet     *bool               `json:"hasWallet,omitempty"`
+	HasWalletWith []*WalletWhereInput `json:"hasWalletWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *UserProjectWhereInput) AddPredicates(predicates ...predicate.UserProject) {
+func (i *WalletTransactionWhereInput) AddPredicates(predicates ...predicate.WalletTransaction) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the UserProjectWhereInput filter on the UserProjectQuery builder.
-func (i *UserProjectWhereInput) Filter(q *UserProjectQuery) (*UserProjectQuery, error) {
+// Filter applies the WalletTransactionWhereInput filter on the WalletTransactionQuery builder.
+func (i *WalletTransactionWhereInput) Filter(q *WalletTransactionQuery) (*WalletTransactionQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyUserProjectWhereInput {
+		if err == ErrEmptyWalletTransactionWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -11550,19 +15921,19 @@ func (i *UserProjectWhereInput) Filter(q *UserProjectQuery) (*UserProjectQuery,
 	return q.Where(p), nil
 }
 
-// ErrEmptyUserProjectWhereInput is returned in case the UserProjectWhereInput is empty.
-var ErrEmptyUserProjectWhereInput = errors.New("ent: empty predicate UserProjectWhereInput")
+// ErrEmptyWalletTransactionWhereInput is returned in case the WalletTransactionWhereInput is empty.
+var ErrEmptyWalletTransactionWhereInput = errors.New("ent: empty predicate WalletTransactionWhereInput")
 
-// P returns a predicate for filtering userprojects.
+// P returns a predicate for filtering wallettransactions.
 // An error is returned if the input is empty or invalid.
-func (i *UserProjectWhereInput) P() (predicate.UserProject, error) {
-	var predicates []predicate.UserProject
+func (i *WalletTransactionWhereInput) P() (predicate.WalletTransaction, error) {
+	var predicates []predicate.WalletTransaction
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, userproject.Not(p))
+		predicates = append(predicates, wallettransaction.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -11572,7 +15943,7 @@ func (i *UserProjectWhereInput) P() (predicate.UserProject, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.UserProject, 0, n)
+		or := make([]predicate.WalletTransaction, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -11580,7 +15951,7 @@ func (i *UserProjectWhereInput) P() (predicate.UserProject, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, userproject.Or(or...))
+		predicates = append(predicates, wallettransaction.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -11590,7 +15961,7 @@ func (i *UserProjectWhereInput) P() (predicate.UserProject, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.UserProject, 0, n)
+		and := make([]predicate.WalletTransaction, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -11598,164 +15969,365 @@ func (i *UserProjectWhereInput) P() (predicate.UserProject, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, userproject.And(and...))
+		predicates = append(predicates, wallettransaction.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, userproject.IDEQ(*i.ID))
+		predicates = append(predicates, wallettransaction.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, userproject.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, wallettransaction.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, userproject.IDIn(i.IDIn...))
+		predicates = append(predicates, wallettransaction.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, userproject.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, wallettransaction.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, userproject.IDGT(*i.IDGT))
+		predicates = append(predicates, wallettransaction.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, userproject.IDGTE(*i.IDGTE))
+		predicates = append(predicates, wallettransaction.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, userproject.IDLT(*i.IDLT))
+		predicates = append(predicates, wallettransaction.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, userproject.IDLTE(*i.IDLTE))
+		predicates = append(predicates, wallettransaction.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, userproject.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, wallettransaction.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, userproject.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, wallettransaction.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, userproject.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, wallettransaction.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, userproject.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, wallettransaction.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, userproject.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, wallettransaction.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, userproject.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, wallettransaction.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, userproject.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, wallettransaction.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, userproject.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, wallettransaction.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, userproject.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, wallettransaction.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, userproject.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, wallettransaction.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, userproject.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, wallettransaction.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, userproject.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, wallettransaction.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, userproject.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, wallettransaction.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, userproject.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, wallettransaction.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, userproject.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, wallettransaction.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, userproject.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, wallettransaction.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.UserID != nil {
-		predicates = append(predicates, userproject.UserIDEQ(*i.UserID))
+	if i.WalletID != nil {
+		predicates = append(predicates, wallettransaction.WalletIDEQ(*i.WalletID))
 	}
-	if i.UserIDNEQ != nil {
-		predicates = append(predicates, userproject.UserIDNEQ(*i.UserIDNEQ))
+	if i.WalletIDNEQ != nil {
+		predicates = append(predicates, wallettransaction.WalletIDNEQ(*i.WalletIDNEQ))
 	}
-	if len(i.UserIDIn) > 0 {
-		predicates = append(predicates, userproject.UserIDIn(i.UserIDIn...))
+	if len(i.WalletIDIn) > 0 {
+		predicates = append(predicates, wallettransaction.WalletIDIn(i.WalletIDIn...))
 	}
-	if len(i.UserIDNotIn) > 0 {
-		predicates = append(predicates, userproject.UserIDNotIn(i.UserIDNotIn...))
+	if len(i.WalletIDNotIn) > 0 {
+		predicates = append(predicates, wallettransaction.WalletIDNotIn(i.WalletIDNotIn...))
+	}
+	if i.APIKeyID != nil {
+		predicates = append(predicates, wallettransaction.APIKeyIDEQ(*i.APIKeyID))
+	}
+	if i.APIKeyIDNEQ != nil {
+		predicates = append(predicates, wallettransaction.APIKeyIDNEQ(*i.APIKeyIDNEQ))
+	}
+	if len(i.APIKeyIDIn) > 0 {
+		predicates = append(predicates, wallettransaction.APIKeyIDIn(i.APIKeyIDIn...))
+	}
+	if len(i.APIKeyIDNotIn) > 0 {
+		predicates = append(predicates, wallettransaction.APIKeyIDNotIn(i.APIKeyIDNotIn...))
+	}
+	if i.APIKeyIDGT != nil {
+		predicates = append(predicates, wallettransaction.APIKeyIDGT(*i.APIKeyIDGT))
+	}
+	if i.APIKeyIDGTE != nil {
+		predicates = append(predicates, wallettransaction.APIKeyIDGTE(*i.APIKeyIDGTE))
+	}
+	if i.APIKeyIDLT != nil {
+		predicates = append(predicates, wallettransaction.APIKeyIDLT(*i.APIKeyIDLT))
+	}
+	if i.APIKeyIDLTE != nil {
+		predicates = append(predicates, wallettransaction.APIKeyIDLTE(*i.APIKeyIDLTE))
 	}
 	if i.ProjectID != nil {
-		predicates = append(predicates, userproject.ProjectIDEQ(*i.ProjectID))
+		predicates = append(predicates, wallettransaction.ProjectIDEQ(*i.ProjectID))
 	}
 	if i.ProjectIDNEQ != nil {
-		predicates = append(predicates, userproject.ProjectIDNEQ(*i.ProjectIDNEQ))
+		predicates = append(predicates, wallettransaction.ProjectIDNEQ(*i.ProjectIDNEQ))
 	}
 	if len(i.ProjectIDIn) > 0 {
-		predicates = append(predicates, userproject.ProjectIDIn(i.ProjectIDIn...))
+		predicates = append(predicates, wallettransaction.ProjectIDIn(i.ProjectIDIn...))
 	}
 	if len(i.ProjectIDNotIn) > 0 {
-		predicates = append(predicates, userproject.ProjectIDNotIn(i.ProjectIDNotIn...))
+		predicates = append(predicates, wallettransaction.ProjectIDNotIn(i.ProjectIDNotIn...))
 	}
-	if i.IsOwner != nil {
-		predicates = append(predicates, userproject.IsOwnerEQ(*i.IsOwner))
+	if i.ProjectIDGT != nil {
+		predicates = append(predicates, wallettransaction.ProjectIDGT(*i.ProjectIDGT))
 	}
-	if i.IsOwnerNEQ != nil {
-		predicates = append(predicates, userproject.IsOwnerNEQ(*i.IsOwnerNEQ))
+	if i.ProjectIDGTE != nil {
+		predicates = append(predicates, wallettransaction.ProjectIDGTE(*i.ProjectIDGTE))
 	}
-
-	if i.HasUser != nil {
-		p := userproject.HasUser()
-		if !*i.HasUser {
-			p = userproject.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.ProjectIDLT != nil {
+		predicates = append(predicates, wallettransaction.ProjectIDLT(*i.ProjectIDLT))
 	}
-	if len(i.HasUserWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasUserWith))
-		for _, w := range i.HasUserWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, userproject.HasUserWith(with...))
+	if i.ProjectIDLTE != nil {
+		predicates = append(predicates, wallettransaction.ProjectIDLTE(*i.ProjectIDLTE))
 	}
-	if i.HasProject != nil {
-		p := userproject.HasProject()
-		if !*i.HasProject {
-			p = userproject.Not(p)
+	if i.Type != nil {
+		predicates = append(predicates, wallettransaction.TypeEQ(*i.Type))
+	}
+	if i.TypeNEQ != nil {
+		predicates = append(predicates, wallettransaction.TypeNEQ(*i.TypeNEQ))
+	}
+	if len(i.TypeIn) > 0 {
+		predicates = append(predicates, wallettransaction.TypeIn(i.TypeIn...))
+	}
+	if len(i.TypeNotIn) > 0 {
+		predicates = append(predicates, wallettransaction.TypeNotIn(i.TypeNotIn...))
+	}
+	if i.Amount != nil {
+		predicates = append(predicates, wallettransaction.AmountEQ(*i.Amount))
+	}
+	if i.AmountNEQ != nil {
+		predicates = append(predicates, wallettransaction.AmountNEQ(*i.AmountNEQ))
+	}
+	if len(i.AmountIn) > 0 {
+		predicates = append(predicates, wallettransaction.AmountIn(i.AmountIn...))
+	}
+	if len(i.AmountNotIn) > 0 {
+		predicates = append(predicates, wallettransaction.AmountNotIn(i.AmountNotIn...))
+	}
+	if i.AmountGT != nil {
+		predicates = append(predicates, wallettransaction.AmountGT(*i.AmountGT))
+	}
+	if i.AmountGTE != nil {
+		predicates = append(predicates, wallettransaction.AmountGTE(*i.AmountGTE))
+	}
+	if i.AmountLT != nil {
+		predicates = append(predicates, wallettransaction.AmountLT(*i.AmountLT))
+	}
+	if i.AmountLTE != nil {
+		predicates = append(predicates, wallettransaction.AmountLTE(*i.AmountLTE))
+	}
+	if i.BalanceAfter != nil {
+		predicates = append(predicates, wallettransaction.BalanceAfterEQ(*i.BalanceAfter))
+	}
+	if i.BalanceAfterNEQ != nil {
+		predicates = append(predicates, wallettransaction.BalanceAfterNEQ(*i.BalanceAfterNEQ))
+	}
+	if len(i.BalanceAfterIn) > 0 {
+		predicates = append(predicates, wallettransaction.BalanceAfterIn(i.BalanceAfterIn...))
+	}
+	if len(i.BalanceAfterNotIn) > 0 {
+		predicates = append(predicates, wallettransaction.BalanceAfterNotIn(i.BalanceAfterNotIn...))
+	}
+	if i.BalanceAfterGT != nil {
+		predicates = append(predicates, wallettransaction.BalanceAfterGT(*i.BalanceAfterGT))
+	}
+	if i.BalanceAfterGTE != nil {
+		predicates = append(predicates, wallettransaction.BalanceAfterGTE(*i.BalanceAfterGTE))
+	}
+	if i.BalanceAfterLT != nil {
+		predicates = append(predicates, wallettransaction.BalanceAfterLT(*i.BalanceAfterLT))
+	}
+	if i.BalanceAfterLTE != nil {
+		predicates = append(predicates, wallettransaction.BalanceAfterLTE(*i.BalanceAfterLTE))
+	}
+	if i.RequestID != nil {
+		predicates = append(predicates, wallettransaction.RequestIDEQ(*i.RequestID))
+	}
+	if i.RequestIDNEQ != nil {
+		predicates = append(predicates, wallettransaction.RequestIDNEQ(*i.RequestIDNEQ))
+	}
+	if len(i.RequestIDIn) > 0 {
+		predicates = append(predicates, wallettransaction.RequestIDIn(i.RequestIDIn...))
+	}
+	if len(i.RequestIDNotIn) > 0 {
+		predicates = append(predicates, wallettransaction.RequestIDNotIn(i.RequestIDNotIn...))
+	}
+	if i.RequestIDGT != nil {
+		predicates = append(predicates, wallettransaction.RequestIDGT(*i.RequestIDGT))
+	}
+	if i.RequestIDGTE != nil {
+		predicates = append(predicates, wallettransaction.RequestIDGTE(*i.RequestIDGTE))
+	}
+	if i.RequestIDLT != nil {
+		predicates = append(predicates, wallettransaction.RequestIDLT(*i.RequestIDLT))
+	}
+	if i.RequestIDLTE != nil {
+		predicates = append(predicates, wallettransaction.RequestIDLTE(*i.RequestIDLTE))
+	}
+	if i.RequestIDIsNil {
+		predicates = append(predicates, wallettransaction.RequestIDIsNil())
+	}
+	if i.RequestIDNotNil {
+		predicates = append(predicates, wallettransaction.RequestIDNotNil())
+	}
+	if i.UsageLogID != nil {
+		predicates = append(predicates, wallettransaction.UsageLogIDEQ(*i.UsageLogID))
+	}
+	if i.UsageLogIDNEQ != nil {
+		predicates = append(predicates, wallettransaction.UsageLogIDNEQ(*i.UsageLogIDNEQ))
+	}
+	if len(i.UsageLogIDIn) > 0 {
+		predicates = append(predicates, wallettransaction.UsageLogIDIn(i.UsageLogIDIn...))
+	}
+	if len(i.UsageLogIDNotIn) > 0 {
+		predicates = append(predicates, wallettransaction.UsageLogIDNotIn(i.UsageLogIDNotIn...))
+	}
+	if i.UsageLogIDGT != nil {
+		predicates = append(predicates, wallettransaction.UsageLogIDGT(*i.UsageLogIDGT))
+	}
+	if i.UsageLogIDGTE != nil {
+		predicates = append(predicates, wallettransaction.UsageLogIDGTE(*i.UsageLogIDGTE))
+	}
+	if i.UsageLogIDLT != nil {
+		predicates = append(predicates, wallettransaction.UsageLogIDLT(*i.UsageLogIDLT))
+	}
+	if i.UsageLogIDLTE != nil {
+		predicates = append(predicates, wallettransaction.UsageLogIDLTE(*i.UsageLogIDLTE))
+	}
+	if i.UsageLogIDIsNil {
+		predicates = append(predicates, wallettransaction.UsageLogIDIsNil())
+	}
+	if i.UsageLogIDNotNil {
+		predicates = append(predicates, wallettransaction.UsageLogIDNotNil())
+	}
+	if i.UserID != nil {
+		predicates = append(predicates, wallettransaction.UserIDEQ(*i.UserID))
+	}
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, wallettransaction.UserIDNEQ(*i.UserIDNEQ))
+	}
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, wallettransaction.UserIDIn(i.UserIDIn...))
+	}
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, wallettransaction.UserIDNotIn(i.UserIDNotIn...))
+	}
+	if i.UserIDGT != nil {
+		predicates = append(predicates, wallettransaction.UserIDGT(*i.UserIDGT))
+	}
+	if i.UserIDGTE != nil {
+		predicates = append(predicates, wallettransaction.UserIDGTE(*i.UserIDGTE))
+	}
+	if i.UserIDLT != nil {
+		predicates = append(predicates, wallettransaction.UserIDLT(*i.UserIDLT))
+	}
+	if i.UserIDLTE != nil {
+		predicates = append(predicates, wallettransaction.UserIDLTE(*i.UserIDLTE))
+	}
+	if i.UserIDIsNil {
+		predicates = append(predicates, wallettransaction.UserIDIsNil())
+	}
+	if i.UserIDNotNil {
+		predicates = append(predicates, wallettransaction.UserIDNotNil())
+	}
+	if i.Description != nil {
+		predicates = append(predicates, wallettransaction.DescriptionEQ(*i.Description))
+	}
+	if i.DescriptionNEQ != nil {
+		predicates = append(predicates, wallettransaction.DescriptionNEQ(*i.DescriptionNEQ))
+	}
+	if len(i.DescriptionIn) > 0 {
+		predicates = append(predicates, wallettransaction.DescriptionIn(i.DescriptionIn...))
+	}
+	if len(i.DescriptionNotIn) > 0 {
+		predicates = append(predicates, wallettransaction.DescriptionNotIn(i.DescriptionNotIn...))
+	}
+	if i.DescriptionGT != nil {
+		predicates = append(predicates, wallettransaction.DescriptionGT(*i.DescriptionGT))
+	}
+	if i.DescriptionGTE != nil {
+		predicates = append(predicates, wallettransaction.DescriptionGTE(*i.DescriptionGTE))
+	}
+	if i.DescriptionLT != nil {
+		predicates = append(predicates, wallettransaction.DescriptionLT(*i.DescriptionLT))
+	}
+	if i.DescriptionLTE != nil {
+		predicates = append(predicates, wallettransaction.DescriptionLTE(*i.DescriptionLTE))
+	}
+	if i.DescriptionContains != nil {
+		predicates = append(predicates, wallettransaction.DescriptionContains(*i.DescriptionContains))
+	}
+	if i.DescriptionHasPrefix != nil {
+		predicates = append(predicates, wallettransaction.DescriptionHasPrefix(*i.DescriptionHasPrefix))
+	}
+	if i.DescriptionHasSuffix != nil {
+		predicates = append(predicates, wallettransaction.DescriptionHasSuffix(*i.DescriptionHasSuffix))
+	}
+	if i.DescriptionEqualFold != nil {
+		predicates = append(predicates, wallettransaction.DescriptionEqualFold(*i.DescriptionEqualFold))
+	}
+	if i.DescriptionContainsFold != nil {
+		predicates = append(predicates, wallettransaction.DescriptionContainsFold(*i.DescriptionContainsFold))
+	}
+
+	if i.HasWallet != nil {
+		p := wallettransaction.HasWallet()
+		if !*i.HasWallet {
+			p = wallettransaction.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasProjectWith) > 0 {
-		with := make([]predicate.Project, 0, len(i.HasProjectWith))
-		for _, w := range i.HasProjectWith {
+	if len(i.HasWalletWith) > 0 {
+		with := make([]predicate.Wallet, 0, len(i.HasWalletWith))
+		for _, w := range i.HasWalletWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasProjectWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasWalletWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, userproject.HasProjectWith(with...))
+		predicates = append(predicates, wallettransaction.HasWalletWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyUserProjectWhereInput
+		return nil, ErrEmptyWalletTransactionWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return userproject.And(predicates...), nil
+		return wallettransaction.And(predicates...), nil
 	}
 }
 
-// UserRoleWhereInput represents a where input for filtering UserRole queries.
-type UserRoleWhereInput struct {
-	Predicates []predicate.UserRole  `json:"-"`
-	Not        *UserRoleWhereInput   `json:"not,omitempty"`
-	Or         []*UserRoleWhereInput `json:"or,omitempty"`
-	And        []*UserRoleWhereInput `json:"and,omitempty"`
+// WebhookDeliveryWhereInput represents a where input for filtering WebhookDelivery queries.
+type WebhookDeliveryWhereInput struct {
+	Predicates []predicate.WebhookDelivery  `json:"-"`
+	Not        *WebhookDeliveryWhereInput   `json:"not,omitempty"`
+	Or         []*WebhookDeliveryWhereInput `json:"or,omitempty"`
+	And        []*WebhookDeliveryWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *int  `json:"id,omitempty"`
@@ -11767,64 +16339,180 @@ type UserRoleWhereInput struct {
 	IDLT    *int  `json:"idLT,omitempty"`
 	IDLTE   *int  `json:"idLTE,omitempty"`
 
-	// "user_id" field predicates.
-	UserID      *int  `json:"userID,omitempty"`
-	UserIDNEQ   *int  `json:"userIDNEQ,omitempty"`
-	UserIDIn    []int `json:"userIDIn,omitempty"`
-	UserIDNotIn []int `json:"userIDNotIn,omitempty"`
-
-	// "role_id" field predicates.
-	RoleID      *int  `json:"roleID,omitempty"`
-	RoleIDNEQ   *int  `json:"roleIDNEQ,omitempty"`
-	RoleIDIn    []int `json:"roleIDIn,omitempty"`
-	RoleIDNotIn []int `json:"roleIDNotIn,omitempty"`
-
 	// "created_at" field predicates.
-	CreatedAt       *time.Time  `json:"createdAt,omitempty"`
-	CreatedAtNEQ    *time.Time  `json:"createdAtNEQ,omitempty"`
-	CreatedAtIn     []time.Time `json:"createdAtIn,omitempty"`
-	CreatedAtNotIn  []time.Time `json:"createdAtNotIn,omitempty"`
-	CreatedAtGT     *time.Time  `json:"createdAtGT,omitempty"`
-	CreatedAtGTE    *time.Time  `json:"createdAtGTE,omitempty"`
-	CreatedAtLT     *time.Time  `json:"createdAtLT,omitempty"`
-	CreatedAtLTE    *time.Time  `json:"createdAtLTE,omitempty"`
-	CreatedAtIsNil  bool        `json:"createdAtIsNil,omitempty"`
-	CreatedAtNotNil bool        `json:"createdAtNotNil,omitempty"`
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
 
 	// "updated_at" field predicates.
-	UpdatedAt       *time.Time  `json:"updatedAt,omitempty"`
-	UpdatedAtNEQ    *time.Time  `json:"updatedAtNEQ,omitempty"`
-	UpdatedAtIn     []time.Time `json:"updatedAtIn,omitempty"`
-	UpdatedAtNotIn  []time.Time `json:"updatedAtNotIn,omitempty"`
-	UpdatedAtGT     *time.Time  `json:"updatedAtGT,omitempty"`
-	UpdatedAtGTE    *time.Time  `json:"updatedAtGTE,omitempty"`
-	UpdatedAtLT     *time.Time  `json:"updatedAtLT,omitempty"`
-	UpdatedAtLTE    *time.Time  `json:"updatedAtLTE,omitempty"`
-	UpdatedAtIsNil  bool        `json:"updatedAtIsNil,omitempty"`
-	UpdatedAtNotNil bool        `json:"updatedAtNotNil,omitempty"`
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
-	// "user" edge predicates.
-	HasUser     *bool             `json:"hasUser,omitempty"`
-	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+	// "event_id" field predicates.
+	EventID             *string  `json:"eventID,omitempty"`
+	EventIDNEQ          *string  `json:"eventIDNEQ,omitempty"`
+	EventIDIn           []string `json:"eventIDIn,omitempty"`
+	EventIDNotIn        []string `json:"eventIDNotIn,omitempty"`
+	EventIDGT           *string  `json:"eventIDGT,omitempty"`
+	EventIDGTE          *string  `json:"eventIDGTE,omitempty"`
+	EventIDLT           *string  `json:"eventIDLT,omitempty"`
+	EventIDLTE          *string  `json:"eventIDLTE,omitempty"`
+	EventIDContains     *string  `json:"eventIDContains,omitempty"`
+	EventIDHasPrefix    *string  `json:"eventIDHasPrefix,omitempty"`
+	EventIDHasSuffix    *string  `json:"eventIDHasSuffix,omitempty"`
+	EventIDEqualFold    *string  `json:"eventIDEqualFold,omitempty"`
+	EventIDContainsFold *string  `json:"eventIDContainsFold,omitempty"`
+
+	// "event" field predicates.
+	Event             *string  `json:"event,omitempty"`
+	EventNEQ          *string  `json:"eventNEQ,omitempty"`
+	EventIn           []string `json:"eventIn,omitempty"`
+	EventNotIn        []string `json:"eventNotIn,omitempty"`
+	EventGT           *string  `json:"eventGT,omitempty"`
+	EventGTE          *string  `json:"eventGTE,omitempty"`
+	EventLT           *string  `json:"eventLT,omitempty"`
+	EventLTE          *string  `json:"eventLTE,omitempty"`
+	EventContains     *string  `json:"eventContains,omitempty"`
+	EventHasPrefix    *string  `json:"eventHasPrefix,omitempty"`
+	EventHasSuffix    *string  `json:"eventHasSuffix,omitempty"`
+	EventEqualFold    *string  `json:"eventEqualFold,omitempty"`
+	EventContainsFold *string  `json:"eventContainsFold,omitempty"`
+
+	// "target_name" field predicates.
+	TargetName             *string  `json:"targetName,omitempty"`
+	TargetNameNEQ          *string  `json:"targetNameNEQ,omitempty"`
+	TargetNameIn           []string `json:"targetNameIn,omitempty"`
+	TargetNameNotIn        []string `json:"targetNameNotIn,omitempty"`
+	TargetNameGT           *string  `json:"targetNameGT,omitempty"`
+	TargetNameGTE          *string  `json:"targetNameGTE,omitempty"`
+	TargetNameLT           *string  `json:"targetNameLT,omitempty"`
+	TargetNameLTE          *string  `json:"targetNameLTE,omitempty"`
+	TargetNameContains     *string  `json:"targetNameContains,omitempty"`
+	TargetNameHasPrefix    *string  `json:"targetNameHasPrefix,omitempty"`
+	TargetNameHasSuffix    *string  `json:"targetNameHasSuffix,omitempty"`
+	TargetNameEqualFold    *string  `json:"targetNameEqualFold,omitempty"`
+	TargetNameContainsFold *string  `json:"targetNameContainsFold,omitempty"`
+
+	// "url" field predicates.
+	URL             *string  `json:"url,omitempty"`
+	URLNEQ          *string  `json:"urlNEQ,omitempty"`
+	URLIn           []string `json:"urlIn,omitempty"`
+	URLNotIn        []string `json:"urlNotIn,omitempty"`
+	URLGT           *string  `json:"urlGT,omitempty"`
+	URLGTE          *string  `json:"urlGTE,omitempty"`
+	URLLT           *string  `json:"urlLT,omitempty"`
+	URLLTE          *string  `json:"urlLTE,omitempty"`
+	URLContains     *string  `json:"urlContains,omitempty"`
+	URLHasPrefix    *string  `json:"urlHasPrefix,omitempty"`
+	URLHasSuffix    *string  `json:"urlHasSuffix,omitempty"`
+	URLEqualFold    *string  `json:"urlEqualFold,omitempty"`
+	URLContainsFold *string  `json:"urlContainsFold,omitempty"`
+
+	// "payload" field predicates.
+	Payload             *string  `json:"payload,omitempty"`
+	PayloadNEQ          *string  `json:"payloadNEQ,omitempty"`
+	PayloadIn           []string `json:"payloadIn,omitempty"`
+	PayloadNotIn        []string `json:"payloadNotIn,omitempty"`
+	PayloadGT           *string  `json:"payloadGT,omitempty"`
+	PayloadGTE          *string  `json:"payloadGTE,omitempty"`
+	PayloadLT           *string  `json:"payloadLT,omitempty"`
+	PayloadLTE          *string  `json:"payloadLTE,omitempty"`
+	PayloadContains     *string  `json:"payloadContains,omitempty"`
+	PayloadHasPrefix    *string  `json:"payloadHasPrefix,omitempty"`
+	PayloadHasSuffix    *string  `json:"payloadHasSuffix,omitempty"`
+	PayloadEqualFold    *string  `json:"payloadEqualFold,omitempty"`
+	PayloadContainsFold *string  `json:"payloadContainsFold,omitempty"`
 
-	// "role" edge predicates.
-	HasRole     *bool             `json:"hasRole,omitempty"`
-	HasRoleWith []*RoleWhereInput `json:"hasRoleWith,omitempty"`
+	// "status" field predicates.
+	Status      *webhookdelivery.Status  `json:"status,omitempty"`
+	StatusNEQ   *webhookdelivery.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []webhookdelivery.Status `json:"statusIn,omitempty"`
+	StatusNotIn []webhookdelivery.Status `json:"statusNotIn,omitempty"`
+
+	// "attempts" field predicates.
+	Attempts      *int  `json:"attempts,omitempty"`
+	AttemptsNEQ   *int  `json:"attemptsNEQ,omitempty"`
+	AttemptsIn    []int `json:"attemptsIn,omitempty"`
+	AttemptsNotIn []int `json:"attemptsNotIn,omitempty"`
+	AttemptsGT    *int  `json:"attemptsGT,omitempty"`
+	AttemptsGTE   *int  `json:"attemptsGTE,omitempty"`
+	AttemptsLT    *int  `json:"attemptsLT,omitempty"`
+	AttemptsLTE   *int  `json:"attemptsLTE,omitempty"`
+
+	// "next_attempt_at" field predicates.
+	NextAttemptAt      *time.Time  `json:"nextAttemptAt,omitempty"`
+	NextAttemptAtNEQ   *time.Time  `json:"nextAttemptAtNEQ,omitempty"`
+	NextAttemptAtIn    []time.Time `json:"nextAttemptAtIn,omitempty"`
+	NextAttemptAtNotIn []time.Time `json:"nextAttemptAtNotIn,omitempty"`
+	NextAttemptAtGT    *time.Time  `json:"nextAttemptAtGT,omitempty"`
+	NextAttemptAtGTE   *time.Time  `json:"nextAttemptAtGTE,omitempty"`
+	NextAttemptAtLT    *time.Time  `json:"nextAttemptAtLT,omitempty"`
+	NextAttemptAtLTE   *time.Time  `json:"nextAttemptAtLTE,omitempty"`
+
+	// "last_error" field predicates.
+	LastError             *string  `json:"lastError,omitempty"`
+	LastErrorNEQ          *string  `json:"lastErrorNEQ,omitempty"`
+	LastErrorIn           []string `json:"lastErrorIn,omitempty"`
+	LastErrorNotIn        []string `json:"lastErrorNotIn,omitempty"`
+	LastErrorGT           *string  `json:"lastErrorGT,omitempty"`
+	LastErrorGTE          *string  `json:"lastErrorGTE,omitempty"`
+	LastErrorLT           *string  `json:"lastErrorLT,omitempty"`
+	LastErrorLTE          *string  `json:"lastErrorLTE,omitempty"`
+	LastErrorContains     *string  `json:"lastErrorContains,omitempty"`
+	LastErrorHasPrefix    *string  `json:"lastErrorHasPrefix,omitempty"`
+	LastErrorHasSuffix    *string  `json:"lastErrorHasSuffix,omitempty"`
+	LastErrorEqualFold    *string  `json:"lastErrorEqualFold,omitempty"`
+	LastErrorContainsFold *string  `json:"lastErrorContainsFold,omitempty"`
+
+	// "response_status" field predicates.
+	ResponseStatus       *int  `json:"responseStatus,omitempty"`
+	ResponseStatusNEQ    *int  `json:"responseStatusNEQ,omitempty"`
+	ResponseStatusIn     []int `json:"responseStatusIn,omitempty"`
+	ResponseStatusNotIn  []int `json:"responseStatusNotIn,omitempty"`
+	ResponseStatusGT     *int  `json:"responseStatusGT,omitempty"`
+	ResponseStatusGTE    *int  `json:"responseStatusGTE,omitempty"`
+	ResponseStatusLT     *int  `json:"responseStatusLT,omitempty"`
+	ResponseStatusLTE    *int  `json:"responseStatusLTE,omitempty"`
+	ResponseStatusIsNil  bool  `json:"responseStatusIsNil,omitempty"`
+	ResponseStatusNotNil bool  `json:"responseStatusNotNil,omitempty"`
+
+	// "delivered_at" field predicates.
+	DeliveredAt       *time.Time  `json:"deliveredAt,omitempty"`
+	DeliveredAtNEQ    *time.Time  `json:"deliveredAtNEQ,omitempty"`
+	DeliveredAtIn     []time.Time `json:"deliveredAtIn,omitempty"`
+	DeliveredAtNotIn  []time.Time `json:"deliveredAtNotIn,omitempty"`
+	DeliveredAtGT     *time.Time  `json:"deliveredAtGT,omitempty"`
+	DeliveredAtGTE    *time.Time  `json:"deliveredAtGTE,omitempty"`
+	DeliveredAtLT     *time.Time  `json:"deliveredAtLT,omitempty"`
+	DeliveredAtLTE    *time.Time  `json:"deliveredAtLTE,omitempty"`
+	DeliveredAtIsNil  bool        `json:"deliveredAtIsNil,omitempty"`
+	DeliveredAtNotNil bool        `json:"deliveredAtNotNil,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *UserRoleWhereInput) AddPredicates(predicates ...predicate.UserRole) {
+func (i *WebhookDeliveryWhereInput) AddPredicates(predicates ...predicate.WebhookDelivery) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the UserRoleWhereInput filter on the UserRoleQuery builder.
-func (i *UserRoleWhereInput) Filter(q *UserRoleQuery) (*UserRoleQuery, error) {
+// Filter applies the WebhookDeliveryWhereInput filter on the WebhookDeliveryQuery builder.
+func (i *WebhookDeliveryWhereInput) Filter(q *WebhookDeliveryQuery) (*WebhookDeliveryQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyUserRoleWhereInput {
+		if err == ErrEmptyWebhookDeliveryWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -11832,19 +16520,19 @@ func (i *UserRoleWhereInput) Filter(q *UserRoleQuery) (*UserRoleQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyUserRoleWhereInput is returned in case the UserRoleWhereInput is empty.
-var ErrEmptyUserRoleWhereInput = errors.New("ent: empty predicate UserRoleWhereInput")
+// ErrEmptyWebhookDeliveryWhereInput is returned in case the WebhookDeliveryWhereInput is empty.
+var ErrEmptyWebhookDeliveryWhereInput = errors.New("ent: empty predicate WebhookDeliveryWhereInput")
 
-// P returns a predicate for filtering userroles.
+// P returns a predicate for filtering webhookdeliveries.
 // An error is returned if the input is empty or invalid.
-func (i *UserRoleWhereInput) P() (predicate.UserRole, error) {
-	var predicates []predicate.UserRole
+func (i *WebhookDeliveryWhereInput) P() (predicate.WebhookDelivery, error) {
+	var predicates []predicate.WebhookDelivery
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, userrole.Not(p))
+		predicates = append(predicates, webhookdelivery.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -11854,7 +16542,7 @@ func (i *UserRoleWhereInput) P() (predicate.UserRole, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.UserRole, 0, n)
+		or := make([]predicate.WebhookDelivery, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -11862,7 +16550,7 @@ func (i *UserRoleWhereInput) P() (predicate.UserRole, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, userrole.Or(or...))
+		predicates = append(predicates, webhookdelivery.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -11872,7 +16560,7 @@ func (i *UserRoleWhereInput) P() (predicate.UserRole, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.UserRole, 0, n)
+		and := make([]predicate.WebhookDelivery, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -11880,160 +16568,442 @@ func (i *UserRoleWhereInput) P() (predicate.UserRole, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, userrole.And(and...))
+		predicates = append(predicates, webhookdelivery.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, userrole.IDEQ(*i.ID))
+		predicates = append(predicates, webhookdelivery.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, userrole.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, webhookdelivery.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, userrole.IDIn(i.IDIn...))
+		predicates = append(predicates, webhookdelivery.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, userrole.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, webhookdelivery.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, userrole.IDGT(*i.IDGT))
+		predicates = append(predicates, webhookdelivery.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, userrole.IDGTE(*i.IDGTE))
+		predicates = append(predicates, webhookdelivery.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, userrole.IDLT(*i.IDLT))
+		predicates = append(predicates, webhookdelivery.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, userrole.IDLTE(*i.IDLTE))
-	}
-	if i.UserID != nil {
-		predicates = append(predicates, userrole.UserIDEQ(*i.UserID))
-	}
-	if i.UserIDNEQ != nil {
-		predicates = append(predicates, userrole.UserIDNEQ(*i.UserIDNEQ))
-	}
-	if len(i.UserIDIn) > 0 {
-		predicates = append(predicates, userrole.UserIDIn(i.UserIDIn...))
-	}
-	if len(i.UserIDNotIn) > 0 {
-		predicates = append(predicates, userrole.UserIDNotIn(i.UserIDNotIn...))
-	}
-	if i.RoleID != nil {
-		predicates = append(predicates, userrole.RoleIDEQ(*i.RoleID))
-	}
-	if i.RoleIDNEQ != nil {
-		predicates = append(predicates, userrole.RoleIDNEQ(*i.RoleIDNEQ))
-	}
-	if len(i.RoleIDIn) > 0 {
-		predicates = append(predicates, userrole.RoleIDIn(i.RoleIDIn...))
-	}
-	if len(i.RoleIDNotIn) > 0 {
-		predicates = append(predicates, userrole.RoleIDNotIn(i.RoleIDNotIn...))
+		predicates = append(predicates, webhookdelivery.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, userrole.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, webhookdelivery.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, userrole.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, webhookdelivery.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, userrole.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, webhookdelivery.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, userrole.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, webhookdelivery.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, userrole.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, webhookdelivery.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, userrole.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, webhookdelivery.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, userrole.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, webhookdelivery.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, userrole.CreatedAtLTE(*i.CreatedAtLTE))
-	}
-	if i.CreatedAtIsNil {
-		predicates = append(predicates, userrole.CreatedAtIsNil())
-	}
-	if i.CreatedAtNotNil {
-		predicates = append(predicates, userrole.CreatedAtNotNil())
+		predicates = append(predicates, webhookdelivery.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, userrole.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, webhookdelivery.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, userrole.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, webhookdelivery.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, userrole.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, webhookdelivery.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, userrole.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, webhookdelivery.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, userrole.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, webhookdelivery.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, userrole.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, webhookdelivery.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, userrole.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, webhookdelivery.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, userrole.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, webhookdelivery.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.UpdatedAtIsNil {
-		predicates = append(predicates, userrole.UpdatedAtIsNil())
+	if i.EventID != nil {
+		predicates = append(predicates, webhookdelivery.EventIDEQ(*i.EventID))
 	}
-	if i.UpdatedAtNotNil {
-		predicates = append(predicates, userrole.UpdatedAtNotNil())
+	if i.EventIDNEQ != nil {
+		predicates = append(predicates, webhookdelivery.EventIDNEQ(*i.EventIDNEQ))
 	}
-
-	if i.HasUser != nil {
-		p := userrole.HasUser()
-		if !*i.HasUser {
-			p = userrole.Not(p)
-		}
-		predicates = append(predicates, p)
+	if len(i.EventIDIn) > 0 {
+		predicates = append(predicates, webhookdelivery.EventIDIn(i.EventIDIn...))
 	}
-	if len(i.HasUserWith) > 0 {
-		with := make([]predicate.User, 0, len(i.HasUserWith))
-		for _, w := range i.HasUserWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, userrole.HasUserWith(with...))
+	if len(i.EventIDNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.EventIDNotIn(i.EventIDNotIn...))
 	}
-	if i.HasRole != nil {
-		p := userrole.HasRole()
-		if !*i.HasRole {
-			p = userrole.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.EventIDGT != nil {
+		predicates = append(predicates, webhookdelivery.EventIDGT(*i.EventIDGT))
 	}
-	if len(i.HasRoleWith) > 0 {
-		with := make([]predicate.Role, 0, len(i.HasRoleWith))
-		for _, w := range i.HasRoleWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasRoleWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, userrole.HasRoleWith(with...))
+	if i.EventIDGTE != nil {
+		predicates = append(predicates, webhookdelivery.EventIDGTE(*i.EventIDGTE))
+	}
+	if i.EventIDLT != nil {
+		predicates = append(predicates, webhookdelivery.EventIDLT(*i.EventIDLT))
+	}
+	if i.EventIDLTE != nil {
+		predicates = append(predicates, webhookdelivery.EventIDLTE(*i.EventIDLTE))
+	}
+	if i.EventIDContains != nil {
+		predicates = append(predicates, webhookdelivery.EventIDContains(*i.EventIDContains))
+	}
+	if i.EventIDHasPrefix != nil {
+		predicates = append(predicates, webhookdelivery.EventIDHasPrefix(*i.EventIDHasPrefix))
+	}
+	if i.EventIDHasSuffix != nil {
+		predicates = append(predicates, webhookdelivery.EventIDHasSuffix(*i.EventIDHasSuffix))
+	}
+	if i.EventIDEqualFold != nil {
+		predicates = append(predicates, webhookdelivery.EventIDEqualFold(*i.EventIDEqualFold))
+	}
+	if i.EventIDContainsFold != nil {
+		predicates = append(predicates, webhookdelivery.EventIDContainsFold(*i.EventIDContainsFold))
+	}
+	if i.Event != nil {
+		predicates = append(predicates, webhookdelivery.EventEQ(*i.Event))
+	}
+	if i.EventNEQ != nil {
+		predicates = append(predicates, webhookdelivery.EventNEQ(*i.EventNEQ))
+	}
+	if len(i.EventIn) > 0 {
+		predicates = append(predicates, webhookdelivery.EventIn(i.EventIn...))
+	}
+	if len(i.EventNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.EventNotIn(i.EventNotIn...))
+	}
+	if i.EventGT != nil {
+		predicates = append(predicates, webhookdelivery.EventGT(*i.EventGT))
+	}
+	if i.EventGTE != nil {
+		predicates = append(predicates, webhookdelivery.EventGTE(*i.EventGTE))
+	}
+	if i.EventLT != nil {
+		predicates = append(predicates, webhookdelivery.EventLT(*i.EventLT))
+	}
+	if i.EventLTE != nil {
+		predicates = append(predicates, webhookdelivery.EventLTE(*i.EventLTE))
+	}
+	if i.EventContains != nil {
+		predicates = append(predicates, webhookdelivery.EventContains(*i.EventContains))
+	}
+	if i.EventHasPrefix != nil {
+		predicates = append(predicates, webhookdelivery.EventHasPrefix(*i.EventHasPrefix))
+	}
+	if i.EventHasSuffix != nil {
+		predicates = append(predicates, webhookdelivery.EventHasSuffix(*i.EventHasSuffix))
+	}
+	if i.EventEqualFold != nil {
+		predicates = append(predicates, webhookdelivery.EventEqualFold(*i.EventEqualFold))
+	}
+	if i.EventContainsFold != nil {
+		predicates = append(predicates, webhookdelivery.EventContainsFold(*i.EventContainsFold))
+	}
+	if i.TargetName != nil {
+		predicates = append(predicates, webhookdelivery.TargetNameEQ(*i.TargetName))
+	}
+	if i.TargetNameNEQ != nil {
+		predicates = append(predicates, webhookdelivery.TargetNameNEQ(*i.TargetNameNEQ))
+	}
+	if len(i.TargetNameIn) > 0 {
+		predicates = append(predicates, webhookdelivery.TargetNameIn(i.TargetNameIn...))
+	}
+	if len(i.TargetNameNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.TargetNameNotIn(i.TargetNameNotIn...))
+	}
+	if i.TargetNameGT != nil {
+		predicates = append(predicates, webhookdelivery.TargetNameGT(*i.TargetNameGT))
+	}
+	if i.TargetNameGTE != nil {
+		predicates = append(predicates, webhookdelivery.TargetNameGTE(*i.TargetNameGTE))
+	}
+	if i.TargetNameLT != nil {
+		predicates = append(predicates, webhookdelivery.TargetNameLT(*i.TargetNameLT))
+	}
+	if i.TargetNameLTE != nil {
+		predicates = append(predicates, webhookdelivery.TargetNameLTE(*i.TargetNameLTE))
+	}
+	if i.TargetNameContains != nil {
+		predicates = append(predicates, webhookdelivery.TargetNameContains(*i.TargetNameContains))
+	}
+	if i.TargetNameHasPrefix != nil {
+		predicates = append(predicates, webhookdelivery.TargetNameHasPrefix(*i.TargetNameHasPrefix))
+	}
+	if i.TargetNameHasSuffix != nil {
+		predicates = append(predicates, webhookdelivery.TargetNameHasSuffix(*i.TargetNameHasSuffix))
+	}
+	if i.TargetNameEqualFold != nil {
+		predicates = append(predicates, webhookdelivery.TargetNameEqualFold(*i.TargetNameEqualFold))
+	}
+	if i.TargetNameContainsFold != nil {
+		predicates = append(predicates, webhookdelivery.TargetNameContainsFold(*i.TargetNameContainsFold))
+	}
+	if i.URL != nil {
+		predicates = append(predicates, webhookdelivery.URLEQ(*i.URL))
+	}
+	if i.URLNEQ != nil {
+		predicates = append(predicates, webhookdelivery.URLNEQ(*i.URLNEQ))
+	}
+	if len(i.URLIn) > 0 {
+		predicates = append(predicates, webhookdelivery.URLIn(i.URLIn...))
+	}
+	if len(i.URLNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.URLNotIn(i.URLNotIn...))
+	}
+	if i.URLGT != nil {
+		predicates = append(predicates, webhookdelivery.URLGT(*i.URLGT))
+	}
+	if i.URLGTE != nil {
+		predicates = append(predicates, webhookdelivery.URLGTE(*i.URLGTE))
+	}
+	if i.URLLT != nil {
+		predicates = append(predicates, webhookdelivery.URLLT(*i.URLLT))
+	}
+	if i.URLLTE != nil {
+		predicates = append(predicates, webhookdelivery.URLLTE(*i.URLLTE))
+	}
+	if i.URLContains != nil {
+		predicates = append(predicates, webhookdelivery.URLContains(*i.URLContains))
+	}
+	if i.URLHasPrefix != nil {
+		predicates = append(predicates, webhookdelivery.URLHasPrefix(*i.URLHasPrefix))
+	}
+	if i.URLHasSuffix != nil {
+		predicates = append(predicates, webhookdelivery.URLHasSuffix(*i.URLHasSuffix))
+	}
+	if i.URLEqualFold != nil {
+		predicates = append(predicates, webhookdelivery.URLEqualFold(*i.URLEqualFold))
+	}
+	if i.URLContainsFold != nil {
+		predicates = append(predicates, webhookdelivery.URLContainsFold(*i.URLContainsFold))
+	}
+	if i.Payload != nil {
+		predicates = append(predicates, webhookdelivery.PayloadEQ(*i.Payload))
+	}
+	if i.PayloadNEQ != nil {
+		predicates = append(predicates, webhookdelivery.PayloadNEQ(*i.PayloadNEQ))
+	}
+	if len(i.PayloadIn) > 0 {
+		predicates = append(predicates, webhookdelivery.PayloadIn(i.PayloadIn...))
+	}
+	if len(i.PayloadNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.PayloadNotIn(i.PayloadNotIn...))
+	}
+	if i.PayloadGT != nil {
+		predicates = append(predicates, webhookdelivery.PayloadGT(*i.PayloadGT))
+	}
+	if i.PayloadGTE != nil {
+		predicates = append(predicates, webhookdelivery.PayloadGTE(*i.PayloadGTE))
+	}
+	if i.PayloadLT != nil {
+		predicates = append(predicates, webhookdelivery.PayloadLT(*i.PayloadLT))
+	}
+	if i.PayloadLTE != nil {
+		predicates = append(predicates, webhookdelivery.PayloadLTE(*i.PayloadLTE))
+	}
+	if i.PayloadContains != nil {
+		predicates = append(predicates, webhookdelivery.PayloadContains(*i.PayloadContains))
 	}
+	if i.PayloadHasPrefix != nil {
+		predicates = append(predicates, webhookdelivery.PayloadHasPrefix(*i.PayloadHasPrefix))
+	}
+	if i.PayloadHasSuffix != nil {
+		predicates = append(predicates, webhookdelivery.PayloadHasSuffix(*i.PayloadHasSuffix))
+	}
+	if i.PayloadEqualFold != nil {
+		predicates = append(predicates, webhookdelivery.PayloadEqualFold(*i.PayloadEqualFold))
+	}
+	if i.PayloadContainsFold != nil {
+		predicates = append(predicates, webhookdelivery.PayloadContainsFold(*i.PayloadContainsFold))
+	}
+	if i.Status != nil {
+		predicates = append(predicates, webhookdelivery.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, webhookdelivery.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, webhookdelivery.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.StatusNotIn(i.StatusNotIn...))
+	}
+	if i.Attempts != nil {
+		predicates = append(predicates, webhookdelivery.AttemptsEQ(*i.Attempts))
+	}
+	if i.AttemptsNEQ != nil {
+		predicates = append(predicates, webhookdelivery.AttemptsNEQ(*i.AttemptsNEQ))
+	}
+	if len(i.AttemptsIn) > 0 {
+		predicates = append(predicates, webhookdelivery.AttemptsIn(i.AttemptsIn...))
+	}
+	if len(i.AttemptsNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.AttemptsNotIn(i.AttemptsNotIn...))
+	}
+	if i.AttemptsGT != nil {
+		predicates = append(predicates, webhookdelivery.AttemptsGT(*i.AttemptsGT))
+	}
+	if i.AttemptsGTE != nil {
+		predicates = append(predicates, webhookdelivery.AttemptsGTE(*i.AttemptsGTE))
+	}
+	if i.AttemptsLT != nil {
+		predicates = append(predicates, webhookdelivery.AttemptsLT(*i.AttemptsLT))
+	}
+	if i.AttemptsLTE != nil {
+		predicates = append(predicates, webhookdelivery.AttemptsLTE(*i.AttemptsLTE))
+	}
+	if i.NextAttemptAt != nil {
+		predicates = append(predicates, webhookdelivery.NextAttemptAtEQ(*i.NextAttemptAt))
+	}
+	if i.NextAttemptAtNEQ != nil {
+		predicates = append(predicates, webhookdelivery.NextAttemptAtNEQ(*i.NextAttemptAtNEQ))
+	}
+	if len(i.NextAttemptAtIn) > 0 {
+		predicates = append(predicates, webhookdelivery.NextAttemptAtIn(i.NextAttemptAtIn...))
+	}
+	if len(i.NextAttemptAtNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.NextAttemptAtNotIn(i.NextAttemptAtNotIn...))
+	}
+	if i.NextAttemptAtGT != nil {
+		predicates = append(predicates, webhookdelivery.NextAttemptAtGT(*i.NextAttemptAtGT))
+	}
+	if i.NextAttemptAtGTE != nil {
+		predicates = append(predicates, webhookdelivery.NextAttemptAtGTE(*i.NextAttemptAtGTE))
+	}
+	if i.NextAttemptAtLT != nil {
+		predicates = append(predicates, webhookdelivery.NextAttemptAtLT(*i.NextAttemptAtLT))
+	}
+	if i.NextAttemptAtLTE != nil {
+		predicates = append(predicates, webhookdelivery.NextAttemptAtLTE(*i.NextAttemptAtLTE))
+	}
+	if i.LastError != nil {
+		predicates = append(predicates, webhookdelivery.LastErrorEQ(*i.LastError))
+	}
+	if i.LastErrorNEQ != nil {
+		predicates = append(predicates, webhookdelivery.LastErrorNEQ(*i.LastErrorNEQ))
+	}
+	if len(i.LastErrorIn) > 0 {
+		predicates = append(predicates, webhookdelivery.LastErrorIn(i.LastErrorIn...))
+	}
+	if len(i.LastErrorNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.LastErrorNotIn(i.LastErrorNotIn...))
+	}
+	if i.LastErrorGT != nil {
+		predicates = append(predicates, webhookdelivery.LastErrorGT(*i.LastErrorGT))
+	}
+	if i.LastErrorGTE != nil {
+		predicates = append(predicates, webhookdelivery.LastErrorGTE(*i.LastErrorGTE))
+	}
+	if i.LastErrorLT != nil {
+		predicates = append(predicates, webhookdelivery.LastErrorLT(*i.LastErrorLT))
+	}
+	if i.LastErrorLTE != nil {
+		predicates = append(predicates, webhookdelivery.LastErrorLTE(*i.LastErrorLTE))
+	}
+	if i.LastErrorContains != nil {
+		predicates = append(predicates, webhookdelivery.LastErrorContains(*i.LastErrorContains))
+	}
+	if i.LastErrorHasPrefix != nil {
+		predicates = append(predicates, webhookdelivery.LastErrorHasPrefix(*i.LastErrorHasPrefix))
+	}
+	if i.LastErrorHasSuffix != nil {
+		predicates = append(predicates, webhookdelivery.LastErrorHasSuffix(*i.LastErrorHasSuffix))
+	}
+	if i.LastErrorEqualFold != nil {
+		predicates = append(predicates, webhookdelivery.LastErrorEqualFold(*i.LastErrorEqualFold))
+	}
+	if i.LastErrorContainsFold != nil {
+		predicates = append(predicates, webhookdelivery.LastErrorContainsFold(*i.LastErrorContainsFold))
+	}
+	if i.ResponseStatus != nil {
+		predicates = append(predicates, webhookdelivery.ResponseStatusEQ(*i.ResponseStatus))
+	}
+	if i.ResponseStatusNEQ != nil {
+		predicates = append(predicates, webhookdelivery.ResponseStatusNEQ(*i.ResponseStatusNEQ))
+	}
+	if len(i.ResponseStatusIn) > 0 {
+		predicates = append(predicates, webhookdelivery.ResponseStatusIn(i.ResponseStatusIn...))
+	}
+	if len(i.ResponseStatusNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.ResponseStatusNotIn(i.ResponseStatusNotIn...))
+	}
+	if i.ResponseStatusGT != nil {
+		predicates = append(predicates, webhookdelivery.ResponseStatusGT(*i.ResponseStatusGT))
+	}
+	if i.ResponseStatusGTE != nil {
+		predicates = append(predicates, webhookdelivery.ResponseStatusGTE(*i.ResponseStatusGTE))
+	}
+	if i.ResponseStatusLT != nil {
+		predicates = append(predicates, webhookdelivery.ResponseStatusLT(*i.ResponseStatusLT))
+	}
+	if i.ResponseStatusLTE != nil {
+		predicates = append(predicates, webhookdelivery.ResponseStatusLTE(*i.ResponseStatusLTE))
+	}
+	if i.ResponseStatusIsNil {
+		predicates = append(predicates, webhookdelivery.ResponseStatusIsNil())
+	}
+	if i.ResponseStatusNotNil {
+		predicates = append(predicates, webhookdelivery.ResponseStatusNotNil())
+	}
+	if i.DeliveredAt != nil {
+		predicates = append(predicates, webhookdelivery.DeliveredAtEQ(*i.DeliveredAt))
+	}
+	if i.DeliveredAtNEQ != nil {
+		predicates = append(predicates, webhookdelivery.DeliveredAtNEQ(*i.DeliveredAtNEQ))
+	}
+	if len(i.DeliveredAtIn) > 0 {
+		predicates = append(predicates, webhookdelivery.DeliveredAtIn(i.DeliveredAtIn...))
+	}
+	if len(i.DeliveredAtNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.DeliveredAtNotIn(i.DeliveredAtNotIn...))
+	}
+	if i.DeliveredAtGT != nil {
+		predicates = append(predicates, webhookdelivery.DeliveredAtGT(*i.DeliveredAtGT))
+	}
+	if i.DeliveredAtGTE != nil {
+		predicates = append(predicates, webhookdelivery.DeliveredAtGTE(*i.DeliveredAtGTE))
+	}
+	if i.DeliveredAtLT != nil {
+		predicates = append(predicates, webhookdelivery.DeliveredAtLT(*i.DeliveredAtLT))
+	}
+	if i.DeliveredAtLTE != nil {
+		predicates = append(predicates, webhookdelivery.DeliveredAtLTE(*i.DeliveredAtLTE))
+	}
+	if i.DeliveredAtIsNil {
+		predicates = append(predicates, webhookdelivery.DeliveredAtIsNil())
+	}
+	if i.DeliveredAtNotNil {
+		predicates = append(predicates, webhookdelivery.DeliveredAtNotNil())
+	}
+
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyUserRoleWhereInput
+		return nil, ErrEmptyWebhookDeliveryWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return userrole.And(predicates...), nil
+		return webhookdelivery.And(predicates...), nil
 	}
 }
@@ -21,6 +21,7 @@ var (
 		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
 		{Name: "profiles", Type: field.TypeJSON, Nullable: true},
 		{Name: "allowed_ips", Type: field.TypeJSON, Nullable: true},
+		{Name: "token_revocations", Type: field.TypeJSON, Nullable: true},
 		{Name: "project_id", Type: field.TypeInt, Default: 1},
 		{Name: "user_id", Type: field.TypeInt, Nullable: true},
 	}
@@ -32,13 +33,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "api_keys_projects_api_keys",
-				Columns:    []*schema.Column{APIKeysColumns[11]},
+				Columns:    []*schema.Column{APIKeysColumns[12]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "api_keys_users_api_keys",
-				Columns:    []*schema.Column{APIKeysColumns[12]},
+				Columns:    []*schema.Column{APIKeysColumns[13]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -47,12 +48,12 @@ var (
 			{
 				Name:    "api_keys_by_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{APIKeysColumns[12]},
+				Columns: []*schema.Column{APIKeysColumns[13]},
 			},
 			{
 				Name:    "api_keys_by_project_id",
 				Unique:  false,
-				Columns: []*schema.Column{APIKeysColumns[11]},
+				Columns: []*schema.Column{APIKeysColumns[12]},
 			},
 			{
 				Name:    "api_keys_by_key",
@@ -93,6 +94,96 @@ var (
 			},
 		},
 	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "actor_type", Type: field.TypeEnum, Enums: []string{"user", "api_key", "system"}},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "api_key_id", Type: field.TypeInt, Nullable: true},
+		{Name: "actor", Type: field.TypeString, Default: ""},
+		{Name: "project_id", Type: field.TypeInt, Nullable: true},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"graphql", "rest"}},
+		{Name: "operation", Type: field.TypeString},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"create", "update", "delete", "execute"}},
+		{Name: "entity_type", Type: field.TypeString, Default: ""},
+		{Name: "entity_id", Type: field.TypeInt, Nullable: true},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "source_ip", Type: field.TypeString, Default: ""},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "audit_logs_by_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "audit_logs_by_entity",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[10], AuditLogsColumns[11]},
+			},
+			{
+				Name:    "audit_logs_by_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[3], AuditLogsColumns[1]},
+			},
+			{
+				Name:    "audit_logs_by_project_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[6], AuditLogsColumns[1]},
+			},
+		},
+	}
+	// BudgetsColumns holds the columns for the "budgets" table.
+	BudgetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "deleted_at", Type: field.TypeInt, Default: 0},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"project", "api_key", "user"}, Default: "project"},
+		{Name: "api_key_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "period", Type: field.TypeEnum, Enums: []string{"daily", "monthly"}, Default: "monthly"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"enabled", "disabled"}, Default: "enabled"},
+		{Name: "settings", Type: field.TypeJSON},
+		{Name: "alerted_threshold", Type: field.TypeInt, Default: 0},
+		{Name: "alerted_period_start", Type: field.TypeTime, Nullable: true},
+		{Name: "project_id", Type: field.TypeInt},
+	}
+	// BudgetsTable holds the schema information for the "budgets" table.
+	BudgetsTable = &schema.Table{
+		Name:       "budgets",
+		Columns:    BudgetsColumns,
+		PrimaryKey: []*schema.Column{BudgetsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "budgets_projects_budgets",
+				Columns:    []*schema.Column{BudgetsColumns[15]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "budgets_by_project_id",
+				Unique:  false,
+				Columns: []*schema.Column{BudgetsColumns[15]},
+			},
+			{
+				Name:    "budgets_by_project_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{BudgetsColumns[15], BudgetsColumns[4], BudgetsColumns[3]},
+			},
+		},
+	}
 	// ChannelsColumns holds the columns for the "channels" table.
 	ChannelsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -257,6 +348,80 @@ var (
 			},
 		},
 	}
+	// ContentBlobsColumns holds the columns for the "content_blobs" table.
+	ContentBlobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "hash", Type: field.TypeString, Size: 64},
+		{Name: "data", Type: field.TypeBytes},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "ref_count", Type: field.TypeInt64, Default: 0},
+	}
+	// ContentBlobsTable holds the schema information for the "content_blobs" table.
+	ContentBlobsTable = &schema.Table{
+		Name:       "content_blobs",
+		Columns:    ContentBlobsColumns,
+		PrimaryKey: []*schema.Column{ContentBlobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "content_blobs_by_hash",
+				Unique:  true,
+				Columns: []*schema.Column{ContentBlobsColumns[3]},
+			},
+			{
+				Name:    "content_blobs_by_ref_count",
+				Unique:  false,
+				Columns: []*schema.Column{ContentBlobsColumns[6]},
+			},
+		},
+	}
+	// DataExportsColumns holds the columns for the "data_exports" table.
+	DataExportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "resource", Type: field.TypeEnum, Enums: []string{"requests", "usage_logs"}},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"csv", "jsonl", "parquet"}},
+		{Name: "include_bodies", Type: field.TypeBool, Default: false},
+		{Name: "criteria", Type: field.TypeJSON},
+		{Name: "data_storage_id", Type: field.TypeInt, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "completed", "failed"}, Default: "pending"},
+		{Name: "storage_key", Type: field.TypeString, Nullable: true},
+		{Name: "row_count", Type: field.TypeInt64, Default: 0},
+		{Name: "size", Type: field.TypeInt64, Default: 0},
+		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "project_id", Type: field.TypeInt},
+	}
+	// DataExportsTable holds the schema information for the "data_exports" table.
+	DataExportsTable = &schema.Table{
+		Name:       "data_exports",
+		Columns:    DataExportsColumns,
+		PrimaryKey: []*schema.Column{DataExportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "data_exports_projects_data_exports",
+				Columns:    []*schema.Column{DataExportsColumns[16]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "data_exports_by_project_id",
+				Unique:  false,
+				Columns: []*schema.Column{DataExportsColumns[16]},
+			},
+			{
+				Name:    "data_exports_by_status",
+				Unique:  false,
+				Columns: []*schema.Column{DataExportsColumns[9]},
+			},
+		},
+	}
 	// DataStoragesColumns holds the columns for the "data_storages" table.
 	DataStoragesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -266,7 +431,7 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString},
 		{Name: "primary", Type: field.TypeBool, Default: false},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"database", "fs", "s3", "gcs", "webdav"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"database", "fs", "s3", "gcs", "webdav", "azblob", "sftp"}},
 		{Name: "settings", Type: field.TypeJSON},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "archived"}, Default: "active"},
 	}
@@ -283,6 +448,31 @@ var (
 			},
 		},
 	}
+	// ExchangeRatesColumns holds the columns for the "exchange_rates" table.
+	ExchangeRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "from_currency", Type: field.TypeString},
+		{Name: "to_currency", Type: field.TypeString},
+		{Name: "rate", Type: field.TypeFloat64},
+		{Name: "effective_at", Type: field.TypeTime},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"manual", "import"}, Default: "manual"},
+		{Name: "import_source", Type: field.TypeString, Nullable: true},
+	}
+	// ExchangeRatesTable holds the schema information for the "exchange_rates" table.
+	ExchangeRatesTable = &schema.Table{
+		Name:       "exchange_rates",
+		Columns:    ExchangeRatesColumns,
+		PrimaryKey: []*schema.Column{ExchangeRatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "exchange_rates_by_pair_effective_at",
+				Unique:  true,
+				Columns: []*schema.Column{ExchangeRatesColumns[3], ExchangeRatesColumns[4], ExchangeRatesColumns[6]},
+			},
+		},
+	}
 	// InvitationsColumns holds the columns for the "invitations" table.
 	InvitationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -321,6 +511,49 @@ var (
 			},
 		},
 	}
+	// InvoicesColumns holds the columns for the "invoices" table.
+	InvoicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "number", Type: field.TypeString},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "period_end", Type: field.TypeTime},
+		{Name: "currency", Type: field.TypeString, Default: ""},
+		{Name: "subtotal", Type: field.TypeFloat64},
+		{Name: "markup_amount", Type: field.TypeFloat64},
+		{Name: "fee", Type: field.TypeFloat64},
+		{Name: "total", Type: field.TypeFloat64},
+		{Name: "line_items", Type: field.TypeJSON, Nullable: true},
+		{Name: "billing_settings", Type: field.TypeJSON},
+		{Name: "project_id", Type: field.TypeInt},
+	}
+	// InvoicesTable holds the schema information for the "invoices" table.
+	InvoicesTable = &schema.Table{
+		Name:       "invoices",
+		Columns:    InvoicesColumns,
+		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invoices_projects_invoices",
+				Columns:    []*schema.Column{InvoicesColumns[13]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoices_by_project_id_period_start",
+				Unique:  true,
+				Columns: []*schema.Column{InvoicesColumns[13], InvoicesColumns[4]},
+			},
+			{
+				Name:    "invoices_by_number",
+				Unique:  true,
+				Columns: []*schema.Column{InvoicesColumns[3]},
+			},
+		},
+	}
 	// ModelsColumns holds the columns for the "models" table.
 	ModelsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -405,6 +638,9 @@ var (
 		{Name: "description", Type: field.TypeString, Default: ""},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "archived"}, Default: "active"},
 		{Name: "profiles", Type: field.TypeJSON, Nullable: true},
+		{Name: "moderation_settings", Type: field.TypeJSON, Nullable: true},
+		{Name: "billing_settings", Type: field.TypeJSON, Nullable: true},
+		{Name: "data_policy", Type: field.TypeJSON, Nullable: true},
 	}
 	// ProjectsTable holds the schema information for the "projects" table.
 	ProjectsTable = &schema.Table{
@@ -536,8 +772,10 @@ var (
 		{Name: "format", Type: field.TypeString, Default: "openai/chat_completions"},
 		{Name: "request_headers", Type: field.TypeJSON, Nullable: true},
 		{Name: "request_body", Type: field.TypeJSON},
+		{Name: "request_body_blobs", Type: field.TypeBool, Default: false},
 		{Name: "response_body", Type: field.TypeJSON, Nullable: true},
 		{Name: "response_chunks", Type: field.TypeJSON, Nullable: true},
+		{Name: "end_user_id", Type: field.TypeString, Nullable: true, Size: 256},
 		{Name: "external_id", Type: field.TypeString, Nullable: true, Size: 512},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "completed", "failed", "canceled"}},
 		{Name: "stream", Type: field.TypeBool, Default: false},
@@ -549,6 +787,10 @@ var (
 		{Name: "content_storage_id", Type: field.TypeInt, Nullable: true},
 		{Name: "content_storage_key", Type: field.TypeString, Nullable: true},
 		{Name: "content_saved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "moderation_verdicts", Type: field.TypeJSON, Nullable: true},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
+		{Name: "archive_storage_id", Type: field.TypeInt, Nullable: true},
+		{Name: "archive_key", Type: field.TypeString, Nullable: true},
 		{Name: "api_key_id", Type: field.TypeInt, Nullable: true},
 		{Name: "channel_id", Type: field.TypeInt, Nullable: true},
 		{Name: "data_storage_id", Type: field.TypeInt, Nullable: true},
@@ -563,31 +805,31 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "requests_api_keys_requests",
-				Columns:    []*schema.Column{RequestsColumns[22]},
+				Columns:    []*schema.Column{RequestsColumns[28]},
 				RefColumns: []*schema.Column{APIKeysColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "requests_channels_requests",
-				Columns:    []*schema.Column{RequestsColumns[23]},
+				Columns:    []*schema.Column{RequestsColumns[29]},
 				RefColumns: []*schema.Column{ChannelsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "requests_data_storages_requests",
-				Columns:    []*schema.Column{RequestsColumns[24]},
+				Columns:    []*schema.Column{RequestsColumns[30]},
 				RefColumns: []*schema.Column{DataStoragesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "requests_projects_requests",
-				Columns:    []*schema.Column{RequestsColumns[25]},
+				Columns:    []*schema.Column{RequestsColumns[31]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "requests_traces_requests",
-				Columns:    []*schema.Column{RequestsColumns[26]},
+				Columns:    []*schema.Column{RequestsColumns[32]},
 				RefColumns: []*schema.Column{TracesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -596,28 +838,33 @@ var (
 			{
 				Name:    "requests_by_api_key_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{RequestsColumns[22], RequestsColumns[1]},
+				Columns: []*schema.Column{RequestsColumns[28], RequestsColumns[1]},
 			},
 			{
 				Name:    "requests_by_project_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{RequestsColumns[25], RequestsColumns[1]},
+				Columns: []*schema.Column{RequestsColumns[31], RequestsColumns[1]},
 			},
 			{
 				Name:    "requests_by_channel_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{RequestsColumns[23], RequestsColumns[1]},
+				Columns: []*schema.Column{RequestsColumns[29], RequestsColumns[1]},
 			},
 			{
 				Name:    "requests_by_trace_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{RequestsColumns[26], RequestsColumns[1]},
+				Columns: []*schema.Column{RequestsColumns[32], RequestsColumns[1]},
 			},
 			{
 				Name:    "requests_by_created_at",
 				Unique:  false,
 				Columns: []*schema.Column{RequestsColumns[1]},
 			},
+			{
+				Name:    "requests_by_archive_key",
+				Unique:  false,
+				Columns: []*schema.Column{RequestsColumns[27]},
+			},
 		},
 	}
 	// RequestExecutionsColumns holds the columns for the "request_executions" table.
@@ -630,6 +877,7 @@ var (
 		{Name: "model_id", Type: field.TypeString},
 		{Name: "format", Type: field.TypeString, Default: "openai/chat_completions"},
 		{Name: "request_body", Type: field.TypeJSON},
+		{Name: "request_body_blobs", Type: field.TypeBool, Default: false},
 		{Name: "response_body", Type: field.TypeJSON, Nullable: true},
 		{Name: "response_chunks", Type: field.TypeJSON, Nullable: true},
 		{Name: "error_message", Type: field.TypeString, Nullable: true},
@@ -654,19 +902,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "request_executions_channels_executions",
-				Columns:    []*schema.Column{RequestExecutionsColumns[20]},
+				Columns:    []*schema.Column{RequestExecutionsColumns[21]},
 				RefColumns: []*schema.Column{ChannelsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "request_executions_data_storages_executions",
-				Columns:    []*schema.Column{RequestExecutionsColumns[21]},
+				Columns:    []*schema.Column{RequestExecutionsColumns[22]},
 				RefColumns: []*schema.Column{DataStoragesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "request_executions_requests_executions",
-				Columns:    []*schema.Column{RequestExecutionsColumns[22]},
+				Columns:    []*schema.Column{RequestExecutionsColumns[23]},
 				RefColumns: []*schema.Column{RequestsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -675,17 +923,44 @@ var (
 			{
 				Name:    "request_executions_by_request_id_status_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{RequestExecutionsColumns[22], RequestExecutionsColumns[12], RequestExecutionsColumns[1]},
+				Columns: []*schema.Column{RequestExecutionsColumns[23], RequestExecutionsColumns[13], RequestExecutionsColumns[1]},
 			},
 			{
 				Name:    "request_executions_by_request_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{RequestExecutionsColumns[22], RequestExecutionsColumns[1]},
+				Columns: []*schema.Column{RequestExecutionsColumns[23], RequestExecutionsColumns[1]},
 			},
 			{
 				Name:    "request_executions_by_channel_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{RequestExecutionsColumns[20], RequestExecutionsColumns[1]},
+				Columns: []*schema.Column{RequestExecutionsColumns[21], RequestExecutionsColumns[1]},
+			},
+		},
+	}
+	// RequestSearchTermsColumns holds the columns for the "request_search_terms" table.
+	RequestSearchTermsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "project_id", Type: field.TypeInt},
+		{Name: "request_id", Type: field.TypeInt},
+		{Name: "field", Type: field.TypeEnum, Enums: []string{"request", "response", "tool"}},
+		{Name: "term", Type: field.TypeString, Size: 64},
+		{Name: "frequency", Type: field.TypeInt, Default: 1},
+	}
+	// RequestSearchTermsTable holds the schema information for the "request_search_terms" table.
+	RequestSearchTermsTable = &schema.Table{
+		Name:       "request_search_terms",
+		Columns:    RequestSearchTermsColumns,
+		PrimaryKey: []*schema.Column{RequestSearchTermsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "request_search_terms_by_project_id_term",
+				Unique:  false,
+				Columns: []*schema.Column{RequestSearchTermsColumns[1], RequestSearchTermsColumns[4]},
+			},
+			{
+				Name:    "request_search_terms_by_request_id_field_term",
+				Unique:  true,
+				Columns: []*schema.Column{RequestSearchTermsColumns[2], RequestSearchTermsColumns[3], RequestSearchTermsColumns[4]},
 			},
 		},
 	}
@@ -726,6 +1001,27 @@ var (
 			},
 		},
 	}
+	// ScimGroupsColumns holds the columns for the "scim_groups" table.
+	ScimGroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "display_name", Type: field.TypeString},
+		{Name: "external_id", Type: field.TypeString, Default: ""},
+	}
+	// ScimGroupsTable holds the schema information for the "scim_groups" table.
+	ScimGroupsTable = &schema.Table{
+		Name:       "scim_groups",
+		Columns:    ScimGroupsColumns,
+		PrimaryKey: []*schema.Column{ScimGroupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scim_groups_by_display_name",
+				Unique:  true,
+				Columns: []*schema.Column{ScimGroupsColumns[3]},
+			},
+		},
+	}
 	// SystemsColumns holds the columns for the "systems" table.
 	SystemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -833,12 +1129,100 @@ var (
 			},
 		},
 	}
+	// UsageDailyRollupsColumns holds the columns for the "usage_daily_rollups" table.
+	UsageDailyRollupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "bucket", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeInt},
+		{Name: "api_key_id", Type: field.TypeInt, Default: 0},
+		{Name: "channel_id", Type: field.TypeInt, Default: 0},
+		{Name: "model_id", Type: field.TypeString},
+		{Name: "end_user_id", Type: field.TypeString, Size: 256, Default: ""},
+		{Name: "request_count", Type: field.TypeInt64, Default: 0},
+		{Name: "prompt_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "prompt_cached_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "prompt_write_cached_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "completion_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "completion_reasoning_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "total_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "total_cost", Type: field.TypeFloat64, Default: 0},
+	}
+	// UsageDailyRollupsTable holds the schema information for the "usage_daily_rollups" table.
+	UsageDailyRollupsTable = &schema.Table{
+		Name:       "usage_daily_rollups",
+		Columns:    UsageDailyRollupsColumns,
+		PrimaryKey: []*schema.Column{UsageDailyRollupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usage_daily_rollups_by_key",
+				Unique:  true,
+				Columns: []*schema.Column{UsageDailyRollupsColumns[1], UsageDailyRollupsColumns[2], UsageDailyRollupsColumns[3], UsageDailyRollupsColumns[4], UsageDailyRollupsColumns[5], UsageDailyRollupsColumns[6]},
+			},
+			{
+				Name:    "usage_daily_rollups_by_project_id_bucket",
+				Unique:  false,
+				Columns: []*schema.Column{UsageDailyRollupsColumns[2], UsageDailyRollupsColumns[1]},
+			},
+			{
+				Name:    "usage_daily_rollups_by_channel_id_bucket",
+				Unique:  false,
+				Columns: []*schema.Column{UsageDailyRollupsColumns[4], UsageDailyRollupsColumns[1]},
+			},
+			{
+				Name:    "usage_daily_rollups_by_api_key_id_bucket",
+				Unique:  false,
+				Columns: []*schema.Column{UsageDailyRollupsColumns[3], UsageDailyRollupsColumns[1]},
+			},
+			{
+				Name:    "usage_daily_rollups_by_model_id_bucket",
+				Unique:  false,
+				Columns: []*schema.Column{UsageDailyRollupsColumns[5], UsageDailyRollupsColumns[1]},
+			},
+		},
+	}
+	// UsageHourlyRollupsColumns holds the columns for the "usage_hourly_rollups" table.
+	UsageHourlyRollupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "bucket", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeInt},
+		{Name: "api_key_id", Type: field.TypeInt, Default: 0},
+		{Name: "channel_id", Type: field.TypeInt, Default: 0},
+		{Name: "model_id", Type: field.TypeString},
+		{Name: "end_user_id", Type: field.TypeString, Size: 256, Default: ""},
+		{Name: "request_count", Type: field.TypeInt64, Default: 0},
+		{Name: "prompt_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "prompt_cached_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "prompt_write_cached_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "completion_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "completion_reasoning_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "total_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "total_cost", Type: field.TypeFloat64, Default: 0},
+	}
+	// UsageHourlyRollupsTable holds the schema information for the "usage_hourly_rollups" table.
+	UsageHourlyRollupsTable = &schema.Table{
+		Name:       "usage_hourly_rollups",
+		Columns:    UsageHourlyRollupsColumns,
+		PrimaryKey: []*schema.Column{UsageHourlyRollupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usage_hourly_rollups_by_key",
+				Unique:  true,
+				Columns: []*schema.Column{UsageHourlyRollupsColumns[1], UsageHourlyRollupsColumns[2], UsageHourlyRollupsColumns[3], UsageHourlyRollupsColumns[4], UsageHourlyRollupsColumns[5], UsageHourlyRollupsColumns[6]},
+			},
+			{
+				Name:    "usage_hourly_rollups_by_project_id_bucket",
+				Unique:  false,
+				Columns: []*schema.Column{UsageHourlyRollupsColumns[2], UsageHourlyRollupsColumns[1]},
+			},
+		},
+	}
 	// UsageLogsColumns holds the columns for the "usage_logs" table.
 	UsageLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
 		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
 		{Name: "api_key_id", Type: field.TypeInt, Nullable: true},
+		{Name: "end_user_id", Type: field.TypeString, Nullable: true, Size: 256},
 		{Name: "model_id", Type: field.TypeString},
 		{Name: "prompt_tokens", Type: field.TypeInt64, Default: 0},
 		{Name: "completion_tokens", Type: field.TypeInt64, Default: 0},
@@ -857,6 +1241,10 @@ var (
 		{Name: "total_cost", Type: field.TypeFloat64, Nullable: true},
 		{Name: "cost_items", Type: field.TypeJSON, Nullable: true},
 		{Name: "cost_price_reference_id", Type: field.TypeString, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Nullable: true},
+		{Name: "original_total_cost", Type: field.TypeFloat64, Nullable: true},
+		{Name: "original_currency", Type: field.TypeString, Nullable: true},
+		{Name: "exchange_rate", Type: field.TypeFloat64, Nullable: true},
 		{Name: "channel_id", Type: field.TypeInt, Nullable: true},
 		{Name: "project_id", Type: field.TypeInt, Default: 1},
 		{Name: "request_id", Type: field.TypeInt},
@@ -869,19 +1257,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "usage_logs_channels_usage_logs",
-				Columns:    []*schema.Column{UsageLogsColumns[22]},
+				Columns:    []*schema.Column{UsageLogsColumns[27]},
 				RefColumns: []*schema.Column{ChannelsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "usage_logs_projects_usage_logs",
-				Columns:    []*schema.Column{UsageLogsColumns[23]},
+				Columns:    []*schema.Column{UsageLogsColumns[28]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "usage_logs_requests_usage_logs",
-				Columns:    []*schema.Column{UsageLogsColumns[24]},
+				Columns:    []*schema.Column{UsageLogsColumns[29]},
 				RefColumns: []*schema.Column{RequestsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -890,7 +1278,7 @@ var (
 			{
 				Name:    "usage_logs_by_request_id",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[24]},
+				Columns: []*schema.Column{UsageLogsColumns[29]},
 			},
 			{
 				Name:    "usage_logs_by_created_at",
@@ -900,23 +1288,28 @@ var (
 			{
 				Name:    "usage_logs_by_model_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[4], UsageLogsColumns[1]},
+				Columns: []*schema.Column{UsageLogsColumns[5], UsageLogsColumns[1]},
 			},
 			{
 				Name:    "usage_logs_by_project_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[23], UsageLogsColumns[1]},
+				Columns: []*schema.Column{UsageLogsColumns[28], UsageLogsColumns[1]},
 			},
 			{
 				Name:    "usage_logs_by_channel_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{UsageLogsColumns[22], UsageLogsColumns[1]},
+				Columns: []*schema.Column{UsageLogsColumns[27], UsageLogsColumns[1]},
 			},
 			{
 				Name:    "usage_logs_by_api_key_id_created_at",
 				Unique:  false,
 				Columns: []*schema.Column{UsageLogsColumns[3], UsageLogsColumns[1]},
 			},
+			{
+				Name:    "usage_logs_by_api_key_id_end_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsageLogsColumns[3], UsageLogsColumns[4], UsageLogsColumns[1]},
+			},
 		},
 	}
 	// UsersColumns holds the columns for the "users" table.
@@ -1030,17 +1423,193 @@ var (
 			},
 		},
 	}
+	// WalletsColumns holds the columns for the "wallets" table.
+	WalletsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "project_id", Type: field.TypeInt},
+		{Name: "balance", Type: field.TypeFloat64, Default: 0},
+		{Name: "reserved", Type: field.TypeFloat64, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"enabled", "disabled"}, Default: "enabled"},
+		{Name: "low_balance_threshold", Type: field.TypeFloat64, Nullable: true},
+		{Name: "low_balance_alerted", Type: field.TypeBool, Default: false},
+		{Name: "api_key_id", Type: field.TypeInt, Unique: true},
+	}
+	// WalletsTable holds the schema information for the "wallets" table.
+	WalletsTable = &schema.Table{
+		Name:       "wallets",
+		Columns:    WalletsColumns,
+		PrimaryKey: []*schema.Column{WalletsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "wallets_api_keys_wallet",
+				Columns:    []*schema.Column{WalletsColumns[9]},
+				RefColumns: []*schema.Column{APIKeysColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wallets_by_api_key_id",
+				Unique:  true,
+				Columns: []*schema.Column{WalletsColumns[9]},
+			},
+			{
+				Name:    "wallets_by_project_id",
+				Unique:  false,
+				Columns: []*schema.Column{WalletsColumns[3]},
+			},
+		},
+	}
+	// WalletReservationsColumns holds the columns for the "wallet_reservations" table.
+	WalletReservationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "wallet_id", Type: field.TypeInt},
+		{Name: "request_id", Type: field.TypeInt},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// WalletReservationsTable holds the schema information for the "wallet_reservations" table.
+	WalletReservationsTable = &schema.Table{
+		Name:       "wallet_reservations",
+		Columns:    WalletReservationsColumns,
+		PrimaryKey: []*schema.Column{WalletReservationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wallet_reservations_by_request_id",
+				Unique:  true,
+				Columns: []*schema.Column{WalletReservationsColumns[4]},
+			},
+			{
+				Name:    "wallet_reservations_by_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{WalletReservationsColumns[6]},
+			},
+		},
+	}
+	// WalletTransactionsColumns holds the columns for the "wallet_transactions" table.
+	WalletTransactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "api_key_id", Type: field.TypeInt},
+		{Name: "project_id", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"top_up", "usage", "refund", "adjustment"}},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "balance_after", Type: field.TypeFloat64},
+		{Name: "request_id", Type: field.TypeInt, Nullable: true},
+		{Name: "usage_log_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "wallet_id", Type: field.TypeInt},
+	}
+	// WalletTransactionsTable holds the schema information for the "wallet_transactions" table.
+	WalletTransactionsTable = &schema.Table{
+		Name:       "wallet_transactions",
+		Columns:    WalletTransactionsColumns,
+		PrimaryKey: []*schema.Column{WalletTransactionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "wallet_transactions_wallets_transactions",
+				Columns:    []*schema.Column{WalletTransactionsColumns[12]},
+				RefColumns: []*schema.Column{WalletsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wallet_transactions_by_wallet_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WalletTransactionsColumns[12], WalletTransactionsColumns[1]},
+			},
+			{
+				Name:    "wallet_transactions_by_request_id",
+				Unique:  false,
+				Columns: []*schema.Column{WalletTransactionsColumns[8]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "event", Type: field.TypeString},
+		{Name: "target_name", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "headers", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhook_deliveries_by_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[9], WebhookDeliveriesColumns[11]},
+			},
+			{
+				Name:    "webhook_deliveries_by_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[1]},
+			},
+		},
+	}
+	// ScimGroupUsersColumns holds the columns for the "scim_group_users" table.
+	ScimGroupUsersColumns = []*schema.Column{
+		{Name: "scim_group_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ScimGroupUsersTable holds the schema information for the "scim_group_users" table.
+	ScimGroupUsersTable = &schema.Table{
+		Name:       "scim_group_users",
+		Columns:    ScimGroupUsersColumns,
+		PrimaryKey: []*schema.Column{ScimGroupUsersColumns[0], ScimGroupUsersColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "scim_group_users_scim_group_id",
+				Columns:    []*schema.Column{ScimGroupUsersColumns[0]},
+				RefColumns: []*schema.Column{ScimGroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "scim_group_users_user_id",
+				Columns:    []*schema.Column{ScimGroupUsersColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		APIKeysTable,
 		APIKeyProfileTemplatesTable,
+		AuditLogsTable,
+		BudgetsTable,
 		ChannelsTable,
 		ChannelModelPricesTable,
 		ChannelModelPriceVersionsTable,
 		ChannelOverrideTemplatesTable,
 		ChannelProbesTable,
+		ContentBlobsTable,
+		DataExportsTable,
 		DataStoragesTable,
+		ExchangeRatesTable,
 		InvitationsTable,
+		InvoicesTable,
 		ModelsTable,
 		OidcIdentitiesTable,
 		ProjectsTable,
@@ -1049,14 +1618,23 @@ var (
 		ProviderQuotaStatusTable,
 		RequestsTable,
 		RequestExecutionsTable,
+		RequestSearchTermsTable,
 		RolesTable,
+		ScimGroupsTable,
 		SystemsTable,
 		ThreadsTable,
 		TracesTable,
+		UsageDailyRollupsTable,
+		UsageHourlyRollupsTable,
 		UsageLogsTable,
 		UsersTable,
 		UserProjectsTable,
 		UserRolesTable,
+		WalletsTable,
+		WalletReservationsTable,
+		WalletTransactionsTable,
+		WebhookDeliveriesTable,
+		ScimGroupUsersTable,
 	}
 )
 
@@ -1064,11 +1642,14 @@ func init() {
 	APIKeysTable.ForeignKeys[0].RefTable = ProjectsTable
 	APIKeysTable.ForeignKeys[1].RefTable = UsersTable
 	APIKeyProfileTemplatesTable.ForeignKeys[0].RefTable = ProjectsTable
+	BudgetsTable.ForeignKeys[0].RefTable = ProjectsTable
 	ChannelModelPricesTable.ForeignKeys[0].RefTable = ChannelsTable
 	ChannelModelPriceVersionsTable.ForeignKeys[0].RefTable = ChannelModelPricesTable
 	ChannelOverrideTemplatesTable.ForeignKeys[0].RefTable = UsersTable
 	ChannelProbesTable.ForeignKeys[0].RefTable = ChannelsTable
+	DataExportsTable.ForeignKeys[0].RefTable = ProjectsTable
 	InvitationsTable.ForeignKeys[0].RefTable = ProjectsTable
+	InvoicesTable.ForeignKeys[0].RefTable = ProjectsTable
 	OidcIdentitiesTable.ForeignKeys[0].RefTable = UsersTable
 	PromptsTable.ForeignKeys[0].RefTable = ProjectsTable
 	ProviderQuotaStatusTable.ForeignKeys[0].RefTable = ChannelsTable
@@ -1091,4 +1672,8 @@ func init() {
 	UserProjectsTable.ForeignKeys[1].RefTable = ProjectsTable
 	UserRolesTable.ForeignKeys[0].RefTable = UsersTable
 	UserRolesTable.ForeignKeys[1].RefTable = RolesTable
+	WalletsTable.ForeignKeys[0].RefTable = APIKeysTable
+	WalletTransactionsTable.ForeignKeys[0].RefTable = WalletsTable
+	ScimGroupUsersTable.ForeignKeys[0].RefTable = ScimGroupsTable
+	ScimGroupUsersTable.ForeignKeys[1].RefTable = UsersTable
 }
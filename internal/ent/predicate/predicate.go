@@ -12,6 +12,12 @@ type APIKey func(*sql.Selector)
 // APIKeyProfileTemplate is the predicate function for apikeyprofiletemplate builders.
 type APIKeyProfileTemplate func(*sql.Selector)
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// Budget is the predicate function for budget builders.
+type Budget func(*sql.Selector)
+
 // Channel is the predicate function for channel builders.
 type Channel func(*sql.Selector)
 
@@ -27,12 +33,24 @@ type ChannelOverrideTemplate func(*sql.Selector)
 // ChannelProbe is the predicate function for channelprobe builders.
 type ChannelProbe func(*sql.Selector)
 
+// ContentBlob is the predicate function for contentblob builders.
+type ContentBlob func(*sql.Selector)
+
+// DataExport is the predicate function for dataexport builders.
+type DataExport func(*sql.Selector)
+
 // DataStorage is the predicate function for datastorage builders.
 type DataStorage func(*sql.Selector)
 
+// ExchangeRate is the predicate function for exchangerate builders.
+type ExchangeRate func(*sql.Selector)
+
 // Invitation is the predicate function for invitation builders.
 type Invitation func(*sql.Selector)
 
+// Invoice is the predicate function for invoice builders.
+type Invoice func(*sql.Selector)
+
 // Model is the predicate function for model builders.
 type Model func(*sql.Selector)
 
@@ -57,9 +75,15 @@ type Request func(*sql.Selector)
 // RequestExecution is the predicate function for requestexecution builders.
 type RequestExecution func(*sql.Selector)
 
+// RequestSearchTerm is the predicate function for requestsearchterm builders.
+type RequestSearchTerm func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
+// SCIMGroup is the predicate function for scimgroup builders.
+type SCIMGroup func(*sql.Selector)
+
 // System is the predicate function for system builders.
 type System func(*sql.Selector)
 
@@ -69,6 +93,12 @@ type Thread func(*sql.Selector)
 // Trace is the predicate function for trace builders.
 type Trace func(*sql.Selector)
 
+// UsageDailyRollup is the predicate function for usagedailyrollup builders.
+type UsageDailyRollup func(*sql.Selector)
+
+// UsageHourlyRollup is the predicate function for usagehourlyrollup builders.
+type UsageHourlyRollup func(*sql.Selector)
+
 // UsageLog is the predicate function for usagelog builders.
 type UsageLog func(*sql.Selector)
 
@@ -80,3 +110,15 @@ type UserProject func(*sql.Selector)
 
 // UserRole is the predicate function for userrole builders.
 type UserRole func(*sql.Selector)
+
+// Wallet is the predicate function for wallet builders.
+type Wallet func(*sql.Selector)
+
+// WalletReservation is the predicate function for walletreservation builders.
+type WalletReservation func(*sql.Selector)
+
+// WalletTransaction is the predicate function for wallettransaction builders.
+type WalletTransaction func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
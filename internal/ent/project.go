@@ -33,6 +33,12 @@ type Project struct {
 	Status project.Status `json:"status,omitempty"`
 	// Profiles holds the value of the "profiles" field.
 	Profiles *objects.ProjectProfiles `json:"profiles,omitempty"`
+	// moderation guardrail settings of the project
+	ModerationSettings *objects.ProjectModerationSettings `json:"moderation_settings,omitempty"`
+	// billing settings used to generate the invoices of the project
+	BillingSettings *objects.ProjectBillingSettings `json:"billing_settings,omitempty"`
+	// content capture and retention policy of the project, overrides the system storage policy
+	DataPolicy *objects.ProjectDataPolicy `json:"data_policy,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProjectQuery when eager-loading is set.
 	Edges        ProjectEdges `json:"edges"`
@@ -61,13 +67,19 @@ type ProjectEdges struct {
 	Prompts []*Prompt `json:"prompts,omitempty"`
 	// APIKeyProfileTemplates holds the value of the api_key_profile_templates edge.
 	APIKeyProfileTemplates []*APIKeyProfileTemplate `json:"api_key_profile_templates,omitempty"`
+	// Budgets holds the value of the budgets edge.
+	Budgets []*Budget `json:"budgets,omitempty"`
+	// Invoices holds the value of the invoices edge.
+	Invoices []*Invoice `json:"invoices,omitempty"`
+	// DataExports holds the value of the data_exports edge.
+	DataExports []*DataExport `json:"data_exports,omitempty"`
 	// ProjectUsers holds the value of the project_users edge.
 	ProjectUsers []*UserProject `json:"project_users,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [11]bool
+	loadedTypes [14]bool
 	// totalCount holds the count of the edges above.
-	totalCount [10]map[string]int
+	totalCount [13]map[string]int
 
 	namedUsers                  map[string][]*User
 	namedInvitations            map[string][]*Invitation
@@ -79,6 +91,9 @@ type ProjectEdges struct {
 	namedTraces                 map[string][]*Trace
 	namedPrompts                map[string][]*Prompt
 	namedAPIKeyProfileTemplates map[string][]*APIKeyProfileTemplate
+	namedBudgets                map[string][]*Budget
+	namedInvoices               map[string][]*Invoice
+	namedDataExports            map[string][]*DataExport
 	namedProjectUsers           map[string][]*UserProject
 }
 
@@ -172,10 +187,37 @@ func (e ProjectEdges) APIKeyProfileTemplatesOrErr() ([]*APIKeyProfileTemplate, e
 	return nil, &NotLoadedError{edge: "api_key_profile_templates"}
 }
 
+// BudgetsOrErr returns the Budgets value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) BudgetsOrErr() ([]*Budget, error) {
+	if e.loadedTypes[10] {
+		return e.Budgets, nil
+	}
+	return nil, &NotLoadedError{edge: "budgets"}
+}
+
+// InvoicesOrErr returns the Invoices value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) InvoicesOrErr() ([]*Invoice, error) {
+	if e.loadedTypes[11] {
+		return e.Invoices, nil
+	}
+	return nil, &NotLoadedError{edge: "invoices"}
+}
+
+// DataExportsOrErr returns the DataExports value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) DataExportsOrErr() ([]*DataExport, error) {
+	if e.loadedTypes[12] {
+		return e.DataExports, nil
+	}
+	return nil, &NotLoadedError{edge: "data_exports"}
+}
+
 // ProjectUsersOrErr returns the ProjectUsers value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProjectEdges) ProjectUsersOrErr() ([]*UserProject, error) {
-	if e.loadedTypes[10] {
+	if e.loadedTypes[13] {
 		return e.ProjectUsers, nil
 	}
 	return nil, &NotLoadedError{edge: "project_users"}
@@ -186,7 +228,7 @@ func (*Project) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case project.FieldProfiles:
+		case project.FieldProfiles, project.FieldModerationSettings, project.FieldBillingSettings, project.FieldDataPolicy:
 			values[i] = new([]byte)
 		case project.FieldID, project.FieldDeletedAt:
 			values[i] = new(sql.NullInt64)
@@ -259,6 +301,30 @@ func (_m *Project) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field profiles: %w", err)
 				}
 			}
+		case project.FieldModerationSettings:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field moderation_settings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ModerationSettings); err != nil {
+					return fmt.Errorf("unmarshal field moderation_settings: %w", err)
+				}
+			}
+		case project.FieldBillingSettings:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_settings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.BillingSettings); err != nil {
+					return fmt.Errorf("unmarshal field billing_settings: %w", err)
+				}
+			}
+		case project.FieldDataPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DataPolicy); err != nil {
+					return fmt.Errorf("unmarshal field data_policy: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -322,6 +388,21 @@ func (_m *Project) QueryAPIKeyProfileTemplates() *APIKeyProfileTemplateQuery {
 	return NewProjectClient(_m.config).QueryAPIKeyProfileTemplates(_m)
 }
 
+// QueryBudgets queries the "budgets" edge of the Project entity.
+func (_m *Project) QueryBudgets() *BudgetQuery {
+	return NewProjectClient(_m.config).QueryBudgets(_m)
+}
+
+// QueryInvoices queries the "invoices" edge of the Project entity.
+func (_m *Project) QueryInvoices() *InvoiceQuery {
+	return NewProjectClient(_m.config).QueryInvoices(_m)
+}
+
+// QueryDataExports queries the "data_exports" edge of the Project entity.
+func (_m *Project) QueryDataExports() *DataExportQuery {
+	return NewProjectClient(_m.config).QueryDataExports(_m)
+}
+
 // QueryProjectUsers queries the "project_users" edge of the Project entity.
 func (_m *Project) QueryProjectUsers() *UserProjectQuery {
 	return NewProjectClient(_m.config).QueryProjectUsers(_m)
@@ -370,6 +451,15 @@ func (_m *Project) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("profiles=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Profiles))
+	builder.WriteString(", ")
+	builder.WriteString("moderation_settings=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModerationSettings))
+	builder.WriteString(", ")
+	builder.WriteString("billing_settings=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BillingSettings))
+	builder.WriteString(", ")
+	builder.WriteString("data_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DataPolicy))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -614,6 +704,78 @@ func (_m *Project) appendNamedAPIKeyProfileTemplates(name string, edges ...*APIK
 	}
 }
 
+// NamedBudgets returns the Budgets named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Project) NamedBudgets(name string) ([]*Budget, error) {
+	if _m.Edges.namedBudgets == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedBudgets[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Project) appendNamedBudgets(name string, edges ...*Budget) {
+	if _m.Edges.namedBudgets == nil {
+		_m.Edges.namedBudgets = make(map[string][]*Budget)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedBudgets[name] = []*Budget{}
+	} else {
+		_m.Edges.namedBudgets[name] = append(_m.Edges.namedBudgets[name], edges...)
+	}
+}
+
+// NamedInvoices returns the Invoices named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Project) NamedInvoices(name string) ([]*Invoice, error) {
+	if _m.Edges.namedInvoices == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedInvoices[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Project) appendNamedInvoices(name string, edges ...*Invoice) {
+	if _m.Edges.namedInvoices == nil {
+		_m.Edges.namedInvoices = make(map[string][]*Invoice)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedInvoices[name] = []*Invoice{}
+	} else {
+		_m.Edges.namedInvoices[name] = append(_m.Edges.namedInvoices[name], edges...)
+	}
+}
+
+// NamedDataExports returns the DataExports named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Project) NamedDataExports(name string) ([]*DataExport, error) {
+	if _m.Edges.namedDataExports == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedDataExports[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Project) appendNamedDataExports(name string, edges ...*DataExport) {
+	if _m.Edges.namedDataExports == nil {
+		_m.Edges.namedDataExports = make(map[string][]*DataExport)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedDataExports[name] = []*DataExport{}
+	} else {
+		_m.Edges.namedDataExports[name] = append(_m.Edges.namedDataExports[name], edges...)
+	}
+}
+
 // NamedProjectUsers returns the ProjectUsers named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (_m *Project) NamedProjectUsers(name string) ([]*UserProject, error) {
@@ -360,6 +360,36 @@ func ProfilesNotNil() predicate.Project {
 	return predicate.Project(sql.FieldNotNull(FieldProfiles))
 }
 
+// ModerationSettingsIsNil applies the IsNil predicate on the "moderation_settings" field.
+func ModerationSettingsIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldModerationSettings))
+}
+
+// ModerationSettingsNotNil applies the NotNil predicate on the "moderation_settings" field.
+func ModerationSettingsNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldModerationSettings))
+}
+
+// BillingSettingsIsNil applies the IsNil predicate on the "billing_settings" field.
+func BillingSettingsIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldBillingSettings))
+}
+
+// BillingSettingsNotNil applies the NotNil predicate on the "billing_settings" field.
+func BillingSettingsNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldBillingSettings))
+}
+
+// DataPolicyIsNil applies the IsNil predicate on the "data_policy" field.
+func DataPolicyIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldDataPolicy))
+}
+
+// DataPolicyNotNil applies the NotNil predicate on the "data_policy" field.
+func DataPolicyNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldDataPolicy))
+}
+
 // HasUsers applies the HasEdge predicate on the "users" edge.
 func HasUsers() predicate.Project {
 	return predicate.Project(func(s *sql.Selector) {
@@ -590,6 +620,75 @@ func HasAPIKeyProfileTemplatesWith(preds ...predicate.APIKeyProfileTemplate) pre
 	})
 }
 
+// HasBudgets applies the HasEdge predicate on the "budgets" edge.
+func HasBudgets() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BudgetsTable, BudgetsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBudgetsWith applies the HasEdge predicate on the "budgets" edge with a given conditions (other predicates).
+func HasBudgetsWith(preds ...predicate.Budget) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newBudgetsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvoices applies the HasEdge predicate on the "invoices" edge.
+func HasInvoices() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvoicesTable, InvoicesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoicesWith applies the HasEdge predicate on the "invoices" edge with a given conditions (other predicates).
+func HasInvoicesWith(preds ...predicate.Invoice) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newInvoicesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDataExports applies the HasEdge predicate on the "data_exports" edge.
+func HasDataExports() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DataExportsTable, DataExportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDataExportsWith applies the HasEdge predicate on the "data_exports" edge with a given conditions (other predicates).
+func HasDataExportsWith(preds ...predicate.DataExport) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newDataExportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasProjectUsers applies the HasEdge predicate on the "project_users" edge.
 func HasProjectUsers() predicate.Project {
 	return predicate.Project(func(s *sql.Selector) {
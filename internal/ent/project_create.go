@@ -13,7 +13,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/apikeyprofiletemplate"
+	"github.com/looplj/axonhub/internal/ent/budget"
+	"github.com/looplj/axonhub/internal/ent/dataexport"
 	"github.com/looplj/axonhub/internal/ent/invitation"
+	"github.com/looplj/axonhub/internal/ent/invoice"
 	"github.com/looplj/axonhub/internal/ent/project"
 	"github.com/looplj/axonhub/internal/ent/prompt"
 	"github.com/looplj/axonhub/internal/ent/request"
@@ -116,6 +119,24 @@ func (_c *ProjectCreate) SetProfiles(v *objects.ProjectProfiles) *ProjectCreate
 	return _c
 }
 
+// SetModerationSettings sets the "moderation_settings" field.
+func (_c *ProjectCreate) SetModerationSettings(v *objects.ProjectModerationSettings) *ProjectCreate {
+	_c.mutation.SetModerationSettings(v)
+	return _c
+}
+
+// SetBillingSettings sets the "billing_settings" field.
+func (_c *ProjectCreate) SetBillingSettings(v *objects.ProjectBillingSettings) *ProjectCreate {
+	_c.mutation.SetBillingSettings(v)
+	return _c
+}
+
+// SetDataPolicy sets the "data_policy" field.
+func (_c *ProjectCreate) SetDataPolicy(v *objects.ProjectDataPolicy) *ProjectCreate {
+	_c.mutation.SetDataPolicy(v)
+	return _c
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (_c *ProjectCreate) AddUserIDs(ids ...int) *ProjectCreate {
 	_c.mutation.AddUserIDs(ids...)
@@ -266,6 +287,51 @@ func (_c *ProjectCreate) AddAPIKeyProfileTemplates(v ...*APIKeyProfileTemplate)
 	return _c.AddAPIKeyProfileTemplateIDs(ids...)
 }
 
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by IDs.
+func (_c *ProjectCreate) AddBudgetIDs(ids ...int) *ProjectCreate {
+	_c.mutation.AddBudgetIDs(ids...)
+	return _c
+}
+
+// AddBudgets adds the "budgets" edges to the Budget entity.
+func (_c *ProjectCreate) AddBudgets(v ...*Budget) *ProjectCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBudgetIDs(ids...)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (_c *ProjectCreate) AddInvoiceIDs(ids ...int) *ProjectCreate {
+	_c.mutation.AddInvoiceIDs(ids...)
+	return _c
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (_c *ProjectCreate) AddInvoices(v ...*Invoice) *ProjectCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInvoiceIDs(ids...)
+}
+
+// AddDataExportIDs adds the "data_exports" edge to the DataExport entity by IDs.
+func (_c *ProjectCreate) AddDataExportIDs(ids ...int) *ProjectCreate {
+	_c.mutation.AddDataExportIDs(ids...)
+	return _c
+}
+
+// AddDataExports adds the "data_exports" edges to the DataExport entity.
+func (_c *ProjectCreate) AddDataExports(v ...*DataExport) *ProjectCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDataExportIDs(ids...)
+}
+
 // AddProjectUserIDs adds the "project_users" edge to the UserProject entity by IDs.
 func (_c *ProjectCreate) AddProjectUserIDs(ids ...int) *ProjectCreate {
 	_c.mutation.AddProjectUserIDs(ids...)
@@ -425,6 +491,18 @@ func (_c *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		_spec.SetField(project.FieldProfiles, field.TypeJSON, value)
 		_node.Profiles = value
 	}
+	if value, ok := _c.mutation.ModerationSettings(); ok {
+		_spec.SetField(project.FieldModerationSettings, field.TypeJSON, value)
+		_node.ModerationSettings = value
+	}
+	if value, ok := _c.mutation.BillingSettings(); ok {
+		_spec.SetField(project.FieldBillingSettings, field.TypeJSON, value)
+		_node.BillingSettings = value
+	}
+	if value, ok := _c.mutation.DataPolicy(); ok {
+		_spec.SetField(project.FieldDataPolicy, field.TypeJSON, value)
+		_node.DataPolicy = value
+	}
 	if nodes := _c.mutation.UsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -589,6 +667,54 @@ func (_c *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.BudgetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BudgetsTable,
+			Columns: []string{project.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.InvoicesTable,
+			Columns: []string{project.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DataExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DataExportsTable,
+			Columns: []string{project.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.ProjectUsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -741,6 +867,60 @@ func (u *ProjectUpsert) ClearProfiles() *ProjectUpsert {
 	return u
 }
 
+// SetModerationSettings sets the "moderation_settings" field.
+func (u *ProjectUpsert) SetModerationSettings(v *objects.ProjectModerationSettings) *ProjectUpsert {
+	u.Set(project.FieldModerationSettings, v)
+	return u
+}
+
+// UpdateModerationSettings sets the "moderation_settings" field to the value that was provided on create.
+func (u *ProjectUpsert) UpdateModerationSettings() *ProjectUpsert {
+	u.SetExcluded(project.FieldModerationSettings)
+	return u
+}
+
+// ClearModerationSettings clears the value of the "moderation_settings" field.
+func (u *ProjectUpsert) ClearModerationSettings() *ProjectUpsert {
+	u.SetNull(project.FieldModerationSettings)
+	return u
+}
+
+// SetBillingSettings sets the "billing_settings" field.
+func (u *ProjectUpsert) SetBillingSettings(v *objects.ProjectBillingSettings) *ProjectUpsert {
+	u.Set(project.FieldBillingSettings, v)
+	return u
+}
+
+// UpdateBillingSettings sets the "billing_settings" field to the value that was provided on create.
+func (u *ProjectUpsert) UpdateBillingSettings() *ProjectUpsert {
+	u.SetExcluded(project.FieldBillingSettings)
+	return u
+}
+
+// ClearBillingSettings clears the value of the "billing_settings" field.
+func (u *ProjectUpsert) ClearBillingSettings() *ProjectUpsert {
+	u.SetNull(project.FieldBillingSettings)
+	return u
+}
+
+// SetDataPolicy sets the "data_policy" field.
+func (u *ProjectUpsert) SetDataPolicy(v *objects.ProjectDataPolicy) *ProjectUpsert {
+	u.Set(project.FieldDataPolicy, v)
+	return u
+}
+
+// UpdateDataPolicy sets the "data_policy" field to the value that was provided on create.
+func (u *ProjectUpsert) UpdateDataPolicy() *ProjectUpsert {
+	u.SetExcluded(project.FieldDataPolicy)
+	return u
+}
+
+// ClearDataPolicy clears the value of the "data_policy" field.
+func (u *ProjectUpsert) ClearDataPolicy() *ProjectUpsert {
+	u.SetNull(project.FieldDataPolicy)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -884,6 +1064,69 @@ func (u *ProjectUpsertOne) ClearProfiles() *ProjectUpsertOne {
 	})
 }
 
+// SetModerationSettings sets the "moderation_settings" field.
+func (u *ProjectUpsertOne) SetModerationSettings(v *objects.ProjectModerationSettings) *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetModerationSettings(v)
+	})
+}
+
+// UpdateModerationSettings sets the "moderation_settings" field to the value that was provided on create.
+func (u *ProjectUpsertOne) UpdateModerationSettings() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateModerationSettings()
+	})
+}
+
+// ClearModerationSettings clears the value of the "moderation_settings" field.
+func (u *ProjectUpsertOne) ClearModerationSettings() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.ClearModerationSettings()
+	})
+}
+
+// SetBillingSettings sets the "billing_settings" field.
+func (u *ProjectUpsertOne) SetBillingSettings(v *objects.ProjectBillingSettings) *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetBillingSettings(v)
+	})
+}
+
+// UpdateBillingSettings sets the "billing_settings" field to the value that was provided on create.
+func (u *ProjectUpsertOne) UpdateBillingSettings() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateBillingSettings()
+	})
+}
+
+// ClearBillingSettings clears the value of the "billing_settings" field.
+func (u *ProjectUpsertOne) ClearBillingSettings() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.ClearBillingSettings()
+	})
+}
+
+// SetDataPolicy sets the "data_policy" field.
+func (u *ProjectUpsertOne) SetDataPolicy(v *objects.ProjectDataPolicy) *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetDataPolicy(v)
+	})
+}
+
+// UpdateDataPolicy sets the "data_policy" field to the value that was provided on create.
+func (u *ProjectUpsertOne) UpdateDataPolicy() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateDataPolicy()
+	})
+}
+
+// ClearDataPolicy clears the value of the "data_policy" field.
+func (u *ProjectUpsertOne) ClearDataPolicy() *ProjectUpsertOne {
+	return u.Update(func(s *ProjectUpsert) {
+		s.ClearDataPolicy()
+	})
+}
+
 // Exec executes the query.
 func (u *ProjectUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1193,6 +1436,69 @@ func (u *ProjectUpsertBulk) ClearProfiles() *ProjectUpsertBulk {
 	})
 }
 
+// SetModerationSettings sets the "moderation_settings" field.
+func (u *ProjectUpsertBulk) SetModerationSettings(v *objects.ProjectModerationSettings) *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetModerationSettings(v)
+	})
+}
+
+// UpdateModerationSettings sets the "moderation_settings" field to the value that was provided on create.
+func (u *ProjectUpsertBulk) UpdateModerationSettings() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateModerationSettings()
+	})
+}
+
+// ClearModerationSettings clears the value of the "moderation_settings" field.
+func (u *ProjectUpsertBulk) ClearModerationSettings() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.ClearModerationSettings()
+	})
+}
+
+// SetBillingSettings sets the "billing_settings" field.
+func (u *ProjectUpsertBulk) SetBillingSettings(v *objects.ProjectBillingSettings) *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetBillingSettings(v)
+	})
+}
+
+// UpdateBillingSettings sets the "billing_settings" field to the value that was provided on create.
+func (u *ProjectUpsertBulk) UpdateBillingSettings() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateBillingSettings()
+	})
+}
+
+// ClearBillingSettings clears the value of the "billing_settings" field.
+func (u *ProjectUpsertBulk) ClearBillingSettings() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.ClearBillingSettings()
+	})
+}
+
+// SetDataPolicy sets the "data_policy" field.
+func (u *ProjectUpsertBulk) SetDataPolicy(v *objects.ProjectDataPolicy) *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.SetDataPolicy(v)
+	})
+}
+
+// UpdateDataPolicy sets the "data_policy" field to the value that was provided on create.
+func (u *ProjectUpsertBulk) UpdateDataPolicy() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.UpdateDataPolicy()
+	})
+}
+
+// ClearDataPolicy clears the value of the "data_policy" field.
+func (u *ProjectUpsertBulk) ClearDataPolicy() *ProjectUpsertBulk {
+	return u.Update(func(s *ProjectUpsert) {
+		s.ClearDataPolicy()
+	})
+}
+
 // Exec executes the query.
 func (u *ProjectUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -15,7 +15,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/apikeyprofiletemplate"
+	"github.com/looplj/axonhub/internal/ent/budget"
+	"github.com/looplj/axonhub/internal/ent/dataexport"
 	"github.com/looplj/axonhub/internal/ent/invitation"
+	"github.com/looplj/axonhub/internal/ent/invoice"
 	"github.com/looplj/axonhub/internal/ent/predicate"
 	"github.com/looplj/axonhub/internal/ent/project"
 	"github.com/looplj/axonhub/internal/ent/prompt"
@@ -45,6 +48,9 @@ type ProjectQuery struct {
 	withTraces                      *TraceQuery
 	withPrompts                     *PromptQuery
 	withAPIKeyProfileTemplates      *APIKeyProfileTemplateQuery
+	withBudgets                     *BudgetQuery
+	withInvoices                    *InvoiceQuery
+	withDataExports                 *DataExportQuery
 	withProjectUsers                *UserProjectQuery
 	loadTotal                       []func(context.Context, []*Project) error
 	modifiers                       []func(*sql.Selector)
@@ -58,6 +64,9 @@ type ProjectQuery struct {
 	withNamedTraces                 map[string]*TraceQuery
 	withNamedPrompts                map[string]*PromptQuery
 	withNamedAPIKeyProfileTemplates map[string]*APIKeyProfileTemplateQuery
+	withNamedBudgets                map[string]*BudgetQuery
+	withNamedInvoices               map[string]*InvoiceQuery
+	withNamedDataExports            map[string]*DataExportQuery
 	withNamedProjectUsers           map[string]*UserProjectQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -315,6 +324,72 @@ func (_q *ProjectQuery) QueryAPIKeyProfileTemplates() *APIKeyProfileTemplateQuer
 	return query
 }
 
+// QueryBudgets chains the current query on the "budgets" edge.
+func (_q *ProjectQuery) QueryBudgets() *BudgetQuery {
+	query := (&BudgetClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.BudgetsTable, project.BudgetsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvoices chains the current query on the "invoices" edge.
+func (_q *ProjectQuery) QueryInvoices() *InvoiceQuery {
+	query := (&InvoiceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.InvoicesTable, project.InvoicesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDataExports chains the current query on the "data_exports" edge.
+func (_q *ProjectQuery) QueryDataExports() *DataExportQuery {
+	query := (&DataExportClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(dataexport.Table, dataexport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.DataExportsTable, project.DataExportsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryProjectUsers chains the current query on the "project_users" edge.
 func (_q *ProjectQuery) QueryProjectUsers() *UserProjectQuery {
 	query := (&UserProjectClient{config: _q.config}).Query()
@@ -539,6 +614,9 @@ func (_q *ProjectQuery) Clone() *ProjectQuery {
 		withTraces:                 _q.withTraces.Clone(),
 		withPrompts:                _q.withPrompts.Clone(),
 		withAPIKeyProfileTemplates: _q.withAPIKeyProfileTemplates.Clone(),
+		withBudgets:                _q.withBudgets.Clone(),
+		withInvoices:               _q.withInvoices.Clone(),
+		withDataExports:            _q.withDataExports.Clone(),
 		withProjectUsers:           _q.withProjectUsers.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
@@ -657,6 +735,39 @@ func (_q *ProjectQuery) WithAPIKeyProfileTemplates(opts ...func(*APIKeyProfileTe
 	return _q
 }
 
+// WithBudgets tells the query-builder to eager-load the nodes that are connected to
+// the "budgets" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProjectQuery) WithBudgets(opts ...func(*BudgetQuery)) *ProjectQuery {
+	query := (&BudgetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBudgets = query
+	return _q
+}
+
+// WithInvoices tells the query-builder to eager-load the nodes that are connected to
+// the "invoices" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProjectQuery) WithInvoices(opts ...func(*InvoiceQuery)) *ProjectQuery {
+	query := (&InvoiceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withInvoices = query
+	return _q
+}
+
+// WithDataExports tells the query-builder to eager-load the nodes that are connected to
+// the "data_exports" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProjectQuery) WithDataExports(opts ...func(*DataExportQuery)) *ProjectQuery {
+	query := (&DataExportClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDataExports = query
+	return _q
+}
+
 // WithProjectUsers tells the query-builder to eager-load the nodes that are connected to
 // the "project_users" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *ProjectQuery) WithProjectUsers(opts ...func(*UserProjectQuery)) *ProjectQuery {
@@ -752,7 +863,7 @@ func (_q *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 	var (
 		nodes       = []*Project{}
 		_spec       = _q.querySpec()
-		loadedTypes = [11]bool{
+		loadedTypes = [14]bool{
 			_q.withUsers != nil,
 			_q.withInvitations != nil,
 			_q.withRoles != nil,
@@ -763,6 +874,9 @@ func (_q *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 			_q.withTraces != nil,
 			_q.withPrompts != nil,
 			_q.withAPIKeyProfileTemplates != nil,
+			_q.withBudgets != nil,
+			_q.withInvoices != nil,
+			_q.withDataExports != nil,
 			_q.withProjectUsers != nil,
 		}
 	)
@@ -859,6 +973,27 @@ func (_q *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 			return nil, err
 		}
 	}
+	if query := _q.withBudgets; query != nil {
+		if err := _q.loadBudgets(ctx, query, nodes,
+			func(n *Project) { n.Edges.Budgets = []*Budget{} },
+			func(n *Project, e *Budget) { n.Edges.Budgets = append(n.Edges.Budgets, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withInvoices; query != nil {
+		if err := _q.loadInvoices(ctx, query, nodes,
+			func(n *Project) { n.Edges.Invoices = []*Invoice{} },
+			func(n *Project, e *Invoice) { n.Edges.Invoices = append(n.Edges.Invoices, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDataExports; query != nil {
+		if err := _q.loadDataExports(ctx, query, nodes,
+			func(n *Project) { n.Edges.DataExports = []*DataExport{} },
+			func(n *Project, e *DataExport) { n.Edges.DataExports = append(n.Edges.DataExports, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withProjectUsers; query != nil {
 		if err := _q.loadProjectUsers(ctx, query, nodes,
 			func(n *Project) { n.Edges.ProjectUsers = []*UserProject{} },
@@ -936,6 +1071,27 @@ func (_q *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 			return nil, err
 		}
 	}
+	for name, query := range _q.withNamedBudgets {
+		if err := _q.loadBudgets(ctx, query, nodes,
+			func(n *Project) { n.appendNamedBudgets(name) },
+			func(n *Project, e *Budget) { n.appendNamedBudgets(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedInvoices {
+		if err := _q.loadInvoices(ctx, query, nodes,
+			func(n *Project) { n.appendNamedInvoices(name) },
+			func(n *Project, e *Invoice) { n.appendNamedInvoices(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedDataExports {
+		if err := _q.loadDataExports(ctx, query, nodes,
+			func(n *Project) { n.appendNamedDataExports(name) },
+			func(n *Project, e *DataExport) { n.appendNamedDataExports(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range _q.withNamedProjectUsers {
 		if err := _q.loadProjectUsers(ctx, query, nodes,
 			func(n *Project) { n.appendNamedProjectUsers(name) },
@@ -1285,6 +1441,96 @@ func (_q *ProjectQuery) loadAPIKeyProfileTemplates(ctx context.Context, query *A
 	}
 	return nil
 }
+func (_q *ProjectQuery) loadBudgets(ctx context.Context, query *BudgetQuery, nodes []*Project, init func(*Project), assign func(*Project, *Budget)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(budget.FieldProjectID)
+	}
+	query.Where(predicate.Budget(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.BudgetsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ProjectQuery) loadInvoices(ctx context.Context, query *InvoiceQuery, nodes []*Project, init func(*Project), assign func(*Project, *Invoice)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(invoice.FieldProjectID)
+	}
+	query.Where(predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.InvoicesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ProjectQuery) loadDataExports(ctx context.Context, query *DataExportQuery, nodes []*Project, init func(*Project), assign func(*Project, *DataExport)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(dataexport.FieldProjectID)
+	}
+	query.Where(predicate.DataExport(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.DataExportsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *ProjectQuery) loadProjectUsers(ctx context.Context, query *UserProjectQuery, nodes []*Project, init func(*Project), assign func(*Project, *UserProject)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Project)
@@ -1549,6 +1795,48 @@ func (_q *ProjectQuery) WithNamedAPIKeyProfileTemplates(name string, opts ...fun
 	return _q
 }
 
+// WithNamedBudgets tells the query-builder to eager-load the nodes that are connected to the "budgets"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProjectQuery) WithNamedBudgets(name string, opts ...func(*BudgetQuery)) *ProjectQuery {
+	query := (&BudgetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedBudgets == nil {
+		_q.withNamedBudgets = make(map[string]*BudgetQuery)
+	}
+	_q.withNamedBudgets[name] = query
+	return _q
+}
+
+// WithNamedInvoices tells the query-builder to eager-load the nodes that are connected to the "invoices"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProjectQuery) WithNamedInvoices(name string, opts ...func(*InvoiceQuery)) *ProjectQuery {
+	query := (&InvoiceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedInvoices == nil {
+		_q.withNamedInvoices = make(map[string]*InvoiceQuery)
+	}
+	_q.withNamedInvoices[name] = query
+	return _q
+}
+
+// WithNamedDataExports tells the query-builder to eager-load the nodes that are connected to the "data_exports"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProjectQuery) WithNamedDataExports(name string, opts ...func(*DataExportQuery)) *ProjectQuery {
+	query := (&DataExportClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedDataExports == nil {
+		_q.withNamedDataExports = make(map[string]*DataExportQuery)
+	}
+	_q.withNamedDataExports[name] = query
+	return _q
+}
+
 // WithNamedProjectUsers tells the query-builder to eager-load the nodes that are connected to the "project_users"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (_q *ProjectQuery) WithNamedProjectUsers(name string, opts ...func(*UserProjectQuery)) *ProjectQuery {
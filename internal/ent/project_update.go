@@ -13,7 +13,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/apikeyprofiletemplate"
+	"github.com/looplj/axonhub/internal/ent/budget"
+	"github.com/looplj/axonhub/internal/ent/dataexport"
 	"github.com/looplj/axonhub/internal/ent/invitation"
+	"github.com/looplj/axonhub/internal/ent/invoice"
 	"github.com/looplj/axonhub/internal/ent/predicate"
 	"github.com/looplj/axonhub/internal/ent/project"
 	"github.com/looplj/axonhub/internal/ent/prompt"
@@ -122,6 +125,42 @@ func (_u *ProjectUpdate) ClearProfiles() *ProjectUpdate {
 	return _u
 }
 
+// SetModerationSettings sets the "moderation_settings" field.
+func (_u *ProjectUpdate) SetModerationSettings(v *objects.ProjectModerationSettings) *ProjectUpdate {
+	_u.mutation.SetModerationSettings(v)
+	return _u
+}
+
+// ClearModerationSettings clears the value of the "moderation_settings" field.
+func (_u *ProjectUpdate) ClearModerationSettings() *ProjectUpdate {
+	_u.mutation.ClearModerationSettings()
+	return _u
+}
+
+// SetBillingSettings sets the "billing_settings" field.
+func (_u *ProjectUpdate) SetBillingSettings(v *objects.ProjectBillingSettings) *ProjectUpdate {
+	_u.mutation.SetBillingSettings(v)
+	return _u
+}
+
+// ClearBillingSettings clears the value of the "billing_settings" field.
+func (_u *ProjectUpdate) ClearBillingSettings() *ProjectUpdate {
+	_u.mutation.ClearBillingSettings()
+	return _u
+}
+
+// SetDataPolicy sets the "data_policy" field.
+func (_u *ProjectUpdate) SetDataPolicy(v *objects.ProjectDataPolicy) *ProjectUpdate {
+	_u.mutation.SetDataPolicy(v)
+	return _u
+}
+
+// ClearDataPolicy clears the value of the "data_policy" field.
+func (_u *ProjectUpdate) ClearDataPolicy() *ProjectUpdate {
+	_u.mutation.ClearDataPolicy()
+	return _u
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (_u *ProjectUpdate) AddUserIDs(ids ...int) *ProjectUpdate {
 	_u.mutation.AddUserIDs(ids...)
@@ -272,6 +311,51 @@ func (_u *ProjectUpdate) AddAPIKeyProfileTemplates(v ...*APIKeyProfileTemplate)
 	return _u.AddAPIKeyProfileTemplateIDs(ids...)
 }
 
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by IDs.
+func (_u *ProjectUpdate) AddBudgetIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.AddBudgetIDs(ids...)
+	return _u
+}
+
+// AddBudgets adds the "budgets" edges to the Budget entity.
+func (_u *ProjectUpdate) AddBudgets(v ...*Budget) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBudgetIDs(ids...)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (_u *ProjectUpdate) AddInvoiceIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.AddInvoiceIDs(ids...)
+	return _u
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (_u *ProjectUpdate) AddInvoices(v ...*Invoice) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvoiceIDs(ids...)
+}
+
+// AddDataExportIDs adds the "data_exports" edge to the DataExport entity by IDs.
+func (_u *ProjectUpdate) AddDataExportIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.AddDataExportIDs(ids...)
+	return _u
+}
+
+// AddDataExports adds the "data_exports" edges to the DataExport entity.
+func (_u *ProjectUpdate) AddDataExports(v ...*DataExport) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDataExportIDs(ids...)
+}
+
 // AddProjectUserIDs adds the "project_users" edge to the UserProject entity by IDs.
 func (_u *ProjectUpdate) AddProjectUserIDs(ids ...int) *ProjectUpdate {
 	_u.mutation.AddProjectUserIDs(ids...)
@@ -502,6 +586,69 @@ func (_u *ProjectUpdate) RemoveAPIKeyProfileTemplates(v ...*APIKeyProfileTemplat
 	return _u.RemoveAPIKeyProfileTemplateIDs(ids...)
 }
 
+// ClearBudgets clears all "budgets" edges to the Budget entity.
+func (_u *ProjectUpdate) ClearBudgets() *ProjectUpdate {
+	_u.mutation.ClearBudgets()
+	return _u
+}
+
+// RemoveBudgetIDs removes the "budgets" edge to Budget entities by IDs.
+func (_u *ProjectUpdate) RemoveBudgetIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.RemoveBudgetIDs(ids...)
+	return _u
+}
+
+// RemoveBudgets removes "budgets" edges to Budget entities.
+func (_u *ProjectUpdate) RemoveBudgets(v ...*Budget) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBudgetIDs(ids...)
+}
+
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (_u *ProjectUpdate) ClearInvoices() *ProjectUpdate {
+	_u.mutation.ClearInvoices()
+	return _u
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (_u *ProjectUpdate) RemoveInvoiceIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.RemoveInvoiceIDs(ids...)
+	return _u
+}
+
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (_u *ProjectUpdate) RemoveInvoices(v ...*Invoice) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvoiceIDs(ids...)
+}
+
+// ClearDataExports clears all "data_exports" edges to the DataExport entity.
+func (_u *ProjectUpdate) ClearDataExports() *ProjectUpdate {
+	_u.mutation.ClearDataExports()
+	return _u
+}
+
+// RemoveDataExportIDs removes the "data_exports" edge to DataExport entities by IDs.
+func (_u *ProjectUpdate) RemoveDataExportIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.RemoveDataExportIDs(ids...)
+	return _u
+}
+
+// RemoveDataExports removes "data_exports" edges to DataExport entities.
+func (_u *ProjectUpdate) RemoveDataExports(v ...*DataExport) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDataExportIDs(ids...)
+}
+
 // ClearProjectUsers clears all "project_users" edges to the UserProject entity.
 func (_u *ProjectUpdate) ClearProjectUsers() *ProjectUpdate {
 	_u.mutation.ClearProjectUsers()
@@ -617,6 +764,24 @@ func (_u *ProjectUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ProfilesCleared() {
 		_spec.ClearField(project.FieldProfiles, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ModerationSettings(); ok {
+		_spec.SetField(project.FieldModerationSettings, field.TypeJSON, value)
+	}
+	if _u.mutation.ModerationSettingsCleared() {
+		_spec.ClearField(project.FieldModerationSettings, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.BillingSettings(); ok {
+		_spec.SetField(project.FieldBillingSettings, field.TypeJSON, value)
+	}
+	if _u.mutation.BillingSettingsCleared() {
+		_spec.ClearField(project.FieldBillingSettings, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DataPolicy(); ok {
+		_spec.SetField(project.FieldDataPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.DataPolicyCleared() {
+		_spec.ClearField(project.FieldDataPolicy, field.TypeJSON)
+	}
 	if _u.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1079,6 +1244,141 @@ func (_u *ProjectUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BudgetsTable,
+			Columns: []string{project.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBudgetsIDs(); len(nodes) > 0 && !_u.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BudgetsTable,
+			Columns: []string{project.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BudgetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BudgetsTable,
+			Columns: []string{project.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.InvoicesTable,
+			Columns: []string{project.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !_u.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.InvoicesTable,
+			Columns: []string{project.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.InvoicesTable,
+			Columns: []string{project.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DataExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DataExportsTable,
+			Columns: []string{project.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDataExportsIDs(); len(nodes) > 0 && !_u.mutation.DataExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DataExportsTable,
+			Columns: []string{project.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DataExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DataExportsTable,
+			Columns: []string{project.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ProjectUsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1227,6 +1527,42 @@ func (_u *ProjectUpdateOne) ClearProfiles() *ProjectUpdateOne {
 	return _u
 }
 
+// SetModerationSettings sets the "moderation_settings" field.
+func (_u *ProjectUpdateOne) SetModerationSettings(v *objects.ProjectModerationSettings) *ProjectUpdateOne {
+	_u.mutation.SetModerationSettings(v)
+	return _u
+}
+
+// ClearModerationSettings clears the value of the "moderation_settings" field.
+func (_u *ProjectUpdateOne) ClearModerationSettings() *ProjectUpdateOne {
+	_u.mutation.ClearModerationSettings()
+	return _u
+}
+
+// SetBillingSettings sets the "billing_settings" field.
+func (_u *ProjectUpdateOne) SetBillingSettings(v *objects.ProjectBillingSettings) *ProjectUpdateOne {
+	_u.mutation.SetBillingSettings(v)
+	return _u
+}
+
+// ClearBillingSettings clears the value of the "billing_settings" field.
+func (_u *ProjectUpdateOne) ClearBillingSettings() *ProjectUpdateOne {
+	_u.mutation.ClearBillingSettings()
+	return _u
+}
+
+// SetDataPolicy sets the "data_policy" field.
+func (_u *ProjectUpdateOne) SetDataPolicy(v *objects.ProjectDataPolicy) *ProjectUpdateOne {
+	_u.mutation.SetDataPolicy(v)
+	return _u
+}
+
+// ClearDataPolicy clears the value of the "data_policy" field.
+func (_u *ProjectUpdateOne) ClearDataPolicy() *ProjectUpdateOne {
+	_u.mutation.ClearDataPolicy()
+	return _u
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (_u *ProjectUpdateOne) AddUserIDs(ids ...int) *ProjectUpdateOne {
 	_u.mutation.AddUserIDs(ids...)
@@ -1377,6 +1713,51 @@ func (_u *ProjectUpdateOne) AddAPIKeyProfileTemplates(v ...*APIKeyProfileTemplat
 	return _u.AddAPIKeyProfileTemplateIDs(ids...)
 }
 
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by IDs.
+func (_u *ProjectUpdateOne) AddBudgetIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.AddBudgetIDs(ids...)
+	return _u
+}
+
+// AddBudgets adds the "budgets" edges to the Budget entity.
+func (_u *ProjectUpdateOne) AddBudgets(v ...*Budget) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBudgetIDs(ids...)
+}
+
+// AddInvoiceIDs adds the "invoices" edge to the Invoice entity by IDs.
+func (_u *ProjectUpdateOne) AddInvoiceIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.AddInvoiceIDs(ids...)
+	return _u
+}
+
+// AddInvoices adds the "invoices" edges to the Invoice entity.
+func (_u *ProjectUpdateOne) AddInvoices(v ...*Invoice) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInvoiceIDs(ids...)
+}
+
+// AddDataExportIDs adds the "data_exports" edge to the DataExport entity by IDs.
+func (_u *ProjectUpdateOne) AddDataExportIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.AddDataExportIDs(ids...)
+	return _u
+}
+
+// AddDataExports adds the "data_exports" edges to the DataExport entity.
+func (_u *ProjectUpdateOne) AddDataExports(v ...*DataExport) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDataExportIDs(ids...)
+}
+
 // AddProjectUserIDs adds the "project_users" edge to the UserProject entity by IDs.
 func (_u *ProjectUpdateOne) AddProjectUserIDs(ids ...int) *ProjectUpdateOne {
 	_u.mutation.AddProjectUserIDs(ids...)
@@ -1607,6 +1988,69 @@ func (_u *ProjectUpdateOne) RemoveAPIKeyProfileTemplates(v ...*APIKeyProfileTemp
 	return _u.RemoveAPIKeyProfileTemplateIDs(ids...)
 }
 
+// ClearBudgets clears all "budgets" edges to the Budget entity.
+func (_u *ProjectUpdateOne) ClearBudgets() *ProjectUpdateOne {
+	_u.mutation.ClearBudgets()
+	return _u
+}
+
+// RemoveBudgetIDs removes the "budgets" edge to Budget entities by IDs.
+func (_u *ProjectUpdateOne) RemoveBudgetIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.RemoveBudgetIDs(ids...)
+	return _u
+}
+
+// RemoveBudgets removes "budgets" edges to Budget entities.
+func (_u *ProjectUpdateOne) RemoveBudgets(v ...*Budget) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBudgetIDs(ids...)
+}
+
+// ClearInvoices clears all "invoices" edges to the Invoice entity.
+func (_u *ProjectUpdateOne) ClearInvoices() *ProjectUpdateOne {
+	_u.mutation.ClearInvoices()
+	return _u
+}
+
+// RemoveInvoiceIDs removes the "invoices" edge to Invoice entities by IDs.
+func (_u *ProjectUpdateOne) RemoveInvoiceIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.RemoveInvoiceIDs(ids...)
+	return _u
+}
+
+// RemoveInvoices removes "invoices" edges to Invoice entities.
+func (_u *ProjectUpdateOne) RemoveInvoices(v ...*Invoice) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInvoiceIDs(ids...)
+}
+
+// ClearDataExports clears all "data_exports" edges to the DataExport entity.
+func (_u *ProjectUpdateOne) ClearDataExports() *ProjectUpdateOne {
+	_u.mutation.ClearDataExports()
+	return _u
+}
+
+// RemoveDataExportIDs removes the "data_exports" edge to DataExport entities by IDs.
+func (_u *ProjectUpdateOne) RemoveDataExportIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.RemoveDataExportIDs(ids...)
+	return _u
+}
+
+// RemoveDataExports removes "data_exports" edges to DataExport entities.
+func (_u *ProjectUpdateOne) RemoveDataExports(v ...*DataExport) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDataExportIDs(ids...)
+}
+
 // ClearProjectUsers clears all "project_users" edges to the UserProject entity.
 func (_u *ProjectUpdateOne) ClearProjectUsers() *ProjectUpdateOne {
 	_u.mutation.ClearProjectUsers()
@@ -1752,6 +2196,24 @@ func (_u *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err er
 	if _u.mutation.ProfilesCleared() {
 		_spec.ClearField(project.FieldProfiles, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ModerationSettings(); ok {
+		_spec.SetField(project.FieldModerationSettings, field.TypeJSON, value)
+	}
+	if _u.mutation.ModerationSettingsCleared() {
+		_spec.ClearField(project.FieldModerationSettings, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.BillingSettings(); ok {
+		_spec.SetField(project.FieldBillingSettings, field.TypeJSON, value)
+	}
+	if _u.mutation.BillingSettingsCleared() {
+		_spec.ClearField(project.FieldBillingSettings, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DataPolicy(); ok {
+		_spec.SetField(project.FieldDataPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.DataPolicyCleared() {
+		_spec.ClearField(project.FieldDataPolicy, field.TypeJSON)
+	}
 	if _u.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -2214,6 +2676,141 @@ func (_u *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BudgetsTable,
+			Columns: []string{project.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBudgetsIDs(); len(nodes) > 0 && !_u.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BudgetsTable,
+			Columns: []string{project.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BudgetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BudgetsTable,
+			Columns: []string{project.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.InvoicesTable,
+			Columns: []string{project.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvoicesIDs(); len(nodes) > 0 && !_u.mutation.InvoicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.InvoicesTable,
+			Columns: []string{project.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvoicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.InvoicesTable,
+			Columns: []string{project.InvoicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DataExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DataExportsTable,
+			Columns: []string{project.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDataExportsIDs(); len(nodes) > 0 && !_u.mutation.DataExportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DataExportsTable,
+			Columns: []string{project.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DataExportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.DataExportsTable,
+			Columns: []string{project.DataExportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dataexport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ProjectUsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
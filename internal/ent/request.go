@@ -48,12 +48,16 @@ type Request struct {
 	RequestHeaders objects.JSONRawMessage `json:"request_headers,omitempty"`
 	// RequestBody holds the value of the "request_body" field.
 	RequestBody objects.JSONRawMessage `json:"request_body,omitempty"`
+	// RequestBodyBlobs holds the value of the "request_body_blobs" field.
+	RequestBodyBlobs bool `json:"request_body_blobs,omitempty"`
 	// ResponseBody holds the value of the "response_body" field.
 	ResponseBody objects.JSONRawMessage `json:"response_body,omitempty"`
 	// ResponseChunks holds the value of the "response_chunks" field.
 	ResponseChunks []objects.JSONRawMessage `json:"response_chunks,omitempty"`
 	// ChannelID holds the value of the "channel_id" field.
 	ChannelID int `json:"channel_id,omitempty"`
+	// End user identifier of the request
+	EndUserID string `json:"end_user_id,omitempty"`
 	// ExternalID holds the value of the "external_id" field.
 	ExternalID string `json:"external_id,omitempty"`
 	// Status holds the value of the "status" field.
@@ -76,6 +80,14 @@ type Request struct {
 	ContentStorageKey *string `json:"content_storage_key,omitempty"`
 	// when the content file was saved
 	ContentSavedAt *time.Time `json:"content_saved_at,omitempty"`
+	// moderation guardrail verdicts of the request
+	ModerationVerdicts []objects.ModerationVerdict `json:"moderation_verdicts,omitempty"`
+	// when the request contents were archived
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// data storage id of the archive object
+	ArchiveStorageID *int `json:"archive_storage_id,omitempty"`
+	// key of the archive object with the request contents
+	ArchiveKey *string `json:"archive_key,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RequestQuery when eager-loading is set.
 	Edges        RequestEdges `json:"edges"`
@@ -186,15 +198,15 @@ func (*Request) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case request.FieldRequestHeaders, request.FieldRequestBody, request.FieldResponseBody, request.FieldResponseChunks:
+		case request.FieldRequestHeaders, request.FieldRequestBody, request.FieldResponseBody, request.FieldResponseChunks, request.FieldModerationVerdicts:
 			values[i] = new([]byte)
-		case request.FieldStream, request.FieldContentSaved:
+		case request.FieldRequestBodyBlobs, request.FieldStream, request.FieldContentSaved:
 			values[i] = new(sql.NullBool)
-		case request.FieldID, request.FieldAPIKeyID, request.FieldProjectID, request.FieldTraceID, request.FieldDataStorageID, request.FieldChannelID, request.FieldMetricsLatencyMs, request.FieldMetricsFirstTokenLatencyMs, request.FieldMetricsReasoningDurationMs, request.FieldContentStorageID:
+		case request.FieldID, request.FieldAPIKeyID, request.FieldProjectID, request.FieldTraceID, request.FieldDataStorageID, request.FieldChannelID, request.FieldMetricsLatencyMs, request.FieldMetricsFirstTokenLatencyMs, request.FieldMetricsReasoningDurationMs, request.FieldContentStorageID, request.FieldArchiveStorageID:
 			values[i] = new(sql.NullInt64)
-		case request.FieldSource, request.FieldModelID, request.FieldReasoningEffort, request.FieldFormat, request.FieldExternalID, request.FieldStatus, request.FieldClientIP, request.FieldContentStorageKey:
+		case request.FieldSource, request.FieldModelID, request.FieldReasoningEffort, request.FieldFormat, request.FieldEndUserID, request.FieldExternalID, request.FieldStatus, request.FieldClientIP, request.FieldContentStorageKey, request.FieldArchiveKey:
 			values[i] = new(sql.NullString)
-		case request.FieldCreatedAt, request.FieldUpdatedAt, request.FieldContentSavedAt:
+		case request.FieldCreatedAt, request.FieldUpdatedAt, request.FieldContentSavedAt, request.FieldArchivedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -293,6 +305,12 @@ func (_m *Request) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field request_body: %w", err)
 				}
 			}
+		case request.FieldRequestBodyBlobs:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field request_body_blobs", values[i])
+			} else if value.Valid {
+				_m.RequestBodyBlobs = value.Bool
+			}
 		case request.FieldResponseBody:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field response_body", values[i])
@@ -315,6 +333,12 @@ func (_m *Request) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ChannelID = int(value.Int64)
 			}
+		case request.FieldEndUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end_user_id", values[i])
+			} else if value.Valid {
+				_m.EndUserID = value.String
+			}
 		case request.FieldExternalID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field external_id", values[i])
@@ -387,6 +411,35 @@ func (_m *Request) assignValues(columns []string, values []any) error {
 				_m.ContentSavedAt = new(time.Time)
 				*_m.ContentSavedAt = value.Time
 			}
+		case request.FieldModerationVerdicts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field moderation_verdicts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ModerationVerdicts); err != nil {
+					return fmt.Errorf("unmarshal field moderation_verdicts: %w", err)
+				}
+			}
+		case request.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				_m.ArchivedAt = new(time.Time)
+				*_m.ArchivedAt = value.Time
+			}
+		case request.FieldArchiveStorageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field archive_storage_id", values[i])
+			} else if value.Valid {
+				_m.ArchiveStorageID = new(int)
+				*_m.ArchiveStorageID = int(value.Int64)
+			}
+		case request.FieldArchiveKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field archive_key", values[i])
+			} else if value.Valid {
+				_m.ArchiveKey = new(string)
+				*_m.ArchiveKey = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -494,6 +547,9 @@ func (_m *Request) String() string {
 	builder.WriteString("request_body=")
 	builder.WriteString(fmt.Sprintf("%v", _m.RequestBody))
 	builder.WriteString(", ")
+	builder.WriteString("request_body_blobs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequestBodyBlobs))
+	builder.WriteString(", ")
 	builder.WriteString("response_body=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ResponseBody))
 	builder.WriteString(", ")
@@ -503,6 +559,9 @@ func (_m *Request) String() string {
 	builder.WriteString("channel_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ChannelID))
 	builder.WriteString(", ")
+	builder.WriteString("end_user_id=")
+	builder.WriteString(_m.EndUserID)
+	builder.WriteString(", ")
 	builder.WriteString("external_id=")
 	builder.WriteString(_m.ExternalID)
 	builder.WriteString(", ")
@@ -547,6 +606,24 @@ func (_m *Request) String() string {
 		builder.WriteString("content_saved_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("moderation_verdicts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModerationVerdicts))
+	builder.WriteString(", ")
+	if v := _m.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ArchiveStorageID; v != nil {
+		builder.WriteString("archive_storage_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ArchiveKey; v != nil {
+		builder.WriteString("archive_key=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
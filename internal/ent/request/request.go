@@ -42,12 +42,16 @@ const (
 	FieldRequestHeaders = "request_headers"
 	// FieldRequestBody holds the string denoting the request_body field in the database.
 	FieldRequestBody = "request_body"
+	// FieldRequestBodyBlobs holds the string denoting the request_body_blobs field in the database.
+	FieldRequestBodyBlobs = "request_body_blobs"
 	// FieldResponseBody holds the string denoting the response_body field in the database.
 	FieldResponseBody = "response_body"
 	// FieldResponseChunks holds the string denoting the response_chunks field in the database.
 	FieldResponseChunks = "response_chunks"
 	// FieldChannelID holds the string denoting the channel_id field in the database.
 	FieldChannelID = "channel_id"
+	// FieldEndUserID holds the string denoting the end_user_id field in the database.
+	FieldEndUserID = "end_user_id"
 	// FieldExternalID holds the string denoting the external_id field in the database.
 	FieldExternalID = "external_id"
 	// FieldStatus holds the string denoting the status field in the database.
@@ -70,6 +74,14 @@ const (
 	FieldContentStorageKey = "content_storage_key"
 	// FieldContentSavedAt holds the string denoting the content_saved_at field in the database.
 	FieldContentSavedAt = "content_saved_at"
+	// FieldModerationVerdicts holds the string denoting the moderation_verdicts field in the database.
+	FieldModerationVerdicts = "moderation_verdicts"
+	// FieldArchivedAt holds the string denoting the archived_at field in the database.
+	FieldArchivedAt = "archived_at"
+	// FieldArchiveStorageID holds the string denoting the archive_storage_id field in the database.
+	FieldArchiveStorageID = "archive_storage_id"
+	// FieldArchiveKey holds the string denoting the archive_key field in the database.
+	FieldArchiveKey = "archive_key"
 	// EdgeAPIKey holds the string denoting the api_key edge name in mutations.
 	EdgeAPIKey = "api_key"
 	// EdgeProject holds the string denoting the project edge name in mutations.
@@ -152,9 +164,11 @@ var Columns = []string{
 	FieldFormat,
 	FieldRequestHeaders,
 	FieldRequestBody,
+	FieldRequestBodyBlobs,
 	FieldResponseBody,
 	FieldResponseChunks,
 	FieldChannelID,
+	FieldEndUserID,
 	FieldExternalID,
 	FieldStatus,
 	FieldStream,
@@ -166,6 +180,10 @@ var Columns = []string{
 	FieldContentStorageID,
 	FieldContentStorageKey,
 	FieldContentSavedAt,
+	FieldModerationVerdicts,
+	FieldArchivedAt,
+	FieldArchiveStorageID,
+	FieldArchiveKey,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -196,6 +214,10 @@ var (
 	DefaultProjectID int
 	// DefaultFormat holds the default value on creation for the "format" field.
 	DefaultFormat string
+	// DefaultRequestBodyBlobs holds the default value on creation for the "request_body_blobs" field.
+	DefaultRequestBodyBlobs bool
+	// EndUserIDValidator is a validator for the "end_user_id" field. It is called by the builders before save.
+	EndUserIDValidator func(string) error
 	// ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
 	ExternalIDValidator func(string) error
 	// DefaultStream holds the default value on creation for the "stream" field.
@@ -317,11 +339,21 @@ func ByFormat(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFormat, opts...).ToFunc()
 }
 
+// ByRequestBodyBlobs orders the results by the request_body_blobs field.
+func ByRequestBodyBlobs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestBodyBlobs, opts...).ToFunc()
+}
+
 // ByChannelID orders the results by the channel_id field.
 func ByChannelID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldChannelID, opts...).ToFunc()
 }
 
+// ByEndUserID orders the results by the end_user_id field.
+func ByEndUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndUserID, opts...).ToFunc()
+}
+
 // ByExternalID orders the results by the external_id field.
 func ByExternalID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExternalID, opts...).ToFunc()
@@ -377,6 +409,21 @@ func ByContentSavedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContentSavedAt, opts...).ToFunc()
 }
 
+// ByArchivedAt orders the results by the archived_at field.
+func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
+}
+
+// ByArchiveStorageID orders the results by the archive_storage_id field.
+func ByArchiveStorageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchiveStorageID, opts...).ToFunc()
+}
+
+// ByArchiveKey orders the results by the archive_key field.
+func ByArchiveKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchiveKey, opts...).ToFunc()
+}
+
 // ByAPIKeyField orders the results by api_key field.
 func ByAPIKeyField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -100,11 +100,21 @@ func Format(v string) predicate.Request {
 	return predicate.Request(sql.FieldEQ(FieldFormat, v))
 }
 
+// RequestBodyBlobs applies equality check predicate on the "request_body_blobs" field. It's identical to RequestBodyBlobsEQ.
+func RequestBodyBlobs(v bool) predicate.Request {
+	return predicate.Request(sql.FieldEQ(FieldRequestBodyBlobs, v))
+}
+
 // ChannelID applies equality check predicate on the "channel_id" field. It's identical to ChannelIDEQ.
 func ChannelID(v int) predicate.Request {
 	return predicate.Request(sql.FieldEQ(FieldChannelID, v))
 }
 
+// EndUserID applies equality check predicate on the "end_user_id" field. It's identical to EndUserIDEQ.
+func EndUserID(v string) predicate.Request {
+	return predicate.Request(sql.FieldEQ(FieldEndUserID, v))
+}
+
 // ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
 func ExternalID(v string) predicate.Request {
 	return predicate.Request(sql.FieldEQ(FieldExternalID, v))
@@ -155,6 +165,21 @@ func ContentSavedAt(v time.Time) predicate.Request {
 	return predicate.Request(sql.FieldEQ(FieldContentSavedAt, v))
 }
 
+// ArchivedAt applies equality check predicate on the "archived_at" field. It's identical to ArchivedAtEQ.
+func ArchivedAt(v time.Time) predicate.Request {
+	return predicate.Request(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// ArchiveStorageID applies equality check predicate on the "archive_storage_id" field. It's identical to ArchiveStorageIDEQ.
+func ArchiveStorageID(v int) predicate.Request {
+	return predicate.Request(sql.FieldEQ(FieldArchiveStorageID, v))
+}
+
+// ArchiveKey applies equality check predicate on the "archive_key" field. It's identical to ArchiveKeyEQ.
+func ArchiveKey(v string) predicate.Request {
+	return predicate.Request(sql.FieldEQ(FieldArchiveKey, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Request {
 	return predicate.Request(sql.FieldEQ(FieldCreatedAt, v))
@@ -580,6 +605,16 @@ func RequestHeadersNotNil() predicate.Request {
 	return predicate.Request(sql.FieldNotNull(FieldRequestHeaders))
 }
 
+// RequestBodyBlobsEQ applies the EQ predicate on the "request_body_blobs" field.
+func RequestBodyBlobsEQ(v bool) predicate.Request {
+	return predicate.Request(sql.FieldEQ(FieldRequestBodyBlobs, v))
+}
+
+// RequestBodyBlobsNEQ applies the NEQ predicate on the "request_body_blobs" field.
+func RequestBodyBlobsNEQ(v bool) predicate.Request {
+	return predicate.Request(sql.FieldNEQ(FieldRequestBodyBlobs, v))
+}
+
 // ResponseBodyIsNil applies the IsNil predicate on the "response_body" field.
 func ResponseBodyIsNil() predicate.Request {
 	return predicate.Request(sql.FieldIsNull(FieldResponseBody))
@@ -630,6 +665,81 @@ func ChannelIDNotNil() predicate.Request {
 	return predicate.Request(sql.FieldNotNull(FieldChannelID))
 }
 
+// EndUserIDEQ applies the EQ predicate on the "end_user_id" field.
+func EndUserIDEQ(v string) predicate.Request {
+	return predicate.Request(sql.FieldEQ(FieldEndUserID, v))
+}
+
+// EndUserIDNEQ applies the NEQ predicate on the "end_user_id" field.
+func EndUserIDNEQ(v string) predicate.Request {
+	return predicate.Request(sql.FieldNEQ(FieldEndUserID, v))
+}
+
+// EndUserIDIn applies the In predicate on the "end_user_id" field.
+func EndUserIDIn(vs ...string) predicate.Request {
+	return predicate.Request(sql.FieldIn(FieldEndUserID, vs...))
+}
+
+// EndUserIDNotIn applies the NotIn predicate on the "end_user_id" field.
+func EndUserIDNotIn(vs ...string) predicate.Request {
+	return predicate.Request(sql.FieldNotIn(FieldEndUserID, vs...))
+}
+
+// EndUserIDGT applies the GT predicate on the "end_user_id" field.
+func EndUserIDGT(v string) predicate.Request {
+	return predicate.Request(sql.FieldGT(FieldEndUserID, v))
+}
+
+// EndUserIDGTE applies the GTE predicate on the "end_user_id" field.
+func EndUserIDGTE(v string) predicate.Request {
+	return predicate.Request(sql.FieldGTE(FieldEndUserID, v))
+}
+
+// EndUserIDLT applies the LT predicate on the "end_user_id" field.
+func EndUserIDLT(v string) predicate.Request {
+	return predicate.Request(sql.FieldLT(FieldEndUserID, v))
+}
+
+// EndUserIDLTE applies the LTE predicate on the "end_user_id" field.
+func EndUserIDLTE(v string) predicate.Request {
+	return predicate.Request(sql.FieldLTE(FieldEndUserID, v))
+}
+
+// EndUserIDContains applies the Contains predicate on the "end_user_id" field.
+func EndUserIDContains(v string) predicate.Request {
+	return predicate.Request(sql.FieldContains(FieldEndUserID, v))
+}
+
+// EndUserIDHasPrefix applies the HasPrefix predicate on the "end_user_id" field.
+func EndUserIDHasPrefix(v string) predicate.Request {
+	return predicate.Request(sql.FieldHasPrefix(FieldEndUserID, v))
+}
+
+// EndUserIDHasSuffix applies the HasSuffix predicate on the "end_user_id" field.
+func EndUserIDHasSuffix(v string) predicate.Request {
+	return predicate.Request(sql.FieldHasSuffix(FieldEndUserID, v))
+}
+
+// EndUserIDIsNil applies the IsNil predicate on the "end_user_id" field.
+func EndUserIDIsNil() predicate.Request {
+	return predicate.Request(sql.FieldIsNull(FieldEndUserID))
+}
+
+// EndUserIDNotNil applies the NotNil predicate on the "end_user_id" field.
+func EndUserIDNotNil() predicate.Request {
+	return predicate.Request(sql.FieldNotNull(FieldEndUserID))
+}
+
+// EndUserIDEqualFold applies the EqualFold predicate on the "end_user_id" field.
+func EndUserIDEqualFold(v string) predicate.Request {
+	return predicate.Request(sql.FieldEqualFold(FieldEndUserID, v))
+}
+
+// EndUserIDContainsFold applies the ContainsFold predicate on the "end_user_id" field.
+func EndUserIDContainsFold(v string) predicate.Request {
+	return predicate.Request(sql.FieldContainsFold(FieldEndUserID, v))
+}
+
 // ExternalIDEQ applies the EQ predicate on the "external_id" field.
 func ExternalIDEQ(v string) predicate.Request {
 	return predicate.Request(sql.FieldEQ(FieldExternalID, v))
@@ -1135,6 +1245,191 @@ func ContentSavedAtNotNil() predicate.Request {
 	return predicate.Request(sql.FieldNotNull(FieldContentSavedAt))
 }
 
+// ModerationVerdictsIsNil applies the IsNil predicate on the "moderation_verdicts" field.
+func ModerationVerdictsIsNil() predicate.Request {
+	return predicate.Request(sql.FieldIsNull(FieldModerationVerdicts))
+}
+
+// ModerationVerdictsNotNil applies the NotNil predicate on the "moderation_verdicts" field.
+func ModerationVerdictsNotNil() predicate.Request {
+	return predicate.Request(sql.FieldNotNull(FieldModerationVerdicts))
+}
+
+// ArchivedAtEQ applies the EQ predicate on the "archived_at" field.
+func ArchivedAtEQ(v time.Time) predicate.Request {
+	return predicate.Request(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtNEQ applies the NEQ predicate on the "archived_at" field.
+func ArchivedAtNEQ(v time.Time) predicate.Request {
+	return predicate.Request(sql.FieldNEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtIn applies the In predicate on the "archived_at" field.
+func ArchivedAtIn(vs ...time.Time) predicate.Request {
+	return predicate.Request(sql.FieldIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtNotIn applies the NotIn predicate on the "archived_at" field.
+func ArchivedAtNotIn(vs ...time.Time) predicate.Request {
+	return predicate.Request(sql.FieldNotIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtGT applies the GT predicate on the "archived_at" field.
+func ArchivedAtGT(v time.Time) predicate.Request {
+	return predicate.Request(sql.FieldGT(FieldArchivedAt, v))
+}
+
+// ArchivedAtGTE applies the GTE predicate on the "archived_at" field.
+func ArchivedAtGTE(v time.Time) predicate.Request {
+	return predicate.Request(sql.FieldGTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtLT applies the LT predicate on the "archived_at" field.
+func ArchivedAtLT(v time.Time) predicate.Request {
+	return predicate.Request(sql.FieldLT(FieldArchivedAt, v))
+}
+
+// ArchivedAtLTE applies the LTE predicate on the "archived_at" field.
+func ArchivedAtLTE(v time.Time) predicate.Request {
+	return predicate.Request(sql.FieldLTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtIsNil applies the IsNil predicate on the "archived_at" field.
+func ArchivedAtIsNil() predicate.Request {
+	return predicate.Request(sql.FieldIsNull(FieldArchivedAt))
+}
+
+// ArchivedAtNotNil applies the NotNil predicate on the "archived_at" field.
+func ArchivedAtNotNil() predicate.Request {
+	return predicate.Request(sql.FieldNotNull(FieldArchivedAt))
+}
+
+// ArchiveStorageIDEQ applies the EQ predicate on the "archive_storage_id" field.
+func ArchiveStorageIDEQ(v int) predicate.Request {
+	return predicate.Request(sql.FieldEQ(FieldArchiveStorageID, v))
+}
+
+// ArchiveStorageIDNEQ applies the NEQ predicate on the "archive_storage_id" field.
+func ArchiveStorageIDNEQ(v int) predicate.Request {
+	return predicate.Request(sql.FieldNEQ(FieldArchiveStorageID, v))
+}
+
+// ArchiveStorageIDIn applies the In predicate on the "archive_storage_id" field.
+func ArchiveStorageIDIn(vs ...int) predicate.Request {
+	return predicate.Request(sql.FieldIn(FieldArchiveStorageID, vs...))
+}
+
+// ArchiveStorageIDNotIn applies the NotIn predicate on the "archive_storage_id" field.
+func ArchiveStorageIDNotIn(vs ...int) predicate.Request {
+	return predicate.Request(sql.FieldNotIn(FieldArchiveStorageID, vs...))
+}
+
+// ArchiveStorageIDGT applies the GT predicate on the "archive_storage_id" field.
+func ArchiveStorageIDGT(v int) predicate.Request {
+	return predicate.Request(sql.FieldGT(FieldArchiveStorageID, v))
+}
+
+// ArchiveStorageIDGTE applies the GTE predicate on the "archive_storage_id" field.
+func ArchiveStorageIDGTE(v int) predicate.Request {
+	return predicate.Request(sql.FieldGTE(FieldArchiveStorageID, v))
+}
+
+// ArchiveStorageIDLT applies the LT predicate on the "archive_storage_id" field.
+func ArchiveStorageIDLT(v int) predicate.Request {
+	return predicate.Request(sql.FieldLT(FieldArchiveStorageID, v))
+}
+
+// ArchiveStorageIDLTE applies the LTE predicate on the "archive_storage_id" field.
+func ArchiveStorageIDLTE(v int) predicate.Request {
+	return predicate.Request(sql.FieldLTE(FieldArchiveStorageID, v))
+}
+
+// ArchiveStorageIDIsNil applies the IsNil predicate on the "archive_storage_id" field.
+func ArchiveStorageIDIsNil() predicate.Request {
+	return predicate.Request(sql.FieldIsNull(FieldArchiveStorageID))
+}
+
+// ArchiveStorageIDNotNil applies the NotNil predicate on the "archive_storage_id" field.
+func ArchiveStorageIDNotNil() predicate.Request {
+	return predicate.Request(sql.FieldNotNull(FieldArchiveStorageID))
+}
+
+// ArchiveKeyEQ applies the EQ predicate on the "archive_key" field.
+func ArchiveKeyEQ(v string) predicate.Request {
+	return predicate.Request(sql.FieldEQ(FieldArchiveKey, v))
+}
+
+// ArchiveKeyNEQ applies the NEQ predicate on the "archive_key" field.
+func ArchiveKeyNEQ(v string) predicate.Request {
+	return predicate.Request(sql.FieldNEQ(FieldArchiveKey, v))
+}
+
+// ArchiveKeyIn applies the In predicate on the "archive_key" field.
+func ArchiveKeyIn(vs ...string) predicate.Request {
+	return predicate.Request(sql.FieldIn(FieldArchiveKey, vs...))
+}
+
+// ArchiveKeyNotIn applies the NotIn predicate on the "archive_key" field.
+func ArchiveKeyNotIn(vs ...string) predicate.Request {
+	return predicate.Request(sql.FieldNotIn(FieldArchiveKey, vs...))
+}
+
+// ArchiveKeyGT applies the GT predicate on the "archive_key" field.
+func ArchiveKeyGT(v string) predicate.Request {
+	return predicate.Request(sql.FieldGT(FieldArchiveKey, v))
+}
+
+// ArchiveKeyGTE applies the GTE predicate on the "archive_key" field.
+func ArchiveKeyGTE(v string) predicate.Request {
+	return predicate.Request(sql.FieldGTE(FieldArchiveKey, v))
+}
+
+// ArchiveKeyLT applies the LT predicate on the "archive_key" field.
+func ArchiveKeyLT(v string) predicate.Request {
+	return predicate.Request(sql.FieldLT(FieldArchiveKey, v))
+}
+
+// ArchiveKeyLTE applies the LTE predicate on the "archive_key" field.
+func ArchiveKeyLTE(v string) predicate.Request {
+	return predicate.Request(sql.FieldLTE(FieldArchiveKey, v))
+}
+
+// ArchiveKeyContains applies the Contains predicate on the "archive_key" field.
+func ArchiveKeyContains(v string) predicate.Request {
+	return predicate.Request(sql.FieldContains(FieldArchiveKey, v))
+}
+
+// ArchiveKeyHasPrefix applies the HasPrefix predicate on the "archive_key" field.
+func ArchiveKeyHasPrefix(v string) predicate.Request {
+	return predicate.Request(sql.FieldHasPrefix(FieldArchiveKey, v))
+}
+
+// ArchiveKeyHasSuffix applies the HasSuffix predicate on the "archive_key" field.
+func ArchiveKeyHasSuffix(v string) predicate.Request {
+	return predicate.Request(sql.FieldHasSuffix(FieldArchiveKey, v))
+}
+
+// ArchiveKeyIsNil applies the IsNil predicate on the "archive_key" field.
+func ArchiveKeyIsNil() predicate.Request {
+	return predicate.Request(sql.FieldIsNull(FieldArchiveKey))
+}
+
+// ArchiveKeyNotNil applies the NotNil predicate on the "archive_key" field.
+func ArchiveKeyNotNil() predicate.Request {
+	return predicate.Request(sql.FieldNotNull(FieldArchiveKey))
+}
+
+// ArchiveKeyEqualFold applies the EqualFold predicate on the "archive_key" field.
+func ArchiveKeyEqualFold(v string) predicate.Request {
+	return predicate.Request(sql.FieldEqualFold(FieldArchiveKey, v))
+}
+
+// ArchiveKeyContainsFold applies the ContainsFold predicate on the "archive_key" field.
+func ArchiveKeyContainsFold(v string) predicate.Request {
+	return predicate.Request(sql.FieldContainsFold(FieldArchiveKey, v))
+}
+
 // HasAPIKey applies the HasEdge predicate on the "api_key" edge.
 func HasAPIKey() predicate.Request {
 	return predicate.Request(func(s *sql.Selector) {
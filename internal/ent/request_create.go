@@ -174,6 +174,20 @@ func (_c *RequestCreate) SetRequestBody(v objects.JSONRawMessage) *RequestCreate
 	return _c
 }
 
+// SetRequestBodyBlobs sets the "request_body_blobs" field.
+func (_c *RequestCreate) SetRequestBodyBlobs(v bool) *RequestCreate {
+	_c.mutation.SetRequestBodyBlobs(v)
+	return _c
+}
+
+// SetNillableRequestBodyBlobs sets the "request_body_blobs" field if the given value is not nil.
+func (_c *RequestCreate) SetNillableRequestBodyBlobs(v *bool) *RequestCreate {
+	if v != nil {
+		_c.SetRequestBodyBlobs(*v)
+	}
+	return _c
+}
+
 // SetResponseBody sets the "response_body" field.
 func (_c *RequestCreate) SetResponseBody(v objects.JSONRawMessage) *RequestCreate {
 	_c.mutation.SetResponseBody(v)
@@ -200,6 +214,20 @@ func (_c *RequestCreate) SetNillableChannelID(v *int) *RequestCreate {
 	return _c
 }
 
+// SetEndUserID sets the "end_user_id" field.
+func (_c *RequestCreate) SetEndUserID(v string) *RequestCreate {
+	_c.mutation.SetEndUserID(v)
+	return _c
+}
+
+// SetNillableEndUserID sets the "end_user_id" field if the given value is not nil.
+func (_c *RequestCreate) SetNillableEndUserID(v *string) *RequestCreate {
+	if v != nil {
+		_c.SetEndUserID(*v)
+	}
+	return _c
+}
+
 // SetExternalID sets the "external_id" field.
 func (_c *RequestCreate) SetExternalID(v string) *RequestCreate {
 	_c.mutation.SetExternalID(v)
@@ -346,6 +374,54 @@ func (_c *RequestCreate) SetNillableContentSavedAt(v *time.Time) *RequestCreate
 	return _c
 }
 
+// SetModerationVerdicts sets the "moderation_verdicts" field.
+func (_c *RequestCreate) SetModerationVerdicts(v []objects.ModerationVerdict) *RequestCreate {
+	_c.mutation.SetModerationVerdicts(v)
+	return _c
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_c *RequestCreate) SetArchivedAt(v time.Time) *RequestCreate {
+	_c.mutation.SetArchivedAt(v)
+	return _c
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_c *RequestCreate) SetNillableArchivedAt(v *time.Time) *RequestCreate {
+	if v != nil {
+		_c.SetArchivedAt(*v)
+	}
+	return _c
+}
+
+// SetArchiveStorageID sets the "archive_storage_id" field.
+func (_c *RequestCreate) SetArchiveStorageID(v int) *RequestCreate {
+	_c.mutation.SetArchiveStorageID(v)
+	return _c
+}
+
+// SetNillableArchiveStorageID sets the "archive_storage_id" field if the given value is not nil.
+func (_c *RequestCreate) SetNillableArchiveStorageID(v *int) *RequestCreate {
+	if v != nil {
+		_c.SetArchiveStorageID(*v)
+	}
+	return _c
+}
+
+// SetArchiveKey sets the "archive_key" field.
+func (_c *RequestCreate) SetArchiveKey(v string) *RequestCreate {
+	_c.mutation.SetArchiveKey(v)
+	return _c
+}
+
+// SetNillableArchiveKey sets the "archive_key" field if the given value is not nil.
+func (_c *RequestCreate) SetNillableArchiveKey(v *string) *RequestCreate {
+	if v != nil {
+		_c.SetArchiveKey(*v)
+	}
+	return _c
+}
+
 // SetAPIKey sets the "api_key" edge to the APIKey entity.
 func (_c *RequestCreate) SetAPIKey(v *APIKey) *RequestCreate {
 	return _c.SetAPIKeyID(v.ID)
@@ -464,6 +540,10 @@ func (_c *RequestCreate) defaults() error {
 		v := request.DefaultFormat
 		_c.mutation.SetFormat(v)
 	}
+	if _, ok := _c.mutation.RequestBodyBlobs(); !ok {
+		v := request.DefaultRequestBodyBlobs
+		_c.mutation.SetRequestBodyBlobs(v)
+	}
 	if _, ok := _c.mutation.Stream(); !ok {
 		v := request.DefaultStream
 		_c.mutation.SetStream(v)
@@ -501,6 +581,14 @@ func (_c *RequestCreate) check() error {
 	if _, ok := _c.mutation.RequestBody(); !ok {
 		return &ValidationError{Name: "request_body", err: errors.New(`ent: missing required field "Request.request_body"`)}
 	}
+	if _, ok := _c.mutation.RequestBodyBlobs(); !ok {
+		return &ValidationError{Name: "request_body_blobs", err: errors.New(`ent: missing required field "Request.request_body_blobs"`)}
+	}
+	if v, ok := _c.mutation.EndUserID(); ok {
+		if err := request.EndUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "end_user_id", err: fmt.Errorf(`ent: validator failed for field "Request.end_user_id": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ExternalID(); ok {
 		if err := request.ExternalIDValidator(v); err != nil {
 			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "Request.external_id": %w`, err)}
@@ -585,6 +673,10 @@ func (_c *RequestCreate) createSpec() (*Request, *sqlgraph.CreateSpec) {
 		_spec.SetField(request.FieldRequestBody, field.TypeJSON, value)
 		_node.RequestBody = value
 	}
+	if value, ok := _c.mutation.RequestBodyBlobs(); ok {
+		_spec.SetField(request.FieldRequestBodyBlobs, field.TypeBool, value)
+		_node.RequestBodyBlobs = value
+	}
 	if value, ok := _c.mutation.ResponseBody(); ok {
 		_spec.SetField(request.FieldResponseBody, field.TypeJSON, value)
 		_node.ResponseBody = value
@@ -593,6 +685,10 @@ func (_c *RequestCreate) createSpec() (*Request, *sqlgraph.CreateSpec) {
 		_spec.SetField(request.FieldResponseChunks, field.TypeJSON, value)
 		_node.ResponseChunks = value
 	}
+	if value, ok := _c.mutation.EndUserID(); ok {
+		_spec.SetField(request.FieldEndUserID, field.TypeString, value)
+		_node.EndUserID = value
+	}
 	if value, ok := _c.mutation.ExternalID(); ok {
 		_spec.SetField(request.FieldExternalID, field.TypeString, value)
 		_node.ExternalID = value
@@ -637,6 +733,22 @@ func (_c *RequestCreate) createSpec() (*Request, *sqlgraph.CreateSpec) {
 		_spec.SetField(request.FieldContentSavedAt, field.TypeTime, value)
 		_node.ContentSavedAt = &value
 	}
+	if value, ok := _c.mutation.ModerationVerdicts(); ok {
+		_spec.SetField(request.FieldModerationVerdicts, field.TypeJSON, value)
+		_node.ModerationVerdicts = value
+	}
+	if value, ok := _c.mutation.ArchivedAt(); ok {
+		_spec.SetField(request.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
+	if value, ok := _c.mutation.ArchiveStorageID(); ok {
+		_spec.SetField(request.FieldArchiveStorageID, field.TypeInt, value)
+		_node.ArchiveStorageID = &value
+	}
+	if value, ok := _c.mutation.ArchiveKey(); ok {
+		_spec.SetField(request.FieldArchiveKey, field.TypeString, value)
+		_node.ArchiveKey = &value
+	}
 	if nodes := _c.mutation.APIKeyIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -836,6 +948,30 @@ func (u *RequestUpsert) ClearRequestHeaders() *RequestUpsert {
 	return u
 }
 
+// SetRequestBody sets the "request_body" field.
+func (u *RequestUpsert) SetRequestBody(v objects.JSONRawMessage) *RequestUpsert {
+	u.Set(request.FieldRequestBody, v)
+	return u
+}
+
+// UpdateRequestBody sets the "request_body" field to the value that was provided on create.
+func (u *RequestUpsert) UpdateRequestBody() *RequestUpsert {
+	u.SetExcluded(request.FieldRequestBody)
+	return u
+}
+
+// SetRequestBodyBlobs sets the "request_body_blobs" field.
+func (u *RequestUpsert) SetRequestBodyBlobs(v bool) *RequestUpsert {
+	u.Set(request.FieldRequestBodyBlobs, v)
+	return u
+}
+
+// UpdateRequestBodyBlobs sets the "request_body_blobs" field to the value that was provided on create.
+func (u *RequestUpsert) UpdateRequestBodyBlobs() *RequestUpsert {
+	u.SetExcluded(request.FieldRequestBodyBlobs)
+	return u
+}
+
 // SetResponseBody sets the "response_body" field.
 func (u *RequestUpsert) SetResponseBody(v objects.JSONRawMessage) *RequestUpsert {
 	u.Set(request.FieldResponseBody, v)
@@ -1064,6 +1200,84 @@ func (u *RequestUpsert) ClearContentSavedAt() *RequestUpsert {
 	return u
 }
 
+// SetModerationVerdicts sets the "moderation_verdicts" field.
+func (u *RequestUpsert) SetModerationVerdicts(v []objects.ModerationVerdict) *RequestUpsert {
+	u.Set(request.FieldModerationVerdicts, v)
+	return u
+}
+
+// UpdateModerationVerdicts sets the "moderation_verdicts" field to the value that was provided on create.
+func (u *RequestUpsert) UpdateModerationVerdicts() *RequestUpsert {
+	u.SetExcluded(request.FieldModerationVerdicts)
+	return u
+}
+
+// ClearModerationVerdicts clears the value of the "moderation_verdicts" field.
+func (u *RequestUpsert) ClearModerationVerdicts() *RequestUpsert {
+	u.SetNull(request.FieldModerationVerdicts)
+	return u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (u *RequestUpsert) SetArchivedAt(v time.Time) *RequestUpsert {
+	u.Set(request.FieldArchivedAt, v)
+	return u
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *RequestUpsert) UpdateArchivedAt() *RequestUpsert {
+	u.SetExcluded(request.FieldArchivedAt)
+	return u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *RequestUpsert) ClearArchivedAt() *RequestUpsert {
+	u.SetNull(request.FieldArchivedAt)
+	return u
+}
+
+// SetArchiveStorageID sets the "archive_storage_id" field.
+func (u *RequestUpsert) SetArchiveStorageID(v int) *RequestUpsert {
+	u.Set(request.FieldArchiveStorageID, v)
+	return u
+}
+
+// UpdateArchiveStorageID sets the "archive_storage_id" field to the value that was provided on create.
+func (u *RequestUpsert) UpdateArchiveStorageID() *RequestUpsert {
+	u.SetExcluded(request.FieldArchiveStorageID)
+	return u
+}
+
+// AddArchiveStorageID adds v to the "archive_storage_id" field.
+func (u *RequestUpsert) AddArchiveStorageID(v int) *RequestUpsert {
+	u.Add(request.FieldArchiveStorageID, v)
+	return u
+}
+
+// ClearArchiveStorageID clears the value of the "archive_storage_id" field.
+func (u *RequestUpsert) ClearArchiveStorageID() *RequestUpsert {
+	u.SetNull(request.FieldArchiveStorageID)
+	return u
+}
+
+// SetArchiveKey sets the "archive_key" field.
+func (u *RequestUpsert) SetArchiveKey(v string) *RequestUpsert {
+	u.Set(request.FieldArchiveKey, v)
+	return u
+}
+
+// UpdateArchiveKey sets the "archive_key" field to the value that was provided on create.
+func (u *RequestUpsert) UpdateArchiveKey() *RequestUpsert {
+	u.SetExcluded(request.FieldArchiveKey)
+	return u
+}
+
+// ClearArchiveKey clears the value of the "archive_key" field.
+func (u *RequestUpsert) ClearArchiveKey() *RequestUpsert {
+	u.SetNull(request.FieldArchiveKey)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -1102,8 +1316,8 @@ func (u *RequestUpsertOne) UpdateNewValues() *RequestUpsertOne {
 		if _, exists := u.create.mutation.Format(); exists {
 			s.SetIgnore(request.FieldFormat)
 		}
-		if _, exists := u.create.mutation.RequestBody(); exists {
-			s.SetIgnore(request.FieldRequestBody)
+		if _, exists := u.create.mutation.EndUserID(); exists {
+			s.SetIgnore(request.FieldEndUserID)
 		}
 		if _, exists := u.create.mutation.Stream(); exists {
 			s.SetIgnore(request.FieldStream)
@@ -1177,6 +1391,34 @@ func (u *RequestUpsertOne) ClearRequestHeaders() *RequestUpsertOne {
 	})
 }
 
+// SetRequestBody sets the "request_body" field.
+func (u *RequestUpsertOne) SetRequestBody(v objects.JSONRawMessage) *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetRequestBody(v)
+	})
+}
+
+// UpdateRequestBody sets the "request_body" field to the value that was provided on create.
+func (u *RequestUpsertOne) UpdateRequestBody() *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateRequestBody()
+	})
+}
+
+// SetRequestBodyBlobs sets the "request_body_blobs" field.
+func (u *RequestUpsertOne) SetRequestBodyBlobs(v bool) *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetRequestBodyBlobs(v)
+	})
+}
+
+// UpdateRequestBodyBlobs sets the "request_body_blobs" field to the value that was provided on create.
+func (u *RequestUpsertOne) UpdateRequestBodyBlobs() *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateRequestBodyBlobs()
+	})
+}
+
 // SetResponseBody sets the "response_body" field.
 func (u *RequestUpsertOne) SetResponseBody(v objects.JSONRawMessage) *RequestUpsertOne {
 	return u.Update(func(s *RequestUpsert) {
@@ -1443,6 +1685,97 @@ func (u *RequestUpsertOne) ClearContentSavedAt() *RequestUpsertOne {
 	})
 }
 
+// SetModerationVerdicts sets the "moderation_verdicts" field.
+func (u *RequestUpsertOne) SetModerationVerdicts(v []objects.ModerationVerdict) *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetModerationVerdicts(v)
+	})
+}
+
+// UpdateModerationVerdicts sets the "moderation_verdicts" field to the value that was provided on create.
+func (u *RequestUpsertOne) UpdateModerationVerdicts() *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateModerationVerdicts()
+	})
+}
+
+// ClearModerationVerdicts clears the value of the "moderation_verdicts" field.
+func (u *RequestUpsertOne) ClearModerationVerdicts() *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.ClearModerationVerdicts()
+	})
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (u *RequestUpsertOne) SetArchivedAt(v time.Time) *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetArchivedAt(v)
+	})
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *RequestUpsertOne) UpdateArchivedAt() *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateArchivedAt()
+	})
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *RequestUpsertOne) ClearArchivedAt() *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.ClearArchivedAt()
+	})
+}
+
+// SetArchiveStorageID sets the "archive_storage_id" field.
+func (u *RequestUpsertOne) SetArchiveStorageID(v int) *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetArchiveStorageID(v)
+	})
+}
+
+// AddArchiveStorageID adds v to the "archive_storage_id" field.
+func (u *RequestUpsertOne) AddArchiveStorageID(v int) *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.AddArchiveStorageID(v)
+	})
+}
+
+// UpdateArchiveStorageID sets the "archive_storage_id" field to the value that was provided on create.
+func (u *RequestUpsertOne) UpdateArchiveStorageID() *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateArchiveStorageID()
+	})
+}
+
+// ClearArchiveStorageID clears the value of the "archive_storage_id" field.
+func (u *RequestUpsertOne) ClearArchiveStorageID() *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.ClearArchiveStorageID()
+	})
+}
+
+// SetArchiveKey sets the "archive_key" field.
+func (u *RequestUpsertOne) SetArchiveKey(v string) *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetArchiveKey(v)
+	})
+}
+
+// UpdateArchiveKey sets the "archive_key" field to the value that was provided on create.
+func (u *RequestUpsertOne) UpdateArchiveKey() *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateArchiveKey()
+	})
+}
+
+// ClearArchiveKey clears the value of the "archive_key" field.
+func (u *RequestUpsertOne) ClearArchiveKey() *RequestUpsertOne {
+	return u.Update(func(s *RequestUpsert) {
+		s.ClearArchiveKey()
+	})
+}
+
 // Exec executes the query.
 func (u *RequestUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1646,8 +1979,8 @@ func (u *RequestUpsertBulk) UpdateNewValues() *RequestUpsertBulk {
 			if _, exists := b.mutation.Format(); exists {
 				s.SetIgnore(request.FieldFormat)
 			}
-			if _, exists := b.mutation.RequestBody(); exists {
-				s.SetIgnore(request.FieldRequestBody)
+			if _, exists := b.mutation.EndUserID(); exists {
+				s.SetIgnore(request.FieldEndUserID)
 			}
 			if _, exists := b.mutation.Stream(); exists {
 				s.SetIgnore(request.FieldStream)
@@ -1722,6 +2055,34 @@ func (u *RequestUpsertBulk) ClearRequestHeaders() *RequestUpsertBulk {
 	})
 }
 
+// SetRequestBody sets the "request_body" field.
+func (u *RequestUpsertBulk) SetRequestBody(v objects.JSONRawMessage) *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetRequestBody(v)
+	})
+}
+
+// UpdateRequestBody sets the "request_body" field to the value that was provided on create.
+func (u *RequestUpsertBulk) UpdateRequestBody() *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateRequestBody()
+	})
+}
+
+// SetRequestBodyBlobs sets the "request_body_blobs" field.
+func (u *RequestUpsertBulk) SetRequestBodyBlobs(v bool) *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetRequestBodyBlobs(v)
+	})
+}
+
+// UpdateRequestBodyBlobs sets the "request_body_blobs" field to the value that was provided on create.
+func (u *RequestUpsertBulk) UpdateRequestBodyBlobs() *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateRequestBodyBlobs()
+	})
+}
+
 // SetResponseBody sets the "response_body" field.
 func (u *RequestUpsertBulk) SetResponseBody(v objects.JSONRawMessage) *RequestUpsertBulk {
 	return u.Update(func(s *RequestUpsert) {
@@ -1988,6 +2349,97 @@ func (u *RequestUpsertBulk) ClearContentSavedAt() *RequestUpsertBulk {
 	})
 }
 
+// SetModerationVerdicts sets the "moderation_verdicts" field.
+func (u *RequestUpsertBulk) SetModerationVerdicts(v []objects.ModerationVerdict) *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetModerationVerdicts(v)
+	})
+}
+
+// UpdateModerationVerdicts sets the "moderation_verdicts" field to the value that was provided on create.
+func (u *RequestUpsertBulk) UpdateModerationVerdicts() *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateModerationVerdicts()
+	})
+}
+
+// ClearModerationVerdicts clears the value of the "moderation_verdicts" field.
+func (u *RequestUpsertBulk) ClearModerationVerdicts() *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.ClearModerationVerdicts()
+	})
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (u *RequestUpsertBulk) SetArchivedAt(v time.Time) *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetArchivedAt(v)
+	})
+}
+
+// UpdateArchivedAt sets the "archived_at" field to the value that was provided on create.
+func (u *RequestUpsertBulk) UpdateArchivedAt() *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateArchivedAt()
+	})
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (u *RequestUpsertBulk) ClearArchivedAt() *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.ClearArchivedAt()
+	})
+}
+
+// SetArchiveStorageID sets the "archive_storage_id" field.
+func (u *RequestUpsertBulk) SetArchiveStorageID(v int) *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetArchiveStorageID(v)
+	})
+}
+
+// AddArchiveStorageID adds v to the "archive_storage_id" field.
+func (u *RequestUpsertBulk) AddArchiveStorageID(v int) *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.AddArchiveStorageID(v)
+	})
+}
+
+// UpdateArchiveStorageID sets the "archive_storage_id" field to the value that was provided on create.
+func (u *RequestUpsertBulk) UpdateArchiveStorageID() *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateArchiveStorageID()
+	})
+}
+
+// ClearArchiveStorageID clears the value of the "archive_storage_id" field.
+func (u *RequestUpsertBulk) ClearArchiveStorageID() *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.ClearArchiveStorageID()
+	})
+}
+
+// SetArchiveKey sets the "archive_key" field.
+func (u *RequestUpsertBulk) SetArchiveKey(v string) *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.SetArchiveKey(v)
+	})
+}
+
+// UpdateArchiveKey sets the "archive_key" field to the value that was provided on create.
+func (u *RequestUpsertBulk) UpdateArchiveKey() *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.UpdateArchiveKey()
+	})
+}
+
+// ClearArchiveKey clears the value of the "archive_key" field.
+func (u *RequestUpsertBulk) ClearArchiveKey() *RequestUpsertBulk {
+	return u.Update(func(s *RequestUpsert) {
+		s.ClearArchiveKey()
+	})
+}
+
 // Exec executes the query.
 func (u *RequestUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
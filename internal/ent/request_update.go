@@ -58,6 +58,32 @@ func (_u *RequestUpdate) ClearRequestHeaders() *RequestUpdate {
 	return _u
 }
 
+// SetRequestBody sets the "request_body" field.
+func (_u *RequestUpdate) SetRequestBody(v objects.JSONRawMessage) *RequestUpdate {
+	_u.mutation.SetRequestBody(v)
+	return _u
+}
+
+// AppendRequestBody appends value to the "request_body" field.
+func (_u *RequestUpdate) AppendRequestBody(v objects.JSONRawMessage) *RequestUpdate {
+	_u.mutation.AppendRequestBody(v)
+	return _u
+}
+
+// SetRequestBodyBlobs sets the "request_body_blobs" field.
+func (_u *RequestUpdate) SetRequestBodyBlobs(v bool) *RequestUpdate {
+	_u.mutation.SetRequestBodyBlobs(v)
+	return _u
+}
+
+// SetNillableRequestBodyBlobs sets the "request_body_blobs" field if the given value is not nil.
+func (_u *RequestUpdate) SetNillableRequestBodyBlobs(v *bool) *RequestUpdate {
+	if v != nil {
+		_u.SetRequestBodyBlobs(*v)
+	}
+	return _u
+}
+
 // SetResponseBody sets the "response_body" field.
 func (_u *RequestUpdate) SetResponseBody(v objects.JSONRawMessage) *RequestUpdate {
 	_u.mutation.SetResponseBody(v)
@@ -310,6 +336,91 @@ func (_u *RequestUpdate) ClearContentSavedAt() *RequestUpdate {
 	return _u
 }
 
+// SetModerationVerdicts sets the "moderation_verdicts" field.
+func (_u *RequestUpdate) SetModerationVerdicts(v []objects.ModerationVerdict) *RequestUpdate {
+	_u.mutation.SetModerationVerdicts(v)
+	return _u
+}
+
+// AppendModerationVerdicts appends value to the "moderation_verdicts" field.
+func (_u *RequestUpdate) AppendModerationVerdicts(v []objects.ModerationVerdict) *RequestUpdate {
+	_u.mutation.AppendModerationVerdicts(v)
+	return _u
+}
+
+// ClearModerationVerdicts clears the value of the "moderation_verdicts" field.
+func (_u *RequestUpdate) ClearModerationVerdicts() *RequestUpdate {
+	_u.mutation.ClearModerationVerdicts()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *RequestUpdate) SetArchivedAt(v time.Time) *RequestUpdate {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *RequestUpdate) SetNillableArchivedAt(v *time.Time) *RequestUpdate {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *RequestUpdate) ClearArchivedAt() *RequestUpdate {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetArchiveStorageID sets the "archive_storage_id" field.
+func (_u *RequestUpdate) SetArchiveStorageID(v int) *RequestUpdate {
+	_u.mutation.ResetArchiveStorageID()
+	_u.mutation.SetArchiveStorageID(v)
+	return _u
+}
+
+// SetNillableArchiveStorageID sets the "archive_storage_id" field if the given value is not nil.
+func (_u *RequestUpdate) SetNillableArchiveStorageID(v *int) *RequestUpdate {
+	if v != nil {
+		_u.SetArchiveStorageID(*v)
+	}
+	return _u
+}
+
+// AddArchiveStorageID adds value to the "archive_storage_id" field.
+func (_u *RequestUpdate) AddArchiveStorageID(v int) *RequestUpdate {
+	_u.mutation.AddArchiveStorageID(v)
+	return _u
+}
+
+// ClearArchiveStorageID clears the value of the "archive_storage_id" field.
+func (_u *RequestUpdate) ClearArchiveStorageID() *RequestUpdate {
+	_u.mutation.ClearArchiveStorageID()
+	return _u
+}
+
+// SetArchiveKey sets the "archive_key" field.
+func (_u *RequestUpdate) SetArchiveKey(v string) *RequestUpdate {
+	_u.mutation.SetArchiveKey(v)
+	return _u
+}
+
+// SetNillableArchiveKey sets the "archive_key" field if the given value is not nil.
+func (_u *RequestUpdate) SetNillableArchiveKey(v *string) *RequestUpdate {
+	if v != nil {
+		_u.SetArchiveKey(*v)
+	}
+	return _u
+}
+
+// ClearArchiveKey clears the value of the "archive_key" field.
+func (_u *RequestUpdate) ClearArchiveKey() *RequestUpdate {
+	_u.mutation.ClearArchiveKey()
+	return _u
+}
+
 // AddExecutionIDs adds the "executions" edge to the RequestExecution entity by IDs.
 func (_u *RequestUpdate) AddExecutionIDs(ids ...int) *RequestUpdate {
 	_u.mutation.AddExecutionIDs(ids...)
@@ -493,6 +604,17 @@ func (_u *RequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.RequestHeadersCleared() {
 		_spec.ClearField(request.FieldRequestHeaders, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.RequestBody(); ok {
+		_spec.SetField(request.FieldRequestBody, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRequestBody(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, request.FieldRequestBody, value)
+		})
+	}
+	if value, ok := _u.mutation.RequestBodyBlobs(); ok {
+		_spec.SetField(request.FieldRequestBodyBlobs, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.ResponseBody(); ok {
 		_spec.SetField(request.FieldResponseBody, field.TypeJSON, value)
 	}
@@ -515,6 +637,9 @@ func (_u *RequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ResponseChunksCleared() {
 		_spec.ClearField(request.FieldResponseChunks, field.TypeJSON)
 	}
+	if _u.mutation.EndUserIDCleared() {
+		_spec.ClearField(request.FieldEndUserID, field.TypeString)
+	}
 	if value, ok := _u.mutation.ExternalID(); ok {
 		_spec.SetField(request.FieldExternalID, field.TypeString, value)
 	}
@@ -575,6 +700,38 @@ func (_u *RequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ContentSavedAtCleared() {
 		_spec.ClearField(request.FieldContentSavedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ModerationVerdicts(); ok {
+		_spec.SetField(request.FieldModerationVerdicts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedModerationVerdicts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, request.FieldModerationVerdicts, value)
+		})
+	}
+	if _u.mutation.ModerationVerdictsCleared() {
+		_spec.ClearField(request.FieldModerationVerdicts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(request.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(request.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ArchiveStorageID(); ok {
+		_spec.SetField(request.FieldArchiveStorageID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedArchiveStorageID(); ok {
+		_spec.AddField(request.FieldArchiveStorageID, field.TypeInt, value)
+	}
+	if _u.mutation.ArchiveStorageIDCleared() {
+		_spec.ClearField(request.FieldArchiveStorageID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ArchiveKey(); ok {
+		_spec.SetField(request.FieldArchiveKey, field.TypeString, value)
+	}
+	if _u.mutation.ArchiveKeyCleared() {
+		_spec.ClearField(request.FieldArchiveKey, field.TypeString)
+	}
 	if _u.mutation.ExecutionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -740,6 +897,32 @@ func (_u *RequestUpdateOne) ClearRequestHeaders() *RequestUpdateOne {
 	return _u
 }
 
+// SetRequestBody sets the "request_body" field.
+func (_u *RequestUpdateOne) SetRequestBody(v objects.JSONRawMessage) *RequestUpdateOne {
+	_u.mutation.SetRequestBody(v)
+	return _u
+}
+
+// AppendRequestBody appends value to the "request_body" field.
+func (_u *RequestUpdateOne) AppendRequestBody(v objects.JSONRawMessage) *RequestUpdateOne {
+	_u.mutation.AppendRequestBody(v)
+	return _u
+}
+
+// SetRequestBodyBlobs sets the "request_body_blobs" field.
+func (_u *RequestUpdateOne) SetRequestBodyBlobs(v bool) *RequestUpdateOne {
+	_u.mutation.SetRequestBodyBlobs(v)
+	return _u
+}
+
+// SetNillableRequestBodyBlobs sets the "request_body_blobs" field if the given value is not nil.
+func (_u *RequestUpdateOne) SetNillableRequestBodyBlobs(v *bool) *RequestUpdateOne {
+	if v != nil {
+		_u.SetRequestBodyBlobs(*v)
+	}
+	return _u
+}
+
 // SetResponseBody sets the "response_body" field.
 func (_u *RequestUpdateOne) SetResponseBody(v objects.JSONRawMessage) *RequestUpdateOne {
 	_u.mutation.SetResponseBody(v)
@@ -992,6 +1175,91 @@ func (_u *RequestUpdateOne) ClearContentSavedAt() *RequestUpdateOne {
 	return _u
 }
 
+// SetModerationVerdicts sets the "moderation_verdicts" field.
+func (_u *RequestUpdateOne) SetModerationVerdicts(v []objects.ModerationVerdict) *RequestUpdateOne {
+	_u.mutation.SetModerationVerdicts(v)
+	return _u
+}
+
+// AppendModerationVerdicts appends value to the "moderation_verdicts" field.
+func (_u *RequestUpdateOne) AppendModerationVerdicts(v []objects.ModerationVerdict) *RequestUpdateOne {
+	_u.mutation.AppendModerationVerdicts(v)
+	return _u
+}
+
+// ClearModerationVerdicts clears the value of the "moderation_verdicts" field.
+func (_u *RequestUpdateOne) ClearModerationVerdicts() *RequestUpdateOne {
+	_u.mutation.ClearModerationVerdicts()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *RequestUpdateOne) SetArchivedAt(v time.Time) *RequestUpdateOne {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *RequestUpdateOne) SetNillableArchivedAt(v *time.Time) *RequestUpdateOne {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *RequestUpdateOne) ClearArchivedAt() *RequestUpdateOne {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetArchiveStorageID sets the "archive_storage_id" field.
+func (_u *RequestUpdateOne) SetArchiveStorageID(v int) *RequestUpdateOne {
+	_u.mutation.ResetArchiveStorageID()
+	_u.mutation.SetArchiveStorageID(v)
+	return _u
+}
+
+// SetNillableArchiveStorageID sets the "archive_storage_id" field if the given value is not nil.
+func (_u *RequestUpdateOne) SetNillableArchiveStorageID(v *int) *RequestUpdateOne {
+	if v != nil {
+		_u.SetArchiveStorageID(*v)
+	}
+	return _u
+}
+
+// AddArchiveStorageID adds value to the "archive_storage_id" field.
+func (_u *RequestUpdateOne) AddArchiveStorageID(v int) *RequestUpdateOne {
+	_u.mutation.AddArchiveStorageID(v)
+	return _u
+}
+
+// ClearArchiveStorageID clears the value of the "archive_storage_id" field.
+func (_u *RequestUpdateOne) ClearArchiveStorageID() *RequestUpdateOne {
+	_u.mutation.ClearArchiveStorageID()
+	return _u
+}
+
+// SetArchiveKey sets the "archive_key" field.
+func (_u *RequestUpdateOne) SetArchiveKey(v string) *RequestUpdateOne {
+	_u.mutation.SetArchiveKey(v)
+	return _u
+}
+
+// SetNillableArchiveKey sets the "archive_key" field if the given value is not nil.
+func (_u *RequestUpdateOne) SetNillableArchiveKey(v *string) *RequestUpdateOne {
+	if v != nil {
+		_u.SetArchiveKey(*v)
+	}
+	return _u
+}
+
+// ClearArchiveKey clears the value of the "archive_key" field.
+func (_u *RequestUpdateOne) ClearArchiveKey() *RequestUpdateOne {
+	_u.mutation.ClearArchiveKey()
+	return _u
+}
+
 // AddExecutionIDs adds the "executions" edge to the RequestExecution entity by IDs.
 func (_u *RequestUpdateOne) AddExecutionIDs(ids ...int) *RequestUpdateOne {
 	_u.mutation.AddExecutionIDs(ids...)
@@ -1205,6 +1473,17 @@ func (_u *RequestUpdateOne) sqlSave(ctx context.Context) (_node *Request, err er
 	if _u.mutation.RequestHeadersCleared() {
 		_spec.ClearField(request.FieldRequestHeaders, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.RequestBody(); ok {
+		_spec.SetField(request.FieldRequestBody, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRequestBody(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, request.FieldRequestBody, value)
+		})
+	}
+	if value, ok := _u.mutation.RequestBodyBlobs(); ok {
+		_spec.SetField(request.FieldRequestBodyBlobs, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.ResponseBody(); ok {
 		_spec.SetField(request.FieldResponseBody, field.TypeJSON, value)
 	}
@@ -1227,6 +1506,9 @@ func (_u *RequestUpdateOne) sqlSave(ctx context.Context) (_node *Request, err er
 	if _u.mutation.ResponseChunksCleared() {
 		_spec.ClearField(request.FieldResponseChunks, field.TypeJSON)
 	}
+	if _u.mutation.EndUserIDCleared() {
+		_spec.ClearField(request.FieldEndUserID, field.TypeString)
+	}
 	if value, ok := _u.mutation.ExternalID(); ok {
 		_spec.SetField(request.FieldExternalID, field.TypeString, value)
 	}
@@ -1287,6 +1569,38 @@ func (_u *RequestUpdateOne) sqlSave(ctx context.Context) (_node *Request, err er
 	if _u.mutation.ContentSavedAtCleared() {
 		_spec.ClearField(request.FieldContentSavedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ModerationVerdicts(); ok {
+		_spec.SetField(request.FieldModerationVerdicts, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedModerationVerdicts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, request.FieldModerationVerdicts, value)
+		})
+	}
+	if _u.mutation.ModerationVerdictsCleared() {
+		_spec.ClearField(request.FieldModerationVerdicts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(request.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(request.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ArchiveStorageID(); ok {
+		_spec.SetField(request.FieldArchiveStorageID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedArchiveStorageID(); ok {
+		_spec.AddField(request.FieldArchiveStorageID, field.TypeInt, value)
+	}
+	if _u.mutation.ArchiveStorageIDCleared() {
+		_spec.ClearField(request.FieldArchiveStorageID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ArchiveKey(); ok {
+		_spec.SetField(request.FieldArchiveKey, field.TypeString, value)
+	}
+	if _u.mutation.ArchiveKeyCleared() {
+		_spec.ClearField(request.FieldArchiveKey, field.TypeString)
+	}
 	if _u.mutation.ExecutionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -37,6 +37,8 @@ const (
 	FieldFormat = "format"
 	// FieldRequestBody holds the string denoting the request_body field in the database.
 	FieldRequestBody = "request_body"
+	// FieldRequestBodyBlobs holds the string denoting the request_body_blobs field in the database.
+	FieldRequestBodyBlobs = "request_body_blobs"
 	// FieldResponseBody holds the string denoting the response_body field in the database.
 	FieldResponseBody = "response_body"
 	// FieldResponseChunks holds the string denoting the response_chunks field in the database.
@@ -105,6 +107,7 @@ var Columns = []string{
 	FieldModelID,
 	FieldFormat,
 	FieldRequestBody,
+	FieldRequestBodyBlobs,
 	FieldResponseBody,
 	FieldResponseChunks,
 	FieldErrorMessage,
@@ -142,6 +145,8 @@ var (
 	ExternalIDValidator func(string) error
 	// DefaultFormat holds the default value on creation for the "format" field.
 	DefaultFormat string
+	// DefaultRequestBodyBlobs holds the default value on creation for the "request_body_blobs" field.
+	DefaultRequestBodyBlobs bool
 	// DefaultStream holds the default value on creation for the "stream" field.
 	DefaultStream bool
 	// DefaultPassThroughApplied holds the default value on creation for the "pass_through_applied" field.
@@ -227,6 +232,11 @@ func ByFormat(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFormat, opts...).ToFunc()
 }
 
+// ByRequestBodyBlobs orders the results by the request_body_blobs field.
+func ByRequestBodyBlobs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestBodyBlobs, opts...).ToFunc()
+}
+
 // ByErrorMessage orders the results by the error_message field.
 func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
@@ -100,6 +100,11 @@ func Format(v string) predicate.RequestExecution {
 	return predicate.RequestExecution(sql.FieldEQ(FieldFormat, v))
 }
 
+// RequestBodyBlobs applies equality check predicate on the "request_body_blobs" field. It's identical to RequestBodyBlobsEQ.
+func RequestBodyBlobs(v bool) predicate.RequestExecution {
+	return predicate.RequestExecution(sql.FieldEQ(FieldRequestBodyBlobs, v))
+}
+
 // ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
 func ErrorMessage(v string) predicate.RequestExecution {
 	return predicate.RequestExecution(sql.FieldEQ(FieldErrorMessage, v))
@@ -545,6 +550,16 @@ func FormatContainsFold(v string) predicate.RequestExecution {
 	return predicate.RequestExecution(sql.FieldContainsFold(FieldFormat, v))
 }
 
+// RequestBodyBlobsEQ applies the EQ predicate on the "request_body_blobs" field.
+func RequestBodyBlobsEQ(v bool) predicate.RequestExecution {
+	return predicate.RequestExecution(sql.FieldEQ(FieldRequestBodyBlobs, v))
+}
+
+// RequestBodyBlobsNEQ applies the NEQ predicate on the "request_body_blobs" field.
+func RequestBodyBlobsNEQ(v bool) predicate.RequestExecution {
+	return predicate.RequestExecution(sql.FieldNEQ(FieldRequestBodyBlobs, v))
+}
+
 // ResponseBodyIsNil applies the IsNil predicate on the "response_body" field.
 func ResponseBodyIsNil() predicate.RequestExecution {
 	return predicate.RequestExecution(sql.FieldIsNull(FieldResponseBody))
@@ -142,6 +142,20 @@ func (_c *RequestExecutionCreate) SetRequestBody(v objects.JSONRawMessage) *Requ
 	return _c
 }
 
+// SetRequestBodyBlobs sets the "request_body_blobs" field.
+func (_c *RequestExecutionCreate) SetRequestBodyBlobs(v bool) *RequestExecutionCreate {
+	_c.mutation.SetRequestBodyBlobs(v)
+	return _c
+}
+
+// SetNillableRequestBodyBlobs sets the "request_body_blobs" field if the given value is not nil.
+func (_c *RequestExecutionCreate) SetNillableRequestBodyBlobs(v *bool) *RequestExecutionCreate {
+	if v != nil {
+		_c.SetRequestBodyBlobs(*v)
+	}
+	return _c
+}
+
 // SetResponseBody sets the "response_body" field.
 func (_c *RequestExecutionCreate) SetResponseBody(v objects.JSONRawMessage) *RequestExecutionCreate {
 	_c.mutation.SetResponseBody(v)
@@ -344,6 +358,10 @@ func (_c *RequestExecutionCreate) defaults() {
 		v := requestexecution.DefaultFormat
 		_c.mutation.SetFormat(v)
 	}
+	if _, ok := _c.mutation.RequestBodyBlobs(); !ok {
+		v := requestexecution.DefaultRequestBodyBlobs
+		_c.mutation.SetRequestBodyBlobs(v)
+	}
 	if _, ok := _c.mutation.Stream(); !ok {
 		v := requestexecution.DefaultStream
 		_c.mutation.SetStream(v)
@@ -376,6 +394,9 @@ func (_c *RequestExecutionCreate) check() error {
 	if _, ok := _c.mutation.RequestBody(); !ok {
 		return &ValidationError{Name: "request_body", err: errors.New(`ent: missing required field "RequestExecution.request_body"`)}
 	}
+	if _, ok := _c.mutation.RequestBodyBlobs(); !ok {
+		return &ValidationError{Name: "request_body_blobs", err: errors.New(`ent: missing required field "RequestExecution.request_body_blobs"`)}
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RequestExecution.status"`)}
 	}
@@ -448,6 +469,10 @@ func (_c *RequestExecutionCreate) createSpec() (*RequestExecution, *sqlgraph.Cre
 		_spec.SetField(requestexecution.FieldRequestBody, field.TypeJSON, value)
 		_node.RequestBody = value
 	}
+	if value, ok := _c.mutation.RequestBodyBlobs(); ok {
+		_spec.SetField(requestexecution.FieldRequestBodyBlobs, field.TypeBool, value)
+		_node.RequestBodyBlobs = value
+	}
 	if value, ok := _c.mutation.ResponseBody(); ok {
 		_spec.SetField(requestexecution.FieldResponseBody, field.TypeJSON, value)
 		_node.ResponseBody = value
@@ -629,6 +654,30 @@ func (u *RequestExecutionUpsert) ClearExternalID() *RequestExecutionUpsert {
 	return u
 }
 
+// SetRequestBody sets the "request_body" field.
+func (u *RequestExecutionUpsert) SetRequestBody(v objects.JSONRawMessage) *RequestExecutionUpsert {
+	u.Set(requestexecution.FieldRequestBody, v)
+	return u
+}
+
+// UpdateRequestBody sets the "request_body" field to the value that was provided on create.
+func (u *RequestExecutionUpsert) UpdateRequestBody() *RequestExecutionUpsert {
+	u.SetExcluded(requestexecution.FieldRequestBody)
+	return u
+}
+
+// SetRequestBodyBlobs sets the "request_body_blobs" field.
+func (u *RequestExecutionUpsert) SetRequestBodyBlobs(v bool) *RequestExecutionUpsert {
+	u.Set(requestexecution.FieldRequestBodyBlobs, v)
+	return u
+}
+
+// UpdateRequestBodyBlobs sets the "request_body_blobs" field to the value that was provided on create.
+func (u *RequestExecutionUpsert) UpdateRequestBodyBlobs() *RequestExecutionUpsert {
+	u.SetExcluded(requestexecution.FieldRequestBodyBlobs)
+	return u
+}
+
 // SetResponseBody sets the "response_body" field.
 func (u *RequestExecutionUpsert) SetResponseBody(v objects.JSONRawMessage) *RequestExecutionUpsert {
 	u.Set(requestexecution.FieldResponseBody, v)
@@ -871,9 +920,6 @@ func (u *RequestExecutionUpsertOne) UpdateNewValues() *RequestExecutionUpsertOne
 		if _, exists := u.create.mutation.Format(); exists {
 			s.SetIgnore(requestexecution.FieldFormat)
 		}
-		if _, exists := u.create.mutation.RequestBody(); exists {
-			s.SetIgnore(requestexecution.FieldRequestBody)
-		}
 		if _, exists := u.create.mutation.Stream(); exists {
 			s.SetIgnore(requestexecution.FieldStream)
 		}
@@ -943,6 +989,34 @@ func (u *RequestExecutionUpsertOne) ClearExternalID() *RequestExecutionUpsertOne
 	})
 }
 
+// SetRequestBody sets the "request_body" field.
+func (u *RequestExecutionUpsertOne) SetRequestBody(v objects.JSONRawMessage) *RequestExecutionUpsertOne {
+	return u.Update(func(s *RequestExecutionUpsert) {
+		s.SetRequestBody(v)
+	})
+}
+
+// UpdateRequestBody sets the "request_body" field to the value that was provided on create.
+func (u *RequestExecutionUpsertOne) UpdateRequestBody() *RequestExecutionUpsertOne {
+	return u.Update(func(s *RequestExecutionUpsert) {
+		s.UpdateRequestBody()
+	})
+}
+
+// SetRequestBodyBlobs sets the "request_body_blobs" field.
+func (u *RequestExecutionUpsertOne) SetRequestBodyBlobs(v bool) *RequestExecutionUpsertOne {
+	return u.Update(func(s *RequestExecutionUpsert) {
+		s.SetRequestBodyBlobs(v)
+	})
+}
+
+// UpdateRequestBodyBlobs sets the "request_body_blobs" field to the value that was provided on create.
+func (u *RequestExecutionUpsertOne) UpdateRequestBodyBlobs() *RequestExecutionUpsertOne {
+	return u.Update(func(s *RequestExecutionUpsert) {
+		s.UpdateRequestBodyBlobs()
+	})
+}
+
 // SetResponseBody sets the "response_body" field.
 func (u *RequestExecutionUpsertOne) SetResponseBody(v objects.JSONRawMessage) *RequestExecutionUpsertOne {
 	return u.Update(func(s *RequestExecutionUpsert) {
@@ -1385,9 +1459,6 @@ func (u *RequestExecutionUpsertBulk) UpdateNewValues() *RequestExecutionUpsertBu
 			if _, exists := b.mutation.Format(); exists {
 				s.SetIgnore(requestexecution.FieldFormat)
 			}
-			if _, exists := b.mutation.RequestBody(); exists {
-				s.SetIgnore(requestexecution.FieldRequestBody)
-			}
 			if _, exists := b.mutation.Stream(); exists {
 				s.SetIgnore(requestexecution.FieldStream)
 			}
@@ -1458,6 +1529,34 @@ func (u *RequestExecutionUpsertBulk) ClearExternalID() *RequestExecutionUpsertBu
 	})
 }
 
+// SetRequestBody sets the "request_body" field.
+func (u *RequestExecutionUpsertBulk) SetRequestBody(v objects.JSONRawMessage) *RequestExecutionUpsertBulk {
+	return u.Update(func(s *RequestExecutionUpsert) {
+		s.SetRequestBody(v)
+	})
+}
+
+// UpdateRequestBody sets the "request_body" field to the value that was provided on create.
+func (u *RequestExecutionUpsertBulk) UpdateRequestBody() *RequestExecutionUpsertBulk {
+	return u.Update(func(s *RequestExecutionUpsert) {
+		s.UpdateRequestBody()
+	})
+}
+
+// SetRequestBodyBlobs sets the "request_body_blobs" field.
+func (u *RequestExecutionUpsertBulk) SetRequestBodyBlobs(v bool) *RequestExecutionUpsertBulk {
+	return u.Update(func(s *RequestExecutionUpsert) {
+		s.SetRequestBodyBlobs(v)
+	})
+}
+
+// UpdateRequestBodyBlobs sets the "request_body_blobs" field to the value that was provided on create.
+func (u *RequestExecutionUpsertBulk) UpdateRequestBodyBlobs() *RequestExecutionUpsertBulk {
+	return u.Update(func(s *RequestExecutionUpsert) {
+		s.UpdateRequestBodyBlobs()
+	})
+}
+
 // SetResponseBody sets the "response_body" field.
 func (u *RequestExecutionUpsertBulk) SetResponseBody(v objects.JSONRawMessage) *RequestExecutionUpsertBulk {
 	return u.Update(func(s *RequestExecutionUpsert) {
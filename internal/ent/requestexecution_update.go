@@ -57,6 +57,32 @@ func (_u *RequestExecutionUpdate) ClearExternalID() *RequestExecutionUpdate {
 	return _u
 }
 
+// SetRequestBody sets the "request_body" field.
+func (_u *RequestExecutionUpdate) SetRequestBody(v objects.JSONRawMessage) *RequestExecutionUpdate {
+	_u.mutation.SetRequestBody(v)
+	return _u
+}
+
+// AppendRequestBody appends value to the "request_body" field.
+func (_u *RequestExecutionUpdate) AppendRequestBody(v objects.JSONRawMessage) *RequestExecutionUpdate {
+	_u.mutation.AppendRequestBody(v)
+	return _u
+}
+
+// SetRequestBodyBlobs sets the "request_body_blobs" field.
+func (_u *RequestExecutionUpdate) SetRequestBodyBlobs(v bool) *RequestExecutionUpdate {
+	_u.mutation.SetRequestBodyBlobs(v)
+	return _u
+}
+
+// SetNillableRequestBodyBlobs sets the "request_body_blobs" field if the given value is not nil.
+func (_u *RequestExecutionUpdate) SetNillableRequestBodyBlobs(v *bool) *RequestExecutionUpdate {
+	if v != nil {
+		_u.SetRequestBodyBlobs(*v)
+	}
+	return _u
+}
+
 // SetResponseBody sets the "response_body" field.
 func (_u *RequestExecutionUpdate) SetResponseBody(v objects.JSONRawMessage) *RequestExecutionUpdate {
 	_u.mutation.SetResponseBody(v)
@@ -373,6 +399,17 @@ func (_u *RequestExecutionUpdate) sqlSave(ctx context.Context) (_node int, err e
 	if _u.mutation.ExternalIDCleared() {
 		_spec.ClearField(requestexecution.FieldExternalID, field.TypeString)
 	}
+	if value, ok := _u.mutation.RequestBody(); ok {
+		_spec.SetField(requestexecution.FieldRequestBody, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRequestBody(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requestexecution.FieldRequestBody, value)
+		})
+	}
+	if value, ok := _u.mutation.RequestBodyBlobs(); ok {
+		_spec.SetField(requestexecution.FieldRequestBodyBlobs, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.ResponseBody(); ok {
 		_spec.SetField(requestexecution.FieldResponseBody, field.TypeJSON, value)
 	}
@@ -508,6 +545,32 @@ func (_u *RequestExecutionUpdateOne) ClearExternalID() *RequestExecutionUpdateOn
 	return _u
 }
 
+// SetRequestBody sets the "request_body" field.
+func (_u *RequestExecutionUpdateOne) SetRequestBody(v objects.JSONRawMessage) *RequestExecutionUpdateOne {
+	_u.mutation.SetRequestBody(v)
+	return _u
+}
+
+// AppendRequestBody appends value to the "request_body" field.
+func (_u *RequestExecutionUpdateOne) AppendRequestBody(v objects.JSONRawMessage) *RequestExecutionUpdateOne {
+	_u.mutation.AppendRequestBody(v)
+	return _u
+}
+
+// SetRequestBodyBlobs sets the "request_body_blobs" field.
+func (_u *RequestExecutionUpdateOne) SetRequestBodyBlobs(v bool) *RequestExecutionUpdateOne {
+	_u.mutation.SetRequestBodyBlobs(v)
+	return _u
+}
+
+// SetNillableRequestBodyBlobs sets the "request_body_blobs" field if the given value is not nil.
+func (_u *RequestExecutionUpdateOne) SetNillableRequestBodyBlobs(v *bool) *RequestExecutionUpdateOne {
+	if v != nil {
+		_u.SetRequestBodyBlobs(*v)
+	}
+	return _u
+}
+
 // SetResponseBody sets the "response_body" field.
 func (_u *RequestExecutionUpdateOne) SetResponseBody(v objects.JSONRawMessage) *RequestExecutionUpdateOne {
 	_u.mutation.SetResponseBody(v)
@@ -854,6 +917,17 @@ func (_u *RequestExecutionUpdateOne) sqlSave(ctx context.Context) (_node *Reques
 	if _u.mutation.ExternalIDCleared() {
 		_spec.ClearField(requestexecution.FieldExternalID, field.TypeString)
 	}
+	if value, ok := _u.mutation.RequestBody(); ok {
+		_spec.SetField(requestexecution.FieldRequestBody, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRequestBody(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requestexecution.FieldRequestBody, value)
+		})
+	}
+	if value, ok := _u.mutation.RequestBodyBlobs(); ok {
+		_spec.SetField(requestexecution.FieldRequestBodyBlobs, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.ResponseBody(); ok {
 		_spec.SetField(requestexecution.FieldResponseBody, field.TypeJSON, value)
 	}
@@ -0,0 +1,147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/looplj/axonhub/internal/ent/requestsearchterm"
+)
+
+// RequestSearchTerm is the model entity for the RequestSearchTerm schema.
+type RequestSearchTerm struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID int `json:"project_id,omitempty"`
+	// RequestID holds the value of the "request_id" field.
+	RequestID int `json:"request_id,omitempty"`
+	// Where the term is found, the tool field holds the names of the tools declared or called
+	Field requestsearchterm.Field `json:"field,omitempty"`
+	// Term holds the value of the "term" field.
+	Term string `json:"term,omitempty"`
+	// Number of occurrences of the term in the field
+	Frequency    int `json:"frequency,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RequestSearchTerm) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case requestsearchterm.FieldID, requestsearchterm.FieldProjectID, requestsearchterm.FieldRequestID, requestsearchterm.FieldFrequency:
+			values[i] = new(sql.NullInt64)
+		case requestsearchterm.FieldField, requestsearchterm.FieldTerm:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RequestSearchTerm fields.
+func (_m *RequestSearchTerm) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case requestsearchterm.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case requestsearchterm.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				_m.ProjectID = int(value.Int64)
+			}
+		case requestsearchterm.FieldRequestID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				_m.RequestID = int(value.Int64)
+			}
+		case requestsearchterm.FieldField:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field field", values[i])
+			} else if value.Valid {
+				_m.Field = requestsearchterm.Field(value.String)
+			}
+		case requestsearchterm.FieldTerm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field term", values[i])
+			} else if value.Valid {
+				_m.Term = value.String
+			}
+		case requestsearchterm.FieldFrequency:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field frequency", values[i])
+			} else if value.Valid {
+				_m.Frequency = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RequestSearchTerm.
+// This includes values selected through modifiers, order, etc.
+func (_m *RequestSearchTerm) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RequestSearchTerm.
+// Note that you need to call RequestSearchTerm.Unwrap() before calling this method if this RequestSearchTerm
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RequestSearchTerm) Update() *RequestSearchTermUpdateOne {
+	return NewRequestSearchTermClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RequestSearchTerm entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RequestSearchTerm) Unwrap() *RequestSearchTerm {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RequestSearchTerm is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RequestSearchTerm) String() string {
+	var builder strings.Builder
+	builder.WriteString("RequestSearchTerm(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("request_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequestID))
+	builder.WriteString(", ")
+	builder.WriteString("field=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Field))
+	builder.WriteString(", ")
+	builder.WriteString("term=")
+	builder.WriteString(_m.Term)
+	builder.WriteString(", ")
+	builder.WriteString("frequency=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Frequency))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RequestSearchTerms is a parsable slice of RequestSearchTerm.
+type RequestSearchTerms []*RequestSearchTerm
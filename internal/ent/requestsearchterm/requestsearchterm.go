@@ -0,0 +1,140 @@
+// Code generated by ent, DO NOT EDIT.
+
+package requestsearchterm
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the requestsearchterm type in the database.
+	Label = "request_search_term"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProjectID holds the string denoting the project_id field in the database.
+	FieldProjectID = "project_id"
+	// FieldRequestID holds the string denoting the request_id field in the database.
+	FieldRequestID = "request_id"
+	// FieldField holds the string denoting the field field in the database.
+	FieldField = "field"
+	// FieldTerm holds the string denoting the term field in the database.
+	FieldTerm = "term"
+	// FieldFrequency holds the string denoting the frequency field in the database.
+	FieldFrequency = "frequency"
+	// Table holds the table name of the requestsearchterm in the database.
+	Table = "request_search_terms"
+)
+
+// Columns holds all SQL columns for requestsearchterm fields.
+var Columns = []string{
+	FieldID,
+	FieldProjectID,
+	FieldRequestID,
+	FieldField,
+	FieldTerm,
+	FieldFrequency,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/looplj/axonhub/internal/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// TermValidator is a validator for the "term" field. It is called by the builders before save.
+	TermValidator func(string) error
+	// DefaultFrequency holds the default value on creation for the "frequency" field.
+	DefaultFrequency int
+)
+
+// Field defines the type for the "field" enum field.
+type Field string
+
+// Field values.
+const (
+	FieldRequest  Field = "request"
+	FieldResponse Field = "response"
+	FieldTool     Field = "tool"
+)
+
+func (f Field) String() string {
+	return string(f)
+}
+
+// FieldValidator is a validator for the "field" field enum values. It is called by the builders before save.
+func FieldValidator(f Field) error {
+	switch f {
+	case FieldRequest, FieldResponse, FieldTool:
+		return nil
+	default:
+		return fmt.Errorf("requestsearchterm: invalid enum value for field field: %q", f)
+	}
+}
+
+// OrderOption defines the ordering options for the RequestSearchTerm queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProjectID orders the results by the project_id field.
+func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
+}
+
+// ByRequestID orders the results by the request_id field.
+func ByRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestID, opts...).ToFunc()
+}
+
+// ByField orders the results by the field field.
+func ByField(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldField, opts...).ToFunc()
+}
+
+// ByTerm orders the results by the term field.
+func ByTerm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTerm, opts...).ToFunc()
+}
+
+// ByFrequency orders the results by the frequency field.
+func ByFrequency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFrequency, opts...).ToFunc()
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Field) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Field) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Field(str)
+	if err := FieldValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Field", str)
+	}
+	return nil
+}
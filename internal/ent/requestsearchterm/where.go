@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package requestsearchterm
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldLTE(FieldID, id))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldEQ(FieldProjectID, v))
+}
+
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldEQ(FieldRequestID, v))
+}
+
+// Term applies equality check predicate on the "term" field. It's identical to TermEQ.
+func Term(v string) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldEQ(FieldTerm, v))
+}
+
+// Frequency applies equality check predicate on the "frequency" field. It's identical to FrequencyEQ.
+func Frequency(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldEQ(FieldFrequency, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDGT applies the GT predicate on the "project_id" field.
+func ProjectIDGT(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldGT(FieldProjectID, v))
+}
+
+// ProjectIDGTE applies the GTE predicate on the "project_id" field.
+func ProjectIDGTE(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldGTE(FieldProjectID, v))
+}
+
+// ProjectIDLT applies the LT predicate on the "project_id" field.
+func ProjectIDLT(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldLT(FieldProjectID, v))
+}
+
+// ProjectIDLTE applies the LTE predicate on the "project_id" field.
+func ProjectIDLTE(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldLTE(FieldProjectID, v))
+}
+
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldLTE(FieldRequestID, v))
+}
+
+// FieldEQ applies the EQ predicate on the "field" field.
+func FieldEQ(v Field) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldEQ(FieldField, v))
+}
+
+// FieldNEQ applies the NEQ predicate on the "field" field.
+func FieldNEQ(v Field) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldNEQ(FieldField, v))
+}
+
+// FieldIn applies the In predicate on the "field" field.
+func FieldIn(vs ...Field) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldIn(FieldField, vs...))
+}
+
+// FieldNotIn applies the NotIn predicate on the "field" field.
+func FieldNotIn(vs ...Field) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldNotIn(FieldField, vs...))
+}
+
+// TermEQ applies the EQ predicate on the "term" field.
+func TermEQ(v string) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldEQ(FieldTerm, v))
+}
+
+// TermNEQ applies the NEQ predicate on the "term" field.
+func TermNEQ(v string) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldNEQ(FieldTerm, v))
+}
+
+// TermIn applies the In predicate on the "term" field.
+func TermIn(vs ...string) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldIn(FieldTerm, vs...))
+}
+
+// TermNotIn applies the NotIn predicate on the "term" field.
+func TermNotIn(vs ...string) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldNotIn(FieldTerm, vs...))
+}
+
+// TermGT applies the GT predicate on the "term" field.
+func TermGT(v string) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldGT(FieldTerm, v))
+}
+
+// TermGTE applies the GTE predicate on the "term" field.
+func TermGTE(v string) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldGTE(FieldTerm, v))
+}
+
+// TermLT applies the LT predicate on the "term" field.
+func TermLT(v string) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldLT(FieldTerm, v))
+}
+
+// TermLTE applies the LTE predicate on the "term" field.
+func TermLTE(v string) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldLTE(FieldTerm, v))
+}
+
+// TermContains applies the Contains predicate on the "term" field.
+func TermContains(v string) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldContains(FieldTerm, v))
+}
+
+// TermHasPrefix applies the HasPrefix predicate on the "term" field.
+func TermHasPrefix(v string) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldHasPrefix(FieldTerm, v))
+}
+
+// TermHasSuffix applies the HasSuffix predicate on the "term" field.
+func TermHasSuffix(v string) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldHasSuffix(FieldTerm, v))
+}
+
+// TermEqualFold applies the EqualFold predicate on the "term" field.
+func TermEqualFold(v string) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldEqualFold(FieldTerm, v))
+}
+
+// TermContainsFold applies the ContainsFold predicate on the "term" field.
+func TermContainsFold(v string) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldContainsFold(FieldTerm, v))
+}
+
+// FrequencyEQ applies the EQ predicate on the "frequency" field.
+func FrequencyEQ(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldEQ(FieldFrequency, v))
+}
+
+// FrequencyNEQ applies the NEQ predicate on the "frequency" field.
+func FrequencyNEQ(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldNEQ(FieldFrequency, v))
+}
+
+// FrequencyIn applies the In predicate on the "frequency" field.
+func FrequencyIn(vs ...int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldIn(FieldFrequency, vs...))
+}
+
+// FrequencyNotIn applies the NotIn predicate on the "frequency" field.
+func FrequencyNotIn(vs ...int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldNotIn(FieldFrequency, vs...))
+}
+
+// FrequencyGT applies the GT predicate on the "frequency" field.
+func FrequencyGT(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldGT(FieldFrequency, v))
+}
+
+// FrequencyGTE applies the GTE predicate on the "frequency" field.
+func FrequencyGTE(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldGTE(FieldFrequency, v))
+}
+
+// FrequencyLT applies the LT predicate on the "frequency" field.
+func FrequencyLT(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldLT(FieldFrequency, v))
+}
+
+// FrequencyLTE applies the LTE predicate on the "frequency" field.
+func FrequencyLTE(v int) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.FieldLTE(FieldFrequency, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RequestSearchTerm) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RequestSearchTerm) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RequestSearchTerm) predicate.RequestSearchTerm {
+	return predicate.RequestSearchTerm(sql.NotPredicates(p))
+}
@@ -0,0 +1,533 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/requestsearchterm"
+)
+
+// RequestSearchTermCreate is the builder for creating a RequestSearchTerm entity.
+type RequestSearchTermCreate struct {
+	config
+	mutation *RequestSearchTermMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetProjectID sets the "project_id" field.
+func (_c *RequestSearchTermCreate) SetProjectID(v int) *RequestSearchTermCreate {
+	_c.mutation.SetProjectID(v)
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *RequestSearchTermCreate) SetRequestID(v int) *RequestSearchTermCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetField sets the "field" field.
+func (_c *RequestSearchTermCreate) SetField(v requestsearchterm.Field) *RequestSearchTermCreate {
+	_c.mutation.SetFieldField(v)
+	return _c
+}
+
+// SetTerm sets the "term" field.
+func (_c *RequestSearchTermCreate) SetTerm(v string) *RequestSearchTermCreate {
+	_c.mutation.SetTerm(v)
+	return _c
+}
+
+// SetFrequency sets the "frequency" field.
+func (_c *RequestSearchTermCreate) SetFrequency(v int) *RequestSearchTermCreate {
+	_c.mutation.SetFrequency(v)
+	return _c
+}
+
+// SetNillableFrequency sets the "frequency" field if the given value is not nil.
+func (_c *RequestSearchTermCreate) SetNillableFrequency(v *int) *RequestSearchTermCreate {
+	if v != nil {
+		_c.SetFrequency(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RequestSearchTermMutation object of the builder.
+func (_c *RequestSearchTermCreate) Mutation() *RequestSearchTermMutation {
+	return _c.mutation
+}
+
+// Save creates the RequestSearchTerm in the database.
+func (_c *RequestSearchTermCreate) Save(ctx context.Context) (*RequestSearchTerm, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RequestSearchTermCreate) SaveX(ctx context.Context) *RequestSearchTerm {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RequestSearchTermCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RequestSearchTermCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RequestSearchTermCreate) defaults() error {
+	if _, ok := _c.mutation.Frequency(); !ok {
+		v := requestsearchterm.DefaultFrequency
+		_c.mutation.SetFrequency(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RequestSearchTermCreate) check() error {
+	if _, ok := _c.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "RequestSearchTerm.project_id"`)}
+	}
+	if _, ok := _c.mutation.RequestID(); !ok {
+		return &ValidationError{Name: "request_id", err: errors.New(`ent: missing required field "RequestSearchTerm.request_id"`)}
+	}
+	if _, ok := _c.mutation.GetField(); !ok {
+		return &ValidationError{Name: "field", err: errors.New(`ent: missing required field "RequestSearchTerm.field"`)}
+	}
+	if v, ok := _c.mutation.GetField(); ok {
+		if err := requestsearchterm.FieldValidator(v); err != nil {
+			return &ValidationError{Name: "field", err: fmt.Errorf(`ent: validator failed for field "RequestSearchTerm.field": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Term(); !ok {
+		return &ValidationError{Name: "term", err: errors.New(`ent: missing required field "RequestSearchTerm.term"`)}
+	}
+	if v, ok := _c.mutation.Term(); ok {
+		if err := requestsearchterm.TermValidator(v); err != nil {
+			return &ValidationError{Name: "term", err: fmt.Errorf(`ent: validator failed for field "RequestSearchTerm.term": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Frequency(); !ok {
+		return &ValidationError{Name: "frequency", err: errors.New(`ent: missing required field "RequestSearchTerm.frequency"`)}
+	}
+	return nil
+}
+
+func (_c *RequestSearchTermCreate) sqlSave(ctx context.Context) (*RequestSearchTerm, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RequestSearchTermCreate) createSpec() (*RequestSearchTerm, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RequestSearchTerm{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(requestsearchterm.Table, sqlgraph.NewFieldSpec(requestsearchterm.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.ProjectID(); ok {
+		_spec.SetField(requestsearchterm.FieldProjectID, field.TypeInt, value)
+		_node.ProjectID = value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(requestsearchterm.FieldRequestID, field.TypeInt, value)
+		_node.RequestID = value
+	}
+	if value, ok := _c.mutation.GetField(); ok {
+		_spec.SetField(requestsearchterm.FieldField, field.TypeEnum, value)
+		_node.Field = value
+	}
+	if value, ok := _c.mutation.Term(); ok {
+		_spec.SetField(requestsearchterm.FieldTerm, field.TypeString, value)
+		_node.Term = value
+	}
+	if value, ok := _c.mutation.Frequency(); ok {
+		_spec.SetField(requestsearchterm.FieldFrequency, field.TypeInt, value)
+		_node.Frequency = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RequestSearchTerm.Create().
+//		SetProjectID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RequestSearchTermUpsert) {
+//			SetProjectID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RequestSearchTermCreate) OnConflict(opts ...sql.ConflictOption) *RequestSearchTermUpsertOne {
+	_c.conflict = opts
+	return &RequestSearchTermUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RequestSearchTerm.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RequestSearchTermCreate) OnConflictColumns(columns ...string) *RequestSearchTermUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RequestSearchTermUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RequestSearchTermUpsertOne is the builder for "upsert"-ing
+	//  one RequestSearchTerm node.
+	RequestSearchTermUpsertOne struct {
+		create *RequestSearchTermCreate
+	}
+
+	// RequestSearchTermUpsert is the "OnConflict" setter.
+	RequestSearchTermUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.RequestSearchTerm.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RequestSearchTermUpsertOne) UpdateNewValues() *RequestSearchTermUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(requestsearchterm.FieldProjectID)
+		}
+		if _, exists := u.create.mutation.RequestID(); exists {
+			s.SetIgnore(requestsearchterm.FieldRequestID)
+		}
+		if _, exists := u.create.mutation.GetField(); exists {
+			s.SetIgnore(requestsearchterm.FieldField)
+		}
+		if _, exists := u.create.mutation.Term(); exists {
+			s.SetIgnore(requestsearchterm.FieldTerm)
+		}
+		if _, exists := u.create.mutation.Frequency(); exists {
+			s.SetIgnore(requestsearchterm.FieldFrequency)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RequestSearchTerm.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RequestSearchTermUpsertOne) Ignore() *RequestSearchTermUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RequestSearchTermUpsertOne) DoNothing() *RequestSearchTermUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RequestSearchTermCreate.OnConflict
+// documentation for more info.
+func (u *RequestSearchTermUpsertOne) Update(set func(*RequestSearchTermUpsert)) *RequestSearchTermUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RequestSearchTermUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *RequestSearchTermUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RequestSearchTermCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RequestSearchTermUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RequestSearchTermUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RequestSearchTermUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RequestSearchTermCreateBulk is the builder for creating many RequestSearchTerm entities in bulk.
+type RequestSearchTermCreateBulk struct {
+	config
+	err      error
+	builders []*RequestSearchTermCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RequestSearchTerm entities in the database.
+func (_c *RequestSearchTermCreateBulk) Save(ctx context.Context) ([]*RequestSearchTerm, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RequestSearchTerm, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RequestSearchTermMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RequestSearchTermCreateBulk) SaveX(ctx context.Context) []*RequestSearchTerm {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RequestSearchTermCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RequestSearchTermCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RequestSearchTerm.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RequestSearchTermUpsert) {
+//			SetProjectID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RequestSearchTermCreateBulk) OnConflict(opts ...sql.ConflictOption) *RequestSearchTermUpsertBulk {
+	_c.conflict = opts
+	return &RequestSearchTermUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RequestSearchTerm.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RequestSearchTermCreateBulk) OnConflictColumns(columns ...string) *RequestSearchTermUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RequestSearchTermUpsertBulk{
+		create: _c,
+	}
+}
+
+// RequestSearchTermUpsertBulk is the builder for "upsert"-ing
+// a bulk of RequestSearchTerm nodes.
+type RequestSearchTermUpsertBulk struct {
+	create *RequestSearchTermCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RequestSearchTerm.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RequestSearchTermUpsertBulk) UpdateNewValues() *RequestSearchTermUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(requestsearchterm.FieldProjectID)
+			}
+			if _, exists := b.mutation.RequestID(); exists {
+				s.SetIgnore(requestsearchterm.FieldRequestID)
+			}
+			if _, exists := b.mutation.GetField(); exists {
+				s.SetIgnore(requestsearchterm.FieldField)
+			}
+			if _, exists := b.mutation.Term(); exists {
+				s.SetIgnore(requestsearchterm.FieldTerm)
+			}
+			if _, exists := b.mutation.Frequency(); exists {
+				s.SetIgnore(requestsearchterm.FieldFrequency)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RequestSearchTerm.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RequestSearchTermUpsertBulk) Ignore() *RequestSearchTermUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RequestSearchTermUpsertBulk) DoNothing() *RequestSearchTermUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RequestSearchTermCreateBulk.OnConflict
+// documentation for more info.
+func (u *RequestSearchTermUpsertBulk) Update(set func(*RequestSearchTermUpsert)) *RequestSearchTermUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RequestSearchTermUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *RequestSearchTermUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RequestSearchTermCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RequestSearchTermCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RequestSearchTermUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/ent/requestsearchterm"
+)
+
+// RequestSearchTermDelete is the builder for deleting a RequestSearchTerm entity.
+type RequestSearchTermDelete struct {
+	config
+	hooks    []Hook
+	mutation *RequestSearchTermMutation
+}
+
+// Where appends a list predicates to the RequestSearchTermDelete builder.
+func (_d *RequestSearchTermDelete) Where(ps ...predicate.RequestSearchTerm) *RequestSearchTermDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *RequestSearchTermDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RequestSearchTermDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *RequestSearchTermDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(requestsearchterm.Table, sqlgraph.NewFieldSpec(requestsearchterm.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// RequestSearchTermDeleteOne is the builder for deleting a single RequestSearchTerm entity.
+type RequestSearchTermDeleteOne struct {
+	_d *RequestSearchTermDelete
+}
+
+// Where appends a list predicates to the RequestSearchTermDelete builder.
+func (_d *RequestSearchTermDeleteOne) Where(ps ...predicate.RequestSearchTerm) *RequestSearchTermDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *RequestSearchTermDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{requestsearchterm.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RequestSearchTermDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/ent/requestsearchterm"
+)
+
+// RequestSearchTermQuery is the builder for querying RequestSearchTerm entities.
+type RequestSearchTermQuery struct {
+	config
+	ctx        *QueryContext
+	order      []requestsearchterm.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RequestSearchTerm
+	loadTotal  []func(context.Context, []*RequestSearchTerm) error
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RequestSearchTermQuery builder.
+func (_q *RequestSearchTermQuery) Where(ps ...predicate.RequestSearchTerm) *RequestSearchTermQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *RequestSearchTermQuery) Limit(limit int) *RequestSearchTermQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *RequestSearchTermQuery) Offset(offset int) *RequestSearchTermQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *RequestSearchTermQuery) Unique(unique bool) *RequestSearchTermQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *RequestSearchTermQuery) Order(o ...requestsearchterm.OrderOption) *RequestSearchTermQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// First returns the first RequestSearchTerm entity from the query.
+// Returns a *NotFoundError when no RequestSearchTerm was found.
+func (_q *RequestSearchTermQuery) First(ctx context.Context) (*RequestSearchTerm, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{requestsearchterm.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *RequestSearchTermQuery) FirstX(ctx context.Context) *RequestSearchTerm {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RequestSearchTerm ID from the query.
+// Returns a *NotFoundError when no RequestSearchTerm ID was found.
+func (_q *RequestSearchTermQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{requestsearchterm.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *RequestSearchTermQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RequestSearchTerm entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RequestSearchTerm entity is found.
+// Returns a *NotFoundError when no RequestSearchTerm entities are found.
+func (_q *RequestSearchTermQuery) Only(ctx context.Context) (*RequestSearchTerm, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{requestsearchterm.Label}
+	default:
+		return nil, &NotSingularError{requestsearchterm.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *RequestSearchTermQuery) OnlyX(ctx context.Context) *RequestSearchTerm {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RequestSearchTerm ID in the query.
+// Returns a *NotSingularError when more than one RequestSearchTerm ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *RequestSearchTermQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{requestsearchterm.Label}
+	default:
+		err = &NotSingularError{requestsearchterm.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *RequestSearchTermQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RequestSearchTerms.
+func (_q *RequestSearchTermQuery) All(ctx context.Context) ([]*RequestSearchTerm, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RequestSearchTerm, *RequestSearchTermQuery]()
+	return withInterceptors[[]*RequestSearchTerm](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *RequestSearchTermQuery) AllX(ctx context.Context) []*RequestSearchTerm {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RequestSearchTerm IDs.
+func (_q *RequestSearchTermQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(requestsearchterm.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *RequestSearchTermQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *RequestSearchTermQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*RequestSearchTermQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *RequestSearchTermQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *RequestSearchTermQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *RequestSearchTermQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RequestSearchTermQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *RequestSearchTermQuery) Clone() *RequestSearchTermQuery {
+	if _q == nil {
+		return nil
+	}
+	return &RequestSearchTermQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]requestsearchterm.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.RequestSearchTerm{}, _q.predicates...),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProjectID int `json:"project_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RequestSearchTerm.Query().
+//		GroupBy(requestsearchterm.FieldProjectID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *RequestSearchTermQuery) GroupBy(field string, fields ...string) *RequestSearchTermGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RequestSearchTermGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = requestsearchterm.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProjectID int `json:"project_id,omitempty"`
+//	}
+//
+//	client.RequestSearchTerm.Query().
+//		Select(requestsearchterm.FieldProjectID).
+//		Scan(ctx, &v)
+func (_q *RequestSearchTermQuery) Select(fields ...string) *RequestSearchTermSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &RequestSearchTermSelect{RequestSearchTermQuery: _q}
+	sbuild.label = requestsearchterm.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RequestSearchTermSelect configured with the given aggregations.
+func (_q *RequestSearchTermQuery) Aggregate(fns ...AggregateFunc) *RequestSearchTermSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *RequestSearchTermQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !requestsearchterm.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	if requestsearchterm.Policy == nil {
+		return errors.New("ent: uninitialized requestsearchterm.Policy (forgotten import ent/runtime?)")
+	}
+	if err := requestsearchterm.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (_q *RequestSearchTermQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RequestSearchTerm, error) {
+	var (
+		nodes = []*RequestSearchTerm{}
+		_spec = _q.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RequestSearchTerm).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RequestSearchTerm{config: _q.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range _q.loadTotal {
+		if err := _q.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *RequestSearchTermQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *RequestSearchTermQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(requestsearchterm.Table, requestsearchterm.Columns, sqlgraph.NewFieldSpec(requestsearchterm.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, requestsearchterm.FieldID)
+		for i := range fields {
+			if fields[i] != requestsearchterm.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *RequestSearchTermQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(requestsearchterm.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = requestsearchterm.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *RequestSearchTermQuery) Modify(modifiers ...func(s *sql.Selector)) *RequestSearchTermSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// RequestSearchTermGroupBy is the group-by builder for RequestSearchTerm entities.
+type RequestSearchTermGroupBy struct {
+	selector
+	build *RequestSearchTermQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *RequestSearchTermGroupBy) Aggregate(fns ...AggregateFunc) *RequestSearchTermGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *RequestSearchTermGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RequestSearchTermQuery, *RequestSearchTermGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *RequestSearchTermGroupBy) sqlScan(ctx context.Context, root *RequestSearchTermQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RequestSearchTermSelect is the builder for selecting fields of RequestSearchTerm entities.
+type RequestSearchTermSelect struct {
+	*RequestSearchTermQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *RequestSearchTermSelect) Aggregate(fns ...AggregateFunc) *RequestSearchTermSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *RequestSearchTermSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RequestSearchTermQuery, *RequestSearchTermSelect](ctx, _s.RequestSearchTermQuery, _s, _s.inters, v)
+}
+
+func (_s *RequestSearchTermSelect) sqlScan(ctx context.Context, root *RequestSearchTermQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *RequestSearchTermSelect) Modify(modifiers ...func(s *sql.Selector)) *RequestSearchTermSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/ent/requestsearchterm"
+)
+
+// RequestSearchTermUpdate is the builder for updating RequestSearchTerm entities.
+type RequestSearchTermUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RequestSearchTermMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RequestSearchTermUpdate builder.
+func (_u *RequestSearchTermUpdate) Where(ps ...predicate.RequestSearchTerm) *RequestSearchTermUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the RequestSearchTermMutation object of the builder.
+func (_u *RequestSearchTermUpdate) Mutation() *RequestSearchTermMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RequestSearchTermUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RequestSearchTermUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RequestSearchTermUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RequestSearchTermUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RequestSearchTermUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RequestSearchTermUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RequestSearchTermUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(requestsearchterm.Table, requestsearchterm.Columns, sqlgraph.NewFieldSpec(requestsearchterm.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{requestsearchterm.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RequestSearchTermUpdateOne is the builder for updating a single RequestSearchTerm entity.
+type RequestSearchTermUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RequestSearchTermMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the RequestSearchTermMutation object of the builder.
+func (_u *RequestSearchTermUpdateOne) Mutation() *RequestSearchTermMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RequestSearchTermUpdate builder.
+func (_u *RequestSearchTermUpdateOne) Where(ps ...predicate.RequestSearchTerm) *RequestSearchTermUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RequestSearchTermUpdateOne) Select(field string, fields ...string) *RequestSearchTermUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RequestSearchTerm entity.
+func (_u *RequestSearchTermUpdateOne) Save(ctx context.Context) (*RequestSearchTerm, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RequestSearchTermUpdateOne) SaveX(ctx context.Context) *RequestSearchTerm {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RequestSearchTermUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RequestSearchTermUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RequestSearchTermUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RequestSearchTermUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RequestSearchTermUpdateOne) sqlSave(ctx context.Context) (_node *RequestSearchTerm, err error) {
+	_spec := sqlgraph.NewUpdateSpec(requestsearchterm.Table, requestsearchterm.Columns, sqlgraph.NewFieldSpec(requestsearchterm.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RequestSearchTerm.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, requestsearchterm.FieldID)
+		for _, f := range fields {
+			if !requestsearchterm.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != requestsearchterm.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &RequestSearchTerm{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{requestsearchterm.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,12 +8,18 @@ import (
 
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/apikeyprofiletemplate"
+	"github.com/looplj/axonhub/internal/ent/auditlog"
+	"github.com/looplj/axonhub/internal/ent/budget"
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/channelmodelprice"
 	"github.com/looplj/axonhub/internal/ent/channelmodelpriceversion"
 	"github.com/looplj/axonhub/internal/ent/channeloverridetemplate"
+	"github.com/looplj/axonhub/internal/ent/contentblob"
+	"github.com/looplj/axonhub/internal/ent/dataexport"
 	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/exchangerate"
 	"github.com/looplj/axonhub/internal/ent/invitation"
+	"github.com/looplj/axonhub/internal/ent/invoice"
 	"github.com/looplj/axonhub/internal/ent/model"
 	"github.com/looplj/axonhub/internal/ent/oidcidentity"
 	"github.com/looplj/axonhub/internal/ent/project"
@@ -22,15 +28,23 @@ import (
 	"github.com/looplj/axonhub/internal/ent/providerquotastatus"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/ent/requestsearchterm"
 	"github.com/looplj/axonhub/internal/ent/role"
 	"github.com/looplj/axonhub/internal/ent/schema"
+	"github.com/looplj/axonhub/internal/ent/scimgroup"
 	"github.com/looplj/axonhub/internal/ent/system"
 	"github.com/looplj/axonhub/internal/ent/thread"
 	"github.com/looplj/axonhub/internal/ent/trace"
+	"github.com/looplj/axonhub/internal/ent/usagedailyrollup"
+	"github.com/looplj/axonhub/internal/ent/usagehourlyrollup"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/ent/user"
 	"github.com/looplj/axonhub/internal/ent/userproject"
 	"github.com/looplj/axonhub/internal/ent/userrole"
+	"github.com/looplj/axonhub/internal/ent/wallet"
+	"github.com/looplj/axonhub/internal/ent/walletreservation"
+	"github.com/looplj/axonhub/internal/ent/wallettransaction"
+	"github.com/looplj/axonhub/internal/ent/webhookdelivery"
 	"github.com/looplj/axonhub/internal/objects"
 
 	"entgo.io/ent"
@@ -135,6 +149,80 @@ func init() {
 	apikeyprofiletemplateDescProfile := apikeyprofiletemplateFields[3].Descriptor()
 	// apikeyprofiletemplate.DefaultProfile holds the default value on creation for the profile field.
 	apikeyprofiletemplate.DefaultProfile = apikeyprofiletemplateDescProfile.Default.(*objects.APIKeyProfile)
+	auditlog.Policy = privacy.NewPolicies(schema.AuditLog{})
+	auditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := auditlog.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[0].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	// auditlogDescActor is the schema descriptor for actor field.
+	auditlogDescActor := auditlogFields[4].Descriptor()
+	// auditlog.DefaultActor holds the default value on creation for the actor field.
+	auditlog.DefaultActor = auditlogDescActor.Default.(string)
+	// auditlogDescEntityType is the schema descriptor for entity_type field.
+	auditlogDescEntityType := auditlogFields[9].Descriptor()
+	// auditlog.DefaultEntityType holds the default value on creation for the entity_type field.
+	auditlog.DefaultEntityType = auditlogDescEntityType.Default.(string)
+	// auditlogDescSourceIP is the schema descriptor for source_ip field.
+	auditlogDescSourceIP := auditlogFields[12].Descriptor()
+	// auditlog.DefaultSourceIP holds the default value on creation for the source_ip field.
+	auditlog.DefaultSourceIP = auditlogDescSourceIP.Default.(string)
+	budgetMixin := schema.Budget{}.Mixin()
+	budget.Policy = privacy.NewPolicies(schema.Budget{})
+	budget.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := budget.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	budgetMixinHooks1 := budgetMixin[1].Hooks()
+
+	budget.Hooks[1] = budgetMixinHooks1[0]
+	budgetMixinInters1 := budgetMixin[1].Interceptors()
+	budget.Interceptors[0] = budgetMixinInters1[0]
+	budgetMixinFields0 := budgetMixin[0].Fields()
+	_ = budgetMixinFields0
+	budgetMixinFields1 := budgetMixin[1].Fields()
+	_ = budgetMixinFields1
+	budgetFields := schema.Budget{}.Fields()
+	_ = budgetFields
+	// budgetDescCreatedAt is the schema descriptor for created_at field.
+	budgetDescCreatedAt := budgetMixinFields0[0].Descriptor()
+	// budget.DefaultCreatedAt holds the default value on creation for the created_at field.
+	budget.DefaultCreatedAt = budgetDescCreatedAt.Default.(func() time.Time)
+	// budgetDescUpdatedAt is the schema descriptor for updated_at field.
+	budgetDescUpdatedAt := budgetMixinFields0[1].Descriptor()
+	// budget.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	budget.DefaultUpdatedAt = budgetDescUpdatedAt.Default.(func() time.Time)
+	// budget.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	budget.UpdateDefaultUpdatedAt = budgetDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// budgetDescDeletedAt is the schema descriptor for deleted_at field.
+	budgetDescDeletedAt := budgetMixinFields1[0].Descriptor()
+	// budget.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	budget.DefaultDeletedAt = budgetDescDeletedAt.Default.(int)
+	// budgetDescDescription is the schema descriptor for description field.
+	budgetDescDescription := budgetFields[2].Descriptor()
+	// budget.DefaultDescription holds the default value on creation for the description field.
+	budget.DefaultDescription = budgetDescDescription.Default.(string)
+	// budgetDescSettings is the schema descriptor for settings field.
+	budgetDescSettings := budgetFields[9].Descriptor()
+	// budget.DefaultSettings holds the default value on creation for the settings field.
+	budget.DefaultSettings = budgetDescSettings.Default.(objects.BudgetSettings)
+	// budgetDescAlertedThreshold is the schema descriptor for alerted_threshold field.
+	budgetDescAlertedThreshold := budgetFields[10].Descriptor()
+	// budget.DefaultAlertedThreshold holds the default value on creation for the alerted_threshold field.
+	budget.DefaultAlertedThreshold = budgetDescAlertedThreshold.Default.(int)
 	channelMixin := schema.Channel{}.Mixin()
 	channel.Policy = privacy.NewPolicies(schema.Channel{})
 	channel.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -320,6 +408,78 @@ func init() {
 	channeloverridetemplateDescBodyOverrideOperations := channeloverridetemplateFields[6].Descriptor()
 	// channeloverridetemplate.DefaultBodyOverrideOperations holds the default value on creation for the body_override_operations field.
 	channeloverridetemplate.DefaultBodyOverrideOperations = channeloverridetemplateDescBodyOverrideOperations.Default.([]objects.OverrideOperation)
+	contentblobMixin := schema.ContentBlob{}.Mixin()
+	contentblob.Policy = privacy.NewPolicies(schema.ContentBlob{})
+	contentblob.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := contentblob.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	contentblobMixinFields0 := contentblobMixin[0].Fields()
+	_ = contentblobMixinFields0
+	contentblobFields := schema.ContentBlob{}.Fields()
+	_ = contentblobFields
+	// contentblobDescCreatedAt is the schema descriptor for created_at field.
+	contentblobDescCreatedAt := contentblobMixinFields0[0].Descriptor()
+	// contentblob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	contentblob.DefaultCreatedAt = contentblobDescCreatedAt.Default.(func() time.Time)
+	// contentblobDescUpdatedAt is the schema descriptor for updated_at field.
+	contentblobDescUpdatedAt := contentblobMixinFields0[1].Descriptor()
+	// contentblob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	contentblob.DefaultUpdatedAt = contentblobDescUpdatedAt.Default.(func() time.Time)
+	// contentblob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	contentblob.UpdateDefaultUpdatedAt = contentblobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// contentblobDescHash is the schema descriptor for hash field.
+	contentblobDescHash := contentblobFields[0].Descriptor()
+	// contentblob.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	contentblob.HashValidator = contentblobDescHash.Validators[0].(func(string) error)
+	// contentblobDescRefCount is the schema descriptor for ref_count field.
+	contentblobDescRefCount := contentblobFields[3].Descriptor()
+	// contentblob.DefaultRefCount holds the default value on creation for the ref_count field.
+	contentblob.DefaultRefCount = contentblobDescRefCount.Default.(int64)
+	dataexportMixin := schema.DataExport{}.Mixin()
+	dataexport.Policy = privacy.NewPolicies(schema.DataExport{})
+	dataexport.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := dataexport.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	dataexportMixinFields0 := dataexportMixin[0].Fields()
+	_ = dataexportMixinFields0
+	dataexportFields := schema.DataExport{}.Fields()
+	_ = dataexportFields
+	// dataexportDescCreatedAt is the schema descriptor for created_at field.
+	dataexportDescCreatedAt := dataexportMixinFields0[0].Descriptor()
+	// dataexport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dataexport.DefaultCreatedAt = dataexportDescCreatedAt.Default.(func() time.Time)
+	// dataexportDescUpdatedAt is the schema descriptor for updated_at field.
+	dataexportDescUpdatedAt := dataexportMixinFields0[1].Descriptor()
+	// dataexport.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dataexport.DefaultUpdatedAt = dataexportDescUpdatedAt.Default.(func() time.Time)
+	// dataexport.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dataexport.UpdateDefaultUpdatedAt = dataexportDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// dataexportDescIncludeBodies is the schema descriptor for include_bodies field.
+	dataexportDescIncludeBodies := dataexportFields[4].Descriptor()
+	// dataexport.DefaultIncludeBodies holds the default value on creation for the include_bodies field.
+	dataexport.DefaultIncludeBodies = dataexportDescIncludeBodies.Default.(bool)
+	// dataexportDescCriteria is the schema descriptor for criteria field.
+	dataexportDescCriteria := dataexportFields[5].Descriptor()
+	// dataexport.DefaultCriteria holds the default value on creation for the criteria field.
+	dataexport.DefaultCriteria = dataexportDescCriteria.Default.(objects.DataExportCriteria)
+	// dataexportDescRowCount is the schema descriptor for row_count field.
+	dataexportDescRowCount := dataexportFields[9].Descriptor()
+	// dataexport.DefaultRowCount holds the default value on creation for the row_count field.
+	dataexport.DefaultRowCount = dataexportDescRowCount.Default.(int64)
+	// dataexportDescSize is the schema descriptor for size field.
+	dataexportDescSize := dataexportFields[10].Descriptor()
+	// dataexport.DefaultSize holds the default value on creation for the size field.
+	dataexport.DefaultSize = dataexportDescSize.Default.(int64)
 	datastorageMixin := schema.DataStorage{}.Mixin()
 	datastorage.Policy = privacy.NewPolicies(schema.DataStorage{})
 	datastorage.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -359,6 +519,34 @@ func init() {
 	datastorageDescPrimary := datastorageFields[2].Descriptor()
 	// datastorage.DefaultPrimary holds the default value on creation for the primary field.
 	datastorage.DefaultPrimary = datastorageDescPrimary.Default.(bool)
+	exchangerateMixin := schema.ExchangeRate{}.Mixin()
+	exchangerate.Policy = privacy.NewPolicies(schema.ExchangeRate{})
+	exchangerate.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := exchangerate.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	exchangerateMixinFields0 := exchangerateMixin[0].Fields()
+	_ = exchangerateMixinFields0
+	exchangerateFields := schema.ExchangeRate{}.Fields()
+	_ = exchangerateFields
+	// exchangerateDescCreatedAt is the schema descriptor for created_at field.
+	exchangerateDescCreatedAt := exchangerateMixinFields0[0].Descriptor()
+	// exchangerate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	exchangerate.DefaultCreatedAt = exchangerateDescCreatedAt.Default.(func() time.Time)
+	// exchangerateDescUpdatedAt is the schema descriptor for updated_at field.
+	exchangerateDescUpdatedAt := exchangerateMixinFields0[1].Descriptor()
+	// exchangerate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	exchangerate.DefaultUpdatedAt = exchangerateDescUpdatedAt.Default.(func() time.Time)
+	// exchangerate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	exchangerate.UpdateDefaultUpdatedAt = exchangerateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// exchangerateDescEffectiveAt is the schema descriptor for effective_at field.
+	exchangerateDescEffectiveAt := exchangerateFields[3].Descriptor()
+	// exchangerate.DefaultEffectiveAt holds the default value on creation for the effective_at field.
+	exchangerate.DefaultEffectiveAt = exchangerateDescEffectiveAt.Default.(func() time.Time)
 	invitationMixin := schema.Invitation{}.Mixin()
 	invitation.Policy = privacy.NewPolicies(schema.Invitation{})
 	invitation.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -402,6 +590,34 @@ func init() {
 	invitationDescUsedCount := invitationFields[4].Descriptor()
 	// invitation.DefaultUsedCount holds the default value on creation for the used_count field.
 	invitation.DefaultUsedCount = invitationDescUsedCount.Default.(int)
+	invoiceMixin := schema.Invoice{}.Mixin()
+	invoice.Policy = privacy.NewPolicies(schema.Invoice{})
+	invoice.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := invoice.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	invoiceMixinFields0 := invoiceMixin[0].Fields()
+	_ = invoiceMixinFields0
+	invoiceFields := schema.Invoice{}.Fields()
+	_ = invoiceFields
+	// invoiceDescCreatedAt is the schema descriptor for created_at field.
+	invoiceDescCreatedAt := invoiceMixinFields0[0].Descriptor()
+	// invoice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invoice.DefaultCreatedAt = invoiceDescCreatedAt.Default.(func() time.Time)
+	// invoiceDescUpdatedAt is the schema descriptor for updated_at field.
+	invoiceDescUpdatedAt := invoiceMixinFields0[1].Descriptor()
+	// invoice.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	invoice.DefaultUpdatedAt = invoiceDescUpdatedAt.Default.(func() time.Time)
+	// invoice.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	invoice.UpdateDefaultUpdatedAt = invoiceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// invoiceDescCurrency is the schema descriptor for currency field.
+	invoiceDescCurrency := invoiceFields[4].Descriptor()
+	// invoice.DefaultCurrency holds the default value on creation for the currency field.
+	invoice.DefaultCurrency = invoiceDescCurrency.Default.(string)
 	modelMixin := schema.Model{}.Mixin()
 	model.Policy = privacy.NewPolicies(schema.Model{})
 	model.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -658,20 +874,28 @@ func init() {
 	requestDescFormat := requestFields[7].Descriptor()
 	// request.DefaultFormat holds the default value on creation for the format field.
 	request.DefaultFormat = requestDescFormat.Default.(string)
+	// requestDescRequestBodyBlobs is the schema descriptor for request_body_blobs field.
+	requestDescRequestBodyBlobs := requestFields[10].Descriptor()
+	// request.DefaultRequestBodyBlobs holds the default value on creation for the request_body_blobs field.
+	request.DefaultRequestBodyBlobs = requestDescRequestBodyBlobs.Default.(bool)
+	// requestDescEndUserID is the schema descriptor for end_user_id field.
+	requestDescEndUserID := requestFields[14].Descriptor()
+	// request.EndUserIDValidator is a validator for the "end_user_id" field. It is called by the builders before save.
+	request.EndUserIDValidator = requestDescEndUserID.Validators[0].(func(string) error)
 	// requestDescExternalID is the schema descriptor for external_id field.
-	requestDescExternalID := requestFields[13].Descriptor()
+	requestDescExternalID := requestFields[15].Descriptor()
 	// request.ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
 	request.ExternalIDValidator = requestDescExternalID.Validators[0].(func(string) error)
 	// requestDescStream is the schema descriptor for stream field.
-	requestDescStream := requestFields[15].Descriptor()
+	requestDescStream := requestFields[17].Descriptor()
 	// request.DefaultStream holds the default value on creation for the stream field.
 	request.DefaultStream = requestDescStream.Default.(bool)
 	// requestDescClientIP is the schema descriptor for client_ip field.
-	requestDescClientIP := requestFields[16].Descriptor()
+	requestDescClientIP := requestFields[18].Descriptor()
 	// request.DefaultClientIP holds the default value on creation for the client_ip field.
 	request.DefaultClientIP = requestDescClientIP.Default.(string)
 	// requestDescContentSaved is the schema descriptor for content_saved field.
-	requestDescContentSaved := requestFields[20].Descriptor()
+	requestDescContentSaved := requestFields[22].Descriptor()
 	// request.DefaultContentSaved holds the default value on creation for the content_saved field.
 	request.DefaultContentSaved = requestDescContentSaved.Default.(bool)
 	requestexecutionMixin := schema.RequestExecution{}.Mixin()
@@ -701,14 +925,37 @@ func init() {
 	requestexecutionDescFormat := requestexecutionFields[6].Descriptor()
 	// requestexecution.DefaultFormat holds the default value on creation for the format field.
 	requestexecution.DefaultFormat = requestexecutionDescFormat.Default.(string)
+	// requestexecutionDescRequestBodyBlobs is the schema descriptor for request_body_blobs field.
+	requestexecutionDescRequestBodyBlobs := requestexecutionFields[8].Descriptor()
+	// requestexecution.DefaultRequestBodyBlobs holds the default value on creation for the request_body_blobs field.
+	requestexecution.DefaultRequestBodyBlobs = requestexecutionDescRequestBodyBlobs.Default.(bool)
 	// requestexecutionDescStream is the schema descriptor for stream field.
-	requestexecutionDescStream := requestexecutionFields[13].Descriptor()
+	requestexecutionDescStream := requestexecutionFields[14].Descriptor()
 	// requestexecution.DefaultStream holds the default value on creation for the stream field.
 	requestexecution.DefaultStream = requestexecutionDescStream.Default.(bool)
 	// requestexecutionDescPassThroughApplied is the schema descriptor for pass_through_applied field.
-	requestexecutionDescPassThroughApplied := requestexecutionFields[19].Descriptor()
+	requestexecutionDescPassThroughApplied := requestexecutionFields[20].Descriptor()
 	// requestexecution.DefaultPassThroughApplied holds the default value on creation for the pass_through_applied field.
 	requestexecution.DefaultPassThroughApplied = requestexecutionDescPassThroughApplied.Default.(bool)
+	requestsearchterm.Policy = privacy.NewPolicies(schema.RequestSearchTerm{})
+	requestsearchterm.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := requestsearchterm.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	requestsearchtermFields := schema.RequestSearchTerm{}.Fields()
+	_ = requestsearchtermFields
+	// requestsearchtermDescTerm is the schema descriptor for term field.
+	requestsearchtermDescTerm := requestsearchtermFields[3].Descriptor()
+	// requestsearchterm.TermValidator is a validator for the "term" field. It is called by the builders before save.
+	requestsearchterm.TermValidator = requestsearchtermDescTerm.Validators[0].(func(string) error)
+	// requestsearchtermDescFrequency is the schema descriptor for frequency field.
+	requestsearchtermDescFrequency := requestsearchtermFields[4].Descriptor()
+	// requestsearchterm.DefaultFrequency holds the default value on creation for the frequency field.
+	requestsearchterm.DefaultFrequency = requestsearchtermDescFrequency.Default.(int)
 	roleMixin := schema.Role{}.Mixin()
 	role.Policy = privacy.NewPolicies(schema.Role{})
 	role.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -752,6 +999,34 @@ func init() {
 	roleDescScopes := roleFields[3].Descriptor()
 	// role.DefaultScopes holds the default value on creation for the scopes field.
 	role.DefaultScopes = roleDescScopes.Default.([]string)
+	scimgroupMixin := schema.SCIMGroup{}.Mixin()
+	scimgroup.Policy = privacy.NewPolicies(schema.SCIMGroup{})
+	scimgroup.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := scimgroup.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	scimgroupMixinFields0 := scimgroupMixin[0].Fields()
+	_ = scimgroupMixinFields0
+	scimgroupFields := schema.SCIMGroup{}.Fields()
+	_ = scimgroupFields
+	// scimgroupDescCreatedAt is the schema descriptor for created_at field.
+	scimgroupDescCreatedAt := scimgroupMixinFields0[0].Descriptor()
+	// scimgroup.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scimgroup.DefaultCreatedAt = scimgroupDescCreatedAt.Default.(func() time.Time)
+	// scimgroupDescUpdatedAt is the schema descriptor for updated_at field.
+	scimgroupDescUpdatedAt := scimgroupMixinFields0[1].Descriptor()
+	// scimgroup.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	scimgroup.DefaultUpdatedAt = scimgroupDescUpdatedAt.Default.(func() time.Time)
+	// scimgroup.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	scimgroup.UpdateDefaultUpdatedAt = scimgroupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// scimgroupDescExternalID is the schema descriptor for external_id field.
+	scimgroupDescExternalID := scimgroupFields[1].Descriptor()
+	// scimgroup.DefaultExternalID holds the default value on creation for the external_id field.
+	scimgroup.DefaultExternalID = scimgroupDescExternalID.Default.(string)
 	systemMixin := schema.System{}.Mixin()
 	system.Policy = privacy.NewPolicies(schema.System{})
 	system.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -835,6 +1110,126 @@ func init() {
 	trace.DefaultUpdatedAt = traceDescUpdatedAt.Default.(func() time.Time)
 	// trace.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	trace.UpdateDefaultUpdatedAt = traceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	usagedailyrollupMixin := schema.UsageDailyRollup{}.Mixin()
+	usagedailyrollup.Policy = privacy.NewPolicies(schema.UsageDailyRollup{})
+	usagedailyrollup.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := usagedailyrollup.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	usagedailyrollupMixinFields0 := usagedailyrollupMixin[0].Fields()
+	_ = usagedailyrollupMixinFields0
+	usagedailyrollupFields := schema.UsageDailyRollup{}.Fields()
+	_ = usagedailyrollupFields
+	// usagedailyrollupDescAPIKeyID is the schema descriptor for api_key_id field.
+	usagedailyrollupDescAPIKeyID := usagedailyrollupMixinFields0[2].Descriptor()
+	// usagedailyrollup.DefaultAPIKeyID holds the default value on creation for the api_key_id field.
+	usagedailyrollup.DefaultAPIKeyID = usagedailyrollupDescAPIKeyID.Default.(int)
+	// usagedailyrollupDescChannelID is the schema descriptor for channel_id field.
+	usagedailyrollupDescChannelID := usagedailyrollupMixinFields0[3].Descriptor()
+	// usagedailyrollup.DefaultChannelID holds the default value on creation for the channel_id field.
+	usagedailyrollup.DefaultChannelID = usagedailyrollupDescChannelID.Default.(int)
+	// usagedailyrollupDescEndUserID is the schema descriptor for end_user_id field.
+	usagedailyrollupDescEndUserID := usagedailyrollupMixinFields0[5].Descriptor()
+	// usagedailyrollup.DefaultEndUserID holds the default value on creation for the end_user_id field.
+	usagedailyrollup.DefaultEndUserID = usagedailyrollupDescEndUserID.Default.(string)
+	// usagedailyrollup.EndUserIDValidator is a validator for the "end_user_id" field. It is called by the builders before save.
+	usagedailyrollup.EndUserIDValidator = usagedailyrollupDescEndUserID.Validators[0].(func(string) error)
+	// usagedailyrollupDescRequestCount is the schema descriptor for request_count field.
+	usagedailyrollupDescRequestCount := usagedailyrollupMixinFields0[6].Descriptor()
+	// usagedailyrollup.DefaultRequestCount holds the default value on creation for the request_count field.
+	usagedailyrollup.DefaultRequestCount = usagedailyrollupDescRequestCount.Default.(int64)
+	// usagedailyrollupDescPromptTokens is the schema descriptor for prompt_tokens field.
+	usagedailyrollupDescPromptTokens := usagedailyrollupMixinFields0[7].Descriptor()
+	// usagedailyrollup.DefaultPromptTokens holds the default value on creation for the prompt_tokens field.
+	usagedailyrollup.DefaultPromptTokens = usagedailyrollupDescPromptTokens.Default.(int64)
+	// usagedailyrollupDescPromptCachedTokens is the schema descriptor for prompt_cached_tokens field.
+	usagedailyrollupDescPromptCachedTokens := usagedailyrollupMixinFields0[8].Descriptor()
+	// usagedailyrollup.DefaultPromptCachedTokens holds the default value on creation for the prompt_cached_tokens field.
+	usagedailyrollup.DefaultPromptCachedTokens = usagedailyrollupDescPromptCachedTokens.Default.(int64)
+	// usagedailyrollupDescPromptWriteCachedTokens is the schema descriptor for prompt_write_cached_tokens field.
+	usagedailyrollupDescPromptWriteCachedTokens := usagedailyrollupMixinFields0[9].Descriptor()
+	// usagedailyrollup.DefaultPromptWriteCachedTokens holds the default value on creation for the prompt_write_cached_tokens field.
+	usagedailyrollup.DefaultPromptWriteCachedTokens = usagedailyrollupDescPromptWriteCachedTokens.Default.(int64)
+	// usagedailyrollupDescCompletionTokens is the schema descriptor for completion_tokens field.
+	usagedailyrollupDescCompletionTokens := usagedailyrollupMixinFields0[10].Descriptor()
+	// usagedailyrollup.DefaultCompletionTokens holds the default value on creation for the completion_tokens field.
+	usagedailyrollup.DefaultCompletionTokens = usagedailyrollupDescCompletionTokens.Default.(int64)
+	// usagedailyrollupDescCompletionReasoningTokens is the schema descriptor for completion_reasoning_tokens field.
+	usagedailyrollupDescCompletionReasoningTokens := usagedailyrollupMixinFields0[11].Descriptor()
+	// usagedailyrollup.DefaultCompletionReasoningTokens holds the default value on creation for the completion_reasoning_tokens field.
+	usagedailyrollup.DefaultCompletionReasoningTokens = usagedailyrollupDescCompletionReasoningTokens.Default.(int64)
+	// usagedailyrollupDescTotalTokens is the schema descriptor for total_tokens field.
+	usagedailyrollupDescTotalTokens := usagedailyrollupMixinFields0[12].Descriptor()
+	// usagedailyrollup.DefaultTotalTokens holds the default value on creation for the total_tokens field.
+	usagedailyrollup.DefaultTotalTokens = usagedailyrollupDescTotalTokens.Default.(int64)
+	// usagedailyrollupDescTotalCost is the schema descriptor for total_cost field.
+	usagedailyrollupDescTotalCost := usagedailyrollupMixinFields0[13].Descriptor()
+	// usagedailyrollup.DefaultTotalCost holds the default value on creation for the total_cost field.
+	usagedailyrollup.DefaultTotalCost = usagedailyrollupDescTotalCost.Default.(float64)
+	usagehourlyrollupMixin := schema.UsageHourlyRollup{}.Mixin()
+	usagehourlyrollup.Policy = privacy.NewPolicies(schema.UsageHourlyRollup{})
+	usagehourlyrollup.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := usagehourlyrollup.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	usagehourlyrollupMixinFields0 := usagehourlyrollupMixin[0].Fields()
+	_ = usagehourlyrollupMixinFields0
+	usagehourlyrollupFields := schema.UsageHourlyRollup{}.Fields()
+	_ = usagehourlyrollupFields
+	// usagehourlyrollupDescAPIKeyID is the schema descriptor for api_key_id field.
+	usagehourlyrollupDescAPIKeyID := usagehourlyrollupMixinFields0[2].Descriptor()
+	// usagehourlyrollup.DefaultAPIKeyID holds the default value on creation for the api_key_id field.
+	usagehourlyrollup.DefaultAPIKeyID = usagehourlyrollupDescAPIKeyID.Default.(int)
+	// usagehourlyrollupDescChannelID is the schema descriptor for channel_id field.
+	usagehourlyrollupDescChannelID := usagehourlyrollupMixinFields0[3].Descriptor()
+	// usagehourlyrollup.DefaultChannelID holds the default value on creation for the channel_id field.
+	usagehourlyrollup.DefaultChannelID = usagehourlyrollupDescChannelID.Default.(int)
+	// usagehourlyrollupDescEndUserID is the schema descriptor for end_user_id field.
+	usagehourlyrollupDescEndUserID := usagehourlyrollupMixinFields0[5].Descriptor()
+	// usagehourlyrollup.DefaultEndUserID holds the default value on creation for the end_user_id field.
+	usagehourlyrollup.DefaultEndUserID = usagehourlyrollupDescEndUserID.Default.(string)
+	// usagehourlyrollup.EndUserIDValidator is a validator for the "end_user_id" field. It is called by the builders before save.
+	usagehourlyrollup.EndUserIDValidator = usagehourlyrollupDescEndUserID.Validators[0].(func(string) error)
+	// usagehourlyrollupDescRequestCount is the schema descriptor for request_count field.
+	usagehourlyrollupDescRequestCount := usagehourlyrollupMixinFields0[6].Descriptor()
+	// usagehourlyrollup.DefaultRequestCount holds the default value on creation for the request_count field.
+	usagehourlyrollup.DefaultRequestCount = usagehourlyrollupDescRequestCount.Default.(int64)
+	// usagehourlyrollupDescPromptTokens is the schema descriptor for prompt_tokens field.
+	usagehourlyrollupDescPromptTokens := usagehourlyrollupMixinFields0[7].Descriptor()
+	// usagehourlyrollup.DefaultPromptTokens holds the default value on creation for the prompt_tokens field.
+	usagehourlyrollup.DefaultPromptTokens = usagehourlyrollupDescPromptTokens.Default.(int64)
+	// usagehourlyrollupDescPromptCachedTokens is the schema descriptor for prompt_cached_tokens field.
+	usagehourlyrollupDescPromptCachedTokens := usagehourlyrollupMixinFields0[8].Descriptor()
+	// usagehourlyrollup.DefaultPromptCachedTokens holds the default value on creation for the prompt_cached_tokens field.
+	usagehourlyrollup.DefaultPromptCachedTokens = usagehourlyrollupDescPromptCachedTokens.Default.(int64)
+	// usagehourlyrollupDescPromptWriteCachedTokens is the schema descriptor for prompt_write_cached_tokens field.
+	usagehourlyrollupDescPromptWriteCachedTokens := usagehourlyrollupMixinFields0[9].Descriptor()
+	// usagehourlyrollup.DefaultPromptWriteCachedTokens holds the default value on creation for the prompt_write_cached_tokens field.
+	usagehourlyrollup.DefaultPromptWriteCachedTokens = usagehourlyrollupDescPromptWriteCachedTokens.Default.(int64)
+	// usagehourlyrollupDescCompletionTokens is the schema descriptor for completion_tokens field.
+	usagehourlyrollupDescCompletionTokens := usagehourlyrollupMixinFields0[10].Descriptor()
+	// usagehourlyrollup.DefaultCompletionTokens holds the default value on creation for the completion_tokens field.
+	usagehourlyrollup.DefaultCompletionTokens = usagehourlyrollupDescCompletionTokens.Default.(int64)
+	// usagehourlyrollupDescCompletionReasoningTokens is the schema descriptor for completion_reasoning_tokens field.
+	usagehourlyrollupDescCompletionReasoningTokens := usagehourlyrollupMixinFields0[11].Descriptor()
+	// usagehourlyrollup.DefaultCompletionReasoningTokens holds the default value on creation for the completion_reasoning_tokens field.
+	usagehourlyrollup.DefaultCompletionReasoningTokens = usagehourlyrollupDescCompletionReasoningTokens.Default.(int64)
+	// usagehourlyrollupDescTotalTokens is the schema descriptor for total_tokens field.
+	usagehourlyrollupDescTotalTokens := usagehourlyrollupMixinFields0[12].Descriptor()
+	// usagehourlyrollup.DefaultTotalTokens holds the default value on creation for the total_tokens field.
+	usagehourlyrollup.DefaultTotalTokens = usagehourlyrollupDescTotalTokens.Default.(int64)
+	// usagehourlyrollupDescTotalCost is the schema descriptor for total_cost field.
+	usagehourlyrollupDescTotalCost := usagehourlyrollupMixinFields0[13].Descriptor()
+	// usagehourlyrollup.DefaultTotalCost holds the default value on creation for the total_cost field.
+	usagehourlyrollup.DefaultTotalCost = usagehourlyrollupDescTotalCost.Default.(float64)
 	usagelogMixin := schema.UsageLog{}.Mixin()
 	usagelog.Policy = privacy.NewPolicies(schema.UsageLog{})
 	usagelog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -859,64 +1254,68 @@ func init() {
 	usagelog.DefaultUpdatedAt = usagelogDescUpdatedAt.Default.(func() time.Time)
 	// usagelog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	usagelog.UpdateDefaultUpdatedAt = usagelogDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// usagelogDescEndUserID is the schema descriptor for end_user_id field.
+	usagelogDescEndUserID := usagelogFields[2].Descriptor()
+	// usagelog.EndUserIDValidator is a validator for the "end_user_id" field. It is called by the builders before save.
+	usagelog.EndUserIDValidator = usagelogDescEndUserID.Validators[0].(func(string) error)
 	// usagelogDescProjectID is the schema descriptor for project_id field.
-	usagelogDescProjectID := usagelogFields[2].Descriptor()
+	usagelogDescProjectID := usagelogFields[3].Descriptor()
 	// usagelog.DefaultProjectID holds the default value on creation for the project_id field.
 	usagelog.DefaultProjectID = usagelogDescProjectID.Default.(int)
 	// usagelogDescPromptTokens is the schema descriptor for prompt_tokens field.
-	usagelogDescPromptTokens := usagelogFields[5].Descriptor()
+	usagelogDescPromptTokens := usagelogFields[6].Descriptor()
 	// usagelog.DefaultPromptTokens holds the default value on creation for the prompt_tokens field.
 	usagelog.DefaultPromptTokens = usagelogDescPromptTokens.Default.(int64)
 	// usagelogDescCompletionTokens is the schema descriptor for completion_tokens field.
-	usagelogDescCompletionTokens := usagelogFields[6].Descriptor()
+	usagelogDescCompletionTokens := usagelogFields[7].Descriptor()
 	// usagelog.DefaultCompletionTokens holds the default value on creation for the completion_tokens field.
 	usagelog.DefaultCompletionTokens = usagelogDescCompletionTokens.Default.(int64)
 	// usagelogDescTotalTokens is the schema descriptor for total_tokens field.
-	usagelogDescTotalTokens := usagelogFields[7].Descriptor()
+	usagelogDescTotalTokens := usagelogFields[8].Descriptor()
 	// usagelog.DefaultTotalTokens holds the default value on creation for the total_tokens field.
 	usagelog.DefaultTotalTokens = usagelogDescTotalTokens.Default.(int64)
 	// usagelogDescPromptAudioTokens is the schema descriptor for prompt_audio_tokens field.
-	usagelogDescPromptAudioTokens := usagelogFields[8].Descriptor()
+	usagelogDescPromptAudioTokens := usagelogFields[9].Descriptor()
 	// usagelog.DefaultPromptAudioTokens holds the default value on creation for the prompt_audio_tokens field.
 	usagelog.DefaultPromptAudioTokens = usagelogDescPromptAudioTokens.Default.(int64)
 	// usagelogDescPromptCachedTokens is the schema descriptor for prompt_cached_tokens field.
-	usagelogDescPromptCachedTokens := usagelogFields[9].Descriptor()
+	usagelogDescPromptCachedTokens := usagelogFields[10].Descriptor()
 	// usagelog.DefaultPromptCachedTokens holds the default value on creation for the prompt_cached_tokens field.
 	usagelog.DefaultPromptCachedTokens = usagelogDescPromptCachedTokens.Default.(int64)
 	// usagelogDescPromptWriteCachedTokens is the schema descriptor for prompt_write_cached_tokens field.
-	usagelogDescPromptWriteCachedTokens := usagelogFields[10].Descriptor()
+	usagelogDescPromptWriteCachedTokens := usagelogFields[11].Descriptor()
 	// usagelog.DefaultPromptWriteCachedTokens holds the default value on creation for the prompt_write_cached_tokens field.
 	usagelog.DefaultPromptWriteCachedTokens = usagelogDescPromptWriteCachedTokens.Default.(int64)
 	// usagelogDescPromptWriteCachedTokens5m is the schema descriptor for prompt_write_cached_tokens_5m field.
-	usagelogDescPromptWriteCachedTokens5m := usagelogFields[11].Descriptor()
+	usagelogDescPromptWriteCachedTokens5m := usagelogFields[12].Descriptor()
 	// usagelog.DefaultPromptWriteCachedTokens5m holds the default value on creation for the prompt_write_cached_tokens_5m field.
 	usagelog.DefaultPromptWriteCachedTokens5m = usagelogDescPromptWriteCachedTokens5m.Default.(int64)
 	// usagelogDescPromptWriteCachedTokens1h is the schema descriptor for prompt_write_cached_tokens_1h field.
-	usagelogDescPromptWriteCachedTokens1h := usagelogFields[12].Descriptor()
+	usagelogDescPromptWriteCachedTokens1h := usagelogFields[13].Descriptor()
 	// usagelog.DefaultPromptWriteCachedTokens1h holds the default value on creation for the prompt_write_cached_tokens_1h field.
 	usagelog.DefaultPromptWriteCachedTokens1h = usagelogDescPromptWriteCachedTokens1h.Default.(int64)
 	// usagelogDescCompletionAudioTokens is the schema descriptor for completion_audio_tokens field.
-	usagelogDescCompletionAudioTokens := usagelogFields[13].Descriptor()
+	usagelogDescCompletionAudioTokens := usagelogFields[14].Descriptor()
 	// usagelog.DefaultCompletionAudioTokens holds the default value on creation for the completion_audio_tokens field.
 	usagelog.DefaultCompletionAudioTokens = usagelogDescCompletionAudioTokens.Default.(int64)
 	// usagelogDescCompletionReasoningTokens is the schema descriptor for completion_reasoning_tokens field.
-	usagelogDescCompletionReasoningTokens := usagelogFields[14].Descriptor()
+	usagelogDescCompletionReasoningTokens := usagelogFields[15].Descriptor()
 	// usagelog.DefaultCompletionReasoningTokens holds the default value on creation for the completion_reasoning_tokens field.
 	usagelog.DefaultCompletionReasoningTokens = usagelogDescCompletionReasoningTokens.Default.(int64)
 	// usagelogDescCompletionAcceptedPredictionTokens is the schema descriptor for completion_accepted_prediction_tokens field.
-	usagelogDescCompletionAcceptedPredictionTokens := usagelogFields[15].Descriptor()
+	usagelogDescCompletionAcceptedPredictionTokens := usagelogFields[16].Descriptor()
 	// usagelog.DefaultCompletionAcceptedPredictionTokens holds the default value on creation for the completion_accepted_prediction_tokens field.
 	usagelog.DefaultCompletionAcceptedPredictionTokens = usagelogDescCompletionAcceptedPredictionTokens.Default.(int64)
 	// usagelogDescCompletionRejectedPredictionTokens is the schema descriptor for completion_rejected_prediction_tokens field.
-	usagelogDescCompletionRejectedPredictionTokens := usagelogFields[16].Descriptor()
+	usagelogDescCompletionRejectedPredictionTokens := usagelogFields[17].Descriptor()
 	// usagelog.DefaultCompletionRejectedPredictionTokens holds the default value on creation for the completion_rejected_prediction_tokens field.
 	usagelog.DefaultCompletionRejectedPredictionTokens = usagelogDescCompletionRejectedPredictionTokens.Default.(int64)
 	// usagelogDescFormat is the schema descriptor for format field.
-	usagelogDescFormat := usagelogFields[18].Descriptor()
+	usagelogDescFormat := usagelogFields[19].Descriptor()
 	// usagelog.DefaultFormat holds the default value on creation for the format field.
 	usagelog.DefaultFormat = usagelogDescFormat.Default.(string)
 	// usagelogDescCostItems is the schema descriptor for cost_items field.
-	usagelogDescCostItems := usagelogFields[20].Descriptor()
+	usagelogDescCostItems := usagelogFields[21].Descriptor()
 	// usagelog.DefaultCostItems holds the default value on creation for the cost_items field.
 	usagelog.DefaultCostItems = usagelogDescCostItems.Default.([]objects.CostItem)
 	userMixin := schema.User{}.Mixin()
@@ -1018,6 +1417,126 @@ func init() {
 	userrole.DefaultUpdatedAt = userroleDescUpdatedAt.Default.(func() time.Time)
 	// userrole.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	userrole.UpdateDefaultUpdatedAt = userroleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	walletMixin := schema.Wallet{}.Mixin()
+	wallet.Policy = privacy.NewPolicies(schema.Wallet{})
+	wallet.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := wallet.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	walletMixinFields0 := walletMixin[0].Fields()
+	_ = walletMixinFields0
+	walletFields := schema.Wallet{}.Fields()
+	_ = walletFields
+	// walletDescCreatedAt is the schema descriptor for created_at field.
+	walletDescCreatedAt := walletMixinFields0[0].Descriptor()
+	// wallet.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wallet.DefaultCreatedAt = walletDescCreatedAt.Default.(func() time.Time)
+	// walletDescUpdatedAt is the schema descriptor for updated_at field.
+	walletDescUpdatedAt := walletMixinFields0[1].Descriptor()
+	// wallet.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	wallet.DefaultUpdatedAt = walletDescUpdatedAt.Default.(func() time.Time)
+	// wallet.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	wallet.UpdateDefaultUpdatedAt = walletDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// walletDescBalance is the schema descriptor for balance field.
+	walletDescBalance := walletFields[2].Descriptor()
+	// wallet.DefaultBalance holds the default value on creation for the balance field.
+	wallet.DefaultBalance = walletDescBalance.Default.(float64)
+	// walletDescReserved is the schema descriptor for reserved field.
+	walletDescReserved := walletFields[3].Descriptor()
+	// wallet.DefaultReserved holds the default value on creation for the reserved field.
+	wallet.DefaultReserved = walletDescReserved.Default.(float64)
+	// walletDescLowBalanceAlerted is the schema descriptor for low_balance_alerted field.
+	walletDescLowBalanceAlerted := walletFields[6].Descriptor()
+	// wallet.DefaultLowBalanceAlerted holds the default value on creation for the low_balance_alerted field.
+	wallet.DefaultLowBalanceAlerted = walletDescLowBalanceAlerted.Default.(bool)
+	walletreservationMixin := schema.WalletReservation{}.Mixin()
+	walletreservation.Policy = privacy.NewPolicies(schema.WalletReservation{})
+	walletreservation.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := walletreservation.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	walletreservationMixinFields0 := walletreservationMixin[0].Fields()
+	_ = walletreservationMixinFields0
+	walletreservationFields := schema.WalletReservation{}.Fields()
+	_ = walletreservationFields
+	// walletreservationDescCreatedAt is the schema descriptor for created_at field.
+	walletreservationDescCreatedAt := walletreservationMixinFields0[0].Descriptor()
+	// walletreservation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	walletreservation.DefaultCreatedAt = walletreservationDescCreatedAt.Default.(func() time.Time)
+	// walletreservationDescUpdatedAt is the schema descriptor for updated_at field.
+	walletreservationDescUpdatedAt := walletreservationMixinFields0[1].Descriptor()
+	// walletreservation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	walletreservation.DefaultUpdatedAt = walletreservationDescUpdatedAt.Default.(func() time.Time)
+	// walletreservation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	walletreservation.UpdateDefaultUpdatedAt = walletreservationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	wallettransactionMixin := schema.WalletTransaction{}.Mixin()
+	wallettransaction.Policy = privacy.NewPolicies(schema.WalletTransaction{})
+	wallettransaction.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := wallettransaction.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	wallettransactionMixinFields0 := wallettransactionMixin[0].Fields()
+	_ = wallettransactionMixinFields0
+	wallettransactionFields := schema.WalletTransaction{}.Fields()
+	_ = wallettransactionFields
+	// wallettransactionDescCreatedAt is the schema descriptor for created_at field.
+	wallettransactionDescCreatedAt := wallettransactionMixinFields0[0].Descriptor()
+	// wallettransaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wallettransaction.DefaultCreatedAt = wallettransactionDescCreatedAt.Default.(func() time.Time)
+	// wallettransactionDescUpdatedAt is the schema descriptor for updated_at field.
+	wallettransactionDescUpdatedAt := wallettransactionMixinFields0[1].Descriptor()
+	// wallettransaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	wallettransaction.DefaultUpdatedAt = wallettransactionDescUpdatedAt.Default.(func() time.Time)
+	// wallettransaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	wallettransaction.UpdateDefaultUpdatedAt = wallettransactionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// wallettransactionDescDescription is the schema descriptor for description field.
+	wallettransactionDescDescription := wallettransactionFields[9].Descriptor()
+	// wallettransaction.DefaultDescription holds the default value on creation for the description field.
+	wallettransaction.DefaultDescription = wallettransactionDescDescription.Default.(string)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdelivery.Policy = privacy.NewPolicies(schema.WebhookDelivery{})
+	webhookdelivery.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhookdelivery.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryMixinFields0[1].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescLastError is the schema descriptor for last_error field.
+	webhookdeliveryDescLastError := webhookdeliveryFields[9].Descriptor()
+	// webhookdelivery.DefaultLastError holds the default value on creation for the last_error field.
+	webhookdelivery.DefaultLastError = webhookdeliveryDescLastError.Default.(string)
 }
 
 const (
@@ -78,6 +78,12 @@ func (APIKey) Fields() []ent.Field {
 			Comment("IP CIDR allowlist for this API key. If non-empty, only requests from matching source IPs are accepted.").
 			Default([]string{}).
 			Optional(),
+		field.JSON("token_revocations", &objects.APIKeyTokenRevocations{}).
+			Comment("Revocations of the short-lived tokens minted by this API key").
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
 	}
 }
 
@@ -104,6 +110,11 @@ func (APIKey) Edges() []ent.Edge {
 				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
 				entgql.RelayConnection(),
 			),
+		edge.To("wallet", Wallet.Type).
+			Unique().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 	}
 }
 
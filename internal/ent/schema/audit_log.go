@@ -0,0 +1,112 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xtime"
+	"github.com/looplj/axonhub/internal/scopes"
+)
+
+// AuditLog records a configuration change made through the admin GraphQL API or an admin REST action.
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at").
+			StorageKey("audit_logs_by_created_at"),
+		index.Fields("entity_type", "entity_id").
+			StorageKey("audit_logs_by_entity"),
+		index.Fields("user_id", "created_at").
+			StorageKey("audit_logs_by_user_id_created_at"),
+		index.Fields("project_id", "created_at").
+			StorageKey("audit_logs_by_project_id_created_at"),
+	}
+}
+
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").
+			Immutable().
+			Default(xtime.UTCNow).
+			Annotations(
+				entgql.OrderField("CREATED_AT"),
+				entsql.DefaultExpr("CURRENT_TIMESTAMP"),
+			),
+		field.Enum("actor_type").
+			Values("user", "api_key", "system").
+			Immutable(),
+		field.Int("user_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("User who made the change"),
+		field.Int("api_key_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("API key that made the change, for changes made through the open API"),
+		field.String("actor").
+			Default("").
+			Immutable().
+			Comment("Display name of the actor at the time of the change, e.g. the user email"),
+		field.Int("project_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Project of the changed entity, or the project of the request if the entity is not project scoped"),
+		field.Enum("source").
+			Values("graphql", "rest").
+			Immutable(),
+		field.String("operation").
+			Immutable().
+			Comment("GraphQL mutation field or REST route that triggered the change"),
+		field.Enum("action").
+			Values("create", "update", "delete", "execute").
+			Immutable().
+			Comment("execute is recorded for operations that do not change any audited entity"),
+		field.String("entity_type").
+			Default("").
+			Immutable(),
+		field.Int("entity_id").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.JSON("changes", []objects.AuditLogChange{}).
+			Optional().
+			Immutable().
+			Comment("Changed fields with secrets redacted"),
+		field.String("source_ip").
+			Default("").
+			Immutable(),
+	}
+}
+
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
+
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
+
+// Policy only allows reading, audit logs are written and cleaned up by the system.
+func (AuditLog) Policy() ent.Policy {
+	return scopes.Policy{
+		Query: scopes.QueryPolicy{
+			scopes.OwnerRule(),
+			scopes.UserReadScopeRule(scopes.ScopeReadAuditLogs),
+		},
+		Mutation: scopes.MutationPolicy{},
+	}
+}
@@ -0,0 +1,130 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/looplj/axonhub/internal/ent/schema/schematype"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/scopes"
+)
+
+// Budget holds the schema definition for the Budget entity.
+// A budget caps the cost spent by a project, an API key or the API keys of a user in the project per period.
+type Budget struct {
+	ent.Schema
+}
+
+func (Budget) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+		schematype.SoftDeleteMixin{},
+	}
+}
+
+func (Budget) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id").
+			StorageKey("budgets_by_project_id"),
+		index.Fields("project_id", "name", "deleted_at").
+			StorageKey("budgets_by_project_id_name").
+			Unique(),
+	}
+}
+
+// Fields of the Budget.
+func (Budget) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("project_id").
+			Immutable().
+			Comment("Project ID that this budget belongs to").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.String("name").
+			Comment("Budget name").
+			Annotations(entgql.OrderField("NAME")),
+		field.String("description").
+			Default("").
+			Comment("Budget description"),
+		field.Enum("scope").
+			Values("project", "api_key", "user").
+			Default("project").
+			Comment("What the spend is counted for: the whole project, one API key, or the API keys of one user in the project"),
+		field.Int("api_key_id").
+			Optional().
+			Nillable().
+			Comment("API key ID of an api_key budget"),
+		field.Int("user_id").
+			Optional().
+			Nillable().
+			Comment("User ID of a user budget"),
+		field.Float("amount").
+			Comment("Budget amount in the system currency"),
+		field.Enum("period").
+			Values("daily", "monthly").
+			Default("monthly").
+			Comment("Budget period, in the system timezone"),
+		field.Enum("status").
+			Values("enabled", "disabled").
+			Default("enabled"),
+		field.JSON("settings", objects.BudgetSettings{}).
+			Default(objects.BudgetSettings{}).
+			Comment("Alert thresholds and enforcement action"),
+		field.Int("alerted_threshold").
+			Default(0).
+			Comment("The highest threshold alerted in the alerted period").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Time("alerted_period_start").
+			Optional().
+			Nillable().
+			Comment("Start of the period the alerted threshold belongs to").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+	}
+}
+
+// Edges of the Budget.
+func (Budget) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("budgets").
+			Field("project_id").
+			Immutable().
+			Required().
+			Unique().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+	}
+}
+
+func (Budget) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
+	}
+}
+
+func (Budget) Policy() ent.Policy {
+	return scopes.Policy{
+		Query: scopes.QueryPolicy{
+			scopes.OwnerRule(),
+			scopes.UserProjectScopeReadRule(scopes.ScopeReadAPIKeys),
+			scopes.UserReadScopeRule(scopes.ScopeReadAPIKeys),
+		},
+		Mutation: scopes.MutationPolicy{
+			scopes.OwnerRule(),
+			scopes.UserProjectScopeWriteRule(scopes.ScopeWriteAPIKeys),
+			scopes.UserWriteScopeRule(scopes.ScopeWriteAPIKeys),
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/looplj/axonhub/internal/scopes"
+)
+
+// ContentBlob holds the schema definition for the ContentBlob entity.
+// It is a large block of a request body, e.g. a system prompt or a tool definition, stored once by the hash of its
+// content and referenced from the bodies of the requests and the executions.
+type ContentBlob struct {
+	ent.Schema
+}
+
+func (ContentBlob) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (ContentBlob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("hash").
+			StorageKey("content_blobs_by_hash").
+			Unique(),
+		index.Fields("ref_count").
+			StorageKey("content_blobs_by_ref_count"),
+	}
+}
+
+// Fields of the ContentBlob.
+func (ContentBlob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("hash").
+			MaxLen(64).
+			Immutable().
+			Comment("Hex encoded SHA-256 of the content"),
+		field.Bytes("data").
+			Immutable().
+			Comment("The zstd compressed content"),
+		field.Int64("size").
+			Immutable().
+			Comment("Size of the uncompressed content in bytes"),
+		field.Int64("ref_count").
+			Default(0).
+			Comment("Number of references from the stored bodies, the blob is deleted by the gc once it drops to zero"),
+	}
+}
+
+func (ContentBlob) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+// Policy denies everything, the blobs are only read and written by the system.
+func (ContentBlob) Policy() ent.Policy {
+	return scopes.Policy{
+		Query:    scopes.QueryPolicy{},
+		Mutation: scopes.MutationPolicy{},
+	}
+}
@@ -0,0 +1,125 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/scopes"
+)
+
+// DataExport holds the schema definition for the DataExport entity.
+// A data export is an asynchronous job writing the requests or the usage logs of a project to a data storage,
+// run by the scheduler and downloaded through the admin API once completed.
+type DataExport struct {
+	ent.Schema
+}
+
+func (DataExport) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (DataExport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id").
+			StorageKey("data_exports_by_project_id"),
+		index.Fields("status").
+			StorageKey("data_exports_by_status"),
+	}
+}
+
+// Fields of the DataExport.
+func (DataExport) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("project_id").
+			Immutable().
+			Comment("Project ID that this export belongs to"),
+		field.Int("user_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("User ID who requested the export"),
+		field.Enum("resource").
+			Values("requests", "usage_logs").
+			Immutable().
+			Comment("The exported records"),
+		field.Enum("format").
+			Values("csv", "jsonl", "parquet").
+			Immutable(),
+		field.Bool("include_bodies").
+			Default(false).
+			Immutable().
+			Comment("Whether the request and response bodies are exported with the requests"),
+		field.JSON("criteria", objects.DataExportCriteria{}).
+			Default(objects.DataExportCriteria{}).
+			Comment("The filters selecting the exported records").
+			Immutable(),
+		field.Int("data_storage_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Data storage the export is written to, the default data storage if not set"),
+		field.Enum("status").
+			Values("pending", "running", "completed", "failed").
+			Default("pending"),
+		field.String("storage_key").
+			Optional().
+			Nillable().
+			Comment("Key of the export file in the data storage"),
+		field.Int64("row_count").
+			Default(0),
+		field.Int64("size").
+			Default(0).
+			Comment("Size of the export file in bytes"),
+		field.String("error_message").
+			Optional().
+			Nillable(),
+		field.Time("started_at").
+			Optional().
+			Nillable(),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the DataExport.
+func (DataExport) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("data_exports").
+			Field("project_id").
+			Immutable().
+			Required().
+			Unique(),
+	}
+}
+
+func (DataExport) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
+
+// Policy allows exporting with the read requests scope, as an export only reads the records of the project.
+func (DataExport) Policy() ent.Policy {
+	return scopes.Policy{
+		Query: scopes.QueryPolicy{
+			scopes.OwnerRule(),
+			scopes.UserProjectScopeReadRule(scopes.ScopeReadRequests),
+			scopes.UserReadScopeRule(scopes.ScopeReadRequests),
+		},
+		Mutation: scopes.MutationPolicy{
+			scopes.OwnerRule(),
+			scopes.UserProjectScopeWriteRule(scopes.ScopeReadRequests),
+			scopes.UserWriteScopeRule(scopes.ScopeReadRequests),
+		},
+	}
+}
@@ -49,7 +49,7 @@ func (DataStorage) Fields() []ent.Field {
 			Comment("data source is primary, only the system database is the primary, it can not be archived."),
 		field.Enum("type").
 			Immutable().
-			Values("database", "fs", "s3", "gcs", "webdav").
+			Values("database", "fs", "s3", "gcs", "webdav", "azblob", "sftp").
 			Comment("data source type"),
 		field.JSON("settings", &objects.DataStorageSettings{}).
 			Comment("data source setting"),
@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/looplj/axonhub/internal/scopes"
+)
+
+// ExchangeRate is a rate between two currencies taking effect at a time.
+// Rates are never updated, a new rate of the pair is added instead, so the rows keep the history.
+type ExchangeRate struct {
+	ent.Schema
+}
+
+func (ExchangeRate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (ExchangeRate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("from_currency", "to_currency", "effective_at").
+			StorageKey("exchange_rates_by_pair_effective_at").
+			Unique(),
+	}
+}
+
+func (ExchangeRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("from_currency").
+			Immutable().
+			Comment("Currency code converted from, e.g. CNY"),
+		field.String("to_currency").
+			Immutable().
+			Comment("Currency code converted to, e.g. USD"),
+		field.Float("rate").
+			Immutable().
+			Comment("Amount of the to currency per unit of the from currency"),
+		field.Time("effective_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When the rate takes effect, the latest effective rate of the pair is used"),
+		field.Enum("source").
+			Values("manual", "import").
+			Default("manual").
+			Immutable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput),
+			),
+		field.String("import_source").
+			Optional().
+			Immutable().
+			Comment("URL or file name the rate was imported from").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput),
+			),
+	}
+}
+
+func (ExchangeRate) Edges() []ent.Edge {
+	return nil
+}
+
+func (ExchangeRate) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entgql.Mutations(entgql.MutationCreate()),
+	}
+}
+
+func (ExchangeRate) Policy() ent.Policy {
+	return scopes.Policy{
+		Query: scopes.QueryPolicy{
+			scopes.OwnerRule(),
+			scopes.UserReadScopeRule(scopes.ScopeReadSettings),
+		},
+		Mutation: scopes.MutationPolicy{
+			scopes.OwnerRule(),
+			scopes.UserWriteScopeRule(scopes.ScopeWriteSettings),
+		},
+	}
+}
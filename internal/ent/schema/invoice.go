@@ -0,0 +1,108 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/scopes"
+)
+
+// Invoice holds the schema definition for the Invoice entity.
+// An invoice is the immutable monthly statement of a project, billed from its usage logs with the billing settings of the project.
+type Invoice struct {
+	ent.Schema
+}
+
+func (Invoice) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (Invoice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "period_start").
+			StorageKey("invoices_by_project_id_period_start").
+			Unique(),
+		index.Fields("number").
+			StorageKey("invoices_by_number").
+			Unique(),
+	}
+}
+
+// Fields of the Invoice.
+func (Invoice) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("project_id").
+			Immutable().
+			Comment("Project ID that this invoice belongs to"),
+		field.String("number").
+			Immutable().
+			Comment("Invoice number, unique across projects"),
+		field.Time("period_start").
+			Immutable().
+			Comment("Start of the billed period, inclusive").
+			Annotations(entgql.OrderField("PERIOD_START")),
+		field.Time("period_end").
+			Immutable().
+			Comment("End of the billed period, exclusive"),
+		field.String("currency").
+			Immutable().
+			Default(""),
+		field.Float("subtotal").
+			Immutable().
+			Comment("Cost of the usage before the markup"),
+		field.Float("markup_amount").
+			Immutable(),
+		field.Float("fee").
+			Immutable().
+			Comment("Fixed monthly fee"),
+		field.Float("total").
+			Immutable(),
+		field.JSON("line_items", []objects.InvoiceLineItem{}).
+			Immutable().
+			Optional(),
+		field.JSON("billing_settings", objects.ProjectBillingSettings{}).
+			Immutable().
+			Comment("Billing settings of the project when the invoice was generated"),
+	}
+}
+
+// Edges of the Invoice.
+func (Invoice) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("invoices").
+			Field("project_id").
+			Immutable().
+			Required().
+			Unique(),
+	}
+}
+
+func (Invoice) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
+
+// Policy allows generating invoices with the project write scope, invoices are never updated once created.
+func (Invoice) Policy() ent.Policy {
+	return scopes.Policy{
+		Query: scopes.QueryPolicy{
+			scopes.OwnerRule(),
+			scopes.UserProjectScopeReadRule(scopes.ScopeReadRequests),
+			scopes.UserReadScopeRule(scopes.ScopeReadRequests),
+		},
+		Mutation: scopes.MutationPolicy{
+			scopes.OwnerRule(),
+			scopes.UserWriteScopeRule(scopes.ScopeWriteProjects),
+		},
+	}
+}
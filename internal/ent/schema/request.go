@@ -35,6 +35,8 @@ func (Request) Indexes() []ent.Index {
 		// Performance indexes for dashboard queries
 		index.Fields("created_at").
 			StorageKey("requests_by_created_at"),
+		index.Fields("archive_key").
+			StorageKey("requests_by_archive_key"),
 	}
 }
 
@@ -70,11 +72,17 @@ func (Request) Fields() []ent.Field {
 			Comment("Request headers"),
 		// The original request from the user.
 		// e.g: the user request via OpenAI request format, but the actual request to the provider with Claude format, the request_body is the OpenAI request format.
+		// It is only updated to be cleared when the request is archived.
 		field.JSON("request_body", objects.JSONRawMessage{}).
-			Immutable().
 			Annotations(
 				entgql.Directives(forceResolver()),
+				entgql.Skip(entgql.SkipMutationUpdateInput),
 			),
+		// Whether the large blocks of the request body were stored as content blobs by the server.
+		// Only the references of such a body are expanded and released, the client can send reference-shaped objects too.
+		field.Bool("request_body_blobs").
+			Default(false).
+			Annotations(entgql.Skip(entgql.SkipAll)),
 		// The final response to the user.
 		// e.g: the provider response with Claude format, but the user expects the response with OpenAI format, the response_body is the OpenAI response format.
 		field.JSON("response_body", objects.JSONRawMessage{}).Optional().Annotations(
@@ -85,6 +93,12 @@ func (Request) Fields() []ent.Field {
 			entgql.Directives(forceResolver()),
 		),
 		field.Int("channel_id").Optional(),
+		// End user the request is made for, e.g. the subject of a short-lived API key token.
+		field.String("end_user_id").
+			Optional().
+			Immutable().
+			MaxLen(256).
+			Comment("End user identifier of the request"),
 		// External ID for tracking requests in external systems
 		field.String("external_id").
 			Optional().
@@ -120,6 +134,36 @@ func (Request) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("when the content file was saved"),
+		// ModerationVerdicts are the results of the project moderation guardrail.
+		field.JSON("moderation_verdicts", []objects.ModerationVerdict{}).
+			Optional().
+			Comment("moderation guardrail verdicts of the request").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		// ArchivedAt is set when the bodies of the request and its executions are moved to an archive object,
+		// the archive object is the ArchiveKey in the data storage ArchiveStorageID.
+		field.Time("archived_at").
+			Optional().
+			Nillable().
+			Comment("when the request contents were archived").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Int("archive_storage_id").
+			Optional().
+			Nillable().
+			Comment("data storage id of the archive object").
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.String("archive_key").
+			Optional().
+			Nillable().
+			Comment("key of the archive object with the request contents").
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
 	}
 }
 
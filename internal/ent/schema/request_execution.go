@@ -52,9 +52,15 @@ func (RequestExecution) Fields() []ent.Field {
 		field.String("format").Immutable().Default("openai/chat_completions"),
 		// The original request to the provider.
 		// e.g: the user request via OpenAI request format, but the actual request to the provider with Claude format, the request_body is the Claude request format.
-		field.JSON("request_body", objects.JSONRawMessage{}).Immutable().Annotations(
+		// It is only updated to be cleared when the request is archived.
+		field.JSON("request_body", objects.JSONRawMessage{}).Annotations(
 			entgql.Directives(forceResolver()),
+			entgql.Skip(entgql.SkipMutationUpdateInput),
 		),
+		// Whether the large blocks of the request body were stored as content blobs by the server.
+		field.Bool("request_body_blobs").
+			Default(false).
+			Annotations(entgql.Skip(entgql.SkipAll)),
 		// The final response from the provider.
 		// e.g: the provider response with Claude format, and the user expects the response with OpenAI format, the response_body is the Claude response format.
 		field.JSON("response_body", objects.JSONRawMessage{}).Optional().Annotations(
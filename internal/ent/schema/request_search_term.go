@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/looplj/axonhub/internal/scopes"
+)
+
+// RequestSearchTerm holds the schema definition for the RequestSearchTerm entity.
+// It is a posting of the embedded inverted index over the request and response bodies, one row per term of a request field.
+type RequestSearchTerm struct {
+	ent.Schema
+}
+
+func (RequestSearchTerm) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "term").
+			StorageKey("request_search_terms_by_project_id_term"),
+		index.Fields("request_id", "field", "term").
+			StorageKey("request_search_terms_by_request_id_field_term").
+			Unique(),
+	}
+}
+
+// Fields of the RequestSearchTerm.
+func (RequestSearchTerm) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("project_id").
+			Immutable(),
+		field.Int("request_id").
+			Immutable(),
+		field.Enum("field").
+			Values("request", "response", "tool").
+			Immutable().
+			Comment("Where the term is found, the tool field holds the names of the tools declared or called"),
+		field.String("term").
+			MaxLen(64).
+			Immutable(),
+		field.Int("frequency").
+			Default(1).
+			Immutable().
+			Comment("Number of occurrences of the term in the field"),
+	}
+}
+
+func (RequestSearchTerm) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+// Policy only allows reading, the index is written by the system.
+func (RequestSearchTerm) Policy() ent.Policy {
+	return scopes.Policy{
+		Query: scopes.QueryPolicy{
+			scopes.UserProjectScopeReadRequestsRule(scopes.ScopeReadRequests),
+			scopes.OwnerRule(),
+			scopes.UserReadScopeRule(scopes.ScopeReadRequests),
+		},
+		Mutation: scopes.MutationPolicy{},
+	}
+}
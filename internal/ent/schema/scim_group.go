@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/looplj/axonhub/internal/scopes"
+)
+
+// SCIMGroup holds a group provisioned by the identity provider through SCIM.
+// Group membership is mapped to roles and project membership by the SCIM config.
+type SCIMGroup struct {
+	ent.Schema
+}
+
+func (SCIMGroup) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (SCIMGroup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("display_name").
+			StorageKey("scim_groups_by_display_name").
+			Unique(),
+	}
+}
+
+func (SCIMGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("display_name").
+			Comment("Group name in the identity provider, used to match the group mappings"),
+		field.String("external_id").
+			Default("").
+			Comment("Group identifier in the identity provider"),
+	}
+}
+
+func (SCIMGroup) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("users", User.Type),
+	}
+}
+
+func (SCIMGroup) Annotations() []schema.Annotation {
+	return []schema.Annotation{entgql.Skip(entgql.SkipAll)}
+}
+
+// Policy denies all access, SCIM groups are only managed by the SCIM service.
+func (SCIMGroup) Policy() ent.Policy {
+	return scopes.Policy{}
+}
@@ -37,6 +37,8 @@ func (UsageLog) Indexes() []ent.Index {
 			StorageKey("usage_logs_by_channel_id_created_at"),
 		index.Fields("api_key_id", "created_at").
 			StorageKey("usage_logs_by_api_key_id_created_at"),
+		index.Fields("api_key_id", "end_user_id", "created_at").
+			StorageKey("usage_logs_by_api_key_id_end_user_id_created_at"),
 	}
 }
 
@@ -44,6 +46,7 @@ func (UsageLog) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("request_id").Immutable().Comment("Related request ID"),
 		field.Int("api_key_id").Optional().Immutable(),
+		field.String("end_user_id").Optional().Immutable().MaxLen(256).Comment("End user identifier the usage is attributed to"),
 		field.Int("project_id").Immutable().Default(1).Comment("Project ID, default to 1 for backward compatibility"),
 		field.Int("channel_id").Immutable().Optional().Comment("Channel ID used for the request"), // Optional for deleted channel, this field is not null.
 		field.String("model_id").Immutable().Comment("Model identifier used for the request"),
@@ -82,6 +85,20 @@ func (UsageLog) Fields() []ent.Field {
 		field.String("cost_price_reference_id").
 			Optional().
 			Comment("Reference ID to the channel model price version used for cost calculation"),
+		field.String("currency").
+			Optional().
+			Comment("Currency of the cost items, the reporting currency unless there was no exchange rate, the total cost is then not set"),
+		field.Float("original_total_cost").
+			Nillable().
+			Optional().
+			Comment("Total cost in the currency of the channel model price, before the conversion"),
+		field.String("original_currency").
+			Optional().
+			Comment("Currency of the channel model price"),
+		field.Float("exchange_rate").
+			Nillable().
+			Optional().
+			Comment("Rate converting the original currency into the reporting currency at request time"),
 	}
 }
 
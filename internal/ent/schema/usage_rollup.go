@@ -0,0 +1,118 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+
+	"github.com/looplj/axonhub/internal/scopes"
+)
+
+// UsageRollupMixin holds the dimensions and the measures shared by the usage rollup tables.
+// The rollups are computed from the usage logs and kept after the usage logs are cleaned up.
+type UsageRollupMixin struct {
+	mixin.Schema
+}
+
+func (UsageRollupMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("bucket").Immutable().Comment("Start of the rollup bucket"),
+		field.Int("project_id").Immutable(),
+		field.Int("api_key_id").Immutable().Default(0).Comment("API key ID, 0 if the requests were not made with an API key"),
+		field.Int("channel_id").Immutable().Default(0).Comment("Channel ID, 0 if the requests were not sent to a channel"),
+		field.String("model_id").Immutable(),
+		field.String("end_user_id").Immutable().Default("").MaxLen(256).Comment("End user identifier the usage is attributed to"),
+
+		field.Int64("request_count").Default(0),
+		field.Int64("prompt_tokens").Default(0),
+		field.Int64("prompt_cached_tokens").Default(0),
+		field.Int64("prompt_write_cached_tokens").Default(0),
+		field.Int64("completion_tokens").Default(0),
+		field.Int64("completion_reasoning_tokens").Default(0),
+		field.Int64("total_tokens").Default(0),
+		field.Float("total_cost").Default(0),
+	}
+}
+
+// UsageHourlyRollup aggregates the usage logs by UTC hour.
+type UsageHourlyRollup struct {
+	ent.Schema
+}
+
+func (UsageHourlyRollup) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UsageRollupMixin{},
+	}
+}
+
+func (UsageHourlyRollup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("bucket", "project_id", "api_key_id", "channel_id", "model_id", "end_user_id").
+			Unique().
+			StorageKey("usage_hourly_rollups_by_key"),
+		index.Fields("project_id", "bucket").
+			StorageKey("usage_hourly_rollups_by_project_id_bucket"),
+	}
+}
+
+func (UsageHourlyRollup) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+func (UsageHourlyRollup) Policy() ent.Policy {
+	return usageRollupPolicy()
+}
+
+// UsageDailyRollup aggregates the hourly rollups by day in the system timezone,
+// the bucket is the start of the day in the system timezone.
+type UsageDailyRollup struct {
+	ent.Schema
+}
+
+func (UsageDailyRollup) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UsageRollupMixin{},
+	}
+}
+
+func (UsageDailyRollup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("bucket", "project_id", "api_key_id", "channel_id", "model_id", "end_user_id").
+			Unique().
+			StorageKey("usage_daily_rollups_by_key"),
+		index.Fields("project_id", "bucket").
+			StorageKey("usage_daily_rollups_by_project_id_bucket"),
+		index.Fields("channel_id", "bucket").
+			StorageKey("usage_daily_rollups_by_channel_id_bucket"),
+		index.Fields("api_key_id", "bucket").
+			StorageKey("usage_daily_rollups_by_api_key_id_bucket"),
+		index.Fields("model_id", "bucket").
+			StorageKey("usage_daily_rollups_by_model_id_bucket"),
+	}
+}
+
+func (UsageDailyRollup) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+func (UsageDailyRollup) Policy() ent.Policy {
+	return usageRollupPolicy()
+}
+
+// usageRollupPolicy only allows reading, the rollups are maintained by the system.
+func usageRollupPolicy() ent.Policy {
+	return scopes.Policy{
+		Query: scopes.QueryPolicy{
+			scopes.OwnerRule(),
+			scopes.UserReadScopeRule(scopes.ScopeReadDashboard),
+		},
+		Mutation: scopes.MutationPolicy{},
+	}
+}
@@ -86,6 +86,11 @@ func (User) Edges() []ent.Edge {
 				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
 				entgql.RelayConnection(),
 			),
+		edge.From("scim_groups", SCIMGroup.Type).
+			Ref("users").
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
 	}
 }
 
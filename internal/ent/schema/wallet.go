@@ -0,0 +1,121 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/looplj/axonhub/internal/scopes"
+)
+
+// Wallet holds the schema definition for the Wallet entity.
+// A wallet is the prepaid credit balance of an API key, the requests of the key are rejected once the balance runs out.
+type Wallet struct {
+	ent.Schema
+}
+
+func (Wallet) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (Wallet) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("api_key_id").
+			StorageKey("wallets_by_api_key_id").
+			Unique(),
+		index.Fields("project_id").
+			StorageKey("wallets_by_project_id"),
+	}
+}
+
+// Fields of the Wallet.
+func (Wallet) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("api_key_id").
+			Immutable().
+			Comment("API key ID that this wallet belongs to").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Int("project_id").
+			Immutable().
+			Comment("Project ID of the API key").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Float("balance").
+			Default(0).
+			Comment("Credit balance, including the reserved credit, in the system currency").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Float("reserved").
+			Default(0).
+			Comment("Credit reserved by the requests in progress").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Enum("status").
+			Values("enabled", "disabled").
+			Default("enabled").
+			Comment("The balance is only checked and debited when the wallet is enabled"),
+		field.Float("low_balance_threshold").
+			Optional().
+			Nillable().
+			Comment("The wallet.low_balance event is sent when the balance falls below the threshold"),
+		field.Bool("low_balance_alerted").
+			Default(false).
+			Comment("Whether the low balance event has been sent since the balance fell below the threshold").
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+	}
+}
+
+// Edges of the Wallet.
+func (Wallet) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("api_key", APIKey.Type).
+			Ref("wallet").
+			Field("api_key_id").
+			Immutable().
+			Required().
+			Unique().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		edge.To("transactions", WalletTransaction.Type).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+				entgql.RelayConnection(),
+			),
+	}
+}
+
+func (Wallet) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entgql.Mutations(entgql.MutationUpdate()),
+	}
+}
+
+func (Wallet) Policy() ent.Policy {
+	return scopes.Policy{
+		Query: scopes.QueryPolicy{
+			scopes.OwnerRule(),
+			scopes.UserProjectScopeReadRule(scopes.ScopeReadAPIKeys),
+			scopes.UserReadScopeRule(scopes.ScopeReadAPIKeys),
+		},
+		Mutation: scopes.MutationPolicy{
+			scopes.OwnerRule(),
+			scopes.UserProjectScopeWriteRule(scopes.ScopeWriteAPIKeys),
+			scopes.UserWriteScopeRule(scopes.ScopeWriteAPIKeys),
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/looplj/axonhub/internal/scopes"
+)
+
+// WalletReservation holds the schema definition for the WalletReservation entity.
+// A reservation holds the estimated cost of a request in progress until the request is settled with its actual cost.
+type WalletReservation struct {
+	ent.Schema
+}
+
+func (WalletReservation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (WalletReservation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("request_id").
+			StorageKey("wallet_reservations_by_request_id").
+			Unique(),
+		index.Fields("expires_at").
+			StorageKey("wallet_reservations_by_expires_at"),
+	}
+}
+
+// Fields of the WalletReservation.
+func (WalletReservation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("wallet_id").
+			Immutable(),
+		field.Int("request_id").
+			Immutable(),
+		field.Float("amount").
+			Immutable(),
+		field.Time("expires_at").
+			Immutable().
+			Comment("The reservation is released if the request is not settled by then"),
+	}
+}
+
+func (WalletReservation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+	}
+}
+
+// Policy only allows reading, reservations are written by the system.
+func (WalletReservation) Policy() ent.Policy {
+	return scopes.Policy{
+		Query: scopes.QueryPolicy{
+			scopes.OwnerRule(),
+			scopes.UserReadScopeRule(scopes.ScopeReadAPIKeys),
+		},
+		Mutation: scopes.MutationPolicy{},
+	}
+}
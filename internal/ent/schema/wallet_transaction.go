@@ -0,0 +1,106 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/looplj/axonhub/internal/scopes"
+)
+
+// WalletTransaction holds the schema definition for the WalletTransaction entity.
+// The transactions are the immutable credit ledger of a wallet.
+type WalletTransaction struct {
+	ent.Schema
+}
+
+func (WalletTransaction) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (WalletTransaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("wallet_id", "created_at").
+			StorageKey("wallet_transactions_by_wallet_id_created_at"),
+		index.Fields("request_id").
+			StorageKey("wallet_transactions_by_request_id"),
+	}
+}
+
+// Fields of the WalletTransaction.
+func (WalletTransaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("wallet_id").
+			Immutable(),
+		field.Int("api_key_id").
+			Immutable(),
+		field.Int("project_id").
+			Immutable(),
+		field.Enum("type").
+			Values("top_up", "usage", "refund", "adjustment").
+			Immutable(),
+		field.Float("amount").
+			Immutable().
+			Comment("Signed amount, positive for credits and negative for debits"),
+		field.Float("balance_after").
+			Immutable().
+			Comment("Balance of the wallet after the transaction"),
+		field.Int("request_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Request ID of a usage debit, or of the request a refund is for"),
+		field.Int("usage_log_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Usage log ID of a usage debit"),
+		field.Int("user_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("ID of the user who made a manual transaction"),
+		field.String("description").
+			Immutable().
+			Default(""),
+	}
+}
+
+// Edges of the WalletTransaction.
+func (WalletTransaction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("wallet", Wallet.Type).
+			Ref("transactions").
+			Field("wallet_id").
+			Immutable().
+			Required().
+			Unique(),
+	}
+}
+
+func (WalletTransaction) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
+
+func (WalletTransaction) Policy() ent.Policy {
+	return scopes.Policy{
+		Query: scopes.QueryPolicy{
+			scopes.OwnerRule(),
+			scopes.UserProjectScopeReadRule(scopes.ScopeReadAPIKeys),
+			scopes.UserReadScopeRule(scopes.ScopeReadAPIKeys),
+		},
+		Mutation: scopes.MutationPolicy{
+			scopes.OwnerRule(),
+			scopes.UserProjectScopeWriteRule(scopes.ScopeWriteAPIKeys),
+			scopes.UserWriteScopeRule(scopes.ScopeWriteAPIKeys),
+		},
+	}
+}
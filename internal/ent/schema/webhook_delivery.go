@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/scopes"
+)
+
+// WebhookDelivery is a webhook notification queued for a target, retried with backoff until it succeeds or runs out of attempts.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at").
+			StorageKey("webhook_deliveries_by_status_next_attempt_at"),
+		index.Fields("created_at").
+			StorageKey("webhook_deliveries_by_created_at"),
+	}
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("event_id").
+			Immutable().
+			Comment("ID of the event, shared by the deliveries of the same event to different targets"),
+		field.String("event").
+			Immutable(),
+		field.String("target_name").
+			Immutable(),
+		field.String("url").
+			Immutable(),
+		field.Text("payload").
+			Immutable().
+			Comment("Rendered request body"),
+		field.JSON("headers", []objects.HeaderEntry{}).
+			Optional().
+			Immutable().
+			Comment("Rendered request headers, the signature headers are added on each attempt").
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
+		field.Enum("status").
+			Values("pending", "succeeded", "failed").
+			Default("pending"),
+		field.Int("attempts").
+			Default(0),
+		field.Time("next_attempt_at").
+			Comment("When the delivery is attempted next, also used as the lease of the instance attempting it"),
+		field.String("last_error").
+			Default(""),
+		field.Int("response_status").
+			Optional().
+			Nillable(),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return nil
+}
+
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
+
+// Policy only allows reading, deliveries are written and cleaned up by the system.
+func (WebhookDelivery) Policy() ent.Policy {
+	return scopes.Policy{
+		Query: scopes.QueryPolicy{
+			scopes.OwnerRule(),
+			scopes.UserReadScopeRule(scopes.ScopeReadSettings),
+		},
+		Mutation: scopes.MutationPolicy{},
+	}
+}
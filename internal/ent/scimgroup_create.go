@@ -0,0 +1,663 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/scimgroup"
+	"github.com/looplj/axonhub/internal/ent/user"
+)
+
+// SCIMGroupCreate is the builder for creating a SCIMGroup entity.
+type SCIMGroupCreate struct {
+	config
+	mutation *SCIMGroupMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SCIMGroupCreate) SetCreatedAt(v time.Time) *SCIMGroupCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SCIMGroupCreate) SetNillableCreatedAt(v *time.Time) *SCIMGroupCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SCIMGroupCreate) SetUpdatedAt(v time.Time) *SCIMGroupCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SCIMGroupCreate) SetNillableUpdatedAt(v *time.Time) *SCIMGroupCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_c *SCIMGroupCreate) SetDisplayName(v string) *SCIMGroupCreate {
+	_c.mutation.SetDisplayName(v)
+	return _c
+}
+
+// SetExternalID sets the "external_id" field.
+func (_c *SCIMGroupCreate) SetExternalID(v string) *SCIMGroupCreate {
+	_c.mutation.SetExternalID(v)
+	return _c
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_c *SCIMGroupCreate) SetNillableExternalID(v *string) *SCIMGroupCreate {
+	if v != nil {
+		_c.SetExternalID(*v)
+	}
+	return _c
+}
+
+// AddUserIDs adds the "users" edge to the User entity by IDs.
+func (_c *SCIMGroupCreate) AddUserIDs(ids ...int) *SCIMGroupCreate {
+	_c.mutation.AddUserIDs(ids...)
+	return _c
+}
+
+// AddUsers adds the "users" edges to the User entity.
+func (_c *SCIMGroupCreate) AddUsers(v ...*User) *SCIMGroupCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUserIDs(ids...)
+}
+
+// Mutation returns the SCIMGroupMutation object of the builder.
+func (_c *SCIMGroupCreate) Mutation() *SCIMGroupMutation {
+	return _c.mutation
+}
+
+// Save creates the SCIMGroup in the database.
+func (_c *SCIMGroupCreate) Save(ctx context.Context) (*SCIMGroup, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SCIMGroupCreate) SaveX(ctx context.Context) *SCIMGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SCIMGroupCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SCIMGroupCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SCIMGroupCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if scimgroup.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized scimgroup.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := scimgroup.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if scimgroup.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized scimgroup.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := scimgroup.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ExternalID(); !ok {
+		v := scimgroup.DefaultExternalID
+		_c.mutation.SetExternalID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SCIMGroupCreate) check() error {
+	if _, ok := _c.mutation.DisplayName(); !ok {
+		return &ValidationError{Name: "display_name", err: errors.New(`ent: missing required field "SCIMGroup.display_name"`)}
+	}
+	if _, ok := _c.mutation.ExternalID(); !ok {
+		return &ValidationError{Name: "external_id", err: errors.New(`ent: missing required field "SCIMGroup.external_id"`)}
+	}
+	return nil
+}
+
+func (_c *SCIMGroupCreate) sqlSave(ctx context.Context) (*SCIMGroup, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SCIMGroupCreate) createSpec() (*SCIMGroup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SCIMGroup{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(scimgroup.Table, sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(scimgroup.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(scimgroup.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DisplayName(); ok {
+		_spec.SetField(scimgroup.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(scimgroup.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = value
+	}
+	if nodes := _c.mutation.UsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   scimgroup.UsersTable,
+			Columns: scimgroup.UsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SCIMGroup.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SCIMGroupUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SCIMGroupCreate) OnConflict(opts ...sql.ConflictOption) *SCIMGroupUpsertOne {
+	_c.conflict = opts
+	return &SCIMGroupUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SCIMGroup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SCIMGroupCreate) OnConflictColumns(columns ...string) *SCIMGroupUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SCIMGroupUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SCIMGroupUpsertOne is the builder for "upsert"-ing
+	//  one SCIMGroup node.
+	SCIMGroupUpsertOne struct {
+		create *SCIMGroupCreate
+	}
+
+	// SCIMGroupUpsert is the "OnConflict" setter.
+	SCIMGroupUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SCIMGroupUpsert) SetUpdatedAt(v time.Time) *SCIMGroupUpsert {
+	u.Set(scimgroup.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SCIMGroupUpsert) UpdateUpdatedAt() *SCIMGroupUpsert {
+	u.SetExcluded(scimgroup.FieldUpdatedAt)
+	return u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *SCIMGroupUpsert) SetDisplayName(v string) *SCIMGroupUpsert {
+	u.Set(scimgroup.FieldDisplayName, v)
+	return u
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *SCIMGroupUpsert) UpdateDisplayName() *SCIMGroupUpsert {
+	u.SetExcluded(scimgroup.FieldDisplayName)
+	return u
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *SCIMGroupUpsert) SetExternalID(v string) *SCIMGroupUpsert {
+	u.Set(scimgroup.FieldExternalID, v)
+	return u
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *SCIMGroupUpsert) UpdateExternalID() *SCIMGroupUpsert {
+	u.SetExcluded(scimgroup.FieldExternalID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SCIMGroup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SCIMGroupUpsertOne) UpdateNewValues() *SCIMGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(scimgroup.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SCIMGroup.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SCIMGroupUpsertOne) Ignore() *SCIMGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SCIMGroupUpsertOne) DoNothing() *SCIMGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SCIMGroupCreate.OnConflict
+// documentation for more info.
+func (u *SCIMGroupUpsertOne) Update(set func(*SCIMGroupUpsert)) *SCIMGroupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SCIMGroupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SCIMGroupUpsertOne) SetUpdatedAt(v time.Time) *SCIMGroupUpsertOne {
+	return u.Update(func(s *SCIMGroupUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SCIMGroupUpsertOne) UpdateUpdatedAt() *SCIMGroupUpsertOne {
+	return u.Update(func(s *SCIMGroupUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *SCIMGroupUpsertOne) SetDisplayName(v string) *SCIMGroupUpsertOne {
+	return u.Update(func(s *SCIMGroupUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *SCIMGroupUpsertOne) UpdateDisplayName() *SCIMGroupUpsertOne {
+	return u.Update(func(s *SCIMGroupUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *SCIMGroupUpsertOne) SetExternalID(v string) *SCIMGroupUpsertOne {
+	return u.Update(func(s *SCIMGroupUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *SCIMGroupUpsertOne) UpdateExternalID() *SCIMGroupUpsertOne {
+	return u.Update(func(s *SCIMGroupUpsert) {
+		s.UpdateExternalID()
+	})
+}
+
+// Exec executes the query.
+func (u *SCIMGroupUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SCIMGroupCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SCIMGroupUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SCIMGroupUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SCIMGroupUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SCIMGroupCreateBulk is the builder for creating many SCIMGroup entities in bulk.
+type SCIMGroupCreateBulk struct {
+	config
+	err      error
+	builders []*SCIMGroupCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SCIMGroup entities in the database.
+func (_c *SCIMGroupCreateBulk) Save(ctx context.Context) ([]*SCIMGroup, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SCIMGroup, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SCIMGroupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SCIMGroupCreateBulk) SaveX(ctx context.Context) []*SCIMGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SCIMGroupCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SCIMGroupCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SCIMGroup.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SCIMGroupUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SCIMGroupCreateBulk) OnConflict(opts ...sql.ConflictOption) *SCIMGroupUpsertBulk {
+	_c.conflict = opts
+	return &SCIMGroupUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SCIMGroup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SCIMGroupCreateBulk) OnConflictColumns(columns ...string) *SCIMGroupUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SCIMGroupUpsertBulk{
+		create: _c,
+	}
+}
+
+// SCIMGroupUpsertBulk is the builder for "upsert"-ing
+// a bulk of SCIMGroup nodes.
+type SCIMGroupUpsertBulk struct {
+	create *SCIMGroupCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SCIMGroup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SCIMGroupUpsertBulk) UpdateNewValues() *SCIMGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(scimgroup.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SCIMGroup.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SCIMGroupUpsertBulk) Ignore() *SCIMGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SCIMGroupUpsertBulk) DoNothing() *SCIMGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SCIMGroupCreateBulk.OnConflict
+// documentation for more info.
+func (u *SCIMGroupUpsertBulk) Update(set func(*SCIMGroupUpsert)) *SCIMGroupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SCIMGroupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SCIMGroupUpsertBulk) SetUpdatedAt(v time.Time) *SCIMGroupUpsertBulk {
+	return u.Update(func(s *SCIMGroupUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SCIMGroupUpsertBulk) UpdateUpdatedAt() *SCIMGroupUpsertBulk {
+	return u.Update(func(s *SCIMGroupUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *SCIMGroupUpsertBulk) SetDisplayName(v string) *SCIMGroupUpsertBulk {
+	return u.Update(func(s *SCIMGroupUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *SCIMGroupUpsertBulk) UpdateDisplayName() *SCIMGroupUpsertBulk {
+	return u.Update(func(s *SCIMGroupUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *SCIMGroupUpsertBulk) SetExternalID(v string) *SCIMGroupUpsertBulk {
+	return u.Update(func(s *SCIMGroupUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *SCIMGroupUpsertBulk) UpdateExternalID() *SCIMGroupUpsertBulk {
+	return u.Update(func(s *SCIMGroupUpsert) {
+		s.UpdateExternalID()
+	})
+}
+
+// Exec executes the query.
+func (u *SCIMGroupUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SCIMGroupCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SCIMGroupCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SCIMGroupUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
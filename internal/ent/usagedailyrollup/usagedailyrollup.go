@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usagedailyrollup
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the usagedailyrollup type in the database.
+	Label = "usage_daily_rollup"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBucket holds the string denoting the bucket field in the database.
+	FieldBucket = "bucket"
+	// FieldProjectID holds the string denoting the project_id field in the database.
+	FieldProjectID = "project_id"
+	// FieldAPIKeyID holds the string denoting the api_key_id field in the database.
+	FieldAPIKeyID = "api_key_id"
+	// FieldChannelID holds the string denoting the channel_id field in the database.
+	FieldChannelID = "channel_id"
+	// FieldModelID holds the string denoting the model_id field in the database.
+	FieldModelID = "model_id"
+	// FieldEndUserID holds the string denoting the end_user_id field in the database.
+	FieldEndUserID = "end_user_id"
+	// FieldRequestCount holds the string denoting the request_count field in the database.
+	FieldRequestCount = "request_count"
+	// FieldPromptTokens holds the string denoting the prompt_tokens field in the database.
+	FieldPromptTokens = "prompt_tokens"
+	// FieldPromptCachedTokens holds the string denoting the prompt_cached_tokens field in the database.
+	FieldPromptCachedTokens = "prompt_cached_tokens"
+	// FieldPromptWriteCachedTokens holds the string denoting the prompt_write_cached_tokens field in the database.
+	FieldPromptWriteCachedTokens = "prompt_write_cached_tokens"
+	// FieldCompletionTokens holds the string denoting the completion_tokens field in the database.
+	FieldCompletionTokens = "completion_tokens"
+	// FieldCompletionReasoningTokens holds the string denoting the completion_reasoning_tokens field in the database.
+	FieldCompletionReasoningTokens = "completion_reasoning_tokens"
+	// FieldTotalTokens holds the string denoting the total_tokens field in the database.
+	FieldTotalTokens = "total_tokens"
+	// FieldTotalCost holds the string denoting the total_cost field in the database.
+	FieldTotalCost = "total_cost"
+	// Table holds the table name of the usagedailyrollup in the database.
+	Table = "usage_daily_rollups"
+)
+
+// Columns holds all SQL columns for usagedailyrollup fields.
+var Columns = []string{
+	FieldID,
+	FieldBucket,
+	FieldProjectID,
+	FieldAPIKeyID,
+	FieldChannelID,
+	FieldModelID,
+	FieldEndUserID,
+	FieldRequestCount,
+	FieldPromptTokens,
+	FieldPromptCachedTokens,
+	FieldPromptWriteCachedTokens,
+	FieldCompletionTokens,
+	FieldCompletionReasoningTokens,
+	FieldTotalTokens,
+	FieldTotalCost,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/looplj/axonhub/internal/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultAPIKeyID holds the default value on creation for the "api_key_id" field.
+	DefaultAPIKeyID int
+	// DefaultChannelID holds the default value on creation for the "channel_id" field.
+	DefaultChannelID int
+	// DefaultEndUserID holds the default value on creation for the "end_user_id" field.
+	DefaultEndUserID string
+	// EndUserIDValidator is a validator for the "end_user_id" field. It is called by the builders before save.
+	EndUserIDValidator func(string) error
+	// DefaultRequestCount holds the default value on creation for the "request_count" field.
+	DefaultRequestCount int64
+	// DefaultPromptTokens holds the default value on creation for the "prompt_tokens" field.
+	DefaultPromptTokens int64
+	// DefaultPromptCachedTokens holds the default value on creation for the "prompt_cached_tokens" field.
+	DefaultPromptCachedTokens int64
+	// DefaultPromptWriteCachedTokens holds the default value on creation for the "prompt_write_cached_tokens" field.
+	DefaultPromptWriteCachedTokens int64
+	// DefaultCompletionTokens holds the default value on creation for the "completion_tokens" field.
+	DefaultCompletionTokens int64
+	// DefaultCompletionReasoningTokens holds the default value on creation for the "completion_reasoning_tokens" field.
+	DefaultCompletionReasoningTokens int64
+	// DefaultTotalTokens holds the default value on creation for the "total_tokens" field.
+	DefaultTotalTokens int64
+	// DefaultTotalCost holds the default value on creation for the "total_cost" field.
+	DefaultTotalCost float64
+)
+
+// OrderOption defines the ordering options for the UsageDailyRollup queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBucket orders the results by the bucket field.
+func ByBucket(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBucket, opts...).ToFunc()
+}
+
+// ByProjectID orders the results by the project_id field.
+func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
+}
+
+// ByAPIKeyID orders the results by the api_key_id field.
+func ByAPIKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIKeyID, opts...).ToFunc()
+}
+
+// ByChannelID orders the results by the channel_id field.
+func ByChannelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChannelID, opts...).ToFunc()
+}
+
+// ByModelID orders the results by the model_id field.
+func ByModelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModelID, opts...).ToFunc()
+}
+
+// ByEndUserID orders the results by the end_user_id field.
+func ByEndUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndUserID, opts...).ToFunc()
+}
+
+// ByRequestCount orders the results by the request_count field.
+func ByRequestCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestCount, opts...).ToFunc()
+}
+
+// ByPromptTokens orders the results by the prompt_tokens field.
+func ByPromptTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptTokens, opts...).ToFunc()
+}
+
+// ByPromptCachedTokens orders the results by the prompt_cached_tokens field.
+func ByPromptCachedTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptCachedTokens, opts...).ToFunc()
+}
+
+// ByPromptWriteCachedTokens orders the results by the prompt_write_cached_tokens field.
+func ByPromptWriteCachedTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptWriteCachedTokens, opts...).ToFunc()
+}
+
+// ByCompletionTokens orders the results by the completion_tokens field.
+func ByCompletionTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletionTokens, opts...).ToFunc()
+}
+
+// ByCompletionReasoningTokens orders the results by the completion_reasoning_tokens field.
+func ByCompletionReasoningTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletionReasoningTokens, opts...).ToFunc()
+}
+
+// ByTotalTokens orders the results by the total_tokens field.
+func ByTotalTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalTokens, opts...).ToFunc()
+}
+
+// ByTotalCost orders the results by the total_cost field.
+func ByTotalCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalCost, opts...).ToFunc()
+}
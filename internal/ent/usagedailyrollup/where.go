@@ -0,0 +1,750 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usagedailyrollup
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLTE(FieldID, id))
+}
+
+// Bucket applies equality check predicate on the "bucket" field. It's identical to BucketEQ.
+func Bucket(v time.Time) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldBucket, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldProjectID, v))
+}
+
+// APIKeyID applies equality check predicate on the "api_key_id" field. It's identical to APIKeyIDEQ.
+func APIKeyID(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldAPIKeyID, v))
+}
+
+// ChannelID applies equality check predicate on the "channel_id" field. It's identical to ChannelIDEQ.
+func ChannelID(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldChannelID, v))
+}
+
+// ModelID applies equality check predicate on the "model_id" field. It's identical to ModelIDEQ.
+func ModelID(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldModelID, v))
+}
+
+// EndUserID applies equality check predicate on the "end_user_id" field. It's identical to EndUserIDEQ.
+func EndUserID(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldEndUserID, v))
+}
+
+// RequestCount applies equality check predicate on the "request_count" field. It's identical to RequestCountEQ.
+func RequestCount(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldRequestCount, v))
+}
+
+// PromptTokens applies equality check predicate on the "prompt_tokens" field. It's identical to PromptTokensEQ.
+func PromptTokens(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// PromptCachedTokens applies equality check predicate on the "prompt_cached_tokens" field. It's identical to PromptCachedTokensEQ.
+func PromptCachedTokens(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldPromptCachedTokens, v))
+}
+
+// PromptWriteCachedTokens applies equality check predicate on the "prompt_write_cached_tokens" field. It's identical to PromptWriteCachedTokensEQ.
+func PromptWriteCachedTokens(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldPromptWriteCachedTokens, v))
+}
+
+// CompletionTokens applies equality check predicate on the "completion_tokens" field. It's identical to CompletionTokensEQ.
+func CompletionTokens(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldCompletionTokens, v))
+}
+
+// CompletionReasoningTokens applies equality check predicate on the "completion_reasoning_tokens" field. It's identical to CompletionReasoningTokensEQ.
+func CompletionReasoningTokens(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldCompletionReasoningTokens, v))
+}
+
+// TotalTokens applies equality check predicate on the "total_tokens" field. It's identical to TotalTokensEQ.
+func TotalTokens(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldTotalTokens, v))
+}
+
+// TotalCost applies equality check predicate on the "total_cost" field. It's identical to TotalCostEQ.
+func TotalCost(v float64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldTotalCost, v))
+}
+
+// BucketEQ applies the EQ predicate on the "bucket" field.
+func BucketEQ(v time.Time) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldBucket, v))
+}
+
+// BucketNEQ applies the NEQ predicate on the "bucket" field.
+func BucketNEQ(v time.Time) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNEQ(FieldBucket, v))
+}
+
+// BucketIn applies the In predicate on the "bucket" field.
+func BucketIn(vs ...time.Time) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldIn(FieldBucket, vs...))
+}
+
+// BucketNotIn applies the NotIn predicate on the "bucket" field.
+func BucketNotIn(vs ...time.Time) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNotIn(FieldBucket, vs...))
+}
+
+// BucketGT applies the GT predicate on the "bucket" field.
+func BucketGT(v time.Time) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGT(FieldBucket, v))
+}
+
+// BucketGTE applies the GTE predicate on the "bucket" field.
+func BucketGTE(v time.Time) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGTE(FieldBucket, v))
+}
+
+// BucketLT applies the LT predicate on the "bucket" field.
+func BucketLT(v time.Time) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLT(FieldBucket, v))
+}
+
+// BucketLTE applies the LTE predicate on the "bucket" field.
+func BucketLTE(v time.Time) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLTE(FieldBucket, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDGT applies the GT predicate on the "project_id" field.
+func ProjectIDGT(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGT(FieldProjectID, v))
+}
+
+// ProjectIDGTE applies the GTE predicate on the "project_id" field.
+func ProjectIDGTE(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGTE(FieldProjectID, v))
+}
+
+// ProjectIDLT applies the LT predicate on the "project_id" field.
+func ProjectIDLT(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLT(FieldProjectID, v))
+}
+
+// ProjectIDLTE applies the LTE predicate on the "project_id" field.
+func ProjectIDLTE(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLTE(FieldProjectID, v))
+}
+
+// APIKeyIDEQ applies the EQ predicate on the "api_key_id" field.
+func APIKeyIDEQ(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldAPIKeyID, v))
+}
+
+// APIKeyIDNEQ applies the NEQ predicate on the "api_key_id" field.
+func APIKeyIDNEQ(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNEQ(FieldAPIKeyID, v))
+}
+
+// APIKeyIDIn applies the In predicate on the "api_key_id" field.
+func APIKeyIDIn(vs ...int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldIn(FieldAPIKeyID, vs...))
+}
+
+// APIKeyIDNotIn applies the NotIn predicate on the "api_key_id" field.
+func APIKeyIDNotIn(vs ...int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNotIn(FieldAPIKeyID, vs...))
+}
+
+// APIKeyIDGT applies the GT predicate on the "api_key_id" field.
+func APIKeyIDGT(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGT(FieldAPIKeyID, v))
+}
+
+// APIKeyIDGTE applies the GTE predicate on the "api_key_id" field.
+func APIKeyIDGTE(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGTE(FieldAPIKeyID, v))
+}
+
+// APIKeyIDLT applies the LT predicate on the "api_key_id" field.
+func APIKeyIDLT(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLT(FieldAPIKeyID, v))
+}
+
+// APIKeyIDLTE applies the LTE predicate on the "api_key_id" field.
+func APIKeyIDLTE(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLTE(FieldAPIKeyID, v))
+}
+
+// ChannelIDEQ applies the EQ predicate on the "channel_id" field.
+func ChannelIDEQ(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldChannelID, v))
+}
+
+// ChannelIDNEQ applies the NEQ predicate on the "channel_id" field.
+func ChannelIDNEQ(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNEQ(FieldChannelID, v))
+}
+
+// ChannelIDIn applies the In predicate on the "channel_id" field.
+func ChannelIDIn(vs ...int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldIn(FieldChannelID, vs...))
+}
+
+// ChannelIDNotIn applies the NotIn predicate on the "channel_id" field.
+func ChannelIDNotIn(vs ...int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNotIn(FieldChannelID, vs...))
+}
+
+// ChannelIDGT applies the GT predicate on the "channel_id" field.
+func ChannelIDGT(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGT(FieldChannelID, v))
+}
+
+// ChannelIDGTE applies the GTE predicate on the "channel_id" field.
+func ChannelIDGTE(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGTE(FieldChannelID, v))
+}
+
+// ChannelIDLT applies the LT predicate on the "channel_id" field.
+func ChannelIDLT(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLT(FieldChannelID, v))
+}
+
+// ChannelIDLTE applies the LTE predicate on the "channel_id" field.
+func ChannelIDLTE(v int) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLTE(FieldChannelID, v))
+}
+
+// ModelIDEQ applies the EQ predicate on the "model_id" field.
+func ModelIDEQ(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldModelID, v))
+}
+
+// ModelIDNEQ applies the NEQ predicate on the "model_id" field.
+func ModelIDNEQ(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNEQ(FieldModelID, v))
+}
+
+// ModelIDIn applies the In predicate on the "model_id" field.
+func ModelIDIn(vs ...string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldIn(FieldModelID, vs...))
+}
+
+// ModelIDNotIn applies the NotIn predicate on the "model_id" field.
+func ModelIDNotIn(vs ...string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNotIn(FieldModelID, vs...))
+}
+
+// ModelIDGT applies the GT predicate on the "model_id" field.
+func ModelIDGT(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGT(FieldModelID, v))
+}
+
+// ModelIDGTE applies the GTE predicate on the "model_id" field.
+func ModelIDGTE(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGTE(FieldModelID, v))
+}
+
+// ModelIDLT applies the LT predicate on the "model_id" field.
+func ModelIDLT(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLT(FieldModelID, v))
+}
+
+// ModelIDLTE applies the LTE predicate on the "model_id" field.
+func ModelIDLTE(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLTE(FieldModelID, v))
+}
+
+// ModelIDContains applies the Contains predicate on the "model_id" field.
+func ModelIDContains(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldContains(FieldModelID, v))
+}
+
+// ModelIDHasPrefix applies the HasPrefix predicate on the "model_id" field.
+func ModelIDHasPrefix(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldHasPrefix(FieldModelID, v))
+}
+
+// ModelIDHasSuffix applies the HasSuffix predicate on the "model_id" field.
+func ModelIDHasSuffix(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldHasSuffix(FieldModelID, v))
+}
+
+// ModelIDEqualFold applies the EqualFold predicate on the "model_id" field.
+func ModelIDEqualFold(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEqualFold(FieldModelID, v))
+}
+
+// ModelIDContainsFold applies the ContainsFold predicate on the "model_id" field.
+func ModelIDContainsFold(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldContainsFold(FieldModelID, v))
+}
+
+// EndUserIDEQ applies the EQ predicate on the "end_user_id" field.
+func EndUserIDEQ(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldEndUserID, v))
+}
+
+// EndUserIDNEQ applies the NEQ predicate on the "end_user_id" field.
+func EndUserIDNEQ(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNEQ(FieldEndUserID, v))
+}
+
+// EndUserIDIn applies the In predicate on the "end_user_id" field.
+func EndUserIDIn(vs ...string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldIn(FieldEndUserID, vs...))
+}
+
+// EndUserIDNotIn applies the NotIn predicate on the "end_user_id" field.
+func EndUserIDNotIn(vs ...string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNotIn(FieldEndUserID, vs...))
+}
+
+// EndUserIDGT applies the GT predicate on the "end_user_id" field.
+func EndUserIDGT(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGT(FieldEndUserID, v))
+}
+
+// EndUserIDGTE applies the GTE predicate on the "end_user_id" field.
+func EndUserIDGTE(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGTE(FieldEndUserID, v))
+}
+
+// EndUserIDLT applies the LT predicate on the "end_user_id" field.
+func EndUserIDLT(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLT(FieldEndUserID, v))
+}
+
+// EndUserIDLTE applies the LTE predicate on the "end_user_id" field.
+func EndUserIDLTE(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLTE(FieldEndUserID, v))
+}
+
+// EndUserIDContains applies the Contains predicate on the "end_user_id" field.
+func EndUserIDContains(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldContains(FieldEndUserID, v))
+}
+
+// EndUserIDHasPrefix applies the HasPrefix predicate on the "end_user_id" field.
+func EndUserIDHasPrefix(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldHasPrefix(FieldEndUserID, v))
+}
+
+// EndUserIDHasSuffix applies the HasSuffix predicate on the "end_user_id" field.
+func EndUserIDHasSuffix(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldHasSuffix(FieldEndUserID, v))
+}
+
+// EndUserIDEqualFold applies the EqualFold predicate on the "end_user_id" field.
+func EndUserIDEqualFold(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEqualFold(FieldEndUserID, v))
+}
+
+// EndUserIDContainsFold applies the ContainsFold predicate on the "end_user_id" field.
+func EndUserIDContainsFold(v string) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldContainsFold(FieldEndUserID, v))
+}
+
+// RequestCountEQ applies the EQ predicate on the "request_count" field.
+func RequestCountEQ(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldRequestCount, v))
+}
+
+// RequestCountNEQ applies the NEQ predicate on the "request_count" field.
+func RequestCountNEQ(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNEQ(FieldRequestCount, v))
+}
+
+// RequestCountIn applies the In predicate on the "request_count" field.
+func RequestCountIn(vs ...int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldIn(FieldRequestCount, vs...))
+}
+
+// RequestCountNotIn applies the NotIn predicate on the "request_count" field.
+func RequestCountNotIn(vs ...int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNotIn(FieldRequestCount, vs...))
+}
+
+// RequestCountGT applies the GT predicate on the "request_count" field.
+func RequestCountGT(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGT(FieldRequestCount, v))
+}
+
+// RequestCountGTE applies the GTE predicate on the "request_count" field.
+func RequestCountGTE(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGTE(FieldRequestCount, v))
+}
+
+// RequestCountLT applies the LT predicate on the "request_count" field.
+func RequestCountLT(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLT(FieldRequestCount, v))
+}
+
+// RequestCountLTE applies the LTE predicate on the "request_count" field.
+func RequestCountLTE(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLTE(FieldRequestCount, v))
+}
+
+// PromptTokensEQ applies the EQ predicate on the "prompt_tokens" field.
+func PromptTokensEQ(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensNEQ applies the NEQ predicate on the "prompt_tokens" field.
+func PromptTokensNEQ(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensIn applies the In predicate on the "prompt_tokens" field.
+func PromptTokensIn(vs ...int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensNotIn applies the NotIn predicate on the "prompt_tokens" field.
+func PromptTokensNotIn(vs ...int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNotIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensGT applies the GT predicate on the "prompt_tokens" field.
+func PromptTokensGT(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGT(FieldPromptTokens, v))
+}
+
+// PromptTokensGTE applies the GTE predicate on the "prompt_tokens" field.
+func PromptTokensGTE(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGTE(FieldPromptTokens, v))
+}
+
+// PromptTokensLT applies the LT predicate on the "prompt_tokens" field.
+func PromptTokensLT(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLT(FieldPromptTokens, v))
+}
+
+// PromptTokensLTE applies the LTE predicate on the "prompt_tokens" field.
+func PromptTokensLTE(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLTE(FieldPromptTokens, v))
+}
+
+// PromptCachedTokensEQ applies the EQ predicate on the "prompt_cached_tokens" field.
+func PromptCachedTokensEQ(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldPromptCachedTokens, v))
+}
+
+// PromptCachedTokensNEQ applies the NEQ predicate on the "prompt_cached_tokens" field.
+func PromptCachedTokensNEQ(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNEQ(FieldPromptCachedTokens, v))
+}
+
+// PromptCachedTokensIn applies the In predicate on the "prompt_cached_tokens" field.
+func PromptCachedTokensIn(vs ...int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldIn(FieldPromptCachedTokens, vs...))
+}
+
+// PromptCachedTokensNotIn applies the NotIn predicate on the "prompt_cached_tokens" field.
+func PromptCachedTokensNotIn(vs ...int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNotIn(FieldPromptCachedTokens, vs...))
+}
+
+// PromptCachedTokensGT applies the GT predicate on the "prompt_cached_tokens" field.
+func PromptCachedTokensGT(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGT(FieldPromptCachedTokens, v))
+}
+
+// PromptCachedTokensGTE applies the GTE predicate on the "prompt_cached_tokens" field.
+func PromptCachedTokensGTE(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGTE(FieldPromptCachedTokens, v))
+}
+
+// PromptCachedTokensLT applies the LT predicate on the "prompt_cached_tokens" field.
+func PromptCachedTokensLT(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLT(FieldPromptCachedTokens, v))
+}
+
+// PromptCachedTokensLTE applies the LTE predicate on the "prompt_cached_tokens" field.
+func PromptCachedTokensLTE(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLTE(FieldPromptCachedTokens, v))
+}
+
+// PromptWriteCachedTokensEQ applies the EQ predicate on the "prompt_write_cached_tokens" field.
+func PromptWriteCachedTokensEQ(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldPromptWriteCachedTokens, v))
+}
+
+// PromptWriteCachedTokensNEQ applies the NEQ predicate on the "prompt_write_cached_tokens" field.
+func PromptWriteCachedTokensNEQ(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNEQ(FieldPromptWriteCachedTokens, v))
+}
+
+// PromptWriteCachedTokensIn applies the In predicate on the "prompt_write_cached_tokens" field.
+func PromptWriteCachedTokensIn(vs ...int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldIn(FieldPromptWriteCachedTokens, vs...))
+}
+
+// PromptWriteCachedTokensNotIn applies the NotIn predicate on the "prompt_write_cached_tokens" field.
+func PromptWriteCachedTokensNotIn(vs ...int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNotIn(FieldPromptWriteCachedTokens, vs...))
+}
+
+// PromptWriteCachedTokensGT applies the GT predicate on the "prompt_write_cached_tokens" field.
+func PromptWriteCachedTokensGT(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGT(FieldPromptWriteCachedTokens, v))
+}
+
+// PromptWriteCachedTokensGTE applies the GTE predicate on the "prompt_write_cached_tokens" field.
+func PromptWriteCachedTokensGTE(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGTE(FieldPromptWriteCachedTokens, v))
+}
+
+// PromptWriteCachedTokensLT applies the LT predicate on the "prompt_write_cached_tokens" field.
+func PromptWriteCachedTokensLT(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLT(FieldPromptWriteCachedTokens, v))
+}
+
+// PromptWriteCachedTokensLTE applies the LTE predicate on the "prompt_write_cached_tokens" field.
+func PromptWriteCachedTokensLTE(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLTE(FieldPromptWriteCachedTokens, v))
+}
+
+// CompletionTokensEQ applies the EQ predicate on the "completion_tokens" field.
+func CompletionTokensEQ(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldCompletionTokens, v))
+}
+
+// CompletionTokensNEQ applies the NEQ predicate on the "completion_tokens" field.
+func CompletionTokensNEQ(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNEQ(FieldCompletionTokens, v))
+}
+
+// CompletionTokensIn applies the In predicate on the "completion_tokens" field.
+func CompletionTokensIn(vs ...int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldIn(FieldCompletionTokens, vs...))
+}
+
+// CompletionTokensNotIn applies the NotIn predicate on the "completion_tokens" field.
+func CompletionTokensNotIn(vs ...int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNotIn(FieldCompletionTokens, vs...))
+}
+
+// CompletionTokensGT applies the GT predicate on the "completion_tokens" field.
+func CompletionTokensGT(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGT(FieldCompletionTokens, v))
+}
+
+// CompletionTokensGTE applies the GTE predicate on the "completion_tokens" field.
+func CompletionTokensGTE(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGTE(FieldCompletionTokens, v))
+}
+
+// CompletionTokensLT applies the LT predicate on the "completion_tokens" field.
+func CompletionTokensLT(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLT(FieldCompletionTokens, v))
+}
+
+// CompletionTokensLTE applies the LTE predicate on the "completion_tokens" field.
+func CompletionTokensLTE(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLTE(FieldCompletionTokens, v))
+}
+
+// CompletionReasoningTokensEQ applies the EQ predicate on the "completion_reasoning_tokens" field.
+func CompletionReasoningTokensEQ(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldCompletionReasoningTokens, v))
+}
+
+// CompletionReasoningTokensNEQ applies the NEQ predicate on the "completion_reasoning_tokens" field.
+func CompletionReasoningTokensNEQ(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNEQ(FieldCompletionReasoningTokens, v))
+}
+
+// CompletionReasoningTokensIn applies the In predicate on the "completion_reasoning_tokens" field.
+func CompletionReasoningTokensIn(vs ...int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldIn(FieldCompletionReasoningTokens, vs...))
+}
+
+// CompletionReasoningTokensNotIn applies the NotIn predicate on the "completion_reasoning_tokens" field.
+func CompletionReasoningTokensNotIn(vs ...int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNotIn(FieldCompletionReasoningTokens, vs...))
+}
+
+// CompletionReasoningTokensGT applies the GT predicate on the "completion_reasoning_tokens" field.
+func CompletionReasoningTokensGT(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGT(FieldCompletionReasoningTokens, v))
+}
+
+// CompletionReasoningTokensGTE applies the GTE predicate on the "completion_reasoning_tokens" field.
+func CompletionReasoningTokensGTE(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGTE(FieldCompletionReasoningTokens, v))
+}
+
+// CompletionReasoningTokensLT applies the LT predicate on the "completion_reasoning_tokens" field.
+func CompletionReasoningTokensLT(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLT(FieldCompletionReasoningTokens, v))
+}
+
+// CompletionReasoningTokensLTE applies the LTE predicate on the "completion_reasoning_tokens" field.
+func CompletionReasoningTokensLTE(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLTE(FieldCompletionReasoningTokens, v))
+}
+
+// TotalTokensEQ applies the EQ predicate on the "total_tokens" field.
+func TotalTokensEQ(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldTotalTokens, v))
+}
+
+// TotalTokensNEQ applies the NEQ predicate on the "total_tokens" field.
+func TotalTokensNEQ(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNEQ(FieldTotalTokens, v))
+}
+
+// TotalTokensIn applies the In predicate on the "total_tokens" field.
+func TotalTokensIn(vs ...int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldIn(FieldTotalTokens, vs...))
+}
+
+// TotalTokensNotIn applies the NotIn predicate on the "total_tokens" field.
+func TotalTokensNotIn(vs ...int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNotIn(FieldTotalTokens, vs...))
+}
+
+// TotalTokensGT applies the GT predicate on the "total_tokens" field.
+func TotalTokensGT(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGT(FieldTotalTokens, v))
+}
+
+// TotalTokensGTE applies the GTE predicate on the "total_tokens" field.
+func TotalTokensGTE(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGTE(FieldTotalTokens, v))
+}
+
+// TotalTokensLT applies the LT predicate on the "total_tokens" field.
+func TotalTokensLT(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLT(FieldTotalTokens, v))
+}
+
+// TotalTokensLTE applies the LTE predicate on the "total_tokens" field.
+func TotalTokensLTE(v int64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLTE(FieldTotalTokens, v))
+}
+
+// TotalCostEQ applies the EQ predicate on the "total_cost" field.
+func TotalCostEQ(v float64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldEQ(FieldTotalCost, v))
+}
+
+// TotalCostNEQ applies the NEQ predicate on the "total_cost" field.
+func TotalCostNEQ(v float64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNEQ(FieldTotalCost, v))
+}
+
+// TotalCostIn applies the In predicate on the "total_cost" field.
+func TotalCostIn(vs ...float64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldIn(FieldTotalCost, vs...))
+}
+
+// TotalCostNotIn applies the NotIn predicate on the "total_cost" field.
+func TotalCostNotIn(vs ...float64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldNotIn(FieldTotalCost, vs...))
+}
+
+// TotalCostGT applies the GT predicate on the "total_cost" field.
+func TotalCostGT(v float64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGT(FieldTotalCost, v))
+}
+
+// TotalCostGTE applies the GTE predicate on the "total_cost" field.
+func TotalCostGTE(v float64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldGTE(FieldTotalCost, v))
+}
+
+// TotalCostLT applies the LT predicate on the "total_cost" field.
+func TotalCostLT(v float64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLT(FieldTotalCost, v))
+}
+
+// TotalCostLTE applies the LTE predicate on the "total_cost" field.
+func TotalCostLTE(v float64) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.FieldLTE(FieldTotalCost, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UsageDailyRollup) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UsageDailyRollup) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UsageDailyRollup) predicate.UsageDailyRollup {
+	return predicate.UsageDailyRollup(sql.NotPredicates(p))
+}
@@ -0,0 +1,1252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/usagedailyrollup"
+)
+
+// UsageDailyRollupCreate is the builder for creating a UsageDailyRollup entity.
+type UsageDailyRollupCreate struct {
+	config
+	mutation *UsageDailyRollupMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetBucket sets the "bucket" field.
+func (_c *UsageDailyRollupCreate) SetBucket(v time.Time) *UsageDailyRollupCreate {
+	_c.mutation.SetBucket(v)
+	return _c
+}
+
+// SetProjectID sets the "project_id" field.
+func (_c *UsageDailyRollupCreate) SetProjectID(v int) *UsageDailyRollupCreate {
+	_c.mutation.SetProjectID(v)
+	return _c
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_c *UsageDailyRollupCreate) SetAPIKeyID(v int) *UsageDailyRollupCreate {
+	_c.mutation.SetAPIKeyID(v)
+	return _c
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_c *UsageDailyRollupCreate) SetNillableAPIKeyID(v *int) *UsageDailyRollupCreate {
+	if v != nil {
+		_c.SetAPIKeyID(*v)
+	}
+	return _c
+}
+
+// SetChannelID sets the "channel_id" field.
+func (_c *UsageDailyRollupCreate) SetChannelID(v int) *UsageDailyRollupCreate {
+	_c.mutation.SetChannelID(v)
+	return _c
+}
+
+// SetNillableChannelID sets the "channel_id" field if the given value is not nil.
+func (_c *UsageDailyRollupCreate) SetNillableChannelID(v *int) *UsageDailyRollupCreate {
+	if v != nil {
+		_c.SetChannelID(*v)
+	}
+	return _c
+}
+
+// SetModelID sets the "model_id" field.
+func (_c *UsageDailyRollupCreate) SetModelID(v string) *UsageDailyRollupCreate {
+	_c.mutation.SetModelID(v)
+	return _c
+}
+
+// SetEndUserID sets the "end_user_id" field.
+func (_c *UsageDailyRollupCreate) SetEndUserID(v string) *UsageDailyRollupCreate {
+	_c.mutation.SetEndUserID(v)
+	return _c
+}
+
+// SetNillableEndUserID sets the "end_user_id" field if the given value is not nil.
+func (_c *UsageDailyRollupCreate) SetNillableEndUserID(v *string) *UsageDailyRollupCreate {
+	if v != nil {
+		_c.SetEndUserID(*v)
+	}
+	return _c
+}
+
+// SetRequestCount sets the "request_count" field.
+func (_c *UsageDailyRollupCreate) SetRequestCount(v int64) *UsageDailyRollupCreate {
+	_c.mutation.SetRequestCount(v)
+	return _c
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (_c *UsageDailyRollupCreate) SetNillableRequestCount(v *int64) *UsageDailyRollupCreate {
+	if v != nil {
+		_c.SetRequestCount(*v)
+	}
+	return _c
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_c *UsageDailyRollupCreate) SetPromptTokens(v int64) *UsageDailyRollupCreate {
+	_c.mutation.SetPromptTokens(v)
+	return _c
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_c *UsageDailyRollupCreate) SetNillablePromptTokens(v *int64) *UsageDailyRollupCreate {
+	if v != nil {
+		_c.SetPromptTokens(*v)
+	}
+	return _c
+}
+
+// SetPromptCachedTokens sets the "prompt_cached_tokens" field.
+func (_c *UsageDailyRollupCreate) SetPromptCachedTokens(v int64) *UsageDailyRollupCreate {
+	_c.mutation.SetPromptCachedTokens(v)
+	return _c
+}
+
+// SetNillablePromptCachedTokens sets the "prompt_cached_tokens" field if the given value is not nil.
+func (_c *UsageDailyRollupCreate) SetNillablePromptCachedTokens(v *int64) *UsageDailyRollupCreate {
+	if v != nil {
+		_c.SetPromptCachedTokens(*v)
+	}
+	return _c
+}
+
+// SetPromptWriteCachedTokens sets the "prompt_write_cached_tokens" field.
+func (_c *UsageDailyRollupCreate) SetPromptWriteCachedTokens(v int64) *UsageDailyRollupCreate {
+	_c.mutation.SetPromptWriteCachedTokens(v)
+	return _c
+}
+
+// SetNillablePromptWriteCachedTokens sets the "prompt_write_cached_tokens" field if the given value is not nil.
+func (_c *UsageDailyRollupCreate) SetNillablePromptWriteCachedTokens(v *int64) *UsageDailyRollupCreate {
+	if v != nil {
+		_c.SetPromptWriteCachedTokens(*v)
+	}
+	return _c
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_c *UsageDailyRollupCreate) SetCompletionTokens(v int64) *UsageDailyRollupCreate {
+	_c.mutation.SetCompletionTokens(v)
+	return _c
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_c *UsageDailyRollupCreate) SetNillableCompletionTokens(v *int64) *UsageDailyRollupCreate {
+	if v != nil {
+		_c.SetCompletionTokens(*v)
+	}
+	return _c
+}
+
+// SetCompletionReasoningTokens sets the "completion_reasoning_tokens" field.
+func (_c *UsageDailyRollupCreate) SetCompletionReasoningTokens(v int64) *UsageDailyRollupCreate {
+	_c.mutation.SetCompletionReasoningTokens(v)
+	return _c
+}
+
+// SetNillableCompletionReasoningTokens sets the "completion_reasoning_tokens" field if the given value is not nil.
+func (_c *UsageDailyRollupCreate) SetNillableCompletionReasoningTokens(v *int64) *UsageDailyRollupCreate {
+	if v != nil {
+		_c.SetCompletionReasoningTokens(*v)
+	}
+	return _c
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_c *UsageDailyRollupCreate) SetTotalTokens(v int64) *UsageDailyRollupCreate {
+	_c.mutation.SetTotalTokens(v)
+	return _c
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_c *UsageDailyRollupCreate) SetNillableTotalTokens(v *int64) *UsageDailyRollupCreate {
+	if v != nil {
+		_c.SetTotalTokens(*v)
+	}
+	return _c
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (_c *UsageDailyRollupCreate) SetTotalCost(v float64) *UsageDailyRollupCreate {
+	_c.mutation.SetTotalCost(v)
+	return _c
+}
+
+// SetNillableTotalCost sets the "total_cost" field if the given value is not nil.
+func (_c *UsageDailyRollupCreate) SetNillableTotalCost(v *float64) *UsageDailyRollupCreate {
+	if v != nil {
+		_c.SetTotalCost(*v)
+	}
+	return _c
+}
+
+// Mutation returns the UsageDailyRollupMutation object of the builder.
+func (_c *UsageDailyRollupCreate) Mutation() *UsageDailyRollupMutation {
+	return _c.mutation
+}
+
+// Save creates the UsageDailyRollup in the database.
+func (_c *UsageDailyRollupCreate) Save(ctx context.Context) (*UsageDailyRollup, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UsageDailyRollupCreate) SaveX(ctx context.Context) *UsageDailyRollup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UsageDailyRollupCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UsageDailyRollupCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UsageDailyRollupCreate) defaults() error {
+	if _, ok := _c.mutation.APIKeyID(); !ok {
+		v := usagedailyrollup.DefaultAPIKeyID
+		_c.mutation.SetAPIKeyID(v)
+	}
+	if _, ok := _c.mutation.ChannelID(); !ok {
+		v := usagedailyrollup.DefaultChannelID
+		_c.mutation.SetChannelID(v)
+	}
+	if _, ok := _c.mutation.EndUserID(); !ok {
+		v := usagedailyrollup.DefaultEndUserID
+		_c.mutation.SetEndUserID(v)
+	}
+	if _, ok := _c.mutation.RequestCount(); !ok {
+		v := usagedailyrollup.DefaultRequestCount
+		_c.mutation.SetRequestCount(v)
+	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		v := usagedailyrollup.DefaultPromptTokens
+		_c.mutation.SetPromptTokens(v)
+	}
+	if _, ok := _c.mutation.PromptCachedTokens(); !ok {
+		v := usagedailyrollup.DefaultPromptCachedTokens
+		_c.mutation.SetPromptCachedTokens(v)
+	}
+	if _, ok := _c.mutation.PromptWriteCachedTokens(); !ok {
+		v := usagedailyrollup.DefaultPromptWriteCachedTokens
+		_c.mutation.SetPromptWriteCachedTokens(v)
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		v := usagedailyrollup.DefaultCompletionTokens
+		_c.mutation.SetCompletionTokens(v)
+	}
+	if _, ok := _c.mutation.CompletionReasoningTokens(); !ok {
+		v := usagedailyrollup.DefaultCompletionReasoningTokens
+		_c.mutation.SetCompletionReasoningTokens(v)
+	}
+	if _, ok := _c.mutation.TotalTokens(); !ok {
+		v := usagedailyrollup.DefaultTotalTokens
+		_c.mutation.SetTotalTokens(v)
+	}
+	if _, ok := _c.mutation.TotalCost(); !ok {
+		v := usagedailyrollup.DefaultTotalCost
+		_c.mutation.SetTotalCost(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UsageDailyRollupCreate) check() error {
+	if _, ok := _c.mutation.Bucket(); !ok {
+		return &ValidationError{Name: "bucket", err: errors.New(`ent: missing required field "UsageDailyRollup.bucket"`)}
+	}
+	if _, ok := _c.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "UsageDailyRollup.project_id"`)}
+	}
+	if _, ok := _c.mutation.APIKeyID(); !ok {
+		return &ValidationError{Name: "api_key_id", err: errors.New(`ent: missing required field "UsageDailyRollup.api_key_id"`)}
+	}
+	if _, ok := _c.mutation.ChannelID(); !ok {
+		return &ValidationError{Name: "channel_id", err: errors.New(`ent: missing required field "UsageDailyRollup.channel_id"`)}
+	}
+	if _, ok := _c.mutation.ModelID(); !ok {
+		return &ValidationError{Name: "model_id", err: errors.New(`ent: missing required field "UsageDailyRollup.model_id"`)}
+	}
+	if _, ok := _c.mutation.EndUserID(); !ok {
+		return &ValidationError{Name: "end_user_id", err: errors.New(`ent: missing required field "UsageDailyRollup.end_user_id"`)}
+	}
+	if v, ok := _c.mutation.EndUserID(); ok {
+		if err := usagedailyrollup.EndUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "end_user_id", err: fmt.Errorf(`ent: validator failed for field "UsageDailyRollup.end_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequestCount(); !ok {
+		return &ValidationError{Name: "request_count", err: errors.New(`ent: missing required field "UsageDailyRollup.request_count"`)}
+	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		return &ValidationError{Name: "prompt_tokens", err: errors.New(`ent: missing required field "UsageDailyRollup.prompt_tokens"`)}
+	}
+	if _, ok := _c.mutation.PromptCachedTokens(); !ok {
+		return &ValidationError{Name: "prompt_cached_tokens", err: errors.New(`ent: missing required field "UsageDailyRollup.prompt_cached_tokens"`)}
+	}
+	if _, ok := _c.mutation.PromptWriteCachedTokens(); !ok {
+		return &ValidationError{Name: "prompt_write_cached_tokens", err: errors.New(`ent: missing required field "UsageDailyRollup.prompt_write_cached_tokens"`)}
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		return &ValidationError{Name: "completion_tokens", err: errors.New(`ent: missing required field "UsageDailyRollup.completion_tokens"`)}
+	}
+	if _, ok := _c.mutation.CompletionReasoningTokens(); !ok {
+		return &ValidationError{Name: "completion_reasoning_tokens", err: errors.New(`ent: missing required field "UsageDailyRollup.completion_reasoning_tokens"`)}
+	}
+	if _, ok := _c.mutation.TotalTokens(); !ok {
+		return &ValidationError{Name: "total_tokens", err: errors.New(`ent: missing required field "UsageDailyRollup.total_tokens"`)}
+	}
+	if _, ok := _c.mutation.TotalCost(); !ok {
+		return &ValidationError{Name: "total_cost", err: errors.New(`ent: missing required field "UsageDailyRollup.total_cost"`)}
+	}
+	return nil
+}
+
+func (_c *UsageDailyRollupCreate) sqlSave(ctx context.Context) (*UsageDailyRollup, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UsageDailyRollupCreate) createSpec() (*UsageDailyRollup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UsageDailyRollup{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(usagedailyrollup.Table, sqlgraph.NewFieldSpec(usagedailyrollup.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Bucket(); ok {
+		_spec.SetField(usagedailyrollup.FieldBucket, field.TypeTime, value)
+		_node.Bucket = value
+	}
+	if value, ok := _c.mutation.ProjectID(); ok {
+		_spec.SetField(usagedailyrollup.FieldProjectID, field.TypeInt, value)
+		_node.ProjectID = value
+	}
+	if value, ok := _c.mutation.APIKeyID(); ok {
+		_spec.SetField(usagedailyrollup.FieldAPIKeyID, field.TypeInt, value)
+		_node.APIKeyID = value
+	}
+	if value, ok := _c.mutation.ChannelID(); ok {
+		_spec.SetField(usagedailyrollup.FieldChannelID, field.TypeInt, value)
+		_node.ChannelID = value
+	}
+	if value, ok := _c.mutation.ModelID(); ok {
+		_spec.SetField(usagedailyrollup.FieldModelID, field.TypeString, value)
+		_node.ModelID = value
+	}
+	if value, ok := _c.mutation.EndUserID(); ok {
+		_spec.SetField(usagedailyrollup.FieldEndUserID, field.TypeString, value)
+		_node.EndUserID = value
+	}
+	if value, ok := _c.mutation.RequestCount(); ok {
+		_spec.SetField(usagedailyrollup.FieldRequestCount, field.TypeInt64, value)
+		_node.RequestCount = value
+	}
+	if value, ok := _c.mutation.PromptTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldPromptTokens, field.TypeInt64, value)
+		_node.PromptTokens = value
+	}
+	if value, ok := _c.mutation.PromptCachedTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldPromptCachedTokens, field.TypeInt64, value)
+		_node.PromptCachedTokens = value
+	}
+	if value, ok := _c.mutation.PromptWriteCachedTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldPromptWriteCachedTokens, field.TypeInt64, value)
+		_node.PromptWriteCachedTokens = value
+	}
+	if value, ok := _c.mutation.CompletionTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldCompletionTokens, field.TypeInt64, value)
+		_node.CompletionTokens = value
+	}
+	if value, ok := _c.mutation.CompletionReasoningTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldCompletionReasoningTokens, field.TypeInt64, value)
+		_node.CompletionReasoningTokens = value
+	}
+	if value, ok := _c.mutation.TotalTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldTotalTokens, field.TypeInt64, value)
+		_node.TotalTokens = value
+	}
+	if value, ok := _c.mutation.TotalCost(); ok {
+		_spec.SetField(usagedailyrollup.FieldTotalCost, field.TypeFloat64, value)
+		_node.TotalCost = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UsageDailyRollup.Create().
+//		SetBucket(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UsageDailyRollupUpsert) {
+//			SetBucket(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UsageDailyRollupCreate) OnConflict(opts ...sql.ConflictOption) *UsageDailyRollupUpsertOne {
+	_c.conflict = opts
+	return &UsageDailyRollupUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UsageDailyRollup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UsageDailyRollupCreate) OnConflictColumns(columns ...string) *UsageDailyRollupUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UsageDailyRollupUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UsageDailyRollupUpsertOne is the builder for "upsert"-ing
+	//  one UsageDailyRollup node.
+	UsageDailyRollupUpsertOne struct {
+		create *UsageDailyRollupCreate
+	}
+
+	// UsageDailyRollupUpsert is the "OnConflict" setter.
+	UsageDailyRollupUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRequestCount sets the "request_count" field.
+func (u *UsageDailyRollupUpsert) SetRequestCount(v int64) *UsageDailyRollupUpsert {
+	u.Set(usagedailyrollup.FieldRequestCount, v)
+	return u
+}
+
+// UpdateRequestCount sets the "request_count" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsert) UpdateRequestCount() *UsageDailyRollupUpsert {
+	u.SetExcluded(usagedailyrollup.FieldRequestCount)
+	return u
+}
+
+// AddRequestCount adds v to the "request_count" field.
+func (u *UsageDailyRollupUpsert) AddRequestCount(v int64) *UsageDailyRollupUpsert {
+	u.Add(usagedailyrollup.FieldRequestCount, v)
+	return u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *UsageDailyRollupUpsert) SetPromptTokens(v int64) *UsageDailyRollupUpsert {
+	u.Set(usagedailyrollup.FieldPromptTokens, v)
+	return u
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsert) UpdatePromptTokens() *UsageDailyRollupUpsert {
+	u.SetExcluded(usagedailyrollup.FieldPromptTokens)
+	return u
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *UsageDailyRollupUpsert) AddPromptTokens(v int64) *UsageDailyRollupUpsert {
+	u.Add(usagedailyrollup.FieldPromptTokens, v)
+	return u
+}
+
+// SetPromptCachedTokens sets the "prompt_cached_tokens" field.
+func (u *UsageDailyRollupUpsert) SetPromptCachedTokens(v int64) *UsageDailyRollupUpsert {
+	u.Set(usagedailyrollup.FieldPromptCachedTokens, v)
+	return u
+}
+
+// UpdatePromptCachedTokens sets the "prompt_cached_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsert) UpdatePromptCachedTokens() *UsageDailyRollupUpsert {
+	u.SetExcluded(usagedailyrollup.FieldPromptCachedTokens)
+	return u
+}
+
+// AddPromptCachedTokens adds v to the "prompt_cached_tokens" field.
+func (u *UsageDailyRollupUpsert) AddPromptCachedTokens(v int64) *UsageDailyRollupUpsert {
+	u.Add(usagedailyrollup.FieldPromptCachedTokens, v)
+	return u
+}
+
+// SetPromptWriteCachedTokens sets the "prompt_write_cached_tokens" field.
+func (u *UsageDailyRollupUpsert) SetPromptWriteCachedTokens(v int64) *UsageDailyRollupUpsert {
+	u.Set(usagedailyrollup.FieldPromptWriteCachedTokens, v)
+	return u
+}
+
+// UpdatePromptWriteCachedTokens sets the "prompt_write_cached_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsert) UpdatePromptWriteCachedTokens() *UsageDailyRollupUpsert {
+	u.SetExcluded(usagedailyrollup.FieldPromptWriteCachedTokens)
+	return u
+}
+
+// AddPromptWriteCachedTokens adds v to the "prompt_write_cached_tokens" field.
+func (u *UsageDailyRollupUpsert) AddPromptWriteCachedTokens(v int64) *UsageDailyRollupUpsert {
+	u.Add(usagedailyrollup.FieldPromptWriteCachedTokens, v)
+	return u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *UsageDailyRollupUpsert) SetCompletionTokens(v int64) *UsageDailyRollupUpsert {
+	u.Set(usagedailyrollup.FieldCompletionTokens, v)
+	return u
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsert) UpdateCompletionTokens() *UsageDailyRollupUpsert {
+	u.SetExcluded(usagedailyrollup.FieldCompletionTokens)
+	return u
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *UsageDailyRollupUpsert) AddCompletionTokens(v int64) *UsageDailyRollupUpsert {
+	u.Add(usagedailyrollup.FieldCompletionTokens, v)
+	return u
+}
+
+// SetCompletionReasoningTokens sets the "completion_reasoning_tokens" field.
+func (u *UsageDailyRollupUpsert) SetCompletionReasoningTokens(v int64) *UsageDailyRollupUpsert {
+	u.Set(usagedailyrollup.FieldCompletionReasoningTokens, v)
+	return u
+}
+
+// UpdateCompletionReasoningTokens sets the "completion_reasoning_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsert) UpdateCompletionReasoningTokens() *UsageDailyRollupUpsert {
+	u.SetExcluded(usagedailyrollup.FieldCompletionReasoningTokens)
+	return u
+}
+
+// AddCompletionReasoningTokens adds v to the "completion_reasoning_tokens" field.
+func (u *UsageDailyRollupUpsert) AddCompletionReasoningTokens(v int64) *UsageDailyRollupUpsert {
+	u.Add(usagedailyrollup.FieldCompletionReasoningTokens, v)
+	return u
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (u *UsageDailyRollupUpsert) SetTotalTokens(v int64) *UsageDailyRollupUpsert {
+	u.Set(usagedailyrollup.FieldTotalTokens, v)
+	return u
+}
+
+// UpdateTotalTokens sets the "total_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsert) UpdateTotalTokens() *UsageDailyRollupUpsert {
+	u.SetExcluded(usagedailyrollup.FieldTotalTokens)
+	return u
+}
+
+// AddTotalTokens adds v to the "total_tokens" field.
+func (u *UsageDailyRollupUpsert) AddTotalTokens(v int64) *UsageDailyRollupUpsert {
+	u.Add(usagedailyrollup.FieldTotalTokens, v)
+	return u
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (u *UsageDailyRollupUpsert) SetTotalCost(v float64) *UsageDailyRollupUpsert {
+	u.Set(usagedailyrollup.FieldTotalCost, v)
+	return u
+}
+
+// UpdateTotalCost sets the "total_cost" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsert) UpdateTotalCost() *UsageDailyRollupUpsert {
+	u.SetExcluded(usagedailyrollup.FieldTotalCost)
+	return u
+}
+
+// AddTotalCost adds v to the "total_cost" field.
+func (u *UsageDailyRollupUpsert) AddTotalCost(v float64) *UsageDailyRollupUpsert {
+	u.Add(usagedailyrollup.FieldTotalCost, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.UsageDailyRollup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UsageDailyRollupUpsertOne) UpdateNewValues() *UsageDailyRollupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.Bucket(); exists {
+			s.SetIgnore(usagedailyrollup.FieldBucket)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(usagedailyrollup.FieldProjectID)
+		}
+		if _, exists := u.create.mutation.APIKeyID(); exists {
+			s.SetIgnore(usagedailyrollup.FieldAPIKeyID)
+		}
+		if _, exists := u.create.mutation.ChannelID(); exists {
+			s.SetIgnore(usagedailyrollup.FieldChannelID)
+		}
+		if _, exists := u.create.mutation.ModelID(); exists {
+			s.SetIgnore(usagedailyrollup.FieldModelID)
+		}
+		if _, exists := u.create.mutation.EndUserID(); exists {
+			s.SetIgnore(usagedailyrollup.FieldEndUserID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UsageDailyRollup.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UsageDailyRollupUpsertOne) Ignore() *UsageDailyRollupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UsageDailyRollupUpsertOne) DoNothing() *UsageDailyRollupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UsageDailyRollupCreate.OnConflict
+// documentation for more info.
+func (u *UsageDailyRollupUpsertOne) Update(set func(*UsageDailyRollupUpsert)) *UsageDailyRollupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UsageDailyRollupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRequestCount sets the "request_count" field.
+func (u *UsageDailyRollupUpsertOne) SetRequestCount(v int64) *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.SetRequestCount(v)
+	})
+}
+
+// AddRequestCount adds v to the "request_count" field.
+func (u *UsageDailyRollupUpsertOne) AddRequestCount(v int64) *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.AddRequestCount(v)
+	})
+}
+
+// UpdateRequestCount sets the "request_count" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsertOne) UpdateRequestCount() *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.UpdateRequestCount()
+	})
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *UsageDailyRollupUpsertOne) SetPromptTokens(v int64) *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.SetPromptTokens(v)
+	})
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *UsageDailyRollupUpsertOne) AddPromptTokens(v int64) *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.AddPromptTokens(v)
+	})
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsertOne) UpdatePromptTokens() *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.UpdatePromptTokens()
+	})
+}
+
+// SetPromptCachedTokens sets the "prompt_cached_tokens" field.
+func (u *UsageDailyRollupUpsertOne) SetPromptCachedTokens(v int64) *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.SetPromptCachedTokens(v)
+	})
+}
+
+// AddPromptCachedTokens adds v to the "prompt_cached_tokens" field.
+func (u *UsageDailyRollupUpsertOne) AddPromptCachedTokens(v int64) *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.AddPromptCachedTokens(v)
+	})
+}
+
+// UpdatePromptCachedTokens sets the "prompt_cached_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsertOne) UpdatePromptCachedTokens() *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.UpdatePromptCachedTokens()
+	})
+}
+
+// SetPromptWriteCachedTokens sets the "prompt_write_cached_tokens" field.
+func (u *UsageDailyRollupUpsertOne) SetPromptWriteCachedTokens(v int64) *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.SetPromptWriteCachedTokens(v)
+	})
+}
+
+// AddPromptWriteCachedTokens adds v to the "prompt_write_cached_tokens" field.
+func (u *UsageDailyRollupUpsertOne) AddPromptWriteCachedTokens(v int64) *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.AddPromptWriteCachedTokens(v)
+	})
+}
+
+// UpdatePromptWriteCachedTokens sets the "prompt_write_cached_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsertOne) UpdatePromptWriteCachedTokens() *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.UpdatePromptWriteCachedTokens()
+	})
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *UsageDailyRollupUpsertOne) SetCompletionTokens(v int64) *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.SetCompletionTokens(v)
+	})
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *UsageDailyRollupUpsertOne) AddCompletionTokens(v int64) *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.AddCompletionTokens(v)
+	})
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsertOne) UpdateCompletionTokens() *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.UpdateCompletionTokens()
+	})
+}
+
+// SetCompletionReasoningTokens sets the "completion_reasoning_tokens" field.
+func (u *UsageDailyRollupUpsertOne) SetCompletionReasoningTokens(v int64) *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.SetCompletionReasoningTokens(v)
+	})
+}
+
+// AddCompletionReasoningTokens adds v to the "completion_reasoning_tokens" field.
+func (u *UsageDailyRollupUpsertOne) AddCompletionReasoningTokens(v int64) *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.AddCompletionReasoningTokens(v)
+	})
+}
+
+// UpdateCompletionReasoningTokens sets the "completion_reasoning_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsertOne) UpdateCompletionReasoningTokens() *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.UpdateCompletionReasoningTokens()
+	})
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (u *UsageDailyRollupUpsertOne) SetTotalTokens(v int64) *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.SetTotalTokens(v)
+	})
+}
+
+// AddTotalTokens adds v to the "total_tokens" field.
+func (u *UsageDailyRollupUpsertOne) AddTotalTokens(v int64) *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.AddTotalTokens(v)
+	})
+}
+
+// UpdateTotalTokens sets the "total_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsertOne) UpdateTotalTokens() *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.UpdateTotalTokens()
+	})
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (u *UsageDailyRollupUpsertOne) SetTotalCost(v float64) *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.SetTotalCost(v)
+	})
+}
+
+// AddTotalCost adds v to the "total_cost" field.
+func (u *UsageDailyRollupUpsertOne) AddTotalCost(v float64) *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.AddTotalCost(v)
+	})
+}
+
+// UpdateTotalCost sets the "total_cost" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsertOne) UpdateTotalCost() *UsageDailyRollupUpsertOne {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.UpdateTotalCost()
+	})
+}
+
+// Exec executes the query.
+func (u *UsageDailyRollupUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UsageDailyRollupCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UsageDailyRollupUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UsageDailyRollupUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UsageDailyRollupUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UsageDailyRollupCreateBulk is the builder for creating many UsageDailyRollup entities in bulk.
+type UsageDailyRollupCreateBulk struct {
+	config
+	err      error
+	builders []*UsageDailyRollupCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UsageDailyRollup entities in the database.
+func (_c *UsageDailyRollupCreateBulk) Save(ctx context.Context) ([]*UsageDailyRollup, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UsageDailyRollup, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsageDailyRollupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UsageDailyRollupCreateBulk) SaveX(ctx context.Context) []*UsageDailyRollup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UsageDailyRollupCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UsageDailyRollupCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UsageDailyRollup.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UsageDailyRollupUpsert) {
+//			SetBucket(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UsageDailyRollupCreateBulk) OnConflict(opts ...sql.ConflictOption) *UsageDailyRollupUpsertBulk {
+	_c.conflict = opts
+	return &UsageDailyRollupUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UsageDailyRollup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UsageDailyRollupCreateBulk) OnConflictColumns(columns ...string) *UsageDailyRollupUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UsageDailyRollupUpsertBulk{
+		create: _c,
+	}
+}
+
+// UsageDailyRollupUpsertBulk is the builder for "upsert"-ing
+// a bulk of UsageDailyRollup nodes.
+type UsageDailyRollupUpsertBulk struct {
+	create *UsageDailyRollupCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UsageDailyRollup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UsageDailyRollupUpsertBulk) UpdateNewValues() *UsageDailyRollupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.Bucket(); exists {
+				s.SetIgnore(usagedailyrollup.FieldBucket)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(usagedailyrollup.FieldProjectID)
+			}
+			if _, exists := b.mutation.APIKeyID(); exists {
+				s.SetIgnore(usagedailyrollup.FieldAPIKeyID)
+			}
+			if _, exists := b.mutation.ChannelID(); exists {
+				s.SetIgnore(usagedailyrollup.FieldChannelID)
+			}
+			if _, exists := b.mutation.ModelID(); exists {
+				s.SetIgnore(usagedailyrollup.FieldModelID)
+			}
+			if _, exists := b.mutation.EndUserID(); exists {
+				s.SetIgnore(usagedailyrollup.FieldEndUserID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UsageDailyRollup.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UsageDailyRollupUpsertBulk) Ignore() *UsageDailyRollupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UsageDailyRollupUpsertBulk) DoNothing() *UsageDailyRollupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UsageDailyRollupCreateBulk.OnConflict
+// documentation for more info.
+func (u *UsageDailyRollupUpsertBulk) Update(set func(*UsageDailyRollupUpsert)) *UsageDailyRollupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UsageDailyRollupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRequestCount sets the "request_count" field.
+func (u *UsageDailyRollupUpsertBulk) SetRequestCount(v int64) *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.SetRequestCount(v)
+	})
+}
+
+// AddRequestCount adds v to the "request_count" field.
+func (u *UsageDailyRollupUpsertBulk) AddRequestCount(v int64) *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.AddRequestCount(v)
+	})
+}
+
+// UpdateRequestCount sets the "request_count" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsertBulk) UpdateRequestCount() *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.UpdateRequestCount()
+	})
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *UsageDailyRollupUpsertBulk) SetPromptTokens(v int64) *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.SetPromptTokens(v)
+	})
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *UsageDailyRollupUpsertBulk) AddPromptTokens(v int64) *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.AddPromptTokens(v)
+	})
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsertBulk) UpdatePromptTokens() *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.UpdatePromptTokens()
+	})
+}
+
+// SetPromptCachedTokens sets the "prompt_cached_tokens" field.
+func (u *UsageDailyRollupUpsertBulk) SetPromptCachedTokens(v int64) *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.SetPromptCachedTokens(v)
+	})
+}
+
+// AddPromptCachedTokens adds v to the "prompt_cached_tokens" field.
+func (u *UsageDailyRollupUpsertBulk) AddPromptCachedTokens(v int64) *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.AddPromptCachedTokens(v)
+	})
+}
+
+// UpdatePromptCachedTokens sets the "prompt_cached_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsertBulk) UpdatePromptCachedTokens() *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.UpdatePromptCachedTokens()
+	})
+}
+
+// SetPromptWriteCachedTokens sets the "prompt_write_cached_tokens" field.
+func (u *UsageDailyRollupUpsertBulk) SetPromptWriteCachedTokens(v int64) *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.SetPromptWriteCachedTokens(v)
+	})
+}
+
+// AddPromptWriteCachedTokens adds v to the "prompt_write_cached_tokens" field.
+func (u *UsageDailyRollupUpsertBulk) AddPromptWriteCachedTokens(v int64) *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.AddPromptWriteCachedTokens(v)
+	})
+}
+
+// UpdatePromptWriteCachedTokens sets the "prompt_write_cached_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsertBulk) UpdatePromptWriteCachedTokens() *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.UpdatePromptWriteCachedTokens()
+	})
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *UsageDailyRollupUpsertBulk) SetCompletionTokens(v int64) *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.SetCompletionTokens(v)
+	})
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *UsageDailyRollupUpsertBulk) AddCompletionTokens(v int64) *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.AddCompletionTokens(v)
+	})
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsertBulk) UpdateCompletionTokens() *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.UpdateCompletionTokens()
+	})
+}
+
+// SetCompletionReasoningTokens sets the "completion_reasoning_tokens" field.
+func (u *UsageDailyRollupUpsertBulk) SetCompletionReasoningTokens(v int64) *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.SetCompletionReasoningTokens(v)
+	})
+}
+
+// AddCompletionReasoningTokens adds v to the "completion_reasoning_tokens" field.
+func (u *UsageDailyRollupUpsertBulk) AddCompletionReasoningTokens(v int64) *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.AddCompletionReasoningTokens(v)
+	})
+}
+
+// UpdateCompletionReasoningTokens sets the "completion_reasoning_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsertBulk) UpdateCompletionReasoningTokens() *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.UpdateCompletionReasoningTokens()
+	})
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (u *UsageDailyRollupUpsertBulk) SetTotalTokens(v int64) *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.SetTotalTokens(v)
+	})
+}
+
+// AddTotalTokens adds v to the "total_tokens" field.
+func (u *UsageDailyRollupUpsertBulk) AddTotalTokens(v int64) *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.AddTotalTokens(v)
+	})
+}
+
+// UpdateTotalTokens sets the "total_tokens" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsertBulk) UpdateTotalTokens() *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.UpdateTotalTokens()
+	})
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (u *UsageDailyRollupUpsertBulk) SetTotalCost(v float64) *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.SetTotalCost(v)
+	})
+}
+
+// AddTotalCost adds v to the "total_cost" field.
+func (u *UsageDailyRollupUpsertBulk) AddTotalCost(v float64) *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.AddTotalCost(v)
+	})
+}
+
+// UpdateTotalCost sets the "total_cost" field to the value that was provided on create.
+func (u *UsageDailyRollupUpsertBulk) UpdateTotalCost() *UsageDailyRollupUpsertBulk {
+	return u.Update(func(s *UsageDailyRollupUpsert) {
+		s.UpdateTotalCost()
+	})
+}
+
+// Exec executes the query.
+func (u *UsageDailyRollupUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UsageDailyRollupCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UsageDailyRollupCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UsageDailyRollupUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,623 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/ent/usagedailyrollup"
+)
+
+// UsageDailyRollupUpdate is the builder for updating UsageDailyRollup entities.
+type UsageDailyRollupUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *UsageDailyRollupMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the UsageDailyRollupUpdate builder.
+func (_u *UsageDailyRollupUpdate) Where(ps ...predicate.UsageDailyRollup) *UsageDailyRollupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRequestCount sets the "request_count" field.
+func (_u *UsageDailyRollupUpdate) SetRequestCount(v int64) *UsageDailyRollupUpdate {
+	_u.mutation.ResetRequestCount()
+	_u.mutation.SetRequestCount(v)
+	return _u
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (_u *UsageDailyRollupUpdate) SetNillableRequestCount(v *int64) *UsageDailyRollupUpdate {
+	if v != nil {
+		_u.SetRequestCount(*v)
+	}
+	return _u
+}
+
+// AddRequestCount adds value to the "request_count" field.
+func (_u *UsageDailyRollupUpdate) AddRequestCount(v int64) *UsageDailyRollupUpdate {
+	_u.mutation.AddRequestCount(v)
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *UsageDailyRollupUpdate) SetPromptTokens(v int64) *UsageDailyRollupUpdate {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *UsageDailyRollupUpdate) SetNillablePromptTokens(v *int64) *UsageDailyRollupUpdate {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *UsageDailyRollupUpdate) AddPromptTokens(v int64) *UsageDailyRollupUpdate {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetPromptCachedTokens sets the "prompt_cached_tokens" field.
+func (_u *UsageDailyRollupUpdate) SetPromptCachedTokens(v int64) *UsageDailyRollupUpdate {
+	_u.mutation.ResetPromptCachedTokens()
+	_u.mutation.SetPromptCachedTokens(v)
+	return _u
+}
+
+// SetNillablePromptCachedTokens sets the "prompt_cached_tokens" field if the given value is not nil.
+func (_u *UsageDailyRollupUpdate) SetNillablePromptCachedTokens(v *int64) *UsageDailyRollupUpdate {
+	if v != nil {
+		_u.SetPromptCachedTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptCachedTokens adds value to the "prompt_cached_tokens" field.
+func (_u *UsageDailyRollupUpdate) AddPromptCachedTokens(v int64) *UsageDailyRollupUpdate {
+	_u.mutation.AddPromptCachedTokens(v)
+	return _u
+}
+
+// SetPromptWriteCachedTokens sets the "prompt_write_cached_tokens" field.
+func (_u *UsageDailyRollupUpdate) SetPromptWriteCachedTokens(v int64) *UsageDailyRollupUpdate {
+	_u.mutation.ResetPromptWriteCachedTokens()
+	_u.mutation.SetPromptWriteCachedTokens(v)
+	return _u
+}
+
+// SetNillablePromptWriteCachedTokens sets the "prompt_write_cached_tokens" field if the given value is not nil.
+func (_u *UsageDailyRollupUpdate) SetNillablePromptWriteCachedTokens(v *int64) *UsageDailyRollupUpdate {
+	if v != nil {
+		_u.SetPromptWriteCachedTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptWriteCachedTokens adds value to the "prompt_write_cached_tokens" field.
+func (_u *UsageDailyRollupUpdate) AddPromptWriteCachedTokens(v int64) *UsageDailyRollupUpdate {
+	_u.mutation.AddPromptWriteCachedTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *UsageDailyRollupUpdate) SetCompletionTokens(v int64) *UsageDailyRollupUpdate {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *UsageDailyRollupUpdate) SetNillableCompletionTokens(v *int64) *UsageDailyRollupUpdate {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *UsageDailyRollupUpdate) AddCompletionTokens(v int64) *UsageDailyRollupUpdate {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetCompletionReasoningTokens sets the "completion_reasoning_tokens" field.
+func (_u *UsageDailyRollupUpdate) SetCompletionReasoningTokens(v int64) *UsageDailyRollupUpdate {
+	_u.mutation.ResetCompletionReasoningTokens()
+	_u.mutation.SetCompletionReasoningTokens(v)
+	return _u
+}
+
+// SetNillableCompletionReasoningTokens sets the "completion_reasoning_tokens" field if the given value is not nil.
+func (_u *UsageDailyRollupUpdate) SetNillableCompletionReasoningTokens(v *int64) *UsageDailyRollupUpdate {
+	if v != nil {
+		_u.SetCompletionReasoningTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionReasoningTokens adds value to the "completion_reasoning_tokens" field.
+func (_u *UsageDailyRollupUpdate) AddCompletionReasoningTokens(v int64) *UsageDailyRollupUpdate {
+	_u.mutation.AddCompletionReasoningTokens(v)
+	return _u
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_u *UsageDailyRollupUpdate) SetTotalTokens(v int64) *UsageDailyRollupUpdate {
+	_u.mutation.ResetTotalTokens()
+	_u.mutation.SetTotalTokens(v)
+	return _u
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_u *UsageDailyRollupUpdate) SetNillableTotalTokens(v *int64) *UsageDailyRollupUpdate {
+	if v != nil {
+		_u.SetTotalTokens(*v)
+	}
+	return _u
+}
+
+// AddTotalTokens adds value to the "total_tokens" field.
+func (_u *UsageDailyRollupUpdate) AddTotalTokens(v int64) *UsageDailyRollupUpdate {
+	_u.mutation.AddTotalTokens(v)
+	return _u
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (_u *UsageDailyRollupUpdate) SetTotalCost(v float64) *UsageDailyRollupUpdate {
+	_u.mutation.ResetTotalCost()
+	_u.mutation.SetTotalCost(v)
+	return _u
+}
+
+// SetNillableTotalCost sets the "total_cost" field if the given value is not nil.
+func (_u *UsageDailyRollupUpdate) SetNillableTotalCost(v *float64) *UsageDailyRollupUpdate {
+	if v != nil {
+		_u.SetTotalCost(*v)
+	}
+	return _u
+}
+
+// AddTotalCost adds value to the "total_cost" field.
+func (_u *UsageDailyRollupUpdate) AddTotalCost(v float64) *UsageDailyRollupUpdate {
+	_u.mutation.AddTotalCost(v)
+	return _u
+}
+
+// Mutation returns the UsageDailyRollupMutation object of the builder.
+func (_u *UsageDailyRollupUpdate) Mutation() *UsageDailyRollupMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UsageDailyRollupUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageDailyRollupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UsageDailyRollupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageDailyRollupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UsageDailyRollupUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UsageDailyRollupUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *UsageDailyRollupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(usagedailyrollup.Table, usagedailyrollup.Columns, sqlgraph.NewFieldSpec(usagedailyrollup.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RequestCount(); ok {
+		_spec.SetField(usagedailyrollup.FieldRequestCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRequestCount(); ok {
+		_spec.AddField(usagedailyrollup.FieldRequestCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldPromptTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(usagedailyrollup.FieldPromptTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PromptCachedTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldPromptCachedTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPromptCachedTokens(); ok {
+		_spec.AddField(usagedailyrollup.FieldPromptCachedTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PromptWriteCachedTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldPromptWriteCachedTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPromptWriteCachedTokens(); ok {
+		_spec.AddField(usagedailyrollup.FieldPromptWriteCachedTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldCompletionTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(usagedailyrollup.FieldCompletionTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CompletionReasoningTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldCompletionReasoningTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionReasoningTokens(); ok {
+		_spec.AddField(usagedailyrollup.FieldCompletionReasoningTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TotalTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldTotalTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalTokens(); ok {
+		_spec.AddField(usagedailyrollup.FieldTotalTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TotalCost(); ok {
+		_spec.SetField(usagedailyrollup.FieldTotalCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalCost(); ok {
+		_spec.AddField(usagedailyrollup.FieldTotalCost, field.TypeFloat64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagedailyrollup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UsageDailyRollupUpdateOne is the builder for updating a single UsageDailyRollup entity.
+type UsageDailyRollupUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UsageDailyRollupMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetRequestCount sets the "request_count" field.
+func (_u *UsageDailyRollupUpdateOne) SetRequestCount(v int64) *UsageDailyRollupUpdateOne {
+	_u.mutation.ResetRequestCount()
+	_u.mutation.SetRequestCount(v)
+	return _u
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (_u *UsageDailyRollupUpdateOne) SetNillableRequestCount(v *int64) *UsageDailyRollupUpdateOne {
+	if v != nil {
+		_u.SetRequestCount(*v)
+	}
+	return _u
+}
+
+// AddRequestCount adds value to the "request_count" field.
+func (_u *UsageDailyRollupUpdateOne) AddRequestCount(v int64) *UsageDailyRollupUpdateOne {
+	_u.mutation.AddRequestCount(v)
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *UsageDailyRollupUpdateOne) SetPromptTokens(v int64) *UsageDailyRollupUpdateOne {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *UsageDailyRollupUpdateOne) SetNillablePromptTokens(v *int64) *UsageDailyRollupUpdateOne {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *UsageDailyRollupUpdateOne) AddPromptTokens(v int64) *UsageDailyRollupUpdateOne {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetPromptCachedTokens sets the "prompt_cached_tokens" field.
+func (_u *UsageDailyRollupUpdateOne) SetPromptCachedTokens(v int64) *UsageDailyRollupUpdateOne {
+	_u.mutation.ResetPromptCachedTokens()
+	_u.mutation.SetPromptCachedTokens(v)
+	return _u
+}
+
+// SetNillablePromptCachedTokens sets the "prompt_cached_tokens" field if the given value is not nil.
+func (_u *UsageDailyRollupUpdateOne) SetNillablePromptCachedTokens(v *int64) *UsageDailyRollupUpdateOne {
+	if v != nil {
+		_u.SetPromptCachedTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptCachedTokens adds value to the "prompt_cached_tokens" field.
+func (_u *UsageDailyRollupUpdateOne) AddPromptCachedTokens(v int64) *UsageDailyRollupUpdateOne {
+	_u.mutation.AddPromptCachedTokens(v)
+	return _u
+}
+
+// SetPromptWriteCachedTokens sets the "prompt_write_cached_tokens" field.
+func (_u *UsageDailyRollupUpdateOne) SetPromptWriteCachedTokens(v int64) *UsageDailyRollupUpdateOne {
+	_u.mutation.ResetPromptWriteCachedTokens()
+	_u.mutation.SetPromptWriteCachedTokens(v)
+	return _u
+}
+
+// SetNillablePromptWriteCachedTokens sets the "prompt_write_cached_tokens" field if the given value is not nil.
+func (_u *UsageDailyRollupUpdateOne) SetNillablePromptWriteCachedTokens(v *int64) *UsageDailyRollupUpdateOne {
+	if v != nil {
+		_u.SetPromptWriteCachedTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptWriteCachedTokens adds value to the "prompt_write_cached_tokens" field.
+func (_u *UsageDailyRollupUpdateOne) AddPromptWriteCachedTokens(v int64) *UsageDailyRollupUpdateOne {
+	_u.mutation.AddPromptWriteCachedTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *UsageDailyRollupUpdateOne) SetCompletionTokens(v int64) *UsageDailyRollupUpdateOne {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *UsageDailyRollupUpdateOne) SetNillableCompletionTokens(v *int64) *UsageDailyRollupUpdateOne {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *UsageDailyRollupUpdateOne) AddCompletionTokens(v int64) *UsageDailyRollupUpdateOne {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetCompletionReasoningTokens sets the "completion_reasoning_tokens" field.
+func (_u *UsageDailyRollupUpdateOne) SetCompletionReasoningTokens(v int64) *UsageDailyRollupUpdateOne {
+	_u.mutation.ResetCompletionReasoningTokens()
+	_u.mutation.SetCompletionReasoningTokens(v)
+	return _u
+}
+
+// SetNillableCompletionReasoningTokens sets the "completion_reasoning_tokens" field if the given value is not nil.
+func (_u *UsageDailyRollupUpdateOne) SetNillableCompletionReasoningTokens(v *int64) *UsageDailyRollupUpdateOne {
+	if v != nil {
+		_u.SetCompletionReasoningTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionReasoningTokens adds value to the "completion_reasoning_tokens" field.
+func (_u *UsageDailyRollupUpdateOne) AddCompletionReasoningTokens(v int64) *UsageDailyRollupUpdateOne {
+	_u.mutation.AddCompletionReasoningTokens(v)
+	return _u
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_u *UsageDailyRollupUpdateOne) SetTotalTokens(v int64) *UsageDailyRollupUpdateOne {
+	_u.mutation.ResetTotalTokens()
+	_u.mutation.SetTotalTokens(v)
+	return _u
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_u *UsageDailyRollupUpdateOne) SetNillableTotalTokens(v *int64) *UsageDailyRollupUpdateOne {
+	if v != nil {
+		_u.SetTotalTokens(*v)
+	}
+	return _u
+}
+
+// AddTotalTokens adds value to the "total_tokens" field.
+func (_u *UsageDailyRollupUpdateOne) AddTotalTokens(v int64) *UsageDailyRollupUpdateOne {
+	_u.mutation.AddTotalTokens(v)
+	return _u
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (_u *UsageDailyRollupUpdateOne) SetTotalCost(v float64) *UsageDailyRollupUpdateOne {
+	_u.mutation.ResetTotalCost()
+	_u.mutation.SetTotalCost(v)
+	return _u
+}
+
+// SetNillableTotalCost sets the "total_cost" field if the given value is not nil.
+func (_u *UsageDailyRollupUpdateOne) SetNillableTotalCost(v *float64) *UsageDailyRollupUpdateOne {
+	if v != nil {
+		_u.SetTotalCost(*v)
+	}
+	return _u
+}
+
+// AddTotalCost adds value to the "total_cost" field.
+func (_u *UsageDailyRollupUpdateOne) AddTotalCost(v float64) *UsageDailyRollupUpdateOne {
+	_u.mutation.AddTotalCost(v)
+	return _u
+}
+
+// Mutation returns the UsageDailyRollupMutation object of the builder.
+func (_u *UsageDailyRollupUpdateOne) Mutation() *UsageDailyRollupMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UsageDailyRollupUpdate builder.
+func (_u *UsageDailyRollupUpdateOne) Where(ps ...predicate.UsageDailyRollup) *UsageDailyRollupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UsageDailyRollupUpdateOne) Select(field string, fields ...string) *UsageDailyRollupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UsageDailyRollup entity.
+func (_u *UsageDailyRollupUpdateOne) Save(ctx context.Context) (*UsageDailyRollup, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageDailyRollupUpdateOne) SaveX(ctx context.Context) *UsageDailyRollup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UsageDailyRollupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageDailyRollupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UsageDailyRollupUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UsageDailyRollupUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *UsageDailyRollupUpdateOne) sqlSave(ctx context.Context) (_node *UsageDailyRollup, err error) {
+	_spec := sqlgraph.NewUpdateSpec(usagedailyrollup.Table, usagedailyrollup.Columns, sqlgraph.NewFieldSpec(usagedailyrollup.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UsageDailyRollup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usagedailyrollup.FieldID)
+		for _, f := range fields {
+			if !usagedailyrollup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usagedailyrollup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RequestCount(); ok {
+		_spec.SetField(usagedailyrollup.FieldRequestCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRequestCount(); ok {
+		_spec.AddField(usagedailyrollup.FieldRequestCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldPromptTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(usagedailyrollup.FieldPromptTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PromptCachedTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldPromptCachedTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPromptCachedTokens(); ok {
+		_spec.AddField(usagedailyrollup.FieldPromptCachedTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PromptWriteCachedTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldPromptWriteCachedTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPromptWriteCachedTokens(); ok {
+		_spec.AddField(usagedailyrollup.FieldPromptWriteCachedTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldCompletionTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(usagedailyrollup.FieldCompletionTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CompletionReasoningTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldCompletionReasoningTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionReasoningTokens(); ok {
+		_spec.AddField(usagedailyrollup.FieldCompletionReasoningTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TotalTokens(); ok {
+		_spec.SetField(usagedailyrollup.FieldTotalTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalTokens(); ok {
+		_spec.AddField(usagedailyrollup.FieldTotalTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TotalCost(); ok {
+		_spec.SetField(usagedailyrollup.FieldTotalCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalCost(); ok {
+		_spec.AddField(usagedailyrollup.FieldTotalCost, field.TypeFloat64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &UsageDailyRollup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagedailyrollup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/looplj/axonhub/internal/ent/usagehourlyrollup"
+)
+
+// UsageHourlyRollup is the model entity for the UsageHourlyRollup schema.
+type UsageHourlyRollup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Start of the rollup bucket
+	Bucket time.Time `json:"bucket,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID int `json:"project_id,omitempty"`
+	// API key ID, 0 if the requests were not made with an API key
+	APIKeyID int `json:"api_key_id,omitempty"`
+	// Channel ID, 0 if the requests were not sent to a channel
+	ChannelID int `json:"channel_id,omitempty"`
+	// ModelID holds the value of the "model_id" field.
+	ModelID string `json:"model_id,omitempty"`
+	// End user identifier the usage is attributed to
+	EndUserID string `json:"end_user_id,omitempty"`
+	// RequestCount holds the value of the "request_count" field.
+	RequestCount int64 `json:"request_count,omitempty"`
+	// PromptTokens holds the value of the "prompt_tokens" field.
+	PromptTokens int64 `json:"prompt_tokens,omitempty"`
+	// PromptCachedTokens holds the value of the "prompt_cached_tokens" field.
+	PromptCachedTokens int64 `json:"prompt_cached_tokens,omitempty"`
+	// PromptWriteCachedTokens holds the value of the "prompt_write_cached_tokens" field.
+	PromptWriteCachedTokens int64 `json:"prompt_write_cached_tokens,omitempty"`
+	// CompletionTokens holds the value of the "completion_tokens" field.
+	CompletionTokens int64 `json:"completion_tokens,omitempty"`
+	// CompletionReasoningTokens holds the value of the "completion_reasoning_tokens" field.
+	CompletionReasoningTokens int64 `json:"completion_reasoning_tokens,omitempty"`
+	// TotalTokens holds the value of the "total_tokens" field.
+	TotalTokens int64 `json:"total_tokens,omitempty"`
+	// TotalCost holds the value of the "total_cost" field.
+	TotalCost    float64 `json:"total_cost,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UsageHourlyRollup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usagehourlyrollup.FieldTotalCost:
+			values[i] = new(sql.NullFloat64)
+		case usagehourlyrollup.FieldID, usagehourlyrollup.FieldProjectID, usagehourlyrollup.FieldAPIKeyID, usagehourlyrollup.FieldChannelID, usagehourlyrollup.FieldRequestCount, usagehourlyrollup.FieldPromptTokens, usagehourlyrollup.FieldPromptCachedTokens, usagehourlyrollup.FieldPromptWriteCachedTokens, usagehourlyrollup.FieldCompletionTokens, usagehourlyrollup.FieldCompletionReasoningTokens, usagehourlyrollup.FieldTotalTokens:
+			values[i] = new(sql.NullInt64)
+		case usagehourlyrollup.FieldModelID, usagehourlyrollup.FieldEndUserID:
+			values[i] = new(sql.NullString)
+		case usagehourlyrollup.FieldBucket:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UsageHourlyRollup fields.
+func (_m *UsageHourlyRollup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usagehourlyrollup.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case usagehourlyrollup.FieldBucket:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field bucket", values[i])
+			} else if value.Valid {
+				_m.Bucket = value.Time
+			}
+		case usagehourlyrollup.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				_m.ProjectID = int(value.Int64)
+			}
+		case usagehourlyrollup.FieldAPIKeyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field api_key_id", values[i])
+			} else if value.Valid {
+				_m.APIKeyID = int(value.Int64)
+			}
+		case usagehourlyrollup.FieldChannelID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field channel_id", values[i])
+			} else if value.Valid {
+				_m.ChannelID = int(value.Int64)
+			}
+		case usagehourlyrollup.FieldModelID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model_id", values[i])
+			} else if value.Valid {
+				_m.ModelID = value.String
+			}
+		case usagehourlyrollup.FieldEndUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end_user_id", values[i])
+			} else if value.Valid {
+				_m.EndUserID = value.String
+			}
+		case usagehourlyrollup.FieldRequestCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field request_count", values[i])
+			} else if value.Valid {
+				_m.RequestCount = value.Int64
+			}
+		case usagehourlyrollup.FieldPromptTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_tokens", values[i])
+			} else if value.Valid {
+				_m.PromptTokens = value.Int64
+			}
+		case usagehourlyrollup.FieldPromptCachedTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_cached_tokens", values[i])
+			} else if value.Valid {
+				_m.PromptCachedTokens = value.Int64
+			}
+		case usagehourlyrollup.FieldPromptWriteCachedTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_write_cached_tokens", values[i])
+			} else if value.Valid {
+				_m.PromptWriteCachedTokens = value.Int64
+			}
+		case usagehourlyrollup.FieldCompletionTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field completion_tokens", values[i])
+			} else if value.Valid {
+				_m.CompletionTokens = value.Int64
+			}
+		case usagehourlyrollup.FieldCompletionReasoningTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field completion_reasoning_tokens", values[i])
+			} else if value.Valid {
+				_m.CompletionReasoningTokens = value.Int64
+			}
+		case usagehourlyrollup.FieldTotalTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_tokens", values[i])
+			} else if value.Valid {
+				_m.TotalTokens = value.Int64
+			}
+		case usagehourlyrollup.FieldTotalCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_cost", values[i])
+			} else if value.Valid {
+				_m.TotalCost = value.Float64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UsageHourlyRollup.
+// This includes values selected through modifiers, order, etc.
+func (_m *UsageHourlyRollup) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UsageHourlyRollup.
+// Note that you need to call UsageHourlyRollup.Unwrap() before calling this method if this UsageHourlyRollup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UsageHourlyRollup) Update() *UsageHourlyRollupUpdateOne {
+	return NewUsageHourlyRollupClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UsageHourlyRollup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UsageHourlyRollup) Unwrap() *UsageHourlyRollup {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UsageHourlyRollup is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UsageHourlyRollup) String() string {
+	var builder strings.Builder
+	builder.WriteString("UsageHourlyRollup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("bucket=")
+	builder.WriteString(_m.Bucket.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("api_key_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.APIKeyID))
+	builder.WriteString(", ")
+	builder.WriteString("channel_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChannelID))
+	builder.WriteString(", ")
+	builder.WriteString("model_id=")
+	builder.WriteString(_m.ModelID)
+	builder.WriteString(", ")
+	builder.WriteString("end_user_id=")
+	builder.WriteString(_m.EndUserID)
+	builder.WriteString(", ")
+	builder.WriteString("request_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequestCount))
+	builder.WriteString(", ")
+	builder.WriteString("prompt_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PromptTokens))
+	builder.WriteString(", ")
+	builder.WriteString("prompt_cached_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PromptCachedTokens))
+	builder.WriteString(", ")
+	builder.WriteString("prompt_write_cached_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PromptWriteCachedTokens))
+	builder.WriteString(", ")
+	builder.WriteString("completion_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CompletionTokens))
+	builder.WriteString(", ")
+	builder.WriteString("completion_reasoning_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CompletionReasoningTokens))
+	builder.WriteString(", ")
+	builder.WriteString("total_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalTokens))
+	builder.WriteString(", ")
+	builder.WriteString("total_cost=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalCost))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UsageHourlyRollups is a parsable slice of UsageHourlyRollup.
+type UsageHourlyRollups []*UsageHourlyRollup
@@ -0,0 +1,1252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/usagehourlyrollup"
+)
+
+// UsageHourlyRollupCreate is the builder for creating a UsageHourlyRollup entity.
+type UsageHourlyRollupCreate struct {
+	config
+	mutation *UsageHourlyRollupMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetBucket sets the "bucket" field.
+func (_c *UsageHourlyRollupCreate) SetBucket(v time.Time) *UsageHourlyRollupCreate {
+	_c.mutation.SetBucket(v)
+	return _c
+}
+
+// SetProjectID sets the "project_id" field.
+func (_c *UsageHourlyRollupCreate) SetProjectID(v int) *UsageHourlyRollupCreate {
+	_c.mutation.SetProjectID(v)
+	return _c
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_c *UsageHourlyRollupCreate) SetAPIKeyID(v int) *UsageHourlyRollupCreate {
+	_c.mutation.SetAPIKeyID(v)
+	return _c
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (_c *UsageHourlyRollupCreate) SetNillableAPIKeyID(v *int) *UsageHourlyRollupCreate {
+	if v != nil {
+		_c.SetAPIKeyID(*v)
+	}
+	return _c
+}
+
+// SetChannelID sets the "channel_id" field.
+func (_c *UsageHourlyRollupCreate) SetChannelID(v int) *UsageHourlyRollupCreate {
+	_c.mutation.SetChannelID(v)
+	return _c
+}
+
+// SetNillableChannelID sets the "channel_id" field if the given value is not nil.
+func (_c *UsageHourlyRollupCreate) SetNillableChannelID(v *int) *UsageHourlyRollupCreate {
+	if v != nil {
+		_c.SetChannelID(*v)
+	}
+	return _c
+}
+
+// SetModelID sets the "model_id" field.
+func (_c *UsageHourlyRollupCreate) SetModelID(v string) *UsageHourlyRollupCreate {
+	_c.mutation.SetModelID(v)
+	return _c
+}
+
+// SetEndUserID sets the "end_user_id" field.
+func (_c *UsageHourlyRollupCreate) SetEndUserID(v string) *UsageHourlyRollupCreate {
+	_c.mutation.SetEndUserID(v)
+	return _c
+}
+
+// SetNillableEndUserID sets the "end_user_id" field if the given value is not nil.
+func (_c *UsageHourlyRollupCreate) SetNillableEndUserID(v *string) *UsageHourlyRollupCreate {
+	if v != nil {
+		_c.SetEndUserID(*v)
+	}
+	return _c
+}
+
+// SetRequestCount sets the "request_count" field.
+func (_c *UsageHourlyRollupCreate) SetRequestCount(v int64) *UsageHourlyRollupCreate {
+	_c.mutation.SetRequestCount(v)
+	return _c
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (_c *UsageHourlyRollupCreate) SetNillableRequestCount(v *int64) *UsageHourlyRollupCreate {
+	if v != nil {
+		_c.SetRequestCount(*v)
+	}
+	return _c
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_c *UsageHourlyRollupCreate) SetPromptTokens(v int64) *UsageHourlyRollupCreate {
+	_c.mutation.SetPromptTokens(v)
+	return _c
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_c *UsageHourlyRollupCreate) SetNillablePromptTokens(v *int64) *UsageHourlyRollupCreate {
+	if v != nil {
+		_c.SetPromptTokens(*v)
+	}
+	return _c
+}
+
+// SetPromptCachedTokens sets the "prompt_cached_tokens" field.
+func (_c *UsageHourlyRollupCreate) SetPromptCachedTokens(v int64) *UsageHourlyRollupCreate {
+	_c.mutation.SetPromptCachedTokens(v)
+	return _c
+}
+
+// SetNillablePromptCachedTokens sets the "prompt_cached_tokens" field if the given value is not nil.
+func (_c *UsageHourlyRollupCreate) SetNillablePromptCachedTokens(v *int64) *UsageHourlyRollupCreate {
+	if v != nil {
+		_c.SetPromptCachedTokens(*v)
+	}
+	return _c
+}
+
+// SetPromptWriteCachedTokens sets the "prompt_write_cached_tokens" field.
+func (_c *UsageHourlyRollupCreate) SetPromptWriteCachedTokens(v int64) *UsageHourlyRollupCreate {
+	_c.mutation.SetPromptWriteCachedTokens(v)
+	return _c
+}
+
+// SetNillablePromptWriteCachedTokens sets the "prompt_write_cached_tokens" field if the given value is not nil.
+func (_c *UsageHourlyRollupCreate) SetNillablePromptWriteCachedTokens(v *int64) *UsageHourlyRollupCreate {
+	if v != nil {
+		_c.SetPromptWriteCachedTokens(*v)
+	}
+	return _c
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_c *UsageHourlyRollupCreate) SetCompletionTokens(v int64) *UsageHourlyRollupCreate {
+	_c.mutation.SetCompletionTokens(v)
+	return _c
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_c *UsageHourlyRollupCreate) SetNillableCompletionTokens(v *int64) *UsageHourlyRollupCreate {
+	if v != nil {
+		_c.SetCompletionTokens(*v)
+	}
+	return _c
+}
+
+// SetCompletionReasoningTokens sets the "completion_reasoning_tokens" field.
+func (_c *UsageHourlyRollupCreate) SetCompletionReasoningTokens(v int64) *UsageHourlyRollupCreate {
+	_c.mutation.SetCompletionReasoningTokens(v)
+	return _c
+}
+
+// SetNillableCompletionReasoningTokens sets the "completion_reasoning_tokens" field if the given value is not nil.
+func (_c *UsageHourlyRollupCreate) SetNillableCompletionReasoningTokens(v *int64) *UsageHourlyRollupCreate {
+	if v != nil {
+		_c.SetCompletionReasoningTokens(*v)
+	}
+	return _c
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_c *UsageHourlyRollupCreate) SetTotalTokens(v int64) *UsageHourlyRollupCreate {
+	_c.mutation.SetTotalTokens(v)
+	return _c
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_c *UsageHourlyRollupCreate) SetNillableTotalTokens(v *int64) *UsageHourlyRollupCreate {
+	if v != nil {
+		_c.SetTotalTokens(*v)
+	}
+	return _c
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (_c *UsageHourlyRollupCreate) SetTotalCost(v float64) *UsageHourlyRollupCreate {
+	_c.mutation.SetTotalCost(v)
+	return _c
+}
+
+// SetNillableTotalCost sets the "total_cost" field if the given value is not nil.
+func (_c *UsageHourlyRollupCreate) SetNillableTotalCost(v *float64) *UsageHourlyRollupCreate {
+	if v != nil {
+		_c.SetTotalCost(*v)
+	}
+	return _c
+}
+
+// Mutation returns the UsageHourlyRollupMutation object of the builder.
+func (_c *UsageHourlyRollupCreate) Mutation() *UsageHourlyRollupMutation {
+	return _c.mutation
+}
+
+// Save creates the UsageHourlyRollup in the database.
+func (_c *UsageHourlyRollupCreate) Save(ctx context.Context) (*UsageHourlyRollup, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UsageHourlyRollupCreate) SaveX(ctx context.Context) *UsageHourlyRollup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UsageHourlyRollupCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UsageHourlyRollupCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UsageHourlyRollupCreate) defaults() error {
+	if _, ok := _c.mutation.APIKeyID(); !ok {
+		v := usagehourlyrollup.DefaultAPIKeyID
+		_c.mutation.SetAPIKeyID(v)
+	}
+	if _, ok := _c.mutation.ChannelID(); !ok {
+		v := usagehourlyrollup.DefaultChannelID
+		_c.mutation.SetChannelID(v)
+	}
+	if _, ok := _c.mutation.EndUserID(); !ok {
+		v := usagehourlyrollup.DefaultEndUserID
+		_c.mutation.SetEndUserID(v)
+	}
+	if _, ok := _c.mutation.RequestCount(); !ok {
+		v := usagehourlyrollup.DefaultRequestCount
+		_c.mutation.SetRequestCount(v)
+	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		v := usagehourlyrollup.DefaultPromptTokens
+		_c.mutation.SetPromptTokens(v)
+	}
+	if _, ok := _c.mutation.PromptCachedTokens(); !ok {
+		v := usagehourlyrollup.DefaultPromptCachedTokens
+		_c.mutation.SetPromptCachedTokens(v)
+	}
+	if _, ok := _c.mutation.PromptWriteCachedTokens(); !ok {
+		v := usagehourlyrollup.DefaultPromptWriteCachedTokens
+		_c.mutation.SetPromptWriteCachedTokens(v)
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		v := usagehourlyrollup.DefaultCompletionTokens
+		_c.mutation.SetCompletionTokens(v)
+	}
+	if _, ok := _c.mutation.CompletionReasoningTokens(); !ok {
+		v := usagehourlyrollup.DefaultCompletionReasoningTokens
+		_c.mutation.SetCompletionReasoningTokens(v)
+	}
+	if _, ok := _c.mutation.TotalTokens(); !ok {
+		v := usagehourlyrollup.DefaultTotalTokens
+		_c.mutation.SetTotalTokens(v)
+	}
+	if _, ok := _c.mutation.TotalCost(); !ok {
+		v := usagehourlyrollup.DefaultTotalCost
+		_c.mutation.SetTotalCost(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UsageHourlyRollupCreate) check() error {
+	if _, ok := _c.mutation.Bucket(); !ok {
+		return &ValidationError{Name: "bucket", err: errors.New(`ent: missing required field "UsageHourlyRollup.bucket"`)}
+	}
+	if _, ok := _c.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "UsageHourlyRollup.project_id"`)}
+	}
+	if _, ok := _c.mutation.APIKeyID(); !ok {
+		return &ValidationError{Name: "api_key_id", err: errors.New(`ent: missing required field "UsageHourlyRollup.api_key_id"`)}
+	}
+	if _, ok := _c.mutation.ChannelID(); !ok {
+		return &ValidationError{Name: "channel_id", err: errors.New(`ent: missing required field "UsageHourlyRollup.channel_id"`)}
+	}
+	if _, ok := _c.mutation.ModelID(); !ok {
+		return &ValidationError{Name: "model_id", err: errors.New(`ent: missing required field "UsageHourlyRollup.model_id"`)}
+	}
+	if _, ok := _c.mutation.EndUserID(); !ok {
+		return &ValidationError{Name: "end_user_id", err: errors.New(`ent: missing required field "UsageHourlyRollup.end_user_id"`)}
+	}
+	if v, ok := _c.mutation.EndUserID(); ok {
+		if err := usagehourlyrollup.EndUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "end_user_id", err: fmt.Errorf(`ent: validator failed for field "UsageHourlyRollup.end_user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequestCount(); !ok {
+		return &ValidationError{Name: "request_count", err: errors.New(`ent: missing required field "UsageHourlyRollup.request_count"`)}
+	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		return &ValidationError{Name: "prompt_tokens", err: errors.New(`ent: missing required field "UsageHourlyRollup.prompt_tokens"`)}
+	}
+	if _, ok := _c.mutation.PromptCachedTokens(); !ok {
+		return &ValidationError{Name: "prompt_cached_tokens", err: errors.New(`ent: missing required field "UsageHourlyRollup.prompt_cached_tokens"`)}
+	}
+	if _, ok := _c.mutation.PromptWriteCachedTokens(); !ok {
+		return &ValidationError{Name: "prompt_write_cached_tokens", err: errors.New(`ent: missing required field "UsageHourlyRollup.prompt_write_cached_tokens"`)}
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		return &ValidationError{Name: "completion_tokens", err: errors.New(`ent: missing required field "UsageHourlyRollup.completion_tokens"`)}
+	}
+	if _, ok := _c.mutation.CompletionReasoningTokens(); !ok {
+		return &ValidationError{Name: "completion_reasoning_tokens", err: errors.New(`ent: missing required field "UsageHourlyRollup.completion_reasoning_tokens"`)}
+	}
+	if _, ok := _c.mutation.TotalTokens(); !ok {
+		return &ValidationError{Name: "total_tokens", err: errors.New(`ent: missing required field "UsageHourlyRollup.total_tokens"`)}
+	}
+	if _, ok := _c.mutation.TotalCost(); !ok {
+		return &ValidationError{Name: "total_cost", err: errors.New(`ent: missing required field "UsageHourlyRollup.total_cost"`)}
+	}
+	return nil
+}
+
+func (_c *UsageHourlyRollupCreate) sqlSave(ctx context.Context) (*UsageHourlyRollup, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UsageHourlyRollupCreate) createSpec() (*UsageHourlyRollup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UsageHourlyRollup{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(usagehourlyrollup.Table, sqlgraph.NewFieldSpec(usagehourlyrollup.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Bucket(); ok {
+		_spec.SetField(usagehourlyrollup.FieldBucket, field.TypeTime, value)
+		_node.Bucket = value
+	}
+	if value, ok := _c.mutation.ProjectID(); ok {
+		_spec.SetField(usagehourlyrollup.FieldProjectID, field.TypeInt, value)
+		_node.ProjectID = value
+	}
+	if value, ok := _c.mutation.APIKeyID(); ok {
+		_spec.SetField(usagehourlyrollup.FieldAPIKeyID, field.TypeInt, value)
+		_node.APIKeyID = value
+	}
+	if value, ok := _c.mutation.ChannelID(); ok {
+		_spec.SetField(usagehourlyrollup.FieldChannelID, field.TypeInt, value)
+		_node.ChannelID = value
+	}
+	if value, ok := _c.mutation.ModelID(); ok {
+		_spec.SetField(usagehourlyrollup.FieldModelID, field.TypeString, value)
+		_node.ModelID = value
+	}
+	if value, ok := _c.mutation.EndUserID(); ok {
+		_spec.SetField(usagehourlyrollup.FieldEndUserID, field.TypeString, value)
+		_node.EndUserID = value
+	}
+	if value, ok := _c.mutation.RequestCount(); ok {
+		_spec.SetField(usagehourlyrollup.FieldRequestCount, field.TypeInt64, value)
+		_node.RequestCount = value
+	}
+	if value, ok := _c.mutation.PromptTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldPromptTokens, field.TypeInt64, value)
+		_node.PromptTokens = value
+	}
+	if value, ok := _c.mutation.PromptCachedTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldPromptCachedTokens, field.TypeInt64, value)
+		_node.PromptCachedTokens = value
+	}
+	if value, ok := _c.mutation.PromptWriteCachedTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldPromptWriteCachedTokens, field.TypeInt64, value)
+		_node.PromptWriteCachedTokens = value
+	}
+	if value, ok := _c.mutation.CompletionTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldCompletionTokens, field.TypeInt64, value)
+		_node.CompletionTokens = value
+	}
+	if value, ok := _c.mutation.CompletionReasoningTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldCompletionReasoningTokens, field.TypeInt64, value)
+		_node.CompletionReasoningTokens = value
+	}
+	if value, ok := _c.mutation.TotalTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldTotalTokens, field.TypeInt64, value)
+		_node.TotalTokens = value
+	}
+	if value, ok := _c.mutation.TotalCost(); ok {
+		_spec.SetField(usagehourlyrollup.FieldTotalCost, field.TypeFloat64, value)
+		_node.TotalCost = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UsageHourlyRollup.Create().
+//		SetBucket(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UsageHourlyRollupUpsert) {
+//			SetBucket(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UsageHourlyRollupCreate) OnConflict(opts ...sql.ConflictOption) *UsageHourlyRollupUpsertOne {
+	_c.conflict = opts
+	return &UsageHourlyRollupUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UsageHourlyRollup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UsageHourlyRollupCreate) OnConflictColumns(columns ...string) *UsageHourlyRollupUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UsageHourlyRollupUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UsageHourlyRollupUpsertOne is the builder for "upsert"-ing
+	//  one UsageHourlyRollup node.
+	UsageHourlyRollupUpsertOne struct {
+		create *UsageHourlyRollupCreate
+	}
+
+	// UsageHourlyRollupUpsert is the "OnConflict" setter.
+	UsageHourlyRollupUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRequestCount sets the "request_count" field.
+func (u *UsageHourlyRollupUpsert) SetRequestCount(v int64) *UsageHourlyRollupUpsert {
+	u.Set(usagehourlyrollup.FieldRequestCount, v)
+	return u
+}
+
+// UpdateRequestCount sets the "request_count" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsert) UpdateRequestCount() *UsageHourlyRollupUpsert {
+	u.SetExcluded(usagehourlyrollup.FieldRequestCount)
+	return u
+}
+
+// AddRequestCount adds v to the "request_count" field.
+func (u *UsageHourlyRollupUpsert) AddRequestCount(v int64) *UsageHourlyRollupUpsert {
+	u.Add(usagehourlyrollup.FieldRequestCount, v)
+	return u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *UsageHourlyRollupUpsert) SetPromptTokens(v int64) *UsageHourlyRollupUpsert {
+	u.Set(usagehourlyrollup.FieldPromptTokens, v)
+	return u
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsert) UpdatePromptTokens() *UsageHourlyRollupUpsert {
+	u.SetExcluded(usagehourlyrollup.FieldPromptTokens)
+	return u
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *UsageHourlyRollupUpsert) AddPromptTokens(v int64) *UsageHourlyRollupUpsert {
+	u.Add(usagehourlyrollup.FieldPromptTokens, v)
+	return u
+}
+
+// SetPromptCachedTokens sets the "prompt_cached_tokens" field.
+func (u *UsageHourlyRollupUpsert) SetPromptCachedTokens(v int64) *UsageHourlyRollupUpsert {
+	u.Set(usagehourlyrollup.FieldPromptCachedTokens, v)
+	return u
+}
+
+// UpdatePromptCachedTokens sets the "prompt_cached_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsert) UpdatePromptCachedTokens() *UsageHourlyRollupUpsert {
+	u.SetExcluded(usagehourlyrollup.FieldPromptCachedTokens)
+	return u
+}
+
+// AddPromptCachedTokens adds v to the "prompt_cached_tokens" field.
+func (u *UsageHourlyRollupUpsert) AddPromptCachedTokens(v int64) *UsageHourlyRollupUpsert {
+	u.Add(usagehourlyrollup.FieldPromptCachedTokens, v)
+	return u
+}
+
+// SetPromptWriteCachedTokens sets the "prompt_write_cached_tokens" field.
+func (u *UsageHourlyRollupUpsert) SetPromptWriteCachedTokens(v int64) *UsageHourlyRollupUpsert {
+	u.Set(usagehourlyrollup.FieldPromptWriteCachedTokens, v)
+	return u
+}
+
+// UpdatePromptWriteCachedTokens sets the "prompt_write_cached_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsert) UpdatePromptWriteCachedTokens() *UsageHourlyRollupUpsert {
+	u.SetExcluded(usagehourlyrollup.FieldPromptWriteCachedTokens)
+	return u
+}
+
+// AddPromptWriteCachedTokens adds v to the "prompt_write_cached_tokens" field.
+func (u *UsageHourlyRollupUpsert) AddPromptWriteCachedTokens(v int64) *UsageHourlyRollupUpsert {
+	u.Add(usagehourlyrollup.FieldPromptWriteCachedTokens, v)
+	return u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *UsageHourlyRollupUpsert) SetCompletionTokens(v int64) *UsageHourlyRollupUpsert {
+	u.Set(usagehourlyrollup.FieldCompletionTokens, v)
+	return u
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsert) UpdateCompletionTokens() *UsageHourlyRollupUpsert {
+	u.SetExcluded(usagehourlyrollup.FieldCompletionTokens)
+	return u
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *UsageHourlyRollupUpsert) AddCompletionTokens(v int64) *UsageHourlyRollupUpsert {
+	u.Add(usagehourlyrollup.FieldCompletionTokens, v)
+	return u
+}
+
+// SetCompletionReasoningTokens sets the "completion_reasoning_tokens" field.
+func (u *UsageHourlyRollupUpsert) SetCompletionReasoningTokens(v int64) *UsageHourlyRollupUpsert {
+	u.Set(usagehourlyrollup.FieldCompletionReasoningTokens, v)
+	return u
+}
+
+// UpdateCompletionReasoningTokens sets the "completion_reasoning_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsert) UpdateCompletionReasoningTokens() *UsageHourlyRollupUpsert {
+	u.SetExcluded(usagehourlyrollup.FieldCompletionReasoningTokens)
+	return u
+}
+
+// AddCompletionReasoningTokens adds v to the "completion_reasoning_tokens" field.
+func (u *UsageHourlyRollupUpsert) AddCompletionReasoningTokens(v int64) *UsageHourlyRollupUpsert {
+	u.Add(usagehourlyrollup.FieldCompletionReasoningTokens, v)
+	return u
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (u *UsageHourlyRollupUpsert) SetTotalTokens(v int64) *UsageHourlyRollupUpsert {
+	u.Set(usagehourlyrollup.FieldTotalTokens, v)
+	return u
+}
+
+// UpdateTotalTokens sets the "total_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsert) UpdateTotalTokens() *UsageHourlyRollupUpsert {
+	u.SetExcluded(usagehourlyrollup.FieldTotalTokens)
+	return u
+}
+
+// AddTotalTokens adds v to the "total_tokens" field.
+func (u *UsageHourlyRollupUpsert) AddTotalTokens(v int64) *UsageHourlyRollupUpsert {
+	u.Add(usagehourlyrollup.FieldTotalTokens, v)
+	return u
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (u *UsageHourlyRollupUpsert) SetTotalCost(v float64) *UsageHourlyRollupUpsert {
+	u.Set(usagehourlyrollup.FieldTotalCost, v)
+	return u
+}
+
+// UpdateTotalCost sets the "total_cost" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsert) UpdateTotalCost() *UsageHourlyRollupUpsert {
+	u.SetExcluded(usagehourlyrollup.FieldTotalCost)
+	return u
+}
+
+// AddTotalCost adds v to the "total_cost" field.
+func (u *UsageHourlyRollupUpsert) AddTotalCost(v float64) *UsageHourlyRollupUpsert {
+	u.Add(usagehourlyrollup.FieldTotalCost, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.UsageHourlyRollup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UsageHourlyRollupUpsertOne) UpdateNewValues() *UsageHourlyRollupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.Bucket(); exists {
+			s.SetIgnore(usagehourlyrollup.FieldBucket)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(usagehourlyrollup.FieldProjectID)
+		}
+		if _, exists := u.create.mutation.APIKeyID(); exists {
+			s.SetIgnore(usagehourlyrollup.FieldAPIKeyID)
+		}
+		if _, exists := u.create.mutation.ChannelID(); exists {
+			s.SetIgnore(usagehourlyrollup.FieldChannelID)
+		}
+		if _, exists := u.create.mutation.ModelID(); exists {
+			s.SetIgnore(usagehourlyrollup.FieldModelID)
+		}
+		if _, exists := u.create.mutation.EndUserID(); exists {
+			s.SetIgnore(usagehourlyrollup.FieldEndUserID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UsageHourlyRollup.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UsageHourlyRollupUpsertOne) Ignore() *UsageHourlyRollupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UsageHourlyRollupUpsertOne) DoNothing() *UsageHourlyRollupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UsageHourlyRollupCreate.OnConflict
+// documentation for more info.
+func (u *UsageHourlyRollupUpsertOne) Update(set func(*UsageHourlyRollupUpsert)) *UsageHourlyRollupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UsageHourlyRollupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRequestCount sets the "request_count" field.
+func (u *UsageHourlyRollupUpsertOne) SetRequestCount(v int64) *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.SetRequestCount(v)
+	})
+}
+
+// AddRequestCount adds v to the "request_count" field.
+func (u *UsageHourlyRollupUpsertOne) AddRequestCount(v int64) *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.AddRequestCount(v)
+	})
+}
+
+// UpdateRequestCount sets the "request_count" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsertOne) UpdateRequestCount() *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.UpdateRequestCount()
+	})
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *UsageHourlyRollupUpsertOne) SetPromptTokens(v int64) *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.SetPromptTokens(v)
+	})
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *UsageHourlyRollupUpsertOne) AddPromptTokens(v int64) *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.AddPromptTokens(v)
+	})
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsertOne) UpdatePromptTokens() *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.UpdatePromptTokens()
+	})
+}
+
+// SetPromptCachedTokens sets the "prompt_cached_tokens" field.
+func (u *UsageHourlyRollupUpsertOne) SetPromptCachedTokens(v int64) *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.SetPromptCachedTokens(v)
+	})
+}
+
+// AddPromptCachedTokens adds v to the "prompt_cached_tokens" field.
+func (u *UsageHourlyRollupUpsertOne) AddPromptCachedTokens(v int64) *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.AddPromptCachedTokens(v)
+	})
+}
+
+// UpdatePromptCachedTokens sets the "prompt_cached_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsertOne) UpdatePromptCachedTokens() *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.UpdatePromptCachedTokens()
+	})
+}
+
+// SetPromptWriteCachedTokens sets the "prompt_write_cached_tokens" field.
+func (u *UsageHourlyRollupUpsertOne) SetPromptWriteCachedTokens(v int64) *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.SetPromptWriteCachedTokens(v)
+	})
+}
+
+// AddPromptWriteCachedTokens adds v to the "prompt_write_cached_tokens" field.
+func (u *UsageHourlyRollupUpsertOne) AddPromptWriteCachedTokens(v int64) *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.AddPromptWriteCachedTokens(v)
+	})
+}
+
+// UpdatePromptWriteCachedTokens sets the "prompt_write_cached_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsertOne) UpdatePromptWriteCachedTokens() *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.UpdatePromptWriteCachedTokens()
+	})
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *UsageHourlyRollupUpsertOne) SetCompletionTokens(v int64) *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.SetCompletionTokens(v)
+	})
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *UsageHourlyRollupUpsertOne) AddCompletionTokens(v int64) *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.AddCompletionTokens(v)
+	})
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsertOne) UpdateCompletionTokens() *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.UpdateCompletionTokens()
+	})
+}
+
+// SetCompletionReasoningTokens sets the "completion_reasoning_tokens" field.
+func (u *UsageHourlyRollupUpsertOne) SetCompletionReasoningTokens(v int64) *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.SetCompletionReasoningTokens(v)
+	})
+}
+
+// AddCompletionReasoningTokens adds v to the "completion_reasoning_tokens" field.
+func (u *UsageHourlyRollupUpsertOne) AddCompletionReasoningTokens(v int64) *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.AddCompletionReasoningTokens(v)
+	})
+}
+
+// UpdateCompletionReasoningTokens sets the "completion_reasoning_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsertOne) UpdateCompletionReasoningTokens() *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.UpdateCompletionReasoningTokens()
+	})
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (u *UsageHourlyRollupUpsertOne) SetTotalTokens(v int64) *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.SetTotalTokens(v)
+	})
+}
+
+// AddTotalTokens adds v to the "total_tokens" field.
+func (u *UsageHourlyRollupUpsertOne) AddTotalTokens(v int64) *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.AddTotalTokens(v)
+	})
+}
+
+// UpdateTotalTokens sets the "total_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsertOne) UpdateTotalTokens() *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.UpdateTotalTokens()
+	})
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (u *UsageHourlyRollupUpsertOne) SetTotalCost(v float64) *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.SetTotalCost(v)
+	})
+}
+
+// AddTotalCost adds v to the "total_cost" field.
+func (u *UsageHourlyRollupUpsertOne) AddTotalCost(v float64) *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.AddTotalCost(v)
+	})
+}
+
+// UpdateTotalCost sets the "total_cost" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsertOne) UpdateTotalCost() *UsageHourlyRollupUpsertOne {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.UpdateTotalCost()
+	})
+}
+
+// Exec executes the query.
+func (u *UsageHourlyRollupUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UsageHourlyRollupCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UsageHourlyRollupUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UsageHourlyRollupUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UsageHourlyRollupUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UsageHourlyRollupCreateBulk is the builder for creating many UsageHourlyRollup entities in bulk.
+type UsageHourlyRollupCreateBulk struct {
+	config
+	err      error
+	builders []*UsageHourlyRollupCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UsageHourlyRollup entities in the database.
+func (_c *UsageHourlyRollupCreateBulk) Save(ctx context.Context) ([]*UsageHourlyRollup, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UsageHourlyRollup, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsageHourlyRollupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UsageHourlyRollupCreateBulk) SaveX(ctx context.Context) []*UsageHourlyRollup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UsageHourlyRollupCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UsageHourlyRollupCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UsageHourlyRollup.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UsageHourlyRollupUpsert) {
+//			SetBucket(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UsageHourlyRollupCreateBulk) OnConflict(opts ...sql.ConflictOption) *UsageHourlyRollupUpsertBulk {
+	_c.conflict = opts
+	return &UsageHourlyRollupUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UsageHourlyRollup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UsageHourlyRollupCreateBulk) OnConflictColumns(columns ...string) *UsageHourlyRollupUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UsageHourlyRollupUpsertBulk{
+		create: _c,
+	}
+}
+
+// UsageHourlyRollupUpsertBulk is the builder for "upsert"-ing
+// a bulk of UsageHourlyRollup nodes.
+type UsageHourlyRollupUpsertBulk struct {
+	create *UsageHourlyRollupCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UsageHourlyRollup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UsageHourlyRollupUpsertBulk) UpdateNewValues() *UsageHourlyRollupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.Bucket(); exists {
+				s.SetIgnore(usagehourlyrollup.FieldBucket)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(usagehourlyrollup.FieldProjectID)
+			}
+			if _, exists := b.mutation.APIKeyID(); exists {
+				s.SetIgnore(usagehourlyrollup.FieldAPIKeyID)
+			}
+			if _, exists := b.mutation.ChannelID(); exists {
+				s.SetIgnore(usagehourlyrollup.FieldChannelID)
+			}
+			if _, exists := b.mutation.ModelID(); exists {
+				s.SetIgnore(usagehourlyrollup.FieldModelID)
+			}
+			if _, exists := b.mutation.EndUserID(); exists {
+				s.SetIgnore(usagehourlyrollup.FieldEndUserID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UsageHourlyRollup.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UsageHourlyRollupUpsertBulk) Ignore() *UsageHourlyRollupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UsageHourlyRollupUpsertBulk) DoNothing() *UsageHourlyRollupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UsageHourlyRollupCreateBulk.OnConflict
+// documentation for more info.
+func (u *UsageHourlyRollupUpsertBulk) Update(set func(*UsageHourlyRollupUpsert)) *UsageHourlyRollupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UsageHourlyRollupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRequestCount sets the "request_count" field.
+func (u *UsageHourlyRollupUpsertBulk) SetRequestCount(v int64) *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.SetRequestCount(v)
+	})
+}
+
+// AddRequestCount adds v to the "request_count" field.
+func (u *UsageHourlyRollupUpsertBulk) AddRequestCount(v int64) *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.AddRequestCount(v)
+	})
+}
+
+// UpdateRequestCount sets the "request_count" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsertBulk) UpdateRequestCount() *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.UpdateRequestCount()
+	})
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (u *UsageHourlyRollupUpsertBulk) SetPromptTokens(v int64) *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.SetPromptTokens(v)
+	})
+}
+
+// AddPromptTokens adds v to the "prompt_tokens" field.
+func (u *UsageHourlyRollupUpsertBulk) AddPromptTokens(v int64) *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.AddPromptTokens(v)
+	})
+}
+
+// UpdatePromptTokens sets the "prompt_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsertBulk) UpdatePromptTokens() *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.UpdatePromptTokens()
+	})
+}
+
+// SetPromptCachedTokens sets the "prompt_cached_tokens" field.
+func (u *UsageHourlyRollupUpsertBulk) SetPromptCachedTokens(v int64) *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.SetPromptCachedTokens(v)
+	})
+}
+
+// AddPromptCachedTokens adds v to the "prompt_cached_tokens" field.
+func (u *UsageHourlyRollupUpsertBulk) AddPromptCachedTokens(v int64) *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.AddPromptCachedTokens(v)
+	})
+}
+
+// UpdatePromptCachedTokens sets the "prompt_cached_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsertBulk) UpdatePromptCachedTokens() *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.UpdatePromptCachedTokens()
+	})
+}
+
+// SetPromptWriteCachedTokens sets the "prompt_write_cached_tokens" field.
+func (u *UsageHourlyRollupUpsertBulk) SetPromptWriteCachedTokens(v int64) *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.SetPromptWriteCachedTokens(v)
+	})
+}
+
+// AddPromptWriteCachedTokens adds v to the "prompt_write_cached_tokens" field.
+func (u *UsageHourlyRollupUpsertBulk) AddPromptWriteCachedTokens(v int64) *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.AddPromptWriteCachedTokens(v)
+	})
+}
+
+// UpdatePromptWriteCachedTokens sets the "prompt_write_cached_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsertBulk) UpdatePromptWriteCachedTokens() *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.UpdatePromptWriteCachedTokens()
+	})
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (u *UsageHourlyRollupUpsertBulk) SetCompletionTokens(v int64) *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.SetCompletionTokens(v)
+	})
+}
+
+// AddCompletionTokens adds v to the "completion_tokens" field.
+func (u *UsageHourlyRollupUpsertBulk) AddCompletionTokens(v int64) *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.AddCompletionTokens(v)
+	})
+}
+
+// UpdateCompletionTokens sets the "completion_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsertBulk) UpdateCompletionTokens() *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.UpdateCompletionTokens()
+	})
+}
+
+// SetCompletionReasoningTokens sets the "completion_reasoning_tokens" field.
+func (u *UsageHourlyRollupUpsertBulk) SetCompletionReasoningTokens(v int64) *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.SetCompletionReasoningTokens(v)
+	})
+}
+
+// AddCompletionReasoningTokens adds v to the "completion_reasoning_tokens" field.
+func (u *UsageHourlyRollupUpsertBulk) AddCompletionReasoningTokens(v int64) *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.AddCompletionReasoningTokens(v)
+	})
+}
+
+// UpdateCompletionReasoningTokens sets the "completion_reasoning_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsertBulk) UpdateCompletionReasoningTokens() *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.UpdateCompletionReasoningTokens()
+	})
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (u *UsageHourlyRollupUpsertBulk) SetTotalTokens(v int64) *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.SetTotalTokens(v)
+	})
+}
+
+// AddTotalTokens adds v to the "total_tokens" field.
+func (u *UsageHourlyRollupUpsertBulk) AddTotalTokens(v int64) *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.AddTotalTokens(v)
+	})
+}
+
+// UpdateTotalTokens sets the "total_tokens" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsertBulk) UpdateTotalTokens() *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.UpdateTotalTokens()
+	})
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (u *UsageHourlyRollupUpsertBulk) SetTotalCost(v float64) *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.SetTotalCost(v)
+	})
+}
+
+// AddTotalCost adds v to the "total_cost" field.
+func (u *UsageHourlyRollupUpsertBulk) AddTotalCost(v float64) *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.AddTotalCost(v)
+	})
+}
+
+// UpdateTotalCost sets the "total_cost" field to the value that was provided on create.
+func (u *UsageHourlyRollupUpsertBulk) UpdateTotalCost() *UsageHourlyRollupUpsertBulk {
+	return u.Update(func(s *UsageHourlyRollupUpsert) {
+		s.UpdateTotalCost()
+	})
+}
+
+// Exec executes the query.
+func (u *UsageHourlyRollupUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UsageHourlyRollupCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UsageHourlyRollupCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UsageHourlyRollupUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,623 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/ent/usagehourlyrollup"
+)
+
+// UsageHourlyRollupUpdate is the builder for updating UsageHourlyRollup entities.
+type UsageHourlyRollupUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *UsageHourlyRollupMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the UsageHourlyRollupUpdate builder.
+func (_u *UsageHourlyRollupUpdate) Where(ps ...predicate.UsageHourlyRollup) *UsageHourlyRollupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRequestCount sets the "request_count" field.
+func (_u *UsageHourlyRollupUpdate) SetRequestCount(v int64) *UsageHourlyRollupUpdate {
+	_u.mutation.ResetRequestCount()
+	_u.mutation.SetRequestCount(v)
+	return _u
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (_u *UsageHourlyRollupUpdate) SetNillableRequestCount(v *int64) *UsageHourlyRollupUpdate {
+	if v != nil {
+		_u.SetRequestCount(*v)
+	}
+	return _u
+}
+
+// AddRequestCount adds value to the "request_count" field.
+func (_u *UsageHourlyRollupUpdate) AddRequestCount(v int64) *UsageHourlyRollupUpdate {
+	_u.mutation.AddRequestCount(v)
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *UsageHourlyRollupUpdate) SetPromptTokens(v int64) *UsageHourlyRollupUpdate {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *UsageHourlyRollupUpdate) SetNillablePromptTokens(v *int64) *UsageHourlyRollupUpdate {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *UsageHourlyRollupUpdate) AddPromptTokens(v int64) *UsageHourlyRollupUpdate {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetPromptCachedTokens sets the "prompt_cached_tokens" field.
+func (_u *UsageHourlyRollupUpdate) SetPromptCachedTokens(v int64) *UsageHourlyRollupUpdate {
+	_u.mutation.ResetPromptCachedTokens()
+	_u.mutation.SetPromptCachedTokens(v)
+	return _u
+}
+
+// SetNillablePromptCachedTokens sets the "prompt_cached_tokens" field if the given value is not nil.
+func (_u *UsageHourlyRollupUpdate) SetNillablePromptCachedTokens(v *int64) *UsageHourlyRollupUpdate {
+	if v != nil {
+		_u.SetPromptCachedTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptCachedTokens adds value to the "prompt_cached_tokens" field.
+func (_u *UsageHourlyRollupUpdate) AddPromptCachedTokens(v int64) *UsageHourlyRollupUpdate {
+	_u.mutation.AddPromptCachedTokens(v)
+	return _u
+}
+
+// SetPromptWriteCachedTokens sets the "prompt_write_cached_tokens" field.
+func (_u *UsageHourlyRollupUpdate) SetPromptWriteCachedTokens(v int64) *UsageHourlyRollupUpdate {
+	_u.mutation.ResetPromptWriteCachedTokens()
+	_u.mutation.SetPromptWriteCachedTokens(v)
+	return _u
+}
+
+// SetNillablePromptWriteCachedTokens sets the "prompt_write_cached_tokens" field if the given value is not nil.
+func (_u *UsageHourlyRollupUpdate) SetNillablePromptWriteCachedTokens(v *int64) *UsageHourlyRollupUpdate {
+	if v != nil {
+		_u.SetPromptWriteCachedTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptWriteCachedTokens adds value to the "prompt_write_cached_tokens" field.
+func (_u *UsageHourlyRollupUpdate) AddPromptWriteCachedTokens(v int64) *UsageHourlyRollupUpdate {
+	_u.mutation.AddPromptWriteCachedTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *UsageHourlyRollupUpdate) SetCompletionTokens(v int64) *UsageHourlyRollupUpdate {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *UsageHourlyRollupUpdate) SetNillableCompletionTokens(v *int64) *UsageHourlyRollupUpdate {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *UsageHourlyRollupUpdate) AddCompletionTokens(v int64) *UsageHourlyRollupUpdate {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetCompletionReasoningTokens sets the "completion_reasoning_tokens" field.
+func (_u *UsageHourlyRollupUpdate) SetCompletionReasoningTokens(v int64) *UsageHourlyRollupUpdate {
+	_u.mutation.ResetCompletionReasoningTokens()
+	_u.mutation.SetCompletionReasoningTokens(v)
+	return _u
+}
+
+// SetNillableCompletionReasoningTokens sets the "completion_reasoning_tokens" field if the given value is not nil.
+func (_u *UsageHourlyRollupUpdate) SetNillableCompletionReasoningTokens(v *int64) *UsageHourlyRollupUpdate {
+	if v != nil {
+		_u.SetCompletionReasoningTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionReasoningTokens adds value to the "completion_reasoning_tokens" field.
+func (_u *UsageHourlyRollupUpdate) AddCompletionReasoningTokens(v int64) *UsageHourlyRollupUpdate {
+	_u.mutation.AddCompletionReasoningTokens(v)
+	return _u
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_u *UsageHourlyRollupUpdate) SetTotalTokens(v int64) *UsageHourlyRollupUpdate {
+	_u.mutation.ResetTotalTokens()
+	_u.mutation.SetTotalTokens(v)
+	return _u
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_u *UsageHourlyRollupUpdate) SetNillableTotalTokens(v *int64) *UsageHourlyRollupUpdate {
+	if v != nil {
+		_u.SetTotalTokens(*v)
+	}
+	return _u
+}
+
+// AddTotalTokens adds value to the "total_tokens" field.
+func (_u *UsageHourlyRollupUpdate) AddTotalTokens(v int64) *UsageHourlyRollupUpdate {
+	_u.mutation.AddTotalTokens(v)
+	return _u
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (_u *UsageHourlyRollupUpdate) SetTotalCost(v float64) *UsageHourlyRollupUpdate {
+	_u.mutation.ResetTotalCost()
+	_u.mutation.SetTotalCost(v)
+	return _u
+}
+
+// SetNillableTotalCost sets the "total_cost" field if the given value is not nil.
+func (_u *UsageHourlyRollupUpdate) SetNillableTotalCost(v *float64) *UsageHourlyRollupUpdate {
+	if v != nil {
+		_u.SetTotalCost(*v)
+	}
+	return _u
+}
+
+// AddTotalCost adds value to the "total_cost" field.
+func (_u *UsageHourlyRollupUpdate) AddTotalCost(v float64) *UsageHourlyRollupUpdate {
+	_u.mutation.AddTotalCost(v)
+	return _u
+}
+
+// Mutation returns the UsageHourlyRollupMutation object of the builder.
+func (_u *UsageHourlyRollupUpdate) Mutation() *UsageHourlyRollupMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UsageHourlyRollupUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageHourlyRollupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UsageHourlyRollupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageHourlyRollupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UsageHourlyRollupUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UsageHourlyRollupUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *UsageHourlyRollupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(usagehourlyrollup.Table, usagehourlyrollup.Columns, sqlgraph.NewFieldSpec(usagehourlyrollup.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RequestCount(); ok {
+		_spec.SetField(usagehourlyrollup.FieldRequestCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRequestCount(); ok {
+		_spec.AddField(usagehourlyrollup.FieldRequestCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldPromptTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(usagehourlyrollup.FieldPromptTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PromptCachedTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldPromptCachedTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPromptCachedTokens(); ok {
+		_spec.AddField(usagehourlyrollup.FieldPromptCachedTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PromptWriteCachedTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldPromptWriteCachedTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPromptWriteCachedTokens(); ok {
+		_spec.AddField(usagehourlyrollup.FieldPromptWriteCachedTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldCompletionTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(usagehourlyrollup.FieldCompletionTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CompletionReasoningTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldCompletionReasoningTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionReasoningTokens(); ok {
+		_spec.AddField(usagehourlyrollup.FieldCompletionReasoningTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TotalTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldTotalTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalTokens(); ok {
+		_spec.AddField(usagehourlyrollup.FieldTotalTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TotalCost(); ok {
+		_spec.SetField(usagehourlyrollup.FieldTotalCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalCost(); ok {
+		_spec.AddField(usagehourlyrollup.FieldTotalCost, field.TypeFloat64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagehourlyrollup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UsageHourlyRollupUpdateOne is the builder for updating a single UsageHourlyRollup entity.
+type UsageHourlyRollupUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UsageHourlyRollupMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetRequestCount sets the "request_count" field.
+func (_u *UsageHourlyRollupUpdateOne) SetRequestCount(v int64) *UsageHourlyRollupUpdateOne {
+	_u.mutation.ResetRequestCount()
+	_u.mutation.SetRequestCount(v)
+	return _u
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (_u *UsageHourlyRollupUpdateOne) SetNillableRequestCount(v *int64) *UsageHourlyRollupUpdateOne {
+	if v != nil {
+		_u.SetRequestCount(*v)
+	}
+	return _u
+}
+
+// AddRequestCount adds value to the "request_count" field.
+func (_u *UsageHourlyRollupUpdateOne) AddRequestCount(v int64) *UsageHourlyRollupUpdateOne {
+	_u.mutation.AddRequestCount(v)
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *UsageHourlyRollupUpdateOne) SetPromptTokens(v int64) *UsageHourlyRollupUpdateOne {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *UsageHourlyRollupUpdateOne) SetNillablePromptTokens(v *int64) *UsageHourlyRollupUpdateOne {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *UsageHourlyRollupUpdateOne) AddPromptTokens(v int64) *UsageHourlyRollupUpdateOne {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetPromptCachedTokens sets the "prompt_cached_tokens" field.
+func (_u *UsageHourlyRollupUpdateOne) SetPromptCachedTokens(v int64) *UsageHourlyRollupUpdateOne {
+	_u.mutation.ResetPromptCachedTokens()
+	_u.mutation.SetPromptCachedTokens(v)
+	return _u
+}
+
+// SetNillablePromptCachedTokens sets the "prompt_cached_tokens" field if the given value is not nil.
+func (_u *UsageHourlyRollupUpdateOne) SetNillablePromptCachedTokens(v *int64) *UsageHourlyRollupUpdateOne {
+	if v != nil {
+		_u.SetPromptCachedTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptCachedTokens adds value to the "prompt_cached_tokens" field.
+func (_u *UsageHourlyRollupUpdateOne) AddPromptCachedTokens(v int64) *UsageHourlyRollupUpdateOne {
+	_u.mutation.AddPromptCachedTokens(v)
+	return _u
+}
+
+// SetPromptWriteCachedTokens sets the "prompt_write_cached_tokens" field.
+func (_u *UsageHourlyRollupUpdateOne) SetPromptWriteCachedTokens(v int64) *UsageHourlyRollupUpdateOne {
+	_u.mutation.ResetPromptWriteCachedTokens()
+	_u.mutation.SetPromptWriteCachedTokens(v)
+	return _u
+}
+
+// SetNillablePromptWriteCachedTokens sets the "prompt_write_cached_tokens" field if the given value is not nil.
+func (_u *UsageHourlyRollupUpdateOne) SetNillablePromptWriteCachedTokens(v *int64) *UsageHourlyRollupUpdateOne {
+	if v != nil {
+		_u.SetPromptWriteCachedTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptWriteCachedTokens adds value to the "prompt_write_cached_tokens" field.
+func (_u *UsageHourlyRollupUpdateOne) AddPromptWriteCachedTokens(v int64) *UsageHourlyRollupUpdateOne {
+	_u.mutation.AddPromptWriteCachedTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *UsageHourlyRollupUpdateOne) SetCompletionTokens(v int64) *UsageHourlyRollupUpdateOne {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *UsageHourlyRollupUpdateOne) SetNillableCompletionTokens(v *int64) *UsageHourlyRollupUpdateOne {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *UsageHourlyRollupUpdateOne) AddCompletionTokens(v int64) *UsageHourlyRollupUpdateOne {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetCompletionReasoningTokens sets the "completion_reasoning_tokens" field.
+func (_u *UsageHourlyRollupUpdateOne) SetCompletionReasoningTokens(v int64) *UsageHourlyRollupUpdateOne {
+	_u.mutation.ResetCompletionReasoningTokens()
+	_u.mutation.SetCompletionReasoningTokens(v)
+	return _u
+}
+
+// SetNillableCompletionReasoningTokens sets the "completion_reasoning_tokens" field if the given value is not nil.
+func (_u *UsageHourlyRollupUpdateOne) SetNillableCompletionReasoningTokens(v *int64) *UsageHourlyRollupUpdateOne {
+	if v != nil {
+		_u.SetCompletionReasoningTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionReasoningTokens adds value to the "completion_reasoning_tokens" field.
+func (_u *UsageHourlyRollupUpdateOne) AddCompletionReasoningTokens(v int64) *UsageHourlyRollupUpdateOne {
+	_u.mutation.AddCompletionReasoningTokens(v)
+	return _u
+}
+
+// SetTotalTokens sets the "total_tokens" field.
+func (_u *UsageHourlyRollupUpdateOne) SetTotalTokens(v int64) *UsageHourlyRollupUpdateOne {
+	_u.mutation.ResetTotalTokens()
+	_u.mutation.SetTotalTokens(v)
+	return _u
+}
+
+// SetNillableTotalTokens sets the "total_tokens" field if the given value is not nil.
+func (_u *UsageHourlyRollupUpdateOne) SetNillableTotalTokens(v *int64) *UsageHourlyRollupUpdateOne {
+	if v != nil {
+		_u.SetTotalTokens(*v)
+	}
+	return _u
+}
+
+// AddTotalTokens adds value to the "total_tokens" field.
+func (_u *UsageHourlyRollupUpdateOne) AddTotalTokens(v int64) *UsageHourlyRollupUpdateOne {
+	_u.mutation.AddTotalTokens(v)
+	return _u
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (_u *UsageHourlyRollupUpdateOne) SetTotalCost(v float64) *UsageHourlyRollupUpdateOne {
+	_u.mutation.ResetTotalCost()
+	_u.mutation.SetTotalCost(v)
+	return _u
+}
+
+// SetNillableTotalCost sets the "total_cost" field if the given value is not nil.
+func (_u *UsageHourlyRollupUpdateOne) SetNillableTotalCost(v *float64) *UsageHourlyRollupUpdateOne {
+	if v != nil {
+		_u.SetTotalCost(*v)
+	}
+	return _u
+}
+
+// AddTotalCost adds value to the "total_cost" field.
+func (_u *UsageHourlyRollupUpdateOne) AddTotalCost(v float64) *UsageHourlyRollupUpdateOne {
+	_u.mutation.AddTotalCost(v)
+	return _u
+}
+
+// Mutation returns the UsageHourlyRollupMutation object of the builder.
+func (_u *UsageHourlyRollupUpdateOne) Mutation() *UsageHourlyRollupMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UsageHourlyRollupUpdate builder.
+func (_u *UsageHourlyRollupUpdateOne) Where(ps ...predicate.UsageHourlyRollup) *UsageHourlyRollupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UsageHourlyRollupUpdateOne) Select(field string, fields ...string) *UsageHourlyRollupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UsageHourlyRollup entity.
+func (_u *UsageHourlyRollupUpdateOne) Save(ctx context.Context) (*UsageHourlyRollup, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageHourlyRollupUpdateOne) SaveX(ctx context.Context) *UsageHourlyRollup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UsageHourlyRollupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageHourlyRollupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UsageHourlyRollupUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UsageHourlyRollupUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *UsageHourlyRollupUpdateOne) sqlSave(ctx context.Context) (_node *UsageHourlyRollup, err error) {
+	_spec := sqlgraph.NewUpdateSpec(usagehourlyrollup.Table, usagehourlyrollup.Columns, sqlgraph.NewFieldSpec(usagehourlyrollup.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UsageHourlyRollup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usagehourlyrollup.FieldID)
+		for _, f := range fields {
+			if !usagehourlyrollup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usagehourlyrollup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RequestCount(); ok {
+		_spec.SetField(usagehourlyrollup.FieldRequestCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRequestCount(); ok {
+		_spec.AddField(usagehourlyrollup.FieldRequestCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldPromptTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(usagehourlyrollup.FieldPromptTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PromptCachedTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldPromptCachedTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPromptCachedTokens(); ok {
+		_spec.AddField(usagehourlyrollup.FieldPromptCachedTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PromptWriteCachedTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldPromptWriteCachedTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPromptWriteCachedTokens(); ok {
+		_spec.AddField(usagehourlyrollup.FieldPromptWriteCachedTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldCompletionTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(usagehourlyrollup.FieldCompletionTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CompletionReasoningTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldCompletionReasoningTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionReasoningTokens(); ok {
+		_spec.AddField(usagehourlyrollup.FieldCompletionReasoningTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TotalTokens(); ok {
+		_spec.SetField(usagehourlyrollup.FieldTotalTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalTokens(); ok {
+		_spec.AddField(usagehourlyrollup.FieldTotalTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.TotalCost(); ok {
+		_spec.SetField(usagehourlyrollup.FieldTotalCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalCost(); ok {
+		_spec.AddField(usagehourlyrollup.FieldTotalCost, field.TypeFloat64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &UsageHourlyRollup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagehourlyrollup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
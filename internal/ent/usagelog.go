@@ -30,6 +30,8 @@ type UsageLog struct {
 	RequestID int `json:"request_id,omitempty"`
 	// APIKeyID holds the value of the "api_key_id" field.
 	APIKeyID int `json:"api_key_id,omitempty"`
+	// End user identifier the usage is attributed to
+	EndUserID string `json:"end_user_id,omitempty"`
 	// Project ID, default to 1 for backward compatibility
 	ProjectID int `json:"project_id,omitempty"`
 	// Channel ID used for the request
@@ -70,6 +72,14 @@ type UsageLog struct {
 	CostItems []objects.CostItem `json:"cost_items,omitempty"`
 	// Reference ID to the channel model price version used for cost calculation
 	CostPriceReferenceID string `json:"cost_price_reference_id,omitempty"`
+	// Currency of the cost items, the reporting currency unless there was no exchange rate, the total cost is then not set
+	Currency string `json:"currency,omitempty"`
+	// Total cost in the currency of the channel model price, before the conversion
+	OriginalTotalCost *float64 `json:"original_total_cost,omitempty"`
+	// Currency of the channel model price
+	OriginalCurrency string `json:"original_currency,omitempty"`
+	// Rate converting the original currency into the reporting currency at request time
+	ExchangeRate *float64 `json:"exchange_rate,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UsageLogQuery when eager-loading is set.
 	Edges        UsageLogEdges `json:"edges"`
@@ -131,11 +141,11 @@ func (*UsageLog) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case usagelog.FieldCostItems:
 			values[i] = new([]byte)
-		case usagelog.FieldTotalCost:
+		case usagelog.FieldTotalCost, usagelog.FieldOriginalTotalCost, usagelog.FieldExchangeRate:
 			values[i] = new(sql.NullFloat64)
 		case usagelog.FieldID, usagelog.FieldRequestID, usagelog.FieldAPIKeyID, usagelog.FieldProjectID, usagelog.FieldChannelID, usagelog.FieldPromptTokens, usagelog.FieldCompletionTokens, usagelog.FieldTotalTokens, usagelog.FieldPromptAudioTokens, usagelog.FieldPromptCachedTokens, usagelog.FieldPromptWriteCachedTokens, usagelog.FieldPromptWriteCachedTokens5m, usagelog.FieldPromptWriteCachedTokens1h, usagelog.FieldCompletionAudioTokens, usagelog.FieldCompletionReasoningTokens, usagelog.FieldCompletionAcceptedPredictionTokens, usagelog.FieldCompletionRejectedPredictionTokens:
 			values[i] = new(sql.NullInt64)
-		case usagelog.FieldModelID, usagelog.FieldSource, usagelog.FieldFormat, usagelog.FieldCostPriceReferenceID:
+		case usagelog.FieldEndUserID, usagelog.FieldModelID, usagelog.FieldSource, usagelog.FieldFormat, usagelog.FieldCostPriceReferenceID, usagelog.FieldCurrency, usagelog.FieldOriginalCurrency:
 			values[i] = new(sql.NullString)
 		case usagelog.FieldCreatedAt, usagelog.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -184,6 +194,12 @@ func (_m *UsageLog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.APIKeyID = int(value.Int64)
 			}
+		case usagelog.FieldEndUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end_user_id", values[i])
+			} else if value.Valid {
+				_m.EndUserID = value.String
+			}
 		case usagelog.FieldProjectID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field project_id", values[i])
@@ -307,6 +323,32 @@ func (_m *UsageLog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CostPriceReferenceID = value.String
 			}
+		case usagelog.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case usagelog.FieldOriginalTotalCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field original_total_cost", values[i])
+			} else if value.Valid {
+				_m.OriginalTotalCost = new(float64)
+				*_m.OriginalTotalCost = value.Float64
+			}
+		case usagelog.FieldOriginalCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field original_currency", values[i])
+			} else if value.Valid {
+				_m.OriginalCurrency = value.String
+			}
+		case usagelog.FieldExchangeRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_rate", values[i])
+			} else if value.Valid {
+				_m.ExchangeRate = new(float64)
+				*_m.ExchangeRate = value.Float64
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -370,6 +412,9 @@ func (_m *UsageLog) String() string {
 	builder.WriteString("api_key_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.APIKeyID))
 	builder.WriteString(", ")
+	builder.WriteString("end_user_id=")
+	builder.WriteString(_m.EndUserID)
+	builder.WriteString(", ")
 	builder.WriteString("project_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ProjectID))
 	builder.WriteString(", ")
@@ -431,6 +476,22 @@ func (_m *UsageLog) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("cost_price_reference_id=")
 	builder.WriteString(_m.CostPriceReferenceID)
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	if v := _m.OriginalTotalCost; v != nil {
+		builder.WriteString("original_total_cost=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("original_currency=")
+	builder.WriteString(_m.OriginalCurrency)
+	builder.WriteString(", ")
+	if v := _m.ExchangeRate; v != nil {
+		builder.WriteString("exchange_rate=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
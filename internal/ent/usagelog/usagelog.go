@@ -27,6 +27,8 @@ const (
 	FieldRequestID = "request_id"
 	// FieldAPIKeyID holds the string denoting the api_key_id field in the database.
 	FieldAPIKeyID = "api_key_id"
+	// FieldEndUserID holds the string denoting the end_user_id field in the database.
+	FieldEndUserID = "end_user_id"
 	// FieldProjectID holds the string denoting the project_id field in the database.
 	FieldProjectID = "project_id"
 	// FieldChannelID holds the string denoting the channel_id field in the database.
@@ -67,6 +69,14 @@ const (
 	FieldCostItems = "cost_items"
 	// FieldCostPriceReferenceID holds the string denoting the cost_price_reference_id field in the database.
 	FieldCostPriceReferenceID = "cost_price_reference_id"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldOriginalTotalCost holds the string denoting the original_total_cost field in the database.
+	FieldOriginalTotalCost = "original_total_cost"
+	// FieldOriginalCurrency holds the string denoting the original_currency field in the database.
+	FieldOriginalCurrency = "original_currency"
+	// FieldExchangeRate holds the string denoting the exchange_rate field in the database.
+	FieldExchangeRate = "exchange_rate"
 	// EdgeRequest holds the string denoting the request edge name in mutations.
 	EdgeRequest = "request"
 	// EdgeProject holds the string denoting the project edge name in mutations.
@@ -105,6 +115,7 @@ var Columns = []string{
 	FieldUpdatedAt,
 	FieldRequestID,
 	FieldAPIKeyID,
+	FieldEndUserID,
 	FieldProjectID,
 	FieldChannelID,
 	FieldModelID,
@@ -125,6 +136,10 @@ var Columns = []string{
 	FieldTotalCost,
 	FieldCostItems,
 	FieldCostPriceReferenceID,
+	FieldCurrency,
+	FieldOriginalTotalCost,
+	FieldOriginalCurrency,
+	FieldExchangeRate,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -151,6 +166,8 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// EndUserIDValidator is a validator for the "end_user_id" field. It is called by the builders before save.
+	EndUserIDValidator func(string) error
 	// DefaultProjectID holds the default value on creation for the "project_id" field.
 	DefaultProjectID int
 	// DefaultPromptTokens holds the default value on creation for the "prompt_tokens" field.
@@ -238,6 +255,11 @@ func ByAPIKeyID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAPIKeyID, opts...).ToFunc()
 }
 
+// ByEndUserID orders the results by the end_user_id field.
+func ByEndUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndUserID, opts...).ToFunc()
+}
+
 // ByProjectID orders the results by the project_id field.
 func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
@@ -333,6 +355,26 @@ func ByCostPriceReferenceID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCostPriceReferenceID, opts...).ToFunc()
 }
 
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByOriginalTotalCost orders the results by the original_total_cost field.
+func ByOriginalTotalCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalTotalCost, opts...).ToFunc()
+}
+
+// ByOriginalCurrency orders the results by the original_currency field.
+func ByOriginalCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalCurrency, opts...).ToFunc()
+}
+
+// ByExchangeRate orders the results by the exchange_rate field.
+func ByExchangeRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchangeRate, opts...).ToFunc()
+}
+
 // ByRequestField orders the results by request field.
 func ByRequestField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -75,6 +75,11 @@ func APIKeyID(v int) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldAPIKeyID, v))
 }
 
+// EndUserID applies equality check predicate on the "end_user_id" field. It's identical to EndUserIDEQ.
+func EndUserID(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldEndUserID, v))
+}
+
 // ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
 func ProjectID(v int) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldProjectID, v))
@@ -165,6 +170,26 @@ func CostPriceReferenceID(v string) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldCostPriceReferenceID, v))
 }
 
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldCurrency, v))
+}
+
+// OriginalTotalCost applies equality check predicate on the "original_total_cost" field. It's identical to OriginalTotalCostEQ.
+func OriginalTotalCost(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldOriginalTotalCost, v))
+}
+
+// OriginalCurrency applies equality check predicate on the "original_currency" field. It's identical to OriginalCurrencyEQ.
+func OriginalCurrency(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldOriginalCurrency, v))
+}
+
+// ExchangeRate applies equality check predicate on the "exchange_rate" field. It's identical to ExchangeRateEQ.
+func ExchangeRate(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldExchangeRate, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldCreatedAt, v))
@@ -315,6 +340,81 @@ func APIKeyIDNotNil() predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldNotNull(FieldAPIKeyID))
 }
 
+// EndUserIDEQ applies the EQ predicate on the "end_user_id" field.
+func EndUserIDEQ(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldEndUserID, v))
+}
+
+// EndUserIDNEQ applies the NEQ predicate on the "end_user_id" field.
+func EndUserIDNEQ(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldEndUserID, v))
+}
+
+// EndUserIDIn applies the In predicate on the "end_user_id" field.
+func EndUserIDIn(vs ...string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldEndUserID, vs...))
+}
+
+// EndUserIDNotIn applies the NotIn predicate on the "end_user_id" field.
+func EndUserIDNotIn(vs ...string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldEndUserID, vs...))
+}
+
+// EndUserIDGT applies the GT predicate on the "end_user_id" field.
+func EndUserIDGT(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldEndUserID, v))
+}
+
+// EndUserIDGTE applies the GTE predicate on the "end_user_id" field.
+func EndUserIDGTE(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldEndUserID, v))
+}
+
+// EndUserIDLT applies the LT predicate on the "end_user_id" field.
+func EndUserIDLT(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldEndUserID, v))
+}
+
+// EndUserIDLTE applies the LTE predicate on the "end_user_id" field.
+func EndUserIDLTE(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldEndUserID, v))
+}
+
+// EndUserIDContains applies the Contains predicate on the "end_user_id" field.
+func EndUserIDContains(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldContains(FieldEndUserID, v))
+}
+
+// EndUserIDHasPrefix applies the HasPrefix predicate on the "end_user_id" field.
+func EndUserIDHasPrefix(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldHasPrefix(FieldEndUserID, v))
+}
+
+// EndUserIDHasSuffix applies the HasSuffix predicate on the "end_user_id" field.
+func EndUserIDHasSuffix(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldHasSuffix(FieldEndUserID, v))
+}
+
+// EndUserIDIsNil applies the IsNil predicate on the "end_user_id" field.
+func EndUserIDIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldEndUserID))
+}
+
+// EndUserIDNotNil applies the NotNil predicate on the "end_user_id" field.
+func EndUserIDNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldEndUserID))
+}
+
+// EndUserIDEqualFold applies the EqualFold predicate on the "end_user_id" field.
+func EndUserIDEqualFold(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEqualFold(FieldEndUserID, v))
+}
+
+// EndUserIDContainsFold applies the ContainsFold predicate on the "end_user_id" field.
+func EndUserIDContainsFold(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldContainsFold(FieldEndUserID, v))
+}
+
 // ProjectIDEQ applies the EQ predicate on the "project_id" field.
 func ProjectIDEQ(v int) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldEQ(FieldProjectID, v))
@@ -1220,6 +1320,256 @@ func CostPriceReferenceIDContainsFold(v string) predicate.UsageLog {
 	return predicate.UsageLog(sql.FieldContainsFold(FieldCostPriceReferenceID, v))
 }
 
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyIsNil applies the IsNil predicate on the "currency" field.
+func CurrencyIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldCurrency))
+}
+
+// CurrencyNotNil applies the NotNil predicate on the "currency" field.
+func CurrencyNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldCurrency))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// OriginalTotalCostEQ applies the EQ predicate on the "original_total_cost" field.
+func OriginalTotalCostEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldOriginalTotalCost, v))
+}
+
+// OriginalTotalCostNEQ applies the NEQ predicate on the "original_total_cost" field.
+func OriginalTotalCostNEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldOriginalTotalCost, v))
+}
+
+// OriginalTotalCostIn applies the In predicate on the "original_total_cost" field.
+func OriginalTotalCostIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldOriginalTotalCost, vs...))
+}
+
+// OriginalTotalCostNotIn applies the NotIn predicate on the "original_total_cost" field.
+func OriginalTotalCostNotIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldOriginalTotalCost, vs...))
+}
+
+// OriginalTotalCostGT applies the GT predicate on the "original_total_cost" field.
+func OriginalTotalCostGT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldOriginalTotalCost, v))
+}
+
+// OriginalTotalCostGTE applies the GTE predicate on the "original_total_cost" field.
+func OriginalTotalCostGTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldOriginalTotalCost, v))
+}
+
+// OriginalTotalCostLT applies the LT predicate on the "original_total_cost" field.
+func OriginalTotalCostLT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldOriginalTotalCost, v))
+}
+
+// OriginalTotalCostLTE applies the LTE predicate on the "original_total_cost" field.
+func OriginalTotalCostLTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldOriginalTotalCost, v))
+}
+
+// OriginalTotalCostIsNil applies the IsNil predicate on the "original_total_cost" field.
+func OriginalTotalCostIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldOriginalTotalCost))
+}
+
+// OriginalTotalCostNotNil applies the NotNil predicate on the "original_total_cost" field.
+func OriginalTotalCostNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldOriginalTotalCost))
+}
+
+// OriginalCurrencyEQ applies the EQ predicate on the "original_currency" field.
+func OriginalCurrencyEQ(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyNEQ applies the NEQ predicate on the "original_currency" field.
+func OriginalCurrencyNEQ(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyIn applies the In predicate on the "original_currency" field.
+func OriginalCurrencyIn(vs ...string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldOriginalCurrency, vs...))
+}
+
+// OriginalCurrencyNotIn applies the NotIn predicate on the "original_currency" field.
+func OriginalCurrencyNotIn(vs ...string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldOriginalCurrency, vs...))
+}
+
+// OriginalCurrencyGT applies the GT predicate on the "original_currency" field.
+func OriginalCurrencyGT(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyGTE applies the GTE predicate on the "original_currency" field.
+func OriginalCurrencyGTE(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyLT applies the LT predicate on the "original_currency" field.
+func OriginalCurrencyLT(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyLTE applies the LTE predicate on the "original_currency" field.
+func OriginalCurrencyLTE(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyContains applies the Contains predicate on the "original_currency" field.
+func OriginalCurrencyContains(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldContains(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyHasPrefix applies the HasPrefix predicate on the "original_currency" field.
+func OriginalCurrencyHasPrefix(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldHasPrefix(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyHasSuffix applies the HasSuffix predicate on the "original_currency" field.
+func OriginalCurrencyHasSuffix(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldHasSuffix(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyIsNil applies the IsNil predicate on the "original_currency" field.
+func OriginalCurrencyIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldOriginalCurrency))
+}
+
+// OriginalCurrencyNotNil applies the NotNil predicate on the "original_currency" field.
+func OriginalCurrencyNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldOriginalCurrency))
+}
+
+// OriginalCurrencyEqualFold applies the EqualFold predicate on the "original_currency" field.
+func OriginalCurrencyEqualFold(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEqualFold(FieldOriginalCurrency, v))
+}
+
+// OriginalCurrencyContainsFold applies the ContainsFold predicate on the "original_currency" field.
+func OriginalCurrencyContainsFold(v string) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldContainsFold(FieldOriginalCurrency, v))
+}
+
+// ExchangeRateEQ applies the EQ predicate on the "exchange_rate" field.
+func ExchangeRateEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateNEQ applies the NEQ predicate on the "exchange_rate" field.
+func ExchangeRateNEQ(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNEQ(FieldExchangeRate, v))
+}
+
+// ExchangeRateIn applies the In predicate on the "exchange_rate" field.
+func ExchangeRateIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIn(FieldExchangeRate, vs...))
+}
+
+// ExchangeRateNotIn applies the NotIn predicate on the "exchange_rate" field.
+func ExchangeRateNotIn(vs ...float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotIn(FieldExchangeRate, vs...))
+}
+
+// ExchangeRateGT applies the GT predicate on the "exchange_rate" field.
+func ExchangeRateGT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGT(FieldExchangeRate, v))
+}
+
+// ExchangeRateGTE applies the GTE predicate on the "exchange_rate" field.
+func ExchangeRateGTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldGTE(FieldExchangeRate, v))
+}
+
+// ExchangeRateLT applies the LT predicate on the "exchange_rate" field.
+func ExchangeRateLT(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLT(FieldExchangeRate, v))
+}
+
+// ExchangeRateLTE applies the LTE predicate on the "exchange_rate" field.
+func ExchangeRateLTE(v float64) predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldLTE(FieldExchangeRate, v))
+}
+
+// ExchangeRateIsNil applies the IsNil predicate on the "exchange_rate" field.
+func ExchangeRateIsNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldIsNull(FieldExchangeRate))
+}
+
+// ExchangeRateNotNil applies the NotNil predicate on the "exchange_rate" field.
+func ExchangeRateNotNil() predicate.UsageLog {
+	return predicate.UsageLog(sql.FieldNotNull(FieldExchangeRate))
+}
+
 // HasRequest applies the HasEdge predicate on the "request" edge.
 func HasRequest() predicate.UsageLog {
 	return predicate.UsageLog(func(s *sql.Selector) {
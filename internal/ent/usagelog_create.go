@@ -74,6 +74,20 @@ func (_c *UsageLogCreate) SetNillableAPIKeyID(v *int) *UsageLogCreate {
 	return _c
 }
 
+// SetEndUserID sets the "end_user_id" field.
+func (_c *UsageLogCreate) SetEndUserID(v string) *UsageLogCreate {
+	_c.mutation.SetEndUserID(v)
+	return _c
+}
+
+// SetNillableEndUserID sets the "end_user_id" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableEndUserID(v *string) *UsageLogCreate {
+	if v != nil {
+		_c.SetEndUserID(*v)
+	}
+	return _c
+}
+
 // SetProjectID sets the "project_id" field.
 func (_c *UsageLogCreate) SetProjectID(v int) *UsageLogCreate {
 	_c.mutation.SetProjectID(v)
@@ -338,6 +352,62 @@ func (_c *UsageLogCreate) SetNillableCostPriceReferenceID(v *string) *UsageLogCr
 	return _c
 }
 
+// SetCurrency sets the "currency" field.
+func (_c *UsageLogCreate) SetCurrency(v string) *UsageLogCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableCurrency(v *string) *UsageLogCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetOriginalTotalCost sets the "original_total_cost" field.
+func (_c *UsageLogCreate) SetOriginalTotalCost(v float64) *UsageLogCreate {
+	_c.mutation.SetOriginalTotalCost(v)
+	return _c
+}
+
+// SetNillableOriginalTotalCost sets the "original_total_cost" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableOriginalTotalCost(v *float64) *UsageLogCreate {
+	if v != nil {
+		_c.SetOriginalTotalCost(*v)
+	}
+	return _c
+}
+
+// SetOriginalCurrency sets the "original_currency" field.
+func (_c *UsageLogCreate) SetOriginalCurrency(v string) *UsageLogCreate {
+	_c.mutation.SetOriginalCurrency(v)
+	return _c
+}
+
+// SetNillableOriginalCurrency sets the "original_currency" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableOriginalCurrency(v *string) *UsageLogCreate {
+	if v != nil {
+		_c.SetOriginalCurrency(*v)
+	}
+	return _c
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_c *UsageLogCreate) SetExchangeRate(v float64) *UsageLogCreate {
+	_c.mutation.SetExchangeRate(v)
+	return _c
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_c *UsageLogCreate) SetNillableExchangeRate(v *float64) *UsageLogCreate {
+	if v != nil {
+		_c.SetExchangeRate(*v)
+	}
+	return _c
+}
+
 // SetRequest sets the "request" edge to the Request entity.
 func (_c *UsageLogCreate) SetRequest(v *Request) *UsageLogCreate {
 	return _c.SetRequestID(v.ID)
@@ -476,6 +546,11 @@ func (_c *UsageLogCreate) check() error {
 	if _, ok := _c.mutation.RequestID(); !ok {
 		return &ValidationError{Name: "request_id", err: errors.New(`ent: missing required field "UsageLog.request_id"`)}
 	}
+	if v, ok := _c.mutation.EndUserID(); ok {
+		if err := usagelog.EndUserIDValidator(v); err != nil {
+			return &ValidationError{Name: "end_user_id", err: fmt.Errorf(`ent: validator failed for field "UsageLog.end_user_id": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.ProjectID(); !ok {
 		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "UsageLog.project_id"`)}
 	}
@@ -547,6 +622,10 @@ func (_c *UsageLogCreate) createSpec() (*UsageLog, *sqlgraph.CreateSpec) {
 		_spec.SetField(usagelog.FieldAPIKeyID, field.TypeInt, value)
 		_node.APIKeyID = value
 	}
+	if value, ok := _c.mutation.EndUserID(); ok {
+		_spec.SetField(usagelog.FieldEndUserID, field.TypeString, value)
+		_node.EndUserID = value
+	}
 	if value, ok := _c.mutation.ModelID(); ok {
 		_spec.SetField(usagelog.FieldModelID, field.TypeString, value)
 		_node.ModelID = value
@@ -619,6 +698,22 @@ func (_c *UsageLogCreate) createSpec() (*UsageLog, *sqlgraph.CreateSpec) {
 		_spec.SetField(usagelog.FieldCostPriceReferenceID, field.TypeString, value)
 		_node.CostPriceReferenceID = value
 	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(usagelog.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.OriginalTotalCost(); ok {
+		_spec.SetField(usagelog.FieldOriginalTotalCost, field.TypeFloat64, value)
+		_node.OriginalTotalCost = &value
+	}
+	if value, ok := _c.mutation.OriginalCurrency(); ok {
+		_spec.SetField(usagelog.FieldOriginalCurrency, field.TypeString, value)
+		_node.OriginalCurrency = value
+	}
+	if value, ok := _c.mutation.ExchangeRate(); ok {
+		_spec.SetField(usagelog.FieldExchangeRate, field.TypeFloat64, value)
+		_node.ExchangeRate = &value
+	}
 	if nodes := _c.mutation.RequestIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1064,6 +1159,90 @@ func (u *UsageLogUpsert) ClearCostPriceReferenceID() *UsageLogUpsert {
 	return u
 }
 
+// SetCurrency sets the "currency" field.
+func (u *UsageLogUpsert) SetCurrency(v string) *UsageLogUpsert {
+	u.Set(usagelog.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateCurrency() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldCurrency)
+	return u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *UsageLogUpsert) ClearCurrency() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldCurrency)
+	return u
+}
+
+// SetOriginalTotalCost sets the "original_total_cost" field.
+func (u *UsageLogUpsert) SetOriginalTotalCost(v float64) *UsageLogUpsert {
+	u.Set(usagelog.FieldOriginalTotalCost, v)
+	return u
+}
+
+// UpdateOriginalTotalCost sets the "original_total_cost" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateOriginalTotalCost() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldOriginalTotalCost)
+	return u
+}
+
+// AddOriginalTotalCost adds v to the "original_total_cost" field.
+func (u *UsageLogUpsert) AddOriginalTotalCost(v float64) *UsageLogUpsert {
+	u.Add(usagelog.FieldOriginalTotalCost, v)
+	return u
+}
+
+// ClearOriginalTotalCost clears the value of the "original_total_cost" field.
+func (u *UsageLogUpsert) ClearOriginalTotalCost() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldOriginalTotalCost)
+	return u
+}
+
+// SetOriginalCurrency sets the "original_currency" field.
+func (u *UsageLogUpsert) SetOriginalCurrency(v string) *UsageLogUpsert {
+	u.Set(usagelog.FieldOriginalCurrency, v)
+	return u
+}
+
+// UpdateOriginalCurrency sets the "original_currency" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateOriginalCurrency() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldOriginalCurrency)
+	return u
+}
+
+// ClearOriginalCurrency clears the value of the "original_currency" field.
+func (u *UsageLogUpsert) ClearOriginalCurrency() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldOriginalCurrency)
+	return u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (u *UsageLogUpsert) SetExchangeRate(v float64) *UsageLogUpsert {
+	u.Set(usagelog.FieldExchangeRate, v)
+	return u
+}
+
+// UpdateExchangeRate sets the "exchange_rate" field to the value that was provided on create.
+func (u *UsageLogUpsert) UpdateExchangeRate() *UsageLogUpsert {
+	u.SetExcluded(usagelog.FieldExchangeRate)
+	return u
+}
+
+// AddExchangeRate adds v to the "exchange_rate" field.
+func (u *UsageLogUpsert) AddExchangeRate(v float64) *UsageLogUpsert {
+	u.Add(usagelog.FieldExchangeRate, v)
+	return u
+}
+
+// ClearExchangeRate clears the value of the "exchange_rate" field.
+func (u *UsageLogUpsert) ClearExchangeRate() *UsageLogUpsert {
+	u.SetNull(usagelog.FieldExchangeRate)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -1084,6 +1263,9 @@ func (u *UsageLogUpsertOne) UpdateNewValues() *UsageLogUpsertOne {
 		if _, exists := u.create.mutation.APIKeyID(); exists {
 			s.SetIgnore(usagelog.FieldAPIKeyID)
 		}
+		if _, exists := u.create.mutation.EndUserID(); exists {
+			s.SetIgnore(usagelog.FieldEndUserID)
+		}
 		if _, exists := u.create.mutation.ProjectID(); exists {
 			s.SetIgnore(usagelog.FieldProjectID)
 		}
@@ -1529,6 +1711,104 @@ func (u *UsageLogUpsertOne) ClearCostPriceReferenceID() *UsageLogUpsertOne {
 	})
 }
 
+// SetCurrency sets the "currency" field.
+func (u *UsageLogUpsertOne) SetCurrency(v string) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateCurrency() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *UsageLogUpsertOne) ClearCurrency() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearCurrency()
+	})
+}
+
+// SetOriginalTotalCost sets the "original_total_cost" field.
+func (u *UsageLogUpsertOne) SetOriginalTotalCost(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetOriginalTotalCost(v)
+	})
+}
+
+// AddOriginalTotalCost adds v to the "original_total_cost" field.
+func (u *UsageLogUpsertOne) AddOriginalTotalCost(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddOriginalTotalCost(v)
+	})
+}
+
+// UpdateOriginalTotalCost sets the "original_total_cost" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateOriginalTotalCost() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateOriginalTotalCost()
+	})
+}
+
+// ClearOriginalTotalCost clears the value of the "original_total_cost" field.
+func (u *UsageLogUpsertOne) ClearOriginalTotalCost() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearOriginalTotalCost()
+	})
+}
+
+// SetOriginalCurrency sets the "original_currency" field.
+func (u *UsageLogUpsertOne) SetOriginalCurrency(v string) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetOriginalCurrency(v)
+	})
+}
+
+// UpdateOriginalCurrency sets the "original_currency" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateOriginalCurrency() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateOriginalCurrency()
+	})
+}
+
+// ClearOriginalCurrency clears the value of the "original_currency" field.
+func (u *UsageLogUpsertOne) ClearOriginalCurrency() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearOriginalCurrency()
+	})
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (u *UsageLogUpsertOne) SetExchangeRate(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetExchangeRate(v)
+	})
+}
+
+// AddExchangeRate adds v to the "exchange_rate" field.
+func (u *UsageLogUpsertOne) AddExchangeRate(v float64) *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddExchangeRate(v)
+	})
+}
+
+// UpdateExchangeRate sets the "exchange_rate" field to the value that was provided on create.
+func (u *UsageLogUpsertOne) UpdateExchangeRate() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateExchangeRate()
+	})
+}
+
+// ClearExchangeRate clears the value of the "exchange_rate" field.
+func (u *UsageLogUpsertOne) ClearExchangeRate() *UsageLogUpsertOne {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearExchangeRate()
+	})
+}
+
 // Exec executes the query.
 func (u *UsageLogUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1714,6 +1994,9 @@ func (u *UsageLogUpsertBulk) UpdateNewValues() *UsageLogUpsertBulk {
 			if _, exists := b.mutation.APIKeyID(); exists {
 				s.SetIgnore(usagelog.FieldAPIKeyID)
 			}
+			if _, exists := b.mutation.EndUserID(); exists {
+				s.SetIgnore(usagelog.FieldEndUserID)
+			}
 			if _, exists := b.mutation.ProjectID(); exists {
 				s.SetIgnore(usagelog.FieldProjectID)
 			}
@@ -2160,6 +2443,104 @@ func (u *UsageLogUpsertBulk) ClearCostPriceReferenceID() *UsageLogUpsertBulk {
 	})
 }
 
+// SetCurrency sets the "currency" field.
+func (u *UsageLogUpsertBulk) SetCurrency(v string) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateCurrency() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *UsageLogUpsertBulk) ClearCurrency() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearCurrency()
+	})
+}
+
+// SetOriginalTotalCost sets the "original_total_cost" field.
+func (u *UsageLogUpsertBulk) SetOriginalTotalCost(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetOriginalTotalCost(v)
+	})
+}
+
+// AddOriginalTotalCost adds v to the "original_total_cost" field.
+func (u *UsageLogUpsertBulk) AddOriginalTotalCost(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddOriginalTotalCost(v)
+	})
+}
+
+// UpdateOriginalTotalCost sets the "original_total_cost" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateOriginalTotalCost() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateOriginalTotalCost()
+	})
+}
+
+// ClearOriginalTotalCost clears the value of the "original_total_cost" field.
+func (u *UsageLogUpsertBulk) ClearOriginalTotalCost() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearOriginalTotalCost()
+	})
+}
+
+// SetOriginalCurrency sets the "original_currency" field.
+func (u *UsageLogUpsertBulk) SetOriginalCurrency(v string) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetOriginalCurrency(v)
+	})
+}
+
+// UpdateOriginalCurrency sets the "original_currency" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateOriginalCurrency() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateOriginalCurrency()
+	})
+}
+
+// ClearOriginalCurrency clears the value of the "original_currency" field.
+func (u *UsageLogUpsertBulk) ClearOriginalCurrency() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearOriginalCurrency()
+	})
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (u *UsageLogUpsertBulk) SetExchangeRate(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.SetExchangeRate(v)
+	})
+}
+
+// AddExchangeRate adds v to the "exchange_rate" field.
+func (u *UsageLogUpsertBulk) AddExchangeRate(v float64) *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.AddExchangeRate(v)
+	})
+}
+
+// UpdateExchangeRate sets the "exchange_rate" field to the value that was provided on create.
+func (u *UsageLogUpsertBulk) UpdateExchangeRate() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.UpdateExchangeRate()
+	})
+}
+
+// ClearExchangeRate clears the value of the "exchange_rate" field.
+func (u *UsageLogUpsertBulk) ClearExchangeRate() *UsageLogUpsertBulk {
+	return u.Update(func(s *UsageLogUpsert) {
+		s.ClearExchangeRate()
+	})
+}
+
 // Exec executes the query.
 func (u *UsageLogUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
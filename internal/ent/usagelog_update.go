@@ -408,6 +408,100 @@ func (_u *UsageLogUpdate) ClearCostPriceReferenceID() *UsageLogUpdate {
 	return _u
 }
 
+// SetCurrency sets the "currency" field.
+func (_u *UsageLogUpdate) SetCurrency(v string) *UsageLogUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableCurrency(v *string) *UsageLogUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (_u *UsageLogUpdate) ClearCurrency() *UsageLogUpdate {
+	_u.mutation.ClearCurrency()
+	return _u
+}
+
+// SetOriginalTotalCost sets the "original_total_cost" field.
+func (_u *UsageLogUpdate) SetOriginalTotalCost(v float64) *UsageLogUpdate {
+	_u.mutation.ResetOriginalTotalCost()
+	_u.mutation.SetOriginalTotalCost(v)
+	return _u
+}
+
+// SetNillableOriginalTotalCost sets the "original_total_cost" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableOriginalTotalCost(v *float64) *UsageLogUpdate {
+	if v != nil {
+		_u.SetOriginalTotalCost(*v)
+	}
+	return _u
+}
+
+// AddOriginalTotalCost adds value to the "original_total_cost" field.
+func (_u *UsageLogUpdate) AddOriginalTotalCost(v float64) *UsageLogUpdate {
+	_u.mutation.AddOriginalTotalCost(v)
+	return _u
+}
+
+// ClearOriginalTotalCost clears the value of the "original_total_cost" field.
+func (_u *UsageLogUpdate) ClearOriginalTotalCost() *UsageLogUpdate {
+	_u.mutation.ClearOriginalTotalCost()
+	return _u
+}
+
+// SetOriginalCurrency sets the "original_currency" field.
+func (_u *UsageLogUpdate) SetOriginalCurrency(v string) *UsageLogUpdate {
+	_u.mutation.SetOriginalCurrency(v)
+	return _u
+}
+
+// SetNillableOriginalCurrency sets the "original_currency" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableOriginalCurrency(v *string) *UsageLogUpdate {
+	if v != nil {
+		_u.SetOriginalCurrency(*v)
+	}
+	return _u
+}
+
+// ClearOriginalCurrency clears the value of the "original_currency" field.
+func (_u *UsageLogUpdate) ClearOriginalCurrency() *UsageLogUpdate {
+	_u.mutation.ClearOriginalCurrency()
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *UsageLogUpdate) SetExchangeRate(v float64) *UsageLogUpdate {
+	_u.mutation.ResetExchangeRate()
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *UsageLogUpdate) SetNillableExchangeRate(v *float64) *UsageLogUpdate {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
+// AddExchangeRate adds value to the "exchange_rate" field.
+func (_u *UsageLogUpdate) AddExchangeRate(v float64) *UsageLogUpdate {
+	_u.mutation.AddExchangeRate(v)
+	return _u
+}
+
+// ClearExchangeRate clears the value of the "exchange_rate" field.
+func (_u *UsageLogUpdate) ClearExchangeRate() *UsageLogUpdate {
+	_u.mutation.ClearExchangeRate()
+	return _u
+}
+
 // Mutation returns the UsageLogMutation object of the builder.
 func (_u *UsageLogUpdate) Mutation() *UsageLogMutation {
 	return _u.mutation
@@ -490,6 +584,9 @@ func (_u *UsageLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.APIKeyIDCleared() {
 		_spec.ClearField(usagelog.FieldAPIKeyID, field.TypeInt)
 	}
+	if _u.mutation.EndUserIDCleared() {
+		_spec.ClearField(usagelog.FieldEndUserID, field.TypeString)
+	}
 	if value, ok := _u.mutation.PromptTokens(); ok {
 		_spec.SetField(usagelog.FieldPromptTokens, field.TypeInt64, value)
 	}
@@ -615,6 +712,36 @@ func (_u *UsageLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.CostPriceReferenceIDCleared() {
 		_spec.ClearField(usagelog.FieldCostPriceReferenceID, field.TypeString)
 	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(usagelog.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.CurrencyCleared() {
+		_spec.ClearField(usagelog.FieldCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.OriginalTotalCost(); ok {
+		_spec.SetField(usagelog.FieldOriginalTotalCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOriginalTotalCost(); ok {
+		_spec.AddField(usagelog.FieldOriginalTotalCost, field.TypeFloat64, value)
+	}
+	if _u.mutation.OriginalTotalCostCleared() {
+		_spec.ClearField(usagelog.FieldOriginalTotalCost, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.OriginalCurrency(); ok {
+		_spec.SetField(usagelog.FieldOriginalCurrency, field.TypeString, value)
+	}
+	if _u.mutation.OriginalCurrencyCleared() {
+		_spec.ClearField(usagelog.FieldOriginalCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(usagelog.FieldExchangeRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedExchangeRate(); ok {
+		_spec.AddField(usagelog.FieldExchangeRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.ExchangeRateCleared() {
+		_spec.ClearField(usagelog.FieldExchangeRate, field.TypeFloat64)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -1014,6 +1141,100 @@ func (_u *UsageLogUpdateOne) ClearCostPriceReferenceID() *UsageLogUpdateOne {
 	return _u
 }
 
+// SetCurrency sets the "currency" field.
+func (_u *UsageLogUpdateOne) SetCurrency(v string) *UsageLogUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableCurrency(v *string) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (_u *UsageLogUpdateOne) ClearCurrency() *UsageLogUpdateOne {
+	_u.mutation.ClearCurrency()
+	return _u
+}
+
+// SetOriginalTotalCost sets the "original_total_cost" field.
+func (_u *UsageLogUpdateOne) SetOriginalTotalCost(v float64) *UsageLogUpdateOne {
+	_u.mutation.ResetOriginalTotalCost()
+	_u.mutation.SetOriginalTotalCost(v)
+	return _u
+}
+
+// SetNillableOriginalTotalCost sets the "original_total_cost" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableOriginalTotalCost(v *float64) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetOriginalTotalCost(*v)
+	}
+	return _u
+}
+
+// AddOriginalTotalCost adds value to the "original_total_cost" field.
+func (_u *UsageLogUpdateOne) AddOriginalTotalCost(v float64) *UsageLogUpdateOne {
+	_u.mutation.AddOriginalTotalCost(v)
+	return _u
+}
+
+// ClearOriginalTotalCost clears the value of the "original_total_cost" field.
+func (_u *UsageLogUpdateOne) ClearOriginalTotalCost() *UsageLogUpdateOne {
+	_u.mutation.ClearOriginalTotalCost()
+	return _u
+}
+
+// SetOriginalCurrency sets the "original_currency" field.
+func (_u *UsageLogUpdateOne) SetOriginalCurrency(v string) *UsageLogUpdateOne {
+	_u.mutation.SetOriginalCurrency(v)
+	return _u
+}
+
+// SetNillableOriginalCurrency sets the "original_currency" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableOriginalCurrency(v *string) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetOriginalCurrency(*v)
+	}
+	return _u
+}
+
+// ClearOriginalCurrency clears the value of the "original_currency" field.
+func (_u *UsageLogUpdateOne) ClearOriginalCurrency() *UsageLogUpdateOne {
+	_u.mutation.ClearOriginalCurrency()
+	return _u
+}
+
+// SetExchangeRate sets the "exchange_rate" field.
+func (_u *UsageLogUpdateOne) SetExchangeRate(v float64) *UsageLogUpdateOne {
+	_u.mutation.ResetExchangeRate()
+	_u.mutation.SetExchangeRate(v)
+	return _u
+}
+
+// SetNillableExchangeRate sets the "exchange_rate" field if the given value is not nil.
+func (_u *UsageLogUpdateOne) SetNillableExchangeRate(v *float64) *UsageLogUpdateOne {
+	if v != nil {
+		_u.SetExchangeRate(*v)
+	}
+	return _u
+}
+
+// AddExchangeRate adds value to the "exchange_rate" field.
+func (_u *UsageLogUpdateOne) AddExchangeRate(v float64) *UsageLogUpdateOne {
+	_u.mutation.AddExchangeRate(v)
+	return _u
+}
+
+// ClearExchangeRate clears the value of the "exchange_rate" field.
+func (_u *UsageLogUpdateOne) ClearExchangeRate() *UsageLogUpdateOne {
+	_u.mutation.ClearExchangeRate()
+	return _u
+}
+
 // Mutation returns the UsageLogMutation object of the builder.
 func (_u *UsageLogUpdateOne) Mutation() *UsageLogMutation {
 	return _u.mutation
@@ -1126,6 +1347,9 @@ func (_u *UsageLogUpdateOne) sqlSave(ctx context.Context) (_node *UsageLog, err
 	if _u.mutation.APIKeyIDCleared() {
 		_spec.ClearField(usagelog.FieldAPIKeyID, field.TypeInt)
 	}
+	if _u.mutation.EndUserIDCleared() {
+		_spec.ClearField(usagelog.FieldEndUserID, field.TypeString)
+	}
 	if value, ok := _u.mutation.PromptTokens(); ok {
 		_spec.SetField(usagelog.FieldPromptTokens, field.TypeInt64, value)
 	}
@@ -1251,6 +1475,36 @@ func (_u *UsageLogUpdateOne) sqlSave(ctx context.Context) (_node *UsageLog, err
 	if _u.mutation.CostPriceReferenceIDCleared() {
 		_spec.ClearField(usagelog.FieldCostPriceReferenceID, field.TypeString)
 	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(usagelog.FieldCurrency, field.TypeString, value)
+	}
+	if _u.mutation.CurrencyCleared() {
+		_spec.ClearField(usagelog.FieldCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.OriginalTotalCost(); ok {
+		_spec.SetField(usagelog.FieldOriginalTotalCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOriginalTotalCost(); ok {
+		_spec.AddField(usagelog.FieldOriginalTotalCost, field.TypeFloat64, value)
+	}
+	if _u.mutation.OriginalTotalCostCleared() {
+		_spec.ClearField(usagelog.FieldOriginalTotalCost, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.OriginalCurrency(); ok {
+		_spec.SetField(usagelog.FieldOriginalCurrency, field.TypeString, value)
+	}
+	if _u.mutation.OriginalCurrencyCleared() {
+		_spec.ClearField(usagelog.FieldOriginalCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExchangeRate(); ok {
+		_spec.SetField(usagelog.FieldExchangeRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedExchangeRate(); ok {
+		_spec.AddField(usagelog.FieldExchangeRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.ExchangeRateCleared() {
+		_spec.ClearField(usagelog.FieldExchangeRate, field.TypeFloat64)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &UsageLog{config: _u.config}
 	_spec.Assign = _node.assignValues
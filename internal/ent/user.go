@@ -60,13 +60,15 @@ type UserEdges struct {
 	ChannelOverrideTemplates []*ChannelOverrideTemplate `json:"channel_override_templates,omitempty"`
 	// OidcIdentities holds the value of the oidc_identities edge.
 	OidcIdentities []*OIDCIdentity `json:"oidc_identities,omitempty"`
+	// ScimGroups holds the value of the scim_groups edge.
+	ScimGroups []*SCIMGroup `json:"scim_groups,omitempty"`
 	// ProjectUsers holds the value of the project_users edge.
 	ProjectUsers []*UserProject `json:"project_users,omitempty"`
 	// UserRoles holds the value of the user_roles edge.
 	UserRoles []*UserRole `json:"user_roles,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [8]bool
 	// totalCount holds the count of the edges above.
 	totalCount [7]map[string]int
 
@@ -75,6 +77,7 @@ type UserEdges struct {
 	namedRoles                    map[string][]*Role
 	namedChannelOverrideTemplates map[string][]*ChannelOverrideTemplate
 	namedOidcIdentities           map[string][]*OIDCIdentity
+	namedScimGroups               map[string][]*SCIMGroup
 	namedProjectUsers             map[string][]*UserProject
 	namedUserRoles                map[string][]*UserRole
 }
@@ -124,10 +127,19 @@ func (e UserEdges) OidcIdentitiesOrErr() ([]*OIDCIdentity, error) {
 	return nil, &NotLoadedError{edge: "oidc_identities"}
 }
 
+// ScimGroupsOrErr returns the ScimGroups value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ScimGroupsOrErr() ([]*SCIMGroup, error) {
+	if e.loadedTypes[5] {
+		return e.ScimGroups, nil
+	}
+	return nil, &NotLoadedError{edge: "scim_groups"}
+}
+
 // ProjectUsersOrErr returns the ProjectUsers value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ProjectUsersOrErr() ([]*UserProject, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[6] {
 		return e.ProjectUsers, nil
 	}
 	return nil, &NotLoadedError{edge: "project_users"}
@@ -136,7 +148,7 @@ func (e UserEdges) ProjectUsersOrErr() ([]*UserProject, error) {
 // UserRolesOrErr returns the UserRoles value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) UserRolesOrErr() ([]*UserRole, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[7] {
 		return e.UserRoles, nil
 	}
 	return nil, &NotLoadedError{edge: "user_roles"}
@@ -290,6 +302,11 @@ func (_m *User) QueryOidcIdentities() *OIDCIdentityQuery {
 	return NewUserClient(_m.config).QueryOidcIdentities(_m)
 }
 
+// QueryScimGroups queries the "scim_groups" edge of the User entity.
+func (_m *User) QueryScimGroups() *SCIMGroupQuery {
+	return NewUserClient(_m.config).QueryScimGroups(_m)
+}
+
 // QueryProjectUsers queries the "project_users" edge of the User entity.
 func (_m *User) QueryProjectUsers() *UserProjectQuery {
 	return NewUserClient(_m.config).QueryProjectUsers(_m)
@@ -481,6 +498,30 @@ func (_m *User) appendNamedOidcIdentities(name string, edges ...*OIDCIdentity) {
 	}
 }
 
+// NamedScimGroups returns the ScimGroups named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *User) NamedScimGroups(name string) ([]*SCIMGroup, error) {
+	if _m.Edges.namedScimGroups == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedScimGroups[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *User) appendNamedScimGroups(name string, edges ...*SCIMGroup) {
+	if _m.Edges.namedScimGroups == nil {
+		_m.Edges.namedScimGroups = make(map[string][]*SCIMGroup)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedScimGroups[name] = []*SCIMGroup{}
+	} else {
+		_m.Edges.namedScimGroups[name] = append(_m.Edges.namedScimGroups[name], edges...)
+	}
+}
+
 // NamedProjectUsers returns the ProjectUsers named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (_m *User) NamedProjectUsers(name string) ([]*UserProject, error) {
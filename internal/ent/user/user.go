@@ -52,6 +52,8 @@ const (
 	EdgeChannelOverrideTemplates = "channel_override_templates"
 	// EdgeOidcIdentities holds the string denoting the oidc_identities edge name in mutations.
 	EdgeOidcIdentities = "oidc_identities"
+	// EdgeScimGroups holds the string denoting the scim_groups edge name in mutations.
+	EdgeScimGroups = "scim_groups"
 	// EdgeProjectUsers holds the string denoting the project_users edge name in mutations.
 	EdgeProjectUsers = "project_users"
 	// EdgeUserRoles holds the string denoting the user_roles edge name in mutations.
@@ -89,6 +91,11 @@ const (
 	OidcIdentitiesInverseTable = "oidc_identities"
 	// OidcIdentitiesColumn is the table column denoting the oidc_identities relation/edge.
 	OidcIdentitiesColumn = "user_id"
+	// ScimGroupsTable is the table that holds the scim_groups relation/edge. The primary key declared below.
+	ScimGroupsTable = "scim_group_users"
+	// ScimGroupsInverseTable is the table name for the SCIMGroup entity.
+	// It exists in this package in order to avoid circular dependency with the "scimgroup" package.
+	ScimGroupsInverseTable = "scim_groups"
 	// ProjectUsersTable is the table that holds the project_users relation/edge.
 	ProjectUsersTable = "user_projects"
 	// ProjectUsersInverseTable is the table name for the UserProject entity.
@@ -129,6 +136,9 @@ var (
 	// RolesPrimaryKey and RolesColumn2 are the table columns denoting the
 	// primary key for the roles relation (M2M).
 	RolesPrimaryKey = []string{"user_id", "role_id"}
+	// ScimGroupsPrimaryKey and ScimGroupsColumn2 are the table columns denoting the
+	// primary key for the scim_groups relation (M2M).
+	ScimGroupsPrimaryKey = []string{"scim_group_id", "user_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -329,6 +339,20 @@ func ByOidcIdentities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByScimGroupsCount orders the results by scim_groups count.
+func ByScimGroupsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newScimGroupsStep(), opts...)
+	}
+}
+
+// ByScimGroups orders the results by scim_groups terms.
+func ByScimGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScimGroupsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByProjectUsersCount orders the results by project_users count.
 func ByProjectUsersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -391,6 +415,13 @@ func newOidcIdentitiesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, OidcIdentitiesTable, OidcIdentitiesColumn),
 	)
 }
+func newScimGroupsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScimGroupsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, ScimGroupsTable, ScimGroupsPrimaryKey...),
+	)
+}
 func newProjectUsersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -780,6 +780,29 @@ func HasOidcIdentitiesWith(preds ...predicate.OIDCIdentity) predicate.User {
 	})
 }
 
+// HasScimGroups applies the HasEdge predicate on the "scim_groups" edge.
+func HasScimGroups() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, ScimGroupsTable, ScimGroupsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScimGroupsWith applies the HasEdge predicate on the "scim_groups" edge with a given conditions (other predicates).
+func HasScimGroupsWith(preds ...predicate.SCIMGroup) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newScimGroupsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasProjectUsers applies the HasEdge predicate on the "project_users" edge.
 func HasProjectUsers() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -16,6 +16,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent/oidcidentity"
 	"github.com/looplj/axonhub/internal/ent/project"
 	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/scimgroup"
 	"github.com/looplj/axonhub/internal/ent/user"
 	"github.com/looplj/axonhub/internal/ent/userproject"
 	"github.com/looplj/axonhub/internal/ent/userrole"
@@ -248,6 +249,21 @@ func (_c *UserCreate) AddOidcIdentities(v ...*OIDCIdentity) *UserCreate {
 	return _c.AddOidcIdentityIDs(ids...)
 }
 
+// AddScimGroupIDs adds the "scim_groups" edge to the SCIMGroup entity by IDs.
+func (_c *UserCreate) AddScimGroupIDs(ids ...int) *UserCreate {
+	_c.mutation.AddScimGroupIDs(ids...)
+	return _c
+}
+
+// AddScimGroups adds the "scim_groups" edges to the SCIMGroup entity.
+func (_c *UserCreate) AddScimGroups(v ...*SCIMGroup) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddScimGroupIDs(ids...)
+}
+
 // AddProjectUserIDs adds the "project_users" edge to the UserProject entity by IDs.
 func (_c *UserCreate) AddProjectUserIDs(ids ...int) *UserCreate {
 	_c.mutation.AddProjectUserIDs(ids...)
@@ -554,6 +570,22 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ScimGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.ScimGroupsTable,
+			Columns: user.ScimGroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.ProjectUsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -19,6 +19,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent/predicate"
 	"github.com/looplj/axonhub/internal/ent/project"
 	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/scimgroup"
 	"github.com/looplj/axonhub/internal/ent/user"
 	"github.com/looplj/axonhub/internal/ent/userproject"
 	"github.com/looplj/axonhub/internal/ent/userrole"
@@ -36,6 +37,7 @@ type UserQuery struct {
 	withRoles                         *RoleQuery
 	withChannelOverrideTemplates      *ChannelOverrideTemplateQuery
 	withOidcIdentities                *OIDCIdentityQuery
+	withScimGroups                    *SCIMGroupQuery
 	withProjectUsers                  *UserProjectQuery
 	withUserRoles                     *UserRoleQuery
 	loadTotal                         []func(context.Context, []*User) error
@@ -45,6 +47,7 @@ type UserQuery struct {
 	withNamedRoles                    map[string]*RoleQuery
 	withNamedChannelOverrideTemplates map[string]*ChannelOverrideTemplateQuery
 	withNamedOidcIdentities           map[string]*OIDCIdentityQuery
+	withNamedScimGroups               map[string]*SCIMGroupQuery
 	withNamedProjectUsers             map[string]*UserProjectQuery
 	withNamedUserRoles                map[string]*UserRoleQuery
 	// intermediate query (i.e. traversal path).
@@ -193,6 +196,28 @@ func (_q *UserQuery) QueryOidcIdentities() *OIDCIdentityQuery {
 	return query
 }
 
+// QueryScimGroups chains the current query on the "scim_groups" edge.
+func (_q *UserQuery) QueryScimGroups() *SCIMGroupQuery {
+	query := (&SCIMGroupClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(scimgroup.Table, scimgroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.ScimGroupsTable, user.ScimGroupsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryProjectUsers chains the current query on the "project_users" edge.
 func (_q *UserQuery) QueryProjectUsers() *UserProjectQuery {
 	query := (&UserProjectClient{config: _q.config}).Query()
@@ -434,6 +459,7 @@ func (_q *UserQuery) Clone() *UserQuery {
 		withRoles:                    _q.withRoles.Clone(),
 		withChannelOverrideTemplates: _q.withChannelOverrideTemplates.Clone(),
 		withOidcIdentities:           _q.withOidcIdentities.Clone(),
+		withScimGroups:               _q.withScimGroups.Clone(),
 		withProjectUsers:             _q.withProjectUsers.Clone(),
 		withUserRoles:                _q.withUserRoles.Clone(),
 		// clone intermediate query.
@@ -498,6 +524,17 @@ func (_q *UserQuery) WithOidcIdentities(opts ...func(*OIDCIdentityQuery)) *UserQ
 	return _q
 }
 
+// WithScimGroups tells the query-builder to eager-load the nodes that are connected to
+// the "scim_groups" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithScimGroups(opts ...func(*SCIMGroupQuery)) *UserQuery {
+	query := (&SCIMGroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withScimGroups = query
+	return _q
+}
+
 // WithProjectUsers tells the query-builder to eager-load the nodes that are connected to
 // the "project_users" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *UserQuery) WithProjectUsers(opts ...func(*UserProjectQuery)) *UserQuery {
@@ -604,12 +641,13 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [8]bool{
 			_q.withProjects != nil,
 			_q.withAPIKeys != nil,
 			_q.withRoles != nil,
 			_q.withChannelOverrideTemplates != nil,
 			_q.withOidcIdentities != nil,
+			_q.withScimGroups != nil,
 			_q.withProjectUsers != nil,
 			_q.withUserRoles != nil,
 		}
@@ -672,6 +710,13 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withScimGroups; query != nil {
+		if err := _q.loadScimGroups(ctx, query, nodes,
+			func(n *User) { n.Edges.ScimGroups = []*SCIMGroup{} },
+			func(n *User, e *SCIMGroup) { n.Edges.ScimGroups = append(n.Edges.ScimGroups, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withProjectUsers; query != nil {
 		if err := _q.loadProjectUsers(ctx, query, nodes,
 			func(n *User) { n.Edges.ProjectUsers = []*UserProject{} },
@@ -721,6 +766,13 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	for name, query := range _q.withNamedScimGroups {
+		if err := _q.loadScimGroups(ctx, query, nodes,
+			func(n *User) { n.appendNamedScimGroups(name) },
+			func(n *User, e *SCIMGroup) { n.appendNamedScimGroups(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range _q.withNamedProjectUsers {
 		if err := _q.loadProjectUsers(ctx, query, nodes,
 			func(n *User) { n.appendNamedProjectUsers(name) },
@@ -955,6 +1007,67 @@ func (_q *UserQuery) loadOidcIdentities(ctx context.Context, query *OIDCIdentity
 	}
 	return nil
 }
+func (_q *UserQuery) loadScimGroups(ctx context.Context, query *SCIMGroupQuery, nodes []*User, init func(*User), assign func(*User, *SCIMGroup)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User)
+	nids := make(map[int]map[*User]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(user.ScimGroupsTable)
+		s.Join(joinT).On(s.C(scimgroup.FieldID), joinT.C(user.ScimGroupsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(user.ScimGroupsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(user.ScimGroupsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*User]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*SCIMGroup](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "scim_groups" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 func (_q *UserQuery) loadProjectUsers(ctx context.Context, query *UserProjectQuery, nodes []*User, init func(*User), assign func(*User, *UserProject)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
@@ -1179,6 +1292,20 @@ func (_q *UserQuery) WithNamedOidcIdentities(name string, opts ...func(*OIDCIden
 	return _q
 }
 
+// WithNamedScimGroups tells the query-builder to eager-load the nodes that are connected to the "scim_groups"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithNamedScimGroups(name string, opts ...func(*SCIMGroupQuery)) *UserQuery {
+	query := (&SCIMGroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedScimGroups == nil {
+		_q.withNamedScimGroups = make(map[string]*SCIMGroupQuery)
+	}
+	_q.withNamedScimGroups[name] = query
+	return _q
+}
+
 // WithNamedProjectUsers tells the query-builder to eager-load the nodes that are connected to the "project_users"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (_q *UserQuery) WithNamedProjectUsers(name string, opts ...func(*UserProjectQuery)) *UserQuery {
@@ -18,6 +18,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent/predicate"
 	"github.com/looplj/axonhub/internal/ent/project"
 	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/scimgroup"
 	"github.com/looplj/axonhub/internal/ent/user"
 	"github.com/looplj/axonhub/internal/ent/userproject"
 	"github.com/looplj/axonhub/internal/ent/userrole"
@@ -275,6 +276,21 @@ func (_u *UserUpdate) AddOidcIdentities(v ...*OIDCIdentity) *UserUpdate {
 	return _u.AddOidcIdentityIDs(ids...)
 }
 
+// AddScimGroupIDs adds the "scim_groups" edge to the SCIMGroup entity by IDs.
+func (_u *UserUpdate) AddScimGroupIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddScimGroupIDs(ids...)
+	return _u
+}
+
+// AddScimGroups adds the "scim_groups" edges to the SCIMGroup entity.
+func (_u *UserUpdate) AddScimGroups(v ...*SCIMGroup) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScimGroupIDs(ids...)
+}
+
 // AddProjectUserIDs adds the "project_users" edge to the UserProject entity by IDs.
 func (_u *UserUpdate) AddProjectUserIDs(ids ...int) *UserUpdate {
 	_u.mutation.AddProjectUserIDs(ids...)
@@ -415,6 +431,27 @@ func (_u *UserUpdate) RemoveOidcIdentities(v ...*OIDCIdentity) *UserUpdate {
 	return _u.RemoveOidcIdentityIDs(ids...)
 }
 
+// ClearScimGroups clears all "scim_groups" edges to the SCIMGroup entity.
+func (_u *UserUpdate) ClearScimGroups() *UserUpdate {
+	_u.mutation.ClearScimGroups()
+	return _u
+}
+
+// RemoveScimGroupIDs removes the "scim_groups" edge to SCIMGroup entities by IDs.
+func (_u *UserUpdate) RemoveScimGroupIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveScimGroupIDs(ids...)
+	return _u
+}
+
+// RemoveScimGroups removes "scim_groups" edges to SCIMGroup entities.
+func (_u *UserUpdate) RemoveScimGroups(v ...*SCIMGroup) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScimGroupIDs(ids...)
+}
+
 // ClearProjectUsers clears all "project_users" edges to the UserProject entity.
 func (_u *UserUpdate) ClearProjectUsers() *UserUpdate {
 	_u.mutation.ClearProjectUsers()
@@ -823,6 +860,51 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ScimGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.ScimGroupsTable,
+			Columns: user.ScimGroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScimGroupsIDs(); len(nodes) > 0 && !_u.mutation.ScimGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.ScimGroupsTable,
+			Columns: user.ScimGroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScimGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.ScimGroupsTable,
+			Columns: user.ScimGroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ProjectUsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1173,6 +1255,21 @@ func (_u *UserUpdateOne) AddOidcIdentities(v ...*OIDCIdentity) *UserUpdateOne {
 	return _u.AddOidcIdentityIDs(ids...)
 }
 
+// AddScimGroupIDs adds the "scim_groups" edge to the SCIMGroup entity by IDs.
+func (_u *UserUpdateOne) AddScimGroupIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddScimGroupIDs(ids...)
+	return _u
+}
+
+// AddScimGroups adds the "scim_groups" edges to the SCIMGroup entity.
+func (_u *UserUpdateOne) AddScimGroups(v ...*SCIMGroup) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddScimGroupIDs(ids...)
+}
+
 // AddProjectUserIDs adds the "project_users" edge to the UserProject entity by IDs.
 func (_u *UserUpdateOne) AddProjectUserIDs(ids ...int) *UserUpdateOne {
 	_u.mutation.AddProjectUserIDs(ids...)
@@ -1313,6 +1410,27 @@ func (_u *UserUpdateOne) RemoveOidcIdentities(v ...*OIDCIdentity) *UserUpdateOne
 	return _u.RemoveOidcIdentityIDs(ids...)
 }
 
+// ClearScimGroups clears all "scim_groups" edges to the SCIMGroup entity.
+func (_u *UserUpdateOne) ClearScimGroups() *UserUpdateOne {
+	_u.mutation.ClearScimGroups()
+	return _u
+}
+
+// RemoveScimGroupIDs removes the "scim_groups" edge to SCIMGroup entities by IDs.
+func (_u *UserUpdateOne) RemoveScimGroupIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveScimGroupIDs(ids...)
+	return _u
+}
+
+// RemoveScimGroups removes "scim_groups" edges to SCIMGroup entities.
+func (_u *UserUpdateOne) RemoveScimGroups(v ...*SCIMGroup) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveScimGroupIDs(ids...)
+}
+
 // ClearProjectUsers clears all "project_users" edges to the UserProject entity.
 func (_u *UserUpdateOne) ClearProjectUsers() *UserUpdateOne {
 	_u.mutation.ClearProjectUsers()
@@ -1751,6 +1869,51 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ScimGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.ScimGroupsTable,
+			Columns: user.ScimGroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedScimGroupsIDs(); len(nodes) > 0 && !_u.mutation.ScimGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.ScimGroupsTable,
+			Columns: user.ScimGroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScimGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.ScimGroupsTable,
+			Columns: user.ScimGroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scimgroup.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ProjectUsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/wallet"
+)
+
+// Wallet is the model entity for the Wallet schema.
+type Wallet struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// API key ID that this wallet belongs to
+	APIKeyID int `json:"api_key_id,omitempty"`
+	// Project ID of the API key
+	ProjectID int `json:"project_id,omitempty"`
+	// Credit balance, including the reserved credit, in the system currency
+	Balance float64 `json:"balance,omitempty"`
+	// Credit reserved by the requests in progress
+	Reserved float64 `json:"reserved,omitempty"`
+	// The balance is only checked and debited when the wallet is enabled
+	Status wallet.Status `json:"status,omitempty"`
+	// The wallet.low_balance event is sent when the balance falls below the threshold
+	LowBalanceThreshold *float64 `json:"low_balance_threshold,omitempty"`
+	// Whether the low balance event has been sent since the balance fell below the threshold
+	LowBalanceAlerted bool `json:"low_balance_alerted,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WalletQuery when eager-loading is set.
+	Edges        WalletEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WalletEdges holds the relations/edges for other nodes in the graph.
+type WalletEdges struct {
+	// APIKey holds the value of the api_key edge.
+	APIKey *APIKey `json:"api_key,omitempty"`
+	// Transactions holds the value of the transactions edge.
+	Transactions []*WalletTransaction `json:"transactions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedTransactions map[string][]*WalletTransaction
+}
+
+// APIKeyOrErr returns the APIKey value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WalletEdges) APIKeyOrErr() (*APIKey, error) {
+	if e.APIKey != nil {
+		return e.APIKey, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: apikey.Label}
+	}
+	return nil, &NotLoadedError{edge: "api_key"}
+}
+
+// TransactionsOrErr returns the Transactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e WalletEdges) TransactionsOrErr() ([]*WalletTransaction, error) {
+	if e.loadedTypes[1] {
+		return e.Transactions, nil
+	}
+	return nil, &NotLoadedError{edge: "transactions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Wallet) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wallet.FieldLowBalanceAlerted:
+			values[i] = new(sql.NullBool)
+		case wallet.FieldBalance, wallet.FieldReserved, wallet.FieldLowBalanceThreshold:
+			values[i] = new(sql.NullFloat64)
+		case wallet.FieldID, wallet.FieldAPIKeyID, wallet.FieldProjectID:
+			values[i] = new(sql.NullInt64)
+		case wallet.FieldStatus:
+			values[i] = new(sql.NullString)
+		case wallet.FieldCreatedAt, wallet.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Wallet fields.
+func (_m *Wallet) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wallet.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case wallet.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case wallet.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case wallet.FieldAPIKeyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field api_key_id", values[i])
+			} else if value.Valid {
+				_m.APIKeyID = int(value.Int64)
+			}
+		case wallet.FieldProjectID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value.Valid {
+				_m.ProjectID = int(value.Int64)
+			}
+		case wallet.FieldBalance:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value.Valid {
+				_m.Balance = value.Float64
+			}
+		case wallet.FieldReserved:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field reserved", values[i])
+			} else if value.Valid {
+				_m.Reserved = value.Float64
+			}
+		case wallet.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = wallet.Status(value.String)
+			}
+		case wallet.FieldLowBalanceThreshold:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field low_balance_threshold", values[i])
+			} else if value.Valid {
+				_m.LowBalanceThreshold = new(float64)
+				*_m.LowBalanceThreshold = value.Float64
+			}
+		case wallet.FieldLowBalanceAlerted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field low_balance_alerted", values[i])
+			} else if value.Valid {
+				_m.LowBalanceAlerted = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Wallet.
+// This includes values selected through modifiers, order, etc.
+func (_m *Wallet) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAPIKey queries the "api_key" edge of the Wallet entity.
+func (_m *Wallet) QueryAPIKey() *APIKeyQuery {
+	return NewWalletClient(_m.config).QueryAPIKey(_m)
+}
+
+// QueryTransactions queries the "transactions" edge of the Wallet entity.
+func (_m *Wallet) QueryTransactions() *WalletTransactionQuery {
+	return NewWalletClient(_m.config).QueryTransactions(_m)
+}
+
+// Update returns a builder for updating this Wallet.
+// Note that you need to call Wallet.Unwrap() before calling this method if this Wallet
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Wallet) Update() *WalletUpdateOne {
+	return NewWalletClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Wallet entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Wallet) Unwrap() *Wallet {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Wallet is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Wallet) String() string {
+	var builder strings.Builder
+	builder.WriteString("Wallet(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("api_key_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.APIKeyID))
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Balance))
+	builder.WriteString(", ")
+	builder.WriteString("reserved=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Reserved))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.LowBalanceThreshold; v != nil {
+		builder.WriteString("low_balance_threshold=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("low_balance_alerted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LowBalanceAlerted))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedTransactions returns the Transactions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Wallet) NamedTransactions(name string) ([]*WalletTransaction, error) {
+	if _m.Edges.namedTransactions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedTransactions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Wallet) appendNamedTransactions(name string, edges ...*WalletTransaction) {
+	if _m.Edges.namedTransactions == nil {
+		_m.Edges.namedTransactions = make(map[string][]*WalletTransaction)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedTransactions[name] = []*WalletTransaction{}
+	} else {
+		_m.Edges.namedTransactions[name] = append(_m.Edges.namedTransactions[name], edges...)
+	}
+}
+
+// Wallets is a parsable slice of Wallet.
+type Wallets []*Wallet
@@ -0,0 +1,457 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wallet
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// APIKeyID applies equality check predicate on the "api_key_id" field. It's identical to APIKeyIDEQ.
+func APIKeyID(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldAPIKeyID, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldProjectID, v))
+}
+
+// Balance applies equality check predicate on the "balance" field. It's identical to BalanceEQ.
+func Balance(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldBalance, v))
+}
+
+// Reserved applies equality check predicate on the "reserved" field. It's identical to ReservedEQ.
+func Reserved(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldReserved, v))
+}
+
+// LowBalanceThreshold applies equality check predicate on the "low_balance_threshold" field. It's identical to LowBalanceThresholdEQ.
+func LowBalanceThreshold(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldLowBalanceThreshold, v))
+}
+
+// LowBalanceAlerted applies equality check predicate on the "low_balance_alerted" field. It's identical to LowBalanceAlertedEQ.
+func LowBalanceAlerted(v bool) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldLowBalanceAlerted, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// APIKeyIDEQ applies the EQ predicate on the "api_key_id" field.
+func APIKeyIDEQ(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldAPIKeyID, v))
+}
+
+// APIKeyIDNEQ applies the NEQ predicate on the "api_key_id" field.
+func APIKeyIDNEQ(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldAPIKeyID, v))
+}
+
+// APIKeyIDIn applies the In predicate on the "api_key_id" field.
+func APIKeyIDIn(vs ...int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldAPIKeyID, vs...))
+}
+
+// APIKeyIDNotIn applies the NotIn predicate on the "api_key_id" field.
+func APIKeyIDNotIn(vs ...int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldAPIKeyID, vs...))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDGT applies the GT predicate on the "project_id" field.
+func ProjectIDGT(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldProjectID, v))
+}
+
+// ProjectIDGTE applies the GTE predicate on the "project_id" field.
+func ProjectIDGTE(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldProjectID, v))
+}
+
+// ProjectIDLT applies the LT predicate on the "project_id" field.
+func ProjectIDLT(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldProjectID, v))
+}
+
+// ProjectIDLTE applies the LTE predicate on the "project_id" field.
+func ProjectIDLTE(v int) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldProjectID, v))
+}
+
+// BalanceEQ applies the EQ predicate on the "balance" field.
+func BalanceEQ(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldBalance, v))
+}
+
+// BalanceNEQ applies the NEQ predicate on the "balance" field.
+func BalanceNEQ(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldBalance, v))
+}
+
+// BalanceIn applies the In predicate on the "balance" field.
+func BalanceIn(vs ...float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldBalance, vs...))
+}
+
+// BalanceNotIn applies the NotIn predicate on the "balance" field.
+func BalanceNotIn(vs ...float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldBalance, vs...))
+}
+
+// BalanceGT applies the GT predicate on the "balance" field.
+func BalanceGT(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldBalance, v))
+}
+
+// BalanceGTE applies the GTE predicate on the "balance" field.
+func BalanceGTE(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldBalance, v))
+}
+
+// BalanceLT applies the LT predicate on the "balance" field.
+func BalanceLT(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldBalance, v))
+}
+
+// BalanceLTE applies the LTE predicate on the "balance" field.
+func BalanceLTE(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldBalance, v))
+}
+
+// ReservedEQ applies the EQ predicate on the "reserved" field.
+func ReservedEQ(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldReserved, v))
+}
+
+// ReservedNEQ applies the NEQ predicate on the "reserved" field.
+func ReservedNEQ(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldReserved, v))
+}
+
+// ReservedIn applies the In predicate on the "reserved" field.
+func ReservedIn(vs ...float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldReserved, vs...))
+}
+
+// ReservedNotIn applies the NotIn predicate on the "reserved" field.
+func ReservedNotIn(vs ...float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldReserved, vs...))
+}
+
+// ReservedGT applies the GT predicate on the "reserved" field.
+func ReservedGT(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldReserved, v))
+}
+
+// ReservedGTE applies the GTE predicate on the "reserved" field.
+func ReservedGTE(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldReserved, v))
+}
+
+// ReservedLT applies the LT predicate on the "reserved" field.
+func ReservedLT(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldReserved, v))
+}
+
+// ReservedLTE applies the LTE predicate on the "reserved" field.
+func ReservedLTE(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldReserved, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// LowBalanceThresholdEQ applies the EQ predicate on the "low_balance_threshold" field.
+func LowBalanceThresholdEQ(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldLowBalanceThreshold, v))
+}
+
+// LowBalanceThresholdNEQ applies the NEQ predicate on the "low_balance_threshold" field.
+func LowBalanceThresholdNEQ(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldLowBalanceThreshold, v))
+}
+
+// LowBalanceThresholdIn applies the In predicate on the "low_balance_threshold" field.
+func LowBalanceThresholdIn(vs ...float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldIn(FieldLowBalanceThreshold, vs...))
+}
+
+// LowBalanceThresholdNotIn applies the NotIn predicate on the "low_balance_threshold" field.
+func LowBalanceThresholdNotIn(vs ...float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotIn(FieldLowBalanceThreshold, vs...))
+}
+
+// LowBalanceThresholdGT applies the GT predicate on the "low_balance_threshold" field.
+func LowBalanceThresholdGT(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGT(FieldLowBalanceThreshold, v))
+}
+
+// LowBalanceThresholdGTE applies the GTE predicate on the "low_balance_threshold" field.
+func LowBalanceThresholdGTE(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldGTE(FieldLowBalanceThreshold, v))
+}
+
+// LowBalanceThresholdLT applies the LT predicate on the "low_balance_threshold" field.
+func LowBalanceThresholdLT(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLT(FieldLowBalanceThreshold, v))
+}
+
+// LowBalanceThresholdLTE applies the LTE predicate on the "low_balance_threshold" field.
+func LowBalanceThresholdLTE(v float64) predicate.Wallet {
+	return predicate.Wallet(sql.FieldLTE(FieldLowBalanceThreshold, v))
+}
+
+// LowBalanceThresholdIsNil applies the IsNil predicate on the "low_balance_threshold" field.
+func LowBalanceThresholdIsNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldIsNull(FieldLowBalanceThreshold))
+}
+
+// LowBalanceThresholdNotNil applies the NotNil predicate on the "low_balance_threshold" field.
+func LowBalanceThresholdNotNil() predicate.Wallet {
+	return predicate.Wallet(sql.FieldNotNull(FieldLowBalanceThreshold))
+}
+
+// LowBalanceAlertedEQ applies the EQ predicate on the "low_balance_alerted" field.
+func LowBalanceAlertedEQ(v bool) predicate.Wallet {
+	return predicate.Wallet(sql.FieldEQ(FieldLowBalanceAlerted, v))
+}
+
+// LowBalanceAlertedNEQ applies the NEQ predicate on the "low_balance_alerted" field.
+func LowBalanceAlertedNEQ(v bool) predicate.Wallet {
+	return predicate.Wallet(sql.FieldNEQ(FieldLowBalanceAlerted, v))
+}
+
+// HasAPIKey applies the HasEdge predicate on the "api_key" edge.
+func HasAPIKey() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, APIKeyTable, APIKeyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAPIKeyWith applies the HasEdge predicate on the "api_key" edge with a given conditions (other predicates).
+func HasAPIKeyWith(preds ...predicate.APIKey) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := newAPIKeyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransactions applies the HasEdge predicate on the "transactions" edge.
+func HasTransactions() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TransactionsTable, TransactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransactionsWith applies the HasEdge predicate on the "transactions" edge with a given conditions (other predicates).
+func HasTransactionsWith(preds ...predicate.WalletTransaction) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := newTransactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Wallet) predicate.Wallet {
+	return predicate.Wallet(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Wallet) predicate.Wallet {
+	return predicate.Wallet(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Wallet) predicate.Wallet {
+	return predicate.Wallet(sql.NotPredicates(p))
+}
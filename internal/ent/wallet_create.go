@@ -0,0 +1,1008 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/wallet"
+	"github.com/looplj/axonhub/internal/ent/wallettransaction"
+)
+
+// WalletCreate is the builder for creating a Wallet entity.
+type WalletCreate struct {
+	config
+	mutation *WalletMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WalletCreate) SetCreatedAt(v time.Time) *WalletCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableCreatedAt(v *time.Time) *WalletCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WalletCreate) SetUpdatedAt(v time.Time) *WalletCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableUpdatedAt(v *time.Time) *WalletCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_c *WalletCreate) SetAPIKeyID(v int) *WalletCreate {
+	_c.mutation.SetAPIKeyID(v)
+	return _c
+}
+
+// SetProjectID sets the "project_id" field.
+func (_c *WalletCreate) SetProjectID(v int) *WalletCreate {
+	_c.mutation.SetProjectID(v)
+	return _c
+}
+
+// SetBalance sets the "balance" field.
+func (_c *WalletCreate) SetBalance(v float64) *WalletCreate {
+	_c.mutation.SetBalance(v)
+	return _c
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableBalance(v *float64) *WalletCreate {
+	if v != nil {
+		_c.SetBalance(*v)
+	}
+	return _c
+}
+
+// SetReserved sets the "reserved" field.
+func (_c *WalletCreate) SetReserved(v float64) *WalletCreate {
+	_c.mutation.SetReserved(v)
+	return _c
+}
+
+// SetNillableReserved sets the "reserved" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableReserved(v *float64) *WalletCreate {
+	if v != nil {
+		_c.SetReserved(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *WalletCreate) SetStatus(v wallet.Status) *WalletCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableStatus(v *wallet.Status) *WalletCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetLowBalanceThreshold sets the "low_balance_threshold" field.
+func (_c *WalletCreate) SetLowBalanceThreshold(v float64) *WalletCreate {
+	_c.mutation.SetLowBalanceThreshold(v)
+	return _c
+}
+
+// SetNillableLowBalanceThreshold sets the "low_balance_threshold" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableLowBalanceThreshold(v *float64) *WalletCreate {
+	if v != nil {
+		_c.SetLowBalanceThreshold(*v)
+	}
+	return _c
+}
+
+// SetLowBalanceAlerted sets the "low_balance_alerted" field.
+func (_c *WalletCreate) SetLowBalanceAlerted(v bool) *WalletCreate {
+	_c.mutation.SetLowBalanceAlerted(v)
+	return _c
+}
+
+// SetNillableLowBalanceAlerted sets the "low_balance_alerted" field if the given value is not nil.
+func (_c *WalletCreate) SetNillableLowBalanceAlerted(v *bool) *WalletCreate {
+	if v != nil {
+		_c.SetLowBalanceAlerted(*v)
+	}
+	return _c
+}
+
+// SetAPIKey sets the "api_key" edge to the APIKey entity.
+func (_c *WalletCreate) SetAPIKey(v *APIKey) *WalletCreate {
+	return _c.SetAPIKeyID(v.ID)
+}
+
+// AddTransactionIDs adds the "transactions" edge to the WalletTransaction entity by IDs.
+func (_c *WalletCreate) AddTransactionIDs(ids ...int) *WalletCreate {
+	_c.mutation.AddTransactionIDs(ids...)
+	return _c
+}
+
+// AddTransactions adds the "transactions" edges to the WalletTransaction entity.
+func (_c *WalletCreate) AddTransactions(v ...*WalletTransaction) *WalletCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the WalletMutation object of the builder.
+func (_c *WalletCreate) Mutation() *WalletMutation {
+	return _c.mutation
+}
+
+// Save creates the Wallet in the database.
+func (_c *WalletCreate) Save(ctx context.Context) (*Wallet, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WalletCreate) SaveX(ctx context.Context) *Wallet {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WalletCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WalletCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WalletCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if wallet.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized wallet.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := wallet.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if wallet.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized wallet.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := wallet.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Balance(); !ok {
+		v := wallet.DefaultBalance
+		_c.mutation.SetBalance(v)
+	}
+	if _, ok := _c.mutation.Reserved(); !ok {
+		v := wallet.DefaultReserved
+		_c.mutation.SetReserved(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := wallet.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.LowBalanceAlerted(); !ok {
+		v := wallet.DefaultLowBalanceAlerted
+		_c.mutation.SetLowBalanceAlerted(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WalletCreate) check() error {
+	if _, ok := _c.mutation.APIKeyID(); !ok {
+		return &ValidationError{Name: "api_key_id", err: errors.New(`ent: missing required field "Wallet.api_key_id"`)}
+	}
+	if _, ok := _c.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "Wallet.project_id"`)}
+	}
+	if _, ok := _c.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "Wallet.balance"`)}
+	}
+	if _, ok := _c.mutation.Reserved(); !ok {
+		return &ValidationError{Name: "reserved", err: errors.New(`ent: missing required field "Wallet.reserved"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Wallet.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := wallet.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Wallet.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LowBalanceAlerted(); !ok {
+		return &ValidationError{Name: "low_balance_alerted", err: errors.New(`ent: missing required field "Wallet.low_balance_alerted"`)}
+	}
+	if len(_c.mutation.APIKeyIDs()) == 0 {
+		return &ValidationError{Name: "api_key", err: errors.New(`ent: missing required edge "Wallet.api_key"`)}
+	}
+	return nil
+}
+
+func (_c *WalletCreate) sqlSave(ctx context.Context) (*Wallet, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WalletCreate) createSpec() (*Wallet, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Wallet{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(wallet.Table, sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(wallet.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallet.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.ProjectID(); ok {
+		_spec.SetField(wallet.FieldProjectID, field.TypeInt, value)
+		_node.ProjectID = value
+	}
+	if value, ok := _c.mutation.Balance(); ok {
+		_spec.SetField(wallet.FieldBalance, field.TypeFloat64, value)
+		_node.Balance = value
+	}
+	if value, ok := _c.mutation.Reserved(); ok {
+		_spec.SetField(wallet.FieldReserved, field.TypeFloat64, value)
+		_node.Reserved = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(wallet.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.LowBalanceThreshold(); ok {
+		_spec.SetField(wallet.FieldLowBalanceThreshold, field.TypeFloat64, value)
+		_node.LowBalanceThreshold = &value
+	}
+	if value, ok := _c.mutation.LowBalanceAlerted(); ok {
+		_spec.SetField(wallet.FieldLowBalanceAlerted, field.TypeBool, value)
+		_node.LowBalanceAlerted = value
+	}
+	if nodes := _c.mutation.APIKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   wallet.APIKeyTable,
+			Columns: []string{wallet.APIKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.APIKeyID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.TransactionsTable,
+			Columns: []string{wallet.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Wallet.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WalletUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WalletCreate) OnConflict(opts ...sql.ConflictOption) *WalletUpsertOne {
+	_c.conflict = opts
+	return &WalletUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WalletCreate) OnConflictColumns(columns ...string) *WalletUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WalletUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WalletUpsertOne is the builder for "upsert"-ing
+	//  one Wallet node.
+	WalletUpsertOne struct {
+		create *WalletCreate
+	}
+
+	// WalletUpsert is the "OnConflict" setter.
+	WalletUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletUpsert) SetUpdatedAt(v time.Time) *WalletUpsert {
+	u.Set(wallet.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletUpsert) UpdateUpdatedAt() *WalletUpsert {
+	u.SetExcluded(wallet.FieldUpdatedAt)
+	return u
+}
+
+// SetBalance sets the "balance" field.
+func (u *WalletUpsert) SetBalance(v float64) *WalletUpsert {
+	u.Set(wallet.FieldBalance, v)
+	return u
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *WalletUpsert) UpdateBalance() *WalletUpsert {
+	u.SetExcluded(wallet.FieldBalance)
+	return u
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *WalletUpsert) AddBalance(v float64) *WalletUpsert {
+	u.Add(wallet.FieldBalance, v)
+	return u
+}
+
+// SetReserved sets the "reserved" field.
+func (u *WalletUpsert) SetReserved(v float64) *WalletUpsert {
+	u.Set(wallet.FieldReserved, v)
+	return u
+}
+
+// UpdateReserved sets the "reserved" field to the value that was provided on create.
+func (u *WalletUpsert) UpdateReserved() *WalletUpsert {
+	u.SetExcluded(wallet.FieldReserved)
+	return u
+}
+
+// AddReserved adds v to the "reserved" field.
+func (u *WalletUpsert) AddReserved(v float64) *WalletUpsert {
+	u.Add(wallet.FieldReserved, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *WalletUpsert) SetStatus(v wallet.Status) *WalletUpsert {
+	u.Set(wallet.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WalletUpsert) UpdateStatus() *WalletUpsert {
+	u.SetExcluded(wallet.FieldStatus)
+	return u
+}
+
+// SetLowBalanceThreshold sets the "low_balance_threshold" field.
+func (u *WalletUpsert) SetLowBalanceThreshold(v float64) *WalletUpsert {
+	u.Set(wallet.FieldLowBalanceThreshold, v)
+	return u
+}
+
+// UpdateLowBalanceThreshold sets the "low_balance_threshold" field to the value that was provided on create.
+func (u *WalletUpsert) UpdateLowBalanceThreshold() *WalletUpsert {
+	u.SetExcluded(wallet.FieldLowBalanceThreshold)
+	return u
+}
+
+// AddLowBalanceThreshold adds v to the "low_balance_threshold" field.
+func (u *WalletUpsert) AddLowBalanceThreshold(v float64) *WalletUpsert {
+	u.Add(wallet.FieldLowBalanceThreshold, v)
+	return u
+}
+
+// ClearLowBalanceThreshold clears the value of the "low_balance_threshold" field.
+func (u *WalletUpsert) ClearLowBalanceThreshold() *WalletUpsert {
+	u.SetNull(wallet.FieldLowBalanceThreshold)
+	return u
+}
+
+// SetLowBalanceAlerted sets the "low_balance_alerted" field.
+func (u *WalletUpsert) SetLowBalanceAlerted(v bool) *WalletUpsert {
+	u.Set(wallet.FieldLowBalanceAlerted, v)
+	return u
+}
+
+// UpdateLowBalanceAlerted sets the "low_balance_alerted" field to the value that was provided on create.
+func (u *WalletUpsert) UpdateLowBalanceAlerted() *WalletUpsert {
+	u.SetExcluded(wallet.FieldLowBalanceAlerted)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WalletUpsertOne) UpdateNewValues() *WalletUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(wallet.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.APIKeyID(); exists {
+			s.SetIgnore(wallet.FieldAPIKeyID)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(wallet.FieldProjectID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WalletUpsertOne) Ignore() *WalletUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WalletUpsertOne) DoNothing() *WalletUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WalletCreate.OnConflict
+// documentation for more info.
+func (u *WalletUpsertOne) Update(set func(*WalletUpsert)) *WalletUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WalletUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletUpsertOne) SetUpdatedAt(v time.Time) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletUpsertOne) UpdateUpdatedAt() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *WalletUpsertOne) SetBalance(v float64) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *WalletUpsertOne) AddBalance(v float64) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *WalletUpsertOne) UpdateBalance() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetReserved sets the "reserved" field.
+func (u *WalletUpsertOne) SetReserved(v float64) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetReserved(v)
+	})
+}
+
+// AddReserved adds v to the "reserved" field.
+func (u *WalletUpsertOne) AddReserved(v float64) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.AddReserved(v)
+	})
+}
+
+// UpdateReserved sets the "reserved" field to the value that was provided on create.
+func (u *WalletUpsertOne) UpdateReserved() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateReserved()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WalletUpsertOne) SetStatus(v wallet.Status) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WalletUpsertOne) UpdateStatus() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetLowBalanceThreshold sets the "low_balance_threshold" field.
+func (u *WalletUpsertOne) SetLowBalanceThreshold(v float64) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetLowBalanceThreshold(v)
+	})
+}
+
+// AddLowBalanceThreshold adds v to the "low_balance_threshold" field.
+func (u *WalletUpsertOne) AddLowBalanceThreshold(v float64) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.AddLowBalanceThreshold(v)
+	})
+}
+
+// UpdateLowBalanceThreshold sets the "low_balance_threshold" field to the value that was provided on create.
+func (u *WalletUpsertOne) UpdateLowBalanceThreshold() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateLowBalanceThreshold()
+	})
+}
+
+// ClearLowBalanceThreshold clears the value of the "low_balance_threshold" field.
+func (u *WalletUpsertOne) ClearLowBalanceThreshold() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.ClearLowBalanceThreshold()
+	})
+}
+
+// SetLowBalanceAlerted sets the "low_balance_alerted" field.
+func (u *WalletUpsertOne) SetLowBalanceAlerted(v bool) *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetLowBalanceAlerted(v)
+	})
+}
+
+// UpdateLowBalanceAlerted sets the "low_balance_alerted" field to the value that was provided on create.
+func (u *WalletUpsertOne) UpdateLowBalanceAlerted() *WalletUpsertOne {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateLowBalanceAlerted()
+	})
+}
+
+// Exec executes the query.
+func (u *WalletUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WalletCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WalletUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WalletUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WalletUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WalletCreateBulk is the builder for creating many Wallet entities in bulk.
+type WalletCreateBulk struct {
+	config
+	err      error
+	builders []*WalletCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Wallet entities in the database.
+func (_c *WalletCreateBulk) Save(ctx context.Context) ([]*Wallet, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Wallet, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WalletMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WalletCreateBulk) SaveX(ctx context.Context) []*Wallet {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WalletCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WalletCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Wallet.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WalletUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WalletCreateBulk) OnConflict(opts ...sql.ConflictOption) *WalletUpsertBulk {
+	_c.conflict = opts
+	return &WalletUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WalletCreateBulk) OnConflictColumns(columns ...string) *WalletUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WalletUpsertBulk{
+		create: _c,
+	}
+}
+
+// WalletUpsertBulk is the builder for "upsert"-ing
+// a bulk of Wallet nodes.
+type WalletUpsertBulk struct {
+	create *WalletCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WalletUpsertBulk) UpdateNewValues() *WalletUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(wallet.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.APIKeyID(); exists {
+				s.SetIgnore(wallet.FieldAPIKeyID)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(wallet.FieldProjectID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Wallet.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WalletUpsertBulk) Ignore() *WalletUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WalletUpsertBulk) DoNothing() *WalletUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WalletCreateBulk.OnConflict
+// documentation for more info.
+func (u *WalletUpsertBulk) Update(set func(*WalletUpsert)) *WalletUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WalletUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletUpsertBulk) SetUpdatedAt(v time.Time) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletUpsertBulk) UpdateUpdatedAt() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *WalletUpsertBulk) SetBalance(v float64) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *WalletUpsertBulk) AddBalance(v float64) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *WalletUpsertBulk) UpdateBalance() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// SetReserved sets the "reserved" field.
+func (u *WalletUpsertBulk) SetReserved(v float64) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetReserved(v)
+	})
+}
+
+// AddReserved adds v to the "reserved" field.
+func (u *WalletUpsertBulk) AddReserved(v float64) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.AddReserved(v)
+	})
+}
+
+// UpdateReserved sets the "reserved" field to the value that was provided on create.
+func (u *WalletUpsertBulk) UpdateReserved() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateReserved()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WalletUpsertBulk) SetStatus(v wallet.Status) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WalletUpsertBulk) UpdateStatus() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetLowBalanceThreshold sets the "low_balance_threshold" field.
+func (u *WalletUpsertBulk) SetLowBalanceThreshold(v float64) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetLowBalanceThreshold(v)
+	})
+}
+
+// AddLowBalanceThreshold adds v to the "low_balance_threshold" field.
+func (u *WalletUpsertBulk) AddLowBalanceThreshold(v float64) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.AddLowBalanceThreshold(v)
+	})
+}
+
+// UpdateLowBalanceThreshold sets the "low_balance_threshold" field to the value that was provided on create.
+func (u *WalletUpsertBulk) UpdateLowBalanceThreshold() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateLowBalanceThreshold()
+	})
+}
+
+// ClearLowBalanceThreshold clears the value of the "low_balance_threshold" field.
+func (u *WalletUpsertBulk) ClearLowBalanceThreshold() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.ClearLowBalanceThreshold()
+	})
+}
+
+// SetLowBalanceAlerted sets the "low_balance_alerted" field.
+func (u *WalletUpsertBulk) SetLowBalanceAlerted(v bool) *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.SetLowBalanceAlerted(v)
+	})
+}
+
+// UpdateLowBalanceAlerted sets the "low_balance_alerted" field to the value that was provided on create.
+func (u *WalletUpsertBulk) UpdateLowBalanceAlerted() *WalletUpsertBulk {
+	return u.Update(func(s *WalletUpsert) {
+		s.UpdateLowBalanceAlerted()
+	})
+}
+
+// Exec executes the query.
+func (u *WalletUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WalletCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WalletCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WalletUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,683 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/ent/wallet"
+	"github.com/looplj/axonhub/internal/ent/wallettransaction"
+)
+
+// WalletUpdate is the builder for updating Wallet entities.
+type WalletUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WalletMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WalletUpdate builder.
+func (_u *WalletUpdate) Where(ps ...predicate.Wallet) *WalletUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WalletUpdate) SetUpdatedAt(v time.Time) *WalletUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *WalletUpdate) SetBalance(v float64) *WalletUpdate {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableBalance(v *float64) *WalletUpdate {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *WalletUpdate) AddBalance(v float64) *WalletUpdate {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// SetReserved sets the "reserved" field.
+func (_u *WalletUpdate) SetReserved(v float64) *WalletUpdate {
+	_u.mutation.ResetReserved()
+	_u.mutation.SetReserved(v)
+	return _u
+}
+
+// SetNillableReserved sets the "reserved" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableReserved(v *float64) *WalletUpdate {
+	if v != nil {
+		_u.SetReserved(*v)
+	}
+	return _u
+}
+
+// AddReserved adds value to the "reserved" field.
+func (_u *WalletUpdate) AddReserved(v float64) *WalletUpdate {
+	_u.mutation.AddReserved(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WalletUpdate) SetStatus(v wallet.Status) *WalletUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableStatus(v *wallet.Status) *WalletUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLowBalanceThreshold sets the "low_balance_threshold" field.
+func (_u *WalletUpdate) SetLowBalanceThreshold(v float64) *WalletUpdate {
+	_u.mutation.ResetLowBalanceThreshold()
+	_u.mutation.SetLowBalanceThreshold(v)
+	return _u
+}
+
+// SetNillableLowBalanceThreshold sets the "low_balance_threshold" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableLowBalanceThreshold(v *float64) *WalletUpdate {
+	if v != nil {
+		_u.SetLowBalanceThreshold(*v)
+	}
+	return _u
+}
+
+// AddLowBalanceThreshold adds value to the "low_balance_threshold" field.
+func (_u *WalletUpdate) AddLowBalanceThreshold(v float64) *WalletUpdate {
+	_u.mutation.AddLowBalanceThreshold(v)
+	return _u
+}
+
+// ClearLowBalanceThreshold clears the value of the "low_balance_threshold" field.
+func (_u *WalletUpdate) ClearLowBalanceThreshold() *WalletUpdate {
+	_u.mutation.ClearLowBalanceThreshold()
+	return _u
+}
+
+// SetLowBalanceAlerted sets the "low_balance_alerted" field.
+func (_u *WalletUpdate) SetLowBalanceAlerted(v bool) *WalletUpdate {
+	_u.mutation.SetLowBalanceAlerted(v)
+	return _u
+}
+
+// SetNillableLowBalanceAlerted sets the "low_balance_alerted" field if the given value is not nil.
+func (_u *WalletUpdate) SetNillableLowBalanceAlerted(v *bool) *WalletUpdate {
+	if v != nil {
+		_u.SetLowBalanceAlerted(*v)
+	}
+	return _u
+}
+
+// AddTransactionIDs adds the "transactions" edge to the WalletTransaction entity by IDs.
+func (_u *WalletUpdate) AddTransactionIDs(ids ...int) *WalletUpdate {
+	_u.mutation.AddTransactionIDs(ids...)
+	return _u
+}
+
+// AddTransactions adds the "transactions" edges to the WalletTransaction entity.
+func (_u *WalletUpdate) AddTransactions(v ...*WalletTransaction) *WalletUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the WalletMutation object of the builder.
+func (_u *WalletUpdate) Mutation() *WalletMutation {
+	return _u.mutation
+}
+
+// ClearTransactions clears all "transactions" edges to the WalletTransaction entity.
+func (_u *WalletUpdate) ClearTransactions() *WalletUpdate {
+	_u.mutation.ClearTransactions()
+	return _u
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to WalletTransaction entities by IDs.
+func (_u *WalletUpdate) RemoveTransactionIDs(ids ...int) *WalletUpdate {
+	_u.mutation.RemoveTransactionIDs(ids...)
+	return _u
+}
+
+// RemoveTransactions removes "transactions" edges to WalletTransaction entities.
+func (_u *WalletUpdate) RemoveTransactions(v ...*WalletTransaction) *WalletUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransactionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WalletUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WalletUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WalletUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WalletUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WalletUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if wallet.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized wallet.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := wallet.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WalletUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := wallet.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Wallet.status": %w`, err)}
+		}
+	}
+	if _u.mutation.APIKeyCleared() && len(_u.mutation.APIKeyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Wallet.api_key"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WalletUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WalletUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *WalletUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wallet.Table, wallet.Columns, sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallet.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(wallet.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(wallet.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Reserved(); ok {
+		_spec.SetField(wallet.FieldReserved, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedReserved(); ok {
+		_spec.AddField(wallet.FieldReserved, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(wallet.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LowBalanceThreshold(); ok {
+		_spec.SetField(wallet.FieldLowBalanceThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLowBalanceThreshold(); ok {
+		_spec.AddField(wallet.FieldLowBalanceThreshold, field.TypeFloat64, value)
+	}
+	if _u.mutation.LowBalanceThresholdCleared() {
+		_spec.ClearField(wallet.FieldLowBalanceThreshold, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.LowBalanceAlerted(); ok {
+		_spec.SetField(wallet.FieldLowBalanceAlerted, field.TypeBool, value)
+	}
+	if _u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.TransactionsTable,
+			Columns: []string{wallet.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !_u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.TransactionsTable,
+			Columns: []string{wallet.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.TransactionsTable,
+			Columns: []string{wallet.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WalletUpdateOne is the builder for updating a single Wallet entity.
+type WalletUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WalletMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WalletUpdateOne) SetUpdatedAt(v time.Time) *WalletUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetBalance sets the "balance" field.
+func (_u *WalletUpdateOne) SetBalance(v float64) *WalletUpdateOne {
+	_u.mutation.ResetBalance()
+	_u.mutation.SetBalance(v)
+	return _u
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableBalance(v *float64) *WalletUpdateOne {
+	if v != nil {
+		_u.SetBalance(*v)
+	}
+	return _u
+}
+
+// AddBalance adds value to the "balance" field.
+func (_u *WalletUpdateOne) AddBalance(v float64) *WalletUpdateOne {
+	_u.mutation.AddBalance(v)
+	return _u
+}
+
+// SetReserved sets the "reserved" field.
+func (_u *WalletUpdateOne) SetReserved(v float64) *WalletUpdateOne {
+	_u.mutation.ResetReserved()
+	_u.mutation.SetReserved(v)
+	return _u
+}
+
+// SetNillableReserved sets the "reserved" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableReserved(v *float64) *WalletUpdateOne {
+	if v != nil {
+		_u.SetReserved(*v)
+	}
+	return _u
+}
+
+// AddReserved adds value to the "reserved" field.
+func (_u *WalletUpdateOne) AddReserved(v float64) *WalletUpdateOne {
+	_u.mutation.AddReserved(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WalletUpdateOne) SetStatus(v wallet.Status) *WalletUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableStatus(v *wallet.Status) *WalletUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLowBalanceThreshold sets the "low_balance_threshold" field.
+func (_u *WalletUpdateOne) SetLowBalanceThreshold(v float64) *WalletUpdateOne {
+	_u.mutation.ResetLowBalanceThreshold()
+	_u.mutation.SetLowBalanceThreshold(v)
+	return _u
+}
+
+// SetNillableLowBalanceThreshold sets the "low_balance_threshold" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableLowBalanceThreshold(v *float64) *WalletUpdateOne {
+	if v != nil {
+		_u.SetLowBalanceThreshold(*v)
+	}
+	return _u
+}
+
+// AddLowBalanceThreshold adds value to the "low_balance_threshold" field.
+func (_u *WalletUpdateOne) AddLowBalanceThreshold(v float64) *WalletUpdateOne {
+	_u.mutation.AddLowBalanceThreshold(v)
+	return _u
+}
+
+// ClearLowBalanceThreshold clears the value of the "low_balance_threshold" field.
+func (_u *WalletUpdateOne) ClearLowBalanceThreshold() *WalletUpdateOne {
+	_u.mutation.ClearLowBalanceThreshold()
+	return _u
+}
+
+// SetLowBalanceAlerted sets the "low_balance_alerted" field.
+func (_u *WalletUpdateOne) SetLowBalanceAlerted(v bool) *WalletUpdateOne {
+	_u.mutation.SetLowBalanceAlerted(v)
+	return _u
+}
+
+// SetNillableLowBalanceAlerted sets the "low_balance_alerted" field if the given value is not nil.
+func (_u *WalletUpdateOne) SetNillableLowBalanceAlerted(v *bool) *WalletUpdateOne {
+	if v != nil {
+		_u.SetLowBalanceAlerted(*v)
+	}
+	return _u
+}
+
+// AddTransactionIDs adds the "transactions" edge to the WalletTransaction entity by IDs.
+func (_u *WalletUpdateOne) AddTransactionIDs(ids ...int) *WalletUpdateOne {
+	_u.mutation.AddTransactionIDs(ids...)
+	return _u
+}
+
+// AddTransactions adds the "transactions" edges to the WalletTransaction entity.
+func (_u *WalletUpdateOne) AddTransactions(v ...*WalletTransaction) *WalletUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTransactionIDs(ids...)
+}
+
+// Mutation returns the WalletMutation object of the builder.
+func (_u *WalletUpdateOne) Mutation() *WalletMutation {
+	return _u.mutation
+}
+
+// ClearTransactions clears all "transactions" edges to the WalletTransaction entity.
+func (_u *WalletUpdateOne) ClearTransactions() *WalletUpdateOne {
+	_u.mutation.ClearTransactions()
+	return _u
+}
+
+// RemoveTransactionIDs removes the "transactions" edge to WalletTransaction entities by IDs.
+func (_u *WalletUpdateOne) RemoveTransactionIDs(ids ...int) *WalletUpdateOne {
+	_u.mutation.RemoveTransactionIDs(ids...)
+	return _u
+}
+
+// RemoveTransactions removes "transactions" edges to WalletTransaction entities.
+func (_u *WalletUpdateOne) RemoveTransactions(v ...*WalletTransaction) *WalletUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTransactionIDs(ids...)
+}
+
+// Where appends a list predicates to the WalletUpdate builder.
+func (_u *WalletUpdateOne) Where(ps ...predicate.Wallet) *WalletUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WalletUpdateOne) Select(field string, fields ...string) *WalletUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Wallet entity.
+func (_u *WalletUpdateOne) Save(ctx context.Context) (*Wallet, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WalletUpdateOne) SaveX(ctx context.Context) *Wallet {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WalletUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WalletUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WalletUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if wallet.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized wallet.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := wallet.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WalletUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := wallet.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Wallet.status": %w`, err)}
+		}
+	}
+	if _u.mutation.APIKeyCleared() && len(_u.mutation.APIKeyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Wallet.api_key"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WalletUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WalletUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *WalletUpdateOne) sqlSave(ctx context.Context) (_node *Wallet, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wallet.Table, wallet.Columns, sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Wallet.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wallet.FieldID)
+		for _, f := range fields {
+			if !wallet.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wallet.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallet.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Balance(); ok {
+		_spec.SetField(wallet.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalance(); ok {
+		_spec.AddField(wallet.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Reserved(); ok {
+		_spec.SetField(wallet.FieldReserved, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedReserved(); ok {
+		_spec.AddField(wallet.FieldReserved, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(wallet.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LowBalanceThreshold(); ok {
+		_spec.SetField(wallet.FieldLowBalanceThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLowBalanceThreshold(); ok {
+		_spec.AddField(wallet.FieldLowBalanceThreshold, field.TypeFloat64, value)
+	}
+	if _u.mutation.LowBalanceThresholdCleared() {
+		_spec.ClearField(wallet.FieldLowBalanceThreshold, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.LowBalanceAlerted(); ok {
+		_spec.SetField(wallet.FieldLowBalanceAlerted, field.TypeBool, value)
+	}
+	if _u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.TransactionsTable,
+			Columns: []string{wallet.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTransactionsIDs(); len(nodes) > 0 && !_u.mutation.TransactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.TransactionsTable,
+			Columns: []string{wallet.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TransactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.TransactionsTable,
+			Columns: []string{wallet.TransactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Wallet{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
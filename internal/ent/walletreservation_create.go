@@ -0,0 +1,589 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/walletreservation"
+)
+
+// WalletReservationCreate is the builder for creating a WalletReservation entity.
+type WalletReservationCreate struct {
+	config
+	mutation *WalletReservationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WalletReservationCreate) SetCreatedAt(v time.Time) *WalletReservationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WalletReservationCreate) SetNillableCreatedAt(v *time.Time) *WalletReservationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WalletReservationCreate) SetUpdatedAt(v time.Time) *WalletReservationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WalletReservationCreate) SetNillableUpdatedAt(v *time.Time) *WalletReservationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (_c *WalletReservationCreate) SetWalletID(v int) *WalletReservationCreate {
+	_c.mutation.SetWalletID(v)
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *WalletReservationCreate) SetRequestID(v int) *WalletReservationCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *WalletReservationCreate) SetAmount(v float64) *WalletReservationCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *WalletReservationCreate) SetExpiresAt(v time.Time) *WalletReservationCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// Mutation returns the WalletReservationMutation object of the builder.
+func (_c *WalletReservationCreate) Mutation() *WalletReservationMutation {
+	return _c.mutation
+}
+
+// Save creates the WalletReservation in the database.
+func (_c *WalletReservationCreate) Save(ctx context.Context) (*WalletReservation, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WalletReservationCreate) SaveX(ctx context.Context) *WalletReservation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WalletReservationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WalletReservationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WalletReservationCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if walletreservation.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized walletreservation.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := walletreservation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if walletreservation.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized walletreservation.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := walletreservation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WalletReservationCreate) check() error {
+	if _, ok := _c.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet_id", err: errors.New(`ent: missing required field "WalletReservation.wallet_id"`)}
+	}
+	if _, ok := _c.mutation.RequestID(); !ok {
+		return &ValidationError{Name: "request_id", err: errors.New(`ent: missing required field "WalletReservation.request_id"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "WalletReservation.amount"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "WalletReservation.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *WalletReservationCreate) sqlSave(ctx context.Context) (*WalletReservation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WalletReservationCreate) createSpec() (*WalletReservation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WalletReservation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(walletreservation.Table, sqlgraph.NewFieldSpec(walletreservation.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(walletreservation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(walletreservation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.WalletID(); ok {
+		_spec.SetField(walletreservation.FieldWalletID, field.TypeInt, value)
+		_node.WalletID = value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(walletreservation.FieldRequestID, field.TypeInt, value)
+		_node.RequestID = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(walletreservation.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(walletreservation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WalletReservation.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WalletReservationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WalletReservationCreate) OnConflict(opts ...sql.ConflictOption) *WalletReservationUpsertOne {
+	_c.conflict = opts
+	return &WalletReservationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WalletReservation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WalletReservationCreate) OnConflictColumns(columns ...string) *WalletReservationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WalletReservationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WalletReservationUpsertOne is the builder for "upsert"-ing
+	//  one WalletReservation node.
+	WalletReservationUpsertOne struct {
+		create *WalletReservationCreate
+	}
+
+	// WalletReservationUpsert is the "OnConflict" setter.
+	WalletReservationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletReservationUpsert) SetUpdatedAt(v time.Time) *WalletReservationUpsert {
+	u.Set(walletreservation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletReservationUpsert) UpdateUpdatedAt() *WalletReservationUpsert {
+	u.SetExcluded(walletreservation.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.WalletReservation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WalletReservationUpsertOne) UpdateNewValues() *WalletReservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(walletreservation.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.WalletID(); exists {
+			s.SetIgnore(walletreservation.FieldWalletID)
+		}
+		if _, exists := u.create.mutation.RequestID(); exists {
+			s.SetIgnore(walletreservation.FieldRequestID)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(walletreservation.FieldAmount)
+		}
+		if _, exists := u.create.mutation.ExpiresAt(); exists {
+			s.SetIgnore(walletreservation.FieldExpiresAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WalletReservation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WalletReservationUpsertOne) Ignore() *WalletReservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WalletReservationUpsertOne) DoNothing() *WalletReservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WalletReservationCreate.OnConflict
+// documentation for more info.
+func (u *WalletReservationUpsertOne) Update(set func(*WalletReservationUpsert)) *WalletReservationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WalletReservationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletReservationUpsertOne) SetUpdatedAt(v time.Time) *WalletReservationUpsertOne {
+	return u.Update(func(s *WalletReservationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletReservationUpsertOne) UpdateUpdatedAt() *WalletReservationUpsertOne {
+	return u.Update(func(s *WalletReservationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WalletReservationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WalletReservationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WalletReservationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WalletReservationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WalletReservationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WalletReservationCreateBulk is the builder for creating many WalletReservation entities in bulk.
+type WalletReservationCreateBulk struct {
+	config
+	err      error
+	builders []*WalletReservationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WalletReservation entities in the database.
+func (_c *WalletReservationCreateBulk) Save(ctx context.Context) ([]*WalletReservation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WalletReservation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WalletReservationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WalletReservationCreateBulk) SaveX(ctx context.Context) []*WalletReservation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WalletReservationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WalletReservationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WalletReservation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WalletReservationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WalletReservationCreateBulk) OnConflict(opts ...sql.ConflictOption) *WalletReservationUpsertBulk {
+	_c.conflict = opts
+	return &WalletReservationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WalletReservation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WalletReservationCreateBulk) OnConflictColumns(columns ...string) *WalletReservationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WalletReservationUpsertBulk{
+		create: _c,
+	}
+}
+
+// WalletReservationUpsertBulk is the builder for "upsert"-ing
+// a bulk of WalletReservation nodes.
+type WalletReservationUpsertBulk struct {
+	create *WalletReservationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WalletReservation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WalletReservationUpsertBulk) UpdateNewValues() *WalletReservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(walletreservation.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.WalletID(); exists {
+				s.SetIgnore(walletreservation.FieldWalletID)
+			}
+			if _, exists := b.mutation.RequestID(); exists {
+				s.SetIgnore(walletreservation.FieldRequestID)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(walletreservation.FieldAmount)
+			}
+			if _, exists := b.mutation.ExpiresAt(); exists {
+				s.SetIgnore(walletreservation.FieldExpiresAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WalletReservation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WalletReservationUpsertBulk) Ignore() *WalletReservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WalletReservationUpsertBulk) DoNothing() *WalletReservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WalletReservationCreateBulk.OnConflict
+// documentation for more info.
+func (u *WalletReservationUpsertBulk) Update(set func(*WalletReservationUpsert)) *WalletReservationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WalletReservationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletReservationUpsertBulk) SetUpdatedAt(v time.Time) *WalletReservationUpsertBulk {
+	return u.Update(func(s *WalletReservationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletReservationUpsertBulk) UpdateUpdatedAt() *WalletReservationUpsertBulk {
+	return u.Update(func(s *WalletReservationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WalletReservationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WalletReservationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WalletReservationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WalletReservationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,757 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/wallet"
+	"github.com/looplj/axonhub/internal/ent/wallettransaction"
+)
+
+// WalletTransactionCreate is the builder for creating a WalletTransaction entity.
+type WalletTransactionCreate struct {
+	config
+	mutation *WalletTransactionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WalletTransactionCreate) SetCreatedAt(v time.Time) *WalletTransactionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WalletTransactionCreate) SetNillableCreatedAt(v *time.Time) *WalletTransactionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WalletTransactionCreate) SetUpdatedAt(v time.Time) *WalletTransactionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WalletTransactionCreate) SetNillableUpdatedAt(v *time.Time) *WalletTransactionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (_c *WalletTransactionCreate) SetWalletID(v int) *WalletTransactionCreate {
+	_c.mutation.SetWalletID(v)
+	return _c
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (_c *WalletTransactionCreate) SetAPIKeyID(v int) *WalletTransactionCreate {
+	_c.mutation.SetAPIKeyID(v)
+	return _c
+}
+
+// SetProjectID sets the "project_id" field.
+func (_c *WalletTransactionCreate) SetProjectID(v int) *WalletTransactionCreate {
+	_c.mutation.SetProjectID(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *WalletTransactionCreate) SetType(v wallettransaction.Type) *WalletTransactionCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *WalletTransactionCreate) SetAmount(v float64) *WalletTransactionCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (_c *WalletTransactionCreate) SetBalanceAfter(v float64) *WalletTransactionCreate {
+	_c.mutation.SetBalanceAfter(v)
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *WalletTransactionCreate) SetRequestID(v int) *WalletTransactionCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_c *WalletTransactionCreate) SetNillableRequestID(v *int) *WalletTransactionCreate {
+	if v != nil {
+		_c.SetRequestID(*v)
+	}
+	return _c
+}
+
+// SetUsageLogID sets the "usage_log_id" field.
+func (_c *WalletTransactionCreate) SetUsageLogID(v int) *WalletTransactionCreate {
+	_c.mutation.SetUsageLogID(v)
+	return _c
+}
+
+// SetNillableUsageLogID sets the "usage_log_id" field if the given value is not nil.
+func (_c *WalletTransactionCreate) SetNillableUsageLogID(v *int) *WalletTransactionCreate {
+	if v != nil {
+		_c.SetUsageLogID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *WalletTransactionCreate) SetUserID(v int) *WalletTransactionCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *WalletTransactionCreate) SetNillableUserID(v *int) *WalletTransactionCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *WalletTransactionCreate) SetDescription(v string) *WalletTransactionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *WalletTransactionCreate) SetNillableDescription(v *string) *WalletTransactionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (_c *WalletTransactionCreate) SetWallet(v *Wallet) *WalletTransactionCreate {
+	return _c.SetWalletID(v.ID)
+}
+
+// Mutation returns the WalletTransactionMutation object of the builder.
+func (_c *WalletTransactionCreate) Mutation() *WalletTransactionMutation {
+	return _c.mutation
+}
+
+// Save creates the WalletTransaction in the database.
+func (_c *WalletTransactionCreate) Save(ctx context.Context) (*WalletTransaction, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WalletTransactionCreate) SaveX(ctx context.Context) *WalletTransaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WalletTransactionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WalletTransactionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WalletTransactionCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if wallettransaction.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized wallettransaction.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := wallettransaction.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if wallettransaction.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized wallettransaction.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := wallettransaction.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		v := wallettransaction.DefaultDescription
+		_c.mutation.SetDescription(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WalletTransactionCreate) check() error {
+	if _, ok := _c.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet_id", err: errors.New(`ent: missing required field "WalletTransaction.wallet_id"`)}
+	}
+	if _, ok := _c.mutation.APIKeyID(); !ok {
+		return &ValidationError{Name: "api_key_id", err: errors.New(`ent: missing required field "WalletTransaction.api_key_id"`)}
+	}
+	if _, ok := _c.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "WalletTransaction.project_id"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "WalletTransaction.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := wallettransaction.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "WalletTransaction.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "WalletTransaction.amount"`)}
+	}
+	if _, ok := _c.mutation.BalanceAfter(); !ok {
+		return &ValidationError{Name: "balance_after", err: errors.New(`ent: missing required field "WalletTransaction.balance_after"`)}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "WalletTransaction.description"`)}
+	}
+	if len(_c.mutation.WalletIDs()) == 0 {
+		return &ValidationError{Name: "wallet", err: errors.New(`ent: missing required edge "WalletTransaction.wallet"`)}
+	}
+	return nil
+}
+
+func (_c *WalletTransactionCreate) sqlSave(ctx context.Context) (*WalletTransaction, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WalletTransactionCreate) createSpec() (*WalletTransaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WalletTransaction{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(wallettransaction.Table, sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(wallettransaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallettransaction.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.APIKeyID(); ok {
+		_spec.SetField(wallettransaction.FieldAPIKeyID, field.TypeInt, value)
+		_node.APIKeyID = value
+	}
+	if value, ok := _c.mutation.ProjectID(); ok {
+		_spec.SetField(wallettransaction.FieldProjectID, field.TypeInt, value)
+		_node.ProjectID = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(wallettransaction.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(wallettransaction.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.BalanceAfter(); ok {
+		_spec.SetField(wallettransaction.FieldBalanceAfter, field.TypeFloat64, value)
+		_node.BalanceAfter = value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(wallettransaction.FieldRequestID, field.TypeInt, value)
+		_node.RequestID = &value
+	}
+	if value, ok := _c.mutation.UsageLogID(); ok {
+		_spec.SetField(wallettransaction.FieldUsageLogID, field.TypeInt, value)
+		_node.UsageLogID = &value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(wallettransaction.FieldUserID, field.TypeInt, value)
+		_node.UserID = &value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(wallettransaction.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if nodes := _c.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wallettransaction.WalletTable,
+			Columns: []string{wallettransaction.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wallet.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WalletID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WalletTransaction.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WalletTransactionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WalletTransactionCreate) OnConflict(opts ...sql.ConflictOption) *WalletTransactionUpsertOne {
+	_c.conflict = opts
+	return &WalletTransactionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WalletTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WalletTransactionCreate) OnConflictColumns(columns ...string) *WalletTransactionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WalletTransactionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WalletTransactionUpsertOne is the builder for "upsert"-ing
+	//  one WalletTransaction node.
+	WalletTransactionUpsertOne struct {
+		create *WalletTransactionCreate
+	}
+
+	// WalletTransactionUpsert is the "OnConflict" setter.
+	WalletTransactionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletTransactionUpsert) SetUpdatedAt(v time.Time) *WalletTransactionUpsert {
+	u.Set(wallettransaction.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletTransactionUpsert) UpdateUpdatedAt() *WalletTransactionUpsert {
+	u.SetExcluded(wallettransaction.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.WalletTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WalletTransactionUpsertOne) UpdateNewValues() *WalletTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(wallettransaction.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.WalletID(); exists {
+			s.SetIgnore(wallettransaction.FieldWalletID)
+		}
+		if _, exists := u.create.mutation.APIKeyID(); exists {
+			s.SetIgnore(wallettransaction.FieldAPIKeyID)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(wallettransaction.FieldProjectID)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(wallettransaction.FieldType)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(wallettransaction.FieldAmount)
+		}
+		if _, exists := u.create.mutation.BalanceAfter(); exists {
+			s.SetIgnore(wallettransaction.FieldBalanceAfter)
+		}
+		if _, exists := u.create.mutation.RequestID(); exists {
+			s.SetIgnore(wallettransaction.FieldRequestID)
+		}
+		if _, exists := u.create.mutation.UsageLogID(); exists {
+			s.SetIgnore(wallettransaction.FieldUsageLogID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(wallettransaction.FieldUserID)
+		}
+		if _, exists := u.create.mutation.Description(); exists {
+			s.SetIgnore(wallettransaction.FieldDescription)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WalletTransaction.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WalletTransactionUpsertOne) Ignore() *WalletTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WalletTransactionUpsertOne) DoNothing() *WalletTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WalletTransactionCreate.OnConflict
+// documentation for more info.
+func (u *WalletTransactionUpsertOne) Update(set func(*WalletTransactionUpsert)) *WalletTransactionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WalletTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletTransactionUpsertOne) SetUpdatedAt(v time.Time) *WalletTransactionUpsertOne {
+	return u.Update(func(s *WalletTransactionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletTransactionUpsertOne) UpdateUpdatedAt() *WalletTransactionUpsertOne {
+	return u.Update(func(s *WalletTransactionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WalletTransactionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WalletTransactionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WalletTransactionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WalletTransactionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WalletTransactionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WalletTransactionCreateBulk is the builder for creating many WalletTransaction entities in bulk.
+type WalletTransactionCreateBulk struct {
+	config
+	err      error
+	builders []*WalletTransactionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WalletTransaction entities in the database.
+func (_c *WalletTransactionCreateBulk) Save(ctx context.Context) ([]*WalletTransaction, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WalletTransaction, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WalletTransactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WalletTransactionCreateBulk) SaveX(ctx context.Context) []*WalletTransaction {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WalletTransactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WalletTransactionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WalletTransaction.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WalletTransactionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WalletTransactionCreateBulk) OnConflict(opts ...sql.ConflictOption) *WalletTransactionUpsertBulk {
+	_c.conflict = opts
+	return &WalletTransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WalletTransaction.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WalletTransactionCreateBulk) OnConflictColumns(columns ...string) *WalletTransactionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WalletTransactionUpsertBulk{
+		create: _c,
+	}
+}
+
+// WalletTransactionUpsertBulk is the builder for "upsert"-ing
+// a bulk of WalletTransaction nodes.
+type WalletTransactionUpsertBulk struct {
+	create *WalletTransactionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WalletTransaction.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WalletTransactionUpsertBulk) UpdateNewValues() *WalletTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(wallettransaction.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.WalletID(); exists {
+				s.SetIgnore(wallettransaction.FieldWalletID)
+			}
+			if _, exists := b.mutation.APIKeyID(); exists {
+				s.SetIgnore(wallettransaction.FieldAPIKeyID)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(wallettransaction.FieldProjectID)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(wallettransaction.FieldType)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(wallettransaction.FieldAmount)
+			}
+			if _, exists := b.mutation.BalanceAfter(); exists {
+				s.SetIgnore(wallettransaction.FieldBalanceAfter)
+			}
+			if _, exists := b.mutation.RequestID(); exists {
+				s.SetIgnore(wallettransaction.FieldRequestID)
+			}
+			if _, exists := b.mutation.UsageLogID(); exists {
+				s.SetIgnore(wallettransaction.FieldUsageLogID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(wallettransaction.FieldUserID)
+			}
+			if _, exists := b.mutation.Description(); exists {
+				s.SetIgnore(wallettransaction.FieldDescription)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WalletTransaction.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WalletTransactionUpsertBulk) Ignore() *WalletTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WalletTransactionUpsertBulk) DoNothing() *WalletTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WalletTransactionCreateBulk.OnConflict
+// documentation for more info.
+func (u *WalletTransactionUpsertBulk) Update(set func(*WalletTransactionUpsert)) *WalletTransactionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WalletTransactionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WalletTransactionUpsertBulk) SetUpdatedAt(v time.Time) *WalletTransactionUpsertBulk {
+	return u.Update(func(s *WalletTransactionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WalletTransactionUpsertBulk) UpdateUpdatedAt() *WalletTransactionUpsertBulk {
+	return u.Update(func(s *WalletTransactionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WalletTransactionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WalletTransactionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WalletTransactionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WalletTransactionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
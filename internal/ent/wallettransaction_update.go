@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/ent/wallettransaction"
+)
+
+// WalletTransactionUpdate is the builder for updating WalletTransaction entities.
+type WalletTransactionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WalletTransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WalletTransactionUpdate builder.
+func (_u *WalletTransactionUpdate) Where(ps ...predicate.WalletTransaction) *WalletTransactionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WalletTransactionUpdate) SetUpdatedAt(v time.Time) *WalletTransactionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the WalletTransactionMutation object of the builder.
+func (_u *WalletTransactionUpdate) Mutation() *WalletTransactionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WalletTransactionUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WalletTransactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WalletTransactionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WalletTransactionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WalletTransactionUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if wallettransaction.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized wallettransaction.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := wallettransaction.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WalletTransactionUpdate) check() error {
+	if _u.mutation.WalletCleared() && len(_u.mutation.WalletIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WalletTransaction.wallet"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WalletTransactionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WalletTransactionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *WalletTransactionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wallettransaction.Table, wallettransaction.Columns, sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallettransaction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(wallettransaction.FieldRequestID, field.TypeInt)
+	}
+	if _u.mutation.UsageLogIDCleared() {
+		_spec.ClearField(wallettransaction.FieldUsageLogID, field.TypeInt)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(wallettransaction.FieldUserID, field.TypeInt)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallettransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WalletTransactionUpdateOne is the builder for updating a single WalletTransaction entity.
+type WalletTransactionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WalletTransactionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WalletTransactionUpdateOne) SetUpdatedAt(v time.Time) *WalletTransactionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the WalletTransactionMutation object of the builder.
+func (_u *WalletTransactionUpdateOne) Mutation() *WalletTransactionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WalletTransactionUpdate builder.
+func (_u *WalletTransactionUpdateOne) Where(ps ...predicate.WalletTransaction) *WalletTransactionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WalletTransactionUpdateOne) Select(field string, fields ...string) *WalletTransactionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WalletTransaction entity.
+func (_u *WalletTransactionUpdateOne) Save(ctx context.Context) (*WalletTransaction, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WalletTransactionUpdateOne) SaveX(ctx context.Context) *WalletTransaction {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WalletTransactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WalletTransactionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WalletTransactionUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if wallettransaction.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized wallettransaction.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := wallettransaction.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WalletTransactionUpdateOne) check() error {
+	if _u.mutation.WalletCleared() && len(_u.mutation.WalletIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WalletTransaction.wallet"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WalletTransactionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WalletTransactionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *WalletTransactionUpdateOne) sqlSave(ctx context.Context) (_node *WalletTransaction, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wallettransaction.Table, wallettransaction.Columns, sqlgraph.NewFieldSpec(wallettransaction.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WalletTransaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wallettransaction.FieldID)
+		for _, f := range fields {
+			if !wallettransaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wallettransaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(wallettransaction.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(wallettransaction.FieldRequestID, field.TypeInt)
+	}
+	if _u.mutation.UsageLogIDCleared() {
+		_spec.ClearField(wallettransaction.FieldUsageLogID, field.TypeInt)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(wallettransaction.FieldUserID, field.TypeInt)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &WalletTransaction{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallettransaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
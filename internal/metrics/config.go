@@ -10,7 +10,12 @@ type Config struct {
 }
 
 type ExporterConfig struct {
-	Type     string `conf:"type" validate:"oneof=stdout otlpgrpc otlphttp" yaml:"type" json:"type"`
+	// Type is the exporter type. The prometheus exporter is pulled from the /metrics endpoint of the server.
+	Type string `conf:"type" validate:"oneof=stdout otlpgrpc otlphttp prometheus" yaml:"type" json:"type"`
+	// Endpoint is the collector endpoint of the otlpgrpc and otlphttp exporters.
 	Endpoint string `conf:"endpoint" yaml:"endpoint" json:"endpoint"`
 	Insecure bool   `conf:"insecure" yaml:"insecure" json:"insecure"`
+	// BearerToken is the token the scrapers send as "Authorization: Bearer <token>" to the /metrics endpoint.
+	// It is required by the prometheus exporter, the metrics hold the usage and the cost of every project and API key.
+	BearerToken string `conf:"bearer_token" yaml:"bearer_token" json:"bearer_token"`
 }
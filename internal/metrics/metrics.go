@@ -3,6 +3,7 @@ package metrics
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 
@@ -26,8 +27,19 @@ type _Metrics struct {
 	ChatTokenCount      metric.Int64Counter
 	ChatSuccessCount    metric.Int64Counter
 	ChatFailureCount    metric.Int64Counter
+
+	// LLM gateway metrics, labelled by channel, model, project and API key.
+	LLMRequestCount             metric.Int64Counter
+	LLMErrorCount               metric.Int64Counter
+	LLMRequestDuration          metric.Float64Histogram
+	LLMTimeToFirstTokenDuration metric.Float64Histogram
+	LLMTokenCount               metric.Int64Counter
+	LLMCost                     metric.Float64Counter
 }
 
+// llmLatencyBuckets are the histogram buckets in seconds of the LLM latency metrics.
+var llmLatencyBuckets = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 20, 30, 60, 120, 300}
+
 var Metrics *_Metrics
 
 // SetupMetrics creates a new ServerMetrics instance.
@@ -137,6 +149,77 @@ func SetupMetrics(provider *sdk.MeterProvider, name string) error {
 
 	Metrics.ChatFailureCount = chatFailureCount
 
+	return setupLLMMetrics(meter)
+}
+
+func setupLLMMetrics(meter metric.Meter) error {
+	llmRequestCount, err := meter.Int64Counter(
+		"axonhub_llm_requests",
+		metric.WithDescription("Number of upstream LLM requests"),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create axonhub_llm_requests counter: %w", err)
+	}
+
+	Metrics.LLMRequestCount = llmRequestCount
+
+	llmErrorCount, err := meter.Int64Counter(
+		"axonhub_llm_errors",
+		metric.WithDescription("Number of failed upstream LLM requests by status class"),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create axonhub_llm_errors counter: %w", err)
+	}
+
+	Metrics.LLMErrorCount = llmErrorCount
+
+	llmRequestDuration, err := meter.Float64Histogram(
+		"axonhub_llm_request_duration",
+		metric.WithDescription("Latency of successful upstream LLM requests"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(llmLatencyBuckets...),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create axonhub_llm_request_duration histogram: %w", err)
+	}
+
+	Metrics.LLMRequestDuration = llmRequestDuration
+
+	llmTimeToFirstToken, err := meter.Float64Histogram(
+		"axonhub_llm_time_to_first_token",
+		metric.WithDescription("Time to first token of successful streaming upstream LLM requests"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(llmLatencyBuckets...),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create axonhub_llm_time_to_first_token histogram: %w", err)
+	}
+
+	Metrics.LLMTimeToFirstTokenDuration = llmTimeToFirstToken
+
+	llmTokenCount, err := meter.Int64Counter(
+		"axonhub_llm_tokens",
+		metric.WithDescription("Number of LLM tokens by type: prompt, cached, reasoning or completion"),
+		metric.WithUnit("{token}"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create axonhub_llm_tokens counter: %w", err)
+	}
+
+	Metrics.LLMTokenCount = llmTokenCount
+
+	llmCost, err := meter.Float64Counter(
+		"axonhub_llm_cost",
+		metric.WithDescription("Cost of LLM requests in the configured currency"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create axonhub_llm_cost counter: %w", err)
+	}
+
+	Metrics.LLMCost = llmCost
+
 	return nil
 }
 
@@ -179,3 +262,100 @@ func (sm *_Metrics) RecordChatRequest(ctx context.Context, channelID, channelNam
 		sm.ChatFailureCount.Add(ctx, 1, metric.WithAttributes(labels...))
 	}
 }
+
+// LLMLabels identifies the series of an LLM request.
+type LLMLabels struct {
+	ChannelID int
+	Model     string
+	ProjectID int
+	// APIKeyID is zero for requests not made with an API key.
+	APIKeyID int
+}
+
+func (l LLMLabels) attributes(extra ...attribute.KeyValue) []attribute.KeyValue {
+	return append([]attribute.KeyValue{
+		attribute.Int("channel_id", l.ChannelID),
+		attribute.String("model", l.Model),
+		attribute.Int("project_id", l.ProjectID),
+		attribute.Int("api_key_id", l.APIKeyID),
+	}, extra...)
+}
+
+// LLMExecution is the outcome of one upstream attempt of an LLM request.
+type LLMExecution struct {
+	LLMLabels
+
+	// StatusClass is "2xx" for successful requests, the class of the upstream status code such as "4xx" or "5xx",
+	// or "canceled" and "unknown" for requests without a status code.
+	StatusClass string
+	// Latency and FirstTokenLatency are only recorded for successful requests, when not zero.
+	Latency           time.Duration
+	FirstTokenLatency time.Duration
+}
+
+// RecordLLMExecution records the request, error and latency metrics of an upstream LLM request.
+// It is a no-op before the metrics are set up.
+func (sm *_Metrics) RecordLLMExecution(ctx context.Context, execution LLMExecution) {
+	if sm == nil || sm.LLMRequestCount == nil {
+		return
+	}
+
+	attrs := metric.WithAttributes(execution.attributes()...)
+	sm.LLMRequestCount.Add(ctx, 1, attrs)
+
+	if execution.StatusClass != "2xx" {
+		sm.LLMErrorCount.Add(ctx, 1, metric.WithAttributes(execution.attributes(attribute.String("status_class", execution.StatusClass))...))
+		return
+	}
+
+	if execution.Latency > 0 {
+		sm.LLMRequestDuration.Record(ctx, execution.Latency.Seconds(), attrs)
+	}
+
+	if execution.FirstTokenLatency > 0 {
+		sm.LLMTimeToFirstTokenDuration.Record(ctx, execution.FirstTokenLatency.Seconds(), attrs)
+	}
+}
+
+// LLMUsage is the token usage and cost of an LLM request.
+type LLMUsage struct {
+	LLMLabels
+
+	PromptTokens     int64
+	CachedTokens     int64
+	ReasoningTokens  int64
+	CompletionTokens int64
+	Cost             float64
+}
+
+// RecordLLMUsage records the token and cost metrics of an LLM request.
+// It is a no-op before the metrics are set up.
+func (sm *_Metrics) RecordLLMUsage(ctx context.Context, usage LLMUsage) {
+	if sm == nil || sm.LLMTokenCount == nil {
+		return
+	}
+
+	for tokenType, count := range map[string]int64{
+		"prompt":     usage.PromptTokens,
+		"cached":     usage.CachedTokens,
+		"reasoning":  usage.ReasoningTokens,
+		"completion": usage.CompletionTokens,
+	} {
+		if count > 0 {
+			sm.LLMTokenCount.Add(ctx, count, metric.WithAttributes(usage.attributes(attribute.String("type", tokenType))...))
+		}
+	}
+
+	if usage.Cost > 0 {
+		sm.LLMCost.Add(ctx, usage.Cost, metric.WithAttributes(usage.attributes()...))
+	}
+}
+
+// StatusClass returns the class of an HTTP status code, such as "4xx".
+func StatusClass(statusCode int) string {
+	if statusCode < 100 || statusCode > 599 {
+		return "unknown"
+	}
+
+	return fmt.Sprintf("%dxx", statusCode/100)
+}
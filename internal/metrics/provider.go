@@ -2,12 +2,18 @@ package metrics
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	otelprometheus "go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
 
 	metric "go.opentelemetry.io/otel/metric"
@@ -15,7 +21,35 @@ import (
 )
 
 // Meter is the global meter for the application.
-var Meter metric.Meter
+// It defaults to the global delegating meter, so instruments created before NewProvider runs are still exported.
+var Meter metric.Meter = otel.Meter("axonhub")
+
+// promHandler serves the prometheus registry, it is only set for the prometheus exporter.
+var promHandler http.Handler
+
+// promBearerToken is the token required to scrape promHandler.
+var promBearerToken string
+
+// Handler serves the metrics in the Prometheus text format when the prometheus exporter is enabled, and 404 otherwise.
+// The scrapers must send the configured bearer token.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if promHandler == nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(promBearerToken)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="metrics"`)
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+
+			return
+		}
+
+		promHandler.ServeHTTP(w, r)
+	})
+}
 
 // NewProvider initializes the OpenTelemetry metrics provider.
 func NewProvider(config Config) (*sdk.MeterProvider, error) {
@@ -35,6 +69,25 @@ func NewProvider(config Config) (*sdk.MeterProvider, error) {
 	)
 
 	switch config.Exporter.Type {
+	case "prometheus":
+		if config.Exporter.BearerToken == "" {
+			return nil, fmt.Errorf("metrics.exporter.bearer_token is required for the prometheus exporter")
+		}
+
+		registry := prometheus.NewRegistry()
+
+		reader, err := otelprometheus.New(otelprometheus.WithRegisterer(registry))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create prometheus exporter: %w", err)
+		}
+
+		meterProvider := sdk.NewMeterProvider(sdk.WithReader(reader))
+		otel.SetMeterProvider(meterProvider)
+		Meter = meterProvider.Meter("axonhub")
+		promHandler = promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+		promBearerToken = config.Exporter.BearerToken
+
+		return meterProvider, nil
 	case "stdout":
 		exporter, err = stdoutmetric.New()
 		if err != nil {
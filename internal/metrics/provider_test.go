@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusExporter(t *testing.T) {
+	_, err := NewProvider(Config{Enabled: true, Exporter: ExporterConfig{Type: "prometheus"}})
+	require.ErrorContains(t, err, "bearer_token is required")
+
+	provider, err := NewProvider(Config{Enabled: true, Exporter: ExporterConfig{Type: "prometheus", BearerToken: "scrape-token"}})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = provider.Shutdown(context.Background())
+		promHandler = nil
+		promBearerToken = ""
+	})
+
+	require.NoError(t, SetupMetrics(provider, "axonhub-test"))
+
+	labels := LLMLabels{ChannelID: 1, Model: "gpt-4o", ProjectID: 2, APIKeyID: 3}
+	Metrics.RecordLLMExecution(t.Context(), LLMExecution{LLMLabels: labels, StatusClass: "2xx", Latency: 1200 * time.Millisecond})
+	Metrics.RecordLLMExecution(t.Context(), LLMExecution{LLMLabels: labels, StatusClass: StatusClass(503)})
+	Metrics.RecordLLMUsage(t.Context(), LLMUsage{LLMLabels: labels, PromptTokens: 10, CompletionTokens: 5, Cost: 0.5})
+
+	for _, authorization := range []string{"", "Bearer wrong-token", "scrape-token"} {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		req.Header.Set("Authorization", authorization)
+
+		rec := httptest.NewRecorder()
+		Handler().ServeHTTP(rec, req)
+		require.Equal(t, http.StatusUnauthorized, rec.Code, "authorization %q", authorization)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Authorization", "Bearer scrape-token")
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	require.Contains(t, body, `axonhub_llm_requests_total{api_key_id="3",channel_id="1",model="gpt-4o"`)
+	require.Contains(t, body, `axonhub_llm_errors_total{api_key_id="3",channel_id="1",model="gpt-4o",otel_scope_name="axonhub-test"`)
+	require.Contains(t, body, `status_class="5xx"`)
+	require.Contains(t, body, "axonhub_llm_request_duration_seconds_bucket")
+	require.Contains(t, body, `type="completion"`)
+	require.Contains(t, body, "axonhub_llm_cost_total")
+}
+
+func TestHandler_NotFoundWithoutPrometheusExporter(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -1,7 +1,10 @@
 package objects
 
 import (
+	"fmt"
 	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/shopspring/decimal"
 )
@@ -21,6 +24,76 @@ type APIKeyProfile struct {
 	ChannelTags          []string             `json:"channelTags,omitempty"`
 	ChannelTagsMatchMode ChannelTagsMatchMode `json:"channelTagsMatchMode,omitempty"`
 	ModelIDs             []string             `json:"modelIDs,omitempty"`
+
+	// Endpoints restricts the endpoint families the API key can call, empty allows all.
+	Endpoints []APIKeyEndpoint `json:"endpoints,omitempty"`
+	Limits    *APIKeyLimits    `json:"limits,omitempty"`
+
+	// EndUserQuota applies separately to each end user of the API key, requests without an end user ID are not subject to it.
+	EndUserQuota *APIKeyQuota `json:"endUserQuota,omitempty"`
+}
+
+// APIKeyEndpoint is an endpoint family an API key profile can be restricted to.
+// If this enum is changed, update apiKeyEndpointOf in the orchestrator.
+type APIKeyEndpoint string
+
+const (
+	APIKeyEndpointChat        APIKeyEndpoint = "chat"
+	APIKeyEndpointEmbeddings  APIKeyEndpoint = "embeddings"
+	APIKeyEndpointImages      APIKeyEndpoint = "images"
+	APIKeyEndpointVideo       APIKeyEndpoint = "video"
+	APIKeyEndpointAudio       APIKeyEndpoint = "audio"
+	APIKeyEndpointRerank      APIKeyEndpoint = "rerank"
+	APIKeyEndpointModerations APIKeyEndpoint = "moderations"
+)
+
+func (e APIKeyEndpoint) IsValid() bool {
+	switch e {
+	case APIKeyEndpointChat, APIKeyEndpointEmbeddings, APIKeyEndpointImages, APIKeyEndpointVideo, APIKeyEndpointAudio,
+		APIKeyEndpointRerank, APIKeyEndpointModerations:
+		return true
+	default:
+		return false
+	}
+}
+
+// AllowsEndpoint reports whether the profile allows the endpoint family.
+func (p *APIKeyProfile) AllowsEndpoint(endpoint APIKeyEndpoint) bool {
+	if p == nil || len(p.Endpoints) == 0 {
+		return true
+	}
+
+	return slices.Contains(p.Endpoints, endpoint)
+}
+
+// APIKeyLimits caps what a single request of the API key can ask for.
+type APIKeyLimits struct {
+	// MaxTokens is the maximum accepted max_tokens (or max_completion_tokens) of a request.
+	MaxTokens *int64 `json:"maxTokens,omitempty"`
+	// AllowedTools lists the tool types the request can declare, e.g. function or web_search. Empty allows all.
+	AllowedTools []string `json:"allowedTools,omitempty"`
+	// MaxImageSize is the largest image size a request can generate, as WIDTHxHEIGHT.
+	MaxImageSize string `json:"maxImageSize,omitempty"`
+}
+
+// ParseImageSize parses an image size of the form WIDTHxHEIGHT, e.g. 1024x1024.
+func ParseImageSize(size string) (int, int, error) {
+	w, h, ok := strings.Cut(strings.ToLower(strings.TrimSpace(size)), "x")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid image size %q", size)
+	}
+
+	width, err := strconv.Atoi(w)
+	if err != nil || width <= 0 {
+		return 0, 0, fmt.Errorf("invalid image size %q", size)
+	}
+
+	height, err := strconv.Atoi(h)
+	if err != nil || height <= 0 {
+		return 0, 0, fmt.Errorf("invalid image size %q", size)
+	}
+
+	return width, height, nil
 }
 
 // ChannelTagsMatchMode controls how profile channel tags are matched.
@@ -96,23 +169,8 @@ func (p *APIKeyProfile) Clone() *APIKeyProfile {
 		cp.ModelMappings = make([]ModelMapping, len(p.ModelMappings))
 		copy(cp.ModelMappings, p.ModelMappings)
 	}
-	if p.Quota != nil {
-		q := *p.Quota
-		if q.Requests != nil {
-			r := *q.Requests
-			q.Requests = &r
-		}
-		if q.TotalTokens != nil {
-			tt := *q.TotalTokens
-			q.TotalTokens = &tt
-		}
-		if q.Cost != nil {
-			c := *q.Cost
-			q.Cost = &c
-		}
-		q.Period = p.Quota.Period.clone()
-		cp.Quota = &q
-	}
+	cp.Quota = p.Quota.clone()
+	cp.EndUserQuota = p.EndUserQuota.clone()
 	if len(p.ChannelIDs) > 0 {
 		cp.ChannelIDs = make([]int, len(p.ChannelIDs))
 		copy(cp.ChannelIDs, p.ChannelIDs)
@@ -129,6 +187,39 @@ func (p *APIKeyProfile) Clone() *APIKeyProfile {
 		s := *p.LoadBalanceStrategy
 		cp.LoadBalanceStrategy = &s
 	}
+	if len(p.Endpoints) > 0 {
+		cp.Endpoints = slices.Clone(p.Endpoints)
+	}
+	if p.Limits != nil {
+		l := *p.Limits
+		if l.MaxTokens != nil {
+			mt := *l.MaxTokens
+			l.MaxTokens = &mt
+		}
+		l.AllowedTools = slices.Clone(p.Limits.AllowedTools)
+		cp.Limits = &l
+	}
+	return &cp
+}
+
+func (q *APIKeyQuota) clone() *APIKeyQuota {
+	if q == nil {
+		return nil
+	}
+	cp := *q
+	if q.Requests != nil {
+		r := *q.Requests
+		cp.Requests = &r
+	}
+	if q.TotalTokens != nil {
+		tt := *q.TotalTokens
+		cp.TotalTokens = &tt
+	}
+	if q.Cost != nil {
+		c := *q.Cost
+		cp.Cost = &c
+	}
+	cp.Period = q.Period.clone()
 	return &cp
 }
 
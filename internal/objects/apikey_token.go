@@ -0,0 +1,46 @@
+package objects
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// APIKeyToken is a short-lived token minted by a service account API key for an end user.
+// Requests made with the token are attributed to the parent API key and the end user.
+type APIKeyToken struct {
+	ID        string
+	APIKeyID  int
+	EndUserID string
+	// Models restricts the models the token can call, empty allows all models of the parent key.
+	Models []string
+	// Budget is the maximum cost the end user can spend while the token is valid.
+	Budget    *decimal.Decimal
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// APIKeyTokenRevocations records the tokens of an API key revoked before a point in time.
+type APIKeyTokenRevocations struct {
+	// RevokedBefore revokes all tokens issued at or before the time.
+	RevokedBefore *time.Time `json:"revokedBefore,omitempty"`
+	// EndUsers revokes the tokens of an end user issued at or before the time.
+	EndUsers map[string]time.Time `json:"endUsers,omitempty"`
+}
+
+// IsRevoked reports whether a token issued at issuedAt for the end user is revoked.
+func (r *APIKeyTokenRevocations) IsRevoked(endUserID string, issuedAt time.Time) bool {
+	if r == nil {
+		return false
+	}
+
+	if r.RevokedBefore != nil && !issuedAt.After(*r.RevokedBefore) {
+		return true
+	}
+
+	if revokedAt, ok := r.EndUsers[endUserID]; ok && !issuedAt.After(revokedAt) {
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,9 @@
+package objects
+
+// AuditLogChange is the before/after value of one field changed by an audited mutation.
+// Secrets are replaced by a redaction marker before the change is stored.
+type AuditLogChange struct {
+	Field  string         `json:"field"`
+	Before JSONRawMessage `json:"before,omitempty"`
+	After  JSONRawMessage `json:"after,omitempty"`
+}
@@ -0,0 +1,40 @@
+package objects
+
+// ProjectBillingSettings configures the monthly invoices of a project.
+// The invoices bill the cost of the usage logs with the markup applied, plus the monthly fee.
+type ProjectBillingSettings struct {
+	Enabled bool `json:"enabled"`
+	// Currency is the currency code of the invoices, the reporting currency if empty.
+	// The costs of the usage logs are converted into it with the exchange rates in effect at the end of the month.
+	Currency string `json:"currency,omitempty"`
+	// MarkupPercent is added on top of the cost, e.g. 20 bills 120% of the cost.
+	MarkupPercent float64 `json:"markupPercent"`
+	// ModelMarkups overrides the markup of the matching models, the first matching pattern wins.
+	ModelMarkups []ModelMarkup `json:"modelMarkups,omitempty"`
+	// MonthlyFee is the fixed fee added to every invoice.
+	MonthlyFee float64 `json:"monthlyFee"`
+}
+
+type ModelMarkup struct {
+	// Model is the model ID, or a regular expression matching the whole model ID like "claude-.*".
+	Model         string  `json:"model"`
+	MarkupPercent float64 `json:"markupPercent"`
+}
+
+// InvoiceLineItem is the usage of one model billed at one channel model price version.
+type InvoiceLineItem struct {
+	ModelID string `json:"modelID"`
+	// PriceReferenceID is the reference id of the channel model price version the cost was calculated with.
+	PriceReferenceID string `json:"priceReferenceID,omitempty"`
+	// Price is the price of the version, kept to reproduce the cost after the price changes.
+	Price                   *ModelPrice `json:"price,omitempty"`
+	RequestCount            int64       `json:"requestCount"`
+	PromptTokens            int64       `json:"promptTokens"`
+	PromptCachedTokens      int64       `json:"promptCachedTokens"`
+	PromptWriteCachedTokens int64       `json:"promptWriteCachedTokens"`
+	CompletionTokens        int64       `json:"completionTokens"`
+	TotalTokens             int64       `json:"totalTokens"`
+	Cost                    float64     `json:"cost"`
+	MarkupPercent           float64     `json:"markupPercent"`
+	Amount                  float64     `json:"amount"`
+}
@@ -0,0 +1,56 @@
+package objects
+
+// BudgetAction is the action enforced on the requests covered by a budget once it is exhausted.
+type BudgetAction string
+
+const (
+	// BudgetActionNone only sends the alerts.
+	BudgetActionNone BudgetAction = "none"
+	// BudgetActionDowngrade maps the requested models with the budget model mappings.
+	BudgetActionDowngrade BudgetAction = "downgrade"
+	// BudgetActionRestrictTags restricts the requests to the channels with any of the budget channel tags.
+	BudgetActionRestrictTags BudgetAction = "restrict_tags"
+	// BudgetActionBlock rejects the requests.
+	BudgetActionBlock BudgetAction = "block"
+)
+
+func (a BudgetAction) IsValid() bool {
+	switch a {
+	case BudgetActionNone, BudgetActionDowngrade, BudgetActionRestrictTags, BudgetActionBlock:
+		return true
+	default:
+		return false
+	}
+}
+
+// DefaultBudgetThresholds are the alert thresholds of a budget without explicit thresholds.
+var DefaultBudgetThresholds = []int{50, 80, 100}
+
+type BudgetSettings struct {
+	// Thresholds are the percentages of the budget amount which send an alert when the spend crosses them.
+	Thresholds []int        `json:"thresholds,omitempty"`
+	Action     BudgetAction `json:"action,omitempty"`
+
+	// ModelMappings are applied to the requested models by the downgrade action.
+	ModelMappings []ModelMapping `json:"modelMappings,omitempty"`
+	// ChannelTags are the channels the requests are restricted to by the restrict_tags action.
+	ChannelTags []string `json:"channelTags,omitempty"`
+}
+
+// ThresholdsOrDefault returns the alert thresholds of the budget.
+func (s BudgetSettings) ThresholdsOrDefault() []int {
+	if len(s.Thresholds) == 0 {
+		return DefaultBudgetThresholds
+	}
+
+	return s.Thresholds
+}
+
+// ActionOrDefault returns the action of the budget.
+func (s BudgetSettings) ActionOrDefault() BudgetAction {
+	if s.Action == "" {
+		return BudgetActionNone
+	}
+
+	return s.Action
+}
@@ -0,0 +1,23 @@
+package objects
+
+import "strings"
+
+// NormalizeCurrencyCode returns the upper case currency code, the codes are compared case-insensitively.
+func NormalizeCurrencyCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// IsValidCurrencyCode reports whether the code is a three letter currency code, e.g. USD or CNY.
+func IsValidCurrencyCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+
+	for _, c := range code {
+		if (c < 'A' || c > 'Z') && (c < 'a' || c > 'z') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,13 @@
+package objects
+
+import "time"
+
+// DataExportCriteria selects the records of a data export, the empty fields do not filter.
+type DataExportCriteria struct {
+	// From is the start of the exported time range, inclusive.
+	From *time.Time `json:"from,omitempty"`
+	// To is the end of the exported time range, exclusive.
+	To        *time.Time `json:"to,omitempty"`
+	ModelIDs  []string   `json:"modelIDs,omitempty"`
+	APIKeyIDs []int      `json:"apiKeyIDs,omitempty"`
+}
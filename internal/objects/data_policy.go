@@ -0,0 +1,27 @@
+package objects
+
+// ProjectDataPolicy configures how the content of the requests of a project is captured and how long its data is kept.
+// The unset fields inherit the system storage policy.
+type ProjectDataPolicy struct {
+	// StoreRequestBody overrides whether the request bodies and headers are stored.
+	StoreRequestBody *bool `json:"storeRequestBody,omitempty"`
+	// StoreResponseBody overrides whether the response bodies and chunks are stored.
+	StoreResponseBody *bool `json:"storeResponseBody,omitempty"`
+	// RedactFields are the JSON paths replaced before the bodies are stored, e.g. "messages.#.content".
+	// A "#" segment matches every element of an array, a "*" segment matches every member of an object.
+	RedactFields []string `json:"redactFields,omitempty"`
+	// DataStorageID stores the content of the project in the data storage instead of the default one.
+	DataStorageID *int `json:"dataStorageID,omitempty"`
+	// Retention overrides the cleanup days of the data of the project.
+	Retention *ProjectRetentionPolicy `json:"retention,omitempty"`
+}
+
+// ProjectRetentionPolicy is the number of days the data of a project is kept, 0 keeps the data forever.
+// The executions, threads and traces inherit the requests days, which inherit the system cleanup days.
+type ProjectRetentionPolicy struct {
+	RequestsDays   *int `json:"requestsDays,omitempty"`
+	ExecutionsDays *int `json:"executionsDays,omitempty"`
+	UsageLogsDays  *int `json:"usageLogsDays,omitempty"`
+	ThreadsDays    *int `json:"threadsDays,omitempty"`
+	TracesDays     *int `json:"tracesDays,omitempty"`
+}
@@ -15,6 +15,12 @@ type DataStorageSettings struct {
 
 	// WebDAV is the webdav data storage.
 	WebDAV *WebDAV `json:"webdav"`
+
+	// AzureBlob is the azure blob data storage.
+	AzureBlob *AzureBlob `json:"azureBlob"`
+
+	// SFTP is the sftp data storage.
+	SFTP *SFTP `json:"sftp"`
 }
 
 type S3 struct {
@@ -40,3 +46,39 @@ type WebDAV struct {
 	InsecureSkipTLS bool   `json:"insecure_skip_tls"`
 	Path            string `json:"path"`
 }
+
+// The authentication types of the azure blob data storage.
+const (
+	AzureBlobAuthSharedKey       = "shared_key"
+	AzureBlobAuthSAS             = "sas"
+	AzureBlobAuthManagedIdentity = "managed_identity"
+)
+
+type AzureBlob struct {
+	AccountName   string `json:"accountName"`
+	ContainerName string `json:"containerName"`
+	// Endpoint is the blob service endpoint, https://<account>.blob.core.windows.net by default.
+	// For Azurite, use http://127.0.0.1:10000/<account>.
+	Endpoint string `json:"endpoint"`
+	// AuthType is one of shared_key, sas and managed_identity.
+	AuthType   string `json:"authType"`
+	AccountKey string `json:"accountKey"`
+	SASToken   string `json:"sasToken"`
+	// ClientID selects a user-assigned managed identity, the system-assigned identity is used if empty.
+	ClientID string `json:"clientID"`
+}
+
+type SFTP struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	// PrivateKey is the PEM encoded private key, tried before the password when set.
+	PrivateKey           string `json:"privateKey"`
+	PrivateKeyPassphrase string `json:"privateKeyPassphrase"`
+	// HostKey is the public key of the server in the authorized_keys format.
+	HostKey             string `json:"hostKey"`
+	InsecureSkipHostKey bool   `json:"insecureSkipHostKey"`
+	// Path is the root directory of the data, the login directory if empty.
+	Path string `json:"path"`
+}
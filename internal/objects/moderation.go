@@ -0,0 +1,60 @@
+package objects
+
+type ModerationAction string
+
+const (
+	// ModerationActionBlock rejects the request when the moderation model flags the content.
+	ModerationActionBlock ModerationAction = "block"
+	// ModerationActionFlag only records the verdict and lets the request continue.
+	ModerationActionFlag ModerationAction = "flag"
+)
+
+type ModerationStage string
+
+const (
+	ModerationStageInput  ModerationStage = "input"
+	ModerationStageOutput ModerationStage = "output"
+)
+
+// ProjectModerationSettings configures the moderation guardrail of a project.
+// When enabled, every chat request of the project is checked by the configured
+// moderation model before it is sent upstream, and optionally the response is checked too.
+type ProjectModerationSettings struct {
+	Enabled bool `json:"enabled"`
+	// Model is the moderation model used for the check, e.g. omni-moderation-latest.
+	Model string `json:"model"`
+	// ChannelIDs restricts the moderation call to the given channels, empty means any channel supporting the model.
+	ChannelIDs  []int            `json:"channelIDs,omitempty"`
+	CheckInput  bool             `json:"checkInput"`
+	CheckOutput bool             `json:"checkOutput"`
+	Action      ModerationAction `json:"action"`
+	// Thresholds overrides the provider flag per category, a category is triggered when its score >= threshold.
+	Thresholds []ModerationThreshold `json:"thresholds,omitempty"`
+	// FailOpen lets the request continue when the moderation call itself fails.
+	FailOpen bool `json:"failOpen"`
+}
+
+type ModerationThreshold struct {
+	Category string  `json:"category"`
+	Score    float64 `json:"score"`
+}
+
+// ModerationVerdict is the result of one moderation check stored on the request.
+type ModerationVerdict struct {
+	Stage   ModerationStage  `json:"stage"`
+	Model   string           `json:"model"`
+	Action  ModerationAction `json:"action"`
+	Flagged bool             `json:"flagged"`
+	Blocked bool             `json:"blocked"`
+	// Categories lists the triggered categories.
+	Categories []string                  `json:"categories,omitempty"`
+	Scores     []ModerationCategoryScore `json:"scores,omitempty"`
+	// LatencyMs is the latency added by the moderation call, the cost is recorded on the moderation request itself.
+	LatencyMs int64  `json:"latencyMs"`
+	Error     string `json:"error,omitempty"`
+}
+
+type ModerationCategoryScore struct {
+	Category string  `json:"category"`
+	Score    float64 `json:"score"`
+}
@@ -2,6 +2,7 @@ package objects
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -182,6 +183,10 @@ func (p *ModelPrice) Validate() error {
 		}
 	}
 
+	if p.Currency != "" && !IsValidCurrencyCode(p.Currency) {
+		return fmt.Errorf("invalid currency code: %s", p.Currency)
+	}
+
 	return nil
 }
 
@@ -338,6 +343,10 @@ type ModelPrice struct {
 
 	// Schedule is the optional time-based price override configuration.
 	Schedule *PriceSchedule `json:"schedule,omitempty"`
+
+	// Currency is the currency code of the price, e.g. USD or CNY, empty for the reporting currency.
+	// The costs are converted into the reporting currency with the exchange rates.
+	Currency string `json:"currency,omitempty"`
 }
 
 func (p *ModelPrice) Equals(other ModelPrice) bool {
@@ -359,7 +368,7 @@ func (p *ModelPrice) Equals(other ModelPrice) bool {
 		return false
 	}
 
-	return true
+	return strings.EqualFold(p.Currency, other.Currency)
 }
 
 // PriceSchedule defines time-based price override configuration.
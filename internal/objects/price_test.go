@@ -437,4 +437,12 @@ func TestModelPrice_Validate(t *testing.T) {
 		assert.Contains(t, err.Error(), "promptWriteCacheVariants[0]")
 		assert.Contains(t, err.Error(), "usagePerUnit is required")
 	})
+
+	t.Run("currency must be a currency code", func(t *testing.T) {
+		require.NoError(t, (&ModelPrice{Currency: "cny"}).Validate())
+
+		err := (&ModelPrice{Currency: "YUAN"}).Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid currency code")
+	})
 }
@@ -51,11 +51,16 @@ const (
 	ScopeReadRequests ScopeSlug = "read_requests"
 	// ScopeWriteRequests manage the requests of the project.
 	ScopeWriteRequests ScopeSlug = "write_requests"
+	// ScopeDebugRequests view the load balancing decisions of the requests in the responses.
+	ScopeDebugRequests ScopeSlug = "debug_requests"
 
 	// ScopeReadPrompts read the prompts of the project.
 	ScopeReadPrompts ScopeSlug = "read_prompts"
 	// ScopeWritePrompts manage the prompts of the project.
 	ScopeWritePrompts ScopeSlug = "write_prompts"
+
+	// ScopeReadAuditLogs read the audit logs of the system.
+	ScopeReadAuditLogs ScopeSlug = "read_audit_logs"
 )
 
 type ScopeLevel string
@@ -163,6 +168,11 @@ var scopeConfigs = []Scope{
 		Description: "Manage request records",
 		Levels:      []ScopeLevel{ScopeLevelSystem, ScopeLevelProject},
 	},
+	{
+		Slug:        ScopeDebugRequests,
+		Description: "View load balancing decisions in request responses",
+		Levels:      []ScopeLevel{ScopeLevelSystem, ScopeLevelProject},
+	},
 	{
 		Slug:        ScopeReadPrompts,
 		Description: "View prompts",
@@ -173,6 +183,11 @@ var scopeConfigs = []Scope{
 		Description: "Manage prompts (create, edit, delete)",
 		Levels:      []ScopeLevel{ScopeLevelSystem, ScopeLevelProject},
 	},
+	{
+		Slug:        ScopeReadAuditLogs,
+		Description: "View audit logs",
+		Levels:      []ScopeLevel{ScopeLevelSystem},
+	},
 }
 
 // AllScopes returns all available scopes, optionally filtered by level.
@@ -24,6 +24,8 @@ type AiSdkHandlersParams struct {
 	PromptService               *biz.PromptService
 	PromptProtectionRuleService *biz.PromptProtectionRuleService
 	QuotaService                *biz.QuotaService
+	BudgetService               *biz.BudgetService
+	WalletService               *biz.WalletService
 	HttpClient                  *httpclient.HttpClient
 	LiveStreamRegistry          *biz.LiveStreamRegistry
 	ChannelLimiterManager       *orchestrator.ChannelLimiterManager
@@ -47,6 +49,8 @@ func NewAiSDKHandlers(params AiSdkHandlersParams) *AiSDKHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
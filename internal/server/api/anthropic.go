@@ -28,6 +28,8 @@ type AnthropicHandlersParams struct {
 	PromptService   *biz.PromptService
 	PromptProtectionRuleService *biz.PromptProtectionRuleService
 	QuotaService    *biz.QuotaService
+	BudgetService   *biz.BudgetService
+	WalletService   *biz.WalletService
 	HttpClient      *httpclient.HttpClient
 	LiveStreamRegistry *biz.LiveStreamRegistry
 	ChannelLimiterManager       *orchestrator.ChannelLimiterManager
@@ -54,6 +56,8 @@ func NewAnthropicHandlers(params AnthropicHandlersParams) *AnthropicHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
@@ -1,23 +1,113 @@
 package api
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
 	"go.uber.org/fx"
 
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/server/biz"
 )
 
 type APIKeyHandlersParams struct {
 	fx.In
 
-	APIKeyService *biz.APIKeyService
+	APIKeyService      *biz.APIKeyService
+	APIKeyTokenService *biz.APIKeyTokenService
 }
 
 type APIKeyHandlers struct {
-	APIKeyService *biz.APIKeyService
+	APIKeyService      *biz.APIKeyService
+	APIKeyTokenService *biz.APIKeyTokenService
 }
 
 func NewAPIKeyHandlers(params APIKeyHandlersParams) *APIKeyHandlers {
 	return &APIKeyHandlers{
-		APIKeyService: params.APIKeyService,
+		APIKeyService:      params.APIKeyService,
+		APIKeyTokenService: params.APIKeyTokenService,
+	}
+}
+
+type MintAPIKeyTokenRequest struct {
+	EndUserID string           `json:"endUserID" binding:"required"`
+	Models    []string         `json:"models"`
+	Budget    *decimal.Decimal `json:"budget"`
+	// ExpiresIn is the token lifetime in seconds.
+	ExpiresIn int64 `json:"expiresIn"`
+}
+
+type MintAPIKeyTokenResponse struct {
+	Token     string    `json:"token"`
+	EndUserID string    `json:"endUserID"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type RevokeAPIKeyTokensRequest struct {
+	// EndUserID revokes the tokens of one end user, empty revokes all tokens of the API key.
+	EndUserID string `json:"endUserID"`
+}
+
+// MintToken mints a short-lived token for an end user of the calling service account API key.
+func (h *APIKeyHandlers) MintToken(c *gin.Context) {
+	apiKey, ok := contexts.GetAPIKey(c.Request.Context())
+	if !ok {
+		JSONError(c, http.StatusUnauthorized, errors.New("API key is required"))
+		return
+	}
+
+	var req MintAPIKeyTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		JSONError(c, http.StatusBadRequest, errors.New("invalid token request"))
+		return
 	}
+
+	token, info, err := h.APIKeyTokenService.MintToken(c.Request.Context(), apiKey, biz.MintAPIKeyTokenInput{
+		EndUserID: req.EndUserID,
+		Models:    req.Models,
+		Budget:    req.Budget,
+		ExpiresIn: time.Duration(req.ExpiresIn) * time.Second,
+	})
+	if err != nil {
+		JSONError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, MintAPIKeyTokenResponse{
+		Token:     token,
+		EndUserID: info.EndUserID,
+		ExpiresAt: info.ExpiresAt,
+	})
+}
+
+// RevokeTokens revokes the tokens minted by the calling service account API key.
+func (h *APIKeyHandlers) RevokeTokens(c *gin.Context) {
+	apiKey, ok := contexts.GetAPIKey(c.Request.Context())
+	if !ok {
+		JSONError(c, http.StatusUnauthorized, errors.New("API key is required"))
+		return
+	}
+
+	var req RevokeAPIKeyTokensRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		JSONError(c, http.StatusBadRequest, errors.New("invalid revoke request"))
+		return
+	}
+
+	// A service account can always revoke its own tokens, it does not need the write_api_keys scope.
+	_, err := authz.RunWithSystemBypass(c.Request.Context(), "api-key-token-revoke", func(bypassCtx context.Context) (*ent.APIKey, error) {
+		return h.APIKeyTokenService.RevokeTokens(bypassCtx, apiKey.ID, req.EndUserID)
+	})
+	if err != nil {
+		JSONError(c, http.StatusInternalServerError, errors.New("failed to revoke tokens"))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
 }
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/gin-contrib/sse"
 	"github.com/gin-gonic/gin"
@@ -89,32 +90,67 @@ func (handlers *ChatCompletionHandlers) ChatCompletionWithRequest(c *gin.Context
 			contentType = ct
 		}
 
+		if result.Explainer != nil {
+			writeExplanationHeaders(c, result.Explainer.Explain(ctx))
+		}
+
 		c.Data(resp.StatusCode, contentType, resp.Body)
 
 		return
 	}
 
 	if result.ChatCompletionStream != nil {
-		defer func() {
+		closeStream := sync.OnceFunc(func() {
 			log.Debug(ctx, "Close chat stream")
 
 			err := result.ChatCompletionStream.Close()
 			if err != nil {
 				logger.Error(ctx, "Error closing stream", log.Cause(err))
 			}
-		}()
+		})
+		defer closeStream()
 
 		c.Header("Access-Control-Allow-Origin", "*")
 
+		// The usage of a stream is only known once it is closed, so the headers only carry the routing.
+		if result.Explainer != nil {
+			writeExplanationHeaders(c, result.Explainer.Routing())
+		}
+
 		streamWriter := handlers.StreamWriter
 		if streamWriter == nil {
 			streamWriter = WriteSSEStream
 		}
 
 		streamWriter(c, newUpstreamErrorStream(ctx, result.ChatCompletionStream, handlers.ChatCompletionOrchestrator.SystemService))
+
+		if result.Explainer != nil {
+			writeExplanationEvent(c, result.Explainer, closeStream)
+		}
+	}
+}
+
+func writeExplanationHeaders(c *gin.Context, explanation *orchestrator.Explanation) {
+	for name, values := range explanation.Headers() {
+		for _, value := range values {
+			c.Writer.Header().Add(name, value)
+		}
 	}
 }
 
+// writeExplanationEvent closes the stream to record its usage, and sends the full explanation as the trailer event of an SSE stream.
+func writeExplanationEvent(c *gin.Context, explainer *orchestrator.RequestExplainer, closeStream func()) {
+	ctx := c.Request.Context()
+	if ctx.Err() != nil || !strings.HasPrefix(c.Writer.Header().Get("Content-Type"), sse.ContentType) {
+		return
+	}
+
+	closeStream()
+
+	c.SSEvent(orchestrator.ExplanationEvent, explainer.Explain(ctx))
+	c.Writer.Flush()
+}
+
 // StreamErrorFormatter formats a stream error into a JSON-serializable object for SSE error events.
 type StreamErrorFormatter func(ctx context.Context, err error) any
 
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/dataexport"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+type DataExportHandlersParams struct {
+	fx.In
+
+	DataExportService *biz.DataExportService
+}
+
+type DataExportHandlers struct {
+	DataExportService *biz.DataExportService
+}
+
+func NewDataExportHandlers(params DataExportHandlersParams) *DataExportHandlers {
+	return &DataExportHandlers{
+		DataExportService: params.DataExportService,
+	}
+}
+
+type DownloadDataExportRequest struct {
+	ExportID int `uri:"export_id"`
+}
+
+// DownloadDataExport downloads the file of a completed data export of the project.
+func (h *DataExportHandlers) DownloadDataExport(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	projectID, ok := contexts.GetProjectID(ctx)
+	if !ok || projectID <= 0 {
+		JSONError(c, http.StatusBadRequest, errors.New("Project ID not found in context"))
+		return
+	}
+
+	var req DownloadDataExportRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		JSONError(c, http.StatusBadRequest, fmt.Errorf("Invalid request: %w", err))
+		return
+	}
+
+	exp, err := ent.FromContext(ctx).DataExport.Get(ctx, req.ExportID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			JSONError(c, http.StatusNotFound, errors.New("Data export not found"))
+			return
+		}
+		JSONError(c, http.StatusInternalServerError, errors.New("Failed to load data export"))
+		return
+	}
+
+	if projectID != exp.ProjectID {
+		JSONError(c, http.StatusNotFound, errors.New("Data export not found"))
+		return
+	}
+
+	if exp.Status != dataexport.StatusCompleted || exp.StorageKey == nil {
+		JSONError(c, http.StatusConflict, errors.New("Data export is not completed"))
+		return
+	}
+
+	f, size, err := h.DataExportService.OpenDataExport(ctx, exp)
+	if err != nil {
+		log.Warn(ctx, "Failed to open data export", log.Int("data_export_id", exp.ID), log.Cause(err))
+		JSONError(c, http.StatusNotFound, errors.New("Data export file not found"))
+		return
+	}
+	defer f.Close()
+
+	filename := fmt.Sprintf("export-%d-%s", exp.ID, path.Base(*exp.StorageKey))
+
+	c.Header("Content-Length", fmt.Sprintf("%d", size))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Header("Content-Type", biz.DataExportContentType(exp.Format))
+	c.Header("Cache-Control", "private, max-age=0, no-cache")
+
+	c.Status(http.StatusOK)
+	_, _ = io.Copy(c.Writer, f)
+}
@@ -29,6 +29,8 @@ type DoubaoHandlersParams struct {
 	PromptService               *biz.PromptService
 	PromptProtectionRuleService *biz.PromptProtectionRuleService
 	QuotaService                *biz.QuotaService
+	BudgetService               *biz.BudgetService
+	WalletService               *biz.WalletService
 	HttpClient                  *httpclient.HttpClient
 	LiveStreamRegistry          *biz.LiveStreamRegistry
 	ChannelLimiterManager       *orchestrator.ChannelLimiterManager
@@ -56,6 +58,8 @@ func NewDoubaoHandlers(params DoubaoHandlersParams) *DoubaoHandlers {
 			params.UsageLogService,
 			params.PromptService,
 			params.QuotaService,
+			params.BudgetService,
+			params.WalletService,
 			params.PromptProtectionRuleService,
 			params.LiveStreamRegistry,
 			params.ChannelLimiterManager,
@@ -22,6 +22,10 @@ var Module = fx.Module("api",
 	fx.Provide(NewCopilotHandlers),
 	fx.Provide(NewRequestContentHandlers),
 	fx.Provide(NewOIDCHandlers),
+	fx.Provide(NewSAMLHandlers),
+	fx.Provide(NewSCIMHandlers),
 	fx.Provide(NewRequestPreviewHandlers),
+	fx.Provide(NewInvoiceHandlers),
+	fx.Provide(NewDataExportHandlers),
 	fx.Invoke(initLogger),
 )
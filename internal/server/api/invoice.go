@@ -0,0 +1,90 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/pkg/xerrors"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+type InvoiceHandlersParams struct {
+	fx.In
+
+	InvoiceService *biz.InvoiceService
+}
+
+type InvoiceHandlers struct {
+	InvoiceService *biz.InvoiceService
+}
+
+func NewInvoiceHandlers(params InvoiceHandlersParams) *InvoiceHandlers {
+	return &InvoiceHandlers{
+		InvoiceService: params.InvoiceService,
+	}
+}
+
+type ExportInvoiceRequest struct {
+	InvoiceID int    `uri:"invoice_id"`
+	Format    string `form:"format"`
+}
+
+// ExportInvoice downloads the invoice as CSV, JSON or HTML, selected with the format query parameter, CSV by default.
+func (h *InvoiceHandlers) ExportInvoice(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	projectID, ok := contexts.GetProjectID(ctx)
+	if !ok || projectID <= 0 {
+		JSONError(c, http.StatusBadRequest, errors.New("Project ID not found in context"))
+		return
+	}
+
+	var req ExportInvoiceRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		JSONError(c, http.StatusBadRequest, fmt.Errorf("Invalid request: %w", err))
+		return
+	}
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		JSONError(c, http.StatusBadRequest, fmt.Errorf("Invalid request: %w", err))
+		return
+	}
+
+	if req.Format == "" {
+		req.Format = biz.InvoiceExportFormatCSV
+	}
+
+	inv, err := ent.FromContext(ctx).Invoice.Get(ctx, req.InvoiceID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			JSONError(c, http.StatusNotFound, errors.New("Invoice not found"))
+			return
+		}
+		JSONError(c, http.StatusInternalServerError, errors.New("Failed to load invoice"))
+		return
+	}
+
+	if projectID != inv.ProjectID {
+		JSONError(c, http.StatusNotFound, errors.New("Invoice not found"))
+		return
+	}
+
+	export, err := h.InvoiceService.ExportInvoice(ctx, inv, req.Format)
+	if err != nil {
+		if _, ok := xerrors.IsCodedError(err); ok {
+			JSONError(c, http.StatusBadRequest, err)
+			return
+		}
+		JSONError(c, http.StatusInternalServerError, errors.New("Failed to export invoice"))
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, export.Filename))
+	c.Data(http.StatusOK, export.ContentType, export.Content)
+}
@@ -22,6 +22,8 @@ type JinaHandlersParams struct {
 	PromptService   *biz.PromptService
 	PromptProtectionRuleService *biz.PromptProtectionRuleService
 	QuotaService    *biz.QuotaService
+	BudgetService   *biz.BudgetService
+	WalletService   *biz.WalletService
 	HttpClient      *httpclient.HttpClient
 	LiveStreamRegistry *biz.LiveStreamRegistry
 	ChannelLimiterManager       *orchestrator.ChannelLimiterManager
@@ -41,6 +43,8 @@ func NewJinaHandlers(params JinaHandlersParams) *JinaHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
@@ -58,6 +62,8 @@ func NewJinaHandlers(params JinaHandlersParams) *JinaHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
@@ -176,8 +176,13 @@ func (h *OIDCHandlers) Callback(c *gin.Context) {
 }
 
 func (h *OIDCHandlers) getBaseURL(c *gin.Context) string {
-	if h.publicURL != "" {
-		return strings.TrimSuffix(h.publicURL, "/")
+	return resolveBaseURL(c, h.publicURL)
+}
+
+// resolveBaseURL returns the public URL of the server, falling back to the request host.
+func resolveBaseURL(c *gin.Context, publicURL string) string {
+	if publicURL != "" {
+		return strings.TrimSuffix(publicURL, "/")
 	}
 
 	// Fallback to request host if publicURL is not configured.
@@ -38,6 +38,8 @@ type OpenAIHandlersParams struct {
 	PromptService               *biz.PromptService
 	PromptProtectionRuleService *biz.PromptProtectionRuleService
 	QuotaService                *biz.QuotaService
+	BudgetService               *biz.BudgetService
+	WalletService               *biz.WalletService
 	HttpClient                  *httpclient.HttpClient
 	LiveStreamRegistry          *biz.LiveStreamRegistry
 	ChannelLimiterManager       *orchestrator.ChannelLimiterManager
@@ -88,6 +90,8 @@ func NewOpenAIHandlers(params OpenAIHandlersParams) *OpenAIHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
@@ -105,6 +109,8 @@ func NewOpenAIHandlers(params OpenAIHandlersParams) *OpenAIHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
@@ -122,6 +128,8 @@ func NewOpenAIHandlers(params OpenAIHandlersParams) *OpenAIHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
@@ -139,6 +147,8 @@ func NewOpenAIHandlers(params OpenAIHandlersParams) *OpenAIHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
@@ -156,6 +166,8 @@ func NewOpenAIHandlers(params OpenAIHandlersParams) *OpenAIHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
@@ -173,6 +185,8 @@ func NewOpenAIHandlers(params OpenAIHandlersParams) *OpenAIHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
@@ -190,6 +204,8 @@ func NewOpenAIHandlers(params OpenAIHandlersParams) *OpenAIHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
@@ -207,6 +223,8 @@ func NewOpenAIHandlers(params OpenAIHandlersParams) *OpenAIHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
@@ -224,6 +242,8 @@ func NewOpenAIHandlers(params OpenAIHandlersParams) *OpenAIHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
@@ -241,6 +261,8 @@ func NewOpenAIHandlers(params OpenAIHandlersParams) *OpenAIHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
@@ -264,6 +286,8 @@ func NewOpenAIHandlers(params OpenAIHandlersParams) *OpenAIHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
@@ -282,6 +306,8 @@ func NewOpenAIHandlers(params OpenAIHandlersParams) *OpenAIHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
@@ -299,6 +325,8 @@ func NewOpenAIHandlers(params OpenAIHandlersParams) *OpenAIHandlers {
 				params.UsageLogService,
 				params.PromptService,
 				params.QuotaService,
+				params.BudgetService,
+				params.WalletService,
 				params.PromptProtectionRuleService,
 				params.LiveStreamRegistry,
 				params.ChannelLimiterManager,
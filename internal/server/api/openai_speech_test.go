@@ -196,6 +196,8 @@ func TestOpenAIHandlers_CreateSpeech_AudioStreamEndToEnd(t *testing.T) {
 		usageLogService,
 		nil,
 		nil,
+		nil,
+		nil,
 		promptProtectionService,
 		biz.NewLiveStreamRegistry(),
 		orchestrator.NewChannelLimiterManager(),
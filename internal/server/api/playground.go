@@ -41,6 +41,8 @@ type PlaygroundHandlersParams struct {
 	PromptService   *biz.PromptService
 	PromptProtectionRuleService *biz.PromptProtectionRuleService
 	QuotaService    *biz.QuotaService
+	BudgetService   *biz.BudgetService
+	WalletService   *biz.WalletService
 	HttpClient      *httpclient.HttpClient
 	LiveStreamRegistry *biz.LiveStreamRegistry
 	ChannelLimiterManager       *orchestrator.ChannelLimiterManager
@@ -65,6 +67,8 @@ func NewPlaygroundHandlers(params PlaygroundHandlersParams) *PlaygroundHandlers
 			params.UsageLogService,
 			params.PromptService,
 			params.QuotaService,
+			params.BudgetService,
+			params.WalletService,
 			params.PromptProtectionRuleService,
 			params.LiveStreamRegistry,
 			params.ChannelLimiterManager,
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/fx"
@@ -14,6 +15,7 @@ import (
 	"github.com/looplj/axonhub/internal/contexts"
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/log"
 	"github.com/looplj/axonhub/internal/server/biz"
 )
 
@@ -21,15 +23,18 @@ type RequestContentHandlersParams struct {
 	fx.In
 
 	DataStorageService *biz.DataStorageService
+	RequestService     *biz.RequestService
 }
 
 type RequestContentHandlers struct {
 	DataStorageService *biz.DataStorageService
+	RequestService     *biz.RequestService
 }
 
 func NewRequestContentHandlers(params RequestContentHandlersParams) *RequestContentHandlers {
 	return &RequestContentHandlers{
 		DataStorageService: params.DataStorageService,
+		RequestService:     params.RequestService,
 	}
 }
 
@@ -165,3 +170,75 @@ func filenameFromKey(key string, requestID int) string {
 	}
 	return filename
 }
+
+type ExportRequestArchivesRequest struct {
+	From   string `form:"from" binding:"required"`
+	To     string `form:"to" binding:"required"`
+	Format string `form:"format"`
+}
+
+// parseExportTime parses a RFC 3339 time or a date, the dates are in UTC.
+func parseExportTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(time.DateOnly, value)
+}
+
+// ExportRequestArchives downloads the archived requests of the project created in [from, to) as JSON lines or Parquet,
+// selected with the format query parameter, JSON lines by default.
+func (h *RequestContentHandlers) ExportRequestArchives(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	projectID, ok := contexts.GetProjectID(ctx)
+	if !ok || projectID <= 0 {
+		JSONError(c, http.StatusBadRequest, errors.New("Project ID not found in context"))
+		return
+	}
+
+	var req ExportRequestArchivesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		JSONError(c, http.StatusBadRequest, fmt.Errorf("Invalid request: %w", err))
+		return
+	}
+
+	from, err := parseExportTime(req.From)
+	if err != nil {
+		JSONError(c, http.StatusBadRequest, fmt.Errorf("Invalid from: %w", err))
+		return
+	}
+
+	to, err := parseExportTime(req.To)
+	if err != nil {
+		JSONError(c, http.StatusBadRequest, fmt.Errorf("Invalid to: %w", err))
+		return
+	}
+
+	if req.Format == "" {
+		req.Format = biz.RequestArchiveExportFormatJSONL
+	}
+
+	input := biz.RequestArchiveExportInput{
+		ProjectID: projectID,
+		From:      from,
+		To:        to,
+		Format:    req.Format,
+	}
+
+	if err := input.Validate(); err != nil {
+		JSONError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	filename := fmt.Sprintf("requests-%d-%s-%s.%s", projectID, from.UTC().Format(time.DateOnly), to.UTC().Format(time.DateOnly), req.Format)
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Header("Content-Type", input.ContentType())
+	c.Status(http.StatusOK)
+
+	// The export is streamed, an error after the first bytes can only be logged.
+	if err := h.RequestService.ExportArchivedRequests(ctx, input, c.Writer); err != nil {
+		log.Error(ctx, "Failed to export request archives", log.Cause(err), log.Int("project_id", projectID))
+	}
+}
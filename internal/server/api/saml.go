@@ -0,0 +1,120 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// SAMLHandlers serves the SAML 2.0 service provider endpoints.
+// A successful assertion ends in the same exchange code redirect as the OIDC callback.
+type SAMLHandlers struct {
+	saml      *biz.SAMLService
+	publicURL string
+}
+
+type SAMLHandlerParams struct {
+	fx.In
+
+	SAMLService *biz.SAMLService
+	PublicURL   string `name:"public_url"`
+}
+
+func NewSAMLHandlers(params SAMLHandlerParams) *SAMLHandlers {
+	if params.SAMLService.CountProviders() > 0 && params.PublicURL == "" {
+		log.Warn(contexts.WithUser(context.Background(), &ent.User{IsOwner: true}), "SAML is enabled but server.public_url is not configured. The SP entity ID and ACS URL will change with the request host.")
+	}
+
+	return &SAMLHandlers{
+		saml:      params.SAMLService,
+		publicURL: params.PublicURL,
+	}
+}
+
+func (h *SAMLHandlers) RegisterRoutes(r gin.IRouter) {
+	group := r.Group("/saml")
+	group.GET("/providers", h.GetProviders)
+	group.GET("/metadata/:provider", h.Metadata)
+	group.GET("/authorize/:provider", h.GetAuthorizeURL)
+	group.GET("/login/:provider", h.Login)
+	group.POST("/acs/:provider", h.ACS)
+}
+
+func (h *SAMLHandlers) GetProviders(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"data": h.saml.GetProviders(),
+	})
+}
+
+func (h *SAMLHandlers) Metadata(c *gin.Context) {
+	metadata, err := h.saml.Metadata(c.Request.Context(), c.Param("provider"), resolveBaseURL(c, h.publicURL))
+	if err != nil {
+		_ = c.Error(err)
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	c.Data(http.StatusOK, "application/samlmetadata+xml", metadata)
+}
+
+// GetAuthorizeURL returns the IdP redirect URL in the same shape as the OIDC authorize endpoint.
+func (h *SAMLHandlers) GetAuthorizeURL(c *gin.Context) {
+	loginURL, err := h.saml.GetLoginURL(c.Request.Context(), c.Param("provider"), resolveBaseURL(c, h.publicURL))
+	if err != nil {
+		_ = c.Error(err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": gin.H{
+			"url": loginURL,
+		},
+	})
+}
+
+// Login redirects the browser to the IdP, so it can be used as a plain sign-in link.
+func (h *SAMLHandlers) Login(c *gin.Context) {
+	baseURL := resolveBaseURL(c, h.publicURL)
+
+	loginURL, err := h.saml.GetLoginURL(c.Request.Context(), c.Param("provider"), baseURL)
+	if err != nil {
+		_ = c.Error(err)
+		c.Redirect(http.StatusFound, fmt.Sprintf("%s/oauth/oidc/idp-callback?error=auth_failed&error_description=%s", baseURL, url.QueryEscape(err.Error())))
+
+		return
+	}
+
+	c.Redirect(http.StatusFound, loginURL)
+}
+
+func (h *SAMLHandlers) ACS(c *gin.Context) {
+	baseURL := resolveBaseURL(c, h.publicURL)
+
+	samlResponse := c.PostForm("SAMLResponse")
+	if samlResponse == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "SAMLResponse is required"})
+		return
+	}
+
+	exchangeCode, err := h.saml.ConsumeAssertion(c.Request.Context(), c.Param("provider"), baseURL, samlResponse, c.PostForm("RelayState"))
+	if err != nil {
+		_ = c.Error(err)
+		c.Redirect(http.StatusFound, fmt.Sprintf("%s/oauth/oidc/idp-callback?error=auth_failed&error_description=%s", baseURL, url.QueryEscape(err.Error())))
+
+		return
+	}
+
+	c.Redirect(http.StatusFound, baseURL+"/oauth/oidc/idp-callback?code="+exchangeCode)
+}
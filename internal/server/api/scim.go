@@ -0,0 +1,247 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+const scimContentType = "application/scim+json"
+
+type SCIMHandlersParams struct {
+	fx.In
+
+	SCIMService *biz.SCIMService
+}
+
+// SCIMHandlers serves the SCIM 2.0 provisioning endpoints, see RFC 7644.
+type SCIMHandlers struct {
+	SCIMService *biz.SCIMService
+}
+
+func NewSCIMHandlers(params SCIMHandlersParams) *SCIMHandlers {
+	return &SCIMHandlers{
+		SCIMService: params.SCIMService,
+	}
+}
+
+func (h *SCIMHandlers) GetServiceProviderConfig(c *gin.Context) {
+	writeSCIM(c, http.StatusOK, gin.H{
+		"schemas":        []string{objects.SCIMSchemaServiceProviderConfig},
+		"patch":          gin.H{"supported": true},
+		"bulk":           gin.H{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         gin.H{"supported": true, "maxResults": biz.SCIMMaxCount},
+		"changePassword": gin.H{"supported": false},
+		"sort":           gin.H{"supported": false},
+		"etag":           gin.H{"supported": false},
+		"authenticationSchemes": []gin.H{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "OAuth Bearer Token",
+				"description": "Authentication with the SCIM token configured in AxonHub",
+				"primary":     true,
+			},
+		},
+	})
+}
+
+func (h *SCIMHandlers) ListUsers(c *gin.Context) {
+	startIndex, count := scimPage(c)
+
+	resp, err := h.SCIMService.ListUsers(c.Request.Context(), c.Query("filter"), startIndex, count)
+	if err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+
+	writeSCIM(c, http.StatusOK, resp)
+}
+
+func (h *SCIMHandlers) GetUser(c *gin.Context) {
+	resp, err := h.SCIMService.GetUser(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+
+	writeSCIM(c, http.StatusOK, resp)
+}
+
+func (h *SCIMHandlers) CreateUser(c *gin.Context) {
+	var req objects.SCIMUser
+	if !bindSCIM(c, &req) {
+		return
+	}
+
+	resp, err := h.SCIMService.CreateUser(c.Request.Context(), req)
+	if err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+
+	writeSCIM(c, http.StatusCreated, resp)
+}
+
+func (h *SCIMHandlers) ReplaceUser(c *gin.Context) {
+	var req objects.SCIMUser
+	if !bindSCIM(c, &req) {
+		return
+	}
+
+	resp, err := h.SCIMService.ReplaceUser(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+
+	writeSCIM(c, http.StatusOK, resp)
+}
+
+func (h *SCIMHandlers) PatchUser(c *gin.Context) {
+	var req objects.SCIMPatchRequest
+	if !bindSCIM(c, &req) {
+		return
+	}
+
+	resp, err := h.SCIMService.PatchUser(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+
+	writeSCIM(c, http.StatusOK, resp)
+}
+
+func (h *SCIMHandlers) DeleteUser(c *gin.Context) {
+	if err := h.SCIMService.DeleteUser(c.Request.Context(), c.Param("id")); err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *SCIMHandlers) ListGroups(c *gin.Context) {
+	startIndex, count := scimPage(c)
+
+	resp, err := h.SCIMService.ListGroups(c.Request.Context(), c.Query("filter"), startIndex, count)
+	if err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+
+	writeSCIM(c, http.StatusOK, resp)
+}
+
+func (h *SCIMHandlers) GetGroup(c *gin.Context) {
+	resp, err := h.SCIMService.GetGroup(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+
+	writeSCIM(c, http.StatusOK, resp)
+}
+
+func (h *SCIMHandlers) CreateGroup(c *gin.Context) {
+	var req objects.SCIMGroup
+	if !bindSCIM(c, &req) {
+		return
+	}
+
+	resp, err := h.SCIMService.CreateGroup(c.Request.Context(), req)
+	if err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+
+	writeSCIM(c, http.StatusCreated, resp)
+}
+
+func (h *SCIMHandlers) ReplaceGroup(c *gin.Context) {
+	var req objects.SCIMGroup
+	if !bindSCIM(c, &req) {
+		return
+	}
+
+	resp, err := h.SCIMService.ReplaceGroup(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+
+	writeSCIM(c, http.StatusOK, resp)
+}
+
+func (h *SCIMHandlers) PatchGroup(c *gin.Context) {
+	var req objects.SCIMPatchRequest
+	if !bindSCIM(c, &req) {
+		return
+	}
+
+	resp, err := h.SCIMService.PatchGroup(c.Request.Context(), c.Param("id"), req)
+	if err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+
+	writeSCIM(c, http.StatusOK, resp)
+}
+
+func (h *SCIMHandlers) DeleteGroup(c *gin.Context) {
+	if err := h.SCIMService.DeleteGroup(c.Request.Context(), c.Param("id")); err != nil {
+		writeSCIMError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// scimPage parses the 1-based startIndex and the count query parameters.
+func scimPage(c *gin.Context) (int, int) {
+	startIndex, err := strconv.Atoi(c.Query("startIndex"))
+	if err != nil {
+		startIndex = 1
+	}
+
+	count, err := strconv.Atoi(c.Query("count"))
+	if err != nil {
+		count = biz.SCIMDefaultCount
+	}
+
+	return startIndex, count
+}
+
+func bindSCIM(c *gin.Context, req any) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		writeSCIMError(c, objects.NewSCIMError(http.StatusBadRequest, "invalidSyntax", "invalid request body"))
+		return false
+	}
+
+	return true
+}
+
+func writeSCIM(c *gin.Context, status int, resp any) {
+	c.Header("Content-Type", scimContentType)
+	c.JSON(status, resp)
+}
+
+func writeSCIMError(c *gin.Context, err error) {
+	_ = c.Error(err)
+
+	var scimErr *objects.SCIMError
+	if !errors.As(err, &scimErr) {
+		log.Error(c.Request.Context(), "SCIM request failed", log.Cause(err))
+
+		scimErr = objects.NewSCIMError(http.StatusInternalServerError, "", "internal server error")
+	}
+
+	writeSCIM(c, scimErr.HTTPStatus(), scimErr)
+}
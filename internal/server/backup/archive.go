@@ -0,0 +1,538 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// An archive backup is a zstd compressed tar of NDJSON chunks, one directory per entity type,
+// e.g. usage_logs/000001.ndjson, followed by manifest.json with the checksums of the chunks.
+// It is written and restored as a stream, the usage data is never loaded in memory as a whole.
+const (
+	ArchiveVersion = "2.0"
+
+	archiveManifestName      = "manifest.json"
+	archiveFullSuffix        = ".tar.zst"
+	archiveIncrementalSuffix = "-incremental.tar.zst"
+	archiveChunkRows         = 10000
+	archiveChunkBytes        = 16 << 20
+
+	// inFlightRequestWindow is how long a pending or processing request holds the checkpoint back,
+	// older requests are considered abandoned.
+	inFlightRequestWindow = time.Hour
+)
+
+// zstdMagic starts every zstd frame, it tells an archive from a JSON backup.
+var zstdMagic = []byte{0x28, 0xB5, 0x2F, 0xFD}
+
+type ArchiveKind string
+
+const (
+	ArchiveKindFull        ArchiveKind = "full"
+	ArchiveKindIncremental ArchiveKind = "incremental"
+)
+
+// ArchiveManifest describes the chunks of an archive, it is the last entry of the archive.
+type ArchiveManifest struct {
+	Version   string      `json:"version"`
+	Kind      ArchiveKind `json:"kind"`
+	CreatedAt time.Time   `json:"created_at"`
+	// Since is the checkpoint an incremental archive starts from.
+	Since *biz.BackupCheckpoint `json:"since,omitempty"`
+	// Checkpoint is the checkpoint the next incremental archive starts from.
+	Checkpoint biz.BackupCheckpoint `json:"checkpoint"`
+	Files      []*ArchiveFile       `json:"files"`
+}
+
+type ArchiveFile struct {
+	Name   string `json:"name"`
+	Entity string `json:"entity"`
+	Rows   int    `json:"rows"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// doArchiveBackup writes an archive backup to w. The usage data is limited to the requests after since,
+// a nil since writes a full archive. The configuration is always backed up in full.
+func (svc *BackupService) doArchiveBackup(ctx context.Context, opts BackupOptions, since *biz.BackupCheckpoint, w io.Writer) (*ArchiveManifest, error) {
+	checkpoint, err := svc.usageCheckpoint(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute backup checkpoint: %w", err)
+	}
+
+	manifest := &ArchiveManifest{
+		Version:    ArchiveVersion,
+		Kind:       ArchiveKindFull,
+		CreatedAt:  checkpoint.CreatedAt,
+		Checkpoint: checkpoint,
+	}
+
+	usageRange := &requestIDRange{Until: checkpoint.RequestID}
+
+	if since != nil {
+		manifest.Kind = ArchiveKindIncremental
+		manifest.Since = since
+		usageRange.After = since.RequestID
+		// The checkpoint never moves back, e.g. when an abandoned request is still in flight.
+		manifest.Checkpoint.RequestID = max(checkpoint.RequestID, since.RequestID)
+		usageRange.Until = manifest.Checkpoint.RequestID
+	}
+
+	opts.usageRequestIDs = usageRange
+
+	zw, err := zstd.NewWriter(w)
+	if err != nil {
+		return nil, err
+	}
+
+	aw := &archiveWriter{tw: tar.NewWriter(zw), modTime: manifest.CreatedAt}
+
+	if err := svc.streamEntities(ctx, aw, opts); err != nil {
+		return nil, err
+	}
+
+	manifest.Files = aw.files
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := aw.writeFile(archiveManifestName, data); err != nil {
+		return nil, err
+	}
+
+	if err := aw.tw.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// usageCheckpoint returns the last request whose usage can be backed up, the requests still in flight
+// and the ones after them are left to the next backup, as their status and usage log are not final.
+func (svc *BackupService) usageCheckpoint(ctx context.Context) (biz.BackupCheckpoint, error) {
+	now := time.Now()
+
+	lastID, err := svc.db.Request.Query().
+		Order(ent.Desc(request.FieldID)).
+		FirstID(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return biz.BackupCheckpoint{}, err
+	}
+
+	inFlightID, err := svc.db.Request.Query().
+		Where(
+			request.StatusIn(request.StatusPending, request.StatusProcessing),
+			request.CreatedAtGT(now.Add(-inFlightRequestWindow)),
+		).
+		Order(ent.Asc(request.FieldID)).
+		FirstID(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return biz.BackupCheckpoint{}, err
+	}
+
+	if inFlightID > 0 {
+		lastID = min(lastID, inFlightID-1)
+	}
+
+	return biz.BackupCheckpoint{CreatedAt: now, RequestID: lastID}, nil
+}
+
+// archiveWriter writes the entities as NDJSON chunks of a tar.
+type archiveWriter struct {
+	tw      *tar.Writer
+	modTime time.Time
+
+	entity string
+	chunk  int
+	rows   int
+	buf    bytes.Buffer
+	files  []*ArchiveFile
+}
+
+func (a *archiveWriter) beginEntities(name string) error {
+	a.entity = name
+	a.chunk = 0
+
+	return nil
+}
+
+func (a *archiveWriter) writeEntity(b []byte) error {
+	a.buf.Write(b)
+	a.buf.WriteByte('\n')
+	a.rows++
+
+	if a.rows >= archiveChunkRows || a.buf.Len() >= archiveChunkBytes {
+		return a.flush()
+	}
+
+	return nil
+}
+
+func (a *archiveWriter) endEntities() error {
+	return a.flush()
+}
+
+// noEntities writes nothing, a missing entity type restores nothing.
+func (a *archiveWriter) noEntities(string, bool) error {
+	return nil
+}
+
+func (a *archiveWriter) flush() error {
+	if a.rows == 0 {
+		return nil
+	}
+
+	a.chunk++
+	name := fmt.Sprintf("%s/%06d.ndjson", a.entity, a.chunk)
+	sum := sha256.Sum256(a.buf.Bytes())
+
+	a.files = append(a.files, &ArchiveFile{
+		Name:   name,
+		Entity: a.entity,
+		Rows:   a.rows,
+		Size:   int64(a.buf.Len()),
+		SHA256: hex.EncodeToString(sum[:]),
+	})
+
+	if err := a.writeFile(name, a.buf.Bytes()); err != nil {
+		return err
+	}
+
+	a.buf.Reset()
+	a.rows = 0
+
+	return nil
+}
+
+func (a *archiveWriter) writeFile(name string, data []byte) error {
+	if err := a.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0o600,
+		Size:     int64(len(data)),
+		ModTime:  a.modTime,
+	}); err != nil {
+		return err
+	}
+
+	_, err := a.tw.Write(data)
+
+	return err
+}
+
+// restoreArchive restores an archive while it is read. The configuration entities are collected and restored
+// before the first usage chunk, the usage data is restored chunk by chunk. The checksums are verified against
+// the manifest at the end of the archive, before the transaction is committed.
+func (svc *BackupService) restoreArchive(ctx context.Context, r io.Reader, opts RestoreOptions) (*RestoreReport, error) {
+	zr, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup archive: %w", err)
+	}
+	defer zr.Close()
+
+	tx, err := svc.db.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	committed := false
+
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	ar := &archiveRestorer{
+		svc:    svc,
+		db:     tx.Client(),
+		opts:   opts,
+		report: &RestoreReport{DryRun: opts.DryRun},
+		config: map[string][]json.RawMessage{},
+		files:  map[string]*ArchiveFile{},
+	}
+
+	var manifest *ArchiveManifest
+
+	tr := tar.NewReader(zr)
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read backup archive: %w", err)
+		}
+
+		if manifest != nil {
+			return nil, fmt.Errorf("invalid backup archive: %s after the manifest", header.Name)
+		}
+
+		if header.Name == archiveManifestName {
+			manifest = &ArchiveManifest{}
+			if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+				return nil, fmt.Errorf("invalid backup archive manifest: %w", err)
+			}
+
+			continue
+		}
+
+		if err := ar.restoreFile(ctx, header.Name, tr); err != nil {
+			return nil, err
+		}
+	}
+
+	if manifest == nil {
+		return nil, fmt.Errorf("invalid backup archive: missing manifest")
+	}
+
+	if manifest.Version != ArchiveVersion {
+		return nil, fmt.Errorf("backup archive version mismatch: expected %s, got %s", ArchiveVersion, manifest.Version)
+	}
+
+	if err := ar.verify(manifest); err != nil {
+		return nil, err
+	}
+
+	// An archive without usage data is restored at the end.
+	if err := ar.restoreConfiguration(ctx); err != nil {
+		return nil, err
+	}
+
+	if opts.DryRun {
+		return ar.report, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	committed = true
+
+	ar.invalidateSystemSettings(ctx)
+
+	return ar.report, nil
+}
+
+type archiveRestorer struct {
+	svc    *BackupService
+	db     *ent.Client
+	opts   RestoreOptions
+	report *RestoreReport
+
+	// config are the configuration entities per type, they are restored before the usage data.
+	config         map[string][]json.RawMessage
+	configData     *BackupData
+	configRestored bool
+
+	usage *usageRestorer
+
+	// files are the chunks read, checked against the manifest.
+	files map[string]*ArchiveFile
+}
+
+func (ar *archiveRestorer) restoreFile(ctx context.Context, name string, r io.Reader) error {
+	entity := path.Dir(name)
+	if entity == "." {
+		return fmt.Errorf("invalid backup archive: unexpected file %s", name)
+	}
+
+	hash := sha256.New()
+	counter := &countingReader{r: io.TeeReader(r, hash)}
+	dec := json.NewDecoder(counter)
+
+	file := &ArchiveFile{Name: name, Entity: entity}
+
+	switch entity {
+	case entityUsageRequests, entityUsageLogs:
+		if err := ar.restoreConfiguration(ctx); err != nil {
+			return err
+		}
+
+		if err := ar.restoreUsageChunk(ctx, entity, dec, file); err != nil {
+			return err
+		}
+	default:
+		if ar.configRestored {
+			return fmt.Errorf("invalid backup archive: %s after the usage data", name)
+		}
+
+		for dec.More() {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return fmt.Errorf("invalid backup archive file %s: %w", name, err)
+			}
+
+			ar.config[entity] = append(ar.config[entity], raw)
+			file.Rows++
+		}
+	}
+
+	// Drain the trailing whitespace, so that the checksum covers the whole file.
+	if _, err := io.Copy(io.Discard, counter); err != nil {
+		return err
+	}
+
+	file.Size = counter.n
+	file.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	ar.files[name] = file
+
+	return nil
+}
+
+func (ar *archiveRestorer) restoreUsageChunk(ctx context.Context, entity string, dec *json.Decoder, file *ArchiveFile) error {
+	switch entity {
+	case entityUsageRequests:
+		var batch []*BackupUsageRequest
+
+		for dec.More() {
+			var req BackupUsageRequest
+			if err := dec.Decode(&req); err != nil {
+				return fmt.Errorf("invalid backup archive file %s: %w", file.Name, err)
+			}
+
+			batch = append(batch, &req)
+			file.Rows++
+
+			if len(batch) >= backupBatchSize {
+				if err := ar.usage.restoreRequests(ctx, batch); err != nil {
+					return err
+				}
+
+				batch = nil
+			}
+		}
+
+		return ar.usage.restoreRequests(ctx, batch)
+	default:
+		var batch []*BackupUsageLog
+
+		for dec.More() {
+			var usageLog BackupUsageLog
+			if err := dec.Decode(&usageLog); err != nil {
+				return fmt.Errorf("invalid backup archive file %s: %w", file.Name, err)
+			}
+
+			batch = append(batch, &usageLog)
+			file.Rows++
+
+			if len(batch) >= backupBatchSize {
+				if err := ar.usage.restoreLogs(ctx, batch); err != nil {
+					return err
+				}
+
+				batch = nil
+			}
+		}
+
+		return ar.usage.restoreLogs(ctx, batch)
+	}
+}
+
+// restoreConfiguration restores the configuration entities once and prepares the restore of the usage data.
+func (ar *archiveRestorer) restoreConfiguration(ctx context.Context) error {
+	if ar.configRestored {
+		return nil
+	}
+
+	ar.configRestored = true
+
+	// The entity types are the JSON fields of a backup, the configuration is decoded like a JSON backup.
+	data, err := json.Marshal(ar.config)
+	if err != nil {
+		return err
+	}
+
+	var backupData BackupData
+	if err := json.Unmarshal(data, &backupData); err != nil {
+		return fmt.Errorf("invalid backup archive: %w", err)
+	}
+
+	ar.config = nil
+	ar.configData = &backupData
+
+	opts := ar.opts.withDefaultConflictStrategies()
+
+	dataStorageIDMap, err := ar.svc.restoreConfiguration(ctx, ar.db, backupData, opts, ar.report)
+	if err != nil {
+		return err
+	}
+
+	ar.usage, err = newUsageRestorer(ctx, ar.svc, ar.db, opts, dataStorageIDMap, ar.report)
+
+	return err
+}
+
+// verify checks that the archive has exactly the chunks of the manifest.
+func (ar *archiveRestorer) verify(manifest *ArchiveManifest) error {
+	if len(manifest.Files) != len(ar.files) {
+		return fmt.Errorf("backup archive checksum mismatch: %d files in the manifest, %d in the archive", len(manifest.Files), len(ar.files))
+	}
+
+	for _, expected := range manifest.Files {
+		actual, ok := ar.files[expected.Name]
+		if !ok {
+			return fmt.Errorf("backup archive checksum mismatch: missing %s", expected.Name)
+		}
+
+		if actual.SHA256 != expected.SHA256 || actual.Size != expected.Size || actual.Rows != expected.Rows {
+			return fmt.Errorf("backup archive checksum mismatch: %s", expected.Name)
+		}
+	}
+
+	return nil
+}
+
+func (ar *archiveRestorer) invalidateSystemSettings(ctx context.Context) {
+	if !ar.opts.IncludeSystemSettings || ar.svc.systemService == nil || ar.configData == nil {
+		return
+	}
+
+	keys := make([]string, 0, len(ar.configData.SystemSettings))
+	for _, s := range ar.configData.SystemSettings {
+		if s != nil {
+			keys = append(keys, s.Key)
+		}
+	}
+
+	ar.svc.systemService.InvalidateSystemValues(ctx, keys...)
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+
+	return n, err
+}
+
+// isArchive reports whether a backup starts like an archive.
+func isArchive(header []byte) bool {
+	return bytes.HasPrefix(header, zstdMagic)
+}
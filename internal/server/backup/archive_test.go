@@ -0,0 +1,293 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+var archiveTestBackupOptions = BackupOptions{
+	IncludeProjects:    true,
+	IncludeChannels:    true,
+	IncludeAPIKeys:     true,
+	IncludeUsageStats:  true,
+	IncludeRequestLogs: true,
+}
+
+var archiveTestRestoreOptions = RestoreOptions{
+	IncludeProjects:    true,
+	IncludeChannels:    true,
+	IncludeAPIKeys:     true,
+	IncludeUsageStats:  true,
+	IncludeRequestLogs: true,
+}
+
+func setupArchiveTest(t *testing.T) (*ent.Client, *BackupService, context.Context, func()) {
+	client, service, ctx := setupBackupTest(t)
+
+	user, err := client.User.Query().First(ctx)
+	require.NoError(t, err)
+
+	proj := createBackupTestProject(t, client, ctx, "Archive Project", "")
+	ch := createBackupTestChannel(t, client, ctx, "Archive Channel", channel.TypeOpenai)
+	ak := createBackupTestAPIKey(t, client, ctx, user, proj, "Archive Key", "ah-archive-key")
+
+	createUsage := func() {
+		createBackupTestUsage(t, client, ctx, proj, ch, ak)
+	}
+
+	return client, service, ctx, createUsage
+}
+
+func deleteArchiveTestUsage(t *testing.T, client *ent.Client, ctx context.Context) {
+	_, err := client.UsageLog.Delete().Exec(ctx)
+	require.NoError(t, err)
+
+	_, err = client.Request.Delete().Exec(ctx)
+	require.NoError(t, err)
+}
+
+func archiveManifestRows(manifest *ArchiveManifest, entity string) int {
+	rows := 0
+
+	for _, f := range manifest.Files {
+		if f.Entity == entity {
+			rows += f.Rows
+		}
+	}
+
+	return rows
+}
+
+func TestBackupService_Archive_RoundTrip(t *testing.T) {
+	client, service, ctx, createUsage := setupArchiveTest(t)
+	defer client.Close()
+
+	for range 3 {
+		createUsage()
+	}
+
+	var buf bytes.Buffer
+
+	manifest, err := service.doArchiveBackup(ctx, archiveTestBackupOptions, nil, &buf)
+	require.NoError(t, err)
+	require.Equal(t, ArchiveKindFull, manifest.Kind)
+	require.Equal(t, 3, archiveManifestRows(manifest, entityUsageRequests))
+	require.Equal(t, 3, archiveManifestRows(manifest, entityUsageLogs))
+	require.Equal(t, 1, archiveManifestRows(manifest, "channels"))
+
+	deleteArchiveTestUsage(t, client, ctx)
+
+	report, err := service.RestoreFromReader(ctx, bytes.NewReader(buf.Bytes()), archiveTestRestoreOptions)
+	require.NoError(t, err)
+	require.Equal(t, 3, report.entity(entityUsageRequests).Created)
+	require.Equal(t, 3, report.entity(entityUsageLogs).Created)
+	require.Equal(t, 1, report.entity("channels").Skipped)
+
+	logs, err := client.UsageLog.Query().WithRequest().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+
+	for _, usageLog := range logs {
+		require.NotNil(t, usageLog.Edges.Request)
+		require.Equal(t, 150, int(usageLog.TotalTokens))
+	}
+
+	t.Run("restoring again skips the usage data", func(t *testing.T) {
+		report, err := service.RestoreFromReader(ctx, bytes.NewReader(buf.Bytes()), archiveTestRestoreOptions)
+		require.NoError(t, err)
+		require.Equal(t, 3, report.entity(entityUsageRequests).Skipped)
+		require.Equal(t, 3, report.entity(entityUsageLogs).Skipped)
+
+		count, err := client.Request.Query().Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 3, count)
+	})
+}
+
+func TestBackupService_Archive_Incremental(t *testing.T) {
+	client, service, ctx, createUsage := setupArchiveTest(t)
+	defer client.Close()
+
+	createUsage()
+	createUsage()
+
+	full, err := service.doArchiveBackup(ctx, archiveTestBackupOptions, nil, io.Discard)
+	require.NoError(t, err)
+
+	createUsage()
+
+	// A request in flight holds the checkpoint back, it is backed up by the next incremental backup.
+	pending, err := client.Request.Create().
+		SetProjectID(1).
+		SetSource(request.SourceAPI).
+		SetModelID("gpt-4").
+		SetFormat("openai/chat_completions").
+		SetRequestBody(objects.JSONRawMessage(`{}`)).
+		SetStatus(request.StatusProcessing).
+		Save(ctx)
+	require.NoError(t, err)
+
+	createUsage()
+
+	var buf bytes.Buffer
+
+	incremental, err := service.doArchiveBackup(ctx, archiveTestBackupOptions, &full.Checkpoint, &buf)
+	require.NoError(t, err)
+	require.Equal(t, ArchiveKindIncremental, incremental.Kind)
+	require.Equal(t, &full.Checkpoint, incremental.Since)
+	require.Equal(t, pending.ID-1, incremental.Checkpoint.RequestID)
+	require.Equal(t, 1, archiveManifestRows(incremental, entityUsageRequests))
+	require.Equal(t, 1, archiveManifestRows(incremental, entityUsageLogs))
+
+	_, err = client.Request.UpdateOne(pending).SetStatus(request.StatusCompleted).Save(ctx)
+	require.NoError(t, err)
+
+	next, err := service.doArchiveBackup(ctx, archiveTestBackupOptions, &incremental.Checkpoint, io.Discard)
+	require.NoError(t, err)
+	require.Equal(t, 2, archiveManifestRows(next, entityUsageRequests))
+	require.Equal(t, 1, archiveManifestRows(next, entityUsageLogs))
+
+	deleteArchiveTestUsage(t, client, ctx)
+
+	report, err := service.RestoreFromReader(ctx, &buf, archiveTestRestoreOptions)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.entity(entityUsageLogs).Created)
+}
+
+func TestBackupService_Archive_ChecksumMismatch(t *testing.T) {
+	client, service, ctx, createUsage := setupArchiveTest(t)
+	defer client.Close()
+
+	createUsage()
+
+	var buf bytes.Buffer
+
+	_, err := service.doArchiveBackup(ctx, archiveTestBackupOptions, nil, &buf)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		modify func(name string, data []byte) []byte
+		err    string
+	}{
+		{
+			name: "modified chunk",
+			modify: func(name string, data []byte) []byte {
+				if name != entityUsageLogs+"/000001.ndjson" {
+					return data
+				}
+
+				return bytes.Replace(data, []byte(`"total_tokens":150`), []byte(`"total_tokens":151`), 1)
+			},
+			err: "checksum mismatch: usage_logs/000001.ndjson",
+		},
+		{
+			name: "missing chunk",
+			modify: func(name string, data []byte) []byte {
+				if name == entityUsageLogs+"/000001.ndjson" {
+					return nil
+				}
+
+				return data
+			},
+			err: "checksum mismatch",
+		},
+		{
+			name: "missing manifest",
+			modify: func(name string, data []byte) []byte {
+				if name == archiveManifestName {
+					return nil
+				}
+
+				return data
+			},
+			err: "missing manifest",
+		},
+	}
+
+	deleteArchiveTestUsage(t, client, ctx)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			archive := rewriteTestArchive(t, buf.Bytes(), tt.modify)
+
+			_, err := service.RestoreFromReader(ctx, bytes.NewReader(archive), archiveTestRestoreOptions)
+			require.ErrorContains(t, err, tt.err)
+
+			count, err := client.Request.Query().Count(ctx)
+			require.NoError(t, err)
+			require.Zero(t, count, "a failed restore must be rolled back")
+		})
+	}
+}
+
+func TestBackupService_RestoreFromReader_JSON(t *testing.T) {
+	client, service, ctx, createUsage := setupArchiveTest(t)
+	defer client.Close()
+
+	createUsage()
+
+	data, err := service.Backup(ctx, archiveTestBackupOptions)
+	require.NoError(t, err)
+
+	deleteArchiveTestUsage(t, client, ctx)
+
+	report, err := service.RestoreFromReader(ctx, bytes.NewReader(data), archiveTestRestoreOptions)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.entity(entityUsageLogs).Created)
+}
+
+// rewriteTestArchive rewrites the files of an archive, a nil result drops the file.
+func rewriteTestArchive(t *testing.T, archive []byte, modify func(name string, data []byte) []byte) []byte {
+	zr, err := zstd.NewReader(bytes.NewReader(archive))
+	require.NoError(t, err)
+
+	defer zr.Close()
+
+	var out bytes.Buffer
+
+	zw, err := zstd.NewWriter(&out)
+	require.NoError(t, err)
+
+	tr := tar.NewReader(zr)
+	tw := tar.NewWriter(zw)
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+
+		data = modify(header.Name, data)
+		if data == nil {
+			continue
+		}
+
+		header.Size = int64(len(data))
+		require.NoError(t, tw.WriteHeader(header))
+
+		_, err = tw.Write(data)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, zw.Close())
+
+	return out.Bytes()
+}
@@ -45,23 +45,36 @@ func (svc *BackupService) triggerAutoBackup(ctx context.Context) {
 		return
 	}
 
+	incremental := false
+
 	if !svc.shouldRunBackup(time.Now(), settings) {
-		log.Info(ctx, "Backup not needed based on frequency",
-			log.String("frequency",
-				string(settings.Frequency)),
-		)
-		return
+		if !settings.Incremental || settings.Checkpoint == nil {
+			log.Info(ctx, "Backup not needed based on frequency",
+				log.String("frequency",
+					string(settings.Frequency)),
+			)
+			return
+		}
+
+		incremental = true
 	}
 
-	log.Info(ctx, "Starting automatic backup")
+	log.Info(ctx, "Starting automatic backup", log.Bool("incremental", incremental))
 
 	startAt := time.Now()
-	err = svc.performBackup(ctx, settings)
+	err = svc.performBackup(ctx, settings, incremental)
 
 	var errMsg string
 	if err != nil {
 		errMsg = err.Error()
 		log.Error(ctx, "Auto backup failed", log.Cause(err))
+
+		if svc.webhookNotifier != nil {
+			svc.webhookNotifier.NotifyBackupFailed(ctx, biz.BackupFailedEvent{
+				Error:      errMsg,
+				OccurredAt: time.Now(),
+			})
+		}
 	} else {
 		log.Info(ctx, "Auto backup completed successfully",
 			log.String("cost", time.Since(startAt).String()))
@@ -85,7 +98,9 @@ func (svc *BackupService) shouldRunBackup(now time.Time, settings *biz.AutoBacku
 	}
 }
 
-func (svc *BackupService) performBackup(ctx context.Context, settings *biz.AutoBackupSettings) error {
+// performBackup backs up to the configured data storage. The file storages get an archive backup, an incremental
+// one contains the configuration and the usage data since the last checkpoint. The database storage gets a JSON backup.
+func (svc *BackupService) performBackup(ctx context.Context, settings *biz.AutoBackupSettings, incremental bool) error {
 	ds, err := svc.dataStorageService.GetDataStorageByID(ctx, settings.DataStorageID)
 	if err != nil {
 		return fmt.Errorf("failed to get data storage: %w", err)
@@ -99,11 +114,23 @@ func (svc *BackupService) performBackup(ctx context.Context, settings *biz.AutoB
 		IncludeUsageStats:  settings.IncludeUsageStats,
 		IncludeRequestLogs: settings.IncludeRequestLogs,
 	}
+	if settings.IncludeConfiguration {
+		opts.IncludeProjects = true
+		opts.IncludeUsers = true
+		opts.IncludeRoles = true
+		opts.IncludePrompts = true
+		opts.IncludePromptProtectionRules = true
+		opts.IncludeChannelOverrideTemplates = true
+		opts.IncludeAPIKeyProfileTemplates = true
+		opts.IncludeDataStorages = true
+		opts.IncludeSystemSettings = true
+	}
 
 	timestamp := time.Now().Format("2006-01-02_15-04-05")
-	filename := fmt.Sprintf("axonhub-backup-%s.json", timestamp)
 
 	if ds.Type == datastorage.TypeDatabase {
+		filename := fmt.Sprintf("axonhub-backup-%s.json", timestamp)
+
 		data, err := svc.doBackup(ctx, opts)
 		if err != nil {
 			return fmt.Errorf("failed to create backup: %w", err)
@@ -116,14 +143,23 @@ func (svc *BackupService) performBackup(ctx context.Context, settings *biz.AutoB
 			log.Int("size", len(data)),
 		)
 	} else {
-		f, err := os.CreateTemp("", "axonhub-backup-*.json")
+		var since *biz.BackupCheckpoint
+
+		filename := fmt.Sprintf("axonhub-backup-%s%s", timestamp, archiveFullSuffix)
+		if incremental {
+			since = settings.Checkpoint
+			filename = fmt.Sprintf("axonhub-backup-%s%s", timestamp, archiveIncrementalSuffix)
+		}
+
+		f, err := os.CreateTemp("", "axonhub-backup-*.tar.zst")
 		if err != nil {
 			return fmt.Errorf("failed to create temp backup file: %w", err)
 		}
 		tmpPath := f.Name()
 		defer os.Remove(tmpPath)
 
-		if err := svc.doBackupToWriter(ctx, opts, f); err != nil {
+		manifest, err := svc.doArchiveBackup(ctx, opts, since, f)
+		if err != nil {
 			f.Close()
 			return fmt.Errorf("failed to create backup: %w", err)
 		}
@@ -139,7 +175,12 @@ func (svc *BackupService) performBackup(ctx context.Context, settings *biz.AutoB
 		log.Info(ctx, "Backup uploaded to storage",
 			log.String("path", key),
 			log.Int64("size", n),
+			log.Int("checkpoint_request_id", manifest.Checkpoint.RequestID),
 		)
+
+		if err := svc.systemService.UpdateAutoBackupCheckpoint(ctx, manifest.Checkpoint); err != nil {
+			return fmt.Errorf("failed to update backup checkpoint: %w", err)
+		}
 	}
 
 	if settings.RetentionDays > 0 {
@@ -164,12 +205,27 @@ func (svc *BackupService) cleanupOldBackups(ctx context.Context, ds *ent.DataSto
 
 	cutoff := time.Now().AddDate(0, 0, -retentionDays)
 
-	var backupFiles []os.FileInfo
+	var (
+		backupFiles []os.FileInfo
+		// lastFull is the latest full archive, the incremental archives after it depend on it.
+		lastFull os.FileInfo
+	)
 
 	for _, f := range files {
-		if strings.HasPrefix(f.Name(), "axonhub-backup-") && strings.HasSuffix(f.Name(), ".json") {
-			backupFiles = append(backupFiles, f)
+		if !isBackupFile(f.Name()) {
+			continue
 		}
+
+		backupFiles = append(backupFiles, f)
+
+		if strings.HasSuffix(f.Name(), archiveFullSuffix) && !strings.HasSuffix(f.Name(), archiveIncrementalSuffix) &&
+			(lastFull == nil || f.ModTime().After(lastFull.ModTime())) {
+			lastFull = f
+		}
+	}
+
+	if lastFull != nil && lastFull.ModTime().Before(cutoff) {
+		cutoff = lastFull.ModTime()
 	}
 
 	sort.Slice(backupFiles, func(i, j int) bool {
@@ -194,7 +250,12 @@ func (svc *BackupService) cleanupOldBackups(ctx context.Context, ds *ent.DataSto
 	return nil
 }
 
-// RunBackupNow triggers an immediate backup.
+func isBackupFile(name string) bool {
+	return strings.HasPrefix(name, "axonhub-backup-") &&
+		(strings.HasSuffix(name, ".json") || strings.HasSuffix(name, archiveFullSuffix))
+}
+
+// RunBackupNow triggers an immediate full backup.
 func (svc *BackupService) RunBackupNow(ctx context.Context) error {
 	ctx = ent.NewContext(ctx, svc.db)
 
@@ -207,7 +268,7 @@ func (svc *BackupService) RunBackupNow(ctx context.Context) error {
 		return fmt.Errorf("data storage not configured for backup")
 	}
 
-	err = svc.performBackup(ctx, settings)
+	err = svc.performBackup(ctx, settings, false)
 
 	var errMsg string
 	if err != nil {
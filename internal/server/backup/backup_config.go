@@ -0,0 +1,361 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/samber/lo"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikeyprofiletemplate"
+	"github.com/looplj/axonhub/internal/ent/channeloverridetemplate"
+	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/oidcidentity"
+	"github.com/looplj/axonhub/internal/ent/prompt"
+	"github.com/looplj/axonhub/internal/ent/promptprotectionrule"
+	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/system"
+	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/userproject"
+	"github.com/looplj/axonhub/internal/ent/userrole"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// instanceSystemKeys are the system settings bound to the instance, they are never backed up.
+var instanceSystemKeys = []string{
+	biz.SystemKeyInitialized,
+	biz.SystemKeyVersion,
+	biz.SystemKeySecretKey,
+	biz.SystemKeyUsageRollupWatermark,
+	biz.SystemKeyRequestSearchWatermark,
+}
+
+func (svc *BackupService) streamDataStorages(ctx context.Context, o entityWriter, opts BackupOptions) error {
+	return streamArrayField(o, "data_storages", opts.IncludeDataStorages, true,
+		func(lastID int) ([]*ent.DataStorage, int, error) {
+			rows, err := svc.db.DataStorage.Query().
+				Where(datastorage.IDGT(lastID)).
+				Order(ent.Asc(datastorage.FieldID)).
+				Limit(backupBatchSize).
+				All(ctx)
+			if err != nil {
+				return nil, 0, err
+			}
+			nextID := 0
+			if len(rows) > 0 {
+				nextID = rows[len(rows)-1].ID
+			}
+			return rows, nextID, nil
+		},
+		func(ds *ent.DataStorage) ([]byte, bool, error) {
+			b, err := json.Marshal(&BackupDataStorage{DataStorage: *ds})
+			return b, true, err
+		},
+	)
+}
+
+func (svc *BackupService) streamSystemSettings(ctx context.Context, o entityWriter, opts BackupOptions) error {
+	return streamArrayField(o, "system_settings", opts.IncludeSystemSettings, true,
+		func(lastID int) ([]*ent.System, int, error) {
+			rows, err := svc.db.System.Query().
+				Where(system.IDGT(lastID)).
+				Order(ent.Asc(system.FieldID)).
+				Limit(backupBatchSize).
+				All(ctx)
+			if err != nil {
+				return nil, 0, err
+			}
+			nextID := 0
+			if len(rows) > 0 {
+				nextID = rows[len(rows)-1].ID
+			}
+			return rows, nextID, nil
+		},
+		func(s *ent.System) ([]byte, bool, error) {
+			if lo.Contains(instanceSystemKeys, s.Key) {
+				return nil, false, nil
+			}
+			b, err := json.Marshal(&BackupSystemSetting{Key: s.Key, Value: s.Value})
+			return b, true, err
+		},
+	)
+}
+
+func (svc *BackupService) streamUsers(ctx context.Context, o entityWriter, opts BackupOptions) error {
+	return streamArrayField(o, "users", opts.IncludeUsers, true,
+		func(lastID int) ([]*ent.User, int, error) {
+			rows, err := svc.db.User.Query().
+				Where(user.IDGT(lastID)).
+				Order(ent.Asc(user.FieldID)).
+				Limit(backupBatchSize).
+				All(ctx)
+			if err != nil {
+				return nil, 0, err
+			}
+			nextID := 0
+			if len(rows) > 0 {
+				nextID = rows[len(rows)-1].ID
+			}
+			return rows, nextID, nil
+		},
+		func(u *ent.User) ([]byte, bool, error) {
+			b, err := json.Marshal(&BackupUser{User: *u, Password: u.Password})
+			return b, true, err
+		},
+	)
+}
+
+func (svc *BackupService) streamRoles(ctx context.Context, o entityWriter, opts BackupOptions) error {
+	return streamArrayField(o, "roles", opts.IncludeRoles, true,
+		func(lastID int) ([]*ent.Role, int, error) {
+			rows, err := svc.db.Role.Query().
+				WithProject().
+				Where(role.IDGT(lastID)).
+				Order(ent.Asc(role.FieldID)).
+				Limit(backupBatchSize).
+				All(ctx)
+			if err != nil {
+				return nil, 0, err
+			}
+			nextID := 0
+			if len(rows) > 0 {
+				nextID = rows[len(rows)-1].ID
+			}
+			return rows, nextID, nil
+		},
+		func(r *ent.Role) ([]byte, bool, error) {
+			data := &BackupRole{Role: *r}
+			if r.Edges.Project != nil {
+				data.ProjectName = r.Edges.Project.Name
+			}
+			data.Role.Edges = ent.RoleEdges{}
+			b, err := json.Marshal(data)
+			return b, true, err
+		},
+	)
+}
+
+func (svc *BackupService) streamProjectMemberships(ctx context.Context, o entityWriter, opts BackupOptions) error {
+	return streamArrayField(o, "project_memberships", opts.IncludeUsers, true,
+		func(lastID int) ([]*ent.UserProject, int, error) {
+			rows, err := svc.db.UserProject.Query().
+				WithUser().
+				WithProject().
+				Where(userproject.IDGT(lastID)).
+				Order(ent.Asc(userproject.FieldID)).
+				Limit(backupBatchSize).
+				All(ctx)
+			if err != nil {
+				return nil, 0, err
+			}
+			nextID := 0
+			if len(rows) > 0 {
+				nextID = rows[len(rows)-1].ID
+			}
+			return rows, nextID, nil
+		},
+		func(up *ent.UserProject) ([]byte, bool, error) {
+			if up.Edges.User == nil || up.Edges.Project == nil {
+				return nil, false, nil
+			}
+			b, err := json.Marshal(&BackupProjectMembership{
+				UserEmail:   up.Edges.User.Email,
+				ProjectName: up.Edges.Project.Name,
+				IsOwner:     up.IsOwner,
+				Scopes:      up.Scopes,
+			})
+			return b, true, err
+		},
+	)
+}
+
+func (svc *BackupService) streamUserRoles(ctx context.Context, o entityWriter, opts BackupOptions) error {
+	return streamArrayField(o, "user_roles", opts.IncludeUsers, true,
+		func(lastID int) ([]*ent.UserRole, int, error) {
+			rows, err := svc.db.UserRole.Query().
+				WithUser().
+				WithRole(func(q *ent.RoleQuery) {
+					q.WithProject()
+				}).
+				Where(userrole.IDGT(lastID)).
+				Order(ent.Asc(userrole.FieldID)).
+				Limit(backupBatchSize).
+				All(ctx)
+			if err != nil {
+				return nil, 0, err
+			}
+			nextID := 0
+			if len(rows) > 0 {
+				nextID = rows[len(rows)-1].ID
+			}
+			return rows, nextID, nil
+		},
+		func(ur *ent.UserRole) ([]byte, bool, error) {
+			if ur.Edges.User == nil || ur.Edges.Role == nil {
+				return nil, false, nil
+			}
+			data := &BackupUserRole{
+				UserEmail: ur.Edges.User.Email,
+				RoleName:  ur.Edges.Role.Name,
+			}
+			if ur.Edges.Role.Edges.Project != nil {
+				data.RoleProjectName = ur.Edges.Role.Edges.Project.Name
+			}
+			b, err := json.Marshal(data)
+			return b, true, err
+		},
+	)
+}
+
+func (svc *BackupService) streamOIDCIdentities(ctx context.Context, o entityWriter, opts BackupOptions) error {
+	return streamArrayField(o, "oidc_identities", opts.IncludeUsers, true,
+		func(lastID int) ([]*ent.OIDCIdentity, int, error) {
+			rows, err := svc.db.OIDCIdentity.Query().
+				WithUser().
+				Where(oidcidentity.IDGT(lastID)).
+				Order(ent.Asc(oidcidentity.FieldID)).
+				Limit(backupBatchSize).
+				All(ctx)
+			if err != nil {
+				return nil, 0, err
+			}
+			nextID := 0
+			if len(rows) > 0 {
+				nextID = rows[len(rows)-1].ID
+			}
+			return rows, nextID, nil
+		},
+		func(identity *ent.OIDCIdentity) ([]byte, bool, error) {
+			if identity.Edges.User == nil {
+				return nil, false, nil
+			}
+			data := &BackupOIDCIdentity{
+				OIDCIdentity: *identity,
+				UserEmail:    identity.Edges.User.Email,
+			}
+			data.OIDCIdentity.Edges = ent.OIDCIdentityEdges{}
+			b, err := json.Marshal(data)
+			return b, true, err
+		},
+	)
+}
+
+func (svc *BackupService) streamPromptProtectionRules(ctx context.Context, o entityWriter, opts BackupOptions) error {
+	return streamArrayField(o, "prompt_protection_rules", opts.IncludePromptProtectionRules, true,
+		func(lastID int) ([]*ent.PromptProtectionRule, int, error) {
+			rows, err := svc.db.PromptProtectionRule.Query().
+				Where(promptprotectionrule.IDGT(lastID)).
+				Order(ent.Asc(promptprotectionrule.FieldID)).
+				Limit(backupBatchSize).
+				All(ctx)
+			if err != nil {
+				return nil, 0, err
+			}
+			nextID := 0
+			if len(rows) > 0 {
+				nextID = rows[len(rows)-1].ID
+			}
+			return rows, nextID, nil
+		},
+		func(rule *ent.PromptProtectionRule) ([]byte, bool, error) {
+			b, err := json.Marshal(&BackupPromptProtectionRule{PromptProtectionRule: *rule})
+			return b, true, err
+		},
+	)
+}
+
+func (svc *BackupService) streamChannelOverrideTemplates(ctx context.Context, o entityWriter, opts BackupOptions) error {
+	return streamArrayField(o, "channel_override_templates", opts.IncludeChannelOverrideTemplates, true,
+		func(lastID int) ([]*ent.ChannelOverrideTemplate, int, error) {
+			rows, err := svc.db.ChannelOverrideTemplate.Query().
+				WithUser().
+				Where(channeloverridetemplate.IDGT(lastID)).
+				Order(ent.Asc(channeloverridetemplate.FieldID)).
+				Limit(backupBatchSize).
+				All(ctx)
+			if err != nil {
+				return nil, 0, err
+			}
+			nextID := 0
+			if len(rows) > 0 {
+				nextID = rows[len(rows)-1].ID
+			}
+			return rows, nextID, nil
+		},
+		func(tmpl *ent.ChannelOverrideTemplate) ([]byte, bool, error) {
+			data := &BackupChannelOverrideTemplate{ChannelOverrideTemplate: *tmpl}
+			if tmpl.Edges.User != nil {
+				data.UserEmail = tmpl.Edges.User.Email
+			}
+			data.ChannelOverrideTemplate.Edges = ent.ChannelOverrideTemplateEdges{}
+			b, err := json.Marshal(data)
+			return b, true, err
+		},
+	)
+}
+
+func (svc *BackupService) streamAPIKeyProfileTemplates(ctx context.Context, o entityWriter, opts BackupOptions) error {
+	return streamArrayField(o, "api_key_profile_templates", opts.IncludeAPIKeyProfileTemplates, true,
+		func(lastID int) ([]*ent.APIKeyProfileTemplate, int, error) {
+			rows, err := svc.db.APIKeyProfileTemplate.Query().
+				WithProject().
+				Where(apikeyprofiletemplate.IDGT(lastID)).
+				Order(ent.Asc(apikeyprofiletemplate.FieldID)).
+				Limit(backupBatchSize).
+				All(ctx)
+			if err != nil {
+				return nil, 0, err
+			}
+			nextID := 0
+			if len(rows) > 0 {
+				nextID = rows[len(rows)-1].ID
+			}
+			return rows, nextID, nil
+		},
+		func(tmpl *ent.APIKeyProfileTemplate) ([]byte, bool, error) {
+			if tmpl.Edges.Project == nil {
+				return nil, false, nil
+			}
+			data := &BackupAPIKeyProfileTemplate{
+				APIKeyProfileTemplate: *tmpl,
+				ProjectName:           tmpl.Edges.Project.Name,
+			}
+			data.APIKeyProfileTemplate.Edges = ent.APIKeyProfileTemplateEdges{}
+			b, err := json.Marshal(data)
+			return b, true, err
+		},
+	)
+}
+
+func (svc *BackupService) streamPrompts(ctx context.Context, o entityWriter, opts BackupOptions) error {
+	return streamArrayField(o, "prompts", opts.IncludePrompts, true,
+		func(lastID int) ([]*ent.Prompt, int, error) {
+			rows, err := svc.db.Prompt.Query().
+				WithProject().
+				Where(prompt.IDGT(lastID)).
+				Order(ent.Asc(prompt.FieldID)).
+				Limit(backupBatchSize).
+				All(ctx)
+			if err != nil {
+				return nil, 0, err
+			}
+			nextID := 0
+			if len(rows) > 0 {
+				nextID = rows[len(rows)-1].ID
+			}
+			return rows, nextID, nil
+		},
+		func(p *ent.Prompt) ([]byte, bool, error) {
+			if p.Edges.Project == nil {
+				return nil, false, nil
+			}
+			data := &BackupPrompt{
+				Prompt:      *p,
+				ProjectName: p.Edges.Project.Name,
+			}
+			data.Prompt.Edges = ent.PromptEdges{}
+			b, err := json.Marshal(data)
+			return b, true, err
+		},
+	)
+}
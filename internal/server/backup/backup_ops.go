@@ -17,6 +17,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent/project"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
+	"github.com/looplj/axonhub/internal/server/biz"
 )
 
 const backupBatchSize = 500
@@ -70,6 +71,23 @@ func (svc *BackupService) doBackupToWriter(ctx context.Context, opts BackupOptio
 		return err
 	}
 
+	if err := svc.streamEntities(ctx, o, opts); err != nil {
+		return err
+	}
+
+	_, err := w.Write([]byte("}"))
+	return err
+}
+
+// streamEntities writes the entities of every type, the usage requests and logs are written last
+// so that they can be restored while an archive is read, after the configuration they reference.
+func (svc *BackupService) streamEntities(ctx context.Context, o entityWriter, opts BackupOptions) error {
+	if err := svc.streamDataStorages(ctx, o, opts); err != nil {
+		return err
+	}
+	if err := svc.streamSystemSettings(ctx, o, opts); err != nil {
+		return err
+	}
 	if err := svc.streamProjects(ctx, o, opts); err != nil {
 		return err
 	}
@@ -82,9 +100,36 @@ func (svc *BackupService) doBackupToWriter(ctx context.Context, opts BackupOptio
 	if err := svc.streamChannelModelPrices(ctx, o, opts); err != nil {
 		return err
 	}
+	if err := svc.streamUsers(ctx, o, opts); err != nil {
+		return err
+	}
+	if err := svc.streamRoles(ctx, o, opts); err != nil {
+		return err
+	}
+	if err := svc.streamProjectMemberships(ctx, o, opts); err != nil {
+		return err
+	}
+	if err := svc.streamUserRoles(ctx, o, opts); err != nil {
+		return err
+	}
+	if err := svc.streamOIDCIdentities(ctx, o, opts); err != nil {
+		return err
+	}
+	if err := svc.streamPromptProtectionRules(ctx, o, opts); err != nil {
+		return err
+	}
+	if err := svc.streamChannelOverrideTemplates(ctx, o, opts); err != nil {
+		return err
+	}
+	if err := svc.streamAPIKeyProfileTemplates(ctx, o, opts); err != nil {
+		return err
+	}
 	if err := svc.streamAPIKeys(ctx, o, opts); err != nil {
 		return err
 	}
+	if err := svc.streamPrompts(ctx, o, opts); err != nil {
+		return err
+	}
 	if err := svc.streamUsageRequests(ctx, o, opts); err != nil {
 		return err
 	}
@@ -92,11 +137,10 @@ func (svc *BackupService) doBackupToWriter(ctx context.Context, opts BackupOptio
 		return err
 	}
 
-	_, err := w.Write([]byte("}"))
-	return err
+	return nil
 }
 
-func (svc *BackupService) streamProjects(ctx context.Context, o *objWriter, opts BackupOptions) error {
+func (svc *BackupService) streamProjects(ctx context.Context, o entityWriter, opts BackupOptions) error {
 	return streamArrayField(o, "projects", opts.IncludeProjects, true,
 		func(lastID int) ([]*ent.Project, int, error) {
 			rows, err := svc.db.Project.Query().
@@ -120,7 +164,7 @@ func (svc *BackupService) streamProjects(ctx context.Context, o *objWriter, opts
 	)
 }
 
-func (svc *BackupService) streamChannels(ctx context.Context, o *objWriter, opts BackupOptions) error {
+func (svc *BackupService) streamChannels(ctx context.Context, o entityWriter, opts BackupOptions) error {
 	return streamArrayField(o, "channels", opts.IncludeChannels, false,
 		func(lastID int) ([]*ent.Channel, int, error) {
 			rows, err := svc.db.Channel.Query().
@@ -147,7 +191,7 @@ func (svc *BackupService) streamChannels(ctx context.Context, o *objWriter, opts
 	)
 }
 
-func (svc *BackupService) streamModels(ctx context.Context, o *objWriter, opts BackupOptions) error {
+func (svc *BackupService) streamModels(ctx context.Context, o entityWriter, opts BackupOptions) error {
 	return streamArrayField(o, "models", opts.IncludeModels, false,
 		func(lastID int) ([]*ent.Model, int, error) {
 			rows, err := svc.db.Model.Query().
@@ -171,7 +215,7 @@ func (svc *BackupService) streamModels(ctx context.Context, o *objWriter, opts B
 	)
 }
 
-func (svc *BackupService) streamChannelModelPrices(ctx context.Context, o *objWriter, opts BackupOptions) error {
+func (svc *BackupService) streamChannelModelPrices(ctx context.Context, o entityWriter, opts BackupOptions) error {
 	return streamArrayField(o, "channel_model_prices", opts.IncludeModelPrices, true,
 		func(lastID int) ([]*ent.ChannelModelPrice, int, error) {
 			rows, err := svc.db.ChannelModelPrice.Query().
@@ -204,11 +248,12 @@ func (svc *BackupService) streamChannelModelPrices(ctx context.Context, o *objWr
 	)
 }
 
-func (svc *BackupService) streamAPIKeys(ctx context.Context, o *objWriter, opts BackupOptions) error {
+func (svc *BackupService) streamAPIKeys(ctx context.Context, o entityWriter, opts BackupOptions) error {
 	return streamArrayField(o, "api_keys", opts.IncludeAPIKeys, true,
 		func(lastID int) ([]*ent.APIKey, int, error) {
 			rows, err := svc.db.APIKey.Query().
 				WithProject().
+				WithUser().
 				Where(apikey.IDGT(lastID)).
 				Order(ent.Asc(apikey.FieldID)).
 				Limit(backupBatchSize).
@@ -227,20 +272,28 @@ func (svc *BackupService) streamAPIKeys(ctx context.Context, o *objWriter, opts
 			if ak.Edges.Project != nil {
 				projectName = ak.Edges.Project.Name
 			}
-			b, err := json.Marshal(&BackupAPIKey{
+			userEmail := ""
+			if ak.Edges.User != nil {
+				userEmail = ak.Edges.User.Email
+			}
+			data := &BackupAPIKey{
 				APIKey:      *ak,
 				ProjectName: projectName,
-			})
+				UserEmail:   userEmail,
+			}
+			data.APIKey.Edges = ent.APIKeyEdges{}
+			b, err := json.Marshal(data)
 			return b, true, err
 		},
 	)
 }
 
-func (svc *BackupService) streamUsageRequests(ctx context.Context, o *objWriter, opts BackupOptions) error {
+func (svc *BackupService) streamUsageRequests(ctx context.Context, o entityWriter, opts BackupOptions) error {
 	return streamArrayField(o, "usage_requests", opts.IncludeRequestLogs, true,
 		func(lastID int) ([]*ent.Request, int, error) {
 			query := svc.db.Request.Query().
 				Where(request.IDGT(lastID)).
+				Where(opts.usageRequestIDs.requestPredicates()...).
 				Order(ent.Asc(request.FieldID)).
 				Limit(backupBatchSize).
 				WithProject().
@@ -259,13 +312,22 @@ func (svc *BackupService) streamUsageRequests(ctx context.Context, o *objWriter,
 			return rows, nextID, nil
 		},
 		func(req *ent.Request) ([]byte, bool, error) {
+			// The backups are self-contained, the bodies are exported with their content blobs.
+			body, err := biz.ExpandContentBlobs(ctx, svc.db, req.RequestBody, req.RequestBodyBlobs)
+			if err != nil {
+				return nil, false, fmt.Errorf("failed to expand request %d body: %w", req.ID, err)
+			}
+
+			req.RequestBody = body
+			req.RequestBodyBlobs = false
+
 			b, err := json.Marshal(backupUsageRequest(req, opts.IncludeAPIKeys))
 			return b, true, err
 		},
 	)
 }
 
-func (svc *BackupService) streamUsageLogs(ctx context.Context, o *objWriter, opts BackupOptions) error {
+func (svc *BackupService) streamUsageLogs(ctx context.Context, o entityWriter, opts BackupOptions) error {
 	apiKeyKeys := map[int]string{}
 	if opts.IncludeUsageStats && opts.IncludeAPIKeys {
 		apiKeys, err := svc.db.APIKey.Query().
@@ -282,6 +344,7 @@ func (svc *BackupService) streamUsageLogs(ctx context.Context, o *objWriter, opt
 		func(lastID int) ([]*ent.UsageLog, int, error) {
 			rows, err := svc.db.UsageLog.Query().
 				Where(usagelog.IDGT(lastID)).
+				Where(opts.usageRequestIDs.usageLogPredicates()...).
 				Order(ent.Asc(usagelog.FieldID)).
 				Limit(backupBatchSize).
 				WithProject().
@@ -303,10 +366,22 @@ func (svc *BackupService) streamUsageLogs(ctx context.Context, o *objWriter, opt
 	)
 }
 
+// entityWriter writes the entities of a backup, one entity type after the other.
+type entityWriter interface {
+	// beginEntities starts the entities of a type, it is called before the first entity.
+	beginEntities(name string) error
+	writeEntity(b []byte) error
+	endEntities() error
+	// noEntities records an entity type without any entity, omitted is true when the type is not backed up.
+	noEntities(name string, omitted bool) error
+}
+
 // objWriter writes a JSON object incrementally, tracking leading commas.
 type objWriter struct {
 	w         io.Writer
 	needComma bool
+	// needElemComma tracks the leading comma of the elements of the current array.
+	needElemComma bool
 }
 
 func (o *objWriter) rawField(name string, raw []byte) error {
@@ -323,10 +398,38 @@ func (o *objWriter) rawField(name string, raw []byte) error {
 	return err
 }
 
+func (o *objWriter) beginEntities(name string) error {
+	o.needElemComma = false
+	return o.rawField(name, []byte("["))
+}
+
+func (o *objWriter) writeEntity(b []byte) error {
+	if o.needElemComma {
+		if _, err := o.w.Write([]byte(",")); err != nil {
+			return err
+		}
+	}
+	o.needElemComma = true
+	_, err := o.w.Write(b)
+	return err
+}
+
+func (o *objWriter) endEntities() error {
+	_, err := o.w.Write([]byte("]"))
+	return err
+}
+
+func (o *objWriter) noEntities(name string, omitted bool) error {
+	if omitted {
+		return o.rawField(name, []byte("null"))
+	}
+	return o.rawField(name, []byte("[]"))
+}
+
 // streamArrayField streams a JSON array field incrementally, processing rows
 // in pages via fetchBatch and transforming each via elem.
 func streamArrayField[T any](
-	o *objWriter, name string, on bool, omitempty bool,
+	o entityWriter, name string, on bool, omitempty bool,
 	fetchBatch func(lastID int) (rows []T, nextID int, err error),
 	elem func(T) (jsonBytes []byte, emit bool, err error),
 ) error {
@@ -334,16 +437,13 @@ func streamArrayField[T any](
 		if omitempty {
 			return nil
 		}
-		return o.rawField(name, []byte("null"))
+		return o.noEntities(name, true)
 	}
 	lastID := 0
 	opened := false
 	for {
 		rows, nextID, err := fetchBatch(lastID)
 		if err != nil {
-			if opened {
-				_, _ = o.w.Write([]byte("]"))
-			}
 			return err
 		}
 		if len(rows) == 0 {
@@ -352,29 +452,19 @@ func streamArrayField[T any](
 		for _, r := range rows {
 			b, emit, err := elem(r)
 			if err != nil {
-				if opened {
-					_, _ = o.w.Write([]byte("]"))
-				}
 				return err
 			}
 			if !emit {
 				continue
 			}
 			if !opened {
-				if err := o.rawField(name, []byte("[")); err != nil {
+				if err := o.beginEntities(name); err != nil {
 					return err
 				}
 				opened = true
-				if _, err := o.w.Write(b); err != nil {
-					return err
-				}
-			} else {
-				if _, err := o.w.Write([]byte(",")); err != nil {
-					return err
-				}
-				if _, err := o.w.Write(b); err != nil {
-					return err
-				}
+			}
+			if err := o.writeEntity(b); err != nil {
+				return err
 			}
 		}
 		lastID = nextID
@@ -383,13 +473,12 @@ func streamArrayField[T any](
 		}
 	}
 	if opened {
-		_, err := o.w.Write([]byte("]"))
-		return err
+		return o.endEntities()
 	}
 	if omitempty {
 		return nil
 	}
-	return o.rawField(name, []byte("[]"))
+	return o.noEntities(name, false)
 }
 
 func backupUsageRequest(req *ent.Request, includeAPIKeyValues bool) *BackupUsageRequest {
@@ -1,9 +1,11 @@
 package backup
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
@@ -19,36 +21,76 @@ import (
 	"github.com/looplj/axonhub/internal/ent/project"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
+	entuser "github.com/looplj/axonhub/internal/ent/user"
 	"github.com/looplj/axonhub/internal/log"
 	"github.com/looplj/axonhub/internal/objects"
 )
 
 func (svc *BackupService) Restore(ctx context.Context, data []byte, opts RestoreOptions) error {
+	_, err := svc.RestoreWithReport(ctx, data, opts)
+	return err
+}
+
+// RestoreFromReader restores a backup read from r, an archive backup is restored as it is read.
+func (svc *BackupService) RestoreFromReader(ctx context.Context, r io.Reader, opts RestoreOptions) (*RestoreReport, error) {
 	user, ok := contexts.GetUser(ctx)
 	if !ok || user == nil {
-		return fmt.Errorf("user not found in context")
+		return nil, fmt.Errorf("user not found in context")
 	}
 
 	if !user.IsOwner {
-		return fmt.Errorf("only owners can perform restore operations")
+		return nil, fmt.Errorf("only owners can perform restore operations")
+	}
+
+	br := bufio.NewReader(r)
+
+	header, err := br.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	if isArchive(header) {
+		return svc.restoreArchive(ctx, br, opts)
+	}
+
+	data, err := io.ReadAll(br)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.RestoreWithReport(ctx, data, opts)
+}
+
+// RestoreWithReport restores the backup and reports the changes per entity type.
+// With opts.DryRun the restore is rolled back, so the report describes what would change.
+func (svc *BackupService) RestoreWithReport(ctx context.Context, data []byte, opts RestoreOptions) (*RestoreReport, error) {
+	user, ok := contexts.GetUser(ctx)
+	if !ok || user == nil {
+		return nil, fmt.Errorf("user not found in context")
+	}
+
+	if !user.IsOwner {
+		return nil, fmt.Errorf("only owners can perform restore operations")
 	}
 
 	var backupData BackupData
 	if err := json.Unmarshal(data, &backupData); err != nil {
-		return err
+		return nil, err
 	}
 
-	if !lo.Contains([]string{BackupVersion, BackupVersionV3, BackupVersionV2, BackupVersionV1}, backupData.Version) {
+	opts = opts.withDefaultConflictStrategies()
+
+	if !lo.Contains([]string{BackupVersion, BackupVersionV4, BackupVersionV3, BackupVersionV2, BackupVersionV1}, backupData.Version) {
 		log.Warn(ctx, "backup version mismatch",
 			log.String("expected", BackupVersion),
 			log.String("got", backupData.Version))
 
-		return fmt.Errorf("backup version mismatch: expected %s, got %s", BackupVersion, backupData.Version)
+		return nil, fmt.Errorf("backup version mismatch: expected %s, got %s", BackupVersion, backupData.Version)
 	}
 
 	tx, err := svc.db.Tx(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
 	}
 
 	committed := false
@@ -60,41 +102,102 @@ func (svc *BackupService) Restore(ctx context.Context, data []byte, opts Restore
 	}()
 
 	txClient := tx.Client()
+	report := &RestoreReport{DryRun: opts.DryRun}
 
-	if err := svc.restore(ctx, txClient, backupData, opts); err != nil {
-		return err
+	if err := svc.restore(ctx, txClient, backupData, opts, report); err != nil {
+		return nil, err
+	}
+
+	if opts.DryRun {
+		return report, nil
 	}
 
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
 	committed = true
 
-	return nil
+	if opts.IncludeSystemSettings && svc.systemService != nil {
+		svc.systemService.InvalidateSystemValues(ctx, lo.Map(backupData.SystemSettings, func(s *BackupSystemSetting, _ int) string {
+			if s == nil {
+				return ""
+			}
+
+			return s.Key
+		})...)
+	}
+
+	return report, nil
+}
+
+func (svc *BackupService) restore(ctx context.Context, db *ent.Client, backupData BackupData, opts RestoreOptions, report *RestoreReport) error {
+	dataStorageIDMap, err := svc.restoreConfiguration(ctx, db, backupData, opts, report)
+	if err != nil {
+		return err
+	}
+
+	if !opts.IncludeUsageStats && !opts.IncludeRequestLogs {
+		return nil
+	}
+
+	usage, err := newUsageRestorer(ctx, svc, db, opts, dataStorageIDMap, report)
+	if err != nil {
+		return err
+	}
+
+	if err := usage.restoreRequests(ctx, backupData.UsageRequests); err != nil {
+		return err
+	}
+
+	return usage.restoreLogs(ctx, backupData.UsageLogs)
 }
 
-func (svc *BackupService) restore(ctx context.Context, db *ent.Client, backupData BackupData, opts RestoreOptions) error {
+// restoreConfiguration restores everything but the usage data, it returns the mapping of the backup data storage IDs.
+func (svc *BackupService) restoreConfiguration(
+	ctx context.Context,
+	db *ent.Client,
+	backupData BackupData,
+	opts RestoreOptions,
+	report *RestoreReport,
+) (map[int]int, error) {
+	if opts.IncludeDataStorages {
+		if err := svc.restoreDataStorages(ctx, db, backupData.DataStorages, opts, report); err != nil {
+			return nil, err
+		}
+	}
+
+	dataStorageIDMap, err := svc.buildDataStorageIDMap(ctx, db, backupData.DataStorages)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.IncludeSystemSettings {
+		if err := svc.restoreSystemSettings(ctx, db, backupData.SystemSettings, opts, dataStorageIDMap, report); err != nil {
+			return nil, err
+		}
+	}
+
 	if opts.IncludeChannels {
-		if err := svc.restoreChannels(ctx, db, backupData.Channels, opts); err != nil {
-			return err
+		if err := svc.restoreChannels(ctx, db, backupData.Channels, opts, report); err != nil {
+			return nil, err
 		}
 	}
 
 	channelIDMap, err := svc.buildChannelIDMap(ctx, db, backupData.Channels)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if opts.IncludeModelPrices {
-		if err := svc.restoreChannelModelPrices(ctx, db, backupData.ChannelModelPrices, opts); err != nil {
-			return err
+		if err := svc.restoreChannelModelPrices(ctx, db, backupData.ChannelModelPrices, opts, report); err != nil {
+			return nil, err
 		}
 	}
 
 	if opts.IncludeModels {
-		if err := svc.restoreModels(ctx, db, backupData.Models, opts, channelIDMap); err != nil {
-			return err
+		if err := svc.restoreModels(ctx, db, backupData.Models, opts, channelIDMap, report); err != nil {
+			return nil, err
 		}
 	}
 
@@ -107,24 +210,73 @@ func (svc *BackupService) restore(ctx context.Context, db *ent.Client, backupDat
 			remapProjectProfilesChannelIDs(projData.Profiles, channelIDMap)
 		}
 
-		if err := svc.restoreProjects(ctx, db, backupData.Projects, opts); err != nil {
-			return err
+		if err := svc.restoreProjects(ctx, db, backupData.Projects, opts, report); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.IncludeUsers {
+		if err := svc.restoreUsers(ctx, db, backupData.Users, opts, report); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.IncludeRoles {
+		if err := svc.restoreRoles(ctx, db, backupData.Roles, opts, report); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.IncludeUsers {
+		if err := svc.restoreProjectMemberships(ctx, db, backupData.ProjectMemberships, opts, report); err != nil {
+			return nil, err
+		}
+
+		if err := svc.restoreUserRoles(ctx, db, backupData.UserRoles, report); err != nil {
+			return nil, err
+		}
+
+		if err := svc.restoreOIDCIdentities(ctx, db, backupData.OIDCIdentities, report); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.IncludePromptProtectionRules {
+		if err := svc.restorePromptProtectionRules(ctx, db, backupData.PromptProtectionRules, opts, report); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.IncludeChannelOverrideTemplates {
+		if err := svc.restoreChannelOverrideTemplates(ctx, db, backupData.ChannelOverrideTemplates, opts, report); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.IncludeAPIKeyProfileTemplates {
+		if err := svc.restoreAPIKeyProfileTemplates(ctx, db, backupData.APIKeyProfileTemplates, opts, channelIDMap, report); err != nil {
+			return nil, err
 		}
 	}
 
 	if opts.IncludeAPIKeys {
-		if err := svc.restoreAPIKeys(ctx, db, backupData.APIKeys, opts, channelIDMap); err != nil {
-			return err
+		if err := svc.restoreAPIKeys(ctx, db, backupData.APIKeys, opts, channelIDMap, report); err != nil {
+			return nil, err
 		}
 	}
 
-	if opts.IncludeUsageStats || opts.IncludeRequestLogs {
-		if err := svc.restoreUsageData(ctx, db, backupData.UsageRequests, backupData.UsageLogs, opts); err != nil {
-			return err
+	if opts.IncludePrompts {
+		apiKeyIDMap, err := svc.buildAPIKeyIDMap(ctx, db, backupData.APIKeys)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := svc.restorePrompts(ctx, db, backupData.Prompts, opts, apiKeyIDMap, report); err != nil {
+			return nil, err
 		}
 	}
 
-	return nil
+	return dataStorageIDMap, nil
 }
 
 func (svc *BackupService) buildChannelIDMap(ctx context.Context, db *ent.Client, channels []*BackupChannel) (map[int]int, error) {
@@ -350,7 +502,7 @@ func remapProjectProfilesChannelIDs(profiles *objects.ProjectProfiles, channelID
 	}
 }
 
-func (svc *BackupService) restoreProjects(ctx context.Context, db *ent.Client, projectsData []*BackupProject, opts RestoreOptions) error {
+func (svc *BackupService) restoreProjects(ctx context.Context, db *ent.Client, projectsData []*BackupProject, opts RestoreOptions, report *RestoreReport) error {
 	if len(projectsData) == 0 {
 		return nil
 	}
@@ -371,6 +523,8 @@ func (svc *BackupService) restoreProjects(ctx context.Context, db *ent.Client, p
 			switch opts.ProjectConflictStrategy {
 			case ConflictStrategySkip:
 				log.Info(ctx, "skipping existing project", log.String("name", projData.Name))
+				report.skipped(entityProjects)
+
 				continue
 			case ConflictStrategyError:
 				log.Error(ctx, "project already exists", log.String("name", projData.Name))
@@ -385,6 +539,8 @@ func (svc *BackupService) restoreProjects(ctx context.Context, db *ent.Client, p
 				if err != nil {
 					return fmt.Errorf("failed to restore project %s: %w", projData.Name, err)
 				}
+
+				report.updated(entityProjects)
 			}
 
 			continue
@@ -399,6 +555,8 @@ func (svc *BackupService) restoreProjects(ctx context.Context, db *ent.Client, p
 		if err != nil {
 			return fmt.Errorf("failed to create project %s: %w", projData.Name, err)
 		}
+
+		report.created(entityProjects)
 	}
 
 	return nil
@@ -409,6 +567,7 @@ func (svc *BackupService) restoreChannelModelPrices(
 	db *ent.Client,
 	prices []*BackupChannelModelPrice,
 	opts RestoreOptions,
+	report *RestoreReport,
 ) error {
 	if len(prices) == 0 {
 		return nil
@@ -459,6 +618,7 @@ func (svc *BackupService) restoreChannelModelPrices(
 				log.String("channel", pData.ChannelName),
 				log.String("model_id", pData.ModelID),
 			)
+			report.skipped(entityChannelModelPrices)
 
 			continue
 		}
@@ -480,11 +640,13 @@ func (svc *BackupService) restoreChannelModelPrices(
 
 		if existing != nil {
 			if existing.ReferenceID == refID && existing.Price.Equals(pData.Price) {
+				report.skipped(entityChannelModelPrices)
 				continue
 			}
 
 			switch opts.ModelPriceConflictStrategy {
 			case ConflictStrategySkip:
+				report.skipped(entityChannelModelPrices)
 				continue
 			case ConflictStrategyError:
 				return fmt.Errorf("channel model price already exists: channel=%s model_id=%s", pData.ChannelName, pData.ModelID)
@@ -524,6 +686,8 @@ func (svc *BackupService) restoreChannelModelPrices(
 				}
 
 				updatedChannels[ch.ID] = struct{}{}
+
+				report.updated(entityChannelModelPrices)
 			}
 
 			continue
@@ -554,6 +718,8 @@ func (svc *BackupService) restoreChannelModelPrices(
 		}
 
 		updatedChannels[ch.ID] = struct{}{}
+
+		report.created(entityChannelModelPrices)
 	}
 
 	// Force update channel updated_at to trigger reload cache.
@@ -568,7 +734,7 @@ func (svc *BackupService) restoreChannelModelPrices(
 	return nil
 }
 
-func (svc *BackupService) restoreChannels(ctx context.Context, db *ent.Client, channels []*BackupChannel, opts RestoreOptions) error {
+func (svc *BackupService) restoreChannels(ctx context.Context, db *ent.Client, channels []*BackupChannel, opts RestoreOptions, report *RestoreReport) error {
 	for _, chData := range channels {
 		existing, err := db.Channel.Query().
 			Where(channel.Name(chData.Name)).
@@ -580,6 +746,7 @@ func (svc *BackupService) restoreChannels(ctx context.Context, db *ent.Client, c
 		credentials := chData.Credentials
 		// Check if credentials are empty (no API key and no OAuth)
 		if credentials.APIKey == "" && len(credentials.APIKeys) == 0 && credentials.OAuth == nil {
+			report.skipped(entityChannels)
 			continue
 		}
 
@@ -592,6 +759,8 @@ func (svc *BackupService) restoreChannels(ctx context.Context, db *ent.Client, c
 			switch opts.ChannelConflictStrategy {
 			case ConflictStrategySkip:
 				log.Info(ctx, "skipping existing channel", log.String("channel", chData.Name))
+				report.skipped(entityChannels)
+
 				continue
 			case ConflictStrategyError:
 				log.Error(ctx, "channel already exists",
@@ -625,6 +794,8 @@ func (svc *BackupService) restoreChannels(ctx context.Context, db *ent.Client, c
 
 					return fmt.Errorf("failed to restore channel %s: %w", chData.Name, err)
 				}
+
+				report.updated(entityChannels)
 			}
 		} else {
 			create := db.Channel.Create().
@@ -653,13 +824,22 @@ func (svc *BackupService) restoreChannels(ctx context.Context, db *ent.Client, c
 
 				return fmt.Errorf("failed to create channel %s: %w", chData.Name, err)
 			}
+
+			report.created(entityChannels)
 		}
 	}
 
 	return nil
 }
 
-func (svc *BackupService) restoreModels(ctx context.Context, db *ent.Client, models []*BackupModel, opts RestoreOptions, channelIDMap map[int]int) error {
+func (svc *BackupService) restoreModels(
+	ctx context.Context,
+	db *ent.Client,
+	models []*BackupModel,
+	opts RestoreOptions,
+	channelIDMap map[int]int,
+	report *RestoreReport,
+) error {
 	for _, modelData := range models {
 		if modelData == nil {
 			continue
@@ -681,6 +861,8 @@ func (svc *BackupService) restoreModels(ctx context.Context, db *ent.Client, mod
 			switch opts.ModelConflictStrategy {
 			case ConflictStrategySkip:
 				log.Info(ctx, "skipping existing model", log.String("model", modelData.ModelID))
+				report.skipped(entityModels)
+
 				continue
 			case ConflictStrategyError:
 				log.Error(ctx, "model already exists",
@@ -709,6 +891,8 @@ func (svc *BackupService) restoreModels(ctx context.Context, db *ent.Client, mod
 
 					return fmt.Errorf("failed to restore model %s: %w", modelData.ModelID, err)
 				}
+
+				report.updated(entityModels)
 			}
 		} else {
 			create := db.Model.Create().
@@ -733,13 +917,22 @@ func (svc *BackupService) restoreModels(ctx context.Context, db *ent.Client, mod
 
 				return fmt.Errorf("failed to create model %s: %w", modelData.ModelID, err)
 			}
+
+			report.created(entityModels)
 		}
 	}
 
 	return nil
 }
 
-func (svc *BackupService) restoreAPIKeys(ctx context.Context, db *ent.Client, apiKeys []*BackupAPIKey, opts RestoreOptions, channelIDMap map[int]int) error {
+func (svc *BackupService) restoreAPIKeys(
+	ctx context.Context,
+	db *ent.Client,
+	apiKeys []*BackupAPIKey,
+	opts RestoreOptions,
+	channelIDMap map[int]int,
+	report *RestoreReport,
+) error {
 	user, ok := contexts.GetUser(ctx)
 	if !ok || user == nil {
 		return fmt.Errorf("user not found in context for restoring API keys")
@@ -763,6 +956,8 @@ func (svc *BackupService) restoreAPIKeys(ctx context.Context, db *ent.Client, ap
 			switch opts.APIKeyConflictStrategy {
 			case ConflictStrategySkip:
 				log.Info(ctx, "skipping existing API key", log.String("name", akData.Name))
+				report.skipped(entityAPIKeys)
+
 				continue
 			case ConflictStrategyError:
 				log.Error(ctx, "API key already exists",
@@ -784,6 +979,8 @@ func (svc *BackupService) restoreAPIKeys(ctx context.Context, db *ent.Client, ap
 
 					return fmt.Errorf("failed to restore API key %s: %w", akData.Name, err)
 				}
+
+				report.updated(entityAPIKeys)
 			}
 		} else {
 			projectName := akData.ProjectName
@@ -791,6 +988,21 @@ func (svc *BackupService) restoreAPIKeys(ctx context.Context, db *ent.Client, ap
 				projectName = "Default"
 			}
 
+			// Keys of other users are restored to their owner when the user exists, otherwise to the restoring user.
+			userID := user.ID
+			if akData.UserEmail != "" && akData.UserEmail != user.Email {
+				owner, err := db.User.Query().
+					Where(entuser.Email(akData.UserEmail)).
+					First(ctx)
+				if err != nil && !ent.IsNotFound(err) {
+					return err
+				}
+
+				if owner != nil {
+					userID = owner.ID
+				}
+			}
+
 			proj, err := db.Project.Query().
 				Where(project.Name(projectName)).
 				First(ctx)
@@ -799,6 +1011,7 @@ func (svc *BackupService) restoreAPIKeys(ctx context.Context, db *ent.Client, ap
 					log.Warn(ctx, "project not found, skipping API key",
 						log.String("project", projectName),
 						log.String("api_key", akData.Name))
+					report.skipped(entityAPIKeys)
 
 					continue
 				}
@@ -813,7 +1026,7 @@ func (svc *BackupService) restoreAPIKeys(ctx context.Context, db *ent.Client, ap
 				SetStatus(akData.Status).
 				SetScopes(akData.Scopes).
 				SetProfiles(akData.Profiles).
-				SetUserID(user.ID).
+				SetUserID(userID).
 				SetProjectID(proj.ID)
 
 			if _, err := create.Save(ctx); err != nil {
@@ -823,44 +1036,85 @@ func (svc *BackupService) restoreAPIKeys(ctx context.Context, db *ent.Client, ap
 
 				return fmt.Errorf("failed to create API key %s: %w", akData.Name, err)
 			}
+
+			report.created(entityAPIKeys)
 		}
 	}
 
 	return nil
 }
 
-func (svc *BackupService) restoreUsageData(
+// usageRestorer restores the usage data in batches, it keeps the mapping of the restored requests across batches.
+type usageRestorer struct {
+	svc    *BackupService
+	db     *ent.Client
+	opts   RestoreOptions
+	report *RestoreReport
+
+	resolver              *usageRestoreResolver
+	dataStorageIDMap      map[int]int
+	requestIDMap          map[int]int
+	restoredLogRequestIDs map[int]struct{}
+}
+
+func newUsageRestorer(
 	ctx context.Context,
+	svc *BackupService,
 	db *ent.Client,
-	requestsData []*BackupUsageRequest,
-	usageLogs []*BackupUsageLog,
 	opts RestoreOptions,
-) error {
+	dataStorageIDMap map[int]int,
+	report *RestoreReport,
+) (*usageRestorer, error) {
 	resolver, err := newUsageRestoreResolver(ctx, db)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	requestIDMap := map[int]int{}
-	if opts.IncludeRequestLogs {
-		requestIDMap, err = svc.restoreUsageRequests(ctx, db, requestsData, resolver)
-		if err != nil {
-			return err
-		}
+	return &usageRestorer{
+		svc:                   svc,
+		db:                    db,
+		opts:                  opts,
+		report:                report,
+		resolver:              resolver,
+		dataStorageIDMap:      dataStorageIDMap,
+		requestIDMap:          map[int]int{},
+		restoredLogRequestIDs: map[int]struct{}{},
+	}, nil
+}
+
+func (u *usageRestorer) restoreRequests(ctx context.Context, requestsData []*BackupUsageRequest) error {
+	if !u.opts.IncludeRequestLogs || len(requestsData) == 0 {
+		return nil
+	}
+
+	remapUsageRequestsStorageIDs(requestsData, u.dataStorageIDMap)
+
+	idMap, err := u.svc.restoreUsageRequests(ctx, u.db, requestsData, u.resolver, u.report)
+	if err != nil {
+		return err
 	}
 
-	if opts.IncludeUsageStats {
-		return svc.restoreUsageLogs(ctx, db, usageLogs, requestIDMap, resolver)
+	for oldID, newID := range idMap {
+		u.requestIDMap[oldID] = newID
 	}
 
 	return nil
 }
 
+func (u *usageRestorer) restoreLogs(ctx context.Context, usageLogs []*BackupUsageLog) error {
+	if !u.opts.IncludeUsageStats {
+		return nil
+	}
+
+	return u.svc.restoreUsageLogs(ctx, u.db, usageLogs, u.requestIDMap, u.restoredLogRequestIDs, u.resolver, u.report)
+}
+
 func (svc *BackupService) restoreUsageRequests(
 	ctx context.Context,
 	db *ent.Client,
 	requestsData []*BackupUsageRequest,
 	resolver *usageRestoreResolver,
+	report *RestoreReport,
 ) (map[int]int, error) {
 	idMap := map[int]int{}
 	if len(requestsData) == 0 {
@@ -888,6 +1142,8 @@ func (svc *BackupService) restoreUsageRequests(
 				log.Int("request_id", oldID),
 				log.String("project", reqData.ProjectName),
 			)
+			report.skipped(entityUsageRequests)
+
 			continue
 		}
 
@@ -910,11 +1166,15 @@ func (svc *BackupService) restoreUsageRequests(
 		if existing, ok := existingRequests.byID[oldID]; ok {
 			if sameUsageRequest(existing, reqData, projectID, channelID, apiKeyID) {
 				idMap[oldID] = existing.ID
+				report.skipped(entityUsageRequests)
+
 				continue
 			}
 		}
 		if existing, ok := existingRequests.byFingerprint[usageRequestBackupFingerprint(reqData)]; ok {
 			idMap[oldID] = existing.ID
+			report.skipped(entityUsageRequests)
+
 			continue
 		}
 
@@ -949,6 +1209,8 @@ func (svc *BackupService) restoreUsageRequests(
 		}
 
 		idMap[oldID] = created.ID
+
+		report.created(entityUsageRequests)
 	}
 
 	return idMap, nil
@@ -1139,7 +1401,9 @@ func (svc *BackupService) restoreUsageLogs(
 	db *ent.Client,
 	usageLogs []*BackupUsageLog,
 	requestIDMap map[int]int,
+	restoredLogRequestIDs map[int]struct{},
 	resolver *usageRestoreResolver,
+	report *RestoreReport,
 ) error {
 	if len(usageLogs) == 0 {
 		return nil
@@ -1149,9 +1413,15 @@ func (svc *BackupService) restoreUsageLogs(
 		return err
 	}
 
-	requestIDs := make([]int, 0, len(requestIDMap))
-	for _, requestID := range requestIDMap {
-		requestIDs = append(requestIDs, requestID)
+	requestIDs := make([]int, 0, len(usageLogs))
+	for _, usageData := range usageLogs {
+		if usageData == nil {
+			continue
+		}
+
+		if requestID, ok := requestIDMap[usageData.RequestID]; ok {
+			requestIDs = append(requestIDs, requestID)
+		}
 	}
 
 	existingLogRequestIDs := map[int]struct{}{}
@@ -1170,7 +1440,6 @@ func (svc *BackupService) restoreUsageLogs(
 		}
 	}
 
-	restoredLogRequestIDs := map[int]struct{}{}
 	builders := make([]*ent.UsageLogCreate, 0, min(len(usageLogs), backupBatchSize))
 	flush := func() error {
 		if len(builders) == 0 {
@@ -1197,6 +1466,8 @@ func (svc *BackupService) restoreUsageLogs(
 				log.Int("usage_log_id", usageData.ID),
 				log.Int("request_id", usageData.RequestID),
 			)
+			report.skipped(entityUsageLogs)
+
 			continue
 		}
 
@@ -1205,6 +1476,8 @@ func (svc *BackupService) restoreUsageLogs(
 				log.Int("usage_log_id", usageData.ID),
 				log.Int("request_id", usageData.RequestID),
 			)
+			report.skipped(entityUsageLogs)
+
 			continue
 		}
 
@@ -1213,6 +1486,8 @@ func (svc *BackupService) restoreUsageLogs(
 				log.Int("usage_log_id", usageData.ID),
 				log.Int("request_id", usageData.RequestID),
 			)
+			report.skipped(entityUsageLogs)
+
 			continue
 		}
 
@@ -1222,6 +1497,8 @@ func (svc *BackupService) restoreUsageLogs(
 				log.Int("usage_log_id", usageData.ID),
 				log.String("project", usageData.ProjectName),
 			)
+			report.skipped(entityUsageLogs)
+
 			continue
 		}
 
@@ -1268,6 +1545,8 @@ func (svc *BackupService) restoreUsageLogs(
 			SetNillableCostPriceReferenceID(nilIfEmpty(usageData.CostPriceReferenceID)))
 		restoredLogRequestIDs[requestID] = struct{}{}
 
+		report.created(entityUsageLogs)
+
 		if len(builders) >= backupBatchSize {
 			if err := flush(); err != nil {
 				return err
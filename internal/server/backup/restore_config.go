@@ -0,0 +1,1074 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/samber/lo"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/apikeyprofiletemplate"
+	"github.com/looplj/axonhub/internal/ent/channeloverridetemplate"
+	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/oidcidentity"
+	"github.com/looplj/axonhub/internal/ent/project"
+	"github.com/looplj/axonhub/internal/ent/prompt"
+	"github.com/looplj/axonhub/internal/ent/promptprotectionrule"
+	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/system"
+	entuser "github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/userproject"
+	"github.com/looplj/axonhub/internal/ent/userrole"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// Entity types of the restore report, named after the fields of the backup.
+const (
+	entityDataStorages             = "data_storages"
+	entitySystemSettings           = "system_settings"
+	entityProjects                 = "projects"
+	entityChannels                 = "channels"
+	entityModels                   = "models"
+	entityChannelModelPrices       = "channel_model_prices"
+	entityUsers                    = "users"
+	entityRoles                    = "roles"
+	entityProjectMemberships       = "project_memberships"
+	entityUserRoles                = "user_roles"
+	entityOIDCIdentities           = "oidc_identities"
+	entityPromptProtectionRules    = "prompt_protection_rules"
+	entityChannelOverrideTemplates = "channel_override_templates"
+	entityAPIKeyProfileTemplates   = "api_key_profile_templates"
+	entityAPIKeys                  = "api_keys"
+	entityPrompts                  = "prompts"
+	entityUsageRequests            = "usage_requests"
+	entityUsageLogs                = "usage_logs"
+)
+
+// restoreDataStorages restores the data storages by name.
+// The primary data storage is bound to the instance, so it is never restored, the backup primary maps to the instance primary.
+func (svc *BackupService) restoreDataStorages(
+	ctx context.Context,
+	db *ent.Client,
+	storages []*BackupDataStorage,
+	opts RestoreOptions,
+	report *RestoreReport,
+) error {
+	for _, dsData := range storages {
+		if dsData == nil {
+			continue
+		}
+
+		if dsData.Primary {
+			report.skipped(entityDataStorages)
+			continue
+		}
+
+		existing, err := db.DataStorage.Query().
+			Where(datastorage.Name(dsData.Name)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+
+		if existing != nil {
+			switch opts.DataStorageConflictStrategy {
+			case ConflictStrategySkip:
+				log.Info(ctx, "skipping existing data storage", log.String("name", dsData.Name))
+				report.skipped(entityDataStorages)
+
+				continue
+			case ConflictStrategyError:
+				return fmt.Errorf("data storage %s already exists", dsData.Name)
+			case ConflictStrategyOverwrite:
+				if existing.Primary || existing.Type != dsData.Type {
+					return fmt.Errorf("data storage %s can not be overwritten by a %s data storage", dsData.Name, dsData.Type)
+				}
+
+				if _, err := db.DataStorage.UpdateOneID(existing.ID).
+					SetDescription(dsData.Description).
+					SetSettings(dsData.Settings).
+					SetStatus(dsData.Status).
+					Save(ctx); err != nil {
+					return fmt.Errorf("failed to restore data storage %s: %w", dsData.Name, err)
+				}
+
+				report.updated(entityDataStorages)
+			}
+
+			continue
+		}
+
+		if _, err := db.DataStorage.Create().
+			SetName(dsData.Name).
+			SetDescription(dsData.Description).
+			SetType(dsData.Type).
+			SetSettings(dsData.Settings).
+			SetStatus(dsData.Status).
+			Save(ctx); err != nil {
+			return fmt.Errorf("failed to create data storage %s: %w", dsData.Name, err)
+		}
+
+		report.created(entityDataStorages)
+	}
+
+	return nil
+}
+
+// buildDataStorageIDMap maps the data storage IDs of the backup to the IDs of the instance by name.
+func (svc *BackupService) buildDataStorageIDMap(ctx context.Context, db *ent.Client, storages []*BackupDataStorage) (map[int]int, error) {
+	idMap := map[int]int{}
+	if len(storages) == 0 {
+		return idMap, nil
+	}
+
+	existing, err := db.DataStorage.Query().
+		Select(datastorage.FieldID, datastorage.FieldName, datastorage.FieldPrimary).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	primary, _ := lo.Find(existing, func(ds *ent.DataStorage) bool { return ds.Primary })
+	byName := lo.SliceToMap(existing, func(ds *ent.DataStorage) (string, int) { return ds.Name, ds.ID })
+
+	for _, dsData := range storages {
+		if dsData == nil || dsData.ID == 0 {
+			continue
+		}
+
+		if dsData.Primary {
+			if primary != nil {
+				idMap[dsData.ID] = primary.ID
+			}
+
+			continue
+		}
+
+		if id, ok := byName[dsData.Name]; ok {
+			idMap[dsData.ID] = id
+		}
+	}
+
+	return idMap, nil
+}
+
+func (svc *BackupService) restoreSystemSettings(
+	ctx context.Context,
+	db *ent.Client,
+	settings []*BackupSystemSetting,
+	opts RestoreOptions,
+	dataStorageIDMap map[int]int,
+	report *RestoreReport,
+) error {
+	for _, setting := range settings {
+		if setting == nil || setting.Key == "" || lo.Contains(instanceSystemKeys, setting.Key) {
+			continue
+		}
+
+		value, err := remapSystemSettingDataStorageID(setting.Key, setting.Value, dataStorageIDMap)
+		if err != nil {
+			return fmt.Errorf("failed to remap system setting %s: %w", setting.Key, err)
+		}
+
+		existing, err := db.System.Query().
+			Where(system.KeyEQ(setting.Key)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+
+		if existing != nil {
+			if existing.Value == value {
+				report.skipped(entitySystemSettings)
+				continue
+			}
+
+			switch opts.SystemSettingConflictStrategy {
+			case ConflictStrategySkip:
+				log.Info(ctx, "skipping existing system setting", log.String("key", setting.Key))
+				report.skipped(entitySystemSettings)
+
+				continue
+			case ConflictStrategyError:
+				return fmt.Errorf("system setting %s already exists", setting.Key)
+			case ConflictStrategyOverwrite:
+				if _, err := db.System.UpdateOneID(existing.ID).
+					SetValue(value).
+					Save(ctx); err != nil {
+					return fmt.Errorf("failed to restore system setting %s: %w", setting.Key, err)
+				}
+
+				report.updated(entitySystemSettings)
+			}
+
+			continue
+		}
+
+		if _, err := db.System.Create().
+			SetKey(setting.Key).
+			SetValue(value).
+			Save(ctx); err != nil {
+			return fmt.Errorf("failed to create system setting %s: %w", setting.Key, err)
+		}
+
+		report.created(entitySystemSettings)
+	}
+
+	return nil
+}
+
+// remapSystemSettingDataStorageID remaps the data storage referenced by a system setting.
+func remapSystemSettingDataStorageID(key, value string, dataStorageIDMap map[int]int) (string, error) {
+	if len(dataStorageIDMap) == 0 {
+		return value, nil
+	}
+
+	switch key {
+	case biz.SystemKeyDefaultDataStorage:
+		oldID, err := strconv.Atoi(value)
+		if err != nil {
+			return value, nil
+		}
+
+		if newID, ok := dataStorageIDMap[oldID]; ok {
+			return strconv.Itoa(newID), nil
+		}
+
+		return value, nil
+	case biz.SystemKeyAutoBackupSettings, biz.SystemKeyVideoStorageSettings:
+		var settings map[string]any
+		if err := json.Unmarshal([]byte(value), &settings); err != nil {
+			return "", err
+		}
+
+		oldID, ok := settings["data_storage_id"].(float64)
+		if !ok {
+			return value, nil
+		}
+
+		newID, ok := dataStorageIDMap[int(oldID)]
+		if !ok {
+			return value, nil
+		}
+
+		settings["data_storage_id"] = newID
+
+		b, err := json.Marshal(settings)
+		if err != nil {
+			return "", err
+		}
+
+		return string(b), nil
+	default:
+		return value, nil
+	}
+}
+
+func (svc *BackupService) restoreUsers(ctx context.Context, db *ent.Client, users []*BackupUser, opts RestoreOptions, report *RestoreReport) error {
+	for _, userData := range users {
+		if userData == nil {
+			continue
+		}
+
+		existing, err := db.User.Query().
+			Where(entuser.Email(userData.Email)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+
+		if existing != nil {
+			switch opts.UserConflictStrategy {
+			case ConflictStrategySkip:
+				log.Info(ctx, "skipping existing user", log.String("email", userData.Email))
+				report.skipped(entityUsers)
+
+				continue
+			case ConflictStrategyError:
+				return fmt.Errorf("user %s already exists", userData.Email)
+			case ConflictStrategyOverwrite:
+				update := db.User.UpdateOneID(existing.ID).
+					SetStatus(userData.Status).
+					SetPreferLanguage(userData.PreferLanguage).
+					SetFirstName(userData.FirstName).
+					SetLastName(userData.LastName).
+					SetAvatar(userData.Avatar).
+					SetIsOwner(userData.IsOwner).
+					SetScopes(userData.Scopes)
+
+				if userData.Password != "" {
+					update.SetPassword(userData.Password)
+				}
+
+				if _, err := update.Save(ctx); err != nil {
+					return fmt.Errorf("failed to restore user %s: %w", userData.Email, err)
+				}
+
+				report.updated(entityUsers)
+			}
+
+			continue
+		}
+
+		if userData.Password == "" {
+			log.Warn(ctx, "user has no password in backup, skipping", log.String("email", userData.Email))
+			report.skipped(entityUsers)
+
+			continue
+		}
+
+		if _, err := db.User.Create().
+			SetEmail(userData.Email).
+			SetStatus(userData.Status).
+			SetPreferLanguage(userData.PreferLanguage).
+			SetPassword(userData.Password).
+			SetFirstName(userData.FirstName).
+			SetLastName(userData.LastName).
+			SetAvatar(userData.Avatar).
+			SetIsOwner(userData.IsOwner).
+			SetScopes(userData.Scopes).
+			Save(ctx); err != nil {
+			return fmt.Errorf("failed to create user %s: %w", userData.Email, err)
+		}
+
+		report.created(entityUsers)
+	}
+
+	return nil
+}
+
+func (svc *BackupService) restoreRoles(ctx context.Context, db *ent.Client, roles []*BackupRole, opts RestoreOptions, report *RestoreReport) error {
+	resolver := newConfigRestoreResolver(ctx, db)
+
+	for _, roleData := range roles {
+		if roleData == nil {
+			continue
+		}
+
+		projectID, ok, err := resolver.roleProjectID(roleData.Level, roleData.ProjectName)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			log.Warn(ctx, "project not found for restoring role, skipping",
+				log.String("role", roleData.Name),
+				log.String("project", roleData.ProjectName))
+			report.skipped(entityRoles)
+
+			continue
+		}
+
+		existing, err := resolver.role(roleData.Name, roleData.Level, roleData.ProjectName)
+		if err != nil {
+			return err
+		}
+
+		if existing != nil {
+			switch opts.RoleConflictStrategy {
+			case ConflictStrategySkip:
+				log.Info(ctx, "skipping existing role", log.String("role", roleData.Name))
+				report.skipped(entityRoles)
+
+				continue
+			case ConflictStrategyError:
+				return fmt.Errorf("role %s already exists", roleData.Name)
+			case ConflictStrategyOverwrite:
+				if _, err := db.Role.UpdateOneID(existing.ID).
+					SetScopes(roleData.Scopes).
+					Save(ctx); err != nil {
+					return fmt.Errorf("failed to restore role %s: %w", roleData.Name, err)
+				}
+
+				report.updated(entityRoles)
+			}
+
+			continue
+		}
+
+		if _, err := db.Role.Create().
+			SetName(roleData.Name).
+			SetLevel(roleData.Level).
+			SetProjectID(projectID).
+			SetScopes(roleData.Scopes).
+			Save(ctx); err != nil {
+			return fmt.Errorf("failed to create role %s: %w", roleData.Name, err)
+		}
+
+		report.created(entityRoles)
+	}
+
+	return nil
+}
+
+func (svc *BackupService) restoreProjectMemberships(
+	ctx context.Context,
+	db *ent.Client,
+	memberships []*BackupProjectMembership,
+	opts RestoreOptions,
+	report *RestoreReport,
+) error {
+	resolver := newConfigRestoreResolver(ctx, db)
+
+	for _, membership := range memberships {
+		if membership == nil {
+			continue
+		}
+
+		u, err := resolver.user(membership.UserEmail)
+		if err != nil {
+			return err
+		}
+
+		proj, err := resolver.project(membership.ProjectName)
+		if err != nil {
+			return err
+		}
+
+		if u == nil || proj == nil {
+			log.Warn(ctx, "user or project not found for restoring project membership, skipping",
+				log.String("user", membership.UserEmail),
+				log.String("project", membership.ProjectName))
+			report.skipped(entityProjectMemberships)
+
+			continue
+		}
+
+		existing, err := db.UserProject.Query().
+			Where(userproject.UserID(u.ID), userproject.ProjectID(proj.ID)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+
+		if existing != nil {
+			switch opts.UserConflictStrategy {
+			case ConflictStrategySkip:
+				report.skipped(entityProjectMemberships)
+				continue
+			case ConflictStrategyError:
+				return fmt.Errorf("user %s is already a member of project %s", membership.UserEmail, membership.ProjectName)
+			case ConflictStrategyOverwrite:
+				if _, err := db.UserProject.UpdateOneID(existing.ID).
+					SetIsOwner(membership.IsOwner).
+					SetScopes(membership.Scopes).
+					Save(ctx); err != nil {
+					return fmt.Errorf("failed to restore project membership %s/%s: %w", membership.UserEmail, membership.ProjectName, err)
+				}
+
+				report.updated(entityProjectMemberships)
+			}
+
+			continue
+		}
+
+		if _, err := db.UserProject.Create().
+			SetUserID(u.ID).
+			SetProjectID(proj.ID).
+			SetIsOwner(membership.IsOwner).
+			SetScopes(membership.Scopes).
+			Save(ctx); err != nil {
+			return fmt.Errorf("failed to create project membership %s/%s: %w", membership.UserEmail, membership.ProjectName, err)
+		}
+
+		report.created(entityProjectMemberships)
+	}
+
+	return nil
+}
+
+func (svc *BackupService) restoreUserRoles(ctx context.Context, db *ent.Client, userRoles []*BackupUserRole, report *RestoreReport) error {
+	resolver := newConfigRestoreResolver(ctx, db)
+
+	for _, userRoleData := range userRoles {
+		if userRoleData == nil {
+			continue
+		}
+
+		u, err := resolver.user(userRoleData.UserEmail)
+		if err != nil {
+			return err
+		}
+
+		level := role.LevelSystem
+		if userRoleData.RoleProjectName != "" {
+			level = role.LevelProject
+		}
+
+		r, err := resolver.role(userRoleData.RoleName, level, userRoleData.RoleProjectName)
+		if err != nil {
+			return err
+		}
+
+		if u == nil || r == nil {
+			log.Warn(ctx, "user or role not found for restoring user role, skipping",
+				log.String("user", userRoleData.UserEmail),
+				log.String("role", userRoleData.RoleName))
+			report.skipped(entityUserRoles)
+
+			continue
+		}
+
+		exists, err := db.UserRole.Query().
+			Where(userrole.UserID(u.ID), userrole.RoleID(r.ID)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			report.skipped(entityUserRoles)
+			continue
+		}
+
+		if _, err := db.UserRole.Create().
+			SetUserID(u.ID).
+			SetRoleID(r.ID).
+			Save(ctx); err != nil {
+			return fmt.Errorf("failed to assign role %s to user %s: %w", userRoleData.RoleName, userRoleData.UserEmail, err)
+		}
+
+		report.created(entityUserRoles)
+	}
+
+	return nil
+}
+
+// restoreOIDCIdentities restores the links of the users to their OIDC identities,
+// the OIDC providers are configured in the config file, not in the backup.
+func (svc *BackupService) restoreOIDCIdentities(ctx context.Context, db *ent.Client, identities []*BackupOIDCIdentity, report *RestoreReport) error {
+	resolver := newConfigRestoreResolver(ctx, db)
+
+	for _, identity := range identities {
+		if identity == nil {
+			continue
+		}
+
+		u, err := resolver.user(identity.UserEmail)
+		if err != nil {
+			return err
+		}
+
+		if u == nil {
+			log.Warn(ctx, "user not found for restoring OIDC identity, skipping", log.String("user", identity.UserEmail))
+			report.skipped(entityOIDCIdentities)
+
+			continue
+		}
+
+		exists, err := db.OIDCIdentity.Query().
+			Where(oidcidentity.Issuer(identity.Issuer), oidcidentity.Subject(identity.Subject)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			report.skipped(entityOIDCIdentities)
+			continue
+		}
+
+		if _, err := db.OIDCIdentity.Create().
+			SetIssuer(identity.Issuer).
+			SetSubject(identity.Subject).
+			SetEmail(identity.Email).
+			SetIdpName(identity.IdpName).
+			SetNillableLastLoginAt(identity.LastLoginAt).
+			SetUserID(u.ID).
+			Save(ctx); err != nil {
+			return fmt.Errorf("failed to create OIDC identity of user %s: %w", identity.UserEmail, err)
+		}
+
+		report.created(entityOIDCIdentities)
+	}
+
+	return nil
+}
+
+func (svc *BackupService) restorePromptProtectionRules(
+	ctx context.Context,
+	db *ent.Client,
+	rules []*BackupPromptProtectionRule,
+	opts RestoreOptions,
+	report *RestoreReport,
+) error {
+	for _, ruleData := range rules {
+		if ruleData == nil {
+			continue
+		}
+
+		existing, err := db.PromptProtectionRule.Query().
+			Where(promptprotectionrule.Name(ruleData.Name)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+
+		if existing != nil {
+			switch opts.PromptProtectionRuleConflictStrategy {
+			case ConflictStrategySkip:
+				log.Info(ctx, "skipping existing prompt protection rule", log.String("name", ruleData.Name))
+				report.skipped(entityPromptProtectionRules)
+
+				continue
+			case ConflictStrategyError:
+				return fmt.Errorf("prompt protection rule %s already exists", ruleData.Name)
+			case ConflictStrategyOverwrite:
+				if _, err := db.PromptProtectionRule.UpdateOneID(existing.ID).
+					SetDescription(ruleData.Description).
+					SetPattern(ruleData.Pattern).
+					SetStatus(ruleData.Status).
+					SetSettings(ruleData.Settings).
+					Save(ctx); err != nil {
+					return fmt.Errorf("failed to restore prompt protection rule %s: %w", ruleData.Name, err)
+				}
+
+				report.updated(entityPromptProtectionRules)
+			}
+
+			continue
+		}
+
+		if _, err := db.PromptProtectionRule.Create().
+			SetName(ruleData.Name).
+			SetDescription(ruleData.Description).
+			SetPattern(ruleData.Pattern).
+			SetStatus(ruleData.Status).
+			SetSettings(ruleData.Settings).
+			Save(ctx); err != nil {
+			return fmt.Errorf("failed to create prompt protection rule %s: %w", ruleData.Name, err)
+		}
+
+		report.created(entityPromptProtectionRules)
+	}
+
+	return nil
+}
+
+func (svc *BackupService) restoreChannelOverrideTemplates(
+	ctx context.Context,
+	db *ent.Client,
+	templates []*BackupChannelOverrideTemplate,
+	opts RestoreOptions,
+	report *RestoreReport,
+) error {
+	currentUser, ok := contexts.GetUser(ctx)
+	if !ok || currentUser == nil {
+		return fmt.Errorf("user not found in context for restoring channel override templates")
+	}
+
+	resolver := newConfigRestoreResolver(ctx, db)
+
+	for _, tmplData := range templates {
+		if tmplData == nil {
+			continue
+		}
+
+		// Templates of users missing on the instance are restored to the restoring user.
+		userID := currentUser.ID
+
+		u, err := resolver.user(tmplData.UserEmail)
+		if err != nil {
+			return err
+		}
+
+		if u != nil {
+			userID = u.ID
+		}
+
+		existing, err := db.ChannelOverrideTemplate.Query().
+			Where(channeloverridetemplate.UserID(userID), channeloverridetemplate.Name(tmplData.Name)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+
+		if existing != nil {
+			switch opts.ChannelOverrideTemplateConflictStrategy {
+			case ConflictStrategySkip:
+				log.Info(ctx, "skipping existing channel override template", log.String("name", tmplData.Name))
+				report.skipped(entityChannelOverrideTemplates)
+
+				continue
+			case ConflictStrategyError:
+				return fmt.Errorf("channel override template %s already exists", tmplData.Name)
+			case ConflictStrategyOverwrite:
+				if _, err := db.ChannelOverrideTemplate.UpdateOneID(existing.ID).
+					SetDescription(tmplData.Description).
+					SetOverrideParameters(tmplData.OverrideParameters).
+					SetOverrideHeaders(tmplData.OverrideHeaders).
+					SetHeaderOverrideOperations(tmplData.HeaderOverrideOperations).
+					SetBodyOverrideOperations(tmplData.BodyOverrideOperations).
+					Save(ctx); err != nil {
+					return fmt.Errorf("failed to restore channel override template %s: %w", tmplData.Name, err)
+				}
+
+				report.updated(entityChannelOverrideTemplates)
+			}
+
+			continue
+		}
+
+		if _, err := db.ChannelOverrideTemplate.Create().
+			SetUserID(userID).
+			SetName(tmplData.Name).
+			SetDescription(tmplData.Description).
+			SetOverrideParameters(tmplData.OverrideParameters).
+			SetOverrideHeaders(tmplData.OverrideHeaders).
+			SetHeaderOverrideOperations(tmplData.HeaderOverrideOperations).
+			SetBodyOverrideOperations(tmplData.BodyOverrideOperations).
+			Save(ctx); err != nil {
+			return fmt.Errorf("failed to create channel override template %s: %w", tmplData.Name, err)
+		}
+
+		report.created(entityChannelOverrideTemplates)
+	}
+
+	return nil
+}
+
+func (svc *BackupService) restoreAPIKeyProfileTemplates(
+	ctx context.Context,
+	db *ent.Client,
+	templates []*BackupAPIKeyProfileTemplate,
+	opts RestoreOptions,
+	channelIDMap map[int]int,
+	report *RestoreReport,
+) error {
+	resolver := newConfigRestoreResolver(ctx, db)
+
+	for _, tmplData := range templates {
+		if tmplData == nil {
+			continue
+		}
+
+		remapAPIKeyProfileChannelIDs(tmplData.Profile, channelIDMap)
+
+		proj, err := resolver.project(tmplData.ProjectName)
+		if err != nil {
+			return err
+		}
+
+		if proj == nil {
+			log.Warn(ctx, "project not found for restoring API key profile template, skipping",
+				log.String("name", tmplData.Name),
+				log.String("project", tmplData.ProjectName))
+			report.skipped(entityAPIKeyProfileTemplates)
+
+			continue
+		}
+
+		existing, err := db.APIKeyProfileTemplate.Query().
+			Where(apikeyprofiletemplate.ProjectID(proj.ID), apikeyprofiletemplate.Name(tmplData.Name)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+
+		if existing != nil {
+			switch opts.APIKeyProfileTemplateConflictStrategy {
+			case ConflictStrategySkip:
+				log.Info(ctx, "skipping existing API key profile template", log.String("name", tmplData.Name))
+				report.skipped(entityAPIKeyProfileTemplates)
+
+				continue
+			case ConflictStrategyError:
+				return fmt.Errorf("API key profile template %s already exists", tmplData.Name)
+			case ConflictStrategyOverwrite:
+				if _, err := db.APIKeyProfileTemplate.UpdateOneID(existing.ID).
+					SetDescription(tmplData.Description).
+					SetProfile(tmplData.Profile).
+					Save(ctx); err != nil {
+					return fmt.Errorf("failed to restore API key profile template %s: %w", tmplData.Name, err)
+				}
+
+				report.updated(entityAPIKeyProfileTemplates)
+			}
+
+			continue
+		}
+
+		if _, err := db.APIKeyProfileTemplate.Create().
+			SetProjectID(proj.ID).
+			SetName(tmplData.Name).
+			SetDescription(tmplData.Description).
+			SetProfile(tmplData.Profile).
+			Save(ctx); err != nil {
+			return fmt.Errorf("failed to create API key profile template %s: %w", tmplData.Name, err)
+		}
+
+		report.created(entityAPIKeyProfileTemplates)
+	}
+
+	return nil
+}
+
+// buildAPIKeyIDMap maps the API key IDs of the backup to the IDs of the instance by key.
+func (svc *BackupService) buildAPIKeyIDMap(ctx context.Context, db *ent.Client, apiKeys []*BackupAPIKey) (map[int]int, error) {
+	idMap := map[int]int{}
+
+	keys := make([]string, 0, len(apiKeys))
+	for _, ak := range apiKeys {
+		if ak != nil && ak.ID != 0 {
+			keys = append(keys, ak.Key)
+		}
+	}
+
+	if len(keys) == 0 {
+		return idMap, nil
+	}
+
+	existing, err := db.APIKey.Query().
+		Where(apikey.KeyIn(keys...)).
+		Select(apikey.FieldID, apikey.FieldKey).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := lo.SliceToMap(existing, func(ak *ent.APIKey) (string, int) { return ak.Key, ak.ID })
+
+	for _, ak := range apiKeys {
+		if ak == nil || ak.ID == 0 {
+			continue
+		}
+
+		if id, ok := byKey[ak.Key]; ok {
+			idMap[ak.ID] = id
+		}
+	}
+
+	return idMap, nil
+}
+
+func (svc *BackupService) restorePrompts(
+	ctx context.Context,
+	db *ent.Client,
+	prompts []*BackupPrompt,
+	opts RestoreOptions,
+	apiKeyIDMap map[int]int,
+	report *RestoreReport,
+) error {
+	resolver := newConfigRestoreResolver(ctx, db)
+
+	for _, promptData := range prompts {
+		if promptData == nil {
+			continue
+		}
+
+		remapPromptSettingsAPIKeyIDs(&promptData.Settings, apiKeyIDMap)
+
+		proj, err := resolver.project(promptData.ProjectName)
+		if err != nil {
+			return err
+		}
+
+		if proj == nil {
+			log.Warn(ctx, "project not found for restoring prompt, skipping",
+				log.String("name", promptData.Name),
+				log.String("project", promptData.ProjectName))
+			report.skipped(entityPrompts)
+
+			continue
+		}
+
+		existing, err := db.Prompt.Query().
+			Where(prompt.ProjectID(proj.ID), prompt.Name(promptData.Name)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+
+		if existing != nil {
+			switch opts.PromptConflictStrategy {
+			case ConflictStrategySkip:
+				log.Info(ctx, "skipping existing prompt", log.String("name", promptData.Name))
+				report.skipped(entityPrompts)
+
+				continue
+			case ConflictStrategyError:
+				return fmt.Errorf("prompt %s already exists", promptData.Name)
+			case ConflictStrategyOverwrite:
+				if _, err := db.Prompt.UpdateOneID(existing.ID).
+					SetDescription(promptData.Description).
+					SetRole(promptData.Role).
+					SetContent(promptData.Content).
+					SetStatus(promptData.Status).
+					SetOrder(promptData.Order).
+					SetSettings(promptData.Settings).
+					Save(ctx); err != nil {
+					return fmt.Errorf("failed to restore prompt %s: %w", promptData.Name, err)
+				}
+
+				report.updated(entityPrompts)
+			}
+
+			continue
+		}
+
+		if _, err := db.Prompt.Create().
+			SetProjectID(proj.ID).
+			SetName(promptData.Name).
+			SetDescription(promptData.Description).
+			SetRole(promptData.Role).
+			SetContent(promptData.Content).
+			SetStatus(promptData.Status).
+			SetOrder(promptData.Order).
+			SetSettings(promptData.Settings).
+			Save(ctx); err != nil {
+			return fmt.Errorf("failed to create prompt %s: %w", promptData.Name, err)
+		}
+
+		report.created(entityPrompts)
+	}
+
+	return nil
+}
+
+func remapAPIKeyProfileChannelIDs(profile *objects.APIKeyProfile, channelIDMap map[int]int) {
+	if profile == nil || len(channelIDMap) == 0 {
+		return
+	}
+
+	for i, oldID := range profile.ChannelIDs {
+		if newID, ok := channelIDMap[oldID]; ok {
+			profile.ChannelIDs[i] = newID
+		}
+	}
+}
+
+func remapPromptSettingsAPIKeyIDs(settings *objects.PromptSettings, apiKeyIDMap map[int]int) {
+	if len(apiKeyIDMap) == 0 {
+		return
+	}
+
+	for i := range settings.Conditions {
+		conditions := settings.Conditions[i].Conditions
+		for j := range conditions {
+			if conditions[j].APIKeyID == nil {
+				continue
+			}
+
+			if newID, ok := apiKeyIDMap[*conditions[j].APIKeyID]; ok {
+				conditions[j].APIKeyID = &newID
+			}
+		}
+	}
+}
+
+func remapUsageRequestsStorageIDs(requests []*BackupUsageRequest, dataStorageIDMap map[int]int) {
+	if len(dataStorageIDMap) == 0 {
+		return
+	}
+
+	for _, req := range requests {
+		if req == nil || req.ContentStorageID == nil {
+			continue
+		}
+
+		if newID, ok := dataStorageIDMap[*req.ContentStorageID]; ok {
+			req.ContentStorageID = &newID
+		}
+	}
+}
+
+// configRestoreResolver resolves the natural keys of the backup to the entities of the instance.
+type configRestoreResolver struct {
+	ctx      context.Context
+	db       *ent.Client
+	users    map[string]*ent.User
+	projects map[string]*ent.Project
+}
+
+func newConfigRestoreResolver(ctx context.Context, db *ent.Client) *configRestoreResolver {
+	return &configRestoreResolver{
+		ctx:      ctx,
+		db:       db,
+		users:    map[string]*ent.User{},
+		projects: map[string]*ent.Project{},
+	}
+}
+
+// user returns the user with the email, or nil if it does not exist.
+func (r *configRestoreResolver) user(email string) (*ent.User, error) {
+	if email == "" {
+		return nil, nil
+	}
+
+	if u, ok := r.users[email]; ok {
+		return u, nil
+	}
+
+	u, err := r.db.User.Query().
+		Where(entuser.Email(email)).
+		First(r.ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	r.users[email] = u
+
+	return u, nil
+}
+
+// project returns the project with the name, or nil if it does not exist.
+func (r *configRestoreResolver) project(name string) (*ent.Project, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	if p, ok := r.projects[name]; ok {
+		return p, nil
+	}
+
+	p, err := r.db.Project.Query().
+		Where(project.Name(name)).
+		First(r.ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	r.projects[name] = p
+
+	return p, nil
+}
+
+// roleProjectID returns the project ID of a role, 0 for the system roles.
+func (r *configRestoreResolver) roleProjectID(level role.Level, projectName string) (int, bool, error) {
+	if level != role.LevelProject {
+		return 0, true, nil
+	}
+
+	p, err := r.project(projectName)
+	if err != nil || p == nil {
+		return 0, false, err
+	}
+
+	return p.ID, true, nil
+}
+
+// role returns the role with the name in the project of a project level role, or nil if it does not exist.
+func (r *configRestoreResolver) role(name string, level role.Level, projectName string) (*ent.Role, error) {
+	projectID, ok, err := r.roleProjectID(level, projectName)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	query := r.db.Role.Query().Where(role.Name(name), role.LevelEQ(level))
+	if level == role.LevelProject {
+		query.Where(role.ProjectID(projectID))
+	}
+
+	existing, err := query.First(r.ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	return existing, nil
+}
@@ -0,0 +1,365 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/apikeyprofiletemplate"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/channeloverridetemplate"
+	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/oidcidentity"
+	"github.com/looplj/axonhub/internal/ent/prompt"
+	"github.com/looplj/axonhub/internal/ent/promptprotectionrule"
+	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/system"
+	entuser "github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/userproject"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+var fullBackupOptions = BackupOptions{
+	IncludeProjects:                 true,
+	IncludeChannels:                 true,
+	IncludeModels:                   true,
+	IncludeAPIKeys:                  true,
+	IncludeModelPrices:              true,
+	IncludeUsers:                    true,
+	IncludeRoles:                    true,
+	IncludePrompts:                  true,
+	IncludePromptProtectionRules:    true,
+	IncludeChannelOverrideTemplates: true,
+	IncludeAPIKeyProfileTemplates:   true,
+	IncludeDataStorages:             true,
+	IncludeSystemSettings:           true,
+}
+
+var fullRestoreOptions = RestoreOptions{
+	IncludeProjects:                 true,
+	IncludeChannels:                 true,
+	IncludeModels:                   true,
+	IncludeAPIKeys:                  true,
+	IncludeModelPrices:              true,
+	IncludeUsers:                    true,
+	IncludeRoles:                    true,
+	IncludePrompts:                  true,
+	IncludePromptProtectionRules:    true,
+	IncludeChannelOverrideTemplates: true,
+	IncludeAPIKeyProfileTemplates:   true,
+	IncludeDataStorages:             true,
+	IncludeSystemSettings:           true,
+}
+
+func createBackupTestDataStorage(t *testing.T, client *ent.Client, ctx context.Context, name string) *ent.DataStorage {
+	ds, err := client.DataStorage.Create().
+		SetName(name).
+		SetDescription(name + " storage").
+		SetType(datastorage.TypeFs).
+		SetSettings(&objects.DataStorageSettings{Directory: lo.ToPtr("/data/" + name)}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	return ds
+}
+
+// seedConfiguration creates one of each configuration entity on the source instance.
+func seedConfiguration(t *testing.T, client *ent.Client, ctx context.Context) {
+	// Shift the IDs of the source, so that remapping is required on the target.
+	createBackupTestChannel(t, client, ctx, "Unused Channel", channel.TypeOpenai)
+	createBackupTestDataStorage(t, client, ctx, "unused")
+
+	ch := createBackupTestChannel(t, client, ctx, "Team Channel", channel.TypeOpenai)
+	archive := createBackupTestDataStorage(t, client, ctx, "archive")
+	proj := createBackupTestProject(t, client, ctx, "Team", "Team project")
+
+	_, err := client.System.Create().SetKey(biz.SystemKeyDefaultDataStorage).SetValue(strconv.Itoa(archive.ID)).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.System.Create().SetKey(biz.SystemKeyBrandName).SetValue("Acme").Save(ctx)
+	require.NoError(t, err)
+	_, err = client.System.Create().SetKey(biz.SystemKeySecretKey).SetValue("source-secret").Save(ctx)
+	require.NoError(t, err)
+
+	alice, err := client.User.Create().
+		SetEmail("alice@example.com").
+		SetPassword("alice-password-hash").
+		SetFirstName("Alice").
+		SetScopes([]string{"read_channels"}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	reviewer, err := client.Role.Create().
+		SetName("Reviewer").
+		SetLevel(role.LevelProject).
+		SetProjectID(proj.ID).
+		SetScopes([]string{"read_requests"}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	auditor, err := client.Role.Create().
+		SetName("Auditor").
+		SetScopes([]string{"read_users"}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.UserProject.Create().SetUserID(alice.ID).SetProjectID(proj.ID).SetScopes([]string{"write_prompts"}).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.UserRole.Create().SetUserID(alice.ID).SetRoleID(reviewer.ID).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.UserRole.Create().SetUserID(alice.ID).SetRoleID(auditor.ID).Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.OIDCIdentity.Create().
+		SetIssuer("https://idp.example.com").
+		SetSubject("alice-subject").
+		SetEmail("alice@example.com").
+		SetUserID(alice.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	createBackupTestAPIKey(t, client, ctx, alice, proj, "Unused Key", "sk-unused")
+	ak := createBackupTestAPIKey(t, client, ctx, alice, proj, "Alice Key", "sk-alice")
+
+	_, err = client.Prompt.Create().
+		SetProjectID(proj.ID).
+		SetName("House Style").
+		SetRole("system").
+		SetContent("Be concise.").
+		SetSettings(objects.PromptSettings{
+			Action: objects.PromptAction{Type: objects.PromptActionTypePrepend},
+			Conditions: []objects.PromptActivationConditionComposite{{
+				Conditions: []objects.PromptActivationCondition{{
+					Type:     objects.PromptActivationConditionTypeAPIKey,
+					APIKeyID: lo.ToPtr(ak.ID),
+				}},
+			}},
+		}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.PromptProtectionRule.Create().
+		SetName("No Secrets").
+		SetPattern("sk-[a-z]+").
+		SetSettings(&objects.PromptProtectionSettings{Action: objects.PromptProtectionActionMask}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.ChannelOverrideTemplate.Create().
+		SetUserID(alice.ID).
+		SetName("Low Temperature").
+		SetOverrideParameters(`{"temperature":0}`).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.APIKeyProfileTemplate.Create().
+		SetProjectID(proj.ID).
+		SetName("Team Channel Only").
+		SetProfile(&objects.APIKeyProfile{Name: "team", ChannelIDs: []int{ch.ID}}).
+		Save(ctx)
+	require.NoError(t, err)
+}
+
+func TestBackupService_BackupRestore_Configuration(t *testing.T) {
+	sourceClient, sourceService, sourceCtx := setupBackupTest(t)
+	defer sourceClient.Close()
+
+	seedConfiguration(t, sourceClient, sourceCtx)
+
+	data, err := sourceService.Backup(sourceCtx, fullBackupOptions)
+	require.NoError(t, err)
+
+	var backupData BackupData
+	require.NoError(t, json.Unmarshal(data, &backupData))
+	require.Equal(t, BackupVersion, backupData.Version)
+	require.Len(t, backupData.Users, 2)
+	require.Len(t, backupData.Roles, 2)
+	require.Len(t, backupData.ProjectMemberships, 1)
+	require.Len(t, backupData.UserRoles, 2)
+	require.Len(t, backupData.OIDCIdentities, 1)
+	require.Len(t, backupData.Prompts, 1)
+	require.Len(t, backupData.PromptProtectionRules, 1)
+	require.Len(t, backupData.ChannelOverrideTemplates, 1)
+	require.Len(t, backupData.APIKeyProfileTemplates, 1)
+	require.Len(t, backupData.DataStorages, 2)
+
+	// The instance bound settings are not backed up.
+	keys := lo.Map(backupData.SystemSettings, func(s *BackupSystemSetting, _ int) string { return s.Key })
+	require.ElementsMatch(t, []string{biz.SystemKeyDefaultDataStorage, biz.SystemKeyBrandName}, keys)
+
+	targetClient, targetService, targetCtx := setupBackupTest(t)
+	defer targetClient.Close()
+
+	createBackupTestChannel(t, targetClient, targetCtx, "Target Channel", channel.TypeOpenai)
+
+	// A dry run reports the changes without applying them.
+	dryRunOptions := fullRestoreOptions
+	dryRunOptions.DryRun = true
+
+	report, err := targetService.RestoreWithReport(targetCtx, data, dryRunOptions)
+	require.NoError(t, err)
+	require.True(t, report.DryRun)
+	require.Equal(t, &RestoreEntityReport{EntityType: entityUsers, Created: 1, Skipped: 1}, report.entity(entityUsers))
+	require.Equal(t, 2, report.entity(entityRoles).Created)
+	require.Equal(t, 1, report.entity(entityPrompts).Created)
+
+	exists, err := targetClient.User.Query().Where(entuser.Email("alice@example.com")).Exist(targetCtx)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	report, err = targetService.RestoreWithReport(targetCtx, data, fullRestoreOptions)
+	require.NoError(t, err)
+	require.False(t, report.DryRun)
+	require.Equal(t, 2, report.entity(entityDataStorages).Created)
+	require.Equal(t, 2, report.entity(entitySystemSettings).Created)
+	require.Equal(t, 1, report.entity(entityProjectMemberships).Created)
+	require.Equal(t, 2, report.entity(entityUserRoles).Created)
+	require.Equal(t, 1, report.entity(entityOIDCIdentities).Created)
+
+	alice, err := targetClient.User.Query().Where(entuser.Email("alice@example.com")).Only(targetCtx)
+	require.NoError(t, err)
+	require.Equal(t, "alice-password-hash", alice.Password)
+	require.Equal(t, "Alice", alice.FirstName)
+	require.Equal(t, []string{"read_channels"}, alice.Scopes)
+
+	proj, err := targetClient.Project.Query().Only(targetCtx)
+	require.NoError(t, err)
+
+	membership, err := targetClient.UserProject.Query().Where(userproject.UserID(alice.ID), userproject.ProjectID(proj.ID)).Only(targetCtx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"write_prompts"}, membership.Scopes)
+
+	roles, err := alice.QueryRoles().All(targetCtx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"Reviewer", "Auditor"}, lo.Map(roles, func(r *ent.Role, _ int) string { return r.Name }))
+
+	reviewer, err := targetClient.Role.Query().Where(role.Name("Reviewer")).Only(targetCtx)
+	require.NoError(t, err)
+	require.Equal(t, proj.ID, lo.FromPtr(reviewer.ProjectID))
+
+	identity, err := targetClient.OIDCIdentity.Query().Where(oidcidentity.Subject("alice-subject")).Only(targetCtx)
+	require.NoError(t, err)
+	require.Equal(t, alice.ID, identity.UserID)
+
+	// The API key is restored to its owner, and the prompt condition is remapped to its new ID.
+	ak, err := targetClient.APIKey.Query().Where(apikey.Key("sk-alice")).Only(targetCtx)
+	require.NoError(t, err)
+	require.Equal(t, alice.ID, ak.UserID)
+
+	p, err := targetClient.Prompt.Query().Where(prompt.Name("House Style")).Only(targetCtx)
+	require.NoError(t, err)
+	require.Equal(t, proj.ID, p.ProjectID)
+	require.Equal(t, ak.ID, lo.FromPtr(p.Settings.Conditions[0].Conditions[0].APIKeyID))
+
+	exists, err = targetClient.PromptProtectionRule.Query().Where(promptprotectionrule.Name("No Secrets")).Exist(targetCtx)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	overrideTemplate, err := targetClient.ChannelOverrideTemplate.Query().Where(channeloverridetemplate.Name("Low Temperature")).Only(targetCtx)
+	require.NoError(t, err)
+	require.Equal(t, alice.ID, overrideTemplate.UserID)
+
+	teamChannel, err := targetClient.Channel.Query().Where(channel.Name("Team Channel")).Only(targetCtx)
+	require.NoError(t, err)
+
+	profileTemplate, err := targetClient.APIKeyProfileTemplate.Query().Where(apikeyprofiletemplate.Name("Team Channel Only")).Only(targetCtx)
+	require.NoError(t, err)
+	require.Equal(t, []int{teamChannel.ID}, profileTemplate.Profile.ChannelIDs)
+
+	// The default data storage setting points to the restored storage.
+	archive, err := targetClient.DataStorage.Query().Where(datastorage.Name("archive")).Only(targetCtx)
+	require.NoError(t, err)
+
+	defaultStorage, err := targetClient.System.Query().Where(system.KeyEQ(biz.SystemKeyDefaultDataStorage)).Only(targetCtx)
+	require.NoError(t, err)
+	require.Equal(t, strconv.Itoa(archive.ID), defaultStorage.Value)
+
+	exists, err = targetClient.System.Query().Where(system.KeyEQ(biz.SystemKeySecretKey)).Exist(targetCtx)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestBackupService_Restore_SelectiveEntityTypes(t *testing.T) {
+	sourceClient, sourceService, sourceCtx := setupBackupTest(t)
+	defer sourceClient.Close()
+
+	seedConfiguration(t, sourceClient, sourceCtx)
+
+	data, err := sourceService.Backup(sourceCtx, fullBackupOptions)
+	require.NoError(t, err)
+
+	targetClient, targetService, targetCtx := setupBackupTest(t)
+	defer targetClient.Close()
+
+	report, err := targetService.RestoreWithReport(targetCtx, data, RestoreOptions{
+		IncludePromptProtectionRules: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, report.Entities, 1)
+	require.Equal(t, &RestoreEntityReport{EntityType: entityPromptProtectionRules, Created: 1}, report.Entities[0])
+
+	count, err := targetClient.User.Query().Count(targetCtx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	count, err = targetClient.Prompt.Query().Count(targetCtx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestBackupService_Restore_ConfigurationConflictStrategies(t *testing.T) {
+	client, service, ctx := setupBackupTest(t)
+	defer client.Close()
+
+	_, err := client.PromptProtectionRule.Create().
+		SetName("No Secrets").
+		SetPattern("old").
+		SetSettings(&objects.PromptProtectionSettings{Action: objects.PromptProtectionActionMask}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	backupData := BackupData{
+		Version: BackupVersion,
+		PromptProtectionRules: []*BackupPromptProtectionRule{{
+			PromptProtectionRule: ent.PromptProtectionRule{
+				Name:     "No Secrets",
+				Pattern:  "new",
+				Status:   promptprotectionrule.StatusEnabled,
+				Settings: &objects.PromptProtectionSettings{Action: objects.PromptProtectionActionMask},
+			},
+		}},
+	}
+
+	data, err := json.Marshal(backupData)
+	require.NoError(t, err)
+
+	err = service.Restore(ctx, data, RestoreOptions{
+		IncludePromptProtectionRules:         true,
+		PromptProtectionRuleConflictStrategy: ConflictStrategyError,
+	})
+	require.Error(t, err)
+
+	report, err := service.RestoreWithReport(ctx, data, RestoreOptions{
+		IncludePromptProtectionRules:         true,
+		PromptProtectionRuleConflictStrategy: ConflictStrategySkip,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, report.entity(entityPromptProtectionRules).Skipped)
+
+	report, err = service.RestoreWithReport(ctx, data, RestoreOptions{
+		IncludePromptProtectionRules:         true,
+		PromptProtectionRuleConflictStrategy: ConflictStrategyOverwrite,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, report.entity(entityPromptProtectionRules).Updated)
+
+	rule, err := client.PromptProtectionRule.Query().Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "new", rule.Pattern)
+}
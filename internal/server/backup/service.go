@@ -16,6 +16,7 @@ type BackupServiceParams struct {
 	Ent                *ent.Client
 	SystemService      *biz.SystemService
 	DataStorageService *biz.DataStorageService
+	WebhookNotifier    *biz.WebhookNotifier
 }
 
 func NewBackupService(params BackupServiceParams) *BackupService {
@@ -23,6 +24,7 @@ func NewBackupService(params BackupServiceParams) *BackupService {
 		db:                 params.Ent,
 		systemService:      params.SystemService,
 		dataStorageService: params.DataStorageService,
+		webhookNotifier:    params.WebhookNotifier,
 	}
 
 	return svc
@@ -33,6 +35,7 @@ type BackupService struct {
 
 	systemService      *biz.SystemService
 	dataStorageService *biz.DataStorageService
+	webhookNotifier    *biz.WebhookNotifier
 }
 
 func (svc *BackupService) RegisterScheduledTasks(ctx context.Context, s *scheduler.Scheduler) error {
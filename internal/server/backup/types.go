@@ -4,22 +4,101 @@ import (
 	"encoding/json"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
+
 	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/predicate"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/objects"
 )
 
 type BackupData struct {
-	Version            string                     `json:"version"`
-	Timestamp          time.Time                  `json:"timestamp"`
-	Projects           []*BackupProject           `json:"projects,omitempty"`
-	Channels           []*BackupChannel           `json:"channels"`
-	Models             []*BackupModel             `json:"models"`
-	ChannelModelPrices []*BackupChannelModelPrice `json:"channel_model_prices,omitempty"`
-	APIKeys            []*BackupAPIKey            `json:"api_keys,omitempty"`
-	UsageRequests      []*BackupUsageRequest      `json:"usage_requests,omitempty"`
-	UsageLogs          []*BackupUsageLog          `json:"usage_logs,omitempty"`
+	Version                  string                           `json:"version"`
+	Timestamp                time.Time                        `json:"timestamp"`
+	DataStorages             []*BackupDataStorage             `json:"data_storages,omitempty"`
+	SystemSettings           []*BackupSystemSetting           `json:"system_settings,omitempty"`
+	Projects                 []*BackupProject                 `json:"projects,omitempty"`
+	Channels                 []*BackupChannel                 `json:"channels"`
+	Models                   []*BackupModel                   `json:"models"`
+	ChannelModelPrices       []*BackupChannelModelPrice       `json:"channel_model_prices,omitempty"`
+	Users                    []*BackupUser                    `json:"users,omitempty"`
+	Roles                    []*BackupRole                    `json:"roles,omitempty"`
+	ProjectMemberships       []*BackupProjectMembership       `json:"project_memberships,omitempty"`
+	UserRoles                []*BackupUserRole                `json:"user_roles,omitempty"`
+	OIDCIdentities           []*BackupOIDCIdentity            `json:"oidc_identities,omitempty"`
+	PromptProtectionRules    []*BackupPromptProtectionRule    `json:"prompt_protection_rules,omitempty"`
+	ChannelOverrideTemplates []*BackupChannelOverrideTemplate `json:"channel_override_templates,omitempty"`
+	APIKeyProfileTemplates   []*BackupAPIKeyProfileTemplate   `json:"api_key_profile_templates,omitempty"`
+	APIKeys                  []*BackupAPIKey                  `json:"api_keys,omitempty"`
+	Prompts                  []*BackupPrompt                  `json:"prompts,omitempty"`
+	UsageRequests            []*BackupUsageRequest            `json:"usage_requests,omitempty"`
+	UsageLogs                []*BackupUsageLog                `json:"usage_logs,omitempty"`
+}
+
+type BackupDataStorage struct {
+	ent.DataStorage
+}
+
+type BackupSystemSetting struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type BackupUser struct {
+	ent.User
+
+	// Password is the password hash of the user.
+	Password string `json:"password"`
+}
+
+type BackupRole struct {
+	ent.Role
+
+	// ProjectName is the project of a project level role.
+	ProjectName string `json:"project_name,omitempty"`
+}
+
+type BackupProjectMembership struct {
+	UserEmail   string   `json:"user_email"`
+	ProjectName string   `json:"project_name"`
+	IsOwner     bool     `json:"is_owner"`
+	Scopes      []string `json:"scopes,omitempty"`
+}
+
+type BackupUserRole struct {
+	UserEmail string `json:"user_email"`
+	RoleName  string `json:"role_name"`
+	// RoleProjectName is the project of a project level role, empty for the system roles.
+	RoleProjectName string `json:"role_project_name,omitempty"`
+}
+
+type BackupOIDCIdentity struct {
+	ent.OIDCIdentity
+
+	UserEmail string `json:"user_email"`
+}
+
+type BackupPromptProtectionRule struct {
+	ent.PromptProtectionRule
+}
+
+type BackupChannelOverrideTemplate struct {
+	ent.ChannelOverrideTemplate
+
+	UserEmail string `json:"user_email,omitempty"`
+}
+
+type BackupAPIKeyProfileTemplate struct {
+	ent.APIKeyProfileTemplate
+
+	ProjectName string `json:"project_name"`
+}
+
+type BackupPrompt struct {
+	ent.Prompt
+
+	ProjectName string `json:"project_name"`
 }
 
 type BackupProject struct {
@@ -40,6 +119,7 @@ type BackupAPIKey struct {
 	ent.APIKey
 
 	ProjectName string `json:"project_name"`
+	UserEmail   string `json:"user_email,omitempty"`
 }
 
 type BackupChannelModelPrice struct {
@@ -190,10 +270,11 @@ func (l BackupUsageLog) MarshalJSON() ([]byte, error) {
 }
 
 const (
-	BackupVersion   = "1.3"
+	BackupVersion   = "1.4"
 	BackupVersionV1 = "1.0"
 	BackupVersionV2 = "1.1"
 	BackupVersionV3 = "1.2"
+	BackupVersionV4 = "1.3"
 )
 
 type BackupOptions struct {
@@ -204,6 +285,44 @@ type BackupOptions struct {
 	IncludeModelPrices bool
 	IncludeUsageStats  bool
 	IncludeRequestLogs bool
+	// IncludeUsers includes the users with their project memberships, role assignments and OIDC identities.
+	IncludeUsers                    bool
+	IncludeRoles                    bool
+	IncludePrompts                  bool
+	IncludePromptProtectionRules    bool
+	IncludeChannelOverrideTemplates bool
+	IncludeAPIKeyProfileTemplates   bool
+	IncludeDataStorages             bool
+	IncludeSystemSettings           bool
+
+	// usageRequestIDs limits the usage requests and logs of an archive to a range of request IDs.
+	usageRequestIDs *requestIDRange
+}
+
+// requestIDRange is the range (After, Until] of request IDs, the usage logs are selected by their request.
+type requestIDRange struct {
+	After int
+	Until int
+}
+
+func (r *requestIDRange) requestPredicates() []predicate.Request {
+	if r == nil {
+		return nil
+	}
+
+	return []predicate.Request{request.IDGT(r.After), request.IDLTE(r.Until)}
+}
+
+func (r *requestIDRange) usageLogPredicates() []predicate.UsageLog {
+	if r == nil {
+		return nil
+	}
+
+	// The request ID is an edge field, ent does not generate its range predicates.
+	return []predicate.UsageLog{
+		sql.FieldGT(usagelog.FieldRequestID, r.After),
+		sql.FieldLTE(usagelog.FieldRequestID, r.Until),
+	}
 }
 
 type ConflictStrategy string
@@ -215,16 +334,98 @@ const (
 )
 
 type RestoreOptions struct {
-	IncludeProjects            bool
-	IncludeChannels            bool
-	IncludeModels              bool
-	IncludeAPIKeys             bool
-	IncludeModelPrices         bool
-	IncludeUsageStats          bool
-	IncludeRequestLogs         bool
-	ProjectConflictStrategy    ConflictStrategy
-	ChannelConflictStrategy    ConflictStrategy
-	ModelConflictStrategy      ConflictStrategy
-	ModelPriceConflictStrategy ConflictStrategy
-	APIKeyConflictStrategy     ConflictStrategy
+	IncludeProjects                         bool
+	IncludeChannels                         bool
+	IncludeModels                           bool
+	IncludeAPIKeys                          bool
+	IncludeModelPrices                      bool
+	IncludeUsageStats                       bool
+	IncludeRequestLogs                      bool
+	IncludeUsers                            bool
+	IncludeRoles                            bool
+	IncludePrompts                          bool
+	IncludePromptProtectionRules            bool
+	IncludeChannelOverrideTemplates         bool
+	IncludeAPIKeyProfileTemplates           bool
+	IncludeDataStorages                     bool
+	IncludeSystemSettings                   bool
+	ProjectConflictStrategy                 ConflictStrategy
+	ChannelConflictStrategy                 ConflictStrategy
+	ModelConflictStrategy                   ConflictStrategy
+	ModelPriceConflictStrategy              ConflictStrategy
+	APIKeyConflictStrategy                  ConflictStrategy
+	UserConflictStrategy                    ConflictStrategy
+	RoleConflictStrategy                    ConflictStrategy
+	PromptConflictStrategy                  ConflictStrategy
+	PromptProtectionRuleConflictStrategy    ConflictStrategy
+	ChannelOverrideTemplateConflictStrategy ConflictStrategy
+	APIKeyProfileTemplateConflictStrategy   ConflictStrategy
+	DataStorageConflictStrategy             ConflictStrategy
+	SystemSettingConflictStrategy           ConflictStrategy
+	// DryRun restores the backup in a transaction that is rolled back, to report what would change.
+	DryRun bool
+}
+
+// withDefaultConflictStrategies skips the conflicting entities of the types without a strategy.
+func (o RestoreOptions) withDefaultConflictStrategies() RestoreOptions {
+	for _, strategy := range []*ConflictStrategy{
+		&o.ProjectConflictStrategy,
+		&o.ChannelConflictStrategy,
+		&o.ModelConflictStrategy,
+		&o.ModelPriceConflictStrategy,
+		&o.APIKeyConflictStrategy,
+		&o.UserConflictStrategy,
+		&o.RoleConflictStrategy,
+		&o.PromptConflictStrategy,
+		&o.PromptProtectionRuleConflictStrategy,
+		&o.ChannelOverrideTemplateConflictStrategy,
+		&o.APIKeyProfileTemplateConflictStrategy,
+		&o.DataStorageConflictStrategy,
+		&o.SystemSettingConflictStrategy,
+	} {
+		if *strategy == "" {
+			*strategy = ConflictStrategySkip
+		}
+	}
+
+	return o
+}
+
+// RestoreReport reports the changes of a restore, per entity type in the order they are restored.
+type RestoreReport struct {
+	DryRun   bool
+	Entities []*RestoreEntityReport
+}
+
+// RestoreEntityReport counts the restored entities of one type.
+type RestoreEntityReport struct {
+	EntityType string
+	Created    int
+	Updated    int
+	Skipped    int
+}
+
+func (r *RestoreReport) entity(entityType string) *RestoreEntityReport {
+	for _, e := range r.Entities {
+		if e.EntityType == entityType {
+			return e
+		}
+	}
+
+	e := &RestoreEntityReport{EntityType: entityType}
+	r.Entities = append(r.Entities, e)
+
+	return e
+}
+
+func (r *RestoreReport) created(entityType string) {
+	r.entity(entityType).Created++
+}
+
+func (r *RestoreReport) updated(entityType string) {
+	r.entity(entityType).Updated++
+}
+
+func (r *RestoreReport) skipped(entityType string) {
+	r.entity(entityType).Skipped++
 }
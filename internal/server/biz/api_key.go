@@ -29,6 +29,7 @@ import (
 	"github.com/looplj/axonhub/internal/pkg/xcache"
 	"github.com/looplj/axonhub/internal/pkg/xcache/live"
 	"github.com/looplj/axonhub/internal/pkg/xerrors"
+	"github.com/looplj/axonhub/internal/pkg/xtime"
 	"github.com/looplj/axonhub/internal/scopes"
 )
 
@@ -568,36 +569,46 @@ func (s *APIKeyService) UpdateAPIKeyProfiles(ctx context.Context, id int, profil
 		}
 	}
 
+	if err := ValidateAPIKeyProfiles(profiles); err != nil {
+		return nil, err
+	}
+
+	apiKey, err := client.APIKey.UpdateOneID(id).
+		SetProfiles(&profiles).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update API key profiles: %w", err)
+	}
+
+	// Invalidate cache
+	s.invalidateAPIKeyCaches(ctx, apiKey.Key)
+
+	return apiKey, nil
+}
+
+// ValidateAPIKeyProfiles validates that profile names are unique, the active profile exists,
+// and the filters, quotas and policies of the profiles are valid.
+func ValidateAPIKeyProfiles(profiles objects.APIKeyProfiles) error {
 	// Validate that profile names are unique (case-insensitive)
 	if err := validateProfileNames(profiles.Profiles); err != nil {
-		return nil, err
+		return err
 	}
 
 	// Validate that active profile exists in the profiles list
 	if err := validateActiveProfile(profiles.ActiveProfile, profiles.Profiles); err != nil {
-		return nil, err
+		return err
 	}
 
 	if err := validateProfileFilters(profiles.Profiles); err != nil {
-		return nil, err
+		return err
 	}
 
 	// Validate quota configuration (if present)
 	if err := validateProfileQuota(profiles.Profiles); err != nil {
-		return nil, err
-	}
-
-	apiKey, err := client.APIKey.UpdateOneID(id).
-		SetProfiles(&profiles).
-		Save(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to update API key profiles: %w", err)
+		return err
 	}
 
-	// Invalidate cache
-	s.invalidateAPIKeyCaches(ctx, apiKey.Key)
-
-	return apiKey, nil
+	return validateProfilePolicy(profiles.Profiles)
 }
 
 // validateProfileNames checks that all profile names are unique (case-insensitive).
@@ -643,61 +654,105 @@ func validateProfileFilters(profiles []objects.APIKeyProfile) error {
 
 func validateProfileQuota(profiles []objects.APIKeyProfile) error {
 	for _, profile := range profiles {
-		if profile.Quota == nil {
-			continue
+		if err := validateQuota(profile.Name, "quota", profile.Quota); err != nil {
+			return err
+		}
+
+		if err := validateQuota(profile.Name, "endUserQuota", profile.EndUserQuota); err != nil {
+			return err
 		}
+	}
+
+	return nil
+}
+
+func validateQuota(profileName, field string, q *objects.APIKeyQuota) error {
+	if q == nil {
+		return nil
+	}
+
+	if q.Requests == nil && q.TotalTokens == nil && q.Cost == nil {
+		return fmt.Errorf("profile '%s' %s must set at least one limit", profileName, field)
+	}
+
+	if q.Requests != nil && *q.Requests <= 0 {
+		return fmt.Errorf("profile '%s' %s.requests must be positive", profileName, field)
+	}
+
+	if q.TotalTokens != nil && *q.TotalTokens <= 0 {
+		return fmt.Errorf("profile '%s' %s.totalTokens must be positive", profileName, field)
+	}
 
-		q := profile.Quota
-		if q.Requests == nil && q.TotalTokens == nil && q.Cost == nil {
-			return fmt.Errorf("profile '%s' quota must set at least one limit", profile.Name)
+	if q.Cost != nil && q.Cost.IsNegative() {
+		return fmt.Errorf("profile '%s' %s.cost must be non-negative", profileName, field)
+	}
+
+	switch q.Period.Type {
+	case objects.APIKeyQuotaPeriodTypeAllTime:
+	case objects.APIKeyQuotaPeriodTypePastDuration:
+		if q.Period.PastDuration == nil {
+			return fmt.Errorf("profile '%s' %s.period.pastDuration is required", profileName, field)
 		}
 
-		if q.Requests != nil && *q.Requests <= 0 {
-			return fmt.Errorf("profile '%s' quota.requests must be positive", profile.Name)
+		if q.Period.PastDuration.Value <= 0 {
+			return fmt.Errorf("profile '%s' %s.period.pastDuration.value must be positive", profileName, field)
 		}
 
-		if q.TotalTokens != nil && *q.TotalTokens <= 0 {
-			return fmt.Errorf("profile '%s' quota.totalTokens must be positive", profile.Name)
+		switch q.Period.PastDuration.Unit {
+		case objects.APIKeyQuotaPastDurationUnitMinute, objects.APIKeyQuotaPastDurationUnitHour, objects.APIKeyQuotaPastDurationUnitDay:
+		default:
+			return fmt.Errorf("profile '%s' %s.period.pastDuration.unit is invalid", profileName, field)
+		}
+	case objects.APIKeyQuotaPeriodTypeCalendarDuration:
+		if q.Period.CalendarDuration == nil {
+			return fmt.Errorf("profile '%s' %s.period.calendarDuration is required", profileName, field)
 		}
 
-		if q.Cost != nil && q.Cost.IsNegative() {
-			return fmt.Errorf("profile '%s' quota.cost must be non-negative", profile.Name)
+		switch q.Period.CalendarDuration.Unit {
+		case objects.APIKeyQuotaCalendarDurationUnitDay, objects.APIKeyQuotaCalendarDurationUnitMonth:
+		default:
+			return fmt.Errorf("profile '%s' %s.period.calendarDuration.unit is invalid", profileName, field)
 		}
+	default:
+		return fmt.Errorf("profile '%s' %s.period.type is invalid", profileName, field)
+	}
 
-		switch q.Period.Type {
-		case objects.APIKeyQuotaPeriodTypeAllTime:
-		case objects.APIKeyQuotaPeriodTypePastDuration:
-			if q.Period.PastDuration == nil {
-				return fmt.Errorf("profile '%s' quota.period.pastDuration is required", profile.Name)
-			}
+	return nil
+}
 
-			if q.Period.PastDuration.Value <= 0 {
-				return fmt.Errorf("profile '%s' quota.period.pastDuration.value must be positive", profile.Name)
+// validateProfilePolicy checks the endpoint families and request limits of the profiles.
+func validateProfilePolicy(profiles []objects.APIKeyProfile) error {
+	for _, profile := range profiles {
+		for _, endpoint := range profile.Endpoints {
+			if !endpoint.IsValid() {
+				return fmt.Errorf("profile '%s' endpoint '%s' is invalid", profile.Name, endpoint)
 			}
+		}
 
-			switch q.Period.PastDuration.Unit {
-			case objects.APIKeyQuotaPastDurationUnitMinute, objects.APIKeyQuotaPastDurationUnitHour, objects.APIKeyQuotaPastDurationUnitDay:
-			default:
-				return fmt.Errorf("profile '%s' quota.period.pastDuration.unit is invalid", profile.Name)
-			}
-		case objects.APIKeyQuotaPeriodTypeCalendarDuration:
-			if q.Period.CalendarDuration == nil {
-				return fmt.Errorf("profile '%s' quota.period.calendarDuration is required", profile.Name)
-			}
+		limits := profile.Limits
+		if limits == nil {
+			continue
+		}
+
+		if limits.MaxTokens != nil && *limits.MaxTokens <= 0 {
+			return fmt.Errorf("profile '%s' limits.maxTokens must be positive", profile.Name)
+		}
 
-			switch q.Period.CalendarDuration.Unit {
-			case objects.APIKeyQuotaCalendarDurationUnitDay, objects.APIKeyQuotaCalendarDurationUnitMonth:
-			default:
-				return fmt.Errorf("profile '%s' quota.period.calendarDuration.unit is invalid", profile.Name)
+		if limits.MaxImageSize != "" {
+			if _, _, err := objects.ParseImageSize(limits.MaxImageSize); err != nil {
+				return fmt.Errorf("profile '%s' limits.maxImageSize: %w", profile.Name, err)
 			}
-		default:
-			return fmt.Errorf("profile '%s' quota.period.type is invalid", profile.Name)
 		}
 	}
 
 	return nil
 }
 
+// ValidateAPIKeyAllowedIPs validates that the allowed IPs of an API key are IP addresses or CIDRs.
+func ValidateAPIKeyAllowedIPs(ips []string) error {
+	return validateAllowedIPs(ips)
+}
+
 func validateAllowedIPs(ips []string) error {
 	for _, ip := range ips {
 		ip = strings.TrimSpace(ip)
@@ -941,9 +996,12 @@ func (s *APIKeyService) RotateAPIKey(ctx context.Context, id int) (*ent.APIKey,
 
 	oldKey := existing.Key
 
-	// Update the key field directly using Ent
+	// Update the key field directly using Ent, the tokens minted with the old key are revoked with it.
+	revokedBefore := xtime.UTCNow().Truncate(time.Millisecond)
+
 	rotated, err := s.db.APIKey.UpdateOneID(id).
 		SetKey(newKey).
+		SetTokenRevocations(&objects.APIKeyTokenRevocations{RevokedBefore: &revokedBefore}).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to rotate API key: %w", err)
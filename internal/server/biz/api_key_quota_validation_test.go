@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 
 	"github.com/looplj/axonhub/internal/objects"
@@ -27,3 +28,57 @@ func TestValidateProfileQuota_PastDurationMinuteAccepted(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestValidateProfilePolicy(t *testing.T) {
+	err := validateProfilePolicy([]objects.APIKeyProfile{
+		{
+			Name:      "p",
+			Endpoints: []objects.APIKeyEndpoint{objects.APIKeyEndpointChat, objects.APIKeyEndpointEmbeddings},
+			Limits: &objects.APIKeyLimits{
+				MaxTokens:    lo.ToPtr(int64(1024)),
+				AllowedTools: []string{"function"},
+				MaxImageSize: "1024x1024",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	err = validateProfilePolicy([]objects.APIKeyProfile{
+		{Name: "p", Endpoints: []objects.APIKeyEndpoint{"fax"}},
+	})
+	require.ErrorContains(t, err, "endpoint 'fax' is invalid")
+
+	err = validateProfilePolicy([]objects.APIKeyProfile{
+		{Name: "p", Limits: &objects.APIKeyLimits{MaxTokens: lo.ToPtr(int64(0))}},
+	})
+	require.ErrorContains(t, err, "maxTokens must be positive")
+
+	err = validateProfilePolicy([]objects.APIKeyProfile{
+		{Name: "p", Limits: &objects.APIKeyLimits{MaxImageSize: "large"}},
+	})
+	require.ErrorContains(t, err, "maxImageSize")
+}
+
+func TestValidateProfileQuota_EndUserQuota(t *testing.T) {
+	err := validateProfileQuota([]objects.APIKeyProfile{
+		{
+			Name: "p",
+			EndUserQuota: &objects.APIKeyQuota{
+				Cost:   lo.ToPtr(decimal.RequireFromString("1")),
+				Period: objects.APIKeyQuotaPeriod{Type: objects.APIKeyQuotaPeriodTypeAllTime},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	err = validateProfileQuota([]objects.APIKeyProfile{
+		{
+			Name: "p",
+			EndUserQuota: &objects.APIKeyQuota{
+				Requests: lo.ToPtr(int64(0)),
+				Period:   objects.APIKeyQuotaPeriod{Type: objects.APIKeyQuotaPeriodTypeAllTime},
+			},
+		},
+	})
+	require.ErrorContains(t, err, "endUserQuota.requests must be positive")
+}
@@ -0,0 +1,298 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/shopspring/decimal"
+	"go.uber.org/fx"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xtime"
+)
+
+const (
+	// APIKeyTokenPrefix distinguishes short-lived API key tokens from API keys.
+	//nolint:gosec // Not a credential.
+	APIKeyTokenPrefix = "aht-"
+
+	// DefaultAPIKeyTokenTTL is the lifetime of a token minted without an explicit expiry.
+	DefaultAPIKeyTokenTTL = time.Hour
+	// MaxAPIKeyTokenTTL is the maximum lifetime of a token.
+	MaxAPIKeyTokenTTL = 24 * time.Hour
+
+	maxEndUserIDLength  = 256
+	apiKeyTokenAudience = "axonhub:api-key-token"
+)
+
+// IsAPIKeyToken reports whether the credential is a short-lived API key token.
+func IsAPIKeyToken(key string) bool {
+	return strings.HasPrefix(key, APIKeyTokenPrefix)
+}
+
+type APIKeyTokenServiceParams struct {
+	fx.In
+
+	Ent           *ent.Client
+	SystemService *SystemService
+	APIKeyService *APIKeyService
+}
+
+// APIKeyTokenService mints and verifies short-lived tokens for the end users of a service account API key.
+// Tokens are signed with the system secret key and are not stored, so they can only be revoked in bulk
+// by parent key or by end user.
+type APIKeyTokenService struct {
+	*AbstractService
+
+	SystemService *SystemService
+	APIKeyService *APIKeyService
+}
+
+func NewAPIKeyTokenService(params APIKeyTokenServiceParams) *APIKeyTokenService {
+	return &APIKeyTokenService{
+		AbstractService: &AbstractService{
+			db: params.Ent,
+		},
+		SystemService: params.SystemService,
+		APIKeyService: params.APIKeyService,
+	}
+}
+
+// MintAPIKeyTokenInput is the constraints embedded in a minted token.
+type MintAPIKeyTokenInput struct {
+	EndUserID string
+	Models    []string
+	Budget    *decimal.Decimal
+	// ExpiresIn defaults to DefaultAPIKeyTokenTTL when zero.
+	ExpiresIn time.Duration
+}
+
+type apiKeyTokenClaims struct {
+	jwt.RegisteredClaims
+
+	APIKeyID int              `json:"akid"`
+	Models   []string         `json:"models,omitempty"`
+	Budget   *decimal.Decimal `json:"budget,omitempty"`
+	// IssuedAtMs is the issue time in milliseconds, "iat" only has second precision,
+	// so a token minted right after a revocation would otherwise be revoked too.
+	IssuedAtMs int64 `json:"iat_ms"`
+}
+
+// MintToken mints a short-lived token for an end user of the service account API key.
+func (s *APIKeyTokenService) MintToken(ctx context.Context, apiKey *ent.APIKey, input MintAPIKeyTokenInput) (string, *objects.APIKeyToken, error) {
+	if apiKey.Type != apikey.TypeServiceAccount {
+		return "", nil, ErrServiceAccountRequired
+	}
+
+	endUserID := strings.TrimSpace(input.EndUserID)
+	if endUserID == "" {
+		return "", nil, fmt.Errorf("end user id is required")
+	}
+
+	if len(endUserID) > maxEndUserIDLength {
+		return "", nil, fmt.Errorf("end user id must be at most %d characters", maxEndUserIDLength)
+	}
+
+	ttl := input.ExpiresIn
+	if ttl == 0 {
+		ttl = DefaultAPIKeyTokenTTL
+	}
+
+	if ttl < time.Second || ttl > MaxAPIKeyTokenTTL {
+		return "", nil, fmt.Errorf("token expiry must be between 1s and %s", MaxAPIKeyTokenTTL)
+	}
+
+	if input.Budget != nil && !input.Budget.IsPositive() {
+		return "", nil, fmt.Errorf("token budget must be positive")
+	}
+
+	models := make([]string, 0, len(input.Models))
+
+	for _, model := range input.Models {
+		if model = strings.TrimSpace(model); model != "" {
+			models = append(models, model)
+		}
+	}
+
+	secretKey, err := authz.RunWithSystemBypass(ctx, "api-key-token-secret-key", func(bypassCtx context.Context) (string, error) {
+		return s.SystemService.SecretKey(bypassCtx)
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get secret key: %w", err)
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", nil, fmt.Errorf("failed to generate token id: %w", err)
+	}
+
+	now := xtime.UTCNow()
+	token := &objects.APIKeyToken{
+		ID:        hex.EncodeToString(id),
+		APIKeyID:  apiKey.ID,
+		EndUserID: endUserID,
+		Models:    models,
+		Budget:    input.Budget,
+		IssuedAt:  now.Truncate(time.Millisecond),
+		ExpiresAt: now.Add(ttl).Truncate(time.Second),
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, apiKeyTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        token.ID,
+			Subject:   token.EndUserID,
+			Audience:  jwt.ClaimStrings{apiKeyTokenAudience},
+			IssuedAt:  jwt.NewNumericDate(token.IssuedAt),
+			ExpiresAt: jwt.NewNumericDate(token.ExpiresAt),
+		},
+		APIKeyID:   token.APIKeyID,
+		Models:     token.Models,
+		Budget:     token.Budget,
+		IssuedAtMs: token.IssuedAt.UnixMilli(),
+	}).SignedString([]byte(secretKey))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return APIKeyTokenPrefix + signed, token, nil
+}
+
+// ParseToken verifies the signature and expiry of a token. It does not check the parent API key.
+func (s *APIKeyTokenService) ParseToken(ctx context.Context, tokenString string) (*objects.APIKeyToken, error) {
+	if !IsAPIKeyToken(tokenString) {
+		return nil, fmt.Errorf("%w: not an api key token", ErrInvalidAPIKey)
+	}
+
+	secretKey, err := authz.RunWithSystemBypass(ctx, "api-key-token-secret-key", func(bypassCtx context.Context) (string, error) {
+		return s.SystemService.SecretKey(bypassCtx)
+	})
+	if err != nil {
+		if errors.Is(err, ErrSystemNotInitialized) {
+			return nil, fmt.Errorf("%w: system not initialized", ErrInvalidAPIKey)
+		}
+
+		return nil, fmt.Errorf("failed to get secret key: %w", err)
+	}
+
+	var claims apiKeyTokenClaims
+
+	_, err = jwt.ParseWithClaims(strings.TrimPrefix(tokenString, APIKeyTokenPrefix), &claims,
+		func(*jwt.Token) (any, error) {
+			return []byte(secretKey), nil
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithAudience(apiKeyTokenAudience),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidAPIKey, err)
+	}
+
+	if claims.APIKeyID == 0 || claims.Subject == "" || claims.IssuedAtMs == 0 {
+		return nil, fmt.Errorf("%w: invalid token claims", ErrInvalidAPIKey)
+	}
+
+	return &objects.APIKeyToken{
+		ID:        claims.ID,
+		APIKeyID:  claims.APIKeyID,
+		EndUserID: claims.Subject,
+		Models:    claims.Models,
+		Budget:    claims.Budget,
+		IssuedAt:  time.UnixMilli(claims.IssuedAtMs).UTC(),
+		ExpiresAt: claims.ExpiresAt.UTC(),
+	}, nil
+}
+
+// getParentKey returns the key of the API key that minted a token.
+func (s *APIKeyTokenService) getParentKey(ctx context.Context, apiKeyID int) (string, error) {
+	client := s.entFromContext(ctx)
+
+	key, err := client.APIKey.Query().
+		Where(apikey.IDEQ(apiKeyID)).
+		Select(apikey.FieldKey).
+		String(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", fmt.Errorf("%w: parent api key not found", ErrInvalidAPIKey)
+		}
+
+		return "", err
+	}
+
+	return key, nil
+}
+
+// RevokeTokens revokes the tokens issued so far by the API key, for one end user or, if endUserID is empty, for all end users.
+func (s *APIKeyTokenService) RevokeTokens(ctx context.Context, apiKeyID int, endUserID string) (*ent.APIKey, error) {
+	client := s.entFromContext(ctx)
+
+	existing, err := client.APIKey.Get(ctx, apiKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	if existing.Type != apikey.TypeServiceAccount {
+		return nil, ErrServiceAccountRequired
+	}
+
+	now := xtime.UTCNow().Truncate(time.Millisecond)
+	revocations := pruneTokenRevocations(existing.TokenRevocations, now)
+
+	endUserID = strings.TrimSpace(endUserID)
+	if endUserID == "" {
+		revocations.RevokedBefore = &now
+		revocations.EndUsers = nil
+	} else {
+		if revocations.EndUsers == nil {
+			revocations.EndUsers = map[string]time.Time{}
+		}
+
+		revocations.EndUsers[endUserID] = now
+	}
+
+	apiKey, err := client.APIKey.UpdateOneID(apiKeyID).
+		SetTokenRevocations(revocations).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke API key tokens: %w", err)
+	}
+
+	s.APIKeyService.invalidateAPIKeyCaches(ctx, apiKey.Key)
+
+	return apiKey, nil
+}
+
+// pruneTokenRevocations drops the revocations older than the longest token lifetime, all tokens they cover have expired.
+func pruneTokenRevocations(existing *objects.APIKeyTokenRevocations, now time.Time) *objects.APIKeyTokenRevocations {
+	pruned := &objects.APIKeyTokenRevocations{}
+	if existing == nil {
+		return pruned
+	}
+
+	expiredBefore := now.Add(-MaxAPIKeyTokenTTL)
+
+	if existing.RevokedBefore != nil && existing.RevokedBefore.After(expiredBefore) {
+		revokedBefore := *existing.RevokedBefore
+		pruned.RevokedBefore = &revokedBefore
+	}
+
+	if len(existing.EndUsers) > 0 {
+		pruned.EndUsers = maps.Clone(existing.EndUsers)
+		maps.DeleteFunc(pruned.EndUsers, func(_ string, revokedAt time.Time) bool {
+			return !revokedAt.After(expiredBefore)
+		})
+	}
+
+	return pruned
+}
@@ -0,0 +1,218 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/project"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+)
+
+func setupTestAPIKeyToken(t *testing.T) (*AuthService, *ent.Client, context.Context, *ent.APIKey) {
+	t.Helper()
+
+	authService, client, cleanup := setupTestAuthService(t, xcache.Config{})
+	t.Cleanup(func() {
+		cleanup()
+		client.Close()
+	})
+
+	authService.APIKeyTokenService = NewAPIKeyTokenService(APIKeyTokenServiceParams{
+		Ent:           client,
+		SystemService: authService.SystemService,
+		APIKeyService: authService.APIKeyService,
+	})
+
+	ctx := ent.NewContext(context.Background(), client)
+	ctx = authz.WithTestBypass(ctx)
+
+	proj := client.Project.Create().SetName("token-project").SetStatus(project.StatusActive).SaveX(ctx)
+
+	key, err := GenerateAPIKey("ah")
+	require.NoError(t, err)
+
+	serviceKey := client.APIKey.Create().
+		SetKey(key).
+		SetName("service").
+		SetType(apikey.TypeServiceAccount).
+		SetProject(proj).
+		SaveX(ctx)
+
+	return authService, client, ctx, serviceKey
+}
+
+func TestAPIKeyToken_MintAndAuthenticate(t *testing.T) {
+	authService, _, ctx, serviceKey := setupTestAPIKeyToken(t)
+	tokens := authService.APIKeyTokenService
+
+	budget := decimal.RequireFromString("2.5")
+
+	tokenString, minted, err := tokens.MintToken(ctx, serviceKey, MintAPIKeyTokenInput{
+		EndUserID: " customer-1 ",
+		Models:    []string{"gpt-4o"},
+		Budget:    &budget,
+		ExpiresIn: 10 * time.Minute,
+	})
+	require.NoError(t, err)
+	require.True(t, IsAPIKeyToken(tokenString))
+	require.Equal(t, "customer-1", minted.EndUserID)
+
+	apiKey, token, err := authService.AuthenticateAPIKeyToken(ctx, tokenString)
+	require.NoError(t, err)
+	require.Equal(t, serviceKey.ID, apiKey.ID)
+	require.Equal(t, "customer-1", token.EndUserID)
+	require.Equal(t, []string{"gpt-4o"}, token.Models)
+	require.True(t, budget.Equal(*token.Budget))
+	require.Equal(t, minted.ExpiresAt, token.ExpiresAt)
+
+	// The signature covers the embedded constraints.
+	_, _, err = authService.AuthenticateAPIKeyToken(ctx, tokenString[:len(tokenString)-2]+"xx")
+	require.ErrorIs(t, err, ErrInvalidAPIKey)
+
+	// Disabling the parent key disables its tokens.
+	_, err = authService.APIKeyService.UpdateAPIKeyStatus(ctx, serviceKey.ID, apikey.StatusDisabled)
+	require.NoError(t, err)
+	authService.APIKeyService.APIKeyCache.Invalidate(buildAPIKeyCacheKey(serviceKey.Key))
+
+	_, _, err = authService.AuthenticateAPIKeyToken(ctx, tokenString)
+	require.ErrorIs(t, err, ErrInvalidAPIKey)
+}
+
+func TestAPIKeyToken_MintValidation(t *testing.T) {
+	authService, client, ctx, serviceKey := setupTestAPIKeyToken(t)
+	tokens := authService.APIKeyTokenService
+
+	_, _, err := tokens.MintToken(ctx, serviceKey, MintAPIKeyTokenInput{})
+	require.ErrorContains(t, err, "end user id is required")
+
+	_, _, err = tokens.MintToken(ctx, serviceKey, MintAPIKeyTokenInput{EndUserID: "u", ExpiresIn: 48 * time.Hour})
+	require.ErrorContains(t, err, "token expiry")
+
+	_, _, err = tokens.MintToken(ctx, serviceKey, MintAPIKeyTokenInput{EndUserID: "u", ExpiresIn: 500 * time.Millisecond})
+	require.ErrorContains(t, err, "token expiry")
+
+	zero := decimal.Zero
+	_, _, err = tokens.MintToken(ctx, serviceKey, MintAPIKeyTokenInput{EndUserID: "u", Budget: &zero})
+	require.ErrorContains(t, err, "budget must be positive")
+
+	key, err := GenerateAPIKey("ah")
+	require.NoError(t, err)
+
+	userKey := client.APIKey.Create().SetKey(key).SetName("user").SetProjectID(serviceKey.ProjectID).SaveX(ctx)
+
+	_, _, err = tokens.MintToken(ctx, userKey, MintAPIKeyTokenInput{EndUserID: "u"})
+	require.ErrorIs(t, err, ErrServiceAccountRequired)
+}
+
+func TestAPIKeyToken_Revoke(t *testing.T) {
+	authService, _, ctx, serviceKey := setupTestAPIKeyToken(t)
+	tokens := authService.APIKeyTokenService
+
+	mint := func(endUserID string) string {
+		tokenString, _, err := tokens.MintToken(ctx, serviceKey, MintAPIKeyTokenInput{EndUserID: endUserID})
+		require.NoError(t, err)
+
+		return tokenString
+	}
+
+	alice := mint("alice")
+	bob := mint("bob")
+
+	// Synchronously invalidate the cache for testing (async notification may not complete in time)
+	invalidate := func() {
+		authService.APIKeyService.APIKeyCache.Invalidate(buildAPIKeyCacheKey(serviceKey.Key))
+	}
+
+	_, err := tokens.RevokeTokens(ctx, serviceKey.ID, "alice")
+	require.NoError(t, err)
+	invalidate()
+
+	_, _, err = authService.AuthenticateAPIKeyToken(ctx, alice)
+	require.ErrorContains(t, err, "revoked")
+
+	_, _, err = authService.AuthenticateAPIKeyToken(ctx, bob)
+	require.NoError(t, err)
+
+	_, err = tokens.RevokeTokens(ctx, serviceKey.ID, "")
+	require.NoError(t, err)
+	invalidate()
+
+	_, _, err = authService.AuthenticateAPIKeyToken(ctx, bob)
+	require.ErrorContains(t, err, "revoked")
+
+	// Tokens minted after the revocation are valid.
+	time.Sleep(2 * time.Millisecond)
+
+	_, _, err = authService.AuthenticateAPIKeyToken(ctx, mint("bob"))
+	require.NoError(t, err)
+}
+
+func TestPruneTokenRevocations(t *testing.T) {
+	now := time.Now().UTC()
+	old := now.Add(-MaxAPIKeyTokenTTL - time.Minute)
+	recent := now.Add(-time.Minute)
+
+	pruned := pruneTokenRevocations(&objects.APIKeyTokenRevocations{
+		RevokedBefore: &old,
+		EndUsers:      map[string]time.Time{"old": old, "recent": recent},
+	}, now)
+
+	require.Nil(t, pruned.RevokedBefore)
+	require.Equal(t, map[string]time.Time{"recent": recent}, pruned.EndUsers)
+}
+
+func TestQuotaService_CheckAPIKeyTokenBudget(t *testing.T) {
+	_, client, ctx, serviceKey := setupTestAPIKeyToken(t)
+
+	issuedAt := time.Now().UTC().Add(-time.Minute)
+
+	createUsage := func(endUserID string, cost float64, createdAt time.Time) {
+		req := client.Request.Create().
+			SetProjectID(serviceKey.ProjectID).
+			SetAPIKeyID(serviceKey.ID).
+			SetEndUserID(endUserID).
+			SetModelID("m").
+			SetStatus(request.StatusCompleted).
+			SetRequestBody(objects.JSONRawMessage([]byte(`{}`))).
+			SaveX(ctx)
+
+		client.UsageLog.Create().
+			SetRequestID(req.ID).
+			SetAPIKeyID(serviceKey.ID).
+			SetEndUserID(endUserID).
+			SetProjectID(serviceKey.ProjectID).
+			SetModelID("m").
+			SetTotalCost(cost).
+			SetCreatedAt(createdAt).
+			SaveX(ctx)
+	}
+
+	// Usage before the token was issued and usage of other end users is not counted.
+	createUsage("alice", 5, issuedAt.Add(-time.Hour))
+	createUsage("bob", 5, issuedAt.Add(time.Second))
+	createUsage("alice", 0.6, issuedAt.Add(time.Second))
+
+	svc := NewQuotaService(client, nil)
+	budget := decimal.RequireFromString("1")
+	token := &objects.APIKeyToken{APIKeyID: serviceKey.ID, EndUserID: "alice", Budget: &budget, IssuedAt: issuedAt}
+
+	result, err := svc.CheckAPIKeyTokenBudget(ctx, token)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+
+	createUsage("alice", 0.4, issuedAt.Add(2*time.Second))
+
+	result, err = svc.CheckAPIKeyTokenBudget(ctx, token)
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+	require.Contains(t, result.Message, "token budget exceeded")
+}
@@ -0,0 +1,417 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/apikeyprofiletemplate"
+	"github.com/looplj/axonhub/internal/ent/auditlog"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/channelmodelprice"
+	"github.com/looplj/axonhub/internal/ent/channeloverridetemplate"
+	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/model"
+	"github.com/looplj/axonhub/internal/ent/project"
+	"github.com/looplj/axonhub/internal/ent/prompt"
+	"github.com/looplj/axonhub/internal/ent/promptprotectionrule"
+	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/system"
+	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/userproject"
+	"github.com/looplj/axonhub/internal/ent/userrole"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+const auditRedacted = "[REDACTED]"
+
+// auditedEntityTables lists the configuration entities recorded in the audit log and their tables.
+// High volume entities like requests and usage logs are intentionally not audited.
+var auditedEntityTables = map[string]string{
+	ent.TypeAPIKey:                  apikey.Table,
+	ent.TypeAPIKeyProfileTemplate:   apikeyprofiletemplate.Table,
+	ent.TypeChannel:                 channel.Table,
+	ent.TypeChannelModelPrice:       channelmodelprice.Table,
+	ent.TypeChannelOverrideTemplate: channeloverridetemplate.Table,
+	ent.TypeDataStorage:             datastorage.Table,
+	ent.TypeModel:                   model.Table,
+	ent.TypeProject:                 project.Table,
+	ent.TypePrompt:                  prompt.Table,
+	ent.TypePromptProtectionRule:    promptprotectionrule.Table,
+	ent.TypeRole:                    role.Table,
+	ent.TypeSystem:                  system.Table,
+	ent.TypeUser:                    user.Table,
+	ent.TypeUserProject:             userproject.Table,
+	ent.TypeUserRole:                userrole.Table,
+}
+
+// auditSensitiveFields lists the fields holding secrets whose name does not tell it.
+var auditSensitiveFields = map[string][]string{
+	ent.TypeAPIKey: {apikey.FieldKey},
+}
+
+// auditIgnoredFields are not recorded in the changes.
+var auditIgnoredFields = []string{"id", "created_at", "updated_at"}
+
+type auditedMutationKey struct{}
+
+type auditableMutation interface {
+	ent.Mutation
+	ID() (int, bool)
+	IDs(ctx context.Context) ([]int, error)
+	Client() *ent.Client
+}
+
+type AuditLogService struct {
+	*AbstractService
+}
+
+func NewAuditLogService(client *ent.Client) *AuditLogService {
+	return &AuditLogService{
+		AbstractService: &AbstractService{
+			db: client,
+		},
+	}
+}
+
+// Hook records the mutations of audited entities made while an admin operation is in progress.
+// The audit log is written with the client of the mutation, so it is part of the same transaction.
+func (s *AuditLogService) Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			info, ok := contexts.GetAuditInfo(ctx)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			table, ok := auditedEntityTables[m.Type()]
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			// The soft delete hook runs the same mutation again as an update.
+			if current, _ := ctx.Value(auditedMutationKey{}).(ent.Mutation); current == m {
+				return next.Mutate(ctx, m)
+			}
+
+			mx, ok := m.(auditableMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			ctx = context.WithValue(ctx, auditedMutationKey{}, m)
+			client := mx.Client()
+			// The soft delete hook changes the operation of the mutation, keep the original one.
+			op := m.Op()
+
+			var (
+				ids    []int
+				before = make(map[int]map[string]any)
+			)
+
+			if !op.Is(ent.OpCreate) {
+				var err error
+
+				ids, err = mx.IDs(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to load audited entity ids: %w", err)
+				}
+
+				for _, id := range ids {
+					before[id], err = auditSnapshot(ctx, client, table, id)
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			value, err := next.Mutate(ctx, m)
+			if err != nil {
+				return value, err
+			}
+
+			if op.Is(ent.OpCreate) {
+				if id, ok := mx.ID(); ok {
+					ids = []int{id}
+				}
+			}
+
+			for _, id := range ids {
+				var after map[string]any
+				if !op.Is(ent.OpDelete | ent.OpDeleteOne) {
+					after, err = auditSnapshot(ctx, client, table, id)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				changes := auditChanges(m, op, before[id], after)
+				if op.Is(ent.OpUpdate|ent.OpUpdateOne) && len(changes) == 0 {
+					continue
+				}
+
+				if err := s.create(ctx, client, info, auditAction(op), m.Type(), &id, auditProjectID(ctx, m.Type(), id, before[id], after), changes); err != nil {
+					return nil, err
+				}
+			}
+
+			return value, nil
+		})
+	}
+}
+
+// RecordOperation records an admin operation which did not change any audited entity, e.g. triggering a backup.
+func (s *AuditLogService) RecordOperation(ctx context.Context) error {
+	info, ok := contexts.GetAuditInfo(ctx)
+	if !ok {
+		return nil
+	}
+
+	var projectID *int
+	if id, ok := contexts.GetProjectID(ctx); ok {
+		projectID = &id
+	}
+
+	return s.create(ctx, s.entFromContext(ctx), info, auditlog.ActionExecute, "", nil, projectID, nil)
+}
+
+func (s *AuditLogService) create(
+	ctx context.Context,
+	client *ent.Client,
+	info *contexts.AuditInfo,
+	action auditlog.Action,
+	entityType string,
+	entityID *int,
+	projectID *int,
+	changes []objects.AuditLogChange,
+) error {
+	mut := client.AuditLog.Create().
+		SetSource(auditlog.Source(info.Source)).
+		SetOperation(info.Operation).
+		SetAction(action).
+		SetEntityType(entityType).
+		SetNillableEntityID(entityID).
+		SetNillableProjectID(projectID).
+		SetChanges(changes)
+
+	if ip, ok := contexts.GetClientIP(ctx); ok {
+		mut.SetSourceIP(ip)
+	}
+
+	if u, ok := contexts.GetUser(ctx); ok {
+		mut.SetActorType(auditlog.ActorTypeUser).SetUserID(u.ID).SetActor(u.Email)
+	} else if key, ok := contexts.GetAPIKey(ctx); ok {
+		mut.SetActorType(auditlog.ActorTypeAPIKey).SetAPIKeyID(key.ID).SetActor(key.Name)
+	} else {
+		mut.SetActorType(auditlog.ActorTypeSystem).SetActor("system")
+	}
+
+	err := authz.RunWithSystemBypassVoid(ctx, "write-audit-log", func(ctx context.Context) error {
+		return mut.Exec(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+
+	info.MarkRecorded()
+
+	return nil
+}
+
+func auditAction(op ent.Op) auditlog.Action {
+	switch {
+	case op.Is(ent.OpCreate):
+		return auditlog.ActionCreate
+	case op.Is(ent.OpDelete | ent.OpDeleteOne):
+		return auditlog.ActionDelete
+	default:
+		return auditlog.ActionUpdate
+	}
+}
+
+// auditSnapshot loads the audited entity as a JSON map, an error fails the write so that no entry is recorded with a missing state.
+func auditSnapshot(ctx context.Context, client *ent.Client, table string, id int) (map[string]any, error) {
+	node, err := client.Noder(ctx, id, ent.WithFixedNodeType(table))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load audited %s %d: %w", table, id, err)
+	}
+
+	data, err := json.Marshal(node)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal audited %s %d: %w", table, id, err)
+	}
+
+	var snapshot map[string]any
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal audited %s %d: %w", table, id, err)
+	}
+
+	return snapshot, nil
+}
+
+func auditProjectID(ctx context.Context, entityType string, id int, before, after map[string]any) *int {
+	if entityType == ent.TypeProject {
+		return &id
+	}
+
+	for _, snapshot := range []map[string]any{after, before} {
+		if projectID, ok := snapshot["project_id"].(float64); ok && projectID > 0 {
+			return lo.ToPtr(int(projectID))
+		}
+	}
+
+	if projectID, ok := contexts.GetProjectID(ctx); ok {
+		return &projectID
+	}
+
+	return nil
+}
+
+// auditChanges builds the redacted field changes of the mutation from the snapshots before and after it.
+func auditChanges(m ent.Mutation, op ent.Op, before, after map[string]any) []objects.AuditLogChange {
+	var fields []string
+
+	switch {
+	case op.Is(ent.OpCreate):
+		fields = append(fields, m.Fields()...)
+		for field := range after {
+			fields = append(fields, field)
+		}
+	case op.Is(ent.OpDelete | ent.OpDeleteOne):
+		for field := range before {
+			fields = append(fields, field)
+		}
+	default:
+		fields = append(fields, m.Fields()...)
+		fields = append(fields, m.AddedFields()...)
+		fields = append(fields, m.ClearedFields()...)
+	}
+
+	slices.Sort(fields)
+	fields = slices.Compact(fields)
+
+	changes := make([]objects.AuditLogChange, 0, len(fields))
+
+	for _, field := range fields {
+		if slices.Contains(auditIgnoredFields, field) {
+			continue
+		}
+
+		var beforeValue, afterValue any
+
+		if isSensitiveAuditField(m.Type(), field) {
+			if before != nil {
+				beforeValue = auditRedacted
+			}
+
+			if after != nil {
+				afterValue = auditRedacted
+			}
+		} else {
+			beforeValue = redactAuditValue(before[field])
+			afterValue = redactAuditValue(after[field])
+
+			if reflect.DeepEqual(beforeValue, afterValue) {
+				continue
+			}
+		}
+
+		changes = append(changes, objects.AuditLogChange{
+			Field:  field,
+			Before: marshalAuditValue(beforeValue),
+			After:  marshalAuditValue(afterValue),
+		})
+	}
+
+	return changes
+}
+
+func marshalAuditValue(value any) objects.JSONRawMessage {
+	if value == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+func isSensitiveAuditField(entityType, field string) bool {
+	return slices.Contains(auditSensitiveFields[entityType], field) || isSensitiveAuditKey(field)
+}
+
+// isSensitiveAuditKey reports whether a field or JSON key holds a secret, e.g. password, secretKey or credentials.
+func isSensitiveAuditKey(key string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	if strings.HasSuffix(normalized, "token") || strings.HasSuffix(normalized, "tokenhash") {
+		return true
+	}
+
+	for _, word := range []string{"password", "passphrase", "secret", "credential", "privatekey", "accesskey", "accountkey", "apikey", "authorization"} {
+		if strings.Contains(normalized, word) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redactAuditValue replaces the secrets nested in JSON values, including JSON encoded strings like system settings.
+func redactAuditValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		redacted := make(map[string]any, len(v))
+
+		// Header entries hold the secret in the value, e.g. {"key": "Authorization", "value": "Bearer ..."}.
+		headerName, _ := v["key"].(string)
+		sensitiveHeader := headerName != "" && isSensitiveAuditKey(headerName)
+
+		for key, item := range v {
+			if isSensitiveAuditKey(key) || (sensitiveHeader && key == "value") {
+				redacted[key] = auditRedacted
+			} else {
+				redacted[key] = redactAuditValue(item)
+			}
+		}
+
+		return redacted
+	case []any:
+		redacted := make([]any, len(v))
+		for i, item := range v {
+			redacted[i] = redactAuditValue(item)
+		}
+
+		return redacted
+	case string:
+		trimmed := strings.TrimSpace(v)
+		if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+			return v
+		}
+
+		var decoded any
+		if err := json.Unmarshal([]byte(trimmed), &decoded); err != nil {
+			return v
+		}
+
+		data, err := json.Marshal(redactAuditValue(decoded))
+		if err != nil {
+			return v
+		}
+
+		return string(data)
+	default:
+		return value
+	}
+}
@@ -0,0 +1,201 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/auditlog"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+func TestAuditLogService_Hook(t *testing.T) {
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := NewAuditLogService(client)
+	client.Use(svc.Hook())
+
+	ctx := context.Background()
+	ctx = ent.NewContext(ctx, client)
+	ctx = authz.WithTestBypass(ctx)
+
+	// Mutations outside of an admin operation are not audited.
+	ch, err := client.Channel.Create().
+		SetType(channel.TypeOpenai).
+		SetName("Internal").
+		SetBaseURL("https://api.openai.com/v1").
+		SetCredentials(objects.ChannelCredentials{APIKeys: []string{"sk-internal"}}).
+		SetSupportedModels([]string{"gpt-4"}).
+		SetDefaultTestModel("gpt-4").
+		Save(ctx)
+	require.NoError(t, err)
+	require.Zero(t, client.AuditLog.Query().CountX(ctx))
+
+	ctx = contexts.WithUser(ctx, &ent.User{ID: 7, Email: "admin@example.com"})
+	ctx = contexts.WithClientIP(ctx, "10.0.0.1")
+
+	info := &contexts.AuditInfo{Source: string(auditlog.SourceGraphql), Operation: "updateChannel"}
+	ctx = contexts.WithAuditInfo(ctx, info)
+
+	_, err = client.Channel.UpdateOneID(ch.ID).
+		SetName("Renamed").
+		SetCredentials(objects.ChannelCredentials{APIKeys: []string{"sk-new"}}).
+		Save(ctx)
+	require.NoError(t, err)
+	require.True(t, info.Recorded())
+
+	entry := client.AuditLog.Query().OnlyX(ctx)
+	require.Equal(t, auditlog.ActorTypeUser, entry.ActorType)
+	require.Equal(t, 7, *entry.UserID)
+	require.Equal(t, "admin@example.com", entry.Actor)
+	require.Equal(t, auditlog.SourceGraphql, entry.Source)
+	require.Equal(t, "updateChannel", entry.Operation)
+	require.Equal(t, auditlog.ActionUpdate, entry.Action)
+	require.Equal(t, ent.TypeChannel, entry.EntityType)
+	require.Equal(t, ch.ID, *entry.EntityID)
+	require.Equal(t, "10.0.0.1", entry.SourceIP)
+
+	changes := make(map[string]objects.AuditLogChange)
+	for _, change := range entry.Changes {
+		changes[change.Field] = change
+	}
+
+	require.Len(t, changes, 2)
+	require.JSONEq(t, `"Internal"`, string(changes["name"].Before))
+	require.JSONEq(t, `"Renamed"`, string(changes["name"].After))
+	require.JSONEq(t, `"[REDACTED]"`, string(changes["credentials"].Before))
+	require.JSONEq(t, `"[REDACTED]"`, string(changes["credentials"].After))
+
+	// Deleting a soft deleted entity is recorded once, as a delete.
+	ctx = contexts.WithAuditInfo(ctx, &contexts.AuditInfo{Source: string(auditlog.SourceGraphql), Operation: "deleteChannel"})
+	require.NoError(t, client.Channel.DeleteOneID(ch.ID).Exec(ctx))
+
+	entries := client.AuditLog.Query().Where(auditlog.OperationEQ("deleteChannel")).AllX(ctx)
+	require.Len(t, entries, 1)
+	require.Equal(t, auditlog.ActionDelete, entries[0].Action)
+	require.NotEmpty(t, entries[0].Changes)
+}
+
+func TestAuditLogService_RecordOperation(t *testing.T) {
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := NewAuditLogService(client)
+
+	ctx := context.Background()
+	ctx = ent.NewContext(ctx, client)
+	ctx = authz.WithTestBypass(ctx)
+
+	// Nothing is recorded without an admin operation.
+	require.NoError(t, svc.RecordOperation(ctx))
+	require.Zero(t, client.AuditLog.Query().CountX(ctx))
+
+	ctx = contexts.WithAPIKey(ctx, &ent.APIKey{ID: 3, Name: "ci"})
+	ctx = contexts.WithProjectID(ctx, 5)
+	ctx = contexts.WithAuditInfo(ctx, &contexts.AuditInfo{Source: string(auditlog.SourceRest), Operation: "POST /admin/invitations"})
+	require.NoError(t, svc.RecordOperation(ctx))
+
+	entry := client.AuditLog.Query().OnlyX(ctx)
+	require.Equal(t, auditlog.ActorTypeAPIKey, entry.ActorType)
+	require.Equal(t, 3, *entry.APIKeyID)
+	require.Equal(t, 5, *entry.ProjectID)
+	require.Equal(t, auditlog.ActionExecute, entry.Action)
+	require.Empty(t, entry.EntityType)
+	require.Nil(t, entry.EntityID)
+}
+
+func TestAuditLogService_Hook_RedactsDataStorageSecrets(t *testing.T) {
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	svc := NewAuditLogService(client)
+	client.Use(svc.Hook())
+
+	ctx := context.Background()
+	ctx = ent.NewContext(ctx, client)
+	ctx = authz.WithTestBypass(ctx)
+	ctx = contexts.WithUser(ctx, &ent.User{ID: 7, Email: "admin@example.com"})
+	ctx = contexts.WithAuditInfo(ctx, &contexts.AuditInfo{Source: string(auditlog.SourceGraphql), Operation: "createDataStorage"})
+
+	for _, settings := range []*objects.DataStorageSettings{
+		{AzureBlob: &objects.AzureBlob{
+			AccountName:   "account",
+			ContainerName: "axonhub",
+			AuthType:      objects.AzureBlobAuthSharedKey,
+			AccountKey:    "azure-account-key",
+			SASToken:      "azure-sas-token",
+		}},
+		{SFTP: &objects.SFTP{
+			Host:                 "sftp.example.com",
+			Username:             "axonhub",
+			Password:             "sftp-password",
+			PrivateKey:           "sftp-private-key",
+			PrivateKeyPassphrase: "sftp-passphrase",
+		}},
+	} {
+		typ := datastorage.TypeAzblob
+		if settings.SFTP != nil {
+			typ = datastorage.TypeSftp
+		}
+
+		_, err := client.DataStorage.Create().
+			SetName(string(typ)).
+			SetDescription(string(typ)).
+			SetType(typ).
+			SetSettings(settings).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	entries := client.AuditLog.Query().AllX(ctx)
+	require.Len(t, entries, 2)
+
+	data, err := json.Marshal([][]objects.AuditLogChange{entries[0].Changes, entries[1].Changes})
+	require.NoError(t, err)
+	require.Contains(t, string(data), "sftp.example.com")
+
+	for _, secret := range []string{"azure-account-key", "azure-sas-token", "sftp-password", "sftp-private-key", "sftp-passphrase"} {
+		require.NotContains(t, string(data), secret)
+	}
+
+	require.True(t, isSensitiveAuditKey("accountKey"))
+	require.True(t, isSensitiveAuditKey("passphrase"))
+}
+
+func TestRedactAuditValue(t *testing.T) {
+	value := map[string]any{
+		"name":      "s3",
+		"secretKey": "very-secret",
+		"headers": []any{
+			map[string]any{"key": "Authorization", "value": "Bearer abc"},
+			map[string]any{"key": "X-Trace", "value": "1"},
+		},
+		"settings": `{"password":"p","host":"db"}`,
+	}
+
+	data, err := json.Marshal(redactAuditValue(value))
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"name": "s3",
+		"secretKey": "[REDACTED]",
+		"headers": [
+			{"key": "Authorization", "value": "[REDACTED]"},
+			{"key": "X-Trace", "value": "1"}
+		],
+		"settings": "{\"host\":\"db\",\"password\":\"[REDACTED]\"}"
+	}`, string(data))
+
+	require.True(t, isSensitiveAuditField(ent.TypeAPIKey, "key"))
+	require.False(t, isSensitiveAuditField(ent.TypeSystem, "key"))
+	require.True(t, isSensitiveAuditKey("refresh_token"))
+	require.False(t, isSensitiveAuditKey("max_tokens"))
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent/project"
 	"github.com/looplj/axonhub/internal/ent/user"
 	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
 )
 
 const OIDC_ONLY_PLACEHOLDER = "!OIDC_SSO_ONLY!"
@@ -59,6 +60,8 @@ type AuthServiceParams struct {
 	OIDCService   *OIDCService
 	Ent           *ent.Client
 	AllowNoAuth   bool `name:"allow_no_auth"`
+
+	APIKeyTokenService *APIKeyTokenService
 }
 
 func NewAuthService(params AuthServiceParams) *AuthService {
@@ -71,6 +74,8 @@ func NewAuthService(params AuthServiceParams) *AuthService {
 		UserService:   params.UserService,
 		OIDCService:   params.OIDCService,
 		AllowNoAuth:   params.AllowNoAuth,
+
+		APIKeyTokenService: params.APIKeyTokenService,
 	}
 }
 
@@ -82,6 +87,8 @@ type AuthService struct {
 	UserService   *UserService
 	OIDCService   *OIDCService
 	AllowNoAuth   bool
+
+	APIKeyTokenService *APIKeyTokenService
 }
 
 // GenerateSecretKey generates a random secret key for JWT.
@@ -231,6 +238,40 @@ func (s *AuthService) AuthenticateAPIKey(ctx context.Context, key string) (*ent.
 	return apiKey, nil
 }
 
+// AuthenticateAPIKeyToken validates a short-lived API key token and returns the parent API key and the token.
+func (s *AuthService) AuthenticateAPIKeyToken(ctx context.Context, tokenString string) (*ent.APIKey, *objects.APIKeyToken, error) {
+	if s.APIKeyTokenService == nil {
+		return nil, nil, fmt.Errorf("%w: api key tokens are not supported", ErrInvalidAPIKey)
+	}
+
+	token, err := s.APIKeyTokenService.ParseToken(ctx, tokenString)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, err := authz.RunWithSystemBypass(ctx, "auth-lookup", func(bypassCtx context.Context) (string, error) {
+		return s.APIKeyTokenService.getParentKey(bypassCtx, token.APIKeyID)
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get parent api key: %w", err)
+	}
+
+	apiKey, err := s.AuthenticateAPIKey(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if apiKey.Type != apikey.TypeServiceAccount {
+		return nil, nil, fmt.Errorf("parent api key is not a service account: %w", ErrInvalidAPIKey)
+	}
+
+	if apiKey.TokenRevocations.IsRevoked(token.EndUserID, token.IssuedAt) {
+		return nil, nil, fmt.Errorf("api key token revoked: %w", ErrInvalidAPIKey)
+	}
+
+	return apiKey, token, nil
+}
+
 func (s *AuthService) AuthenticateNoAuth(ctx context.Context) (*ent.APIKey, error) {
 	if !s.AllowNoAuth {
 		return nil, fmt.Errorf("%w: API key required", ErrInvalidAPIKey)
@@ -0,0 +1,466 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+)
+
+// azureBlobFs adapts an Azure Blob container to afero.Fs for the callers that
+// need a filesystem: the backup listing and retention, and the content download.
+// Byte Save/Load/Delete go through the native azureBlobObjectStore instead.
+//
+// Blob storage has no directories: Mkdir is a no-op, and a path is a directory
+// when blobs exist under its prefix. Files are opened either for reading or for
+// writing, a write streams to the blob and is committed on Close.
+type azureBlobFs struct {
+	store *azureBlobObjectStore
+}
+
+var _ afero.Fs = (*azureBlobFs)(nil)
+
+func newAzureBlobFs(store *azureBlobObjectStore) *azureBlobFs {
+	return &azureBlobFs{store: store}
+}
+
+// azureBlobKey maps a filesystem path to a blob name, "" is the container root.
+func azureBlobKey(name string) string {
+	key := strings.Trim(path.Clean("/"+strings.ReplaceAll(name, "\\", "/")), "/")
+	if key == "." {
+		return ""
+	}
+
+	return key
+}
+
+func (f *azureBlobFs) Name() string { return "azureblob" }
+
+func (f *azureBlobFs) Create(name string) (afero.File, error) {
+	return f.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+}
+
+func (f *azureBlobFs) Mkdir(string, os.FileMode) error { return nil }
+
+func (f *azureBlobFs) MkdirAll(string, os.FileMode) error { return nil }
+
+func (f *azureBlobFs) Open(name string) (afero.File, error) {
+	return f.OpenFile(name, os.O_RDONLY, 0)
+}
+
+func (f *azureBlobFs) OpenFile(name string, flag int, _ os.FileMode) (afero.File, error) {
+	key := azureBlobKey(name)
+
+	if flag&(os.O_WRONLY|os.O_RDWR) == 0 {
+		info, err := f.Stat(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return &azureBlobReadFile{fs: f, name: name, key: key, info: info.(*azureBlobFileInfo)}, nil
+	}
+
+	if key == "" || flag&os.O_APPEND != 0 {
+		return nil, &os.PathError{Op: "open", Path: name, Err: errors.ErrUnsupported}
+	}
+
+	pr, pw := io.Pipe()
+	file := &azureBlobWriteFile{name: name, pw: pw, done: make(chan error, 1)}
+
+	go func() {
+		n, err := f.store.PutObjectStream(context.Background(), key, pr, -1)
+		file.size = n
+		_ = pr.CloseWithError(err)
+		file.done <- err
+	}()
+
+	return file, nil
+}
+
+func (f *azureBlobFs) Remove(name string) error {
+	key := azureBlobKey(name)
+	if key == "" {
+		return &os.PathError{Op: "remove", Path: name, Err: errors.ErrUnsupported}
+	}
+
+	_, err := f.store.client.NewBlobClient(key).Delete(context.Background(), nil)
+	if err != nil {
+		if isAzureBlobNotFound(err) {
+			return &os.PathError{Op: "remove", Path: name, Err: os.ErrNotExist}
+		}
+
+		return &os.PathError{Op: "remove", Path: name, Err: err}
+	}
+
+	return nil
+}
+
+func (f *azureBlobFs) RemoveAll(name string) error {
+	ctx := context.Background()
+	key := azureBlobKey(name)
+
+	if key != "" {
+		if err := f.store.DeleteObject(ctx, key); err != nil {
+			return err
+		}
+
+		key += "/"
+	}
+
+	pager := f.store.client.NewListBlobsFlatPager(&container.ListBlobsFlatOptions{Prefix: &key})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("azure blob list %q: %w", key, err)
+		}
+
+		for _, item := range page.Segment.BlobItems {
+			if err := f.store.DeleteObject(ctx, *item.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (f *azureBlobFs) Rename(oldname, _ string) error {
+	return &os.PathError{Op: "rename", Path: oldname, Err: errors.ErrUnsupported}
+}
+
+func (f *azureBlobFs) Stat(name string) (os.FileInfo, error) {
+	ctx := context.Background()
+	key := azureBlobKey(name)
+
+	if key == "" {
+		return &azureBlobFileInfo{name: "/", dir: true}, nil
+	}
+
+	props, err := f.store.client.NewBlobClient(key).GetProperties(ctx, nil)
+	if err == nil {
+		info := &azureBlobFileInfo{name: path.Base(key)}
+		if props.ContentLength != nil {
+			info.size = *props.ContentLength
+		}
+
+		if props.LastModified != nil {
+			info.modTime = *props.LastModified
+		}
+
+		return info, nil
+	}
+
+	if !isAzureBlobNotFound(err) {
+		return nil, &os.PathError{Op: "stat", Path: name, Err: err}
+	}
+
+	// A path without a blob is a directory when blobs exist under its prefix.
+	prefix := key + "/"
+
+	page, err := f.store.client.NewListBlobsFlatPager(&container.ListBlobsFlatOptions{
+		Prefix:     &prefix,
+		MaxResults: lo.ToPtr(int32(1)),
+	}).NextPage(ctx)
+	if err != nil {
+		return nil, &os.PathError{Op: "stat", Path: name, Err: err}
+	}
+
+	if len(page.Segment.BlobItems) == 0 {
+		return nil, &os.PathError{Op: "stat", Path: name, Err: os.ErrNotExist}
+	}
+
+	return &azureBlobFileInfo{name: path.Base(key), dir: true}, nil
+}
+
+func (f *azureBlobFs) Chmod(string, os.FileMode) error { return nil }
+
+func (f *azureBlobFs) Chown(string, int, int) error { return nil }
+
+func (f *azureBlobFs) Chtimes(string, time.Time, time.Time) error { return nil }
+
+// readDir lists the blobs and the prefixes directly under the directory.
+func (f *azureBlobFs) readDir(key string) ([]os.FileInfo, error) {
+	ctx := context.Background()
+
+	prefix := ""
+	if key != "" {
+		prefix = key + "/"
+	}
+
+	var infos []os.FileInfo
+
+	pager := f.store.client.NewListBlobsHierarchyPager("/", &container.ListBlobsHierarchyOptions{Prefix: &prefix})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("azure blob list %q: %w", prefix, err)
+		}
+
+		for _, p := range page.Segment.BlobPrefixes {
+			infos = append(infos, &azureBlobFileInfo{name: path.Base(strings.TrimSuffix(*p.Name, "/")), dir: true})
+		}
+
+		for _, item := range page.Segment.BlobItems {
+			info := &azureBlobFileInfo{name: path.Base(*item.Name)}
+			if item.Properties != nil {
+				if item.Properties.ContentLength != nil {
+					info.size = *item.Properties.ContentLength
+				}
+
+				if item.Properties.LastModified != nil {
+					info.modTime = *item.Properties.LastModified
+				}
+			}
+
+			infos = append(infos, info)
+		}
+	}
+
+	return infos, nil
+}
+
+type azureBlobFileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+	dir     bool
+}
+
+func (i *azureBlobFileInfo) Name() string       { return i.name }
+func (i *azureBlobFileInfo) Size() int64        { return i.size }
+func (i *azureBlobFileInfo) ModTime() time.Time { return i.modTime }
+func (i *azureBlobFileInfo) IsDir() bool        { return i.dir }
+func (i *azureBlobFileInfo) Sys() any           { return nil }
+
+func (i *azureBlobFileInfo) Mode() os.FileMode {
+	if i.dir {
+		return os.ModeDir | 0o755
+	}
+
+	return 0o644
+}
+
+// azureBlobReadFile reads a blob, the download is opened lazily from the current offset.
+type azureBlobReadFile struct {
+	fs     *azureBlobFs
+	name   string
+	key    string
+	info   *azureBlobFileInfo
+	body   io.ReadCloser
+	offset int64
+
+	entries []os.FileInfo
+	listed  bool
+}
+
+func (r *azureBlobReadFile) Name() string { return r.name }
+
+func (r *azureBlobReadFile) Stat() (os.FileInfo, error) { return r.info, nil }
+
+func (r *azureBlobReadFile) Sync() error { return nil }
+
+func (r *azureBlobReadFile) Close() error {
+	if r.body == nil {
+		return nil
+	}
+
+	err := r.body.Close()
+	r.body = nil
+
+	return err
+}
+
+func (r *azureBlobReadFile) Read(p []byte) (int, error) {
+	if r.info.dir {
+		return 0, &os.PathError{Op: "read", Path: r.name, Err: errors.New("is a directory")}
+	}
+
+	if r.offset >= r.info.size {
+		return 0, io.EOF
+	}
+
+	if r.body == nil {
+		body, _, err := r.fs.store.openRange(context.Background(), r.key, r.offset)
+		if err != nil {
+			return 0, err
+		}
+
+		r.body = body
+	}
+
+	n, err := r.body.Read(p)
+	r.offset += int64(n)
+
+	return n, err
+}
+
+func (r *azureBlobReadFile) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.info.size {
+		return 0, io.EOF
+	}
+
+	resp, err := r.fs.store.client.NewBlobClient(r.key).DownloadStream(context.Background(), &blob.DownloadStreamOptions{
+		Range: blob.HTTPRange{Offset: off, Count: int64(len(p))},
+	})
+	if err != nil {
+		return 0, &os.PathError{Op: "read", Path: r.name, Err: err}
+	}
+	defer resp.Body.Close()
+
+	n, err := io.ReadFull(resp.Body, p)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = io.EOF
+	}
+
+	return n, err
+}
+
+func (r *azureBlobReadFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.info.size
+	default:
+		return 0, fmt.Errorf("invalid whence: %d", whence)
+	}
+
+	if offset < 0 {
+		return 0, fmt.Errorf("negative offset: %d", offset)
+	}
+
+	if offset != r.offset {
+		_ = r.Close()
+		r.offset = offset
+	}
+
+	return offset, nil
+}
+
+func (r *azureBlobReadFile) Readdir(count int) ([]os.FileInfo, error) {
+	if !r.info.dir {
+		return nil, &os.PathError{Op: "readdir", Path: r.name, Err: errors.New("not a directory")}
+	}
+
+	if !r.listed {
+		entries, err := r.fs.readDir(r.key)
+		if err != nil {
+			return nil, err
+		}
+
+		r.entries = entries
+		r.listed = true
+	}
+
+	if count <= 0 {
+		entries := r.entries
+		r.entries = nil
+
+		return entries, nil
+	}
+
+	if len(r.entries) == 0 {
+		return nil, io.EOF
+	}
+
+	count = min(count, len(r.entries))
+	entries := r.entries[:count]
+	r.entries = r.entries[count:]
+
+	return entries, nil
+}
+
+func (r *azureBlobReadFile) Readdirnames(n int) ([]string, error) {
+	entries, err := r.Readdir(n)
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+
+	return names, err
+}
+
+func (r *azureBlobReadFile) Write([]byte) (int, error) { return 0, r.readOnly("write") }
+
+func (r *azureBlobReadFile) WriteAt([]byte, int64) (int, error) { return 0, r.readOnly("write") }
+
+func (r *azureBlobReadFile) WriteString(string) (int, error) { return 0, r.readOnly("write") }
+
+func (r *azureBlobReadFile) Truncate(int64) error { return r.readOnly("truncate") }
+
+func (r *azureBlobReadFile) readOnly(op string) error {
+	return &os.PathError{Op: op, Path: r.name, Err: os.ErrPermission}
+}
+
+// azureBlobWriteFile streams the written bytes to the blob, the upload is committed on Close.
+type azureBlobWriteFile struct {
+	name   string
+	pw     *io.PipeWriter
+	done   chan error
+	size   int64
+	closed bool
+	err    error
+}
+
+func (w *azureBlobWriteFile) Name() string { return w.name }
+
+func (w *azureBlobWriteFile) Stat() (os.FileInfo, error) {
+	return &azureBlobFileInfo{name: path.Base(azureBlobKey(w.name)), size: w.size, modTime: time.Now()}, nil
+}
+
+func (w *azureBlobWriteFile) Sync() error { return nil }
+
+func (w *azureBlobWriteFile) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, os.ErrClosed
+	}
+
+	return w.pw.Write(p)
+}
+
+func (w *azureBlobWriteFile) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *azureBlobWriteFile) Close() error {
+	if w.closed {
+		return w.err
+	}
+
+	w.closed = true
+	_ = w.pw.Close()
+	w.err = <-w.done
+
+	return w.err
+}
+
+func (w *azureBlobWriteFile) Read([]byte) (int, error) { return 0, w.writeOnly("read") }
+
+func (w *azureBlobWriteFile) ReadAt([]byte, int64) (int, error) { return 0, w.writeOnly("read") }
+
+func (w *azureBlobWriteFile) Seek(int64, int) (int64, error) { return 0, w.writeOnly("seek") }
+
+func (w *azureBlobWriteFile) WriteAt([]byte, int64) (int, error) { return 0, w.writeOnly("write") }
+
+func (w *azureBlobWriteFile) Readdir(int) ([]os.FileInfo, error) { return nil, w.writeOnly("readdir") }
+
+func (w *azureBlobWriteFile) Readdirnames(int) ([]string, error) {
+	return nil, w.writeOnly("readdirnames")
+}
+
+func (w *azureBlobWriteFile) Truncate(int64) error { return w.writeOnly("truncate") }
+
+func (w *azureBlobWriteFile) writeOnly(op string) error {
+	return &os.PathError{Op: op, Path: w.name, Err: errors.ErrUnsupported}
+}
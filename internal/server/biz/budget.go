@@ -0,0 +1,672 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"go.uber.org/fx"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/budget"
+	"github.com/looplj/axonhub/internal/ent/schema/schematype"
+	"github.com/looplj/axonhub/internal/ent/usagehourlyrollup"
+	"github.com/looplj/axonhub/internal/ent/usagelog"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xerrors"
+	"github.com/looplj/axonhub/internal/pkg/xregexp"
+	"github.com/looplj/axonhub/internal/pkg/xtime"
+	"github.com/looplj/axonhub/internal/server/scheduler"
+)
+
+// BudgetSpend is the spend of a budget in its current period.
+type BudgetSpend struct {
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Amount      float64
+	Spent       float64
+	Remaining   float64
+	Percent     float64
+	// Forecast is the spend at the end of the period at the burn rate of the period so far.
+	Forecast        float64
+	ForecastPercent float64
+	// ProjectedExhaustionAt is when the budget runs out at the current burn rate, nil if it does not run out in the period.
+	ProjectedExhaustionAt *time.Time
+}
+
+// Exhausted reports whether the spend reached the budget amount.
+func (s BudgetSpend) Exhausted() bool {
+	return s.Spent >= s.Amount
+}
+
+type BudgetServiceParams struct {
+	fx.In
+
+	Ent                *ent.Client
+	SystemService      *SystemService
+	UsageRollupService *UsageRollupService
+	WebhookNotifier    *WebhookNotifier
+}
+
+func NewBudgetService(params BudgetServiceParams) *BudgetService {
+	svc := &BudgetService{
+		AbstractService: &AbstractService{
+			db: params.Ent,
+		},
+		SystemService:      params.SystemService,
+		UsageRollupService: params.UsageRollupService,
+		WebhookNotifier:    params.WebhookNotifier,
+	}
+	svc.exhaustedBudgets.Store(&map[int][]*ent.Budget{})
+
+	return svc
+}
+
+type BudgetService struct {
+	*AbstractService
+
+	SystemService      *SystemService
+	UsageRollupService *UsageRollupService
+	WebhookNotifier    *WebhookNotifier
+
+	// exhaustedBudgets are the enabled budgets with an enforcement action whose spend reached the amount,
+	// keyed by project ID. It is replaced as a whole by every budget check.
+	exhaustedBudgets atomic.Pointer[map[int][]*ent.Budget]
+}
+
+func (svc *BudgetService) RegisterScheduledTasks(ctx context.Context, s *scheduler.Scheduler) error {
+	return s.Register(ctx, scheduler.TaskSpec{
+		Name:        "budget-check",
+		Description: "Check budget spend, send threshold alerts and refresh the enforced budgets every minute",
+		CronExpr:    "*/1 * * * *",
+		Timezone:    "UTC",
+	}, svc.checkBudgetsPeriodically)
+}
+
+func (svc *BudgetService) checkBudgetsPeriodically(ctx context.Context) {
+	ctx = authz.WithSystemBypass(ctx, "budget-check")
+
+	if err := svc.CheckBudgets(ctx); err != nil {
+		log.Error(ctx, "failed to check budgets", log.Cause(err))
+	}
+}
+
+// CheckBudgets computes the spend of all the enabled budgets, alerts the newly crossed thresholds
+// and refreshes the exhausted budgets enforced on the requests.
+func (svc *BudgetService) CheckBudgets(ctx context.Context) error {
+	budgets, err := svc.entFromContext(ctx).Budget.Query().
+		Where(budget.StatusEQ(budget.StatusEnabled)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query budgets: %w", err)
+	}
+
+	exhausted := make(map[int][]*ent.Budget)
+
+	for _, b := range budgets {
+		spend, err := svc.Spend(ctx, b)
+		if err != nil {
+			log.Error(ctx, "failed to compute budget spend", log.Int("budget_id", b.ID), log.Cause(err))
+			continue
+		}
+
+		if spend.Exhausted() && b.Settings.ActionOrDefault() != objects.BudgetActionNone {
+			exhausted[b.ProjectID] = append(exhausted[b.ProjectID], b)
+		}
+
+		if err := svc.alertThresholds(ctx, b, spend); err != nil {
+			log.Error(ctx, "failed to alert budget thresholds", log.Int("budget_id", b.ID), log.Cause(err))
+		}
+	}
+
+	svc.exhaustedBudgets.Store(&exhausted)
+
+	return nil
+}
+
+// ExhaustedBudgets returns the exhausted budgets with an enforcement action covering the requests of the API key,
+// as of the last budget check.
+func (svc *BudgetService) ExhaustedBudgets(apiKey *ent.APIKey) []*ent.Budget {
+	if apiKey == nil {
+		return nil
+	}
+
+	var budgets []*ent.Budget
+
+	for _, b := range (*svc.exhaustedBudgets.Load())[apiKey.ProjectID] {
+		switch b.Scope {
+		case budget.ScopeAPIKey:
+			if b.APIKeyID == nil || *b.APIKeyID != apiKey.ID {
+				continue
+			}
+		case budget.ScopeUser:
+			if b.UserID == nil || *b.UserID != apiKey.UserID {
+				continue
+			}
+		case budget.ScopeProject:
+		}
+
+		budgets = append(budgets, b)
+	}
+
+	return budgets
+}
+
+// alertThresholds sends an alert when the spend crosses a threshold higher than the one alerted in the period.
+// The alert state is updated conditionally, so only one instance alerts a threshold.
+func (svc *BudgetService) alertThresholds(ctx context.Context, b *ent.Budget, spend BudgetSpend) error {
+	crossed := 0
+
+	for _, threshold := range b.Settings.ThresholdsOrDefault() {
+		if threshold > crossed && spend.Spent >= b.Amount*float64(threshold)/100 {
+			crossed = threshold
+		}
+	}
+
+	alerted := 0
+	if b.AlertedPeriodStart != nil && b.AlertedPeriodStart.Equal(spend.PeriodStart) {
+		alerted = b.AlertedThreshold
+	}
+
+	if crossed <= alerted {
+		return nil
+	}
+
+	n, err := svc.entFromContext(ctx).Budget.Update().
+		Where(
+			budget.IDEQ(b.ID),
+			budget.Or(
+				budget.AlertedPeriodStartIsNil(),
+				budget.AlertedPeriodStartNEQ(spend.PeriodStart),
+				budget.AlertedThresholdLT(crossed),
+			),
+		).
+		SetAlertedThreshold(crossed).
+		SetAlertedPeriodStart(spend.PeriodStart).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update budget alert state: %w", err)
+	}
+
+	if n == 0 {
+		return nil
+	}
+
+	if svc.WebhookNotifier != nil {
+		svc.WebhookNotifier.NotifyBudgetThresholdCrossed(ctx, BudgetThresholdCrossedEvent{
+			Budget:     b,
+			Spend:      spend,
+			Threshold:  crossed,
+			OccurredAt: xtime.UTCNow(),
+		})
+	}
+
+	return nil
+}
+
+// Spend returns the spend of the budget in its current period.
+func (svc *BudgetService) Spend(ctx context.Context, b *ent.Budget) (BudgetSpend, error) {
+	now := xtime.UTCNow()
+
+	start, end, err := budgetPeriod(now, b.Period, svc.SystemService.TimeLocation(ctx))
+	if err != nil {
+		return BudgetSpend{}, err
+	}
+
+	spent, err := authz.RunWithSystemBypass(ctx, "budget-spend", func(bypassCtx context.Context) (float64, error) {
+		return svc.spent(bypassCtx, b, start, now)
+	})
+	if err != nil {
+		return BudgetSpend{}, err
+	}
+
+	spend := BudgetSpend{
+		PeriodStart: start,
+		PeriodEnd:   end,
+		Amount:      b.Amount,
+		Spent:       spent,
+		Remaining:   max(b.Amount-spent, 0),
+		Forecast:    spent,
+	}
+
+	if elapsed := now.Sub(start); elapsed > 0 {
+		rate := spent / elapsed.Seconds()
+		spend.Forecast = spent + rate*end.Sub(now).Seconds()
+
+		if rate > 0 && spent < b.Amount {
+			at := now.Add(time.Duration((b.Amount - spent) / rate * float64(time.Second)))
+			if at.Before(end) {
+				spend.ProjectedExhaustionAt = &at
+			}
+		}
+	}
+
+	if b.Amount > 0 {
+		spend.Percent = spent / b.Amount * 100
+		spend.ForecastPercent = spend.Forecast / b.Amount * 100
+	}
+
+	return spend, nil
+}
+
+// spent sums the cost of the budget from start to now. The hours before the usage rollup watermark
+// are read from the hourly rollups, so that the spend of the period is kept after the usage logs are cleaned up,
+// the rest from the usage logs.
+func (svc *BudgetService) spent(ctx context.Context, b *ent.Budget, start, now time.Time) (float64, error) {
+	client := svc.entFromContext(ctx)
+
+	var apiKeyIDs []int
+
+	switch b.Scope {
+	case budget.ScopeAPIKey:
+		if b.APIKeyID == nil {
+			return 0, nil
+		}
+
+		apiKeyIDs = []int{*b.APIKeyID}
+	case budget.ScopeUser:
+		if b.UserID == nil {
+			return 0, nil
+		}
+
+		// The deleted API keys of the user still count for the spend of the period.
+		ids, err := client.APIKey.Query().
+			Where(apikey.ProjectIDEQ(b.ProjectID), apikey.UserIDEQ(*b.UserID)).
+			IDs(schematype.SkipSoftDelete(ctx))
+		if err != nil {
+			return 0, fmt.Errorf("failed to query user api keys: %w", err)
+		}
+
+		if len(ids) == 0 {
+			return 0, nil
+		}
+
+		apiKeyIDs = ids
+	case budget.ScopeProject:
+	}
+
+	split := start
+
+	// The hourly rollups only cover the periods starting on the hour.
+	if start.Equal(start.Truncate(time.Hour)) && svc.UsageRollupService != nil {
+		watermark, err := svc.UsageRollupService.watermark(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		if watermark.After(start) {
+			split = watermark
+		}
+
+		if split.After(now) {
+			split = now
+		}
+	}
+
+	var total float64
+
+	if split.After(start) {
+		q := client.UsageHourlyRollup.Query().
+			Where(
+				usagehourlyrollup.ProjectIDEQ(b.ProjectID),
+				usagehourlyrollup.BucketGTE(start),
+				usagehourlyrollup.BucketLT(split),
+			)
+		if apiKeyIDs != nil {
+			q = q.Where(usagehourlyrollup.APIKeyIDIn(apiKeyIDs...))
+		}
+
+		cost, err := sumCost(ctx, q.Modify(func(s *sql.Selector) {
+			s.Select(sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagehourlyrollup.FieldTotalCost)), "total_cost"))
+		}))
+		if err != nil {
+			return 0, fmt.Errorf("failed to sum budget rollup cost: %w", err)
+		}
+
+		total += cost
+	}
+
+	q := client.UsageLog.Query().
+		Where(
+			usagelog.ProjectIDEQ(b.ProjectID),
+			usagelog.CreatedAtGTE(split),
+			usagelog.CreatedAtLTE(now),
+		)
+	if apiKeyIDs != nil {
+		q = q.Where(usagelog.APIKeyIDIn(apiKeyIDs...))
+	}
+
+	cost, err := sumCost(ctx, q.Modify(func(s *sql.Selector) {
+		s.Select(sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldTotalCost)), "total_cost"))
+	}))
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum budget usage cost: %w", err)
+	}
+
+	return total + cost, nil
+}
+
+type costScanner interface {
+	Scan(ctx context.Context, v any) error
+}
+
+func sumCost(ctx context.Context, selector costScanner) (float64, error) {
+	var rows []struct {
+		TotalCost float64 `json:"total_cost"`
+	}
+
+	if err := selector.Scan(ctx, &rows); err != nil {
+		return 0, err
+	}
+
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	return rows[0].TotalCost, nil
+}
+
+// budgetPeriod returns the calendar period of the budget containing now, in the system timezone.
+func budgetPeriod(now time.Time, period budget.Period, loc *time.Location) (time.Time, time.Time, error) {
+	unit := objects.APIKeyQuotaCalendarDurationUnitMonth
+	if period == budget.PeriodDaily {
+		unit = objects.APIKeyQuotaCalendarDurationUnitDay
+	}
+
+	window, err := quotaWindow(now, objects.APIKeyQuotaPeriod{
+		Type:             objects.APIKeyQuotaPeriodTypeCalendarDuration,
+		CalendarDuration: &objects.APIKeyQuotaCalendarDuration{Unit: unit},
+	}, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return *window.Start, *window.End, nil
+}
+
+func normalizeBudgetSettings(settings *objects.BudgetSettings) {
+	if settings.Action == "" {
+		settings.Action = objects.BudgetActionNone
+	}
+
+	if len(settings.Thresholds) == 0 {
+		settings.Thresholds = slices.Clone(objects.DefaultBudgetThresholds)
+	}
+
+	slices.Sort(settings.Thresholds)
+	settings.Thresholds = slices.Compact(settings.Thresholds)
+}
+
+func validateBudgetSettings(settings objects.BudgetSettings) error {
+	for _, threshold := range settings.Thresholds {
+		if threshold <= 0 {
+			return fmt.Errorf("budget thresholds must be positive percentages")
+		}
+	}
+
+	if !settings.Action.IsValid() {
+		return fmt.Errorf("invalid budget action: %s", settings.Action)
+	}
+
+	//nolint:exhaustive // Checked.
+	switch settings.Action {
+	case objects.BudgetActionDowngrade:
+		if len(settings.ModelMappings) == 0 {
+			return fmt.Errorf("model mappings are required for the downgrade action")
+		}
+
+		for _, mapping := range settings.ModelMappings {
+			if mapping.From == "" || mapping.To == "" {
+				return fmt.Errorf("model mapping from and to are required")
+			}
+
+			if err := xregexp.ValidateRegex(mapping.From); err != nil {
+				return fmt.Errorf("invalid model mapping pattern %q: %w", mapping.From, err)
+			}
+		}
+	case objects.BudgetActionRestrictTags:
+		if len(settings.ChannelTags) == 0 {
+			return fmt.Errorf("channel tags are required for the restrict_tags action")
+		}
+	}
+
+	return nil
+}
+
+// validateBudgetTarget checks that the budget has the target of its scope, and that the API key belongs to the project.
+func (svc *BudgetService) validateBudgetTarget(ctx context.Context, projectID int, scope budget.Scope, apiKeyID, userID *int) error {
+	switch scope {
+	case budget.ScopeAPIKey:
+		if apiKeyID == nil {
+			return fmt.Errorf("api key id is required for an api_key budget")
+		}
+
+		exists, err := svc.entFromContext(ctx).APIKey.Query().
+			Where(apikey.IDEQ(*apiKeyID), apikey.ProjectIDEQ(projectID)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check budget api key: %w", err)
+		}
+
+		if !exists {
+			return fmt.Errorf("api key not found in project")
+		}
+	case budget.ScopeUser:
+		if userID == nil {
+			return fmt.Errorf("user id is required for a user budget")
+		}
+	case budget.ScopeProject:
+	default:
+		return fmt.Errorf("invalid budget scope: %s", scope)
+	}
+
+	return nil
+}
+
+func (svc *BudgetService) CreateBudget(ctx context.Context, input ent.CreateBudgetInput) (*ent.Budget, error) {
+	projectID, ok := contexts.GetProjectID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("project id not found in context")
+	}
+
+	if input.Amount <= 0 {
+		return nil, fmt.Errorf("budget amount must be positive")
+	}
+
+	scope := budget.DefaultScope
+	if input.Scope != nil {
+		scope = *input.Scope
+	}
+
+	if err := svc.validateBudgetTarget(ctx, projectID, scope, input.APIKeyID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	var settings objects.BudgetSettings
+	if input.Settings != nil {
+		settings = *input.Settings
+	}
+
+	normalizeBudgetSettings(&settings)
+
+	if err := validateBudgetSettings(settings); err != nil {
+		return nil, err
+	}
+
+	exists, err := svc.entFromContext(ctx).Budget.Query().
+		Where(
+			budget.Name(input.Name),
+			budget.ProjectIDEQ(projectID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check budget name uniqueness: %w", err)
+	}
+
+	if exists {
+		return nil, xerrors.DuplicateNameError("budget", input.Name)
+	}
+
+	b, err := svc.entFromContext(ctx).Budget.Create().
+		SetProjectID(projectID).
+		SetName(input.Name).
+		SetNillableDescription(input.Description).
+		SetScope(scope).
+		SetNillableAPIKeyID(input.APIKeyID).
+		SetNillableUserID(input.UserID).
+		SetAmount(input.Amount).
+		SetNillablePeriod(input.Period).
+		SetNillableStatus(input.Status).
+		SetSettings(settings).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create budget: %w", err)
+	}
+
+	return b, nil
+}
+
+func (svc *BudgetService) UpdateBudget(ctx context.Context, id int, input *ent.UpdateBudgetInput) (*ent.Budget, error) {
+	projectID, ok := contexts.GetProjectID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("project id not found in context")
+	}
+
+	existing, err := svc.entFromContext(ctx).Budget.Query().
+		Where(
+			budget.IDEQ(id),
+			budget.ProjectIDEQ(projectID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("budget not found or not in project")
+		}
+
+		return nil, fmt.Errorf("failed to query budget: %w", err)
+	}
+
+	if input.Amount != nil && *input.Amount <= 0 {
+		return nil, fmt.Errorf("budget amount must be positive")
+	}
+
+	scope := existing.Scope
+	if input.Scope != nil {
+		scope = *input.Scope
+	}
+
+	apiKeyID := existing.APIKeyID
+	if input.ClearAPIKeyID {
+		apiKeyID = nil
+	}
+
+	if input.APIKeyID != nil {
+		apiKeyID = input.APIKeyID
+	}
+
+	userID := existing.UserID
+	if input.ClearUserID {
+		userID = nil
+	}
+
+	if input.UserID != nil {
+		userID = input.UserID
+	}
+
+	if err := svc.validateBudgetTarget(ctx, projectID, scope, apiKeyID, userID); err != nil {
+		return nil, err
+	}
+
+	if input.Settings != nil {
+		normalizeBudgetSettings(input.Settings)
+
+		if err := validateBudgetSettings(*input.Settings); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Name != nil {
+		exists, err := svc.entFromContext(ctx).Budget.Query().
+			Where(
+				budget.Name(*input.Name),
+				budget.ProjectIDEQ(projectID),
+				budget.IDNEQ(id),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check budget name uniqueness: %w", err)
+		}
+
+		if exists {
+			return nil, xerrors.DuplicateNameError("budget", *input.Name)
+		}
+	}
+
+	b, err := svc.entFromContext(ctx).Budget.UpdateOne(existing).
+		SetInput(*input).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update budget: %w", err)
+	}
+
+	return b, nil
+}
+
+func (svc *BudgetService) DeleteBudget(ctx context.Context, id int) error {
+	projectID, ok := contexts.GetProjectID(ctx)
+	if !ok {
+		return fmt.Errorf("project id not found in context")
+	}
+
+	n, err := svc.entFromContext(ctx).Budget.Delete().
+		Where(
+			budget.IDEQ(id),
+			budget.ProjectIDEQ(projectID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete budget: %w", err)
+	}
+
+	if n == 0 {
+		return fmt.Errorf("budget not found or not in project")
+	}
+
+	return nil
+}
+
+func (svc *BudgetService) UpdateBudgetStatus(ctx context.Context, id int, status budget.Status) (*ent.Budget, error) {
+	projectID, ok := contexts.GetProjectID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("project id not found in context")
+	}
+
+	n, err := svc.entFromContext(ctx).Budget.Update().
+		Where(
+			budget.IDEQ(id),
+			budget.ProjectIDEQ(projectID),
+		).
+		SetStatus(status).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update budget status: %w", err)
+	}
+
+	if n == 0 {
+		return nil, fmt.Errorf("budget not found or not in project")
+	}
+
+	b, err := svc.entFromContext(ctx).Budget.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query updated budget: %w", err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,218 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/budget"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+	"github.com/looplj/axonhub/internal/pkg/xtime"
+	"github.com/looplj/axonhub/llm/httpclient"
+)
+
+func setupTestBudgetService(t *testing.T) (context.Context, *ent.Client, *BudgetService) {
+	t.Helper()
+
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=0")
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := authz.WithTestBypass(ent.NewContext(context.Background(), client))
+
+	systemService := NewSystemService(SystemServiceParams{
+		CacheConfig: xcache.Config{},
+		Ent:         client,
+	})
+
+	return ctx, client, NewBudgetService(BudgetServiceParams{
+		Ent:           client,
+		SystemService: systemService,
+		UsageRollupService: NewUsageRollupService(UsageRollupServiceParams{
+			Ent:           client,
+			SystemService: systemService,
+		}),
+		WebhookNotifier: NewWebhookNotifier(systemService, httpclient.NewHttpClient()),
+	})
+}
+
+func createTestBudgetUsageLog(t *testing.T, ctx context.Context, client *ent.Client, apiKeyID int, cost float64) {
+	t.Helper()
+
+	_, err := client.UsageLog.Create().
+		SetRequestID(1).
+		SetProjectID(1).
+		SetAPIKeyID(apiKeyID).
+		SetChannelID(1).
+		SetModelID("gpt-4").
+		SetTotalCost(cost).
+		SetCreatedAt(xtime.UTCNow()).
+		Save(ctx)
+	require.NoError(t, err)
+}
+
+func TestBudgetService_CreateBudget_Validation(t *testing.T) {
+	ctx, _, svc := setupTestBudgetService(t)
+	ctx = contexts.WithProjectID(ctx, 1)
+
+	_, err := svc.CreateBudget(ctx, ent.CreateBudgetInput{Name: "zero", Amount: 0})
+	require.Error(t, err)
+
+	_, err = svc.CreateBudget(ctx, ent.CreateBudgetInput{Name: "key", Amount: 10, Scope: lo.ToPtr(budget.ScopeAPIKey)})
+	require.ErrorContains(t, err, "api key id is required")
+
+	_, err = svc.CreateBudget(ctx, ent.CreateBudgetInput{
+		Name:     "downgrade",
+		Amount:   10,
+		Settings: &objects.BudgetSettings{Action: objects.BudgetActionDowngrade},
+	})
+	require.ErrorContains(t, err, "model mappings are required")
+
+	b, err := svc.CreateBudget(ctx, ent.CreateBudgetInput{Name: "project", Amount: 10})
+	require.NoError(t, err)
+	require.Equal(t, budget.ScopeProject, b.Scope)
+	require.Equal(t, budget.PeriodMonthly, b.Period)
+	require.Equal(t, objects.BudgetActionNone, b.Settings.Action)
+	require.Equal(t, []int{50, 80, 100}, b.Settings.Thresholds)
+
+	_, err = svc.CreateBudget(ctx, ent.CreateBudgetInput{Name: "project", Amount: 20})
+	require.Error(t, err)
+}
+
+func TestBudgetService_Spend(t *testing.T) {
+	ctx, client, svc := setupTestBudgetService(t)
+
+	createTestBudgetUsageLog(t, ctx, client, 1, 3)
+	createTestBudgetUsageLog(t, ctx, client, 2, 4)
+
+	projectBudget, err := client.Budget.Create().
+		SetProjectID(1).
+		SetName("project").
+		SetAmount(10).
+		SetPeriod(budget.PeriodDaily).
+		Save(ctx)
+	require.NoError(t, err)
+
+	spend, err := svc.Spend(ctx, projectBudget)
+	require.NoError(t, err)
+	require.InDelta(t, 7, spend.Spent, 1e-9)
+	require.InDelta(t, 3, spend.Remaining, 1e-9)
+	require.InDelta(t, 70, spend.Percent, 1e-9)
+	require.GreaterOrEqual(t, spend.Forecast, spend.Spent)
+	require.Equal(t, 24*time.Hour, spend.PeriodEnd.Sub(spend.PeriodStart))
+
+	keyBudget, err := client.Budget.Create().
+		SetProjectID(1).
+		SetName("key").
+		SetScope(budget.ScopeAPIKey).
+		SetAPIKeyID(2).
+		SetAmount(4).
+		Save(ctx)
+	require.NoError(t, err)
+
+	spend, err = svc.Spend(ctx, keyBudget)
+	require.NoError(t, err)
+	require.InDelta(t, 4, spend.Spent, 1e-9)
+	require.True(t, spend.Exhausted())
+	require.Nil(t, spend.ProjectedExhaustionAt)
+}
+
+func TestBudgetService_CheckBudgets(t *testing.T) {
+	ctx, client, svc := setupTestBudgetService(t)
+
+	var (
+		mu     sync.Mutex
+		events []WebhookRenderContext
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		var event WebhookRenderContext
+		_ = json.Unmarshal(body, &event)
+
+		mu.Lock()
+		events = append(events, event)
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	require.NoError(t, svc.SystemService.SetWebhookNotifierConfig(ctx, &WebhookNotifierConfig{
+		Targets: []WebhookTarget{{
+			Name:    "budget",
+			Enabled: true,
+			URL:     server.URL,
+			Body:    `{"event":"{{.Event}}","severity":"{{.Severity}}","trigger":{"threshold":{{.Trigger.Threshold}}},"budget":{"id":{{.Budget.ID}}}}`,
+		}},
+		Subscriptions: []WebhookSubscription{{Event: EventBudgetThresholdCrossed, TargetNames: []string{"budget"}}},
+	}))
+
+	apiKey, err := client.APIKey.Create().
+		SetName("key").
+		SetKey("ah-budget-key").
+		SetProjectID(1).
+		SetUserID(5).
+		Save(ctx)
+	require.NoError(t, err)
+
+	keyBudget, err := client.Budget.Create().
+		SetProjectID(1).
+		SetName("key").
+		SetScope(budget.ScopeAPIKey).
+		SetAPIKeyID(apiKey.ID).
+		SetAmount(10).
+		SetSettings(objects.BudgetSettings{Thresholds: []int{50, 80, 100}, Action: objects.BudgetActionBlock}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.Budget.Create().
+		SetProjectID(1).
+		SetName("other user").
+		SetScope(budget.ScopeUser).
+		SetUserID(6).
+		SetAmount(1).
+		SetSettings(objects.BudgetSettings{Action: objects.BudgetActionBlock}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	createTestBudgetUsageLog(t, ctx, client, apiKey.ID, 6)
+
+	require.NoError(t, svc.CheckBudgets(ctx))
+	require.Empty(t, svc.ExhaustedBudgets(apiKey))
+
+	// Checking again does not alert the same threshold twice.
+	require.NoError(t, svc.CheckBudgets(ctx))
+
+	createTestBudgetUsageLog(t, ctx, client, apiKey.ID, 5)
+
+	require.NoError(t, svc.CheckBudgets(ctx))
+
+	exhausted := svc.ExhaustedBudgets(apiKey)
+	require.Len(t, exhausted, 1)
+	require.Equal(t, keyBudget.ID, exhausted[0].ID)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	require.Len(t, events, 2)
+	require.Equal(t, EventBudgetThresholdCrossed, events[0].Event)
+	require.Equal(t, 50, events[0].Trigger.Threshold)
+	require.Equal(t, "warning", events[0].Severity)
+	require.Equal(t, 100, events[1].Trigger.Threshold)
+	require.Equal(t, "critical", events[1].Severity)
+	require.Equal(t, keyBudget.ID, events[1].Budget.ID)
+}
@@ -821,10 +821,32 @@ func (svc *ChannelService) UpdateChannelStatus(ctx context.Context, id int, stat
 	}
 
 	svc.asyncReloadChannels()
+	svc.notifyChannelStatusChanged(ctx, channel)
 
 	return channel, nil
 }
 
+// notifyChannelStatusChanged notifies the webhook subscribers of the status changes once the caller-owned transaction commits.
+func (svc *ChannelService) notifyChannelStatusChanged(ctx context.Context, channels ...*ent.Channel) {
+	if svc.WebhookNotifier == nil || len(channels) == 0 {
+		return
+	}
+
+	notifyCtx := context.WithoutCancel(ctx)
+	now := time.Now()
+
+	svc.runAfterCommit(ctx, func() {
+		go func() {
+			for _, ch := range channels {
+				svc.WebhookNotifier.NotifyChannelStatusChanged(notifyCtx, ChannelStatusChangedEvent{
+					Channel:    ch,
+					OccurredAt: now,
+				})
+			}
+		}()
+	})
+}
+
 // For test, disable async reload.
 var asyncReloadDisabled = false
 
@@ -841,9 +863,14 @@ func (svc *ChannelService) asyncReloadChannels() {
 // reloadChannelsAfterCommit waits for a caller-owned Ent transaction, including
 // the GraphQL Transactioner, before publishing the channel cache refresh.
 func (svc *ChannelService) reloadChannelsAfterCommit(ctx context.Context) {
+	svc.runAfterCommit(ctx, svc.asyncReloadChannels)
+}
+
+// runAfterCommit runs fn after the caller-owned Ent transaction commits, or right away without one.
+func (svc *ChannelService) runAfterCommit(ctx context.Context, fn func()) {
 	tx := ent.TxFromContext(ctx)
 	if tx == nil {
-		svc.asyncReloadChannels()
+		fn()
 
 		return
 	}
@@ -854,7 +881,7 @@ func (svc *ChannelService) reloadChannelsAfterCommit(ctx context.Context) {
 				return err
 			}
 
-			svc.asyncReloadChannels()
+			fn()
 
 			return nil
 		})
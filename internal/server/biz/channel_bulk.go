@@ -187,6 +187,17 @@ func (svc *ChannelService) bulkUpdateChannelStatus(ctx context.Context, ids []in
 
 	svc.asyncReloadChannels()
 
+	if svc.WebhookNotifier != nil && status != channel.StatusArchived {
+		channels, err := client.Channel.Query().
+			Where(channel.IDIn(ids...)).
+			All(ctx)
+		if err != nil {
+			log.Warn(ctx, "failed to load channels for webhook notification", log.Cause(err))
+		} else {
+			svc.notifyChannelStatusChanged(ctx, channels...)
+		}
+	}
+
 	return nil
 }
 
@@ -3,6 +3,7 @@ package biz
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/samber/lo"
 
@@ -165,6 +166,20 @@ func (svc *ChannelService) syncChannelModelsForChannel(ctx context.Context, ch *
 		updatedCh.Unwrap()
 	}
 
+	if modelsChanged && svc.WebhookNotifier != nil {
+		notifyCtx := context.WithoutCancel(ctx)
+		event := ChannelModelsChangedEvent{
+			Channel:    updatedCh,
+			Added:      addedModels,
+			Removed:    removedModels,
+			OccurredAt: time.Now(),
+		}
+
+		svc.runAfterCommit(ctx, func() {
+			go svc.WebhookNotifier.NotifyChannelModelsChanged(notifyCtx, event)
+		})
+	}
+
 	log.Info(ctx, "successfully synced models for channel",
 		log.Int("channel_id", ch.ID),
 		log.String("channel_name", ch.Name),
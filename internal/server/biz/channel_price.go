@@ -83,7 +83,8 @@ func modelCardToChannelModelPrice(card *objects.ModelCard) (objects.ModelPrice,
 		return objects.ModelPrice{}, false
 	}
 
-	return objects.ModelPrice{Items: items}, true
+	// The model library costs are in USD.
+	return objects.ModelPrice{Items: items, Currency: "USD"}, true
 }
 
 // createChannelModelPriceIfMissing atomically creates a current price when the
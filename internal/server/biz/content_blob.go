@@ -0,0 +1,296 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/samber/lo"
+	"github.com/tidwall/gjson"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/contentblob"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+const (
+	// contentBlobRefKey is the key of the object replacing a block stored as a content blob: {"$axonhub_blob":"<hash>"}.
+	contentBlobRefKey = "$axonhub_blob"
+
+	// contentBlobMinSize is the size from which a block of a body is stored as a content blob.
+	contentBlobMinSize = 4 << 10
+)
+
+var (
+	contentBlobEncoder, _ = zstd.NewWriter(nil)
+	contentBlobDecoder, _ = zstd.NewReader(nil)
+)
+
+type contentBlock struct {
+	hash string
+	data []byte
+}
+
+// rewriteContentBlocks rebuilds a JSON object body with fn applied to its blocks: the values of its fields and the
+// items of its array fields. The agentic requests resend the same messages, system prompts and tools, which are all blocks.
+func rewriteContentBlocks(body []byte, fn func(block gjson.Result) string) []byte {
+	root := gjson.ParseBytes(body)
+
+	var buf bytes.Buffer
+
+	buf.Grow(len(body))
+	buf.WriteByte('{')
+
+	first := true
+
+	root.ForEach(func(key, value gjson.Result) bool {
+		if !first {
+			buf.WriteByte(',')
+		}
+
+		first = false
+
+		buf.WriteString(key.Raw)
+		buf.WriteByte(':')
+
+		if !value.IsArray() {
+			buf.WriteString(fn(value))
+			return true
+		}
+
+		buf.WriteByte('[')
+
+		firstItem := true
+
+		value.ForEach(func(_, item gjson.Result) bool {
+			if !firstItem {
+				buf.WriteByte(',')
+			}
+
+			firstItem = false
+
+			buf.WriteString(fn(item))
+
+			return true
+		})
+
+		buf.WriteByte(']')
+
+		return true
+	})
+
+	buf.WriteByte('}')
+
+	return buf.Bytes()
+}
+
+func isContentBlobCandidate(body []byte) bool {
+	return len(body) >= contentBlobMinSize && json.Valid(body) && gjson.ParseBytes(body).IsObject()
+}
+
+func hasContentBlobRefs(body []byte) bool {
+	return bytes.Contains(body, []byte(`"`+contentBlobRefKey+`"`))
+}
+
+// contentBlobRef returns the hash of the content blob referenced by the block.
+func contentBlobRef(block gjson.Result) (string, bool) {
+	if !block.IsObject() {
+		return "", false
+	}
+
+	var (
+		hash string
+		keys int
+	)
+
+	block.ForEach(func(key, value gjson.Result) bool {
+		keys++
+
+		if key.Str == contentBlobRefKey && value.Type == gjson.String {
+			hash = value.Str
+		}
+
+		return keys == 1
+	})
+
+	return hash, keys == 1 && hash != ""
+}
+
+// splitContentBlobs replaces the large blocks of a body with references to content blobs.
+// A body already holding reference-shaped blocks, which only a client can send, is not split:
+// its blocks could not be told apart from the references once expanded.
+func splitContentBlobs(body []byte) ([]byte, []contentBlock) {
+	if !isContentBlobCandidate(body) || len(contentBlobRefs(body)) > 0 {
+		return body, nil
+	}
+
+	var blocks []contentBlock
+
+	split := rewriteContentBlocks(body, func(block gjson.Result) string {
+		if len(block.Raw) < contentBlobMinSize {
+			return block.Raw
+		}
+
+		sum := sha256.Sum256([]byte(block.Raw))
+		hash := hex.EncodeToString(sum[:])
+		blocks = append(blocks, contentBlock{hash: hash, data: []byte(block.Raw)})
+
+		return `{"` + contentBlobRefKey + `":"` + hash + `"}`
+	})
+
+	if len(blocks) == 0 {
+		return body, nil
+	}
+
+	return split, blocks
+}
+
+// contentBlobRefs returns the hashes of the content blobs referenced by a body, once per reference.
+func contentBlobRefs(body []byte) []string {
+	if !hasContentBlobRefs(body) || !json.Valid(body) || !gjson.ParseBytes(body).IsObject() {
+		return nil
+	}
+
+	var hashes []string
+
+	rewriteContentBlocks(body, func(block gjson.Result) string {
+		if hash, ok := contentBlobRef(block); ok {
+			hashes = append(hashes, hash)
+		}
+
+		return block.Raw
+	})
+
+	return hashes
+}
+
+// StoreContentBlobs stores the large blocks of the body as content blobs, taking a reference on each of them, and
+// returns the body referencing the blobs and whether it was split. The body is returned as is when it has no large block
+// or the blobs can not be stored. Only the split bodies must be expanded and released, they are flagged by request_body_blobs.
+func StoreContentBlobs(ctx context.Context, client *ent.Client, body objects.JSONRawMessage) (objects.JSONRawMessage, bool) {
+	split, blocks := splitContentBlobs(body)
+	if len(blocks) == 0 {
+		return body, false
+	}
+
+	ctx = authz.WithSystemBypass(ctx, "content-blob-store")
+
+	for i, block := range blocks {
+		if err := acquireContentBlob(ctx, client, block); err != nil {
+			log.Warn(ctx, "Failed to store content blob, keeping the body inline", log.Cause(err))
+
+			if err := releaseContentBlobRefs(ctx, client, lo.Map(blocks[:i], func(b contentBlock, _ int) string { return b.hash })); err != nil {
+				log.Warn(ctx, "Failed to release content blobs", log.Cause(err))
+			}
+
+			return body, false
+		}
+	}
+
+	return split, true
+}
+
+func acquireContentBlob(ctx context.Context, client *ent.Client, block contentBlock) error {
+	updated, err := client.ContentBlob.Update().
+		Where(contentblob.Hash(block.hash)).
+		AddRefCount(1).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if updated > 0 {
+		return nil
+	}
+
+	return client.ContentBlob.Create().
+		SetHash(block.hash).
+		SetData(contentBlobEncoder.EncodeAll(block.data, nil)).
+		SetSize(int64(len(block.data))).
+		SetRefCount(1).
+		OnConflictColumns(contentblob.FieldHash).
+		AddRefCount(1).
+		Exec(ctx)
+}
+
+// ExpandContentBlobs returns the body with the referenced content blobs put back in place.
+// The body is returned as is unless it was split by StoreContentBlobs.
+func ExpandContentBlobs(ctx context.Context, client *ent.Client, body objects.JSONRawMessage, split bool) (objects.JSONRawMessage, error) {
+	if !split {
+		return body, nil
+	}
+
+	hashes := contentBlobRefs(body)
+	if len(hashes) == 0 {
+		return body, nil
+	}
+
+	blobs, err := client.ContentBlob.Query().
+		Where(contentblob.HashIn(lo.Uniq(hashes)...)).
+		All(authz.WithSystemBypass(ctx, "content-blob-load"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load content blobs: %w", err)
+	}
+
+	contents := make(map[string]string, len(blobs))
+
+	for _, blob := range blobs {
+		data, err := contentBlobDecoder.DecodeAll(blob.Data, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress content blob %s: %w", blob.Hash, err)
+		}
+
+		contents[blob.Hash] = string(data)
+	}
+
+	for _, hash := range hashes {
+		if _, ok := contents[hash]; !ok {
+			return nil, fmt.Errorf("content blob %s not found", hash)
+		}
+	}
+
+	return rewriteContentBlocks(body, func(block gjson.Result) string {
+		if hash, ok := contentBlobRef(block); ok {
+			return contents[hash]
+		}
+
+		return block.Raw
+	}), nil
+}
+
+// ReleaseContentBlobs drops the references of the bodies on their content blobs, the unreferenced blobs are deleted by the gc.
+// The bodies must have been split by StoreContentBlobs, the references of the other bodies were sent by the clients.
+func ReleaseContentBlobs(ctx context.Context, client *ent.Client, bodies ...objects.JSONRawMessage) error {
+	var hashes []string
+	for _, body := range bodies {
+		hashes = append(hashes, contentBlobRefs(body)...)
+	}
+
+	return releaseContentBlobRefs(authz.WithSystemBypass(ctx, "content-blob-release"), client, hashes)
+}
+
+func releaseContentBlobRefs(ctx context.Context, client *ent.Client, hashes []string) error {
+	counts := lo.CountValues(hashes)
+
+	// Update in a stable order, so that concurrent releases do not deadlock.
+	keys := lo.Keys(counts)
+	slices.Sort(keys)
+
+	for _, hash := range keys {
+		if err := client.ContentBlob.Update().
+			Where(contentblob.Hash(hash)).
+			AddRefCount(-int64(counts[hash])).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to release content blob %s: %w", hash, err)
+		}
+	}
+
+	return nil
+}
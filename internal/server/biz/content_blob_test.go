@@ -0,0 +1,162 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/contentblob"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+func contentBlobTestBody(user string) objects.JSONRawMessage {
+	system := strings.Repeat("You are a helpful assistant. ", 200)
+	tool := fmt.Sprintf(`{"type":"function","function":{"name":"search","description":%q}}`, strings.Repeat("Search the web. ", 300))
+
+	return objects.JSONRawMessage(fmt.Sprintf(
+		`{"model":"gpt-4o","messages":[{"role":"system","content":%q},{"role":"user","content":%q}],"tools":[%s],"stream":true}`,
+		system, user, tool,
+	))
+}
+
+func contentBlobRefCounts(t *testing.T, ctx context.Context, client *ent.Client) map[string]int64 {
+	t.Helper()
+
+	blobs, err := client.ContentBlob.Query().All(ctx)
+	require.NoError(t, err)
+
+	counts := map[string]int64{}
+	for _, blob := range blobs {
+		counts[blob.Hash] = blob.RefCount
+	}
+
+	return counts
+}
+
+func TestContentBlobs(t *testing.T) {
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := authz.WithTestBypass(ent.NewContext(context.Background(), client))
+
+	first := contentBlobTestBody("hello")
+	second := contentBlobTestBody("how are you?")
+
+	storedFirst, split := StoreContentBlobs(ctx, client, first)
+	require.True(t, split)
+
+	storedSecond, split := StoreContentBlobs(ctx, client, second)
+	require.True(t, split)
+
+	require.Less(t, len(storedFirst), 300)
+	require.Contains(t, string(storedFirst), contentBlobRefKey)
+
+	counts := contentBlobRefCounts(t, ctx, client)
+	require.Len(t, counts, 2, "the system prompt and the tool are stored once")
+
+	for _, count := range counts {
+		require.EqualValues(t, 2, count)
+	}
+
+	for _, tc := range []struct {
+		stored   objects.JSONRawMessage
+		original objects.JSONRawMessage
+	}{
+		{storedFirst, first},
+		{storedSecond, second},
+	} {
+		expanded, err := ExpandContentBlobs(ctx, client, tc.stored, true)
+		require.NoError(t, err)
+		require.JSONEq(t, string(tc.original), string(expanded))
+	}
+
+	t.Run("small bodies are kept inline", func(t *testing.T) {
+		body := objects.JSONRawMessage(`{"model":"gpt-4o","messages":[{"role":"user","content":"hi"}]}`)
+		stored, split := StoreContentBlobs(ctx, client, body)
+		require.Equal(t, body, stored)
+		require.False(t, split)
+	})
+
+	t.Run("non object bodies are kept inline", func(t *testing.T) {
+		body := objects.JSONRawMessage(`[` + strings.Repeat(`"x",`, 2000) + `"x"]`)
+		stored, split := StoreContentBlobs(ctx, client, body)
+		require.Equal(t, body, stored)
+		require.False(t, split)
+	})
+
+	t.Run("client references are kept as is", func(t *testing.T) {
+		// A client sending the reference of a stored blob must neither read it nor change its references.
+		var hash string
+		for h := range contentBlobRefCounts(t, ctx, client) {
+			hash = h
+		}
+
+		body := objects.JSONRawMessage(fmt.Sprintf(
+			`{"model":"gpt-4o","messages":[{"%s":%q},{"role":"user","content":%q}]}`,
+			contentBlobRefKey, hash, strings.Repeat("Tell me a story. ", 300),
+		))
+		before := contentBlobRefCounts(t, ctx, client)
+
+		stored, split := StoreContentBlobs(ctx, client, body)
+		require.False(t, split)
+		require.Equal(t, body, stored)
+
+		expanded, err := ExpandContentBlobs(ctx, client, stored, split)
+		require.NoError(t, err)
+		require.Equal(t, body, expanded)
+		require.Equal(t, before, contentBlobRefCounts(t, ctx, client))
+	})
+
+	t.Run("release drops the references", func(t *testing.T) {
+		require.NoError(t, ReleaseContentBlobs(ctx, client, storedFirst))
+
+		for _, count := range contentBlobRefCounts(t, ctx, client) {
+			require.EqualValues(t, 1, count)
+		}
+
+		require.NoError(t, ReleaseContentBlobs(ctx, client, storedSecond, objects.JSONRawMessage(`{}`), nil))
+
+		unreferenced, err := client.ContentBlob.Query().Where(contentblob.RefCountLTE(0)).Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, unreferenced)
+	})
+
+	t.Run("missing blob", func(t *testing.T) {
+		_, err := client.ContentBlob.Delete().Exec(ctx)
+		require.NoError(t, err)
+
+		_, err = ExpandContentBlobs(ctx, client, storedFirst, true)
+		require.ErrorContains(t, err, "not found")
+	})
+}
+
+func TestSplitContentBlobs_KeepsFieldOrder(t *testing.T) {
+	body := contentBlobTestBody("hello")
+
+	split, blocks := splitContentBlobs(body)
+	require.Len(t, blocks, 2)
+	require.True(t, json.Valid(split))
+	require.Len(t, contentBlobRefs(split), 2)
+
+	contents := map[string]string{}
+	for _, block := range blocks {
+		contents[block.hash] = string(block.data)
+	}
+
+	joined := rewriteContentBlocks(split, func(block gjson.Result) string {
+		if hash, ok := contentBlobRef(block); ok {
+			return contents[hash]
+		}
+
+		return block.Raw
+	})
+	require.Equal(t, string(body), string(joined))
+}
@@ -0,0 +1,707 @@
+package biz
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/samber/lo"
+	"go.uber.org/fx"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/dataexport"
+	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/ent/usagelog"
+	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xerrors"
+	"github.com/looplj/axonhub/internal/pkg/xtime"
+	"github.com/looplj/axonhub/internal/server/scheduler"
+)
+
+const (
+	dataExportBatchSize = 500
+
+	// dataExportRunTimeout is the time after which a running export is considered abandoned, e.g. by a restart, and failed.
+	dataExportRunTimeout = 6 * time.Hour
+)
+
+// GenerateDataExportKey generates the storage key of the file of a data export.
+func GenerateDataExportKey(projectID, exportID int, resource dataexport.Resource, format dataexport.Format) string {
+	return fmt.Sprintf("/%d/exports/%d/%s.%s", projectID, exportID, resource, format)
+}
+
+// DataExportContentType returns the content type of the file of a data export.
+func DataExportContentType(format dataexport.Format) string {
+	switch format {
+	case dataexport.FormatCsv:
+		return "text/csv; charset=utf-8"
+	case dataexport.FormatParquet:
+		return "application/vnd.apache.parquet"
+	default:
+		return "application/x-ndjson"
+	}
+}
+
+type DataExportServiceParams struct {
+	fx.In
+
+	Ent                *ent.Client
+	DataStorageService *DataStorageService
+	RequestService     *RequestService
+}
+
+// DataExportService runs the data exports of the projects.
+//
+// An export is created pending, then run by the scheduler as the user who created it, so that the exported records
+// are the ones the user can read, and written to a data storage to be downloaded through the admin API.
+type DataExportService struct {
+	*AbstractService
+
+	DataStorageService *DataStorageService
+	RequestService     *RequestService
+	mu                 sync.Mutex
+}
+
+func NewDataExportService(params DataExportServiceParams) *DataExportService {
+	return &DataExportService{
+		AbstractService: &AbstractService{
+			db: params.Ent,
+		},
+		DataStorageService: params.DataStorageService,
+		RequestService:     params.RequestService,
+	}
+}
+
+func (svc *DataExportService) RegisterScheduledTasks(ctx context.Context, s *scheduler.Scheduler) error {
+	return s.Register(ctx, scheduler.TaskSpec{
+		Name:        "data-export",
+		Description: "Run the pending data exports every minute",
+		CronExpr:    "* * * * *",
+		Timezone:    "UTC",
+	}, svc.runExportsPeriodically)
+}
+
+func (svc *DataExportService) runExportsPeriodically(ctx context.Context) {
+	if _, err := svc.RunPendingExports(ctx); err != nil {
+		log.Error(ctx, "Failed to run data exports", log.Cause(err))
+	}
+}
+
+// CreateDataExportInput is the input of a data export.
+type CreateDataExportInput struct {
+	Resource      dataexport.Resource         `json:"resource"`
+	Format        dataexport.Format           `json:"format"`
+	IncludeBodies bool                        `json:"includeBodies"`
+	Criteria      *objects.DataExportCriteria `json:"criteria,omitempty"`
+	// DataStorageID is the data storage the export is written to, the default data storage if not set.
+	DataStorageID *int `json:"dataStorageID,omitempty"`
+}
+
+// CreateDataExport creates a pending data export of the project, run by the scheduler.
+func (svc *DataExportService) CreateDataExport(ctx context.Context, projectID int, input CreateDataExportInput) (*ent.DataExport, error) {
+	if err := dataexport.ResourceValidator(input.Resource); err != nil {
+		return nil, xerrors.ValidationError(fmt.Sprintf("unsupported data export resource: %s", input.Resource))
+	}
+
+	if err := dataexport.FormatValidator(input.Format); err != nil {
+		return nil, xerrors.ValidationError(fmt.Sprintf("unsupported data export format: %s", input.Format))
+	}
+
+	if input.IncludeBodies && input.Resource != dataexport.ResourceRequests {
+		return nil, xerrors.ValidationError("the bodies can only be exported with the requests")
+	}
+
+	var criteria objects.DataExportCriteria
+	if input.Criteria != nil {
+		criteria = *input.Criteria
+	}
+
+	if criteria.From != nil && criteria.To != nil && !criteria.From.Before(*criteria.To) {
+		return nil, xerrors.ValidationError("the data export time range is empty")
+	}
+
+	ds, err := authz.RunWithSystemBypass(ctx, "data-export-storage", func(bypassCtx context.Context) (*ent.DataStorage, error) {
+		bypassCtx = ent.NewContext(bypassCtx, svc.db)
+
+		if input.DataStorageID != nil {
+			return svc.DataStorageService.GetDataStorageByID(bypassCtx, *input.DataStorageID)
+		}
+
+		return svc.DataStorageService.GetDefaultDataStorage(bypassCtx)
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, xerrors.ValidationError("data storage not found")
+		}
+
+		return nil, err
+	}
+
+	if ds.Type == datastorage.TypeDatabase {
+		return nil, xerrors.ValidationError("data exports can not be written to a database data storage")
+	}
+
+	if ds.Status != datastorage.StatusActive {
+		return nil, xerrors.ValidationError("data exports can only be written to an active data storage")
+	}
+
+	create := svc.entFromContext(ctx).DataExport.Create().
+		SetProjectID(projectID).
+		SetResource(input.Resource).
+		SetFormat(input.Format).
+		SetIncludeBodies(input.IncludeBodies).
+		SetCriteria(criteria).
+		SetDataStorageID(ds.ID)
+
+	if u, ok := contexts.GetUser(ctx); ok && u != nil {
+		create.SetUserID(u.ID)
+	}
+
+	exp, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create data export: %w", err)
+	}
+
+	return exp, nil
+}
+
+// RunPendingExports runs the pending data exports one at a time, and returns the number of exports run.
+func (svc *DataExportService) RunPendingExports(ctx context.Context) (int, error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	bypassCtx := ent.NewContext(authz.WithSystemBypass(ctx, "data-export"), svc.db)
+
+	if _, err := svc.db.DataExport.Update().
+		Where(
+			dataexport.StatusEQ(dataexport.StatusRunning),
+			dataexport.StartedAtLT(xtime.UTCNow().Add(-dataExportRunTimeout)),
+		).
+		SetStatus(dataexport.StatusFailed).
+		SetErrorMessage("the export was abandoned").
+		SetCompletedAt(xtime.UTCNow()).
+		Save(bypassCtx); err != nil {
+		return 0, fmt.Errorf("failed to fail abandoned data exports: %w", err)
+	}
+
+	run := 0
+
+	for {
+		exp, err := svc.db.DataExport.Query().
+			Where(dataexport.StatusEQ(dataexport.StatusPending)).
+			Order(ent.Asc(dataexport.FieldID)).
+			First(bypassCtx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return run, nil
+			}
+
+			return run, fmt.Errorf("failed to query pending data exports: %w", err)
+		}
+
+		// Claim the export, another instance may have claimed it first.
+		claimed, err := svc.db.DataExport.Update().
+			Where(
+				dataexport.ID(exp.ID),
+				dataexport.StatusEQ(dataexport.StatusPending),
+			).
+			SetStatus(dataexport.StatusRunning).
+			SetStartedAt(xtime.UTCNow()).
+			Save(bypassCtx)
+		if err != nil {
+			return run, fmt.Errorf("failed to claim data export: %w", err)
+		}
+
+		if claimed == 0 {
+			continue
+		}
+
+		if err := svc.runExport(ctx, exp); err != nil {
+			return run, err
+		}
+
+		run++
+	}
+}
+
+// runExport runs a claimed export and records its outcome.
+func (svc *DataExportService) runExport(ctx context.Context, exp *ent.DataExport) error {
+	bypassCtx := ent.NewContext(authz.WithSystemBypass(ctx, "data-export"), svc.db)
+
+	key, rows, size, exportErr := svc.writeExport(ctx, exp)
+
+	update := svc.db.DataExport.UpdateOneID(exp.ID).SetCompletedAt(xtime.UTCNow())
+
+	if exportErr != nil {
+		log.Warn(ctx, "Data export failed", log.Int("data_export_id", exp.ID), log.Cause(exportErr))
+
+		update.SetStatus(dataexport.StatusFailed).SetErrorMessage(exportErr.Error())
+	} else {
+		update.SetStatus(dataexport.StatusCompleted).SetStorageKey(key).SetRowCount(rows).SetSize(size)
+	}
+
+	if err := update.Exec(bypassCtx); err != nil {
+		return fmt.Errorf("failed to update data export: %w", err)
+	}
+
+	return nil
+}
+
+// writeExport streams the records of the export to its data storage, and returns the key, the number of rows and the size.
+func (svc *DataExportService) writeExport(ctx context.Context, exp *ent.DataExport) (string, int64, int64, error) {
+	bypassCtx := ent.NewContext(authz.WithSystemBypass(ctx, "data-export"), svc.db)
+
+	ds, err := svc.exportDataStorage(bypassCtx, exp)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	queryCtx, err := svc.exportContext(ctx, exp)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	type result struct {
+		rows int64
+		err  error
+	}
+
+	pr, pw := io.Pipe()
+	done := make(chan result, 1)
+
+	go func() {
+		rows, err := svc.writeRecords(queryCtx, exp, pw)
+		_ = pw.CloseWithError(err)
+		done <- result{rows: rows, err: err}
+	}()
+
+	key := GenerateDataExportKey(exp.ProjectID, exp.ID, exp.Resource, exp.Format)
+
+	_, size, saveErr := svc.DataStorageService.SaveDataFromReader(bypassCtx, ds, key, pr)
+	// Unblock the writer when the storage stopped reading.
+	_ = pr.CloseWithError(saveErr)
+
+	written := <-done
+	if err := errors.Join(written.err, saveErr); err != nil {
+		if err := svc.DataStorageService.DeleteData(bypassCtx, ds, key); err != nil {
+			log.Warn(ctx, "Failed to delete the partial data export", log.Int("data_export_id", exp.ID), log.Cause(err))
+		}
+
+		// The writer error is the cause of the storage error, if any.
+		if written.err != nil {
+			return "", 0, 0, written.err
+		}
+
+		return "", 0, 0, saveErr
+	}
+
+	return key, written.rows, size, nil
+}
+
+func (svc *DataExportService) exportDataStorage(ctx context.Context, exp *ent.DataExport) (*ent.DataStorage, error) {
+	if exp.DataStorageID == nil {
+		return svc.DataStorageService.GetDefaultDataStorage(ctx)
+	}
+
+	return svc.DataStorageService.GetDataStorageByID(ctx, *exp.DataStorageID)
+}
+
+// exportContext returns the context the records of the export are read with: the user who created the export in its
+// project, so that the privacy rules select the records the user can read, or the system for the exports without a user.
+func (svc *DataExportService) exportContext(ctx context.Context, exp *ent.DataExport) (context.Context, error) {
+	ctx = ent.NewContext(ctx, svc.db)
+
+	if exp.UserID == nil {
+		return authz.WithSystemBypass(ctx, "data-export"), nil
+	}
+
+	u, err := svc.db.User.Query().
+		Where(user.ID(*exp.UserID)).
+		WithRoles().
+		WithProjectUsers().
+		Only(authz.WithSystemBypass(ctx, "data-export-user"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the user of the data export: %w", err)
+	}
+
+	if u.Status != user.StatusActivated {
+		return nil, errors.New("the user of the data export is not activated")
+	}
+
+	return contexts.WithProjectID(contexts.WithUser(ctx, u), exp.ProjectID), nil
+}
+
+func (svc *DataExportService) writeRecords(ctx context.Context, exp *ent.DataExport, w io.Writer) (int64, error) {
+	switch exp.Resource {
+	case dataexport.ResourceRequests:
+		return writeDataExportRows(exp.Format, w, svc.requestRows(ctx, exp))
+	case dataexport.ResourceUsageLogs:
+		return writeDataExportRows(exp.Format, w, svc.usageLogRows(ctx, exp))
+	default:
+		return 0, fmt.Errorf("unsupported data export resource: %s", exp.Resource)
+	}
+}
+
+// OpenDataExport opens the file of a completed data export for download.
+func (svc *DataExportService) OpenDataExport(ctx context.Context, exp *ent.DataExport) (io.ReadCloser, int64, error) {
+	if exp.Status != dataexport.StatusCompleted || exp.StorageKey == nil {
+		return nil, 0, xerrors.ValidationError("the data export is not completed")
+	}
+
+	bypassCtx := ent.NewContext(authz.WithSystemBypass(ctx, "data-export-download"), svc.db)
+
+	ds, err := svc.exportDataStorage(bypassCtx, exp)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return svc.DataStorageService.OpenData(bypassCtx, ds, *exp.StorageKey)
+}
+
+// dataExportRow is a row of a data export, the CSV columns are the ones of the JSON lines and the Parquet files.
+type dataExportRow interface {
+	csvHeader() []string
+	csvRecord() []string
+}
+
+// writeDataExportRows writes the batches of rows returned by next until an empty batch, and returns the number of rows.
+func writeDataExportRows[T dataExportRow](format dataexport.Format, w io.Writer, next func() ([]T, error)) (int64, error) {
+	var (
+		write func(rows []T) error
+		flush func() error
+	)
+
+	switch format {
+	case dataexport.FormatCsv:
+		cw := csv.NewWriter(w)
+
+		var header T
+		if err := cw.Write(header.csvHeader()); err != nil {
+			return 0, err
+		}
+
+		write = func(rows []T) error {
+			for _, row := range rows {
+				if err := cw.Write(row.csvRecord()); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+		flush = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+	case dataexport.FormatJsonl:
+		enc := json.NewEncoder(w)
+
+		write = func(rows []T) error {
+			for _, row := range rows {
+				if err := enc.Encode(row); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+		flush = func() error { return nil }
+	case dataexport.FormatParquet:
+		pw := parquet.NewGenericWriter[T](w)
+
+		write = func(rows []T) error {
+			_, err := pw.Write(rows)
+			return err
+		}
+		flush = pw.Close
+	default:
+		return 0, fmt.Errorf("unsupported data export format: %s", format)
+	}
+
+	var count int64
+
+	for {
+		rows, err := next()
+		if err != nil {
+			return count, err
+		}
+
+		if len(rows) == 0 {
+			break
+		}
+
+		if err := write(rows); err != nil {
+			return count, fmt.Errorf("failed to write data export rows: %w", err)
+		}
+
+		count += int64(len(rows))
+	}
+
+	if err := flush(); err != nil {
+		return count, fmt.Errorf("failed to write data export: %w", err)
+	}
+
+	return count, nil
+}
+
+// requestExportRow is an exported request, the bodies are only set when the export includes them.
+type requestExportRow struct {
+	ID                  int64                  `json:"id" parquet:"id"`
+	ProjectID           int64                  `json:"project_id" parquet:"project_id"`
+	CreatedAt           time.Time              `json:"created_at" parquet:"created_at,timestamp(millisecond)"`
+	APIKeyID            int64                  `json:"api_key_id,omitempty" parquet:"api_key_id"`
+	ChannelID           int64                  `json:"channel_id,omitempty" parquet:"channel_id"`
+	ModelID             string                 `json:"model_id" parquet:"model_id,dict"`
+	Format              string                 `json:"format" parquet:"format,dict"`
+	Source              string                 `json:"source" parquet:"source,dict"`
+	Status              string                 `json:"status" parquet:"status,dict"`
+	Stream              bool                   `json:"stream" parquet:"stream"`
+	EndUserID           string                 `json:"end_user_id,omitempty" parquet:"end_user_id"`
+	LatencyMs           int64                  `json:"latency_ms,omitempty" parquet:"latency_ms"`
+	FirstTokenLatencyMs int64                  `json:"first_token_latency_ms,omitempty" parquet:"first_token_latency_ms"`
+	RequestBody         objects.JSONRawMessage `json:"request_body,omitempty" parquet:"request_body,optional,zstd"`
+	ResponseBody        objects.JSONRawMessage `json:"response_body,omitempty" parquet:"response_body,optional,zstd"`
+}
+
+func (requestExportRow) csvHeader() []string {
+	return []string{
+		"id", "project_id", "created_at", "api_key_id", "channel_id", "model_id", "format", "source", "status",
+		"stream", "end_user_id", "latency_ms", "first_token_latency_ms", "request_body", "response_body",
+	}
+}
+
+func (r requestExportRow) csvRecord() []string {
+	return []string{
+		strconv.FormatInt(r.ID, 10),
+		strconv.FormatInt(r.ProjectID, 10),
+		r.CreatedAt.UTC().Format(time.RFC3339Nano),
+		strconv.FormatInt(r.APIKeyID, 10),
+		strconv.FormatInt(r.ChannelID, 10),
+		r.ModelID,
+		r.Format,
+		r.Source,
+		r.Status,
+		strconv.FormatBool(r.Stream),
+		r.EndUserID,
+		strconv.FormatInt(r.LatencyMs, 10),
+		strconv.FormatInt(r.FirstTokenLatencyMs, 10),
+		string(r.RequestBody),
+		string(r.ResponseBody),
+	}
+}
+
+func (svc *DataExportService) requestRows(ctx context.Context, exp *ent.DataExport) func() ([]requestExportRow, error) {
+	preds := []predicate.Request{request.ProjectID(exp.ProjectID)}
+
+	if exp.Criteria.From != nil {
+		preds = append(preds, request.CreatedAtGTE(*exp.Criteria.From))
+	}
+
+	if exp.Criteria.To != nil {
+		preds = append(preds, request.CreatedAtLT(*exp.Criteria.To))
+	}
+
+	if len(exp.Criteria.ModelIDs) > 0 {
+		preds = append(preds, request.ModelIDIn(exp.Criteria.ModelIDs...))
+	}
+
+	if len(exp.Criteria.APIKeyIDs) > 0 {
+		preds = append(preds, request.APIKeyIDIn(exp.Criteria.APIKeyIDs...))
+	}
+
+	lastID := 0
+
+	return func() ([]requestExportRow, error) {
+		query := svc.db.Request.Query().
+			Where(preds...).
+			Where(request.IDGT(lastID)).
+			Order(ent.Asc(request.FieldID)).
+			Limit(dataExportBatchSize)
+
+		if !exp.IncludeBodies {
+			query.Select(
+				request.FieldID,
+				request.FieldProjectID,
+				request.FieldCreatedAt,
+				request.FieldAPIKeyID,
+				request.FieldChannelID,
+				request.FieldModelID,
+				request.FieldFormat,
+				request.FieldSource,
+				request.FieldStatus,
+				request.FieldStream,
+				request.FieldEndUserID,
+				request.FieldMetricsLatencyMs,
+				request.FieldMetricsFirstTokenLatencyMs,
+			)
+		}
+
+		reqs, err := query.All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query requests: %w", err)
+		}
+
+		rows := make([]requestExportRow, 0, len(reqs))
+
+		for _, req := range reqs {
+			row := requestExportRow{
+				ID:                  int64(req.ID),
+				ProjectID:           int64(req.ProjectID),
+				CreatedAt:           req.CreatedAt,
+				APIKeyID:            int64(req.APIKeyID),
+				ChannelID:           int64(req.ChannelID),
+				ModelID:             req.ModelID,
+				Format:              req.Format,
+				Source:              req.Source.String(),
+				Status:              req.Status.String(),
+				Stream:              req.Stream,
+				EndUserID:           req.EndUserID,
+				LatencyMs:           lo.FromPtr(req.MetricsLatencyMs),
+				FirstTokenLatencyMs: lo.FromPtr(req.MetricsFirstTokenLatencyMs),
+			}
+
+			if exp.IncludeBodies {
+				if row.RequestBody, err = svc.RequestService.LoadRequestBody(ctx, req); err != nil {
+					return nil, err
+				}
+
+				if row.ResponseBody, err = svc.RequestService.LoadResponseBody(ctx, req); err != nil {
+					return nil, err
+				}
+			}
+
+			rows = append(rows, row)
+			lastID = req.ID
+		}
+
+		return rows, nil
+	}
+}
+
+// usageLogExportRow is an exported usage log.
+type usageLogExportRow struct {
+	ID                        int64     `json:"id" parquet:"id"`
+	RequestID                 int64     `json:"request_id" parquet:"request_id"`
+	ProjectID                 int64     `json:"project_id" parquet:"project_id"`
+	CreatedAt                 time.Time `json:"created_at" parquet:"created_at,timestamp(millisecond)"`
+	APIKeyID                  int64     `json:"api_key_id,omitempty" parquet:"api_key_id"`
+	ChannelID                 int64     `json:"channel_id,omitempty" parquet:"channel_id"`
+	ModelID                   string    `json:"model_id" parquet:"model_id,dict"`
+	Format                    string    `json:"format" parquet:"format,dict"`
+	Source                    string    `json:"source" parquet:"source,dict"`
+	EndUserID                 string    `json:"end_user_id,omitempty" parquet:"end_user_id"`
+	PromptTokens              int64     `json:"prompt_tokens" parquet:"prompt_tokens"`
+	PromptCachedTokens        int64     `json:"prompt_cached_tokens" parquet:"prompt_cached_tokens"`
+	PromptWriteCachedTokens   int64     `json:"prompt_write_cached_tokens" parquet:"prompt_write_cached_tokens"`
+	CompletionTokens          int64     `json:"completion_tokens" parquet:"completion_tokens"`
+	CompletionReasoningTokens int64     `json:"completion_reasoning_tokens" parquet:"completion_reasoning_tokens"`
+	TotalTokens               int64     `json:"total_tokens" parquet:"total_tokens"`
+	TotalCost                 float64   `json:"total_cost" parquet:"total_cost"`
+	Currency                  string    `json:"currency,omitempty" parquet:"currency,dict"`
+}
+
+func (usageLogExportRow) csvHeader() []string {
+	return []string{
+		"id", "request_id", "project_id", "created_at", "api_key_id", "channel_id", "model_id", "format", "source",
+		"end_user_id", "prompt_tokens", "prompt_cached_tokens", "prompt_write_cached_tokens", "completion_tokens",
+		"completion_reasoning_tokens", "total_tokens", "total_cost", "currency",
+	}
+}
+
+func (r usageLogExportRow) csvRecord() []string {
+	return []string{
+		strconv.FormatInt(r.ID, 10),
+		strconv.FormatInt(r.RequestID, 10),
+		strconv.FormatInt(r.ProjectID, 10),
+		r.CreatedAt.UTC().Format(time.RFC3339Nano),
+		strconv.FormatInt(r.APIKeyID, 10),
+		strconv.FormatInt(r.ChannelID, 10),
+		r.ModelID,
+		r.Format,
+		r.Source,
+		r.EndUserID,
+		strconv.FormatInt(r.PromptTokens, 10),
+		strconv.FormatInt(r.PromptCachedTokens, 10),
+		strconv.FormatInt(r.PromptWriteCachedTokens, 10),
+		strconv.FormatInt(r.CompletionTokens, 10),
+		strconv.FormatInt(r.CompletionReasoningTokens, 10),
+		strconv.FormatInt(r.TotalTokens, 10),
+		strconv.FormatFloat(r.TotalCost, 'f', -1, 64),
+		r.Currency,
+	}
+}
+
+func (svc *DataExportService) usageLogRows(ctx context.Context, exp *ent.DataExport) func() ([]usageLogExportRow, error) {
+	preds := []predicate.UsageLog{usagelog.ProjectID(exp.ProjectID)}
+
+	if exp.Criteria.From != nil {
+		preds = append(preds, usagelog.CreatedAtGTE(*exp.Criteria.From))
+	}
+
+	if exp.Criteria.To != nil {
+		preds = append(preds, usagelog.CreatedAtLT(*exp.Criteria.To))
+	}
+
+	if len(exp.Criteria.ModelIDs) > 0 {
+		preds = append(preds, usagelog.ModelIDIn(exp.Criteria.ModelIDs...))
+	}
+
+	if len(exp.Criteria.APIKeyIDs) > 0 {
+		preds = append(preds, usagelog.APIKeyIDIn(exp.Criteria.APIKeyIDs...))
+	}
+
+	lastID := 0
+
+	return func() ([]usageLogExportRow, error) {
+		logs, err := svc.db.UsageLog.Query().
+			Where(preds...).
+			Where(usagelog.IDGT(lastID)).
+			Order(ent.Asc(usagelog.FieldID)).
+			Limit(dataExportBatchSize).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query usage logs: %w", err)
+		}
+
+		rows := make([]usageLogExportRow, 0, len(logs))
+
+		for _, ul := range logs {
+			rows = append(rows, usageLogExportRow{
+				ID:                        int64(ul.ID),
+				RequestID:                 int64(ul.RequestID),
+				ProjectID:                 int64(ul.ProjectID),
+				CreatedAt:                 ul.CreatedAt,
+				APIKeyID:                  int64(ul.APIKeyID),
+				ChannelID:                 int64(ul.ChannelID),
+				ModelID:                   ul.ModelID,
+				Format:                    ul.Format,
+				Source:                    ul.Source.String(),
+				EndUserID:                 ul.EndUserID,
+				PromptTokens:              ul.PromptTokens,
+				PromptCachedTokens:        ul.PromptCachedTokens,
+				PromptWriteCachedTokens:   ul.PromptWriteCachedTokens,
+				CompletionTokens:          ul.CompletionTokens,
+				CompletionReasoningTokens: ul.CompletionReasoningTokens,
+				TotalTokens:               ul.TotalTokens,
+				TotalCost:                 lo.FromPtr(ul.TotalCost),
+				Currency:                  ul.Currency,
+			})
+			lastID = ul.ID
+		}
+
+		return rows, nil
+	}
+}
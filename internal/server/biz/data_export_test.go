@@ -0,0 +1,348 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/dataexport"
+	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+	"github.com/looplj/axonhub/internal/pkg/xerrors"
+	"github.com/looplj/axonhub/internal/scopes"
+)
+
+type dataExportTestEnv struct {
+	client     *ent.Client
+	svc        *DataExportService
+	ctx        context.Context
+	project    *ent.Project
+	member     *ent.User
+	fsStorage  *ent.DataStorage
+	dbStorage  *ent.DataStorage
+	otherKeyID int
+	sharedKey  int
+}
+
+func setupDataExportTest(t *testing.T) *dataExportTestEnv {
+	t.Helper()
+
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := authz.WithTestBypass(ent.NewContext(context.Background(), client))
+
+	dbStorage, err := client.DataStorage.Create().
+		SetName("Primary").
+		SetDescription("Primary database storage").
+		SetPrimary(true).
+		SetType(datastorage.TypeDatabase).
+		SetSettings(&objects.DataStorageSettings{}).
+		SetStatus(datastorage.StatusActive).
+		Save(ctx)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+
+	fsStorage, err := client.DataStorage.Create().
+		SetName("Exports").
+		SetDescription("Exports storage").
+		SetType(datastorage.TypeFs).
+		SetSettings(&objects.DataStorageSettings{Directory: &dir}).
+		SetStatus(datastorage.StatusActive).
+		Save(ctx)
+	require.NoError(t, err)
+
+	systemService := NewSystemService(SystemServiceParams{
+		CacheConfig: xcache.Config{},
+		Ent:         client,
+	})
+	dataStorageService := NewDataStorageService(DataStorageServiceParams{
+		SystemService: systemService,
+		CacheConfig:   xcache.Config{Mode: xcache.ModeMemory},
+		Client:        client,
+	})
+	requestService := NewRequestService(client, systemService.CacheConfig, systemService, nil, dataStorageService, NewLiveStreamRegistry())
+
+	proj, err := client.Project.Create().SetName("exports").Save(ctx)
+	require.NoError(t, err)
+
+	createUser := func(email string) *ent.User {
+		u, err := client.User.Create().SetEmail(email).SetPassword("password").Save(ctx)
+		require.NoError(t, err)
+
+		return u
+	}
+
+	member := createUser("member@example.com")
+	other := createUser("other@example.com")
+
+	_, err = client.UserProject.Create().
+		SetUserID(member.ID).
+		SetProjectID(proj.ID).
+		SetScopes([]string{string(scopes.ScopeReadRequests)}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	member, err = client.User.Query().Where(user.ID(member.ID)).WithRoles().WithProjectUsers().Only(ctx)
+	require.NoError(t, err)
+
+	createKey := func(name string, owner *ent.User, keyType apikey.Type) int {
+		key, err := client.APIKey.Create().
+			SetName(name).
+			SetKey("sk-" + name).
+			SetUserID(owner.ID).
+			SetProjectID(proj.ID).
+			SetType(keyType).
+			Save(ctx)
+		require.NoError(t, err)
+
+		return key.ID
+	}
+
+	sharedKey := createKey("shared", other, apikey.TypeUser)
+	otherKey := createKey("other-personal", other, apikey.TypePersonal)
+
+	base := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	for i, tc := range []struct {
+		model string
+		keyID int
+	}{
+		{"gpt-4o", sharedKey},
+		{"gpt-4o-mini", sharedKey},
+		{"gpt-4o", otherKey},
+		{"gpt-4o", sharedKey},
+	} {
+		req, err := client.Request.Create().
+			SetCreatedAt(base.Add(time.Duration(i) * time.Hour)).
+			SetProjectID(proj.ID).
+			SetAPIKeyID(tc.keyID).
+			SetModelID(tc.model).
+			SetRequestBody(objects.JSONRawMessage(`{"model":"` + tc.model + `"}`)).
+			SetResponseBody(objects.JSONRawMessage(`{"id":"resp"}`)).
+			SetStatus(request.StatusCompleted).
+			Save(ctx)
+		require.NoError(t, err)
+
+		_, err = client.UsageLog.Create().
+			SetCreatedAt(req.CreatedAt).
+			SetRequestID(req.ID).
+			SetProjectID(proj.ID).
+			SetAPIKeyID(tc.keyID).
+			SetModelID(tc.model).
+			SetPromptTokens(10).
+			SetCompletionTokens(5).
+			SetTotalTokens(15).
+			SetTotalCost(0.25).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	return &dataExportTestEnv{
+		client: client,
+		svc: NewDataExportService(DataExportServiceParams{
+			Ent:                client,
+			DataStorageService: dataStorageService,
+			RequestService:     requestService,
+		}),
+		ctx:        ctx,
+		project:    proj,
+		member:     member,
+		fsStorage:  fsStorage,
+		dbStorage:  dbStorage,
+		otherKeyID: otherKey,
+		sharedKey:  sharedKey,
+	}
+}
+
+// memberContext returns the context of a request of the project member, without the test bypass.
+func (env *dataExportTestEnv) memberContext() context.Context {
+	ctx := ent.NewContext(context.Background(), env.client)
+	return contexts.WithProjectID(contexts.WithUser(ctx, env.member), env.project.ID)
+}
+
+// runExport creates the export as the project member, runs it and returns the completed export and its content.
+func (env *dataExportTestEnv) runExport(t *testing.T, input CreateDataExportInput) (*ent.DataExport, []byte) {
+	t.Helper()
+
+	input.DataStorageID = &env.fsStorage.ID
+
+	exp, err := env.svc.CreateDataExport(env.memberContext(), env.project.ID, input)
+	require.NoError(t, err)
+	require.Equal(t, dataexport.StatusPending, exp.Status)
+	require.Equal(t, env.member.ID, *exp.UserID)
+
+	run, err := env.svc.RunPendingExports(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, run)
+
+	exp, err = env.client.DataExport.Get(env.ctx, exp.ID)
+	require.NoError(t, err)
+	require.Equal(t, dataexport.StatusCompleted, exp.Status, lo.FromPtr(exp.ErrorMessage))
+	require.NotNil(t, exp.CompletedAt)
+
+	f, size, err := env.svc.OpenDataExport(env.ctx, exp)
+	require.NoError(t, err)
+
+	defer f.Close()
+
+	content, err := io.ReadAll(f)
+	require.NoError(t, err)
+	require.EqualValues(t, size, len(content))
+	require.EqualValues(t, exp.Size, len(content))
+
+	return exp, content
+}
+
+func TestDataExportService_RequestsCSV(t *testing.T) {
+	env := setupDataExportTest(t)
+
+	exp, content := env.runExport(t, CreateDataExportInput{
+		Resource: dataexport.ResourceRequests,
+		Format:   dataexport.FormatCsv,
+		Criteria: &objects.DataExportCriteria{ModelIDs: []string{"gpt-4o"}},
+	})
+
+	records, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+	require.NoError(t, err)
+
+	// The personal API key request of the other user is not readable by the member.
+	require.EqualValues(t, 2, exp.RowCount)
+	require.Len(t, records, 3)
+	require.Equal(t, requestExportRow{}.csvHeader(), records[0])
+
+	for _, record := range records[1:] {
+		require.Equal(t, "gpt-4o", record[5])
+		require.Empty(t, record[13], "the bodies are not exported")
+	}
+}
+
+func TestDataExportService_RequestsJSONLWithBodies(t *testing.T) {
+	env := setupDataExportTest(t)
+
+	from := time.Date(2026, 5, 1, 11, 0, 0, 0, time.UTC)
+
+	_, content := env.runExport(t, CreateDataExportInput{
+		Resource:      dataexport.ResourceRequests,
+		Format:        dataexport.FormatJsonl,
+		IncludeBodies: true,
+		Criteria:      &objects.DataExportCriteria{From: &from, APIKeyIDs: []int{env.sharedKey}},
+	})
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 2)
+
+	var row requestExportRow
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &row))
+	require.Equal(t, "gpt-4o-mini", row.ModelID)
+	require.Equal(t, int64(env.sharedKey), row.APIKeyID)
+	require.JSONEq(t, `{"model":"gpt-4o-mini"}`, string(row.RequestBody))
+	require.JSONEq(t, `{"id":"resp"}`, string(row.ResponseBody))
+}
+
+func TestDataExportService_UsageLogsParquet(t *testing.T) {
+	env := setupDataExportTest(t)
+
+	exp, content := env.runExport(t, CreateDataExportInput{
+		Resource: dataexport.ResourceUsageLogs,
+		Format:   dataexport.FormatParquet,
+	})
+	require.EqualValues(t, 3, exp.RowCount)
+	require.True(t, strings.HasSuffix(*exp.StorageKey, "/usage_logs.parquet"))
+
+	rows, err := parquet.Read[usageLogExportRow](bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	for _, row := range rows {
+		require.NotEqual(t, int64(env.otherKeyID), row.APIKeyID)
+		require.EqualValues(t, 15, row.TotalTokens)
+		require.InDelta(t, 0.25, row.TotalCost, 1e-9)
+	}
+}
+
+func TestDataExportService_CreateDataExport(t *testing.T) {
+	env := setupDataExportTest(t)
+
+	testCases := []struct {
+		name  string
+		input CreateDataExportInput
+	}{
+		{
+			name: "usage logs with bodies",
+			input: CreateDataExportInput{
+				Resource:      dataexport.ResourceUsageLogs,
+				Format:        dataexport.FormatCsv,
+				IncludeBodies: true,
+				DataStorageID: &env.fsStorage.ID,
+			},
+		},
+		{
+			name: "database storage",
+			input: CreateDataExportInput{
+				Resource:      dataexport.ResourceRequests,
+				Format:        dataexport.FormatCsv,
+				DataStorageID: &env.dbStorage.ID,
+			},
+		},
+		{
+			name: "unsupported format",
+			input: CreateDataExportInput{
+				Resource:      dataexport.ResourceRequests,
+				Format:        "xlsx",
+				DataStorageID: &env.fsStorage.ID,
+			},
+		},
+		{
+			name: "empty range",
+			input: CreateDataExportInput{
+				Resource:      dataexport.ResourceRequests,
+				Format:        dataexport.FormatCsv,
+				Criteria:      &objects.DataExportCriteria{From: lo.ToPtr(time.Now()), To: lo.ToPtr(time.Now().Add(-time.Hour))},
+				DataStorageID: &env.fsStorage.ID,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := env.svc.CreateDataExport(env.memberContext(), env.project.ID, tc.input)
+			require.Error(t, err)
+
+			_, ok := xerrors.IsCodedError(err)
+			require.True(t, ok, err)
+		})
+	}
+
+	t.Run("other project", func(t *testing.T) {
+		other, err := env.client.Project.Create().SetName("other").Save(env.ctx)
+		require.NoError(t, err)
+
+		ctx := contexts.WithProjectID(contexts.WithUser(ent.NewContext(context.Background(), env.client), env.member), other.ID)
+
+		_, err = env.svc.CreateDataExport(ctx, other.ID, CreateDataExportInput{
+			Resource:      dataexport.ResourceRequests,
+			Format:        dataexport.FormatCsv,
+			DataStorageID: &env.fsStorage.ID,
+		})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,232 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/project"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// RedactedValue replaces the redacted fields of the stored bodies.
+const RedactedValue = "[REDACTED]"
+
+// The resource types of the project retention policy.
+const (
+	RetentionResourceRequests   = "requests"
+	RetentionResourceExecutions = "executions"
+	RetentionResourceUsageLogs  = "usage_logs"
+	RetentionResourceThreads    = "threads"
+	RetentionResourceTraces     = "traces"
+)
+
+// ProjectRetentionDays returns the retention days of the resource set by the project policy.
+// The executions, threads and traces fall back to the requests days, it returns false when the project inherits the system cleanup days.
+func ProjectRetentionDays(policy *objects.ProjectDataPolicy, resource string) (int, bool) {
+	if policy == nil || policy.Retention == nil {
+		return 0, false
+	}
+
+	retention := policy.Retention
+
+	var days *int
+
+	switch resource {
+	case RetentionResourceRequests:
+		days = retention.RequestsDays
+	case RetentionResourceExecutions:
+		days = retention.ExecutionsDays
+	case RetentionResourceUsageLogs:
+		days = retention.UsageLogsDays
+	case RetentionResourceThreads:
+		days = retention.ThreadsDays
+	case RetentionResourceTraces:
+		days = retention.TracesDays
+	}
+
+	if days == nil && resource != RetentionResourceRequests && resource != RetentionResourceUsageLogs {
+		days = retention.RequestsDays
+	}
+
+	if days == nil {
+		return 0, false
+	}
+
+	return *days, true
+}
+
+// RedactJSONFields replaces the values at the paths with RedactedValue, the paths missing from the body are ignored.
+func RedactJSONFields(body []byte, paths []string) []byte {
+	if len(body) == 0 || len(paths) == 0 || !gjson.ValidBytes(body) {
+		return body
+	}
+
+	for _, path := range paths {
+		for _, resolved := range resolveRedactPath(body, strings.Split(path, ".")) {
+			redacted, err := sjson.SetBytes(body, resolved, RedactedValue)
+			if err != nil {
+				continue
+			}
+
+			body = redacted
+		}
+	}
+
+	return body
+}
+
+// resolveRedactPath expands the "#" and "*" segments into the paths of the matching values of the body.
+func resolveRedactPath(body []byte, segments []string) []string {
+	paths := []string{""}
+
+	for _, segment := range segments {
+		var next []string
+
+		for _, prefix := range paths {
+			if segment != "#" && segment != "*" {
+				next = append(next, joinRedactPath(prefix, segment))
+				continue
+			}
+
+			parent := gjson.ParseBytes(body)
+			if prefix != "" {
+				parent = gjson.GetBytes(body, prefix)
+			}
+
+			switch {
+			case segment == "#" && parent.IsArray():
+				for i := range len(parent.Array()) {
+					next = append(next, joinRedactPath(prefix, strconv.Itoa(i)))
+				}
+			case segment == "*" && parent.IsObject():
+				parent.ForEach(func(key, _ gjson.Result) bool {
+					next = append(next, joinRedactPath(prefix, gjson.Escape(key.String())))
+					return true
+				})
+			}
+		}
+
+		paths = next
+	}
+
+	resolved := paths[:0]
+
+	for _, path := range paths {
+		if gjson.GetBytes(body, path).Exists() {
+			resolved = append(resolved, path)
+		}
+	}
+
+	return resolved
+}
+
+func joinRedactPath(prefix, segment string) string {
+	if prefix == "" {
+		return segment
+	}
+
+	return prefix + "." + segment
+}
+
+// contentCapture is the content capture policy of a project, the system storage policy overridden by the project data policy.
+type contentCapture struct {
+	StoreRequestBody  bool
+	StoreResponseBody bool
+	StoreChunks       bool
+	RedactFields      []string
+	DataStorageID     *int
+}
+
+// redact applies the redaction of the project to the body.
+func (c contentCapture) redact(body []byte) []byte {
+	return RedactJSONFields(body, c.RedactFields)
+}
+
+// contentCapture resolves the content capture policy of the project.
+func (s *RequestService) contentCapture(ctx context.Context, projectID int) contentCapture {
+	capture := contentCapture{
+		StoreRequestBody:  true,
+		StoreResponseBody: true,
+	}
+
+	if policy, err := s.SystemService.StoragePolicy(ctx); err == nil {
+		capture.StoreRequestBody = policy.StoreRequestBody
+		capture.StoreResponseBody = policy.StoreResponseBody
+		capture.StoreChunks = policy.StoreChunks
+	} else {
+		log.Warn(ctx, "Failed to get storage policy, defaulting to store request and response body", log.Cause(err))
+	}
+
+	dataPolicy := s.projectDataPolicy(ctx, projectID)
+	if dataPolicy == nil {
+		return capture
+	}
+
+	if dataPolicy.StoreRequestBody != nil {
+		capture.StoreRequestBody = *dataPolicy.StoreRequestBody
+	}
+
+	if dataPolicy.StoreResponseBody != nil {
+		capture.StoreResponseBody = *dataPolicy.StoreResponseBody
+	}
+
+	capture.RedactFields = dataPolicy.RedactFields
+	capture.DataStorageID = dataPolicy.DataStorageID
+
+	// The chunks carry the response content, they can not be redacted field by field.
+	if !capture.StoreResponseBody || len(capture.RedactFields) > 0 {
+		capture.StoreChunks = false
+	}
+
+	return capture
+}
+
+// projectDataPolicy returns the data policy of the project, the project of the API key is used when it is the same project.
+func (s *RequestService) projectDataPolicy(ctx context.Context, projectID int) *objects.ProjectDataPolicy {
+	if projectID == 0 {
+		return nil
+	}
+
+	if apiKey, ok := contexts.GetAPIKey(ctx); ok && apiKey != nil && apiKey.Edges.Project != nil && apiKey.Edges.Project.ID == projectID {
+		return apiKey.Edges.Project.DataPolicy
+	}
+
+	proj, err := s.entFromContext(ctx).Project.Query().
+		Where(project.ID(projectID)).
+		Select(project.FieldDataPolicy).
+		Only(authz.WithSystemBypass(ctx, "request-project-data-policy"))
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			log.Warn(ctx, "Failed to get project data policy", log.Cause(err), log.Int("project_id", projectID))
+		}
+
+		return nil
+	}
+
+	return proj.DataPolicy
+}
+
+// dataStorage returns the data storage of the project, or the default data storage.
+func (s *RequestService) dataStorage(ctx context.Context, capture contentCapture) (*ent.DataStorage, error) {
+	if capture.DataStorageID != nil {
+		ds, err := s.DataStorageService.GetDataStorageByID(authz.WithSystemBypass(ctx, "request-project-data-storage"), *capture.DataStorageID)
+		if err == nil && ds.Status == datastorage.StatusActive {
+			return ds, nil
+		}
+
+		log.Warn(ctx, "Project data storage is not available, falling back to the default data storage",
+			log.Cause(err),
+			log.Int("data_storage_id", *capture.DataStorageID))
+	}
+
+	return s.DataStorageService.GetDefaultDataStorage(ctx)
+}
@@ -0,0 +1,170 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+	"github.com/looplj/axonhub/llm"
+	"github.com/looplj/axonhub/llm/httpclient"
+)
+
+func TestRedactJSONFields(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		paths    []string
+		expected string
+	}{
+		{
+			name:     "array elements",
+			body:     `{"model":"gpt-4o","messages":[{"role":"user","content":"secret"},{"role":"assistant","content":[{"type":"text","text":"secret"}]}]}`,
+			paths:    []string{"messages.#.content"},
+			expected: `{"model":"gpt-4o","messages":[{"role":"user","content":"[REDACTED]"},{"role":"assistant","content":"[REDACTED]"}]}`,
+		},
+		{
+			name:     "object members with dotted keys",
+			body:     `{"metadata":{"user.email":"a@example.com","ip":"127.0.0.1"},"input":"hi"}`,
+			paths:    []string{"metadata.*"},
+			expected: `{"metadata":{"user.email":"[REDACTED]","ip":"[REDACTED]"},"input":"hi"}`,
+		},
+		{
+			name:     "nested wildcards",
+			body:     `{"choices":[{"message":{"content":"secret","tool_calls":[{"function":{"arguments":"{}"}}]}}]}`,
+			paths:    []string{"choices.#.message.tool_calls.#.function.arguments", "choices.#.message.content"},
+			expected: `{"choices":[{"message":{"content":"[REDACTED]","tool_calls":[{"function":{"arguments":"[REDACTED]"}}]}}]}`,
+		},
+		{
+			name:     "missing paths",
+			body:     `{"input":"hi"}`,
+			paths:    []string{"messages.#.content", "metadata.*", "instructions"},
+			expected: `{"input":"hi"}`,
+		},
+		{
+			name:     "invalid json",
+			body:     `not json`,
+			paths:    []string{"input"},
+			expected: `not json`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			redacted := RedactJSONFields([]byte(tc.body), tc.paths)
+			if tc.expected == tc.body {
+				require.Equal(t, tc.expected, string(redacted))
+			} else {
+				require.JSONEq(t, tc.expected, string(redacted))
+			}
+		})
+	}
+}
+
+func TestRequestService_ProjectDataPolicy(t *testing.T) {
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := authz.WithTestBypass(ent.NewContext(context.Background(), client))
+
+	systemService := NewSystemService(SystemServiceParams{CacheConfig: xcache.Config{}, Ent: client})
+	require.NoError(t, systemService.SetStoragePolicy(ctx, &StoragePolicy{
+		StoreChunks:       true,
+		StoreRequestBody:  true,
+		StoreResponseBody: true,
+	}))
+
+	dataStorageService := NewDataStorageService(DataStorageServiceParams{
+		SystemService: systemService,
+		CacheConfig:   xcache.Config{Mode: xcache.ModeMemory},
+		Client:        client,
+	})
+	requestService := NewRequestService(client, systemService.CacheConfig, systemService, nil, dataStorageService, NewLiveStreamRegistry())
+
+	dir := t.TempDir()
+
+	fsStorage, err := client.DataStorage.Create().
+		SetName("project-fs").
+		SetDescription("project storage").
+		SetType(datastorage.TypeFs).
+		SetSettings(&objects.DataStorageSettings{Directory: &dir}).
+		SetStatus(datastorage.StatusActive).
+		Save(ctx)
+	require.NoError(t, err)
+
+	requestBody := []byte(`{"model":"gpt-4o","stream":true,"messages":[{"role":"user","content":"my secret"}]}`)
+	responseBody := []byte(`{"id":"resp","choices":[{"message":{"role":"assistant","content":"your secret"}}]}`)
+	chunks := []*httpclient.StreamEvent{{Data: []byte(`{"choices":[{"delta":{"content":"your secret"}}]}`)}}
+
+	persist := func(t *testing.T, policy *objects.ProjectDataPolicy) *ent.Request {
+		t.Helper()
+
+		proj, err := client.Project.Create().SetName(t.Name()).SetDataPolicy(policy).Save(ctx)
+		require.NoError(t, err)
+
+		projectCtx := contexts.WithProjectID(ctx, proj.ID)
+
+		req, err := requestService.CreateRequest(projectCtx,
+			&llm.Request{Model: "gpt-4o", Stream: lo.ToPtr(true)},
+			&httpclient.Request{JSONBody: requestBody, Headers: map[string][]string{"X-Test": {"1"}}},
+			llm.APIFormatOpenAIChatCompletion,
+		)
+		require.NoError(t, err)
+		require.NoError(t, requestService.UpdateRequestCompleted(projectCtx, req.ID, "resp", objects.JSONRawMessage(responseBody), nil))
+		require.NoError(t, requestService.SaveRequestChunks(projectCtx, req.ID, chunks))
+
+		req, err = client.Request.Get(ctx, req.ID)
+		require.NoError(t, err)
+
+		return req
+	}
+
+	t.Run("system policy", func(t *testing.T) {
+		req := persist(t, nil)
+
+		require.JSONEq(t, string(requestBody), string(req.RequestBody))
+		require.JSONEq(t, string(responseBody), string(req.ResponseBody))
+		require.Len(t, req.ResponseChunks, 1)
+	})
+
+	t.Run("no content", func(t *testing.T) {
+		req := persist(t, &objects.ProjectDataPolicy{
+			StoreRequestBody:  lo.ToPtr(false),
+			StoreResponseBody: lo.ToPtr(false),
+		})
+
+		require.JSONEq(t, `{}`, string(req.RequestBody))
+		require.JSONEq(t, `{}`, string(req.RequestHeaders))
+		require.Empty(t, req.ResponseBody)
+		require.Empty(t, req.ResponseChunks)
+	})
+
+	t.Run("redacted in project storage", func(t *testing.T) {
+		req := persist(t, &objects.ProjectDataPolicy{
+			RedactFields:  []string{"messages.#.content", "choices.#.message.content"},
+			DataStorageID: &fsStorage.ID,
+		})
+
+		require.Equal(t, fsStorage.ID, req.DataStorageID)
+
+		storedRequest, err := requestService.LoadRequestBody(ctx, req)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"model":"gpt-4o","stream":true,"messages":[{"role":"user","content":"[REDACTED]"}]}`, string(storedRequest))
+
+		storedResponse, err := requestService.LoadResponseBody(ctx, req)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"id":"resp","choices":[{"message":{"role":"assistant","content":"[REDACTED]"}}]}`, string(storedResponse))
+
+		// The chunks can not be redacted, so they are not stored.
+		_, err = dataStorageService.LoadData(ctx, fsStorage, GenerateResponseChunksKey(req.ProjectID, req.ID))
+		require.Error(t, err)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -53,6 +54,8 @@ type DataStorageService struct {
 	objectStoreCache map[int]ObjectStore
 	fsCacheMu        sync.RWMutex
 	latestUpdate     time.Time
+
+	archiveEntryCache requestArchiveEntryCache
 }
 
 // DataStorageServiceParams holds the dependencies for DataStorageService.
@@ -138,11 +141,14 @@ func (s *DataStorageService) refreshFileSystems(ctx context.Context) error {
 	}
 
 	s.fsCacheMu.Lock()
+	oldCache := s.fsCache
 	s.fsCache = newCache
 	// Drop cached native object-store clients so changed configs are rebuilt lazily.
 	s.objectStoreCache = make(map[int]ObjectStore)
 	s.fsCacheMu.Unlock()
 
+	closeFileSystems(lo.Values(oldCache)...)
+
 	log.Info(ctx, "refreshed data storage filesystems", log.Int("count", len(newCache)))
 
 	return nil
@@ -190,6 +196,28 @@ func (s *DataStorageService) buildFileSystem(ctx context.Context, ds *ent.DataSt
 			return nil, fmt.Errorf("failed to create webdav filesystem: %w", err)
 		}
 
+		return fs, nil
+	case datastorage.TypeAzblob:
+		if ds.Settings == nil || ds.Settings.AzureBlob == nil {
+			return nil, fmt.Errorf("azure blob settings not configured")
+		}
+
+		store, err := newAzureBlobObjectStore(ds.Settings.AzureBlob)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create azure blob filesystem: %w", err)
+		}
+
+		return newAzureBlobFs(store), nil
+	case datastorage.TypeSftp:
+		if ds.Settings == nil || ds.Settings.SFTP == nil {
+			return nil, fmt.Errorf("sftp settings not configured")
+		}
+
+		fs, err := newSFTPFs(ds.Settings.SFTP)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create sftp filesystem: %w", err)
+		}
+
 		return fs, nil
 	default:
 		return nil, fmt.Errorf("unsupported storage type: %s", ds.Type)
@@ -375,10 +403,13 @@ func (s *DataStorageService) InvalidatePrimaryDataStorageCache(ctx context.Conte
 func (s *DataStorageService) InvalidateAllDataStorageCache(ctx context.Context) error {
 	// Also clear filesystem cache
 	s.fsCacheMu.Lock()
+	oldCache := s.fsCache
 	s.fsCache = make(map[int]afero.Fs)
 	s.objectStoreCache = make(map[int]ObjectStore)
 	s.fsCacheMu.Unlock()
 
+	closeFileSystems(lo.Values(oldCache)...)
+
 	s.latestUpdate = time.Time{}
 
 	return s.Cache.Clear(ctx)
@@ -387,13 +418,26 @@ func (s *DataStorageService) InvalidateAllDataStorageCache(ctx context.Context)
 // InvalidateFsCache invalidates the cached filesystem for a specific data storage.
 func (s *DataStorageService) InvalidateFsCache(id int) error {
 	s.fsCacheMu.Lock()
+	fs := s.fsCache[id]
 	delete(s.fsCache, id)
 	delete(s.objectStoreCache, id)
 	s.fsCacheMu.Unlock()
 
+	closeFileSystems(fs)
+
 	return nil
 }
 
+// closeFileSystems closes the connections held by the filesystems removed from the cache (SFTP).
+// An operation still running on a closed filesystem fails, the next one uses the rebuilt filesystem.
+func closeFileSystems(fss ...afero.Fs) {
+	for _, fs := range fss {
+		if closer, ok := fs.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+}
+
 // newS3Client builds an aws-sdk-go-v2 S3 client from the given config. It uses
 // an adaptive retryer (client-side rate limiting + backoff/jitter) so throttling
 // (HTTP 503 SlowDown) does not trigger a retry storm that multiplies Class A
@@ -532,8 +576,8 @@ func (s *DataStorageService) SaveData(ctx context.Context, ds *ent.DataStorage,
 	switch ds.Type {
 	case datastorage.TypeDatabase:
 		return nil
-	case datastorage.TypeFs, datastorage.TypeS3, datastorage.TypeGcs, datastorage.TypeWebdav:
-		// Object-store backends (S3) write in a single PutObject via a native
+	case datastorage.TypeFs, datastorage.TypeS3, datastorage.TypeGcs, datastorage.TypeWebdav, datastorage.TypeAzblob, datastorage.TypeSftp:
+		// Object-store backends (S3, Azure Blob) write in a single PutObject via a native
 		// client; other backends fall back to the afero filesystem path below.
 		if store, ok, err := s.objectStoreFor(ctx, ds); err != nil {
 			return err
@@ -561,6 +605,10 @@ func (s *DataStorageService) SaveData(ctx context.Context, ds *ent.DataStorage,
 			if err != nil {
 				return fmt.Errorf("failed to create directory: %w, key: %s", err, key)
 			}
+		} else if ds.Type == datastorage.TypeSftp {
+			if err := fs.MkdirAll(path.Dir(key), 0o777); err != nil {
+				return fmt.Errorf("failed to create directory: %w, key: %s", err, key)
+			}
 		}
 
 		// For S3 with PathStyle enabled, remove leading slash from key
@@ -590,8 +638,8 @@ func (s *DataStorageService) SaveDataFromReader(ctx context.Context, ds *ent.Dat
 	switch ds.Type {
 	case datastorage.TypeDatabase:
 		return "", 0, fmt.Errorf("database storage does not support streaming writes")
-	case datastorage.TypeFs, datastorage.TypeS3, datastorage.TypeGcs, datastorage.TypeWebdav:
-		// Object-store backends (S3) stream via a native uploader with a tuned
+	case datastorage.TypeFs, datastorage.TypeS3, datastorage.TypeGcs, datastorage.TypeWebdav, datastorage.TypeAzblob, datastorage.TypeSftp:
+		// Object-store backends (S3, Azure Blob) stream via a native uploader with a tuned
 		// part size; other backends fall back to the afero filesystem path below.
 		if store, ok, err := s.objectStoreFor(ctx, ds); err != nil {
 			return "", 0, err
@@ -622,6 +670,10 @@ func (s *DataStorageService) SaveDataFromReader(ctx context.Context, ds *ent.Dat
 			if err := s.mkdirAll(fs, filepath.Dir(key)); err != nil {
 				return "", 0, fmt.Errorf("failed to create directory: %w, key: %s", err, key)
 			}
+		} else if ds.Type == datastorage.TypeSftp {
+			if err := fs.MkdirAll(path.Dir(key), 0o777); err != nil {
+				return "", 0, fmt.Errorf("failed to create directory: %w, key: %s", err, key)
+			}
 		} else if isS3PathStyle(ds) {
 			key = strings.TrimPrefix(key, "/")
 		}
@@ -654,8 +706,8 @@ func (s *DataStorageService) DeleteData(ctx context.Context, ds *ent.DataStorage
 	switch ds.Type {
 	case datastorage.TypeDatabase:
 		return nil
-	case datastorage.TypeFs, datastorage.TypeS3, datastorage.TypeGcs, datastorage.TypeWebdav:
-		// Object-store backends (S3) delete with a single idempotent DeleteObject
+	case datastorage.TypeFs, datastorage.TypeS3, datastorage.TypeGcs, datastorage.TypeWebdav, datastorage.TypeAzblob, datastorage.TypeSftp:
+		// Object-store backends (S3, Azure Blob) delete with a single idempotent DeleteObject
 		// (no pre-Stat / ListObjectsV2); other backends fall back to afero below.
 		if store, ok, err := s.objectStoreFor(ctx, ds); err != nil {
 			return err
@@ -698,8 +750,8 @@ func (s *DataStorageService) LoadData(ctx context.Context, ds *ent.DataStorage,
 	case datastorage.TypeDatabase:
 		// For database storage, the key is the data itself
 		return []byte(key), nil
-	case datastorage.TypeFs, datastorage.TypeS3, datastorage.TypeGcs, datastorage.TypeWebdav:
-		// Object-store backends (S3) read with a single GetObject; a missing key
+	case datastorage.TypeFs, datastorage.TypeS3, datastorage.TypeGcs, datastorage.TypeWebdav, datastorage.TypeAzblob, datastorage.TypeSftp:
+		// Object-store backends (S3, Azure Blob) read with a single GetObject; a missing key
 		// returns os.ErrNotExist (no ListObjectsV2). Others fall back to afero.
 		if store, ok, err := s.objectStoreFor(ctx, ds); err != nil {
 			return nil, err
@@ -732,6 +784,47 @@ func (s *DataStorageService) LoadData(ctx context.Context, ds *ent.DataStorage,
 	}
 }
 
+// OpenData opens the data stored under the key for streaming, and returns its size.
+// Database storage is not supported because it does not store data under keys.
+func (s *DataStorageService) OpenData(ctx context.Context, ds *ent.DataStorage, key string) (io.ReadCloser, int64, error) {
+	switch ds.Type {
+	case datastorage.TypeDatabase:
+		return nil, 0, fmt.Errorf("database storage does not support streaming reads")
+	case datastorage.TypeFs, datastorage.TypeS3, datastorage.TypeGcs, datastorage.TypeWebdav, datastorage.TypeAzblob, datastorage.TypeSftp:
+		if store, ok, err := s.objectStoreFor(ctx, ds); err != nil {
+			return nil, 0, err
+		} else if ok {
+			return store.OpenObject(ctx, normalizeObjectKey(key))
+		}
+
+		fs, err := s.GetFileSystem(ctx, ds)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get file system: %w", err)
+		}
+
+		if ds.Type == datastorage.TypeFs {
+			key = filepath.FromSlash(key)
+		} else if isS3PathStyle(ds) {
+			key = strings.TrimPrefix(key, "/")
+		}
+
+		f, err := fs.Open(key)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to open file: %w", err)
+		}
+
+		info, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return nil, 0, fmt.Errorf("failed to stat file: %w", err)
+		}
+
+		return f, info.Size(), nil
+	default:
+		return nil, 0, fmt.Errorf("unsupported storage type: %s", ds.Type)
+	}
+}
+
 // isS3Provided checks if any S3 field is provided in the input (non-empty).
 func isS3Provided(s3 *objects.S3) bool {
 	if s3 == nil {
@@ -855,6 +948,67 @@ func (s *DataStorageService) mergeSettings(existing, input *objects.DataStorageS
 		*merged.WebDAV = *existing.WebDAV
 	}
 
+	// Merge Azure Blob settings
+	if isAzureBlobProvided(input.AzureBlob) {
+		// Input Azure Blob is provided, merge fields
+		merged.AzureBlob = &objects.AzureBlob{
+			AccountName:   input.AzureBlob.AccountName,
+			ContainerName: input.AzureBlob.ContainerName,
+			Endpoint:      input.AzureBlob.Endpoint,
+			AuthType:      input.AzureBlob.AuthType,
+			ClientID:      input.AzureBlob.ClientID,
+		}
+
+		// Only update sensitive fields if they are non-empty
+		if input.AzureBlob.AccountKey != "" {
+			merged.AzureBlob.AccountKey = input.AzureBlob.AccountKey
+		} else if existing.AzureBlob != nil {
+			merged.AzureBlob.AccountKey = existing.AzureBlob.AccountKey
+		}
+
+		if input.AzureBlob.SASToken != "" {
+			merged.AzureBlob.SASToken = input.AzureBlob.SASToken
+		} else if existing.AzureBlob != nil {
+			merged.AzureBlob.SASToken = existing.AzureBlob.SASToken
+		}
+	} else if existing.AzureBlob != nil {
+		// No Azure Blob input provided, preserve existing Azure Blob config
+		merged.AzureBlob = &objects.AzureBlob{}
+		*merged.AzureBlob = *existing.AzureBlob
+	}
+
+	// Merge SFTP settings
+	if isSFTPProvided(input.SFTP) {
+		// Input SFTP is provided, merge fields
+		merged.SFTP = &objects.SFTP{
+			Host:                input.SFTP.Host,
+			Port:                input.SFTP.Port,
+			Username:            input.SFTP.Username,
+			HostKey:             input.SFTP.HostKey,
+			InsecureSkipHostKey: input.SFTP.InsecureSkipHostKey,
+			Path:                input.SFTP.Path,
+		}
+
+		// Only update sensitive fields if they are non-empty
+		if input.SFTP.Password != "" {
+			merged.SFTP.Password = input.SFTP.Password
+		} else if existing.SFTP != nil {
+			merged.SFTP.Password = existing.SFTP.Password
+		}
+
+		if input.SFTP.PrivateKey != "" {
+			merged.SFTP.PrivateKey = input.SFTP.PrivateKey
+			merged.SFTP.PrivateKeyPassphrase = input.SFTP.PrivateKeyPassphrase
+		} else if existing.SFTP != nil {
+			merged.SFTP.PrivateKey = existing.SFTP.PrivateKey
+			merged.SFTP.PrivateKeyPassphrase = existing.SFTP.PrivateKeyPassphrase
+		}
+	} else if existing.SFTP != nil {
+		// No SFTP input provided, preserve existing SFTP config
+		merged.SFTP = &objects.SFTP{}
+		*merged.SFTP = *existing.SFTP
+	}
+
 	return merged
 }
 
@@ -867,6 +1021,25 @@ func isWebDAVProvided(webdav *objects.WebDAV) bool {
 	return webdav.URL != "" || webdav.Username != "" || webdav.Password != "" || webdav.Path != ""
 }
 
+// isAzureBlobProvided checks if any Azure Blob field is provided in the input (non-empty).
+func isAzureBlobProvided(azureBlob *objects.AzureBlob) bool {
+	if azureBlob == nil {
+		return false
+	}
+
+	return azureBlob.AccountName != "" || azureBlob.ContainerName != "" || azureBlob.Endpoint != "" ||
+		azureBlob.AccountKey != "" || azureBlob.SASToken != ""
+}
+
+// isSFTPProvided checks if any SFTP field is provided in the input (non-empty).
+func isSFTPProvided(sftp *objects.SFTP) bool {
+	if sftp == nil {
+		return false
+	}
+
+	return sftp.Host != "" || sftp.Username != "" || sftp.Password != "" || sftp.PrivateKey != ""
+}
+
 func (s *DataStorageService) mkdirAll(fs afero.Fs, dir string) error {
 	if dir == "." || dir == "/" || dir == "" {
 		return nil
@@ -0,0 +1,149 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+const dataStorageConnectionTestTimeout = 30 * time.Second
+
+// DataStorageConnectionTestResult is the result of a data storage connection test.
+type DataStorageConnectionTestResult struct {
+	Success   bool    `json:"success"`
+	LatencyMs int     `json:"latencyMs"`
+	Message   *string `json:"message,omitempty"`
+}
+
+// TestDataStorageConnectionInput selects the data storage to test.
+// With an ID, the saved settings are merged with the input settings, like an update.
+type TestDataStorageConnectionInput struct {
+	ID       *int
+	Type     *datastorage.Type
+	Settings *objects.DataStorageSettings
+}
+
+// TestConnection writes, reads back and deletes a test object on the data storage.
+// The storage is built from the settings without the caches, so unsaved settings can be tested.
+// A failing storage is reported in the result, the error is only returned for an invalid input.
+func (s *DataStorageService) TestConnection(ctx context.Context, input TestDataStorageConnectionInput) (*DataStorageConnectionTestResult, error) {
+	ds := &ent.DataStorage{}
+
+	switch {
+	case input.ID != nil:
+		existing, err := ent.FromContext(ctx).DataStorage.Get(ctx, *input.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get data storage: %w", err)
+		}
+
+		if input.Type != nil && *input.Type != existing.Type {
+			return nil, fmt.Errorf("data storage type can not be changed")
+		}
+
+		ds.ID = existing.ID
+		ds.Type = existing.Type
+		ds.Settings = existing.Settings
+
+		if input.Settings != nil {
+			ds.Settings = s.mergeSettings(existing.Settings, input.Settings)
+		}
+	case input.Type != nil && input.Settings != nil:
+		ds.Type = *input.Type
+		ds.Settings = input.Settings
+	default:
+		return nil, fmt.Errorf("data storage id, or type and settings are required")
+	}
+
+	start := time.Now()
+	err := s.probe(ctx, ds)
+	result := &DataStorageConnectionTestResult{
+		Success:   err == nil,
+		LatencyMs: int(time.Since(start).Milliseconds()),
+	}
+
+	if err != nil {
+		log.Warn(ctx, "data storage connection test failed",
+			log.Int("data_storage_id", ds.ID),
+			log.String("type", ds.Type.String()),
+			log.Cause(err),
+		)
+
+		result.Message = lo.ToPtr(err.Error())
+	}
+
+	return result, nil
+}
+
+// probe writes, reads back and deletes a test object at the root of the data storage.
+func (s *DataStorageService) probe(ctx context.Context, ds *ent.DataStorage) error {
+	if ds.Type == datastorage.TypeDatabase {
+		// The database storage is the system database, it is connected if the server runs.
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dataStorageConnectionTestTimeout)
+	defer cancel()
+
+	key := fmt.Sprintf("axonhub-connection-test-%d.txt", time.Now().UnixNano())
+	data := []byte("axonhub data storage connection test")
+
+	if hasObjectStore(ds.Type) {
+		store, err := newObjectStore(ctx, ds)
+		if err != nil {
+			return err
+		}
+
+		if err := store.PutObject(ctx, key, data); err != nil {
+			return fmt.Errorf("failed to write test object: %w", err)
+		}
+
+		got, err := store.GetObject(ctx, key)
+		if err != nil {
+			return fmt.Errorf("failed to read test object: %w", err)
+		}
+
+		if !bytes.Equal(got, data) {
+			return fmt.Errorf("test object read back does not match")
+		}
+
+		if err := store.DeleteObject(ctx, key); err != nil {
+			return fmt.Errorf("failed to delete test object: %w", err)
+		}
+
+		return nil
+	}
+
+	fs, err := s.buildFileSystem(ctx, ds)
+	if err != nil {
+		return err
+	}
+	defer closeFileSystems(fs)
+
+	if err := afero.WriteFile(fs, key, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write test object: %w", err)
+	}
+
+	got, err := afero.ReadFile(fs, key)
+	if err != nil {
+		return fmt.Errorf("failed to read test object: %w", err)
+	}
+
+	if !bytes.Equal(got, data) {
+		return fmt.Errorf("test object read back does not match")
+	}
+
+	if err := fs.Remove(key); err != nil {
+		return fmt.Errorf("failed to delete test object: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,702 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"go.uber.org/fx"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/exchangerate"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/watcher"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+	"github.com/looplj/axonhub/internal/pkg/xcache/live"
+	"github.com/looplj/axonhub/internal/pkg/xerrors"
+	"github.com/looplj/axonhub/internal/pkg/xtime"
+	"github.com/looplj/axonhub/llm/httpclient"
+)
+
+const (
+	ExchangeRateImportFormatCSV  = "csv"
+	ExchangeRateImportFormatJSON = "json"
+)
+
+// maxExchangeRateImportRates limits the rates of an import, the table is loaded into memory.
+const maxExchangeRateImportRates = 1000
+
+type ExchangeRateServiceParams struct {
+	fx.In
+
+	CacheConfig   xcache.Config
+	Ent           *ent.Client
+	SystemService *SystemService
+	HttpClient    *httpclient.HttpClient
+}
+
+// ExchangeRateService manages the exchange rate table and converts the costs into the reporting currency,
+// the currency code of the general settings.
+type ExchangeRateService struct {
+	*AbstractService
+
+	SystemService *SystemService
+	httpClient    *httpclient.HttpClient
+
+	ratesCache    *live.Cache[exchangeRateTable]
+	ratesNotifier watcher.Notifier[live.CacheEvent[struct{}]]
+}
+
+type currencyPair struct {
+	From string
+	To   string
+}
+
+// exchangeRateTable holds the latest effective rate of each currency pair.
+type exchangeRateTable map[currencyPair]*ent.ExchangeRate
+
+func NewExchangeRateService(params ExchangeRateServiceParams) *ExchangeRateService {
+	svc := &ExchangeRateService{
+		AbstractService: &AbstractService{
+			db: params.Ent,
+		},
+		SystemService: params.SystemService,
+		httpClient:    params.HttpClient,
+	}
+
+	cacheMode := params.CacheConfig.Mode
+	if cacheMode == "" {
+		cacheMode = xcache.ModeMemory
+	}
+
+	watcherMode := cacheMode
+	if watcherMode == xcache.ModeTwoLevel {
+		watcherMode = watcher.ModeRedis
+	}
+
+	notifier, err := watcher.NewWatcherFromConfig[live.CacheEvent[struct{}]](watcher.Config{
+		Mode:  watcherMode,
+		Redis: params.CacheConfig.Redis,
+	}, watcher.WatcherFromConfigOptions{
+		RedisChannel: "axonhub:cache:exchange_rates",
+		Buffer:       32,
+	})
+	if err != nil {
+		panic(fmt.Errorf("exchange rate watcher init failed: %w", err))
+	}
+
+	svc.ratesNotifier = notifier
+	svc.ratesCache = live.NewCache(live.Options[exchangeRateTable]{
+		Name:            "axonhub:exchange_rates",
+		InitialValue:    exchangeRateTable{},
+		RefreshInterval: 30 * time.Second,
+		DebounceDelay:   500 * time.Millisecond,
+		RefreshFunc:     svc.onRatesRefreshed,
+		Watcher:         notifier,
+	})
+
+	if err := svc.ratesCache.Load(context.Background(), true); err != nil {
+		panic(fmt.Errorf("exchange rate cache initial load failed: %w", err))
+	}
+
+	return svc
+}
+
+func (svc *ExchangeRateService) Stop() {
+	if svc.ratesCache != nil {
+		svc.ratesCache.Stop()
+	}
+}
+
+func (svc *ExchangeRateService) onRatesRefreshed(ctx context.Context, _ exchangeRateTable, lastUpdate time.Time) (exchangeRateTable, time.Time, bool, error) {
+	ctx = authz.WithSystemBypass(ctx, "exchange-rate-cache")
+
+	// The rates taking effect later are picked up by the periodic refresh.
+	rates, err := svc.entFromContext(ctx).ExchangeRate.Query().
+		Where(exchangerate.EffectiveAtLTE(xtime.UTCNow())).
+		Order(ent.Asc(exchangerate.FieldEffectiveAt), ent.Asc(exchangerate.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, lastUpdate, false, err
+	}
+
+	table := make(exchangeRateTable, len(rates))
+	for _, rate := range rates {
+		table[currencyPair{From: rate.FromCurrency, To: rate.ToCurrency}] = rate
+	}
+
+	// Always mark as changed, the rates taking effect do not change the update time.
+	return table, xtime.UTCNow(), true, nil
+}
+
+func (svc *ExchangeRateService) asyncReloadRates() {
+	if svc.ratesNotifier == nil {
+		return
+	}
+
+	if err := svc.ratesNotifier.Notify(context.Background(), live.NewForceRefreshEvent[struct{}]()); err != nil {
+		log.Warn(context.Background(), "exchange rate cache watcher notify failed", log.Cause(err))
+	}
+}
+
+// Rate returns the latest effective rate converting the from currency into the to currency,
+// using the rate of the inverse pair if the pair has no rate.
+func (svc *ExchangeRateService) Rate(from, to string) (decimal.Decimal, bool) {
+	from = objects.NormalizeCurrencyCode(from)
+	to = objects.NormalizeCurrencyCode(to)
+
+	if from == to {
+		return decimal.NewFromInt(1), true
+	}
+
+	table := svc.ratesCache.GetData()
+
+	if rate, ok := table[currencyPair{From: from, To: to}]; ok {
+		return decimal.NewFromFloat(rate.Rate), true
+	}
+
+	if rate, ok := table[currencyPair{From: to, To: from}]; ok && rate.Rate > 0 {
+		return decimal.NewFromInt(1).DivRound(decimal.NewFromFloat(rate.Rate), 12), true
+	}
+
+	return decimal.Decimal{}, false
+}
+
+// RateAt returns the rate converting the from currency into the to currency which was in effect before the time,
+// from the history of the rates, using the rate of the inverse pair if the pair had no rate.
+func (svc *ExchangeRateService) RateAt(ctx context.Context, from, to string, before time.Time) (decimal.Decimal, bool, error) {
+	from = objects.NormalizeCurrencyCode(from)
+	to = objects.NormalizeCurrencyCode(to)
+
+	if from == to {
+		return decimal.NewFromInt(1), true, nil
+	}
+
+	latest := func(from, to string) (*ent.ExchangeRate, error) {
+		rate, err := svc.entFromContext(ctx).ExchangeRate.Query().
+			Where(
+				exchangerate.FromCurrency(from),
+				exchangerate.ToCurrency(to),
+				exchangerate.EffectiveAtLT(before),
+			).
+			Order(ent.Desc(exchangerate.FieldEffectiveAt), ent.Desc(exchangerate.FieldID)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to query exchange rate: %w", err)
+		}
+
+		return rate, nil
+	}
+
+	rate, err := latest(from, to)
+	if err != nil {
+		return decimal.Decimal{}, false, err
+	}
+
+	if rate != nil {
+		return decimal.NewFromFloat(rate.Rate), true, nil
+	}
+
+	rate, err = latest(to, from)
+	if err != nil {
+		return decimal.Decimal{}, false, err
+	}
+
+	if rate != nil && rate.Rate > 0 {
+		return decimal.NewFromInt(1).DivRound(decimal.NewFromFloat(rate.Rate), 12), true, nil
+	}
+
+	return decimal.Decimal{}, false, nil
+}
+
+// ReportingCurrency returns the currency the costs are reported in.
+func (svc *ExchangeRateService) ReportingCurrency(ctx context.Context) string {
+	settings, err := svc.SystemService.GeneralSettings(ctx)
+	if err != nil {
+		log.Warn(ctx, "failed to get general settings", log.Cause(err))
+		return objects.NormalizeCurrencyCode(defaultGeneralSettings.CurrencyCode)
+	}
+
+	return objects.NormalizeCurrencyCode(settings.CurrencyCode)
+}
+
+// CostConversion is a cost converted into the reporting currency.
+type CostConversion struct {
+	// Currency is the currency of the items and the total, the original currency if there is no rate.
+	Currency string
+	Items    []objects.CostItem
+	Total    float64
+
+	OriginalCurrency string
+	OriginalTotal    float64
+	// Rate is nil if there is no rate converting the original currency into the reporting currency.
+	Rate *float64
+}
+
+// Converted reports whether the cost is in the reporting currency. An unconverted cost must not be added
+// to the costs in the reporting currency, e.g. the rollups, the budgets and the wallet debits.
+func (c CostConversion) Converted() bool {
+	return c.Rate != nil
+}
+
+// ConvertCost converts the cost items and the total in the currency, empty for the reporting currency,
+// into the reporting currency. The cost is kept unconverted if there is no rate, see CostConversion.Converted.
+func (svc *ExchangeRateService) ConvertCost(ctx context.Context, currency string, items []objects.CostItem, total float64) CostConversion {
+	reporting := svc.ReportingCurrency(ctx)
+
+	currency = objects.NormalizeCurrencyCode(currency)
+	if currency == "" {
+		currency = reporting
+	}
+
+	conversion := CostConversion{
+		Currency:         currency,
+		Items:            items,
+		Total:            total,
+		OriginalCurrency: currency,
+		OriginalTotal:    total,
+	}
+
+	rate, ok := svc.Rate(currency, reporting)
+	if !ok {
+		log.Warn(ctx, "no exchange rate to convert cost into the reporting currency",
+			log.String("currency", currency),
+			log.String("reporting_currency", reporting),
+		)
+
+		return conversion
+	}
+
+	conversion.Currency = reporting
+	conversion.Rate = lo.ToPtr(rate.InexactFloat64())
+
+	if rate.Equal(decimal.NewFromInt(1)) {
+		return conversion
+	}
+
+	conversion.Total = decimal.NewFromFloat(total).Mul(rate).InexactFloat64()
+	conversion.Items = make([]objects.CostItem, len(items))
+
+	for i, item := range items {
+		item.Subtotal = item.Subtotal.Mul(rate)
+
+		if len(item.TierBreakdown) > 0 {
+			tiers := make([]objects.TierCost, len(item.TierBreakdown))
+			for j, tier := range item.TierBreakdown {
+				tier.Subtotal = tier.Subtotal.Mul(rate)
+				tiers[j] = tier
+			}
+
+			item.TierBreakdown = tiers
+		}
+
+		conversion.Items[i] = item
+	}
+
+	return conversion
+}
+
+// CreateExchangeRate adds a rate of the currency pair, it takes effect now if the effective time is not set.
+func (svc *ExchangeRateService) CreateExchangeRate(ctx context.Context, input ent.CreateExchangeRateInput) (*ent.ExchangeRate, error) {
+	rate := exchangeRateRecord{
+		FromCurrency: input.FromCurrency,
+		ToCurrency:   input.ToCurrency,
+		Rate:         input.Rate,
+		EffectiveAt:  lo.FromPtr(input.EffectiveAt),
+	}
+
+	if err := rate.normalize(); err != nil {
+		return nil, err
+	}
+
+	created, err := svc.entFromContext(ctx).ExchangeRate.Create().
+		SetFromCurrency(rate.FromCurrency).
+		SetToCurrency(rate.ToCurrency).
+		SetRate(rate.Rate).
+		SetEffectiveAt(rate.EffectiveAt).
+		SetSource(exchangerate.SourceManual).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, xerrors.AlreadyExistsError("exchange rate")
+		}
+
+		return nil, fmt.Errorf("failed to create exchange rate: %w", err)
+	}
+
+	svc.asyncReloadRates()
+
+	return created, nil
+}
+
+// DeleteExchangeRate deletes a rate added by mistake, the previous rate of the pair takes effect again.
+func (svc *ExchangeRateService) DeleteExchangeRate(ctx context.Context, id int) error {
+	if err := svc.entFromContext(ctx).ExchangeRate.DeleteOneID(id).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete exchange rate: %w", err)
+	}
+
+	svc.asyncReloadRates()
+
+	return nil
+}
+
+// ImportExchangeRatesInput is the source of an import, either the content of a file or a URL to fetch it from.
+type ImportExchangeRatesInput struct {
+	// Content is the content of the file.
+	Content *string
+	// Filename is the name of the file, recorded as the source of the rates.
+	Filename *string
+	URL      *string
+	// Format is csv or json, detected from the content if empty.
+	Format *string
+}
+
+// ImportExchangeRates adds the rates of a CSV or JSON file, the rates already in the table are skipped.
+//
+// The CSV has a from_currency, to_currency, rate and optional effective_at header.
+// The JSON is either a list of objects with the same fields, or an object with a base currency,
+// a rates map and an optional date, e.g. {"base": "USD", "date": "2026-01-02", "rates": {"CNY": 7.1}}.
+func (svc *ExchangeRateService) ImportExchangeRates(ctx context.Context, input ImportExchangeRatesInput) ([]*ent.ExchangeRate, error) {
+	var (
+		content []byte
+		source  string
+		format  string
+	)
+
+	if input.Format != nil {
+		format = strings.ToLower(*input.Format)
+	}
+
+	switch {
+	case input.URL != nil && *input.URL != "":
+		body, contentType, err := svc.fetchRates(ctx, *input.URL)
+		if err != nil {
+			return nil, err
+		}
+
+		content = body
+		source = *input.URL
+
+		if format == "" && strings.Contains(contentType, "csv") {
+			format = ExchangeRateImportFormatCSV
+		}
+	case input.Content != nil:
+		content = []byte(*input.Content)
+		source = "upload"
+
+		if input.Filename != nil && *input.Filename != "" {
+			source = *input.Filename
+		}
+	default:
+		return nil, xerrors.ValidationError("either the content or the url is required")
+	}
+
+	records, err := parseExchangeRates(content, format)
+	if err != nil {
+		return nil, xerrors.ValidationError(err.Error())
+	}
+
+	if len(records) == 0 {
+		return nil, xerrors.ValidationError("no exchange rates found")
+	}
+
+	if len(records) > maxExchangeRateImportRates {
+		return nil, xerrors.ValidationError(fmt.Sprintf("too many exchange rates, at most %d are allowed per import", maxExchangeRateImportRates))
+	}
+
+	for i := range records {
+		if err := records[i].normalize(); err != nil {
+			return nil, xerrors.ValidationError(fmt.Sprintf("rate %d: %s", i+1, err.Error()))
+		}
+	}
+
+	var imported []*ent.ExchangeRate
+
+	err = svc.RunInTransaction(ctx, func(ctx context.Context) error {
+		client := svc.entFromContext(ctx)
+
+		for _, record := range records {
+			exists, err := client.ExchangeRate.Query().
+				Where(
+					exchangerate.FromCurrency(record.FromCurrency),
+					exchangerate.ToCurrency(record.ToCurrency),
+					exchangerate.EffectiveAt(record.EffectiveAt),
+				).
+				Exist(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to query exchange rate: %w", err)
+			}
+
+			if exists {
+				continue
+			}
+
+			rate, err := client.ExchangeRate.Create().
+				SetFromCurrency(record.FromCurrency).
+				SetToCurrency(record.ToCurrency).
+				SetRate(record.Rate).
+				SetEffectiveAt(record.EffectiveAt).
+				SetSource(exchangerate.SourceImport).
+				SetImportSource(source).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create exchange rate: %w", err)
+			}
+
+			imported = append(imported, rate)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info(ctx, "imported exchange rates",
+		log.String("source", source),
+		log.Int("count", len(imported)),
+		log.Int("skipped", len(records)-len(imported)),
+	)
+
+	svc.asyncReloadRates()
+
+	return imported, nil
+}
+
+func (svc *ExchangeRateService) fetchRates(ctx context.Context, url string) ([]byte, string, error) {
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		return nil, "", xerrors.ValidationError("the url must be http or https")
+	}
+
+	resp, err := svc.httpClient.Do(ctx, &httpclient.Request{
+		Method: http.MethodGet,
+		URL:    url,
+		Headers: http.Header{
+			"Accept": []string{"application/json, text/csv"},
+		},
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch exchange rates: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("failed to fetch exchange rates: non-OK status %d", resp.StatusCode)
+	}
+
+	return resp.Body, resp.Headers.Get("Content-Type"), nil
+}
+
+type exchangeRateRecord struct {
+	FromCurrency string    `json:"from_currency"`
+	ToCurrency   string    `json:"to_currency"`
+	Rate         float64   `json:"rate"`
+	EffectiveAt  time.Time `json:"effective_at"`
+}
+
+func (r *exchangeRateRecord) normalize() error {
+	r.FromCurrency = objects.NormalizeCurrencyCode(r.FromCurrency)
+	r.ToCurrency = objects.NormalizeCurrencyCode(r.ToCurrency)
+
+	if !objects.IsValidCurrencyCode(r.FromCurrency) {
+		return xerrors.ValidationError(fmt.Sprintf("invalid currency code: %q", r.FromCurrency))
+	}
+
+	if !objects.IsValidCurrencyCode(r.ToCurrency) {
+		return xerrors.ValidationError(fmt.Sprintf("invalid currency code: %q", r.ToCurrency))
+	}
+
+	if r.FromCurrency == r.ToCurrency {
+		return xerrors.ValidationError("the currencies of the rate must be different")
+	}
+
+	if r.Rate <= 0 {
+		return xerrors.ValidationError("the rate must be positive")
+	}
+
+	if r.EffectiveAt.IsZero() {
+		r.EffectiveAt = xtime.UTCNow()
+	}
+
+	r.EffectiveAt = r.EffectiveAt.UTC()
+
+	return nil
+}
+
+func parseExchangeRates(content []byte, format string) ([]exchangeRateRecord, error) {
+	content = bytes.TrimSpace(content)
+
+	if format == "" {
+		format = ExchangeRateImportFormatCSV
+		if len(content) > 0 && (content[0] == '{' || content[0] == '[') {
+			format = ExchangeRateImportFormatJSON
+		}
+	}
+
+	switch format {
+	case ExchangeRateImportFormatCSV:
+		return parseExchangeRatesCSV(content)
+	case ExchangeRateImportFormatJSON:
+		return parseExchangeRatesJSON(content)
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+func parseExchangeRatesCSV(content []byte) ([]exchangeRateRecord, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, name := range []string{"from_currency", "to_currency", "rate"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing csv column: %s", name)
+		}
+	}
+
+	value := func(row []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(row) {
+			return ""
+		}
+
+		return strings.TrimSpace(row[i])
+	}
+
+	var records []exchangeRateRecord
+
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read csv line %d: %w", line, err)
+		}
+
+		rate, err := strconv.ParseFloat(value(row, "rate"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate on csv line %d: %w", line, err)
+		}
+
+		effectiveAt, err := parseExchangeRateTime(value(row, "effective_at"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid effective_at on csv line %d: %w", line, err)
+		}
+
+		records = append(records, exchangeRateRecord{
+			FromCurrency: value(row, "from_currency"),
+			ToCurrency:   value(row, "to_currency"),
+			Rate:         rate,
+			EffectiveAt:  effectiveAt,
+		})
+	}
+
+	return records, nil
+}
+
+func parseExchangeRatesJSON(content []byte) ([]exchangeRateRecord, error) {
+	type jsonRate struct {
+		FromCurrency string  `json:"from_currency"`
+		ToCurrency   string  `json:"to_currency"`
+		Rate         float64 `json:"rate"`
+		EffectiveAt  string  `json:"effective_at"`
+	}
+
+	if len(content) > 0 && content[0] == '[' {
+		var rates []jsonRate
+		if err := json.Unmarshal(content, &rates); err != nil {
+			return nil, fmt.Errorf("failed to parse json: %w", err)
+		}
+
+		records := make([]exchangeRateRecord, 0, len(rates))
+		for i, rate := range rates {
+			effectiveAt, err := parseExchangeRateTime(rate.EffectiveAt)
+			if err != nil {
+				return nil, fmt.Errorf("invalid effective_at of rate %d: %w", i+1, err)
+			}
+
+			records = append(records, exchangeRateRecord{
+				FromCurrency: rate.FromCurrency,
+				ToCurrency:   rate.ToCurrency,
+				Rate:         rate.Rate,
+				EffectiveAt:  effectiveAt,
+			})
+		}
+
+		return records, nil
+	}
+
+	var table struct {
+		Base  string             `json:"base"`
+		Date  string             `json:"date"`
+		Rates map[string]float64 `json:"rates"`
+	}
+	if err := json.Unmarshal(content, &table); err != nil {
+		return nil, fmt.Errorf("failed to parse json: %w", err)
+	}
+
+	if table.Base == "" {
+		return nil, errors.New("missing base currency")
+	}
+
+	effectiveAt, err := parseExchangeRateTime(table.Date)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date: %w", err)
+	}
+
+	records := make([]exchangeRateRecord, 0, len(table.Rates))
+	for _, currency := range slices.Sorted(maps.Keys(table.Rates)) {
+		rate := table.Rates[currency]
+		// The base currency is usually listed with the rate 1.
+		if strings.EqualFold(currency, table.Base) {
+			continue
+		}
+
+		records = append(records, exchangeRateRecord{
+			FromCurrency: table.Base,
+			ToCurrency:   currency,
+			Rate:         rate,
+			EffectiveAt:  effectiveAt,
+		})
+	}
+
+	return records, nil
+}
+
+// parseExchangeRateTime parses a RFC 3339 time or a date at midnight UTC, the zero time if empty.
+func parseExchangeRateTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(time.DateOnly, value)
+}
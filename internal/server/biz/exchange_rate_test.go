@@ -0,0 +1,274 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+	"github.com/looplj/axonhub/llm"
+	"github.com/looplj/axonhub/llm/httpclient"
+)
+
+func setupTestExchangeRateService(t *testing.T) (context.Context, *ent.Client, *ExchangeRateService) {
+	t.Helper()
+
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=0")
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := authz.WithTestBypass(ent.NewContext(context.Background(), client))
+
+	svc := NewExchangeRateService(ExchangeRateServiceParams{
+		CacheConfig: xcache.Config{},
+		Ent:         client,
+		SystemService: NewSystemService(SystemServiceParams{
+			CacheConfig: xcache.Config{},
+			Ent:         client,
+		}),
+		HttpClient: httpclient.NewHttpClient(),
+	})
+	t.Cleanup(svc.Stop)
+
+	return ctx, client, svc
+}
+
+func TestParseExchangeRates(t *testing.T) {
+	records, err := parseExchangeRates([]byte("from_currency,to_currency,rate,effective_at\nCNY,USD,0.14,2026-01-02\nEUR, USD ,1.1,\n"), "")
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, "CNY", records[0].FromCurrency)
+	require.InDelta(t, 0.14, records[0].Rate, 1e-9)
+	require.Equal(t, time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), records[0].EffectiveAt)
+	require.Equal(t, "USD", records[1].ToCurrency)
+	require.True(t, records[1].EffectiveAt.IsZero())
+
+	records, err = parseExchangeRates([]byte(`{"base": "USD", "date": "2026-01-02", "rates": {"USD": 1, "JPY": 150, "CNY": 7.1}}`), "")
+	require.NoError(t, err)
+	require.Equal(t, []exchangeRateRecord{
+		{FromCurrency: "USD", ToCurrency: "CNY", Rate: 7.1, EffectiveAt: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{FromCurrency: "USD", ToCurrency: "JPY", Rate: 150, EffectiveAt: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)},
+	}, records)
+
+	records, err = parseExchangeRates([]byte(`[{"from_currency": "cny", "to_currency": "usd", "rate": 0.14, "effective_at": "2026-01-02T08:00:00+08:00"}]`), "json")
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.True(t, records[0].EffectiveAt.Equal(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)))
+
+	_, err = parseExchangeRates([]byte("from,to\nCNY,USD"), "")
+	require.Error(t, err)
+
+	_, err = parseExchangeRates([]byte("from_currency,to_currency,rate\nCNY,USD,abc"), "")
+	require.Error(t, err)
+
+	_, err = parseExchangeRates([]byte("{}"), "xml")
+	require.Error(t, err)
+}
+
+func TestExchangeRateService_Rate(t *testing.T) {
+	ctx, _, svc := setupTestExchangeRateService(t)
+
+	_, err := svc.CreateExchangeRate(ctx, ent.CreateExchangeRateInput{
+		FromCurrency: "cny",
+		ToCurrency:   "USD",
+		Rate:         0.2,
+		EffectiveAt:  lo.ToPtr(time.Now().Add(-2 * time.Hour)),
+	})
+	require.NoError(t, err)
+
+	_, err = svc.CreateExchangeRate(ctx, ent.CreateExchangeRateInput{
+		FromCurrency: "CNY",
+		ToCurrency:   "USD",
+		Rate:         0.125,
+		EffectiveAt:  lo.ToPtr(time.Now().Add(-time.Hour)),
+	})
+	require.NoError(t, err)
+
+	_, err = svc.CreateExchangeRate(ctx, ent.CreateExchangeRateInput{
+		FromCurrency: "CNY",
+		ToCurrency:   "USD",
+		Rate:         1,
+		EffectiveAt:  lo.ToPtr(time.Now().Add(time.Hour)),
+	})
+	require.NoError(t, err)
+
+	for _, input := range []ent.CreateExchangeRateInput{
+		{FromCurrency: "CNY", ToCurrency: "CNY", Rate: 1},
+		{FromCurrency: "CNY", ToCurrency: "USD", Rate: 0},
+		{FromCurrency: "YUAN", ToCurrency: "USD", Rate: 1},
+	} {
+		_, err := svc.CreateExchangeRate(ctx, input)
+		require.Error(t, err)
+	}
+
+	require.NoError(t, svc.ratesCache.Load(ctx, true))
+
+	rate, ok := svc.Rate("CNY", "usd")
+	require.True(t, ok)
+	require.True(t, rate.Equal(decimal.RequireFromString("0.125")), rate.String())
+
+	rate, ok = svc.Rate("USD", "CNY")
+	require.True(t, ok)
+	require.True(t, rate.Equal(decimal.NewFromInt(8)), rate.String())
+
+	rate, ok = svc.Rate("EUR", "EUR")
+	require.True(t, ok)
+	require.True(t, rate.Equal(decimal.NewFromInt(1)))
+
+	_, ok = svc.Rate("EUR", "USD")
+	require.False(t, ok)
+}
+
+func TestExchangeRateService_ImportExchangeRates(t *testing.T) {
+	ctx, client, svc := setupTestExchangeRateService(t)
+
+	content := "from_currency,to_currency,rate,effective_at\nCNY,USD,0.14,2026-01-02\nEUR,USD,1.1,2026-01-02\n"
+
+	imported, err := svc.ImportExchangeRates(ctx, ImportExchangeRatesInput{
+		Content:  lo.ToPtr(content),
+		Filename: lo.ToPtr("rates.csv"),
+	})
+	require.NoError(t, err)
+	require.Len(t, imported, 2)
+	require.Equal(t, "rates.csv", imported[0].ImportSource)
+
+	// The rates already in the table are skipped, the history keeps the new ones.
+	imported, err = svc.ImportExchangeRates(ctx, ImportExchangeRatesInput{
+		Content: lo.ToPtr(content + "CNY,USD,0.15,2026-02-01\n"),
+	})
+	require.NoError(t, err)
+	require.Len(t, imported, 1)
+	require.Equal(t, 3, client.ExchangeRate.Query().CountX(ctx))
+
+	require.NoError(t, svc.ratesCache.Load(ctx, true))
+
+	rate, ok := svc.Rate("CNY", "USD")
+	require.True(t, ok)
+	require.True(t, rate.Equal(decimal.RequireFromString("0.15")), rate.String())
+
+	_, err = svc.ImportExchangeRates(ctx, ImportExchangeRatesInput{})
+	require.Error(t, err)
+
+	_, err = svc.ImportExchangeRates(ctx, ImportExchangeRatesInput{Content: lo.ToPtr("from_currency,to_currency,rate\nCNY,USD,-1\n")})
+	require.Error(t, err)
+
+	_, err = svc.ImportExchangeRates(ctx, ImportExchangeRatesInput{URL: lo.ToPtr("file:///etc/passwd")})
+	require.Error(t, err)
+}
+
+func TestExchangeRateService_ConvertCost(t *testing.T) {
+	ctx, _, svc := setupTestExchangeRateService(t)
+
+	_, err := svc.CreateExchangeRate(ctx, ent.CreateExchangeRateInput{
+		FromCurrency: "USD",
+		ToCurrency:   "CNY",
+		Rate:         8,
+		EffectiveAt:  lo.ToPtr(time.Now().Add(-time.Hour)),
+	})
+	require.NoError(t, err)
+	require.NoError(t, svc.ratesCache.Load(ctx, true))
+
+	items := []objects.CostItem{{
+		ItemCode: objects.PriceItemCodeUsage,
+		Quantity: 100,
+		TierBreakdown: []objects.TierCost{
+			{UpTo: lo.ToPtr(int64(50)), Units: 50, Subtotal: decimal.RequireFromString("0.5")},
+			{Units: 50, Subtotal: decimal.RequireFromString("1")},
+		},
+		Subtotal: decimal.RequireFromString("1.5"),
+	}}
+
+	// The reporting currency defaults to USD.
+	conversion := svc.ConvertCost(ctx, "CNY", items, 1.5)
+	require.Equal(t, "USD", conversion.Currency)
+	require.Equal(t, "CNY", conversion.OriginalCurrency)
+	require.InDelta(t, 1.5, conversion.OriginalTotal, 1e-9)
+	require.InDelta(t, 0.1875, conversion.Total, 1e-9)
+	require.InDelta(t, 0.125, lo.FromPtr(conversion.Rate), 1e-9)
+	require.True(t, conversion.Items[0].Subtotal.Equal(decimal.RequireFromString("0.1875")))
+	require.True(t, conversion.Items[0].TierBreakdown[0].Subtotal.Equal(decimal.RequireFromString("0.0625")))
+	require.True(t, items[0].Subtotal.Equal(decimal.RequireFromString("1.5")), "the original items are not modified")
+
+	conversion = svc.ConvertCost(ctx, "", items, 1.5)
+	require.Equal(t, "USD", conversion.Currency)
+	require.Equal(t, "USD", conversion.OriginalCurrency)
+	require.InDelta(t, 1.5, conversion.Total, 1e-9)
+	require.InDelta(t, 1, lo.FromPtr(conversion.Rate), 1e-9)
+
+	conversion = svc.ConvertCost(ctx, "EUR", items, 1.5)
+	require.False(t, conversion.Converted())
+	require.Equal(t, "EUR", conversion.Currency, "the cost is kept unconverted without a rate")
+	require.InDelta(t, 1.5, conversion.Total, 1e-9)
+	require.Nil(t, conversion.Rate)
+}
+
+func TestExchangeRateService_MissingRate(t *testing.T) {
+	ctx, client, svc := setupTestExchangeRateService(t)
+
+	ch, err := client.Channel.Create().
+		SetType(channel.TypeOpenaiFake).
+		SetName("eur").
+		SetSupportedModels([]string{"m1"}).
+		SetDefaultTestModel("m1").
+		SetStatus(channel.StatusEnabled).
+		SetCredentials(objects.ChannelCredentials{}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	unit := decimal.NewFromFloat(10)
+	_, err = client.ChannelModelPrice.Create().
+		SetChannelID(ch.ID).
+		SetModelID("m1").
+		SetPrice(objects.ModelPrice{
+			Currency: "EUR",
+			Items: []objects.ModelPriceItem{{
+				ItemCode: objects.PriceItemCodeUsage,
+				Pricing:  objects.Pricing{Mode: objects.PricingModeUsagePerUnit, UsagePerUnit: &unit},
+			}},
+		}).
+		SetReferenceID("ref-eur").
+		Save(ctx)
+	require.NoError(t, err)
+
+	channelService := NewChannelServiceForTest(client)
+	built, err := channelService.GetChannel(ctx, ch.ID)
+	require.NoError(t, err)
+	channelService.preloadModelPrices(ctx, built)
+	channelService.SetEnabledChannelsForTest([]*Channel{built})
+
+	usage := &llm.Usage{PromptTokens: 100000, TotalTokens: 100000}
+
+	t.Run("usage log keeps the cost out of the totals", func(t *testing.T) {
+		usageLogService := NewUsageLogService(client, svc.SystemService, channelService)
+		usageLogService.SetExchangeRateService(svc)
+
+		ul, err := usageLogService.CreateUsageLog(ctx, CreateUsageLogParams{
+			RequestID:     1,
+			ProjectID:     1,
+			ChannelID:     ch.ID,
+			ActualModelID: "m1",
+			Usage:         usage,
+			Source:        "api",
+			Format:        "openai/chat_completions",
+		})
+		require.NoError(t, err)
+		require.Nil(t, ul.TotalCost)
+		require.Nil(t, ul.ExchangeRate)
+		require.Equal(t, "EUR", ul.Currency)
+		require.Equal(t, "EUR", ul.OriginalCurrency)
+		require.InDelta(t, 1, lo.FromPtr(ul.OriginalTotalCost), 1e-9)
+	})
+
+	t.Run("wallet estimate is not charged", func(t *testing.T) {
+		walletService := NewWalletService(WalletServiceParams{Ent: client, ExchangeRateService: svc})
+		require.Zero(t, walletService.EstimateCost(ctx, built, "m1", usage))
+	})
+}
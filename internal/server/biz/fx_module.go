@@ -5,6 +5,7 @@ import (
 
 	"go.uber.org/fx"
 
+	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/log"
 	"github.com/looplj/axonhub/internal/server/scheduler"
 )
@@ -16,11 +17,15 @@ var Module = fx.Module("biz",
 	fx.Provide(NewAuthService),
 	fx.Provide(NewChannelService),
 	fx.Provide(NewRequestService),
+	fx.Provide(NewRequestSearchService),
 	fx.Provide(NewUsageLogService),
+	fx.Provide(NewExchangeRateService),
+	fx.Provide(NewUsageRollupService),
 	fx.Provide(NewVideoService),
 	fx.Provide(NewUserService),
 	fx.Provide(NewInvitationService),
 	fx.Provide(NewAPIKeyService),
+	fx.Provide(NewAPIKeyTokenService),
 	fx.Provide(NewProjectService),
 	fx.Provide(NewRoleService),
 	fx.Provide(NewThreadService),
@@ -32,9 +37,42 @@ var Module = fx.Module("biz",
 	fx.Provide(NewPromptService),
 	fx.Provide(NewPromptProtectionRuleService),
 	fx.Provide(NewQuotaService),
+	fx.Provide(NewBudgetService),
+	fx.Provide(NewInvoiceService),
+	fx.Provide(NewDataExportService),
+	fx.Provide(NewWalletService),
 	fx.Provide(NewProviderQuotaService),
 	fx.Provide(NewOIDCService),
+	fx.Provide(NewSAMLService),
+	fx.Provide(NewSCIMService),
 	fx.Provide(NewAPIKeyProfileTemplateService),
+	fx.Provide(NewAuditLogService),
+	fx.Invoke(func(client *ent.Client, svc *AuditLogService) {
+		client.Use(svc.Hook())
+	}),
+	fx.Invoke(func(client *ent.Client, notifier *WebhookNotifier, quotaService *QuotaService) {
+		client.Use(notifier.UserHook())
+		quotaService.SetWebhookNotifier(notifier)
+	}),
+	fx.Invoke(func(lc fx.Lifecycle, usageLogService *UsageLogService, svc *ExchangeRateService) {
+		usageLogService.SetExchangeRateService(svc)
+		lc.Append(fx.Hook{
+			OnStop: func(ctx context.Context) error {
+				svc.Stop()
+				return nil
+			},
+		})
+	}),
+	fx.Invoke(func(client *ent.Client, svc *WalletService) {
+		client.Use(svc.UsageLogHook())
+	}),
+	fx.Invoke(func(lc fx.Lifecycle, notifier *WebhookNotifier, s *scheduler.Scheduler) {
+		lc.Append(fx.Hook{
+			OnStart: func(ctx context.Context) error {
+				return notifier.RegisterScheduledTasks(ctx, s)
+			},
+		})
+	}),
 	fx.Invoke(func(lc fx.Lifecycle, svc *APIKeyService) {
 		lc.Append(fx.Hook{
 			OnStop: func(ctx context.Context) error {
@@ -93,6 +131,48 @@ var Module = fx.Module("biz",
 			},
 		})
 	}),
+	fx.Invoke(func(lc fx.Lifecycle, svc *UsageRollupService, s *scheduler.Scheduler) {
+		lc.Append(fx.Hook{
+			OnStart: func(ctx context.Context) error {
+				return svc.RegisterScheduledTasks(ctx, s)
+			},
+		})
+	}),
+	fx.Invoke(func(lc fx.Lifecycle, svc *RequestSearchService, s *scheduler.Scheduler) {
+		lc.Append(fx.Hook{
+			OnStart: func(ctx context.Context) error {
+				return svc.RegisterScheduledTasks(ctx, s)
+			},
+		})
+	}),
+	fx.Invoke(func(lc fx.Lifecycle, svc *BudgetService, s *scheduler.Scheduler) {
+		lc.Append(fx.Hook{
+			OnStart: func(ctx context.Context) error {
+				return svc.RegisterScheduledTasks(ctx, s)
+			},
+		})
+	}),
+	fx.Invoke(func(lc fx.Lifecycle, svc *InvoiceService, s *scheduler.Scheduler) {
+		lc.Append(fx.Hook{
+			OnStart: func(ctx context.Context) error {
+				return svc.RegisterScheduledTasks(ctx, s)
+			},
+		})
+	}),
+	fx.Invoke(func(lc fx.Lifecycle, svc *DataExportService, s *scheduler.Scheduler) {
+		lc.Append(fx.Hook{
+			OnStart: func(ctx context.Context) error {
+				return svc.RegisterScheduledTasks(ctx, s)
+			},
+		})
+	}),
+	fx.Invoke(func(lc fx.Lifecycle, svc *WalletService, s *scheduler.Scheduler) {
+		lc.Append(fx.Hook{
+			OnStart: func(ctx context.Context) error {
+				return svc.RegisterScheduledTasks(ctx, s)
+			},
+		})
+	}),
 	fx.Invoke(func(lc fx.Lifecycle, svc *PromptService, s *scheduler.Scheduler) {
 		lc.Append(fx.Hook{
 			OnStart: func(ctx context.Context) error {
@@ -0,0 +1,580 @@
+package biz
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+	"go.uber.org/fx"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/channelmodelpriceversion"
+	"github.com/looplj/axonhub/internal/ent/invoice"
+	"github.com/looplj/axonhub/internal/ent/usagelog"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xerrors"
+	"github.com/looplj/axonhub/internal/pkg/xregexp"
+	"github.com/looplj/axonhub/internal/pkg/xtime"
+	"github.com/looplj/axonhub/internal/server/scheduler"
+)
+
+// InvoiceMonthLayout is the layout of the month an invoice is generated for.
+const InvoiceMonthLayout = "2006-01"
+
+const (
+	InvoiceExportFormatCSV  = "csv"
+	InvoiceExportFormatJSON = "json"
+	InvoiceExportFormatHTML = "html"
+)
+
+type InvoiceServiceParams struct {
+	fx.In
+
+	Ent                 *ent.Client
+	SystemService       *SystemService
+	ExchangeRateService *ExchangeRateService
+}
+
+// InvoiceService generates the monthly invoices of the projects with billing enabled.
+//
+// An invoice bills the cost of the usage logs of the month, grouped by model and channel model price version,
+// so an invoice generated again from the same usage logs has the same line items even if the prices changed since.
+// The costs are converted into the currency of the invoice with the exchange rates in effect at the end of the month,
+// so rates added later do not change the invoice.
+type InvoiceService struct {
+	*AbstractService
+
+	SystemService       *SystemService
+	ExchangeRateService *ExchangeRateService
+}
+
+func NewInvoiceService(params InvoiceServiceParams) *InvoiceService {
+	return &InvoiceService{
+		AbstractService: &AbstractService{
+			db: params.Ent,
+		},
+		SystemService:       params.SystemService,
+		ExchangeRateService: params.ExchangeRateService,
+	}
+}
+
+func (svc *InvoiceService) RegisterScheduledTasks(ctx context.Context, s *scheduler.Scheduler) error {
+	return s.Register(ctx, scheduler.TaskSpec{
+		Name:        "invoice-generate",
+		Description: "Generate the missing invoices of the previous month for the projects with billing enabled daily at 01:00",
+		CronExpr:    "0 1 * * *",
+		Timezone:    svc.SystemService.TimeLocation(ctx).String(),
+	}, svc.generateInvoicesPeriodically)
+}
+
+func (svc *InvoiceService) generateInvoicesPeriodically(ctx context.Context) {
+	ctx = authz.WithSystemBypass(ctx, "invoice-generate")
+
+	if err := svc.GenerateMonthlyInvoices(ctx); err != nil {
+		log.Error(ctx, "failed to generate monthly invoices", log.Cause(err))
+	}
+}
+
+// GenerateMonthlyInvoices generates the invoices of the previous month for the projects with billing enabled
+// which do not have one yet.
+func (svc *InvoiceService) GenerateMonthlyInvoices(ctx context.Context) error {
+	loc := svc.SystemService.TimeLocation(ctx)
+	now := xtime.UTCNow().In(loc)
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc).AddDate(0, -1, 0).Format(InvoiceMonthLayout)
+
+	projects, err := svc.entFromContext(ctx).Project.Query().All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query projects: %w", err)
+	}
+
+	for _, proj := range projects {
+		if proj.BillingSettings == nil || !proj.BillingSettings.Enabled {
+			continue
+		}
+
+		if _, err := svc.GenerateInvoice(ctx, proj.ID, month); err != nil {
+			if _, ok := xerrors.IsCodedError(err); ok {
+				continue
+			}
+
+			log.Error(ctx, "failed to generate invoice",
+				log.Int("project_id", proj.ID),
+				log.String("month", month),
+				log.Cause(err),
+			)
+		}
+	}
+
+	return nil
+}
+
+// InvoicePeriod returns the period of the month in the YYYY-MM format, in the system timezone.
+func (svc *InvoiceService) InvoicePeriod(ctx context.Context, month string) (time.Time, time.Time, error) {
+	start, err := time.ParseInLocation(InvoiceMonthLayout, month, svc.SystemService.TimeLocation(ctx))
+	if err != nil {
+		return time.Time{}, time.Time{}, xerrors.ValidationError(fmt.Sprintf("invalid invoice month '%s', expected YYYY-MM", month))
+	}
+
+	return start, start.AddDate(0, 1, 0), nil
+}
+
+// GenerateInvoice generates and stores the invoice of the project for the month in the YYYY-MM format.
+// The month must have ended, and a project has at most one invoice per month.
+func (svc *InvoiceService) GenerateInvoice(ctx context.Context, projectID int, month string) (*ent.Invoice, error) {
+	start, end, err := svc.InvoicePeriod(ctx, month)
+	if err != nil {
+		return nil, err
+	}
+
+	if end.After(xtime.UTCNow()) {
+		return nil, xerrors.ValidationError(fmt.Sprintf("the month %s has not ended yet", month))
+	}
+
+	client := svc.entFromContext(ctx)
+
+	proj, err := client.Project.Get(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	if proj.BillingSettings == nil || !proj.BillingSettings.Enabled {
+		return nil, xerrors.ValidationError("billing is not enabled for the project")
+	}
+
+	exists, err := client.Invoice.Query().
+		Where(
+			invoice.ProjectIDEQ(projectID),
+			invoice.PeriodStartEQ(start),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check invoice existence: %w", err)
+	}
+
+	if exists {
+		return nil, xerrors.AlreadyExistsError("invoice")
+	}
+
+	settings := *proj.BillingSettings
+	if settings.Currency == "" {
+		general, err := svc.SystemService.GeneralSettings(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		settings.Currency = general.CurrencyCode
+	}
+
+	lineItems, err := authz.RunWithSystemBypass(ctx, "invoice-line-items", func(bypassCtx context.Context) ([]objects.InvoiceLineItem, error) {
+		return svc.lineItems(bypassCtx, projectID, start, end, settings)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	subtotal := decimal.Zero
+	amount := decimal.Zero
+
+	for _, item := range lineItems {
+		subtotal = subtotal.Add(decimal.NewFromFloat(item.Cost))
+		amount = amount.Add(decimal.NewFromFloat(item.Amount))
+	}
+
+	fee := decimal.NewFromFloat(settings.MonthlyFee)
+
+	inv, err := client.Invoice.Create().
+		SetProjectID(projectID).
+		SetNumber(fmt.Sprintf("INV-%s-%d", start.Format("200601"), projectID)).
+		SetPeriodStart(start).
+		SetPeriodEnd(end).
+		SetCurrency(settings.Currency).
+		SetSubtotal(subtotal.InexactFloat64()).
+		SetMarkupAmount(amount.Sub(subtotal).InexactFloat64()).
+		SetFee(fee.InexactFloat64()).
+		SetTotal(amount.Add(fee).InexactFloat64()).
+		SetLineItems(lineItems).
+		SetBillingSettings(settings).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, xerrors.AlreadyExistsError("invoice")
+		}
+
+		return nil, fmt.Errorf("failed to create invoice: %w", err)
+	}
+
+	return inv, nil
+}
+
+type invoiceUsageRow struct {
+	ModelID                 string  `json:"model_id"`
+	PriceReferenceID        string  `json:"price_reference_id"`
+	Currency                string  `json:"currency"`
+	RequestCount            int64   `json:"request_count"`
+	PromptTokens            int64   `json:"prompt_tokens"`
+	PromptCachedTokens      int64   `json:"prompt_cached_tokens"`
+	PromptWriteCachedTokens int64   `json:"prompt_write_cached_tokens"`
+	CompletionTokens        int64   `json:"completion_tokens"`
+	TotalTokens             int64   `json:"total_tokens"`
+	TotalCost               float64 `json:"total_cost"`
+}
+
+// lineItems aggregates the usage logs of the project in the period by model and price version, converts their costs
+// into the currency of the invoice and applies the markups. The costs kept unconverted in the usage logs, without
+// a rate at the time, are billed from their original currency. The rates are the ones in effect at the end of the period,
+// and the invoice fails if a currency has no rate.
+func (svc *InvoiceService) lineItems(
+	ctx context.Context,
+	projectID int,
+	start, end time.Time,
+	settings objects.ProjectBillingSettings,
+) ([]objects.InvoiceLineItem, error) {
+	var rows []invoiceUsageRow
+
+	err := svc.entFromContext(ctx).UsageLog.Query().
+		Where(
+			usagelog.ProjectIDEQ(projectID),
+			usagelog.CreatedAtGTE(start),
+			usagelog.CreatedAtLT(end),
+		).
+		Modify(func(s *entsql.Selector) {
+			keys := []string{
+				s.C(usagelog.FieldModelID),
+				fmt.Sprintf("COALESCE(%s, '')", s.C(usagelog.FieldCostPriceReferenceID)),
+				fmt.Sprintf("COALESCE(%s, '')", s.C(usagelog.FieldCurrency)),
+			}
+
+			sum := func(column string) string {
+				return fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(column))
+			}
+
+			s.Select(
+				entsql.As(keys[0], "model_id"),
+				entsql.As(keys[1], "price_reference_id"),
+				entsql.As(keys[2], "currency"),
+				entsql.As(entsql.Count(s.C(usagelog.FieldID)), "request_count"),
+				entsql.As(sum(usagelog.FieldPromptTokens), "prompt_tokens"),
+				entsql.As(sum(usagelog.FieldPromptCachedTokens), "prompt_cached_tokens"),
+				entsql.As(sum(usagelog.FieldPromptWriteCachedTokens), "prompt_write_cached_tokens"),
+				entsql.As(sum(usagelog.FieldCompletionTokens), "completion_tokens"),
+				entsql.As(sum(usagelog.FieldTotalTokens), "total_tokens"),
+				entsql.As(fmt.Sprintf("COALESCE(SUM(COALESCE(%s, %s)), 0)",
+					s.C(usagelog.FieldTotalCost), s.C(usagelog.FieldOriginalTotalCost)), "total_cost"),
+			).GroupBy(keys...)
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate usage logs: %w", err)
+	}
+
+	referenceIDs := make([]string, 0, len(rows))
+
+	for _, row := range rows {
+		if row.PriceReferenceID != "" {
+			referenceIDs = append(referenceIDs, row.PriceReferenceID)
+		}
+	}
+
+	prices := make(map[string]objects.ModelPrice, len(referenceIDs))
+
+	if len(referenceIDs) > 0 {
+		versions, err := svc.entFromContext(ctx).ChannelModelPriceVersion.Query().
+			Where(channelmodelpriceversion.ReferenceIDIn(referenceIDs...)).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query channel model price versions: %w", err)
+		}
+
+		for _, version := range versions {
+			prices[version.ReferenceID] = version.Price
+		}
+	}
+
+	// The usage logs without currency were not converted, their costs are in the reporting currency.
+	reporting := svc.ExchangeRateService.ReportingCurrency(ctx)
+
+	type lineItemKey struct {
+		ModelID          string
+		PriceReferenceID string
+	}
+
+	var (
+		keys  []lineItemKey
+		usage = make(map[lineItemKey]*objects.InvoiceLineItem, len(rows))
+		costs = make(map[lineItemKey]decimal.Decimal, len(rows))
+		rates = make(map[string]decimal.Decimal)
+	)
+
+	for _, row := range rows {
+		currency := cmp.Or(row.Currency, reporting)
+
+		rate, ok := rates[currency]
+		if !ok {
+			found, exists, err := svc.ExchangeRateService.RateAt(ctx, currency, settings.Currency, end)
+			if err != nil {
+				return nil, err
+			}
+
+			if !exists {
+				return nil, fmt.Errorf("no exchange rate to convert the %s costs of model %s into %s", currency, row.ModelID, settings.Currency)
+			}
+
+			rate = found
+			rates[currency] = rate
+		}
+
+		key := lineItemKey{ModelID: row.ModelID, PriceReferenceID: row.PriceReferenceID}
+
+		item, ok := usage[key]
+		if !ok {
+			item = &objects.InvoiceLineItem{
+				ModelID:          row.ModelID,
+				PriceReferenceID: row.PriceReferenceID,
+			}
+			usage[key] = item
+			keys = append(keys, key)
+		}
+
+		item.RequestCount += row.RequestCount
+		item.PromptTokens += row.PromptTokens
+		item.PromptCachedTokens += row.PromptCachedTokens
+		item.PromptWriteCachedTokens += row.PromptWriteCachedTokens
+		item.CompletionTokens += row.CompletionTokens
+		item.TotalTokens += row.TotalTokens
+		costs[key] = costs[key].Add(decimal.NewFromFloat(row.TotalCost).Mul(rate))
+	}
+
+	items := make([]objects.InvoiceLineItem, 0, len(keys))
+
+	for _, key := range keys {
+		item := *usage[key]
+		cost := costs[key]
+		markup := InvoiceMarkupPercent(settings, item.ModelID)
+
+		item.Cost = cost.InexactFloat64()
+		item.MarkupPercent = markup
+		item.Amount = cost.Mul(decimal.NewFromFloat(100 + markup)).Div(decimal.NewFromInt(100)).InexactFloat64()
+
+		if price, ok := prices[item.PriceReferenceID]; ok {
+			item.Price = &price
+		}
+
+		items = append(items, item)
+	}
+
+	slices.SortFunc(items, func(a, b objects.InvoiceLineItem) int {
+		return cmp.Or(
+			strings.Compare(a.ModelID, b.ModelID),
+			strings.Compare(a.PriceReferenceID, b.PriceReferenceID),
+		)
+	})
+
+	return items, nil
+}
+
+// InvoiceMarkupPercent returns the markup of the model, from the first matching model markup or the default markup.
+func InvoiceMarkupPercent(settings objects.ProjectBillingSettings, modelID string) float64 {
+	for _, markup := range settings.ModelMarkups {
+		if xregexp.MatchString(markup.Model, modelID) {
+			return markup.MarkupPercent
+		}
+	}
+
+	return settings.MarkupPercent
+}
+
+// InvoiceExport is an invoice exported in one of the export formats.
+type InvoiceExport struct {
+	Content     []byte
+	ContentType string
+	Filename    string
+}
+
+type invoiceDocument struct {
+	Number          string                         `json:"number"`
+	ProjectID       int                            `json:"projectID"`
+	ProjectName     string                         `json:"projectName"`
+	PeriodStart     time.Time                      `json:"periodStart"`
+	PeriodEnd       time.Time                      `json:"periodEnd"`
+	Currency        string                         `json:"currency"`
+	Subtotal        float64                        `json:"subtotal"`
+	MarkupAmount    float64                        `json:"markupAmount"`
+	Fee             float64                        `json:"fee"`
+	Total           float64                        `json:"total"`
+	LineItems       []objects.InvoiceLineItem      `json:"lineItems"`
+	BillingSettings objects.ProjectBillingSettings `json:"billingSettings"`
+	CreatedAt       time.Time                      `json:"createdAt"`
+}
+
+// ExportInvoice exports the invoice as CSV, JSON or HTML.
+func (svc *InvoiceService) ExportInvoice(ctx context.Context, inv *ent.Invoice, format string) (*InvoiceExport, error) {
+	proj, err := svc.entFromContext(ctx).Project.Get(ctx, inv.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	doc := invoiceDocument{
+		Number:          inv.Number,
+		ProjectID:       inv.ProjectID,
+		ProjectName:     proj.Name,
+		PeriodStart:     inv.PeriodStart,
+		PeriodEnd:       inv.PeriodEnd,
+		Currency:        inv.Currency,
+		Subtotal:        inv.Subtotal,
+		MarkupAmount:    inv.MarkupAmount,
+		Fee:             inv.Fee,
+		Total:           inv.Total,
+		LineItems:       inv.LineItems,
+		BillingSettings: inv.BillingSettings,
+		CreatedAt:       inv.CreatedAt,
+	}
+
+	var (
+		content     []byte
+		contentType string
+	)
+
+	switch format {
+	case InvoiceExportFormatCSV:
+		content, err = doc.csv()
+		contentType = "text/csv; charset=utf-8"
+	case InvoiceExportFormatJSON:
+		content, err = json.MarshalIndent(doc, "", "  ")
+		contentType = "application/json"
+	case InvoiceExportFormatHTML:
+		content, err = doc.html()
+		contentType = "text/html; charset=utf-8"
+	default:
+		return nil, xerrors.ValidationError(fmt.Sprintf("unsupported invoice export format: %s", format))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to export invoice: %w", err)
+	}
+
+	return &InvoiceExport{
+		Content:     content,
+		ContentType: contentType,
+		Filename:    inv.Number + "." + format,
+	}, nil
+}
+
+func formatInvoiceAmount(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func (doc invoiceDocument) csv() ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+
+	records := [][]string{
+		{
+			"model_id", "price_reference_id", "request_count", "prompt_tokens", "prompt_cached_tokens",
+			"prompt_write_cached_tokens", "completion_tokens", "total_tokens", "cost", "markup_percent", "amount",
+		},
+	}
+
+	for _, item := range doc.LineItems {
+		records = append(records, []string{
+			item.ModelID,
+			item.PriceReferenceID,
+			strconv.FormatInt(item.RequestCount, 10),
+			strconv.FormatInt(item.PromptTokens, 10),
+			strconv.FormatInt(item.PromptCachedTokens, 10),
+			strconv.FormatInt(item.PromptWriteCachedTokens, 10),
+			strconv.FormatInt(item.CompletionTokens, 10),
+			strconv.FormatInt(item.TotalTokens, 10),
+			formatInvoiceAmount(item.Cost),
+			formatInvoiceAmount(item.MarkupPercent),
+			formatInvoiceAmount(item.Amount),
+		})
+	}
+
+	// The totals follow the line items with the label in the first column and the value in the amount column.
+	for _, total := range []struct {
+		label string
+		value float64
+	}{
+		{"subtotal", doc.Subtotal},
+		{"markup", doc.MarkupAmount},
+		{"monthly_fee", doc.Fee},
+		{"total", doc.Total},
+	} {
+		record := make([]string, len(records[0]))
+		record[0] = total.label
+		record[len(record)-1] = formatInvoiceAmount(total.value)
+		records = append(records, record)
+	}
+
+	if err := w.WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var invoiceHTMLTemplate = template.Must(template.New("invoice").Funcs(template.FuncMap{
+	"amount": formatInvoiceAmount,
+	"date": func(t time.Time) string {
+		return t.Format(time.DateOnly)
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Invoice {{.Number}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; width: 100%; margin-top: 1em; }
+th, td { border-bottom: 1px solid #ddd; padding: 6px 8px; text-align: right; }
+th:first-child, td:first-child, th:nth-child(2), td:nth-child(2) { text-align: left; }
+tfoot td { font-weight: bold; border-bottom: none; }
+</style>
+</head>
+<body>
+<h1>Invoice {{.Number}}</h1>
+<p>Project: {{.ProjectName}}<br>
+Period: {{date .PeriodStart}} – {{date .PeriodEnd}} (exclusive)<br>
+Currency: {{.Currency}}</p>
+<table>
+<thead>
+<tr><th>Model</th><th>Price reference</th><th>Requests</th><th>Total tokens</th><th>Cost</th><th>Markup %</th><th>Amount</th></tr>
+</thead>
+<tbody>
+{{- range .LineItems}}
+<tr><td>{{.ModelID}}</td><td>{{.PriceReferenceID}}</td><td>{{.RequestCount}}</td><td>{{.TotalTokens}}</td><td>{{amount .Cost}}</td><td>{{amount .MarkupPercent}}</td><td>{{amount .Amount}}</td></tr>
+{{- end}}
+</tbody>
+<tfoot>
+<tr><td colspan="6">Subtotal</td><td>{{amount .Subtotal}}</td></tr>
+<tr><td colspan="6">Markup</td><td>{{amount .MarkupAmount}}</td></tr>
+<tr><td colspan="6">Monthly fee</td><td>{{amount .Fee}}</td></tr>
+<tr><td colspan="6">Total</td><td>{{amount .Total}} {{.Currency}}</td></tr>
+</tfoot>
+</table>
+</body>
+</html>
+`))
+
+func (doc invoiceDocument) html() ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := invoiceHTMLTemplate.Execute(&buf, doc); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,338 @@
+package biz
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/channelmodelpriceversion"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+	"github.com/looplj/axonhub/internal/pkg/xerrors"
+	"github.com/looplj/axonhub/llm/httpclient"
+)
+
+func setupTestInvoiceService(t *testing.T) (context.Context, *ent.Client, *InvoiceService) {
+	t.Helper()
+
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=0")
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := authz.WithTestBypass(ent.NewContext(context.Background(), client))
+
+	systemService := NewSystemService(SystemServiceParams{
+		CacheConfig: xcache.Config{},
+		Ent:         client,
+	})
+
+	exchangeRateService := NewExchangeRateService(ExchangeRateServiceParams{
+		CacheConfig:   xcache.Config{},
+		Ent:           client,
+		SystemService: systemService,
+		HttpClient:    httpclient.NewHttpClient(),
+	})
+	t.Cleanup(exchangeRateService.Stop)
+
+	return ctx, client, NewInvoiceService(InvoiceServiceParams{
+		Ent:                 client,
+		SystemService:       systemService,
+		ExchangeRateService: exchangeRateService,
+	})
+}
+
+func createTestInvoiceExchangeRate(
+	t *testing.T,
+	ctx context.Context,
+	svc *InvoiceService,
+	from, to string,
+	rate float64,
+	effectiveAt time.Time,
+) {
+	t.Helper()
+
+	_, err := svc.ExchangeRateService.CreateExchangeRate(ctx, ent.CreateExchangeRateInput{
+		FromCurrency: from,
+		ToCurrency:   to,
+		Rate:         rate,
+		EffectiveAt:  lo.ToPtr(effectiveAt),
+	})
+	require.NoError(t, err)
+	require.NoError(t, svc.ExchangeRateService.ratesCache.Load(ctx, true))
+}
+
+func createTestInvoiceUsageLog(
+	t *testing.T,
+	ctx context.Context,
+	client *ent.Client,
+	projectID int,
+	modelID, referenceID string,
+	cost float64,
+	createdAt time.Time,
+) {
+	t.Helper()
+
+	_, err := client.UsageLog.Create().
+		SetRequestID(1).
+		SetProjectID(projectID).
+		SetChannelID(1).
+		SetModelID(modelID).
+		SetPromptTokens(100).
+		SetCompletionTokens(50).
+		SetTotalTokens(150).
+		SetTotalCost(cost).
+		SetCostPriceReferenceID(referenceID).
+		SetCreatedAt(createdAt).
+		Save(ctx)
+	require.NoError(t, err)
+}
+
+func TestInvoiceService_GenerateInvoice(t *testing.T) {
+	ctx, client, svc := setupTestInvoiceService(t)
+
+	proj, err := client.Project.Create().
+		SetName("billing").
+		SetBillingSettings(&objects.ProjectBillingSettings{
+			Enabled:       true,
+			MarkupPercent: 20,
+			ModelMarkups:  []objects.ModelMarkup{{Model: "claude-.*", MarkupPercent: 50}},
+			MonthlyFee:    100,
+		}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	other, err := client.Project.Create().SetName("other").Save(ctx)
+	require.NoError(t, err)
+
+	price := objects.ModelPrice{
+		Items: []objects.ModelPriceItem{
+			{
+				ItemCode: objects.PriceItemCodeUsage,
+				Pricing: objects.Pricing{
+					Mode:         objects.PricingModeUsagePerUnit,
+					UsagePerUnit: lo.ToPtr(decimal.NewFromFloat(0.00001)),
+				},
+			},
+		},
+	}
+
+	_, err = client.ChannelModelPriceVersion.Create().
+		SetChannelID(1).
+		SetModelID("gpt-4").
+		SetChannelModelPriceID(1).
+		SetPrice(price).
+		SetStatus(channelmodelpriceversion.StatusArchived).
+		SetEffectiveStartAt(time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)).
+		SetReferenceID("ref-gpt-4").
+		Save(ctx)
+	require.NoError(t, err)
+
+	january := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	createTestInvoiceUsageLog(t, ctx, client, proj.ID, "gpt-4", "ref-gpt-4", 1, january)
+	createTestInvoiceUsageLog(t, ctx, client, proj.ID, "gpt-4", "ref-gpt-4", 1, january)
+	createTestInvoiceUsageLog(t, ctx, client, proj.ID, "gpt-4", "", 0.5, january)
+	createTestInvoiceUsageLog(t, ctx, client, proj.ID, "claude-3-opus", "ref-claude", 2, january)
+	// Usage of other months and other projects is not billed.
+	createTestInvoiceUsageLog(t, ctx, client, proj.ID, "gpt-4", "ref-gpt-4", 10, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+	createTestInvoiceUsageLog(t, ctx, client, other.ID, "gpt-4", "ref-gpt-4", 10, january)
+
+	inv, err := svc.GenerateInvoice(ctx, proj.ID, "2026-01")
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("INV-202601-%d", proj.ID), inv.Number)
+	require.Equal(t, "USD", inv.Currency)
+	require.True(t, inv.PeriodStart.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.True(t, inv.PeriodEnd.Equal(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)))
+	require.InDelta(t, 4.5, inv.Subtotal, 1e-9)
+	require.InDelta(t, 1.5, inv.MarkupAmount, 1e-9)
+	require.InDelta(t, 100, inv.Fee, 1e-9)
+	require.InDelta(t, 106, inv.Total, 1e-9)
+
+	require.Len(t, inv.LineItems, 3)
+
+	claude := inv.LineItems[0]
+	require.Equal(t, "claude-3-opus", claude.ModelID)
+	require.Equal(t, "ref-claude", claude.PriceReferenceID)
+	require.Nil(t, claude.Price)
+	require.InDelta(t, 50, claude.MarkupPercent, 1e-9)
+	require.InDelta(t, 3, claude.Amount, 1e-9)
+
+	unpriced := inv.LineItems[1]
+	require.Equal(t, "gpt-4", unpriced.ModelID)
+	require.Empty(t, unpriced.PriceReferenceID)
+	require.InDelta(t, 0.6, unpriced.Amount, 1e-9)
+
+	gpt4 := inv.LineItems[2]
+	require.Equal(t, "ref-gpt-4", gpt4.PriceReferenceID)
+	require.Equal(t, int64(2), gpt4.RequestCount)
+	require.Equal(t, int64(300), gpt4.TotalTokens)
+	require.NotNil(t, gpt4.Price)
+	require.True(t, gpt4.Price.Equals(price))
+	require.InDelta(t, 20, gpt4.MarkupPercent, 1e-9)
+	require.InDelta(t, 2.4, gpt4.Amount, 1e-9)
+
+	// The invoice keeps the settings it was generated with.
+	_, err = client.Project.UpdateOneID(proj.ID).
+		SetBillingSettings(&objects.ProjectBillingSettings{Enabled: true, MarkupPercent: 80}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	stored, err := client.Invoice.Get(ctx, inv.ID)
+	require.NoError(t, err)
+	require.InDelta(t, 20, stored.BillingSettings.MarkupPercent, 1e-9)
+	require.InDelta(t, 106, stored.Total, 1e-9)
+
+	_, err = svc.GenerateInvoice(ctx, proj.ID, "2026-01")
+	require.True(t, xerrors.As0[*xerrors.CodedError](err))
+	require.ErrorContains(t, err, "invoice already exists")
+
+	_, err = svc.GenerateInvoice(ctx, other.ID, "2026-01")
+	require.ErrorContains(t, err, "billing is not enabled")
+
+	_, err = svc.GenerateInvoice(ctx, proj.ID, "2026-13")
+	require.ErrorContains(t, err, "invalid invoice month")
+
+	_, err = svc.GenerateInvoice(ctx, proj.ID, "2999-01")
+	require.ErrorContains(t, err, "has not ended yet")
+}
+
+func TestInvoiceService_ExportInvoice(t *testing.T) {
+	ctx, client, svc := setupTestInvoiceService(t)
+
+	proj, err := client.Project.Create().
+		SetName("billing").
+		SetBillingSettings(&objects.ProjectBillingSettings{Enabled: true, Currency: "EUR", MarkupPercent: 10}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	createTestInvoiceUsageLog(t, ctx, client, proj.ID, "gpt-4", "ref-gpt-4", 4, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC))
+	createTestInvoiceExchangeRate(t, ctx, svc, "USD", "EUR", 0.5, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	inv, err := svc.GenerateInvoice(ctx, proj.ID, "2026-03")
+	require.NoError(t, err)
+
+	export, err := svc.ExportInvoice(ctx, inv, InvoiceExportFormatCSV)
+	require.NoError(t, err)
+	require.Equal(t, inv.Number+".csv", export.Filename)
+
+	records, err := csv.NewReader(strings.NewReader(string(export.Content))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 6)
+	require.Equal(t, "model_id", records[0][0])
+	require.Equal(t, []string{"gpt-4", "ref-gpt-4", "1", "100", "0", "0", "50", "150", "2", "10", "2.2"}, records[1], "the cost is converted into EUR")
+	require.Equal(t, "total", records[5][0])
+	require.Equal(t, "2.2", records[5][len(records[5])-1])
+
+	export, err = svc.ExportInvoice(ctx, inv, InvoiceExportFormatJSON)
+	require.NoError(t, err)
+	require.Equal(t, "application/json", export.ContentType)
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(export.Content, &doc))
+	require.Equal(t, inv.Number, doc["number"])
+	require.Equal(t, "billing", doc["projectName"])
+	require.Equal(t, "EUR", doc["currency"])
+
+	export, err = svc.ExportInvoice(ctx, inv, InvoiceExportFormatHTML)
+	require.NoError(t, err)
+	require.Contains(t, string(export.Content), "Invoice "+inv.Number)
+	require.Contains(t, string(export.Content), "<td>gpt-4</td>")
+	require.Contains(t, string(export.Content), "2.2 EUR")
+
+	_, err = svc.ExportInvoice(ctx, inv, "pdf")
+	require.ErrorContains(t, err, "unsupported invoice export format")
+}
+
+func TestInvoiceService_GenerateInvoice_ConvertsCurrencies(t *testing.T) {
+	ctx, client, svc := setupTestInvoiceService(t)
+
+	proj, err := client.Project.Create().
+		SetName("billing").
+		SetBillingSettings(&objects.ProjectBillingSettings{Enabled: true}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	january := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	createTestInvoiceUsageLog(t, ctx, client, proj.ID, "gpt-4", "ref-gpt-4", 1, january)
+
+	// A cost converted into the reporting currency, and a cost kept in EUR as there was no rate.
+	_, err = client.UsageLog.Create().
+		SetRequestID(2).
+		SetProjectID(proj.ID).
+		SetChannelID(1).
+		SetModelID("gpt-4").
+		SetTotalCost(2).
+		SetCurrency("USD").
+		SetOriginalTotalCost(1).
+		SetOriginalCurrency("GBP").
+		SetExchangeRate(2).
+		SetCostPriceReferenceID("ref-gpt-4").
+		SetCreatedAt(january).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.UsageLog.Create().
+		SetRequestID(3).
+		SetProjectID(proj.ID).
+		SetChannelID(1).
+		SetModelID("gpt-4").
+		SetCurrency("EUR").
+		SetOriginalTotalCost(3).
+		SetOriginalCurrency("EUR").
+		SetCostPriceReferenceID("ref-gpt-4").
+		SetCreatedAt(january).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = svc.GenerateInvoice(ctx, proj.ID, "2026-01")
+	require.ErrorContains(t, err, "no exchange rate to convert the EUR costs of model gpt-4 into USD")
+
+	createTestInvoiceExchangeRate(t, ctx, svc, "EUR", "USD", 1.5, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	inv, err := svc.GenerateInvoice(ctx, proj.ID, "2026-01")
+	require.NoError(t, err)
+	require.Equal(t, "USD", inv.Currency)
+	require.Len(t, inv.LineItems, 1)
+	require.Equal(t, int64(3), inv.LineItems[0].RequestCount)
+	require.InDelta(t, 7.5, inv.LineItems[0].Cost, 1e-9)
+	require.InDelta(t, 7.5, inv.Subtotal, 1e-9)
+}
+
+func TestInvoiceService_GenerateInvoice_UsesRatesOfPeriod(t *testing.T) {
+	ctx, client, svc := setupTestInvoiceService(t)
+
+	proj, err := client.Project.Create().
+		SetName("billing").
+		SetBillingSettings(&objects.ProjectBillingSettings{Enabled: true, Currency: "EUR"}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	createTestInvoiceUsageLog(t, ctx, client, proj.ID, "gpt-4", "ref-gpt-4", 4, time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC))
+	createTestInvoiceExchangeRate(t, ctx, svc, "USD", "EUR", 0.5, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	// The rate taking effect when the period has ended is not used.
+	createTestInvoiceExchangeRate(t, ctx, svc, "USD", "EUR", 0.8, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC))
+
+	inv, err := svc.GenerateInvoice(ctx, proj.ID, "2026-03")
+	require.NoError(t, err)
+	require.InDelta(t, 2, inv.Subtotal, 1e-9)
+
+	// A rate changed after the period does not change the invoice generated again.
+	createTestInvoiceExchangeRate(t, ctx, svc, "USD", "EUR", 0.9, time.Now().Add(-time.Minute))
+	require.NoError(t, client.Invoice.DeleteOneID(inv.ID).Exec(ctx))
+
+	regenerated, err := svc.GenerateInvoice(ctx, proj.ID, "2026-03")
+	require.NoError(t, err)
+	require.InDelta(t, inv.Subtotal, regenerated.Subtotal, 1e-9)
+	require.InDelta(t, inv.Total, regenerated.Total, 1e-9)
+	require.InDelta(t, inv.LineItems[0].Cost, regenerated.LineItems[0].Cost, 1e-9)
+}
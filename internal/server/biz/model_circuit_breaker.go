@@ -102,6 +102,9 @@ type ModelCircuitBreakerStats struct {
 type ModelCircuitBreaker struct {
 	// In-memory model health statistics
 	modelStats *xmap.Map[ChannelModelKey, *ModelCircuitBreakerStats]
+
+	// Optional notifier of the opened circuits
+	webhookNotifier *WebhookNotifier
 }
 
 // NewModelCircuitBreaker creates a new model circuit breaker.
@@ -111,6 +114,11 @@ func NewModelCircuitBreaker() *ModelCircuitBreaker {
 	}
 }
 
+// SetWebhookNotifier wires the optional notifier of the opened circuits.
+func (m *ModelCircuitBreaker) SetWebhookNotifier(n *WebhookNotifier) {
+	m.webhookNotifier = n
+}
+
 // ChannelModelKey generates a unique key for channel and model combination.
 func (m *ModelCircuitBreaker) ChannelModelKey(channelID int, modelID string) ChannelModelKey {
 	return ChannelModelKey{
@@ -190,6 +198,16 @@ func (m *ModelCircuitBreaker) RecordError(ctx context.Context, channelID int, mo
 				log.String("model_id", modelID),
 				log.Int("failures", stats.ConsecutiveFailures),
 			)
+
+			if m.webhookNotifier != nil {
+				go m.webhookNotifier.NotifyCircuitBreakerOpened(context.WithoutCancel(ctx), CircuitBreakerOpenedEvent{
+					ChannelID:           channelID,
+					ModelID:             modelID,
+					ConsecutiveFailures: stats.ConsecutiveFailures,
+					NextProbeAt:         stats.NextProbeAt,
+					OccurredAt:          now,
+				})
+			}
 		} else if wasProbe {
 			// Only apply exponential backoff when an actual probe failed.
 			// Non-probe errors (e.g. requests rejected by circuit breaker) must not
@@ -356,6 +374,21 @@ func (m *ModelCircuitBreaker) EndProbe(channelID int, modelID string) {
 	atomic.StoreInt32(&stats.probingInProgress, 0)
 }
 
+// States returns the current state of every channel model tracked by the circuit breaker.
+func (m *ModelCircuitBreaker) States() map[ChannelModelKey]CircuitBreakerState {
+	states := make(map[ChannelModelKey]CircuitBreakerState)
+
+	m.modelStats.Range(func(key ChannelModelKey, stats *ModelCircuitBreakerStats) bool {
+		stats.RLock()
+		states[key] = stats.State
+		stats.RUnlock()
+
+		return true
+	})
+
+	return states
+}
+
 // GetAllNonClosedModels returns all models that are not in closed state.
 func (m *ModelCircuitBreaker) GetAllNonClosedModels(ctx context.Context) []*ModelCircuitBreakerStats {
 	var nonClosed []*ModelCircuitBreakerStats
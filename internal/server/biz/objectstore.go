@@ -11,7 +11,7 @@ import (
 )
 
 // ObjectStore is a minimal object-oriented blob API used natively for backends
-// with real Class-A pricing (currently S3; GCS planned). Filesystem and WebDAV
+// with real Class-A pricing (S3 and Azure Blob; GCS planned). Filesystem and WebDAV
 // backends keep the afero path; Database is a no-op above this layer.
 //
 // Keys passed to an ObjectStore are already normalized via normalizeObjectKey
@@ -50,21 +50,17 @@ func normalizeObjectKey(key string) string {
 }
 
 // objectStoreFor returns a native ObjectStore for backends that have one
-// (currently S3 only). For all other backends it returns (nil, false, nil) so
+// (S3 and Azure Blob). For all other backends it returns (nil, false, nil) so
 // the caller falls back to the afero filesystem path or the Database no-op.
 //
 // Clients are cached by data storage ID alongside fsCache and invalidated in
 // lockstep (see InvalidateFsCache / InvalidateAllDataStorageCache /
-// refreshFileSystems). Building a client performs no S3 network call.
+// refreshFileSystems). Building a client performs no network call.
 func (s *DataStorageService) objectStoreFor(ctx context.Context, ds *ent.DataStorage) (ObjectStore, bool, error) {
-	if ds == nil || ds.Type != datastorage.TypeS3 {
+	if ds == nil || !hasObjectStore(ds.Type) {
 		return nil, false, nil
 	}
 
-	if ds.Settings == nil || ds.Settings.S3 == nil {
-		return nil, false, fmt.Errorf("s3 settings not configured")
-	}
-
 	s.fsCacheMu.RLock()
 	if store, ok := s.objectStoreCache[ds.ID]; ok {
 		s.fsCacheMu.RUnlock()
@@ -72,9 +68,9 @@ func (s *DataStorageService) objectStoreFor(ctx context.Context, ds *ent.DataSto
 	}
 	s.fsCacheMu.RUnlock()
 
-	store, err := newS3ObjectStore(ctx, ds.Settings.S3)
+	store, err := newObjectStore(ctx, ds)
 	if err != nil {
-		return nil, false, fmt.Errorf("failed to create s3 object store: %w", err)
+		return nil, false, err
 	}
 
 	s.fsCacheMu.Lock()
@@ -83,3 +79,38 @@ func (s *DataStorageService) objectStoreFor(ctx context.Context, ds *ent.DataSto
 
 	return store, true, nil
 }
+
+// hasObjectStore reports whether the backend has a native ObjectStore.
+func hasObjectStore(t datastorage.Type) bool {
+	return t == datastorage.TypeS3 || t == datastorage.TypeAzblob
+}
+
+// newObjectStore builds an uncached native ObjectStore for the data storage.
+func newObjectStore(ctx context.Context, ds *ent.DataStorage) (ObjectStore, error) {
+	switch ds.Type {
+	case datastorage.TypeS3:
+		if ds.Settings == nil || ds.Settings.S3 == nil {
+			return nil, fmt.Errorf("s3 settings not configured")
+		}
+
+		store, err := newS3ObjectStore(ctx, ds.Settings.S3)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create s3 object store: %w", err)
+		}
+
+		return store, nil
+	case datastorage.TypeAzblob:
+		if ds.Settings == nil || ds.Settings.AzureBlob == nil {
+			return nil, fmt.Errorf("azure blob settings not configured")
+		}
+
+		store, err := newAzureBlobObjectStore(ds.Settings.AzureBlob)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create azure blob object store: %w", err)
+		}
+
+		return store, nil
+	default:
+		return nil, fmt.Errorf("unsupported object store type: %s", ds.Type)
+	}
+}
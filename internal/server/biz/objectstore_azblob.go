@@ -0,0 +1,182 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// azureBlobBlockSize is the block size used for streaming uploads. Payloads
+// smaller than one block are uploaded with a single Put Blob, larger ones are
+// staged in blocks of this size and committed with one Put Block List.
+const azureBlobBlockSize = 16 * 1024 * 1024 // 16 MiB
+
+// newAzureBlobContainerClient builds a container client from the given config.
+// It performs no network call, so a wrong credential only surfaces on the first
+// operation (see TestDataStorageConnection).
+func newAzureBlobContainerClient(cfg *objects.AzureBlob) (*container.Client, error) {
+	if cfg.AccountName == "" && cfg.Endpoint == "" {
+		return nil, fmt.Errorf("azure blob account name or endpoint is required")
+	}
+
+	if cfg.ContainerName == "" {
+		return nil, fmt.Errorf("azure blob container name is required")
+	}
+
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", cfg.AccountName)
+	}
+
+	containerURL, err := url.JoinPath(endpoint, cfg.ContainerName)
+	if err != nil {
+		return nil, fmt.Errorf("invalid azure blob endpoint: %w", err)
+	}
+
+	switch cfg.AuthType {
+	case objects.AzureBlobAuthSharedKey, "":
+		cred, err := container.NewSharedKeyCredential(cfg.AccountName, cfg.AccountKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid azure blob shared key: %w", err)
+		}
+
+		return container.NewClientWithSharedKeyCredential(containerURL, cred, nil)
+	case objects.AzureBlobAuthSAS:
+		if cfg.SASToken == "" {
+			return nil, fmt.Errorf("azure blob sas token is required")
+		}
+
+		return container.NewClientWithNoCredential(containerURL+"?"+strings.TrimPrefix(cfg.SASToken, "?"), nil)
+	case objects.AzureBlobAuthManagedIdentity:
+		opts := &azidentity.ManagedIdentityCredentialOptions{}
+		if cfg.ClientID != "" {
+			opts.ID = azidentity.ClientID(cfg.ClientID)
+		}
+
+		cred, err := azidentity.NewManagedIdentityCredential(opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create managed identity credential: %w", err)
+		}
+
+		return container.NewClient(containerURL, cred, nil)
+	default:
+		return nil, fmt.Errorf("unsupported azure blob auth type: %s", cfg.AuthType)
+	}
+}
+
+// azureBlobObjectStore is the native ObjectStore implementation for Azure Blob
+// Storage. Like s3ObjectStore it issues one call per logical operation.
+type azureBlobObjectStore struct {
+	client *container.Client
+}
+
+func newAzureBlobObjectStore(cfg *objects.AzureBlob) (*azureBlobObjectStore, error) {
+	client, err := newAzureBlobContainerClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &azureBlobObjectStore{client: client}, nil
+}
+
+func (o *azureBlobObjectStore) PutObject(ctx context.Context, key string, data []byte) error {
+	_, err := o.client.NewBlockBlobClient(key).UploadBuffer(ctx, data, nil)
+	if err != nil {
+		return fmt.Errorf("azure blob put %q: %w", key, err)
+	}
+
+	return nil
+}
+
+func (o *azureBlobObjectStore) PutObjectStream(ctx context.Context, key string, r io.Reader, _ int64) (int64, error) {
+	// UploadStream always stages blocks and commits a block list, read the first
+	// block ahead so that small payloads are uploaded with a single Put Blob.
+	head := make([]byte, azureBlobBlockSize)
+
+	n, err := io.ReadFull(r, head)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		if err := o.PutObject(ctx, key, head[:n]); err != nil {
+			return 0, err
+		}
+
+		return int64(n), nil
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("azure blob upload %q: %w", key, err)
+	}
+
+	cr := &countingReader{r: io.MultiReader(bytes.NewReader(head), r)}
+
+	_, err = o.client.NewBlockBlobClient(key).UploadStream(ctx, cr, &blockblob.UploadStreamOptions{
+		BlockSize: azureBlobBlockSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("azure blob upload %q: %w", key, err)
+	}
+
+	return cr.n, nil
+}
+
+func (o *azureBlobObjectStore) GetObject(ctx context.Context, key string) ([]byte, error) {
+	body, _, err := o.OpenObject(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return io.ReadAll(body)
+}
+
+func (o *azureBlobObjectStore) OpenObject(ctx context.Context, key string) (io.ReadCloser, int64, error) {
+	return o.openRange(ctx, key, 0)
+}
+
+// openRange streams the blob from the offset to its end.
+func (o *azureBlobObjectStore) openRange(ctx context.Context, key string, offset int64) (io.ReadCloser, int64, error) {
+	resp, err := o.client.NewBlobClient(key).DownloadStream(ctx, &blob.DownloadStreamOptions{
+		Range: blob.HTTPRange{Offset: offset},
+	})
+	if err != nil {
+		if isAzureBlobNotFound(err) {
+			return nil, 0, fmt.Errorf("%w: %s", os.ErrNotExist, key)
+		}
+
+		return nil, 0, fmt.Errorf("azure blob get %q: %w", key, err)
+	}
+
+	var size int64
+	if resp.ContentLength != nil {
+		size = *resp.ContentLength
+	}
+
+	return resp.Body, size, nil
+}
+
+func (o *azureBlobObjectStore) DeleteObject(ctx context.Context, key string) error {
+	// Azure returns 404 for a missing blob, deleting is idempotent like on S3.
+	_, err := o.client.NewBlobClient(key).Delete(ctx, nil)
+	if err != nil && !isAzureBlobNotFound(err) {
+		return fmt.Errorf("azure blob delete %q: %w", key, err)
+	}
+
+	return nil
+}
+
+// isAzureBlobNotFound reports whether err represents a missing blob.
+func isAzureBlobNotFound(err error) bool {
+	return bloberror.HasCode(err, bloberror.BlobNotFound, bloberror.ResourceNotFound)
+}
@@ -0,0 +1,167 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/spf13/afero"
+
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// TestAzureBlobObjectStoreIntegration exercises the Azure Blob ObjectStore and
+// filesystem against the Azurite emulator. It is skipped unless
+// AXONHUB_TEST_AZURITE_ENDPOINT is set, so it never runs during a normal `go test ./...`.
+//
+//	docker run -d --rm --name azurite -p 10000:10000 \
+//	  mcr.microsoft.com/azure-storage/azurite azurite-blob --blobHost 0.0.0.0
+//	AXONHUB_TEST_AZURITE_ENDPOINT=http://127.0.0.1:10000/devstoreaccount1 go test ./internal/server/biz/ -run TestAzureBlobObjectStoreIntegration -v
+func TestAzureBlobObjectStoreIntegration(t *testing.T) {
+	cfg := azuriteTestConfig(t)
+	ctx := context.Background()
+
+	store, err := newAzureBlobObjectStore(cfg)
+	if err != nil {
+		t.Fatalf("newAzureBlobObjectStore: %v", err)
+	}
+
+	if _, err := store.client.Create(ctx, nil); err != nil && !bloberror.HasCode(err, bloberror.ContainerAlreadyExists) {
+		t.Fatalf("create container: %v", err)
+	}
+
+	t.Run("put/get round trip", func(t *testing.T) {
+		key := "2/requests/5/request_body.json"
+		data := []byte(`{"hello":"world"}`)
+
+		if err := store.PutObject(ctx, key, data); err != nil {
+			t.Fatalf("PutObject: %v", err)
+		}
+
+		got, err := store.GetObject(ctx, key)
+		if err != nil {
+			t.Fatalf("GetObject: %v", err)
+		}
+
+		if !bytes.Equal(got, data) {
+			t.Fatalf("GetObject = %q, want %q", got, data)
+		}
+	})
+
+	t.Run("missing key maps to os.ErrNotExist", func(t *testing.T) {
+		if _, err := store.GetObject(ctx, "2/requests/does-not-exist.json"); !errors.Is(err, os.ErrNotExist) {
+			t.Fatalf("GetObject(missing) = %v, want os.ErrNotExist", err)
+		}
+
+		if _, _, err := store.OpenObject(ctx, "2/requests/does-not-exist.json"); !errors.Is(err, os.ErrNotExist) {
+			t.Fatalf("OpenObject(missing) = %v, want os.ErrNotExist", err)
+		}
+	})
+
+	t.Run("delete is idempotent", func(t *testing.T) {
+		key := "2/requests/5/delete_me.json"
+		if err := store.PutObject(ctx, key, []byte("x")); err != nil {
+			t.Fatalf("PutObject: %v", err)
+		}
+
+		if err := store.DeleteObject(ctx, key); err != nil {
+			t.Fatalf("DeleteObject: %v", err)
+		}
+
+		if err := store.DeleteObject(ctx, key); err != nil {
+			t.Fatalf("DeleteObject(missing) = %v, want nil", err)
+		}
+	})
+
+	t.Run("stream multi block round trip", func(t *testing.T) {
+		key := "2/videos/5/video.mp4"
+		payload := bytes.Repeat([]byte("b"), azureBlobBlockSize+(1<<20)) // > one block -> block list
+
+		n, err := store.PutObjectStream(ctx, key, bytes.NewReader(payload), -1)
+		if err != nil {
+			t.Fatalf("PutObjectStream: %v", err)
+		}
+
+		if n != int64(len(payload)) {
+			t.Fatalf("PutObjectStream wrote %d bytes, want %d", n, len(payload))
+		}
+
+		rc, size, err := store.OpenObject(ctx, key)
+		if err != nil {
+			t.Fatalf("OpenObject: %v", err)
+		}
+		defer rc.Close()
+
+		if size != int64(len(payload)) {
+			t.Fatalf("OpenObject size = %d, want %d", size, len(payload))
+		}
+
+		got, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatalf("read OpenObject: %v", err)
+		}
+
+		if !bytes.Equal(got, payload) {
+			t.Fatalf("stream payload mismatch (got %d bytes)", len(got))
+		}
+	})
+
+	t.Run("filesystem lists and removes backups", func(t *testing.T) {
+		fs := newAzureBlobFs(store)
+		name := "axonhub-backup-20260101-000000.tar.zst"
+
+		if err := afero.WriteFile(fs, name, []byte("backup"), 0o644); err != nil {
+			t.Fatalf("WriteFile: %v", err)
+		}
+
+		entries, err := afero.ReadDir(fs, "/")
+		if err != nil {
+			t.Fatalf("ReadDir: %v", err)
+		}
+
+		var found bool
+
+		for _, entry := range entries {
+			if entry.Name() == name {
+				found = !entry.IsDir() && entry.Size() == int64(len("backup"))
+			}
+		}
+
+		if !found {
+			t.Fatalf("ReadDir(/) does not list %s as a file", name)
+		}
+
+		if info, err := fs.Stat("2/requests"); err != nil || !info.IsDir() {
+			t.Fatalf("Stat(2/requests) = %v, %v, want a directory", info, err)
+		}
+
+		if err := fs.Remove(name); err != nil {
+			t.Fatalf("Remove: %v", err)
+		}
+
+		if _, err := fs.Stat(name); !errors.Is(err, os.ErrNotExist) {
+			t.Fatalf("Stat after Remove = %v, want os.ErrNotExist", err)
+		}
+	})
+}
+
+func azuriteTestConfig(t *testing.T) *objects.AzureBlob {
+	t.Helper()
+
+	endpoint := os.Getenv("AXONHUB_TEST_AZURITE_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("AXONHUB_TEST_AZURITE_ENDPOINT not set; skipping Azure Blob integration test")
+	}
+
+	return &objects.AzureBlob{
+		AccountName:   getenvDefault("AXONHUB_TEST_AZURITE_ACCOUNT", "devstoreaccount1"),
+		ContainerName: getenvDefault("AXONHUB_TEST_AZURITE_CONTAINER", "axonhub-test"),
+		Endpoint:      endpoint,
+		AuthType:      objects.AzureBlobAuthSharedKey,
+		AccountKey:    getenvDefault("AXONHUB_TEST_AZURITE_KEY", azuriteAccountKey),
+	}
+}
@@ -0,0 +1,120 @@
+package biz
+
+import (
+	"testing"
+
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// azuriteAccountKey is the well-known development key of the Azurite emulator.
+const azuriteAccountKey = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+
+func TestNewAzureBlobContainerClient(t *testing.T) {
+	cases := []struct {
+		name    string
+		cfg     objects.AzureBlob
+		wantURL string
+		wantErr bool
+	}{
+		{
+			name:    "shared key with default endpoint",
+			cfg:     objects.AzureBlob{AccountName: "account", ContainerName: "axonhub", AccountKey: azuriteAccountKey},
+			wantURL: "https://account.blob.core.windows.net/axonhub",
+		},
+		{
+			name: "shared key with custom endpoint",
+			cfg: objects.AzureBlob{
+				AccountName:   "devstoreaccount1",
+				ContainerName: "axonhub",
+				Endpoint:      "http://127.0.0.1:10000/devstoreaccount1",
+				AuthType:      objects.AzureBlobAuthSharedKey,
+				AccountKey:    azuriteAccountKey,
+			},
+			wantURL: "http://127.0.0.1:10000/devstoreaccount1/axonhub",
+		},
+		{
+			name: "sas token",
+			cfg: objects.AzureBlob{
+				AccountName:   "account",
+				ContainerName: "axonhub",
+				AuthType:      objects.AzureBlobAuthSAS,
+				SASToken:      "?sv=2022-11-02&sig=abc",
+			},
+			wantURL: "https://account.blob.core.windows.net/axonhub?sv=2022-11-02&sig=abc",
+		},
+		{
+			name: "managed identity",
+			cfg: objects.AzureBlob{
+				AccountName:   "account",
+				ContainerName: "axonhub",
+				AuthType:      objects.AzureBlobAuthManagedIdentity,
+				ClientID:      "00000000-0000-0000-0000-000000000000",
+			},
+			wantURL: "https://account.blob.core.windows.net/axonhub",
+		},
+		{
+			name:    "missing account",
+			cfg:     objects.AzureBlob{ContainerName: "axonhub", AccountKey: azuriteAccountKey},
+			wantErr: true,
+		},
+		{
+			name:    "missing container",
+			cfg:     objects.AzureBlob{AccountName: "account", AccountKey: azuriteAccountKey},
+			wantErr: true,
+		},
+		{
+			name:    "invalid shared key",
+			cfg:     objects.AzureBlob{AccountName: "account", ContainerName: "axonhub", AccountKey: "not base64"},
+			wantErr: true,
+		},
+		{
+			name:    "missing sas token",
+			cfg:     objects.AzureBlob{AccountName: "account", ContainerName: "axonhub", AuthType: objects.AzureBlobAuthSAS},
+			wantErr: true,
+		},
+		{
+			name:    "unknown auth type",
+			cfg:     objects.AzureBlob{AccountName: "account", ContainerName: "axonhub", AuthType: "oauth"},
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client, err := newAzureBlobContainerClient(&c.cfg)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("newAzureBlobContainerClient() = nil error, want error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("newAzureBlobContainerClient: %v", err)
+			}
+
+			if got := client.URL(); got != c.wantURL {
+				t.Errorf("container URL = %q, want %q", got, c.wantURL)
+			}
+		})
+	}
+}
+
+func TestAzureBlobKey(t *testing.T) {
+	cases := []struct {
+		in, want string
+	}{
+		{"/2/requests/5/request_body.json", "2/requests/5/request_body.json"},
+		{"axonhub-backup-20260101-000000.tar.zst", "axonhub-backup-20260101-000000.tar.zst"},
+		{"/videos/", "videos"},
+		{"/", ""},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		if got := azureBlobKey(c.in); got != c.want {
+			t.Errorf("azureBlobKey(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
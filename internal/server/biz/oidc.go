@@ -761,18 +761,27 @@ func (s *OIDCService) Callback(ctx context.Context, providerIdentifier, code, st
 		return "", "", fmt.Errorf("User account is deactivated")
 	}
 
-	// Generate short-lived exchange code
+	exchangeCode, err := s.issueExchangeCode(ctx, userEntity.ID)
+	if err != nil {
+		return "", "", err
+	}
+
+	return exchangeCode, "login", nil
+}
+
+// issueExchangeCode generates a short-lived, single-use code the frontend exchanges for a JWT of the user.
+func (s *OIDCService) issueExchangeCode(ctx context.Context, userID int) (string, error) {
 	exchangeCodeBytes := make([]byte, 32)
 	_, _ = rand.Read(exchangeCodeBytes)
 	exchangeCode := hex.EncodeToString(exchangeCodeBytes)
 
 	// Cache user ID for exchange (valid for 5 mins)
-	err = s.cache.Set(ctx, "oidc_exchange:"+exchangeCode, fmt.Appendf(nil, "%d", userEntity.ID), store.WithExpiration(5*time.Minute))
+	err := s.cache.Set(ctx, "oidc_exchange:"+exchangeCode, fmt.Appendf(nil, "%d", userID), store.WithExpiration(5*time.Minute))
 	if err != nil {
-		return "", "", fmt.Errorf("failed to cache exchange code: %w", err)
+		return "", fmt.Errorf("failed to cache exchange code: %w", err)
 	}
 
-	return exchangeCode, "login", nil
+	return exchangeCode, nil
 }
 
 type oidcClaims struct {
@@ -3,15 +3,18 @@ package biz
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 
+	"github.com/looplj/axonhub/internal/authz"
 	"github.com/looplj/axonhub/internal/contexts"
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/datastorage"
 	"github.com/looplj/axonhub/internal/ent/project"
 	"github.com/looplj/axonhub/internal/ent/role"
 	"github.com/looplj/axonhub/internal/ent/userproject"
@@ -19,6 +22,7 @@ import (
 	"github.com/looplj/axonhub/internal/objects"
 	"github.com/looplj/axonhub/internal/pkg/xcache"
 	"github.com/looplj/axonhub/internal/pkg/xerrors"
+	"github.com/looplj/axonhub/internal/pkg/xregexp"
 	"github.com/looplj/axonhub/internal/scopes"
 )
 
@@ -295,6 +299,190 @@ func ValidateProjectProfiles(profiles objects.ProjectProfiles) error {
 	return nil
 }
 
+// UpdateProjectModerationSettings updates the moderation guardrail settings of a project.
+func (s *ProjectService) UpdateProjectModerationSettings(ctx context.Context, id int, settings objects.ProjectModerationSettings) (*ent.Project, error) {
+	if err := ValidateProjectModerationSettings(settings); err != nil {
+		return nil, err
+	}
+
+	client := s.entFromContext(ctx)
+
+	proj, err := client.Project.UpdateOneID(id).
+		SetModerationSettings(&settings).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update project moderation settings: %w", err)
+	}
+
+	s.invalidateProjectCache(ctx, id)
+
+	return proj, nil
+}
+
+// ValidateProjectModerationSettings validates the moderation model, action and category thresholds.
+func ValidateProjectModerationSettings(settings objects.ProjectModerationSettings) error {
+	if !settings.Enabled {
+		return nil
+	}
+
+	if strings.TrimSpace(settings.Model) == "" {
+		return fmt.Errorf("moderation model is required")
+	}
+
+	if settings.Action != objects.ModerationActionBlock && settings.Action != objects.ModerationActionFlag {
+		return fmt.Errorf("invalid moderation action: %s", settings.Action)
+	}
+
+	if !settings.CheckInput && !settings.CheckOutput {
+		return fmt.Errorf("at least one of input or output moderation must be enabled")
+	}
+
+	seen := make(map[string]bool)
+
+	for _, threshold := range settings.Thresholds {
+		category := strings.TrimSpace(threshold.Category)
+		if category == "" {
+			return fmt.Errorf("moderation threshold category cannot be empty")
+		}
+
+		if seen[category] {
+			return fmt.Errorf("duplicate moderation threshold category: %s", category)
+		}
+
+		seen[category] = true
+
+		if threshold.Score < 0 || threshold.Score > 1 {
+			return fmt.Errorf("moderation threshold for '%s' must be between 0 and 1", category)
+		}
+	}
+
+	return nil
+}
+
+// UpdateProjectBillingSettings updates the billing settings used to generate the invoices of a project.
+func (s *ProjectService) UpdateProjectBillingSettings(ctx context.Context, id int, settings objects.ProjectBillingSettings) (*ent.Project, error) {
+	if err := ValidateProjectBillingSettings(settings); err != nil {
+		return nil, err
+	}
+
+	client := s.entFromContext(ctx)
+
+	proj, err := client.Project.UpdateOneID(id).
+		SetBillingSettings(&settings).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update project billing settings: %w", err)
+	}
+
+	s.invalidateProjectCache(ctx, id)
+
+	return proj, nil
+}
+
+// ValidateProjectBillingSettings validates the markups, the model patterns and the monthly fee.
+func ValidateProjectBillingSettings(settings objects.ProjectBillingSettings) error {
+	if settings.MarkupPercent < -100 {
+		return fmt.Errorf("markup percent cannot be less than -100")
+	}
+
+	if settings.MonthlyFee < 0 {
+		return fmt.Errorf("monthly fee cannot be negative")
+	}
+
+	for _, markup := range settings.ModelMarkups {
+		if strings.TrimSpace(markup.Model) == "" {
+			return fmt.Errorf("model markup pattern cannot be empty")
+		}
+
+		if err := xregexp.ValidateRegex(markup.Model); err != nil {
+			return fmt.Errorf("invalid model markup pattern '%s': %w", markup.Model, err)
+		}
+
+		if markup.MarkupPercent < -100 {
+			return fmt.Errorf("markup percent of '%s' cannot be less than -100", markup.Model)
+		}
+	}
+
+	return nil
+}
+
+// UpdateProjectDataPolicy updates the content capture and retention policy of a project.
+func (s *ProjectService) UpdateProjectDataPolicy(ctx context.Context, id int, policy objects.ProjectDataPolicy) (*ent.Project, error) {
+	if err := ValidateProjectDataPolicy(policy); err != nil {
+		return nil, err
+	}
+
+	client := s.entFromContext(ctx)
+
+	if policy.DataStorageID != nil {
+		// The project admins are not required to read the data storages to select one.
+		ds, err := client.DataStorage.Get(authz.WithSystemBypass(ctx, "project-data-policy-storage"), *policy.DataStorageID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("data storage %d not found", *policy.DataStorageID)
+			}
+
+			return nil, fmt.Errorf("failed to get data storage: %w", err)
+		}
+
+		if ds.Status != datastorage.StatusActive {
+			return nil, fmt.Errorf("data storage %s is not active", ds.Name)
+		}
+	}
+
+	proj, err := client.Project.UpdateOneID(id).
+		SetDataPolicy(&policy).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update project data policy: %w", err)
+	}
+
+	s.invalidateProjectCache(ctx, id)
+
+	return proj, nil
+}
+
+// ValidateProjectDataPolicy validates the redact paths and the retention days.
+func ValidateProjectDataPolicy(policy objects.ProjectDataPolicy) error {
+	for _, path := range policy.RedactFields {
+		if strings.TrimSpace(path) == "" {
+			return fmt.Errorf("redact field path cannot be empty")
+		}
+
+		if slices.Contains(strings.Split(path, "."), "") {
+			return fmt.Errorf("invalid redact field path '%s'", path)
+		}
+	}
+
+	retention := policy.Retention
+	if retention == nil {
+		return nil
+	}
+
+	for _, item := range []struct {
+		name string
+		days *int
+	}{
+		{name: "requests", days: retention.RequestsDays},
+		{name: "executions", days: retention.ExecutionsDays},
+		{name: "usage logs", days: retention.UsageLogsDays},
+		{name: "threads", days: retention.ThreadsDays},
+		{name: "traces", days: retention.TracesDays},
+	} {
+		if item.days != nil && *item.days < 0 {
+			return fmt.Errorf("%s retention days cannot be negative", item.name)
+		}
+	}
+
+	// The executions are deleted with their requests, they can not be kept longer.
+	if retention.RequestsDays != nil && *retention.RequestsDays > 0 && retention.ExecutionsDays != nil &&
+		(*retention.ExecutionsDays == 0 || *retention.ExecutionsDays > *retention.RequestsDays) {
+		return fmt.Errorf("executions retention days cannot exceed requests retention days")
+	}
+
+	return nil
+}
+
 // UpdateProjectStatus updates the status of a project.
 func (s *ProjectService) UpdateProjectStatus(ctx context.Context, id int, status project.Status) (*ent.Project, error) {
 	client := s.entFromContext(ctx)
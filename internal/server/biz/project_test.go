@@ -334,3 +334,204 @@ func TestValidateProjectProfiles(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateProjectModerationSettings(t *testing.T) {
+	testCases := []struct {
+		name     string
+		settings objects.ProjectModerationSettings
+		errorMsg string
+	}{
+		{
+			name:     "disabled settings are not validated",
+			settings: objects.ProjectModerationSettings{},
+		},
+		{
+			name: "valid settings",
+			settings: objects.ProjectModerationSettings{
+				Enabled:    true,
+				Model:      "omni-moderation-latest",
+				CheckInput: true,
+				Action:     objects.ModerationActionBlock,
+				Thresholds: []objects.ModerationThreshold{{Category: "violence", Score: 0.5}},
+			},
+		},
+		{
+			name: "missing model",
+			settings: objects.ProjectModerationSettings{
+				Enabled:    true,
+				CheckInput: true,
+				Action:     objects.ModerationActionBlock,
+			},
+			errorMsg: "moderation model is required",
+		},
+		{
+			name: "invalid action",
+			settings: objects.ProjectModerationSettings{
+				Enabled:    true,
+				Model:      "omni-moderation-latest",
+				CheckInput: true,
+				Action:     "drop",
+			},
+			errorMsg: "invalid moderation action: drop",
+		},
+		{
+			name: "no stage enabled",
+			settings: objects.ProjectModerationSettings{
+				Enabled: true,
+				Model:   "omni-moderation-latest",
+				Action:  objects.ModerationActionFlag,
+			},
+			errorMsg: "at least one of input or output moderation must be enabled",
+		},
+		{
+			name: "duplicate threshold category",
+			settings: objects.ProjectModerationSettings{
+				Enabled:     true,
+				Model:       "omni-moderation-latest",
+				CheckOutput: true,
+				Action:      objects.ModerationActionFlag,
+				Thresholds: []objects.ModerationThreshold{
+					{Category: "hate", Score: 0.5},
+					{Category: "hate", Score: 0.7},
+				},
+			},
+			errorMsg: "duplicate moderation threshold category: hate",
+		},
+		{
+			name: "threshold out of range",
+			settings: objects.ProjectModerationSettings{
+				Enabled:    true,
+				Model:      "omni-moderation-latest",
+				CheckInput: true,
+				Action:     objects.ModerationActionBlock,
+				Thresholds: []objects.ModerationThreshold{{Category: "hate", Score: 1.5}},
+			},
+			errorMsg: "moderation threshold for 'hate' must be between 0 and 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateProjectModerationSettings(tc.settings)
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateProjectBillingSettings(t *testing.T) {
+	testCases := []struct {
+		name     string
+		settings objects.ProjectBillingSettings
+		errorMsg string
+	}{
+		{
+			name: "valid settings",
+			settings: objects.ProjectBillingSettings{
+				Enabled:       true,
+				MarkupPercent: 20,
+				ModelMarkups:  []objects.ModelMarkup{{Model: "claude-.*", MarkupPercent: 30}},
+				MonthlyFee:    100,
+			},
+		},
+		{
+			name:     "negative monthly fee",
+			settings: objects.ProjectBillingSettings{Enabled: true, MonthlyFee: -1},
+			errorMsg: "monthly fee cannot be negative",
+		},
+		{
+			name:     "markup below -100",
+			settings: objects.ProjectBillingSettings{Enabled: true, MarkupPercent: -150},
+			errorMsg: "markup percent cannot be less than -100",
+		},
+		{
+			name: "empty model pattern",
+			settings: objects.ProjectBillingSettings{
+				Enabled:      true,
+				ModelMarkups: []objects.ModelMarkup{{Model: " ", MarkupPercent: 10}},
+			},
+			errorMsg: "model markup pattern cannot be empty",
+		},
+		{
+			name: "invalid model pattern",
+			settings: objects.ProjectBillingSettings{
+				Enabled:      true,
+				ModelMarkups: []objects.ModelMarkup{{Model: "gpt-(4", MarkupPercent: 10}},
+			},
+			errorMsg: "invalid model markup pattern 'gpt-(4'",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateProjectBillingSettings(tc.settings)
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateProjectDataPolicy(t *testing.T) {
+	days := func(d int) *int { return &d }
+
+	testCases := []struct {
+		name     string
+		policy   objects.ProjectDataPolicy
+		errorMsg string
+	}{
+		{
+			name: "valid policy",
+			policy: objects.ProjectDataPolicy{
+				RedactFields: []string{"messages.#.content", "metadata.*"},
+				Retention: &objects.ProjectRetentionPolicy{
+					RequestsDays:   days(365),
+					ExecutionsDays: days(30),
+					UsageLogsDays:  days(0),
+				},
+			},
+		},
+		{
+			name:     "empty redact path",
+			policy:   objects.ProjectDataPolicy{RedactFields: []string{" "}},
+			errorMsg: "redact field path cannot be empty",
+		},
+		{
+			name:     "invalid redact path",
+			policy:   objects.ProjectDataPolicy{RedactFields: []string{"messages..content"}},
+			errorMsg: "invalid redact field path 'messages..content'",
+		},
+		{
+			name:     "negative days",
+			policy:   objects.ProjectDataPolicy{Retention: &objects.ProjectRetentionPolicy{TracesDays: days(-1)}},
+			errorMsg: "traces retention days cannot be negative",
+		},
+		{
+			name: "executions kept longer than requests",
+			policy: objects.ProjectDataPolicy{Retention: &objects.ProjectRetentionPolicy{
+				RequestsDays:   days(7),
+				ExecutionsDays: days(0),
+			}},
+			errorMsg: "executions retention days cannot exceed requests retention days",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateProjectDataPolicy(tc.policy)
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
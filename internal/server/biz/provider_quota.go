@@ -306,6 +306,7 @@ type ProviderQuotaServiceParams struct {
 	Ent                       *ent.Client
 	SystemService             *SystemService
 	HttpClient                *httpclient.HttpClient
+	WebhookNotifier           *WebhookNotifier
 	CheckInterval             time.Duration `name:"provider_quota_check_interval" optional:"true"`
 	WarningCheckIntervalRatio int           `name:"provider_quota_warning_check_interval_ratio" optional:"true"`
 }
@@ -314,6 +315,7 @@ type ProviderQuotaService struct {
 	*AbstractService
 
 	SystemService             *SystemService
+	WebhookNotifier           *WebhookNotifier
 	checkInterval             time.Duration
 	warningCheckIntervalRatio int
 	httpClient                *httpclient.HttpClient
@@ -329,6 +331,7 @@ func NewProviderQuotaService(params ProviderQuotaServiceParams) *ProviderQuotaSe
 	svc := &ProviderQuotaService{
 		AbstractService:           &AbstractService{db: params.Ent},
 		SystemService:             params.SystemService,
+		WebhookNotifier:           params.WebhookNotifier,
 		checkers:                  make(map[string]provider_quota.QuotaChecker),
 		checkInterval:             params.CheckInterval,
 		warningCheckIntervalRatio: params.WarningCheckIntervalRatio,
@@ -508,6 +511,29 @@ func (svc *ProviderQuotaService) GetQuotaStatus(channelID int) *QuotaChannelStat
 	return status
 }
 
+// QuotaStatuses returns the cached quota status of every channel with a provider quota checker.
+func (svc *ProviderQuotaService) QuotaStatuses() map[int]*QuotaChannelStatus {
+	statuses := make(map[int]*QuotaChannelStatus)
+	if svc == nil {
+		return statuses
+	}
+
+	svc.quotaCache.Range(func(key, val any) bool {
+		channelID, ok := key.(int)
+		if !ok {
+			return true
+		}
+
+		if status, ok := val.(*QuotaChannelStatus); ok {
+			statuses[channelID] = status
+		}
+
+		return true
+	})
+
+	return statuses
+}
+
 func (svc *ProviderQuotaService) updateQuotaCache(channelID int, status providerquotastatus.Status, ready bool, limits []provider_quota.QuotaLimitStatus) {
 	svc.quotaCache.Store(channelID, &QuotaChannelStatus{
 		Status: status,
@@ -679,6 +705,7 @@ func (svc *ProviderQuotaService) saveQuotaStatus(
 ) {
 	nextCheck := now.Add(svc.nextCheckIntervalForStatus(providerquotastatus.Status(quotaData.Status)))
 	pt := providerquotastatus.ProviderType(providerType)
+	previous := svc.GetQuotaStatus(channelID)
 
 	create := svc.db.ProviderQuotaStatus.Create().
 		SetChannelID(channelID).
@@ -709,6 +736,19 @@ func (svc *ProviderQuotaService) saveQuotaStatus(
 	}
 
 	svc.updateQuotaCache(channelID, providerquotastatus.Status(quotaData.Status), quotaData.Ready, quotaData.Limits)
+
+	status := providerquotastatus.Status(quotaData.Status)
+	if svc.WebhookNotifier != nil &&
+		(status == providerquotastatus.StatusWarning || status == providerquotastatus.StatusExhausted) &&
+		(previous == nil || previous.Status != status) {
+		svc.WebhookNotifier.NotifyProviderQuotaLow(ctx, ProviderQuotaLowEvent{
+			ChannelID:    channelID,
+			ProviderType: providerType,
+			Status:       quotaData.Status,
+			NextResetAt:  quotaData.NextResetAt,
+			OccurredAt:   now,
+		})
+	}
 }
 
 func (svc *ProviderQuotaService) saveQuotaError(
@@ -3,6 +3,7 @@ package biz
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/looplj/axonhub/internal/authz"
 	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/predicate"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/objects"
 	"github.com/looplj/axonhub/internal/pkg/xtime"
@@ -41,13 +43,54 @@ type QuotaResult struct {
 type QuotaService struct {
 	ent    *ent.Client
 	system *SystemService
+
+	webhookNotifier *WebhookNotifier
+	// notifiedWindows keeps the quota window start last notified for each API key.
+	notifiedWindows sync.Map
 }
 
 func NewQuotaService(entClient *ent.Client, systemService *SystemService) *QuotaService {
 	return &QuotaService{ent: entClient, system: systemService}
 }
 
+// SetWebhookNotifier wires the optional notifier of the exceeded quotas. Called once at startup from the biz fx module.
+func (s *QuotaService) SetWebhookNotifier(n *WebhookNotifier) {
+	s.webhookNotifier = n
+}
+
+// NotifyAPIKeyQuotaExceeded notifies the exceeded quota of the API key once per quota window on this instance.
+func (s *QuotaService) NotifyAPIKeyQuotaExceeded(ctx context.Context, apiKey *ent.APIKey, result QuotaCheckResult) {
+	if s.webhookNotifier == nil || result.Allowed {
+		return
+	}
+
+	var windowStart int64
+	if result.Window.Start != nil {
+		windowStart = result.Window.Start.Unix()
+	}
+
+	if previous, loaded := s.notifiedWindows.Swap(apiKey.ID, windowStart); loaded && previous == windowStart {
+		return
+	}
+
+	go s.webhookNotifier.NotifyAPIKeyQuotaExceeded(context.WithoutCancel(ctx), APIKeyQuotaExceededEvent{
+		APIKey:     apiKey,
+		Message:    result.Message,
+		Window:     result.Window,
+		OccurredAt: time.Now(),
+	})
+}
+
 func (s *QuotaService) CheckAPIKeyQuota(ctx context.Context, apiKeyID int, quota *objects.APIKeyQuota) (QuotaCheckResult, error) {
+	return s.checkQuota(ctx, apiKeyID, quota)
+}
+
+// CheckEndUserQuota checks the usage of one end user of the API key against the quota.
+func (s *QuotaService) CheckEndUserQuota(ctx context.Context, apiKeyID int, endUserID string, quota *objects.APIKeyQuota) (QuotaCheckResult, error) {
+	return s.checkQuota(ctx, apiKeyID, quota, usagelog.EndUserIDEQ(endUserID))
+}
+
+func (s *QuotaService) checkQuota(ctx context.Context, apiKeyID int, quota *objects.APIKeyQuota, preds ...predicate.UsageLog) (QuotaCheckResult, error) {
 	if quota == nil {
 		return QuotaCheckResult{Allowed: true}, nil
 	}
@@ -61,7 +104,7 @@ func (s *QuotaService) CheckAPIKeyQuota(ctx context.Context, apiKeyID int, quota
 
 	if quota.Requests != nil {
 		reqCount, err := authz.RunWithSystemBypass(ctx, "quota-request-count", func(bypassCtx context.Context) (int64, error) {
-			return s.requestCount(bypassCtx, apiKeyID, window)
+			return s.requestCount(bypassCtx, apiKeyID, window, preds...)
 		})
 		if err != nil {
 			return QuotaCheckResult{}, err
@@ -84,7 +127,7 @@ func (s *QuotaService) CheckAPIKeyQuota(ctx context.Context, apiKeyID int, quota
 	}
 
 	usageAgg, err := authz.RunWithSystemBypass(ctx, "quota-usage-agg", func(bypassCtx context.Context) (usageAggResult, error) {
-		return s.usageAgg(bypassCtx, apiKeyID, window, quota.TotalTokens != nil, quota.Cost != nil)
+		return s.usageAgg(bypassCtx, apiKeyID, window, quota.TotalTokens != nil, quota.Cost != nil, preds...)
 	})
 	if err != nil {
 		return QuotaCheckResult{}, err
@@ -112,6 +155,38 @@ func (s *QuotaService) CheckAPIKeyQuota(ctx context.Context, apiKeyID int, quota
 	}, nil
 }
 
+// CheckAPIKeyTokenBudget checks the cost spent by the end user of a short-lived API key token
+// since the token was issued against the budget embedded in the token.
+func (s *QuotaService) CheckAPIKeyTokenBudget(ctx context.Context, token *objects.APIKeyToken) (QuotaCheckResult, error) {
+	if token == nil || token.Budget == nil {
+		return QuotaCheckResult{Allowed: true}, nil
+	}
+
+	start := token.IssuedAt
+	end := xtime.UTCNow()
+	window := QuotaWindow{Start: &start, End: &end, EndInclusive: true}
+
+	usageAgg, err := authz.RunWithSystemBypass(ctx, "quota-token-budget", func(bypassCtx context.Context) (usageAggResult, error) {
+		return s.usageAgg(bypassCtx, token.APIKeyID, window, false, true, usagelog.EndUserIDEQ(token.EndUserID))
+	})
+	if err != nil {
+		return QuotaCheckResult{}, err
+	}
+
+	if usageAgg.TotalCost.GreaterThanOrEqual(*token.Budget) {
+		return QuotaCheckResult{
+			Allowed: false,
+			Message: fmt.Sprintf("token budget exceeded: %s/%s", usageAgg.TotalCost.String(), token.Budget.String()),
+			Window:  window,
+		}, nil
+	}
+
+	return QuotaCheckResult{
+		Allowed: true,
+		Window:  window,
+	}, nil
+}
+
 // ProfileQuotaUsage is the per-profile quota usage of an API key, shared by the
 // admin and OpenAPI GraphQL resolvers so the "iterate profiles → GetQuota" logic
 // lives in one place.
@@ -255,8 +330,8 @@ func quotaWindow(now time.Time, period objects.APIKeyQuotaPeriod, loc *time.Loca
 	}
 }
 
-func (s *QuotaService) requestCount(ctx context.Context, apiKeyID int, window QuotaWindow) (int64, error) {
-	q := s.ent.UsageLog.Query().Where(usagelog.APIKeyIDEQ(apiKeyID))
+func (s *QuotaService) requestCount(ctx context.Context, apiKeyID int, window QuotaWindow, preds ...predicate.UsageLog) (int64, error) {
+	q := s.ent.UsageLog.Query().Where(usagelog.APIKeyIDEQ(apiKeyID)).Where(preds...)
 
 	if window.Start != nil {
 		q = q.Where(usagelog.CreatedAtGTE(*window.Start))
@@ -283,7 +358,14 @@ type usageAggResult struct {
 	TotalCost   decimal.Decimal
 }
 
-func (s *QuotaService) usageAgg(ctx context.Context, apiKeyID int, window QuotaWindow, needTokens bool, needCost bool) (usageAggResult, error) {
+func (s *QuotaService) usageAgg(
+	ctx context.Context,
+	apiKeyID int,
+	window QuotaWindow,
+	needTokens bool,
+	needCost bool,
+	preds ...predicate.UsageLog,
+) (usageAggResult, error) {
 	if !needTokens && !needCost {
 		return usageAggResult{}, nil
 	}
@@ -373,7 +455,7 @@ func (s *QuotaService) usageAgg(ctx context.Context, apiKeyID int, window QuotaW
 		}
 	}
 
-	agg1, err := queryAgg(s.ent.UsageLog.Query().Where(usagelog.APIKeyIDEQ(apiKeyID)))
+	agg1, err := queryAgg(s.ent.UsageLog.Query().Where(usagelog.APIKeyIDEQ(apiKeyID)).Where(preds...))
 	if err != nil {
 		return usageAggResult{}, err
 	}
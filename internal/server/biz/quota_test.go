@@ -485,3 +485,72 @@ func TestQuotaService_CalendarDay_CostExceeded(t *testing.T) {
 	require.False(t, res.Allowed)
 	require.Contains(t, res.Message, "cost quota exceeded")
 }
+
+func TestQuotaService_CheckEndUserQuota(t *testing.T) {
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=0")
+	defer client.Close()
+
+	ctx := context.Background()
+	ctx = ent.NewContext(ctx, client)
+	ctx = authz.WithTestBypass(ctx)
+
+	p, err := client.Project.Create().
+		SetName("p").
+		SetStatus(project.StatusActive).
+		Save(ctx)
+	require.NoError(t, err)
+
+	apiKeyID := 1
+
+	createUsage := func(endUserID string, tokens int64) {
+		req, err := client.Request.Create().
+			SetProjectID(p.ID).
+			SetAPIKeyID(apiKeyID).
+			SetEndUserID(endUserID).
+			SetModelID("m").
+			SetFormat("openai/chat_completions").
+			SetStatus(request.StatusCompleted).
+			SetRequestBody(objects.JSONRawMessage([]byte(`{}`))).
+			Save(ctx)
+		require.NoError(t, err)
+
+		_, err = client.UsageLog.Create().
+			SetRequestID(req.ID).
+			SetAPIKeyID(apiKeyID).
+			SetEndUserID(endUserID).
+			SetProjectID(p.ID).
+			SetChannelID(1).
+			SetModelID("m").
+			SetTotalTokens(tokens).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	createUsage("alice", 80)
+	createUsage("bob", 500)
+
+	systemService := NewSystemService(SystemServiceParams{Ent: client})
+	svc := NewQuotaService(client, systemService)
+
+	quota := &objects.APIKeyQuota{
+		TotalTokens: lo.ToPtr(int64(100)),
+		Period: objects.APIKeyQuotaPeriod{
+			Type: objects.APIKeyQuotaPeriodTypeAllTime,
+		},
+	}
+
+	res, err := svc.CheckEndUserQuota(ctx, apiKeyID, "alice", quota)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+
+	res, err = svc.CheckEndUserQuota(ctx, apiKeyID, "bob", quota)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Contains(t, res.Message, "total_tokens quota exceeded")
+
+	createUsage("alice", 20)
+
+	res, err = svc.CheckEndUserQuota(ctx, apiKeyID, "alice", quota)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+}
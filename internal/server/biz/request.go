@@ -141,12 +141,8 @@ func (s *RequestService) CreateRequest(
 	projectID, _ := contexts.GetProjectID(ctx)
 
 	// Decide whether to store the original request body
-	storeRequestBody := true
-	if policy, err := s.SystemService.StoragePolicy(ctx); err == nil {
-		storeRequestBody = policy.StoreRequestBody
-	} else {
-		log.Warn(ctx, "Failed to get storage policy, defaulting to store request body", log.Cause(err))
-	}
+	capture := s.contentCapture(ctx, projectID)
+	storeRequestBody := capture.StoreRequestBody
 
 	var (
 		requestBodyBytes    objects.JSONRawMessage = []byte("{}")
@@ -166,6 +162,8 @@ func (s *RequestService) CreateRequest(
 			requestBodyBytes = b
 		}
 
+		requestBodyBytes = capture.redact(requestBodyBytes)
+
 		if httpRequest != nil && len(httpRequest.Headers) > 0 {
 			requestHeadersBytes, _ = xjson.Marshal(httpclient.MaskSensitiveHeaders(httpRequest.Headers))
 		}
@@ -176,10 +174,10 @@ func (s *RequestService) CreateRequest(
 		isStream = *llmRequest.Stream
 	}
 
-	// Get default data storage
-	dataStorage, err := s.DataStorageService.GetDefaultDataStorage(ctx)
+	// Get the data storage of the project, or the default data storage
+	dataStorage, err := s.dataStorage(ctx, capture)
 	if err != nil {
-		log.Warn(ctx, "Failed to get default data storage, request will be created without data storage", log.Cause(err))
+		log.Warn(ctx, "Failed to get data storage, request will be created without data storage", log.Cause(err))
 	}
 
 	client := s.entFromContext(ctx)
@@ -203,12 +201,18 @@ func (s *RequestService) CreateRequest(
 	// Determine if we should store in database or external storage
 	useExternalStorage := storeRequestBody && s.shouldUseExternalStorage(ctx, dataStorage)
 
+	var (
+		requestBodyForDB objects.JSONRawMessage
+		requestBodyBlobs bool
+	)
+
 	if useExternalStorage {
 		// Set empty JSON for database, actual data will be in external storage
 		mut = mut.SetRequestBody([]byte("{}"))
 	} else {
-		// Store in database
-		mut = mut.SetRequestBody(requestBodyBytes)
+		// Store in database, the large blocks are stored once as content blobs
+		requestBodyForDB, requestBodyBlobs = StoreContentBlobs(ctx, client, requestBodyBytes)
+		mut = mut.SetRequestBody(requestBodyForDB).SetRequestBodyBlobs(requestBodyBlobs)
 	}
 
 	if dataStorage != nil {
@@ -219,6 +223,10 @@ func (s *RequestService) CreateRequest(
 		mut = mut.SetAPIKeyID(apiKey.ID)
 	}
 
+	if endUserID, ok := contexts.GetEndUserID(ctx); ok && endUserID != "" {
+		mut = mut.SetEndUserID(endUserID)
+	}
+
 	if trace, ok := contexts.GetTrace(ctx); ok && trace != nil {
 		mut = mut.SetTraceID(trace.ID)
 	}
@@ -229,7 +237,13 @@ func (s *RequestService) CreateRequest(
 		if !useExternalStorage {
 			log.Warn(ctx, "Failed to save request body due to error, retrying with placeholder", log.Cause(err))
 
-			mut = mut.SetRequestBody(_InvalidRequestBodyJSON)
+			if requestBodyBlobs {
+				if err := ReleaseContentBlobs(ctx, client, requestBodyForDB); err != nil {
+					log.Warn(ctx, "Failed to release request body content blobs", log.Cause(err))
+				}
+			}
+
+			mut = mut.SetRequestBody(_InvalidRequestBodyJSON).SetRequestBodyBlobs(false)
 
 			req, err = mut.Save(ctx)
 			if err != nil {
@@ -266,12 +280,8 @@ func (s *RequestService) CreateRequestExecution(
 	passThroughApplied bool,
 ) (*ent.RequestExecution, error) {
 	// Decide whether to store the channel request body
-	storeRequestBody := true
-	if policy, err := s.SystemService.StoragePolicy(ctx); err == nil {
-		storeRequestBody = policy.StoreRequestBody
-	} else {
-		log.Warn(ctx, "Failed to get storage policy, defaulting to store request body", log.Cause(err))
-	}
+	capture := s.contentCapture(ctx, request.ProjectID)
+	storeRequestBody := capture.StoreRequestBody
 
 	var (
 		requestBodyBytes    objects.JSONRawMessage = []byte("{}")
@@ -291,6 +301,8 @@ func (s *RequestService) CreateRequestExecution(
 			requestBodyBytes = b
 		}
 
+		requestBodyBytes = capture.redact(requestBodyBytes)
+
 		if len(channelRequest.Headers) > 0 {
 			requestHeadersBytes, _ = xjson.Marshal(httpclient.MaskSensitiveHeaders(channelRequest.Headers))
 		}
@@ -313,13 +325,17 @@ func (s *RequestService) CreateRequestExecution(
 	// Determine if we should store in database or external storage
 	useExternalStorage := storeRequestBody && s.shouldUseExternalStorage(ctx, dataStorage)
 
-	var requestBodyForDB objects.JSONRawMessage
+	var (
+		requestBodyForDB objects.JSONRawMessage
+		requestBodyBlobs bool
+	)
+
 	if useExternalStorage {
 		// Set empty JSON for database, actual data will be in external storage
 		requestBodyForDB = []byte("{}")
 	} else {
-		// Store in database
-		requestBodyForDB = requestBodyBytes
+		// Store in database, the large blocks are stored once as content blobs
+		requestBodyForDB, requestBodyBlobs = StoreContentBlobs(ctx, client, requestBodyBytes)
 	}
 
 	mut := client.RequestExecution.Create().
@@ -329,6 +345,7 @@ func (s *RequestService) CreateRequestExecution(
 		SetChannelID(channel.ID).
 		SetModelID(modelID).
 		SetRequestBody(requestBodyForDB).
+		SetRequestBodyBlobs(requestBodyBlobs).
 		SetStatus(requestexecution.StatusProcessing).
 		SetStream(request.Stream).
 		SetRequestHeaders(requestHeadersBytes).
@@ -351,7 +368,13 @@ func (s *RequestService) CreateRequestExecution(
 
 		log.Warn(ctx, "Failed to save execution request body due to error, retrying with placeholder", log.Cause(err))
 
-		mut = mut.SetRequestBody(_InvalidRequestBodyJSON)
+		if requestBodyBlobs {
+			if err := ReleaseContentBlobs(ctx, client, requestBodyForDB); err != nil {
+				log.Warn(ctx, "Failed to release execution request body content blobs", log.Cause(err))
+			}
+		}
+
+		mut = mut.SetRequestBody(_InvalidRequestBodyJSON).SetRequestBodyBlobs(false)
 
 		execution, err = mut.Save(ctx)
 		if err != nil {
@@ -389,14 +412,6 @@ func (s *RequestService) UpdateRequestCompleted(
 	responseBody any,
 	metrics *LatencyMetrics,
 ) error {
-	// Decide whether to store the final response body
-	storeResponseBody := true
-	if policy, err := s.SystemService.StoragePolicy(ctx); err == nil {
-		storeResponseBody = policy.StoreResponseBody
-	} else {
-		log.Warn(ctx, "Failed to get storage policy, defaulting to store response body", log.Cause(err))
-	}
-
 	client := s.entFromContext(ctx)
 
 	// Get the request to check data storage
@@ -406,6 +421,9 @@ func (s *RequestService) UpdateRequestCompleted(
 		return err
 	}
 
+	// Decide whether to store the final response body
+	capture := s.contentCapture(ctx, req.ProjectID)
+
 	// Get data storage if set
 	var dataStorage *ent.DataStorage
 	if req.DataStorageID != 0 {
@@ -434,13 +452,15 @@ func (s *RequestService) UpdateRequestCompleted(
 		}
 	}
 
-	if storeResponseBody {
+	if capture.StoreResponseBody {
 		responseBodyBytes, err := xjson.Marshal(responseBody)
 		if err != nil {
 			log.Error(ctx, "Failed to serialize response body", log.Cause(err))
 			return err
 		}
 
+		responseBodyBytes = capture.redact(responseBodyBytes)
+
 		// Check if we should use external storage
 		if s.shouldUseExternalStorage(ctx, dataStorage) {
 			// Save to external storage
@@ -482,14 +502,6 @@ func (s *RequestService) UpdateRequestCompletedWithAudio(
 	filename string,
 	metrics *LatencyMetrics,
 ) error {
-	// Decide whether to store the final response body metadata.
-	storeResponseBody := true
-	if policy, err := s.SystemService.StoragePolicy(ctx); err == nil {
-		storeResponseBody = policy.StoreResponseBody
-	} else {
-		log.Warn(ctx, "Failed to get storage policy, defaulting to store response body", log.Cause(err))
-	}
-
 	client := s.entFromContext(ctx)
 
 	req, err := client.Request.Get(ctx, requestID)
@@ -498,6 +510,9 @@ func (s *RequestService) UpdateRequestCompletedWithAudio(
 		return err
 	}
 
+	// Decide whether to store the final response body
+	capture := s.contentCapture(ctx, req.ProjectID)
+
 	var dataStorage *ent.DataStorage
 	if req.DataStorageID != 0 {
 		dataStorage, err = s.DataStorageService.GetDataStorageByID(ctx, req.DataStorageID)
@@ -524,13 +539,15 @@ func (s *RequestService) UpdateRequestCompletedWithAudio(
 		}
 	}
 
-	if storeResponseBody {
+	if capture.StoreResponseBody {
 		responseBodyBytes, err := xjson.Marshal(responseBody)
 		if err != nil {
 			log.Error(ctx, "Failed to serialize response body", log.Cause(err))
 			return err
 		}
 
+		responseBodyBytes = capture.redact(responseBodyBytes)
+
 		if s.shouldUseExternalStorage(ctx, dataStorage) {
 			key := GenerateResponseBodyKey(req.ProjectID, requestID)
 			if err := s.DataStorageService.SaveData(ctx, dataStorage, key, responseBodyBytes); err != nil {
@@ -542,7 +559,7 @@ func (s *RequestService) UpdateRequestCompletedWithAudio(
 	}
 
 	// Persist the binary audio to external storage when one is configured.
-	if len(audio) > 0 && s.shouldUseExternalStorage(ctx, dataStorage) {
+	if len(audio) > 0 && capture.StoreResponseBody && s.shouldUseExternalStorage(ctx, dataStorage) {
 		key := GenerateAudioKey(req.ProjectID, requestID, filename)
 		if err := s.DataStorageService.SaveData(ctx, dataStorage, key, audio); err != nil {
 			log.Error(ctx, "Failed to save audio to external storage", log.Cause(err))
@@ -574,14 +591,6 @@ func (s *RequestService) UpdateRequestStatusExternalIDAndResponseBody(
 	responseBody any,
 	metrics *LatencyMetrics,
 ) error {
-	// Decide whether to store the final response body
-	storeResponseBody := true
-	if policy, err := s.SystemService.StoragePolicy(ctx); err == nil {
-		storeResponseBody = policy.StoreResponseBody
-	} else {
-		log.Warn(ctx, "Failed to get storage policy, defaulting to store response body", log.Cause(err))
-	}
-
 	client := s.entFromContext(ctx)
 
 	// Get the request to check data storage
@@ -591,6 +600,9 @@ func (s *RequestService) UpdateRequestStatusExternalIDAndResponseBody(
 		return err
 	}
 
+	// Decide whether to store the final response body
+	capture := s.contentCapture(ctx, req.ProjectID)
+
 	// Get data storage if set
 	var dataStorage *ent.DataStorage
 	if req.DataStorageID != 0 {
@@ -619,13 +631,15 @@ func (s *RequestService) UpdateRequestStatusExternalIDAndResponseBody(
 		}
 	}
 
-	if storeResponseBody {
+	if capture.StoreResponseBody {
 		responseBodyBytes, err := xjson.Marshal(responseBody)
 		if err != nil {
 			log.Error(ctx, "Failed to serialize response body", log.Cause(err))
 			return err
 		}
 
+		responseBodyBytes = capture.redact(responseBodyBytes)
+
 		// Check if we should use external storage
 		if s.shouldUseExternalStorage(ctx, dataStorage) {
 			// Save to external storage
@@ -659,14 +673,6 @@ func (s *RequestService) UpdateRequestExecutionCompleted(
 	responseBody any,
 	metrics *LatencyMetrics,
 ) error {
-	// Decide whether to store the final response body for execution
-	storeResponseBody := true
-	if policy, err := s.SystemService.StoragePolicy(ctx); err == nil {
-		storeResponseBody = policy.StoreResponseBody
-	} else {
-		log.Warn(ctx, "Failed to get storage policy, defaulting to store response body", log.Cause(err))
-	}
-
 	client := s.entFromContext(ctx)
 
 	// Get the execution to check data storage
@@ -676,6 +682,9 @@ func (s *RequestService) UpdateRequestExecutionCompleted(
 		return err
 	}
 
+	// Decide whether to store the final response body for execution
+	capture := s.contentCapture(ctx, execution.ProjectID)
+
 	// Get data storage if set
 	var dataStorage *ent.DataStorage
 	if execution.DataStorageID != 0 {
@@ -704,12 +713,14 @@ func (s *RequestService) UpdateRequestExecutionCompleted(
 		}
 	}
 
-	if storeResponseBody {
+	if capture.StoreResponseBody {
 		responseBodyBytes, err := xjson.Marshal(responseBody)
 		if err != nil {
 			return err
 		}
 
+		responseBodyBytes = capture.redact(responseBodyBytes)
+
 		// Check if we should use external storage
 		if s.shouldUseExternalStorage(ctx, dataStorage) {
 			// Save to external storage
@@ -725,12 +736,14 @@ func (s *RequestService) UpdateRequestExecutionCompleted(
 		}
 	}
 
-	_, err = upd.Save(ctx)
+	execution, err = upd.Save(ctx)
 	if err != nil {
 		log.Error(ctx, "Failed to update request execution status to completed", log.Cause(err))
 		return err
 	}
 
+	recordExecutionMetrics(ctx, execution)
+
 	return nil
 }
 
@@ -778,12 +791,14 @@ func (s *RequestService) UpdateRequestExecutionStatus(
 		upd = upd.SetResponseStatusCode(*errorInfo.StatusCode)
 	}
 
-	_, err := upd.Save(ctx)
+	execution, err := upd.Save(ctx)
 	if err != nil {
 		log.Error(ctx, "Failed to update request execution status", log.Cause(err), log.Any("status", status))
 		return err
 	}
 
+	recordExecutionMetrics(ctx, execution)
+
 	return nil
 }
 
@@ -854,7 +869,7 @@ func marshalStreamEventForStorage(chunk *httpclient.StreamEvent) (objects.JSONRa
 }
 
 // SaveRequestExecutionChunks saves all response chunks to request execution at once.
-// Only stores chunks if the system StoreChunks setting is enabled.
+// Only stores chunks if the system StoreChunks setting is enabled and the project data policy allows it.
 func (s *RequestService) SaveRequestExecutionChunks(
 	ctx context.Context,
 	executionID int,
@@ -907,6 +922,11 @@ func (s *RequestService) SaveRequestExecutionChunks(
 		return fmt.Errorf("failed to get request execution: %w", err)
 	}
 
+	// The project can disable the chunks on top of the system setting
+	if !s.contentCapture(ctx, execution.ProjectID).StoreChunks {
+		return nil
+	}
+
 	// Get data storage if set
 	var dataStorage *ent.DataStorage
 	if execution.DataStorageID != 0 {
@@ -943,7 +963,7 @@ func (s *RequestService) SaveRequestExecutionChunks(
 }
 
 // SaveRequestChunks saves all response chunks to request at once.
-// Only stores chunks if the system StoreChunks setting is enabled.
+// Only stores chunks if the system StoreChunks setting is enabled and the project data policy allows it.
 func (s *RequestService) SaveRequestChunks(
 	ctx context.Context,
 	requestID int,
@@ -995,6 +1015,11 @@ func (s *RequestService) SaveRequestChunks(
 		return fmt.Errorf("failed to get request: %w", err)
 	}
 
+	// The project can disable the chunks on top of the system setting
+	if !s.contentCapture(ctx, req.ProjectID).StoreChunks {
+		return nil
+	}
+
 	// Get data storage if set
 	var dataStorage *ent.DataStorage
 	if req.DataStorageID != 0 {
@@ -1139,12 +1164,33 @@ func (s *RequestService) UpdateRequestChannelID(ctx context.Context, requestID i
 	return nil
 }
 
+// UpdateRequestModerationVerdicts stores the moderation guardrail verdicts of a request.
+func (s *RequestService) UpdateRequestModerationVerdicts(ctx context.Context, requestID int, verdicts []objects.ModerationVerdict) error {
+	client := s.entFromContext(ctx)
+
+	if err := client.Request.UpdateOneID(requestID).
+		SetModerationVerdicts(verdicts).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update request moderation verdicts: %w", err)
+	}
+
+	return nil
+}
+
 // LoadRequestBody returns the stored request body, loading from external storage when necessary.
 func (s *RequestService) LoadRequestBody(ctx context.Context, req *ent.Request) (objects.JSONRawMessage, error) {
 	if req == nil {
 		return nil, fmt.Errorf("request is nil")
 	}
 
+	if req.ArchivedAt != nil {
+		if entry := s.loadArchivedRequest(ctx, req); entry != nil && entry.RequestBody != nil {
+			return entry.RequestBody, nil
+		}
+
+		return xjson.EmptyJSONRawMessage, nil
+	}
+
 	dataStorage, err := s.getDataStorage(ctx, req.DataStorageID)
 	if err != nil {
 		log.Warn(ctx, "Failed to get data storage for request body", log.Cause(err), log.Int("request_id", req.ID))
@@ -1156,7 +1202,13 @@ func (s *RequestService) LoadRequestBody(ctx context.Context, req *ent.Request)
 			return xjson.EmptyJSONRawMessage, nil
 		}
 
-		return req.RequestBody, nil
+		body, err := ExpandContentBlobs(ctx, s.entFromContext(ctx), req.RequestBody, req.RequestBodyBlobs)
+		if err != nil {
+			log.Warn(ctx, "Failed to expand request body content blobs", log.Cause(err), log.Int("request_id", req.ID))
+			return xjson.EmptyJSONRawMessage, nil
+		}
+
+		return body, nil
 	}
 
 	key := GenerateRequestBodyKey(req.ProjectID, req.ID)
@@ -1184,6 +1236,14 @@ func (s *RequestService) LoadResponseBody(ctx context.Context, req *ent.Request)
 		return xjson.EmptyJSONRawMessage, nil
 	}
 
+	if req.ArchivedAt != nil {
+		if entry := s.loadArchivedRequest(ctx, req); entry != nil && entry.ResponseBody != nil {
+			return entry.ResponseBody, nil
+		}
+
+		return xjson.EmptyJSONRawMessage, nil
+	}
+
 	dataStorage, err := s.getDataStorage(ctx, req.DataStorageID)
 	if err != nil {
 		log.Warn(ctx, "Failed to get data storage for request response body", log.Cause(err), log.Int("request_id", req.ID))
@@ -1227,6 +1287,14 @@ func (s *RequestService) LoadResponseChunks(ctx context.Context, req *ent.Reques
 		return []objects.JSONRawMessage{}, nil
 	}
 
+	if req.ArchivedAt != nil {
+		if entry := s.loadArchivedRequest(ctx, req); entry != nil && entry.ResponseChunks != nil {
+			return entry.ResponseChunks, nil
+		}
+
+		return []objects.JSONRawMessage{}, nil
+	}
+
 	dataStorage, err := s.getDataStorage(ctx, req.DataStorageID)
 	if err != nil {
 		log.Warn(ctx, "Failed to get data storage for request response chunks", log.Cause(err), log.Int("request_id", req.ID))
@@ -1265,6 +1333,14 @@ func (s *RequestService) LoadRequestExecutionRequestBody(ctx context.Context, ex
 		return nil, fmt.Errorf("request execution is nil")
 	}
 
+	if archived, ok := s.loadArchivedExecution(ctx, exec); ok {
+		if archived != nil && archived.RequestBody != nil {
+			return archived.RequestBody, nil
+		}
+
+		return xjson.EmptyJSONRawMessage, nil
+	}
+
 	dataStorage, err := s.getDataStorage(ctx, exec.DataStorageID)
 	if err != nil {
 		log.Warn(ctx, "Failed to get data storage for execution request body", log.Cause(err), log.Int("execution_id", exec.ID))
@@ -1276,7 +1352,13 @@ func (s *RequestService) LoadRequestExecutionRequestBody(ctx context.Context, ex
 			return xjson.EmptyJSONRawMessage, nil
 		}
 
-		return exec.RequestBody, nil
+		body, err := ExpandContentBlobs(ctx, s.entFromContext(ctx), exec.RequestBody, exec.RequestBodyBlobs)
+		if err != nil {
+			log.Warn(ctx, "Failed to expand execution request body content blobs", log.Cause(err), log.Int("execution_id", exec.ID))
+			return xjson.EmptyJSONRawMessage, nil
+		}
+
+		return body, nil
 	}
 
 	key := GenerateExecutionRequestBodyKey(exec.ProjectID, exec.RequestID, exec.ID)
@@ -1304,6 +1386,14 @@ func (s *RequestService) LoadRequestExecutionResponseBody(ctx context.Context, e
 		return xjson.EmptyJSONRawMessage, nil
 	}
 
+	if archived, ok := s.loadArchivedExecution(ctx, exec); ok {
+		if archived != nil && archived.ResponseBody != nil {
+			return archived.ResponseBody, nil
+		}
+
+		return xjson.EmptyJSONRawMessage, nil
+	}
+
 	dataStorage, err := s.getDataStorage(ctx, exec.DataStorageID)
 	if err != nil {
 		log.Warn(ctx, "Failed to get data storage for execution response body", log.Cause(err), log.Int("execution_id", exec.ID))
@@ -1348,6 +1438,14 @@ func (s *RequestService) LoadRequestExecutionResponseChunks(ctx context.Context,
 		return []objects.JSONRawMessage{}, nil
 	}
 
+	if archived, ok := s.loadArchivedExecution(ctx, exec); ok {
+		if archived != nil && archived.ResponseChunks != nil {
+			return archived.ResponseChunks, nil
+		}
+
+		return []objects.JSONRawMessage{}, nil
+	}
+
 	dataStorage, err := s.getDataStorage(ctx, exec.DataStorageID)
 	if err != nil {
 		log.Warn(ctx, "Failed to get data storage for execution response chunks", log.Cause(err), log.Int("execution_id", exec.ID))
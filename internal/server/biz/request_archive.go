@@ -0,0 +1,459 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xerrors"
+)
+
+// RequestArchiveEntry is an archived request, an archive object is the zstd compressed JSON lines of the entries
+// of the requests of a project and a day. The entries carry the request metadata, so that the objects can be
+// consumed without the database. The generated content files, e.g. videos, are moved to the archive data storage
+// as they are, under the same keys.
+type RequestArchiveEntry struct {
+	RequestID      int                        `json:"request_id"`
+	ProjectID      int                        `json:"project_id"`
+	CreatedAt      time.Time                  `json:"created_at"`
+	ModelID        string                     `json:"model_id"`
+	Format         string                     `json:"format"`
+	Status         string                     `json:"status"`
+	Stream         bool                       `json:"stream"`
+	ChannelID      int                        `json:"channel_id,omitempty"`
+	APIKeyID       int                        `json:"api_key_id,omitempty"`
+	RequestBody    objects.JSONRawMessage     `json:"request_body,omitempty"`
+	ResponseBody   objects.JSONRawMessage     `json:"response_body,omitempty"`
+	ResponseChunks []objects.JSONRawMessage   `json:"response_chunks,omitempty"`
+	Executions     []*RequestArchiveExecution `json:"executions,omitempty"`
+}
+
+// RequestArchiveExecution is an archived request execution.
+type RequestArchiveExecution struct {
+	ExecutionID    int                      `json:"execution_id"`
+	RequestBody    objects.JSONRawMessage   `json:"request_body,omitempty"`
+	ResponseBody   objects.JSONRawMessage   `json:"response_body,omitempty"`
+	ResponseChunks []objects.JSONRawMessage `json:"response_chunks,omitempty"`
+}
+
+func (e *RequestArchiveEntry) execution(executionID int) *RequestArchiveExecution {
+	for _, exec := range e.Executions {
+		if exec.ExecutionID == executionID {
+			return exec
+		}
+	}
+
+	return nil
+}
+
+// GenerateRequestArchiveKey generates the storage key of an archive object, partitioned by project and day.
+func GenerateRequestArchiveKey(projectID int, day time.Time, firstRequestID, lastRequestID int) string {
+	return fmt.Sprintf("/archive/requests/project_id=%d/date=%s/requests-%d-%d.jsonl.zst",
+		projectID, day.UTC().Format(time.DateOnly), firstRequestID, lastRequestID)
+}
+
+// SaveRequestArchive writes the entries to an archive object.
+func (s *DataStorageService) SaveRequestArchive(ctx context.Context, ds *ent.DataStorage, key string, entries []*RequestArchiveEntry) error {
+	var buf bytes.Buffer
+
+	zw, err := zstd.NewWriter(&buf)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(zw)
+	for _, entry := range entries {
+		if err := enc.Encode(entry); err != nil {
+			return fmt.Errorf("failed to encode archived request %d: %w", entry.RequestID, err)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	return s.SaveData(ctx, ds, key, buf.Bytes())
+}
+
+// ReadRequestArchive reads the entries of an archive object in request ID order, until fn returns false.
+func (s *DataStorageService) ReadRequestArchive(
+	ctx context.Context,
+	ds *ent.DataStorage,
+	key string,
+	fn func(entry *RequestArchiveEntry) (bool, error),
+) error {
+	data, err := s.LoadData(ctx, ds, key)
+	if err != nil {
+		return err
+	}
+
+	zr, err := zstd.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	dec := json.NewDecoder(zr)
+
+	for {
+		var entry RequestArchiveEntry
+		if err := dec.Decode(&entry); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+
+			return fmt.Errorf("invalid request archive %s: %w", key, err)
+		}
+
+		more, err := fn(&entry)
+		if err != nil || !more {
+			return err
+		}
+	}
+}
+
+// requestArchiveEntryCache keeps the last archive entry loaded, the bodies and chunks of a request are loaded one after the other.
+type requestArchiveEntryCache struct {
+	mu    sync.Mutex
+	key   string
+	entry *RequestArchiveEntry
+}
+
+func (c *requestArchiveEntryCache) get(key string, requestID int) *RequestArchiveEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entry != nil && c.key == key && c.entry.RequestID == requestID {
+		return c.entry
+	}
+
+	return nil
+}
+
+func (c *requestArchiveEntryCache) set(key string, entry *RequestArchiveEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.key = key
+	c.entry = entry
+}
+
+// LoadRequestArchiveEntry loads the archive entry of an archived request.
+func (s *DataStorageService) LoadRequestArchiveEntry(ctx context.Context, req *ent.Request) (*RequestArchiveEntry, error) {
+	if req.ArchivedAt == nil || req.ArchiveStorageID == nil || req.ArchiveKey == nil {
+		return nil, fmt.Errorf("request %d is not archived", req.ID)
+	}
+
+	key := *req.ArchiveKey
+
+	if entry := s.archiveEntryCache.get(key, req.ID); entry != nil {
+		return entry, nil
+	}
+
+	ds, err := s.GetDataStorageByID(authz.WithSystemBypass(ctx, "request-archive-storage"), *req.ArchiveStorageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archive data storage: %w", err)
+	}
+
+	var found *RequestArchiveEntry
+
+	if err := s.ReadRequestArchive(ctx, ds, key, func(entry *RequestArchiveEntry) (bool, error) {
+		if entry.RequestID != req.ID {
+			return true, nil
+		}
+
+		found = entry
+
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("request %d not found in archive %s", req.ID, key)
+	}
+
+	s.archiveEntryCache.set(key, found)
+
+	return found, nil
+}
+
+// loadArchivedRequest returns the archive entry of the request, nil when the request is not archived
+// or the archive can not be read.
+func (s *RequestService) loadArchivedRequest(ctx context.Context, req *ent.Request) *RequestArchiveEntry {
+	entry, err := s.DataStorageService.LoadRequestArchiveEntry(ctx, req)
+	if err != nil {
+		log.Warn(ctx, "Failed to load archived request", log.Cause(err), log.Int("request_id", req.ID))
+		return nil
+	}
+
+	return entry
+}
+
+// loadArchivedExecution returns the archived execution, false when the request of the execution is not archived.
+func (s *RequestService) loadArchivedExecution(ctx context.Context, exec *ent.RequestExecution) (*RequestArchiveExecution, bool) {
+	req, err := s.entFromContext(ctx).Request.Query().
+		Where(
+			request.ID(exec.RequestID),
+			request.ArchivedAtNotNil(),
+		).
+		Select(
+			request.FieldID,
+			request.FieldArchivedAt,
+			request.FieldArchiveStorageID,
+			request.FieldArchiveKey,
+		).
+		Only(authz.WithSystemBypass(ctx, "request-archive-execution"))
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			log.Warn(ctx, "Failed to check archived request of execution", log.Cause(err), log.Int("execution_id", exec.ID))
+		}
+
+		return nil, false
+	}
+
+	entry := s.loadArchivedRequest(ctx, req)
+	if entry == nil {
+		return nil, true
+	}
+
+	return entry.execution(exec.ID), true
+}
+
+const (
+	RequestArchiveExportFormatJSONL   = "jsonl"
+	RequestArchiveExportFormatParquet = "parquet"
+)
+
+// RequestArchiveExportInput selects the archived requests of a project to export.
+type RequestArchiveExportInput struct {
+	ProjectID int
+	// From and To are the range [From, To) of the request creation time.
+	From   time.Time
+	To     time.Time
+	Format string
+}
+
+// Validate checks the format and the range of the export.
+func (input RequestArchiveExportInput) Validate() error {
+	switch input.Format {
+	case RequestArchiveExportFormatJSONL, RequestArchiveExportFormatParquet:
+	default:
+		return xerrors.ValidationError(fmt.Sprintf("unsupported request archive export format: %s", input.Format))
+	}
+
+	if !input.From.Before(input.To) {
+		return xerrors.ValidationError("the request archive export range is empty")
+	}
+
+	return nil
+}
+
+// ContentType returns the content type of the export.
+func (input RequestArchiveExportInput) ContentType() string {
+	if input.Format == RequestArchiveExportFormatParquet {
+		return "application/vnd.apache.parquet"
+	}
+
+	return "application/x-ndjson"
+}
+
+// requestArchiveParquetRow is an archived request in the Parquet export, the bodies are JSON strings.
+type requestArchiveParquetRow struct {
+	RequestID      int64     `parquet:"request_id"`
+	ProjectID      int64     `parquet:"project_id"`
+	CreatedAt      time.Time `parquet:"created_at,timestamp(millisecond)"`
+	ModelID        string    `parquet:"model_id,dict"`
+	Format         string    `parquet:"format,dict"`
+	Status         string    `parquet:"status,dict"`
+	Stream         bool      `parquet:"stream"`
+	ChannelID      int64     `parquet:"channel_id"`
+	APIKeyID       int64     `parquet:"api_key_id"`
+	RequestBody    string    `parquet:"request_body,zstd"`
+	ResponseBody   string    `parquet:"response_body,zstd"`
+	ResponseChunks string    `parquet:"response_chunks,zstd"`
+}
+
+type requestArchiveExportWriter interface {
+	write(entry *RequestArchiveEntry) error
+	close() error
+}
+
+type jsonlRequestArchiveWriter struct {
+	enc *json.Encoder
+}
+
+func (w *jsonlRequestArchiveWriter) write(entry *RequestArchiveEntry) error {
+	return w.enc.Encode(entry)
+}
+
+func (w *jsonlRequestArchiveWriter) close() error {
+	return nil
+}
+
+type parquetRequestArchiveWriter struct {
+	w *parquet.GenericWriter[requestArchiveParquetRow]
+}
+
+func (w *parquetRequestArchiveWriter) write(entry *RequestArchiveEntry) error {
+	chunks := ""
+
+	if len(entry.ResponseChunks) > 0 {
+		data, err := json.Marshal(entry.ResponseChunks)
+		if err != nil {
+			return err
+		}
+
+		chunks = string(data)
+	}
+
+	_, err := w.w.Write([]requestArchiveParquetRow{{
+		RequestID:      int64(entry.RequestID),
+		ProjectID:      int64(entry.ProjectID),
+		CreatedAt:      entry.CreatedAt,
+		ModelID:        entry.ModelID,
+		Format:         entry.Format,
+		Status:         entry.Status,
+		Stream:         entry.Stream,
+		ChannelID:      int64(entry.ChannelID),
+		APIKeyID:       int64(entry.APIKeyID),
+		RequestBody:    string(entry.RequestBody),
+		ResponseBody:   string(entry.ResponseBody),
+		ResponseChunks: chunks,
+	}})
+
+	return err
+}
+
+func (w *parquetRequestArchiveWriter) close() error {
+	return w.w.Close()
+}
+
+const requestArchiveExportBatchSize = 500
+
+// ExportArchivedRequests writes the archived requests of a project to w, in request ID order.
+// The requests are selected with the client of the context, so that the export sees the requests the caller can read.
+func (s *RequestService) ExportArchivedRequests(ctx context.Context, input RequestArchiveExportInput, w io.Writer) error {
+	if err := input.Validate(); err != nil {
+		return err
+	}
+
+	var out requestArchiveExportWriter
+	if input.Format == RequestArchiveExportFormatParquet {
+		out = &parquetRequestArchiveWriter{w: parquet.NewGenericWriter[requestArchiveParquetRow](w)}
+	} else {
+		out = &jsonlRequestArchiveWriter{enc: json.NewEncoder(w)}
+	}
+
+	client := s.entFromContext(ctx)
+	storages := map[int]*ent.DataStorage{}
+	lastID := 0
+
+	for {
+		reqs, err := client.Request.Query().
+			Where(
+				request.ProjectID(input.ProjectID),
+				request.ArchivedAtNotNil(),
+				request.CreatedAtGTE(input.From),
+				request.CreatedAtLT(input.To),
+				request.IDGT(lastID),
+			).
+			Select(
+				request.FieldID,
+				request.FieldArchivedAt,
+				request.FieldArchiveStorageID,
+				request.FieldArchiveKey,
+			).
+			Order(ent.Asc(request.FieldID)).
+			Limit(requestArchiveExportBatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query archived requests: %w", err)
+		}
+
+		if len(reqs) == 0 {
+			break
+		}
+
+		lastID = reqs[len(reqs)-1].ID
+
+		if err := s.exportArchivedRequestBatch(ctx, reqs, storages, out); err != nil {
+			return err
+		}
+	}
+
+	return out.close()
+}
+
+// exportArchivedRequestBatch reads each archive object of the batch once and writes the entries of the batch.
+func (s *RequestService) exportArchivedRequestBatch(
+	ctx context.Context,
+	reqs []*ent.Request,
+	storages map[int]*ent.DataStorage,
+	out requestArchiveExportWriter,
+) error {
+	type archiveObject struct {
+		storageID int
+		key       string
+	}
+
+	var objects []archiveObject
+
+	requestIDs := map[archiveObject]map[int]struct{}{}
+
+	for _, req := range reqs {
+		if req.ArchiveStorageID == nil || req.ArchiveKey == nil {
+			continue
+		}
+
+		obj := archiveObject{storageID: *req.ArchiveStorageID, key: *req.ArchiveKey}
+		if _, ok := requestIDs[obj]; !ok {
+			objects = append(objects, obj)
+			requestIDs[obj] = map[int]struct{}{}
+		}
+
+		requestIDs[obj][req.ID] = struct{}{}
+	}
+
+	for _, obj := range objects {
+		ds, ok := storages[obj.storageID]
+		if !ok {
+			var err error
+
+			ds, err = s.DataStorageService.GetDataStorageByID(authz.WithSystemBypass(ctx, "request-archive-export"), obj.storageID)
+			if err != nil {
+				return fmt.Errorf("failed to get archive data storage: %w", err)
+			}
+
+			storages[obj.storageID] = ds
+		}
+
+		ids := requestIDs[obj]
+
+		if err := s.DataStorageService.ReadRequestArchive(ctx, ds, obj.key, func(entry *RequestArchiveEntry) (bool, error) {
+			if _, ok := ids[entry.RequestID]; !ok {
+				return true, nil
+			}
+
+			delete(ids, entry.RequestID)
+
+			return len(ids) > 0, out.write(entry)
+		}); err != nil {
+			return fmt.Errorf("failed to read request archive %s: %w", obj.key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/metrics"
+)
+
+// recordExecutionMetrics records the gateway metrics of a finished request execution.
+func recordExecutionMetrics(ctx context.Context, execution *ent.RequestExecution) {
+	labels := metrics.LLMLabels{
+		ChannelID: execution.ChannelID,
+		Model:     execution.ModelID,
+		ProjectID: execution.ProjectID,
+	}
+	if apiKey, ok := contexts.GetAPIKey(ctx); ok && apiKey != nil {
+		labels.APIKeyID = apiKey.ID
+	}
+
+	record := metrics.LLMExecution{LLMLabels: labels}
+
+	switch {
+	case execution.Status == requestexecution.StatusCompleted:
+		record.StatusClass = "2xx"
+		if execution.MetricsLatencyMs != nil {
+			record.Latency = time.Duration(*execution.MetricsLatencyMs) * time.Millisecond
+		}
+
+		if execution.MetricsFirstTokenLatencyMs != nil {
+			record.FirstTokenLatency = time.Duration(*execution.MetricsFirstTokenLatencyMs) * time.Millisecond
+		}
+	case execution.ResponseStatusCode != nil:
+		record.StatusClass = metrics.StatusClass(*execution.ResponseStatusCode)
+	case execution.Status == requestexecution.StatusCanceled:
+		record.StatusClass = "canceled"
+	default:
+		record.StatusClass = "unknown"
+	}
+
+	metrics.Metrics.RecordLLMExecution(ctx, record)
+}
@@ -0,0 +1,658 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect/sql"
+	"go.uber.org/fx"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/ent/requestsearchterm"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/pkg/xerrors"
+	"github.com/looplj/axonhub/internal/pkg/xtime"
+	"github.com/looplj/axonhub/internal/server/scheduler"
+)
+
+const (
+	requestSearchBatchSize = 100
+
+	// requestSearchPendingTimeout is how long the indexer waits for a request in progress to finish,
+	// after that the request is indexed as it is so that it does not hold back the requests after it.
+	requestSearchPendingTimeout = time.Hour
+
+	// requestSearchMaxTextBytes is the maximum number of bytes of text indexed per body.
+	requestSearchMaxTextBytes = 1 << 20
+
+	// requestSearchMaxTerms is the maximum number of distinct terms indexed per field of a request.
+	requestSearchMaxTerms = 4096
+
+	requestSearchMaxTermLen = 64
+
+	// requestSearchMaxFrequency caps the term frequency used for ranking, so that a term repeated
+	// many times does not outweigh the other terms.
+	requestSearchMaxFrequency = 10
+
+	requestSearchMaxQueryTerms = 16
+
+	requestSearchDefaultLimit = 20
+	requestSearchMaxLimit     = 100
+
+	// requestSearchToolPrefix prefixes the tool names in the index and in the search query.
+	requestSearchToolPrefix = "tool:"
+)
+
+// requestSearchFieldWeights are the ranking weights of the matches in each field.
+var requestSearchFieldWeights = map[requestsearchterm.Field]float64{
+	requestsearchterm.FieldRequest:  1,
+	requestsearchterm.FieldResponse: 2,
+	requestsearchterm.FieldTool:     4,
+}
+
+// requestSearchSkippedKeys are the keys of the request and response bodies whose values are not indexed,
+// they hold identifiers, enums, signatures, binary data or schemas rather than text.
+var requestSearchSkippedKeys = map[string]bool{
+	"id":                 true,
+	"model":              true,
+	"role":               true,
+	"type":               true,
+	"object":             true,
+	"tool_call_id":       true,
+	"tool_use_id":        true,
+	"call_id":            true,
+	"finish_reason":      true,
+	"stop_reason":        true,
+	"system_fingerprint": true,
+	"signature":          true,
+	"encrypted_content":  true,
+	"data":               true,
+	"b64_json":           true,
+	"url":                true,
+	"input_schema":       true,
+	"parameters":         true,
+}
+
+// requestSearchToolKeys are the keys whose objects declare or call a tool by name.
+var requestSearchToolKeys = map[string]bool{
+	"tools":                 true,
+	"function":              true,
+	"function_call":         true,
+	"functionCall":          true,
+	"functionDeclarations":  true,
+	"function_declarations": true,
+}
+
+// requestSearchToolTypes are the types of the content blocks and items which call a tool by name.
+var requestSearchToolTypes = map[string]bool{
+	"tool_use":         true,
+	"server_tool_use":  true,
+	"function_call":    true,
+	"custom_tool_call": true,
+}
+
+// RequestSearchServiceParams contains dependencies for RequestSearchService.
+type RequestSearchServiceParams struct {
+	fx.In
+
+	Ent            *ent.Client
+	SystemService  *SystemService
+	RequestService *RequestService
+}
+
+// RequestSearchService maintains an inverted index over the request and response bodies and the tool names of the requests,
+// and searches the requests of a project with it.
+//
+// The index is stored in the database, so it works the same on all the supported databases, and it is built from the bodies
+// loaded through the data storage of the request, so the bodies offloaded to an external storage are indexed as well.
+// The requests are indexed in the order of their IDs once they are finished.
+type RequestSearchService struct {
+	*AbstractService
+
+	SystemService  *SystemService
+	RequestService *RequestService
+	mu             sync.Mutex
+}
+
+// NewRequestSearchService creates a new RequestSearchService.
+func NewRequestSearchService(params RequestSearchServiceParams) *RequestSearchService {
+	return &RequestSearchService{
+		AbstractService: &AbstractService{
+			db: params.Ent,
+		},
+		SystemService:  params.SystemService,
+		RequestService: params.RequestService,
+	}
+}
+
+func (svc *RequestSearchService) RegisterScheduledTasks(ctx context.Context, s *scheduler.Scheduler) error {
+	return s.Register(ctx, scheduler.TaskSpec{
+		Name:        "request-search-index",
+		Description: "Index the finished requests for the request search every minute",
+		CronExpr:    "* * * * *",
+		Timezone:    "UTC",
+	}, svc.runIndexPeriodically)
+}
+
+func (svc *RequestSearchService) runIndexPeriodically(ctx context.Context) {
+	ctx = authz.WithSystemBypass(ctx, "request-search-index")
+
+	if _, err := svc.IndexPending(ctx); err != nil {
+		log.Error(ctx, "Failed to index requests for search", log.Cause(err))
+	}
+}
+
+// IndexPending indexes the requests after the watermark of the previous run, and returns the number of requests indexed.
+// It stops at the first request still in progress, unless the request has been in progress for too long.
+func (svc *RequestSearchService) IndexPending(ctx context.Context) (int, error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	ctx = ent.NewContext(ctx, svc.db)
+
+	lastID, err := svc.watermark(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	indexed := 0
+
+	for {
+		reqs, err := svc.db.Request.Query().
+			Where(request.IDGT(lastID)).
+			Order(ent.Asc(request.FieldID)).
+			Limit(requestSearchBatchSize).
+			All(ctx)
+		if err != nil {
+			return indexed, fmt.Errorf("failed to query requests to index: %w", err)
+		}
+
+		stalledBefore := xtime.UTCNow().Add(-requestSearchPendingTimeout)
+		blocked := false
+
+		for _, req := range reqs {
+			if isRequestInProgress(req) && req.CreatedAt.After(stalledBefore) {
+				blocked = true
+				break
+			}
+
+			if err := svc.IndexRequest(ctx, req); err != nil {
+				return indexed, err
+			}
+
+			lastID = req.ID
+			indexed++
+		}
+
+		if err := svc.SystemService.setSystemValue(ctx, SystemKeyRequestSearchWatermark, strconv.Itoa(lastID)); err != nil {
+			return indexed, err
+		}
+
+		if blocked || len(reqs) < requestSearchBatchSize {
+			return indexed, nil
+		}
+	}
+}
+
+func isRequestInProgress(req *ent.Request) bool {
+	return req.Status == request.StatusPending || req.Status == request.StatusProcessing
+}
+
+// IndexRequest replaces the index entries of the request with the terms of its current bodies.
+func (svc *RequestSearchService) IndexRequest(ctx context.Context, req *ent.Request) error {
+	requestBody, err := svc.RequestService.LoadRequestBody(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to load request body: %w", err)
+	}
+
+	responseBody, err := svc.RequestService.LoadResponseBody(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to load response body: %w", err)
+	}
+
+	requestDoc := extractRequestSearchDocument(requestBody)
+	responseDoc := extractRequestSearchDocument(responseBody)
+
+	tools := requestDoc.tools
+	for name, count := range responseDoc.tools {
+		tools[name] += count
+	}
+
+	fields := []struct {
+		field requestsearchterm.Field
+		terms map[string]int
+	}{
+		{field: requestsearchterm.FieldRequest, terms: requestDoc.terms},
+		{field: requestsearchterm.FieldResponse, terms: responseDoc.terms},
+		{field: requestsearchterm.FieldTool, terms: tools},
+	}
+
+	return svc.RunInTransaction(ctx, func(ctx context.Context) error {
+		client := svc.entFromContext(ctx)
+
+		if _, err := client.RequestSearchTerm.Delete().
+			Where(requestsearchterm.RequestIDEQ(req.ID)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete request search terms: %w", err)
+		}
+
+		var creates []*ent.RequestSearchTermCreate
+
+		for _, f := range fields {
+			for term, count := range f.terms {
+				creates = append(creates, client.RequestSearchTerm.Create().
+					SetProjectID(req.ProjectID).
+					SetRequestID(req.ID).
+					SetField(f.field).
+					SetTerm(term).
+					SetFrequency(count))
+			}
+		}
+
+		// Keep the batches well below the bind variable limit of SQLite.
+		for chunk := range slices.Chunk(creates, 500) {
+			if err := client.RequestSearchTerm.CreateBulk(chunk...).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to create request search terms: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (svc *RequestSearchService) watermark(ctx context.Context) (int, error) {
+	value, err := svc.SystemService.getSystemValue(ctx, SystemKeyRequestSearchWatermark)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+
+		return 0, fmt.Errorf("failed to get request search watermark: %w", err)
+	}
+
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		log.Warn(ctx, "Invalid request search watermark, indexing all requests", log.String("watermark", value))
+		return 0, nil
+	}
+
+	return id, nil
+}
+
+// SearchRequestsInput is the input of a request search.
+type SearchRequestsInput struct {
+	// Query is the words to search for, all of them must match. A word prefixed with tool: matches the
+	// requests declaring or calling the tool of the name.
+	Query string
+	First *int
+}
+
+// RequestSearchResult is a request matching a search, with the relevance score of the match.
+type RequestSearchResult struct {
+	Request *ent.Request
+	Score   float64
+}
+
+// SearchRequests searches the requests of the project in the context, ordered by relevance.
+//
+// A request matches when all the terms of the query are found in its bodies or tool names.
+// The matches are scored by the frequency of the terms, the field they are found in, and how rare
+// the terms are in the project.
+func (svc *RequestSearchService) SearchRequests(ctx context.Context, input SearchRequestsInput) ([]*RequestSearchResult, error) {
+	projectID, ok := contexts.GetProjectID(ctx)
+	if !ok {
+		return nil, fmt.Errorf("project id not found in context")
+	}
+
+	terms, err := ParseRequestSearchQuery(input.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := requestSearchDefaultLimit
+	if input.First != nil {
+		if *input.First <= 0 || *input.First > requestSearchMaxLimit {
+			return nil, xerrors.ValidationError(fmt.Sprintf("first must be between 1 and %d", requestSearchMaxLimit))
+		}
+
+		limit = *input.First
+	}
+
+	client := svc.entFromContext(ctx)
+
+	var frequencies []struct {
+		Term  string `json:"term"`
+		Count int    `json:"count"`
+	}
+
+	err = client.RequestSearchTerm.Query().
+		Where(
+			requestsearchterm.ProjectIDEQ(projectID),
+			requestsearchterm.TermIn(terms...),
+		).
+		Modify(func(s *sql.Selector) {
+			s.Select(
+				s.C(requestsearchterm.FieldTerm),
+				sql.As(sql.Count(sql.Distinct(s.C(requestsearchterm.FieldRequestID))), "count"),
+			).
+				GroupBy(s.C(requestsearchterm.FieldTerm))
+		}).
+		Scan(ctx, &frequencies)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query request search term frequencies: %w", err)
+	}
+
+	if len(frequencies) < len(terms) {
+		return []*RequestSearchResult{}, nil
+	}
+
+	total, err := client.Request.Query().
+		Where(request.ProjectIDEQ(projectID)).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count requests: %w", err)
+	}
+
+	idf := make(map[string]float64, len(frequencies))
+	for _, f := range frequencies {
+		idf[f.Term] = math.Log(1 + (float64(total)-float64(f.Count)+0.5)/(float64(f.Count)+0.5))
+	}
+
+	var rows []struct {
+		RequestID int     `json:"request_id"`
+		Score     float64 `json:"score"`
+	}
+
+	err = client.RequestSearchTerm.Query().
+		Where(
+			requestsearchterm.ProjectIDEQ(projectID),
+			requestsearchterm.TermIn(terms...),
+		).
+		Limit(limit).
+		Modify(func(s *sql.Selector) {
+			s.Select(s.C(requestsearchterm.FieldRequestID)).
+				AppendSelectExprAs(requestSearchScoreExpr(s, terms, idf), "score").
+				GroupBy(s.C(requestsearchterm.FieldRequestID)).
+				Having(sql.ExprP(fmt.Sprintf("COUNT(DISTINCT %s) = %d", s.C(requestsearchterm.FieldTerm), len(terms)))).
+				OrderExpr(
+					sql.Expr("score DESC"),
+					sql.Expr(s.C(requestsearchterm.FieldRequestID)+" DESC"),
+				)
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search requests: %w", err)
+	}
+
+	if len(rows) == 0 {
+		return []*RequestSearchResult{}, nil
+	}
+
+	ids := make([]int, len(rows))
+	for i, row := range rows {
+		ids[i] = row.RequestID
+	}
+
+	// The requests are queried again under the request policy, which also hides the requests of the
+	// personal API keys of the other users.
+	reqs, err := client.Request.Query().
+		Where(
+			request.IDIn(ids...),
+			request.ProjectIDEQ(projectID),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query requests: %w", err)
+	}
+
+	byID := make(map[int]*ent.Request, len(reqs))
+	for _, req := range reqs {
+		byID[req.ID] = req
+	}
+
+	results := make([]*RequestSearchResult, 0, len(rows))
+
+	for _, row := range rows {
+		req, ok := byID[row.RequestID]
+		if !ok {
+			continue
+		}
+
+		results = append(results, &RequestSearchResult{
+			Request: req,
+			Score:   row.Score,
+		})
+	}
+
+	return results, nil
+}
+
+// requestSearchScoreExpr builds the sum of the weighted term frequencies of a request.
+// The weights are formatted into the expression as numbers, only the terms are bound as arguments.
+func requestSearchScoreExpr(s *sql.Selector, terms []string, idf map[string]float64) sql.Querier {
+	var (
+		b    strings.Builder
+		args = make([]any, 0, len(terms))
+	)
+
+	b.WriteString("SUM((CASE ")
+	b.WriteString(s.C(requestsearchterm.FieldTerm))
+
+	for _, term := range terms {
+		fmt.Fprintf(&b, " WHEN ? THEN %s", strconv.FormatFloat(idf[term], 'f', 6, 64))
+
+		args = append(args, term)
+	}
+
+	b.WriteString(" ELSE 0 END) * (CASE ")
+	b.WriteString(s.C(requestsearchterm.FieldField))
+
+	for _, field := range []requestsearchterm.Field{
+		requestsearchterm.FieldRequest,
+		requestsearchterm.FieldResponse,
+		requestsearchterm.FieldTool,
+	} {
+		fmt.Fprintf(&b, " WHEN '%s' THEN %s", field, strconv.FormatFloat(requestSearchFieldWeights[field], 'f', 1, 64))
+	}
+
+	fmt.Fprintf(&b, " ELSE 0 END) * (CASE WHEN %[1]s > %[2]d THEN %[2]d ELSE %[1]s END))",
+		s.C(requestsearchterm.FieldFrequency), requestSearchMaxFrequency)
+
+	return sql.ExprP(b.String(), args...)
+}
+
+// ParseRequestSearchQuery parses a search query into the index terms to match.
+// The words are tokenized the same way as the bodies, and the words prefixed with tool: match the tool names as a whole.
+func ParseRequestSearchQuery(query string) ([]string, error) {
+	var terms []string
+
+	seen := make(map[string]bool)
+	add := func(term string) {
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+
+	for _, word := range strings.Fields(query) {
+		if len(word) > len(requestSearchToolPrefix) && strings.EqualFold(word[:len(requestSearchToolPrefix)], requestSearchToolPrefix) {
+			name := normalizeRequestSearchToolName(word[len(requestSearchToolPrefix):])
+			if name == "" {
+				return nil, xerrors.ValidationError(fmt.Sprintf("invalid tool name in search query: %s", word))
+			}
+
+			add(requestSearchToolPrefix + name)
+
+			continue
+		}
+
+		tokenizeRequestSearchText(word, add)
+	}
+
+	if len(terms) == 0 {
+		return nil, xerrors.ValidationError("search query has no searchable words")
+	}
+
+	if len(terms) > requestSearchMaxQueryTerms {
+		return nil, xerrors.ValidationError(fmt.Sprintf("search query has more than %d words", requestSearchMaxQueryTerms))
+	}
+
+	return terms, nil
+}
+
+func normalizeRequestSearchToolName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name)+len(requestSearchToolPrefix) > requestSearchMaxTermLen {
+		return ""
+	}
+
+	return name
+}
+
+// requestSearchDocument holds the terms extracted from a body with their frequencies.
+type requestSearchDocument struct {
+	terms map[string]int
+	tools map[string]int
+	size  int
+}
+
+func (d *requestSearchDocument) addTerm(term string) {
+	if _, ok := d.terms[term]; !ok && len(d.terms) >= requestSearchMaxTerms {
+		return
+	}
+
+	d.terms[term]++
+}
+
+func (d *requestSearchDocument) addTool(name string) {
+	name = normalizeRequestSearchToolName(name)
+	if name == "" {
+		return
+	}
+
+	d.tools[requestSearchToolPrefix+name]++
+}
+
+// extractRequestSearchDocument extracts the text terms and the tool names of a request or response body.
+// The body is walked as generic JSON, so all the API formats are supported.
+func extractRequestSearchDocument(body []byte) *requestSearchDocument {
+	doc := &requestSearchDocument{
+		terms: make(map[string]int),
+		tools: make(map[string]int),
+	}
+
+	if len(body) == 0 {
+		return doc
+	}
+
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return doc
+	}
+
+	doc.walk(v, "")
+
+	return doc
+}
+
+func (d *requestSearchDocument) walk(v any, key string) {
+	switch v := v.(type) {
+	case map[string]any:
+		typ, _ := v["type"].(string)
+		if requestSearchToolKeys[key] || requestSearchToolTypes[typ] {
+			if name, ok := v["name"].(string); ok {
+				d.addTool(name)
+			}
+		}
+
+		for k, child := range v {
+			if requestSearchSkippedKeys[k] {
+				continue
+			}
+
+			d.walk(child, k)
+		}
+	case []any:
+		for _, child := range v {
+			d.walk(child, key)
+		}
+	case string:
+		if d.size >= requestSearchMaxTextBytes || strings.HasPrefix(v, "data:") {
+			return
+		}
+
+		d.size += len(v)
+		tokenizeRequestSearchText(v, d.addTerm)
+	}
+}
+
+// tokenizeRequestSearchText splits text into lowercase words of letters and digits.
+// The runs of CJK characters, which are not separated by spaces, are split into overlapping bigrams.
+func tokenizeRequestSearchText(text string, add func(string)) {
+	var (
+		word []rune
+		cjk  []rune
+	)
+
+	flushWord := func() {
+		if len(word) >= 2 {
+			if term := string(word); len(term) <= requestSearchMaxTermLen {
+				add(term)
+			}
+		}
+
+		word = word[:0]
+	}
+
+	flushCJK := func() {
+		switch len(cjk) {
+		case 0:
+		case 1:
+			add(string(cjk))
+		default:
+			for i := 0; i+1 < len(cjk); i++ {
+				add(string(cjk[i : i+2]))
+			}
+		}
+
+		cjk = cjk[:0]
+	}
+
+	for _, r := range text {
+		switch {
+		case r == utf8.RuneError:
+			flushWord()
+			flushCJK()
+		case isCJKRune(r):
+			flushWord()
+
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+
+			word = append(word, unicode.ToLower(r))
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+
+	flushWord()
+	flushCJK()
+}
+
+func isCJKRune(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
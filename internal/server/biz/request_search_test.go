@@ -0,0 +1,325 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/ent/requestsearchterm"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+	"github.com/looplj/axonhub/internal/pkg/xerrors"
+	"github.com/looplj/axonhub/internal/pkg/xtime"
+)
+
+func setupTestRequestSearchService(t *testing.T) (context.Context, *ent.Client, *RequestSearchService) {
+	t.Helper()
+
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=0")
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := authz.WithTestBypass(ent.NewContext(context.Background(), client))
+
+	_, err := client.DataStorage.Create().
+		SetName("Primary").
+		SetDescription("Primary database storage").
+		SetPrimary(true).
+		SetType(datastorage.TypeDatabase).
+		SetSettings(&objects.DataStorageSettings{}).
+		SetStatus(datastorage.StatusActive).
+		Save(ctx)
+	require.NoError(t, err)
+
+	systemService := NewSystemService(SystemServiceParams{
+		CacheConfig: xcache.Config{},
+		Ent:         client,
+	})
+	dataStorageService := NewDataStorageService(DataStorageServiceParams{
+		SystemService: systemService,
+		CacheConfig:   xcache.Config{Mode: xcache.ModeMemory},
+		Client:        client,
+	})
+	requestService := NewRequestService(client, systemService.CacheConfig, systemService, nil, dataStorageService, NewLiveStreamRegistry())
+
+	return ctx, client, NewRequestSearchService(RequestSearchServiceParams{
+		Ent:            client,
+		SystemService:  systemService,
+		RequestService: requestService,
+	})
+}
+
+func createTestSearchRequest(t *testing.T, ctx context.Context, client *ent.Client, projectID int, status request.Status, requestBody, responseBody string) *ent.Request {
+	t.Helper()
+
+	create := client.Request.Create().
+		SetProjectID(projectID).
+		SetModelID("gpt-4o").
+		SetRequestBody(objects.JSONRawMessage(requestBody)).
+		SetStatus(status)
+	if responseBody != "" {
+		create.SetResponseBody(objects.JSONRawMessage(responseBody))
+	}
+
+	req, err := create.Save(ctx)
+	require.NoError(t, err)
+
+	return req
+}
+
+func TestTokenizeRequestSearchText(t *testing.T) {
+	var terms []string
+
+	tokenizeRequestSearchText("Hello, World! search_web x 42 请求搜索", func(term string) {
+		terms = append(terms, term)
+	})
+
+	require.Equal(t, []string{"hello", "world", "search", "web", "42", "请求", "求搜", "搜索"}, terms)
+}
+
+func TestParseRequestSearchQuery(t *testing.T) {
+	terms, err := ParseRequestSearchQuery("Weather TOOL:Search_Web weather")
+	require.NoError(t, err)
+	require.Equal(t, []string{"weather", "tool:search_web"}, terms)
+
+	_, err = ParseRequestSearchQuery("  ! ? ")
+	_, ok := xerrors.IsCodedError(err)
+	require.True(t, ok)
+}
+
+func TestExtractRequestSearchDocument(t *testing.T) {
+	t.Run("openai chat completions", func(t *testing.T) {
+		doc := extractRequestSearchDocument([]byte(`{
+			"model": "gpt-4o",
+			"messages": [
+				{"role": "user", "content": "What is the weather in Paris?"},
+				{"role": "assistant", "tool_calls": [{"id": "call_1", "type": "function", "function": {"name": "get_weather", "arguments": "{\"city\":\"Paris\"}"}}]}
+			],
+			"tools": [{"type": "function", "function": {"name": "get_weather", "description": "Look up the weather", "parameters": {"type": "object", "properties": {"ignored": {}}}}}]
+		}`))
+
+		require.Equal(t, 2, doc.tools["tool:get_weather"])
+		require.Equal(t, 2, doc.terms["paris"])
+		require.Equal(t, 4, doc.terms["weather"])
+		require.NotContains(t, doc.terms, "gpt")
+		require.NotContains(t, doc.terms, "call_1")
+		require.NotContains(t, doc.terms, "ignored")
+	})
+
+	t.Run("anthropic messages", func(t *testing.T) {
+		doc := extractRequestSearchDocument([]byte(`{
+			"content": [
+				{"type": "text", "text": "Let me search."},
+				{"type": "tool_use", "id": "toolu_1", "name": "search_web", "input": {"query": "axonhub"}},
+				{"type": "image", "source": {"type": "base64", "data": "aGVsbG8gd29ybGQ="}}
+			]
+		}`))
+
+		require.Equal(t, 1, doc.tools["tool:search_web"])
+		require.Equal(t, 1, doc.terms["axonhub"])
+		require.NotContains(t, doc.terms, "agvsbg8gd29ybgq")
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		doc := extractRequestSearchDocument([]byte(`not json`))
+		require.Empty(t, doc.terms)
+		require.Empty(t, doc.tools)
+	})
+}
+
+func TestRequestSearchService_IndexPending(t *testing.T) {
+	ctx, client, svc := setupTestRequestSearchService(t)
+
+	first := createTestSearchRequest(t, ctx, client, 1, request.StatusCompleted,
+		`{"messages":[{"role":"user","content":"hello there"}]}`,
+		`{"choices":[{"message":{"role":"assistant","content":"general kenobi"}}]}`)
+	pending := createTestSearchRequest(t, ctx, client, 1, request.StatusProcessing,
+		`{"messages":[{"role":"user","content":"still running"}]}`, "")
+	createTestSearchRequest(t, ctx, client, 1, request.StatusCompleted,
+		`{"messages":[{"role":"user","content":"after the pending one"}]}`, "")
+
+	indexed, err := svc.IndexPending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, indexed)
+
+	terms, err := client.RequestSearchTerm.Query().
+		Where(requestsearchterm.RequestIDEQ(first.ID)).
+		All(ctx)
+	require.NoError(t, err)
+
+	fields := make(map[string]requestsearchterm.Field)
+	for _, term := range terms {
+		fields[term.Term] = term.Field
+	}
+
+	require.Equal(t, requestsearchterm.FieldRequest, fields["hello"])
+	require.Equal(t, requestsearchterm.FieldResponse, fields["kenobi"])
+
+	// The request in progress holds back the indexing until it finishes.
+	indexed, err = svc.IndexPending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, indexed)
+
+	_, err = client.Request.UpdateOne(pending).SetStatus(request.StatusFailed).Save(ctx)
+	require.NoError(t, err)
+
+	indexed, err = svc.IndexPending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, indexed)
+
+	// A request in progress for too long is indexed as it is.
+	_, err = client.Request.Create().
+		SetProjectID(1).
+		SetModelID("gpt-4o").
+		SetRequestBody(objects.JSONRawMessage(`{"messages":[{"role":"user","content":"stalled"}]}`)).
+		SetStatus(request.StatusProcessing).
+		SetCreatedAt(xtime.UTCNow().Add(-2 * requestSearchPendingTimeout)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	indexed, err = svc.IndexPending(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, indexed)
+}
+
+func TestRequestSearchService_IndexRequest_ExternalStorage(t *testing.T) {
+	ctx, client, svc := setupTestRequestSearchService(t)
+
+	dir := t.TempDir()
+	ds, err := client.DataStorage.Create().
+		SetName("fs").
+		SetDescription("external storage").
+		SetPrimary(false).
+		SetType(datastorage.TypeFs).
+		SetSettings(&objects.DataStorageSettings{Directory: &dir}).
+		SetStatus(datastorage.StatusActive).
+		Save(ctx)
+	require.NoError(t, err)
+
+	req, err := client.Request.Create().
+		SetProjectID(1).
+		SetModelID("gpt-4o").
+		SetRequestBody(objects.JSONRawMessage(`{}`)).
+		SetStatus(request.StatusCompleted).
+		SetDataStorageID(ds.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, svc.RequestService.DataStorageService.SaveData(ctx, ds, GenerateRequestBodyKey(1, req.ID),
+		[]byte(`{"messages":[{"role":"user","content":"offloaded question"}]}`)))
+	require.NoError(t, svc.RequestService.DataStorageService.SaveData(ctx, ds, GenerateResponseBodyKey(1, req.ID),
+		[]byte(`{"choices":[{"message":{"content":"offloaded answer"}}]}`)))
+
+	require.NoError(t, svc.IndexRequest(ctx, req))
+
+	results, err := svc.SearchRequests(contexts.WithProjectID(ctx, 1), SearchRequestsInput{Query: "offloaded answer"})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, req.ID, results[0].Request.ID)
+}
+
+func TestRequestSearchService_SearchRequests(t *testing.T) {
+	ctx, client, svc := setupTestRequestSearchService(t)
+
+	weatherTool := createTestSearchRequest(t, ctx, client, 1, request.StatusCompleted,
+		`{"messages":[{"role":"user","content":"weather in paris"}],"tools":[{"name":"search_web","description":"search the web"}]}`,
+		`{"content":[{"type":"tool_use","id":"toolu_1","name":"search_web","input":{"query":"paris weather"}}]}`)
+	weatherAnswer := createTestSearchRequest(t, ctx, client, 1, request.StatusCompleted,
+		`{"messages":[{"role":"user","content":"is it sunny in paris"}]}`,
+		`{"choices":[{"message":{"content":"the weather in paris is sunny, sunny weather all week"}}]}`)
+	weatherQuestion := createTestSearchRequest(t, ctx, client, 1, request.StatusCompleted,
+		`{"messages":[{"role":"user","content":"tell me about the weather"}]}`,
+		`{"choices":[{"message":{"content":"I can not tell"}}]}`)
+	createTestSearchRequest(t, ctx, client, 2, request.StatusCompleted,
+		`{"messages":[{"role":"user","content":"weather in paris"}]}`, "")
+
+	_, err := svc.IndexPending(ctx)
+	require.NoError(t, err)
+
+	projectCtx := contexts.WithProjectID(ctx, 1)
+
+	t.Run("all words must match", func(t *testing.T) {
+		results, err := svc.SearchRequests(projectCtx, SearchRequestsInput{Query: "Paris weather"})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+
+		ids := []int{results[0].Request.ID, results[1].Request.ID}
+		require.ElementsMatch(t, []int{weatherTool.ID, weatherAnswer.ID}, ids)
+		require.GreaterOrEqual(t, results[0].Score, results[1].Score)
+	})
+
+	t.Run("ranked by relevance", func(t *testing.T) {
+		results, err := svc.SearchRequests(projectCtx, SearchRequestsInput{Query: "weather"})
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		require.Equal(t, weatherAnswer.ID, results[0].Request.ID)
+		require.Equal(t, weatherQuestion.ID, results[2].Request.ID)
+	})
+
+	t.Run("tool filter", func(t *testing.T) {
+		results, err := svc.SearchRequests(projectCtx, SearchRequestsInput{Query: "tool:search_web"})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, weatherTool.ID, results[0].Request.ID)
+
+		results, err = svc.SearchRequests(projectCtx, SearchRequestsInput{Query: "tool:search_web sunny"})
+		require.NoError(t, err)
+		require.Empty(t, results)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		first := 1
+
+		results, err := svc.SearchRequests(projectCtx, SearchRequestsInput{Query: "weather", First: &first})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+
+		first = requestSearchMaxLimit + 1
+		_, err = svc.SearchRequests(projectCtx, SearchRequestsInput{Query: "weather", First: &first})
+		require.Error(t, err)
+	})
+
+	t.Run("project scoped", func(t *testing.T) {
+		results, err := svc.SearchRequests(contexts.WithProjectID(ctx, 3), SearchRequestsInput{Query: "weather"})
+		require.NoError(t, err)
+		require.Empty(t, results)
+
+		_, err = svc.SearchRequests(ctx, SearchRequestsInput{Query: "weather"})
+		require.Error(t, err)
+	})
+}
+
+func TestRequestSearchService_IndexRequest_Reindex(t *testing.T) {
+	ctx, client, svc := setupTestRequestSearchService(t)
+
+	req := createTestSearchRequest(t, ctx, client, 1, request.StatusCompleted,
+		`{"messages":[{"role":"user","content":"first version"}]}`, "")
+	require.NoError(t, svc.IndexRequest(ctx, req))
+
+	req, err := client.Request.UpdateOne(req).
+		SetResponseBody(objects.JSONRawMessage(`{"choices":[{"message":{"content":"second answer"}}]}`)).
+		Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, svc.IndexRequest(ctx, req))
+
+	count, err := client.RequestSearchTerm.Query().
+		Where(
+			requestsearchterm.RequestIDEQ(req.ID),
+			requestsearchterm.TermEQ("version"),
+		).
+		Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	results, err := svc.SearchRequests(contexts.WithProjectID(ctx, 1), SearchRequestsInput{Query: "second"})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.WithinDuration(t, time.Now(), results[0].Request.CreatedAt, time.Minute)
+}
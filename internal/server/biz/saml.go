@@ -0,0 +1,592 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/eko/gocache/lib/v4/store"
+	dsig "github.com/russellhaering/goxmldsig"
+	"go.uber.org/fx"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+)
+
+type SAMLConfig struct {
+	Providers []SAMLProvider `conf:"providers" yaml:"providers" json:"providers"`
+}
+
+type SAMLProvider struct {
+	ID          string `conf:"id" yaml:"id" json:"id"`
+	DisplayName string `conf:"display_name" yaml:"display_name" json:"display_name"`
+	// Identity provider metadata, either fetched from a URL or inline XML.
+	IDPMetadataURL string `conf:"idp_metadata_url" yaml:"idp_metadata_url" json:"idp_metadata_url"`
+	IDPMetadata    string `conf:"idp_metadata" yaml:"idp_metadata" json:"idp_metadata"`
+	// Service provider settings. The certificate and private key are PEM content or file paths.
+	EntityID          string `conf:"entity_id" yaml:"entity_id" json:"entity_id"`
+	Certificate       string `conf:"certificate" yaml:"certificate" json:"certificate"`
+	PrivateKey        string `conf:"private_key" yaml:"private_key" json:"private_key"`
+	SignRequests      bool   `conf:"sign_requests" yaml:"sign_requests" json:"sign_requests"`
+	NameIDFormat      string `conf:"name_id_format" yaml:"name_id_format" json:"name_id_format"`
+	AllowIDPInitiated bool   `conf:"allow_idp_initiated" yaml:"allow_idp_initiated" json:"allow_idp_initiated"`
+	// Behavior
+	AttributeMapping   SAMLAttributeMapping `conf:"attribute_mapping" yaml:"attribute_mapping" json:"attribute_mapping"`
+	JITEnabled         bool                 `conf:"jit_enabled" yaml:"jit_enabled" json:"jit_enabled"`
+	AutoLinkByEmail    bool                 `conf:"auto_link_by_email" yaml:"auto_link_by_email" json:"auto_link_by_email"`
+	SyncUserInfo       bool                 `conf:"sync_user_info" yaml:"sync_user_info" json:"sync_user_info"`
+	GroupParser        GroupParserConfig    `conf:"group_parser" yaml:"group_parser" json:"group_parser"`
+	RoleMappingRules   []RoleMappingRule    `conf:"role_mappings" yaml:"role_mappings" json:"role_mappings"`
+	DefaultRoles       []string             `conf:"default_roles" yaml:"default_roles" json:"default_roles"`
+	DefaultScopes      []string             `conf:"default_scopes" yaml:"default_scopes" json:"default_scopes"`
+	SyncRoleStrategy   string               `conf:"sync_role_strategy" yaml:"sync_role_strategy" json:"sync_role_strategy"`
+	RolePrecedenceMode string               `conf:"role_precedence_mode" yaml:"role_precedence_mode" json:"role_precedence_mode"`
+}
+
+// SAMLAttributeMapping names the assertion attributes holding the user profile.
+// An empty field falls back to the common attribute names of the major IdPs.
+type SAMLAttributeMapping struct {
+	Email     string `conf:"email" yaml:"email" json:"email"`
+	FirstName string `conf:"first_name" yaml:"first_name" json:"first_name"`
+	LastName  string `conf:"last_name" yaml:"last_name" json:"last_name"`
+	Name      string `conf:"name" yaml:"name" json:"name"`
+	Groups    string `conf:"groups" yaml:"groups" json:"groups"`
+}
+
+var defaultSAMLAttributes = map[string][]string{
+	"email": {
+		"email", "mail", "emailaddress",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress",
+		"urn:oid:0.9.2342.19200300.100.1.3",
+	},
+	"first_name": {
+		"firstName", "givenName",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname",
+		"urn:oid:2.5.4.42",
+	},
+	"last_name": {
+		"lastName", "surname", "sn",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname",
+		"urn:oid:2.5.4.4",
+	},
+	"name": {
+		"name", "displayName", "cn",
+		"http://schemas.microsoft.com/identity/claims/displayname",
+		"urn:oid:2.16.840.1.113730.3.1.241",
+	},
+	"groups": {
+		"groups", "memberOf", "roles",
+		"http://schemas.microsoft.com/ws/2008/06/identity/claims/groups",
+		"http://schemas.microsoft.com/ws/2008/06/identity/claims/role",
+	},
+}
+
+// SAMLProviderInfo is the public description of a SAML provider shown on the sign-in page.
+type SAMLProviderInfo struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name"`
+}
+
+// SAMLService implements a SAML 2.0 service provider. Users are resolved
+// and provisioned through the OIDC service: SAML identities are stored as
+// OIDCIdentity records keyed by the IdP entity ID and the NameID.
+type SAMLService struct {
+	oidc *OIDCService
+
+	cache      xcache.Cache[[]byte]
+	httpClient *http.Client
+	providers  map[string]*samlProvider
+	order      []string
+}
+
+type samlProvider struct {
+	config SAMLProvider
+
+	key  *rsa.PrivateKey
+	cert *x509.Certificate
+
+	mu       sync.Mutex
+	metadata *saml.EntityDescriptor
+}
+
+type SAMLServiceParams struct {
+	fx.In
+
+	Config      SAMLConfig
+	CacheConfig xcache.Config
+	OIDCService *OIDCService
+}
+
+func NewSAMLService(params SAMLServiceParams) (*SAMLService, error) {
+	svc := &SAMLService{
+		oidc:       params.OIDCService,
+		cache:      xcache.NewFromConfig[[]byte](params.CacheConfig),
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		providers:  make(map[string]*samlProvider),
+	}
+
+	for i, cfg := range params.Config.Providers {
+		cfg.ID = strings.TrimSpace(cfg.ID)
+		if cfg.ID == "" {
+			return nil, fmt.Errorf("SAML provider at index %d requires id", i)
+		}
+
+		if cfg.DisplayName == "" {
+			cfg.DisplayName = cfg.ID
+		}
+
+		providerID := normalizeOIDCProviderIdentifier(cfg.ID)
+		if _, ok := svc.providers[providerID]; ok {
+			return nil, fmt.Errorf("duplicate SAML provider id %q", cfg.ID)
+		}
+
+		p, err := newSAMLProvider(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("SAML provider %q: %w", cfg.ID, err)
+		}
+
+		svc.providers[providerID] = p
+		svc.order = append(svc.order, providerID)
+	}
+
+	return svc, nil
+}
+
+func newSAMLProvider(cfg SAMLProvider) (*samlProvider, error) {
+	p := &samlProvider{config: cfg}
+
+	switch {
+	case cfg.IDPMetadata != "":
+		md, err := parseSAMLMetadata([]byte(cfg.IDPMetadata))
+		if err != nil {
+			return nil, err
+		}
+
+		p.metadata = md
+	case cfg.IDPMetadataURL == "":
+		return nil, errors.New("idp_metadata_url or idp_metadata is required")
+	}
+
+	if (cfg.Certificate == "") != (cfg.PrivateKey == "") {
+		return nil, errors.New("certificate and private_key must be configured together")
+	}
+
+	if cfg.PrivateKey != "" {
+		key, cert, err := loadSAMLKeyPair(cfg.PrivateKey, cfg.Certificate)
+		if err != nil {
+			return nil, err
+		}
+
+		p.key = key
+		p.cert = cert
+	}
+
+	if cfg.SignRequests && p.key == nil {
+		return nil, errors.New("sign_requests requires certificate and private_key")
+	}
+
+	return p, nil
+}
+
+func (s *SAMLService) CountProviders() int {
+	return len(s.providers)
+}
+
+func (s *SAMLService) GetProviders() []SAMLProviderInfo {
+	providers := make([]SAMLProviderInfo, 0, len(s.order))
+	for _, id := range s.order {
+		p := s.providers[id]
+		providers = append(providers, SAMLProviderInfo{
+			ID:          p.config.ID,
+			DisplayName: p.config.DisplayName,
+		})
+	}
+
+	return providers
+}
+
+// Metadata returns the SP metadata XML to register in the IdP.
+func (s *SAMLService) Metadata(ctx context.Context, providerIdentifier, baseURL string) ([]byte, error) {
+	sp, _, err := s.serviceProvider(ctx, providerIdentifier, baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return xml.MarshalIndent(sp.Metadata(), "", "  ")
+}
+
+// GetLoginURL builds the HTTP-Redirect binding URL of an AuthnRequest to the IdP.
+// The request ID is remembered under the relay state so the ACS only accepts
+// assertions issued in response to it.
+func (s *SAMLService) GetLoginURL(ctx context.Context, providerIdentifier, baseURL string) (string, error) {
+	sp, _, err := s.serviceProvider(ctx, providerIdentifier, baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	authnRequest, err := sp.MakeAuthenticationRequest(sp.GetSSOBindingLocation(saml.HTTPRedirectBinding), saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		return "", fmt.Errorf("failed to create SAML authentication request: %w", err)
+	}
+
+	relayStateBytes := make([]byte, 16)
+	_, _ = rand.Read(relayStateBytes)
+	relayState := hex.EncodeToString(relayStateBytes)
+
+	err = s.cache.Set(ctx, "saml_request:"+relayState, []byte(authnRequest.ID), store.WithExpiration(10*time.Minute))
+	if err != nil {
+		return "", fmt.Errorf("failed to cache SAML request: %w", err)
+	}
+
+	redirectURL, err := authnRequest.Redirect(relayState, sp)
+	if err != nil {
+		return "", fmt.Errorf("failed to create SAML redirect: %w", err)
+	}
+
+	return redirectURL.String(), nil
+}
+
+// ConsumeAssertion validates the SAML response posted to the ACS, resolves the
+// user and returns an exchange code for the frontend to sign in with.
+func (s *SAMLService) ConsumeAssertion(ctx context.Context, providerIdentifier, baseURL, samlResponse, relayState string) (string, error) {
+	// Elevate privileges for database operations as this is an unauthenticated flow
+	ctx = contexts.WithUser(ctx, &ent.User{IsOwner: true})
+
+	sp, p, err := s.serviceProvider(ctx, providerIdentifier, baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	var possibleRequestIDs []string
+
+	if relayState != "" {
+		requestID, err := s.cache.Get(ctx, "saml_request:"+relayState)
+		if err == nil && len(requestID) > 0 {
+			_ = s.cache.Delete(ctx, "saml_request:"+relayState) // Consume request
+			possibleRequestIDs = []string{string(requestID)}
+		}
+	}
+
+	if len(possibleRequestIDs) == 0 && !p.config.AllowIDPInitiated {
+		return "", fmt.Errorf("invalid or expired SAML relay state")
+	}
+
+	rawResponse, err := base64.StdEncoding.DecodeString(samlResponse)
+	if err != nil {
+		return "", fmt.Errorf("invalid SAML response encoding: %w", err)
+	}
+
+	assertion, err := sp.ParseXMLResponse(rawResponse, possibleRequestIDs)
+	if err != nil {
+		var invalidErr *saml.InvalidResponseError
+		if errors.As(err, &invalidErr) {
+			return "", fmt.Errorf("invalid SAML response: %w", invalidErr.PrivateErr)
+		}
+
+		return "", fmt.Errorf("invalid SAML response: %w", err)
+	}
+
+	// Reject replays. The assertion is only valid for MaxIssueDelay after issuance,
+	// so its ID only has to be remembered for that long.
+	replayKey := "saml_assertion:" + sp.IDPMetadata.EntityID + ":" + assertion.ID
+	if seen, err := s.cache.Get(ctx, replayKey); err == nil && len(seen) > 0 {
+		return "", fmt.Errorf("SAML assertion has already been used")
+	}
+
+	err = s.cache.Set(ctx, replayKey, []byte{1}, store.WithExpiration(saml.MaxIssueDelay+saml.MaxClockSkew))
+	if err != nil {
+		return "", fmt.Errorf("failed to cache SAML assertion: %w", err)
+	}
+
+	if assertion.Subject == nil || assertion.Subject.NameID == nil || assertion.Subject.NameID.Value == "" {
+		return "", fmt.Errorf("SAML assertion has no NameID")
+	}
+
+	nameID := assertion.Subject.NameID
+	attrs := p.attributes(assertion)
+
+	email := attrs.first("email")
+	if email == "" && nameID.Format == string(saml.EmailAddressNameIDFormat) {
+		email = nameID.Value
+	}
+
+	email = strings.ToLower(email)
+
+	oidcProvider := p.oidcProvider(sp.IDPMetadata.EntityID)
+	groups := s.oidc.extractGroups(map[string]any{"groups": attrs.all("groups")}, oidcProvider)
+
+	// The assertion is signed by the IdP, so its email is trusted like a verified OIDC email.
+	u, err := s.oidc.resolveUser(ctx, oidcProvider, nameID.Value, email, email != "",
+		attrs.first("name"), attrs.first("first_name"), attrs.first("last_name"), "", groups)
+	if err != nil {
+		return "", err
+	}
+
+	if u.Status == user.StatusDeactivated {
+		return "", fmt.Errorf("User account is deactivated")
+	}
+
+	return s.oidc.issueExchangeCode(ctx, u.ID)
+}
+
+func (s *SAMLService) serviceProvider(ctx context.Context, providerIdentifier, baseURL string) (*saml.ServiceProvider, *samlProvider, error) {
+	p, ok := s.providers[normalizeOIDCProviderIdentifier(providerIdentifier)]
+	if !ok {
+		return nil, nil, fmt.Errorf("SAML provider not found: %s", providerIdentifier)
+	}
+
+	md, err := s.idpMetadata(ctx, p)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid base URL: %w", err)
+	}
+
+	sp := &saml.ServiceProvider{
+		EntityID:          p.config.EntityID,
+		Key:               p.key,
+		Certificate:       p.cert,
+		HTTPClient:        s.httpClient,
+		MetadataURL:       *base.JoinPath("/oauth/saml/metadata", p.config.ID),
+		AcsURL:            *base.JoinPath("/oauth/saml/acs", p.config.ID),
+		IDPMetadata:       md,
+		AuthnNameIDFormat: saml.NameIDFormat(p.config.NameIDFormat),
+		AllowIDPInitiated: p.config.AllowIDPInitiated,
+	}
+
+	if sp.AuthnNameIDFormat == "" {
+		sp.AuthnNameIDFormat = saml.UnspecifiedNameIDFormat
+	}
+
+	if p.config.SignRequests {
+		sp.SignatureMethod = dsig.RSASHA256SignatureMethod
+	}
+
+	return sp, p, nil
+}
+
+// idpMetadata returns the IdP metadata, fetching it on first use when configured by URL.
+func (s *SAMLService) idpMetadata(ctx context.Context, p *samlProvider) (*saml.EntityDescriptor, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.config.IDPMetadataURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IdP metadata URL: %w", err)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch IdP metadata: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch IdP metadata: status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read IdP metadata: %w", err)
+	}
+
+	md, err := parseSAMLMetadata(body)
+	if err != nil {
+		return nil, err
+	}
+
+	p.metadata = md
+
+	return md, nil
+}
+
+// oidcProvider adapts the SAML provider to the OIDC provider config used for
+// identity linking, JIT provisioning and role mapping.
+func (p *samlProvider) oidcProvider(issuer string) *oidcProvider {
+	return &oidcProvider{
+		config: OIDCProvider{
+			ID:                 p.config.ID,
+			Name:               p.config.ID,
+			DisplayName:        p.config.DisplayName,
+			Issuer:             issuer,
+			JITEnabled:         p.config.JITEnabled,
+			AutoLinkByEmail:    p.config.AutoLinkByEmail,
+			SyncUserInfo:       p.config.SyncUserInfo,
+			GroupClaims:        []string{"groups"},
+			GroupParser:        p.config.GroupParser,
+			RoleMappingRules:   p.config.RoleMappingRules,
+			DefaultRoles:       p.config.DefaultRoles,
+			DefaultScopes:      p.config.DefaultScopes,
+			SyncRoleStrategy:   p.config.SyncRoleStrategy,
+			RolePrecedenceMode: p.config.RolePrecedenceMode,
+		},
+	}
+}
+
+type samlAttributes struct {
+	values  map[string][]string
+	mapping map[string]string
+}
+
+func (p *samlProvider) attributes(assertion *saml.Assertion) samlAttributes {
+	values := make(map[string][]string)
+
+	for _, statement := range assertion.AttributeStatements {
+		for _, attr := range statement.Attributes {
+			for _, v := range attr.Values {
+				if v.Value == "" {
+					continue
+				}
+
+				for _, name := range []string{attr.Name, attr.FriendlyName} {
+					if name != "" {
+						key := strings.ToLower(name)
+						values[key] = append(values[key], v.Value)
+					}
+				}
+			}
+		}
+	}
+
+	m := p.config.AttributeMapping
+
+	return samlAttributes{
+		values: values,
+		mapping: map[string]string{
+			"email":      m.Email,
+			"first_name": m.FirstName,
+			"last_name":  m.LastName,
+			"name":       m.Name,
+			"groups":     m.Groups,
+		},
+	}
+}
+
+func (a samlAttributes) all(field string) []string {
+	names := defaultSAMLAttributes[field]
+	if mapped := a.mapping[field]; mapped != "" {
+		names = []string{mapped}
+	}
+
+	for _, name := range names {
+		if v := a.values[strings.ToLower(name)]; len(v) > 0 {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func (a samlAttributes) first(field string) string {
+	if v := a.all(field); len(v) > 0 {
+		return strings.TrimSpace(v[0])
+	}
+
+	return ""
+}
+
+// parseSAMLMetadata accepts an EntityDescriptor or an EntitiesDescriptor and
+// returns the first entity acting as an identity provider.
+func parseSAMLMetadata(data []byte) (*saml.EntityDescriptor, error) {
+	var entity saml.EntityDescriptor
+	if err := xml.Unmarshal(data, &entity); err == nil {
+		if len(entity.IDPSSODescriptors) == 0 {
+			return nil, errors.New("IdP metadata has no IDPSSODescriptor")
+		}
+
+		return &entity, nil
+	}
+
+	var entities saml.EntitiesDescriptor
+	if err := xml.Unmarshal(data, &entities); err != nil {
+		return nil, fmt.Errorf("invalid IdP metadata: %w", err)
+	}
+
+	for i := range entities.EntityDescriptors {
+		if len(entities.EntityDescriptors[i].IDPSSODescriptors) > 0 {
+			return &entities.EntityDescriptors[i], nil
+		}
+	}
+
+	return nil, errors.New("IdP metadata has no IDPSSODescriptor")
+}
+
+func loadSAMLKeyPair(keyValue, certValue string) (*rsa.PrivateKey, *x509.Certificate, error) {
+	keyPEM, err := readPEMValue(keyValue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("private_key: %w", err)
+	}
+
+	certPEM, err := readPEMValue(certValue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("certificate: %w", err)
+	}
+
+	keyBlock, _ := pem.Decode(keyPEM)
+	if keyBlock == nil {
+		return nil, nil, errors.New("private_key: no PEM block found")
+	}
+
+	var key *rsa.PrivateKey
+
+	if parsed, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes); err == nil {
+		key = parsed
+	} else {
+		parsed, err := x509.ParsePKCS8PrivateKey(keyBlock.Bytes)
+		if err != nil {
+			return nil, nil, fmt.Errorf("private_key: %w", err)
+		}
+
+		rsaKey, ok := parsed.(*rsa.PrivateKey)
+		if !ok {
+			return nil, nil, errors.New("private_key: only RSA keys are supported")
+		}
+
+		key = rsaKey
+	}
+
+	certBlock, _ := pem.Decode(certPEM)
+	if certBlock == nil {
+		return nil, nil, errors.New("certificate: no PEM block found")
+	}
+
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("certificate: %w", err)
+	}
+
+	return key, cert, nil
+}
+
+// readPEMValue returns inline PEM content as is and reads anything else as a file path.
+func readPEMValue(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	return os.ReadFile(value)
+}
@@ -0,0 +1,252 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/xml"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/oidcidentity"
+	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+)
+
+const samlTestBaseURL = "https://axonhub.example.com"
+
+// samlStubIdP is a minimal identity provider signing assertions for a single service provider.
+type samlStubIdP struct {
+	idp        *saml.IdentityProvider
+	spMetadata *saml.EntityDescriptor
+}
+
+func (s *samlStubIdP) GetServiceProvider(_ *http.Request, _ string) (*saml.EntityDescriptor, error) {
+	return s.spMetadata, nil
+}
+
+func newSAMLStubIdP(t *testing.T) *samlStubIdP {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "stub-idp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(certDER)
+	require.NoError(t, err)
+
+	metadataURL, _ := url.Parse("https://idp.example.com/metadata")
+	ssoURL, _ := url.Parse("https://idp.example.com/sso")
+
+	stub := &samlStubIdP{}
+	stub.idp = &saml.IdentityProvider{
+		Key:                     key,
+		Certificate:             cert,
+		MetadataURL:             *metadataURL,
+		SSOURL:                  *ssoURL,
+		ServiceProviderProvider: stub,
+	}
+
+	return stub
+}
+
+func (s *samlStubIdP) metadataXML(t *testing.T) string {
+	t.Helper()
+
+	data, err := xml.Marshal(s.idp.Metadata())
+	require.NoError(t, err)
+
+	return string(data)
+}
+
+// respond plays the IdP side of the redirect binding and returns the posted SAMLResponse and RelayState.
+func (s *samlStubIdP) respond(t *testing.T, loginURL string, session *saml.Session) (string, string) {
+	t.Helper()
+
+	req, err := saml.NewIdpAuthnRequest(s.idp, httptest.NewRequest(http.MethodGet, loginURL, nil))
+	require.NoError(t, err)
+	require.NoError(t, req.Validate())
+	require.NoError(t, saml.DefaultAssertionMaker{}.MakeAssertion(req, session))
+	require.NoError(t, req.MakeAssertionEl())
+
+	form, err := req.PostBinding()
+	require.NoError(t, err)
+	require.Equal(t, samlTestBaseURL+"/oauth/saml/acs/corp", form.URL)
+
+	return form.SAMLResponse, form.RelayState
+}
+
+func setupTestSAMLService(t *testing.T, cfg SAMLProvider) (*SAMLService, *samlStubIdP, *ent.Client, context.Context) {
+	t.Helper()
+
+	oidcSvc, client := setupTestOIDCService(t)
+	t.Cleanup(func() { client.Close() })
+
+	stub := newSAMLStubIdP(t)
+	cfg.ID = "corp"
+	cfg.IDPMetadata = stub.metadataXML(t)
+
+	svc, err := NewSAMLService(SAMLServiceParams{
+		Config:      SAMLConfig{Providers: []SAMLProvider{cfg}},
+		CacheConfig: xcache.Config{Mode: xcache.ModeMemory},
+		OIDCService: oidcSvc,
+	})
+	require.NoError(t, err)
+
+	ctx := ent.NewContext(context.Background(), client)
+	ctx = authz.WithTestBypass(ctx)
+
+	spMetadata, err := svc.Metadata(ctx, "corp", samlTestBaseURL)
+	require.NoError(t, err)
+
+	stub.spMetadata = &saml.EntityDescriptor{}
+	require.NoError(t, xml.Unmarshal(spMetadata, stub.spMetadata))
+
+	return svc, stub, client, ctx
+}
+
+func samlTestSession(email string, groups ...string) *saml.Session {
+	return &saml.Session{
+		ID:             "session-1",
+		CreateTime:     time.Now(),
+		ExpireTime:     time.Now().Add(time.Hour),
+		Index:          "1",
+		NameID:         "alice-persistent-id",
+		NameIDFormat:   "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent",
+		UserEmail:      email,
+		UserGivenName:  "Alice",
+		UserSurname:    "Smith",
+		UserCommonName: "Alice Smith",
+		Groups:         groups,
+	}
+}
+
+func TestSAMLService_LoginProvisionsUser(t *testing.T) {
+	svc, stub, client, ctx := setupTestSAMLService(t, SAMLProvider{
+		JITEnabled: true,
+		AttributeMapping: SAMLAttributeMapping{
+			Email:  "eduPersonPrincipalName",
+			Groups: "eduPersonAffiliation",
+		},
+		RoleMappingRules: []RoleMappingRule{{MatchGroup: "ai-admins", DBRole: "Admin"}},
+	})
+
+	admin := client.Role.Create().SetName("Admin").SetLevel(role.LevelSystem).SaveX(ctx)
+
+	loginURL, err := svc.GetLoginURL(ctx, "corp", samlTestBaseURL)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(loginURL, "https://idp.example.com/sso?SAMLRequest="))
+
+	samlResponse, relayState := stub.respond(t, loginURL, samlTestSession("Alice@example.com", "AI-Admins"))
+
+	code, err := svc.ConsumeAssertion(ctx, "corp", samlTestBaseURL, samlResponse, relayState)
+	require.NoError(t, err)
+
+	u, err := svc.oidc.ExchangeCode(ctx, code)
+	require.NoError(t, err)
+	require.Equal(t, "alice@example.com", u.Email)
+	require.Equal(t, "Alice", u.FirstName)
+	require.Equal(t, "Smith", u.LastName)
+	require.Equal(t, OIDC_ONLY_PLACEHOLDER, u.Password)
+
+	roleIDs := client.Role.Query().Where(role.HasUsersWith(user.IDEQ(u.ID))).IDsX(ctx)
+	require.Equal(t, []int{admin.ID}, roleIDs)
+
+	identity := client.OIDCIdentity.Query().Where(oidcidentity.UserID(u.ID)).OnlyX(ctx)
+	require.Equal(t, stub.idp.MetadataURL.String(), identity.Issuer)
+	require.Equal(t, "alice-persistent-id", identity.Subject)
+
+	// The relay state is single use.
+	_, err = svc.ConsumeAssertion(ctx, "corp", samlTestBaseURL, samlResponse, relayState)
+	require.ErrorContains(t, err, "relay state")
+
+	// A second login resolves the same user through the linked identity.
+	loginURL, err = svc.GetLoginURL(ctx, "corp", samlTestBaseURL)
+	require.NoError(t, err)
+
+	samlResponse, relayState = stub.respond(t, loginURL, samlTestSession("alice@example.com"))
+
+	code, err = svc.ConsumeAssertion(ctx, "corp", samlTestBaseURL, samlResponse, relayState)
+	require.NoError(t, err)
+
+	again, err := svc.oidc.ExchangeCode(ctx, code)
+	require.NoError(t, err)
+	require.Equal(t, u.ID, again.ID)
+}
+
+func TestSAMLService_RejectsInvalidResponses(t *testing.T) {
+	svc, stub, client, ctx := setupTestSAMLService(t, SAMLProvider{JITEnabled: true})
+
+	loginURL, err := svc.GetLoginURL(ctx, "corp", samlTestBaseURL)
+	require.NoError(t, err)
+
+	samlResponse, relayState := stub.respond(t, loginURL, samlTestSession("alice@example.com"))
+
+	// Tampering with the signed assertion invalidates the signature.
+	raw, err := base64.StdEncoding.DecodeString(samlResponse)
+	require.NoError(t, err)
+
+	tampered := base64.StdEncoding.EncodeToString([]byte(strings.ReplaceAll(string(raw), "alice@example.com", "mallory@example.com")))
+
+	_, err = svc.ConsumeAssertion(ctx, "corp", samlTestBaseURL, tampered, relayState)
+	require.ErrorContains(t, err, "invalid SAML response")
+
+	// Unsolicited responses are rejected unless IdP-initiated login is allowed.
+	_, err = svc.ConsumeAssertion(ctx, "corp", samlTestBaseURL, samlResponse, "")
+	require.ErrorContains(t, err, "relay state")
+
+	require.Zero(t, client.User.Query().CountX(ctx))
+}
+
+func TestSAMLService_IDPInitiatedReplay(t *testing.T) {
+	svc, stub, _, ctx := setupTestSAMLService(t, SAMLProvider{JITEnabled: true, AllowIDPInitiated: true})
+
+	loginURL, err := svc.GetLoginURL(ctx, "corp", samlTestBaseURL)
+	require.NoError(t, err)
+
+	samlResponse, _ := stub.respond(t, loginURL, samlTestSession("alice@example.com"))
+
+	_, err = svc.ConsumeAssertion(ctx, "corp", samlTestBaseURL, samlResponse, "")
+	require.NoError(t, err)
+
+	_, err = svc.ConsumeAssertion(ctx, "corp", samlTestBaseURL, samlResponse, "")
+	require.ErrorContains(t, err, "already been used")
+}
+
+func TestNewSAMLService_Validation(t *testing.T) {
+	_, err := NewSAMLService(SAMLServiceParams{
+		Config:      SAMLConfig{Providers: []SAMLProvider{{ID: "corp"}}},
+		CacheConfig: xcache.Config{Mode: xcache.ModeMemory},
+	})
+	require.ErrorContains(t, err, "idp_metadata")
+
+	_, err = NewSAMLService(SAMLServiceParams{
+		Config: SAMLConfig{Providers: []SAMLProvider{
+			{ID: "corp", IDPMetadataURL: "https://idp.example.com/metadata", SignRequests: true},
+		}},
+		CacheConfig: xcache.Config{Mode: xcache.ModeMemory},
+	})
+	require.ErrorContains(t, err, "sign_requests")
+}
@@ -0,0 +1,1080 @@
+package biz
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+	"go.uber.org/fx"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/scimgroup"
+	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/userproject"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+const (
+	SCIMDefaultCount = 100
+	SCIMMaxCount     = 1000
+)
+
+// SCIMConfig configures the SCIM 2.0 provisioning endpoints under /scim/v2.
+type SCIMConfig struct {
+	Enabled bool `conf:"enabled" yaml:"enabled" json:"enabled"`
+	// Token is the bearer token used by the identity provider, it grants access to the SCIM endpoints only.
+	Token         string             `conf:"token" yaml:"token" json:"token"`
+	GroupMappings []SCIMGroupMapping `conf:"group_mappings" yaml:"group_mappings" json:"group_mappings"`
+}
+
+// SCIMGroupMapping grants the members of an identity provider group system roles and project membership.
+// Roles and projects referenced by any mapping are managed by SCIM: they are revoked when the user leaves the groups.
+type SCIMGroupMapping struct {
+	Group    string               `conf:"group" yaml:"group" json:"group"`
+	Roles    []string             `conf:"roles" yaml:"roles" json:"roles"`
+	Projects []SCIMProjectMapping `conf:"projects" yaml:"projects" json:"projects"`
+}
+
+type SCIMProjectMapping struct {
+	ProjectID int      `conf:"project_id" yaml:"project_id" json:"project_id"`
+	Roles     []string `conf:"roles" yaml:"roles" json:"roles"`
+	Scopes    []string `conf:"scopes" yaml:"scopes" json:"scopes"`
+}
+
+type SCIMServiceParams struct {
+	fx.In
+
+	Config        SCIMConfig
+	Ent           *ent.Client
+	UserService   *UserService
+	APIKeyService *APIKeyService
+}
+
+// SCIMService provisions users and groups from an identity provider.
+type SCIMService struct {
+	*AbstractService
+
+	cfg           SCIMConfig
+	userService   *UserService
+	apiKeyService *APIKeyService
+}
+
+func NewSCIMService(params SCIMServiceParams) (*SCIMService, error) {
+	for i, mapping := range params.Config.GroupMappings {
+		if strings.TrimSpace(mapping.Group) == "" {
+			return nil, fmt.Errorf("SCIM group mapping at index %d requires group", i)
+		}
+
+		for _, project := range mapping.Projects {
+			if project.ProjectID <= 0 {
+				return nil, fmt.Errorf("SCIM group mapping %q requires project_id for project mappings", mapping.Group)
+			}
+		}
+	}
+
+	return &SCIMService{
+		AbstractService: &AbstractService{
+			db: params.Ent,
+		},
+		cfg:           params.Config,
+		userService:   params.UserService,
+		apiKeyService: params.APIKeyService,
+	}, nil
+}
+
+// Enabled reports whether the SCIM endpoints are enabled and have a token configured.
+func (s *SCIMService) Enabled() bool {
+	return s.cfg.Enabled && s.cfg.Token != ""
+}
+
+// Authenticate reports whether the token is the SCIM bearer token.
+func (s *SCIMService) Authenticate(token string) bool {
+	if !s.Enabled() || token == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(token), []byte(s.cfg.Token)) == 1
+}
+
+// ListUsers lists the users matching the filter, the startIndex is 1-based.
+func (s *SCIMService) ListUsers(ctx context.Context, filter string, startIndex, count int) (*objects.SCIMListResponse[objects.SCIMUser], error) {
+	query := s.entFromContext(ctx).User.Query()
+
+	if filter != "" {
+		attr, value, err := parseSCIMFilter(filter)
+		if err != nil {
+			return nil, err
+		}
+
+		switch strings.ToLower(attr) {
+		case "username", "emails", "emails.value":
+			query.Where(user.EmailEqualFold(value))
+		case "id":
+			query.Where(user.IDIn(parseSCIMIDs(value)...))
+		default:
+			return nil, objects.NewSCIMError(http.StatusBadRequest, "invalidFilter", fmt.Sprintf("filtering users by %q is not supported", attr))
+		}
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	startIndex, count = normalizeSCIMPage(startIndex, count)
+
+	var users []*ent.User
+	if count > 0 {
+		users, err = query.
+			WithScimGroups().
+			Order(ent.Asc(user.FieldID)).
+			Offset(startIndex - 1).
+			Limit(count).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list users: %w", err)
+		}
+	}
+
+	return newSCIMListResponse(total, startIndex, lo.Map(users, func(u *ent.User, _ int) objects.SCIMUser {
+		return toSCIMUser(u)
+	})), nil
+}
+
+// GetUser gets the user by its SCIM id.
+func (s *SCIMService) GetUser(ctx context.Context, id string) (*objects.SCIMUser, error) {
+	u, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.ToPtr(toSCIMUser(u)), nil
+}
+
+// CreateUser provisions a user, the user signs in through SSO and has no password.
+func (s *SCIMService) CreateUser(ctx context.Context, input objects.SCIMUser) (*objects.SCIMUser, error) {
+	email := strings.TrimSpace(input.UserName)
+	if email == "" {
+		return nil, objects.NewSCIMError(http.StatusBadRequest, "invalidValue", "userName is required")
+	}
+
+	if err := s.checkEmailAvailable(ctx, email, 0); err != nil {
+		return nil, err
+	}
+
+	firstName, lastName := scimUserNames(input)
+
+	status := user.StatusActivated
+	if input.Active != nil && !*input.Active {
+		status = user.StatusDeactivated
+	}
+
+	created, err := s.entFromContext(ctx).User.Create().
+		SetEmail(email).
+		SetFirstName(firstName).
+		SetLastName(lastName).
+		SetPassword(OIDC_ONLY_PLACEHOLDER).
+		SetStatus(status).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	return lo.ToPtr(toSCIMUser(created)), nil
+}
+
+// ReplaceUser replaces the attributes of the user.
+func (s *SCIMService) ReplaceUser(ctx context.Context, id string, input objects.SCIMUser) (*objects.SCIMUser, error) {
+	u, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.updateUser(ctx, u, input)
+}
+
+// PatchUser applies the patch operations to the user.
+func (s *SCIMService) PatchUser(ctx context.Context, id string, patch objects.SCIMPatchRequest) (*objects.SCIMUser, error) {
+	u, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	input := toSCIMUser(u)
+	for _, op := range patch.Operations {
+		if err := applySCIMUserPatch(&input, op); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.updateUser(ctx, u, input)
+}
+
+// DeleteUser deprovisions the user: its user and personal API keys are disabled and the user is deleted.
+func (s *SCIMService) DeleteUser(ctx context.Context, id string) error {
+	u, err := s.getUser(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if u.IsOwner {
+		return objects.NewSCIMError(http.StatusBadRequest, "mutability", "the owner user cannot be deprovisioned")
+	}
+
+	var disabledKeys []string
+
+	err = s.RunInTransaction(ctx, func(ctx context.Context) error {
+		client := s.entFromContext(ctx)
+
+		disabledKeys, err = s.disableUserAPIKeys(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := client.User.UpdateOneID(u.ID).
+			SetStatus(user.StatusDeactivated).
+			ClearScimGroups().
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to deactivate user: %w", err)
+		}
+
+		return s.userService.deleteUser(ctx, u.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.apiKeyService.invalidateAPIKeyCaches(ctx, disabledKeys...)
+
+	return nil
+}
+
+func (s *SCIMService) getUser(ctx context.Context, id string) (*ent.User, error) {
+	userID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, scimNotFound("User", id)
+	}
+
+	u, err := s.entFromContext(ctx).User.Query().
+		Where(user.IDEQ(userID)).
+		WithScimGroups().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, scimNotFound("User", id)
+		}
+
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return u, nil
+}
+
+func (s *SCIMService) checkEmailAvailable(ctx context.Context, email string, userID int) error {
+	exists, err := s.entFromContext(ctx).User.Query().
+		Where(user.EmailEqualFold(email), user.IDNEQ(userID)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check user email: %w", err)
+	}
+
+	if exists {
+		return objects.NewSCIMError(http.StatusConflict, "uniqueness", fmt.Sprintf("user %q already exists", email))
+	}
+
+	return nil
+}
+
+// updateUser saves the attributes of the user, deactivating the user disables its user and personal API keys.
+func (s *SCIMService) updateUser(ctx context.Context, u *ent.User, input objects.SCIMUser) (*objects.SCIMUser, error) {
+	email := strings.TrimSpace(input.UserName)
+	if email == "" {
+		return nil, objects.NewSCIMError(http.StatusBadRequest, "invalidValue", "userName is required")
+	}
+
+	if !strings.EqualFold(email, u.Email) {
+		if err := s.checkEmailAvailable(ctx, email, u.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	deactivate := input.Active != nil && !*input.Active && u.Status == user.StatusActivated
+	if deactivate && u.IsOwner {
+		return nil, objects.NewSCIMError(http.StatusBadRequest, "mutability", "the owner user cannot be deprovisioned")
+	}
+
+	var disabledKeys []string
+
+	err := s.RunInTransaction(ctx, func(ctx context.Context) error {
+		update := s.entFromContext(ctx).User.UpdateOneID(u.ID).
+			SetEmail(email)
+
+		if firstName, lastName := scimUserNames(input); firstName != "" || lastName != "" {
+			update.SetFirstName(firstName).SetLastName(lastName)
+		}
+
+		if input.Active != nil {
+			update.SetStatus(lo.Ternary(*input.Active, user.StatusActivated, user.StatusDeactivated))
+		}
+
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+
+		if !deactivate {
+			return nil
+		}
+
+		var err error
+
+		disabledKeys, err = s.disableUserAPIKeys(ctx, u.ID)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.userService.invalidateUserCache(ctx, u.ID)
+	s.apiKeyService.invalidateAPIKeyCaches(ctx, disabledKeys...)
+
+	return s.GetUser(ctx, strconv.Itoa(u.ID))
+}
+
+// disableUserAPIKeys disables the enabled user and personal API keys created by the user, and returns their keys.
+// Service account keys belong to the project and are left untouched.
+func (s *SCIMService) disableUserAPIKeys(ctx context.Context, userID int) ([]string, error) {
+	client := s.entFromContext(ctx)
+
+	apiKeys, err := client.APIKey.Query().
+		Where(
+			apikey.UserIDEQ(userID),
+			apikey.TypeIn(apikey.TypeUser, apikey.TypePersonal),
+			apikey.StatusEQ(apikey.StatusEnabled),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user API keys: %w", err)
+	}
+
+	if len(apiKeys) == 0 {
+		return nil, nil
+	}
+
+	err = client.APIKey.Update().
+		Where(apikey.IDIn(lo.Map(apiKeys, func(k *ent.APIKey, _ int) int { return k.ID })...)).
+		SetStatus(apikey.StatusDisabled).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to disable user API keys: %w", err)
+	}
+
+	return lo.Map(apiKeys, func(k *ent.APIKey, _ int) string { return k.Key }), nil
+}
+
+// ListGroups lists the groups matching the filter, the startIndex is 1-based.
+func (s *SCIMService) ListGroups(ctx context.Context, filter string, startIndex, count int) (*objects.SCIMListResponse[objects.SCIMGroup], error) {
+	query := s.entFromContext(ctx).SCIMGroup.Query()
+
+	if filter != "" {
+		attr, value, err := parseSCIMFilter(filter)
+		if err != nil {
+			return nil, err
+		}
+
+		switch strings.ToLower(attr) {
+		case "displayname":
+			query.Where(scimgroup.DisplayNameEQ(value))
+		case "externalid":
+			query.Where(scimgroup.ExternalIDEQ(value))
+		case "id":
+			query.Where(scimgroup.IDIn(parseSCIMIDs(value)...))
+		default:
+			return nil, objects.NewSCIMError(http.StatusBadRequest, "invalidFilter", fmt.Sprintf("filtering groups by %q is not supported", attr))
+		}
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count groups: %w", err)
+	}
+
+	startIndex, count = normalizeSCIMPage(startIndex, count)
+
+	var groups []*ent.SCIMGroup
+	if count > 0 {
+		groups, err = query.
+			WithUsers().
+			Order(ent.Asc(scimgroup.FieldID)).
+			Offset(startIndex - 1).
+			Limit(count).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list groups: %w", err)
+		}
+	}
+
+	return newSCIMListResponse(total, startIndex, lo.Map(groups, func(g *ent.SCIMGroup, _ int) objects.SCIMGroup {
+		return toSCIMGroup(g)
+	})), nil
+}
+
+// GetGroup gets the group by its SCIM id.
+func (s *SCIMService) GetGroup(ctx context.Context, id string) (*objects.SCIMGroup, error) {
+	g, err := s.getGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.ToPtr(toSCIMGroup(g)), nil
+}
+
+// CreateGroup creates a group and grants its members the mapped roles and projects.
+func (s *SCIMService) CreateGroup(ctx context.Context, input objects.SCIMGroup) (*objects.SCIMGroup, error) {
+	displayName := strings.TrimSpace(input.DisplayName)
+	if displayName == "" {
+		return nil, objects.NewSCIMError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+
+	var groupID int
+
+	err := s.RunInTransaction(ctx, func(ctx context.Context) error {
+		client := s.entFromContext(ctx)
+
+		exists, err := client.SCIMGroup.Query().Where(scimgroup.DisplayNameEQ(displayName)).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check group name: %w", err)
+		}
+
+		if exists {
+			return objects.NewSCIMError(http.StatusConflict, "uniqueness", fmt.Sprintf("group %q already exists", displayName))
+		}
+
+		memberIDs, err := s.resolveMemberIDs(ctx, input.Members)
+		if err != nil {
+			return err
+		}
+
+		created, err := client.SCIMGroup.Create().
+			SetDisplayName(displayName).
+			SetExternalID(input.ExternalID).
+			AddUserIDs(memberIDs...).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create group: %w", err)
+		}
+
+		groupID = created.ID
+
+		return s.syncGroupMappings(ctx, memberIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetGroup(ctx, strconv.Itoa(groupID))
+}
+
+// ReplaceGroup replaces the name and members of the group.
+func (s *SCIMService) ReplaceGroup(ctx context.Context, id string, input objects.SCIMGroup) (*objects.SCIMGroup, error) {
+	g, err := s.getGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.DisplayName == "" {
+		input.DisplayName = g.DisplayName
+	}
+
+	return s.updateGroup(ctx, g, input.DisplayName, input.ExternalID, input.Members)
+}
+
+// PatchGroup applies the patch operations to the group.
+func (s *SCIMService) PatchGroup(ctx context.Context, id string, patch objects.SCIMPatchRequest) (*objects.SCIMGroup, error) {
+	g, err := s.getGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	input := toSCIMGroup(g)
+	for _, op := range patch.Operations {
+		if err := applySCIMGroupPatch(&input, op); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.updateGroup(ctx, g, input.DisplayName, input.ExternalID, input.Members)
+}
+
+// DeleteGroup deletes the group and revokes the mapped roles and projects from its members.
+func (s *SCIMService) DeleteGroup(ctx context.Context, id string) error {
+	g, err := s.getGroup(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := s.entFromContext(ctx).SCIMGroup.DeleteOneID(g.ID).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete group: %w", err)
+		}
+
+		return s.syncGroupMappings(ctx, groupMemberIDs(g))
+	})
+}
+
+func (s *SCIMService) getGroup(ctx context.Context, id string) (*ent.SCIMGroup, error) {
+	groupID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, scimNotFound("Group", id)
+	}
+
+	g, err := s.entFromContext(ctx).SCIMGroup.Query().
+		Where(scimgroup.IDEQ(groupID)).
+		WithUsers().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, scimNotFound("Group", id)
+		}
+
+		return nil, fmt.Errorf("failed to get group: %w", err)
+	}
+
+	return g, nil
+}
+
+func (s *SCIMService) updateGroup(ctx context.Context, g *ent.SCIMGroup, displayName, externalID string, members []objects.SCIMMember) (*objects.SCIMGroup, error) {
+	displayName = strings.TrimSpace(displayName)
+	if displayName == "" {
+		return nil, objects.NewSCIMError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+
+	err := s.RunInTransaction(ctx, func(ctx context.Context) error {
+		client := s.entFromContext(ctx)
+
+		if displayName != g.DisplayName {
+			exists, err := client.SCIMGroup.Query().
+				Where(scimgroup.DisplayNameEQ(displayName), scimgroup.IDNEQ(g.ID)).
+				Exist(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to check group name: %w", err)
+			}
+
+			if exists {
+				return objects.NewSCIMError(http.StatusConflict, "uniqueness", fmt.Sprintf("group %q already exists", displayName))
+			}
+		}
+
+		memberIDs, err := s.resolveMemberIDs(ctx, members)
+		if err != nil {
+			return err
+		}
+
+		err = client.SCIMGroup.UpdateOneID(g.ID).
+			SetDisplayName(displayName).
+			SetExternalID(externalID).
+			ClearUsers().
+			AddUserIDs(memberIDs...).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update group: %w", err)
+		}
+
+		// A renamed group may match other mappings, so former and current members are synced.
+		return s.syncGroupMappings(ctx, append(groupMemberIDs(g), memberIDs...))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetGroup(ctx, strconv.Itoa(g.ID))
+}
+
+// resolveMemberIDs returns the ids of the existing users referenced by the members.
+func (s *SCIMService) resolveMemberIDs(ctx context.Context, members []objects.SCIMMember) ([]int, error) {
+	ids := lo.FlatMap(members, func(m objects.SCIMMember, _ int) []int { return parseSCIMIDs(m.Value) })
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	existing, err := s.entFromContext(ctx).User.Query().
+		Where(user.IDIn(lo.Uniq(ids)...)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query group members: %w", err)
+	}
+
+	return existing, nil
+}
+
+func (s *SCIMService) syncGroupMappings(ctx context.Context, userIDs []int) error {
+	if len(s.cfg.GroupMappings) == 0 {
+		return nil
+	}
+
+	userIDs = lo.Uniq(userIDs)
+	sort.Ints(userIDs)
+
+	for _, userID := range userIDs {
+		if err := s.syncUserGroupMappings(ctx, userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncUserGroupMappings grants the roles and projects mapped from the groups of the user,
+// and revokes the mapped ones the user is no longer entitled to.
+// Roles and projects which are not referenced by any mapping are left untouched,
+// and the project owner is never removed from its project.
+func (s *SCIMService) syncUserGroupMappings(ctx context.Context, userID int) error {
+	client := s.entFromContext(ctx)
+
+	groups, err := client.SCIMGroup.Query().
+		Where(scimgroup.HasUsersWith(user.IDEQ(userID))).
+		Select(scimgroup.FieldDisplayName).
+		Strings(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query user groups: %w", err)
+	}
+
+	var (
+		managedRoles    []string
+		grantedRoles    []string
+		managedProjects = make(map[int][]string)
+		grantedProjects = make(map[int]*SCIMProjectMapping)
+	)
+
+	for _, mapping := range s.cfg.GroupMappings {
+		granted := lo.ContainsBy(groups, func(group string) bool { return strings.EqualFold(group, mapping.Group) })
+
+		managedRoles = append(managedRoles, mapping.Roles...)
+		if granted {
+			grantedRoles = append(grantedRoles, mapping.Roles...)
+		}
+
+		for _, project := range mapping.Projects {
+			managedProjects[project.ProjectID] = append(managedProjects[project.ProjectID], project.Roles...)
+			if !granted {
+				continue
+			}
+
+			grantedProject, ok := grantedProjects[project.ProjectID]
+			if !ok {
+				grantedProject = &SCIMProjectMapping{ProjectID: project.ProjectID, Scopes: []string{}}
+				grantedProjects[project.ProjectID] = grantedProject
+			}
+
+			grantedProject.Roles = append(grantedProject.Roles, project.Roles...)
+			grantedProject.Scopes = lo.Uniq(append(grantedProject.Scopes, project.Scopes...))
+		}
+	}
+
+	currentRoleIDs, err := client.Role.Query().
+		Where(role.HasUsersWith(user.IDEQ(userID))).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query user roles: %w", err)
+	}
+
+	systemRoles, err := client.Role.Query().
+		Where(role.LevelEQ(role.LevelSystem), role.NameIn(managedRoles...)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query mapped roles: %w", err)
+	}
+
+	addRoleIDs, removeRoleIDs := diffMappedRoles(systemRoles, grantedRoles, currentRoleIDs)
+
+	projectIDs := lo.Keys(managedProjects)
+	sort.Ints(projectIDs)
+
+	for _, projectID := range projectIDs {
+		membership, err := client.UserProject.Query().
+			Where(userproject.UserIDEQ(userID), userproject.ProjectIDEQ(projectID)).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("failed to query project membership: %w", err)
+		}
+
+		grantedProject, granted := grantedProjects[projectID]
+
+		switch {
+		case granted && membership == nil:
+			err = client.UserProject.Create().
+				SetUserID(userID).
+				SetProjectID(projectID).
+				SetScopes(grantedProject.Scopes).
+				Exec(ctx)
+		case granted:
+			err = membership.Update().SetScopes(grantedProject.Scopes).Exec(ctx)
+		case membership != nil && !membership.IsOwner:
+			err = client.UserProject.DeleteOne(membership).Exec(ctx)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to sync project %d membership: %w", projectID, err)
+		}
+
+		projectRoles, err := client.Role.Query().
+			Where(role.ProjectIDEQ(projectID), role.NameIn(managedProjects[projectID]...)).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query mapped project roles: %w", err)
+		}
+
+		var grantedProjectRoles []string
+		if granted {
+			grantedProjectRoles = grantedProject.Roles
+		}
+
+		add, remove := diffMappedRoles(projectRoles, grantedProjectRoles, currentRoleIDs)
+		addRoleIDs = append(addRoleIDs, add...)
+		removeRoleIDs = append(removeRoleIDs, remove...)
+	}
+
+	if len(addRoleIDs) > 0 || len(removeRoleIDs) > 0 {
+		err = client.User.UpdateOneID(userID).
+			AddRoleIDs(addRoleIDs...).
+			RemoveRoleIDs(removeRoleIDs...).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to sync user roles: %w", err)
+		}
+	}
+
+	s.userService.invalidateUserCache(ctx, userID)
+
+	return nil
+}
+
+// diffMappedRoles returns the roles to add and remove so the user has exactly the granted ones of the mapped roles.
+func diffMappedRoles(mappedRoles []*ent.Role, granted []string, current []int) (add, remove []int) {
+	for _, r := range mappedRoles {
+		has := lo.Contains(current, r.ID)
+
+		switch want := lo.Contains(granted, r.Name); {
+		case want && !has:
+			add = append(add, r.ID)
+		case !want && has:
+			remove = append(remove, r.ID)
+		}
+	}
+
+	return add, remove
+}
+
+func toSCIMUser(u *ent.User) objects.SCIMUser {
+	formatted := strings.TrimSpace(u.FirstName + " " + u.LastName)
+
+	return objects.SCIMUser{
+		Schemas:  []string{objects.SCIMSchemaUser},
+		ID:       strconv.Itoa(u.ID),
+		UserName: u.Email,
+		Name: &objects.SCIMName{
+			Formatted:  formatted,
+			GivenName:  u.FirstName,
+			FamilyName: u.LastName,
+		},
+		DisplayName: formatted,
+		Emails: []objects.SCIMMultiValue{
+			{Value: u.Email, Type: "work", Primary: true},
+		},
+		Active: lo.ToPtr(u.Status == user.StatusActivated),
+		Groups: lo.Map(u.Edges.ScimGroups, func(g *ent.SCIMGroup, _ int) objects.SCIMMember {
+			return objects.SCIMMember{Value: strconv.Itoa(g.ID), Display: g.DisplayName}
+		}),
+		Meta: &objects.SCIMMeta{
+			ResourceType: "User",
+			Created:      u.CreatedAt,
+			LastModified: u.UpdatedAt,
+		},
+	}
+}
+
+func toSCIMGroup(g *ent.SCIMGroup) objects.SCIMGroup {
+	return objects.SCIMGroup{
+		Schemas:     []string{objects.SCIMSchemaGroup},
+		ID:          strconv.Itoa(g.ID),
+		ExternalID:  g.ExternalID,
+		DisplayName: g.DisplayName,
+		Members: lo.Map(g.Edges.Users, func(u *ent.User, _ int) objects.SCIMMember {
+			return objects.SCIMMember{Value: strconv.Itoa(u.ID), Display: u.Email}
+		}),
+		Meta: &objects.SCIMMeta{
+			ResourceType: "Group",
+			Created:      g.CreatedAt,
+			LastModified: g.UpdatedAt,
+		},
+	}
+}
+
+func groupMemberIDs(g *ent.SCIMGroup) []int {
+	return lo.Map(g.Edges.Users, func(u *ent.User, _ int) int { return u.ID })
+}
+
+func newSCIMListResponse[T any](total, startIndex int, resources []T) *objects.SCIMListResponse[T] {
+	return &objects.SCIMListResponse[T]{
+		Schemas:      []string{objects.SCIMSchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    lo.Ternary(resources == nil, []T{}, resources),
+	}
+}
+
+func normalizeSCIMPage(startIndex, count int) (int, int) {
+	return max(startIndex, 1), min(max(count, 0), SCIMMaxCount)
+}
+
+func scimNotFound(resourceType, id string) error {
+	return objects.NewSCIMError(http.StatusNotFound, "", fmt.Sprintf("%s %s not found", resourceType, id))
+}
+
+// scimUserNames returns the first and last name of the user, falling back to split the display name.
+func scimUserNames(input objects.SCIMUser) (string, string) {
+	if input.Name != nil && (input.Name.GivenName != "" || input.Name.FamilyName != "") {
+		return input.Name.GivenName, input.Name.FamilyName
+	}
+
+	name := input.DisplayName
+	if name == "" && input.Name != nil {
+		name = input.Name.Formatted
+	}
+
+	firstName, lastName, _ := strings.Cut(strings.TrimSpace(name), " ")
+
+	return firstName, strings.TrimSpace(lastName)
+}
+
+func parseSCIMIDs(values ...string) []int {
+	return lo.FilterMap(values, func(value string, _ int) (int, bool) {
+		id, err := strconv.Atoi(value)
+		return id, err == nil
+	})
+}
+
+var (
+	scimFilterPattern       = regexp.MustCompile(`(?i)^\s*([a-z][\w.:$]*)\s+eq\s+"((?:[^"\\]|\\.)*)"\s*$`)
+	scimMemberFilterPattern = regexp.MustCompile(`(?i)^\s*members\s*\[(.+)\]\s*$`)
+)
+
+// parseSCIMFilter parses a filter of the form `attribute eq "value"`, which is the filter used by identity providers.
+func parseSCIMFilter(filter string) (string, string, error) {
+	matches := scimFilterPattern.FindStringSubmatch(filter)
+	if matches == nil {
+		return "", "", objects.NewSCIMError(http.StatusBadRequest, "invalidFilter", `only filters of the form 'attribute eq "value"' are supported`)
+	}
+
+	value, err := strconv.Unquote(`"` + matches[2] + `"`)
+	if err != nil {
+		return "", "", objects.NewSCIMError(http.StatusBadRequest, "invalidFilter", "invalid filter value")
+	}
+
+	// The attribute may be prefixed with the schema URN.
+	attr := matches[1]
+	if i := strings.LastIndex(attr, ":"); i >= 0 {
+		attr = attr[i+1:]
+	}
+
+	return attr, value, nil
+}
+
+func applySCIMUserPatch(input *objects.SCIMUser, op objects.SCIMPatchOperation) error {
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+	case "remove":
+		// Removing user attributes is not supported, the identity provider deprovisions with active=false.
+		return nil
+	default:
+		return objects.NewSCIMError(http.StatusBadRequest, "invalidSyntax", fmt.Sprintf("unsupported patch operation %q", op.Op))
+	}
+
+	if op.Path != "" {
+		return setSCIMUserAttribute(input, op.Path, op.Value)
+	}
+
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(op.Value, &values); err != nil {
+		return objects.NewSCIMError(http.StatusBadRequest, "invalidValue", "patch value must be an object when path is omitted")
+	}
+
+	for path, value := range values {
+		if err := setSCIMUserAttribute(input, path, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setSCIMUserAttribute sets the user attribute, unknown attributes are ignored.
+func setSCIMUserAttribute(input *objects.SCIMUser, path string, value json.RawMessage) error {
+	if input.Name == nil {
+		input.Name = &objects.SCIMName{}
+	}
+
+	var target any
+
+	switch strings.ToLower(path) {
+	case "active":
+		active, err := parseSCIMBool(value)
+		if err != nil {
+			return err
+		}
+
+		input.Active = &active
+
+		return nil
+	case "username":
+		target = &input.UserName
+	case "displayname":
+		target = &input.DisplayName
+	case "name":
+		target = input.Name
+	case "name.givenname":
+		target = &input.Name.GivenName
+	case "name.familyname":
+		target = &input.Name.FamilyName
+	case "name.formatted":
+		target = &input.Name.Formatted
+	default:
+		return nil
+	}
+
+	if err := json.Unmarshal(value, target); err != nil {
+		return objects.NewSCIMError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("invalid value for %q", path))
+	}
+
+	return nil
+}
+
+// parseSCIMBool parses a boolean, some identity providers send booleans as "True" or "False" strings.
+func parseSCIMBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b, nil
+		}
+	}
+
+	return false, objects.NewSCIMError(http.StatusBadRequest, "invalidValue", "active must be a boolean")
+}
+
+func applySCIMGroupPatch(input *objects.SCIMGroup, op objects.SCIMPatchOperation) error {
+	path := strings.ToLower(strings.TrimSpace(op.Path))
+
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+		replace := strings.EqualFold(op.Op, "replace")
+
+		if path == "" {
+			var value struct {
+				DisplayName *string               `json:"displayName"`
+				ExternalID  *string               `json:"externalId"`
+				Members     *[]objects.SCIMMember `json:"members"`
+			}
+			if err := json.Unmarshal(op.Value, &value); err != nil {
+				return objects.NewSCIMError(http.StatusBadRequest, "invalidValue", "patch value must be an object when path is omitted")
+			}
+
+			if value.DisplayName != nil {
+				input.DisplayName = *value.DisplayName
+			}
+
+			if value.ExternalID != nil {
+				input.ExternalID = *value.ExternalID
+			}
+
+			if value.Members != nil {
+				input.Members = mergeSCIMMembers(input.Members, *value.Members, replace)
+			}
+
+			return nil
+		}
+
+		switch path {
+		case "displayname":
+			return unmarshalSCIMValue(op.Value, &input.DisplayName)
+		case "externalid":
+			return unmarshalSCIMValue(op.Value, &input.ExternalID)
+		case "members":
+			var members []objects.SCIMMember
+			if err := unmarshalSCIMValue(op.Value, &members); err != nil {
+				return err
+			}
+
+			input.Members = mergeSCIMMembers(input.Members, members, replace)
+		}
+
+		return nil
+	case "remove":
+		if path == "members" {
+			if len(op.Value) == 0 {
+				input.Members = nil
+				return nil
+			}
+
+			var members []objects.SCIMMember
+			if err := unmarshalSCIMValue(op.Value, &members); err != nil {
+				return err
+			}
+
+			input.Members = removeSCIMMembers(input.Members, lo.Map(members, func(m objects.SCIMMember, _ int) string { return m.Value }))
+
+			return nil
+		}
+
+		if matches := scimMemberFilterPattern.FindStringSubmatch(op.Path); matches != nil {
+			attr, value, err := parseSCIMFilter(matches[1])
+			if err != nil {
+				return err
+			}
+
+			if !strings.EqualFold(attr, "value") {
+				return objects.NewSCIMError(http.StatusBadRequest, "invalidPath", fmt.Sprintf("unsupported member filter on %q", attr))
+			}
+
+			input.Members = removeSCIMMembers(input.Members, []string{value})
+		}
+
+		return nil
+	default:
+		return objects.NewSCIMError(http.StatusBadRequest, "invalidSyntax", fmt.Sprintf("unsupported patch operation %q", op.Op))
+	}
+}
+
+func unmarshalSCIMValue(value json.RawMessage, target any) error {
+	if err := json.Unmarshal(value, target); err != nil {
+		return objects.NewSCIMError(http.StatusBadRequest, "invalidValue", "invalid patch value")
+	}
+
+	return nil
+}
+
+func mergeSCIMMembers(current, members []objects.SCIMMember, replace bool) []objects.SCIMMember {
+	if replace {
+		return members
+	}
+
+	return lo.UniqBy(append(current, members...), func(m objects.SCIMMember) string { return m.Value })
+}
+
+func removeSCIMMembers(current []objects.SCIMMember, values []string) []objects.SCIMMember {
+	return lo.Filter(current, func(m objects.SCIMMember, _ int) bool { return !lo.Contains(values, m.Value) })
+}
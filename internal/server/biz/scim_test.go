@@ -0,0 +1,210 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/role"
+	"github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/ent/userproject"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+)
+
+func setupTestSCIMService(t *testing.T, cfg SCIMConfig) (*SCIMService, *ent.Client, context.Context) {
+	t.Helper()
+
+	cacheConfig := xcache.Config{Mode: xcache.ModeMemory}
+	apiKeyService, client := setupTestAPIKeyService(t, cacheConfig)
+	t.Cleanup(func() {
+		apiKeyService.Stop()
+		client.Close()
+	})
+
+	svc, err := NewSCIMService(SCIMServiceParams{
+		Config:        cfg,
+		Ent:           client,
+		UserService:   NewUserService(UserServiceParams{CacheConfig: cacheConfig, Ent: client}),
+		APIKeyService: apiKeyService,
+	})
+	require.NoError(t, err)
+
+	ctx := ent.NewContext(context.Background(), client)
+	ctx = authz.WithTestBypass(ctx)
+
+	return svc, client, ctx
+}
+
+func TestSCIMService_Authenticate(t *testing.T) {
+	svc, _, _ := setupTestSCIMService(t, SCIMConfig{Enabled: true, Token: "scim-token"})
+	require.True(t, svc.Authenticate("scim-token"))
+	require.False(t, svc.Authenticate("other-token"))
+	require.False(t, svc.Authenticate(""))
+
+	disabled, _, _ := setupTestSCIMService(t, SCIMConfig{Token: "scim-token"})
+	require.False(t, disabled.Authenticate("scim-token"))
+}
+
+func TestSCIMService_UserLifecycle(t *testing.T) {
+	svc, client, ctx := setupTestSCIMService(t, SCIMConfig{Enabled: true, Token: "scim-token"})
+
+	created, err := svc.CreateUser(ctx, objects.SCIMUser{
+		UserName: "alice@example.com",
+		Name:     &objects.SCIMName{GivenName: "Alice", FamilyName: "Smith"},
+	})
+	require.NoError(t, err)
+	require.True(t, *created.Active)
+
+	_, err = svc.CreateUser(ctx, objects.SCIMUser{UserName: "ALICE@example.com"})
+	var scimErr *objects.SCIMError
+	require.ErrorAs(t, err, &scimErr)
+	require.Equal(t, http.StatusConflict, scimErr.HTTPStatus())
+
+	list, err := svc.ListUsers(ctx, `userName eq "alice@example.com"`, 1, SCIMDefaultCount)
+	require.NoError(t, err)
+	require.Equal(t, 1, list.TotalResults)
+	require.Equal(t, created.ID, list.Resources[0].ID)
+
+	userID, err := strconv.Atoi(created.ID)
+	require.NoError(t, err)
+
+	project := client.Project.Create().SetName("Test Project").SaveX(ctx)
+	personalKey := client.APIKey.Create().SetKey("ah-personal").SetName("personal").SetType(apikey.TypePersonal).
+		SetUserID(userID).SetProject(project).SaveX(ctx)
+	userKey := client.APIKey.Create().SetKey("ah-user").SetName("user").SetType(apikey.TypeUser).
+		SetUserID(userID).SetProject(project).SaveX(ctx)
+	serviceKey := client.APIKey.Create().SetKey("ah-service").SetName("service").SetType(apikey.TypeServiceAccount).
+		SetUserID(userID).SetProject(project).SaveX(ctx)
+
+	// Azure AD sends booleans as strings.
+	patched, err := svc.PatchUser(ctx, created.ID, objects.SCIMPatchRequest{
+		Operations: []objects.SCIMPatchOperation{
+			{Op: "Replace", Path: "active", Value: json.RawMessage(`"False"`)},
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, *patched.Active)
+	require.Equal(t, "Alice", patched.Name.GivenName)
+
+	require.Equal(t, user.StatusDeactivated, client.User.GetX(ctx, userID).Status)
+	require.Equal(t, apikey.StatusDisabled, client.APIKey.GetX(ctx, personalKey.ID).Status)
+	require.Equal(t, apikey.StatusDisabled, client.APIKey.GetX(ctx, userKey.ID).Status)
+	require.Equal(t, apikey.StatusEnabled, client.APIKey.GetX(ctx, serviceKey.ID).Status)
+
+	require.NoError(t, svc.DeleteUser(ctx, created.ID))
+
+	_, err = svc.GetUser(ctx, created.ID)
+	require.ErrorAs(t, err, &scimErr)
+	require.Equal(t, http.StatusNotFound, scimErr.HTTPStatus())
+}
+
+func TestSCIMService_GroupMappings(t *testing.T) {
+	svc, client, ctx := setupTestSCIMService(t, SCIMConfig{
+		Enabled: true,
+		Token:   "scim-token",
+		GroupMappings: []SCIMGroupMapping{
+			{
+				Group: "engineering",
+				Roles: []string{"Developer"},
+				Projects: []SCIMProjectMapping{
+					{ProjectID: 1, Roles: []string{"Project Developer"}, Scopes: []string{"read_channels"}},
+				},
+			},
+		},
+	})
+
+	project := client.Project.Create().SetName("Engineering").SaveX(ctx)
+	require.Equal(t, 1, project.ID)
+
+	developer := client.Role.Create().SetName("Developer").SetLevel(role.LevelSystem).SaveX(ctx)
+	manual := client.Role.Create().SetName("Manual").SetLevel(role.LevelSystem).SaveX(ctx)
+	projectDeveloper := client.Role.Create().SetName("Project Developer").SetLevel(role.LevelProject).
+		SetProjectID(project.ID).SaveX(ctx)
+
+	created, err := svc.CreateUser(ctx, objects.SCIMUser{UserName: "bob@example.com"})
+	require.NoError(t, err)
+
+	userID, err := strconv.Atoi(created.ID)
+	require.NoError(t, err)
+	client.User.UpdateOneID(userID).AddRoleIDs(manual.ID).ExecX(ctx)
+
+	group, err := svc.CreateGroup(ctx, objects.SCIMGroup{
+		DisplayName: "Engineering",
+		Members:     []objects.SCIMMember{{Value: created.ID}},
+	})
+	require.NoError(t, err)
+	require.Len(t, group.Members, 1)
+
+	roleIDs := client.Role.Query().Where(role.HasUsersWith(user.IDEQ(userID))).IDsX(ctx)
+	require.ElementsMatch(t, []int{developer.ID, manual.ID, projectDeveloper.ID}, roleIDs)
+
+	membership := client.UserProject.Query().
+		Where(userproject.UserIDEQ(userID), userproject.ProjectIDEQ(project.ID)).
+		OnlyX(ctx)
+	require.Equal(t, []string{"read_channels"}, membership.Scopes)
+
+	scimUser, err := svc.GetUser(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, []objects.SCIMMember{{Value: group.ID, Display: "Engineering"}}, scimUser.Groups)
+
+	// Okta removes members with a filtered path.
+	_, err = svc.PatchGroup(ctx, group.ID, objects.SCIMPatchRequest{
+		Operations: []objects.SCIMPatchOperation{
+			{Op: "remove", Path: `members[value eq "` + created.ID + `"]`},
+		},
+	})
+	require.NoError(t, err)
+
+	roleIDs = client.Role.Query().Where(role.HasUsersWith(user.IDEQ(userID))).IDsX(ctx)
+	require.Equal(t, []int{manual.ID}, roleIDs)
+	require.False(t, client.UserProject.Query().
+		Where(userproject.UserIDEQ(userID), userproject.ProjectIDEQ(project.ID)).
+		ExistX(ctx))
+}
+
+func TestParseSCIMFilter(t *testing.T) {
+	attr, value, err := parseSCIMFilter(`userName eq "a\"b@example.com"`)
+	require.NoError(t, err)
+	require.Equal(t, "userName", attr)
+	require.Equal(t, `a"b@example.com`, value)
+
+	attr, _, err = parseSCIMFilter(`urn:ietf:params:scim:schemas:core:2.0:Group:displayName EQ "x"`)
+	require.NoError(t, err)
+	require.Equal(t, "displayName", attr)
+
+	_, _, err = parseSCIMFilter(`userName sw "a"`)
+	require.Error(t, err)
+}
+
+func TestApplySCIMGroupPatch(t *testing.T) {
+	group := objects.SCIMGroup{
+		DisplayName: "eng",
+		Members:     []objects.SCIMMember{{Value: "1"}, {Value: "2"}},
+	}
+
+	require.NoError(t, applySCIMGroupPatch(&group, objects.SCIMPatchOperation{
+		Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"2"},{"value":"3"}]`),
+	}))
+	require.Equal(t, []objects.SCIMMember{{Value: "1"}, {Value: "2"}, {Value: "3"}}, group.Members)
+
+	require.NoError(t, applySCIMGroupPatch(&group, objects.SCIMPatchOperation{
+		Op: "remove", Path: "members", Value: json.RawMessage(`[{"value":"1"}]`),
+	}))
+	require.Equal(t, []objects.SCIMMember{{Value: "2"}, {Value: "3"}}, group.Members)
+
+	require.NoError(t, applySCIMGroupPatch(&group, objects.SCIMPatchOperation{
+		Op: "replace", Value: json.RawMessage(`{"displayName":"platform"}`),
+	}))
+	require.Equal(t, "platform", group.DisplayName)
+	require.Len(t, group.Members, 2)
+
+	require.Error(t, applySCIMGroupPatch(&group, objects.SCIMPatchOperation{Op: "move"}))
+}
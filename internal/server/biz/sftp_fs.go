@@ -0,0 +1,351 @@
+package biz
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/sftp"
+	"github.com/spf13/afero"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+const sftpDialTimeout = 30 * time.Second
+
+// sftpFs is an afero.Fs over an SFTP server. The paths are relative to the
+// configured root directory, or to the login directory.
+//
+// The connection is dialed on the first operation and shared by the later ones,
+// it is dialed again after the server closed it. File modes are not applied: the
+// umask of the server decides, unlike afero's sftpfs which chmods every file.
+type sftpFs struct {
+	cfg             *objects.SFTP
+	auth            []ssh.AuthMethod
+	hostKeyCallback ssh.HostKeyCallback
+
+	mu     sync.Mutex
+	client *sftp.Client
+	root   string
+}
+
+var _ afero.Fs = (*sftpFs)(nil)
+
+// newSFTPFs validates the config and returns the filesystem without connecting to the server.
+func newSFTPFs(cfg *objects.SFTP) (*sftpFs, error) {
+	if cfg.Host == "" {
+		return nil, fmt.Errorf("sftp host is required")
+	}
+
+	if cfg.Username == "" {
+		return nil, fmt.Errorf("sftp username is required")
+	}
+
+	var auth []ssh.AuthMethod
+
+	if cfg.PrivateKey != "" {
+		var (
+			signer ssh.Signer
+			err    error
+		)
+
+		if cfg.PrivateKeyPassphrase != "" {
+			signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(cfg.PrivateKey), []byte(cfg.PrivateKeyPassphrase))
+		} else {
+			signer, err = ssh.ParsePrivateKey([]byte(cfg.PrivateKey))
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid sftp private key: %w", err)
+		}
+
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+
+	if cfg.Password != "" {
+		auth = append(auth, ssh.Password(cfg.Password))
+	}
+
+	if len(auth) == 0 {
+		return nil, fmt.Errorf("sftp password or private key is required")
+	}
+
+	var hostKeyCallback ssh.HostKeyCallback
+
+	switch {
+	case cfg.HostKey != "":
+		hostKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(cfg.HostKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid sftp host key: %w", err)
+		}
+
+		hostKeyCallback = ssh.FixedHostKey(hostKey)
+	case cfg.InsecureSkipHostKey:
+		//nolint:gosec // InsecureSkipHostKey is configurable by the user.
+		hostKeyCallback = ssh.InsecureIgnoreHostKey()
+	default:
+		return nil, fmt.Errorf("sftp host key is required unless insecure skip host key is enabled")
+	}
+
+	return &sftpFs{cfg: cfg, auth: auth, hostKeyCallback: hostKeyCallback}, nil
+}
+
+// conn returns the connected client and the root directory, dialing the server if needed.
+func (f *sftpFs) conn() (*sftp.Client, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.client != nil {
+		return f.client, f.root, nil
+	}
+
+	port := f.cfg.Port
+	if port == 0 {
+		port = 22
+	}
+
+	sshClient, err := ssh.Dial("tcp", net.JoinHostPort(f.cfg.Host, strconv.Itoa(port)), &ssh.ClientConfig{
+		User:            f.cfg.Username,
+		Auth:            f.auth,
+		HostKeyCallback: f.hostKeyCallback,
+		Timeout:         sftpDialTimeout,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to connect to sftp server: %w", err)
+	}
+
+	client, err := sftp.NewClient(sshClient)
+	if err != nil {
+		_ = sshClient.Close()
+		return nil, "", fmt.Errorf("failed to start sftp session: %w", err)
+	}
+
+	root := f.cfg.Path
+	if root == "" {
+		root, err = client.Getwd()
+		if err != nil {
+			_ = client.Close()
+			return nil, "", fmt.Errorf("failed to get sftp login directory: %w", err)
+		}
+	}
+
+	f.client = client
+	f.root = path.Clean(root)
+
+	go func() {
+		_ = client.Wait()
+		_ = sshClient.Close()
+
+		f.mu.Lock()
+		if f.client == client {
+			f.client = nil
+		}
+		f.mu.Unlock()
+	}()
+
+	return f.client, f.root, nil
+}
+
+// resolve returns the client and the path of the name under the root directory.
+func (f *sftpFs) resolve(name string) (*sftp.Client, string, error) {
+	client, root, err := f.conn()
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Cleaning the name as an absolute path drops the ".." escaping the root.
+	return client, path.Join(root, path.Clean("/"+strings.ReplaceAll(name, "\\", "/"))), nil
+}
+
+// Close closes the connection, the next operation dials the server again.
+func (f *sftpFs) Close() error {
+	f.mu.Lock()
+	client := f.client
+	f.client = nil
+	f.mu.Unlock()
+
+	if client == nil {
+		return nil
+	}
+
+	return client.Close()
+}
+
+func (f *sftpFs) Name() string { return "sftp" }
+
+func (f *sftpFs) Create(name string) (afero.File, error) {
+	return f.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+}
+
+func (f *sftpFs) Mkdir(name string, _ os.FileMode) error {
+	client, p, err := f.resolve(name)
+	if err != nil {
+		return err
+	}
+
+	return client.Mkdir(p)
+}
+
+func (f *sftpFs) MkdirAll(name string, _ os.FileMode) error {
+	client, p, err := f.resolve(name)
+	if err != nil {
+		return err
+	}
+
+	return client.MkdirAll(p)
+}
+
+func (f *sftpFs) Open(name string) (afero.File, error) {
+	return f.OpenFile(name, os.O_RDONLY, 0)
+}
+
+func (f *sftpFs) OpenFile(name string, flag int, _ os.FileMode) (afero.File, error) {
+	client, p, err := f.resolve(name)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := client.OpenFile(p, flag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sftpFile{File: file, client: client, name: name}, nil
+}
+
+func (f *sftpFs) Remove(name string) error {
+	client, p, err := f.resolve(name)
+	if err != nil {
+		return err
+	}
+
+	return client.Remove(p)
+}
+
+func (f *sftpFs) RemoveAll(name string) error {
+	client, p, err := f.resolve(name)
+	if err != nil {
+		return err
+	}
+
+	err = client.RemoveAll(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func (f *sftpFs) Rename(oldname, newname string) error {
+	client, oldPath, err := f.resolve(oldname)
+	if err != nil {
+		return err
+	}
+
+	_, newPath, err := f.resolve(newname)
+	if err != nil {
+		return err
+	}
+
+	return client.PosixRename(oldPath, newPath)
+}
+
+func (f *sftpFs) Stat(name string) (os.FileInfo, error) {
+	client, p, err := f.resolve(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Stat(p)
+}
+
+func (f *sftpFs) Chmod(name string, mode os.FileMode) error {
+	client, p, err := f.resolve(name)
+	if err != nil {
+		return err
+	}
+
+	return client.Chmod(p, mode)
+}
+
+func (f *sftpFs) Chown(name string, uid, gid int) error {
+	client, p, err := f.resolve(name)
+	if err != nil {
+		return err
+	}
+
+	return client.Chown(p, uid, gid)
+}
+
+func (f *sftpFs) Chtimes(name string, atime, mtime time.Time) error {
+	client, p, err := f.resolve(name)
+	if err != nil {
+		return err
+	}
+
+	return client.Chtimes(p, atime, mtime)
+}
+
+// sftpFile adds the directory listing of afero.File to sftp.File.
+type sftpFile struct {
+	*sftp.File
+
+	client  *sftp.Client
+	name    string
+	entries []os.FileInfo
+	listed  bool
+}
+
+func (f *sftpFile) Name() string { return f.name }
+
+func (f *sftpFile) Readdir(count int) ([]os.FileInfo, error) {
+	if !f.listed {
+		entries, err := f.client.ReadDir(f.File.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		f.entries = entries
+		f.listed = true
+	}
+
+	if count <= 0 {
+		entries := f.entries
+		f.entries = nil
+
+		return entries, nil
+	}
+
+	if len(f.entries) == 0 {
+		return nil, io.EOF
+	}
+
+	count = min(count, len(f.entries))
+	entries := f.entries[:count]
+	f.entries = f.entries[count:]
+
+	return entries, nil
+}
+
+func (f *sftpFile) Readdirnames(n int) ([]string, error) {
+	entries, err := f.Readdir(n)
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+
+	return names, err
+}
+
+func (f *sftpFile) WriteString(s string) (int, error) {
+	return f.Write([]byte(s))
+}
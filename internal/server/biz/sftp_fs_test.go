@@ -0,0 +1,321 @@
+package biz
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/sftp"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// startSFTPServer serves SFTP on a local port for the user "test" with the password "test".
+// It returns the address and the host key of the server in the authorized_keys format.
+func startSFTPServer(t *testing.T) (string, string) {
+	t.Helper()
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	require.NoError(t, err)
+
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if c.User() == "test" && string(password) == "test" {
+				return nil, nil
+			}
+
+			return nil, ssh.ErrNoAuth
+		},
+	}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go serveSFTPConn(conn, config)
+		}
+	}()
+
+	return listener.Addr().String(), strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey())))
+}
+
+func serveSFTPConn(conn net.Conn, config *ssh.ServerConfig) {
+	serverConn, channels, requests, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		_ = conn.Close()
+		return
+	}
+	defer serverConn.Close()
+
+	go ssh.DiscardRequests(requests)
+
+	for newChannel := range channels {
+		if newChannel.ChannelType() != "session" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			for req := range requests {
+				_ = req.Reply(req.Type == "subsystem" && string(req.Payload[4:]) == "sftp", nil)
+			}
+		}()
+
+		go func() {
+			defer channel.Close()
+
+			server, err := sftp.NewServer(channel)
+			if err != nil {
+				return
+			}
+
+			_ = server.Serve()
+		}()
+	}
+}
+
+func sftpTestSettings(t *testing.T) *objects.SFTP {
+	t.Helper()
+
+	addr, hostKey := startSFTPServer(t)
+
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+
+	portNumber, err := net.LookupPort("tcp", port)
+	require.NoError(t, err)
+
+	return &objects.SFTP{
+		Host:     host,
+		Port:     portNumber,
+		Username: "test",
+		Password: "test",
+		HostKey:  hostKey,
+		Path:     t.TempDir(),
+	}
+}
+
+func TestDataStorageService_SFTP(t *testing.T) {
+	client, service, ctx := setupDataStorageTest(t)
+	defer client.Close()
+
+	cfg := sftpTestSettings(t)
+
+	ds, err := client.DataStorage.Create().
+		SetName("sftp-storage").
+		SetDescription("SFTP storage").
+		SetType(datastorage.TypeSftp).
+		SetSettings(&objects.DataStorageSettings{SFTP: cfg}).
+		SetStatus(datastorage.StatusActive).
+		Save(ctx)
+	require.NoError(t, err)
+
+	key := "/1/requests/2/request_body.json"
+	data := []byte(`{"model":"gpt-4o"}`)
+
+	require.NoError(t, service.SaveData(ctx, ds, key, data))
+
+	// The keys are stored under the root directory.
+	stored, err := os.ReadFile(filepath.Join(cfg.Path, "1", "requests", "2", "request_body.json"))
+	require.NoError(t, err)
+	require.Equal(t, data, stored)
+
+	loaded, err := service.LoadData(ctx, ds, key)
+	require.NoError(t, err)
+	require.Equal(t, data, loaded)
+
+	streamKey, n, err := service.SaveDataFromReader(ctx, ds, "/1/videos/2/video.mp4", strings.NewReader("video"))
+	require.NoError(t, err)
+	require.EqualValues(t, 5, n)
+
+	body, size, err := service.OpenData(ctx, ds, streamKey)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, size)
+
+	video, err := io.ReadAll(body)
+	require.NoError(t, err)
+	require.NoError(t, body.Close())
+	require.Equal(t, "video", string(video))
+
+	// The backups are listed at the root of the filesystem.
+	fs, err := service.GetFileSystem(ctx, ds)
+	require.NoError(t, err)
+	require.NoError(t, afero.WriteFile(fs, "axonhub-backup-20260101-000000.tar.zst", []byte("backup"), 0o644))
+
+	entries, err := afero.ReadDir(fs, "/")
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+
+	require.ElementsMatch(t, []string{"1", "axonhub-backup-20260101-000000.tar.zst"}, names)
+
+	// The connection is dialed again after it is closed.
+	require.NoError(t, fs.(*sftpFs).Close())
+
+	require.NoError(t, service.DeleteData(ctx, ds, key))
+	require.NoError(t, service.DeleteData(ctx, ds, key))
+
+	_, err = service.LoadData(ctx, ds, key)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// Paths can not escape the root directory.
+	require.NoError(t, afero.WriteFile(fs, "../../escape.txt", []byte("x"), 0o644))
+	_, err = os.Stat(filepath.Join(cfg.Path, "escape.txt"))
+	require.NoError(t, err)
+}
+
+func TestNewSFTPFs(t *testing.T) {
+	testCases := []struct {
+		name string
+		cfg  *objects.SFTP
+		err  string
+	}{
+		{
+			name: "host required",
+			cfg:  &objects.SFTP{Username: "test", Password: "test"},
+			err:  "sftp host is required",
+		},
+		{
+			name: "credentials required",
+			cfg:  &objects.SFTP{Host: "localhost", Username: "test", InsecureSkipHostKey: true},
+			err:  "sftp password or private key is required",
+		},
+		{
+			name: "host key required",
+			cfg:  &objects.SFTP{Host: "localhost", Username: "test", Password: "test"},
+			err:  "sftp host key is required",
+		},
+		{
+			name: "invalid private key",
+			cfg:  &objects.SFTP{Host: "localhost", Username: "test", PrivateKey: "invalid", InsecureSkipHostKey: true},
+			err:  "invalid sftp private key",
+		},
+		{
+			name: "insecure skip host key",
+			cfg:  &objects.SFTP{Host: "localhost", Username: "test", Password: "test", InsecureSkipHostKey: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newSFTPFs(tc.cfg)
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestDataStorageService_TestConnection(t *testing.T) {
+	client, service, ctx := setupDataStorageTest(t)
+	defer client.Close()
+
+	cfg := sftpTestSettings(t)
+
+	t.Run("new storage", func(t *testing.T) {
+		result, err := service.TestConnection(ctx, TestDataStorageConnectionInput{
+			Type:     new(datastorage.TypeSftp),
+			Settings: &objects.DataStorageSettings{SFTP: cfg},
+		})
+		require.NoError(t, err)
+		require.True(t, result.Success, "%v", result.Message)
+
+		// The test object is deleted.
+		entries, err := os.ReadDir(cfg.Path)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("saved storage with the saved password", func(t *testing.T) {
+		ds, err := client.DataStorage.Create().
+			SetName("sftp-connection").
+			SetDescription("SFTP storage").
+			SetType(datastorage.TypeSftp).
+			SetSettings(&objects.DataStorageSettings{SFTP: cfg}).
+			Save(ctx)
+		require.NoError(t, err)
+
+		settings := *cfg
+		settings.Password = ""
+
+		result, err := service.TestConnection(ctx, TestDataStorageConnectionInput{
+			ID:       &ds.ID,
+			Settings: &objects.DataStorageSettings{SFTP: &settings},
+		})
+		require.NoError(t, err)
+		require.True(t, result.Success, "%v", result.Message)
+	})
+
+	t.Run("wrong host key", func(t *testing.T) {
+		_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		signer, err := ssh.NewSignerFromKey(otherKey)
+		require.NoError(t, err)
+
+		settings := *cfg
+		settings.HostKey = string(ssh.MarshalAuthorizedKey(signer.PublicKey()))
+
+		result, err := service.TestConnection(ctx, TestDataStorageConnectionInput{
+			Type:     new(datastorage.TypeSftp),
+			Settings: &objects.DataStorageSettings{SFTP: &settings},
+		})
+		require.NoError(t, err)
+		require.False(t, result.Success)
+		require.Contains(t, *result.Message, "host key mismatch")
+	})
+
+	t.Run("missing directory", func(t *testing.T) {
+		result, err := service.TestConnection(ctx, TestDataStorageConnectionInput{
+			Type:     new(datastorage.TypeFs),
+			Settings: &objects.DataStorageSettings{Directory: new(filepath.Join(t.TempDir(), "missing"))},
+		})
+		require.NoError(t, err)
+		require.False(t, result.Success)
+		require.Contains(t, *result.Message, "failed to write test object")
+	})
+
+	t.Run("database storage", func(t *testing.T) {
+		result, err := service.TestConnection(ctx, TestDataStorageConnectionInput{
+			Type:     new(datastorage.TypeDatabase),
+			Settings: &objects.DataStorageSettings{},
+		})
+		require.NoError(t, err)
+		require.True(t, result.Success)
+	})
+
+	t.Run("type or id required", func(t *testing.T) {
+		_, err := service.TestConnection(ctx, TestDataStorageConnectionInput{})
+		require.Error(t, err)
+	})
+}
@@ -119,11 +119,19 @@ const (
 	// SystemKeySecuritySettings is the key used to store security settings.
 	// The value is JSON-encoded SecuritySettings struct.
 	SystemKeySecuritySettings = "security_settings"
+
+	// SystemKeyUsageRollupWatermark is the key used to store the start of the oldest hour
+	// the usage rollup task recomputes on its next run, in RFC 3339 format.
+	SystemKeyUsageRollupWatermark = "usage_rollup_watermark"
+
+	// SystemKeyRequestSearchWatermark is the key used to store the ID of the last request
+	// the request search indexer has indexed.
+	SystemKeyRequestSearchWatermark = "request_search_watermark"
 )
 
 // SystemGeneralSettings represents general system configuration settings.
 type SystemGeneralSettings struct {
-	// CurrencyCode is the code used for currency display (e.g., USD, RMB).
+	// CurrencyCode is the reporting currency (e.g., USD, CNY), the costs are converted into it with the exchange rates.
 	CurrencyCode string `json:"currency_code"`
 	Timezone     string `json:"timezone"`
 }
@@ -247,12 +255,25 @@ type AutoBackupSettings struct {
 	IncludeModelPrices bool `json:"include_model_prices"`
 	IncludeUsageStats  bool `json:"include_usage_stats"`
 	IncludeRequestLogs bool `json:"include_request_logs"`
+	// IncludeConfiguration includes the projects, users, roles, prompts, rules, templates, data storages and system settings.
+	IncludeConfiguration bool `json:"include_configuration"`
 	// RetentionDays defines how many days to keep backups (0 = keep all)
 	RetentionDays int `json:"retention_days"`
+	// Incremental backs up the usage data since the last checkpoint on the days without a full backup.
+	Incremental bool `json:"incremental"`
 	// LastBackupAt is the timestamp of the last successful backup
 	LastBackupAt *time.Time `json:"last_backup_at,omitempty"`
 	// LastBackupError is the error message from the last backup attempt (if any)
 	LastBackupError string `json:"last_backup_error,omitempty"`
+	// Checkpoint is the checkpoint of the last successful archive backup, the next incremental backup starts from it.
+	Checkpoint *BackupCheckpoint `json:"checkpoint,omitempty"`
+}
+
+// BackupCheckpoint is the position of an archive backup in the usage data.
+type BackupCheckpoint struct {
+	CreatedAt time.Time `json:"created_at"`
+	// RequestID is the last request backed up with its usage log, the requests are backed up in ID order.
+	RequestID int `json:"request_id"`
 }
 
 type autoBackupSettingsJSON struct {
@@ -265,9 +286,13 @@ type autoBackupSettingsJSON struct {
 	IncludeModelPrices bool            `json:"include_model_prices"`
 	IncludeUsageStats  *bool           `json:"include_usage_stats"`
 	IncludeRequestLogs *bool           `json:"include_request_logs"`
-	RetentionDays      int             `json:"retention_days"`
-	LastBackupAt       *time.Time      `json:"last_backup_at,omitempty"`
-	LastBackupError    string          `json:"last_backup_error,omitempty"`
+	// IncludeConfiguration is a pointer so that settings stored before it existed get the default.
+	IncludeConfiguration *bool             `json:"include_configuration"`
+	RetentionDays        int               `json:"retention_days"`
+	Incremental          bool              `json:"incremental"`
+	LastBackupAt         *time.Time        `json:"last_backup_at,omitempty"`
+	LastBackupError      string            `json:"last_backup_error,omitempty"`
+	Checkpoint           *BackupCheckpoint `json:"checkpoint,omitempty"`
 }
 
 // StoragePolicy represents the storage policy configuration.
@@ -277,6 +302,17 @@ type StoragePolicy struct {
 	StoreRequestBody  bool            `json:"store_request_body"`
 	StoreResponseBody bool            `json:"store_response_body"`
 	CleanupOptions    []CleanupOption `json:"cleanup_options"`
+	// Archive moves the bodies of old requests to archive objects, before the requests are cleaned up.
+	Archive *RequestArchivePolicy `json:"archive,omitempty"`
+}
+
+// RequestArchivePolicy represents the archive configuration of the request bodies.
+type RequestArchivePolicy struct {
+	Enabled bool `json:"enabled"`
+	// ArchiveDays is the age in days of the requests to archive.
+	ArchiveDays int `json:"archive_days"`
+	// DataStorageID is the file data storage of the archive objects.
+	DataStorageID int `json:"data_storage_id"`
 }
 
 // CleanupOption represents cleanup configuration for a specific resource type.
@@ -445,11 +481,26 @@ type WebhookTarget struct {
 	TimeoutMs int                     `json:"timeout_ms"`
 	Headers   []objects.HeaderEntry   `json:"headers"`
 	Body      string                  `json:"body"`
+
+	// Secret signs the deliveries with HMAC-SHA256 when set, see the X-AxonHub-Signature header.
+	Secret string `json:"secret,omitempty"`
 }
 
 type WebhookSubscription struct {
+	// Event is the event name, "*" for all events or a prefix wildcard like "channel.*".
 	Event       string   `json:"event"`
 	TargetNames []string `json:"target_names"`
+
+	// ProjectIDs limits the subscription to the events of the projects, empty for all.
+	// Events not bound to a project are not filtered.
+	ProjectIDs []int `json:"project_ids,omitempty"`
+
+	// ChannelIDs limits the subscription to the events of the channels, empty for all.
+	// Events not bound to a channel are not filtered.
+	ChannelIDs []int `json:"channel_ids,omitempty"`
+
+	// Severities limits the subscription to the events of the severities, empty for all.
+	Severities []string `json:"severities,omitempty"`
 }
 
 // SystemModelSettings represents model-related configuration settings.
@@ -1041,6 +1092,21 @@ func (s *SystemService) SetStoragePolicy(ctx context.Context, policy *StoragePol
 		}
 	}
 
+	if archive := policy.Archive; archive != nil && archive.Enabled {
+		if archive.ArchiveDays <= 0 {
+			return fmt.Errorf("archive_days must be positive")
+		}
+
+		ds, err := s.entFromContext(ctx).DataStorage.Get(ctx, archive.DataStorageID)
+		if err != nil {
+			return fmt.Errorf("failed to get archive data storage: %w", err)
+		}
+
+		if ds.Type == datastorage.TypeDatabase {
+			return fmt.Errorf("the archive data storage must be a file storage")
+		}
+	}
+
 	jsonBytes, err := json.Marshal(policy)
 	if err != nil {
 		return fmt.Errorf("failed to marshal storage policy: %w", err)
@@ -1461,6 +1527,15 @@ func (s *SystemService) invalidateSystemValueCache(ctx context.Context, key stri
 	}
 }
 
+// InvalidateSystemValues drops the cached values of the keys, e.g. after they are written outside the service.
+func (s *SystemService) InvalidateSystemValues(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if key != "" {
+			s.invalidateSystemValueCache(ctx, key)
+		}
+	}
+}
+
 func (s *SystemService) TimeLocation(ctx context.Context) *time.Location {
 	s.mu.RLock()
 
@@ -1601,20 +1676,27 @@ func (s *SystemService) AutoBackupSettings(ctx context.Context) (*AutoBackupSett
 	if stored.IncludeRequestLogs != nil {
 		includeRequestLogs = *stored.IncludeRequestLogs
 	}
+	includeConfiguration := defaultAutoBackupSettings.IncludeConfiguration
+	if stored.IncludeConfiguration != nil {
+		includeConfiguration = *stored.IncludeConfiguration
+	}
 
 	settings := AutoBackupSettings{
-		Enabled:            stored.Enabled,
-		Frequency:          stored.Frequency,
-		DataStorageID:      stored.DataStorageID,
-		IncludeChannels:    stored.IncludeChannels,
-		IncludeModels:      stored.IncludeModels,
-		IncludeAPIKeys:     stored.IncludeAPIKeys,
-		IncludeModelPrices: stored.IncludeModelPrices,
-		IncludeUsageStats:  includeUsageStats,
-		IncludeRequestLogs: includeRequestLogs,
-		RetentionDays:      stored.RetentionDays,
-		LastBackupAt:       stored.LastBackupAt,
-		LastBackupError:    stored.LastBackupError,
+		Enabled:              stored.Enabled,
+		Frequency:            stored.Frequency,
+		DataStorageID:        stored.DataStorageID,
+		IncludeChannels:      stored.IncludeChannels,
+		IncludeModels:        stored.IncludeModels,
+		IncludeAPIKeys:       stored.IncludeAPIKeys,
+		IncludeModelPrices:   stored.IncludeModelPrices,
+		IncludeUsageStats:    includeUsageStats,
+		IncludeRequestLogs:   includeRequestLogs,
+		IncludeConfiguration: includeConfiguration,
+		RetentionDays:        stored.RetentionDays,
+		Incremental:          stored.Incremental,
+		LastBackupAt:         stored.LastBackupAt,
+		LastBackupError:      stored.LastBackupError,
+		Checkpoint:           stored.Checkpoint,
 	}
 
 	return &settings, nil
@@ -1894,3 +1976,15 @@ func (s *SystemService) UpdateAutoBackupLastRun(ctx context.Context, lastError s
 
 	return s.SetAutoBackupSettings(ctx, *settings)
 }
+
+// UpdateAutoBackupCheckpoint records the checkpoint of a successful archive backup.
+func (s *SystemService) UpdateAutoBackupCheckpoint(ctx context.Context, checkpoint BackupCheckpoint) error {
+	settings, err := s.AutoBackupSettings(ctx)
+	if err != nil {
+		return err
+	}
+
+	settings.Checkpoint = &checkpoint
+
+	return s.SetAutoBackupSettings(ctx, *settings)
+}
@@ -22,6 +22,11 @@ var defaultStoragePolicy = StoragePolicy{
 			Enabled:      false,
 			CleanupDays:  30,
 		},
+		{
+			ResourceType: "audit_logs",
+			Enabled:      false,
+			CleanupDays:  180,
+		},
 	},
 }
 
@@ -64,15 +69,16 @@ var defaultGeneralSettings = SystemGeneralSettings{
 }
 
 var defaultAutoBackupSettings = AutoBackupSettings{
-	Enabled:            false,
-	Frequency:          BackupFrequencyDaily,
-	IncludeChannels:    true,
-	IncludeModels:      true,
-	IncludeAPIKeys:     false,
-	IncludeModelPrices: true,
-	IncludeUsageStats:  false,
-	IncludeRequestLogs: false,
-	RetentionDays:      30,
+	Enabled:              false,
+	Frequency:            BackupFrequencyDaily,
+	IncludeChannels:      true,
+	IncludeModels:        true,
+	IncludeAPIKeys:       false,
+	IncludeModelPrices:   true,
+	IncludeUsageStats:    false,
+	IncludeRequestLogs:   false,
+	IncludeConfiguration: true,
+	RetentionDays:        30,
 }
 
 var defaultVideoStorageSettings = VideoStorageSettings{
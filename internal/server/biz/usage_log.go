@@ -11,6 +11,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/metrics"
 	"github.com/looplj/axonhub/internal/objects"
 	"github.com/looplj/axonhub/llm"
 )
@@ -22,14 +23,19 @@ type UsageLogService struct {
 	SystemService  *SystemService
 	ChannelService *ChannelService
 
+	// ExchangeRateService converts the costs into the reporting currency, the costs are not converted if nil.
+	ExchangeRateService *ExchangeRateService
+
 	// OnUsageLogCreated is called after a usage log is successfully created.
 	// Used to invalidate caches that depend on usage log data.
 	OnUsageLogCreated func()
 }
 
-func (s *UsageLogService) computeUsageCost(ctx context.Context, channelID int, modelID string, usage *llm.Usage) ([]objects.CostItem, *float64, string) {
+// computeUsageCost computes the cost of the usage in the currency of the channel model price,
+// it returns the cost items, the total cost, the price reference id and the price currency.
+func (s *UsageLogService) computeUsageCost(ctx context.Context, channelID int, modelID string, usage *llm.Usage) ([]objects.CostItem, *float64, string, string) {
 	if usage == nil {
-		return nil, nil, ""
+		return nil, nil, "", ""
 	}
 
 	ch := s.ChannelService.GetEnabledChannel(channelID)
@@ -39,7 +45,7 @@ func (s *UsageLogService) computeUsageCost(ctx context.Context, channelID int, m
 			log.String("model_id", modelID),
 		)
 
-		return nil, nil, ""
+		return nil, nil, "", ""
 	}
 
 	if log.DebugEnabled(ctx) {
@@ -64,10 +70,15 @@ func (s *UsageLogService) computeUsageCost(ctx context.Context, channelID int, m
 			)
 		}
 
-		return items, lo.ToPtr(totalCost), modelPrice.ReferenceID
+		return items, lo.ToPtr(totalCost), modelPrice.ReferenceID, modelPrice.Price.Currency
 	}
 
-	return nil, nil, ""
+	return nil, nil, "", ""
+}
+
+// SetExchangeRateService sets the service converting the costs into the reporting currency.
+func (s *UsageLogService) SetExchangeRateService(svc *ExchangeRateService) {
+	s.ExchangeRateService = svc
 }
 
 // NewUsageLogService creates a new UsageLogService.
@@ -91,6 +102,7 @@ type CreateUsageLogParams struct {
 	Source        usagelog.Source
 	Format        string
 	APIKeyID      *int
+	EndUserID     string
 }
 
 // CreateUsageLog creates a new usage log record from LLM response usage data.
@@ -118,6 +130,12 @@ func (s *UsageLogService) CreateUsageLog(ctx context.Context, params CreateUsage
 		mut = mut.SetAPIKeyID(ctxAPIKey.ID)
 	}
 
+	if params.EndUserID != "" {
+		mut = mut.SetEndUserID(params.EndUserID)
+	} else if endUserID, ok := contexts.GetEndUserID(ctx); ok && endUserID != "" {
+		mut = mut.SetEndUserID(endUserID)
+	}
+
 	// Set prompt tokens details if available
 	if params.Usage.PromptTokensDetails != nil {
 		mut = mut.
@@ -142,9 +160,28 @@ func (s *UsageLogService) CreateUsageLog(ctx context.Context, params CreateUsage
 		totalCost        *float64
 		costItems        []objects.CostItem
 		priceReferenceID string
+		priceCurrency    string
 	)
 
-	costItems, totalCost, priceReferenceID = s.computeUsageCost(ctx, params.ChannelID, params.ActualModelID, params.Usage)
+	costItems, totalCost, priceReferenceID, priceCurrency = s.computeUsageCost(ctx, params.ChannelID, params.ActualModelID, params.Usage)
+
+	if totalCost != nil && s.ExchangeRateService != nil {
+		conversion := s.ExchangeRateService.ConvertCost(ctx, priceCurrency, costItems, *totalCost)
+		costItems = conversion.Items
+		totalCost = lo.ToPtr(conversion.Total)
+
+		// The unconverted cost is only kept as the original cost, so that the sums of the total costs,
+		// all in the reporting currency, leave it out.
+		if !conversion.Converted() {
+			totalCost = nil
+		}
+
+		mut = mut.
+			SetCurrency(conversion.Currency).
+			SetOriginalCurrency(conversion.OriginalCurrency).
+			SetOriginalTotalCost(conversion.OriginalTotal).
+			SetNillableExchangeRate(conversion.Rate)
+	}
 
 	mut = mut.
 		SetNillableTotalCost(totalCost).
@@ -168,6 +205,20 @@ func (s *UsageLogService) CreateUsageLog(ctx context.Context, params CreateUsage
 		)
 	}
 
+	metrics.Metrics.RecordLLMUsage(ctx, metrics.LLMUsage{
+		LLMLabels: metrics.LLMLabels{
+			ChannelID: usageLog.ChannelID,
+			Model:     usageLog.ModelID,
+			ProjectID: usageLog.ProjectID,
+			APIKeyID:  usageLog.APIKeyID,
+		},
+		PromptTokens:     usageLog.PromptTokens,
+		CachedTokens:     usageLog.PromptCachedTokens,
+		ReasoningTokens:  usageLog.CompletionReasoningTokens,
+		CompletionTokens: usageLog.CompletionTokens,
+		Cost:             lo.FromPtr(usageLog.TotalCost),
+	})
+
 	if s.OnUsageLogCreated != nil {
 		s.OnUsageLogCreated()
 	}
@@ -195,5 +246,6 @@ func (s *UsageLogService) CreateUsageLogFromRequest(
 		Source:        usagelog.Source(request.Source),
 		Format:        request.Format,
 		APIKeyID:      lo.ToPtr(request.APIKeyID),
+		EndUserID:     request.EndUserID,
 	})
 }
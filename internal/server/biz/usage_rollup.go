@@ -0,0 +1,385 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/fx"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/usagedailyrollup"
+	"github.com/looplj/axonhub/internal/ent/usagehourlyrollup"
+	"github.com/looplj/axonhub/internal/ent/usagelog"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/pkg/xtime"
+	"github.com/looplj/axonhub/internal/server/scheduler"
+)
+
+// usageRollupSettleDelay is how long the rollup task waits before it stops recomputing an hour,
+// so that usage logs committed late are still rolled up.
+const usageRollupSettleDelay = 10 * time.Minute
+
+const usageRollupBatchSize = 500
+
+// UsageRollupServiceParams contains dependencies for UsageRollupService.
+type UsageRollupServiceParams struct {
+	fx.In
+
+	Ent           *ent.Client
+	SystemService *SystemService
+}
+
+// UsageRollupService maintains the hourly and daily usage rollups.
+//
+// The hourly rollups are recomputed from the usage logs, and the daily rollups are recomputed from
+// the hourly rollups in the system timezone. A rollup bucket is always replaced as a whole,
+// so recomputing a bucket is idempotent.
+type UsageRollupService struct {
+	*AbstractService
+
+	SystemService *SystemService
+	mu            sync.Mutex
+}
+
+// NewUsageRollupService creates a new UsageRollupService.
+func NewUsageRollupService(params UsageRollupServiceParams) *UsageRollupService {
+	return &UsageRollupService{
+		AbstractService: &AbstractService{
+			db: params.Ent,
+		},
+		SystemService: params.SystemService,
+	}
+}
+
+func (svc *UsageRollupService) RegisterScheduledTasks(ctx context.Context, s *scheduler.Scheduler) error {
+	return s.Register(ctx, scheduler.TaskSpec{
+		Name:        "usage-rollup",
+		Description: "Roll up recent usage logs into the hourly and daily usage rollups every 5 minutes",
+		CronExpr:    "*/5 * * * *",
+		Timezone:    "UTC",
+	}, svc.runRollupPeriodically)
+}
+
+func (svc *UsageRollupService) runRollupPeriodically(ctx context.Context) {
+	ctx = authz.WithSystemBypass(ctx, "usage-rollup")
+
+	if err := svc.RollupRecent(ctx); err != nil {
+		log.Error(ctx, "Failed to roll up usage logs", log.Cause(err))
+	}
+}
+
+// RollupRecent recomputes the rollups from the watermark of the previous run to now.
+// On the first run, all the existing usage logs are rolled up.
+func (svc *UsageRollupService) RollupRecent(ctx context.Context) error {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	ctx = ent.NewContext(ctx, svc.db)
+	now := xtime.UTCNow()
+
+	from, err := svc.watermark(ctx)
+	if err != nil {
+		return err
+	}
+
+	if from.IsZero() {
+		from, err = svc.earliestUsageLogHour(ctx)
+		if err != nil {
+			return err
+		}
+
+		if from.IsZero() {
+			from = now.Truncate(time.Hour)
+		}
+	}
+
+	to := now.Truncate(time.Hour).Add(time.Hour)
+
+	if err := svc.rollup(ctx, from, to); err != nil {
+		return err
+	}
+
+	return svc.SystemService.setSystemValue(ctx, SystemKeyUsageRollupWatermark,
+		now.Add(-usageRollupSettleDelay).Truncate(time.Hour).Format(time.RFC3339))
+}
+
+// Backfill recomputes the rollups between from and to.
+//
+// The hourly rollups are only recomputed from the oldest remaining usage log on, so the rollups of
+// usage logs which have been cleaned up are kept. The hour of the oldest usage log is kept as well
+// if it has already been rolled up, as its usage logs may have been partially cleaned up.
+// The daily rollups of the whole range are rebuilt from the hourly rollups, which also realigns them
+// after the system timezone is changed.
+func (svc *UsageRollupService) Backfill(ctx context.Context, from, to time.Time) error {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	ctx = ent.NewContext(ctx, svc.db)
+
+	from = from.UTC().Truncate(time.Hour)
+	to = to.UTC()
+
+	earliest, err := svc.earliestUsageLogHour(ctx)
+	if err != nil {
+		return err
+	}
+
+	hourFrom := from
+	if earliest.IsZero() {
+		hourFrom = to
+	} else if hourFrom.Before(earliest) {
+		hourFrom = earliest
+
+		exists, err := svc.db.UsageHourlyRollup.Query().
+			Where(usagehourlyrollup.BucketEQ(earliest)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check hourly usage rollup: %w", err)
+		}
+
+		if exists {
+			hourFrom = earliest.Add(time.Hour)
+		}
+	}
+
+	for hour := hourFrom; hour.Before(to); hour = hour.Add(time.Hour) {
+		if err := svc.rollupHour(ctx, hour); err != nil {
+			return err
+		}
+	}
+
+	return svc.rollupDays(ctx, from, to)
+}
+
+func (svc *UsageRollupService) rollup(ctx context.Context, from, to time.Time) error {
+	for hour := from; hour.Before(to); hour = hour.Add(time.Hour) {
+		if err := svc.rollupHour(ctx, hour); err != nil {
+			return err
+		}
+	}
+
+	return svc.rollupDays(ctx, from, to)
+}
+
+// rollupDays recomputes the daily rollups of the days in the system timezone overlapping [from, to).
+func (svc *UsageRollupService) rollupDays(ctx context.Context, from, to time.Time) error {
+	loc := svc.SystemService.TimeLocation(ctx)
+
+	localFrom := from.In(loc)
+	day := time.Date(localFrom.Year(), localFrom.Month(), localFrom.Day(), 0, 0, 0, 0, loc)
+
+	for ; day.Before(to); day = day.AddDate(0, 0, 1) {
+		if err := svc.rollupDay(ctx, day.UTC(), day.AddDate(0, 0, 1).UTC()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// usageRollupRow is an aggregated row of the usage logs or the hourly rollups.
+type usageRollupRow struct {
+	ProjectID                 int     `json:"project_id"`
+	APIKeyID                  int     `json:"api_key_id"`
+	ChannelID                 int     `json:"channel_id"`
+	ModelID                   string  `json:"model_id"`
+	EndUserID                 string  `json:"end_user_id"`
+	RequestCount              int64   `json:"request_count"`
+	PromptTokens              int64   `json:"prompt_tokens"`
+	PromptCachedTokens        int64   `json:"prompt_cached_tokens"`
+	PromptWriteCachedTokens   int64   `json:"prompt_write_cached_tokens"`
+	CompletionTokens          int64   `json:"completion_tokens"`
+	CompletionReasoningTokens int64   `json:"completion_reasoning_tokens"`
+	TotalTokens               int64   `json:"total_tokens"`
+	TotalCost                 float64 `json:"total_cost"`
+}
+
+// selectUsageRollupColumns selects the rollup key and the measures of the selector,
+// the request count is counted with countExpr.
+func selectUsageRollupColumns(s *entsql.Selector, countExpr string) {
+	keys := []string{
+		s.C(usagelog.FieldProjectID),
+		fmt.Sprintf("COALESCE(%s, 0)", s.C(usagelog.FieldAPIKeyID)),
+		fmt.Sprintf("COALESCE(%s, 0)", s.C(usagelog.FieldChannelID)),
+		s.C(usagelog.FieldModelID),
+		fmt.Sprintf("COALESCE(%s, '')", s.C(usagelog.FieldEndUserID)),
+	}
+
+	sum := func(column string) string {
+		return fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(column))
+	}
+
+	s.Select(
+		entsql.As(keys[0], "project_id"),
+		entsql.As(keys[1], "api_key_id"),
+		entsql.As(keys[2], "channel_id"),
+		entsql.As(keys[3], "model_id"),
+		entsql.As(keys[4], "end_user_id"),
+		entsql.As(countExpr, "request_count"),
+		entsql.As(sum(usagelog.FieldPromptTokens), "prompt_tokens"),
+		entsql.As(sum(usagelog.FieldPromptCachedTokens), "prompt_cached_tokens"),
+		entsql.As(sum(usagelog.FieldPromptWriteCachedTokens), "prompt_write_cached_tokens"),
+		entsql.As(sum(usagelog.FieldCompletionTokens), "completion_tokens"),
+		entsql.As(sum(usagelog.FieldCompletionReasoningTokens), "completion_reasoning_tokens"),
+		entsql.As(sum(usagelog.FieldTotalTokens), "total_tokens"),
+		entsql.As(sum(usagelog.FieldTotalCost), "total_cost"),
+	).GroupBy(keys...)
+}
+
+// rollupHour replaces the hourly rollups of the hour starting at hour with the aggregated usage logs.
+func (svc *UsageRollupService) rollupHour(ctx context.Context, hour time.Time) error {
+	var rows []usageRollupRow
+
+	err := svc.db.UsageLog.Query().
+		Where(
+			usagelog.CreatedAtGTE(hour),
+			usagelog.CreatedAtLT(hour.Add(time.Hour)),
+		).
+		Modify(func(s *entsql.Selector) {
+			selectUsageRollupColumns(s, entsql.Count(s.C(usagelog.FieldID)))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return fmt.Errorf("failed to aggregate usage logs: %w", err)
+	}
+
+	return svc.RunInTransaction(ctx, func(ctx context.Context) error {
+		client := svc.entFromContext(ctx)
+
+		if _, err := client.UsageHourlyRollup.Delete().
+			Where(usagehourlyrollup.BucketEQ(hour)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete hourly usage rollups: %w", err)
+		}
+
+		for start := 0; start < len(rows); start += usageRollupBatchSize {
+			batch := rows[start:min(start+usageRollupBatchSize, len(rows))]
+
+			builders := make([]*ent.UsageHourlyRollupCreate, 0, len(batch))
+			for _, row := range batch {
+				builders = append(builders, client.UsageHourlyRollup.Create().
+					SetBucket(hour).
+					SetProjectID(row.ProjectID).
+					SetAPIKeyID(row.APIKeyID).
+					SetChannelID(row.ChannelID).
+					SetModelID(row.ModelID).
+					SetEndUserID(row.EndUserID).
+					SetRequestCount(row.RequestCount).
+					SetPromptTokens(row.PromptTokens).
+					SetPromptCachedTokens(row.PromptCachedTokens).
+					SetPromptWriteCachedTokens(row.PromptWriteCachedTokens).
+					SetCompletionTokens(row.CompletionTokens).
+					SetCompletionReasoningTokens(row.CompletionReasoningTokens).
+					SetTotalTokens(row.TotalTokens).
+					SetTotalCost(row.TotalCost))
+			}
+
+			if err := client.UsageHourlyRollup.CreateBulk(builders...).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to create hourly usage rollups: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// rollupDay replaces the daily rollups of the day [dayStart, dayEnd) with the aggregated hourly rollups.
+func (svc *UsageRollupService) rollupDay(ctx context.Context, dayStart, dayEnd time.Time) error {
+	var rows []usageRollupRow
+
+	err := svc.db.UsageHourlyRollup.Query().
+		Where(
+			usagehourlyrollup.BucketGTE(dayStart),
+			usagehourlyrollup.BucketLT(dayEnd),
+		).
+		Modify(func(s *entsql.Selector) {
+			selectUsageRollupColumns(s, fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagehourlyrollup.FieldRequestCount)))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return fmt.Errorf("failed to aggregate hourly usage rollups: %w", err)
+	}
+
+	return svc.RunInTransaction(ctx, func(ctx context.Context) error {
+		client := svc.entFromContext(ctx)
+
+		if _, err := client.UsageDailyRollup.Delete().
+			Where(
+				usagedailyrollup.BucketGTE(dayStart),
+				usagedailyrollup.BucketLT(dayEnd),
+			).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete daily usage rollups: %w", err)
+		}
+
+		for start := 0; start < len(rows); start += usageRollupBatchSize {
+			batch := rows[start:min(start+usageRollupBatchSize, len(rows))]
+
+			builders := make([]*ent.UsageDailyRollupCreate, 0, len(batch))
+			for _, row := range batch {
+				builders = append(builders, client.UsageDailyRollup.Create().
+					SetBucket(dayStart).
+					SetProjectID(row.ProjectID).
+					SetAPIKeyID(row.APIKeyID).
+					SetChannelID(row.ChannelID).
+					SetModelID(row.ModelID).
+					SetEndUserID(row.EndUserID).
+					SetRequestCount(row.RequestCount).
+					SetPromptTokens(row.PromptTokens).
+					SetPromptCachedTokens(row.PromptCachedTokens).
+					SetPromptWriteCachedTokens(row.PromptWriteCachedTokens).
+					SetCompletionTokens(row.CompletionTokens).
+					SetCompletionReasoningTokens(row.CompletionReasoningTokens).
+					SetTotalTokens(row.TotalTokens).
+					SetTotalCost(row.TotalCost))
+			}
+
+			if err := client.UsageDailyRollup.CreateBulk(builders...).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to create daily usage rollups: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// earliestUsageLogHour returns the start of the hour of the oldest usage log, or zero if there is none.
+func (svc *UsageRollupService) earliestUsageLogHour(ctx context.Context) (time.Time, error) {
+	first, err := svc.db.UsageLog.Query().
+		Order(ent.Asc(usagelog.FieldCreatedAt)).
+		Select(usagelog.FieldCreatedAt).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return time.Time{}, nil
+		}
+
+		return time.Time{}, fmt.Errorf("failed to get earliest usage log: %w", err)
+	}
+
+	return first.CreatedAt.UTC().Truncate(time.Hour), nil
+}
+
+func (svc *UsageRollupService) watermark(ctx context.Context) (time.Time, error) {
+	value, err := svc.SystemService.getSystemValue(ctx, SystemKeyUsageRollupWatermark)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return time.Time{}, nil
+		}
+
+		return time.Time{}, fmt.Errorf("failed to get usage rollup watermark: %w", err)
+	}
+
+	watermark, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		log.Warn(ctx, "Invalid usage rollup watermark, rolling up all usage logs", log.String("watermark", value))
+		return time.Time{}, nil
+	}
+
+	return watermark.UTC(), nil
+}
@@ -0,0 +1,183 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/ent/usagedailyrollup"
+	"github.com/looplj/axonhub/internal/ent/usagehourlyrollup"
+	"github.com/looplj/axonhub/internal/ent/usagelog"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+	"github.com/looplj/axonhub/internal/pkg/xtime"
+)
+
+func setupTestUsageRollupService(t *testing.T) (context.Context, *ent.Client, *UsageRollupService) {
+	t.Helper()
+
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=0")
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := authz.WithTestBypass(ent.NewContext(context.Background(), client))
+
+	systemService := NewSystemService(SystemServiceParams{
+		CacheConfig: xcache.Config{},
+		Ent:         client,
+	})
+
+	return ctx, client, NewUsageRollupService(UsageRollupServiceParams{
+		Ent:           client,
+		SystemService: systemService,
+	})
+}
+
+func createTestRollupUsageLog(t *testing.T, ctx context.Context, client *ent.Client, createdAt time.Time, apiKeyID int, modelID string, tokens int64, cost float64) {
+	t.Helper()
+
+	create := client.UsageLog.Create().
+		SetRequestID(1).
+		SetProjectID(1).
+		SetChannelID(1).
+		SetModelID(modelID).
+		SetPromptTokens(tokens).
+		SetCompletionTokens(tokens).
+		SetTotalTokens(2 * tokens).
+		SetTotalCost(cost).
+		SetCreatedAt(createdAt)
+	if apiKeyID > 0 {
+		create.SetAPIKeyID(apiKeyID)
+	}
+
+	_, err := create.Save(ctx)
+	require.NoError(t, err)
+}
+
+func TestUsageRollupService_Backfill(t *testing.T) {
+	ctx, client, svc := setupTestUsageRollupService(t)
+
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	createTestRollupUsageLog(t, ctx, client, day.Add(1*time.Hour+5*time.Minute), 7, "gpt-4", 10, 0.5)
+	createTestRollupUsageLog(t, ctx, client, day.Add(1*time.Hour+40*time.Minute), 7, "gpt-4", 20, 1)
+	createTestRollupUsageLog(t, ctx, client, day.Add(1*time.Hour+50*time.Minute), 0, "gpt-4", 5, 0.25)
+	createTestRollupUsageLog(t, ctx, client, day.Add(3*time.Hour), 7, "claude", 100, 2)
+
+	require.NoError(t, svc.Backfill(ctx, day, day.AddDate(0, 0, 1)))
+
+	hourly, err := client.UsageHourlyRollup.Query().
+		Order(ent.Asc(usagehourlyrollup.FieldBucket), ent.Asc(usagehourlyrollup.FieldAPIKeyID)).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, hourly, 3)
+
+	require.True(t, hourly[0].Bucket.Equal(day.Add(time.Hour)))
+	require.Equal(t, 0, hourly[0].APIKeyID)
+	require.Equal(t, int64(1), hourly[0].RequestCount)
+
+	require.True(t, hourly[1].Bucket.Equal(day.Add(time.Hour)))
+	require.Equal(t, 7, hourly[1].APIKeyID)
+	require.Equal(t, "gpt-4", hourly[1].ModelID)
+	require.Equal(t, int64(2), hourly[1].RequestCount)
+	require.Equal(t, int64(30), hourly[1].PromptTokens)
+	require.Equal(t, int64(60), hourly[1].TotalTokens)
+	require.InDelta(t, 1.5, hourly[1].TotalCost, 1e-9)
+
+	require.True(t, hourly[2].Bucket.Equal(day.Add(3*time.Hour)))
+	require.Equal(t, "claude", hourly[2].ModelID)
+
+	daily, err := client.UsageDailyRollup.Query().
+		Order(ent.Asc(usagedailyrollup.FieldModelID), ent.Asc(usagedailyrollup.FieldAPIKeyID)).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, daily, 3)
+
+	for _, rollup := range daily {
+		require.True(t, rollup.Bucket.Equal(day))
+	}
+
+	require.Equal(t, "claude", daily[0].ModelID)
+	require.Equal(t, int64(1), daily[0].RequestCount)
+	require.Equal(t, int64(3), daily[1].RequestCount+daily[2].RequestCount)
+
+	// Backfilling again replaces the buckets instead of adding to them.
+	require.NoError(t, svc.Backfill(ctx, day, day.AddDate(0, 0, 1)))
+
+	count, err := client.UsageHourlyRollup.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+}
+
+func TestUsageRollupService_Backfill_KeepsCleanedUpUsage(t *testing.T) {
+	ctx, client, svc := setupTestUsageRollupService(t)
+
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	createTestRollupUsageLog(t, ctx, client, day.Add(1*time.Hour), 7, "gpt-4", 10, 1)
+	createTestRollupUsageLog(t, ctx, client, day.Add(2*time.Hour+10*time.Minute), 7, "gpt-4", 10, 1)
+	createTestRollupUsageLog(t, ctx, client, day.Add(2*time.Hour+20*time.Minute), 7, "gpt-4", 10, 1)
+
+	require.NoError(t, svc.Backfill(ctx, day, day.AddDate(0, 0, 1)))
+
+	// The cleanup removes the first hour and part of the second hour.
+	_, err := client.UsageLog.Delete().
+		Where(usagelog.CreatedAtLT(day.Add(2*time.Hour + 15*time.Minute))).
+		Exec(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, svc.Backfill(ctx, day, day.AddDate(0, 0, 1)))
+
+	hourly, err := client.UsageHourlyRollup.Query().
+		Order(ent.Asc(usagehourlyrollup.FieldBucket)).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, hourly, 2)
+	require.Equal(t, int64(1), hourly[0].RequestCount)
+	require.Equal(t, int64(2), hourly[1].RequestCount)
+
+	daily, err := client.UsageDailyRollup.Query().Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), daily.RequestCount)
+	require.InDelta(t, 3, daily.TotalCost, 1e-9)
+}
+
+func TestUsageRollupService_RollupRecent(t *testing.T) {
+	ctx, client, svc := setupTestUsageRollupService(t)
+
+	now := xtime.UTCNow()
+
+	createTestRollupUsageLog(t, ctx, client, now.Add(-26*time.Hour), 7, "gpt-4", 10, 1)
+	createTestRollupUsageLog(t, ctx, client, now, 7, "gpt-4", 10, 1)
+
+	// The first run rolls up all the existing usage logs.
+	require.NoError(t, svc.RollupRecent(ctx))
+
+	sum := func() int64 {
+		var v []struct {
+			Sum int64 `json:"sum"`
+		}
+
+		err := client.UsageDailyRollup.Query().
+			Aggregate(ent.As(ent.Sum(usagedailyrollup.FieldRequestCount), "sum")).
+			Scan(ctx, &v)
+		require.NoError(t, err)
+
+		return v[0].Sum
+	}
+
+	require.Equal(t, int64(2), sum())
+
+	watermark, err := svc.watermark(ctx)
+	require.NoError(t, err)
+	require.False(t, watermark.IsZero())
+	require.True(t, watermark.After(now.Add(-2*time.Hour)))
+
+	// The next run only recomputes the recent hours, picking up the new usage logs.
+	createTestRollupUsageLog(t, ctx, client, now, 7, "gpt-4", 10, 1)
+	require.NoError(t, svc.RollupRecent(ctx))
+	require.Equal(t, int64(3), sum())
+}
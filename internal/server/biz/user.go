@@ -536,6 +536,11 @@ func (s *UserService) DeleteUser(ctx context.Context, id int) error {
 		return fmt.Errorf("permission denied: %w", err)
 	}
 
+	return s.deleteUser(ctx, id)
+}
+
+// deleteUser soft deletes a user without permission validation.
+func (s *UserService) deleteUser(ctx context.Context, id int) error {
 	return s.RunInTransaction(ctx, func(ctx context.Context) error {
 		client := s.entFromContext(ctx)
 
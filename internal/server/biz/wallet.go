@@ -0,0 +1,540 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/samber/lo"
+	"go.uber.org/fx"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/ent/wallet"
+	"github.com/looplj/axonhub/internal/ent/walletreservation"
+	"github.com/looplj/axonhub/internal/ent/wallettransaction"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/pkg/xerrors"
+	"github.com/looplj/axonhub/internal/pkg/xtime"
+	"github.com/looplj/axonhub/internal/server/scheduler"
+	"github.com/looplj/axonhub/llm"
+)
+
+// walletReservationTTL is how long a reservation holds the credit if its request is never settled,
+// e.g. when the instance serving the request stops.
+const walletReservationTTL = time.Hour
+
+// ErrInsufficientBalance is returned when the available balance of a wallet does not cover the reservation of a request.
+var ErrInsufficientBalance = errors.New("insufficient wallet balance")
+
+type WalletServiceParams struct {
+	fx.In
+
+	Ent                 *ent.Client
+	WebhookNotifier     *WebhookNotifier
+	ExchangeRateService *ExchangeRateService
+}
+
+// WalletService manages the prepaid credit of the API keys.
+//
+// Before the upstream call, the estimated cost of a request is reserved from the wallet of its API key,
+// and the request is rejected if the available balance, the balance minus the reserved credit, does not cover it.
+// When the usage log of the request is created, the reservation is released and the actual cost is debited.
+type WalletService struct {
+	*AbstractService
+
+	WebhookNotifier     *WebhookNotifier
+	ExchangeRateService *ExchangeRateService
+}
+
+func NewWalletService(params WalletServiceParams) *WalletService {
+	return &WalletService{
+		AbstractService: &AbstractService{
+			db: params.Ent,
+		},
+		WebhookNotifier:     params.WebhookNotifier,
+		ExchangeRateService: params.ExchangeRateService,
+	}
+}
+
+func (svc *WalletService) RegisterScheduledTasks(ctx context.Context, s *scheduler.Scheduler) error {
+	return s.Register(ctx, scheduler.TaskSpec{
+		Name:        "wallet-reservation-release",
+		Description: "Release the expired wallet reservations of the requests which were never settled every 5 minutes",
+		CronExpr:    "*/5 * * * *",
+		Timezone:    "UTC",
+	}, svc.releaseExpiredReservationsPeriodically)
+}
+
+func (svc *WalletService) releaseExpiredReservationsPeriodically(ctx context.Context) {
+	ctx = authz.WithSystemBypass(ctx, "wallet-reservation-release")
+
+	if err := svc.ReleaseExpiredReservations(ctx); err != nil {
+		log.Error(ctx, "failed to release expired wallet reservations", log.Cause(err))
+	}
+}
+
+// EstimateCost estimates the cost of the usage with the price of the model in the channel in the reporting currency,
+// 0 if the model has no price or its currency can not be converted, like the debit of the usage log.
+func (svc *WalletService) EstimateCost(ctx context.Context, ch *Channel, modelID string, usage *llm.Usage) float64 {
+	if ch == nil {
+		return 0
+	}
+
+	modelPrice, ok := ch.cachedModelPrices[modelID]
+	if !ok {
+		return 0
+	}
+
+	items, total := ComputeUsageCost(usage, modelPrice.Price, time.Now())
+	if svc.ExchangeRateService == nil {
+		return total.InexactFloat64()
+	}
+
+	conversion := svc.ExchangeRateService.ConvertCost(ctx, modelPrice.Price.Currency, items, total.InexactFloat64())
+	if !conversion.Converted() {
+		return 0
+	}
+
+	return conversion.Total
+}
+
+// Reserve reserves the amount from the enabled wallet of the API key for the request.
+// It returns nil if the API key has no enabled wallet, and ErrInsufficientBalance if the available balance
+// is exhausted or does not cover the amount.
+func (svc *WalletService) Reserve(ctx context.Context, apiKeyID, requestID int, amount float64) (*ent.WalletReservation, error) {
+	w, err := svc.entFromContext(ctx).Wallet.Query().
+		Where(
+			wallet.APIKeyIDEQ(apiKeyID),
+			wallet.StatusEQ(wallet.StatusEnabled),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get wallet: %w", err)
+	}
+
+	amount = max(amount, 0)
+
+	var reservation *ent.WalletReservation
+
+	err = svc.RunInTransaction(ctx, func(ctx context.Context) error {
+		client := svc.entFromContext(ctx)
+
+		// The check and the reservation are a single conditional update, so concurrent requests cannot overdraw the wallet.
+		n, err := client.Wallet.Update().
+			Where(
+				wallet.ID(w.ID),
+				predicate.Wallet(func(s *entsql.Selector) {
+					available := fmt.Sprintf("%s - %s", s.C(wallet.FieldBalance), s.C(wallet.FieldReserved))
+					s.Where(entsql.And(
+						entsql.ExprP(available+" > 0"),
+						entsql.ExprP(available+" >= ?", amount),
+					))
+				}),
+			).
+			AddReserved(amount).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to reserve wallet credit: %w", err)
+		}
+
+		if n == 0 {
+			return ErrInsufficientBalance
+		}
+
+		reservation, err = client.WalletReservation.Create().
+			SetWalletID(w.ID).
+			SetRequestID(requestID).
+			SetAmount(amount).
+			SetExpiresAt(xtime.UTCNow().Add(walletReservationTTL)).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create wallet reservation: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+// Release releases the reservation of the request without debiting the wallet, e.g. when the request failed.
+func (svc *WalletService) Release(ctx context.Context, requestID int) error {
+	return svc.RunInTransaction(ctx, func(ctx context.Context) error {
+		_, err := svc.takeReservation(ctx, requestID)
+		return err
+	})
+}
+
+// ReleaseExpiredReservations releases the reservations whose requests were not settled before they expired.
+func (svc *WalletService) ReleaseExpiredReservations(ctx context.Context) error {
+	reservations, err := svc.entFromContext(ctx).WalletReservation.Query().
+		Where(walletreservation.ExpiresAtLT(xtime.UTCNow())).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query expired wallet reservations: %w", err)
+	}
+
+	for _, reservation := range reservations {
+		if err := svc.Release(ctx, reservation.RequestID); err != nil {
+			return err
+		}
+	}
+
+	if len(reservations) > 0 {
+		log.Info(ctx, "released expired wallet reservations", log.Int("count", len(reservations)))
+	}
+
+	return nil
+}
+
+// takeReservation deletes the reservation of the request and releases its credit.
+// It returns nil if the request has no reservation or it has already been taken.
+func (svc *WalletService) takeReservation(ctx context.Context, requestID int) (*ent.WalletReservation, error) {
+	client := svc.entFromContext(ctx)
+
+	reservation, err := client.WalletReservation.Query().
+		Where(walletreservation.RequestIDEQ(requestID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get wallet reservation: %w", err)
+	}
+
+	// Deleting by ID decides which of the concurrent settlements and releases of the request takes the reservation.
+	n, err := client.WalletReservation.Delete().
+		Where(walletreservation.ID(reservation.ID)).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete wallet reservation: %w", err)
+	}
+
+	if n == 0 {
+		return nil, nil
+	}
+
+	if err := client.Wallet.UpdateOneID(reservation.WalletID).
+		AddReserved(-reservation.Amount).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to release wallet reservation: %w", err)
+	}
+
+	return reservation, nil
+}
+
+// SettleUsage releases the reservation of the request of the usage log and debits the actual cost
+// from the wallet of its API key.
+func (svc *WalletService) SettleUsage(ctx context.Context, usageLog *ent.UsageLog) error {
+	var updated *ent.Wallet
+
+	err := svc.RunInTransaction(ctx, func(ctx context.Context) error {
+		client := svc.entFromContext(ctx)
+
+		reservation, err := svc.takeReservation(ctx, usageLog.RequestID)
+		if err != nil {
+			return err
+		}
+
+		query := client.Wallet.Query()
+		if reservation != nil {
+			query = query.Where(wallet.ID(reservation.WalletID))
+		} else {
+			if usageLog.APIKeyID == 0 {
+				return nil
+			}
+
+			query = query.Where(
+				wallet.APIKeyIDEQ(usageLog.APIKeyID),
+				wallet.StatusEQ(wallet.StatusEnabled),
+			)
+		}
+
+		w, err := query.Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+
+			return fmt.Errorf("failed to get wallet: %w", err)
+		}
+
+		cost := lo.FromPtr(usageLog.TotalCost)
+		if cost == 0 {
+			return nil
+		}
+
+		updated, err = client.Wallet.UpdateOneID(w.ID).
+			AddBalance(-cost).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to debit wallet: %w", err)
+		}
+
+		_, err = client.WalletTransaction.Create().
+			SetWalletID(w.ID).
+			SetAPIKeyID(w.APIKeyID).
+			SetProjectID(w.ProjectID).
+			SetType(wallettransaction.TypeUsage).
+			SetAmount(-cost).
+			SetBalanceAfter(updated.Balance).
+			SetRequestID(usageLog.RequestID).
+			SetUsageLogID(usageLog.ID).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create wallet transaction: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if updated != nil {
+		svc.checkLowBalance(ctx, updated)
+	}
+
+	return nil
+}
+
+// UsageLogHook settles the wallet of the API key after a usage log is created, or after the transaction
+// creating it commits.
+func (svc *WalletService) UsageLogHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			value, err := next.Mutate(ctx, m)
+			if err != nil || m.Type() != ent.TypeUsageLog || !m.Op().Is(ent.OpCreate) {
+				return value, err
+			}
+
+			usageLog, ok := value.(*ent.UsageLog)
+			if !ok {
+				return value, nil
+			}
+
+			settle := func(ctx context.Context) {
+				settleCtx := authz.WithSystemBypass(ent.NewContext(ctx, svc.db), "wallet-settle")
+				if err := svc.SettleUsage(settleCtx, usageLog); err != nil {
+					log.Error(settleCtx, "failed to settle wallet usage",
+						log.Int("request_id", usageLog.RequestID),
+						log.Int("usage_log_id", usageLog.ID),
+						log.Cause(err),
+					)
+				}
+			}
+
+			um, ok := m.(*ent.UsageLogMutation)
+			if !ok {
+				return value, nil
+			}
+
+			tx, txErr := um.Tx()
+			if txErr != nil {
+				settle(context.WithoutCancel(ctx))
+
+				return value, nil
+			}
+
+			tx.OnCommit(func(next ent.Committer) ent.Committer {
+				return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+					if err := next.Commit(ctx, tx); err != nil {
+						return err
+					}
+
+					// The context of the request still refers to the committed transaction.
+					settle(context.Background())
+
+					return nil
+				})
+			})
+
+			return value, nil
+		})
+	}
+}
+
+// CreditWalletInput is a manual transaction of a wallet.
+type CreditWalletInput struct {
+	APIKeyID int
+	Type     wallettransaction.Type
+	// Amount is positive for top-ups and refunds, and signed for adjustments.
+	Amount      float64
+	Description string
+	// RequestID is the request a refund is for.
+	RequestID *int
+}
+
+// CreditWallet records a top-up, refund or adjustment of the wallet of the API key,
+// creating the wallet on the first credit.
+func (svc *WalletService) CreditWallet(ctx context.Context, input CreditWalletInput) (*ent.WalletTransaction, error) {
+	//nolint:exhaustive // Checked.
+	switch input.Type {
+	case wallettransaction.TypeTopUp, wallettransaction.TypeRefund:
+		if input.Amount <= 0 {
+			return nil, xerrors.ValidationError(fmt.Sprintf("%s amount must be positive", input.Type))
+		}
+	case wallettransaction.TypeAdjustment:
+		if input.Amount == 0 {
+			return nil, xerrors.ValidationError("adjustment amount cannot be zero")
+		}
+	default:
+		return nil, xerrors.ValidationError(fmt.Sprintf("invalid wallet transaction type: %s", input.Type))
+	}
+
+	apiKey, err := svc.entFromContext(ctx).APIKey.Get(ctx, input.APIKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+
+	var (
+		transaction *ent.WalletTransaction
+		updated     *ent.Wallet
+	)
+
+	err = svc.RunInTransaction(ctx, func(ctx context.Context) error {
+		client := svc.entFromContext(ctx)
+
+		w, err := client.Wallet.Query().
+			Where(wallet.APIKeyIDEQ(apiKey.ID)).
+			Only(ctx)
+		if err != nil {
+			if !ent.IsNotFound(err) {
+				return fmt.Errorf("failed to get wallet: %w", err)
+			}
+
+			w, err = client.Wallet.Create().
+				SetAPIKeyID(apiKey.ID).
+				SetProjectID(apiKey.ProjectID).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create wallet: %w", err)
+			}
+		}
+
+		updated, err = client.Wallet.UpdateOneID(w.ID).
+			AddBalance(input.Amount).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to credit wallet: %w", err)
+		}
+
+		create := client.WalletTransaction.Create().
+			SetWalletID(w.ID).
+			SetAPIKeyID(w.APIKeyID).
+			SetProjectID(w.ProjectID).
+			SetType(input.Type).
+			SetAmount(input.Amount).
+			SetBalanceAfter(updated.Balance).
+			SetNillableRequestID(input.RequestID).
+			SetDescription(input.Description)
+
+		if user, ok := contexts.GetUser(ctx); ok && user != nil {
+			create.SetUserID(user.ID)
+		}
+
+		transaction, err = create.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create wallet transaction: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	svc.checkLowBalance(authz.WithSystemBypass(ctx, "wallet-low-balance"), updated)
+
+	return transaction, nil
+}
+
+// UpdateWallet updates the status and the low balance threshold of the wallet.
+func (svc *WalletService) UpdateWallet(ctx context.Context, id int, input ent.UpdateWalletInput) (*ent.Wallet, error) {
+	if input.LowBalanceThreshold != nil && *input.LowBalanceThreshold < 0 {
+		return nil, xerrors.ValidationError("low balance threshold cannot be negative")
+	}
+
+	w, err := svc.entFromContext(ctx).Wallet.UpdateOneID(id).
+		SetInput(input).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update wallet: %w", err)
+	}
+
+	if w.LowBalanceThreshold == nil && w.LowBalanceAlerted {
+		w, err = svc.entFromContext(ctx).Wallet.UpdateOneID(id).
+			SetLowBalanceAlerted(false).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update wallet: %w", err)
+		}
+	}
+
+	svc.checkLowBalance(authz.WithSystemBypass(ctx, "wallet-low-balance"), w)
+
+	return w, nil
+}
+
+// checkLowBalance sends the low balance event once when the balance falls below the threshold,
+// and rearms it when the balance is back above the threshold, the alert state of w is updated accordingly.
+func (svc *WalletService) checkLowBalance(ctx context.Context, w *ent.Wallet) {
+	if w.LowBalanceThreshold == nil {
+		return
+	}
+
+	client := svc.entFromContext(ctx)
+	low := w.Balance < *w.LowBalanceThreshold
+
+	if low == w.LowBalanceAlerted {
+		return
+	}
+
+	// The conditional update makes a single instance send the event.
+	n, err := client.Wallet.Update().
+		Where(
+			wallet.ID(w.ID),
+			wallet.LowBalanceAlerted(!low),
+		).
+		SetLowBalanceAlerted(low).
+		Save(ctx)
+	if err != nil {
+		log.Warn(ctx, "failed to update wallet low balance alert", log.Int("wallet_id", w.ID), log.Cause(err))
+		return
+	}
+
+	if n == 0 {
+		return
+	}
+
+	w.LowBalanceAlerted = low
+
+	if !low || svc.WebhookNotifier == nil {
+		return
+	}
+
+	apiKey, err := client.APIKey.Get(ctx, w.APIKeyID)
+	if err != nil {
+		log.Warn(ctx, "failed to get api key of wallet", log.Int("wallet_id", w.ID), log.Cause(err))
+		return
+	}
+
+	svc.WebhookNotifier.NotifyWalletLowBalance(ctx, WalletLowBalanceEvent{
+		Wallet:     w,
+		APIKey:     apiKey,
+		OccurredAt: xtime.UTCNow(),
+	})
+}
@@ -0,0 +1,217 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/ent/wallet"
+	"github.com/looplj/axonhub/internal/ent/wallettransaction"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+	"github.com/looplj/axonhub/internal/pkg/xerrors"
+	"github.com/looplj/axonhub/llm/httpclient"
+)
+
+func setupTestWalletService(t *testing.T) (context.Context, *ent.Client, *WalletService, *ent.APIKey) {
+	t.Helper()
+
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=0")
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := authz.WithTestBypass(ent.NewContext(context.Background(), client))
+
+	systemService := NewSystemService(SystemServiceParams{
+		CacheConfig: xcache.Config{},
+		Ent:         client,
+	})
+
+	svc := NewWalletService(WalletServiceParams{
+		Ent:             client,
+		WebhookNotifier: NewWebhookNotifier(systemService, httpclient.NewHttpClient()),
+	})
+	client.Use(svc.UsageLogHook())
+
+	apiKey, err := client.APIKey.Create().
+		SetName("key").
+		SetKey("ah-wallet-key").
+		SetProjectID(1).
+		SetUserID(5).
+		Save(ctx)
+	require.NoError(t, err)
+
+	return ctx, client, svc, apiKey
+}
+
+func topUpTestWallet(t *testing.T, ctx context.Context, svc *WalletService, apiKeyID int, amount float64) *ent.Wallet {
+	t.Helper()
+
+	transaction, err := svc.CreditWallet(ctx, CreditWalletInput{
+		APIKeyID: apiKeyID,
+		Type:     wallettransaction.TypeTopUp,
+		Amount:   amount,
+	})
+	require.NoError(t, err)
+
+	w, err := svc.entFromContext(ctx).Wallet.Get(ctx, transaction.WalletID)
+	require.NoError(t, err)
+
+	return w
+}
+
+func TestWalletService_CreditWallet(t *testing.T) {
+	ctx, client, svc, apiKey := setupTestWalletService(t)
+
+	w := topUpTestWallet(t, ctx, svc, apiKey.ID, 10)
+	require.Equal(t, apiKey.ID, w.APIKeyID)
+	require.Equal(t, apiKey.ProjectID, w.ProjectID)
+	require.InDelta(t, 10, w.Balance, 1e-9)
+
+	transaction, err := svc.CreditWallet(ctx, CreditWalletInput{
+		APIKeyID:    apiKey.ID,
+		Type:        wallettransaction.TypeAdjustment,
+		Amount:      -2.5,
+		Description: "correction",
+	})
+	require.NoError(t, err)
+	require.Equal(t, w.ID, transaction.WalletID)
+	require.InDelta(t, -2.5, transaction.Amount, 1e-9)
+	require.InDelta(t, 7.5, transaction.BalanceAfter, 1e-9)
+	require.Equal(t, "correction", transaction.Description)
+
+	count, err := client.Wallet.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	for _, input := range []CreditWalletInput{
+		{APIKeyID: apiKey.ID, Type: wallettransaction.TypeTopUp, Amount: 0},
+		{APIKeyID: apiKey.ID, Type: wallettransaction.TypeRefund, Amount: -1},
+		{APIKeyID: apiKey.ID, Type: wallettransaction.TypeAdjustment, Amount: 0},
+		{APIKeyID: apiKey.ID, Type: wallettransaction.TypeUsage, Amount: 1},
+	} {
+		_, err := svc.CreditWallet(ctx, input)
+		_, ok := xerrors.IsCodedError(err)
+		require.True(t, ok, "type %s amount %g", input.Type, input.Amount)
+	}
+}
+
+func TestWalletService_ReserveAndSettle(t *testing.T) {
+	ctx, client, svc, apiKey := setupTestWalletService(t)
+
+	reservation, err := svc.Reserve(ctx, apiKey.ID, 1, 1)
+	require.NoError(t, err)
+	require.Nil(t, reservation, "api keys without wallet are not limited")
+
+	w := topUpTestWallet(t, ctx, svc, apiKey.ID, 1)
+
+	reservation, err = svc.Reserve(ctx, apiKey.ID, 1, 0.6)
+	require.NoError(t, err)
+	require.NotNil(t, reservation)
+
+	_, err = svc.Reserve(ctx, apiKey.ID, 2, 0.6)
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+
+	w = client.Wallet.GetX(ctx, w.ID)
+	require.InDelta(t, 0.6, w.Reserved, 1e-9)
+
+	_, err = client.UsageLog.Create().
+		SetRequestID(1).
+		SetAPIKeyID(apiKey.ID).
+		SetProjectID(apiKey.ProjectID).
+		SetChannelID(1).
+		SetModelID("gpt-4o").
+		SetTotalCost(0.25).
+		Save(ctx)
+	require.NoError(t, err)
+
+	w = client.Wallet.GetX(ctx, w.ID)
+	require.InDelta(t, 0.75, w.Balance, 1e-9)
+	require.InDelta(t, 0, w.Reserved, 1e-9)
+	require.False(t, client.WalletReservation.Query().ExistX(ctx))
+
+	usage := client.WalletTransaction.Query().
+		Where(wallettransaction.TypeEQ(wallettransaction.TypeUsage)).
+		OnlyX(ctx)
+	require.InDelta(t, -0.25, usage.Amount, 1e-9)
+	require.InDelta(t, 0.75, usage.BalanceAfter, 1e-9)
+	require.Equal(t, 1, lo.FromPtr(usage.RequestID))
+
+	reservation, err = svc.Reserve(ctx, apiKey.ID, 2, 0.6)
+	require.NoError(t, err)
+	require.NotNil(t, reservation)
+
+	require.NoError(t, svc.Release(ctx, 2))
+	require.NoError(t, svc.Release(ctx, 2))
+
+	w = client.Wallet.GetX(ctx, w.ID)
+	require.InDelta(t, 0.75, w.Balance, 1e-9)
+	require.InDelta(t, 0, w.Reserved, 1e-9)
+
+	_, err = svc.UpdateWallet(ctx, w.ID, ent.UpdateWalletInput{Status: lo.ToPtr(wallet.StatusDisabled)})
+	require.NoError(t, err)
+
+	reservation, err = svc.Reserve(ctx, apiKey.ID, 3, 100)
+	require.NoError(t, err)
+	require.Nil(t, reservation, "disabled wallets are not limited")
+}
+
+func TestWalletService_ReleaseExpiredReservations(t *testing.T) {
+	ctx, client, svc, apiKey := setupTestWalletService(t)
+
+	w := topUpTestWallet(t, ctx, svc, apiKey.ID, 1)
+
+	_, err := svc.Reserve(ctx, apiKey.ID, 1, 0.25)
+	require.NoError(t, err)
+
+	// A reservation left by a request which was never settled.
+	client.Wallet.UpdateOneID(w.ID).AddReserved(0.5).ExecX(ctx)
+	client.WalletReservation.Create().
+		SetWalletID(w.ID).
+		SetRequestID(2).
+		SetAmount(0.5).
+		SetExpiresAt(time.Now().Add(-time.Minute)).
+		SaveX(ctx)
+
+	require.NoError(t, svc.ReleaseExpiredReservations(ctx))
+
+	w = client.Wallet.GetX(ctx, w.ID)
+	require.InDelta(t, 0.25, w.Reserved, 1e-9)
+	require.Equal(t, 1, client.WalletReservation.Query().OnlyX(ctx).RequestID)
+}
+
+func TestWalletService_LowBalanceAlert(t *testing.T) {
+	ctx, client, svc, apiKey := setupTestWalletService(t)
+
+	w := topUpTestWallet(t, ctx, svc, apiKey.ID, 10)
+
+	w, err := svc.UpdateWallet(ctx, w.ID, ent.UpdateWalletInput{LowBalanceThreshold: lo.ToPtr(5.0)})
+	require.NoError(t, err)
+	require.False(t, w.LowBalanceAlerted)
+
+	_, err = svc.UpdateWallet(ctx, w.ID, ent.UpdateWalletInput{LowBalanceThreshold: lo.ToPtr(-1.0)})
+	require.Error(t, err)
+
+	_, err = svc.CreditWallet(ctx, CreditWalletInput{
+		APIKeyID: apiKey.ID,
+		Type:     wallettransaction.TypeAdjustment,
+		Amount:   -6,
+	})
+	require.NoError(t, err)
+	require.True(t, client.Wallet.GetX(ctx, w.ID).LowBalanceAlerted)
+
+	topUpTestWallet(t, ctx, svc, apiKey.ID, 10)
+	require.False(t, client.Wallet.GetX(ctx, w.ID).LowBalanceAlerted, "the alert is rearmed above the threshold")
+
+	w, err = svc.UpdateWallet(ctx, w.ID, ent.UpdateWalletInput{LowBalanceThreshold: lo.ToPtr(20.0)})
+	require.NoError(t, err)
+	require.True(t, w.LowBalanceAlerted)
+
+	w, err = svc.UpdateWallet(ctx, w.ID, ent.UpdateWalletInput{ClearLowBalanceThreshold: true})
+	require.NoError(t, err)
+	require.False(t, w.LowBalanceAlerted)
+}
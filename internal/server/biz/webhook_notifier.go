@@ -3,25 +3,80 @@ package biz
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"text/template"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/samber/lo"
 
 	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/webhookdelivery"
 	"github.com/looplj/axonhub/internal/log"
 	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/scheduler"
 	"github.com/looplj/axonhub/llm/httpclient"
 )
 
-const EventChannelAutoDisabled = "channel.auto_disabled"
+const (
+	EventChannelAutoDisabled    = "channel.auto_disabled"
+	EventChannelDisabled        = "channel.disabled"
+	EventChannelEnabled         = "channel.enabled"
+	EventChannelModelsChanged   = "channel.models_changed"
+	EventProviderQuotaLow       = "provider_quota.low"
+	EventAPIKeyQuotaExceeded    = "api_key.quota_exceeded"
+	EventBudgetThresholdCrossed = "budget.threshold_crossed"
+	EventCircuitBreakerOpened   = "circuit_breaker.opened"
+	EventBackupFailed           = "backup.failed"
+	EventUserRegistered         = "user.registered"
+	EventWalletLowBalance       = "wallet.low_balance"
+
+	// EventTest is sent by the test action, it is delivered to the chosen target regardless of the subscriptions.
+	EventTest = "test"
+)
+
+// WebhookEvents lists the events that can be subscribed.
+var WebhookEvents = []string{
+	EventChannelAutoDisabled,
+	EventChannelDisabled,
+	EventChannelEnabled,
+	EventChannelModelsChanged,
+	EventProviderQuotaLow,
+	EventAPIKeyQuotaExceeded,
+	EventBudgetThresholdCrossed,
+	EventCircuitBreakerOpened,
+	EventBackupFailed,
+	EventUserRegistered,
+	EventWalletLowBalance,
+}
 
 const (
 	defaultWebhookMethod    = http.MethodPost
 	defaultWebhookTimeoutMs = 3000
+
+	webhookDeliveryMaxAttempts = 6
+	webhookDeliveryBaseBackoff = 30 * time.Second
+	webhookDeliveryMaxBackoff  = time.Hour
+	webhookDeliveryBatchSize   = 100
+	// webhookDeliveryLease keeps other instances from attempting a delivery while it is being sent.
+	webhookDeliveryLease = 2 * time.Minute
+)
+
+const (
+	WebhookHeaderEvent     = "X-AxonHub-Event"
+	WebhookHeaderDelivery  = "X-AxonHub-Delivery"
+	WebhookHeaderTimestamp = "X-AxonHub-Timestamp"
+	WebhookHeaderSignature = "X-AxonHub-Signature"
 )
 
 type ChannelAutoDisabledEvent struct {
@@ -37,10 +92,73 @@ type ChannelAutoDisabledEvent struct {
 	OccurredAt      time.Time
 }
 
+type ChannelStatusChangedEvent struct {
+	Channel    *ent.Channel
+	OccurredAt time.Time
+}
+
+type ChannelModelsChangedEvent struct {
+	Channel    *ent.Channel
+	Added      []string
+	Removed    []string
+	OccurredAt time.Time
+}
+
+type ProviderQuotaLowEvent struct {
+	ChannelID    int
+	ProviderType string
+	Status       string
+	NextResetAt  *time.Time
+	OccurredAt   time.Time
+}
+
+type APIKeyQuotaExceededEvent struct {
+	APIKey     *ent.APIKey
+	Message    string
+	Window     QuotaWindow
+	OccurredAt time.Time
+}
+
+type BudgetThresholdCrossedEvent struct {
+	Budget     *ent.Budget
+	Spend      BudgetSpend
+	Threshold  int
+	OccurredAt time.Time
+}
+
+type CircuitBreakerOpenedEvent struct {
+	ChannelID           int
+	ModelID             string
+	ConsecutiveFailures int
+	NextProbeAt         time.Time
+	OccurredAt          time.Time
+}
+
+type BackupFailedEvent struct {
+	Error      string
+	OccurredAt time.Time
+}
+
+type UserRegisteredEvent struct {
+	User       *ent.User
+	OccurredAt time.Time
+}
+
+type WalletLowBalanceEvent struct {
+	Wallet     *ent.Wallet
+	APIKey     *ent.APIKey
+	OccurredAt time.Time
+}
+
 type WebhookRenderContext struct {
+	// ID is the event id, shared by the deliveries of the event to different targets.
+	ID         string `json:"id"`
 	Event      string `json:"event"`
 	Severity   string `json:"severity"`
+	Message    string `json:"message"`
 	OccurredAt string `json:"occurred_at"`
+	ProjectID  int    `json:"project_id,omitempty"`
+	Model      string `json:"model,omitempty"`
 
 	Channel struct {
 		ID       int    `json:"id"`
@@ -57,28 +175,117 @@ type WebhookRenderContext struct {
 		ActualCount int    `json:"actual_count"`
 		Reason      string `json:"reason"`
 	} `json:"trigger"`
+
+	Budget struct {
+		ID          int     `json:"id"`
+		Name        string  `json:"name"`
+		ProjectID   int     `json:"project_id"`
+		Scope       string  `json:"scope"`
+		APIKeyID    int     `json:"api_key_id,omitempty"`
+		UserID      int     `json:"user_id,omitempty"`
+		Period      string  `json:"period"`
+		Action      string  `json:"action"`
+		Amount      float64 `json:"amount"`
+		Spent       float64 `json:"spent"`
+		Percent     float64 `json:"percent"`
+		Forecast    float64 `json:"forecast"`
+		PeriodStart string  `json:"period_start"`
+		PeriodEnd   string  `json:"period_end"`
+	} `json:"budget"`
+
+	APIKey struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"api_key"`
+
+	Wallet struct {
+		ID        int     `json:"id"`
+		Balance   float64 `json:"balance"`
+		Reserved  float64 `json:"reserved"`
+		Threshold float64 `json:"threshold"`
+	} `json:"wallet"`
+
+	User struct {
+		ID        int    `json:"id"`
+		Email     string `json:"email"`
+		FirstName string `json:"first_name"`
+		LastName  string `json:"last_name"`
+	} `json:"user"`
+
+	Models struct {
+		Added   []string `json:"added"`
+		Removed []string `json:"removed"`
+	} `json:"models"`
+
+	Quota struct {
+		ProviderType string `json:"provider_type"`
+		Status       string `json:"status"`
+		NextResetAt  string `json:"next_reset_at,omitempty"`
+	} `json:"quota"`
 }
 
+// WebhookNotifier renders the events for the subscribed targets and delivers them.
+// Every delivery is persisted before the first attempt, failed attempts are retried
+// with exponential backoff by the scheduled retry task.
 type WebhookNotifier struct {
+	*AbstractService
+
 	SystemService *SystemService
 	httpClient    *httpclient.HttpClient
 }
 
 func NewWebhookNotifier(systemService *SystemService, httpClient *httpclient.HttpClient) *WebhookNotifier {
-	return &WebhookNotifier{
+	n := &WebhookNotifier{
 		SystemService: systemService,
 		httpClient:    httpClient,
 	}
+
+	if systemService != nil {
+		n.AbstractService = systemService.AbstractService
+	}
+
+	return n
+}
+
+func (n *WebhookNotifier) RegisterScheduledTasks(ctx context.Context, s *scheduler.Scheduler) error {
+	return s.Register(ctx, scheduler.TaskSpec{
+		Name:        "webhook-delivery-retry",
+		Description: "Retry failed webhook deliveries",
+		CronExpr:    "*/1 * * * *",
+		Timezone:    "UTC",
+	}, func(ctx context.Context) {
+		if err := n.RetryDeliveries(ctx); err != nil {
+			log.Error(ctx, "failed to retry webhook deliveries", log.Cause(err))
+		}
+	})
+}
+
+func newWebhookRenderContext(event, severity string, occurredAt time.Time) WebhookRenderContext {
+	return WebhookRenderContext{
+		ID:         uuid.NewString(),
+		Event:      event,
+		Severity:   severity,
+		OccurredAt: occurredAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func (c *WebhookRenderContext) setChannel(ch *ent.Channel) {
+	if ch == nil {
+		return
+	}
+
+	c.Channel.ID = ch.ID
+	c.Channel.Name = ch.Name
+	c.Channel.Provider = ch.Type.String()
+	c.Channel.BaseURL = ch.BaseURL
+	c.Channel.Status = ch.Status.String()
 }
 
 func (n *WebhookNotifier) NotifyChannelAutoDisabled(ctx context.Context, event ChannelAutoDisabledEvent) {
 	log.Info(ctx, "notify channel auto disabled", log.Any("event", event))
 
-	renderCtx := WebhookRenderContext{
-		Event:      EventChannelAutoDisabled,
-		Severity:   "warning",
-		OccurredAt: event.OccurredAt.UTC().Format(time.RFC3339),
-	}
+	renderCtx := newWebhookRenderContext(EventChannelAutoDisabled, "warning", event.OccurredAt)
+	renderCtx.Message = fmt.Sprintf("channel %q was disabled automatically: %s", event.ChannelName, event.Reason)
 	renderCtx.Channel.ID = event.ChannelID
 	renderCtx.Channel.Name = event.ChannelName
 	renderCtx.Channel.Provider = event.ChannelProvider
@@ -90,50 +297,266 @@ func (n *WebhookNotifier) NotifyChannelAutoDisabled(ctx context.Context, event C
 	renderCtx.Trigger.ActualCount = event.ActualCount
 	renderCtx.Trigger.Reason = event.Reason
 
-	n.notify(ctx, EventChannelAutoDisabled, renderCtx)
+	n.notify(ctx, renderCtx)
 }
 
-func (n *WebhookNotifier) notify(ctx context.Context, eventName string, renderCtx WebhookRenderContext) {
-	ctx = authz.WithSystemBypass(context.WithoutCancel(ctx), "webhook-notifier")
-	cfg := *n.SystemService.WebhookNotifierConfigOrDefault(ctx)
-	targets := n.selectTargets(cfg, eventName)
+// NotifyChannelStatusChanged notifies the manual enabling and disabling of a channel, other statuses are ignored.
+func (n *WebhookNotifier) NotifyChannelStatusChanged(ctx context.Context, event ChannelStatusChangedEvent) {
+	var renderCtx WebhookRenderContext
+
+	//nolint:exhaustive // Only enabling and disabling are notified.
+	switch event.Channel.Status {
+	case channel.StatusEnabled:
+		renderCtx = newWebhookRenderContext(EventChannelEnabled, "info", event.OccurredAt)
+		renderCtx.Message = fmt.Sprintf("channel %q was enabled", event.Channel.Name)
+	case channel.StatusDisabled:
+		renderCtx = newWebhookRenderContext(EventChannelDisabled, "warning", event.OccurredAt)
+		renderCtx.Message = fmt.Sprintf("channel %q was disabled", event.Channel.Name)
+	default:
+		return
+	}
 
-	log.Debug(ctx, "notify webhook",
-		log.Any("cfg", cfg),
-		log.String("event_name", eventName),
-		log.Any("targets", targets),
+	renderCtx.setChannel(event.Channel)
+	renderCtx.Trigger.Type = "channel_status"
+
+	n.notify(ctx, renderCtx)
+}
+
+func (n *WebhookNotifier) NotifyChannelModelsChanged(ctx context.Context, event ChannelModelsChangedEvent) {
+	renderCtx := newWebhookRenderContext(EventChannelModelsChanged, "info", event.OccurredAt)
+	renderCtx.Message = fmt.Sprintf("channel %q models changed: %d added, %d removed", event.Channel.Name, len(event.Added), len(event.Removed))
+	renderCtx.setChannel(event.Channel)
+	renderCtx.Trigger.Type = "model_sync"
+	renderCtx.Models.Added = lo.Ternary(event.Added == nil, []string{}, event.Added)
+	renderCtx.Models.Removed = lo.Ternary(event.Removed == nil, []string{}, event.Removed)
+
+	n.notify(ctx, renderCtx)
+}
+
+func (n *WebhookNotifier) NotifyProviderQuotaLow(ctx context.Context, event ProviderQuotaLowEvent) {
+	severity := "warning"
+	if event.Status == "exhausted" {
+		severity = "critical"
+	}
+
+	renderCtx := newWebhookRenderContext(EventProviderQuotaLow, severity, event.OccurredAt)
+	renderCtx.setChannel(n.loadChannel(ctx, event.ChannelID))
+	renderCtx.Channel.ID = event.ChannelID
+	renderCtx.Message = fmt.Sprintf("provider quota of channel %q is %s", renderCtx.Channel.Name, event.Status)
+	renderCtx.Trigger.Type = "provider_quota"
+	renderCtx.Trigger.Reason = event.Status
+	renderCtx.Quota.ProviderType = event.ProviderType
+	renderCtx.Quota.Status = event.Status
+
+	if event.NextResetAt != nil {
+		renderCtx.Quota.NextResetAt = event.NextResetAt.UTC().Format(time.RFC3339)
+	}
+
+	n.notify(ctx, renderCtx)
+}
+
+func (n *WebhookNotifier) NotifyAPIKeyQuotaExceeded(ctx context.Context, event APIKeyQuotaExceededEvent) {
+	renderCtx := newWebhookRenderContext(EventAPIKeyQuotaExceeded, "warning", event.OccurredAt)
+	renderCtx.Message = fmt.Sprintf("api key %q %s", event.APIKey.Name, event.Message)
+	renderCtx.ProjectID = event.APIKey.ProjectID
+	renderCtx.APIKey.ID = event.APIKey.ID
+	renderCtx.APIKey.Name = event.APIKey.Name
+	renderCtx.Trigger.Type = "api_key_quota"
+	renderCtx.Trigger.Reason = event.Message
+
+	n.notify(ctx, renderCtx)
+}
+
+func (n *WebhookNotifier) NotifyBudgetThresholdCrossed(ctx context.Context, event BudgetThresholdCrossedEvent) {
+	log.Info(ctx, "notify budget threshold crossed",
+		log.Int("budget_id", event.Budget.ID),
+		log.Int("threshold", event.Threshold),
+		log.Float64("spent", event.Spend.Spent),
 	)
 
-	if len(targets) == 0 {
-		return
+	severity := "warning"
+	if event.Spend.Exhausted() {
+		severity = "critical"
 	}
 
-	for _, target := range targets {
-		body, err := renderWebhookTemplate(target.Body, renderCtx)
-		if err != nil {
-			log.Warn(ctx, "failed to render webhook body template",
-				log.String("event", eventName),
-				log.String("target", target.Name),
-				log.Cause(err),
-			)
+	renderCtx := newWebhookRenderContext(EventBudgetThresholdCrossed, severity, event.OccurredAt)
+	renderCtx.ProjectID = event.Budget.ProjectID
+	renderCtx.Trigger.Type = "budget_threshold"
+	renderCtx.Trigger.Threshold = event.Threshold
+	renderCtx.Trigger.Reason = fmt.Sprintf("budget %q spent %.2f%% of %g", event.Budget.Name, event.Spend.Percent, event.Budget.Amount)
+	renderCtx.Message = renderCtx.Trigger.Reason
+	renderCtx.Budget.ID = event.Budget.ID
+	renderCtx.Budget.Name = event.Budget.Name
+	renderCtx.Budget.ProjectID = event.Budget.ProjectID
+	renderCtx.Budget.Scope = event.Budget.Scope.String()
+	renderCtx.Budget.APIKeyID = lo.FromPtr(event.Budget.APIKeyID)
+	renderCtx.Budget.UserID = lo.FromPtr(event.Budget.UserID)
+	renderCtx.Budget.Period = event.Budget.Period.String()
+	renderCtx.Budget.Action = string(event.Budget.Settings.ActionOrDefault())
+	renderCtx.Budget.Amount = event.Budget.Amount
+	renderCtx.Budget.Spent = event.Spend.Spent
+	renderCtx.Budget.Percent = event.Spend.Percent
+	renderCtx.Budget.Forecast = event.Spend.Forecast
+	renderCtx.Budget.PeriodStart = event.Spend.PeriodStart.UTC().Format(time.RFC3339)
+	renderCtx.Budget.PeriodEnd = event.Spend.PeriodEnd.UTC().Format(time.RFC3339)
+
+	n.notify(ctx, renderCtx)
+}
 
-			continue
-		}
+func (n *WebhookNotifier) NotifyWalletLowBalance(ctx context.Context, event WalletLowBalanceEvent) {
+	threshold := lo.FromPtr(event.Wallet.LowBalanceThreshold)
 
-		headers, err := renderWebhookHeaders(target.Headers, renderCtx)
-		if err != nil {
-			log.Warn(ctx, "failed to render webhook headers",
-				log.String("event", eventName),
-				log.String("target", target.Name),
-				log.Cause(err),
-			)
+	severity := "warning"
+	if event.Wallet.Balance <= 0 {
+		severity = "critical"
+	}
 
-			continue
-		}
+	renderCtx := newWebhookRenderContext(EventWalletLowBalance, severity, event.OccurredAt)
+	renderCtx.ProjectID = event.Wallet.ProjectID
+	renderCtx.APIKey.ID = event.APIKey.ID
+	renderCtx.APIKey.Name = event.APIKey.Name
+	renderCtx.Wallet.ID = event.Wallet.ID
+	renderCtx.Wallet.Balance = event.Wallet.Balance
+	renderCtx.Wallet.Reserved = event.Wallet.Reserved
+	renderCtx.Wallet.Threshold = threshold
+	renderCtx.Trigger.Type = "wallet_low_balance"
+	renderCtx.Trigger.Reason = fmt.Sprintf("balance %g is below %g", event.Wallet.Balance, threshold)
+	renderCtx.Message = fmt.Sprintf("wallet of api key %q is low, %s", event.APIKey.Name, renderCtx.Trigger.Reason)
+
+	n.notify(ctx, renderCtx)
+}
+
+func (n *WebhookNotifier) NotifyCircuitBreakerOpened(ctx context.Context, event CircuitBreakerOpenedEvent) {
+	renderCtx := newWebhookRenderContext(EventCircuitBreakerOpened, "warning", event.OccurredAt)
+	renderCtx.setChannel(n.loadChannel(ctx, event.ChannelID))
+	renderCtx.Channel.ID = event.ChannelID
+	renderCtx.Model = event.ModelID
+	renderCtx.Message = fmt.Sprintf("circuit breaker opened for model %q of channel %q, next probe at %s",
+		event.ModelID, renderCtx.Channel.Name, event.NextProbeAt.UTC().Format(time.RFC3339))
+	renderCtx.Trigger.Type = "circuit_breaker"
+	renderCtx.Trigger.ActualCount = event.ConsecutiveFailures
+
+	n.notify(ctx, renderCtx)
+}
+
+func (n *WebhookNotifier) NotifyBackupFailed(ctx context.Context, event BackupFailedEvent) {
+	renderCtx := newWebhookRenderContext(EventBackupFailed, "critical", event.OccurredAt)
+	renderCtx.Message = "auto backup failed: " + event.Error
+	renderCtx.Trigger.Type = "backup"
+	renderCtx.Trigger.Reason = event.Error
+
+	n.notify(ctx, renderCtx)
+}
+
+func (n *WebhookNotifier) NotifyUserRegistered(ctx context.Context, event UserRegisteredEvent) {
+	renderCtx := newWebhookRenderContext(EventUserRegistered, "info", event.OccurredAt)
+	renderCtx.Message = fmt.Sprintf("user %q registered", event.User.Email)
+	renderCtx.Trigger.Type = "user_registration"
+	renderCtx.User.ID = event.User.ID
+	renderCtx.User.Email = event.User.Email
+	renderCtx.User.FirstName = event.User.FirstName
+	renderCtx.User.LastName = event.User.LastName
+
+	n.notify(ctx, renderCtx)
+}
+
+// UserHook notifies the users created, after the transaction commits if the user is created in one.
+func (n *WebhookNotifier) UserHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			value, err := next.Mutate(ctx, m)
+			if err != nil || m.Type() != ent.TypeUser || !m.Op().Is(ent.OpCreate) {
+				return value, err
+			}
+
+			u, ok := value.(*ent.User)
+			if !ok {
+				return value, nil
+			}
+
+			notify := func() {
+				go n.NotifyUserRegistered(context.WithoutCancel(ctx), UserRegisteredEvent{User: u, OccurredAt: time.Now()})
+			}
+
+			um, ok := m.(*ent.UserMutation)
+			if !ok {
+				return value, nil
+			}
+
+			tx, txErr := um.Tx()
+			if txErr != nil {
+				notify()
+
+				return value, nil
+			}
+
+			tx.OnCommit(func(next ent.Committer) ent.Committer {
+				return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+					if err := next.Commit(ctx, tx); err != nil {
+						return err
+					}
+
+					notify()
+
+					return nil
+				})
+			})
+
+			return value, nil
+		})
+	}
+}
+
+// SendTestEvent delivers a sample event to the target, regardless of the subscriptions and whether the target is enabled.
+func (n *WebhookNotifier) SendTestEvent(ctx context.Context, targetName, event string) (*ent.WebhookDelivery, error) {
+	cfg, err := n.SystemService.WebhookNotifierConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	target, ok := lo.Find(cfg.Targets, func(item WebhookTarget) bool {
+		return item.Name == targetName
+	})
+	if !ok {
+		return nil, fmt.Errorf("webhook target %q not found", targetName)
+	}
+
+	if strings.TrimSpace(target.URL) == "" {
+		return nil, fmt.Errorf("webhook target %q has no url", targetName)
+	}
+
+	if event == "" {
+		event = EventTest
+	}
+
+	renderCtx := newWebhookRenderContext(event, "info", time.Now())
+	renderCtx.Message = "This is a test event from AxonHub."
+	renderCtx.Trigger.Type = "test"
+
+	// The test event is attempted once, the failure is recorded in the returned delivery.
+	delivery, err := n.deliver(authz.WithSystemBypass(ctx, "webhook-test-event"), target, renderCtx, 1)
+	if delivery == nil || delivery.ID == 0 {
+		return delivery, err
+	}
+
+	return delivery, nil
+}
+
+func (n *WebhookNotifier) notify(ctx context.Context, renderCtx WebhookRenderContext) {
+	ctx = authz.WithSystemBypass(context.WithoutCancel(ctx), "webhook-notifier")
+	cfg := *n.SystemService.WebhookNotifierConfigOrDefault(ctx)
+	targets := n.selectTargets(cfg, renderCtx)
+
+	log.Debug(ctx, "notify webhook",
+		log.Any("cfg", cfg),
+		log.String("event_name", renderCtx.Event),
+		log.Any("targets", targets),
+	)
 
-		if err := n.send(ctx, target, body, headers); err != nil {
+	for _, target := range targets {
+		if _, err := n.deliver(ctx, target, renderCtx, webhookDeliveryMaxAttempts); err != nil {
 			log.Warn(ctx, "failed to send webhook notification",
-				log.String("event", eventName),
+				log.String("event", renderCtx.Event),
 				log.String("target", target.Name),
 				log.Cause(err),
 			)
@@ -141,15 +564,17 @@ func (n *WebhookNotifier) notify(ctx context.Context, eventName string, renderCt
 	}
 }
 
-func (n *WebhookNotifier) selectTargets(cfg WebhookNotifierConfig, eventName string) []WebhookTarget {
-	subscription, ok := lo.Find(cfg.Subscriptions, func(item WebhookSubscription) bool {
-		return item.Event == eventName
-	})
-	if !ok {
-		return nil
+// selectTargets collects the enabled targets of all the subscriptions matching the event.
+func (n *WebhookNotifier) selectTargets(cfg WebhookNotifierConfig, renderCtx WebhookRenderContext) []WebhookTarget {
+	var names []string
+
+	for _, subscription := range cfg.Subscriptions {
+		if subscription.matches(renderCtx) {
+			names = append(names, subscription.TargetNames...)
+		}
 	}
 
-	names := subscription.TargetNames
+	names = lo.Uniq(names)
 	if len(names) == 0 || len(cfg.Targets) == 0 {
 		return nil
 	}
@@ -169,7 +594,203 @@ func (n *WebhookNotifier) selectTargets(cfg WebhookNotifierConfig, eventName str
 	return targets
 }
 
-func (n *WebhookNotifier) send(ctx context.Context, target WebhookTarget, body string, headers http.Header) error {
+func (s WebhookSubscription) matches(renderCtx WebhookRenderContext) bool {
+	if !matchWebhookEvent(s.Event, renderCtx.Event) {
+		return false
+	}
+
+	if len(s.ProjectIDs) > 0 && renderCtx.ProjectID != 0 && !lo.Contains(s.ProjectIDs, renderCtx.ProjectID) {
+		return false
+	}
+
+	if len(s.ChannelIDs) > 0 && renderCtx.Channel.ID != 0 && !lo.Contains(s.ChannelIDs, renderCtx.Channel.ID) {
+		return false
+	}
+
+	if len(s.Severities) > 0 && !lo.Contains(s.Severities, renderCtx.Severity) {
+		return false
+	}
+
+	return true
+}
+
+// matchWebhookEvent matches the event name against "*", an exact name or a prefix wildcard like "channel.*".
+func matchWebhookEvent(pattern, event string) bool {
+	if pattern == "*" || pattern == event {
+		return true
+	}
+
+	prefix, ok := strings.CutSuffix(pattern, "*")
+
+	return ok && prefix != "" && strings.HasPrefix(event, prefix)
+}
+
+// deliver renders the event for the target, persists the delivery and makes the first attempt.
+// Rendering errors are not retried as the same templates would fail again.
+func (n *WebhookNotifier) deliver(ctx context.Context, target WebhookTarget, renderCtx WebhookRenderContext, maxAttempts int) (*ent.WebhookDelivery, error) {
+	body, err := renderWebhookBody(target.Body, renderCtx)
+	if err != nil {
+		return nil, fmt.Errorf("render body: %w", err)
+	}
+
+	headers, err := renderWebhookHeaders(target.Headers, renderCtx)
+	if err != nil {
+		return nil, fmt.Errorf("render headers: %w", err)
+	}
+
+	now := time.Now()
+	delivery := &ent.WebhookDelivery{
+		EventID:       renderCtx.ID,
+		Event:         renderCtx.Event,
+		TargetName:    target.Name,
+		URL:           target.URL,
+		Payload:       body,
+		Headers:       headerEntries(headers),
+		Status:        webhookdelivery.StatusPending,
+		NextAttemptAt: now.Add(webhookDeliveryLease),
+	}
+
+	if n.AbstractService != nil {
+		created, err := n.db.WebhookDelivery.Create().
+			SetEventID(delivery.EventID).
+			SetEvent(delivery.Event).
+			SetTargetName(delivery.TargetName).
+			SetURL(delivery.URL).
+			SetPayload(delivery.Payload).
+			SetHeaders(delivery.Headers).
+			SetNextAttemptAt(delivery.NextAttemptAt).
+			Save(ctx)
+		if err != nil {
+			// Still try to deliver once, the notification is more important than the delivery log.
+			log.Warn(ctx, "failed to persist webhook delivery", log.String("target", target.Name), log.Cause(err))
+		} else {
+			delivery = created
+		}
+	}
+
+	return n.attempt(ctx, target, delivery, maxAttempts)
+}
+
+// RetryDeliveries attempts the pending deliveries that are due.
+// A delivery is claimed by moving its next attempt time forward, so only one instance attempts it.
+func (n *WebhookNotifier) RetryDeliveries(ctx context.Context) error {
+	ctx = authz.WithSystemBypass(ctx, "webhook-delivery-retry")
+	now := time.Now()
+
+	deliveries, err := n.db.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(webhookDeliveryBatchSize).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query pending webhook deliveries: %w", err)
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	cfg := n.SystemService.WebhookNotifierConfigOrDefault(ctx)
+
+	for _, delivery := range deliveries {
+		claimed, err := n.db.WebhookDelivery.Update().
+			Where(
+				webhookdelivery.ID(delivery.ID),
+				webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+				webhookdelivery.NextAttemptAtLTE(now),
+			).
+			SetNextAttemptAt(now.Add(webhookDeliveryLease)).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to claim webhook delivery: %w", err)
+		}
+
+		if claimed == 0 {
+			continue
+		}
+
+		target, ok := lo.Find(cfg.Targets, func(item WebhookTarget) bool {
+			return item.Name == delivery.TargetName
+		})
+		if !ok || !target.Enabled {
+			if err := n.db.WebhookDelivery.UpdateOneID(delivery.ID).
+				SetStatus(webhookdelivery.StatusFailed).
+				SetLastError("webhook target is removed or disabled").
+				Exec(ctx); err != nil {
+				log.Warn(ctx, "failed to update webhook delivery", log.Int("delivery_id", delivery.ID), log.Cause(err))
+			}
+
+			continue
+		}
+
+		if _, err := n.attempt(ctx, target, delivery, webhookDeliveryMaxAttempts); err != nil {
+			log.Warn(ctx, "failed to retry webhook delivery",
+				log.Int("delivery_id", delivery.ID),
+				log.String("target", target.Name),
+				log.Cause(err),
+			)
+		}
+	}
+
+	return nil
+}
+
+// attempt sends the delivery and records the result, the delivery fails after maxAttempts attempts.
+// The returned error is the error of the attempt.
+func (n *WebhookNotifier) attempt(ctx context.Context, target WebhookTarget, delivery *ent.WebhookDelivery, maxAttempts int) (*ent.WebhookDelivery, error) {
+	statusCode, sendErr := n.send(ctx, target, delivery)
+
+	// The delivery was not persisted.
+	if delivery.ID == 0 {
+		return delivery, sendErr
+	}
+
+	now := time.Now()
+	attempts := delivery.Attempts + 1
+
+	update := n.db.WebhookDelivery.UpdateOneID(delivery.ID).
+		SetAttempts(attempts)
+	if statusCode != 0 {
+		update.SetResponseStatus(statusCode)
+	}
+
+	switch {
+	case sendErr == nil:
+		update.SetStatus(webhookdelivery.StatusSucceeded).
+			SetDeliveredAt(now).
+			SetLastError("")
+	case attempts >= maxAttempts:
+		update.SetStatus(webhookdelivery.StatusFailed).
+			SetLastError(sendErr.Error())
+	default:
+		update.SetNextAttemptAt(now.Add(webhookRetryBackoff(attempts))).
+			SetLastError(sendErr.Error())
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		log.Warn(ctx, "failed to update webhook delivery", log.Int("delivery_id", delivery.ID), log.Cause(err))
+
+		return delivery, sendErr
+	}
+
+	return updated, sendErr
+}
+
+// webhookRetryBackoff returns the delay before the next attempt after the given number of attempts.
+func webhookRetryBackoff(attempts int) time.Duration {
+	backoff := webhookDeliveryBaseBackoff
+	for i := 1; i < attempts && backoff < webhookDeliveryMaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, webhookDeliveryMaxBackoff)
+}
+
+func (n *WebhookNotifier) send(ctx context.Context, target WebhookTarget, delivery *ent.WebhookDelivery) (int, error) {
 	timeout := time.Duration(target.TimeoutMs) * time.Millisecond
 	if timeout <= 0 {
 		timeout = defaultWebhookTimeoutMs * time.Millisecond
@@ -178,27 +799,99 @@ func (n *WebhookNotifier) send(ctx context.Context, target WebhookTarget, body s
 	reqCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	headers := make(http.Header, len(delivery.Headers)+4)
+	for _, header := range delivery.Headers {
+		headers.Set(header.Key, header.Value)
+	}
+
 	if headers.Get("Content-Type") == "" {
 		headers.Set("Content-Type", "application/json")
 	}
 
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	headers.Set(WebhookHeaderEvent, delivery.Event)
+	headers.Set(WebhookHeaderTimestamp, timestamp)
+
+	if delivery.ID != 0 {
+		headers.Set(WebhookHeaderDelivery, strconv.Itoa(delivery.ID))
+	}
+
+	if target.Secret != "" {
+		headers.Set(WebhookHeaderSignature, SignWebhookPayload(target.Secret, timestamp, delivery.Payload))
+	}
+
 	client := n.httpClient
 	if target.Proxy != nil {
 		client = client.WithProxy(target.Proxy)
 	}
 
-	_, err := client.Do(reqCtx, &httpclient.Request{
+	resp, err := client.Do(reqCtx, &httpclient.Request{
 		Method:      defaultWebhookMethod,
-		URL:         target.URL,
+		URL:         delivery.URL,
 		Headers:     headers,
 		ContentType: headers.Get("Content-Type"),
-		Body:        []byte(body),
+		Body:        []byte(delivery.Payload),
 	})
 	if err != nil {
-		return fmt.Errorf("do request: %w", err)
+		var httpErr *httpclient.Error
+		if errors.As(err, &httpErr) {
+			return httpErr.StatusCode, fmt.Errorf("do request: %w", err)
+		}
+
+		return 0, fmt.Errorf("do request: %w", err)
 	}
 
-	return nil
+	return resp.StatusCode, nil
+}
+
+// SignWebhookPayload returns the X-AxonHub-Signature header value, the hex HMAC-SHA256 of "<timestamp>.<body>".
+func SignWebhookPayload(secret, timestamp, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write([]byte(body))
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (n *WebhookNotifier) loadChannel(ctx context.Context, channelID int) *ent.Channel {
+	if n.AbstractService == nil {
+		return nil
+	}
+
+	ch, err := n.db.Channel.Get(authz.WithSystemBypass(ctx, "webhook-notifier"), channelID)
+	if err != nil {
+		log.Warn(ctx, "failed to load channel for webhook notification", log.Int("channel_id", channelID), log.Cause(err))
+
+		return nil
+	}
+
+	return ch
+}
+
+func headerEntries(headers http.Header) []objects.HeaderEntry {
+	entries := make([]objects.HeaderEntry, 0, len(headers))
+	for key, values := range headers {
+		for _, value := range values {
+			entries = append(entries, objects.HeaderEntry{Key: key, Value: value})
+		}
+	}
+
+	return entries
+}
+
+// renderWebhookBody renders the body template of the target, the whole event is sent as JSON when the template is empty.
+func renderWebhookBody(body string, renderCtx WebhookRenderContext) (string, error) {
+	if strings.TrimSpace(body) == "" {
+		data, err := json.Marshal(renderCtx)
+		if err != nil {
+			return "", err
+		}
+
+		return string(data), nil
+	}
+
+	return renderWebhookTemplate(body, renderCtx)
 }
 
 func renderWebhookHeaders(headers []objects.HeaderEntry, renderCtx WebhookRenderContext) (http.Header, error) {
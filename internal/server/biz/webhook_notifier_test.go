@@ -2,6 +2,7 @@ package biz
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -13,6 +14,7 @@ import (
 	"github.com/looplj/axonhub/internal/authz"
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/ent/webhookdelivery"
 	"github.com/looplj/axonhub/internal/objects"
 	"github.com/looplj/axonhub/internal/pkg/xcache"
 	"github.com/looplj/axonhub/llm/httpclient"
@@ -152,7 +154,7 @@ func TestWebhookNotifier_SelectTargetsSkipsInvalidTargets(t *testing.T) {
 		Subscriptions: []WebhookSubscription{
 			{Event: EventChannelAutoDisabled, TargetNames: []string{"a", "b", "c", "missing"}},
 		},
-	}, EventChannelAutoDisabled)
+	}, WebhookRenderContext{Event: EventChannelAutoDisabled})
 
 	require.Len(t, targets, 1)
 	require.Equal(t, "a", targets[0].Name)
@@ -163,3 +165,162 @@ func TestRenderWebhookTemplate_NoTemplate(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "plain text", result)
 }
+
+func TestWebhookNotifier_SignsAndRetriesDeliveries(t *testing.T) {
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=0")
+	defer client.Close()
+
+	var (
+		calls      int
+		bodies     []string
+		signatures []string
+		timestamps []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		calls++
+		bodies = append(bodies, string(body))
+		signatures = append(signatures, r.Header.Get(WebhookHeaderSignature))
+		timestamps = append(timestamps, r.Header.Get(WebhookHeaderTimestamp))
+
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	systemService := newTestSystemServiceWithWebhookConfig(t, client, WebhookNotifierConfig{
+		Targets: []WebhookTarget{
+			{Name: "signed", Enabled: true, URL: server.URL, Secret: "s3cret"},
+		},
+		Subscriptions: []WebhookSubscription{
+			{Event: "backup.*", TargetNames: []string{"signed"}},
+		},
+	})
+	notifier := NewWebhookNotifier(systemService, httpclient.NewHttpClient())
+
+	ctx := authz.WithTestBypass(ent.NewContext(context.Background(), client))
+
+	notifier.NotifyBackupFailed(ctx, BackupFailedEvent{Error: "disk full", OccurredAt: time.Now()})
+
+	require.Equal(t, 1, calls)
+	require.Contains(t, bodies[0], `"event":"backup.failed"`)
+	require.Contains(t, bodies[0], `"reason":"disk full"`)
+	require.Equal(t, SignWebhookPayload("s3cret", timestamps[0], bodies[0]), signatures[0])
+
+	delivery, err := client.WebhookDelivery.Query().Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, webhookdelivery.StatusPending, delivery.Status)
+	require.Equal(t, 1, delivery.Attempts)
+	require.Equal(t, http.StatusBadGateway, *delivery.ResponseStatus)
+	require.NotEmpty(t, delivery.LastError)
+
+	// Not due yet.
+	require.NoError(t, notifier.RetryDeliveries(ctx))
+	require.Equal(t, 1, calls)
+
+	_, err = client.WebhookDelivery.UpdateOneID(delivery.ID).SetNextAttemptAt(time.Now().Add(-time.Second)).Save(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, notifier.RetryDeliveries(ctx))
+	require.Equal(t, 2, calls)
+	require.Equal(t, bodies[0], bodies[1])
+	require.Equal(t, SignWebhookPayload("s3cret", timestamps[1], bodies[1]), signatures[1])
+
+	delivery, err = client.WebhookDelivery.Get(ctx, delivery.ID)
+	require.NoError(t, err)
+	require.Equal(t, webhookdelivery.StatusSucceeded, delivery.Status)
+	require.Equal(t, 2, delivery.Attempts)
+	require.NotNil(t, delivery.DeliveredAt)
+	require.Empty(t, delivery.LastError)
+}
+
+func TestWebhookNotifier_SendTestEvent(t *testing.T) {
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=0")
+	defer client.Close()
+
+	var receivedBody string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		receivedBody = string(body)
+
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	systemService := newTestSystemServiceWithWebhookConfig(t, client, WebhookNotifierConfig{
+		Targets: []WebhookTarget{
+			{Name: "disabled", Enabled: false, URL: server.URL},
+		},
+	})
+	notifier := NewWebhookNotifier(systemService, httpclient.NewHttpClient())
+
+	ctx := authz.WithTestBypass(ent.NewContext(context.Background(), client))
+
+	_, err := notifier.SendTestEvent(ctx, "missing", "")
+	require.Error(t, err)
+
+	delivery, err := notifier.SendTestEvent(ctx, "disabled", EventBudgetThresholdCrossed)
+	require.NoError(t, err)
+	require.Equal(t, EventBudgetThresholdCrossed, delivery.Event)
+	require.Equal(t, webhookdelivery.StatusFailed, delivery.Status)
+	require.Equal(t, http.StatusInternalServerError, *delivery.ResponseStatus)
+
+	var event WebhookRenderContext
+	require.NoError(t, json.Unmarshal([]byte(receivedBody), &event))
+	require.Equal(t, EventBudgetThresholdCrossed, event.Event)
+	require.Equal(t, "test", event.Trigger.Type)
+	require.NotEmpty(t, event.ID)
+}
+
+func TestWebhookSubscription_Matches(t *testing.T) {
+	renderCtx := WebhookRenderContext{Event: EventChannelDisabled, Severity: "warning", ProjectID: 1}
+	renderCtx.Channel.ID = 2
+
+	require.True(t, WebhookSubscription{Event: "*"}.matches(renderCtx))
+	require.True(t, WebhookSubscription{Event: "channel.*"}.matches(renderCtx))
+	require.False(t, WebhookSubscription{Event: "budget.*"}.matches(renderCtx))
+	require.False(t, WebhookSubscription{Event: EventChannelEnabled}.matches(renderCtx))
+	require.True(t, WebhookSubscription{Event: "*", ProjectIDs: []int{1}, ChannelIDs: []int{2, 3}}.matches(renderCtx))
+	require.False(t, WebhookSubscription{Event: "*", ProjectIDs: []int{3}}.matches(renderCtx))
+	require.False(t, WebhookSubscription{Event: "*", ChannelIDs: []int{3}}.matches(renderCtx))
+	require.True(t, WebhookSubscription{Event: "*", Severities: []string{"warning", "critical"}}.matches(renderCtx))
+	require.False(t, WebhookSubscription{Event: "*", Severities: []string{"critical"}}.matches(renderCtx))
+
+	// Events not bound to a project or channel are not filtered by them.
+	require.True(t, WebhookSubscription{Event: "*", ProjectIDs: []int{3}, ChannelIDs: []int{3}}.matches(WebhookRenderContext{Event: EventBackupFailed}))
+}
+
+func TestWebhookNotifier_SelectTargetsMergesSubscriptions(t *testing.T) {
+	notifier := &WebhookNotifier{}
+	targets := notifier.selectTargets(WebhookNotifierConfig{
+		Targets: []WebhookTarget{
+			{Name: "a", Enabled: true, URL: "https://a.example.com"},
+			{Name: "b", Enabled: true, URL: "https://b.example.com"},
+		},
+		Subscriptions: []WebhookSubscription{
+			{Event: "*", TargetNames: []string{"a"}},
+			{Event: EventUserRegistered, TargetNames: []string{"a", "b"}},
+		},
+	}, WebhookRenderContext{Event: EventUserRegistered})
+
+	require.Len(t, targets, 2)
+	require.Equal(t, "a", targets[0].Name)
+	require.Equal(t, "b", targets[1].Name)
+}
+
+func TestWebhookRetryBackoff(t *testing.T) {
+	require.Equal(t, 30*time.Second, webhookRetryBackoff(1))
+	require.Equal(t, time.Minute, webhookRetryBackoff(2))
+	require.Equal(t, 8*time.Minute, webhookRetryBackoff(5))
+	require.Equal(t, time.Hour, webhookRetryBackoff(20))
+}
@@ -0,0 +1,454 @@
+package gc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/datastorage"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xjson"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// archiveRequests moves the bodies of the finished requests older than the archive days to archive objects
+// in the archive data storage, one object per project and day of each batch. Only the metadata stays in the database.
+func (w *Worker) archiveRequests(ctx context.Context, policy *biz.RequestArchivePolicy) (int, error) {
+	if policy.ArchiveDays <= 0 {
+		log.Debug(ctx, "No archive needed for requests")
+		return 0, nil
+	}
+
+	archiveStorage, err := w.DataStorageService.GetDataStorageByID(ctx, policy.DataStorageID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get archive data storage: %w", err)
+	}
+
+	if archiveStorage.Type == datastorage.TypeDatabase {
+		return 0, fmt.Errorf("archive data storage %d is not a file storage", archiveStorage.ID)
+	}
+
+	cutoffTime := time.Now().AddDate(0, 0, -policy.ArchiveDays)
+	cache := make(map[int]*ent.DataStorage)
+	totalArchived := 0
+	lastID := 0
+
+	for {
+		reqs, err := w.Ent.Request.Query().
+			Where(
+				request.IDGT(lastID),
+				request.CreatedAtLT(cutoffTime),
+				request.ArchivedAtIsNil(),
+				request.StatusIn(request.StatusCompleted, request.StatusFailed, request.StatusCanceled),
+			).
+			WithExecutions().
+			Order(ent.Asc(request.FieldID)).
+			Limit(w.getBatchSize()).
+			All(ctx)
+		if err != nil {
+			return totalArchived, fmt.Errorf("failed to query requests to archive: %w", err)
+		}
+
+		if len(reqs) == 0 {
+			break
+		}
+
+		lastID = reqs[len(reqs)-1].ID
+
+		archived, err := w.archiveRequestBatch(ctx, archiveStorage, reqs, cache)
+		totalArchived += archived
+
+		if err != nil {
+			return totalArchived, err
+		}
+	}
+
+	return totalArchived, nil
+}
+
+// archiveRequestBatch writes an archive object for each project and day of the batch, then clears the bodies
+// of the archived requests and removes their external copies.
+func (w *Worker) archiveRequestBatch(
+	ctx context.Context,
+	archiveStorage *ent.DataStorage,
+	reqs []*ent.Request,
+	cache map[int]*ent.DataStorage,
+) (int, error) {
+	type partition struct {
+		projectID int
+		day       string
+	}
+
+	var (
+		partitions []partition
+		groups     = map[partition][]*ent.Request{}
+	)
+
+	for _, req := range reqs {
+		p := partition{projectID: req.ProjectID, day: req.CreatedAt.UTC().Format(time.DateOnly)}
+		if _, ok := groups[p]; !ok {
+			partitions = append(partitions, p)
+		}
+
+		groups[p] = append(groups[p], req)
+	}
+
+	archived := 0
+
+	for _, p := range partitions {
+		group := groups[p]
+
+		entries := make([]*biz.RequestArchiveEntry, 0, len(group))
+
+		for _, req := range group {
+			entry, err := w.buildRequestArchiveEntry(ctx, req, cache)
+			if err != nil {
+				return archived, fmt.Errorf("failed to load request %d to archive: %w", req.ID, err)
+			}
+
+			entries = append(entries, entry)
+		}
+
+		key := biz.GenerateRequestArchiveKey(p.projectID, group[0].CreatedAt, group[0].ID, group[len(group)-1].ID)
+
+		if err := w.DataStorageService.SaveRequestArchive(ctx, archiveStorage, key, entries); err != nil {
+			return archived, fmt.Errorf("failed to save request archive %s: %w", key, err)
+		}
+
+		if err := w.moveRequestContent(ctx, archiveStorage, group, cache); err != nil {
+			return archived, err
+		}
+
+		if err := w.markRequestsArchived(ctx, archiveStorage, key, group); err != nil {
+			return archived, err
+		}
+
+		for _, req := range group {
+			w.cleanupRequestExternalStorage(ctx, req, cache)
+			w.cleanupMovedContent(ctx, archiveStorage, req, cache)
+
+			for _, exec := range req.Edges.Executions {
+				w.cleanupExecutionExternalStorage(ctx, exec, cache)
+			}
+		}
+
+		archived += len(group)
+
+		log.Debug(ctx, "Archived requests",
+			log.Int("project_id", p.projectID),
+			log.String("key", key),
+			log.Int("archived_count", len(group)),
+		)
+	}
+
+	return archived, nil
+}
+
+func (w *Worker) buildRequestArchiveEntry(ctx context.Context, req *ent.Request, cache map[int]*ent.DataStorage) (*biz.RequestArchiveEntry, error) {
+	entry := &biz.RequestArchiveEntry{
+		RequestID: req.ID,
+		ProjectID: req.ProjectID,
+		CreatedAt: req.CreatedAt,
+		ModelID:   req.ModelID,
+		Format:    req.Format,
+		Status:    string(req.Status),
+		Stream:    req.Stream,
+		ChannelID: req.ChannelID,
+		APIKeyID:  req.APIKeyID,
+	}
+
+	ds, err := w.externalDataStorage(ctx, req.DataStorageID, cache)
+	if err != nil {
+		return nil, err
+	}
+
+	if ds == nil {
+		if entry.RequestBody, err = biz.ExpandContentBlobs(ctx, w.Ent, req.RequestBody, req.RequestBodyBlobs); err != nil {
+			return nil, err
+		}
+
+		entry.ResponseBody = req.ResponseBody
+		entry.ResponseChunks = req.ResponseChunks
+	} else {
+		if entry.RequestBody, err = w.loadArchiveData(ctx, ds, biz.GenerateRequestBodyKey(req.ProjectID, req.ID)); err != nil {
+			return nil, err
+		}
+
+		if entry.ResponseBody, err = w.loadArchiveData(ctx, ds, biz.GenerateResponseBodyKey(req.ProjectID, req.ID)); err != nil {
+			return nil, err
+		}
+
+		if entry.ResponseChunks, err = w.loadArchiveChunks(ctx, ds, biz.GenerateResponseChunksKey(req.ProjectID, req.ID)); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, exec := range req.Edges.Executions {
+		archivedExec := &biz.RequestArchiveExecution{ExecutionID: exec.ID}
+
+		ds, err := w.externalDataStorage(ctx, exec.DataStorageID, cache)
+		if err != nil {
+			return nil, err
+		}
+
+		if ds == nil {
+			if archivedExec.RequestBody, err = biz.ExpandContentBlobs(ctx, w.Ent, exec.RequestBody, exec.RequestBodyBlobs); err != nil {
+				return nil, err
+			}
+
+			archivedExec.ResponseBody = exec.ResponseBody
+			archivedExec.ResponseChunks = exec.ResponseChunks
+		} else {
+			if archivedExec.RequestBody, err = w.loadArchiveData(ctx, ds,
+				biz.GenerateExecutionRequestBodyKey(exec.ProjectID, exec.RequestID, exec.ID)); err != nil {
+				return nil, err
+			}
+
+			if archivedExec.ResponseBody, err = w.loadArchiveData(ctx, ds,
+				biz.GenerateExecutionResponseBodyKey(exec.ProjectID, exec.RequestID, exec.ID)); err != nil {
+				return nil, err
+			}
+
+			if archivedExec.ResponseChunks, err = w.loadArchiveChunks(ctx, ds,
+				biz.GenerateExecutionResponseChunksKey(exec.ProjectID, exec.RequestID, exec.ID)); err != nil {
+				return nil, err
+			}
+		}
+
+		entry.Executions = append(entry.Executions, archivedExec)
+	}
+
+	return entry, nil
+}
+
+// externalDataStorage returns the data storage of the bodies, nil when they are stored in the database.
+func (w *Worker) externalDataStorage(ctx context.Context, id int, cache map[int]*ent.DataStorage) (*ent.DataStorage, error) {
+	if id == 0 {
+		return nil, nil
+	}
+
+	ds, err := w.getDataStorageCached(ctx, id, cache)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data storage %d: %w", id, err)
+	}
+
+	if ds.Primary {
+		return nil, nil
+	}
+
+	return ds, nil
+}
+
+// loadArchiveData loads an external body, a missing body is archived as empty.
+func (w *Worker) loadArchiveData(ctx context.Context, ds *ent.DataStorage, key string) (objects.JSONRawMessage, error) {
+	data, err := w.DataStorageService.LoadData(ctx, ds, key)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to load %s: %w", key, err)
+	}
+
+	if !json.Valid(data) {
+		return nil, nil
+	}
+
+	return objects.JSONRawMessage(data), nil
+}
+
+func (w *Worker) loadArchiveChunks(ctx context.Context, ds *ent.DataStorage, key string) ([]objects.JSONRawMessage, error) {
+	data, err := w.loadArchiveData(ctx, ds, key)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	var chunks []objects.JSONRawMessage
+	if err := json.Unmarshal(data, &chunks); err != nil {
+		log.Warn(ctx, "Failed to unmarshal response chunks to archive", log.Cause(err), log.String("key", key))
+		return nil, nil
+	}
+
+	return chunks, nil
+}
+
+// moveRequestContent copies the generated content files of the requests to the archive data storage, under the same keys.
+func (w *Worker) moveRequestContent(ctx context.Context, archiveStorage *ent.DataStorage, reqs []*ent.Request, cache map[int]*ent.DataStorage) error {
+	for _, req := range reqs {
+		if !contentMoved(archiveStorage, req) {
+			continue
+		}
+
+		ds, err := w.getDataStorageCached(ctx, *req.ContentStorageID, cache)
+		if err != nil {
+			return fmt.Errorf("failed to get content data storage of request %d: %w", req.ID, err)
+		}
+
+		data, err := w.DataStorageService.LoadData(ctx, ds, *req.ContentStorageKey)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			return fmt.Errorf("failed to load content of request %d: %w", req.ID, err)
+		}
+
+		if err := w.DataStorageService.SaveData(ctx, archiveStorage, *req.ContentStorageKey, data); err != nil {
+			return fmt.Errorf("failed to archive content of request %d: %w", req.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// markRequestsArchived points the requests to the archive object and clears the bodies kept in the database.
+func (w *Worker) markRequestsArchived(ctx context.Context, archiveStorage *ent.DataStorage, key string, reqs []*ent.Request) error {
+	ids := make([]int, len(reqs))
+
+	var (
+		contentIDs []int
+		bodies     []objects.JSONRawMessage
+	)
+
+	for i, req := range reqs {
+		ids[i] = req.ID
+		if req.RequestBodyBlobs {
+			bodies = append(bodies, req.RequestBody)
+		}
+
+		for _, exec := range req.Edges.Executions {
+			if exec.RequestBodyBlobs {
+				bodies = append(bodies, exec.RequestBody)
+			}
+		}
+
+		if contentMoved(archiveStorage, req) {
+			contentIDs = append(contentIDs, req.ID)
+		}
+	}
+
+	tx, err := w.Ent.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start archive transaction: %w", err)
+	}
+
+	now := time.Now()
+
+	err = func() error {
+		if err := biz.ReleaseContentBlobs(ctx, tx.Client(), bodies...); err != nil {
+			return err
+		}
+
+		if err := tx.Request.Update().
+			Where(request.IDIn(ids...)).
+			SetArchivedAt(now).
+			SetArchiveStorageID(archiveStorage.ID).
+			SetArchiveKey(key).
+			SetRequestBody(xjson.EmptyJSONRawMessage).
+			SetRequestBodyBlobs(false).
+			ClearResponseBody().
+			ClearResponseChunks().
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to mark requests archived: %w", err)
+		}
+
+		if len(contentIDs) > 0 {
+			if err := tx.Request.Update().
+				Where(request.IDIn(contentIDs...)).
+				SetContentStorageID(archiveStorage.ID).
+				Exec(ctx); err != nil {
+				return fmt.Errorf("failed to move archived request content: %w", err)
+			}
+		}
+
+		if err := tx.RequestExecution.Update().
+			Where(requestexecution.RequestIDIn(ids...)).
+			SetRequestBody(xjson.EmptyJSONRawMessage).
+			SetRequestBodyBlobs(false).
+			ClearResponseBody().
+			ClearResponseChunks().
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to clear archived request executions: %w", err)
+		}
+
+		return nil
+	}()
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit archive transaction: %w", err)
+	}
+
+	return nil
+}
+
+// contentMoved reports whether the content file of the request was copied to the archive data storage.
+func contentMoved(archiveStorage *ent.DataStorage, req *ent.Request) bool {
+	return req.ContentSaved && req.ContentStorageID != nil && req.ContentStorageKey != nil &&
+		*req.ContentStorageID != archiveStorage.ID
+}
+
+// cleanupMovedContent removes the content file from its original storage once the request points to the archive.
+func (w *Worker) cleanupMovedContent(ctx context.Context, archiveStorage *ent.DataStorage, req *ent.Request, cache map[int]*ent.DataStorage) {
+	if !contentMoved(archiveStorage, req) {
+		return
+	}
+
+	ds, err := w.getDataStorageCached(ctx, *req.ContentStorageID, cache)
+	if err != nil {
+		log.Warn(ctx, "Failed to load content data storage for archive cleanup", log.Cause(err), log.Int("request_id", req.ID))
+		return
+	}
+
+	if err := w.DataStorageService.DeleteData(ctx, ds, *req.ContentStorageKey); err != nil {
+		log.Warn(ctx, "Failed to delete archived request content", log.Cause(err), log.Int("request_id", req.ID))
+	}
+}
+
+// cleanupUnreferencedArchives deletes the archive objects of the deleted requests that are no longer referenced by any request.
+func (w *Worker) cleanupUnreferencedArchives(ctx context.Context, deleted []*ent.Request, cache map[int]*ent.DataStorage) {
+	seen := map[string]struct{}{}
+
+	for _, req := range deleted {
+		if req.ArchiveStorageID == nil || req.ArchiveKey == nil {
+			continue
+		}
+
+		key := *req.ArchiveKey
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+
+		referenced, err := w.Ent.Request.Query().Where(request.ArchiveKey(key)).Exist(ctx)
+		if err != nil {
+			log.Warn(ctx, "Failed to check request archive references", log.Cause(err), log.String("key", key))
+			continue
+		}
+
+		if referenced {
+			continue
+		}
+
+		ds, err := w.getDataStorageCached(ctx, *req.ArchiveStorageID, cache)
+		if err != nil {
+			log.Warn(ctx, "Failed to load archive data storage for cleanup", log.Cause(err), log.String("key", key))
+			continue
+		}
+
+		if err := w.DataStorageService.DeleteData(ctx, ds, key); err != nil {
+			log.Warn(ctx, "Failed to delete request archive", log.Cause(err), log.String("key", key))
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package gc
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+func TestWorker_archiveRequests(t *testing.T) {
+	worker, ctx, dataStorage, baseDir := setupWorkerWithFSStorage(t)
+	client := worker.Ent
+
+	proj, err := client.Project.Create().SetName("archive").Save(ctx)
+	require.NoError(t, err)
+
+	createRequest := func(createdAt time.Time, status request.Status) *ent.Request {
+		req, err := client.Request.Create().
+			SetCreatedAt(createdAt).
+			SetProjectID(proj.ID).
+			SetSource(request.SourceAPI).
+			SetModelID("gpt-4o").
+			SetFormat("openai/chat_completions").
+			SetRequestBody(objects.JSONRawMessage(`{"model":"gpt-4o"}`)).
+			SetResponseBody(objects.JSONRawMessage(`{"id":"resp"}`)).
+			SetResponseChunks([]objects.JSONRawMessage{objects.JSONRawMessage(`{"delta":"hi"}`)}).
+			SetStream(true).
+			SetStatus(status).
+			Save(ctx)
+		require.NoError(t, err)
+
+		return req
+	}
+
+	old := time.Now().AddDate(0, 0, -40)
+	archivedReq := createRequest(old, request.StatusCompleted)
+	processingReq := createRequest(old, request.StatusProcessing)
+	recentReq := createRequest(time.Now(), request.StatusCompleted)
+
+	exec, err := client.RequestExecution.Create().
+		SetProjectID(proj.ID).
+		SetRequestID(archivedReq.ID).
+		SetModelID("gpt-4o").
+		SetFormat("openai/chat_completions").
+		SetRequestBody(objects.JSONRawMessage(`{"model":"upstream"}`)).
+		SetResponseBody(objects.JSONRawMessage(`{"id":"upstream"}`)).
+		SetStatus(requestexecution.StatusCompleted).
+		Save(ctx)
+	require.NoError(t, err)
+
+	archived, err := worker.archiveRequests(ctx, &biz.RequestArchivePolicy{
+		Enabled:       true,
+		ArchiveDays:   30,
+		DataStorageID: dataStorage.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, archived)
+
+	for _, req := range []*ent.Request{processingReq, recentReq} {
+		req, err := client.Request.Get(ctx, req.ID)
+		require.NoError(t, err)
+		require.Nil(t, req.ArchivedAt)
+	}
+
+	req, err := client.Request.Get(ctx, archivedReq.ID)
+	require.NoError(t, err)
+	require.NotNil(t, req.ArchivedAt)
+	require.Equal(t, dataStorage.ID, *req.ArchiveStorageID)
+	require.Equal(t, biz.GenerateRequestArchiveKey(proj.ID, old, req.ID, req.ID), *req.ArchiveKey)
+	require.True(t, strings.Contains(*req.ArchiveKey, "/date="+old.UTC().Format(time.DateOnly)+"/"))
+	require.JSONEq(t, `{}`, string(req.RequestBody))
+	require.Nil(t, req.ResponseBody)
+	require.Empty(t, req.ResponseChunks)
+
+	_, err = os.Stat(pathForKey(baseDir, *req.ArchiveKey))
+	require.NoError(t, err)
+
+	archivedExec, err := client.RequestExecution.Get(ctx, exec.ID)
+	require.NoError(t, err)
+	require.Nil(t, archivedExec.ResponseBody)
+
+	cacheConfig := xcache.Config{Mode: xcache.ModeMemory}
+	requestService := biz.NewRequestService(client, cacheConfig, worker.DataStorageService.SystemService, nil, worker.DataStorageService, nil)
+
+	t.Run("archived bodies are loaded transparently", func(t *testing.T) {
+		body, err := requestService.LoadRequestBody(ctx, req)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"model":"gpt-4o"}`, string(body))
+
+		chunks, err := requestService.LoadResponseChunks(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, chunks, 1)
+		require.JSONEq(t, `{"delta":"hi"}`, string(chunks[0]))
+
+		execBody, err := requestService.LoadRequestExecutionResponseBody(ctx, archivedExec)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"id":"upstream"}`, string(execBody))
+	})
+
+	exportInput := biz.RequestArchiveExportInput{
+		ProjectID: proj.ID,
+		From:      old.AddDate(0, 0, -1),
+		To:        time.Now(),
+		Format:    biz.RequestArchiveExportFormatJSONL,
+	}
+
+	t.Run("export jsonl", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, requestService.ExportArchivedRequests(ctx, exportInput, &buf))
+
+		var entry biz.RequestArchiveEntry
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+		require.Equal(t, req.ID, entry.RequestID)
+		require.Equal(t, "completed", entry.Status)
+		require.Len(t, entry.Executions, 1)
+	})
+
+	t.Run("export parquet", func(t *testing.T) {
+		input := exportInput
+		input.Format = biz.RequestArchiveExportFormatParquet
+
+		var buf bytes.Buffer
+		require.NoError(t, requestService.ExportArchivedRequests(ctx, input, &buf))
+
+		file, err := parquet.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.NoError(t, err)
+		require.EqualValues(t, 1, file.NumRows())
+	})
+
+	t.Run("unreferenced archives are deleted with the requests", func(t *testing.T) {
+		_, err := worker.cleanupOldRequestExecutions(ctx, time.Now().AddDate(0, 0, -30), cleanupScope{})
+		require.NoError(t, err)
+
+		deleted, err := worker.cleanupOldRequestsRecords(ctx, time.Now().AddDate(0, 0, -30), cleanupScope{})
+		require.NoError(t, err)
+		require.Equal(t, 2, deleted)
+
+		_, err = os.Stat(pathForKey(baseDir, *req.ArchiveKey))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -10,16 +10,22 @@ import (
 
 	entsql "entgo.io/ent/dialect/sql"
 
+	"github.com/looplj/axonhub/internal/authz"
 	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/auditlog"
 	"github.com/looplj/axonhub/internal/ent/channelprobe"
+	"github.com/looplj/axonhub/internal/ent/contentblob"
 	"github.com/looplj/axonhub/internal/ent/datastorage"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/ent/requestsearchterm"
 	"github.com/looplj/axonhub/internal/ent/schema/schematype"
 	"github.com/looplj/axonhub/internal/ent/thread"
 	"github.com/looplj/axonhub/internal/ent/trace"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
+	"github.com/looplj/axonhub/internal/ent/webhookdelivery"
 	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
 	"github.com/looplj/axonhub/internal/server/biz"
 	"github.com/looplj/axonhub/internal/server/scheduler"
 )
@@ -47,6 +53,7 @@ type Config struct {
 type Worker struct {
 	SystemService      *biz.SystemService
 	DataStorageService *biz.DataStorageService
+	UsageRollupService *biz.UsageRollupService
 	Ent                *ent.Client
 	Config             Config
 }
@@ -57,6 +64,7 @@ type Params struct {
 	Config             Config
 	SystemService      *biz.SystemService
 	DataStorageService *biz.DataStorageService
+	UsageRollupService *biz.UsageRollupService
 	Client             *ent.Client
 }
 
@@ -64,6 +72,7 @@ func NewWorker(params Params) *Worker {
 	w := &Worker{
 		SystemService:      params.SystemService,
 		DataStorageService: params.DataStorageService,
+		UsageRollupService: params.UsageRollupService,
 		Ent:                params.Client,
 		Config:             params.Config,
 	}
@@ -74,7 +83,7 @@ func NewWorker(params Params) *Worker {
 func (w *Worker) RegisterScheduledTasks(ctx context.Context, s *scheduler.Scheduler) error {
 	return s.Register(ctx, scheduler.TaskSpec{
 		Name:        "gc",
-		Description: "Garbage collection — cleanup old requests, traces, usage logs, and channel probes",
+		Description: "Garbage collection — cleanup old requests, traces, usage logs, audit logs, and channel probes",
 		CronExpr:    w.Config.CRON,
 		Timezone:    "UTC",
 	}, w.runAutomaticCleanup)
@@ -122,6 +131,26 @@ func (w *Worker) runCleanup(ctx context.Context, manual bool, manualDays map[str
 
 	log.Debug(ctx, "Storage policy for cleanup", log.Any("policy", policy))
 
+	if policy.Archive != nil && policy.Archive.Enabled {
+		archived, err := w.archiveRequests(ctx, policy.Archive)
+		if err != nil {
+			log.Error(ctx, "Failed to archive requests",
+				log.Int("archived_count", archived),
+				log.Cause(err))
+		} else {
+			log.Info(ctx, "Successfully archived requests",
+				log.Int("archived_count", archived),
+				log.Int("archive_days", policy.Archive.ArchiveDays))
+		}
+	}
+
+	retentions, err := w.loadProjectRetentions(ctx, cleanupDaysByResource(policy, manual, manualDays))
+	if err != nil {
+		// The projects keeping their data longer can not be skipped, so nothing is cleaned up.
+		log.Error(ctx, "Failed to load project retention policies for cleanup", log.Cause(err))
+		return
+	}
+
 	for _, option := range policy.CleanupOptions {
 		if option.Enabled || manual {
 			if manual && manualDays != nil {
@@ -137,7 +166,7 @@ func (w *Worker) runCleanup(ctx context.Context, manual bool, manualDays map[str
 			}
 			switch option.ResourceType {
 			case "requests":
-				err := w.cleanupRequests(ctx, days, manual)
+				err := w.cleanupRequests(ctx, days, manual, retentions)
 				if err != nil {
 					log.Error(ctx, "Failed to cleanup requests",
 						log.String("resource", option.ResourceType),
@@ -148,7 +177,7 @@ func (w *Worker) runCleanup(ctx context.Context, manual bool, manualDays map[str
 						log.Int("cleanup_days", days))
 				}
 
-				err = w.cleanupThreads(ctx, days, manual)
+				err = w.cleanupThreads(ctx, days, manual, retentions.systemScope(biz.RetentionResourceThreads))
 				if err != nil {
 					log.Error(ctx, "Failed to cleanup threads",
 						log.String("resource", "threads"),
@@ -159,7 +188,7 @@ func (w *Worker) runCleanup(ctx context.Context, manual bool, manualDays map[str
 						log.Int("cleanup_days", days))
 				}
 
-				err = w.cleanupTraces(ctx, days, manual)
+				err = w.cleanupTraces(ctx, days, manual, retentions.systemScope(biz.RetentionResourceTraces))
 				if err != nil {
 					log.Error(ctx, "Failed to cleanup traces",
 						log.String("resource", "traces"),
@@ -170,7 +199,7 @@ func (w *Worker) runCleanup(ctx context.Context, manual bool, manualDays map[str
 						log.Int("cleanup_days", days))
 				}
 			case "usage_logs":
-				err := w.cleanupUsageLogs(ctx, days, manual)
+				err := w.cleanupUsageLogs(ctx, days, manual, retentions.systemScope(biz.RetentionResourceUsageLogs))
 				if err != nil {
 					log.Error(ctx, "Failed to cleanup usage logs",
 						log.String("resource", option.ResourceType),
@@ -180,6 +209,17 @@ func (w *Worker) runCleanup(ctx context.Context, manual bool, manualDays map[str
 						log.String("resource", option.ResourceType),
 						log.Int("cleanup_days", days))
 				}
+			case "audit_logs":
+				err := w.cleanupAuditLogs(ctx, days, manual)
+				if err != nil {
+					log.Error(ctx, "Failed to cleanup audit logs",
+						log.String("resource", option.ResourceType),
+						log.Cause(err))
+				} else {
+					log.Info(ctx, "Successfully cleaned up audit logs",
+						log.String("resource", option.ResourceType),
+						log.Int("cleanup_days", days))
+				}
 			default:
 				log.Warn(ctx, "Unknown resource type for cleanup",
 					log.String("resource", option.ResourceType))
@@ -187,6 +227,17 @@ func (w *Worker) runCleanup(ctx context.Context, manual bool, manualDays map[str
 		}
 	}
 
+	w.cleanupProjects(ctx, retentions)
+
+	deleted, err := w.cleanupContentBlobs(ctx)
+	if err != nil {
+		log.Error(ctx, "Failed to cleanup content blobs",
+			log.Cause(err))
+	} else {
+		log.Info(ctx, "Successfully cleaned up content blobs",
+			log.Int("deleted_count", deleted))
+	}
+
 	err = w.cleanupChannelProbes(ctx, 3, manual)
 	if err != nil {
 		log.Error(ctx, "Failed to cleanup channel probes",
@@ -196,6 +247,15 @@ func (w *Worker) runCleanup(ctx context.Context, manual bool, manualDays map[str
 			log.Int("cleanup_days", 3))
 	}
 
+	err = w.cleanupWebhookDeliveries(ctx, 7, manual)
+	if err != nil {
+		log.Error(ctx, "Failed to cleanup webhook deliveries",
+			log.Cause(err))
+	} else {
+		log.Info(ctx, "Successfully cleaned up webhook deliveries",
+			log.Int("cleanup_days", 7))
+	}
+
 	if w.Config.VacuumEnabled {
 		if err := w.runVacuum(ctx); err != nil {
 			log.Error(ctx, "Failed to run VACUUM after cleanup",
@@ -206,8 +266,8 @@ func (w *Worker) runCleanup(ctx context.Context, manual bool, manualDays map[str
 	log.Info(ctx, "Cleanup process completed")
 }
 
-// cleanupRequests deletes requests older than the specified number of days.
-func (w *Worker) cleanupRequests(ctx context.Context, cleanupDays int, manual bool) error {
+// cleanupRequests deletes requests older than the specified number of days, except the requests of the projects with their own retention days.
+func (w *Worker) cleanupRequests(ctx context.Context, cleanupDays int, manual bool, retentions *projectRetentions) error {
 	if cleanupDays <= 0 {
 		log.Debug(ctx, "No cleanup needed for requests")
 		return nil
@@ -215,7 +275,7 @@ func (w *Worker) cleanupRequests(ctx context.Context, cleanupDays int, manual bo
 
 	cutoffTime := time.Now().AddDate(0, 0, -cleanupDays)
 
-	execResult, err := w.cleanupOldRequestExecutions(ctx, cutoffTime)
+	execResult, err := w.cleanupOldRequestExecutions(ctx, cutoffTime, retentions.systemScope(biz.RetentionResourceExecutions))
 	if err != nil {
 		return fmt.Errorf("failed to cleanup request executions: %w", err)
 	}
@@ -225,7 +285,7 @@ func (w *Worker) cleanupRequests(ctx context.Context, cleanupDays int, manual bo
 		log.Time("cutoff_time", cutoffTime),
 	)
 
-	reqResult, err := w.cleanupOldRequestsRecords(ctx, cutoffTime)
+	reqResult, err := w.cleanupOldRequestsRecords(ctx, cutoffTime, retentions.systemScope(biz.RetentionResourceRequests))
 	if err != nil {
 		return fmt.Errorf("failed to cleanup requests: %w", err)
 	}
@@ -237,7 +297,7 @@ func (w *Worker) cleanupRequests(ctx context.Context, cleanupDays int, manual bo
 	return nil
 }
 
-func (w *Worker) cleanupOldRequestExecutions(ctx context.Context, cutoffTime time.Time) (int, error) {
+func (w *Worker) cleanupOldRequestExecutions(ctx context.Context, cutoffTime time.Time, scope cleanupScope) (int, error) {
 	batchSize := w.getBatchSize()
 	totalDeleted := 0
 	cache := make(map[int]*ent.DataStorage)
@@ -249,6 +309,8 @@ func (w *Worker) cleanupOldRequestExecutions(ctx context.Context, cutoffTime tim
 				requestexecution.FieldProjectID,
 				requestexecution.FieldDataStorageID,
 				requestexecution.FieldRequestID,
+				requestexecution.FieldRequestBody,
+				requestexecution.FieldRequestBodyBlobs,
 			).
 			Where(
 				requestexecution.CreatedAtLT(cutoffTime),
@@ -256,6 +318,7 @@ func (w *Worker) cleanupOldRequestExecutions(ctx context.Context, cutoffTime tim
 					request.HasTraceWith(trace.StatusEQ(trace.StatusRetained)),
 				)),
 			).
+			Where(scope.executionPredicates()...).
 			Order(ent.Asc(requestexecution.FieldID)).
 			Limit(batchSize).
 			All(ctx)
@@ -269,11 +332,21 @@ func (w *Worker) cleanupOldRequestExecutions(ctx context.Context, cutoffTime tim
 
 		ids := make([]int, len(executions))
 
+		var bodies []objects.JSONRawMessage
+
 		for i, exec := range executions {
 			ids[i] = exec.ID
+			if exec.RequestBodyBlobs {
+				bodies = append(bodies, exec.RequestBody)
+			}
+
 			w.cleanupExecutionExternalStorage(ctx, exec, cache)
 		}
 
+		if err := biz.ReleaseContentBlobs(ctx, w.Ent, bodies...); err != nil {
+			return totalDeleted, fmt.Errorf("failed to release request executions content blobs: %w", err)
+		}
+
 		if _, err := w.Ent.RequestExecution.Delete().
 			Where(requestexecution.IDIn(ids...)).
 			Exec(ctx); err != nil {
@@ -291,7 +364,7 @@ func (w *Worker) cleanupOldRequestExecutions(ctx context.Context, cutoffTime tim
 	return totalDeleted, nil
 }
 
-func (w *Worker) cleanupOldRequestsRecords(ctx context.Context, cutoffTime time.Time) (int, error) {
+func (w *Worker) cleanupOldRequestsRecords(ctx context.Context, cutoffTime time.Time, scope cleanupScope) (int, error) {
 	batchSize := w.getBatchSize()
 	totalDeleted := 0
 	cache := make(map[int]*ent.DataStorage)
@@ -302,11 +375,16 @@ func (w *Worker) cleanupOldRequestsRecords(ctx context.Context, cutoffTime time.
 				request.FieldID,
 				request.FieldProjectID,
 				request.FieldDataStorageID,
+				request.FieldArchiveStorageID,
+				request.FieldArchiveKey,
+				request.FieldRequestBody,
+				request.FieldRequestBodyBlobs,
 			).
 			Where(
 				request.CreatedAtLT(cutoffTime),
 				request.Not(request.HasTraceWith(trace.StatusEQ(trace.StatusRetained))),
 			).
+			Where(scope.requestPredicates()...).
 			Order(ent.Asc(request.FieldID)).
 			Limit(batchSize).
 			All(ctx)
@@ -319,17 +397,36 @@ func (w *Worker) cleanupOldRequestsRecords(ctx context.Context, cutoffTime time.
 		}
 
 		ids := make([]int, len(reqs))
+
+		var bodies []objects.JSONRawMessage
+
 		for i, req := range reqs {
 			ids[i] = req.ID
+			if req.RequestBodyBlobs {
+				bodies = append(bodies, req.RequestBody)
+			}
+
 			w.cleanupRequestExternalStorage(ctx, req, cache)
 		}
 
+		if err := biz.ReleaseContentBlobs(ctx, w.Ent, bodies...); err != nil {
+			return totalDeleted, fmt.Errorf("failed to release requests content blobs: %w", err)
+		}
+
+		if _, err := w.Ent.RequestSearchTerm.Delete().
+			Where(requestsearchterm.RequestIDIn(ids...)).
+			Exec(ctx); err != nil {
+			return totalDeleted, fmt.Errorf("failed to delete request search terms batch: %w", err)
+		}
+
 		if _, err := w.Ent.Request.Delete().
 			Where(request.IDIn(ids...)).
 			Exec(ctx); err != nil {
 			return totalDeleted, fmt.Errorf("failed to delete requests batch: %w", err)
 		}
 
+		w.cleanupUnreferencedArchives(ctx, reqs, cache)
+
 		totalDeleted += len(ids)
 	}
 
@@ -428,10 +525,10 @@ func (w *Worker) cleanupRequestExternalStorage(ctx context.Context, req *ent.Req
 // directories as real entries that must be explicitly removed during cleanup.
 // Object stores (S3/GCS) have no real directories — the "*DirKey" paths are
 // never created, so attempting to delete them only costs a wasted
-// ListObjectsV2 (Class A). Filesystem and WebDAV backends do create real
+// ListObjectsV2 (Class A). Filesystem, WebDAV and SFTP backends do create real
 // directories that should be removed.
 func hasRealDirectories(t datastorage.Type) bool {
-	return t == datastorage.TypeFs || t == datastorage.TypeWebdav
+	return t == datastorage.TypeFs || t == datastorage.TypeWebdav || t == datastorage.TypeSftp
 }
 
 func (w *Worker) getDataStorageCached(ctx context.Context, id int, cache map[int]*ent.DataStorage) (*ent.DataStorage, error) {
@@ -449,12 +546,43 @@ func (w *Worker) getDataStorageCached(ctx context.Context, id int, cache map[int
 	return ds, nil
 }
 
+// cleanupContentBlobs deletes the content blobs no longer referenced by any request or execution body.
+func (w *Worker) cleanupContentBlobs(ctx context.Context) (int, error) {
+	ctx = authz.WithSystemBypass(ctx, "gc-content-blobs")
+	batchSize := w.getBatchSize()
+
+	return w.deleteInBatches(ctx, func() (int, error) {
+		ids, err := w.Ent.ContentBlob.Query().
+			Where(contentblob.RefCountLTE(0)).
+			Limit(batchSize).
+			IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return 0, err
+		}
+
+		// The reference count is checked again, a blob can be referenced again in the meantime.
+		return w.Ent.ContentBlob.Delete().
+			Where(
+				contentblob.IDIn(ids...),
+				contentblob.RefCountLTE(0),
+			).
+			Exec(ctx)
+	})
+}
+
 // cleanupUsageLogs deletes usage logs older than the specified number of days.
-func (w *Worker) cleanupUsageLogs(ctx context.Context, cleanupDays int, manual bool) error {
+func (w *Worker) cleanupUsageLogs(ctx context.Context, cleanupDays int, manual bool, scope cleanupScope) error {
 	if cleanupDays <= 0 {
 		return nil
 	}
 
+	// The usage logs are rolled up before they are deleted, so that the analytics keep their usage.
+	if w.UsageRollupService != nil {
+		if err := w.UsageRollupService.RollupRecent(ctx); err != nil {
+			return fmt.Errorf("failed to roll up usage logs before cleanup: %w", err)
+		}
+	}
+
 	cutoffTime := time.Now().AddDate(0, 0, -cleanupDays)
 	batchSize := w.getBatchSize()
 
@@ -466,6 +594,7 @@ func (w *Worker) cleanupUsageLogs(ctx context.Context, cleanupDays int, manual b
 					request.HasTraceWith(trace.StatusEQ(trace.StatusRetained)),
 				)),
 			).
+			Where(scope.usageLogPredicates()...).
 			Order(ent.Asc(usagelog.FieldID)).
 			Limit(batchSize).
 			IDs(ctx)
@@ -489,8 +618,43 @@ func (w *Worker) cleanupUsageLogs(ctx context.Context, cleanupDays int, manual b
 	return nil
 }
 
+// cleanupAuditLogs deletes audit logs older than the specified number of days.
+func (w *Worker) cleanupAuditLogs(ctx context.Context, cleanupDays int, manual bool) error {
+	if cleanupDays <= 0 {
+		return nil
+	}
+
+	cutoffTime := time.Now().AddDate(0, 0, -cleanupDays)
+	batchSize := w.getBatchSize()
+
+	result, err := w.deleteInBatches(ctx, func() (int, error) {
+		ids, err := w.Ent.AuditLog.Query().
+			Where(auditlog.CreatedAtLT(cutoffTime)).
+			Order(ent.Asc(auditlog.FieldID)).
+			Limit(batchSize).
+			IDs(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to query old audit logs: %w", err)
+		}
+		if len(ids) == 0 {
+			return 0, nil
+		}
+
+		return w.Ent.AuditLog.Delete().Where(auditlog.IDIn(ids...)).Exec(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete old audit logs: %w", err)
+	}
+
+	log.Debug(ctx, "Cleaned up audit logs",
+		log.Int("deleted_count", result),
+		log.Time("cutoff_time", cutoffTime))
+
+	return nil
+}
+
 // cleanupThreads deletes threads older than the specified number of days.
-func (w *Worker) cleanupThreads(ctx context.Context, cleanupDays int, manual bool) error {
+func (w *Worker) cleanupThreads(ctx context.Context, cleanupDays int, manual bool, scope cleanupScope) error {
 	if cleanupDays <= 0 {
 		log.Debug(ctx, "No cleanup needed for threads")
 		return nil
@@ -505,6 +669,7 @@ func (w *Worker) cleanupThreads(ctx context.Context, cleanupDays int, manual boo
 				thread.CreatedAtLT(cutoffTime),
 				thread.StatusNEQ(thread.StatusRetained),
 			).
+			Where(scope.threadPredicates()...).
 			Order(ent.Asc(thread.FieldID)).
 			Limit(batchSize).
 			IDs(ctx)
@@ -529,7 +694,7 @@ func (w *Worker) cleanupThreads(ctx context.Context, cleanupDays int, manual boo
 }
 
 // cleanupTraces deletes traces older than the specified number of days.
-func (w *Worker) cleanupTraces(ctx context.Context, cleanupDays int, manual bool) error {
+func (w *Worker) cleanupTraces(ctx context.Context, cleanupDays int, manual bool, scope cleanupScope) error {
 	if cleanupDays <= 0 {
 		log.Debug(ctx, "No cleanup needed for traces")
 		return nil
@@ -544,6 +709,7 @@ func (w *Worker) cleanupTraces(ctx context.Context, cleanupDays int, manual bool
 				trace.CreatedAtLT(cutoffTime),
 				trace.StatusNEQ(trace.StatusRetained),
 			).
+			Where(scope.tracePredicates()...).
 			Order(ent.Asc(trace.FieldID)).
 			Limit(batchSize).
 			IDs(ctx)
@@ -603,6 +769,44 @@ func (w *Worker) cleanupChannelProbes(ctx context.Context, cleanupDays int, manu
 	return nil
 }
 
+// cleanupWebhookDeliveries deletes the finished webhook deliveries older than the specified number of days.
+func (w *Worker) cleanupWebhookDeliveries(ctx context.Context, cleanupDays int, manual bool) error {
+	if cleanupDays <= 0 {
+		return nil
+	}
+
+	cutoffTime := time.Now().AddDate(0, 0, -cleanupDays)
+	batchSize := w.getBatchSize()
+
+	result, err := w.deleteInBatches(ctx, func() (int, error) {
+		ids, err := w.Ent.WebhookDelivery.Query().
+			Where(
+				webhookdelivery.CreatedAtLT(cutoffTime),
+				webhookdelivery.StatusNEQ(webhookdelivery.StatusPending),
+			).
+			Order(ent.Asc(webhookdelivery.FieldID)).
+			Limit(batchSize).
+			IDs(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to query old webhook deliveries: %w", err)
+		}
+		if len(ids) == 0 {
+			return 0, nil
+		}
+
+		return w.Ent.WebhookDelivery.Delete().Where(webhookdelivery.IDIn(ids...)).Exec(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete old webhook deliveries: %w", err)
+	}
+
+	log.Debug(ctx, "Cleaned up webhook deliveries",
+		log.Int("deleted_count", result),
+		log.Time("cutoff_time", cutoffTime))
+
+	return nil
+}
+
 // runVacuum executes VACUUM command on SQLite/PostgreSQL database.
 func (w *Worker) runVacuum(ctx context.Context) error {
 	if !w.Config.VacuumEnabled {
@@ -676,6 +880,12 @@ func (w *Worker) PreviewCleanup(ctx context.Context, input TriggerGcCleanupInput
 	ctx = ent.NewContext(ctx, w.Ent)
 	ctx = schematype.SkipSoftDelete(ctx)
 
+	// The projects with their own retention days are not cleaned up with the system cleanup days.
+	retentions, err := w.loadProjectRetentions(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	var items []GcCleanupPreviewItem
 
 	if input.RequestsCleanupDays > 0 {
@@ -683,7 +893,7 @@ func (w *Worker) PreviewCleanup(ctx context.Context, input TriggerGcCleanupInput
 		count, err := w.Ent.Request.Query().Where(
 			request.CreatedAtLT(cutoff),
 			request.Not(request.HasTraceWith(trace.StatusEQ(trace.StatusRetained))),
-		).Count(ctx)
+		).Where(retentions.systemScope(biz.RetentionResourceRequests).requestPredicates()...).Count(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to count requests for preview: %w", err)
 		}
@@ -702,7 +912,7 @@ func (w *Worker) PreviewCleanup(ctx context.Context, input TriggerGcCleanupInput
 			usagelog.Not(usagelog.HasRequestWith(
 				request.HasTraceWith(trace.StatusEQ(trace.StatusRetained)),
 			)),
-		).Count(ctx)
+		).Where(retentions.systemScope(biz.RetentionResourceUsageLogs).usageLogPredicates()...).Count(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to count usage logs for preview: %w", err)
 		}
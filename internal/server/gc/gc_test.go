@@ -2,6 +2,7 @@ package gc
 
 import (
 	"context"
+	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -14,9 +15,11 @@ import (
 
 	"github.com/looplj/axonhub/internal/authz"
 	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/auditlog"
 	"github.com/looplj/axonhub/internal/ent/channelprobe"
 	"github.com/looplj/axonhub/internal/ent/datastorage"
 	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/objects"
 	"github.com/looplj/axonhub/internal/pkg/xcache"
 	"github.com/looplj/axonhub/internal/server/biz"
@@ -269,13 +272,13 @@ func TestWorker_cleanupWithZeroDays(t *testing.T) {
 	ctx := context.Background()
 
 	// Test with 0 days - should not error
-	err := worker.cleanupRequests(ctx, 0, false)
+	err := worker.cleanupRequests(ctx, 0, false, nil)
 	if err != nil {
 		t.Fatalf("cleanupRequests with 0 days failed: %v", err)
 	}
 
 	// Test with negative days - should not error
-	err = worker.cleanupUsageLogs(ctx, -1, false)
+	err = worker.cleanupUsageLogs(ctx, -1, false, cleanupScope{})
 	if err != nil {
 		t.Fatalf("cleanupUsageLogs with negative days failed: %v", err)
 	}
@@ -332,3 +335,115 @@ func TestWorker_cleanupChannelProbesDeletesInBatches(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 2, totalCount)
 }
+
+func TestWorker_cleanupAuditLogs(t *testing.T) {
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() {
+		client.Close()
+	})
+
+	ctx := authz.WithTestBypass(context.Background())
+	ctx = ent.NewContext(ctx, client)
+
+	worker := &Worker{Ent: client, Config: Config{CRON: "0 0 * * *"}}
+
+	for _, createdAt := range []time.Time{
+		time.Now().AddDate(0, 0, -200),
+		time.Now().AddDate(0, 0, -190),
+		time.Now().AddDate(0, 0, -10),
+	} {
+		_, err := client.AuditLog.Create().
+			SetCreatedAt(createdAt).
+			SetActorType(auditlog.ActorTypeSystem).
+			SetSource(auditlog.SourceGraphql).
+			SetOperation("updateChannel").
+			SetAction(auditlog.ActionUpdate).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, worker.cleanupAuditLogs(ctx, 180, false))
+
+	totalCount, err := client.AuditLog.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, totalCount)
+}
+
+func TestWorker_cleanupContentBlobs(t *testing.T) {
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() {
+		client.Close()
+	})
+
+	ctx := authz.WithTestBypass(context.Background())
+	ctx = ent.NewContext(ctx, client)
+
+	worker := &Worker{Ent: client, Config: Config{CRON: "0 0 * * *"}}
+
+	for i, refCount := range []int64{0, 1, -1} {
+		_, err := client.ContentBlob.Create().
+			SetHash(fmt.Sprintf("%064d", i)).
+			SetData([]byte("data")).
+			SetSize(4).
+			SetRefCount(refCount).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	deleted, err := worker.cleanupContentBlobs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, deleted)
+
+	remaining, err := client.ContentBlob.Query().Only(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, remaining.RefCount)
+}
+
+func TestWorker_cleanupOldRequestsRecords_ReleasesServerContentBlobs(t *testing.T) {
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() {
+		client.Close()
+	})
+
+	ctx := authz.WithTestBypass(context.Background())
+	ctx = ent.NewContext(ctx, client)
+
+	worker := &Worker{Ent: client, Config: Config{CRON: "0 0 * * *"}}
+
+	proj, err := client.Project.Create().SetName("blobs").Save(ctx)
+	require.NoError(t, err)
+
+	hash := fmt.Sprintf("%064d", 1)
+
+	_, err = client.ContentBlob.Create().
+		SetHash(hash).
+		SetData([]byte("data")).
+		SetSize(4).
+		SetRefCount(1).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Both bodies reference the blob, only the first one was split by the server.
+	body := objects.JSONRawMessage(`{"messages":[{"$axonhub_blob":"` + hash + `"}]}`)
+
+	for _, blobs := range []bool{true, false} {
+		_, err := client.Request.Create().
+			SetCreatedAt(time.Now().AddDate(0, 0, -40)).
+			SetProjectID(proj.ID).
+			SetModelID("gpt-4o").
+			SetFormat("openai/chat_completions").
+			SetRequestBody(body).
+			SetRequestBodyBlobs(blobs).
+			SetStatus(request.StatusCompleted).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	deleted, err := worker.cleanupOldRequestsRecords(ctx, time.Now().AddDate(0, 0, -30), cleanupScope{})
+	require.NoError(t, err)
+	require.Equal(t, 2, deleted)
+
+	blob, err := client.ContentBlob.Query().Only(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, blob.RefCount)
+}
@@ -0,0 +1,220 @@
+package gc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/predicate"
+	"github.com/looplj/axonhub/internal/ent/project"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/ent/thread"
+	"github.com/looplj/axonhub/internal/ent/trace"
+	"github.com/looplj/axonhub/internal/ent/usagelog"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// cleanupScope restricts a cleanup to the records of one project,
+// or to the records of the projects without their own retention days.
+type cleanupScope struct {
+	projectID          int
+	excludedProjectIDs []int
+}
+
+func (s cleanupScope) requestPredicates() []predicate.Request {
+	switch {
+	case s.projectID != 0:
+		return []predicate.Request{request.ProjectID(s.projectID)}
+	case len(s.excludedProjectIDs) > 0:
+		return []predicate.Request{request.ProjectIDNotIn(s.excludedProjectIDs...)}
+	default:
+		return nil
+	}
+}
+
+func (s cleanupScope) executionPredicates() []predicate.RequestExecution {
+	switch {
+	case s.projectID != 0:
+		return []predicate.RequestExecution{requestexecution.ProjectID(s.projectID)}
+	case len(s.excludedProjectIDs) > 0:
+		return []predicate.RequestExecution{requestexecution.ProjectIDNotIn(s.excludedProjectIDs...)}
+	default:
+		return nil
+	}
+}
+
+func (s cleanupScope) usageLogPredicates() []predicate.UsageLog {
+	switch {
+	case s.projectID != 0:
+		return []predicate.UsageLog{usagelog.ProjectID(s.projectID)}
+	case len(s.excludedProjectIDs) > 0:
+		return []predicate.UsageLog{usagelog.ProjectIDNotIn(s.excludedProjectIDs...)}
+	default:
+		return nil
+	}
+}
+
+func (s cleanupScope) threadPredicates() []predicate.Thread {
+	switch {
+	case s.projectID != 0:
+		return []predicate.Thread{thread.ProjectID(s.projectID)}
+	case len(s.excludedProjectIDs) > 0:
+		return []predicate.Thread{thread.ProjectIDNotIn(s.excludedProjectIDs...)}
+	default:
+		return nil
+	}
+}
+
+func (s cleanupScope) tracePredicates() []predicate.Trace {
+	switch {
+	case s.projectID != 0:
+		return []predicate.Trace{trace.ProjectID(s.projectID)}
+	case len(s.excludedProjectIDs) > 0:
+		return []predicate.Trace{trace.ProjectIDNotIn(s.excludedProjectIDs...)}
+	default:
+		return nil
+	}
+}
+
+// projectRetentions holds the projects with their own retention days, and the system cleanup days they override.
+type projectRetentions struct {
+	projects []*ent.Project
+	// systemDays are the system cleanup days by resource type, 0 when the cleanup is disabled.
+	systemDays map[string]int
+}
+
+// loadProjectRetentions loads the projects with a retention policy.
+func (w *Worker) loadProjectRetentions(ctx context.Context, systemDays map[string]int) (*projectRetentions, error) {
+	projects, err := w.Ent.Project.Query().
+		Where(project.DataPolicyNotNil()).
+		Select(project.FieldID, project.FieldDataPolicy).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query project data policies: %w", err)
+	}
+
+	retentions := &projectRetentions{systemDays: systemDays}
+
+	for _, proj := range projects {
+		if proj.DataPolicy != nil && proj.DataPolicy.Retention != nil {
+			retentions.projects = append(retentions.projects, proj)
+		}
+	}
+
+	return retentions, nil
+}
+
+// systemScope returns the scope of the system cleanup of the resource, which skips the projects with their own retention days.
+func (r *projectRetentions) systemScope(resource string) cleanupScope {
+	if r == nil {
+		return cleanupScope{}
+	}
+
+	var scope cleanupScope
+
+	for _, proj := range r.projects {
+		if _, ok := biz.ProjectRetentionDays(proj.DataPolicy, resource); ok {
+			scope.excludedProjectIDs = append(scope.excludedProjectIDs, proj.ID)
+		}
+	}
+
+	return scope
+}
+
+// days returns the retention days of the resource of the project, false when the project inherits the system cleanup days.
+func (r *projectRetentions) days(proj *ent.Project, resource string) (int, bool) {
+	days, ok := biz.ProjectRetentionDays(proj.DataPolicy, resource)
+	if !ok || resource != biz.RetentionResourceExecutions {
+		return days, ok
+	}
+
+	// The executions are not kept longer than their requests.
+	requestDays, ok := biz.ProjectRetentionDays(proj.DataPolicy, biz.RetentionResourceRequests)
+	if !ok {
+		requestDays = r.systemDays[biz.RetentionResourceRequests]
+	}
+
+	if requestDays > 0 && (days == 0 || days > requestDays) {
+		days = requestDays
+	}
+
+	return days, true
+}
+
+// cleanupProjects deletes the data of the projects with their own retention days.
+func (w *Worker) cleanupProjects(ctx context.Context, retentions *projectRetentions) {
+	for _, proj := range retentions.projects {
+		for _, resource := range []string{
+			biz.RetentionResourceExecutions,
+			biz.RetentionResourceRequests,
+			biz.RetentionResourceUsageLogs,
+			biz.RetentionResourceThreads,
+			biz.RetentionResourceTraces,
+		} {
+			days, ok := retentions.days(proj, resource)
+			if !ok || days <= 0 {
+				continue
+			}
+
+			if err := w.cleanupProjectResource(ctx, proj.ID, resource, days); err != nil {
+				log.Error(ctx, "Failed to cleanup project data",
+					log.Int("project_id", proj.ID),
+					log.String("resource", resource),
+					log.Cause(err))
+			} else {
+				log.Info(ctx, "Successfully cleaned up project data",
+					log.Int("project_id", proj.ID),
+					log.String("resource", resource),
+					log.Int("cleanup_days", days))
+			}
+		}
+	}
+}
+
+func (w *Worker) cleanupProjectResource(ctx context.Context, projectID int, resource string, days int) error {
+	scope := cleanupScope{projectID: projectID}
+	cutoffTime := time.Now().AddDate(0, 0, -days)
+
+	switch resource {
+	case biz.RetentionResourceExecutions:
+		_, err := w.cleanupOldRequestExecutions(ctx, cutoffTime, scope)
+		return err
+	case biz.RetentionResourceRequests:
+		_, err := w.cleanupOldRequestsRecords(ctx, cutoffTime, scope)
+		return err
+	case biz.RetentionResourceUsageLogs:
+		return w.cleanupUsageLogs(ctx, days, false, scope)
+	case biz.RetentionResourceThreads:
+		return w.cleanupThreads(ctx, days, false, scope)
+	case biz.RetentionResourceTraces:
+		return w.cleanupTraces(ctx, days, false, scope)
+	default:
+		return fmt.Errorf("unknown retention resource: %s", resource)
+	}
+}
+
+// cleanupDaysByResource returns the system cleanup days of the resource types cleaned up by the run.
+func cleanupDaysByResource(policy *biz.StoragePolicy, manual bool, manualDays map[string]int) map[string]int {
+	days := make(map[string]int)
+
+	for _, option := range policy.CleanupOptions {
+		if !option.Enabled && !manual {
+			continue
+		}
+
+		if manual && manualDays != nil {
+			if d, ok := manualDays[option.ResourceType]; ok {
+				days[option.ResourceType] = d
+			}
+
+			continue
+		}
+
+		days[option.ResourceType] = option.CleanupDays
+	}
+
+	return days
+}
@@ -0,0 +1,216 @@
+package gc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/ent/requestexecution"
+	"github.com/looplj/axonhub/internal/ent/thread"
+	"github.com/looplj/axonhub/internal/ent/usagelog"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+func TestWorker_runCleanupHonorsProjectRetention(t *testing.T) {
+	worker, ctx, _, _ := setupWorkerWithFSStorage(t)
+	client := worker.Ent
+
+	worker.SystemService = biz.NewSystemService(biz.SystemServiceParams{CacheConfig: xcache.Config{}, Ent: client})
+	require.NoError(t, worker.SystemService.SetStoragePolicy(ctx, &biz.StoragePolicy{
+		StoreRequestBody:  true,
+		StoreResponseBody: true,
+		CleanupOptions: []biz.CleanupOption{
+			{ResourceType: "requests", Enabled: true, CleanupDays: 3},
+			{ResourceType: "usage_logs", Enabled: true, CleanupDays: 30},
+		},
+	}))
+
+	system, err := client.Project.Create().SetName("system").Save(ctx)
+	require.NoError(t, err)
+
+	// The audit project keeps its requests for a year, and its executions for 10 days.
+	audit, err := client.Project.Create().
+		SetName("audit").
+		SetDataPolicy(&objects.ProjectDataPolicy{Retention: &objects.ProjectRetentionPolicy{
+			RequestsDays:   lo.ToPtr(365),
+			ExecutionsDays: lo.ToPtr(10),
+			UsageLogsDays:  lo.ToPtr(0),
+		}}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// The ephemeral project keeps its requests for a day and inherits the usage logs cleanup.
+	ephemeral, err := client.Project.Create().
+		SetName("ephemeral").
+		SetDataPolicy(&objects.ProjectDataPolicy{Retention: &objects.ProjectRetentionPolicy{RequestsDays: lo.ToPtr(1)}}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	type records struct {
+		request   *ent.Request
+		execution *ent.RequestExecution
+		usageLog  *ent.UsageLog
+		thread    *ent.Thread
+	}
+
+	create := func(proj *ent.Project, age time.Duration) records {
+		createdAt := time.Now().Add(-age)
+
+		th, err := client.Thread.Create().
+			SetCreatedAt(createdAt).
+			SetProjectID(proj.ID).
+			SetThreadID(proj.Name + createdAt.String()).
+			Save(ctx)
+		require.NoError(t, err)
+
+		req, err := client.Request.Create().
+			SetCreatedAt(createdAt).
+			SetProjectID(proj.ID).
+			SetModelID("gpt-4o").
+			SetFormat("openai/chat_completions").
+			SetRequestBody(objects.JSONRawMessage(`{}`)).
+			SetStatus(request.StatusCompleted).
+			Save(ctx)
+		require.NoError(t, err)
+
+		exec, err := client.RequestExecution.Create().
+			SetCreatedAt(createdAt).
+			SetProjectID(proj.ID).
+			SetRequestID(req.ID).
+			SetModelID("gpt-4o").
+			SetFormat("openai/chat_completions").
+			SetRequestBody(objects.JSONRawMessage(`{}`)).
+			SetStatus(requestexecution.StatusCompleted).
+			Save(ctx)
+		require.NoError(t, err)
+
+		usageLog, err := client.UsageLog.Create().
+			SetCreatedAt(createdAt).
+			SetProjectID(proj.ID).
+			SetRequestID(req.ID).
+			SetModelID("gpt-4o").
+			Save(ctx)
+		require.NoError(t, err)
+
+		return records{request: req, execution: exec, usageLog: usageLog, thread: th}
+	}
+
+	day := 24 * time.Hour
+
+	systemOld := create(system, 5*day)
+	systemRecent := create(system, 2*day)
+	auditOld := create(audit, 60*day)
+	auditMonth := create(audit, 20*day)
+	ephemeralOld := create(ephemeral, 2*day)
+	ephemeralRecent := create(ephemeral, time.Hour)
+
+	worker.runCleanup(ctx, false, nil)
+
+	requestExists := func(r records) bool {
+		exists, err := client.Request.Query().Where(request.ID(r.request.ID)).Exist(ctx)
+		require.NoError(t, err)
+
+		return exists
+	}
+	executionExists := func(r records) bool {
+		exists, err := client.RequestExecution.Query().Where(requestexecution.ID(r.execution.ID)).Exist(ctx)
+		require.NoError(t, err)
+
+		return exists
+	}
+	usageLogExists := func(r records) bool {
+		exists, err := client.UsageLog.Query().Where(usagelog.ID(r.usageLog.ID)).Exist(ctx)
+		require.NoError(t, err)
+
+		return exists
+	}
+	threadExists := func(r records) bool {
+		exists, err := client.Thread.Query().Where(thread.ID(r.thread.ID)).Exist(ctx)
+		require.NoError(t, err)
+
+		return exists
+	}
+
+	// The system cleanup days apply to the projects without retention days.
+	require.False(t, requestExists(systemOld))
+	require.False(t, executionExists(systemOld))
+	require.False(t, threadExists(systemOld))
+	require.True(t, requestExists(systemRecent))
+
+	// The audit project keeps its requests, threads and usage logs, and cleans up its old executions.
+	require.True(t, requestExists(auditOld))
+	require.True(t, threadExists(auditOld))
+	require.True(t, usageLogExists(auditOld))
+	require.False(t, executionExists(auditOld))
+	require.False(t, executionExists(auditMonth))
+
+	// The ephemeral project cleans up after a day, its usage logs follow the system cleanup days.
+	require.False(t, requestExists(ephemeralOld))
+	require.False(t, executionExists(ephemeralOld))
+	require.False(t, threadExists(ephemeralOld))
+	require.True(t, usageLogExists(ephemeralOld))
+	require.True(t, requestExists(ephemeralRecent))
+	require.True(t, executionExists(ephemeralRecent))
+}
+
+func TestProjectRetentions_days(t *testing.T) {
+	retentions := &projectRetentions{systemDays: map[string]int{biz.RetentionResourceRequests: 3}}
+
+	testCases := []struct {
+		name      string
+		retention *objects.ProjectRetentionPolicy
+		resource  string
+		days      int
+		ok        bool
+	}{
+		{
+			name:      "inherits the system days",
+			retention: &objects.ProjectRetentionPolicy{UsageLogsDays: lo.ToPtr(90)},
+			resource:  biz.RetentionResourceRequests,
+		},
+		{
+			name:      "traces fall back to the requests days",
+			retention: &objects.ProjectRetentionPolicy{RequestsDays: lo.ToPtr(30)},
+			resource:  biz.RetentionResourceTraces,
+			days:      30,
+			ok:        true,
+		},
+		{
+			name:      "executions are not kept longer than the project requests",
+			retention: &objects.ProjectRetentionPolicy{RequestsDays: lo.ToPtr(30), ExecutionsDays: lo.ToPtr(0)},
+			resource:  biz.RetentionResourceExecutions,
+			days:      30,
+			ok:        true,
+		},
+		{
+			name:      "executions are not kept longer than the system requests",
+			retention: &objects.ProjectRetentionPolicy{ExecutionsDays: lo.ToPtr(7)},
+			resource:  biz.RetentionResourceExecutions,
+			days:      3,
+			ok:        true,
+		},
+		{
+			name:      "usage logs kept forever",
+			retention: &objects.ProjectRetentionPolicy{UsageLogsDays: lo.ToPtr(0)},
+			resource:  biz.RetentionResourceUsageLogs,
+			days:      0,
+			ok:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proj := &ent.Project{DataPolicy: &objects.ProjectDataPolicy{Retention: tc.retention}}
+
+			days, ok := retentions.days(proj, tc.resource)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.days, days)
+		})
+	}
+}
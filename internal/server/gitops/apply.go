@@ -0,0 +1,418 @@
+package gitops
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/channelmodelpriceversion"
+	"github.com/looplj/axonhub/internal/ent/model"
+	entuser "github.com/looplj/axonhub/internal/ent/user"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// applier applies the planned operations in a transaction.
+type applier struct {
+	db  *ent.Client
+	now time.Time
+
+	// channelIDs are the IDs of the existing channels, the created channels are added.
+	channelIDs map[string]int
+	// changedChannels are the channels whose prices are changed, their updated_at is touched to reload the cached prices.
+	changedChannels map[int]struct{}
+}
+
+func newApplier(ctx context.Context, db *ent.Client) (*applier, error) {
+	channels, err := db.Channel.Query().
+		Select(channel.FieldID, channel.FieldName).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query channels: %w", err)
+	}
+
+	a := &applier{
+		db:              db,
+		now:             time.Now(),
+		channelIDs:      map[string]int{},
+		changedChannels: map[int]struct{}{},
+	}
+
+	for _, ch := range channels {
+		a.channelIDs[ch.Name] = ch.ID
+	}
+
+	return a, nil
+}
+
+func (a *applier) finish(ctx context.Context) error {
+	for channelID := range a.changedChannels {
+		if err := a.db.Channel.UpdateOneID(channelID).
+			SetUpdatedAt(a.now).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update channel updated_at: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (a *applier) createChannel(ctx context.Context, ch *Channel, credentials objects.ChannelCredentials) error {
+	policies, settings, endpoints, err := channelFields(ch)
+	if err != nil {
+		return err
+	}
+
+	create := a.db.Channel.Create().
+		SetName(ch.Name).
+		SetType(channel.Type(ch.Type)).
+		SetBaseURL(ch.BaseURL).
+		SetStatus(channel.Status(ch.Status)).
+		SetCredentials(credentials).
+		SetSupportedModels(nonNil(ch.SupportedModels)).
+		SetManualModels(nonNil(ch.ManualModels)).
+		SetAutoSyncSupportedModels(ch.AutoSyncSupportedModels).
+		SetAutoSyncModelPattern(ch.AutoSyncModelPattern).
+		SetTags(nonNil(ch.Tags)).
+		SetDefaultTestModel(ch.DefaultTestModel).
+		SetPolicies(policies).
+		SetSettings(&settings).
+		SetEndpoints(nonNil(endpoints)).
+		SetOrderingWeight(ch.OrderingWeight)
+
+	if ch.Remark != "" {
+		create.SetRemark(ch.Remark)
+	}
+
+	created, err := create.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create channel %s: %w", ch.Name, err)
+	}
+
+	a.channelIDs[created.Name] = created.ID
+
+	return nil
+}
+
+func (a *applier) updateChannel(ctx context.Context, id int, ch *Channel, credentials objects.ChannelCredentials) error {
+	policies, settings, endpoints, err := channelFields(ch)
+	if err != nil {
+		return err
+	}
+
+	update := a.db.Channel.UpdateOneID(id).
+		SetType(channel.Type(ch.Type)).
+		SetBaseURL(ch.BaseURL).
+		SetStatus(channel.Status(ch.Status)).
+		SetCredentials(credentials).
+		SetSupportedModels(nonNil(ch.SupportedModels)).
+		SetManualModels(nonNil(ch.ManualModels)).
+		SetAutoSyncSupportedModels(ch.AutoSyncSupportedModels).
+		SetAutoSyncModelPattern(ch.AutoSyncModelPattern).
+		SetTags(nonNil(ch.Tags)).
+		SetDefaultTestModel(ch.DefaultTestModel).
+		SetPolicies(policies).
+		SetSettings(&settings).
+		SetEndpoints(nonNil(endpoints)).
+		SetOrderingWeight(ch.OrderingWeight)
+
+	if ch.Remark != "" {
+		update.SetRemark(ch.Remark)
+	} else {
+		update.ClearRemark()
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update channel %s: %w", ch.Name, err)
+	}
+
+	return nil
+}
+
+func channelFields(ch *Channel) (objects.ChannelPolicies, objects.ChannelSettings, []objects.ChannelEndpoint, error) {
+	policies, err := decode[objects.ChannelPolicies](ch.Policies)
+	if err != nil {
+		return policies, objects.ChannelSettings{}, nil, fmt.Errorf("policies: %w", err)
+	}
+
+	settings, err := decode[objects.ChannelSettings](ch.Settings)
+	if err != nil {
+		return policies, settings, nil, fmt.Errorf("settings: %w", err)
+	}
+
+	endpoints, err := decode[[]objects.ChannelEndpoint](ch.Endpoints)
+	if err != nil {
+		return policies, settings, nil, fmt.Errorf("endpoints: %w", err)
+	}
+
+	return policies, settings, endpoints, nil
+}
+
+func (a *applier) createModel(ctx context.Context, m *Model) error {
+	modelCard, settings, err := a.modelFields(m)
+	if err != nil {
+		return err
+	}
+
+	create := a.db.Model.Create().
+		SetDeveloper(m.Developer).
+		SetModelID(m.ModelID).
+		SetType(model.Type(m.Type)).
+		SetName(m.Name).
+		SetIcon(m.Icon).
+		SetGroup(m.Group).
+		SetStatus(model.Status(m.Status)).
+		SetModelCard(&modelCard).
+		SetSettings(&settings)
+
+	if m.Remark != "" {
+		create.SetRemark(m.Remark)
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to create model %s: %w", modelName(m.Developer, m.ModelID), err)
+	}
+
+	return nil
+}
+
+func (a *applier) updateModel(ctx context.Context, id int, m *Model) error {
+	modelCard, settings, err := a.modelFields(m)
+	if err != nil {
+		return err
+	}
+
+	update := a.db.Model.UpdateOneID(id).
+		SetType(model.Type(m.Type)).
+		SetName(m.Name).
+		SetIcon(m.Icon).
+		SetGroup(m.Group).
+		SetStatus(model.Status(m.Status)).
+		SetModelCard(&modelCard).
+		SetSettings(&settings)
+
+	if m.Remark != "" {
+		update.SetRemark(m.Remark)
+	} else {
+		update.ClearRemark()
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update model %s: %w", modelName(m.Developer, m.ModelID), err)
+	}
+
+	return nil
+}
+
+func (a *applier) modelFields(m *Model) (objects.ModelCard, objects.ModelSettings, error) {
+	modelCard, err := decode[objects.ModelCard](m.ModelCard)
+	if err != nil {
+		return modelCard, objects.ModelSettings{}, fmt.Errorf("modelCard: %w", err)
+	}
+
+	// The channels created by the apply have their IDs now.
+	settings, err := modelSettings(m.Settings, a.channelIDs)
+	if err != nil {
+		return modelCard, settings, fmt.Errorf("settings: %w", err)
+	}
+
+	return modelCard, settings, nil
+}
+
+func (a *applier) createPrice(ctx context.Context, price *Price) error {
+	channelID, modelPrice, err := a.priceFields(price)
+	if err != nil {
+		return err
+	}
+
+	created, err := a.db.ChannelModelPrice.Create().
+		SetChannelID(channelID).
+		SetModelID(price.ModelID).
+		SetPrice(modelPrice).
+		SetReferenceID(generateReferenceID()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create channel model price %s: %w", priceName(price.Channel, price.ModelID), err)
+	}
+
+	return a.createPriceVersion(ctx, created)
+}
+
+func (a *applier) updatePrice(ctx context.Context, existing *ent.ChannelModelPrice, price *Price) error {
+	_, modelPrice, err := a.priceFields(price)
+	if err != nil {
+		return err
+	}
+
+	if err := a.archivePriceVersions(ctx, existing); err != nil {
+		return err
+	}
+
+	updated, err := a.db.ChannelModelPrice.UpdateOneID(existing.ID).
+		SetPrice(modelPrice).
+		SetReferenceID(generateReferenceID()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update channel model price %s: %w", priceName(price.Channel, price.ModelID), err)
+	}
+
+	return a.createPriceVersion(ctx, updated)
+}
+
+func (a *applier) deletePrice(ctx context.Context, existing *ent.ChannelModelPrice) error {
+	if err := a.archivePriceVersions(ctx, existing); err != nil {
+		return err
+	}
+
+	if err := a.db.ChannelModelPrice.DeleteOne(existing).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete channel model price: %w", err)
+	}
+
+	a.changedChannels[existing.ChannelID] = struct{}{}
+
+	return nil
+}
+
+func (a *applier) priceFields(price *Price) (int, objects.ModelPrice, error) {
+	channelID, ok := a.channelIDs[price.Channel]
+	if !ok {
+		return 0, objects.ModelPrice{}, fmt.Errorf("channel %q not found", price.Channel)
+	}
+
+	modelPrice, err := decode[objects.ModelPrice](price.Price)
+	if err != nil {
+		return 0, modelPrice, fmt.Errorf("price: %w", err)
+	}
+
+	return channelID, modelPrice, nil
+}
+
+func (a *applier) archivePriceVersions(ctx context.Context, existing *ent.ChannelModelPrice) error {
+	_, err := a.db.ChannelModelPriceVersion.Update().
+		Where(
+			channelmodelpriceversion.ChannelModelPriceIDEQ(existing.ID),
+			channelmodelpriceversion.StatusEQ(channelmodelpriceversion.StatusActive),
+		).
+		SetStatus(channelmodelpriceversion.StatusArchived).
+		SetEffectiveEndAt(a.now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to archive channel model price versions: %w", err)
+	}
+
+	return nil
+}
+
+func (a *applier) createPriceVersion(ctx context.Context, price *ent.ChannelModelPrice) error {
+	_, err := a.db.ChannelModelPriceVersion.Create().
+		SetChannelID(price.ChannelID).
+		SetModelID(price.ModelID).
+		SetChannelModelPriceID(price.ID).
+		SetPrice(price.Price).
+		SetStatus(channelmodelpriceversion.StatusActive).
+		SetEffectiveStartAt(a.now).
+		SetReferenceID(price.ReferenceID).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create channel model price version: %w", err)
+	}
+
+	a.changedChannels[price.ChannelID] = struct{}{}
+
+	return nil
+}
+
+func (a *applier) createAPIKey(ctx context.Context, projectID int, k *APIKey, key string) error {
+	profiles, err := apiKeyProfiles(k.Profiles, a.channelIDs)
+	if err != nil {
+		return fmt.Errorf("profiles: %w", err)
+	}
+
+	ownerID, err := a.ownerID(ctx, k.Owner)
+	if err != nil {
+		return err
+	}
+
+	err = a.db.APIKey.Create().
+		SetProjectID(projectID).
+		SetUserID(ownerID).
+		SetName(k.Name).
+		SetKey(key).
+		SetType(apikey.Type(k.Type)).
+		SetStatus(apikey.Status(k.Status)).
+		SetScopes(k.Scopes).
+		SetAllowedIps(nonNil(k.AllowedIPs)).
+		SetProfiles(&profiles).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create API key %s: %w", apiKeyName(k.Project, k.Name), err)
+	}
+
+	return nil
+}
+
+func (a *applier) updateAPIKey(ctx context.Context, id int, k *APIKey, key string) error {
+	profiles, err := apiKeyProfiles(k.Profiles, a.channelIDs)
+	if err != nil {
+		return fmt.Errorf("profiles: %w", err)
+	}
+
+	err = a.db.APIKey.UpdateOneID(id).
+		SetKey(key).
+		SetType(apikey.Type(k.Type)).
+		SetStatus(apikey.Status(k.Status)).
+		SetScopes(k.Scopes).
+		SetAllowedIps(nonNil(k.AllowedIPs)).
+		SetProfiles(&profiles).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update API key %s: %w", apiKeyName(k.Project, k.Name), err)
+	}
+
+	return nil
+}
+
+// ownerID returns the user owning a new API key, the current user or the first owner owns the keys without an owner.
+func (a *applier) ownerID(ctx context.Context, email string) (int, error) {
+	if email != "" {
+		owner, err := a.db.User.Query().
+			Where(entuser.Email(email)).
+			Only(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to find owner %s: %w", email, err)
+		}
+
+		return owner.ID, nil
+	}
+
+	if user, ok := contexts.GetUser(ctx); ok && user != nil {
+		return user.ID, nil
+	}
+
+	owner, err := a.db.User.Query().
+		Where(entuser.IsOwner(true)).
+		Order(ent.Asc(entuser.FieldID)).
+		First(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find the owner user: %w", err)
+	}
+
+	return owner.ID, nil
+}
+
+const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// generateReferenceID generates the reference ID of a price version, the same way as the channel service.
+func generateReferenceID() string {
+	b := make([]byte, 8)
+	for i := range b {
+		//nolint:gosec // not a security issue.
+		b[i] = letters[rand.IntN(len(letters))]
+	}
+
+	return string(b)
+}
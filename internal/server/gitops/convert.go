@@ -0,0 +1,285 @@
+package gitops
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Keys of the channel references in the JSON of the model settings and the API key profiles,
+// mapped to the keys referencing the channels by name in the documents.
+var (
+	modelSettingsChannelRefKeys = map[string]string{
+		"channelId":  "channel",
+		"channelIds": "channels",
+	}
+	apiKeyProfilesChannelRefKeys = map[string]string{
+		"channelIDs": "channels",
+	}
+)
+
+// canonical converts a value to the generic form of its JSON encoding without the zero values,
+// so that a document and the database compare equal regardless of how the zero values were written.
+func canonical(v any) (any, error) {
+	generic, err := clone(v)
+	if err != nil {
+		return nil, err
+	}
+
+	compacted := compact(generic)
+	if isZero(compacted) {
+		return nil, nil
+	}
+
+	return compacted, nil
+}
+
+// clone converts a value to the generic form of its JSON encoding.
+func clone(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var generic any
+	if err := dec.Decode(&generic); err != nil {
+		return nil, err
+	}
+
+	return generic, nil
+}
+
+// compact converts the JSON numbers and drops the zero values of the maps, list items are kept.
+func compact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			value = compact(value)
+			if isZero(value) {
+				delete(v, key)
+			} else {
+				v[key] = value
+			}
+		}
+
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = compact(item)
+		}
+
+		return v
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+
+		f, _ := v.Float64()
+
+		return f
+	default:
+		return v
+	}
+}
+
+func isZero(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case map[string]any:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case int64:
+		return v == 0
+	case float64:
+		return v == 0
+	default:
+		return false
+	}
+}
+
+// decode decodes a generic value of a document into T, unknown fields are rejected to catch typos.
+func decode[T any](v any) (T, error) {
+	var typed T
+
+	if v == nil {
+		return typed, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return typed, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(&typed); err != nil {
+		return typed, err
+	}
+
+	return typed, nil
+}
+
+// normalize decodes a generic value of a document into T and returns its canonical form.
+func normalize[T any](v any) (any, error) {
+	typed, err := decode[T](v)
+	if err != nil {
+		return nil, err
+	}
+
+	return canonical(typed)
+}
+
+// channelRefsToNames replaces the channel IDs in a canonical value by the channel names.
+// References of unknown channels are kept as IDs.
+func channelRefsToNames(v any, keys map[string]string, names map[int]string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			nameKey, ok := keys[key]
+			if !ok {
+				v[key] = channelRefsToNames(value, keys, names)
+				continue
+			}
+
+			if named, ok := channelIDsToNames(value, names); ok {
+				delete(v, key)
+				v[nameKey] = named
+			}
+		}
+
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = channelRefsToNames(item, keys, names)
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+func channelIDsToNames(v any, names map[int]string) (any, bool) {
+	switch v := v.(type) {
+	case int64:
+		name, ok := names[int(v)]
+		return name, ok
+	case []any:
+		named := make([]any, 0, len(v))
+
+		for _, item := range v {
+			name, ok := channelIDsToNames(item, names)
+			if !ok {
+				return nil, false
+			}
+
+			named = append(named, name)
+		}
+
+		return named, true
+	default:
+		return nil, false
+	}
+}
+
+// channelRefsToIDs replaces the channel names in a value of a document by the channel IDs.
+func channelRefsToIDs(v any, keys map[string]string, ids map[string]int) (any, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		// Sorted to report the same error for the same document.
+		for _, key := range sortedKeys(v) {
+			value := v[key]
+
+			idKey := ""
+
+			for candidate, nameKey := range keys {
+				if nameKey == key {
+					idKey = candidate
+				}
+			}
+
+			if idKey == "" {
+				converted, err := channelRefsToIDs(value, keys, ids)
+				if err != nil {
+					return nil, err
+				}
+
+				v[key] = converted
+
+				continue
+			}
+
+			converted, err := channelNamesToIDs(value, ids)
+			if err != nil {
+				return nil, err
+			}
+
+			delete(v, key)
+			v[idKey] = converted
+		}
+
+		return v, nil
+	case []any:
+		for i, item := range v {
+			converted, err := channelRefsToIDs(item, keys, ids)
+			if err != nil {
+				return nil, err
+			}
+
+			v[i] = converted
+		}
+
+		return v, nil
+	default:
+		return v, nil
+	}
+}
+
+func channelNamesToIDs(v any, ids map[string]int) (any, error) {
+	switch v := v.(type) {
+	case string:
+		id, ok := ids[v]
+		if !ok {
+			return nil, fmt.Errorf("channel %q not found", v)
+		}
+
+		return id, nil
+	case []any:
+		converted := make([]any, 0, len(v))
+
+		for _, item := range v {
+			id, err := channelNamesToIDs(item, ids)
+			if err != nil {
+				return nil, err
+			}
+
+			converted = append(converted, id)
+		}
+
+		return converted, nil
+	default:
+		return nil, fmt.Errorf("invalid channel reference %v", v)
+	}
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
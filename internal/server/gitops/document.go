@@ -0,0 +1,320 @@
+package gitops
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/model"
+)
+
+// Marshal encodes the document as YAML.
+func Marshal(doc *Document) ([]byte, error) {
+	var buf bytes.Buffer
+
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Parse decodes YAML documents, documents separated by --- are merged. Unknown fields are rejected.
+func Parse(data []byte) (*Document, error) {
+	merged := &Document{Version: DocumentVersion}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	for {
+		var doc Document
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, err
+		}
+
+		if err := merge(merged, &doc); err != nil {
+			return nil, err
+		}
+	}
+
+	return merged, nil
+}
+
+// Load reads the documents of a file, or of all the .yaml and .yml files of a directory in name order.
+func Load(path string) (*Document, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		return Parse(data)
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := &Document{Version: DocumentVersion}
+
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(path, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		doc, err := Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+
+		if err := merge(merged, doc); err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+	}
+
+	return merged, nil
+}
+
+// WriteDir writes the document to a directory with one file per kind,
+// a file is written for every kind so that the directory manages all of them.
+func WriteDir(doc *Document, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	files := []struct {
+		name string
+		doc  *Document
+	}{
+		{name: "channels.yaml", doc: &Document{Version: doc.Version, Channels: nonNil(doc.Channels)}},
+		{name: "models.yaml", doc: &Document{Version: doc.Version, Models: nonNil(doc.Models)}},
+		{name: "prices.yaml", doc: &Document{Version: doc.Version, Prices: nonNil(doc.Prices)}},
+		{name: "api_keys.yaml", doc: &Document{Version: doc.Version, APIKeys: nonNil(doc.APIKeys)}},
+	}
+
+	for _, file := range files {
+		data, err := Marshal(file.doc)
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, file.name), data, 0o600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nonNil keeps the kind managed by the document when there is no object of it,
+// the empty list is encoded as [] because omitempty only omits nil slices.
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+
+	return items
+}
+
+func merge(dst, src *Document) error {
+	if src.Version != "" && src.Version != DocumentVersion {
+		return fmt.Errorf("unsupported version %q, expected %q", src.Version, DocumentVersion)
+	}
+
+	dst.Channels = mergeKind(dst.Channels, src.Channels)
+	dst.Models = mergeKind(dst.Models, src.Models)
+	dst.Prices = mergeKind(dst.Prices, src.Prices)
+	dst.APIKeys = mergeKind(dst.APIKeys, src.APIKeys)
+
+	return nil
+}
+
+// mergeKind appends the objects of a kind, the empty items of the lists are dropped.
+func mergeKind[T comparable](dst, src []T) []T {
+	if src == nil {
+		return dst
+	}
+
+	return append(nonNil(dst), lo.Compact(src)...)
+}
+
+// validate checks the identities and the enums of the objects and fills the defaults of the omitted ones.
+// The structured fields are checked when planning.
+func (doc *Document) validate() error {
+	var errs []error
+
+	seen := map[string]bool{}
+	check := func(kind, name string, err error) {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", kind, name, err))
+			return
+		}
+
+		key := kind + " " + name
+		if seen[key] {
+			errs = append(errs, fmt.Errorf("%s %s is declared more than once", kind, name))
+		}
+
+		seen[key] = true
+	}
+
+	for _, ch := range doc.Channels {
+		check(KindChannel, ch.Name, ch.validate())
+	}
+
+	for _, m := range doc.Models {
+		check(KindModel, modelName(m.Developer, m.ModelID), m.validate())
+	}
+
+	for _, p := range doc.Prices {
+		var err error
+		if p.Channel == "" || p.ModelID == "" {
+			err = errors.New("channel and modelID are required")
+		}
+
+		check(KindPrice, priceName(p.Channel, p.ModelID), err)
+	}
+
+	for _, k := range doc.APIKeys {
+		check(KindAPIKey, apiKeyName(k.Project, k.Name), k.validate())
+	}
+
+	return errors.Join(errs...)
+}
+
+func (ch *Channel) validate() error {
+	if strings.TrimSpace(ch.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	if err := channel.TypeValidator(channel.Type(ch.Type)); err != nil {
+		return err
+	}
+
+	if ch.Status == "" {
+		ch.Status = channel.DefaultStatus.String()
+	}
+
+	if err := channel.StatusValidator(channel.Status(ch.Status)); err != nil {
+		return err
+	}
+
+	if ch.Policies == nil {
+		policies, err := canonical(channel.DefaultPolicies)
+		if err != nil {
+			return err
+		}
+
+		ch.Policies = policies
+	}
+
+	if ch.Credentials != nil {
+		refs := slices.Clone(ch.Credentials.APIKeys)
+		if ch.Credentials.APIKey != nil {
+			refs = append(refs, *ch.Credentials.APIKey)
+		}
+
+		if ch.Credentials.GCP != nil && ch.Credentials.GCP.JSONData != nil {
+			refs = append(refs, *ch.Credentials.GCP.JSONData)
+		}
+
+		for _, ref := range refs {
+			if err := ref.validate(); err != nil {
+				return fmt.Errorf("credentials: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *Model) validate() error {
+	if m.Developer == "" || m.ModelID == "" {
+		return errors.New("developer and modelID are required")
+	}
+
+	if m.Type == "" {
+		m.Type = model.DefaultType.String()
+	}
+
+	if err := model.TypeValidator(model.Type(m.Type)); err != nil {
+		return err
+	}
+
+	if m.Status == "" {
+		m.Status = model.DefaultStatus.String()
+	}
+
+	return model.StatusValidator(model.Status(m.Status))
+}
+
+func (k *APIKey) validate() error {
+	if k.Project == "" || k.Name == "" {
+		return errors.New("project and name are required")
+	}
+
+	if k.Type == "" {
+		k.Type = apikey.DefaultType.String()
+	}
+
+	if err := apikey.TypeValidator(apikey.Type(k.Type)); err != nil {
+		return err
+	}
+
+	if k.Type == apikey.TypeNoauth.String() {
+		return errors.New("the noauth API key is managed by AxonHub")
+	}
+
+	if k.Status == "" {
+		k.Status = apikey.DefaultStatus.String()
+	}
+
+	if err := apikey.StatusValidator(apikey.Status(k.Status)); err != nil {
+		return err
+	}
+
+	if k.Scopes == nil {
+		k.Scopes = slices.Clone(apikey.DefaultScopes)
+	}
+
+	if k.Key != nil {
+		if err := k.Key.validate(); err != nil {
+			return fmt.Errorf("key: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,7 @@
+package gitops
+
+import "go.uber.org/fx"
+
+var Module = fx.Module("gitops",
+	fx.Provide(NewGitOpsService),
+)
@@ -0,0 +1,373 @@
+package gitops
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/channel"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/ent/model"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+func setupGitOpsTest(t *testing.T) (*ent.Client, *GitOpsService, context.Context) {
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+
+	ctx := context.Background()
+	ctx = ent.NewContext(ctx, client)
+	ctx = authz.WithTestBypass(ctx)
+
+	owner, err := client.User.Create().
+		SetEmail("owner@example.com").
+		SetPassword("password").
+		SetIsOwner(true).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.Project.Create().
+		SetName("Default").
+		Save(ctx)
+	require.NoError(t, err)
+
+	ctx = contexts.WithUser(ctx, owner)
+
+	return client, NewGitOpsService(GitOpsServiceParams{Ent: client}), ctx
+}
+
+const testDocument = `
+version: axonhub/v1
+channels:
+  - name: OpenAI
+    type: openai
+    baseURL: https://api.openai.com/v1
+    status: enabled
+    credentials:
+      apiKeys:
+        - env: TEST_OPENAI_KEY
+    supportedModels: [gpt-4o]
+    defaultTestModel: gpt-4o
+    tags: [prod]
+    settings:
+      modelMappings:
+        - from: gpt-4
+          to: gpt-4o
+models:
+  - developer: openai
+    modelID: gpt-4o
+    name: GPT-4o
+    status: enabled
+    settings:
+      associations:
+        - type: channel_model
+          priority: 1
+          channelModel:
+            channel: OpenAI
+            modelId: gpt-4o
+prices:
+  - channel: OpenAI
+    modelID: gpt-4o
+    price:
+      items:
+        - itemCode: prompt_tokens
+          pricing:
+            mode: usage_per_unit
+            usagePerUnit: "0.0025"
+apiKeys:
+  - project: Default
+    name: ci
+    key:
+      env: TEST_CI_KEY
+    scopes: [read_channels, write_requests]
+    profiles:
+      activeProfile: default
+      profiles:
+        - name: default
+          channels: [OpenAI]
+`
+
+func TestApply(t *testing.T) {
+	client, svc, ctx := setupGitOpsTest(t)
+
+	t.Setenv("TEST_OPENAI_KEY", "sk-openai")
+	t.Setenv("TEST_CI_KEY", "ah-ci")
+
+	doc, err := Parse([]byte(testDocument))
+	require.NoError(t, err)
+
+	dryRun, err := svc.ApplyWithoutAuth(ctx, doc, ApplyOptions{DryRun: true})
+	require.NoError(t, err)
+	require.Len(t, dryRun.Changes, 4)
+
+	count, err := client.Channel.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count, "dry run must not change the database")
+
+	applied, err := svc.ApplyWithoutAuth(ctx, doc, ApplyOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []*Change{
+		{Kind: KindChannel, Name: "OpenAI", Action: ActionCreate},
+		{Kind: KindModel, Name: "openai/gpt-4o", Action: ActionCreate},
+		{Kind: KindPrice, Name: "OpenAI/gpt-4o", Action: ActionCreate},
+		{Kind: KindAPIKey, Name: "Default/ci", Action: ActionCreate},
+	}, applied.Changes)
+
+	ch, err := client.Channel.Query().Where(channel.Name("OpenAI")).Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"sk-openai"}, ch.Credentials.APIKeys)
+	require.Equal(t, channel.StatusEnabled, ch.Status)
+
+	m, err := client.Model.Query().Where(model.ModelID("gpt-4o")).Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ch.ID, m.Settings.Associations[0].ChannelModel.ChannelID)
+
+	key, err := client.APIKey.Query().Where(apikey.Name("ci")).Only(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "ah-ci", key.Key)
+	require.Equal(t, []int{ch.ID}, key.Profiles.Profiles[0].ChannelIDs)
+
+	t.Run("applying again changes nothing", func(t *testing.T) {
+		doc, err := Parse([]byte(testDocument))
+		require.NoError(t, err)
+
+		again, err := svc.ApplyWithoutAuth(ctx, doc, ApplyOptions{})
+		require.NoError(t, err)
+		require.True(t, again.Empty(), "unexpected changes: %v", again.Changes)
+	})
+
+	t.Run("changed fields are planned", func(t *testing.T) {
+		t.Setenv("TEST_OPENAI_KEY", "sk-rotated")
+
+		doc, err := Parse([]byte(testDocument))
+		require.NoError(t, err)
+
+		doc.Channels[0].Tags = []string{"staging"}
+
+		changed, err := svc.ApplyWithoutAuth(ctx, doc, ApplyOptions{DryRun: true})
+		require.NoError(t, err)
+		require.Equal(t, []*Change{
+			{Kind: KindChannel, Name: "OpenAI", Action: ActionUpdate, Fields: []string{"tags", "credentials"}},
+		}, changed.Changes)
+	})
+}
+
+func TestApply_ExportedDocumentIsUnchanged(t *testing.T) {
+	client, svc, ctx := setupGitOpsTest(t)
+
+	ch, err := client.Channel.Create().
+		SetName("Anthropic (US)").
+		SetType(channel.TypeAnthropic).
+		SetCredentials(objects.ChannelCredentials{APIKey: "sk-ant"}).
+		SetSupportedModels([]string{"claude-sonnet-4"}).
+		SetDefaultTestModel("claude-sonnet-4").
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.Model.Create().
+		SetDeveloper("anthropic").
+		SetModelID("claude-sonnet-4").
+		SetName("Claude Sonnet 4").
+		SetIcon("Claude").
+		SetGroup("claude").
+		SetModelCard(&objects.ModelCard{}).
+		SetSettings(&objects.ModelSettings{
+			Associations: []*objects.ModelAssociation{{
+				Type: "regex",
+				Regex: &objects.RegexAssociation{
+					Pattern: "claude-.*",
+					Exclude: []*objects.ExcludeAssociation{{ChannelIds: []int{ch.ID}}},
+				},
+			}},
+		}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.ChannelModelPrice.Create().
+		SetChannelID(ch.ID).
+		SetModelID("claude-sonnet-4").
+		SetPrice(objects.ModelPrice{Items: []objects.ModelPriceItem{{
+			ItemCode: objects.PriceItemCodeUsage,
+			Pricing: objects.Pricing{
+				Mode:         objects.PricingModeUsagePerUnit,
+				UsagePerUnit: lo.ToPtr(decimal.RequireFromString("0.003")),
+			},
+		}}}).
+		SetReferenceID("ref").
+		Save(ctx)
+	require.NoError(t, err)
+
+	exported, err := svc.Export(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &Credentials{APIKey: &SecretRef{Env: "AXONHUB_CHANNEL_ANTHROPIC__US__API_KEY"}}, exported.Channels[0].Credentials)
+
+	dir := t.TempDir()
+	require.NoError(t, WriteDir(exported, dir))
+
+	data, err := os.ReadFile(filepath.Join(dir, "models.yaml"))
+	require.NoError(t, err)
+	require.Contains(t, string(data), "- Anthropic (US)")
+
+	t.Setenv("AXONHUB_CHANNEL_ANTHROPIC__US__API_KEY", "sk-ant")
+
+	loaded, err := Load(dir)
+	require.NoError(t, err)
+
+	result, err := svc.ApplyWithoutAuth(ctx, loaded, ApplyOptions{Prune: true})
+	require.NoError(t, err)
+	require.True(t, result.Empty(), "unexpected changes: %v", result.Changes)
+}
+
+func TestApply_Prune(t *testing.T) {
+	client, svc, ctx := setupGitOpsTest(t)
+
+	for _, name := range []string{"kept", "unmanaged"} {
+		_, err := client.Channel.Create().
+			SetName(name).
+			SetType(channel.TypeOpenai).
+			SetCredentials(objects.ChannelCredentials{APIKey: "sk"}).
+			SetSupportedModels([]string{}).
+			SetDefaultTestModel("").
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	_, err := client.Model.Create().
+		SetDeveloper("openai").
+		SetModelID("gpt-4o").
+		SetName("GPT-4o").
+		SetIcon("").
+		SetGroup("").
+		SetModelCard(&objects.ModelCard{}).
+		SetSettings(&objects.ModelSettings{}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	doc, err := Parse([]byte(`
+channels:
+  - name: kept
+    type: openai
+    status: enabled
+`))
+	require.NoError(t, err)
+
+	result, err := svc.ApplyWithoutAuth(ctx, doc, ApplyOptions{Prune: true})
+	require.NoError(t, err)
+	require.Equal(t, []*Change{
+		{Kind: KindChannel, Name: "kept", Action: ActionUpdate, Fields: []string{"status"}},
+		{Kind: KindChannel, Name: "unmanaged", Action: ActionDelete},
+	}, result.Changes, "models are not managed by the document and must not be pruned")
+
+	channels, err := client.Channel.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+	require.Equal(t, "sk", channels[0].Credentials.APIKey, "omitted credentials must be kept")
+
+	t.Run("references to pruned channels are rejected", func(t *testing.T) {
+		doc, err := Parse([]byte(`
+channels: []
+apiKeys:
+  - project: Default
+    name: ci
+    key: {env: TEST_CI_KEY}
+    profiles:
+      activeProfile: default
+      profiles:
+        - name: default
+          channels: [kept]
+`))
+		require.NoError(t, err)
+
+		t.Setenv("TEST_CI_KEY", "ah-ci")
+
+		_, err = svc.ApplyWithoutAuth(ctx, doc, ApplyOptions{Prune: true})
+		require.ErrorContains(t, err, `channel "kept" not found`)
+	})
+}
+
+func TestApply_Errors(t *testing.T) {
+	_, svc, ctx := setupGitOpsTest(t)
+
+	tests := []struct {
+		name     string
+		document string
+		err      string
+	}{
+		{
+			name: "unset secret",
+			document: `
+channels:
+  - name: OpenAI
+    type: openai
+    credentials:
+      apiKey: {env: TEST_UNSET_KEY}
+`,
+			err: "environment variable TEST_UNSET_KEY is not set",
+		},
+		{
+			name: "new channel without credentials",
+			document: `
+channels:
+  - name: OpenAI
+    type: openai
+`,
+			err: "credentials are required for new channels",
+		},
+		{
+			name: "unknown field in settings",
+			document: `
+models:
+  - developer: openai
+    modelID: gpt-4o
+    name: GPT-4o
+    settings:
+      asociations: []
+`,
+			err: `unknown field "asociations"`,
+		},
+		{
+			name: "duplicate",
+			document: `
+models:
+  - {developer: openai, modelID: gpt-4o, name: GPT-4o}
+  - {developer: openai, modelID: gpt-4o, name: GPT-4o}
+`,
+			err: "model openai/gpt-4o is declared more than once",
+		},
+		{
+			name: "unknown project",
+			document: `
+apiKeys:
+  - {project: Missing, name: ci, key: {env: HOME}}
+`,
+			err: `project "Missing" not found`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Parse([]byte(tt.document))
+			require.NoError(t, err)
+
+			_, err = svc.ApplyWithoutAuth(ctx, doc, ApplyOptions{DryRun: true})
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestParse_RejectsUnknownFields(t *testing.T) {
+	_, err := Parse([]byte(`
+channels:
+  - name: OpenAI
+    typ: openai
+`))
+	require.ErrorContains(t, err, "field typ not found")
+}
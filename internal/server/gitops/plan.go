@@ -0,0 +1,648 @@
+package gitops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/project"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// operation is a planned change with the function applying it.
+type operation struct {
+	change *Change
+	apply  func(ctx context.Context, a *applier) error
+}
+
+// planner compares a document with the state and plans the operations to make the state match the document.
+type planner struct {
+	state *state
+	doc   *Document
+	prune bool
+
+	// projectIDs are the IDs of the projects of the declared API keys.
+	projectIDs map[string]int
+
+	// channelIDs are the IDs of the channels existing after the apply, used to check the channel references.
+	// The channels to create get negative placeholder IDs.
+	channelIDs   map[string]int
+	channelNames map[int]string
+
+	operations []*operation
+	deletions  []*operation
+	errs       []error
+}
+
+// plan validates the document and plans the operations, the deletions are planned after the other operations
+// in the reverse order of the kinds, so that the objects are deleted before the objects they reference.
+func plan(ctx context.Context, db *ent.Client, doc *Document, opts ApplyOptions) ([]*operation, error) {
+	if err := doc.validate(); err != nil {
+		return nil, err
+	}
+
+	st, err := loadState(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &planner{
+		state:        st,
+		doc:          doc,
+		prune:        opts.Prune,
+		projectIDs:   map[string]int{},
+		channelIDs:   map[string]int{},
+		channelNames: map[int]string{},
+	}
+
+	if err := p.loadProjects(ctx, db); err != nil {
+		return nil, err
+	}
+
+	p.planChannelIDs()
+	p.planChannels()
+	p.planModels()
+	p.planPrices()
+	p.planAPIKeys()
+
+	if err := errors.Join(p.errs...); err != nil {
+		return nil, err
+	}
+
+	slices.Reverse(p.deletions)
+
+	return append(p.operations, p.deletions...), nil
+}
+
+func (p *planner) loadProjects(ctx context.Context, db *ent.Client) error {
+	var names []string
+	for _, k := range p.doc.APIKeys {
+		names = append(names, k.Project)
+	}
+
+	if len(names) == 0 {
+		return nil
+	}
+
+	projects, err := db.Project.Query().
+		Where(project.NameIn(names...)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query projects: %w", err)
+	}
+
+	for _, proj := range projects {
+		p.projectIDs[proj.Name] = proj.ID
+	}
+
+	return nil
+}
+
+// pruned reports whether the existing objects of a kind not declared in the document are deleted.
+func (p *planner) pruned(declared bool) bool {
+	return p.prune && declared
+}
+
+func (p *planner) fail(kind, name string, err error) {
+	p.errs = append(p.errs, fmt.Errorf("%s %s: %w", kind, name, err))
+}
+
+func (p *planner) add(change *Change, apply func(ctx context.Context, a *applier) error) {
+	op := &operation{change: change, apply: apply}
+
+	if change.Action == ActionDelete {
+		p.deletions = append(p.deletions, op)
+	} else {
+		p.operations = append(p.operations, op)
+	}
+}
+
+func (p *planner) planChannelIDs() {
+	placeholder := 0
+
+	for _, ch := range p.doc.Channels {
+		if existing := p.existingChannel(ch.Name); existing != nil {
+			p.channelIDs[ch.Name] = existing.ID
+		} else {
+			placeholder--
+			p.channelIDs[ch.Name] = placeholder
+		}
+	}
+
+	if !p.pruned(p.doc.Channels != nil) {
+		for _, ch := range p.state.channels {
+			p.channelIDs[ch.Name] = ch.ID
+		}
+	}
+
+	for name, id := range p.channelIDs {
+		p.channelNames[id] = name
+	}
+}
+
+func (p *planner) existingChannel(name string) *ent.Channel {
+	existing, _ := lo.Find(p.state.channels, func(ch *ent.Channel) bool {
+		return ch.Name == name
+	})
+
+	return existing
+}
+
+func (p *planner) planChannels() {
+	declared := map[string]bool{}
+
+	for _, ch := range p.doc.Channels {
+		declared[ch.Name] = true
+
+		desired, err := normalizeChannel(ch)
+		if err != nil {
+			p.fail(KindChannel, ch.Name, err)
+			continue
+		}
+
+		existing := p.existingChannel(ch.Name)
+		if existing == nil {
+			if ch.Credentials == nil {
+				p.fail(KindChannel, ch.Name, errors.New("credentials are required for new channels"))
+				continue
+			}
+
+			credentials, err := resolveCredentials(ch.Credentials, objects.ChannelCredentials{})
+			if err != nil {
+				p.fail(KindChannel, ch.Name, fmt.Errorf("credentials: %w", err))
+				continue
+			}
+
+			p.add(&Change{Kind: KindChannel, Name: ch.Name, Action: ActionCreate}, func(ctx context.Context, a *applier) error {
+				return a.createChannel(ctx, ch, credentials)
+			})
+
+			continue
+		}
+
+		current, err := exportChannel(existing)
+		if err != nil {
+			p.fail(KindChannel, ch.Name, err)
+			continue
+		}
+
+		fields, err := changedFields(current, desired, "credentials")
+		if err != nil {
+			p.fail(KindChannel, ch.Name, err)
+			continue
+		}
+
+		credentials := existing.Credentials
+
+		if ch.Credentials != nil {
+			credentials, err = resolveCredentials(ch.Credentials, existing.Credentials)
+			if err != nil {
+				p.fail(KindChannel, ch.Name, fmt.Errorf("credentials: %w", err))
+				continue
+			}
+
+			if !sameCanonical(managedCredentials(existing.Credentials), managedCredentials(credentials)) {
+				fields = append(fields, "credentials")
+			}
+		}
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		p.add(&Change{Kind: KindChannel, Name: ch.Name, Action: ActionUpdate, Fields: fields}, func(ctx context.Context, a *applier) error {
+			return a.updateChannel(ctx, existing.ID, ch, credentials)
+		})
+	}
+
+	if !p.pruned(p.doc.Channels != nil) {
+		return
+	}
+
+	for _, existing := range p.state.channels {
+		if declared[existing.Name] {
+			continue
+		}
+
+		p.add(&Change{Kind: KindChannel, Name: existing.Name, Action: ActionDelete}, func(ctx context.Context, a *applier) error {
+			return a.db.Channel.DeleteOneID(existing.ID).Exec(ctx)
+		})
+	}
+}
+
+func (p *planner) planModels() {
+	declared := map[string]bool{}
+
+	for _, m := range p.doc.Models {
+		name := modelName(m.Developer, m.ModelID)
+		declared[name] = true
+
+		desired, err := p.normalizeModel(m)
+		if err != nil {
+			p.fail(KindModel, name, err)
+			continue
+		}
+
+		existing, _ := lo.Find(p.state.models, func(e *ent.Model) bool {
+			return e.Developer == m.Developer && e.ModelID == m.ModelID
+		})
+		if existing == nil {
+			p.add(&Change{Kind: KindModel, Name: name, Action: ActionCreate}, func(ctx context.Context, a *applier) error {
+				return a.createModel(ctx, m)
+			})
+
+			continue
+		}
+
+		current, err := exportModel(existing, p.state.channelNames)
+		if err != nil {
+			p.fail(KindModel, name, err)
+			continue
+		}
+
+		fields, err := changedFields(current, desired)
+		if err != nil {
+			p.fail(KindModel, name, err)
+			continue
+		}
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		p.add(&Change{Kind: KindModel, Name: name, Action: ActionUpdate, Fields: fields}, func(ctx context.Context, a *applier) error {
+			return a.updateModel(ctx, existing.ID, m)
+		})
+	}
+
+	if !p.pruned(p.doc.Models != nil) {
+		return
+	}
+
+	for _, existing := range p.state.models {
+		name := modelName(existing.Developer, existing.ModelID)
+		if declared[name] {
+			continue
+		}
+
+		p.add(&Change{Kind: KindModel, Name: name, Action: ActionDelete}, func(ctx context.Context, a *applier) error {
+			return a.db.Model.DeleteOneID(existing.ID).Exec(ctx)
+		})
+	}
+}
+
+func (p *planner) planPrices() {
+	declared := map[string]bool{}
+
+	for _, price := range p.doc.Prices {
+		name := priceName(price.Channel, price.ModelID)
+		declared[name] = true
+
+		if _, ok := p.channelIDs[price.Channel]; !ok {
+			p.fail(KindPrice, name, fmt.Errorf("channel %q not found", price.Channel))
+			continue
+		}
+
+		desired, err := normalizePrice(price)
+		if err != nil {
+			p.fail(KindPrice, name, err)
+			continue
+		}
+
+		existing, _ := lo.Find(p.state.prices, func(e *ent.ChannelModelPrice) bool {
+			return e.Edges.Channel.Name == price.Channel && e.ModelID == price.ModelID
+		})
+		if existing == nil {
+			p.add(&Change{Kind: KindPrice, Name: name, Action: ActionCreate}, func(ctx context.Context, a *applier) error {
+				return a.createPrice(ctx, price)
+			})
+
+			continue
+		}
+
+		current, err := exportPrice(existing)
+		if err != nil {
+			p.fail(KindPrice, name, err)
+			continue
+		}
+
+		fields, err := changedFields(current, desired)
+		if err != nil {
+			p.fail(KindPrice, name, err)
+			continue
+		}
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		p.add(&Change{Kind: KindPrice, Name: name, Action: ActionUpdate, Fields: fields}, func(ctx context.Context, a *applier) error {
+			return a.updatePrice(ctx, existing, price)
+		})
+	}
+
+	if !p.pruned(p.doc.Prices != nil) {
+		return
+	}
+
+	for _, existing := range p.state.prices {
+		name := priceName(existing.Edges.Channel.Name, existing.ModelID)
+		if declared[name] {
+			continue
+		}
+
+		p.add(&Change{Kind: KindPrice, Name: name, Action: ActionDelete}, func(ctx context.Context, a *applier) error {
+			return a.deletePrice(ctx, existing)
+		})
+	}
+}
+
+func (p *planner) planAPIKeys() {
+	declared := map[string]bool{}
+
+	for _, k := range p.doc.APIKeys {
+		name := apiKeyName(k.Project, k.Name)
+		declared[name] = true
+
+		projectID, ok := p.projectIDs[k.Project]
+		if !ok {
+			p.fail(KindAPIKey, name, fmt.Errorf("project %q not found", k.Project))
+			continue
+		}
+
+		desired, err := p.normalizeAPIKey(k)
+		if err != nil {
+			p.fail(KindAPIKey, name, err)
+			continue
+		}
+
+		existing, _ := lo.Find(p.state.apiKeys, func(e *ent.APIKey) bool {
+			return e.ProjectID == projectID && e.Name == k.Name
+		})
+		if existing == nil {
+			if k.Key == nil {
+				p.fail(KindAPIKey, name, errors.New("key is required for new API keys"))
+				continue
+			}
+
+			key, err := k.Key.resolve()
+			if err != nil {
+				p.fail(KindAPIKey, name, fmt.Errorf("key: %w", err))
+				continue
+			}
+
+			p.add(&Change{Kind: KindAPIKey, Name: name, Action: ActionCreate}, func(ctx context.Context, a *applier) error {
+				return a.createAPIKey(ctx, projectID, k, key)
+			})
+
+			continue
+		}
+
+		current, err := exportAPIKey(existing, p.state.channelNames)
+		if err != nil {
+			p.fail(KindAPIKey, name, err)
+			continue
+		}
+
+		// The owner can not be changed.
+		fields, err := changedFields(current, desired, "key", "owner")
+		if err != nil {
+			p.fail(KindAPIKey, name, err)
+			continue
+		}
+
+		key := existing.Key
+
+		if k.Key != nil {
+			key, err = k.Key.resolve()
+			if err != nil {
+				p.fail(KindAPIKey, name, fmt.Errorf("key: %w", err))
+				continue
+			}
+
+			if key != existing.Key {
+				fields = append(fields, "key")
+			}
+		}
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		p.add(&Change{Kind: KindAPIKey, Name: name, Action: ActionUpdate, Fields: fields}, func(ctx context.Context, a *applier) error {
+			return a.updateAPIKey(ctx, existing.ID, k, key)
+		})
+	}
+
+	if !p.pruned(p.doc.APIKeys != nil) {
+		return
+	}
+
+	for _, existing := range p.state.apiKeys {
+		name := apiKeyName(apiKeyProjectName(existing), existing.Name)
+		if declared[name] {
+			continue
+		}
+
+		p.add(&Change{Kind: KindAPIKey, Name: name, Action: ActionDelete}, func(ctx context.Context, a *applier) error {
+			return a.db.APIKey.DeleteOneID(existing.ID).Exec(ctx)
+		})
+	}
+}
+
+// normalizeChannel returns the channel as it is exported after it is applied.
+func normalizeChannel(ch *Channel) (*Channel, error) {
+	normalized := *ch
+	normalized.Credentials = nil
+
+	var err error
+
+	if normalized.Policies, err = normalize[objects.ChannelPolicies](ch.Policies); err != nil {
+		return nil, fmt.Errorf("policies: %w", err)
+	}
+
+	if normalized.Settings, err = normalize[objects.ChannelSettings](ch.Settings); err != nil {
+		return nil, fmt.Errorf("settings: %w", err)
+	}
+
+	if normalized.Endpoints, err = normalize[[]objects.ChannelEndpoint](ch.Endpoints); err != nil {
+		return nil, fmt.Errorf("endpoints: %w", err)
+	}
+
+	return &normalized, nil
+}
+
+// normalizeModel returns the model as it is exported after it is applied.
+func (p *planner) normalizeModel(m *Model) (*Model, error) {
+	normalized := *m
+
+	var err error
+
+	if normalized.ModelCard, err = normalize[objects.ModelCard](m.ModelCard); err != nil {
+		return nil, fmt.Errorf("modelCard: %w", err)
+	}
+
+	settings, err := modelSettings(m.Settings, p.channelIDs)
+	if err != nil {
+		return nil, fmt.Errorf("settings: %w", err)
+	}
+
+	canonicalSettings, err := canonical(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized.Settings = channelRefsToNames(canonicalSettings, modelSettingsChannelRefKeys, p.channelNames)
+
+	return &normalized, nil
+}
+
+// normalizePrice returns the price as it is exported after it is applied.
+func normalizePrice(price *Price) (*Price, error) {
+	modelPrice, err := decode[objects.ModelPrice](price.Price)
+	if err != nil {
+		return nil, fmt.Errorf("price: %w", err)
+	}
+
+	if err := modelPrice.Validate(); err != nil {
+		return nil, fmt.Errorf("price: %w", err)
+	}
+
+	normalized := *price
+
+	if normalized.Price, err = canonical(modelPrice); err != nil {
+		return nil, err
+	}
+
+	return &normalized, nil
+}
+
+// normalizeAPIKey returns the API key as it is exported after it is applied.
+func (p *planner) normalizeAPIKey(k *APIKey) (*APIKey, error) {
+	if err := biz.ValidateAPIKeyAllowedIPs(k.AllowedIPs); err != nil {
+		return nil, fmt.Errorf("allowedIPs: %w", err)
+	}
+
+	profiles, err := apiKeyProfiles(k.Profiles, p.channelIDs)
+	if err != nil {
+		return nil, fmt.Errorf("profiles: %w", err)
+	}
+
+	canonicalProfiles, err := canonical(profiles)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized := *k
+	normalized.Profiles = channelRefsToNames(canonicalProfiles, apiKeyProfilesChannelRefKeys, p.channelNames)
+
+	return &normalized, nil
+}
+
+// modelSettings decodes the model settings of a document with the channel references resolved to IDs.
+func modelSettings(v any, channelIDs map[string]int) (objects.ModelSettings, error) {
+	// Cloned without dropping the zero values, so that the unknown fields are still rejected.
+	copied, err := clone(v)
+	if err != nil {
+		return objects.ModelSettings{}, err
+	}
+
+	withIDs, err := channelRefsToIDs(copied, modelSettingsChannelRefKeys, channelIDs)
+	if err != nil {
+		return objects.ModelSettings{}, err
+	}
+
+	return decode[objects.ModelSettings](withIDs)
+}
+
+// apiKeyProfiles decodes and validates the API key profiles of a document with the channel references resolved to IDs.
+func apiKeyProfiles(v any, channelIDs map[string]int) (objects.APIKeyProfiles, error) {
+	// Cloned without dropping the zero values, so that the unknown fields are still rejected.
+	copied, err := clone(v)
+	if err != nil {
+		return objects.APIKeyProfiles{}, err
+	}
+
+	withIDs, err := channelRefsToIDs(copied, apiKeyProfilesChannelRefKeys, channelIDs)
+	if err != nil {
+		return objects.APIKeyProfiles{}, err
+	}
+
+	profiles, err := decode[objects.APIKeyProfiles](withIDs)
+	if err != nil {
+		return objects.APIKeyProfiles{}, err
+	}
+
+	if len(profiles.Profiles) > 0 {
+		if err := biz.ValidateAPIKeyProfiles(profiles); err != nil {
+			return objects.APIKeyProfiles{}, err
+		}
+	}
+
+	return profiles, nil
+}
+
+// changedFields lists the top level fields which differ between the exported and the normalized declared object.
+// The fields are compared in their YAML form, so omitted and empty values are the same.
+func changedFields(current, desired any, ignored ...string) ([]string, error) {
+	currentFields, err := yamlFields(current)
+	if err != nil {
+		return nil, err
+	}
+
+	desiredFields, err := yamlFields(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := map[string]any{}
+	for key := range currentFields {
+		keys[key] = nil
+	}
+
+	for key := range desiredFields {
+		keys[key] = nil
+	}
+
+	var fields []string
+
+	for _, key := range sortedKeys(keys) {
+		if slices.Contains(ignored, key) {
+			continue
+		}
+
+		if !reflect.DeepEqual(currentFields[key], desiredFields[key]) {
+			fields = append(fields, key)
+		}
+	}
+
+	return fields, nil
+}
+
+func yamlFields(v any) (map[string]any, error) {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]any
+	if err := yaml.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+func sameCanonical(a, b any) bool {
+	ca, errA := canonical(a)
+	cb, errB := canonical(b)
+
+	return errA == nil && errB == nil && reflect.DeepEqual(ca, cb)
+}
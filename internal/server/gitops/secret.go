@@ -0,0 +1,199 @@
+package gitops
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+func (r *SecretRef) validate() error {
+	switch {
+	case r.Env != "" && r.File != "":
+		return errors.New("secret reference must set only one of env and file")
+	case r.Env == "" && r.File == "":
+		return errors.New("secret reference must set env or file")
+	default:
+		return nil
+	}
+}
+
+// resolve reads the referenced secret.
+func (r *SecretRef) resolve() (string, error) {
+	if err := r.validate(); err != nil {
+		return "", err
+	}
+
+	if r.Env != "" {
+		value, ok := os.LookupEnv(r.Env)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", r.Env)
+		}
+
+		return value, nil
+	}
+
+	data, err := os.ReadFile(r.File)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret file: %w", err)
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
+
+// secretRefPaths returns the paths of the secret references of the document.
+func (d *Document) secretRefPaths() []string {
+	var paths []string
+
+	for _, ch := range d.Channels {
+		if ch.Credentials == nil {
+			continue
+		}
+
+		if ch.Credentials.APIKey != nil {
+			paths = append(paths, fmt.Sprintf("channel %q credentials.apiKey", ch.Name))
+		}
+
+		for i := range ch.Credentials.APIKeys {
+			paths = append(paths, fmt.Sprintf("channel %q credentials.apiKeys[%d]", ch.Name, i))
+		}
+
+		if ch.Credentials.GCP != nil && ch.Credentials.GCP.JSONData != nil {
+			paths = append(paths, fmt.Sprintf("channel %q credentials.gcp.jsonData", ch.Name))
+		}
+	}
+
+	for _, k := range d.APIKeys {
+		if k.Key != nil {
+			paths = append(paths, fmt.Sprintf("api key %s/%s key", k.Project, k.Name))
+		}
+	}
+
+	return paths
+}
+
+// resolveCredentials resolves the secrets of the declared credentials,
+// the OAuth credentials of the existing channel are kept.
+func resolveCredentials(declared *Credentials, existing objects.ChannelCredentials) (objects.ChannelCredentials, error) {
+	credentials := objects.ChannelCredentials{
+		OAuth: existing.OAuth,
+	}
+
+	if declared.APIKey != nil {
+		value, err := declared.APIKey.resolve()
+		if err != nil {
+			return credentials, fmt.Errorf("apiKey: %w", err)
+		}
+
+		credentials.APIKey = value
+	}
+
+	for i, ref := range declared.APIKeys {
+		value, err := ref.resolve()
+		if err != nil {
+			return credentials, fmt.Errorf("apiKeys[%d]: %w", i, err)
+		}
+
+		credentials.APIKeys = append(credentials.APIKeys, value)
+	}
+
+	if declared.Azure != nil {
+		credentials.Azure = &objects.AzureCredential{APIVersion: declared.Azure.APIVersion}
+	}
+
+	if declared.GCP != nil {
+		credentials.GCP = &objects.GCPCredential{
+			Region:    declared.GCP.Region,
+			ProjectID: declared.GCP.ProjectID,
+		}
+
+		if declared.GCP.JSONData != nil {
+			value, err := declared.GCP.JSONData.resolve()
+			if err != nil {
+				return credentials, fmt.Errorf("gcp.jsonData: %w", err)
+			}
+
+			credentials.GCP.JSONData = value
+		}
+	}
+
+	return credentials, nil
+}
+
+// managedCredentials drops the credentials which are not managed by the documents.
+func managedCredentials(credentials objects.ChannelCredentials) objects.ChannelCredentials {
+	managed := objects.ChannelCredentials{
+		APIKeys: credentials.APIKeys,
+		Azure:   credentials.Azure,
+		GCP:     credentials.GCP,
+	}
+
+	// The legacy API key of the OAuth channels holds the OAuth credentials.
+	if !credentials.IsOAuth() {
+		managed.APIKey = credentials.APIKey
+	}
+
+	return managed
+}
+
+// exportCredentials references the secrets of the channel credentials by environment variables named after the channel.
+func exportCredentials(channelName string, credentials objects.ChannelCredentials) *Credentials {
+	managed := managedCredentials(credentials)
+	prefix := secretEnvName("AXONHUB_CHANNEL", channelName)
+
+	var exported Credentials
+
+	if managed.APIKey != "" {
+		exported.APIKey = &SecretRef{Env: prefix + "_API_KEY"}
+	}
+
+	for i := range managed.APIKeys {
+		exported.APIKeys = append(exported.APIKeys, SecretRef{Env: prefix + "_API_KEYS_" + strconv.Itoa(i+1)})
+	}
+
+	if managed.Azure != nil {
+		exported.Azure = &AzureCredential{APIVersion: managed.Azure.APIVersion}
+	}
+
+	if managed.GCP != nil {
+		exported.GCP = &GCPCredential{
+			Region:    managed.GCP.Region,
+			ProjectID: managed.GCP.ProjectID,
+		}
+
+		if managed.GCP.JSONData != "" {
+			exported.GCP.JSONData = &SecretRef{Env: prefix + "_GCP_JSON"}
+		}
+	}
+
+	if exported.APIKey == nil && len(exported.APIKeys) == 0 && exported.Azure == nil && exported.GCP == nil {
+		return nil
+	}
+
+	return &exported
+}
+
+// secretEnvName builds an environment variable name from the prefix and the object names,
+// e.g. AXONHUB_CHANNEL and "OpenAI (US)" give AXONHUB_CHANNEL_OPENAI__US_.
+func secretEnvName(prefix string, names ...string) string {
+	var b strings.Builder
+
+	b.WriteString(prefix)
+
+	for _, name := range names {
+		b.WriteByte('_')
+
+		for _, r := range strings.ToUpper(name) {
+			if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+				b.WriteRune(r)
+			} else {
+				b.WriteByte('_')
+			}
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,144 @@
+package gitops
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/fx"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+)
+
+type GitOpsServiceParams struct {
+	fx.In
+
+	Ent *ent.Client
+}
+
+func NewGitOpsService(params GitOpsServiceParams) *GitOpsService {
+	return &GitOpsService{
+		db: params.Ent,
+	}
+}
+
+// GitOpsService exports the channels, models, prices and API keys as declarative documents and applies them.
+type GitOpsService struct {
+	db *ent.Client
+}
+
+func checkOwner(ctx context.Context) error {
+	user, ok := contexts.GetUser(ctx)
+	if !ok || user == nil {
+		return fmt.Errorf("user not found in context")
+	}
+
+	if !user.IsOwner {
+		return fmt.Errorf("only owners can export or apply configuration")
+	}
+
+	return nil
+}
+
+// Export exports the managed objects, the secrets are referenced by environment variables named after the objects.
+func (svc *GitOpsService) Export(ctx context.Context) (*Document, error) {
+	if err := checkOwner(ctx); err != nil {
+		return nil, err
+	}
+
+	return svc.ExportWithoutAuth(ctx)
+}
+
+// ExportWithoutAuth exports without user authentication check, it is used by the CLI which runs in a privileged context.
+func (svc *GitOpsService) ExportWithoutAuth(ctx context.Context) (*Document, error) {
+	st, err := loadState(ctx, svc.db)
+	if err != nil {
+		return nil, err
+	}
+
+	return st.document()
+}
+
+// Apply makes the database match the document and returns the applied changes.
+// The changes are applied in a single transaction, applying the same document again changes nothing.
+//
+// The secret references are rejected: they are resolved from the environment and the files of the server,
+// so a document applied through the API could send the secrets of the server, e.g. its database DSN, to a
+// channel pointing to another host. Only the CLI resolves them, see ApplyWithoutAuth.
+func (svc *GitOpsService) Apply(ctx context.Context, doc *Document, opts ApplyOptions) (*Plan, error) {
+	if err := checkOwner(ctx); err != nil {
+		return nil, err
+	}
+
+	if paths := doc.secretRefPaths(); len(paths) > 0 {
+		return nil, fmt.Errorf("secret references can only be applied with the CLI: %s", strings.Join(paths, ", "))
+	}
+
+	return svc.ApplyWithoutAuth(ctx, doc, opts)
+}
+
+// ApplyWithoutAuth applies without user authentication check and resolves the secret references,
+// it is used by the CLI which runs in a privileged context.
+func (svc *GitOpsService) ApplyWithoutAuth(ctx context.Context, doc *Document, opts ApplyOptions) (*Plan, error) {
+	if opts.DryRun {
+		operations, err := plan(ctx, svc.db, doc, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return planOf(operations), nil
+	}
+
+	tx, err := svc.db.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	committed := false
+
+	defer func() {
+		if !committed {
+			_ = tx.Rollback()
+		}
+	}()
+
+	txClient := tx.Client()
+
+	operations, err := plan(ctx, txClient, doc, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := newApplier(ctx, txClient)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, op := range operations {
+		if err := op.apply(ctx, a); err != nil {
+			return nil, fmt.Errorf("failed to %s %s %s: %w", op.change.Action, op.change.Kind, op.change.Name, err)
+		}
+	}
+
+	if err := a.finish(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	committed = true
+
+	return planOf(operations), nil
+}
+
+func planOf(operations []*operation) *Plan {
+	p := &Plan{Changes: []*Change{}}
+	for _, op := range operations {
+		p.Changes = append(p.Changes, op.change)
+	}
+
+	return p
+}
@@ -0,0 +1,254 @@
+package gitops
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/channelmodelprice"
+)
+
+// state is the managed objects in the database.
+type state struct {
+	channels []*ent.Channel
+	models   []*ent.Model
+	// prices are loaded with their channel.
+	prices []*ent.ChannelModelPrice
+	// apiKeys are loaded with their project and user, the no auth key is managed by AxonHub and not included.
+	apiKeys []*ent.APIKey
+
+	channelNames map[int]string
+}
+
+func loadState(ctx context.Context, db *ent.Client) (*state, error) {
+	channels, err := db.Channel.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query channels: %w", err)
+	}
+
+	models, err := db.Model.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query models: %w", err)
+	}
+
+	prices, err := db.ChannelModelPrice.Query().
+		Where(channelmodelprice.HasChannel()).
+		WithChannel().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query channel model prices: %w", err)
+	}
+
+	apiKeys, err := db.APIKey.Query().
+		Where(apikey.TypeNEQ(apikey.TypeNoauth)).
+		WithProject().
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query API keys: %w", err)
+	}
+
+	slices.SortFunc(channels, func(a, b *ent.Channel) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	slices.SortFunc(models, func(a, b *ent.Model) int {
+		return cmp.Or(cmp.Compare(a.Developer, b.Developer), cmp.Compare(a.ModelID, b.ModelID))
+	})
+	slices.SortFunc(prices, func(a, b *ent.ChannelModelPrice) int {
+		return cmp.Compare(priceName(a.Edges.Channel.Name, a.ModelID), priceName(b.Edges.Channel.Name, b.ModelID))
+	})
+	slices.SortFunc(apiKeys, func(a, b *ent.APIKey) int {
+		return cmp.Compare(apiKeyName(apiKeyProjectName(a), a.Name), apiKeyName(apiKeyProjectName(b), b.Name))
+	})
+
+	channelNames := make(map[int]string, len(channels))
+	for _, ch := range channels {
+		channelNames[ch.ID] = ch.Name
+	}
+
+	return &state{
+		channels:     channels,
+		models:       models,
+		prices:       prices,
+		apiKeys:      apiKeys,
+		channelNames: channelNames,
+	}, nil
+}
+
+// document exports the state, the secrets are referenced by environment variables named after the objects.
+func (s *state) document() (*Document, error) {
+	doc := &Document{Version: DocumentVersion}
+
+	for _, ch := range s.channels {
+		exported, err := exportChannel(ch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export channel %s: %w", ch.Name, err)
+		}
+
+		doc.Channels = append(doc.Channels, exported)
+	}
+
+	for _, m := range s.models {
+		exported, err := exportModel(m, s.channelNames)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export model %s: %w", modelName(m.Developer, m.ModelID), err)
+		}
+
+		doc.Models = append(doc.Models, exported)
+	}
+
+	for _, p := range s.prices {
+		exported, err := exportPrice(p)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export price %s: %w", priceName(p.Edges.Channel.Name, p.ModelID), err)
+		}
+
+		doc.Prices = append(doc.Prices, exported)
+	}
+
+	for _, k := range s.apiKeys {
+		exported, err := exportAPIKey(k, s.channelNames)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export API key %s: %w", apiKeyName(apiKeyProjectName(k), k.Name), err)
+		}
+
+		doc.APIKeys = append(doc.APIKeys, exported)
+	}
+
+	return doc, nil
+}
+
+func exportChannel(ch *ent.Channel) (*Channel, error) {
+	policies, err := canonical(ch.Policies)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := canonical(ch.Settings)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints, err := canonical(ch.Endpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	exported := &Channel{
+		Name:                    ch.Name,
+		Type:                    ch.Type.String(),
+		BaseURL:                 ch.BaseURL,
+		Status:                  ch.Status.String(),
+		Credentials:             exportCredentials(ch.Name, ch.Credentials),
+		SupportedModels:         ch.SupportedModels,
+		ManualModels:            ch.ManualModels,
+		AutoSyncSupportedModels: ch.AutoSyncSupportedModels,
+		AutoSyncModelPattern:    ch.AutoSyncModelPattern,
+		Tags:                    ch.Tags,
+		DefaultTestModel:        ch.DefaultTestModel,
+		Policies:                policies,
+		Settings:                settings,
+		Endpoints:               endpoints,
+		OrderingWeight:          ch.OrderingWeight,
+	}
+
+	if ch.Remark != nil {
+		exported.Remark = *ch.Remark
+	}
+
+	return exported, nil
+}
+
+func exportModel(m *ent.Model, channelNames map[int]string) (*Model, error) {
+	modelCard, err := canonical(m.ModelCard)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := canonical(m.Settings)
+	if err != nil {
+		return nil, err
+	}
+
+	exported := &Model{
+		Developer: m.Developer,
+		ModelID:   m.ModelID,
+		Type:      m.Type.String(),
+		Name:      m.Name,
+		Icon:      m.Icon,
+		Group:     m.Group,
+		Status:    m.Status.String(),
+		ModelCard: modelCard,
+		Settings:  channelRefsToNames(settings, modelSettingsChannelRefKeys, channelNames),
+	}
+
+	if m.Remark != nil {
+		exported.Remark = *m.Remark
+	}
+
+	return exported, nil
+}
+
+func exportPrice(p *ent.ChannelModelPrice) (*Price, error) {
+	price, err := canonical(p.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Price{
+		Channel: p.Edges.Channel.Name,
+		ModelID: p.ModelID,
+		Price:   price,
+	}, nil
+}
+
+func exportAPIKey(k *ent.APIKey, channelNames map[int]string) (*APIKey, error) {
+	profiles, err := canonical(k.Profiles)
+	if err != nil {
+		return nil, err
+	}
+
+	exported := &APIKey{
+		Project:    apiKeyProjectName(k),
+		Name:       k.Name,
+		Key:        &SecretRef{Env: secretEnvName("AXONHUB_API_KEY", apiKeyProjectName(k), k.Name)},
+		Type:       k.Type.String(),
+		Status:     k.Status.String(),
+		Scopes:     k.Scopes,
+		AllowedIPs: k.AllowedIps,
+		Profiles:   channelRefsToNames(profiles, apiKeyProfilesChannelRefKeys, channelNames),
+	}
+
+	if exported.Scopes == nil {
+		exported.Scopes = []string{}
+	}
+
+	if k.Edges.User != nil {
+		exported.Owner = k.Edges.User.Email
+	}
+
+	return exported, nil
+}
+
+func apiKeyProjectName(k *ent.APIKey) string {
+	if k.Edges.Project == nil {
+		return ""
+	}
+
+	return k.Edges.Project.Name
+}
+
+func modelName(developer, modelID string) string {
+	return developer + "/" + modelID
+}
+
+func priceName(channelName, modelID string) string {
+	return channelName + "/" + modelID
+}
+
+func apiKeyName(projectName, name string) string {
+	return projectName + "/" + name
+}
@@ -0,0 +1,150 @@
+package gitops
+
+// DocumentVersion is the version of the declarative configuration format.
+const DocumentVersion = "axonhub/v1"
+
+// Document is the declarative configuration of the routing entities.
+// Channels are referenced by name, so a document can be applied to any instance.
+//
+// A nil list means the kind is not managed by the document, an empty list means no object of the kind should exist,
+// which only matters when unmanaged objects are pruned.
+type Document struct {
+	Version  string     `yaml:"version"`
+	Channels []*Channel `yaml:"channels,omitempty"`
+	Models   []*Model   `yaml:"models,omitempty"`
+	Prices   []*Price   `yaml:"prices,omitempty"`
+	APIKeys  []*APIKey  `yaml:"apiKeys,omitempty"`
+}
+
+// SecretRef references a secret kept outside of the configuration, exactly one of the fields must be set.
+type SecretRef struct {
+	// Env is the environment variable holding the secret.
+	Env string `yaml:"env,omitempty"`
+	// File is the path of the file holding the secret, surrounding whitespace is trimmed.
+	File string `yaml:"file,omitempty"`
+}
+
+// Credentials are the channel credentials with the secrets replaced by references.
+// OAuth credentials are refreshed by AxonHub itself, so they are not managed and kept unchanged.
+type Credentials struct {
+	APIKey  *SecretRef       `yaml:"apiKey,omitempty"`
+	APIKeys []SecretRef      `yaml:"apiKeys,omitempty"`
+	Azure   *AzureCredential `yaml:"azure,omitempty"`
+	GCP     *GCPCredential   `yaml:"gcp,omitempty"`
+}
+
+type AzureCredential struct {
+	APIVersion string `yaml:"apiVersion"`
+}
+
+type GCPCredential struct {
+	Region    string `yaml:"region"`
+	ProjectID string `yaml:"projectID"`
+	// JSONData references the service account key file.
+	JSONData *SecretRef `yaml:"jsonData,omitempty"`
+}
+
+// Channel is identified by its name.
+// The structured fields have the same shape as in the GraphQL API.
+type Channel struct {
+	Name    string `yaml:"name"`
+	Type    string `yaml:"type"`
+	BaseURL string `yaml:"baseURL,omitempty"`
+	Status  string `yaml:"status"`
+	// Credentials are kept unchanged when omitted for an existing channel.
+	Credentials             *Credentials `yaml:"credentials,omitempty"`
+	SupportedModels         []string     `yaml:"supportedModels,omitempty"`
+	ManualModels            []string     `yaml:"manualModels,omitempty"`
+	AutoSyncSupportedModels bool         `yaml:"autoSyncSupportedModels,omitempty"`
+	AutoSyncModelPattern    string       `yaml:"autoSyncModelPattern,omitempty"`
+	Tags                    []string     `yaml:"tags,omitempty"`
+	DefaultTestModel        string       `yaml:"defaultTestModel,omitempty"`
+	Policies                any          `yaml:"policies,omitempty"`
+	Settings                any          `yaml:"settings,omitempty"`
+	Endpoints               any          `yaml:"endpoints,omitempty"`
+	OrderingWeight          int          `yaml:"orderingWeight,omitempty"`
+	Remark                  string       `yaml:"remark,omitempty"`
+}
+
+// Model is identified by its developer and model ID.
+// The channels of the associations are referenced by name with channel and channels
+// instead of channelId and channelIds.
+type Model struct {
+	Developer string `yaml:"developer"`
+	ModelID   string `yaml:"modelID"`
+	Type      string `yaml:"type"`
+	Name      string `yaml:"name"`
+	Icon      string `yaml:"icon,omitempty"`
+	Group     string `yaml:"group,omitempty"`
+	Status    string `yaml:"status"`
+	ModelCard any    `yaml:"modelCard,omitempty"`
+	Settings  any    `yaml:"settings,omitempty"`
+	Remark    string `yaml:"remark,omitempty"`
+}
+
+// Price is the price of a model in a channel, identified by both.
+type Price struct {
+	Channel string `yaml:"channel"`
+	ModelID string `yaml:"modelID"`
+	Price   any    `yaml:"price"`
+}
+
+// APIKey is identified by its project and name.
+// The channels of the profiles are referenced by name with channels instead of channelIDs.
+type APIKey struct {
+	Project string `yaml:"project"`
+	Name    string `yaml:"name"`
+	// Owner is the email of the user owning the key, the current user or the first owner is used for new keys when empty.
+	// It can not be changed after the key is created.
+	Owner string `yaml:"owner,omitempty"`
+	// Key is required for new keys and kept unchanged when omitted for an existing key.
+	Key        *SecretRef `yaml:"key,omitempty"`
+	Type       string     `yaml:"type"`
+	Status     string     `yaml:"status"`
+	Scopes     []string   `yaml:"scopes"`
+	AllowedIPs []string   `yaml:"allowedIPs,omitempty"`
+	Profiles   any        `yaml:"profiles,omitempty"`
+}
+
+// Kinds of the objects in a plan.
+const (
+	KindChannel = "channel"
+	KindModel   = "model"
+	KindPrice   = "price"
+	KindAPIKey  = "api_key"
+)
+
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Change is a change of a single object in a plan.
+type Change struct {
+	Kind string
+	// Name identifies the object within its kind, e.g. developer/model_id for models.
+	Name   string
+	Action Action
+	// Fields are the changed fields of an update.
+	Fields []string
+}
+
+// Plan lists the changes needed to make the database match a document, objects already matching are not listed.
+type Plan struct {
+	Changes []*Change
+}
+
+// Empty reports whether the database already matches the document.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+type ApplyOptions struct {
+	// Prune deletes the objects of the kinds managed by the document which are not declared in it.
+	Prune bool
+	// DryRun computes the plan without applying it.
+	DryRun bool
+}
@@ -10,11 +10,10 @@ import (
 	"fmt"
 	"time"
 
-	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/looplj/axonhub/internal/authz"
 	"github.com/looplj/axonhub/internal/ent"
-	"github.com/looplj/axonhub/internal/ent/usagelog"
+	"github.com/looplj/axonhub/internal/ent/usagedailyrollup"
 	"github.com/looplj/axonhub/internal/pkg/xtime"
 	"github.com/looplj/axonhub/internal/scopes"
 	"github.com/samber/lo"
@@ -24,8 +23,8 @@ import (
 func (r *queryResolver) AnalyticsMetadata(ctx context.Context) (*AnalyticsMetadata, error) {
 	loc := r.systemService.TimeLocation(ctx)
 
-	// Find earliest created_at
-	first, err := r.client.UsageLog.Query().Order(ent.Asc(usagelog.FieldCreatedAt)).First(ctx)
+	// Find earliest daily rollup, which outlives the cleaned up usage logs
+	first, err := r.client.UsageDailyRollup.Query().Order(ent.Asc(usagedailyrollup.FieldBucket)).First(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return &AnalyticsMetadata{}, nil
@@ -34,7 +33,7 @@ func (r *queryResolver) AnalyticsMetadata(ctx context.Context) (*AnalyticsMetada
 		return nil, err
 	}
 
-	d := first.CreatedAt.In(loc).Format("2006-01-02")
+	d := first.Bucket.In(loc).Format("2006-01-02")
 	return &AnalyticsMetadata{EarliestDate: &d}, nil
 }
 
@@ -55,17 +54,17 @@ func (r *queryResolver) AnalyticsOverview(ctx context.Context, filter *Analytics
 
 	var results []overviewResult
 
-	err := r.client.UsageLog.Query().
+	err := r.client.UsageDailyRollup.Query().
 		Modify(func(s *sql.Selector) {
 			r.buildAnalyticsWhere(s, filter, apiKeyIDs, hasUserFilter, loc)
 
 			s.Select(
-				sql.As(sql.Count(s.C(usagelog.FieldID)), "total_requests"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptTokens)), "total_input_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptCachedTokens)), "total_cached_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldCompletionTokens)), "total_output_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldTotalTokens)), "total_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldTotalCost)), "total_cost"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldRequestCount)), "total_requests"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptTokens)), "total_input_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptCachedTokens)), "total_cached_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldCompletionTokens)), "total_output_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalTokens)), "total_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalCost)), "total_cost"),
 			)
 		}).
 		Scan(ctx, &results)
@@ -96,7 +95,6 @@ func (r *queryResolver) AnalyticsDailyStats(ctx context.Context, filter *Analyti
 
 	loc := r.systemService.TimeLocation(ctx)
 	nowUTC := xtime.UTCNow()
-	_, offsetSeconds := nowUTC.In(loc).Zone()
 
 	// Determine date range — 同仪表盘 parseTimeWindow 模式
 	var startDay, endDay time.Time
@@ -121,48 +119,33 @@ func (r *queryResolver) AnalyticsDailyStats(ctx context.Context, filter *Analyti
 	endDay = time.Date(endDay.Year(), endDay.Month(), endDay.Day(), 0, 0, 0, 0, loc)
 
 	type dailyStats struct {
-		Date         string  `json:"date"`
-		InputTokens  int64   `json:"input_tokens"`
-		CachedTokens int64   `json:"cached_tokens"`
-		OutputTokens int64   `json:"output_tokens"`
-		TotalTokens  int64   `json:"total_tokens"`
-		RequestCount int     `json:"request_count"`
-		Cost         float64 `json:"cost"`
+		Bucket       time.Time `json:"bucket"`
+		InputTokens  int64     `json:"input_tokens"`
+		CachedTokens int64     `json:"cached_tokens"`
+		OutputTokens int64     `json:"output_tokens"`
+		TotalTokens  int64     `json:"total_tokens"`
+		RequestCount int       `json:"request_count"`
+		Cost         float64   `json:"cost"`
 	}
 
 	var results []dailyStats
 
-	err := r.client.UsageLog.Query().
+	err := r.client.UsageDailyRollup.Query().
 		Modify(func(s *sql.Selector) {
 			r.buildAnalyticsWhere(s, filter, apiKeyIDs, hasUserFilter, loc)
 
-			// Build dialect-specific date expression
-			createdAtCol := s.C(usagelog.FieldCreatedAt)
-			var dateExpr string
-
-			switch s.Dialect() {
-			case dialect.SQLite:
-				dateExpr = fmt.Sprintf("strftime('%%Y-%%m-%%d', datetime(substr(%s, 1, 19), '%+d seconds'))", createdAtCol, offsetSeconds)
-			case dialect.MySQL:
-				offsetStr := xtime.FormatUTCOffset(offsetSeconds)
-				dateExpr = fmt.Sprintf("DATE_FORMAT(CONVERT_TZ(%s, '+00:00', '%s'), '%%Y-%%m-%%d')", createdAtCol, offsetStr)
-			case dialect.Postgres:
-				dateExpr = fmt.Sprintf("to_char(%s AT TIME ZONE '%s', 'YYYY-MM-DD')", createdAtCol, loc.String())
-			default:
-				dateExpr = fmt.Sprintf("DATE(%s)", createdAtCol)
-			}
-
+			// The daily rollups are bucketed by the start of the day in the system timezone.
 			s.Select(
-				sql.As(dateExpr, "date"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptTokens)), "input_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptCachedTokens)), "cached_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldCompletionTokens)), "output_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldTotalTokens)), "total_tokens"),
-				sql.As(sql.Count(s.C(usagelog.FieldID)), "request_count"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldTotalCost)), "cost"),
+				sql.As(s.C(usagedailyrollup.FieldBucket), "bucket"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptTokens)), "input_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptCachedTokens)), "cached_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldCompletionTokens)), "output_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalTokens)), "total_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldRequestCount)), "request_count"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalCost)), "cost"),
 			).
-				GroupBy(dateExpr).
-				OrderBy("date")
+				GroupBy(s.C(usagedailyrollup.FieldBucket)).
+				OrderBy(s.C(usagedailyrollup.FieldBucket))
 		}).
 		Scan(ctx, &results)
 	if err != nil {
@@ -171,7 +154,7 @@ func (r *queryResolver) AnalyticsDailyStats(ctx context.Context, filter *Analyti
 
 	// Build lookup map
 	statsMap := lo.SliceToMap(results, func(item dailyStats) (string, dailyStats) {
-		return item.Date, item
+		return item.Bucket.In(loc).Format("2006-01-02"), item
 	})
 
 	// Fill in missing dates with zero values
@@ -228,8 +211,10 @@ func (r *queryResolver) AnalyticsDimensionStats(ctx context.Context, filter *Ana
 		results, err = r.queryAPIKeyStats(ctx, filter, apiKeyIDs, hasUserFilter, loc)
 	case "user":
 		results, err = r.queryUserStats(ctx, filter, apiKeyIDs, hasUserFilter, loc)
+	case "endUser":
+		results, err = r.queryEndUserStats(ctx, filter, apiKeyIDs, hasUserFilter, loc)
 	default:
-		return nil, fmt.Errorf("unsupported dimension: %s, must be one of: channel, model, apiKey, user", dimension)
+		return nil, fmt.Errorf("unsupported dimension: %s, must be one of: channel, model, apiKey, user, endUser", dimension)
 	}
 
 	if err != nil {
@@ -0,0 +1,97 @@
+package gql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+func TestQueryResolver_Analytics_ReadsDailyRollups(t *testing.T) {
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=0")
+	defer client.Close()
+
+	ctx := authz.WithTestBypass(ent.NewContext(context.Background(), client))
+
+	systemService := biz.NewSystemService(biz.SystemServiceParams{
+		CacheConfig: xcache.Config{},
+		Ent:         client,
+	})
+	require.NoError(t, systemService.SetGeneralSettings(ctx, biz.SystemGeneralSettings{Timezone: "Asia/Shanghai"}))
+
+	loc := systemService.TimeLocation(ctx)
+	resolver := &queryResolver{&Resolver{client: client, systemService: systemService}}
+
+	// The rollups of usage logs which have already been cleaned up.
+	for _, item := range []struct {
+		day      int
+		apiKeyID int
+		modelID  string
+		requests int64
+		tokens   int64
+		cost     float64
+	}{
+		{day: 1, apiKeyID: 7, modelID: "gpt-4", requests: 2, tokens: 100, cost: 1},
+		{day: 1, apiKeyID: 0, modelID: "claude", requests: 1, tokens: 50, cost: 0.5},
+		{day: 3, apiKeyID: 7, modelID: "gpt-4", requests: 4, tokens: 200, cost: 2},
+	} {
+		_, err := client.UsageDailyRollup.Create().
+			SetBucket(time.Date(2026, 3, item.day, 0, 0, 0, 0, loc).UTC()).
+			SetProjectID(1).
+			SetAPIKeyID(item.apiKeyID).
+			SetChannelID(1).
+			SetModelID(item.modelID).
+			SetRequestCount(item.requests).
+			SetPromptTokens(item.tokens).
+			SetTotalTokens(item.tokens).
+			SetTotalCost(item.cost).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	filter := &AnalyticsFilter{
+		StartTime: lo.ToPtr("2026-03-01"),
+		EndTime:   lo.ToPtr("2026-03-03"),
+	}
+
+	metadata, err := resolver.AnalyticsMetadata(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "2026-03-01", *metadata.EarliestDate)
+
+	overview, err := resolver.AnalyticsOverview(ctx, filter)
+	require.NoError(t, err)
+	require.Equal(t, 7, overview.TotalRequests)
+	require.Equal(t, 350, overview.TotalTokens)
+	require.InDelta(t, 3.5, overview.TotalCost, 1e-9)
+
+	daily, err := resolver.AnalyticsDailyStats(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, daily, 3)
+	require.Equal(t, "2026-03-01", daily[0].Date)
+	require.Equal(t, 3, daily[0].RequestCount)
+	require.Equal(t, 0, daily[1].RequestCount)
+	require.Equal(t, "2026-03-03", daily[2].Date)
+	require.Equal(t, 4, daily[2].RequestCount)
+
+	models, err := resolver.AnalyticsDimensionStats(ctx, filter, "model")
+	require.NoError(t, err)
+	require.Len(t, models, 2)
+	require.Equal(t, "gpt-4", models[0].ID)
+	require.Equal(t, 6, models[0].RequestCount)
+
+	// The end date is inclusive, the rollups after it are excluded.
+	overview, err = resolver.AnalyticsOverview(ctx, &AnalyticsFilter{
+		StartTime: lo.ToPtr("2026-03-01"),
+		EndTime:   lo.ToPtr("2026-03-02"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, overview.TotalRequests)
+}
@@ -12,7 +12,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/channel"
-	"github.com/looplj/axonhub/internal/ent/usagelog"
+	"github.com/looplj/axonhub/internal/ent/usagedailyrollup"
 	"github.com/looplj/axonhub/internal/objects"
 )
 
@@ -43,6 +43,7 @@ func parseDateStr(dateStr string, loc *time.Location) time.Time {
 	return time.Date(y, time.Month(m), d, 0, 0, 0, 0, loc)
 }
 
+// buildAnalyticsWhere filters the daily usage rollups, whose buckets start at midnight in the system timezone.
 func (r *queryResolver) buildAnalyticsWhere(s *sql.Selector, filter *AnalyticsFilter, apiKeyIDs []int, hasUserFilter bool, loc *time.Location) {
 	if filter == nil {
 		return
@@ -51,8 +52,8 @@ func (r *queryResolver) buildAnalyticsWhere(s *sql.Selector, filter *AnalyticsFi
 	if filter.StartTime != nil {
 		startDate := parseDateStr(*filter.StartTime, loc)
 		if !startDate.IsZero() {
-			// 同仪表盘：本地午夜转 UTC 再比较，数据库 created_at 是 UTC
-			s.Where(sql.GTE(s.C(usagelog.FieldCreatedAt), startDate.UTC()))
+			// 同仪表盘：本地午夜转 UTC 再比较，数据库 bucket 是 UTC
+			s.Where(sql.GTE(s.C(usagedailyrollup.FieldBucket), startDate.UTC()))
 		}
 	}
 
@@ -60,18 +61,18 @@ func (r *queryResolver) buildAnalyticsWhere(s *sql.Selector, filter *AnalyticsFi
 		endDate := parseDateStr(*filter.EndTime, loc)
 		if !endDate.IsZero() {
 			endDateNext := endDate.AddDate(0, 0, 1)
-			s.Where(sql.LT(s.C(usagelog.FieldCreatedAt), endDateNext.UTC()))
+			s.Where(sql.LT(s.C(usagedailyrollup.FieldBucket), endDateNext.UTC()))
 		}
 	}
 
 	if len(filter.ProjectIDs) > 0 {
 		ids := lo.Map(filter.ProjectIDs, func(g *objects.GUID, _ int) int { return g.ID })
-		s.Where(sql.InInts(usagelog.FieldProjectID, ids...))
+		s.Where(sql.InInts(s.C(usagedailyrollup.FieldProjectID), ids...))
 	}
 
 	if len(filter.ChannelIDs) > 0 {
 		ids := lo.Map(filter.ChannelIDs, func(g *objects.GUID, _ int) int { return g.ID })
-		s.Where(sql.InInts(usagelog.FieldChannelID, ids...))
+		s.Where(sql.InInts(s.C(usagedailyrollup.FieldChannelID), ids...))
 	}
 
 	if len(filter.ModelIDs) > 0 {
@@ -79,7 +80,15 @@ func (r *queryResolver) buildAnalyticsWhere(s *sql.Selector, filter *AnalyticsFi
 		for i, v := range filter.ModelIDs {
 			vals[i] = v
 		}
-		s.Where(sql.In(usagelog.FieldModelID, vals...))
+		s.Where(sql.In(s.C(usagedailyrollup.FieldModelID), vals...))
+	}
+
+	if len(filter.EndUserIDs) > 0 {
+		vals := make([]any, len(filter.EndUserIDs))
+		for i, v := range filter.EndUserIDs {
+			vals[i] = v
+		}
+		s.Where(sql.In(s.C(usagedailyrollup.FieldEndUserID), vals...))
 	}
 
 	// API key / user filtering:
@@ -87,7 +96,7 @@ func (r *queryResolver) buildAnalyticsWhere(s *sql.Selector, filter *AnalyticsFi
 	// - apiKeyIDs == 0 && hasUserFilter: user filter matched no API keys → return empty
 	// - apiKeyIDs == 0 && !hasUserFilter: no API key filter → show all
 	if len(apiKeyIDs) > 0 {
-		s.Where(sql.InInts(usagelog.FieldAPIKeyID, apiKeyIDs...))
+		s.Where(sql.InInts(s.C(usagedailyrollup.FieldAPIKeyID), apiKeyIDs...))
 	} else if hasUserFilter {
 		s.Where(sql.False())
 	}
@@ -165,11 +174,11 @@ type dimStats struct {
 func (r *queryResolver) queryChannelStats(ctx context.Context, filter *AnalyticsFilter, apiKeyIDs []int, hasUserFilter bool, loc *time.Location) ([]dimStats, error) {
 	var results []dimStats
 
-	err := r.client.UsageLog.Query().
+	err := r.client.UsageDailyRollup.Query().
 		Modify(func(s *sql.Selector) {
 			channelTable := sql.Table(channel.Table)
 			s.Join(channelTable).On(
-				s.C(usagelog.FieldChannelID),
+				s.C(usagedailyrollup.FieldChannelID),
 				channelTable.C(channel.FieldID),
 			)
 			s.Where(sql.EQ(channelTable.C(channel.FieldDeletedAt), 0))
@@ -177,16 +186,16 @@ func (r *queryResolver) queryChannelStats(ctx context.Context, filter *Analytics
 			r.buildAnalyticsWhere(s, filter, apiKeyIDs, hasUserFilter, loc)
 
 			s.Select(
-				sql.As(fmt.Sprintf("CAST(%s AS TEXT)", s.C(usagelog.FieldChannelID)), "id"),
+				sql.As(fmt.Sprintf("CAST(%s AS TEXT)", s.C(usagedailyrollup.FieldChannelID)), "id"),
 				sql.As(channelTable.C(channel.FieldName), "name"),
-				sql.As(sql.Count(s.C(usagelog.FieldID)), "request_count"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptTokens)), "input_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptCachedTokens)), "cached_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldCompletionTokens)), "output_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldTotalTokens)), "total_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldTotalCost)), "cost"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldRequestCount)), "request_count"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptTokens)), "input_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptCachedTokens)), "cached_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldCompletionTokens)), "output_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalTokens)), "total_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalCost)), "cost"),
 			).
-				GroupBy(s.C(usagelog.FieldChannelID), channelTable.C(channel.FieldName)).
+				GroupBy(s.C(usagedailyrollup.FieldChannelID), channelTable.C(channel.FieldName)).
 				OrderBy(sql.Desc("total_tokens"))
 		}).
 		Scan(ctx, &results)
@@ -200,21 +209,21 @@ func (r *queryResolver) queryChannelStats(ctx context.Context, filter *Analytics
 func (r *queryResolver) queryModelStats(ctx context.Context, filter *AnalyticsFilter, apiKeyIDs []int, hasUserFilter bool, loc *time.Location) ([]dimStats, error) {
 	var results []dimStats
 
-	err := r.client.UsageLog.Query().
+	err := r.client.UsageDailyRollup.Query().
 		Modify(func(s *sql.Selector) {
 			r.buildAnalyticsWhere(s, filter, apiKeyIDs, hasUserFilter, loc)
 
 			s.Select(
-				sql.As(s.C(usagelog.FieldModelID), "id"),
-				sql.As(s.C(usagelog.FieldModelID), "name"),
-				sql.As(sql.Count(s.C(usagelog.FieldID)), "request_count"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptTokens)), "input_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptCachedTokens)), "cached_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldCompletionTokens)), "output_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldTotalTokens)), "total_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldTotalCost)), "cost"),
+				sql.As(s.C(usagedailyrollup.FieldModelID), "id"),
+				sql.As(s.C(usagedailyrollup.FieldModelID), "name"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldRequestCount)), "request_count"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptTokens)), "input_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptCachedTokens)), "cached_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldCompletionTokens)), "output_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalTokens)), "total_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalCost)), "cost"),
 			).
-				GroupBy(s.C(usagelog.FieldModelID)).
+				GroupBy(s.C(usagedailyrollup.FieldModelID)).
 				OrderBy(sql.Desc("total_tokens"))
 		}).
 		Scan(ctx, &results)
@@ -238,21 +247,21 @@ func (r *queryResolver) queryAPIKeyStats(ctx context.Context, filter *AnalyticsF
 
 	var rawResults []apiKeyStatsRaw
 
-	err := r.client.UsageLog.Query().
-		Where(usagelog.APIKeyIDNotNil()).
+	err := r.client.UsageDailyRollup.Query().
+		Where(usagedailyrollup.APIKeyIDGT(0)).
 		Modify(func(s *sql.Selector) {
 			r.buildAnalyticsWhere(s, filter, apiKeyIDs, hasUserFilter, loc)
 
 			s.Select(
-				sql.As(s.C(usagelog.FieldAPIKeyID), "api_key_id"),
-				sql.As(sql.Count(s.C(usagelog.FieldID)), "request_count"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptTokens)), "input_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptCachedTokens)), "cached_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldCompletionTokens)), "output_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldTotalTokens)), "total_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldTotalCost)), "cost"),
+				sql.As(s.C(usagedailyrollup.FieldAPIKeyID), "api_key_id"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldRequestCount)), "request_count"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptTokens)), "input_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptCachedTokens)), "cached_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldCompletionTokens)), "output_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalTokens)), "total_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalCost)), "cost"),
 			).
-				GroupBy(s.C(usagelog.FieldAPIKeyID)).
+				GroupBy(s.C(usagedailyrollup.FieldAPIKeyID)).
 				OrderBy(sql.Desc("total_tokens"))
 		}).
 		Scan(ctx, &rawResults)
@@ -291,6 +300,35 @@ func (r *queryResolver) queryAPIKeyStats(ctx context.Context, filter *AnalyticsF
 	return results, nil
 }
 
+func (r *queryResolver) queryEndUserStats(ctx context.Context, filter *AnalyticsFilter, apiKeyIDs []int, hasUserFilter bool, loc *time.Location) ([]dimStats, error) {
+	var results []dimStats
+
+	err := r.client.UsageDailyRollup.Query().
+		Where(usagedailyrollup.EndUserIDNEQ("")).
+		Modify(func(s *sql.Selector) {
+			r.buildAnalyticsWhere(s, filter, apiKeyIDs, hasUserFilter, loc)
+
+			s.Select(
+				sql.As(s.C(usagedailyrollup.FieldEndUserID), "id"),
+				sql.As(s.C(usagedailyrollup.FieldEndUserID), "name"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldRequestCount)), "request_count"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptTokens)), "input_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptCachedTokens)), "cached_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldCompletionTokens)), "output_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalTokens)), "total_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalCost)), "cost"),
+			).
+				GroupBy(s.C(usagedailyrollup.FieldEndUserID)).
+				OrderBy(sql.Desc("total_tokens"))
+		}).
+		Scan(ctx, &results)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get analytics stats by end user: %w", err)
+	}
+
+	return results, nil
+}
+
 func (r *queryResolver) queryUserStats(ctx context.Context, filter *AnalyticsFilter, apiKeyIDs []int, hasUserFilter bool, loc *time.Location) ([]dimStats, error) {
 	type userStatsRaw struct {
 		UserID       int     `json:"user_id"`
@@ -307,14 +345,14 @@ func (r *queryResolver) queryUserStats(ctx context.Context, filter *AnalyticsFil
 
 	var rawResults []userStatsRaw
 
-	err := r.client.UsageLog.Query().
-		Where(usagelog.APIKeyIDNotNil()).
+	err := r.client.UsageDailyRollup.Query().
+		Where(usagedailyrollup.APIKeyIDGT(0)).
 		Modify(func(s *sql.Selector) {
 			apiKeyTable := sql.Table(apikey.Table)
 			userTable := sql.Table("users")
 
 			s.Join(apiKeyTable).On(
-				s.C(usagelog.FieldAPIKeyID),
+				s.C(usagedailyrollup.FieldAPIKeyID),
 				apiKeyTable.C(apikey.FieldID),
 			)
 			s.Join(userTable).On(
@@ -330,12 +368,12 @@ func (r *queryResolver) queryUserStats(ctx context.Context, filter *AnalyticsFil
 				sql.As(userTable.C("first_name"), "first_name"),
 				sql.As(userTable.C("last_name"), "last_name"),
 				sql.As(userTable.C("email"), "email"),
-				sql.As(sql.Count(s.C(usagelog.FieldID)), "request_count"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptTokens)), "input_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptCachedTokens)), "cached_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldCompletionTokens)), "output_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldTotalTokens)), "total_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldTotalCost)), "cost"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldRequestCount)), "request_count"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptTokens)), "input_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptCachedTokens)), "cached_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldCompletionTokens)), "output_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalTokens)), "total_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalCost)), "cost"),
 			).
 				GroupBy(
 					userTable.C("id"),
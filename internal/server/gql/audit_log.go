@@ -0,0 +1,55 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent/auditlog"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// AuditLogRecorder records every root mutation field in the audit log.
+// Changes of audited entities are recorded by the audit hook with their diff,
+// mutations which do not change any audited entity get a single entry.
+type AuditLogRecorder struct {
+	AuditLogService *biz.AuditLogService
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = &AuditLogRecorder{}
+
+func (r *AuditLogRecorder) ExtensionName() string {
+	return "audit_log_recorder"
+}
+
+func (r *AuditLogRecorder) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (r *AuditLogRecorder) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || fc.Object != "Mutation" {
+		return next(ctx)
+	}
+
+	info := &contexts.AuditInfo{
+		Source:    string(auditlog.SourceGraphql),
+		Operation: fc.Field.Name,
+	}
+	ctx = contexts.WithAuditInfo(ctx, info)
+
+	res, err := next(ctx)
+	if err != nil || info.Recorded() {
+		return res, err
+	}
+
+	if err := r.AuditLogService.RecordOperation(ctx); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
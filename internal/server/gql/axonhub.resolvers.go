@@ -417,6 +417,11 @@ func (r *mutationResolver) RotateAPIKey(ctx context.Context, id objects.GUID) (*
 	return r.apiKeyService.RotateAPIKey(ctx, id.ID)
 }
 
+// RevokeAPIKeyTokens is the resolver for the revokeAPIKeyTokens field.
+func (r *mutationResolver) RevokeAPIKeyTokens(ctx context.Context, id objects.GUID, endUserID *string) (*ent.APIKey, error) {
+	return r.authService.APIKeyTokenService.RevokeTokens(ctx, id.ID, lo.FromPtr(endUserID))
+}
+
 // BulkDisableAPIKeys is the resolver for the bulkDisableAPIKeys field.
 func (r *mutationResolver) BulkDisableAPIKeys(ctx context.Context, ids []*objects.GUID) (bool, error) {
 	apiKeyIDs := objects.IntGuids(ids)
@@ -7,7 +7,6 @@ package gql
 
 import (
 	"context"
-	"io"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/looplj/axonhub/internal/contexts"
@@ -35,19 +34,20 @@ func (r *mutationResolver) Backup(ctx context.Context, input backup.BackupOption
 
 // Restore is the resolver for the restore field.
 func (r *mutationResolver) Restore(ctx context.Context, file graphql.Upload, input backup.RestoreOptions) (*RestorePayload, error) {
-	fileContent, err := io.ReadAll(file.File)
+	report, err := r.backupService.RestoreFromReader(ctx, file.File, input)
 	if err != nil {
 		return nil, err
 	}
 
-	err = r.backupService.Restore(ctx, fileContent, input)
-	if err != nil {
-		return nil, err
+	message := "Restore completed successfully"
+	if report.DryRun {
+		message = "Restore dry run completed, no changes were applied"
 	}
 
 	return &RestorePayload{
 		Success: true,
-		Message: lo.ToPtr("Restore completed successfully"),
+		Message: lo.ToPtr(message),
+		Report:  report,
 	}, nil
 }
 
@@ -72,6 +72,11 @@ func (r *mutationResolver) UpdateAutoBackupSettings(ctx context.Context, input U
 	}
 
 	if input.DataStorageID != nil {
+		// The incremental backups continue the full backups of the same data storage.
+		if settings.DataStorageID != *input.DataStorageID {
+			settings.Checkpoint = nil
+		}
+
 		settings.DataStorageID = *input.DataStorageID
 	}
 
@@ -99,10 +104,18 @@ func (r *mutationResolver) UpdateAutoBackupSettings(ctx context.Context, input U
 		settings.IncludeRequestLogs = *input.IncludeRequestLogs
 	}
 
+	if input.IncludeConfiguration != nil {
+		settings.IncludeConfiguration = *input.IncludeConfiguration
+	}
+
 	if input.RetentionDays != nil {
 		settings.RetentionDays = *input.RetentionDays
 	}
 
+	if input.Incremental != nil {
+		settings.Incremental = *input.Incremental
+	}
+
 	if err := r.systemService.SetAutoBackupSettings(ctx, *settings); err != nil {
 		return false, err
 	}
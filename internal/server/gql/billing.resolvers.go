@@ -0,0 +1,39 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// UpdateProjectBillingSettings is the resolver for the updateProjectBillingSettings field.
+func (r *mutationResolver) UpdateProjectBillingSettings(ctx context.Context, id objects.GUID, input objects.ProjectBillingSettings) (*ent.Project, error) {
+	return r.projectService.UpdateProjectBillingSettings(ctx, id.ID, input)
+}
+
+// GenerateInvoice is the resolver for the generateInvoice field.
+func (r *mutationResolver) GenerateInvoice(ctx context.Context, projectID objects.GUID, month string) (*ent.Invoice, error) {
+	return r.invoiceService.GenerateInvoice(ctx, projectID.ID, month)
+}
+
+// ExportInvoice is the resolver for the exportInvoice field.
+func (r *queryResolver) ExportInvoice(ctx context.Context, id objects.GUID, format InvoiceExportFormat) (string, error) {
+	inv, err := r.client.Invoice.Get(ctx, id.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get invoice: %w", err)
+	}
+
+	export, err := r.invoiceService.ExportInvoice(ctx, inv, format.String())
+	if err != nil {
+		return "", err
+	}
+
+	return string(export.Content), nil
+}
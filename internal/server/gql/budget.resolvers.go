@@ -0,0 +1,53 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/budget"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// Spend is the resolver for the spend field.
+func (r *budgetResolver) Spend(ctx context.Context, obj *ent.Budget) (*biz.BudgetSpend, error) {
+	spend, err := r.budgetService.Spend(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spend, nil
+}
+
+// CreateBudget is the resolver for the createBudget field.
+func (r *mutationResolver) CreateBudget(ctx context.Context, input ent.CreateBudgetInput) (*ent.Budget, error) {
+	return r.budgetService.CreateBudget(ctx, input)
+}
+
+// UpdateBudget is the resolver for the updateBudget field.
+func (r *mutationResolver) UpdateBudget(ctx context.Context, id objects.GUID, input ent.UpdateBudgetInput) (*ent.Budget, error) {
+	return r.budgetService.UpdateBudget(ctx, id.ID, &input)
+}
+
+// DeleteBudget is the resolver for the deleteBudget field.
+func (r *mutationResolver) DeleteBudget(ctx context.Context, id objects.GUID) (bool, error) {
+	if err := r.budgetService.DeleteBudget(ctx, id.ID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// UpdateBudgetStatus is the resolver for the updateBudgetStatus field.
+func (r *mutationResolver) UpdateBudgetStatus(ctx context.Context, id objects.GUID, status budget.Status) (bool, error) {
+	if _, err := r.budgetService.UpdateBudgetStatus(ctx, id.ID, status); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
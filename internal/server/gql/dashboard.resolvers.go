@@ -25,6 +25,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/ent/requestexecution"
 	"github.com/looplj/axonhub/internal/ent/schema/schematype"
+	"github.com/looplj/axonhub/internal/ent/usagedailyrollup"
 	"github.com/looplj/axonhub/internal/ent/usagelog"
 	"github.com/looplj/axonhub/internal/log"
 	"github.com/looplj/axonhub/internal/objects"
@@ -141,7 +142,7 @@ func (r *queryResolver) RequestStats(ctx context.Context) (*RequestStats, error)
 }
 
 // RequestStatsByChannel is the resolver for the requestStatsByChannel field.
-// Note: Uses usage_logs table for result-only statistics aggregated by channel.
+// Note: Uses the daily usage rollups for result-only statistics aggregated by channel.
 // For channel-level process tracking (e.g., success/failure rates), use request_execution table.
 func (r *queryResolver) RequestStatsByChannel(ctx context.Context, timeWindow *string) ([]*RequestStatsByChannel, error) {
 	ctx = authz.WithScopeDecision(ctx, scopes.ScopeReadDashboard)
@@ -156,12 +157,12 @@ func (r *queryResolver) RequestStatsByChannel(ctx context.Context, timeWindow *s
 
 	var results []channelStats
 
-	// Aggregate by channel directly in the database using usage_logs table joined with channels
-	err := r.client.UsageLog.Query().
+	// Aggregate by channel directly in the database using the daily usage rollups joined with channels
+	err := r.client.UsageDailyRollup.Query().
 		Modify(func(s *sql.Selector) {
 			channelTable := sql.Table(channel.Table)
 			s.Join(channelTable).On(
-				s.C(usagelog.FieldChannelID),
+				s.C(usagedailyrollup.FieldChannelID),
 				channelTable.C(channel.FieldID),
 			)
 
@@ -170,7 +171,7 @@ func (r *queryResolver) RequestStatsByChannel(ctx context.Context, timeWindow *s
 
 			// Apply time window filter when provided
 			if applyFilter {
-				s.Where(sql.GTE(s.C(usagelog.FieldCreatedAt), since))
+				s.Where(sql.GTE(s.C(usagedailyrollup.FieldBucket), since.UTC()))
 			}
 
 			// Group by channel fields to get names and types directly
@@ -179,7 +180,7 @@ func (r *queryResolver) RequestStatsByChannel(ctx context.Context, timeWindow *s
 			// Select fields: channel name, type and the count of logs
 			s.Select(
 				sql.As(channelTable.C(channel.FieldName), "channel_name"),
-				sql.As(sql.Count(s.C(usagelog.FieldID)), "count"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldRequestCount)), "count"),
 			)
 
 			// Order by count descending and limit to top 10
@@ -200,7 +201,7 @@ func (r *queryResolver) RequestStatsByChannel(ctx context.Context, timeWindow *s
 }
 
 // RequestStatsByModel is the resolver for the requestStatsByModel field.
-// Note: Uses usage_logs table for result-only statistics aggregated by model.
+// Note: Uses the daily usage rollups for result-only statistics aggregated by model.
 // This provides successful request counts per model.
 func (r *queryResolver) RequestStatsByModel(ctx context.Context, timeWindow *string) ([]*RequestStatsByModel, error) {
 	ctx = authz.WithScopeDecision(ctx, scopes.ScopeReadDashboard)
@@ -214,16 +215,16 @@ func (r *queryResolver) RequestStatsByModel(ctx context.Context, timeWindow *str
 
 	var results []modelStats
 
-	query := r.client.UsageLog.Query()
+	query := r.client.UsageDailyRollup.Query()
 
 	// Apply time window filter when provided
 	if applyFilter {
-		query = query.Where(usagelog.CreatedAtGTE(since))
+		query = query.Where(usagedailyrollup.BucketGTE(since.UTC()))
 	}
 
 	err := query.
-		GroupBy(usagelog.FieldModelID).
-		Aggregate(ent.As(ent.Count(), "request_count")).
+		GroupBy(usagedailyrollup.FieldModelID).
+		Aggregate(ent.As(ent.Sum(usagedailyrollup.FieldRequestCount), "request_count")).
 		Scan(ctx, &results)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get requests by model: %w", err)
@@ -249,7 +250,7 @@ func (r *queryResolver) RequestStatsByModel(ctx context.Context, timeWindow *str
 }
 
 // RequestStatsByAPIKey is the resolver for the requestStatsByAPIKey field.
-// Note: Uses usage_logs table for result-only statistics aggregated by API key.
+// Note: Uses the daily usage rollups for result-only statistics aggregated by API key.
 // This provides successful request counts per API key.
 func (r *queryResolver) RequestStatsByAPIKey(ctx context.Context, timeWindow *string) ([]*RequestStatsByAPIKey, error) {
 	ctx = authz.WithScopeDecision(ctx, scopes.ScopeReadDashboard)
@@ -264,17 +265,17 @@ func (r *queryResolver) RequestStatsByAPIKey(ctx context.Context, timeWindow *st
 	var results []apiKeyStats
 
 	// Database-level aggregation
-	query := r.client.UsageLog.Query().
-		Where(usagelog.APIKeyIDNotNil())
+	query := r.client.UsageDailyRollup.Query().
+		Where(usagedailyrollup.APIKeyIDGT(0))
 
 	// Apply time window filter when provided
 	if applyFilter {
-		query = query.Where(usagelog.CreatedAtGTE(since))
+		query = query.Where(usagedailyrollup.BucketGTE(since.UTC()))
 	}
 
 	err := query.
-		GroupBy(usagelog.FieldAPIKeyID).
-		Aggregate(ent.As(ent.Count(), "request_count")).
+		GroupBy(usagedailyrollup.FieldAPIKeyID).
+		Aggregate(ent.As(ent.Sum(usagedailyrollup.FieldRequestCount), "request_count")).
 		Scan(ctx, &results)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get requests by API key: %w", err)
@@ -328,7 +329,7 @@ func (r *queryResolver) RequestStatsByAPIKey(ctx context.Context, timeWindow *st
 }
 
 // TokenStatsByAPIKey is the resolver for the tokenStatsByAPIKey field.
-// Note: Uses usage_logs table for token consumption statistics aggregated by API key.
+// Note: Uses the daily usage rollups for token consumption statistics aggregated by API key.
 // This provides actual token usage (input, output, cached, reasoning) per API key.
 func (r *queryResolver) TokenStatsByAPIKey(ctx context.Context, timeWindow *string) ([]*TokenStatsByAPIKey, error) {
 	ctx = authz.WithScopeDecision(ctx, scopes.ScopeReadDashboard)
@@ -345,31 +346,30 @@ func (r *queryResolver) TokenStatsByAPIKey(ctx context.Context, timeWindow *stri
 
 	var results []tokenStats
 
-	// Aggregate directly on usage_logs.api_key_id. Joining to requests is unnecessary
-	// (the column is already on usage_logs) and breaks once the requests table is
-	// pruned by GC retention while usage_logs are still kept.
-	err := r.client.UsageLog.Query().
-		Where(usagelog.APIKeyIDNotNil()).
+	// Aggregate directly on the rollup api_key_id. Joining to requests is unnecessary
+	// and breaks once the requests table is pruned by GC retention, while the rollups are kept.
+	err := r.client.UsageDailyRollup.Query().
+		Where(usagedailyrollup.APIKeyIDGT(0)).
 		Modify(func(s *sql.Selector) {
 			if applyFilter {
-				s.Where(sql.GTE(s.C(usagelog.FieldCreatedAt), since))
+				s.Where(sql.GTE(s.C(usagedailyrollup.FieldBucket), since.UTC()))
 			}
 
-			s.GroupBy(s.C(usagelog.FieldAPIKeyID))
+			s.GroupBy(s.C(usagedailyrollup.FieldAPIKeyID))
 
 			s.Select(
-				sql.As(s.C(usagelog.FieldAPIKeyID), "api_key_id"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptTokens)), "input_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldCompletionTokens)), "output_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptCachedTokens)), "cached_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldCompletionReasoningTokens)), "reasoning_tokens"),
+				sql.As(s.C(usagedailyrollup.FieldAPIKeyID), "api_key_id"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptTokens)), "input_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldCompletionTokens)), "output_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptCachedTokens)), "cached_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldCompletionReasoningTokens)), "reasoning_tokens"),
 			)
 
 			// Order by billable total (input + output). Reasoning is already inside
 			// completion_tokens, so adding it would double-count.
 			s.OrderBy(sql.Desc(fmt.Sprintf("COALESCE(SUM(%s), 0) + COALESCE(SUM(%s), 0)",
-				s.C(usagelog.FieldPromptTokens),
-				s.C(usagelog.FieldCompletionTokens))))
+				s.C(usagedailyrollup.FieldPromptTokens),
+				s.C(usagedailyrollup.FieldCompletionTokens))))
 			s.Limit(10)
 		}).
 		Scan(ctx, &results)
@@ -1484,11 +1484,11 @@ func (r *queryResolver) TokenStatsByChannel(ctx context.Context, timeWindow *str
 
 	var results []channelTokenStats
 
-	err := r.client.UsageLog.Query().
+	err := r.client.UsageDailyRollup.Query().
 		Modify(func(s *sql.Selector) {
 			channelTable := sql.Table(channel.Table)
 			s.Join(channelTable).On(
-				s.C(usagelog.FieldChannelID),
+				s.C(usagedailyrollup.FieldChannelID),
 				channelTable.C(channel.FieldID),
 			)
 
@@ -1496,7 +1496,7 @@ func (r *queryResolver) TokenStatsByChannel(ctx context.Context, timeWindow *str
 
 			// Apply time window filter when provided
 			if applyFilter {
-				s.Where(sql.GTE(s.C(usagelog.FieldCreatedAt), since))
+				s.Where(sql.GTE(s.C(usagedailyrollup.FieldBucket), since.UTC()))
 			}
 
 			s.GroupBy(channelTable.C(channel.FieldID), channelTable.C(channel.FieldName))
@@ -1504,17 +1504,17 @@ func (r *queryResolver) TokenStatsByChannel(ctx context.Context, timeWindow *str
 			s.Select(
 				sql.As(channelTable.C(channel.FieldID), "channel_id"),
 				sql.As(channelTable.C(channel.FieldName), "channel_name"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptTokens)), "input_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldCompletionTokens)), "output_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptCachedTokens)), "cached_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldCompletionReasoningTokens)), "reasoning_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptTokens)), "input_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldCompletionTokens)), "output_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptCachedTokens)), "cached_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldCompletionReasoningTokens)), "reasoning_tokens"),
 			)
 
 			// Order by billable total (input + output). Reasoning is already inside
 			// completion_tokens, so adding it would double-count.
 			s.OrderBy(sql.Desc(fmt.Sprintf("COALESCE(SUM(%s), 0) + COALESCE(SUM(%s), 0)",
-				s.C(usagelog.FieldPromptTokens),
-				s.C(usagelog.FieldCompletionTokens))))
+				s.C(usagedailyrollup.FieldPromptTokens),
+				s.C(usagedailyrollup.FieldCompletionTokens))))
 			s.Limit(10)
 		}).
 		Scan(ctx, &results)
@@ -1553,28 +1553,28 @@ func (r *queryResolver) TokenStatsByModel(ctx context.Context, timeWindow *strin
 
 	var results []modelTokenStats
 
-	err := r.client.UsageLog.Query().
+	err := r.client.UsageDailyRollup.Query().
 		Modify(func(s *sql.Selector) {
 			// Apply time window filter when provided
 			if applyFilter {
-				s.Where(sql.GTE(s.C(usagelog.FieldCreatedAt), since))
+				s.Where(sql.GTE(s.C(usagedailyrollup.FieldBucket), since.UTC()))
 			}
 
-			s.GroupBy(s.C(usagelog.FieldModelID))
+			s.GroupBy(s.C(usagedailyrollup.FieldModelID))
 
 			s.Select(
-				s.C(usagelog.FieldModelID),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptTokens)), "input_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldCompletionTokens)), "output_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldPromptCachedTokens)), "cached_tokens"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldCompletionReasoningTokens)), "reasoning_tokens"),
+				s.C(usagedailyrollup.FieldModelID),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptTokens)), "input_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldCompletionTokens)), "output_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldPromptCachedTokens)), "cached_tokens"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldCompletionReasoningTokens)), "reasoning_tokens"),
 			)
 
 			// Order by billable total (input + output). Reasoning is already inside
 			// completion_tokens, so adding it would double-count.
 			s.OrderBy(sql.Desc(fmt.Sprintf("COALESCE(SUM(%s), 0) + COALESCE(SUM(%s), 0)",
-				s.C(usagelog.FieldPromptTokens),
-				s.C(usagelog.FieldCompletionTokens))))
+				s.C(usagedailyrollup.FieldPromptTokens),
+				s.C(usagedailyrollup.FieldCompletionTokens))))
 			s.Limit(10)
 		}).
 		Scan(ctx, &results)
@@ -1609,11 +1609,11 @@ func (r *queryResolver) CostStatsByChannel(ctx context.Context, timeWindow *stri
 
 	var results []channelCostStats
 
-	err := r.client.UsageLog.Query().
+	err := r.client.UsageDailyRollup.Query().
 		Modify(func(s *sql.Selector) {
 			channelTable := sql.Table(channel.Table)
 			s.Join(channelTable).On(
-				s.C(usagelog.FieldChannelID),
+				s.C(usagedailyrollup.FieldChannelID),
 				channelTable.C(channel.FieldID),
 			)
 
@@ -1621,14 +1621,14 @@ func (r *queryResolver) CostStatsByChannel(ctx context.Context, timeWindow *stri
 
 			// Apply time window filtering
 			if applyFilter {
-				s.Where(sql.GTE(s.C(usagelog.FieldCreatedAt), since))
+				s.Where(sql.GTE(s.C(usagedailyrollup.FieldBucket), since.UTC()))
 			}
 
 			s.GroupBy(channelTable.C(channel.FieldName))
 
 			s.Select(
 				sql.As(channelTable.C(channel.FieldName), "channel_name"),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldTotalCost)), "total_cost"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalCost)), "total_cost"),
 			)
 
 			s.OrderBy(sql.Desc("total_cost"))
@@ -1660,18 +1660,18 @@ func (r *queryResolver) CostStatsByModel(ctx context.Context, timeWindow *string
 
 	var results []modelCostStats
 
-	err := r.client.UsageLog.Query().
+	err := r.client.UsageDailyRollup.Query().
 		Modify(func(s *sql.Selector) {
 			// Apply time window filtering
 			if applyFilter {
-				s.Where(sql.GTE(s.C(usagelog.FieldCreatedAt), since))
+				s.Where(sql.GTE(s.C(usagedailyrollup.FieldBucket), since.UTC()))
 			}
 
-			s.GroupBy(s.C(usagelog.FieldModelID))
+			s.GroupBy(s.C(usagedailyrollup.FieldModelID))
 
 			s.Select(
-				s.C(usagelog.FieldModelID),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldTotalCost)), "total_cost"),
+				s.C(usagedailyrollup.FieldModelID),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalCost)), "total_cost"),
 			)
 
 			s.OrderBy(sql.Desc("total_cost"))
@@ -1703,19 +1703,19 @@ func (r *queryResolver) CostStatsByAPIKey(ctx context.Context, timeWindow *strin
 
 	var results []apiKeyCostStats
 
-	err := r.client.UsageLog.Query().
-		Where(usagelog.APIKeyIDNotNil()).
+	err := r.client.UsageDailyRollup.Query().
+		Where(usagedailyrollup.APIKeyIDGT(0)).
 		Modify(func(s *sql.Selector) {
 			// Apply time window filtering
 			if applyFilter {
-				s.Where(sql.GTE(s.C(usagelog.FieldCreatedAt), since))
+				s.Where(sql.GTE(s.C(usagedailyrollup.FieldBucket), since.UTC()))
 			}
 
-			s.GroupBy(s.C(usagelog.FieldAPIKeyID))
+			s.GroupBy(s.C(usagedailyrollup.FieldAPIKeyID))
 
 			s.Select(
-				s.C(usagelog.FieldAPIKeyID),
-				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagelog.FieldTotalCost)), "total_cost"),
+				s.C(usagedailyrollup.FieldAPIKeyID),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(usagedailyrollup.FieldTotalCost)), "total_cost"),
 			)
 
 			s.OrderBy(sql.Desc("total_cost"))
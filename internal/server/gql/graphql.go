@@ -21,6 +21,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/apikeyprofiletemplate"
+	"github.com/looplj/axonhub/internal/ent/auditlog"
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/ent/channeloverridetemplate"
 	"github.com/looplj/axonhub/internal/ent/channelprobe"
@@ -42,6 +43,7 @@ import (
 	"github.com/looplj/axonhub/internal/server/backup"
 	"github.com/looplj/axonhub/internal/server/biz"
 	"github.com/looplj/axonhub/internal/server/gc"
+	"github.com/looplj/axonhub/internal/server/gitops"
 	"github.com/looplj/axonhub/internal/server/orchestrator"
 	"github.com/looplj/axonhub/internal/server/scheduler"
 	"github.com/looplj/axonhub/internal/server/video_storage"
@@ -69,10 +71,19 @@ type Dependencies struct {
 	APIKeyProfileTemplateService   *biz.APIKeyProfileTemplateService
 	ModelService                   *biz.ModelService
 	BackupService                  *backup.BackupService
+	GitOpsService                  *gitops.GitOpsService
 	ChannelProbeService            *biz.ChannelProbeService
 	PromptService                  *biz.PromptService
 	PromptProtectionRuleService    *biz.PromptProtectionRuleService
+	BudgetService                  *biz.BudgetService
+	WebhookNotifier                *biz.WebhookNotifier
+	InvoiceService                 *biz.InvoiceService
+	WalletService                  *biz.WalletService
+	ExchangeRateService            *biz.ExchangeRateService
+	RequestSearchService           *biz.RequestSearchService
+	DataExportService              *biz.DataExportService
 	ProviderQuotaService           *biz.ProviderQuotaService
+	AuditLogService                *biz.AuditLogService
 	Scheduler                      *scheduler.Scheduler
 	DefaultSelector                *orchestrator.DefaultSelector
 	CandidateSelectorDiagnostics   *orchestrator.CandidateSelectorDiagnostics
@@ -108,9 +119,17 @@ func NewGraphqlHandlers(deps Dependencies) *GraphqlHandler {
 			deps.APIKeyProfileTemplateService,
 			deps.ModelService,
 			deps.BackupService,
+			deps.GitOpsService,
 			deps.ChannelProbeService,
 			deps.PromptService,
 			deps.PromptProtectionRuleService,
+			deps.BudgetService,
+			deps.WebhookNotifier,
+			deps.InvoiceService,
+			deps.WalletService,
+			deps.ExchangeRateService,
+			deps.RequestSearchService,
+			deps.DataExportService,
 			deps.ProviderQuotaService,
 			deps.Scheduler,
 			deps.DefaultSelector,
@@ -145,6 +164,9 @@ func NewGraphqlHandlers(deps Dependencies) *GraphqlHandler {
 			return skipTestChannelTransaction(op) || skipBulkImportTransaction(op)
 		},
 	})
+	if deps.AuditLogService != nil {
+		gqlSrv.Use(&AuditLogRecorder{AuditLogService: deps.AuditLogService})
+	}
 
 	// Set error presenter to handle CodedError and add extensions.code
 	gqlSrv.SetErrorPresenter(func(ctx context.Context, err error) *gqlerror.Error {
@@ -184,6 +206,7 @@ var guidTypeToNodeType = map[string]string{
 	ent.TypeUser:                    user.Table,
 	ent.TypeAPIKey:                  apikey.Table,
 	ent.TypeAPIKeyProfileTemplate:   apikeyprofiletemplate.Table,
+	ent.TypeAuditLog:                auditlog.Table,
 	ent.TypeModel:                   model.Table,
 	ent.TypeChannel:                 channel.Table,
 	ent.TypeChannelProbe:            channelprobe.Table,
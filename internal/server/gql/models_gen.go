@@ -12,6 +12,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/ent/channel"
 	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/backup"
 	"github.com/looplj/axonhub/internal/server/biz"
 	"github.com/looplj/axonhub/llm/httpclient"
 	"github.com/shopspring/decimal"
@@ -70,7 +71,7 @@ type AnalyticsDailyStat struct {
 	Cost                float64 `json:"cost"`
 }
 
-// Statistics for a single item in a dimension breakdown (channel/model/apiKey/user)
+// Statistics for a single item in a dimension breakdown (channel/model/apiKey/user/endUser)
 type AnalyticsDimensionStat struct {
 	ID                string  `json:"id"`
 	Name              string  `json:"name"`
@@ -99,6 +100,8 @@ type AnalyticsFilter struct {
 	APIKeyIDs []*objects.GUID `json:"apiKeyIDs,omitempty"`
 	// Filter by user IDs (will match through api_keys.user_id)
 	UserIDs []*objects.GUID `json:"userIDs,omitempty"`
+	// Filter by end user IDs of shared API keys
+	EndUserIDs []string `json:"endUserIDs,omitempty"`
 }
 
 // Metadata for the analytics page (independent of filters)
@@ -130,6 +133,15 @@ type ApplyChannelOverrideTemplatePayload struct {
 	Channels []*ent.Channel `json:"channels"`
 }
 
+type ApplyConfigurationInput struct {
+	// The YAML documents, documents separated by --- are merged.
+	Content string `json:"content"`
+	// Delete the objects of the kinds managed by the documents which are not declared in them.
+	Prune bool `json:"prune"`
+	// Report the changes without applying them.
+	DryRun bool `json:"dryRun"`
+}
+
 type AutoDisableAPIKey struct {
 	Enabled  bool                       `json:"enabled"`
 	Statuses []*AutoDisableAPIKeyStatus `json:"statuses"`
@@ -428,8 +440,9 @@ type RequestStatsByModel struct {
 }
 
 type RestorePayload struct {
-	Success bool    `json:"success"`
-	Message *string `json:"message,omitempty"`
+	Success bool                  `json:"success"`
+	Message *string               `json:"message,omitempty"`
+	Report  *backup.RestoreReport `json:"report,omitempty"`
 }
 
 type ScopeInfo struct {
@@ -438,6 +451,12 @@ type ScopeInfo struct {
 	Levels      []string `json:"levels"`
 }
 
+type SendTestWebhookEventInput struct {
+	TargetName string `json:"targetName"`
+	// The event name of the test event, defaults to "test".
+	Event *string `json:"event,omitempty"`
+}
+
 type SignInInput struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
@@ -555,16 +574,18 @@ type UpdateAPIKeyScopesInput struct {
 }
 
 type UpdateAutoBackupSettingsInput struct {
-	Enabled            *bool                `json:"enabled,omitempty"`
-	Frequency          *biz.BackupFrequency `json:"frequency,omitempty"`
-	DataStorageID      *int                 `json:"dataStorageID,omitempty"`
-	IncludeChannels    *bool                `json:"includeChannels,omitempty"`
-	IncludeModels      *bool                `json:"includeModels,omitempty"`
-	IncludeAPIKeys     *bool                `json:"includeAPIKeys,omitempty"`
-	IncludeModelPrices *bool                `json:"includeModelPrices,omitempty"`
-	IncludeUsageStats  *bool                `json:"includeUsageStats,omitempty"`
-	IncludeRequestLogs *bool                `json:"includeRequestLogs,omitempty"`
-	RetentionDays      *int                 `json:"retentionDays,omitempty"`
+	Enabled              *bool                `json:"enabled,omitempty"`
+	Frequency            *biz.BackupFrequency `json:"frequency,omitempty"`
+	DataStorageID        *int                 `json:"dataStorageID,omitempty"`
+	IncludeChannels      *bool                `json:"includeChannels,omitempty"`
+	IncludeModels        *bool                `json:"includeModels,omitempty"`
+	IncludeAPIKeys       *bool                `json:"includeAPIKeys,omitempty"`
+	IncludeModelPrices   *bool                `json:"includeModelPrices,omitempty"`
+	IncludeUsageStats    *bool                `json:"includeUsageStats,omitempty"`
+	IncludeRequestLogs   *bool                `json:"includeRequestLogs,omitempty"`
+	IncludeConfiguration *bool                `json:"includeConfiguration,omitempty"`
+	RetentionDays        *int                 `json:"retentionDays,omitempty"`
+	Incremental          *bool                `json:"incremental,omitempty"`
 }
 
 type UpdateBrandSettingsInput struct {
@@ -689,6 +710,63 @@ func (e DiagnosticsTarget) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type InvoiceExportFormat string
+
+const (
+	InvoiceExportFormatCSV  InvoiceExportFormat = "csv"
+	InvoiceExportFormatJSON InvoiceExportFormat = "json"
+	InvoiceExportFormatHTML InvoiceExportFormat = "html"
+)
+
+var AllInvoiceExportFormat = []InvoiceExportFormat{
+	InvoiceExportFormatCSV,
+	InvoiceExportFormatJSON,
+	InvoiceExportFormatHTML,
+}
+
+func (e InvoiceExportFormat) IsValid() bool {
+	switch e {
+	case InvoiceExportFormatCSV, InvoiceExportFormatJSON, InvoiceExportFormatHTML:
+		return true
+	}
+	return false
+}
+
+func (e InvoiceExportFormat) String() string {
+	return string(e)
+}
+
+func (e *InvoiceExportFormat) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = InvoiceExportFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid InvoiceExportFormat", str)
+	}
+	return nil
+}
+
+func (e InvoiceExportFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *InvoiceExportFormat) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e InvoiceExportFormat) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type OverrideApplyMode string
 
 const (
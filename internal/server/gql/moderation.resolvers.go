@@ -0,0 +1,18 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/objects"
+)
+
+// UpdateProjectModerationSettings is the resolver for the updateProjectModerationSettings field.
+func (r *mutationResolver) UpdateProjectModerationSettings(ctx context.Context, id objects.GUID, input objects.ProjectModerationSettings) (*ent.Project, error) {
+	return r.projectService.UpdateProjectModerationSettings(ctx, id.ID, input)
+}
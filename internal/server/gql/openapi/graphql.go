@@ -14,6 +14,7 @@ import (
 
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/internal/server/gql"
 )
 
 type GraphqlHandler struct {
@@ -28,6 +29,7 @@ type Dependencies struct {
 	APIKeyService                *biz.APIKeyService
 	APIKeyProfileTemplateService *biz.APIKeyProfileTemplateService
 	QuotaService                 *biz.QuotaService
+	AuditLogService              *biz.AuditLogService
 }
 
 func NewGraphqlHandlers(deps Dependencies) *GraphqlHandler {
@@ -53,6 +55,9 @@ func NewGraphqlHandlers(deps Dependencies) *GraphqlHandler {
 	gqlSrv.Use(entgql.Transactioner{
 		TxOpener: deps.Ent,
 	})
+	if deps.AuditLogService != nil {
+		gqlSrv.Use(&gql.AuditLogRecorder{AuditLogService: deps.AuditLogService})
+	}
 
 	return &GraphqlHandler{
 		Graphql:    gqlSrv,
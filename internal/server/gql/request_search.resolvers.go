@@ -0,0 +1,17 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// SearchRequests is the resolver for the searchRequests field.
+func (r *queryResolver) SearchRequests(ctx context.Context, input biz.SearchRequestsInput) ([]*biz.RequestSearchResult, error) {
+	return r.requestSearchService.SearchRequests(ctx, input)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/looplj/axonhub/internal/server/backup"
 	"github.com/looplj/axonhub/internal/server/biz"
 	"github.com/looplj/axonhub/internal/server/gc"
+	"github.com/looplj/axonhub/internal/server/gitops"
 	"github.com/looplj/axonhub/internal/server/orchestrator"
 	"github.com/looplj/axonhub/internal/server/scheduler"
 	"github.com/looplj/axonhub/internal/server/video_storage"
@@ -41,9 +42,17 @@ type Resolver struct {
 	apiKeyProfileTemplateService   *biz.APIKeyProfileTemplateService
 	modelService                   *biz.ModelService
 	backupService                  *backup.BackupService
+	gitOpsService                  *gitops.GitOpsService
 	channelProbeService            *biz.ChannelProbeService
 	promptService                  *biz.PromptService
 	promptProtectionRuleService    *biz.PromptProtectionRuleService
+	budgetService                  *biz.BudgetService
+	webhookNotifier                *biz.WebhookNotifier
+	invoiceService                 *biz.InvoiceService
+	walletService                  *biz.WalletService
+	exchangeRateService            *biz.ExchangeRateService
+	requestSearchService           *biz.RequestSearchService
+	dataExportService              *biz.DataExportService
 	providerQuotaService           *biz.ProviderQuotaService
 	scheduler                      *scheduler.Scheduler
 	modelFetcher                   *biz.ModelFetcher
@@ -75,9 +84,17 @@ func NewSchema(
 	apiKeyProfileTemplateService *biz.APIKeyProfileTemplateService,
 	modelService *biz.ModelService,
 	backupService *backup.BackupService,
+	gitOpsService *gitops.GitOpsService,
 	channelProbeService *biz.ChannelProbeService,
 	promptService *biz.PromptService,
 	promptProtectionRuleService *biz.PromptProtectionRuleService,
+	budgetService *biz.BudgetService,
+	webhookNotifier *biz.WebhookNotifier,
+	invoiceService *biz.InvoiceService,
+	walletService *biz.WalletService,
+	exchangeRateService *biz.ExchangeRateService,
+	requestSearchService *biz.RequestSearchService,
+	dataExportService *biz.DataExportService,
 	providerQuotaService *biz.ProviderQuotaService,
 	scheduler *scheduler.Scheduler,
 	defaultSelector *orchestrator.DefaultSelector,
@@ -108,9 +125,17 @@ func NewSchema(
 			apiKeyProfileTemplateService:   apiKeyProfileTemplateService,
 			modelService:                   modelService,
 			backupService:                  backupService,
+			gitOpsService:                  gitOpsService,
 			channelProbeService:            channelProbeService,
 			promptService:                  promptService,
 			promptProtectionRuleService:    promptProtectionRuleService,
+			budgetService:                  budgetService,
+			webhookNotifier:                webhookNotifier,
+			invoiceService:                 invoiceService,
+			walletService:                  walletService,
+			exchangeRateService:            exchangeRateService,
+			requestSearchService:           requestSearchService,
+			dataExportService:              dataExportService,
 			providerQuotaService:           providerQuotaService,
 			scheduler:                      scheduler,
 			modelFetcher:                   modelFetcher,
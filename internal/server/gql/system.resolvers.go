@@ -48,6 +48,15 @@ func (r *mutationResolver) UpdateBrandSettings(ctx context.Context, input Update
 
 // UpdateStoragePolicy is the resolver for the updateStoragePolicy field.
 func (r *mutationResolver) UpdateStoragePolicy(ctx context.Context, input biz.StoragePolicy) (bool, error) {
+	if input.Archive == nil {
+		current, err := r.systemService.StoragePolicy(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to get storage policy: %w", err)
+		}
+
+		input.Archive = current.Archive
+	}
+
 	err := r.systemService.SetStoragePolicy(ctx, &input)
 	if err != nil {
 		return false, fmt.Errorf("failed to update storage policy: %w", err)
@@ -0,0 +1,29 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// CreditWallet is the resolver for the creditWallet field.
+func (r *mutationResolver) CreditWallet(ctx context.Context, input biz.CreditWalletInput) (*ent.WalletTransaction, error) {
+	return r.walletService.CreditWallet(ctx, input)
+}
+
+// UpdateWallet is the resolver for the updateWallet field.
+func (r *mutationResolver) UpdateWallet(ctx context.Context, id objects.GUID, input ent.UpdateWalletInput) (*ent.Wallet, error) {
+	return r.walletService.UpdateWallet(ctx, id.ID, input)
+}
+
+// Available is the resolver for the available field.
+func (r *walletResolver) Available(ctx context.Context, obj *ent.Wallet) (float64, error) {
+	return obj.Balance - obj.Reserved, nil
+}
@@ -0,0 +1,31 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/scopes"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// SendTestWebhookEvent is the resolver for the sendTestWebhookEvent field.
+func (r *mutationResolver) SendTestWebhookEvent(ctx context.Context, input SendTestWebhookEventInput) (*ent.WebhookDelivery, error) {
+	if !scopes.UserHasScope(ctx, scopes.ScopeWriteSettings) {
+		return nil, fmt.Errorf("permission denied: requires write:settings scope")
+	}
+
+	return r.webhookNotifier.SendTestEvent(ctx, input.TargetName, lo.FromPtr(input.Event))
+}
+
+// WebhookEvents is the resolver for the webhookEvents field.
+func (r *queryResolver) WebhookEvents(ctx context.Context) ([]string, error) {
+	return biz.WebhookEvents, nil
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent/auditlog"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// WithClientIP stores the client ip of the request in the context.
+func WithClientIP() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(contexts.WithClientIP(c.Request.Context(), c.ClientIP()))
+		c.Next()
+	}
+}
+
+// WithAuditLog records the admin REST action in the audit log.
+// Changes of audited entities made by the handler are recorded with their diff,
+// otherwise a single entry is recorded for a successful action.
+func WithAuditLog(auditLogService *biz.AuditLogService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		info := &contexts.AuditInfo{
+			Source:    string(auditlog.SourceRest),
+			Operation: c.Request.Method + " " + c.FullPath(),
+		}
+		ctx := contexts.WithAuditInfo(c.Request.Context(), info)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		if info.Recorded() || c.Writer.Status() >= http.StatusBadRequest {
+			return
+		}
+
+		if err := auditLogService.RecordOperation(ctx); err != nil {
+			log.Warn(ctx, "failed to record admin action", log.Cause(err))
+		}
+	}
+}
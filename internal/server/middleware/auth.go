@@ -14,6 +14,7 @@ import (
 	"github.com/looplj/axonhub/internal/ent/apikey"
 	"github.com/looplj/axonhub/internal/ent/request"
 	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
 	"github.com/looplj/axonhub/internal/server/biz"
 	"github.com/looplj/axonhub/llm/transformer/shared"
 )
@@ -33,9 +34,17 @@ func WithAPIKeyConfig(auth *biz.AuthService, config *APIKeyConfig) gin.HandlerFu
 			return
 		}
 
-		var apiKey *ent.APIKey
+		var (
+			apiKey *ent.APIKey
+			token  *objects.APIKeyToken
+		)
+
 		if err == nil {
-			apiKey, err = auth.AuthenticateAPIKey(c.Request.Context(), key)
+			if biz.IsAPIKeyToken(key) {
+				apiKey, token, err = auth.AuthenticateAPIKeyToken(c.Request.Context(), key)
+			} else {
+				apiKey, err = auth.AuthenticateAPIKey(c.Request.Context(), key)
+			}
 		}
 		if err != nil {
 			apiKey, err = auth.AuthenticateNoAuth(c.Request.Context())
@@ -65,6 +74,12 @@ func WithAPIKeyConfig(auth *biz.AuthService, config *APIKeyConfig) gin.HandlerFu
 			ctx = contexts.WithProjectID(ctx, apiKey.Edges.Project.ID)
 		}
 
+		// Requests made with a token are attributed to the parent key and the end user of the token.
+		if token != nil {
+			ctx = contexts.WithAPIKeyToken(ctx, token)
+			ctx = contexts.WithEndUserID(ctx, token.EndUserID)
+		}
+
 		ctx = withSessionScopeForAPIKey(ctx, apiKey)
 
 		ctx, err = withAPIKeyPrincipal(ctx, apiKey)
@@ -231,6 +246,37 @@ func WithGeminiKeyAuth(auth *biz.AuthService) gin.HandlerFunc {
 	}
 }
 
+// WithSCIMAuth validates the SCIM bearer token of the identity provider.
+// SCIM requests are not made by a user or an API key, they run as the system.
+func WithSCIMAuth(scim *biz.SCIMService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !scim.Enabled() {
+			abortWithSCIMError(c, objects.NewSCIMError(http.StatusNotFound, "", "SCIM is not enabled"))
+			return
+		}
+
+		token, err := ExtractAPIKeyFromRequest(c.Request, &APIKeyConfig{
+			Headers:       []string{"Authorization"},
+			RequireBearer: true,
+		})
+		if err != nil || !scim.Authenticate(token) {
+			abortWithSCIMError(c, objects.NewSCIMError(http.StatusUnauthorized, "", "Invalid token"))
+			return
+		}
+
+		ctx := authz.WithSystemBypass(c.Request.Context(), "scim-provisioning")
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
+
+func abortWithSCIMError(c *gin.Context, err *objects.SCIMError) {
+	_ = c.Error(err)
+	c.Header("Content-Type", "application/scim+json")
+	c.AbortWithStatusJSON(err.HTTPStatus(), err)
+}
+
 // WithSource 中间件用于设置请求来源到 context 中.
 func WithSource(source request.Source) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/looplj/axonhub/internal/tracing"
+)
+
+// WithOTelTracing starts an OpenTelemetry server span for each request, continuing the trace
+// of an incoming W3C traceparent header. Static files, /health and /metrics are not traced.
+// It must run after WithLoggingTracing so that the span carries the AxonHub trace and request IDs.
+func WithOTelTracing() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" || route == "/health" || route == "/metrics" {
+			c.Next()
+			return
+		}
+
+		ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+
+		attrs := []attribute.KeyValue{
+			semconv.HTTPRequestMethodKey.String(c.Request.Method),
+			semconv.HTTPRoute(route),
+			semconv.URLPath(c.Request.URL.Path),
+		}
+		if traceID, ok := tracing.GetTraceID(ctx); ok {
+			attrs = append(attrs, attribute.String("axonhub.trace_id", traceID))
+		}
+
+		if requestID, ok := tracing.GetRequestID(ctx); ok {
+			attrs = append(attrs, attribute.String("axonhub.request_id", requestID))
+		}
+
+		ctx, span := tracing.Tracer.Start(ctx, c.Request.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attrs...),
+		)
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
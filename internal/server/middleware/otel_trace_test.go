@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/looplj/axonhub/internal/tracing"
+)
+
+func TestWithOTelTracing(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	originalTracer, originalPropagator := tracing.Tracer, otel.GetTextMapPropagator()
+	tracing.Tracer = provider.Tracer("axonhub-test")
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Cleanup(func() {
+		tracing.Tracer = originalTracer
+		otel.SetTextMapPropagator(originalPropagator)
+	})
+
+	router := gin.New()
+	router.Use(WithLoggingTracing(tracing.Config{}), WithOTelTracing())
+
+	var handlerSpan trace.SpanContext
+
+	router.POST("/v1/chat/completions", func(c *gin.Context) {
+		handlerSpan = trace.SpanContextFromContext(c.Request.Context())
+		c.Status(http.StatusBadGateway)
+	})
+	router.GET("/health", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	t.Run("continues the incoming trace", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/chat/completions", nil)
+		req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+
+		span := spans[0]
+		require.Equal(t, "POST /v1/chat/completions", span.Name())
+		require.Equal(t, trace.SpanKindServer, span.SpanKind())
+		require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+		require.Equal(t, "00f067aa0ba902b7", span.Parent().SpanID().String())
+		require.Equal(t, span.SpanContext(), handlerSpan)
+		require.Equal(t, "Error", span.Status().Code.String())
+
+		attrs := attribute.NewSet(span.Attributes()...)
+
+		status, ok := attrs.Value("http.response.status_code")
+		require.True(t, ok)
+		require.Equal(t, int64(http.StatusBadGateway), status.AsInt64())
+
+		_, ok = attrs.Value("axonhub.request_id")
+		require.True(t, ok)
+	})
+
+	t.Run("skips health checks", func(t *testing.T) {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+		require.Len(t, recorder.Ended(), 1)
+	})
+}
@@ -0,0 +1,164 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/llm"
+	"github.com/looplj/axonhub/llm/pipeline"
+)
+
+// enforceAPIKeyPolicy rejects requests outside the endpoint families and limits of the
+// active API key profile before any channel is selected, so nothing is sent upstream.
+func enforceAPIKeyPolicy(inbound *PersistentInboundTransformer, internalCall bool) pipeline.Middleware {
+	return pipeline.OnLlmRequest("enforce-api-key-policy", func(ctx context.Context, llmRequest *llm.Request) (*llm.Request, error) {
+		apiKey := inbound.state.APIKey
+		if apiKey == nil || internalCall {
+			return llmRequest, nil
+		}
+
+		profile := apiKey.GetActiveProfile()
+		if profile == nil {
+			return llmRequest, nil
+		}
+
+		if reason := checkAPIKeyPolicy(profile, llmRequest); reason != "" {
+			log.Warn(ctx, "request denied by API key policy",
+				log.Int("api_key_id", apiKey.ID),
+				log.String("active_profile", profile.Name),
+				log.String("request_type", llmRequest.RequestType.String()),
+				log.String("reason", reason))
+
+			requestID, _ := contexts.GetRequestID(ctx)
+
+			return nil, &llm.ResponseError{
+				StatusCode: http.StatusForbidden,
+				Detail: llm.ErrorDetail{
+					Code:      "api_key_policy_denied",
+					Message:   reason,
+					Type:      "permission_error",
+					RequestID: requestID,
+				},
+			}
+		}
+
+		applyMaxTokensLimit(profile, llmRequest)
+
+		return llmRequest, nil
+	})
+}
+
+// applyMaxTokensLimit sends the max tokens limit of the profile as max_tokens when the chat request
+// does not ask for a value, so the provider default can not exceed the limit.
+func applyMaxTokensLimit(profile *objects.APIKeyProfile, llmRequest *llm.Request) {
+	if profile.Limits == nil || profile.Limits.MaxTokens == nil {
+		return
+	}
+
+	if apiKeyEndpointOf(llmRequest.RequestType) != objects.APIKeyEndpointChat {
+		return
+	}
+
+	if llmRequest.MaxTokens != nil || llmRequest.MaxCompletionTokens != nil {
+		return
+	}
+
+	llmRequest.MaxTokens = lo.ToPtr(*profile.Limits.MaxTokens)
+}
+
+// checkAPIKeyPolicy returns the reason the request is denied by the profile, or an empty string if it is allowed.
+func checkAPIKeyPolicy(profile *objects.APIKeyProfile, llmRequest *llm.Request) string {
+	endpoint := apiKeyEndpointOf(llmRequest.RequestType)
+	if !profile.AllowsEndpoint(endpoint) {
+		if endpoint == "" {
+			return fmt.Sprintf("API key is not allowed to call %s requests", llmRequest.RequestType)
+		}
+
+		return fmt.Sprintf("API key is not allowed to call %s endpoints", endpoint)
+	}
+
+	limits := profile.Limits
+	if limits == nil {
+		return ""
+	}
+
+	if limits.MaxTokens != nil {
+		for _, requested := range []*int64{llmRequest.MaxTokens, llmRequest.MaxCompletionTokens} {
+			if requested != nil && *requested > *limits.MaxTokens {
+				return fmt.Sprintf("max_tokens %d exceeds the API key limit of %d", *requested, *limits.MaxTokens)
+			}
+		}
+	}
+
+	if len(limits.AllowedTools) > 0 {
+		for _, tool := range llmRequest.Tools {
+			if !slices.Contains(limits.AllowedTools, tool.Type) {
+				return fmt.Sprintf("tool type %s is not allowed for this API key", tool.Type)
+			}
+		}
+	}
+
+	if limits.MaxImageSize != "" && llmRequest.Image != nil {
+		if reason := checkImageSize(llmRequest.Image.Size, limits.MaxImageSize); reason != "" {
+			return reason
+		}
+	}
+
+	return ""
+}
+
+// checkImageSize compares the requested image size with the limit. Sizes chosen by the
+// provider, such as "auto", are allowed.
+func checkImageSize(size, maxSize string) string {
+	if size == "" || strings.EqualFold(size, "auto") {
+		return ""
+	}
+
+	maxWidth, maxHeight, err := objects.ParseImageSize(maxSize)
+	if err != nil {
+		return ""
+	}
+
+	width, height, err := objects.ParseImageSize(size)
+	if err != nil {
+		return fmt.Sprintf("image size %s cannot be checked against the API key limit of %s", size, maxSize)
+	}
+
+	if width > maxWidth || height > maxHeight {
+		return fmt.Sprintf("image size %s exceeds the API key limit of %s", size, maxSize)
+	}
+
+	return ""
+}
+
+// apiKeyEndpointOf maps the inbound request type to its endpoint family.
+// The request types without a family, e.g. realtime sessions or batch jobs once they are routed,
+// return an empty family which is denied by the profiles restricting the endpoints.
+func apiKeyEndpointOf(requestType llm.RequestType) objects.APIKeyEndpoint {
+	switch requestType {
+	case "", llm.RequestTypeChat, llm.RequestTypeCompletion, llm.RequestTypeCompact:
+		return objects.APIKeyEndpointChat
+	case llm.RequestTypeEmbedding:
+		return objects.APIKeyEndpointEmbeddings
+	case llm.RequestTypeImage:
+		return objects.APIKeyEndpointImages
+	case llm.RequestTypeVideo:
+		return objects.APIKeyEndpointVideo
+	case llm.RequestTypeSpeech, llm.RequestTypeTranscription, llm.RequestTypeTranslation:
+		return objects.APIKeyEndpointAudio
+	case llm.RequestTypeRerank:
+		return objects.APIKeyEndpointRerank
+	case llm.RequestTypeModeration:
+		return objects.APIKeyEndpointModerations
+	default:
+		return ""
+	}
+}
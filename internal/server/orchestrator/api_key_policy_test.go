@@ -0,0 +1,193 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/llm"
+)
+
+func TestEnforceAPIKeyPolicy(t *testing.T) {
+	profile := objects.APIKeyProfile{
+		Name:      "embeddings-only",
+		Endpoints: []objects.APIKeyEndpoint{objects.APIKeyEndpointEmbeddings, objects.APIKeyEndpointChat, objects.APIKeyEndpointImages},
+		Limits: &objects.APIKeyLimits{
+			MaxTokens:    lo.ToPtr(int64(1024)),
+			AllowedTools: []string{llm.ToolTypeFunction},
+			MaxImageSize: "1024x1024",
+		},
+	}
+
+	tests := []struct {
+		name        string
+		request     *llm.Request
+		internal    bool
+		expectError string
+	}{
+		{
+			name:    "allowed embedding",
+			request: &llm.Request{RequestType: llm.RequestTypeEmbedding},
+		},
+		{
+			name:        "video endpoint denied",
+			request:     &llm.Request{RequestType: llm.RequestTypeVideo},
+			expectError: "video endpoints",
+		},
+		{
+			name:        "audio endpoint denied",
+			request:     &llm.Request{RequestType: llm.RequestTypeTranscription},
+			expectError: "audio endpoints",
+		},
+		{
+			name:        "request type without an endpoint family denied",
+			request:     &llm.Request{RequestType: llm.RequestType("realtime")},
+			expectError: "realtime requests",
+		},
+		{
+			name:     "internal call skips policy",
+			request:  &llm.Request{RequestType: llm.RequestTypeModeration},
+			internal: true,
+		},
+		{
+			name:    "max tokens within limit",
+			request: &llm.Request{RequestType: llm.RequestTypeChat, MaxTokens: lo.ToPtr(int64(1024))},
+		},
+		{
+			name:        "max completion tokens over limit",
+			request:     &llm.Request{RequestType: llm.RequestTypeChat, MaxCompletionTokens: lo.ToPtr(int64(4096))},
+			expectError: "exceeds the API key limit of 1024",
+		},
+		{
+			name: "function tool allowed",
+			request: &llm.Request{
+				RequestType: llm.RequestTypeChat,
+				Tools:       []llm.Tool{{Type: llm.ToolTypeFunction}},
+			},
+		},
+		{
+			name: "web search tool denied",
+			request: &llm.Request{
+				RequestType: llm.RequestTypeChat,
+				Tools:       []llm.Tool{{Type: llm.ToolTypeFunction}, {Type: llm.ToolTypeWebSearch}},
+			},
+			expectError: "tool type web_search",
+		},
+		{
+			name:    "auto image size allowed",
+			request: &llm.Request{RequestType: llm.RequestTypeImage, Image: &llm.ImageRequest{Size: "auto"}},
+		},
+		{
+			name:        "image size over limit",
+			request:     &llm.Request{RequestType: llm.RequestTypeImage, Image: &llm.ImageRequest{Size: "1536x1024"}},
+			expectError: "image size 1536x1024",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inbound := &PersistentInboundTransformer{
+				state: &PersistenceState{
+					APIKey: &ent.APIKey{
+						ID:   1,
+						Name: "test-key",
+						Profiles: &objects.APIKeyProfiles{
+							ActiveProfile: profile.Name,
+							Profiles:      []objects.APIKeyProfile{profile},
+						},
+					},
+				},
+			}
+
+			result, err := enforceAPIKeyPolicy(inbound, tt.internal).OnInboundLlmRequest(context.Background(), tt.request)
+			if tt.expectError == "" {
+				require.NoError(t, err)
+				require.Same(t, tt.request, result)
+
+				return
+			}
+
+			var respErr *llm.ResponseError
+			require.True(t, errors.As(err, &respErr))
+			require.Equal(t, http.StatusForbidden, respErr.StatusCode)
+			require.Equal(t, "api_key_policy_denied", respErr.Detail.Code)
+			require.Contains(t, respErr.Detail.Message, tt.expectError)
+		})
+	}
+}
+
+func TestEnforceAPIKeyPolicy_InjectsMaxTokens(t *testing.T) {
+	inbound := &PersistentInboundTransformer{
+		state: &PersistenceState{
+			APIKey: &ent.APIKey{
+				Name: "test-key",
+				Profiles: &objects.APIKeyProfiles{
+					ActiveProfile: "default",
+					Profiles: []objects.APIKeyProfile{{
+						Name:   "default",
+						Limits: &objects.APIKeyLimits{MaxTokens: lo.ToPtr(int64(1024))},
+					}},
+				},
+			},
+		},
+	}
+
+	t.Run("unset max tokens use the limit", func(t *testing.T) {
+		result, err := enforceAPIKeyPolicy(inbound, false).OnInboundLlmRequest(context.Background(), &llm.Request{
+			RequestType: llm.RequestTypeChat,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, result.MaxTokens)
+		require.Equal(t, int64(1024), *result.MaxTokens)
+		require.Nil(t, result.MaxCompletionTokens)
+	})
+
+	t.Run("requested max completion tokens are kept", func(t *testing.T) {
+		result, err := enforceAPIKeyPolicy(inbound, false).OnInboundLlmRequest(context.Background(), &llm.Request{
+			RequestType:         llm.RequestTypeChat,
+			MaxCompletionTokens: lo.ToPtr(int64(512)),
+		})
+		require.NoError(t, err)
+		require.Nil(t, result.MaxTokens)
+		require.Equal(t, int64(512), *result.MaxCompletionTokens)
+	})
+
+	t.Run("non chat requests are not changed", func(t *testing.T) {
+		result, err := enforceAPIKeyPolicy(inbound, false).OnInboundLlmRequest(context.Background(), &llm.Request{
+			RequestType: llm.RequestTypeEmbedding,
+		})
+		require.NoError(t, err)
+		require.Nil(t, result.MaxTokens)
+	})
+}
+
+func TestEnforceAPIKeyPolicy_NoRestrictions(t *testing.T) {
+	inbound := &PersistentInboundTransformer{
+		state: &PersistenceState{
+			APIKey: &ent.APIKey{
+				Name: "test-key",
+				Profiles: &objects.APIKeyProfiles{
+					ActiveProfile: "default",
+					Profiles:      []objects.APIKeyProfile{{Name: "default"}},
+				},
+			},
+		},
+	}
+
+	_, err := enforceAPIKeyPolicy(inbound, false).OnInboundLlmRequest(context.Background(), &llm.Request{
+		RequestType: llm.RequestTypeVideo,
+		MaxTokens:   lo.ToPtr(int64(100000)),
+	})
+	require.NoError(t, err)
+
+	_, err = enforceAPIKeyPolicy(inbound, false).OnInboundLlmRequest(context.Background(), &llm.Request{
+		RequestType: llm.RequestType("realtime"),
+	})
+	require.NoError(t, err)
+}
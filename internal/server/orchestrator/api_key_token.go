@@ -0,0 +1,64 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/llm"
+	"github.com/looplj/axonhub/llm/pipeline"
+)
+
+// enforceAPIKeyToken enforces the models and budget embedded in the short-lived token the request is made with.
+func enforceAPIKeyToken(quotaService *biz.QuotaService, internalCall bool) pipeline.Middleware {
+	return pipeline.OnLlmRequest("enforce-api-key-token", func(ctx context.Context, llmRequest *llm.Request) (*llm.Request, error) {
+		token, ok := contexts.GetAPIKeyToken(ctx)
+		if !ok || internalCall {
+			return llmRequest, nil
+		}
+
+		if len(token.Models) > 0 && !slices.Contains(token.Models, llmRequest.Model) {
+			log.Warn(ctx, "model access denied by API key token",
+				log.Int("api_key_id", token.APIKeyID),
+				log.String("end_user_id", token.EndUserID),
+				log.String("model", llmRequest.Model),
+				log.Any("allowed_models", token.Models))
+
+			return nil, fmt.Errorf("%w: %s", biz.ErrInvalidModel, llmRequest.Model)
+		}
+
+		if quotaService == nil || token.Budget == nil {
+			return llmRequest, nil
+		}
+
+		result, err := quotaService.CheckAPIKeyTokenBudget(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Allowed {
+			return llmRequest, nil
+		}
+
+		log.Info(ctx, "api key token budget exceeded",
+			log.Int("api_key_id", token.APIKeyID),
+			log.String("end_user_id", token.EndUserID),
+			log.String("message", result.Message))
+
+		requestID, _ := contexts.GetRequestID(ctx)
+
+		return nil, &llm.ResponseError{
+			StatusCode: http.StatusForbidden,
+			Detail: llm.ErrorDetail{
+				Code:      "quota_exceeded",
+				Message:   result.Message,
+				Type:      "quota_exceeded_error",
+				RequestID: requestID,
+			},
+		}
+	})
+}
@@ -0,0 +1,37 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/llm"
+)
+
+func TestEnforceAPIKeyToken_Models(t *testing.T) {
+	ctx := contexts.WithAPIKeyToken(context.Background(), &objects.APIKeyToken{
+		APIKeyID:  1,
+		EndUserID: "customer-1",
+		Models:    []string{"gpt-4o"},
+	})
+
+	req := &llm.Request{Model: "gpt-4o"}
+	result, err := enforceAPIKeyToken(nil, false).OnInboundLlmRequest(ctx, req)
+	require.NoError(t, err)
+	require.Same(t, req, result)
+
+	_, err = enforceAPIKeyToken(nil, false).OnInboundLlmRequest(ctx, &llm.Request{Model: "gpt-4.1"})
+	require.ErrorIs(t, err, biz.ErrInvalidModel)
+
+	// Internal calls, such as the moderation guardrail, are not restricted by the token.
+	_, err = enforceAPIKeyToken(nil, true).OnInboundLlmRequest(ctx, &llm.Request{Model: "omni-moderation-latest"})
+	require.NoError(t, err)
+
+	// Requests without a token are not affected.
+	_, err = enforceAPIKeyToken(nil, false).OnInboundLlmRequest(context.Background(), &llm.Request{Model: "gpt-4.1"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,82 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/llm"
+	"github.com/looplj/axonhub/llm/pipeline"
+)
+
+// enforceBudgets enforces the actions of the exhausted budgets covering the API key.
+// It runs after the API key model mapping, so the downgrade mappings apply to the model the channels are selected for.
+func enforceBudgets(inbound *PersistentInboundTransformer, budgetService *biz.BudgetService, internalCall bool) pipeline.Middleware {
+	return pipeline.OnLlmRequest("enforce-budgets", func(ctx context.Context, llmRequest *llm.Request) (*llm.Request, error) {
+		if budgetService == nil || internalCall {
+			return llmRequest, nil
+		}
+
+		budgets := budgetService.ExhaustedBudgets(inbound.state.APIKey)
+		if len(budgets) == 0 {
+			return llmRequest, nil
+		}
+
+		for _, b := range budgets {
+			if b.Settings.ActionOrDefault() == objects.BudgetActionBlock {
+				return nil, budgetExceededError(ctx, inbound.state.APIKey, b)
+			}
+		}
+
+		var channelTags [][]string
+
+		for _, b := range budgets {
+			//nolint:exhaustive // Checked.
+			switch b.Settings.ActionOrDefault() {
+			case objects.BudgetActionDowngrade:
+				model := inbound.state.ModelMapper.applyModelMapping(b.Settings.ModelMappings, llmRequest.Model)
+				if model == llmRequest.Model {
+					continue
+				}
+
+				log.Info(ctx, "downgraded model by exhausted budget",
+					log.Int("budget_id", b.ID),
+					log.String("original_model", llmRequest.Model),
+					log.String("mapped_model", model))
+
+				llmRequest.Model = model
+				inbound.state.OriginalModel = model
+			case objects.BudgetActionRestrictTags:
+				channelTags = append(channelTags, b.Settings.ChannelTags)
+			}
+		}
+
+		inbound.state.BudgetChannelTags = channelTags
+
+		return llmRequest, nil
+	})
+}
+
+func budgetExceededError(ctx context.Context, apiKey *ent.APIKey, b *ent.Budget) error {
+	requestID, _ := contexts.GetRequestID(ctx)
+	log.Info(ctx, "budget exceeded",
+		log.Int("api_key_id", apiKey.ID),
+		log.Int("budget_id", b.ID),
+		log.String("budget_name", b.Name),
+		log.String("request_id", requestID))
+
+	return &llm.ResponseError{
+		StatusCode: http.StatusForbidden,
+		Detail: llm.ErrorDetail{
+			Code:      "budget_exceeded",
+			Message:   fmt.Sprintf("budget %q exceeded", b.Name),
+			Type:      "quota_exceeded_error",
+			RequestID: requestID,
+		},
+	}
+}
@@ -0,0 +1,121 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/budget"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xcache"
+	"github.com/looplj/axonhub/internal/pkg/xtime"
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/llm"
+)
+
+func setupTestBudgets(t *testing.T, settings ...objects.BudgetSettings) (*biz.BudgetService, *ent.APIKey) {
+	t.Helper()
+
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=0")
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := authz.WithTestBypass(ent.NewContext(context.Background(), client))
+
+	systemService := biz.NewSystemService(biz.SystemServiceParams{
+		CacheConfig: xcache.Config{},
+		Ent:         client,
+	})
+	budgetService := biz.NewBudgetService(biz.BudgetServiceParams{
+		Ent:           client,
+		SystemService: systemService,
+	})
+
+	apiKey, err := client.APIKey.Create().
+		SetName("budget-key").
+		SetKey("ah-budget-key").
+		SetProjectID(1).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.UsageLog.Create().
+		SetRequestID(1).
+		SetProjectID(1).
+		SetAPIKeyID(apiKey.ID).
+		SetModelID("gpt-4").
+		SetTotalCost(10).
+		SetCreatedAt(xtime.UTCNow()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	for i, s := range settings {
+		_, err := client.Budget.Create().
+			SetProjectID(1).
+			SetName(string(rune('a' + i))).
+			SetScope(budget.ScopeAPIKey).
+			SetAPIKeyID(apiKey.ID).
+			SetAmount(5).
+			SetSettings(s).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, budgetService.CheckBudgets(ctx))
+
+	return budgetService, apiKey
+}
+
+func TestEnforceBudgets_Block(t *testing.T) {
+	budgetService, apiKey := setupTestBudgets(t,
+		objects.BudgetSettings{Action: objects.BudgetActionDowngrade, ModelMappings: []objects.ModelMapping{{From: "gpt-4", To: "gpt-4o-mini"}}},
+		objects.BudgetSettings{Action: objects.BudgetActionBlock},
+	)
+
+	inbound := &PersistentInboundTransformer{
+		state: &PersistenceState{APIKey: apiKey, ModelMapper: NewModelMapper()},
+	}
+
+	_, err := enforceBudgets(inbound, budgetService, false).OnInboundLlmRequest(context.Background(), &llm.Request{Model: "gpt-4"})
+
+	var respErr *llm.ResponseError
+	require.True(t, errors.As(err, &respErr))
+	require.Equal(t, http.StatusForbidden, respErr.StatusCode)
+	require.Equal(t, "budget_exceeded", respErr.Detail.Code)
+}
+
+func TestEnforceBudgets_DowngradeAndRestrictTags(t *testing.T) {
+	budgetService, apiKey := setupTestBudgets(t,
+		objects.BudgetSettings{Action: objects.BudgetActionDowngrade, ModelMappings: []objects.ModelMapping{{From: "gpt-4*", To: "gpt-4o-mini"}}},
+		objects.BudgetSettings{Action: objects.BudgetActionRestrictTags, ChannelTags: []string{"cheap"}},
+		objects.BudgetSettings{Action: objects.BudgetActionNone},
+	)
+
+	inbound := &PersistentInboundTransformer{
+		state: &PersistenceState{APIKey: apiKey, ModelMapper: NewModelMapper(), OriginalModel: "gpt-4"},
+	}
+
+	req, err := enforceBudgets(inbound, budgetService, false).OnInboundLlmRequest(context.Background(), &llm.Request{Model: "gpt-4"})
+	require.NoError(t, err)
+	require.Equal(t, "gpt-4o-mini", req.Model)
+	require.Equal(t, "gpt-4o-mini", inbound.state.OriginalModel)
+	require.Equal(t, [][]string{{"cheap"}}, inbound.state.BudgetChannelTags)
+}
+
+func TestEnforceBudgets_NotExhausted(t *testing.T) {
+	budgetService, _ := setupTestBudgets(t, objects.BudgetSettings{Action: objects.BudgetActionBlock})
+
+	// Another API key of the project is not covered by the api_key budget.
+	inbound := &PersistentInboundTransformer{
+		state: &PersistenceState{APIKey: &ent.APIKey{ID: 100, ProjectID: 1}, ModelMapper: NewModelMapper()},
+	}
+
+	req, err := enforceBudgets(inbound, budgetService, false).OnInboundLlmRequest(context.Background(), &llm.Request{Model: "gpt-4"})
+	require.NoError(t, err)
+	require.Equal(t, "gpt-4", req.Model)
+	require.Empty(t, inbound.state.BudgetChannelTags)
+}
@@ -0,0 +1,122 @@
+package orchestrator
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/llm"
+	"github.com/looplj/axonhub/llm/pipeline"
+)
+
+// EndUserIDHeader identifies the end user of a request made with a shared API key.
+const EndUserIDHeader = "AH-End-User-Id"
+
+// maxEndUserIDLength matches the length of the end_user_id column of requests and usage logs.
+const maxEndUserIDLength = 256
+
+// resolveEndUser attributes the request to an end user of the API key, so that it is recorded
+// on the request and its usage logs. The subject of an API key token takes precedence over the
+// AH-End-User-Id header, which takes precedence over the user identifiers in the request body.
+func resolveEndUser() pipeline.Middleware {
+	return pipeline.OnLlmRequest("resolve-end-user", func(ctx context.Context, llmRequest *llm.Request) (*llm.Request, error) {
+		if _, ok := contexts.GetEndUserID(ctx); ok {
+			return llmRequest, nil
+		}
+
+		endUserID := endUserIDOf(llmRequest)
+		if endUserID == "" {
+			return llmRequest, nil
+		}
+
+		if len(endUserID) > maxEndUserIDLength {
+			log.Warn(ctx, "end user id is too long, request is not attributed to an end user",
+				log.Int("length", len(endUserID)))
+
+			return llmRequest, nil
+		}
+
+		contexts.WithEndUserID(ctx, endUserID)
+
+		return llmRequest, nil
+	})
+}
+
+func endUserIDOf(llmRequest *llm.Request) string {
+	if llmRequest.RawRequest != nil && llmRequest.RawRequest.Headers != nil {
+		if endUserID := strings.TrimSpace(llmRequest.RawRequest.Headers.Get(EndUserIDHeader)); endUserID != "" {
+			return endUserID
+		}
+	}
+
+	if llmRequest.User != nil {
+		if endUserID := strings.TrimSpace(*llmRequest.User); endUserID != "" {
+			return endUserID
+		}
+	}
+
+	// Anthropic requests carry the end user in metadata.user_id.
+	if endUserID := strings.TrimSpace(llmRequest.Metadata["user_id"]); endUserID != "" {
+		return endUserID
+	}
+
+	if llmRequest.SafetyIdentifier != nil {
+		return strings.TrimSpace(*llmRequest.SafetyIdentifier)
+	}
+
+	return ""
+}
+
+// enforceEndUserQuota enforces the end user quota of the active profile on the end user the request is attributed to.
+func enforceEndUserQuota(inbound *PersistentInboundTransformer, quotaService *biz.QuotaService, internalCall bool) pipeline.Middleware {
+	return pipeline.OnLlmRequest("enforce-end-user-quota", func(ctx context.Context, llmRequest *llm.Request) (*llm.Request, error) {
+		if quotaService == nil || internalCall {
+			return llmRequest, nil
+		}
+
+		apiKey := inbound.state.APIKey
+		if apiKey == nil {
+			return llmRequest, nil
+		}
+
+		profile := apiKey.GetActiveProfile()
+		if profile == nil || profile.EndUserQuota == nil {
+			return llmRequest, nil
+		}
+
+		endUserID, ok := contexts.GetEndUserID(ctx)
+		if !ok {
+			return llmRequest, nil
+		}
+
+		result, err := quotaService.CheckEndUserQuota(ctx, apiKey.ID, endUserID, profile.EndUserQuota)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Allowed {
+			return llmRequest, nil
+		}
+
+		requestID, _ := contexts.GetRequestID(ctx)
+		log.Info(ctx, "end user quota exceeded",
+			log.Int("api_key_id", apiKey.ID),
+			log.String("profile_name", profile.Name),
+			log.String("end_user_id", endUserID),
+			log.String("message", result.Message),
+			log.String("request_id", requestID))
+
+		return nil, &llm.ResponseError{
+			StatusCode: http.StatusForbidden,
+			Detail: llm.ErrorDetail{
+				Code:      "quota_exceeded",
+				Message:   "end user " + result.Message,
+				Type:      "quota_exceeded_error",
+				RequestID: requestID,
+			},
+		}
+	})
+}
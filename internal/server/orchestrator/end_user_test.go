@@ -0,0 +1,176 @@
+package orchestrator
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/ent/usagelog"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/llm"
+	"github.com/looplj/axonhub/llm/httpclient"
+	"github.com/looplj/axonhub/llm/pipeline"
+	"github.com/looplj/axonhub/llm/pipeline/stream"
+	"github.com/looplj/axonhub/llm/transformer/openai"
+)
+
+func TestEndUserIDOf(t *testing.T) {
+	headers := http.Header{}
+	headers.Set(EndUserIDHeader, " header-user ")
+
+	tests := []struct {
+		name    string
+		request *llm.Request
+		want    string
+	}{
+		{
+			name: "header takes precedence",
+			request: &llm.Request{
+				RawRequest:       &httpclient.Request{Headers: headers},
+				User:             lo.ToPtr("field-user"),
+				SafetyIdentifier: lo.ToPtr("safety-user"),
+			},
+			want: "header-user",
+		},
+		{
+			name:    "user field",
+			request: &llm.Request{User: lo.ToPtr("field-user"), SafetyIdentifier: lo.ToPtr("safety-user")},
+			want:    "field-user",
+		},
+		{
+			name:    "anthropic metadata",
+			request: &llm.Request{Metadata: map[string]string{"user_id": "metadata-user"}},
+			want:    "metadata-user",
+		},
+		{
+			name:    "safety identifier",
+			request: &llm.Request{User: lo.ToPtr(" "), SafetyIdentifier: lo.ToPtr("safety-user")},
+			want:    "safety-user",
+		},
+		{
+			name:    "none",
+			request: &llm.Request{},
+			want:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, endUserIDOf(tt.request))
+		})
+	}
+}
+
+func TestResolveEndUser_TokenSubjectTakesPrecedence(t *testing.T) {
+	ctx := contexts.WithEndUserID(context.Background(), "token-user")
+
+	_, err := resolveEndUser().OnInboundLlmRequest(ctx, &llm.Request{User: lo.ToPtr("field-user")})
+	require.NoError(t, err)
+
+	endUserID, ok := contexts.GetEndUserID(ctx)
+	require.True(t, ok)
+	require.Equal(t, "token-user", endUserID)
+}
+
+func TestChatCompletionOrchestrator_Process_EndUserQuotaExceeded(t *testing.T) {
+	baseCtx := authz.WithTestBypass(context.Background())
+
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=0")
+	defer client.Close()
+
+	baseCtx = ent.NewContext(baseCtx, client)
+
+	project := createTestProject(t, baseCtx, client)
+	ch := createTestChannel(t, baseCtx, client)
+	channelService, requestService, systemService, usageLogService := setupTestServices(t, client)
+
+	apiKey, err := client.APIKey.Create().
+		SetName("End User Quota API Key").
+		SetKey("ah-end-user-quota-key").
+		SetProjectID(project.ID).
+		SetProfiles(&objects.APIKeyProfiles{
+			ActiveProfile: "default",
+			Profiles: []objects.APIKeyProfile{
+				{
+					Name: "default",
+					EndUserQuota: &objects.APIKeyQuota{
+						Requests: lo.ToPtr(int64(1)),
+						Period: objects.APIKeyQuotaPeriod{
+							Type: objects.APIKeyQuotaPeriodTypeAllTime,
+						},
+					},
+				},
+			},
+		}).
+		Save(baseCtx)
+	require.NoError(t, err)
+
+	executor := &mockExecutor{
+		response: &httpclient.Response{
+			StatusCode: 200,
+			Body:       buildMockOpenAIResponse("chatcmpl-end-user-1", "gpt-4", "ok", 10, 20),
+			Headers:    http.Header{"Content-Type": []string{"application/json"}},
+		},
+	}
+
+	outbound, err := openai.NewOutboundTransformer(ch.BaseURL, ch.Credentials.APIKey)
+	require.NoError(t, err)
+
+	orchestrator := &ChatCompletionOrchestrator{
+		channelSelector: &staticChannelSelector{candidates: channelsToTestCandidates([]*biz.Channel{{
+			Channel:  ch,
+			Outbound: outbound,
+		}}, "gpt-4")},
+		Inbound:               openai.NewInboundTransformer(),
+		RequestService:        requestService,
+		ChannelService:        channelService,
+		PromptProvider:        &stubPromptProvider{},
+		SystemService:         systemService,
+		UsageLogService:       usageLogService,
+		QuotaService:          biz.NewQuotaService(client, systemService),
+		PipelineFactory:       pipeline.NewFactory(executor),
+		ModelMapper:           NewModelMapper(),
+		channelLimiterManager: NewChannelLimiterManager(),
+		Middlewares: []pipeline.Middleware{
+			stream.EnsureUsage(),
+		},
+	}
+
+	// Every call gets its own context container, as every HTTP request does.
+	process := func(endUserID string) error {
+		ctx := contexts.WithProjectID(baseCtx, project.ID)
+		ctx = contexts.WithAPIKey(ctx, apiKey)
+
+		httpRequest := buildTestRequest("gpt-4", "Hello!", false)
+		httpRequest.Headers.Set(EndUserIDHeader, endUserID)
+
+		_, err := orchestrator.Process(ctx, httpRequest)
+
+		return err
+	}
+
+	require.NoError(t, process("alice"))
+
+	err = process("alice")
+
+	var respErr *llm.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, http.StatusForbidden, respErr.StatusCode)
+	require.Equal(t, "quota_exceeded", respErr.Detail.Code)
+	require.Contains(t, respErr.Detail.Message, "end user requests quota exceeded")
+
+	// The quota applies to each end user separately.
+	require.NoError(t, process("bob"))
+
+	count, err := client.UsageLog.Query().Where(usagelog.EndUserIDEQ("alice")).Count(baseCtx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
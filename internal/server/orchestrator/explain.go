@@ -0,0 +1,299 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/scopes"
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/llm/httpclient"
+)
+
+// ExplainHeader opts a request in to the explanation of how it was routed and billed.
+const ExplainHeader = "AH-Explain"
+
+// ExplanationEvent is the SSE event sent as the trailer of an explained stream.
+const ExplanationEvent = "axonhub.explanation"
+
+// Headers of the explanation of a request.
+const (
+	ExplainRequestRecordIDHeader        = "AH-Request-Record-Id"
+	ExplainChannelIDHeader              = "AH-Channel-Id"
+	ExplainChannelNameHeader            = "AH-Channel-Name"
+	ExplainAttemptsHeader               = "AH-Attempts"
+	ExplainCacheHitHeader               = "AH-Cache-Hit"
+	ExplainCostHeader                   = "AH-Cost"
+	ExplainCostCurrencyHeader           = "AH-Cost-Currency"
+	ExplainQuotaRemainingRequestsHeader = "AH-Quota-Remaining-Requests"
+	ExplainQuotaRemainingTokensHeader   = "AH-Quota-Remaining-Tokens"
+	ExplainQuotaRemainingCostHeader     = "AH-Quota-Remaining-Cost"
+	ExplainDecisionLogHeader            = "AH-Decision-Log"
+)
+
+// Explanation describes how a request was routed and billed.
+type Explanation struct {
+	// RequestID is the ID of the request record.
+	RequestID int `json:"requestId,omitempty"`
+	// ChannelID and ChannelName are the channel that served the request,
+	// only set when the caller has the read_channels scope.
+	ChannelID   int    `json:"channelId,omitempty"`
+	ChannelName string `json:"channelName,omitempty"`
+	// Attempts is the number of upstream attempts, including retries.
+	Attempts int `json:"attempts"`
+	// CacheHit reports whether the provider served part of the prompt from its cache.
+	CacheHit *bool `json:"cacheHit,omitempty"`
+	// Cost and Currency are the computed cost of the request.
+	Cost     *float64 `json:"cost,omitempty"`
+	Currency string   `json:"currency,omitempty"`
+	// QuotaRemaining is the remaining quota of the API key in the current quota window.
+	QuotaRemaining *ExplainedQuota `json:"quotaRemaining,omitempty"`
+	// Decisions are the load balancing decisions, only set when the caller has the debug_requests scope.
+	Decisions []ExplainedDecision `json:"decisions,omitempty"`
+}
+
+// ExplainedQuota is the remaining quota of the API key, a nil field means the quota is unlimited.
+type ExplainedQuota struct {
+	Requests    *int64           `json:"requests,omitempty"`
+	TotalTokens *int64           `json:"totalTokens,omitempty"`
+	Cost        *decimal.Decimal `json:"cost,omitempty"`
+}
+
+// ExplainedDecision is a load balancing decision made for the request.
+type ExplainedDecision struct {
+	ChannelCount int                        `json:"channelCount"`
+	DurationMs   int64                      `json:"durationMs"`
+	Channels     []ExplainedChannelDecision `json:"channels"`
+}
+
+// ExplainedChannelDecision is the score of one channel in a load balancing decision.
+type ExplainedChannelDecision struct {
+	ChannelID   int                      `json:"channelId"`
+	ChannelName string                   `json:"channelName"`
+	Rank        int                      `json:"rank"`
+	TotalScore  float64                  `json:"totalScore"`
+	Strategies  []ExplainedStrategyScore `json:"strategies"`
+}
+
+// ExplainedStrategyScore is the score given to a channel by one strategy.
+type ExplainedStrategyScore struct {
+	Name  string  `json:"name"`
+	Score float64 `json:"score"`
+}
+
+// Headers returns the explanation as response headers.
+func (e *Explanation) Headers() http.Header {
+	headers := http.Header{}
+
+	if e.RequestID != 0 {
+		headers.Set(ExplainRequestRecordIDHeader, strconv.Itoa(e.RequestID))
+	}
+
+	if e.ChannelID != 0 {
+		headers.Set(ExplainChannelIDHeader, strconv.Itoa(e.ChannelID))
+		// Channel names are free text, escape them to keep the header value valid.
+		headers.Set(ExplainChannelNameHeader, url.PathEscape(e.ChannelName))
+	}
+
+	headers.Set(ExplainAttemptsHeader, strconv.Itoa(e.Attempts))
+
+	if e.CacheHit != nil {
+		headers.Set(ExplainCacheHitHeader, strconv.FormatBool(*e.CacheHit))
+	}
+
+	if e.Cost != nil {
+		headers.Set(ExplainCostHeader, strconv.FormatFloat(*e.Cost, 'f', -1, 64))
+
+		if e.Currency != "" {
+			headers.Set(ExplainCostCurrencyHeader, e.Currency)
+		}
+	}
+
+	if q := e.QuotaRemaining; q != nil {
+		if q.Requests != nil {
+			headers.Set(ExplainQuotaRemainingRequestsHeader, strconv.FormatInt(*q.Requests, 10))
+		}
+
+		if q.TotalTokens != nil {
+			headers.Set(ExplainQuotaRemainingTokensHeader, strconv.FormatInt(*q.TotalTokens, 10))
+		}
+
+		if q.Cost != nil {
+			headers.Set(ExplainQuotaRemainingCostHeader, q.Cost.String())
+		}
+	}
+
+	if len(e.Decisions) > 0 {
+		if b, err := json.Marshal(e.Decisions); err == nil {
+			headers.Set(ExplainDecisionLogHeader, string(b))
+		}
+	}
+
+	return headers
+}
+
+// RequestExplainer builds the explanation of a request from its persistence state.
+type RequestExplainer struct {
+	state        *PersistenceState
+	quotaService *biz.QuotaService
+	recorder     *DecisionRecorder
+
+	showChannel bool
+}
+
+// newRequestExplainer returns an explainer if the request opts in to the explanation, otherwise nil.
+// The scopes are checked against the caller context, so it must be called before the context is system bypassed.
+func newRequestExplainer(ctx context.Context, request *httpclient.Request, quotaService *biz.QuotaService) *RequestExplainer {
+	if request == nil || !explainRequested(request.Headers) {
+		return nil
+	}
+
+	explainer := &RequestExplainer{
+		quotaService: quotaService,
+		showChannel:  authz.HasScope(ctx, scopes.ScopeReadChannels),
+	}
+
+	if authz.HasScope(ctx, scopes.ScopeDebugRequests) {
+		explainer.recorder = &DecisionRecorder{}
+	}
+
+	return explainer
+}
+
+func explainRequested(headers http.Header) bool {
+	if headers == nil {
+		return false
+	}
+
+	v, err := strconv.ParseBool(strings.TrimSpace(headers.Get(ExplainHeader)))
+
+	return err == nil && v
+}
+
+// Routing returns the explanation known once the response starts, without the usage of the response.
+func (e *RequestExplainer) Routing() *Explanation {
+	state := e.state
+	explanation := &Explanation{
+		Attempts: state.Attempts,
+	}
+
+	if state.Request != nil {
+		explanation.RequestID = state.Request.ID
+	}
+
+	if e.showChannel && state.CurrentCandidate != nil && state.CurrentCandidate.Channel != nil {
+		explanation.ChannelID = state.CurrentCandidate.Channel.ID
+		explanation.ChannelName = state.CurrentCandidate.Channel.Name
+	}
+
+	if e.recorder != nil {
+		explanation.Decisions = explainDecisions(e.recorder.Logs())
+	}
+
+	return explanation
+}
+
+// Explain returns the full explanation, it should be called once the response is completed.
+func (e *RequestExplainer) Explain(ctx context.Context) *Explanation {
+	explanation := e.Routing()
+
+	if usageLog := e.state.UsageLog; usageLog != nil {
+		cacheHit := usageLog.PromptCachedTokens > 0
+		explanation.CacheHit = &cacheHit
+		explanation.Cost = usageLog.TotalCost
+		explanation.Currency = usageLog.Currency
+	}
+
+	explanation.QuotaRemaining = e.quotaRemaining(ctx)
+
+	return explanation
+}
+
+func (e *RequestExplainer) quotaRemaining(ctx context.Context) *ExplainedQuota {
+	apiKey := e.state.APIKey
+	if e.quotaService == nil || apiKey == nil {
+		return nil
+	}
+
+	profile := apiKey.GetActiveProfile()
+	if profile == nil || profile.Quota == nil {
+		return nil
+	}
+
+	quota := profile.Quota
+
+	result, err := e.quotaService.GetQuota(authz.WithSystemBypass(ctx, "explain-request-quota"), apiKey.ID, quota)
+	if err != nil {
+		log.Warn(ctx, "failed to get quota of the api key for the explanation", log.Cause(err))
+		return nil
+	}
+
+	remaining := &ExplainedQuota{}
+
+	if quota.Requests != nil {
+		v := max(*quota.Requests-result.Usage.RequestCount, 0)
+		remaining.Requests = &v
+	}
+
+	if quota.TotalTokens != nil {
+		v := max(*quota.TotalTokens-result.Usage.TotalTokens, 0)
+		remaining.TotalTokens = &v
+	}
+
+	if quota.Cost != nil {
+		v := decimal.Max(quota.Cost.Sub(result.Usage.TotalCost), decimal.Zero)
+		remaining.Cost = &v
+	}
+
+	return remaining
+}
+
+// explainDecisions converts the decision logs to the explained form, which only exposes the channel identity and scores.
+func explainDecisions(logs []*DecisionLog) []ExplainedDecision {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	decisions := make([]ExplainedDecision, 0, len(logs))
+
+	for _, l := range logs {
+		decision := ExplainedDecision{
+			ChannelCount: l.ChannelCount,
+			DurationMs:   l.TotalDuration.Milliseconds(),
+			Channels:     make([]ExplainedChannelDecision, 0, len(l.Channels)),
+		}
+
+		for _, c := range l.Channels {
+			channel := ExplainedChannelDecision{
+				Rank:       c.FinalRank,
+				TotalScore: c.TotalScore,
+				Strategies: make([]ExplainedStrategyScore, 0, len(c.StrategyScores)),
+			}
+
+			if c.Channel != nil {
+				channel.ChannelID = c.Channel.ID
+				channel.ChannelName = c.Channel.Name
+			}
+
+			for _, s := range c.StrategyScores {
+				channel.Strategies = append(channel.Strategies, ExplainedStrategyScore{
+					Name:  s.StrategyName,
+					Score: s.Score,
+				})
+			}
+
+			decision.Channels = append(decision.Channels, channel)
+		}
+
+		decisions = append(decisions, decision)
+	}
+
+	return decisions
+}
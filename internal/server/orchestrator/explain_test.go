@@ -0,0 +1,243 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/scopes"
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/llm/httpclient"
+	"github.com/looplj/axonhub/llm/pipeline"
+	"github.com/looplj/axonhub/llm/pipeline/stream"
+	"github.com/looplj/axonhub/llm/transformer/openai"
+)
+
+func explainHeaders(value string) http.Header {
+	headers := http.Header{}
+	headers.Set(ExplainHeader, value)
+
+	return headers
+}
+
+func TestExplainRequested(t *testing.T) {
+	require.False(t, explainRequested(nil))
+	require.False(t, explainRequested(http.Header{}))
+	require.False(t, explainRequested(explainHeaders("false")))
+	require.False(t, explainRequested(explainHeaders("yes")))
+	require.True(t, explainRequested(explainHeaders("true")))
+	require.True(t, explainRequested(explainHeaders(" 1 ")))
+}
+
+func TestNewRequestExplainer_Scopes(t *testing.T) {
+	request := &httpclient.Request{Headers: explainHeaders("true")}
+
+	apiKeyContext := func(keyScopes ...scopes.ScopeSlug) context.Context {
+		ctx, err := authz.WithPrincipal(context.Background(), authz.Principal{Type: authz.PrincipalTypeAPIKey, APIKeyID: lo.ToPtr(1)})
+		require.NoError(t, err)
+
+		return contexts.WithAPIKey(ctx, &ent.APIKey{ID: 1, Scopes: lo.Map(keyScopes, func(s scopes.ScopeSlug, _ int) string { return string(s) })})
+	}
+
+	require.Nil(t, newRequestExplainer(apiKeyContext(scopes.ScopeReadChannels), &httpclient.Request{Headers: http.Header{}}, nil))
+
+	explainer := newRequestExplainer(apiKeyContext(scopes.ScopeWriteRequests), request, nil)
+	require.NotNil(t, explainer)
+	require.False(t, explainer.showChannel)
+	require.Nil(t, explainer.recorder)
+
+	explainer = newRequestExplainer(apiKeyContext(scopes.ScopeReadChannels, scopes.ScopeDebugRequests), request, nil)
+	require.NotNil(t, explainer)
+	require.True(t, explainer.showChannel)
+	require.NotNil(t, explainer.recorder)
+}
+
+func TestExplanation_Headers(t *testing.T) {
+	explanation := &Explanation{
+		RequestID:   42,
+		ChannelID:   7,
+		ChannelName: "openai primary",
+		Attempts:    2,
+		CacheHit:    lo.ToPtr(true),
+		Cost:        lo.ToPtr(0.0125),
+		Currency:    "USD",
+		QuotaRemaining: &ExplainedQuota{
+			Requests: lo.ToPtr(int64(9)),
+			Cost:     lo.ToPtr(decimal.RequireFromString("4.5")),
+		},
+		Decisions: []ExplainedDecision{{
+			ChannelCount: 2,
+			Channels:     []ExplainedChannelDecision{{ChannelID: 7, ChannelName: "openai primary", Rank: 1, TotalScore: 100}},
+		}},
+	}
+
+	headers := explanation.Headers()
+	require.Equal(t, "42", headers.Get(ExplainRequestRecordIDHeader))
+	require.Equal(t, "7", headers.Get(ExplainChannelIDHeader))
+	require.Equal(t, "openai%20primary", headers.Get(ExplainChannelNameHeader))
+	require.Equal(t, "2", headers.Get(ExplainAttemptsHeader))
+	require.Equal(t, "true", headers.Get(ExplainCacheHitHeader))
+	require.Equal(t, "0.0125", headers.Get(ExplainCostHeader))
+	require.Equal(t, "USD", headers.Get(ExplainCostCurrencyHeader))
+	require.Equal(t, "9", headers.Get(ExplainQuotaRemainingRequestsHeader))
+	require.Empty(t, headers.Get(ExplainQuotaRemainingTokensHeader))
+	require.Equal(t, "4.5", headers.Get(ExplainQuotaRemainingCostHeader))
+
+	var decisions []ExplainedDecision
+	require.NoError(t, json.Unmarshal([]byte(headers.Get(ExplainDecisionLogHeader)), &decisions))
+	require.Equal(t, explanation.Decisions, decisions)
+
+	// The channel and the usage are omitted when unknown.
+	headers = (&Explanation{Attempts: 1}).Headers()
+	require.Equal(t, "1", headers.Get(ExplainAttemptsHeader))
+	require.Empty(t, headers.Get(ExplainChannelIDHeader))
+	require.Empty(t, headers.Get(ExplainCostHeader))
+	require.Empty(t, headers.Get(ExplainDecisionLogHeader))
+}
+
+func TestLoadBalancer_Sort_RecordsDecisions(t *testing.T) {
+	strategy := &channelBasedStrategy{name: "test", scores: map[int]float64{1: 10, 2: 50}}
+	lb := newTestLoadBalancer(t, &biz.RetryPolicy{Enabled: true, MaxChannelRetries: 3}, strategy)
+
+	candidates := []*ChannelModelsCandidate{
+		{Channel: &biz.Channel{Channel: &ent.Channel{ID: 1, Name: "ch1"}}},
+		{Channel: &biz.Channel{Channel: &ent.Channel{ID: 2, Name: "ch2"}}},
+	}
+
+	// Without a recorder nothing is recorded.
+	recorder := &DecisionRecorder{}
+	lb.Sort(context.Background(), candidates, "gpt-4", false)
+	require.Empty(t, recorder.Logs())
+
+	result := lb.Sort(WithDecisionRecorder(context.Background(), recorder), candidates, "gpt-4", false)
+	require.Equal(t, 2, result[0].Channel.ID)
+
+	logs := recorder.Logs()
+	require.Len(t, logs, 1)
+	require.Equal(t, 2, logs[0].ChannelCount)
+
+	decisions := explainDecisions(logs)
+	require.Len(t, decisions, 1)
+	require.Equal(t, []ExplainedChannelDecision{
+		{ChannelID: 2, ChannelName: "ch2", Rank: 1, TotalScore: 50, Strategies: []ExplainedStrategyScore{{Name: "test", Score: 50}}},
+		{ChannelID: 1, ChannelName: "ch1", Rank: 2, TotalScore: 10, Strategies: []ExplainedStrategyScore{{Name: "test", Score: 10}}},
+	}, decisions[0].Channels)
+}
+
+func TestChatCompletionOrchestrator_Process_Explain(t *testing.T) {
+	ctx := authz.WithTestBypass(context.Background())
+
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=0")
+	defer client.Close()
+
+	ctx = ent.NewContext(ctx, client)
+
+	project := createTestProject(t, ctx, client)
+	ch := createTestChannel(t, ctx, client)
+	channelService, requestService, systemService, usageLogService := setupTestServices(t, client)
+
+	apiKey, err := client.APIKey.Create().
+		SetName("Explain API Key").
+		SetKey("ah-explain-key").
+		SetProjectID(project.ID).
+		SetProfiles(&objects.APIKeyProfiles{
+			ActiveProfile: "default",
+			Profiles: []objects.APIKeyProfile{
+				{
+					Name: "default",
+					Quota: &objects.APIKeyQuota{
+						Requests:    lo.ToPtr(int64(10)),
+						TotalTokens: lo.ToPtr(int64(100)),
+						Period: objects.APIKeyQuotaPeriod{
+							Type: objects.APIKeyQuotaPeriodTypeAllTime,
+						},
+					},
+				},
+			},
+		}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	executor := &mockExecutor{
+		response: &httpclient.Response{
+			StatusCode: 200,
+			Body:       buildMockOpenAIResponse("chatcmpl-explain", "gpt-4", "Hello!", 10, 20),
+			Headers:    http.Header{"Content-Type": []string{"application/json"}},
+		},
+	}
+
+	outbound, err := openai.NewOutboundTransformer(ch.BaseURL, ch.Credentials.APIKey)
+	require.NoError(t, err)
+
+	orchestrator := &ChatCompletionOrchestrator{
+		channelSelector: &staticChannelSelector{candidates: channelsToTestCandidates([]*biz.Channel{{
+			Channel:  ch,
+			Outbound: outbound,
+		}}, "gpt-4")},
+		Inbound:               openai.NewInboundTransformer(),
+		RequestService:        requestService,
+		ChannelService:        channelService,
+		PromptProvider:        &stubPromptProvider{},
+		SystemService:         systemService,
+		UsageLogService:       usageLogService,
+		QuotaService:          biz.NewQuotaService(client, systemService),
+		PipelineFactory:       pipeline.NewFactory(executor),
+		ModelMapper:           NewModelMapper(),
+		channelLimiterManager: NewChannelLimiterManager(),
+		Middlewares: []pipeline.Middleware{
+			stream.EnsureUsage(),
+		},
+	}
+
+	ctx = contexts.WithProjectID(ctx, project.ID)
+	ctx = contexts.WithAPIKey(ctx, apiKey)
+
+	// The explanation is opt-in.
+	result, err := orchestrator.Process(ctx, buildTestRequest("gpt-4", "Hello!", false))
+	require.NoError(t, err)
+	require.Nil(t, result.Explainer)
+
+	httpRequest := buildTestRequest("gpt-4", "Hello!", false)
+	httpRequest.Headers.Set(ExplainHeader, "true")
+
+	result, err = orchestrator.Process(ctx, httpRequest)
+	require.NoError(t, err)
+	require.NotNil(t, result.Explainer)
+
+	dbRequest, err := client.Request.Query().Order(ent.Desc("id")).First(ctx)
+	require.NoError(t, err)
+
+	explanation := result.Explainer.Explain(ctx)
+	require.Equal(t, dbRequest.ID, explanation.RequestID)
+	require.Equal(t, ch.ID, explanation.ChannelID)
+	require.Equal(t, ch.Name, explanation.ChannelName)
+	require.Equal(t, 1, explanation.Attempts)
+	require.Equal(t, lo.ToPtr(false), explanation.CacheHit)
+	require.NotNil(t, explanation.QuotaRemaining)
+	require.Equal(t, lo.ToPtr(int64(8)), explanation.QuotaRemaining.Requests)
+	require.Equal(t, lo.ToPtr(int64(40)), explanation.QuotaRemaining.TotalTokens)
+	require.Nil(t, explanation.QuotaRemaining.Cost)
+}
+
+func TestDecisionRecorder_Logs(t *testing.T) {
+	recorder := &DecisionRecorder{}
+	recorder.Record(&DecisionLog{ChannelCount: 1, TotalDuration: time.Millisecond})
+
+	logs := recorder.Logs()
+	require.Len(t, logs, 1)
+
+	// The returned logs are a copy.
+	logs[0] = nil
+	require.NotNil(t, recorder.Logs()[0])
+}
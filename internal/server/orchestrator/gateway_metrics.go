@@ -0,0 +1,173 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/server/biz"
+)
+
+// GatewayMetrics exports the routing state of the gateway.
+//
+// Two instruments:
+//   - axonhub_circuit_breaker_state        (observable gauge) — 0 closed, 1 half open, 2 open, per channel and model
+//   - axonhub_provider_quota_remaining     (observable gauge) — remaining ratio of each provider quota limit, per channel
+//
+// Every orchestrator owns a model circuit breaker, so the breakers are tracked in one
+// process-wide GatewayMetrics and the worst state of a channel model is reported.
+type GatewayMetrics struct {
+	mu            sync.Mutex
+	breakers      []*biz.ModelCircuitBreaker
+	quotaStatuses providerQuotaStatusLister
+}
+
+// providerQuotaStatusLister is implemented by the provider quota service.
+type providerQuotaStatusLister interface {
+	QuotaStatuses() map[int]*biz.QuotaChannelStatus
+}
+
+var (
+	sharedGatewayMetricsOnce sync.Once
+	sharedGatewayMetrics     *GatewayMetrics
+)
+
+// getSharedGatewayMetrics returns the process-wide gateway metrics, registering its gauges on first use.
+func getSharedGatewayMetrics(meter metric.Meter) *GatewayMetrics {
+	sharedGatewayMetricsOnce.Do(func() {
+		m, err := NewGatewayMetrics(meter)
+		if err != nil {
+			log.Warn(context.Background(), "failed to register gateway metrics, continuing without them", log.Cause(err))
+
+			m = &GatewayMetrics{}
+		}
+
+		sharedGatewayMetrics = m
+	})
+
+	return sharedGatewayMetrics
+}
+
+// NewGatewayMetrics registers the gateway gauges. Pass a nil meter to obtain a no-op metrics struct.
+func NewGatewayMetrics(meter metric.Meter) (*GatewayMetrics, error) {
+	m := &GatewayMetrics{}
+	if meter == nil {
+		return m, nil
+	}
+
+	breakerState, err := meter.Int64ObservableGauge(
+		"axonhub_circuit_breaker_state",
+		metric.WithDescription("Model circuit breaker state of a channel model: 0 closed, 1 half open, 2 open"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create axonhub_circuit_breaker_state gauge: %w", err)
+	}
+
+	quotaRemaining, err := meter.Float64ObservableGauge(
+		"axonhub_provider_quota_remaining",
+		metric.WithDescription("Remaining ratio of a provider quota limit of a channel, from 0 to 1"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create axonhub_provider_quota_remaining gauge: %w", err)
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, observer metric.Observer) error {
+		for key, state := range m.circuitBreakerStates() {
+			observer.ObserveInt64(breakerState, circuitBreakerStateValue(state), metric.WithAttributes(
+				attribute.Int("channel_id", key.ChannelID),
+				attribute.String("model", key.ModelID),
+			))
+		}
+
+		for channelID, status := range m.providerQuotaStatuses() {
+			for _, limit := range status.Limits {
+				observer.ObserveFloat64(quotaRemaining, min(max(1-limit.UsageRatio, 0), 1), metric.WithAttributes(
+					attribute.Int("channel_id", channelID),
+					attribute.String("limit_type", string(limit.Type)),
+				))
+			}
+		}
+
+		return nil
+	}, breakerState, quotaRemaining)
+	if err != nil {
+		return nil, fmt.Errorf("register gateway gauge callback: %w", err)
+	}
+
+	return m, nil
+}
+
+// TrackCircuitBreaker adds the circuit breaker of an orchestrator to the reported state.
+func (m *GatewayMetrics) TrackCircuitBreaker(breaker *biz.ModelCircuitBreaker) {
+	if m == nil || breaker == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.breakers = append(m.breakers, breaker)
+}
+
+// TrackProviderQuota reports the quota of the provider if it can list the quota of all channels.
+func (m *GatewayMetrics) TrackProviderQuota(provider ProviderQuotaStatusProvider) {
+	if m == nil {
+		return
+	}
+
+	lister, ok := provider.(providerQuotaStatusLister)
+	if !ok {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.quotaStatuses = lister
+}
+
+// circuitBreakerStates merges the states of all tracked breakers, keeping the worst state of each channel model.
+func (m *GatewayMetrics) circuitBreakerStates() map[biz.ChannelModelKey]biz.CircuitBreakerState {
+	m.mu.Lock()
+	breakers := m.breakers
+	m.mu.Unlock()
+
+	merged := make(map[biz.ChannelModelKey]biz.CircuitBreakerState)
+
+	for _, breaker := range breakers {
+		for key, state := range breaker.States() {
+			if existing, ok := merged[key]; !ok || circuitBreakerStateValue(state) > circuitBreakerStateValue(existing) {
+				merged[key] = state
+			}
+		}
+	}
+
+	return merged
+}
+
+func (m *GatewayMetrics) providerQuotaStatuses() map[int]*biz.QuotaChannelStatus {
+	m.mu.Lock()
+	lister := m.quotaStatuses
+	m.mu.Unlock()
+
+	if lister == nil {
+		return nil
+	}
+
+	return lister.QuotaStatuses()
+}
+
+func circuitBreakerStateValue(state biz.CircuitBreakerState) int64 {
+	switch state {
+	case biz.StateHalfOpen:
+		return 1
+	case biz.StateOpen:
+		return 2
+	default:
+		return 0
+	}
+}
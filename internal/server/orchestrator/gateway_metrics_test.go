@@ -0,0 +1,99 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/internal/server/biz/provider_quota"
+)
+
+type stubQuotaStatusLister struct {
+	statuses map[int]*biz.QuotaChannelStatus
+}
+
+func (s *stubQuotaStatusLister) GetQuotaStatus(channelID int) *biz.QuotaChannelStatus {
+	return s.statuses[channelID]
+}
+
+func (s *stubQuotaStatusLister) QuotaStatuses() map[int]*biz.QuotaChannelStatus {
+	return s.statuses
+}
+
+func TestGatewayMetrics_NilMeterIsNoop(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewGatewayMetrics(nil)
+	require.NoError(t, err)
+
+	m.TrackCircuitBreaker(biz.NewModelCircuitBreaker())
+	m.TrackProviderQuota(&stubQuotaStatusLister{})
+}
+
+func TestGatewayMetrics_GaugeCallback(t *testing.T) {
+	t.Parallel()
+
+	reader := metric.NewManualReader()
+	provider := metric.NewMeterProvider(metric.WithReader(reader))
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+	m, err := NewGatewayMetrics(provider.Meter("axonhub-test"))
+	require.NoError(t, err)
+
+	// The same channel model is open in one orchestrator and closed in another.
+	openBreaker := biz.NewModelCircuitBreaker()
+	for range biz.DefaultModelCircuitBreakerPolicy().OpenThreshold {
+		openBreaker.RecordError(t.Context(), 1, "gpt-4o", false)
+	}
+
+	closedBreaker := biz.NewModelCircuitBreaker()
+	closedBreaker.RecordSuccess(t.Context(), 1, "gpt-4o")
+
+	m.TrackCircuitBreaker(closedBreaker)
+	m.TrackCircuitBreaker(openBreaker)
+	m.TrackProviderQuota(&stubQuotaStatusLister{statuses: map[int]*biz.QuotaChannelStatus{
+		2: {Limits: []provider_quota.QuotaLimitStatus{{Type: "tokens", UsageRatio: 0.25}}},
+	}})
+
+	rm := metricdata.ResourceMetrics{}
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	state := findGauge(t, rm, "axonhub_circuit_breaker_state")
+	require.Len(t, state.DataPoints, 1)
+	assert.Equal(t, int64(2), state.DataPoints[0].Value)
+
+	model, ok := state.DataPoints[0].Attributes.Value(attribute.Key("model"))
+	require.True(t, ok)
+	assert.Equal(t, "gpt-4o", model.AsString())
+
+	remaining := findFloatGauge(t, rm, "axonhub_provider_quota_remaining")
+	require.Len(t, remaining.DataPoints, 1)
+	assert.InDelta(t, 0.75, remaining.DataPoints[0].Value, 1e-9)
+}
+
+func findFloatGauge(t *testing.T, rm metricdata.ResourceMetrics, name string) metricdata.Gauge[float64] {
+	t.Helper()
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, mt := range sm.Metrics {
+			if mt.Name != name {
+				continue
+			}
+
+			g, ok := mt.Data.(metricdata.Gauge[float64])
+			require.True(t, ok, "metric %q is not a float64 gauge", name)
+
+			return g
+		}
+	}
+
+	t.Fatalf("gauge %q not present in collected metrics", name)
+	return metricdata.Gauge[float64]{}
+}
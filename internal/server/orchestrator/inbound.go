@@ -6,11 +6,15 @@ import (
 	"errors"
 
 	"github.com/tidwall/gjson"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
 
 	"github.com/looplj/axonhub/internal/dumper"
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/log"
 	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/internal/tracing"
 	"github.com/looplj/axonhub/llm"
 	"github.com/looplj/axonhub/llm/httpclient"
 	"github.com/looplj/axonhub/llm/streams"
@@ -301,11 +305,23 @@ func (p *PersistentInboundTransformer) TransformError(ctx context.Context, rawEr
 }
 
 func (p *PersistentInboundTransformer) TransformRequest(ctx context.Context, request *httpclient.Request) (*llm.Request, error) {
+	ctx, span := tracing.Tracer.Start(ctx, "axonhub.inbound.transform")
+	defer span.End()
+
 	llmRequest, err := p.wrapped.TransformRequest(ctx, request)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
 		return nil, err
 	}
 
+	span.SetAttributes(
+		attribute.String("axonhub.api_format", string(llmRequest.APIFormat)),
+		attribute.String("axonhub.request_type", string(llmRequest.RequestType)),
+		semconv.GenAIRequestModel(llmRequest.Model),
+	)
+
 	llmRequest.RawRequest = request
 	p.state.RawRequest = request
 	p.state.LlmRequest = llmRequest
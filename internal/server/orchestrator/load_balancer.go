@@ -3,6 +3,7 @@ package orchestrator
 import (
 	"context"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -416,6 +417,15 @@ func (lb *LoadBalancer) sortWithDebug(
 	// Log the decision with all details (only top k)
 	lb.logDecision(ctx, candidates, model, selected, topK, time.Since(startTime))
 
+	if recorder := GetDecisionRecorder(ctx); recorder != nil {
+		recorder.Record(&DecisionLog{
+			Timestamp:     startTime,
+			ChannelCount:  len(candidates),
+			TotalDuration: time.Since(startTime),
+			Channels:      slices.Clone(selected),
+		})
+	}
+
 	result := lo.Map(selected, func(decision ChannelDecision, _ int) *ChannelModelsCandidate {
 		// Find the corresponding candidate by channel ID
 		for _, c := range candidates {
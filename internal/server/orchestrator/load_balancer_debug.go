@@ -2,6 +2,7 @@ package orchestrator
 
 import (
 	"context"
+	"sync"
 	"time"
 )
 
@@ -114,3 +115,47 @@ func GetDebugInfo(ctx context.Context) *Debug {
 
 	return nil
 }
+
+type decisionRecorderKey struct{}
+
+// DecisionRecorder collects the load balancing decisions made for a request.
+type DecisionRecorder struct {
+	mu   sync.Mutex
+	logs []*DecisionLog
+}
+
+// Record appends a decision to the recorder.
+func (r *DecisionRecorder) Record(log *DecisionLog) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.logs = append(r.logs, log)
+}
+
+// Logs returns the recorded decisions in the order they were made.
+func (r *DecisionRecorder) Logs() []*DecisionLog {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*DecisionLog(nil), r.logs...)
+}
+
+// WithDecisionRecorder enables debug mode and records the load balancing decisions made with the context into the recorder.
+func WithDecisionRecorder(ctx context.Context, recorder *DecisionRecorder) context.Context {
+	if !IsDebugEnabled(ctx) {
+		opts := DefaultDebugOptions()
+		opts.Enabled = true
+		ctx = EnableDebugMode(ctx, opts)
+	}
+
+	return context.WithValue(ctx, decisionRecorderKey{}, recorder)
+}
+
+// GetDecisionRecorder retrieves the decision recorder from the context if available.
+func GetDecisionRecorder(ctx context.Context) *DecisionRecorder {
+	if recorder, ok := ctx.Value(decisionRecorderKey{}).(*DecisionRecorder); ok {
+		return recorder
+	}
+
+	return nil
+}
@@ -11,13 +11,13 @@ import (
 	"github.com/looplj/axonhub/llm/pipeline"
 )
 
-func checkApiKeyModelAccess(inbound *PersistentInboundTransformer) pipeline.Middleware {
+func checkApiKeyModelAccess(inbound *PersistentInboundTransformer, internalCall bool) pipeline.Middleware {
 	return pipeline.OnLlmRequest("check-api-key-model-access", func(ctx context.Context, llmRequest *llm.Request) (*llm.Request, error) {
 		if llmRequest.Model == "" {
 			return nil, fmt.Errorf("%w: request model is empty", biz.ErrInvalidModel)
 		}
 
-		if inbound.state.APIKey == nil {
+		if inbound.state.APIKey == nil || internalCall {
 			return llmRequest, nil
 		}
 
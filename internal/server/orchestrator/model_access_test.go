@@ -135,7 +135,7 @@ func TestCheckApiKeyModelAccess(t *testing.T) {
 				state: state,
 			}
 
-			middleware := checkApiKeyModelAccess(inbound)
+			middleware := checkApiKeyModelAccess(inbound, false)
 
 			llmRequest := &llm.Request{
 				Model: tt.model,
@@ -199,7 +199,7 @@ func TestAutoReasoningEffortRunsBeforeModelAccess(t *testing.T) {
 	assert.Equal(t, "gpt-5.4", normalized.Model)
 	assert.Equal(t, "xhigh", normalized.ReasoningEffort)
 
-	result, err := checkApiKeyModelAccess(inbound).OnInboundLlmRequest(ctx, normalized)
+	result, err := checkApiKeyModelAccess(inbound, false).OnInboundLlmRequest(ctx, normalized)
 	require.NoError(t, err)
 	require.NotNil(t, result)
 	assert.Equal(t, "gpt-5.4", result.Model)
@@ -0,0 +1,418 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/pkg/xcontext"
+	"github.com/looplj/axonhub/llm"
+	"github.com/looplj/axonhub/llm/httpclient"
+	"github.com/looplj/axonhub/llm/pipeline"
+	"github.com/looplj/axonhub/llm/transformer"
+	"github.com/looplj/axonhub/llm/transformer/openai"
+)
+
+// ErrModerationBlocked is returned when the project moderation guardrail blocks a request or its response.
+var ErrModerationBlocked = errors.New("request blocked by moderation guardrail")
+
+// ModerationGuard checks request and response content with the moderation model configured on the project.
+// The moderation call goes through a dedicated moderation orchestrator, so it is persisted as its own
+// request with its own usage log, which keeps the added latency and cost separate from the guarded request.
+type ModerationGuard struct {
+	orchestrator *ChatCompletionOrchestrator
+}
+
+// newModerationGuard creates a guard sharing the services and load balancers of the given orchestrator.
+func newModerationGuard(processor *ChatCompletionOrchestrator) *ModerationGuard {
+	c := *processor
+	c.Inbound = openai.NewModerationInboundTransformer()
+	c.ModerationGuard = nil
+	c.internalCall = true
+
+	return &ModerationGuard{
+		orchestrator: &c,
+	}
+}
+
+// Moderate runs the moderation model over the given input and evaluates the result against the settings.
+func (g *ModerationGuard) Moderate(
+	ctx context.Context,
+	settings *objects.ProjectModerationSettings,
+	stage objects.ModerationStage,
+	input llm.ModerationInput,
+) (objects.ModerationVerdict, error) {
+	body, err := json.Marshal(openai.ModerationCreateRequest{
+		Model: settings.Model,
+		Input: input,
+	})
+	if err != nil {
+		return objects.ModerationVerdict{}, fmt.Errorf("failed to marshal moderation request: %w", err)
+	}
+
+	processor := g.orchestrator
+	if len(settings.ChannelIDs) > 0 {
+		processor = processor.WithAllowedChannels(settings.ChannelIDs)
+	}
+
+	// The moderation call records its load balancing decisions apart from the guarded request,
+	// so they do not show up in the explanation of the guarded request.
+	if GetDecisionRecorder(ctx) != nil {
+		ctx = WithDecisionRecorder(ctx, &DecisionRecorder{})
+	}
+
+	startTime := time.Now()
+
+	result, err := processor.Process(ctx, &httpclient.Request{
+		Method: http.MethodPost,
+		Headers: http.Header{
+			"Content-Type": []string{"application/json"},
+		},
+		Body: body,
+	})
+	latencyMs := time.Since(startTime).Milliseconds()
+
+	if err != nil {
+		return objects.ModerationVerdict{
+			Stage:     stage,
+			Model:     settings.Model,
+			Action:    settings.Action,
+			LatencyMs: latencyMs,
+			Error:     err.Error(),
+		}, fmt.Errorf("moderation call failed: %w", err)
+	}
+
+	if result.ChatCompletion == nil {
+		return objects.ModerationVerdict{}, errors.New("moderation call returned no response")
+	}
+
+	var resp openai.ModerationCreateResponse
+	if err := json.Unmarshal(result.ChatCompletion.Body, &resp); err != nil {
+		return objects.ModerationVerdict{}, fmt.Errorf("failed to decode moderation response: %w", err)
+	}
+
+	verdict := evaluateModeration(settings, stage, resp)
+	verdict.LatencyMs = latencyMs
+
+	return verdict, nil
+}
+
+// evaluateModeration applies the category thresholds of the settings to the moderation results.
+// Categories with a configured threshold are triggered by score, other categories follow the provider flag.
+func evaluateModeration(
+	settings *objects.ProjectModerationSettings,
+	stage objects.ModerationStage,
+	resp openai.ModerationCreateResponse,
+) objects.ModerationVerdict {
+	verdict := objects.ModerationVerdict{
+		Stage:  stage,
+		Model:  resp.Model,
+		Action: settings.Action,
+	}
+	if verdict.Model == "" {
+		verdict.Model = settings.Model
+	}
+
+	thresholds := make(map[string]float64, len(settings.Thresholds))
+	for _, threshold := range settings.Thresholds {
+		thresholds[threshold.Category] = threshold.Score
+	}
+
+	maxScores := make(map[string]float64)
+	triggered := make(map[string]bool)
+
+	for _, result := range resp.Results {
+		for category, score := range result.CategoryScores {
+			if current, ok := maxScores[category]; !ok || score > current {
+				maxScores[category] = score
+			}
+		}
+
+		for category, flagged := range result.Categories {
+			if _, ok := thresholds[category]; ok {
+				continue
+			}
+
+			if flagged {
+				triggered[category] = true
+			}
+		}
+
+		// Some providers only report the overall flag.
+		if result.Flagged && len(result.Categories) == 0 && len(thresholds) == 0 {
+			verdict.Flagged = true
+		}
+	}
+
+	for category, threshold := range thresholds {
+		if score, ok := maxScores[category]; ok && score >= threshold {
+			triggered[category] = true
+		}
+	}
+
+	for category := range triggered {
+		verdict.Categories = append(verdict.Categories, category)
+	}
+
+	slices.Sort(verdict.Categories)
+
+	for category, score := range maxScores {
+		verdict.Scores = append(verdict.Scores, objects.ModerationCategoryScore{
+			Category: category,
+			Score:    score,
+		})
+	}
+
+	slices.SortFunc(verdict.Scores, func(a, b objects.ModerationCategoryScore) int {
+		return strings.Compare(a.Category, b.Category)
+	})
+
+	verdict.Flagged = verdict.Flagged || len(verdict.Categories) > 0
+	verdict.Blocked = verdict.Flagged && settings.Action == objects.ModerationActionBlock
+
+	return verdict
+}
+
+// projectModerationSettings returns the moderation settings of the API key project if the guardrail applies to the request.
+func projectModerationSettings(state *PersistenceState, llmRequest *llm.Request) *objects.ProjectModerationSettings {
+	if state.APIKey == nil || state.APIKey.Edges.Project == nil {
+		return nil
+	}
+
+	// Only chat requests are guarded, this also keeps the moderation call itself from being moderated.
+	if llmRequest == nil || (llmRequest.RequestType != "" && llmRequest.RequestType != llm.RequestTypeChat) {
+		return nil
+	}
+
+	settings := state.APIKey.Edges.Project.ModerationSettings
+	if settings == nil || !settings.Enabled {
+		return nil
+	}
+
+	return settings
+}
+
+// moderationInputFromRequest collects the content of the current turn, i.e. the messages after the last assistant message.
+func moderationInputFromRequest(llmRequest *llm.Request) llm.ModerationInput {
+	start := 0
+
+	for i, msg := range llmRequest.Messages {
+		if msg.Role == "assistant" {
+			start = i + 1
+		}
+	}
+
+	return moderationInputFromMessages(llmRequest.Messages[start:])
+}
+
+// moderationInputFromResponse collects the assistant content of a non-streaming response.
+func moderationInputFromResponse(llmResp *llm.Response) llm.ModerationInput {
+	messages := make([]llm.Message, 0, len(llmResp.Choices))
+
+	for _, choice := range llmResp.Choices {
+		if choice.Message != nil {
+			messages = append(messages, *choice.Message)
+		}
+	}
+
+	return moderationInputFromMessages(messages)
+}
+
+func moderationInputFromMessages(messages []llm.Message) llm.ModerationInput {
+	var (
+		texts    []string
+		parts    []llm.ModerationMultiModalPart
+		hasImage bool
+	)
+
+	addText := func(text string) {
+		if strings.TrimSpace(text) == "" {
+			return
+		}
+
+		texts = append(texts, text)
+		parts = append(parts, llm.ModerationMultiModalPart{Type: "text", Text: text})
+	}
+
+	for _, msg := range messages {
+		if msg.Content.Content != nil {
+			addText(*msg.Content.Content)
+		}
+
+		for _, part := range msg.Content.MultipleContent {
+			switch part.Type {
+			case "text":
+				if part.Text != nil {
+					addText(*part.Text)
+				}
+			case "image_url":
+				if part.ImageURL != nil && part.ImageURL.URL != "" {
+					hasImage = true
+
+					parts = append(parts, llm.ModerationMultiModalPart{
+						Type:     "image_url",
+						ImageURL: &llm.ModerationImageURLObject{URL: part.ImageURL.URL},
+					})
+				}
+			}
+		}
+	}
+
+	if hasImage {
+		return llm.ModerationInput{Parts: parts}
+	}
+
+	return llm.ModerationInput{StringArray: texts}
+}
+
+func isEmptyModerationInput(input llm.ModerationInput) bool {
+	return len(input.Parts) == 0 && len(input.StringArray) == 0 && input.String == ""
+}
+
+func moderationBlockedError(verdict objects.ModerationVerdict) error {
+	if len(verdict.Categories) == 0 {
+		return fmt.Errorf("%w: %w", transformer.ErrInvalidRequest, ErrModerationBlocked)
+	}
+
+	return fmt.Errorf("%w: %w (categories: %s)", transformer.ErrInvalidRequest, ErrModerationBlocked, strings.Join(verdict.Categories, ", "))
+}
+
+// recordModerationVerdict appends the verdict to the state and persists all verdicts on the request.
+func recordModerationVerdict(ctx context.Context, state *PersistenceState, verdict objects.ModerationVerdict) {
+	state.ModerationVerdicts = append(state.ModerationVerdicts, verdict)
+
+	if state.Request == nil {
+		return
+	}
+
+	persistCtx, cancel := xcontext.DetachWithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	if err := state.RequestService.UpdateRequestModerationVerdicts(persistCtx, state.Request.ID, state.ModerationVerdicts); err != nil {
+		log.Warn(persistCtx, "failed to persist moderation verdicts", log.Cause(err))
+	}
+}
+
+// runModeration runs one moderation stage and decides whether the request may continue.
+func runModeration(
+	ctx context.Context,
+	guard *ModerationGuard,
+	state *PersistenceState,
+	settings *objects.ProjectModerationSettings,
+	stage objects.ModerationStage,
+	input llm.ModerationInput,
+) error {
+	if isEmptyModerationInput(input) {
+		return nil
+	}
+
+	verdict, err := guard.Moderate(ctx, settings, stage, input)
+	if err != nil {
+		if verdict.Error == "" {
+			verdict = objects.ModerationVerdict{
+				Stage:  stage,
+				Model:  settings.Model,
+				Action: settings.Action,
+				Error:  err.Error(),
+			}
+		}
+
+		recordModerationVerdict(ctx, state, verdict)
+
+		if settings.FailOpen {
+			log.Warn(ctx, "moderation check failed, continue as fail open",
+				log.String("stage", string(stage)),
+				log.Cause(err),
+			)
+
+			return nil
+		}
+
+		return err
+	}
+
+	recordModerationVerdict(ctx, state, verdict)
+
+	if verdict.Flagged {
+		log.Info(ctx, "moderation guardrail flagged content",
+			log.String("stage", string(stage)),
+			log.Bool("blocked", verdict.Blocked),
+			log.Any("categories", verdict.Categories),
+		)
+	}
+
+	if verdict.Blocked {
+		return moderationBlockedError(verdict)
+	}
+
+	return nil
+}
+
+// moderateInput checks the request content before it is sent upstream.
+// It runs after the request is persisted so that blocked requests are recorded with their verdicts.
+func moderateInput(inbound *PersistentInboundTransformer, guard *ModerationGuard) pipeline.Middleware {
+	return pipeline.OnLlmRequest("moderate-input", func(ctx context.Context, llmRequest *llm.Request) (*llm.Request, error) {
+		if guard == nil {
+			return llmRequest, nil
+		}
+
+		settings := projectModerationSettings(inbound.state, llmRequest)
+		if settings == nil || !settings.CheckInput {
+			return llmRequest, nil
+		}
+
+		if err := runModeration(ctx, guard, inbound.state, settings, objects.ModerationStageInput, moderationInputFromRequest(llmRequest)); err != nil {
+			return nil, err
+		}
+
+		return llmRequest, nil
+	})
+}
+
+// moderateOutput checks the response content of non-streaming requests.
+// Streaming responses are delivered to the client as they arrive, so they are only guarded on input.
+func moderateOutput(inbound *PersistentInboundTransformer, guard *ModerationGuard) pipeline.Middleware {
+	return &moderateOutputMiddleware{
+		inbound: inbound,
+		guard:   guard,
+	}
+}
+
+type moderateOutputMiddleware struct {
+	pipeline.DummyMiddleware
+
+	inbound *PersistentInboundTransformer
+	guard   *ModerationGuard
+}
+
+func (m *moderateOutputMiddleware) Name() string {
+	return "moderate-output"
+}
+
+func (m *moderateOutputMiddleware) OnOutboundLlmResponse(ctx context.Context, llmResp *llm.Response) (*llm.Response, error) {
+	state := m.inbound.state
+	if m.guard == nil || llmResp == nil {
+		return llmResp, nil
+	}
+
+	settings := projectModerationSettings(state, state.LlmRequest)
+	if settings == nil || !settings.CheckOutput {
+		return llmResp, nil
+	}
+
+	if err := runModeration(ctx, m.guard, state, settings, objects.ModerationStageOutput, moderationInputFromResponse(llmResp)); err != nil {
+		// The upstream call already succeeded, retrying another channel would only burn more cost.
+		state.ModerationBlocked = true
+
+		return nil, err
+	}
+
+	return llmResp, nil
+}
@@ -0,0 +1,221 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/apikey"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/objects"
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/llm"
+	"github.com/looplj/axonhub/llm/httpclient"
+	"github.com/looplj/axonhub/llm/pipeline"
+	"github.com/looplj/axonhub/llm/pipeline/stream"
+	"github.com/looplj/axonhub/llm/streams"
+	"github.com/looplj/axonhub/llm/transformer/openai"
+)
+
+func TestEvaluateModeration(t *testing.T) {
+	resp := openai.ModerationCreateResponse{
+		Model: "omni-moderation-latest",
+		Results: []llm.ModerationClassification{
+			{
+				Flagged:        true,
+				Categories:     map[string]bool{"violence": true, "harassment": false},
+				CategoryScores: map[string]float64{"violence": 0.91, "harassment": 0.42},
+			},
+		},
+	}
+
+	t.Run("provider flags with block action", func(t *testing.T) {
+		settings := &objects.ProjectModerationSettings{Action: objects.ModerationActionBlock}
+
+		verdict := evaluateModeration(settings, objects.ModerationStageInput, resp)
+		assert.Equal(t, "omni-moderation-latest", verdict.Model)
+		assert.Equal(t, []string{"violence"}, verdict.Categories)
+		assert.True(t, verdict.Flagged)
+		assert.True(t, verdict.Blocked)
+		require.Len(t, verdict.Scores, 2)
+		assert.Equal(t, "harassment", verdict.Scores[0].Category)
+	})
+
+	t.Run("thresholds override provider flags", func(t *testing.T) {
+		settings := &objects.ProjectModerationSettings{
+			Action: objects.ModerationActionBlock,
+			Thresholds: []objects.ModerationThreshold{
+				{Category: "violence", Score: 0.95},
+				{Category: "harassment", Score: 0.4},
+			},
+		}
+
+		verdict := evaluateModeration(settings, objects.ModerationStageOutput, resp)
+		assert.Equal(t, []string{"harassment"}, verdict.Categories)
+		assert.True(t, verdict.Blocked)
+	})
+
+	t.Run("flag action does not block", func(t *testing.T) {
+		settings := &objects.ProjectModerationSettings{Action: objects.ModerationActionFlag}
+
+		verdict := evaluateModeration(settings, objects.ModerationStageInput, resp)
+		assert.True(t, verdict.Flagged)
+		assert.False(t, verdict.Blocked)
+	})
+}
+
+func TestModerationInputFromRequest(t *testing.T) {
+	first := "first question"
+	answer := "an answer"
+	second := "second question"
+	caption := "what is this"
+
+	input := moderationInputFromRequest(&llm.Request{
+		Messages: []llm.Message{
+			{Role: "user", Content: llm.MessageContent{Content: &first}},
+			{Role: "assistant", Content: llm.MessageContent{Content: &answer}},
+			{Role: "user", Content: llm.MessageContent{Content: &second}},
+		},
+	})
+	assert.Equal(t, []string{"second question"}, input.StringArray)
+	assert.Empty(t, input.Parts)
+
+	input = moderationInputFromRequest(&llm.Request{
+		Messages: []llm.Message{
+			{Role: "user", Content: llm.MessageContent{MultipleContent: []llm.MessageContentPart{
+				{Type: "text", Text: &caption},
+				{Type: "image_url", ImageURL: &llm.ImageURL{URL: "https://example.com/a.png"}},
+			}}},
+		},
+	})
+	require.Len(t, input.Parts, 2)
+	assert.Equal(t, "image_url", input.Parts[1].Type)
+	assert.Empty(t, input.StringArray)
+}
+
+// moderationRoutingExecutor answers moderation calls with a clean moderation result and other calls with a chat completion.
+type moderationRoutingExecutor struct {
+	moderationCalls atomic.Int64
+	chatCalls       atomic.Int64
+}
+
+func (e *moderationRoutingExecutor) Do(ctx context.Context, request *httpclient.Request) (*httpclient.Response, error) {
+	if strings.Contains(request.URL, "/moderations") {
+		e.moderationCalls.Add(1)
+
+		body, err := json.Marshal(openai.ModerationCreateResponse{
+			ID:      "modr-1",
+			Model:   "omni-moderation-latest",
+			Results: []llm.ModerationClassification{{Flagged: false}},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &httpclient.Response{
+			StatusCode: http.StatusOK,
+			Body:       body,
+			Headers:    http.Header{"Content-Type": []string{"application/json"}},
+			Request:    request,
+		}, nil
+	}
+
+	e.chatCalls.Add(1)
+
+	return &httpclient.Response{
+		StatusCode: http.StatusOK,
+		Body:       buildMockOpenAIResponse("chatcmpl-moderated", "gpt-4", "ok", 10, 20),
+		Headers:    http.Header{"Content-Type": []string{"application/json"}},
+	}, nil
+}
+
+func (e *moderationRoutingExecutor) DoStream(ctx context.Context, request *httpclient.Request) (streams.Stream[*httpclient.StreamEvent], error) {
+	return streams.SliceStream([]*httpclient.StreamEvent{}), nil
+}
+
+func TestChatCompletionOrchestrator_Process_ModerationIgnoresAPIKeyModelRestrictions(t *testing.T) {
+	ctx := authz.WithTestBypass(context.Background())
+
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=0")
+	defer client.Close()
+
+	ctx = ent.NewContext(ctx, client)
+
+	project, err := client.Project.Create().
+		SetName("Moderated Project").
+		SetModerationSettings(&objects.ProjectModerationSettings{
+			Enabled:    true,
+			Model:      "omni-moderation-latest",
+			CheckInput: true,
+			Action:     objects.ModerationActionBlock,
+			FailOpen:   false,
+		}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	ch := createTestChannel(t, ctx, client)
+	channelService, requestService, systemService, usageLogService := setupTestServices(t, client)
+
+	created, err := client.APIKey.Create().
+		SetName("Restricted API Key").
+		SetKey("ah-restricted-moderation-key").
+		SetProjectID(project.ID).
+		SetProfiles(&objects.APIKeyProfiles{
+			ActiveProfile: "default",
+			Profiles: []objects.APIKeyProfile{
+				{
+					Name:     "default",
+					ModelIDs: []string{"gpt-4"},
+				},
+			},
+		}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	apiKey, err := client.APIKey.Query().Where(apikey.IDEQ(created.ID)).WithProject().Only(ctx)
+	require.NoError(t, err)
+
+	outbound, err := openai.NewOutboundTransformer(ch.BaseURL, ch.Credentials.APIKey)
+	require.NoError(t, err)
+
+	executor := &moderationRoutingExecutor{}
+
+	orchestrator := &ChatCompletionOrchestrator{
+		channelSelector: &staticChannelSelector{candidates: channelsToTestCandidates([]*biz.Channel{{
+			Channel:  ch,
+			Outbound: outbound,
+		}}, "gpt-4")},
+		Inbound:               openai.NewInboundTransformer(),
+		RequestService:        requestService,
+		ChannelService:        channelService,
+		PromptProvider:        &stubPromptProvider{},
+		SystemService:         systemService,
+		UsageLogService:       usageLogService,
+		PipelineFactory:       pipeline.NewFactory(executor),
+		ModelMapper:           NewModelMapper(),
+		channelLimiterManager: NewChannelLimiterManager(),
+		Middlewares: []pipeline.Middleware{
+			stream.EnsureUsage(),
+		},
+	}
+	orchestrator.ModerationGuard = newModerationGuard(orchestrator)
+
+	ctx = contexts.WithProjectID(ctx, project.ID)
+	ctx = contexts.WithAPIKey(ctx, apiKey)
+
+	// The moderation model is not in the model list of the API key profile, the guard call must still go through.
+	result, err := orchestrator.Process(ctx, buildTestRequest("gpt-4", "Hello!", false))
+	require.NoError(t, err)
+	require.NotNil(t, result.ChatCompletion)
+	require.EqualValues(t, 1, executor.moderationCalls.Load())
+	require.EqualValues(t, 1, executor.chatCalls.Load())
+}
@@ -28,6 +28,8 @@ func NewChatCompletionOrchestrator(
 	usageLogService *biz.UsageLogService,
 	promptService *biz.PromptService,
 	quotaService *biz.QuotaService,
+	budgetService *biz.BudgetService,
+	walletService *biz.WalletService,
 	promptProtectionRuleService *biz.PromptProtectionRuleService,
 	liveStreamRegistry *biz.LiveStreamRegistry,
 	channelLimiterManager *ChannelLimiterManager,
@@ -45,6 +47,11 @@ func NewChatCompletionOrchestrator(
 
 	// Initialize model circuit breaker
 	modelCircuitBreaker := biz.NewModelCircuitBreaker()
+	modelCircuitBreaker.SetWebhookNotifier(channelService.WebhookNotifier)
+
+	gatewayMetrics := getSharedGatewayMetrics(metrics.Meter)
+	gatewayMetrics.TrackCircuitBreaker(modelCircuitBreaker)
+	gatewayMetrics.TrackProviderQuota(quotaProvider)
 
 	rateLimitStrategy := NewRateLimitAwareStrategy(rateLimitTracker, channelLimiterManager)
 	quotaStrategy := NewQuotaAwareStrategy(quotaProvider, systemService)
@@ -70,13 +77,15 @@ func NewChatCompletionOrchestrator(
 		quotaStrategy,
 	).WithoutWeightTieBreaker().WithRoundRobinHealthFilter(roundRobinHealthFilter)
 
-	return &ChatCompletionOrchestrator{
+	processor := &ChatCompletionOrchestrator{
 		Inbound:            inbound,
 		RequestService:     requestService,
 		ChannelService:     channelService,
 		SystemService:      systemService,
 		UsageLogService:    usageLogService,
 		QuotaService:       quotaService,
+		BudgetService:      budgetService,
+		WalletService:      walletService,
 		LiveStreamRegistry: liveStreamRegistry,
 		PromptProvider:     promptService,
 		PromptProtecter:    promptProtectionRuleService,
@@ -98,6 +107,9 @@ func NewChatCompletionOrchestrator(
 		quotaProvider:              quotaProvider,
 		proxy:                      nil,
 	}
+	processor.ModerationGuard = newModerationGuard(processor)
+
+	return processor
 }
 
 type ChatCompletionOrchestrator struct {
@@ -107,9 +119,12 @@ type ChatCompletionOrchestrator struct {
 	SystemService      *biz.SystemService
 	UsageLogService    *biz.UsageLogService
 	QuotaService       *biz.QuotaService
+	BudgetService      *biz.BudgetService
+	WalletService      *biz.WalletService
 	LiveStreamRegistry *biz.LiveStreamRegistry
 	PromptProvider     PromptProvider
 	PromptProtecter    PromptProtecter
+	ModerationGuard    *ModerationGuard
 	Middlewares        []pipeline.Middleware
 	PipelineFactory    *pipeline.Factory
 	ModelMapper        *ModelMapper
@@ -139,6 +154,10 @@ type ChatCompletionOrchestrator struct {
 	// proxy is the proxy configuration for testing
 	// If set, it will override the channel's default proxy configuration
 	proxy *httpclient.ProxyConfig
+
+	// internalCall marks orchestrators serving AxonHub's own calls, such as the moderation
+	// guardrail, which are not subject to the endpoint and limit policy of the API key.
+	internalCall bool
 }
 
 func (processor *ChatCompletionOrchestrator) WithChannelSelector(selector CandidateSelector) *ChatCompletionOrchestrator {
@@ -165,9 +184,18 @@ func (processor *ChatCompletionOrchestrator) WithProxy(proxy *httpclient.ProxyCo
 type ChatCompletionResult struct {
 	ChatCompletion       *httpclient.Response
 	ChatCompletionStream streams.Stream[*httpclient.StreamEvent]
+
+	// Explainer explains how the request was routed and billed, it is nil unless the request opts in with the AH-Explain header.
+	Explainer *RequestExplainer
 }
 
 func (processor *ChatCompletionOrchestrator) Process(ctx context.Context, request *httpclient.Request) (ChatCompletionResult, error) {
+	// The explanation is checked against the scopes of the caller, before the context is system bypassed.
+	explainer := newRequestExplainer(ctx, request, processor.QuotaService)
+	if explainer != nil && explainer.recorder != nil {
+		ctx = WithDecisionRecorder(ctx, explainer.recorder)
+	}
+
 	// The context is system bypassed to allow the orchestrator to access the system settings.
 	ctx = authz.WithSystemBypass(ctx, "process-chat-completion")
 
@@ -217,6 +245,10 @@ func (processor *ChatCompletionOrchestrator) Process(ctx context.Context, reques
 		CurrentCandidateIndex: 0,
 	}
 
+	if explainer != nil {
+		explainer.state = state
+	}
+
 	var pipelineOpts []pipeline.Option
 
 	// Only apply retry if policy is enabled
@@ -243,13 +275,19 @@ func (processor *ChatCompletionOrchestrator) Process(ctx context.Context, reques
 	middlewares = append(middlewares, processor.Middlewares...)
 
 	inbound, outbound := NewPersistentTransformers(state, processor.Inbound)
+	executionTracer := newExecutionTracer(outbound)
 
 	// Add inbound middlewares (executed after inbound.TransformRequest)
 	middlewares = append(middlewares,
-		enforceQuota(inbound, processor.QuotaService),
+		resolveEndUser(),
+		enforceQuota(inbound, processor.QuotaService, processor.internalCall),
+		enforceEndUserQuota(inbound, processor.QuotaService, processor.internalCall),
 		applyAutoReasoningEffort(processor.SystemService),
-		checkApiKeyModelAccess(inbound),
+		checkApiKeyModelAccess(inbound, processor.internalCall),
+		enforceAPIKeyPolicy(inbound, processor.internalCall),
+		enforceAPIKeyToken(processor.QuotaService, processor.internalCall),
 		applyModelMapping(inbound),
+		enforceBudgets(inbound, processor.BudgetService, processor.internalCall),
 		selectCandidates(inbound, processor.quotaProvider, processor.SystemService),
 		injectPrompts(inbound),
 		protectPrompts(inbound),
@@ -257,7 +295,12 @@ func (processor *ChatCompletionOrchestrator) Process(ctx context.Context, reques
 		// response is saved when pass-through is enabled.
 		applyPassThroughResponse(outbound, processor.SystemService),
 		applyPassThroughStream(outbound, processor.SystemService),
+		// Output moderation is registered before persistRequest so that, in reverse order,
+		// the usage log of the upstream call is recorded before the response is checked.
+		moderateOutput(inbound, processor.ModerationGuard),
 		persistRequest(inbound),
+		moderateInput(inbound, processor.ModerationGuard),
+		reserveWalletCredit(inbound, processor.WalletService, processor.internalCall),
 	)
 
 	// Add outbound middlewares (executed after outbound.TransformRequest)
@@ -280,6 +323,9 @@ func (processor *ChatCompletionOrchestrator) Process(ctx context.Context, reques
 		// to ensure that the request execution is created with the correct request bodys.
 		persistRequestExecution(outbound),
 
+		// Trace the attempt as a GenAI span, after the request execution is created.
+		executionTracer.executionMiddleware(),
+
 		// Forward the events to the live streaming.
 		withLivePreview(state, processor.SystemService, processor.LiveStreamRegistry),
 
@@ -291,6 +337,8 @@ func (processor *ChatCompletionOrchestrator) Process(ctx context.Context, reques
 		withRateLimitAdmission(outbound, processor.rateLimitTracker),
 		// Rate limit tracking middleware for TPM and provider cooldown signals.
 		withRateLimitTracking(outbound, processor.rateLimitTracker),
+		// Trace the upstream call once the attempt is admitted and propagate the trace context to the provider.
+		executionTracer.httpMiddleware(),
 
 		// Response pass-through capture middlewares must be last in the outbound list
 		// so they run first in reverse order (before any other OnOutboundRawResponse/OnOutboundRawStream handlers).
@@ -332,6 +380,13 @@ func (processor *ChatCompletionOrchestrator) Process(ctx context.Context, reques
 			); updateErr != nil {
 				log.Warn(persistCtx, "Failed to update request status from error", log.Cause(updateErr))
 			}
+
+			// Release the credit reserved for the request, it is a no-op if the usage log has already settled it.
+			if state.WalletReserved {
+				if releaseErr := processor.WalletService.Release(persistCtx, request.ID); releaseErr != nil {
+					log.Warn(persistCtx, "Failed to release wallet reservation", log.Cause(releaseErr))
+				}
+			}
 		}
 
 		return ChatCompletionResult{}, err
@@ -342,11 +397,13 @@ func (processor *ChatCompletionOrchestrator) Process(ctx context.Context, reques
 		return ChatCompletionResult{
 			ChatCompletion:       nil,
 			ChatCompletionStream: result.EventStream,
+			Explainer:            explainer,
 		}, nil
 	}
 
 	return ChatCompletionResult{
 		ChatCompletion:       result.Response,
 		ChatCompletionStream: nil,
+		Explainer:            explainer,
 	}, nil
 }
@@ -263,9 +263,11 @@ func (ts *OutboundPersistentStream) persistAggregatedResponse(ctx context.Contex
 
 	// Try to create usage log from aggregated response
 	if usage := meta.Usage; usage != nil {
-		_, err := ts.UsageLogService.CreateUsageLogFromRequest(ctx, ts.request, ts.requestExec, usage)
+		usageLog, err := ts.UsageLogService.CreateUsageLogFromRequest(ctx, ts.request, ts.requestExec, usage)
 		if err != nil {
 			log.Warn(ctx, "Failed to create usage log from request", log.Cause(err))
+		} else {
+			ts.state.UsageLog = usageLog
 		}
 	}
 
@@ -504,6 +506,10 @@ func (p *PersistentOutboundTransformer) GetRequestedModel() string {
 // HasMoreChannels returns true if there are more candidates available for retry.
 // It implements the pipeline.Retryable interface.
 func (p *PersistentOutboundTransformer) HasMoreChannels() bool {
+	if p.state.ModerationBlocked {
+		return false
+	}
+
 	return p.state.CurrentCandidateIndex+1 < len(p.state.ChannelModelsCandidates)
 }
 
@@ -575,6 +581,10 @@ func (p *PersistentOutboundTransformer) CanRetry(err error) bool {
 		return false
 	}
 
+	if errors.Is(err, ErrModerationBlocked) || p.state.ModerationBlocked {
+		return false
+	}
+
 	// Local admission rejection: the same channel cannot make progress until the
 	// local queue/RPM state changes, so bounce immediately to the next channel.
 	if isChannelQueueError(err) || isLocalRPMExhaustedError(err) {
@@ -11,9 +11,9 @@ import (
 	"github.com/looplj/axonhub/llm/pipeline"
 )
 
-func enforceQuota(inbound *PersistentInboundTransformer, quotaService *biz.QuotaService) pipeline.Middleware {
+func enforceQuota(inbound *PersistentInboundTransformer, quotaService *biz.QuotaService, internalCall bool) pipeline.Middleware {
 	return pipeline.OnLlmRequest("enforce-quota", func(ctx context.Context, llmRequest *llm.Request) (*llm.Request, error) {
-		if quotaService == nil {
+		if quotaService == nil || internalCall {
 			return llmRequest, nil
 		}
 
@@ -52,6 +52,7 @@ func enforceQuota(inbound *PersistentInboundTransformer, quotaService *biz.Quota
 			fields = append(fields, log.String("request_id", requestID))
 		}
 		log.Info(ctx, "api key quota exceeded", fields...)
+		quotaService.NotifyAPIKeyQuotaExceeded(ctx, apiKey, result)
 
 		return nil, &llm.ResponseError{
 			StatusCode: http.StatusForbidden,
@@ -69,9 +69,11 @@ func (m *persistRequestMiddleware) OnOutboundLlmResponse(ctx context.Context, ll
 	// Determine usage to log - unified in Response.Usage for all request types.
 	usageToLog := llmResp.Usage
 
-	_, err := state.UsageLogService.CreateUsageLogFromRequest(persistCtx, state.Request, state.RequestExec, usageToLog)
+	usageLog, err := state.UsageLogService.CreateUsageLogFromRequest(persistCtx, state.Request, state.RequestExec, usageToLog)
 	if err != nil {
 		log.Warn(persistCtx, "Failed to create usage log from request", log.Cause(err))
+	} else {
+		state.UsageLog = usageLog
 	}
 
 	return llmResp, nil
@@ -113,6 +113,7 @@ func (m *persistRequestExecutionMiddleware) OnOutboundRawRequest(ctx context.Con
 	}
 
 	state.RequestExec = requestExec
+	state.Attempts++
 
 	return request, nil
 }
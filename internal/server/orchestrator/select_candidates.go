@@ -5,11 +5,17 @@ import (
 	"fmt"
 
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/looplj/axonhub/internal/ent/providerquotastatus"
 	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/objects"
 	"github.com/looplj/axonhub/internal/server/biz"
 	"github.com/looplj/axonhub/internal/server/biz/provider_quota"
+	"github.com/looplj/axonhub/internal/tracing"
 	"github.com/looplj/axonhub/llm"
 	"github.com/looplj/axonhub/llm/pipeline"
 )
@@ -52,6 +58,11 @@ func selectCandidates(inbound *PersistentInboundTransformer, quotaProvider Provi
 			}
 		}
 
+		// Exhausted budgets restricting the channels
+		for _, tags := range inbound.state.BudgetChannelTags {
+			selector = WithChannelTagsFilterSelector(selector, tags, objects.ChannelTagsMatchModeAny)
+		}
+
 		// Apply Google native tools filter (only for Gemini native API format)
 		if llmRequest.APIFormat == llm.APIFormatGeminiContents {
 			selector = WithGoogleNativeToolsSelector(selector)
@@ -76,7 +87,7 @@ func selectCandidates(inbound *PersistentInboundTransformer, quotaProvider Provi
 			)
 		}
 
-		candidates, err := selector.Select(ctx, llmRequest)
+		candidates, err := traceSelectCandidates(ctx, selector, llmRequest)
 		if err != nil {
 			return nil, err
 		}
@@ -148,3 +159,23 @@ func areAllChannelsExhausted(candidates []*ChannelModelsCandidate, quotaProvider
 
 	return true
 }
+
+// traceSelectCandidates selects the candidates in an axonhub.select_candidates span.
+func traceSelectCandidates(ctx context.Context, selector CandidateSelector, llmRequest *llm.Request) ([]*ChannelModelsCandidate, error) {
+	ctx, span := tracing.Tracer.Start(ctx, "axonhub.select_candidates",
+		trace.WithAttributes(semconv.GenAIRequestModel(llmRequest.Model)),
+	)
+	defer span.End()
+
+	candidates, err := selector.Select(ctx, llmRequest)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("axonhub.candidate_count", len(candidates)))
+
+	return candidates, nil
+}
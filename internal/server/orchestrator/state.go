@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/objects"
 	"github.com/looplj/axonhub/internal/server/biz"
 	"github.com/looplj/axonhub/llm"
 	"github.com/looplj/axonhub/llm/httpclient"
@@ -81,4 +82,24 @@ type PersistenceState struct {
 
 	// PassThroughApplied records whether the inbound request body was substituted during pass-through.
 	PassThroughApplied bool
+
+	// ModerationVerdicts are the moderation guardrail verdicts of the request.
+	ModerationVerdicts []objects.ModerationVerdict
+
+	// ModerationBlocked records whether the response was blocked by the moderation guardrail,
+	// in which case the request must not be retried on other channels.
+	ModerationBlocked bool
+
+	// BudgetChannelTags are the channel tags of the exhausted restrict_tags budgets of the request,
+	// the candidates must match each of them.
+	BudgetChannelTags [][]string
+
+	// WalletReserved tracks whether the estimated cost of the request is reserved from the wallet of the API key.
+	WalletReserved bool
+
+	// Attempts is the number of upstream attempts made for the request, including retries.
+	Attempts int
+
+	// UsageLog is the usage log recorded for the response, nil until the usage is known.
+	UsageLog *ent.UsageLog
 }
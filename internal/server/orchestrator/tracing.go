@@ -0,0 +1,397 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/looplj/axonhub/internal/pkg/xerrors"
+	"github.com/looplj/axonhub/internal/tracing"
+	"github.com/looplj/axonhub/llm"
+	"github.com/looplj/axonhub/llm/httpclient"
+	"github.com/looplj/axonhub/llm/pipeline"
+	"github.com/looplj/axonhub/llm/streams"
+)
+
+// executionTracer traces the attempts of a request following the OpenTelemetry GenAI semantic conventions.
+//
+// Every attempt, which is persisted as a request execution, is traced as a "{operation} {model}" client span
+// carrying the gen_ai.* attributes, with a child HTTP client span for the upstream call whose context is
+// propagated to the provider in the traceparent header.
+type executionTracer struct {
+	outbound *PersistentOutboundTransformer
+
+	attemptSpan trace.Span
+	httpSpan    trace.Span
+
+	// attempt is the sequence number of the current attempt span.
+	attempt int
+}
+
+func newExecutionTracer(outbound *PersistentOutboundTransformer) *executionTracer {
+	return &executionTracer{outbound: outbound}
+}
+
+// executionMiddleware starts the attempt span. It must run after persistRequestExecution,
+// so that the span carries the request execution ID.
+func (t *executionTracer) executionMiddleware() pipeline.Middleware {
+	return &traceExecution{tracer: t}
+}
+
+// httpMiddleware starts the upstream HTTP span. It must run after the admission middlewares,
+// so that the span does not include the time queued in the channel limiter.
+func (t *executionTracer) httpMiddleware() pipeline.Middleware {
+	return &traceOutboundHTTP{tracer: t}
+}
+
+func (t *executionTracer) startAttempt(ctx context.Context) {
+	// A stream which was never closed leaves the span of the previous attempt open.
+	t.endAttempt(nil, nil)
+
+	state := t.outbound.state
+	model := t.outbound.GetCurrentModelID()
+	operation := genAIOperationName(state.LlmRequest)
+
+	attrs := []attribute.KeyValue{
+		semconv.GenAIOperationNameKey.String(operation),
+		semconv.GenAIRequestModel(model),
+		attribute.String("axonhub.requested_model", t.outbound.GetRequestedModel()),
+	}
+
+	if channel := t.outbound.GetCurrentChannel(); channel != nil {
+		attrs = append(attrs,
+			semconv.GenAIProviderNameKey.String(string(channel.Type)),
+			attribute.Int("axonhub.channel.id", channel.ID),
+			attribute.String("axonhub.channel.name", channel.Name),
+		)
+	}
+
+	if state.Request != nil {
+		attrs = append(attrs, attribute.Int("axonhub.request.id", state.Request.ID))
+	}
+
+	if state.RequestExec != nil {
+		attrs = append(attrs, attribute.Int("axonhub.execution.id", state.RequestExec.ID))
+	}
+
+	if req := state.LlmRequest; req != nil {
+		if req.MaxCompletionTokens != nil {
+			attrs = append(attrs, semconv.GenAIRequestMaxTokens(int(*req.MaxCompletionTokens)))
+		} else if req.MaxTokens != nil {
+			attrs = append(attrs, semconv.GenAIRequestMaxTokens(int(*req.MaxTokens)))
+		}
+
+		if req.Temperature != nil {
+			attrs = append(attrs, semconv.GenAIRequestTemperature(*req.Temperature))
+		}
+
+		if req.TopP != nil {
+			attrs = append(attrs, semconv.GenAIRequestTopP(*req.TopP))
+		}
+	}
+
+	t.attempt++
+
+	_, t.attemptSpan = tracing.Tracer.Start(ctx, operation+" "+model,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// endAttempt ends the attempt span with the final response, or the error of the attempt.
+func (t *executionTracer) endAttempt(response *llm.Response, err error) {
+	if t.attemptSpan == nil {
+		return
+	}
+
+	span := t.attemptSpan
+	t.attemptSpan = nil
+
+	if response != nil {
+		span.SetAttributes(genAIResponseAttributes(response)...)
+	}
+
+	if err != nil {
+		recordSpanError(span, err)
+	}
+
+	span.End()
+}
+
+func (t *executionTracer) startHTTP(ctx context.Context, request *httpclient.Request) {
+	t.endHTTP(0, nil)
+
+	if t.attemptSpan != nil {
+		ctx = trace.ContextWithSpan(ctx, t.attemptSpan)
+	}
+
+	attrs := []attribute.KeyValue{
+		semconv.HTTPRequestMethodKey.String(request.Method),
+	}
+
+	if u, err := url.Parse(request.URL); err == nil {
+		// The query is dropped as some providers take the API key from it.
+		attrs = append(attrs, semconv.URLFull(u.Scheme+"://"+u.Host+u.Path))
+		attrs = append(attrs, semconv.ServerAddress(u.Hostname()))
+
+		if port, err := strconv.Atoi(u.Port()); err == nil {
+			attrs = append(attrs, semconv.ServerPort(port))
+		}
+	}
+
+	ctx, t.httpSpan = tracing.Tracer.Start(ctx, request.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+
+	if request.Headers == nil {
+		request.Headers = make(map[string][]string)
+	}
+
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(request.Headers))
+}
+
+func (t *executionTracer) endHTTP(statusCode int, err error) {
+	if t.httpSpan == nil {
+		return
+	}
+
+	span := t.httpSpan
+	t.httpSpan = nil
+
+	if statusCode > 0 {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(statusCode))
+	}
+
+	if err != nil {
+		recordSpanError(span, err)
+	}
+
+	span.End()
+}
+
+type traceExecution struct {
+	pipeline.DummyMiddleware
+
+	tracer *executionTracer
+}
+
+func (m *traceExecution) Name() string {
+	return "trace-execution"
+}
+
+func (m *traceExecution) OnOutboundRawRequest(ctx context.Context, request *httpclient.Request) (*httpclient.Request, error) {
+	m.tracer.startAttempt(ctx)
+	return request, nil
+}
+
+func (m *traceExecution) OnOutboundRawError(ctx context.Context, err error) {
+	m.tracer.endAttempt(nil, err)
+}
+
+func (m *traceExecution) OnOutboundLlmResponse(ctx context.Context, response *llm.Response) (*llm.Response, error) {
+	m.tracer.endAttempt(response, nil)
+	return response, nil
+}
+
+func (m *traceExecution) OnOutboundLlmStream(ctx context.Context, stream streams.Stream[*llm.Response]) (streams.Stream[*llm.Response], error) {
+	if m.tracer.attemptSpan == nil {
+		return stream, nil
+	}
+
+	return &traceExecutionStream{
+		stream:  stream,
+		tracer:  m.tracer,
+		span:    m.tracer.attemptSpan,
+		attempt: m.tracer.attempt,
+	}, nil
+}
+
+type traceOutboundHTTP struct {
+	pipeline.DummyMiddleware
+
+	tracer *executionTracer
+}
+
+func (m *traceOutboundHTTP) Name() string {
+	return "trace-outbound-http"
+}
+
+func (m *traceOutboundHTTP) OnOutboundRawRequest(ctx context.Context, request *httpclient.Request) (*httpclient.Request, error) {
+	m.tracer.startHTTP(ctx, request)
+	return request, nil
+}
+
+func (m *traceOutboundHTTP) OnOutboundRawResponse(ctx context.Context, response *httpclient.Response) (*httpclient.Response, error) {
+	m.tracer.endHTTP(response.StatusCode, nil)
+	return response, nil
+}
+
+func (m *traceOutboundHTTP) OnOutboundRawStream(ctx context.Context, stream streams.Stream[*httpclient.StreamEvent]) (streams.Stream[*httpclient.StreamEvent], error) {
+	m.tracer.endHTTP(0, nil)
+	return stream, nil
+}
+
+func (m *traceOutboundHTTP) OnOutboundRawError(ctx context.Context, err error) {
+	var statusCode int
+	if httpErr, ok := xerrors.As[*httpclient.Error](err); ok {
+		statusCode = httpErr.StatusCode
+	}
+
+	m.tracer.endHTTP(statusCode, err)
+}
+
+// traceExecutionStream keeps the attempt span open until the stream is closed,
+// recording the first token, the finish reasons and the usage of the stream.
+type traceExecutionStream struct {
+	stream  streams.Stream[*llm.Response]
+	tracer  *executionTracer
+	span    trace.Span
+	attempt int
+
+	firstTokenSeen bool
+	last           llm.Response
+	finishReasons  []string
+}
+
+func (s *traceExecutionStream) Next() bool {
+	return s.stream.Next()
+}
+
+func (s *traceExecutionStream) Current() *llm.Response {
+	event := s.stream.Current()
+	if event == nil {
+		return event
+	}
+
+	if !s.firstTokenSeen {
+		s.firstTokenSeen = true
+		s.span.AddEvent("gen_ai.first_token")
+	}
+
+	if event.ID != "" {
+		s.last.ID = event.ID
+	}
+
+	if event.Model != "" {
+		s.last.Model = event.Model
+	}
+
+	if event.Usage != nil {
+		s.last.Usage = event.Usage
+	}
+
+	for _, choice := range event.Choices {
+		if choice.FinishReason != nil && *choice.FinishReason != "" {
+			s.finishReasons = append(s.finishReasons, *choice.FinishReason)
+		}
+	}
+
+	return event
+}
+
+func (s *traceExecutionStream) Err() error {
+	return s.stream.Err()
+}
+
+func (s *traceExecutionStream) Close() error {
+	// The tracer may have moved on to another attempt, so the span of this stream is ended directly.
+	if s.tracer.attempt == s.attempt {
+		s.tracer.attemptSpan = nil
+	}
+
+	s.span.SetAttributes(genAIResponseAttributes(&s.last)...)
+
+	if len(s.finishReasons) > 0 {
+		s.span.SetAttributes(semconv.GenAIResponseFinishReasons(s.finishReasons...))
+	}
+
+	if err := s.stream.Err(); err != nil {
+		recordSpanError(s.span, err)
+	}
+
+	s.span.End()
+
+	return s.stream.Close()
+}
+
+func genAIOperationName(req *llm.Request) string {
+	if req == nil {
+		return "chat"
+	}
+
+	switch req.RequestType {
+	case "", llm.RequestTypeChat, llm.RequestTypeCompact:
+		return "chat"
+	case llm.RequestTypeEmbedding:
+		return "embeddings"
+	case llm.RequestTypeCompletion:
+		return "text_completion"
+	default:
+		return string(req.RequestType)
+	}
+}
+
+func genAIResponseAttributes(response *llm.Response) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+
+	if response.ID != "" {
+		attrs = append(attrs, semconv.GenAIResponseID(response.ID))
+	}
+
+	if response.Model != "" {
+		attrs = append(attrs, semconv.GenAIResponseModel(response.Model))
+	}
+
+	var finishReasons []string
+
+	for _, choice := range response.Choices {
+		if choice.FinishReason != nil && *choice.FinishReason != "" {
+			finishReasons = append(finishReasons, *choice.FinishReason)
+		}
+	}
+
+	if len(finishReasons) > 0 {
+		attrs = append(attrs, semconv.GenAIResponseFinishReasons(finishReasons...))
+	}
+
+	if usage := response.Usage; usage != nil {
+		attrs = append(attrs,
+			semconv.GenAIUsageInputTokens(int(usage.PromptTokens)),
+			semconv.GenAIUsageOutputTokens(int(usage.CompletionTokens)),
+		)
+
+		if usage.PromptTokensDetails != nil && usage.PromptTokensDetails.CachedTokens > 0 {
+			attrs = append(attrs, semconv.GenAIUsageCacheReadInputTokens(int(usage.PromptTokensDetails.CachedTokens)))
+		}
+	}
+
+	return attrs
+}
+
+func recordSpanError(span trace.Span, err error) {
+	errorType := "_OTHER"
+
+	if httpErr, ok := xerrors.As[*httpclient.Error](err); ok {
+		errorType = strconv.Itoa(httpErr.StatusCode)
+	} else if errors.Is(err, context.Canceled) {
+		errorType = "canceled"
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		errorType = "timeout"
+	} else if xerrors.As0[net.Error](err) {
+		errorType = "network"
+	}
+
+	span.SetAttributes(semconv.ErrorTypeKey.String(errorType))
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,188 @@
+package orchestrator
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/looplj/axonhub/internal/authz"
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/ent"
+	"github.com/looplj/axonhub/internal/ent/enttest"
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/internal/tracing"
+	"github.com/looplj/axonhub/llm/httpclient"
+	"github.com/looplj/axonhub/llm/pipeline"
+	"github.com/looplj/axonhub/llm/pipeline/stream"
+	"github.com/looplj/axonhub/llm/transformer/openai"
+)
+
+func TestChatCompletionOrchestrator_Process_Tracing(t *testing.T) {
+	recorder := useTracingRecorder(t)
+
+	executor := &mockExecutor{
+		response: &httpclient.Response{
+			StatusCode: 200,
+			Body:       buildMockOpenAIResponse("chatcmpl-trace-1", "gpt-4", "ok", 10, 20),
+			Headers:    http.Header{"Content-Type": []string{"application/json"}},
+		},
+	}
+
+	ctx, orchestrator, ch := newTracingTestOrchestrator(t, executor)
+
+	// The server span of the HTTP request.
+	ctx, serverSpan := tracing.Tracer.Start(ctx, "POST /v1/chat/completions")
+
+	_, err := orchestrator.Process(ctx, buildTestRequest("gpt-4", "Hello!", false))
+	require.NoError(t, err)
+	serverSpan.End()
+
+	spans := endedSpansByName(recorder)
+	require.Contains(t, spans, "axonhub.inbound.transform")
+	require.Contains(t, spans, "axonhub.select_candidates")
+	require.Contains(t, spans, "chat gpt-4")
+	require.Contains(t, spans, "POST")
+
+	attempt := spans["chat gpt-4"]
+	require.Equal(t, serverSpan.SpanContext().SpanID(), attempt.Parent().SpanID())
+
+	attrs := attribute.NewSet(attempt.Attributes()...)
+	requireSpanAttribute(t, attrs, "gen_ai.operation.name", attribute.StringValue("chat"))
+	requireSpanAttribute(t, attrs, "gen_ai.request.model", attribute.StringValue("gpt-4"))
+	requireSpanAttribute(t, attrs, "gen_ai.provider.name", attribute.StringValue(string(ch.Type)))
+	requireSpanAttribute(t, attrs, "gen_ai.response.id", attribute.StringValue("chatcmpl-trace-1"))
+	requireSpanAttribute(t, attrs, "gen_ai.response.finish_reasons", attribute.StringSliceValue([]string{"stop"}))
+	requireSpanAttribute(t, attrs, "gen_ai.usage.input_tokens", attribute.Int64Value(10))
+	requireSpanAttribute(t, attrs, "gen_ai.usage.output_tokens", attribute.Int64Value(20))
+
+	// The upstream call is a child of the attempt, and its context is sent to the provider.
+	httpSpan := spans["POST"]
+	require.Equal(t, attempt.SpanContext().SpanID(), httpSpan.Parent().SpanID())
+	requireSpanAttribute(t, attribute.NewSet(httpSpan.Attributes()...), "http.response.status_code", attribute.Int64Value(200))
+
+	require.NotNil(t, executor.lastRequest)
+	require.Equal(t,
+		"00-"+httpSpan.SpanContext().TraceID().String()+"-"+httpSpan.SpanContext().SpanID().String()+"-01",
+		executor.lastRequest.Headers.Get("traceparent"),
+	)
+}
+
+func TestChatCompletionOrchestrator_Process_TracingStream(t *testing.T) {
+	recorder := useTracingRecorder(t)
+
+	executor := &mockExecutor{
+		streamEvents: []*httpclient.StreamEvent{
+			{Data: []byte(`{"id":"chatcmpl-trace-stream","object":"chat.completion.chunk","created":1677652288,"model":"gpt-4","choices":[{"index":0,"delta":{"role":"assistant","content":"Hi"}}]}`)},
+			{Data: []byte(`{"id":"chatcmpl-trace-stream","object":"chat.completion.chunk","created":1677652288,"model":"gpt-4","choices":[{"index":0,"delta":{},"finish_reason":"length"}],"usage":{"prompt_tokens":11,"completion_tokens":13,"total_tokens":24}}`)},
+			{Data: []byte(`[DONE]`)},
+		},
+	}
+
+	ctx, orchestrator, _ := newTracingTestOrchestrator(t, executor)
+
+	result, err := orchestrator.Process(ctx, buildTestRequest("gpt-4", "Hello!", true))
+	require.NoError(t, err)
+	require.NotNil(t, result.ChatCompletionStream)
+
+	// The attempt lasts until the stream is consumed.
+	require.NotContains(t, endedSpansByName(recorder), "chat gpt-4")
+
+	for result.ChatCompletionStream.Next() {
+		_ = result.ChatCompletionStream.Current()
+	}
+
+	require.NoError(t, result.ChatCompletionStream.Close())
+
+	attempt := endedSpansByName(recorder)["chat gpt-4"]
+	require.NotNil(t, attempt)
+
+	attrs := attribute.NewSet(attempt.Attributes()...)
+	requireSpanAttribute(t, attrs, "gen_ai.response.finish_reasons", attribute.StringSliceValue([]string{"length"}))
+	requireSpanAttribute(t, attrs, "gen_ai.usage.input_tokens", attribute.Int64Value(11))
+	requireSpanAttribute(t, attrs, "gen_ai.usage.output_tokens", attribute.Int64Value(13))
+
+	require.NotEmpty(t, attempt.Events())
+	require.Equal(t, "gen_ai.first_token", attempt.Events()[0].Name)
+}
+
+// useTracingRecorder records the spans of the test and propagates the W3C trace context.
+func useTracingRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	originalTracer, originalPropagator := tracing.Tracer, otel.GetTextMapPropagator()
+	tracing.Tracer = provider.Tracer("axonhub-test")
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Cleanup(func() {
+		tracing.Tracer = originalTracer
+		otel.SetTextMapPropagator(originalPropagator)
+	})
+
+	return recorder
+}
+
+func newTracingTestOrchestrator(t *testing.T, executor *mockExecutor) (context.Context, *ChatCompletionOrchestrator, *ent.Channel) {
+	t.Helper()
+
+	ctx := authz.WithTestBypass(context.Background())
+
+	client := enttest.NewEntClient(t, "sqlite3", "file:ent?mode=memory&_fk=0")
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx = ent.NewContext(ctx, client)
+
+	project := createTestProject(t, ctx, client)
+	ch := createTestChannel(t, ctx, client)
+	channelService, requestService, systemService, usageLogService := setupTestServices(t, client)
+
+	outbound, err := openai.NewOutboundTransformer(ch.BaseURL, ch.Credentials.APIKey)
+	require.NoError(t, err)
+
+	orchestrator := &ChatCompletionOrchestrator{
+		channelSelector: &staticChannelSelector{candidates: channelsToTestCandidates([]*biz.Channel{{
+			Channel:  ch,
+			Outbound: outbound,
+		}}, "gpt-4")},
+		Inbound:               openai.NewInboundTransformer(),
+		RequestService:        requestService,
+		ChannelService:        channelService,
+		PromptProvider:        &stubPromptProvider{},
+		SystemService:         systemService,
+		UsageLogService:       usageLogService,
+		PipelineFactory:       pipeline.NewFactory(executor),
+		ModelMapper:           NewModelMapper(),
+		channelLimiterManager: NewChannelLimiterManager(),
+		Middlewares: []pipeline.Middleware{
+			stream.EnsureUsage(),
+		},
+	}
+
+	return contexts.WithProjectID(ctx, project.ID), orchestrator, ch
+}
+
+func endedSpansByName(recorder *tracetest.SpanRecorder) map[string]sdktrace.ReadOnlySpan {
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+
+	return spans
+}
+
+func requireSpanAttribute(t *testing.T, attrs attribute.Set, key string, want attribute.Value) {
+	t.Helper()
+
+	got, ok := attrs.Value(attribute.Key(key))
+	require.True(t, ok, "attribute %q is missing", key)
+	require.Equal(t, want, got, "attribute %q", key)
+}
@@ -0,0 +1,89 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/looplj/axonhub/internal/contexts"
+	"github.com/looplj/axonhub/internal/log"
+	"github.com/looplj/axonhub/internal/server/biz"
+	"github.com/looplj/axonhub/llm"
+	"github.com/looplj/axonhub/llm/pipeline"
+)
+
+// defaultWalletCompletionTokens is the completion tokens of the reservation when the request sets no max tokens.
+const defaultWalletCompletionTokens = 1024
+
+// reserveWalletCredit reserves the estimated cost of the request from the wallet of the API key before the upstream call.
+// It runs after persistRequest, the reservation is keyed by the request and settled when its usage log is created.
+func reserveWalletCredit(inbound *PersistentInboundTransformer, walletService *biz.WalletService, internalCall bool) pipeline.Middleware {
+	return pipeline.OnLlmRequest("reserve-wallet-credit", func(ctx context.Context, llmRequest *llm.Request) (*llm.Request, error) {
+		state := inbound.state
+		if walletService == nil || internalCall || state.APIKey == nil || state.Request == nil || state.WalletReserved {
+			return llmRequest, nil
+		}
+
+		amount := estimateWalletCost(ctx, walletService, state.ChannelModelsCandidates, llmRequest)
+
+		reservation, err := walletService.Reserve(ctx, state.APIKey.ID, state.Request.ID, amount)
+		if err != nil {
+			if errors.Is(err, biz.ErrInsufficientBalance) {
+				return nil, insufficientBalanceError(ctx, state.APIKey.ID)
+			}
+
+			return nil, err
+		}
+
+		state.WalletReserved = reservation != nil
+
+		return llmRequest, nil
+	})
+}
+
+// estimateWalletCost estimates the cost of the request with the most expensive model of the candidates,
+// so the reservation covers the request whichever candidate serves it.
+func estimateWalletCost(ctx context.Context, walletService *biz.WalletService, candidates []*ChannelModelsCandidate, llmRequest *llm.Request) float64 {
+	completionTokens := int64(defaultWalletCompletionTokens)
+
+	switch {
+	case llmRequest.MaxCompletionTokens != nil && *llmRequest.MaxCompletionTokens > 0:
+		completionTokens = *llmRequest.MaxCompletionTokens
+	case llmRequest.MaxTokens != nil && *llmRequest.MaxTokens > 0:
+		completionTokens = *llmRequest.MaxTokens
+	}
+
+	promptTokens := estimatePromptTokens(llmRequest)
+	usage := &llm.Usage{
+		PromptTokens:     promptTokens,
+		CompletionTokens: completionTokens,
+		TotalTokens:      promptTokens + completionTokens,
+	}
+
+	var cost float64
+
+	for _, candidate := range candidates {
+		for _, model := range candidate.Models {
+			cost = max(cost, walletService.EstimateCost(ctx, candidate.Channel, model.ActualModel, usage))
+		}
+	}
+
+	return cost
+}
+
+func insufficientBalanceError(ctx context.Context, apiKeyID int) error {
+	requestID, _ := contexts.GetRequestID(ctx)
+	log.Info(ctx, "insufficient wallet balance",
+		log.Int("api_key_id", apiKeyID),
+		log.String("request_id", requestID))
+
+	return &llm.ResponseError{
+		StatusCode: http.StatusPaymentRequired,
+		Detail: llm.ErrorDetail{
+			Code:      "insufficient_balance",
+			Message:   "insufficient wallet balance",
+			Type:      "quota_exceeded_error",
+			RequestID: requestID,
+		},
+	}
+}
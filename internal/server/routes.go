@@ -7,6 +7,7 @@ import (
 
 	"github.com/looplj/axonhub/internal/ent"
 	"github.com/looplj/axonhub/internal/ent/request"
+	"github.com/looplj/axonhub/internal/metrics"
 	"github.com/looplj/axonhub/internal/server/api"
 	"github.com/looplj/axonhub/internal/server/biz"
 	"github.com/looplj/axonhub/internal/server/gql"
@@ -28,6 +29,7 @@ type Handlers struct {
 	Playground     *api.PlaygroundHandlers
 	System         *api.SystemHandlers
 	Auth           *api.AuthHandlers
+	APIKey         *api.APIKeyHandlers
 	Invitation     *api.InvitationHandlers
 	Jina           *api.JinaHandlers
 	Codex          *api.CodexHandlers
@@ -36,16 +38,22 @@ type Handlers struct {
 	Copilot        *api.CopilotHandlers
 	RequestContent *api.RequestContentHandlers
 	OIDC           *api.OIDCHandlers
+	SAML           *api.SAMLHandlers
+	SCIM           *api.SCIMHandlers
 	RequestPreview *api.RequestPreviewHandlers
+	Invoice        *api.InvoiceHandlers
+	DataExport     *api.DataExportHandlers
 }
 
 type Services struct {
 	fx.In
 
-	TraceService  *biz.TraceService
-	ThreadService *biz.ThreadService
-	AuthService   *biz.AuthService
-	SystemService *biz.SystemService
+	TraceService    *biz.TraceService
+	ThreadService   *biz.ThreadService
+	AuthService     *biz.AuthService
+	SystemService   *biz.SystemService
+	AuditLogService *biz.AuditLogService
+	SCIMService     *biz.SCIMService
 }
 
 func SetupRoutes(server *Server, handlers Handlers, client *ent.Client, services Services, ipAccessControl *middleware.IPAccessControlConfig) {
@@ -56,8 +64,10 @@ func SetupRoutes(server *Server, handlers Handlers, client *ent.Client, services
 	server.NoRoute(static.Handler())
 
 	server.Use(middleware.AccessLog())
+	server.Use(middleware.WithClientIP())
 	server.Use(middleware.WithEntClient(client))
 	server.Use(middleware.WithLoggingTracing(server.Config.Trace))
+	server.Use(middleware.WithOTelTracing())
 	server.Use(middleware.WithMetrics())
 
 	// Setup CORS middleware at server level if enabled
@@ -81,6 +91,8 @@ func SetupRoutes(server *Server, handlers Handlers, client *ent.Client, services
 		publicGroup.GET("/favicon", handlers.System.GetFavicon)
 		// Health check endpoint - no authentication required
 		publicGroup.GET("/health", handlers.System.Health)
+		// Prometheus metrics, only served when the prometheus metrics exporter is enabled, to the scrapers sending its bearer token
+		publicGroup.GET("/metrics", gin.WrapH(metrics.Handler()))
 		publicGroup.GET("/auth/invitations/:token", handlers.Invitation.Get)
 		publicGroup.POST("/auth/invitations/:token/register", handlers.Invitation.Register)
 	}
@@ -97,6 +109,31 @@ func SetupRoutes(server *Server, handlers Handlers, client *ent.Client, services
 	oauthGroup := server.Group("/oauth", middleware.WithTimeout(server.Config.RequestTimeout))
 	{
 		handlers.OIDC.RegisterRoutes(oauthGroup)
+		handlers.SAML.RegisterRoutes(oauthGroup)
+	}
+
+	// SCIM provisioning - authenticated by the dedicated SCIM token
+	scimGroup := server.Group("/scim/v2",
+		middleware.WithTimeout(server.Config.RequestTimeout),
+		middleware.WithSCIMAuth(services.SCIMService),
+	)
+	{
+		scimGroup.GET("/ServiceProviderConfig", handlers.SCIM.GetServiceProviderConfig)
+		scimGroup.GET("/Users", handlers.SCIM.ListUsers)
+		scimGroup.GET("/Users/:id", handlers.SCIM.GetUser)
+		scimGroup.GET("/Groups", handlers.SCIM.ListGroups)
+		scimGroup.GET("/Groups/:id", handlers.SCIM.GetGroup)
+
+		// Provisioning changes are recorded in the audit log.
+		scimActionGroup := scimGroup.Group("", middleware.WithAuditLog(services.AuditLogService))
+		scimActionGroup.POST("/Users", handlers.SCIM.CreateUser)
+		scimActionGroup.PUT("/Users/:id", handlers.SCIM.ReplaceUser)
+		scimActionGroup.PATCH("/Users/:id", handlers.SCIM.PatchUser)
+		scimActionGroup.DELETE("/Users/:id", handlers.SCIM.DeleteUser)
+		scimActionGroup.POST("/Groups", handlers.SCIM.CreateGroup)
+		scimActionGroup.PUT("/Groups/:id", handlers.SCIM.ReplaceGroup)
+		scimActionGroup.PATCH("/Groups/:id", handlers.SCIM.PatchGroup)
+		scimActionGroup.DELETE("/Groups/:id", handlers.SCIM.DeleteGroup)
 	}
 
 	adminGroup := server.Group("/admin", middleware.WithJWTAuth(services.AuthService), middleware.WithProjectID())
@@ -108,20 +145,22 @@ func SetupRoutes(server *Server, handlers Handlers, client *ent.Client, services
 		adminGroup.POST("/graphql", middleware.WithTimeout(server.Config.RequestTimeout), func(c *gin.Context) {
 			handlers.Graphql.Graphql.ServeHTTP(c.Writer, c.Request)
 		})
-		adminGroup.POST("/invitations", handlers.Invitation.Create)
+		// Admin actions outside of GraphQL are recorded in the audit log.
+		adminActionGroup := adminGroup.Group("", middleware.WithAuditLog(services.AuditLogService))
+		adminActionGroup.POST("/invitations", handlers.Invitation.Create)
 
-		adminGroup.POST("/codex/oauth/start", handlers.Codex.StartOAuth)
-		adminGroup.POST("/codex/oauth/exchange", handlers.Codex.Exchange)
-		adminGroup.POST("/codex/auth/decode", handlers.Codex.DecodeAuthJSON)
+		adminActionGroup.POST("/codex/oauth/start", handlers.Codex.StartOAuth)
+		adminActionGroup.POST("/codex/oauth/exchange", handlers.Codex.Exchange)
+		adminActionGroup.POST("/codex/auth/decode", handlers.Codex.DecodeAuthJSON)
 
-		adminGroup.POST("/claudecode/oauth/start", handlers.ClaudeCode.StartOAuth)
-		adminGroup.POST("/claudecode/oauth/exchange", handlers.ClaudeCode.Exchange)
+		adminActionGroup.POST("/claudecode/oauth/start", handlers.ClaudeCode.StartOAuth)
+		adminActionGroup.POST("/claudecode/oauth/exchange", handlers.ClaudeCode.Exchange)
 
-		adminGroup.POST("/antigravity/oauth/start", handlers.Antigravity.StartOAuth)
-		adminGroup.POST("/antigravity/oauth/exchange", handlers.Antigravity.Exchange)
+		adminActionGroup.POST("/antigravity/oauth/start", handlers.Antigravity.StartOAuth)
+		adminActionGroup.POST("/antigravity/oauth/exchange", handlers.Antigravity.Exchange)
 
-		adminGroup.POST("/copilot/oauth/start", handlers.Copilot.StartOAuth)
-		adminGroup.POST("/copilot/oauth/poll", handlers.Copilot.PollOAuth)
+		adminActionGroup.POST("/copilot/oauth/start", handlers.Copilot.StartOAuth)
+		adminActionGroup.POST("/copilot/oauth/poll", handlers.Copilot.PollOAuth)
 
 		// OIDC Manual Linking
 		adminGroup.GET("/oidc/link/:provider", handlers.OIDC.GetLinkAuthorizeURL)
@@ -144,6 +183,21 @@ func SetupRoutes(server *Server, handlers Handlers, client *ent.Client, services
 			middleware.WithTimeout(server.Config.RequestTimeout),
 			handlers.RequestPreview.PreviewRequest,
 		)
+		adminGroup.GET(
+			"/request-archives/export",
+			middleware.WithTimeout(server.Config.LLMRequestTimeout),
+			handlers.RequestContent.ExportRequestArchives,
+		)
+		adminGroup.GET(
+			"/invoices/:invoice_id/export",
+			middleware.WithTimeout(server.Config.RequestTimeout),
+			handlers.Invoice.ExportInvoice,
+		)
+		adminGroup.GET(
+			"/data-exports/:export_id/download",
+			middleware.WithTimeout(server.Config.LLMRequestTimeout),
+			handlers.DataExport.DownloadDataExport,
+		)
 	}
 
 	openAPIGroup := server.Group(
@@ -161,6 +215,10 @@ func SetupRoutes(server *Server, handlers Handlers, client *ent.Client, services
 		})
 
 		openAPIGroup.POST("/webhook/echo", handlers.System.WebhookEcho)
+
+		// Short-lived tokens for the end users of the service account API key.
+		openAPIGroup.POST("/v1/api-key-tokens", handlers.APIKey.MintToken)
+		openAPIGroup.POST("/v1/api-key-tokens/revoke", handlers.APIKey.RevokeTokens)
 	}
 
 	apiGroup := server.Group("/",
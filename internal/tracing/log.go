@@ -3,6 +3,8 @@ package tracing
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/looplj/axonhub/internal/log"
 )
 
@@ -32,5 +34,13 @@ func TraceFieldsHooks(ctx context.Context, msg string, fields ...log.Field) []lo
 		fields = append(fields, log.String("operation_name", operationName))
 	}
 
+	// Correlate the log entry with the OpenTelemetry span when tracing is enabled.
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		fields = append(fields,
+			log.String("otel_trace_id", spanContext.TraceID().String()),
+			log.String("otel_span_id", spanContext.SpanID().String()),
+		)
+	}
+
 	return fields
 }
@@ -0,0 +1,117 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.40.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// OTelConfig specifies the configuration for OpenTelemetry distributed tracing.
+type OTelConfig struct {
+	// Enabled specifies whether spans are exported and W3C trace context is propagated.
+	// Default is false.
+	Enabled bool `conf:"enabled" yaml:"enabled" json:"enabled"`
+
+	// ServiceName is the service.name resource attribute of the spans.
+	// Default to "axonhub".
+	ServiceName string `conf:"service_name" yaml:"service_name" json:"service_name"`
+
+	// SampleRatio is the ratio of new traces to sample, from 0 to 1.
+	// Traces started by the caller follow the sampling decision of the incoming traceparent.
+	// Default to 1.
+	SampleRatio float64 `conf:"sample_ratio" validate:"gte=0,lte=1" yaml:"sample_ratio" json:"sample_ratio"`
+
+	Exporter OTelExporterConfig `conf:"exporter" yaml:"exporter" json:"exporter"`
+}
+
+type OTelExporterConfig struct {
+	// Type is the span exporter type.
+	// Default to "otlpgrpc".
+	Type string `conf:"type" validate:"oneof=stdout otlpgrpc otlphttp" yaml:"type" json:"type"`
+	// Endpoint is the collector endpoint of the otlpgrpc and otlphttp exporters.
+	Endpoint string `conf:"endpoint" yaml:"endpoint" json:"endpoint"`
+	Insecure bool   `conf:"insecure" yaml:"insecure" json:"insecure"`
+}
+
+// Tracer is the tracer of the application.
+// It is backed by the global tracer provider, so it is a no-op until NewTracerProvider enables tracing.
+var Tracer trace.Tracer = otel.Tracer("github.com/looplj/axonhub")
+
+// NewTracerProvider initializes the OpenTelemetry tracer provider and the W3C trace context propagator.
+// It returns nil if tracing is disabled.
+func NewTracerProvider(config OTelConfig) (*sdktrace.TracerProvider, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+
+	ctx := context.Background()
+
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+
+	switch config.Exporter.Type {
+	case "stdout":
+		exporter, err = stdouttrace.New()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stdout exporter: %w", err)
+		}
+	case "otlpgrpc":
+		opts := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(config.Exporter.Endpoint),
+		}
+		if config.Exporter.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create otlpgrpc exporter: %w", err)
+		}
+	case "otlphttp":
+		opts := []otlptracehttp.Option{
+			otlptracehttp.WithEndpoint(config.Exporter.Endpoint),
+		}
+		if config.Exporter.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+
+		exporter, err = otlptracehttp.New(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create otlphttp exporter: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported tracing exporter type: %q", config.Exporter.Type)
+	}
+
+	serviceName := config.ServiceName
+	if serviceName == "" {
+		serviceName = "axonhub"
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing resource: %w", err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return tracerProvider, nil
+}
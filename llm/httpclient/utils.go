@@ -206,8 +206,9 @@ var blockedHeaders = map[string]bool{
 
 	// AxonHub customized headers that should not be forwarded to upstream to avoid recognition.
 	// NOTE: user customized trace/thread headers will be sent to upstream.
-	"Ah-Trace-Id":  true,
-	"Ah-Thread-Id": true,
+	"Ah-Trace-Id":    true,
+	"Ah-Thread-Id":   true,
+	"Ah-End-User-Id": true,
 
 	// X-Initiator is used by specific channels (e.g. Copilot) for billing control.
 	// Block from auto-merge so it is only forwarded by the channel that explicitly needs it.